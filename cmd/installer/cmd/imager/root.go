@@ -43,10 +43,19 @@ var cmdFlags struct {
 	ImageCache            string
 	OutputPath            string
 	OutputKind            string
+	OutputFormat          string
+	ImageDiskFormat       string
+	ImageDiskFormatOpts   string
+	CompressionLevel      int
+	Checksums             bool
+	DeltaBase             string
 	TarToStdout           bool
 	OverlayName           string
 	OverlayImage          string
 	OverlayOptions        []string
+	MachineConfig         string
+	// AllowMachineConfigSecrets is an explicit opt-in to embed a machine config with secrets into the image.
+	AllowMachineConfigSecrets bool
 	// Only used when generating a secure boot iso without also providing a secure boot database.
 	SecurebootIncludeWellKnownCerts bool
 }
@@ -149,6 +158,44 @@ var rootCmd = &cobra.Command{
 					}
 
 					prof.Output.Kind = outKind
+
+					if outKind.IsCloudImage() {
+						// cloud images are ready to be imported, and they are not compressed further
+						prof.Output.OutFormat = profile.OutFormatRaw
+					}
+				}
+
+				if cmdFlags.OutputFormat != "" {
+					outFormat, err := profile.OutFormatString(cmdFlags.OutputFormat)
+					if err != nil {
+						return err
+					}
+
+					prof.Output.OutFormat = outFormat
+				}
+
+				prof.Output.CompressionLevel = cmdFlags.CompressionLevel
+				prof.Output.Checksums = cmdFlags.Checksums
+
+				if cmdFlags.DeltaBase != "" {
+					prof.Output.DeltaBase = cmdFlags.DeltaBase
+				}
+
+				if cmdFlags.ImageDiskFormat != "" || cmdFlags.ImageDiskFormatOpts != "" {
+					if prof.Output.ImageOptions == nil {
+						prof.Output.ImageOptions = &profile.ImageOptions{}
+					}
+
+					if cmdFlags.ImageDiskFormat != "" {
+						diskFormat, err := profile.DiskFormatString(cmdFlags.ImageDiskFormat)
+						if err != nil {
+							return err
+						}
+
+						prof.Output.ImageOptions.DiskFormat = diskFormat
+					}
+
+					prof.Output.ImageOptions.DiskFormatOptions = cmdFlags.ImageDiskFormatOpts
 				}
 
 				if cmdFlags.BaseInstallerImage != "" {
@@ -197,6 +244,22 @@ var rootCmd = &cobra.Command{
 					prof.Output.ImageOptions.DiskSize = int64(size)
 				}
 
+				if cmdFlags.MachineConfig != "" {
+					machineConfig := cmdFlags.MachineConfig
+
+					if strings.HasPrefix(machineConfig, "@") {
+						data, err := os.ReadFile(machineConfig[1:])
+						if err != nil {
+							return err
+						}
+
+						machineConfig = string(data)
+					}
+
+					prof.Customization.MachineConfig = machineConfig
+					prof.Customization.AllowMachineConfigSecrets = cmdFlags.AllowMachineConfigSecrets
+				}
+
 				if cmdFlags.SecurebootIncludeWellKnownCerts {
 					if prof.Input.SecureBoot == nil {
 						prof.Input.SecureBoot = &profile.SecureBootAssets{}
@@ -214,7 +277,7 @@ var rootCmd = &cobra.Command{
 				return err
 			}
 
-			if _, err = imager.Execute(ctx, cmdFlags.OutputPath, report); err != nil {
+			if _, err = imager.ExecuteVariants(ctx, cmdFlags.OutputPath, report); err != nil {
 				report.Report(reporter.Update{
 					Message: err.Error(),
 					Status:  reporter.StatusError,
@@ -252,11 +315,19 @@ func init() {
 	rootCmd.PersistentFlags().Var(&cmdFlags.MetaValues, "meta", "A key/value pair for META")
 	rootCmd.PersistentFlags().StringArrayVar(&cmdFlags.SystemExtensionImages, "system-extension-image", []string{}, "The image reference to the system extension to install")
 	rootCmd.PersistentFlags().StringVar(&cmdFlags.OutputPath, "output", "/out", "The output directory path")
-	rootCmd.PersistentFlags().StringVar(&cmdFlags.OutputKind, "output-kind", "", "Override output kind")
+	rootCmd.PersistentFlags().StringVar(&cmdFlags.OutputKind, "output-kind", "", "Override output kind (e.g. image, gce, azure-vhd, ami)")
+	rootCmd.PersistentFlags().StringVar(&cmdFlags.OutputFormat, "output-format", "", "Override output format (raw, .tar.gz, .xz, .gz, .zst)")
+	rootCmd.PersistentFlags().IntVar(&cmdFlags.CompressionLevel, "compression-level", 0, "Override compression level of the output format")
+	rootCmd.PersistentFlags().BoolVar(&cmdFlags.Checksums, "checksums", false, "Write sha256 checksum sidecar file for the output")
+	rootCmd.PersistentFlags().StringVar(&cmdFlags.DeltaBase, "delta-base", "", "Write binary delta from the previous build of the output at the path")
+	rootCmd.PersistentFlags().StringVar(&cmdFlags.ImageDiskFormat, "image-disk-format", "", "Override disk image format (raw, qcow2, vhd, vhdx, vmdk, ova)")
+	rootCmd.PersistentFlags().StringVar(&cmdFlags.ImageDiskFormatOpts, "image-disk-format-options", "", "Override disk image format options (passed to qemu-img)")
 	rootCmd.PersistentFlags().BoolVar(&cmdFlags.TarToStdout, "tar-to-stdout", false, "Tar output and send to stdout")
 	rootCmd.PersistentFlags().StringVar(&cmdFlags.OverlayName, "overlay-name", "", "The name of the overlay to use")
 	rootCmd.PersistentFlags().StringVar(&cmdFlags.OverlayImage, "overlay-image", "", "The image reference to the overlay")
 	rootCmd.PersistentFlags().StringArrayVar(&cmdFlags.OverlayOptions, "overlay-option", []string{}, "Extra options to pass to the overlay")
+	rootCmd.PersistentFlags().StringVar(&cmdFlags.MachineConfig, "machine-config", "", "Machine config to embed into the image (metal ISO and disk image only), use @file to read from a file")
+	rootCmd.PersistentFlags().BoolVar(&cmdFlags.AllowMachineConfigSecrets, "machine-config-allow-secrets", false, "Allow embedding a machine config which contains secrets into the image")
 	rootCmd.MarkFlagsMutuallyExclusive("board", "overlay-name")
 	rootCmd.MarkFlagsMutuallyExclusive("board", "overlay-image")
 	rootCmd.MarkFlagsMutuallyExclusive("board", "overlay-option")
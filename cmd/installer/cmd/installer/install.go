@@ -72,6 +72,12 @@ func runInstallCmd(ctx context.Context) (err error) {
 		if config.Machine() != nil && config.Machine().Install().LegacyBIOSSupport() {
 			options.LegacyBIOSSupport = true
 		}
+
+		if verificationConfig := config.ExtensionVerificationConfig(); verificationConfig != nil {
+			if err = install.VerifyExtensions(options.Arch, verificationConfig); err != nil {
+				return err
+			}
+		}
 	}
 
 	return install.Install(ctx, p, mode, options)
@@ -0,0 +1,55 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package install
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+
+	"github.com/siderolabs/talos/internal/pkg/extensions"
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	extinterface "github.com/siderolabs/talos/pkg/machinery/extensions"
+)
+
+// VerifyExtensions enforces the signature verification policy on the system extensions baked into the installer.
+//
+// The verification happens before any of the boot assets is written to the disk.
+func VerifyExtensions(arch string, policy config.ExtensionVerificationConfig) error {
+	var cfg extinterface.Config
+
+	if err := cfg.Read(fmt.Sprintf(constants.ExtensionsAssetPath, arch)); err != nil {
+		if errors.Is(err, fs.ErrNotExist) && policy.InsecureNoVerify() {
+			log.Printf("WARNING: the installer image doesn't record the system extensions, skipping signature verification")
+
+			return nil
+		}
+
+		if errors.Is(err, fs.ErrNotExist) {
+			return errors.New("the installer image doesn't record the system extensions, it should be built with the imager to verify the signatures")
+		}
+
+		return fmt.Errorf("error reading system extensions configuration: %w", err)
+	}
+
+	results, err := extensions.Verify(cfg.Layers, policy)
+	if err != nil {
+		return fmt.Errorf("system extensions signature verification failed: %w", err)
+	}
+
+	for _, result := range results {
+		if result.Err != nil {
+			log.Printf("WARNING: system extension %q is not verified: %s", result.Layer.Metadata.Name, result.Err)
+
+			continue
+		}
+
+		log.Printf("system extension %q (%s) is signed by %q", result.Layer.Metadata.Name, result.Layer.Source.Digest, result.Signer)
+	}
+
+	return nil
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/siderolabs/go-blockdevice/v2/block"
 	"github.com/siderolabs/go-blockdevice/v2/partitioning"
 	"github.com/siderolabs/go-blockdevice/v2/partitioning/gpt"
+	"github.com/siderolabs/go-copy/copy"
 	"github.com/siderolabs/go-pointer"
 	"github.com/siderolabs/go-procfs/procfs"
 	"gopkg.in/yaml.v3"
@@ -27,6 +28,7 @@ import (
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/board"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader/mount"
 	bootloaderoptions "github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader/options"
 	"github.com/siderolabs/talos/internal/pkg/cache"
 	"github.com/siderolabs/talos/internal/pkg/meta"
@@ -61,6 +63,9 @@ type Options struct {
 
 	ImageCachePath string
 
+	// EmbeddedConfigPath is the path to the machine config embedded into the disk image (image mode only).
+	EmbeddedConfigPath string
+
 	// Options specific for the image creation mode.
 	ImageSecureboot     bool
 	DiskImageBootloader string
@@ -329,6 +334,12 @@ func (i *Installer) Install(ctx context.Context, mode Mode) (err error) {
 				return fmt.Errorf("failed to install image cache: %w", err)
 			}
 		}
+
+		if mode == ModeImage && i.options.EmbeddedConfigPath != "" {
+			if err = i.installEmbeddedConfig(info); err != nil {
+				return fmt.Errorf("failed to install embedded machine config: %w", err)
+			}
+		}
 	}
 
 	if mode == ModeUpgrade {
@@ -497,6 +508,13 @@ func (i *Installer) createPartitions(ctx context.Context, gptdev gpt.Device, mod
 		partition.NewPartitionOptions(constants.MetaPartitionLabel, false, quirk),
 	)
 
+	// machine config embedded into the disk image, read by the metal platform via talos.config=metal-iso
+	if mode == ModeImage && i.options.EmbeddedConfigPath != "" {
+		partitions = append(partitions,
+			partition.NewPartitionOptions(constants.MetalConfigISOLabel, false, quirk),
+		)
+	}
+
 	legacyImage := mode == ModeImage && !quirks.New(i.options.Version).SkipDataPartitions()
 
 	// compatibility when installing on Talos < 1.8
@@ -569,6 +587,37 @@ func (i *Installer) createPartitions(ctx context.Context, gptdev gpt.Device, mod
 	return info, nil
 }
 
+// installEmbeddedConfig copies the machine config to the config partition of the disk image.
+func (i *Installer) installEmbeddedConfig(info *blkid.Info) error {
+	tempMountDir, err := os.MkdirTemp("", "talos-embedded-config-install")
+	if err != nil {
+		return fmt.Errorf("creating temporary directory for talos-embedded-config-install: %w", err)
+	}
+
+	defer os.RemoveAll(tempMountDir) //nolint:errcheck
+
+	return mount.PartitionOp(
+		i.options.Disk,
+		[]mount.Spec{
+			{
+				PartitionLabel: constants.MetalConfigISOLabel,
+				FilesystemType: partition.FilesystemTypeVFAT,
+				MountTarget:    tempMountDir,
+			},
+		},
+		func() error {
+			return copy.File(i.options.EmbeddedConfigPath, filepath.Join(tempMountDir, constants.ConfigFilename))
+		},
+		[]blkid.ProbeOption{
+			// installation happens with locked blockdevice
+			blkid.WithSkipLocking(true),
+		},
+		nil,
+		nil,
+		info,
+	)
+}
+
 func (i *Installer) runPreflightChecks(mode Mode) error {
 	if mode != ModeUpgrade {
 		// pre-flight checks only apply to upgrades
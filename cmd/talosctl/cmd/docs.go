@@ -21,6 +21,7 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/block"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/hardware"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/k8s"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/runtime/extensions"
@@ -140,6 +141,10 @@ var docsCmd = &cobra.Command{
 					name:    "hardware",
 					fileDoc: hardware.GetFileDoc(),
 				},
+				{
+					name:    "k8s",
+					fileDoc: k8s.GetFileDoc(),
+				},
 			} {
 				path := filepath.Join(dir, pkg.name)
 
@@ -24,9 +24,14 @@ var (
 	controlplanesFlagName     = "controlplanes"
 	kubernetesVersionFlagName = "kubernetes-version"
 	registryMirrorFlagName    = "registry-mirror"
+	registryCacheFlagName     = "with-registry-cache"
 	networkMTUFlagName        = "mtu"
 	networkCIDRFlagName       = "cidr"
+	networkIPv6FlagName       = "ipv6"
+	networkIPv6OnlyFlagName   = "ipv6-only"
 	talosVersionFlagName      = "talos-version"
+	bootOrderFlagName         = "boot-order"
+	noBootFlagName            = "no-boot"
 
 	// Flags that have been renamed in the user-facing commands.
 	controlPlaneCpusFlagName        = "cpus-controlplanes"
@@ -36,10 +41,30 @@ var (
 	configPatchFlagName             = "config-patch"
 	configPatchControlPlaneFlagName = "config-patch-controlplanes"
 	configPatchWorkerFlagName       = "config-patch-workers"
+	configPatchNodeFlagName         = "config-patch-node"
 	talosconfigDestinationFlagName  = "talosconfig-destination"
 
 	// Qemu flags.
-	disksFlagName = "disks"
+	disksFlagName                      = "disks"
+	extraDisksControlPlanesFlagName    = "extra-disks-controlplanes"
+	extraDisksWorkersFlagName          = "extra-disks-workers"
+	cpuModelFlagName                   = "cpu-model"
+	cpuTopologyControlPlanesFlagName   = "cpu-topology-controlplanes"
+	cpuTopologyWorkersFlagName         = "cpu-topology-workers"
+	machineTypeFlagName                = "machine-type"
+	nestedVirtualizationFlagName       = "with-nested-virt"
+	extraQemuArgsControlPlanesFlagName = "extra-qemu-args-controlplanes"
+	extraQemuArgsWorkersFlagName       = "extra-qemu-args-workers"
+	hostMountsFlagName                 = "mount-host-path"
+	concurrencyFlagName                = "concurrency"
+)
+
+// Boot orders of the cluster nodes.
+const (
+	// bootOrderParallel boots all the nodes at once.
+	bootOrderParallel = "parallel"
+	// bootOrderStaged boots the worker nodes once the control plane is healthy.
+	bootOrderStaged = "staged"
 )
 
 // commonOps are the options that are not specific to a single provider.
@@ -48,6 +73,7 @@ type commonOps struct {
 	rootOps                   *clustercmd.CmdOps
 	talosconfigDestination    string
 	registryMirrors           []string
+	withRegistryCache         bool
 	registryInsecure          []string
 	kubernetesVersion         string
 	applyConfigEnabled        bool
@@ -55,6 +81,8 @@ type commonOps struct {
 	networkCIDR               string
 	networkMTU                int
 	networkIPv4               bool
+	networkIPv6               bool
+	networkIPv6Only           bool
 	dnsDomain                 string
 	workers                   int
 	controlplanes             int
@@ -75,11 +103,14 @@ type commonOps struct {
 	configPatch               []string
 	configPatchControlPlane   []string
 	configPatchWorker         []string
+	configPatchNode           []string
 	kubePrismPort             int
 	skipK8sNodeReadinessCheck bool
 	withJSONLogs              bool
 	wireguardCIDR             string
 	withUUIDHostnames         bool
+	bootOrder                 string
+	noBoot                    bool
 }
 
 func getDefaultCommonOptions() commonOps {
@@ -108,6 +139,7 @@ func getDefaultCommonOptions() commonOps {
 		kubePrismPort:          constants.DefaultKubePrismPort,
 		enableClusterDiscovery: true,
 		talosVersion:           helpers.GetTag(),
+		bootOrder:              bootOrderParallel,
 	}
 }
 
@@ -120,11 +152,15 @@ func getCommonUserFacingFlags(pointer *commonOps) *pflag.FlagSet {
 	addConfigPatchFlag(common, &pointer.configPatch, configPatchFlagName)
 	addConfigPatchControlPlaneFlag(common, &pointer.configPatchControlPlane, configPatchControlPlaneFlagName)
 	addConfigPatchWorkerFlag(common, &pointer.configPatchWorker, configPatchWorkerFlagName)
+	addConfigPatchNodeFlag(common, &pointer.configPatchNode)
 
 	addControlplaneCpusFlag(common, &pointer.controlplaneResources.cpu, controlPlaneCpusFlagName)
 	addWorkersCpusFlag(common, &pointer.workerResources.cpu, workersCpusFlagName)
 	addControlPlaneMemoryFlag(common, &pointer.controlplaneResources.memory, controlPlaneMemoryFlagName)
 	addWorkersMemoryFlag(common, &pointer.workerResources.memory, workersMemoryFlagName)
+	addNetworkIPv6Flags(common, pointer)
+	addRegistryCacheFlag(common, &pointer.withRegistryCache)
+	addBootFlags(common, pointer)
 
 	// The following flags are used in tests and development
 	addNetworkMTUFlag(common, &pointer.networkMTU)
@@ -175,6 +211,12 @@ func addConfigPatchWorkerFlag(flagset *pflag.FlagSet, bind *[]string, flagName s
 	flagset.StringArrayVar(bind, flagName, nil, "patch generated machineconfigs (applied to 'worker' type)")
 }
 
+func addConfigPatchNodeFlag(flagset *pflag.FlagSet, bind *[]string) {
+	flagset.StringArrayVar(bind, configPatchNodeFlagName, nil,
+		`patch generated machineconfig of a single node in format "<node>=<patch>", e.g. "worker-3=@patch.yaml", `+
+			`the node is selected by its name or by the type and the index of the node of that type, applied after the patches for the node type`)
+}
+
 func addWorkersFlag(flagset *pflag.FlagSet, bind *int) {
 	flagset.IntVar(bind, workersFlagName, *bind, "the number of workers to create")
 }
@@ -191,10 +233,31 @@ func addRegistryMirrorFlag(flagset *pflag.FlagSet, bind *[]string) {
 	flagset.StringSliceVar(bind, registryMirrorFlagName, []string{}, "list of registry mirrors to use in format: <registry host>=<mirror URL>")
 }
 
+func addRegistryCacheFlag(flagset *pflag.FlagSet, bind *bool) {
+	flagset.BoolVar(bind, registryCacheFlagName, *bind,
+		"run a pull-through registry cache for the common registries on the host (kept across clusters), explicit registry mirrors take precedence")
+}
+
 func addNetworkMTUFlag(flagset *pflag.FlagSet, bind *int) {
 	flagset.IntVar(bind, networkMTUFlagName, *bind, "MTU of the cluster network")
 }
 
+func addNetworkIPv6Flags(flagset *pflag.FlagSet, bind *commonOps) {
+	flagset.BoolVar(&bind.networkIPv6, networkIPv6FlagName, bind.networkIPv6, "enable IPv6 network in the cluster")
+	flagset.BoolVar(&bind.networkIPv6Only, networkIPv6OnlyFlagName, bind.networkIPv6Only,
+		"create an IPv6-only Kubernetes cluster, IPv4 network is only used by the nodes to reach IPv4-only hosts via the masquerading on the host")
+}
+
+func addBootFlags(flagset *pflag.FlagSet, bind *commonOps) {
+	flagset.StringVar(&bind.bootOrder, bootOrderFlagName, bind.bootOrder,
+		fmt.Sprintf(`order to boot the nodes in: %q boots all the nodes at once, %q boots the workers once the control plane is healthy`, bootOrderParallel, bootOrderStaged))
+	flagset.BoolVar(&bind.noBoot, noBootFlagName, bind.noBoot, "create the nodes without booting them, boot the nodes later with 'talosctl cluster start'")
+}
+
+func addFromFlag(flagset *pflag.FlagSet, bind *string) {
+	flagset.StringVar(bind, fromFlagName, *bind, "create the cluster from the spec exported with 'cluster export', the spec overrides the flags describing the cluster")
+}
+
 func addTalosVersionFlag(flagset *pflag.FlagSet, bind *string, description string) {
 	flagset.StringVar(bind, talosVersionFlagName, *bind, description)
 }
@@ -203,5 +266,36 @@ func addTalosVersionFlag(flagset *pflag.FlagSet, bind *string, description strin
 
 func addDisksFlag(flagset *pflag.FlagSet, bind *[]string, defaultVal []string) {
 	flagset.StringSliceVar(bind, disksFlagName, defaultVal,
-		`list of disks to create in format "<driver1>:<size1>" (disks after the first one are added only to worker machines)`)
+		`list of disks to create in format "<driver1>:<size1>[:<count1>]" (disks after the first one are added only to worker machines)`)
+}
+
+func addExtraDisksFlags(flagset *pflag.FlagSet, bind *qemuOps) {
+	flagset.StringSliceVar(&bind.extraDisksControlPlanes, extraDisksControlPlanesFlagName, bind.extraDisksControlPlanes,
+		`list of extra disks to create for each control plane/VM in format "<driver1>:<size1>[:<count1>]", e.g. "nvme:10GiB:2" (none by default)`)
+	flagset.StringSliceVar(&bind.extraDisksWorkers, extraDisksWorkersFlagName, bind.extraDisksWorkers,
+		`list of extra disks to create for each worker/VM in format "<driver1>:<size1>[:<count1>]", overrides the extra disks from --`+disksFlagName)
+}
+
+func addQemuCPUFlags(flagset *pflag.FlagSet, bind *qemuOps) {
+	flagset.StringVar(&bind.cpuModel, cpuModelFlagName, bind.cpuModel,
+		`QEMU CPU model with optional feature flags, e.g. "host" or "Skylake-Server,-hle" (defaults to "max")`)
+	flagset.StringVar(&bind.cpuTopologyControlPlanes, cpuTopologyControlPlanesFlagName, bind.cpuTopologyControlPlanes,
+		`CPU topology for each control plane/VM in format "sockets=<n>,cores=<n>,threads=<n>", should match the number of CPUs`)
+	flagset.StringVar(&bind.cpuTopologyWorkers, cpuTopologyWorkersFlagName, bind.cpuTopologyWorkers,
+		`CPU topology for each worker/VM in format "sockets=<n>,cores=<n>,threads=<n>", should match the number of CPUs`)
+	flagset.StringVar(&bind.machineType, machineTypeFlagName, bind.machineType, "QEMU machine type (defaults to q35 on amd64, virt on arm64)")
+	flagset.BoolVar(&bind.nestedVirtualization, nestedVirtualizationFlagName, bind.nestedVirtualization, "expose hardware virtualization extensions to the VMs")
+	flagset.StringArrayVar(&bind.extraQemuArgsControlPlanes, extraQemuArgsControlPlanesFlagName, bind.extraQemuArgsControlPlanes,
+		`extra QEMU arguments for each control plane/VM, e.g. "-device virtio-rng-pci" (can be specified multiple times)`)
+	flagset.StringArrayVar(&bind.extraQemuArgsWorkers, extraQemuArgsWorkersFlagName, bind.extraQemuArgsWorkers,
+		`extra QEMU arguments for each worker/VM, e.g. "-device virtio-rng-pci" (can be specified multiple times)`)
+}
+
+func addConcurrencyFlag(flagset *pflag.FlagSet, bind *int) {
+	flagset.IntVar(bind, concurrencyFlagName, *bind, "maximum number of VMs created in parallel")
+}
+
+func addHostMountsFlag(flagset *pflag.FlagSet, bind *[]string) {
+	flagset.StringArrayVar(bind, hostMountsFlagName, *bind,
+		`host directory to share with the VMs in format "host=<host path>,guest=<guest path>[,readonly][,type=virtiofs|9p]" (can be specified multiple times)`)
 }
@@ -19,54 +19,71 @@ import (
 	"github.com/siderolabs/talos/pkg/cli"
 	"github.com/siderolabs/talos/pkg/images"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/provision/providers"
 )
 
 type qemuOps struct {
-	nodeInstallImage          string
-	nodeVmlinuzPath           string
-	nodeInitramfsPath         string
-	nodeISOPath               string
-	nodeUSBPath               string
-	nodeUKIPath               string
-	nodeDiskImagePath         string
-	nodeIPXEBootScript        string
-	bootloaderEnabled         bool
-	uefiEnabled               bool
-	tpm1_2Enabled             bool
-	tpm2Enabled               bool
-	extraUEFISearchPaths      []string
-	networkNoMasqueradeCIDRs  []string
-	nameservers               []string
-	disks                     []string
-	diskBlockSize             uint
-	preallocateDisks          bool
-	clusterUserVolumes        []string
-	targetArch                string
-	cniBinPath                []string
-	cniConfDir                string
-	cniCacheDir               string
-	cniBundleURL              string
-	encryptStatePartition     bool
-	encryptEphemeralPartition bool
-	encryptUserVolumes        bool
-	useVIP                    bool
-	badRTC                    bool
-	extraBootKernelArgs       string
-	dhcpSkipHostname          bool
-	networkChaos              bool
-	jitter                    time.Duration
-	latency                   time.Duration
-	packetLoss                float64
-	packetReorder             float64
-	packetCorrupt             float64
-	bandwidth                 int
-	diskEncryptionKeyTypes    []string
-	withFirewall              string
-	withSiderolinkAgent       agentFlag
-	debugShellEnabled         bool
-	withIOMMU                 bool
-	configInjectionMethod     string
-	networkIPv6               bool
+	nodeInstallImage           string
+	controlplaneImage          string
+	workerImage                string
+	nodeVmlinuzPath            string
+	nodeInitramfsPath          string
+	nodeISOPath                string
+	nodeUSBPath                string
+	nodeUKIPath                string
+	nodeDiskImagePath          string
+	nodeIPXEBootScript         string
+	bootloaderEnabled          bool
+	uefiEnabled                bool
+	tpm1_2Enabled              bool
+	tpm2Enabled                bool
+	secureBootEnabled          bool
+	secureBootVarsPath         string
+	extraUEFISearchPaths       []string
+	networkNoMasqueradeCIDRs   []string
+	nameservers                []string
+	disks                      []string
+	diskBlockSize              uint
+	preallocateDisks           bool
+	clusterUserVolumes         []string
+	targetArch                 string
+	cniBinPath                 []string
+	cniConfDir                 string
+	cniCacheDir                string
+	cniBundleURL               string
+	encryptStatePartition      bool
+	encryptEphemeralPartition  bool
+	encryptUserVolumes         bool
+	useVIP                     bool
+	badRTC                     bool
+	extraBootKernelArgs        string
+	dhcpSkipHostname           bool
+	networkChaos               bool
+	jitter                     time.Duration
+	latency                    time.Duration
+	packetLoss                 float64
+	packetReorder              float64
+	packetCorrupt              float64
+	bandwidth                  int
+	diskEncryptionKeyTypes     []string
+	withFirewall               string
+	withSiderolinkAgent        agentFlag
+	debugShellEnabled          bool
+	withIOMMU                  bool
+	configInjectionMethod      string
+	cpuModel                   string
+	cpuTopologyControlPlanes   string
+	cpuTopologyWorkers         string
+	machineType                string
+	nestedVirtualization       bool
+	extraQemuArgsControlPlanes []string
+	extraQemuArgsWorkers       []string
+	additionalNetworks         []string
+	additionalNICs             []string
+	hostMounts                 []string
+	concurrency                int
+	extraDisksControlPlanes    []string
+	extraDisksWorkers          []string
 }
 
 type legacyOps struct {
@@ -88,7 +105,6 @@ var createCmd = getCreateCmd()
 func getCreateCmd() *cobra.Command {
 	const (
 		networkIPv4Flag               = "ipv4"
-		networkIPv6Flag               = "ipv6"
 		networkNoMasqueradeCIDRsFlag  = "no-masquerade-cidrs"
 		nameserversFlag               = "nameservers"
 		preallocateDisksFlag          = "disk-preallocate"
@@ -101,6 +117,8 @@ func getCreateCmd() *cobra.Command {
 		firewallFlag                  = "with-firewall"
 		tpmEnabledFlag                = "with-tpm1_2"
 		tpm2EnabledFlag               = "with-tpm2"
+		secureBootEnabledFlag         = "with-secureboot"
+		secureBootVarsFlag            = "secureboot-vars"
 		withDebugShellFlag            = "with-debug-shell"
 		withIOMMUFlag                 = "with-iommu"
 		talosconfigFlag               = "talosconfig"
@@ -156,6 +174,8 @@ func getCreateCmd() *cobra.Command {
 		// They are not applicable when no machine configuration is generated, hence mutually exclusive with the --input-dir flag.
 
 		nodeInstallImageFlag          = "install-image"
+		controlplaneImageFlag         = "controlplane-image"
+		workerImageFlag               = "worker-image"
 		configDebugFlag               = "with-debug"
 		dnsDomainFlag                 = "dns-domain"
 		withClusterDiscoveryFlag      = "with-cluster-discovery"
@@ -168,6 +188,8 @@ func getCreateCmd() *cobra.Command {
 		forceEndpointFlag             = "endpoint"
 		kubePrismFlag                 = "kubeprism-port"
 		diskEncryptionKeyTypesFlag    = "disk-encryption-key-types"
+		additionalNetworkFlag         = "additional-network"
+		additionalNICFlag             = "additional-nic"
 	)
 
 	unImplementedFlagsDarwin := []string{
@@ -184,6 +206,9 @@ func getCreateCmd() *cobra.Command {
 		packetReorderFlag,
 		packetCorruptFlag,
 		bandwidthFlag,
+		nestedVirtualizationFlagName,
+		additionalNetworkFlag,
+		additionalNICFlag,
 
 		// The following might work but need testing first.
 		configInjectionMethodFlag,
@@ -195,6 +220,8 @@ func getCreateCmd() *cobra.Command {
 	}
 	legacyOps := legacyOps{}
 
+	var from string
+
 	getCommonFlags := func() *pflag.FlagSet {
 		common := pflag.NewFlagSet("common", pflag.PanicOnError)
 
@@ -210,7 +237,10 @@ func getCreateCmd() *cobra.Command {
 		addConfigPatchFlag(common, &ops.common.configPatch, configPatchFlag)
 		addConfigPatchControlPlaneFlag(common, &ops.common.configPatchControlPlane, configPatchControlPlaneFlag)
 		addConfigPatchWorkerFlag(common, &ops.common.configPatchWorker, configPatchWorkerFlag)
+		addConfigPatchNodeFlag(common, &ops.common.configPatchNode)
 		addRegistryMirrorFlag(common, &ops.common.registryMirrors)
+		addRegistryCacheFlag(common, &ops.common.withRegistryCache)
+		addBootFlags(common, &ops.common)
 		addNetworkMTUFlag(common, &ops.common.networkMTU)
 		addTalosVersionFlag(common, &ops.common.talosVersion, "the desired Talos version to generate config for")
 
@@ -221,6 +251,7 @@ func getCreateCmd() *cobra.Command {
 		common.IntVar(&ops.common.controlPlanePort, controlPlanePortFlag, ops.common.controlPlanePort, "control plane port (load balancer and local API port)")
 		common.BoolVar(&ops.common.configDebug, configDebugFlag, ops.common.configDebug, "enable debug in Talos config to send service logs to the console")
 		common.BoolVar(&ops.common.networkIPv4, networkIPv4Flag, ops.common.networkIPv4, "enable IPv4 network in the cluster")
+		addNetworkIPv6Flags(common, &ops.common)
 		common.BoolVar(&ops.common.clusterWait, clusterWaitFlag, ops.common.clusterWait, "wait for the cluster to be ready before returning")
 		common.DurationVar(&ops.common.clusterWaitTimeout, clusterWaitTimeoutFlag, ops.common.clusterWaitTimeout, "timeout to wait for the cluster to be ready")
 		common.BoolVar(&ops.common.forceInitNodeAsEndpoint, forceInitNodeAsEndpointFlag, ops.common.forceInitNodeAsEndpoint, "use init node as endpoint instead of any load balancer endpoint")
@@ -237,6 +268,7 @@ func getCreateCmd() *cobra.Command {
 		common.BoolVar(&ops.common.skipK8sNodeReadinessCheck, skipK8sNodeReadinessCheckFlag, ops.common.skipK8sNodeReadinessCheck, "skip k8s node readiness checks")
 		common.BoolVar(&ops.common.withJSONLogs, withJSONLogsFlag, ops.common.withJSONLogs, "enable JSON logs receiver and configure Talos to send logs there")
 		common.BoolVar(&ops.common.withUUIDHostnames, withUUIDHostnamesFlag, ops.common.withUUIDHostnames, "use machine UUIDs as default hostnames")
+		addFromFlag(common, &from)
 
 		return common
 	}
@@ -247,6 +279,10 @@ func getCreateCmd() *cobra.Command {
 		qemu.BoolVar(&ops.qemu.preallocateDisks, preallocateDisksFlag, true, "whether disk space should be preallocated")
 		qemu.StringSliceVar(&ops.qemu.clusterUserVolumes, clusterUserVolumesFlag, ops.qemu.clusterUserVolumes, "list of user volumes to create for each VM in format: <name1>:<size1>:<name2>:<size2>")
 		qemu.StringVar(&ops.qemu.nodeInstallImage, nodeInstallImageFlag, helpers.DefaultImage(images.DefaultInstallerImageRepository), "the installer image to use")
+		qemu.StringVar(&ops.qemu.controlplaneImage, controlplaneImageFlag, ops.qemu.controlplaneImage,
+			"the installer image for the control plane nodes, the nodes boot the matching Talos version (defaults to --install-image)")
+		qemu.StringVar(&ops.qemu.workerImage, workerImageFlag, ops.qemu.workerImage,
+			"the installer image for the worker nodes, the nodes boot the matching Talos version (defaults to --install-image)")
 		qemu.StringVar(&ops.qemu.nodeVmlinuzPath, nodeVmlinuzPathFlag, helpers.ArtifactPath(constants.KernelAssetWithArch), "the compressed kernel image to use")
 		qemu.StringVar(&ops.qemu.nodeISOPath, nodeISOPathFlag, ops.qemu.nodeISOPath, "the ISO path to use for the initial boot")
 		qemu.StringVar(&ops.qemu.nodeUSBPath, nodeUSBPathFlag, ops.qemu.nodeUSBPath, "the USB stick image path to use for the initial boot")
@@ -258,11 +294,23 @@ func getCreateCmd() *cobra.Command {
 		qemu.BoolVar(&ops.qemu.uefiEnabled, uefiEnabledFlag, ops.qemu.uefiEnabled, "enable UEFI on x86_64 architecture")
 		qemu.BoolVar(&ops.qemu.tpm1_2Enabled, tpmEnabledFlag, ops.qemu.tpm1_2Enabled, "enable TPM 1.2 emulation support using swtpm")
 		qemu.BoolVar(&ops.qemu.tpm2Enabled, tpm2EnabledFlag, ops.qemu.tpm2Enabled, "enable TPM 2.0 emulation support using swtpm")
+		qemu.BoolVar(&ops.qemu.secureBootEnabled, secureBootEnabledFlag, ops.qemu.secureBootEnabled,
+			"enable UEFI SecureBoot, the firmware starts in the setup mode unless --secureboot-vars is specified (requires UEFI)")
+		qemu.StringVar(&ops.qemu.secureBootVarsPath, secureBootVarsFlag, ops.qemu.secureBootVarsPath,
+			"path to the UEFI variables store (OVMF_VARS) with the SecureBoot keys enrolled, used as a template for each VM (requires --with-secureboot)")
 		qemu.BoolVar(&ops.qemu.debugShellEnabled, withDebugShellFlag, ops.qemu.debugShellEnabled, "drop talos into a maintenance shell on boot, this is for advanced debugging for developers only")
 		qemu.BoolVar(&ops.qemu.withIOMMU, withIOMMUFlag, ops.qemu.withIOMMU, "enable IOMMU support, this also add a new PCI root port and an interface attached to it")
 		qemu.MarkHidden("with-debug-shell") //nolint:errcheck
+		addQemuCPUFlags(qemu, &ops.qemu)
 		qemu.StringSliceVar(&ops.qemu.extraUEFISearchPaths, extraUEFISearchPathsFlag, ops.qemu.extraUEFISearchPaths, "additional search paths for UEFI firmware (only applies when UEFI is enabled)")
 		qemu.StringSliceVar(&ops.qemu.networkNoMasqueradeCIDRs, networkNoMasqueradeCIDRsFlag, ops.qemu.networkNoMasqueradeCIDRs, "list of CIDRs to exclude from NAT")
+		qemu.StringArrayVar(&ops.qemu.additionalNetworks, additionalNetworkFlag, ops.qemu.additionalNetworks,
+			"create an additional network (bridge) without DHCP in format <name>[:<cidr>], can be specified multiple times")
+		qemu.StringArrayVar(&ops.qemu.additionalNICs, additionalNICFlag, ops.qemu.additionalNICs,
+			"attach an additional NIC to the additional network in format <network>[:<nodes>], where nodes is one of all, controlplanes, workers, controlplane-<N> or worker-<N> (defaults to all)")
+		addHostMountsFlag(qemu, &ops.qemu.hostMounts)
+		addConcurrencyFlag(qemu, &ops.qemu.concurrency)
+		addExtraDisksFlags(qemu, &ops.qemu)
 		qemu.StringSliceVar(&ops.qemu.nameservers, nameserversFlag, ops.qemu.nameservers, "list of nameservers to use")
 		qemu.IntVar(&legacyOps.clusterDiskSize, clusterDiskSizeFlag, 6*1024, "default limit on disk size in MB (each VM)")
 		qemu.UintVar(&ops.qemu.diskBlockSize, diskBlockSizeFlag, ops.qemu.diskBlockSize, "disk block size")
@@ -278,7 +326,6 @@ func getCreateCmd() *cobra.Command {
 		qemu.BoolVar(&ops.qemu.encryptEphemeralPartition, encryptEphemeralPartitionFlag, ops.qemu.encryptEphemeralPartition, "enable ephemeral partition encryption")
 		qemu.BoolVar(&ops.qemu.encryptUserVolumes, encryptUserVolumeFlag, ops.qemu.encryptUserVolumes, "enable ephemeral partition encryption")
 		qemu.StringArrayVar(&ops.qemu.diskEncryptionKeyTypes, diskEncryptionKeyTypesFlag, []string{"uuid"}, "encryption key types to use for disk encryption (uuid, kms)")
-		qemu.BoolVar(&ops.qemu.networkIPv6, networkIPv6Flag, ops.qemu.networkIPv6, "enable IPv6 network in the cluster")
 		qemu.BoolVar(&ops.qemu.useVIP, useVIPFlag, ops.qemu.useVIP, "use a virtual IP for the controlplane endpoint instead of the loadbalancer")
 		qemu.BoolVar(&ops.qemu.badRTC, badRTCFlag, ops.qemu.badRTC, "launch VM with bad RTC state")
 		qemu.StringVar(&ops.qemu.extraBootKernelArgs, extraBootKernelArgsFlag, ops.qemu.extraBootKernelArgs, "add extra kernel args to the initial boot from vmlinuz and initramfs")
@@ -316,6 +363,15 @@ func getCreateCmd() *cobra.Command {
 					return err
 				}
 
+				if from != "" {
+					// the spec already has the full list of disks
+					if err := importClusterSpec(from, providers.QemuProviderName, ops, nil); err != nil {
+						return err
+					}
+
+					return create(ctx, *ops)
+				}
+
 				ops.qemu.disks = append(ops.qemu.disks, fmt.Sprintf("virtio:%d", legacyOps.clusterDiskSize))
 
 				for i := range legacyOps.extraDisks {
@@ -19,11 +19,12 @@ import (
 )
 
 type dockerOps struct {
-	hostIP      string
-	disableIPv6 bool
-	mountOpts   opts.MountOpt
-	ports       string
-	talosImage  string
+	hostIP         string
+	disableIPv6    bool
+	mountOpts      opts.MountOpt
+	ports          string
+	workersPublish []string
+	talosImage     string
 }
 
 func init() {
@@ -37,6 +38,7 @@ func init() {
 
 	const (
 		portsFlag             = "exposed-ports"
+		workersPublishFlag    = "workers-publish"
 		dockerDisableIPv6Flag = "disable-ipv6"
 		dockerHostIPFlag      = "host-ip"
 		mountOptsFlag         = "mount"
@@ -48,6 +50,8 @@ func init() {
 
 		docker.StringVarP(&ops.docker.ports, portsFlag, "p", ops.docker.ports,
 			"comma-separated list of ports/protocols to expose on init node. Ex -p <hostPort>:<containerPort>/<protocol (tcp or udp)>")
+		docker.StringArrayVar(&ops.docker.workersPublish, workersPublishFlag, ops.docker.workersPublish,
+			"publish worker ports on the host, can be specified multiple times. Ex --workers-publish [<hostIP>:][<hostPort>:]<containerPort>[/<protocol>], ports can be ranges (30000-30010)")
 		docker.StringVar(&ops.docker.hostIP, dockerHostIPFlag, ops.docker.hostIP, "Host IP to forward exposed ports to")
 		docker.BoolVar(&ops.docker.disableIPv6, dockerDisableIPv6Flag, ops.docker.disableIPv6, "skip enabling IPv6 in containers")
 		cli.Should(docker.MarkHidden(dockerDisableIPv6Flag))
@@ -57,8 +61,11 @@ func init() {
 		return docker
 	}
 
+	var from string
+
 	commonFlags := getCommonUserFacingFlags(&ops.common)
 	commonFlags.StringVar(&ops.common.networkCIDR, subnetFlag, ops.common.networkCIDR, "Docker network subnet CIDR")
+	addFromFlag(commonFlags, &from)
 
 	createDockerCmd := &cobra.Command{
 		Use:   "docker",
@@ -66,6 +73,21 @@ func init() {
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return cli.WithContext(context.Background(), func(ctx context.Context) error {
+				if from != "" {
+					if err := importClusterSpec(from, providers.DockerProviderName, ops, nil); err != nil {
+						return err
+					}
+				}
+
+				spec, err := newClusterSpec(providers.DockerProviderName, *ops, nil)
+				if err != nil {
+					return err
+				}
+
+				if err = startRegistryCache(ctx, ops.common); err != nil {
+					return err
+				}
+
 				provisioner, err := providers.Factory(ctx, providers.DockerProviderName)
 				if err != nil {
 					return err
@@ -81,7 +103,11 @@ func init() {
 					return err
 				}
 
-				err = postCreate(ctx, ops.common, data.talosconfig, cluster, data.provisionOptions, data.clusterRequest)
+				if err = saveClusterSpec(cluster, spec); err != nil {
+					return err
+				}
+
+				err = postCreate(ctx, ops.common, data.talosconfig, provisioner, cluster, data.provisionOptions, data.clusterRequest)
 				if err != nil {
 					return err
 				}
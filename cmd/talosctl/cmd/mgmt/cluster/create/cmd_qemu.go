@@ -31,11 +31,11 @@ type createQemuOps struct {
 func getDefaultQemuOptions() qemuOps {
 	return qemuOps{
 		preallocateDisks:  false,
-		networkIPv6:       false,
 		bootloaderEnabled: true,
 		uefiEnabled:       true,
 		nameservers:       []string{"8.8.8.8", "1.1.1.1", "2001:4860:4860::8888", "2606:4700:4700::1111"},
 		diskBlockSize:     512,
+		concurrency:       4,
 		targetArch:        runtime.GOARCH,
 		cniBinPath:        []string{filepath.Join(clustercmd.DefaultCNIDir, "bin")},
 		cniConfDir:        filepath.Join(clustercmd.DefaultCNIDir, "conf.d"),
@@ -48,6 +48,9 @@ func getDefaultQemuOptions() qemuOps {
 
 func init() {
 	cqOps := createQemuOps{}
+
+	var from string
+
 	ops := &createOps{
 		common: getDefaultCommonOptions(),
 		qemu:   getDefaultQemuOptions(),
@@ -59,11 +62,16 @@ func init() {
 	addControlplanesFlag(commonFlags, &ops.common.controlplanes)
 	addTalosVersionFlag(commonFlags, &ops.common.talosVersion, "the desired talos version")
 	commonFlags.StringVar(&ops.common.networkCIDR, networkCIDRFlagName, "10.5.0.0/24", "CIDR of the cluster network")
+	addFromFlag(commonFlags, &from)
 
 	getQemuFlags := func() *pflag.FlagSet {
 		qemu := pflag.NewFlagSet("qemu", pflag.PanicOnError)
 
 		addDisksFlag(qemu, &ops.qemu.disks, []string{"virtio:10GB", "virtio:6GB"})
+		addQemuCPUFlags(qemu, &ops.qemu)
+		addHostMountsFlag(qemu, &ops.qemu.hostMounts)
+		addConcurrencyFlag(qemu, &ops.qemu.concurrency)
+		addExtraDisksFlags(qemu, &ops.qemu)
 		qemu.StringVar(&cqOps.schematicID, "schematic-id", "", "image factory schematic id (defaults to an empty schematic)")
 		qemu.StringVar(&cqOps.imageFactoryURL, "image-factory-url", "https://factory.talos.dev/", "image factory url")
 
@@ -76,6 +84,21 @@ func init() {
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return cli.WithContext(context.Background(), func(ctx context.Context) error {
+				if from != "" {
+					if err := importClusterSpec(from, providers.QemuProviderName, ops, &cqOps); err != nil {
+						return err
+					}
+				}
+
+				spec, err := newClusterSpec(providers.QemuProviderName, *ops, &cqOps)
+				if err != nil {
+					return err
+				}
+
+				if err = startRegistryCache(ctx, ops.common); err != nil {
+					return err
+				}
+
 				provisioner, err := providers.Factory(ctx, providers.QemuProviderName)
 				if err != nil {
 					return err
@@ -91,7 +114,11 @@ func init() {
 					return err
 				}
 
-				err = postCreate(ctx, ops.common, data.talosconfig, cluster, data.provisionOptions, data.clusterRequest)
+				if err = saveClusterSpec(cluster, spec); err != nil {
+					return err
+				}
+
+				err = postCreate(ctx, ops.common, data.talosconfig, provisioner, cluster, data.provisionOptions, data.clusterRequest)
 				if err != nil {
 					return err
 				}
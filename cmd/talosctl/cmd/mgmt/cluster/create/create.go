@@ -6,6 +6,8 @@ package create
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"math/big"
@@ -15,6 +17,7 @@ import (
 	"os"
 	"path/filepath"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -24,14 +27,21 @@ import (
 	"github.com/siderolabs/go-pointer"
 	sideronet "github.com/siderolabs/net"
 
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/mgmt/cluster/internal/registrycache"
 	"github.com/siderolabs/talos/pkg/bytesize"
 	"github.com/siderolabs/talos/pkg/cluster/check"
+	"github.com/siderolabs/talos/pkg/images"
 	clientconfig "github.com/siderolabs/talos/pkg/machinery/client/config"
 	"github.com/siderolabs/talos/pkg/machinery/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/bundle"
 	"github.com/siderolabs/talos/pkg/machinery/config/configpatcher"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
 	"github.com/siderolabs/talos/pkg/machinery/config/generate"
 	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/imager/quirks"
 	"github.com/siderolabs/talos/pkg/provision"
 	"github.com/siderolabs/talos/pkg/provision/access"
 )
@@ -71,6 +81,116 @@ func getCidr4(cOps commonOps) (netip.Prefix, error) {
 	return cidr4, nil
 }
 
+// getCidr6 returns the ULA IPv6 network derived from the IPv4 CIDR.
+//
+// ULA IPv6 network fd00::/8 is used, 'TAL' in hex is added to build /32 network, and IPv4 CIDR is added to build /64 unique network.
+func getCidr6(cidr4 netip.Prefix) (netip.Prefix, error) {
+	addr := cidr4.Addr().As4()
+
+	cidr6, err := netip.ParsePrefix(fmt.Sprintf("fd74:616c:%02x%02x:%02x%02x::/64", addr[0], addr[1], addr[2], addr[3]))
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("error validating cidr IPv6 block: %w", err)
+	}
+
+	return cidr6, nil
+}
+
+// getNetworkCIDRs returns the CIDRs of the cluster network, the first CIDR defines the primary address family of the cluster.
+//
+// In the IPv6-only mode IPv4 CIDR is still allocated as the secondary one, so that the nodes can reach
+// IPv4-only hosts (e.g. container registries) via the masquerading on the host, while Kubernetes uses IPv6 only.
+func getNetworkCIDRs(cOps commonOps) ([]netip.Prefix, error) {
+	cidr4, err := getCidr4(cOps)
+	if err != nil {
+		return nil, err
+	}
+
+	cidr6, err := getCidr6(cidr4)
+	if err != nil {
+		return nil, err
+	}
+
+	if cOps.networkIPv6Only {
+		return []netip.Prefix{cidr6, cidr4}, nil
+	}
+
+	var cidrs []netip.Prefix
+
+	if cOps.networkIPv4 {
+		cidrs = append(cidrs, cidr4)
+	}
+
+	if cOps.networkIPv6 {
+		cidrs = append(cidrs, cidr6)
+	}
+
+	if len(cidrs) == 0 {
+		return nil, errors.New("neither IPv4 nor IPv6 network was enabled")
+	}
+
+	return cidrs, nil
+}
+
+// getNetworkFamilyConfigOptions returns the config generation options and patches for the address families of the cluster network.
+//
+// Pod and service subnets are picked for each address family used by Kubernetes, and if the nodes have
+// multiple address families, kubelet and etcd are configured to use the addresses of the right family.
+func getNetworkFamilyConfigOptions(cOps commonOps, cidrs []netip.Prefix) ([]generate.Option, []bundle.Option) {
+	kubernetesCIDRs := cidrs
+
+	if cOps.networkIPv6Only {
+		kubernetesCIDRs = cidrs[:1]
+	}
+
+	var podSubnets, serviceSubnets []string
+
+	for _, cidr := range kubernetesCIDRs {
+		if cidr.Addr().Is6() {
+			podSubnets = append(podSubnets, constants.DefaultIPv6PodNet)
+			serviceSubnets = append(serviceSubnets, constants.DefaultIPv6ServiceNet)
+		} else {
+			podSubnets = append(podSubnets, constants.DefaultIPv4PodNet)
+			serviceSubnets = append(serviceSubnets, constants.DefaultIPv4ServiceNet)
+		}
+	}
+
+	genOptions := []generate.Option{
+		generate.WithPodSubnets(podSubnets...),
+		generate.WithServiceSubnets(serviceSubnets...),
+	}
+
+	if len(cidrs) == 1 {
+		return genOptions, nil
+	}
+
+	nodeIPPatch := container.NewV1Alpha1(
+		&v1alpha1.Config{
+			ConfigVersion: "v1alpha1",
+			MachineConfig: &v1alpha1.MachineConfig{
+				MachineKubelet: &v1alpha1.KubeletConfig{
+					KubeletNodeIP: &v1alpha1.KubeletNodeIPConfig{
+						KubeletNodeIPValidSubnets: xslices.Map(kubernetesCIDRs, netip.Prefix.String),
+					},
+				},
+			},
+		})
+
+	etcdPatch := container.NewV1Alpha1(
+		&v1alpha1.Config{
+			ConfigVersion: "v1alpha1",
+			ClusterConfig: &v1alpha1.ClusterConfig{
+				EtcdConfig: &v1alpha1.EtcdConfig{
+					EtcdAdvertisedSubnets: []string{kubernetesCIDRs[0].String()},
+				},
+			},
+		})
+
+	return genOptions, []bundle.Option{
+		bundle.WithPatch([]configpatcher.Patch{configpatcher.NewStrategicMergePatch(nodeIPPatch)}),
+		bundle.WithPatchControlPlane([]configpatcher.Patch{configpatcher.NewStrategicMergePatch(etcdPatch)}),
+	}
+}
+
 func createNodeRequests(cOps commonOps, controlplaneRes, workerRes parsedNodeResources, nodeIPs [][]netip.Addr) (
 	controlplanes, workers []provision.NodeRequest, err error,
 ) {
@@ -231,15 +351,20 @@ func downloadBootAssets(ctx context.Context, qOps *qemuOps) error {
 }
 
 func parseDisksFlag(disks []string) ([]diskRequest, error) {
-	result := []diskRequest{}
-
 	if len(disks) == 0 {
 		return nil, errors.New("at least one disk has to be specified")
 	}
 
+	return parseDiskSpecs(disks)
+}
+
+// parseDiskSpecs parses the disk specs in the `<driver>:<size>[:<count>]` format.
+func parseDiskSpecs(disks []string) ([]diskRequest, error) {
+	result := []diskRequest{}
+
 	for _, d := range disks {
-		parts := strings.SplitN(d, ":", 2)
-		if len(parts) != 2 {
+		parts := strings.SplitN(d, ":", 3)
+		if len(parts) < 2 {
 			return nil, fmt.Errorf("invalid disk format: %q", d)
 		}
 
@@ -250,10 +375,21 @@ func parseDisksFlag(disks []string) ([]diskRequest, error) {
 			return nil, fmt.Errorf("invalid size in disk spec: %q", d)
 		}
 
-		result = append(result, diskRequest{
-			Driver:    parts[0],
-			SizeBytes: size.Bytes(),
-		})
+		count := 1
+
+		if len(parts) == 3 {
+			count, err = strconv.Atoi(parts[2])
+			if err != nil || count < 1 {
+				return nil, fmt.Errorf("invalid count in disk spec: %q", d)
+			}
+		}
+
+		for range count {
+			result = append(result, diskRequest{
+				Driver:    parts[0],
+				SizeBytes: size.Bytes(),
+			})
+		}
 	}
 
 	return result, nil
@@ -273,19 +409,60 @@ func parseCPUShare(cpus string) (int64, error) {
 	return nano.Num().Int64(), nil
 }
 
-func getRegistryMirrorGenOps(cOps commonOps) ([]generate.Option, error) {
-	ops := make([]generate.Option, 0, len(cOps.registryMirrors))
+func getRegistryMirrorGenOps(cOps commonOps, gatewayIPs []netip.Addr) ([]generate.Option, error) {
+	registryMirrors, err := getRegistryMirrors(cOps, gatewayIPs)
+	if err != nil {
+		return nil, err
+	}
+
+	ops := make([]generate.Option, 0, len(registryMirrors))
+
+	for _, registryMirror := range registryMirrors {
+		left, right, _ := strings.Cut(registryMirror, "=")
+
+		ops = append(ops, generate.WithRegistryMirror(left, right))
+	}
+
+	return ops, nil
+}
+
+// getRegistryMirrors returns the registry mirrors including the registry cache ones, explicit mirrors take precedence.
+func getRegistryMirrors(cOps commonOps, gatewayIPs []netip.Addr) ([]string, error) {
+	registryMirrors := make([]string, 0, len(cOps.registryMirrors))
+	mirrored := map[string]struct{}{}
 
 	for _, registryMirror := range cOps.registryMirrors {
-		left, right, ok := strings.Cut(registryMirror, "=")
+		left, _, ok := strings.Cut(registryMirror, "=")
 		if !ok {
 			return nil, fmt.Errorf("invalid registry mirror spec: %q", registryMirror)
 		}
 
-		ops = append(ops, generate.WithRegistryMirror(left, right))
+		registryMirrors = append(registryMirrors, registryMirror)
+		mirrored[left] = struct{}{}
 	}
 
-	return ops, nil
+	if !cOps.withRegistryCache {
+		return registryMirrors, nil
+	}
+
+	// the nodes reach the registry cache on the host via the bridge address
+	for _, registryMirror := range registrycache.Mirrors(gatewayIPs[0]) {
+		left, _, _ := strings.Cut(registryMirror, "=")
+
+		if _, ok := mirrored[left]; !ok {
+			registryMirrors = append(registryMirrors, registryMirror)
+		}
+	}
+
+	return registryMirrors, nil
+}
+
+func startRegistryCache(ctx context.Context, cOps commonOps) error {
+	if !cOps.withRegistryCache {
+		return nil
+	}
+
+	return registrycache.Start(ctx, os.Stdout)
 }
 
 func getBaseClusterRequest(cOps commonOps, cidrs []netip.Prefix, gatewayIPs []netip.Addr) provision.ClusterRequest {
@@ -317,6 +494,122 @@ func getVersionContractGenOps(cOps commonOps) ([]generate.Option, *config.Versio
 	return []generate.Option{generate.WithVersionContract(versionContract)}, versionContract, nil
 }
 
+// nodeImage describes the installer image of a class of nodes and the matching boot assets.
+type nodeImage struct {
+	installImage  string
+	talosVersion  string
+	kernelPath    string
+	initramfsPath string
+}
+
+// getNodeImage resolves the boot assets matching the installer image, so that the nodes boot the Talos version they install.
+//
+// Boot assets are downloaded from the Image Factory for the Image Factory installer images, and from the Talos release otherwise.
+func getNodeImage(ctx context.Context, installImage, targetArch string) (nodeImage, error) {
+	idx := strings.LastIndex(installImage, ":")
+	if idx == -1 || strings.Contains(installImage[idx:], "/") {
+		return nodeImage{}, fmt.Errorf("installer image %q should be tagged with the Talos version", installImage)
+	}
+
+	repository, talosVersion := installImage[:idx], installImage[idx+1:]
+
+	if _, err := config.ParseContractFromVersion(talosVersion); err != nil {
+		return nodeImage{}, fmt.Errorf("error parsing Talos version of the installer image %q: %w", installImage, err)
+	}
+
+	var assets qemuOps
+
+	host, path, _ := strings.Cut(repository, "/")
+
+	if installer, schematicID, ok := strings.Cut(path, "/"); ok && strings.HasSuffix(installer, "installer") && !strings.Contains(schematicID, "/") {
+		// Image Factory installer image: <factory host>/<platform>-installer/<schematic ID>:<version>
+		assets.nodeVmlinuzPath = fmt.Sprintf("https://%s/image/%s/%s/kernel-%s", host, schematicID, talosVersion, targetArch)
+		assets.nodeInitramfsPath = fmt.Sprintf("https://%s/image/%s/%s/initramfs-%s.xz", host, schematicID, talosVersion, targetArch)
+	} else {
+		assets.nodeVmlinuzPath = fmt.Sprintf("https://github.com/%s/talos/releases/download/%s/vmlinuz-%s", images.Username, talosVersion, targetArch)
+		assets.nodeInitramfsPath = fmt.Sprintf("https://github.com/%s/talos/releases/download/%s/initramfs-%s.xz", images.Username, talosVersion, targetArch)
+	}
+
+	// boot assets are cached, so that each version is only downloaded once
+	if err := downloadBootAssets(ctx, &assets); err != nil {
+		return nodeImage{}, err
+	}
+
+	return nodeImage{
+		installImage:  installImage,
+		talosVersion:  talosVersion,
+		kernelPath:    assets.nodeVmlinuzPath,
+		initramfsPath: assets.nodeInitramfsPath,
+	}, nil
+}
+
+// getNodeImageOverrides resolves the installer image overrides for the control plane and worker nodes.
+//
+// The machine config of the nodes is pinned to the installer image, so that the nodes install (and upgrade from) the Talos version they boot.
+func getNodeImageOverrides(ctx context.Context, qOps qemuOps, versionContract *config.VersionContract) (
+	controlplaneImage, workerImage *nodeImage, bundleOpts []bundle.Option, err error,
+) {
+	for _, override := range []struct {
+		image     string
+		dest      **nodeImage
+		patchFunc func([]configpatcher.Patch) bundle.Option
+	}{
+		{image: qOps.controlplaneImage, dest: &controlplaneImage, patchFunc: bundle.WithPatchControlPlane},
+		{image: qOps.workerImage, dest: &workerImage, patchFunc: bundle.WithPatchWorker},
+	} {
+		if override.image == "" {
+			continue
+		}
+
+		var (
+			image         nodeImage
+			imageContract *config.VersionContract
+		)
+
+		if image, err = getNodeImage(ctx, override.image, qOps.targetArch); err != nil {
+			return nil, nil, nil, err
+		}
+
+		if imageContract, err = config.ParseContractFromVersion(image.talosVersion); err != nil {
+			return nil, nil, nil, err
+		}
+
+		if versionContract != nil && versionContract.Greater(imageContract) {
+			return nil, nil, nil, fmt.Errorf(
+				"machine config is generated for Talos %s which is newer than the installer image %q, set --talos-version to the oldest Talos version in the cluster",
+				versionContract, override.image,
+			)
+		}
+
+		cfg := container.NewV1Alpha1(
+			&v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineInstall: &v1alpha1.InstallConfig{
+						InstallImage: image.installImage,
+					},
+				},
+			})
+
+		bundleOpts = append(bundleOpts, override.patchFunc([]configpatcher.Patch{configpatcher.NewStrategicMergePatch(cfg)}))
+		*override.dest = &image
+	}
+
+	return controlplaneImage, workerImage, bundleOpts, nil
+}
+
+// applyNodeImage boots the node with the boot assets matching the installer image override.
+func applyNodeImage(node *provision.NodeRequest, image *nodeImage) {
+	if image == nil {
+		return
+	}
+
+	node.Quirks = quirks.New(image.talosVersion)
+	node.TalosVersion = image.talosVersion
+	node.KernelPath = image.kernelPath
+	node.InitramfsPath = image.initramfsPath
+}
+
 func getWithAdditionalSubjectAltNamesGenOps(endpointList []string) []generate.Option {
 	return xslices.Map(endpointList, func(endpointHostPort string) generate.Option {
 		endpointHost, _, err := net.SplitHostPort(endpointHostPort)
@@ -376,6 +669,286 @@ func getDisks(qOps qemuOps) (primaryDisks, workerExtraDisks []*provision.Disk, e
 	return primaryDisks, workerExtraDisks, nil
 }
 
+// getNodeClassExtraDisks returns the extra disks of the controlplane and worker nodes.
+//
+// By default, only the workers get the extra disks from the disks flag, the extra disks flags override that per node class.
+func getNodeClassExtraDisks(qOps qemuOps, workerExtraDisks []*provision.Disk) (controlplaneExtraDisks, workerExtra []*provision.Disk, err error) {
+	toDisks := func(specs []string) ([]*provision.Disk, error) {
+		diskRequests, err := parseDiskSpecs(specs)
+		if err != nil {
+			return nil, err
+		}
+
+		return xslices.Map(diskRequests, func(d diskRequest) *provision.Disk {
+			return &provision.Disk{
+				Size:            d.SizeBytes,
+				SkipPreallocate: !qOps.preallocateDisks,
+				Driver:          d.Driver,
+				BlockSize:       qOps.diskBlockSize,
+			}
+		}), nil
+	}
+
+	workerExtra = workerExtraDisks
+
+	if len(qOps.extraDisksControlPlanes) > 0 {
+		if controlplaneExtraDisks, err = toDisks(qOps.extraDisksControlPlanes); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if len(qOps.extraDisksWorkers) > 0 {
+		if workerExtra, err = toDisks(qOps.extraDisksWorkers); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return controlplaneExtraDisks, workerExtra, nil
+}
+
+// nodeDisks returns copies of the disks for the node with deterministic serial numbers and WWNs,
+// so that the disks can be matched by the disk selectors in the machine config.
+//
+// The serial number is derived from the node selector (e.g. `w3` for the third worker) and the disk index (`w3-disk1`),
+// the WWN is derived from the cluster name and the serial number.
+func nodeDisks(clusterName, nodeSelector string, disks []*provision.Disk) []*provision.Disk {
+	result := make([]*provision.Disk, 0, len(disks))
+
+	for i, disk := range disks {
+		disk := *disk
+
+		if disk.Serial == "" {
+			disk.Serial = fmt.Sprintf("%s-disk%d", nodeSelector, i)
+		}
+
+		if disk.WWN == "" {
+			hash := sha256.Sum256([]byte(clusterName + "/" + disk.Serial))
+
+			// NAA 5 (IEEE Registered) format
+			disk.WWN = fmt.Sprintf("0x%016x", binary.BigEndian.Uint64(hash[:8])&0x0fffffffffffffff|0x5<<60)
+		}
+
+		result = append(result, &disk)
+	}
+
+	return result
+}
+
+// applyQemuCPUOptions applies the CPU model, topology, machine type and extra args options to the node requests.
+func applyQemuCPUOptions(qOps qemuOps, controlplanes, workers []provision.NodeRequest) error {
+	for _, nodeClass := range []struct {
+		nodes         []provision.NodeRequest
+		cpuTopology   string
+		extraQemuArgs []string
+	}{
+		{
+			nodes:         controlplanes,
+			cpuTopology:   qOps.cpuTopologyControlPlanes,
+			extraQemuArgs: qOps.extraQemuArgsControlPlanes,
+		},
+		{
+			nodes:         workers,
+			cpuTopology:   qOps.cpuTopologyWorkers,
+			extraQemuArgs: qOps.extraQemuArgsWorkers,
+		},
+	} {
+		var cpuTopology *provision.CPUTopology
+
+		if nodeClass.cpuTopology != "" {
+			topology, err := provision.ParseCPUTopology(nodeClass.cpuTopology)
+			if err != nil {
+				return err
+			}
+
+			cpuTopology = &topology
+		}
+
+		extraQemuArgs, err := parseExtraQemuArgs(nodeClass.extraQemuArgs)
+		if err != nil {
+			return err
+		}
+
+		for i := range nodeClass.nodes {
+			nodeClass.nodes[i].CPUModel = qOps.cpuModel
+			nodeClass.nodes[i].CPUTopology = cpuTopology
+			nodeClass.nodes[i].MachineType = qOps.machineType
+			nodeClass.nodes[i].NestedVirtualization = qOps.nestedVirtualization
+			nodeClass.nodes[i].ExtraQemuArgs = extraQemuArgs
+		}
+	}
+
+	return nil
+}
+
+// parseExtraQemuArgs parses extra QEMU arguments in format "-option value" into the list of arguments.
+func parseExtraQemuArgs(args []string) ([]string, error) {
+	var result []string
+
+	for _, arg := range args {
+		option, value, _ := strings.Cut(strings.TrimSpace(arg), " ")
+
+		if !strings.HasPrefix(option, "-") {
+			return nil, fmt.Errorf("invalid extra QEMU argument %q: should start with an option, e.g. \"-device virtio-rng-pci\"", arg)
+		}
+
+		result = append(result, option)
+
+		if value = strings.TrimSpace(value); value != "" {
+			result = append(result, value)
+		}
+	}
+
+	return result, nil
+}
+
+// applyQemuAdditionalNetworks parses the additional networks and attaches the additional NICs to the node requests.
+//
+// Additional NICs get predictable MAC addresses in the form of 52:54:00:<network>:<node>:<nic>,
+// where node is the index of the node across control plane nodes and workers.
+//
+//nolint:gocyclo
+func applyQemuAdditionalNetworks(qOps qemuOps, controlplanes, workers []provision.NodeRequest) ([]provision.AdditionalNetworkRequest, error) {
+	additionalNetworks := make([]provision.AdditionalNetworkRequest, 0, len(qOps.additionalNetworks))
+
+	for _, spec := range qOps.additionalNetworks {
+		name, cidr, _ := strings.Cut(spec, ":")
+
+		if name == "" {
+			return nil, fmt.Errorf("invalid additional network %q: name is required", spec)
+		}
+
+		if slices.ContainsFunc(additionalNetworks, func(other provision.AdditionalNetworkRequest) bool { return other.Name == name }) {
+			return nil, fmt.Errorf("duplicate additional network %q", name)
+		}
+
+		network := provision.AdditionalNetworkRequest{
+			Name: name,
+		}
+
+		if cidr != "" {
+			prefix, err := netip.ParsePrefix(cidr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid additional network %q CIDR: %w", spec, err)
+			}
+
+			network.CIDR = prefix.Masked()
+		}
+
+		additionalNetworks = append(additionalNetworks, network)
+	}
+
+	type nicTarget struct {
+		node      *provision.NodeRequest
+		nodeClass string
+		nodeName  string
+	}
+
+	var targets []nicTarget
+
+	for i := range controlplanes {
+		targets = append(targets, nicTarget{node: &controlplanes[i], nodeClass: "controlplanes", nodeName: fmt.Sprintf("controlplane-%d", i+1)})
+	}
+
+	for i := range workers {
+		targets = append(targets, nicTarget{node: &workers[i], nodeClass: "workers", nodeName: fmt.Sprintf("worker-%d", i+1)})
+	}
+
+	if len(additionalNetworks) > 0xff || len(targets) > 0xff {
+		return nil, errors.New("too many additional networks or nodes to generate MAC addresses")
+	}
+
+	for _, spec := range qOps.additionalNICs {
+		networkName, target, _ := strings.Cut(spec, ":")
+
+		networkIdx := slices.IndexFunc(additionalNetworks, func(network provision.AdditionalNetworkRequest) bool { return network.Name == networkName })
+		if networkIdx == -1 {
+			return nil, fmt.Errorf("invalid additional NIC %q: unknown network %q", spec, networkName)
+		}
+
+		matched := false
+
+		for i, nicTarget := range targets {
+			switch target {
+			case "", "all", nicTarget.nodeClass, nicTarget.nodeName:
+			default:
+				continue
+			}
+
+			matched = true
+			node := nicTarget.node
+
+			if len(node.AdditionalNICs) >= 0xff {
+				return nil, fmt.Errorf("too many additional NICs for the node %q", node.Name)
+			}
+
+			node.AdditionalNICs = append(node.AdditionalNICs, provision.NIC{
+				Network: networkName,
+				MAC:     net.HardwareAddr{0x52, 0x54, 0x00, byte(networkIdx + 1), byte(i + 1), byte(len(node.AdditionalNICs) + 1)}.String(),
+			})
+		}
+
+		if !matched {
+			return nil, fmt.Errorf("invalid additional NIC %q: no nodes match %q", spec, target)
+		}
+	}
+
+	return additionalNetworks, nil
+}
+
+// getQemuHostMounts parses the host mounts shared with the nodes.
+//
+// The machine config patch mounts the shared directories into the kubelet, so that they are visible to the workloads.
+func getQemuHostMounts(qOps qemuOps) ([]provision.HostMount, []bundle.Option, error) {
+	if len(qOps.hostMounts) == 0 {
+		return nil, nil, nil
+	}
+
+	hostMounts := make([]provision.HostMount, 0, len(qOps.hostMounts))
+	extraMounts := make([]v1alpha1.ExtraMount, 0, len(qOps.hostMounts))
+
+	for i, spec := range qOps.hostMounts {
+		mount, err := provision.ParseHostMount(spec)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if slices.ContainsFunc(hostMounts, func(other provision.HostMount) bool { return other.GuestPath == mount.GuestPath }) {
+			return nil, nil, fmt.Errorf("duplicate host mount guest path %q", mount.GuestPath)
+		}
+
+		mount.Tag = fmt.Sprintf("hostmount%d", i)
+
+		options := []string{"rw"}
+		if mount.ReadOnly {
+			options = []string{"ro"}
+		}
+
+		if mount.Type == provision.HostMountType9P {
+			options = append(options, "trans=virtio", "version=9p2000.L")
+		}
+
+		hostMounts = append(hostMounts, mount)
+		extraMounts = append(extraMounts, v1alpha1.ExtraMount{
+			Destination: mount.GuestPath,
+			Type:        mount.Type,
+			Source:      mount.Tag,
+			Options:     options,
+		})
+	}
+
+	cfg := container.NewV1Alpha1(
+		&v1alpha1.Config{
+			ConfigVersion: "v1alpha1",
+			MachineConfig: &v1alpha1.MachineConfig{
+				MachineKubelet: &v1alpha1.KubeletConfig{
+					KubeletExtraMounts: extraMounts,
+				},
+			},
+		})
+
+	return hostMounts, []bundle.Option{bundle.WithPatch([]configpatcher.Patch{configpatcher.NewStrategicMergePatch(cfg)})}, nil
+}
+
 func getConfigPatchBundleOps(cOps commonOps) ([]bundle.Option, error) {
 	configBundleOpts := []bundle.Option{}
 
@@ -406,10 +979,113 @@ func getConfigPatchBundleOps(cOps commonOps) ([]bundle.Option, error) {
 	return configBundleOpts, nil
 }
 
+// nodeConfigPatch is a config patch applied to a single node on top of the config patches for the node type.
+type nodeConfigPatch struct {
+	// selector is the node name, or the node type with the index of the node of that type, e.g. `worker-3`.
+	selector string
+	// name is the patch file name, or the position of the inline patch.
+	name  string
+	patch []configpatcher.Patch
+}
+
+// parseNodeConfigPatches parses the node config patches in the `<selector>=<patch>` format, patch can be read from file with @file.
+func parseNodeConfigPatches(specs []string) ([]nodeConfigPatch, error) {
+	patches := make([]nodeConfigPatch, 0, len(specs))
+
+	for i, spec := range specs {
+		selector, patchString, ok := strings.Cut(spec, "=")
+		if !ok || selector == "" || patchString == "" {
+			return nil, fmt.Errorf("invalid node config patch %q: expected <node>=<patch>", spec)
+		}
+
+		name := fmt.Sprintf("inline#%d", i+1)
+
+		if filename, isFile := strings.CutPrefix(patchString, "@"); isFile {
+			name = filename
+		}
+
+		patch, err := configpatcher.LoadPatches([]string{patchString})
+		if err != nil {
+			return nil, fmt.Errorf("error loading config patch %s for node %q: %w", name, selector, err)
+		}
+
+		patches = append(patches, nodeConfigPatch{
+			selector: selector,
+			name:     name,
+			patch:    patch,
+		})
+	}
+
+	return patches, nil
+}
+
+// applyNodeConfigPatches applies the node config patches to the configs of the matching nodes, and validates the patched configs.
+//
+// Patches are applied in the order they are specified, each patch should match at least one node.
+func applyNodeConfigPatches(nodes []provision.NodeRequest, patches []nodeConfigPatch, mode validation.RuntimeMode) error {
+	if len(patches) == 0 {
+		return nil
+	}
+
+	// nodes are selected by type with the index of the node of that type (starting with 1), as the names might be random
+	selectors := make([]string, len(nodes))
+	counts := map[string]int{}
+
+	for i, node := range nodes {
+		typ := node.Type.String()
+		if node.Type == machine.TypeInit {
+			typ = machine.TypeControlPlane.String()
+		}
+
+		counts[typ]++
+		selectors[i] = fmt.Sprintf("%s-%d", typ, counts[typ])
+	}
+
+	for _, patch := range patches {
+		matched := false
+
+		for i := range nodes {
+			if patch.selector != selectors[i] && patch.selector != nodes[i].Name {
+				continue
+			}
+
+			matched = true
+
+			if nodes[i].Config == nil {
+				return fmt.Errorf("error applying config patch %s to node %q: node has no config", patch.name, nodes[i].Name)
+			}
+
+			out, err := configpatcher.Apply(configpatcher.WithConfig(nodes[i].Config), patch.patch)
+			if err != nil {
+				return fmt.Errorf("error applying config patch %s to node %q: %w", patch.name, nodes[i].Name, err)
+			}
+
+			cfg, err := out.Config()
+			if err != nil {
+				return fmt.Errorf("error applying config patch %s to node %q: %w", patch.name, nodes[i].Name, err)
+			}
+
+			if _, err = cfg.Validate(mode, validation.WithLocal()); err != nil {
+				return fmt.Errorf("config of node %q is invalid after applying config patch %s: %w", nodes[i].Name, patch.name, err)
+			}
+
+			nodes[i].Config = cfg
+			nodes[i].ConfigPatches = append(nodes[i].ConfigPatches, patch.name)
+		}
+
+		if !matched {
+			return fmt.Errorf("config patch %s doesn't match any node: %q", patch.name, patch.selector)
+		}
+	}
+
+	return nil
+}
+
 func postCreate(
 	ctx context.Context,
 	cOps commonOps,
 	bundleTalosconfig *clientconfig.Config,
+	provisioner provision.Provisioner,
 	cluster provision.Cluster,
 	provisionOptions []provision.Option,
 	request provision.ClusterRequest,
@@ -418,17 +1094,53 @@ func postCreate(
 		return err
 	}
 
+	if cOps.noBoot {
+		fmt.Println("the nodes were created without booting them, use 'talosctl cluster start' to boot the nodes")
+
+		return nil
+	}
+
+	bootedNodes := xslices.Filter(request.Nodes, func(node provision.NodeRequest) bool { return !node.Stopped })
+	stagedNodes := xslices.Filter(request.Nodes, func(node provision.NodeRequest) bool { return node.Stopped })
+
 	clusterAccess := access.NewAdapter(cluster, provisionOptions...)
 	defer clusterAccess.Close() //nolint:errcheck
 
 	if cOps.applyConfigEnabled {
-		err := clusterAccess.ApplyConfig(ctx, request.Nodes, request.SiderolinkRequest, os.Stdout)
+		err := clusterAccess.ApplyConfig(ctx, bootedNodes, request.SiderolinkRequest, os.Stdout)
 		if err != nil {
 			return err
 		}
 	}
 
-	return bootstrapCluster(ctx, clusterAccess, cOps)
+	if err := bootstrapCluster(ctx, clusterAccess, cOps); err != nil {
+		return err
+	}
+
+	if len(stagedNodes) > 0 {
+		// staged boot order: the rest of the nodes are booted once the control plane is healthy
+		if err := waitControlPlane(ctx, clusterAccess, cOps); err != nil {
+			return err
+		}
+
+		if err := provisioner.StartNodes(ctx, cluster, xslices.Map(stagedNodes, func(node provision.NodeRequest) string { return node.Name }),
+			provision.WithLogWriter(os.Stdout),
+		); err != nil {
+			return err
+		}
+
+		// the cluster info has the started nodes now
+		clusterAccess = access.NewAdapter(cluster, provisionOptions...)
+		defer clusterAccess.Close() //nolint:errcheck
+
+		if cOps.applyConfigEnabled {
+			if err := clusterAccess.ApplyConfig(ctx, stagedNodes, request.SiderolinkRequest, os.Stdout); err != nil {
+				return err
+			}
+		}
+	}
+
+	return waitCluster(ctx, clusterAccess, cOps)
 }
 
 func bootstrapCluster(ctx context.Context, clusterAccess *access.Adapter, cOps commonOps) error {
@@ -442,6 +1154,26 @@ func bootstrapCluster(ctx context.Context, clusterAccess *access.Adapter, cOps c
 		}
 	}
 
+	return nil
+}
+
+// waitControlPlane waits for the control plane to be healthy before the rest of the nodes are booted.
+//
+// The Kubernetes nodes readiness is not checked, as the CNI and other workloads might not be scheduled on the control plane nodes.
+func waitControlPlane(ctx context.Context, clusterAccess *access.Adapter, cOps commonOps) error {
+	checkCtx, checkCtxCancel := context.WithTimeout(ctx, cOps.clusterWaitTimeout)
+	defer checkCtxCancel()
+
+	checks := slices.Concat(check.PreBootSequenceChecks(), check.K8sComponentsReadinessChecks())
+
+	return check.Wait(checkCtx, clusterAccess, checks, check.StderrReporter())
+}
+
+func waitCluster(ctx context.Context, clusterAccess *access.Adapter, cOps commonOps) error {
+	if cOps.skipInjectingConfig && !cOps.applyConfigEnabled {
+		return nil
+	}
+
 	if !cOps.clusterWait {
 		return nil
 	}
@@ -469,6 +1201,25 @@ func bootstrapCluster(ctx context.Context, clusterAccess *access.Adapter, cOps c
 	return mergeKubeconfig(ctx, clusterAccess)
 }
 
+// applyBootOrder marks the nodes which are not booted when the cluster is created as stopped.
+func applyBootOrder(cOps commonOps, nodes []provision.NodeRequest) error {
+	switch cOps.bootOrder {
+	case bootOrderParallel:
+	case bootOrderStaged:
+		if cOps.skipInjectingConfig && !cOps.applyConfigEnabled {
+			return fmt.Errorf("%s boot order requires the nodes to receive the machine config on boot", bootOrderStaged)
+		}
+	default:
+		return fmt.Errorf("unsupported boot order %q, expected %q or %q", cOps.bootOrder, bootOrderParallel, bootOrderStaged)
+	}
+
+	for i := range nodes {
+		nodes[i].Stopped = cOps.noBoot || (cOps.bootOrder == bootOrderStaged && !nodes[i].Type.IsControlPlane())
+	}
+
+	return nil
+}
+
 type createClusterRequestOps struct {
 	commonOps              commonOps
 	provisioner            provision.Provisioner
@@ -476,6 +1227,9 @@ type createClusterRequestOps struct {
 	withExtraProvisionOpts func(provision.ClusterRequest) []provision.Option
 	modifyClusterRequest   func(provision.ClusterRequest) (provision.ClusterRequest, error)
 	modifyNodes            func(cr provision.ClusterRequest, cp, w []provision.NodeRequest) (controlplanes, workers []provision.NodeRequest, err error)
+	extraBundleOpts        []bundle.Option
+	// validationMode is the runtime mode the node configs are validated for after applying the node config patches.
+	validationMode validation.RuntimeMode
 }
 
 //nolint:gocyclo
@@ -493,24 +1247,29 @@ func createClusterRequest(ops createClusterRequestOps) (clusterCreateRequestData
 		return clusterCreateRequestData{}, fmt.Errorf("error parsing worker resources: %s", err)
 	}
 
-	cidr, err := getCidr4(cOps)
+	cidrs, err := getNetworkCIDRs(cOps)
 	if err != nil {
 		return clusterCreateRequestData{}, err
 	}
 
-	nodeIPs, err := getIps(cidr, cOps)
-	if err != nil {
-		return clusterCreateRequestData{}, err
-	}
+	gatewayIPs := make([]netip.Addr, len(cidrs))
+	nodeIPs := make([][]netip.Addr, len(cidrs))
 
-	gatewayIP, err := sideronet.NthIPInNetwork(cidr, gatewayOffset)
-	if err != nil {
-		return clusterCreateRequestData{}, err
+	for i, cidr := range cidrs {
+		gatewayIPs[i], err = sideronet.NthIPInNetwork(cidr, gatewayOffset)
+		if err != nil {
+			return clusterCreateRequestData{}, err
+		}
+
+		nodeIPs[i], err = getIps(cidr, cOps)
+		if err != nil {
+			return clusterCreateRequestData{}, err
+		}
 	}
 
-	clusterRequest := getBaseClusterRequest(cOps, []netip.Prefix{cidr}, []netip.Addr{gatewayIP})
+	clusterRequest := getBaseClusterRequest(cOps, cidrs, gatewayIPs)
 
-	controlplanes, workers, err := createNodeRequests(cOps, controlplaneResources, workerResources, [][]netip.Addr{nodeIPs})
+	controlplanes, workers, err := createNodeRequests(cOps, controlplaneResources, workerResources, nodeIPs)
 	if err != nil {
 		return clusterCreateRequestData{}, err
 	}
@@ -525,7 +1284,7 @@ func createClusterRequest(ops createClusterRequestOps) (clusterCreateRequestData
 
 	genOptions := []generate.Option{}
 
-	registryMirrorOps, err := getRegistryMirrorGenOps(cOps)
+	registryMirrorOps, err := getRegistryMirrorGenOps(cOps, gatewayIPs)
 	if err != nil {
 		return clusterCreateRequestData{}, err
 	}
@@ -537,7 +1296,10 @@ func createClusterRequest(ops createClusterRequestOps) (clusterCreateRequestData
 		return clusterCreateRequestData{}, err
 	}
 
+	networkFamilyGenOps, networkFamilyBundleOps := getNetworkFamilyConfigOptions(cOps, cidrs)
+
 	genOptions = append(genOptions, versionContractGenOps...)
+	genOptions = append(genOptions, networkFamilyGenOps...)
 	genOptions = append(genOptions, provisioner.GenOptions(clusterRequest.Network)...)
 	genOptions = append(genOptions, ops.withExtraGenOpts(clusterRequest)...)
 
@@ -562,6 +1324,13 @@ func createClusterRequest(ops createClusterRequestOps) (clusterCreateRequestData
 		return clusterCreateRequestData{}, err
 	}
 
+	nodeConfigPatches, err := parseNodeConfigPatches(cOps.configPatchNode)
+	if err != nil {
+		return clusterCreateRequestData{}, err
+	}
+
+	configBundleOpts = append(configBundleOpts, networkFamilyBundleOps...)
+	configBundleOpts = append(configBundleOpts, ops.extraBundleOpts...)
 	configBundleOpts = append(configBundleOpts, configPatchBundleOps...)
 
 	configBundle, err := bundle.NewBundle(configBundleOpts...)
@@ -593,6 +1362,14 @@ func createClusterRequest(ops createClusterRequestOps) (clusterCreateRequestData
 	clusterRequest.Nodes = append(clusterRequest.Nodes, controlplanes...)
 	clusterRequest.Nodes = append(clusterRequest.Nodes, workers...)
 
+	if err = applyNodeConfigPatches(clusterRequest.Nodes, nodeConfigPatches, ops.validationMode); err != nil {
+		return clusterCreateRequestData{}, err
+	}
+
+	if err = applyBootOrder(cOps, clusterRequest.Nodes); err != nil {
+		return clusterCreateRequestData{}, err
+	}
+
 	return clusterCreateRequestData{
 		clusterRequest:   clusterRequest,
 		provisionOptions: provisionOptions,
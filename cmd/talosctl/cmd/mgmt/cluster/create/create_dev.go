@@ -36,6 +36,7 @@ import (
 	clustercmd "github.com/siderolabs/talos/cmd/talosctl/cmd/mgmt/cluster"
 	"github.com/siderolabs/talos/cmd/talosctl/cmd/mgmt/cluster/internal/firewallpatch"
 	"github.com/siderolabs/talos/cmd/talosctl/pkg/mgmt/helpers"
+	machinedruntime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
 	"github.com/siderolabs/talos/pkg/machinery/cel"
 	"github.com/siderolabs/talos/pkg/machinery/cel/celenv"
 	clientconfig "github.com/siderolabs/talos/pkg/machinery/client/config"
@@ -134,6 +135,11 @@ func create(ctx context.Context, ops createOps) error {
 	// qemu options
 	qOps := ops.qemu
 
+	spec, err := newClusterSpec(providers.QemuProviderName, ops, nil)
+	if err != nil {
+		return err
+	}
+
 	if err := downloadBootAssets(ctx, &qOps); err != nil {
 		return err
 	}
@@ -156,29 +162,9 @@ func create(ctx context.Context, ops createOps) error {
 		return err
 	}
 
-	// use ULA IPv6 network fd00::/8, add 'TAL' in hex to build /32 network, add IPv4 CIDR to build /64 unique network
-	cidr6, err := netip.ParsePrefix(
-		fmt.Sprintf(
-			"fd74:616c:%02x%02x:%02x%02x::/64",
-			cidr4.Addr().As4()[0], cidr4.Addr().As4()[1], cidr4.Addr().As4()[2], cidr4.Addr().As4()[3],
-		),
-	)
+	cidrs, err := getNetworkCIDRs(cOps)
 	if err != nil {
-		return fmt.Errorf("error validating cidr IPv6 block: %w", err)
-	}
-
-	var cidrs []netip.Prefix
-
-	if cOps.networkIPv4 {
-		cidrs = append(cidrs, cidr4)
-	}
-
-	if qOps.networkIPv6 {
-		cidrs = append(cidrs, cidr6)
-	}
-
-	if len(cidrs) == 0 {
-		return errors.New("neither IPv4 nor IPv6 network was enabled")
+		return err
 	}
 
 	// Gateway addr at 1st IP in range, ex. 192.168.0.1
@@ -279,11 +265,14 @@ func create(ctx context.Context, ops createOps) error {
 		provision.WithUEFI(qOps.uefiEnabled),
 		provision.WithTPM1_2(qOps.tpm1_2Enabled),
 		provision.WithTPM2(qOps.tpm2Enabled),
+		provision.WithSecureBoot(qOps.secureBootEnabled),
+		provision.WithSecureBootVars(qOps.secureBootVarsPath),
 		provision.WithDebugShell(qOps.debugShellEnabled),
 		provision.WithIOMMU(qOps.withIOMMU),
 		provision.WithExtraUEFISearchPaths(qOps.extraUEFISearchPaths),
 		provision.WithTargetArch(qOps.targetArch),
 		provision.WithSiderolinkAgent(qOps.withSiderolinkAgent.IsEnabled()),
+		provision.WithConcurrency(qOps.concurrency),
 	}
 
 	var configBundleOpts []bundle.Option
@@ -293,6 +282,11 @@ func create(ctx context.Context, ops createOps) error {
 		return err
 	}
 
+	controlplaneExtraDisks, workerDisks, err := getNodeClassExtraDisks(qOps, workerDisks)
+	if err != nil {
+		return err
+	}
+
 	genOptions := []generate.Option{
 		generate.WithInstallImage(qOps.nodeInstallImage),
 		generate.WithDebug(cOps.configDebug),
@@ -300,17 +294,24 @@ func create(ctx context.Context, ops createOps) error {
 		generate.WithClusterDiscovery(cOps.enableClusterDiscovery),
 	}
 
-	registryMirrorOps, err := getRegistryMirrorGenOps(cOps)
+	registryMirrorOps, err := getRegistryMirrorGenOps(cOps, gatewayIPs)
 	if err != nil {
 		return err
 	}
 
+	if err = startRegistryCache(ctx, cOps); err != nil {
+		return err
+	}
+
 	genOptions = append(genOptions, registryMirrorOps...)
 
 	for _, registryHost := range cOps.registryInsecure {
 		genOptions = append(genOptions, generate.WithRegistryInsecureSkipVerify(registryHost))
 	}
 
+	networkFamilyGenOps, networkFamilyBundleOps := getNetworkFamilyConfigOptions(cOps, cidrs)
+
+	genOptions = append(genOptions, networkFamilyGenOps...)
 	genOptions = append(genOptions, provisioner.GenOptions(request.Network)...)
 
 	if cOps.customCNIUrl != "" {
@@ -332,6 +333,16 @@ func create(ctx context.Context, ops createOps) error {
 
 	genOptions = append(genOptions, versionContractGenOps...)
 
+	controlplaneImage, workerImage, nodeImageBundleOps, err := getNodeImageOverrides(ctx, qOps, versionContract)
+	if err != nil {
+		return err
+	}
+
+	hostMounts, hostMountBundleOps, err := getQemuHostMounts(qOps)
+	if err != nil {
+		return err
+	}
+
 	extraDisks, userVolumePatches, err := getExtraDisks(provisioner, cidr4, versionContract, &provisionOptions, qOps)
 	if err != nil {
 		return err
@@ -496,12 +507,20 @@ func create(ctx context.Context, ops createOps) error {
 		bundle.WithPatch(userVolumePatches),
 		bundle.WithPatch(diskEncryptionPatches),
 	)
+	configBundleOpts = append(configBundleOpts, networkFamilyBundleOps...)
+	configBundleOpts = append(configBundleOpts, nodeImageBundleOps...)
+	configBundleOpts = append(configBundleOpts, hostMountBundleOps...)
 
 	configPatchBundleOps, err := getConfigPatchBundleOps(cOps)
 	if err != nil {
 		return err
 	}
 
+	nodeConfigPatches, err := parseNodeConfigPatches(cOps.configPatchNode)
+	if err != nil {
+		return err
+	}
+
 	configBundleOpts = append(configBundleOpts, configPatchBundleOps...)
 
 	if qOps.withFirewall != "" {
@@ -629,6 +648,14 @@ func create(ctx context.Context, ops createOps) error {
 		return err
 	}
 
+	if err = applyQemuCPUOptions(qOps, controlplanes, workers); err != nil {
+		return err
+	}
+
+	if request.Network.AdditionalNetworks, err = applyQemuAdditionalNetworks(qOps, controlplanes, workers); err != nil {
+		return err
+	}
+
 	// Create the controlplane nodes.
 	for i, node := range controlplanes {
 		var cfg config.Provider
@@ -639,11 +666,15 @@ func create(ctx context.Context, ops createOps) error {
 		}
 
 		node.Quirks = quirks.New(cOps.talosVersion)
-		node.Disks = primaryDisks
+		node.TalosVersion = cOps.talosVersion
+		node.Disks = nodeDisks(rootOps.ClusterName, fmt.Sprintf("cp%d", i+1), slices.Concat(primaryDisks, controlplaneExtraDisks))
 		node.SkipInjectingConfig = cOps.skipInjectingConfig
 		node.ConfigInjectionMethod = configInjectionMethod
 		node.BadRTC = qOps.badRTC
 		node.ExtraKernelArgs = extraKernelArgs
+		node.HostMounts = hostMounts
+
+		applyNodeImage(&node, controlplaneImage)
 
 		if cOps.withInitNode && i == 0 {
 			cfg = configBundle.Init()
@@ -680,17 +711,29 @@ func create(ctx context.Context, ops createOps) error {
 			return err
 		}
 
-		node.Disks = append(primaryDisks, workerDisks...) //nolint:gocritic
+		node.Disks = nodeDisks(rootOps.ClusterName, fmt.Sprintf("w%d", i), slices.Concat(primaryDisks, workerDisks))
 		node.Quirks = quirks.New(cOps.talosVersion)
+		node.TalosVersion = cOps.talosVersion
 		node.Config = cfg
 		node.ConfigInjectionMethod = configInjectionMethod
 		node.SkipInjectingConfig = cOps.skipInjectingConfig
 		node.BadRTC = qOps.badRTC
 		node.ExtraKernelArgs = extraKernelArgs
+		node.HostMounts = hostMounts
+
+		applyNodeImage(&node, workerImage)
 
 		request.Nodes = append(request.Nodes, node)
 	}
 
+	if err = applyNodeConfigPatches(request.Nodes, nodeConfigPatches, machinedruntime.ModeMetal); err != nil {
+		return err
+	}
+
+	if err = applyBootOrder(cOps, request.Nodes); err != nil {
+		return err
+	}
+
 	request.SiderolinkRequest = slb.SiderolinkRequest()
 
 	cluster, err := provisioner.Create(ctx, request, provisionOptions...)
@@ -698,6 +741,10 @@ func create(ctx context.Context, ops createOps) error {
 		return err
 	}
 
+	if err = saveClusterSpec(cluster, spec); err != nil {
+		return err
+	}
+
 	if qOps.debugShellEnabled {
 		fmt.Println("You can now connect to debug shell on any node using these commands:")
 
@@ -719,7 +766,7 @@ func create(ctx context.Context, ops createOps) error {
 	}
 
 	// Create and save the talosctl configuration file.
-	err = postCreate(ctx, cOps, bundleTalosconfig, cluster, provisionOptions, request)
+	err = postCreate(ctx, cOps, bundleTalosconfig, provisioner, cluster, provisionOptions, request)
 	if err != nil {
 		return err
 	}
@@ -1221,7 +1268,7 @@ func (a *agentFlag) Set(s string) error {
 	switch s {
 	case "true", "wireguard":
 		*a = 1
-	case "tunnel":
+	case "tunnel", "grpc-tunnel":
 		*a = 2
 	case "wireguard+tls":
 		*a = 3
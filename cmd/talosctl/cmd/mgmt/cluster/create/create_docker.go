@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/siderolabs/talos/cmd/talosctl/pkg/mgmt/helpers"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
 	clientconfig "github.com/siderolabs/talos/pkg/machinery/client/config"
 	"github.com/siderolabs/talos/pkg/machinery/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/generate"
@@ -38,8 +39,9 @@ func getDockerClusterRequest(
 	}
 
 	return createClusterRequest(createClusterRequestOps{
-		commonOps:   cOps,
-		provisioner: provisioner,
+		commonOps:      cOps,
+		provisioner:    provisioner,
+		validationMode: runtime.ModeContainer,
 		withExtraGenOpts: func(cr provision.ClusterRequest) []generate.Option {
 			endpointList := provisioner.GetTalosAPIEndpoints(cr.Network)
 			genOptions := []generate.Option{}
@@ -66,11 +68,24 @@ func getDockerClusterRequest(
 			return cr, nil
 		},
 		modifyNodes: func(cr provision.ClusterRequest, cp, w []provision.NodeRequest) (controlplanes, workers []provision.NodeRequest, err error) {
+			var publishedPorts []provision.PublishedPort
+
+			for _, spec := range dOps.workersPublish {
+				var ports []provision.PublishedPort
+
+				if ports, err = provision.ParsePublishedPorts(spec); err != nil {
+					return nil, nil, err
+				}
+
+				publishedPorts = append(publishedPorts, ports...)
+			}
+
 			for i := range cp {
 				cp[i].Mounts = dOps.mountOpts.Value()
 			}
 			for i := range w {
 				w[i].Mounts = dOps.mountOpts.Value()
+				w[i].PublishedPorts = publishedPorts
 			}
 
 			return cp, w, nil
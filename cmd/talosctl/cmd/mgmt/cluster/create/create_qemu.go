@@ -12,6 +12,7 @@ import (
 
 	"github.com/siderolabs/gen/xslices"
 
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
 	"github.com/siderolabs/talos/pkg/cli"
 	"github.com/siderolabs/talos/pkg/machinery/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/generate"
@@ -57,9 +58,16 @@ func getQemuClusterRequest(
 		return clusterCreateRequestData{}, err
 	}
 
+	hostMounts, hostMountBundleOps, err := getQemuHostMounts(qOps)
+	if err != nil {
+		return clusterCreateRequestData{}, err
+	}
+
 	return createClusterRequest(createClusterRequestOps{
-		commonOps:   cOps,
-		provisioner: provisioner,
+		commonOps:       cOps,
+		provisioner:     provisioner,
+		extraBundleOpts: hostMountBundleOps,
+		validationMode:  runtime.ModeMetal,
 		withExtraGenOpts: func(cr provision.ClusterRequest) []generate.Option {
 			genOptions := []generate.Option{
 				generate.WithInstallImage(qOps.nodeInstallImage),
@@ -77,6 +85,7 @@ func getQemuClusterRequest(
 				provision.WithUEFI(qOps.uefiEnabled),
 				provision.WithTargetArch(qOps.targetArch),
 				provision.WithSiderolinkAgent(qOps.withSiderolinkAgent.IsEnabled()),
+				provision.WithConcurrency(qOps.concurrency),
 			}
 		},
 		modifyClusterRequest: func(cr provision.ClusterRequest) (provision.ClusterRequest, error) {
@@ -104,12 +113,23 @@ func getQemuClusterRequest(
 				return nil, nil, err
 			}
 
+			controlplaneExtraDisks, workerExtraDisks, err := getNodeClassExtraDisks(qOps, workerDisks)
+			if err != nil {
+				return nil, nil, err
+			}
+
 			for i := range cp {
-				cp[i].Disks = primaryDisks
+				cp[i].Disks = nodeDisks(cr.Name, fmt.Sprintf("cp%d", i+1), slices.Concat(primaryDisks, controlplaneExtraDisks))
+				cp[i].HostMounts = hostMounts
 			}
 
 			for i := range w {
-				w[i].Disks = slices.Concat(primaryDisks, workerDisks)
+				w[i].Disks = nodeDisks(cr.Name, fmt.Sprintf("w%d", i+1), slices.Concat(primaryDisks, workerExtraDisks))
+				w[i].HostMounts = hostMounts
+			}
+
+			if err = applyQemuCPUOptions(qOps, cp, w); err != nil {
+				return nil, nil, err
 			}
 
 			return cp, w, nil
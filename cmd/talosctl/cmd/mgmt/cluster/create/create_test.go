@@ -5,15 +5,28 @@
 package create //nolint:testpackage
 
 import (
+	"fmt"
 	"net/netip"
+	"os"
+	"path/filepath"
 	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/siderolabs/gen/xslices"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/siderolabs/talos/cmd/talosctl/cmd/mgmt/cluster"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
 	"github.com/siderolabs/talos/pkg/bytesize"
+	"github.com/siderolabs/talos/pkg/cli"
+	"github.com/siderolabs/talos/pkg/machinery/config/bundle"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate"
+	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/provision"
+	"github.com/siderolabs/talos/pkg/provision/providers"
 )
 
 func TestGetDisks(t *testing.T) {
@@ -79,6 +92,45 @@ func TestGetDisks(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "disk count",
+			args: args{
+				disks:            []string{"virtio:4096", "nvme:1024:2"},
+				preallocateDisks: true,
+				diskBlockSize:    512,
+			},
+			wantPrimary: []*provision.Disk{
+				{
+					Size:      4096 * 1024 * 1024,
+					Driver:    "virtio",
+					BlockSize: 512,
+				},
+			},
+			wantWorkerExtra: []*provision.Disk{
+				{
+					Size:      1024 * 1024 * 1024,
+					Driver:    "nvme",
+					BlockSize: 512,
+				},
+				{
+					Size:      1024 * 1024 * 1024,
+					Driver:    "nvme",
+					BlockSize: 512,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid disk count",
+			args: args{
+				disks:            []string{"virtio:4096", "nvme:1024:0"},
+				preallocateDisks: true,
+				diskBlockSize:    512,
+			},
+			wantPrimary:     nil,
+			wantWorkerExtra: nil,
+			wantErr:         true,
+		},
 		{
 			name: "invalid disk format",
 			args: args{
@@ -134,6 +186,57 @@ func TestGetDisks(t *testing.T) {
 	}
 }
 
+func TestGetNodeClassExtraDisks(t *testing.T) {
+	workerExtra := []*provision.Disk{{Size: 1024, Driver: "virtio"}}
+
+	controlplaneDisks, workerDisks, err := getNodeClassExtraDisks(qemuOps{}, workerExtra)
+	require.NoError(t, err)
+
+	assert.Empty(t, controlplaneDisks)
+	assert.Equal(t, workerExtra, workerDisks)
+
+	controlplaneDisks, workerDisks, err = getNodeClassExtraDisks(qemuOps{
+		extraDisksControlPlanes: []string{"nvme:1024"},
+		extraDisksWorkers:       []string{"scsi:2048:2"},
+		diskBlockSize:           4096,
+	}, workerExtra)
+	require.NoError(t, err)
+
+	assert.Equal(t, []*provision.Disk{
+		{Size: 1024 * 1024 * 1024, SkipPreallocate: true, Driver: "nvme", BlockSize: 4096},
+	}, controlplaneDisks)
+	assert.Equal(t, []*provision.Disk{
+		{Size: 2048 * 1024 * 1024, SkipPreallocate: true, Driver: "scsi", BlockSize: 4096},
+		{Size: 2048 * 1024 * 1024, SkipPreallocate: true, Driver: "scsi", BlockSize: 4096},
+	}, workerDisks)
+
+	_, _, err = getNodeClassExtraDisks(qemuOps{extraDisksWorkers: []string{"scsi"}}, workerExtra)
+	assert.Error(t, err)
+}
+
+func TestNodeDisks(t *testing.T) {
+	disks := []*provision.Disk{
+		{Size: 1024, Driver: "virtio"},
+		{Size: 2048, Driver: "nvme", Serial: "custom"},
+	}
+
+	w1 := nodeDisks("test-cluster", "w1", disks)
+	require.Len(t, w1, 2)
+
+	assert.Equal(t, "w1-disk0", w1[0].Serial)
+	assert.Equal(t, "custom", w1[1].Serial)
+	assert.Regexp(t, regexp.MustCompile(`^0x5[0-9a-f]{15}$`), w1[0].WWN)
+
+	// the source disks are not modified
+	assert.Empty(t, disks[0].Serial)
+	assert.NotSame(t, disks[0], w1[0])
+
+	// the identity is stable across runs and unique per node and cluster
+	assert.Equal(t, w1, nodeDisks("test-cluster", "w1", disks))
+	assert.NotEqual(t, w1[0].WWN, nodeDisks("test-cluster", "w2", disks)[0].WWN)
+	assert.NotEqual(t, w1[0].WWN, nodeDisks("other-cluster", "w1", disks)[0].WWN)
+}
+
 func TestCreateNodeRequests(t *testing.T) {
 	cOps := commonOps{
 		rootOps: &cluster.CmdOps{
@@ -193,3 +296,424 @@ func TestCreateNodeRequests(t *testing.T) {
 	assert.Regexp(t, regexp.MustCompile("^machine-[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$"), workers[0].Name)
 	assert.Regexp(t, regexp.MustCompile("^machine-[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$"), workers[1].Name)
 }
+
+func TestGetNetworkCIDRs(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		ipv4     bool
+		ipv6     bool
+		ipv6Only bool
+
+		expected           []string
+		expectedPodSubnets []string
+		expectedError      string
+	}{
+		{
+			name:               "ipv4",
+			ipv4:               true,
+			expected:           []string{"10.5.0.0/24"},
+			expectedPodSubnets: []string{constants.DefaultIPv4PodNet},
+		},
+		{
+			name:               "dual-stack",
+			ipv4:               true,
+			ipv6:               true,
+			expected:           []string{"10.5.0.0/24", "fd74:616c:a05::/64"},
+			expectedPodSubnets: []string{constants.DefaultIPv4PodNet, constants.DefaultIPv6PodNet},
+		},
+		{
+			name:               "ipv6",
+			ipv6:               true,
+			expected:           []string{"fd74:616c:a05::/64"},
+			expectedPodSubnets: []string{constants.DefaultIPv6PodNet},
+		},
+		{
+			name:               "ipv6-only",
+			ipv4:               true,
+			ipv6Only:           true,
+			expected:           []string{"fd74:616c:a05::/64", "10.5.0.0/24"},
+			expectedPodSubnets: []string{constants.DefaultIPv6PodNet},
+		},
+		{
+			name:          "none",
+			expectedError: "neither IPv4 nor IPv6 network was enabled",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			cOps := commonOps{
+				networkCIDR:     "10.5.0.0/24",
+				networkIPv4:     test.ipv4,
+				networkIPv6:     test.ipv6,
+				networkIPv6Only: test.ipv6Only,
+			}
+
+			cidrs, err := getNetworkCIDRs(cOps)
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, xslices.Map(cidrs, netip.Prefix.String))
+
+			genOptions, bundleOptions := getNetworkFamilyConfigOptions(cOps, cidrs)
+
+			var opts generate.Options
+
+			for _, opt := range genOptions {
+				require.NoError(t, opt(&opts))
+			}
+
+			assert.Equal(t, test.expectedPodSubnets, opts.PodSubnets)
+
+			if len(cidrs) > 1 {
+				assert.Len(t, bundleOptions, 2)
+			} else {
+				assert.Empty(t, bundleOptions)
+			}
+		})
+	}
+}
+
+func TestApplyQemuCPUOptions(t *testing.T) {
+	qOps := qemuOps{
+		cpuModel:                   "host",
+		cpuTopologyWorkers:         "sockets=2,cores=2",
+		nestedVirtualization:       true,
+		extraQemuArgsControlPlanes: []string{"-device virtio-rng-pci", "-no-hpet"},
+	}
+
+	controlplanes := []provision.NodeRequest{{Name: "controlplane-1"}}
+	workers := []provision.NodeRequest{{Name: "worker-1"}, {Name: "worker-2"}}
+
+	assert.NoError(t, applyQemuCPUOptions(qOps, controlplanes, workers))
+
+	assert.Equal(t, "host", controlplanes[0].CPUModel)
+	assert.True(t, controlplanes[0].NestedVirtualization)
+	assert.Nil(t, controlplanes[0].CPUTopology)
+	assert.Equal(t, []string{"-device", "virtio-rng-pci", "-no-hpet"}, controlplanes[0].ExtraQemuArgs)
+
+	for _, node := range workers {
+		assert.Equal(t, "host", node.CPUModel)
+		assert.Equal(t, &provision.CPUTopology{Sockets: 2, Cores: 2, Threads: 1}, node.CPUTopology)
+		assert.Empty(t, node.ExtraQemuArgs)
+	}
+
+	qOps.extraQemuArgsWorkers = []string{"device virtio-rng-pci"}
+
+	assert.EqualError(t, applyQemuCPUOptions(qOps, controlplanes, workers),
+		`invalid extra QEMU argument "device virtio-rng-pci": should start with an option, e.g. "-device virtio-rng-pci"`)
+}
+
+func TestApplyQemuAdditionalNetworks(t *testing.T) {
+	qOps := qemuOps{
+		additionalNetworks: []string{"storage:10.10.0.0/24", "lab"},
+		additionalNICs:     []string{"storage", "lab:workers", "lab:controlplane-1", "storage:worker-2"},
+	}
+
+	controlplanes := []provision.NodeRequest{{Name: "controlplane-1"}}
+	workers := []provision.NodeRequest{{Name: "worker-1"}, {Name: "worker-2"}}
+
+	networks, err := applyQemuAdditionalNetworks(qOps, controlplanes, workers)
+	require.NoError(t, err)
+
+	assert.Equal(t, []provision.AdditionalNetworkRequest{
+		{Name: "storage", CIDR: netip.MustParsePrefix("10.10.0.0/24")},
+		{Name: "lab"},
+	}, networks)
+
+	assert.Equal(t, []provision.NIC{
+		{Network: "storage", MAC: "52:54:00:01:01:01"},
+		{Network: "lab", MAC: "52:54:00:02:01:02"},
+	}, controlplanes[0].AdditionalNICs)
+	assert.Equal(t, []provision.NIC{
+		{Network: "storage", MAC: "52:54:00:01:02:01"},
+		{Network: "lab", MAC: "52:54:00:02:02:02"},
+	}, workers[0].AdditionalNICs)
+	assert.Equal(t, []provision.NIC{
+		{Network: "storage", MAC: "52:54:00:01:03:01"},
+		{Network: "lab", MAC: "52:54:00:02:03:02"},
+		{Network: "storage", MAC: "52:54:00:01:03:03"},
+	}, workers[1].AdditionalNICs)
+
+	_, err = applyQemuAdditionalNetworks(qemuOps{additionalNICs: []string{"storage"}}, controlplanes, workers)
+	assert.EqualError(t, err, `invalid additional NIC "storage": unknown network "storage"`)
+
+	_, err = applyQemuAdditionalNetworks(qemuOps{additionalNetworks: []string{"lab"}, additionalNICs: []string{"lab:worker-3"}}, controlplanes, workers)
+	assert.EqualError(t, err, `invalid additional NIC "lab:worker-3": no nodes match "worker-3"`)
+
+	_, err = applyQemuAdditionalNetworks(qemuOps{additionalNetworks: []string{"lab:10.0.0.0"}}, controlplanes, workers)
+	assert.ErrorContains(t, err, `invalid additional network "lab:10.0.0.0" CIDR`)
+}
+
+func TestGetRegistryMirrors(t *testing.T) {
+	gatewayIPs := []netip.Addr{netip.MustParseAddr("10.5.0.1")}
+
+	cOps := getDefaultCommonOptions()
+	cOps.registryMirrors = []string{"docker.io=http://10.5.0.1:5000"}
+
+	mirrors, err := getRegistryMirrors(cOps, gatewayIPs)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"docker.io=http://10.5.0.1:5000"}, mirrors)
+
+	cOps.withRegistryCache = true
+
+	mirrors, err = getRegistryMirrors(cOps, gatewayIPs)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"docker.io=http://10.5.0.1:5000",
+		"registry.k8s.io=http://10.5.0.1:5101",
+		"gcr.io=http://10.5.0.1:5102",
+		"ghcr.io=http://10.5.0.1:5103",
+		"quay.io=http://10.5.0.1:5104",
+		"factory.talos.dev=http://10.5.0.1:5105",
+	}, mirrors)
+
+	cOps.registryMirrors = []string{"docker.io"}
+
+	_, err = getRegistryMirrors(cOps, gatewayIPs)
+	assert.EqualError(t, err, `invalid registry mirror spec: "docker.io"`)
+}
+
+func TestClusterSpecRoundTrip(t *testing.T) {
+	patchPath := filepath.Join(t.TempDir(), "patch.yaml")
+	require.NoError(t, os.WriteFile(patchPath, []byte("machine:\n  sysctls:\n    vm.max_map_count: \"262144\"\n"), 0o644))
+
+	for _, test := range []struct {
+		name        string
+		provisioner string
+		modify      func(ops *createOps)
+	}{
+		{
+			name:        "docker",
+			provisioner: providers.DockerProviderName,
+			modify: func(ops *createOps) {
+				ops.common.workers = 3
+				ops.common.registryMirrors = []string{"docker.io=http://10.5.0.1:5000"}
+				ops.common.withRegistryCache = true
+				ops.docker.talosImage = "ghcr.io/siderolabs/talos:v1.12.0"
+				ops.docker.workersPublish = []string{"30080:80/tcp"}
+				ops.docker.hostIP = "127.0.0.1"
+			},
+		},
+		{
+			name:        "qemu",
+			provisioner: providers.QemuProviderName,
+			modify: func(ops *createOps) {
+				ops.common.controlplanes = 3
+				ops.common.networkCIDR = "10.10.0.0/24"
+				ops.common.networkIPv6 = true
+				ops.common.configPatchWorker = []string{"@" + patchPath}
+				ops.common.configPatchNode = []string{"worker-1=@" + patchPath}
+				ops.qemu.disks = []string{"virtio:10GB", "nvme:6GB"}
+				ops.qemu.extraDisksWorkers = []string{"nvme:6GB:2"}
+				ops.common.bootOrder = bootOrderStaged
+				ops.qemu.nodeVmlinuzPath = "/home/user/_out/vmlinuz-amd64"
+				ops.qemu.nodeISOPath = "https://factory.talos.dev/image/376567988ad370138ad8b2698212367b8edcb69b5fd68c80be1f2ec7d603b4ba/v1.12.0/metal-amd64.iso"
+				ops.qemu.additionalNetworks = []string{"storage:10.10.10.0/24"}
+				ops.qemu.withSiderolinkAgent = 2
+				cli.Should(ops.common.workerResources.memory.Set("4096"))
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			ops := createOps{
+				common: getDefaultCommonOptions(),
+				qemu:   getDefaultQemuOptions(),
+			}
+
+			test.modify(&ops)
+
+			spec, err := newClusterSpec(test.provisioner, ops, nil)
+			require.NoError(t, err)
+
+			exported, err := spec.marshal()
+			require.NoError(t, err)
+
+			// secrets and host-specific options are not exported
+			assert.NotContains(t, string(exported), "127.0.0.1")
+			assert.NotContains(t, string(exported), "/home/user")
+			assert.NotContains(t, string(exported), patchPath)
+
+			imported, err := unmarshalClusterSpec(exported)
+			require.NoError(t, err)
+
+			importedOps := createOps{
+				common: getDefaultCommonOptions(),
+				qemu:   getDefaultQemuOptions(),
+			}
+
+			require.NoError(t, imported.apply(test.provisioner, &importedOps, nil))
+
+			reexportedSpec, err := newClusterSpec(test.provisioner, importedOps, nil)
+			require.NoError(t, err)
+
+			reexported, err := reexportedSpec.marshal()
+			require.NoError(t, err)
+
+			assert.Equal(t, string(exported), string(reexported))
+		})
+	}
+
+	spec, err := newClusterSpec(providers.DockerProviderName, createOps{common: getDefaultCommonOptions()}, nil)
+	require.NoError(t, err)
+
+	assert.EqualError(t, spec.apply(providers.QemuProviderName, &createOps{}, nil), `cluster spec is for the "docker" provisioner, can't create it with "qemu"`)
+
+	_, err = unmarshalClusterSpec([]byte("version: v1\nprovisioner: docker\n"))
+	assert.EqualError(t, err, `unsupported cluster spec version "v1", expected "v1alpha1"`)
+}
+
+func TestApplyNodeConfigPatches(t *testing.T) {
+	configBundle, err := bundle.NewBundle(bundle.WithInputOptions(&bundle.InputOptions{
+		ClusterName: "test-cluster",
+		Endpoint:    "https://10.5.0.2:6443",
+		KubeVersion: strings.TrimPrefix(constants.DefaultKubernetesVersion, "v"),
+	}))
+	require.NoError(t, err)
+
+	nodes := func() []provision.NodeRequest {
+		return []provision.NodeRequest{
+			{Name: "test-cluster-controlplane-1", Type: machine.TypeControlPlane, Config: configBundle.ControlPlane()},
+			{Name: "machine-1", Type: machine.TypeWorker, Config: configBundle.Worker()},
+			{Name: "machine-2", Type: machine.TypeWorker, Config: configBundle.Worker()},
+		}
+	}
+
+	patchPath := filepath.Join(t.TempDir(), "patch.yaml")
+	require.NoError(t, os.WriteFile(patchPath, []byte("machine:\n  sysctls:\n    vm.max_map_count: \"262144\"\n"), 0o644))
+
+	patches, err := parseNodeConfigPatches([]string{
+		"worker-2=@" + patchPath,
+		`test-cluster-controlplane-1=machine: {sysctls: {net.core.somaxconn: "65535"}}`,
+	})
+	require.NoError(t, err)
+
+	patched := nodes()
+
+	require.NoError(t, applyNodeConfigPatches(patched, patches, runtime.ModeMetal))
+
+	assert.Equal(t, []string{"inline#2"}, patched[0].ConfigPatches)
+	assert.Equal(t, map[string]string{"net.core.somaxconn": "65535"}, patched[0].Config.Machine().Sysctls())
+	assert.Empty(t, patched[1].ConfigPatches)
+	assert.Empty(t, patched[1].Config.Machine().Sysctls())
+	assert.Equal(t, []string{patchPath}, patched[2].ConfigPatches)
+	assert.Equal(t, map[string]string{"vm.max_map_count": "262144"}, patched[2].Config.Machine().Sysctls())
+
+	patches, err = parseNodeConfigPatches([]string{"worker-3=@" + patchPath})
+	require.NoError(t, err)
+
+	assert.EqualError(t, applyNodeConfigPatches(nodes(), patches, runtime.ModeMetal), fmt.Sprintf(`config patch %s doesn't match any node: "worker-3"`, patchPath))
+
+	_, err = parseNodeConfigPatches([]string{"worker-1"})
+	assert.EqualError(t, err, `invalid node config patch "worker-1": expected <node>=<patch>`)
+
+	missingPath := filepath.Join(t.TempDir(), "missing.yaml")
+
+	_, err = parseNodeConfigPatches([]string{"worker-1=@" + missingPath})
+	assert.ErrorContains(t, err, fmt.Sprintf(`error loading config patch %s for node "worker-1"`, missingPath))
+}
+
+func TestApplyBootOrder(t *testing.T) {
+	newNodes := func() []provision.NodeRequest {
+		return []provision.NodeRequest{
+			{Name: "init-1", Type: machine.TypeInit},
+			{Name: "controlplane-1", Type: machine.TypeControlPlane},
+			{Name: "worker-1", Type: machine.TypeWorker},
+		}
+	}
+
+	stopped := func(nodes []provision.NodeRequest) []bool {
+		return xslices.Map(nodes, func(node provision.NodeRequest) bool { return node.Stopped })
+	}
+
+	for _, test := range []struct {
+		name      string
+		bootOrder string
+		noBoot    bool
+
+		expected []bool
+	}{
+		{
+			name:      "parallel",
+			bootOrder: bootOrderParallel,
+			expected:  []bool{false, false, false},
+		},
+		{
+			name:      "staged",
+			bootOrder: bootOrderStaged,
+			expected:  []bool{false, false, true},
+		},
+		{
+			name:      "no boot",
+			bootOrder: bootOrderStaged,
+			noBoot:    true,
+			expected:  []bool{true, true, true},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			nodes := newNodes()
+
+			require.NoError(t, applyBootOrder(commonOps{bootOrder: test.bootOrder, noBoot: test.noBoot}, nodes))
+
+			assert.Equal(t, test.expected, stopped(nodes))
+		})
+	}
+
+	assert.EqualError(t, applyBootOrder(commonOps{bootOrder: "random"}, newNodes()), `unsupported boot order "random", expected "parallel" or "staged"`)
+	assert.EqualError(t, applyBootOrder(commonOps{bootOrder: bootOrderStaged, skipInjectingConfig: true}, newNodes()),
+		"staged boot order requires the nodes to receive the machine config on boot")
+}
+
+func TestGetNodeImageInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		image         string
+		expectedError string
+	}{
+		{
+			image:         "ghcr.io/siderolabs/installer",
+			expectedError: `installer image "ghcr.io/siderolabs/installer" should be tagged with the Talos version`,
+		},
+		{
+			image:         "127.0.0.1:5005/siderolabs/installer",
+			expectedError: `installer image "127.0.0.1:5005/siderolabs/installer" should be tagged with the Talos version`,
+		},
+		{
+			image:         "ghcr.io/siderolabs/installer:latest",
+			expectedError: `error parsing Talos version of the installer image "ghcr.io/siderolabs/installer:latest": error parsing version "vlatest"`,
+		},
+	} {
+		t.Run(test.image, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := getNodeImage(t.Context(), test.image, "amd64")
+			require.EqualError(t, err, test.expectedError)
+		})
+	}
+}
+
+func TestGetQemuHostMounts(t *testing.T) {
+	t.Parallel()
+
+	hostMounts, bundleOpts, err := getQemuHostMounts(qemuOps{
+		hostMounts: []string{
+			"host=/src,guest=/var/mnt/src,readonly,type=virtiofs",
+			"host=/data,guest=/var/mnt/data,type=9p",
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []provision.HostMount{
+		{HostPath: "/src", GuestPath: "/var/mnt/src", ReadOnly: true, Type: provision.HostMountTypeVirtioFS, Tag: "hostmount0"},
+		{HostPath: "/data", GuestPath: "/var/mnt/data", Type: provision.HostMountType9P, Tag: "hostmount1"},
+	}, hostMounts)
+	assert.Len(t, bundleOpts, 1)
+
+	_, _, err = getQemuHostMounts(qemuOps{hostMounts: []string{"host=/src,guest=/var/mnt/src", "host=/data,guest=/var/mnt/src"}})
+	assert.EqualError(t, err, `duplicate host mount guest path "/var/mnt/src"`)
+}
@@ -0,0 +1,65 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package create
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	clustercmd "github.com/siderolabs/talos/cmd/talosctl/cmd/mgmt/cluster"
+)
+
+var exportCmdFlags struct {
+	output string
+}
+
+// exportCmd represents the cluster export command.
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Exports the spec of a local cluster, so that it can be re-created with 'cluster create --from'",
+	Long: `Exports the spec of a local cluster: node count, network, versions, resources, config patches and registry mirrors.
+
+Secrets are not exported, they are generated again when the cluster is created from the spec.
+Host-specific options (boot asset paths, CNI directories, mounts) are not exported either, they are re-derived on import.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return export(clustercmd.PersistentFlags)
+	},
+}
+
+func export(rootOps clustercmd.CmdOps) error {
+	spec, err := loadClusterSpec(filepath.Join(rootOps.StateDir, rootOps.ClusterName, clusterSpecFilename))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("cluster %q has no spec stored in the state directory, it was probably created by an older version of talosctl", rootOps.ClusterName)
+		}
+
+		return err
+	}
+
+	data, err := spec.marshal()
+	if err != nil {
+		return err
+	}
+
+	if exportCmdFlags.output == "-" {
+		_, err = os.Stdout.Write(data)
+
+		return err
+	}
+
+	return os.WriteFile(exportCmdFlags.output, data, 0o644)
+}
+
+func init() {
+	exportCmd.Flags().StringVarP(&exportCmdFlags.output, "output", "o", "-", "path to the file to write the cluster spec to, '-' for stdout")
+
+	clustercmd.Cmd.AddCommand(exportCmd)
+}
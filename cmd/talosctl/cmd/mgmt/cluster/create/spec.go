@@ -0,0 +1,563 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package create
+
+import (
+	"bytes"
+	"cmp"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/siderolabs/talos/pkg/provision"
+	"github.com/siderolabs/talos/pkg/provision/providers"
+)
+
+const (
+	// clusterSpecFilename is the name of the file in the cluster state directory the cluster spec is stored to.
+	clusterSpecFilename = "cluster-spec.yaml"
+
+	clusterSpecVersion = "v1alpha1"
+
+	fromFlagName = "from"
+)
+
+// clusterSpec describes the local cluster in a host-independent way, so that it can be shared and re-created.
+//
+// Secrets are not part of the spec, they are generated each time the cluster is created.
+// Host-specific options (paths to the boot assets on the host, CNI directories, mounts, talosconfig destination)
+// are not part of the spec either, they are re-derived on import.
+type clusterSpec struct {
+	Version     string      `yaml:"version"`
+	Provisioner string      `yaml:"provisioner"`
+	Common      commonSpec  `yaml:"common"`
+	Docker      *dockerSpec `yaml:"docker,omitempty"`
+	QEMU        *qemuSpec   `yaml:"qemu,omitempty"`
+}
+
+type commonSpec struct {
+	Controlplanes           int      `yaml:"controlplanes"`
+	Workers                 int      `yaml:"workers"`
+	ControlplaneCPUs        string   `yaml:"controlplaneCPUs"`
+	ControlplaneMemory      string   `yaml:"controlplaneMemory"`
+	WorkerCPUs              string   `yaml:"workerCPUs"`
+	WorkerMemory            string   `yaml:"workerMemory"`
+	TalosVersion            string   `yaml:"talosVersion"`
+	KubernetesVersion       string   `yaml:"kubernetesVersion"`
+	RegistryMirrors         []string `yaml:"registryMirrors,omitempty"`
+	RegistryCache           bool     `yaml:"registryCache,omitempty"`
+	RegistryInsecure        []string `yaml:"registryInsecure,omitempty"`
+	NetworkCIDR             string   `yaml:"networkCIDR"`
+	NetworkMTU              int      `yaml:"networkMTU"`
+	NetworkIPv4             bool     `yaml:"networkIPv4"`
+	NetworkIPv6             bool     `yaml:"networkIPv6"`
+	NetworkIPv6Only         bool     `yaml:"networkIPv6Only"`
+	WireguardCIDR           string   `yaml:"wireguardCIDR,omitempty"`
+	DNSDomain               string   `yaml:"dnsDomain"`
+	ControlPlanePort        int      `yaml:"controlPlanePort"`
+	KubePrismPort           int      `yaml:"kubePrismPort"`
+	ForceInitNodeAsEndpoint bool     `yaml:"forceInitNodeAsEndpoint"`
+	ForceEndpoint           string   `yaml:"forceEndpoint,omitempty"`
+	WithInitNode            bool     `yaml:"withInitNode"`
+	CustomCNIURL            string   `yaml:"customCNIURL,omitempty"`
+	ApplyConfigEnabled      bool     `yaml:"applyConfigEnabled"`
+	SkipInjectingConfig     bool     `yaml:"skipInjectingConfig"`
+	ConfigDebug             bool     `yaml:"configDebug"`
+	EnableKubeSpan          bool     `yaml:"enableKubeSpan"`
+	EnableClusterDiscovery  bool     `yaml:"enableClusterDiscovery"`
+	WithJSONLogs            bool     `yaml:"withJSONLogs"`
+	WithUUIDHostnames       bool     `yaml:"withUUIDHostnames"`
+	BootOrder               string   `yaml:"bootOrder,omitempty"`
+	// Config patches are stored inline, as the patch files are not available on other hosts.
+	ConfigPatches             []string `yaml:"configPatches,omitempty"`
+	ConfigPatchesControlPlane []string `yaml:"configPatchesControlPlane,omitempty"`
+	ConfigPatchesWorker       []string `yaml:"configPatchesWorker,omitempty"`
+	ConfigPatchesNode         []string `yaml:"configPatchesNode,omitempty"`
+}
+
+type dockerSpec struct {
+	TalosImage     string   `yaml:"talosImage"`
+	Ports          string   `yaml:"ports,omitempty"`
+	WorkersPublish []string `yaml:"workersPublish,omitempty"`
+	DisableIPv6    bool     `yaml:"disableIPv6"`
+}
+
+type qemuSpec struct {
+	TargetArch      string `yaml:"targetArch"`
+	SchematicID     string `yaml:"schematicID,omitempty"`
+	ImageFactoryURL string `yaml:"imageFactoryURL,omitempty"`
+	InstallImage    string `yaml:"installImage,omitempty"`
+	// Per node class installer images of mixed-version clusters.
+	ControlplaneImage string `yaml:"controlplaneImage,omitempty"`
+	WorkerImage       string `yaml:"workerImage,omitempty"`
+	// Boot assets are only stored if they are URLs, local paths are re-derived on import.
+	VmlinuzURL                 string   `yaml:"vmlinuzURL,omitempty"`
+	InitramfsURL               string   `yaml:"initramfsURL,omitempty"`
+	ISOURL                     string   `yaml:"isoURL,omitempty"`
+	USBURL                     string   `yaml:"usbURL,omitempty"`
+	UKIURL                     string   `yaml:"ukiURL,omitempty"`
+	DiskImageURL               string   `yaml:"diskImageURL,omitempty"`
+	IPXEBootScript             string   `yaml:"ipxeBootScript,omitempty"`
+	ExtraBootKernelArgs        string   `yaml:"extraBootKernelArgs,omitempty"`
+	BootloaderEnabled          bool     `yaml:"bootloaderEnabled"`
+	UEFIEnabled                bool     `yaml:"uefiEnabled"`
+	SecureBootEnabled          bool     `yaml:"secureBootEnabled"`
+	TPM1_2Enabled              bool     `yaml:"tpm1_2Enabled"`
+	TPM2Enabled                bool     `yaml:"tpm2Enabled"`
+	WithIOMMU                  bool     `yaml:"withIOMMU"`
+	CPUModel                   string   `yaml:"cpuModel,omitempty"`
+	CPUTopologyControlPlanes   string   `yaml:"cpuTopologyControlPlanes,omitempty"`
+	CPUTopologyWorkers         string   `yaml:"cpuTopologyWorkers,omitempty"`
+	MachineType                string   `yaml:"machineType,omitempty"`
+	NestedVirtualization       bool     `yaml:"nestedVirtualization"`
+	ExtraQemuArgsControlPlanes []string `yaml:"extraQemuArgsControlPlanes,omitempty"`
+	ExtraQemuArgsWorkers       []string `yaml:"extraQemuArgsWorkers,omitempty"`
+	Disks                      []string `yaml:"disks"`
+	DiskBlockSize              uint     `yaml:"diskBlockSize"`
+	PreallocateDisks           bool     `yaml:"preallocateDisks"`
+	ExtraDisksControlPlanes    []string `yaml:"extraDisksControlPlanes,omitempty"`
+	ExtraDisksWorkers          []string `yaml:"extraDisksWorkers,omitempty"`
+	UserVolumes                []string `yaml:"userVolumes,omitempty"`
+	EncryptStatePartition      bool     `yaml:"encryptStatePartition"`
+	EncryptEphemeralPartition  bool     `yaml:"encryptEphemeralPartition"`
+	EncryptUserVolumes         bool     `yaml:"encryptUserVolumes"`
+	DiskEncryptionKeyTypes     []string `yaml:"diskEncryptionKeyTypes,omitempty"`
+	Nameservers                []string `yaml:"nameservers,omitempty"`
+	NetworkNoMasqueradeCIDRs   []string `yaml:"networkNoMasqueradeCIDRs,omitempty"`
+	AdditionalNetworks         []string `yaml:"additionalNetworks,omitempty"`
+	AdditionalNICs             []string `yaml:"additionalNICs,omitempty"`
+	UseVIP                     bool     `yaml:"useVIP"`
+	BadRTC                     bool     `yaml:"badRTC"`
+	DHCPSkipHostname           bool     `yaml:"dhcpSkipHostname"`
+	WithFirewall               string   `yaml:"withFirewall,omitempty"`
+	WithSiderolinkAgent        string   `yaml:"withSiderolinkAgent,omitempty"`
+	ConfigInjectionMethod      string   `yaml:"configInjectionMethod,omitempty"`
+	CNIBundleURL               string   `yaml:"cniBundleURL"`
+}
+
+// newClusterSpec captures the cluster spec from the create options.
+//
+// cqOps is only set for the user-facing QEMU command.
+func newClusterSpec(provisionerName string, ops createOps, cqOps *createQemuOps) (clusterSpec, error) {
+	cOps := ops.common
+
+	spec := clusterSpec{
+		Version:     clusterSpecVersion,
+		Provisioner: provisionerName,
+		Common: commonSpec{
+			Controlplanes:           cOps.controlplanes,
+			Workers:                 cOps.workers,
+			ControlplaneCPUs:        cOps.controlplaneResources.cpu,
+			ControlplaneMemory:      cOps.controlplaneResources.memory.String(),
+			WorkerCPUs:              cOps.workerResources.cpu,
+			WorkerMemory:            cOps.workerResources.memory.String(),
+			TalosVersion:            cOps.talosVersion,
+			KubernetesVersion:       cOps.kubernetesVersion,
+			RegistryMirrors:         cOps.registryMirrors,
+			RegistryCache:           cOps.withRegistryCache,
+			RegistryInsecure:        cOps.registryInsecure,
+			NetworkCIDR:             cOps.networkCIDR,
+			NetworkMTU:              cOps.networkMTU,
+			NetworkIPv4:             cOps.networkIPv4,
+			NetworkIPv6:             cOps.networkIPv6,
+			NetworkIPv6Only:         cOps.networkIPv6Only,
+			WireguardCIDR:           cOps.wireguardCIDR,
+			DNSDomain:               cOps.dnsDomain,
+			ControlPlanePort:        cOps.controlPlanePort,
+			KubePrismPort:           cOps.kubePrismPort,
+			ForceInitNodeAsEndpoint: cOps.forceInitNodeAsEndpoint,
+			ForceEndpoint:           cOps.forceEndpoint,
+			WithInitNode:            cOps.withInitNode,
+			CustomCNIURL:            cOps.customCNIUrl,
+			ApplyConfigEnabled:      cOps.applyConfigEnabled,
+			SkipInjectingConfig:     cOps.skipInjectingConfig,
+			ConfigDebug:             cOps.configDebug,
+			EnableKubeSpan:          cOps.enableKubeSpan,
+			EnableClusterDiscovery:  cOps.enableClusterDiscovery,
+			WithJSONLogs:            cOps.withJSONLogs,
+			WithUUIDHostnames:       cOps.withUUIDHostnames,
+			BootOrder:               cOps.bootOrder,
+		},
+	}
+
+	var err error
+
+	if spec.Common.ConfigPatches, err = inlineConfigPatches(cOps.configPatch); err != nil {
+		return clusterSpec{}, err
+	}
+
+	if spec.Common.ConfigPatchesControlPlane, err = inlineConfigPatches(cOps.configPatchControlPlane); err != nil {
+		return clusterSpec{}, err
+	}
+
+	if spec.Common.ConfigPatchesWorker, err = inlineConfigPatches(cOps.configPatchWorker); err != nil {
+		return clusterSpec{}, err
+	}
+
+	if spec.Common.ConfigPatchesNode, err = inlineNodeConfigPatches(cOps.configPatchNode); err != nil {
+		return clusterSpec{}, err
+	}
+
+	switch provisionerName {
+	case providers.DockerProviderName:
+		spec.Docker = &dockerSpec{
+			TalosImage:     ops.docker.talosImage,
+			Ports:          ops.docker.ports,
+			WorkersPublish: ops.docker.workersPublish,
+			DisableIPv6:    ops.docker.disableIPv6,
+		}
+	case providers.QemuProviderName:
+		qOps := ops.qemu
+
+		spec.QEMU = &qemuSpec{
+			TargetArch:                 qOps.targetArch,
+			InstallImage:               qOps.nodeInstallImage,
+			ControlplaneImage:          qOps.controlplaneImage,
+			WorkerImage:                qOps.workerImage,
+			VmlinuzURL:                 urlOrEmpty(qOps.nodeVmlinuzPath),
+			InitramfsURL:               urlOrEmpty(qOps.nodeInitramfsPath),
+			ISOURL:                     urlOrEmpty(qOps.nodeISOPath),
+			USBURL:                     urlOrEmpty(qOps.nodeUSBPath),
+			UKIURL:                     urlOrEmpty(qOps.nodeUKIPath),
+			DiskImageURL:               urlOrEmpty(qOps.nodeDiskImagePath),
+			IPXEBootScript:             qOps.nodeIPXEBootScript,
+			ExtraBootKernelArgs:        qOps.extraBootKernelArgs,
+			BootloaderEnabled:          qOps.bootloaderEnabled,
+			UEFIEnabled:                qOps.uefiEnabled,
+			SecureBootEnabled:          qOps.secureBootEnabled,
+			TPM1_2Enabled:              qOps.tpm1_2Enabled,
+			TPM2Enabled:                qOps.tpm2Enabled,
+			WithIOMMU:                  qOps.withIOMMU,
+			CPUModel:                   qOps.cpuModel,
+			CPUTopologyControlPlanes:   qOps.cpuTopologyControlPlanes,
+			CPUTopologyWorkers:         qOps.cpuTopologyWorkers,
+			MachineType:                qOps.machineType,
+			NestedVirtualization:       qOps.nestedVirtualization,
+			ExtraQemuArgsControlPlanes: qOps.extraQemuArgsControlPlanes,
+			ExtraQemuArgsWorkers:       qOps.extraQemuArgsWorkers,
+			Disks:                      qOps.disks,
+			DiskBlockSize:              qOps.diskBlockSize,
+			PreallocateDisks:           qOps.preallocateDisks,
+			ExtraDisksControlPlanes:    qOps.extraDisksControlPlanes,
+			ExtraDisksWorkers:          qOps.extraDisksWorkers,
+			UserVolumes:                qOps.clusterUserVolumes,
+			EncryptStatePartition:      qOps.encryptStatePartition,
+			EncryptEphemeralPartition:  qOps.encryptEphemeralPartition,
+			EncryptUserVolumes:         qOps.encryptUserVolumes,
+			DiskEncryptionKeyTypes:     qOps.diskEncryptionKeyTypes,
+			Nameservers:                qOps.nameservers,
+			NetworkNoMasqueradeCIDRs:   qOps.networkNoMasqueradeCIDRs,
+			AdditionalNetworks:         qOps.additionalNetworks,
+			AdditionalNICs:             qOps.additionalNICs,
+			UseVIP:                     qOps.useVIP,
+			BadRTC:                     qOps.badRTC,
+			DHCPSkipHostname:           qOps.dhcpSkipHostname,
+			WithFirewall:               qOps.withFirewall,
+			ConfigInjectionMethod:      qOps.configInjectionMethod,
+			CNIBundleURL:               qOps.cniBundleURL,
+		}
+
+		if qOps.withSiderolinkAgent.IsEnabled() {
+			spec.QEMU.WithSiderolinkAgent = qOps.withSiderolinkAgent.String()
+		}
+
+		if cqOps != nil {
+			spec.QEMU.SchematicID = cqOps.schematicID
+			spec.QEMU.ImageFactoryURL = cqOps.imageFactoryURL
+		}
+	default:
+		return clusterSpec{}, fmt.Errorf("unsupported provisioner %q", provisionerName)
+	}
+
+	return spec, nil
+}
+
+// apply the cluster spec to the create options.
+//
+// Options which are not part of the spec are left untouched.
+//
+//nolint:gocyclo
+func (spec clusterSpec) apply(provisionerName string, ops *createOps, cqOps *createQemuOps) error {
+	if spec.Provisioner != provisionerName {
+		return fmt.Errorf("cluster spec is for the %q provisioner, can't create it with %q", spec.Provisioner, provisionerName)
+	}
+
+	common := spec.Common
+	cOps := &ops.common
+
+	cOps.controlplanes = common.Controlplanes
+	cOps.workers = common.Workers
+	cOps.controlplaneResources.cpu = common.ControlplaneCPUs
+	cOps.workerResources.cpu = common.WorkerCPUs
+
+	if err := cOps.controlplaneResources.memory.Set(common.ControlplaneMemory); err != nil {
+		return fmt.Errorf("invalid controlplane memory: %w", err)
+	}
+
+	if err := cOps.workerResources.memory.Set(common.WorkerMemory); err != nil {
+		return fmt.Errorf("invalid worker memory: %w", err)
+	}
+
+	cOps.talosVersion = common.TalosVersion
+	cOps.kubernetesVersion = common.KubernetesVersion
+	cOps.registryMirrors = common.RegistryMirrors
+	cOps.withRegistryCache = common.RegistryCache
+	cOps.registryInsecure = common.RegistryInsecure
+	cOps.networkCIDR = common.NetworkCIDR
+	cOps.networkMTU = common.NetworkMTU
+	cOps.networkIPv4 = common.NetworkIPv4
+	cOps.networkIPv6 = common.NetworkIPv6
+	cOps.networkIPv6Only = common.NetworkIPv6Only
+	cOps.wireguardCIDR = common.WireguardCIDR
+	cOps.dnsDomain = common.DNSDomain
+	cOps.controlPlanePort = common.ControlPlanePort
+	cOps.kubePrismPort = common.KubePrismPort
+	cOps.forceInitNodeAsEndpoint = common.ForceInitNodeAsEndpoint
+	cOps.forceEndpoint = common.ForceEndpoint
+	cOps.withInitNode = common.WithInitNode
+	cOps.customCNIUrl = common.CustomCNIURL
+	cOps.applyConfigEnabled = common.ApplyConfigEnabled
+	cOps.skipInjectingConfig = common.SkipInjectingConfig
+	cOps.configDebug = common.ConfigDebug
+	cOps.enableKubeSpan = common.EnableKubeSpan
+	cOps.enableClusterDiscovery = common.EnableClusterDiscovery
+	cOps.withJSONLogs = common.WithJSONLogs
+	cOps.withUUIDHostnames = common.WithUUIDHostnames
+	cOps.configPatch = common.ConfigPatches
+	cOps.configPatchControlPlane = common.ConfigPatchesControlPlane
+	cOps.configPatchWorker = common.ConfigPatchesWorker
+	cOps.configPatchNode = common.ConfigPatchesNode
+
+	// specs exported before the boot order was added boot all the nodes at once
+	if common.BootOrder != "" {
+		cOps.bootOrder = common.BootOrder
+	}
+
+	switch provisionerName {
+	case providers.DockerProviderName:
+		if spec.Docker == nil {
+			return errors.New("cluster spec is missing the docker options")
+		}
+
+		ops.docker.talosImage = spec.Docker.TalosImage
+		ops.docker.ports = spec.Docker.Ports
+		ops.docker.workersPublish = spec.Docker.WorkersPublish
+		ops.docker.disableIPv6 = spec.Docker.DisableIPv6
+	case providers.QemuProviderName:
+		if spec.QEMU == nil {
+			return errors.New("cluster spec is missing the qemu options")
+		}
+
+		q := spec.QEMU
+		qOps := &ops.qemu
+
+		qOps.targetArch = q.TargetArch
+
+		if q.InstallImage != "" {
+			qOps.nodeInstallImage = q.InstallImage
+		}
+
+		qOps.controlplaneImage = q.ControlplaneImage
+		qOps.workerImage = q.WorkerImage
+
+		for _, asset := range []struct {
+			url  string
+			path *string
+		}{
+			{q.VmlinuzURL, &qOps.nodeVmlinuzPath},
+			{q.InitramfsURL, &qOps.nodeInitramfsPath},
+			{q.ISOURL, &qOps.nodeISOPath},
+			{q.USBURL, &qOps.nodeUSBPath},
+			{q.UKIURL, &qOps.nodeUKIPath},
+			{q.DiskImageURL, &qOps.nodeDiskImagePath},
+		} {
+			if asset.url != "" {
+				*asset.path = asset.url
+			}
+		}
+
+		qOps.nodeIPXEBootScript = q.IPXEBootScript
+		qOps.extraBootKernelArgs = q.ExtraBootKernelArgs
+		qOps.bootloaderEnabled = q.BootloaderEnabled
+		qOps.uefiEnabled = q.UEFIEnabled
+		qOps.secureBootEnabled = q.SecureBootEnabled
+		qOps.tpm1_2Enabled = q.TPM1_2Enabled
+		qOps.tpm2Enabled = q.TPM2Enabled
+		qOps.withIOMMU = q.WithIOMMU
+		qOps.cpuModel = q.CPUModel
+		qOps.cpuTopologyControlPlanes = q.CPUTopologyControlPlanes
+		qOps.cpuTopologyWorkers = q.CPUTopologyWorkers
+		qOps.machineType = q.MachineType
+		qOps.nestedVirtualization = q.NestedVirtualization
+		qOps.extraQemuArgsControlPlanes = q.ExtraQemuArgsControlPlanes
+		qOps.extraQemuArgsWorkers = q.ExtraQemuArgsWorkers
+		qOps.disks = q.Disks
+		qOps.diskBlockSize = q.DiskBlockSize
+		qOps.preallocateDisks = q.PreallocateDisks
+		qOps.extraDisksControlPlanes = q.ExtraDisksControlPlanes
+		qOps.extraDisksWorkers = q.ExtraDisksWorkers
+		qOps.clusterUserVolumes = q.UserVolumes
+		qOps.encryptStatePartition = q.EncryptStatePartition
+		qOps.encryptEphemeralPartition = q.EncryptEphemeralPartition
+		qOps.encryptUserVolumes = q.EncryptUserVolumes
+		qOps.diskEncryptionKeyTypes = q.DiskEncryptionKeyTypes
+		qOps.nameservers = q.Nameservers
+		qOps.networkNoMasqueradeCIDRs = q.NetworkNoMasqueradeCIDRs
+		qOps.additionalNetworks = q.AdditionalNetworks
+		qOps.additionalNICs = q.AdditionalNICs
+		qOps.useVIP = q.UseVIP
+		qOps.badRTC = q.BadRTC
+		qOps.dhcpSkipHostname = q.DHCPSkipHostname
+		qOps.withFirewall = q.WithFirewall
+		qOps.configInjectionMethod = q.ConfigInjectionMethod
+		qOps.cniBundleURL = q.CNIBundleURL
+		qOps.withSiderolinkAgent = 0
+
+		if q.WithSiderolinkAgent != "" {
+			if err := qOps.withSiderolinkAgent.Set(q.WithSiderolinkAgent); err != nil {
+				return err
+			}
+		}
+
+		if cqOps != nil {
+			cqOps.schematicID = q.SchematicID
+			cqOps.imageFactoryURL = cmp.Or(q.ImageFactoryURL, cqOps.imageFactoryURL)
+		}
+	}
+
+	return nil
+}
+
+// inlineConfigPatches replaces config patches loaded from files with their contents.
+func inlineConfigPatches(patches []string) ([]string, error) {
+	result := make([]string, 0, len(patches))
+
+	for _, patch := range patches {
+		if filename, ok := strings.CutPrefix(patch, "@"); ok {
+			contents, err := os.ReadFile(filename)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read config patch: %w", err)
+			}
+
+			patch = string(contents)
+		}
+
+		result = append(result, patch)
+	}
+
+	if len(result) == 0 {
+		return nil, nil
+	}
+
+	return result, nil
+}
+
+// inlineNodeConfigPatches replaces node config patches loaded from files with their contents, keeping the node selectors.
+func inlineNodeConfigPatches(patches []string) ([]string, error) {
+	result := make([]string, 0, len(patches))
+
+	for _, patch := range patches {
+		selector, patchString, ok := strings.Cut(patch, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid node config patch %q: expected <node>=<patch>", patch)
+		}
+
+		inlined, err := inlineConfigPatches([]string{patchString})
+		if err != nil {
+			return nil, fmt.Errorf("node %q: %w", selector, err)
+		}
+
+		result = append(result, selector+"="+inlined[0])
+	}
+
+	if len(result) == 0 {
+		return nil, nil
+	}
+
+	return result, nil
+}
+
+// urlOrEmpty returns the boot asset location if it is a URL, and an empty string if it's a local path.
+func urlOrEmpty(path string) string {
+	u, err := url.Parse(path)
+	if err != nil || !(u.Scheme == "http" || u.Scheme == "https") {
+		return ""
+	}
+
+	return path
+}
+
+func (spec clusterSpec) marshal() ([]byte, error) {
+	var buf bytes.Buffer
+
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(spec); err != nil {
+		return nil, err
+	}
+
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func unmarshalClusterSpec(data []byte) (clusterSpec, error) {
+	var spec clusterSpec
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	if err := decoder.Decode(&spec); err != nil {
+		return clusterSpec{}, fmt.Errorf("failed to decode cluster spec: %w", err)
+	}
+
+	if spec.Version != clusterSpecVersion {
+		return clusterSpec{}, fmt.Errorf("unsupported cluster spec version %q, expected %q", spec.Version, clusterSpecVersion)
+	}
+
+	return spec, nil
+}
+
+func loadClusterSpec(path string) (clusterSpec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return clusterSpec{}, err
+	}
+
+	return unmarshalClusterSpec(data)
+}
+
+// saveClusterSpec stores the cluster spec in the state directory of the cluster, so that it can be exported later.
+func saveClusterSpec(cluster provision.Cluster, spec clusterSpec) error {
+	statePath, err := cluster.StatePath()
+	if err != nil {
+		return err
+	}
+
+	data, err := spec.marshal()
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(statePath, clusterSpecFilename), data, 0o600)
+}
+
+// importClusterSpec loads the cluster spec from the path and applies it to the create options.
+func importClusterSpec(path, provisionerName string, ops *createOps, cqOps *createQemuOps) error {
+	spec, err := loadClusterSpec(path)
+	if err != nil {
+		return err
+	}
+
+	return spec.apply(provisionerName, ops, cqOps)
+}
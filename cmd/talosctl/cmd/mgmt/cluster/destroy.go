@@ -6,9 +6,11 @@ package cluster
 
 import (
 	"context"
+	"os"
 
 	"github.com/spf13/cobra"
 
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/mgmt/cluster/internal/registrycache"
 	"github.com/siderolabs/talos/pkg/cli"
 	"github.com/siderolabs/talos/pkg/provision"
 	"github.com/siderolabs/talos/pkg/provision/providers"
@@ -18,6 +20,7 @@ var destroyCmdFlags struct {
 	forceDelete                bool
 	saveSupportArchivePath     string
 	saveClusterLogsArchivePath string
+	cleanRegistryCache         bool
 }
 
 // destroyCmd represents the cluster destroy command.
@@ -44,19 +47,28 @@ func destroy(ctx context.Context) error {
 		return err
 	}
 
-	return provisioner.Destroy(
+	if err = provisioner.Destroy(
 		ctx,
 		cluster,
 		provision.WithDeleteOnErr(destroyCmdFlags.forceDelete),
 		provision.WithSaveSupportArchivePath(destroyCmdFlags.saveSupportArchivePath),
 		provision.WithSaveClusterLogsArchivePath(destroyCmdFlags.saveClusterLogsArchivePath),
-	)
+	); err != nil {
+		return err
+	}
+
+	if destroyCmdFlags.cleanRegistryCache {
+		return registrycache.Remove(ctx, os.Stdout)
+	}
+
+	return nil
 }
 
 func init() {
 	destroyCmd.PersistentFlags().BoolVarP(&destroyCmdFlags.forceDelete, "force", "f", false, "force deletion of cluster directory if there were errors")
 	destroyCmd.PersistentFlags().StringVarP(&destroyCmdFlags.saveSupportArchivePath, "save-support-archive-path", "", "", "save support archive to the specified file on destroy")
 	destroyCmd.PersistentFlags().StringVarP(&destroyCmdFlags.saveClusterLogsArchivePath, "save-cluster-logs-archive-path", "", "", "save cluster logs archive to the specified file on destroy")
+	destroyCmd.PersistentFlags().BoolVar(&destroyCmdFlags.cleanRegistryCache, "clean-registry-cache", false, "remove the registry cache created with --with-registry-cache along with the cached images")
 	AddProvisionerFlag(destroyCmd)
 
 	Cmd.AddCommand(destroyCmd)
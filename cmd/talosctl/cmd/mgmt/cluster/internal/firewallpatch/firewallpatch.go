@@ -23,7 +23,7 @@ func ingressRuleWithinCluster(cidrs []netip.Prefix, gateways []netip.Addr) []net
 	for i := range cidrs {
 		rules = append(rules,
 			network.IngressRule{
-				Subnet: cidrs[i],
+				Subnet: network.Prefix{Prefix: cidrs[i]},
 				Except: network.Prefix{Prefix: netip.PrefixFrom(gateways[i], gateways[i].BitLen())},
 			},
 		)
@@ -35,10 +35,10 @@ func ingressRuleWithinCluster(cidrs []netip.Prefix, gateways []netip.Addr) []net
 func ingressRuleWideOpen() []network.IngressRule {
 	return []network.IngressRule{
 		{
-			Subnet: netip.MustParsePrefix("0.0.0.0/0"),
+			Subnet: network.Prefix{Prefix: netip.MustParsePrefix("0.0.0.0/0")},
 		},
 		{
-			Subnet: netip.MustParsePrefix("::/0"),
+			Subnet: network.Prefix{Prefix: netip.MustParsePrefix("::/0")},
 		},
 	}
 }
@@ -46,7 +46,7 @@ func ingressRuleWideOpen() []network.IngressRule {
 func ingressOnly(ips []netip.Addr) []network.IngressRule {
 	return xslices.Map(ips, func(ip netip.Addr) network.IngressRule {
 		return network.IngressRule{
-			Subnet: netip.PrefixFrom(ip, ip.BitLen()),
+			Subnet: network.Prefix{Prefix: netip.PrefixFrom(ip, ip.BitLen())},
 		}
 	})
 }
@@ -0,0 +1,214 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package registrycache manages the pull-through registry cache shared by the local clusters.
+//
+// The cache is a set of docker containers (one per upstream registry), with the storage in named volumes,
+// so that the pulled images survive the cluster create/destroy cycles.
+package registrycache
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/netip"
+	"strconv"
+
+	"github.com/containerd/errdefs"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/image"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/volume"
+	"github.com/docker/docker/client"
+	"github.com/docker/go-connections/nat"
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+// Image is the registry image used for the cache.
+const Image = "docker.io/library/registry:2"
+
+const (
+	namePrefix  = "talos-registry-cache-"
+	labelName   = "talos.registry-cache"
+	storagePath = "/var/lib/registry"
+)
+
+// Registry is an upstream registry cached by the registry cache.
+type Registry struct {
+	// Host is the registry host as used in the image references.
+	Host string
+	// RemoteURL is the upstream registry endpoint.
+	RemoteURL string
+	// Port is the host port the cache for the registry listens on.
+	Port int
+}
+
+// Registries is the list of the cached registries.
+var Registries = []Registry{
+	{Host: "docker.io", RemoteURL: "https://registry-1.docker.io", Port: 5100},
+	{Host: "registry.k8s.io", RemoteURL: "https://registry.k8s.io", Port: 5101},
+	{Host: "gcr.io", RemoteURL: "https://gcr.io", Port: 5102},
+	{Host: "ghcr.io", RemoteURL: "https://ghcr.io", Port: 5103},
+	{Host: "quay.io", RemoteURL: "https://quay.io", Port: 5104},
+	{Host: "factory.talos.dev", RemoteURL: "https://factory.talos.dev", Port: 5105},
+}
+
+// Name returns the name of the container and the volume of the registry cache.
+func (registry Registry) Name() string {
+	return namePrefix + registry.Host
+}
+
+// Mirrors returns the registry mirrors in the `<registry host>=<mirror URL>` format,
+// with the cache reachable from the nodes on the host address.
+func Mirrors(hostAddr netip.Addr) []string {
+	mirrors := make([]string, 0, len(Registries))
+
+	for _, registry := range Registries {
+		mirrors = append(mirrors, registry.Host+"=http://"+nethelpers.JoinHostPort(hostAddr.String(), registry.Port))
+	}
+
+	return mirrors
+}
+
+// Start makes sure that the registry cache containers are running.
+//
+// Existing containers and volumes are reused.
+func Start(ctx context.Context, logWriter io.Writer) error {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return err
+	}
+
+	defer cli.Close() //nolint:errcheck
+
+	if err = ensureImage(ctx, cli, logWriter); err != nil {
+		return fmt.Errorf("error pulling registry cache image: %w", err)
+	}
+
+	for _, registry := range Registries {
+		if err = start(ctx, cli, registry, logWriter); err != nil {
+			return fmt.Errorf("error starting registry cache for %s: %w", registry.Host, err)
+		}
+	}
+
+	return nil
+}
+
+func ensureImage(ctx context.Context, cli *client.Client, logWriter io.Writer) error {
+	_, err := cli.ImageInspect(ctx, Image)
+	if err == nil {
+		return nil
+	}
+
+	if !errdefs.IsNotFound(err) {
+		return err
+	}
+
+	fmt.Fprintln(logWriter, "downloading", Image)
+
+	reader, err := cli.ImagePull(ctx, Image, image.PullOptions{})
+	if err != nil {
+		return err
+	}
+
+	defer reader.Close() //nolint:errcheck
+
+	_, err = io.Copy(io.Discard, reader)
+
+	return err
+}
+
+func start(ctx context.Context, cli *client.Client, registry Registry, logWriter io.Writer) error {
+	info, err := cli.ContainerInspect(ctx, registry.Name())
+
+	switch {
+	case err == nil:
+		if info.State != nil && info.State.Running {
+			return nil
+		}
+
+		return cli.ContainerStart(ctx, info.ID, container.StartOptions{})
+	case !errdefs.IsNotFound(err):
+		return err
+	}
+
+	fmt.Fprintf(logWriter, "creating registry cache for %s on port %d\n", registry.Host, registry.Port)
+
+	if _, err = cli.VolumeCreate(ctx, volume.CreateOptions{
+		Name:   registry.Name(),
+		Labels: map[string]string{labelName: registry.Host},
+	}); err != nil {
+		return err
+	}
+
+	port := nat.Port("5000/tcp")
+
+	resp, err := cli.ContainerCreate(ctx,
+		&container.Config{
+			Image: Image,
+			Env: []string{
+				"REGISTRY_PROXY_REMOTEURL=" + registry.RemoteURL,
+			},
+			ExposedPorts: nat.PortSet{
+				port: struct{}{},
+			},
+			Labels: map[string]string{labelName: registry.Host},
+		},
+		&container.HostConfig{
+			PortBindings: nat.PortMap{
+				port: []nat.PortBinding{{HostPort: strconv.Itoa(registry.Port)}},
+			},
+			RestartPolicy: container.RestartPolicy{
+				Name: container.RestartPolicyUnlessStopped,
+			},
+			Mounts: []mount.Mount{
+				{
+					Type:   mount.TypeVolume,
+					Source: registry.Name(),
+					Target: storagePath,
+				},
+			},
+		},
+		nil, nil, registry.Name(),
+	)
+	if err != nil {
+		return err
+	}
+
+	return cli.ContainerStart(ctx, resp.ID, container.StartOptions{})
+}
+
+// Remove removes the registry cache containers along with the cached images.
+func Remove(ctx context.Context, logWriter io.Writer) error {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return err
+	}
+
+	defer cli.Close() //nolint:errcheck
+
+	var multiErr *multierror.Error
+
+	for _, registry := range Registries {
+		err = cli.ContainerRemove(ctx, registry.Name(), container.RemoveOptions{Force: true})
+		if err != nil && !errdefs.IsNotFound(err) {
+			multiErr = multierror.Append(multiErr, err)
+
+			continue
+		}
+
+		err = cli.VolumeRemove(ctx, registry.Name(), true)
+		if err != nil && !errdefs.IsNotFound(err) {
+			multiErr = multierror.Append(multiErr, err)
+
+			continue
+		}
+
+		fmt.Fprintf(logWriter, "removed registry cache for %s\n", registry.Host)
+	}
+
+	return multiErr.ErrorOrNil()
+}
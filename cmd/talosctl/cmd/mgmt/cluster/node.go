@@ -0,0 +1,117 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/siderolabs/gen/xslices"
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/talos/pkg/cli"
+	"github.com/siderolabs/talos/pkg/provision"
+	"github.com/siderolabs/talos/pkg/provision/providers"
+)
+
+// nodeCmd represents the cluster node command.
+var nodeCmd = &cobra.Command{
+	Use:   "node",
+	Short: "Manages the nodes of a local provisioned kubernetes cluster",
+	Long:  ``,
+}
+
+// nodeStartCmd represents the cluster node start command.
+var nodeStartCmd = &cobra.Command{
+	Use:   "start <node>...",
+	Short: "Boots the nodes of a local provisioned kubernetes cluster",
+	Long: `Boots the nodes of a local provisioned kubernetes cluster.
+
+The node is specified by its name, the cluster name prefix can be omitted (e.g. "worker-2").`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cli.WithContext(context.Background(), func(ctx context.Context) error {
+			return setNodesPowerState(ctx, args, false)
+		})
+	},
+}
+
+// nodeStopCmd represents the cluster node stop command.
+var nodeStopCmd = &cobra.Command{
+	Use:   "stop <node>...",
+	Short: "Stops the nodes of a local provisioned kubernetes cluster",
+	Long: `Stops the nodes of a local provisioned kubernetes cluster without a graceful shutdown.
+
+The node is kept along with its disks, and can be booted again with 'cluster node start' or 'cluster start'.
+The node is specified by its name, the cluster name prefix can be omitted (e.g. "worker-2").`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cli.WithContext(context.Background(), func(ctx context.Context) error {
+			return setNodesPowerState(ctx, args, true)
+		})
+	},
+}
+
+func setNodesPowerState(ctx context.Context, selectors []string, stopped bool) error {
+	provisioner, err := providers.Factory(ctx, provisionerName)
+	if err != nil {
+		return err
+	}
+
+	defer provisioner.Close() //nolint:errcheck
+
+	cluster, err := provisioner.Reflect(ctx, PersistentFlags.ClusterName, PersistentFlags.StateDir)
+	if err != nil {
+		return err
+	}
+
+	names, err := resolveNodeNames(cluster.Info(), selectors)
+	if err != nil {
+		return err
+	}
+
+	if stopped {
+		return provisioner.StopNodes(ctx, cluster, names, provision.WithLogWriter(os.Stderr))
+	}
+
+	return provisioner.StartNodes(ctx, cluster, names, provision.WithLogWriter(os.Stderr))
+}
+
+// resolveNodeNames resolves the node selectors to the node names.
+//
+// The selector is either the node name, or the node name without the cluster name prefix (e.g. "worker-2").
+func resolveNodeNames(info provision.ClusterInfo, selectors []string) ([]string, error) {
+	names := make([]string, 0, len(selectors))
+
+	for _, selector := range selectors {
+		idx := slices.IndexFunc(info.Nodes, func(node provision.NodeInfo) bool {
+			name := strings.TrimLeft(node.Name, "/")
+
+			return name == selector || name == info.ClusterName+"-"+selector
+		})
+		if idx == -1 {
+			return nil, fmt.Errorf("node %q not found in cluster %q", selector, info.ClusterName)
+		}
+
+		names = append(names, strings.TrimLeft(info.Nodes[idx].Name, "/"))
+	}
+
+	return names, nil
+}
+
+func nodeNames(nodes []provision.NodeInfo) []string {
+	return xslices.Map(nodes, func(node provision.NodeInfo) string { return strings.TrimLeft(node.Name, "/") })
+}
+
+func init() {
+	AddProvisionerFlag(nodeStartCmd)
+	AddProvisionerFlag(nodeStopCmd)
+
+	nodeCmd.AddCommand(nodeStartCmd, nodeStopCmd)
+	Cmd.AddCommand(nodeCmd)
+}
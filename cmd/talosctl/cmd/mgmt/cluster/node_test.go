@@ -0,0 +1,33 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster //nolint:testpackage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/provision"
+)
+
+func TestResolveNodeNames(t *testing.T) {
+	info := provision.ClusterInfo{
+		ClusterName: "talos-default",
+		Nodes: []provision.NodeInfo{
+			{Name: "talos-default-controlplane-1"},
+			{Name: "/talos-default-worker-1"},
+			{Name: "machine-5c8c2a1e"},
+		},
+	}
+
+	names, err := resolveNodeNames(info, []string{"worker-1", "talos-default-controlplane-1", "machine-5c8c2a1e"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"talos-default-worker-1", "talos-default-controlplane-1", "machine-5c8c2a1e"}, names)
+
+	_, err = resolveNodeNames(info, []string{"worker-2"})
+	assert.EqualError(t, err, `node "worker-2" not found in cluster "talos-default"`)
+}
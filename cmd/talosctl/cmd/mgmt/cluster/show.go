@@ -13,12 +13,14 @@ import (
 	"slices"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/siderolabs/gen/xslices"
 	"github.com/spf13/cobra"
 
 	"github.com/siderolabs/talos/pkg/cli"
+	"github.com/siderolabs/talos/pkg/machinery/client"
 	"github.com/siderolabs/talos/pkg/provision"
 	"github.com/siderolabs/talos/pkg/provision/providers"
 )
@@ -47,11 +49,52 @@ func show(ctx context.Context) error {
 		return err
 	}
 
-	return ShowCluster(cluster)
+	return showCluster(cluster, bootedVersions(ctx, cluster))
+}
+
+// bootedVersions queries the Talos version of the running nodes using the cluster context of the talosconfig.
+//
+// Nodes which can't be queried (e.g. still booting, or the talosconfig has no context for the cluster) are omitted.
+func bootedVersions(ctx context.Context, cluster provision.Cluster) map[string]string {
+	c, err := client.New(ctx, client.WithDefaultConfig(), client.WithContextName(cluster.Info().ClusterName))
+	if err != nil {
+		return nil
+	}
+
+	defer c.Close() //nolint:errcheck
+
+	versions := map[string]string{}
+
+	for _, node := range cluster.Info().Nodes {
+		if node.Stopped || len(node.IPs) == 0 {
+			continue
+		}
+
+		nodeCtx, cancel := context.WithTimeout(client.WithNode(ctx, node.IPs[0].String()), 5*time.Second)
+
+		resp, err := c.Version(nodeCtx)
+
+		cancel()
+
+		if err != nil || len(resp.GetMessages()) == 0 {
+			continue
+		}
+
+		versions[node.Name] = resp.GetMessages()[0].GetVersion().GetTag()
+	}
+
+	return versions
 }
 
 // ShowCluster prints the details about the cluster to the terminal.
 func ShowCluster(cluster provision.Cluster) error {
+	return showCluster(cluster, nil)
+}
+
+// showCluster prints the details about the cluster, versions maps node names to the Talos version queried from the node.
+//
+// If the node wasn't queried, the Talos version recorded by the provisioner is shown.
+func showCluster(cluster provision.Cluster, versions map[string]string) error {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
 	fmt.Fprintf(w, "PROVISIONER\t%s\n", cluster.Provisioner())
 	fmt.Fprintf(w, "NAME\t%s\n", cluster.Info().ClusterName)
@@ -65,6 +108,16 @@ func ShowCluster(cluster provision.Cluster) error {
 
 	fmt.Fprintf(w, "NETWORK GATEWAY\t%s\n", strings.Join(gateways, ","))
 	fmt.Fprintf(w, "NETWORK MTU\t%d\n", cluster.Info().Network.MTU)
+
+	for _, network := range cluster.Info().Network.AdditionalNetworks {
+		cidr := "-"
+		if network.CIDR.IsValid() {
+			cidr = network.CIDR.String()
+		}
+
+		fmt.Fprintf(w, "ADDITIONAL NETWORK\t%s (bridge %s, CIDR %s)\n", network.Name, network.BridgeName, cidr)
+	}
+
 	fmt.Fprintf(w, "KUBERNETES ENDPOINT\t%s\n", cluster.Info().KubernetesEndpoint)
 
 	if err := w.Flush(); err != nil {
@@ -75,11 +128,69 @@ func ShowCluster(cluster provision.Cluster) error {
 
 	w = tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
 
-	fmt.Fprintf(w, "NAME\tTYPE\tIP\tCPU\tRAM\tDISK\n")
-
 	nodes := cluster.Info().Nodes
 	slices.SortFunc(nodes, func(a, b provision.NodeInfo) int { return cmp.Compare(a.Name, b.Name) })
 
+	// QEMU CPU and machine options are only recorded by the QEMU provisioner
+	showQemuOptions := slices.ContainsFunc(nodes, func(node provision.NodeInfo) bool { return node.CPUModel != "" })
+
+	// published ports are only recorded by the Docker provisioner
+	showPublishedPorts := slices.ContainsFunc(nodes, func(node provision.NodeInfo) bool { return len(node.PublishedPorts) > 0 })
+
+	// additional NICs are only recorded by the QEMU provisioner
+	showAdditionalNICs := slices.ContainsFunc(nodes, func(node provision.NodeInfo) bool { return len(node.AdditionalNICs) > 0 })
+
+	// host mounts are only recorded by the QEMU provisioner
+	showHostMounts := slices.ContainsFunc(nodes, func(node provision.NodeInfo) bool { return len(node.HostMounts) > 0 })
+
+	// the Talos version is queried from the running nodes, and it is recorded by the QEMU provisioner
+	showTalosVersion := len(versions) > 0 || slices.ContainsFunc(nodes, func(node provision.NodeInfo) bool { return node.TalosVersion != "" })
+
+	// node-specific config patches are only shown if any node has them
+	showConfigPatches := slices.ContainsFunc(nodes, func(node provision.NodeInfo) bool { return len(node.ConfigPatches) > 0 })
+
+	// the power state is only shown if any node is stopped
+	showState := slices.ContainsFunc(nodes, func(node provision.NodeInfo) bool { return node.Stopped })
+
+	// disk topology is only recorded by the QEMU provisioner, show it if any node has extra disks
+	showDisks := slices.ContainsFunc(nodes, func(node provision.NodeInfo) bool { return len(node.Disks) > 1 })
+
+	fmt.Fprintf(w, "NAME\tTYPE\tIP\tCPU\tRAM\tDISK")
+
+	if showState {
+		fmt.Fprintf(w, "\tSTATE")
+	}
+
+	if showTalosVersion {
+		fmt.Fprintf(w, "\tVERSION")
+	}
+
+	if showQemuOptions {
+		fmt.Fprintf(w, "\tCPU MODEL\tTOPOLOGY\tMACHINE\tEXTRA ARGS")
+	}
+
+	if showPublishedPorts {
+		fmt.Fprintf(w, "\tPORTS")
+	}
+
+	if showAdditionalNICs {
+		fmt.Fprintf(w, "\tNICS")
+	}
+
+	if showHostMounts {
+		fmt.Fprintf(w, "\tMOUNTS")
+	}
+
+	if showConfigPatches {
+		fmt.Fprintf(w, "\tPATCHES")
+	}
+
+	if showDisks {
+		fmt.Fprintf(w, "\tDISKS")
+	}
+
+	fmt.Fprintln(w)
+
 	for _, node := range nodes {
 		cpus := "-"
 		if node.NanoCPUs > 0 {
@@ -98,7 +209,7 @@ func ShowCluster(cluster provision.Cluster) error {
 
 		ips := xslices.Map(node.IPs, netip.Addr.String)
 
-		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s",
 			node.Name,
 			node.Type,
 			strings.Join(ips, ","),
@@ -106,6 +217,103 @@ func ShowCluster(cluster provision.Cluster) error {
 			mem,
 			disk,
 		)
+
+		if showState {
+			state := "running"
+			if node.Stopped {
+				state = "stopped"
+			}
+
+			fmt.Fprintf(w, "\t%s", state)
+		}
+
+		if showTalosVersion {
+			fmt.Fprintf(w, "\t%s", cmp.Or(versions[node.Name], node.TalosVersion, "-"))
+		}
+
+		if showQemuOptions {
+			cpuModel := cmp.Or(node.CPUModel, "-")
+			if node.NestedVirtualization {
+				cpuModel += " (nested)"
+			}
+
+			topology := "-"
+			if node.CPUTopology != nil {
+				topology = node.CPUTopology.String()
+			}
+
+			extraArgs := "-"
+			if len(node.ExtraQemuArgs) > 0 {
+				extraArgs = strings.Join(node.ExtraQemuArgs, " ")
+			}
+
+			fmt.Fprintf(w, "\t%s\t%s\t%s\t%s",
+				cpuModel,
+				topology,
+				cmp.Or(node.MachineType, "-"),
+				extraArgs,
+			)
+		}
+
+		if showPublishedPorts {
+			ports := "-"
+			if len(node.PublishedPorts) > 0 {
+				ports = strings.Join(xslices.Map(node.PublishedPorts, provision.PublishedPort.String), ",")
+			}
+
+			fmt.Fprintf(w, "\t%s", ports)
+		}
+
+		if showAdditionalNICs {
+			nics := "-"
+			if len(node.AdditionalNICs) > 0 {
+				nics = strings.Join(xslices.Map(node.AdditionalNICs, func(nic provision.NIC) string { return nic.Network + "=" + nic.MAC }), ",")
+			}
+
+			fmt.Fprintf(w, "\t%s", nics)
+		}
+
+		if showHostMounts {
+			mounts := "-"
+			if len(node.HostMounts) > 0 {
+				mounts = strings.Join(xslices.Map(node.HostMounts, func(mount provision.HostMount) string {
+					mode := "rw"
+					if mount.ReadOnly {
+						mode = "ro"
+					}
+
+					return fmt.Sprintf("%s:%s:%s (%s)", mount.HostPath, mount.GuestPath, mode, mount.Type)
+				}), ",")
+			}
+
+			fmt.Fprintf(w, "\t%s", mounts)
+		}
+
+		if showConfigPatches {
+			patches := "-"
+			if len(node.ConfigPatches) > 0 {
+				patches = strings.Join(node.ConfigPatches, ",")
+			}
+
+			fmt.Fprintf(w, "\t%s", patches)
+		}
+
+		if showDisks {
+			disks := "-"
+			if len(node.Disks) > 0 {
+				disks = strings.Join(xslices.Map(node.Disks, func(disk provision.Disk) string {
+					if disk.Serial == "" {
+						return fmt.Sprintf("%s:%s", disk.Driver, humanize.Bytes(disk.Size))
+					}
+
+					return fmt.Sprintf("%s:%s(%s)", disk.Driver, humanize.Bytes(disk.Size), disk.Serial)
+				}), ",")
+			}
+
+			fmt.Fprintf(w, "\t%s", disks)
+		}
+
+		fmt.Fprintln(w)
 	}
 
 	return w.Flush()
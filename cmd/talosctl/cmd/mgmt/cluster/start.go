@@ -0,0 +1,74 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/siderolabs/gen/xslices"
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/talos/pkg/cli"
+	"github.com/siderolabs/talos/pkg/provision"
+	"github.com/siderolabs/talos/pkg/provision/providers"
+)
+
+// startCmd represents the cluster start command.
+var startCmd = &cobra.Command{
+	Use:   "start",
+	Short: "Boots the stopped nodes of a local provisioned kubernetes cluster",
+	Long: `Boots the nodes created with 'cluster create --no-boot' or stopped with 'cluster node stop'.
+
+The control plane nodes are booted before the worker nodes.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cli.WithContext(context.Background(), start)
+	},
+}
+
+func start(ctx context.Context) error {
+	provisioner, err := providers.Factory(ctx, provisionerName)
+	if err != nil {
+		return err
+	}
+
+	defer provisioner.Close() //nolint:errcheck
+
+	cluster, err := provisioner.Reflect(ctx, PersistentFlags.ClusterName, PersistentFlags.StateDir)
+	if err != nil {
+		return err
+	}
+
+	stoppedNodes := xslices.Filter(cluster.Info().Nodes, func(node provision.NodeInfo) bool { return node.Stopped })
+	if len(stoppedNodes) == 0 {
+		fmt.Fprintln(os.Stderr, "no stopped nodes found")
+
+		return nil
+	}
+
+	// control plane nodes go first, the order of the nodes within each type is preserved
+	controlplanes := xslices.Filter(stoppedNodes, func(node provision.NodeInfo) bool { return node.Type.IsControlPlane() })
+	workers := xslices.Filter(stoppedNodes, func(node provision.NodeInfo) bool { return !node.Type.IsControlPlane() })
+
+	for _, nodes := range [][]provision.NodeInfo{controlplanes, workers} {
+		if len(nodes) == 0 {
+			continue
+		}
+
+		if err = provisioner.StartNodes(ctx, cluster, nodeNames(nodes), provision.WithLogWriter(os.Stderr)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func init() {
+	AddProvisionerFlag(startCmd)
+
+	Cmd.AddCommand(startCmd)
+}
@@ -76,6 +76,9 @@ var genConfigCmdFlags struct {
 	withClusterDiscovery    bool
 	withKubeSpan            bool
 	withSecrets             string
+	variables               []string
+	valuesFiles             []string
+	strictVariables         bool
 }
 
 // NewConfigCmd builds the config generation subcommand with the given name.
@@ -246,14 +249,27 @@ func writeConfig(args []string) error {
 		commentsFlags |= encoder.CommentsExamples
 	}
 
+	vars, err := configpatcher.LoadVariables(genConfigCmdFlags.valuesFiles, genConfigCmdFlags.variables, genConfigCmdFlags.strictVariables)
+	if err != nil {
+		return err
+	}
+
+	var configPatches [3][]string
+
+	for i, patches := range [][]string{genConfigCmdFlags.configPatch, genConfigCmdFlags.configPatchControlPlane, genConfigCmdFlags.configPatchWorker} {
+		if configPatches[i], err = vars.SubstitutePatches(patches); err != nil {
+			return err
+		}
+	}
+
 	configBundle, err := GenerateConfigBundle(
 		genOptions,
 		args[0],
 		args[1],
 		genConfigCmdFlags.kubernetesVersion,
-		genConfigCmdFlags.configPatch,
-		genConfigCmdFlags.configPatchControlPlane,
-		genConfigCmdFlags.configPatchWorker)
+		configPatches[0],
+		configPatches[1],
+		configPatches[2])
 	if err != nil {
 		return err
 	}
@@ -441,6 +457,9 @@ func init() {
 	genConfigCmd.Flags().StringArrayVar(&genConfigCmdFlags.configPatch, "config-patch", nil, "patch generated machineconfigs (applied to all node types), use @file to read a patch from file")
 	genConfigCmd.Flags().StringArrayVar(&genConfigCmdFlags.configPatchControlPlane, "config-patch-control-plane", nil, "patch generated machineconfigs (applied to 'init' and 'controlplane' types)")
 	genConfigCmd.Flags().StringArrayVar(&genConfigCmdFlags.configPatchWorker, "config-patch-worker", nil, "patch generated machineconfigs (applied to 'worker' type)")
+	genConfigCmd.Flags().StringArrayVar(&genConfigCmdFlags.variables, "set", nil, "set a variable substituted as ${key} in the config patches (key=value)")
+	genConfigCmd.Flags().StringArrayVar(&genConfigCmdFlags.valuesFiles, "values", nil, "read variables substituted in the config patches from a YAML file")
+	genConfigCmd.Flags().BoolVar(&genConfigCmdFlags.strictVariables, "strict-variables", false, "fail on references to undefined variables in the config patches")
 	genConfigCmd.Flags().StringSliceVar(&genConfigCmdFlags.registryMirrors, "registry-mirror", []string{}, "list of registry mirrors to use in format: <registry host>=<mirror URL>")
 	genConfigCmd.Flags().BoolVarP(&genConfigCmdFlags.persistConfig, "persist", "p", true, "the desired persist value for configs")
 	genConfigCmd.Flags().BoolVarP(&genConfigCmdFlags.withExamples, "with-examples", "", true, "renders all machine configs with the commented examples")
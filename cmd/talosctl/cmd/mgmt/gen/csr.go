@@ -12,6 +12,7 @@ import (
 	"net"
 	"os"
 	"path"
+	"slices"
 	"strings"
 
 	"github.com/siderolabs/crypto/x509"
@@ -57,6 +58,9 @@ var genCSRCmd = &cobra.Command{
 		}
 
 		roles, unknownRoles := role.Parse(genCSRCmdFlags.roles)
+		// custom roles are granted permissions by the API authorization policy
+		unknownRoles = slices.DeleteFunc(unknownRoles, func(r string) bool { return role.Role(r).IsCustom() })
+
 		if len(unknownRoles) != 0 {
 			return fmt.Errorf("unknown roles: %s", strings.Join(unknownRoles, ", "))
 		}
@@ -5,8 +5,9 @@
 package mgmt
 
 import (
-	"fmt"
+	"net"
 	"slices"
+	"strconv"
 
 	"github.com/siderolabs/gen/xiter"
 	"github.com/siderolabs/go-loadbalancer/loadbalancer"
@@ -17,7 +18,7 @@ import (
 )
 
 var loadbalancerLaunchCmdFlags struct {
-	addr             string
+	addrs            []string
 	ports            []int
 	upstreams        []string
 	apidOnlyInitNode bool
@@ -32,17 +33,19 @@ var loadbalancerLaunchCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		lb := loadbalancer.TCP{Logger: makeLogger()}
 
-		for _, port := range loadbalancerLaunchCmdFlags.ports {
-			if err := lb.AddRoute(
-				fmt.Sprintf("%s:%d", loadbalancerLaunchCmdFlags.addr, port),
-				xiter.Map(
-					func(upstream string) string {
-						return fmt.Sprintf("%s:%d", upstream, port)
-					},
-					slices.Values(loadbalancerLaunchCmdFlags.upstreams),
-				),
-			); err != nil {
-				return err
+		for _, addr := range loadbalancerLaunchCmdFlags.addrs {
+			for _, port := range loadbalancerLaunchCmdFlags.ports {
+				if err := lb.AddRoute(
+					net.JoinHostPort(addr, strconv.Itoa(port)),
+					xiter.Map(
+						func(upstream string) string {
+							return net.JoinHostPort(upstream, strconv.Itoa(port))
+						},
+						slices.Values(loadbalancerLaunchCmdFlags.upstreams),
+					),
+				); err != nil {
+					return err
+				}
 			}
 		}
 
@@ -59,7 +62,7 @@ func makeLogger() *zap.Logger {
 }
 
 func init() {
-	loadbalancerLaunchCmd.Flags().StringVar(&loadbalancerLaunchCmdFlags.addr, "loadbalancer-addr", "localhost", "load balancer listen address (IP or host)")
+	loadbalancerLaunchCmd.Flags().StringSliceVar(&loadbalancerLaunchCmdFlags.addrs, "loadbalancer-addr", []string{"localhost"}, "load balancer listen addresses (IP or host)")
 	loadbalancerLaunchCmd.Flags().IntSliceVar(&loadbalancerLaunchCmdFlags.ports, "loadbalancer-ports", []int{constants.DefaultControlPlanePort}, "load balancer ports")
 	loadbalancerLaunchCmd.Flags().StringSliceVar(&loadbalancerLaunchCmdFlags.upstreams, "loadbalancer-upstreams", []string{}, "load balancer upstreams (nodes to proxy to)")
 	addCommand(loadbalancerLaunchCmd)
@@ -15,8 +15,11 @@ import (
 )
 
 var patchCmdFlags struct {
-	patches []string
-	output  string
+	patches         []string
+	output          string
+	variables       []string
+	valuesFiles     []string
+	strictVariables bool
 }
 
 // patchCmd represents the `machineconfig patch` command.
@@ -30,7 +33,17 @@ var patchCmd = &cobra.Command{
 			return err
 		}
 
-		patches, err := configpatcher.LoadPatches(patchCmdFlags.patches)
+		vars, err := configpatcher.LoadVariables(patchCmdFlags.valuesFiles, patchCmdFlags.variables, patchCmdFlags.strictVariables)
+		if err != nil {
+			return err
+		}
+
+		patchStrings, err := vars.SubstitutePatches(patchCmdFlags.patches)
+		if err != nil {
+			return err
+		}
+
+		patches, err := configpatcher.LoadPatches(patchStrings)
 		if err != nil {
 			return err
 		}
@@ -68,6 +81,9 @@ func init() {
 	// use StringArrayVarP instead of StringSliceVarP to prevent cobra from splitting the patch string on commas
 	patchCmd.Flags().StringArrayVarP(&patchCmdFlags.patches, "patch", "p", nil, "patch generated machineconfigs (applied to all node types), use @file to read a patch from file")
 	patchCmd.Flags().StringVarP(&patchCmdFlags.output, "output", "o", "", "output destination. if not specified, output will be printed to stdout")
+	patchCmd.Flags().StringArrayVar(&patchCmdFlags.variables, "set", nil, "set a variable substituted as ${key} in the patches (key=value)")
+	patchCmd.Flags().StringArrayVar(&patchCmdFlags.valuesFiles, "values", nil, "read variables substituted in the patches from a YAML file")
+	patchCmd.Flags().BoolVar(&patchCmdFlags.strictVariables, "strict-variables", false, "fail on references to undefined variables in the patches")
 
 	Cmd.AddCommand(patchCmd)
 }
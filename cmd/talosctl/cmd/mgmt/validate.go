@@ -5,8 +5,11 @@
 package mgmt
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
 
+	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
@@ -19,6 +22,7 @@ var (
 	validateConfigArg string
 	validateModeArg   string
 	validateStrictArg bool
+	validateOutputArg string
 )
 
 // validateCmd reads in a userData file and attempts to parse it.
@@ -28,6 +32,10 @@ var validateCmd = &cobra.Command{
 	Long:  ``,
 	Args:  cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if validateOutputArg != "text" && validateOutputArg != "json" {
+			return fmt.Errorf("unsupported output format %q", validateOutputArg)
+		}
+
 		cfg, err := configloader.NewFromFile(validateConfigArg)
 		if err != nil {
 			return err
@@ -43,12 +51,37 @@ var validateCmd = &cobra.Command{
 			opts = append(opts, validation.WithStrict())
 		}
 
-		warnings, err := cfg.Validate(mode, opts...)
-		for _, w := range warnings {
-			cli.Warning("%s", w)
+		findings := cfg.ValidateFindings(mode, opts...)
+
+		if validateStrictArg {
+			for i := range findings {
+				findings[i].Severity = validation.SeverityError
+			}
 		}
-		if err != nil {
-			return err
+
+		if validateOutputArg == "json" {
+			if findings == nil {
+				findings = validation.Findings{}
+			}
+
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+
+			if err = enc.Encode(findings); err != nil {
+				return err
+			}
+
+			if findings.HasErrors() {
+				return fmt.Errorf("%s is not valid for %s mode", validateConfigArg, validateModeArg)
+			}
+
+			return nil
+		}
+
+		printFindings(findings)
+
+		if findings.HasErrors() {
+			return fmt.Errorf("%s is not valid for %s mode", validateConfigArg, validateModeArg)
 		}
 
 		fmt.Printf("%s is valid for %s mode\n", validateConfigArg, validateModeArg)
@@ -57,6 +90,37 @@ var validateCmd = &cobra.Command{
 	},
 }
 
+// printFindings prints findings grouped by severity.
+func printFindings(findings validation.Findings) {
+	for _, group := range []struct {
+		severity validation.Severity
+		title    string
+		colorize func(string, ...any) string
+	}{
+		{
+			severity: validation.SeverityError,
+			title:    "Errors",
+			colorize: color.RedString,
+		},
+		{
+			severity: validation.SeverityWarning,
+			title:    "Warnings",
+			colorize: color.YellowString,
+		},
+	} {
+		filtered := findings.Filter(group.severity)
+		if len(filtered) == 0 {
+			continue
+		}
+
+		fmt.Fprintln(os.Stderr, group.colorize("%s (%d):", group.title, len(filtered)))
+
+		for _, finding := range filtered {
+			fmt.Fprintln(os.Stderr, group.colorize("  * %s", finding))
+		}
+	}
+}
+
 func init() {
 	validateCmd.Flags().StringVarP(&validateConfigArg, "config", "c", "", "the path of the config file")
 	validateCmd.Flags().StringVarP(
@@ -68,5 +132,6 @@ func init() {
 	)
 	cli.Should(validateCmd.MarkFlagRequired("mode"))
 	validateCmd.Flags().BoolVarP(&validateStrictArg, "strict", "", false, "treat validation warnings as errors")
+	validateCmd.Flags().StringVarP(&validateOutputArg, "output", "o", "text", "output format (text, json)")
 	addCommand(validateCmd)
 }
@@ -0,0 +1,258 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/siderolabs/gen/xslices"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/siderolabs/talos/internal/pkg/secureboot/attestation"
+	"github.com/siderolabs/talos/internal/pkg/secureboot/eventlog"
+	"github.com/siderolabs/talos/pkg/cli"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+var attestCmdFlags struct {
+	nonce   string
+	pcrs    []int
+	pcrBank string
+	json    bool
+}
+
+// attestCmd represents the attest command.
+var attestCmd = &cobra.Command{
+	Use:   "attest",
+	Short: "Fetch the TPM quote of the node for the remote attestation.",
+	Long: `The command fetches the TPM quote of the PCR values over the nonce, signed by the attestation key of the node.
+
+The quote is verified against the nonce, the attestation key and the reported PCR values, and the PCR values
+are compared with the replayed measured boot event log.
+This is meant for manual checks: the attestation key is not verified to belong to the node,
+so an external verifier should compare it with the enrolled one.
+
+If the nonce is not specified, a random one is generated.
+Use --json to get the raw attestation for an external verifier.`,
+	Example: `talosctl attest --nodes 172.20.0.2 --nonce 8f6b0f5d3c3a0e1c2b4f7a9d6e5c4b3a`,
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		nonce, err := attestNonce()
+		if err != nil {
+			return err
+		}
+
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			var remotePeer peer.Peer
+
+			response, err := c.Attest(ctx, &machine.AttestRequest{
+				Nonce:   nonce,
+				Pcrs:    xslices.Map(attestCmdFlags.pcrs, func(pcr int) int32 { return int32(pcr) }),
+				PcrBank: attestCmdFlags.pcrBank,
+			}, grpc.Peer(&remotePeer))
+			if err != nil {
+				if response == nil {
+					return fmt.Errorf("error fetching the attestation: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			defaultNode := client.AddrFromPeer(&remotePeer)
+
+			for _, message := range response.Messages {
+				if attestCmdFlags.json {
+					b, err := protojson.Marshal(message)
+					if err != nil {
+						return err
+					}
+
+					fmt.Printf("%s\n", b)
+
+					continue
+				}
+
+				node := defaultNode
+
+				if message.Metadata != nil {
+					node = message.Metadata.Hostname
+				}
+
+				if err = printAttestation(node, nonce, message); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	},
+}
+
+func attestNonce() ([]byte, error) {
+	if attestCmdFlags.nonce == "" {
+		nonce := make([]byte, attestation.MaxNonceSize)
+
+		if _, err := rand.Read(nonce); err != nil {
+			return nil, err
+		}
+
+		return nonce, nil
+	}
+
+	nonce, err := hex.DecodeString(attestCmdFlags.nonce)
+	if err != nil {
+		return nil, fmt.Errorf("nonce should be hex-encoded: %w", err)
+	}
+
+	if len(nonce) == 0 || len(nonce) > attestation.MaxNonceSize {
+		return nil, fmt.Errorf("nonce should be 1 to %d bytes long", attestation.MaxNonceSize)
+	}
+
+	return nonce, nil
+}
+
+//nolint:gocyclo
+func printAttestation(node string, nonce []byte, message *machine.Attestation) error {
+	pcrBank, err := attestationPCRBank(message.GetPcrBank())
+	if err != nil {
+		return err
+	}
+
+	pcrs := make(map[int][]byte, len(message.GetPcrs()))
+
+	for _, pcr := range message.GetPcrs() {
+		pcrs[int(pcr.GetIndex())] = pcr.GetValue()
+	}
+
+	evidence := attestation.Evidence{
+		Quote:     message.GetQuote(),
+		Signature: message.GetSignature(),
+		AKPublic:  message.GetAkPublic(),
+		PCRBank:   pcrBank,
+		PCRs:      pcrs,
+	}
+
+	verification := "OK"
+
+	quote, err := evidence.Verify(nonce)
+	if err != nil {
+		verification = "FAILED: " + err.Error()
+
+		// show the quote contents anyway to help with troubleshooting
+		quote, _ = attestation.ParseQuote(message.GetQuote()) //nolint:errcheck
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintf(w, "NODE:\t%s\n", node)
+	fmt.Fprintf(w, "NONCE:\t%x\n", nonce)
+	fmt.Fprintf(w, "VERIFICATION:\t%s\n", verification)
+
+	if quote != nil {
+		fmt.Fprintf(w, "SIGNER:\t%x\n", quote.QualifiedSigner)
+		fmt.Fprintf(w, "CLOCK:\t%dms (resets %d, restarts %d, safe %v)\n",
+			quote.ClockInfo.Clock, quote.ClockInfo.ResetCount, quote.ClockInfo.RestartCount, quote.ClockInfo.Safe)
+		fmt.Fprintf(w, "FIRMWARE VERSION:\t0x%016x\n", quote.FirmwareVersion)
+		fmt.Fprintf(w, "PCR DIGEST:\t%x\n", quote.PCRDigest)
+	}
+
+	// the PCR values are compared with the event log replay, PCRs which are not in the event log are not measured by the firmware
+	var replayed map[int][]byte
+
+	eventLogStatus := "not available"
+
+	if len(message.GetEventLog()) > 0 {
+		log, err := eventlog.Parse(message.GetEventLog())
+		if err == nil {
+			replayed, err = log.Replay(eventlog.Algorithm(pcrBank))
+		}
+
+		if err != nil {
+			eventLogStatus = "invalid: " + err.Error()
+		} else {
+			eventLogStatus = fmt.Sprintf("%d events", len(log.Events))
+		}
+	}
+
+	fmt.Fprintf(w, "EVENT LOG:\t%s\n", eventLogStatus)
+	fmt.Fprintf(w, "PCR BANK:\t%s\n", message.GetPcrBank())
+
+	if err = w.Flush(); err != nil {
+		return err
+	}
+
+	w = tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, "PCR\tVALUE\tEVENT LOG")
+
+	for _, pcr := range message.GetPcrs() {
+		status := "-"
+
+		if value, ok := replayed[int(pcr.GetIndex())]; ok {
+			if bytes.Equal(value, pcr.GetValue()) {
+				status = "match"
+			} else {
+				status = "MISMATCH"
+			}
+		}
+
+		fmt.Fprintf(w, "%d\t%x\t%s\n", pcr.GetIndex(), pcr.GetValue(), status)
+	}
+
+	if err = w.Flush(); err != nil {
+		return err
+	}
+
+	if ak, err := attestation.ParsePublic(message.GetAkPublic()); err == nil {
+		if key, err := ak.PublicKey(); err == nil {
+			der, err := x509.MarshalPKIXPublicKey(key)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("ATTESTATION KEY (%s):\n%s", ak.Type, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+		}
+	}
+
+	fmt.Println()
+
+	return nil
+}
+
+func attestationPCRBank(bank string) (attestation.Algorithm, error) {
+	for _, alg := range []attestation.Algorithm{
+		attestation.AlgorithmSHA1,
+		attestation.AlgorithmSHA256,
+		attestation.AlgorithmSHA384,
+		attestation.AlgorithmSHA512,
+	} {
+		if alg.String() == bank {
+			return alg, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unsupported PCR bank %q", bank)
+}
+
+func init() {
+	attestCmd.Flags().StringVar(&attestCmdFlags.nonce, "nonce", "", "hex-encoded nonce to include into the quote, up to 32 bytes, random if not specified")
+	attestCmd.Flags().IntSliceVar(&attestCmdFlags.pcrs, "pcrs", nil, "PCRs to quote, defaults to 0-7 and 11")
+	attestCmd.Flags().StringVar(&attestCmdFlags.pcrBank, "pcr-bank", "", "PCR bank to quote: sha1, sha256, sha384 or sha512, defaults to sha256")
+	attestCmd.Flags().BoolVar(&attestCmdFlags.json, "json", false, "print the raw attestation as JSON")
+
+	addCommand(attestCmd)
+}
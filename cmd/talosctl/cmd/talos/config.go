@@ -22,6 +22,7 @@ import (
 
 	"github.com/dustin/go-humanize"
 	"github.com/ryanuber/go-glob"
+	talosx509 "github.com/siderolabs/crypto/x509"
 	"github.com/siderolabs/gen/maps"
 	"github.com/spf13/cobra"
 	"google.golang.org/protobuf/types/known/durationpb"
@@ -31,6 +32,11 @@ import (
 	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/client"
 	clientconfig "github.com/siderolabs/talos/pkg/machinery/client/config"
+	configconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/security"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/role"
 )
@@ -391,8 +397,10 @@ var configMergeCmd = &cobra.Command{
 
 // configNewCmdFlags represents the `config new` command flags.
 var configNewCmdFlags struct {
-	roles  []string
-	crtTTL time.Duration
+	roles            []string
+	crtTTL           time.Duration
+	recovery         bool
+	certFingerprints []string
 }
 
 // configNewCmd represents the `config new` command.
@@ -407,12 +415,15 @@ var configNewCmd = &cobra.Command{
 
 		path := args[0]
 
-		return WithClient(func(ctx context.Context, c *client.Client) error {
+		fn := func(ctx context.Context, c *client.Client) error {
 			if err := helpers.FailIfMultiNodes(ctx, "talosconfig"); err != nil {
 				return err
 			}
 
 			roles, unknownRoles := role.Parse(configNewCmdFlags.roles)
+			// custom roles are granted permissions by the API authorization policy
+			unknownRoles = slices.DeleteFunc(unknownRoles, func(r string) bool { return role.Role(r).IsCustom() })
+
 			if len(unknownRoles) != 0 {
 				return fmt.Errorf("unknown roles: %s", strings.Join(unknownRoles, ", "))
 			}
@@ -438,11 +449,22 @@ var configNewCmd = &cobra.Command{
 				return err
 			}
 
-			// make the new config immediately useful
-			config.Contexts[config.Context].Endpoints = c.GetEndpoints()
+			if configNewCmdFlags.recovery {
+				// the recovery service endpoint can't be used to access the Talos API
+				fmt.Fprintln(os.Stderr, "client configuration recovered, set the endpoints with 'talosctl config endpoint'")
+			} else {
+				// make the new config immediately useful
+				config.Contexts[config.Context].Endpoints = c.GetEndpoints()
+			}
 
 			return config.Save(path)
-		})
+		}
+
+		if configNewCmdFlags.recovery {
+			return WithClientMaintenance(configNewCmdFlags.certFingerprints, fn)
+		}
+
+		return WithClient(fn)
 	},
 }
 
@@ -579,6 +601,241 @@ var configInfoCmd = &cobra.Command{
 	},
 }
 
+var configRevokeCertCmdFlags struct {
+	file          string
+	fingerprints  []string
+	serialNumbers []string
+	certs         []string
+	fromContexts  []string
+	comment       string
+	remove        bool
+}
+
+// configRevokeCertCmd represents the `config revoke-cert` command.
+var configRevokeCertCmd = &cobra.Command{
+	Use:   "revoke-cert",
+	Short: "Revoke Talos API client certificates in the certificate revocation config document",
+	Long: `The command maintains the CertificateRevocationConfig machine configuration document:
+the revoked certificates are added to the document (or removed from it with --remove).
+
+The certificates can be specified by the SPKI fingerprint, the serial number, the certificate file,
+or the name of the talosconfig context which holds the certificate.
+
+The document is read from and written back to the file specified with --file (printed to stdout otherwise),
+and it should be applied to all machines of the cluster, e.g. with 'talosctl patch machineconfig --patch @<file>'.`,
+	Example: `talosctl config revoke-cert --from-context lost-laptop --comment "lost laptop" --file revocation.yaml
+talosctl config revoke-cert --serial 6c:8f:0b:5a:1d:ee:25:41:78:b7:fa:73:0d:3e:92:1c --file revocation.yaml`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		entries, err := configRevokeCertEntries()
+		if err != nil {
+			return err
+		}
+
+		if len(entries) == 0 {
+			return errors.New("no certificates specified, use --fingerprint, --serial, --cert or --from-context")
+		}
+
+		var existing []byte
+
+		if configRevokeCertCmdFlags.file != "" {
+			existing, err = os.ReadFile(configRevokeCertCmdFlags.file)
+			if err != nil && !errors.Is(err, os.ErrNotExist) {
+				return fmt.Errorf("error reading %q: %w", configRevokeCertCmdFlags.file, err)
+			}
+		}
+
+		out, err := updateCertificateRevocationConfig(existing, entries, configRevokeCertCmdFlags.remove)
+		if err != nil {
+			return err
+		}
+
+		if configRevokeCertCmdFlags.file == "" {
+			_, err = os.Stdout.Write(out)
+
+			return err
+		}
+
+		return os.WriteFile(configRevokeCertCmdFlags.file, out, 0o644)
+	},
+}
+
+// configRevokeCertEntries builds the revoked certificate entries from the command flags.
+func configRevokeCertEntries() ([]security.RevokedCertificate, error) {
+	var entries []security.RevokedCertificate
+
+	for _, fingerprint := range configRevokeCertCmdFlags.fingerprints {
+		parsed, err := talosx509.ParseFingerprint(fingerprint)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing certificate fingerprint %q: %w", fingerprint, err)
+		}
+
+		entries = append(entries, security.RevokedCertificate{
+			RevokedFingerprint: parsed.String(),
+			RevokedComment:     configRevokeCertCmdFlags.comment,
+		})
+	}
+
+	for _, serialNumber := range configRevokeCertCmdFlags.serialNumbers {
+		parsed, err := security.ParseSerialNumber(serialNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, security.RevokedCertificate{
+			RevokedSerialNumber: parsed.Text(16),
+			RevokedComment:      configRevokeCertCmdFlags.comment,
+		})
+	}
+
+	for _, path := range configRevokeCertCmdFlags.certs {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("error reading certificate: %w", err)
+		}
+
+		entry, err := revokedCertificateEntry(data, configRevokeCertCmdFlags.comment)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing certificate %q: %w", path, err)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	if len(configRevokeCertCmdFlags.fromContexts) > 0 {
+		c, err := clientconfig.Open(GlobalArgs.Talosconfig)
+		if err != nil {
+			return nil, fmt.Errorf("error reading config: %w", err)
+		}
+
+		for _, name := range configRevokeCertCmdFlags.fromContexts {
+			cfgContext, ok := c.Contexts[name]
+			if !ok {
+				return nil, fmt.Errorf("context %q is not defined", name)
+			}
+
+			data, err := base64.StdEncoding.DecodeString(cfgContext.Crt)
+			if err != nil {
+				return nil, fmt.Errorf("error decoding certificate of context %q: %w", name, err)
+			}
+
+			entry, err := revokedCertificateEntry(data, configRevokeCertCmdFlags.comment)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing certificate of context %q: %w", name, err)
+			}
+
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries, nil
+}
+
+// revokedCertificateEntry builds the revoked certificate entry from the PEM-encoded certificate.
+//
+// The entry revokes both the certificate (by the serial number) and its key (by the fingerprint).
+func revokedCertificateEntry(data []byte, comment string) (security.RevokedCertificate, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return security.RevokedCertificate{}, errors.New("error decoding PEM")
+	}
+
+	crt, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return security.RevokedCertificate{}, err
+	}
+
+	if comment == "" {
+		comment = crt.Subject.String()
+	}
+
+	return security.RevokedCertificate{
+		RevokedFingerprint:  talosx509.SPKIFingerprint(crt).String(),
+		RevokedSerialNumber: crt.SerialNumber.Text(16),
+		RevokedComment:      comment,
+	}, nil
+}
+
+// updateCertificateRevocationConfig adds (or removes) the entries to the CertificateRevocationConfig document.
+//
+// The other documents are preserved, the document is created if it doesn't exist.
+//
+//nolint:gocyclo
+func updateCertificateRevocationConfig(existing []byte, entries []security.RevokedCertificate, remove bool) ([]byte, error) {
+	var docs []configconfig.Document
+
+	if len(bytes.TrimSpace(existing)) > 0 {
+		cfg, err := configloader.NewFromBytes(existing)
+		if err != nil {
+			return nil, fmt.Errorf("error loading certificate revocation config: %w", err)
+		}
+
+		docs = cfg.Documents()
+	}
+
+	var revocation *security.CertificateRevocationConfigV1Alpha1
+
+	for _, doc := range docs {
+		if d, ok := doc.(*security.CertificateRevocationConfigV1Alpha1); ok {
+			revocation = d
+		}
+	}
+
+	if revocation == nil {
+		revocation = security.NewCertificateRevocationConfigV1Alpha1()
+		docs = append(docs, revocation)
+	}
+
+	for _, entry := range entries {
+		index := slices.IndexFunc(revocation.RevocationRevokedCertificates, func(existing security.RevokedCertificate) bool {
+			return sameRevokedCertificate(existing, entry)
+		})
+
+		switch {
+		case remove:
+			revocation.RevocationRevokedCertificates = slices.DeleteFunc(revocation.RevocationRevokedCertificates, func(existing security.RevokedCertificate) bool {
+				return sameRevokedCertificate(existing, entry)
+			})
+		case index == -1:
+			revocation.RevocationRevokedCertificates = append(revocation.RevocationRevokedCertificates, entry)
+		}
+	}
+
+	if _, err := revocation.Validate(nil); err != nil {
+		return nil, fmt.Errorf("invalid certificate revocation config: %w", err)
+	}
+
+	cfg, err := container.New(docs...)
+	if err != nil {
+		return nil, err
+	}
+
+	return cfg.EncodeBytes(encoder.WithComments(encoder.CommentsDisabled))
+}
+
+// sameRevokedCertificate returns true if the entries revoke the same fingerprint or serial number.
+func sameRevokedCertificate(a, b security.RevokedCertificate) bool {
+	if a.RevokedFingerprint != "" && b.RevokedFingerprint != "" {
+		fingerprintA, errA := talosx509.ParseFingerprint(a.RevokedFingerprint)
+		fingerprintB, errB := talosx509.ParseFingerprint(b.RevokedFingerprint)
+
+		if errA == nil && errB == nil && fingerprintA.String() == fingerprintB.String() {
+			return true
+		}
+	}
+
+	if a.RevokedSerialNumber != "" && b.RevokedSerialNumber != "" {
+		serialA, errA := security.ParseSerialNumber(a.RevokedSerialNumber)
+		serialB, errB := security.ParseSerialNumber(b.RevokedSerialNumber)
+
+		if errA == nil && errB == nil && serialA.Cmp(serialB) == 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
 // CompleteConfigContext represents tab completion for `--context`
 // argument and `config [context|remove]` command.
 func CompleteConfigContext(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
@@ -604,6 +861,7 @@ func init() {
 		configMergeCmd,
 		configNewCmd,
 		configInfoCmd,
+		configRevokeCertCmd,
 	)
 
 	configAddCmd.Flags().StringVar(&configAddCmdFlags.ca, "ca", "", "the path to the CA certificate")
@@ -620,9 +878,21 @@ func init() {
 
 	configNewCmd.Flags().StringSliceVar(&configNewCmdFlags.roles, "roles", role.MakeSet(role.Admin).Strings(), "roles")
 	configNewCmd.Flags().DurationVar(&configNewCmdFlags.crtTTL, "crt-ttl", constants.TalosAPIDefaultCertificateValidityDuration, "certificate TTL")
+	configNewCmd.Flags().BoolVar(&configNewCmdFlags.recovery, "recovery", false,
+		fmt.Sprintf("recover the client configuration from the credential recovery service (link-local address, port %d)", constants.RecoveryPort))
+	configNewCmd.Flags().StringSliceVar(&configNewCmdFlags.certFingerprints, "cert-fingerprint", nil,
+		"list of server certificate fingerprints to accept in recovery mode (defaults to no check)")
 
 	configInfoCmd.Flags().StringVarP(&configInfoCmdFlags.output, "output", "o", "text", "output format (json|yaml|text). Default text.")
 
+	configRevokeCertCmd.Flags().StringVarP(&configRevokeCertCmdFlags.file, "file", "f", "", "certificate revocation config document to update (created if it doesn't exist), printed to stdout if not set")
+	configRevokeCertCmd.Flags().StringSliceVar(&configRevokeCertCmdFlags.fingerprints, "fingerprint", nil, "SPKI fingerprints of the certificates to revoke")
+	configRevokeCertCmd.Flags().StringSliceVar(&configRevokeCertCmdFlags.serialNumbers, "serial", nil, "serial numbers (hexadecimal) of the certificates to revoke")
+	configRevokeCertCmd.Flags().StringSliceVar(&configRevokeCertCmdFlags.certs, "cert", nil, "paths to the PEM-encoded certificates to revoke")
+	configRevokeCertCmd.Flags().StringSliceVar(&configRevokeCertCmdFlags.fromContexts, "from-context", nil, "names of the talosconfig contexts to revoke the certificates of")
+	configRevokeCertCmd.Flags().StringVar(&configRevokeCertCmdFlags.comment, "comment", "", "comment for the revoked certificates (defaults to the certificate subject)")
+	configRevokeCertCmd.Flags().BoolVar(&configRevokeCertCmdFlags.remove, "remove", false, "remove the certificates from the document instead of revoking them")
+
 	addCommand(configCmd)
 }
 
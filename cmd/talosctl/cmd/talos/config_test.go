@@ -5,14 +5,18 @@
 package talos //nolint:testpackage // to test unexported function
 
 import (
+	"math/big"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/siderolabs/crypto/x509"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	clientconfig "github.com/siderolabs/talos/pkg/machinery/client/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/security"
 )
 
 func TestConfigInfoCommand(t *testing.T) {
@@ -102,3 +106,55 @@ Certificate expires: 10 years from now (2031-07-03)
 		})
 	}
 }
+
+func TestUpdateCertificateRevocationConfig(t *testing.T) {
+	t.Parallel()
+
+	ca, err := x509.NewSelfSignedCertificateAuthority()
+	require.NoError(t, err)
+
+	entry, err := revokedCertificateEntry(ca.CrtPEM, "")
+	require.NoError(t, err)
+
+	assert.Equal(t, x509.SPKIFingerprint(ca.Crt).String(), entry.RevokedFingerprint)
+	assert.Equal(t, ca.Crt.SerialNumber.Text(16), entry.RevokedSerialNumber)
+	assert.Equal(t, ca.Crt.Subject.String(), entry.RevokedComment)
+
+	existing := []byte(`apiVersion: v1alpha1
+kind: TrustedRootsConfig
+name: custom-ca
+certificates: ""
+`)
+
+	out, err := updateCertificateRevocationConfig(existing, []security.RevokedCertificate{entry}, false)
+	require.NoError(t, err)
+
+	// revoking the same certificate twice is a no-op
+	out, err = updateCertificateRevocationConfig(out, []security.RevokedCertificate{entry, {RevokedSerialNumber: "0a:0b"}}, false)
+	require.NoError(t, err)
+
+	cfg, err := configloader.NewFromBytes(out)
+	require.NoError(t, err)
+
+	require.Len(t, cfg.Documents(), 2)
+	assert.Equal(t, "TrustedRootsConfig", cfg.Documents()[0].Kind())
+
+	revocation := cfg.CertificateRevocationConfig()
+	require.NotNil(t, revocation)
+
+	assert.Equal(t, []string{entry.RevokedFingerprint}, revocation.RevokedFingerprints())
+	assert.Len(t, revocation.RevokedSerialNumbers(), 2)
+
+	// remove by the serial number only
+	out, err = updateCertificateRevocationConfig(out, []security.RevokedCertificate{{RevokedSerialNumber: entry.RevokedSerialNumber}}, true)
+	require.NoError(t, err)
+
+	cfg, err = configloader.NewFromBytes(out)
+	require.NoError(t, err)
+
+	revocation = cfg.CertificateRevocationConfig()
+	require.NotNil(t, revocation)
+
+	assert.Empty(t, revocation.RevokedFingerprints())
+	assert.Equal(t, []*big.Int{big.NewInt(0x0a0b)}, revocation.RevokedSerialNumbers())
+}
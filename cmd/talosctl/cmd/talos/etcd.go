@@ -7,17 +7,22 @@ package talos
 import (
 	"context"
 	"crypto/sha256"
+	"errors"
 	"fmt"
 	"io"
 	"os"
 	"slices"
 	"strings"
 	"text/tabwriter"
+	"time"
 
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/dustin/go-humanize"
 	"github.com/siderolabs/gen/xslices"
 	"github.com/spf13/cobra"
 	snapshot "go.etcd.io/etcd/etcdutl/v3/snapshot"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
 	"github.com/siderolabs/talos/pkg/cli"
@@ -186,6 +191,26 @@ Always prefer etcd leave over this command.`,
 	},
 }
 
+var etcdPromoteCmd = &cobra.Command{
+	Use:   "promote <member ID>",
+	Short: "Promote the learner member to a voting member of etcd cluster",
+	Long: `Learners are promoted automatically once they catch up with the voting members.
+Use this command only if the learner is not promoted according to the promotion policy.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			memberID, err := etcdresource.ParseMemberID(args[0])
+			if err != nil {
+				return fmt.Errorf("error parsing member ID: %w", err)
+			}
+
+			return c.EtcdPromoteMember(ctx, &machine.EtcdPromoteMemberRequest{
+				MemberId: memberID,
+			})
+		})
+	},
+}
+
 var etcdForfeitLeadershipCmd = &cobra.Command{
 	Use:   "forfeit-leadership",
 	Short: "Tell node to forfeit etcd cluster leadership",
@@ -326,6 +351,93 @@ var etcdStatusCmd = &cobra.Command{
 	},
 }
 
+var etcdSnapshotStatusCmd = &cobra.Command{
+	Use:   "snapshot-status",
+	Short: "Get the status of the scheduled etcd snapshots",
+	Long:  `Returns the status of the last scheduled snapshot of etcd member on the node, use multiple nodes to get status of all members.`,
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			md, _ := metadata.FromOutgoingContext(ctx)
+			nodes := md.Get("nodes")
+
+			if len(nodes) == 0 {
+				nodes = []string{""}
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			pattern := "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n"
+			header := "MEMBER\tLAST RUN\tNEXT RUN\tSIZE\tDURATION\tSHA256\tUPLOAD\tERROR"
+
+			if nodes[0] != "" {
+				header = "NODE\t" + header
+				pattern = "%s\t" + pattern
+			}
+
+			fmt.Fprintln(w, header)
+
+			for _, node := range nodes {
+				nodeCtx := ctx
+
+				if node != "" {
+					nodeCtx = client.WithNode(ctx, node)
+				}
+
+				status, err := safe.StateGetByID[*etcdresource.SnapshotStatus](nodeCtx, c.COSI, etcdresource.LocalMemberID)
+				if err != nil {
+					if state.IsNotFoundError(err) {
+						err = errors.New("scheduled snapshots are not enabled")
+					}
+
+					cli.Warning("%s: %s", node, err)
+
+					continue
+				}
+
+				spec := status.TypedSpec()
+
+				var lastRun, nextRun string
+
+				if !spec.LastRun.IsZero() {
+					lastRun = spec.LastRun.Format(time.RFC3339)
+				}
+
+				if !spec.NextRun.IsZero() {
+					nextRun = spec.NextRun.Format(time.RFC3339)
+				}
+
+				upload := spec.UploadLocation
+				if spec.UploadError != "" {
+					upload = "error: " + spec.UploadError
+				}
+
+				lastError := spec.LastError
+				if lastError == "" && spec.SkipReason != "" {
+					lastError = "skipped: " + spec.SkipReason
+				}
+
+				args := []any{
+					spec.MemberID,
+					lastRun,
+					nextRun,
+					humanize.Bytes(uint64(spec.Size)),
+					spec.Duration.Round(time.Millisecond),
+					spec.SHA256,
+					upload,
+					lastError,
+				}
+				if node != "" {
+					args = slices.Insert(args, 0, any(node))
+				}
+
+				fmt.Fprintf(w, pattern, args...)
+			}
+
+			return w.Flush()
+		})
+	},
+}
+
 var etcdSnapshotCmd = &cobra.Command{
 	Use:   "snapshot <path>",
 	Short: "Stream snapshot of the etcd node to the path.",
@@ -585,7 +697,9 @@ func init() {
 		etcdLeaveCmd,
 		etcdMemberListCmd,
 		etcdMemberRemoveCmd,
+		etcdPromoteCmd,
 		etcdSnapshotCmd,
+		etcdSnapshotStatusCmd,
 		etcdStatusCmd,
 		etcdDowngradeCmd,
 	)
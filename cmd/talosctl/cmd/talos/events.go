@@ -26,6 +26,8 @@ var eventsCmdFlags struct {
 	tailDuration time.Duration
 	tailID       string
 	actorID      string
+	sinceBoot    int32
+	replay       bool
 }
 
 // eventsCmd represents the events command.
@@ -56,11 +58,23 @@ var eventsCmd = &cobra.Command{
 				opts = append(opts, client.WithActorID(eventsCmdFlags.actorID))
 			}
 
+			if eventsCmdFlags.sinceBoot != 0 {
+				opts = append(opts, client.WithSinceBoot(eventsCmdFlags.sinceBoot))
+			}
+
+			if eventsCmdFlags.replay {
+				opts = append(opts, client.WithReplayPersisted())
+			}
+
 			events, err := c.Events(ctx, opts...)
 			if err != nil {
 				return err
 			}
 
+			// separate the events of different boots when replaying the persisted events
+			replaying := eventsCmdFlags.sinceBoot != 0 || eventsCmdFlags.replay
+			lastBootID := map[string]string{}
+
 			return helpers.ReadGRPCStream(events, func(ev *machine.Event, node string, multipleNodes bool) error {
 				format := "%s\t%s\t%s\n%s\t%s\t%s\n"
 
@@ -95,6 +109,46 @@ var eventsCmd = &cobra.Command{
 					args = []any{"error", msg.GetError()}
 				case *machine.AddressEvent:
 					args = []any{msg.GetHostname(), fmt.Sprintf("ADDRESSES: %s", strings.Join(msg.GetAddresses(), ","))}
+				case *machine.VIPEvent:
+					args = []any{msg.GetIp(), fmt.Sprintf("LINK: %s, OWNER: %s (priority %d), OWNED: %v", msg.GetLink(), msg.GetOwner(), msg.GetOwnerPriority(), msg.GetOwned())}
+				case *machine.StaticFallbackEvent:
+					args = []any{msg.GetLink(), fmt.Sprintf("MODE: %s, GATEWAY: %s, REACHABLE: %v", msg.GetMode(), msg.GetGateway(), msg.GetGatewayReachable())}
+				case *machine.ProbeEvent:
+					args = []any{msg.GetId(), fmt.Sprintf("SUCCESS: %v, FAILURES: %d, ERROR: %s", msg.GetSuccess(), msg.GetConsecutiveFailures(), msg.GetLastError())}
+				case *machine.EtcdDefragmentationEvent:
+					args = []any{msg.GetMemberId(), fmt.Sprintf("DURATION: %s, SIZE: %d -> %d, ERROR: %s", msg.GetDuration().AsDuration(), msg.GetDbSizeBefore(), msg.GetDbSizeAfter(), msg.GetError())}
+				case *machine.EtcdLearnerStuckEvent:
+					args = []any{msg.GetMemberId(), fmt.Sprintf("LAG: %d, LEARNER FOR: %s", msg.GetLag(), msg.GetLearnerFor().AsDuration())}
+				case *machine.EtcdSnapshotEvent:
+					args = []any{msg.GetMemberId(), fmt.Sprintf("PATH: %s, SIZE: %d, UPLOAD: %s, ERROR: %s, UPLOAD ERROR: %s", msg.GetPath(), msg.GetSize(), msg.GetUploadLocation(), msg.GetError(), msg.GetUploadError())}
+				case *machine.EtcdAlarmEvent:
+					args = []any{msg.GetMemberId(), fmt.Sprintf("ALARM: %s, ACTIVE: %v", msg.GetAlarm(), msg.GetActive())}
+				case *machine.CertificateExpiryEvent:
+					args = []any{msg.GetId(), fmt.Sprintf("COMPONENT: %s, NOT AFTER: %s, DAYS REMAINING: %d, THRESHOLD: %d", msg.GetComponent(), msg.GetNotAfter().AsTime(), msg.GetDaysRemaining(), msg.GetThreshold())}
+				case *machine.StaticPodRestartStormEvent:
+					args = []any{msg.GetId(), fmt.Sprintf("POD: %s, RESTARTS: %d, RECENT RESTARTS: %d, LAST EXIT CODE: %d, LAST EXIT REASON: %s", msg.GetPodName(), msg.GetRestartCount(), msg.GetRecentRestarts(), msg.GetLastExitCode(), msg.GetLastExitReason())}
+				case *machine.KubeletShutdownEvent:
+					args = []any{"kubelet", fmt.Sprintf("GRACE PERIOD: %s, WAITED: %s, TIMED OUT: %v, ERROR: %s", msg.GetGracePeriod().AsDuration(), msg.GetWaitDuration().AsDuration(), msg.GetTimedOut(), msg.GetError())}
+				case *machine.ShutdownImminentEvent:
+					args = []any{msg.GetSequence(), fmt.Sprintf("INHIBITORS: %v, MAX WAIT: %s", msg.GetInhibitors(), msg.GetMaxWait().AsDuration())}
+				case *machine.UpgradeEvent:
+					args = []any{msg.GetImage(), fmt.Sprintf("FROM: %s, TO: %s, PHASE: %s, OUTCOME: %s, MESSAGE: %s", msg.GetFromVersion(), msg.GetToVersion(), msg.GetPhase(), msg.GetOutcome(), msg.GetMessage())}
+				case *machine.ZombieProcessesEvent:
+					args = []any{"zombies", fmt.Sprintf("ZOMBIES: %d, THRESHOLD: %d, PARENTS: %s", msg.GetZombies(), msg.GetThreshold(), strings.Join(msg.GetParentCommands(), ", "))}
+				case *machine.CertificateSigningEvent:
+					args = []any{msg.GetSource(), fmt.Sprintf("DECISION: %s, SUBJECT: %s, DNS NAMES: %s, ADDRESSES: %s, REASON: %s", msg.GetDecision(), msg.GetSubject(), strings.Join(msg.GetDnsNames(), ","), strings.Join(msg.GetIpAddresses(), ","), msg.GetReason())}
+				case *machine.APIAuthorizationDeniedEvent:
+					args = []any{msg.GetMethod(), fmt.Sprintf("DENIED, ROLES: %s", strings.Join(msg.GetRoles(), ","))}
+				case *machine.SecretsBundleExportEvent:
+					args = []any{"secrets-bundle", fmt.Sprintf("EXPORTED, ROLES: %s", strings.Join(msg.GetRoles(), ","))}
+				case *machine.JoinTokenEvent:
+					args = []any{msg.GetName(), fmt.Sprintf("JOIN TOKEN %s, SOURCE: %s", msg.GetAction(), msg.GetSource())}
+				case *machine.IdentityConflictEvent:
+					args = []any{msg.GetNodeId(), fmt.Sprintf("IDENTITY CONFLICT, HOSTNAME: %s, ADDRESSES: %s", msg.GetHostname(), strings.Join(msg.GetAddresses(), ","))}
+				case *machine.CertificateRevokedEvent:
+					args = []any{msg.GetSource(), fmt.Sprintf("REVOKED, SUBJECT: %s, SERIAL: %s, FINGERPRINT: %s, METHOD: %s, REASON: %s", msg.GetSubject(), msg.GetSerialNumber(), msg.GetFingerprint(), msg.GetMethod(), msg.GetReason())}
+				case *machine.DiskKeyRotationEvent:
+					args = []any{msg.GetVolumeId(), fmt.Sprintf("SLOT: %d, STEP: %s, EPOCH: %d, ERROR: %s", msg.GetSlot(), msg.GetStep(), msg.GetEpoch(), msg.GetError())}
 				case *machine.MachineStatusEvent:
 					args = []any{
 						msg.GetStage().String(),
@@ -109,6 +163,12 @@ var eventsCmd = &cobra.Command{
 					}
 				}
 
+				if replaying && event.BootID != "" && lastBootID[event.Node] != event.BootID {
+					fmt.Fprintf(w, "%s\t--- boot %s ---\n", event.Node, event.BootID)
+
+					lastBootID[event.Node] = event.BootID
+				}
+
 				args = append([]any{event.Node, event.ID, event.TypeURL, event.ActorID}, args...)
 				fmt.Fprintf(w, format, args...)
 
@@ -124,4 +184,6 @@ func init() {
 	eventsCmd.Flags().DurationVar(&eventsCmdFlags.tailDuration, "duration", 0, "show events for the past duration interval (one second resolution, default is to show no history)")
 	eventsCmd.Flags().StringVar(&eventsCmdFlags.tailID, "since", "", "show events after the specified event ID (default is to show no history)")
 	eventsCmd.Flags().StringVar(&eventsCmdFlags.actorID, "actor-id", "", "filter events by the specified actor ID (default is no filter)")
+	eventsCmd.Flags().Int32Var(&eventsCmdFlags.sinceBoot, "since-boot", 0, "replay persisted events starting with the specified boot relative to the current one (e.g. -1 for the previous boot)")
+	eventsCmd.Flags().BoolVar(&eventsCmdFlags.replay, "replay-persisted", false, "replay all events persisted during the previous boots")
 }
@@ -7,6 +7,11 @@ package talos
 import (
 	"context"
 	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
@@ -29,6 +34,8 @@ var getCmdFlags struct {
 	namespace string
 	output    string
 	watch     bool
+	history   bool
+	diff      string
 }
 
 // getCmd represents the get (resources) command.
@@ -38,8 +45,13 @@ var getCmd = &cobra.Command{
 	SuggestFor: []string{},
 	Short:      "Get a specific resource or list of resources (use 'talosctl get rd' to see all available resource types).",
 	Long: `Similar to 'kubectl get', 'talosctl get' returns a set of resources from the OS.
-To get a list of all available resource definitions, issue 'talosctl get rd'`,
-	Example: "",
+To get a list of all available resource definitions, issue 'talosctl get rd'
+
+With --history flag, the revisions of the resource recorded by the node are listed,
+and --diff flag prints the diff between two recorded revisions.
+The revision history is recorded for the resource types configured with the ResourceHistoryConfig document.`,
+	Example: `talosctl get machineconfig --history
+talosctl get machineconfig --diff 3,4`,
 	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		switch len(args) {
 		case 0:
@@ -67,6 +79,10 @@ func getResources(args []string) func(ctx context.Context, c *client.Client) err
 			return err
 		}
 
+		if getCmdFlags.history || getCmdFlags.diff != "" {
+			return getResourceHistory(ctx, c, args)
+		}
+
 		out, err := output.NewWriter(getCmdFlags.output)
 		if err != nil {
 			return err
@@ -103,60 +119,46 @@ func getResources(args []string) func(ctx context.Context, c *client.Client) err
 				return err
 			}
 
-			aggregatedCh := make(chan nodeAndEvent)
-
-			for _, node := range nodes {
-				var nodeCtx context.Context
-
-				if node == "" {
-					nodeCtx = ctx
-				} else {
-					nodeCtx = client.WithNode(ctx, node)
-				}
-
-				watchCh := make(chan state.Event)
-
-				if resourceID == "" {
-					err = c.COSI.WatchKind(
-						nodeCtx,
-						resource.NewMetadata(getCmdFlags.namespace, resourceType, "", resource.VersionUndefined),
-						watchCh,
-						state.WithBootstrapContents(true),
-						state.WithWatchKindUnmarshalOptions(state.WithSkipProtobufUnmarshal()),
-					)
-				} else {
-					err = c.COSI.Watch(
-						nodeCtx,
-						resource.NewMetadata(getCmdFlags.namespace, resourceType, resourceID, resource.VersionUndefined),
-						watchCh,
-						state.WithWatchUnmarshalOptions(state.WithSkipProtobufUnmarshal()),
-					)
-				}
-
-				if err != nil {
-					return fmt.Errorf("error setting up watch on node %s: %w", node, err)
-				}
-
-				go aggregateEvents(ctx, aggregatedCh, watchCh, node)
+			eventCh, err := c.COSI.WatchAggregated(ctx, nodes, resourceType,
+				client.WithAggregatedNamespace(getCmdFlags.namespace),
+				client.WithAggregatedID(resourceID),
+				client.WithAggregatedUnmarshalOptions(state.WithSkipProtobufUnmarshal()),
+			)
+			if err != nil {
+				return fmt.Errorf("error setting up watch: %w", err)
 			}
 
-			var bootstrapped bool
+			bootstrapped := map[string]bool{}
+			established := map[string]bool{}
 
 			for {
-				var nev nodeAndEvent
+				var ev client.AggregatedEvent
 
 				select {
-				case nev = <-aggregatedCh:
+				case ev = <-eventCh:
 				case <-ctx.Done():
 					return nil
 				}
 
-				if nev.ev.Type == state.Errored {
-					return fmt.Errorf("error watching resource: %w", nev.ev.Error)
+				if ev.Dropped > 0 {
+					fmt.Fprintf(os.Stderr, "%s: %d events dropped\n", ev.Node, ev.Dropped)
 				}
 
-				if nev.ev.Type == state.Bootstrapped {
-					bootstrapped = true
+				if ev.Type == state.Errored {
+					if !established[ev.Node] {
+						return fmt.Errorf("error watching resource on node %s: %w", ev.Node, ev.Error)
+					}
+
+					// the watch is re-established in the background
+					fmt.Fprintf(os.Stderr, "%s: error watching resource, reconnecting: %s\n", ev.Node, ev.Error)
+
+					continue
+				}
+
+				established[ev.Node] = true
+
+				if ev.Type == state.Bootstrapped {
+					bootstrapped[ev.Node] = true
 
 					if err = out.Flush(); err != nil {
 						return err
@@ -165,16 +167,16 @@ func getResources(args []string) func(ctx context.Context, c *client.Client) err
 					continue
 				}
 
-				if nev.ev.Resource == nil {
+				if ev.Resource == nil {
 					// new event type without resource, skip it
 					continue
 				}
 
-				if err = out.WriteResource(nev.node, nev.ev.Resource, nev.ev.Type); err != nil {
+				if err = out.WriteResource(ev.Node, ev.Resource, ev.Type); err != nil {
 					return err
 				}
 
-				if bootstrapped {
+				if bootstrapped[ev.Node] {
 					if err = out.Flush(); err != nil {
 						return err
 					}
@@ -209,24 +211,115 @@ func getResources(args []string) func(ctx context.Context, c *client.Client) err
 	}
 }
 
-type nodeAndEvent struct {
-	node string
-	ev   state.Event
-}
+// getResourceHistory prints the recorded revisions of the resource, or the diff between two revisions.
+//
+//nolint:gocyclo
+func getResourceHistory(ctx context.Context, c *client.Client, args []string) error {
+	var (
+		resourceID string
+		from, to   uint64
+	)
+
+	if len(args) == 2 {
+		resourceID = args[1]
+	}
+
+	if getCmdFlags.diff != "" {
+		if err := helpers.FailIfMultiNodes(ctx, "get --diff"); err != nil {
+			return err
+		}
+
+		var err error
+
+		from, to, err = parseRevisionRange(getCmdFlags.diff)
+		if err != nil {
+			return err
+		}
+	}
+
+	md, _ := metadata.FromOutgoingContext(ctx)
+	nodes := md.Get("nodes")
 
-func aggregateEvents(ctx context.Context, outCh chan<- nodeAndEvent, watchCh <-chan state.Event, node string) {
-	for {
-		select {
-		case ev := <-watchCh:
-			select {
-			case outCh <- nodeAndEvent{node, ev}:
-			case <-ctx.Done():
-				return
+	rdCtx := ctx
+
+	if len(nodes) > 0 {
+		// fetch the RD from the first node
+		rdCtx = client.WithNode(ctx, nodes[0])
+	}
+
+	rd, err := c.ResolveResourceKind(rdCtx, &getCmdFlags.namespace, args[0])
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.Inspect.ResourceHistory(ctx, rd.TypedSpec().Type, resourceID, from, to)
+	if err != nil {
+		if resp == nil {
+			return fmt.Errorf("error getting resource history: %w", err)
+		}
+
+		cli.Warning("%s", err)
+	}
+
+	if getCmdFlags.diff != "" {
+		for _, msg := range resp.GetMessages() {
+			if msg.GetDiff() == "" {
+				fmt.Fprintf(os.Stderr, "revisions %d and %d are identical\n", from, to)
+
+				continue
+			}
+
+			fmt.Print(msg.GetDiff())
+		}
+
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, "NODE\tREVISION\tTIMESTAMP\tEVENT\tNAMESPACE\tID\tVERSION")
+
+	for _, msg := range resp.GetMessages() {
+		for _, revision := range msg.GetRevisions() {
+			event := strings.ToLower(revision.GetEvent().String())
+
+			if revision.GetRedacted() {
+				event += " (redacted)"
 			}
-		case <-ctx.Done():
-			return
+
+			fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\t%s\t%s\n",
+				msg.GetMetadata().GetHostname(),
+				revision.GetRevision(),
+				revision.GetTimestamp().AsTime().Local().Format(time.RFC3339),
+				event,
+				revision.GetNamespace(),
+				revision.GetId(),
+				revision.GetVersion(),
+			)
 		}
 	}
+
+	return w.Flush()
+}
+
+// parseRevisionRange parses the revision range in the <from>,<to> format.
+func parseRevisionRange(value string) (uint64, uint64, error) {
+	fromStr, toStr, ok := strings.Cut(value, ",")
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid revision range %q, expected <from>,<to>", value)
+	}
+
+	from, err := strconv.ParseUint(strings.TrimSpace(fromStr), 10, 64)
+	if err != nil || from == 0 {
+		return 0, 0, fmt.Errorf("invalid revision %q", fromStr)
+	}
+
+	to, err := strconv.ParseUint(strings.TrimSpace(toStr), 10, 64)
+	if err != nil || to == 0 {
+		return 0, 0, fmt.Errorf("invalid revision %q", toStr)
+	}
+
+	return from, to, nil
 }
 
 // completeResourceDefinition represents tab complete options for `get` and `get *` commands.
@@ -319,6 +412,9 @@ func init() {
 	getCmd.Flags().StringVarP(&getCmdFlags.output, "output", "o", "table", "output mode (json, table, yaml, jsonpath)")
 	getCmd.Flags().BoolVarP(&getCmdFlags.watch, "watch", "w", false, "watch resource changes")
 	getCmd.Flags().BoolVarP(&getCmdFlags.insecure, "insecure", "i", false, "get resources using the insecure (encrypted with no auth) maintenance service")
+	getCmd.Flags().BoolVar(&getCmdFlags.history, "history", false, "list the recorded revisions of the resource")
+	getCmd.Flags().StringVar(&getCmdFlags.diff, "diff", "", "print the diff between two recorded revisions of the resource, e.g. 3,4")
+	getCmd.MarkFlagsMutuallyExclusive("watch", "history", "diff")
 	cli.Should(getCmd.RegisterFlagCompletionFunc("output", output.CompleteOutputArg))
 	addCommand(getCmd)
 }
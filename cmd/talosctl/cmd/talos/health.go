@@ -82,6 +82,7 @@ var healthCmdFlags struct {
 	forceEndpoint      string
 	runOnServer        bool
 	runE2E             bool
+	secureBootEnforced bool
 }
 
 // healthCmd represents the health command.
@@ -144,7 +145,13 @@ func healthOnClient(ctx context.Context, c *client.Client) error {
 	checkCtx, checkCtxCancel := context.WithTimeout(ctx, healthCmdFlags.clusterWaitTimeout)
 	defer checkCtxCancel()
 
-	return check.Wait(checkCtx, &state, append(check.DefaultClusterChecks(), check.ExtraClusterChecks()...), check.StderrReporter())
+	checks := append(check.DefaultClusterChecks(), check.ExtraClusterChecks()...)
+
+	if healthCmdFlags.secureBootEnforced {
+		checks = append(checks, check.SecureBootChecks()...)
+	}
+
+	return check.Wait(checkCtx, &state, checks, check.StderrReporter())
 }
 
 func healthOnServer(ctx context.Context, c *client.Client) error {
@@ -161,6 +168,7 @@ func healthOnServer(ctx context.Context, c *client.Client) error {
 		ControlPlaneNodes: controlPlaneNodes,
 		WorkerNodes:       healthCmdFlags.clusterState.WorkerNodes,
 		ForceEndpoint:     healthCmdFlags.forceEndpoint,
+		RequireSecureBoot: healthCmdFlags.secureBootEnforced,
 	})
 	if err != nil {
 		return err
@@ -220,6 +228,7 @@ func init() {
 	healthCmd.Flags().StringVar(&healthCmdFlags.forceEndpoint, "k8s-endpoint", "", "use endpoint instead of kubeconfig default")
 	healthCmd.Flags().BoolVar(&healthCmdFlags.runOnServer, "server", true, "run server-side check")
 	healthCmd.Flags().BoolVar(&healthCmdFlags.runE2E, "run-e2e", false, "run Kubernetes e2e test")
+	healthCmd.Flags().BoolVar(&healthCmdFlags.secureBootEnforced, "secureboot-enforced", false, "check that SecureBoot is enforced on all nodes")
 }
 
 func buildClusterInfo(clusterState clusterNodes) (cluster.Info, error) {
@@ -10,7 +10,9 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
+	"path/filepath"
 	"slices"
 	"strings"
 	"text/tabwriter"
@@ -21,6 +23,7 @@ import (
 
 	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
 	"github.com/siderolabs/talos/pkg/imager/cache"
+	"github.com/siderolabs/talos/pkg/imager/delta"
 	"github.com/siderolabs/talos/pkg/images"
 	"github.com/siderolabs/talos/pkg/machinery/api/common"
 	"github.com/siderolabs/talos/pkg/machinery/api/machine"
@@ -305,6 +308,101 @@ var imageCacheCreateCmdFlags struct {
 	force    bool
 }
 
+// imageDeltaApplyCmd represents the image delta-apply command.
+var imageDeltaApplyCmd = &cobra.Command{
+	Use:   "delta-apply",
+	Short: "Reconstruct an imager output from the previous build and the delta",
+	Long: `Reconstruct an imager output from the previous build and the binary delta written by the imager with --delta-base.
+
+If the base doesn't match the delta (or the delta can't be applied), the full output is downloaded from --full-url,
+the downloaded output is verified against the manifest.
+
+The delta is applied on the machine running talosctl: the node upgrade flow still pulls the installer image from the registry,
+so the reconstructed installer image should be pushed to a registry (mirror) reachable by the nodes before the upgrade.`,
+	Example: `talosctl image delta-apply --base installer-amd64.tar --manifest installer-amd64.tar.delta.json --output installer-amd64-new.tar \
+    --full-url https://example.com/talos/installer-amd64.tar`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		manifest, err := delta.LoadManifest(imageDeltaApplyCmdFlags.manifestPath)
+		if err != nil {
+			return fmt.Errorf("error loading delta manifest: %w", err)
+		}
+
+		deltaPath := imageDeltaApplyCmdFlags.deltaPath
+		if deltaPath == "" {
+			deltaPath = filepath.Join(filepath.Dir(imageDeltaApplyCmdFlags.manifestPath), manifest.Delta.Name)
+		}
+
+		if imageDeltaApplyCmdFlags.fullURL == "" {
+			if err = delta.Apply(imageDeltaApplyCmdFlags.basePath, deltaPath, manifest, imageDeltaApplyCmdFlags.outputPath); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(os.Stderr, "reconstructed %s from the delta (%s)\n", imageDeltaApplyCmdFlags.outputPath, humanize.IBytes(uint64(manifest.Delta.Size)))
+
+			return nil
+		}
+
+		usedDelta, err := delta.Reconstruct(cmd.Context(), imageDeltaApplyCmdFlags.basePath, deltaPath, manifest, imageDeltaApplyCmdFlags.outputPath,
+			func(ctx context.Context, outPath string) error {
+				fmt.Fprintf(os.Stderr, "delta can't be applied, downloading %s\n", imageDeltaApplyCmdFlags.fullURL)
+
+				return download(ctx, imageDeltaApplyCmdFlags.fullURL, outPath)
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		if usedDelta {
+			fmt.Fprintf(os.Stderr, "reconstructed %s from the delta (%s)\n", imageDeltaApplyCmdFlags.outputPath, humanize.IBytes(uint64(manifest.Delta.Size)))
+		} else {
+			fmt.Fprintf(os.Stderr, "downloaded %s (%s)\n", imageDeltaApplyCmdFlags.outputPath, humanize.IBytes(uint64(manifest.Target.Size)))
+		}
+
+		return nil
+	},
+}
+
+var imageDeltaApplyCmdFlags struct {
+	basePath     string
+	deltaPath    string
+	manifestPath string
+	outputPath   string
+	fullURL      string
+}
+
+func download(ctx context.Context, url, outPath string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d downloading %s", resp.StatusCode, url)
+	}
+
+	out, err := os.Create(outPath)
+	if err != nil {
+		return err
+	}
+
+	defer out.Close() //nolint:errcheck
+
+	if _, err = io.Copy(out, resp.Body); err != nil {
+		return err
+	}
+
+	return out.Close()
+}
+
 func init() {
 	imageCmd.PersistentFlags().StringVar(&imageCmdFlags.namespace, "namespace", "cri", "namespace to use: `system` (etcd and kubelet images) or `cri` for all Kubernetes workloads")
 	addCommand(imageCmd)
@@ -314,6 +412,7 @@ func init() {
 	imageCmd.AddCommand(imagePullCmd)
 	imageCmd.AddCommand(imageCacheCreateCmd)
 	imageCmd.AddCommand(imageIntegrationCmd)
+	imageCmd.AddCommand(imageDeltaApplyCmd)
 
 	imageCacheCreateCmd.PersistentFlags().StringVar(&imageCacheCreateCmdFlags.imageCachePath, "image-cache-path", "", "directory to save the image cache in OCI format")
 	imageCacheCreateCmd.MarkPersistentFlagRequired("image-cache-path") //nolint:errcheck
@@ -324,6 +423,15 @@ func init() {
 	imageCacheCreateCmd.PersistentFlags().BoolVar(&imageCacheCreateCmdFlags.insecure, "insecure", false, "allow insecure registries")
 	imageCacheCreateCmd.PersistentFlags().BoolVar(&imageCacheCreateCmdFlags.force, "force", false, "force overwrite of existing image cache")
 
+	imageDeltaApplyCmd.PersistentFlags().StringVar(&imageDeltaApplyCmdFlags.basePath, "base", "", "path to the previous build of the output")
+	imageDeltaApplyCmd.MarkPersistentFlagRequired("base") //nolint:errcheck
+	imageDeltaApplyCmd.PersistentFlags().StringVar(&imageDeltaApplyCmdFlags.manifestPath, "manifest", "", "path to the delta manifest (.delta.json)")
+	imageDeltaApplyCmd.MarkPersistentFlagRequired("manifest") //nolint:errcheck
+	imageDeltaApplyCmd.PersistentFlags().StringVar(&imageDeltaApplyCmdFlags.deltaPath, "delta", "", "path to the delta, defaults to the delta next to the manifest")
+	imageDeltaApplyCmd.PersistentFlags().StringVarP(&imageDeltaApplyCmdFlags.outputPath, "output", "o", "", "path to write the reconstructed output to")
+	imageDeltaApplyCmd.MarkPersistentFlagRequired("output") //nolint:errcheck
+	imageDeltaApplyCmd.PersistentFlags().StringVar(&imageDeltaApplyCmdFlags.fullURL, "full-url", "", "URL to download the full output from if the delta can't be applied")
+
 	imageIntegrationCmd.PersistentFlags().StringVar(&imageIntegrationCmdFlags.installerTag, "installer-tag", "", "tag of the installer image to use")
 	imageIntegrationCmd.MarkPersistentFlagRequired("installer-tag") //nolint:errcheck
 	imageIntegrationCmd.PersistentFlags().StringVar(&imageIntegrationCmdFlags.registryAndUser, "registry-and-user", "", "registry and user to use for the images")
@@ -0,0 +1,148 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"github.com/siderolabs/talos/pkg/cli"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+var inhibitorsCmdFlags struct {
+	who     string
+	why     string
+	maxHold time.Duration
+}
+
+var inhibitorsCmd = &cobra.Command{
+	Use:   "inhibitors",
+	Short: "Manage the shutdown inhibitors",
+	Long: `Shutdown inhibitors delay the reboot, shutdown and upgrade of the node until they are released,
+or their maximum hold time expires.`,
+	Args: cobra.NoArgs,
+}
+
+var inhibitorsListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List the shutdown inhibitors",
+	Long:    ``,
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			var remotePeer peer.Peer
+
+			resp, err := c.InhibitorList(ctx, grpc.Peer(&remotePeer))
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error listing shutdown inhibitors: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tID\tWHO\tWHY\tMAX HOLD\tACQUIRED")
+
+			defaultNode := client.AddrFromPeer(&remotePeer)
+
+			for _, msg := range resp.Messages {
+				node := defaultNode
+
+				if msg.Metadata != nil {
+					node = msg.Metadata.Hostname
+				}
+
+				for _, inhibitor := range msg.Inhibitors {
+					fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s ago\n",
+						node,
+						inhibitor.GetId(),
+						inhibitor.GetWho(),
+						inhibitor.GetWhy(),
+						inhibitor.GetMaxHold().AsDuration(),
+						time.Since(inhibitor.GetAcquired().AsTime()).Round(time.Second),
+					)
+				}
+			}
+
+			return w.Flush()
+		})
+	},
+}
+
+var inhibitorsAcquireCmd = &cobra.Command{
+	Use:   "acquire",
+	Short: "Acquire a shutdown inhibitor",
+	Long:  ``,
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			var remotePeer peer.Peer
+
+			resp, err := c.InhibitorAcquire(ctx, inhibitorsCmdFlags.who, inhibitorsCmdFlags.why, inhibitorsCmdFlags.maxHold, grpc.Peer(&remotePeer))
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error acquiring shutdown inhibitor: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tID\tMAX HOLD")
+
+			defaultNode := client.AddrFromPeer(&remotePeer)
+
+			for _, msg := range resp.Messages {
+				node := defaultNode
+
+				if msg.Metadata != nil {
+					node = msg.Metadata.Hostname
+				}
+
+				fmt.Fprintf(w, "%s\t%s\t%s\n", node, msg.GetInhibitor().GetId(), msg.GetInhibitor().GetMaxHold().AsDuration())
+			}
+
+			return w.Flush()
+		})
+	},
+}
+
+var inhibitorsReleaseCmd = &cobra.Command{
+	Use:   "release <id>",
+	Short: "Release a shutdown inhibitor",
+	Long:  ``,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			if _, err := c.InhibitorRelease(ctx, args[0]); err != nil {
+				return fmt.Errorf("error releasing shutdown inhibitor: %w", err)
+			}
+
+			return nil
+		})
+	},
+}
+
+func init() {
+	inhibitorsAcquireCmd.Flags().StringVar(&inhibitorsCmdFlags.who, "who", "talosctl", "name of the inhibitor holder, the number of inhibitors is limited per holder")
+	inhibitorsAcquireCmd.Flags().StringVar(&inhibitorsCmdFlags.why, "why", "", "reason for the inhibitor")
+	inhibitorsAcquireCmd.Flags().DurationVar(&inhibitorsCmdFlags.maxHold, "max-hold", constants.ShutdownInhibitorMaxHold, "maximum time the inhibitor delays the shutdown")
+
+	inhibitorsCmd.AddCommand(inhibitorsListCmd)
+	inhibitorsCmd.AddCommand(inhibitorsAcquireCmd)
+	inhibitorsCmd.AddCommand(inhibitorsReleaseCmd)
+	addCommand(inhibitorsCmd)
+}
@@ -5,11 +5,16 @@
 package talos
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
+	"text/tabwriter"
 
 	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
 
 	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
 	"github.com/siderolabs/talos/pkg/cli"
@@ -60,9 +65,153 @@ to render the graph:
 	},
 }
 
+var inspectBootstrapManifestsCmdFlags struct {
+	diff bool
+}
+
+// inspectBootstrapManifestsCmd represents the inspect bootstrap-manifests command.
+var inspectBootstrapManifestsCmd = &cobra.Command{
+	Use:   "bootstrap-manifests",
+	Short: "Inspect the objects of the bootstrap manifests rendered by Talos.",
+	Long: `Inspect the objects of the bootstrap manifests rendered by Talos.
+
+With --diff flag, the objects are compared against the live cluster objects
+using server-side apply in dry-run mode, and the diff is printed for each object
+which would be created or updated. No changes are applied to the cluster.
+`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			if err := helpers.FailIfMultiNodes(ctx, "inspect bootstrap-manifests"); err != nil {
+				return err
+			}
+
+			resp, err := c.Inspect.BootstrapManifests(ctx, inspectBootstrapManifestsCmdFlags.diff)
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error getting bootstrap manifests: %s", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+			header := "MANIFEST\tKIND\tNAMESPACE\tNAME\tPOLICY"
+			if inspectBootstrapManifestsCmdFlags.diff {
+				header += "\tACTION"
+			}
+
+			fmt.Fprintln(w, header)
+
+			var diffs []string
+
+			for _, msg := range resp.GetMessages() {
+				for _, object := range msg.GetObjects() {
+					line := fmt.Sprintf("%s\t%s\t%s\t%s\t%s", object.GetManifest(), object.GetKind(), object.GetNamespace(), object.GetName(), object.GetPolicy())
+					if inspectBootstrapManifestsCmdFlags.diff {
+						line += "\t" + object.GetAction().String()
+					}
+
+					fmt.Fprintln(w, line)
+
+					if object.GetDiff() != "" {
+						diffs = append(diffs, object.GetDiff())
+					}
+				}
+			}
+
+			if err = w.Flush(); err != nil {
+				return err
+			}
+
+			if len(diffs) > 0 {
+				fmt.Println()
+				fmt.Print(strings.Join(diffs, "\n"))
+			}
+
+			return nil
+		})
+	},
+}
+
+var inspectResourceDefinitionCmdFlags struct {
+	schema bool
+}
+
+// inspectResourceDefinitionCmd represents the inspect resource-definition command.
+var inspectResourceDefinitionCmd = &cobra.Command{
+	Use:     "resource-definition <type>",
+	Aliases: []string{"rd"},
+	Short:   "Inspect the resource definition, optionally with the JSON Schema of the resource spec.",
+	Long: `Inspect the resource definition, optionally with the JSON Schema of the resource spec.
+
+With --schema flag, the JSON Schema of the resource spec is printed: it describes the spec
+as it is printed by 'talosctl get <type> -o yaml'.
+`,
+	Example: `talosctl inspect resource-definition addresses --schema`,
+	Args:    cobra.ExactArgs(1),
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return completeResourceDefinition(toComplete != "")
+		}
+
+		return nil, cobra.ShellCompDirectiveError | cobra.ShellCompDirectiveNoFileComp
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			if err := helpers.FailIfMultiNodes(ctx, "inspect resource-definition"); err != nil {
+				return err
+			}
+
+			var namespace string
+
+			rd, err := c.ResolveResourceKind(ctx, &namespace, args[0])
+			if err != nil {
+				return err
+			}
+
+			if !inspectResourceDefinitionCmdFlags.schema {
+				return yaml.NewEncoder(os.Stdout).Encode(rd.TypedSpec())
+			}
+
+			resp, err := c.Inspect.ResourceSchema(ctx, rd.TypedSpec().Type)
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error getting resource schema: %s", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			for _, msg := range resp.GetMessages() {
+				var out bytes.Buffer
+
+				if err = json.Indent(&out, msg.GetSchema(), "", "  "); err != nil {
+					return fmt.Errorf("error formatting resource schema: %w", err)
+				}
+
+				out.WriteByte('\n')
+
+				if _, err = out.WriteTo(os.Stdout); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	},
+}
+
 func init() {
 	addCommand(inspectCmd)
 
 	inspectCmd.AddCommand(inspectDependenciesCmd)
 	inspectDependenciesCmd.Flags().BoolVar(&inspectDependenciesCmdFlags.withResources, "with-resources", false, "display live resource information with dependencies")
+
+	inspectCmd.AddCommand(inspectBootstrapManifestsCmd)
+	inspectBootstrapManifestsCmd.Flags().BoolVar(&inspectBootstrapManifestsCmdFlags.diff, "diff", false, "compute the diff against the live cluster objects (dry-run)")
+
+	inspectCmd.AddCommand(inspectResourceDefinitionCmd)
+	inspectResourceDefinitionCmd.Flags().BoolVar(&inspectResourceDefinitionCmdFlags.schema, "schema", false, "print the JSON Schema of the resource spec")
 }
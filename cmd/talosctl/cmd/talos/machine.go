@@ -0,0 +1,107 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"github.com/siderolabs/talos/pkg/cli"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+var machineResetIdentityCmdFlags struct {
+	confirm bool
+}
+
+var machineCmd = &cobra.Command{
+	Use:   "machine",
+	Short: "Manage the machine identity",
+	Long:  ``,
+	Args:  cobra.NoArgs,
+}
+
+var machineResetIdentityCmd = &cobra.Command{
+	Use:   "reset-identity",
+	Short: "Regenerate the node identity and the KubeSpan key of the machine",
+	Long: `The machines cloned from the same disk image share the node identity, which confuses the cluster discovery and KubeSpan.
+The conflicts are reported as IdentityConflict resources ('talosctl get identityconflicts') and IdentityConflictEvent events.
+
+The command regenerates the node identity (and /etc/machine-id derived from it) and the KubeSpan key of the machine,
+the effects which might require an action are printed once the new identity is established.
+
+The reset is refused if a volume is encrypted with the node ID key.`,
+	Example: `talosctl machine reset-identity --nodes 172.20.0.5 --confirm`,
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if !machineResetIdentityCmdFlags.confirm {
+			return errors.New("node identity reset should be confirmed with --confirm")
+		}
+
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			var remotePeer peer.Peer
+
+			resp, err := c.ResetIdentity(ctx, &machine.ResetIdentityRequest{Confirm: true}, grpc.Peer(&remotePeer))
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error resetting node identity: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tPREVIOUS NODE ID\tNODE ID\tKUBESPAN PUBLIC KEY")
+
+			defaultNode := client.AddrFromPeer(&remotePeer)
+
+			var notes []string
+
+			for _, msg := range resp.Messages {
+				node := defaultNode
+
+				if msg.Metadata != nil {
+					node = msg.Metadata.Hostname
+				}
+
+				publicKey := msg.GetKubespanPublicKey()
+				if publicKey == "" {
+					publicKey = "-"
+				}
+
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", node, msg.GetPreviousNodeId(), msg.GetNodeId(), publicKey)
+
+				for _, note := range msg.GetNotes() {
+					notes = append(notes, fmt.Sprintf("%s: %s", node, note))
+				}
+			}
+
+			if err = w.Flush(); err != nil {
+				return err
+			}
+
+			for _, note := range notes {
+				fmt.Fprintf(os.Stderr, "NOTE: %s\n", note)
+			}
+
+			return nil
+		})
+	},
+}
+
+func init() {
+	machineResetIdentityCmd.Flags().BoolVar(&machineResetIdentityCmdFlags.confirm, "confirm", false, "confirm the node identity reset")
+
+	machineCmd.AddCommand(machineResetIdentityCmd)
+	addCommand(machineCmd)
+}
@@ -5,9 +5,11 @@
 package talos
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -28,8 +30,9 @@ import (
 )
 
 var (
-	sortMethod     string
-	watchProcesses bool
+	sortMethod      string
+	watchProcesses  bool
+	zombieProcesses bool
 )
 
 // processesCmd represents the processes command.
@@ -70,6 +73,7 @@ var processesCmd = &cobra.Command{
 func init() {
 	processesCmd.Flags().StringVarP(&sortMethod, "sort", "s", "rss", "Column to sort output by. [rss|cpu]")
 	processesCmd.Flags().BoolVarP(&watchProcesses, "watch", "w", false, "Stream running processes")
+	processesCmd.Flags().BoolVar(&zombieProcesses, "zombies", false, "List only zombie processes with their parent processes")
 	addCommand(processesCmd)
 }
 
@@ -189,6 +193,10 @@ func processesOutput(ctx context.Context, c *client.Client) (output string, err
 
 	defaultNode := client.AddrFromPeer(&remotePeer)
 
+	if zombieProcesses {
+		return zombieProcessesOutput(resp, defaultNode)
+	}
+
 	var s []string
 
 	s = append(s, "NODE | PID | STATE | THREADS | CPU-TIME | VIRTMEM | RESMEM | LABEL | COMMAND")
@@ -240,3 +248,43 @@ func processesOutput(ctx context.Context, c *client.Client) (output string, err
 
 	return res, helpers.CheckErrors(resp.Messages...)
 }
+
+func zombieProcessesOutput(resp *machineapi.ProcessesResponse, defaultNode string) (string, error) {
+	s := []string{"NODE | PID | PPID | PARENT | COMMAND"}
+
+	for _, msg := range resp.Messages {
+		node := defaultNode
+
+		if msg.Metadata != nil {
+			node = msg.Metadata.Hostname
+		}
+
+		// zombie process stays attached to the parent until the parent reaps it or exits
+		parents := make(map[int32]string, len(msg.Processes))
+
+		for _, p := range msg.Processes {
+			parents[p.Pid] = p.Command
+		}
+
+		procs := slices.Clone(msg.Processes)
+
+		slices.SortFunc(procs, func(a, b *machineapi.ProcessInfo) int {
+			return cmp.Or(cmp.Compare(a.Ppid, b.Ppid), cmp.Compare(a.Pid, b.Pid))
+		})
+
+		for _, p := range procs {
+			if p.State != "Z" {
+				continue
+			}
+
+			parent, ok := parents[p.Ppid]
+			if !ok {
+				parent = "<unknown>"
+			}
+
+			s = append(s, fmt.Sprintf("%s | %d | %d | %s | %s", node, p.Pid, p.Ppid, parent, p.Command))
+		}
+	}
+
+	return columnize.SimpleFormat(s), helpers.CheckErrors(resp.Messages...)
+}
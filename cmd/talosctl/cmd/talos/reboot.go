@@ -19,7 +19,8 @@ import (
 var rebootCmdFlags struct {
 	trackableActionCmdFlags
 
-	mode string
+	mode             string
+	ignoreInhibitors bool
 }
 
 // rebootCmd represents the reboot command.
@@ -44,6 +45,10 @@ var rebootCmd = &cobra.Command{
 			return fmt.Errorf("invalid reboot mode: %q", rebootCmdFlags.mode)
 		}
 
+		if rebootCmdFlags.ignoreInhibitors {
+			opts = append(opts, client.WithIgnoreInhibitors)
+		}
+
 		if !rebootCmdFlags.wait {
 			return WithClient(func(ctx context.Context, c *client.Client) error {
 				if err := helpers.ClientVersionCheck(ctx, c); err != nil {
@@ -86,6 +91,7 @@ func rebootGetActorID(opts ...client.RebootMode) func(ctx context.Context, c *cl
 
 func init() {
 	rebootCmd.Flags().StringVarP(&rebootCmdFlags.mode, "mode", "m", "default", "select the reboot mode: \"default\", \"powercycle\" (skips kexec)")
+	rebootCmd.Flags().BoolVar(&rebootCmdFlags.ignoreInhibitors, "ignore-inhibitors", false, "if true, reboot the node without waiting for the shutdown inhibitors to be released")
 	rebootCmdFlags.addTrackActionFlags(rebootCmd)
 	addCommand(rebootCmd)
 }
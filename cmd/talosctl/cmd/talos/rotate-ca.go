@@ -6,10 +6,14 @@ package talos
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
+	"slices"
 	"time"
 
 	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
 
 	"github.com/siderolabs/talos/pkg/cluster"
 	"github.com/siderolabs/talos/pkg/machinery/client"
@@ -25,6 +29,8 @@ var rotateCACmdFlags struct {
 	clusterState     clusterNodes
 	forceEndpoint    string
 	output           string
+	phase            string
+	stateFile        string
 	withExamples     bool
 	withDocs         bool
 	dryRun           bool
@@ -40,10 +46,20 @@ var rotateCACmd = &cobra.Command{
 By default both CAs are rotated, but you can choose to rotate just one or another.
 The command starts by generating new CAs, and gracefully applying it to the cluster.
 
+The Talos CA rotation is split into phases (accept, issue, retire), and the progress is saved
+to the state file after each phase. With --phase, the rotation stops after the specified phase.
+An interrupted or stopped rotation is resumed from the state file by re-running the command.
+Once the new Talos CA is issuing, run the command with the new 'talosconfig' to resume the rotation.
+
 For Kubernetes, the command only rotates the API server issuing CA, and other Kubernetes
-PKI can be rotated by applying machine config changes to the controlplane nodes.`,
+PKI can be rotated by applying machine config changes to the controlplane nodes.
+Kubernetes CA is rotated only after Talos CA rotation is completed.`,
 	Args: cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if rotateCACmdFlags.phase != "" && !slices.Contains(talos.Phases, talos.Phase(rotateCACmdFlags.phase)) {
+			return fmt.Errorf("unknown phase %q, expected one of %q", rotateCACmdFlags.phase, talos.Phases)
+		}
+
 		err := rotateCACmdFlags.clusterState.InitNodeInfos()
 		if err != nil {
 			return err
@@ -76,17 +92,30 @@ func rotateCA(ctx context.Context, c *client.Client) error {
 	}
 
 	if rotateCACmdFlags.rotateTalos {
-		var newTalosconfig *clientconfig.Config
+		var (
+			newTalosconfig *clientconfig.Config
+			done           bool
+		)
 
-		newTalosconfig, err = rotateTalosCA(ctx, c, encoderOpt, clusterInfo, newBundle)
+		newTalosconfig, done, err = rotateTalosCA(ctx, c, encoderOpt, clusterInfo, newBundle)
 		if err != nil {
 			return fmt.Errorf("error rotating Talos CA: %w", err)
 		}
 
-		// re-create client with new Talos PKI
-		c, err = client.New(ctx, client.WithConfig(newTalosconfig))
-		if err != nil {
-			return fmt.Errorf("failed to create new client with rotated Talos CA: %w", err)
+		if !done {
+			if rotateCACmdFlags.rotateKubernetes {
+				fmt.Println("> Skipping Kubernetes CA rotation until Talos CA rotation is completed.")
+			}
+
+			return nil
+		}
+
+		if newTalosconfig != nil {
+			// re-create client with new Talos PKI
+			c, err = client.New(ctx, client.WithConfig(newTalosconfig))
+			if err != nil {
+				return fmt.Errorf("failed to create new client with rotated Talos CA: %w", err)
+			}
 		}
 	}
 
@@ -99,10 +128,13 @@ func rotateCA(ctx context.Context, c *client.Client) error {
 	return nil
 }
 
-func rotateTalosCA(ctx context.Context, oldClient *client.Client, encoderOpt encoder.Option, clusterInfo cluster.Info, newBundle *secrets.Bundle) (*clientconfig.Config, error) {
+// rotateTalosCA rotates the Talos CA, and returns the new talosconfig once the new CA is issuing.
+//
+//nolint:gocyclo
+func rotateTalosCA(ctx context.Context, oldClient *client.Client, encoderOpt encoder.Option, clusterInfo cluster.Info, newBundle *secrets.Bundle) (*clientconfig.Config, bool, error) {
 	oldTalosconfig, err := clientconfig.Open(GlobalArgs.Talosconfig)
 	if err != nil {
-		return nil, fmt.Errorf("failed to open config file %q: %w", GlobalArgs.Talosconfig, err)
+		return nil, false, fmt.Errorf("failed to open config file %q: %w", GlobalArgs.Talosconfig, err)
 	}
 
 	configContext := oldTalosconfig.Context
@@ -111,6 +143,19 @@ func rotateTalosCA(ctx context.Context, oldClient *client.Client, encoderOpt enc
 		configContext = GlobalArgs.CmdContext
 	}
 
+	resume, err := loadRotateCAState(rotateCACmdFlags.stateFile)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var lastState talos.State
+
+	if resume != nil {
+		fmt.Printf("> Resuming Talos CA rotation from %q\n", rotateCACmdFlags.stateFile)
+
+		lastState = *resume
+	}
+
 	options := talos.Options{
 		DryRun: rotateCACmdFlags.dryRun,
 
@@ -122,6 +167,14 @@ func rotateTalosCA(ctx context.Context, oldClient *client.Client, encoderOpt enc
 
 		NewTalosCA: newBundle.Certs.OS,
 
+		Resume: resume,
+		Phase:  talos.Phase(rotateCACmdFlags.phase),
+		Checkpoint: func(state talos.State) error {
+			lastState = state
+
+			return saveRotateCAState(rotateCACmdFlags.stateFile, state)
+		},
+
 		EncoderOption: encoderOpt,
 
 		Printf: func(format string, args ...any) { fmt.Printf(format, args...) },
@@ -129,18 +182,72 @@ func rotateTalosCA(ctx context.Context, oldClient *client.Client, encoderOpt enc
 
 	newTalosconfig, err := talos.Rotate(ctx, options)
 	if err != nil {
-		return nil, err
+		if !rotateCACmdFlags.dryRun {
+			fmt.Printf("> Talos CA rotation was interrupted, re-run the command to resume it from %q\n", rotateCACmdFlags.stateFile)
+		}
+
+		return nil, false, err
 	}
 
 	if rotateCACmdFlags.dryRun {
 		fmt.Println("> Dry-run mode enabled, no changes were made to the cluster, re-run with `--dry-run=false` to apply the changes.")
 
-		return nil, nil
+		return nil, true, nil
+	}
+
+	if newTalosconfig != nil {
+		fmt.Printf("> Writing new talosconfig to %q\n", rotateCACmdFlags.output)
+
+		if err = newTalosconfig.Save(rotateCACmdFlags.output); err != nil {
+			return nil, false, err
+		}
+	}
+
+	if !lastState.Done(talos.PhaseRetire) {
+		fmt.Printf("> Talos CA rotation stopped after phase %q, re-run the command to resume it from %q\n", lastState.Completed, rotateCACmdFlags.stateFile)
+
+		return newTalosconfig, false, nil
+	}
+
+	if err = os.Remove(rotateCACmdFlags.stateFile); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, false, fmt.Errorf("error removing Talos CA rotation state: %w", err)
+	}
+
+	return newTalosconfig, true, nil
+}
+
+// loadRotateCAState loads the state of the interrupted Talos CA rotation, if any.
+func loadRotateCAState(path string) (*talos.State, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("error reading Talos CA rotation state: %w", err)
+	}
+
+	var state talos.State
+
+	if err = yaml.Unmarshal(contents, &state); err != nil {
+		return nil, fmt.Errorf("error parsing Talos CA rotation state %q: %w", path, err)
+	}
+
+	if state.CurrentCA == nil || state.NewCA == nil {
+		return nil, fmt.Errorf("invalid Talos CA rotation state %q: missing CAs", path)
 	}
 
-	fmt.Printf("> Writing new talosconfig to %q\n", rotateCACmdFlags.output)
+	return &state, nil
+}
+
+// saveRotateCAState saves the state of the Talos CA rotation, it contains the new Talos CA key.
+func saveRotateCAState(path string, state talos.State) error {
+	contents, err := yaml.Marshal(state)
+	if err != nil {
+		return err
+	}
 
-	return newTalosconfig, newTalosconfig.Save(rotateCACmdFlags.output)
+	return os.WriteFile(path, contents, 0o600)
 }
 
 func rotateKubernetesCA(ctx context.Context, c *client.Client, encoderOpt encoder.Option, clusterInfo cluster.Info, newBundle *secrets.Bundle) error {
@@ -181,6 +288,8 @@ func init() {
 	rotateCACmd.Flags().BoolVarP(&rotateCACmdFlags.withExamples, "with-examples", "", true, "patch all machine configs with the commented examples")
 	rotateCACmd.Flags().BoolVarP(&rotateCACmdFlags.withDocs, "with-docs", "", true, "patch all machine configs adding the documentation for each field")
 	rotateCACmd.Flags().StringVarP(&rotateCACmdFlags.output, "output", "o", "talosconfig", "path to the output new `talosconfig`")
+	rotateCACmd.Flags().StringVar(&rotateCACmdFlags.phase, "phase", "", fmt.Sprintf("stop Talos CA rotation after the phase (one of %q), all phases are run by default", talos.Phases))
+	rotateCACmd.Flags().StringVar(&rotateCACmdFlags.stateFile, "state-file", "talos-ca-rotation.yaml", "path to the Talos CA rotation state file, used to resume the rotation")
 	rotateCACmd.Flags().BoolVarP(&rotateCACmdFlags.dryRun, "dry-run", "", true, "dry-run mode (no changes to the cluster)")
 	rotateCACmd.Flags().BoolVarP(&rotateCACmdFlags.rotateTalos, "talos", "", true, "rotate Talos API CA")
 	rotateCACmd.Flags().BoolVarP(&rotateCACmdFlags.rotateKubernetes, "kubernetes", "", true, "rotate Kubernetes API CA")
@@ -0,0 +1,94 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/talos/pkg/cli"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+var rotateCertsCmdFlags struct {
+	components []string
+}
+
+// rotateCertsCmd represents the rotate-certs command.
+var rotateCertsCmd = &cobra.Command{
+	Use:   "rotate-certs",
+	Short: "Force early rotation of the certificates issued by Talos on the node.",
+	Long: `The command re-issues the certificates of the selected components with the current cluster CAs.
+
+The components are rotated one by one in the following order: ` + strings.Join(secrets.CertificateComponents, ", ") + `.
+The command returns once all certificates of the selected components are re-issued.
+
+Use 'talosctl get certificatestatuses' to see the expiry of the certificates.
+To rotate the cluster CAs, use 'talosctl rotate-ca' instead.`,
+	Example: `talosctl rotate-certs --nodes 172.20.0.2 --component apiserver,kubeconfig`,
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(rotateCertsCmdFlags.components) == 0 {
+			return fmt.Errorf("at least one component should be specified, supported components: %s", strings.Join(secrets.CertificateComponents, ", "))
+		}
+
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			response, err := c.RotateCertificates(ctx, &machine.RotateCertificatesRequest{
+				Components: rotateCertsCmdFlags.components,
+			})
+			if err != nil {
+				if response == nil {
+					return fmt.Errorf("error rotating certificates: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			node := ""
+			pattern := "%s\n"
+			header := "ROTATED"
+
+			for i, message := range response.Messages {
+				if message.Metadata != nil && message.Metadata.Hostname != "" {
+					node = message.Metadata.Hostname
+				}
+
+				if i == 0 {
+					if node != "" {
+						header = "NODE\t" + header
+						pattern = "%s\t" + pattern
+					}
+
+					fmt.Fprintln(w, header)
+				}
+
+				args := []any{strings.Join(message.GetComponents(), ",")}
+
+				if node != "" {
+					args = append([]any{node}, args...)
+				}
+
+				fmt.Fprintf(w, pattern, args...)
+			}
+
+			return w.Flush()
+		})
+	},
+}
+
+func init() {
+	rotateCertsCmd.Flags().StringSliceVar(&rotateCertsCmdFlags.components, "component", nil,
+		fmt.Sprintf("components to rotate the certificates for (%s)", strings.Join(secrets.CertificateComponents, ", ")))
+
+	addCommand(rotateCertsCmd)
+}
@@ -0,0 +1,104 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/siderolabs/gen/xslices"
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/talos/pkg/cli"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+var rotateDiskKeyCmdFlags struct {
+	volume string
+	slots  []int
+}
+
+// rotateDiskKeyCmd represents the rotate-disk-key command.
+var rotateDiskKeyCmd = &cobra.Command{
+	Use:   "rotate-disk-key",
+	Short: "Rotate the disk encryption keys of the volume.",
+	Long: `The command replaces the disk encryption keys wrapped by a key provider (KMS, TPM) with freshly generated ones.
+
+The volume stays online during the rotation: the new key is added to a temporary key slot and verified
+before the old key is removed, so the volume can be unlocked even if the node loses power mid-rotation;
+the interrupted rotation is completed on the next attempt or on the next boot.
+
+By default all key slots wrapped by a key provider are rotated.
+If the key slot is not in the machine configuration anymore, it is removed from the volume.
+
+Use 'talosctl get volumekeystatuses' to see the key epochs and the rotation progress.`,
+	Example: `talosctl rotate-disk-key --nodes 172.20.0.2 --volume STATE`,
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if rotateDiskKeyCmdFlags.volume == "" {
+			return errors.New("volume should be specified")
+		}
+
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			response, err := c.RotateDiskKey(ctx, &machine.RotateDiskKeyRequest{
+				VolumeId: rotateDiskKeyCmdFlags.volume,
+				Slots:    xslices.Map(rotateDiskKeyCmdFlags.slots, func(slot int) int64 { return int64(slot) }),
+			})
+			if err != nil {
+				if response == nil {
+					return fmt.Errorf("error rotating disk encryption keys: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			node := ""
+			pattern := "%s\t%s\n"
+			header := "VOLUME\tSLOTS"
+
+			for i, message := range response.Messages {
+				if message.Metadata != nil && message.Metadata.Hostname != "" {
+					node = message.Metadata.Hostname
+				}
+
+				if i == 0 {
+					if node != "" {
+						header = "NODE\t" + header
+						pattern = "%s\t" + pattern
+					}
+
+					fmt.Fprintln(w, header)
+				}
+
+				slots := xslices.Map(message.GetSlots(), func(slot *machine.RotateDiskKeySlot) string {
+					return fmt.Sprintf("%d:%s@%d", slot.GetSlot(), slot.GetProvider(), slot.GetEpoch())
+				})
+
+				args := []any{message.GetVolumeId(), strings.Join(slots, ",")}
+
+				if node != "" {
+					args = append([]any{node}, args...)
+				}
+
+				fmt.Fprintf(w, pattern, args...)
+			}
+
+			return w.Flush()
+		})
+	},
+}
+
+func init() {
+	rotateDiskKeyCmd.Flags().StringVar(&rotateDiskKeyCmdFlags.volume, "volume", "", "ID of the encrypted volume, e.g. STATE or EPHEMERAL")
+	rotateDiskKeyCmd.Flags().IntSliceVar(&rotateDiskKeyCmdFlags.slots, "slot", nil, "key slots to rotate, defaults to all key slots wrapped by a key provider")
+
+	addCommand(rotateDiskKeyCmd)
+}
@@ -0,0 +1,84 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+var secretsBundleCmdFlags struct {
+	outputFile         string
+	allowSecretsExport bool
+	force              bool
+}
+
+// secretsBundleCmd represents the get secrets-bundle command.
+var secretsBundleCmd = &cobra.Command{
+	Use:   "secrets-bundle",
+	Short: "Export the secrets bundle of the cluster from a control plane node.",
+	Long: `The command reconstructs the secrets bundle from the running control plane node:
+the cluster, etcd and Talos API CAs, the service account key and the encryption secrets.
+A new bootstrap token is generated and registered in the cluster on each export.
+
+The bundle is written in the format of 'talosctl gen secrets', so it can be used to generate
+the machine configuration of the cluster with 'talosctl gen config --with-secrets', e.g. for disaster recovery.
+
+The bundle grants full access to the cluster, so the export should be confirmed with '--allow-secrets-export'.
+Each export is reported with an event on the node.`,
+	Example: `talosctl get secrets-bundle --nodes 172.20.0.2 --allow-secrets-export -o secrets.yaml`,
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if !secretsBundleCmdFlags.allowSecretsExport {
+			return errors.New("the secrets bundle contains the cluster secrets, confirm the export with --allow-secrets-export")
+		}
+
+		if !secretsBundleCmdFlags.force {
+			if _, err := os.Stat(secretsBundleCmdFlags.outputFile); err == nil {
+				return fmt.Errorf("file %q already exists, use --force to overwrite", secretsBundleCmdFlags.outputFile)
+			}
+		}
+
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			if len(GlobalArgs.Nodes) > 1 {
+				return errors.New("command \"get secrets-bundle\" is not supported with multiple nodes")
+			}
+
+			resp, err := c.SecretsBundleExport(ctx, &machine.SecretsBundleExportRequest{
+				AllowSecretsExport: true,
+			})
+			if err != nil {
+				return fmt.Errorf("error exporting the secrets bundle: %w", err)
+			}
+
+			if len(resp.GetMessages()) != 1 {
+				return fmt.Errorf("unexpected number of responses: %d", len(resp.GetMessages()))
+			}
+
+			if err = os.WriteFile(secretsBundleCmdFlags.outputFile, resp.GetMessages()[0].GetBundle(), 0o600); err != nil {
+				return fmt.Errorf("error writing the secrets bundle: %w", err)
+			}
+
+			fmt.Fprintf(os.Stderr, "secrets bundle written to %q\n", secretsBundleCmdFlags.outputFile)
+
+			return nil
+		})
+	},
+}
+
+func init() {
+	secretsBundleCmd.Flags().StringVarP(&secretsBundleCmdFlags.outputFile, "output-file", "o", "secrets.yaml", "path of the output file")
+	secretsBundleCmd.Flags().BoolVar(&secretsBundleCmdFlags.allowSecretsExport, "allow-secrets-export", false, "confirm the export of the cluster secrets")
+	secretsBundleCmd.Flags().BoolVar(&secretsBundleCmdFlags.force, "force", false, "overwrite the output file if it exists")
+
+	getCmd.AddCommand(secretsBundleCmd)
+}
@@ -6,19 +6,28 @@ package talos
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
+	"slices"
 	"text/tabwriter"
 
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/siderolabs/talos/pkg/cli"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/client"
 	"github.com/siderolabs/talos/pkg/machinery/formatters"
 )
 
+var serviceCmdFlags struct {
+	output string
+	why    bool
+}
+
 // serviceCmd represents the service command.
 var serviceCmd = &cobra.Command{
 	Use:     "service [<id> [start|stop|restart|status]]",
@@ -26,7 +35,8 @@ var serviceCmd = &cobra.Command{
 	Short:   "Retrieve the state of a service (or all services), control service state",
 	Long: `Service control command. If run without arguments, lists all the services and their state.
 If service ID is specified, default action 'status' is executed which shows status of a single list service.
-With actions 'start', 'stop', 'restart', service state is updated respectively.`,
+With actions 'start', 'stop', 'restart', service state is updated respectively.
+With '--why' flag, the status shows the chain of conditions the service is blocked on.`,
 	Args: cobra.MaximumNArgs(2),
 	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		switch len(args) {
@@ -50,6 +60,10 @@ With actions 'start', 'stop', 'restart', service state is updated respectively.`
 			action = args[1]
 		}
 
+		if serviceCmdFlags.output != "table" && serviceCmdFlags.output != "json" {
+			return fmt.Errorf("unsupported output format %q", serviceCmdFlags.output)
+		}
+
 		return WithClient(func(ctx context.Context, c *client.Client) error {
 			switch action {
 			case "status":
@@ -57,6 +71,10 @@ With actions 'start', 'stop', 'restart', service state is updated respectively.`
 					return serviceList(ctx, c)
 				}
 
+				if serviceCmdFlags.why {
+					return serviceWhy(ctx, c, serviceID)
+				}
+
 				return serviceInfo(ctx, c, serviceID)
 			case "start":
 				return serviceStart(ctx, c, serviceID)
@@ -83,6 +101,10 @@ func serviceList(ctx context.Context, c *client.Client) error {
 		cli.Warning("%s", err)
 	}
 
+	if serviceCmdFlags.output == "json" {
+		return serviceListJSON(ctx, c, resp, client.AddrFromPeer(&remotePeer))
+	}
+
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
 	fmt.Fprintln(w, "NODE\tSERVICE\tSTATE\tHEALTH\tLAST CHANGE\tLAST EVENT")
 
@@ -105,6 +127,92 @@ func serviceList(ctx context.Context, c *client.Client) error {
 	return w.Flush()
 }
 
+type serviceJSON struct {
+	Node         string                      `json:"node"`
+	Service      json.RawMessage             `json:"service"`
+	Dependencies json.RawMessage             `json:"dependencies,omitempty"`
+	BlockedOn    []formatters.ServiceBlocker `json:"blockedOn,omitempty"`
+}
+
+func serviceListJSON(ctx context.Context, c *client.Client, resp *machine.ServiceListResponse, defaultNode string) error {
+	// dependencies are best-effort, as older nodes don't support the API
+	deps := map[string][]*machine.ServiceDependencyInfo{}
+
+	depsResp, err := c.ServiceDependencies(ctx, "")
+	if err != nil {
+		cli.Warning("error getting service dependencies: %s", err)
+	}
+
+	if depsResp != nil {
+		for _, msg := range depsResp.Messages {
+			node := defaultNode
+
+			if msg.Metadata != nil {
+				node = msg.Metadata.Hostname
+			}
+
+			deps[node] = msg.Services
+		}
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+
+	for _, msg := range resp.Messages {
+		node := defaultNode
+
+		if msg.Metadata != nil {
+			node = msg.Metadata.Hostname
+		}
+
+		for _, s := range msg.Services {
+			out := serviceJSON{
+				Node: node,
+			}
+
+			if out.Service, err = protojson.Marshal(s); err != nil {
+				return err
+			}
+
+			if idx := slices.IndexFunc(deps[node], func(svc *machine.ServiceDependencyInfo) bool { return svc.Id == s.Id }); idx != -1 {
+				if out.Dependencies, err = protojson.Marshal(deps[node][idx]); err != nil {
+					return err
+				}
+
+				out.BlockedOn = formatters.ServiceBlockers(deps[node], s.Id)
+			}
+
+			if err = enc.Encode(out); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func serviceWhy(ctx context.Context, c *client.Client, id string) error {
+	var remotePeer peer.Peer
+
+	// all services are requested to follow the chain of dependencies
+	resp, err := c.ServiceDependencies(ctx, "", grpc.Peer(&remotePeer))
+	if err != nil {
+		if resp == nil {
+			return fmt.Errorf("error getting service dependencies: %w", err)
+		}
+
+		cli.Warning("%s", err)
+	}
+
+	if !slices.ContainsFunc(resp.Messages, func(msg *machine.ServiceDependencies) bool {
+		return slices.ContainsFunc(msg.Services, func(svc *machine.ServiceDependencyInfo) bool { return svc.Id == id })
+	}) {
+		return fmt.Errorf("service %q is not registered on any nodes", id)
+	}
+
+	return formatters.RenderServiceBlockers(resp, id, os.Stdout, client.AddrFromPeer(&remotePeer))
+}
+
 func serviceInfo(ctx context.Context, c *client.Client, id string) error {
 	var remotePeer peer.Peer
 
@@ -217,5 +325,7 @@ func serviceRestart(ctx context.Context, c *client.Client, id string) error {
 }
 
 func init() {
+	serviceCmd.Flags().StringVarP(&serviceCmdFlags.output, "output", "o", "table", "output format for the list of services (table, json)")
+	serviceCmd.Flags().BoolVar(&serviceCmdFlags.why, "why", false, "show the chain of conditions the service is blocked on")
 	addCommand(serviceCmd)
 }
@@ -19,7 +19,8 @@ import (
 var shutdownCmdFlags struct {
 	trackableActionCmdFlags
 
-	force bool
+	force            bool
+	ignoreInhibitors bool
 }
 
 // shutdownCmd represents the shutdown command.
@@ -33,9 +34,7 @@ var shutdownCmd = &cobra.Command{
 			shutdownCmdFlags.wait = true
 		}
 
-		opts := []client.ShutdownOption{
-			client.WithShutdownForce(shutdownCmdFlags.force),
-		}
+		opts := shutdownOptions()
 
 		if !shutdownCmdFlags.wait {
 			return WithClient(func(ctx context.Context, c *client.Client) error {
@@ -61,8 +60,15 @@ var shutdownCmd = &cobra.Command{
 	},
 }
 
+func shutdownOptions() []client.ShutdownOption {
+	return []client.ShutdownOption{
+		client.WithShutdownForce(shutdownCmdFlags.force),
+		client.WithShutdownIgnoreInhibitors(shutdownCmdFlags.ignoreInhibitors),
+	}
+}
+
 func shutdownGetActorID(ctx context.Context, c *client.Client) (string, error) {
-	resp, err := c.ShutdownWithResponse(ctx, client.WithShutdownForce(shutdownCmdFlags.force))
+	resp, err := c.ShutdownWithResponse(ctx, shutdownOptions()...)
 	if err != nil {
 		return "", err
 	}
@@ -76,6 +82,7 @@ func shutdownGetActorID(ctx context.Context, c *client.Client) (string, error) {
 
 func init() {
 	shutdownCmd.Flags().BoolVar(&shutdownCmdFlags.force, "force", false, "if true, force a node to shutdown without a cordon/drain")
+	shutdownCmd.Flags().BoolVar(&shutdownCmdFlags.ignoreInhibitors, "ignore-inhibitors", false, "if true, shutdown the node without waiting for the shutdown inhibitors to be released")
 	shutdownCmdFlags.addTrackActionFlags(shutdownCmd)
 	addCommand(shutdownCmd)
 }
@@ -0,0 +1,149 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"github.com/siderolabs/talos/pkg/cli"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate/secrets"
+)
+
+var tokenCmdFlags struct {
+	token string
+	ttl   time.Duration
+}
+
+var tokenCmd = &cobra.Command{
+	Use:   "token",
+	Short: "Manage the join tokens",
+	Long: `Join tokens are accepted by trustd in addition to the machine configuration token (machine.token)
+when the machines join the cluster.
+
+The join tokens are stored on each control plane node, so the commands should target all control plane nodes.`,
+	Args: cobra.NoArgs,
+}
+
+var tokenCreateCmd = &cobra.Command{
+	Use:   "create <name>",
+	Short: "Create a join token",
+	Long: `The token is generated by talosctl (unless specified with --token), and it is printed once,
+only the hash of the token is stored on the nodes.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			token := tokenCmdFlags.token
+
+			if token == "" {
+				var err error
+
+				token, err = secrets.NewTrustdToken()
+				if err != nil {
+					return fmt.Errorf("error generating join token: %w", err)
+				}
+			}
+
+			var expiresAt time.Time
+
+			if tokenCmdFlags.ttl > 0 {
+				expiresAt = time.Now().Add(tokenCmdFlags.ttl)
+			}
+
+			if _, err := c.JoinTokenCreate(ctx, args[0], token, expiresAt); err != nil {
+				return fmt.Errorf("error creating join token: %w", err)
+			}
+
+			fmt.Println(token)
+
+			return nil
+		})
+	},
+}
+
+var tokenRevokeCmd = &cobra.Command{
+	Use:   "revoke <name>",
+	Short: "Revoke a join token",
+	Long:  ``,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			if _, err := c.JoinTokenRevoke(ctx, args[0]); err != nil {
+				return fmt.Errorf("error revoking join token: %w", err)
+			}
+
+			return nil
+		})
+	},
+}
+
+var tokenListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List the join tokens",
+	Long:    ``,
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			var remotePeer peer.Peer
+
+			resp, err := c.JoinTokenList(ctx, grpc.Peer(&remotePeer))
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error listing join tokens: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tNAME\tCREATED\tEXPIRES\tEXPIRED")
+
+			defaultNode := client.AddrFromPeer(&remotePeer)
+
+			for _, msg := range resp.Messages {
+				node := defaultNode
+
+				if msg.Metadata != nil {
+					node = msg.Metadata.Hostname
+				}
+
+				for _, token := range msg.Tokens {
+					created, expires := "-", "never"
+
+					if token.GetCreatedAt() != nil {
+						created = token.GetCreatedAt().AsTime().Local().Format(time.RFC3339)
+					}
+
+					if token.GetExpiresAt() != nil {
+						expires = token.GetExpiresAt().AsTime().Local().Format(time.RFC3339)
+					}
+
+					fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%t\n", node, token.GetName(), created, expires, token.GetExpired())
+				}
+			}
+
+			return w.Flush()
+		})
+	},
+}
+
+func init() {
+	tokenCreateCmd.Flags().StringVar(&tokenCmdFlags.token, "token", "", "join token to create in the format [a-z0-9]{6}.[a-z0-9]{16} (generated if not set)")
+	tokenCreateCmd.Flags().DurationVar(&tokenCmdFlags.ttl, "ttl", 24*time.Hour, "time to live of the join token, 0 means the token never expires")
+
+	tokenCmd.AddCommand(tokenCreateCmd)
+	tokenCmd.AddCommand(tokenListCmd)
+	tokenCmd.AddCommand(tokenRevokeCmd)
+	addCommand(tokenCmd)
+}
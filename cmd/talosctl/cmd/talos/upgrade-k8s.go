@@ -6,7 +6,11 @@ package talos
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"os"
+	"text/tabwriter"
 
 	"github.com/siderolabs/go-kubernetes/kubernetes/upgrade"
 	"github.com/spf13/cobra"
@@ -36,8 +40,10 @@ var upgradeK8sCmdFlags struct {
 	FromVersion string
 	ToVersion   string
 
-	withExamples bool
-	withDocs     bool
+	withExamples  bool
+	withDocs      bool
+	preChecksOnly bool
+	output        string
 }
 
 func init() {
@@ -49,6 +55,9 @@ func init() {
 	upgradeK8sCmd.Flags().BoolVar(&upgradeOptions.DryRun, "dry-run", false, "skip the actual upgrade and show the upgrade plan instead")
 	upgradeK8sCmd.Flags().BoolVar(&upgradeOptions.PrePullImages, "pre-pull-images", true, "pre-pull images before upgrade")
 	upgradeK8sCmd.Flags().BoolVar(&upgradeOptions.UpgradeKubelet, "upgrade-kubelet", true, "upgrade kubelet service")
+	upgradeK8sCmd.Flags().BoolVar(&upgradeOptions.Force, "force", false, "proceed with the upgrade even if the pre-flight checks fail")
+	upgradeK8sCmd.Flags().BoolVar(&upgradeK8sCmdFlags.preChecksOnly, "pre-checks-only", false, "run the pre-flight checks and print the report without upgrading")
+	upgradeK8sCmd.Flags().StringVarP(&upgradeK8sCmdFlags.output, "output", "o", "table", "pre-flight checks report output format (table, json)")
 
 	upgradeK8sCmd.Flags().StringVar(&upgradeOptions.KubeletImage, "kubelet-image", constants.KubeletImage, "kubelet image to use")
 	upgradeK8sCmd.Flags().StringVar(&upgradeOptions.APIServerImage, "apiserver-image", constants.KubernetesAPIServerImage, "kube-apiserver image to use")
@@ -60,6 +69,10 @@ func init() {
 }
 
 func upgradeKubernetes(ctx context.Context, c *client.Client) error {
+	if upgradeK8sCmdFlags.output != "table" && upgradeK8sCmdFlags.output != "json" {
+		return fmt.Errorf("unsupported output format %q", upgradeK8sCmdFlags.output)
+	}
+
 	if err := helpers.FailIfMultiNodes(ctx, "upgrade-k8s"); err != nil {
 		return err
 	}
@@ -111,5 +124,52 @@ func upgradeKubernetes(ctx context.Context, c *client.Client) error {
 
 	upgradeOptions.EncoderOpt = encoder.WithComments(commentsFlags)
 
+	if upgradeK8sCmdFlags.preChecksOnly {
+		// keep stdout clean for the report
+		upgradeOptions.LogOutput = os.Stderr
+
+		report, err := k8s.Preflight(ctx, &state, upgradeOptions)
+		if err != nil {
+			return err
+		}
+
+		if err = printPreflightReport(report, upgradeK8sCmdFlags.output); err != nil {
+			return err
+		}
+
+		if report.HasErrors() {
+			return errors.New("pre-flight checks failed")
+		}
+
+		return nil
+	}
+
 	return k8s.Upgrade(ctx, &state, upgradeOptions)
 }
+
+func printPreflightReport(report *k8s.PreflightReport, output string) error {
+	if output == "json" {
+		out, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		fmt.Println(string(out))
+
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "NODE\tCHECK\tSEVERITY\tMESSAGE")
+
+	for _, check := range report.Checks {
+		node := check.Node
+		if node == "" {
+			node = "cluster"
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", node, check.Name, check.Severity, check.Message)
+	}
+
+	return w.Flush()
+}
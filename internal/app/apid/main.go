@@ -12,29 +12,36 @@ import (
 	"flag"
 	"fmt"
 	"log"
+	"net/netip"
 	"os/signal"
 	"regexp"
 	"slices"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/cosi-project/runtime/api/v1alpha1"
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/cosi-project/runtime/pkg/state/protobuf/client"
+	"github.com/rs/xid"
 	"github.com/siderolabs/go-debug"
 	"github.com/siderolabs/grpc-proxy/proxy"
+	eventsapi "github.com/siderolabs/siderolink/api/events"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/anypb"
 
 	apidbackend "github.com/siderolabs/talos/internal/app/apid/pkg/backend"
 	"github.com/siderolabs/talos/internal/app/apid/pkg/director"
 	"github.com/siderolabs/talos/internal/app/apid/pkg/provider"
+	"github.com/siderolabs/talos/internal/app/apid/pkg/revocation"
 	"github.com/siderolabs/talos/internal/pkg/selinux"
 	"github.com/siderolabs/talos/pkg/grpc/factory"
 	"github.com/siderolabs/talos/pkg/grpc/middleware/authz"
 	"github.com/siderolabs/talos/pkg/grpc/proxy/backend"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/startup"
 )
@@ -67,6 +74,8 @@ func apidMain() error {
 
 	rbacEnabled := flag.Bool("enable-rbac", false, "enable RBAC for Talos API")
 	extKeyUsageCheckEnabled := flag.Bool("enable-ext-key-usage-check", false, "enable check for client certificate ext key usage")
+	proxyTargetCheckEnabled := flag.Bool("enable-proxy-target-check", false, "only allow proxying requests to cluster members")
+	proxyTargetAllowedCIDRs := flag.String("proxy-target-allowed-cidrs", "", "comma-separated list of CIDRs allowed as proxy targets in addition to cluster members")
 
 	flag.Parse()
 
@@ -99,6 +108,11 @@ func apidMain() error {
 		serverTLSConfig.VerifyPeerCertificate = verifyExtKeyUsage
 	}
 
+	revocationChecker, err := revocation.NewChecker(ctx, resources, auditPublisher(eventsapi.NewEventSinkServiceClient(runtimeConn)))
+	if err != nil {
+		return fmt.Errorf("failed to create certificate revocation checker: %w", err)
+	}
+
 	clientTLSConfig, err := tlsConfig.ClientConfig()
 	if err != nil {
 		return fmt.Errorf("failed to create client TLS config: %w", err)
@@ -124,6 +138,30 @@ func apidMain() error {
 
 	router := director.NewRouter(remoteFactory, localBackend, localAddressProvider)
 
+	if *proxyTargetCheckEnabled {
+		var allowedCIDRs []netip.Prefix
+
+		for cidr := range strings.SplitSeq(*proxyTargetAllowedCIDRs, ",") {
+			if cidr == "" {
+				continue
+			}
+
+			prefix, err := netip.ParsePrefix(cidr)
+			if err != nil {
+				return fmt.Errorf("failed to parse allowed proxy target CIDR: %w", err)
+			}
+
+			allowedCIDRs = append(allowedCIDRs, prefix)
+		}
+
+		targetValidator, err := director.NewClusterTargetValidator(ctx, resources, localAddressProvider, allowedCIDRs)
+		if err != nil {
+			return fmt.Errorf("failed to create proxy target validator: %w", err)
+		}
+
+		router.SetTargetValidator(targetValidator)
+	}
+
 	// all existing streaming methods
 	for _, methodName := range []string{
 		"/machine.MachineService/Copy",
@@ -197,6 +235,8 @@ func apidMain() error {
 				),
 				grpc.MaxRecvMsgSize(constants.GRPCMaxMessageSize),
 			),
+			factory.WithUnaryInterceptor(revocationChecker.UnaryInterceptor()),
+			factory.WithStreamInterceptor(revocationChecker.StreamInterceptor()),
 			factory.WithUnaryInterceptor(injector.UnaryInterceptor()),
 			factory.WithStreamInterceptor(injector.StreamInterceptor()),
 		)
@@ -277,3 +317,26 @@ func verifyExtKeyUsage(_ [][]byte, verifiedChains [][]*x509.Certificate) error {
 
 	return nil
 }
+
+// auditPublisher publishes the requests rejected because of the revoked client certificates as machine events via machined.
+func auditPublisher(client eventsapi.EventSinkServiceClient) revocation.AuditFunc {
+	return func(ctx context.Context, event *machine.CertificateRevokedEvent) {
+		// publish the event even if the request is canceled
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+		defer cancel()
+
+		data, err := anypb.New(event)
+		if err != nil {
+			log.Printf("failed to marshal certificate revoked event: %s", err)
+
+			return
+		}
+
+		if _, err = client.Publish(ctx, &eventsapi.EventRequest{
+			Id:   xid.New().String(),
+			Data: data,
+		}); err != nil {
+			log.Printf("failed to publish certificate revoked event: %s", err)
+		}
+	}
+}
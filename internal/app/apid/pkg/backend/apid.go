@@ -72,6 +72,7 @@ func (a *APID) GetConnection(ctx context.Context, _ string) (context.Context, *g
 	md = md.Copy()
 
 	authz.SetMetadata(md, authz.GetRoles(ctx))
+	authz.SetPeerMetadata(md, authz.GetPeer(ctx))
 
 	if authority := md[":authority"]; len(authority) > 0 {
 		md.Set("proxyfrom", authority...)
@@ -7,6 +7,7 @@ package director
 
 import (
 	"context"
+	"log"
 	"regexp"
 	"slices"
 	"strings"
@@ -15,6 +16,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 )
 
@@ -23,6 +25,7 @@ type Router struct {
 	localBackend         proxy.Backend
 	remoteBackendFactory RemoteBackendFactory
 	localAddressProvider LocalAddressProvider
+	targetValidator      TargetValidator
 	streamedMatchers     []*regexp.Regexp
 }
 
@@ -64,6 +67,12 @@ func (r *Router) Director(ctx context.Context, fullMethodName string) (proxy.Mod
 		return proxy.One2One, nil, status.Error(codes.InvalidArgument, "node metadata must be single-valued")
 	}
 
+	if r.targetValidator != nil {
+		if err := r.validateTargets(ctx, fullMethodName, slices.Concat(node, nodes)); err != nil {
+			return proxy.One2One, nil, err
+		}
+	}
+
 	// special handling for cases when a single node is requested, but forwarding is disabled
 	//
 	// if there's a single destination, and that destination is local node, skip forwarding and send a request to the same node
@@ -93,6 +102,25 @@ func (r *Router) Director(ctx context.Context, fullMethodName string) (proxy.Mod
 	}
 }
 
+// validateTargets checks requested targets against the target validator.
+func (r *Router) validateTargets(ctx context.Context, fullMethodName string, targets []string) error {
+	for _, target := range targets {
+		if err := r.targetValidator.ValidateTarget(target); err != nil {
+			peerAddr := "unknown"
+
+			if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+				peerAddr = p.Addr.String()
+			}
+
+			log.Printf("audit: rejected proxy request %s from %s to %q: %s", fullMethodName, peerAddr, target, err)
+
+			return status.Errorf(codes.PermissionDenied, "proxying to %q is not allowed: %s", target, err)
+		}
+	}
+
+	return nil
+}
+
 // singleDirector sends request to a single instance in one-2-one mode.
 func (r *Router) singleDirector(target string) (proxy.Mode, []proxy.Backend, error) {
 	if r.remoteBackendFactory == nil {
@@ -127,6 +155,11 @@ func (r *Router) aggregateDirector(targets []string) (proxy.Mode, []proxy.Backen
 	return proxy.One2Many, backends, nil
 }
 
+// SetTargetValidator enables validation of the requested proxy targets.
+func (r *Router) SetTargetValidator(validator TargetValidator) {
+	r.targetValidator = validator
+}
+
 // StreamedDetector implements proxy.StreamedDetector.
 func (r *Router) StreamedDetector(fullMethodName string) bool {
 	return slices.ContainsFunc(r.streamedMatchers, func(regex *regexp.Regexp) bool { return regex.MatchString(fullMethodName) })
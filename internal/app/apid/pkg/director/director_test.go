@@ -155,6 +155,55 @@ func (suite *DirectorSuite) TestDirectorNoRemoteBackend() {
 	suite.Assert().NoError(err)
 }
 
+func (suite *DirectorSuite) TestDirectorTargetValidation() {
+	ctx := context.Background()
+
+	router := director.NewRouter(
+		mockBackendFactory,
+		suite.localBackend,
+		&mockLocalAddressProvider{
+			local: map[string]struct{}{
+				"localhost": {},
+			},
+		},
+	)
+	router.SetTargetValidator(&mockTargetValidator{
+		allowed: map[string]struct{}{
+			"localhost": {},
+			"127.0.0.2": {},
+		},
+	})
+
+	md := metadata.New(nil)
+	md.Set("node", "127.0.0.2")
+	mode, backends, err := router.Director(metadata.NewIncomingContext(ctx, md), "/service.Service/method")
+	suite.Assert().Equal(proxy.One2One, mode)
+	suite.Assert().Len(backends, 1)
+	suite.Assert().Equal("127.0.0.2", backends[0].(*mockBackend).target)
+	suite.Assert().NoError(err)
+
+	// spoofed target
+	md = metadata.New(nil)
+	md.Set("node", "169.254.169.254")
+	_, _, err = router.Director(metadata.NewIncomingContext(ctx, md), "/service.Service/method")
+	suite.Assert().Equal(codes.PermissionDenied, status.Code(err))
+	suite.Assert().Contains(err.Error(), `"169.254.169.254"`)
+
+	// a single spoofed target rejects the whole request
+	md = metadata.New(nil)
+	md.Set("nodes", "localhost", "127.0.0.2", "10.0.0.1")
+	_, backends, err = router.Director(metadata.NewIncomingContext(ctx, md), "/service.Service/method")
+	suite.Assert().Nil(backends)
+	suite.Assert().Equal(codes.PermissionDenied, status.Code(err))
+	suite.Assert().Contains(err.Error(), `"10.0.0.1"`)
+
+	// requests without targets go to the local node
+	mode, backends, err = router.Director(metadata.NewIncomingContext(ctx, metadata.New(nil)), "/service.Service/method")
+	suite.Assert().Equal(proxy.One2One, mode)
+	suite.Assert().Equal([]proxy.Backend{suite.localBackend}, backends)
+	suite.Assert().NoError(err)
+}
+
 func TestDirectorSuite(t *testing.T) {
 	suite.Run(t, new(DirectorSuite))
 }
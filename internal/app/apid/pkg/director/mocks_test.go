@@ -6,6 +6,7 @@ package director_test
 
 import (
 	"context"
+	"errors"
 
 	"github.com/siderolabs/grpc-proxy/proxy"
 	"google.golang.org/grpc"
@@ -44,3 +45,15 @@ func (m *mockLocalAddressProvider) IsLocalTarget(t string) bool {
 
 	return ok
 }
+
+type mockTargetValidator struct {
+	allowed map[string]struct{}
+}
+
+func (m *mockTargetValidator) ValidateTarget(t string) error {
+	if _, ok := m.allowed[t]; !ok {
+		return errors.New("not a cluster member")
+	}
+
+	return nil
+}
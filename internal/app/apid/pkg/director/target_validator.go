@@ -0,0 +1,168 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package director
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+	"sync"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/cluster"
+)
+
+// TargetValidator validates proxy targets requested by the clients.
+type TargetValidator interface {
+	ValidateTarget(string) error
+}
+
+// clusterTargetValidator allows only local node, cluster members and explicitly allowed CIDRs as proxy targets.
+type clusterTargetValidator struct {
+	localAddressProvider LocalAddressProvider
+	allowedCIDRs         []netip.Prefix
+
+	mu sync.Mutex
+
+	memberAddresses map[resource.ID][]netip.Addr
+	memberHostnames map[resource.ID]string
+}
+
+// NewClusterTargetValidator initializes and returns a new TargetValidator which watches cluster members.
+func NewClusterTargetValidator(ctx context.Context, st state.State, localAddressProvider LocalAddressProvider, allowedCIDRs []netip.Prefix) (TargetValidator, error) {
+	v := &clusterTargetValidator{
+		localAddressProvider: localAddressProvider,
+		allowedCIDRs:         allowedCIDRs,
+
+		memberAddresses: map[resource.ID][]netip.Addr{},
+		memberHostnames: map[resource.ID]string{},
+	}
+
+	evCh := make(chan state.Event)
+
+	if err := st.WatchKind(ctx, resource.NewMetadata(cluster.NamespaceName, cluster.MemberType, "", resource.VersionUndefined), evCh, state.WithBootstrapContents(true)); err != nil {
+		return nil, err
+	}
+
+	go v.watch(ctx, evCh)
+
+	return v, nil
+}
+
+func (v *clusterTargetValidator) watch(ctx context.Context, evCh <-chan state.Event) {
+	for {
+		var ev state.Event
+
+		select {
+		case <-ctx.Done():
+			return
+		case ev = <-evCh:
+		}
+
+		switch ev.Type {
+		case state.Created, state.Updated:
+			member, ok := ev.Resource.(*cluster.Member)
+			if !ok {
+				continue
+			}
+
+			v.mu.Lock()
+			v.memberAddresses[member.Metadata().ID()] = member.TypedSpec().Addresses
+			v.memberHostnames[member.Metadata().ID()] = member.TypedSpec().Hostname
+			v.mu.Unlock()
+		case state.Destroyed:
+			v.mu.Lock()
+			delete(v.memberAddresses, ev.Resource.Metadata().ID())
+			delete(v.memberHostnames, ev.Resource.Metadata().ID())
+			v.mu.Unlock()
+		case state.Bootstrapped, state.Errored, state.Noop:
+			// ignore
+		}
+	}
+}
+
+// ValidateTarget implements TargetValidator interface.
+func (v *clusterTargetValidator) ValidateTarget(target string) error {
+	if v.localAddressProvider.IsLocalTarget(target) {
+		return nil
+	}
+
+	host := target
+
+	if h, _, err := net.SplitHostPort(target); err == nil {
+		host = h
+	}
+
+	host = strings.TrimSuffix(strings.TrimPrefix(host, "["), "]")
+
+	if host != target && v.localAddressProvider.IsLocalTarget(host) {
+		return nil
+	}
+
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		// not an IP address, match against member hostnames
+		if v.isMemberHostname(host) {
+			return nil
+		}
+
+		return fmt.Errorf("target %q is not a cluster member", target)
+	}
+
+	addr = addr.Unmap().WithZone("")
+
+	for _, prefix := range v.allowedCIDRs {
+		if prefix.Contains(addr) {
+			return nil
+		}
+	}
+
+	if v.isMemberAddress(addr) {
+		return nil
+	}
+
+	return fmt.Errorf("target %q is not a cluster member or an allowed address", target)
+}
+
+func (v *clusterTargetValidator) isMemberHostname(hostname string) bool {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	for _, memberHostname := range v.memberHostnames {
+		if memberHostname == "" {
+			continue
+		}
+
+		if strings.EqualFold(memberHostname, hostname) {
+			return true
+		}
+
+		// allow short hostname if the member hostname is FQDN
+		if short, _, ok := strings.Cut(memberHostname, "."); ok && strings.EqualFold(short, hostname) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (v *clusterTargetValidator) isMemberAddress(addr netip.Addr) bool {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	for _, addresses := range v.memberAddresses {
+		for _, memberAddr := range addresses {
+			if memberAddr.Unmap() == addr {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,98 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package director_test
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/app/apid/pkg/director"
+	"github.com/siderolabs/talos/pkg/machinery/resources/cluster"
+)
+
+func TestClusterTargetValidator(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	member1 := cluster.NewMember(cluster.NamespaceName, "node-1")
+	member1.TypedSpec().Addresses = []netip.Addr{netip.MustParseAddr("172.20.0.2"), netip.MustParseAddr("fd00::2")}
+	member1.TypedSpec().Hostname = "node-1.example.org"
+
+	require.NoError(t, st.Create(ctx, member1))
+
+	validator, err := director.NewClusterTargetValidator(ctx, st,
+		&mockLocalAddressProvider{
+			local: map[string]struct{}{
+				"localhost":  {},
+				"172.20.0.1": {},
+			},
+		},
+		[]netip.Prefix{netip.MustParsePrefix("10.5.0.0/16")},
+	)
+	require.NoError(t, err)
+
+	// members existing before the start are picked up from the bootstrap contents
+	assert.EventuallyWithT(t, func(collect *assert.CollectT) {
+		assert.NoError(collect, validator.ValidateTarget("172.20.0.2"))
+	}, 5*time.Second, 10*time.Millisecond)
+
+	for _, target := range []string{
+		"localhost",
+		"172.20.0.1",
+		"172.20.0.1:50000",
+		"172.20.0.2:50000",
+		"fd00::2",
+		"[fd00::2]:50000",
+		"::ffff:172.20.0.2",
+		"node-1.example.org",
+		"node-1",
+		"10.5.3.4",
+	} {
+		assert.NoError(t, validator.ValidateTarget(target), "target %q", target)
+	}
+
+	// spoofed targets
+	for _, target := range []string{
+		"172.20.0.3",
+		"8.8.8.8:50000",
+		"[fd00::3]:50000",
+		"node-2",
+		"metadata.google.internal",
+	} {
+		assert.Error(t, validator.ValidateTarget(target), "target %q", target)
+	}
+
+	assert.EqualError(t, validator.ValidateTarget("172.20.0.3"), `target "172.20.0.3" is not a cluster member or an allowed address`)
+
+	// member joins after the start
+	member2 := cluster.NewMember(cluster.NamespaceName, "node-2")
+	member2.TypedSpec().Addresses = []netip.Addr{netip.MustParseAddr("172.20.0.3")}
+	member2.TypedSpec().Hostname = "node-2"
+
+	require.NoError(t, st.Create(ctx, member2))
+
+	assert.EventuallyWithT(t, func(collect *assert.CollectT) {
+		assert.NoError(collect, validator.ValidateTarget("172.20.0.3"))
+		assert.NoError(collect, validator.ValidateTarget("node-2"))
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// member leaves
+	require.NoError(t, st.Destroy(ctx, member1.Metadata()))
+
+	assert.EventuallyWithT(t, func(collect *assert.CollectT) {
+		assert.Error(collect, validator.ValidateTarget("172.20.0.2"))
+		assert.Error(collect, validator.ValidateTarget("node-1"))
+	}, 5*time.Second, 10*time.Millisecond)
+}
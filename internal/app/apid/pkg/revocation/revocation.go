@@ -0,0 +1,229 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package revocation implements the revocation checks of the Talos API client certificates.
+package revocation
+
+import (
+	"context"
+	stdx509 "crypto/x509"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/crypto/x509"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+var (
+	// ErrRevoked is returned to the client when the client certificate is revoked.
+	ErrRevoked = status.Error(codes.PermissionDenied, "client certificate is revoked")
+
+	// ErrNotLoaded is returned to the client when the revoked certificates are not loaded yet.
+	ErrNotLoaded = status.Error(codes.Unavailable, "certificate revocation state is not loaded yet")
+)
+
+// AuditFunc is called for every request rejected because of the revoked client certificate.
+type AuditFunc func(ctx context.Context, event *machine.CertificateRevokedEvent)
+
+// Checker rejects the requests authenticated with the revoked client certificates.
+//
+// The revoked certificates are watched via secrets.CertificateRevocation resource.
+// Until the resource is loaded, all requests authenticated with a client certificate are rejected.
+type Checker struct {
+	audit AuditFunc
+
+	loaded     chan struct{}
+	loadedOnce sync.Once
+
+	mu sync.Mutex
+
+	fingerprints     map[string]struct{}
+	serialNumbers    map[string]struct{}
+	crlNextUpdate    time.Time
+	denyOnExpiredCRL bool
+	expiredReported  bool
+}
+
+// NewChecker initializes and returns a new Checker which watches the revoked certificates.
+func NewChecker(ctx context.Context, st state.State, audit AuditFunc) (*Checker, error) {
+	c := &Checker{
+		audit:  audit,
+		loaded: make(chan struct{}),
+	}
+
+	evCh := make(chan state.Event)
+
+	if err := st.Watch(ctx, resource.NewMetadata(secrets.NamespaceName, secrets.CertificateRevocationType, secrets.CertificateRevocationID, resource.VersionUndefined), evCh); err != nil {
+		return nil, err
+	}
+
+	go c.watch(ctx, evCh)
+
+	return c, nil
+}
+
+func (c *Checker) watch(ctx context.Context, evCh <-chan state.Event) {
+	for {
+		var ev state.Event
+
+		select {
+		case <-ctx.Done():
+			return
+		case ev = <-evCh:
+		}
+
+		switch ev.Type {
+		case state.Created, state.Updated:
+			revocation, ok := ev.Resource.(*secrets.CertificateRevocation)
+			if !ok {
+				continue
+			}
+
+			c.update(revocation.TypedSpec())
+
+			c.loadedOnce.Do(func() { close(c.loaded) })
+		case state.Destroyed:
+			// the resource is always created by machined, so the initial state is not loaded until it appears
+			c.update(&secrets.CertificateRevocationSpec{})
+		case state.Bootstrapped, state.Errored, state.Noop:
+			// ignore
+		}
+	}
+}
+
+func (c *Checker) update(spec *secrets.CertificateRevocationSpec) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.fingerprints = make(map[string]struct{}, len(spec.Fingerprints))
+
+	for _, fingerprint := range spec.Fingerprints {
+		c.fingerprints[fingerprint] = struct{}{}
+	}
+
+	c.serialNumbers = make(map[string]struct{}, len(spec.SerialNumbers))
+
+	for _, serialNumber := range spec.SerialNumbers {
+		c.serialNumbers[serialNumber] = struct{}{}
+	}
+
+	if !spec.CRLNextUpdate.Equal(c.crlNextUpdate) {
+		c.expiredReported = false
+	}
+
+	c.crlNextUpdate = spec.CRLNextUpdate
+	c.denyOnExpiredCRL = spec.DenyOnExpiredCRL
+}
+
+// Loaded returns a channel which is closed once the revoked certificates are loaded.
+func (c *Checker) Loaded() <-chan struct{} {
+	return c.loaded
+}
+
+// revoked returns the reason if the certificate is revoked, or an empty string otherwise.
+func (c *Checker) revoked(cert *stdx509.Certificate, fingerprint string, now time.Time) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.fingerprints[fingerprint]; ok {
+		return "fingerprint is revoked"
+	}
+
+	if _, ok := c.serialNumbers[cert.SerialNumber.Text(16)]; ok {
+		return "serial number is revoked"
+	}
+
+	if !c.crlNextUpdate.IsZero() && now.After(c.crlNextUpdate) {
+		if c.denyOnExpiredCRL {
+			return "certificate revocation list is expired"
+		}
+
+		if !c.expiredReported {
+			log.Printf("certificate revocation list is expired since %s, the client certificates are still accepted", c.crlNextUpdate)
+
+			c.expiredReported = true
+		}
+	}
+
+	return ""
+}
+
+// Check verifies that the client certificate of the request is not revoked.
+//
+// The requests without a client certificate (e.g. on the local socket) are not checked.
+func (c *Checker) Check(ctx context.Context, method string) error {
+	remotePeer, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	tlsInfo, ok := remotePeer.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.PeerCertificates) == 0 {
+		return nil
+	}
+
+	cert := tlsInfo.State.PeerCertificates[0]
+
+	select {
+	case <-c.loaded:
+	default:
+		log.Printf("rejected %s from %s: certificate revocation state is not loaded yet", method, remotePeer.Addr)
+
+		return ErrNotLoaded
+	}
+
+	fingerprint := x509.SPKIFingerprint(cert).String()
+
+	reason := c.revoked(cert, fingerprint, time.Now())
+	if reason == "" {
+		return nil
+	}
+
+	log.Printf("rejected %s from %s: client certificate %q %s", method, remotePeer.Addr, cert.Subject, reason)
+
+	if c.audit != nil {
+		c.audit(ctx, &machine.CertificateRevokedEvent{
+			Source:       remotePeer.Addr.String(),
+			Subject:      cert.Subject.String(),
+			SerialNumber: cert.SerialNumber.Text(16),
+			Fingerprint:  fingerprint,
+			Method:       method,
+			Reason:       reason,
+		})
+	}
+
+	return ErrRevoked
+}
+
+// UnaryInterceptor returns grpc UnaryServerInterceptor.
+func (c *Checker) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := c.Check(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor returns grpc StreamServerInterceptor.
+func (c *Checker) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := c.Check(stream.Context(), info.FullMethod); err != nil {
+			return err
+		}
+
+		return handler(srv, stream)
+	}
+}
@@ -0,0 +1,142 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package revocation_test
+
+import (
+	"context"
+	"crypto/tls"
+	stdx509 "crypto/x509"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/siderolabs/crypto/x509"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/internal/app/apid/pkg/revocation"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+type mockAudit struct {
+	mu     sync.Mutex
+	events []*machine.CertificateRevokedEvent
+}
+
+func (m *mockAudit) audit(_ context.Context, event *machine.CertificateRevokedEvent) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.events = append(m.events, event)
+}
+
+func (m *mockAudit) get() []*machine.CertificateRevokedEvent {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]*machine.CertificateRevokedEvent(nil), m.events...)
+}
+
+func peerContext(ctx context.Context, cert *stdx509.Certificate) context.Context {
+	return peer.NewContext(ctx, &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP("172.20.0.1"), Port: 52000},
+		AuthInfo: credentials.TLSInfo{
+			State: tls.ConnectionState{
+				PeerCertificates: []*stdx509.Certificate{cert},
+			},
+		},
+	})
+}
+
+func TestChecker(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	revoked, err := x509.NewSelfSignedCertificateAuthority()
+	require.NoError(t, err)
+
+	valid, err := x509.NewSelfSignedCertificateAuthority()
+	require.NoError(t, err)
+
+	audit := &mockAudit{}
+
+	checker, err := revocation.NewChecker(ctx, st, audit.audit)
+	require.NoError(t, err)
+
+	// the revocation state is not loaded yet, all client certificates are rejected
+	assert.Equal(t, codes.Unavailable, status.Code(checker.Check(peerContext(ctx, valid.Crt), "/machine.MachineService/Version")))
+	assert.Empty(t, audit.get())
+
+	// requests without a client certificate are not checked
+	require.NoError(t, checker.Check(ctx, "/machine.MachineService/Version"))
+
+	// no revocation configured
+	res := secrets.NewCertificateRevocation()
+	require.NoError(t, st.Create(ctx, res))
+
+	select {
+	case <-checker.Loaded():
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "revocation state is not loaded")
+	}
+
+	require.NoError(t, checker.Check(peerContext(ctx, revoked.Crt), "/machine.MachineService/Version"))
+
+	res.TypedSpec().Fingerprints = []string{x509.SPKIFingerprint(revoked.Crt).String()}
+	require.NoError(t, st.Update(ctx, res))
+
+	assert.EventuallyWithT(t, func(collect *assert.CollectT) {
+		assert.Equal(collect, codes.PermissionDenied, status.Code(checker.Check(peerContext(ctx, revoked.Crt), "/machine.MachineService/Reboot")))
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, checker.Check(peerContext(ctx, valid.Crt), "/machine.MachineService/Reboot"))
+
+	events := audit.get()
+	require.NotEmpty(t, events)
+	assert.Equal(t, "/machine.MachineService/Reboot", events[0].Method)
+	assert.Equal(t, "172.20.0.1:52000", events[0].Source)
+	assert.Equal(t, "fingerprint is revoked", events[0].Reason)
+
+	// revoke by serial number, the fingerprint revocation is lifted
+	res.TypedSpec().Fingerprints = nil
+	res.TypedSpec().SerialNumbers = []string{valid.Crt.SerialNumber.Text(16)}
+	require.NoError(t, st.Update(ctx, res))
+
+	assert.EventuallyWithT(t, func(collect *assert.CollectT) {
+		assert.NoError(collect, checker.Check(peerContext(ctx, revoked.Crt), "/machine.MachineService/Reboot"))
+		assert.Equal(collect, codes.PermissionDenied, status.Code(checker.Check(peerContext(ctx, valid.Crt), "/machine.MachineService/Reboot")))
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// expired CRL fails open
+	res.TypedSpec().SerialNumbers = nil
+	res.TypedSpec().CRLNextUpdate = time.Now().Add(-time.Hour)
+	require.NoError(t, st.Update(ctx, res))
+
+	assert.EventuallyWithT(t, func(collect *assert.CollectT) {
+		assert.NoError(collect, checker.Check(peerContext(ctx, valid.Crt), "/machine.MachineService/Reboot"))
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// expired CRL fails closed
+	res.TypedSpec().DenyOnExpiredCRL = true
+	require.NoError(t, st.Update(ctx, res))
+
+	assert.EventuallyWithT(t, func(collect *assert.CollectT) {
+		assert.Equal(collect, codes.PermissionDenied, status.Code(checker.Check(peerContext(ctx, valid.Crt), "/machine.MachineService/Reboot")))
+	}, 5*time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, "certificate revocation list is expired", audit.get()[len(audit.get())-1].Reason)
+}
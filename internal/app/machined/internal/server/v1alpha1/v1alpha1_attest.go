@@ -0,0 +1,98 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"maps"
+	"slices"
+	"time"
+
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/internal/pkg/secureboot/attestation"
+	"github.com/siderolabs/talos/internal/pkg/secureboot/eventlog"
+	"github.com/siderolabs/talos/internal/pkg/secureboot/tpm2"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// attestLimiter limits the rate of the quotes: the TPM is slow, and it is shared with the disk encryption.
+var attestLimiter = rate.NewLimiter(rate.Every(time.Second), 5)
+
+// Attest implements the machine.MachineServer interface.
+func (s *Server) Attest(ctx context.Context, in *machine.AttestRequest) (*machine.AttestResponse, error) {
+	if len(in.Nonce) == 0 || len(in.Nonce) > attestation.MaxNonceSize {
+		return nil, status.Errorf(codes.InvalidArgument, "nonce should be 1 to %d bytes long", attestation.MaxNonceSize)
+	}
+
+	pcrs := make([]int, 0, len(in.Pcrs))
+
+	for _, pcr := range in.Pcrs {
+		// PC Client TPMs have 24 PCRs
+		if pcr < 0 || pcr > 23 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid PCR index %d", pcr)
+		}
+
+		pcrs = append(pcrs, int(pcr))
+	}
+
+	pcrBank := cmp.Or(in.PcrBank, constants.DefaultTPMPCRBank)
+
+	if _, err := tpm2.PCRBankAlg(pcrBank); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if !attestLimiter.Allow() {
+		return nil, status.Error(codes.ResourceExhausted, "attestation rate limit exceeded, retry later")
+	}
+
+	quote, err := tpm2.Quote(in.Nonce, tpm2.QuoteOptions{
+		PCRs:    pcrs,
+		PCRBank: pcrBank,
+	})
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, status.Error(codes.FailedPrecondition, "TPM device is not available")
+		}
+
+		return nil, fmt.Errorf("error producing the TPM quote: %w", err)
+	}
+
+	// the event log is optional, the verifier might rely on the PCR values only
+	_, eventLog, err := eventlog.Read()
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		log.Printf("failed to read the TPM event log: %s", err)
+	}
+
+	attestationPCRs := make([]*machine.AttestationPCR, 0, len(quote.PCRs))
+
+	for _, pcr := range slices.Sorted(maps.Keys(quote.PCRs)) {
+		attestationPCRs = append(attestationPCRs, &machine.AttestationPCR{
+			Index: int32(pcr),
+			Value: quote.PCRs[pcr],
+		})
+	}
+
+	return &machine.AttestResponse{
+		Messages: []*machine.Attestation{
+			{
+				Quote:     quote.Quote,
+				Signature: quote.Signature,
+				AkPublic:  quote.AKPublic,
+				PcrBank:   pcrBank,
+				Pcrs:      attestationPCRs,
+				EventLog:  eventLog,
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,256 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/hexops/gotextdiff"
+	"github.com/hexops/gotextdiff/myers"
+	"github.com/hexops/gotextdiff/span"
+	"gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/discovery"
+	memory "k8s.io/client-go/discovery/cached"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	k8sadapter "github.com/siderolabs/talos/internal/app/machined/pkg/adapters/k8s"
+	inspectapi "github.com/siderolabs/talos/pkg/machinery/api/inspect"
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+// BootstrapManifests implements inspect.InspectService interface.
+//
+// BootstrapManifests returns the objects of the rendered bootstrap manifests, and optionally
+// computes the diff against the live cluster objects using server-side apply in dry-run mode.
+//
+//nolint:gocyclo
+func (s *InspectServer) BootstrapManifests(ctx context.Context, in *inspectapi.BootstrapManifestsRequest) (*inspectapi.BootstrapManifestsResponse, error) {
+	if err := s.server.checkControlplane("bootstrap manifests"); err != nil {
+		return nil, err
+	}
+
+	st := s.server.Controller.Runtime().State().V1Alpha2().Resources()
+
+	manifests, err := safe.StateListAll[*k8s.Manifest](ctx, st)
+	if err != nil {
+		return nil, fmt.Errorf("error listing manifests: %w", err)
+	}
+
+	var differ *manifestDiffer
+
+	if in.Diff {
+		differ, err = newManifestDiffer(ctx, st)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var objects []*inspectapi.BootstrapManifestObject
+
+	for manifest := range manifests.All() {
+		for _, obj := range k8sadapter.Manifest(manifest).Objects() {
+			object := &inspectapi.BootstrapManifestObject{
+				Manifest:   manifest.Metadata().ID(),
+				ApiVersion: obj.GetAPIVersion(),
+				Kind:       obj.GetKind(),
+				Namespace:  obj.GetNamespace(),
+				Name:       obj.GetName(),
+				Policy:     obj.GetAnnotations()[constants.AnnotationManifestPolicy],
+			}
+
+			if differ != nil {
+				if err = differ.diff(ctx, obj, object); err != nil {
+					return nil, err
+				}
+			}
+
+			objects = append(objects, object)
+		}
+	}
+
+	slices.SortStableFunc(objects, func(a, b *inspectapi.BootstrapManifestObject) int {
+		return cmp.Compare(a.Manifest, b.Manifest)
+	})
+
+	return &inspectapi.BootstrapManifestsResponse{
+		Messages: []*inspectapi.BootstrapManifests{
+			{
+				Objects: objects,
+			},
+		},
+	}, nil
+}
+
+// manifestDiffer computes the diff between the manifest objects and the live cluster objects.
+type manifestDiffer struct {
+	mapper *restmapper.DeferredDiscoveryRESTMapper
+	dyn    dynamic.Interface
+}
+
+func newManifestDiffer(ctx context.Context, st state.State) (*manifestDiffer, error) {
+	k8sSecrets, err := safe.StateGetByID[*secrets.Kubernetes](ctx, st, secrets.KubernetesID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting kubernetes secrets: %w", err)
+	}
+
+	kubeconfig, err := clientcmd.BuildConfigFromKubeconfigGetter("", func() (*clientcmdapi.Config, error) {
+		return clientcmd.Load([]byte(k8sSecrets.TypedSpec().LocalhostAdminKubeconfig))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error loading kubeconfig: %w", err)
+	}
+
+	dc, err := discovery.NewDiscoveryClientForConfig(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("error building discovery client: %w", err)
+	}
+
+	dyn, err := dynamic.NewForConfig(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("error building dynamic client: %w", err)
+	}
+
+	return &manifestDiffer{
+		mapper: restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(dc)),
+		dyn:    dyn,
+	}, nil
+}
+
+func (d *manifestDiffer) diff(ctx context.Context, obj *unstructured.Unstructured, object *inspectapi.BootstrapManifestObject) error {
+	gvk := obj.GroupVersionKind()
+
+	mapping, err := d.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		if meta.IsNoMatchError(err) {
+			// the resource type is not known yet (e.g. the CRD is not created), so the object is going to be created
+			object.Action = inspectapi.BootstrapManifestAction_CREATE
+			object.Diff, err = unifiedDiff(obj.GetName(), nil, obj)
+
+			return err
+		}
+
+		return fmt.Errorf("error creating mapping for object %s/%s: %w", gvk.Kind, obj.GetName(), err)
+	}
+
+	var dr dynamic.ResourceInterface
+
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		if obj.GetNamespace() == "" {
+			obj.SetNamespace(corev1.NamespaceDefault)
+			object.Namespace = corev1.NamespaceDefault
+		}
+
+		dr = d.dyn.Resource(mapping.Resource).Namespace(obj.GetNamespace())
+	} else {
+		dr = d.dyn.Resource(mapping.Resource)
+	}
+
+	live, err := dr.Get(ctx, obj.GetName(), metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("error getting object %s/%s: %w", gvk.Kind, obj.GetName(), err)
+		}
+
+		object.Action = inspectapi.BootstrapManifestAction_CREATE
+		object.Diff, err = unifiedDiff(obj.GetName(), nil, obj)
+
+		return err
+	}
+
+	if object.Policy == talosconfig.BootstrapManifestPolicyCreateOnly {
+		object.Action = inspectapi.BootstrapManifestAction_SKIP
+
+		return nil
+	}
+
+	// run server-side apply in dry-run mode, so that the defaulting and pruning of the fields
+	// is done by the API server, and the result can be compared with the live object
+	applied, err := dr.Apply(ctx, obj.GetName(), obj, metav1.ApplyOptions{
+		FieldManager: "talos",
+		Force:        true,
+		DryRun:       []string{metav1.DryRunAll},
+	})
+	if err != nil {
+		return fmt.Errorf("error applying object %s/%s in dry-run mode: %w", gvk.Kind, obj.GetName(), err)
+	}
+
+	object.Diff, err = unifiedDiff(obj.GetName(), live, applied)
+	if err != nil {
+		return err
+	}
+
+	if object.Diff == "" {
+		object.Action = inspectapi.BootstrapManifestAction_UNCHANGED
+	} else {
+		object.Action = inspectapi.BootstrapManifestAction_UPDATE
+	}
+
+	return nil
+}
+
+// unifiedDiff returns the unified diff between two objects ignoring the fields managed by the API server.
+//
+// Either of the objects might be nil.
+func unifiedDiff(name string, from, to *unstructured.Unstructured) (string, error) {
+	fromYAML, err := marshalForDiff(from)
+	if err != nil {
+		return "", err
+	}
+
+	toYAML, err := marshalForDiff(to)
+	if err != nil {
+		return "", err
+	}
+
+	if fromYAML == toYAML {
+		return "", nil
+	}
+
+	edits := myers.ComputeEdits(span.URIFromPath(name), fromYAML, toYAML)
+
+	return fmt.Sprint(gotextdiff.ToUnified("live/"+name, "manifest/"+name, fromYAML, edits)), nil
+}
+
+func marshalForDiff(obj *unstructured.Unstructured) (string, error) {
+	if obj == nil {
+		return "", nil
+	}
+
+	obj = obj.DeepCopy()
+
+	for _, field := range [][]string{
+		{"metadata", "managedFields"},
+		{"metadata", "resourceVersion"},
+		{"metadata", "uid"},
+		{"metadata", "generation"},
+		{"metadata", "creationTimestamp"},
+		{"status"},
+	} {
+		unstructured.RemoveNestedField(obj.Object, field...)
+	}
+
+	out, err := yaml.Marshal(obj.Object)
+	if err != nil {
+		return "", fmt.Errorf("error marshaling object %s: %w", obj.GetName(), err)
+	}
+
+	return string(out), nil
+}
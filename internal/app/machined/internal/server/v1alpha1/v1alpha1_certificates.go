@@ -0,0 +1,141 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/xslices"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	secretsres "github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+// certificateRotationTimeout is the time to wait for the certificates of a single component to be re-issued.
+const certificateRotationTimeout = 5 * time.Minute
+
+// controlPlaneCertificateComponents are the components which are only present on control plane nodes.
+var controlPlaneCertificateComponents = []string{
+	secretsres.CertificateComponentEtcd,
+	secretsres.CertificateComponentAPIServer,
+	secretsres.CertificateComponentKubeconfig,
+}
+
+// RotateCertificates implements the machine.MachineServer interface.
+func (s *Server) RotateCertificates(ctx context.Context, in *machine.RotateCertificatesRequest) (*machine.RotateCertificatesResponse, error) {
+	if len(in.Components) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one component should be specified")
+	}
+
+	for _, component := range in.Components {
+		if !slices.Contains(secretsres.CertificateComponents, component) {
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported component %q, supported components: %s", component, strings.Join(secretsres.CertificateComponents, ", "))
+		}
+	}
+
+	// rotate the components in the fixed order regardless of the order in the request
+	components := xslices.Filter(secretsres.CertificateComponents, func(component string) bool {
+		return slices.Contains(in.Components, component)
+	})
+
+	for _, component := range components {
+		if slices.Contains(controlPlaneCertificateComponents, component) {
+			if err := s.checkControlplane(component + " certificate rotation"); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, component := range components {
+		log.Printf("rotating %s certificates via API", component)
+
+		if err := s.rotateCertificates(ctx, component); err != nil {
+			return nil, err
+		}
+	}
+
+	return &machine.RotateCertificatesResponse{
+		Messages: []*machine.RotateCertificates{
+			{
+				Components: components,
+			},
+		},
+	}, nil
+}
+
+// rotateCertificates requests the rotation of the component certificates, and waits for all of them to be re-issued.
+func (s *Server) rotateCertificates(ctx context.Context, component string) error {
+	st := s.Controller.Runtime().State().V1Alpha2().Resources()
+
+	statuses, err := safe.StateListAll[*secretsres.CertificateStatus](ctx, st)
+	if err != nil {
+		return fmt.Errorf("error listing certificate statuses: %w", err)
+	}
+
+	// certificate ID -> serial number before the rotation
+	issued := map[resource.ID]string{}
+
+	for certStatus := range statuses.All() {
+		if certStatus.TypedSpec().Component == component {
+			issued[certStatus.Metadata().ID()] = certStatus.TypedSpec().SerialNumber
+		}
+	}
+
+	if len(issued) == 0 {
+		return status.Errorf(codes.FailedPrecondition, "no %s certificates found", component)
+	}
+
+	requestedAt := time.Now()
+
+	_, err = safe.StateUpdateWithConflicts(ctx, st, secretsres.NewCertificateRotation(component).Metadata(), func(r *secretsres.CertificateRotation) error {
+		r.TypedSpec().RequestedAt = requestedAt
+
+		return nil
+	})
+	if state.IsNotFoundError(err) {
+		rotation := secretsres.NewCertificateRotation(component)
+		rotation.TypedSpec().RequestedAt = requestedAt
+
+		err = st.Create(ctx, rotation)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error requesting %s certificate rotation: %w", component, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, certificateRotationTimeout)
+	defer cancel()
+
+	for id, serialNumber := range issued {
+		if _, err = st.WatchFor(ctx, secretsres.NewCertificateStatus(id).Metadata(),
+			state.WithCondition(func(r resource.Resource) (bool, error) {
+				if resource.IsTombstone(r) {
+					return false, nil
+				}
+
+				certStatus, ok := r.(*secretsres.CertificateStatus)
+				if !ok {
+					return false, nil
+				}
+
+				return certStatus.TypedSpec().SerialNumber != serialNumber, nil
+			}),
+		); err != nil {
+			return fmt.Errorf("error waiting for %s certificate to be rotated: %w", id, err)
+		}
+	}
+
+	return nil
+}
@@ -75,7 +75,13 @@ func (s *Server) HealthCheck(in *clusterapi.HealthCheckRequest, srv clusterapi.C
 		return err
 	}
 
-	return check.Wait(checkCtx, &state, append(check.DefaultClusterChecks(), check.ExtraClusterChecks()...), &healthReporter{srv: srv})
+	checks := append(check.DefaultClusterChecks(), check.ExtraClusterChecks()...)
+
+	if in.GetClusterInfo().GetRequireSecureBoot() {
+		checks = append(checks, check.SecureBootChecks()...)
+	}
+
+	return check.Wait(checkCtx, &state, checks, &healthReporter{srv: srv})
 }
 
 type healthReporter struct {
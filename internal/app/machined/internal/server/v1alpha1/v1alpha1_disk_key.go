@@ -0,0 +1,124 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/xslices"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+)
+
+// diskKeyRotationTimeout is the time to wait for the disk encryption keys of a volume to be rotated.
+const diskKeyRotationTimeout = 10 * time.Minute
+
+// RotateDiskKey implements the machine.MachineServer interface.
+func (s *Server) RotateDiskKey(ctx context.Context, in *machine.RotateDiskKeyRequest) (*machine.RotateDiskKeyResponse, error) {
+	if in.VolumeId == "" {
+		return nil, status.Error(codes.InvalidArgument, "volume ID should be specified")
+	}
+
+	slots := xslices.Map(in.Slots, func(slot int64) int { return int(slot) })
+
+	for _, slot := range slots {
+		if slot < 0 || slot == constants.EncryptionKeyRotationSlot {
+			return nil, status.Errorf(codes.InvalidArgument, "key slot %d can't be rotated", slot)
+		}
+	}
+
+	st := s.Controller.Runtime().State().V1Alpha2().Resources()
+
+	volumeStatus, err := safe.StateGetByID[*block.VolumeStatus](ctx, st, in.VolumeId)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "volume %q not found", in.VolumeId)
+		}
+
+		return nil, fmt.Errorf("error getting volume status: %w", err)
+	}
+
+	if volumeStatus.TypedSpec().EncryptionProvider != block.EncryptionProviderLUKS2 {
+		return nil, status.Errorf(codes.FailedPrecondition, "volume %q is not encrypted", in.VolumeId)
+	}
+
+	if volumeStatus.TypedSpec().Phase != block.VolumePhaseReady {
+		return nil, status.Errorf(codes.FailedPrecondition, "volume %q is not ready", in.VolumeId)
+	}
+
+	log.Printf("rotating disk encryption keys of volume %s via API", in.VolumeId)
+
+	requestedAt := time.Now()
+
+	_, err = safe.StateUpdateWithConflicts(ctx, st, block.NewVolumeKeyRotation(block.NamespaceName, in.VolumeId).Metadata(), func(r *block.VolumeKeyRotation) error {
+		r.TypedSpec().RequestedAt = requestedAt
+		r.TypedSpec().Slots = slots
+
+		return nil
+	})
+	if state.IsNotFoundError(err) {
+		rotation := block.NewVolumeKeyRotation(block.NamespaceName, in.VolumeId)
+		rotation.TypedSpec().RequestedAt = requestedAt
+		rotation.TypedSpec().Slots = slots
+
+		err = st.Create(ctx, rotation)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error requesting disk key rotation: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, diskKeyRotationTimeout)
+	defer cancel()
+
+	res, err := st.WatchFor(ctx, block.NewVolumeKeyStatus(block.NamespaceName, in.VolumeId).Metadata(),
+		state.WithCondition(func(r resource.Resource) (bool, error) {
+			if resource.IsTombstone(r) {
+				return false, nil
+			}
+
+			keyStatus, ok := r.(*block.VolumeKeyStatus)
+			if !ok {
+				return false, nil
+			}
+
+			return keyStatus.TypedSpec().RotationRequestedAt.Equal(requestedAt), nil
+		}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error waiting for disk key rotation: %w", err)
+	}
+
+	keyStatus := res.(*block.VolumeKeyStatus) //nolint:forcetypeassert,errcheck
+
+	if keyStatus.TypedSpec().RotationError != "" {
+		return nil, status.Errorf(codes.Aborted, "error rotating disk encryption keys of volume %q: %s", in.VolumeId, keyStatus.TypedSpec().RotationError)
+	}
+
+	return &machine.RotateDiskKeyResponse{
+		Messages: []*machine.RotateDiskKey{
+			{
+				VolumeId: in.VolumeId,
+				Slots: xslices.Map(keyStatus.TypedSpec().Slots, func(slot block.VolumeKeySlotSpec) *machine.RotateDiskKeySlot {
+					return &machine.RotateDiskKeySlot{
+						Slot:     int64(slot.Slot),
+						Provider: slot.Provider,
+						Epoch:    int64(slot.Epoch),
+					}
+				}),
+			},
+		},
+	}, nil
+}
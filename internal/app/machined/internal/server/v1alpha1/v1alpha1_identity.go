@@ -0,0 +1,152 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+	"github.com/siderolabs/talos/pkg/machinery/resources/cluster"
+	"github.com/siderolabs/talos/pkg/machinery/resources/kubespan"
+)
+
+// identityResetTimeout is the time to wait for the node identity to be regenerated.
+const identityResetTimeout = time.Minute
+
+// ResetIdentity implements the machine.MachineServer interface.
+//
+//nolint:gocyclo
+func (s *Server) ResetIdentity(ctx context.Context, in *machine.ResetIdentityRequest) (*machine.ResetIdentityResponse, error) {
+	if !in.Confirm {
+		return nil, status.Error(codes.InvalidArgument, "node identity reset should be confirmed explicitly")
+	}
+
+	st := s.Controller.Runtime().State().V1Alpha2().Resources()
+
+	// the volumes encrypted with the node ID key can't be unlocked with the new node identity
+	volumeConfigs, err := safe.StateListAll[*block.VolumeConfig](ctx, st)
+	if err != nil {
+		return nil, fmt.Errorf("error listing volume configs: %w", err)
+	}
+
+	for volumeConfig := range volumeConfigs.All() {
+		for _, key := range volumeConfig.TypedSpec().Encryption.Keys {
+			if key.Type == block.EncryptionKeyNodeID {
+				return nil, status.Errorf(codes.FailedPrecondition,
+					"volume %q is encrypted with the node ID key, it would not be unlocked with the new node identity", volumeConfig.Metadata().ID())
+			}
+		}
+	}
+
+	identity, err := safe.StateGetByID[*cluster.Identity](ctx, st, cluster.LocalIdentity)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Error(codes.Unavailable, "node identity is not established yet")
+		}
+
+		return nil, fmt.Errorf("error getting node identity: %w", err)
+	}
+
+	previousNodeID := identity.TypedSpec().NodeID
+
+	var previousPublicKey string
+
+	kubespanIdentity, err := safe.StateGetByID[*kubespan.Identity](ctx, st, kubespan.LocalIdentity)
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, fmt.Errorf("error getting KubeSpan identity: %w", err)
+	}
+
+	if kubespanIdentity != nil {
+		previousPublicKey = kubespanIdentity.TypedSpec().PublicKey
+	}
+
+	log.Printf("resetting node identity %s via API", previousNodeID)
+
+	requestedAt := time.Now()
+
+	_, err = safe.StateUpdateWithConflicts(ctx, st, cluster.NewIdentityReset(cluster.NamespaceName, cluster.LocalIdentity).Metadata(), func(r *cluster.IdentityReset) error {
+		r.TypedSpec().RequestedAt = requestedAt
+
+		return nil
+	})
+	if state.IsNotFoundError(err) {
+		identityReset := cluster.NewIdentityReset(cluster.NamespaceName, cluster.LocalIdentity)
+		identityReset.TypedSpec().RequestedAt = requestedAt
+
+		err = st.Create(ctx, identityReset)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error requesting node identity reset: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, identityResetTimeout)
+	defer cancel()
+
+	res, err := st.WatchFor(ctx, cluster.NewIdentity(cluster.NamespaceName, cluster.LocalIdentity).Metadata(),
+		state.WithCondition(func(r resource.Resource) (bool, error) {
+			if resource.IsTombstone(r) {
+				return false, nil
+			}
+
+			newIdentity, ok := r.(*cluster.Identity)
+			if !ok {
+				return false, nil
+			}
+
+			return newIdentity.TypedSpec().NodeID != previousNodeID, nil
+		}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error waiting for the new node identity: %w", err)
+	}
+
+	reply := &machine.ResetIdentity{
+		PreviousNodeId: previousNodeID,
+		NodeId:         res.(*cluster.Identity).TypedSpec().NodeID, //nolint:forcetypeassert,errcheck
+		Notes: []string{
+			"/etc/machine-id was regenerated, restart the kubelet service to report the new machine ID to Kubernetes",
+			"the discovery service entry of the previous node ID expires on its own, the other members see the new node ID on the next update",
+			"the stable default hostname, iSCSI initiator name and NVMe host NQN are derived from the node identity and change with it",
+		},
+	}
+
+	if previousPublicKey != "" {
+		res, err = st.WatchFor(ctx, kubespan.NewIdentity(kubespan.NamespaceName, kubespan.LocalIdentity).Metadata(),
+			state.WithCondition(func(r resource.Resource) (bool, error) {
+				if resource.IsTombstone(r) {
+					return false, nil
+				}
+
+				newIdentity, ok := r.(*kubespan.Identity)
+				if !ok {
+					return false, nil
+				}
+
+				return newIdentity.TypedSpec().PublicKey != previousPublicKey, nil
+			}),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error waiting for the new KubeSpan identity: %w", err)
+		}
+
+		reply.KubespanPublicKey = res.(*kubespan.Identity).TypedSpec().PublicKey //nolint:forcetypeassert,errcheck
+		reply.Notes = append(reply.Notes, "KubeSpan key was regenerated, the peers pick up the new public key via discovery and re-establish the connections")
+	}
+
+	return &machine.ResetIdentityResponse{
+		Messages: []*machine.ResetIdentity{reply},
+	}, nil
+}
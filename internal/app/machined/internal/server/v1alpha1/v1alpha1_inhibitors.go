@@ -0,0 +1,114 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/grpc/middleware/authz"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	runtimeres "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// InhibitorAcquire implements the machine.MachineServer interface.
+func (s *Server) InhibitorAcquire(ctx context.Context, in *machine.InhibitorAcquireRequest) (*machine.InhibitorAcquireResponse, error) {
+	if in.GetWho() == "" {
+		return nil, status.Error(codes.InvalidArgument, "inhibitor holder name is required")
+	}
+
+	inhibitor, err := runtime.AcquireShutdownInhibitor(
+		ctx,
+		s.Controller.Runtime().State().V1Alpha2().Resources(),
+		authz.GetPeer(ctx),
+		in.GetWho(),
+		in.GetWhy(),
+		in.GetMaxHold().AsDuration(),
+	)
+	if err != nil {
+		if errors.Is(err, runtime.ErrShutdownInhibitorLimit) {
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
+
+		return nil, err
+	}
+
+	log.Printf("shutdown inhibitor %s acquired by %q (%s): %s", inhibitor.Metadata().ID(), inhibitor.TypedSpec().Who, inhibitor.TypedSpec().Owner, inhibitor.TypedSpec().Why)
+
+	return &machine.InhibitorAcquireResponse{
+		Messages: []*machine.InhibitorAcquire{
+			{
+				Inhibitor: inhibitorToAPI(inhibitor),
+			},
+		},
+	}, nil
+}
+
+// InhibitorList implements the machine.MachineServer interface.
+func (s *Server) InhibitorList(ctx context.Context, _ *emptypb.Empty) (*machine.InhibitorListResponse, error) {
+	st := s.Controller.Runtime().State().V1Alpha2().Resources()
+
+	if _, err := runtime.RemoveExpiredShutdownInhibitors(ctx, st); err != nil {
+		return nil, err
+	}
+
+	inhibitors, err := safe.StateListAll[*runtimeres.ShutdownInhibitor](ctx, st)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*machine.Inhibitor, 0, inhibitors.Len())
+
+	for inhibitor := range inhibitors.All() {
+		list = append(list, inhibitorToAPI(inhibitor))
+	}
+
+	return &machine.InhibitorListResponse{
+		Messages: []*machine.InhibitorList{
+			{
+				Inhibitors: list,
+			},
+		},
+	}, nil
+}
+
+// InhibitorRelease implements the machine.MachineServer interface.
+func (s *Server) InhibitorRelease(ctx context.Context, in *machine.InhibitorReleaseRequest) (*machine.InhibitorReleaseResponse, error) {
+	if err := s.Controller.Runtime().State().V1Alpha2().Resources().Destroy(ctx, runtimeres.NewShutdownInhibitor(in.GetId()).Metadata()); err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "shutdown inhibitor %q not found", in.GetId())
+		}
+
+		return nil, err
+	}
+
+	log.Printf("shutdown inhibitor %s released", in.GetId())
+
+	return &machine.InhibitorReleaseResponse{
+		Messages: []*machine.InhibitorRelease{
+			{},
+		},
+	}, nil
+}
+
+func inhibitorToAPI(inhibitor *runtimeres.ShutdownInhibitor) *machine.Inhibitor {
+	return &machine.Inhibitor{
+		Id:       inhibitor.Metadata().ID(),
+		Who:      inhibitor.TypedSpec().Who,
+		Why:      inhibitor.TypedSpec().Why,
+		MaxHold:  durationpb.New(inhibitor.TypedSpec().MaxHold),
+		Acquired: timestamppb.New(inhibitor.TypedSpec().Acquired),
+	}
+}
@@ -6,12 +6,23 @@ package runtime
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/state"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/siderolabs/talos/internal/pkg/resourcehistory"
+	"github.com/siderolabs/talos/pkg/grpc/middleware/authz"
 	inspectapi "github.com/siderolabs/talos/pkg/machinery/api/inspect"
+	"github.com/siderolabs/talos/pkg/machinery/resources/schema"
+	"github.com/siderolabs/talos/pkg/machinery/role"
 )
 
 // InspectServer implements InspectService API.
@@ -69,3 +80,97 @@ func (s *InspectServer) ControllerRuntimeDependencies(ctx context.Context, in *e
 		},
 	}, nil
 }
+
+// ResourceSchema implements inspect.InspectService interface.
+func (s *InspectServer) ResourceSchema(ctx context.Context, in *inspectapi.ResourceSchemaRequest) (*inspectapi.ResourceSchemaResponse, error) {
+	r, ok := s.server.Controller.Runtime().State().V1Alpha2().RegisteredResource(in.GetType())
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "resource type %q is not registered", in.GetType())
+	}
+
+	out, err := json.Marshal(schema.ForResource(r))
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling resource schema: %w", err)
+	}
+
+	return &inspectapi.ResourceSchemaResponse{
+		Messages: []*inspectapi.ResourceSchema{
+			{
+				Type:   in.GetType(),
+				Schema: out,
+			},
+		},
+	}, nil
+}
+
+// ResourceHistory implements inspect.InspectService interface.
+//
+//nolint:gocyclo
+func (s *InspectServer) ResourceHistory(ctx context.Context, in *inspectapi.ResourceHistoryRequest) (*inspectapi.ResourceHistoryResponse, error) {
+	r, ok := s.server.Controller.Runtime().State().V1Alpha2().RegisteredResource(in.GetType())
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "resource type %q is not registered", in.GetType())
+	}
+
+	// follow the access policy of the COSI API: the history of the sensitive resources is only available to admins
+	if r.ResourceDefinition().Sensitivity == meta.Sensitive && !authz.GetRoles(ctx).Includes(role.Admin) {
+		return nil, authz.ErrNotAuthorized
+	}
+
+	recorder := s.server.Controller.V1Alpha2().ResourceHistory()
+
+	revisions, err := recorder.Revisions(in.GetType(), in.GetId())
+	if err != nil {
+		if errors.Is(err, resourcehistory.ErrNotRecorded) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
+		return nil, err
+	}
+
+	history := &inspectapi.ResourceHistory{
+		Type:      in.GetType(),
+		Revisions: make([]*inspectapi.ResourceRevision, 0, len(revisions)),
+	}
+
+	for _, revision := range revisions {
+		var event inspectapi.ResourceRevisionEvent
+
+		switch revision.Event { //nolint:exhaustive
+		case state.Created:
+			event = inspectapi.ResourceRevisionEvent_CREATED
+		case state.Updated:
+			event = inspectapi.ResourceRevisionEvent_UPDATED
+		case state.Destroyed:
+			event = inspectapi.ResourceRevisionEvent_DESTROYED
+		}
+
+		history.Revisions = append(history.Revisions, &inspectapi.ResourceRevision{
+			Revision:  revision.Number,
+			Timestamp: timestamppb.New(revision.Timestamp),
+			Event:     event,
+			Namespace: revision.Namespace,
+			Id:        revision.ID,
+			Version:   revision.Version.String(),
+			Spec:      revision.Spec,
+			Redacted:  revision.Redacted,
+		})
+	}
+
+	if in.GetFromRevision() != 0 && in.GetToRevision() != 0 {
+		history.Diff, err = recorder.Diff(in.GetType(), in.GetFromRevision(), in.GetToRevision())
+		if err != nil {
+			if errors.Is(err, resourcehistory.ErrRevisionNotFound) {
+				return nil, status.Error(codes.NotFound, err.Error())
+			}
+
+			return nil, err
+		}
+	}
+
+	return &inspectapi.ResourceHistoryResponse{
+		Messages: []*inspectapi.ResourceHistory{
+			history,
+		},
+	}, nil
+}
@@ -0,0 +1,174 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+var (
+	// joinTokenFormat is the format of the machine configuration token.
+	joinTokenFormat = regexp.MustCompile(`^[a-z0-9]{6}\.[a-z0-9]{16}$`)
+	joinTokenName   = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+)
+
+// joinTokensMu serializes the updates of the join tokens stored in META.
+var joinTokensMu sync.Mutex
+
+// JoinTokenCreate implements the machine.MachineServer interface.
+func (s *Server) JoinTokenCreate(ctx context.Context, in *machine.JoinTokenCreateRequest) (*machine.JoinTokenCreateResponse, error) {
+	if err := s.checkControlplane("join tokens"); err != nil {
+		return nil, err
+	}
+
+	if !joinTokenName.MatchString(in.GetName()) || in.GetName() == secrets.JoinTokenMachineConfigID {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid join token name %q", in.GetName())
+	}
+
+	if !joinTokenFormat.MatchString(in.GetToken()) {
+		return nil, status.Error(codes.InvalidArgument, "join token should match the format [a-z0-9]{6}.[a-z0-9]{16}")
+	}
+
+	now := time.Now()
+
+	spec := secrets.JoinTokenSpec{
+		TokenHash: secrets.HashJoinToken(in.GetToken()),
+		CreatedAt: now,
+	}
+
+	if in.GetExpiresAt() != nil {
+		spec.ExpiresAt = in.GetExpiresAt().AsTime()
+
+		if !spec.ExpiresAt.After(now) {
+			return nil, status.Error(codes.InvalidArgument, "join token expiration time should be in the future")
+		}
+	}
+
+	joinTokensMu.Lock()
+	defer joinTokensMu.Unlock()
+
+	meta := s.Controller.Runtime().State().Machine().Meta()
+
+	tokens, err := runtime.ReadJoinTokens(meta)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, exists := tokens[in.GetName()]; exists {
+		return nil, status.Errorf(codes.AlreadyExists, "join token %q already exists", in.GetName())
+	}
+
+	tokens[in.GetName()] = spec
+
+	if err = runtime.WriteJoinTokens(ctx, meta, tokens); err != nil {
+		return nil, err
+	}
+
+	s.Controller.Runtime().Events().Publish(ctx, &machine.JoinTokenEvent{
+		Name:   in.GetName(),
+		Action: machine.JoinTokenEvent_CREATED,
+	})
+
+	return &machine.JoinTokenCreateResponse{
+		Messages: []*machine.JoinTokenCreate{
+			{},
+		},
+	}, nil
+}
+
+// JoinTokenList implements the machine.MachineServer interface.
+func (s *Server) JoinTokenList(ctx context.Context, _ *emptypb.Empty) (*machine.JoinTokenListResponse, error) {
+	if err := s.checkControlplane("join tokens"); err != nil {
+		return nil, err
+	}
+
+	tokens, err := safe.StateListAll[*secrets.JoinToken](ctx, s.Controller.Runtime().State().V1Alpha2().Resources())
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	list := make([]*machine.JoinTokenInfo, 0, tokens.Len())
+
+	for token := range tokens.All() {
+		info := &machine.JoinTokenInfo{
+			Name:    token.Metadata().ID(),
+			Expired: token.TypedSpec().Expired(now),
+		}
+
+		if !token.TypedSpec().CreatedAt.IsZero() {
+			info.CreatedAt = timestamppb.New(token.TypedSpec().CreatedAt)
+		}
+
+		if !token.TypedSpec().ExpiresAt.IsZero() {
+			info.ExpiresAt = timestamppb.New(token.TypedSpec().ExpiresAt)
+		}
+
+		list = append(list, info)
+	}
+
+	return &machine.JoinTokenListResponse{
+		Messages: []*machine.JoinTokenList{
+			{
+				Tokens: list,
+			},
+		},
+	}, nil
+}
+
+// JoinTokenRevoke implements the machine.MachineServer interface.
+func (s *Server) JoinTokenRevoke(ctx context.Context, in *machine.JoinTokenRevokeRequest) (*machine.JoinTokenRevokeResponse, error) {
+	if err := s.checkControlplane("join tokens"); err != nil {
+		return nil, err
+	}
+
+	if in.GetName() == secrets.JoinTokenMachineConfigID {
+		return nil, status.Error(codes.InvalidArgument, "machine configuration join token can't be revoked, it should be changed in the machine configuration")
+	}
+
+	joinTokensMu.Lock()
+	defer joinTokensMu.Unlock()
+
+	meta := s.Controller.Runtime().State().Machine().Meta()
+
+	tokens, err := runtime.ReadJoinTokens(meta)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, exists := tokens[in.GetName()]; !exists {
+		return nil, status.Errorf(codes.NotFound, "join token %q not found", in.GetName())
+	}
+
+	delete(tokens, in.GetName())
+
+	if err = runtime.WriteJoinTokens(ctx, meta, tokens); err != nil {
+		return nil, err
+	}
+
+	s.Controller.Runtime().Events().Publish(ctx, &machine.JoinTokenEvent{
+		Name:   in.GetName(),
+		Action: machine.JoinTokenEvent_REVOKED,
+	})
+
+	return &machine.JoinTokenRevokeResponse{
+		Messages: []*machine.JoinTokenRevoke{
+			{},
+		},
+	}, nil
+}
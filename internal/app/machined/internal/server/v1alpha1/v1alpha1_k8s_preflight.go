@@ -0,0 +1,195 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/blang/semver/v4"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/dustin/go-humanize"
+	"github.com/google/go-containerregistry/pkg/name"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+)
+
+// kubeletMaxMinorSkew is the maximum number of the minor versions kubelet might be older than kube-apiserver.
+const kubeletMaxMinorSkew = 3
+
+// KubernetesUpgradePreflight implements the machine.MachineServer interface.
+func (s *Server) KubernetesUpgradePreflight(ctx context.Context, in *machine.KubernetesUpgradePreflightRequest) (*machine.KubernetesUpgradePreflightResponse, error) {
+	toVersion, err := semver.ParseTolerant(in.ToVersion)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid Kubernetes version %q: %s", in.ToVersion, err)
+	}
+
+	st := s.Controller.Runtime().State().V1Alpha2().Resources()
+
+	var checks []*machine.KubernetesUpgradePreflightCheck
+
+	kubeletCheck, err := kubeletVersionSkewCheck(ctx, st, toVersion, in.UpgradeKubelet)
+	if err != nil {
+		return nil, err
+	}
+
+	checks = append(checks, kubeletCheck)
+
+	if s.Controller.Runtime().Config().Machine().Type().IsControlPlane() {
+		var apiServerCheck *machine.KubernetesUpgradePreflightCheck
+
+		apiServerCheck, err = apiServerVersionCheck(ctx, st, toVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		checks = append(checks, apiServerCheck)
+	}
+
+	checks = append(checks, diskSpaceCheck())
+
+	return &machine.KubernetesUpgradePreflightResponse{
+		Messages: []*machine.KubernetesUpgradePreflight{
+			{
+				Checks: checks,
+			},
+		},
+	}, nil
+}
+
+func kubeletVersionSkewCheck(ctx context.Context, st state.State, toVersion semver.Version, upgradeKubelet bool) (*machine.KubernetesUpgradePreflightCheck, error) {
+	check := &machine.KubernetesUpgradePreflightCheck{
+		Name: "kubelet version skew",
+	}
+
+	kubeletSpec, err := safe.StateGetByID[*k8s.KubeletSpec](ctx, st, k8s.KubeletID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			check.Severity = machine.KubernetesUpgradePreflightCheck_WARNING
+			check.Message = "kubelet is not running"
+
+			return check, nil
+		}
+
+		return nil, fmt.Errorf("error getting kubelet spec: %w", err)
+	}
+
+	kubeletVersion, err := imageVersion(kubeletSpec.TypedSpec().Image)
+	if err != nil {
+		check.Severity = machine.KubernetesUpgradePreflightCheck_WARNING
+		check.Message = fmt.Sprintf("failed to detect kubelet version: %s", err)
+
+		return check, nil
+	}
+
+	switch {
+	case kubeletVersion.Major != toVersion.Major || kubeletVersion.Minor > toVersion.Minor:
+		check.Severity = machine.KubernetesUpgradePreflightCheck_ERROR
+		check.Message = fmt.Sprintf("kubelet version %s is newer than the target version %s", kubeletVersion, toVersion)
+	case upgradeKubelet:
+		check.Message = fmt.Sprintf("kubelet is going to be upgraded from %s to %s", kubeletVersion, toVersion)
+	case toVersion.Minor-kubeletVersion.Minor > kubeletMaxMinorSkew:
+		check.Severity = machine.KubernetesUpgradePreflightCheck_ERROR
+		check.Message = fmt.Sprintf("kubelet version %s is more than %d minor versions older than the target version %s", kubeletVersion, kubeletMaxMinorSkew, toVersion)
+	default:
+		check.Message = fmt.Sprintf("kubelet version %s is within the supported skew of the target version %s", kubeletVersion, toVersion)
+	}
+
+	return check, nil
+}
+
+func apiServerVersionCheck(ctx context.Context, st state.State, toVersion semver.Version) (*machine.KubernetesUpgradePreflightCheck, error) {
+	check := &machine.KubernetesUpgradePreflightCheck{
+		Name: "kube-apiserver version",
+	}
+
+	apiServerConfig, err := safe.StateGetByID[*k8s.APIServerConfig](ctx, st, k8s.APIServerConfigID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			check.Severity = machine.KubernetesUpgradePreflightCheck_WARNING
+			check.Message = "kube-apiserver is not configured"
+
+			return check, nil
+		}
+
+		return nil, fmt.Errorf("error getting kube-apiserver config: %w", err)
+	}
+
+	apiServerVersion, err := imageVersion(apiServerConfig.TypedSpec().Image)
+	if err != nil {
+		check.Severity = machine.KubernetesUpgradePreflightCheck_WARNING
+		check.Message = fmt.Sprintf("failed to detect kube-apiserver version: %s", err)
+
+		return check, nil
+	}
+
+	switch {
+	case apiServerVersion.Major != toVersion.Major || apiServerVersion.Minor > toVersion.Minor:
+		check.Severity = machine.KubernetesUpgradePreflightCheck_ERROR
+		check.Message = fmt.Sprintf("kube-apiserver version %s is newer than the target version %s", apiServerVersion, toVersion)
+	case toVersion.Minor-apiServerVersion.Minor > 1:
+		check.Severity = machine.KubernetesUpgradePreflightCheck_ERROR
+		check.Message = fmt.Sprintf("kube-apiserver can't be upgraded from %s to %s skipping minor versions", apiServerVersion, toVersion)
+	default:
+		check.Message = fmt.Sprintf("kube-apiserver is going to be upgraded from %s to %s", apiServerVersion, toVersion)
+	}
+
+	return check, nil
+}
+
+// diskSpaceCheck verifies that there is enough space available to pull the new images.
+func diskSpaceCheck() *machine.KubernetesUpgradePreflightCheck {
+	check := &machine.KubernetesUpgradePreflightCheck{
+		Name: "available disk space",
+	}
+
+	var stat unix.Statfs_t
+
+	if err := unix.Statfs(constants.EphemeralMountPoint, &stat); err != nil {
+		check.Severity = machine.KubernetesUpgradePreflightCheck_WARNING
+		check.Message = fmt.Sprintf("failed to get the available space on %s: %s", constants.EphemeralMountPoint, err)
+
+		return check
+	}
+
+	available := uint64(stat.Bsize) * stat.Bavail
+
+	check.Severity = diskSpaceSeverity(available)
+	check.Message = fmt.Sprintf("%s available on %s, at least %s is required to pull the images",
+		humanize.IBytes(available), constants.EphemeralMountPoint, humanize.IBytes(constants.KubernetesUpgradeMinAvailableSpace))
+
+	return check
+}
+
+func diskSpaceSeverity(available uint64) machine.KubernetesUpgradePreflightCheck_Severity {
+	switch {
+	case available < constants.KubernetesUpgradeMinAvailableSpace:
+		return machine.KubernetesUpgradePreflightCheck_ERROR
+	case available < 2*constants.KubernetesUpgradeMinAvailableSpace:
+		return machine.KubernetesUpgradePreflightCheck_WARNING
+	default:
+		return machine.KubernetesUpgradePreflightCheck_INFO
+	}
+}
+
+func imageVersion(image string) (semver.Version, error) {
+	ref, err := name.ParseReference(image)
+	if err != nil {
+		return semver.Version{}, err
+	}
+
+	tag, ok := ref.(name.Tag)
+	if !ok {
+		return semver.Version{}, fmt.Errorf("image %q is not referenced by tag", image)
+	}
+
+	return semver.ParseTolerant(tag.TagStr())
+}
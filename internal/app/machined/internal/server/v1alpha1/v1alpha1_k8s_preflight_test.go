@@ -0,0 +1,195 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime //nolint:testpackage
+
+import (
+	"testing"
+
+	"github.com/blang/semver/v4"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+)
+
+func TestKubeletVersionSkewCheck(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name           string
+		image          string
+		toVersion      string
+		upgradeKubelet bool
+
+		expectedSeverity machine.KubernetesUpgradePreflightCheck_Severity
+		expectedMessage  string
+	}{
+		{
+			name:             "not running",
+			toVersion:        "1.34.0",
+			expectedSeverity: machine.KubernetesUpgradePreflightCheck_WARNING,
+			expectedMessage:  "kubelet is not running",
+		},
+		{
+			name:             "no tag",
+			image:            "ghcr.io/siderolabs/kubelet@sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+			toVersion:        "1.34.0",
+			expectedSeverity: machine.KubernetesUpgradePreflightCheck_WARNING,
+			expectedMessage:  "failed to detect kubelet version",
+		},
+		{
+			name:             "newer",
+			image:            "ghcr.io/siderolabs/kubelet:v1.35.0",
+			toVersion:        "1.34.0",
+			expectedSeverity: machine.KubernetesUpgradePreflightCheck_ERROR,
+			expectedMessage:  "kubelet version 1.35.0 is newer than the target version 1.34.0",
+		},
+		{
+			name:             "upgraded",
+			image:            "ghcr.io/siderolabs/kubelet:v1.29.0",
+			toVersion:        "1.34.0",
+			upgradeKubelet:   true,
+			expectedSeverity: machine.KubernetesUpgradePreflightCheck_INFO,
+			expectedMessage:  "kubelet is going to be upgraded from 1.29.0 to 1.34.0",
+		},
+		{
+			name:             "skew too large",
+			image:            "ghcr.io/siderolabs/kubelet:v1.30.0",
+			toVersion:        "1.34.0",
+			expectedSeverity: machine.KubernetesUpgradePreflightCheck_ERROR,
+			expectedMessage:  "kubelet version 1.30.0 is more than 3 minor versions older than the target version 1.34.0",
+		},
+		{
+			name:             "within skew",
+			image:            "ghcr.io/siderolabs/kubelet:v1.31.0",
+			toVersion:        "1.34.0",
+			expectedSeverity: machine.KubernetesUpgradePreflightCheck_INFO,
+			expectedMessage:  "kubelet version 1.31.0 is within the supported skew of the target version 1.34.0",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+			st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+			if test.image != "" {
+				kubeletSpec := k8s.NewKubeletSpec(k8s.NamespaceName, k8s.KubeletID)
+				kubeletSpec.TypedSpec().Image = test.image
+
+				require.NoError(t, st.Create(ctx, kubeletSpec))
+			}
+
+			check, err := kubeletVersionSkewCheck(ctx, st, semver.MustParse(test.toVersion), test.upgradeKubelet)
+			require.NoError(t, err)
+
+			assert.Equal(t, "kubelet version skew", check.Name)
+			assert.Equal(t, test.expectedSeverity, check.Severity)
+			assert.Contains(t, check.Message, test.expectedMessage)
+		})
+	}
+}
+
+func TestAPIServerVersionCheck(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name      string
+		image     string
+		toVersion string
+
+		expectedSeverity machine.KubernetesUpgradePreflightCheck_Severity
+		expectedMessage  string
+	}{
+		{
+			name:             "not configured",
+			toVersion:        "1.34.0",
+			expectedSeverity: machine.KubernetesUpgradePreflightCheck_WARNING,
+			expectedMessage:  "kube-apiserver is not configured",
+		},
+		{
+			name:             "invalid image",
+			image:            "registry.k8s.io/kube-apiserver:latest",
+			toVersion:        "1.34.0",
+			expectedSeverity: machine.KubernetesUpgradePreflightCheck_WARNING,
+			expectedMessage:  "failed to detect kube-apiserver version",
+		},
+		{
+			name:             "newer",
+			image:            "registry.k8s.io/kube-apiserver:v1.35.1",
+			toVersion:        "1.34.0",
+			expectedSeverity: machine.KubernetesUpgradePreflightCheck_ERROR,
+			expectedMessage:  "kube-apiserver version 1.35.1 is newer than the target version 1.34.0",
+		},
+		{
+			name:             "skipping minor versions",
+			image:            "registry.k8s.io/kube-apiserver:v1.32.3",
+			toVersion:        "1.34.0",
+			expectedSeverity: machine.KubernetesUpgradePreflightCheck_ERROR,
+			expectedMessage:  "kube-apiserver can't be upgraded from 1.32.3 to 1.34.0 skipping minor versions",
+		},
+		{
+			name:             "next minor",
+			image:            "registry.k8s.io/kube-apiserver:v1.33.3",
+			toVersion:        "1.34.0",
+			expectedSeverity: machine.KubernetesUpgradePreflightCheck_INFO,
+			expectedMessage:  "kube-apiserver is going to be upgraded from 1.33.3 to 1.34.0",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+			st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+			if test.image != "" {
+				apiServerConfig := k8s.NewAPIServerConfig()
+				apiServerConfig.TypedSpec().Image = test.image
+
+				require.NoError(t, st.Create(ctx, apiServerConfig))
+			}
+
+			check, err := apiServerVersionCheck(ctx, st, semver.MustParse(test.toVersion))
+			require.NoError(t, err)
+
+			assert.Equal(t, "kube-apiserver version", check.Name)
+			assert.Equal(t, test.expectedSeverity, check.Severity)
+			assert.Contains(t, check.Message, test.expectedMessage)
+		})
+	}
+}
+
+func TestDiskSpaceSeverity(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		available uint64
+		expected  machine.KubernetesUpgradePreflightCheck_Severity
+	}{
+		{
+			available: 0,
+			expected:  machine.KubernetesUpgradePreflightCheck_ERROR,
+		},
+		{
+			available: constants.KubernetesUpgradeMinAvailableSpace - 1,
+			expected:  machine.KubernetesUpgradePreflightCheck_ERROR,
+		},
+		{
+			available: constants.KubernetesUpgradeMinAvailableSpace,
+			expected:  machine.KubernetesUpgradePreflightCheck_WARNING,
+		},
+		{
+			available: 2 * constants.KubernetesUpgradeMinAvailableSpace,
+			expected:  machine.KubernetesUpgradePreflightCheck_INFO,
+		},
+	} {
+		assert.Equal(t, test.expected, diskSpaceSeverity(test.available), "available %d", test.available)
+	}
+}
@@ -0,0 +1,208 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8s "k8s.io/client-go/kubernetes"
+
+	"github.com/siderolabs/talos/pkg/grpc/middleware/authz"
+	"github.com/siderolabs/talos/pkg/kubernetes"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate/secrets"
+	secretsres "github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+	"github.com/siderolabs/talos/pkg/machinery/role"
+)
+
+// SecretsBundleExport implements the machine.MachineServer interface.
+func (s *Server) SecretsBundleExport(ctx context.Context, in *machine.SecretsBundleExportRequest) (*machine.SecretsBundleExportResponse, error) {
+	cfg := s.Controller.Runtime().Config()
+
+	if err := checkSecretsBundleExport(ctx, in, cfg); err != nil {
+		return nil, err
+	}
+
+	st := s.Controller.Runtime().State().V1Alpha2().Resources()
+
+	bundle, err := secretsBundle(ctx, st)
+	if err != nil {
+		return nil, err
+	}
+
+	k8sRoot, err := safe.StateGetByID[*secretsres.KubernetesRoot](ctx, st, secretsres.KubernetesRootID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting Kubernetes root secrets: %w", err)
+	}
+
+	k8sClient, err := kubernetes.NewTemporaryClientFromPKI(k8sRoot.TypedSpec().IssuingCA, k8sRoot.TypedSpec().LocalEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("error building Kubernetes client: %w", err)
+	}
+
+	defer k8sClient.Close() //nolint:errcheck
+
+	if err = registerBootstrapToken(ctx, k8sClient, bundle.Secrets.BootstrapToken); err != nil {
+		return nil, err
+	}
+
+	bundle.Cluster = &secrets.Cluster{
+		ID:     cfg.Cluster().ID(),
+		Secret: cfg.Cluster().Secret(),
+	}
+
+	if err = bundle.Validate(); err != nil {
+		return nil, fmt.Errorf("error validating the secrets bundle: %w", err)
+	}
+
+	bundleBytes, err := yaml.Marshal(bundle)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling the secrets bundle: %w", err)
+	}
+
+	roles := authz.GetRoles(ctx).Strings()
+
+	log.Printf("secrets bundle exported via API, roles: %s", strings.Join(roles, ","))
+
+	s.Controller.Runtime().Events().Publish(ctx, &machine.SecretsBundleExportEvent{
+		Roles: roles,
+	})
+
+	return &machine.SecretsBundleExportResponse{
+		Messages: []*machine.SecretsBundleExport{
+			{
+				Bundle: bundleBytes,
+			},
+		},
+	}, nil
+}
+
+// checkSecretsBundleExport checks whether the secrets bundle can be exported.
+func checkSecretsBundleExport(ctx context.Context, in *machine.SecretsBundleExportRequest, cfg config.Config) error {
+	// the API authorization policy might grant the method to other roles, but the bundle is never exported to them
+	if !authz.HasRole(ctx, role.Admin) {
+		return status.Errorf(codes.PermissionDenied, "secrets bundle export requires the %s role", role.Admin)
+	}
+
+	if !in.AllowSecretsExport {
+		return status.Error(codes.InvalidArgument, "secrets bundle export should be confirmed explicitly")
+	}
+
+	if cfg == nil || cfg.Machine() == nil {
+		return status.Error(codes.FailedPrecondition, "machine configuration is not available")
+	}
+
+	if !cfg.Machine().Features().SecretsBundleExportEnabled() {
+		return status.Error(codes.FailedPrecondition, "secrets bundle export is disabled in the machine configuration")
+	}
+
+	if !cfg.Machine().Type().IsControlPlane() {
+		return status.Error(codes.FailedPrecondition, "secrets bundle can only be exported from a control plane node")
+	}
+
+	return nil
+}
+
+// secretsBundle reconstructs the secrets bundle from the root secrets of the control plane.
+//
+// The bootstrap token of the cluster is not exported, a new bootstrap token is generated instead.
+func secretsBundle(ctx context.Context, st state.State) (*secrets.Bundle, error) {
+	k8sRoot, err := safe.StateGetByID[*secretsres.KubernetesRoot](ctx, st, secretsres.KubernetesRootID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Error(codes.Unavailable, "Kubernetes root secrets are not ready")
+		}
+
+		return nil, fmt.Errorf("error getting Kubernetes root secrets: %w", err)
+	}
+
+	etcdRoot, err := safe.StateGetByID[*secretsres.EtcdRoot](ctx, st, secretsres.EtcdRootID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Error(codes.Unavailable, "etcd root secrets are not ready")
+		}
+
+		return nil, fmt.Errorf("error getting etcd root secrets: %w", err)
+	}
+
+	osRoot, err := safe.StateGetByID[*secretsres.OSRoot](ctx, st, secretsres.OSRootID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Error(codes.Unavailable, "OS root secrets are not ready")
+		}
+
+		return nil, fmt.Errorf("error getting OS root secrets: %w", err)
+	}
+
+	bootstrapToken, err := secrets.NewBootstrapToken()
+	if err != nil {
+		return nil, fmt.Errorf("error generating bootstrap token: %w", err)
+	}
+
+	k8sSpec := k8sRoot.TypedSpec()
+
+	return &secrets.Bundle{
+		Clock: secrets.NewFixedClock(time.Now()),
+		Secrets: &secrets.Secrets{
+			BootstrapToken:            bootstrapToken,
+			AESCBCEncryptionSecret:    k8sSpec.AESCBCEncryptionSecret,
+			SecretboxEncryptionSecret: k8sSpec.SecretboxEncryptionSecret,
+		},
+		TrustdInfo: &secrets.TrustdInfo{
+			Token: osRoot.TypedSpec().Token,
+		},
+		Certs: &secrets.Certs{
+			Etcd:              etcdRoot.TypedSpec().EtcdCA,
+			K8s:               k8sSpec.IssuingCA,
+			K8sAggregator:     k8sSpec.AggregatorCA,
+			K8sServiceAccount: k8sSpec.ServiceAccount,
+			OS:                osRoot.TypedSpec().IssuingCA,
+		},
+	}, nil
+}
+
+// registerBootstrapToken creates the bootstrap token secret, so that the machines generated from the bundle can join the cluster.
+//
+// The secret follows the one created for the bootstrap token of the cluster, and it can be deleted to revoke the token.
+func registerBootstrapToken(ctx context.Context, k8sClient k8s.Interface, token string) error {
+	tokenID, tokenSecret, ok := strings.Cut(token, ".")
+	if !ok {
+		return errors.New("invalid bootstrap token format")
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "bootstrap-token-" + tokenID,
+			Namespace: metav1.NamespaceSystem,
+		},
+		Type: corev1.SecretTypeBootstrapToken,
+		StringData: map[string]string{
+			"description":                    "bootstrap token of the exported secrets bundle",
+			"token-id":                       tokenID,
+			"token-secret":                   tokenSecret,
+			"usage-bootstrap-authentication": "true",
+			"auth-extra-groups":              "system:bootstrappers:nodes",
+		},
+	}
+
+	if _, err := k8sClient.CoreV1().Secrets(secret.Namespace).Create(ctx, secret, metav1.CreateOptions{}); err != nil {
+		return fmt.Errorf("error creating bootstrap token secret: %w", err)
+	}
+
+	return nil
+}
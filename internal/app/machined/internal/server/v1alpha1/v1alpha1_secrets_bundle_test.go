@@ -0,0 +1,215 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime //nolint:testpackage
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/siderolabs/talos/pkg/grpc/middleware/authz"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate/secrets"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	secretsres "github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+	"github.com/siderolabs/talos/pkg/machinery/role"
+)
+
+func TestCheckSecretsBundleExport(t *testing.T) {
+	t.Parallel()
+
+	machineConfig := func(machineType string, features *v1alpha1.FeaturesConfig) config.Config {
+		cfg, err := container.New(&v1alpha1.Config{
+			ConfigVersion: "v1alpha1",
+			MachineConfig: &v1alpha1.MachineConfig{
+				MachineType:     machineType,
+				MachineFeatures: features,
+			},
+		})
+		require.NoError(t, err)
+
+		return cfg
+	}
+
+	for _, test := range []struct {
+		name  string
+		roles role.Set
+		allow bool
+		cfg   config.Config
+
+		expectedCode codes.Code
+	}{
+		{
+			name:  "allowed",
+			roles: role.MakeSet(role.Admin),
+			allow: true,
+			cfg:   machineConfig("controlplane", nil),
+
+			expectedCode: codes.OK,
+		},
+		{
+			name:  "operator",
+			roles: role.MakeSet(role.Operator),
+			allow: true,
+			cfg:   machineConfig("controlplane", nil),
+
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:  "not confirmed",
+			roles: role.MakeSet(role.Admin),
+			cfg:   machineConfig("controlplane", nil),
+
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:  "no config",
+			roles: role.MakeSet(role.Admin),
+			allow: true,
+
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:  "disabled",
+			roles: role.MakeSet(role.Admin),
+			allow: true,
+			cfg: machineConfig("controlplane", &v1alpha1.FeaturesConfig{
+				DisableSecretsBundleExport: pointer.To(true),
+			}),
+
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:  "worker",
+			roles: role.MakeSet(role.Admin),
+			allow: true,
+			cfg:   machineConfig("worker", nil),
+
+			expectedCode: codes.FailedPrecondition,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := authz.ContextWithRoles(t.Context(), test.roles)
+
+			err := checkSecretsBundleExport(ctx, &machine.SecretsBundleExportRequest{AllowSecretsExport: test.allow}, test.cfg)
+
+			assert.Equal(t, test.expectedCode, status.Code(err))
+		})
+	}
+}
+
+func TestSecretsBundle(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	// the root secrets are not ready yet
+	_, err := secretsBundle(ctx, st)
+	require.Error(t, err)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	original := createRootSecrets(ctx, t, st)
+
+	bundle, err := secretsBundle(ctx, st)
+	require.NoError(t, err)
+
+	assert.Equal(t, original.Certs.K8s, bundle.Certs.K8s)
+	assert.Equal(t, original.Certs.K8sAggregator, bundle.Certs.K8sAggregator)
+	assert.Equal(t, original.Certs.K8sServiceAccount, bundle.Certs.K8sServiceAccount)
+	assert.Equal(t, original.Certs.Etcd, bundle.Certs.Etcd)
+	assert.Equal(t, original.Certs.OS, bundle.Certs.OS)
+	assert.Equal(t, original.TrustdInfo.Token, bundle.TrustdInfo.Token)
+	assert.Equal(t, original.Secrets.SecretboxEncryptionSecret, bundle.Secrets.SecretboxEncryptionSecret)
+	assert.Equal(t, original.Secrets.AESCBCEncryptionSecret, bundle.Secrets.AESCBCEncryptionSecret)
+
+	// the bootstrap token is regenerated on each export
+	assert.Regexp(t, `^[a-z0-9]{6}\.[a-z0-9]{16}$`, bundle.Secrets.BootstrapToken)
+	assert.NotEqual(t, original.Secrets.BootstrapToken, bundle.Secrets.BootstrapToken)
+
+	another, err := secretsBundle(ctx, st)
+	require.NoError(t, err)
+
+	assert.NotEqual(t, bundle.Secrets.BootstrapToken, another.Secrets.BootstrapToken)
+
+	bundle.Cluster = original.Cluster
+
+	require.NoError(t, bundle.Validate())
+}
+
+func TestRegisterBootstrapToken(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	k8sClient := fake.NewClientset()
+
+	token, err := secrets.NewBootstrapToken()
+	require.NoError(t, err)
+
+	require.NoError(t, registerBootstrapToken(ctx, k8sClient, token))
+
+	tokenID, tokenSecret, _ := strings.Cut(token, ".")
+
+	secret, err := k8sClient.CoreV1().Secrets(metav1.NamespaceSystem).Get(ctx, "bootstrap-token-"+tokenID, metav1.GetOptions{})
+	require.NoError(t, err)
+
+	assert.Equal(t, corev1.SecretTypeBootstrapToken, secret.Type)
+	assert.Equal(t, tokenID, secret.StringData["token-id"])
+	assert.Equal(t, tokenSecret, secret.StringData["token-secret"])
+	assert.Equal(t, "true", secret.StringData["usage-bootstrap-authentication"])
+	assert.Equal(t, "system:bootstrappers:nodes", secret.StringData["auth-extra-groups"])
+
+	// the token is never reused
+	require.Error(t, registerBootstrapToken(ctx, k8sClient, token))
+
+	require.Error(t, registerBootstrapToken(ctx, k8sClient, "invalid"))
+}
+
+func createRootSecrets(ctx context.Context, t *testing.T, st state.State) *secrets.Bundle {
+	t.Helper()
+
+	bundle, err := secrets.NewBundle(secrets.NewFixedClock(time.Now()), config.TalosVersionCurrent)
+	require.NoError(t, err)
+
+	tokenID, tokenSecret, _ := strings.Cut(bundle.Secrets.BootstrapToken, ".")
+
+	k8sRoot := secretsres.NewKubernetesRoot(secretsres.KubernetesRootID)
+	k8sRoot.TypedSpec().IssuingCA = bundle.Certs.K8s
+	k8sRoot.TypedSpec().AggregatorCA = bundle.Certs.K8sAggregator
+	k8sRoot.TypedSpec().ServiceAccount = bundle.Certs.K8sServiceAccount
+	k8sRoot.TypedSpec().AESCBCEncryptionSecret = bundle.Secrets.AESCBCEncryptionSecret
+	k8sRoot.TypedSpec().SecretboxEncryptionSecret = bundle.Secrets.SecretboxEncryptionSecret
+	k8sRoot.TypedSpec().BootstrapTokenID = tokenID
+	k8sRoot.TypedSpec().BootstrapTokenSecret = tokenSecret
+	require.NoError(t, st.Create(ctx, k8sRoot))
+
+	etcdRoot := secretsres.NewEtcdRoot(secretsres.EtcdRootID)
+	etcdRoot.TypedSpec().EtcdCA = bundle.Certs.Etcd
+	require.NoError(t, st.Create(ctx, etcdRoot))
+
+	osRoot := secretsres.NewOSRoot(secretsres.OSRootID)
+	osRoot.TypedSpec().IssuingCA = bundle.Certs.OS
+	osRoot.TypedSpec().Token = bundle.TrustdInfo.Token
+	require.NoError(t, st.Create(ctx, osRoot))
+
+	return bundle
+}
@@ -153,7 +153,10 @@ func (s *Server) Register(obj *grpc.Server) {
 	cosiv1alpha1.RegisterStateServer(obj, server.NewState(resourceState))
 	inspect.RegisterInspectServiceServer(obj, &InspectServer{server: s})
 	storage.RegisterStorageServiceServer(obj, &storaged.Server{Controller: s.Controller})
-	timeapi.RegisterTimeServiceServer(obj, &TimeServer{ConfigProvider: s.Controller.Runtime()})
+	timeapi.RegisterTimeServiceServer(obj, &TimeServer{
+		ConfigProvider: s.Controller.Runtime(),
+		Resources:      s.Controller.Runtime().State().V1Alpha2().Resources(),
+	})
 }
 
 // modeWrapper overrides RequiresInstall() based on actual installed status.
@@ -718,6 +721,27 @@ func (s *Server) ServiceList(ctx context.Context, in *emptypb.Empty) (result *ma
 	return result, nil
 }
 
+// ServiceDependencies returns the dependencies and the conditions of the registered services.
+func (s *Server) ServiceDependencies(ctx context.Context, in *machine.ServiceDependenciesRequest) (*machine.ServiceDependenciesResponse, error) {
+	services := xslices.Map(system.Services(s.Controller.Runtime()).List(), (*system.ServiceRunner).DependenciesAsProto)
+
+	if in.Id != "" {
+		services = xslices.Filter(services, func(svc *machine.ServiceDependencyInfo) bool { return svc.Id == in.Id })
+
+		if len(services) == 0 {
+			return nil, status.Errorf(codes.NotFound, "service %q not defined", in.Id)
+		}
+	}
+
+	return &machine.ServiceDependenciesResponse{
+		Messages: []*machine.ServiceDependencies{
+			{
+				Services: services,
+			},
+		},
+	}, nil
+}
+
 // ServiceStart implements the machine.MachineServer interface and starts a
 // service running on Talos.
 func (s *Server) ServiceStart(ctx context.Context, in *machine.ServiceStartRequest) (reply *machine.ServiceStartResponse, err error) {
@@ -1421,6 +1445,18 @@ func (s *Server) Events(req *machine.EventsRequest, l machine.MachineService_Eve
 		opts = append(opts, runtime.WithActorID(req.WithActorId))
 	}
 
+	if req.SinceBoot > 0 {
+		return status.Error(codes.InvalidArgument, "since_boot should be negative")
+	}
+
+	if req.SinceBoot != 0 {
+		opts = append(opts, runtime.WithSinceBoot(int(req.SinceBoot)))
+	}
+
+	if req.ReplayPersisted {
+		opts = append(opts, runtime.WithReplayPersisted())
+	}
+
 	if err := s.Controller.Runtime().Events().Watch(func(events <-chan runtime.EventInfo) {
 		errCh <- func() error {
 			for {
@@ -1863,6 +1899,40 @@ func (s *Server) EtcdLeaveCluster(ctx context.Context, in *machine.EtcdLeaveClus
 	}, nil
 }
 
+// EtcdPromoteMember implements the machine.MachineServer interface.
+func (s *Server) EtcdPromoteMember(ctx context.Context, in *machine.EtcdPromoteMemberRequest) (*machine.EtcdPromoteMemberResponse, error) {
+	if err := s.checkControlplane("etcd promote member"); err != nil {
+		return nil, err
+	}
+
+	client, err := etcd.NewClientFromControlPlaneIPs(ctx, s.Controller.Runtime().State().V1Alpha2().Resources())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create etcd client: %w", err)
+	}
+
+	defer client.Close() //nolint:errcheck
+
+	ctx = clientv3.WithRequireLeader(ctx)
+
+	if _, err = client.MemberPromote(ctx, in.MemberId); err != nil {
+		if errors.Is(err, rpctypes.ErrMemberNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		if errors.Is(err, rpctypes.ErrLearnerNotReady) || errors.Is(err, rpctypes.ErrMemberNotLearner) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
+		return nil, fmt.Errorf("failed to promote member: %w", err)
+	}
+
+	return &machine.EtcdPromoteMemberResponse{
+		Messages: []*machine.EtcdPromoteMember{
+			{},
+		},
+	}, nil
+}
+
 // EtcdForfeitLeadership implements the machine.MachineServer interface.
 func (s *Server) EtcdForfeitLeadership(ctx context.Context, in *machine.EtcdForfeitLeadershipRequest) (*machine.EtcdForfeitLeadershipResponse, error) {
 	if err := s.checkControlplane("etcd forfeit leadership"); err != nil {
@@ -1914,24 +1984,27 @@ func (s *Server) EtcdSnapshot(in *machine.EtcdSnapshotRequest, srv machine.Machi
 	//nolint:errcheck
 	defer client.Close()
 
-	rd, err := client.Snapshot(srv.Context())
-	if err != nil {
-		return fmt.Errorf("failed reading etcd snapshot: %w", err)
-	}
+	// defragmentation can't run while the snapshot is being streamed
+	return etcd.WithMaintenance(func() error {
+		rd, err := client.Snapshot(srv.Context())
+		if err != nil {
+			return fmt.Errorf("failed reading etcd snapshot: %w", err)
+		}
 
-	chunker := stream.NewChunker(ctx, rd)
-	chunkCh := chunker.Read()
+		chunker := stream.NewChunker(ctx, rd)
+		chunkCh := chunker.Read()
 
-	for data := range chunkCh {
-		err := srv.SendMsg(&common.Data{Bytes: data})
-		if err != nil {
-			cancel()
+		for data := range chunkCh {
+			err := srv.SendMsg(&common.Data{Bytes: data})
+			if err != nil {
+				cancel()
 
-			return err
+				return err
+			}
 		}
-	}
 
-	return nil
+		return nil
+	})
 }
 
 // EtcdRecover implements the machine.MachineServer interface.
@@ -2101,8 +2174,11 @@ func (s *Server) EtcdDefragment(ctx context.Context, in *emptypb.Empty) (*machin
 	//nolint:errcheck
 	defer client.Close()
 
-	_, err = client.Defragment(ctx, nethelpers.JoinHostPort("localhost", constants.EtcdClientPort))
-	if err != nil {
+	if err = etcd.WithMaintenance(func() error {
+		_, err = client.Defragment(ctx, nethelpers.JoinHostPort("localhost", constants.EtcdClientPort))
+
+		return err
+	}); err != nil {
 		return nil, fmt.Errorf("failed to defragment etcd: %w", err)
 	}
 
@@ -10,6 +10,8 @@ import (
 	"time"
 
 	ntpclient "github.com/beevik/ntp"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -18,6 +20,8 @@ import (
 	timeapi "github.com/siderolabs/talos/pkg/machinery/api/time"
 	"github.com/siderolabs/talos/pkg/machinery/config"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+	timeres "github.com/siderolabs/talos/pkg/machinery/resources/time"
 )
 
 // ConfigProvider defines an interface sufficient for the TimeServer.
@@ -30,6 +34,8 @@ type TimeServer struct {
 	timeapi.UnimplementedTimeServiceServer
 
 	ConfigProvider ConfigProvider
+	// Resources is optional, if set, the time server in use is picked from the time sync status.
+	Resources state.State
 }
 
 // Register implements the factory.Registrator interface.
@@ -37,17 +43,50 @@ func (r *TimeServer) Register(s *grpc.Server) {
 	timeapi.RegisterTimeServiceServer(s, r)
 }
 
-// Time issues a query to the configured ntp server and displays the results.
+// Time issues a query to the time server in use and displays the results.
 func (r *TimeServer) Time(ctx context.Context, in *emptypb.Empty) (reply *timeapi.TimeResponse, err error) {
+	server, err := r.currentTimeServer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.TimeCheck(ctx, &timeapi.TimeRequest{
+		Server: server,
+	})
+}
+
+// currentTimeServer returns the time server selected by the time sync process,
+// falling back to the most preferred configured time server.
+func (r *TimeServer) currentTimeServer(ctx context.Context) (string, error) {
+	if r.Resources != nil {
+		sources, err := safe.StateListAll[*timeres.SourceStatus](ctx, r.Resources)
+		if err != nil {
+			return "", fmt.Errorf("error listing time sources: %w", err)
+		}
+
+		for source := range sources.All() {
+			if source.TypedSpec().Selected {
+				return source.TypedSpec().Server, nil
+			}
+		}
+
+		timeServers, err := safe.StateGetByID[*network.TimeServerStatus](ctx, r.Resources, network.TimeServerID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return "", fmt.Errorf("error getting time servers: %w", err)
+		}
+
+		if timeServers != nil && len(timeServers.TypedSpec().NTPServers) > 0 {
+			return timeServers.TypedSpec().NTPServers[0], nil
+		}
+	}
+
 	timeServers := r.ConfigProvider.Config().Machine().Time().Servers()
 
 	if len(timeServers) == 0 {
-		timeServers = []string{constants.DefaultNTPServer}
+		return constants.DefaultNTPServer, nil
 	}
 
-	return r.TimeCheck(ctx, &timeapi.TimeRequest{
-		Server: timeServers[0],
-	})
+	return timeServers[0], nil
 }
 
 // TimeCheck issues a query to the specified ntp server and displays the results.
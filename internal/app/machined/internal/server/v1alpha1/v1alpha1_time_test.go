@@ -11,6 +11,9 @@ import (
 	"os"
 	"testing"
 
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"google.golang.org/grpc"
@@ -24,6 +27,7 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/container"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	timeres "github.com/siderolabs/talos/pkg/machinery/resources/time"
 )
 
 type TimedSuite struct {
@@ -83,6 +87,56 @@ func (suite *TimedSuite) TestTime() {
 	suite.Assert().Equal(reply.Messages[0].Server, testServer)
 }
 
+func (suite *TimedSuite) TestTimeSelectedSource() {
+	testServer := "time.cloudflare.com"
+
+	resources := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	for _, source := range []struct {
+		server   string
+		selected bool
+	}{
+		{server: "127.0.0.1"},
+		{server: testServer, selected: true},
+	} {
+		status := timeres.NewSourceStatus(source.server)
+		status.TypedSpec().Server = source.server
+		status.TypedSpec().Selected = source.selected
+
+		suite.Require().NoError(resources.Create(suite.T().Context(), status))
+	}
+
+	// Create gRPC server
+	api := &runtime.TimeServer{
+		ConfigProvider: &mockConfigProvider{timeServer: "127.0.0.1"},
+		Resources:      resources,
+	}
+	server := factory.NewServer(api)
+	listener, err := fakeTimedRPC(suite.T())
+	suite.Assert().NoError(err)
+
+	defer server.Stop()
+
+	//nolint:errcheck
+	defer os.Remove(listener.Addr().String())
+
+	//nolint:errcheck
+	go server.Serve(listener)
+
+	conn, err := grpc.NewClient(
+		fmt.Sprintf("%s://%s", "unix", listener.Addr().String()),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(dialer.DialUnix()),
+	)
+	suite.Require().NoError(err)
+	suite.T().Cleanup(func() { conn.Close() }) //nolint:errcheck
+
+	nClient := timeapi.NewTimeServiceClient(conn)
+	reply, err := nClient.Time(context.Background(), &emptypb.Empty{})
+	suite.Require().NoError(err)
+	suite.Assert().Equal(reply.Messages[0].Server, testServer)
+}
+
 func (suite *TimedSuite) TestTimeCheck() {
 	testServer := "time.cloudflare.com"
 
@@ -6,9 +6,12 @@ package kubespan
 
 import (
 	"net/netip"
+	"slices"
 	"time"
 
 	"github.com/siderolabs/gen/value"
+	"github.com/siderolabs/gen/xslices"
+	"github.com/siderolabs/net"
 	"go4.org/netipx"
 	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
 
@@ -120,6 +123,63 @@ func (a peerStatus) ShouldChangeEndpoint() bool {
 	return a.PeerStatusSpec.State == kubespan.PeerStateDown || value.IsZero(a.PeerStatusSpec.LastUsedEndpoint)
 }
 
+// FilterEndpoints applies endpoint filters to the list of peer endpoints.
+//
+// Endpoints which were filtered out are recorded in the status, and the list of allowed endpoints is returned.
+func (a peerStatus) FilterEndpoints(endpoints []netip.AddrPort, filters []string) ([]netip.AddrPort, error) {
+	a.PeerStatusSpec.FilteredEndpoints = nil
+
+	if filters == nil {
+		return endpoints, nil
+	}
+
+	allowedIPs, err := net.FilterIPs(xslices.Map(endpoints, netip.AddrPort.Addr), filters)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed := make([]netip.AddrPort, 0, len(endpoints))
+
+	for _, endpoint := range endpoints {
+		if slices.Contains(allowedIPs, endpoint.Addr()) {
+			allowed = append(allowed, endpoint)
+		} else {
+			a.PeerStatusSpec.FilteredEndpoints = append(a.PeerStatusSpec.FilteredEndpoints, endpoint)
+		}
+	}
+
+	return allowed, nil
+}
+
+// IsEndpointFiltered tells whether the last used endpoint was filtered out by the endpoint filters.
+func (a peerStatus) IsEndpointFiltered() bool {
+	return !value.IsZero(a.PeerStatusSpec.LastUsedEndpoint) && slices.Contains(a.PeerStatusSpec.FilteredEndpoints, a.PeerStatusSpec.LastUsedEndpoint)
+}
+
+// CalculateKeepalive updates the NAT detection and the effective persistent keepalive interval.
+//
+// The peer is considered to be behind NAT if the endpoint seen by Wireguard is not one of the advertised endpoints.
+// Overrides take precedence, otherwise the keepalive is either always enabled, or enabled only for the peers behind NAT.
+func (a peerStatus) CalculateKeepalive(cfg *kubespan.ConfigSpec, advertisedEndpoints []netip.AddrPort) {
+	a.PeerStatusSpec.BehindNAT = !value.IsZero(a.PeerStatusSpec.Endpoint) && !slices.Contains(advertisedEndpoints, a.PeerStatusSpec.Endpoint)
+
+	for _, override := range cfg.PersistentKeepaliveOverrides {
+		if override.Matches(a.PeerStatusSpec.Label, a.PeerStatusSpec.Endpoint) {
+			a.PeerStatusSpec.PersistentKeepaliveInterval = override.Interval
+
+			return
+		}
+	}
+
+	if cfg.PersistentKeepaliveNATOnly && !a.PeerStatusSpec.BehindNAT {
+		a.PeerStatusSpec.PersistentKeepaliveInterval = 0
+
+		return
+	}
+
+	a.PeerStatusSpec.PersistentKeepaliveInterval = cfg.PersistentKeepaliveInterval
+}
+
 // PickNewEndpoint picks new endpoint given the state and list of available endpoints.
 //
 // If returned newEndpoint is zero value, no new endpoint is available.
@@ -11,6 +11,7 @@ import (
 
 	"github.com/siderolabs/gen/value"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	kubespanadapter "github.com/siderolabs/talos/internal/app/machined/pkg/adapters/kubespan"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/adapters/wireguard"
@@ -62,6 +63,117 @@ func TestPeerStatus_PickNewEndpoint(t *testing.T) {
 	kubespanadapter.PeerStatusSpec(&peerStatus).UpdateEndpoint(newEndpoint)
 }
 
+func TestPeerStatus_FilterEndpoints(t *testing.T) {
+	peerStatus := kubespan.PeerStatusSpec{}
+
+	endpoints := []netip.AddrPort{
+		netip.MustParseAddrPort("10.3.4.5:51820"),
+		netip.MustParseAddrPort("192.168.3.8:51820"),
+		netip.MustParseAddrPort("[2001:db8::1]:51820"),
+	}
+
+	// no filters => all endpoints are allowed
+	allowed, err := kubespanadapter.PeerStatusSpec(&peerStatus).FilterEndpoints(endpoints, nil)
+	require.NoError(t, err)
+	assert.Equal(t, endpoints, allowed)
+	assert.Empty(t, peerStatus.FilteredEndpoints)
+
+	kubespanadapter.PeerStatusSpec(&peerStatus).UpdateEndpoint(endpoints[1])
+	assert.False(t, kubespanadapter.PeerStatusSpec(&peerStatus).IsEndpointFiltered())
+
+	// filter out the endpoint in use
+	allowed, err = kubespanadapter.PeerStatusSpec(&peerStatus).FilterEndpoints(endpoints, []string{"0.0.0.0/0", "!192.168.0.0/16"})
+	require.NoError(t, err)
+	assert.Equal(t, endpoints[:1], allowed)
+	assert.Equal(t, endpoints[1:], peerStatus.FilteredEndpoints)
+	assert.True(t, kubespanadapter.PeerStatusSpec(&peerStatus).IsEndpointFiltered())
+
+	// the first allowed endpoint is picked
+	newEndpoint := kubespanadapter.PeerStatusSpec(&peerStatus).PickNewEndpoint(allowed)
+	assert.Equal(t, endpoints[0], newEndpoint)
+	kubespanadapter.PeerStatusSpec(&peerStatus).UpdateEndpoint(newEndpoint)
+	assert.False(t, kubespanadapter.PeerStatusSpec(&peerStatus).IsEndpointFiltered())
+
+	// invalid filter
+	_, err = kubespanadapter.PeerStatusSpec(&peerStatus).FilterEndpoints(endpoints, []string{"foo"})
+	require.Error(t, err)
+}
+
+func TestPeerStatus_CalculateKeepalive(t *testing.T) {
+	advertised := []netip.AddrPort{
+		netip.MustParseAddrPort("10.3.4.5:51820"),
+		netip.MustParseAddrPort("192.168.3.4:51820"),
+	}
+
+	for _, tt := range []struct {
+		name string
+
+		cfg      kubespan.ConfigSpec
+		label    string
+		endpoint netip.AddrPort
+
+		expectedBehindNAT bool
+		expectedKeepalive time.Duration
+	}{
+		{
+			name:              "always",
+			cfg:               kubespan.ConfigSpec{PersistentKeepaliveInterval: 25 * time.Second},
+			endpoint:          netip.MustParseAddrPort("10.3.4.5:51820"),
+			expectedKeepalive: 25 * time.Second,
+		},
+		{
+			name:     "nat only, not behind nat",
+			cfg:      kubespan.ConfigSpec{PersistentKeepaliveInterval: 25 * time.Second, PersistentKeepaliveNATOnly: true},
+			endpoint: netip.MustParseAddrPort("10.3.4.5:51820"),
+		},
+		{
+			name:              "nat only, behind nat",
+			cfg:               kubespan.ConfigSpec{PersistentKeepaliveInterval: 25 * time.Second, PersistentKeepaliveNATOnly: true},
+			endpoint:          netip.MustParseAddrPort("1.2.3.4:41000"),
+			expectedBehindNAT: true,
+			expectedKeepalive: 25 * time.Second,
+		},
+		{
+			name: "label override",
+			cfg: kubespan.ConfigSpec{
+				PersistentKeepaliveInterval: 25 * time.Second,
+				PersistentKeepaliveNATOnly:  true,
+				PersistentKeepaliveOverrides: []kubespan.KeepaliveOverride{
+					{Label: "worker-1", Interval: 5 * time.Second},
+				},
+			},
+			label:             "worker-1",
+			endpoint:          netip.MustParseAddrPort("10.3.4.5:51820"),
+			expectedKeepalive: 5 * time.Second,
+		},
+		{
+			name: "cidr override",
+			cfg: kubespan.ConfigSpec{
+				PersistentKeepaliveInterval: 25 * time.Second,
+				PersistentKeepaliveOverrides: []kubespan.KeepaliveOverride{
+					{Label: "worker-1", Interval: 5 * time.Second},
+					{CIDR: netip.MustParsePrefix("1.2.3.0/24")},
+				},
+			},
+			label:             "worker-2",
+			endpoint:          netip.MustParseAddrPort("1.2.3.4:41000"),
+			expectedBehindNAT: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			peerStatus := kubespan.PeerStatusSpec{
+				Label:    tt.label,
+				Endpoint: tt.endpoint,
+			}
+
+			kubespanadapter.PeerStatusSpec(&peerStatus).CalculateKeepalive(&tt.cfg, advertised)
+
+			assert.Equal(t, tt.expectedBehindNAT, peerStatus.BehindNAT)
+			assert.Equal(t, tt.expectedKeepalive, peerStatus.PersistentKeepaliveInterval)
+		})
+	}
+}
+
 func TestPeerStatus_CalculateState(t *testing.T) {
 	for _, tt := range []struct {
 		name string
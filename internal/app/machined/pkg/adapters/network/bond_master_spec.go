@@ -5,6 +5,9 @@
 package network
 
 import (
+	"bytes"
+	"net/netip"
+
 	"github.com/mdlayher/netlink"
 	"golang.org/x/sys/unix"
 
@@ -91,6 +94,10 @@ func (a bondMaster) Encode() ([]byte, error) {
 
 	if bond.Mode != nethelpers.BondMode8023AD && bond.Mode != nethelpers.BondModeALB && bond.Mode != nethelpers.BondModeTLB {
 		encoder.Uint32(unix.IFLA_BOND_ARP_INTERVAL, bond.ARPInterval)
+
+		// the kernel replaces the whole list of targets, so the (possibly empty) list is always sent
+		encoder.Nested(unix.IFLA_BOND_ARP_IP_TARGET, encodeBondTargets(bond.ARPIPTargets))
+		encoder.Nested(unix.IFLA_BOND_NS_IP6_TARGET, encodeBondTargets(bond.NSIP6Targets))
 	}
 
 	encoder.Uint32(unix.IFLA_BOND_RESEND_IGMP, bond.ResendIGMP)
@@ -129,6 +136,132 @@ func (a bondMaster) Encode() ([]byte, error) {
 	return encoder.Encode()
 }
 
+func encodeBondTargets(targets []netip.Addr) func(*netlink.AttributeEncoder) error {
+	return func(nae *netlink.AttributeEncoder) error {
+		for i, target := range targets {
+			nae.Bytes(uint16(i), target.AsSlice())
+		}
+
+		return nil
+	}
+}
+
+func decodeBondTargets(targets *[]netip.Addr) func(*netlink.AttributeDecoder) error {
+	return func(nad *netlink.AttributeDecoder) error {
+		*targets = nil
+
+		for nad.Next() {
+			if addr, ok := netip.AddrFromSlice(nad.Bytes()); ok {
+				*targets = append(*targets, addr)
+			}
+		}
+
+		return nil
+	}
+}
+
+// BondChange describes the disruption required to apply bond settings changes.
+type BondChange int
+
+// BondChange constants.
+const (
+	// BondChangeLive can be applied to the bond which is up and has slaves.
+	BondChangeLive BondChange = iota
+	// BondChangeDown requires the bond to be brought down.
+	BondChangeDown
+	// BondChangeNoSlaves requires the bond to be brought down and all slaves to be removed.
+	BondChangeNoSlaves
+)
+
+// bondNoSlavesAttributes can only be changed when the bond has no slaves.
+//
+// See BOND_OPTFLAG_NOSLAVES in drivers/net/bonding/bond_options.c.
+var bondNoSlavesAttributes = map[uint16]struct{}{
+	unix.IFLA_BOND_MODE:          {},
+	unix.IFLA_BOND_FAIL_OVER_MAC: {},
+}
+
+// bondDownAttributes can only be changed when the bond is down.
+//
+// See BOND_OPTFLAG_IFDOWN in drivers/net/bonding/bond_options.c.
+var bondDownAttributes = map[uint16]struct{}{
+	unix.IFLA_BOND_AD_LACP_RATE:      {},
+	unix.IFLA_BOND_AD_SELECT:         {},
+	unix.IFLA_BOND_AD_ACTOR_SYS_PRIO: {},
+	unix.IFLA_BOND_AD_USER_PORT_KEY:  {},
+	unix.IFLA_BOND_TLB_DYNAMIC_LB:    {},
+}
+
+// EncodeChanges encodes only the settings which differ from the existing bond settings.
+//
+// The kernel rejects setting some options (even to the same value) while the bond is up or has slaves,
+// so only changed attributes are encoded, and the returned BondChange describes
+// how disruptive the change is.
+func (a bondMaster) EncodeChanges(existing *network.BondMasterSpec) ([]byte, BondChange, error) {
+	data, err := a.Encode()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// mode change affects the set of attributes, so apply all of them
+	if a.Mode != existing.Mode {
+		return data, BondChangeNoSlaves, nil
+	}
+
+	existingData, err := BondMasterSpec(existing).Encode()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	attrs, err := netlink.UnmarshalAttributes(data)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	existingAttrs, err := netlink.UnmarshalAttributes(existingData)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	existingValues := make(map[uint16][]byte, len(existingAttrs))
+
+	for _, attr := range existingAttrs {
+		existingValues[attr.Type] = attr.Data
+	}
+
+	var (
+		changed []netlink.Attribute
+		change  BondChange
+	)
+
+	for _, attr := range attrs {
+		if existingValue, ok := existingValues[attr.Type]; ok && bytes.Equal(existingValue, attr.Data) {
+			continue
+		}
+
+		changed = append(changed, attr)
+
+		if _, ok := bondNoSlavesAttributes[attr.Type]; ok {
+			change = max(change, BondChangeNoSlaves)
+		}
+
+		if _, ok := bondDownAttributes[attr.Type]; ok {
+			change = max(change, BondChangeDown)
+		}
+	}
+
+	if len(changed) == 0 {
+		return nil, BondChangeLive, nil
+	}
+
+	data, err = netlink.MarshalAttributes(changed)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return data, change, nil
+}
+
 // Decode the BondMasterSpec from netlink attributes.
 //
 //nolint:gocyclo,cyclop
@@ -168,6 +301,10 @@ func (a bondMaster) Decode(data []byte) error {
 			bond.DownDelay = decoder.Uint32()
 		case unix.IFLA_BOND_ARP_INTERVAL:
 			bond.ARPInterval = decoder.Uint32()
+		case unix.IFLA_BOND_ARP_IP_TARGET:
+			decoder.Nested(decodeBondTargets(&bond.ARPIPTargets))
+		case unix.IFLA_BOND_NS_IP6_TARGET:
+			decoder.Nested(decodeBondTargets(&bond.NSIP6Targets))
 		case unix.IFLA_BOND_RESEND_IGMP:
 			bond.ResendIGMP = decoder.Uint32()
 		case unix.IFLA_BOND_MIN_LINKS:
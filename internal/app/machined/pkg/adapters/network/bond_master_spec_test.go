@@ -5,8 +5,10 @@
 package network_test
 
 import (
+	"net/netip"
 	"testing"
 
+	"github.com/mdlayher/netlink"
 	"github.com/stretchr/testify/require"
 
 	networkadapter "github.com/siderolabs/talos/internal/app/machined/pkg/adapters/network"
@@ -31,3 +33,109 @@ func TestBondMasterSpec(t *testing.T) {
 
 	require.Equal(t, spec, decodedSpec)
 }
+
+func TestBondMasterSpecARPTargets(t *testing.T) {
+	spec := network.BondMasterSpec{
+		Mode:         nethelpers.BondModeActiveBackup,
+		ARPInterval:  100,
+		ARPValidate:  nethelpers.ARPValidateAll,
+		ARPIPTargets: []netip.Addr{netip.MustParseAddr("10.0.0.1"), netip.MustParseAddr("10.0.0.2")},
+		NSIP6Targets: []netip.Addr{netip.MustParseAddr("2001:db8::1")},
+	}
+
+	b, err := networkadapter.BondMasterSpec(&spec).Encode()
+	require.NoError(t, err)
+
+	var decodedSpec network.BondMasterSpec
+
+	require.NoError(t, networkadapter.BondMasterSpec(&decodedSpec).Decode(b))
+
+	require.Equal(t, spec, decodedSpec)
+
+	// changing the targets doesn't require bringing the bond down
+	updated := spec
+	updated.ARPIPTargets = []netip.Addr{netip.MustParseAddr("10.0.0.3")}
+
+	data, change, err := networkadapter.BondMasterSpec(&updated).EncodeChanges(&spec)
+	require.NoError(t, err)
+
+	require.Equal(t, networkadapter.BondChangeLive, change)
+
+	attrs, err := netlink.UnmarshalAttributes(data)
+	require.NoError(t, err)
+
+	require.Len(t, attrs, 1)
+}
+
+func TestBondMasterSpecEncodeChanges(t *testing.T) {
+	existing := network.BondMasterSpec{
+		Mode:     nethelpers.BondMode8023AD,
+		MIIMon:   100,
+		LACPRate: nethelpers.LACPRateSlow,
+	}
+
+	networkadapter.BondMasterSpec(&existing).FillDefaults()
+
+	for _, test := range []struct {
+		name   string
+		update func(*network.BondMasterSpec)
+
+		expectedChange   networkadapter.BondChange
+		expectedAttrs    int
+		expectedNoChange bool
+	}{
+		{
+			name:             "no changes",
+			update:           func(*network.BondMasterSpec) {},
+			expectedNoChange: true,
+		},
+		{
+			name: "live",
+			update: func(spec *network.BondMasterSpec) {
+				spec.HashPolicy = nethelpers.BondXmitPolicyLayer34
+				spec.MinLinks = 1
+			},
+			expectedChange: networkadapter.BondChangeLive,
+			expectedAttrs:  2,
+		},
+		{
+			name: "down",
+			update: func(spec *network.BondMasterSpec) {
+				spec.LACPRate = nethelpers.LACPRateFast
+			},
+			expectedChange: networkadapter.BondChangeDown,
+			expectedAttrs:  1,
+		},
+		{
+			name: "mode",
+			update: func(spec *network.BondMasterSpec) {
+				spec.Mode = nethelpers.BondModeActiveBackup
+			},
+			expectedChange: networkadapter.BondChangeNoSlaves,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			spec := existing
+
+			test.update(&spec)
+
+			data, change, err := networkadapter.BondMasterSpec(&spec).EncodeChanges(&existing)
+			require.NoError(t, err)
+
+			if test.expectedNoChange {
+				require.Empty(t, data)
+
+				return
+			}
+
+			require.Equal(t, test.expectedChange, change)
+
+			if test.expectedAttrs > 0 {
+				attrs, err := netlink.UnmarshalAttributes(data)
+				require.NoError(t, err)
+
+				require.Len(t, attrs, test.expectedAttrs)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"fmt"
+	"net/netip"
+
+	"github.com/mdlayher/netlink"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// BondStatusSpec adapter provides decoding of the bond runtime state from netlink structures.
+//
+//nolint:revive,golint
+func BondStatusSpec(r *network.BondStatusSpec) bondStatus {
+	return bondStatus{
+		BondStatusSpec: r,
+	}
+}
+
+type bondStatus struct {
+	*network.BondStatusSpec
+}
+
+// Decode the BondStatusSpec from the bond master netlink attributes.
+//
+// Active slave is returned as a link index, as the link name is not known to the adapter.
+//
+//nolint:gocyclo
+func (a bondStatus) Decode(data []byte) (activeSlaveIndex uint32, err error) {
+	status := a.BondStatusSpec
+
+	decoder, err := netlink.NewAttributeDecoder(data)
+	if err != nil {
+		return 0, err
+	}
+
+	for decoder.Next() {
+		switch decoder.Type() {
+		case unix.IFLA_BOND_MODE:
+			status.Mode = nethelpers.BondMode(decoder.Uint8())
+		case unix.IFLA_BOND_XMIT_HASH_POLICY:
+			status.HashPolicy = nethelpers.BondXmitHashPolicy(decoder.Uint8())
+		case unix.IFLA_BOND_AD_LACP_RATE:
+			status.LACPRate = nethelpers.LACPRate(decoder.Uint8())
+		case unix.IFLA_BOND_ACTIVE_SLAVE:
+			activeSlaveIndex = decoder.Uint32()
+		case unix.IFLA_BOND_ARP_IP_TARGET, unix.IFLA_BOND_NS_IP6_TARGET:
+			decoder.Nested(func(nad *netlink.AttributeDecoder) error {
+				for nad.Next() {
+					if addr, ok := netip.AddrFromSlice(nad.Bytes()); ok {
+						status.ARPTargets = append(status.ARPTargets, network.BondARPTargetStatus{Address: addr})
+					}
+				}
+
+				return nil
+			})
+		case unix.IFLA_BOND_AD_INFO:
+			decoder.Nested(func(nad *netlink.AttributeDecoder) error {
+				for nad.Next() {
+					switch nad.Type() {
+					case unix.IFLA_BOND_AD_INFO_AGGREGATOR:
+						status.AggregatorID = nad.Uint16()
+					case unix.IFLA_BOND_AD_INFO_NUM_PORTS:
+						status.NumPorts = nad.Uint16()
+					case unix.IFLA_BOND_AD_INFO_ACTOR_KEY:
+						status.ActorKey = nad.Uint16()
+					case unix.IFLA_BOND_AD_INFO_PARTNER_KEY:
+						status.PartnerKey = nad.Uint16()
+					case unix.IFLA_BOND_AD_INFO_PARTNER_MAC:
+						status.PartnerMAC = nethelpers.HardwareAddr(nad.Bytes())
+					}
+				}
+
+				return nil
+			})
+		}
+	}
+
+	return activeSlaveIndex, decoder.Err()
+}
+
+// DecodeSlave decodes the bond slave netlink attributes and appends the slave to the list of slaves.
+func (a bondStatus) DecodeSlave(name string, data []byte) error {
+	slave := network.BondSlaveStatus{
+		Name: name,
+	}
+
+	decoder, err := netlink.NewAttributeDecoder(data)
+	if err != nil {
+		return err
+	}
+
+	for decoder.Next() {
+		switch decoder.Type() {
+		case unix.IFLA_BOND_SLAVE_STATE:
+			slave.State = bondSlaveState(decoder.Uint8())
+		case unix.IFLA_BOND_SLAVE_MII_STATUS:
+			slave.MIIStatus = bondSlaveMIIStatus(decoder.Uint8())
+		case unix.IFLA_BOND_SLAVE_LINK_FAILURE_COUNT:
+			slave.LinkFailureCount = decoder.Uint32()
+		case unix.IFLA_BOND_SLAVE_PERM_HWADDR:
+			slave.PermanentAddr = nethelpers.HardwareAddr(decoder.Bytes())
+		case unix.IFLA_BOND_SLAVE_AD_AGGREGATOR_ID:
+			slave.AggregatorID = decoder.Uint16()
+		case unix.IFLA_BOND_SLAVE_AD_ACTOR_OPER_PORT_STATE:
+			slave.ActorOperPortState = decoder.Uint8()
+		case unix.IFLA_BOND_SLAVE_AD_PARTNER_OPER_PORT_STATE:
+			slave.PartnerOperPortState = decoder.Uint16()
+		}
+	}
+
+	if err = decoder.Err(); err != nil {
+		return err
+	}
+
+	a.Slaves = append(a.Slaves, slave)
+
+	return nil
+}
+
+// See BOND_STATE_* in include/net/bonding.h.
+func bondSlaveState(state uint8) string {
+	switch state {
+	case 0:
+		return "active"
+	case 1:
+		return "backup"
+	default:
+		return fmt.Sprintf("unknown(%d)", state)
+	}
+}
+
+// See BOND_LINK_* in include/net/bonding.h.
+func bondSlaveMIIStatus(status uint8) string {
+	switch status {
+	case 0:
+		return "up"
+	case 1:
+		return "fail"
+	case 2:
+		return "down"
+	case 3:
+		return "back"
+	default:
+		return fmt.Sprintf("unknown(%d)", status)
+	}
+}
@@ -0,0 +1,80 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"testing"
+
+	"github.com/mdlayher/netlink"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
+
+	networkadapter "github.com/siderolabs/talos/internal/app/machined/pkg/adapters/network"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+func TestBondStatusSpec(t *testing.T) {
+	partnerMAC := []byte{0x00, 0x11, 0x22, 0x33, 0x44, 0x55}
+
+	encoder := netlink.NewAttributeEncoder()
+	encoder.Uint8(unix.IFLA_BOND_MODE, uint8(nethelpers.BondMode8023AD))
+	encoder.Uint8(unix.IFLA_BOND_XMIT_HASH_POLICY, uint8(nethelpers.BondXmitPolicyLayer34))
+	encoder.Uint8(unix.IFLA_BOND_AD_LACP_RATE, uint8(nethelpers.LACPRateFast))
+	encoder.Uint32(unix.IFLA_BOND_ACTIVE_SLAVE, 3)
+	encoder.Nested(unix.IFLA_BOND_AD_INFO, func(nae *netlink.AttributeEncoder) error {
+		nae.Uint16(unix.IFLA_BOND_AD_INFO_AGGREGATOR, 1)
+		nae.Uint16(unix.IFLA_BOND_AD_INFO_NUM_PORTS, 2)
+		nae.Uint16(unix.IFLA_BOND_AD_INFO_ACTOR_KEY, 15)
+		nae.Uint16(unix.IFLA_BOND_AD_INFO_PARTNER_KEY, 32)
+		nae.Bytes(unix.IFLA_BOND_AD_INFO_PARTNER_MAC, partnerMAC)
+
+		return nil
+	})
+
+	masterData, err := encoder.Encode()
+	require.NoError(t, err)
+
+	encoder = netlink.NewAttributeEncoder()
+	encoder.Uint8(unix.IFLA_BOND_SLAVE_STATE, 0)
+	encoder.Uint8(unix.IFLA_BOND_SLAVE_MII_STATUS, 2)
+	encoder.Uint32(unix.IFLA_BOND_SLAVE_LINK_FAILURE_COUNT, 5)
+	encoder.Uint16(unix.IFLA_BOND_SLAVE_AD_AGGREGATOR_ID, 1)
+	encoder.Uint8(unix.IFLA_BOND_SLAVE_AD_ACTOR_OPER_PORT_STATE, 0x3d)
+	encoder.Uint16(unix.IFLA_BOND_SLAVE_AD_PARTNER_OPER_PORT_STATE, 0x3f)
+
+	slaveData, err := encoder.Encode()
+	require.NoError(t, err)
+
+	var spec network.BondStatusSpec
+
+	activeSlaveIndex, err := networkadapter.BondStatusSpec(&spec).Decode(masterData)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, activeSlaveIndex)
+
+	require.NoError(t, networkadapter.BondStatusSpec(&spec).DecodeSlave("eth0", slaveData))
+
+	require.Equal(t, network.BondStatusSpec{
+		Mode:         nethelpers.BondMode8023AD,
+		HashPolicy:   nethelpers.BondXmitPolicyLayer34,
+		LACPRate:     nethelpers.LACPRateFast,
+		AggregatorID: 1,
+		NumPorts:     2,
+		ActorKey:     15,
+		PartnerKey:   32,
+		PartnerMAC:   partnerMAC,
+		Slaves: []network.BondSlaveStatus{
+			{
+				Name:                 "eth0",
+				State:                "active",
+				MIIStatus:            "down",
+				LinkFailureCount:     5,
+				AggregatorID:         1,
+				ActorOperPortState:   0x3d,
+				PartnerOperPortState: 0x3f,
+			},
+		},
+	}, spec)
+}
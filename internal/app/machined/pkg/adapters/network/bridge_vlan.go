@@ -0,0 +1,127 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/mdlayher/netlink"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// See include/uapi/linux/if_bridge.h.
+const (
+	iflaBridgeVLANInfo = 2
+
+	bridgeVLANInfoPVID       = 1 << 1
+	bridgeVLANInfoUntagged   = 1 << 2
+	bridgeVLANInfoRangeBegin = 1 << 3
+	bridgeVLANInfoRangeEnd   = 1 << 4
+)
+
+// BridgeVLANs adapter provides encoding/decoding of the bridge port VLAN table to netlink structures.
+//
+//nolint:revive
+func BridgeVLANs(r *[]network.BridgeVLANEntry) bridgeVLANs {
+	return bridgeVLANs{
+		entries: r,
+	}
+}
+
+type bridgeVLANs struct {
+	entries *[]network.BridgeVLANEntry
+}
+
+// Encode the VLAN table into the AF_BRIDGE link message attributes.
+func (a bridgeVLANs) Encode() ([]byte, error) {
+	encoder := netlink.NewAttributeEncoder()
+
+	encoder.Nested(unix.IFLA_AF_SPEC, func(nae *netlink.AttributeEncoder) error {
+		for _, entry := range *a.entries {
+			var flags uint16
+
+			if entry.PVID {
+				flags |= bridgeVLANInfoPVID
+			}
+
+			if entry.Untagged {
+				flags |= bridgeVLANInfoUntagged
+			}
+
+			info := make([]byte, 4)
+
+			binary.NativeEndian.PutUint16(info[0:2], flags)
+			binary.NativeEndian.PutUint16(info[2:4], entry.VID)
+
+			nae.Bytes(iflaBridgeVLANInfo, info)
+		}
+
+		return nil
+	})
+
+	return encoder.Encode()
+}
+
+// Decode the VLAN table from the AF_BRIDGE link message attributes.
+func (a bridgeVLANs) Decode(data []byte) error {
+	*a.entries = nil
+
+	decoder, err := netlink.NewAttributeDecoder(data)
+	if err != nil {
+		return err
+	}
+
+	for decoder.Next() {
+		if decoder.Type() != unix.IFLA_AF_SPEC {
+			continue
+		}
+
+		decoder.Nested(func(nad *netlink.AttributeDecoder) error {
+			var rangeBegin uint16
+
+			for nad.Next() {
+				if nad.Type() != iflaBridgeVLANInfo {
+					continue
+				}
+
+				info := nad.Bytes()
+				if len(info) < 4 {
+					return fmt.Errorf("bridge VLAN info is too short: %d bytes", len(info))
+				}
+
+				flags := binary.NativeEndian.Uint16(info[0:2])
+				vid := binary.NativeEndian.Uint16(info[2:4])
+
+				if flags&bridgeVLANInfoRangeBegin != 0 {
+					rangeBegin = vid
+
+					continue
+				}
+
+				first := vid
+
+				if flags&bridgeVLANInfoRangeEnd != 0 && rangeBegin != 0 {
+					first = rangeBegin
+					rangeBegin = 0
+				}
+
+				for id := int(first); id <= int(vid); id++ {
+					*a.entries = append(*a.entries, network.BridgeVLANEntry{
+						VID:      uint16(id),
+						PVID:     flags&bridgeVLANInfoPVID != 0,
+						Untagged: flags&bridgeVLANInfoUntagged != 0,
+					})
+				}
+			}
+
+			return nil
+		})
+	}
+
+	return decoder.Err()
+}
@@ -0,0 +1,72 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/mdlayher/netlink"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
+
+	networkadapter "github.com/siderolabs/talos/internal/app/machined/pkg/adapters/network"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+func TestBridgeVLANs(t *testing.T) {
+	entries := []network.BridgeVLANEntry{
+		{
+			VID:      10,
+			PVID:     true,
+			Untagged: true,
+		},
+		{
+			VID:      20,
+			Untagged: true,
+		},
+		{
+			VID: 30,
+		},
+	}
+
+	b, err := networkadapter.BridgeVLANs(&entries).Encode()
+	require.NoError(t, err)
+
+	var decoded []network.BridgeVLANEntry
+
+	require.NoError(t, networkadapter.BridgeVLANs(&decoded).Decode(b))
+
+	require.Equal(t, entries, decoded)
+}
+
+func TestBridgeVLANsDecodeRange(t *testing.T) {
+	vlanInfo := func(flags, vid uint16) []byte {
+		info := make([]byte, 4)
+
+		binary.NativeEndian.PutUint16(info[0:2], flags)
+		binary.NativeEndian.PutUint16(info[2:4], vid)
+
+		return info
+	}
+
+	encoder := netlink.NewAttributeEncoder()
+
+	encoder.Nested(unix.IFLA_AF_SPEC, func(nae *netlink.AttributeEncoder) error {
+		nae.Bytes(2, vlanInfo(1<<3, 100)) // range begin
+		nae.Bytes(2, vlanInfo(1<<4, 102)) // range end
+
+		return nil
+	})
+
+	b, err := encoder.Encode()
+	require.NoError(t, err)
+
+	var decoded []network.BridgeVLANEntry
+
+	require.NoError(t, networkadapter.BridgeVLANs(&decoded).Decode(b))
+
+	require.Equal(t, []network.BridgeVLANEntry{{VID: 100}, {VID: 101}, {VID: 102}}, decoded)
+}
@@ -692,6 +692,16 @@ func (a nftablesRule) Compile() (*NfTablesCompiled, error) {
 		)
 	}
 
+	if a.NfTablesRule.Log != nil {
+		rulePost = append(rulePost,
+			// [ log prefix <prefix> ]
+			&expr.Log{
+				Key:  1 << unix.NFTA_LOG_PREFIX,
+				Data: []byte(a.NfTablesRule.Log.Prefix),
+			},
+		)
+	}
+
 	if a.NfTablesRule.Verdict != nil {
 		rulePost = append(rulePost,
 			// [ verdict accept|drop ]
@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go4.org/netipx"
+	"golang.org/x/sys/unix"
 
 	"github.com/siderolabs/talos/internal/app/machined/pkg/adapters/network"
 	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
@@ -641,6 +642,33 @@ func TestNfTablesRuleCompile(t *testing.T) { //nolint:tparallel
 				},
 			},
 		},
+		{
+			name: "log",
+			spec: networkres.NfTablesRule{
+				MatchLimit: &networkres.NfTablesLimitMatch{
+					PacketRatePerSecond: 5,
+				},
+				AnonCounter: true,
+				Log: &networkres.NfTablesLog{
+					Prefix: "talos-test: ",
+				},
+			},
+			expectedRules: [][]expr.Any{
+				{
+					&expr.Limit{
+						Type:  expr.LimitTypePkts,
+						Rate:  5,
+						Burst: 5,
+						Unit:  expr.LimitTimeSecond,
+					},
+					&expr.Counter{},
+					&expr.Log{
+						Key:  1 << unix.NFTA_LOG_PREFIX,
+						Data: []byte("talos-test: "),
+					},
+				},
+			},
+		},
 		{
 			name: "ct state",
 			spec: networkres.NfTablesRule{
@@ -0,0 +1,166 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/mdlayher/netlink"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// See struct ifla_vf_mac in include/uapi/linux/if_link.h.
+const (
+	vfMACLen     = 32
+	vfMACAddrLen = 6
+)
+
+// SRIOVVFSpec adapter provides encoding of the SR-IOV virtual function settings to netlink structures.
+//
+//nolint:revive
+func SRIOVVFSpec(r *network.SRIOVVFSpec) sriovVFSpec {
+	return sriovVFSpec{
+		spec: r,
+	}
+}
+
+type sriovVFSpec struct {
+	spec *network.SRIOVVFSpec
+}
+
+// Encode the virtual function settings into the physical function link message attributes.
+//
+// Only the settings which are set in the spec are encoded.
+func (a sriovVFSpec) Encode() ([]byte, error) {
+	spec := a.spec
+
+	encoder := netlink.NewAttributeEncoder()
+
+	encoder.Nested(unix.IFLA_VFINFO_LIST, func(nae *netlink.AttributeEncoder) error {
+		nae.Nested(unix.IFLA_VF_INFO, func(vfae *netlink.AttributeEncoder) error {
+			if len(spec.HardwareAddr) > 0 {
+				b := make([]byte, 4+vfMACLen)
+
+				binary.NativeEndian.PutUint32(b[0:4], spec.Index)
+				copy(b[4:], spec.HardwareAddr)
+
+				vfae.Bytes(unix.IFLA_VF_MAC, b)
+			}
+
+			if spec.VLAN != 0 {
+				b := make([]byte, 12)
+
+				binary.NativeEndian.PutUint32(b[0:4], spec.Index)
+				binary.NativeEndian.PutUint32(b[4:8], uint32(spec.VLAN))
+
+				vfae.Bytes(unix.IFLA_VF_VLAN, b)
+			}
+
+			if spec.SpoofCheck != nil {
+				vfae.Bytes(unix.IFLA_VF_SPOOFCHK, vfSetting(spec.Index, *spec.SpoofCheck))
+			}
+
+			if spec.Trust != nil {
+				vfae.Bytes(unix.IFLA_VF_TRUST, vfSetting(spec.Index, *spec.Trust))
+			}
+
+			return nil
+		})
+
+		return nil
+	})
+
+	return encoder.Encode()
+}
+
+func vfSetting(index uint32, enabled bool) []byte {
+	b := make([]byte, 8)
+
+	binary.NativeEndian.PutUint32(b[0:4], index)
+
+	if enabled {
+		binary.NativeEndian.PutUint32(b[4:8], 1)
+	}
+
+	return b
+}
+
+// SRIOVVFStatuses adapter provides decoding of the SR-IOV virtual function settings from netlink structures.
+//
+//nolint:revive
+func SRIOVVFStatuses(r *[]network.SRIOVVFStatus) sriovVFStatuses {
+	return sriovVFStatuses{
+		statuses: r,
+	}
+}
+
+type sriovVFStatuses struct {
+	statuses *[]network.SRIOVVFStatus
+}
+
+// Decode the virtual function settings from the physical function link message attributes.
+func (a sriovVFStatuses) Decode(data []byte) error {
+	*a.statuses = nil
+
+	decoder, err := netlink.NewAttributeDecoder(data)
+	if err != nil {
+		return err
+	}
+
+	for decoder.Next() {
+		if decoder.Type() != unix.IFLA_VFINFO_LIST {
+			continue
+		}
+
+		decoder.Nested(func(nad *netlink.AttributeDecoder) error {
+			for nad.Next() {
+				if nad.Type() != unix.IFLA_VF_INFO {
+					continue
+				}
+
+				var status network.SRIOVVFStatus
+
+				nad.Nested(func(vfad *netlink.AttributeDecoder) error {
+					for vfad.Next() {
+						b := vfad.Bytes()
+
+						if len(b) < 8 {
+							continue
+						}
+
+						status.Index = binary.NativeEndian.Uint32(b[0:4])
+
+						switch vfad.Type() {
+						case unix.IFLA_VF_MAC:
+							if len(b) < 4+vfMACAddrLen {
+								return fmt.Errorf("VF MAC attribute is too short: %d bytes", len(b))
+							}
+
+							status.HardwareAddr = nethelpers.HardwareAddr(append([]byte(nil), b[4:4+vfMACAddrLen]...))
+						case unix.IFLA_VF_VLAN:
+							status.VLAN = uint16(binary.NativeEndian.Uint32(b[4:8]))
+						case unix.IFLA_VF_SPOOFCHK:
+							status.SpoofCheck = binary.NativeEndian.Uint32(b[4:8]) == 1
+						case unix.IFLA_VF_TRUST:
+							status.Trust = binary.NativeEndian.Uint32(b[4:8]) == 1
+						}
+					}
+
+					return nil
+				})
+
+				*a.statuses = append(*a.statuses, status)
+			}
+
+			return nil
+		})
+	}
+
+	return decoder.Err()
+}
@@ -0,0 +1,64 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"testing"
+
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/require"
+
+	networkadapter "github.com/siderolabs/talos/internal/app/machined/pkg/adapters/network"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+func TestSRIOVVFSpec(t *testing.T) {
+	spec := network.SRIOVVFSpec{
+		Index:        3,
+		HardwareAddr: nethelpers.HardwareAddr{0x02, 0, 0, 0, 0, 0x03},
+		VLAN:         100,
+		Trust:        pointer.To(true),
+		SpoofCheck:   pointer.To(false),
+	}
+
+	b, err := networkadapter.SRIOVVFSpec(&spec).Encode()
+	require.NoError(t, err)
+
+	var decoded []network.SRIOVVFStatus
+
+	require.NoError(t, networkadapter.SRIOVVFStatuses(&decoded).Decode(b))
+
+	require.Equal(t, []network.SRIOVVFStatus{
+		{
+			Index:        3,
+			HardwareAddr: nethelpers.HardwareAddr{0x02, 0, 0, 0, 0, 0x03},
+			VLAN:         100,
+			Trust:        true,
+			SpoofCheck:   false,
+		},
+	}, decoded)
+}
+
+func TestSRIOVVFSpecPartial(t *testing.T) {
+	spec := network.SRIOVVFSpec{
+		Index: 1,
+		Trust: pointer.To(true),
+	}
+
+	b, err := networkadapter.SRIOVVFSpec(&spec).Encode()
+	require.NoError(t, err)
+
+	var decoded []network.SRIOVVFStatus
+
+	require.NoError(t, networkadapter.SRIOVVFStatuses(&decoded).Decode(b))
+
+	require.Equal(t, []network.SRIOVVFStatus{
+		{
+			Index: 1,
+			Trust: true,
+		},
+	}, decoded)
+}
@@ -62,7 +62,7 @@ func (a wireguardSpec) Encode(existing *network.WireguardSpec) (*wgtypes.Config,
 	l, r := 0, 0
 
 	for l < len(existing.Peers) || r < len(spec.Peers) {
-		addPeer := func(peer *network.WireguardPeer) error {
+		addPeer := func(peer *network.WireguardPeer, updateOnly bool) error {
 			pubKey, err := wgtypes.ParseKey(peer.PublicKey)
 			if err != nil {
 				return err
@@ -95,6 +95,7 @@ func (a wireguardSpec) Encode(existing *network.WireguardSpec) (*wgtypes.Config,
 				Endpoint:                    endpoint,
 				PresharedKey:                presharedKey,
 				PersistentKeepaliveInterval: &peer.PersistentKeepaliveInterval,
+				UpdateOnly:                  updateOnly,
 				ReplaceAllowedIPs:           true,
 				AllowedIPs: xslices.Map(peer.AllowedIPs, func(peerIP netip.Prefix) net.IPNet {
 					return *netipx.PrefixIPNet(peerIP)
@@ -131,7 +132,7 @@ func (a wireguardSpec) Encode(existing *network.WireguardSpec) (*wgtypes.Config,
 		switch {
 		// peer from the "right" (new spec) is missing in "existing" (left), add it
 		case left == nil || (right != nil && left.PublicKey > right.PublicKey):
-			if err := addPeer(right); err != nil {
+			if err := addPeer(right, false); err != nil {
 				return nil, err
 			}
 
@@ -147,8 +148,8 @@ func (a wireguardSpec) Encode(existing *network.WireguardSpec) (*wgtypes.Config,
 		// peer public keys are equal, so either they are identical or peer should be replaced
 		case left.PublicKey == right.PublicKey:
 			if !left.Equal(right) {
-				// replace peer
-				if err := addPeer(right); err != nil {
+				// update the existing peer in place (e.g. keepalive interval change), without removing it
+				if err := addPeer(right, true); err != nil {
 					return nil, err
 				}
 			}
@@ -280,6 +280,33 @@ func TestWireguardSpecEncode(t *testing.T) {
 				PublicKey:                   pub1.PublicKey(),
 				PresharedKey:                &priv,
 				PersistentKeepaliveInterval: pointer.To[time.Duration](0),
+				UpdateOnly:                  true,
+				ReplaceAllowedIPs:           true,
+				AllowedIPs: []net.IPNet{
+					{
+						IP:   net.ParseIP("172.24.0.0").To4(),
+						Mask: net.IPv4Mask(255, 255, 0, 0),
+					},
+				},
+			},
+		},
+	}, delta)
+
+	// update peer1 keepalive interval, the peer is updated in place
+	specV4 := specV3
+	specV4.Peers = []network.WireguardPeer{specV3.Peers[0]}
+	specV4.Peers[0].PersistentKeepaliveInterval = 25 * time.Second
+
+	delta, err = networkadapter.WireguardSpec(&specV4).Encode(&specV3)
+	require.NoError(t, err)
+
+	assert.Equal(t, &wgtypes.Config{
+		Peers: []wgtypes.PeerConfig{
+			{
+				PublicKey:                   pub1.PublicKey(),
+				PresharedKey:                &priv,
+				PersistentKeepaliveInterval: pointer.To(25 * time.Second),
+				UpdateOnly:                  true,
 				ReplaceAllowedIPs:           true,
 				AllowedIPs: []net.IPNet{
 					{
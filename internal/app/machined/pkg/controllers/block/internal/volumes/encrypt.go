@@ -126,6 +126,7 @@ func HandleEncryptionWithHandler(ctx context.Context, logger *zap.Logger, volume
 				volumeContext.Status.TPMEncryptionOptions = block.TPMEncryptionOptionsInfo{
 					PCRs:       key.TPMPCRs,
 					PubKeyPCRs: key.TPMPubKeyPCRs,
+					PCRBank:    key.TPMPCRBank,
 				}
 			}
 		}
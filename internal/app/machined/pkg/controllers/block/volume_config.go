@@ -126,6 +126,7 @@ func convertEncryptionConfiguration(in cfg.EncryptionConfig, out *block.VolumeCo
 			out.Encryption.Keys[i].TPMCheckSecurebootStatusOnEnroll = key.TPM().CheckSecurebootOnEnroll()
 			out.Encryption.Keys[i].TPMPCRs = key.TPM().PCRs()
 			out.Encryption.Keys[i].TPMPubKeyPCRs = key.TPM().PubKeyPCRs()
+			out.Encryption.Keys[i].TPMPCRBank = key.TPM().PCRBank()
 		default:
 			return fmt.Errorf("unsupported encryption key type: slot %d", key.Slot())
 		}
@@ -479,7 +480,7 @@ func (ctrl *VolumeConfigController) manageStandardVolumes(ctx context.Context, r
 			UID:          constants.KubernetesAPIServerRunUser,
 			GID:          constants.KubernetesAPIServerRunGroup,
 			Recursive:    true,
-			SELinuxLabel: "system_u:object_r:kube_log_t:s0",
+			SELinuxLabel: constants.KubernetesAuditLogDirSELinuxLabel,
 		},
 		{
 			Path:         "/var/log/containers",
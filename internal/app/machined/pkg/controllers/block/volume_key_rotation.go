@@ -0,0 +1,351 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/gen/xslices"
+	blockdev "github.com/siderolabs/go-blockdevice/v2/block"
+	"go.uber.org/zap"
+
+	machineruntime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/pkg/encryption"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+	"github.com/siderolabs/talos/pkg/machinery/resources/hardware"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+const keyRotationTimeout = 5 * time.Minute
+
+// VolumeKeyRotationController reports the disk encryption keys of the volumes and rotates them on request.
+type VolumeKeyRotationController struct {
+	V1Alpha1Events machineruntime.Publisher
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *VolumeKeyRotationController) Name() string {
+	return "block.VolumeKeyRotationController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *VolumeKeyRotationController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: block.NamespaceName,
+			Type:      block.VolumeKeyRotationType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: block.NamespaceName,
+			Type:      block.VolumeStatusType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: block.NamespaceName,
+			Type:      block.VolumeConfigType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: hardware.NamespaceName,
+			Type:      hardware.SystemInformationType,
+			ID:        optional.Some(hardware.SystemInformationID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: hardware.NamespaceName,
+			Type:      hardware.PCRStatusType,
+			Kind:      controller.InputStrong,
+		},
+		{
+			Namespace: secrets.NamespaceName,
+			Type:      secrets.EncryptionSaltType,
+			ID:        optional.Some(secrets.EncryptionSaltID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *VolumeKeyRotationController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: block.VolumeKeyStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo,cyclop
+func (ctrl *VolumeKeyRotationController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	// key slots are read from the LUKS2 header only when the volume is opened or the keys are rotated
+	keySlotsCache := map[string][]block.VolumeKeySlotSpec{}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		volumeStatuses, err := safe.ReaderListAll[*block.VolumeStatus](ctx, r)
+		if err != nil {
+			return fmt.Errorf("failed to list volume statuses: %w", err)
+		}
+
+		r.StartTrackingOutputs()
+
+		for volumeStatus := range volumeStatuses.All() {
+			volumeID := volumeStatus.Metadata().ID()
+
+			if volumeStatus.TypedSpec().Phase != block.VolumePhaseReady || volumeStatus.TypedSpec().EncryptionProvider != block.EncryptionProviderLUKS2 {
+				delete(keySlotsCache, volumeID)
+
+				continue
+			}
+
+			volumeConfig, err := safe.ReaderGetByID[*block.VolumeConfig](ctx, r, volumeID)
+			if err != nil {
+				if state.IsNotFoundError(err) {
+					continue
+				}
+
+				return fmt.Errorf("failed to get volume config: %w", err)
+			}
+
+			rotation, err := safe.ReaderGetByID[*block.VolumeKeyRotation](ctx, r, volumeID)
+			if err != nil && !state.IsNotFoundError(err) {
+				return fmt.Errorf("failed to get volume key rotation: %w", err)
+			}
+
+			var spec block.VolumeKeyStatusSpec
+
+			keyStatus, err := safe.ReaderGetByID[*block.VolumeKeyStatus](ctx, r, volumeID)
+
+			switch {
+			case err == nil:
+				spec = keyStatus.TypedSpec().DeepCopy()
+			case !state.IsNotFoundError(err):
+				return fmt.Errorf("failed to get volume key status: %w", err)
+			}
+
+			volumeLogger := logger.With(zap.String("volume", volumeID))
+
+			handler, err := encryption.NewHandler(volumeConfig.TypedSpec().Encryption, volumeID, encryption.Helpers{
+				GetSystemInformation: systemInformationGetter(r),
+				TPMLocker:            hardware.LockPCRStatus(r, constants.UKIPCR, volumeID+"-key-rotation"),
+				SaltGetter:           encryptionSaltGetter(r),
+			})
+			if err != nil {
+				volumeLogger.Warn("failed to create encryption handler", zap.Error(err))
+
+				continue
+			}
+
+			location := volumeStatus.TypedSpec().Location
+
+			if rotation != nil && !spec.RotationRequestedAt.Equal(rotation.TypedSpec().RequestedAt) {
+				ctrl.rotate(ctx, r, volumeLogger, handler, volumeID, volumeStatus.TypedSpec(), volumeConfig.TypedSpec(), rotation.TypedSpec(), &spec)
+
+				delete(keySlotsCache, volumeID)
+			}
+
+			if _, cached := keySlotsCache[volumeID]; !cached {
+				keySlots, err := readKeySlots(ctx, handler, location, volumeConfig.TypedSpec())
+				if err != nil {
+					volumeLogger.Warn("failed to read encryption key slots", zap.Error(err))
+				} else {
+					keySlotsCache[volumeID] = keySlots
+				}
+			}
+
+			spec.Slots = keySlotsCache[volumeID]
+			spec.Epoch = 0
+
+			for _, slot := range spec.Slots {
+				spec.Epoch = max(spec.Epoch, slot.Epoch)
+			}
+
+			if err = safe.WriterModify(ctx, r, block.NewVolumeKeyStatus(block.NamespaceName, volumeID), func(res *block.VolumeKeyStatus) error {
+				*res.TypedSpec() = spec
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("failed to update volume key status: %w", err)
+			}
+		}
+
+		if err = safe.CleanupOutputs[*block.VolumeKeyStatus](ctx, r); err != nil {
+			return err
+		}
+	}
+}
+
+// rotate rotates the requested key slots one by one, recording the progress in the volume key status.
+func (ctrl *VolumeKeyRotationController) rotate(
+	ctx context.Context,
+	r controller.Runtime,
+	logger *zap.Logger,
+	handler *encryption.Handler,
+	volumeID string,
+	volumeStatus *block.VolumeStatusSpec,
+	volumeConfig *block.VolumeConfigSpec,
+	rotation *block.VolumeKeyRotationSpec,
+	spec *block.VolumeKeyStatusSpec,
+) {
+	slots := rotation.Slots
+	if len(slots) == 0 {
+		// rotate all keys wrapped by a key provider
+		for _, key := range volumeConfig.Encryption.Keys {
+			if key.Type == block.EncryptionKeyKMS || key.Type == block.EncryptionKeyTPM {
+				slots = append(slots, key.Slot)
+			}
+		}
+	}
+
+	spec.RotationError = ""
+
+	err := ctrl.rotateSlots(ctx, r, logger, handler, volumeID, volumeStatus, slots, spec)
+	if err != nil {
+		logger.Error("failed to rotate encryption keys", zap.Error(err))
+
+		spec.RotationError = err.Error()
+	} else {
+		spec.RotatedAt = time.Now()
+	}
+
+	// the request is marked as handled only once the rotation is finished
+	spec.RotationRequestedAt = rotation.RequestedAt
+	spec.RotationStep = ""
+}
+
+func (ctrl *VolumeKeyRotationController) rotateSlots(
+	ctx context.Context,
+	r controller.Runtime,
+	logger *zap.Logger,
+	handler *encryption.Handler,
+	volumeID string,
+	volumeStatus *block.VolumeStatusSpec,
+	slots []int,
+	spec *block.VolumeKeyStatusSpec,
+) error {
+	if len(slots) == 0 {
+		return errors.New("no key slots to rotate, only the keys wrapped by a key provider (KMS, TPM) can be rotated")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, keyRotationTimeout)
+	defer cancel()
+
+	unlock, err := lockVolumeDevice(ctx, volumeStatus)
+	if err != nil {
+		return err
+	}
+
+	defer unlock()
+
+	for _, slot := range slots {
+		slotLogger := logger.With(zap.Int("slot", slot))
+
+		epoch, err := handler.RotateKey(ctx, slotLogger, volumeStatus.Location, slot, func(step encryption.RotationStep) {
+			spec.RotationStep = fmt.Sprintf("slot %d: %s", slot, step)
+
+			ctrl.publish(ctx, volumeID, slot, string(step), 0, nil)
+
+			if err := safe.WriterModify(ctx, r, block.NewVolumeKeyStatus(block.NamespaceName, volumeID), func(res *block.VolumeKeyStatus) error {
+				*res.TypedSpec() = *spec
+
+				return nil
+			}); err != nil {
+				slotLogger.Warn("failed to update volume key status", zap.Error(err))
+			}
+		})
+
+		ctrl.publish(ctx, volumeID, slot, "", epoch, err)
+
+		if err != nil {
+			return fmt.Errorf("failed to rotate key slot %d: %w", slot, err)
+		}
+	}
+
+	return nil
+}
+
+func (ctrl *VolumeKeyRotationController) publish(ctx context.Context, volumeID string, slot int, step string, epoch int, err error) {
+	if ctrl.V1Alpha1Events == nil {
+		return
+	}
+
+	event := &machine.DiskKeyRotationEvent{
+		VolumeId: volumeID,
+		Slot:     int64(slot),
+		Step:     step,
+		Epoch:    int64(epoch),
+	}
+
+	if err != nil {
+		event.Error = err.Error()
+	}
+
+	ctrl.V1Alpha1Events.Publish(ctx, event)
+}
+
+// lockVolumeDevice locks the device of the volume the same way the volume manager does while opening the volume.
+func lockVolumeDevice(ctx context.Context, volumeStatus *block.VolumeStatusSpec) (func(), error) {
+	devPath := volumeStatus.ParentLocation
+	if devPath == "" {
+		devPath = volumeStatus.Location
+	}
+
+	dev, err := blockdev.NewFromPath(devPath, blockdev.OpenForWrite())
+	if err != nil {
+		return nil, fmt.Errorf("error opening disk: %w", err)
+	}
+
+	if err = dev.RetryLockWithTimeout(ctx, true, 10*time.Second); err != nil {
+		dev.Close() //nolint:errcheck
+
+		return nil, fmt.Errorf("error locking disk: %w", err)
+	}
+
+	return func() {
+		dev.Unlock() //nolint:errcheck
+		dev.Close()  //nolint:errcheck
+	}, nil
+}
+
+// readKeySlots reads the key slots of the volume, the provider of the configured key slots is taken from the configuration.
+func readKeySlots(ctx context.Context, handler *encryption.Handler, location string, volumeConfig *block.VolumeConfigSpec) ([]block.VolumeKeySlotSpec, error) {
+	keySlots, err := handler.KeySlots(ctx, location)
+	if err != nil {
+		return nil, err
+	}
+
+	return xslices.Map(keySlots, func(keySlot encryption.KeySlotInfo) block.VolumeKeySlotSpec {
+		provider := keySlot.Provider
+
+		for _, key := range volumeConfig.Encryption.Keys {
+			if key.Slot == keySlot.Slot {
+				provider = key.Type.String()
+			}
+		}
+
+		return block.VolumeKeySlotSpec{
+			Slot:     keySlot.Slot,
+			Provider: provider,
+			Epoch:    keySlot.Epoch,
+		}
+	}), nil
+}
@@ -384,9 +384,9 @@ func (ctrl *VolumeManagerController) Run(ctx context.Context, r controller.Runti
 					DevicesReady:            devicesReady,
 					PreviousWaveProvisioned: vc.TypedSpec().Provisioning.Wave <= fullyProvisionedWave,
 					EncryptionHelpers: encryption.Helpers{
-						GetSystemInformation: ctrl.getSystemInformation(r),
+						GetSystemInformation: systemInformationGetter(r),
 						TPMLocker:            hardware.LockPCRStatus(r, constants.UKIPCR, vc.Metadata().ID()),
-						SaltGetter:           ctrl.getSaltGetter(r),
+						SaltGetter:           encryptionSaltGetter(r),
 					},
 					ShouldCloseVolume: shouldCloseVolume,
 				},
@@ -593,7 +593,7 @@ func (ctrl *VolumeManagerController) processVolumeConfig(ctx context.Context, lo
 	}
 }
 
-func (ctrl *VolumeManagerController) getSystemInformation(r controller.Reader) helpers.SystemInformationGetter {
+func systemInformationGetter(r controller.Reader) helpers.SystemInformationGetter {
 	return func(ctx context.Context) (*hardware.SystemInformation, error) {
 		systemInfo, err := safe.ReaderGetByID[*hardware.SystemInformation](ctx, r, hardware.SystemInformationID)
 		if err != nil && !state.IsNotFoundError(err) {
@@ -608,7 +608,7 @@ func (ctrl *VolumeManagerController) getSystemInformation(r controller.Reader) h
 	}
 }
 
-func (ctrl *VolumeManagerController) getSaltGetter(r controller.Reader) helpers.SaltGetter {
+func encryptionSaltGetter(r controller.Reader) helpers.SaltGetter {
 	return func(ctx context.Context) ([]byte, error) {
 		salt, err := safe.ReaderGetByID[*secrets.EncryptionSalt](ctx, r, secrets.EncryptionSaltID)
 		if err != nil && !state.IsNotFoundError(err) {
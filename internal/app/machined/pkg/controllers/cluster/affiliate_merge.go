@@ -12,6 +12,8 @@ import (
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
 	"go.uber.org/zap"
 
 	"github.com/siderolabs/talos/pkg/machinery/resources/cluster"
@@ -33,6 +35,12 @@ func (ctrl *AffiliateMergeController) Inputs() []controller.Input {
 			Type:      cluster.AffiliateType,
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: cluster.NamespaceName,
+			Type:      cluster.IdentityType,
+			ID:        optional.Some(cluster.LocalIdentity),
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -62,12 +70,23 @@ func (ctrl *AffiliateMergeController) Run(ctx context.Context, r controller.Runt
 			return errors.New("error listing affiliates")
 		}
 
+		identity, err := safe.ReaderGetByID[*cluster.Identity](ctx, r, cluster.LocalIdentity)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting local identity: %w", err)
+		}
+
 		mergedAffiliates := make(map[resource.ID]*cluster.AffiliateSpec, rawAffiliates.Len())
 
 		for rawAffiliate := range rawAffiliates.All() {
 			affiliateSpec := rawAffiliate.TypedSpec()
 			id := affiliateSpec.NodeID
 
+			// the local affiliate is managed by LocalAffiliateController,
+			// a raw affiliate with the local node ID is a machine cloned with the node identity (see IdentityConflictController)
+			if identity != nil && id == identity.TypedSpec().NodeID {
+				continue
+			}
+
 			if affiliate, ok := mergedAffiliates[id]; ok {
 				affiliate.Merge(affiliateSpec)
 			} else {
@@ -0,0 +1,173 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/gen/xslices"
+	"go.uber.org/zap"
+
+	v1alpha1runtime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/resources/cluster"
+)
+
+// IdentityConflictController detects other machines which present the same node identity as the local node.
+//
+// The discovery registries report the affiliates of the other machines, so an affiliate with the local node ID
+// but a different hostname or nodename means that the machine was cloned along with the node identity.
+type IdentityConflictController struct {
+	V1Alpha1Events v1alpha1runtime.Publisher
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *IdentityConflictController) Name() string {
+	return "cluster.IdentityConflictController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *IdentityConflictController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: cluster.NamespaceName,
+			Type:      cluster.IdentityType,
+			ID:        optional.Some(cluster.LocalIdentity),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: cluster.NamespaceName,
+			Type:      cluster.AffiliateType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: cluster.RawNamespaceName,
+			Type:      cluster.AffiliateType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *IdentityConflictController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: cluster.IdentityConflictType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *IdentityConflictController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		identity, err := safe.ReaderGetByID[*cluster.Identity](ctx, r, cluster.LocalIdentity)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting local identity: %w", err)
+		}
+
+		r.StartTrackingOutputs()
+
+		if identity != nil {
+			if err = ctrl.detectConflicts(ctx, r, logger, identity.TypedSpec().NodeID); err != nil {
+				return err
+			}
+		}
+
+		if err = safe.CleanupOutputs[*cluster.IdentityConflict](ctx, r); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func (ctrl *IdentityConflictController) detectConflicts(ctx context.Context, r controller.Runtime, logger *zap.Logger, localID string) error {
+	localAffiliate, err := safe.ReaderGetByID[*cluster.Affiliate](ctx, r, localID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil
+		}
+
+		return fmt.Errorf("error getting local affiliate: %w", err)
+	}
+
+	rawAffiliates, err := safe.ReaderList[*cluster.Affiliate](ctx, r, resource.NewMetadata(cluster.RawNamespaceName, cluster.AffiliateType, "", resource.VersionUndefined))
+	if err != nil {
+		return fmt.Errorf("error listing raw affiliates: %w", err)
+	}
+
+	for rawAffiliate := range rawAffiliates.All() {
+		spec := rawAffiliate.TypedSpec()
+
+		if spec.NodeID != localID || !conflicts(localAffiliate.TypedSpec(), spec) {
+			continue
+		}
+
+		id := rawAffiliate.Metadata().ID()
+
+		_, err = safe.ReaderGetByID[*cluster.IdentityConflict](ctx, r, id)
+		detected := state.IsNotFoundError(err)
+
+		if err = safe.WriterModify(ctx, r, cluster.NewIdentityConflict(cluster.NamespaceName, id), func(res *cluster.IdentityConflict) error {
+			*res.TypedSpec() = cluster.IdentityConflictSpec{
+				NodeID:    spec.NodeID,
+				Hostname:  spec.Hostname,
+				Nodename:  spec.Nodename,
+				Addresses: slices.Clone(spec.Addresses),
+			}
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating identity conflict: %w", err)
+		}
+
+		if !detected {
+			continue
+		}
+
+		logger.Warn("another machine presents the same node identity, the node identity should be reset on one of the machines",
+			zap.String("node_id", spec.NodeID),
+			zap.String("hostname", spec.Hostname),
+			zap.Stringers("addresses", spec.Addresses),
+		)
+
+		if ctrl.V1Alpha1Events != nil {
+			ctrl.V1Alpha1Events.Publish(ctx, &machine.IdentityConflictEvent{
+				NodeId:    spec.NodeID,
+				Hostname:  spec.Hostname,
+				Addresses: xslices.Map(spec.Addresses, netip.Addr.String),
+			})
+		}
+	}
+
+	return nil
+}
+
+// conflicts returns true if the affiliate with the local node ID describes a different machine.
+//
+// The discovery service might echo back the local affiliate, so the affiliate is only considered a conflict
+// if it reports a different hostname or nodename.
+func conflicts(local, other *cluster.AffiliateSpec) bool {
+	if other.Hostname != "" && other.Hostname != local.Hostname {
+		return true
+	}
+
+	return other.Nodename != "" && other.Nodename != local.Nodename
+}
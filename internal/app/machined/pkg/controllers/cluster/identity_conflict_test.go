@@ -0,0 +1,130 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster_test
+
+import (
+	"context"
+	"net/netip"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/proto"
+
+	clusterctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/cluster"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/resources/cluster"
+)
+
+const conflictingNodeID = "7x1SuC8Ege5BGXdAfTEff5iQnlWZLfv9h1LGMxA2pYkC"
+
+type mockIdentityConflictEvents struct {
+	mu     sync.Mutex
+	events []*machine.IdentityConflictEvent
+}
+
+func (s *mockIdentityConflictEvents) Publish(_ context.Context, m proto.Message) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if event, ok := m.(*machine.IdentityConflictEvent); ok {
+		s.events = append(s.events, event)
+	}
+}
+
+func (s *mockIdentityConflictEvents) get() []*machine.IdentityConflictEvent {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]*machine.IdentityConflictEvent(nil), s.events...)
+}
+
+type IdentityConflictSuite struct {
+	ctest.DefaultSuite
+
+	events *mockIdentityConflictEvents
+}
+
+func (suite *IdentityConflictSuite) TestConflict() {
+	identity := cluster.NewIdentity(cluster.NamespaceName, cluster.LocalIdentity)
+	identity.TypedSpec().NodeID = conflictingNodeID
+	suite.Create(identity)
+
+	localAffiliate := cluster.NewAffiliate(cluster.NamespaceName, conflictingNodeID)
+	*localAffiliate.TypedSpec() = cluster.AffiliateSpec{
+		NodeID:    conflictingNodeID,
+		Hostname:  "worker-1",
+		Nodename:  "worker-1",
+		Addresses: []netip.Addr{netip.MustParseAddr("192.168.3.4")},
+	}
+	suite.Create(localAffiliate)
+
+	// echo of the local affiliate from the discovery service
+	echo := cluster.NewAffiliate(cluster.RawNamespaceName, "service/"+conflictingNodeID)
+	*echo.TypedSpec() = *localAffiliate.TypedSpec()
+	suite.Create(echo)
+
+	other := cluster.NewAffiliate(cluster.RawNamespaceName, "service/9dwHNUViZlPlIervqX9Qo256RUhrfhgO0xBBnKcKl4F")
+	*other.TypedSpec() = cluster.AffiliateSpec{
+		NodeID:   "9dwHNUViZlPlIervqX9Qo256RUhrfhgO0xBBnKcKl4F",
+		Hostname: "worker-2",
+		Nodename: "worker-2",
+	}
+	suite.Create(other)
+
+	ctest.AssertNoResource[*cluster.IdentityConflict](suite, echo.Metadata().ID())
+
+	clone := cluster.NewAffiliate(cluster.RawNamespaceName, "k8s/"+conflictingNodeID)
+	*clone.TypedSpec() = cluster.AffiliateSpec{
+		NodeID:    conflictingNodeID,
+		Hostname:  "worker-3",
+		Nodename:  "worker-3",
+		Addresses: []netip.Addr{netip.MustParseAddr("192.168.3.6")},
+	}
+	suite.Create(clone)
+
+	ctest.AssertResource(suite, clone.Metadata().ID(), func(conflict *cluster.IdentityConflict, asrt *assert.Assertions) {
+		asrt.Equal(conflictingNodeID, conflict.TypedSpec().NodeID)
+		asrt.Equal("worker-3", conflict.TypedSpec().Hostname)
+		asrt.Equal([]netip.Addr{netip.MustParseAddr("192.168.3.6")}, conflict.TypedSpec().Addresses)
+	})
+
+	suite.Assert().Eventually(func() bool {
+		return len(suite.events.get()) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	event := suite.events.get()[0]
+	suite.Assert().Equal(conflictingNodeID, event.NodeId)
+	suite.Assert().Equal("worker-3", event.Hostname)
+	suite.Assert().Equal([]string{"192.168.3.6"}, event.Addresses)
+
+	// once the identity of the clone is reset, the conflict goes away
+	suite.Destroy(clone)
+
+	ctest.AssertNoResource[*cluster.IdentityConflict](suite, clone.Metadata().ID())
+	suite.Assert().Len(suite.events.get(), 1)
+}
+
+func TestIdentityConflictSuite(t *testing.T) {
+	t.Parallel()
+
+	s := &IdentityConflictSuite{
+		events: &mockIdentityConflictEvents{},
+	}
+
+	s.DefaultSuite = ctest.DefaultSuite{
+		Timeout: 10 * time.Second,
+		AfterSetup: func(suite *ctest.DefaultSuite) {
+			suite.Require().NoError(suite.Runtime().RegisterController(&clusterctrl.IdentityConflictController{
+				V1Alpha1Events: s.events,
+			}))
+		},
+	}
+
+	suite.Run(t, s)
+}
@@ -97,6 +97,11 @@ func (ctrl *LocalAffiliateController) Inputs() []controller.Input {
 			ID:        optional.Some(k8s.APIServerConfigID),
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.WireguardEndpointStatusType,
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -206,6 +211,11 @@ func (ctrl *LocalAffiliateController) Run(ctx context.Context, r controller.Runt
 			return fmt.Errorf("error getting discovered public IP: %w", err)
 		}
 
+		wireguardEndpoints, err := safe.ReaderListAll[*network.WireguardEndpointStatus](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error listing wireguard endpoint statuses: %w", err)
+		}
+
 		// optional resources (kubernetes)
 		apiServerConfig, err := safe.ReaderGetByID[*k8s.APIServerConfig](ctx, r, k8s.APIServerConfigID)
 		if err != nil && !state.IsNotFoundError(err) {
@@ -295,6 +305,20 @@ func (ctrl *LocalAffiliateController) Run(ctx context.Context, r controller.Runt
 							spec.KubeSpan.Endpoints = append(spec.KubeSpan.Endpoints, addr)
 						}
 					}
+
+					// add the resolved extra announced hostname endpoints
+					for endpoint := range wireguardEndpoints.All() {
+						endpointSpec := endpoint.TypedSpec()
+
+						if endpointSpec.LinkName != constants.KubeSpanLinkName || !endpointSpec.ResolvedEndpoint.IsValid() ||
+							!slices.Contains(kubespanConfig.TypedSpec().ExtraHostnameEndpoints, endpointSpec.Endpoint) {
+							continue
+						}
+
+						if !slices.Contains(spec.KubeSpan.Endpoints, endpointSpec.ResolvedEndpoint) {
+							spec.KubeSpan.Endpoints = append(spec.KubeSpan.Endpoints, endpointSpec.ResolvedEndpoint)
+						}
+					}
 				}
 
 				return nil
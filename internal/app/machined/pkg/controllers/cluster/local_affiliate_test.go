@@ -5,6 +5,7 @@
 package cluster_test
 
 import (
+	"cmp"
 	"net"
 	"net/netip"
 	"testing"
@@ -19,6 +20,7 @@ import (
 	clusterctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/cluster"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
 	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/fipsmode"
 	"github.com/siderolabs/talos/pkg/machinery/resources/cluster"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
@@ -139,6 +141,49 @@ func (suite *LocalAffiliateSuite) TestGeneration() {
 		asrt.Empty(r.TypedSpec().KubeSpan.AdditionalAddresses)
 	})
 
+	// announce the resolved hostname endpoints
+	ksConfig.TypedSpec().ExtraHostnameEndpoints = []string{"hub.example.com:51820"}
+	suite.Require().NoError(suite.state.Update(suite.ctx, ksConfig))
+
+	for _, endpoint := range []network.WireguardEndpointStatusSpec{
+		{
+			LinkName:         constants.KubeSpanLinkName,
+			Endpoint:         "hub.example.com:51820",
+			ResolvedEndpoint: netip.MustParseAddrPort("5.6.7.8:51820"),
+		},
+		{
+			// not configured anymore, ignored
+			LinkName:         constants.KubeSpanLinkName,
+			Endpoint:         "old.example.com:51820",
+			ResolvedEndpoint: netip.MustParseAddrPort("5.6.7.9:51820"),
+		},
+		{
+			// plain Wireguard peer, ignored
+			LinkName:         "wg0",
+			PublicKey:        "kW8VJ7ZvCk6Zz5GmVLcvXjzt0Sa7oEfQDFPYiaPhbx4=",
+			Endpoint:         "hub.example.com:51820",
+			ResolvedEndpoint: netip.MustParseAddrPort("5.6.7.10:51820"),
+		},
+	} {
+		status := network.NewWireguardEndpointStatus(network.NamespaceName, network.WireguardEndpointStatusID(endpoint.LinkName, cmp.Or(endpoint.PublicKey, endpoint.Endpoint)))
+		*status.TypedSpec() = endpoint
+		suite.Require().NoError(suite.state.Create(suite.ctx, status))
+	}
+
+	ctest.AssertResource(suite, nodeIdentity.TypedSpec().NodeID, func(r *cluster.Affiliate, asrt *assert.Assertions) {
+		asrt.Equal(
+			[]string{
+				"172.20.0.2:51820",
+				"10.5.0.1:51820",
+				"1.1.1.1:51820",
+				"[2001:123:4567::1]:51820",
+				"1.2.3.4:5678",
+				"5.6.7.8:51820",
+			},
+			xslices.Map(r.TypedSpec().KubeSpan.Endpoints, netip.AddrPort.String),
+		)
+	})
+
 	// disable discovery, local affiliate should be removed
 	discoveryConfig.TypedSpec().DiscoveryEnabled = false
 	suite.Require().NoError(suite.state.Update(suite.ctx, discoveryConfig))
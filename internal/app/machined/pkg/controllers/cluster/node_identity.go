@@ -6,14 +6,20 @@ package cluster
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io/fs"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
 	"go.uber.org/zap"
 
 	blockadapter "github.com/siderolabs/talos/internal/app/machined/pkg/adapters/block"
 	clusteradapter "github.com/siderolabs/talos/internal/app/machined/pkg/adapters/cluster"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/automaton"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/automaton/blockautomaton"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
@@ -24,8 +30,14 @@ import (
 )
 
 // NodeIdentityController manages runtime.Identity caching identity in the STATE.
+//
+// The identity is regenerated on IdentityReset request.
 type NodeIdentityController struct {
 	stateMachine blockautomaton.VolumeMounterAutomaton
+	established  bool
+
+	// resetRequestedAt is the last handled identity reset request.
+	resetRequestedAt time.Time
 }
 
 // Name implements controller.Controller interface.
@@ -36,6 +48,12 @@ func (ctrl *NodeIdentityController) Name() string {
 // Inputs implements controller.Controller interface.
 func (ctrl *NodeIdentityController) Inputs() []controller.Input {
 	return []controller.Input{
+		{
+			Namespace: cluster.NamespaceName,
+			Type:      cluster.IdentityResetType,
+			ID:        optional.Some(cluster.LocalIdentity),
+			Kind:      controller.InputWeak,
+		},
 		{
 			Namespace: block.NamespaceName,
 			Type:      block.VolumeMountStatusType,
@@ -78,57 +96,101 @@ func (ctrl *NodeIdentityController) Run(ctx context.Context, r controller.Runtim
 		case <-r.EventCh():
 		}
 
-		if ctrl.stateMachine == nil {
+		identityReset, err := safe.ReaderGetByID[*cluster.IdentityReset](ctx, r, cluster.LocalIdentity)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting identity reset request: %w", err)
+		}
+
+		var resetRequestedAt time.Time
+
+		if identityReset != nil && !identityReset.TypedSpec().RequestedAt.Equal(ctrl.resetRequestedAt) {
+			resetRequestedAt = identityReset.TypedSpec().RequestedAt
+		}
+
+		if ctrl.stateMachine == nil && (!ctrl.established || !resetRequestedAt.IsZero()) {
 			ctrl.stateMachine = blockautomaton.NewVolumeMounter(
 				ctrl.Name(),
 				constants.StatePartitionLabel,
-				ctrl.establishNodeIdentity,
+				ctrl.establishNodeIdentity(resetRequestedAt),
 				blockautomaton.WithDetached(true),
 			)
 		}
 
-		if err := ctrl.stateMachine.Run(ctx, r, logger); err != nil {
-			return fmt.Errorf("error running volume mounter machine: %w", err)
+		if ctrl.stateMachine != nil {
+			if err = ctrl.stateMachine.Run(ctx, r, logger,
+				automaton.WithAfterFunc(func() error {
+					ctrl.stateMachine = nil
+					ctrl.established = true
+
+					// the reset might have been requested while the identity was being established
+					r.QueueReconcile()
+
+					return nil
+				}),
+			); err != nil {
+				return fmt.Errorf("error running volume mounter machine: %w", err)
+			}
 		}
 
 		r.ResetRestartBackoff()
 	}
 }
 
-func (ctrl *NodeIdentityController) establishNodeIdentity(ctx context.Context, r controller.ReaderWriter, logger *zap.Logger, mountStatus *block.VolumeMountStatus) error {
-	return blockadapter.VolumeMountStatus(mountStatus).WithRoot(logger, func(root xfs.Root) error {
-		var localIdentity cluster.IdentitySpec
+func (ctrl *NodeIdentityController) establishNodeIdentity(
+	resetRequestedAt time.Time,
+) func(
+	ctx context.Context, r controller.ReaderWriter, logger *zap.Logger, mountStatus *block.VolumeMountStatus,
+) error {
+	return func(ctx context.Context, r controller.ReaderWriter, logger *zap.Logger, mountStatus *block.VolumeMountStatus) error {
+		return blockadapter.VolumeMountStatus(mountStatus).WithRoot(logger, func(root xfs.Root) error {
+			if !resetRequestedAt.IsZero() {
+				// drop the cached identity, so that a new one is generated below
+				if err := xfs.Remove(root, constants.NodeIdentityFilename); err != nil && !errors.Is(err, fs.ErrNotExist) {
+					return fmt.Errorf("error removing cached node identity: %w", err)
+				}
+			}
+
+			var localIdentity cluster.IdentitySpec
+
+			if err := controllers.LoadOrNewFromFile(root, constants.NodeIdentityFilename, &localIdentity, func(v *cluster.IdentitySpec) error {
+				return clusteradapter.IdentitySpec(v).Generate()
+			}); err != nil {
+				return fmt.Errorf("error caching node identity: %w", err)
+			}
 
-		if err := controllers.LoadOrNewFromFile(root, constants.NodeIdentityFilename, &localIdentity, func(v *cluster.IdentitySpec) error {
-			return clusteradapter.IdentitySpec(v).Generate()
-		}); err != nil {
-			return fmt.Errorf("error caching node identity: %w", err)
-		}
+			if err := safe.WriterModify(ctx, r, cluster.NewIdentity(cluster.NamespaceName, cluster.LocalIdentity), func(r *cluster.Identity) error {
+				*r.TypedSpec() = localIdentity
 
-		if err := safe.WriterModify(ctx, r, cluster.NewIdentity(cluster.NamespaceName, cluster.LocalIdentity), func(r *cluster.Identity) error {
-			*r.TypedSpec() = localIdentity
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error modifying resource: %w", err)
+			}
 
-			return nil
-		}); err != nil {
-			return fmt.Errorf("error modifying resource: %w", err)
-		}
+			// generate `/etc/machine-id` from node identity
+			if err := safe.WriterModify(ctx, r, files.NewEtcFileSpec(files.NamespaceName, "machine-id"),
+				func(r *files.EtcFileSpec) error {
+					var err error
 
-		// generate `/etc/machine-id` from node identity
-		if err := safe.WriterModify(ctx, r, files.NewEtcFileSpec(files.NamespaceName, "machine-id"),
-			func(r *files.EtcFileSpec) error {
-				var err error
+					r.TypedSpec().Contents, err = clusteradapter.IdentitySpec(&localIdentity).ConvertMachineID()
+					r.TypedSpec().Mode = 0o444
+					r.TypedSpec().SelinuxLabel = constants.EtcSelinuxLabel
 
-				r.TypedSpec().Contents, err = clusteradapter.IdentitySpec(&localIdentity).ConvertMachineID()
-				r.TypedSpec().Mode = 0o444
-				r.TypedSpec().SelinuxLabel = constants.EtcSelinuxLabel
+					return err
+				}); err != nil {
+				return fmt.Errorf("error modifying machine-id: %w", err)
+			}
 
-				return err
-			}); err != nil {
-			return fmt.Errorf("error modifying machine-id: %w", err)
-		}
+			if !resetRequestedAt.IsZero() {
+				ctrl.resetRequestedAt = resetRequestedAt
+
+				logger.Warn("node identity reset", zap.String("node_id", localIdentity.NodeID))
+
+				return nil
+			}
 
-		logger.Info("node identity established", zap.String("node_id", localIdentity.NodeID))
+			logger.Info("node identity established", zap.String("node_id", localIdentity.NodeID))
 
-		return nil
-	})
+			return nil
+		})
+	}
 }
@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/stretchr/testify/assert"
@@ -84,6 +85,65 @@ func (suite *NodeIdentitySuite) TestLoad() {
 	ctest.AssertNoResource[*block.VolumeMountRequest](suite, mountID)
 }
 
+func (suite *NodeIdentitySuite) TestReset() {
+	statePath := suite.T().TempDir()
+	mountID := (&clusterctrl.NodeIdentityController{}).Name() + "-" + constants.StatePartitionLabel
+
+	suite.Require().NoError(os.WriteFile(filepath.Join(statePath, constants.NodeIdentityFilename), []byte("nodeId: gvqfS27LxD58lPlASmpaueeRVzuof16iXoieRgEvBWaE\n"), 0o600))
+
+	ctest.AssertResource(suite, mountID, func(*block.VolumeMountRequest, *assert.Assertions) {})
+
+	volumeMountStatus := block.NewVolumeMountStatus(block.NamespaceName, mountID)
+	volumeMountStatus.TypedSpec().Target = statePath
+	suite.Create(volumeMountStatus)
+
+	ctest.AssertResource(suite, cluster.LocalIdentity, func(identity *cluster.Identity, asrt *assert.Assertions) {
+		asrt.Equal("gvqfS27LxD58lPlASmpaueeRVzuof16iXoieRgEvBWaE", identity.TypedSpec().NodeID)
+	})
+
+	ctest.AssertResources(suite, []resource.ID{volumeMountStatus.Metadata().ID()}, func(vms *block.VolumeMountStatus, asrt *assert.Assertions) {
+		asrt.True(vms.Metadata().Finalizers().Empty())
+	})
+
+	suite.Destroy(volumeMountStatus)
+
+	ctest.AssertNoResource[*block.VolumeMountRequest](suite, mountID)
+
+	identityReset := cluster.NewIdentityReset(cluster.NamespaceName, cluster.LocalIdentity)
+	identityReset.TypedSpec().RequestedAt = time.Now()
+	suite.Create(identityReset)
+
+	ctest.AssertResource(suite, mountID, func(*block.VolumeMountRequest, *assert.Assertions) {})
+
+	volumeMountStatus = block.NewVolumeMountStatus(block.NamespaceName, mountID)
+	volumeMountStatus.TypedSpec().Target = statePath
+	suite.Create(volumeMountStatus)
+
+	var newNodeID string
+
+	ctest.AssertResource(suite, cluster.LocalIdentity, func(identity *cluster.Identity, asrt *assert.Assertions) {
+		asrt.NotEqual("gvqfS27LxD58lPlASmpaueeRVzuof16iXoieRgEvBWaE", identity.TypedSpec().NodeID)
+
+		newNodeID = identity.TypedSpec().NodeID
+	})
+	ctest.AssertResource(suite, "machine-id", func(f *files.EtcFileSpec, asrt *assert.Assertions) {
+		asrt.NotEqual("8d2c0de2408fa2a178bad7f45d9aa8fb", string(f.TypedSpec().Contents))
+	})
+
+	// the new identity is persisted
+	contents, err := os.ReadFile(filepath.Join(statePath, constants.NodeIdentityFilename))
+	suite.Require().NoError(err)
+	suite.Assert().Equal("nodeId: "+newNodeID+"\n", string(contents))
+
+	ctest.AssertResources(suite, []resource.ID{volumeMountStatus.Metadata().ID()}, func(vms *block.VolumeMountStatus, asrt *assert.Assertions) {
+		asrt.True(vms.Metadata().Finalizers().Empty())
+	})
+
+	suite.Destroy(volumeMountStatus)
+
+	ctest.AssertNoResource[*block.VolumeMountRequest](suite, mountID)
+}
+
 func TestNodeIdentitySuite(t *testing.T) {
 	t.Parallel()
 
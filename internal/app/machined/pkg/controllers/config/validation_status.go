@@ -0,0 +1,109 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/gen/xslices"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// ValidationStatusController reports the validation warnings of the active machine config.
+//
+// Warnings are logged and published as the runtime.ConfigValidationStatus resource.
+type ValidationStatusController struct {
+	ValidationMode validation.RuntimeMode
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *ValidationStatusController) Name() string {
+	return "config.ValidationStatusController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *ValidationStatusController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.ActiveID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *ValidationStatusController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: runtime.ConfigValidationStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *ValidationStatusController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.ActiveID)
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting config: %w", err)
+			}
+
+			if err = r.Destroy(ctx, runtime.NewConfigValidationStatus().Metadata()); err != nil && !state.IsNotFoundError(err) {
+				return fmt.Errorf("error destroying config validation status: %w", err)
+			}
+
+			continue
+		}
+
+		findings := cfg.Config().ValidateFindings(ctrl.ValidationMode)
+
+		// the active config was validated before being applied, so errors are not expected here
+		for _, finding := range findings {
+			switch finding.Severity {
+			case validation.SeverityError:
+				logger.Error("machine config validation error", zap.String("finding", finding.String()))
+			case validation.SeverityWarning:
+				logger.Warn("machine config validation warning", zap.String("finding", finding.String()))
+			}
+		}
+
+		if err = safe.WriterModify(ctx, r, runtime.NewConfigValidationStatus(), func(status *runtime.ConfigValidationStatus) error {
+			status.TypedSpec().ConfigVersion = cfg.Metadata().Version().String()
+			status.TypedSpec().Warnings = xslices.Map(findings.Filter(validation.SeverityWarning), func(finding validation.Finding) runtime.ConfigValidationFinding {
+				return runtime.ConfigValidationFinding{
+					Kind:    finding.Kind,
+					Name:    finding.Name,
+					Path:    finding.Path,
+					Message: finding.Message,
+				}
+			})
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating config validation status: %w", err)
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
@@ -0,0 +1,82 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package config_test
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/crypto/x509"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	configctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/config"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+type ValidationStatusSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *ValidationStatusSuite) TestWarnings() {
+	ctest.AssertNoResource[*runtime.ConfigValidationStatus](suite, runtime.ConfigValidationStatusID)
+
+	v1alpha1Cfg := &v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		ClusterConfig: &v1alpha1.ClusterConfig{
+			ControlPlane: &v1alpha1.ControlPlaneConfig{
+				Endpoint: &v1alpha1.Endpoint{
+					URL: must(url.Parse("https://localhost:6443")),
+				},
+			},
+		},
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineType: "join",
+			MachineCA: &x509.PEMEncodedCertificateAndKey{
+				Crt: []byte("cert"),
+			},
+		},
+	}
+
+	ctr, err := container.New(v1alpha1Cfg)
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(ctr)
+	suite.Create(cfg)
+
+	ctest.AssertResource(suite, runtime.ConfigValidationStatusID, func(status *runtime.ConfigValidationStatus, asrt *assert.Assertions) {
+		asrt.Equal(cfg.Metadata().Version().String(), status.TypedSpec().ConfigVersion)
+		asrt.Equal([]runtime.ConfigValidationFinding{
+			{
+				Kind:    "v1alpha1.Config",
+				Message: `use "worker" instead of "join" for machine type`,
+			},
+		}, status.TypedSpec().Warnings)
+	})
+
+	suite.Destroy(cfg)
+
+	ctest.AssertNoResource[*runtime.ConfigValidationStatus](suite, runtime.ConfigValidationStatusID)
+}
+
+func TestValidationStatusSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, &ValidationStatusSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 5 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&configctrl.ValidationStatusController{
+					ValidationMode: validationModeMock{},
+				}))
+			},
+		},
+	})
+}
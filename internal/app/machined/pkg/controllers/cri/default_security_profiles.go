@@ -0,0 +1,147 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cri
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/cri"
+)
+
+// DefaultSecurityProfilesController reports the effective default security profiles of the CRI containers.
+type DefaultSecurityProfilesController struct {
+	// AppArmorEnabledPath is the path to the kernel parameter reporting whether AppArmor is enabled.
+	AppArmorEnabledPath string
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *DefaultSecurityProfilesController) Name() string {
+	return "cri.DefaultSecurityProfilesController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *DefaultSecurityProfilesController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.ActiveID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *DefaultSecurityProfilesController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: cri.DefaultSecurityProfilesType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *DefaultSecurityProfilesController) Run(ctx context.Context, r controller.Runtime, _ *zap.Logger) error {
+	if ctrl.AppArmorEnabledPath == "" {
+		ctrl.AppArmorEnabledPath = constants.AppArmorEnabledPath
+	}
+
+	// AppArmor can't be enabled at runtime, so the support is checked once
+	appArmorSupported, err := ctrl.appArmorSupported()
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.ActiveID)
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return fmt.Errorf("error getting config: %w", err)
+		}
+
+		if cfg.Config().Machine() == nil {
+			continue
+		}
+
+		seccomp := talosconfig.SecurityProfileUnconfined
+		appArmor := talosconfig.SecurityProfileRuntimeDefault
+
+		var seccompLocalhostProfile string
+
+		if cfg.Config().Machine().Kubelet().DefaultRuntimeSeccompProfileEnabled() {
+			seccomp = talosconfig.SecurityProfileRuntimeDefault
+		}
+
+		if profiles := cfg.Config().DefaultSecurityProfilesConfig(); profiles != nil {
+			if profiles.SeccompProfileType() != "" {
+				seccomp = profiles.SeccompProfileType()
+			}
+
+			if profiles.AppArmorProfileType() != "" {
+				appArmor = profiles.AppArmorProfileType()
+			}
+		}
+
+		if seccomp == talosconfig.SecurityProfileLocalhost {
+			// the path is relative to the kubelet seccomp directory, as in the pod security context
+			seccompLocalhostProfile = filepath.Join(filepath.Base(constants.SeccompProfilesDirectory), constants.SeccompDefaultProfileName)
+		}
+
+		if !appArmorSupported {
+			appArmor = talosconfig.SecurityProfileUnconfined
+		}
+
+		if err = safe.WriterModify(ctx, r, cri.NewDefaultSecurityProfiles(), func(res *cri.DefaultSecurityProfiles) error {
+			res.TypedSpec().Seccomp = string(seccomp)
+			res.TypedSpec().SeccompLocalhostProfile = seccompLocalhostProfile
+			res.TypedSpec().AppArmor = string(appArmor)
+			res.TypedSpec().AppArmorSupported = appArmorSupported
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating default security profiles: %w", err)
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func (ctrl *DefaultSecurityProfilesController) appArmorSupported() (bool, error) {
+	contents, err := os.ReadFile(ctrl.AppArmorEnabledPath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("error reading AppArmor status: %w", err)
+	}
+
+	return bytes.Equal(bytes.TrimSpace(contents), []byte("Y")), nil
+}
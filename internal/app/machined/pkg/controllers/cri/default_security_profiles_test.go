@@ -0,0 +1,100 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cri_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	crictrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/cri"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	runtimecfg "github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/cri"
+)
+
+type DefaultSecurityProfilesSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *DefaultSecurityProfilesSuite) TestKubeletDefault() {
+	cfg := config.NewMachineConfig(container.NewV1Alpha1(&v1alpha1.Config{
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineKubelet: &v1alpha1.KubeletConfig{
+				KubeletDefaultRuntimeSeccompProfileEnabled: pointer.To(true),
+			},
+		},
+	}))
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), cfg))
+
+	ctest.AssertResource(suite, cri.DefaultSecurityProfilesID, func(r *cri.DefaultSecurityProfiles, asrt *assert.Assertions) {
+		asrt.Equal(string(talosconfig.SecurityProfileRuntimeDefault), r.TypedSpec().Seccomp)
+		asrt.Empty(r.TypedSpec().SeccompLocalhostProfile)
+		asrt.Equal(string(talosconfig.SecurityProfileRuntimeDefault), r.TypedSpec().AppArmor)
+		asrt.True(r.TypedSpec().AppArmorSupported)
+	})
+}
+
+func (suite *DefaultSecurityProfilesSuite) TestConfigDocument() {
+	profiles := runtimecfg.NewDefaultSecurityProfilesV1Alpha1()
+	profiles.SeccompConfig = &runtimecfg.SeccompDefaultProfileConfig{
+		SeccompType:    talosconfig.SecurityProfileLocalhost,
+		SeccompProfile: `{"defaultAction":"SCMP_ACT_ERRNO"}`,
+	}
+	profiles.AppArmorConfig = &runtimecfg.AppArmorDefaultProfileConfig{
+		AppArmorType: talosconfig.SecurityProfileUnconfined,
+	}
+
+	ctr, err := container.New(
+		&v1alpha1.Config{
+			MachineConfig: &v1alpha1.MachineConfig{
+				MachineKubelet: &v1alpha1.KubeletConfig{
+					KubeletDefaultRuntimeSeccompProfileEnabled: pointer.To(true),
+				},
+			},
+		},
+		profiles,
+	)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), config.NewMachineConfig(ctr)))
+
+	ctest.AssertResource(suite, cri.DefaultSecurityProfilesID, func(r *cri.DefaultSecurityProfiles, asrt *assert.Assertions) {
+		asrt.Equal(string(talosconfig.SecurityProfileLocalhost), r.TypedSpec().Seccomp)
+		asrt.Equal("profiles/talos-default.json", r.TypedSpec().SeccompLocalhostProfile)
+		asrt.Equal(string(talosconfig.SecurityProfileUnconfined), r.TypedSpec().AppArmor)
+	})
+}
+
+func TestDefaultSecurityProfilesSuite(t *testing.T) {
+	t.Parallel()
+
+	appArmorEnabledPath := filepath.Join(t.TempDir(), "enabled")
+
+	if err := os.WriteFile(appArmorEnabledPath, []byte("Y\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	suite.Run(t, &DefaultSecurityProfilesSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 5 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&crictrl.DefaultSecurityProfilesController{
+					AppArmorEnabledPath: appArmorEnabledPath,
+				}))
+			},
+		},
+	})
+}
@@ -6,6 +6,7 @@ package cri
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/cosi-project/runtime/pkg/controller"
@@ -14,6 +15,8 @@ import (
 	"github.com/siderolabs/gen/optional"
 	"go.uber.org/zap"
 
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/cri"
 )
@@ -81,6 +84,24 @@ func (ctrl *SeccompProfileController) Run(ctx context.Context, r controller.Runt
 			}
 		}
 
+		// the default Localhost profile is written next to the user profiles, so that the pods can reference it explicitly
+		if profiles := cfg.Config().DefaultSecurityProfilesConfig(); profiles != nil && profiles.SeccompProfileType() == talosconfig.SecurityProfileLocalhost {
+			var value map[string]any
+
+			if err = json.Unmarshal([]byte(profiles.SeccompLocalhostProfile()), &value); err != nil {
+				return fmt.Errorf("error unmarshaling default seccomp profile: %w", err)
+			}
+
+			if err = safe.WriterModify(ctx, r, cri.NewSeccompProfile(constants.SeccompDefaultProfileName), func(cri *cri.SeccompProfile) error {
+				cri.TypedSpec().Name = constants.SeccompDefaultProfileName
+				cri.TypedSpec().Value = value
+
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+
 		if err = safe.CleanupOutputs[*cri.SeccompProfile](ctx, r); err != nil {
 			return err
 		}
@@ -0,0 +1,340 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	pkgetcd "github.com/siderolabs/talos/internal/pkg/etcd"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/etcd"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+// Defragmenter performs the maintenance operations of the local etcd member.
+type Defragmenter interface {
+	// Status returns the total and in-use size of the database, and whether the member is a learner.
+	Status(ctx context.Context) (dbSize, dbSizeInUse int64, learner bool, err error)
+	// Defragment defragments the database.
+	Defragment(ctx context.Context) error
+	// Lock executes f exclusively across the etcd members.
+	Lock(ctx context.Context, logger *zap.Logger, f func() error) error
+}
+
+// DefragmentationController defragments the local etcd member on the configured schedule.
+//
+// Defragmentation is serialized across the members with the etcd mutex, and it is skipped if the local member is not healthy,
+// is a learner, or another maintenance operation (snapshot, defragmentation) is in progress.
+type DefragmentationController struct {
+	V1Alpha1Events runtime.Publisher
+	// Defragmenter defaults to the local etcd client.
+	Defragmenter Defragmenter
+
+	interval time.Duration
+	status   etcd.DefragmentationStatusSpec
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *DefragmentationController) Name() string {
+	return "etcd.DefragmentationController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *DefragmentationController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.ActiveID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: v1alpha1.NamespaceName,
+			Type:      v1alpha1.ServiceType,
+			ID:        optional.Some(etcdServiceID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: etcd.NamespaceName,
+			Type:      etcd.MemberType,
+			ID:        optional.Some(etcd.LocalMemberID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *DefragmentationController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: etcd.DefragmentationStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo,cyclop
+func (ctrl *DefragmentationController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.Defragmenter == nil {
+		ctrl.Defragmenter = localDefragmenter{}
+	}
+
+	ctrl.interval = 0
+	ctrl.status = etcd.DefragmentationStatusSpec{}
+
+	timer := time.NewTimer(time.Hour)
+	timer.Stop()
+
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-timer.C:
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.ActiveID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		var (
+			interval  time.Duration
+			threshold int
+		)
+
+		if cfg != nil && cfg.Config().Machine() != nil && cfg.Config().Cluster() != nil && cfg.Config().Machine().Type().IsControlPlane() {
+			interval = cfg.Config().Cluster().Etcd().DefragmentationInterval()
+			threshold = cfg.Config().Cluster().Etcd().DefragmentationThreshold()
+		}
+
+		r.StartTrackingOutputs()
+
+		if interval == 0 {
+			ctrl.interval = 0
+			ctrl.status = etcd.DefragmentationStatusSpec{}
+
+			timer.Stop()
+
+			if err = safe.CleanupOutputs[*etcd.DefragmentationStatus](ctx, r); err != nil {
+				return err
+			}
+
+			r.ResetRestartBackoff()
+
+			continue
+		}
+
+		now := time.Now()
+
+		// (re)schedule the next run when the schedule changes
+		if interval != ctrl.interval {
+			ctrl.interval = interval
+			ctrl.status.NextRun = now.Add(interval)
+		}
+
+		etcdService, err := safe.ReaderGetByID[*v1alpha1.Service](ctx, r, etcdServiceID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting etcd service resource: %w", err)
+		}
+
+		member, err := safe.ReaderGetByID[*etcd.Member](ctx, r, etcd.LocalMemberID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting etcd member resource: %w", err)
+		}
+
+		if member != nil {
+			ctrl.status.MemberID = member.TypedSpec().MemberID
+		}
+
+		if !now.Before(ctrl.status.NextRun) {
+			healthy := etcdService != nil && etcdService.Metadata().Phase() == resource.PhaseRunning && etcdService.TypedSpec().Healthy
+
+			if err = ctrl.defragment(ctx, logger, healthy, threshold); err != nil {
+				return err
+			}
+
+			ctrl.status.NextRun = time.Now().Add(interval)
+		}
+
+		timer.Reset(time.Until(ctrl.status.NextRun))
+
+		if err = safe.WriterModify(ctx, r, etcd.NewDefragmentationStatus(etcd.NamespaceName, etcd.LocalMemberID), func(res *etcd.DefragmentationStatus) error {
+			*res.TypedSpec() = ctrl.status
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating etcd defragmentation status: %w", err)
+		}
+
+		if err = safe.CleanupOutputs[*etcd.DefragmentationStatus](ctx, r); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// defragment runs the scheduled defragmentation, and records the results in the status.
+//
+// Only the context cancellation is returned as an error, defragmentation errors are recorded in the status.
+func (ctrl *DefragmentationController) defragment(ctx context.Context, logger *zap.Logger, healthy bool, threshold int) error {
+	ctrl.status.SkipReason = ""
+
+	if !healthy {
+		ctrl.skip(logger, "etcd member is not healthy")
+
+		return nil
+	}
+
+	err := ctrl.Defragmenter.Lock(ctx, logger, func() error {
+		return pkgetcd.TryMaintenance(func() error {
+			dbSize, dbSizeInUse, learner, err := ctrl.Defragmenter.Status(ctx)
+			if err != nil {
+				return fmt.Errorf("error getting etcd status: %w", err)
+			}
+
+			if learner {
+				ctrl.skip(logger, "etcd member is a learner")
+
+				return nil
+			}
+
+			var fragmented int64
+
+			if dbSize > 0 {
+				fragmented = (dbSize - dbSizeInUse) * 100 / dbSize
+			}
+
+			if fragmented < int64(threshold) {
+				ctrl.skip(logger, fmt.Sprintf("unused database space %d%% is below the threshold %d%%", fragmented, threshold))
+
+				return nil
+			}
+
+			logger.Info("defragmenting etcd member", zap.Int64("db_size", dbSize), zap.Int64("db_size_in_use", dbSizeInUse))
+
+			start := time.Now()
+
+			ctrl.status.LastRun = start
+			ctrl.status.DBSizeBefore = dbSize
+			ctrl.status.DBSizeAfter = 0
+
+			if err = ctrl.Defragmenter.Defragment(ctx); err != nil {
+				return fmt.Errorf("error defragmenting etcd: %w", err)
+			}
+
+			ctrl.status.Duration = time.Since(start)
+
+			ctrl.status.DBSizeAfter, _, _, err = ctrl.Defragmenter.Status(ctx)
+			if err != nil {
+				return fmt.Errorf("error getting etcd status: %w", err)
+			}
+
+			ctrl.status.LastError = ""
+
+			logger.Info("etcd member defragmented",
+				zap.Duration("duration", ctrl.status.Duration),
+				zap.Int64("db_size_before", ctrl.status.DBSizeBefore),
+				zap.Int64("db_size_after", ctrl.status.DBSizeAfter),
+			)
+
+			ctrl.publish(ctx)
+
+			return nil
+		})
+	})
+
+	switch {
+	case err == nil:
+	case errors.Is(err, pkgetcd.ErrMaintenanceInProgress):
+		ctrl.skip(logger, "another maintenance operation is in progress")
+	case ctx.Err() != nil:
+		return ctx.Err()
+	default:
+		logger.Error("etcd defragmentation failed", zap.Error(err))
+
+		ctrl.status.LastError = err.Error()
+
+		ctrl.publish(ctx)
+	}
+
+	return nil
+}
+
+func (ctrl *DefragmentationController) skip(logger *zap.Logger, reason string) {
+	logger.Info("skipping scheduled etcd defragmentation", zap.String("reason", reason))
+
+	ctrl.status.SkipReason = reason
+}
+
+func (ctrl *DefragmentationController) publish(ctx context.Context) {
+	if ctrl.V1Alpha1Events == nil {
+		return
+	}
+
+	ctrl.V1Alpha1Events.Publish(ctx, &machine.EtcdDefragmentationEvent{
+		MemberId:     ctrl.status.MemberID,
+		Duration:     durationpb.New(ctrl.status.Duration),
+		DbSizeBefore: ctrl.status.DBSizeBefore,
+		DbSizeAfter:  ctrl.status.DBSizeAfter,
+		Error:        ctrl.status.LastError,
+	})
+}
+
+// localDefragmenter performs the maintenance operations via the local etcd client.
+type localDefragmenter struct{}
+
+func (localDefragmenter) Status(ctx context.Context) (int64, int64, bool, error) {
+	client, err := pkgetcd.NewLocalClient(ctx)
+	if err != nil {
+		return 0, 0, false, err
+	}
+
+	defer client.Close() //nolint:errcheck
+
+	resp, err := client.Status(ctx, nethelpers.JoinHostPort("localhost", constants.EtcdClientPort))
+	if err != nil {
+		return 0, 0, false, err
+	}
+
+	return resp.DbSize, resp.DbSizeInUse, resp.IsLearner, nil
+}
+
+func (localDefragmenter) Defragment(ctx context.Context) error {
+	client, err := pkgetcd.NewLocalClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer client.Close() //nolint:errcheck
+
+	_, err = client.Defragment(ctx, nethelpers.JoinHostPort("localhost", constants.EtcdClientPort))
+
+	return err
+}
+
+func (localDefragmenter) Lock(ctx context.Context, logger *zap.Logger, f func() error) error {
+	return pkgetcd.WithLock(ctx, constants.EtcdTalosDefragmentationMutex, logger, f)
+}
@@ -0,0 +1,182 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd_test
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/crypto/x509"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	etcdctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/etcd"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/etcd"
+	v1alpha1res "github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+type mockDefragmenter struct {
+	mu sync.Mutex
+
+	dbSize, dbSizeInUse int64
+	defragmentErr       error
+	defragmentations    int
+}
+
+func (m *mockDefragmenter) Status(context.Context) (int64, int64, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.dbSize, m.dbSizeInUse, false, nil
+}
+
+func (m *mockDefragmenter) Defragment(context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.defragmentErr != nil {
+		return m.defragmentErr
+	}
+
+	m.defragmentations++
+	m.dbSize = m.dbSizeInUse
+
+	return nil
+}
+
+func (m *mockDefragmenter) Lock(_ context.Context, _ *zap.Logger, f func() error) error {
+	return f()
+}
+
+type DefragmentationSuite struct {
+	ctest.DefaultSuite
+
+	defragmenter *mockDefragmenter
+}
+
+func (suite *DefragmentationSuite) createConfig(schedule string, threshold int) {
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(container.NewV1Alpha1(&v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineType: "controlplane",
+		},
+		ClusterConfig: &v1alpha1.ClusterConfig{
+			ControlPlane: &v1alpha1.ControlPlaneConfig{
+				Endpoint: &v1alpha1.Endpoint{
+					URL: u,
+				},
+			},
+			EtcdConfig: &v1alpha1.EtcdConfig{
+				RootCA: &x509.PEMEncodedCertificateAndKey{},
+				EtcdDefragmentation: &v1alpha1.EtcdDefragmentationConfig{
+					DefragmentationSchedule:  schedule,
+					DefragmentationThreshold: threshold,
+				},
+			},
+		},
+	}))
+
+	suite.Create(cfg)
+}
+
+func (suite *DefragmentationSuite) createEtcdService(healthy bool) *v1alpha1res.Service {
+	etcdService := v1alpha1res.NewService("etcd")
+	etcdService.TypedSpec().Running = true
+	etcdService.TypedSpec().Healthy = healthy
+
+	suite.Create(etcdService)
+
+	return etcdService
+}
+
+func (suite *DefragmentationSuite) TestDefragment() {
+	suite.defragmenter.mu.Lock()
+	suite.defragmenter.dbSize = 1000
+	suite.defragmenter.dbSizeInUse = 400
+	suite.defragmenter.mu.Unlock()
+
+	member := etcd.NewMember(etcd.NamespaceName, etcd.LocalMemberID)
+	member.TypedSpec().MemberID = "000000000000007b"
+	suite.Create(member)
+
+	suite.createEtcdService(true)
+	suite.createConfig("@every 1s", 50)
+
+	ctest.AssertResource(suite, etcd.LocalMemberID, func(status *etcd.DefragmentationStatus, asrt *assert.Assertions) {
+		spec := status.TypedSpec()
+
+		asrt.Equal("000000000000007b", spec.MemberID)
+		asrt.False(spec.LastRun.IsZero())
+		asrt.EqualValues(1000, spec.DBSizeBefore)
+		asrt.EqualValues(400, spec.DBSizeAfter)
+		asrt.Empty(spec.LastError)
+		asrt.True(spec.NextRun.After(spec.LastRun))
+	})
+
+	// the database is not fragmented anymore, next run is skipped
+	ctest.AssertResource(suite, etcd.LocalMemberID, func(status *etcd.DefragmentationStatus, asrt *assert.Assertions) {
+		asrt.Equal("unused database space 0% is below the threshold 50%", status.TypedSpec().SkipReason)
+	})
+
+	suite.defragmenter.mu.Lock()
+	suite.Assert().Equal(1, suite.defragmenter.defragmentations)
+	suite.defragmenter.mu.Unlock()
+}
+
+func (suite *DefragmentationSuite) TestUnhealthy() {
+	suite.createEtcdService(false)
+	suite.createConfig("@every 1s", 0)
+
+	ctest.AssertResource(suite, etcd.LocalMemberID, func(status *etcd.DefragmentationStatus, asrt *assert.Assertions) {
+		asrt.Equal("etcd member is not healthy", status.TypedSpec().SkipReason)
+		asrt.True(status.TypedSpec().LastRun.IsZero())
+	})
+}
+
+func (suite *DefragmentationSuite) TestError() {
+	suite.defragmenter.mu.Lock()
+	suite.defragmenter.dbSize = 1000
+	suite.defragmenter.defragmentErr = errors.New("defragment failed")
+	suite.defragmenter.mu.Unlock()
+
+	suite.createEtcdService(true)
+	suite.createConfig("@every 1s", 0)
+
+	ctest.AssertResource(suite, etcd.LocalMemberID, func(status *etcd.DefragmentationStatus, asrt *assert.Assertions) {
+		asrt.Equal("error defragmenting etcd: defragment failed", status.TypedSpec().LastError)
+		asrt.EqualValues(1000, status.TypedSpec().DBSizeBefore)
+	})
+}
+
+func TestDefragmentationSuite(t *testing.T) {
+	t.Parallel()
+
+	s := &DefragmentationSuite{}
+
+	s.DefaultSuite = ctest.DefaultSuite{
+		Timeout: 10 * time.Second,
+		AfterSetup: func(suite *ctest.DefaultSuite) {
+			s.defragmenter = &mockDefragmenter{}
+
+			suite.Require().NoError(suite.Runtime().RegisterController(&etcdctrl.DefragmentationController{
+				Defragmenter: s.defragmenter,
+			}))
+		},
+	}
+
+	suite.Run(t, s)
+}
@@ -7,6 +7,7 @@ package etcd
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/resource"
@@ -14,15 +15,33 @@ import (
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/siderolabs/gen/optional"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/durationpb"
 
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
 	pkgetcd "github.com/siderolabs/talos/internal/pkg/etcd"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/etcd"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
 	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
 )
 
+// learnerRefreshInterval is the interval the learner status is refreshed while the local member is a learner.
+const learnerRefreshInterval = 15 * time.Second
+
 // MemberController updates information about the local etcd member.
+//
+// While the local member is a learner, the controller tracks the learner lag and whether the learner
+// is eligible for the promotion, and reports learners which are not promoted for a long time.
 type MemberController struct {
+	V1Alpha1Events runtime.Publisher
+
 	GetLocalMemberIDFunc func(ctx context.Context) (uint64, error)
+	GetLearnerStatusFunc func(ctx context.Context, endpoints []string) (pkgetcd.LearnerStatus, error)
+
+	learnerSince  time.Time
+	stuckReported bool
 }
 
 // Name implements controller.Controller interface.
@@ -41,6 +60,17 @@ func (ctrl *MemberController) Inputs() []controller.Input {
 			ID:        optional.Some(etcdServiceID),
 			Kind:      controller.InputStrong,
 		},
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.ActiveID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: k8s.ControlPlaneNamespaceName,
+			Type:      k8s.EndpointType,
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -56,13 +86,22 @@ func (ctrl *MemberController) Outputs() []controller.Output {
 
 // Run implements controller.Controller interface.
 //
-//nolint:gocyclo
-func (ctrl *MemberController) Run(ctx context.Context, r controller.Runtime, _ *zap.Logger) error {
+//nolint:gocyclo,cyclop
+func (ctrl *MemberController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	ctrl.learnerSince = time.Time{}
+	ctrl.stuckReported = false
+
+	timer := time.NewTimer(learnerRefreshInterval)
+	timer.Stop()
+
+	defer timer.Stop()
+
 	for {
 		select {
 		case <-ctx.Done():
 			return nil
 		case <-r.EventCh():
+		case <-timer.C:
 		}
 
 		m := etcd.NewMember(etcd.NamespaceName, etcd.LocalMemberID)
@@ -72,9 +111,26 @@ func (ctrl *MemberController) Run(ctx context.Context, r controller.Runtime, _ *
 			return fmt.Errorf("error getting etcd service resource: %w", err)
 		}
 
+		running := etcdService != nil && etcdService.Metadata().Phase() == resource.PhaseRunning && etcdService.TypedSpec().Running
 		updateMemberID := etcdService != nil && etcdService.Metadata().Phase() == resource.PhaseRunning && etcdService.TypedSpec().Healthy
 
-		if updateMemberID {
+		// learners are never reported as healthy, as they don't serve linearizable reads
+		var learner pkgetcd.LearnerStatus
+
+		if running && !updateMemberID {
+			learner, err = ctrl.getLearnerStatus(ctx, r)
+			if err != nil && !learner.IsLearner {
+				logger.Debug("error getting etcd learner status", zap.Error(err))
+			}
+		}
+
+		switch {
+		case updateMemberID:
+			ctrl.learnerSince = time.Time{}
+			ctrl.stuckReported = false
+
+			timer.Stop()
+
 			var memberID uint64
 
 			memberID, err = ctrl.getLocalMemberID(ctx)
@@ -83,13 +139,26 @@ func (ctrl *MemberController) Run(ctx context.Context, r controller.Runtime, _ *
 			}
 
 			if err = safe.WriterModify(ctx, r, m, func(status *etcd.Member) error {
-				status.TypedSpec().MemberID = etcd.FormatMemberID(memberID)
+				*status.TypedSpec() = etcd.MemberSpec{
+					MemberID: etcd.FormatMemberID(memberID),
+				}
 
 				return nil
 			}); err != nil {
 				return fmt.Errorf("error updating etcd member resource: %w", err)
 			}
-		} else {
+		case learner.IsLearner:
+			if err = ctrl.updateLearner(ctx, r, logger, m, learner, err); err != nil {
+				return err
+			}
+
+			timer.Reset(learnerRefreshInterval)
+		default:
+			ctrl.learnerSince = time.Time{}
+			ctrl.stuckReported = false
+
+			timer.Stop()
+
 			if err = r.Destroy(ctx, m.Metadata()); err != nil && !state.IsNotFoundError(err) {
 				return fmt.Errorf("error destroying etcd member resource: %w", err)
 			}
@@ -99,6 +168,73 @@ func (ctrl *MemberController) Run(ctx context.Context, r controller.Runtime, _ *
 	}
 }
 
+// updateLearner updates the member resource of the local learner, and reports the learner if it is not promoted for a long time.
+//
+// If the learner lag is not known (lagErr is not nil), the learner is not eligible for the promotion.
+func (ctrl *MemberController) updateLearner(
+	ctx context.Context, r controller.Runtime, logger *zap.Logger, m *etcd.Member, learner pkgetcd.LearnerStatus, lagErr error,
+) error {
+	maxLagEntries, stuckTimeout := uint64(constants.EtcdLearnerMaxLagEntries), constants.EtcdLearnerStuckTimeout
+
+	cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.ActiveID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return fmt.Errorf("error getting machine config: %w", err)
+	}
+
+	if cfg != nil && cfg.Config().Cluster() != nil {
+		maxLagEntries = cfg.Config().Cluster().Etcd().LearnerMaxLagEntries()
+		stuckTimeout = cfg.Config().Cluster().Etcd().LearnerStuckTimeout()
+	}
+
+	if ctrl.learnerSince.IsZero() {
+		ctrl.learnerSince = time.Now()
+	}
+
+	if lagErr != nil {
+		logger.Warn("error getting etcd learner lag", zap.Error(lagErr))
+	}
+
+	spec := etcd.MemberSpec{
+		MemberID:          etcd.FormatMemberID(learner.MemberID),
+		IsLearner:         true,
+		LearnerLag:        learner.Lag,
+		PromotionEligible: lagErr == nil && learner.Lag <= maxLagEntries,
+		LearnerSince:      ctrl.learnerSince,
+	}
+
+	if err = safe.WriterModify(ctx, r, m, func(status *etcd.Member) error {
+		*status.TypedSpec() = spec
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("error updating etcd member resource: %w", err)
+	}
+
+	learnerFor := time.Since(ctrl.learnerSince)
+
+	if learnerFor < stuckTimeout || ctrl.stuckReported {
+		return nil
+	}
+
+	ctrl.stuckReported = true
+
+	logger.Warn("etcd learner is not promoted for a long time",
+		zap.String("member_id", spec.MemberID),
+		zap.Uint64("lag", spec.LearnerLag),
+		zap.Duration("learner_for", learnerFor),
+	)
+
+	if ctrl.V1Alpha1Events != nil {
+		ctrl.V1Alpha1Events.Publish(ctx, &machine.EtcdLearnerStuckEvent{
+			MemberId:   spec.MemberID,
+			Lag:        spec.LearnerLag,
+			LearnerFor: durationpb.New(learnerFor),
+		})
+	}
+
+	return nil
+}
+
 // getLocalMemberID gets the etcd member ID of the local node.
 func (ctrl *MemberController) getLocalMemberID(ctx context.Context) (uint64, error) {
 	if ctrl.GetLocalMemberIDFunc != nil {
@@ -114,3 +250,22 @@ func (ctrl *MemberController) getLocalMemberID(ctx context.Context) (uint64, err
 
 	return client.GetMemberID(ctx)
 }
+
+// getLearnerStatus gets the learner status of the local etcd member.
+func (ctrl *MemberController) getLearnerStatus(ctx context.Context, r controller.Reader) (pkgetcd.LearnerStatus, error) {
+	endpointResources, err := safe.ReaderListAll[*k8s.Endpoint](ctx, r)
+	if err != nil {
+		return pkgetcd.LearnerStatus{}, fmt.Errorf("error getting endpoints resources: %w", err)
+	}
+
+	endpoints, err := pkgetcd.EndpointsFromList(endpointResources)
+	if err != nil {
+		return pkgetcd.LearnerStatus{}, err
+	}
+
+	if ctrl.GetLearnerStatusFunc != nil {
+		return ctrl.GetLearnerStatusFunc(ctx, endpoints)
+	}
+
+	return pkgetcd.GetLearnerStatus(ctx, endpoints)
+}
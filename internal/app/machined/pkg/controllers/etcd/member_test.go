@@ -6,16 +6,22 @@ package etcd_test
 
 import (
 	"context"
+	"net/netip"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/siderolabs/go-retry/retry"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+	clientv3 "go.etcd.io/etcd/client/v3"
 
 	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
 	etcdctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/etcd"
+	pkgetcd "github.com/siderolabs/talos/internal/pkg/etcd"
 	"github.com/siderolabs/talos/pkg/machinery/resources/etcd"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
 	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
 )
 
@@ -147,3 +153,69 @@ func (suite *MemberSuite) TestCleanup() {
 		suite.assertInexistentEtcdMember(expectedMember),
 	))
 }
+
+func (suite *MemberSuite) TestEtcdLearner() {
+	// 3-node cluster with the local member being a lagging learner
+	var learnerRaftIndex atomic.Uint64
+
+	learnerRaftIndex.Store(1000)
+
+	suite.ctrl.GetLocalMemberIDFunc = func(ctx context.Context) (uint64, error) {
+		return 123, nil
+	}
+	suite.ctrl.GetLearnerStatusFunc = func(ctx context.Context, endpoints []string) (pkgetcd.LearnerStatus, error) {
+		suite.Assert().Equal([]string{"172.20.0.2:2379", "172.20.0.3:2379", "172.20.0.4:2379"}, endpoints)
+
+		learner := &clientv3.StatusResponse{IsLearner: true, RaftIndex: learnerRaftIndex.Load()}
+
+		lag, err := pkgetcd.LearnerLag(learner, []*clientv3.StatusResponse{
+			{RaftIndex: 6000},
+			{RaftIndex: 5990},
+			learner,
+		})
+
+		return pkgetcd.LearnerStatus{MemberID: 123, IsLearner: true, Lag: lag}, err
+	}
+
+	endpoint := k8s.NewEndpoint(k8s.ControlPlaneNamespaceName, k8s.ControlPlaneKubernetesEndpointsID)
+	endpoint.TypedSpec().Addresses = []netip.Addr{
+		netip.MustParseAddr("172.20.0.2"),
+		netip.MustParseAddr("172.20.0.3"),
+		netip.MustParseAddr("172.20.0.4"),
+	}
+	suite.Create(endpoint)
+
+	// learners are never healthy
+	etcdService := v1alpha1.NewService("etcd")
+	etcdService.TypedSpec().Running = true
+	etcdService.TypedSpec().Healthy = false
+	suite.Create(etcdService)
+
+	ctest.AssertResource(suite, etcd.LocalMemberID, func(member *etcd.Member, asrt *assert.Assertions) {
+		spec := member.TypedSpec()
+
+		asrt.Equal("000000000000007b", spec.MemberID)
+		asrt.True(spec.IsLearner)
+		asrt.EqualValues(5000, spec.LearnerLag)
+		asrt.False(spec.PromotionEligible)
+		asrt.False(spec.LearnerSince.IsZero())
+	})
+
+	// learner catches up within the default max lag
+	learnerRaftIndex.Store(5500)
+	suite.Update(etcdService)
+
+	ctest.AssertResource(suite, etcd.LocalMemberID, func(member *etcd.Member, asrt *assert.Assertions) {
+		asrt.True(member.TypedSpec().IsLearner)
+		asrt.EqualValues(500, member.TypedSpec().LearnerLag)
+		asrt.True(member.TypedSpec().PromotionEligible)
+	})
+
+	// learner is promoted
+	etcdService.TypedSpec().Healthy = true
+	suite.Update(etcdService)
+
+	ctest.AssertResource(suite, etcd.LocalMemberID, func(member *etcd.Member, asrt *assert.Assertions) {
+		asrt.Equal(etcd.MemberSpec{MemberID: "000000000000007b"}, *member.TypedSpec())
+	})
+}
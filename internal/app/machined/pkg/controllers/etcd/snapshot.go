@@ -0,0 +1,429 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	pkgetcd "github.com/siderolabs/talos/internal/pkg/etcd"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/etcd"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+const (
+	snapshotPrefix     = "snapshot-"
+	snapshotSuffix     = ".db"
+	snapshotTimeFormat = "20060102T150405Z"
+)
+
+// Snapshotter takes and uploads the snapshots of the local etcd member.
+type Snapshotter interface {
+	// Snapshot writes the snapshot of the local etcd member to w.
+	Snapshot(ctx context.Context, w io.Writer) error
+	// Upload uploads the snapshot to the object storage, and returns the location of the uploaded object.
+	Upload(ctx context.Context, dest talosconfig.EtcdSnapshotUpload, key string, snapshot io.ReadSeeker, size int64, sha256sum string) (string, error)
+}
+
+// SnapshotController takes the snapshots of the local etcd member on the configured schedule.
+//
+// Snapshots are kept on the EPHEMERAL partition according to the retention, and optionally uploaded to the object storage.
+// The snapshot is skipped if the local member is not healthy or another maintenance operation (snapshot, defragmentation) is in progress.
+// Failures are reported in the status and as events, and never affect the etcd service.
+type SnapshotController struct {
+	V1Alpha1Events runtime.Publisher
+	// Snapshotter defaults to the local etcd client.
+	Snapshotter Snapshotter
+	// SnapshotsPath defaults to constants.EtcdSnapshotsPath.
+	SnapshotsPath string
+
+	interval time.Duration
+	status   etcd.SnapshotStatusSpec
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *SnapshotController) Name() string {
+	return "etcd.SnapshotController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *SnapshotController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.ActiveID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: v1alpha1.NamespaceName,
+			Type:      v1alpha1.ServiceType,
+			ID:        optional.Some(etcdServiceID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: etcd.NamespaceName,
+			Type:      etcd.MemberType,
+			ID:        optional.Some(etcd.LocalMemberID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *SnapshotController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: etcd.SnapshotStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo,cyclop
+func (ctrl *SnapshotController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.Snapshotter == nil {
+		ctrl.Snapshotter = localSnapshotter{}
+	}
+
+	if ctrl.SnapshotsPath == "" {
+		ctrl.SnapshotsPath = constants.EtcdSnapshotsPath
+	}
+
+	ctrl.interval = 0
+	ctrl.status = etcd.SnapshotStatusSpec{}
+
+	timer := time.NewTimer(time.Hour)
+	timer.Stop()
+
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-timer.C:
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.ActiveID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		var (
+			interval  time.Duration
+			retention int
+			upload    talosconfig.EtcdSnapshotUpload
+		)
+
+		if cfg != nil && cfg.Config().Machine() != nil && cfg.Config().Cluster() != nil && cfg.Config().Machine().Type().IsControlPlane() {
+			interval = cfg.Config().Cluster().Etcd().SnapshotInterval()
+			retention = cfg.Config().Cluster().Etcd().SnapshotRetention()
+			upload = cfg.Config().Cluster().Etcd().SnapshotUpload()
+		}
+
+		r.StartTrackingOutputs()
+
+		if interval == 0 {
+			ctrl.interval = 0
+			ctrl.status = etcd.SnapshotStatusSpec{}
+
+			timer.Stop()
+
+			if err = safe.CleanupOutputs[*etcd.SnapshotStatus](ctx, r); err != nil {
+				return err
+			}
+
+			r.ResetRestartBackoff()
+
+			continue
+		}
+
+		now := time.Now()
+
+		// (re)schedule the next run when the schedule changes
+		if interval != ctrl.interval {
+			ctrl.interval = interval
+			ctrl.status.NextRun = now.Add(interval)
+		}
+
+		etcdService, err := safe.ReaderGetByID[*v1alpha1.Service](ctx, r, etcdServiceID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting etcd service resource: %w", err)
+		}
+
+		member, err := safe.ReaderGetByID[*etcd.Member](ctx, r, etcd.LocalMemberID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting etcd member resource: %w", err)
+		}
+
+		if member != nil {
+			ctrl.status.MemberID = member.TypedSpec().MemberID
+		}
+
+		if !now.Before(ctrl.status.NextRun) {
+			healthy := etcdService != nil && etcdService.Metadata().Phase() == resource.PhaseRunning && etcdService.TypedSpec().Healthy
+
+			if err = ctrl.snapshot(ctx, logger, healthy, retention, upload); err != nil {
+				return err
+			}
+
+			ctrl.status.NextRun = time.Now().Add(interval)
+		}
+
+		timer.Reset(time.Until(ctrl.status.NextRun))
+
+		if err = safe.WriterModify(ctx, r, etcd.NewSnapshotStatus(etcd.NamespaceName, etcd.LocalMemberID), func(res *etcd.SnapshotStatus) error {
+			*res.TypedSpec() = ctrl.status
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating etcd snapshot status: %w", err)
+		}
+
+		if err = safe.CleanupOutputs[*etcd.SnapshotStatus](ctx, r); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// snapshot runs the scheduled snapshot, and records the results in the status.
+//
+// Only the context cancellation is returned as an error, snapshot and upload errors are recorded in the status.
+func (ctrl *SnapshotController) snapshot(ctx context.Context, logger *zap.Logger, healthy bool, retention int, upload talosconfig.EtcdSnapshotUpload) error {
+	ctrl.status.SkipReason = ""
+
+	if !healthy {
+		ctrl.skip(logger, "etcd member is not healthy")
+
+		return nil
+	}
+
+	err := pkgetcd.TryMaintenance(func() error {
+		return ctrl.save(ctx)
+	})
+
+	switch {
+	case err == nil:
+	case errors.Is(err, pkgetcd.ErrMaintenanceInProgress):
+		ctrl.skip(logger, "another maintenance operation is in progress")
+
+		return nil
+	case ctx.Err() != nil:
+		return ctx.Err()
+	default:
+		logger.Error("etcd snapshot failed", zap.Error(err))
+
+		ctrl.status.LastError = err.Error()
+
+		ctrl.publish(ctx)
+
+		return nil
+	}
+
+	logger.Info("etcd snapshot saved",
+		zap.String("path", ctrl.status.Path),
+		zap.Int64("size", ctrl.status.Size),
+		zap.Duration("duration", ctrl.status.Duration),
+	)
+
+	if err = ctrl.cleanup(retention); err != nil {
+		logger.Warn("error removing old etcd snapshots", zap.Error(err))
+	}
+
+	if upload != nil {
+		if err = ctrl.upload(ctx, upload); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			logger.Error("etcd snapshot upload failed", zap.Error(err))
+
+			ctrl.status.UploadError = err.Error()
+		} else {
+			logger.Info("etcd snapshot uploaded", zap.String("location", ctrl.status.UploadLocation))
+		}
+	}
+
+	ctrl.publish(ctx)
+
+	return nil
+}
+
+// save writes the snapshot of the local etcd member to the snapshots directory.
+func (ctrl *SnapshotController) save(ctx context.Context) error {
+	start := time.Now()
+
+	ctrl.status.LastRun = start
+	ctrl.status.Duration = 0
+	ctrl.status.Path = ""
+	ctrl.status.Size = 0
+	ctrl.status.SHA256 = ""
+	ctrl.status.LastError = ""
+	ctrl.status.UploadLocation = ""
+	ctrl.status.UploadError = ""
+
+	if err := os.MkdirAll(ctrl.SnapshotsPath, 0o700); err != nil {
+		return fmt.Errorf("error creating etcd snapshots directory: %w", err)
+	}
+
+	snapshotPath := filepath.Join(ctrl.SnapshotsPath, snapshotPrefix+start.UTC().Format(snapshotTimeFormat)+snapshotSuffix)
+	partPath := snapshotPath + ".part"
+
+	f, err := os.OpenFile(partPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return fmt.Errorf("error creating etcd snapshot file: %w", err)
+	}
+
+	defer os.Remove(partPath) //nolint:errcheck
+
+	defer f.Close() //nolint:errcheck
+
+	hash := sha256.New()
+
+	if err = ctrl.Snapshotter.Snapshot(ctx, io.MultiWriter(f, hash)); err != nil {
+		return fmt.Errorf("error taking etcd snapshot: %w", err)
+	}
+
+	if err = f.Sync(); err != nil {
+		return fmt.Errorf("error writing etcd snapshot: %w", err)
+	}
+
+	st, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	if err = f.Close(); err != nil {
+		return fmt.Errorf("error writing etcd snapshot: %w", err)
+	}
+
+	if err = os.Rename(partPath, snapshotPath); err != nil {
+		return fmt.Errorf("error renaming etcd snapshot: %w", err)
+	}
+
+	ctrl.status.Duration = time.Since(start)
+	ctrl.status.Path = snapshotPath
+	ctrl.status.Size = st.Size()
+	ctrl.status.SHA256 = hex.EncodeToString(hash.Sum(nil))
+
+	return nil
+}
+
+// cleanup removes the snapshots exceeding the retention, oldest first.
+func (ctrl *SnapshotController) cleanup(retention int) error {
+	snapshots, err := filepath.Glob(filepath.Join(ctrl.SnapshotsPath, snapshotPrefix+"*"+snapshotSuffix))
+	if err != nil {
+		return err
+	}
+
+	// snapshot names sort in the chronological order
+	slices.Sort(snapshots)
+
+	var errs error
+
+	for len(snapshots) > retention {
+		if err = os.Remove(snapshots[0]); err != nil {
+			errs = errors.Join(errs, err)
+		}
+
+		snapshots = snapshots[1:]
+	}
+
+	return errs
+}
+
+// upload uploads the last snapshot to the object storage.
+func (ctrl *SnapshotController) upload(ctx context.Context, dest talosconfig.EtcdSnapshotUpload) error {
+	f, err := os.Open(ctrl.status.Path)
+	if err != nil {
+		return err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	key := path.Join(dest.Prefix(), ctrl.status.MemberID, filepath.Base(ctrl.status.Path))
+
+	ctrl.status.UploadLocation, err = ctrl.Snapshotter.Upload(ctx, dest, key, f, ctrl.status.Size, ctrl.status.SHA256)
+
+	return err
+}
+
+func (ctrl *SnapshotController) skip(logger *zap.Logger, reason string) {
+	logger.Info("skipping scheduled etcd snapshot", zap.String("reason", reason))
+
+	ctrl.status.SkipReason = reason
+}
+
+func (ctrl *SnapshotController) publish(ctx context.Context) {
+	if ctrl.V1Alpha1Events == nil {
+		return
+	}
+
+	ctrl.V1Alpha1Events.Publish(ctx, &machine.EtcdSnapshotEvent{
+		MemberId:       ctrl.status.MemberID,
+		Path:           ctrl.status.Path,
+		Size:           ctrl.status.Size,
+		Sha256:         ctrl.status.SHA256,
+		Duration:       durationpb.New(ctrl.status.Duration),
+		UploadLocation: ctrl.status.UploadLocation,
+		Error:          ctrl.status.LastError,
+		UploadError:    ctrl.status.UploadError,
+	})
+}
+
+// localSnapshotter takes the snapshots via the local etcd client.
+type localSnapshotter struct{}
+
+func (localSnapshotter) Snapshot(ctx context.Context, w io.Writer) error {
+	client, err := pkgetcd.NewLocalClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer client.Close() //nolint:errcheck
+
+	rd, err := client.Snapshot(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer rd.Close() //nolint:errcheck
+
+	_, err = io.Copy(w, rd)
+
+	return err
+}
+
+func (localSnapshotter) Upload(ctx context.Context, dest talosconfig.EtcdSnapshotUpload, key string, snapshot io.ReadSeeker, size int64, sha256sum string) (string, error) {
+	return pkgetcd.UploadSnapshot(ctx, dest, key, snapshot, size, sha256sum)
+}
@@ -0,0 +1,241 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/crypto/x509"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	etcdctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/etcd"
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/etcd"
+	v1alpha1res "github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+const snapshotData = "etcd snapshot"
+
+type mockSnapshotter struct {
+	mu sync.Mutex
+
+	snapshotErr error
+	uploadErr   error
+	uploads     []string
+}
+
+func (m *mockSnapshotter) Snapshot(_ context.Context, w io.Writer) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.snapshotErr != nil {
+		return m.snapshotErr
+	}
+
+	_, err := io.WriteString(w, snapshotData)
+
+	return err
+}
+
+func (m *mockSnapshotter) Upload(_ context.Context, dest talosconfig.EtcdSnapshotUpload, key string, snapshot io.ReadSeeker, _ int64, sha256sum string) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.uploadErr != nil {
+		return "", m.uploadErr
+	}
+
+	data, err := io.ReadAll(snapshot)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(data)
+
+	if hex.EncodeToString(hash[:]) != sha256sum {
+		return "", errors.New("checksum mismatch")
+	}
+
+	m.uploads = append(m.uploads, key)
+
+	return dest.Endpoint() + "/" + dest.Bucket() + "/" + key, nil
+}
+
+type SnapshotSuite struct {
+	ctest.DefaultSuite
+
+	snapshotter   *mockSnapshotter
+	snapshotsPath string
+}
+
+func (suite *SnapshotSuite) createConfig(retention int, s3 *v1alpha1.EtcdSnapshotsS3Config) *config.MachineConfig {
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(container.NewV1Alpha1(&v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineType: "controlplane",
+		},
+		ClusterConfig: &v1alpha1.ClusterConfig{
+			ControlPlane: &v1alpha1.ControlPlaneConfig{
+				Endpoint: &v1alpha1.Endpoint{
+					URL: u,
+				},
+			},
+			EtcdConfig: &v1alpha1.EtcdConfig{
+				RootCA: &x509.PEMEncodedCertificateAndKey{},
+				EtcdSnapshots: &v1alpha1.EtcdSnapshotsConfig{
+					SnapshotsSchedule:  "@every 1s",
+					SnapshotsRetention: retention,
+					SnapshotsS3:        s3,
+				},
+			},
+		},
+	}))
+
+	suite.Create(cfg)
+
+	return cfg
+}
+
+func (suite *SnapshotSuite) createEtcd() {
+	member := etcd.NewMember(etcd.NamespaceName, etcd.LocalMemberID)
+	member.TypedSpec().MemberID = "000000000000007b"
+	suite.Create(member)
+
+	etcdService := v1alpha1res.NewService("etcd")
+	etcdService.TypedSpec().Running = true
+	etcdService.TypedSpec().Healthy = true
+	suite.Create(etcdService)
+}
+
+func (suite *SnapshotSuite) TestSnapshot() {
+	suite.createEtcd()
+	suite.createConfig(2, &v1alpha1.EtcdSnapshotsS3Config{
+		S3Endpoint:        "https://s3.example.com",
+		S3Bucket:          "backups",
+		S3Prefix:          "cluster",
+		S3AccessKeyID:     "key",
+		S3SecretAccessKey: "secret",
+	})
+
+	hash := sha256.Sum256([]byte(snapshotData))
+
+	ctest.AssertResource(suite, etcd.LocalMemberID, func(status *etcd.SnapshotStatus, asrt *assert.Assertions) {
+		spec := status.TypedSpec()
+
+		asrt.Equal("000000000000007b", spec.MemberID)
+		asrt.False(spec.LastRun.IsZero())
+		asrt.EqualValues(len(snapshotData), spec.Size)
+		asrt.Equal(hex.EncodeToString(hash[:]), spec.SHA256)
+		asrt.Equal(suite.snapshotsPath, filepath.Dir(spec.Path))
+		asrt.Regexp(`^https://s3.example.com/backups/cluster/000000000000007b/snapshot-\d{8}T\d{6}Z\.db$`, spec.UploadLocation)
+		asrt.Empty(spec.LastError)
+		asrt.Empty(spec.UploadError)
+	})
+
+	// wait for a few more snapshots, and check the retention
+	suite.AssertWithin(10*time.Second, 100*time.Millisecond, func() error {
+		suite.snapshotter.mu.Lock()
+		defer suite.snapshotter.mu.Unlock()
+
+		if len(suite.snapshotter.uploads) < 4 {
+			return errors.New("waiting for snapshots")
+		}
+
+		return nil
+	})
+
+	snapshots, err := filepath.Glob(filepath.Join(suite.snapshotsPath, "*"))
+	suite.Require().NoError(err)
+	suite.Assert().Len(snapshots, 2)
+}
+
+func (suite *SnapshotSuite) TestErrors() {
+	suite.snapshotter.mu.Lock()
+	suite.snapshotter.uploadErr = errors.New("access denied")
+	suite.snapshotter.mu.Unlock()
+
+	suite.createEtcd()
+	suite.createConfig(0, &v1alpha1.EtcdSnapshotsS3Config{
+		S3Endpoint: "https://s3.example.com",
+		S3Bucket:   "backups",
+	})
+
+	// snapshot is saved locally even if the upload fails
+	ctest.AssertResource(suite, etcd.LocalMemberID, func(status *etcd.SnapshotStatus, asrt *assert.Assertions) {
+		asrt.Equal("access denied", status.TypedSpec().UploadError)
+		asrt.Empty(status.TypedSpec().UploadLocation)
+		asrt.FileExists(status.TypedSpec().Path)
+	})
+
+	suite.snapshotter.mu.Lock()
+	suite.snapshotter.snapshotErr = errors.New("member is stopping")
+	suite.snapshotter.mu.Unlock()
+
+	ctest.AssertResource(suite, etcd.LocalMemberID, func(status *etcd.SnapshotStatus, asrt *assert.Assertions) {
+		asrt.Equal("error taking etcd snapshot: member is stopping", status.TypedSpec().LastError)
+		asrt.Empty(status.TypedSpec().Path)
+	})
+
+	// partial snapshots are removed
+	snapshots, err := filepath.Glob(filepath.Join(suite.snapshotsPath, "*.part"))
+	suite.Require().NoError(err)
+	suite.Assert().Empty(snapshots)
+}
+
+func (suite *SnapshotSuite) TestDisabled() {
+	cfg := suite.createConfig(0, nil)
+
+	// etcd is not running yet
+	ctest.AssertResource(suite, etcd.LocalMemberID, func(status *etcd.SnapshotStatus, asrt *assert.Assertions) {
+		asrt.Equal("etcd member is not healthy", status.TypedSpec().SkipReason)
+	})
+
+	suite.Destroy(cfg)
+
+	ctest.AssertNoResource[*etcd.SnapshotStatus](suite, etcd.LocalMemberID)
+
+	entries, err := os.ReadDir(suite.snapshotsPath)
+	suite.Require().NoError(err)
+	suite.Assert().Empty(entries)
+}
+
+func TestSnapshotSuite(t *testing.T) {
+	t.Parallel()
+
+	s := &SnapshotSuite{}
+
+	s.DefaultSuite = ctest.DefaultSuite{
+		Timeout: 15 * time.Second,
+		AfterSetup: func(suite *ctest.DefaultSuite) {
+			s.snapshotter = &mockSnapshotter{}
+			s.snapshotsPath = suite.T().TempDir()
+
+			suite.Require().NoError(suite.Runtime().RegisterController(&etcdctrl.SnapshotController{
+				Snapshotter:   s.snapshotter,
+				SnapshotsPath: s.snapshotsPath,
+			}))
+		},
+	}
+
+	suite.Run(t, s)
+}
@@ -0,0 +1,261 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	pkgetcd "github.com/siderolabs/talos/internal/pkg/etcd"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/etcd"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+// StatusRefreshInterval is the interval the etcd alarms and the database size are polled.
+const StatusRefreshInterval = 30 * time.Second
+
+// StatusClient fetches the state of the local etcd member.
+type StatusClient interface {
+	// Status returns the status of the local etcd member.
+	Status(ctx context.Context) (*etcdserverpb.StatusResponse, error)
+	// AlarmList returns the active alarms of the cluster members.
+	AlarmList(ctx context.Context) ([]*etcdserverpb.AlarmMember, error)
+}
+
+// StatusController polls the alarms and the database size of the local etcd member.
+//
+// Raised and cleared alarms are reported as events.
+type StatusController struct {
+	V1Alpha1Events runtime.Publisher
+	// StatusClient defaults to the local etcd client.
+	StatusClient StatusClient
+	Clock        clock.Clock
+
+	// alarms tracks the time each active alarm was first seen.
+	alarms map[alarmKey]time.Time
+}
+
+type alarmKey struct {
+	typ      string
+	memberID uint64
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *StatusController) Name() string {
+	return "etcd.StatusController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *StatusController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: v1alpha1.NamespaceName,
+			Type:      v1alpha1.ServiceType,
+			ID:        optional.Some(etcdServiceID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *StatusController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: etcd.StatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *StatusController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.StatusClient == nil {
+		ctrl.StatusClient = localStatusClient{}
+	}
+
+	if ctrl.Clock == nil {
+		ctrl.Clock = clock.New()
+	}
+
+	if ctrl.alarms == nil {
+		ctrl.alarms = map[alarmKey]time.Time{}
+	}
+
+	ticker := ctrl.Clock.Ticker(StatusRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ticker.C:
+		}
+
+		etcdService, err := safe.ReaderGetByID[*v1alpha1.Service](ctx, r, etcdServiceID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting etcd service resource: %w", err)
+		}
+
+		r.StartTrackingOutputs()
+
+		// alarms can be read from the member which is running, but not healthy (e.g. NOSPACE alarm is raised)
+		if etcdService != nil && etcdService.Metadata().Phase() == resource.PhaseRunning && etcdService.TypedSpec().Running {
+			if err = ctrl.updateStatus(ctx, r, logger); err != nil {
+				logger.Debug("error getting etcd status", zap.Error(err))
+			}
+		}
+
+		if err = safe.CleanupOutputs[*etcd.Status](ctx, r); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func (ctrl *StatusController) updateStatus(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	status, err := ctrl.StatusClient.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	alarms, err := ctrl.StatusClient.AlarmList(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctrl.trackAlarms(ctx, logger, alarms)
+
+	quota := status.GetDbSizeQuota()
+	if quota <= 0 {
+		quota = constants.EtcdDefaultQuotaBackendBytes
+	}
+
+	return safe.WriterModify(ctx, r, etcd.NewStatus(etcd.NamespaceName, etcd.LocalMemberID), func(res *etcd.Status) error {
+		spec := res.TypedSpec()
+
+		spec.MemberID = etcd.FormatMemberID(status.GetHeader().GetMemberId())
+		spec.DBSize = status.GetDbSize()
+		spec.DBSizeInUse = status.GetDbSizeInUse()
+		spec.DBSizeQuota = quota
+		spec.Alarms = make([]etcd.Alarm, 0, len(ctrl.alarms))
+
+		for key, since := range ctrl.alarms {
+			spec.Alarms = append(spec.Alarms, etcd.Alarm{
+				Type:     key.typ,
+				MemberID: etcd.FormatMemberID(key.memberID),
+				Since:    since,
+			})
+		}
+
+		slices.SortFunc(spec.Alarms, func(a, b etcd.Alarm) int {
+			return cmp.Or(cmp.Compare(a.MemberID, b.MemberID), cmp.Compare(a.Type, b.Type))
+		})
+
+		return nil
+	})
+}
+
+// trackAlarms updates the set of the active alarms, and reports raised and cleared alarms.
+func (ctrl *StatusController) trackAlarms(ctx context.Context, logger *zap.Logger, alarms []*etcdserverpb.AlarmMember) {
+	active := map[alarmKey]struct{}{}
+
+	for _, alarm := range alarms {
+		if alarm.GetAlarm() == etcdserverpb.AlarmType_NONE {
+			continue
+		}
+
+		key := alarmKey{typ: alarm.GetAlarm().String(), memberID: alarm.GetMemberID()}
+		active[key] = struct{}{}
+
+		if _, ok := ctrl.alarms[key]; ok {
+			continue
+		}
+
+		ctrl.alarms[key] = ctrl.Clock.Now()
+
+		logger.Warn("etcd alarm raised", zap.String("alarm", key.typ), zap.String("member_id", etcd.FormatMemberID(key.memberID)))
+
+		ctrl.publish(ctx, key, true)
+	}
+
+	for key := range ctrl.alarms {
+		if _, ok := active[key]; ok {
+			continue
+		}
+
+		delete(ctrl.alarms, key)
+
+		logger.Info("etcd alarm cleared", zap.String("alarm", key.typ), zap.String("member_id", etcd.FormatMemberID(key.memberID)))
+
+		ctrl.publish(ctx, key, false)
+	}
+}
+
+func (ctrl *StatusController) publish(ctx context.Context, key alarmKey, active bool) {
+	if ctrl.V1Alpha1Events == nil {
+		return
+	}
+
+	ctrl.V1Alpha1Events.Publish(ctx, &machine.EtcdAlarmEvent{
+		MemberId: etcd.FormatMemberID(key.memberID),
+		Alarm:    key.typ,
+		Active:   active,
+	})
+}
+
+// localStatusClient fetches the state via the local etcd client.
+type localStatusClient struct{}
+
+func (localStatusClient) Status(ctx context.Context) (*etcdserverpb.StatusResponse, error) {
+	client, err := pkgetcd.NewLocalClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer client.Close() //nolint:errcheck
+
+	resp, err := client.Status(ctx, nethelpers.JoinHostPort("localhost", constants.EtcdClientPort))
+	if err != nil {
+		return nil, err
+	}
+
+	return (*etcdserverpb.StatusResponse)(resp), nil
+}
+
+func (localStatusClient) AlarmList(ctx context.Context) ([]*etcdserverpb.AlarmMember, error) {
+	client, err := pkgetcd.NewLocalClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer client.Close() //nolint:errcheck
+
+	resp, err := client.AlarmList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Alarms, nil
+}
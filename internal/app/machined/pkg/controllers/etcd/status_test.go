@@ -0,0 +1,199 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	etcdctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/etcd"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/etcd"
+	v1alpha1res "github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+// fakeEtcd is a fake etcd member which allows to inject alarms.
+type fakeEtcd struct {
+	mu sync.Mutex
+
+	dbSize, dbSizeInUse, dbSizeQuota int64
+	alarms                           []*etcdserverpb.AlarmMember
+}
+
+func (f *fakeEtcd) Status(context.Context) (*etcdserverpb.StatusResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return &etcdserverpb.StatusResponse{
+		Header:      &etcdserverpb.ResponseHeader{MemberId: 123},
+		DbSize:      f.dbSize,
+		DbSizeInUse: f.dbSizeInUse,
+		DbSizeQuota: f.dbSizeQuota,
+	}, nil
+}
+
+func (f *fakeEtcd) AlarmList(context.Context) ([]*etcdserverpb.AlarmMember, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.alarms, nil
+}
+
+func (f *fakeEtcd) setAlarms(alarms ...*etcdserverpb.AlarmMember) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.alarms = alarms
+}
+
+type mockAlarmEvents struct {
+	mu     sync.Mutex
+	events []*machine.EtcdAlarmEvent
+}
+
+func (s *mockAlarmEvents) Publish(_ context.Context, m proto.Message) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if event, ok := m.(*machine.EtcdAlarmEvent); ok {
+		s.events = append(s.events, event)
+	}
+}
+
+func (s *mockAlarmEvents) get() []*machine.EtcdAlarmEvent {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]*machine.EtcdAlarmEvent(nil), s.events...)
+}
+
+type StatusSuite struct {
+	ctest.DefaultSuite
+
+	clock  *clock.Mock
+	etcd   *fakeEtcd
+	events *mockAlarmEvents
+}
+
+func (suite *StatusSuite) TestAlarms() {
+	suite.etcd.mu.Lock()
+	suite.etcd.dbSize = 1000
+	suite.etcd.dbSizeInUse = 800
+	suite.etcd.dbSizeQuota = 10000
+	suite.etcd.mu.Unlock()
+
+	etcdService := v1alpha1res.NewService("etcd")
+	etcdService.TypedSpec().Running = true
+	etcdService.TypedSpec().Healthy = true
+	suite.Create(etcdService)
+
+	ctest.AssertResource(suite, etcd.LocalMemberID, func(status *etcd.Status, asrt *assert.Assertions) {
+		spec := status.TypedSpec()
+
+		asrt.Equal("000000000000007b", spec.MemberID)
+		asrt.EqualValues(1000, spec.DBSize)
+		asrt.EqualValues(800, spec.DBSizeInUse)
+		asrt.EqualValues(10000, spec.DBSizeQuota)
+		asrt.Equal(10, spec.QuotaUsage())
+		asrt.Empty(spec.Alarms)
+	})
+
+	raisedAt := suite.clock.Now()
+
+	suite.etcd.mu.Lock()
+	suite.etcd.dbSize = 9500
+	suite.etcd.mu.Unlock()
+
+	suite.etcd.setAlarms(&etcdserverpb.AlarmMember{MemberID: 123, Alarm: etcdserverpb.AlarmType_NOSPACE})
+
+	suite.clock.Add(etcdctrl.StatusRefreshInterval)
+
+	ctest.AssertResource(suite, etcd.LocalMemberID, func(status *etcd.Status, asrt *assert.Assertions) {
+		spec := status.TypedSpec()
+
+		asrt.Equal(95, spec.QuotaUsage())
+		asrt.Equal([]etcd.Alarm{
+			{
+				Type:     etcd.AlarmTypeNoSpace,
+				MemberID: "000000000000007b",
+				Since:    raisedAt.Add(etcdctrl.StatusRefreshInterval),
+			},
+		}, spec.Alarms)
+	})
+
+	// the alarm is still active, so it is reported only once
+	suite.clock.Add(etcdctrl.StatusRefreshInterval)
+	suite.clock.Add(etcdctrl.StatusRefreshInterval)
+
+	suite.etcd.setAlarms()
+
+	suite.clock.Add(etcdctrl.StatusRefreshInterval)
+
+	ctest.AssertResource(suite, etcd.LocalMemberID, func(status *etcd.Status, asrt *assert.Assertions) {
+		asrt.Empty(status.TypedSpec().Alarms)
+	})
+
+	events := suite.events.get()
+	suite.Require().Len(events, 2)
+
+	suite.Assert().Equal(etcd.AlarmTypeNoSpace, events[0].GetAlarm())
+	suite.Assert().True(events[0].GetActive())
+	suite.Assert().Equal(etcd.AlarmTypeNoSpace, events[1].GetAlarm())
+	suite.Assert().False(events[1].GetActive())
+
+	// etcd is stopped
+	etcdService.TypedSpec().Running = false
+	suite.Update(etcdService)
+
+	ctest.AssertNoResource[*etcd.Status](suite, etcd.LocalMemberID)
+}
+
+func (suite *StatusSuite) TestDefaultQuota() {
+	suite.etcd.mu.Lock()
+	suite.etcd.dbSize = constants.EtcdDefaultQuotaBackendBytes / 2
+	suite.etcd.mu.Unlock()
+
+	etcdService := v1alpha1res.NewService("etcd")
+	etcdService.TypedSpec().Running = true
+	suite.Create(etcdService)
+
+	ctest.AssertResource(suite, etcd.LocalMemberID, func(status *etcd.Status, asrt *assert.Assertions) {
+		asrt.EqualValues(constants.EtcdDefaultQuotaBackendBytes, status.TypedSpec().DBSizeQuota)
+		asrt.Equal(50, status.TypedSpec().QuotaUsage())
+	})
+}
+
+func TestStatusSuite(t *testing.T) {
+	t.Parallel()
+
+	s := &StatusSuite{}
+
+	s.DefaultSuite = ctest.DefaultSuite{
+		Timeout: 10 * time.Second,
+		AfterSetup: func(suite *ctest.DefaultSuite) {
+			s.clock = clock.NewMock()
+			s.etcd = &fakeEtcd{}
+			s.events = &mockAlarmEvents{}
+
+			suite.Require().NoError(suite.Runtime().RegisterController(&etcdctrl.StatusController{
+				V1Alpha1Events: s.events,
+				StatusClient:   s.etcd,
+				Clock:          s.clock,
+			}))
+		},
+	}
+
+	suite.Run(t, s)
+}
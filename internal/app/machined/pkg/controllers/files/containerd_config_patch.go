@@ -0,0 +1,145 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package files
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/pkg/toml"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/files"
+)
+
+// ContainerdConfigPatchController generates containerd configuration patches from the machine configuration.
+//
+// Each patch is verified to be mergeable with the base configuration before it's applied.
+type ContainerdConfigPatchController struct {
+	// Path to /etc/cri/conf.d directory.
+	CRIConfdPath string
+	// Path to the base system containerd configuration.
+	SystemContainerdConfigPath string
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *ContainerdConfigPatchController) Name() string {
+	return "files.ContainerdConfigPatchController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *ContainerdConfigPatchController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.ActiveID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *ContainerdConfigPatchController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: files.EtcFileSpecType,
+			Kind: controller.OutputShared,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *ContainerdConfigPatchController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.CRIConfdPath == "" {
+		ctrl.CRIConfdPath = constants.EtcCRIConfdPath
+	}
+
+	if ctrl.SystemContainerdConfigPath == "" {
+		ctrl.SystemContainerdConfigPath = constants.SystemContainerdConfig
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.ActiveID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		var criPatch, systemPatch string
+
+		if cfg != nil && cfg.Config().ContainerdConfig() != nil {
+			criPatch = cfg.Config().ContainerdConfig().CRIConfigPatch()
+			systemPatch = cfg.Config().ContainerdConfig().SystemConfigPatch()
+		}
+
+		// the patch itself is one of the CRI config parts, so it should be excluded from the base
+		criParts, err := filepath.Glob(filepath.Join(ctrl.CRIConfdPath, "*.part"))
+		if err != nil {
+			return err
+		}
+
+		criParts = slices.DeleteFunc(criParts, func(part string) bool {
+			return filepath.Base(part) == filepath.Base(constants.CRIConfigPatchPart)
+		})
+
+		slices.Sort(criParts)
+
+		for _, patch := range []struct {
+			name     string
+			id       string
+			contents string
+			base     []string
+		}{
+			{
+				name:     "cri",
+				id:       constants.CRIConfigPatchPart,
+				contents: criPatch,
+				base:     criParts,
+			},
+			{
+				name:     "system",
+				id:       constants.SystemContainerdConfigPatch,
+				contents: systemPatch,
+				base:     []string{ctrl.SystemContainerdConfigPath},
+			},
+		} {
+			if err = toml.CheckMerge(patch.base, []byte(patch.contents)); err != nil {
+				// keep the previous patch, as the new one would break containerd startup
+				logger.Error("containerd config patch can't be merged with the base config, skipping", zap.String("instance", patch.name), zap.Error(err))
+
+				continue
+			}
+
+			if err = safe.WriterModify(ctx, r, files.NewEtcFileSpec(files.NamespaceName, patch.id),
+				func(r *files.EtcFileSpec) error {
+					spec := r.TypedSpec()
+
+					spec.Contents = []byte(patch.contents)
+					spec.Mode = 0o600
+					spec.SelinuxLabel = constants.EtcSelinuxLabel
+
+					return nil
+				}); err != nil {
+				return fmt.Errorf("error modifying resource: %w", err)
+			}
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
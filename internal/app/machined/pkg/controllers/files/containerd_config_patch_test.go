@@ -0,0 +1,113 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package files_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	filesctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/files"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	runtimecfg "github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/files"
+)
+
+type ContainerdConfigPatchSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *ContainerdConfigPatchSuite) TestNoConfig() {
+	ctest.AssertResources(suite, []resource.ID{constants.CRIConfigPatchPart, constants.SystemContainerdConfigPatch},
+		func(etcFile *files.EtcFileSpec, asrt *assert.Assertions) {
+			asrt.Empty(etcFile.TypedSpec().Contents)
+		},
+	)
+}
+
+func (suite *ContainerdConfigPatchSuite) TestPatch() {
+	containerdConfig := runtimecfg.NewContainerdConfigV1Alpha1()
+	containerdConfig.ContainerdCRIConfigPatch = `[plugins."io.containerd.nri.v1.nri"]
+  disable = false
+`
+	containerdConfig.ContainerdSystemConfigPatch = `[debug]
+  level = "debug"
+`
+
+	ctr, err := container.New(containerdConfig)
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(ctr)
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), cfg))
+
+	ctest.AssertResource(suite, constants.CRIConfigPatchPart, func(etcFile *files.EtcFileSpec, asrt *assert.Assertions) {
+		asrt.Equal(containerdConfig.ContainerdCRIConfigPatch, string(etcFile.TypedSpec().Contents))
+	})
+
+	ctest.AssertResource(suite, constants.SystemContainerdConfigPatch, func(etcFile *files.EtcFileSpec, asrt *assert.Assertions) {
+		asrt.Equal(containerdConfig.ContainerdSystemConfigPatch, string(etcFile.TypedSpec().Contents))
+	})
+
+	// patch which conflicts with the base config is not applied
+	containerdConfig = containerdConfig.DeepCopy()
+	containerdConfig.ContainerdCRIConfigPatch = `[plugins."io.containerd.nri.v1.nri"]
+  disable = "no"
+`
+	containerdConfig.ContainerdSystemConfigPatch = ""
+
+	ctr, err = container.New(containerdConfig)
+	suite.Require().NoError(err)
+
+	newCfg := config.NewMachineConfig(ctr)
+	newCfg.Metadata().SetVersion(cfg.Metadata().Version())
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), newCfg))
+
+	ctest.AssertResource(suite, constants.SystemContainerdConfigPatch, func(etcFile *files.EtcFileSpec, asrt *assert.Assertions) {
+		asrt.Empty(etcFile.TypedSpec().Contents)
+	})
+
+	ctest.AssertResource(suite, constants.CRIConfigPatchPart, func(etcFile *files.EtcFileSpec, asrt *assert.Assertions) {
+		asrt.Equal("[plugins.\"io.containerd.nri.v1.nri\"]\n  disable = false\n", string(etcFile.TypedSpec().Contents))
+	})
+}
+
+func TestContainerdConfigPatchSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, &ContainerdConfigPatchSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 10 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				criConfdPath := suite.T().TempDir()
+				systemConfigPath := filepath.Join(suite.T().TempDir(), "config.toml")
+
+				suite.Require().NoError(os.WriteFile(filepath.Join(criConfdPath, "00-base.part"), []byte(`version = 3
+
+[plugins."io.containerd.nri.v1.nri"]
+  disable = true
+`), 0o600))
+				suite.Require().NoError(os.WriteFile(filepath.Join(criConfdPath, filepath.Base(constants.CRIConfigPatchPart)), []byte(`version = "broken"`), 0o600))
+				suite.Require().NoError(os.WriteFile(systemConfigPath, []byte(`version = 3
+
+[debug]
+  level = "info"
+`), 0o600))
+
+				suite.Require().NoError(suite.Runtime().RegisterController(&filesctrl.ContainerdConfigPatchController{
+					CRIConfdPath:               criConfdPath,
+					SystemContainerdConfigPath: systemConfigPath,
+				}))
+			},
+		},
+	})
+}
@@ -16,16 +16,25 @@ import (
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/siderolabs/gen/optional"
 	"go.uber.org/zap"
 
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/merge"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/files"
 )
 
+const criDisableAppArmorConfig = `[plugins."io.containerd.cri.v1.runtime"]
+  disable_apparmor = true
+`
+
 // CRIBaseRuntimeSpecController generates parts of the CRI config for base OCI runtime configuration.
+//
+// The default security profiles are applied here as well: the Localhost seccomp profile becomes a part of the base runtime spec,
+// as the kubelet always sets the seccomp profile type explicitly, so the CRI only keeps the base one for the Unconfined type.
 type CRIBaseRuntimeSpecController struct{}
 
 // Name implements controller.Controller interface.
@@ -97,6 +106,18 @@ func (ctrl *CRIBaseRuntimeSpecController) Run(ctx context.Context, r controller.
 		// * remove default rlimits (See https://github.com/containerd/cri/issues/515)
 		defaultSpec.Process.Rlimits = nil
 
+		profiles := cfg.Config().DefaultSecurityProfilesConfig()
+
+		if profiles != nil && profiles.SeccompProfileType() == talosconfig.SecurityProfileLocalhost {
+			var seccomp specs.LinuxSeccomp
+
+			if err := json.Unmarshal([]byte(profiles.SeccompLocalhostProfile()), &seccomp); err != nil {
+				return fmt.Errorf("error unmarshaling default seccomp profile: %w", err)
+			}
+
+			defaultSpec.Linux.Seccomp = &seccomp
+		}
+
 		if len(cfg.Config().Machine().BaseRuntimeSpecOverrides()) > 0 {
 			var overrides oci.Spec
 
@@ -132,6 +153,25 @@ func (ctrl *CRIBaseRuntimeSpecController) Run(ctx context.Context, r controller.
 			return fmt.Errorf("error modifying resource: %w", err)
 		}
 
+		var securityProfilesPart []byte
+
+		if profiles != nil && profiles.AppArmorProfileType() == talosconfig.SecurityProfileUnconfined {
+			securityProfilesPart = []byte(criDisableAppArmorConfig)
+		}
+
+		if err := safe.WriterModify(ctx, r, files.NewEtcFileSpec(files.NamespaceName, constants.CRISecurityProfilesConfigPart),
+			func(r *files.EtcFileSpec) error {
+				spec := r.TypedSpec()
+
+				spec.Contents = securityProfilesPart
+				spec.Mode = 0o600
+				spec.SelinuxLabel = constants.EtcSelinuxLabel
+
+				return nil
+			}); err != nil {
+			return fmt.Errorf("error modifying resource: %w", err)
+		}
+
 		r.ResetRestartBackoff()
 	}
 }
@@ -10,12 +10,15 @@ import (
 	"time"
 
 	"github.com/containerd/containerd/v2/pkg/oci"
+	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
 	filesctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/files"
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	runtimecfg "github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
@@ -49,6 +52,10 @@ func (suite *CRIBaseRuntimeSpecSuite) TestDefaults() {
 
 		asrt.Empty(ociSpec.Process.Rlimits)
 	})
+
+	ctest.AssertResource(suite, constants.CRISecurityProfilesConfigPart, func(etcFile *files.EtcFileSpec, asrt *assert.Assertions) {
+		asrt.Empty(etcFile.TypedSpec().Contents)
+	})
 }
 
 func (suite *CRIBaseRuntimeSpecSuite) TestOverrides() {
@@ -92,6 +99,52 @@ func (suite *CRIBaseRuntimeSpecSuite) TestOverrides() {
 	})
 }
 
+func (suite *CRIBaseRuntimeSpecSuite) TestDefaultSecurityProfiles() {
+	profiles := runtimecfg.NewDefaultSecurityProfilesV1Alpha1()
+	profiles.SeccompConfig = &runtimecfg.SeccompDefaultProfileConfig{
+		SeccompType:    talosconfig.SecurityProfileLocalhost,
+		SeccompProfile: `{"defaultAction":"SCMP_ACT_ERRNO","syscalls":[{"names":["read","write"],"action":"SCMP_ACT_ALLOW"}]}`,
+	}
+	profiles.AppArmorConfig = &runtimecfg.AppArmorDefaultProfileConfig{
+		AppArmorType: talosconfig.SecurityProfileUnconfined,
+	}
+
+	ctr, err := container.New(
+		&v1alpha1.Config{
+			ConfigVersion: "v1alpha1",
+			MachineConfig: &v1alpha1.MachineConfig{
+				MachineType: "worker",
+			},
+		},
+		profiles,
+	)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), config.NewMachineConfig(ctr)))
+
+	ctest.AssertResource(suite, constants.CRIBaseRuntimeSpec, func(etcFile *files.EtcFileSpec, asrt *assert.Assertions) {
+		var ociSpec oci.Spec
+
+		asrt.NoError(json.Unmarshal(etcFile.TypedSpec().Contents, &ociSpec))
+
+		if !asrt.NotNil(ociSpec.Linux.Seccomp) {
+			return
+		}
+
+		asrt.Equal(specs.ActErrno, ociSpec.Linux.Seccomp.DefaultAction)
+		asrt.Equal([]specs.LinuxSyscall{
+			{
+				Names:  []string{"read", "write"},
+				Action: specs.ActAllow,
+			},
+		}, ociSpec.Linux.Seccomp.Syscalls)
+	})
+
+	ctest.AssertResource(suite, constants.CRISecurityProfilesConfigPart, func(etcFile *files.EtcFileSpec, asrt *assert.Assertions) {
+		asrt.Contains(string(etcFile.TypedSpec().Contents), "disable_apparmor = true")
+	})
+}
+
 func TestCRIBaseRuntimeSpecSuite(t *testing.T) {
 	t.Parallel()
 
@@ -20,6 +20,7 @@ import (
 
 	"github.com/siderolabs/talos/internal/pkg/containers/cri/containerd"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/cri"
 	"github.com/siderolabs/talos/pkg/machinery/resources/files"
 	"github.com/siderolabs/talos/pkg/xfs"
@@ -49,6 +50,12 @@ func (ctrl *CRIRegistryConfigController) Inputs() []controller.Input {
 			ID:        optional.Some(cri.RegistriesConfigID),
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.ActiveID),
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -97,18 +104,30 @@ func (ctrl *CRIRegistryConfigController) Run(ctx context.Context, r controller.R
 			return fmt.Errorf("error getting registries config: %w", err)
 		}
 
+		machineConfig, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.ActiveID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
 		var (
 			criRegistryContents []byte
 			criHosts            *containerd.HostsConfig
+			trustedRootsPath    string
 		)
 
+		// containerd caches system roots on startup, so point it explicitly to the trusted roots bundle
+		// to pick up changes to the custom trusted roots
+		if machineConfig != nil && len(machineConfig.Config().TrustedRoots().ExtraTrustedRootCertificates()) > 0 {
+			trustedRootsPath = constants.DefaultTrustedCAFile
+		}
+
 		if cfg != nil {
 			criRegistryContents, err = containerd.GenerateCRIConfig(cfg.TypedSpec())
 			if err != nil {
 				return err
 			}
 
-			criHosts, err = containerd.GenerateHosts(cfg.TypedSpec(), dest)
+			criHosts, err = containerd.GenerateHosts(cfg.TypedSpec(), dest, trustedRootsPath)
 			if err != nil {
 				return err
 			}
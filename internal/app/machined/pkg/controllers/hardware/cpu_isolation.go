@@ -0,0 +1,235 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hardware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	v1alpha1runtime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/cpuset"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/hardware"
+)
+
+const (
+	defaultProcIRQPath = "/proc/irq"
+	defaultSysCPUPath  = "/sys/devices/system/cpu"
+
+	// irqAffinityResyncInterval is the interval to re-apply IRQ affinity, as drivers might reset it for new IRQs.
+	irqAffinityResyncInterval = time.Minute
+)
+
+// CPUIsolationController steers IRQs away from isolated CPUs and reports the effective CPU isolation.
+//
+// Kernel arguments for the CPU isolation are applied by the installer on install and upgrade.
+type CPUIsolationController struct {
+	V1Alpha1Mode v1alpha1runtime.Mode
+
+	// ProcIRQPath and SysCPUPath can be overridden in tests.
+	ProcIRQPath string
+	SysCPUPath  string
+
+	steered bool
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *CPUIsolationController) Name() string {
+	return "hardware.CPUIsolationController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *CPUIsolationController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.ActiveID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *CPUIsolationController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: hardware.CPUIsolationStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *CPUIsolationController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	// IRQ affinity can't be changed from the container
+	if ctrl.V1Alpha1Mode.InContainer() {
+		return nil
+	}
+
+	if ctrl.ProcIRQPath == "" {
+		ctrl.ProcIRQPath = defaultProcIRQPath
+	}
+
+	if ctrl.SysCPUPath == "" {
+		ctrl.SysCPUPath = defaultSysCPUPath
+	}
+
+	ticker := time.NewTicker(irqAffinityResyncInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ticker.C:
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.ActiveID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		var configured cpuset.Set
+
+		if cfg != nil && cfg.Config().CPUIsolationConfig() != nil {
+			configured = cfg.Config().CPUIsolationConfig().IsolatedCPUs()
+		}
+
+		present, err := ctrl.readCPUList("present")
+		if err != nil {
+			return err
+		}
+
+		isolated, err := ctrl.readCPUList("isolated")
+		if err != nil {
+			return err
+		}
+
+		nohzFull, err := ctrl.readCPUList("nohz_full")
+		if err != nil {
+			return err
+		}
+
+		housekeeping := present.Difference(configured)
+
+		switch {
+		case len(configured) > 0 && len(housekeeping) == 0:
+			logger.Warn("all CPUs are isolated, skipping IRQ affinity configuration", zap.Stringer("isolated", configured))
+		case len(configured) > 0:
+			if err = ctrl.setIRQAffinity(housekeeping, logger); err != nil {
+				return err
+			}
+
+			ctrl.steered = true
+		case ctrl.steered:
+			// CPU isolation was removed, restore IRQ affinity to all CPUs
+			if err = ctrl.setIRQAffinity(present, logger); err != nil {
+				return err
+			}
+
+			ctrl.steered = false
+		}
+
+		defaultAffinity, err := os.ReadFile(filepath.Join(ctrl.ProcIRQPath, "default_smp_affinity"))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("error reading default IRQ affinity: %w", err)
+		}
+
+		if err = safe.WriterModify(ctx, r, hardware.NewCPUIsolationStatus(), func(res *hardware.CPUIsolationStatus) error {
+			res.TypedSpec().ConfiguredCPUs = configured.String()
+			res.TypedSpec().IsolatedCPUs = isolated.String()
+			res.TypedSpec().NoHZFullCPUs = nohzFull.String()
+			res.TypedSpec().HousekeepingCPUs = housekeeping.String()
+			res.TypedSpec().DefaultIRQAffinity = strings.TrimSpace(string(defaultAffinity))
+			res.TypedSpec().KernelArgsPending = len(configured) > 0 && configured.String() != isolated.String()
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating CPU isolation status: %w", err)
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// readCPUList reads a CPU list from sysfs, missing files are treated as an empty list.
+func (ctrl *CPUIsolationController) readCPUList(name string) (cpuset.Set, error) {
+	contents, err := os.ReadFile(filepath.Join(ctrl.SysCPUPath, name))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("error reading CPU list %q: %w", name, err)
+	}
+
+	// nohz_full reads as "(null)" if no CPUs are in adaptive-tick mode
+	if strings.TrimSpace(string(contents)) == "(null)" {
+		return nil, nil
+	}
+
+	cpus, err := cpuset.Parse(string(contents))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing CPU list %q: %w", name, err)
+	}
+
+	return cpus, nil
+}
+
+// setIRQAffinity sets the default IRQ affinity for new IRQs and the affinity of all existing IRQs.
+func (ctrl *CPUIsolationController) setIRQAffinity(cpus cpuset.Set, logger *zap.Logger) error {
+	mask := cpus.Mask()
+
+	if err := os.WriteFile(filepath.Join(ctrl.ProcIRQPath, "default_smp_affinity"), []byte(mask), 0o600); err != nil {
+		return fmt.Errorf("error writing default IRQ affinity: %w", err)
+	}
+
+	entries, err := os.ReadDir(ctrl.ProcIRQPath)
+	if err != nil {
+		return fmt.Errorf("error listing IRQs: %w", err)
+	}
+
+	var updated, skipped int
+
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		if _, err = strconv.Atoi(entry.Name()); err != nil {
+			continue
+		}
+
+		// per-CPU and kernel-managed IRQs can't be moved, writing the affinity fails for them
+		if err = os.WriteFile(filepath.Join(ctrl.ProcIRQPath, entry.Name(), "smp_affinity"), []byte(mask), 0o600); err != nil {
+			skipped++
+
+			continue
+		}
+
+		updated++
+	}
+
+	logger.Debug("IRQ affinity configured", zap.String("mask", mask), zap.Int("updated", updated), zap.Int("skipped", skipped))
+
+	return nil
+}
@@ -0,0 +1,116 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hardware_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/hardware"
+	v1alpha1runtime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	hardwareconfigtype "github.com/siderolabs/talos/pkg/machinery/config/types/hardware"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	hardwareres "github.com/siderolabs/talos/pkg/machinery/resources/hardware"
+)
+
+type CPUIsolationSuite struct {
+	ctest.DefaultSuite
+
+	procIRQPath string
+	sysCPUPath  string
+}
+
+func TestCPUIsolationSuite(t *testing.T) {
+	s := &CPUIsolationSuite{}
+
+	s.DefaultSuite = ctest.DefaultSuite{
+		AfterSetup: func(suite *ctest.DefaultSuite) {
+			s.procIRQPath = suite.T().TempDir()
+			s.sysCPUPath = suite.T().TempDir()
+
+			suite.Require().NoError(os.WriteFile(filepath.Join(s.procIRQPath, "default_smp_affinity"), []byte("ff\n"), 0o600))
+
+			for _, irq := range []string{"0", "24", "25"} {
+				suite.Require().NoError(os.Mkdir(filepath.Join(s.procIRQPath, irq), 0o755))
+				suite.Require().NoError(os.WriteFile(filepath.Join(s.procIRQPath, irq, "smp_affinity"), []byte("ff\n"), 0o600))
+			}
+
+			suite.Require().NoError(os.WriteFile(filepath.Join(s.sysCPUPath, "present"), []byte("0-7\n"), 0o600))
+			suite.Require().NoError(os.WriteFile(filepath.Join(s.sysCPUPath, "isolated"), []byte("\n"), 0o600))
+			suite.Require().NoError(os.WriteFile(filepath.Join(s.sysCPUPath, "nohz_full"), []byte("(null)\n"), 0o600))
+
+			suite.Require().NoError(suite.Runtime().RegisterController(&hardware.CPUIsolationController{
+				V1Alpha1Mode: v1alpha1runtime.ModeMetal,
+				ProcIRQPath:  s.procIRQPath,
+				SysCPUPath:   s.sysCPUPath,
+			}))
+		},
+	}
+
+	suite.Run(t, s)
+}
+
+func (suite *CPUIsolationSuite) assertAffinity(irq, expected string) {
+	contents, err := os.ReadFile(filepath.Join(suite.procIRQPath, irq, "smp_affinity"))
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal(expected, string(contents))
+}
+
+func (suite *CPUIsolationSuite) TestNoConfig() {
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), hardwareres.CPUIsolationStatusID, func(status *hardwareres.CPUIsolationStatus, asrt *assert.Assertions) {
+		asrt.Empty(status.TypedSpec().ConfiguredCPUs)
+		asrt.Empty(status.TypedSpec().IsolatedCPUs)
+		asrt.Empty(status.TypedSpec().NoHZFullCPUs)
+		asrt.Equal("0-7", status.TypedSpec().HousekeepingCPUs)
+		asrt.Equal("ff", status.TypedSpec().DefaultIRQAffinity)
+		asrt.False(status.TypedSpec().KernelArgsPending)
+	})
+
+	suite.assertAffinity("24", "ff\n")
+}
+
+func (suite *CPUIsolationSuite) TestIsolation() {
+	isolationConfig := hardwareconfigtype.NewCPUIsolationConfigV1Alpha1()
+	isolationConfig.IsolatedCPUsConfig = "2-5"
+
+	cfg, err := container.New(isolationConfig)
+	suite.Require().NoError(err)
+
+	machineConfig := config.NewMachineConfig(cfg)
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), machineConfig))
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), hardwareres.CPUIsolationStatusID, func(status *hardwareres.CPUIsolationStatus, asrt *assert.Assertions) {
+		asrt.Equal("2-5", status.TypedSpec().ConfiguredCPUs)
+		asrt.Empty(status.TypedSpec().IsolatedCPUs)
+		asrt.Equal("0-1,6-7", status.TypedSpec().HousekeepingCPUs)
+		asrt.Equal("c3", status.TypedSpec().DefaultIRQAffinity)
+		asrt.True(status.TypedSpec().KernelArgsPending)
+	})
+
+	for _, irq := range []string{"0", "24", "25"} {
+		suite.assertAffinity(irq, "c3")
+	}
+
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), machineConfig.Metadata()))
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), hardwareres.CPUIsolationStatusID, func(status *hardwareres.CPUIsolationStatus, asrt *assert.Assertions) {
+		asrt.Empty(status.TypedSpec().ConfiguredCPUs)
+		asrt.Equal("0-7", status.TypedSpec().HousekeepingCPUs)
+		asrt.Equal("ff", status.TypedSpec().DefaultIRQAffinity)
+		asrt.False(status.TypedSpec().KernelArgsPending)
+	})
+
+	for _, irq := range []string{"0", "24", "25"} {
+		suite.assertAffinity(irq, "ff")
+	}
+}
@@ -7,6 +7,7 @@ package k8s
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"slices"
 	"strconv"
 	"strings"
@@ -96,7 +97,37 @@ func NewControlPlaneAuditPolicyController() *ControlPlaneAuditPolicyController {
 			TransformFunc: func(ctx context.Context, r controller.Reader, logger *zap.Logger, machineConfig *config.MachineConfig, res *k8s.AuditPolicyConfig) error {
 				cfgProvider := machineConfig.Config()
 
-				res.TypedSpec().Config = cfgProvider.Cluster().APIServer().AuditPolicy()
+				// default log backend, used unless the audit config document overrides it
+				spec := k8s.AuditPolicyConfigSpec{
+					Config:       cfgProvider.Cluster().APIServer().AuditPolicy(),
+					LogPath:      filepath.Join(constants.KubernetesAuditLogDir, "kube-apiserver.log"),
+					LogMaxAge:    constants.KubernetesAuditLogMaxAge,
+					LogMaxBackup: constants.KubernetesAuditLogMaxBackup,
+					LogMaxSize:   constants.KubernetesAuditLogMaxSize,
+				}
+
+				if auditConfig := cfgProvider.KubeAPIServerAuditConfig(); auditConfig != nil {
+					if policy := auditConfig.AuditPolicy(); policy != nil {
+						spec.Config = policy
+					}
+
+					spec.LogPath, spec.LogMaxAge, spec.LogMaxBackup, spec.LogMaxSize = "", 0, 0, 0
+
+					if log := auditConfig.AuditLog(); log != nil {
+						spec.LogPath = log.Path()
+						spec.LogMaxAge = log.MaxAge()
+						spec.LogMaxBackup = log.MaxBackup()
+						spec.LogMaxSize = log.MaxSize()
+					}
+
+					if webhook := auditConfig.AuditWebhook(); webhook != nil {
+						spec.WebhookURL = webhook.URL()
+						spec.WebhookCACertificate = webhook.CACertificate()
+						spec.WebhookToken = webhook.Token()
+					}
+				}
+
+				*res.TypedSpec() = spec
 
 				return nil
 			},
@@ -350,6 +381,33 @@ func NewControlPlaneBootstrapManifestsController() *ControlPlaneBootstrapManifes
 					TalosAPIServiceEnabled: cfgProvider.Machine().Features().KubernetesTalosAPIAccess().Enabled(),
 				}
 
+				if bootstrapManifestsConfig := cfgProvider.BootstrapManifestsConfig(); bootstrapManifestsConfig != nil {
+					res.TypedSpec().ObjectPolicies = xslices.Map(bootstrapManifestsConfig.ObjectPolicies(), func(policy talosconfig.BootstrapManifestObjectPolicy) k8s.BootstrapManifestObjectPolicy {
+						return k8s.BootstrapManifestObjectPolicy{
+							Manifest:  policy.Manifest(),
+							Kind:      policy.Kind(),
+							Namespace: policy.Namespace(),
+							Name:      policy.Name(),
+							Policy:    policy.Policy(),
+						}
+					})
+				}
+
+				if coreDNSConfig := cfgProvider.CoreDNSConfig(); coreDNSConfig != nil {
+					spec := res.TypedSpec()
+
+					spec.CoreDNSOverridesEnabled = true
+					spec.CoreDNSStubDomains = xslices.Map(coreDNSConfig.StubDomains(), func(stubDomain talosconfig.CoreDNSStubDomain) k8s.CoreDNSStubDomain {
+						return k8s.CoreDNSStubDomain{
+							Domain:    stubDomain.Domain(),
+							Upstreams: stubDomain.Upstreams(),
+						}
+					})
+					spec.CoreDNSExtraServerBlocks = coreDNSConfig.ExtraServerBlocks()
+					spec.CoreDNSReplicas = coreDNSConfig.Replicas().ValueOrZero()
+					spec.CoreDNSResources = convertResources(coreDNSConfig.Resources())
+				}
+
 				return nil
 			},
 		},
@@ -66,12 +66,24 @@ func (ctrl *ControlPlaneStaticPodController) Inputs() []controller.Input {
 			Type:      k8s.SchedulerConfigType,
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: k8s.ControlPlaneNamespaceName,
+			Type:      k8s.AuditPolicyConfigType,
+			ID:        optional.Some(k8s.AuditPolicyConfigID),
+			Kind:      controller.InputWeak,
+		},
 		{
 			Namespace: k8s.ControlPlaneNamespaceName,
 			Type:      k8s.SecretsStatusType,
 			ID:        optional.Some(k8s.StaticPodSecretsStaticPodID),
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: k8s.ControlPlaneNamespaceName,
+			Type:      k8s.KubernetesCertSANStatusType,
+			ID:        optional.Some(k8s.APIServerID),
+			Kind:      controller.InputWeak,
+		},
 		{
 			Namespace: k8s.ControlPlaneNamespaceName,
 			Type:      k8s.ConfigStatusType,
@@ -378,10 +390,6 @@ func (ctrl *ControlPlaneStaticPodController) manageAPIServer(ctx context.Context
 		"tls-cipher-suites":                "TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305,TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305,TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,TLS_RSA_WITH_AES_256_GCM_SHA384,TLS_RSA_WITH_AES_128_GCM_SHA256", //nolint:lll
 		"encryption-provider-config":       filepath.Join(constants.KubernetesAPIServerSecretsDir, "encryptionconfig.yaml"),
 		"audit-policy-file":                filepath.Join(constants.KubernetesAPIServerConfigDir, "auditpolicy.yaml"),
-		"audit-log-path":                   filepath.Join(constants.KubernetesAuditLogDir, "kube-apiserver.log"),
-		"audit-log-maxage":                 "30",
-		"audit-log-maxbackup":              "10",
-		"audit-log-maxsize":                "100",
 		"profiling":                        "false",
 		"etcd-cafile":                      filepath.Join(constants.KubernetesAPIServerSecretsDir, "etcd-client-ca.crt"),
 		"etcd-certfile":                    filepath.Join(constants.KubernetesAPIServerSecretsDir, "etcd-client.crt"),
@@ -399,6 +407,22 @@ func (ctrl *ControlPlaneStaticPodController) manageAPIServer(ctx context.Context
 		"kubelet-preferred-address-types":  "InternalIP,ExternalIP,Hostname",
 	}
 
+	audit, err := ctrl.auditBackend(ctx, r)
+	if err != nil {
+		return "", err
+	}
+
+	if audit.LogPath != "" {
+		builder.Set("audit-log-path", audit.LogPath)
+		builder.Set("audit-log-maxage", strconv.Itoa(audit.LogMaxAge))
+		builder.Set("audit-log-maxbackup", strconv.Itoa(audit.LogMaxBackup))
+		builder.Set("audit-log-maxsize", strconv.Itoa(audit.LogMaxSize))
+	}
+
+	if audit.WebhookURL != "" {
+		builder.Set("audit-webhook-config-file", filepath.Join(constants.KubernetesAPIServerConfigDir, auditWebhookKubeconfigFilename))
+	}
+
 	if cfg.AdvertisedAddress != "" {
 		builder.Set("advertise-address", cfg.AdvertisedAddress)
 	}
@@ -433,9 +457,10 @@ func (ctrl *ControlPlaneStaticPodController) manageAPIServer(ctx context.Context
 		"tls-cert-file":                    argsbuilder.MergeDenied,
 		"tls-private-key-file":             argsbuilder.MergeDenied,
 		"authorization-config":             argsbuilder.MergeDenied,
+		"audit-webhook-config-file":        argsbuilder.MergeDenied,
 	}
 
-	if err := builder.Merge(cfg.ExtraArgs, argsbuilder.WithMergePolicies(mergePolicies)); err != nil {
+	if err = builder.Merge(cfg.ExtraArgs, argsbuilder.WithMergePolicies(mergePolicies)); err != nil {
 		return "", err
 	}
 
@@ -451,6 +476,22 @@ func (ctrl *ControlPlaneStaticPodController) manageAPIServer(ctx context.Context
 		env = append(env, goGCEnv)
 	}
 
+	annotations := map[string]string{
+		constants.AnnotationStaticPodSecretsVersion:    secretsVersion,
+		constants.AnnotationStaticPodConfigFileVersion: configVersion,
+		constants.AnnotationStaticPodConfigVersion:     configResource.Metadata().Version().String(),
+	}
+
+	// changing the SANs of the serving certificate restarts only kube-apiserver
+	certSANStatus, err := safe.ReaderGetByID[*k8s.KubernetesCertSANStatus](ctx, r, k8s.APIServerID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return "", fmt.Errorf("error getting cert SANs status: %w", err)
+	}
+
+	if certSANStatus != nil {
+		annotations[constants.AnnotationStaticPodCertSANsVersion] = certSANStatus.Metadata().Version().String()
+	}
+
 	return k8s.APIServerID, safe.WriterModify(ctx, r, k8s.NewStaticPod(k8s.NamespaceName, k8s.APIServerID), func(r *k8s.StaticPod) error {
 		return k8sadapter.StaticPod(r).SetPod(&v1.Pod{
 			TypeMeta: metav1.TypeMeta{
@@ -458,13 +499,9 @@ func (ctrl *ControlPlaneStaticPodController) manageAPIServer(ctx context.Context
 				Kind:       "Pod",
 			},
 			ObjectMeta: metav1.ObjectMeta{
-				Name:      k8s.APIServerID,
-				Namespace: "kube-system",
-				Annotations: map[string]string{
-					constants.AnnotationStaticPodSecretsVersion:    secretsVersion,
-					constants.AnnotationStaticPodConfigFileVersion: configVersion,
-					constants.AnnotationStaticPodConfigVersion:     configResource.Metadata().Version().String(),
-				},
+				Name:        k8s.APIServerID,
+				Namespace:   "kube-system",
+				Annotations: annotations,
 				Labels: map[string]string{
 					"tier":                         "control-plane",
 					"k8s-app":                      k8s.APIServerID,
@@ -506,12 +543,7 @@ func (ctrl *ControlPlaneStaticPodController) manageAPIServer(ctx context.Context
 								MountPath: constants.KubernetesAPIServerConfigDir,
 								ReadOnly:  true,
 							},
-							{
-								Name:      "audit",
-								MountPath: constants.KubernetesAuditLogDir,
-								ReadOnly:  false,
-							},
-						}, volumeMounts(cfg.ExtraVolumes)...),
+						}, append(auditVolumeMounts(audit), volumeMounts(cfg.ExtraVolumes)...)...),
 						Resources: resources,
 						SecurityContext: &v1.SecurityContext{
 							AllowPrivilegeEscalation: pointer.To(false),
@@ -551,20 +583,76 @@ func (ctrl *ControlPlaneStaticPodController) manageAPIServer(ctx context.Context
 							},
 						},
 					},
-					{
-						Name: "audit",
-						VolumeSource: v1.VolumeSource{
-							HostPath: &v1.HostPathVolumeSource{
-								Path: constants.KubernetesAuditLogDir,
-							},
-						},
-					},
-				}, volumes(cfg.ExtraVolumes)...),
+				}, append(auditVolumes(audit), volumes(cfg.ExtraVolumes)...)...),
 			},
 		})
 	})
 }
 
+// auditBackend returns the kube-apiserver audit backend configuration.
+//
+// If the audit policy config is not available yet, the default log backend is used.
+func (ctrl *ControlPlaneStaticPodController) auditBackend(ctx context.Context, r controller.Reader) (*k8s.AuditPolicyConfigSpec, error) {
+	auditConfig, err := safe.ReaderGetByID[*k8s.AuditPolicyConfig](ctx, r, k8s.AuditPolicyConfigID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return &k8s.AuditPolicyConfigSpec{
+				LogPath:      filepath.Join(constants.KubernetesAuditLogDir, "kube-apiserver.log"),
+				LogMaxAge:    constants.KubernetesAuditLogMaxAge,
+				LogMaxBackup: constants.KubernetesAuditLogMaxBackup,
+				LogMaxSize:   constants.KubernetesAuditLogMaxSize,
+			}, nil
+		}
+
+		return nil, fmt.Errorf("error getting audit policy config: %w", err)
+	}
+
+	return auditConfig.TypedSpec(), nil
+}
+
+// auditLogDir returns the host directory of the audit log file, or empty string if the audit log is not written to a file.
+func auditLogDir(audit *k8s.AuditPolicyConfigSpec) string {
+	// "-" sends the audit log to the standard output
+	if audit.LogPath == "" || audit.LogPath == "-" {
+		return ""
+	}
+
+	return filepath.Dir(audit.LogPath)
+}
+
+func auditVolumes(audit *k8s.AuditPolicyConfigSpec) []v1.Volume {
+	dir := auditLogDir(audit)
+	if dir == "" {
+		return nil
+	}
+
+	return []v1.Volume{
+		{
+			Name: "audit",
+			VolumeSource: v1.VolumeSource{
+				HostPath: &v1.HostPathVolumeSource{
+					Path: dir,
+				},
+			},
+		},
+	}
+}
+
+func auditVolumeMounts(audit *k8s.AuditPolicyConfigSpec) []v1.VolumeMount {
+	dir := auditLogDir(audit)
+	if dir == "" {
+		return nil
+	}
+
+	return []v1.VolumeMount{
+		{
+			Name:      "audit",
+			MountPath: dir,
+			ReadOnly:  false,
+		},
+	}
+}
+
 func (ctrl *ControlPlaneStaticPodController) manageControllerManager(ctx context.Context, r controller.Runtime,
 	_ *zap.Logger, configResource resource.Resource, secretsVersion, _ string,
 ) (string, error) {
@@ -14,6 +14,7 @@ import (
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 	v1 "k8s.io/api/core/v1"
@@ -339,6 +340,60 @@ func (suite *ControlPlaneStaticPodSuite) TestReconcileExtraArgsK8s() {
 	}
 }
 
+func (suite *ControlPlaneStaticPodSuite) TestReconcileAudit() {
+	configStatus := k8s.NewConfigStatus(k8s.ControlPlaneNamespaceName, k8s.ConfigStatusStaticPodID)
+	secretStatus := k8s.NewSecretsStatus(k8s.ControlPlaneNamespaceName, k8s.StaticPodSecretsStaticPodID)
+	configAPIServer := k8s.NewAPIServerConfig()
+	auditConfig := k8s.NewAuditPolicyConfig()
+	*auditConfig.TypedSpec() = k8s.AuditPolicyConfigSpec{
+		LogPath:      "/var/log/audit/custom/audit.log",
+		LogMaxAge:    7,
+		LogMaxBackup: 5,
+		LogMaxSize:   50,
+		WebhookURL:   "https://audit.example.com/events",
+	}
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), configStatus))
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), secretStatus))
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), configAPIServer))
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), auditConfig))
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), k8s.APIServerID, func(staticPod *k8s.StaticPod, assert *assert.Assertions) {
+		apiServerPod, err := k8sadapter.StaticPod(staticPod).Pod()
+		suite.Require().NoError(err)
+
+		assert.Subset(apiServerPod.Spec.Containers[0].Command, []string{
+			"--audit-log-path=/var/log/audit/custom/audit.log",
+			"--audit-log-maxage=7",
+			"--audit-log-maxbackup=5",
+			"--audit-log-maxsize=50",
+			"--audit-webhook-config-file=" + filepath.Join(constants.KubernetesAPIServerConfigDir, "audit-webhook-kubeconfig.yaml"),
+		})
+
+		assert.Contains(apiServerPod.Spec.Containers[0].VolumeMounts, v1.VolumeMount{
+			Name:      "audit",
+			MountPath: "/var/log/audit/custom",
+		})
+	})
+
+	// webhook only, audit log is disabled
+	auditConfig.TypedSpec().LogPath = ""
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), auditConfig))
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), k8s.APIServerID, func(staticPod *k8s.StaticPod, assert *assert.Assertions) {
+		apiServerPod, err := k8sadapter.StaticPod(staticPod).Pod()
+		suite.Require().NoError(err)
+
+		for _, arg := range apiServerPod.Spec.Containers[0].Command {
+			assert.False(strings.HasPrefix(arg, "--audit-log-path"), "unexpected arg %q", arg)
+		}
+
+		for _, volume := range apiServerPod.Spec.Volumes {
+			assert.NotEqual("audit", volume.Name)
+		}
+	})
+}
+
 func (suite *ControlPlaneStaticPodSuite) TestReconcileEnvironmentVariables() {
 	configStatus := k8s.NewConfigStatus(k8s.ControlPlaneNamespaceName, k8s.ConfigStatusStaticPodID)
 	secretStatus := k8s.NewSecretsStatus(k8s.ControlPlaneNamespaceName, k8s.StaticPodSecretsStaticPodID)
@@ -443,6 +498,53 @@ func (suite *ControlPlaneStaticPodSuite) TestReconcileAdvertisedAddressArg() {
 	})
 }
 
+func (suite *ControlPlaneStaticPodSuite) TestReconcileCertSANsVersion() {
+	configStatus := k8s.NewConfigStatus(k8s.ControlPlaneNamespaceName, k8s.ConfigStatusStaticPodID)
+	secretStatus := k8s.NewSecretsStatus(k8s.ControlPlaneNamespaceName, k8s.StaticPodSecretsStaticPodID)
+	configAPIServer := k8s.NewAPIServerConfig()
+	configControllerManager := k8s.NewControllerManagerConfig()
+	configControllerManager.TypedSpec().Enabled = true
+
+	suite.Create(configStatus)
+	suite.Create(secretStatus)
+	suite.Create(configAPIServer)
+	suite.Create(configControllerManager)
+
+	certSANStatus := k8s.NewKubernetesCertSANStatus(k8s.ControlPlaneNamespaceName, k8s.APIServerID)
+	certSANStatus.TypedSpec().DNSNames = []string{"kubernetes", "localhost"}
+	suite.Create(certSANStatus)
+
+	var controllerManagerVersion resource.Version
+
+	ctest.AssertResource(suite, k8s.ControllerManagerID, func(staticPod *k8s.StaticPod, _ *assert.Assertions) {
+		controllerManagerVersion = staticPod.Metadata().Version()
+	})
+
+	assertCertSANsVersion := func() {
+		current, err := safe.StateGetByID[*k8s.KubernetesCertSANStatus](suite.Ctx(), suite.State(), k8s.APIServerID)
+		suite.Require().NoError(err)
+
+		ctest.AssertResource(suite, k8s.APIServerID, func(staticPod *k8s.StaticPod, asrt *assert.Assertions) {
+			pod, err := k8sadapter.StaticPod(staticPod).Pod()
+			suite.Require().NoError(err)
+
+			asrt.Equal(current.Metadata().Version().String(), pod.Annotations[constants.AnnotationStaticPodCertSANsVersion])
+		})
+	}
+
+	assertCertSANsVersion()
+
+	// SANs change only updates kube-apiserver static pod
+	certSANStatus.TypedSpec().DNSNames = []string{"api.example.com", "kubernetes", "localhost"}
+	suite.Update(certSANStatus)
+
+	assertCertSANsVersion()
+
+	ctest.AssertResource(suite, k8s.ControllerManagerID, func(staticPod *k8s.StaticPod, asrt *assert.Assertions) {
+		asrt.True(staticPod.Metadata().Version().Equal(controllerManagerVersion))
+	})
+}
+
 func (suite *ControlPlaneStaticPodSuite) TestControlPlaneStaticPodsExceptScheduler() {
 	configStatus := k8s.NewConfigStatus(k8s.ControlPlaneNamespaceName, k8s.ConfigStatusStaticPodID)
 	secretStatus := k8s.NewSecretsStatus(k8s.ControlPlaneNamespaceName, k8s.StaticPodSecretsStaticPodID)
@@ -20,6 +20,7 @@ import (
 	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
 	k8sctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/k8s"
 	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	k8scfg "github.com/siderolabs/talos/pkg/machinery/config/types/k8s"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
@@ -95,6 +96,110 @@ func (suite *K8sControlPlaneSuite) TestReconcileDefaults() {
 			assert.Equal(v1alpha1.APIServerDefaultAuthorizationConfigAuthorizers, authorizationConfig.TypedSpec().Config)
 		},
 	)
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{k8s.AuditPolicyConfigID},
+		func(audit *k8s.AuditPolicyConfig, assert *assert.Assertions) {
+			assert.Equal(v1alpha1.APIServerDefaultAuditPolicy.Object, audit.TypedSpec().Config)
+			assert.Equal(constants.KubernetesAuditLogDir+"/kube-apiserver.log", audit.TypedSpec().LogPath)
+			assert.Equal(constants.KubernetesAuditLogMaxAge, audit.TypedSpec().LogMaxAge)
+			assert.Empty(audit.TypedSpec().WebhookURL)
+		},
+	)
+}
+
+func (suite *K8sControlPlaneSuite) TestReconcileAuditConfig() {
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	auditConfig := k8scfg.NewKubeAPIServerAuditConfigV1Alpha1()
+	auditConfig.PolicyConfig = `apiVersion: audit.k8s.io/v1
+kind: Policy
+rules:
+  - level: RequestResponse
+`
+	auditConfig.WebhookConfig = &k8scfg.KubeAPIServerAuditWebhookConfig{
+		WebhookURL:   "https://audit.example.com/events",
+		WebhookToken: "secret",
+	}
+
+	ctr, err := container.New(
+		&v1alpha1.Config{
+			ConfigVersion: "v1alpha1",
+			MachineConfig: &v1alpha1.MachineConfig{
+				MachineType: "controlplane",
+			},
+			ClusterConfig: &v1alpha1.ClusterConfig{
+				ControlPlane: &v1alpha1.ControlPlaneConfig{
+					Endpoint: &v1alpha1.Endpoint{
+						URL: u,
+					},
+				},
+			},
+		},
+		auditConfig,
+	)
+	suite.Require().NoError(err)
+
+	suite.setupMachine(config.NewMachineConfig(ctr))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{k8s.AuditPolicyConfigID},
+		func(audit *k8s.AuditPolicyConfig, assert *assert.Assertions) {
+			spec := audit.TypedSpec()
+
+			assert.Equal([]any{map[string]any{"level": "RequestResponse"}}, spec.Config["rules"])
+			assert.Empty(spec.LogPath)
+			assert.Equal("https://audit.example.com/events", spec.WebhookURL)
+			assert.Equal("secret", spec.WebhookToken)
+		},
+	)
+}
+
+func (suite *K8sControlPlaneSuite) TestReconcileBootstrapManifestsObjectPolicies() {
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	bootstrapManifestsConfig := k8scfg.NewBootstrapManifestsConfigV1Alpha1()
+	bootstrapManifestsConfig.ObjectPoliciesConfig = []k8scfg.BootstrapManifestObjectPolicyConfig{
+		{
+			ObjectKind:      "ConfigMap",
+			ObjectNamespace: "kube-system",
+			ObjectName:      "coredns",
+			ObjectPolicy:    "create-only",
+		},
+	}
+
+	ctr, err := container.New(
+		&v1alpha1.Config{
+			ConfigVersion: "v1alpha1",
+			MachineConfig: &v1alpha1.MachineConfig{
+				MachineType: "controlplane",
+			},
+			ClusterConfig: &v1alpha1.ClusterConfig{
+				ControlPlane: &v1alpha1.ControlPlaneConfig{
+					Endpoint: &v1alpha1.Endpoint{
+						URL: u,
+					},
+				},
+			},
+		},
+		bootstrapManifestsConfig,
+	)
+	suite.Require().NoError(err)
+
+	suite.setupMachine(config.NewMachineConfig(ctr))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{k8s.BootstrapManifestsConfigID},
+		func(bootstrapConfig *k8s.BootstrapManifestsConfig, assert *assert.Assertions) {
+			assert.Equal([]k8s.BootstrapManifestObjectPolicy{
+				{
+					Kind:      "ConfigMap",
+					Namespace: "kube-system",
+					Name:      "coredns",
+					Policy:    "create-only",
+				},
+			}, bootstrapConfig.TypedSpec().ObjectPolicies)
+		},
+	)
 }
 
 func (suite *K8sControlPlaneSuite) TestReconcileEmptyAuthorizationConfigForK8sLessThanv128() {
@@ -7,6 +7,7 @@ package k8stemplates
 import (
 	"cmp"
 	"fmt"
+	"strings"
 
 	"github.com/siderolabs/go-pointer"
 	appsv1 "k8s.io/api/apps/v1"
@@ -199,6 +200,20 @@ func CoreDNSConfigMap(spec *k8s.BootstrapManifestsConfigSpec) runtime.Object {
 }
 `
 
+	for _, stubDomain := range spec.CoreDNSStubDomains {
+		coreDNSConfig += fmt.Sprintf(`
+%s:53 {
+    errors
+    cache 30
+    forward . %s
+}
+`, stubDomain.Domain, strings.Join(stubDomain.Upstreams, " "))
+	}
+
+	if spec.CoreDNSExtraServerBlocks != "" {
+		coreDNSConfig += "\n" + strings.TrimRight(spec.CoreDNSExtraServerBlocks, "\n") + "\n"
+	}
+
 	return &corev1.ConfigMap{
 		TypeMeta: v1.TypeMeta{
 			Kind:       "ConfigMap",
@@ -216,6 +231,32 @@ func CoreDNSConfigMap(spec *k8s.BootstrapManifestsConfigSpec) runtime.Object {
 
 // CoreDNSDeployment returns the CoreDNS Deployment object.
 func CoreDNSDeployment(spec *k8s.BootstrapManifestsConfigSpec) runtime.Object {
+	replicas := int32(2)
+
+	if spec.CoreDNSReplicas > 0 {
+		replicas = int32(spec.CoreDNSReplicas)
+	}
+
+	resources := corev1.ResourceRequirements{
+		Limits: corev1.ResourceList{
+			corev1.ResourceMemory: resource.MustParse("170Mi"),
+		},
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("100m"),
+			corev1.ResourceMemory: resource.MustParse("70Mi"),
+		},
+	}
+
+	overrideResources(resources.Requests, spec.CoreDNSResources.Requests)
+	overrideResources(resources.Limits, spec.CoreDNSResources.Limits)
+
+	// GOMEMLIMIT is set to ~95% of the memory limit
+	goMemLimit := "161MiB"
+
+	if memoryLimit, ok := resources.Limits[corev1.ResourceMemory]; ok && spec.CoreDNSResources.Limits[string(corev1.ResourceMemory)] != "" {
+		goMemLimit = fmt.Sprintf("%dMiB", memoryLimit.Value()*95/100/(1024*1024))
+	}
+
 	return &appsv1.Deployment{
 		TypeMeta: v1.TypeMeta{
 			Kind:       "Deployment",
@@ -230,7 +271,7 @@ func CoreDNSDeployment(spec *k8s.BootstrapManifestsConfigSpec) runtime.Object {
 			},
 		},
 		Spec: appsv1.DeploymentSpec{
-			Replicas: pointer.To(int32(2)),
+			Replicas: pointer.To(replicas),
 			Strategy: appsv1.DeploymentStrategy{
 				Type: appsv1.RollingUpdateDeploymentStrategyType,
 				RollingUpdate: &appsv1.RollingUpdateDeployment{
@@ -292,19 +333,11 @@ func CoreDNSDeployment(spec *k8s.BootstrapManifestsConfigSpec) runtime.Object {
 							Name:            "coredns",
 							Image:           spec.CoreDNSImage,
 							ImagePullPolicy: corev1.PullIfNotPresent,
-							Resources: corev1.ResourceRequirements{
-								Limits: corev1.ResourceList{
-									corev1.ResourceMemory: resource.MustParse("170Mi"),
-								},
-								Requests: corev1.ResourceList{
-									corev1.ResourceCPU:    resource.MustParse("100m"),
-									corev1.ResourceMemory: resource.MustParse("70Mi"),
-								},
-							},
+							Resources:       resources,
 							Env: []corev1.EnvVar{
 								{
 									Name:  "GOMEMLIMIT",
-									Value: "161MiB",
+									Value: goMemLimit,
 								},
 							},
 							Args: []string{"-conf", "/etc/coredns/Corefile"},
@@ -388,3 +421,21 @@ func CoreDNSDeployment(spec *k8s.BootstrapManifestsConfigSpec) runtime.Object {
 		},
 	}
 }
+
+// overrideResources replaces the default resource quantities with the overrides.
+//
+// Invalid quantities are ignored, as they are rejected by the machine config validation.
+func overrideResources(resources corev1.ResourceList, overrides map[string]string) {
+	for name, value := range overrides {
+		if value == "" {
+			continue
+		}
+
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil {
+			continue
+		}
+
+		resources[corev1.ResourceName(name)] = quantity
+	}
+}
@@ -106,6 +106,39 @@ func TestTemplates(t *testing.T) {
 				})
 			},
 		},
+		{
+			name: "coredns-configmap-overrides",
+			obj: func() runtime.Object {
+				return k8stemplates.CoreDNSConfigMap(&k8s.BootstrapManifestsConfigSpec{
+					ClusterDomain: "cluster.local",
+					CoreDNSStubDomains: []k8s.CoreDNSStubDomain{
+						{
+							Domain:    "corp.example.com",
+							Upstreams: []string{"10.0.0.53", "tls://10.0.1.53"},
+						},
+					},
+					CoreDNSExtraServerBlocks: "example.org:53 {\n    errors\n    forward . 192.168.1.53\n}\n",
+				})
+			},
+		},
+		{
+			name: "coredns-deployment-overrides",
+			obj: func() runtime.Object {
+				return k8stemplates.CoreDNSDeployment(&k8s.BootstrapManifestsConfigSpec{
+					CoreDNSImage:    "coredns/coredns:1.9.3",
+					CoreDNSReplicas: 3,
+					CoreDNSResources: k8s.Resources{
+						Requests: map[string]string{
+							"cpu":    "200m",
+							"memory": "",
+						},
+						Limits: map[string]string{
+							"memory": "256Mi",
+						},
+					},
+				})
+			},
+		},
 		{
 			name: "kubelet-bootstrapping-token",
 			obj: func() runtime.Object {
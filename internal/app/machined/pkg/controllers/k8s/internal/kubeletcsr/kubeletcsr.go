@@ -0,0 +1,143 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package kubeletcsr implements verification of the kubelet serving certificate signing requests.
+package kubeletcsr
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+
+	certificatesv1 "k8s.io/api/certificates/v1"
+)
+
+const (
+	nodeUserPrefix = "system:node:"
+	nodesGroup     = "system:nodes"
+)
+
+// SANMismatchError is returned when the requested DNS name or IP address doesn't belong to the node.
+type SANMismatchError struct {
+	SAN string
+}
+
+// Error implements error interface.
+func (e *SANMismatchError) Error() string {
+	return fmt.Sprintf("requested SAN %q doesn't match the node hostname and addresses", e.SAN)
+}
+
+// NodeIdentity is the set of the names and addresses of the node known to Talos.
+type NodeIdentity struct {
+	Nodename  string
+	Hostnames []string
+	Addresses []netip.Addr
+}
+
+// IsKubeletServing returns true if the CSR requests a kubelet serving certificate.
+func IsKubeletServing(csr *certificatesv1.CertificateSigningRequest) bool {
+	return csr.Spec.SignerName == certificatesv1.KubeletServingSignerName
+}
+
+// IsPending returns true if the CSR is neither approved, denied nor failed.
+func IsPending(csr *certificatesv1.CertificateSigningRequest) bool {
+	return !slices.ContainsFunc(csr.Status.Conditions, func(condition certificatesv1.CertificateSigningRequestCondition) bool {
+		switch condition.Type { //nolint:exhaustive
+		case certificatesv1.CertificateApproved, certificatesv1.CertificateDenied, certificatesv1.CertificateFailed:
+			return true
+		default:
+			return false
+		}
+	})
+}
+
+// Nodename returns the name of the node which created the CSR.
+func Nodename(csr *certificatesv1.CertificateSigningRequest) (string, bool) {
+	nodename, ok := strings.CutPrefix(csr.Spec.Username, nodeUserPrefix)
+
+	return nodename, ok && nodename != ""
+}
+
+// Verify checks that the CSR is a kubelet serving certificate request of the node,
+// and that the requested DNS names and IP addresses belong to the node.
+//
+//nolint:gocyclo,cyclop
+func Verify(csr *certificatesv1.CertificateSigningRequest, identity NodeIdentity) error {
+	if !IsKubeletServing(csr) {
+		return fmt.Errorf("unexpected signer name %q", csr.Spec.SignerName)
+	}
+
+	expectedUsername := nodeUserPrefix + identity.Nodename
+
+	if csr.Spec.Username != expectedUsername {
+		return fmt.Errorf("CSR is created by %q, expected %q", csr.Spec.Username, expectedUsername)
+	}
+
+	if !slices.Contains(csr.Spec.Groups, nodesGroup) {
+		return fmt.Errorf("CSR requester is not in the %q group", nodesGroup)
+	}
+
+	if !slices.Contains(csr.Spec.Usages, certificatesv1.UsageServerAuth) {
+		return fmt.Errorf("CSR doesn't request %q usage", certificatesv1.UsageServerAuth)
+	}
+
+	for _, usage := range csr.Spec.Usages {
+		switch usage { //nolint:exhaustive
+		case certificatesv1.UsageDigitalSignature, certificatesv1.UsageKeyEncipherment, certificatesv1.UsageServerAuth:
+		default:
+			return fmt.Errorf("unexpected usage %q", usage)
+		}
+	}
+
+	block, _ := pem.Decode(csr.Spec.Request)
+	if block == nil || block.Type != "CERTIFICATE REQUEST" {
+		return errors.New("CSR doesn't contain a PEM-encoded certificate request")
+	}
+
+	req, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return fmt.Errorf("error parsing certificate request: %w", err)
+	}
+
+	if err = req.CheckSignature(); err != nil {
+		return fmt.Errorf("invalid certificate request signature: %w", err)
+	}
+
+	if req.Subject.CommonName != expectedUsername {
+		return fmt.Errorf("unexpected common name %q, expected %q", req.Subject.CommonName, expectedUsername)
+	}
+
+	if !slices.Equal(req.Subject.Organization, []string{nodesGroup}) {
+		return fmt.Errorf("unexpected organization %q, expected %q", req.Subject.Organization, nodesGroup)
+	}
+
+	if len(req.EmailAddresses) > 0 || len(req.URIs) > 0 {
+		return errors.New("email and URI SANs are not allowed")
+	}
+
+	if len(req.DNSNames) == 0 && len(req.IPAddresses) == 0 {
+		return errors.New("certificate request doesn't contain any DNS or IP SANs")
+	}
+
+	for _, dnsName := range req.DNSNames {
+		if !strings.EqualFold(dnsName, identity.Nodename) && !slices.ContainsFunc(identity.Hostnames, func(hostname string) bool {
+			return strings.EqualFold(dnsName, hostname)
+		}) {
+			return &SANMismatchError{SAN: dnsName}
+		}
+	}
+
+	for _, ip := range req.IPAddresses {
+		addr, ok := netip.AddrFromSlice(ip)
+		if !ok || !slices.Contains(identity.Addresses, addr.Unmap()) {
+			return &SANMismatchError{SAN: ip.String()}
+		}
+	}
+
+	return nil
+}
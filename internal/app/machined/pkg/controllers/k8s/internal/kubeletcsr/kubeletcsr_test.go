@@ -0,0 +1,262 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kubeletcsr_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/k8s/internal/kubeletcsr"
+)
+
+type csrOptions struct {
+	username     string
+	commonName   string
+	organization []string
+	dnsNames     []string
+	ipAddresses  []string
+	usages       []certificatesv1.KeyUsage
+}
+
+func newCSR(t *testing.T, opts csrOptions) *certificatesv1.CertificateSigningRequest {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.CertificateRequest{
+		Subject: pkix.Name{
+			CommonName:   opts.commonName,
+			Organization: opts.organization,
+		},
+		DNSNames: opts.dnsNames,
+	}
+
+	for _, ip := range opts.ipAddresses {
+		template.IPAddresses = append(template.IPAddresses, net.ParseIP(ip))
+	}
+
+	der, err := x509.CreateCertificateRequest(rand.Reader, template, key)
+	require.NoError(t, err)
+
+	return &certificatesv1.CertificateSigningRequest{
+		Spec: certificatesv1.CertificateSigningRequestSpec{
+			Request:    pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der}),
+			SignerName: certificatesv1.KubeletServingSignerName,
+			Usages:     opts.usages,
+			Username:   opts.username,
+			Groups:     []string{"system:nodes", "system:authenticated"},
+		},
+	}
+}
+
+func kubeletCSROptions(nodename string, ipAddresses ...string) csrOptions {
+	return csrOptions{
+		username:     "system:node:" + nodename,
+		commonName:   "system:node:" + nodename,
+		organization: []string{"system:nodes"},
+		dnsNames:     []string{nodename},
+		ipAddresses:  ipAddresses,
+		usages: []certificatesv1.KeyUsage{
+			certificatesv1.UsageDigitalSignature,
+			certificatesv1.UsageKeyEncipherment,
+			certificatesv1.UsageServerAuth,
+		},
+	}
+}
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	identity := kubeletcsr.NodeIdentity{
+		Nodename:  "worker-1",
+		Hostnames: []string{"worker-1.example.com"},
+		Addresses: []netip.Addr{netip.MustParseAddr("172.20.0.5"), netip.MustParseAddr("fd00::5")},
+	}
+
+	for _, test := range []struct {
+		name string
+		opts func() csrOptions
+
+		expectedError    string
+		expectedMismatch bool
+	}{
+		{
+			name: "valid",
+			opts: func() csrOptions {
+				return kubeletCSROptions("worker-1", "172.20.0.5", "fd00::5")
+			},
+		},
+		{
+			name: "valid with FQDN",
+			opts: func() csrOptions {
+				opts := kubeletCSROptions("worker-1", "172.20.0.5")
+				opts.dnsNames = append(opts.dnsNames, "Worker-1.example.com")
+
+				return opts
+			},
+		},
+		{
+			name: "spoofed DNS name",
+			opts: func() csrOptions {
+				opts := kubeletCSROptions("worker-1", "172.20.0.5")
+				opts.dnsNames = append(opts.dnsNames, "kubernetes.default.svc")
+
+				return opts
+			},
+
+			expectedError:    `requested SAN "kubernetes.default.svc" doesn't match the node hostname and addresses`,
+			expectedMismatch: true,
+		},
+		{
+			name: "spoofed IP address",
+			opts: func() csrOptions {
+				return kubeletCSROptions("worker-1", "172.20.0.5", "10.96.0.1")
+			},
+
+			expectedError:    `requested SAN "10.96.0.1" doesn't match the node hostname and addresses`,
+			expectedMismatch: true,
+		},
+		{
+			name: "other node name",
+			opts: func() csrOptions {
+				opts := kubeletCSROptions("worker-1", "172.20.0.5")
+				opts.dnsNames = []string{"worker-2"}
+
+				return opts
+			},
+
+			expectedError:    `requested SAN "worker-2" doesn't match the node hostname and addresses`,
+			expectedMismatch: true,
+		},
+		{
+			name: "requested by other node",
+			opts: func() csrOptions {
+				opts := kubeletCSROptions("worker-1", "172.20.0.5")
+				opts.username = "system:node:worker-2"
+
+				return opts
+			},
+
+			expectedError: `CSR is created by "system:node:worker-2", expected "system:node:worker-1"`,
+		},
+		{
+			name: "common name mismatch",
+			opts: func() csrOptions {
+				opts := kubeletCSROptions("worker-1", "172.20.0.5")
+				opts.commonName = "system:node:worker-2"
+
+				return opts
+			},
+
+			expectedError: `unexpected common name "system:node:worker-2", expected "system:node:worker-1"`,
+		},
+		{
+			name: "client auth usage",
+			opts: func() csrOptions {
+				opts := kubeletCSROptions("worker-1", "172.20.0.5")
+				opts.usages = append(opts.usages, certificatesv1.UsageClientAuth)
+
+				return opts
+			},
+
+			expectedError: `unexpected usage "client auth"`,
+		},
+		{
+			name: "no SANs",
+			opts: func() csrOptions {
+				opts := kubeletCSROptions("worker-1")
+				opts.dnsNames = nil
+
+				return opts
+			},
+
+			expectedError: "certificate request doesn't contain any DNS or IP SANs",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := kubeletcsr.Verify(newCSR(t, test.opts()), identity)
+
+			if test.expectedError == "" {
+				require.NoError(t, err)
+
+				return
+			}
+
+			require.EqualError(t, err, test.expectedError)
+
+			var mismatchErr *kubeletcsr.SANMismatchError
+
+			assert.Equal(t, test.expectedMismatch, errors.As(err, &mismatchErr))
+		})
+	}
+}
+
+func TestVerifyIPChange(t *testing.T) {
+	t.Parallel()
+
+	identity := kubeletcsr.NodeIdentity{
+		Nodename:  "worker-1",
+		Addresses: []netip.Addr{netip.MustParseAddr("172.20.0.5")},
+	}
+
+	oldAddressCSR := newCSR(t, kubeletCSROptions("worker-1", "172.20.0.5"))
+	newAddressCSR := newCSR(t, kubeletCSROptions("worker-1", "172.20.0.6"))
+
+	require.NoError(t, kubeletcsr.Verify(oldAddressCSR, identity))
+	require.EqualError(t, kubeletcsr.Verify(newAddressCSR, identity), `requested SAN "172.20.0.6" doesn't match the node hostname and addresses`)
+
+	// node address changes
+	identity.Addresses = []netip.Addr{netip.MustParseAddr("172.20.0.6")}
+
+	require.NoError(t, kubeletcsr.Verify(newAddressCSR, identity))
+	require.EqualError(t, kubeletcsr.Verify(oldAddressCSR, identity), `requested SAN "172.20.0.5" doesn't match the node hostname and addresses`)
+}
+
+func TestRenewal(t *testing.T) {
+	t.Parallel()
+
+	identity := kubeletcsr.NodeIdentity{
+		Nodename:  "worker-1",
+		Addresses: []netip.Addr{netip.MustParseAddr("172.20.0.5")},
+	}
+
+	initial := newCSR(t, kubeletCSROptions("worker-1", "172.20.0.5"))
+	initial.Status.Conditions = []certificatesv1.CertificateSigningRequestCondition{
+		{
+			Type:   certificatesv1.CertificateApproved,
+			Status: corev1.ConditionTrue,
+		},
+	}
+
+	assert.False(t, kubeletcsr.IsPending(initial))
+
+	// renewal request is a new CSR with a new key for the same node
+	renewal := newCSR(t, kubeletCSROptions("worker-1", "172.20.0.5"))
+
+	assert.True(t, kubeletcsr.IsKubeletServing(renewal))
+	assert.True(t, kubeletcsr.IsPending(renewal))
+
+	nodename, ok := kubeletcsr.Nodename(renewal)
+	require.True(t, ok)
+	assert.Equal(t, "worker-1", nodename)
+
+	require.NoError(t, kubeletcsr.Verify(renewal, identity))
+}
@@ -0,0 +1,108 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kubeletcsr
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/informers"
+	informersv1 "k8s.io/client-go/informers/certificates/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/siderolabs/talos/pkg/kubernetes"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// Watcher watches the kubelet serving certificate signing requests.
+type Watcher struct {
+	client *kubernetes.Client
+
+	csrs informersv1.CertificateSigningRequestInformer
+}
+
+// NewWatcher creates new kubelet serving CSR watcher.
+func NewWatcher(client *kubernetes.Client) *Watcher {
+	return &Watcher{
+		client: client,
+	}
+}
+
+// List returns the kubelet serving CSRs.
+func (w *Watcher) List() ([]*certificatesv1.CertificateSigningRequest, error) {
+	return w.csrs.Lister().List(labels.Everything())
+}
+
+// Watch starts watching CSRs and notifies on updates via notify channel.
+func (w *Watcher) Watch(ctx context.Context, logger *zap.Logger) (<-chan struct{}, <-chan error, func(), error) {
+	logger.Debug("starting kubelet serving CSR watcher")
+
+	informerFactory := informers.NewSharedInformerFactoryWithOptions(
+		w.client.Clientset,
+		constants.KubernetesInformerDefaultResyncPeriod,
+		informers.WithTweakListOptions(
+			func(opts *metav1.ListOptions) {
+				opts.FieldSelector = fields.OneTermEqualSelector("spec.signerName", certificatesv1.KubeletServingSignerName).String()
+			},
+		),
+	)
+
+	notifyCh := make(chan struct{}, 1)
+	watchErrCh := make(chan error, 1)
+
+	notify := func(_ any) {
+		select {
+		case notifyCh <- struct{}{}:
+		default:
+		}
+	}
+
+	w.csrs = informerFactory.Certificates().V1().CertificateSigningRequests()
+
+	if err := w.csrs.Informer().SetWatchErrorHandler(func(r *cache.Reflector, err error) {
+		select {
+		case watchErrCh <- err:
+		default:
+		}
+	}); err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to set watch error handler: %w", err)
+	}
+
+	if _, err := w.csrs.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    notify,
+		UpdateFunc: func(_, _ any) { notify(nil) },
+	}); err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to add event handler: %w", err)
+	}
+
+	informerFactory.Start(ctx.Done())
+
+	go func() {
+		logger.Debug("waiting for CSR cache sync")
+
+		result := informerFactory.WaitForCacheSync(ctx.Done())
+
+		var synced bool
+
+		// result should contain a single entry
+		for _, v := range result {
+			synced = v
+		}
+
+		logger.Debug("CSR cache sync done", zap.Bool("synced", synced))
+
+		select {
+		case notifyCh <- struct{}{}:
+		default:
+		}
+	}()
+
+	return notifyCh, watchErrCh, informerFactory.Shutdown, nil
+}
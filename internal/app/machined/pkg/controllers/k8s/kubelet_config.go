@@ -18,6 +18,7 @@ import (
 	"github.com/siderolabs/gen/xslices"
 	"go.uber.org/zap"
 
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
 )
@@ -73,12 +74,20 @@ func NewKubeletConfigController() *KubeletConfigController {
 				kubeletConfig.ExtraConfig = cfgProvider.Machine().Kubelet().ExtraConfig()
 				kubeletConfig.CloudProviderExternal = cfgProvider.Cluster().ExternalCloudProvider().Enabled()
 				kubeletConfig.DefaultRuntimeSeccompEnabled = cfgProvider.Machine().Kubelet().DefaultRuntimeSeccompProfileEnabled()
+
+				// the Localhost default profile is applied via the CRI base runtime spec, so the kubelet shouldn't override it
+				if profiles := cfgProvider.DefaultSecurityProfilesConfig(); profiles != nil && profiles.SeccompProfileType() != "" {
+					kubeletConfig.DefaultRuntimeSeccompEnabled = profiles.SeccompProfileType() == talosconfig.SecurityProfileRuntimeDefault
+				}
+
 				kubeletConfig.SkipNodeRegistration = cfgProvider.Machine().Kubelet().SkipNodeRegistration()
 				kubeletConfig.StaticPodListURL = staticPodURL.TypedSpec().URL
 				kubeletConfig.DisableManifestsDirectory = cfgProvider.Machine().Kubelet().DisableManifestsDirectory()
 				kubeletConfig.EnableFSQuotaMonitoring = cfgProvider.Machine().Features().DiskQuotaSupportEnabled()
 				kubeletConfig.CredentialProviderConfig = cfgProvider.Machine().Kubelet().CredentialProviderConfig()
 				kubeletConfig.AllowSchedulingOnControlPlane = cfgProvider.Cluster().ScheduleOnControlPlanes()
+				kubeletConfig.ShutdownGracePeriod = cfgProvider.Machine().Kubelet().ShutdownGracePeriod()
+				kubeletConfig.ShutdownGracePeriodCriticalPods = cfgProvider.Machine().Kubelet().ShutdownGracePeriodCriticalPods()
 
 				return nil
 			},
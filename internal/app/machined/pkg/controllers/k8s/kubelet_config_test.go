@@ -24,7 +24,9 @@ import (
 	"go.uber.org/zap/zaptest"
 
 	k8sctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/k8s"
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	runtimecfg "github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
@@ -249,6 +251,73 @@ func (suite *KubeletConfigSuite) TestReconcileDefaults() {
 	)
 }
 
+func (suite *KubeletConfigSuite) TestReconcileDefaultSecurityProfiles() {
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	suite.createStaticPodServerStatus()
+
+	profiles := runtimecfg.NewDefaultSecurityProfilesV1Alpha1()
+	profiles.SeccompConfig = &runtimecfg.SeccompDefaultProfileConfig{
+		SeccompType:    talosconfig.SecurityProfileLocalhost,
+		SeccompProfile: `{"defaultAction":"SCMP_ACT_ERRNO"}`,
+	}
+
+	ctr, err := container.New(
+		&v1alpha1.Config{
+			ConfigVersion: "v1alpha1",
+			MachineConfig: &v1alpha1.MachineConfig{
+				MachineKubelet: &v1alpha1.KubeletConfig{
+					KubeletImage: "kubelet",
+					KubeletDefaultRuntimeSeccompProfileEnabled: pointer.To(true),
+				},
+			},
+			ClusterConfig: &v1alpha1.ClusterConfig{
+				ControlPlane: &v1alpha1.ControlPlaneConfig{
+					Endpoint: &v1alpha1.Endpoint{
+						URL: u,
+					},
+				},
+				ClusterNetwork: &v1alpha1.ClusterNetworkConfig{
+					ServiceSubnet: []string{constants.DefaultIPv4ServiceNet},
+				},
+			},
+		},
+		profiles,
+	)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, config.NewMachineConfig(ctr)))
+
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				kubeletConfig, err := suite.state.Get(
+					suite.ctx,
+					resource.NewMetadata(
+						k8s.NamespaceName,
+						k8s.KubeletConfigType,
+						k8s.KubeletID,
+						resource.VersionUndefined,
+					),
+				)
+				if err != nil {
+					if state.IsNotFoundError(err) {
+						return retry.ExpectedError(err)
+					}
+
+					return err
+				}
+
+				// the Localhost profile is applied by the CRI, the kubelet shouldn't default to RuntimeDefault
+				suite.Assert().False(kubeletConfig.(*k8s.KubeletConfig).TypedSpec().DefaultRuntimeSeccompEnabled)
+
+				return nil
+			},
+		),
+	)
+}
+
 func (suite *KubeletConfigSuite) TearDownTest() {
 	suite.T().Log("tear down")
 
@@ -0,0 +1,398 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/k8s/internal/kubeletcsr"
+	"github.com/siderolabs/talos/pkg/kubernetes"
+	"github.com/siderolabs/talos/pkg/machinery/resources/cluster"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+// csrMismatchGracePeriod is the time mismatching CSRs are kept pending before being denied.
+//
+// Node addresses might be updated in the cluster discovery after the kubelet requests a new certificate.
+const csrMismatchGracePeriod = time.Minute
+
+// KubeletCSRApproverController approves kubelet serving certificate signing requests matching the node identity.
+type KubeletCSRApproverController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *KubeletCSRApproverController) Name() string {
+	return "k8s.KubeletCSRApproverController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *KubeletCSRApproverController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.ActiveID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: secrets.NamespaceName,
+			Type:      secrets.KubernetesType,
+			ID:        optional.Some(secrets.KubernetesID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: cluster.NamespaceName,
+			Type:      cluster.MemberType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *KubeletCSRApproverController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo,cyclop
+func (ctrl *KubeletCSRApproverController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	var (
+		kubernetesClient *kubernetes.Client
+		kubeconfig       string
+		watcher          *kubeletcsr.Watcher
+		watchCtxCancel   context.CancelFunc
+		notifyCh         <-chan struct{}
+		watchErrCh       <-chan error
+		notifyCloser     func()
+		watchErrors      int
+		watchReady       bool
+		requeueTimer     *time.Timer
+		requeueCh        <-chan time.Time
+	)
+
+	closeWatcher := func() {
+		if watchCtxCancel != nil {
+			watchCtxCancel()
+			watchCtxCancel = nil
+		}
+
+		if notifyCloser != nil {
+			notifyCloser()
+			notifyCloser = nil
+			notifyCh = nil
+			watchErrCh = nil
+		}
+
+		if kubernetesClient != nil {
+			kubernetesClient.Close() //nolint:errcheck
+
+			kubernetesClient = nil
+		}
+
+		watchErrors = 0
+		watchReady = false
+		watcher = nil
+	}
+
+	defer closeWatcher()
+
+	defer func() {
+		if requeueTimer != nil {
+			requeueTimer.Stop()
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-requeueCh:
+			requeueCh = nil
+		case <-notifyCh:
+			watchErrors = 0
+			watchReady = true
+		case watchErr := <-watchErrCh:
+			logger.Error("CSR watch error", zap.Error(watchErr), zap.Int("error_count", watchErrors))
+
+			watchErrors++
+
+			if watchErrors >= watchErrorsThreshold {
+				closeWatcher()
+			} else {
+				// keep waiting
+				continue
+			}
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.ActiveID)
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting machine config: %w", err)
+			}
+
+			closeWatcher()
+
+			continue
+		}
+
+		if cfg.Config().Machine() == nil || cfg.Config().Cluster() == nil ||
+			!cfg.Config().Machine().Type().IsControlPlane() ||
+			!cfg.Config().Cluster().KubeletServingCertificateApprover().Enabled() {
+			closeWatcher()
+
+			continue
+		}
+
+		secretsResource, err := safe.ReaderGetByID[*secrets.Kubernetes](ctx, r, secrets.KubernetesID)
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting kubernetes secrets: %w", err)
+			}
+
+			closeWatcher()
+
+			continue
+		}
+
+		if kubernetesClient != nil && kubeconfig != secretsResource.TypedSpec().LocalhostAdminKubeconfig {
+			// admin kubeconfig was rotated, so we need to reinitialize the watcher
+			closeWatcher()
+		}
+
+		if kubernetesClient == nil {
+			kubeconfig = secretsResource.TypedSpec().LocalhostAdminKubeconfig
+
+			kubernetesClient, err = newKubernetesClientFromKubeconfig(kubeconfig)
+			if err != nil {
+				return fmt.Errorf("error building kubernetes client: %w", err)
+			}
+		}
+
+		if watcher == nil {
+			watcher = kubeletcsr.NewWatcher(kubernetesClient)
+		}
+
+		if notifyCh == nil {
+			var watchCtx context.Context
+
+			watchCtx, watchCtxCancel = context.WithCancel(ctx) //nolint:govet
+
+			notifyCh, watchErrCh, notifyCloser, err = watcher.Watch(watchCtx, logger)
+			if err != nil {
+				return fmt.Errorf("error setting up CSR watcher: %w", err) //nolint:govet
+			}
+		}
+
+		if !watchReady {
+			// CSR watcher is not ready yet
+			continue
+		}
+
+		requeueAfter, err := ctrl.reconcile(ctx, r, logger, kubernetesClient, watcher)
+		if err != nil {
+			return err
+		}
+
+		if requeueAfter > 0 {
+			if requeueTimer != nil {
+				requeueTimer.Stop()
+			}
+
+			requeueTimer = time.NewTimer(requeueAfter)
+			requeueCh = requeueTimer.C
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// reconcile approves or denies pending kubelet serving CSRs.
+//
+// It returns the duration after which the pending CSRs should be checked again.
+//
+//nolint:gocyclo
+func (ctrl *KubeletCSRApproverController) reconcile(
+	ctx context.Context,
+	r controller.Reader,
+	logger *zap.Logger,
+	kubernetesClient *kubernetes.Client,
+	watcher *kubeletcsr.Watcher,
+) (time.Duration, error) {
+	csrs, err := watcher.List()
+	if err != nil {
+		return 0, fmt.Errorf("error listing CSRs: %w", err)
+	}
+
+	members, err := safe.ReaderListAll[*cluster.Member](ctx, r)
+	if err != nil {
+		return 0, fmt.Errorf("error listing cluster members: %w", err)
+	}
+
+	identities := make(map[string]kubeletcsr.NodeIdentity, members.Len())
+
+	for member := range members.All() {
+		identities[member.Metadata().ID()] = kubeletcsr.NodeIdentity{
+			Nodename:  member.Metadata().ID(),
+			Hostnames: []string{member.TypedSpec().Hostname},
+			Addresses: member.TypedSpec().Addresses,
+		}
+	}
+
+	var requeueAfter time.Duration
+
+	for _, csr := range csrs {
+		if !kubeletcsr.IsKubeletServing(csr) || !kubeletcsr.IsPending(csr) {
+			continue
+		}
+
+		nodename, ok := kubeletcsr.Nodename(csr)
+		if !ok {
+			if err = ctrl.deny(ctx, logger, kubernetesClient, csr, fmt.Sprintf("CSR is created by %q, which is not a node", csr.Spec.Username)); err != nil {
+				return 0, err
+			}
+
+			continue
+		}
+
+		identity, ok := identities[nodename]
+		if !ok {
+			// the node might not be discovered yet
+			logger.Debug("skipping CSR for unknown node", zap.String("csr", csr.Name), zap.String("node", nodename))
+
+			continue
+		}
+
+		verifyErr := kubeletcsr.Verify(csr, identity)
+		if verifyErr == nil {
+			if err = ctrl.approve(ctx, logger, kubernetesClient, csr); err != nil {
+				return 0, err
+			}
+
+			continue
+		}
+
+		var mismatchErr *kubeletcsr.SANMismatchError
+
+		if errors.As(verifyErr, &mismatchErr) {
+			if remaining := csrMismatchGracePeriod - time.Since(csr.CreationTimestamp.Time); remaining > 0 {
+				// give the cluster discovery some time to catch up with the node address changes
+				if requeueAfter == 0 || remaining < requeueAfter {
+					requeueAfter = remaining
+				}
+
+				continue
+			}
+		}
+
+		if err = ctrl.deny(ctx, logger, kubernetesClient, csr, verifyErr.Error()); err != nil {
+			return 0, err
+		}
+	}
+
+	return requeueAfter, nil
+}
+
+func (ctrl *KubeletCSRApproverController) approve(ctx context.Context, logger *zap.Logger, kubernetesClient *kubernetes.Client, csr *certificatesv1.CertificateSigningRequest) error {
+	csr = csr.DeepCopy()
+	csr.Status.Conditions = append(csr.Status.Conditions, certificatesv1.CertificateSigningRequestCondition{
+		Type:           certificatesv1.CertificateApproved,
+		Status:         corev1.ConditionTrue,
+		Reason:         "TalosKubeletServingApprove",
+		Message:        "kubelet serving certificate request matches the node identity",
+		LastUpdateTime: metav1.Now(),
+	})
+
+	if err := ctrl.updateApproval(ctx, kubernetesClient, csr); err != nil {
+		return err
+	}
+
+	logger.Info("approved kubelet serving CSR", zap.String("csr", csr.Name), zap.String("username", csr.Spec.Username))
+
+	return nil
+}
+
+func (ctrl *KubeletCSRApproverController) deny(ctx context.Context, logger *zap.Logger, kubernetesClient *kubernetes.Client, csr *certificatesv1.CertificateSigningRequest, reason string) error {
+	csr = csr.DeepCopy()
+	csr.Status.Conditions = append(csr.Status.Conditions, certificatesv1.CertificateSigningRequestCondition{
+		Type:           certificatesv1.CertificateDenied,
+		Status:         corev1.ConditionTrue,
+		Reason:         "TalosKubeletServingDeny",
+		Message:        reason,
+		LastUpdateTime: metav1.Now(),
+	})
+
+	if err := ctrl.updateApproval(ctx, kubernetesClient, csr); err != nil {
+		return err
+	}
+
+	logger.Warn("denied kubelet serving CSR", zap.String("csr", csr.Name), zap.String("username", csr.Spec.Username), zap.String("reason", reason))
+
+	now := metav1.Now()
+
+	if _, err := kubernetesClient.CoreV1().Events(metav1.NamespaceDefault).Create(ctx, &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: csr.Name + ".",
+		},
+		InvolvedObject: corev1.ObjectReference{
+			APIVersion: certificatesv1.SchemeGroupVersion.String(),
+			Kind:       "CertificateSigningRequest",
+			Name:       csr.Name,
+			UID:        csr.UID,
+		},
+		Reason:         "CertificateDenied",
+		Message:        fmt.Sprintf("kubelet serving certificate request denied: %s", reason),
+		Type:           corev1.EventTypeWarning,
+		Source:         corev1.EventSource{Component: "talos"},
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+	}, metav1.CreateOptions{}); err != nil {
+		logger.Warn("failed to create CSR denial event", zap.String("csr", csr.Name), zap.Error(err))
+	}
+
+	return nil
+}
+
+func (ctrl *KubeletCSRApproverController) updateApproval(ctx context.Context, kubernetesClient *kubernetes.Client, csr *certificatesv1.CertificateSigningRequest) error {
+	_, err := kubernetesClient.CertificatesV1().CertificateSigningRequests().UpdateApproval(ctx, csr.Name, csr, metav1.UpdateOptions{})
+	if err != nil {
+		if apierrors.IsConflict(err) || apierrors.IsNotFound(err) {
+			// CSR was handled by another control plane node, or removed
+			return nil
+		}
+
+		return fmt.Errorf("error updating approval of CSR %q: %w", csr.Name, err)
+	}
+
+	return nil
+}
+
+func newKubernetesClientFromKubeconfig(kubeconfig string) (*kubernetes.Client, error) {
+	restConfig, err := clientcmd.BuildConfigFromKubeconfigGetter("", func() (*clientcmdapi.Config, error) {
+		return clientcmd.Load([]byte(kubeconfig))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error loading kubeconfig: %w", err)
+	}
+
+	return kubernetes.NewForConfig(restConfig)
+}
@@ -15,6 +15,7 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/resource"
@@ -34,6 +35,7 @@ import (
 	"github.com/siderolabs/talos/internal/app/machined/pkg/system"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/system/services"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/kubelet"
 	"github.com/siderolabs/talos/pkg/machinery/resources/files"
 	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
 	runtimeres "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
@@ -52,6 +54,9 @@ type ServiceManager interface {
 type KubeletServiceController struct {
 	V1Alpha1Services ServiceManager
 	V1Alpha1Mode     runtimetalos.Mode
+
+	// rotationRequestedAt is the time of the last handled certificate rotation request.
+	rotationRequestedAt time.Time
 }
 
 // Name implements controller.Controller interface.
@@ -137,6 +142,12 @@ func (ctrl *KubeletServiceController) Run(ctx context.Context, r controller.Runt
 			ID:        optional.Some(secrets.KubeletID),
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: secrets.NamespaceName,
+			Type:      secrets.CertificateRotationType,
+			ID:        optional.Some(secrets.CertificateComponentKubelet),
+			Kind:      controller.InputWeak,
+		},
 	}); err != nil {
 		return err
 	}
@@ -172,6 +183,18 @@ func (ctrl *KubeletServiceController) Run(ctx context.Context, r controller.Runt
 
 		secretSpec := secret.TypedSpec()
 
+		rotation, err := safe.ReaderGetByID[*secrets.CertificateRotation](ctx, r, secrets.CertificateComponentKubelet)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting certificate rotation request: %w", err)
+		}
+
+		var forceRotation bool
+
+		if rotation != nil && !rotation.TypedSpec().RequestedAt.Equal(ctrl.rotationRequestedAt) {
+			forceRotation = true
+			ctrl.rotationRequestedAt = rotation.TypedSpec().RequestedAt
+		}
+
 		if err = ctrl.writePKI(secretSpec); err != nil {
 			return fmt.Errorf("error writing kubelet PKI: %w", err)
 		}
@@ -180,7 +203,7 @@ func (ctrl *KubeletServiceController) Run(ctx context.Context, r controller.Runt
 			return fmt.Errorf("error writing kubelet configuration: %w", err)
 		}
 
-		if err = ctrl.writeKubeletCredentialProviderConfig(cfgSpec); err != nil {
+		if err = ctrl.writeKubeletCredentialProviderConfig(cfgSpec, logger); err != nil {
 			return fmt.Errorf("error writing kubelet credential provider configuration: %w", err)
 		}
 
@@ -195,7 +218,7 @@ func (ctrl *KubeletServiceController) Run(ctx context.Context, r controller.Runt
 			}
 		}
 
-		if err = ctrl.refreshKubeletCerts(cfgSpec.ExpectedNodename, secretSpec.AcceptedCAs, logger); err != nil {
+		if err = ctrl.refreshKubeletCerts(cfgSpec.ExpectedNodename, secretSpec.AcceptedCAs, forceRotation, logger); err != nil {
 			return err
 		}
 
@@ -363,11 +386,19 @@ func (ctrl *KubeletServiceController) writeConfig(cfgSpec *k8s.KubeletSpecSpec)
 	return os.WriteFile("/etc/kubernetes/kubelet.yaml", buf.Bytes(), 0o600)
 }
 
-func (ctrl *KubeletServiceController) writeKubeletCredentialProviderConfig(cfgSpec *k8s.KubeletSpecSpec) error {
+func (ctrl *KubeletServiceController) writeKubeletCredentialProviderConfig(cfgSpec *k8s.KubeletSpecSpec, logger *zap.Logger) error {
 	if cfgSpec.CredentialProviderConfig == nil {
 		return os.RemoveAll(constants.KubeletCredentialProviderConfig)
 	}
 
+	// provider binaries are delivered via system extensions, kubelet fails image pulls matching the provider if the binary is missing
+	for _, name := range kubelet.CredentialProviderNames(cfgSpec.CredentialProviderConfig) {
+		if _, err := os.Stat(filepath.Join(constants.KubeletCredentialProviderBinDir, name)); err != nil {
+			logger.Warn("kubelet credential provider binary is not available, make sure the system extension providing it is installed",
+				zap.String("provider", name), zap.String("dir", constants.KubeletCredentialProviderBinDir), zap.Error(err))
+		}
+	}
+
 	var kubeletCredentialProviderConfig kubeletconfig.CredentialProviderConfig
 
 	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(cfgSpec.CredentialProviderConfig, &kubeletCredentialProviderConfig); err != nil {
@@ -427,11 +458,11 @@ func (ctrl *KubeletServiceController) updateKubeconfig(newEndpoint *url.URL, acc
 }
 
 // refreshKubeletCerts checks if the existing kubelet certificates match the node hostname and expected CA.
-// If they don't match, it clears the certificate directory and the removes kubelet's kubeconfig so that
+// If they don't match (or the rotation is forced), it clears the certificate directory and the removes kubelet's kubeconfig so that
 // they can be regenerated next time kubelet is started.
 //
 //nolint:gocyclo
-func (ctrl *KubeletServiceController) refreshKubeletCerts(expectedNodename string, acceptedCAs []*talosx509.PEMEncodedCertificate, logger *zap.Logger) error {
+func (ctrl *KubeletServiceController) refreshKubeletCerts(expectedNodename string, acceptedCAs []*talosx509.PEMEncodedCertificate, force bool, logger *zap.Logger) error {
 	cert, err := ctrl.readKubeletClientCertificate()
 	if err != nil {
 		return err
@@ -441,6 +472,12 @@ func (ctrl *KubeletServiceController) refreshKubeletCerts(expectedNodename strin
 		return nil
 	}
 
+	if force {
+		logger.Info("kubelet certificate rotation requested, removing")
+
+		return removeKubeletCerts()
+	}
+
 	valid := true
 
 	// refresh certs only if we are managing the node name (not overridden by the user)
@@ -484,8 +521,13 @@ func (ctrl *KubeletServiceController) refreshKubeletCerts(expectedNodename strin
 		return nil
 	}
 
+	return removeKubeletCerts()
+}
+
+// removeKubeletCerts removes kubelet certificates and kubeconfig, so that kubelet goes through the bootstrap process again.
+func removeKubeletCerts() error {
 	// remove the pki directory
-	err = os.RemoveAll(constants.KubeletPKIDir)
+	err := os.RemoveAll(constants.KubeletPKIDir)
 	if err != nil {
 		return err
 	}
@@ -5,6 +5,7 @@
 package k8s
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"net/netip"
@@ -387,11 +388,11 @@ func NewKubeletConfiguration(cfgSpec *k8s.KubeletConfigSpec, kubeletVersion comp
 	extraConfig := cfgSpec.ExtraConfig
 
 	if _, overridden := extraConfig["shutdownGracePeriod"]; !overridden && config.ShutdownGracePeriod.Duration == 0 {
-		config.ShutdownGracePeriod = metav1.Duration{Duration: constants.KubeletShutdownGracePeriod}
+		config.ShutdownGracePeriod = metav1.Duration{Duration: cmp.Or(cfgSpec.ShutdownGracePeriod, constants.KubeletShutdownGracePeriod)}
 	}
 
 	if _, overridden := extraConfig["shutdownGracePeriodCriticalPods"]; !overridden && config.ShutdownGracePeriodCriticalPods.Duration == 0 {
-		config.ShutdownGracePeriodCriticalPods = metav1.Duration{Duration: constants.KubeletShutdownGracePeriodCriticalPods}
+		config.ShutdownGracePeriodCriticalPods = metav1.Duration{Duration: cmp.Or(cfgSpec.ShutdownGracePeriodCriticalPods, constants.KubeletShutdownGracePeriodCriticalPods)}
 	}
 
 	if config.StreamingConnectionIdleTimeout.Duration == 0 {
@@ -450,6 +450,21 @@ func TestNewKubeletConfigurationMerge(t *testing.T) {
 			},
 			machineType: machine.TypeWorker,
 		},
+		{
+			name: "shutdown grace period",
+			cfgSpec: &k8s.KubeletConfigSpec{
+				ClusterDNS:                      []string{"10.0.0.5"},
+				ClusterDomain:                   "cluster.local",
+				ShutdownGracePeriod:             5 * time.Minute,
+				ShutdownGracePeriodCriticalPods: time.Minute,
+			},
+			kubeletVersion: compatibility.VersionFromImageRef("ghcr.io/siderolabs/kubelet:v1.29.0"),
+			expectedOverrides: func(kc *kubeletconfig.KubeletConfiguration) {
+				kc.ShutdownGracePeriod = metav1.Duration{Duration: 5 * time.Minute}
+				kc.ShutdownGracePeriodCriticalPods = metav1.Duration{Duration: time.Minute}
+			},
+			machineType: machine.TypeWorker,
+		},
 		{
 			name: "enable seccomp default",
 			cfgSpec: &k8s.KubeletConfigSpec{
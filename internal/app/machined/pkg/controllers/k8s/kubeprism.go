@@ -8,7 +8,9 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"slices"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/cosi-project/runtime/pkg/controller"
@@ -25,12 +27,25 @@ import (
 )
 
 // KubePrismController creates KubePrism load balancer based on KubePrismEndpointsType resource.
+//
+// In the failover only mode, the connections are forwarded to the primary (cluster) endpoint while it is healthy,
+// and to a single healthy control plane endpoint otherwise.
+// The endpoints are probed in the background, so that the slow probes don't block the controller.
 type KubePrismController struct {
 	balancerHost string
 	balancerPort int
 	lb           *controlplane.LoadBalancer
 	ticker       *time.Ticker
 	upstreamCh   chan []string
+
+	failoverOnly bool
+	primary      string
+	backends     []string
+	active       string
+
+	activeCh    chan string
+	proberStop  context.CancelFunc
+	proberGroup sync.WaitGroup
 }
 
 // Name implements controller.Controller interface.
@@ -84,6 +99,20 @@ func (ctrl *KubePrismController) Run(ctx context.Context, r controller.Runtime,
 				return err
 			}
 
+			continue
+		case active := <-ctrl.activeChan():
+			if ctrl.lb == nil || !ctrl.failoverOnly || active == ctrl.active {
+				continue
+			}
+
+			ctrl.active = active
+			ctrl.upstreamChan() <- []string{active}
+
+			err := ctrl.writeKubePrismStatus(ctx, r)
+			if err != nil {
+				return err
+			}
+
 			continue
 		case <-r.EventCh():
 		}
@@ -95,7 +124,7 @@ func (ctrl *KubePrismController) Run(ctx context.Context, r controller.Runtime,
 
 		switch {
 		case ctrl.lb == nil && lbCfg != nil:
-			err = ctrl.startKubePrism(lbCfg, logger)
+			err = ctrl.startKubePrism(ctx, lbCfg, logger)
 			if err != nil {
 				return err
 			}
@@ -111,12 +140,12 @@ func (ctrl *KubePrismController) Run(ctx context.Context, r controller.Runtime,
 					return err
 				}
 
-				err = ctrl.startKubePrism(lbCfg, logger)
+				err = ctrl.startKubePrism(ctx, lbCfg, logger)
 				if err != nil {
 					return err
 				}
 			} else {
-				ctrl.upstreamChan() <- makeEndpoints(lbCfg.TypedSpec())
+				ctrl.upstreamChan() <- ctrl.upstreams(ctx, lbCfg.TypedSpec(), logger)
 			}
 		}
 
@@ -145,7 +174,11 @@ func (ctrl *KubePrismController) writeKubePrismStatus(
 			return fmt.Errorf("failed to get KubePrism status: %w", err)
 		}
 
-		if got != nil && got.TypedSpec().Healthy == healthy {
+		activeEndpoint := ctrl.active
+		failedOver := ctrl.failoverOnly && ctrl.active != ctrl.primary
+
+		if got != nil && got.TypedSpec().Healthy == healthy &&
+			got.TypedSpec().ActiveEndpoint == activeEndpoint && got.TypedSpec().FailedOver == failedOver {
 			return nil
 		}
 
@@ -156,6 +189,8 @@ func (ctrl *KubePrismController) writeKubePrismStatus(
 			func(res *k8s.KubePrismStatuses) error {
 				res.TypedSpec().Host = ctrl.endpoint()
 				res.TypedSpec().Healthy = healthy
+				res.TypedSpec().ActiveEndpoint = activeEndpoint
+				res.TypedSpec().FailedOver = failedOver
 
 				return nil
 			},
@@ -182,7 +217,7 @@ func (ctrl *KubePrismController) writeKubePrismStatus(
 	return nil
 }
 
-func (ctrl *KubePrismController) startKubePrism(lbCfg *k8s.KubePrismConfig, logger *zap.Logger) error {
+func (ctrl *KubePrismController) startKubePrism(ctx context.Context, lbCfg *k8s.KubePrismConfig, logger *zap.Logger) error {
 	spec := lbCfg.TypedSpec()
 	ctrl.balancerHost = spec.Host
 	ctrl.balancerPort = spec.Port
@@ -208,7 +243,7 @@ func (ctrl *KubePrismController) startKubePrism(lbCfg *k8s.KubePrismConfig, logg
 
 	logger.Info("KubePrism is enabled", zap.String("endpoint", ctrl.endpoint()))
 
-	ctrl.upstreamChan() <- makeEndpoints(spec)
+	ctrl.upstreamChan() <- ctrl.upstreams(ctx, spec, logger)
 
 	ctrl.lb = lb
 
@@ -221,6 +256,134 @@ func makeEndpoints(spec *k8s.KubePrismConfigSpec) []string {
 	})
 }
 
+// upstreams returns the list of the upstreams for the load balancer.
+//
+// In the failover only mode, the list contains the single active endpoint,
+// and the endpoints are probed in the background to update the active endpoint.
+func (ctrl *KubePrismController) upstreams(ctx context.Context, spec *k8s.KubePrismConfigSpec, logger *zap.Logger) []string {
+	endpoints := makeEndpoints(spec)
+
+	ctrl.stopFailoverProber()
+
+	ctrl.failoverOnly = spec.FailoverOnly
+
+	if !spec.FailoverOnly {
+		ctrl.primary, ctrl.backends, ctrl.active = "", nil, ""
+
+		return endpoints
+	}
+
+	ctrl.primary = net.JoinHostPort(spec.PrimaryEndpoint.Host, strconv.FormatUint(uint64(spec.PrimaryEndpoint.Port), 10))
+	ctrl.backends = slices.DeleteFunc(endpoints, func(endpoint string) bool {
+		return endpoint == ctrl.primary
+	})
+
+	// keep the active backend if it is still in the list, the prober fails back to the primary if it is healthy
+	if !slices.Contains(ctrl.backends, ctrl.active) {
+		ctrl.active = ctrl.primary
+	}
+
+	ctrl.startFailoverProber(ctx, logger)
+
+	return []string{ctrl.active}
+}
+
+// startFailoverProber starts probing the endpoints in the background, the selected endpoint is sent to the activeCh.
+func (ctrl *KubePrismController) startFailoverProber(ctx context.Context, logger *zap.Logger) {
+	ctx, ctrl.proberStop = context.WithCancel(ctx)
+
+	primary, backends, active := ctrl.primary, slices.Clone(ctrl.backends), ctrl.active
+	activeCh := ctrl.activeChan()
+
+	ctrl.proberGroup.Add(1)
+
+	go func() {
+		defer ctrl.proberGroup.Done()
+
+		ticker := time.NewTicker(constants.KubePrismFailoverProbeInterval)
+		defer ticker.Stop()
+
+		for {
+			active = selectFailoverEndpoint(ctx, logger, primary, backends, active)
+
+			select {
+			case <-ctx.Done():
+				return
+			case activeCh <- active:
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (ctrl *KubePrismController) stopFailoverProber() {
+	if ctrl.proberStop == nil {
+		return
+	}
+
+	replaceWithZero(&ctrl.proberStop)()
+
+	ctrl.proberGroup.Wait()
+}
+
+// selectFailoverEndpoint returns the primary endpoint if it is healthy, and fails over to a healthy backend otherwise.
+//
+// The current active backend is preferred to avoid flapping between the backends.
+// If none of the endpoints are healthy, the primary endpoint is returned.
+func selectFailoverEndpoint(ctx context.Context, logger *zap.Logger, primary string, backends []string, active string) string {
+	if probeEndpoint(ctx, primary) {
+		if active != "" && active != primary {
+			logger.Info("KubePrism primary endpoint is healthy again", zap.String("endpoint", primary))
+		}
+
+		return primary
+	}
+
+	candidates := backends
+
+	if idx := slices.Index(candidates, active); idx > 0 {
+		candidates = slices.Concat([]string{active}, candidates[:idx], candidates[idx+1:])
+	}
+
+	for _, candidate := range candidates {
+		if !probeEndpoint(ctx, candidate) {
+			continue
+		}
+
+		if candidate != active {
+			logger.Warn("KubePrism primary endpoint is not healthy, failing over",
+				zap.String("primary", primary),
+				zap.String("endpoint", candidate),
+			)
+		}
+
+		return candidate
+	}
+
+	return primary
+}
+
+func probeEndpoint(ctx context.Context, endpoint string) bool {
+	ctx, cancel := context.WithTimeout(ctx, constants.KubePrismFailoverProbeTimeout)
+	defer cancel()
+
+	var d net.Dialer
+
+	conn, err := d.DialContext(ctx, "tcp", endpoint)
+	if err != nil {
+		return false
+	}
+
+	conn.Close() //nolint:errcheck
+
+	return true
+}
+
 func (ctrl *KubePrismController) takeTickerC() <-chan time.Time {
 	switch {
 	case ctrl.lb == nil && ctrl.ticker == nil:
@@ -253,7 +416,17 @@ func (ctrl *KubePrismController) upstreamChan() chan []string {
 	return ctrl.upstreamCh
 }
 
+func (ctrl *KubePrismController) activeChan() chan string {
+	if ctrl.activeCh == nil {
+		ctrl.activeCh = make(chan string)
+	}
+
+	return ctrl.activeCh
+}
+
 func (ctrl *KubePrismController) stopKubePrism(logger *zap.Logger) error {
+	ctrl.stopFailoverProber()
+
 	replaceWithZero(&ctrl.upstreamCh)
 
 	lb := replaceWithZero(&ctrl.lb)
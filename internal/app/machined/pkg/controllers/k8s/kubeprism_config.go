@@ -57,6 +57,18 @@ func NewKubePrismConfigController() *KubePrismConfigController {
 				spec.Endpoints = endpt.TypedSpec().Endpoints
 				spec.Host = "127.0.0.1"
 				spec.Port = cfg.Config().Machine().Features().KubePrism().Port()
+				spec.FailoverOnly = false
+				spec.PrimaryEndpoint = k8s.KubePrismEndpoint{}
+
+				if cfg.Config().Machine().Features().KubePrism().FailoverOnly() && cfg.Config().Cluster() != nil {
+					if ce := cfg.Config().Cluster().Endpoint(); ce != nil {
+						spec.FailoverOnly = true
+						spec.PrimaryEndpoint = k8s.KubePrismEndpoint{
+							Host: ce.Hostname(),
+							Port: toPort(ce.Port()),
+						}
+					}
+				}
 
 				return nil
 			},
@@ -129,6 +129,56 @@ func (suite *KubePrismConfigControllerSuite) TestGeneration() {
 	})
 }
 
+func (suite *KubePrismConfigControllerSuite) TestFailoverOnly() {
+	cfg := &v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineFeatures: &v1alpha1.FeaturesConfig{
+				KubePrismSupport: &v1alpha1.KubePrism{
+					ServerEnabled:      pointer.To(true),
+					ServerFailoverOnly: pointer.To(true),
+				},
+			},
+		},
+		ClusterConfig: &v1alpha1.ClusterConfig{
+			ControlPlane: &v1alpha1.ControlPlaneConfig{
+				Endpoint: &v1alpha1.Endpoint{
+					URL: must(url.Parse("https://example.com:6443"))(suite.Require()),
+				},
+			},
+		},
+	}
+
+	mc := config.NewMachineConfig(container.NewV1Alpha1(cfg))
+	suite.Create(mc)
+
+	endpoints := k8s.NewKubePrismEndpoints(k8s.NamespaceName, k8s.KubePrismEndpointsID)
+	endpoints.TypedSpec().Endpoints = []k8s.KubePrismEndpoint{
+		{Host: "example.com", Port: 6443},
+		{Host: "192.168.3.4", Port: 6443},
+	}
+
+	suite.Create(endpoints)
+
+	ctest.AssertResource(suite, k8s.KubePrismConfigID, func(e *k8s.KubePrismConfig, asrt *assert.Assertions) {
+		asrt.True(e.TypedSpec().FailoverOnly)
+		asrt.Equal(k8s.KubePrismEndpoint{Host: "example.com", Port: 6443}, e.TypedSpec().PrimaryEndpoint)
+		asrt.Len(e.TypedSpec().Endpoints, 2)
+	})
+
+	ctest.UpdateWithConflicts(suite, mc, func(cfg *config.MachineConfig) error {
+		balancer := cfg.Config().Machine().Features().KubePrism().(*v1alpha1.KubePrism)
+		balancer.ServerFailoverOnly = nil
+
+		return nil
+	})
+
+	ctest.AssertResource(suite, k8s.KubePrismConfigID, func(e *k8s.KubePrismConfig, asrt *assert.Assertions) {
+		asrt.False(e.TypedSpec().FailoverOnly)
+		asrt.Zero(e.TypedSpec().PrimaryEndpoint)
+	})
+}
+
 func TestEndpointsBalancerConfigControllerSuite(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,97 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s_test
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	clusterctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/k8s"
+)
+
+// endpoint is a local TCP endpoint which can be brought down and up again on the same address.
+type endpoint struct {
+	t *testing.T
+
+	addr     string
+	listener net.Listener
+}
+
+func newEndpoint(t *testing.T) *endpoint {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	t.Cleanup(func() { l.Close() }) //nolint:errcheck
+
+	return &endpoint{t: t, addr: l.Addr().String(), listener: l}
+}
+
+func (e *endpoint) down() {
+	require.NoError(e.t, e.listener.Close())
+}
+
+func (e *endpoint) up() {
+	l, err := net.Listen("tcp", e.addr)
+	require.NoError(e.t, err)
+
+	e.t.Cleanup(func() { l.Close() }) //nolint:errcheck
+
+	e.listener = l
+}
+
+func TestSelectFailoverEndpoint(t *testing.T) {
+	t.Parallel()
+
+	logger := zaptest.NewLogger(t)
+
+	primary, backend1, backend2 := newEndpoint(t), newEndpoint(t), newEndpoint(t)
+	backends := []string{backend1.addr, backend2.addr}
+
+	selectEndpoint := func(active string) string {
+		return clusterctrl.SelectFailoverEndpoint(t.Context(), logger, primary.addr, backends, active)
+	}
+
+	// the primary endpoint is healthy
+	active := selectEndpoint("")
+	assert.Equal(t, primary.addr, active)
+
+	// the primary endpoint is down, fail over to the first healthy backend
+	primary.down()
+
+	active = selectEndpoint(active)
+	assert.Equal(t, backend1.addr, active)
+
+	// the active backend is down, fail over to the next one
+	backend1.down()
+
+	active = selectEndpoint(active)
+	assert.Equal(t, backend2.addr, active)
+
+	// the first backend is healthy again, but the active backend is sticky
+	backend1.up()
+
+	active = selectEndpoint(active)
+	assert.Equal(t, backend2.addr, active)
+
+	// the primary endpoint is healthy again, fail back
+	primary.up()
+
+	active = selectEndpoint(active)
+	assert.Equal(t, primary.addr, active)
+
+	// none of the endpoints are healthy, the primary endpoint is used
+	primary.down()
+	backend1.down()
+	backend2.down()
+
+	active = selectEndpoint(backend1.addr)
+	assert.Equal(t, primary.addr, active)
+}
@@ -6,6 +6,9 @@ package k8s
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 
 	"github.com/cosi-project/runtime/pkg/controller"
@@ -14,10 +17,13 @@ import (
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/siderolabs/gen/optional"
 	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	k8sadapter "github.com/siderolabs/talos/internal/app/machined/pkg/adapters/k8s"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/k8s/internal/k8stemplates"
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
 	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
 )
@@ -103,6 +109,11 @@ func (ctrl *ManifestController) Run(ctx context.Context, r controller.Runtime, _
 			return err
 		}
 
+		renderedManifests, err = ctrl.applyObjectPolicies(config, renderedManifests)
+		if err != nil {
+			return err
+		}
+
 		for _, renderedManifest := range renderedManifests {
 			if err = safe.WriterModify(ctx, r, k8s.NewManifest(k8s.ControlPlaneNamespaceName, renderedManifest.name),
 				func(r *k8s.Manifest) error {
@@ -196,8 +207,8 @@ func (ctrl *ManifestController) render(cfg k8s.BootstrapManifestsConfigSpec, scr
 					k8stemplates.CoreDNSServiceAccount(),
 					k8stemplates.CoreDNSClusterRole(),
 					k8stemplates.CoreDNSClusterRoleBinding(),
-					k8stemplates.CoreDNSConfigMap(&cfg),
-					k8stemplates.CoreDNSDeployment(&cfg),
+					withOverridesChecksum(&cfg, k8stemplates.CoreDNSConfigMap(&cfg)),
+					withOverridesChecksum(&cfg, k8stemplates.CoreDNSDeployment(&cfg)),
 				},
 			},
 			renderedManifest{
@@ -255,6 +266,85 @@ func (ctrl *ManifestController) render(cfg k8s.BootstrapManifestsConfigSpec, scr
 	return manifests, nil
 }
 
+// withOverridesChecksum marks the CoreDNS objects with the checksum if the CoreDNS configuration is overridden.
+//
+// Objects with the checksum are kept in sync with the machine configuration without waiting for the Kubernetes upgrade.
+func withOverridesChecksum(cfg *k8s.BootstrapManifestsConfigSpec, obj runtime.Object) runtime.Object {
+	if !cfg.CoreDNSOverridesEnabled {
+		return obj
+	}
+
+	objMeta, err := meta.Accessor(obj)
+	if err != nil {
+		return obj
+	}
+
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return obj
+	}
+
+	sum := sha256.Sum256(data)
+
+	annotations := objMeta.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	annotations[constants.AnnotationManifestChecksum] = hex.EncodeToString(sum[:])
+
+	objMeta.SetAnnotations(annotations)
+
+	return obj
+}
+
+// applyObjectPolicies drops the objects with the skip policy, and marks the objects with the create-only policy.
+//
+// Manifests which have all objects dropped are not rendered at all.
+func (ctrl *ManifestController) applyObjectPolicies(cfg k8s.BootstrapManifestsConfigSpec, manifests []renderedManifest) ([]renderedManifest, error) {
+	if len(cfg.ObjectPolicies) == 0 {
+		return manifests, nil
+	}
+
+	result := make([]renderedManifest, 0, len(manifests))
+
+	for _, manifest := range manifests {
+		objs := make([]runtime.Object, 0, len(manifest.objs))
+
+		for _, obj := range manifest.objs {
+			objMeta, err := meta.Accessor(obj)
+			if err != nil {
+				return nil, fmt.Errorf("error accessing object metadata in manifest %q: %w", manifest.name, err)
+			}
+
+			switch cfg.ObjectPolicy(manifest.name, obj.GetObjectKind().GroupVersionKind().Kind, objMeta.GetNamespace(), objMeta.GetName()) {
+			case talosconfig.BootstrapManifestPolicySkip:
+				continue
+			case talosconfig.BootstrapManifestPolicyCreateOnly:
+				annotations := objMeta.GetAnnotations()
+				if annotations == nil {
+					annotations = map[string]string{}
+				}
+
+				annotations[constants.AnnotationManifestPolicy] = talosconfig.BootstrapManifestPolicyCreateOnly
+
+				objMeta.SetAnnotations(annotations)
+			}
+
+			objs = append(objs, obj)
+		}
+
+		if len(objs) > 0 {
+			result = append(result, renderedManifest{
+				name: manifest.name,
+				objs: objs,
+			})
+		}
+	}
+
+	return result, nil
+}
+
 //nolint:dupl
 func (ctrl *ManifestController) teardownAll(ctx context.Context, r controller.Runtime) error {
 	manifests, err := r.List(ctx, resource.NewMetadata(k8s.ControlPlaneNamespaceName, k8s.ManifestType, "", resource.VersionUndefined))
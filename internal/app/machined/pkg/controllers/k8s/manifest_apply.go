@@ -37,6 +37,7 @@ import (
 	k8sadapter "github.com/siderolabs/talos/internal/app/machined/pkg/adapters/k8s"
 	"github.com/siderolabs/talos/internal/pkg/etcd"
 	"github.com/siderolabs/talos/pkg/logging"
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
 	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
@@ -263,9 +264,13 @@ func (ctrl *ManifestApplyController) apply(ctx context.Context, logger *zap.Logg
 			dr = dyn.Resource(mapping.Resource)
 		}
 
-		_, err = dr.Get(ctx, obj.GetName(), metav1.GetOptions{})
+		live, err := dr.Get(ctx, obj.GetName(), metav1.GetOptions{})
 		if err == nil {
-			// already exists
+			// already exists, update only the objects which are kept in sync with the machine configuration
+			if err = ctrl.update(ctx, logger, dr, obj, live, objName); err != nil {
+				multiErr = multierror.Append(multiErr, err)
+			}
+
 			continue
 		}
 
@@ -299,6 +304,30 @@ func (ctrl *ManifestApplyController) apply(ctx context.Context, logger *zap.Logg
 	return multiErr.ErrorOrNil()
 }
 
+// update applies the object if the checksum of the manifest object doesn't match the checksum of the live object.
+func (ctrl *ManifestApplyController) update(ctx context.Context, logger *zap.Logger, dr dynamic.ResourceInterface, obj, live *unstructured.Unstructured, objName string) error {
+	checksum := obj.GetAnnotations()[constants.AnnotationManifestChecksum]
+
+	if checksum == "" || checksum == live.GetAnnotations()[constants.AnnotationManifestChecksum] {
+		return nil
+	}
+
+	if obj.GetAnnotations()[constants.AnnotationManifestPolicy] == talosconfig.BootstrapManifestPolicyCreateOnly {
+		return nil
+	}
+
+	if _, err := dr.Apply(ctx, obj.GetName(), obj, metav1.ApplyOptions{
+		FieldManager: "talos",
+		Force:        true,
+	}); err != nil {
+		return fmt.Errorf("error updating %s: %w", objName, err)
+	}
+
+	logger.Sugar().Infof("updated %s", objName)
+
+	return nil
+}
+
 func isNamespace(gvk schema.GroupVersionKind) bool {
 	return gvk.Kind == "Namespace" && gvk.Version == "v1"
 }
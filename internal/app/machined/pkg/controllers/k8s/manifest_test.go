@@ -26,6 +26,7 @@ import (
 
 	k8sadapter "github.com/siderolabs/talos/internal/app/machined/pkg/adapters/k8s"
 	k8sctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/k8s"
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
 	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
@@ -314,6 +315,168 @@ func (suite *ManifestSuite) TestReconcileIPv6() {
 	suite.Assert().Contains(v, fmt.Sprintf(`"IPv6Network": "%s"`, constants.DefaultIPv6PodNet))
 }
 
+func (suite *ManifestSuite) TestReconcileObjectPolicies() {
+	rootSecrets := secrets.NewKubernetesRoot(secrets.KubernetesRootID)
+	manifestConfig := k8s.NewBootstrapManifestsConfig()
+	spec := defaultManifestSpec
+	spec.ObjectPolicies = []k8s.BootstrapManifestObjectPolicy{
+		{
+			Manifest: "10-kube-proxy",
+			Policy:   talosconfig.BootstrapManifestPolicySkip,
+		},
+		{
+			Kind:      "ConfigMap",
+			Namespace: "kube-system",
+			Name:      "coredns",
+			Policy:    talosconfig.BootstrapManifestPolicyCreateOnly,
+		},
+		{
+			Manifest: "11-core-dns",
+			Kind:     "Deployment",
+			Policy:   talosconfig.BootstrapManifestPolicySkip,
+		},
+	}
+	*manifestConfig.TypedSpec() = spec
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, rootSecrets))
+	suite.Require().NoError(suite.state.Create(suite.ctx, manifestConfig))
+
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertManifests(
+					[]string{
+						"00-kubelet-bootstrapping-token",
+						"01-csr-approver-role-binding",
+						"01-csr-node-bootstrap",
+						"01-csr-renewal-role-binding",
+						"05-flannel",
+						"11-core-dns",
+						"11-core-dns-svc",
+						"11-kube-config-in-cluster",
+						"11-talos-node-rbac-template",
+					},
+				)
+			},
+		),
+	)
+
+	r, err := suite.state.Get(
+		suite.ctx,
+		resource.NewMetadata(
+			k8s.ControlPlaneNamespaceName,
+			k8s.ManifestType,
+			"11-core-dns",
+			resource.VersionUndefined,
+		),
+	)
+	suite.Require().NoError(err)
+
+	manifest := r.(*k8s.Manifest) //nolint:forcetypeassert
+	objects := k8sadapter.Manifest(manifest).Objects()
+	suite.Require().Len(objects, 4)
+
+	for _, obj := range objects {
+		suite.Assert().NotEqual("Deployment", obj.GetKind())
+
+		if obj.GetKind() == "ConfigMap" {
+			suite.Assert().Equal(talosconfig.BootstrapManifestPolicyCreateOnly, obj.GetAnnotations()[constants.AnnotationManifestPolicy])
+		} else {
+			suite.Assert().NotContains(obj.GetAnnotations(), constants.AnnotationManifestPolicy)
+		}
+	}
+}
+
+func (suite *ManifestSuite) TestReconcileCoreDNSOverrides() {
+	rootSecrets := secrets.NewKubernetesRoot(secrets.KubernetesRootID)
+	manifestConfig := k8s.NewBootstrapManifestsConfig()
+	spec := defaultManifestSpec
+	spec.CoreDNSOverridesEnabled = true
+	spec.CoreDNSStubDomains = []k8s.CoreDNSStubDomain{
+		{
+			Domain:    "corp.example.com",
+			Upstreams: []string{"10.0.0.53"},
+		},
+	}
+	spec.CoreDNSReplicas = 3
+	*manifestConfig.TypedSpec() = spec
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, rootSecrets))
+	suite.Require().NoError(suite.state.Create(suite.ctx, manifestConfig))
+
+	getCoreDNSObjects := func() map[string]*unstructured.Unstructured {
+		r, err := suite.state.Get(
+			suite.ctx,
+			resource.NewMetadata(
+				k8s.ControlPlaneNamespaceName,
+				k8s.ManifestType,
+				"11-core-dns",
+				resource.VersionUndefined,
+			),
+		)
+		suite.Require().NoError(err)
+
+		objects := map[string]*unstructured.Unstructured{}
+
+		for _, obj := range k8sadapter.Manifest(r.(*k8s.Manifest)).Objects() { //nolint:forcetypeassert
+			objects[obj.GetKind()] = obj
+		}
+
+		return objects
+	}
+
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertManifests(
+					[]string{
+						"00-kubelet-bootstrapping-token",
+						"01-csr-approver-role-binding",
+						"01-csr-node-bootstrap",
+						"01-csr-renewal-role-binding",
+						"05-flannel",
+						"10-kube-proxy",
+						"11-core-dns",
+						"11-core-dns-svc",
+						"11-kube-config-in-cluster",
+						"11-talos-node-rbac-template",
+					},
+				)
+			},
+		),
+	)
+
+	objects := getCoreDNSObjects()
+
+	corefile, _, _ := unstructured.NestedString(objects["ConfigMap"].Object, "data", "Corefile") //nolint:errcheck
+	suite.Assert().Contains(corefile, "corp.example.com:53 {")
+	suite.Assert().Contains(corefile, "forward . 10.0.0.53")
+
+	replicas, _, _ := unstructured.NestedInt64(objects["Deployment"].Object, "spec", "replicas") //nolint:errcheck
+	suite.Assert().EqualValues(3, replicas)
+
+	configMapChecksum := objects["ConfigMap"].GetAnnotations()[constants.AnnotationManifestChecksum]
+	suite.Assert().NotEmpty(configMapChecksum)
+	suite.Assert().NotEmpty(objects["Deployment"].GetAnnotations()[constants.AnnotationManifestChecksum])
+	suite.Assert().NotContains(objects["ServiceAccount"].GetAnnotations(), constants.AnnotationManifestChecksum)
+
+	// the checksum changes with the overrides
+	manifestConfig.TypedSpec().CoreDNSStubDomains[0].Upstreams = []string{"10.0.0.54"}
+	suite.Require().NoError(suite.state.Update(suite.ctx, manifestConfig))
+
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				if getCoreDNSObjects()["ConfigMap"].GetAnnotations()[constants.AnnotationManifestChecksum] == configMapChecksum {
+					return retry.ExpectedErrorf("checksum is not updated")
+				}
+
+				return nil
+			},
+		),
+	)
+}
+
 func (suite *ManifestSuite) TearDownTest() {
 	suite.T().Log("tear down")
 
@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -21,6 +22,8 @@ import (
 	k8sjson "k8s.io/apimachinery/pkg/runtime/serializer/json"
 	apiserverv1 "k8s.io/apiserver/pkg/apis/apiserver/v1"
 	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	schedulerv1 "k8s.io/kube-scheduler/config/v1"
 
 	"github.com/siderolabs/talos/internal/pkg/selinux"
@@ -28,6 +31,9 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
 )
 
+// auditWebhookKubeconfigFilename is the name of the kube-apiserver audit webhook kubeconfig in the config directory.
+const auditWebhookKubeconfigFilename = "audit-webhook-kubeconfig.yaml"
+
 // RenderConfigsStaticPodController manages k8s.ConfigsReady and renders configs for the control plane.
 type RenderConfigsStaticPodController struct{}
 
@@ -218,6 +224,10 @@ func (ctrl *RenderConfigsStaticPodController) Run(ctx context.Context, r control
 			}
 		}
 
+		if err = renderAuditBackend(auditConfig); err != nil {
+			return err
+		}
+
 		if err = safe.WriterModify(ctx, r, k8s.NewConfigStatus(k8s.ControlPlaneNamespaceName, k8s.ConfigStatusStaticPodID), func(r *k8s.ConfigStatus) error {
 			r.TypedSpec().Ready = true
 			r.TypedSpec().Version = admissionRes.Metadata().Version().String() +
@@ -320,3 +330,64 @@ func authorizationConfig(spec *k8s.AuthorizationConfigSpec, kubeAPIServerVersion
 		return &cfg, nil
 	}
 }
+
+// renderAuditBackend prepares the kube-apiserver audit log directory and renders the audit webhook kubeconfig.
+func renderAuditBackend(spec *k8s.AuditPolicyConfigSpec) error {
+	if dir := auditLogDir(spec); dir != "" && dir != constants.KubernetesAuditLogDir {
+		if err := os.MkdirAll(dir, 0o700); err != nil {
+			return fmt.Errorf("error creating audit log directory: %w", err)
+		}
+
+		if err := os.Chown(dir, constants.KubernetesAPIServerRunUser, constants.KubernetesAPIServerRunGroup); err != nil {
+			return fmt.Errorf("error chowning audit log directory: %w", err)
+		}
+
+		if err := selinux.SetLabel(dir, constants.KubernetesAuditLogDirSELinuxLabel); err != nil {
+			return err
+		}
+	}
+
+	path := filepath.Join(constants.KubernetesAPIServerConfigDir, auditWebhookKubeconfigFilename)
+
+	if spec.WebhookURL == "" {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("error removing audit webhook kubeconfig: %w", err)
+		}
+
+		return nil
+	}
+
+	kubeconfig, err := clientcmd.Write(clientcmdapi.Config{
+		Clusters: map[string]*clientcmdapi.Cluster{
+			"audit-webhook": {
+				Server:                   spec.WebhookURL,
+				CertificateAuthorityData: []byte(spec.WebhookCACertificate),
+			},
+		},
+		AuthInfos: map[string]*clientcmdapi.AuthInfo{
+			"kube-apiserver": {
+				Token: spec.WebhookToken,
+			},
+		},
+		Contexts: map[string]*clientcmdapi.Context{
+			"audit-webhook": {
+				Cluster:  "audit-webhook",
+				AuthInfo: "kube-apiserver",
+			},
+		},
+		CurrentContext: "audit-webhook",
+	})
+	if err != nil {
+		return fmt.Errorf("error marshaling audit webhook kubeconfig: %w", err)
+	}
+
+	if err = os.WriteFile(path, kubeconfig, 0o400); err != nil {
+		return fmt.Errorf("error writing audit webhook kubeconfig: %w", err)
+	}
+
+	if err = os.Chown(path, constants.KubernetesAPIServerRunUser, constants.KubernetesAPIServerRunGroup); err != nil {
+		return fmt.Errorf("error chowning audit webhook kubeconfig: %w", err)
+	}
+
+	return nil
+}
@@ -8,8 +8,11 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"net"
+	"net/netip"
 	"os"
 	"path/filepath"
+	"slices"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/resource"
@@ -78,6 +81,10 @@ func (ctrl *RenderSecretsStaticPodController) Outputs() []controller.Output {
 			Type: k8s.SecretsStatusType,
 			Kind: controller.OutputExclusive,
 		},
+		{
+			Type: k8s.KubernetesCertSANStatusType,
+			Kind: controller.OutputExclusive,
+		},
 	}
 }
 
@@ -325,6 +332,11 @@ func (ctrl *RenderSecretsStaticPodController) Run(ctx context.Context, r control
 			}
 		}
 
+		// report the SANs before the secrets status, so that kube-apiserver static pod picks them up with the new secrets
+		if err = ctrl.updateCertSANStatus(ctx, r, k8sCerts.APIServer); err != nil {
+			return err
+		}
+
 		if err = safe.WriterModify(ctx, r, k8s.NewSecretsStatus(k8s.ControlPlaneNamespaceName, k8s.StaticPodSecretsStaticPodID), func(r *k8s.SecretsStatus) error {
 			r.TypedSpec().Ready = true
 			r.TypedSpec().Version = secretsRes.Metadata().Version().String()
@@ -337,3 +349,26 @@ func (ctrl *RenderSecretsStaticPodController) Run(ctx context.Context, r control
 		r.ResetRestartBackoff()
 	}
 }
+
+// updateCertSANStatus reports the SANs of the kube-apiserver serving certificate which was rendered to disk.
+func (ctrl *RenderSecretsStaticPodController) updateCertSANStatus(ctx context.Context, r controller.Runtime, apiServer *x509.PEMEncodedCertificateAndKey) error {
+	cert, err := apiServer.GetCert()
+	if err != nil {
+		return fmt.Errorf("error parsing kube-apiserver certificate: %w", err)
+	}
+
+	ips := xslices.Map(cert.IPAddresses, func(ip net.IP) netip.Addr {
+		addr, _ := netip.AddrFromSlice(ip)
+
+		return addr.Unmap()
+	})
+
+	slices.SortFunc(ips, func(a, b netip.Addr) int { return a.Compare(b) })
+
+	return safe.WriterModify(ctx, r, k8s.NewKubernetesCertSANStatus(k8s.ControlPlaneNamespaceName, k8s.APIServerID), func(r *k8s.KubernetesCertSANStatus) error {
+		r.TypedSpec().DNSNames = slices.Sorted(slices.Values(cert.DNSNames))
+		r.TypedSpec().IPs = ips
+
+		return nil
+	})
+}
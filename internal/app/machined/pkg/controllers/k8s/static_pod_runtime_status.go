@@ -0,0 +1,452 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	containerd "github.com/containerd/containerd/v2/client"
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/pkg/cri"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+const (
+	// StaticPodRuntimeStatusRefreshInterval is the interval to refresh the static pod runtime status without the containerd events.
+	StaticPodRuntimeStatusRefreshInterval = 15 * time.Second
+
+	// StaticPodRestartStormThreshold is the number of restarts within StaticPodRestartStormWindow which is considered a restart storm.
+	StaticPodRestartStormThreshold = 5
+
+	// StaticPodRestartStormWindow is the window to count the static pod restarts in.
+	StaticPodRestartStormWindow = 10 * time.Minute
+)
+
+// StaticPodRuntime provides access to the container runtime state of the static pods.
+type StaticPodRuntime interface {
+	ListPodSandbox(ctx context.Context, filter *runtimeapi.PodSandboxFilter) ([]*runtimeapi.PodSandbox, error)
+	ListContainers(ctx context.Context, filter *runtimeapi.ContainerFilter) ([]*runtimeapi.Container, error)
+	ContainerStatus(ctx context.Context, containerID string, verbose bool) (*runtimeapi.ContainerStatus, map[string]string, error)
+	// Events returns a channel which receives a notification on each container state change.
+	Events(ctx context.Context) (<-chan struct{}, <-chan error)
+	Close() error
+}
+
+// StaticPodRuntimeStatusController reports the container runtime state of the control plane static pods.
+//
+// The state is refreshed on containerd task events, and the repeated restarts of the static pods are reported as events.
+type StaticPodRuntimeStatusController struct {
+	V1Alpha1Events runtime.Publisher
+	// RuntimeProvider defaults to the CRI containerd.
+	RuntimeProvider func() (StaticPodRuntime, error)
+	Clock           clock.Clock
+
+	pods map[resource.ID]*staticPodRestarts
+}
+
+// staticPodRestarts tracks the restarts of the static pod.
+type staticPodRestarts struct {
+	sandboxID        string
+	restartCount     int
+	restarts         []time.Time
+	storm            bool
+	lastProbeFailure string
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *StaticPodRuntimeStatusController) Name() string {
+	return "k8s.StaticPodRuntimeStatusController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *StaticPodRuntimeStatusController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: v1alpha1.NamespaceName,
+			Type:      v1alpha1.ServiceType,
+			ID:        optional.Some("cri"),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: k8s.NamespaceName,
+			Type:      k8s.StaticPodStatusType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *StaticPodRuntimeStatusController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: k8s.StaticPodRuntimeStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+func defaultStaticPodRuntime() (StaticPodRuntime, error) {
+	criClient, err := cri.NewClient("unix://"+constants.CRIContainerdAddress, 10*time.Second)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CRI client: %w", err)
+	}
+
+	containerdClient, err := containerd.New(constants.CRIContainerdAddress)
+	if err != nil {
+		criClient.Close() //nolint:errcheck
+
+		return nil, fmt.Errorf("error creating containerd client: %w", err)
+	}
+
+	return &criStaticPodRuntime{
+		Client:           criClient,
+		containerdClient: containerdClient,
+	}, nil
+}
+
+type criStaticPodRuntime struct {
+	*cri.Client
+
+	containerdClient *containerd.Client
+}
+
+func (rt *criStaticPodRuntime) Events(ctx context.Context) (<-chan struct{}, <-chan error) {
+	envelopeCh, errCh := rt.containerdClient.Subscribe(ctx,
+		fmt.Sprintf(`namespace==%q,topic~="^/tasks/"`, constants.K8sContainerdNamespace),
+	)
+
+	notifyCh := make(chan struct{}, 1)
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case _, ok := <-envelopeCh:
+				if !ok {
+					return
+				}
+			}
+
+			select {
+			case notifyCh <- struct{}{}:
+			default:
+				// notification is already pending
+			}
+		}
+	}()
+
+	return notifyCh, errCh
+}
+
+func (rt *criStaticPodRuntime) Close() error {
+	rt.containerdClient.Close() //nolint:errcheck
+
+	return rt.Client.Close()
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo,cyclop
+func (ctrl *StaticPodRuntimeStatusController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.RuntimeProvider == nil {
+		ctrl.RuntimeProvider = defaultStaticPodRuntime
+	}
+
+	if ctrl.Clock == nil {
+		ctrl.Clock = clock.New()
+	}
+
+	ctrl.pods = map[resource.ID]*staticPodRestarts{}
+
+	ticker := ctrl.Clock.Ticker(StaticPodRuntimeStatusRefreshInterval)
+	defer ticker.Stop()
+
+	var (
+		podRuntime   StaticPodRuntime
+		eventsCh     <-chan struct{}
+		eventsErrCh  <-chan error
+		eventsCancel context.CancelFunc
+		closeRuntime = func() {
+			if podRuntime == nil {
+				return
+			}
+
+			eventsCancel()
+			podRuntime.Close() //nolint:errcheck
+
+			podRuntime, eventsCh, eventsErrCh = nil, nil, nil
+		}
+	)
+
+	defer closeRuntime()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ticker.C:
+		case <-eventsCh:
+		case err := <-eventsErrCh:
+			return fmt.Errorf("error watching containerd events: %w", err)
+		}
+
+		criService, err := safe.ReaderGetByID[*v1alpha1.Service](ctx, r, "cri")
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting CRI service: %w", err)
+		}
+
+		r.StartTrackingOutputs()
+
+		if criService != nil && criService.TypedSpec().Running && criService.TypedSpec().Healthy {
+			if podRuntime == nil {
+				podRuntime, err = ctrl.RuntimeProvider()
+				if err != nil {
+					return fmt.Errorf("error creating static pod runtime: %w", err)
+				}
+
+				var eventsCtx context.Context
+
+				eventsCtx, eventsCancel = context.WithCancel(ctx)
+				eventsCh, eventsErrCh = podRuntime.Events(eventsCtx)
+			}
+
+			if err = ctrl.reconcile(ctx, r, logger, podRuntime); err != nil {
+				return err
+			}
+		} else {
+			closeRuntime()
+		}
+
+		if err = safe.CleanupOutputs[*k8s.StaticPodRuntimeStatus](ctx, r); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func (ctrl *StaticPodRuntimeStatusController) reconcile(ctx context.Context, r controller.Runtime, logger *zap.Logger, podRuntime StaticPodRuntime) error {
+	sandboxes, err := podRuntime.ListPodSandbox(ctx, &runtimeapi.PodSandboxFilter{
+		LabelSelector: map[string]string{
+			"tier": "control-plane",
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error listing control plane pod sandboxes: %w", err)
+	}
+
+	podStatuses, err := safe.ReaderListAll[*k8s.StaticPodStatus](ctx, r)
+	if err != nil {
+		return fmt.Errorf("error listing static pod statuses: %w", err)
+	}
+
+	// static pod ID -> most recent sandbox
+	latestSandboxes := map[resource.ID]*runtimeapi.PodSandbox{}
+
+	for _, sandbox := range sandboxes {
+		id := sandbox.GetLabels()["k8s-app"]
+		if id == "" {
+			continue
+		}
+
+		if latest, ok := latestSandboxes[id]; !ok || sandbox.GetCreatedAt() > latest.GetCreatedAt() {
+			latestSandboxes[id] = sandbox
+		}
+	}
+
+	for id, sandbox := range latestSandboxes {
+		spec, err := ctrl.podRuntimeStatus(ctx, podRuntime, sandbox)
+		if err != nil {
+			return fmt.Errorf("error getting runtime status of %s: %w", id, err)
+		}
+
+		podName := sandbox.GetMetadata().GetNamespace() + "/" + sandbox.GetMetadata().GetName()
+
+		if podStatus, ok := podStatuses.Find(func(podStatus *k8s.StaticPodStatus) bool {
+			return podStatus.Metadata().ID() == podName
+		}); ok {
+			spec.Ready, spec.LastProbeFailure = staticPodReadiness(podStatus.TypedSpec().PodStatus)
+		}
+
+		ctrl.trackRestarts(ctx, logger, id, sandbox.GetId(), &spec)
+
+		if err = safe.WriterModify(ctx, r, k8s.NewStaticPodRuntimeStatus(k8s.NamespaceName, id), func(res *k8s.StaticPodRuntimeStatus) error {
+			*res.TypedSpec() = spec
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating static pod runtime status: %w", err)
+		}
+	}
+
+	for id := range ctrl.pods {
+		if _, ok := latestSandboxes[id]; !ok {
+			delete(ctrl.pods, id)
+		}
+	}
+
+	return nil
+}
+
+func (ctrl *StaticPodRuntimeStatusController) podRuntimeStatus(ctx context.Context, podRuntime StaticPodRuntime, sandbox *runtimeapi.PodSandbox) (k8s.StaticPodRuntimeStatusSpec, error) {
+	spec := k8s.StaticPodRuntimeStatusSpec{
+		PodName: sandbox.GetMetadata().GetName(),
+		Phase:   k8s.StaticPodPhaseUnknown,
+	}
+
+	containers, err := podRuntime.ListContainers(ctx, &runtimeapi.ContainerFilter{
+		PodSandboxId: sandbox.GetId(),
+	})
+	if err != nil {
+		return spec, fmt.Errorf("error listing containers: %w", err)
+	}
+
+	if len(containers) == 0 {
+		return spec, nil
+	}
+
+	// most recent attempt first
+	slices.SortFunc(containers, func(a, b *runtimeapi.Container) int {
+		return int(b.GetMetadata().GetAttempt()) - int(a.GetMetadata().GetAttempt())
+	})
+
+	current := containers[0]
+
+	spec.RestartCount = int(current.GetMetadata().GetAttempt())
+	spec.Image = current.GetImage().GetImage()
+	spec.ImageRef = current.GetImageRef()
+
+	switch current.GetState() {
+	case runtimeapi.ContainerState_CONTAINER_RUNNING:
+		spec.Phase = k8s.StaticPodPhaseRunning
+	case runtimeapi.ContainerState_CONTAINER_CREATED:
+		spec.Phase = k8s.StaticPodPhaseCreated
+	case runtimeapi.ContainerState_CONTAINER_EXITED:
+		spec.Phase = k8s.StaticPodPhaseExited
+	case runtimeapi.ContainerState_CONTAINER_UNKNOWN:
+	}
+
+	// the last exited container is either the current one, or the previous attempt
+	for _, container := range containers {
+		if container.GetState() != runtimeapi.ContainerState_CONTAINER_EXITED {
+			continue
+		}
+
+		status, _, err := podRuntime.ContainerStatus(ctx, container.GetId(), false)
+		if err != nil {
+			return spec, fmt.Errorf("error getting container status: %w", err)
+		}
+
+		spec.LastExitCode = int(status.GetExitCode())
+		spec.LastExitReason = status.GetReason()
+
+		if status.GetMessage() != "" {
+			spec.LastExitReason = strings.TrimSpace(spec.LastExitReason + ": " + status.GetMessage())
+		}
+
+		break
+	}
+
+	return spec, nil
+}
+
+// trackRestarts records the restarts of the static pod, and reports the restart storm.
+func (ctrl *StaticPodRuntimeStatusController) trackRestarts(ctx context.Context, logger *zap.Logger, id resource.ID, sandboxID string, spec *k8s.StaticPodRuntimeStatusSpec) {
+	now := ctrl.Clock.Now()
+
+	pod, ok := ctrl.pods[id]
+	if !ok || pod.sandboxID != sandboxID {
+		// the pod was (re)created, restart count starts over
+		pod = &staticPodRestarts{
+			sandboxID:    sandboxID,
+			restartCount: spec.RestartCount,
+		}
+
+		ctrl.pods[id] = pod
+	}
+
+	for range spec.RestartCount - pod.restartCount {
+		pod.restarts = append(pod.restarts, now)
+	}
+
+	pod.restartCount = spec.RestartCount
+	pod.restarts = slices.DeleteFunc(pod.restarts, func(t time.Time) bool {
+		return now.Sub(t) > StaticPodRestartStormWindow
+	})
+
+	if spec.LastProbeFailure != "" {
+		pod.lastProbeFailure = spec.LastProbeFailure
+	}
+
+	spec.LastProbeFailure = pod.lastProbeFailure
+
+	storm := len(pod.restarts) >= StaticPodRestartStormThreshold
+
+	if storm && !pod.storm {
+		logger.Warn("static pod is restarting repeatedly",
+			zap.String("id", id),
+			zap.Int("restart_count", spec.RestartCount),
+			zap.Int("recent_restarts", len(pod.restarts)),
+			zap.Int("last_exit_code", spec.LastExitCode),
+			zap.String("last_exit_reason", spec.LastExitReason),
+		)
+
+		if ctrl.V1Alpha1Events != nil {
+			ctrl.V1Alpha1Events.Publish(ctx, &machine.StaticPodRestartStormEvent{
+				Id:             id,
+				PodName:        spec.PodName,
+				RestartCount:   int64(spec.RestartCount),
+				RecentRestarts: int64(len(pod.restarts)),
+				LastExitCode:   int64(spec.LastExitCode),
+				LastExitReason: spec.LastExitReason,
+			})
+		}
+	}
+
+	pod.storm = storm
+	spec.RestartStorm = storm
+}
+
+// staticPodReadiness returns the readiness of the static pod as reported by the kubelet.
+//
+// The kubelet doesn't expose the probe output, so the message of the Ready condition is used as the probe failure.
+func staticPodReadiness(podStatus map[string]any) (ready bool, probeFailure string) {
+	conditions, _ := podStatus["conditions"].([]any) //nolint:errcheck
+
+	for _, condition := range conditions {
+		conditionObj, ok := condition.(map[string]any)
+		if !ok || conditionObj["type"] != "Ready" {
+			continue
+		}
+
+		if conditionObj["status"] == "True" {
+			return true, ""
+		}
+
+		message, _ := conditionObj["message"].(string) //nolint:errcheck
+
+		return false, message
+	}
+
+	return false, ""
+}
@@ -0,0 +1,292 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/proto"
+	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	k8sctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+type mockStaticPodRuntime struct {
+	mu         sync.Mutex
+	sandboxes  []*runtimeapi.PodSandbox
+	containers []*runtimeapi.Container
+	statuses   map[string]*runtimeapi.ContainerStatus
+
+	eventsCh chan struct{}
+}
+
+func newMockStaticPodRuntime() *mockStaticPodRuntime {
+	return &mockStaticPodRuntime{
+		statuses: map[string]*runtimeapi.ContainerStatus{},
+		eventsCh: make(chan struct{}, 1),
+	}
+}
+
+func (rt *mockStaticPodRuntime) ListPodSandbox(_ context.Context, filter *runtimeapi.PodSandboxFilter) ([]*runtimeapi.PodSandbox, error) {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	var result []*runtimeapi.PodSandbox
+
+outer:
+	for _, sandbox := range rt.sandboxes {
+		for k, v := range filter.GetLabelSelector() {
+			if sandbox.GetLabels()[k] != v {
+				continue outer
+			}
+		}
+
+		result = append(result, proto.Clone(sandbox).(*runtimeapi.PodSandbox)) //nolint:forcetypeassert
+	}
+
+	return result, nil
+}
+
+func (rt *mockStaticPodRuntime) ListContainers(_ context.Context, filter *runtimeapi.ContainerFilter) ([]*runtimeapi.Container, error) {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	var result []*runtimeapi.Container
+
+	for _, container := range rt.containers {
+		if container.GetPodSandboxId() == filter.GetPodSandboxId() {
+			result = append(result, proto.Clone(container).(*runtimeapi.Container)) //nolint:forcetypeassert
+		}
+	}
+
+	return result, nil
+}
+
+func (rt *mockStaticPodRuntime) ContainerStatus(_ context.Context, containerID string, _ bool) (*runtimeapi.ContainerStatus, map[string]string, error) {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	status, ok := rt.statuses[containerID]
+	if !ok {
+		return nil, nil, fmt.Errorf("container %q not found", containerID)
+	}
+
+	return status, nil, nil
+}
+
+func (rt *mockStaticPodRuntime) Events(context.Context) (<-chan struct{}, <-chan error) {
+	return rt.eventsCh, nil
+}
+
+func (rt *mockStaticPodRuntime) Close() error {
+	return nil
+}
+
+// restart replaces the running container of the sandbox with a new attempt, and marks the previous one as exited.
+func (rt *mockStaticPodRuntime) restart(sandboxID string, exitCode int32, reason string) {
+	rt.mu.Lock()
+
+	var attempt uint32
+
+	for _, container := range rt.containers {
+		if container.GetPodSandboxId() == sandboxID && container.GetState() == runtimeapi.ContainerState_CONTAINER_RUNNING {
+			container.State = runtimeapi.ContainerState_CONTAINER_EXITED
+			rt.statuses[container.GetId()] = &runtimeapi.ContainerStatus{
+				Id:       container.GetId(),
+				State:    runtimeapi.ContainerState_CONTAINER_EXITED,
+				ExitCode: exitCode,
+				Reason:   reason,
+			}
+
+			attempt = container.GetMetadata().GetAttempt() + 1
+		}
+	}
+
+	rt.containers = append(rt.containers, &runtimeapi.Container{
+		Id:           fmt.Sprintf("%s-%d", sandboxID, attempt),
+		PodSandboxId: sandboxID,
+		Metadata:     &runtimeapi.ContainerMetadata{Attempt: attempt},
+		Image:        &runtimeapi.ImageSpec{Image: "registry.k8s.io/kube-apiserver:v1.34.1"},
+		ImageRef:     "sha256:abcd",
+		State:        runtimeapi.ContainerState_CONTAINER_RUNNING,
+	})
+
+	rt.mu.Unlock()
+
+	select {
+	case rt.eventsCh <- struct{}{}:
+	default:
+	}
+}
+
+type mockStaticPodEvents struct {
+	mu     sync.Mutex
+	events []*machine.StaticPodRestartStormEvent
+}
+
+func (s *mockStaticPodEvents) Publish(_ context.Context, m proto.Message) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if event, ok := m.(*machine.StaticPodRestartStormEvent); ok {
+		s.events = append(s.events, event)
+	}
+}
+
+func (s *mockStaticPodEvents) count() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.events)
+}
+
+type StaticPodRuntimeStatusSuite struct {
+	ctest.DefaultSuite
+
+	clock       *clock.Mock
+	podRuntime  *mockStaticPodRuntime
+	stormEvents *mockStaticPodEvents
+}
+
+func TestStaticPodRuntimeStatusSuite(t *testing.T) {
+	t.Parallel()
+
+	s := &StaticPodRuntimeStatusSuite{}
+
+	s.DefaultSuite = ctest.DefaultSuite{
+		Timeout: 10 * time.Second,
+		AfterSetup: func(suite *ctest.DefaultSuite) {
+			s.clock = clock.NewMock()
+			s.podRuntime = newMockStaticPodRuntime()
+			s.stormEvents = &mockStaticPodEvents{}
+
+			suite.Require().NoError(suite.Runtime().RegisterController(&k8sctrl.StaticPodRuntimeStatusController{
+				V1Alpha1Events: s.stormEvents,
+				RuntimeProvider: func() (k8sctrl.StaticPodRuntime, error) {
+					return s.podRuntime, nil
+				},
+				Clock: s.clock,
+			}))
+		},
+	}
+
+	suite.Run(t, s)
+}
+
+func (suite *StaticPodRuntimeStatusSuite) TestStatus() {
+	suite.podRuntime.sandboxes = []*runtimeapi.PodSandbox{
+		{
+			Id:       "sandbox1",
+			Metadata: &runtimeapi.PodSandboxMetadata{Name: "kube-apiserver-node1", Namespace: "kube-system"},
+			Labels:   map[string]string{"tier": "control-plane", "k8s-app": "kube-apiserver"},
+		},
+		{
+			Id:       "sandbox2",
+			Metadata: &runtimeapi.PodSandboxMetadata{Name: "coredns-1234", Namespace: "kube-system"},
+			Labels:   map[string]string{"k8s-app": "kube-dns"},
+		},
+	}
+
+	suite.podRuntime.restart("sandbox1", 0, "")
+	suite.podRuntime.restart("sandbox2", 0, "")
+
+	criService := v1alpha1.NewService("cri")
+	criService.TypedSpec().Running = true
+	criService.TypedSpec().Healthy = true
+	suite.Create(criService)
+
+	ctest.AssertResource(suite, "kube-apiserver", func(status *k8s.StaticPodRuntimeStatus, asrt *assert.Assertions) {
+		asrt.Equal("kube-apiserver-node1", status.TypedSpec().PodName)
+		asrt.Equal(k8s.StaticPodPhaseRunning, status.TypedSpec().Phase)
+		asrt.Equal("registry.k8s.io/kube-apiserver:v1.34.1", status.TypedSpec().Image)
+		asrt.Equal("sha256:abcd", status.TypedSpec().ImageRef)
+		asrt.Zero(status.TypedSpec().RestartCount)
+		asrt.False(status.TypedSpec().Ready)
+	})
+
+	ctest.AssertNoResource[*k8s.StaticPodRuntimeStatus](suite, "kube-dns")
+
+	podStatus := k8s.NewStaticPodStatus(k8s.NamespaceName, "kube-system/kube-apiserver-node1")
+	podStatus.TypedSpec().PodStatus = map[string]any{
+		"conditions": []any{
+			map[string]any{
+				"type":    "Ready",
+				"status":  "False",
+				"message": "containers with unready status: [kube-apiserver]",
+			},
+		},
+	}
+	suite.Create(podStatus)
+
+	ctest.AssertResource(suite, "kube-apiserver", func(status *k8s.StaticPodRuntimeStatus, asrt *assert.Assertions) {
+		asrt.False(status.TypedSpec().Ready)
+		asrt.Equal("containers with unready status: [kube-apiserver]", status.TypedSpec().LastProbeFailure)
+	})
+
+	for range k8sctrl.StaticPodRestartStormThreshold - 1 {
+		suite.podRuntime.restart("sandbox1", 1, "Error")
+
+		suite.clock.Add(time.Second)
+	}
+
+	ctest.AssertResource(suite, "kube-apiserver", func(status *k8s.StaticPodRuntimeStatus, asrt *assert.Assertions) {
+		asrt.Equal(k8sctrl.StaticPodRestartStormThreshold-1, status.TypedSpec().RestartCount)
+		asrt.Equal(1, status.TypedSpec().LastExitCode)
+		asrt.Equal("Error", status.TypedSpec().LastExitReason)
+		asrt.False(status.TypedSpec().RestartStorm)
+	})
+
+	suite.Assert().Zero(suite.stormEvents.count())
+
+	suite.podRuntime.restart("sandbox1", 137, "OOMKilled")
+
+	ctest.AssertResource(suite, "kube-apiserver", func(status *k8s.StaticPodRuntimeStatus, asrt *assert.Assertions) {
+		asrt.Equal(k8sctrl.StaticPodRestartStormThreshold, status.TypedSpec().RestartCount)
+		asrt.Equal(137, status.TypedSpec().LastExitCode)
+		asrt.Equal("OOMKilled", status.TypedSpec().LastExitReason)
+		asrt.True(status.TypedSpec().RestartStorm)
+	})
+
+	suite.Assert().Equal(1, suite.stormEvents.count())
+	suite.Assert().EqualValues(137, suite.stormEvents.events[0].GetLastExitCode())
+
+	// the pod becomes ready, and the restarts fall out of the window
+	podStatus.TypedSpec().PodStatus = map[string]any{
+		"conditions": []any{
+			map[string]any{
+				"type":   "Ready",
+				"status": "True",
+			},
+		},
+	}
+	suite.Update(podStatus)
+
+	suite.clock.Add(k8sctrl.StaticPodRestartStormWindow + k8sctrl.StaticPodRuntimeStatusRefreshInterval)
+
+	ctest.AssertResource(suite, "kube-apiserver", func(status *k8s.StaticPodRuntimeStatus, asrt *assert.Assertions) {
+		asrt.True(status.TypedSpec().Ready)
+		asrt.False(status.TypedSpec().RestartStorm)
+		asrt.Equal("containers with unready status: [kube-apiserver]", status.TypedSpec().LastProbeFailure)
+	})
+
+	suite.Assert().Equal(1, suite.stormEvents.count())
+
+	// CRI goes down
+	criService.TypedSpec().Running = false
+	suite.Update(criService)
+
+	ctest.AssertNoResource[*k8s.StaticPodRuntimeStatus](suite, "kube-apiserver")
+}
@@ -6,6 +6,8 @@ package kubespan
 
 import (
 	"context"
+	"fmt"
+	"net/netip"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/controller/generic/transform"
@@ -51,7 +53,29 @@ func NewConfigController() *ConfigController {
 					res.TypedSpec().HarvestExtraEndpoints = c.Machine().Network().KubeSpan().HarvestExtraEndpoints()
 					res.TypedSpec().MTU = c.Machine().Network().KubeSpan().MTU()
 					res.TypedSpec().EndpointFilters = c.Machine().Network().KubeSpan().Filters().Endpoints()
+					res.TypedSpec().PeerEndpointFilters = c.Machine().Network().KubeSpan().Filters().PeerEndpoints()
 					res.TypedSpec().ExtraEndpoints = c.KubespanConfig().ExtraAnnouncedEndpoints()
+					res.TypedSpec().ExtraHostnameEndpoints = c.KubespanConfig().ExtraAnnouncedHostnameEndpoints()
+					res.TypedSpec().PersistentKeepaliveInterval = c.Machine().Network().KubeSpan().Keepalive().Interval()
+					res.TypedSpec().PersistentKeepaliveNATOnly = c.Machine().Network().KubeSpan().Keepalive().NATOnly()
+
+					for _, override := range c.Machine().Network().KubeSpan().Keepalive().Overrides() {
+						keepaliveOverride := kubespan.KeepaliveOverride{
+							Label:    override.Label(),
+							Interval: override.Interval(),
+						}
+
+						if override.CIDR() != "" {
+							cidr, err := netip.ParsePrefix(override.CIDR())
+							if err != nil {
+								return fmt.Errorf("error parsing keepalive override CIDR: %w", err)
+							}
+
+							keepaliveOverride.CIDR = cidr
+						}
+
+						res.TypedSpec().PersistentKeepaliveOverrides = append(res.TypedSpec().PersistentKeepaliveOverrides, keepaliveOverride)
+					}
 				}
 
 				return nil
@@ -18,6 +18,7 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/config/container"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/kubespan"
 )
@@ -38,6 +39,15 @@ func (suite *ConfigSuite) TestReconcileConfig() {
 				MachineNetwork: &v1alpha1.NetworkConfig{
 					NetworkKubeSpan: &v1alpha1.NetworkKubeSpan{
 						KubeSpanEnabled: pointer.To(true),
+						KubeSpanKeepalive: &v1alpha1.KubeSpanKeepalive{
+							KeepaliveNATOnly: pointer.To(true),
+							KeepaliveOverrides: []v1alpha1.KubeSpanKeepaliveOverride{
+								{
+									OverrideCIDR:     "10.0.0.0/8",
+									OverrideInterval: 10 * time.Second,
+								},
+							},
+						},
 					},
 				},
 			},
@@ -50,6 +60,9 @@ func (suite *ConfigSuite) TestReconcileConfig() {
 			ExtraAnnouncedEndpointsConfig: []netip.AddrPort{
 				netip.MustParseAddrPort("192.168.33.11:1001"),
 			},
+			ExtraAnnouncedHostnameEndpointsConfig: []string{
+				"hub.example.com:51820",
+			},
 		},
 	)
 	suite.Require().NoError(err)
@@ -73,6 +86,15 @@ func (suite *ConfigSuite) TestReconcileConfig() {
 				suite.Assert().False(spec.AdvertiseKubernetesNetworks)
 				suite.Assert().False(spec.HarvestExtraEndpoints)
 				suite.Assert().Equal("[\"192.168.33.11:1001\"]", fmt.Sprintf("%q", spec.ExtraEndpoints))
+				suite.Assert().Equal([]string{"hub.example.com:51820"}, spec.ExtraHostnameEndpoints)
+				suite.Assert().Equal(constants.KubeSpanDefaultPeerKeepalive, spec.PersistentKeepaliveInterval)
+				suite.Assert().True(spec.PersistentKeepaliveNATOnly)
+				suite.Assert().Equal([]kubespan.KeepaliveOverride{
+					{
+						CIDR:     netip.MustParsePrefix("10.0.0.0/8"),
+						Interval: 10 * time.Second,
+					},
+				}, spec.PersistentKeepaliveOverrides)
 
 				return nil
 			},
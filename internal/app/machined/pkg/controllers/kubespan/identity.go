@@ -6,8 +6,11 @@ package kubespan
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io/fs"
 	"net"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/safe"
@@ -23,6 +26,7 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/fipsmode"
 	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+	"github.com/siderolabs/talos/pkg/machinery/resources/cluster"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/kubespan"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
@@ -30,8 +34,13 @@ import (
 )
 
 // IdentityController watches KubeSpan configuration, updates KubeSpan Identity.
+//
+// The KubeSpan key is regenerated on node identity reset request.
 type IdentityController struct {
 	stateMachine blockautomaton.VolumeMounterAutomaton
+
+	// resetRequestedAt is the last handled identity reset request.
+	resetRequestedAt time.Time
 }
 
 // Name implements controller.Controller interface.
@@ -54,6 +63,12 @@ func (ctrl *IdentityController) Inputs() []controller.Input {
 			ID:        optional.Some(network.FirstHardwareAddr),
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: cluster.NamespaceName,
+			Type:      cluster.IdentityResetType,
+			ID:        optional.Some(cluster.LocalIdentity),
+			Kind:      controller.InputWeak,
+		},
 		{
 			Namespace: block.NamespaceName,
 			Type:      block.VolumeMountStatusType,
@@ -102,6 +117,17 @@ func (ctrl *IdentityController) Run(ctx context.Context, r controller.Runtime, l
 			return fmt.Errorf("error getting first MAC address: %w", err)
 		}
 
+		identityReset, err := safe.ReaderGetByID[*cluster.IdentityReset](ctx, r, cluster.LocalIdentity)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting identity reset request: %w", err)
+		}
+
+		var resetRequestedAt time.Time
+
+		if identityReset != nil && !identityReset.TypedSpec().RequestedAt.Equal(ctrl.resetRequestedAt) {
+			resetRequestedAt = identityReset.TypedSpec().RequestedAt
+		}
+
 		_, err = safe.ReaderGetByID[*kubespan.Identity](ctx, r, kubespan.LocalIdentity)
 		alreadyHasIdentity := err == nil
 
@@ -110,17 +136,29 @@ func (ctrl *IdentityController) Run(ctx context.Context, r controller.Runtime, l
 				return fmt.Errorf("KubeSpan is not supported in strict FIPS mode")
 			}
 
-			if ctrl.stateMachine == nil && !alreadyHasIdentity {
+			if ctrl.stateMachine == nil && (!alreadyHasIdentity || !resetRequestedAt.IsZero()) {
 				ctrl.stateMachine = blockautomaton.NewVolumeMounter(
 					ctrl.Name(),
 					constants.StatePartitionLabel,
-					ctrl.establishIdentity(cfg, firstMAC),
+					ctrl.establishIdentity(cfg, firstMAC, resetRequestedAt),
 					blockautomaton.WithDetached(true),
 				)
 			}
-		} else if alreadyHasIdentity {
-			if err = r.Destroy(ctx, kubespan.NewIdentity(kubespan.NamespaceName, kubespan.LocalIdentity).Metadata()); err != nil {
-				return fmt.Errorf("error cleaning up identity: %w", err)
+		} else {
+			if alreadyHasIdentity {
+				if err = r.Destroy(ctx, kubespan.NewIdentity(kubespan.NamespaceName, kubespan.LocalIdentity).Metadata()); err != nil {
+					return fmt.Errorf("error cleaning up identity: %w", err)
+				}
+			}
+
+			// drop the cached key, so that a new one is generated when KubeSpan gets enabled
+			if ctrl.stateMachine == nil && !resetRequestedAt.IsZero() {
+				ctrl.stateMachine = blockautomaton.NewVolumeMounter(
+					ctrl.Name(),
+					constants.StatePartitionLabel,
+					ctrl.resetIdentity(resetRequestedAt),
+					blockautomaton.WithDetached(true),
+				)
 			}
 		}
 
@@ -141,12 +179,18 @@ func (ctrl *IdentityController) Run(ctx context.Context, r controller.Runtime, l
 }
 
 func (ctrl *IdentityController) establishIdentity(
-	cfg *kubespan.Config, firstMAC *network.HardwareAddr,
+	cfg *kubespan.Config, firstMAC *network.HardwareAddr, resetRequestedAt time.Time,
 ) func(
 	ctx context.Context, r controller.ReaderWriter, logger *zap.Logger, mountStatus *block.VolumeMountStatus,
 ) error {
 	return func(ctx context.Context, r controller.ReaderWriter, logger *zap.Logger, mountStatus *block.VolumeMountStatus) error {
 		return blockadapter.VolumeMountStatus(mountStatus).WithRoot(logger, func(root xfs.Root) error {
+			if !resetRequestedAt.IsZero() {
+				if err := removeCachedIdentity(root); err != nil {
+					return err
+				}
+			}
+
 			var localIdentity kubespan.IdentitySpec
 
 			if err := controllers.LoadOrNewFromFile(root, constants.KubeSpanIdentityFilename, &localIdentity, func(v *kubespan.IdentitySpec) error {
@@ -162,11 +206,47 @@ func (ctrl *IdentityController) establishIdentity(
 				return fmt.Errorf("error updating KubeSpan address: %w", err)
 			}
 
-			return safe.WriterModify(ctx, r, kubespan.NewIdentity(kubespan.NamespaceName, kubespan.LocalIdentity), func(res *kubespan.Identity) error {
+			if err := safe.WriterModify(ctx, r, kubespan.NewIdentity(kubespan.NamespaceName, kubespan.LocalIdentity), func(res *kubespan.Identity) error {
 				*res.TypedSpec() = localIdentity
 
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
+
+			if !resetRequestedAt.IsZero() {
+				ctrl.resetRequestedAt = resetRequestedAt
+
+				logger.Warn("KubeSpan identity reset", zap.String("public_key", localIdentity.PublicKey))
+			}
+
+			return nil
+		})
+	}
+}
+
+func (ctrl *IdentityController) resetIdentity(
+	resetRequestedAt time.Time,
+) func(
+	ctx context.Context, r controller.ReaderWriter, logger *zap.Logger, mountStatus *block.VolumeMountStatus,
+) error {
+	return func(ctx context.Context, r controller.ReaderWriter, logger *zap.Logger, mountStatus *block.VolumeMountStatus) error {
+		return blockadapter.VolumeMountStatus(mountStatus).WithRoot(logger, func(root xfs.Root) error {
+			if err := removeCachedIdentity(root); err != nil {
+				return err
+			}
+
+			ctrl.resetRequestedAt = resetRequestedAt
+
+			return nil
 		})
 	}
 }
+
+func removeCachedIdentity(root xfs.Root) error {
+	if err := xfs.Remove(root, constants.KubeSpanIdentityFilename); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("error removing cached kubespan identity: %w", err)
+	}
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/stretchr/testify/assert"
@@ -20,6 +21,7 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/fipsmode"
 	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+	"github.com/siderolabs/talos/pkg/machinery/resources/cluster"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/kubespan"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
@@ -132,6 +134,80 @@ publicKey: Oak2fBEWngBhwslBxDVgnRNHXs88OAp4kjroSX0uqUE=
 	ctest.AssertNoResource[*block.VolumeMountRequest](suite, mountID)
 }
 
+func (suite *IdentitySuite) TestReset() {
+	statePath := suite.T().TempDir()
+	mountID := (&kubespanctrl.IdentityController{}).Name() + "-" + constants.StatePartitionLabel
+
+	const identityYaml = `address: ""
+subnet: ""
+privateKey: sF45u5ePau58WeeCUY3T8D9foEKaQ8Opx4cGC8g4XE4=
+publicKey: Oak2fBEWngBhwslBxDVgnRNHXs88OAp4kjroSX0uqUE=
+`
+
+	suite.Require().NoError(os.WriteFile(filepath.Join(statePath, constants.KubeSpanIdentityFilename), []byte(identityYaml), 0o600))
+
+	cfg := kubespan.NewConfig(config.NamespaceName, kubespan.ConfigID)
+	cfg.TypedSpec().Enabled = true
+	cfg.TypedSpec().ClusterID = "8XuV9TZHW08DOk3bVxQjH9ih_TBKjnh-j44tsCLSBzo="
+
+	suite.Create(cfg)
+
+	firstMac := network.NewHardwareAddr(network.NamespaceName, network.FirstHardwareAddr)
+	mac, err := net.ParseMAC("ea:71:1b:b2:cc:ee")
+	suite.Require().NoError(err)
+
+	firstMac.TypedSpec().HardwareAddr = nethelpers.HardwareAddr(mac)
+	suite.Create(firstMac)
+
+	ctest.AssertResource(suite, mountID, func(*block.VolumeMountRequest, *assert.Assertions) {})
+
+	volumeMountStatus := block.NewVolumeMountStatus(block.NamespaceName, mountID)
+	volumeMountStatus.TypedSpec().Target = statePath
+	suite.Create(volumeMountStatus)
+
+	ctest.AssertResource(suite, kubespan.LocalIdentity, func(identity *kubespan.Identity, asrt *assert.Assertions) {
+		asrt.Equal("Oak2fBEWngBhwslBxDVgnRNHXs88OAp4kjroSX0uqUE=", identity.TypedSpec().PublicKey)
+	})
+
+	ctest.AssertResources(suite, []resource.ID{volumeMountStatus.Metadata().ID()}, func(vms *block.VolumeMountStatus, asrt *assert.Assertions) {
+		asrt.True(vms.Metadata().Finalizers().Empty())
+	})
+
+	suite.Destroy(volumeMountStatus)
+
+	ctest.AssertNoResource[*block.VolumeMountRequest](suite, mountID)
+
+	identityReset := cluster.NewIdentityReset(cluster.NamespaceName, cluster.LocalIdentity)
+	identityReset.TypedSpec().RequestedAt = time.Now()
+	suite.Create(identityReset)
+
+	ctest.AssertResource(suite, mountID, func(*block.VolumeMountRequest, *assert.Assertions) {})
+
+	volumeMountStatus = block.NewVolumeMountStatus(block.NamespaceName, mountID)
+	volumeMountStatus.TypedSpec().Target = statePath
+	suite.Create(volumeMountStatus)
+
+	ctest.AssertResource(suite, kubespan.LocalIdentity, func(identity *kubespan.Identity, asrt *assert.Assertions) {
+		spec := identity.TypedSpec()
+
+		asrt.NotEqual("sF45u5ePau58WeeCUY3T8D9foEKaQ8Opx4cGC8g4XE4=", spec.PrivateKey)
+		asrt.NotEqual("Oak2fBEWngBhwslBxDVgnRNHXs88OAp4kjroSX0uqUE=", spec.PublicKey)
+
+		_, err := wgtypes.ParseKey(spec.PublicKey)
+		asrt.NoError(err)
+
+		asrt.Equal("fd7f:175a:b97c:5602:e871:1bff:feb2:ccee/128", spec.Address.String())
+	})
+
+	ctest.AssertResources(suite, []resource.ID{volumeMountStatus.Metadata().ID()}, func(vms *block.VolumeMountStatus, asrt *assert.Assertions) {
+		asrt.True(vms.Metadata().Finalizers().Empty())
+	})
+
+	suite.Destroy(volumeMountStatus)
+
+	ctest.AssertNoResource[*block.VolumeMountRequest](suite, mountID)
+}
+
 func TestIdentitySuite(t *testing.T) {
 	t.Parallel()
 
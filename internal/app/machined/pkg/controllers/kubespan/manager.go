@@ -285,9 +285,14 @@ func (ctrl *ManagerController) Run(ctx context.Context, r controller.Runtime, lo
 
 			var endpoint string
 
-			// check if the endpoint should be updated
-			if kubespanadapter.PeerStatusSpec(peerStatus).ShouldChangeEndpoint() {
-				newEndpoint := kubespanadapter.PeerStatusSpec(peerStatus).PickNewEndpoint(peerSpec.Endpoints)
+			endpoints, err := kubespanadapter.PeerStatusSpec(peerStatus).FilterEndpoints(peerSpec.Endpoints, cfgSpec.PeerEndpointFilters)
+			if err != nil {
+				return fmt.Errorf("error filtering KubeSpan peer endpoints: %w", err)
+			}
+
+			// check if the endpoint should be updated, peers with working endpoints are switched only if the endpoint got filtered out
+			if kubespanadapter.PeerStatusSpec(peerStatus).ShouldChangeEndpoint() || kubespanadapter.PeerStatusSpec(peerStatus).IsEndpointFiltered() {
+				newEndpoint := kubespanadapter.PeerStatusSpec(peerStatus).PickNewEndpoint(endpoints)
 
 				if !value.IsZero(newEndpoint) {
 					logger.Debug("updating endpoint for the peer", zap.String("peer", pubKey), zap.String("label", peerSpec.Label), zap.Stringer("endpoint", newEndpoint))
@@ -307,11 +312,14 @@ func (ctrl *ManagerController) Run(ctx context.Context, r controller.Runtime, lo
 				updateSpecs = true
 			}
 
+			// keepalive changes are applied to the existing peers in place
+			kubespanadapter.PeerStatusSpec(peerStatus).CalculateKeepalive(cfgSpec, peerSpec.Endpoints)
+
 			wgPeers = append(wgPeers, network.WireguardPeer{
 				PublicKey:                   pubKey,
 				PresharedKey:                cfgSpec.SharedSecret,
 				Endpoint:                    endpoint,
-				PersistentKeepaliveInterval: constants.KubeSpanDefaultPeerKeepalive,
+				PersistentKeepaliveInterval: peerStatus.PersistentKeepaliveInterval,
 				AllowedIPs:                  slices.Clone(peerSpec.AllowedIPs),
 			})
 		}
@@ -492,6 +500,7 @@ func (ctrl *ManagerController) Run(ctx context.Context, r controller.Runtime, lo
 				Flags:       0,
 				Protocol:    nethelpers.ProtocolStatic,
 				ConfigLayer: network.ConfigOperator,
+				Origin:      network.RouteOriginKubeSpan,
 			},
 			{
 				Family:      nethelpers.FamilyInet6,
@@ -507,6 +516,7 @@ func (ctrl *ManagerController) Run(ctx context.Context, r controller.Runtime, lo
 				Flags:       0,
 				Protocol:    nethelpers.ProtocolStatic,
 				ConfigLayer: network.ConfigOperator,
+				Origin:      network.RouteOriginKubeSpan,
 			},
 		} {
 			if err = safe.WriterModify(ctx, r,
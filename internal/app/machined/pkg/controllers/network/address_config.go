@@ -257,29 +257,32 @@ func (ctrl *AddressConfigController) processDevicesConfiguration(logger *zap.Log
 			continue
 		}
 
-		for _, cidr := range device.Addresses() {
-			ipPrefix, err := parseIPOrIPPrefix(cidr)
-			if err != nil {
-				logger.Info(fmt.Sprintf("skipping address %q on interface %q", cidr, device.Interface()), zap.Error(err))
+		// static addresses of the interface with DHCP fallback are managed by the static fallback operator
+		if !staticFallbackEnabled(device) {
+			for _, cidr := range device.Addresses() {
+				ipPrefix, err := parseIPOrIPPrefix(cidr)
+				if err != nil {
+					logger.Info(fmt.Sprintf("skipping address %q on interface %q", cidr, device.Interface()), zap.Error(err))
 
-				continue
-			}
+					continue
+				}
 
-			address := network.AddressSpecSpec{
-				Address:     ipPrefix,
-				Scope:       nethelpers.ScopeGlobal,
-				LinkName:    device.Interface(),
-				ConfigLayer: network.ConfigMachineConfiguration,
-				Flags:       nethelpers.AddressFlags(nethelpers.AddressPermanent),
-			}
+				address := network.AddressSpecSpec{
+					Address:     ipPrefix,
+					Scope:       nethelpers.ScopeGlobal,
+					LinkName:    device.Interface(),
+					ConfigLayer: network.ConfigMachineConfiguration,
+					Flags:       nethelpers.AddressFlags(nethelpers.AddressPermanent),
+				}
 
-			if address.Address.Addr().Is6() {
-				address.Family = nethelpers.FamilyInet6
-			} else {
-				address.Family = nethelpers.FamilyInet4
-			}
+				if address.Address.Addr().Is6() {
+					address.Family = nethelpers.FamilyInet6
+				} else {
+					address.Family = nethelpers.FamilyInet4
+				}
 
-			addresses = append(addresses, address)
+				addresses = append(addresses, address)
+			}
 		}
 
 		for _, vlan := range device.Vlans() {
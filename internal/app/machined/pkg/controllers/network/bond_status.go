@@ -0,0 +1,202 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/jsimonetti/rtnetlink/v2"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+
+	networkadapter "github.com/siderolabs/talos/internal/app/machined/pkg/adapters/network"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/watch"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// BondStatusController reports runtime state of the bond links.
+type BondStatusController struct {
+	// RefreshInterval is the interval to refresh LACP state which is not reported via netlink notifications.
+	RefreshInterval time.Duration
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *BondStatusController) Name() string {
+	return "network.BondStatusController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *BondStatusController) Inputs() []controller.Input {
+	return nil
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *BondStatusController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.BondStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *BondStatusController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	// wait for udevd to be healthy, which implies that all link renames are done
+	if err := runtime.WaitForDevicesReady(ctx, r,
+		[]controller.Input{
+			{
+				Namespace: network.NamespaceName,
+				Type:      network.LinkSpecType,
+				Kind:      controller.InputWeak,
+			},
+		},
+	); err != nil {
+		return err
+	}
+
+	rtnetlinkWatcher, err := watch.NewRtNetlink(watch.NewDefaultRateLimitedTrigger(ctx, r), unix.RTMGRP_LINK)
+	if err != nil {
+		return err
+	}
+
+	defer rtnetlinkWatcher.Done()
+
+	conn, err := rtnetlink.Dial(nil)
+	if err != nil {
+		return fmt.Errorf("error dialing rtnetlink socket: %w", err)
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	refreshInterval := ctrl.RefreshInterval
+	if refreshInterval == 0 {
+		refreshInterval = 30 * time.Second
+	}
+
+	ticker := time.NewTicker(refreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ticker.C:
+		}
+
+		r.StartTrackingOutputs()
+
+		if err = ctrl.reconcile(ctx, r, logger, conn); err != nil {
+			return err
+		}
+
+		if err = safe.CleanupOutputs[*network.BondStatus](ctx, r); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func (ctrl *BondStatusController) reconcile(ctx context.Context, r controller.Runtime, logger *zap.Logger, conn *rtnetlink.Conn) error {
+	links, err := conn.Link.List()
+	if err != nil {
+		return fmt.Errorf("error listing links: %w", err)
+	}
+
+	neighbors, err := conn.Neigh.List()
+	if err != nil {
+		return fmt.Errorf("error listing neighbors: %w", err)
+	}
+
+	linkNames := make(map[uint32]string, len(links))
+
+	for _, link := range links {
+		linkNames[link.Index] = link.Attributes.Name
+	}
+
+	for _, link := range links {
+		if link.Attributes.Info == nil || link.Attributes.Info.Kind != network.LinkKindBond {
+			continue
+		}
+
+		linkData, ok := link.Attributes.Info.Data.(*rtnetlink.LinkData)
+		if !ok || linkData == nil {
+			logger.Warn("bond link data is nil", zap.String("link", link.Attributes.Name))
+
+			continue
+		}
+
+		if err = safe.WriterModify(ctx, r, network.NewBondStatus(network.NamespaceName, link.Attributes.Name), func(res *network.BondStatus) error {
+			*res.TypedSpec() = network.BondStatusSpec{}
+
+			activeSlaveIndex, err := networkadapter.BondStatusSpec(res.TypedSpec()).Decode(linkData.Data)
+			if err != nil {
+				logger.Warn("failure decoding bond attributes", zap.Error(err), zap.String("link", link.Attributes.Name))
+			}
+
+			res.TypedSpec().ActiveSlave = linkNames[activeSlaveIndex]
+
+			for i := range res.TypedSpec().ARPTargets {
+				res.TypedSpec().ARPTargets[i].State = bondTargetState(neighbors, link.Index, res.TypedSpec().ARPTargets[i].Address)
+			}
+
+			for _, slave := range links {
+				if slave.Attributes.Master == nil || *slave.Attributes.Master != link.Index || slave.Attributes.Info == nil {
+					continue
+				}
+
+				slaveData, ok := slave.Attributes.Info.SlaveData.(*rtnetlink.LinkData)
+				if !ok || slaveData == nil {
+					continue
+				}
+
+				if err = networkadapter.BondStatusSpec(res.TypedSpec()).DecodeSlave(slave.Attributes.Name, slaveData.Data); err != nil {
+					logger.Warn("failure decoding bond slave attributes", zap.Error(err), zap.String("link", slave.Attributes.Name))
+				}
+			}
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error modifying bond status: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// bondTargetState reports the state of the neighbor entry for the bond ARP/NS monitoring target.
+func bondTargetState(neighbors []rtnetlink.NeighMessage, linkIndex uint32, target netip.Addr) string {
+	for _, neighbor := range neighbors {
+		if neighbor.Index != linkIndex || neighbor.Attributes == nil {
+			continue
+		}
+
+		addr, ok := netip.AddrFromSlice(neighbor.Attributes.Address)
+		if !ok || addr.Unmap() != target {
+			continue
+		}
+
+		switch {
+		case neighbor.State&(unix.NUD_REACHABLE|unix.NUD_PERMANENT|unix.NUD_NOARP) != 0:
+			return "reachable"
+		case neighbor.State&(unix.NUD_STALE|unix.NUD_DELAY|unix.NUD_PROBE) != 0:
+			return "stale"
+		case neighbor.State&unix.NUD_INCOMPLETE != 0:
+			return "incomplete"
+		case neighbor.State&unix.NUD_FAILED != 0:
+			return "failed"
+		}
+	}
+
+	return "none"
+}
@@ -0,0 +1,151 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/jsimonetti/rtnetlink/v2"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+
+	networkadapter "github.com/siderolabs/talos/internal/app/machined/pkg/adapters/network"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/watch"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// BridgeStatusController reports runtime state of the bridge links.
+type BridgeStatusController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *BridgeStatusController) Name() string {
+	return "network.BridgeStatusController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *BridgeStatusController) Inputs() []controller.Input {
+	return nil
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *BridgeStatusController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.BridgeStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *BridgeStatusController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	// wait for udevd to be healthy, which implies that all link renames are done
+	if err := runtime.WaitForDevicesReady(ctx, r,
+		[]controller.Input{
+			{
+				Namespace: network.NamespaceName,
+				Type:      network.LinkSpecType,
+				Kind:      controller.InputWeak,
+			},
+		},
+	); err != nil {
+		return err
+	}
+
+	// bridge VLAN changes are reported as link notifications
+	rtnetlinkWatcher, err := watch.NewRtNetlink(watch.NewDefaultRateLimitedTrigger(ctx, r), unix.RTMGRP_LINK)
+	if err != nil {
+		return err
+	}
+
+	defer rtnetlinkWatcher.Done()
+
+	conn, err := rtnetlink.Dial(nil)
+	if err != nil {
+		return fmt.Errorf("error dialing rtnetlink socket: %w", err)
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	bridgeVLANs, err := dialBridgeVLAN()
+	if err != nil {
+		return fmt.Errorf("error dialing bridge VLAN socket: %w", err)
+	}
+
+	defer bridgeVLANs.Close() //nolint:errcheck
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		r.StartTrackingOutputs()
+
+		if err = ctrl.reconcile(ctx, r, logger, conn, bridgeVLANs); err != nil {
+			return err
+		}
+
+		if err = safe.CleanupOutputs[*network.BridgeStatus](ctx, r); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func (ctrl *BridgeStatusController) reconcile(ctx context.Context, r controller.Runtime, logger *zap.Logger, conn *rtnetlink.Conn, bridgeVLANs *bridgeVLANConn) error {
+	links, err := conn.Link.List()
+	if err != nil {
+		return fmt.Errorf("error listing links: %w", err)
+	}
+
+	vlanTables, err := bridgeVLANs.List()
+	if err != nil {
+		return err
+	}
+
+	for _, link := range links {
+		if link.Attributes.Info == nil || link.Attributes.Info.Kind != network.LinkKindBridge {
+			continue
+		}
+
+		if err = safe.WriterModify(ctx, r, network.NewBridgeStatus(network.NamespaceName, link.Attributes.Name), func(res *network.BridgeStatus) error {
+			*res.TypedSpec() = network.BridgeStatusSpec{}
+
+			if linkData, ok := link.Attributes.Info.Data.(*rtnetlink.LinkData); ok && linkData != nil {
+				var bridgeMaster network.BridgeMasterSpec
+
+				if err := networkadapter.BridgeMasterSpec(&bridgeMaster).Decode(linkData.Data); err != nil {
+					logger.Warn("failure decoding bridge attributes", zap.Error(err), zap.String("link", link.Attributes.Name))
+				}
+
+				res.TypedSpec().VLANFiltering = bridgeMaster.VLAN.FilteringEnabled
+			}
+
+			for _, port := range links {
+				if port.Attributes.Master == nil || *port.Attributes.Master != link.Index {
+					continue
+				}
+
+				res.TypedSpec().Ports = append(res.TypedSpec().Ports, network.BridgePortStatus{
+					Name:  port.Attributes.Name,
+					VLANs: vlanTables[port.Index],
+				})
+			}
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error modifying bridge status: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/mdlayher/netlink"
+	"golang.org/x/sys/unix"
+
+	networkadapter "github.com/siderolabs/talos/internal/app/machined/pkg/adapters/network"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// ifInfoMsgLen is the size of struct ifinfomsg.
+const ifInfoMsgLen = 16
+
+// rtextFilterBrVLAN requests the bridge VLAN table in the link dump (see include/uapi/linux/rtnetlink.h).
+const rtextFilterBrVLAN = 1 << 1
+
+// bridgeVLANConn manages bridge port VLAN tables via AF_BRIDGE rtnetlink messages,
+// which are not supported by the rtnetlink library.
+type bridgeVLANConn struct {
+	conn *netlink.Conn
+}
+
+func dialBridgeVLAN() (*bridgeVLANConn, error) {
+	conn, err := netlink.Dial(unix.NETLINK_ROUTE, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bridgeVLANConn{conn: conn}, nil
+}
+
+// Close the underlying netlink socket.
+func (c *bridgeVLANConn) Close() error {
+	return c.conn.Close()
+}
+
+func ifInfoMsg(family uint8, index uint32) []byte {
+	b := make([]byte, ifInfoMsgLen)
+
+	b[0] = family
+	binary.NativeEndian.PutUint32(b[4:8], index)
+
+	return b
+}
+
+// List returns the VLAN tables of all bridge ports (and bridges) by link index.
+func (c *bridgeVLANConn) List() (map[uint32][]network.BridgeVLANEntry, error) {
+	encoder := netlink.NewAttributeEncoder()
+	encoder.Uint32(unix.IFLA_EXT_MASK, rtextFilterBrVLAN)
+
+	attrs, err := encoder.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	msgs, err := c.conn.Execute(netlink.Message{
+		Header: netlink.Header{
+			Type:  unix.RTM_GETLINK,
+			Flags: netlink.Request | netlink.Dump,
+		},
+		Data: append(ifInfoMsg(unix.AF_BRIDGE, 0), attrs...),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error dumping bridge VLANs: %w", err)
+	}
+
+	result := make(map[uint32][]network.BridgeVLANEntry, len(msgs))
+
+	for _, msg := range msgs {
+		if len(msg.Data) < ifInfoMsgLen {
+			return nil, errors.New("bridge link message is too short")
+		}
+
+		var entries []network.BridgeVLANEntry
+
+		if err = networkadapter.BridgeVLANs(&entries).Decode(msg.Data[ifInfoMsgLen:]); err != nil {
+			return nil, fmt.Errorf("error decoding bridge VLANs: %w", err)
+		}
+
+		result[binary.NativeEndian.Uint32(msg.Data[4:8])] = entries
+	}
+
+	return result, nil
+}
+
+// Add (or update) VLANs of the bridge port.
+func (c *bridgeVLANConn) Add(index uint32, entries []network.BridgeVLANEntry) error {
+	return c.execute(unix.RTM_SETLINK, index, entries)
+}
+
+// Delete VLANs of the bridge port.
+func (c *bridgeVLANConn) Delete(index uint32, entries []network.BridgeVLANEntry) error {
+	return c.execute(unix.RTM_DELLINK, index, entries)
+}
+
+func (c *bridgeVLANConn) execute(msgType netlink.HeaderType, index uint32, entries []network.BridgeVLANEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	attrs, err := networkadapter.BridgeVLANs(&entries).Encode()
+	if err != nil {
+		return err
+	}
+
+	_, err = c.conn.Execute(netlink.Message{
+		Header: netlink.Header{
+			Type:  msgType,
+			Flags: netlink.Request | netlink.Acknowledge,
+		},
+		Data: append(ifInfoMsg(unix.AF_BRIDGE, index), attrs...),
+	})
+
+	return err
+}
@@ -382,6 +382,8 @@ func parseBondOptions(options string) (v1alpha1.Bond, error) {
 		switch optionPair[0] {
 		case "arp_ip_target":
 			bond.BondARPIPTarget = strings.Split(optionPair[1], ";")
+		case "ns_ip6_target":
+			bond.BondNSIP6Target = strings.Split(optionPair[1], ";")
 		case "mode":
 			bond.BondMode = optionPair[1]
 		case "xmit_hash_policy":
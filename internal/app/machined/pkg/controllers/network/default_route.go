@@ -0,0 +1,225 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// defaultRouteSelection is the link which owns the default route of the address family.
+type defaultRouteSelection struct {
+	linkName   string
+	candidates []string
+	reason     string
+}
+
+// defaultRoutePolicy defines the links which own the default routes, and the handling of the other default routes.
+type defaultRoutePolicy struct {
+	owners       map[nethelpers.Family]defaultRouteSelection
+	suppress     bool
+	demoteMetric uint32
+}
+
+// newDefaultRoutePolicy selects the links which own the default routes based on the configuration and the link carrier state.
+func newDefaultRoutePolicy(cfg talosconfig.NetworkDefaultRouteConfig, linkStatuses safe.List[*network.LinkStatus]) defaultRoutePolicy {
+	policy := defaultRoutePolicy{
+		owners: map[nethelpers.Family]defaultRouteSelection{},
+	}
+
+	if cfg == nil {
+		return policy
+	}
+
+	policy.suppress = cfg.SuppressOtherRoutes()
+	policy.demoteMetric = cfg.DemotedRouteMetric()
+
+	linkNameResolver := network.NewLinkResolver(linkStatuses.All)
+
+	carrier := map[string]bool{}
+
+	for link := range linkStatuses.All() {
+		carrier[link.Metadata().ID()] = link.TypedSpec().OperationalState == nethelpers.OperStateUnknown || link.TypedSpec().OperationalState == nethelpers.OperStateUp
+	}
+
+	for _, family := range []nethelpers.Family{nethelpers.FamilyInet4, nethelpers.FamilyInet6} {
+		links := cfg.DefaultRouteLinks(family)
+		if len(links) == 0 {
+			continue
+		}
+
+		selection := defaultRouteSelection{
+			candidates: make([]string, 0, len(links)),
+		}
+
+		for _, link := range links {
+			selection.candidates = append(selection.candidates, linkNameResolver.Resolve(link))
+		}
+
+		selection.linkName = selection.candidates[0]
+
+		switch {
+		case !cfg.Failover():
+			selection.reason = "preferred link"
+		case carrier[selection.linkName]:
+			selection.reason = "preferred link has carrier"
+		default:
+			selection.reason = fmt.Sprintf("no candidate link has carrier, keeping preferred link %q", selection.linkName)
+
+			for _, candidate := range selection.candidates[1:] {
+				if carrier[candidate] {
+					selection.reason = fmt.Sprintf("failover from %q without carrier", selection.linkName)
+					selection.linkName = candidate
+
+					break
+				}
+			}
+		}
+
+		policy.owners[family] = selection
+	}
+
+	return policy
+}
+
+// apply demotes or suppresses the default routes which are not owned by the selected link.
+//
+// Returns false if the route should be removed.
+func (policy defaultRoutePolicy) apply(spec *network.RouteSpecSpec) bool {
+	if spec.Table != nethelpers.TableMain || spec.OutLinkName == "" || !isDefaultRouteDestination(spec.Destination) {
+		return true
+	}
+
+	selection, ok := policy.owners[spec.Family]
+	if !ok || spec.OutLinkName == selection.linkName {
+		return true
+	}
+
+	if policy.suppress {
+		return false
+	}
+
+	spec.Priority = max(spec.Priority, policy.demoteMetric)
+
+	return true
+}
+
+func isDefaultRouteDestination(destination netip.Prefix) bool {
+	return !destination.IsValid() || destination.Bits() == 0
+}
+
+// DefaultRouteStatusController reports the links which own the default routes.
+type DefaultRouteStatusController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *DefaultRouteStatusController) Name() string {
+	return "network.DefaultRouteStatusController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *DefaultRouteStatusController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.ActiveID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.LinkStatusType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *DefaultRouteStatusController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.DefaultRouteStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *DefaultRouteStatusController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		policy, err := readDefaultRoutePolicy(ctx, r)
+		if err != nil {
+			return err
+		}
+
+		r.StartTrackingOutputs()
+
+		for family, selection := range policy.owners {
+			if err = safe.WriterModify(ctx, r, network.NewDefaultRouteStatus(network.NamespaceName, family.String()), func(res *network.DefaultRouteStatus) error {
+				spec := res.TypedSpec()
+
+				if spec.LinkName != selection.linkName {
+					logger.Info("default route owner changed",
+						zap.Stringer("family", family),
+						zap.String("link", selection.linkName),
+						zap.String("reason", selection.reason),
+					)
+				}
+
+				spec.Family = family
+				spec.LinkName = selection.linkName
+				spec.Candidates = selection.candidates
+				spec.Reason = selection.reason
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error modifying default route status: %w", err)
+			}
+		}
+
+		if err = safe.CleanupOutputs[*network.DefaultRouteStatus](ctx, r); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func readDefaultRoutePolicy(ctx context.Context, r controller.Reader) (defaultRoutePolicy, error) {
+	cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.ActiveID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return defaultRoutePolicy{}, fmt.Errorf("error getting config: %w", err)
+	}
+
+	linkStatuses, err := safe.ReaderListAll[*network.LinkStatus](ctx, r)
+	if err != nil {
+		return defaultRoutePolicy{}, fmt.Errorf("error listing link statuses: %w", err)
+	}
+
+	var defaultRouteConfig talosconfig.NetworkDefaultRouteConfig
+
+	if cfg != nil {
+		defaultRouteConfig = cfg.Config().NetworkDefaultRouteConfig()
+	}
+
+	return newDefaultRoutePolicy(defaultRouteConfig, linkStatuses), nil
+}
@@ -0,0 +1,97 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	networkcfg "github.com/siderolabs/talos/pkg/machinery/config/types/network"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type DefaultRouteStatusSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *DefaultRouteStatusSuite) TestStatus() {
+	linkStatuses := map[string]*network.LinkStatus{}
+
+	for _, link := range []string{"eth0", "eth1"} {
+		linkStatus := network.NewLinkStatus(network.NamespaceName, link)
+		linkStatus.TypedSpec().OperationalState = nethelpers.OperStateUp
+
+		suite.Create(linkStatus)
+
+		linkStatuses[link] = linkStatus
+	}
+
+	defaultRouteConfig := networkcfg.NewDefaultRouteConfigV1Alpha1()
+	defaultRouteConfig.LinksConfig = []string{"eth0", "eth1"}
+	defaultRouteConfig.IPv6Config = &networkcfg.DefaultRouteFamilyConfig{
+		FamilyLinksConfig: []string{"eth1"},
+	}
+	defaultRouteConfig.FailoverConfig = pointer.To(true)
+
+	ctr, err := container.New(defaultRouteConfig)
+	suite.Require().NoError(err)
+
+	suite.Create(config.NewMachineConfig(ctr))
+
+	ctest.AssertResource(suite, "inet4", func(status *network.DefaultRouteStatus, asrt *assert.Assertions) {
+		asrt.Equal(nethelpers.FamilyInet4, status.TypedSpec().Family)
+		asrt.Equal("eth0", status.TypedSpec().LinkName)
+		asrt.Equal([]string{"eth0", "eth1"}, status.TypedSpec().Candidates)
+		asrt.Equal("preferred link has carrier", status.TypedSpec().Reason)
+	})
+
+	ctest.AssertResource(suite, "inet6", func(status *network.DefaultRouteStatus, asrt *assert.Assertions) {
+		asrt.Equal("eth1", status.TypedSpec().LinkName)
+		asrt.Equal([]string{"eth1"}, status.TypedSpec().Candidates)
+	})
+
+	linkStatuses["eth0"].TypedSpec().OperationalState = nethelpers.OperStateDown
+	suite.Update(linkStatuses["eth0"])
+
+	ctest.AssertResource(suite, "inet4", func(status *network.DefaultRouteStatus, asrt *assert.Assertions) {
+		asrt.Equal("eth1", status.TypedSpec().LinkName)
+		asrt.Equal(`failover from "eth0" without carrier`, status.TypedSpec().Reason)
+	})
+
+	linkStatuses["eth1"].TypedSpec().OperationalState = nethelpers.OperStateDown
+	suite.Update(linkStatuses["eth1"])
+
+	ctest.AssertResource(suite, "inet4", func(status *network.DefaultRouteStatus, asrt *assert.Assertions) {
+		asrt.Equal("eth0", status.TypedSpec().LinkName)
+		asrt.Equal(`no candidate link has carrier, keeping preferred link "eth0"`, status.TypedSpec().Reason)
+	})
+
+	suite.Destroy(config.NewMachineConfig(ctr))
+
+	ctest.AssertNoResource[*network.DefaultRouteStatus](suite, "inet4")
+	ctest.AssertNoResource[*network.DefaultRouteStatus](suite, "inet6")
+}
+
+func TestDefaultRouteStatusSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, &DefaultRouteStatusSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 5 * time.Second,
+			AfterSetup: func(s *ctest.DefaultSuite) {
+				s.Require().NoError(s.Runtime().RegisterController(&netctrl.DefaultRouteStatusController{}))
+			},
+		},
+	})
+}
@@ -0,0 +1,164 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+
+	blockadapter "github.com/siderolabs/talos/internal/app/machined/pkg/adapters/block"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/automaton"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/automaton/blockautomaton"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+	"github.com/siderolabs/talos/pkg/xfs"
+)
+
+// DHCP4LeaseLoadController loads cached DHCPv4 leases from STATE.
+type DHCP4LeaseLoadController struct {
+	stateMachine blockautomaton.VolumeMounterAutomaton
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *DHCP4LeaseLoadController) Name() string {
+	return "network.DHCP4LeaseLoadController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *DHCP4LeaseLoadController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: block.NamespaceName,
+			Type:      block.VolumeMountStatusType,
+			Kind:      controller.InputStrong,
+		},
+		{
+			Namespace: block.NamespaceName,
+			Type:      block.VolumeMountRequestType,
+			Kind:      controller.InputDestroyReady,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *DHCP4LeaseLoadController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: block.VolumeMountRequestType,
+			Kind: controller.OutputShared,
+		},
+		{
+			Type: network.DHCP4LeaseType,
+			Kind: controller.OutputShared,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *DHCP4LeaseLoadController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		if ctrl.stateMachine == nil {
+			ctrl.stateMachine = blockautomaton.NewVolumeMounter(
+				ctrl.Name(),
+				constants.StatePartitionLabel,
+				ctrl.load(),
+				blockautomaton.WithReadOnly(true),
+				blockautomaton.WithDetached(true),
+			)
+		}
+
+		if err := ctrl.stateMachine.Run(ctx, r, logger,
+			automaton.WithAfterFunc(func() error {
+				ctrl.stateMachine = nil
+
+				return nil
+			}),
+		); err != nil {
+			return fmt.Errorf("error running volume mounter machine: %w", err)
+		}
+
+		if ctrl.stateMachine == nil {
+			// we read only once, so once read, we should stop
+			return nil
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func (ctrl *DHCP4LeaseLoadController) load() func(
+	ctx context.Context, r controller.ReaderWriter, logger *zap.Logger, mountStatus *block.VolumeMountStatus,
+) error {
+	return func(ctx context.Context, r controller.ReaderWriter, logger *zap.Logger, mountStatus *block.VolumeMountStatus) error {
+		return blockadapter.VolumeMountStatus(mountStatus).WithRoot(logger, func(root xfs.Root) error {
+			leases, err := loadDHCP4Leases(root, constants.DHCP4LeasesFilename)
+			if err != nil {
+				logger.Warn("ignored failure loading cached DHCPv4 leases", zap.Error(err))
+
+				return nil
+			}
+
+			now := time.Now()
+
+			for _, lease := range leases {
+				// stale leases are never used, as the address might have been handed out to another host
+				if lease.Expired(now) {
+					logger.Debug("ignored expired cached DHCPv4 lease", zap.String("link", lease.LinkName), zap.Time("expiry", lease.Expiry))
+
+					continue
+				}
+
+				if err := safe.WriterModify(ctx, r,
+					network.NewDHCP4Lease(network.NamespaceName, network.DHCP4LeaseCachedID(lease.LinkName)),
+					func(out *network.DHCP4Lease) error {
+						*out.TypedSpec() = lease
+
+						return nil
+					},
+				); err != nil {
+					return fmt.Errorf("error modifying cached DHCPv4 lease: %w", err)
+				}
+
+				logger.Debug("loaded cached DHCPv4 lease", zap.String("link", lease.LinkName), zap.Stringer("address", lease.Address))
+			}
+
+			return nil
+		})
+	}
+}
+
+func loadDHCP4Leases(root xfs.Root, path string) ([]network.DHCP4LeaseSpec, error) {
+	marshaled, err := xfs.ReadFile(root, path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	var leases []network.DHCP4LeaseSpec
+
+	if err = yaml.Unmarshal(marshaled, &leases); err != nil {
+		return nil, fmt.Errorf("error unmarshaling DHCPv4 leases: %w", err)
+	}
+
+	return leases, nil
+}
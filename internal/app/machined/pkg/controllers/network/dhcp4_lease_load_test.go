@@ -0,0 +1,91 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net/netip"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type DHCP4LeaseLoadSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *DHCP4LeaseLoadSuite) TestLoadLeases() {
+	statePath := suite.T().TempDir()
+	mountID := (&netctrl.DHCP4LeaseLoadController{}).Name() + "-" + constants.StatePartitionLabel
+
+	marshaled, err := yaml.Marshal([]network.DHCP4LeaseSpec{
+		{
+			LinkName:  "eth0",
+			Address:   netip.MustParsePrefix("10.0.0.5/24"),
+			ServerID:  netip.MustParseAddr("10.0.0.1"),
+			LeaseTime: time.Hour,
+			Expiry:    time.Now().Add(time.Hour),
+			ACK:       []byte{0x02, 0x01},
+		},
+		{
+			LinkName:  "eth1",
+			Address:   netip.MustParsePrefix("10.0.1.5/24"),
+			ServerID:  netip.MustParseAddr("10.0.1.1"),
+			LeaseTime: time.Hour,
+			Expiry:    time.Now().Add(-time.Minute),
+			ACK:       []byte{0x02, 0x01},
+		},
+	})
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(os.WriteFile(filepath.Join(statePath, constants.DHCP4LeasesFilename), marshaled, 0o600))
+
+	ctest.AssertResource(suite, mountID, func(mountRequest *block.VolumeMountRequest, asrt *assert.Assertions) {
+		asrt.Equal(constants.StatePartitionLabel, mountRequest.TypedSpec().VolumeID)
+	})
+
+	volumeMountStatus := block.NewVolumeMountStatus(block.NamespaceName, mountID)
+	volumeMountStatus.TypedSpec().Target = statePath
+	suite.Create(volumeMountStatus)
+
+	ctest.AssertNoResource[*block.VolumeMountRequest](suite, mountID)
+
+	suite.Destroy(volumeMountStatus)
+
+	ctest.AssertResource(suite, network.DHCP4LeaseCachedID("eth0"), func(lease *network.DHCP4Lease, asrt *assert.Assertions) {
+		asrt.Equal("eth0", lease.TypedSpec().LinkName)
+		asrt.Equal(netip.MustParsePrefix("10.0.0.5/24"), lease.TypedSpec().Address)
+		asrt.Equal(netip.MustParseAddr("10.0.0.1"), lease.TypedSpec().ServerID)
+		asrt.Equal([]byte{0x02, 0x01}, lease.TypedSpec().ACK)
+	})
+
+	// expired lease is never loaded
+	ctest.AssertNoResource[*network.DHCP4Lease](suite, network.DHCP4LeaseCachedID("eth1"))
+}
+
+func TestDHCP4LeaseLoadSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, &DHCP4LeaseLoadSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 5 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(
+					suite.Runtime().RegisterController(&netctrl.DHCP4LeaseLoadController{}),
+				)
+			},
+		},
+	})
+}
@@ -0,0 +1,189 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+
+	blockadapter "github.com/siderolabs/talos/internal/app/machined/pkg/adapters/block"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/automaton"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/automaton/blockautomaton"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+	"github.com/siderolabs/talos/pkg/xfs"
+)
+
+// DHCP4LeaseStoreController stores (caches) active DHCPv4 leases in STATE.
+type DHCP4LeaseStoreController struct {
+	stateMachine                    blockautomaton.VolumeMounterAutomaton
+	leasesToStore, lastStoredLeases []network.DHCP4LeaseSpec
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *DHCP4LeaseStoreController) Name() string {
+	return "network.DHCP4LeaseStoreController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *DHCP4LeaseStoreController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.DHCP4LeaseType,
+			Kind:      controller.InputStrong,
+		},
+		{
+			Namespace: block.NamespaceName,
+			Type:      block.VolumeMountStatusType,
+			Kind:      controller.InputStrong,
+		},
+		{
+			Namespace: block.NamespaceName,
+			Type:      block.VolumeMountRequestType,
+			Kind:      controller.InputDestroyReady,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *DHCP4LeaseStoreController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: block.VolumeMountRequestType,
+			Kind: controller.OutputShared,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *DHCP4LeaseStoreController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		leases, err := safe.ReaderListAll[*network.DHCP4Lease](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error listing DHCPv4 leases: %w", err)
+		}
+
+		var activeLeases []network.DHCP4LeaseSpec
+
+		for lease := range leases.All() {
+			// leases loaded from the cache are already stored
+			if lease.Metadata().ID() == network.DHCP4LeaseCachedID(lease.TypedSpec().LinkName) {
+				continue
+			}
+
+			activeLeases = append(activeLeases, lease.TypedSpec().DeepCopy())
+		}
+
+		slices.SortFunc(activeLeases, func(a, b network.DHCP4LeaseSpec) int {
+			return cmp.Compare(a.LinkName, b.LinkName)
+		})
+
+		// if there are new leases which are not stored yet
+		if len(activeLeases) > 0 && !slices.EqualFunc(activeLeases, ctrl.lastStoredLeases, sameDHCP4Lease) {
+			ctrl.leasesToStore = activeLeases
+		}
+
+		if ctrl.stateMachine == nil && ctrl.leasesToStore != nil {
+			ctrl.stateMachine = blockautomaton.NewVolumeMounter(
+				ctrl.Name(),
+				constants.StatePartitionLabel,
+				ctrl.store(),
+				blockautomaton.WithDetached(true),
+			)
+		}
+
+		if ctrl.stateMachine != nil {
+			if err := ctrl.stateMachine.Run(ctx, r, logger,
+				automaton.WithAfterFunc(func() error {
+					ctrl.stateMachine = nil
+
+					return nil
+				}),
+			); err != nil {
+				return fmt.Errorf("error running volume mounter machine: %w", err)
+			}
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func sameDHCP4Lease(a, b network.DHCP4LeaseSpec) bool {
+	return a.LinkName == b.LinkName && a.Expiry.Equal(b.Expiry) && bytes.Equal(a.ACK, b.ACK)
+}
+
+func (ctrl *DHCP4LeaseStoreController) store() func(
+	ctx context.Context, r controller.ReaderWriter, logger *zap.Logger, mountStatus *block.VolumeMountStatus,
+) error {
+	return func(ctx context.Context, r controller.ReaderWriter, logger *zap.Logger, mountStatus *block.VolumeMountStatus) error {
+		return blockadapter.VolumeMountStatus(mountStatus).WithRoot(logger, func(root xfs.Root) error {
+			if err := ctrl.storeLeases(root, constants.DHCP4LeasesFilename, ctrl.leasesToStore); err != nil {
+				return fmt.Errorf("error saving DHCPv4 leases: %w", err)
+			}
+
+			// remember last stored leases
+			ctrl.lastStoredLeases, ctrl.leasesToStore = ctrl.leasesToStore, nil
+
+			logger.Debug("stored active DHCPv4 leases")
+
+			return nil
+		})
+	}
+}
+
+func (ctrl *DHCP4LeaseStoreController) storeLeases(root xfs.Root, path string, leases []network.DHCP4LeaseSpec) error {
+	existing, err := loadDHCP4Leases(root, path)
+	if err != nil {
+		// the cache is going to be overwritten anyways
+		existing = nil
+	}
+
+	now := time.Now()
+
+	// keep the leases of the links which are not active right now, unless they have expired
+	merged := slices.DeleteFunc(existing, func(lease network.DHCP4LeaseSpec) bool {
+		return lease.Expired(now) || slices.ContainsFunc(leases, func(active network.DHCP4LeaseSpec) bool {
+			return active.LinkName == lease.LinkName
+		})
+	})
+
+	merged = append(merged, leases...)
+
+	slices.SortFunc(merged, func(a, b network.DHCP4LeaseSpec) int {
+		return cmp.Compare(a.LinkName, b.LinkName)
+	})
+
+	marshaled, err := yaml.Marshal(merged)
+	if err != nil {
+		return fmt.Errorf("error marshaling DHCPv4 leases: %w", err)
+	}
+
+	if _, err := xfs.Stat(root, path); err == nil {
+		contents, err := xfs.ReadFile(root, path)
+		if err == nil && bytes.Equal(marshaled, contents) {
+			// existing contents are identical, skip writing to avoid no-op writes
+			return nil
+		}
+	}
+
+	return xfs.WriteFile(root, path, marshaled, 0o600)
+}
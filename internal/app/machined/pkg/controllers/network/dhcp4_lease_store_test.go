@@ -0,0 +1,129 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net/netip"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type DHCP4LeaseStoreSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *DHCP4LeaseStoreSuite) TestStoreLeases() {
+	statePath := suite.T().TempDir()
+	mountID := (&netctrl.DHCP4LeaseStoreController{}).Name() + "-" + constants.StatePartitionLabel
+
+	expiry := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	// the cache holds a lease of a link which is not active, and an expired one
+	marshaled, err := yaml.Marshal([]network.DHCP4LeaseSpec{
+		{
+			LinkName: "eth1",
+			Address:  netip.MustParsePrefix("10.0.1.5/24"),
+			Expiry:   expiry,
+		},
+		{
+			LinkName: "eth2",
+			Address:  netip.MustParsePrefix("10.0.2.5/24"),
+			Expiry:   time.Now().Add(-time.Minute),
+		},
+	})
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(os.WriteFile(filepath.Join(statePath, constants.DHCP4LeasesFilename), marshaled, 0o600))
+
+	// cached lease should be ignored
+	cachedLease := network.NewDHCP4Lease(network.NamespaceName, network.DHCP4LeaseCachedID("eth3"))
+	cachedLease.TypedSpec().LinkName = "eth3"
+	cachedLease.TypedSpec().Expiry = expiry
+	suite.Create(cachedLease)
+
+	ctest.AssertNoResource[*block.VolumeMountRequest](suite, mountID)
+
+	lease := network.NewDHCP4Lease(network.NamespaceName, "eth0")
+	lease.TypedSpec().LinkName = "eth0"
+	lease.TypedSpec().Address = netip.MustParsePrefix("10.0.0.5/24")
+	lease.TypedSpec().ServerID = netip.MustParseAddr("10.0.0.1")
+	lease.TypedSpec().LeaseTime = time.Hour
+	lease.TypedSpec().Expiry = expiry
+	lease.TypedSpec().ACK = []byte{0x02, 0x01}
+	suite.Create(lease)
+
+	ctest.AssertResource(suite, mountID, func(mountRequest *block.VolumeMountRequest, asrt *assert.Assertions) {
+		asrt.Equal(constants.StatePartitionLabel, mountRequest.TypedSpec().VolumeID)
+	})
+
+	volumeMountStatus := block.NewVolumeMountStatus(block.NamespaceName, mountID)
+	volumeMountStatus.TypedSpec().Target = statePath
+	suite.Create(volumeMountStatus)
+
+	suite.EventuallyWithT(func(collect *assert.CollectT) {
+		asrt := assert.New(collect)
+
+		contents, err := os.ReadFile(filepath.Join(statePath, constants.DHCP4LeasesFilename))
+		if !asrt.NoError(err) {
+			return
+		}
+
+		var stored []network.DHCP4LeaseSpec
+
+		if !asrt.NoError(yaml.Unmarshal(contents, &stored)) || !asrt.Len(stored, 2) {
+			return
+		}
+
+		asrt.Equal("eth0", stored[0].LinkName)
+		asrt.Equal(netip.MustParsePrefix("10.0.0.5/24"), stored[0].Address)
+		asrt.Equal([]byte{0x02, 0x01}, stored[0].ACK)
+		asrt.Equal("eth1", stored[1].LinkName)
+	}, time.Second, 10*time.Millisecond)
+
+	suite.Destroy(volumeMountStatus)
+
+	ctest.AssertNoResource[*block.VolumeMountRequest](suite, mountID)
+
+	// lease didn't change, nothing to store
+	lease.Metadata().Labels().Set("foo", "bar")
+	suite.Update(lease)
+
+	ctest.AssertNoResource[*block.VolumeMountRequest](suite, mountID)
+
+	// lease was renewed
+	lease.TypedSpec().Expiry = expiry.Add(time.Hour)
+	suite.Update(lease)
+
+	ctest.AssertResource(suite, mountID, func(mountRequest *block.VolumeMountRequest, asrt *assert.Assertions) {
+		asrt.Equal(constants.StatePartitionLabel, mountRequest.TypedSpec().VolumeID)
+	})
+}
+
+func TestDHCP4LeaseStoreSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, &DHCP4LeaseStoreSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 5 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(
+					suite.Runtime().RegisterController(&netctrl.DHCP4LeaseStoreController{}),
+				)
+			},
+		},
+	})
+}
@@ -11,6 +11,8 @@ import (
 	"iter"
 	"net/netip"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/coredns/coredns/plugin/pkg/proxy"
 	"github.com/cosi-project/runtime/pkg/controller"
@@ -27,6 +29,9 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 )
 
+// dnsResolveCacheStatusInterval is the interval to refresh the DNSResolveCache statuses.
+const dnsResolveCacheStatusInterval = 30 * time.Second
+
 // DNSResolveCacheController starts dns server on both udp and tcp ports based on finalized network configuration.
 type DNSResolveCacheController struct {
 	State  state.State
@@ -35,6 +40,7 @@ type DNSResolveCacheController struct {
 	mx        sync.Mutex
 	manager   *dns.Manager
 	reconcile chan struct{}
+	conns     atomic.Pointer[map[string]*network.DNSConn]
 }
 
 // Name implements controller.Controller interface.
@@ -52,6 +58,12 @@ func (ctrl *DNSResolveCacheController) Inputs() []controller.Input {
 			ID:        optional.Some(network.HostDNSConfigID),
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.ResolverStatusType,
+			ID:        optional.Some(network.ResolverID),
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -82,12 +94,17 @@ func (ctrl *DNSResolveCacheController) Run(ctx context.Context, r controller.Run
 		}
 	}()
 
+	// refresh the rejected queries counters periodically
+	ticker := time.NewTicker(dnsResolveCacheStatusInterval)
+	defer ticker.Stop()
+
 	for {
 		select {
 		case <-ctx.Done():
 			return nil
 		case <-r.EventCh():
 		case <-ctrl.reconcile:
+		case <-ticker.C:
 		}
 
 		if err := ctrl.run(ctx, r); err != nil {
@@ -112,6 +129,27 @@ func (ctrl *DNSResolveCacheController) run(ctx context.Context, r controller.Run
 
 	ctrl.manager.AllowNodeResolving(cfg.TypedSpec().ResolveMemberNames)
 
+	if ctrl.manager.SetCacheOptions(dns.CacheOptions{
+		Size:        cfg.TypedSpec().CacheSize,
+		MinTTL:      cfg.TypedSpec().CacheMinTTL,
+		MaxTTL:      cfg.TypedSpec().CacheMaxTTL,
+		NegativeTTL: cfg.TypedSpec().NegativeCacheTTL,
+		ServeStale:  cfg.TypedSpec().ServeStale,
+	}) {
+		ctrl.Logger.Info(
+			"updated dns cache options",
+			zap.Int("size", cfg.TypedSpec().CacheSize),
+			zap.Duration("min_ttl", cfg.TypedSpec().CacheMinTTL),
+			zap.Duration("max_ttl", cfg.TypedSpec().CacheMaxTTL),
+			zap.Duration("negative_ttl", cfg.TypedSpec().NegativeCacheTTL),
+			zap.Duration("serve_stale", cfg.TypedSpec().ServeStale),
+		)
+	}
+
+	if ctrl.manager.SetAllowedClients(cfg.TypedSpec().AllowedClients) {
+		ctrl.Logger.Info("updated dns allowed clients", zap.Stringers("prefixes", cfg.TypedSpec().AllowedClients))
+	}
+
 	if !cfg.TypedSpec().Enabled {
 		return ctrl.manager.ClearAll(false)
 	}
@@ -136,11 +174,43 @@ func (ctrl *DNSResolveCacheController) run(ctx context.Context, r controller.Run
 		}
 	}
 
+	resolverStatus, err := safe.ReaderGetByID[*network.ResolverStatus](ctx, r, network.ResolverID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return fmt.Errorf("error getting resolver status: %w", err)
+	}
+
+	var resolverOptions network.ResolverOptions
+
+	if resolverStatus != nil {
+		resolverOptions = resolverStatus.TypedSpec().Options
+	}
+
+	if ctrl.manager.SetUpstreamPolicy(dns.UpstreamPolicy{
+		Parallel: resolverOptions.UpstreamPolicy == "parallel",
+		Rotate:   resolverOptions.Rotate,
+		Timeout:  resolverOptions.Timeout,
+	}) {
+		ctrl.Logger.Info(
+			"updated dns upstream policy",
+			zap.String("policy", cmp.Or(resolverOptions.UpstreamPolicy, "sequential")),
+			zap.Bool("rotate", resolverOptions.Rotate),
+			zap.Duration("timeout", resolverOptions.Timeout),
+		)
+	}
+
 	upstreams, err := safe.ReaderListAll[*network.DNSUpstream](ctx, r)
 	if err != nil {
 		return fmt.Errorf("error getting resolver status: %w", err)
 	}
 
+	conns := make(map[string]*network.DNSConn, upstreams.Len())
+
+	for upstream := range upstreams.All() {
+		conns[upstream.TypedSpec().Value.Conn.Addr()] = upstream.TypedSpec().Value.Conn
+	}
+
+	ctrl.conns.Store(&conns)
+
 	prxs := xiter.Map(
 		// We are using iterator here to preserve finalizer on
 		func(upstream *network.DNSUpstream) *proxy.Proxy {
@@ -167,6 +237,7 @@ func (ctrl *DNSResolveCacheController) writeDNSStatus(ctx context.Context, r con
 
 	return safe.WriterModify(ctx, r, res, func(drc *network.DNSResolveCache) error {
 		drc.TypedSpec().Status = "running"
+		drc.TypedSpec().RejectedQueries = ctrl.manager.RejectedQueries(config)
 
 		return nil
 	})
@@ -175,6 +246,7 @@ func (ctrl *DNSResolveCacheController) writeDNSStatus(ctx context.Context, r con
 func (ctrl *DNSResolveCacheController) init(ctx context.Context) {
 	if ctrl.manager == nil {
 		ctrl.manager = dns.NewManager(&memberReader{st: ctrl.State}, ctrl.eventHook, ctrl.Logger)
+		ctrl.manager.SetQueryObserver(ctrl.observeQuery)
 
 		// Ensure we stop all runners when the context is canceled, no matter where we are currently.
 		// For example if we are in Controller runtime sleeping after error and ctx is canceled, we should stop all runners
@@ -201,6 +273,17 @@ func (ctrl *DNSResolveCacheController) eventHook(event suture.Event) {
 	}
 }
 
+func (ctrl *DNSResolveCacheController) observeQuery(addr string, rtt time.Duration, err error) {
+	conns := ctrl.conns.Load()
+	if conns == nil {
+		return
+	}
+
+	if conn, ok := (*conns)[addr]; ok {
+		conn.ObserveQuery(rtt, err)
+	}
+}
+
 type memberReader struct{ st state.State }
 
 func (m *memberReader) ReadMembers(ctx context.Context) (iter.Seq[*cluster.Member], error) {
@@ -16,6 +16,7 @@ import (
 	"slices"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/safe"
@@ -153,6 +154,7 @@ func (ctrl *EtcFileController) Run(ctx context.Context, r controller.Runtime, lo
 					r.TypedSpec().Contents = renderResolvConf(
 						pickNameservers(hostDNSCfg, resolverStatus),
 						resolverStatus.TypedSpec().SearchDomains,
+						resolverStatus.TypedSpec().Options,
 					)
 					r.TypedSpec().Mode = 0o644
 					r.TypedSpec().SelinuxLabel = constants.EtcSelinuxLabel
@@ -176,7 +178,7 @@ func (ctrl *EtcFileController) Run(ctx context.Context, r controller.Runtime, lo
 			src := "resolv.conf"
 			dst := filepath.Join(ctrl.BindMountTarget, src)
 
-			conf := renderResolvConf(slices.All(dnsServers), resolverStatus.TypedSpec().SearchDomains)
+			conf := renderResolvConf(slices.All(dnsServers), resolverStatus.TypedSpec().SearchDomains, network.ResolverOptions{})
 
 			if err := efiles.UpdateFile(ctrl.EtcRoot, src, conf, 0o644, constants.EtcSelinuxLabel); err != nil {
 				return fmt.Errorf("error writing pod resolv.conf: %w", err)
@@ -221,7 +223,7 @@ func pickNameservers(hostDNSCfg *network.HostDNSConfig, resolverStatus *network.
 	return slices.All(resolverStatus.TypedSpec().DNSServers)
 }
 
-func renderResolvConf(nameservers iter.Seq2[int, netip.Addr], searchDomains []string) []byte {
+func renderResolvConf(nameservers iter.Seq2[int, netip.Addr], searchDomains []string, opts network.ResolverOptions) []byte {
 	var buf bytes.Buffer
 
 	for i, ns := range nameservers {
@@ -237,9 +239,32 @@ func renderResolvConf(nameservers iter.Seq2[int, netip.Addr], searchDomains []st
 		fmt.Fprintf(&buf, "\nsearch %s\n", strings.Join(searchDomains, " "))
 	}
 
+	if options := renderResolvOptions(opts); len(options) > 0 {
+		fmt.Fprintf(&buf, "\noptions %s\n", strings.Join(options, " "))
+	}
+
 	return buf.Bytes()
 }
 
+func renderResolvOptions(opts network.ResolverOptions) []string {
+	var options []string
+
+	if opts.Rotate {
+		options = append(options, "rotate")
+	}
+
+	if opts.Timeout > 0 {
+		// resolv.conf timeout is in seconds, round up to not make the timeout shorter than requested
+		options = append(options, fmt.Sprintf("timeout:%d", (opts.Timeout+time.Second-1)/time.Second))
+	}
+
+	if opts.Attempts > 0 {
+		options = append(options, fmt.Sprintf("attempts:%d", opts.Attempts))
+	}
+
+	return options
+}
+
 func (ctrl *EtcFileController) renderHosts(hostnameStatus *network.HostnameStatusSpec, nodeAddressStatus *network.NodeAddressSpec, cfgProvider talosconfig.Config) ([]byte, error) {
 	var buf bytes.Buffer
 
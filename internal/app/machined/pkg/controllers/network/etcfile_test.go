@@ -248,6 +248,25 @@ func (suite *EtcFileConfigSuite) TestComplete() {
 	)
 }
 
+func (suite *EtcFileConfigSuite) TestResolverOptions() {
+	suite.resolverStatus.TypedSpec().SearchDomains = []string{"foo.example.com"}
+	suite.resolverStatus.TypedSpec().Options = network.ResolverOptions{
+		Rotate:         true,
+		Timeout:        1500 * time.Millisecond,
+		Attempts:       3,
+		UpstreamPolicy: "parallel",
+	}
+
+	suite.testFiles(
+		[]resource.Resource{suite.defaultAddress, suite.hostnameStatus, suite.resolverStatus, suite.hostDNSConfig},
+		etcFileContents{
+			hosts:            "127.0.0.1   localhost\n33.11.22.44 foo.example.com foo\n::1         localhost ip6-localhost ip6-loopback\nff02::1     ip6-allnodes\nff02::2     ip6-allrouters\n",
+			resolvConf:       "nameserver 127.0.0.53\n\nsearch foo.example.com\n\noptions rotate timeout:2 attempts:3\n",
+			resolvGlobalConf: "nameserver 169.254.116.108\n\nsearch foo.example.com\n",
+		},
+	)
+}
+
 func (suite *EtcFileConfigSuite) TestNoExtraHosts() {
 	suite.resolverStatus.TypedSpec().SearchDomains = []string{"foo.example.com"}
 
@@ -8,6 +8,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/safe"
@@ -17,11 +20,41 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 )
 
-// EthernetSpecController reports Ethernet link statuses.
-type EthernetSpecController struct{}
+// DefaultEthernetSpecRetryInterval is the default interval to retry applying the Ethernet link settings which failed to apply.
+const DefaultEthernetSpecRetryInterval = 30 * time.Second
+
+// EthtoolClient is the subset of the ethtool client used by the EthernetSpecController.
+type EthtoolClient interface {
+	SetRings(rings ethtool.Rings) error
+	SetFeatures(ifi ethtool.Interface, features map[string]bool) error
+	SetChannels(channels ethtool.Channels) error
+	Close() error
+}
+
+// EthernetSpecController applies the Ethernet link settings via ethtool.
+//
+// The settings are re-applied if the link is re-created (e.g. after a link rename),
+// or if the link operational state changes (e.g. after a driver reset which keeps the link index).
+// The settings which failed to apply are retried periodically.
+type EthernetSpecController struct {
+	// NewClient creates the ethtool client, defaults to ethtool.New.
+	NewClient func() (EthtoolClient, error)
+	// RetryInterval is the interval to retry failed settings, defaults to DefaultEthernetSpecRetryInterval.
+	RetryInterval time.Duration
+
+	// applied maps link name to the applied spec version and the link state.
+	applied map[string]ethernetApplied
+}
+
+type ethernetApplied struct {
+	version          string
+	linkIndex        uint32
+	operationalState nethelpers.OperationalState
+}
 
 // Name implements controller.Controller interface.
 func (ctrl *EthernetSpecController) Name() string {
@@ -39,7 +72,19 @@ func (ctrl *EthernetSpecController) Outputs() []controller.Output {
 }
 
 // Run implements controller.Controller interface.
+//
+//nolint:gocyclo
 func (ctrl *EthernetSpecController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.NewClient == nil {
+		ctrl.NewClient = func() (EthtoolClient, error) {
+			return ethtool.New()
+		}
+	}
+
+	if ctrl.RetryInterval == 0 {
+		ctrl.RetryInterval = DefaultEthernetSpecRetryInterval
+	}
+
 	// wait for udevd to be healthy, which implies that all link renames are done
 	if err := runtime.WaitForDevicesReady(ctx, r,
 		[]controller.Input{
@@ -48,12 +93,17 @@ func (ctrl *EthernetSpecController) Run(ctx context.Context, r controller.Runtim
 				Type:      network.EthernetSpecType,
 				Kind:      controller.InputWeak,
 			},
+			{
+				Namespace: network.NamespaceName,
+				Type:      network.LinkStatusType,
+				Kind:      controller.InputWeak,
+			},
 		},
 	); err != nil {
 		return err
 	}
 
-	ethClient, err := ethtool.New()
+	ethClient, err := ctrl.NewClient()
 	if err != nil {
 		logger.Warn("error dialing ethtool socket", zap.Error(err))
 
@@ -62,11 +112,19 @@ func (ctrl *EthernetSpecController) Run(ctx context.Context, r controller.Runtim
 
 	defer ethClient.Close() //nolint:errcheck
 
+	ctrl.applied = map[string]ethernetApplied{}
+
+	retryTimer := time.NewTimer(ctrl.RetryInterval)
+	retryTimer.Stop()
+
+	defer retryTimer.Stop()
+
 	for {
 		select {
 		case <-ctx.Done():
 			return nil
 		case <-r.EventCh():
+		case <-retryTimer.C:
 		}
 
 		specs, err := safe.ReaderListAll[*network.EthernetSpec](ctx, r)
@@ -74,16 +132,59 @@ func (ctrl *EthernetSpecController) Run(ctx context.Context, r controller.Runtim
 			return fmt.Errorf("error reading EthernetSpec resources: %w", err)
 		}
 
-		var errs error
+		links, err := safe.ReaderListAll[*network.LinkStatus](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error reading LinkStatus resources: %w", err)
+		}
+
+		seen := map[string]struct{}{}
+		retry := false
 
 		for spec := range specs.All() {
+			linkName := spec.Metadata().ID()
+
+			link, ok := links.Find(func(link *network.LinkStatus) bool { return link.Metadata().ID() == linkName })
+			if !ok {
+				// the link doesn't exist yet (or it was renamed), the spec will be applied once it appears
+				continue
+			}
+
+			seen[linkName] = struct{}{}
+
+			applied := ethernetApplied{
+				version:          spec.Metadata().Version().String(),
+				linkIndex:        link.TypedSpec().Index,
+				operationalState: link.TypedSpec().OperationalState,
+			}
+
+			if ctrl.applied[linkName] == applied {
+				continue
+			}
+
+			// unsupported settings shouldn't prevent the rest of the settings from being applied,
+			// the settings which were not applied are reported in the EthernetStatus resource,
+			// and the whole spec is retried later, as the error might be transient
 			if err = ctrl.apply(ethClient, spec); err != nil {
-				errs = errors.Join(errs, fmt.Errorf("error configuring %q: %w", spec.Metadata().ID(), err))
+				logger.Warn("error configuring link", zap.String("link", linkName), zap.Error(err))
+
+				delete(ctrl.applied, linkName)
+
+				retry = true
+
+				continue
+			}
+
+			ctrl.applied[linkName] = applied
+		}
+
+		for linkName := range ctrl.applied {
+			if _, ok := seen[linkName]; !ok {
+				delete(ctrl.applied, linkName)
 			}
 		}
 
-		if errs != nil {
-			return fmt.Errorf("failed to reconcile Ethernet specs: %w", errs)
+		if retry {
+			retryTimer.Reset(ctrl.RetryInterval)
 		}
 
 		r.ResetRestartBackoff()
@@ -99,9 +200,11 @@ func optionalFromPtr[T any](ptr *T) optional.Optional[T] {
 }
 
 func (ctrl *EthernetSpecController) apply(
-	ethClient *ethtool.Client,
+	ethClient EthtoolClient,
 	spec *network.EthernetSpec,
 ) error {
+	var errs error
+
 	ringSpec := spec.TypedSpec().Rings
 
 	if !value.IsZero(ringSpec) {
@@ -120,20 +223,19 @@ func (ctrl *EthernetSpecController) apply(
 			TXPushBufLen: optionalFromPtr(ringSpec.TXPushBufLen),
 			TCPDataSplit: optionalFromPtr(ringSpec.TCPDataSplit),
 		}); err != nil {
-			return fmt.Errorf("error updating rings: %w", err)
+			errs = errors.Join(errs, fmt.Errorf("error updating rings: %w", err))
 		}
 	}
 
-	featureSpec := spec.TypedSpec().Features
-
-	if len(featureSpec) > 0 {
+	// features are applied one by one, so that a feature not supported by the driver doesn't affect other features
+	for _, name := range slices.Sorted(maps.Keys(spec.TypedSpec().Features)) {
 		if err := ethClient.SetFeatures(
 			ethtool.Interface{
 				Name: spec.Metadata().ID(),
 			},
-			featureSpec,
+			map[string]bool{name: spec.TypedSpec().Features[name]},
 		); err != nil {
-			return fmt.Errorf("error updating features: %w", err)
+			errs = errors.Join(errs, fmt.Errorf("error updating feature %q: %w", name, err))
 		}
 	}
 
@@ -149,9 +251,9 @@ func (ctrl *EthernetSpecController) apply(
 			OtherCount:    optionalFromPtr(channelsSpec.Other),
 			CombinedCount: optionalFromPtr(channelsSpec.Combined),
 		}); err != nil {
-			return fmt.Errorf("error updating channels: %w", err)
+			errs = errors.Join(errs, fmt.Errorf("error updating channels: %w", err))
 		}
 	}
 
-	return nil
+	return errs
 }
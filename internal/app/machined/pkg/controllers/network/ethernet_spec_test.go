@@ -0,0 +1,180 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mdlayher/ethtool"
+	"github.com/siderolabs/go-retry/retry"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+	runtimeres "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+type mockEthtoolClient struct {
+	mu sync.Mutex
+
+	fail     bool
+	features []map[string]bool
+}
+
+func (m *mockEthtoolClient) SetRings(ethtool.Rings) error {
+	return nil
+}
+
+func (m *mockEthtoolClient) SetFeatures(_ ethtool.Interface, features map[string]bool) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.features = append(m.features, features)
+
+	if m.fail {
+		return errors.New("device or resource busy")
+	}
+
+	return nil
+}
+
+func (m *mockEthtoolClient) SetChannels(ethtool.Channels) error {
+	return nil
+}
+
+func (m *mockEthtoolClient) Close() error {
+	return nil
+}
+
+func (m *mockEthtoolClient) SetFail(fail bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.fail = fail
+}
+
+func (m *mockEthtoolClient) Calls() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return len(m.features)
+}
+
+type EthernetSpecSuite struct {
+	ctest.DefaultSuite
+
+	client *mockEthtoolClient
+}
+
+func (suite *EthernetSpecSuite) assertCalls(expected int) {
+	suite.AssertWithin(3*time.Second, 10*time.Millisecond, func() error {
+		if calls := suite.client.Calls(); calls != expected {
+			return retry.ExpectedErrorf("expected %d calls, got %d", expected, calls)
+		}
+
+		return nil
+	})
+}
+
+func (suite *EthernetSpecSuite) TestReapply() {
+	deviceStatus := runtimeres.NewDevicesStatus(runtimeres.NamespaceName, runtimeres.DevicesID)
+	deviceStatus.TypedSpec().Ready = true
+	suite.Create(deviceStatus)
+
+	spec := network.NewEthernetSpec(network.NamespaceName, "eth0")
+	spec.TypedSpec().Features = map[string]bool{"rx-gro-hw": false}
+	suite.Create(spec)
+
+	// the link doesn't exist yet
+	time.Sleep(100 * time.Millisecond)
+	suite.Assert().Zero(suite.client.Calls())
+
+	link := network.NewLinkStatus(network.NamespaceName, "eth0")
+	link.TypedSpec().Index = 1
+	link.TypedSpec().OperationalState = nethelpers.OperStateUp
+	suite.Create(link)
+
+	suite.assertCalls(1)
+
+	// the spec is not re-applied if nothing changes
+	link.TypedSpec().LinkState = true
+	suite.Update(link)
+
+	time.Sleep(100 * time.Millisecond)
+	suite.assertCalls(1)
+
+	// the driver reset keeps the link index, but the link goes through the down state
+	link.TypedSpec().OperationalState = nethelpers.OperStateDown
+	suite.Update(link)
+
+	suite.assertCalls(2)
+
+	// failures are retried until the settings are applied
+	suite.client.SetFail(true)
+
+	link.TypedSpec().OperationalState = nethelpers.OperStateUp
+	suite.Update(link)
+
+	suite.AssertWithin(3*time.Second, 10*time.Millisecond, func() error {
+		if calls := suite.client.Calls(); calls < 5 {
+			return retry.ExpectedErrorf("expected retries, got %d calls", calls)
+		}
+
+		return nil
+	})
+
+	suite.client.SetFail(false)
+
+	suite.AssertWithin(3*time.Second, 10*time.Millisecond, func() error {
+		calls := suite.client.Calls()
+
+		time.Sleep(200 * time.Millisecond)
+
+		if suite.client.Calls() != calls {
+			return retry.ExpectedErrorf("still retrying")
+		}
+
+		return nil
+	})
+
+	// the link is re-created with a new index
+	calls := suite.client.Calls()
+
+	suite.Destroy(link)
+
+	link = network.NewLinkStatus(network.NamespaceName, "eth0")
+	link.TypedSpec().Index = 2
+	link.TypedSpec().OperationalState = nethelpers.OperStateUp
+	suite.Create(link)
+
+	suite.assertCalls(calls + 1)
+}
+
+func TestEthernetSpecSuite(t *testing.T) {
+	t.Parallel()
+
+	s := &EthernetSpecSuite{}
+
+	s.DefaultSuite = ctest.DefaultSuite{
+		Timeout: 10 * time.Second,
+		AfterSetup: func(suite *ctest.DefaultSuite) {
+			s.client = &mockEthtoolClient{}
+
+			suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.EthernetSpecController{
+				NewClient: func() (netctrl.EthtoolClient, error) {
+					return s.client, nil
+				},
+				RetryInterval: 20 * time.Millisecond,
+			}))
+		},
+	}
+
+	suite.Run(t, s)
+}
@@ -56,6 +56,11 @@ func (ctrl *EthernetStatusController) Run(ctx context.Context, r controller.Runt
 				Type:      network.LinkSpecType,
 				Kind:      controller.InputWeak,
 			},
+			{
+				Namespace: network.NamespaceName,
+				Type:      network.EthernetSpecType,
+				Kind:      controller.InputWeak,
+			},
 		},
 	); err != nil {
 		return err
@@ -115,6 +120,11 @@ func (ctrl *EthernetStatusController) reconcile(
 		return fmt.Errorf("error listing links: %w", err)
 	}
 
+	ethernetSpecs, err := safe.ReaderListAll[*network.EthernetSpec](ctx, r)
+	if err != nil {
+		return fmt.Errorf("error listing EthernetSpec resources: %w", err)
+	}
+
 	for _, linkInfo := range linkInfos {
 		iface := linkInfo.Interface
 
@@ -211,6 +221,12 @@ func (ctrl *EthernetStatusController) reconcile(
 				}
 			}
 
+			res.TypedSpec().ConfigErrors = nil
+
+			if ethernetSpec, ok := ethernetSpecs.Find(func(spec *network.EthernetSpec) bool { return spec.Metadata().ID() == iface.Name }); ok {
+				res.TypedSpec().ConfigErrors = ethernetSpec.TypedSpec().ConfigErrors(res.TypedSpec())
+			}
+
 			return nil
 		}); err != nil {
 			return fmt.Errorf("error updating EthernetStatus resource: %w", err)
@@ -18,8 +18,25 @@ import (
 
 type genericMergeFunc[T typed.DeepCopyable[T], E typed.Extension] func(logger *zap.Logger, in safe.List[*typed.Resource[T, E]]) map[resource.ID]*T
 
+// genericMergeWithInputsFunc is a merge function which can read the extra inputs of the controller.
+type genericMergeWithInputsFunc[T typed.DeepCopyable[T], E typed.Extension] func(
+	ctx context.Context, r controller.Reader, logger *zap.Logger, in safe.List[*typed.Resource[T, E]],
+) (map[resource.ID]*T, error)
+
 // GenericMergeController initializes a generic merge controller for network resources.
 func GenericMergeController[T typed.DeepCopyable[T], E typed.Extension](namespaceIn, namespaceOut resource.Namespace, mergeFunc genericMergeFunc[T, E]) controller.Controller {
+	return GenericMergeControllerWithInputs(namespaceIn, namespaceOut, nil,
+		func(_ context.Context, _ controller.Reader, logger *zap.Logger, in safe.List[*typed.Resource[T, E]]) (map[resource.ID]*T, error) {
+			return mergeFunc(logger, in), nil
+		},
+	)
+}
+
+// GenericMergeControllerWithInputs initializes a generic merge controller for network resources,
+// which watches extra inputs and passes them to the merge function.
+func GenericMergeControllerWithInputs[T typed.DeepCopyable[T], E typed.Extension](
+	namespaceIn, namespaceOut resource.Namespace, extraInputs []controller.Input, mergeFunc genericMergeWithInputsFunc[T, E],
+) controller.Controller {
 	var zeroE E
 
 	controllerName := strings.ReplaceAll(zeroE.ResourceDefinition().Type, "Spec", "MergeController")
@@ -29,6 +46,7 @@ func GenericMergeController[T typed.DeepCopyable[T], E typed.Extension](namespac
 		resourceType:   zeroE.ResourceDefinition().Type,
 		namespaceIn:    namespaceIn,
 		namespaceOut:   namespaceOut,
+		extraInputs:    extraInputs,
 		mergeFunc:      mergeFunc,
 	}
 }
@@ -38,7 +56,8 @@ type genericMergeController[T typed.DeepCopyable[T], E typed.Extension] struct {
 	resourceType   resource.Type
 	namespaceIn    resource.Namespace
 	namespaceOut   resource.Namespace
-	mergeFunc      genericMergeFunc[T, E]
+	extraInputs    []controller.Input
+	mergeFunc      genericMergeWithInputsFunc[T, E]
 }
 
 func (ctrl *genericMergeController[T, E]) Name() string {
@@ -46,7 +65,7 @@ func (ctrl *genericMergeController[T, E]) Name() string {
 }
 
 func (ctrl *genericMergeController[T, E]) Inputs() []controller.Input {
-	return []controller.Input{
+	return append([]controller.Input{
 		{
 			Namespace: ctrl.namespaceIn,
 			Type:      ctrl.resourceType,
@@ -57,7 +76,7 @@ func (ctrl *genericMergeController[T, E]) Inputs() []controller.Input {
 			Type:      ctrl.resourceType,
 			Kind:      controller.InputDestroyReady,
 		},
-	}
+	}, ctrl.extraInputs...)
 }
 
 func (ctrl *genericMergeController[T, E]) Outputs() []controller.Output {
@@ -86,7 +105,10 @@ func (ctrl *genericMergeController[T, E]) Run(ctx context.Context, r controller.
 			return fmt.Errorf("error listing source network resources: %w", err)
 		}
 
-		merged := ctrl.mergeFunc(logger, in)
+		merged, err := ctrl.mergeFunc(ctx, r, logger, in)
+		if err != nil {
+			return fmt.Errorf("error merging resources: %w", err)
+		}
 
 		// cleanup resources, detecting conflicts on the way
 		out, err := safe.ReaderList[*R](ctx, r, resource.NewMetadata(ctrl.namespaceOut, ctrl.resourceType, "", resource.VersionUndefined))
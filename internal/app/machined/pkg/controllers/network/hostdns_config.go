@@ -5,6 +5,7 @@
 package network
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"net/netip"
@@ -93,16 +94,44 @@ func (ctrl *HostDNSConfigController) Run(ctx context.Context, r controller.Runti
 
 			res.TypedSpec().ServiceHostDNSAddress = netip.Addr{}
 
+			res.TypedSpec().CacheSize = constants.HostDNSDefaultCacheSize
+			res.TypedSpec().CacheMinTTL = 0
+			res.TypedSpec().CacheMaxTTL = constants.HostDNSDefaultCacheMaxTTL
+			res.TypedSpec().NegativeCacheTTL = constants.HostDNSDefaultNegativeCacheTTL
+			res.TypedSpec().ServeStale = 0
+			res.TypedSpec().AllowedClients = defaultHostDNSAllowedClients()
+
 			if cfgProvider == nil {
 				res.TypedSpec().Enabled = false
 
 				return nil
 			}
 
-			res.TypedSpec().Enabled = cfgProvider.Machine().Features().HostDNS().Enabled()
-			res.TypedSpec().ResolveMemberNames = cfgProvider.Machine().Features().HostDNS().ResolveMemberNames()
+			hostDNS := cfgProvider.Machine().Features().HostDNS()
+
+			res.TypedSpec().Enabled = hostDNS.Enabled()
+			res.TypedSpec().ResolveMemberNames = hostDNS.ResolveMemberNames()
 
-			if !cfgProvider.Machine().Features().HostDNS().ForwardKubeDNSToHost() {
+			res.TypedSpec().CacheSize = cmp.Or(hostDNS.CacheSize(), constants.HostDNSDefaultCacheSize)
+			res.TypedSpec().CacheMinTTL = hostDNS.CacheMinTTL()
+			res.TypedSpec().CacheMaxTTL = cmp.Or(hostDNS.CacheMaxTTL(), constants.HostDNSDefaultCacheMaxTTL)
+			res.TypedSpec().ServeStale = hostDNS.ServeStale()
+
+			if allowedClients := hostDNS.AllowedClients(); len(allowedClients) > 0 {
+				res.TypedSpec().AllowedClients = append(res.TypedSpec().AllowedClients, allowedClients...)
+			} else {
+				for _, cidr := range cfgProvider.Cluster().Network().PodCIDRs() {
+					res.TypedSpec().AllowedClients = append(res.TypedSpec().AllowedClients, netip.MustParsePrefix(cidr).Masked())
+				}
+			}
+
+			if hostDNS.NegativeCacheEnabled() {
+				res.TypedSpec().NegativeCacheTTL = cmp.Or(hostDNS.NegativeCacheTTL(), constants.HostDNSDefaultNegativeCacheTTL)
+			} else {
+				res.TypedSpec().NegativeCacheTTL = 0
+			}
+
+			if !hostDNS.ForwardKubeDNSToHost() {
 				return nil
 			}
 
@@ -135,6 +164,17 @@ func (ctrl *HostDNSConfigController) Run(ctx context.Context, r controller.Runti
 	}
 }
 
+// defaultHostDNSAllowedClients returns the node-local prefixes which are always allowed to query the host DNS.
+func defaultHostDNSAllowedClients() []netip.Prefix {
+	hostDNSAddress := netip.MustParseAddr(constants.HostDNSAddress)
+
+	return []netip.Prefix{
+		netip.MustParsePrefix("127.0.0.0/8"),
+		netip.MustParsePrefix("::1/128"),
+		netip.PrefixFrom(hostDNSAddress, hostDNSAddress.BitLen()),
+	}
+}
+
 func updateSpec(ctx context.Context, r controller.Runtime, newServiceAddr netip.Addr, logger *zap.Logger) error {
 	newDNSAddrPrefix := netip.PrefixFrom(newServiceAddr, newServiceAddr.BitLen())
 
@@ -0,0 +1,110 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package lldp
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+
+	"github.com/mdlayher/packet"
+	"golang.org/x/sys/unix"
+)
+
+// Packet is a received LLDP frame.
+type Packet struct {
+	LinkName string
+	Frame    []byte
+}
+
+// Listener receives LLDP frames.
+type Listener interface {
+	Listen(ctx context.Context, linkName string, packetCh chan<- Packet) error
+}
+
+// SocketListener receives LLDP frames on the link via the raw packet socket bound to the LLDP ethertype.
+type SocketListener struct{}
+
+// Listen implements Listener interface.
+func (SocketListener) Listen(ctx context.Context, linkName string, packetCh chan<- Packet) error {
+	iface, err := net.InterfaceByName(linkName)
+	if err != nil {
+		return fmt.Errorf("error looking up link %q: %w", linkName, err)
+	}
+
+	conn, err := packet.Listen(iface, packet.Raw, EtherType, nil)
+	if err != nil {
+		return fmt.Errorf("error listening on packet socket: %w", err)
+	}
+
+	stop := context.AfterFunc(ctx, func() {
+		conn.Close() //nolint:errcheck
+	})
+	defer stop()
+
+	defer conn.Close() //nolint:errcheck
+
+	if err = joinMulticast(conn, iface); err != nil {
+		return err
+	}
+
+	// one extra byte to detect frames which are larger than the maximum size
+	buf := make([]byte, MaxFrameSize+1)
+
+	for {
+		n, _, err := conn.ReadFrom(buf)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return fmt.Errorf("error reading packet socket: %w", err)
+		}
+
+		if n > MaxFrameSize {
+			continue
+		}
+
+		select {
+		case packetCh <- Packet{
+			LinkName: linkName,
+			Frame:    slices.Clone(buf[:n]),
+		}:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// joinMulticast makes the link accept the frames sent to the LLDP multicast address.
+func joinMulticast(conn *packet.Conn, iface *net.Interface) error {
+	rawConn, err := conn.SyscallConn()
+	if err != nil {
+		return fmt.Errorf("error getting raw connection: %w", err)
+	}
+
+	mreq := unix.PacketMreq{
+		Ifindex: int32(iface.Index),
+		Type:    unix.PACKET_MR_MULTICAST,
+		Alen:    uint16(len(MulticastAddr)),
+	}
+
+	copy(mreq.Address[:], MulticastAddr)
+
+	var sockErr error
+
+	if err = rawConn.Control(func(fd uintptr) {
+		sockErr = unix.SetsockoptPacketMreq(int(fd), unix.SOL_PACKET, unix.PACKET_ADD_MEMBERSHIP, &mreq)
+	}); err != nil {
+		return fmt.Errorf("error controlling packet socket: %w", err)
+	}
+
+	if sockErr != nil {
+		return fmt.Errorf("error joining LLDP multicast group: %w", sockErr)
+	}
+
+	return nil
+}
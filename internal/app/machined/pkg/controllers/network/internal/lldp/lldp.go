@@ -0,0 +1,224 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package lldp implements parsing and receiving of LLDP (IEEE 802.1AB) frames.
+package lldp
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"time"
+)
+
+// EtherType is the LLDP ethertype.
+const EtherType = 0x88cc
+
+// MaxFrameSize is the maximum size of the accepted LLDP frame (Ethernet header and the maximum payload).
+//
+// Larger frames are dropped without parsing.
+const MaxFrameSize = 14 + 1500
+
+// MulticastAddr is the nearest bridge group address LLDP frames are sent to.
+var MulticastAddr = net.HardwareAddr{0x01, 0x80, 0xc2, 0x00, 0x00, 0x0e}
+
+const (
+	tlvEnd               = 0
+	tlvChassisID         = 1
+	tlvPortID            = 2
+	tlvTTL               = 3
+	tlvPortDescription   = 4
+	tlvSystemName        = 5
+	tlvSystemDescription = 6
+	tlvOrganization      = 127
+
+	// Chassis ID and port ID subtypes which are not printable strings.
+	chassisIDSubtypeMAC     = 4
+	chassisIDSubtypeNetwork = 5
+	portIDSubtypeMAC        = 3
+	portIDSubtypeNetwork    = 4
+
+	// IEEE 802.1 organizationally specific TLV: port VLAN ID.
+	ouiIEEE8021            = 0x0080c2
+	ieee8021SubtypePortVID = 1
+
+	// IANA address family numbers.
+	addressFamilyIPv4 = 1
+	addressFamilyIPv6 = 2
+
+	ethernetHeaderLength = 14
+)
+
+// Neighbor is a parsed LLDP data unit.
+type Neighbor struct {
+	ChassisID         string
+	PortID            string
+	PortDescription   string
+	SystemName        string
+	SystemDescription string
+	// Port VLAN ID, zero if not advertised.
+	PortVLANID uint16
+	// Time to live, zero means the neighbor is shutting down.
+	TTL time.Duration
+}
+
+// ParseFrame parses the Ethernet frame with the LLDP data unit.
+func ParseFrame(b []byte) (*Neighbor, error) {
+	if len(b) > MaxFrameSize {
+		return nil, fmt.Errorf("frame is too large: %d bytes", len(b))
+	}
+
+	if len(b) < ethernetHeaderLength {
+		return nil, errors.New("frame is too short")
+	}
+
+	if etherType := binary.BigEndian.Uint16(b[12:14]); etherType != EtherType {
+		return nil, fmt.Errorf("unexpected ethertype 0x%04x", etherType)
+	}
+
+	return Parse(b[ethernetHeaderLength:])
+}
+
+// Parse the LLDP data unit.
+//
+// The chassis ID, port ID and TTL TLVs are mandatory, unknown TLVs are skipped.
+//
+//nolint:gocyclo,cyclop
+func Parse(b []byte) (*Neighbor, error) {
+	var (
+		neighbor Neighbor
+		seen     [tlvTTL + 1]bool
+	)
+
+	for index := 0; len(b) > 0; index++ {
+		if len(b) < 2 {
+			return nil, errors.New("truncated TLV")
+		}
+
+		header := binary.BigEndian.Uint16(b[:2])
+		typ, length := int(header>>9), int(header&0x1ff)
+
+		if length > len(b)-2 {
+			return nil, fmt.Errorf("TLV %d overflows the data unit", typ)
+		}
+
+		value := b[2 : 2+length]
+		b = b[2+length:]
+
+		// IEEE 802.1AB, section 8.2: the first three TLVs are chassis ID, port ID and TTL in this order
+		if index <= tlvTTL-1 && typ != index+1 {
+			return nil, fmt.Errorf("unexpected TLV %d at position %d", typ, index)
+		}
+
+		switch typ {
+		case tlvEnd:
+			b = nil
+		case tlvChassisID, tlvPortID:
+			if seen[typ] {
+				return nil, fmt.Errorf("duplicate TLV %d", typ)
+			}
+
+			if length < 2 {
+				return nil, fmt.Errorf("TLV %d is too short", typ)
+			}
+
+			if typ == tlvChassisID {
+				neighbor.ChassisID = formatID(value[0], value[1:], chassisIDSubtypeMAC, chassisIDSubtypeNetwork)
+			} else {
+				neighbor.PortID = formatID(value[0], value[1:], portIDSubtypeMAC, portIDSubtypeNetwork)
+			}
+		case tlvTTL:
+			if seen[typ] {
+				return nil, fmt.Errorf("duplicate TLV %d", typ)
+			}
+
+			if length < 2 {
+				return nil, errors.New("TTL TLV is too short")
+			}
+
+			neighbor.TTL = time.Duration(binary.BigEndian.Uint16(value)) * time.Second
+		case tlvPortDescription:
+			neighbor.PortDescription = string(value)
+		case tlvSystemName:
+			neighbor.SystemName = string(value)
+		case tlvSystemDescription:
+			neighbor.SystemDescription = string(value)
+		case tlvOrganization:
+			if length >= 6 && uint32(value[0])<<16|uint32(value[1])<<8|uint32(value[2]) == ouiIEEE8021 && value[3] == ieee8021SubtypePortVID {
+				neighbor.PortVLANID = binary.BigEndian.Uint16(value[4:6])
+			}
+		}
+
+		if typ <= tlvTTL {
+			seen[typ] = true
+		}
+	}
+
+	if !seen[tlvChassisID] || !seen[tlvPortID] || !seen[tlvTTL] {
+		return nil, errors.New("mandatory TLVs are missing")
+	}
+
+	return &neighbor, nil
+}
+
+// formatID formats the chassis or port ID: MAC and network addresses are formatted, other subtypes are strings.
+func formatID(subtype byte, id []byte, macSubtype, networkSubtype byte) string {
+	switch subtype {
+	case macSubtype:
+		if len(id) == 6 {
+			return net.HardwareAddr(id).String()
+		}
+	case networkSubtype:
+		if len(id) > 0 {
+			switch addr, ok := netip.AddrFromSlice(id[1:]); {
+			case ok && id[0] == addressFamilyIPv4 && addr.Is4():
+				return addr.String()
+			case ok && id[0] == addressFamilyIPv6 && addr.Is6():
+				return addr.String()
+			}
+		}
+	}
+
+	return string(id)
+}
+
+// Marshal the neighbor into the LLDP data unit.
+//
+// Chassis ID and port ID are encoded as locally assigned strings.
+func (neighbor *Neighbor) Marshal() []byte {
+	var b []byte
+
+	appendTLV := func(typ int, value []byte) {
+		b = binary.BigEndian.AppendUint16(b, uint16(typ<<9|len(value)))
+		b = append(b, value...)
+	}
+
+	const subtypeLocal = 7
+
+	appendTLV(tlvChassisID, append([]byte{subtypeLocal}, neighbor.ChassisID...))
+	appendTLV(tlvPortID, append([]byte{subtypeLocal}, neighbor.PortID...))
+	appendTLV(tlvTTL, binary.BigEndian.AppendUint16(nil, uint16(neighbor.TTL/time.Second)))
+
+	if neighbor.PortDescription != "" {
+		appendTLV(tlvPortDescription, []byte(neighbor.PortDescription))
+	}
+
+	if neighbor.SystemName != "" {
+		appendTLV(tlvSystemName, []byte(neighbor.SystemName))
+	}
+
+	if neighbor.SystemDescription != "" {
+		appendTLV(tlvSystemDescription, []byte(neighbor.SystemDescription))
+	}
+
+	if neighbor.PortVLANID != 0 {
+		appendTLV(tlvOrganization, binary.BigEndian.AppendUint16([]byte{0x00, 0x80, 0xc2, ieee8021SubtypePortVID}, neighbor.PortVLANID))
+	}
+
+	appendTLV(tlvEnd, nil)
+
+	return b
+}
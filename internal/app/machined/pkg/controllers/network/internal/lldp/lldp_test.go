@@ -0,0 +1,121 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package lldp_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/lldp"
+)
+
+func TestParseRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	neighbor := &lldp.Neighbor{
+		ChassisID:         "switch-1",
+		PortID:            "Ethernet1/12",
+		PortDescription:   "rack 3, node 12",
+		SystemName:        "switch-1.example.com",
+		SystemDescription: "Switch OS 1.2.3",
+		PortVLANID:        100,
+		TTL:               120 * time.Second,
+	}
+
+	parsed, err := lldp.Parse(neighbor.Marshal())
+	require.NoError(t, err)
+
+	assert.Equal(t, neighbor, parsed)
+}
+
+func TestParseFrame(t *testing.T) {
+	t.Parallel()
+
+	frame := slices.Concat(
+		// Ethernet header: destination, source, ethertype
+		[]byte{0x01, 0x80, 0xc2, 0x00, 0x00, 0x0e, 0x00, 0x1b, 0x21, 0x3c, 0x4d, 0x5e, 0x88, 0xcc},
+		// chassis ID: MAC address
+		[]byte{0x02, 0x07, 0x04, 0x00, 0x1b, 0x21, 0x3c, 0x4d, 0x5e},
+		// port ID: interface name
+		[]byte{0x04, 0x05, 0x05, 'e', 't', 'h', '7'},
+		// TTL
+		[]byte{0x06, 0x02, 0x00, 0x78},
+		// system name
+		[]byte{0x0a, 0x03, 's', 'w', '1'},
+		// unknown TLV: system capabilities
+		[]byte{0x0e, 0x04, 0x00, 0x14, 0x00, 0x04},
+		// IEEE 802.1 port VLAN ID
+		[]byte{0xfe, 0x06, 0x00, 0x80, 0xc2, 0x01, 0x00, 0x0a},
+		// management address: ignored
+		[]byte{0x10, 0x0c, 0x05, 0x01, 0x0a, 0x00, 0x00, 0x01, 0x02, 0x00, 0x00, 0x00, 0x01, 0x00},
+		// end
+		[]byte{0x00, 0x00},
+	)
+
+	neighbor, err := lldp.ParseFrame(frame)
+	require.NoError(t, err)
+
+	assert.Equal(t, &lldp.Neighbor{
+		ChassisID:  "00:1b:21:3c:4d:5e",
+		PortID:     "eth7",
+		SystemName: "sw1",
+		PortVLANID: 10,
+		TTL:        120 * time.Second,
+	}, neighbor)
+}
+
+func TestParseInvalid(t *testing.T) {
+	t.Parallel()
+
+	valid := (&lldp.Neighbor{ChassisID: "a", PortID: "b", TTL: time.Minute}).Marshal()
+
+	for _, test := range []struct {
+		name          string
+		frame         []byte
+		expectedError string
+	}{
+		{
+			name:          "short",
+			frame:         []byte{0x01, 0x80},
+			expectedError: "frame is too short",
+		},
+		{
+			name:          "too large",
+			frame:         make([]byte, lldp.MaxFrameSize+1),
+			expectedError: "frame is too large: 1515 bytes",
+		},
+		{
+			name:          "wrong ethertype",
+			frame:         slices.Concat(make([]byte, 12), []byte{0x08, 0x00}, valid),
+			expectedError: "unexpected ethertype 0x0800",
+		},
+		{
+			name:          "truncated",
+			frame:         slices.Concat(make([]byte, 12), []byte{0x88, 0xcc}, valid[:len(valid)-3]),
+			expectedError: "TLV 3 overflows the data unit",
+		},
+		{
+			name:          "wrong order",
+			frame:         slices.Concat(make([]byte, 12), []byte{0x88, 0xcc}, []byte{0x04, 0x02, 0x07, 'b'}, valid),
+			expectedError: "unexpected TLV 2 at position 0",
+		},
+		{
+			name:          "missing TTL",
+			frame:         slices.Concat(make([]byte, 12), []byte{0x88, 0xcc}, valid[:8], []byte{0x00, 0x00}),
+			expectedError: "unexpected TLV 0 at position 2",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := lldp.ParseFrame(test.frame)
+			assert.EqualError(t, err, test.expectedError)
+		})
+	}
+}
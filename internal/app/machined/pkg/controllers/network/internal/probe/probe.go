@@ -6,16 +6,25 @@
 package probe
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
+	"math/rand/v2"
 	"net"
+	"net/http"
 	"sync"
 	"syscall"
 
 	"github.com/benbjohnson/clock"
+	"github.com/hashicorp/go-cleanhttp"
 	"github.com/siderolabs/gen/channel"
 	"go.uber.org/zap"
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
 
+	"github.com/siderolabs/talos/pkg/httpdefaults"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 )
 
@@ -27,6 +36,9 @@ type Runner struct {
 
 	cancel context.CancelFunc
 	wg     sync.WaitGroup
+
+	icmpID  int
+	icmpSeq int
 }
 
 // Notification of a runner status.
@@ -82,6 +94,8 @@ func (runner *Runner) run(ctx context.Context, notifyCh chan<- Notification, log
 			firstIteration = false
 		}
 
+		start := runner.Clock.Now()
+
 		err := runner.probe(ctx)
 		if err == nil {
 			if consecutiveFailures > 0 {
@@ -93,7 +107,8 @@ func (runner *Runner) run(ctx context.Context, notifyCh chan<- Notification, log
 			if !channel.SendWithContext(ctx, notifyCh, Notification{
 				ID: runner.ID,
 				Status: network.ProbeStatusSpec{
-					Success: true,
+					Success:     true,
+					LastLatency: runner.Clock.Since(start),
 				},
 			}) {
 				return
@@ -115,8 +130,9 @@ func (runner *Runner) run(ctx context.Context, notifyCh chan<- Notification, log
 		if !channel.SendWithContext(ctx, notifyCh, Notification{
 			ID: runner.ID,
 			Status: network.ProbeStatusSpec{
-				Success:   false,
-				LastError: err.Error(),
+				Success:             false,
+				LastError:           err.Error(),
+				ConsecutiveFailures: consecutiveFailures,
 			},
 		}) {
 			return
@@ -126,11 +142,19 @@ func (runner *Runner) run(ctx context.Context, notifyCh chan<- Notification, log
 
 // probe runs a probe.
 func (runner *Runner) probe(ctx context.Context) error {
-	var zeroTCP network.TCPProbeSpec
+	var (
+		zeroTCP  network.TCPProbeSpec
+		zeroHTTP network.HTTPProbeSpec
+		zeroICMP network.ICMPProbeSpec
+	)
 
 	switch {
 	case runner.Spec.TCP != zeroTCP:
 		return runner.probeTCP(ctx)
+	case runner.Spec.HTTP != zeroHTTP:
+		return runner.probeHTTP(ctx)
+	case runner.Spec.ICMP != zeroICMP:
+		return runner.probeICMP(ctx)
 	default:
 		return errors.New("no probe type specified")
 	}
@@ -157,3 +181,126 @@ func (runner *Runner) probeTCP(ctx context.Context) error {
 
 	return conn.Close()
 }
+
+// probeHTTP runs an HTTP probe.
+func (runner *Runner) probeHTTP(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, runner.Spec.HTTP.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, runner.Spec.HTTP.URL, nil)
+	if err != nil {
+		return err
+	}
+
+	client := &http.Client{
+		Transport: httpdefaults.PatchTransport(cleanhttp.DefaultTransport()),
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("unexpected response status: %s", resp.Status)
+	}
+
+	return nil
+}
+
+// probeICMP runs an ICMP echo probe.
+//
+//nolint:gocyclo
+func (runner *Runner) probeICMP(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, runner.Spec.ICMP.Timeout)
+	defer cancel()
+
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", runner.Spec.ICMP.Host)
+	if err != nil {
+		return err
+	}
+
+	addr := addrs[0].Unmap()
+
+	var (
+		listenNetwork, listenAddr string
+		echoType, replyType       icmp.Type
+		protocol                  int
+	)
+
+	if addr.Is4() {
+		listenNetwork, listenAddr = "ip4:icmp", "0.0.0.0"
+		echoType, replyType = ipv4.ICMPTypeEcho, ipv4.ICMPTypeEchoReply
+		protocol = ipv4.ICMPTypeEcho.Protocol()
+	} else {
+		listenNetwork, listenAddr = "ip6:ipv6-icmp", "::"
+		echoType, replyType = ipv6.ICMPTypeEchoRequest, ipv6.ICMPTypeEchoReply
+		protocol = ipv6.ICMPTypeEchoRequest.Protocol()
+	}
+
+	conn, err := icmp.ListenPacket(listenNetwork, listenAddr)
+	if err != nil {
+		return fmt.Errorf("error listening on ICMP socket: %w", err)
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err = conn.SetDeadline(deadline); err != nil {
+			return err
+		}
+	}
+
+	if runner.icmpID == 0 {
+		runner.icmpID = rand.IntN(0xffff) + 1
+	}
+
+	runner.icmpSeq = (runner.icmpSeq + 1) & 0xffff
+
+	payload := []byte(runner.ID)
+
+	request, err := (&icmp.Message{
+		Type: echoType,
+		Body: &icmp.Echo{
+			ID:   runner.icmpID,
+			Seq:  runner.icmpSeq,
+			Data: payload,
+		},
+	}).Marshal(nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err = conn.WriteTo(request, &net.IPAddr{IP: addr.AsSlice(), Zone: addr.Zone()}); err != nil {
+		return err
+	}
+
+	buf := make([]byte, 1500)
+
+	for {
+		var (
+			n    int
+			peer net.Addr
+		)
+
+		n, peer, err = conn.ReadFrom(buf)
+		if err != nil {
+			return fmt.Errorf("no ICMP echo reply from %s: %w", addr, err)
+		}
+
+		if peerAddr, ok := peer.(*net.IPAddr); !ok || !peerAddr.IP.Equal(addr.AsSlice()) {
+			continue
+		}
+
+		reply, parseErr := icmp.ParseMessage(protocol, buf[:n])
+		if parseErr != nil || reply.Type != replyType {
+			continue
+		}
+
+		if echo, ok := reply.Body.(*icmp.Echo); ok && echo.ID == runner.icmpID && echo.Seq == runner.icmpSeq && bytes.Equal(echo.Data, payload) {
+			return nil
+		}
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -53,12 +54,11 @@ func TestProbeHTTP(t *testing.T) {
 
 	// probe should always succeed
 	for range 3 {
-		assert.Equal(t, probe.Notification{
-			ID: "test",
-			Status: network.ProbeStatusSpec{
-				Success: true,
-			},
-		}, <-notifyCh)
+		notification := <-notifyCh
+
+		assert.Equal(t, "test", notification.ID)
+		assert.True(t, notification.Status.Success)
+		assert.Empty(t, notification.Status.LastError)
 	}
 
 	// stop the test server, probe should fail
@@ -142,6 +142,7 @@ func TestProbeConsecutiveFailures(t *testing.T) {
 	assert.Equal(t, "consecutive-failures", notify.ID)
 	assert.False(t, notify.Status.Success)
 	assert.Contains(t, notify.Status.LastError, "connection refused")
+	assert.Equal(t, 3, notify.Status.ConsecutiveFailures)
 
 	// advance clock to trigger another failure(s)
 	mockClock.Add(p.Spec.Interval)
@@ -149,4 +150,64 @@ func TestProbeConsecutiveFailures(t *testing.T) {
 	notify = <-notifyCh
 	assert.Equal(t, "consecutive-failures", notify.ID)
 	assert.False(t, notify.Status.Success)
+	assert.Equal(t, 4, notify.Status.ConsecutiveFailures)
+}
+
+func TestProbeHTTPGet(t *testing.T) {
+	t.Parallel()
+
+	var healthy atomic.Bool
+
+	healthy.Store(true)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if healthy.Load() {
+			w.WriteHeader(http.StatusOK)
+		} else {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	p := probe.Runner{
+		ID: "http",
+		Spec: network.ProbeSpecSpec{
+			Interval:         10 * time.Millisecond,
+			FailureThreshold: 1,
+			HTTP: network.HTTPProbeSpec{
+				URL:     server.URL,
+				Timeout: time.Second,
+			},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(t.Context(), 3*time.Second)
+	t.Cleanup(cancel)
+
+	notifyCh := make(chan probe.Notification)
+
+	p.Start(ctx, notifyCh, zaptest.NewLogger(t))
+	t.Cleanup(p.Stop)
+
+	notification := <-notifyCh
+	assert.Equal(t, "http", notification.ID)
+	assert.True(t, notification.Status.Success)
+	assert.Positive(t, notification.Status.LastLatency)
+
+	// the server starts returning errors, probe should fail
+	healthy.Store(false)
+
+	for {
+		notification = <-notifyCh
+
+		if notification.Status.Success {
+			continue
+		}
+
+		assert.Equal(t, "http", notification.ID)
+		assert.Equal(t, "unexpected response status: 503 Service Unavailable", notification.Status.LastError)
+		assert.Equal(t, 1, notification.Status.ConsecutiveFailures)
+
+		break
+	}
 }
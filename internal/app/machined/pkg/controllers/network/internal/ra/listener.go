@@ -0,0 +1,105 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package ra
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"slices"
+
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv6"
+)
+
+// Packet is a received router advertisement packet.
+type Packet struct {
+	LinkName string
+	Source   netip.Addr
+	HopLimit int
+	Payload  []byte
+}
+
+// Listener receives router advertisements.
+type Listener interface {
+	Listen(ctx context.Context, packetCh chan<- Packet) error
+}
+
+// SocketListener receives router advertisements on all links via the raw ICMPv6 socket.
+type SocketListener struct{}
+
+// Listen implements Listener interface.
+func (SocketListener) Listen(ctx context.Context, packetCh chan<- Packet) error {
+	conn, err := icmp.ListenPacket("ip6:ipv6-icmp", "::")
+	if err != nil {
+		return fmt.Errorf("error listening on ICMPv6 socket: %w", err)
+	}
+
+	stop := context.AfterFunc(ctx, func() {
+		conn.Close() //nolint:errcheck
+	})
+	defer stop()
+
+	defer conn.Close() //nolint:errcheck
+
+	pc := conn.IPv6PacketConn()
+
+	var filter ipv6.ICMPFilter
+
+	filter.SetAll(true)
+	filter.Accept(ipv6.ICMPTypeRouterAdvertisement)
+
+	if err = pc.SetICMPFilter(&filter); err != nil {
+		return fmt.Errorf("error setting ICMPv6 filter: %w", err)
+	}
+
+	if err = pc.SetControlMessage(ipv6.FlagInterface|ipv6.FlagHopLimit, true); err != nil {
+		return fmt.Errorf("error enabling control messages: %w", err)
+	}
+
+	buf := make([]byte, 1500)
+
+	for {
+		n, cm, src, err := pc.ReadFrom(buf)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return fmt.Errorf("error reading ICMPv6 socket: %w", err)
+		}
+
+		if cm == nil {
+			continue
+		}
+
+		iface, err := net.InterfaceByIndex(cm.IfIndex)
+		if err != nil {
+			continue
+		}
+
+		ipAddr, ok := src.(*net.IPAddr)
+		if !ok {
+			continue
+		}
+
+		source, ok := netip.AddrFromSlice(ipAddr.IP)
+		if !ok {
+			continue
+		}
+
+		select {
+		case packetCh <- Packet{
+			LinkName: iface.Name,
+			Source:   source,
+			HopLimit: cm.HopLimit,
+			Payload:  slices.Clone(buf[:n]),
+		}:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
@@ -0,0 +1,262 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package ra implements parsing and receiving of IPv6 router advertisements.
+package ra
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"net/netip"
+	"time"
+)
+
+// InfiniteLifetime is the lifetime which never expires (all ones in the packet).
+const InfiniteLifetime = time.Duration(math.MaxInt64)
+
+const (
+	icmpTypeRouterAdvertisement = 134
+
+	optionRouteInformation   = 24
+	optionRecursiveDNSServer = 25
+
+	// ICMPv6 header (4 bytes) + RA header (12 bytes).
+	headerLength = 16
+)
+
+// Preference is the router or route preference (RFC 4191).
+type Preference int8
+
+// Preference values.
+const (
+	PreferenceLow    Preference = -1
+	PreferenceMedium Preference = 0
+	PreferenceHigh   Preference = 1
+)
+
+// String implements fmt.Stringer interface.
+func (p Preference) String() string {
+	switch p {
+	case PreferenceLow:
+		return "low"
+	case PreferenceMedium:
+		return "medium"
+	case PreferenceHigh:
+		return "high"
+	default:
+		return fmt.Sprintf("Preference(%d)", int8(p))
+	}
+}
+
+// preferenceFromBits decodes the 2-bit preference field.
+//
+// The reserved value (10) is returned as !ok.
+func preferenceFromBits(bits byte) (Preference, bool) {
+	switch bits & 0x3 {
+	case 0b01:
+		return PreferenceHigh, true
+	case 0b00:
+		return PreferenceMedium, true
+	case 0b11:
+		return PreferenceLow, true
+	default:
+		return PreferenceMedium, false
+	}
+}
+
+func (p Preference) bits() byte {
+	switch p {
+	case PreferenceHigh:
+		return 0b01
+	case PreferenceLow:
+		return 0b11
+	default:
+		return 0b00
+	}
+}
+
+// RouterAdvertisement is a parsed router advertisement message.
+//
+// Only the fields relevant for the routing and DNS configuration are kept.
+type RouterAdvertisement struct {
+	// Router preference, reserved value is treated as medium.
+	RouterPreference Preference
+	// Router lifetime, zero means the router is not a default router.
+	RouterLifetime time.Duration
+
+	Routes     []RouteInformation
+	DNSServers []RecursiveDNSServer
+}
+
+// RouteInformation is the route information option (RFC 4191).
+type RouteInformation struct {
+	Prefix     netip.Prefix
+	Preference Preference
+	Lifetime   time.Duration
+}
+
+// RecursiveDNSServer is the recursive DNS server option (RFC 8106).
+type RecursiveDNSServer struct {
+	Servers  []netip.Addr
+	Lifetime time.Duration
+}
+
+func lifetime(seconds uint32) time.Duration {
+	if seconds == math.MaxUint32 {
+		return InfiniteLifetime
+	}
+
+	return time.Duration(seconds) * time.Second
+}
+
+func lifetimeSeconds(d time.Duration) uint32 {
+	if d == InfiniteLifetime {
+		return math.MaxUint32
+	}
+
+	return uint32(d / time.Second)
+}
+
+// Parse the ICMPv6 router advertisement message.
+//
+// Options which are malformed or carry the reserved preference are skipped, as required by RFC 4191.
+//
+//nolint:gocyclo
+func Parse(b []byte) (*RouterAdvertisement, error) {
+	if len(b) < headerLength {
+		return nil, errors.New("router advertisement is too short")
+	}
+
+	if b[0] != icmpTypeRouterAdvertisement || b[1] != 0 {
+		return nil, fmt.Errorf("unexpected ICMPv6 type %d code %d", b[0], b[1])
+	}
+
+	ra := &RouterAdvertisement{
+		RouterLifetime: time.Duration(binary.BigEndian.Uint16(b[6:8])) * time.Second,
+	}
+
+	ra.RouterPreference, _ = preferenceFromBits(b[5] >> 3)
+
+	for options := b[headerLength:]; len(options) > 0; {
+		if len(options) < 2 {
+			return nil, errors.New("truncated option")
+		}
+
+		length := int(options[1]) * 8
+
+		if length == 0 {
+			return nil, errors.New("option with zero length")
+		}
+
+		if length > len(options) {
+			return nil, fmt.Errorf("option %d overflows the message", options[0])
+		}
+
+		option := options[:length]
+		options = options[length:]
+
+		switch option[0] {
+		case optionRouteInformation:
+			if route, ok := parseRouteInformation(option); ok {
+				ra.Routes = append(ra.Routes, route)
+			}
+		case optionRecursiveDNSServer:
+			if rdnss, ok := parseRecursiveDNSServer(option); ok {
+				ra.DNSServers = append(ra.DNSServers, rdnss)
+			}
+		}
+	}
+
+	return ra, nil
+}
+
+func parseRouteInformation(option []byte) (RouteInformation, bool) {
+	if len(option) < 8 {
+		return RouteInformation{}, false
+	}
+
+	prefixLength := int(option[2])
+
+	switch {
+	case prefixLength > 128:
+		return RouteInformation{}, false
+	case prefixLength > 64 && len(option) < 24:
+		return RouteInformation{}, false
+	case prefixLength > 0 && len(option) < 16:
+		return RouteInformation{}, false
+	}
+
+	preference, ok := preferenceFromBits(option[3] >> 3)
+	if !ok {
+		return RouteInformation{}, false
+	}
+
+	var addr [16]byte
+
+	copy(addr[:], option[8:])
+
+	return RouteInformation{
+		Prefix:     netip.PrefixFrom(netip.AddrFrom16(addr), prefixLength).Masked(),
+		Preference: preference,
+		Lifetime:   lifetime(binary.BigEndian.Uint32(option[4:8])),
+	}, true
+}
+
+func parseRecursiveDNSServer(option []byte) (RecursiveDNSServer, bool) {
+	if len(option) < 24 || (len(option)-8)%16 != 0 {
+		return RecursiveDNSServer{}, false
+	}
+
+	rdnss := RecursiveDNSServer{
+		Lifetime: lifetime(binary.BigEndian.Uint32(option[4:8])),
+	}
+
+	for servers := option[8:]; len(servers) > 0; servers = servers[16:] {
+		rdnss.Servers = append(rdnss.Servers, netip.AddrFrom16([16]byte(servers[:16])))
+	}
+
+	return rdnss, true
+}
+
+// Marshal the router advertisement into the ICMPv6 message.
+//
+// The checksum is left empty, as it is computed by the kernel.
+func (ra *RouterAdvertisement) Marshal() []byte {
+	b := make([]byte, headerLength)
+
+	b[0] = icmpTypeRouterAdvertisement
+	b[5] = ra.RouterPreference.bits() << 3
+	binary.BigEndian.PutUint16(b[6:8], uint16(min(ra.RouterLifetime/time.Second, math.MaxUint16)))
+
+	for _, route := range ra.Routes {
+		option := make([]byte, 24)
+
+		option[0] = optionRouteInformation
+		option[1] = 3
+		option[2] = byte(route.Prefix.Bits())
+		option[3] = route.Preference.bits() << 3
+		binary.BigEndian.PutUint32(option[4:8], lifetimeSeconds(route.Lifetime))
+		copy(option[8:], route.Prefix.Addr().AsSlice())
+
+		b = append(b, option...)
+	}
+
+	for _, rdnss := range ra.DNSServers {
+		option := make([]byte, 8, 8+16*len(rdnss.Servers))
+
+		option[0] = optionRecursiveDNSServer
+		option[1] = byte(1 + 2*len(rdnss.Servers))
+		binary.BigEndian.PutUint32(option[4:8], lifetimeSeconds(rdnss.Lifetime))
+
+		for _, server := range rdnss.Servers {
+			option = append(option, server.AsSlice()...)
+		}
+
+		b = append(b, option...)
+	}
+
+	return b
+}
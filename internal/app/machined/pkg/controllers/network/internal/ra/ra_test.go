@@ -0,0 +1,112 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package ra_test
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/ra"
+)
+
+func TestParseRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	adv := &ra.RouterAdvertisement{
+		RouterPreference: ra.PreferenceHigh,
+		RouterLifetime:   1800 * time.Second,
+		Routes: []ra.RouteInformation{
+			{
+				Prefix:     netip.MustParsePrefix("2001:db8:1::/48"),
+				Preference: ra.PreferenceLow,
+				Lifetime:   time.Hour,
+			},
+			{
+				Prefix:     netip.MustParsePrefix("2001:db8:2::/64"),
+				Preference: ra.PreferenceMedium,
+				Lifetime:   ra.InfiniteLifetime,
+			},
+		},
+		DNSServers: []ra.RecursiveDNSServer{
+			{
+				Servers:  []netip.Addr{netip.MustParseAddr("2001:db8::53"), netip.MustParseAddr("2001:db8::54")},
+				Lifetime: 600 * time.Second,
+			},
+		},
+	}
+
+	parsed, err := ra.Parse(adv.Marshal())
+	require.NoError(t, err)
+
+	assert.Equal(t, adv, parsed)
+}
+
+func TestParseInvalid(t *testing.T) {
+	t.Parallel()
+
+	valid := (&ra.RouterAdvertisement{RouterLifetime: time.Minute}).Marshal()
+
+	for _, test := range []struct {
+		name          string
+		packet        []byte
+		expectedError string
+	}{
+		{
+			name:          "short",
+			packet:        valid[:8],
+			expectedError: "router advertisement is too short",
+		},
+		{
+			name:          "wrong type",
+			packet:        append([]byte{133}, valid[1:]...),
+			expectedError: "unexpected ICMPv6 type 133 code 0",
+		},
+		{
+			name:          "zero length option",
+			packet:        append(valid, 24, 0, 0, 0, 0, 0, 0, 0),
+			expectedError: "option with zero length",
+		},
+		{
+			name:          "overflowing option",
+			packet:        append(valid, 25, 3, 0, 0, 0, 0, 0, 0),
+			expectedError: "option 25 overflows the message",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ra.Parse(test.packet)
+			require.EqualError(t, err, test.expectedError)
+		})
+	}
+}
+
+func TestParseReservedPreference(t *testing.T) {
+	t.Parallel()
+
+	packet := (&ra.RouterAdvertisement{
+		RouterLifetime: time.Minute,
+		Routes: []ra.RouteInformation{
+			{
+				Prefix:   netip.MustParsePrefix("2001:db8:1::/48"),
+				Lifetime: time.Hour,
+			},
+		},
+	}).Marshal()
+
+	// set reserved preference (10) for both router and route information option
+	packet[5] = 0b10 << 3
+	packet[16+3] = 0b10 << 3
+
+	parsed, err := ra.Parse(packet)
+	require.NoError(t, err)
+
+	assert.Equal(t, ra.PreferenceMedium, parsed.RouterPreference)
+	assert.Empty(t, parsed.Routes)
+}
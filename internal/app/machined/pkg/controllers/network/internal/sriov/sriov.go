@@ -0,0 +1,169 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package sriov provides access to SR-IOV attributes of PCI devices via sysfs.
+package sriov
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// VFIODriver is the name of the driver which passes the device through to userspace.
+const VFIODriver = "vfio-pci"
+
+// ErrNotSupported is returned when the device doesn't support SR-IOV.
+var ErrNotSupported = errors.New("device doesn't support SR-IOV")
+
+// Device is a SR-IOV capable PCI device (physical function).
+type Device struct {
+	path string
+}
+
+// NewDevice returns a Device for the PCI device sysfs directory, e.g. /sys/bus/pci/devices/0000:03:00.0.
+func NewDevice(path string) *Device {
+	return &Device{path: path}
+}
+
+// VF describes a virtual function of the device.
+type VF struct {
+	Index      uint32
+	PCIAddress string
+	Driver     string
+	LinkName   string
+}
+
+// TotalVFs returns the maximum number of virtual functions supported by the device.
+func (d *Device) TotalVFs() (uint32, error) {
+	v, err := d.readUint32("sriov_totalvfs")
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, ErrNotSupported
+	}
+
+	return v, err
+}
+
+// NumVFs returns the number of enabled virtual functions.
+func (d *Device) NumVFs() (uint32, error) {
+	return d.readUint32("sriov_numvfs")
+}
+
+// SetNumVFs changes the number of enabled virtual functions.
+//
+// The kernel doesn't allow changing the number of virtual functions directly from one non-zero value
+// to another, so all virtual functions are removed first, which fails if any of them is in use.
+func (d *Device) SetNumVFs(numVFs uint32) error {
+	current, err := d.NumVFs()
+	if err != nil {
+		return err
+	}
+
+	if current == numVFs {
+		return nil
+	}
+
+	if current > 0 {
+		vfs, err := d.VFs()
+		if err != nil {
+			return err
+		}
+
+		if inUse := InUse(vfs); len(inUse) > 0 {
+			return fmt.Errorf("refusing to change the number of VFs from %d to %d: VFs %s are bound to %s", current, numVFs, strings.Join(inUse, ", "), VFIODriver)
+		}
+
+		if err = d.writeUint32("sriov_numvfs", 0); err != nil {
+			return err
+		}
+	}
+
+	if numVFs == 0 {
+		return nil
+	}
+
+	return d.writeUint32("sriov_numvfs", numVFs)
+}
+
+// VFs returns the list of enabled virtual functions.
+func (d *Device) VFs() ([]VF, error) {
+	matches, err := filepath.Glob(filepath.Join(d.path, "virtfn*"))
+	if err != nil {
+		return nil, err
+	}
+
+	vfs := make([]VF, 0, len(matches))
+
+	for _, match := range matches {
+		index, err := strconv.ParseUint(strings.TrimPrefix(filepath.Base(match), "virtfn"), 10, 32)
+		if err != nil {
+			continue
+		}
+
+		target, err := os.Readlink(match)
+		if err != nil {
+			return nil, err
+		}
+
+		vf := VF{
+			Index:      uint32(index),
+			PCIAddress: filepath.Base(target),
+		}
+
+		if driver, err := os.Readlink(filepath.Join(match, "driver")); err == nil {
+			vf.Driver = filepath.Base(driver)
+		}
+
+		if entries, err := os.ReadDir(filepath.Join(match, "net")); err == nil && len(entries) > 0 {
+			vf.LinkName = entries[0].Name()
+		}
+
+		vfs = append(vfs, vf)
+	}
+
+	slices.SortFunc(vfs, func(a, b VF) int {
+		return int(a.Index) - int(b.Index)
+	})
+
+	return vfs, nil
+}
+
+// InUse returns PCI addresses of the virtual functions which are passed through to userspace.
+func InUse(vfs []VF) []string {
+	var inUse []string
+
+	for _, vf := range vfs {
+		if vf.Driver == VFIODriver {
+			inUse = append(inUse, vf.PCIAddress)
+		}
+	}
+
+	return inUse
+}
+
+func (d *Device) readUint32(name string) (uint32, error) {
+	contents, err := os.ReadFile(filepath.Join(d.path, name))
+	if err != nil {
+		return 0, err
+	}
+
+	v, err := strconv.ParseUint(strings.TrimSpace(string(contents)), 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("error parsing %s: %w", name, err)
+	}
+
+	return uint32(v), nil
+}
+
+func (d *Device) writeUint32(name string, v uint32) error {
+	if err := os.WriteFile(filepath.Join(d.path, name), []byte(strconv.FormatUint(uint64(v), 10)), 0o644); err != nil {
+		return fmt.Errorf("error writing %s: %w", name, err)
+	}
+
+	return nil
+}
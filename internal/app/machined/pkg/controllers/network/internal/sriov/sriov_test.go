@@ -0,0 +1,105 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package sriov_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/sriov"
+)
+
+// mockDevice builds a fake sysfs PCI device directory with the given VF drivers.
+func mockDevice(t *testing.T, totalVFs string, drivers ...string) string {
+	t.Helper()
+
+	root := t.TempDir()
+	pf := filepath.Join(root, "0000:03:00.0")
+
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "drivers", "ixgbevf"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "drivers", "vfio-pci"), 0o755))
+	require.NoError(t, os.MkdirAll(pf, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(pf, "sriov_totalvfs"), []byte(totalVFs+"\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(pf, "sriov_numvfs"), []byte("0\n"), 0o644))
+
+	for i, driver := range drivers {
+		vf := filepath.Join(root, "0000:03:10."+string(rune('0'+i)))
+
+		require.NoError(t, os.MkdirAll(filepath.Join(vf, "net", "enp3s16f"+string(rune('0'+i))), 0o755))
+		require.NoError(t, os.Symlink(filepath.Join(root, "drivers", driver), filepath.Join(vf, "driver")))
+		require.NoError(t, os.Symlink(vf, filepath.Join(pf, "virtfn"+string(rune('0'+i)))))
+	}
+
+	if len(drivers) > 0 {
+		require.NoError(t, os.WriteFile(filepath.Join(pf, "sriov_numvfs"), []byte{byte('0' + len(drivers)), '\n'}, 0o644))
+	}
+
+	return pf
+}
+
+func TestDevice(t *testing.T) {
+	t.Parallel()
+
+	dev := sriov.NewDevice(mockDevice(t, "8", "ixgbevf", "vfio-pci"))
+
+	totalVFs, err := dev.TotalVFs()
+	require.NoError(t, err)
+	assert.EqualValues(t, 8, totalVFs)
+
+	numVFs, err := dev.NumVFs()
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, numVFs)
+
+	vfs, err := dev.VFs()
+	require.NoError(t, err)
+
+	assert.Equal(t, []sriov.VF{
+		{
+			Index:      0,
+			PCIAddress: "0000:03:10.0",
+			Driver:     "ixgbevf",
+			LinkName:   "enp3s16f0",
+		},
+		{
+			Index:      1,
+			PCIAddress: "0000:03:10.1",
+			Driver:     "vfio-pci",
+			LinkName:   "enp3s16f1",
+		},
+	}, vfs)
+
+	assert.Equal(t, []string{"0000:03:10.1"}, sriov.InUse(vfs))
+
+	assert.EqualError(t, dev.SetNumVFs(1), "refusing to change the number of VFs from 2 to 1: VFs 0000:03:10.1 are bound to vfio-pci")
+
+	// no change is always allowed
+	assert.NoError(t, dev.SetNumVFs(2))
+}
+
+func TestDeviceSetNumVFs(t *testing.T) {
+	t.Parallel()
+
+	path := mockDevice(t, "8")
+	dev := sriov.NewDevice(path)
+
+	require.NoError(t, dev.SetNumVFs(4))
+
+	numVFs, err := dev.NumVFs()
+	require.NoError(t, err)
+	assert.EqualValues(t, 4, numVFs)
+}
+
+func TestDeviceNotSupported(t *testing.T) {
+	t.Parallel()
+
+	dev := sriov.NewDevice(t.TempDir())
+
+	_, err := dev.TotalVFs()
+	assert.ErrorIs(t, err, sriov.ErrNotSupported)
+}
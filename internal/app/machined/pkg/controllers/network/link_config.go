@@ -282,6 +282,9 @@ func (ctrl *LinkConfigController) processDevicesConfiguration(logger *zap.Logger
 	bondedLinks := map[string]ordered.Pair[string, int]{} // mapping physical interface -> bond interface
 	bridgedLinks := map[string]string{}                   // mapping physical interface -> bridge interface
 
+	// mapping (bridge interface, port interface) -> port VLAN config
+	bridgePortVLANs := map[ordered.Pair[string, string]][]talosconfig.BridgeVLANPort{}
+
 	for _, device := range devices {
 		if device.Ignore() {
 			continue
@@ -323,6 +326,12 @@ func (ctrl *LinkConfigController) processDevicesConfiguration(logger *zap.Logger
 
 				bridgedLinks[linkName] = deviceInterface
 			}
+
+			for _, port := range device.Bridge().VLAN().Ports() {
+				key := ordered.MakePair(deviceInterface, linkNameResolver.Resolve(port.Interface()))
+
+				bridgePortVLANs[key] = append(bridgePortVLANs[key], port)
+			}
 		}
 
 		if device.BridgePort() != nil {
@@ -419,6 +428,10 @@ func (ctrl *LinkConfigController) processDevicesConfiguration(logger *zap.Logger
 		}
 
 		SetBridgeSlave(linkMap[slaveName], bridgeIface)
+
+		if ports := bridgePortVLANs[ordered.MakePair(bridgeIface, slaveName)]; len(ports) > 0 {
+			SetBridgeSlaveVLANs(linkMap[slaveName], ports)
+		}
 	}
 
 	return maps.ValuesFunc(linkMap, func(link *network.LinkSpecSpec) network.LinkSpecSpec { return *link })
@@ -448,9 +461,10 @@ func wireguardLink(link *network.LinkSpecSpec, config talosconfig.WireguardConfi
 	link.Kind = network.LinkKindWireguard
 	link.Type = nethelpers.LinkNone
 	link.Wireguard = network.WireguardSpec{
-		PrivateKey:   config.PrivateKey(),
-		ListenPort:   config.ListenPort(),
-		FirewallMark: config.FirewallMark(),
+		PrivateKey:              config.PrivateKey(),
+		ListenPort:              config.ListenPort(),
+		FirewallMark:            config.FirewallMark(),
+		EndpointResolveInterval: config.EndpointResolveInterval(),
 	}
 
 	for _, peer := range config.Peers() {
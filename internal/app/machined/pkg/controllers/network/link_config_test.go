@@ -177,6 +177,14 @@ func (suite *LinkConfigSuite) TestMachineConfiguration() {
 									},
 									BridgeVLAN: &v1alpha1.BridgeVLAN{
 										BridgeVLANFiltering: pointer.To(true),
+										BridgeVLANPorts: []*v1alpha1.BridgeVLANPort{
+											{
+												BridgeVLANPortInterface: "eth4",
+												BridgeVLANPortPVID:      10,
+												BridgeVLANPortUntagged:  []uint16{40},
+												BridgeVLANPortTagged:    []uint16{30, 20},
+											},
+										},
 									},
 								},
 							},
@@ -294,6 +302,17 @@ func (suite *LinkConfigSuite) TestMachineConfiguration() {
 				asrt.True(r.TypedSpec().Up)
 				asrt.False(r.TypedSpec().Logical)
 				asrt.Equal("br0", r.TypedSpec().BridgeSlave.MasterName)
+
+				if r.TypedSpec().Name == "eth4" {
+					asrt.Equal([]network.BridgeVLANEntry{
+						{VID: 10, PVID: true, Untagged: true},
+						{VID: 20},
+						{VID: 30},
+						{VID: 40, Untagged: true},
+					}, r.TypedSpec().BridgeSlave.VLANs)
+				} else {
+					asrt.Empty(r.TypedSpec().BridgeSlave.VLANs)
+				}
 			case "eth8":
 				asrt.True(r.TypedSpec().Up)
 				asrt.False(r.TypedSpec().Logical)
@@ -41,13 +41,43 @@ func NewLinkMergeController() controller.Controller {
 
 				existing, ok := links[id]
 				if !ok {
-					links[id] = link.TypedSpec()
+					spec := link.TypedSpec().DeepCopy()
+					links[id] = &spec
 				} else if err := existing.Merge(link.TypedSpec()); err != nil {
 					logger.Warn("error merging links", zap.Error(err))
 				}
 			}
 
+			raiseParentMTU(logger, links)
+
 			return links
 		},
 	)
 }
+
+// raiseParentMTU raises the MTU of the parent links, so that it's not lower than the MTU of the child links (e.g. VLANs).
+//
+// Only parent links with the MTU set in the spec are raised here, for other parent links the MTU is checked
+// by the LinkSpecController when the MTU is applied.
+func raiseParentMTU(logger *zap.Logger, links map[string]*network.LinkSpecSpec) {
+	// links might be stacked (e.g. VLAN on top of a VLAN), so repeat until nothing changes
+	for changed := true; changed; {
+		changed = false
+
+		for _, link := range links {
+			if link.ParentName == "" || link.MTU == 0 {
+				continue
+			}
+
+			parent, ok := links[network.LinkID(link.ParentName)]
+			if !ok || parent.MTU == 0 || parent.MTU >= link.MTU {
+				continue
+			}
+
+			logger.Info("raising parent link MTU", zap.String("link", link.Name), zap.String("parent", parent.Name), zap.Uint32("mtu", link.MTU))
+
+			parent.MTU = link.MTU
+			changed = true
+		}
+	}
+}
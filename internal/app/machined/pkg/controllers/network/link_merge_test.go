@@ -129,6 +129,79 @@ func (suite *LinkMergeSuite) TestMergeLogicalLink() {
 	)
 }
 
+func (suite *LinkMergeSuite) TestMergeParentMTU() {
+	bond := network.NewLinkSpec(network.ConfigNamespaceName, "configuration/bond0")
+	*bond.TypedSpec() = network.LinkSpecSpec{
+		Name:        "bond0",
+		Logical:     true,
+		Up:          true,
+		MTU:         1500,
+		Kind:        network.LinkKindBond,
+		Type:        nethelpers.LinkEther,
+		ConfigLayer: network.ConfigMachineConfiguration,
+	}
+
+	bondVLAN := network.NewLinkSpec(network.ConfigNamespaceName, "configuration/bond0.100")
+	*bondVLAN.TypedSpec() = network.LinkSpecSpec{
+		Name:       "bond0.100",
+		Logical:    true,
+		Up:         true,
+		MTU:        9000,
+		Kind:       network.LinkKindVLAN,
+		Type:       nethelpers.LinkEther,
+		ParentName: "bond0",
+		VLAN: network.VLANSpec{
+			VID:      100,
+			Protocol: nethelpers.VLANProtocol8021Q,
+		},
+		ConfigLayer: network.ConfigMachineConfiguration,
+	}
+
+	// parent MTU is not managed
+	eth1 := network.NewLinkSpec(network.ConfigNamespaceName, "configuration/eth1")
+	*eth1.TypedSpec() = network.LinkSpecSpec{
+		Name:        "eth1",
+		Up:          true,
+		ConfigLayer: network.ConfigMachineConfiguration,
+	}
+
+	eth1VLAN := network.NewLinkSpec(network.ConfigNamespaceName, "configuration/eth1.200")
+	*eth1VLAN.TypedSpec() = network.LinkSpecSpec{
+		Name:       "eth1.200",
+		Logical:    true,
+		Up:         true,
+		MTU:        9000,
+		Kind:       network.LinkKindVLAN,
+		Type:       nethelpers.LinkEther,
+		ParentName: "eth1",
+		VLAN: network.VLANSpec{
+			VID:      200,
+			Protocol: nethelpers.VLANProtocol8021Q,
+		},
+		ConfigLayer: network.ConfigMachineConfiguration,
+	}
+
+	for _, res := range []resource.Resource{bond, bondVLAN, eth1, eth1VLAN} {
+		suite.Create(res)
+	}
+
+	suite.assertLinks(
+		[]string{
+			"bond0",
+			"bond0.100",
+			"eth1",
+			"eth1.200",
+		}, func(r *network.LinkSpec, asrt *assert.Assertions) {
+			switch r.Metadata().ID() {
+			case "bond0", "bond0.100", "eth1.200":
+				asrt.EqualValues(9000, r.TypedSpec().MTU)
+			case "eth1":
+				asrt.EqualValues(0, r.TypedSpec().MTU)
+			}
+		},
+	)
+}
+
 func (suite *LinkMergeSuite) TestMergeFlapping() {
 	// simulate two conflicting link definitions which are getting removed/added constantly
 	dhcp := network.NewLinkSpec(network.ConfigNamespaceName, "dhcp/eth0")
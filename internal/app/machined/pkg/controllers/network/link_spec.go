@@ -5,6 +5,7 @@
 package network
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
@@ -13,9 +14,11 @@ import (
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/hashicorp/go-multierror"
 	"github.com/jsimonetti/rtnetlink/v2"
 	"github.com/siderolabs/gen/pair/ordered"
+	"github.com/siderolabs/gen/xslices"
 	"github.com/siderolabs/go-pointer"
 	"go.uber.org/zap"
 	"golang.org/x/sys/unix"
@@ -83,6 +86,13 @@ func (ctrl *LinkSpecController) Run(ctx context.Context, r controller.Runtime, l
 
 	defer conn.Close() //nolint:errcheck
 
+	bridgeVLANs, err := dialBridgeVLAN()
+	if err != nil {
+		return fmt.Errorf("error dialing bridge VLAN socket: %w", err)
+	}
+
+	defer bridgeVLANs.Close() //nolint:errcheck
+
 	wgClient, err := wgctrl.New()
 	if err != nil {
 		logger.Warn("error creating wireguard client", zap.Error(err))
@@ -123,10 +133,10 @@ func (ctrl *LinkSpecController) Run(ctx context.Context, r controller.Runtime, l
 		// loop over links and make reconcile decision
 		var multiErr *multierror.Error
 
-		SortBonds(&list)
+		SortLinks(&list)
 
 		for link := range list.All() {
-			if err = ctrl.syncLink(ctx, r, logger, conn, wgClient, &links, link); err != nil {
+			if err = ctrl.syncLink(ctx, r, logger, conn, bridgeVLANs, wgClient, &links, link); err != nil {
 				multiErr = multierror.Append(multiErr, err)
 			}
 		}
@@ -139,24 +149,49 @@ func (ctrl *LinkSpecController) Run(ctx context.Context, r controller.Runtime, l
 	}
 }
 
-// SortBonds sort resources in increasing order, except it places slave interfaces right after the bond
-// in proper order.
-func SortBonds(items *safe.List[*network.LinkSpec]) {
-	items.SortFunc(func(ll, rr *network.LinkSpec) int {
-		left := ll.TypedSpec()
-		right := rr.TypedSpec()
+// SortLinks sorts resources in the order they should be created.
+//
+// Links are sorted in increasing order, except that bond slave interfaces are placed right after the bond
+// in proper order, and links which have a parent link (e.g. VLANs) are placed after the parent link (e.g. bond or bridge).
+func SortLinks(items *safe.List[*network.LinkSpec]) {
+	parents := make(map[string]string, items.Len())
+
+	for item := range items.All() {
+		if item.TypedSpec().ParentName != "" {
+			parents[item.TypedSpec().Name] = item.TypedSpec().ParentName
+		}
+	}
 
-		l := ordered.MakeTriple(left.Name, 0, "")
-		if left.BondSlave.MasterName != "" {
-			l = ordered.MakeTriple(left.BondSlave.MasterName, left.BondSlave.SlaveIndex, left.Name)
+	// depth is the number of parent links of the link which are managed via LinkSpecs
+	depth := func(name string) int {
+		var d int
+
+		for range len(parents) { // limit the depth to protect against loops
+			parent, ok := parents[name]
+			if !ok {
+				break
+			}
+
+			d++
+			name = parent
 		}
 
-		r := ordered.MakeTriple(right.Name, 0, "")
-		if right.BondSlave.MasterName != "" {
-			r = ordered.MakeTriple(right.BondSlave.MasterName, right.BondSlave.SlaveIndex, right.Name)
+		return d
+	}
+
+	key := func(spec *network.LinkSpecSpec) (int, ordered.Triple[string, int, string]) {
+		if spec.BondSlave.MasterName != "" {
+			return depth(spec.BondSlave.MasterName), ordered.MakeTriple(spec.BondSlave.MasterName, spec.BondSlave.SlaveIndex, spec.Name)
 		}
 
-		return l.Compare(r)
+		return depth(spec.Name), ordered.MakeTriple(spec.Name, 0, "")
+	}
+
+	items.SortFunc(func(ll, rr *network.LinkSpec) int {
+		lDepth, l := key(ll.TypedSpec())
+		rDepth, r := key(rr.TypedSpec())
+
+		return cmp.Or(cmp.Compare(lDepth, rDepth), l.Compare(r))
 	})
 }
 
@@ -206,13 +241,16 @@ func findLink(links []rtnetlink.LinkMessage, name string, allowAliases bool) *rt
 // For bonded links, there are two sync steps applied:
 //
 //   - bond slave interfaces are enslaved to be part of the bond (by changing MasterIndex)
-//   - bond master link settings are synced with the spec: some settings can't be applied on UP bond and a bond which has slaves,
-//     so slaves are removed and bond is brought down (these settings are going to be reconciled back in the next sync cycle)
+//   - bond master link settings are synced with the spec: only changed settings are applied, and some settings can't be applied
+//     on UP bond or a bond which has slaves, so only if required the bond is brought down and slaves are removed
+//     (these settings are going to be reconciled back in the next sync cycle)
 //
 // For wireguard links, only settings are synced with the diff generated by the WireguardSpec.
 //
+// Links which depend on other links (e.g. VLANs on top of bonds or bridges) are synced after the parent link, see SortLinks.
+//
 //nolint:gocyclo,cyclop,dupl
-func (ctrl *LinkSpecController) syncLink(ctx context.Context, r controller.Runtime, logger *zap.Logger, conn *rtnetlink.Conn, wgClient *wgctrl.Client,
+func (ctrl *LinkSpecController) syncLink(ctx context.Context, r controller.Runtime, logger *zap.Logger, conn *rtnetlink.Conn, bridgeVLANs *bridgeVLANConn, wgClient *wgctrl.Client,
 	links *[]rtnetlink.LinkMessage, link *network.LinkSpec,
 ) error {
 	logger = logger.With(zap.String("link", link.TypedSpec().Name))
@@ -390,65 +428,74 @@ func (ctrl *LinkSpecController) syncLink(ctx context.Context, r controller.Runti
 				return fmt.Errorf("error parsing bond attributes for %q: %w", link.TypedSpec().Name, err)
 			}
 
-			if existingBond != link.TypedSpec().BondMaster {
+			if !existingBond.Equal(&link.TypedSpec().BondMaster) {
 				logger.Debug("updating bond settings",
 					zap.String("old", fmt.Sprintf("%+v", existingBond)),
 					zap.String("new", fmt.Sprintf("%+v", link.TypedSpec().BondMaster)),
 				)
 
-				data, err := networkadapter.BondMasterSpec(&link.TypedSpec().BondMaster).Encode()
+				data, change, err := networkadapter.BondMasterSpec(&link.TypedSpec().BondMaster).EncodeChanges(&existingBond)
 				if err != nil {
 					return fmt.Errorf("error encoding bond attributes for %q: %w", link.TypedSpec().Name, err)
 				}
 
-				// bring bond down
-				if err = conn.Link.Set(&rtnetlink.LinkMessage{
-					Family: existing.Family,
-					Type:   existing.Type,
-					Index:  existing.Index,
-					Flags:  0,
-					Change: unix.IFF_UP,
-				}); err != nil {
-					return fmt.Errorf("error changing flags for %q: %w", link.TypedSpec().Name, err)
-				}
-
-				// unslave all slaves
-				for i, slave := range *links {
-					if slave.Attributes.Master != nil && *slave.Attributes.Master == existing.Index {
+				// no changes if the difference is only in the settings which are not applicable to the bond mode
+				if len(data) > 0 {
+					if change >= networkadapter.BondChangeDown {
+						// bring bond down
 						if err = conn.Link.Set(&rtnetlink.LinkMessage{
-							Family: slave.Family,
-							Type:   slave.Type,
-							Index:  slave.Index,
-							Attributes: &rtnetlink.LinkAttributes{
-								Master: pointer.To[uint32](0),
-							},
+							Family: existing.Family,
+							Type:   existing.Type,
+							Index:  existing.Index,
+							Flags:  0,
+							Change: unix.IFF_UP,
 						}); err != nil {
-							return fmt.Errorf("error unslaving link %q under %q: %w", slave.Attributes.Name, link.TypedSpec().BondSlave.MasterName, err)
+							return fmt.Errorf("error changing flags for %q: %w", link.TypedSpec().Name, err)
 						}
 
-						(*links)[i].Attributes.Master = nil
+						existing.Flags &^= unix.IFF_UP
 					}
-				}
 
-				// update settings
-				if err = conn.Link.Set(&rtnetlink.LinkMessage{
-					Family: existing.Family,
-					Type:   existing.Type,
-					Index:  existing.Index,
-					Attributes: &rtnetlink.LinkAttributes{
-						Info: &rtnetlink.LinkInfo{
-							Kind: existing.Attributes.Info.Kind,
-							Data: &rtnetlink.LinkData{
-								Name: existing.Attributes.Info.Kind,
-								Data: data,
+					if change >= networkadapter.BondChangeNoSlaves {
+						// unslave all slaves
+						for i, slave := range *links {
+							if slave.Attributes.Master != nil && *slave.Attributes.Master == existing.Index {
+								if err = conn.Link.Set(&rtnetlink.LinkMessage{
+									Family: slave.Family,
+									Type:   slave.Type,
+									Index:  slave.Index,
+									Attributes: &rtnetlink.LinkAttributes{
+										Master: pointer.To[uint32](0),
+									},
+								}); err != nil {
+									return fmt.Errorf("error unslaving link %q under %q: %w", slave.Attributes.Name, link.TypedSpec().BondSlave.MasterName, err)
+								}
+
+								(*links)[i].Attributes.Master = nil
+							}
+						}
+					}
+
+					// update settings
+					if err = conn.Link.Set(&rtnetlink.LinkMessage{
+						Family: existing.Family,
+						Type:   existing.Type,
+						Index:  existing.Index,
+						Attributes: &rtnetlink.LinkAttributes{
+							Info: &rtnetlink.LinkInfo{
+								Kind: existing.Attributes.Info.Kind,
+								Data: &rtnetlink.LinkData{
+									Name: existing.Attributes.Info.Kind,
+									Data: data,
+								},
 							},
 						},
-					},
-				}); err != nil {
-					return fmt.Errorf("error updating bond settings for %q: %w", link.TypedSpec().Name, err)
-				}
+					}); err != nil {
+						return fmt.Errorf("error updating bond settings for %q: %w", link.TypedSpec().Name, err)
+					}
 
-				logger.Info("updated bond settings")
+					logger.Info("updated bond settings", zap.Bool("bond_down", change >= networkadapter.BondChangeDown), zap.Bool("slaves_removed", change >= networkadapter.BondChangeNoSlaves))
+				}
 			}
 		}
 
@@ -590,22 +637,20 @@ func (ctrl *LinkSpecController) syncLink(ctx context.Context, r controller.Runti
 			logger.Debug("brought link up/down", zap.Bool("up", link.TypedSpec().Up))
 		}
 
-		// sync MTU if it's set in the spec
+		// sync MTU if it's set in the spec, the MTU is changed in place without re-creating the link
 		if link.TypedSpec().MTU != 0 && existing.Attributes.MTU != link.TypedSpec().MTU {
-			if err := conn.Link.Set(&rtnetlink.LinkMessage{
-				Family: existing.Family,
-				Type:   existing.Type,
-				Index:  existing.Index,
-				Attributes: &rtnetlink.LinkAttributes{
-					MTU: link.TypedSpec().MTU,
-				},
-			}); err != nil {
-				return fmt.Errorf("error setting MTU for %q: %w", link.TypedSpec().Name, err)
+			parentOK, err := ctrl.syncParentMTU(ctx, r, logger, conn, *links, link)
+			if err != nil {
+				return err
 			}
 
-			existing.Attributes.MTU = link.TypedSpec().MTU
+			if parentOK {
+				if err = ctrl.setMTU(conn, existing, link.TypedSpec().MTU); err != nil {
+					return fmt.Errorf("error setting MTU for %q: %w", link.TypedSpec().Name, err)
+				}
 
-			logger.Info("changed MTU for the link", zap.Uint32("mtu", link.TypedSpec().MTU))
+				logger.Info("changed MTU for the link", zap.Uint32("mtu", link.TypedSpec().MTU))
+			}
 		}
 
 		// sync master index (for links which are bridge or bond slaves)
@@ -654,7 +699,103 @@ func (ctrl *LinkSpecController) syncLink(ctx context.Context, r controller.Runti
 
 			logger.Info("enslaved/unslaved link", zap.String("parent", masterName))
 		}
+
+		// sync bridge port VLANs, if they are managed
+		if bridgeMasterName != "" && masterIndex != 0 && len(link.TypedSpec().BridgeSlave.VLANs) > 0 {
+			if err := ctrl.syncBridgeVLANs(logger, bridgeVLANs, existing.Index, link.TypedSpec().BridgeSlave.VLANs); err != nil {
+				return fmt.Errorf("error syncing bridge VLANs for %q: %w", link.TypedSpec().Name, err)
+			}
+		}
 	}
 
 	return nil
 }
+
+func (ctrl *LinkSpecController) syncBridgeVLANs(logger *zap.Logger, bridgeVLANs *bridgeVLANConn, index uint32, desired []network.BridgeVLANEntry) error {
+	existingTables, err := bridgeVLANs.List()
+	if err != nil {
+		return err
+	}
+
+	existing := existingTables[index]
+
+	// changing the flags of the VLAN is done by adding it once again
+	toAdd := xslices.Filter(desired, func(entry network.BridgeVLANEntry) bool {
+		return !slices.Contains(existing, entry)
+	})
+
+	toDelete := xslices.Filter(existing, func(entry network.BridgeVLANEntry) bool {
+		return !slices.ContainsFunc(desired, func(desiredEntry network.BridgeVLANEntry) bool { return desiredEntry.VID == entry.VID })
+	})
+
+	if len(toAdd) == 0 && len(toDelete) == 0 {
+		return nil
+	}
+
+	// add first, so that the PVID is moved before the previous PVID VLAN is deleted
+	if err = bridgeVLANs.Add(index, toAdd); err != nil {
+		return fmt.Errorf("error adding VLANs: %w", err)
+	}
+
+	if err = bridgeVLANs.Delete(index, toDelete); err != nil {
+		return fmt.Errorf("error deleting VLANs: %w", err)
+	}
+
+	logger.Info("updated bridge port VLANs", zap.Int("added", len(toAdd)), zap.Int("deleted", len(toDelete)))
+
+	return nil
+}
+
+// setMTU changes the MTU of the existing link in place.
+func (ctrl *LinkSpecController) setMTU(conn *rtnetlink.Conn, existing *rtnetlink.LinkMessage, mtu uint32) error {
+	if err := conn.Link.Set(&rtnetlink.LinkMessage{
+		Family: existing.Family,
+		Type:   existing.Type,
+		Index:  existing.Index,
+		Attributes: &rtnetlink.LinkAttributes{
+			MTU: mtu,
+		},
+	}); err != nil {
+		return err
+	}
+
+	existing.Attributes.MTU = mtu
+
+	return nil
+}
+
+// syncParentMTU makes sure the MTU of the parent link (e.g. for VLANs) is not lower than the MTU of the link.
+//
+// If the parent link is managed by Talos, the parent MTU is raised, otherwise the MTU of the link can't be applied,
+// and false is returned.
+func (ctrl *LinkSpecController) syncParentMTU(ctx context.Context, r controller.Runtime, logger *zap.Logger, conn *rtnetlink.Conn,
+	links []rtnetlink.LinkMessage, link *network.LinkSpec,
+) (bool, error) {
+	parent := findLink(links, link.TypedSpec().ParentName, true)
+	if parent == nil || parent.Attributes.MTU >= link.TypedSpec().MTU {
+		return true, nil
+	}
+
+	_, err := safe.ReaderGetByID[*network.LinkSpec](ctx, r, network.LinkID(link.TypedSpec().ParentName))
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			logger.Error("link MTU exceeds the MTU of the parent link which is not managed by Talos, skipping MTU change",
+				zap.String("parent", link.TypedSpec().ParentName),
+				zap.Uint32("mtu", link.TypedSpec().MTU),
+				zap.Uint32("parent_mtu", parent.Attributes.MTU),
+			)
+
+			return false, nil
+		}
+
+		return false, fmt.Errorf("error getting parent link spec: %w", err)
+	}
+
+	if err = ctrl.setMTU(conn, parent, link.TypedSpec().MTU); err != nil {
+		return false, fmt.Errorf("error raising MTU of the parent link %q: %w", link.TypedSpec().ParentName, err)
+	}
+
+	logger.Info("raised MTU of the parent link", zap.String("parent", link.TypedSpec().ParentName), zap.Uint32("mtu", link.TypedSpec().MTU))
+
+	return true, nil
+}
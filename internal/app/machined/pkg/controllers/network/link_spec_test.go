@@ -357,6 +357,118 @@ func (suite *LinkSpecSuite) TestVLAN() {
 	)
 }
 
+func (suite *LinkSpecSuite) TestVLANOnBond() {
+	bondName := suite.uniqueDummyInterface()
+	bond := network.NewLinkSpec(network.NamespaceName, bondName)
+	*bond.TypedSpec() = network.LinkSpecSpec{
+		Name:    bondName,
+		Type:    nethelpers.LinkEther,
+		Kind:    network.LinkKindBond,
+		Up:      true,
+		Logical: true,
+		BondMaster: network.BondMasterSpec{
+			Mode:   nethelpers.BondModeActiveBackup,
+			MIIMon: 100,
+		},
+		ConfigLayer: network.ConfigDefault,
+	}
+	networkadapter.BondMasterSpec(&bond.TypedSpec().BondMaster).FillDefaults()
+
+	dummy0Name := suite.uniqueDummyInterface()
+	dummy0 := network.NewLinkSpec(network.NamespaceName, dummy0Name)
+	*dummy0.TypedSpec() = network.LinkSpecSpec{
+		Name:    dummy0Name,
+		Type:    nethelpers.LinkEther,
+		Kind:    "dummy",
+		Up:      true,
+		Logical: true,
+		BondSlave: network.BondSlave{
+			MasterName: bondName,
+			SlaveIndex: 0,
+		},
+		ConfigLayer: network.ConfigDefault,
+	}
+
+	// VLAN name sorts before the bond name, so it should be ordered by the dependency
+	vlanName := fmt.Sprintf("avlan%02x%02x%02x", rand.Int32()&0xff, rand.Int32()&0xff, rand.Int32()&0xff)
+	vlan := network.NewLinkSpec(network.NamespaceName, vlanName)
+	*vlan.TypedSpec() = network.LinkSpecSpec{
+		Name:        vlanName,
+		Type:        nethelpers.LinkEther,
+		Kind:        network.LinkKindVLAN,
+		Up:          true,
+		Logical:     true,
+		ParentName:  bondName,
+		ConfigLayer: network.ConfigDefault,
+		VLAN: network.VLANSpec{
+			VID:      100,
+			Protocol: nethelpers.VLANProtocol8021Q,
+		},
+	}
+
+	for _, res := range []resource.Resource{vlan, dummy0, bond} {
+		suite.Require().NoError(suite.state.Create(suite.ctx, res), "%v", res.Spec())
+	}
+
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				var bondIndex uint32
+
+				if err := suite.assertInterfaces([]string{bondName}, func(r *network.LinkStatus) error {
+					bondIndex = r.TypedSpec().Index
+
+					return nil
+				}); err != nil {
+					return err
+				}
+
+				return suite.assertInterfaces(
+					[]string{dummy0Name, vlanName}, func(r *network.LinkStatus) error {
+						switch r.Metadata().ID() {
+						case dummy0Name:
+							if r.TypedSpec().MasterIndex != bondIndex {
+								return retry.ExpectedErrorf("slave is not part of the bond")
+							}
+						case vlanName:
+							suite.Assert().Equal(network.LinkKindVLAN, r.TypedSpec().Kind)
+							suite.Assert().EqualValues(100, r.TypedSpec().VLAN.VID)
+
+							if r.TypedSpec().LinkIndex != bondIndex {
+								return retry.ExpectedErrorf("vlan parent is not the bond: %d != %d", r.TypedSpec().LinkIndex, bondIndex)
+							}
+						}
+
+						return nil
+					},
+				)
+			},
+		),
+	)
+
+	// teardown the links
+	for _, r := range []resource.Resource{vlan, dummy0, bond} {
+		for {
+			ready, err := suite.state.Teardown(suite.ctx, r.Metadata())
+			suite.Require().NoError(err)
+
+			if ready {
+				break
+			}
+
+			time.Sleep(100 * time.Millisecond)
+		}
+	}
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertNoInterface(bondName)
+			},
+		),
+	)
+}
+
 //nolint:gocyclo
 func (suite *LinkSpecSuite) TestVLANViaAlias() {
 	dummyInterface := suite.uniqueDummyInterface()
@@ -1075,7 +1187,7 @@ func TestLinkSpecSuite(t *testing.T) {
 	suite.Run(t, new(LinkSpecSuite))
 }
 
-func TestSortBonds(t *testing.T) {
+func TestSortLinks(t *testing.T) {
 	expected := toResources([]network.LinkSpecSpec{
 		{
 			Name: "A",
@@ -1105,6 +1217,15 @@ func TestSortBonds(t *testing.T) {
 				MasterName: "C",
 				SlaveIndex: 2,
 			},
+		}, {
+			Name:       "0vlan",
+			ParentName: "C",
+		}, {
+			Name:       "C.10",
+			ParentName: "C",
+		}, {
+			Name:       "0vlan.20",
+			ParentName: "0vlan",
 		},
 	})
 
@@ -1118,7 +1239,7 @@ func TestSortBonds(t *testing.T) {
 		})
 
 		rnd.Shuffle(res.Len(), res.Swap)
-		netctrl.SortBonds(&res)
+		netctrl.SortLinks(&res)
 		require.Equal(t, expected, res, "failed with seed %d iteration %d", seed, i)
 	}
 }
@@ -161,6 +161,18 @@ func (ctrl *LinkStatusController) reconcile(
 		return fmt.Errorf("error listing links: %w", err)
 	}
 
+	// MTU configured via LinkSpecs, to report it next to the actual MTU of the link
+	linkSpecs, err := safe.ReaderListAll[*network.LinkSpec](ctx, r)
+	if err != nil {
+		return fmt.Errorf("error listing link specs: %w", err)
+	}
+
+	configuredMTU := map[string]uint32{}
+
+	for linkSpec := range linkSpecs.All() {
+		configuredMTU[linkSpec.TypedSpec().Name] = linkSpec.TypedSpec().MTU
+	}
+
 	// for every rtnetlink discovered link
 	for _, link := range links {
 		var (
@@ -238,6 +250,16 @@ func (ctrl *LinkStatusController) reconcile(
 			status.Type = nethelpers.LinkType(link.Type)
 			status.QueueDisc = link.Attributes.QueueDisc
 			status.MTU = link.Attributes.MTU
+			status.ConfiguredMTU = configuredMTU[link.Attributes.Name]
+
+			for _, altName := range link.Attributes.AltNames {
+				if status.ConfiguredMTU != 0 {
+					break
+				}
+
+				status.ConfiguredMTU = configuredMTU[altName]
+			}
+
 			if link.Attributes.Master != nil {
 				status.MasterIndex = *link.Attributes.Master
 			} else {
@@ -0,0 +1,348 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/lldp"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// lldpListenRetryInterval is the interval to restart the LLDP listener after a failure.
+const lldpListenRetryInterval = 10 * time.Second
+
+// LLDPController listens for LLDP frames on the links which have LLDP configured and outputs LLDPNeighborStatuses.
+//
+// The neighbor is removed once its TTL expires without a refresh, or when the neighbor announces a shutdown.
+type LLDPController struct {
+	// Listener receives LLDP frames, defaults to the raw packet socket.
+	Listener lldp.Listener
+
+	listeners map[string]*lldpListener
+	retries   map[string]time.Time
+	neighbors map[string]lldpNeighbor
+}
+
+type lldpListener struct {
+	linkIndex uint32
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func (listener *lldpListener) stop() {
+	listener.cancel()
+	listener.wg.Wait()
+}
+
+type lldpListenError struct {
+	linkName string
+	listener *lldpListener
+	err      error
+}
+
+type lldpNeighbor struct {
+	lldp.Neighbor
+
+	expires time.Time
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *LLDPController) Name() string {
+	return "network.LLDPController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *LLDPController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.ActiveID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.LinkStatusType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *LLDPController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.LLDPNeighborStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *LLDPController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.Listener == nil {
+		ctrl.Listener = lldp.SocketListener{}
+	}
+
+	ctrl.listeners = map[string]*lldpListener{}
+	ctrl.retries = map[string]time.Time{}
+	ctrl.neighbors = map[string]lldpNeighbor{}
+
+	packetCh := make(chan lldp.Packet)
+	errCh := make(chan lldpListenError)
+
+	defer func() {
+		for _, listener := range ctrl.listeners {
+			listener.stop()
+		}
+	}()
+
+	timer := time.NewTimer(time.Hour)
+	timer.Stop()
+
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case packet := <-packetCh:
+			ctrl.handlePacket(logger, packet, time.Now())
+		case ev := <-errCh:
+			if ctrl.listeners[ev.linkName] == ev.listener {
+				logger.Warn("error listening for LLDP frames", zap.String("link", ev.linkName), zap.Error(ev.err))
+
+				ev.listener.stop()
+				delete(ctrl.listeners, ev.linkName)
+
+				ctrl.retries[ev.linkName] = time.Now().Add(lldpListenRetryInterval)
+			}
+		case <-timer.C:
+		}
+
+		nextRetry, err := ctrl.reconcileListeners(ctx, r, logger, packetCh, errCh, time.Now())
+		if err != nil {
+			return err
+		}
+
+		nextExpiry, err := ctrl.reconcileOutputs(ctx, r, logger, time.Now())
+		if err != nil {
+			return err
+		}
+
+		nextWakeup := nextRetry
+
+		if nextWakeup.IsZero() || (!nextExpiry.IsZero() && nextExpiry.Before(nextWakeup)) {
+			nextWakeup = nextExpiry
+		}
+
+		if nextWakeup.IsZero() {
+			timer.Stop()
+		} else {
+			timer.Reset(time.Until(nextWakeup))
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// reconcileListeners starts and stops the listeners for the configured links, and returns the time of the next listener restart.
+//
+//nolint:gocyclo
+func (ctrl *LLDPController) reconcileListeners(
+	ctx context.Context, r controller.Runtime, logger *zap.Logger,
+	packetCh chan<- lldp.Packet, errCh chan<- lldpListenError, now time.Time,
+) (time.Time, error) {
+	cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.ActiveID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return time.Time{}, fmt.Errorf("error getting machine config: %w", err)
+	}
+
+	linkStatuses, err := safe.ReaderListAll[*network.LinkStatus](ctx, r)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("error listing link statuses: %w", err)
+	}
+
+	linkNameResolver := network.NewLinkResolver(linkStatuses.All)
+
+	linkIndexes := map[string]uint32{}
+
+	for link := range linkStatuses.All() {
+		linkIndexes[link.Metadata().ID()] = link.TypedSpec().Index
+	}
+
+	// figure out which listeners should run: LLDP is configured, and the link exists
+	shouldRun := map[string]uint32{}
+
+	if cfg != nil {
+		for _, lldpConfig := range cfg.Config().NetworkLLDPConfigs() {
+			linkName := linkNameResolver.Resolve(lldpConfig.Name())
+
+			if index, exists := linkIndexes[linkName]; exists {
+				shouldRun[linkName] = index
+			}
+		}
+	}
+
+	// stop listeners which shouldn't run, restart listeners if the link was re-created
+	for linkName, listener := range ctrl.listeners {
+		if index, exists := shouldRun[linkName]; !exists || index != listener.linkIndex {
+			logger.Debug("stopping LLDP listener", zap.String("link", linkName))
+
+			listener.stop()
+			delete(ctrl.listeners, linkName)
+		}
+	}
+
+	for linkName := range ctrl.neighbors {
+		if _, exists := shouldRun[linkName]; !exists {
+			delete(ctrl.neighbors, linkName)
+		}
+	}
+
+	var nextRetry time.Time
+
+	for linkName, index := range shouldRun {
+		if _, running := ctrl.listeners[linkName]; running {
+			continue
+		}
+
+		if retry, ok := ctrl.retries[linkName]; ok && now.Before(retry) {
+			if nextRetry.IsZero() || retry.Before(nextRetry) {
+				nextRetry = retry
+			}
+
+			continue
+		}
+
+		delete(ctrl.retries, linkName)
+
+		logger.Debug("starting LLDP listener", zap.String("link", linkName))
+
+		listenCtx, listenCancel := context.WithCancel(ctx)
+
+		listener := &lldpListener{
+			linkIndex: index,
+			cancel:    listenCancel,
+		}
+
+		listener.wg.Add(1)
+
+		go func() {
+			defer listener.wg.Done()
+
+			if err := ctrl.Listener.Listen(listenCtx, linkName, packetCh); err != nil && listenCtx.Err() == nil {
+				select {
+				case errCh <- lldpListenError{linkName: linkName, listener: listener, err: err}:
+				case <-listenCtx.Done():
+				}
+			}
+		}()
+
+		ctrl.listeners[linkName] = listener
+	}
+
+	return nextRetry, nil
+}
+
+func (ctrl *LLDPController) handlePacket(logger *zap.Logger, packet lldp.Packet, now time.Time) {
+	if _, ok := ctrl.listeners[packet.LinkName]; !ok {
+		// listener was already stopped, late packet
+		return
+	}
+
+	neighbor, err := lldp.ParseFrame(packet.Frame)
+	if err != nil {
+		logger.Debug("failed to parse LLDP frame", zap.String("link", packet.LinkName), zap.Error(err))
+
+		return
+	}
+
+	previous, known := ctrl.neighbors[packet.LinkName]
+
+	if neighbor.TTL == 0 {
+		if known {
+			logger.Info("LLDP neighbor shut down", zap.String("link", packet.LinkName), zap.String("chassis_id", neighbor.ChassisID), zap.String("port_id", neighbor.PortID))
+
+			delete(ctrl.neighbors, packet.LinkName)
+		}
+
+		return
+	}
+
+	if !known || previous.ChassisID != neighbor.ChassisID || previous.PortID != neighbor.PortID {
+		logger.Info("discovered LLDP neighbor",
+			zap.String("link", packet.LinkName),
+			zap.String("chassis_id", neighbor.ChassisID),
+			zap.String("port_id", neighbor.PortID),
+			zap.String("system_name", neighbor.SystemName),
+		)
+	}
+
+	ctrl.neighbors[packet.LinkName] = lldpNeighbor{
+		Neighbor: *neighbor,
+		expires:  now.Add(neighbor.TTL),
+	}
+}
+
+// reconcileOutputs removes the expired neighbors, updates the statuses, and returns the time of the next expiration.
+func (ctrl *LLDPController) reconcileOutputs(ctx context.Context, r controller.Runtime, logger *zap.Logger, now time.Time) (time.Time, error) {
+	var nextExpiry time.Time
+
+	r.StartTrackingOutputs()
+
+	for linkName, neighbor := range ctrl.neighbors {
+		if !now.Before(neighbor.expires) {
+			logger.Info("LLDP neighbor expired", zap.String("link", linkName), zap.String("chassis_id", neighbor.ChassisID), zap.String("port_id", neighbor.PortID))
+
+			delete(ctrl.neighbors, linkName)
+
+			continue
+		}
+
+		if nextExpiry.IsZero() || neighbor.expires.Before(nextExpiry) {
+			nextExpiry = neighbor.expires
+		}
+
+		if err := safe.WriterModify(ctx, r, network.NewLLDPNeighborStatus(network.NamespaceName, linkName), func(res *network.LLDPNeighborStatus) error {
+			spec := res.TypedSpec()
+
+			spec.LinkName = linkName
+			spec.ChassisID = neighbor.ChassisID
+			spec.PortID = neighbor.PortID
+			spec.PortDescription = neighbor.PortDescription
+			spec.SystemName = neighbor.SystemName
+			spec.SystemDescription = neighbor.SystemDescription
+			spec.PortVLANID = neighbor.PortVLANID
+			spec.TTL = neighbor.TTL
+			spec.Expires = neighbor.expires
+
+			return nil
+		}); err != nil {
+			return time.Time{}, fmt.Errorf("error modifying LLDP neighbor status: %w", err)
+		}
+	}
+
+	if err := safe.CleanupOutputs[*network.LLDPNeighborStatus](ctx, r); err != nil {
+		return time.Time{}, err
+	}
+
+	return nextExpiry, nil
+}
@@ -0,0 +1,148 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/lldp"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	networkcfg "github.com/siderolabs/talos/pkg/machinery/config/types/network"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type mockLLDPListener struct {
+	packetCh chan lldp.Packet
+}
+
+func (listener *mockLLDPListener) Listen(ctx context.Context, linkName string, packetCh chan<- lldp.Packet) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case packet := <-listener.packetCh:
+			if packet.LinkName != linkName {
+				continue
+			}
+
+			select {
+			case packetCh <- packet:
+			case <-ctx.Done():
+				return nil
+			}
+		}
+	}
+}
+
+type LLDPSuite struct {
+	ctest.DefaultSuite
+
+	listener *mockLLDPListener
+}
+
+func (suite *LLDPSuite) inject(linkName string, neighbor *lldp.Neighbor) {
+	frame := slices.Concat(
+		[]byte{0x01, 0x80, 0xc2, 0x00, 0x00, 0x0e, 0x00, 0x1b, 0x21, 0x3c, 0x4d, 0x5e, 0x88, 0xcc},
+		neighbor.Marshal(),
+	)
+
+	select {
+	case suite.listener.packetCh <- lldp.Packet{
+		LinkName: linkName,
+		Frame:    frame,
+	}:
+	case <-suite.Ctx().Done():
+		suite.FailNow("timeout injecting packet")
+	}
+}
+
+func (suite *LLDPSuite) TestNeighbors() {
+	for _, link := range []string{"eth0", "eth1"} {
+		linkStatus := network.NewLinkStatus(network.NamespaceName, link)
+		linkStatus.TypedSpec().Type = nethelpers.LinkEther
+		linkStatus.TypedSpec().Index = 1
+
+		suite.Create(linkStatus)
+	}
+
+	cfg, err := container.New(networkcfg.NewLLDPConfigV1Alpha1("eth0"))
+	suite.Require().NoError(err)
+
+	suite.Create(config.NewMachineConfig(cfg))
+
+	suite.inject("eth0", &lldp.Neighbor{
+		ChassisID:       "switch-1",
+		PortID:          "Ethernet1/12",
+		PortDescription: "rack 3, node 12",
+		SystemName:      "switch-1.example.com",
+		PortVLANID:      100,
+		TTL:             2 * time.Minute,
+	})
+
+	ctest.AssertResource(suite, "eth0", func(status *network.LLDPNeighborStatus, asrt *assert.Assertions) {
+		spec := status.TypedSpec()
+
+		asrt.Equal("eth0", spec.LinkName)
+		asrt.Equal("switch-1", spec.ChassisID)
+		asrt.Equal("Ethernet1/12", spec.PortID)
+		asrt.Equal("rack 3, node 12", spec.PortDescription)
+		asrt.Equal("switch-1.example.com", spec.SystemName)
+		asrt.EqualValues(100, spec.PortVLANID)
+		asrt.Equal(2*time.Minute, spec.TTL)
+		asrt.False(spec.Expires.IsZero())
+	})
+
+	// neighbor shuts down
+	suite.inject("eth0", &lldp.Neighbor{
+		ChassisID: "switch-1",
+		PortID:    "Ethernet1/12",
+	})
+
+	ctest.AssertNoResource[*network.LLDPNeighborStatus](suite, "eth0")
+
+	// neighbor expires
+	suite.inject("eth0", &lldp.Neighbor{
+		ChassisID: "switch-2",
+		PortID:    "Ethernet1/1",
+		TTL:       time.Second,
+	})
+
+	ctest.AssertResource(suite, "eth0", func(status *network.LLDPNeighborStatus, asrt *assert.Assertions) {
+		asrt.Equal("switch-2", status.TypedSpec().ChassisID)
+	})
+
+	ctest.AssertNoResource[*network.LLDPNeighborStatus](suite, "eth0")
+	ctest.AssertNoResource[*network.LLDPNeighborStatus](suite, "eth1")
+}
+
+func TestLLDPSuite(t *testing.T) {
+	t.Parallel()
+
+	listener := &mockLLDPListener{
+		packetCh: make(chan lldp.Packet),
+	}
+
+	suite.Run(t, &LLDPSuite{
+		listener: listener,
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 10 * time.Second,
+			AfterSetup: func(s *ctest.DefaultSuite) {
+				s.Require().NoError(s.Runtime().RegisterController(&netctrl.LLDPController{
+					Listener: listener,
+				}))
+			},
+		},
+	})
+}
@@ -6,7 +6,12 @@
 package network
 
 import (
+	"cmp"
+	"fmt"
+	"maps"
 	"net"
+	"net/netip"
+	"slices"
 
 	"github.com/siderolabs/gen/pair/ordered"
 
@@ -85,6 +90,16 @@ func SetBondMaster(link *network.LinkSpecSpec, bond talosconfig.Bond) error {
 		return err
 	}
 
+	arpIPTargets, err := parseBondTargets(bond.ARPIPTarget())
+	if err != nil {
+		return err
+	}
+
+	nsIP6Targets, err := parseBondTargets(bond.NSIP6Target())
+	if err != nil {
+		return err
+	}
+
 	link.BondMaster = network.BondMasterSpec{
 		Mode:            bondMode,
 		HashPolicy:      hashPolicy,
@@ -99,6 +114,8 @@ func SetBondMaster(link *network.LinkSpecSpec, bond talosconfig.Bond) error {
 		UpDelay:         bond.UpDelay(),
 		DownDelay:       bond.DownDelay(),
 		ARPInterval:     bond.ARPInterval(),
+		ARPIPTargets:    arpIPTargets,
+		NSIP6Targets:    nsIP6Targets,
 		ResendIGMP:      bond.ResendIGMP(),
 		MinLinks:        bond.MinLinks(),
 		LPInterval:      bond.LPInterval(),
@@ -116,6 +133,25 @@ func SetBondMaster(link *network.LinkSpecSpec, bond talosconfig.Bond) error {
 	return nil
 }
 
+func parseBondTargets(targets []string) ([]netip.Addr, error) {
+	if len(targets) == 0 {
+		return nil, nil
+	}
+
+	addrs := make([]netip.Addr, 0, len(targets))
+
+	for _, target := range targets {
+		addr, err := netip.ParseAddr(target)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing bond target %q: %w", target, err)
+		}
+
+		addrs = append(addrs, addr)
+	}
+
+	return addrs, nil
+}
+
 // SetBridgeSlave sets the bridge slave spec.
 func SetBridgeSlave(link *network.LinkSpecSpec, bridge string) {
 	link.BridgeSlave = network.BridgeSlave{
@@ -123,6 +159,38 @@ func SetBridgeSlave(link *network.LinkSpecSpec, bridge string) {
 	}
 }
 
+// SetBridgeSlaveVLANs sets the VLAN table of the bridge port from the bridge port VLAN configuration.
+func SetBridgeSlaveVLANs(link *network.LinkSpecSpec, ports []talosconfig.BridgeVLANPort) {
+	vlans := map[uint16]network.BridgeVLANEntry{}
+
+	for _, port := range ports {
+		for _, vid := range port.Tagged() {
+			vlans[vid] = network.BridgeVLANEntry{VID: vid}
+		}
+
+		for _, vid := range port.Untagged() {
+			vlans[vid] = network.BridgeVLANEntry{VID: vid, Untagged: true}
+		}
+	}
+
+	for _, port := range ports {
+		if port.PVID() == 0 {
+			continue
+		}
+
+		// PVID egresses untagged unless it's explicitly listed as tagged
+		vlans[port.PVID()] = network.BridgeVLANEntry{
+			VID:      port.PVID(),
+			PVID:     true,
+			Untagged: !slices.Contains(port.Tagged(), port.PVID()),
+		}
+	}
+
+	link.BridgeSlave.VLANs = slices.SortedFunc(maps.Values(vlans), func(a, b network.BridgeVLANEntry) int {
+		return cmp.Compare(a.VID, b.VID)
+	})
+}
+
 // SetBridgeMaster sets the bridge master spec.
 func SetBridgeMaster(link *network.LinkSpecSpec, bridge talosconfig.Bridge) error {
 	link.Logical = true
@@ -19,6 +19,7 @@ import (
 	"github.com/siderolabs/go-pointer"
 	"go.uber.org/zap"
 
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
@@ -30,6 +31,7 @@ import (
 const (
 	IngressChainName    = "ingress"
 	PreroutingChainName = "prerouting"
+	EgressChainName     = "egress"
 )
 
 // NfTablesChainConfigController generates nftables rules based on machine configuration.
@@ -99,7 +101,7 @@ func (ctrl *NfTablesChainConfigController) Run(ctx context.Context, r controller
 
 		r.StartTrackingOutputs()
 
-		if cfg != nil && !(cfg.Config().NetworkRules().DefaultAction() == nethelpers.DefaultActionAccept && cfg.Config().NetworkRules().Rules() == nil) {
+		if cfg != nil && !(cfg.Config().NetworkRules().DefaultAction() == nethelpers.DefaultActionAccept && len(firewallRules(cfg, false)) == 0) {
 			if err = safe.WriterModify(ctx, r, network.NewNfTablesChain(network.NamespaceName, IngressChainName), ctrl.buildIngressChain(cfg)); err != nil {
 				return err
 			}
@@ -111,6 +113,12 @@ func (ctrl *NfTablesChainConfigController) Run(ctx context.Context, r controller
 			}
 		}
 
+		if cfg != nil && !(cfg.Config().NetworkRules().DefaultEgressAction() == nethelpers.DefaultActionAccept && len(firewallRules(cfg, true)) == 0) {
+			if err = safe.WriterModify(ctx, r, network.NewNfTablesChain(network.NamespaceName, EgressChainName), ctrl.buildEgressChain(cfg)); err != nil {
+				return err
+			}
+		}
+
 		if err = safe.CleanupOutputs[*network.NfTablesChain](ctx, r); err != nil {
 			return err
 		}
@@ -265,14 +273,7 @@ func (ctrl *NfTablesChainConfigController) buildIngressChain(cfg *config.Machine
 			}
 		}
 
-		for _, rule := range cfg.Config().NetworkRules().Rules() {
-			portRanges := rule.PortRanges()
-
-			// sort port ranges, machine config validation ensures that there are no overlaps
-			slices.SortFunc(portRanges, func(a, b [2]uint16) int {
-				return cmp.Compare(a[0], b[0])
-			})
-
+		for _, rule := range firewallRules(cfg, false) {
 			// if default accept, drop anything that doesn't match the rule
 			verdict := nethelpers.VerdictDrop
 
@@ -282,21 +283,10 @@ func (ctrl *NfTablesChainConfigController) buildIngressChain(cfg *config.Machine
 
 			spec.Rules = append(spec.Rules,
 				network.NfTablesRule{
-					MatchSourceAddress: &network.NfTablesAddressMatch{
-						IncludeSubnets: rule.Subnets(),
-						ExcludeSubnets: rule.ExceptSubnets(),
-						Invert:         defaultAction == nethelpers.DefaultActionAccept,
-					},
-					MatchLayer4: &network.NfTablesLayer4Match{
-						Protocol: rule.Protocol(),
-						MatchDestinationPort: &network.NfTablesPortMatch{
-							Ranges: xslices.Map(portRanges, func(pr [2]uint16) network.PortRange {
-								return network.PortRange{Lo: pr[0], Hi: pr[1]}
-							}),
-						},
-					},
-					AnonCounter: true,
-					Verdict:     pointer.To(verdict),
+					MatchSourceAddress: rule.addressMatch(defaultAction == nethelpers.DefaultActionAccept),
+					MatchLayer4:        rule.layer4Match(),
+					AnonCounter:        true,
+					Verdict:            pointer.To(verdict),
 				},
 			)
 		}
@@ -352,21 +342,23 @@ func (ctrl *NfTablesChainConfigController) buildPreroutingChain(cfg *config.Mach
 			},
 		)
 
-		// drop any 'new' connections to ports outside of the allowed ranges
-		for _, rule := range cfg.Config().NetworkRules().Rules() {
-			portRanges := rule.PortRanges()
-
-			// sort port ranges, machine config validation ensures that there are no overlaps
-			slices.SortFunc(portRanges, func(a, b [2]uint16) int {
-				return cmp.Compare(a[0], b[0])
-			})
+		rules := firewallRules(cfg, false)
 
+		// drop any 'new' connections to ports outside of the allowed ranges
+		for _, rule := range rules {
 			verdict := nethelpers.VerdictDrop
 
 			if defaultAction == nethelpers.DefaultActionBlock {
 				verdict = nethelpers.VerdictAccept
 			}
 
+			sourceMatch := rule.addressMatch(defaultAction == nethelpers.DefaultActionAccept)
+
+			// with default action accept, the rule drops the traffic, so log it before dropping
+			if rule.log && defaultAction == nethelpers.DefaultActionAccept {
+				spec.Rules = append(spec.Rules, rule.logRule(sourceMatch))
+			}
+
 			spec.Rules = append(spec.Rules,
 				network.NfTablesRule{
 					MatchConntrackState: &network.NfTablesConntrackStateMatch{
@@ -374,26 +366,22 @@ func (ctrl *NfTablesChainConfigController) buildPreroutingChain(cfg *config.Mach
 							nethelpers.ConntrackStateNew,
 						},
 					},
-					MatchSourceAddress: &network.NfTablesAddressMatch{
-						IncludeSubnets: rule.Subnets(),
-						ExcludeSubnets: rule.ExceptSubnets(),
-						Invert:         defaultAction == nethelpers.DefaultActionAccept,
-					},
-					MatchLayer4: &network.NfTablesLayer4Match{
-						Protocol: rule.Protocol(),
-						MatchDestinationPort: &network.NfTablesPortMatch{
-							Ranges: xslices.Map(portRanges, func(pr [2]uint16) network.PortRange {
-								return network.PortRange{Lo: pr[0], Hi: pr[1]}
-							}),
-						},
-					},
-					AnonCounter: true,
-					Verdict:     pointer.To(verdict),
+					MatchSourceAddress: sourceMatch,
+					MatchLayer4:        rule.layer4Match(),
+					AnonCounter:        true,
+					Verdict:            pointer.To(verdict),
 				},
 			)
 		}
 
 		if defaultAction == nethelpers.DefaultActionBlock {
+			// with default action block, anything which wasn't accepted by the rules above is dropped below
+			for _, rule := range rules {
+				if rule.log {
+					spec.Rules = append(spec.Rules, rule.logRule(nil))
+				}
+			}
+
 			// drop any TCP/UDP new connections
 			spec.Rules = append(spec.Rules,
 				network.NfTablesRule{
@@ -426,3 +414,260 @@ func (ctrl *NfTablesChainConfigController) buildPreroutingChain(cfg *config.Mach
 		return nil
 	}
 }
+
+//nolint:gocyclo
+func (ctrl *NfTablesChainConfigController) buildEgressChain(cfg *config.MachineConfig) func(*network.NfTablesChain) error {
+	return func(chain *network.NfTablesChain) error {
+		spec := chain.TypedSpec()
+
+		spec.Type = nethelpers.ChainTypeFilter
+		spec.Hook = nethelpers.ChainHookOutput
+		spec.Priority = nethelpers.ChainPriorityMangle + 10
+		spec.Policy = nethelpers.VerdictAccept
+
+		// preamble
+		spec.Rules = []network.NfTablesRule{
+			// trusted interfaces: loopback, siderolink and kubespan
+			{
+				MatchOIfName: &network.NfTablesIfNameMatch{
+					InterfaceNames: []string{
+						"lo",
+						constants.SideroLinkName,
+						constants.KubeSpanLinkName,
+					},
+					Operator: nethelpers.OperatorEqual,
+				},
+				AnonCounter: true,
+				Verdict:     pointer.To(nethelpers.VerdictAccept),
+			},
+		}
+
+		defaultAction := cfg.Config().NetworkRules().DefaultEgressAction()
+
+		if defaultAction == nethelpers.DefaultActionBlock {
+			spec.Policy = nethelpers.VerdictDrop
+
+			spec.Rules = append(spec.Rules,
+				// conntrack
+				network.NfTablesRule{
+					MatchConntrackState: &network.NfTablesConntrackStateMatch{
+						States: []nethelpers.ConntrackState{
+							nethelpers.ConntrackStateEstablished,
+							nethelpers.ConntrackStateRelated,
+						},
+					},
+					AnonCounter: true,
+					Verdict:     pointer.To(nethelpers.VerdictAccept),
+				},
+				network.NfTablesRule{
+					MatchConntrackState: &network.NfTablesConntrackStateMatch{
+						States: []nethelpers.ConntrackState{
+							nethelpers.ConntrackStateInvalid,
+						},
+					},
+					AnonCounter: true,
+					Verdict:     pointer.To(nethelpers.VerdictDrop),
+				},
+				// allow ICMP and ICMPv6 explicitly
+				network.NfTablesRule{
+					MatchLayer4: &network.NfTablesLayer4Match{
+						Protocol: nethelpers.ProtocolICMP,
+					},
+					AnonCounter: true,
+					Verdict:     pointer.To(nethelpers.VerdictAccept),
+				},
+				network.NfTablesRule{
+					MatchLayer4: &network.NfTablesLayer4Match{
+						Protocol: nethelpers.ProtocolICMPv6,
+					},
+					AnonCounter: true,
+					Verdict:     pointer.To(nethelpers.VerdictAccept),
+				},
+			)
+
+			// fail-safe ports which keep the machine manageable
+			for _, selector := range cfg.Config().NetworkRules().EgressFailSafe() {
+				spec.Rules = append(spec.Rules,
+					network.NfTablesRule{
+						MatchLayer4: &network.NfTablesLayer4Match{
+							Protocol: selector.Protocol,
+							MatchDestinationPort: &network.NfTablesPortMatch{
+								Ranges: xslices.Map(selector.PortRanges, func(pr [2]uint16) network.PortRange {
+									return network.PortRange{Lo: pr[0], Hi: pr[1]}
+								}),
+							},
+						},
+						AnonCounter: true,
+						Verdict:     pointer.To(nethelpers.VerdictAccept),
+					},
+				)
+			}
+
+			if cfg.Config().Cluster() != nil {
+				spec.Rules = append(spec.Rules,
+					// allow traffic to Kubernetes pods/services
+					network.NfTablesRule{
+						MatchDestinationAddress: &network.NfTablesAddressMatch{
+							IncludeSubnets: xslices.Map(
+								slices.Concat(cfg.Config().Cluster().Network().PodCIDRs(), cfg.Config().Cluster().Network().ServiceCIDRs()),
+								netip.MustParsePrefix,
+							),
+						},
+						AnonCounter: true,
+						Verdict:     pointer.To(nethelpers.VerdictAccept),
+					},
+				)
+			}
+		}
+
+		rules := firewallRules(cfg, true)
+
+		for _, rule := range rules {
+			// if default accept, drop anything that doesn't match the rule
+			verdict := nethelpers.VerdictDrop
+
+			if defaultAction == nethelpers.DefaultActionBlock {
+				verdict = nethelpers.VerdictAccept
+			}
+
+			destinationMatch := rule.addressMatch(defaultAction == nethelpers.DefaultActionAccept)
+
+			// with default action accept, the rule drops the traffic, so log it before dropping
+			if rule.log && defaultAction == nethelpers.DefaultActionAccept {
+				spec.Rules = append(spec.Rules, rule.logRule(destinationMatch))
+			}
+
+			spec.Rules = append(spec.Rules,
+				network.NfTablesRule{
+					MatchConntrackState: &network.NfTablesConntrackStateMatch{
+						States: []nethelpers.ConntrackState{
+							nethelpers.ConntrackStateNew,
+						},
+					},
+					MatchDestinationAddress: destinationMatch,
+					MatchLayer4:             rule.layer4Match(),
+					AnonCounter:             true,
+					Verdict:                 pointer.To(verdict),
+				},
+			)
+		}
+
+		if defaultAction == nethelpers.DefaultActionBlock {
+			// with default action block, anything which wasn't accepted by the rules above is dropped by the policy
+			for _, rule := range rules {
+				if rule.log {
+					spec.Rules = append(spec.Rules, rule.logRule(nil))
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+// ruleLogPacketRatePerSecond is the rate limit for logging packets dropped by the network rules.
+const ruleLogPacketRatePerSecond = 5
+
+// firewallRule is a network rule with address sets resolved.
+type firewallRule struct {
+	name          string
+	egress        bool
+	protocol      nethelpers.Protocol
+	portRanges    []network.PortRange
+	subnets       []netip.Prefix
+	exceptSubnets []netip.Prefix
+	log           bool
+}
+
+// firewallRules returns either ingress or egress network rules from the machine configuration.
+//
+// Rules are sorted by name, so that the generated chains don't change if the order of the documents changes.
+func firewallRules(cfg *config.MachineConfig, egress bool) []firewallRule {
+	addressSets := map[string][]netip.Prefix{}
+
+	for _, addressSet := range cfg.Config().NetworkAddressSets() {
+		addressSets[addressSet.Name()] = addressSet.Subnets()
+	}
+
+	configRules := xslices.Filter(cfg.Config().NetworkRules().Rules(), func(rule talosconfig.NetworkRule) bool {
+		return rule.IsEgress() == egress
+	})
+
+	rules := xslices.Map(configRules, func(rule talosconfig.NetworkRule) firewallRule {
+		portRanges := rule.PortRanges()
+
+		// sort port ranges, machine config validation ensures that there are no overlaps
+		slices.SortFunc(portRanges, func(a, b [2]uint16) int {
+			return cmp.Compare(a[0], b[0])
+		})
+
+		subnets := slices.Clone(rule.Subnets())
+
+		// machine config validation ensures that referenced address sets exist
+		for _, name := range rule.AddressSets() {
+			subnets = append(subnets, addressSets[name]...)
+		}
+
+		return firewallRule{
+			name:     rule.Name(),
+			egress:   rule.IsEgress(),
+			protocol: rule.Protocol(),
+			portRanges: xslices.Map(portRanges, func(pr [2]uint16) network.PortRange {
+				return network.PortRange{Lo: pr[0], Hi: pr[1]}
+			}),
+			subnets:       subnets,
+			exceptSubnets: rule.ExceptSubnets(),
+			log:           rule.Log(),
+		}
+	})
+
+	slices.SortStableFunc(rules, func(a, b firewallRule) int {
+		return cmp.Compare(a.name, b.name)
+	})
+
+	return rules
+}
+
+func (rule firewallRule) layer4Match() *network.NfTablesLayer4Match {
+	return &network.NfTablesLayer4Match{
+		Protocol: rule.protocol,
+		MatchDestinationPort: &network.NfTablesPortMatch{
+			Ranges: rule.portRanges,
+		},
+	}
+}
+
+// addressMatch returns the match of the rule subnets: source for ingress, destination for egress rules.
+func (rule firewallRule) addressMatch(invert bool) *network.NfTablesAddressMatch {
+	return &network.NfTablesAddressMatch{
+		IncludeSubnets: rule.subnets,
+		ExcludeSubnets: rule.exceptSubnets,
+		Invert:         invert,
+	}
+}
+
+// logRule builds a rate-limited rule which logs 'new' connections to the rule ports.
+func (rule firewallRule) logRule(addressMatch *network.NfTablesAddressMatch) network.NfTablesRule {
+	logRule := network.NfTablesRule{
+		MatchConntrackState: &network.NfTablesConntrackStateMatch{
+			States: []nethelpers.ConntrackState{
+				nethelpers.ConntrackStateNew,
+			},
+		},
+		MatchLayer4: rule.layer4Match(),
+		MatchLimit: &network.NfTablesLimitMatch{
+			PacketRatePerSecond: ruleLogPacketRatePerSecond,
+		},
+		Log: &network.NfTablesLog{
+			Prefix: "talos-" + rule.name + ": ",
+		},
+	}
+
+	if rule.egress {
+		logRule.MatchDestinationAddress = addressMatch
+	} else {
+		logRule.MatchSourceAddress = addressMatch
+	}
+
+	return logRule
+}
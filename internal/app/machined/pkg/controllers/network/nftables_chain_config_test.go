@@ -40,11 +40,11 @@ func (suite *NfTablesChainConfigTestSuite) injectConfig(block bool) {
 	kubeletIngressCfg.PortSelector.Protocol = nethelpers.ProtocolTCP
 	kubeletIngressCfg.Ingress = []networkcfg.IngressRule{
 		{
-			Subnet: netip.MustParsePrefix("10.0.0.0/8"),
+			Subnet: networkcfg.Prefix{Prefix: netip.MustParsePrefix("10.0.0.0/8")},
 			Except: networkcfg.Prefix{Prefix: netip.MustParsePrefix("10.3.0.0/16")},
 		},
 		{
-			Subnet: netip.MustParsePrefix("192.168.0.0/16"),
+			Subnet: networkcfg.Prefix{Prefix: netip.MustParsePrefix("192.168.0.0/16")},
 		},
 	}
 
@@ -59,7 +59,7 @@ func (suite *NfTablesChainConfigTestSuite) injectConfig(block bool) {
 	apidIngressCfg.PortSelector.Protocol = nethelpers.ProtocolTCP
 	apidIngressCfg.Ingress = []networkcfg.IngressRule{
 		{
-			Subnet: netip.MustParsePrefix("0.0.0.0/0"),
+			Subnet: networkcfg.Prefix{Prefix: netip.MustParsePrefix("0.0.0.0/0")},
 		},
 	}
 
@@ -112,11 +112,7 @@ func (suite *NfTablesChainConfigTestSuite) TestDefaultAccept() {
 				{
 					MatchSourceAddress: &network.NfTablesAddressMatch{
 						IncludeSubnets: []netip.Prefix{
-							netip.MustParsePrefix("10.0.0.0/8"),
-							netip.MustParsePrefix("192.168.0.0/16"),
-						},
-						ExcludeSubnets: []netip.Prefix{
-							netip.MustParsePrefix("10.3.0.0/16"),
+							netip.MustParsePrefix("0.0.0.0/0"),
 						},
 						Invert: true,
 					},
@@ -125,8 +121,8 @@ func (suite *NfTablesChainConfigTestSuite) TestDefaultAccept() {
 						MatchDestinationPort: &network.NfTablesPortMatch{
 							Ranges: []network.PortRange{
 								{
-									Lo: 10250,
-									Hi: 10250,
+									Lo: 50000,
+									Hi: 50000,
 								},
 							},
 						},
@@ -137,7 +133,11 @@ func (suite *NfTablesChainConfigTestSuite) TestDefaultAccept() {
 				{
 					MatchSourceAddress: &network.NfTablesAddressMatch{
 						IncludeSubnets: []netip.Prefix{
-							netip.MustParsePrefix("0.0.0.0/0"),
+							netip.MustParsePrefix("10.0.0.0/8"),
+							netip.MustParsePrefix("192.168.0.0/16"),
+						},
+						ExcludeSubnets: []netip.Prefix{
+							netip.MustParsePrefix("10.3.0.0/16"),
 						},
 						Invert: true,
 					},
@@ -146,8 +146,8 @@ func (suite *NfTablesChainConfigTestSuite) TestDefaultAccept() {
 						MatchDestinationPort: &network.NfTablesPortMatch{
 							Ranges: []network.PortRange{
 								{
-									Lo: 50000,
-									Hi: 50000,
+									Lo: 10250,
+									Hi: 10250,
 								},
 							},
 						},
@@ -199,11 +199,7 @@ func (suite *NfTablesChainConfigTestSuite) TestDefaultAccept() {
 					},
 					MatchSourceAddress: &network.NfTablesAddressMatch{
 						IncludeSubnets: []netip.Prefix{
-							netip.MustParsePrefix("10.0.0.0/8"),
-							netip.MustParsePrefix("192.168.0.0/16"),
-						},
-						ExcludeSubnets: []netip.Prefix{
-							netip.MustParsePrefix("10.3.0.0/16"),
+							netip.MustParsePrefix("0.0.0.0/0"),
 						},
 						Invert: true,
 					},
@@ -212,8 +208,8 @@ func (suite *NfTablesChainConfigTestSuite) TestDefaultAccept() {
 						MatchDestinationPort: &network.NfTablesPortMatch{
 							Ranges: []network.PortRange{
 								{
-									Lo: 10250,
-									Hi: 10250,
+									Lo: 50000,
+									Hi: 50000,
 								},
 							},
 						},
@@ -229,7 +225,11 @@ func (suite *NfTablesChainConfigTestSuite) TestDefaultAccept() {
 					},
 					MatchSourceAddress: &network.NfTablesAddressMatch{
 						IncludeSubnets: []netip.Prefix{
-							netip.MustParsePrefix("0.0.0.0/0"),
+							netip.MustParsePrefix("10.0.0.0/8"),
+							netip.MustParsePrefix("192.168.0.0/16"),
+						},
+						ExcludeSubnets: []netip.Prefix{
+							netip.MustParsePrefix("10.3.0.0/16"),
 						},
 						Invert: true,
 					},
@@ -238,8 +238,8 @@ func (suite *NfTablesChainConfigTestSuite) TestDefaultAccept() {
 						MatchDestinationPort: &network.NfTablesPortMatch{
 							Ranges: []network.PortRange{
 								{
-									Lo: 50000,
-									Hi: 50000,
+									Lo: 10250,
+									Hi: 10250,
 								},
 							},
 						},
@@ -336,11 +336,7 @@ func (suite *NfTablesChainConfigTestSuite) TestDefaultBlock() {
 				{
 					MatchSourceAddress: &network.NfTablesAddressMatch{
 						IncludeSubnets: []netip.Prefix{
-							netip.MustParsePrefix("10.0.0.0/8"),
-							netip.MustParsePrefix("192.168.0.0/16"),
-						},
-						ExcludeSubnets: []netip.Prefix{
-							netip.MustParsePrefix("10.3.0.0/16"),
+							netip.MustParsePrefix("0.0.0.0/0"),
 						},
 					},
 					MatchLayer4: &network.NfTablesLayer4Match{
@@ -348,8 +344,8 @@ func (suite *NfTablesChainConfigTestSuite) TestDefaultBlock() {
 						MatchDestinationPort: &network.NfTablesPortMatch{
 							Ranges: []network.PortRange{
 								{
-									Lo: 10250,
-									Hi: 10250,
+									Lo: 50000,
+									Hi: 50000,
 								},
 							},
 						},
@@ -360,7 +356,11 @@ func (suite *NfTablesChainConfigTestSuite) TestDefaultBlock() {
 				{
 					MatchSourceAddress: &network.NfTablesAddressMatch{
 						IncludeSubnets: []netip.Prefix{
-							netip.MustParsePrefix("0.0.0.0/0"),
+							netip.MustParsePrefix("10.0.0.0/8"),
+							netip.MustParsePrefix("192.168.0.0/16"),
+						},
+						ExcludeSubnets: []netip.Prefix{
+							netip.MustParsePrefix("10.3.0.0/16"),
 						},
 					},
 					MatchLayer4: &network.NfTablesLayer4Match{
@@ -368,8 +368,8 @@ func (suite *NfTablesChainConfigTestSuite) TestDefaultBlock() {
 						MatchDestinationPort: &network.NfTablesPortMatch{
 							Ranges: []network.PortRange{
 								{
-									Lo: 50000,
-									Hi: 50000,
+									Lo: 10250,
+									Hi: 10250,
 								},
 							},
 						},
@@ -421,11 +421,7 @@ func (suite *NfTablesChainConfigTestSuite) TestDefaultBlock() {
 					},
 					MatchSourceAddress: &network.NfTablesAddressMatch{
 						IncludeSubnets: []netip.Prefix{
-							netip.MustParsePrefix("10.0.0.0/8"),
-							netip.MustParsePrefix("192.168.0.0/16"),
-						},
-						ExcludeSubnets: []netip.Prefix{
-							netip.MustParsePrefix("10.3.0.0/16"),
+							netip.MustParsePrefix("0.0.0.0/0"),
 						},
 					},
 					MatchLayer4: &network.NfTablesLayer4Match{
@@ -433,8 +429,8 @@ func (suite *NfTablesChainConfigTestSuite) TestDefaultBlock() {
 						MatchDestinationPort: &network.NfTablesPortMatch{
 							Ranges: []network.PortRange{
 								{
-									Lo: 10250,
-									Hi: 10250,
+									Lo: 50000,
+									Hi: 50000,
 								},
 							},
 						},
@@ -450,7 +446,11 @@ func (suite *NfTablesChainConfigTestSuite) TestDefaultBlock() {
 					},
 					MatchSourceAddress: &network.NfTablesAddressMatch{
 						IncludeSubnets: []netip.Prefix{
-							netip.MustParsePrefix("0.0.0.0/0"),
+							netip.MustParsePrefix("10.0.0.0/8"),
+							netip.MustParsePrefix("192.168.0.0/16"),
+						},
+						ExcludeSubnets: []netip.Prefix{
+							netip.MustParsePrefix("10.3.0.0/16"),
 						},
 					},
 					MatchLayer4: &network.NfTablesLayer4Match{
@@ -458,8 +458,8 @@ func (suite *NfTablesChainConfigTestSuite) TestDefaultBlock() {
 						MatchDestinationPort: &network.NfTablesPortMatch{
 							Ranges: []network.PortRange{
 								{
-									Lo: 50000,
-									Hi: 50000,
+									Lo: 10250,
+									Hi: 10250,
 								},
 							},
 						},
@@ -496,6 +496,257 @@ func (suite *NfTablesChainConfigTestSuite) TestDefaultBlock() {
 	})
 }
 
+func (suite *NfTablesChainConfigTestSuite) TestAddressSetLog() {
+	addressSetCfg := networkcfg.NewAddressSetV1Alpha1("office")
+	addressSetCfg.SetSubnets = []netip.Prefix{
+		netip.MustParsePrefix("192.168.10.0/24"),
+		netip.MustParsePrefix("2001:db8:10::/48"),
+	}
+
+	nodePortCfg := networkcfg.NewRuleConfigV1Alpha1()
+	nodePortCfg.MetaName = "nodeports"
+	nodePortCfg.PortSelector.Ports = []networkcfg.PortRange{
+		{
+			Lo: 30000,
+			Hi: 32767,
+		},
+	}
+	nodePortCfg.PortSelector.Protocol = nethelpers.ProtocolTCP
+	nodePortCfg.Ingress = []networkcfg.IngressRule{
+		{
+			Subnet: networkcfg.Prefix{Prefix: netip.MustParsePrefix("10.0.0.0/8")},
+		},
+		{
+			AddressSet: "office",
+		},
+	}
+	nodePortCfg.RuleLog = true
+
+	cfg, err := container.New(addressSetCfg, nodePortCfg)
+	suite.Require().NoError(err)
+
+	suite.Create(config.NewMachineConfig(cfg))
+
+	nodeAddresses := network.NewNodeAddress(network.NamespaceName, network.NodeAddressRoutedID)
+	nodeAddresses.TypedSpec().Addresses = []netip.Prefix{netip.MustParsePrefix("10.3.4.5/24")}
+	suite.Create(nodeAddresses)
+
+	sourceMatch := &network.NfTablesAddressMatch{
+		IncludeSubnets: []netip.Prefix{
+			netip.MustParsePrefix("10.0.0.0/8"),
+			netip.MustParsePrefix("192.168.10.0/24"),
+			netip.MustParsePrefix("2001:db8:10::/48"),
+		},
+		Invert: true,
+	}
+
+	layer4Match := &network.NfTablesLayer4Match{
+		Protocol: nethelpers.ProtocolTCP,
+		MatchDestinationPort: &network.NfTablesPortMatch{
+			Ranges: []network.PortRange{
+				{
+					Lo: 30000,
+					Hi: 32767,
+				},
+			},
+		},
+	}
+
+	ctest.AssertResource(suite, netctrl.PreroutingChainName, func(chain *network.NfTablesChain, asrt *assert.Assertions) {
+		spec := chain.TypedSpec()
+
+		if !asrt.Len(spec.Rules, 4) {
+			return
+		}
+
+		asrt.Equal(
+			[]network.NfTablesRule{
+				{
+					MatchConntrackState: &network.NfTablesConntrackStateMatch{
+						States: []nethelpers.ConntrackState{
+							nethelpers.ConntrackStateNew,
+						},
+					},
+					MatchSourceAddress: sourceMatch,
+					MatchLayer4:        layer4Match,
+					MatchLimit: &network.NfTablesLimitMatch{
+						PacketRatePerSecond: 5,
+					},
+					Log: &network.NfTablesLog{
+						Prefix: "talos-nodeports: ",
+					},
+				},
+				{
+					MatchConntrackState: &network.NfTablesConntrackStateMatch{
+						States: []nethelpers.ConntrackState{
+							nethelpers.ConntrackStateNew,
+						},
+					},
+					MatchSourceAddress: sourceMatch,
+					MatchLayer4:        layer4Match,
+					AnonCounter:        true,
+					Verdict:            pointer.To(nethelpers.VerdictDrop),
+				},
+			},
+			spec.Rules[2:])
+	})
+}
+
+func (suite *NfTablesChainConfigTestSuite) TestEgress() {
+	registryCfg := networkcfg.NewRuleConfigV1Alpha1()
+	registryCfg.MetaName = "registry"
+	registryCfg.PortSelector.Ports = []networkcfg.PortRange{
+		{
+			Lo: 443,
+			Hi: 443,
+		},
+	}
+	registryCfg.PortSelector.Protocol = nethelpers.ProtocolTCP
+	registryCfg.Egress = []networkcfg.EgressRule{
+		{
+			Subnet: networkcfg.Prefix{Prefix: netip.MustParsePrefix("10.0.0.0/8")},
+			Except: networkcfg.Prefix{Prefix: netip.MustParsePrefix("10.3.0.0/16")},
+		},
+	}
+	registryCfg.RuleLog = true
+
+	defaultActionCfg := networkcfg.NewDefaultActionConfigV1Alpha1()
+	defaultActionCfg.Egress = nethelpers.DefaultActionBlock
+	defaultActionCfg.EgressFailSafeConfig = []networkcfg.RulePortSelector{
+		{
+			Protocol: nethelpers.ProtocolTCP,
+			Ports: []networkcfg.PortRange{
+				{
+					Lo: 50000,
+					Hi: 50001,
+				},
+			},
+		},
+	}
+
+	cfg, err := container.New(registryCfg, defaultActionCfg)
+	suite.Require().NoError(err)
+
+	suite.Create(config.NewMachineConfig(cfg))
+
+	layer4Match := &network.NfTablesLayer4Match{
+		Protocol: nethelpers.ProtocolTCP,
+		MatchDestinationPort: &network.NfTablesPortMatch{
+			Ranges: []network.PortRange{
+				{
+					Lo: 443,
+					Hi: 443,
+				},
+			},
+		},
+	}
+
+	ctest.AssertResource(suite, netctrl.EgressChainName, func(chain *network.NfTablesChain, asrt *assert.Assertions) {
+		spec := chain.TypedSpec()
+
+		asrt.Equal(nethelpers.ChainTypeFilter, spec.Type)
+		asrt.Equal(nethelpers.ChainPriorityMangle+10, spec.Priority)
+		asrt.Equal(nethelpers.ChainHookOutput, spec.Hook)
+		asrt.Equal(nethelpers.VerdictDrop, spec.Policy)
+
+		asrt.Equal(
+			[]network.NfTablesRule{
+				{
+					MatchOIfName: &network.NfTablesIfNameMatch{
+						InterfaceNames: []string{
+							"lo",
+							constants.SideroLinkName,
+							constants.KubeSpanLinkName,
+						},
+						Operator: nethelpers.OperatorEqual,
+					},
+					AnonCounter: true,
+					Verdict:     pointer.To(nethelpers.VerdictAccept),
+				},
+				{
+					MatchConntrackState: &network.NfTablesConntrackStateMatch{
+						States: []nethelpers.ConntrackState{
+							nethelpers.ConntrackStateEstablished,
+							nethelpers.ConntrackStateRelated,
+						},
+					},
+					AnonCounter: true,
+					Verdict:     pointer.To(nethelpers.VerdictAccept),
+				},
+				{
+					MatchConntrackState: &network.NfTablesConntrackStateMatch{
+						States: []nethelpers.ConntrackState{
+							nethelpers.ConntrackStateInvalid,
+						},
+					},
+					AnonCounter: true,
+					Verdict:     pointer.To(nethelpers.VerdictDrop),
+				},
+				{
+					MatchLayer4: &network.NfTablesLayer4Match{
+						Protocol: nethelpers.ProtocolICMP,
+					},
+					AnonCounter: true,
+					Verdict:     pointer.To(nethelpers.VerdictAccept),
+				},
+				{
+					MatchLayer4: &network.NfTablesLayer4Match{
+						Protocol: nethelpers.ProtocolICMPv6,
+					},
+					AnonCounter: true,
+					Verdict:     pointer.To(nethelpers.VerdictAccept),
+				},
+				{
+					MatchLayer4: &network.NfTablesLayer4Match{
+						Protocol: nethelpers.ProtocolTCP,
+						MatchDestinationPort: &network.NfTablesPortMatch{
+							Ranges: []network.PortRange{
+								{
+									Lo: 50000,
+									Hi: 50001,
+								},
+							},
+						},
+					},
+					AnonCounter: true,
+					Verdict:     pointer.To(nethelpers.VerdictAccept),
+				},
+				{
+					MatchConntrackState: &network.NfTablesConntrackStateMatch{
+						States: []nethelpers.ConntrackState{
+							nethelpers.ConntrackStateNew,
+						},
+					},
+					MatchDestinationAddress: &network.NfTablesAddressMatch{
+						IncludeSubnets: []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")},
+						ExcludeSubnets: []netip.Prefix{netip.MustParsePrefix("10.3.0.0/16")},
+					},
+					MatchLayer4: layer4Match,
+					AnonCounter: true,
+					Verdict:     pointer.To(nethelpers.VerdictAccept),
+				},
+				{
+					MatchConntrackState: &network.NfTablesConntrackStateMatch{
+						States: []nethelpers.ConntrackState{
+							nethelpers.ConntrackStateNew,
+						},
+					},
+					MatchLayer4: layer4Match,
+					MatchLimit: &network.NfTablesLimitMatch{
+						PacketRatePerSecond: 5,
+					},
+					Log: &network.NfTablesLog{
+						Prefix: "talos-registry: ",
+					},
+				},
+			},
+			spec.Rules)
+	})
+
+	// no ingress rules and the default ingress action is accept
+	ctest.AssertNoResource[*network.NfTablesChain](suite, netctrl.IngressChainName)
+}
+
 func TestNfTablesChainConfig(t *testing.T) {
 	t.Parallel()
 
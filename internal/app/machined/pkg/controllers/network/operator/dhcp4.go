@@ -6,6 +6,7 @@ package operator
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"net"
@@ -15,6 +16,7 @@ import (
 	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/insomniacslk/dhcp/dhcpv4"
 	"github.com/insomniacslk/dhcp/dhcpv4/nclient4"
@@ -33,12 +35,24 @@ type DHCP4 struct {
 	logger *zap.Logger
 	state  state.State
 
-	linkName            string
-	routeMetric         uint32
-	skipHostnameRequest bool
-	requestMTU          bool
+	linkName                    string
+	routeMetric                 uint32
+	skipHostnameRequest         bool
+	ignoreClasslessStaticRoutes bool
+	requestMTU                  bool
+	optimisticLease             bool
+	clientIdentifier            []byte
+	skipSendHostname            bool
+	requestedOptions            []uint32
 
-	lease *nclient4.Lease
+	// hostname sent with the last request
+	sentHostname string
+
+	lease       *nclient4.Lease
+	leaseExpiry time.Time
+
+	// lease cached from the previous boot, which is confirmed via INIT-REBOOT
+	cachedLease *dhcpv4.DHCPv4
 
 	mu          sync.Mutex
 	addresses   []network.AddressSpecSpec
@@ -47,16 +61,27 @@ type DHCP4 struct {
 	hostname    []network.HostnameSpecSpec
 	resolvers   []network.ResolverSpecSpec
 	timeservers []network.TimeServerSpecSpec
+	leases      []network.DHCP4LeaseSpec
 }
 
 // NewDHCP4 creates DHCPv4 operator.
 func NewDHCP4(logger *zap.Logger, linkName string, config network.DHCP4OperatorSpec, platform runtime.Platform, state state.State) *DHCP4 {
+	clientIdentifier, err := hex.DecodeString(config.ClientIdentifier)
+	if err != nil {
+		logger.Warn("ignoring invalid DHCP client identifier", zap.Error(err), zap.String("link", linkName))
+	}
+
 	return &DHCP4{
-		logger:              logger,
-		state:               state,
-		linkName:            linkName,
-		routeMetric:         config.RouteMetric,
-		skipHostnameRequest: config.SkipHostnameRequest,
+		logger:                      logger,
+		state:                       state,
+		linkName:                    linkName,
+		routeMetric:                 config.RouteMetric,
+		skipHostnameRequest:         config.SkipHostnameRequest,
+		ignoreClasslessStaticRoutes: config.IgnoreClasslessStaticRoutes,
+		optimisticLease:             config.OptimisticLease,
+		clientIdentifier:            clientIdentifier,
+		skipSendHostname:            config.SkipSendHostname,
+		requestedOptions:            config.RequestedOptions,
 		// <3 azure
 		// When including dhcp.OptionInterfaceMTU we don't get a dhcp offer back on azure.
 		// So we'll need to explicitly exclude adding this option for azure.
@@ -107,6 +132,53 @@ func (d *DHCP4) knownHostname(hostname network.HostnameStatusSpec) bool {
 	return false
 }
 
+// loadCachedLease loads the lease cached from the previous boot, if it is still valid.
+//
+// If the optimistic lease is enabled, the network configuration of the cached lease is applied right away.
+func (d *DHCP4) loadCachedLease(ctx context.Context) bool {
+	cached, err := safe.StateGetByID[*network.DHCP4Lease](ctx, d.state, network.DHCP4LeaseCachedID(d.linkName))
+	if err != nil {
+		if !state.IsNotFoundError(err) {
+			d.logger.Warn("failed to get cached DHCP lease", zap.Error(err), zap.String("link", d.linkName))
+		}
+
+		return false
+	}
+
+	if cached.TypedSpec().Expired(time.Now()) {
+		d.logger.Debug("ignoring expired cached DHCP lease", zap.String("link", d.linkName), zap.Time("expiry", cached.TypedSpec().Expiry))
+
+		return false
+	}
+
+	// the lease is bound to the client identifier, so a new lease should be acquired if the identifier has changed
+	if cached.TypedSpec().ClientIdentifier != hex.EncodeToString(d.clientIdentifier) {
+		d.logger.Debug("ignoring cached DHCP lease acquired with a different client identifier", zap.String("link", d.linkName))
+
+		return false
+	}
+
+	ack, err := dhcpv4.FromBytes(cached.TypedSpec().ACK)
+	if err != nil {
+		d.logger.Warn("failed to parse cached DHCP lease", zap.Error(err), zap.String("link", d.linkName))
+
+		return false
+	}
+
+	d.cachedLease = ack
+
+	if !d.optimisticLease {
+		return false
+	}
+
+	d.logger.Info("applying cached DHCP lease", zap.String("link", d.linkName), zap.Stringer("address", cached.TypedSpec().Address))
+
+	d.parseNetworkConfigFromAck(ack, false)
+	d.leaseExpiry = cached.TypedSpec().Expiry
+
+	return true
+}
+
 // waitForNetworkReady waits for the network to be ready and the leased address to
 // be assigned to the associated so that unicast operations can bind successfully.
 func (d *DHCP4) waitForNetworkReady(ctx context.Context) error {
@@ -153,6 +225,13 @@ func (d *DHCP4) Run(ctx context.Context, notifyCh chan<- struct{}) {
 		d.logger.Warn("failed to watch for hostname changes", zap.Error(err))
 	}
 
+	if d.loadCachedLease(ctx) {
+		// Notify the underlying controller about the optimistically applied lease
+		if !channel.SendWithContext(ctx, notifyCh, struct{}{}) {
+			return
+		}
+	}
+
 	for {
 		// Track if we need to acquire a new lease
 		newLease := d.lease == nil
@@ -161,6 +240,23 @@ func (d *DHCP4) Run(ctx context.Context, notifyCh chan<- struct{}) {
 		leaseTime, err := d.requestRenew(ctx, hostname, uint16(time.Since(dhcpStartTime).Seconds()))
 		if err != nil && !errors.Is(err, context.Canceled) {
 			d.logger.Warn("DHCP request/renew failed", zap.Error(err), zap.String("link", d.linkName))
+
+			var nak *nclient4.ErrNak
+
+			switch {
+			case d.withdrawExpiredRoutes():
+				d.logger.Warn("DHCP lease expired, withdrawing routes", zap.String("link", d.linkName))
+
+				// Notify the underlying controller about the withdrawn routes
+				if !channel.SendWithContext(ctx, notifyCh, struct{}{}) {
+					return
+				}
+			case errors.As(err, &nak):
+				// Notify the underlying controller, as the configuration of the rejected lease might have been withdrawn
+				if !channel.SendWithContext(ctx, notifyCh, struct{}{}) {
+					return
+				}
+			}
 		}
 
 		if err == nil {
@@ -214,6 +310,11 @@ func (d *DHCP4) Run(ctx context.Context, notifyCh chan<- struct{}) {
 				oldHostname := hostname
 				hostname = extractHostname(event.Resource)
 
+				// The hostname is never sent, so there is no need to restart the sequence.
+				if d.skipSendHostname {
+					continue
+				}
+
 				d.logger.Debug("detected hostname change",
 					zap.String("old", oldHostname.FQDN()),
 					zap.String("new", hostname.FQDN()),
@@ -253,6 +354,25 @@ func (d *DHCP4) Run(ctx context.Context, notifyCh chan<- struct{}) {
 	}
 }
 
+// withdrawExpiredRoutes removes the routes received with the lease if the lease has expired.
+//
+// It returns true if any routes were withdrawn.
+func (d *DHCP4) withdrawExpiredRoutes() bool {
+	if d.leaseExpiry.IsZero() || time.Now().Before(d.leaseExpiry) {
+		return false
+	}
+
+	d.leaseExpiry = time.Time{}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	withdrawn := len(d.routes) > 0
+	d.routes = nil
+
+	return withdrawn
+}
+
 // AddressSpecs implements Operator interface.
 func (d *DHCP4) AddressSpecs() []network.AddressSpecSpec {
 	d.mu.Lock()
@@ -261,6 +381,14 @@ func (d *DHCP4) AddressSpecs() []network.AddressSpecSpec {
 	return d.addresses
 }
 
+// DHCP4LeaseSpecs implements LeaseOperator interface.
+func (d *DHCP4) DHCP4LeaseSpecs() []network.DHCP4LeaseSpec {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.leases
+}
+
 // LinkSpecs implements Operator interface.
 func (d *DHCP4) LinkSpecs() []network.LinkSpecSpec {
 	d.mu.Lock()
@@ -341,8 +469,8 @@ func (d *DHCP4) parseNetworkConfigFromAck(ack *dhcpv4.DHCPv4, useHostname bool)
 	//   a Router option, the DHCP client MUST ignore the Router option.
 	d.routes = nil
 
-	if len(ack.ClasslessStaticRoute()) > 0 {
-		for _, route := range ack.ClasslessStaticRoute() {
+	if classlessRoutes := d.classlessStaticRoutes(ack); len(classlessRoutes) > 0 {
+		for _, route := range classlessRoutes {
 			gw, _ := netipx.FromStdIP(route.Router)
 			dst, _ := netipx.FromStdIPNet(route.Dest)
 
@@ -358,6 +486,7 @@ func (d *DHCP4) parseNetworkConfigFromAck(ack *dhcpv4.DHCPv4, useHostname bool)
 				Type:        nethelpers.TypeUnicast,
 				Protocol:    nethelpers.ProtocolBoot,
 				ConfigLayer: network.ConfigOperator,
+				Origin:      network.RouteOriginDHCP4,
 			})
 		}
 	} else {
@@ -375,6 +504,7 @@ func (d *DHCP4) parseNetworkConfigFromAck(ack *dhcpv4.DHCPv4, useHostname bool)
 				Type:        nethelpers.TypeUnicast,
 				Protocol:    nethelpers.ProtocolBoot,
 				ConfigLayer: network.ConfigOperator,
+				Origin:      network.RouteOriginDHCP4,
 			})
 
 			if !addr.Contains(gw) {
@@ -390,6 +520,7 @@ func (d *DHCP4) parseNetworkConfigFromAck(ack *dhcpv4.DHCPv4, useHostname bool)
 					Type:        nethelpers.TypeUnicast,
 					Protocol:    nethelpers.ProtocolBoot,
 					ConfigLayer: network.ConfigOperator,
+					Origin:      network.RouteOriginDHCP4,
 				})
 			}
 		}
@@ -484,21 +615,84 @@ func (d *DHCP4) newClient() (*nclient4.Client, error) {
 	return nclient4.New(d.linkName, clientOpts...)
 }
 
-//nolint:gocyclo
-func (d *DHCP4) requestRenew(ctx context.Context, hostname network.HostnameStatusSpec, secs uint16) (time.Duration, error) {
+// optionMSClasslessStaticRoute is the pre-standard variant of the classless static route option used by Microsoft DHCP servers.
+const optionMSClasslessStaticRoute = dhcpv4.GenericOptionCode(249)
+
+// classlessStaticRoutes returns the classless static routes from the ACK.
+//
+// Option 121 is preferred over option 249, which is only sent by some DHCP servers.
+func (d *DHCP4) classlessStaticRoutes(ack *dhcpv4.DHCPv4) dhcpv4.Routes {
+	if d.ignoreClasslessStaticRoutes {
+		return nil
+	}
+
+	if routes := ack.ClasslessStaticRoute(); len(routes) > 0 {
+		return routes
+	}
+
+	data := ack.Options.Get(optionMSClasslessStaticRoute)
+	if len(data) == 0 {
+		return nil
+	}
+
+	var routes dhcpv4.Routes
+
+	if err := routes.FromBytes(data); err != nil {
+		d.logger.Warn("failed to parse DHCP option 249", zap.Error(err), zap.String("link", d.linkName))
+
+		return nil
+	}
+
+	return routes
+}
+
+// requestedOptionCodes returns the list of options to be requested from the DHCP server, excluding the hostname options.
+//
+// The second return value is true if the hostname should be requested.
+func (d *DHCP4) requestedOptionCodes() ([]dhcpv4.OptionCode, bool) {
+	if d.requestedOptions != nil {
+		opts := make([]dhcpv4.OptionCode, 0, len(d.requestedOptions))
+		requestHostname := false
+
+		for _, code := range d.requestedOptions {
+			switch uint8(code) {
+			case dhcpv4.OptionHostName.Code(), dhcpv4.OptionDomainName.Code():
+				// hostname options are handled separately to avoid sending and requesting the hostname at the same time
+				requestHostname = true
+			default:
+				opts = append(opts, dhcpv4.GenericOptionCode(code))
+			}
+		}
+
+		return opts, requestHostname && !d.skipHostnameRequest
+	}
+
 	opts := []dhcpv4.OptionCode{
-		dhcpv4.OptionClasslessStaticRoute,
 		dhcpv4.OptionDomainNameServer,
 		// TODO(twelho): This is unused until network.ResolverSpec supports search domains
 		dhcpv4.OptionDNSDomainSearchList,
 		dhcpv4.OptionNTPServers,
 	}
 
+	if !d.ignoreClasslessStaticRoutes {
+		opts = append(opts, dhcpv4.OptionClasslessStaticRoute, optionMSClasslessStaticRoute)
+	}
+
 	if d.requestMTU {
 		opts = append(opts, dhcpv4.OptionInterfaceMTU)
 	}
 
-	sendHostnameRequest := !d.skipHostnameRequest
+	return opts, !d.skipHostnameRequest
+}
+
+//nolint:gocyclo
+func (d *DHCP4) requestRenew(ctx context.Context, hostname network.HostnameStatusSpec, secs uint16) (time.Duration, error) {
+	if d.skipSendHostname {
+		hostname = network.HostnameStatusSpec{}
+	}
+
+	opts, sendHostnameRequest := d.requestedOptionCodes()
+
 	if hostname.Hostname != "" && !d.knownHostname(hostname) {
 		// If we are supposed to publish a hostname, don't request one from the DHCP server.
 		//
@@ -524,11 +718,19 @@ func (d *DHCP4) requestRenew(ctx context.Context, hostname network.HostnameStatu
 
 	mods := []dhcpv4.Modifier{dhcpv4.WithRequestedOptions(opts...), WithNumSecunds(secs)}
 
+	if len(d.clientIdentifier) > 0 {
+		mods = append(mods, dhcpv4.WithOption(dhcpv4.OptClientIdentifier(d.clientIdentifier)))
+	}
+
+	d.sentHostname = ""
+
 	if !sendHostnameRequest {
 		// If the node has a hostname, always send it to the DHCP
 		// server with option 12 during lease acquisition and renewal
 		if len(hostname.Hostname) > 0 {
 			mods = append(mods, dhcpv4.WithOption(dhcpv4.OptHostName(hostname.Hostname)))
+
+			d.sentHostname = hostname.FQDN()
 		}
 
 		if len(hostname.Domainname) > 0 {
@@ -553,7 +755,22 @@ func (d *DHCP4) requestRenew(ctx context.Context, hostname network.HostnameStatu
 	case d.lease != nil && d.lease.Offer != nil:
 		d.logger.Debug("DHCP REQUEST FROM OFFER", zap.String("link", d.linkName))
 		d.lease, err = client.RequestFromOffer(ctx, d.lease.Offer, mods...)
-	case len(addresses) >= 1:
+	case d.lease == nil && d.cachedLease != nil:
+		cachedLease := d.cachedLease
+
+		// the cached lease is tried only once, discovery is used next time
+		d.cachedLease = nil
+
+		d.logger.Debug("DHCP REQUEST with cached lease", zap.String("link", d.linkName), zap.Stringer("cached_ip", cachedLease.YourIPAddr))
+
+		d.lease, err = requestCachedLease(ctx, client, cachedLease, mods...)
+
+		var nak *nclient4.ErrNak
+
+		if errors.As(err, &nak) {
+			// the server doesn't honor the cached lease, so drop the configuration applied from it
+			d.clearNetworkConfig()
+		}
 		previousIPAddress := net.IP(addresses[0].Address.Addr().AsSlice())
 
 		d.logger.Debug("DHCP REQUEST with previous IP", zap.String("link", d.linkName), zap.Stringer("previous_ip", previousIPAddress))
@@ -577,7 +794,124 @@ func (d *DHCP4) requestRenew(ctx context.Context, hostname network.HostnameStatu
 
 	d.parseNetworkConfigFromAck(d.lease.ACK, sendHostnameRequest)
 
-	return d.lease.ACK.IPAddressLeaseTime(time.Minute * 30), nil
+	leaseTime := d.lease.ACK.IPAddressLeaseTime(time.Minute * 30)
+	d.leaseExpiry = time.Now().Add(leaseTime)
+
+	d.updateLeaseSpec(leaseTime)
+
+	return leaseTime, nil
+}
+
+// updateLeaseSpec records the current lease to be persisted across reboots.
+func (d *DHCP4) updateLeaseSpec(leaseTime time.Duration) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if len(d.addresses) == 0 {
+		d.leases = nil
+
+		return
+	}
+
+	serverID, _ := netipx.FromStdIP(d.lease.ACK.ServerIdentifier())
+
+	d.leases = []network.DHCP4LeaseSpec{
+		{
+			LinkName:  d.linkName,
+			Address:   d.addresses[0].Address,
+			ServerID:  serverID,
+			LeaseTime: leaseTime,
+			Expiry:    d.leaseExpiry,
+			ACK:       d.lease.ACK.ToBytes(),
+
+			ClientIdentifier: hex.EncodeToString(d.clientIdentifier),
+			Hostname:         d.sentHostname,
+		},
+	}
+}
+
+// Release implements ReleaseOperator interface.
+//
+// The DHCP server is notified that the current lease is no longer used.
+func (d *DHCP4) Release() error {
+	if d.lease == nil {
+		return nil
+	}
+
+	client, err := d.newClient()
+	if err != nil {
+		return err
+	}
+
+	//nolint:errcheck
+	defer client.Close()
+
+	var mods []dhcpv4.Modifier
+
+	if len(d.clientIdentifier) > 0 {
+		mods = append(mods, dhcpv4.WithOption(dhcpv4.OptClientIdentifier(d.clientIdentifier)))
+	}
+
+	d.logger.Debug("DHCP RELEASE", zap.String("link", d.linkName), zap.Stringer("address", d.lease.ACK.YourIPAddr))
+
+	if err = client.Release(d.lease, mods...); err != nil {
+		return fmt.Errorf("error releasing DHCP lease: %w", err)
+	}
+
+	d.lease = nil
+
+	return nil
+}
+
+// clearNetworkConfig drops the network configuration received with the lease.
+func (d *DHCP4) clearNetworkConfig() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.addresses = nil
+	d.links = nil
+	d.routes = nil
+	d.resolvers = nil
+	d.timeservers = nil
+	d.leases = nil
+	d.leaseExpiry = time.Time{}
+}
+
+// requestCachedLease requests the lease cached from the previous boot to be confirmed by the DHCP server.
+//
+// RFC 2131, section 4.3.2:
+//
+//	DHCPREQUEST generated during INIT-REBOOT state:
+//	'server identifier' MUST NOT be filled in, 'requested IP address'
+//	option MUST be filled in with client's notion of its previously
+//	assigned address. 'ciaddr' MUST be zero.
+func requestCachedLease(ctx context.Context, client *nclient4.Client, cached *dhcpv4.DHCPv4, mods ...dhcpv4.Modifier) (*nclient4.Lease, error) {
+	request, err := dhcpv4.New(dhcpv4.PrependModifiers(mods,
+		dhcpv4.WithMessageType(dhcpv4.MessageTypeRequest),
+		dhcpv4.WithHwAddr(client.InterfaceAddr()),
+		dhcpv4.WithBroadcast(true),
+		dhcpv4.WithOption(dhcpv4.OptRequestedIPAddress(cached.YourIPAddr)),
+	)...)
+	if err != nil {
+		return nil, fmt.Errorf("error building DHCP request: %w", err)
+	}
+
+	response, err := client.SendAndRead(ctx, client.RemoteAddr(), request, nclient4.IsMessageType(dhcpv4.MessageTypeAck, dhcpv4.MessageTypeNak))
+	if err != nil {
+		return nil, fmt.Errorf("got an error while processing the cached lease request: %w", err)
+	}
+
+	if response.MessageType() == dhcpv4.MessageTypeNak {
+		return nil, &nclient4.ErrNak{
+			Offer: request,
+			Nak:   response,
+		}
+	}
+
+	return &nclient4.Lease{
+		ACK:          response,
+		CreationTime: time.Now(),
+	}, nil
 }
 
 func collapseSummary(summary string) string {
@@ -0,0 +1,379 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package operator_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	"github.com/insomniacslk/dhcp/dhcpv4/nclient4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/operator"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/platform/metal"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// mockDHCPServer honors the requests for the leased address, and rejects any other address.
+func mockDHCPServer(t *testing.T, leasedIP net.IP) *net.UDPAddr {
+	t.Helper()
+
+	conn, err := net.ListenPacket("udp4", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	t.Cleanup(func() { conn.Close() }) //nolint:errcheck
+
+	serverID := net.IPv4(10, 0, 0, 1)
+
+	go func() {
+		buf := make([]byte, 1500)
+
+		for {
+			n, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+
+			request, err := dhcpv4.FromBytes(buf[:n])
+			if err != nil || request.MessageType() != dhcpv4.MessageTypeRequest {
+				continue
+			}
+
+			// INIT-REBOOT request must not target a specific server
+			assert.Nil(t, request.ServerIdentifier())
+			assert.True(t, request.ClientIPAddr.IsUnspecified())
+
+			var reply *dhcpv4.DHCPv4
+
+			if request.RequestedIPAddress().Equal(leasedIP) {
+				reply, err = dhcpv4.NewReplyFromRequest(request,
+					dhcpv4.WithMessageType(dhcpv4.MessageTypeAck),
+					dhcpv4.WithYourIP(leasedIP),
+					dhcpv4.WithServerIP(serverID),
+					dhcpv4.WithOption(dhcpv4.OptServerIdentifier(serverID)),
+					dhcpv4.WithOption(dhcpv4.OptSubnetMask(net.CIDRMask(24, 32))),
+					dhcpv4.WithOption(dhcpv4.OptIPAddressLeaseTime(time.Hour)),
+				)
+			} else {
+				reply, err = dhcpv4.NewReplyFromRequest(request,
+					dhcpv4.WithMessageType(dhcpv4.MessageTypeNak),
+					dhcpv4.WithOption(dhcpv4.OptServerIdentifier(serverID)),
+				)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if _, err = conn.WriteTo(reply.ToBytes(), addr); err != nil {
+				return
+			}
+		}
+	}()
+
+	return conn.LocalAddr().(*net.UDPAddr) //nolint:forcetypeassert
+}
+
+func newTestClient(t *testing.T, serverAddr *net.UDPAddr) *nclient4.Client {
+	t.Helper()
+
+	conn, err := net.ListenPacket("udp4", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	client, err := nclient4.NewWithConn(conn, net.HardwareAddr{0x02, 0x00, 0x00, 0x00, 0x00, 0x01},
+		nclient4.WithServerAddr(serverAddr),
+		nclient4.WithTimeout(time.Second),
+		nclient4.WithRetry(1),
+	)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { client.Close() }) //nolint:errcheck
+
+	return client
+}
+
+func cachedAck(t *testing.T, ip net.IP) *dhcpv4.DHCPv4 {
+	t.Helper()
+
+	ack, err := dhcpv4.New(
+		dhcpv4.WithMessageType(dhcpv4.MessageTypeAck),
+		dhcpv4.WithYourIP(ip),
+	)
+	require.NoError(t, err)
+
+	// simulate the reboot by going through the serialized form, as the lease is cached in STATE
+	ack, err = dhcpv4.FromBytes(ack.ToBytes())
+	require.NoError(t, err)
+
+	return ack
+}
+
+func TestRequestCachedLease(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	leasedIP := net.IPv4(10, 0, 0, 5).To4()
+	serverAddr := mockDHCPServer(t, leasedIP)
+
+	t.Run("honored", func(t *testing.T) {
+		t.Parallel()
+
+		lease, err := operator.RequestCachedLease(ctx, newTestClient(t, serverAddr), cachedAck(t, leasedIP))
+		require.NoError(t, err)
+
+		assert.Equal(t, dhcpv4.MessageTypeAck, lease.ACK.MessageType())
+		assert.True(t, lease.ACK.YourIPAddr.Equal(leasedIP))
+		assert.Equal(t, time.Hour, lease.ACK.IPAddressLeaseTime(0))
+	})
+
+	t.Run("rejected", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := operator.RequestCachedLease(ctx, newTestClient(t, serverAddr), cachedAck(t, net.IPv4(10, 0, 0, 6)))
+		require.Error(t, err)
+
+		var nak *nclient4.ErrNak
+
+		assert.True(t, errors.As(err, &nak))
+	})
+}
+
+func leaseAck(t *testing.T, opts ...dhcpv4.Modifier) *dhcpv4.DHCPv4 {
+	t.Helper()
+
+	ack, err := dhcpv4.New(append([]dhcpv4.Modifier{
+		dhcpv4.WithMessageType(dhcpv4.MessageTypeAck),
+		dhcpv4.WithYourIP(net.IPv4(10, 0, 0, 5)),
+		dhcpv4.WithOption(dhcpv4.OptSubnetMask(net.CIDRMask(24, 32))),
+	}, opts...)...)
+	require.NoError(t, err)
+
+	// go through the serialized form, as the options are parsed from the wire
+	ack, err = dhcpv4.FromBytes(ack.ToBytes())
+	require.NoError(t, err)
+
+	return ack
+}
+
+func classlessRoutes(t *testing.T, routes ...string) dhcpv4.Routes {
+	t.Helper()
+
+	result := make(dhcpv4.Routes, 0, len(routes))
+
+	for i := 0; i < len(routes); i += 2 {
+		_, dest, err := net.ParseCIDR(routes[i])
+		require.NoError(t, err)
+
+		result = append(result, &dhcpv4.Route{
+			Dest:   dest,
+			Router: net.ParseIP(routes[i+1]).To4(),
+		})
+	}
+
+	return result
+}
+
+func routeStrings(routes []network.RouteSpecSpec) []string {
+	result := make([]string, 0, len(routes))
+
+	for _, route := range routes {
+		destination, gateway := "default", "link"
+
+		if route.Destination.IsValid() {
+			destination = route.Destination.String()
+		}
+
+		if route.Gateway.IsValid() {
+			gateway = route.Gateway.String()
+		}
+
+		result = append(result, destination+" via "+gateway)
+	}
+
+	return result
+}
+
+func TestDHCP4Routes(t *testing.T) {
+	t.Parallel()
+
+	optionMSClasslessStaticRoute := dhcpv4.GenericOptionCode(249)
+
+	for _, test := range []struct {
+		name         string
+		opts         func(t *testing.T) []dhcpv4.Modifier
+		ignoreRoutes bool
+
+		expected []string
+	}{
+		{
+			name: "router",
+			opts: func(*testing.T) []dhcpv4.Modifier {
+				return []dhcpv4.Modifier{
+					dhcpv4.WithOption(dhcpv4.OptRouter(net.IPv4(10, 0, 0, 1))),
+				}
+			},
+			expected: []string{"default via 10.0.0.1"},
+		},
+		{
+			name: "router outside of the subnet",
+			opts: func(*testing.T) []dhcpv4.Modifier {
+				return []dhcpv4.Modifier{
+					dhcpv4.WithOption(dhcpv4.OptRouter(net.IPv4(192, 168, 0, 1))),
+				}
+			},
+			expected: []string{"default via 192.168.0.1", "192.168.0.1/32 via link"},
+		},
+		{
+			name: "option 121",
+			opts: func(t *testing.T) []dhcpv4.Modifier {
+				return []dhcpv4.Modifier{
+					dhcpv4.WithOption(dhcpv4.OptClasslessStaticRoute(classlessRoutes(t,
+						"0.0.0.0/0", "10.0.0.1",
+						"172.16.0.0/12", "10.0.0.254",
+					)...)),
+				}
+			},
+			expected: []string{"default via 10.0.0.1", "172.16.0.0/12 via 10.0.0.254"},
+		},
+		{
+			name: "option 249",
+			opts: func(t *testing.T) []dhcpv4.Modifier {
+				return []dhcpv4.Modifier{
+					dhcpv4.WithOption(dhcpv4.OptGeneric(optionMSClasslessStaticRoute, classlessRoutes(t,
+						"0.0.0.0/0", "10.0.0.2",
+						"192.168.100.0/24", "10.0.0.253",
+					).ToBytes())),
+				}
+			},
+			expected: []string{"default via 10.0.0.2", "192.168.100.0/24 via 10.0.0.253"},
+		},
+		{
+			name: "option 121 preferred over option 249",
+			opts: func(t *testing.T) []dhcpv4.Modifier {
+				return []dhcpv4.Modifier{
+					dhcpv4.WithOption(dhcpv4.OptClasslessStaticRoute(classlessRoutes(t, "0.0.0.0/0", "10.0.0.1")...)),
+					dhcpv4.WithOption(dhcpv4.OptGeneric(optionMSClasslessStaticRoute, classlessRoutes(t, "0.0.0.0/0", "10.0.0.2").ToBytes())),
+				}
+			},
+			expected: []string{"default via 10.0.0.1"},
+		},
+		{
+			name: "option 121 preferred over router (RFC 3442)",
+			opts: func(t *testing.T) []dhcpv4.Modifier {
+				return []dhcpv4.Modifier{
+					dhcpv4.WithOption(dhcpv4.OptRouter(net.IPv4(10, 0, 0, 3))),
+					dhcpv4.WithOption(dhcpv4.OptClasslessStaticRoute(classlessRoutes(t, "172.16.0.0/12", "10.0.0.254")...)),
+				}
+			},
+			expected: []string{"172.16.0.0/12 via 10.0.0.254"},
+		},
+		{
+			name: "option 249 preferred over router",
+			opts: func(t *testing.T) []dhcpv4.Modifier {
+				return []dhcpv4.Modifier{
+					dhcpv4.WithOption(dhcpv4.OptRouter(net.IPv4(10, 0, 0, 3))),
+					dhcpv4.WithOption(dhcpv4.OptGeneric(optionMSClasslessStaticRoute, classlessRoutes(t, "172.16.0.0/12", "10.0.0.254").ToBytes())),
+				}
+			},
+			expected: []string{"172.16.0.0/12 via 10.0.0.254"},
+		},
+		{
+			name: "invalid option 249",
+			opts: func(*testing.T) []dhcpv4.Modifier {
+				return []dhcpv4.Modifier{
+					dhcpv4.WithOption(dhcpv4.OptRouter(net.IPv4(10, 0, 0, 3))),
+					dhcpv4.WithOption(dhcpv4.OptGeneric(optionMSClasslessStaticRoute, []byte{33, 10, 0})),
+				}
+			},
+			expected: []string{"default via 10.0.0.3"},
+		},
+		{
+			name: "classless routes ignored",
+			opts: func(t *testing.T) []dhcpv4.Modifier {
+				return []dhcpv4.Modifier{
+					dhcpv4.WithOption(dhcpv4.OptRouter(net.IPv4(10, 0, 0, 3))),
+					dhcpv4.WithOption(dhcpv4.OptClasslessStaticRoute(classlessRoutes(t, "172.16.0.0/12", "10.0.0.254")...)),
+				}
+			},
+			ignoreRoutes: true,
+			expected:     []string{"default via 10.0.0.3"},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			dhcp := operator.NewDHCP4(zaptest.NewLogger(t), "eth0", network.DHCP4OperatorSpec{
+				RouteMetric:                 1024,
+				IgnoreClasslessStaticRoutes: test.ignoreRoutes,
+			}, &metal.Metal{}, nil)
+
+			operator.ParseNetworkConfigFromAck(dhcp, leaseAck(t, test.opts(t)...), false)
+
+			routes := dhcp.RouteSpecs()
+
+			assert.Equal(t, test.expected, routeStrings(routes))
+
+			for _, route := range routes {
+				assert.Equal(t, "eth0", route.OutLinkName)
+				assert.EqualValues(t, 1024, route.Priority)
+				assert.Equal(t, network.RouteOriginDHCP4, route.Origin)
+			}
+		})
+	}
+}
+
+func TestDHCP4RoutesWithdrawn(t *testing.T) {
+	t.Parallel()
+
+	dhcp := operator.NewDHCP4(zaptest.NewLogger(t), "eth0", network.DHCP4OperatorSpec{}, &metal.Metal{}, nil)
+
+	operator.ParseNetworkConfigFromAck(dhcp, leaseAck(t,
+		dhcpv4.WithOption(dhcpv4.OptClasslessStaticRoute(classlessRoutes(t,
+			"0.0.0.0/0", "10.0.0.1",
+			"172.16.0.0/12", "10.0.0.254",
+		)...)),
+	), false)
+
+	assert.Equal(t, []string{"default via 10.0.0.1", "172.16.0.0/12 via 10.0.0.254"}, routeStrings(dhcp.RouteSpecs()))
+
+	// the new lease doesn't have the classless routes anymore, the routes are replaced
+	operator.ParseNetworkConfigFromAck(dhcp, leaseAck(t,
+		dhcpv4.WithOption(dhcpv4.OptRouter(net.IPv4(10, 0, 0, 3))),
+	), false)
+
+	assert.Equal(t, []string{"default via 10.0.0.3"}, routeStrings(dhcp.RouteSpecs()))
+
+	// the new lease has no routes at all, all routes are withdrawn
+	operator.ParseNetworkConfigFromAck(dhcp, leaseAck(t), false)
+
+	assert.Empty(t, dhcp.RouteSpecs())
+
+	operator.ParseNetworkConfigFromAck(dhcp, leaseAck(t,
+		dhcpv4.WithOption(dhcpv4.OptRouter(net.IPv4(10, 0, 0, 3))),
+	), false)
+
+	// the lease is still valid
+	dhcp.SetLeaseExpiry(time.Now().Add(time.Hour))
+
+	assert.False(t, operator.WithdrawExpiredRoutes(dhcp))
+	assert.Equal(t, []string{"default via 10.0.0.3"}, routeStrings(dhcp.RouteSpecs()))
+
+	// the lease expired without a renewal, the routes are withdrawn
+	dhcp.SetLeaseExpiry(time.Now().Add(-time.Second))
+
+	assert.True(t, operator.WithdrawExpiredRoutes(dhcp))
+	assert.Empty(t, dhcp.RouteSpecs())
+
+	// nothing to withdraw anymore
+	assert.False(t, operator.WithdrawExpiredRoutes(dhcp))
+}
@@ -6,6 +6,7 @@ package operator
 
 import (
 	"context"
+	"encoding/binary"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -34,6 +35,7 @@ type DHCP6 struct {
 
 	linkName            string
 	duid                []byte
+	iaid                uint32
 	skipHostnameRequest bool
 
 	mu          sync.Mutex
@@ -51,6 +53,7 @@ func NewDHCP6(logger *zap.Logger, linkName string, config network.DHCP6OperatorS
 		logger:              logger,
 		linkName:            linkName,
 		duid:                duidBin,
+		iaid:                config.IAID,
 		skipHostnameRequest: config.SkipHostnameRequest,
 	}
 }
@@ -237,10 +240,18 @@ func (d *DHCP6) renew(ctx context.Context) (time.Duration, error) {
 		if derr != nil {
 			d.logger.Error("failed to parse DUID, ignored", zap.String("link", d.linkName))
 		} else {
-			modifiers = []dhcpv6.Modifier{dhcpv6.WithClientID(duid)}
+			modifiers = append(modifiers, dhcpv6.WithClientID(duid))
 		}
 	}
 
+	if d.iaid != 0 {
+		var iaid [4]byte
+
+		binary.BigEndian.PutUint32(iaid[:], d.iaid)
+
+		modifiers = append(modifiers, dhcpv6.WithIAID(iaid))
+	}
+
 	reply, err := cli.RapidSolicit(ctx, modifiers...)
 	if err != nil {
 		return 0, err
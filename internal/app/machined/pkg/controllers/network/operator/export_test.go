@@ -0,0 +1,30 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package operator
+
+import "time"
+
+// RequestCachedLease is exported for testing.
+var RequestCachedLease = requestCachedLease
+
+// VIPCandidate is exported for testing.
+type VIPCandidate = vipCandidate
+
+// ShouldDeferVIP is exported for testing.
+var ShouldDeferVIP = shouldDeferVIP
+
+// ShouldYieldVIP is exported for testing.
+var ShouldYieldVIP = shouldYieldVIP
+
+// ParseNetworkConfigFromAck is exported for testing.
+var ParseNetworkConfigFromAck = (*DHCP4).parseNetworkConfigFromAck
+
+// WithdrawExpiredRoutes is exported for testing.
+var WithdrawExpiredRoutes = (*DHCP4).withdrawExpiredRoutes
+
+// SetLeaseExpiry is exported for testing.
+func (d *DHCP4) SetLeaseExpiry(expiry time.Time) {
+	d.leaseExpiry = expiry
+}
@@ -25,3 +25,25 @@ type Operator interface {
 	ResolverSpecs() []network.ResolverSpecSpec
 	TimeServerSpecs() []network.TimeServerSpecSpec
 }
+
+// LeaseOperator is implemented by the operators which hold leases to be persisted across reboots.
+type LeaseOperator interface {
+	DHCP4LeaseSpecs() []network.DHCP4LeaseSpec
+}
+
+// ReleaseOperator is implemented by the operators which can release the leases they hold.
+//
+// Release is called after the operator is stopped.
+type ReleaseOperator interface {
+	Release() error
+}
+
+// VIPStatusOperator is implemented by the operators which report the status of the shared IP.
+type VIPStatusOperator interface {
+	VIPStatusSpecs() []network.VIPStatusSpec
+}
+
+// StaticFallbackStatusOperator is implemented by the operators which report the status of the static configuration with DHCP fallback.
+type StaticFallbackStatusOperator interface {
+	StaticFallbackStatusSpecs() []network.StaticFallbackStatusSpec
+}
@@ -0,0 +1,382 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package operator
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"sync"
+	"time"
+
+	"github.com/mdlayher/arp"
+	"github.com/mdlayher/packet"
+	"github.com/siderolabs/gen/channel"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// GatewayProber checks whether the gateway is reachable via the link using the source address.
+//
+// The source address is the static address, which is not assigned to the link after falling back to DHCPv4.
+type GatewayProber func(ctx context.Context, linkName string, source, gateway netip.Addr, timeout time.Duration) error
+
+// StaticFallback implements the static configuration with DHCPv4 fallback network operator.
+//
+// The static configuration is applied first, and the gateway is probed via ARP.
+// If the gateway doesn't respond within the probe timeout, the static configuration is replaced with
+// the DHCPv4 operator. The gateway is re-probed periodically to switch back to the static configuration.
+type StaticFallback struct {
+	logger *zap.Logger
+
+	linkName string
+	config   network.StaticFallbackOperatorSpec
+	source   netip.Addr
+
+	newDHCP4 func() Operator
+	probe    GatewayProber
+
+	mu     sync.Mutex
+	dhcp4  Operator
+	status network.StaticFallbackStatusSpec
+}
+
+// NewStaticFallback creates static configuration with DHCPv4 fallback operator.
+//
+// newDHCP4 is called each time the operator falls back to DHCPv4.
+func NewStaticFallback(logger *zap.Logger, linkName string, config network.StaticFallbackOperatorSpec, newDHCP4 func() Operator, probe GatewayProber) *StaticFallback {
+	if config.ProbeTimeout == 0 {
+		config.ProbeTimeout = constants.DHCPFallbackDefaultProbeTimeout
+	}
+
+	if config.ProbeInterval == 0 {
+		config.ProbeInterval = constants.DHCPFallbackDefaultProbeInterval
+	}
+
+	var source netip.Addr
+
+	for _, address := range config.Addresses {
+		if address.Address.Addr().Is4() {
+			source = address.Address.Addr()
+
+			break
+		}
+	}
+
+	return &StaticFallback{
+		logger:   logger,
+		linkName: linkName,
+		config:   config,
+		source:   source,
+		newDHCP4: newDHCP4,
+		probe:    probe,
+		status: network.StaticFallbackStatusSpec{
+			LinkName: linkName,
+			Mode:     network.StaticFallbackModeStatic,
+			Gateway:  config.Gateway,
+		},
+	}
+}
+
+// Prefix returns unique operator prefix which gets prepended to each spec.
+func (s *StaticFallback) Prefix() string {
+	return fmt.Sprintf("static-fallback/%s", s.linkName)
+}
+
+// Run the operator loop.
+//
+//nolint:gocyclo
+func (s *StaticFallback) Run(ctx context.Context, notifyCh chan<- struct{}) {
+	var (
+		dhcp4Cancel context.CancelFunc
+		dhcp4Wg     sync.WaitGroup
+	)
+
+	stopDHCP4 := func() {
+		if dhcp4Cancel == nil {
+			return
+		}
+
+		dhcp4Cancel()
+		dhcp4Wg.Wait()
+
+		dhcp4Cancel = nil
+
+		s.mu.Lock()
+		s.dhcp4 = nil
+		s.mu.Unlock()
+	}
+
+	defer stopDHCP4()
+
+	s.mu.Lock()
+	s.status.LastTransition = time.Now()
+	s.mu.Unlock()
+
+	// publish the static configuration before probing the gateway, so that the link is configured while probing
+	if !channel.SendWithContext(ctx, notifyCh, struct{}{}) {
+		return
+	}
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		err := s.probe(ctx, s.linkName, s.source, s.config.Gateway, s.config.ProbeTimeout)
+		if ctx.Err() != nil {
+			return
+		}
+
+		reachable := err == nil
+
+		s.mu.Lock()
+		mode := s.status.Mode
+		s.mu.Unlock()
+
+		switch {
+		case !reachable && mode == network.StaticFallbackModeStatic:
+			s.logger.Warn("gateway is not reachable, falling back to DHCPv4", zap.String("link", s.linkName), zap.Stringer("gateway", s.config.Gateway), zap.Error(err))
+
+			dhcp4 := s.newDHCP4()
+
+			var dhcp4Ctx context.Context
+
+			dhcp4Ctx, dhcp4Cancel = context.WithCancel(ctx)
+
+			dhcp4Wg.Add(1)
+
+			go func() {
+				defer dhcp4Wg.Done()
+
+				dhcp4.Run(dhcp4Ctx, notifyCh)
+			}()
+
+			s.mu.Lock()
+			s.dhcp4 = dhcp4
+			s.mu.Unlock()
+
+			mode = network.StaticFallbackModeDHCP4
+		case reachable && mode == network.StaticFallbackModeDHCP4:
+			s.logger.Info("gateway is reachable, switching back to the static configuration", zap.String("link", s.linkName), zap.Stringer("gateway", s.config.Gateway))
+
+			stopDHCP4()
+
+			mode = network.StaticFallbackModeStatic
+		default:
+			s.logger.Debug("probed gateway", zap.String("link", s.linkName), zap.Stringer("gateway", s.config.Gateway), zap.Bool("reachable", reachable), zap.Error(err))
+		}
+
+		s.mu.Lock()
+
+		now := time.Now()
+
+		if s.status.Mode != mode {
+			s.status.LastTransition = now
+		}
+
+		s.status.Mode = mode
+		s.status.GatewayReachable = reachable
+		s.status.LastProbe = now
+
+		s.mu.Unlock()
+
+		if !channel.SendWithContext(ctx, notifyCh, struct{}{}) {
+			return
+		}
+
+		timer.Reset(s.config.ProbeInterval)
+	}
+}
+
+// fallback returns the DHCPv4 operator if the operator fell back to DHCPv4.
+func (s *StaticFallback) fallback() Operator {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.dhcp4
+}
+
+// AddressSpecs implements Operator interface.
+func (s *StaticFallback) AddressSpecs() []network.AddressSpecSpec {
+	if dhcp4 := s.fallback(); dhcp4 != nil {
+		return dhcp4.AddressSpecs()
+	}
+
+	return s.config.Addresses
+}
+
+// RouteSpecs implements Operator interface.
+func (s *StaticFallback) RouteSpecs() []network.RouteSpecSpec {
+	if dhcp4 := s.fallback(); dhcp4 != nil {
+		return dhcp4.RouteSpecs()
+	}
+
+	return s.config.Routes
+}
+
+// LinkSpecs implements Operator interface.
+func (s *StaticFallback) LinkSpecs() []network.LinkSpecSpec {
+	if dhcp4 := s.fallback(); dhcp4 != nil {
+		return dhcp4.LinkSpecs()
+	}
+
+	return nil
+}
+
+// HostnameSpecs implements Operator interface.
+func (s *StaticFallback) HostnameSpecs() []network.HostnameSpecSpec {
+	if dhcp4 := s.fallback(); dhcp4 != nil {
+		return dhcp4.HostnameSpecs()
+	}
+
+	return nil
+}
+
+// ResolverSpecs implements Operator interface.
+func (s *StaticFallback) ResolverSpecs() []network.ResolverSpecSpec {
+	if dhcp4 := s.fallback(); dhcp4 != nil {
+		return dhcp4.ResolverSpecs()
+	}
+
+	return nil
+}
+
+// TimeServerSpecs implements Operator interface.
+func (s *StaticFallback) TimeServerSpecs() []network.TimeServerSpecSpec {
+	if dhcp4 := s.fallback(); dhcp4 != nil {
+		return dhcp4.TimeServerSpecs()
+	}
+
+	return nil
+}
+
+// DHCP4LeaseSpecs implements LeaseOperator interface.
+func (s *StaticFallback) DHCP4LeaseSpecs() []network.DHCP4LeaseSpec {
+	if leaseOperator, ok := s.fallback().(LeaseOperator); ok {
+		return leaseOperator.DHCP4LeaseSpecs()
+	}
+
+	return nil
+}
+
+// Release implements ReleaseOperator interface.
+func (s *StaticFallback) Release() error {
+	if releaseOperator, ok := s.fallback().(ReleaseOperator); ok {
+		return releaseOperator.Release()
+	}
+
+	return nil
+}
+
+// StaticFallbackStatusSpecs implements StaticFallbackStatusOperator interface.
+func (s *StaticFallback) StaticFallbackStatusSpecs() []network.StaticFallbackStatusSpec {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return []network.StaticFallbackStatusSpec{s.status}
+}
+
+// ProbeGatewayARP probes the gateway by resolving its hardware address via ARP.
+//
+// The ARP request is sent with the static source address, so the probe doesn't depend on the addresses
+// assigned to the link: after falling back to DHCPv4 the static address is withdrawn, and the DHCPv4 lease
+// might be missing as well.
+//
+// The probe is retried until the timeout expires, as the link might not be up yet.
+func ProbeGatewayARP(ctx context.Context, linkName string, source, gateway netip.Addr, timeout time.Duration) error {
+	if !source.Is4() || !gateway.Is4() {
+		return fmt.Errorf("gateway %s can't be probed from %s: IPv4 addresses are required", gateway, source)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		err := resolveARP(ctx, linkName, source, gateway)
+		if err == nil {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("gateway %s is not reachable: %w", gateway, err)
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+const etherTypeARP = 0x0806
+
+var etherBroadcast = net.HardwareAddr{0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+
+// resolveARP sends a raw ARP request for the gateway, and waits for the reply.
+//
+// arp.Client can't be used here, as it requires an IPv4 address assigned to the link.
+func resolveARP(ctx context.Context, linkName string, source, gateway netip.Addr) error {
+	iface, err := net.InterfaceByName(linkName)
+	if err != nil {
+		return err
+	}
+
+	conn, err := packet.Listen(iface, packet.Datagram, etherTypeARP, nil)
+	if err != nil {
+		return fmt.Errorf("error listening for ARP: %w", err)
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	deadline := time.Now().Add(time.Second)
+
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+
+	if err = conn.SetDeadline(deadline); err != nil {
+		return fmt.Errorf("error setting deadline: %w", err)
+	}
+
+	request, err := arp.NewPacket(arp.OperationRequest, iface.HardwareAddr, source, etherBroadcast, gateway)
+	if err != nil {
+		return fmt.Errorf("error building packet: %w", err)
+	}
+
+	b, err := request.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("error marshaling packet: %w", err)
+	}
+
+	if _, err = conn.WriteTo(b, &packet.Addr{HardwareAddr: etherBroadcast}); err != nil {
+		return fmt.Errorf("error sending ARP request: %w", err)
+	}
+
+	buf := make([]byte, 1500)
+
+	for {
+		n, _, err := conn.ReadFrom(buf)
+		if err != nil {
+			return err
+		}
+
+		var reply arp.Packet
+
+		if err = reply.UnmarshalBinary(buf[:n]); err != nil {
+			// not an ARP packet we can parse, skip it
+			continue
+		}
+
+		if reply.Operation == arp.OperationReply && reply.SenderIP == gateway {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,267 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package operator_test
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/operator"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// mockDHCP4 is a DHCPv4 operator which acquires the lease immediately (unless noLease is set).
+type mockDHCP4 struct {
+	running *atomic.Int32
+	noLease bool
+}
+
+func (m *mockDHCP4) Run(ctx context.Context, _ chan<- struct{}) {
+	m.running.Add(1)
+	defer m.running.Add(-1)
+
+	<-ctx.Done()
+}
+
+func (m *mockDHCP4) Prefix() string { return "dhcp4/eth0" }
+
+func (m *mockDHCP4) AddressSpecs() []network.AddressSpecSpec {
+	if m.noLease {
+		return nil
+	}
+
+	return []network.AddressSpecSpec{{Address: netip.MustParsePrefix("10.5.0.3/24"), LinkName: "eth0"}}
+}
+
+func (m *mockDHCP4) RouteSpecs() []network.RouteSpecSpec {
+	if m.noLease {
+		return nil
+	}
+
+	return []network.RouteSpecSpec{{Gateway: netip.MustParseAddr("10.5.0.1"), OutLinkName: "eth0"}}
+}
+
+func (m *mockDHCP4) LinkSpecs() []network.LinkSpecSpec             { return nil }
+func (m *mockDHCP4) HostnameSpecs() []network.HostnameSpecSpec     { return nil }
+func (m *mockDHCP4) ResolverSpecs() []network.ResolverSpecSpec     { return nil }
+func (m *mockDHCP4) TimeServerSpecs() []network.TimeServerSpecSpec { return nil }
+
+func TestStaticFallback(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	t.Cleanup(cancel)
+
+	var (
+		reachable atomic.Bool
+		running   atomic.Int32
+	)
+
+	reachable.Store(true)
+
+	staticAddress := network.AddressSpecSpec{Address: netip.MustParsePrefix("192.168.1.10/24"), LinkName: "eth0"}
+	staticRoute := network.RouteSpecSpec{Gateway: netip.MustParseAddr("192.168.1.1"), OutLinkName: "eth0"}
+
+	op := operator.NewStaticFallback(
+		zaptest.NewLogger(t),
+		"eth0",
+		network.StaticFallbackOperatorSpec{
+			Addresses:     []network.AddressSpecSpec{staticAddress},
+			Routes:        []network.RouteSpecSpec{staticRoute},
+			Gateway:       staticRoute.Gateway,
+			ProbeTimeout:  time.Second,
+			ProbeInterval: 10 * time.Millisecond,
+		},
+		func() operator.Operator {
+			return &mockDHCP4{running: &running}
+		},
+		func(_ context.Context, linkName string, source, gateway netip.Addr, _ time.Duration) error {
+			assert.Equal(t, "eth0", linkName)
+			assert.Equal(t, staticAddress.Address.Addr(), source)
+			assert.Equal(t, staticRoute.Gateway, gateway)
+
+			if reachable.Load() {
+				return nil
+			}
+
+			return errors.New("no ARP reply")
+		},
+	)
+
+	assert.Equal(t, "static-fallback/eth0", op.Prefix())
+
+	notifyCh := make(chan struct{})
+
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		op.Run(ctx, notifyCh)
+	}()
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-notifyCh:
+			}
+		}
+	}()
+
+	assertMode := func(mode string, reachable bool) {
+		t.Helper()
+
+		assert.EventuallyWithT(t, func(collect *assert.CollectT) {
+			statuses := op.StaticFallbackStatusSpecs()
+
+			if !assert.Len(collect, statuses, 1) {
+				return
+			}
+
+			assert.Equal(collect, "eth0", statuses[0].LinkName)
+			assert.Equal(collect, mode, statuses[0].Mode)
+			assert.Equal(collect, reachable, statuses[0].GatewayReachable)
+			assert.False(collect, statuses[0].LastProbe.IsZero())
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+
+	assertMode(network.StaticFallbackModeStatic, true)
+	assert.Equal(t, []network.AddressSpecSpec{staticAddress}, op.AddressSpecs())
+	assert.Equal(t, []network.RouteSpecSpec{staticRoute}, op.RouteSpecs())
+	assert.EqualValues(t, 0, running.Load())
+
+	// gateway goes away, fall back to DHCP
+	reachable.Store(false)
+
+	assertMode(network.StaticFallbackModeDHCP4, false)
+	assert.Equal(t, netip.MustParsePrefix("10.5.0.3/24"), op.AddressSpecs()[0].Address)
+	assert.Equal(t, netip.MustParseAddr("10.5.0.1"), op.RouteSpecs()[0].Gateway)
+	assert.Eventually(t, func() bool { return running.Load() == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	// gateway is back, switch back to the static configuration
+	reachable.Store(true)
+
+	assertMode(network.StaticFallbackModeStatic, true)
+	assert.Equal(t, []network.AddressSpecSpec{staticAddress}, op.AddressSpecs())
+	assert.Eventually(t, func() bool { return running.Load() == 0 }, 5*time.Second, 10*time.Millisecond)
+
+	cancel()
+	wg.Wait()
+}
+
+func TestStaticFallbackNoLease(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	t.Cleanup(cancel)
+
+	var (
+		reachable atomic.Bool
+		running   atomic.Int32
+		probes    atomic.Int32
+	)
+
+	staticAddress := network.AddressSpecSpec{Address: netip.MustParsePrefix("192.168.1.10/24"), LinkName: "eth0"}
+	staticRoute := network.RouteSpecSpec{Gateway: netip.MustParseAddr("192.168.1.1"), OutLinkName: "eth0"}
+
+	op := operator.NewStaticFallback(
+		zaptest.NewLogger(t),
+		"eth0",
+		network.StaticFallbackOperatorSpec{
+			Addresses: []network.AddressSpecSpec{
+				{Address: netip.MustParsePrefix("2001:db8::10/64"), LinkName: "eth0"},
+				staticAddress,
+			},
+			Routes:        []network.RouteSpecSpec{staticRoute},
+			Gateway:       staticRoute.Gateway,
+			ProbeTimeout:  time.Second,
+			ProbeInterval: 10 * time.Millisecond,
+		},
+		func() operator.Operator {
+			return &mockDHCP4{running: &running, noLease: true}
+		},
+		func(_ context.Context, _ string, source, _ netip.Addr, _ time.Duration) error {
+			probes.Add(1)
+
+			// the static address is used as the source even if no address is assigned to the link
+			assert.Equal(t, staticAddress.Address.Addr(), source)
+
+			if reachable.Load() {
+				return nil
+			}
+
+			return errors.New("no ARP reply")
+		},
+	)
+
+	notifyCh := make(chan struct{})
+
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		op.Run(ctx, notifyCh)
+	}()
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-notifyCh:
+			}
+		}
+	}()
+
+	assertMode := func(mode string) {
+		t.Helper()
+
+		assert.EventuallyWithT(t, func(collect *assert.CollectT) {
+			statuses := op.StaticFallbackStatusSpecs()
+
+			if !assert.Len(collect, statuses, 1) {
+				return
+			}
+
+			assert.Equal(collect, mode, statuses[0].Mode)
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+
+	// gateway is not reachable from the start, fall back to DHCPv4 which never gets a lease
+	assertMode(network.StaticFallbackModeDHCP4)
+	assert.Empty(t, op.AddressSpecs())
+	assert.Empty(t, op.RouteSpecs())
+	assert.Eventually(t, func() bool { return running.Load() == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	// the gateway keeps being probed without any address on the link
+	probed := probes.Load()
+	assert.Eventually(t, func() bool { return probes.Load() > probed+2 }, 5*time.Second, 10*time.Millisecond)
+
+	// gateway is back, switch back to the static configuration
+	reachable.Store(true)
+
+	assertMode(network.StaticFallbackModeStatic)
+	assert.Equal(t, []network.AddressSpecSpec{{Address: netip.MustParsePrefix("2001:db8::10/64"), LinkName: "eth0"}, staticAddress}, op.AddressSpecs())
+	assert.Equal(t, []network.RouteSpecSpec{staticRoute}, op.RouteSpecs())
+	assert.Eventually(t, func() bool { return running.Load() == 0 }, 5*time.Second, 10*time.Millisecond)
+
+	cancel()
+	wg.Wait()
+}
@@ -6,6 +6,7 @@ package operator
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/netip"
@@ -16,6 +17,7 @@ import (
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/state"
+	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.etcd.io/etcd/client/v3/concurrency"
 	"go.uber.org/zap"
 
@@ -30,6 +32,9 @@ import (
 
 const campaignRetryInterval = time.Second
 
+// errVIPDeferred is returned when the node won the election, but a node with a higher priority should own the shared IP.
+var errVIPDeferred = errors.New("deferred to a node with a higher priority")
+
 // VIP implements the Virtual (Shared) IP network operator.
 type VIP struct {
 	logger *zap.Logger
@@ -37,11 +42,14 @@ type VIP struct {
 	linkName      string
 	sharedIP      netip.Addr
 	gratuitousARP bool
+	priority      uint32
+	preempt       bool
 
 	state state.State
 
 	mu     sync.Mutex
 	leader bool
+	status network.VIPStatusSpec
 
 	handler vip.Handler
 }
@@ -64,8 +72,16 @@ func NewVIP(logger *zap.Logger, linkName string, spec network.VIPOperatorSpec, s
 		linkName:      linkName,
 		sharedIP:      spec.IP,
 		gratuitousARP: spec.GratuitousARP,
+		priority:      spec.Priority,
+		preempt:       spec.Preempt,
 		state:         state,
 		handler:       handler,
+		status: network.VIPStatusSpec{
+			LinkName: linkName,
+			IP:       spec.IP,
+			Priority: spec.Priority,
+			Preempt:  spec.Preempt,
+		},
 	}
 }
 
@@ -79,7 +95,7 @@ func (vip *VIP) Run(ctx context.Context, notifyCh chan<- struct{}) {
 	for {
 		err := vip.campaign(ctx, notifyCh)
 		if err != nil {
-			if !errors.Is(err, context.Canceled) {
+			if !errors.Is(err, context.Canceled) && !errors.Is(err, errVIPDeferred) {
 				vip.logger.Warn("campaign failure", zap.Error(err), zap.String("link", vip.linkName), zap.Stringer("ip", vip.sharedIP))
 			}
 
@@ -122,6 +138,14 @@ func (vip *VIP) AddressSpecs() []network.AddressSpecSpec {
 	}
 }
 
+// VIPStatusSpecs implements VIPStatusOperator interface.
+func (vip *VIP) VIPStatusSpecs() []network.VIPStatusSpec {
+	vip.mu.Lock()
+	defer vip.mu.Unlock()
+
+	return []network.VIPStatusSpec{vip.status}
+}
+
 // LinkSpecs implements Operator interface.
 func (vip *VIP) LinkSpecs() []network.LinkSpecSpec {
 	return nil
@@ -151,6 +175,92 @@ func (vip *VIP) etcdElectionKey() string {
 	return fmt.Sprintf("%s:vip:election:%s", constants.EtcdRootTalosKey, vip.sharedIP.String())
 }
 
+func (vip *VIP) etcdCandidatesKey() string {
+	return fmt.Sprintf("%s:vip:candidates:%s/", constants.EtcdRootTalosKey, vip.sharedIP.String())
+}
+
+// registerCandidate publishes the candidate settings, they are removed with the session.
+func (vip *VIP) registerCandidate(ctx context.Context, ec *etcd.Client, sess *concurrency.Session, self vipCandidate) error {
+	marshaled, err := json.Marshal(self)
+	if err != nil {
+		return fmt.Errorf("error marshaling candidate: %w", err)
+	}
+
+	if _, err = ec.Put(ctx, vip.etcdCandidatesKey()+self.Hostname, string(marshaled), clientv3.WithLease(sess.Lease())); err != nil {
+		return fmt.Errorf("error registering candidate: %w", err)
+	}
+
+	return nil
+}
+
+func (vip *VIP) listCandidates(ctx context.Context, ec *etcd.Client) ([]vipCandidate, error) {
+	resp, err := ec.Get(ctx, vip.etcdCandidatesKey(), clientv3.WithPrefix())
+	if err != nil {
+		return nil, fmt.Errorf("error listing candidates: %w", err)
+	}
+
+	candidates := make([]vipCandidate, 0, len(resp.Kvs))
+
+	for _, kv := range resp.Kvs {
+		var candidate vipCandidate
+
+		if err = json.Unmarshal(kv.Value, &candidate); err != nil {
+			vip.logger.Warn("ignoring invalid candidate", zap.ByteString("key", kv.Key), zap.Error(err))
+
+			continue
+		}
+
+		candidates = append(candidates, candidate)
+	}
+
+	return candidates, nil
+}
+
+// updateOwner records the current owner of the shared IP along with its priority.
+func (vip *VIP) updateOwner(ctx context.Context, ec *etcd.Client, notifyCh chan<- struct{}, owner string) error {
+	candidates, err := vip.listCandidates(ctx, ec)
+	if err != nil {
+		return err
+	}
+
+	var ownerPriority uint32
+
+	for _, candidate := range candidates {
+		if candidate.Hostname == owner {
+			ownerPriority = candidate.Priority
+		}
+	}
+
+	changed := func() bool {
+		vip.mu.Lock()
+		defer vip.mu.Unlock()
+
+		if vip.status.Owner == owner && vip.status.OwnerPriority == ownerPriority {
+			return false
+		}
+
+		if vip.status.Owner != owner {
+			vip.status.LastTransition = time.Now()
+		}
+
+		vip.status.Owner = owner
+		vip.status.OwnerPriority = ownerPriority
+
+		return true
+	}()
+
+	if !changed {
+		return nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case notifyCh <- struct{}{}:
+		return nil
+	}
+}
+
 func (vip *VIP) waitForPreconditions(ctx context.Context) error {
 	//  wait for the etcd to be up
 	_, err := vip.state.WatchFor(ctx, resource.NewMetadata(v1alpha1.NamespaceName, v1alpha1.ServiceType, "etcd", resource.VersionUndefined),
@@ -242,6 +352,28 @@ func (vip *VIP) campaign(ctx context.Context, notifyCh chan<- struct{}) error {
 		}
 	}
 
+	memberID, err := ec.GetMemberID(ctx)
+	if err != nil {
+		return fmt.Errorf("failed getting etcd member ID: %w", err)
+	}
+
+	self := vipCandidate{
+		Hostname: hostname,
+		MemberID: memberID,
+		Priority: vip.priority,
+		Preempt:  vip.preempt,
+	}
+
+	if err = vip.registerCandidate(ctx, ec, sess, self); err != nil {
+		return err
+	}
+
+	// observe the current owner while waiting for the campaign to complete
+	ownerCtx, ownerCancel := context.WithCancel(ctx)
+	defer ownerCancel()
+
+	ownerCh := election.Observe(ownerCtx)
+
 	campaignErrCh := make(chan error)
 
 	go func() {
@@ -275,6 +407,16 @@ campaignLoop:
 
 			// node won the election campaign!
 			break campaignLoop
+		case resp, ok := <-ownerCh:
+			if !ok {
+				ownerCh = nil
+
+				continue
+			}
+
+			if err = vip.updateOwner(ctx, ec, notifyCh, string(resp.Kvs[0].Value)); err != nil {
+				return fmt.Errorf("error updating shared IP owner: %w", err)
+			}
 		case <-sess.Done():
 			vip.logger.Info("etcd session closed")
 
@@ -305,10 +447,31 @@ campaignLoop:
 		election.Resign(resignCtx) //nolint:errcheck
 	}()
 
+	ownerCancel()
+
+	candidates, err := vip.listCandidates(ctx, ec)
+	if err != nil {
+		return err
+	}
+
+	// the shared IP is not owned by anyone right now, so the higher priority node should own it regardless of the preemption
+	if preferred, deferVIP := shouldDeferVIP(self, candidates); deferVIP {
+		vip.logger.Info("deferring shared IP to the node with a higher priority",
+			zap.String("link", vip.linkName), zap.Stringer("ip", vip.sharedIP),
+			zap.String("preferred", preferred.Hostname), zap.Uint32("priority", preferred.Priority),
+		)
+
+		return errVIPDeferred
+	}
+
 	if err = vip.markAsLeader(ctx, notifyCh, true); err != nil {
 		return err
 	}
 
+	if err = vip.updateOwner(ctx, ec, notifyCh, hostname); err != nil {
+		return fmt.Errorf("error updating shared IP owner: %w", err)
+	}
+
 	defer func() {
 		if err = vip.markAsLeader(ctx, notifyCh, false); err != nil && !errors.Is(err, context.Canceled) {
 			vip.logger.Info("failed disabling shared IP", zap.String("link", vip.linkName), zap.Stringer("ip", vip.sharedIP), zap.Error(err))
@@ -320,6 +483,7 @@ campaignLoop:
 	vip.logger.Info("enabled shared IP", zap.String("link", vip.linkName), zap.Stringer("ip", vip.sharedIP))
 
 	observe := election.Observe(ctx)
+	candidatesWatch := ec.Watch(ctx, vip.etcdCandidatesKey(), clientv3.WithPrefix())
 
 observeLoop:
 	for {
@@ -338,6 +502,26 @@ observeLoop:
 			if string(resp.Kvs[0].Value) != hostname {
 				vip.logger.Info("detected new leader", zap.ByteString("leader", resp.Kvs[0].Value))
 
+				break observeLoop
+			}
+		case _, ok := <-candidatesWatch:
+			if !ok {
+				break observeLoop
+			}
+
+			candidates, err = vip.listCandidates(ctx, ec)
+			if err != nil {
+				vip.logger.Warn("failed to list shared IP candidates", zap.Error(err))
+
+				continue
+			}
+
+			if preferred, yield := shouldYieldVIP(self, candidates); yield {
+				vip.logger.Info("yielding shared IP to the node with a higher priority",
+					zap.String("link", vip.linkName), zap.Stringer("ip", vip.sharedIP),
+					zap.String("preferred", preferred.Hostname), zap.Uint32("priority", preferred.Priority),
+				)
+
 				break observeLoop
 			}
 		case event := <-watchCh:
@@ -379,6 +563,11 @@ func (vip *VIP) markAsLeader(ctx context.Context, notifyCh chan<- struct{}, lead
 		defer vip.mu.Unlock()
 
 		vip.leader = leader
+
+		if vip.status.Owned != leader {
+			vip.status.Owned = leader
+			vip.status.LastTransition = time.Now()
+		}
 	}()
 
 	select {
@@ -0,0 +1,71 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package operator
+
+import (
+	"cmp"
+	"slices"
+)
+
+// vipCandidate describes a node campaigning for the shared IP.
+//
+// Candidates are published in etcd next to the election, so that the nodes can take the priorities into account.
+type vipCandidate struct {
+	Hostname string `json:"hostname"`
+	MemberID uint64 `json:"memberID"`
+	Priority uint32 `json:"priority"`
+	Preempt  bool   `json:"preempt"`
+}
+
+// compareVIPCandidates orders the candidates: higher priority first, ties are broken by the lower etcd member ID.
+func compareVIPCandidates(a, b vipCandidate) int {
+	return cmp.Or(
+		cmp.Compare(b.Priority, a.Priority),
+		cmp.Compare(a.MemberID, b.MemberID),
+	)
+}
+
+// preferredVIPCandidate returns the candidate which should own the shared IP.
+//
+// If no candidate has a higher priority than self, self is returned.
+func preferredVIPCandidate(self vipCandidate, candidates []vipCandidate) vipCandidate {
+	preferred := self
+
+	for _, candidate := range candidates {
+		if candidate.Hostname == self.Hostname {
+			continue
+		}
+
+		// candidates with the same priority as self never take over, so that the election order is preserved
+		if candidate.Priority <= self.Priority {
+			continue
+		}
+
+		if preferred.Hostname == self.Hostname || compareVIPCandidates(candidate, preferred) < 0 {
+			preferred = candidate
+		}
+	}
+
+	return preferred
+}
+
+// shouldDeferVIP returns true if the node which has just won the election should let a higher priority candidate own the shared IP.
+//
+// When the shared IP is not owned by anyone, the preemption setting doesn't matter.
+func shouldDeferVIP(self vipCandidate, candidates []vipCandidate) (vipCandidate, bool) {
+	preferred := preferredVIPCandidate(self, candidates)
+
+	return preferred, preferred.Hostname != self.Hostname
+}
+
+// shouldYieldVIP returns true if the current owner of the shared IP should hand it over to a higher priority candidate
+// which has preemption enabled.
+func shouldYieldVIP(self vipCandidate, candidates []vipCandidate) (vipCandidate, bool) {
+	preemptive := slices.DeleteFunc(slices.Clone(candidates), func(candidate vipCandidate) bool {
+		return !candidate.Preempt
+	})
+
+	return shouldDeferVIP(self, preemptive)
+}
@@ -0,0 +1,98 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package operator_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/operator"
+)
+
+func TestShouldDeferVIP(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name       string
+		self       operator.VIPCandidate
+		candidates []operator.VIPCandidate
+
+		expectedDefer     bool
+		expectedPreferred string
+	}{
+		{
+			name: "no priorities",
+			self: operator.VIPCandidate{Hostname: "node2", MemberID: 2},
+			candidates: []operator.VIPCandidate{
+				{Hostname: "node1", MemberID: 1},
+				{Hostname: "node2", MemberID: 2},
+			},
+			expectedPreferred: "node2",
+		},
+		{
+			name: "higher priority",
+			self: operator.VIPCandidate{Hostname: "node1", MemberID: 1, Priority: 10},
+			candidates: []operator.VIPCandidate{
+				{Hostname: "node1", MemberID: 1, Priority: 10},
+				{Hostname: "node2", MemberID: 2, Priority: 20},
+				{Hostname: "node3", MemberID: 3, Priority: 5},
+			},
+			expectedDefer:     true,
+			expectedPreferred: "node2",
+		},
+		{
+			name: "tie broken by member ID",
+			self: operator.VIPCandidate{Hostname: "node1", MemberID: 1},
+			candidates: []operator.VIPCandidate{
+				{Hostname: "node1", MemberID: 1},
+				{Hostname: "node2", MemberID: 5, Priority: 20},
+				{Hostname: "node3", MemberID: 3, Priority: 20},
+			},
+			expectedDefer:     true,
+			expectedPreferred: "node3",
+		},
+		{
+			name: "highest priority",
+			self: operator.VIPCandidate{Hostname: "node2", MemberID: 2, Priority: 20},
+			candidates: []operator.VIPCandidate{
+				{Hostname: "node1", MemberID: 1, Priority: 20},
+				{Hostname: "node2", MemberID: 2, Priority: 20},
+			},
+			expectedPreferred: "node2",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			preferred, deferVIP := operator.ShouldDeferVIP(test.self, test.candidates)
+
+			assert.Equal(t, test.expectedDefer, deferVIP)
+			assert.Equal(t, test.expectedPreferred, preferred.Hostname)
+		})
+	}
+}
+
+func TestShouldYieldVIP(t *testing.T) {
+	t.Parallel()
+
+	self := operator.VIPCandidate{Hostname: "node1", MemberID: 1, Priority: 10}
+
+	preferred, yield := operator.ShouldYieldVIP(self, []operator.VIPCandidate{
+		self,
+		{Hostname: "node2", MemberID: 2, Priority: 20, Preempt: false},
+	})
+
+	assert.False(t, yield)
+	assert.Equal(t, "node1", preferred.Hostname)
+
+	preferred, yield = operator.ShouldYieldVIP(self, []operator.VIPCandidate{
+		self,
+		{Hostname: "node2", MemberID: 2, Priority: 20, Preempt: true},
+	})
+
+	assert.True(t, yield)
+	assert.Equal(t, "node2", preferred.Hostname)
+}
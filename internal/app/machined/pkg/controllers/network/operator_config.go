@@ -6,19 +6,25 @@ package network
 
 import (
 	"context"
+	"encoding/binary"
+	"encoding/hex"
 	"fmt"
+	"net"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/hashicorp/go-multierror"
+	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/gen/value"
 	"github.com/siderolabs/gen/xslices"
 	"github.com/siderolabs/go-procfs/procfs"
 	"go.uber.org/zap"
 
 	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/hardware"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 )
 
@@ -50,6 +56,12 @@ func (ctrl *OperatorConfigController) Inputs() []controller.Input {
 			Type:      network.LinkSpecType,
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: hardware.NamespaceName,
+			Type:      hardware.SystemInformationType,
+			ID:        optional.Some(hardware.SystemInformationID),
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -83,6 +95,11 @@ func (ctrl *OperatorConfigController) Run(ctx context.Context, r controller.Runt
 
 		linkNameResolver := network.NewLinkResolver(linkStatuses.All)
 
+		sysInfo, err := safe.ReaderGetByID[*hardware.SystemInformation](ctx, r, hardware.SystemInformationID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting system information: %w", err)
+		}
+
 		var (
 			specs      []network.OperatorSpecSpec
 			specErrors *multierror.Error
@@ -142,20 +159,22 @@ func (ctrl *OperatorConfigController) Run(ctx context.Context, r controller.Runt
 				}
 
 				if device.DHCP() && device.DHCPOptions().IPv4() {
-					routeMetric := device.DHCPOptions().RouteMetric()
-					if routeMetric == 0 {
-						routeMetric = network.DefaultRouteMetric
+					linkName := linkNameResolver.Resolve(device.Interface())
+
+					dhcp4, ok, dhcp4Err := dhcp4OperatorSpec(device.DHCPOptions(), linkName, linkStatuses, sysInfo)
+					if dhcp4Err != nil {
+						specErrors = multierror.Append(specErrors, dhcp4Err)
 					}
 
-					specs = append(specs, network.OperatorSpecSpec{
-						Operator:  network.OperatorDHCP4,
-						LinkName:  linkNameResolver.Resolve(device.Interface()),
-						RequireUp: true,
-						DHCP4: network.DHCP4OperatorSpec{
-							RouteMetric: routeMetric,
-						},
-						ConfigLayer: network.ConfigMachineConfiguration,
-					})
+					if ok {
+						specs = append(specs, network.OperatorSpecSpec{
+							Operator:    network.OperatorDHCP4,
+							LinkName:    linkName,
+							RequireUp:   true,
+							DHCP4:       dhcp4,
+							ConfigLayer: network.ConfigMachineConfiguration,
+						})
+					}
 				}
 
 				if device.DHCP() && device.DHCPOptions().IPv6() {
@@ -164,35 +183,65 @@ func (ctrl *OperatorConfigController) Run(ctx context.Context, r controller.Runt
 						routeMetric = network.DefaultRouteMetric
 					}
 
-					specs = append(specs, network.OperatorSpecSpec{
-						Operator:  network.OperatorDHCP6,
-						LinkName:  linkNameResolver.Resolve(device.Interface()),
-						RequireUp: true,
-						DHCP6: network.DHCP6OperatorSpec{
-							RouteMetric: routeMetric,
-							DUID:        device.DHCPOptions().DUIDv6(),
-						},
-						ConfigLayer: network.ConfigMachineConfiguration,
-					})
-				}
+					linkName := linkNameResolver.Resolve(device.Interface())
 
-				for _, vlan := range device.Vlans() {
-					if vlan.DHCP() && vlan.DHCPOptions().IPv4() {
-						routeMetric := vlan.DHCPOptions().RouteMetric()
-						if routeMetric == 0 {
-							routeMetric = network.DefaultRouteMetric
-						}
+					duid, ok, duidErr := resolveDHCP6DUID(device.DHCPOptions(), linkName, linkStatuses, sysInfo)
+					if duidErr != nil {
+						specErrors = multierror.Append(specErrors, duidErr)
+					}
 
+					if ok {
 						specs = append(specs, network.OperatorSpecSpec{
-							Operator:  network.OperatorDHCP4,
-							LinkName:  nethelpers.VLANLinkName(device.Interface(), vlan.ID()),
+							Operator:  network.OperatorDHCP6,
+							LinkName:  linkName,
 							RequireUp: true,
-							DHCP4: network.DHCP4OperatorSpec{
+							DHCP6: network.DHCP6OperatorSpec{
 								RouteMetric: routeMetric,
+								DUID:        duid,
+								IAID:        device.DHCPOptions().IAIDv6(),
 							},
 							ConfigLayer: network.ConfigMachineConfiguration,
 						})
 					}
+				}
+
+				if staticFallbackEnabled(device) {
+					linkName := linkNameResolver.Resolve(device.Interface())
+
+					dhcp4, ok, fallbackErr := dhcp4OperatorSpec(device.DHCPOptions(), linkName, linkStatuses, sysInfo)
+					if fallbackErr == nil && ok {
+						var spec network.OperatorSpecSpec
+
+						spec, fallbackErr = staticFallbackOperatorSpec(device, linkName, dhcp4)
+						if fallbackErr == nil {
+							specs = append(specs, spec)
+						}
+					}
+
+					if fallbackErr != nil {
+						specErrors = multierror.Append(specErrors, fallbackErr)
+					}
+				}
+
+				for _, vlan := range device.Vlans() {
+					if vlan.DHCP() && vlan.DHCPOptions().IPv4() {
+						linkName := nethelpers.VLANLinkName(device.Interface(), vlan.ID())
+
+						dhcp4, ok, dhcp4Err := dhcp4OperatorSpec(vlan.DHCPOptions(), linkName, linkStatuses, sysInfo)
+						if dhcp4Err != nil {
+							specErrors = multierror.Append(specErrors, dhcp4Err)
+						}
+
+						if ok {
+							specs = append(specs, network.OperatorSpecSpec{
+								Operator:    network.OperatorDHCP4,
+								LinkName:    linkName,
+								RequireUp:   true,
+								DHCP4:       dhcp4,
+								ConfigLayer: network.ConfigMachineConfiguration,
+							})
+						}
+					}
 
 					if vlan.DHCP() && vlan.DHCPOptions().IPv6() {
 						routeMetric := vlan.DHCPOptions().RouteMetric()
@@ -200,16 +249,26 @@ func (ctrl *OperatorConfigController) Run(ctx context.Context, r controller.Runt
 							routeMetric = network.DefaultRouteMetric
 						}
 
-						specs = append(specs, network.OperatorSpecSpec{
-							Operator:  network.OperatorDHCP6,
-							LinkName:  nethelpers.VLANLinkName(device.Interface(), vlan.ID()),
-							RequireUp: true,
-							DHCP6: network.DHCP6OperatorSpec{
-								RouteMetric: routeMetric,
-								DUID:        vlan.DHCPOptions().DUIDv6(),
-							},
-							ConfigLayer: network.ConfigMachineConfiguration,
-						})
+						linkName := nethelpers.VLANLinkName(device.Interface(), vlan.ID())
+
+						duid, ok, duidErr := resolveDHCP6DUID(vlan.DHCPOptions(), linkName, linkStatuses, sysInfo)
+						if duidErr != nil {
+							specErrors = multierror.Append(specErrors, duidErr)
+						}
+
+						if ok {
+							specs = append(specs, network.OperatorSpecSpec{
+								Operator:  network.OperatorDHCP6,
+								LinkName:  linkName,
+								RequireUp: true,
+								DHCP6: network.DHCP6OperatorSpec{
+									RouteMetric: routeMetric,
+									DUID:        duid,
+									IAID:        vlan.DHCPOptions().IAIDv6(),
+								},
+								ConfigLayer: network.ConfigMachineConfiguration,
+							})
+						}
 					}
 				}
 			}
@@ -322,3 +381,220 @@ func (ctrl *OperatorConfigController) apply(ctx context.Context, r controller.Ru
 
 	return ids, nil
 }
+
+// staticFallbackEnabled returns true if the static configuration of the device falls back to DHCP.
+func staticFallbackEnabled(device talosconfig.Device) bool {
+	return device.DHCPFallback() != nil && device.DHCPFallback().Enabled()
+}
+
+// staticFallbackOperatorSpec builds the static configuration with DHCP fallback operator spec for the device.
+//
+// The operator manages the static addresses and routes of the device, and probes the gateway of the default route.
+func staticFallbackOperatorSpec(device talosconfig.Device, linkName string, dhcp4 network.DHCP4OperatorSpec) (network.OperatorSpecSpec, error) {
+	fallback := network.StaticFallbackOperatorSpec{
+		ProbeTimeout:  device.DHCPFallback().ProbeTimeout(),
+		ProbeInterval: device.DHCPFallback().ProbeInterval(),
+	}
+
+	for _, cidr := range device.Addresses() {
+		ipPrefix, err := parseIPOrIPPrefix(cidr)
+		if err != nil {
+			return network.OperatorSpecSpec{}, fmt.Errorf("link %q: error parsing address %q: %w", linkName, cidr, err)
+		}
+
+		address := network.AddressSpecSpec{
+			Address:     ipPrefix,
+			Scope:       nethelpers.ScopeGlobal,
+			LinkName:    linkName,
+			ConfigLayer: network.ConfigOperator,
+			Flags:       nethelpers.AddressFlags(nethelpers.AddressPermanent),
+		}
+
+		if address.Address.Addr().Is6() {
+			address.Family = nethelpers.FamilyInet6
+		} else {
+			address.Family = nethelpers.FamilyInet4
+		}
+
+		fallback.Addresses = append(fallback.Addresses, address)
+	}
+
+	for _, route := range device.Routes() {
+		routeSpec, err := convertRouteConfig(linkName, route)
+		if err != nil {
+			return network.OperatorSpecSpec{}, fmt.Errorf("link %q: %w", linkName, err)
+		}
+
+		routeSpec.ConfigLayer = network.ConfigOperator
+
+		if !fallback.Gateway.IsValid() && value.IsZero(routeSpec.Destination) && routeSpec.Gateway.Is4() {
+			fallback.Gateway = routeSpec.Gateway
+		}
+
+		fallback.Routes = append(fallback.Routes, routeSpec)
+	}
+
+	if !fallback.Gateway.IsValid() {
+		return network.OperatorSpecSpec{}, fmt.Errorf("link %q: no default route with an IPv4 gateway to probe", linkName)
+	}
+
+	return network.OperatorSpecSpec{
+		Operator:       network.OperatorStaticFallback,
+		LinkName:       linkName,
+		RequireUp:      true,
+		DHCP4:          dhcp4,
+		StaticFallback: fallback,
+		ConfigLayer:    network.ConfigMachineConfiguration,
+	}, nil
+}
+
+// dhcp4OperatorSpec builds the DHCPv4 operator options from the DHCP options.
+//
+// If the client identifier can't be generated yet (e.g. link is not created yet), ok is false.
+func dhcp4OperatorSpec(
+	opts talosconfig.DHCPOptions, linkName string, linkStatuses safe.List[*network.LinkStatus], sysInfo *hardware.SystemInformation,
+) (spec network.DHCP4OperatorSpec, ok bool, err error) {
+	routeMetric := opts.RouteMetric()
+	if routeMetric == 0 {
+		routeMetric = network.DefaultRouteMetric
+	}
+
+	clientID, ok, err := resolveDHCP4ClientIdentifier(opts, linkName, linkStatuses, sysInfo)
+	if err != nil || !ok {
+		return network.DHCP4OperatorSpec{}, false, err
+	}
+
+	return network.DHCP4OperatorSpec{
+		RouteMetric:                 routeMetric,
+		IgnoreClasslessStaticRoutes: opts.IgnoreClasslessStaticRoutes(),
+		OptimisticLease:             opts.OptimisticLease(),
+		ClientIdentifier:            clientID,
+		SkipSendHostname:            !opts.SendHostname(),
+		RequestedOptions:            opts.RequestedOptions(),
+	}, true, nil
+}
+
+// resolveDHCP4ClientIdentifier builds the DHCPv4 client identifier (option 61) from the DHCP options.
+//
+// The `duid` identifier follows RFC 4361: it is built from the DHCPv6 IAID and DUID, and DUID-LL is used
+// if the DUID is not configured.
+func resolveDHCP4ClientIdentifier(
+	opts talosconfig.DHCPOptions, linkName string, linkStatuses safe.List[*network.LinkStatus], sysInfo *hardware.SystemInformation,
+) (clientID string, ok bool, err error) {
+	var clientIDBin []byte
+
+	switch opts.ClientIdentifier() {
+	case "":
+		return "", true, nil
+	case nethelpers.ClientIdentifierTypeMAC, nethelpers.ClientIdentifierTypeDUID:
+		linkStatus, found := linkStatuses.Find(func(link *network.LinkStatus) bool {
+			return link.Metadata().ID() == linkName
+		})
+		if !found {
+			return "", false, nil
+		}
+
+		hwAddr := net.HardwareAddr(linkStatus.TypedSpec().PermanentAddr)
+		if len(hwAddr) == 0 {
+			hwAddr = net.HardwareAddr(linkStatus.TypedSpec().HardwareAddr)
+		}
+
+		if opts.ClientIdentifier() == nethelpers.ClientIdentifierTypeMAC {
+			clientIDBin, err = nethelpers.ClientIdentifierLL(uint16(linkStatus.TypedSpec().Type), hwAddr)
+
+			break
+		}
+
+		var duid string
+
+		duid, ok, err = resolveDHCP6DUID(opts, linkName, linkStatuses, sysInfo)
+		if err != nil || !ok {
+			return "", false, err
+		}
+
+		var duidBin []byte
+
+		if duid == "" {
+			duidBin, err = nethelpers.DUIDLL(uint16(linkStatus.TypedSpec().Type), hwAddr)
+		} else {
+			duidBin, err = hex.DecodeString(duid)
+		}
+
+		if err != nil {
+			break
+		}
+
+		iaid := opts.IAIDv6()
+		if iaid == 0 && len(hwAddr) >= 4 {
+			iaid = binary.BigEndian.Uint32(hwAddr[len(hwAddr)-4:])
+		}
+
+		clientIDBin, err = nethelpers.ClientIdentifierDUID(iaid, duidBin)
+	default:
+		clientIDBin, err = nethelpers.ParseClientIdentifier(opts.ClientIdentifier())
+	}
+
+	if err != nil {
+		return "", false, fmt.Errorf("link %q: error generating client identifier: %w", linkName, err)
+	}
+
+	return hex.EncodeToString(clientIDBin), true, nil
+}
+
+// resolveDHCP6DUID builds the DUID for the DHCPv6 operator from the DHCP options.
+//
+// If the DUID is generated from the link-layer address or the machine UUID, the result is stable
+// across reboots, so the DHCPv6 server keeps handing out the same lease.
+// If the DUID can't be generated yet (e.g. link is not created yet), ok is false.
+func resolveDHCP6DUID(
+	opts talosconfig.DHCPOptions, linkName string, linkStatuses safe.List[*network.LinkStatus], sysInfo *hardware.SystemInformation,
+) (duid string, ok bool, err error) {
+	var duidBin []byte
+
+	if opts.DUIDv6() != "" {
+		duidBin, err = nethelpers.ParseDUID(opts.DUIDv6())
+		if err != nil {
+			return "", false, fmt.Errorf("link %q: %w", linkName, err)
+		}
+
+		return hex.EncodeToString(duidBin), true, nil
+	}
+
+	switch opts.DUIDv6Type() {
+	case "":
+		// default DUID generated by the DHCPv6 client
+		return "", true, nil
+	case nethelpers.DUIDTypeLL, nethelpers.DUIDTypeLLT:
+		linkStatus, found := linkStatuses.Find(func(link *network.LinkStatus) bool {
+			return link.Metadata().ID() == linkName
+		})
+		if !found {
+			return "", false, nil
+		}
+
+		hwAddr := net.HardwareAddr(linkStatus.TypedSpec().PermanentAddr)
+		if len(hwAddr) == 0 {
+			hwAddr = net.HardwareAddr(linkStatus.TypedSpec().HardwareAddr)
+		}
+
+		if opts.DUIDv6Type() == nethelpers.DUIDTypeLL {
+			duidBin, err = nethelpers.DUIDLL(uint16(linkStatus.TypedSpec().Type), hwAddr)
+		} else {
+			duidBin, err = nethelpers.DUIDLLT(uint16(linkStatus.TypedSpec().Type), hwAddr)
+		}
+	case nethelpers.DUIDTypeUUID:
+		if sysInfo == nil {
+			return "", false, nil
+		}
+
+		duidBin, err = nethelpers.DUIDUUID(sysInfo.TypedSpec().UUID)
+	default:
+		err = nethelpers.ValidateDUIDType(opts.DUIDv6Type())
+	}
+
+	if err != nil {
+		return "", false, fmt.Errorf("link %q: error generating DUID: %w", linkName, err)
+	}
+
+	return hex.EncodeToString(duidBin), true, nil
+}
@@ -6,6 +6,7 @@
 package network_test
 
 import (
+	"net/netip"
 	"net/url"
 	"testing"
 	"time"
@@ -21,8 +22,10 @@ import (
 	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
 	"github.com/siderolabs/talos/pkg/machinery/config/container"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/hardware"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 )
 
@@ -180,8 +183,10 @@ func (suite *OperatorConfigSuite) TestMachineConfigurationDHCP4() {
 								DeviceInterface: "eth3",
 								DeviceDHCP:      pointer.To(true),
 								DeviceDHCPOptions: &v1alpha1.DHCPOptions{
-									DHCPIPv4:        pointer.To(true),
-									DHCPRouteMetric: 256,
+									DHCPIPv4:                        pointer.To(true),
+									DHCPRouteMetric:                 256,
+									DHCPIgnoreClasslessStaticRoutes: pointer.To(true),
+									DHCPOptimisticLease:             pointer.To(true),
 								},
 							},
 							{
@@ -235,9 +240,12 @@ func (suite *OperatorConfigSuite) TestMachineConfigurationDHCP4() {
 			case "configuration/dhcp4/eth1":
 				asrt.Equal("eth1", r.TypedSpec().LinkName)
 				asrt.EqualValues(network.DefaultRouteMetric, r.TypedSpec().DHCP4.RouteMetric)
+				asrt.False(r.TypedSpec().DHCP4.IgnoreClasslessStaticRoutes)
 			case "configuration/dhcp4/eth3":
 				asrt.Equal("eth3", r.TypedSpec().LinkName)
 				asrt.EqualValues(256, r.TypedSpec().DHCP4.RouteMetric)
+				asrt.True(r.TypedSpec().DHCP4.IgnoreClasslessStaticRoutes)
+				asrt.True(r.TypedSpec().DHCP4.OptimisticLease)
 			case "configuration/dhcp4/eth4.25":
 				asrt.Equal("eth4.25", r.TypedSpec().LinkName)
 				asrt.EqualValues(network.DefaultRouteMetric, r.TypedSpec().DHCP4.RouteMetric)
@@ -339,6 +347,284 @@ func (suite *OperatorConfigSuite) TestMachineConfigurationDHCP6() {
 	)
 }
 
+func (suite *OperatorConfigSuite) TestMachineConfigurationStaticFallback() {
+	suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.OperatorConfigController{}))
+
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(
+		container.NewV1Alpha1(
+			&v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "eth0",
+								DeviceAddresses: []string{"192.168.1.10/24"},
+								DeviceRoutes: []*v1alpha1.Route{
+									{
+										RouteNetwork: "10.0.0.0/8",
+										RouteGateway: "192.168.1.2",
+									},
+									{
+										RouteGateway: "192.168.1.1",
+									},
+								},
+								DeviceDHCPOptions: &v1alpha1.DHCPOptions{
+									DHCPRouteMetric: 256,
+								},
+								DeviceDHCPFallback: &v1alpha1.DeviceDHCPFallbackConfig{
+									FallbackEnabled:      true,
+									FallbackProbeTimeout: 5 * time.Second,
+								},
+							},
+							{
+								DeviceInterface: "eth1",
+								DeviceAddresses: []string{"192.168.2.10/24"},
+								DeviceRoutes: []*v1alpha1.Route{
+									{
+										RouteGateway: "192.168.2.1",
+									},
+								},
+								DeviceDHCPFallback: &v1alpha1.DeviceDHCPFallbackConfig{},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							URL: u,
+						},
+					},
+				},
+			},
+		),
+	)
+
+	suite.Create(cfg)
+
+	suite.assertOperators(
+		[]string{
+			"configuration/static-fallback/eth0",
+		}, func(r *network.OperatorSpec, asrt *assert.Assertions) {
+			spec := r.TypedSpec()
+
+			asrt.Equal(network.OperatorStaticFallback, spec.Operator)
+			asrt.Equal("eth0", spec.LinkName)
+			asrt.True(spec.RequireUp)
+			asrt.EqualValues(256, spec.DHCP4.RouteMetric)
+
+			asrt.Equal(netip.MustParseAddr("192.168.1.1"), spec.StaticFallback.Gateway)
+			asrt.Equal(5*time.Second, spec.StaticFallback.ProbeTimeout)
+			asrt.Equal(constants.DHCPFallbackDefaultProbeInterval, spec.StaticFallback.ProbeInterval)
+
+			if asrt.Len(spec.StaticFallback.Addresses, 1) {
+				asrt.Equal(netip.MustParsePrefix("192.168.1.10/24"), spec.StaticFallback.Addresses[0].Address)
+				asrt.Equal(network.ConfigOperator, spec.StaticFallback.Addresses[0].ConfigLayer)
+			}
+
+			if asrt.Len(spec.StaticFallback.Routes, 2) {
+				asrt.Equal(netip.MustParsePrefix("10.0.0.0/8"), spec.StaticFallback.Routes[0].Destination)
+				asrt.Equal(netip.MustParseAddr("192.168.1.1"), spec.StaticFallback.Routes[1].Gateway)
+				asrt.Equal("eth0", spec.StaticFallback.Routes[1].OutLinkName)
+			}
+		},
+	)
+
+	suite.assertNoOperators(
+		[]string{
+			"configuration/static-fallback/eth1",
+			"configuration/dhcp4/eth0",
+		},
+	)
+}
+
+func (suite *OperatorConfigSuite) TestMachineConfigurationDHCP6DUID() {
+	suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.OperatorConfigController{}))
+
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	dhcp6Device := func(name string, opts v1alpha1.DHCPOptions) *v1alpha1.Device {
+		opts.DHCPIPv4 = pointer.To(false)
+		opts.DHCPIPv6 = pointer.To(true)
+
+		return &v1alpha1.Device{
+			DeviceInterface:   name,
+			DeviceDHCP:        pointer.To(true),
+			DeviceDHCPOptions: &opts,
+		}
+	}
+
+	cfg := config.NewMachineConfig(
+		container.NewV1Alpha1(
+			&v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							dhcp6Device("eth0", v1alpha1.DHCPOptions{DHCPDUIDv6: "00:03:00:01:52:54:00:aa:bb:cc", DHCPIAIDv6: 42}),
+							dhcp6Device("eth1", v1alpha1.DHCPOptions{DHCPDUIDv6Type: nethelpers.DUIDTypeLL}),
+							dhcp6Device("eth2", v1alpha1.DHCPOptions{DHCPDUIDv6Type: nethelpers.DUIDTypeLLT}),
+							dhcp6Device("eth3", v1alpha1.DHCPOptions{DHCPDUIDv6Type: nethelpers.DUIDTypeUUID}),
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							URL: u,
+						},
+					},
+				},
+			},
+		),
+	)
+
+	suite.Create(cfg)
+
+	// link-layer and UUID based DUIDs can't be generated yet
+	suite.assertOperators(
+		[]string{
+			"configuration/dhcp6/eth0",
+		}, func(r *network.OperatorSpec, asrt *assert.Assertions) {
+			asrt.Equal("00030001525400aabbcc", r.TypedSpec().DHCP6.DUID)
+			asrt.EqualValues(42, r.TypedSpec().DHCP6.IAID)
+		},
+	)
+
+	suite.assertNoOperators(
+		[]string{
+			"configuration/dhcp6/eth1",
+			"configuration/dhcp6/eth2",
+			"configuration/dhcp6/eth3",
+		},
+	)
+
+	for _, link := range []string{"eth1", "eth2"} {
+		linkStatus := network.NewLinkStatus(network.NamespaceName, link)
+		linkStatus.TypedSpec().Type = nethelpers.LinkEther
+		linkStatus.TypedSpec().HardwareAddr = nethelpers.HardwareAddr{0x52, 0x54, 0x00, 0x12, 0x34, 0x56}
+
+		suite.Create(linkStatus)
+	}
+
+	sysInfo := hardware.NewSystemInformation(hardware.SystemInformationID)
+	sysInfo.TypedSpec().UUID = "00112233-4455-6677-8899-aabbccddeeff"
+
+	suite.Create(sysInfo)
+
+	suite.assertOperators(
+		[]string{
+			"configuration/dhcp6/eth1",
+			"configuration/dhcp6/eth2",
+			"configuration/dhcp6/eth3",
+		}, func(r *network.OperatorSpec, asrt *assert.Assertions) {
+			switch r.Metadata().ID() {
+			case "configuration/dhcp6/eth1":
+				asrt.Equal("00030001525400123456", r.TypedSpec().DHCP6.DUID)
+			case "configuration/dhcp6/eth2":
+				asrt.Equal("0001000100000000525400123456", r.TypedSpec().DHCP6.DUID)
+			case "configuration/dhcp6/eth3":
+				asrt.Equal("000400112233445566778899aabbccddeeff", r.TypedSpec().DHCP6.DUID)
+			}
+
+			asrt.Zero(r.TypedSpec().DHCP6.IAID)
+		},
+	)
+}
+
+func (suite *OperatorConfigSuite) TestMachineConfigurationDHCP4ClientIdentifier() {
+	suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.OperatorConfigController{}))
+
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	dhcp4Device := func(name string, opts v1alpha1.DHCPOptions) *v1alpha1.Device {
+		return &v1alpha1.Device{
+			DeviceInterface:   name,
+			DeviceDHCP:        pointer.To(true),
+			DeviceDHCPOptions: &opts,
+		}
+	}
+
+	cfg := config.NewMachineConfig(
+		container.NewV1Alpha1(
+			&v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							dhcp4Device("eth0", v1alpha1.DHCPOptions{
+								DHCPClientIdentifier: "node-1",
+								DHCPSendHostname:     pointer.To(false),
+								DHCPRequestedOptions: []uint32{1, 3, 6},
+							}),
+							dhcp4Device("eth1", v1alpha1.DHCPOptions{DHCPClientIdentifier: nethelpers.ClientIdentifierTypeMAC}),
+							dhcp4Device("eth2", v1alpha1.DHCPOptions{DHCPClientIdentifier: nethelpers.ClientIdentifierTypeDUID, DHCPIAIDv6: 42}),
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							URL: u,
+						},
+					},
+				},
+			},
+		),
+	)
+
+	suite.Create(cfg)
+
+	// link-layer based client identifiers can't be generated yet
+	suite.assertOperators(
+		[]string{
+			"configuration/dhcp4/eth0",
+		}, func(r *network.OperatorSpec, asrt *assert.Assertions) {
+			asrt.Equal("006e6f64652d31", r.TypedSpec().DHCP4.ClientIdentifier)
+			asrt.True(r.TypedSpec().DHCP4.SkipSendHostname)
+			asrt.Equal([]uint32{1, 3, 6}, r.TypedSpec().DHCP4.RequestedOptions)
+		},
+	)
+
+	suite.assertNoOperators(
+		[]string{
+			"configuration/dhcp4/eth1",
+			"configuration/dhcp4/eth2",
+		},
+	)
+
+	for _, link := range []string{"eth1", "eth2"} {
+		linkStatus := network.NewLinkStatus(network.NamespaceName, link)
+		linkStatus.TypedSpec().Type = nethelpers.LinkEther
+		linkStatus.TypedSpec().HardwareAddr = nethelpers.HardwareAddr{0x52, 0x54, 0x00, 0x12, 0x34, 0x56}
+
+		suite.Create(linkStatus)
+	}
+
+	suite.assertOperators(
+		[]string{
+			"configuration/dhcp4/eth1",
+			"configuration/dhcp4/eth2",
+		}, func(r *network.OperatorSpec, asrt *assert.Assertions) {
+			switch r.Metadata().ID() {
+			case "configuration/dhcp4/eth1":
+				asrt.Equal("01525400123456", r.TypedSpec().DHCP4.ClientIdentifier)
+			case "configuration/dhcp4/eth2":
+				asrt.Equal("ff0000002a00030001525400123456", r.TypedSpec().DHCP4.ClientIdentifier)
+			}
+
+			asrt.False(r.TypedSpec().DHCP4.SkipSendHostname)
+			asrt.Empty(r.TypedSpec().DHCP4.RequestedOptions)
+		},
+	)
+}
+
 func (suite *OperatorConfigSuite) TestMachineConfigurationWithAliases() {
 	suite.Require().NoError(
 		suite.Runtime().RegisterController(
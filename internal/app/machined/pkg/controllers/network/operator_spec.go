@@ -81,6 +81,18 @@ func (ctrl *OperatorSpecController) Outputs() []controller.Output {
 			Type: network.TimeServerSpecType,
 			Kind: controller.OutputShared,
 		},
+		{
+			Type: network.DHCP4LeaseType,
+			Kind: controller.OutputShared,
+		},
+		{
+			Type: network.VIPStatusType,
+			Kind: controller.OutputExclusive,
+		},
+		{
+			Type: network.StaticFallbackStatusType,
+			Kind: controller.OutputExclusive,
+		},
 	}
 }
 
@@ -239,6 +251,16 @@ func (ctrl *OperatorSpecController) reconcileOperators(ctx context.Context, r co
 
 			// stop operator
 			ctrl.operators[id].Stop()
+
+			// the lease is bound to the client identifier, so release it before acquiring a new one with the new identifier
+			if ctrl.operators[id].Spec.DHCP4.ClientIdentifier != shouldRun[id].DHCP4.ClientIdentifier {
+				if releaseOperator, ok := ctrl.operators[id].Operator.(operator.ReleaseOperator); ok {
+					if err = releaseOperator.Release(); err != nil {
+						logger.Warn("failed to release the lease", zap.String("operator", id), zap.Error(err))
+					}
+				}
+			}
+
 			delete(ctrl.operators, id)
 		}
 	}
@@ -366,18 +388,62 @@ func (ctrl *OperatorSpecController) reconcileOperatorOutputs(ctx context.Context
 				return fmt.Errorf("error applying spec: %w", err)
 			}
 		}
+
+		if leaseOperator, ok := op.Operator.(operator.LeaseOperator); ok {
+			for _, leaseSpec := range leaseOperator.DHCP4LeaseSpecs() {
+				if err := apply(
+					network.NewDHCP4Lease(network.NamespaceName, leaseSpec.LinkName),
+					func(r resource.Resource) {
+						*r.(*network.DHCP4Lease).TypedSpec() = leaseSpec
+					},
+				); err != nil {
+					return fmt.Errorf("error applying lease: %w", err)
+				}
+			}
+		}
+
+		if statusOperator, ok := op.Operator.(operator.VIPStatusOperator); ok {
+			for _, statusSpec := range statusOperator.VIPStatusSpecs() {
+				if err := apply(
+					network.NewVIPStatus(network.NamespaceName, statusSpec.IP.String()),
+					func(r resource.Resource) {
+						*r.(*network.VIPStatus).TypedSpec() = statusSpec
+					},
+				); err != nil {
+					return fmt.Errorf("error applying status: %w", err)
+				}
+			}
+		}
+
+		if statusOperator, ok := op.Operator.(operator.StaticFallbackStatusOperator); ok {
+			for _, statusSpec := range statusOperator.StaticFallbackStatusSpecs() {
+				if err := apply(
+					network.NewStaticFallbackStatus(network.NamespaceName, statusSpec.LinkName),
+					func(r resource.Resource) {
+						*r.(*network.StaticFallbackStatus).TypedSpec() = statusSpec
+					},
+				); err != nil {
+					return fmt.Errorf("error applying status: %w", err)
+				}
+			}
+		}
 	}
 
 	// clean up not touched specs
-	for _, resourceType := range []resource.Type{
-		network.AddressSpecType,
-		network.LinkSpecType,
-		network.RouteSpecType,
-		network.HostnameSpecType,
-		network.ResolverSpecType,
-		network.TimeServerSpecType,
+	for _, md := range []*resource.Metadata{
+		resource.NewMetadata(network.ConfigNamespaceName, network.AddressSpecType, "", resource.VersionUndefined),
+		resource.NewMetadata(network.ConfigNamespaceName, network.LinkSpecType, "", resource.VersionUndefined),
+		resource.NewMetadata(network.ConfigNamespaceName, network.RouteSpecType, "", resource.VersionUndefined),
+		resource.NewMetadata(network.ConfigNamespaceName, network.HostnameSpecType, "", resource.VersionUndefined),
+		resource.NewMetadata(network.ConfigNamespaceName, network.ResolverSpecType, "", resource.VersionUndefined),
+		resource.NewMetadata(network.ConfigNamespaceName, network.TimeServerSpecType, "", resource.VersionUndefined),
+		resource.NewMetadata(network.NamespaceName, network.DHCP4LeaseType, "", resource.VersionUndefined),
+		resource.NewMetadata(network.NamespaceName, network.VIPStatusType, "", resource.VersionUndefined),
+		resource.NewMetadata(network.NamespaceName, network.StaticFallbackStatusType, "", resource.VersionUndefined),
 	} {
-		list, err := r.List(ctx, resource.NewMetadata(network.ConfigNamespaceName, resourceType, "", resource.VersionUndefined))
+		resourceType := md.Type()
+
+		list, err := r.List(ctx, md)
 		if err != nil {
 			return fmt.Errorf("error listing specs: %w", err)
 		}
@@ -423,6 +489,15 @@ func (ctrl *OperatorSpecController) newOperator(logger *zap.Logger, spec *networ
 		logger = logger.With(zap.String("operator", "vip"))
 
 		return operator.NewVIP(logger, spec.LinkName, spec.VIP, ctrl.State)
+	case network.OperatorStaticFallback:
+		dhcp4Logger := logger.With(zap.String("operator", "dhcp4"))
+		logger = logger.With(zap.String("operator", "static-fallback"))
+
+		linkName, dhcp4Config := spec.LinkName, spec.DHCP4
+
+		return operator.NewStaticFallback(logger, spec.LinkName, spec.StaticFallback, func() operator.Operator {
+			return operator.NewDHCP4(dhcp4Logger, linkName, dhcp4Config, ctrl.V1alpha1Platform, ctrl.State)
+		}, operator.ProbeGatewayARP)
 	default:
 		panic(fmt.Sprintf("unexpected operator %s", spec.Operator))
 	}
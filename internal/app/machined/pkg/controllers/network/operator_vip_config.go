@@ -225,6 +225,8 @@ func handleVIP(ctx context.Context, vlanConfig talosconfig.VIPConfig, deviceName
 		VIP: network.VIPOperatorSpec{
 			IP:            sharedIP,
 			GratuitousARP: true,
+			Priority:      vlanConfig.Priority(),
+			Preempt:       vlanConfig.Preempt(),
 		},
 		ConfigLayer: network.ConfigMachineConfiguration,
 	}
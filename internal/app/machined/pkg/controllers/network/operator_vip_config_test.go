@@ -75,7 +75,9 @@ func (suite *OperatorVIPConfigSuite) TestMachineConfigurationVIP() {
 								DeviceInterface: "eth2",
 								DeviceDHCP:      pointer.To(true),
 								DeviceVIPConfig: &v1alpha1.DeviceVIPConfig{
-									SharedIP: "fd7a:115c:a1e0:ab12:4843:cd96:6277:2302",
+									SharedIP:    "fd7a:115c:a1e0:ab12:4843:cd96:6277:2302",
+									VIPPriority: 100,
+									VIPPreempt:  pointer.To(false),
 								},
 							},
 							{
@@ -127,6 +129,8 @@ func (suite *OperatorVIPConfigSuite) TestMachineConfigurationVIP() {
 			case "configuration/vip/eth1":
 				asrt.Equal("eth1", r.TypedSpec().LinkName)
 				asrt.EqualValues(netip.MustParseAddr("2.3.4.5"), r.TypedSpec().VIP.IP)
+				asrt.Zero(r.TypedSpec().VIP.Priority)
+				asrt.True(r.TypedSpec().VIP.Preempt)
 			case "configuration/vip/eth5":
 				asrt.Equal("eth5", r.TypedSpec().LinkName)
 				asrt.EqualValues(netip.MustParseAddr("2.3.4.5"), r.TypedSpec().VIP.IP)
@@ -136,6 +140,8 @@ func (suite *OperatorVIPConfigSuite) TestMachineConfigurationVIP() {
 					netip.MustParseAddr("fd7a:115c:a1e0:ab12:4843:cd96:6277:2302"),
 					r.TypedSpec().VIP.IP,
 				)
+				asrt.EqualValues(100, r.TypedSpec().VIP.Priority)
+				asrt.False(r.TypedSpec().VIP.Preempt)
 			case "configuration/vip/eth3.26":
 				asrt.Equal("eth3.26", r.TypedSpec().LinkName)
 				asrt.EqualValues(netip.MustParseAddr("5.5.4.4"), r.TypedSpec().VIP.IP)
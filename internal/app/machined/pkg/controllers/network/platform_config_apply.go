@@ -221,6 +221,7 @@ func (ctrl *PlatformConfigApplyController) apply(ctx context.Context, r controll
 
 					*spec = newSpec.(network.RouteSpecSpec) //nolint:forcetypeassert
 					spec.ConfigLayer = network.ConfigPlatform
+					spec.Origin = network.RouteOriginPlatform
 
 					return nil
 				}
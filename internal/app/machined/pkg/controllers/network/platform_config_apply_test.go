@@ -152,6 +152,7 @@ func (suite *PlatformConfigApplySuite) TestRoutes() {
 
 		asrt.Equal("10.0.0.1", spec.Gateway.String())
 		asrt.Equal(network.ConfigPlatform, spec.ConfigLayer)
+		asrt.Equal(network.RouteOriginPlatform, spec.Origin)
 	}, rtestutils.WithNamespace(network.ConfigNamespaceName))
 }
 
@@ -0,0 +1,115 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	configtypes "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// ProbeConfigController manages network.ProbeSpec based on machine configuration.
+type ProbeConfigController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *ProbeConfigController) Name() string {
+	return "network.ProbeConfigController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *ProbeConfigController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.ActiveID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *ProbeConfigController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.ProbeSpecType,
+			Kind: controller.OutputShared,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *ProbeConfigController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		r.StartTrackingOutputs()
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.ActiveID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error reading machine configuration: %w", err)
+		}
+
+		if cfg != nil {
+			if err = ctrl.apply(ctx, r, cfg.Config().NetworkProbeConfigs()); err != nil {
+				return fmt.Errorf("error applying ProbeSpec: %w", err)
+			}
+		}
+
+		if err = safe.CleanupOutputs[*network.ProbeSpec](ctx, r); err != nil {
+			return fmt.Errorf("error cleaning up ProbeSpec: %w", err)
+		}
+	}
+}
+
+func (ctrl *ProbeConfigController) apply(ctx context.Context, r controller.Runtime, configs []configtypes.NetworkProbeConfig) error {
+	for _, cfg := range configs {
+		if err := safe.WriterModify(ctx, r, network.NewProbeSpec(network.NamespaceName, cfg.Name()), func(spec *network.ProbeSpec) error {
+			spec.TypedSpec().Interval = cfg.Interval()
+			spec.TypedSpec().FailureThreshold = cfg.FailureThreshold()
+			spec.TypedSpec().ConfigLayer = network.ConfigMachineConfiguration
+			spec.TypedSpec().TCP = network.TCPProbeSpec{}
+			spec.TypedSpec().HTTP = network.HTTPProbeSpec{}
+			spec.TypedSpec().ICMP = network.ICMPProbeSpec{}
+
+			switch {
+			case cfg.TCPEndpoint() != "":
+				spec.TypedSpec().TCP = network.TCPProbeSpec{
+					Endpoint: cfg.TCPEndpoint(),
+					Timeout:  cfg.Timeout(),
+				}
+			case cfg.HTTPURL() != "":
+				spec.TypedSpec().HTTP = network.HTTPProbeSpec{
+					URL:     cfg.HTTPURL(),
+					Timeout: cfg.Timeout(),
+				}
+			case cfg.ICMPHost() != "":
+				spec.TypedSpec().ICMP = network.ICMPProbeSpec{
+					Host:    cfg.ICMPHost(),
+					Timeout: cfg.Timeout(),
+				}
+			}
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error writing ProbeSpec: %w", err)
+		}
+	}
+
+	return nil
+}
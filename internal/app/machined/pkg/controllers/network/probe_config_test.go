@@ -0,0 +1,93 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	networkcfg "github.com/siderolabs/talos/pkg/machinery/config/types/network"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type ProbeConfigSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *ProbeConfigSuite) TestReconcile() {
+	cfg1 := networkcfg.NewProbeConfigV1Alpha1("gateway")
+	cfg1.ProbeTCP = &networkcfg.ProbeTCPConfig{TCPEndpoint: "10.5.0.1:6443"}
+
+	ctr, err := container.New(cfg1)
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(ctr)
+	suite.Create(cfg)
+
+	ctest.AssertResource(suite, "gateway", func(spec *network.ProbeSpec, asrt *assert.Assertions) {
+		asrt.Equal(network.ProbeSpecSpec{
+			Interval:         10 * time.Second,
+			FailureThreshold: 3,
+			TCP: network.TCPProbeSpec{
+				Endpoint: "10.5.0.1:6443",
+				Timeout:  5 * time.Second,
+			},
+			ConfigLayer: network.ConfigMachineConfiguration,
+		}, *spec.TypedSpec())
+	})
+
+	cfg2 := networkcfg.NewProbeConfigV1Alpha1("registry")
+	cfg2.ProbeInterval = 30 * time.Second
+	cfg2.ProbeTimeout = 10 * time.Second
+	cfg2.ProbeFailureThreshold = 1
+	cfg2.ProbeHTTP = &networkcfg.ProbeHTTPConfig{HTTPURL: "https://registry.example.com/v2/"}
+
+	ctr, err = container.New(cfg1, cfg2)
+	suite.Require().NoError(err)
+
+	cfgNew := config.NewMachineConfig(ctr)
+	cfgNew.Metadata().SetVersion(cfg.Metadata().Version())
+	suite.Update(cfgNew)
+
+	ctest.AssertResources(suite, []string{"gateway", "registry"}, func(spec *network.ProbeSpec, asrt *assert.Assertions) {
+		asrt.Equal(network.ConfigMachineConfiguration, spec.TypedSpec().ConfigLayer)
+	})
+	ctest.AssertResource(suite, "registry", func(spec *network.ProbeSpec, asrt *assert.Assertions) {
+		asrt.Equal(network.ProbeSpecSpec{
+			Interval:         30 * time.Second,
+			FailureThreshold: 1,
+			HTTP: network.HTTPProbeSpec{
+				URL:     "https://registry.example.com/v2/",
+				Timeout: 10 * time.Second,
+			},
+			ConfigLayer: network.ConfigMachineConfiguration,
+		}, *spec.TypedSpec())
+	})
+
+	suite.Destroy(cfgNew)
+
+	ctest.AssertNoResource[*network.ProbeSpec](suite, "gateway")
+	ctest.AssertNoResource[*network.ProbeSpec](suite, "registry")
+}
+
+func TestProbeConfigSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, &ProbeConfigSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 10 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.ProbeConfigController{}))
+			},
+		},
+	})
+}
@@ -0,0 +1,100 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// ProbeEventController reports the network probe transitions between the success and failure states to the events stream.
+type ProbeEventController struct {
+	V1Alpha1Events runtime.Publisher
+
+	states map[resource.ID]bool
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *ProbeEventController) Name() string {
+	return "network.ProbeEventController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *ProbeEventController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.ProbeStatusType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *ProbeEventController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *ProbeEventController) Run(ctx context.Context, r controller.Runtime, _ *zap.Logger) error {
+	ctrl.states = map[resource.ID]bool{}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		statuses, err := safe.ReaderListAll[*network.ProbeStatus](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error listing probe statuses: %w", err)
+		}
+
+		seen := map[resource.ID]struct{}{}
+
+		for status := range statuses.All() {
+			spec := status.TypedSpec()
+
+			seen[status.Metadata().ID()] = struct{}{}
+
+			previous, ok := ctrl.states[status.Metadata().ID()]
+			if ok && previous == spec.Success {
+				continue
+			}
+
+			ctrl.states[status.Metadata().ID()] = spec.Success
+
+			// the probe succeeding for the first time is not a transition
+			if !ok && spec.Success {
+				continue
+			}
+
+			ctrl.V1Alpha1Events.Publish(ctx, &machine.ProbeEvent{
+				Id:                  status.Metadata().ID(),
+				Success:             spec.Success,
+				LastError:           spec.LastError,
+				ConsecutiveFailures: int64(spec.ConsecutiveFailures),
+			})
+		}
+
+		for id := range ctrl.states {
+			if _, ok := seen[id]; !ok {
+				delete(ctrl.states, id)
+			}
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
@@ -38,9 +38,9 @@ func (suite *ProbeSuite) TestReconcile() {
 	suite.Require().NoError(suite.State().Create(suite.Ctx(), probeGoogle))
 
 	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{googleProbeSpecID}, func(r *network.ProbeStatus, assert *assert.Assertions) {
-		assert.Equal(network.ProbeStatusSpec{
-			Success: true,
-		}, *r.TypedSpec())
+		assert.True(r.TypedSpec().Success)
+		assert.Empty(r.TypedSpec().LastError)
+		assert.Zero(r.TypedSpec().ConsecutiveFailures)
 	})
 
 	failingProbeSpec := network.ProbeSpecSpec{
@@ -60,15 +60,16 @@ func (suite *ProbeSuite) TestReconcile() {
 
 	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{failingProbeSpecID}, func(r *network.ProbeStatus, assert *assert.Assertions) {
 		assert.False(r.TypedSpec().Success)
+		assert.Positive(r.TypedSpec().ConsecutiveFailures)
 	})
 
 	probeFailing.TypedSpec().TCP.Endpoint = "google.com:443"
 	suite.Require().NoError(suite.State().Update(suite.Ctx(), probeFailing))
 
 	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{failingProbeSpecID}, func(r *network.ProbeStatus, assert *assert.Assertions) {
-		assert.Equal(network.ProbeStatusSpec{
-			Success: true,
-		}, *r.TypedSpec())
+		assert.True(r.TypedSpec().Success)
+		assert.Empty(r.TypedSpec().LastError)
+		assert.Zero(r.TypedSpec().ConsecutiveFailures)
 	})
 
 	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), probeFailing.Metadata()))
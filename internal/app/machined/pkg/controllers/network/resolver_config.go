@@ -220,8 +220,16 @@ func (ctrl *ResolverConfigController) parseMachineConfiguration(logger *zap.Logg
 
 	resolvers := cfgProvider.Machine().Network().Resolvers()
 	searchDomains := cfgProvider.Machine().Network().SearchDomains()
+	resolverOptions := cfgProvider.Machine().Network().ResolverOptions()
 
-	if len(resolvers) == 0 && len(searchDomains) == 0 {
+	spec.Options = network.ResolverOptions{
+		Rotate:         resolverOptions.Rotate(),
+		Timeout:        resolverOptions.Timeout(),
+		Attempts:       resolverOptions.Attempts(),
+		UpstreamPolicy: resolverOptions.UpstreamPolicy(),
+	}
+
+	if len(resolvers) == 0 && len(searchDomains) == 0 && spec.Options.IsZero() {
 		return spec, false
 	}
 
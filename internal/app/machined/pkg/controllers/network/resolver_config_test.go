@@ -232,6 +232,10 @@ func (suite *ResolverConfigSuite) TestMachineConfiguration() {
 					MachineNetwork: &v1alpha1.NetworkConfig{
 						NameServers: []string{"2.2.2.2", "3.3.3.3"},
 						Searches:    []string{"example.com", "example.org"},
+						NetworkResolverOptions: &v1alpha1.ResolverOptionsConfig{
+							ResolverTimeout:        2 * time.Second,
+							ResolverUpstreamPolicy: "parallel",
+						},
 					},
 				},
 				ClusterConfig: &v1alpha1.ClusterConfig{
@@ -262,12 +266,21 @@ func (suite *ResolverConfigSuite) TestMachineConfiguration() {
 				[]string{"example.com", "example.org"},
 				r.TypedSpec().SearchDomains,
 			)
+
+			asrt.Equal(
+				network.ResolverOptions{
+					Timeout:        2 * time.Second,
+					UpstreamPolicy: "parallel",
+				},
+				r.TypedSpec().Options,
+			)
 		},
 	)
 
 	ctest.UpdateWithConflicts(suite, cfg, func(r *config.MachineConfig) error {
 		r.Container().RawV1Alpha1().MachineConfig.MachineNetwork.NameServers = nil
 		r.Container().RawV1Alpha1().MachineConfig.MachineNetwork.Searches = nil
+		r.Container().RawV1Alpha1().MachineConfig.MachineNetwork.NetworkResolverOptions = nil
 
 		return nil
 	})
@@ -40,6 +40,11 @@ func NewResolverMergeController() controller.Controller {
 
 				final.SearchDomains = slices.Insert(final.SearchDomains, 0, spec.SearchDomains...)
 
+				// options are not merged, the highest layer which sets them wins
+				if !spec.Options.IsZero() {
+					final.Options = spec.Options
+				}
+
 				if spec.ConfigLayer == final.ConfigLayer {
 					// simply append server lists on the same layer
 					final.DNSServers = append(final.DNSServers, spec.DNSServers...)
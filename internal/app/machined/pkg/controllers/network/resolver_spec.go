@@ -95,6 +95,7 @@ func (ctrl *ResolverSpecController) Run(ctx context.Context, r controller.Runtim
 				if err = safe.WriterModify(ctx, r, network.NewResolverStatus(network.NamespaceName, spec.Metadata().ID()), func(r *network.ResolverStatus) error {
 					r.TypedSpec().DNSServers = spec.TypedSpec().DNSServers
 					r.TypedSpec().SearchDomains = spec.TypedSpec().SearchDomains
+					r.TypedSpec().Options = spec.TypedSpec().Options
 
 					return nil
 				}); err != nil {
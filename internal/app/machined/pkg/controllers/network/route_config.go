@@ -203,6 +203,7 @@ func (ctrl *RouteConfigController) parseCmdline(logger *zap.Logger) (routes []ne
 			Type:        nethelpers.TypeUnicast,
 			OutLinkName: linkConfig.LinkName,
 			ConfigLayer: network.ConfigCmdline,
+			Origin:      network.RouteOriginCmdline,
 		}
 
 		if defaultGatewayRoute.Gateway.Is6() {
@@ -228,6 +229,7 @@ func (ctrl *RouteConfigController) parseCmdline(logger *zap.Logger) (routes []ne
 				Type:        nethelpers.TypeUnicast,
 				Protocol:    nethelpers.ProtocolBoot,
 				ConfigLayer: network.ConfigCmdline,
+				Origin:      network.RouteOriginCmdline,
 			})
 		}
 	}
@@ -237,83 +239,30 @@ func (ctrl *RouteConfigController) parseCmdline(logger *zap.Logger) (routes []ne
 
 //nolint:gocyclo,cyclop
 func (ctrl *RouteConfigController) processDevicesConfiguration(logger *zap.Logger, devices []talosconfig.Device) (routes []network.RouteSpecSpec) {
-	convert := func(linkName string, in talosconfig.Route) (route network.RouteSpecSpec, err error) {
-		if in.Network() != "" {
-			route.Destination, err = netip.ParsePrefix(in.Network())
-			if err != nil {
-				return route, fmt.Errorf("error parsing route network: %w", err)
-			}
-		}
-
-		if in.Gateway() != "" {
-			route.Gateway, err = netip.ParseAddr(in.Gateway())
-			if err != nil {
-				return route, fmt.Errorf("error parsing route gateway: %w", err)
-			}
-		}
-
-		if in.Source() != "" {
-			route.Source, err = netip.ParseAddr(in.Source())
-			if err != nil {
-				return route, fmt.Errorf("error parsing route source: %w", err)
-			}
-		}
-
-		normalizedFamily := route.Normalize()
-
-		route.Priority = in.Metric()
-		if route.Priority == 0 {
-			route.Priority = network.DefaultRouteMetric
-		}
-
-		route.MTU = in.MTU()
-
-		switch {
-		case !value.IsZero(route.Gateway) && route.Gateway.Is6():
-			route.Family = nethelpers.FamilyInet6
-		case !value.IsZero(route.Destination) && route.Destination.Addr().Is6():
-			route.Family = nethelpers.FamilyInet6
-		case normalizedFamily != 0:
-			route.Family = normalizedFamily
-		default:
-			route.Family = nethelpers.FamilyInet4
-		}
-
-		route.Table = nethelpers.TableMain
-		route.Protocol = nethelpers.ProtocolStatic
-		route.OutLinkName = linkName
-		route.ConfigLayer = network.ConfigMachineConfiguration
-
-		route.Type = nethelpers.TypeUnicast
-
-		if route.Destination.Addr().IsMulticast() {
-			route.Type = nethelpers.TypeMulticast
-		}
-
-		return route, nil
-	}
-
 	for _, device := range devices {
 		if device.Ignore() {
 			continue
 		}
 
-		for _, route := range device.Routes() {
-			routeSpec, err := convert(device.Interface(), route)
-			if err != nil {
-				logger.Sugar().Infof("skipping route %q -> %q on interface %q: %s", route.Network(), route.Gateway(), device.Interface(), err)
+		// static routes of the interface with DHCP fallback are managed by the static fallback operator
+		if !staticFallbackEnabled(device) {
+			for _, route := range device.Routes() {
+				routeSpec, err := convertRouteConfig(device.Interface(), route)
+				if err != nil {
+					logger.Sugar().Infof("skipping route %q -> %q on interface %q: %s", route.Network(), route.Gateway(), device.Interface(), err)
 
-				continue
-			}
+					continue
+				}
 
-			routes = append(routes, routeSpec)
+				routes = append(routes, routeSpec)
+			}
 		}
 
 		for _, vlan := range device.Vlans() {
 			vlanLinkName := nethelpers.VLANLinkName(device.Interface(), vlan.ID())
 
 			for _, route := range vlan.Routes() {
-				routeSpec, err := convert(vlanLinkName, route)
+				routeSpec, err := convertRouteConfig(vlanLinkName, route)
 				if err != nil {
 					logger.Sugar().Infof("skipping route %q -> %q on interface %q: %s", route.Network(), route.Gateway(), vlanLinkName, err)
 
@@ -327,3 +276,63 @@ func (ctrl *RouteConfigController) processDevicesConfiguration(logger *zap.Logge
 
 	return routes
 }
+
+// convertRouteConfig converts the route from the machine configuration to the route spec.
+//
+//nolint:gocyclo
+func convertRouteConfig(linkName string, in talosconfig.Route) (route network.RouteSpecSpec, err error) {
+	if in.Network() != "" {
+		route.Destination, err = netip.ParsePrefix(in.Network())
+		if err != nil {
+			return route, fmt.Errorf("error parsing route network: %w", err)
+		}
+	}
+
+	if in.Gateway() != "" {
+		route.Gateway, err = netip.ParseAddr(in.Gateway())
+		if err != nil {
+			return route, fmt.Errorf("error parsing route gateway: %w", err)
+		}
+	}
+
+	if in.Source() != "" {
+		route.Source, err = netip.ParseAddr(in.Source())
+		if err != nil {
+			return route, fmt.Errorf("error parsing route source: %w", err)
+		}
+	}
+
+	normalizedFamily := route.Normalize()
+
+	route.Priority = in.Metric()
+	if route.Priority == 0 {
+		route.Priority = network.DefaultRouteMetric
+	}
+
+	route.MTU = in.MTU()
+
+	switch {
+	case !value.IsZero(route.Gateway) && route.Gateway.Is6():
+		route.Family = nethelpers.FamilyInet6
+	case !value.IsZero(route.Destination) && route.Destination.Addr().Is6():
+		route.Family = nethelpers.FamilyInet6
+	case normalizedFamily != 0:
+		route.Family = normalizedFamily
+	default:
+		route.Family = nethelpers.FamilyInet4
+	}
+
+	route.Table = in.Table()
+	route.Protocol = nethelpers.ProtocolStatic
+	route.OutLinkName = linkName
+	route.ConfigLayer = network.ConfigMachineConfiguration
+	route.Origin = network.RouteOriginStatic
+
+	route.Type = nethelpers.TypeUnicast
+
+	if route.Destination.Addr().IsMulticast() {
+		route.Type = nethelpers.TypeMulticast
+	}
+
+	return route, nil
+}
@@ -87,6 +87,7 @@ func (suite *RouteConfigSuite) TestCmdline() {
 			"cmdline/inet4/10.3.5.1//1026",
 		}, func(r *network.RouteSpec, asrt *assert.Assertions) {
 			asrt.Equal(network.ConfigCmdline, r.TypedSpec().ConfigLayer)
+			asrt.Equal(network.RouteOriginCmdline, r.TypedSpec().Origin)
 			asrt.Equal(nethelpers.FamilyInet4, r.TypedSpec().Family)
 
 			switch r.Metadata().ID() {
@@ -209,6 +210,11 @@ func (suite *RouteConfigSuite) TestMachineConfiguration() {
 										RouteGateway: "192.244.0.1",
 										RouteSource:  "192.244.0.10",
 									},
+									{
+										RouteNetwork: "10.9.0.0/24",
+										RouteGateway: "192.244.0.1",
+										RouteTable:   100,
+									},
 								},
 							},
 						},
@@ -234,6 +240,7 @@ func (suite *RouteConfigSuite) TestMachineConfiguration() {
 			"configuration/inet4/192.168.0.25/192.168.0.0/18/25",
 			"configuration/inet4/192.244.0.1/192.244.0.0/24/1024",
 			"configuration/inet4//169.254.254.254/32/1024",
+			"configuration/100/inet4/192.244.0.1/10.9.0.0/24/1024",
 		}, func(r *network.RouteSpec, asrt *assert.Assertions) {
 			switch r.Metadata().ID() {
 			case "configuration/inet6/2001:470:6d:30e:8ed2:b60c:9d2f:803b//1024":
@@ -259,9 +266,13 @@ func (suite *RouteConfigSuite) TestMachineConfiguration() {
 				asrt.EqualValues(network.DefaultRouteMetric, r.TypedSpec().Priority)
 				asrt.Equal(nethelpers.ScopeLink, r.TypedSpec().Scope)
 				asrt.Equal("169.254.254.254/32", r.TypedSpec().Destination.String())
+			case "configuration/100/inet4/192.244.0.1/10.9.0.0/24/1024":
+				asrt.Equal("eth1", r.TypedSpec().OutLinkName)
+				asrt.EqualValues(100, r.TypedSpec().Table)
 			}
 
 			asrt.Equal(network.ConfigMachineConfiguration, r.TypedSpec().ConfigLayer)
+			asrt.Equal(network.RouteOriginStatic, r.TypedSpec().Origin)
 		},
 	)
 }
@@ -5,38 +5,191 @@
 package network
 
 import (
+	"cmp"
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
 	"go.uber.org/zap"
 
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 )
 
 // NewRouteMergeController initializes a RouteMergeController.
 //
 // RouteMergeController merges network.RouteSpec in network.ConfigNamespace and produces final network.RouteSpec in network.Namespace.
+//
+// Routes of different origins (static, DHCP, platform, etc.) to the same destination with the same metric are ordered
+// by the route source precedence from the machine configuration, so that the result doesn't depend on the order the routes appear in.
+//
+// Default routes of the links which don't own the default route (as selected by the default route configuration) are demoted or removed.
 func NewRouteMergeController() controller.Controller {
-	return GenericMergeController(
+	return GenericMergeControllerWithInputs(
 		network.ConfigNamespaceName,
 		network.NamespaceName,
-		func(logger *zap.Logger, list safe.List[*network.RouteSpec]) map[resource.ID]*network.RouteSpecSpec {
-			// route is allowed as long as it's not duplicate, for duplicate higher layer takes precedence
-			routes := map[string]*network.RouteSpecSpec{}
+		[]controller.Input{
+			{
+				Namespace: config.NamespaceName,
+				Type:      config.MachineConfigType,
+				ID:        optional.Some(config.ActiveID),
+				Kind:      controller.InputWeak,
+			},
+			{
+				Namespace: network.NamespaceName,
+				Type:      network.LinkStatusType,
+				Kind:      controller.InputWeak,
+			},
+		},
+		func(ctx context.Context, r controller.Reader, _ *zap.Logger, list safe.List[*network.RouteSpec]) (map[resource.ID]*network.RouteSpecSpec, error) {
+			cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.ActiveID)
+			if err != nil && !state.IsNotFoundError(err) {
+				return nil, fmt.Errorf("error getting config: %w", err)
+			}
 
-			for route := range list.All() {
-				id := network.RouteID(route.TypedSpec().Table, route.TypedSpec().Family, route.TypedSpec().Destination, route.TypedSpec().Gateway, route.TypedSpec().Priority, route.TypedSpec().OutLinkName)
+			linkStatuses, err := safe.ReaderListAll[*network.LinkStatus](ctx, r)
+			if err != nil {
+				return nil, fmt.Errorf("error listing link statuses: %w", err)
+			}
+
+			var (
+				sources            []talosconfig.RouteSource
+				defaultRouteConfig talosconfig.NetworkDefaultRouteConfig
+			)
 
-				existing, ok := routes[id]
-				if ok && existing.ConfigLayer > route.TypedSpec().ConfigLayer {
-					// skip this route, as existing one is higher layer
-					continue
+			if cfg != nil {
+				if cfg.Config().NetworkRouteSourceConfig() != nil {
+					sources = cfg.Config().NetworkRouteSourceConfig().RouteSources()
 				}
 
-				routes[id] = route.TypedSpec()
+				defaultRouteConfig = cfg.Config().NetworkDefaultRouteConfig()
 			}
 
-			return routes
+			return mergeRoutes(list, newRouteSourcePolicy(sources), newDefaultRoutePolicy(defaultRouteConfig, linkStatuses)), nil
 		},
 	)
 }
+
+// routeSourcePolicy defines the precedence and the route metric overrides of the route origins.
+type routeSourcePolicy struct {
+	precedence []string
+	metrics    map[string]uint32
+}
+
+func newRouteSourcePolicy(sources []talosconfig.RouteSource) routeSourcePolicy {
+	policy := routeSourcePolicy{
+		metrics: map[string]uint32{},
+	}
+
+	for _, source := range sources {
+		policy.precedence = append(policy.precedence, source.Name)
+
+		if source.RouteMetric != 0 {
+			policy.metrics[source.Name] = source.RouteMetric
+		}
+	}
+
+	// origins which are not configured explicitly keep the default order
+	for _, origin := range network.DefaultRouteOriginPrecedence {
+		if !slices.Contains(policy.precedence, origin) {
+			policy.precedence = append(policy.precedence, origin)
+		}
+	}
+
+	return policy
+}
+
+// rank returns the rank of the origin, lower rank takes precedence.
+func (policy routeSourcePolicy) rank(origin string) int {
+	if idx := slices.Index(policy.precedence, origin); idx != -1 {
+		return idx
+	}
+
+	return len(policy.precedence)
+}
+
+// compare orders the routes with the same ID: higher config layer first, then higher precedence origin first.
+func (policy routeSourcePolicy) compare(a, b *network.RouteSpecSpec) int {
+	return cmp.Or(
+		cmp.Compare(b.ConfigLayer, a.ConfigLayer),
+		cmp.Compare(policy.rank(a.Origin), policy.rank(b.Origin)),
+	)
+}
+
+func mergeRoutes(list safe.List[*network.RouteSpec], policy routeSourcePolicy, defaultRoutes defaultRoutePolicy) map[resource.ID]*network.RouteSpecSpec {
+	// route is allowed as long as it's not duplicate, for duplicate higher layer takes precedence
+	routes := map[resource.ID]*network.RouteSpecSpec{}
+
+	for route := range list.All() {
+		spec := *route.TypedSpec()
+
+		id := network.RouteID(spec.Table, spec.Family, spec.Destination, spec.Gateway, spec.Priority, spec.OutLinkName)
+
+		if existing, ok := routes[id]; ok && policy.compare(existing, &spec) <= 0 {
+			// skip this route, as existing one takes precedence
+			continue
+		}
+
+		routes[id] = &spec
+	}
+
+	// default routes of the links which don't own the default route are demoted or suppressed
+	for id, spec := range routes {
+		if !defaultRoutes.apply(spec) {
+			delete(routes, id)
+		}
+	}
+
+	type tieKey struct {
+		table       nethelpers.RoutingTable
+		family      nethelpers.Family
+		destination netip.Prefix
+		priority    uint32
+	}
+
+	// override the metric of the routes which use the default one, and collect the origins of the routes which have the same metric
+	tieOrigins := map[tieKey][]string{}
+
+	for _, spec := range routes {
+		if metric, ok := policy.metrics[spec.Origin]; ok && spec.Priority == network.DefaultRouteMetric {
+			spec.Priority = metric
+		}
+
+		key := tieKey{spec.Table, spec.Family, spec.Destination, spec.Priority}
+
+		if !slices.Contains(tieOrigins[key], spec.Origin) {
+			tieOrigins[key] = append(tieOrigins[key], spec.Origin)
+		}
+	}
+
+	for _, origins := range tieOrigins {
+		slices.SortFunc(origins, func(a, b string) int {
+			return cmp.Or(cmp.Compare(policy.rank(a), policy.rank(b)), cmp.Compare(a, b))
+		})
+	}
+
+	// routes of the lower precedence origins get a higher metric, so that the kernel prefers the higher precedence one
+	merged := make(map[resource.ID]*network.RouteSpecSpec, len(routes))
+
+	for _, spec := range routes {
+		spec.Priority += uint32(slices.Index(tieOrigins[tieKey{spec.Table, spec.Family, spec.Destination, spec.Priority}], spec.Origin))
+
+		id := network.RouteID(spec.Table, spec.Family, spec.Destination, spec.Gateway, spec.Priority, spec.OutLinkName)
+
+		if existing, ok := merged[id]; ok && policy.compare(existing, spec) <= 0 {
+			continue
+		}
+
+		merged[id] = spec
+	}
+
+	return merged
+}
@@ -5,19 +5,25 @@
 package network_test
 
 import (
+	"maps"
 	"math/rand/v2"
 	"net/netip"
+	"slices"
 	"testing"
 	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/siderolabs/go-pointer"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
 	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	networkcfg "github.com/siderolabs/talos/pkg/machinery/config/types/network"
 	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 )
 
@@ -215,6 +221,168 @@ func (suite *RouteMergeSuite) TestMergeFlapping() {
 	testMergeFlapping(&suite.DefaultSuite, []*network.RouteSpec{cmdline, dhcp}, "inet4/10.5.0.3//50", dhcp)
 }
 
+func (suite *RouteMergeSuite) TestMergeOrigins() {
+	// default routes of different origins with the same metric
+	newDefaultRoute := func(id, gateway, linkName string, layer network.ConfigLayer, origin string) *network.RouteSpec {
+		route := network.NewRouteSpec(network.ConfigNamespaceName, id)
+		*route.TypedSpec() = network.RouteSpecSpec{
+			Gateway:     netip.MustParseAddr(gateway),
+			OutLinkName: linkName,
+			Family:      nethelpers.FamilyInet4,
+			Scope:       nethelpers.ScopeGlobal,
+			Type:        nethelpers.TypeUnicast,
+			Table:       nethelpers.TableMain,
+			Priority:    network.DefaultRouteMetric,
+			ConfigLayer: layer,
+			Origin:      origin,
+		}
+
+		return route
+	}
+
+	routes := []*network.RouteSpec{
+		newDefaultRoute("platform/inet4/10.0.2.1//1024", "10.0.2.1", "eth2", network.ConfigPlatform, network.RouteOriginPlatform),
+		newDefaultRoute("dhcp4/eth1/inet4/10.0.1.1//1024", "10.0.1.1", "eth1", network.ConfigOperator, network.RouteOriginDHCP4),
+		newDefaultRoute("configuration/inet4/10.0.0.1//1024", "10.0.0.1", "eth0", network.ConfigMachineConfiguration, network.RouteOriginStatic),
+	}
+
+	assertMetrics := func(expected map[string]uint32) {
+		suite.assertRoutes(slices.Collect(maps.Keys(expected)), func(r *network.RouteSpec, asrt *assert.Assertions) {
+			asrt.Equal(expected[r.Metadata().ID()], r.TypedSpec().Priority)
+		})
+	}
+
+	// the result doesn't depend on the order the routes appear in
+	for range 5 {
+		for _, idx := range rand.Perm(len(routes)) {
+			suite.Create(routes[idx])
+		}
+
+		assertMetrics(map[string]uint32{
+			"inet4/10.0.0.1//1024": 1024,
+			"inet4/10.0.1.1//1025": 1025,
+			"inet4/10.0.2.1//1026": 1026,
+		})
+
+		for _, idx := range rand.Perm(len(routes)) {
+			suite.Destroy(routes[idx])
+		}
+
+		suite.assertNoRoute("inet4/10.0.0.1//1024")
+		suite.assertNoRoute("inet4/10.0.1.1//1025")
+		suite.assertNoRoute("inet4/10.0.2.1//1026")
+	}
+
+	for _, route := range routes {
+		suite.Create(route)
+	}
+
+	// prefer DHCP routes with an overridden metric, and platform routes over static routes
+	routeSourceConfig := networkcfg.NewRouteSourceConfigV1Alpha1()
+	routeSourceConfig.SourcesConfig = []networkcfg.RouteSourceEntry{
+		{
+			SourceName:        network.RouteOriginDHCP4,
+			SourceRouteMetric: 100,
+		},
+		{
+			SourceName: network.RouteOriginPlatform,
+		},
+	}
+
+	ctr, err := container.New(routeSourceConfig)
+	suite.Require().NoError(err)
+
+	suite.Create(config.NewMachineConfig(ctr))
+
+	assertMetrics(map[string]uint32{
+		"inet4/10.0.1.1//100":  100,
+		"inet4/10.0.2.1//1024": 1024,
+		"inet4/10.0.0.1//1025": 1025,
+	})
+
+	suite.assertNoRoute("inet4/10.0.0.1//1024")
+	suite.assertNoRoute("inet4/10.0.1.1//1025")
+	suite.assertNoRoute("inet4/10.0.2.1//1026")
+}
+
+func (suite *RouteMergeSuite) TestMergeDefaultRoute() {
+	newDefaultRoute := func(id, gateway, linkName string) *network.RouteSpec {
+		route := network.NewRouteSpec(network.ConfigNamespaceName, id)
+		*route.TypedSpec() = network.RouteSpecSpec{
+			Gateway:     netip.MustParseAddr(gateway),
+			OutLinkName: linkName,
+			Family:      nethelpers.FamilyInet4,
+			Scope:       nethelpers.ScopeGlobal,
+			Type:        nethelpers.TypeUnicast,
+			Table:       nethelpers.TableMain,
+			Priority:    network.DefaultRouteMetric,
+			ConfigLayer: network.ConfigOperator,
+			Origin:      network.RouteOriginDHCP4,
+		}
+
+		return route
+	}
+
+	for _, route := range []*network.RouteSpec{
+		newDefaultRoute("dhcp4/eth0/inet4/10.0.0.1//1024", "10.0.0.1", "eth0"),
+		newDefaultRoute("dhcp4/eth1/inet4/10.0.1.1//1024", "10.0.1.1", "eth1"),
+	} {
+		suite.Create(route)
+	}
+
+	linkStatuses := map[string]*network.LinkStatus{}
+
+	for _, link := range []string{"eth0", "eth1"} {
+		linkStatus := network.NewLinkStatus(network.NamespaceName, link)
+		linkStatus.TypedSpec().OperationalState = nethelpers.OperStateUp
+
+		suite.Create(linkStatus)
+
+		linkStatuses[link] = linkStatus
+	}
+
+	// eth1 owns the default route, eth0 default route is demoted
+	defaultRouteConfig := networkcfg.NewDefaultRouteConfigV1Alpha1()
+	defaultRouteConfig.LinksConfig = []string{"eth1", "eth0"}
+	defaultRouteConfig.FailoverConfig = pointer.To(true)
+
+	ctr, err := container.New(defaultRouteConfig)
+	suite.Require().NoError(err)
+
+	machineConfig := config.NewMachineConfig(ctr)
+	suite.Create(machineConfig)
+
+	suite.assertRoutes([]string{"inet4/10.0.1.1//1024", "inet4/10.0.0.1//4096"}, func(*network.RouteSpec, *assert.Assertions) {})
+	suite.assertNoRoute("inet4/10.0.0.1//1024")
+
+	// eth1 loses carrier, default route fails over to eth0
+	linkStatuses["eth1"].TypedSpec().OperationalState = nethelpers.OperStateDown
+	suite.Update(linkStatuses["eth1"])
+
+	suite.assertRoutes([]string{"inet4/10.0.0.1//1024", "inet4/10.0.1.1//4096"}, func(*network.RouteSpec, *assert.Assertions) {})
+	suite.assertNoRoute("inet4/10.0.1.1//1024")
+
+	// carrier is restored, default route moves back to eth1
+	linkStatuses["eth1"].TypedSpec().OperationalState = nethelpers.OperStateUp
+	suite.Update(linkStatuses["eth1"])
+
+	suite.assertRoutes([]string{"inet4/10.0.1.1//1024", "inet4/10.0.0.1//4096"}, func(*network.RouteSpec, *assert.Assertions) {})
+
+	// suppress eth0 default route
+	defaultRouteConfig.OtherRoutesConfig = networkcfg.DefaultRouteOtherRoutesSuppress
+
+	ctr, err = container.New(defaultRouteConfig)
+	suite.Require().NoError(err)
+
+	newMachineConfig := config.NewMachineConfig(ctr)
+	newMachineConfig.Metadata().SetVersion(machineConfig.Metadata().Version())
+	suite.Update(newMachineConfig)
+
+	suite.assertRoutes([]string{"inet4/10.0.1.1//1024"}, func(*network.RouteSpec, *assert.Assertions) {})
+	suite.assertNoRoute("inet4/10.0.0.1//4096")
+	suite.assertNoRoute("inet4/10.0.0.1//1024")
+}
+
 func TestRouteMergeSuite(t *testing.T) {
 	t.Parallel()
 
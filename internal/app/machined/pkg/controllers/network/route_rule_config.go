@@ -0,0 +1,158 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// RouteRuleConfigController manages network.RouteRuleSpec based on machine configuration.
+type RouteRuleConfigController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *RouteRuleConfigController) Name() string {
+	return "network.RouteRuleConfigController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *RouteRuleConfigController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.ActiveID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *RouteRuleConfigController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.RouteRuleSpecType,
+			Kind: controller.OutputShared,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *RouteRuleConfigController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		r.StartTrackingOutputs()
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.ActiveID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error reading machine configuration: %w", err)
+		}
+
+		if cfg != nil && cfg.Config().Machine() != nil {
+			for _, spec := range ctrl.processMachineConfiguration(logger, cfg.Config().Machine().Network().RouteRules()) {
+				id := network.LayeredID(spec.ConfigLayer, network.RouteRuleID(spec.Family, spec.Priority))
+
+				if err = safe.WriterModify(ctx, r, network.NewRouteRuleSpec(network.ConfigNamespaceName, id), func(r *network.RouteRuleSpec) error {
+					*r.TypedSpec() = spec
+
+					return nil
+				}); err != nil {
+					return fmt.Errorf("error writing route rule spec: %w", err)
+				}
+			}
+		}
+
+		if err = safe.CleanupOutputs[*network.RouteRuleSpec](ctx, r); err != nil {
+			return fmt.Errorf("error cleaning up route rule specs: %w", err)
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func (ctrl *RouteRuleConfigController) processMachineConfiguration(logger *zap.Logger, rules []talosconfig.RouteRule) []network.RouteRuleSpecSpec {
+	var specs []network.RouteRuleSpecSpec
+
+	parsePrefix := func(in string) (netip.Prefix, error) {
+		if in == "" {
+			return netip.Prefix{}, nil
+		}
+
+		prefix, err := netip.ParsePrefix(in)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+
+		return prefix.Masked(), nil
+	}
+
+	for _, rule := range rules {
+		source, err := parsePrefix(rule.From())
+		if err != nil {
+			logger.Info("skipping route rule", zap.Uint32("priority", rule.Priority()), zap.Error(err))
+
+			continue
+		}
+
+		destination, err := parsePrefix(rule.To())
+		if err != nil {
+			logger.Info("skipping route rule", zap.Uint32("priority", rule.Priority()), zap.Error(err))
+
+			continue
+		}
+
+		var families []nethelpers.Family
+
+		switch {
+		case source.IsValid():
+			families = []nethelpers.Family{familyOf(source.Addr())}
+		case destination.IsValid():
+			families = []nethelpers.Family{familyOf(destination.Addr())}
+		default:
+			// rule without address selectors is installed for both address families
+			families = []nethelpers.Family{nethelpers.FamilyInet4, nethelpers.FamilyInet6}
+		}
+
+		for _, family := range families {
+			specs = append(specs, network.RouteRuleSpecSpec{
+				Family:      family,
+				Source:      source,
+				Destination: destination,
+				Table:       rule.Table(),
+				Priority:    rule.Priority(),
+				FwMark:      rule.FwMark(),
+				FwMask:      rule.FwMask(),
+				ConfigLayer: network.ConfigMachineConfiguration,
+			})
+		}
+	}
+
+	return specs
+}
+
+func familyOf(addr netip.Addr) nethelpers.Family {
+	if addr.Is6() {
+		return nethelpers.FamilyInet6
+	}
+
+	return nethelpers.FamilyInet4
+}
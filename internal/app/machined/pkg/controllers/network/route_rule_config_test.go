@@ -0,0 +1,110 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net/netip"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type RouteRuleConfigSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *RouteRuleConfigSuite) TestMachineConfiguration() {
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(
+		container.NewV1Alpha1(
+			&v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkRouteRules: []*v1alpha1.RouteRule{
+							{
+								RuleFrom:     "10.9.0.1/24",
+								RulePriority: 1000,
+								RuleTable:    100,
+							},
+							{
+								RuleFwMark:   0x100,
+								RulePriority: 1001,
+								RuleTable:    101,
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							URL: u,
+						},
+					},
+				},
+			},
+		),
+	)
+
+	suite.Create(cfg)
+
+	ctest.AssertResources(suite,
+		[]string{
+			"configuration/inet4/01000",
+			"configuration/inet4/01001",
+			"configuration/inet6/01001",
+		},
+		func(r *network.RouteRuleSpec, asrt *assert.Assertions) {
+			asrt.Equal(network.ConfigMachineConfiguration, r.TypedSpec().ConfigLayer)
+
+			switch r.Metadata().ID() {
+			case "configuration/inet4/01000":
+				asrt.Equal(nethelpers.FamilyInet4, r.TypedSpec().Family)
+				asrt.Equal(netip.MustParsePrefix("10.9.0.0/24"), r.TypedSpec().Source)
+				asrt.EqualValues(100, r.TypedSpec().Table)
+				asrt.Zero(r.TypedSpec().FwMark)
+			case "configuration/inet4/01001", "configuration/inet6/01001":
+				asrt.False(r.TypedSpec().Source.IsValid())
+				asrt.EqualValues(101, r.TypedSpec().Table)
+				asrt.EqualValues(0x100, r.TypedSpec().FwMark)
+				asrt.EqualValues(0xffffffff, r.TypedSpec().FwMask)
+			}
+		},
+		rtestutils.WithNamespace(network.ConfigNamespaceName),
+	)
+
+	suite.Destroy(cfg)
+
+	for _, id := range []string{"configuration/inet4/01000", "configuration/inet4/01001", "configuration/inet6/01001"} {
+		ctest.AssertNoResource[*network.RouteRuleSpec](suite, id, rtestutils.WithNamespace(network.ConfigNamespaceName))
+	}
+}
+
+func TestRouteRuleConfigSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, &RouteRuleConfigSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 10 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.RouteRuleConfigController{}))
+			},
+		},
+	})
+}
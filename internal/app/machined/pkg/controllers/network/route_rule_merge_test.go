@@ -0,0 +1,93 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type RouteRuleMergeSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *RouteRuleMergeSuite) TestMerge() {
+	platform := network.NewRouteRuleSpec(network.ConfigNamespaceName, "platform/inet4/01000")
+	*platform.TypedSpec() = network.RouteRuleSpecSpec{
+		Family:      nethelpers.FamilyInet4,
+		Source:      netip.MustParsePrefix("10.9.0.0/24"),
+		Table:       100,
+		Priority:    1000,
+		ConfigLayer: network.ConfigPlatform,
+	}
+
+	static := network.NewRouteRuleSpec(network.ConfigNamespaceName, "configuration/inet4/01000")
+	*static.TypedSpec() = network.RouteRuleSpecSpec{
+		Family:      nethelpers.FamilyInet4,
+		Source:      netip.MustParsePrefix("10.9.0.0/24"),
+		Table:       200,
+		Priority:    1000,
+		ConfigLayer: network.ConfigMachineConfiguration,
+	}
+
+	other := network.NewRouteRuleSpec(network.ConfigNamespaceName, "platform/inet6/01000")
+	*other.TypedSpec() = network.RouteRuleSpecSpec{
+		Family:      nethelpers.FamilyInet6,
+		Source:      netip.MustParsePrefix("fd00::/64"),
+		Table:       100,
+		Priority:    1000,
+		ConfigLayer: network.ConfigPlatform,
+	}
+
+	for _, res := range []resource.Resource{platform, static, other} {
+		suite.Create(res)
+	}
+
+	ctest.AssertResources(suite,
+		[]string{
+			"inet4/01000",
+			"inet6/01000",
+		},
+		func(r *network.RouteRuleSpec, asrt *assert.Assertions) {
+			switch r.Metadata().ID() {
+			case "inet4/01000":
+				asrt.EqualValues(200, r.TypedSpec().Table)
+				asrt.Equal(network.ConfigMachineConfiguration, r.TypedSpec().ConfigLayer)
+			case "inet6/01000":
+				asrt.EqualValues(100, r.TypedSpec().Table)
+			}
+		},
+	)
+
+	suite.Destroy(static)
+
+	ctest.AssertResource(suite, "inet4/01000", func(r *network.RouteRuleSpec, asrt *assert.Assertions) {
+		asrt.EqualValues(100, r.TypedSpec().Table)
+		asrt.Equal(network.ConfigPlatform, r.TypedSpec().ConfigLayer)
+	})
+}
+
+func TestRouteRuleMergeSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, &RouteRuleMergeSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 10 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(netctrl.NewRouteRuleMergeController()))
+			},
+		},
+	})
+}
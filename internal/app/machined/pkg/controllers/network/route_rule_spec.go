@@ -0,0 +1,282 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"os"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/hashicorp/go-multierror"
+	"github.com/jsimonetti/rtnetlink/v2"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/watch"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// rtmgrpIPv6Rule is the legacy multicast group bitmask for IPv6 rule notifications, it's not defined in x/sys/unix.
+const rtmgrpIPv6Rule = 1 << (unix.RTNLGRP_IPV6_RULE - 1)
+
+// RouteRuleSpecController applies network.RouteRuleSpec to the kernel routing policy database.
+type RouteRuleSpecController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *RouteRuleSpecController) Name() string {
+	return "network.RouteRuleSpecController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *RouteRuleSpecController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.RouteRuleSpecType,
+			Kind:      controller.InputStrong,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *RouteRuleSpecController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *RouteRuleSpecController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	// watch rule changes to restore the rules removed outside of Talos
+	watcher, err := watch.NewRtNetlink(watch.NewDefaultRateLimitedTrigger(ctx, r), unix.RTMGRP_IPV4_RULE|rtmgrpIPv6Rule)
+	if err != nil {
+		return err
+	}
+
+	defer watcher.Done()
+
+	conn, err := rtnetlink.Dial(nil)
+	if err != nil {
+		return fmt.Errorf("error dialing rtnetlink socket: %w", err)
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		list, err := safe.ReaderListAll[*network.RouteRuleSpec](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error listing route rule specs: %w", err)
+		}
+
+		// add finalizers for all live resources
+		for rule := range list.All() {
+			if rule.Metadata().Phase() != resource.PhaseRunning {
+				continue
+			}
+
+			if err = r.AddFinalizer(ctx, rule.Metadata(), ctrl.Name()); err != nil {
+				return fmt.Errorf("error adding finalizer: %w", err)
+			}
+		}
+
+		rules, err := conn.Rule.List()
+		if err != nil {
+			return fmt.Errorf("error listing rules: %w", err)
+		}
+
+		var multiErr *multierror.Error
+
+		for rule := range list.All() {
+			if err = ctrl.syncRule(ctx, r, logger, conn, rules, rule); err != nil {
+				multiErr = multierror.Append(multiErr, err)
+			}
+		}
+
+		if err = multiErr.ErrorOrNil(); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+//nolint:gocyclo
+func (ctrl *RouteRuleSpecController) syncRule(ctx context.Context, r controller.Runtime, logger *zap.Logger, conn *rtnetlink.Conn,
+	rules []rtnetlink.RuleMessage, rule *network.RouteRuleSpec,
+) error {
+	spec := rule.TypedSpec()
+
+	logger = logger.With(
+		zap.Stringer("family", spec.Family),
+		zap.Uint32("priority", spec.Priority),
+		zap.Stringer("table", spec.Table),
+	)
+
+	switch rule.Metadata().Phase() {
+	case resource.PhaseTearingDown:
+		for i := range rules {
+			existing := &rules[i]
+
+			if !ruleHasPriority(existing, spec.Family, spec.Priority) || !ruleMatches(existing, spec) {
+				continue
+			}
+
+			if err := conn.Rule.Delete(existing); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return fmt.Errorf("error removing rule: %w", err)
+			}
+
+			logger.Info("deleted route rule")
+		}
+
+		// now remove finalizer as rule was deleted
+		if err := r.RemoveFinalizer(ctx, rule.Metadata(), ctrl.Name()); err != nil {
+			return fmt.Errorf("error removing finalizer: %w", err)
+		}
+	case resource.PhaseRunning:
+		matchFound := false
+
+		for i := range rules {
+			existing := &rules[i]
+
+			if !ruleHasPriority(existing, spec.Family, spec.Priority) {
+				continue
+			}
+
+			if ruleMatches(existing, spec) {
+				matchFound = true
+
+				continue
+			}
+
+			// only remove the rules installed by Talos, e.g. from the previous version of the spec
+			if existing.Attributes == nil || pointer.SafeDeref(existing.Attributes.Protocol) != uint8(nethelpers.ProtocolStatic) {
+				continue
+			}
+
+			if err := conn.Rule.Delete(existing); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return fmt.Errorf("error removing rule: %w", err)
+			}
+
+			logger.Debug("removed route rule due to mismatch")
+		}
+
+		if matchFound {
+			return nil
+		}
+
+		msg := &rtnetlink.RuleMessage{
+			Family:    uint8(spec.Family),
+			SrcLength: uint8(netipPrefixBitsCorrected(spec.Source)),
+			DstLength: uint8(netipPrefixBitsCorrected(spec.Destination)),
+			Action:    unix.FR_ACT_TO_TBL,
+			Attributes: &rtnetlink.RuleAttributes{
+				Table:    pointer.To(uint32(spec.Table)),
+				Priority: pointer.To(spec.Priority),
+				Protocol: pointer.To(uint8(nethelpers.ProtocolStatic)),
+			},
+		}
+
+		if spec.Source.IsValid() {
+			msg.Attributes.Src = pointer.To(net.IP(spec.Source.Addr().AsSlice()))
+		}
+
+		if spec.Destination.IsValid() {
+			msg.Attributes.Dst = pointer.To(net.IP(spec.Destination.Addr().AsSlice()))
+		}
+
+		if spec.FwMark != 0 || spec.FwMask != 0 {
+			msg.Attributes.FwMark = pointer.To(spec.FwMark)
+			msg.Attributes.FwMask = pointer.To(spec.FwMask)
+		}
+
+		if err := conn.Rule.Add(msg); err != nil && !errors.Is(err, os.ErrExist) {
+			return fmt.Errorf("error adding rule: %w", err)
+		}
+
+		logger.Info("created route rule",
+			zap.Stringer("from", spec.Source),
+			zap.Stringer("to", spec.Destination),
+		)
+	}
+
+	return nil
+}
+
+// ruleTable returns the routing table of the rule.
+//
+// The table in the message header is truncated to 8 bits, so the attribute takes precedence.
+func ruleTable(rule *rtnetlink.RuleMessage) nethelpers.RoutingTable {
+	if rule.Attributes != nil && rule.Attributes.Table != nil {
+		return nethelpers.RoutingTable(*rule.Attributes.Table)
+	}
+
+	return nethelpers.RoutingTable(rule.Table)
+}
+
+// ruleSelectors returns source and destination prefixes of the rule.
+func ruleSelectors(rule *rtnetlink.RuleMessage) (src, dst netip.Prefix) {
+	if rule.Attributes == nil {
+		return src, dst
+	}
+
+	if rule.Attributes.Src != nil {
+		if addr, ok := netip.AddrFromSlice(*rule.Attributes.Src); ok {
+			src = netip.PrefixFrom(addr.Unmap(), int(rule.SrcLength))
+		}
+	}
+
+	if rule.Attributes.Dst != nil {
+		if addr, ok := netip.AddrFromSlice(*rule.Attributes.Dst); ok {
+			dst = netip.PrefixFrom(addr.Unmap(), int(rule.DstLength))
+		}
+	}
+
+	return src, dst
+}
+
+func ruleHasPriority(rule *rtnetlink.RuleMessage, family nethelpers.Family, priority uint32) bool {
+	if rule.Family != uint8(family) {
+		return false
+	}
+
+	if rule.Attributes == nil {
+		return priority == 0
+	}
+
+	return pointer.SafeDeref(rule.Attributes.Priority) == priority
+}
+
+func ruleMatches(rule *rtnetlink.RuleMessage, spec *network.RouteRuleSpecSpec) bool {
+	if rule.Action != unix.FR_ACT_TO_TBL || ruleTable(rule) != spec.Table {
+		return false
+	}
+
+	src, dst := ruleSelectors(rule)
+
+	if src != spec.Source || dst != spec.Destination {
+		return false
+	}
+
+	var fwMark, fwMask uint32
+
+	if rule.Attributes != nil {
+		fwMark = pointer.SafeDeref(rule.Attributes.FwMark)
+		fwMask = pointer.SafeDeref(rule.Attributes.FwMask)
+	}
+
+	return fwMark == spec.FwMark && fwMask == spec.FwMask
+}
@@ -0,0 +1,126 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net/netip"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jsimonetti/rtnetlink/v2"
+	"github.com/siderolabs/go-pointer"
+	"github.com/siderolabs/go-retry/retry"
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type RouteRuleSpecSuite struct {
+	ctest.DefaultSuite
+}
+
+// testRulePriority is picked to avoid conflicts with the rules installed on the host.
+const testRulePriority = 31337
+
+func (suite *RouteRuleSpecSuite) findRules() []rtnetlink.RuleMessage {
+	conn, err := rtnetlink.Dial(nil)
+	suite.Require().NoError(err)
+
+	defer conn.Close() //nolint:errcheck
+
+	rules, err := conn.Rule.List()
+	suite.Require().NoError(err)
+
+	var result []rtnetlink.RuleMessage
+
+	for _, rule := range rules {
+		if rule.Family == unix.AF_INET && rule.Attributes != nil && pointer.SafeDeref(rule.Attributes.Priority) == testRulePriority {
+			result = append(result, rule)
+		}
+	}
+
+	return result
+}
+
+func (suite *RouteRuleSpecSuite) assertRuleTable(table uint32) {
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				rules := suite.findRules()
+
+				if len(rules) != 1 {
+					return retry.ExpectedErrorf("expected 1 rule, got %d", len(rules))
+				}
+
+				if pointer.SafeDeref(rules[0].Attributes.Table) != table {
+					return retry.ExpectedErrorf("expected table %d, got %d", table, pointer.SafeDeref(rules[0].Attributes.Table))
+				}
+
+				return nil
+			},
+		),
+	)
+}
+
+func (suite *RouteRuleSpecSuite) TestRule() {
+	rule := network.NewRouteRuleSpec(network.NamespaceName, network.RouteRuleID(nethelpers.FamilyInet4, testRulePriority))
+	*rule.TypedSpec() = network.RouteRuleSpecSpec{
+		Family:      nethelpers.FamilyInet4,
+		Source:      netip.MustParsePrefix("10.9.0.0/24"),
+		Table:       100,
+		Priority:    testRulePriority,
+		ConfigLayer: network.ConfigMachineConfiguration,
+	}
+
+	suite.Create(rule)
+
+	suite.assertRuleTable(100)
+
+	ctest.UpdateWithConflicts(suite, rule, func(r *network.RouteRuleSpec) error {
+		r.TypedSpec().Table = 101
+
+		return nil
+	})
+
+	// the rule should be replaced
+	suite.assertRuleTable(101)
+
+	// teardown the rule
+	for {
+		ready, err := suite.State().Teardown(suite.Ctx(), rule.Metadata())
+		suite.Require().NoError(err)
+
+		if ready {
+			break
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	// torn down rule should be removed immediately
+	suite.Assert().Empty(suite.findRules())
+
+	suite.Destroy(rule)
+}
+
+func TestRouteRuleSpecSuite(t *testing.T) {
+	if os.Geteuid() != 0 {
+		t.Skip("requires root")
+	}
+
+	suite.Run(t, &RouteRuleSpecSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 10 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.RouteRuleSpecController{}))
+			},
+		},
+	})
+}
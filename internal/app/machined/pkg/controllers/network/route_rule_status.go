@@ -0,0 +1,126 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/jsimonetti/rtnetlink/v2"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/watch"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// RouteRuleStatusController reports the routing policy rules installed in the kernel.
+type RouteRuleStatusController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *RouteRuleStatusController) Name() string {
+	return "network.RouteRuleStatusController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *RouteRuleStatusController) Inputs() []controller.Input {
+	return nil
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *RouteRuleStatusController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.RouteRuleStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *RouteRuleStatusController) Run(ctx context.Context, r controller.Runtime, _ *zap.Logger) error {
+	watcher, err := watch.NewRtNetlink(watch.NewDefaultRateLimitedTrigger(ctx, r), unix.RTMGRP_IPV4_RULE|rtmgrpIPv6Rule)
+	if err != nil {
+		return err
+	}
+
+	defer watcher.Done()
+
+	conn, err := rtnetlink.Dial(nil)
+	if err != nil {
+		return fmt.Errorf("error dialing rtnetlink socket: %w", err)
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		r.StartTrackingOutputs()
+
+		rules, err := conn.Rule.List()
+		if err != nil {
+			return fmt.Errorf("error listing rules: %w", err)
+		}
+
+		touchedIDs := map[resource.ID]struct{}{}
+
+		for i := range rules {
+			rule := &rules[i]
+
+			status := network.RouteRuleStatusSpec{
+				Family: nethelpers.Family(rule.Family),
+				Table:  ruleTable(rule),
+			}
+
+			status.Source, status.Destination = ruleSelectors(rule)
+
+			if rule.Attributes != nil {
+				status.Priority = pointer.SafeDeref(rule.Attributes.Priority)
+				status.FwMark = pointer.SafeDeref(rule.Attributes.FwMark)
+				status.FwMask = pointer.SafeDeref(rule.Attributes.FwMask)
+				status.InLinkName = pointer.SafeDeref(rule.Attributes.IIFName)
+				status.OutLinkName = pointer.SafeDeref(rule.Attributes.OIFName)
+				status.Protocol = nethelpers.RouteProtocol(pointer.SafeDeref(rule.Attributes.Protocol))
+			}
+
+			// several rules might have the same priority, disambiguate them
+			id := network.RouteRuleID(status.Family, status.Priority)
+
+			for n := 1; ; n++ {
+				if _, touched := touchedIDs[id]; !touched {
+					break
+				}
+
+				id = fmt.Sprintf("%s/%d", network.RouteRuleID(status.Family, status.Priority), n)
+			}
+
+			touchedIDs[id] = struct{}{}
+
+			if err = safe.WriterModify(ctx, r, network.NewRouteRuleStatus(network.NamespaceName, id), func(r *network.RouteRuleStatus) error {
+				*r.TypedSpec() = status
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error modifying resource: %w", err)
+			}
+		}
+
+		if err = safe.CleanupOutputs[*network.RouteRuleStatus](ctx, r); err != nil {
+			return fmt.Errorf("error cleaning up route rule statuses: %w", err)
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
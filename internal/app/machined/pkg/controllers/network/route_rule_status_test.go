@@ -0,0 +1,60 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type RouteRuleStatusSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *RouteRuleStatusSuite) TestDefaultRules() {
+	// kernel default rules
+	ctest.AssertResources(suite,
+		[]string{
+			"inet4/00000",
+			"inet4/32766",
+			"inet4/32767",
+		},
+		func(r *network.RouteRuleStatus, asrt *assert.Assertions) {
+			asrt.Equal(nethelpers.FamilyInet4, r.TypedSpec().Family)
+			asrt.False(r.TypedSpec().Source.IsValid())
+			asrt.False(r.TypedSpec().Destination.IsValid())
+
+			switch r.Metadata().ID() {
+			case "inet4/00000":
+				asrt.Equal(nethelpers.TableLocal, r.TypedSpec().Table)
+			case "inet4/32766":
+				asrt.Equal(nethelpers.TableMain, r.TypedSpec().Table)
+			case "inet4/32767":
+				asrt.Equal(nethelpers.TableDefault, r.TypedSpec().Table)
+			}
+		},
+	)
+}
+
+func TestRouteRuleStatusSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, &RouteRuleStatusSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 10 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.RouteRuleStatusController{}))
+			},
+		},
+	})
+}
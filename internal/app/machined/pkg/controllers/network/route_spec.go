@@ -131,6 +131,17 @@ func netipPrefixBitsCorrected(p netip.Prefix) int {
 	return p.Bits()
 }
 
+// routeTable returns the routing table of the route.
+//
+// The table in the message header is truncated to 8 bits, so the attribute takes precedence.
+func routeTable(route *rtnetlink.RouteMessage) nethelpers.RoutingTable {
+	if route.Attributes.Table != 0 {
+		return nethelpers.RoutingTable(route.Attributes.Table)
+	}
+
+	return nethelpers.RoutingTable(route.Table)
+}
+
 func findMatchingRoutes(existingRoutes []rtnetlink.RouteMessage, expected *network.RouteSpecSpec) []*rtnetlink.RouteMessage {
 	var result []*rtnetlink.RouteMessage //nolint:prealloc
 
@@ -151,7 +162,7 @@ func findMatchingRoutes(existingRoutes []rtnetlink.RouteMessage, expected *netwo
 			continue
 		}
 
-		if nethelpers.RoutingTable(route.Table) != expected.Table {
+		if routeTable(&route) != expected.Table {
 			continue
 		}
 
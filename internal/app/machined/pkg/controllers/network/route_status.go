@@ -31,7 +31,13 @@ func (ctrl *RouteStatusController) Name() string {
 
 // Inputs implements controller.Controller interface.
 func (ctrl *RouteStatusController) Inputs() []controller.Input {
-	return nil
+	return []controller.Input{
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.RouteSpecType,
+			Kind:      controller.InputWeak,
+		},
+	}
 }
 
 // Outputs implements controller.Controller interface.
@@ -93,6 +99,18 @@ func (ctrl *RouteStatusController) Run(ctx context.Context, r controller.Runtime
 			itemsToDelete[r.Metadata().ID()] = struct{}{}
 		}
 
+		// route specs are used to report the origin of the routes
+		specs, err := safe.ReaderListAll[*network.RouteSpec](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error listing route specs: %w", err)
+		}
+
+		originLookup := make(map[resource.ID]string, specs.Len())
+
+		for spec := range specs.All() {
+			originLookup[spec.Metadata().ID()] = spec.TypedSpec().Origin
+		}
+
 		routes, err := conn.Route.List()
 		if err != nil {
 			return fmt.Errorf("error listing routes: %w", err)
@@ -105,7 +123,9 @@ func (ctrl *RouteStatusController) Run(ctx context.Context, r controller.Runtime
 			gatewayAddr, _ := netip.AddrFromSlice(route.Attributes.Gateway)
 			outLinkName := linkLookup[route.Attributes.OutIface]
 
-			id := network.RouteID(nethelpers.RoutingTable(route.Table), nethelpers.Family(route.Family), dstPrefix, gatewayAddr, route.Attributes.Priority, outLinkName)
+			table := routeTable(&route)
+
+			id := network.RouteID(table, nethelpers.Family(route.Family), dstPrefix, gatewayAddr, route.Attributes.Priority, outLinkName)
 
 			if err = safe.WriterModify(ctx, r, network.NewRouteStatus(network.NamespaceName, id), func(r *network.RouteStatus) error {
 				status := r.TypedSpec()
@@ -117,11 +137,16 @@ func (ctrl *RouteStatusController) Run(ctx context.Context, r controller.Runtime
 				status.OutLinkIndex = route.Attributes.OutIface
 				status.OutLinkName = outLinkName
 				status.Priority = route.Attributes.Priority
-				status.Table = nethelpers.RoutingTable(route.Table)
+				status.Table = table
 				status.Scope = nethelpers.Scope(route.Scope)
 				status.Type = nethelpers.RouteType(route.Type)
 				status.Protocol = nethelpers.RouteProtocol(route.Protocol)
 				status.Flags = nethelpers.RouteFlags(route.Flags)
+				status.Origin = originLookup[id]
+
+				if status.Origin == "" && status.Protocol == nethelpers.ProtocolKernel {
+					status.Origin = network.RouteOriginKernel
+				}
 
 				if route.Attributes.Metrics != nil {
 					status.MTU = route.Attributes.Metrics.MTU
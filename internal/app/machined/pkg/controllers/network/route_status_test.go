@@ -74,6 +74,7 @@ func (suite *RouteStatusSuite) TestRoutes() {
 			asrt.Equal(nethelpers.ScopeHost, r.TypedSpec().Scope)
 			asrt.Equal(nethelpers.TypeLocal, r.TypedSpec().Type)
 			asrt.Equal(nethelpers.ProtocolKernel, r.TypedSpec().Protocol)
+			asrt.Equal(network.RouteOriginKernel, r.TypedSpec().Origin)
 			asrt.EqualValues(0, r.TypedSpec().MTU)
 		},
 	)
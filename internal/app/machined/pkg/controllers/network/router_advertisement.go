@@ -0,0 +1,544 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"net/netip"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/siderolabs/gen/xslices"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/ra"
+	"github.com/siderolabs/talos/pkg/machinery/kernel"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// RouterAdvertisementController processes IPv6 router advertisements for the links which have router advertisements configured.
+//
+// The kernel keeps handling the SLAAC addresses, while the default routers (honoring the router preference),
+// route information options and recursive DNS servers are handled by the controller.
+type RouterAdvertisementController struct {
+	// Listener receives router advertisements, defaults to the raw ICMPv6 socket.
+	Listener ra.Listener
+
+	configs map[string]raLinkConfig
+	links   map[string]*raLink
+}
+
+type raLinkConfig struct {
+	accept      bool
+	acceptRDNSS bool
+}
+
+type raLink struct {
+	routers map[netip.Addr]*raRouter
+}
+
+type raRouter struct {
+	preference        ra.Preference
+	lifetime          time.Duration
+	expires           time.Time
+	lastAdvertisement time.Time
+
+	routes     map[netip.Prefix]raRoute
+	dnsServers []raDNSServer
+}
+
+type raRoute struct {
+	preference ra.Preference
+	expires    time.Time
+}
+
+type raDNSServer struct {
+	addr    netip.Addr
+	expires time.Time
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *RouterAdvertisementController) Name() string {
+	return "network.RouterAdvertisementController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *RouterAdvertisementController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.DeviceConfigSpecType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.LinkStatusType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *RouterAdvertisementController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.RouteSpecType,
+			Kind: controller.OutputShared,
+		},
+		{
+			Type: network.ResolverSpecType,
+			Kind: controller.OutputShared,
+		},
+		{
+			Type: network.RouterStatusType,
+			Kind: controller.OutputExclusive,
+		},
+		{
+			Type: runtime.KernelParamSpecType,
+			Kind: controller.OutputShared,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *RouterAdvertisementController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.Listener == nil {
+		ctrl.Listener = ra.SocketListener{}
+	}
+
+	ctrl.configs = map[string]raLinkConfig{}
+	ctrl.links = map[string]*raLink{}
+
+	packetCh := make(chan ra.Packet)
+	listenErrCh := make(chan error, 1)
+
+	var (
+		listenCancel context.CancelFunc
+		listenWg     sync.WaitGroup
+	)
+
+	stopListener := func() {
+		if listenCancel == nil {
+			return
+		}
+
+		listenCancel()
+		listenWg.Wait()
+
+		listenCancel = nil
+	}
+
+	defer stopListener()
+
+	timer := time.NewTimer(time.Hour)
+	timer.Stop()
+
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+			if err := ctrl.updateConfig(ctx, r); err != nil {
+				return err
+			}
+		case packet := <-packetCh:
+			ctrl.handlePacket(logger, packet, time.Now())
+		case <-timer.C:
+		case err := <-listenErrCh:
+			return fmt.Errorf("error listening for router advertisements: %w", err)
+		}
+
+		switch {
+		case len(ctrl.links) > 0 && listenCancel == nil:
+			var listenCtx context.Context
+
+			listenCtx, listenCancel = context.WithCancel(ctx)
+
+			listenWg.Add(1)
+
+			go func() {
+				defer listenWg.Done()
+
+				if err := ctrl.Listener.Listen(listenCtx, packetCh); err != nil && listenCtx.Err() == nil {
+					select {
+					case listenErrCh <- err:
+					default:
+					}
+				}
+			}()
+		case len(ctrl.links) == 0:
+			stopListener()
+		}
+
+		nextExpiry, err := ctrl.reconcile(ctx, r, logger, time.Now())
+		if err != nil {
+			return err
+		}
+
+		if nextExpiry.IsZero() {
+			timer.Stop()
+		} else {
+			timer.Reset(time.Until(nextExpiry))
+		}
+	}
+}
+
+func (ctrl *RouterAdvertisementController) updateConfig(ctx context.Context, r controller.Runtime) error {
+	linkStatuses, err := safe.ReaderListAll[*network.LinkStatus](ctx, r)
+	if err != nil {
+		return fmt.Errorf("error listing link statuses: %w", err)
+	}
+
+	linkNameResolver := network.NewLinkResolver(linkStatuses.All)
+
+	existingLinks := map[string]struct{}{}
+
+	for link := range linkStatuses.All() {
+		existingLinks[link.Metadata().ID()] = struct{}{}
+	}
+
+	items, err := safe.ReaderListAll[*network.DeviceConfigSpec](ctx, r)
+	if err != nil {
+		return fmt.Errorf("error listing device configs: %w", err)
+	}
+
+	configs := map[string]raLinkConfig{}
+
+	for item := range items.All() {
+		device := item.TypedSpec().Device
+
+		if device.Ignore() || device.RouterAdvertisements() == nil {
+			continue
+		}
+
+		linkName := linkNameResolver.Resolve(device.Interface())
+
+		// kernel params can only be set on the existing links
+		if _, exists := existingLinks[linkName]; !exists {
+			continue
+		}
+
+		configs[linkName] = raLinkConfig{
+			accept:      device.RouterAdvertisements().Accept(),
+			acceptRDNSS: device.RouterAdvertisements().AcceptRDNSS(),
+		}
+	}
+
+	for linkName := range ctrl.links {
+		if !configs[linkName].accept {
+			delete(ctrl.links, linkName)
+		}
+	}
+
+	for linkName, cfg := range configs {
+		if _, exists := ctrl.links[linkName]; cfg.accept && !exists {
+			ctrl.links[linkName] = &raLink{
+				routers: map[netip.Addr]*raRouter{},
+			}
+		}
+	}
+
+	ctrl.configs = configs
+
+	return nil
+}
+
+func expiresAt(now time.Time, lifetime time.Duration) time.Time {
+	if lifetime == ra.InfiniteLifetime {
+		return time.Time{}
+	}
+
+	return now.Add(lifetime)
+}
+
+func (ctrl *RouterAdvertisementController) handlePacket(logger *zap.Logger, packet ra.Packet, now time.Time) {
+	link, ok := ctrl.links[packet.LinkName]
+	if !ok {
+		return
+	}
+
+	// RFC 4861, section 6.1.2: router advertisements should be sent from the link-local address with hop limit 255
+	if packet.HopLimit != 255 || !packet.Source.IsLinkLocalUnicast() {
+		logger.Debug("ignoring invalid router advertisement", zap.String("link", packet.LinkName), zap.Stringer("source", packet.Source))
+
+		return
+	}
+
+	adv, err := ra.Parse(packet.Payload)
+	if err != nil {
+		logger.Debug("failed to parse router advertisement", zap.String("link", packet.LinkName), zap.Stringer("source", packet.Source), zap.Error(err))
+
+		return
+	}
+
+	source := packet.Source.WithZone("")
+
+	router, ok := link.routers[source]
+	if !ok {
+		router = &raRouter{
+			routes: map[netip.Prefix]raRoute{},
+		}
+
+		link.routers[source] = router
+
+		logger.Info("discovered IPv6 router", zap.String("link", packet.LinkName), zap.Stringer("router", source), zap.Stringer("preference", adv.RouterPreference))
+	}
+
+	router.lastAdvertisement = now
+	router.preference = adv.RouterPreference
+	router.lifetime = adv.RouterLifetime
+	router.expires = time.Time{}
+
+	if adv.RouterLifetime > 0 {
+		router.expires = expiresAt(now, adv.RouterLifetime)
+	}
+
+	for _, route := range adv.Routes {
+		if route.Lifetime == 0 {
+			delete(router.routes, route.Prefix)
+
+			continue
+		}
+
+		router.routes[route.Prefix] = raRoute{
+			preference: route.Preference,
+			expires:    expiresAt(now, route.Lifetime),
+		}
+	}
+
+	for _, rdnss := range adv.DNSServers {
+		for _, server := range rdnss.Servers {
+			idx := slices.IndexFunc(router.dnsServers, func(s raDNSServer) bool { return s.addr == server })
+
+			switch {
+			case rdnss.Lifetime == 0 && idx != -1:
+				router.dnsServers = slices.Delete(router.dnsServers, idx, idx+1)
+			case rdnss.Lifetime == 0:
+			case idx != -1:
+				router.dnsServers[idx].expires = expiresAt(now, rdnss.Lifetime)
+			default:
+				router.dnsServers = append(router.dnsServers, raDNSServer{addr: server, expires: expiresAt(now, rdnss.Lifetime)})
+			}
+		}
+	}
+}
+
+// expire removes expired information, and returns the time of the next expiration.
+func (ctrl *RouterAdvertisementController) expire(logger *zap.Logger, now time.Time) time.Time {
+	var nextExpiry time.Time
+
+	expired := func(expires time.Time) bool {
+		if expires.IsZero() {
+			return false
+		}
+
+		if now.Before(expires) {
+			if nextExpiry.IsZero() || expires.Before(nextExpiry) {
+				nextExpiry = expires
+			}
+
+			return false
+		}
+
+		return true
+	}
+
+	for linkName, link := range ctrl.links {
+		for addr, router := range link.routers {
+			if router.lifetime > 0 && expired(router.expires) {
+				logger.Info("IPv6 default router expired", zap.String("link", linkName), zap.Stringer("router", addr))
+
+				router.lifetime = 0
+				router.expires = time.Time{}
+			}
+
+			maps.DeleteFunc(router.routes, func(_ netip.Prefix, route raRoute) bool {
+				return expired(route.expires)
+			})
+
+			router.dnsServers = slices.DeleteFunc(router.dnsServers, func(server raDNSServer) bool {
+				return expired(server.expires)
+			})
+
+			if router.lifetime == 0 && len(router.routes) == 0 && len(router.dnsServers) == 0 {
+				delete(link.routers, addr)
+			}
+		}
+	}
+
+	return nextExpiry
+}
+
+// raRouteMetric converts the preference to the route metric, so that more preferred routes have lower metric.
+func raRouteMetric(preference ra.Preference) uint32 {
+	return uint32(int64(network.DefaultRouteMetric) + 1 - int64(preference))
+}
+
+func raRouteSpec(linkName string, gateway netip.Addr, destination netip.Prefix, preference ra.Preference) network.RouteSpecSpec {
+	spec := network.RouteSpecSpec{
+		Family:      nethelpers.FamilyInet6,
+		Destination: destination,
+		Gateway:     gateway,
+		OutLinkName: linkName,
+		Table:       nethelpers.TableMain,
+		Priority:    raRouteMetric(preference),
+		Scope:       nethelpers.ScopeGlobal,
+		Type:        nethelpers.TypeUnicast,
+		Protocol:    nethelpers.ProtocolRA,
+		ConfigLayer: network.ConfigOperator,
+		Origin:      network.RouteOriginRA,
+	}
+
+	spec.Normalize()
+
+	return spec
+}
+
+func raSysctl(linkName, key string) string {
+	// dots in the link name (VLANs) should be replaced with slashes
+	return kernel.Sysctl + ".net.ipv6.conf." + strings.ReplaceAll(linkName, ".", "/") + "." + key
+}
+
+//nolint:gocyclo,cyclop
+func (ctrl *RouterAdvertisementController) reconcile(ctx context.Context, r controller.Runtime, logger *zap.Logger, now time.Time) (time.Time, error) {
+	nextExpiry := ctrl.expire(logger, now)
+
+	r.StartTrackingOutputs()
+
+	for linkName, cfg := range ctrl.configs {
+		params := map[string]string{
+			"accept_ra": "0",
+		}
+
+		if cfg.accept {
+			// the kernel handles SLAAC, while the routes are managed by the controller
+			params = map[string]string{
+				"accept_ra":                  "2",
+				"accept_ra_defrtr":           "0",
+				"accept_ra_rtr_pref":         "0",
+				"accept_ra_rt_info_max_plen": "0",
+			}
+		}
+
+		for key, value := range params {
+			if err := safe.WriterModify(ctx, r, runtime.NewKernelParamSpec(runtime.NamespaceName, raSysctl(linkName, key)),
+				func(res *runtime.KernelParamSpec) error {
+					res.TypedSpec().Value = value
+
+					return nil
+				},
+			); err != nil {
+				return time.Time{}, fmt.Errorf("error modifying kernel param: %w", err)
+			}
+		}
+	}
+
+	for _, linkName := range slices.Sorted(maps.Keys(ctrl.links)) {
+		link := ctrl.links[linkName]
+
+		var dnsServers []netip.Addr
+
+		for _, addr := range slices.SortedFunc(maps.Keys(link.routers), netip.Addr.Compare) {
+			router := link.routers[addr]
+
+			var routes []network.RouteSpecSpec
+
+			if router.lifetime > 0 {
+				routes = append(routes, raRouteSpec(linkName, addr, netip.Prefix{}, router.preference))
+			}
+
+			prefixes := slices.SortedFunc(maps.Keys(router.routes), func(a, b netip.Prefix) int {
+				return cmp.Or(a.Addr().Compare(b.Addr()), cmp.Compare(a.Bits(), b.Bits()))
+			})
+
+			for _, prefix := range prefixes {
+				routes = append(routes, raRouteSpec(linkName, addr, prefix, router.routes[prefix].preference))
+			}
+
+			for _, route := range routes {
+				id := fmt.Sprintf("ra/%s/%s", linkName,
+					network.RouteID(route.Table, route.Family, route.Destination, route.Gateway, route.Priority, route.OutLinkName),
+				)
+
+				if err := safe.WriterModify(ctx, r, network.NewRouteSpec(network.ConfigNamespaceName, id), func(res *network.RouteSpec) error {
+					*res.TypedSpec() = route
+
+					return nil
+				}); err != nil {
+					return time.Time{}, fmt.Errorf("error modifying route spec: %w", err)
+				}
+			}
+
+			routerDNSServers := xslices.Map(router.dnsServers, func(server raDNSServer) netip.Addr { return server.addr })
+
+			for _, server := range routerDNSServers {
+				if !slices.Contains(dnsServers, server) {
+					dnsServers = append(dnsServers, server)
+				}
+			}
+
+			if err := safe.WriterModify(ctx, r, network.NewRouterStatus(network.NamespaceName, network.RouterStatusID(linkName, addr)), func(res *network.RouterStatus) error {
+				spec := res.TypedSpec()
+
+				spec.LinkName = linkName
+				spec.Address = addr
+				spec.Preference = router.preference.String()
+				spec.Lifetime = router.lifetime
+				spec.Expires = router.expires
+				spec.Routes = prefixes
+				spec.DNSServers = routerDNSServers
+				spec.LastAdvertisement = router.lastAdvertisement
+
+				return nil
+			}); err != nil {
+				return time.Time{}, fmt.Errorf("error modifying router status: %w", err)
+			}
+		}
+
+		if len(dnsServers) > 0 && ctrl.configs[linkName].acceptRDNSS {
+			if err := safe.WriterModify(ctx, r, network.NewResolverSpec(network.ConfigNamespaceName, fmt.Sprintf("ra/%s/%s", linkName, network.ResolverID)),
+				func(res *network.ResolverSpec) error {
+					res.TypedSpec().DNSServers = dnsServers
+					res.TypedSpec().ConfigLayer = network.ConfigOperator
+
+					return nil
+				},
+			); err != nil {
+				return time.Time{}, fmt.Errorf("error modifying resolver spec: %w", err)
+			}
+		}
+	}
+
+	if err := safe.CleanupOutputs[*network.RouteSpec](ctx, r); err != nil {
+		return time.Time{}, err
+	}
+
+	if err := safe.CleanupOutputs[*network.ResolverSpec](ctx, r); err != nil {
+		return time.Time{}, err
+	}
+
+	if err := safe.CleanupOutputs[*network.RouterStatus](ctx, r); err != nil {
+		return time.Time{}, err
+	}
+
+	if err := safe.CleanupOutputs[*runtime.KernelParamSpec](ctx, r); err != nil {
+		return time.Time{}, err
+	}
+
+	return nextExpiry, nil
+}
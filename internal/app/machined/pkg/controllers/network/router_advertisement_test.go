@@ -0,0 +1,237 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"context"
+	"net/netip"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/ra"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+type mockRAListener struct {
+	packetCh chan ra.Packet
+}
+
+func (listener *mockRAListener) Listen(ctx context.Context, packetCh chan<- ra.Packet) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case packet := <-listener.packetCh:
+			select {
+			case packetCh <- packet:
+			case <-ctx.Done():
+				return nil
+			}
+		}
+	}
+}
+
+type RouterAdvertisementSuite struct {
+	ctest.DefaultSuite
+
+	listener *mockRAListener
+}
+
+var (
+	raRouter1 = netip.MustParseAddr("fe80::1")
+	raRouter2 = netip.MustParseAddr("fe80::2")
+)
+
+func (suite *RouterAdvertisementSuite) inject(linkName string, source netip.Addr, adv *ra.RouterAdvertisement) {
+	select {
+	case suite.listener.packetCh <- ra.Packet{
+		LinkName: linkName,
+		Source:   source,
+		HopLimit: 255,
+		Payload:  adv.Marshal(),
+	}:
+	case <-suite.Ctx().Done():
+		suite.FailNow("timeout injecting packet")
+	}
+}
+
+func (suite *RouterAdvertisementSuite) createConfig() {
+	for _, link := range []string{"eth0", "eth1"} {
+		linkStatus := network.NewLinkStatus(network.NamespaceName, link)
+		linkStatus.TypedSpec().Type = nethelpers.LinkEther
+		linkStatus.TypedSpec().LinkState = true
+
+		suite.Create(linkStatus)
+	}
+
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	suite.Create(config.NewMachineConfig(
+		container.NewV1Alpha1(
+			&v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface:            "eth0",
+								DeviceRouterAdvertisements: &v1alpha1.DeviceRouterAdvertisementsConfig{},
+							},
+							{
+								DeviceInterface: "eth1",
+								DeviceRouterAdvertisements: &v1alpha1.DeviceRouterAdvertisementsConfig{
+									RAAccept: pointer.To(false),
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							URL: u,
+						},
+					},
+				},
+			},
+		),
+	))
+}
+
+func (suite *RouterAdvertisementSuite) TestKernelParams() {
+	suite.createConfig()
+
+	ctest.AssertResources(suite,
+		[]string{
+			"proc.sys.net.ipv6.conf.eth0.accept_ra",
+			"proc.sys.net.ipv6.conf.eth0.accept_ra_defrtr",
+			"proc.sys.net.ipv6.conf.eth0.accept_ra_rtr_pref",
+			"proc.sys.net.ipv6.conf.eth0.accept_ra_rt_info_max_plen",
+			"proc.sys.net.ipv6.conf.eth1.accept_ra",
+		},
+		func(param *runtime.KernelParamSpec, asrt *assert.Assertions) {
+			switch param.Metadata().ID() {
+			case "proc.sys.net.ipv6.conf.eth0.accept_ra":
+				asrt.Equal("2", param.TypedSpec().Value)
+			default:
+				asrt.Equal("0", param.TypedSpec().Value)
+			}
+		},
+	)
+
+	ctest.AssertNoResource[*runtime.KernelParamSpec](suite, "proc.sys.net.ipv6.conf.eth1.accept_ra_defrtr")
+}
+
+func (suite *RouterAdvertisementSuite) TestRouters() {
+	suite.createConfig()
+
+	// wait for the config to be processed
+	ctest.AssertResource(suite, "proc.sys.net.ipv6.conf.eth0.accept_ra", func(*runtime.KernelParamSpec, *assert.Assertions) {})
+
+	suite.inject("eth0", raRouter1, &ra.RouterAdvertisement{
+		RouterPreference: ra.PreferenceLow,
+		RouterLifetime:   30 * time.Minute,
+		DNSServers: []ra.RecursiveDNSServer{
+			{
+				Servers:  []netip.Addr{netip.MustParseAddr("2001:db8::53")},
+				Lifetime: time.Hour,
+			},
+		},
+	})
+
+	suite.inject("eth0", raRouter2, &ra.RouterAdvertisement{
+		RouterPreference: ra.PreferenceHigh,
+		RouterLifetime:   30 * time.Minute,
+		Routes: []ra.RouteInformation{
+			{
+				Prefix:     netip.MustParsePrefix("2001:db8:1::/48"),
+				Preference: ra.PreferenceMedium,
+				Lifetime:   time.Second,
+			},
+		},
+	})
+
+	// packets on links which don't accept router advertisements are ignored
+	suite.inject("eth1", raRouter1, &ra.RouterAdvertisement{
+		RouterLifetime: 30 * time.Minute,
+	})
+
+	ctest.AssertResources(suite,
+		[]string{
+			"ra/eth0/eth0/inet6/fe80::1//1026",
+			"ra/eth0/eth0/inet6/fe80::2//1024",
+			"ra/eth0/eth0/inet6/fe80::2/2001:db8:1::/48/1025",
+		},
+		func(route *network.RouteSpec, asrt *assert.Assertions) {
+			asrt.Equal("eth0", route.TypedSpec().OutLinkName)
+			asrt.Equal(nethelpers.ProtocolRA, route.TypedSpec().Protocol)
+			asrt.Equal(network.ConfigOperator, route.TypedSpec().ConfigLayer)
+			asrt.Equal(network.RouteOriginRA, route.TypedSpec().Origin)
+		},
+		rtestutils.WithNamespace(network.ConfigNamespaceName),
+	)
+
+	ctest.AssertResource(suite, "ra/eth0/resolvers", func(resolver *network.ResolverSpec, asrt *assert.Assertions) {
+		asrt.Equal([]netip.Addr{netip.MustParseAddr("2001:db8::53")}, resolver.TypedSpec().DNSServers)
+	}, rtestutils.WithNamespace(network.ConfigNamespaceName))
+
+	ctest.AssertResource(suite, "eth0/fe80::2", func(status *network.RouterStatus, asrt *assert.Assertions) {
+		asrt.Equal("high", status.TypedSpec().Preference)
+		asrt.Equal(30*time.Minute, status.TypedSpec().Lifetime)
+		asrt.False(status.TypedSpec().Expires.IsZero())
+	})
+
+	ctest.AssertNoResource[*network.RouterStatus](suite, "eth1/fe80::1")
+
+	// route information expires
+	ctest.AssertNoResource[*network.RouteSpec](suite, "ra/eth0/eth0/inet6/fe80::2/2001:db8:1::/48/1025", rtestutils.WithNamespace(network.ConfigNamespaceName))
+
+	// router stops being a default router
+	suite.inject("eth0", raRouter1, &ra.RouterAdvertisement{
+		RouterLifetime: 0,
+	})
+
+	ctest.AssertNoResource[*network.RouteSpec](suite, "ra/eth0/eth0/inet6/fe80::1//1026", rtestutils.WithNamespace(network.ConfigNamespaceName))
+
+	ctest.AssertResource(suite, "eth0/fe80::1", func(status *network.RouterStatus, asrt *assert.Assertions) {
+		asrt.Zero(status.TypedSpec().Lifetime)
+		asrt.Equal([]netip.Addr{netip.MustParseAddr("2001:db8::53")}, status.TypedSpec().DNSServers)
+	})
+}
+
+func TestRouterAdvertisementSuite(t *testing.T) {
+	t.Parallel()
+
+	listener := &mockRAListener{
+		packetCh: make(chan ra.Packet),
+	}
+
+	suite.Run(t, &RouterAdvertisementSuite{
+		listener: listener,
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 10 * time.Second,
+			AfterSetup: func(s *ctest.DefaultSuite) {
+				s.Require().NoError(s.Runtime().RegisterController(&netctrl.DeviceConfigController{}))
+				s.Require().NoError(s.Runtime().RegisterController(&netctrl.RouterAdvertisementController{
+					Listener: listener,
+				}))
+			},
+		},
+	})
+}
@@ -0,0 +1,103 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/gen/xslices"
+	"go.uber.org/zap"
+
+	configtypes "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// SRIOVConfigController manages network.SRIOVSpec based on machine configuration.
+type SRIOVConfigController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *SRIOVConfigController) Name() string {
+	return "network.SRIOVConfigController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *SRIOVConfigController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.ActiveID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *SRIOVConfigController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.SRIOVSpecType,
+			Kind: controller.OutputShared,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *SRIOVConfigController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		r.StartTrackingOutputs()
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.ActiveID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error reading machine configuration: %w", err)
+		}
+
+		if cfg != nil {
+			if err = ctrl.apply(ctx, r, cfg.Config().SRIOVConfigs()); err != nil {
+				return fmt.Errorf("error applying SRIOVSpec: %w", err)
+			}
+		}
+
+		if err = safe.CleanupOutputs[*network.SRIOVSpec](ctx, r); err != nil {
+			return fmt.Errorf("error cleaning up SRIOVSpec: %w", err)
+		}
+	}
+}
+
+func (ctrl *SRIOVConfigController) apply(ctx context.Context, r controller.Runtime, configs []configtypes.SRIOVConfig) error {
+	for _, cfg := range configs {
+		if err := safe.WriterModify(ctx, r, network.NewSRIOVSpec(network.NamespaceName, cfg.Name()), func(spec *network.SRIOVSpec) error {
+			spec.TypedSpec().NumVFs = cfg.NumVFs()
+			spec.TypedSpec().VFs = xslices.Map(cfg.VFs(), func(vf configtypes.SRIOVVFConfig) network.SRIOVVFSpec {
+				return network.SRIOVVFSpec{
+					Index:        vf.Index,
+					HardwareAddr: nethelpers.HardwareAddr(vf.HardwareAddr),
+					VLAN:         vf.VLAN,
+					Trust:        vf.Trust,
+					SpoofCheck:   vf.SpoofCheck,
+				}
+			})
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error writing SRIOVSpec: %w", err)
+		}
+	}
+
+	return nil
+}
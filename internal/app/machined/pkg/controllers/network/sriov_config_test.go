@@ -0,0 +1,95 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	networkcfg "github.com/siderolabs/talos/pkg/machinery/config/types/network"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type SRIOVConfigSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *SRIOVConfigSuite) TestReconcile() {
+	cfg1 := networkcfg.NewSRIOVConfigV1Alpha1("enp3s0f0")
+	cfg1.NumVFsConfig = 4
+
+	ctr, err := container.New(cfg1)
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(ctr)
+	suite.Create(cfg)
+
+	ctest.AssertResource(suite, "enp3s0f0", func(spec *network.SRIOVSpec, asrt *assert.Assertions) {
+		asrt.Equal(uint32(4), spec.TypedSpec().NumVFs)
+		asrt.Empty(spec.TypedSpec().VFs)
+	})
+
+	cfg2 := networkcfg.NewSRIOVConfigV1Alpha1("0000:04:00.1")
+	cfg2.NumVFsConfig = 2
+	cfg2.VFsConfig = []networkcfg.SRIOVVFConfig{
+		{
+			VFIndex:        1,
+			VFHardwareAddr: "02:00:00:00:00:02",
+			VFVLAN:         200,
+			VFTrust:        pointer.To(true),
+		},
+	}
+
+	ctr, err = container.New(cfg1, cfg2)
+	suite.Require().NoError(err)
+
+	cfgNew := config.NewMachineConfig(ctr)
+	cfgNew.Metadata().SetVersion(cfg.Metadata().Version())
+	suite.Update(cfgNew)
+
+	ctest.AssertResource(suite, "enp3s0f0", func(spec *network.SRIOVSpec, asrt *assert.Assertions) {
+		asrt.Equal(uint32(4), spec.TypedSpec().NumVFs)
+	})
+	ctest.AssertResource(suite, "0000:04:00.1", func(spec *network.SRIOVSpec, asrt *assert.Assertions) {
+		asrt.Equal(uint32(2), spec.TypedSpec().NumVFs)
+
+		if asrt.Len(spec.TypedSpec().VFs, 1) {
+			vf := spec.TypedSpec().VFs[0]
+
+			asrt.Equal(uint32(1), vf.Index)
+			asrt.Equal("02:00:00:00:00:02", net.HardwareAddr(vf.HardwareAddr).String())
+			asrt.Equal(uint16(200), vf.VLAN)
+			asrt.Equal(pointer.To(true), vf.Trust)
+			asrt.Nil(vf.SpoofCheck)
+		}
+	})
+
+	suite.Destroy(cfgNew)
+
+	ctest.AssertNoResource[*network.SRIOVSpec](suite, "enp3s0f0")
+	ctest.AssertNoResource[*network.SRIOVSpec](suite, "0000:04:00.1")
+}
+
+func TestSRIOVConfigSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, &SRIOVConfigSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 10 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.SRIOVConfigController{}))
+			},
+		},
+	})
+}
@@ -0,0 +1,212 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/sriov"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// SRIOVSpecController applies SR-IOV configuration to the physical functions and reports their status.
+type SRIOVSpecController struct {
+	// SysfsRoot is the path to the sysfs mount, defaults to /sys.
+	SysfsRoot string
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *SRIOVSpecController) Name() string {
+	return "network.SRIOVSpecController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *SRIOVSpecController) Inputs() []controller.Input {
+	return nil
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *SRIOVSpecController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.SRIOVStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *SRIOVSpecController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.SysfsRoot == "" {
+		ctrl.SysfsRoot = "/sys"
+	}
+
+	// wait for udevd to be healthy, which implies that all link renames are done
+	if err := runtime.WaitForDevicesReady(ctx, r,
+		[]controller.Input{
+			{
+				Namespace: network.NamespaceName,
+				Type:      network.SRIOVSpecType,
+				Kind:      controller.InputWeak,
+			},
+			{
+				// VF links appear and disappear as the number of VFs changes
+				Namespace: network.NamespaceName,
+				Type:      network.LinkStatusType,
+				Kind:      controller.InputWeak,
+			},
+		},
+	); err != nil {
+		return err
+	}
+
+	vfConn, err := dialSRIOVVF()
+	if err != nil {
+		return fmt.Errorf("error dialing SR-IOV netlink socket: %w", err)
+	}
+
+	defer vfConn.Close() //nolint:errcheck
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		r.StartTrackingOutputs()
+
+		specs, err := safe.ReaderListAll[*network.SRIOVSpec](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error listing SR-IOV specs: %w", err)
+		}
+
+		links, err := safe.ReaderListAll[*network.LinkStatus](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error listing links: %w", err)
+		}
+
+		for spec := range specs.All() {
+			status := ctrl.apply(spec, links, vfConn)
+
+			if status.Error != "" {
+				logger.Error("failed to apply SR-IOV configuration", zap.String("id", spec.Metadata().ID()), zap.String("error", status.Error))
+			}
+
+			if err = safe.WriterModify(ctx, r, network.NewSRIOVStatus(network.NamespaceName, spec.Metadata().ID()), func(res *network.SRIOVStatus) error {
+				*res.TypedSpec() = status
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error modifying SR-IOV status: %w", err)
+			}
+		}
+
+		if err = safe.CleanupOutputs[*network.SRIOVStatus](ctx, r); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// apply the SR-IOV spec to the physical function.
+//
+// Failures are not fatal for the controller, they are reported in the status.
+func (ctrl *SRIOVSpecController) apply(spec *network.SRIOVSpec, links safe.List[*network.LinkStatus], vfConn *sriovVFConn) network.SRIOVStatusSpec {
+	var status network.SRIOVStatusSpec
+
+	// physical function is selected either by the link name or by the PCI bus path
+	pf, found := links.Find(func(link *network.LinkStatus) bool {
+		return link.Metadata().ID() == spec.Metadata().ID() || link.TypedSpec().BusPath == spec.Metadata().ID()
+	})
+	if !found {
+		status.Error = "physical function link not found"
+
+		return status
+	}
+
+	status.LinkName = pf.Metadata().ID()
+	status.BusPath = pf.TypedSpec().BusPath
+
+	dev := sriov.NewDevice(filepath.Join(ctrl.SysfsRoot, "class", "net", status.LinkName, "device"))
+
+	totalVFs, err := dev.TotalVFs()
+	if err != nil {
+		status.Error = err.Error()
+
+		return status
+	}
+
+	status.TotalVFs = totalVFs
+
+	var errs error
+
+	if spec.TypedSpec().NumVFs > totalVFs {
+		errs = errors.Join(errs, fmt.Errorf("requested %d VFs, but the device supports at most %d", spec.TypedSpec().NumVFs, totalVFs))
+	} else if err = dev.SetNumVFs(spec.TypedSpec().NumVFs); err != nil {
+		errs = errors.Join(errs, err)
+	}
+
+	if status.NumVFs, err = dev.NumVFs(); err != nil {
+		errs = errors.Join(errs, err)
+	}
+
+	for _, vfSpec := range spec.TypedSpec().VFs {
+		if vfSpec.Index >= status.NumVFs {
+			continue
+		}
+
+		if err = vfConn.Set(pf.TypedSpec().Index, vfSpec); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("error configuring VF %d: %w", vfSpec.Index, err))
+		}
+	}
+
+	vfs, err := dev.VFs()
+	if err != nil {
+		errs = errors.Join(errs, err)
+	}
+
+	vfStates, err := vfConn.Get(pf.TypedSpec().Index)
+	if err != nil {
+		errs = errors.Join(errs, err)
+	}
+
+	for _, vf := range vfs {
+		vfStatus := network.SRIOVVFStatus{
+			Index:      vf.Index,
+			PCIAddress: vf.PCIAddress,
+			LinkName:   vf.LinkName,
+			Driver:     vf.Driver,
+		}
+
+		for _, vfState := range vfStates {
+			if vfState.Index == vf.Index {
+				vfStatus.HardwareAddr = vfState.HardwareAddr
+				vfStatus.VLAN = vfState.VLAN
+				vfStatus.Trust = vfState.Trust
+				vfStatus.SpoofCheck = vfState.SpoofCheck
+			}
+		}
+
+		status.VFs = append(status.VFs, vfStatus)
+	}
+
+	if errs != nil {
+		status.Error = errs.Error()
+	}
+
+	status.Ready = status.Error == "" && status.NumVFs == spec.TypedSpec().NumVFs
+
+	return status
+}
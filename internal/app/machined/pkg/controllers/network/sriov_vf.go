@@ -0,0 +1,88 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/mdlayher/netlink"
+	"golang.org/x/sys/unix"
+
+	networkadapter "github.com/siderolabs/talos/internal/app/machined/pkg/adapters/network"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// sriovVFConn manages SR-IOV virtual function settings via the physical function link,
+// which is not supported by the rtnetlink library.
+type sriovVFConn struct {
+	conn *netlink.Conn
+}
+
+func dialSRIOVVF() (*sriovVFConn, error) {
+	conn, err := netlink.Dial(unix.NETLINK_ROUTE, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sriovVFConn{conn: conn}, nil
+}
+
+// Close the underlying netlink socket.
+func (c *sriovVFConn) Close() error {
+	return c.conn.Close()
+}
+
+// Get returns the virtual function settings of the physical function link.
+func (c *sriovVFConn) Get(index uint32) ([]network.SRIOVVFStatus, error) {
+	encoder := netlink.NewAttributeEncoder()
+	encoder.Uint32(unix.IFLA_EXT_MASK, unix.RTEXT_FILTER_VF)
+
+	attrs, err := encoder.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	msgs, err := c.conn.Execute(netlink.Message{
+		Header: netlink.Header{
+			Type:  unix.RTM_GETLINK,
+			Flags: netlink.Request,
+		},
+		Data: append(ifInfoMsg(unix.AF_UNSPEC, index), attrs...),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting VF info: %w", err)
+	}
+
+	if len(msgs) != 1 || len(msgs[0].Data) < ifInfoMsgLen {
+		return nil, errors.New("unexpected link message")
+	}
+
+	var vfs []network.SRIOVVFStatus
+
+	if err = networkadapter.SRIOVVFStatuses(&vfs).Decode(msgs[0].Data[ifInfoMsgLen:]); err != nil {
+		return nil, fmt.Errorf("error decoding VF info: %w", err)
+	}
+
+	return vfs, nil
+}
+
+// Set the virtual function settings via the physical function link.
+func (c *sriovVFConn) Set(index uint32, spec network.SRIOVVFSpec) error {
+	attrs, err := networkadapter.SRIOVVFSpec(&spec).Encode()
+	if err != nil {
+		return err
+	}
+
+	_, err = c.conn.Execute(netlink.Message{
+		Header: netlink.Header{
+			Type:  unix.RTM_SETLINK,
+			Flags: netlink.Request | netlink.Acknowledge,
+		},
+		Data: append(ifInfoMsg(unix.AF_UNSPEC, index), attrs...),
+	})
+
+	return err
+}
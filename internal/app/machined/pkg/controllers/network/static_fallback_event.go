@@ -0,0 +1,100 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// StaticFallbackEventController reports the transitions between the static configuration and the DHCP fallback to the events stream.
+type StaticFallbackEventController struct {
+	V1Alpha1Events runtime.Publisher
+
+	modes map[resource.ID]string
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *StaticFallbackEventController) Name() string {
+	return "network.StaticFallbackEventController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *StaticFallbackEventController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.StaticFallbackStatusType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *StaticFallbackEventController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *StaticFallbackEventController) Run(ctx context.Context, r controller.Runtime, _ *zap.Logger) error {
+	ctrl.modes = map[resource.ID]string{}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		statuses, err := safe.ReaderListAll[*network.StaticFallbackStatus](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error listing static fallback statuses: %w", err)
+		}
+
+		seen := map[resource.ID]struct{}{}
+
+		for status := range statuses.All() {
+			spec := status.TypedSpec()
+
+			seen[status.Metadata().ID()] = struct{}{}
+
+			previous, ok := ctrl.modes[status.Metadata().ID()]
+			if ok && previous == spec.Mode {
+				continue
+			}
+
+			ctrl.modes[status.Metadata().ID()] = spec.Mode
+
+			// the static configuration is applied first, so it's not a transition
+			if !ok && spec.Mode == network.StaticFallbackModeStatic {
+				continue
+			}
+
+			ctrl.V1Alpha1Events.Publish(ctx, &machine.StaticFallbackEvent{
+				Link:             spec.LinkName,
+				Mode:             spec.Mode,
+				Gateway:          spec.Gateway.String(),
+				GatewayReachable: spec.GatewayReachable,
+			})
+		}
+
+		for id := range ctrl.modes {
+			if _, ok := seen[id]; !ok {
+				delete(ctrl.modes, id)
+			}
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
@@ -60,6 +60,16 @@ func (ctrl *StatusController) Inputs() []controller.Input {
 			Type:      network.ProbeStatusType,
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.SRIOVSpecType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.SRIOVStatusType,
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -163,6 +173,29 @@ func (ctrl *StatusController) Run(ctx context.Context, r controller.Runtime, _ *
 			}
 		}
 
+		// SR-IOV: every spec should be either applied or failed
+		sriovSpecs, err := safe.ReaderListAll[*network.SRIOVSpec](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error getting SR-IOV specs: %w", err)
+		}
+
+		sriovStatuses, err := safe.ReaderListAll[*network.SRIOVStatus](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error getting SR-IOV statuses: %w", err)
+		}
+
+		result.SRIOVReady = true
+
+		for spec := range sriovSpecs.All() {
+			if _, found := sriovStatuses.Find(func(status *network.SRIOVStatus) bool {
+				return status.Metadata().ID() == spec.Metadata().ID() && (status.TypedSpec().Ready || status.TypedSpec().Error != "")
+			}); !found {
+				result.SRIOVReady = false
+
+				break
+			}
+		}
+
 		// update output status
 		if err = safe.WriterModify(ctx, r, network.NewStatus(network.NamespaceName, network.StatusID),
 			func(r *network.Status) error {
@@ -27,7 +27,7 @@ type StatusSuite struct {
 
 func (suite *StatusSuite) TestNone() {
 	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{network.StatusID}, func(r *network.Status, assert *assert.Assertions) {
-		assert.Equal(network.StatusSpec{}, *r.TypedSpec())
+		assert.Equal(network.StatusSpec{SRIOVReady: true}, *r.TypedSpec())
 	})
 }
 
@@ -38,7 +38,7 @@ func (suite *StatusSuite) TestAddresses() {
 	suite.Require().NoError(suite.State().Create(suite.Ctx(), nodeAddress))
 
 	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{network.StatusID}, func(r *network.Status, assert *assert.Assertions) {
-		assert.Equal(network.StatusSpec{AddressReady: true}, *r.TypedSpec())
+		assert.Equal(network.StatusSpec{AddressReady: true, SRIOVReady: true}, *r.TypedSpec())
 	})
 }
 
@@ -49,7 +49,7 @@ func (suite *StatusSuite) TestRoutes() {
 	suite.Require().NoError(suite.State().Create(suite.Ctx(), route))
 
 	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{network.StatusID}, func(r *network.Status, assert *assert.Assertions) {
-		assert.Equal(network.StatusSpec{ConnectivityReady: true}, *r.TypedSpec())
+		assert.Equal(network.StatusSpec{ConnectivityReady: true, SRIOVReady: true}, *r.TypedSpec())
 	})
 }
 
@@ -59,7 +59,7 @@ func (suite *StatusSuite) TestProbeStatuses() {
 	suite.Require().NoError(suite.State().Create(suite.Ctx(), probeStatus))
 
 	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{network.StatusID}, func(r *network.Status, assert *assert.Assertions) {
-		assert.Equal(network.StatusSpec{ConnectivityReady: true}, *r.TypedSpec())
+		assert.Equal(network.StatusSpec{ConnectivityReady: true, SRIOVReady: true}, *r.TypedSpec())
 	})
 
 	// failing probe make status not ready
@@ -72,7 +72,7 @@ func (suite *StatusSuite) TestProbeStatuses() {
 	suite.Require().NoError(suite.State().Create(suite.Ctx(), probeStatusFail))
 
 	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{network.StatusID}, func(r *network.Status, assert *assert.Assertions) {
-		assert.Equal(network.StatusSpec{}, *r.TypedSpec())
+		assert.Equal(network.StatusSpec{SRIOVReady: true}, *r.TypedSpec())
 	})
 }
 
@@ -83,7 +83,7 @@ func (suite *StatusSuite) TestHostname() {
 	suite.Require().NoError(suite.State().Create(suite.Ctx(), hostname))
 
 	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{network.StatusID}, func(r *network.Status, assert *assert.Assertions) {
-		assert.Equal(network.StatusSpec{HostnameReady: true}, *r.TypedSpec())
+		assert.Equal(network.StatusSpec{HostnameReady: true, SRIOVReady: true}, *r.TypedSpec())
 	})
 }
 
@@ -93,7 +93,27 @@ func (suite *StatusSuite) TestEtcFiles() {
 	}
 
 	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{network.StatusID}, func(r *network.Status, assert *assert.Assertions) {
-		assert.Equal(network.StatusSpec{EtcFilesReady: true}, *r.TypedSpec())
+		assert.Equal(network.StatusSpec{EtcFilesReady: true, SRIOVReady: true}, *r.TypedSpec())
+	})
+}
+
+func (suite *StatusSuite) TestSRIOV() {
+	spec := network.NewSRIOVSpec(network.NamespaceName, "enp3s0f0")
+	spec.TypedSpec().NumVFs = 4
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), spec))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{network.StatusID}, func(r *network.Status, assert *assert.Assertions) {
+		assert.Equal(network.StatusSpec{}, *r.TypedSpec())
+	})
+
+	status := network.NewSRIOVStatus(network.NamespaceName, "enp3s0f0")
+	status.TypedSpec().Ready = true
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), status))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{network.StatusID}, func(r *network.Status, assert *assert.Assertions) {
+		assert.Equal(network.StatusSpec{SRIOVReady: true}, *r.TypedSpec())
 	})
 }
 
@@ -199,6 +199,7 @@ func (ctrl *TimeServerConfigController) parseMachineConfiguration(cfgProvider ta
 
 	spec.NTPServers = slices.Clone(cfgProvider.Machine().Time().Servers())
 	spec.ConfigLayer = network.ConfigMachineConfiguration
+	spec.DHCPServersMode = cfgProvider.Machine().Time().DHCPServers()
 
 	return spec
 }
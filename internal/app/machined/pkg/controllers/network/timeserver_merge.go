@@ -6,11 +6,14 @@
 package network
 
 import (
+	"slices"
+
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/safe"
 	"go.uber.org/zap"
 
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 )
 
@@ -23,9 +26,17 @@ func NewTimeServerMergeController() controller.Controller {
 		network.NamespaceName,
 		func(logger *zap.Logger, list safe.List[*network.TimeServerSpec]) map[resource.ID]*network.TimeServerSpecSpec {
 			// simply merge by layers, overriding with the next configuration layer
-			var final network.TimeServerSpecSpec
+			var (
+				final       network.TimeServerSpecSpec
+				dhcpServers []string
+			)
 
 			for spec := range list.All() {
+				if spec.TypedSpec().ConfigLayer == network.ConfigOperator {
+					// keep track of DHCP servers, as they might be merged with the machine configuration
+					dhcpServers = append(dhcpServers, spec.TypedSpec().NTPServers...)
+				}
+
 				if final.NTPServers != nil && spec.TypedSpec().ConfigLayer < final.ConfigLayer {
 					// skip this spec, as existing one is higher layer
 					continue
@@ -33,13 +44,25 @@ func NewTimeServerMergeController() controller.Controller {
 
 				if spec.TypedSpec().ConfigLayer == final.ConfigLayer {
 					// merge server lists on the same level
-					final.NTPServers = append(final.NTPServers, spec.TypedSpec().NTPServers...)
+					final.NTPServers = slices.Concat(final.NTPServers, spec.TypedSpec().NTPServers)
 				} else {
 					// otherwise, replace the lists
 					final = *spec.TypedSpec()
 				}
 			}
 
+			if final.ConfigLayer == network.ConfigMachineConfiguration && len(dhcpServers) > 0 {
+				switch final.DHCPServersMode {
+				case nethelpers.DHCPTimeServersSupplement:
+					// DHCP servers are used as a fallback after the configured servers
+					final.NTPServers = slices.Concat(final.NTPServers, slices.DeleteFunc(dhcpServers, func(server string) bool {
+						return slices.Contains(final.NTPServers, server)
+					}))
+				case nethelpers.DHCPTimeServersReplace:
+					final.NTPServers = dhcpServers
+				}
+			}
+
 			if final.NTPServers != nil {
 				return map[resource.ID]*network.TimeServerSpecSpec{
 					network.TimeServerID: &final,
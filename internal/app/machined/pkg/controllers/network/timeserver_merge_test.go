@@ -15,6 +15,7 @@ import (
 	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
 	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 )
 
@@ -77,6 +78,61 @@ func (suite *TimeServerMergeSuite) TestMerge() {
 	)
 }
 
+func (suite *TimeServerMergeSuite) TestMergeDHCPMode() {
+	dhcp := network.NewTimeServerSpec(network.ConfigNamespaceName, "dhcp/eth0")
+	*dhcp.TypedSpec() = network.TimeServerSpecSpec{
+		NTPServers:  []string{"ntp.eth0", "my.ntp"},
+		ConfigLayer: network.ConfigOperator,
+	}
+
+	static := network.NewTimeServerSpec(network.ConfigNamespaceName, "configuration/timeservers")
+	*static.TypedSpec() = network.TimeServerSpecSpec{
+		NTPServers:      []string{"my.ntp"},
+		ConfigLayer:     network.ConfigMachineConfiguration,
+		DHCPServersMode: nethelpers.DHCPTimeServersIgnore,
+	}
+
+	for _, res := range []resource.Resource{dhcp, static} {
+		suite.Create(res)
+	}
+
+	suite.assertTimeServers(
+		[]string{
+			"timeservers",
+		}, func(r *network.TimeServerSpec, asrt *assert.Assertions) {
+			asrt.Equal([]string{"my.ntp"}, r.TypedSpec().NTPServers)
+		},
+	)
+
+	ctest.UpdateWithConflicts(suite, static, func(r *network.TimeServerSpec) error {
+		r.TypedSpec().DHCPServersMode = nethelpers.DHCPTimeServersSupplement
+
+		return nil
+	})
+
+	suite.assertTimeServers(
+		[]string{
+			"timeservers",
+		}, func(r *network.TimeServerSpec, asrt *assert.Assertions) {
+			asrt.Equal([]string{"my.ntp", "ntp.eth0"}, r.TypedSpec().NTPServers)
+		},
+	)
+
+	ctest.UpdateWithConflicts(suite, static, func(r *network.TimeServerSpec) error {
+		r.TypedSpec().DHCPServersMode = nethelpers.DHCPTimeServersReplace
+
+		return nil
+	})
+
+	suite.assertTimeServers(
+		[]string{
+			"timeservers",
+		}, func(r *network.TimeServerSpec, asrt *assert.Assertions) {
+			asrt.Equal([]string{"ntp.eth0", "my.ntp"}, r.TypedSpec().NTPServers)
+		},
+	)
+}
+
 func TestTimeServerMergeSuite(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,106 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// VIPEventController reports the transitions of the shared IP owner to the events stream.
+type VIPEventController struct {
+	V1Alpha1Events runtime.Publisher
+
+	owners map[resource.ID]vipOwner
+}
+
+type vipOwner struct {
+	owner string
+	owned bool
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *VIPEventController) Name() string {
+	return "network.VIPEventController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *VIPEventController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.VIPStatusType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *VIPEventController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *VIPEventController) Run(ctx context.Context, r controller.Runtime, _ *zap.Logger) error {
+	ctrl.owners = map[resource.ID]vipOwner{}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		statuses, err := safe.ReaderListAll[*network.VIPStatus](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error listing VIP statuses: %w", err)
+		}
+
+		seen := map[resource.ID]struct{}{}
+
+		for status := range statuses.All() {
+			spec := status.TypedSpec()
+			current := vipOwner{owner: spec.Owner, owned: spec.Owned}
+
+			seen[status.Metadata().ID()] = struct{}{}
+
+			if previous, ok := ctrl.owners[status.Metadata().ID()]; ok && previous == current {
+				continue
+			}
+
+			ctrl.owners[status.Metadata().ID()] = current
+
+			// the owner is not known yet
+			if spec.Owner == "" && !spec.Owned {
+				continue
+			}
+
+			ctrl.V1Alpha1Events.Publish(ctx, &machine.VIPEvent{
+				Ip:            spec.IP.String(),
+				Link:          spec.LinkName,
+				Owner:         spec.Owner,
+				OwnerPriority: spec.OwnerPriority,
+				Owned:         spec.Owned,
+			})
+		}
+
+		for id := range ctrl.owners {
+			if _, ok := seen[id]; !ok {
+				delete(ctrl.owners, id)
+			}
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
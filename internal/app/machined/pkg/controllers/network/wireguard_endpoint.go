@@ -0,0 +1,361 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"os"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+	"golang.zx2c4.com/wireguard/wgctrl"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/adapters/wireguard"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/kubespan"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// DefaultWireguardEndpointResolveInterval is the default interval to re-resolve Wireguard peer endpoints specified as hostnames.
+const DefaultWireguardEndpointResolveInterval = time.Minute
+
+// WireguardClient is the subset of the Wireguard client used by the WireguardEndpointController.
+type WireguardClient interface {
+	Device(name string) (*wgtypes.Device, error)
+	ConfigureDevice(name string, cfg wgtypes.Config) error
+	Close() error
+}
+
+// WireguardEndpointController re-resolves Wireguard peer endpoints specified as hostnames.
+//
+// The peer endpoint is updated only if the resolved address changed and the last handshake is stale,
+// so that a working tunnel (e.g. roamed to a different endpoint) is never disrupted.
+//
+// KubeSpan extra announced endpoints specified as hostnames are re-resolved as well,
+// the resolved addresses are announced to the KubeSpan peers via the local affiliate.
+type WireguardEndpointController struct {
+	// NewClient creates the Wireguard client, defaults to wgctrl.New.
+	NewClient func() (WireguardClient, error)
+	// Resolve resolves the hostname:port endpoint, defaults to the DNS lookup.
+	Resolve func(ctx context.Context, endpoint string) ([]netip.AddrPort, error)
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *WireguardEndpointController) Name() string {
+	return "network.WireguardEndpointController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *WireguardEndpointController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.LinkSpecType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: config.NamespaceName,
+			Type:      kubespan.ConfigType,
+			ID:        optional.Some(kubespan.ConfigID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *WireguardEndpointController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.WireguardEndpointStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *WireguardEndpointController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.NewClient == nil {
+		ctrl.NewClient = func() (WireguardClient, error) {
+			return wgctrl.New()
+		}
+	}
+
+	if ctrl.Resolve == nil {
+		ctrl.Resolve = resolveWireguardEndpoint
+	}
+
+	wgClient, err := ctrl.NewClient()
+	if err != nil {
+		logger.Warn("error creating wireguard client", zap.Error(err))
+
+		return nil
+	}
+
+	defer wgClient.Close() //nolint:errcheck
+
+	timer := time.NewTimer(DefaultWireguardEndpointResolveInterval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-timer.C:
+		}
+
+		nextCheck, err := ctrl.reconcile(ctx, r, logger, wgClient)
+		if err != nil {
+			return err
+		}
+
+		timer.Reset(nextCheck)
+
+		r.ResetRestartBackoff()
+	}
+}
+
+//nolint:gocyclo
+func (ctrl *WireguardEndpointController) reconcile(ctx context.Context, r controller.Runtime, logger *zap.Logger, wgClient WireguardClient) (time.Duration, error) {
+	r.StartTrackingOutputs()
+
+	nextCheck := DefaultWireguardEndpointResolveInterval
+
+	links, err := safe.ReaderListAll[*network.LinkSpec](ctx, r)
+	if err != nil {
+		return 0, fmt.Errorf("error listing link specs: %w", err)
+	}
+
+	for link := range links.All() {
+		if link.Metadata().Phase() != resource.PhaseRunning || link.TypedSpec().Kind != network.LinkKindWireguard {
+			continue
+		}
+
+		interval := link.TypedSpec().Wireguard.EndpointResolveInterval
+		if interval <= 0 {
+			interval = DefaultWireguardEndpointResolveInterval
+		}
+
+		linkName := link.TypedSpec().Name
+
+		dev, err := wgClient.Device(linkName)
+		if err != nil {
+			if !errors.Is(err, os.ErrNotExist) {
+				logger.Warn("error getting wireguard device", zap.String("link", linkName), zap.Error(err))
+			}
+
+			dev = nil
+		}
+
+		for _, peer := range link.TypedSpec().Wireguard.Peers {
+			if !peer.HasHostnameEndpoint() {
+				continue
+			}
+
+			id := network.WireguardEndpointStatusID(linkName, peer.PublicKey)
+
+			existing, err := safe.ReaderGetByID[*network.WireguardEndpointStatus](ctx, r, id)
+			if err != nil && !state.IsNotFoundError(err) {
+				return 0, fmt.Errorf("error getting wireguard endpoint status: %w", err)
+			}
+
+			status := network.WireguardEndpointStatusSpec{
+				LinkName:  linkName,
+				PublicKey: peer.PublicKey,
+				Endpoint:  peer.Endpoint,
+			}
+
+			if existing != nil && existing.TypedSpec().Endpoint == peer.Endpoint {
+				status.ResolvedEndpoint = existing.TypedSpec().ResolvedEndpoint
+				status.LastResolved = existing.TypedSpec().LastResolved
+				status.LastError = existing.TypedSpec().LastError
+			}
+
+			var devPeer *wgtypes.Peer
+
+			if dev != nil {
+				if idx := slices.IndexFunc(dev.Peers, func(p wgtypes.Peer) bool { return p.PublicKey.String() == peer.PublicKey }); idx != -1 {
+					devPeer = &dev.Peers[idx]
+				}
+			}
+
+			if devPeer != nil {
+				if devPeer.Endpoint != nil {
+					status.CurrentEndpoint = devPeer.Endpoint.AddrPort()
+				}
+
+				status.LastHandshake = devPeer.LastHandshakeTime
+			}
+
+			nextCheck = min(nextCheck, ctrl.resolve(ctx, logger, &status, interval))
+
+			if devPeer != nil && status.ResolvedEndpoint.IsValid() && status.ResolvedEndpoint != status.CurrentEndpoint &&
+				time.Since(status.LastHandshake) > wireguard.PeerDownInterval {
+				if err = wgClient.ConfigureDevice(linkName, wgtypes.Config{
+					Peers: []wgtypes.PeerConfig{
+						{
+							PublicKey:  devPeer.PublicKey,
+							UpdateOnly: true,
+							Endpoint:   net.UDPAddrFromAddrPort(status.ResolvedEndpoint),
+						},
+					},
+				}); err != nil {
+					status.LastError = fmt.Sprintf("error updating peer endpoint: %s", err)
+
+					logger.Warn("error updating wireguard peer endpoint", zap.String("link", linkName), zap.Error(err))
+				} else {
+					logger.Info("updated wireguard peer endpoint",
+						zap.String("link", linkName),
+						zap.String("endpoint", peer.Endpoint),
+						zap.Stringer("old", status.CurrentEndpoint),
+						zap.Stringer("new", status.ResolvedEndpoint),
+					)
+
+					status.CurrentEndpoint = status.ResolvedEndpoint
+				}
+			}
+
+			if err = safe.WriterModify(ctx, r, network.NewWireguardEndpointStatus(network.NamespaceName, id), func(res *network.WireguardEndpointStatus) error {
+				*res.TypedSpec() = status
+
+				return nil
+			}); err != nil {
+				return 0, fmt.Errorf("error modifying wireguard endpoint status: %w", err)
+			}
+		}
+	}
+
+	if err = ctrl.reconcileKubeSpan(ctx, r, logger, &nextCheck); err != nil {
+		return 0, err
+	}
+
+	if err = safe.CleanupOutputs[*network.WireguardEndpointStatus](ctx, r); err != nil {
+		return 0, err
+	}
+
+	return nextCheck, nil
+}
+
+// reconcileKubeSpan re-resolves the KubeSpan extra announced endpoints specified as hostnames.
+func (ctrl *WireguardEndpointController) reconcileKubeSpan(ctx context.Context, r controller.Runtime, logger *zap.Logger, nextCheck *time.Duration) error {
+	cfg, err := safe.ReaderGetByID[*kubespan.Config](ctx, r, kubespan.ConfigID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil
+		}
+
+		return fmt.Errorf("error getting kubespan config: %w", err)
+	}
+
+	if !cfg.TypedSpec().Enabled {
+		return nil
+	}
+
+	for _, endpoint := range cfg.TypedSpec().ExtraHostnameEndpoints {
+		id := network.WireguardEndpointStatusID(constants.KubeSpanLinkName, endpoint)
+
+		existing, err := safe.ReaderGetByID[*network.WireguardEndpointStatus](ctx, r, id)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting wireguard endpoint status: %w", err)
+		}
+
+		status := network.WireguardEndpointStatusSpec{
+			LinkName: constants.KubeSpanLinkName,
+			Endpoint: endpoint,
+		}
+
+		if existing != nil {
+			status.ResolvedEndpoint = existing.TypedSpec().ResolvedEndpoint
+			status.LastResolved = existing.TypedSpec().LastResolved
+			status.LastError = existing.TypedSpec().LastError
+		}
+
+		*nextCheck = min(*nextCheck, ctrl.resolve(ctx, logger, &status, DefaultWireguardEndpointResolveInterval))
+
+		if err = safe.WriterModify(ctx, r, network.NewWireguardEndpointStatus(network.NamespaceName, id), func(res *network.WireguardEndpointStatus) error {
+			*res.TypedSpec() = status
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error modifying wireguard endpoint status: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// resolve re-resolves the endpoint if the interval has passed since the last resolution, and returns the time until the next one.
+func (ctrl *WireguardEndpointController) resolve(ctx context.Context, logger *zap.Logger, status *network.WireguardEndpointStatusSpec, interval time.Duration) time.Duration {
+	if sinceResolved := time.Since(status.LastResolved); sinceResolved < interval {
+		return interval - sinceResolved
+	}
+
+	status.LastResolved = time.Now()
+	status.LastError = ""
+
+	addrs, err := ctrl.Resolve(ctx, status.Endpoint)
+	if err != nil {
+		status.LastError = err.Error()
+
+		logger.Warn("error resolving wireguard peer endpoint", zap.String("link", status.LinkName), zap.String("endpoint", status.Endpoint), zap.Error(err))
+
+		return interval
+	}
+
+	// prefer the address currently in use, if the hostname still resolves to it
+	status.ResolvedEndpoint = addrs[0]
+
+	if slices.Contains(addrs, status.CurrentEndpoint) {
+		status.ResolvedEndpoint = status.CurrentEndpoint
+	}
+
+	return interval
+}
+
+// resolveWireguardEndpoint resolves the hostname:port endpoint to the list of addresses.
+func resolveWireguardEndpoint(ctx context.Context, endpoint string) ([]netip.AddrPort, error) {
+	host, portStr, err := net.SplitHostPort(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("invalid port %q: %w", portStr, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(addrs) == 0 {
+		return nil, fmt.Errorf("no addresses found for %q", host)
+	}
+
+	result := make([]netip.AddrPort, 0, len(addrs))
+
+	for _, addr := range addrs {
+		result = append(result, netip.AddrPortFrom(addr.Unmap(), uint16(port)))
+	}
+
+	return result, nil
+}
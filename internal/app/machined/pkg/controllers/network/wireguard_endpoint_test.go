@@ -0,0 +1,314 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	networkctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/kubespan"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+const (
+	wgPeerKey1 = "BoI1LhmoOqIg+xPdiSBtbU7ZRi/zNCWcvTF/wa2bpKY="
+	wgPeerKey2 = "jTs0IXfIKf0GGEuEpx+jNA4+u6+r1VQGmKFnBnPoeOQ="
+	wgPeerKey3 = "6p7fw8PgjnAjH+wJ5HzefwPHCJdr6pTKvXtJWhl/zz4="
+)
+
+type mockWireguardClient struct {
+	mu sync.Mutex
+
+	devices    map[string]*wgtypes.Device
+	configured []wgtypes.PeerConfig
+}
+
+func (m *mockWireguardClient) Device(name string) (*wgtypes.Device, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	dev, ok := m.devices[name]
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+
+	devCopy := *dev
+	devCopy.Peers = append([]wgtypes.Peer(nil), dev.Peers...)
+
+	return &devCopy, nil
+}
+
+func (m *mockWireguardClient) ConfigureDevice(name string, cfg wgtypes.Config) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	dev, ok := m.devices[name]
+	if !ok {
+		return os.ErrNotExist
+	}
+
+	for _, peerConfig := range cfg.Peers {
+		m.configured = append(m.configured, peerConfig)
+
+		for i := range dev.Peers {
+			if dev.Peers[i].PublicKey == peerConfig.PublicKey {
+				dev.Peers[i].Endpoint = peerConfig.Endpoint
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *mockWireguardClient) Close() error {
+	return nil
+}
+
+func (m *mockWireguardClient) Configured() []wgtypes.PeerConfig {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]wgtypes.PeerConfig(nil), m.configured...)
+}
+
+type mockResolver struct {
+	mu sync.Mutex
+
+	addrs map[string][]netip.AddrPort
+}
+
+func (m *mockResolver) Resolve(_ context.Context, endpoint string) ([]netip.AddrPort, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	addrs := m.addrs[endpoint]
+	if len(addrs) == 0 {
+		return nil, fmt.Errorf("no such host %q", endpoint)
+	}
+
+	return addrs, nil
+}
+
+func (m *mockResolver) Set(endpoint string, addrs ...netip.AddrPort) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.addrs[endpoint] = addrs
+}
+
+type WireguardEndpointSuite struct {
+	ctest.DefaultSuite
+
+	client   *mockWireguardClient
+	resolver *mockResolver
+}
+
+func mustParseKey(key string) wgtypes.Key {
+	k, err := wgtypes.ParseKey(key)
+	if err != nil {
+		panic(err)
+	}
+
+	return k
+}
+
+func wireguardPeer(key, endpoint string, lastHandshake time.Time) wgtypes.Peer {
+	return wgtypes.Peer{
+		PublicKey:         mustParseKey(key),
+		Endpoint:          net.UDPAddrFromAddrPort(netip.MustParseAddrPort(endpoint)),
+		LastHandshakeTime: lastHandshake,
+	}
+}
+
+func (suite *WireguardEndpointSuite) createLink(name string, peers ...network.WireguardPeer) *network.LinkSpec {
+	link := network.NewLinkSpec(network.NamespaceName, name)
+	link.TypedSpec().Name = name
+	link.TypedSpec().Kind = network.LinkKindWireguard
+	link.TypedSpec().Wireguard.Peers = peers
+	link.TypedSpec().Wireguard.EndpointResolveInterval = 100 * time.Millisecond
+
+	suite.Create(link)
+
+	return link
+}
+
+func (suite *WireguardEndpointSuite) TestStalePeer() {
+	suite.client.devices["wg0"] = &wgtypes.Device{
+		Name: "wg0",
+		Peers: []wgtypes.Peer{
+			wireguardPeer(wgPeerKey1, "10.0.0.1:51820", time.Time{}),
+			wireguardPeer(wgPeerKey2, "10.0.0.5:51820", time.Time{}),
+		},
+	}
+
+	suite.resolver.Set("hub.example.com:51820", netip.MustParseAddrPort("10.0.0.2:51820"))
+
+	link := suite.createLink("wg0",
+		network.WireguardPeer{PublicKey: wgPeerKey1, Endpoint: "hub.example.com:51820"},
+		network.WireguardPeer{PublicKey: wgPeerKey2, Endpoint: "10.0.0.5:51820"},
+	)
+
+	ctest.AssertResource(suite, network.WireguardEndpointStatusID("wg0", wgPeerKey1), func(r *network.WireguardEndpointStatus, asrt *assert.Assertions) {
+		spec := r.TypedSpec()
+
+		asrt.Equal("wg0", spec.LinkName)
+		asrt.Equal(wgPeerKey1, spec.PublicKey)
+		asrt.Equal("hub.example.com:51820", spec.Endpoint)
+		asrt.Equal(netip.MustParseAddrPort("10.0.0.2:51820"), spec.ResolvedEndpoint)
+		asrt.Equal(netip.MustParseAddrPort("10.0.0.2:51820"), spec.CurrentEndpoint)
+		asrt.NotZero(spec.LastResolved)
+		asrt.Empty(spec.LastError)
+	})
+
+	// the peer with the IP endpoint is not tracked
+	ctest.AssertNoResource[*network.WireguardEndpointStatus](suite, network.WireguardEndpointStatusID("wg0", wgPeerKey2))
+
+	configured := suite.client.Configured()
+	suite.Require().Len(configured, 1)
+	suite.Assert().Equal(wgPeerKey1, configured[0].PublicKey.String())
+	suite.Assert().True(configured[0].UpdateOnly)
+	suite.Assert().Equal("10.0.0.2:51820", configured[0].Endpoint.String())
+
+	// the hostname is re-resolved on the interval
+	suite.resolver.Set("hub.example.com:51820", netip.MustParseAddrPort("10.0.0.3:51820"))
+
+	ctest.AssertResource(suite, network.WireguardEndpointStatusID("wg0", wgPeerKey1), func(r *network.WireguardEndpointStatus, asrt *assert.Assertions) {
+		asrt.Equal(netip.MustParseAddrPort("10.0.0.3:51820"), r.TypedSpec().ResolvedEndpoint)
+		asrt.Equal(netip.MustParseAddrPort("10.0.0.3:51820"), r.TypedSpec().CurrentEndpoint)
+	})
+
+	// resolve errors are reported
+	suite.resolver.Set("hub.example.com:51820")
+
+	ctest.AssertResource(suite, network.WireguardEndpointStatusID("wg0", wgPeerKey1), func(r *network.WireguardEndpointStatus, asrt *assert.Assertions) {
+		asrt.Equal(netip.MustParseAddrPort("10.0.0.3:51820"), r.TypedSpec().ResolvedEndpoint)
+		asrt.NotEmpty(r.TypedSpec().LastError)
+	})
+
+	suite.Destroy(link)
+
+	ctest.AssertNoResource[*network.WireguardEndpointStatus](suite, network.WireguardEndpointStatusID("wg0", wgPeerKey1))
+}
+
+func (suite *WireguardEndpointSuite) TestActivePeer() {
+	suite.client.devices["wg1"] = &wgtypes.Device{
+		Name: "wg1",
+		Peers: []wgtypes.Peer{
+			wireguardPeer(wgPeerKey3, "10.0.1.1:51820", time.Now()),
+		},
+	}
+
+	suite.resolver.Set("active.example.com:51820", netip.MustParseAddrPort("10.0.1.2:51820"))
+
+	suite.createLink("wg1",
+		network.WireguardPeer{PublicKey: wgPeerKey3, Endpoint: "active.example.com:51820"},
+	)
+
+	// the handshake is recent, the working tunnel is not disrupted
+	ctest.AssertResource(suite, network.WireguardEndpointStatusID("wg1", wgPeerKey3), func(r *network.WireguardEndpointStatus, asrt *assert.Assertions) {
+		asrt.Equal(netip.MustParseAddrPort("10.0.1.2:51820"), r.TypedSpec().ResolvedEndpoint)
+		asrt.Equal(netip.MustParseAddrPort("10.0.1.1:51820"), r.TypedSpec().CurrentEndpoint)
+	})
+
+	suite.Assert().Empty(suite.client.Configured())
+
+	// the hostname resolves to the address in use, among others
+	suite.resolver.Set("active.example.com:51820", netip.MustParseAddrPort("10.0.1.3:51820"), netip.MustParseAddrPort("10.0.1.1:51820"))
+
+	ctest.AssertResource(suite, network.WireguardEndpointStatusID("wg1", wgPeerKey3), func(r *network.WireguardEndpointStatus, asrt *assert.Assertions) {
+		asrt.Equal(netip.MustParseAddrPort("10.0.1.1:51820"), r.TypedSpec().ResolvedEndpoint)
+	})
+
+	suite.Assert().Empty(suite.client.Configured())
+}
+
+func (suite *WireguardEndpointSuite) TestKubeSpan() {
+	suite.resolver.Set("hub.example.com:51820", netip.MustParseAddrPort("192.0.2.1:51820"))
+
+	ksConfig := kubespan.NewConfig(config.NamespaceName, kubespan.ConfigID)
+	ksConfig.TypedSpec().ExtraHostnameEndpoints = []string{"hub.example.com:51820"}
+	suite.Create(ksConfig)
+
+	// KubeSpan is disabled
+	ctest.AssertNoResource[*network.WireguardEndpointStatus](suite, network.WireguardEndpointStatusID(constants.KubeSpanLinkName, "hub.example.com:51820"))
+
+	ksConfig.TypedSpec().Enabled = true
+	suite.Update(ksConfig)
+
+	ctest.AssertResource(suite, network.WireguardEndpointStatusID(constants.KubeSpanLinkName, "hub.example.com:51820"),
+		func(r *network.WireguardEndpointStatus, asrt *assert.Assertions) {
+			spec := r.TypedSpec()
+
+			asrt.Equal(constants.KubeSpanLinkName, spec.LinkName)
+			asrt.Empty(spec.PublicKey)
+			asrt.Equal("hub.example.com:51820", spec.Endpoint)
+			asrt.Equal(netip.MustParseAddrPort("192.0.2.1:51820"), spec.ResolvedEndpoint)
+			asrt.Empty(spec.LastError)
+		})
+
+	ksConfig.TypedSpec().ExtraHostnameEndpoints = nil
+	suite.Update(ksConfig)
+
+	ctest.AssertNoResource[*network.WireguardEndpointStatus](suite, network.WireguardEndpointStatusID(constants.KubeSpanLinkName, "hub.example.com:51820"))
+
+	suite.Assert().Empty(suite.client.Configured())
+}
+
+func (suite *WireguardEndpointSuite) TestNoDevice() {
+	suite.resolver.Set("missing.example.com:51820", netip.MustParseAddrPort("10.0.2.2:51820"))
+
+	suite.createLink("wg2",
+		network.WireguardPeer{PublicKey: wgPeerKey1, Endpoint: "missing.example.com:51820"},
+	)
+
+	// the device is not created yet, the endpoint is still resolved
+	ctest.AssertResource(suite, network.WireguardEndpointStatusID("wg2", wgPeerKey1), func(r *network.WireguardEndpointStatus, asrt *assert.Assertions) {
+		asrt.Equal(netip.MustParseAddrPort("10.0.2.2:51820"), r.TypedSpec().ResolvedEndpoint)
+		asrt.False(r.TypedSpec().CurrentEndpoint.IsValid())
+	})
+
+	suite.Assert().Empty(suite.client.Configured())
+}
+
+func TestWireguardEndpointSuite(t *testing.T) {
+	t.Parallel()
+
+	s := &WireguardEndpointSuite{}
+
+	s.DefaultSuite = ctest.DefaultSuite{
+		Timeout: 10 * time.Second,
+		AfterSetup: func(suite *ctest.DefaultSuite) {
+			s.client = &mockWireguardClient{
+				devices: map[string]*wgtypes.Device{},
+			}
+			s.resolver = &mockResolver{
+				addrs: map[string][]netip.AddrPort{},
+			}
+
+			suite.Require().NoError(suite.Runtime().RegisterController(&networkctrl.WireguardEndpointController{
+				NewClient: func() (networkctrl.WireguardClient, error) {
+					return s.client, nil
+				},
+				Resolve: s.resolver.Resolve,
+			}))
+		},
+	}
+
+	suite.Run(t, s)
+}
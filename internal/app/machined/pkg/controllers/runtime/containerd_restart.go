@@ -0,0 +1,215 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/files"
+)
+
+// ContainerdRestartController restarts containerd instances when the containerd configuration patches change.
+//
+// The CRI containerd instance is restarted together with the kubelet, it is also restarted when the base runtime spec
+// or the default security profiles change.
+type ContainerdRestartController struct {
+	V1Alpha1Services ServiceManager
+
+	appliedCRIConfig   optional.Optional[string]
+	appliedSystemPatch optional.Optional[string]
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *ContainerdRestartController) Name() string {
+	return "runtime.ContainerdRestartController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *ContainerdRestartController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: files.NamespaceName,
+			Type:      files.EtcFileSpecType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: files.NamespaceName,
+			Type:      files.EtcFileStatusType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *ContainerdRestartController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *ContainerdRestartController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		if err := ctrl.reconcileCRI(ctx, r, logger); err != nil {
+			return err
+		}
+
+		if err := ctrl.reconcileSystem(ctx, r, logger); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// reconcileCRI restarts the CRI containerd instance once the merged CRI config containing the new patch
+// or the new default security profiles is written.
+func (ctrl *ContainerdRestartController) reconcileCRI(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	spec, upToDate, err := ctrl.getWrittenFile(ctx, r, constants.CRIConfig)
+	if err != nil || !upToDate {
+		return err
+	}
+
+	patchChecksum, ok := spec.Metadata().Annotations().Get(files.SourceFileAnnotation + ":" + filepath.Join("/etc", constants.CRIConfigPatchPart))
+	if !ok {
+		// patch part is not merged yet
+		return nil
+	}
+
+	securityProfilesChecksum, _ := spec.Metadata().Annotations().Get(files.SourceFileAnnotation + ":" + filepath.Join("/etc", constants.CRISecurityProfilesConfigPart))
+
+	// base runtime spec is not merged into the CRI config, it is read by the CRI on startup
+	baseSpec, upToDate, err := ctrl.getWrittenFile(ctx, r, constants.CRIBaseRuntimeSpec)
+	if err != nil || !upToDate {
+		return err
+	}
+
+	baseSpecSum := sha256.Sum256(baseSpec.TypedSpec().Contents)
+
+	checksum := strings.Join([]string{patchChecksum, securityProfilesChecksum, hex.EncodeToString(baseSpecSum[:])}, ":")
+
+	applied, initialized := ctrl.appliedCRIConfig.Get()
+	ctrl.appliedCRIConfig = optional.Some(checksum)
+
+	// CRI service waits for /etc files to be ready, so on startup it's already running with the current config
+	if !initialized || applied == checksum {
+		return nil
+	}
+
+	if !ctrl.isRunning("cri") {
+		return nil
+	}
+
+	logger.Info("restarting CRI containerd to apply config changes")
+
+	kubeletRunning := ctrl.isRunning("kubelet")
+
+	if kubeletRunning {
+		if err := ctrl.V1Alpha1Services.Stop(ctx, "kubelet"); err != nil {
+			return fmt.Errorf("error stopping kubelet service: %w", err)
+		}
+	}
+
+	if err := ctrl.restart(ctx, "cri"); err != nil {
+		return err
+	}
+
+	if kubeletRunning {
+		if err := ctrl.V1Alpha1Services.Start("kubelet"); err != nil {
+			return fmt.Errorf("error starting kubelet service: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// reconcileSystem restarts the system containerd instance once the new patch is written.
+func (ctrl *ContainerdRestartController) reconcileSystem(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	spec, upToDate, err := ctrl.getWrittenFile(ctx, r, constants.SystemContainerdConfigPatch)
+	if err != nil || !upToDate {
+		return err
+	}
+
+	sum := sha256.Sum256(spec.TypedSpec().Contents)
+	checksum := hex.EncodeToString(sum[:])
+
+	applied, initialized := ctrl.appliedSystemPatch.Get()
+	ctrl.appliedSystemPatch = optional.Some(checksum)
+
+	// system containerd is started early in the boot, before the patch is written
+	if initialized && applied == checksum {
+		return nil
+	}
+
+	if !initialized && len(spec.TypedSpec().Contents) == 0 {
+		return nil
+	}
+
+	if !ctrl.isRunning("containerd") {
+		return nil
+	}
+
+	logger.Info("restarting system containerd to apply config patch")
+
+	return ctrl.restart(ctx, "containerd")
+}
+
+// getWrittenFile returns the file spec and whether the file was written with the latest spec version.
+func (ctrl *ContainerdRestartController) getWrittenFile(ctx context.Context, r controller.Runtime, id string) (*files.EtcFileSpec, bool, error) {
+	spec, err := safe.ReaderGetByID[*files.EtcFileSpec](ctx, r, id)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, false, nil
+		}
+
+		return nil, false, fmt.Errorf("error getting file spec %q: %w", id, err)
+	}
+
+	status, err := safe.ReaderGetByID[*files.EtcFileStatus](ctx, r, id)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, false, nil
+		}
+
+		return nil, false, fmt.Errorf("error getting file status %q: %w", id, err)
+	}
+
+	return spec, status.TypedSpec().SpecVersion == spec.Metadata().Version().String(), nil
+}
+
+func (ctrl *ContainerdRestartController) isRunning(id string) bool {
+	// error is returned if the service is not loaded
+	_, running, err := ctrl.V1Alpha1Services.IsRunning(id)
+
+	return err == nil && running
+}
+
+func (ctrl *ContainerdRestartController) restart(ctx context.Context, id string) error {
+	if err := ctrl.V1Alpha1Services.Stop(ctx, id); err != nil {
+		return fmt.Errorf("error stopping %s service: %w", id, err)
+	}
+
+	if err := ctrl.V1Alpha1Services.Start(id); err != nil {
+		return fmt.Errorf("error starting %s service: %w", id, err)
+	}
+
+	return nil
+}
@@ -51,6 +51,11 @@ func (ctrl *DiagnosticsController) Inputs() []controller.Input {
 			Type:      k8s.NodenameType,
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: runtime.NamespaceName,
+			Type:      runtime.ProcessReaperStatusType,
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
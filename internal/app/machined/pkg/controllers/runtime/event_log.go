@@ -0,0 +1,338 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/rs/xid"
+	"github.com/siderolabs/gen/channel"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	blockadapter "github.com/siderolabs/talos/internal/app/machined/pkg/adapters/block"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/automaton"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/automaton/blockautomaton"
+	machinedruntime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/pkg/eventlog"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/xfs"
+)
+
+// EventLogController persists the machine events on the STATE partition,
+// and loads the events persisted during the previous boots into the event stream.
+//
+// Events are written in batches, the batch is flushed immediately on sequence events and events
+// with warning or error severity, so that the events preceding a reboot are not lost.
+type EventLogController struct {
+	V1Alpha1Events machinedruntime.EventStream
+	// FlushInterval defaults to constants.EventLogFlushInterval.
+	FlushInterval time.Duration
+
+	log          *eventlog.Log
+	stateMachine blockautomaton.VolumeMounterAutomaton
+	eventID      xid.ID
+	loaded       bool
+
+	pending, flushing []pendingEvent
+	pendingSize       int
+}
+
+type pendingEvent struct {
+	severity machinedruntime.EventSeverity
+	data     []byte
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *EventLogController) Name() string {
+	return "runtime.EventLogController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *EventLogController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.ActiveID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: block.NamespaceName,
+			Type:      block.VolumeMountStatusType,
+			Kind:      controller.InputStrong,
+		},
+		{
+			Namespace: block.NamespaceName,
+			Type:      block.VolumeMountRequestType,
+			Kind:      controller.InputDestroyReady,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *EventLogController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: block.VolumeMountRequestType,
+			Kind: controller.OutputShared,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo,cyclop
+func (ctrl *EventLogController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	flushInterval := ctrl.FlushInterval
+	if flushInterval == 0 {
+		flushInterval = constants.EventLogFlushInterval
+	}
+
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+
+	watchCh := make(chan machinedruntime.EventInfo)
+	watchDone := make(chan struct{})
+
+	var opts []machinedruntime.WatchOptionFunc
+
+	if ctrl.eventID.IsNil() {
+		opts = append(opts, machinedruntime.WithTailEvents(-1))
+	} else {
+		opts = append(opts, machinedruntime.WithTailID(ctrl.eventID))
+	}
+
+	// Watch returns immediately, setting up a goroutine which will copy events to `watchCh`
+	if err := ctrl.V1Alpha1Events.Watch(func(eventCh <-chan machinedruntime.EventInfo) {
+		defer close(watchDone)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-eventCh:
+				if !ok {
+					return
+				}
+
+				if !channel.SendWithContext(ctx, watchCh, event) {
+					return
+				}
+			}
+		}
+	}, opts...); err != nil {
+		return err
+	}
+
+	for {
+		flush := false
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-watchDone:
+			// the watch is aborted if the controller falls behind the event stream, restart it
+			return errors.New("event stream watch terminated")
+		case event := <-watchCh:
+			if !ctrl.enqueue(event, logger) {
+				continue
+			}
+
+			flush = true
+		case <-ticker.C:
+			flush = true
+		case <-r.EventCh():
+		}
+
+		maxSize, minSeverity, err := ctrl.readConfig(ctx, r)
+		if err != nil {
+			return err
+		}
+
+		if ctrl.log == nil {
+			ctrl.log = eventlog.New(constants.EventLogFilename, int64(maxSize))
+		}
+
+		ctrl.log.SetMaxSize(int64(maxSize))
+
+		if flush && ctrl.flushing == nil {
+			for _, event := range ctrl.pending {
+				if event.severity >= minSeverity {
+					ctrl.flushing = append(ctrl.flushing, event)
+				}
+			}
+
+			ctrl.pending, ctrl.pendingSize = nil, 0
+		}
+
+		if ctrl.stateMachine == nil && (!ctrl.loaded || ctrl.flushing != nil) {
+			ctrl.stateMachine = blockautomaton.NewVolumeMounter(
+				ctrl.Name(),
+				constants.StatePartitionLabel,
+				ctrl.persist(),
+				blockautomaton.WithDetached(true),
+			)
+		}
+
+		if ctrl.stateMachine != nil {
+			if err := ctrl.stateMachine.Run(ctx, r, logger,
+				automaton.WithAfterFunc(func() error {
+					ctrl.stateMachine = nil
+
+					return nil
+				}),
+			); err != nil {
+				return fmt.Errorf("error running volume mounter machine: %w", err)
+			}
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// enqueue adds the event to the pending batch, it returns true if the batch should be flushed immediately.
+func (ctrl *EventLogController) enqueue(event machinedruntime.EventInfo, logger *zap.Logger) bool {
+	ctrl.eventID = event.ID
+
+	if event.Payload == nil {
+		return false
+	}
+
+	msg, err := event.ToMachineEvent()
+	if err != nil {
+		logger.Debug("failed to serialize event", zap.Error(err))
+
+		return false
+	}
+
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		logger.Debug("failed to marshal event", zap.Error(err))
+
+		return false
+	}
+
+	severity := event.Severity()
+
+	ctrl.pending = append(ctrl.pending, pendingEvent{
+		severity: severity,
+		data:     data,
+	})
+	ctrl.pendingSize += len(data)
+
+	// drop the oldest events if the STATE partition is not available for a long time
+	for len(ctrl.pending) > 1 && ctrl.pendingSize > constants.EventLogDefaultMaxSize {
+		ctrl.pendingSize -= len(ctrl.pending[0].data)
+		ctrl.pending = ctrl.pending[1:]
+	}
+
+	_, isSequence := event.Payload.(*machine.SequenceEvent)
+
+	return isSequence || severity >= machinedruntime.EventSeverityWarning
+}
+
+func (ctrl *EventLogController) readConfig(ctx context.Context, r controller.Reader) (uint64, machinedruntime.EventSeverity, error) {
+	maxSize, minSeverity := uint64(constants.EventLogDefaultMaxSize), constants.EventLogDefaultMinSeverity
+
+	cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.ActiveID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return 0, 0, fmt.Errorf("error getting machine config: %w", err)
+	}
+
+	if cfg != nil && cfg.Config().EventLogConfig() != nil {
+		maxSize = cfg.Config().EventLogConfig().MaxSize()
+		minSeverity = cfg.Config().EventLogConfig().MinSeverity()
+	}
+
+	severity, err := machinedruntime.ParseEventSeverity(minSeverity)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return maxSize, severity, nil
+}
+
+func (ctrl *EventLogController) persist() func(
+	ctx context.Context, r controller.ReaderWriter, logger *zap.Logger, mountStatus *block.VolumeMountStatus,
+) error {
+	return func(ctx context.Context, r controller.ReaderWriter, logger *zap.Logger, mountStatus *block.VolumeMountStatus) error {
+		return blockadapter.VolumeMountStatus(mountStatus).WithRoot(logger, func(root xfs.Root) error {
+			if !ctrl.loaded {
+				ctrl.V1Alpha1Events.LoadHistory(ctrl.load(root, logger))
+
+				ctrl.loaded = true
+			}
+
+			if ctrl.flushing == nil {
+				return nil
+			}
+
+			records := make([][]byte, 0, len(ctrl.flushing))
+
+			for _, event := range ctrl.flushing {
+				records = append(records, event.data)
+			}
+
+			if err := ctrl.log.Append(root, records); err != nil {
+				return fmt.Errorf("error persisting events: %w", err)
+			}
+
+			logger.Debug("persisted events", zap.Int("count", len(records)))
+
+			ctrl.flushing = nil
+
+			return nil
+		})
+	}
+}
+
+// load reads the persisted events, skipping the records which can't be decoded.
+func (ctrl *EventLogController) load(root xfs.Root, logger *zap.Logger) []machinedruntime.Event {
+	records, err := ctrl.log.ReadAll(root)
+	if err != nil {
+		logger.Warn("failed to read persisted events", zap.Error(err))
+
+		return nil
+	}
+
+	events := make([]machinedruntime.Event, 0, len(records))
+
+	for _, record := range records {
+		var msg machine.Event
+
+		if err = proto.Unmarshal(record, &msg); err != nil {
+			logger.Debug("skipping corrupted persisted event", zap.Error(err))
+
+			continue
+		}
+
+		event, err := machinedruntime.EventFromMachineEvent(&msg)
+		if err != nil {
+			logger.Debug("skipping persisted event", zap.Error(err))
+
+			continue
+		}
+
+		events = append(events, event)
+	}
+
+	logger.Info("loaded persisted events", zap.Int("count", len(events)))
+
+	return events
+}
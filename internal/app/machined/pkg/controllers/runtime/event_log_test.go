@@ -0,0 +1,156 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	runtimectrls "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
+	talosruntime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1"
+	"github.com/siderolabs/talos/internal/pkg/eventlog"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+	"github.com/siderolabs/talos/pkg/xfs"
+)
+
+type EventLogSuite struct {
+	ctest.DefaultSuite
+
+	events    *v1alpha1.Events
+	statePath string
+}
+
+func (suite *EventLogSuite) SetupTest() {
+	suite.events = v1alpha1.NewEvents(1000, 10)
+	suite.statePath = suite.T().TempDir()
+
+	suite.persistPreviousBoot(&xfs.OSRoot{Shadow: suite.statePath})
+
+	// events published before the controller starts are picked up from the stream
+	suite.events.Publish(context.Background(), &machine.TaskEvent{
+		Task:   "startEverything",
+		Action: machine.TaskEvent_START,
+	})
+	suite.events.Publish(context.Background(), &machine.SequenceEvent{
+		Sequence: "boot",
+		Action:   machine.SequenceEvent_STOP,
+	})
+
+	suite.DefaultSuite.SetupTest()
+}
+
+func (suite *EventLogSuite) persistPreviousBoot(root xfs.Root) {
+	event := talosruntime.Event{
+		ID:     xid.New(),
+		BootID: "previous-boot",
+		Payload: &machine.SequenceEvent{
+			Sequence: "reboot",
+			Action:   machine.SequenceEvent_START,
+		},
+	}
+
+	msg, err := event.ToMachineEvent()
+	suite.Require().NoError(err)
+
+	data, err := proto.Marshal(msg)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(eventlog.New(constants.EventLogFilename, constants.EventLogDefaultMaxSize).Append(root, [][]byte{data}))
+}
+
+func (suite *EventLogSuite) TestPersistAndLoad() {
+	root := &xfs.OSRoot{Shadow: suite.statePath}
+
+	mountID := (&runtimectrls.EventLogController{}).Name() + "-" + constants.StatePartitionLabel
+
+	ctest.AssertResource(suite, mountID, func(mountRequest *block.VolumeMountRequest, asrt *assert.Assertions) {
+		asrt.Equal(constants.StatePartitionLabel, mountRequest.TypedSpec().VolumeID)
+	})
+
+	volumeMountStatus := block.NewVolumeMountStatus(block.NamespaceName, mountID)
+	volumeMountStatus.TypedSpec().Target = suite.statePath
+	suite.Create(volumeMountStatus)
+
+	// the task event is below the default severity and is not persisted
+	suite.EventuallyWithT(func(collect *assert.CollectT) {
+		asrt := assert.New(collect)
+
+		records, err := eventlog.New(constants.EventLogFilename, constants.EventLogDefaultMaxSize).ReadAll(root)
+		asrt.NoError(err)
+
+		if !asrt.Len(records, 2) {
+			return
+		}
+
+		var msg machine.Event
+
+		asrt.NoError(proto.Unmarshal(records[1], &msg))
+		asrt.Equal(suite.events.BootID(), msg.BootId)
+	}, time.Second, 10*time.Millisecond)
+
+	// the events of the previous boot are replayed before the events of the current boot
+	ctx, cancel := context.WithTimeout(suite.Ctx(), time.Second)
+	defer cancel()
+
+	replayed := make(chan talosruntime.Event)
+
+	suite.Require().NoError(suite.events.Watch(func(eventCh <-chan talosruntime.EventInfo) {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-eventCh:
+				if !ok {
+					return
+				}
+
+				select {
+				case replayed <- event.Event:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}, talosruntime.WithSinceBoot(-1)))
+
+	for _, expected := range []string{"previous-boot", suite.events.BootID(), suite.events.BootID()} {
+		select {
+		case <-ctx.Done():
+			suite.FailNow("timed out waiting for events")
+		case event := <-replayed:
+			suite.Assert().Equal(expected, event.BootID)
+		}
+	}
+}
+
+func TestEventLogSuite(t *testing.T) {
+	t.Parallel()
+
+	s := &EventLogSuite{}
+
+	s.DefaultSuite = ctest.DefaultSuite{
+		Timeout: 5 * time.Second,
+		AfterSetup: func(suite *ctest.DefaultSuite) {
+			suite.Require().NoError(
+				suite.Runtime().RegisterController(&runtimectrls.EventLogController{
+					V1Alpha1Events: s.events,
+					FlushInterval:  100 * time.Millisecond,
+				}),
+			)
+		},
+	}
+
+	suite.Run(t, s)
+}
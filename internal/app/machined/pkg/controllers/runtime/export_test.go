@@ -4,5 +4,60 @@
 
 package runtime
 
+import (
+	"crypto/x509"
+
+	"github.com/siderolabs/talos/internal/pkg/miniprocfs"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
 // BuildExpectedImageNames is exported for testing.
 var BuildExpectedImageNames = buildExpectedImageNames
+
+// WatchdogFeedConditions is exported for testing.
+type WatchdogFeedConditions = watchdogFeedConditions
+
+// BlockedBy is exported for testing.
+func (c *WatchdogFeedConditions) BlockedBy(spec *runtime.WatchdogTimerConfigSpec, stage runtime.MachineStage, kubeletHealthy bool, probeErr error) string {
+	return c.blockedBy(spec, stage, kubeletHealthy, probeErr)
+}
+
+// ResolveUpgradeCheckpoint is exported for testing.
+var ResolveUpgradeCheckpoint = resolveUpgradeCheckpoint
+
+// ProcessTracker is exported for testing.
+type ProcessTracker = processTracker
+
+// Reap is exported for testing.
+func (t *ProcessTracker) Reap(pid int32) {
+	t.reap(pid)
+}
+
+// Sample is exported for testing.
+func (t *ProcessTracker) Sample(stats []miniprocfs.ProcessStat) {
+	t.sample(stats)
+}
+
+// Status is exported for testing.
+func (t *ProcessTracker) Status() runtime.ProcessReaperStatusSpec {
+	return t.status()
+}
+
+// SecureBootStatusSpec is exported for testing.
+func SecureBootStatusSpec(secureBoot, setupMode, bootedWithUKI bool, pk, kek, db, authorities []*x509.Certificate) runtime.SecureBootStatusSpec {
+	return secureBootState{
+		pk:            pk,
+		kek:           kek,
+		db:            db,
+		authorities:   authorities,
+		secureBoot:    secureBoot,
+		setupMode:     setupMode,
+		bootedWithUKI: bootedWithUKI,
+	}.spec()
+}
+
+// TPMStatusSpec is exported for testing.
+var TPMStatusSpec = tpmStatusSpec
+
+// MeasuredBootStatusSpec is exported for testing.
+var MeasuredBootStatusSpec = measuredBootStatusSpec
@@ -0,0 +1,156 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/pkg/extensions"
+	extconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// ExtensionVerificationController verifies the signatures of the installed system extensions.
+type ExtensionVerificationController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *ExtensionVerificationController) Name() string {
+	return "runtime.ExtensionVerificationController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *ExtensionVerificationController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: runtime.NamespaceName,
+			Type:      runtime.ExtensionStatusType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.ActiveID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *ExtensionVerificationController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: runtime.ExtensionVerificationStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *ExtensionVerificationController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.ActiveID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		extensionStatuses, err := safe.ReaderListAll[*runtime.ExtensionStatus](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error listing extension statuses: %w", err)
+		}
+
+		r.StartTrackingOutputs()
+
+		var (
+			policy    extconfig.ExtensionVerificationConfig
+			results   []extensions.VerificationResult
+			policyErr error
+		)
+
+		if cfg != nil {
+			policy = cfg.Config().ExtensionVerificationConfig()
+		}
+
+		if policy != nil {
+			layers := make([]*runtime.ExtensionStatusSpec, 0, extensionStatuses.Len())
+
+			for ext := range extensionStatuses.All() {
+				layers = append(layers, ext.TypedSpec())
+			}
+
+			// the policy is enforced by the installer, the failures are only reported here
+			results, err = extensions.Verify(layers, policy)
+			if err != nil {
+				logger.Warn("system extensions signature verification failed", zap.Error(err))
+
+				if results == nil {
+					policyErr = err
+				}
+			}
+		}
+
+		verification := make(map[*runtime.ExtensionStatusSpec]extensions.VerificationResult, len(results))
+
+		for _, result := range results {
+			verification[result.Layer] = result
+		}
+
+		for ext := range extensionStatuses.All() {
+			layer := ext.TypedSpec()
+
+			if err = safe.WriterModify(ctx, r, runtime.NewExtensionVerificationStatus(runtime.NamespaceName, ext.Metadata().ID()), func(res *runtime.ExtensionVerificationStatus) error {
+				spec := res.TypedSpec()
+
+				*spec = runtime.ExtensionVerificationStatusSpec{
+					Name: layer.Metadata.Name,
+				}
+
+				if layer.Source != nil {
+					spec.ImageRef = layer.Source.ImageRef
+					spec.Digest = layer.Source.Digest
+				}
+
+				result, ok := verification[layer]
+
+				switch {
+				case policy == nil:
+					spec.Error = "signature verification is not configured"
+				case policyErr != nil:
+					spec.Error = policyErr.Error()
+				case !ok:
+					// the generated modules.dep extension is not verified on its own
+				case result.Err != nil:
+					spec.Error = result.Err.Error()
+				default:
+					spec.Verified = true
+					spec.Signer = result.Signer
+				}
+
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+
+		if err = safe.CleanupOutputs[*runtime.ExtensionVerificationStatus](ctx, r); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,106 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	extensionsconfig "github.com/siderolabs/talos/pkg/machinery/config/types/runtime/extensions"
+	"github.com/siderolabs/talos/pkg/machinery/extensions"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	runtimeres "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+type ExtensionVerificationSuite struct {
+	ctest.DefaultSuite
+}
+
+func TestExtensionVerificationSuite(t *testing.T) {
+	suite.Run(t, &ExtensionVerificationSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&runtime.ExtensionVerificationController{}))
+			},
+		},
+	})
+}
+
+func (suite *ExtensionVerificationSuite) createExtension(id, digest string, signatures ...extensions.Signature) {
+	ext := runtimeres.NewExtensionStatus(runtimeres.NamespaceName, id)
+	ext.TypedSpec().Image = id + ".sqsh"
+	ext.TypedSpec().Metadata.Name = id
+	ext.TypedSpec().Source = &extensions.Source{
+		ImageRef:   "ghcr.io/siderolabs/" + id + ":v1.0.0",
+		Digest:     digest,
+		Signatures: signatures,
+	}
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), ext))
+}
+
+func (suite *ExtensionVerificationSuite) TestReconcile() {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	suite.Require().NoError(err)
+
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	suite.Require().NoError(err)
+
+	const (
+		signedDigest   = "sha256:1111111111111111111111111111111111111111111111111111111111111111"
+		unsignedDigest = "sha256:2222222222222222222222222222222222222222222222222222222222222222"
+	)
+
+	payload := fmt.Appendf(nil, `{"critical":{"image":{"docker-manifest-digest":%q},"type":%q}}`, signedDigest, extensions.SignaturePayloadType)
+
+	suite.createExtension("gvisor", signedDigest, extensions.Signature{Payload: payload, Signature: ed25519.Sign(privateKey, payload)})
+	suite.createExtension("iscsi-tools", unsignedDigest)
+
+	ctest.AssertResource(suite, "gvisor", func(status *runtimeres.ExtensionVerificationStatus, asrt *assert.Assertions) {
+		asrt.Equal("gvisor", status.TypedSpec().Name)
+		asrt.Equal(signedDigest, status.TypedSpec().Digest)
+		asrt.False(status.TypedSpec().Verified)
+		asrt.Equal("signature verification is not configured", status.TypedSpec().Error)
+	})
+
+	verificationConfig := extensionsconfig.NewVerificationConfigV1Alpha1()
+	verificationConfig.ConfigTrustedKeys = []extensionsconfig.TrustedKeyConfig{
+		{
+			KeyName:      "test",
+			KeyPublicKey: string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})),
+		},
+	}
+
+	cntr, err := container.New(verificationConfig)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), config.NewMachineConfig(cntr)))
+
+	ctest.AssertResource(suite, "gvisor", func(status *runtimeres.ExtensionVerificationStatus, asrt *assert.Assertions) {
+		asrt.Equal("ghcr.io/siderolabs/gvisor:v1.0.0", status.TypedSpec().ImageRef)
+		asrt.True(status.TypedSpec().Verified)
+		asrt.Equal("test", status.TypedSpec().Signer)
+		asrt.Empty(status.TypedSpec().Error)
+	})
+
+	ctest.AssertResource(suite, "iscsi-tools", func(status *runtimeres.ExtensionVerificationStatus, asrt *assert.Assertions) {
+		asrt.False(status.TypedSpec().Verified)
+		asrt.Equal("image "+unsignedDigest+" is not signed", status.TypedSpec().Error)
+	})
+
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), runtimeres.NewExtensionStatus(runtimeres.NamespaceName, "iscsi-tools").Metadata()))
+
+	ctest.AssertNoResource[*runtimeres.ExtensionVerificationStatus](suite, "iscsi-tools")
+}
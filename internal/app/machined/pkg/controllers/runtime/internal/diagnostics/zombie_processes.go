@@ -0,0 +1,42 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package diagnostics
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/xslices"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// ZombieProcessesCheck checks for the zombie processes which are not reaped by their parents.
+func ZombieProcessesCheck(ctx context.Context, r controller.Reader, logger *zap.Logger) (*runtime.DiagnosticSpec, error) {
+	status, err := safe.ReaderGetByID[*runtime.ProcessReaperStatus](ctx, r, runtime.ProcessReaperStatusID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("error reading process reaper status: %w", err)
+	}
+
+	if status.TypedSpec().ZombieProcesses < constants.ZombieProcessesThreshold {
+		return nil, nil
+	}
+
+	return &runtime.DiagnosticSpec{
+		Message: fmt.Sprintf("%d zombie processes are not reaped by their parents", status.TypedSpec().ZombieProcesses),
+		Details: xslices.Map(status.TypedSpec().ZombieParents, func(parent runtime.ProcessParentSpec) string {
+			return fmt.Sprintf("parent %q: %d zombies", parent.Command, parent.Count)
+		}),
+	}, nil
+}
@@ -68,12 +68,13 @@ func (ctrl *KernelModuleConfigController) Run(ctx context.Context, r controller.
 		r.StartTrackingOutputs()
 
 		if cfg != nil && cfg.Config().Machine() != nil {
-			for _, module := range cfg.Config().Machine().Kernel().Modules() {
+			for i, module := range cfg.Config().Machine().Kernel().Modules() {
 				item := runtime.NewKernelModuleSpec(runtime.NamespaceName, module.Name())
 
 				if err = safe.WriterModify(ctx, r, item, func(res *runtime.KernelModuleSpec) error {
 					res.TypedSpec().Name = module.Name()
 					res.TypedSpec().Parameters = module.Parameters()
+					res.TypedSpec().Order = i
 
 					return nil
 				}); err != nil {
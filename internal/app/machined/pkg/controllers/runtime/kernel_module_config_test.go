@@ -4,6 +4,7 @@
 package runtime_test
 
 import (
+	"slices"
 	"testing"
 	"time"
 
@@ -39,7 +40,8 @@ func (suite *KernelModuleConfigSuite) TestReconcileConfig() {
 								ModuleName: "btrfs",
 							},
 							{
-								ModuleName: "e1000",
+								ModuleName:       "e1000",
+								ModuleParameters: []string{"debug=2"},
 							},
 						},
 					},
@@ -57,7 +59,9 @@ func (suite *KernelModuleConfigSuite) TestReconcileConfig() {
 		suite.assertResource(
 			specMD,
 			func(res resource.Resource) bool {
-				return res.(*runtimeresource.KernelModuleSpec).TypedSpec().Name == "e1000"
+				spec := res.(*runtimeresource.KernelModuleSpec).TypedSpec()
+
+				return spec.Name == "e1000" && spec.Order == 1 && slices.Equal(spec.Parameters, []string{"debug=2"})
 			},
 		),
 	))
@@ -5,9 +5,14 @@
 package runtime
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/cosi-project/runtime/pkg/controller"
@@ -19,9 +24,25 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
 )
 
-// KernelModuleSpecController watches KernelModuleSpecs, sets/resets kernel params.
+// ModuleManager loads and unloads kernel modules.
+type ModuleManager interface {
+	Load(name, params string, flags int) error
+	Unload(name string) error
+}
+
+// KernelModuleSpecController watches KernelModuleSpecs, loads kernel modules.
 type KernelModuleSpecController struct {
 	V1Alpha1Mode v1alpha1runtime.Mode
+
+	// Manager loads the modules, defaults to kmod.
+	Manager ModuleManager
+	// ProcModulesPath is the path to the list of the loaded modules, defaults to /proc/modules.
+	ProcModulesPath string
+	// SysModulePath is the path to the modules in sysfs, defaults to /sys/module.
+	SysModulePath string
+
+	// parameters the modules were loaded with by this controller
+	loadedParameters map[string][]string
 }
 
 // Name implements controller.Controller interface.
@@ -42,19 +63,40 @@ func (ctrl *KernelModuleSpecController) Inputs() []controller.Input {
 
 // Outputs implements controller.Controller interface.
 func (ctrl *KernelModuleSpecController) Outputs() []controller.Output {
-	return nil
+	return []controller.Output{
+		{
+			Type: runtime.KernelModuleStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
 }
 
 // Run implements controller.Controller interface.
-func (ctrl *KernelModuleSpecController) Run(ctx context.Context, r controller.Runtime, _ *zap.Logger) error {
+func (ctrl *KernelModuleSpecController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
 	if ctrl.V1Alpha1Mode == v1alpha1runtime.ModeContainer {
 		// not supported in container mode
 		return nil
 	}
 
-	manager, err := kmod.New()
-	if err != nil {
-		return fmt.Errorf("error initializing kmod manager: %w", err)
+	if ctrl.Manager == nil {
+		manager, err := kmod.New()
+		if err != nil {
+			return fmt.Errorf("error initializing kmod manager: %w", err)
+		}
+
+		ctrl.Manager = manager
+	}
+
+	if ctrl.ProcModulesPath == "" {
+		ctrl.ProcModulesPath = "/proc/modules"
+	}
+
+	if ctrl.SysModulePath == "" {
+		ctrl.SysModulePath = "/sys/module"
+	}
+
+	if ctrl.loadedParameters == nil {
+		ctrl.loadedParameters = map[string][]string{}
 	}
 
 	for {
@@ -69,18 +111,37 @@ func (ctrl *KernelModuleSpecController) Run(ctx context.Context, r controller.Ru
 			return err
 		}
 
+		// load modules in the configured order, so that parameters of the dependencies are applied first
+		sortedModules := slices.SortedStableFunc(modules.All(), func(a, b *runtime.KernelModuleSpec) int {
+			return cmp.Compare(a.TypedSpec().Order, b.TypedSpec().Order)
+		})
+
+		r.StartTrackingOutputs()
+
 		var multiErr error
 
-		// note: this code doesn't support module unloading in any way for now
-		for module := range modules.All() {
-			moduleSpec := module.TypedSpec()
-			parameters := strings.Join(moduleSpec.Parameters, " ")
+		for _, module := range sortedModules {
+			status := ctrl.loadModule(module.TypedSpec(), logger)
+
+			if status.Error != "" {
+				multiErr = errors.Join(multiErr, fmt.Errorf("error loading module %q: %s", module.TypedSpec().Name, status.Error))
+			}
+
+			if err = safe.WriterModify(ctx, r, runtime.NewKernelModuleStatus(runtime.NamespaceName, module.Metadata().ID()),
+				func(res *runtime.KernelModuleStatus) error {
+					*res.TypedSpec() = status
 
-			if err = manager.Load(moduleSpec.Name, parameters, 0); err != nil {
-				multiErr = errors.Join(multiErr, fmt.Errorf("error loading module %q: %w", moduleSpec.Name, err))
+					return nil
+				},
+			); err != nil {
+				return fmt.Errorf("error updating kernel module status: %w", err)
 			}
 		}
 
+		if err = safe.CleanupOutputs[*runtime.KernelModuleStatus](ctx, r); err != nil {
+			return err
+		}
+
 		if multiErr != nil {
 			return multiErr
 		}
@@ -88,3 +149,158 @@ func (ctrl *KernelModuleSpecController) Run(ctx context.Context, r controller.Ru
 		r.ResetRestartBackoff()
 	}
 }
+
+// loadModule loads the module, reloading it if the parameters have changed.
+//
+// The parameters of the loaded module are compared with the live values in sysfs, so that the modules
+// loaded outside of this controller (e.g. autoloaded by udev) are not reloaded needlessly.
+// Module unloading (when the module is removed from the configuration) is not supported.
+func (ctrl *KernelModuleSpecController) loadModule(spec *runtime.KernelModuleSpecSpec, logger *zap.Logger) runtime.KernelModuleStatusSpec {
+	loaded, err := ctrl.isModuleLoaded(spec.Name)
+	if err != nil {
+		return runtime.KernelModuleStatusSpec{Error: err.Error()}
+	}
+
+	if loaded {
+		liveParameters, matches := ctrl.liveParameters(spec)
+		if matches {
+			ctrl.loadedParameters[spec.Name] = slices.Clone(spec.Parameters)
+
+			return runtime.KernelModuleStatusSpec{
+				Parameters: spec.Parameters,
+				Loaded:     true,
+			}
+		}
+
+		if err = ctrl.Manager.Unload(spec.Name); err != nil {
+			logger.Warn("kernel module parameters changed, but the module can't be unloaded, reboot is required to apply them",
+				zap.String("module", spec.Name),
+				zap.Strings("parameters", spec.Parameters),
+				zap.Strings("live_parameters", liveParameters),
+				zap.Error(err),
+			)
+
+			return runtime.KernelModuleStatusSpec{
+				Parameters:     liveParameters,
+				Loaded:         true,
+				RebootRequired: true,
+			}
+		}
+
+		logger.Info("unloaded kernel module to apply new parameters", zap.String("module", spec.Name))
+	}
+
+	if err = ctrl.Manager.Load(spec.Name, strings.Join(spec.Parameters, " "), 0); err != nil {
+		return runtime.KernelModuleStatusSpec{Error: err.Error()}
+	}
+
+	ctrl.loadedParameters[spec.Name] = slices.Clone(spec.Parameters)
+
+	return runtime.KernelModuleStatusSpec{
+		Parameters: ctrl.loadedParameters[spec.Name],
+		Loaded:     true,
+	}
+}
+
+// liveParameters reads the live values of the configured parameters from /sys/module/<name>/parameters.
+//
+// It returns the live parameters, and whether they match the configured ones.
+// Parameters which are not exposed in sysfs are compared with the ones the module was loaded with by this controller,
+// the parameters removed from the configuration require a reload to restore the defaults.
+func (ctrl *KernelModuleSpecController) liveParameters(spec *runtime.KernelModuleSpecSpec) ([]string, bool) {
+	loadedParameters := ctrl.loadedParameters[spec.Name]
+	parametersDir := filepath.Join(ctrl.SysModulePath, normalizeModuleName(spec.Name), "parameters")
+
+	matches := true
+	liveParameters := make([]string, 0, len(spec.Parameters))
+	configured := make(map[string]struct{}, len(spec.Parameters))
+
+	for _, parameter := range spec.Parameters {
+		key, value, _ := strings.Cut(parameter, "=")
+		configured[key] = struct{}{}
+
+		contents, err := os.ReadFile(filepath.Join(parametersDir, key))
+		if err != nil {
+			// parameter is not readable, fall back to the parameters the module was loaded with
+			if !slices.Contains(loadedParameters, parameter) {
+				matches = false
+			}
+
+			continue
+		}
+
+		live := strings.TrimSpace(string(contents))
+		liveParameters = append(liveParameters, key+"="+live)
+
+		if !parameterValueMatches(value, live) {
+			matches = false
+		}
+	}
+
+	for _, parameter := range loadedParameters {
+		key, _, _ := strings.Cut(parameter, "=")
+
+		if _, ok := configured[key]; !ok {
+			matches = false
+		}
+	}
+
+	return liveParameters, matches
+}
+
+// parameterValueMatches compares the configured parameter value with the live value in sysfs.
+//
+// Booleans are shown as Y/N in sysfs, and accepted in a variety of forms.
+func parameterValueMatches(configured, live string) bool {
+	configured = strings.Trim(configured, `"`)
+
+	if configured == live {
+		return true
+	}
+
+	switch live {
+	case "Y":
+		// parameter without the value enables the boolean
+		return configured == "" || strings.ContainsAny(configured[:1], "yYtT1") || strings.EqualFold(configured, "on")
+	case "N":
+		return configured != "" && (strings.ContainsAny(configured[:1], "nNfF0") || strings.EqualFold(configured, "off"))
+	case "(null)":
+		return configured == ""
+	}
+
+	configuredInt, err := strconv.ParseInt(configured, 0, 64)
+	if err != nil {
+		return false
+	}
+
+	liveInt, err := strconv.ParseInt(live, 0, 64)
+	if err != nil {
+		return false
+	}
+
+	return configuredInt == liveInt
+}
+
+// normalizeModuleName returns the module name as the kernel presents it, treating dashes and underscores as equal.
+func normalizeModuleName(name string) string {
+	return strings.ReplaceAll(name, "-", "_")
+}
+
+// isModuleLoaded checks whether the loadable module is present in /proc/modules.
+func (ctrl *KernelModuleSpecController) isModuleLoaded(name string) (bool, error) {
+	f, err := os.Open(ctrl.ProcModulesPath)
+	if err != nil {
+		return false, err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	modules, err := ParseModules(f)
+	if err != nil {
+		return false, err
+	}
+
+	name = normalizeModuleName(name)
+
+	return slices.ContainsFunc(modules, func(m Module) bool { return m.Name == name }), nil
+}
@@ -0,0 +1,227 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	runtimectrls "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// mockModuleManager simulates loading the modules by updating /proc/modules and /sys/module.
+type mockModuleManager struct {
+	procModulesPath string
+	sysModulePath   string
+
+	mu       sync.Mutex
+	loaded   []string
+	unloaded []string
+	busy     map[string]bool
+}
+
+func (m *mockModuleManager) Load(name, params string, _ int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.loaded = append(m.loaded, name)
+
+	return m.load(name, params)
+}
+
+func (m *mockModuleManager) load(name, params string) error {
+	f, err := os.OpenFile(m.procModulesPath, os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	if _, err = fmt.Fprintf(f, "%s 16384 0 - Live 0x0000000000000000\n", name); err != nil {
+		return err
+	}
+
+	parametersDir := filepath.Join(m.sysModulePath, name, "parameters")
+
+	if err = os.MkdirAll(parametersDir, 0o755); err != nil {
+		return err
+	}
+
+	for _, param := range strings.Fields(params) {
+		key, value, _ := strings.Cut(param, "=")
+
+		if err = os.WriteFile(filepath.Join(parametersDir, key), []byte(value+"\n"), 0o644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *mockModuleManager) Unload(name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.busy[name] {
+		return errors.New("module is in use")
+	}
+
+	m.unloaded = append(m.unloaded, name)
+
+	contents, err := os.ReadFile(m.procModulesPath)
+	if err != nil {
+		return err
+	}
+
+	var lines []string
+
+	for line := range strings.Lines(string(contents)) {
+		if !strings.HasPrefix(line, name+" ") {
+			lines = append(lines, line)
+		}
+	}
+
+	if err = os.WriteFile(m.procModulesPath, []byte(strings.Join(lines, "")), 0o644); err != nil {
+		return err
+	}
+
+	return os.RemoveAll(filepath.Join(m.sysModulePath, name))
+}
+
+func (m *mockModuleManager) calls() (loaded, unloaded []string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]string(nil), m.loaded...), append([]string(nil), m.unloaded...)
+}
+
+func TestKernelModuleSpecSuite(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+
+	manager := &mockModuleManager{
+		procModulesPath: filepath.Join(root, "modules"),
+		sysModulePath:   filepath.Join(root, "module"),
+		busy:            map[string]bool{"busy_mod": true},
+	}
+
+	if err := os.WriteFile(manager.procModulesPath, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	// modules autoloaded before the controller starts
+	for name, params := range map[string]string{
+		"autoloaded": "debug=Y",
+		"busy_mod":   "mode=1",
+	} {
+		if err := manager.load(name, params); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	suite.Run(t, &KernelModuleSpecSuite{
+		manager: manager,
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 10 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&runtimectrls.KernelModuleSpecController{
+					Manager:         manager,
+					ProcModulesPath: manager.procModulesPath,
+					SysModulePath:   manager.sysModulePath,
+				}))
+			},
+		},
+	})
+}
+
+type KernelModuleSpecSuite struct {
+	ctest.DefaultSuite
+
+	manager *mockModuleManager
+}
+
+func (suite *KernelModuleSpecSuite) createSpec(name string, order int, params ...string) *runtime.KernelModuleSpec {
+	spec := runtime.NewKernelModuleSpec(runtime.NamespaceName, name)
+	spec.TypedSpec().Name = name
+	spec.TypedSpec().Order = order
+	spec.TypedSpec().Parameters = params
+
+	suite.Create(spec)
+
+	return spec
+}
+
+func (suite *KernelModuleSpecSuite) TestReconcile() {
+	// created in the reverse order, loaded by the configured order
+	second := suite.createSpec("second", 2, "opt=b")
+	suite.createSpec("first", 1, "opt=a")
+
+	// live parameters match the configuration (booleans are shown as Y/N in sysfs)
+	suite.createSpec("autoloaded", 0, "debug=1")
+
+	// live parameters don't match, and the module can't be unloaded
+	suite.createSpec("busy_mod", 0, "mode=2")
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{"first", "second", "autoloaded"},
+		func(status *runtime.KernelModuleStatus, asrt *assert.Assertions) {
+			asrt.True(status.TypedSpec().Loaded)
+			asrt.False(status.TypedSpec().RebootRequired)
+			asrt.Empty(status.TypedSpec().Error)
+
+			switch status.Metadata().ID() {
+			case "first":
+				asrt.Equal([]string{"opt=a"}, status.TypedSpec().Parameters)
+			case "second":
+				asrt.Equal([]string{"opt=b"}, status.TypedSpec().Parameters)
+			case "autoloaded":
+				asrt.Equal([]string{"debug=1"}, status.TypedSpec().Parameters)
+			}
+		},
+	)
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{"busy_mod"},
+		func(status *runtime.KernelModuleStatus, asrt *assert.Assertions) {
+			asrt.True(status.TypedSpec().Loaded)
+			asrt.True(status.TypedSpec().RebootRequired)
+			asrt.Equal([]string{"mode=1"}, status.TypedSpec().Parameters)
+		},
+	)
+
+	loaded, unloaded := suite.manager.calls()
+	suite.Assert().Equal([]string{"first", "second"}, loaded)
+	suite.Assert().Empty(unloaded)
+
+	// parameters of the module loaded by the controller change, the module is reloaded
+	second.TypedSpec().Parameters = []string{"opt=c"}
+	suite.Update(second)
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), "second",
+		func(status *runtime.KernelModuleStatus, asrt *assert.Assertions) {
+			asrt.Equal([]string{"opt=c"}, status.TypedSpec().Parameters)
+		},
+	)
+
+	_, unloaded = suite.manager.calls()
+	suite.Assert().Equal([]string{"second"}, unloaded)
+
+	// the status is removed with the spec
+	suite.Destroy(runtime.NewKernelModuleSpec(runtime.NamespaceName, "first"))
+
+	rtestutils.AssertNoResource[*runtime.KernelModuleStatus](suite.Ctx(), suite.T(), suite.State(), "first")
+}
@@ -7,9 +7,11 @@ package runtime
 import (
 	"context"
 	"errors"
+	"fmt"
 	"os"
 	"slices"
 	"strings"
+	"syscall"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/resource"
@@ -27,6 +29,7 @@ import (
 type KernelParamSpecController struct {
 	defaults map[string]string
 	state    map[string]string
+	failures map[string]string
 }
 
 // Name implements controller.Controller interface.
@@ -57,6 +60,10 @@ func (ctrl *KernelParamSpecController) Outputs() []controller.Output {
 			Type: runtime.KernelParamStatusType,
 			Kind: controller.OutputExclusive,
 		},
+		{
+			Type: runtime.SysctlStatusType,
+			Kind: controller.OutputExclusive,
+		},
 	}
 }
 
@@ -72,6 +79,10 @@ func (ctrl *KernelParamSpecController) Run(ctx context.Context, r controller.Run
 		ctrl.defaults = map[string]string{}
 	}
 
+	if ctrl.failures == nil {
+		ctrl.failures = map[string]string{}
+	}
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -98,8 +109,12 @@ func (ctrl *KernelParamSpecController) Run(ctx context.Context, r controller.Run
 			list := slices.Concat(configs.Items, defaults.Items)
 
 			touchedIDs := map[string]string{}
+			failures := map[string]string{}
 
-			var errs *multierror.Error
+			var (
+				errs   *multierror.Error
+				status runtime.SysctlStatusSpec
+			)
 
 			for i, item := range list {
 				spec := item.(runtime.KernelParam).TypedSpec()
@@ -114,26 +129,54 @@ func (ctrl *KernelParamSpecController) Run(ctx context.Context, r controller.Run
 				}
 
 				if err = ctrl.updateKernelParam(ctx, r, id, spec.Value); err != nil {
-					if errors.Is(err, os.ErrNotExist) && spec.IgnoreErrors {
-						status := runtime.NewKernelParamStatus(runtime.NamespaceName, id)
+					var paramErr *kernelParamError
+
+					switch {
+					case errors.Is(err, os.ErrNotExist) && spec.IgnoreErrors:
+						status.Skipped = append(status.Skipped, id)
+
+						paramStatus := runtime.NewKernelParamStatus(runtime.NamespaceName, id)
 
-						if e := safe.WriterModify(ctx, r, status, func(res *runtime.KernelParamStatus) error {
+						if e := safe.WriterModify(ctx, r, paramStatus, func(res *runtime.KernelParamStatus) error {
 							res.TypedSpec().Unsupported = true
 
 							return nil
 						}); e != nil {
 							errs = multierror.Append(errs, e)
 						}
-					} else {
+					case errors.As(err, &paramErr):
+						// failure to write the kernel param is reported via the status, as retrying won't fix it
+						failure := runtime.SysctlFailure{
+							Key:   id,
+							Value: spec.Value,
+							Error: paramErr.Error(),
+						}
+
+						var errno syscall.Errno
+
+						if errors.As(paramErr, &errno) {
+							failure.Errno = uint32(errno)
+						}
+
+						status.Failed = append(status.Failed, failure)
+						failures[id] = failure.Error
+
+						if ctrl.failures[id] != failure.Error {
+							logger.Warn("failed to set kernel param", zap.String("key", id), zap.String("value", spec.Value), zap.Error(paramErr))
+						}
+					default:
 						errs = multierror.Append(errs, err)
 					}
 
 					continue
 				}
 
+				status.Applied = append(status.Applied, id)
 				touchedIDs[id] = spec.Value
 			}
 
+			ctrl.failures = failures
+
 			for key := range ctrl.state {
 				if _, ok := touchedIDs[key]; ok {
 					continue
@@ -144,6 +187,18 @@ func (ctrl *KernelParamSpecController) Run(ctx context.Context, r controller.Run
 				}
 			}
 
+			slices.Sort(status.Applied)
+			slices.Sort(status.Skipped)
+			slices.SortFunc(status.Failed, func(a, b runtime.SysctlFailure) int { return strings.Compare(a.Key, b.Key) })
+
+			if err = safe.WriterModify(ctx, r, runtime.NewSysctlStatus(), func(res *runtime.SysctlStatus) error {
+				*res.TypedSpec() = status
+
+				return nil
+			}); err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error updating sysctl status: %w", err))
+			}
+
 			if errs != nil {
 				return errs
 			}
@@ -165,6 +220,12 @@ func (ctrl *KernelParamSpecController) updateKernelParam(ctx context.Context, r
 	}
 
 	if err := krnl.WriteParam(prop); err != nil {
+		// the kernel rejected the value, retrying won't fix it;
+		// other errors (e.g. the param of the link which doesn't exist yet) are retried
+		if errors.Is(err, syscall.EINVAL) || errors.Is(err, syscall.EPERM) {
+			return &kernelParamError{err: err}
+		}
+
 		return err
 	}
 
@@ -198,3 +259,16 @@ func (ctrl *KernelParamSpecController) resetKernelParam(ctx context.Context, r c
 
 	return r.Destroy(ctx, resource.NewMetadata(runtime.NamespaceName, runtime.KernelParamStatusType, key, resource.VersionUndefined))
 }
+
+// kernelParamError is returned when the kernel rejects the value of the kernel param.
+type kernelParamError struct {
+	err error
+}
+
+func (e *kernelParamError) Error() string {
+	return e.err.Error()
+}
+
+func (e *kernelParamError) Unwrap() error {
+	return e.err
+}
@@ -6,7 +6,9 @@ package runtime_test
 
 import (
 	"os"
+	"slices"
 	"strings"
+	"syscall"
 	"testing"
 	"time"
 
@@ -107,6 +109,46 @@ func (suite *KernelParamSpecSuite) TestParamsUnsupported() {
 	))
 }
 
+func (suite *KernelParamSpecSuite) TestParamsFailedStatus() {
+	suite.Require().NoError(suite.runtime.RegisterController(&runtimecontrollers.KernelParamSpecController{}))
+
+	suite.startRuntime()
+
+	rejectedID := "proc.sys.kernel.panic"
+	skippedID := "proc.sys.some.other.not.existing.sysctl"
+
+	spec := runtimeresource.NewKernelParamSpec(runtimeresource.NamespaceName, rejectedID)
+	spec.TypedSpec().Value = "not-a-number"
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, spec))
+
+	spec = runtimeresource.NewKernelParamSpec(runtimeresource.NamespaceName, skippedID)
+	spec.TypedSpec().Value = "value"
+	spec.TypedSpec().IgnoreErrors = true
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, spec))
+
+	spec = runtimeresource.NewKernelParamSpec(runtimeresource.NamespaceName, procSysfsFileMax)
+	spec.TypedSpec().Value = "500000"
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, spec))
+
+	statusMD := resource.NewMetadata(runtimeresource.NamespaceName, runtimeresource.SysctlStatusType, runtimeresource.SysctlStatusID, resource.VersionUndefined)
+
+	suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		suite.assertResource(
+			statusMD,
+			func(res resource.Resource) bool {
+				status := res.(*runtimeresource.SysctlStatus).TypedSpec()
+
+				return len(status.Failed) == 1 && status.Failed[0].Key == rejectedID && status.Failed[0].Errno == uint32(syscall.EINVAL) &&
+					slices.Equal(status.Skipped, []string{skippedID}) &&
+					slices.Equal(status.Applied, []string{procSysfsFileMax})
+			},
+		),
+	))
+}
+
 func TestKernelParamSpecSuite(t *testing.T) {
 	if os.Geteuid() != 0 {
 		t.Skip("skipping test because it requires root privileges")
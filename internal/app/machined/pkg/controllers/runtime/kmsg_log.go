@@ -6,9 +6,11 @@ package runtime
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/url"
+	"path/filepath"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/cosi-project/runtime/pkg/controller"
@@ -30,10 +32,9 @@ import (
 )
 
 const (
-	drainTimeout    = 100 * time.Millisecond
-	logSendTimeout  = 5 * time.Second
-	logRetryTimeout = 1 * time.Second
-	logCloseTimeout = 5 * time.Second
+	drainTimeout      = 100 * time.Millisecond
+	logCloseTimeout   = 5 * time.Second
+	logStatusInterval = 10 * time.Second
 )
 
 // KmsgLogDeliveryController watches events and forwards them to the events sink server
@@ -56,10 +57,24 @@ func (ctrl *KmsgLogDeliveryController) Inputs() []controller.Input {
 
 // Outputs implements controller.Controller interface.
 func (ctrl *KmsgLogDeliveryController) Outputs() []controller.Output {
-	return nil
+	return []controller.Output{
+		{
+			Type: runtime.KmsgLogDeliveryStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// kmsgLogSender is a buffered log sender for a single destination.
+type kmsgLogSender struct {
+	*logging.BufferedSender
+
+	format string
 }
 
 // Run implements controller.Controller interface.
+//
+//nolint:gocyclo,cyclop
 func (ctrl *KmsgLogDeliveryController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
 	if err := networkutils.WaitForNetworkReady(ctx, r,
 		func(status *network.StatusSpec) bool {
@@ -72,6 +87,12 @@ func (ctrl *KmsgLogDeliveryController) Run(ctx context.Context, r controller.Run
 				ID:        optional.Some(runtime.KmsgLogConfigID),
 				Kind:      controller.InputWeak,
 			},
+			{
+				Namespace: runtime.NamespaceName,
+				Type:      runtime.MountStatusType,
+				ID:        optional.Some(constants.EphemeralPartitionLabel),
+				Kind:      controller.InputWeak,
+			},
 		},
 	); err != nil {
 		return fmt.Errorf("error waiting for network: %w", err)
@@ -87,69 +108,17 @@ func (ctrl *KmsgLogDeliveryController) Run(ctx context.Context, r controller.Run
 
 	kmsgCh := reader.Scan(ctx)
 
-	for {
-		select {
-		case <-ctx.Done():
-			return nil
-		case <-r.EventCh():
-		}
-
-		cfg, err := safe.ReaderGetByID[*runtime.KmsgLogConfig](ctx, r, runtime.KmsgLogConfigID)
-		if err != nil && !state.IsNotFoundError(err) {
-			return fmt.Errorf("error getting configuration: %w", err)
-		}
-
-		if cfg == nil {
-			// no config, wait for the next event
-			continue
-		}
-
-		if err = ctrl.deliverLogs(ctx, r, logger, kmsgCh, cfg.TypedSpec().Destinations); err != nil {
-			return fmt.Errorf("error delivering logs: %w", err)
-		}
-
-		r.ResetRestartBackoff()
-	}
-}
-
-type logConfig struct {
-	endpoint *url.URL
-}
-
-func (c logConfig) Format() string {
-	return constants.LoggingFormatJSONLines
-}
-
-func (c logConfig) Endpoint() *url.URL {
-	return c.endpoint
-}
-
-func (c logConfig) ExtraTags() map[string]string {
-	return nil
-}
-
-//nolint:gocyclo
-func (ctrl *KmsgLogDeliveryController) deliverLogs(ctx context.Context, r controller.Runtime, logger *zap.Logger, kmsgCh <-chan kmsg.Packet, destURLs []*url.URL) error {
 	if ctrl.drainSub == nil {
 		ctrl.drainSub = ctrl.Drainer.Subscribe()
 	}
 
-	// initialize all log senders
-	destLogConfigs := xslices.Map(destURLs, func(u *url.URL) config.LoggingDestination {
-		return logConfig{endpoint: u}
-	})
-	senders := xslices.Map(destLogConfigs, logging.NewJSONLines)
+	// senders are kept across config changes, so that the buffered messages are not lost
+	senders := map[string]*kmsgLogSender{}
 
-	defer func() {
-		closeCtx, closeCtxCancel := context.WithTimeout(context.Background(), logCloseTimeout)
-		defer closeCtxCancel()
+	defer closeKmsgLogSenders(logger, senders)
 
-		for _, sender := range senders {
-			if err := sender.Close(closeCtx); err != nil {
-				logger.Error("error closing log sender", zap.Error(err))
-			}
-		}
-	}()
+	statusTicker := time.NewTicker(logStatusInterval)
+	defer statusTicker.Stop()
 
 	var (
 		drainTimer   *time.Timer
@@ -157,6 +126,13 @@ func (ctrl *KmsgLogDeliveryController) deliverLogs(ctx context.Context, r contro
 	)
 
 	for {
+		// don't consume kernel messages until there is a destination to deliver them to
+		var msgCh <-chan kmsg.Packet
+
+		if len(senders) > 0 {
+			msgCh = kmsgCh
+		}
+
 		var msg kmsg.Packet
 
 		select {
@@ -165,8 +141,23 @@ func (ctrl *KmsgLogDeliveryController) deliverLogs(ctx context.Context, r contro
 
 			return nil
 		case <-r.EventCh():
-			// config changed, restart the loop
-			return nil
+			if err = ctrl.updateSenders(ctx, r, logger, senders); err != nil {
+				return err
+			}
+
+			if err = ctrl.publishStatus(ctx, r, senders); err != nil {
+				return err
+			}
+
+			r.ResetRestartBackoff()
+
+			continue
+		case <-statusTicker.C:
+			if err = ctrl.publishStatus(ctx, r, senders); err != nil {
+				return err
+			}
+
+			continue
 		case <-ctrl.drainSub.EventCh():
 			// drain started, assume that ksmg is drained if there're no new messages in drainTimeout
 			drainTimer = time.NewTimer(drainTimeout)
@@ -174,10 +165,21 @@ func (ctrl *KmsgLogDeliveryController) deliverLogs(ctx context.Context, r contro
 
 			continue
 		case <-drainTimerCh:
+			// flush the buffered messages before reporting the drain as complete
+			closeKmsgLogSenders(logger, senders)
+
+			if err = ctrl.publishStatus(ctx, r, senders); err != nil {
+				return err
+			}
+
+			clear(senders)
+
+			drainTimer, drainTimerCh = nil, nil
+
 			ctrl.drainSub.Cancel()
 
-			return nil
-		case msg = <-kmsgCh:
+			continue
+		case msg = <-msgCh:
 			if drainTimer != nil {
 				// if draining, reset the timer as there's a new message
 				if !drainTimer.Stop() {
@@ -204,58 +206,195 @@ func (ctrl *KmsgLogDeliveryController) deliverLogs(ctx context.Context, r contro
 			},
 		}
 
-		if err := ctrl.resend(ctx, r, logger, senders, &event); err != nil {
-			return fmt.Errorf("error sending log event: %w", err)
+		// buffered senders never block, each destination has its own queue
+		for _, sender := range senders {
+			if err = sender.Send(ctx, &event); err != nil {
+				logger.Debug("error sending log event", zap.Error(err))
+			}
 		}
 	}
 }
 
+// updateSenders reconciles the log senders with the configuration.
+//
 //nolint:gocyclo
-func (ctrl *KmsgLogDeliveryController) resend(ctx context.Context, r controller.Runtime, logger *zap.Logger, senders []machinedruntime.LogSender, e *machinedruntime.LogEvent) error {
-	for {
-		sendCtx, sendCancel := context.WithTimeout(ctx, logSendTimeout)
-		sendErrors := make(chan error, len(senders))
+func (ctrl *KmsgLogDeliveryController) updateSenders(ctx context.Context, r controller.Runtime, logger *zap.Logger, senders map[string]*kmsgLogSender) error {
+	cfg, err := safe.ReaderGetByID[*runtime.KmsgLogConfig](ctx, r, runtime.KmsgLogConfigID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return fmt.Errorf("error getting configuration: %w", err)
+	}
 
-		for _, sender := range senders {
-			go func() {
-				sendErrors <- sender.Send(sendCtx, e)
-			}()
-		}
+	_, err = safe.ReaderGetByID[*runtime.MountStatus](ctx, r, constants.EphemeralPartitionLabel)
+	if err != nil && !state.IsNotFoundError(err) {
+		return fmt.Errorf("error getting EPHEMERAL mount status: %w", err)
+	}
 
-		var dontRetry bool
+	// spool is enabled only once EPHEMERAL is mounted
+	spoolReady := err == nil
 
-		for range senders {
-			err := <-sendErrors
+	type destination struct {
+		endpoint *url.URL
+		format   string
+	}
 
-			// don't retry if at least one sender succeed to avoid implementing per-sender queue, etc
-			if err == nil {
-				dontRetry = true
+	var destinations []destination
 
-				continue
-			}
+	if cfg != nil {
+		destinations = append(destinations, xslices.Map(cfg.TypedSpec().Destinations, func(u *url.URL) destination {
+			return destination{endpoint: u, format: constants.LoggingFormatJSONLines}
+		})...)
 
-			logger.Debug("error sending log event", zap.Error(err))
+		destinations = append(destinations, xslices.Map(cfg.TypedSpec().SyslogDestinations, func(u *url.URL) destination {
+			return destination{endpoint: u, format: constants.LoggingFormatSyslog}
+		})...)
+	}
 
-			if errors.Is(err, machinedruntime.ErrDontRetry) || errors.Is(err, context.Canceled) {
-				dontRetry = true
-			}
+	touched := map[string]struct{}{}
+
+	for _, dest := range destinations {
+		id := dest.endpoint.String()
+		opts := kmsgLogBufferOptions(cfg.TypedSpec(), dest.endpoint, spoolReady)
+
+		touched[id] = struct{}{}
+
+		if sender, ok := senders[id]; ok && sender.format == dest.format {
+			sender.Update(opts)
+
+			continue
+		} else if ok {
+			// closing flushes the buffered messages, don't block the kmsg reader meanwhile
+			go closeKmsgLogSender(logger, id, sender)
 		}
 
-		sendCancel()
+		var sender machinedruntime.LogSender
 
-		if dontRetry {
-			return nil
+		switch dest.format {
+		case constants.LoggingFormatSyslog:
+			sender = logging.NewSyslog(logConfig{endpoint: dest.endpoint, format: dest.format}, logging.SyslogFacilityKernel)
+		default:
+			sender = logging.NewJSONLines(logConfig{endpoint: dest.endpoint, format: dest.format})
 		}
 
-		select {
-		case <-ctx.Done():
+		senders[id] = &kmsgLogSender{
+			BufferedSender: logging.NewBuffered(sender, opts),
+			format:         dest.format,
+		}
+	}
+
+	for id, sender := range senders {
+		if _, ok := touched[id]; !ok {
+			go closeKmsgLogSender(logger, id, sender)
+
+			delete(senders, id)
+		}
+	}
+
+	return nil
+}
+
+func (ctrl *KmsgLogDeliveryController) publishStatus(ctx context.Context, r controller.Runtime, senders map[string]*kmsgLogSender) error {
+	r.StartTrackingOutputs()
+
+	for id, sender := range senders {
+		stats := sender.Stats()
+
+		if err := safe.WriterModify(ctx, r, runtime.NewKmsgLogDeliveryStatus(id), func(status *runtime.KmsgLogDeliveryStatus) error {
+			*status.TypedSpec() = runtime.KmsgLogDeliveryStatusSpec{
+				Destination:   id,
+				SentBytes:     stats.SentBytes,
+				DroppedBytes:  stats.DroppedBytes,
+				SpooledBytes:  stats.SpooledBytes,
+				BufferedBytes: stats.BufferedBytes,
+				SpoolBytes:    stats.SpoolBytes,
+				LastError:     stats.LastError,
+			}
+
 			return nil
-		case <-r.EventCh():
-			// config changed, restart the loop
-			return errors.New("config changed")
-		case <-time.After(logRetryTimeout):
+		}); err != nil {
+			return fmt.Errorf("error updating kmsg log delivery status: %w", err)
 		}
 	}
+
+	return safe.CleanupOutputs[*runtime.KmsgLogDeliveryStatus](ctx, r)
+}
+
+func kmsgLogBufferOptions(cfg *runtime.KmsgLogConfigSpec, endpoint *url.URL, spoolReady bool) logging.BufferOptions {
+	opts := logging.DefaultBufferOptions()
+
+	buffer, ok := cfg.Buffer(endpoint)
+	if !ok {
+		return opts
+	}
+
+	opts.MemorySize = int64(buffer.MemorySize)
+	opts.RetryMinBackoff = buffer.RetryMinBackoff
+	opts.RetryMaxBackoff = buffer.RetryMaxBackoff
+
+	if buffer.DropPolicy == constants.KmsgLogDropPolicyNewest {
+		opts.DropPolicy = logging.DropNewest
+	}
+
+	if buffer.SpoolSize > 0 && spoolReady {
+		opts.SpoolDir = filepath.Join(constants.KmsgLogSpoolPath, kmsgLogSpoolName(endpoint))
+		opts.SpoolSize = int64(buffer.SpoolSize)
+	}
+
+	return opts
+}
+
+// kmsgLogSpoolName returns the name of the spool directory for the destination.
+func kmsgLogSpoolName(endpoint *url.URL) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '.':
+			return r
+		default:
+			return '_'
+		}
+	}, endpoint.Scheme+"_"+endpoint.Host)
+}
+
+func closeKmsgLogSenders(logger *zap.Logger, senders map[string]*kmsgLogSender) {
+	var wg sync.WaitGroup
+
+	// close the senders in parallel, so that a single unavailable destination doesn't delay the others
+	for id, sender := range senders {
+		wg.Go(func() {
+			closeKmsgLogSender(logger, id, sender)
+		})
+	}
+
+	wg.Wait()
+}
+
+func closeKmsgLogSender(logger *zap.Logger, id string, sender *kmsgLogSender) {
+	closeCtx, closeCtxCancel := context.WithTimeout(context.Background(), logCloseTimeout)
+	defer closeCtxCancel()
+
+	if err := sender.Close(closeCtx); err != nil {
+		logger.Error("error closing log sender", zap.String("destination", id), zap.Error(err))
+	}
+}
+
+type logConfig struct {
+	endpoint *url.URL
+	format   string
+}
+
+func (c logConfig) Format() string {
+	return c.format
+}
+
+func (c logConfig) Endpoint() *url.URL {
+	return c.endpoint
+}
+
+func (c logConfig) ExtraTags() map[string]string {
+	return nil
+}
+
+func (c logConfig) TLS() config.LoggingTLSConfig {
+	return nil
 }
 
 func kmsgPriorityToLevel(pri kmsg.Priority) zapcore.Level {
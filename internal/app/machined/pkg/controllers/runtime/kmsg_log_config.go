@@ -66,7 +66,10 @@ func (ctrl *KmsgLogConfigController) Run(ctx context.Context, r controller.Runti
 		case <-r.EventCh():
 		}
 
-		var destinations []*url.URL
+		var (
+			destinations, syslogDestinations []*url.URL
+			buffers                          []runtime.KmsgLogBufferSpec
+		)
 
 		if ctrl.Cmdline != nil {
 			if val := ctrl.Cmdline.Get(constants.KernelParamLoggingKernel).First(); val != nil {
@@ -92,13 +95,28 @@ func (ctrl *KmsgLogConfigController) Run(ctx context.Context, r controller.Runti
 						return v.String() == u.String()
 					})
 				})...)
+
+			syslogDestinations = cfg.Config().Runtime().KmsgLogSyslogURLs()
+
+			for _, dest := range cfg.Config().KmsgLogDestinations() {
+				buffers = append(buffers, runtime.KmsgLogBufferSpec{
+					Destination:     dest.DestinationURL().String(),
+					MemorySize:      dest.MemoryBufferSize(),
+					SpoolSize:       dest.SpoolSize(),
+					DropPolicy:      dest.DropPolicy(),
+					RetryMinBackoff: dest.RetryMinBackoff(),
+					RetryMaxBackoff: dest.RetryMaxBackoff(),
+				})
+			}
 		}
 
 		r.StartTrackingOutputs()
 
-		if len(destinations) > 0 {
+		if len(destinations) > 0 || len(syslogDestinations) > 0 {
 			if err = safe.WriterModify(ctx, r, runtime.NewKmsgLogConfig(), func(cfg *runtime.KmsgLogConfig) error {
 				cfg.TypedSpec().Destinations = destinations
+				cfg.TypedSpec().SyslogDestinations = syslogDestinations
+				cfg.TypedSpec().Buffers = buffers
 
 				return nil
 			}); err != nil {
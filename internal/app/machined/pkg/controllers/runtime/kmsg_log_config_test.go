@@ -18,6 +18,7 @@ import (
 	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
 	runtimectrls "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
 	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/block"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
 	runtimecfg "github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
@@ -61,7 +62,19 @@ func (suite *KmsgLogConfigSuite) TestKmsgLogConfigMachineConfig() {
 		},
 	}
 
-	cfg, err := container.New(kmsgLogConfig1, kmsgLogConfig2)
+	kmsgLogConfig3 := &runtimecfg.KmsgLogV1Alpha1{
+		MetaName: "3",
+		KmsgLogURL: meta.URL{
+			URL: must(url.Parse("tls://10.0.0.3:6514")),
+		},
+		KmsgLogFormat: constants.LoggingFormatSyslog,
+		KmsgLogBuffer: &runtimecfg.KmsgLogBufferConfig{
+			BufferSpoolSize:  block.MustByteSize("16MiB"),
+			BufferDropPolicy: constants.KmsgLogDropPolicyNewest,
+		},
+	}
+
+	cfg, err := container.New(kmsgLogConfig1, kmsgLogConfig2, kmsgLogConfig3)
 	suite.Require().NoError(err)
 
 	suite.Require().NoError(suite.State().Create(suite.Ctx(), config.NewMachineConfig(cfg)))
@@ -75,6 +88,25 @@ func (suite *KmsgLogConfigSuite) TestKmsgLogConfigMachineConfig() {
 				},
 				xslices.Map(cfg.TypedSpec().Destinations, func(u *url.URL) string { return u.String() }),
 			)
+			asrt.Equal(
+				[]string{"tls://10.0.0.3:6514"},
+				xslices.Map(cfg.TypedSpec().SyslogDestinations, func(u *url.URL) string { return u.String() }),
+			)
+
+			buffer, ok := cfg.TypedSpec().Buffer(must(url.Parse("https://10.0.0.2:4444/logs")))
+			asrt.True(ok)
+			asrt.Equal(runtime.KmsgLogBufferSpec{
+				Destination:     "https://10.0.0.2:4444/logs",
+				MemorySize:      constants.KmsgLogDefaultBufferSize,
+				DropPolicy:      constants.KmsgLogDropPolicyOldest,
+				RetryMinBackoff: constants.KmsgLogDefaultRetryMinBackoff,
+				RetryMaxBackoff: constants.KmsgLogDefaultRetryMaxBackoff,
+			}, buffer)
+
+			buffer, ok = cfg.TypedSpec().Buffer(must(url.Parse("tls://10.0.0.3:6514")))
+			asrt.True(ok)
+			asrt.Equal(uint64(16*1024*1024), buffer.SpoolSize)
+			asrt.Equal(constants.KmsgLogDropPolicyNewest, buffer.DropPolicy)
 		})
 }
 
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
 	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/cosi-project/runtime/pkg/state/registry"
@@ -297,6 +298,10 @@ func (mock mockState) ResourceRegistry() *registry.ResourceRegistry {
 	return nil
 }
 
+func (mock mockState) RegisteredResource(resource.Type) (meta.ResourceWithRD, bool) {
+	return nil, false
+}
+
 func (mock mockState) GetConfig(context.Context) (config.Provider, error) {
 	return nil, nil
 }
@@ -11,15 +11,18 @@ import (
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
 	"go.uber.org/zap"
 
 	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
 	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
 )
 
 // MountStatusController transforms block.MountStatus resources into legacy v1alpha1.MountStatus.
 //
 // It only exists to provide backwards compatibility with legacy consumers.
+// Kubelet extra mounts are reported as well, as they are not managed by the block subsystem.
 type MountStatusController struct{}
 
 // Name implements controller.Controller interface.
@@ -40,6 +43,12 @@ func (ctrl *MountStatusController) Inputs() []controller.Input {
 			Type:      block.VolumeStatusType,
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: k8s.NamespaceName,
+			Type:      k8s.KubeletSpecType,
+			ID:        optional.Some(k8s.KubeletID),
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -97,8 +106,35 @@ func (ctrl *MountStatusController) Run(ctx context.Context, r controller.Runtime
 			}
 		}
 
+		kubeletSpec, err := safe.ReaderGetByID[*k8s.KubeletSpec](ctx, r, k8s.KubeletID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("failed to get kubelet spec: %w", err)
+		}
+
+		if kubeletSpec != nil {
+			for _, mount := range kubeletSpec.TypedSpec().ExtraMounts {
+				if err = safe.WriterModify(ctx, r, runtime.NewMountStatus(runtime.NamespaceName, KubeletExtraMountID(mount.Destination)),
+					func(res *runtime.MountStatus) error {
+						res.TypedSpec().Source = mount.Source
+						res.TypedSpec().Target = mount.Destination
+						res.TypedSpec().FilesystemType = mount.Type
+						res.TypedSpec().Options = mount.Options
+
+						return nil
+					},
+				); err != nil {
+					return fmt.Errorf("failed to write kubelet extra mount status: %w", err)
+				}
+			}
+		}
+
 		if err := safe.CleanupOutputs[*runtime.MountStatus](ctx, r); err != nil {
 			return fmt.Errorf("failed to cleanup mount statuses: %w", err)
 		}
 	}
 }
+
+// KubeletExtraMountID returns the MountStatus ID for the kubelet extra mount.
+func KubeletExtraMountID(destination string) string {
+	return "kubelet:" + destination
+}
@@ -0,0 +1,308 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/siderolabs/gen/maps"
+	"github.com/siderolabs/go-cmd/pkg/cmd/proc/reaper"
+	"go.uber.org/zap"
+
+	v1alpha1runtime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/pkg/miniprocfs"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+const (
+	// ProcessReaperSampleInterval is the interval to sample the processes for zombie and orphan accounting.
+	ProcessReaperSampleInterval = 30 * time.Second
+
+	// processReaperTopParents is the number of parent commands reported in the status.
+	processReaperTopParents = 10
+
+	// unknownParentCommand is reported when the parent process was not sampled.
+	unknownParentCommand = "<unknown>"
+)
+
+// ProcessReaperController tracks the processes reaped by machined (PID 1) and the zombie processes.
+//
+// The reaped processes are counted as the reaper reports them, while the zombie and orphaned processes
+// are accounted by sampling the processes on an interval, so the accounting is cheap.
+type ProcessReaperController struct {
+	V1Alpha1Events v1alpha1runtime.Publisher
+	// ProcPath defaults to /proc.
+	ProcPath string
+	// ReaperNotify subscribes to the processes reaped by machined, defaults to the machined process reaper.
+	ReaperNotify func(ch chan reaper.ProcessInfo) bool
+	// ReaperStop unsubscribes from the processes reaped by machined.
+	ReaperStop func(ch chan reaper.ProcessInfo)
+
+	tracker     processTracker
+	zombieAlert bool
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *ProcessReaperController) Name() string {
+	return "runtime.ProcessReaperController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *ProcessReaperController) Inputs() []controller.Input {
+	return nil
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *ProcessReaperController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: runtime.ProcessReaperStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *ProcessReaperController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.ProcPath == "" {
+		ctrl.ProcPath = "/proc"
+	}
+
+	if ctrl.ReaperNotify == nil {
+		ctrl.ReaperNotify = func(ch chan reaper.ProcessInfo) bool { return reaper.Notify(ch) }
+		ctrl.ReaperStop = func(ch chan reaper.ProcessInfo) { reaper.Stop(ch) }
+	}
+
+	reapedCh := make(chan reaper.ProcessInfo, 256)
+
+	if ctrl.ReaperNotify(reapedCh) {
+		defer ctrl.ReaperStop(reapedCh)
+	} else {
+		logger.Debug("process reaper is not running, reaped processes are not counted")
+	}
+
+	ticker := time.NewTicker(ProcessReaperSampleInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case info := <-reapedCh:
+			ctrl.tracker.reap(int32(info.Pid))
+
+			continue
+		case <-r.EventCh():
+		case <-ticker.C:
+		}
+
+		stats, err := ctrl.sample()
+		if err != nil {
+			return fmt.Errorf("error sampling processes: %w", err)
+		}
+
+		ctrl.tracker.sample(stats)
+
+		spec := ctrl.tracker.status()
+
+		if err = safe.WriterModify(ctx, r, runtime.NewProcessReaperStatus(), func(res *runtime.ProcessReaperStatus) error {
+			*res.TypedSpec() = spec
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating process reaper status: %w", err)
+		}
+
+		ctrl.report(ctx, logger, spec)
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// sample reads the short stat of all processes.
+func (ctrl *ProcessReaperController) sample() ([]miniprocfs.ProcessStat, error) {
+	processes, err := miniprocfs.NewProcessesWithPath(ctrl.ProcPath)
+	if err != nil {
+		return nil, err
+	}
+
+	defer processes.Close() //nolint:errcheck
+
+	var stats []miniprocfs.ProcessStat
+
+	for {
+		stat, err := processes.NextStat()
+		if err != nil {
+			return nil, err
+		}
+
+		if stat == nil {
+			return stats, nil
+		}
+
+		stats = append(stats, *stat)
+	}
+}
+
+// report publishes an event when the number of zombie processes crosses the threshold.
+func (ctrl *ProcessReaperController) report(ctx context.Context, logger *zap.Logger, spec runtime.ProcessReaperStatusSpec) {
+	if spec.ZombieProcesses < constants.ZombieProcessesThreshold {
+		ctrl.zombieAlert = false
+
+		return
+	}
+
+	if ctrl.zombieAlert {
+		return
+	}
+
+	ctrl.zombieAlert = true
+
+	parentCommands := make([]string, 0, len(spec.ZombieParents))
+
+	for _, parent := range spec.ZombieParents {
+		parentCommands = append(parentCommands, fmt.Sprintf("%s (%d)", parent.Command, parent.Count))
+	}
+
+	logger.Warn("zombie processes are accumulating",
+		zap.Uint64("zombies", spec.ZombieProcesses),
+		zap.Strings("parents", parentCommands),
+	)
+
+	if ctrl.V1Alpha1Events == nil {
+		return
+	}
+
+	ctrl.V1Alpha1Events.Publish(ctx, &machine.ZombieProcessesEvent{
+		Zombies:        int64(spec.ZombieProcesses),
+		Threshold:      constants.ZombieProcessesThreshold,
+		ParentCommands: parentCommands,
+	})
+}
+
+// processTracker accounts the reaped, orphaned and zombie processes.
+//
+// The original parent of an orphaned process is only known if the process was sampled before
+// it was reparented to PID 1.
+type processTracker struct {
+	// processes is the last sample
+	processes map[int32]miniprocfs.ProcessStat
+
+	reaped, orphaned uint64
+	orphanParents    map[string]uint64
+
+	zombies       uint64
+	zombieParents map[string]uint64
+}
+
+// reap accounts the process reaped by machined.
+func (t *processTracker) reap(pid int32) {
+	t.reaped++
+
+	stat, ok := t.processes[pid]
+	if !ok {
+		return
+	}
+
+	// the process was reparented to machined after the last sample
+	if stat.PPID != 1 {
+		t.orphan(stat.PPID)
+
+		// keep the process for the parent command lookups of its children, but don't account it twice
+		stat.PPID = 1
+		t.processes[pid] = stat
+	}
+}
+
+// sample accounts the processes reparented to machined since the last sample and the zombie processes.
+func (t *processTracker) sample(stats []miniprocfs.ProcessStat) {
+	processes := make(map[int32]miniprocfs.ProcessStat, len(stats))
+
+	for _, stat := range stats {
+		processes[stat.PID] = stat
+	}
+
+	t.zombies = 0
+	t.zombieParents = map[string]uint64{}
+
+	for _, stat := range stats {
+		if prev, ok := t.processes[stat.PID]; ok && stat.PPID == 1 && prev.PPID != 1 {
+			t.orphan(prev.PPID)
+		}
+
+		if stat.State == miniprocfs.StateZombie {
+			t.zombies++
+			t.zombieParents[parentCommand(processes, stat.PPID)]++
+		}
+	}
+
+	t.processes = processes
+}
+
+// orphan accounts the orphaned process by the command of its original parent.
+func (t *processTracker) orphan(ppid int32) {
+	if t.orphanParents == nil {
+		t.orphanParents = map[string]uint64{}
+	}
+
+	t.orphaned++
+	t.orphanParents[parentCommand(t.processes, ppid)]++
+}
+
+func (t *processTracker) status() runtime.ProcessReaperStatusSpec {
+	return runtime.ProcessReaperStatusSpec{
+		ReapedProcesses:   t.reaped,
+		OrphanedProcesses: t.orphaned,
+		ZombieProcesses:   t.zombies,
+		ZombieParents:     topParents(t.zombieParents),
+		OrphanParents:     topParents(t.orphanParents),
+	}
+}
+
+func parentCommand(processes map[int32]miniprocfs.ProcessStat, ppid int32) string {
+	if parent, ok := processes[ppid]; ok {
+		return parent.Command
+	}
+
+	return unknownParentCommand
+}
+
+// topParents returns the parent commands with the most processes first.
+func topParents(counts map[string]uint64) []runtime.ProcessParentSpec {
+	if len(counts) == 0 {
+		return nil
+	}
+
+	parents := maps.ToSlice(counts, func(command string, count uint64) runtime.ProcessParentSpec {
+		return runtime.ProcessParentSpec{
+			Command: command,
+			Count:   count,
+		}
+	})
+
+	slices.SortFunc(parents, func(a, b runtime.ProcessParentSpec) int {
+		if c := cmp.Compare(b.Count, a.Count); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a.Command, b.Command)
+	})
+
+	if len(parents) > processReaperTopParents {
+		parents = parents[:processReaperTopParents]
+	}
+
+	return parents
+}
@@ -0,0 +1,169 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/go-cmd/pkg/cmd/proc/reaper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	runtimectrls "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
+	"github.com/siderolabs/talos/internal/pkg/miniprocfs"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+func TestProcessTracker(t *testing.T) {
+	t.Parallel()
+
+	var tracker runtimectrls.ProcessTracker
+
+	tracker.Sample([]miniprocfs.ProcessStat{
+		{PID: 1, PPID: 0, State: "S", Command: "machined"},
+		{PID: 100, PPID: 1, State: "S", Command: "csi-driver"},
+		{PID: 200, PPID: 100, State: "S", Command: "mount"},
+		{PID: 201, PPID: 100, State: miniprocfs.StateZombie, Command: "mount"},
+		{PID: 202, PPID: 100, State: miniprocfs.StateZombie, Command: "mount"},
+		{PID: 300, PPID: 1, State: "S", Command: "containerd"},
+		{PID: 301, PPID: 300, State: miniprocfs.StateZombie, Command: "sh"},
+	})
+
+	assert.Equal(t, runtime.ProcessReaperStatusSpec{
+		ZombieProcesses: 3,
+		ZombieParents: []runtime.ProcessParentSpec{
+			{Command: "csi-driver", Count: 2},
+			{Command: "containerd", Count: 1},
+		},
+	}, tracker.Status())
+
+	// csi-driver exits, the zombies are reparented to machined and reaped
+	tracker.Reap(100)
+	tracker.Reap(201)
+	tracker.Reap(202)
+
+	// the process which was never sampled
+	tracker.Reap(400)
+
+	tracker.Sample([]miniprocfs.ProcessStat{
+		{PID: 1, PPID: 0, State: "S", Command: "machined"},
+		{PID: 200, PPID: 1, State: "S", Command: "mount"},
+		{PID: 300, PPID: 1, State: "S", Command: "containerd"},
+	})
+
+	assert.Equal(t, runtime.ProcessReaperStatusSpec{
+		ReapedProcesses:   4,
+		OrphanedProcesses: 3,
+		OrphanParents: []runtime.ProcessParentSpec{
+			{Command: "csi-driver", Count: 3},
+		},
+	}, tracker.Status())
+
+	// the orphan is not accounted twice
+	tracker.Reap(200)
+
+	assert.Equal(t, uint64(5), tracker.Status().ReapedProcesses)
+	assert.Equal(t, uint64(3), tracker.Status().OrphanedProcesses)
+}
+
+type mockZombieEvents struct {
+	mu     sync.Mutex
+	events []*machine.ZombieProcessesEvent
+}
+
+func (s *mockZombieEvents) Publish(_ context.Context, m proto.Message) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if event, ok := m.(*machine.ZombieProcessesEvent); ok {
+		s.events = append(s.events, event)
+	}
+}
+
+func (s *mockZombieEvents) get() []*machine.ZombieProcessesEvent {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]*machine.ZombieProcessesEvent(nil), s.events...)
+}
+
+type ProcessReaperSuite struct {
+	ctest.DefaultSuite
+
+	procPath string
+	events   *mockZombieEvents
+}
+
+func TestProcessReaperSuite(t *testing.T) {
+	t.Parallel()
+
+	s := &ProcessReaperSuite{}
+
+	s.DefaultSuite = ctest.DefaultSuite{
+		AfterSetup: func(suite *ctest.DefaultSuite) {
+			s.procPath = suite.T().TempDir()
+			s.events = &mockZombieEvents{}
+
+			s.writeProcess(1, 0, "S", "machined")
+			s.writeProcess(100, 1, "S", "csi-driver")
+
+			for pid := 1000; pid < 1000+constants.ZombieProcessesThreshold; pid++ {
+				s.writeProcess(pid, 100, miniprocfs.StateZombie, "mount")
+			}
+
+			suite.Require().NoError(suite.Runtime().RegisterController(&runtimectrls.ProcessReaperController{
+				V1Alpha1Events: s.events,
+				ProcPath:       s.procPath,
+				// reaped processes are covered by TestProcessTracker
+				ReaperNotify: func(chan reaper.ProcessInfo) bool { return false },
+			}))
+		},
+	}
+
+	suite.Run(t, s)
+}
+
+func (suite *ProcessReaperSuite) writeProcess(pid, ppid int, state, command string) {
+	dir := filepath.Join(suite.procPath, fmt.Sprint(pid))
+
+	suite.Require().NoError(os.Mkdir(dir, 0o755))
+	suite.Require().NoError(os.WriteFile(
+		filepath.Join(dir, "stat"),
+		fmt.Appendf(nil, "%d (%s) %s %d %d %d 0 -1 4194560 0 0 0 0 0 0 0 0 20 0 1 0 100 0 0\n", pid, command, state, ppid, pid, pid),
+		0o644,
+	))
+}
+
+func (suite *ProcessReaperSuite) TestZombies() {
+	ctest.AssertResource(suite, runtime.ProcessReaperStatusID, func(status *runtime.ProcessReaperStatus, asrt *assert.Assertions) {
+		asrt.EqualValues(constants.ZombieProcessesThreshold, status.TypedSpec().ZombieProcesses)
+		asrt.Equal([]runtime.ProcessParentSpec{
+			{Command: "csi-driver", Count: constants.ZombieProcessesThreshold},
+		}, status.TypedSpec().ZombieParents)
+	})
+
+	suite.EventuallyWithT(func(collect *assert.CollectT) {
+		asrt := assert.New(collect)
+
+		events := suite.events.get()
+
+		if !asrt.Len(events, 1) {
+			return
+		}
+
+		asrt.EqualValues(constants.ZombieProcessesThreshold, events[0].Zombies)
+		asrt.Equal([]string{fmt.Sprintf("csi-driver (%d)", constants.ZombieProcessesThreshold)}, events[0].ParentCommands)
+	}, 10*time.Second, 100*time.Millisecond)
+}
@@ -0,0 +1,202 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/crypto/x509"
+	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/go-procfs/procfs"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/siderolabs/talos/internal/app/maintenance"
+	"github.com/siderolabs/talos/internal/app/recovery"
+	"github.com/siderolabs/talos/pkg/grpc/factory"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/meta"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+// RecoveryServiceController runs the credential recovery service while the recovery gate is open.
+//
+// The service listens only for the link-local connections, and it reuses the maintenance service certificate.
+type RecoveryServiceController struct {
+	Cmdline      *procfs.Cmdline
+	MetaProvider MetaProvider
+	State        state.State
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *RecoveryServiceController) Name() string {
+	return "runtime.RecoveryServiceController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *RecoveryServiceController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.ActiveID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: runtime.NamespaceName,
+			Type:      runtime.MetaKeyType,
+			ID:        optional.Some(runtime.MetaKeyTagToID(meta.RecoveryGate)),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: secrets.NamespaceName,
+			Type:      secrets.MaintenanceServiceCertsType,
+			ID:        optional.Some(secrets.MaintenanceServiceCertsID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *RecoveryServiceController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo,cyclop
+func (ctrl *RecoveryServiceController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	var (
+		server   *grpc.Server
+		serverWg sync.WaitGroup
+		listener net.Listener
+	)
+
+	shutdownServer := func(ctx context.Context) {
+		if server != nil {
+			shutdownCtx, shutdownCancel := context.WithTimeout(ctx, 5*time.Second)
+			defer shutdownCancel()
+
+			factory.ServerGracefulStop(server, shutdownCtx)
+
+			serverWg.Wait()
+
+			server = nil
+
+			logger.Warn("credential recovery service stopped")
+		}
+
+		if listener != nil {
+			listener.Close() //nolint:errcheck
+
+			listener = nil
+		}
+	}
+
+	defer shutdownServer(context.Background())
+
+	srv := &recovery.Server{
+		State:   ctrl.State,
+		Cmdline: ctrl.Cmdline,
+		Meta:    ctrl.MetaProvider.Meta(),
+		Logger:  logger,
+	}
+
+	tlsProvider := maintenance.NewTLSProvider()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		source, err := recovery.CheckGate(ctx, r, ctrl.Cmdline)
+		if err != nil {
+			if errors.Is(err, recovery.ErrDisabled) || errors.Is(err, recovery.ErrGateClosed) {
+				shutdownServer(ctx)
+
+				continue
+			}
+
+			return err
+		}
+
+		cert, err := safe.ReaderGetByID[*secrets.MaintenanceServiceCerts](ctx, r, secrets.MaintenanceServiceCertsID)
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return fmt.Errorf("failed to get maintenance service certs: %w", err)
+		}
+
+		if err = tlsProvider.Update(cert); err != nil {
+			return fmt.Errorf("failed to update tls provider: %w", err)
+		}
+
+		if server != nil {
+			continue
+		}
+
+		rawListener, err := (&net.ListenConfig{}).Listen(ctx, "tcp", net.JoinHostPort("::", strconv.Itoa(constants.RecoveryPort)))
+		if err != nil {
+			return fmt.Errorf("failed to listen: %w", err)
+		}
+
+		listener = recovery.NewListener(rawListener, logger)
+
+		tlsConfig, err := tlsProvider.TLSConfig()
+		if err != nil {
+			return fmt.Errorf("failed to get tls config: %w", err)
+		}
+
+		server = factory.NewServer(
+			srv,
+			factory.WithDefaultLog(),
+			factory.ServerOptions(
+				grpc.Creds(
+					credentials.NewTLS(tlsConfig),
+				),
+			),
+		)
+
+		serverWg.Add(1)
+
+		go func() {
+			defer serverWg.Done()
+
+			//nolint:errcheck
+			server.Serve(listener)
+		}()
+
+		certificateFingerprint, err := x509.SPKIFingerprintFromPEM(cert.TypedSpec().Server.Crt)
+		if err != nil {
+			return fmt.Errorf("failed to get certificate fingerprint: %w", err)
+		}
+
+		logger.Warn("CREDENTIAL RECOVERY SERVICE IS RUNNING, admin credentials can be recovered over link-local connections",
+			zap.Stringer("gate", source),
+			zap.Int("port", constants.RecoveryPort),
+			zap.String("fingerprint", certificateFingerprint.String()),
+		)
+		logger.Sugar().Infof("\ttalosctl config new --recovery --nodes '[<link-local address>%%<interface>]:%d' --cert-fingerprint '%s' <path>",
+			constants.RecoveryPort, certificateFingerprint.String())
+
+		r.ResetRestartBackoff()
+	}
+}
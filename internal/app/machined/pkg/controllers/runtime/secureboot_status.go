@@ -0,0 +1,207 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/foxboron/go-uefi/efi"
+	"github.com/foxboron/go-uefi/efi/signature"
+	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/gen/xslices"
+	"go.uber.org/zap"
+
+	machineruntime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/pkg/secureboot/database"
+	"github.com/siderolabs/talos/internal/pkg/secureboot/eventlog"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	runtimeres "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// SecureBootStatusController reports the UEFI SecureBoot state and the enrolled keys.
+type SecureBootStatusController struct {
+	V1Alpha1Mode machineruntime.Mode
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *SecureBootStatusController) Name() string {
+	return "runtime.SecureBootStatusController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *SecureBootStatusController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: runtimeres.NamespaceName,
+			Type:      runtimeres.SecurityStateType,
+			ID:        optional.Some(runtimeres.SecurityStateID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *SecureBootStatusController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: runtimeres.SecureBootStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *SecureBootStatusController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	// UEFI variables are not available inside a container
+	if ctrl.V1Alpha1Mode == machineruntime.ModeContainer {
+		return nil
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		// wait for the security state, it reports whether Talos was booted from a UKI
+		securityState, err := safe.ReaderGetByID[*runtimeres.SecurityState](ctx, r, runtimeres.SecurityStateID)
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return fmt.Errorf("failed to get security state: %w", err)
+		}
+
+		if _, err = os.Stat(constants.EFIVarsMountPoint); err != nil {
+			// not booted with UEFI
+			return nil //nolint:nilerr
+		}
+
+		sbState := secureBootState{
+			secureBoot:    efi.GetSecureBoot(),
+			setupMode:     efi.GetSetupMode(),
+			bootedWithUKI: securityState.TypedSpec().BootedWithUKI,
+		}
+
+		for _, db := range []struct {
+			name  string
+			get   func() (*signature.SignatureDatabase, error)
+			certs *[]*x509.Certificate
+		}{
+			{name: "PK", get: efi.GetPK, certs: &sbState.pk},
+			{name: "KEK", get: efi.GetKEK, certs: &sbState.kek},
+			{name: "db", get: efi.Getdb, certs: &sbState.db},
+		} {
+			*db.certs, err = signatureDatabaseCertificates(db.get)
+			if err != nil {
+				logger.Warn("failed to read the signature database", zap.String("database", db.name), zap.Error(err))
+			}
+		}
+
+		log, _, err := eventlog.Read()
+		if err == nil {
+			sbState.authorities, err = log.Authorities()
+		}
+
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			logger.Warn("failed to read the boot authorities from the TPM event log", zap.Error(err))
+		}
+
+		if err = safe.WriterModify(ctx, r, runtimeres.NewSecureBootStatus(), func(res *runtimeres.SecureBootStatus) error {
+			*res.TypedSpec() = sbState.spec()
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("failed to update SecureBoot status: %w", err)
+		}
+
+		// the state doesn't change without a reboot
+		return nil
+	}
+}
+
+func signatureDatabaseCertificates(get func() (*signature.SignatureDatabase, error)) ([]*x509.Certificate, error) {
+	db, err := get()
+	if err != nil {
+		// the variable is missing when no keys are enrolled
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	var certs []*x509.Certificate
+
+	for _, list := range *db {
+		if list.SignatureType != signature.CERT_X509_GUID {
+			continue
+		}
+
+		for _, sig := range list.Signatures {
+			cert, err := x509.ParseCertificate(sig.Data)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse certificate: %w", err)
+			}
+
+			certs = append(certs, cert)
+		}
+	}
+
+	return certs, nil
+}
+
+// secureBootState is the SecureBoot state read from the UEFI variables and the TPM event log.
+type secureBootState struct {
+	pk, kek, db []*x509.Certificate
+	authorities []*x509.Certificate
+
+	secureBoot    bool
+	setupMode     bool
+	bootedWithUKI bool
+}
+
+func (s secureBootState) spec() runtimeres.SecureBootStatusSpec {
+	fingerprints := func(certs []*x509.Certificate) []string {
+		return xslices.Map(certs, func(cert *x509.Certificate) string {
+			return x509CertFingerprint(*cert)
+		})
+	}
+
+	spec := runtimeres.SecureBootStatusSpec{
+		SecureBoot:                s.secureBoot,
+		SetupMode:                 s.setupMode,
+		PKFingerprints:            fingerprints(s.pk),
+		KEKFingerprints:           fingerprints(s.kek),
+		DBFingerprints:            fingerprints(s.db),
+		BootAuthorityFingerprints: fingerprints(s.authorities),
+	}
+
+	spec.UKIVerified = spec.Enforced() && s.bootedWithUKI
+
+	for _, authority := range s.authorities {
+		if database.IsWellKnownCertificate(authority) {
+			continue
+		}
+
+		for _, cert := range s.db {
+			if cert.Equal(authority) {
+				spec.SigningKeyEnrolled = true
+			}
+		}
+	}
+
+	return spec
+}
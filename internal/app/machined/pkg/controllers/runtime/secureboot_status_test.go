@@ -0,0 +1,117 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	runtimectrls "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
+)
+
+func generateCertificate(t *testing.T, commonName string) *x509.Certificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return cert
+}
+
+func TestSecureBootStatusSpec(t *testing.T) {
+	t.Parallel()
+
+	pk := generateCertificate(t, "PK")
+	kek := generateCertificate(t, "KEK")
+	signingKey := generateCertificate(t, "Talos SecureBoot Signing Key")
+	otherKey := generateCertificate(t, "Other Signing Key")
+
+	for _, test := range []struct {
+		name string
+
+		secureBoot    bool
+		setupMode     bool
+		bootedWithUKI bool
+		authorities   []*x509.Certificate
+
+		expectedEnforced           bool
+		expectedSigningKeyEnrolled bool
+		expectedUKIVerified        bool
+	}{
+		{
+			name:          "enforced",
+			secureBoot:    true,
+			bootedWithUKI: true,
+			authorities:   []*x509.Certificate{signingKey},
+
+			expectedEnforced:           true,
+			expectedSigningKeyEnrolled: true,
+			expectedUKIVerified:        true,
+		},
+		{
+			name:          "setup mode",
+			secureBoot:    true,
+			setupMode:     true,
+			bootedWithUKI: true,
+		},
+		{
+			name:        "disabled",
+			authorities: []*x509.Certificate{signingKey},
+
+			expectedSigningKeyEnrolled: true,
+		},
+		{
+			name:        "booted with a different key",
+			secureBoot:  true,
+			authorities: []*x509.Certificate{otherKey},
+
+			expectedEnforced: true,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			spec := runtimectrls.SecureBootStatusSpec(
+				test.secureBoot, test.setupMode, test.bootedWithUKI,
+				[]*x509.Certificate{pk}, []*x509.Certificate{kek}, []*x509.Certificate{signingKey},
+				test.authorities,
+			)
+
+			assert.Equal(t, test.secureBoot, spec.SecureBoot)
+			assert.Equal(t, test.setupMode, spec.SetupMode)
+			assert.Equal(t, test.expectedEnforced, spec.Enforced())
+			assert.Equal(t, test.expectedSigningKeyEnrolled, spec.SigningKeyEnrolled)
+			assert.Equal(t, test.expectedUKIVerified, spec.UKIVerified)
+
+			assert.Len(t, spec.PKFingerprints, 1)
+			assert.Len(t, spec.KEKFingerprints, 1)
+			assert.Len(t, spec.DBFingerprints, 1)
+			assert.Len(t, spec.BootAuthorityFingerprints, len(test.authorities))
+			assert.NotEqual(t, spec.PKFingerprints[0], spec.KEKFingerprints[0])
+		})
+	}
+}
@@ -0,0 +1,106 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/system/services"
+	"github.com/siderolabs/talos/internal/pkg/cgroup"
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	runtimecfg "github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// ServiceResourcesController reports the effective resource protection of the system services.
+//
+// Memory protection overrides are applied to the cgroups of the running services,
+// the services which are not running pick them up when started.
+type ServiceResourcesController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *ServiceResourcesController) Name() string {
+	return "runtime.ServiceResourcesController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *ServiceResourcesController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.ActiveID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *ServiceResourcesController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: runtime.ServiceResourcesStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *ServiceResourcesController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.ActiveID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		var machineConfig talosconfig.Config
+
+		if cfg != nil {
+			machineConfig = cfg.Config()
+		}
+
+		r.StartTrackingOutputs()
+
+		for _, id := range runtimecfg.ServiceResourcesServices {
+			resources, ok := services.GetSystemServiceResources(machineConfig, id)
+			if !ok {
+				continue
+			}
+
+			if err = cgroup.UpdateMemoryProtection(resources.CgroupPath, resources.MemoryProtection); err != nil {
+				logger.Warn("failed to update memory protection", zap.String("service", id), zap.Error(err))
+			}
+
+			if err = safe.WriterModify(ctx, r, runtime.NewServiceResourcesStatus(id), func(status *runtime.ServiceResourcesStatus) error {
+				status.TypedSpec().CgroupPath = resources.CgroupPath
+				status.TypedSpec().OOMScoreAdj = resources.OOMScoreAdj
+				status.TypedSpec().MemoryMin = resources.MemoryProtection.Min
+				status.TypedSpec().MemoryLow = resources.MemoryProtection.Low
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error updating service resources status: %w", err)
+			}
+		}
+
+		if err = safe.CleanupOutputs[*runtime.ServiceResourcesStatus](ctx, r); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,79 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	runtimectrls "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/block"
+	runtimecfg "github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+type ServiceResourcesSuite struct {
+	ctest.DefaultSuite
+}
+
+func TestServiceResourcesSuite(t *testing.T) {
+	suite.Run(t, new(ServiceResourcesSuite))
+}
+
+func (suite *ServiceResourcesSuite) TestDefaults() {
+	suite.Require().NoError(suite.Runtime().RegisterController(&runtimectrls.ServiceResourcesController{}))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), runtimecfg.ServiceResourcesServices,
+		func(status *runtime.ServiceResourcesStatus, asrt *assert.Assertions) {
+			asrt.NotEmpty(status.TypedSpec().CgroupPath)
+			asrt.Negative(status.TypedSpec().OOMScoreAdj)
+			asrt.GreaterOrEqual(status.TypedSpec().MemoryLow, status.TypedSpec().MemoryMin)
+		})
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), "apid",
+		func(status *runtime.ServiceResourcesStatus, asrt *assert.Assertions) {
+			asrt.Equal(constants.CgroupApid, status.TypedSpec().CgroupPath)
+			asrt.Equal(-998, status.TypedSpec().OOMScoreAdj)
+			asrt.EqualValues(constants.CgroupApidReservedMemory, status.TypedSpec().MemoryMin)
+			asrt.EqualValues(constants.CgroupApidReservedMemory*2, status.TypedSpec().MemoryLow)
+		})
+}
+
+func (suite *ServiceResourcesSuite) TestOverrides() {
+	suite.Require().NoError(suite.Runtime().RegisterController(&runtimectrls.ServiceResourcesController{}))
+
+	etcdOverride := runtimecfg.NewServiceResourcesV1Alpha1("etcd")
+	etcdOverride.MemoryMinSize = block.MustByteSize("128MiB")
+	etcdOverride.MemoryLowSize = block.MustByteSize("512MiB")
+
+	cfg, err := container.New(etcdOverride)
+	suite.Require().NoError(err)
+
+	machineConfig := config.NewMachineConfig(cfg)
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), machineConfig))
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), "etcd",
+		func(status *runtime.ServiceResourcesStatus, asrt *assert.Assertions) {
+			asrt.Equal(constants.CgroupEtcd, status.TypedSpec().CgroupPath)
+			asrt.EqualValues(128*1024*1024, status.TypedSpec().MemoryMin)
+			asrt.EqualValues(512*1024*1024, status.TypedSpec().MemoryLow)
+		})
+
+	// removing the override restores the defaults
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), machineConfig.Metadata()))
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), "etcd",
+		func(status *runtime.ServiceResourcesStatus, asrt *assert.Assertions) {
+			asrt.Zero(status.TypedSpec().MemoryMin)
+			asrt.EqualValues(constants.CgroupEtcdReservedMemory, status.TypedSpec().MemoryLow)
+		})
+}
@@ -0,0 +1,314 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"slices"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/google/go-tpm/tpm2"
+	"github.com/google/go-tpm/tpm2/transport"
+	"go.uber.org/zap"
+
+	machineruntime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/pkg/secureboot/eventlog"
+	tpm2internal "github.com/siderolabs/talos/internal/pkg/secureboot/tpm2"
+	"github.com/siderolabs/talos/internal/pkg/tpm"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	runtimeres "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+// measuredPCRs is the list of PCRs reported in the measured boot status.
+//
+// PCRs 0-7 are measured by the firmware, and they can be verified against the event log.
+var measuredPCRs = []int{0, 1, 2, 3, 4, 5, 6, 7, constants.UKIPCR}
+
+// TPMStatusController reports the TPM device information and the measured boot state.
+type TPMStatusController struct {
+	V1Alpha1Mode machineruntime.Mode
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *TPMStatusController) Name() string {
+	return "runtime.TPMStatusController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *TPMStatusController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: v1alpha1.NamespaceName,
+			Type:      v1alpha1.ServiceType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *TPMStatusController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: runtimeres.TPMStatusType,
+			Kind: controller.OutputExclusive,
+		},
+		{
+			Type: runtimeres.MeasuredBootStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *TPMStatusController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	// the TPM belongs to the host in container mode
+	if ctrl.V1Alpha1Mode == machineruntime.ModeContainer {
+		return nil
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		// wait for the `machined` service to start, as by that time the boot measurements are done
+		_, err := safe.ReaderGetByID[*v1alpha1.Service](ctx, r, "machined")
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return fmt.Errorf("failed to get machined state: %w", err)
+		}
+
+		t, err := tpm.Open()
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+
+			return fmt.Errorf("error opening TPM device: %w", err)
+		}
+
+		tpmStatus, measuredBootStatus, err := readTPMStatus(t, logger)
+
+		t.Close() //nolint:errcheck
+
+		if err != nil {
+			// not a TPM 2.0 device, or the TPM is not functional
+			logger.Warn("failed to read the TPM status", zap.Error(err))
+
+			return nil
+		}
+
+		if err = safe.WriterModify(ctx, r, runtimeres.NewTPMStatus(), func(res *runtimeres.TPMStatus) error {
+			*res.TypedSpec() = tpmStatus
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("failed to update TPM status: %w", err)
+		}
+
+		if err = safe.WriterModify(ctx, r, runtimeres.NewMeasuredBootStatus(), func(res *runtimeres.MeasuredBootStatus) error {
+			*res.TypedSpec() = measuredBootStatus
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("failed to update measured boot status: %w", err)
+		}
+
+		// the PCR values are captured once, as they are extended by Talos later on
+		return nil
+	}
+}
+
+func readTPMStatus(t transport.TPM, logger *zap.Logger) (runtimeres.TPMStatusSpec, runtimeres.MeasuredBootStatusSpec, error) {
+	propertiesResp, err := tpm2.GetCapability{
+		Capability:    tpm2.TPMCapTPMProperties,
+		Property:      uint32(tpm2.TPMPTManufacturer),
+		PropertyCount: uint32(tpm2.TPMPTFirmwareVersion2-tpm2.TPMPTManufacturer) + 1,
+	}.Execute(t)
+	if err != nil {
+		return runtimeres.TPMStatusSpec{}, runtimeres.MeasuredBootStatusSpec{}, fmt.Errorf("failed to get TPM properties: %w", err)
+	}
+
+	properties, err := propertiesResp.CapabilityData.Data.TPMProperties()
+	if err != nil {
+		return runtimeres.TPMStatusSpec{}, runtimeres.MeasuredBootStatusSpec{}, fmt.Errorf("failed to parse TPM properties: %w", err)
+	}
+
+	pcrsResp, err := tpm2.GetCapability{
+		Capability:    tpm2.TPMCapPCRs,
+		Property:      0,
+		PropertyCount: 1,
+	}.Execute(t)
+	if err != nil {
+		return runtimeres.TPMStatusSpec{}, runtimeres.MeasuredBootStatusSpec{}, fmt.Errorf("failed to get PCR capabilities: %w", err)
+	}
+
+	assignedPCRs, err := pcrsResp.CapabilityData.Data.AssignedPCR()
+	if err != nil {
+		return runtimeres.TPMStatusSpec{}, runtimeres.MeasuredBootStatusSpec{}, fmt.Errorf("failed to parse assigned PCRs: %w", err)
+	}
+
+	tpmStatus := tpmStatusSpec(properties.TPMProperty, assignedPCRs.PCRSelections)
+
+	pcrs := map[int][]byte{}
+
+	for _, pcr := range measuredPCRs {
+		value, err := tpm2internal.ReadPCR(t, pcr)
+		if err != nil {
+			logger.Warn("failed to read PCR", zap.Int("pcr", pcr), zap.Error(err))
+
+			continue
+		}
+
+		pcrs[pcr] = value
+	}
+
+	log, rawLog, err := eventlog.Read()
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		logger.Warn("failed to read the TPM event log", zap.Error(err))
+	}
+
+	return tpmStatus, measuredBootStatusSpec(pcrs, log, rawLog), nil
+}
+
+// tpmStatusSpec builds the TPM status from the TPM properties and the PCR allocation.
+func tpmStatusSpec(properties []tpm2.TPMSTaggedProperty, selections []tpm2.TPMSPCRSelection) runtimeres.TPMStatusSpec {
+	var (
+		spec                   runtimeres.TPMStatusSpec
+		vendor                 [4]uint32
+		firmware1, firmware2   uint32
+		firmwareVersionPresent bool
+	)
+
+	for _, property := range properties {
+		switch property.Property { //nolint:exhaustive
+		case tpm2.TPMPTManufacturer:
+			spec.Manufacturer = propertyString(property.Value)
+		case tpm2.TPMPTVendorString1, tpm2.TPMPTVendorString2, tpm2.TPMPTVendorString3, tpm2.TPMPTVendorString4:
+			vendor[property.Property-tpm2.TPMPTVendorString1] = property.Value
+		case tpm2.TPMPTFirmwareVersion1:
+			firmware1, firmwareVersionPresent = property.Value, true
+		case tpm2.TPMPTFirmwareVersion2:
+			firmware2 = property.Value
+		}
+	}
+
+	spec.VendorInfo = propertyString(vendor[:]...)
+
+	if firmwareVersionPresent {
+		spec.FirmwareVersion = fmt.Sprintf("%d.%d.%d.%d", firmware1>>16, firmware1&0xffff, firmware2>>16, firmware2&0xffff)
+	}
+
+	for _, selection := range selections {
+		// the bank is enabled if at least one PCR is allocated in it
+		if !slices.ContainsFunc(selection.PCRSelect, func(b byte) bool { return b != 0 }) {
+			continue
+		}
+
+		spec.PCRBanks = append(spec.PCRBanks, pcrBankName(selection.Hash))
+	}
+
+	return spec
+}
+
+// propertyString decodes the TPM property value which is an ASCII string packed into 32-bit integers.
+func propertyString(values ...uint32) string {
+	var data []byte
+
+	for _, value := range values {
+		data = binary.BigEndian.AppendUint32(data, value)
+	}
+
+	return strings.TrimSpace(string(bytes.Trim(data, "\x00")))
+}
+
+func pcrBankName(alg tpm2.TPMAlgID) string {
+	switch alg { //nolint:exhaustive
+	case tpm2.TPMAlgSHA1:
+		return "sha1"
+	case tpm2.TPMAlgSHA256:
+		return "sha256"
+	case tpm2.TPMAlgSHA384:
+		return "sha384"
+	case tpm2.TPMAlgSHA512:
+		return "sha512"
+	case tpm2.TPMAlgSM3256:
+		return "sm3_256"
+	default:
+		return fmt.Sprintf("0x%04x", uint16(alg))
+	}
+}
+
+// measuredBootStatusSpec builds the measured boot status from the PCR values read from the TPM and the event log.
+//
+// The event log is verified if replaying it matches the values of all firmware PCRs (0-7).
+func measuredBootStatusSpec(pcrs map[int][]byte, log *eventlog.Log, rawLog []byte) runtimeres.MeasuredBootStatusSpec {
+	spec := runtimeres.MeasuredBootStatusSpec{
+		PCRBank: constants.DefaultTPMPCRBank,
+	}
+
+	for _, pcr := range measuredPCRs {
+		value, ok := pcrs[pcr]
+		if !ok {
+			continue
+		}
+
+		spec.PCRs = append(spec.PCRs, runtimeres.PCRValueSpec{
+			PCR:   pcr,
+			Value: hex.EncodeToString(value),
+		})
+	}
+
+	if log == nil {
+		return spec
+	}
+
+	digest := sha256.Sum256(rawLog)
+
+	spec.EventLogDigest = hex.EncodeToString(digest[:])
+	spec.EventLogEvents = len(log.Events)
+
+	replayed, err := log.Replay(eventlog.AlgorithmSHA256)
+	if err != nil {
+		return spec
+	}
+
+	spec.EventLogVerified = true
+
+	for pcr := range constants.SecureBootStatePCR + 1 {
+		expected, ok := replayed[pcr]
+		if !ok {
+			// no events were recorded for the PCR, so it should have the initial value
+			expected = make([]byte, sha256.Size)
+		}
+
+		value, ok := pcrs[pcr]
+		if !ok || !bytes.Equal(value, expected) {
+			spec.EventLogVerified = false
+
+			break
+		}
+	}
+
+	return spec
+}
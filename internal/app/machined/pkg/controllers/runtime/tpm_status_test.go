@@ -0,0 +1,107 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/google/go-tpm/tpm2"
+	"github.com/stretchr/testify/assert"
+
+	runtimectrls "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
+	"github.com/siderolabs/talos/internal/pkg/secureboot/eventlog"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+func TestTPMStatusSpec(t *testing.T) {
+	t.Parallel()
+
+	spec := runtimectrls.TPMStatusSpec(
+		[]tpm2.TPMSTaggedProperty{
+			{Property: tpm2.TPMPTManufacturer, Value: 0x494e5443},  // "INTC"
+			{Property: tpm2.TPMPTVendorString1, Value: 0x496e7465}, // "Inte"
+			{Property: tpm2.TPMPTVendorString2, Value: 0x6c000000}, // "l"
+			{Property: tpm2.TPMPTVendorString3, Value: 0},
+			{Property: tpm2.TPMPTVendorString4, Value: 0},
+			{Property: tpm2.TPMPTFirmwareVersion1, Value: 0x000b0002},
+			{Property: tpm2.TPMPTFirmwareVersion2, Value: 0x00030001},
+		},
+		[]tpm2.TPMSPCRSelection{
+			{Hash: tpm2.TPMAlgSHA1, PCRSelect: []byte{0, 0, 0}},
+			{Hash: tpm2.TPMAlgSHA256, PCRSelect: []byte{0xff, 0xff, 0xff}},
+			{Hash: tpm2.TPMAlgSHA384, PCRSelect: []byte{0xff, 0xff, 0xff}},
+		},
+	)
+
+	assert.Equal(t, runtime.TPMStatusSpec{
+		Manufacturer:    "INTC",
+		VendorInfo:      "Intel",
+		FirmwareVersion: "11.2.3.1",
+		PCRBanks:        []string{"sha256", "sha384"},
+	}, spec)
+}
+
+func TestMeasuredBootStatusSpec(t *testing.T) {
+	t.Parallel()
+
+	extend := func(value []byte, data string) []byte {
+		digest := sha256.Sum256([]byte(data))
+		h := sha256.New()
+		h.Write(value)
+		h.Write(digest[:])
+
+		return h.Sum(nil)
+	}
+
+	digest := func(data string) map[eventlog.Algorithm][]byte {
+		d := sha256.Sum256([]byte(data))
+
+		return map[eventlog.Algorithm][]byte{eventlog.AlgorithmSHA256: d[:]}
+	}
+
+	log := &eventlog.Log{
+		Algorithms: map[eventlog.Algorithm]int{eventlog.AlgorithmSHA256: 32},
+	}
+
+	pcrs := map[int][]byte{}
+
+	for pcr := range 8 {
+		log.Events = append(log.Events, eventlog.Event{
+			PCR:     pcr,
+			Type:    4, // EV_SEPARATOR
+			Digests: digest("separator"),
+		})
+
+		pcrs[pcr] = extend(make([]byte, 32), "separator")
+	}
+
+	pcrs[11] = extend(make([]byte, 32), ".linux")
+
+	rawLog := []byte("raw event log")
+	rawLogDigest := sha256.Sum256(rawLog)
+
+	spec := runtimectrls.MeasuredBootStatusSpec(pcrs, log, rawLog)
+
+	assert.Equal(t, "sha256", spec.PCRBank)
+	assert.Len(t, spec.PCRs, 9)
+	assert.Equal(t, runtime.PCRValueSpec{PCR: 11, Value: hex.EncodeToString(pcrs[11])}, spec.PCRs[8])
+	assert.Equal(t, hex.EncodeToString(rawLogDigest[:]), spec.EventLogDigest)
+	assert.Equal(t, 8, spec.EventLogEvents)
+	assert.True(t, spec.EventLogVerified)
+
+	// PCR 7 was extended outside of the event log
+	pcrs[7] = extend(pcrs[7], "unexpected")
+
+	spec = runtimectrls.MeasuredBootStatusSpec(pcrs, log, rawLog)
+	assert.False(t, spec.EventLogVerified)
+
+	// no event log
+	spec = runtimectrls.MeasuredBootStatusSpec(pcrs, nil, nil)
+	assert.Len(t, spec.PCRs, 9)
+	assert.Empty(t, spec.EventLogDigest)
+	assert.False(t, spec.EventLogVerified)
+}
@@ -0,0 +1,217 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	machineruntime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/meta"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/version"
+)
+
+// UpgradeStatusController resolves the upgrade checkpoint left by the previous boot and publishes the last upgrade attempt.
+//
+// An upgrade interrupted before the new image was installed is rolled back (the previous version is kept),
+// an upgrade interrupted after that is resumed by booting the new image, and it succeeds once the machine is running and ready.
+type UpgradeStatusController struct {
+	MetaProvider   MetaProvider
+	V1Alpha1Events machineruntime.Publisher
+	// BootedVersion defaults to version.Tag.
+	BootedVersion string
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *UpgradeStatusController) Name() string {
+	return "runtime.UpgradeStatusController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *UpgradeStatusController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: runtime.NamespaceName,
+			Type:      runtime.MetaKeyType,
+			ID:        optional.Some(runtime.MetaKeyTagToID(meta.UpgradeCheckpoint)),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: runtime.NamespaceName,
+			Type:      runtime.MetaLoadedType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: runtime.NamespaceName,
+			Type:      runtime.MachineStatusType,
+			ID:        optional.Some(runtime.MachineStatusID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *UpgradeStatusController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: runtime.UpgradeStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo,cyclop
+func (ctrl *UpgradeStatusController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	bootedVersion := ctrl.BootedVersion
+	if bootedVersion == "" {
+		bootedVersion = version.Tag
+	}
+
+	// the checkpoint left by the previous boot is resolved once, the checkpoints
+	// written afterwards belong to the upgrade running on this boot
+	resolved := false
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		metaLoaded, err := safe.ReaderGetByID[*runtime.MetaLoaded](ctx, r, runtime.MetaLoadedID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("failed to get meta loaded: %w", err)
+		}
+
+		if metaLoaded == nil {
+			continue
+		}
+
+		r.StartTrackingOutputs()
+
+		checkpoint, err := machineruntime.ReadUpgradeCheckpoint(ctrl.MetaProvider.Meta())
+		if err != nil {
+			logger.Warn("ignoring upgrade checkpoint", zap.Error(err))
+
+			checkpoint = nil
+		}
+
+		if checkpoint != nil {
+			var changed bool
+
+			if !resolved {
+				_, fallbackPending := ctrl.MetaProvider.Meta().ReadTag(meta.Upgrade)
+
+				changed = resolveUpgradeCheckpoint(checkpoint, bootedVersion, fallbackPending)
+			}
+
+			if checkpoint.Phase == runtime.UpgradePhaseBoot && checkpoint.Outcome == "" {
+				machineStatus, err := safe.ReaderGetByID[*runtime.MachineStatus](ctx, r, runtime.MachineStatusID)
+				if err != nil && !state.IsNotFoundError(err) {
+					return fmt.Errorf("error getting machine status: %w", err)
+				}
+
+				if machineStatus != nil && machineStatus.TypedSpec().Stage == runtime.MachineStageRunning && machineStatus.TypedSpec().Status.Ready {
+					checkpoint.Outcome = runtime.UpgradeOutcomeSucceeded
+					checkpoint.Message = ""
+					changed = true
+				}
+			}
+
+			if changed {
+				checkpoint.UpdatedAt = time.Now()
+
+				if err = machineruntime.WriteUpgradeCheckpoint(ctx, ctrl.MetaProvider.Meta(), checkpoint); err != nil {
+					return err
+				}
+
+				logger.Info("upgrade checkpoint updated",
+					zap.String("image", checkpoint.Image),
+					zap.String("phase", checkpoint.Phase),
+					zap.String("outcome", checkpoint.Outcome),
+					zap.String("message", checkpoint.Message),
+				)
+
+				ctrl.publish(ctx, checkpoint)
+			}
+
+			if err = safe.WriterModify(ctx, r, runtime.NewUpgradeStatus(), func(status *runtime.UpgradeStatus) error {
+				*status.TypedSpec() = *checkpoint
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error updating upgrade status: %w", err)
+			}
+		}
+
+		resolved = true
+
+		if err = safe.CleanupOutputs[*runtime.UpgradeStatus](ctx, r); err != nil {
+			return err
+		}
+	}
+}
+
+func (ctrl *UpgradeStatusController) publish(ctx context.Context, checkpoint *runtime.UpgradeStatusSpec) {
+	if ctrl.V1Alpha1Events == nil {
+		return
+	}
+
+	ctrl.V1Alpha1Events.Publish(ctx, &machine.UpgradeEvent{
+		Image:       checkpoint.Image,
+		FromVersion: checkpoint.FromVersion,
+		ToVersion:   checkpoint.ToVersion,
+		Phase:       checkpoint.Phase,
+		Outcome:     checkpoint.Outcome,
+		Message:     checkpoint.Message,
+	})
+}
+
+// resolveUpgradeCheckpoint decides the fate of the upgrade left in progress by the previous boot.
+//
+// The new image is booted if the installer left the fallback entry, or if the booted version differs from the version before the upgrade.
+// It returns true if the checkpoint was updated.
+func resolveUpgradeCheckpoint(checkpoint *runtime.UpgradeStatusSpec, bootedVersion string, fallbackPending bool) bool {
+	if checkpoint.Outcome != "" {
+		return false
+	}
+
+	bootedNew := fallbackPending || bootedVersion != checkpoint.FromVersion
+
+	switch {
+	case bootedNew && checkpoint.Phase == runtime.UpgradePhaseBoot:
+		// the new image was already booted, but the machine didn't become ready before the reboot
+		return false
+	case bootedNew:
+		if checkpoint.Phase != runtime.UpgradePhaseInstalled {
+			checkpoint.Message = fmt.Sprintf("resumed upgrade interrupted in phase %q", checkpoint.Phase)
+		}
+
+		checkpoint.Phase = runtime.UpgradePhaseBoot
+		checkpoint.ToVersion = bootedVersion
+	case checkpoint.Phase == runtime.UpgradePhaseBoot:
+		checkpoint.Outcome = runtime.UpgradeOutcomeRolledBack
+		checkpoint.Message = "the new image failed to boot, reverted to the previous version"
+	case checkpoint.Phase == runtime.UpgradePhaseInstalled:
+		checkpoint.Outcome = runtime.UpgradeOutcomeRolledBack
+		checkpoint.Message = "the previous version was booted after the new image was installed"
+	default:
+		checkpoint.Outcome = runtime.UpgradeOutcomeRolledBack
+		checkpoint.Message = fmt.Sprintf("upgrade interrupted in phase %q before the new image was installed, the previous version was kept", checkpoint.Phase)
+	}
+
+	return true
+}
@@ -0,0 +1,303 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	runtimectrls "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
+	machineruntime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/pkg/meta"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	metaconsts "github.com/siderolabs/talos/pkg/machinery/meta"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+func TestResolveUpgradeCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name            string
+		phase           string
+		outcome         string
+		bootedVersion   string
+		fallbackPending bool
+
+		expectedChanged bool
+		expectedPhase   string
+		expectedOutcome string
+	}{
+		{
+			name:            "interrupted while draining",
+			phase:           runtime.UpgradePhasePrepare,
+			bootedVersion:   "v1.11.0",
+			expectedChanged: true,
+			expectedPhase:   runtime.UpgradePhasePrepare,
+			expectedOutcome: runtime.UpgradeOutcomeRolledBack,
+		},
+		{
+			name:            "interrupted while installing",
+			phase:           runtime.UpgradePhaseInstall,
+			bootedVersion:   "v1.11.0",
+			expectedChanged: true,
+			expectedPhase:   runtime.UpgradePhaseInstall,
+			expectedOutcome: runtime.UpgradeOutcomeRolledBack,
+		},
+		{
+			name:            "interrupted while installing after the boot entry was switched",
+			phase:           runtime.UpgradePhaseInstall,
+			bootedVersion:   "v1.12.0",
+			expectedChanged: true,
+			expectedPhase:   runtime.UpgradePhaseBoot,
+		},
+		{
+			name:            "installed",
+			phase:           runtime.UpgradePhaseInstalled,
+			bootedVersion:   "v1.12.0",
+			fallbackPending: true,
+			expectedChanged: true,
+			expectedPhase:   runtime.UpgradePhaseBoot,
+		},
+		{
+			name:            "installed same version",
+			phase:           runtime.UpgradePhaseInstalled,
+			bootedVersion:   "v1.11.0",
+			fallbackPending: true,
+			expectedChanged: true,
+			expectedPhase:   runtime.UpgradePhaseBoot,
+		},
+		{
+			name:            "installed but the previous version was booted",
+			phase:           runtime.UpgradePhaseInstalled,
+			bootedVersion:   "v1.11.0",
+			expectedChanged: true,
+			expectedPhase:   runtime.UpgradePhaseInstalled,
+			expectedOutcome: runtime.UpgradeOutcomeRolledBack,
+		},
+		{
+			name:            "rebooted before ready",
+			phase:           runtime.UpgradePhaseBoot,
+			bootedVersion:   "v1.12.0",
+			fallbackPending: true,
+			expectedPhase:   runtime.UpgradePhaseBoot,
+		},
+		{
+			name:            "reverted",
+			phase:           runtime.UpgradePhaseBoot,
+			bootedVersion:   "v1.11.0",
+			expectedChanged: true,
+			expectedPhase:   runtime.UpgradePhaseBoot,
+			expectedOutcome: runtime.UpgradeOutcomeRolledBack,
+		},
+		{
+			name:            "finished",
+			phase:           runtime.UpgradePhaseBoot,
+			outcome:         runtime.UpgradeOutcomeSucceeded,
+			bootedVersion:   "v1.12.0",
+			expectedPhase:   runtime.UpgradePhaseBoot,
+			expectedOutcome: runtime.UpgradeOutcomeSucceeded,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			checkpoint := runtime.UpgradeStatusSpec{
+				Image:       "ghcr.io/siderolabs/installer:v1.12.0",
+				FromVersion: "v1.11.0",
+				Phase:       test.phase,
+				Outcome:     test.outcome,
+			}
+
+			assert.Equal(t, test.expectedChanged, runtimectrls.ResolveUpgradeCheckpoint(&checkpoint, test.bootedVersion, test.fallbackPending))
+			assert.Equal(t, test.expectedPhase, checkpoint.Phase)
+			assert.Equal(t, test.expectedOutcome, checkpoint.Outcome)
+
+			if test.expectedPhase == runtime.UpgradePhaseBoot && test.phase != runtime.UpgradePhaseBoot {
+				assert.Equal(t, test.bootedVersion, checkpoint.ToVersion)
+			}
+		})
+	}
+}
+
+type mockUpgradeEvents struct {
+	mu     sync.Mutex
+	events []*machine.UpgradeEvent
+}
+
+func (s *mockUpgradeEvents) Publish(_ context.Context, m proto.Message) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if event, ok := m.(*machine.UpgradeEvent); ok {
+		s.events = append(s.events, event)
+	}
+}
+
+func (s *mockUpgradeEvents) outcomes() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	outcomes := make([]string, 0, len(s.events))
+
+	for _, event := range s.events {
+		outcomes = append(outcomes, event.Outcome)
+	}
+
+	return outcomes
+}
+
+type UpgradeStatusSuite struct {
+	ctest.DefaultSuite
+
+	meta   *meta.Meta
+	events *mockUpgradeEvents
+}
+
+func TestUpgradeStatusSuite(t *testing.T) {
+	t.Parallel()
+
+	s := &UpgradeStatusSuite{}
+
+	s.DefaultSuite = ctest.DefaultSuite{
+		AfterSetup: func(suite *ctest.DefaultSuite) {
+			path := filepath.Join(suite.T().TempDir(), "meta")
+
+			f, err := os.Create(path)
+			suite.Require().NoError(err)
+			suite.Require().NoError(f.Truncate(1024 * 1024))
+			suite.Require().NoError(f.Close())
+
+			s.meta, err = meta.New(suite.Ctx(), suite.State(), meta.WithFixedPath(path))
+			suite.Require().NoError(err)
+
+			s.events = &mockUpgradeEvents{}
+
+			suite.Require().NoError(suite.Runtime().RegisterController(&runtimectrls.UpgradeStatusController{
+				MetaProvider:   metaProvider{meta: s.meta},
+				V1Alpha1Events: s.events,
+				BootedVersion:  "v1.12.0",
+			}))
+		},
+	}
+
+	suite.Run(t, s)
+}
+
+// writeCheckpoint simulates the machine losing power right after the checkpoint was written.
+func (suite *UpgradeStatusSuite) writeCheckpoint(phase string, fallbackPending bool) {
+	suite.Require().NoError(machineruntime.WriteUpgradeCheckpoint(suite.Ctx(), suite.meta, &runtime.UpgradeStatusSpec{
+		Image:       "ghcr.io/siderolabs/installer:v1.12.0",
+		FromVersion: "v1.12.0",
+		Phase:       phase,
+		StartedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}))
+
+	if fallbackPending {
+		_, err := suite.meta.SetTag(suite.Ctx(), metaconsts.Upgrade, "A")
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *UpgradeStatusSuite) boot() {
+	suite.Create(runtime.NewMetaLoaded())
+}
+
+func (suite *UpgradeStatusSuite) TestNoCheckpoint() {
+	suite.boot()
+
+	machineStatus := runtime.NewMachineStatus()
+	machineStatus.TypedSpec().Stage = runtime.MachineStageRunning
+	machineStatus.TypedSpec().Status.Ready = true
+	suite.Create(machineStatus)
+
+	ctest.AssertNoResource[*runtime.UpgradeStatus](suite, runtime.UpgradeStatusID)
+}
+
+func (suite *UpgradeStatusSuite) TestInterruptedBeforeInstall() {
+	suite.writeCheckpoint(runtime.UpgradePhaseInstall, false)
+	suite.boot()
+
+	ctest.AssertResource(suite, runtime.UpgradeStatusID, func(status *runtime.UpgradeStatus, asrt *assert.Assertions) {
+		asrt.Equal(runtime.UpgradePhaseInstall, status.TypedSpec().Phase)
+		asrt.Equal(runtime.UpgradeOutcomeRolledBack, status.TypedSpec().Outcome)
+		asrt.Empty(status.TypedSpec().ToVersion)
+	})
+
+	// the outcome is persisted
+	checkpoint, err := machineruntime.ReadUpgradeCheckpoint(suite.meta)
+	suite.Require().NoError(err)
+	suite.Assert().Equal(runtime.UpgradeOutcomeRolledBack, checkpoint.Outcome)
+
+	suite.Assert().Equal([]string{runtime.UpgradeOutcomeRolledBack}, suite.events.outcomes())
+}
+
+func (suite *UpgradeStatusSuite) TestInterruptedAfterInstall() {
+	suite.writeCheckpoint(runtime.UpgradePhaseInstalled, true)
+	suite.boot()
+
+	machineStatus := runtime.NewMachineStatus()
+	machineStatus.TypedSpec().Stage = runtime.MachineStageBooting
+	suite.Create(machineStatus)
+
+	ctest.AssertResource(suite, runtime.UpgradeStatusID, func(status *runtime.UpgradeStatus, asrt *assert.Assertions) {
+		asrt.Equal(runtime.UpgradePhaseBoot, status.TypedSpec().Phase)
+		asrt.Empty(status.TypedSpec().Outcome)
+		asrt.Equal("v1.12.0", status.TypedSpec().ToVersion)
+	})
+
+	machineStatus.TypedSpec().Stage = runtime.MachineStageRunning
+	machineStatus.TypedSpec().Status.Ready = true
+	suite.Update(machineStatus)
+
+	ctest.AssertResource(suite, runtime.UpgradeStatusID, func(status *runtime.UpgradeStatus, asrt *assert.Assertions) {
+		asrt.Equal(runtime.UpgradePhaseBoot, status.TypedSpec().Phase)
+		asrt.Equal(runtime.UpgradeOutcomeSucceeded, status.TypedSpec().Outcome)
+	})
+
+	checkpoint, err := machineruntime.ReadUpgradeCheckpoint(suite.meta)
+	suite.Require().NoError(err)
+	suite.Assert().Equal(runtime.UpgradeOutcomeSucceeded, checkpoint.Outcome)
+
+	suite.Assert().Equal([]string{"", runtime.UpgradeOutcomeSucceeded}, suite.events.outcomes())
+}
+
+func (suite *UpgradeStatusSuite) TestUpgradeOnThisBoot() {
+	suite.Require().NoError(machineruntime.WriteUpgradeCheckpoint(suite.Ctx(), suite.meta, &runtime.UpgradeStatusSpec{
+		Image:       "ghcr.io/siderolabs/installer:v1.11.0",
+		FromVersion: "v1.10.0",
+		ToVersion:   "v1.11.0",
+		Phase:       runtime.UpgradePhaseBoot,
+		Outcome:     runtime.UpgradeOutcomeSucceeded,
+	}))
+
+	suite.boot()
+
+	ctest.AssertResource(suite, runtime.UpgradeStatusID, func(status *runtime.UpgradeStatus, asrt *assert.Assertions) {
+		asrt.Equal(runtime.UpgradeOutcomeSucceeded, status.TypedSpec().Outcome)
+	})
+
+	// checkpoints written by the upgrade running on this boot are not resolved
+	for _, phase := range []string{runtime.UpgradePhasePrepare, runtime.UpgradePhaseInstall, runtime.UpgradePhaseInstalled} {
+		suite.writeCheckpoint(phase, phase == runtime.UpgradePhaseInstalled)
+
+		ctest.AssertResource(suite, runtime.UpgradeStatusID, func(status *runtime.UpgradeStatus, asrt *assert.Assertions) {
+			asrt.Equal(phase, status.TypedSpec().Phase)
+			asrt.Empty(status.TypedSpec().Outcome)
+		})
+	}
+
+	suite.Assert().Empty(suite.events.outcomes())
+}
@@ -7,6 +7,7 @@ package runtime
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"syscall"
 	"time"
@@ -20,9 +21,10 @@ import (
 	"golang.org/x/sys/unix"
 
 	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
 )
 
-// WatchdogTimerController watches v1alpha1.Config, creates/updates/deletes kernel module specs.
+// WatchdogTimerController opens the hardware watchdog and feeds it while the feed conditions are met.
 type WatchdogTimerController struct{}
 
 // Name implements controller.Controller interface.
@@ -38,6 +40,18 @@ func (ctrl *WatchdogTimerController) Inputs() []controller.Input {
 			Type:      runtime.WatchdogTimerConfigType,
 			ID:        optional.Some(runtime.WatchdogTimerConfigID),
 		},
+		{
+			Namespace: runtime.NamespaceName,
+			Type:      runtime.MachineStatusType,
+			ID:        optional.Some(runtime.MachineStatusID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: v1alpha1.NamespaceName,
+			Type:      v1alpha1.ServiceType,
+			ID:        optional.Some("kubelet"),
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -73,7 +87,11 @@ func (ctrl *WatchdogTimerController) Run(ctx context.Context, r controller.Runti
 
 	defer tickerStop()
 
-	var wd *os.File
+	var (
+		wd         *os.File
+		applied    *runtime.WatchdogTimerConfigSpec
+		conditions watchdogFeedConditions
+	)
 
 	wdClose := func() {
 		if wd == nil {
@@ -92,6 +110,7 @@ func (ctrl *WatchdogTimerController) Run(ctx context.Context, r controller.Runti
 		}
 
 		wd = nil
+		applied = nil
 	}
 
 	defer wdClose()
@@ -101,8 +120,8 @@ func (ctrl *WatchdogTimerController) Run(ctx context.Context, r controller.Runti
 		case <-ctx.Done():
 			return nil
 		case <-tickerC:
-			if _, _, err := syscall.Syscall(syscall.SYS_IOCTL, wd.Fd(), unix.WDIOC_KEEPALIVE, 0); err != 0 {
-				return fmt.Errorf("failed to feed watchdog: %w", err)
+			if err := ctrl.feed(ctx, r, logger, wd, applied, &conditions); err != nil {
+				return err
 			}
 
 			continue
@@ -116,6 +135,11 @@ func (ctrl *WatchdogTimerController) Run(ctx context.Context, r controller.Runti
 			}
 		}
 
+		// other inputs are only checked when the watchdog is fed
+		if cfg != nil && applied != nil && *applied == *cfg.TypedSpec() {
+			continue
+		}
+
 		r.StartTrackingOutputs()
 
 		if cfg == nil {
@@ -156,10 +180,17 @@ func (ctrl *WatchdogTimerController) Run(ctx context.Context, r controller.Runti
 
 			logger.Info("set hardware watchdog timeout", zap.Duration("timeout", cfg.TypedSpec().Timeout), zap.Duration("feed_interval", feedInterval))
 
+			// the feed conditions are re-armed with the new configuration
+			spec := *cfg.TypedSpec()
+			applied = &spec
+			conditions = watchdogFeedConditions{}
+
 			if err = safe.WriterModify(ctx, r, runtime.NewWatchdogTimerStatus(cfg.Metadata().ID()), func(status *runtime.WatchdogTimerStatus) error {
 				status.TypedSpec().Device = cfg.TypedSpec().Device
 				status.TypedSpec().Timeout = cfg.TypedSpec().Timeout
 				status.TypedSpec().FeedInterval = feedInterval
+				status.TypedSpec().LastFeed = time.Now()
+				status.TypedSpec().FeedBlockedBy = ""
 
 				return nil
 			}); err != nil {
@@ -172,3 +203,152 @@ func (ctrl *WatchdogTimerController) Run(ctx context.Context, r controller.Runti
 		}
 	}
 }
+
+// feed checks the feed conditions, feeds the watchdog if they are met and updates the status.
+func (ctrl *WatchdogTimerController) feed(
+	ctx context.Context, r controller.Runtime, logger *zap.Logger, wd *os.File, spec *runtime.WatchdogTimerConfigSpec, conditions *watchdogFeedConditions,
+) error {
+	feedInterval := spec.Timeout / 3
+
+	// machined is considered alive if the resource state responds in time
+	checkCtx, checkCancel := context.WithTimeout(ctx, feedInterval)
+	defer checkCancel()
+
+	var blockedBy string
+
+	machineStatus, err := safe.ReaderGetByID[*runtime.MachineStatus](checkCtx, r, runtime.MachineStatusID)
+
+	switch {
+	case err != nil && !state.IsNotFoundError(err):
+		blockedBy = fmt.Sprintf("machined is not responsive: %s", err)
+	default:
+		var stage runtime.MachineStage
+
+		if machineStatus != nil {
+			stage = machineStatus.TypedSpec().Stage
+		}
+
+		var (
+			kubeletHealthy bool
+			probeErr       error
+		)
+
+		if spec.RequireKubelet {
+			kubelet, err := safe.ReaderGetByID[*v1alpha1.Service](checkCtx, r, "kubelet")
+			if err != nil && !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting kubelet service: %w", err)
+			}
+
+			kubeletHealthy = kubelet != nil && kubelet.TypedSpec().Running && kubelet.TypedSpec().Healthy
+		}
+
+		if spec.ProbeURL != "" && !watchdogConditionsSuspended(stage) {
+			probeErr = watchdogProbe(checkCtx, spec.ProbeURL)
+		}
+
+		blockedBy = conditions.blockedBy(spec, stage, kubeletHealthy, probeErr)
+	}
+
+	if blockedBy != "" {
+		if conditions.lastBlockedBy != blockedBy {
+			logger.Warn("not feeding hardware watchdog", zap.String("reason", blockedBy))
+		}
+	} else {
+		if _, _, err := syscall.Syscall(syscall.SYS_IOCTL, wd.Fd(), uintptr(unix.WDIOC_KEEPALIVE), 0); err != 0 {
+			return fmt.Errorf("failed to feed watchdog: %w", err)
+		}
+
+		if conditions.lastBlockedBy != "" {
+			logger.Info("resumed feeding hardware watchdog")
+		}
+	}
+
+	conditions.lastBlockedBy = blockedBy
+
+	if err = safe.WriterModify(ctx, r, runtime.NewWatchdogTimerStatus(runtime.WatchdogTimerConfigID), func(status *runtime.WatchdogTimerStatus) error {
+		status.TypedSpec().Device = spec.Device
+		status.TypedSpec().Timeout = spec.Timeout
+		status.TypedSpec().FeedInterval = feedInterval
+		status.TypedSpec().FeedBlockedBy = blockedBy
+
+		if blockedBy == "" {
+			status.TypedSpec().LastFeed = time.Now()
+		}
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("error updating watchdog status: %w", err)
+	}
+
+	return nil
+}
+
+// watchdogFeedConditions tracks whether the optional feed conditions are armed.
+//
+// A condition is armed once it is met for the first time, so that the machine is not reset
+// while the kubelet (or the probed endpoint) is still starting up.
+type watchdogFeedConditions struct {
+	kubeletArmed  bool
+	probeArmed    bool
+	lastBlockedBy string
+}
+
+// blockedBy returns the reason the watchdog should not be fed, or an empty string if it should be fed.
+func (c *watchdogFeedConditions) blockedBy(spec *runtime.WatchdogTimerConfigSpec, stage runtime.MachineStage, kubeletHealthy bool, probeErr error) string {
+	if watchdogConditionsSuspended(stage) {
+		return ""
+	}
+
+	if spec.RequireKubelet {
+		switch {
+		case kubeletHealthy:
+			c.kubeletArmed = true
+		case c.kubeletArmed:
+			return "kubelet is not healthy"
+		}
+	}
+
+	if spec.ProbeURL != "" {
+		switch {
+		case probeErr == nil:
+			c.probeArmed = true
+		case c.probeArmed:
+			return fmt.Sprintf("probe %s failed: %s", spec.ProbeURL, probeErr)
+		}
+	}
+
+	return ""
+}
+
+// watchdogConditionsSuspended returns true if the optional feed conditions are not enforced in the stage.
+//
+// During intentional reboots, shutdowns, resets and upgrades the services are stopped on purpose,
+// so the watchdog is fed as long as machined is alive.
+func watchdogConditionsSuspended(stage runtime.MachineStage) bool {
+	switch stage { //nolint:exhaustive
+	case runtime.MachineStageRebooting, runtime.MachineStageShuttingDown, runtime.MachineStageResetting, runtime.MachineStageUpgrading:
+		return true
+	default:
+		return false
+	}
+}
+
+func watchdogProbe(ctx context.Context, url string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+
+	resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	return nil
+}
@@ -69,6 +69,8 @@ func (ctrl *WatchdogTimerConfigController) Run(ctx context.Context, r controller
 				if err = safe.WriterModify(ctx, r, runtime.NewWatchdogTimerConfig(), func(cfg *runtime.WatchdogTimerConfig) error {
 					cfg.TypedSpec().Device = watchdogConfig.Device()
 					cfg.TypedSpec().Timeout = watchdogConfig.Timeout()
+					cfg.TypedSpec().RequireKubelet = watchdogConfig.RequireKubelet()
+					cfg.TypedSpec().ProbeURL = watchdogConfig.ProbeURL()
 
 					return nil
 				}); err != nil {
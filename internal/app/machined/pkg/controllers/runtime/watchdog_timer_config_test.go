@@ -5,10 +5,13 @@
 package runtime_test
 
 import (
+	"net/url"
 	"testing"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/siderolabs/gen/ensure"
+	"github.com/siderolabs/go-pointer"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 
@@ -58,3 +61,26 @@ func (suite *WatchdogTimerConfigSuite) TestWatchdogTimerConfigMachineConfig() {
 			)
 		})
 }
+
+func (suite *WatchdogTimerConfigSuite) TestWatchdogTimerConfigFeed() {
+	suite.Require().NoError(suite.Runtime().RegisterController(&runtimectrls.WatchdogTimerConfigController{}))
+
+	watchdogTimerConfig := &runtimecfg.WatchdogTimerV1Alpha1{
+		WatchdogDevice: "/dev/watchdog0",
+		WatchdogFeed: &runtimecfg.WatchdogFeedConfig{
+			FeedRequireKubelet: pointer.To(true),
+		},
+	}
+	watchdogTimerConfig.WatchdogFeed.FeedProbeURL.URL = ensure.Value(url.Parse("http://127.0.0.1:8080/healthz"))
+
+	cfg, err := container.New(watchdogTimerConfig)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), config.NewMachineConfig(cfg)))
+
+	rtestutils.AssertResources[*runtime.WatchdogTimerConfig](suite.Ctx(), suite.T(), suite.State(), []resource.ID{runtime.WatchdogTimerConfigID},
+		func(cfg *runtime.WatchdogTimerConfig, asrt *assert.Assertions) {
+			asrt.True(cfg.TypedSpec().RequireKubelet)
+			asrt.Equal("http://127.0.0.1:8080/healthz", cfg.TypedSpec().ProbeURL)
+		})
+}
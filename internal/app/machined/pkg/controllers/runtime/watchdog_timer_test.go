@@ -0,0 +1,78 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	runtimectrls "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+func TestWatchdogFeedConditions(t *testing.T) {
+	t.Parallel()
+
+	type step struct {
+		stage          runtime.MachineStage
+		kubeletHealthy bool
+		probeErr       error
+
+		expectedBlockedBy string
+	}
+
+	errProbe := errors.New("connection refused")
+
+	for _, test := range []struct {
+		name  string
+		spec  runtime.WatchdogTimerConfigSpec
+		steps []step
+	}{
+		{
+			name: "no conditions",
+			spec: runtime.WatchdogTimerConfigSpec{},
+			steps: []step{
+				{stage: runtime.MachineStageRunning},
+				{stage: runtime.MachineStageRunning, probeErr: errProbe},
+			},
+		},
+		{
+			name: "kubelet",
+			spec: runtime.WatchdogTimerConfigSpec{RequireKubelet: true},
+			steps: []step{
+				// not armed until the kubelet is healthy for the first time
+				{stage: runtime.MachineStageBooting},
+				{stage: runtime.MachineStageRunning, kubeletHealthy: true},
+				{stage: runtime.MachineStageRunning, expectedBlockedBy: "kubelet is not healthy"},
+				{stage: runtime.MachineStageRunning, kubeletHealthy: true},
+				// suspended during upgrades
+				{stage: runtime.MachineStageUpgrading},
+			},
+		},
+		{
+			name: "probe",
+			spec: runtime.WatchdogTimerConfigSpec{ProbeURL: "http://127.0.0.1:8080/healthz"},
+			steps: []step{
+				{stage: runtime.MachineStageBooting, probeErr: errProbe},
+				{stage: runtime.MachineStageRunning},
+				{stage: runtime.MachineStageRunning, probeErr: errProbe, expectedBlockedBy: "probe http://127.0.0.1:8080/healthz failed: connection refused"},
+				{stage: runtime.MachineStageShuttingDown, probeErr: errProbe},
+				{stage: runtime.MachineStageRebooting, probeErr: errProbe},
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var conditions runtimectrls.WatchdogFeedConditions
+
+			for i, step := range test.steps {
+				assert.Equal(t, step.expectedBlockedBy, conditions.BlockedBy(&test.spec, step.stage, step.kubeletHealthy, step.probeErr), "step %d", i)
+			}
+		})
+	}
+}
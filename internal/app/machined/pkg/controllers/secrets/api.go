@@ -162,6 +162,13 @@ func (ctrl *APIController) reconcile(ctx context.Context, r controller.Runtime,
 			ID:        optional.Some(timeresource.StatusID),
 			Kind:      controller.InputWeak,
 		},
+		// same for the forced rotation request
+		{
+			Namespace: secrets.NamespaceName,
+			Type:      secrets.CertificateRotationType,
+			ID:        optional.Some(secrets.CertificateComponentTalosAPI),
+			Kind:      controller.InputWeak,
+		},
 	}
 
 	if !isControlplane {
@@ -269,9 +276,15 @@ func (ctrl *APIController) reconcile(ctx context.Context, r controller.Runtime,
 				return err
 			}
 		} else {
-			if err := ctrl.generateWorker(ctx, r, logger, rootSpec, endpointsStr, certSANs); err != nil {
+			lifetime, err := ctrl.generateWorker(ctx, r, logger, rootSpec, endpointsStr, certSANs)
+			if err != nil {
 				return err
 			}
+
+			// trustd might sign the certificate for less than the default validity, so refresh it in time
+			if lifetime > 0 {
+				refreshTicker.Reset(min(x509.DefaultCertificateValidityDuration, lifetime) / 2)
+			}
 		}
 
 		r.ResetRestartBackoff()
@@ -337,22 +350,22 @@ func (ctrl *APIController) generateControlPlane(ctx context.Context, r controlle
 
 func (ctrl *APIController) generateWorker(ctx context.Context, r controller.Runtime, logger *zap.Logger,
 	rootSpec *secrets.OSRootSpec, endpointsStr []string, certSANs *secrets.CertSANSpec,
-) error {
+) (time.Duration, error) {
 	remoteGen, err := gen.NewRemoteGenerator(rootSpec.Token, endpointsStr, rootSpec.AcceptedCAs)
 	if err != nil {
-		return fmt.Errorf("failed creating trustd client: %w", err)
+		return 0, fmt.Errorf("failed creating trustd client: %w", err)
 	}
 
 	defer remoteGen.Close() //nolint:errcheck
 
 	// use the last CA in the list of accepted CAs as a template
 	if len(rootSpec.AcceptedCAs) == 0 {
-		return errors.New("no accepted CAs")
+		return 0, errors.New("no accepted CAs")
 	}
 
 	acceptedCA, err := rootSpec.AcceptedCAs[len(rootSpec.AcceptedCAs)-1].GetCert()
 	if err != nil {
-		return fmt.Errorf("failed to parse CA certificate: %w", err)
+		return 0, fmt.Errorf("failed to parse CA certificate: %w", err)
 	}
 
 	serverCSR, serverCert, err := x509.NewCSRAndIdentityFromCA(
@@ -362,7 +375,7 @@ func (ctrl *APIController) generateWorker(ctx context.Context, r controller.Runt
 		x509.CommonName(certSANs.FQDN),
 	)
 	if err != nil {
-		return fmt.Errorf("failed to generate API server CSR: %w", err)
+		return 0, fmt.Errorf("failed to generate API server CSR: %w", err)
 	}
 
 	logger.Debug("sending CSR", zap.Strings("endpoints", endpointsStr))
@@ -391,12 +404,12 @@ func (ctrl *APIController) generateWorker(ctx context.Context, r controller.Runt
 		// wait for the goroutine to finish, ignoring the error (should be context.Canceled)
 		<-errCh
 
-		return nil
+		return 0, nil
 	case err = <-errCh:
 	}
 
 	if err != nil {
-		return fmt.Errorf("failed to sign API server CSR: %w", err)
+		return 0, fmt.Errorf("failed to sign API server CSR: %w", err)
 	}
 
 	if err := safe.WriterModify(ctx, r, secrets.NewAPI(),
@@ -412,7 +425,7 @@ func (ctrl *APIController) generateWorker(ctx context.Context, r controller.Runt
 
 			return nil
 		}); err != nil {
-		return fmt.Errorf("error modifying resource: %w", err)
+		return 0, fmt.Errorf("error modifying resource: %w", err)
 	}
 
 	serverFingerprint, _ := x509.SPKIFingerprintFromPEM(serverCert.Crt) //nolint:errcheck
@@ -421,7 +434,12 @@ func (ctrl *APIController) generateWorker(ctx context.Context, r controller.Runt
 		zap.Stringer("server", serverFingerprint),
 	)
 
-	return nil
+	cert, err := serverCert.GetCert()
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse API server certificate: %w", err)
+	}
+
+	return time.Until(cert.NotAfter), nil
 }
 
 func (ctrl *APIController) teardownAll(ctx context.Context, r controller.Runtime) error {
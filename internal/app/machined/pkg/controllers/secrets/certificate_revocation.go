@@ -0,0 +1,128 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secrets
+
+import (
+	"context"
+	stdx509 "crypto/x509"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/controller/generic/transform"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/xerrors"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/types/security"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+// CertificateRevocationController manages secrets.CertificateRevocation based on configuration.
+type CertificateRevocationController = transform.Controller[*config.MachineConfig, *secrets.CertificateRevocation]
+
+// NewCertificateRevocationController instanciates the controller.
+//
+//nolint:gocyclo
+func NewCertificateRevocationController() *CertificateRevocationController {
+	return transform.NewController(
+		transform.Settings[*config.MachineConfig, *secrets.CertificateRevocation]{
+			Name:                    "secrets.CertificateRevocationController",
+			MapMetadataOptionalFunc: rootMapFunc(secrets.NewCertificateRevocation(), false),
+			TransformFunc: func(ctx context.Context, r controller.Reader, logger *zap.Logger, cfg *config.MachineConfig, res *secrets.CertificateRevocation) error {
+				revocationConfig := cfg.Config().CertificateRevocationConfig()
+				if revocationConfig == nil {
+					*res.TypedSpec() = secrets.CertificateRevocationSpec{}
+
+					return nil
+				}
+
+				spec := secrets.CertificateRevocationSpec{
+					Fingerprints:     slices.Clone(revocationConfig.RevokedFingerprints()),
+					DenyOnExpiredCRL: revocationConfig.DenyOnExpiredCRL(),
+				}
+
+				for _, serialNumber := range revocationConfig.RevokedSerialNumbers() {
+					spec.SerialNumbers = append(spec.SerialNumbers, serialNumber.Text(16))
+				}
+
+				if crlPEM := revocationConfig.CRL(); crlPEM != nil {
+					osRoot, err := safe.ReaderGetByID[*secrets.OSRoot](ctx, r, secrets.OSRootID)
+					if err != nil {
+						if state.IsNotFoundError(err) {
+							return xerrors.NewTaggedf[transform.SkipReconcileTag]("OS root resource not found; not verifying the CRL")
+						}
+
+						return err
+					}
+
+					// the explicitly revoked certificates are published even if the CRL is not valid
+					if err = loadCRL(crlPEM, osRoot.TypedSpec(), &spec); err != nil {
+						logger.Warn("failed to load certificate revocation list", zap.Error(err))
+
+						spec.CRLError = err.Error()
+					} else {
+						logger.Debug("loaded certificate revocation list",
+							zap.Int("serial_numbers", len(spec.SerialNumbers)),
+							zap.Time("next_update", spec.CRLNextUpdate),
+						)
+					}
+				}
+
+				slices.Sort(spec.Fingerprints)
+				spec.Fingerprints = slices.Compact(spec.Fingerprints)
+
+				slices.Sort(spec.SerialNumbers)
+				spec.SerialNumbers = slices.Compact(spec.SerialNumbers)
+
+				*res.TypedSpec() = spec
+
+				return nil
+			},
+		},
+		transform.WithExtraInputs(
+			safe.Input[*secrets.OSRoot](controller.InputWeak),
+		),
+	)
+}
+
+// loadCRL parses the CRL, verifies its signature, and adds the revoked certificates to the spec.
+func loadCRL(crlPEM []byte, osRoot *secrets.OSRootSpec, spec *secrets.CertificateRevocationSpec) error {
+	crl, err := security.ParseCRL(crlPEM)
+	if err != nil {
+		return fmt.Errorf("error parsing the certificate revocation list: %w", err)
+	}
+
+	if err = checkCRLSignature(crl, osRoot); err != nil {
+		return err
+	}
+
+	for _, entry := range crl.RevokedCertificateEntries {
+		spec.SerialNumbers = append(spec.SerialNumbers, entry.SerialNumber.Text(16))
+	}
+
+	spec.CRLNextUpdate = crl.NextUpdate
+
+	return nil
+}
+
+// checkCRLSignature verifies that the CRL is signed by one of the accepted Talos API CAs.
+func checkCRLSignature(crl *stdx509.RevocationList, osRoot *secrets.OSRootSpec) error {
+	for _, acceptedCA := range osRoot.AcceptedCAs {
+		ca, err := acceptedCA.GetCert()
+		if err != nil {
+			continue
+		}
+
+		if crl.CheckSignatureFrom(ca) == nil {
+			return nil
+		}
+	}
+
+	return errors.New("certificate revocation list is not signed by the Talos API CA")
+}
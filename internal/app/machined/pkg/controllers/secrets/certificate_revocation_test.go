@@ -0,0 +1,183 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secrets_test
+
+import (
+	"crypto"
+	"crypto/rand"
+	stdx509 "crypto/x509"
+	"encoding/pem"
+	"math/big"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/siderolabs/crypto/x509"
+	"github.com/siderolabs/gen/xslices"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	secretsctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/secrets"
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate"
+	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/security"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+func TestCertificateRevocationSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, &CertificateRevocationSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 10 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(secretsctrl.NewRootOSController()))
+				suite.Require().NoError(suite.Runtime().RegisterController(secretsctrl.NewCertificateRevocationController()))
+			},
+		},
+	})
+}
+
+type CertificateRevocationSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *CertificateRevocationSuite) TestReconcile() {
+	input, err := generate.NewInput("test-cluster", "http://localhost:6443", "")
+	suite.Require().NoError(err)
+
+	cfg, err := input.Config(machine.TypeWorker)
+	suite.Require().NoError(err)
+
+	machineConfig := config.NewMachineConfig(cfg)
+	suite.Create(machineConfig)
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{secrets.CertificateRevocationID},
+		func(res *secrets.CertificateRevocation, asrt *assert.Assertions) {
+			asrt.Equal(secrets.CertificateRevocationSpec{}, *res.TypedSpec())
+		},
+	)
+
+	ca, err := x509.NewCertificateAuthorityFromCertificateAndKey(input.Options.SecretsBundle.Certs.OS)
+	suite.Require().NoError(err)
+
+	signer, ok := ca.Key.(crypto.Signer)
+	suite.Require().True(ok)
+
+	nextUpdate := time.Now().Add(time.Hour).Truncate(time.Second).UTC()
+
+	crl, err := stdx509.CreateRevocationList(rand.Reader, &stdx509.RevocationList{
+		Number:     big.NewInt(1),
+		ThisUpdate: time.Now(),
+		NextUpdate: nextUpdate,
+		RevokedCertificateEntries: []stdx509.RevocationListEntry{
+			{
+				SerialNumber:   big.NewInt(0xbeef),
+				RevocationTime: time.Now(),
+			},
+		},
+	}, ca.Crt, signer)
+	suite.Require().NoError(err)
+
+	revocation := security.NewCertificateRevocationConfigV1Alpha1()
+	revocation.RevocationRevokedCertificates = []security.RevokedCertificate{
+		{
+			RevokedSerialNumber: "0a:0b",
+		},
+		{
+			RevokedSerialNumber: "BEEF",
+		},
+	}
+	revocation.RevocationCRL = string(pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: crl}))
+	revocation.RevocationExpiredCRLPolicy = security.ExpiredCRLPolicyDeny
+
+	cfg, err = container.New(append(cfg.Documents(), revocation)...)
+	suite.Require().NoError(err)
+
+	newMachineConfig := config.NewMachineConfig(cfg)
+	newMachineConfig.Metadata().SetVersion(machineConfig.Metadata().Version())
+	suite.Update(newMachineConfig)
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{secrets.CertificateRevocationID},
+		func(res *secrets.CertificateRevocation, asrt *assert.Assertions) {
+			asrt.Equal(secrets.CertificateRevocationSpec{
+				SerialNumbers:    []string{"a0b", "beef"},
+				CRLNextUpdate:    nextUpdate,
+				DenyOnExpiredCRL: true,
+			}, *res.TypedSpec())
+
+			asrt.False(res.TypedSpec().IsExpired(time.Now()))
+			asrt.True(res.TypedSpec().IsExpired(nextUpdate.Add(time.Second)))
+		},
+	)
+
+	// CRL signed by an unknown CA is reported, the explicit revocations are still applied
+	unknownCA, err := x509.NewSelfSignedCertificateAuthority()
+	suite.Require().NoError(err)
+
+	unknownSigner, ok := unknownCA.Key.(crypto.Signer)
+	suite.Require().True(ok)
+
+	foreignCRL, err := stdx509.CreateRevocationList(rand.Reader, &stdx509.RevocationList{
+		Number:     big.NewInt(2),
+		ThisUpdate: time.Now(),
+		NextUpdate: nextUpdate,
+		RevokedCertificateEntries: []stdx509.RevocationListEntry{
+			{
+				SerialNumber:   big.NewInt(0xcafe),
+				RevocationTime: time.Now(),
+			},
+		},
+	}, unknownCA.Crt, unknownSigner)
+	suite.Require().NoError(err)
+
+	revocation.RevocationCRL = string(pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: foreignCRL}))
+
+	baseDocuments := xslices.Filter(cfg.Documents(), func(doc talosconfig.Document) bool {
+		_, isRevocation := doc.(*security.CertificateRevocationConfigV1Alpha1)
+
+		return !isRevocation
+	})
+
+	cfg, err = container.New(append(slices.Clone(baseDocuments), revocation)...)
+	suite.Require().NoError(err)
+
+	foreignMachineConfig := config.NewMachineConfig(cfg)
+	foreignMachineConfig.Metadata().SetVersion(newMachineConfig.Metadata().Version())
+	suite.Update(foreignMachineConfig)
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{secrets.CertificateRevocationID},
+		func(res *secrets.CertificateRevocation, asrt *assert.Assertions) {
+			asrt.Equal(secrets.CertificateRevocationSpec{
+				SerialNumbers:    []string{"a0b", "beef"},
+				DenyOnExpiredCRL: true,
+				CRLError:         "certificate revocation list is not signed by the Talos API CA",
+			}, *res.TypedSpec())
+		},
+	)
+
+	// CRL which can't be parsed
+	revocation.RevocationCRL = "-----BEGIN X509 CRL-----\nZm9v\n-----END X509 CRL-----\n"
+
+	cfg, err = container.New(append(slices.Clone(baseDocuments), revocation)...)
+	suite.Require().NoError(err)
+
+	brokenMachineConfig := config.NewMachineConfig(cfg)
+	brokenMachineConfig.Metadata().SetVersion(foreignMachineConfig.Metadata().Version())
+	suite.Update(brokenMachineConfig)
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{secrets.CertificateRevocationID},
+		func(res *secrets.CertificateRevocation, asrt *assert.Assertions) {
+			asrt.Equal([]string{"a0b", "beef"}, res.TypedSpec().SerialNumbers)
+			asrt.Contains(res.TypedSpec().CRLError, "error parsing the certificate revocation list")
+		},
+	)
+}
@@ -0,0 +1,410 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secrets
+
+import (
+	"context"
+	stdlibx509 "crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/crypto/x509"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+// CertificateStatusRefreshInterval is the interval to refresh the certificate status.
+//
+// Kubelet certificates are read from the disk, so they are picked up on refresh.
+const CertificateStatusRefreshInterval = time.Minute
+
+// CertificateStatusController tracks the expiry of the certificates managed by Talos.
+//
+// An event is published each time the remaining validity of a certificate crosses one of the configured thresholds.
+type CertificateStatusController struct {
+	V1Alpha1Events runtime.Publisher
+	Clock          clock.Clock
+	// KubeletPKIDir defaults to constants.KubeletPKIDir.
+	KubeletPKIDir string
+
+	// reported holds the lowest threshold reported for each certificate.
+	reported map[resource.ID]reportedThreshold
+}
+
+type reportedThreshold struct {
+	serialNumber string
+	threshold    int
+}
+
+// trackedCertificate is a certificate to report the status for.
+type trackedCertificate struct {
+	id        resource.ID
+	component string
+	cert      *stdlibx509.Certificate
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *CertificateStatusController) Name() string {
+	return "secrets.CertificateStatusController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *CertificateStatusController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.ActiveID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: secrets.NamespaceName,
+			Type:      secrets.EtcdType,
+			ID:        optional.Some(secrets.EtcdID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: secrets.NamespaceName,
+			Type:      secrets.KubernetesDynamicCertsType,
+			ID:        optional.Some(secrets.KubernetesDynamicCertsID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: secrets.NamespaceName,
+			Type:      secrets.KubernetesType,
+			ID:        optional.Some(secrets.KubernetesID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: secrets.NamespaceName,
+			Type:      secrets.APIType,
+			ID:        optional.Some(secrets.APIID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *CertificateStatusController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: secrets.CertificateStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *CertificateStatusController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.Clock == nil {
+		ctrl.Clock = clock.New()
+	}
+
+	if ctrl.KubeletPKIDir == "" {
+		ctrl.KubeletPKIDir = constants.KubeletPKIDir
+	}
+
+	if ctrl.reported == nil {
+		ctrl.reported = map[resource.ID]reportedThreshold{}
+	}
+
+	ticker := ctrl.Clock.Ticker(CertificateStatusRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ticker.C:
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.ActiveID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		thresholds := constants.DefaultCertificateExpiryThresholds
+
+		if cfg != nil && cfg.Config().CertificateExpiryConfig() != nil {
+			thresholds = cfg.Config().CertificateExpiryConfig().ExpiryThresholds()
+		}
+
+		certs, err := ctrl.collect(ctx, r, logger)
+		if err != nil {
+			return err
+		}
+
+		now := ctrl.Clock.Now()
+
+		r.StartTrackingOutputs()
+
+		touched := map[resource.ID]struct{}{}
+
+		for _, tracked := range certs {
+			spec := certificateStatusSpec(tracked, now, thresholds)
+
+			if err = safe.WriterModify(ctx, r, secrets.NewCertificateStatus(tracked.id), func(res *secrets.CertificateStatus) error {
+				*res.TypedSpec() = spec
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error updating certificate status: %w", err)
+			}
+
+			touched[tracked.id] = struct{}{}
+
+			ctrl.report(ctx, logger, tracked.id, spec)
+		}
+
+		for id := range ctrl.reported {
+			if _, ok := touched[id]; !ok {
+				delete(ctrl.reported, id)
+			}
+		}
+
+		if err = safe.CleanupOutputs[*secrets.CertificateStatus](ctx, r); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// collect gathers the certificates from the secrets resources and kubelet PKI directory.
+//
+// Missing resources are skipped, as the set of certificates depends on the machine type.
+//
+//nolint:gocyclo
+func (ctrl *CertificateStatusController) collect(ctx context.Context, r controller.Reader, logger *zap.Logger) ([]trackedCertificate, error) {
+	var certs []trackedCertificate
+
+	addPEM := func(id resource.ID, component string, pair *x509.PEMEncodedCertificateAndKey) {
+		if pair == nil || len(pair.Crt) == 0 {
+			return
+		}
+
+		cert, err := parseCertificate(pair.Crt)
+		if err != nil {
+			logger.Warn("failed to parse certificate", zap.String("id", id), zap.Error(err))
+
+			return
+		}
+
+		certs = append(certs, trackedCertificate{id: id, component: component, cert: cert})
+	}
+
+	addKubeconfig := func(id resource.ID, kubeconfig string) {
+		if kubeconfig == "" {
+			return
+		}
+
+		crt, err := kubeconfigClientCertificate(kubeconfig)
+		if err != nil {
+			logger.Warn("failed to parse kubeconfig", zap.String("id", id), zap.Error(err))
+
+			return
+		}
+
+		addPEM(id, secrets.CertificateComponentKubeconfig, &x509.PEMEncodedCertificateAndKey{Crt: crt})
+	}
+
+	etcdSecrets, err := safe.ReaderGetByID[*secrets.Etcd](ctx, r, secrets.EtcdID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, fmt.Errorf("error getting etcd secrets: %w", err)
+	}
+
+	if etcdSecrets != nil {
+		addPEM("etcd", secrets.CertificateComponentEtcd, etcdSecrets.TypedSpec().Etcd)
+		addPEM("etcd-peer", secrets.CertificateComponentEtcd, etcdSecrets.TypedSpec().EtcdPeer)
+		addPEM("etcd-admin", secrets.CertificateComponentEtcd, etcdSecrets.TypedSpec().EtcdAdmin)
+		addPEM("etcd-kube-apiserver-client", secrets.CertificateComponentEtcd, etcdSecrets.TypedSpec().EtcdAPIServer)
+	}
+
+	dynamicCerts, err := safe.ReaderGetByID[*secrets.KubernetesDynamicCerts](ctx, r, secrets.KubernetesDynamicCertsID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, fmt.Errorf("error getting kubernetes dynamic certs: %w", err)
+	}
+
+	if dynamicCerts != nil {
+		addPEM("kube-apiserver", secrets.CertificateComponentAPIServer, dynamicCerts.TypedSpec().APIServer)
+		addPEM("kube-apiserver-kubelet-client", secrets.CertificateComponentAPIServer, dynamicCerts.TypedSpec().APIServerKubeletClient)
+		addPEM("front-proxy-client", secrets.CertificateComponentAPIServer, dynamicCerts.TypedSpec().FrontProxy)
+	}
+
+	k8sSecrets, err := safe.ReaderGetByID[*secrets.Kubernetes](ctx, r, secrets.KubernetesID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, fmt.Errorf("error getting kubernetes secrets: %w", err)
+	}
+
+	if k8sSecrets != nil {
+		addKubeconfig("admin-kubeconfig", k8sSecrets.TypedSpec().AdminKubeconfig)
+		addKubeconfig("controller-manager-kubeconfig", k8sSecrets.TypedSpec().ControllerManagerKubeconfig)
+		addKubeconfig("scheduler-kubeconfig", k8sSecrets.TypedSpec().SchedulerKubeconfig)
+	}
+
+	apiSecrets, err := safe.ReaderGetByID[*secrets.API](ctx, r, secrets.APIID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, fmt.Errorf("error getting API secrets: %w", err)
+	}
+
+	if apiSecrets != nil {
+		addPEM("talos-api-server", secrets.CertificateComponentTalosAPI, apiSecrets.TypedSpec().Server)
+		addPEM("talos-api-client", secrets.CertificateComponentTalosAPI, apiSecrets.TypedSpec().Client)
+	}
+
+	for _, kubeletCert := range []struct {
+		id        resource.ID
+		filenames []string
+	}{
+		{"kubelet-client", []string{"kubelet-client-current.pem"}},
+		// serving certificate is either issued by the cluster CA (server TLS bootstrap) or self-signed
+		{"kubelet-serving", []string{"kubelet-server-current.pem", "kubelet.crt"}},
+	} {
+		for _, filename := range kubeletCert.filenames {
+			contents, err := os.ReadFile(filepath.Join(ctrl.KubeletPKIDir, filename))
+			if err != nil {
+				if errors.Is(err, os.ErrNotExist) {
+					continue
+				}
+
+				return nil, fmt.Errorf("error reading kubelet certificate: %w", err)
+			}
+
+			addPEM(kubeletCert.id, secrets.CertificateComponentKubelet, &x509.PEMEncodedCertificateAndKey{Crt: contents})
+
+			break
+		}
+	}
+
+	return certs, nil
+}
+
+// report publishes an event if the certificate crossed a threshold which was not reported yet.
+func (ctrl *CertificateStatusController) report(ctx context.Context, logger *zap.Logger, id resource.ID, spec secrets.CertificateStatusSpec) {
+	reported, ok := ctrl.reported[id]
+
+	// the certificate was renewed, start over
+	if !ok || reported.serialNumber != spec.SerialNumber {
+		reported = reportedThreshold{serialNumber: spec.SerialNumber}
+	}
+
+	if spec.ExpiryThreshold == 0 || (reported.threshold != 0 && reported.threshold <= spec.ExpiryThreshold) {
+		ctrl.reported[id] = reported
+
+		return
+	}
+
+	reported.threshold = spec.ExpiryThreshold
+	ctrl.reported[id] = reported
+
+	logger.Warn("certificate is about to expire",
+		zap.String("id", id),
+		zap.String("component", spec.Component),
+		zap.Time("not_after", spec.NotAfter),
+		zap.Int("days_remaining", spec.DaysRemaining),
+	)
+
+	if ctrl.V1Alpha1Events == nil {
+		return
+	}
+
+	ctrl.V1Alpha1Events.Publish(ctx, &machine.CertificateExpiryEvent{
+		Id:            id,
+		Component:     spec.Component,
+		NotAfter:      timestamppb.New(spec.NotAfter),
+		DaysRemaining: int64(spec.DaysRemaining),
+		Threshold:     int64(spec.ExpiryThreshold),
+	})
+}
+
+// certificateStatusSpec builds the status of the certificate at the specified time.
+//
+// Thresholds are expected to be sorted in descending order.
+func certificateStatusSpec(tracked trackedCertificate, now time.Time, thresholds []int) secrets.CertificateStatusSpec {
+	remaining := tracked.cert.NotAfter.Sub(now)
+
+	spec := secrets.CertificateStatusSpec{
+		Component:     tracked.component,
+		CommonName:    tracked.cert.Subject.CommonName,
+		SerialNumber:  tracked.cert.SerialNumber.Text(16),
+		NotBefore:     tracked.cert.NotBefore,
+		NotAfter:      tracked.cert.NotAfter,
+		DaysRemaining: int(math.Floor(remaining.Hours() / 24)),
+	}
+
+	for _, threshold := range thresholds {
+		if remaining < time.Duration(threshold)*24*time.Hour {
+			spec.ExpiryThreshold = threshold
+		}
+	}
+
+	return spec
+}
+
+// parseCertificate parses the first non-CA certificate in the PEM bundle.
+func parseCertificate(contents []byte) (*stdlibx509.Certificate, error) {
+	for {
+		var block *pem.Block
+
+		block, contents = pem.Decode(contents)
+		if block == nil {
+			return nil, errors.New("no certificate found")
+		}
+
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		cert, err := stdlibx509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+
+		if !cert.IsCA {
+			return cert, nil
+		}
+	}
+}
+
+// kubeconfigClientCertificate returns the client certificate of the current context of the kubeconfig.
+func kubeconfigClientCertificate(kubeconfig string) ([]byte, error) {
+	cfg, err := clientcmd.Load([]byte(kubeconfig))
+	if err != nil {
+		return nil, err
+	}
+
+	kubeContext, ok := cfg.Contexts[cfg.CurrentContext]
+	if !ok {
+		return nil, fmt.Errorf("context %q not found", cfg.CurrentContext)
+	}
+
+	authInfo, ok := cfg.AuthInfos[kubeContext.AuthInfo]
+	if !ok {
+		return nil, fmt.Errorf("user %q not found", kubeContext.AuthInfo)
+	}
+
+	return authInfo.ClientCertificateData, nil
+}
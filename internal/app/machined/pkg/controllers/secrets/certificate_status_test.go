@@ -0,0 +1,243 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secrets_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	stdlibx509 "crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/siderolabs/crypto/x509"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	secretsctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/secrets"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/security"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+type mockEventsStream struct {
+	mu       sync.Mutex
+	messages []proto.Message
+}
+
+func (s *mockEventsStream) Publish(_ context.Context, m proto.Message) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.messages = append(s.messages, m)
+}
+
+// thresholds returns the reported thresholds by certificate ID.
+func (s *mockEventsStream) thresholds() map[string][]int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := map[string][]int64{}
+
+	for _, m := range s.messages {
+		if event, ok := m.(*machine.CertificateExpiryEvent); ok {
+			result[event.GetId()] = append(result[event.GetId()], event.GetThreshold())
+		}
+	}
+
+	return result
+}
+
+type CertificateStatusSuite struct {
+	ctest.DefaultSuite
+
+	clock         *clock.Mock
+	events        *mockEventsStream
+	kubeletPKIDir string
+	start         time.Time
+}
+
+func TestCertificateStatusSuite(t *testing.T) {
+	s := &CertificateStatusSuite{}
+
+	s.DefaultSuite = ctest.DefaultSuite{
+		Timeout: 10 * time.Second,
+		AfterSetup: func(suite *ctest.DefaultSuite) {
+			s.start = time.Now().Truncate(time.Second)
+			s.clock = clock.NewMock()
+			s.clock.Set(s.start)
+			s.events = &mockEventsStream{}
+			s.kubeletPKIDir = suite.T().TempDir()
+
+			suite.Require().NoError(suite.Runtime().RegisterController(&secretsctrl.CertificateStatusController{
+				V1Alpha1Events: s.events,
+				Clock:          s.clock,
+				KubeletPKIDir:  s.kubeletPKIDir,
+			}))
+		},
+	}
+
+	suite.Run(t, s)
+}
+
+func (suite *CertificateStatusSuite) generateCertificate(commonName string, notAfter time.Time) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	suite.Require().NoError(err)
+
+	template := &stdlibx509.Certificate{
+		SerialNumber: big.NewInt(notAfter.Unix()),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    suite.start.Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+
+	der, err := stdlibx509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	suite.Require().NoError(err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func (suite *CertificateStatusSuite) days(n int) time.Time {
+	return suite.start.Add(time.Duration(n) * 24 * time.Hour)
+}
+
+func (suite *CertificateStatusSuite) assertThresholds(expected map[string][]int64) {
+	suite.AssertWithin(5*time.Second, 10*time.Millisecond, func() error {
+		actual := suite.events.thresholds()
+
+		for id, thresholds := range expected {
+			if !slices.Equal(actual[id], thresholds) {
+				return fmt.Errorf("unexpected thresholds for %q: %v", id, actual[id])
+			}
+		}
+
+		return nil
+	})
+}
+
+func (suite *CertificateStatusSuite) TestThresholds() {
+	// kubelet certificates are picked up on the next reconcile
+	suite.Require().NoError(os.WriteFile(filepath.Join(suite.kubeletPKIDir, "kubelet-client-current.pem"), suite.generateCertificate("system:node:foo", suite.days(10)), 0o600))
+
+	etcdSecrets := secrets.NewEtcd()
+	etcdSecrets.TypedSpec().Etcd = &x509.PEMEncodedCertificateAndKey{Crt: suite.generateCertificate("etcd", suite.days(40))}
+	suite.Create(etcdSecrets)
+
+	ctest.AssertResource(suite, "etcd", func(status *secrets.CertificateStatus, asrt *assert.Assertions) {
+		asrt.Equal(secrets.CertificateComponentEtcd, status.TypedSpec().Component)
+		asrt.Equal("etcd", status.TypedSpec().CommonName)
+		asrt.Equal(40, status.TypedSpec().DaysRemaining)
+		asrt.Zero(status.TypedSpec().ExpiryThreshold)
+	})
+
+	ctest.AssertResource(suite, "kubelet-client", func(status *secrets.CertificateStatus, asrt *assert.Assertions) {
+		asrt.Equal(secrets.CertificateComponentKubelet, status.TypedSpec().Component)
+		asrt.Equal(10, status.TypedSpec().DaysRemaining)
+		asrt.Equal(30, status.TypedSpec().ExpiryThreshold)
+	})
+
+	suite.assertThresholds(map[string][]int64{
+		"etcd":           nil,
+		"kubelet-client": {30},
+	})
+
+	// 31 days later: etcd certificate crosses 30 days threshold, kubelet client certificate expires
+	suite.clock.Add(31 * 24 * time.Hour)
+
+	ctest.AssertResource(suite, "etcd", func(status *secrets.CertificateStatus, asrt *assert.Assertions) {
+		asrt.Equal(9, status.TypedSpec().DaysRemaining)
+		asrt.Equal(30, status.TypedSpec().ExpiryThreshold)
+	})
+
+	ctest.AssertResource(suite, "kubelet-client", func(status *secrets.CertificateStatus, asrt *assert.Assertions) {
+		asrt.Equal(-21, status.TypedSpec().DaysRemaining)
+		asrt.Equal(1, status.TypedSpec().ExpiryThreshold)
+	})
+
+	suite.assertThresholds(map[string][]int64{
+		"etcd": {30},
+	})
+
+	// intermediate thresholds might be reported depending on the timing, but the last one is always reported
+	suite.AssertWithin(5*time.Second, 10*time.Millisecond, func() error {
+		thresholds := suite.events.thresholds()["kubelet-client"]
+
+		if len(thresholds) == 0 || thresholds[len(thresholds)-1] != 1 {
+			return fmt.Errorf("unexpected thresholds for kubelet-client: %v", thresholds)
+		}
+
+		return nil
+	})
+
+	// kubelet client certificate is renewed
+	suite.Require().NoError(os.WriteFile(filepath.Join(suite.kubeletPKIDir, "kubelet-client-current.pem"), suite.generateCertificate("system:node:foo", suite.days(31+365)), 0o600))
+
+	suite.clock.Add(secretsctrl.CertificateStatusRefreshInterval)
+
+	ctest.AssertResource(suite, "kubelet-client", func(status *secrets.CertificateStatus, asrt *assert.Assertions) {
+		asrt.Equal(364, status.TypedSpec().DaysRemaining)
+		asrt.Zero(status.TypedSpec().ExpiryThreshold)
+	})
+}
+
+func (suite *CertificateStatusSuite) TestConfiguredThresholds() {
+	apiSecrets := secrets.NewAPI()
+	apiSecrets.TypedSpec().Server = &x509.PEMEncodedCertificateAndKey{Crt: suite.generateCertificate("talos", suite.days(100))}
+	suite.Create(apiSecrets)
+
+	ctest.AssertResource(suite, "talos-api-server", func(status *secrets.CertificateStatus, asrt *assert.Assertions) {
+		asrt.Equal(secrets.CertificateComponentTalosAPI, status.TypedSpec().Component)
+		asrt.Equal(100, status.TypedSpec().DaysRemaining)
+		asrt.Zero(status.TypedSpec().ExpiryThreshold)
+	})
+
+	expiryConfig := security.NewCertificateExpiryConfigV1Alpha1()
+	expiryConfig.Thresholds = []int{90, 120}
+
+	cfg, err := container.New(expiryConfig)
+	suite.Require().NoError(err)
+
+	suite.Create(config.NewMachineConfig(cfg))
+
+	ctest.AssertResource(suite, "talos-api-server", func(status *secrets.CertificateStatus, asrt *assert.Assertions) {
+		asrt.Equal(120, status.TypedSpec().ExpiryThreshold)
+	})
+
+	suite.clock.Add(11 * 24 * time.Hour)
+
+	ctest.AssertResource(suite, "talos-api-server", func(status *secrets.CertificateStatus, asrt *assert.Assertions) {
+		asrt.Equal(89, status.TypedSpec().DaysRemaining)
+		asrt.Equal(90, status.TypedSpec().ExpiryThreshold)
+	})
+
+	suite.assertThresholds(map[string][]int64{
+		"talos-api-server": {120, 90},
+	})
+
+	// no more events for the same threshold
+	suite.clock.Add(24 * time.Hour)
+
+	ctest.AssertResource(suite, "talos-api-server", func(status *secrets.CertificateStatus, asrt *assert.Assertions) {
+		asrt.Equal(88, status.TypedSpec().DaysRemaining)
+	})
+
+	suite.assertThresholds(map[string][]int64{
+		"talos-api-server": {120, 90},
+	})
+}
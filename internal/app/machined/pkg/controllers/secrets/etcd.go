@@ -64,6 +64,13 @@ func (ctrl *EtcdController) Inputs() []controller.Input {
 			ID:        optional.Some(network.FilteredNodeAddressID(network.NodeAddressAccumulativeID, k8s.NodeAddressFilterNoK8s)),
 			Kind:      controller.InputWeak,
 		},
+		// forced rotation triggers the reconcile which regenerates the certificates
+		{
+			Namespace: secrets.NamespaceName,
+			Type:      secrets.CertificateRotationType,
+			ID:        optional.Some(secrets.CertificateComponentEtcd),
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
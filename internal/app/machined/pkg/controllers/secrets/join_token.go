@@ -0,0 +1,139 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secrets
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	machineruntime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/meta"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+// JoinTokenController manages secrets.JoinToken accepted by trustd.
+//
+// The join tokens are built from the machine configuration token and the tokens created via the API (stored in META).
+type JoinTokenController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *JoinTokenController) Name() string {
+	return "secrets.JoinTokenController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *JoinTokenController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineTypeType,
+			ID:        optional.Some(config.MachineTypeID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: secrets.NamespaceName,
+			Type:      secrets.OSRootType,
+			ID:        optional.Some(secrets.OSRootID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: runtime.NamespaceName,
+			Type:      runtime.MetaKeyType,
+			ID:        optional.Some(runtime.MetaKeyTagToID(meta.JoinTokens)),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *JoinTokenController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: secrets.JoinTokenType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *JoinTokenController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		machineType, err := safe.ReaderGetByID[*config.MachineType](ctx, r, config.MachineTypeID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine type: %w", err)
+		}
+
+		osRoot, err := safe.ReaderGetByID[*secrets.OSRoot](ctx, r, secrets.OSRootID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting OS root: %w", err)
+		}
+
+		metaKey, err := safe.ReaderGetByID[*runtime.MetaKey](ctx, r, runtime.MetaKeyTagToID(meta.JoinTokens))
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting join tokens META key: %w", err)
+		}
+
+		r.StartTrackingOutputs()
+
+		// only trustd running on the control plane nodes accepts the join tokens
+		if machineType != nil && machineType.MachineType().IsControlPlane() {
+			if osRoot != nil && osRoot.TypedSpec().Token != "" {
+				if err = safe.WriterModify(ctx, r, secrets.NewJoinToken(secrets.JoinTokenMachineConfigID), func(res *secrets.JoinToken) error {
+					*res.TypedSpec() = secrets.JoinTokenSpec{
+						TokenHash: secrets.HashJoinToken(osRoot.TypedSpec().Token),
+					}
+
+					return nil
+				}); err != nil {
+					return fmt.Errorf("error updating machine config join token: %w", err)
+				}
+			}
+
+			if metaKey != nil {
+				tokens, parseErr := machineruntime.ParseJoinTokens([]byte(metaKey.TypedSpec().Value))
+				if parseErr != nil {
+					// keep the machine config token working even if the stored tokens are corrupted
+					logger.Error("failed to parse join tokens", zap.Error(parseErr))
+				}
+
+				for name, spec := range tokens {
+					if name == secrets.JoinTokenMachineConfigID {
+						continue
+					}
+
+					if err = safe.WriterModify(ctx, r, secrets.NewJoinToken(name), func(res *secrets.JoinToken) error {
+						*res.TypedSpec() = spec
+
+						return nil
+					}); err != nil {
+						return fmt.Errorf("error updating join token %q: %w", name, err)
+					}
+				}
+			}
+		}
+
+		if err = safe.CleanupOutputs[*secrets.JoinToken](ctx, r); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
@@ -0,0 +1,100 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secrets_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	secretsctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/secrets"
+	machineruntime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+	"github.com/siderolabs/talos/pkg/machinery/meta"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+func TestJoinTokenSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, &JoinTokenSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 10 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&secretsctrl.JoinTokenController{}))
+			},
+		},
+	})
+}
+
+type JoinTokenSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *JoinTokenSuite) TestReconcile() {
+	machineType := config.NewMachineType()
+	machineType.SetMachineType(machine.TypeControlPlane)
+	suite.Create(machineType)
+
+	osRoot := secrets.NewOSRoot(secrets.OSRootID)
+	osRoot.TypedSpec().Token = "abcdef.0123456789abcdef"
+	suite.Create(osRoot)
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{secrets.JoinTokenMachineConfigID},
+		func(res *secrets.JoinToken, asrt *assert.Assertions) {
+			asrt.True(res.TypedSpec().Matches("abcdef.0123456789abcdef"))
+			asrt.False(res.TypedSpec().Matches("abcdef.0000000000000000"))
+			asrt.False(res.TypedSpec().Expired(time.Now()))
+		},
+	)
+
+	expiresAt := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tokens, err := json.Marshal(machineruntime.JoinTokens{
+		"workers": {
+			TokenHash: secrets.HashJoinToken("ghijkl.0123456789abcdef"),
+			ExpiresAt: expiresAt,
+		},
+	})
+	suite.Require().NoError(err)
+
+	metaKey := runtime.NewMetaKey(runtime.NamespaceName, runtime.MetaKeyTagToID(meta.JoinTokens))
+	metaKey.TypedSpec().Value = string(tokens)
+	suite.Create(metaKey)
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{"workers"},
+		func(res *secrets.JoinToken, asrt *assert.Assertions) {
+			asrt.True(res.TypedSpec().Matches("ghijkl.0123456789abcdef"))
+			asrt.Equal(expiresAt, res.TypedSpec().ExpiresAt.UTC())
+			asrt.False(res.TypedSpec().Expired(expiresAt.Add(-time.Second)))
+			asrt.True(res.TypedSpec().Expired(expiresAt))
+		},
+	)
+
+	// revoke the token
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), metaKey.Metadata()))
+
+	rtestutils.AssertNoResource[*secrets.JoinToken](suite.Ctx(), suite.T(), suite.State(), "workers")
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{secrets.JoinTokenMachineConfigID},
+		func(*secrets.JoinToken, *assert.Assertions) {},
+	)
+
+	// worker nodes don't accept the join tokens
+	ctest.UpdateWithConflicts(suite, machineType, func(res *config.MachineType) error {
+		res.SetMachineType(machine.TypeWorker)
+
+		return nil
+	})
+
+	rtestutils.AssertNoResource[*secrets.JoinToken](suite.Ctx(), suite.T(), suite.State(), secrets.JoinTokenMachineConfigID)
+}
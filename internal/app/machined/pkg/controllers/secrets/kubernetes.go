@@ -55,6 +55,12 @@ func (ctrl *KubernetesController) Inputs() []controller.Input {
 			ID:        optional.Some(timeresource.StatusID),
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: secrets.NamespaceName,
+			Type:      secrets.CertificateRotationType,
+			ID:        optional.Some(secrets.CertificateComponentKubeconfig),
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
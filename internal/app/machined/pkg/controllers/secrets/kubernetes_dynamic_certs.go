@@ -8,6 +8,9 @@ import (
 	"context"
 	stdlibx509 "crypto/x509"
 	"fmt"
+	"net"
+	"net/netip"
+	"slices"
 	"time"
 
 	"github.com/cosi-project/runtime/pkg/controller"
@@ -16,6 +19,7 @@ import (
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/siderolabs/crypto/x509"
 	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/gen/xslices"
 	"go.uber.org/zap"
 
 	"github.com/siderolabs/talos/pkg/machinery/constants"
@@ -51,7 +55,7 @@ func (ctrl *KubernetesDynamicCertsController) Outputs() []controller.Output {
 // Run implements controller.Controller interface.
 //
 //nolint:gocyclo,cyclop
-func (ctrl *KubernetesDynamicCertsController) Run(ctx context.Context, r controller.Runtime, _ *zap.Logger) error {
+func (ctrl *KubernetesDynamicCertsController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
 	// wait for the network to be ready first, then switch to regular inputs
 	if err := r.UpdateInputs([]controller.Input{
 		{
@@ -105,6 +109,12 @@ func (ctrl *KubernetesDynamicCertsController) Run(ctx context.Context, r control
 			ID:        optional.Some(secrets.CertSANKubernetesID),
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: secrets.NamespaceName,
+			Type:      secrets.CertificateRotationType,
+			ID:        optional.Some(secrets.CertificateComponentAPIServer),
+			Kind:      controller.InputWeak,
+		},
 	}); err != nil {
 		return fmt.Errorf("error updating inputs: %w", err)
 	}
@@ -114,12 +124,20 @@ func (ctrl *KubernetesDynamicCertsController) Run(ctx context.Context, r control
 	refreshTicker := time.NewTicker(KubernetesCertificateValidityDuration / 2)
 	defer refreshTicker.Stop()
 
+	// all certificates are re-issued on refresh, CA change, or rotation request,
+	// while the change of the SANs only re-issues the kube-apiserver serving certificate
+	var (
+		refresh                      bool
+		lastCAs, lastRotationVersion string
+	)
+
 	for {
 		select {
 		case <-ctx.Done():
 			return nil
 		case <-r.EventCh():
 		case <-refreshTicker.C:
+			refresh = true
 		}
 
 		k8sRoot, err := safe.ReaderGet[*secrets.KubernetesRoot](ctx, r, resource.NewMetadata(secrets.NamespaceName, secrets.KubernetesRootType, secrets.KubernetesRootID, resource.VersionUndefined))
@@ -158,12 +176,34 @@ func (ctrl *KubernetesDynamicCertsController) Run(ctx context.Context, r control
 			return err
 		}
 
+		var rotationVersion string
+
+		rotation, err := safe.ReaderGetByID[*secrets.CertificateRotation](ctx, r, secrets.CertificateComponentAPIServer)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting certificate rotation request: %w", err)
+		}
+
+		if rotation != nil {
+			rotationVersion = rotation.Metadata().Version().String()
+		}
+
+		cas := string(k8sRoot.TypedSpec().IssuingCA.Crt) + string(k8sRoot.TypedSpec().AggregatorCA.Crt)
+		renewAll := refresh || cas != lastCAs || rotationVersion != lastRotationVersion
+
 		if err = safe.WriterModify(ctx, r, secrets.NewKubernetesDynamicCerts(), func(r *secrets.KubernetesDynamicCerts) error {
-			return ctrl.updateSecrets(k8sRoot.TypedSpec(), r.TypedSpec(), certSANs.TypedSpec())
+			if renewAll || r.TypedSpec().APIServer == nil {
+				return ctrl.updateSecrets(k8sRoot.TypedSpec(), r.TypedSpec(), certSANs.TypedSpec())
+			}
+
+			return ctrl.updateAPIServerSANs(logger, k8sRoot.TypedSpec(), r.TypedSpec(), certSANs.TypedSpec())
 		}); err != nil {
 			return err
 		}
 
+		refresh = false
+		lastCAs = cas
+		lastRotationVersion = rotationVersion
+
 		r.ResetRestartBackoff()
 	}
 }
@@ -176,23 +216,10 @@ func (ctrl *KubernetesDynamicCertsController) updateSecrets(k8sRoot *secrets.Kub
 		return fmt.Errorf("failed to parse CA certificate: %w", err)
 	}
 
-	apiServer, err := x509.NewKeyPair(ca,
-		x509.IPAddresses(certSANs.StdIPs()),
-		x509.DNSNames(certSANs.DNSNames),
-		x509.CommonName("kube-apiserver"),
-		x509.Organization("kube-master"),
-		x509.NotAfter(time.Now().Add(KubernetesCertificateValidityDuration)),
-		x509.KeyUsage(stdlibx509.KeyUsageDigitalSignature|stdlibx509.KeyUsageKeyEncipherment),
-		x509.ExtKeyUsage([]stdlibx509.ExtKeyUsage{
-			stdlibx509.ExtKeyUsageServerAuth,
-		}),
-	)
-	if err != nil {
-		return fmt.Errorf("failed to generate api-server cert: %w", err)
+	if k8sCerts.APIServer, err = ctrl.issueAPIServer(ca, certSANs); err != nil {
+		return err
 	}
 
-	k8sCerts.APIServer = x509.NewCertificateAndKeyFromKeyPair(apiServer)
-
 	apiServerKubeletClient, err := x509.NewKeyPair(ca,
 		x509.CommonName(constants.KubernetesAPIServerKubeletClientCommonName),
 		x509.Organization(constants.KubernetesAdminCertOrganization),
@@ -230,6 +257,60 @@ func (ctrl *KubernetesDynamicCertsController) updateSecrets(k8sRoot *secrets.Kub
 	return nil
 }
 
+// updateAPIServerSANs re-issues the kube-apiserver serving certificate if the SANs have changed.
+func (ctrl *KubernetesDynamicCertsController) updateAPIServerSANs(logger *zap.Logger, k8sRoot *secrets.KubernetesRootSpec, k8sCerts *secrets.KubernetesDynamicCertsSpec,
+	certSANs *secrets.CertSANSpec,
+) error {
+	cert, err := k8sCerts.APIServer.GetCert()
+	if err != nil {
+		return fmt.Errorf("failed to parse api-server cert: %w", err)
+	}
+
+	certIPs := xslices.Map(cert.IPAddresses, func(ip net.IP) string { return ip.String() })
+	sanIPs := xslices.Map(certSANs.IPs, netip.Addr.String)
+
+	if sameSet(cert.DNSNames, certSANs.DNSNames) && sameSet(certIPs, sanIPs) {
+		return nil
+	}
+
+	logger.Info("re-issuing kube-apiserver certificate as SANs changed",
+		zap.Strings("dns_names", certSANs.DNSNames),
+		zap.Strings("ips", sanIPs),
+	)
+
+	ca, err := x509.NewCertificateAuthorityFromCertificateAndKey(k8sRoot.IssuingCA)
+	if err != nil {
+		return fmt.Errorf("failed to parse CA certificate: %w", err)
+	}
+
+	k8sCerts.APIServer, err = ctrl.issueAPIServer(ca, certSANs)
+
+	return err
+}
+
+func (ctrl *KubernetesDynamicCertsController) issueAPIServer(ca *x509.CertificateAuthority, certSANs *secrets.CertSANSpec) (*x509.PEMEncodedCertificateAndKey, error) {
+	apiServer, err := x509.NewKeyPair(ca,
+		x509.IPAddresses(certSANs.StdIPs()),
+		x509.DNSNames(certSANs.DNSNames),
+		x509.CommonName("kube-apiserver"),
+		x509.Organization("kube-master"),
+		x509.NotAfter(time.Now().Add(KubernetesCertificateValidityDuration)),
+		x509.KeyUsage(stdlibx509.KeyUsageDigitalSignature|stdlibx509.KeyUsageKeyEncipherment),
+		x509.ExtKeyUsage([]stdlibx509.ExtKeyUsage{
+			stdlibx509.ExtKeyUsageServerAuth,
+		}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate api-server cert: %w", err)
+	}
+
+	return x509.NewCertificateAndKeyFromKeyPair(apiServer), nil
+}
+
+func sameSet(a, b []string) bool {
+	return slices.Equal(slices.Sorted(slices.Values(a)), slices.Sorted(slices.Values(b)))
+}
+
 func (ctrl *KubernetesDynamicCertsController) teardownAll(ctx context.Context, r controller.Runtime) error {
 	list, err := r.List(ctx, resource.NewMetadata(secrets.NamespaceName, secrets.KubernetesDynamicCertsType, "", resource.VersionUndefined))
 	if err != nil {
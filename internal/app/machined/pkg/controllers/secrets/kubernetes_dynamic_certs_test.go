@@ -197,4 +197,45 @@ func (suite *KubernetesDynamicCertsSuite) TestReconcile() {
 				frontProxyCert.ExtKeyUsage,
 			)
 		})
+	var frontProxy []byte
+
+	ctest.AssertResource(suite, secrets.KubernetesDynamicCertsID, func(certs *secrets.KubernetesDynamicCerts, _ *assert.Assertions) {
+		frontProxy = certs.TypedSpec().FrontProxy.Crt
+	})
+
+	// adding SANs re-issues only the kube-apiserver serving certificate
+	certSANs.TypedSpec().Append("api.example.com", "10.5.0.1")
+	certSANs.TypedSpec().Sort()
+	suite.Update(certSANs)
+
+	ctest.AssertResource(suite, secrets.KubernetesDynamicCertsID, func(certs *secrets.KubernetesDynamicCerts, asrt *assert.Assertions) {
+		apiCert, err := certs.TypedSpec().APIServer.GetCert()
+		asrt.NoError(err)
+
+		if err != nil {
+			return
+		}
+
+		asrt.Contains(apiCert.DNSNames, "api.example.com")
+		asrt.Equal("[10.2.1.3 10.4.3.2 10.5.0.1 172.16.0.1]", fmt.Sprintf("%v", apiCert.IPAddresses))
+		asrt.Equal(frontProxy, certs.TypedSpec().FrontProxy.Crt)
+	})
+
+	// removing SANs
+	certSANs.TypedSpec().Reset()
+	certSANs.TypedSpec().Append("kubernetes", "localhost", "172.16.0.1")
+	suite.Update(certSANs)
+
+	ctest.AssertResource(suite, secrets.KubernetesDynamicCertsID, func(certs *secrets.KubernetesDynamicCerts, asrt *assert.Assertions) {
+		apiCert, err := certs.TypedSpec().APIServer.GetCert()
+		asrt.NoError(err)
+
+		if err != nil {
+			return
+		}
+
+		asrt.Equal([]string{"kubernetes", "localhost"}, apiCert.DNSNames)
+		asrt.Equal("[172.16.0.1]", fmt.Sprintf("%v", apiCert.IPAddresses))
+		asrt.Equal(frontProxy, certs.TypedSpec().FrontProxy.Crt)
+	})
 }
@@ -0,0 +1,217 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secrets
+
+import (
+	"context"
+	stdlibx509 "crypto/x509"
+	"errors"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/crypto/x509"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+// OSRootStatusController reports the Talos API CAs accepted and presented by the node.
+//
+// The status is used to track the convergence of the Talos API CA rotation.
+type OSRootStatusController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *OSRootStatusController) Name() string {
+	return "secrets.OSRootStatusController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *OSRootStatusController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: secrets.NamespaceName,
+			Type:      secrets.OSRootType,
+			ID:        optional.Some(secrets.OSRootID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: secrets.NamespaceName,
+			Type:      secrets.APIType,
+			ID:        optional.Some(secrets.APIID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: secrets.NamespaceName,
+			Type:      secrets.TrustdType,
+			ID:        optional.Some(secrets.TrustdID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *OSRootStatusController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: secrets.OSRootStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *OSRootStatusController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		root, err := safe.ReaderGetByID[*secrets.OSRoot](ctx, r, secrets.OSRootID)
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting OS root: %w", err)
+			}
+
+			if err = r.Destroy(ctx, secrets.NewOSRootStatus().Metadata()); err != nil && !state.IsNotFoundError(err) {
+				return fmt.Errorf("error destroying OS root status: %w", err)
+			}
+
+			continue
+		}
+
+		apiSecrets, err := safe.ReaderGetByID[*secrets.API](ctx, r, secrets.APIID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting API secrets: %w", err)
+		}
+
+		trustdSecrets, err := safe.ReaderGetByID[*secrets.Trustd](ctx, r, secrets.TrustdID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting trustd secrets: %w", err)
+		}
+
+		var presented osRootPresentedCerts
+
+		if apiSecrets != nil {
+			presented.apiServer = apiSecrets.TypedSpec().Server
+			presented.apiClient = apiSecrets.TypedSpec().Client
+		}
+
+		if trustdSecrets != nil {
+			presented.trustdServer = trustdSecrets.TypedSpec().Server
+		}
+
+		spec, err := osRootStatusSpec(root.TypedSpec(), presented)
+		if err != nil {
+			logger.Warn("failed to build OS root status", zap.Error(err))
+
+			continue
+		}
+
+		if err = safe.WriterModify(ctx, r, secrets.NewOSRootStatus(), func(res *secrets.OSRootStatus) error {
+			*res.TypedSpec() = spec
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating OS root status: %w", err)
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// osRootPresentedCerts are the certificates presented by apid and trustd, each of them might be missing.
+type osRootPresentedCerts struct {
+	apiServer    *x509.PEMEncodedCertificateAndKey
+	apiClient    *x509.PEMEncodedCertificateAndKey
+	trustdServer *x509.PEMEncodedCertificateAndKey
+}
+
+type osRootCA struct {
+	cert        *stdlibx509.Certificate
+	fingerprint string
+}
+
+// osRootStatusSpec builds the status of the OS root and the certificates issued by it.
+//
+//nolint:gocyclo
+func osRootStatusSpec(root *secrets.OSRootSpec, presented osRootPresentedCerts) (secrets.OSRootStatusSpec, error) {
+	var spec secrets.OSRootStatusSpec
+
+	cas := make([]osRootCA, 0, len(root.AcceptedCAs))
+
+	for _, acceptedCA := range root.AcceptedCAs {
+		cert, err := acceptedCA.GetCert()
+		if err != nil {
+			return spec, fmt.Errorf("failed to parse accepted CA: %w", err)
+		}
+
+		fingerprint, err := x509.SPKIFingerprintFromDER(cert.Raw)
+		if err != nil {
+			return spec, fmt.Errorf("failed to fingerprint accepted CA: %w", err)
+		}
+
+		cas = append(cas, osRootCA{cert: cert, fingerprint: fingerprint.String()})
+		spec.AcceptedCAs = append(spec.AcceptedCAs, fingerprint.String())
+	}
+
+	// the issuing CA is always the last accepted CA, on worker nodes it has no key
+	if len(cas) == 0 {
+		return spec, errors.New("no accepted CAs")
+	}
+
+	issuing := cas[len(cas)-1]
+	spec.IssuingCA = issuing.fingerprint
+
+	spec.Phase = secrets.OSRootPhaseStable
+
+	for _, ca := range cas[:len(cas)-1] {
+		if ca.fingerprint == issuing.fingerprint {
+			continue
+		}
+
+		if ca.cert.NotBefore.After(issuing.cert.NotBefore) {
+			spec.Phase = secrets.OSRootPhaseNewCAAccepted
+
+			break
+		}
+
+		spec.Phase = secrets.OSRootPhaseNewCAIssuing
+	}
+
+	issuedBy := func(pair *x509.PEMEncodedCertificateAndKey) string {
+		if pair == nil || len(pair.Crt) == 0 {
+			return ""
+		}
+
+		cert, err := parseCertificate(pair.Crt)
+		if err != nil {
+			return ""
+		}
+
+		for _, ca := range cas {
+			if cert.CheckSignatureFrom(ca.cert) == nil {
+				return ca.fingerprint
+			}
+		}
+
+		return ""
+	}
+
+	spec.APIServerCA = issuedBy(presented.apiServer)
+	spec.APIClientCA = issuedBy(presented.apiClient)
+	spec.TrustdServerCA = issuedBy(presented.trustdServer)
+
+	// worker nodes don't have the API client and trustd certificates
+	spec.Converged = spec.APIServerCA == spec.IssuingCA &&
+		(presented.apiClient == nil || spec.APIClientCA == spec.IssuingCA) &&
+		(presented.trustdServer == nil || spec.TrustdServerCA == spec.IssuingCA)
+
+	return spec, nil
+}
@@ -0,0 +1,114 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secrets_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/siderolabs/crypto/x509"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	secretsctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/secrets"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+func TestOSRootStatusSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, &OSRootStatusSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&secretsctrl.OSRootStatusController{}))
+			},
+		},
+	})
+}
+
+type OSRootStatusSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *OSRootStatusSuite) newCA(notBefore time.Time) (*x509.PEMEncodedCertificateAndKey, string) {
+	ca, err := x509.NewSelfSignedCertificateAuthority(
+		x509.Organization("talos"),
+		x509.NotBefore(notBefore),
+	)
+	suite.Require().NoError(err)
+
+	fingerprint, err := x509.SPKIFingerprintFromPEM(ca.CrtPEM)
+	suite.Require().NoError(err)
+
+	return &x509.PEMEncodedCertificateAndKey{Crt: ca.CrtPEM, Key: ca.KeyPEM}, fingerprint.String()
+}
+
+func (suite *OSRootStatusSuite) newServerCert(ca *x509.PEMEncodedCertificateAndKey) *x509.PEMEncodedCertificateAndKey {
+	authority, err := x509.NewCertificateAuthorityFromCertificateAndKey(ca)
+	suite.Require().NoError(err)
+
+	keyPair, err := x509.NewKeyPair(authority, x509.CommonName("foo.example.com"))
+	suite.Require().NoError(err)
+
+	return x509.NewCertificateAndKeyFromKeyPair(keyPair)
+}
+
+func (suite *OSRootStatusSuite) TestRotation() {
+	oldCA, oldFingerprint := suite.newCA(time.Now().Add(-time.Hour))
+	newCA, newFingerprint := suite.newCA(time.Now())
+
+	// new CA is accepted, old CA is still issuing
+	rootSecrets := secrets.NewOSRoot(secrets.OSRootID)
+	rootSecrets.TypedSpec().IssuingCA = oldCA
+	rootSecrets.TypedSpec().AcceptedCAs = []*x509.PEMEncodedCertificate{{Crt: newCA.Crt}, {Crt: oldCA.Crt}}
+	suite.Create(rootSecrets)
+
+	apiSecrets := secrets.NewAPI()
+	apiSecrets.TypedSpec().Server = suite.newServerCert(oldCA)
+	suite.Create(apiSecrets)
+
+	ctest.AssertResource(suite, secrets.OSRootStatusID, func(status *secrets.OSRootStatus, asrt *assert.Assertions) {
+		asrt.Equal(oldFingerprint, status.TypedSpec().IssuingCA)
+		asrt.Equal([]string{newFingerprint, oldFingerprint}, status.TypedSpec().AcceptedCAs)
+		asrt.Equal(oldFingerprint, status.TypedSpec().APIServerCA)
+		asrt.Equal(secrets.OSRootPhaseNewCAAccepted, status.TypedSpec().Phase)
+		asrt.True(status.TypedSpec().Converged)
+	})
+
+	// new CA is issuing, but the certificate is not re-issued yet
+	rootSecrets.TypedSpec().IssuingCA = newCA
+	rootSecrets.TypedSpec().AcceptedCAs = []*x509.PEMEncodedCertificate{{Crt: oldCA.Crt}, {Crt: newCA.Crt}}
+	suite.Update(rootSecrets)
+
+	ctest.AssertResource(suite, secrets.OSRootStatusID, func(status *secrets.OSRootStatus, asrt *assert.Assertions) {
+		asrt.Equal(newFingerprint, status.TypedSpec().IssuingCA)
+		asrt.Equal(oldFingerprint, status.TypedSpec().APIServerCA)
+		asrt.Equal(secrets.OSRootPhaseNewCAIssuing, status.TypedSpec().Phase)
+		asrt.False(status.TypedSpec().Converged)
+	})
+
+	apiSecrets.TypedSpec().Server = suite.newServerCert(newCA)
+	suite.Update(apiSecrets)
+
+	ctest.AssertResource(suite, secrets.OSRootStatusID, func(status *secrets.OSRootStatus, asrt *assert.Assertions) {
+		asrt.Equal(newFingerprint, status.TypedSpec().APIServerCA)
+		asrt.True(status.TypedSpec().Converged)
+	})
+
+	// old CA is retired
+	rootSecrets.TypedSpec().AcceptedCAs = []*x509.PEMEncodedCertificate{{Crt: newCA.Crt}}
+	suite.Update(rootSecrets)
+
+	ctest.AssertResource(suite, secrets.OSRootStatusID, func(status *secrets.OSRootStatus, asrt *assert.Assertions) {
+		asrt.Equal([]string{newFingerprint}, status.TypedSpec().AcceptedCAs)
+		asrt.Equal(secrets.OSRootPhaseStable, status.TypedSpec().Phase)
+		asrt.True(status.TypedSpec().Converged)
+	})
+
+	suite.Destroy(rootSecrets)
+
+	ctest.AssertNoResource[*secrets.OSRootStatus](suite, secrets.OSRootStatusID)
+}
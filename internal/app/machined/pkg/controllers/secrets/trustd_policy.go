@@ -0,0 +1,52 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secrets
+
+import (
+	"context"
+	"slices"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/controller/generic/transform"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+// TrustdPolicyController manages secrets.TrustdPolicy based on configuration.
+type TrustdPolicyController = transform.Controller[*config.MachineConfig, *secrets.TrustdPolicy]
+
+// NewTrustdPolicyController instanciates the controller.
+func NewTrustdPolicyController() *TrustdPolicyController {
+	return transform.NewController(
+		transform.Settings[*config.MachineConfig, *secrets.TrustdPolicy]{
+			Name:                    "secrets.TrustdPolicyController",
+			MapMetadataOptionalFunc: rootMapFunc(secrets.NewTrustdPolicy(), true),
+			TransformFunc: func(ctx context.Context, r controller.Reader, logger *zap.Logger, cfg *config.MachineConfig, res *secrets.TrustdPolicy) error {
+				policy := res.TypedSpec()
+
+				*policy = secrets.TrustdPolicySpec{}
+
+				policyConfig := cfg.Config().TrustdPolicyConfig()
+				if policyConfig == nil {
+					return nil
+				}
+
+				policy.RequestsPerMinute = policyConfig.RequestsPerMinute()
+				policy.RequestBurst = policyConfig.RequestBurst()
+
+				if subnets := policyConfig.AllowedSubnets(); len(subnets) > 0 {
+					policy.AllowedSubnets = subnets
+				}
+
+				policy.AllowedHostnames = slices.Clone(policyConfig.AllowedHostnames())
+				policy.MaxCertificateLifetime = policyConfig.MaxCertificateLifetime()
+
+				return nil
+			},
+		},
+	)
+}
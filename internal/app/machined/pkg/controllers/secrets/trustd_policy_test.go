@@ -0,0 +1,101 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secrets_test
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	secretsctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/secrets"
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate"
+	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/security"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+func TestTrustdPolicySuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, &TrustdPolicySuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 10 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(secretsctrl.NewTrustdPolicyController()))
+			},
+		},
+	})
+}
+
+type TrustdPolicySuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *TrustdPolicySuite) genConfig(machineType machine.Type, policy *security.TrustdPolicyConfigV1Alpha1) talosconfig.Provider {
+	input, err := generate.NewInput("test-cluster", "http://localhost:6443", "")
+	suite.Require().NoError(err)
+
+	cfg, err := input.Config(machineType)
+	suite.Require().NoError(err)
+
+	if policy == nil {
+		return cfg
+	}
+
+	cfg, err = container.New(append(cfg.Documents(), policy)...)
+	suite.Require().NoError(err)
+
+	return cfg
+}
+
+func (suite *TrustdPolicySuite) TestReconcile() {
+	machineConfig := config.NewMachineConfig(suite.genConfig(machine.TypeControlPlane, nil))
+	suite.Create(machineConfig)
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{secrets.TrustdPolicyID},
+		func(res *secrets.TrustdPolicy, asrt *assert.Assertions) {
+			asrt.Equal(secrets.TrustdPolicySpec{}, *res.TypedSpec())
+		},
+	)
+
+	policy := security.NewTrustdPolicyConfigV1Alpha1()
+	policy.PolicyRateLimit = &security.TrustdRateLimitConfig{
+		RateLimitRequestsPerMinute: 10,
+	}
+	policy.PolicyAllowedSubnets = []string{"10.5.0.0/16"}
+	policy.PolicyAllowedHostnames = []string{"worker-*"}
+	policy.PolicyMaxCertificateLifetime = 24 * time.Hour
+
+	newMachineConfig := config.NewMachineConfig(suite.genConfig(machine.TypeControlPlane, policy))
+	newMachineConfig.Metadata().SetVersion(machineConfig.Metadata().Version())
+	suite.Update(newMachineConfig)
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{secrets.TrustdPolicyID},
+		func(res *secrets.TrustdPolicy, asrt *assert.Assertions) {
+			asrt.Equal(secrets.TrustdPolicySpec{
+				RequestsPerMinute:      10,
+				RequestBurst:           10,
+				AllowedSubnets:         []netip.Prefix{netip.MustParsePrefix("10.5.0.0/16")},
+				AllowedHostnames:       []string{"worker-*"},
+				MaxCertificateLifetime: 24 * time.Hour,
+			}, *res.TypedSpec())
+		},
+	)
+}
+
+func (suite *TrustdPolicySuite) TestReconcileWorker() {
+	suite.Create(config.NewMachineConfig(suite.genConfig(machine.TypeWorker, security.NewTrustdPolicyConfigV1Alpha1())))
+
+	rtestutils.AssertNoResource[*secrets.TrustdPolicy](suite.Ctx(), suite.T(), suite.State(), secrets.TrustdPolicyID)
+}
@@ -7,6 +7,7 @@ package time
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sync"
 	stdtime "time"
 
@@ -15,11 +16,13 @@ import (
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/gen/xslices"
 	"go.uber.org/zap"
 
 	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
 	v1alpha1runtime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
 	"github.com/siderolabs/talos/internal/pkg/ntp"
+	configconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 	"github.com/siderolabs/talos/pkg/machinery/resources/time"
@@ -50,6 +53,10 @@ func (ctrl *SyncController) Outputs() []controller.Output {
 			Type: time.StatusType,
 			Kind: controller.OutputExclusive,
 		},
+		{
+			Type: time.SourceStatusType,
+			Kind: controller.OutputExclusive,
+		},
 	}
 }
 
@@ -58,7 +65,10 @@ type NTPSyncer interface {
 	Run(ctx context.Context)
 	Synced() <-chan struct{}
 	EpochChange() <-chan struct{}
+	StatusChange() <-chan struct{}
 	SetTimeServers([]string)
+	SetNTSServers([]ntp.NTSServer)
+	ServerStatuses() []ntp.ServerStatus
 }
 
 // NewNTPSyncerFunc function allows to replace ntp.Syncer with the mock.
@@ -102,9 +112,10 @@ func (ctrl *SyncController) Run(ctx context.Context, r controller.Runtime, logge
 		syncCtxCancel context.CancelFunc
 		syncWg        sync.WaitGroup
 
-		syncCh  <-chan struct{}
-		epochCh <-chan struct{}
-		syncer  NTPSyncer
+		syncCh   <-chan struct{}
+		epochCh  <-chan struct{}
+		statusCh <-chan struct{}
+		syncer   NTPSyncer
 
 		timeSynced bool
 		epoch      int
@@ -135,6 +146,7 @@ func (ctrl *SyncController) Run(ctx context.Context, r controller.Runtime, logge
 			timeSynced = true
 		case <-epochCh:
 			epoch++
+		case <-statusCh:
 		case <-timeSyncTimeoutCh:
 			timeSynced = true
 			timeSyncTimeoutTimer = nil
@@ -163,7 +175,10 @@ func (ctrl *SyncController) Run(ctx context.Context, r controller.Runtime, logge
 			}
 		}
 
-		var syncTimeout stdtime.Duration
+		var (
+			syncTimeout stdtime.Duration
+			ntsServers  []ntp.NTSServer
+		)
 
 		syncDisabled := false
 
@@ -177,6 +192,15 @@ func (ctrl *SyncController) Run(ctx context.Context, r controller.Runtime, logge
 			}
 
 			syncTimeout = cfg.Config().Machine().Time().BootTimeout()
+
+			ntsServers = xslices.Map(cfg.Config().Machine().Time().NTSServers(), func(server configconfig.NTSServer) ntp.NTSServer {
+				return ntp.NTSServer{
+					Server:        server.Server(),
+					CACertificate: server.CACertificate(),
+				}
+			})
+
+			timeServers = withNTSServers(timeServers, ntsServers, cfg.Config().Machine().Time().NTSAllowPlainFallback())
 		}
 
 		if !timeSynced {
@@ -212,11 +236,17 @@ func (ctrl *SyncController) Run(ctx context.Context, r controller.Runtime, logge
 			syncer = nil
 			syncCh = nil
 			epochCh = nil
+			statusCh = nil
 		case !syncDisabled && syncer == nil:
 			// start syncing
 			syncer = ctrl.NewNTPSyncer(logger, timeServers)
+
+			// NTS servers should be set before the sync starts, so that they are never queried without NTS
+			syncer.SetNTSServers(ntsServers)
+
 			syncCh = syncer.Synced()
 			epochCh = syncer.EpochChange()
+			statusCh = syncer.StatusChange()
 
 			timeSynced = false
 
@@ -231,19 +261,29 @@ func (ctrl *SyncController) Run(ctx context.Context, r controller.Runtime, logge
 			}()
 		}
 
+		var serverStatuses []ntp.ServerStatus
+
 		if syncer != nil {
+			syncer.SetNTSServers(ntsServers)
 			syncer.SetTimeServers(timeServers)
+
+			serverStatuses = syncer.ServerStatuses()
 		}
 
 		if syncDisabled {
 			timeSynced = true
 		}
 
+		r.StartTrackingOutputs()
+
 		if err = safe.WriterModify(ctx, r, time.NewStatus(), func(r *time.Status) error {
 			*r.TypedSpec() = time.StatusSpec{
 				Epoch:        epoch,
 				Synced:       timeSynced,
 				SyncDisabled: syncDisabled,
+				Authenticated: slices.ContainsFunc(serverStatuses, func(status ntp.ServerStatus) bool {
+					return status.Selected && status.Authenticated
+				}),
 			}
 
 			return nil
@@ -251,6 +291,58 @@ func (ctrl *SyncController) Run(ctx context.Context, r controller.Runtime, logge
 			return fmt.Errorf("error updating objects: %w", err) //nolint:govet
 		}
 
+		if syncer != nil {
+			for priority, serverStatus := range serverStatuses {
+				if err = safe.WriterModify(ctx, r, time.NewSourceStatus(serverStatus.Server), func(r *time.SourceStatus) error {
+					*r.TypedSpec() = time.SourceStatusSpec{
+						Server:    serverStatus.Server,
+						Address:   serverStatus.Address,
+						Priority:  priority,
+						Reachable: serverStatus.Reachable,
+						Selected:  serverStatus.Selected,
+						Stratum:   serverStatus.Stratum,
+						Offset:    serverStatus.Offset,
+						RTT:       serverStatus.RTT,
+						LastQuery: serverStatus.LastQuery,
+						LastError: serverStatus.LastError,
+
+						Authenticated: serverStatus.Authenticated,
+					}
+
+					return nil
+				}); err != nil {
+					return fmt.Errorf("error updating time source status: %w", err)
+				}
+			}
+		}
+
+		if err = safe.CleanupOutputs[*time.SourceStatus](ctx, r); err != nil {
+			return fmt.Errorf("error cleaning up time source statuses: %w", err)
+		}
+
 		r.ResetRestartBackoff()
 	}
 }
+
+// withNTSServers puts NTS servers first in the list of time servers.
+//
+// Plain time servers are kept as a fallback only if it is allowed.
+func withNTSServers(timeServers []string, ntsServers []ntp.NTSServer, allowPlainFallback bool) []string {
+	if len(ntsServers) == 0 {
+		return timeServers
+	}
+
+	result := xslices.Map(ntsServers, func(server ntp.NTSServer) string { return server.Server })
+
+	if !allowPlainFallback {
+		return result
+	}
+
+	for _, server := range timeServers {
+		if !slices.Contains(result, server) {
+			result = append(result, server)
+		}
+	}
+
+	return result
+}
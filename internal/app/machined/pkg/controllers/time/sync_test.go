@@ -18,6 +18,7 @@ import (
 	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
 	"github.com/siderolabs/go-pointer"
 	"github.com/siderolabs/go-retry/retry"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zaptest"
@@ -25,6 +26,7 @@ import (
 	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
 	timectrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/time"
 	v1alpha1runtime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/pkg/ntp"
 	"github.com/siderolabs/talos/pkg/machinery/config/container"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
@@ -450,6 +452,200 @@ func (suite *SyncSuite) TestReconcileSyncBootTimeout() {
 	)
 }
 
+func (suite *SyncSuite) TestReconcileSourceStatus() {
+	suite.Require().NoError(
+		suite.runtime.RegisterController(
+			&timectrl.SyncController{
+				V1Alpha1Mode: v1alpha1runtime.ModeMetal,
+				NewNTPSyncer: suite.newMockSyncer,
+			},
+		),
+	)
+
+	suite.startRuntime()
+
+	timeServers := network.NewTimeServerStatus(network.NamespaceName, network.TimeServerID)
+	timeServers.TypedSpec().NTPServers = []string{"127.0.0.1", "127.0.0.2"}
+	suite.Require().NoError(suite.state.Create(suite.ctx, timeServers))
+
+	cfg := config.NewMachineConfig(
+		container.NewV1Alpha1(
+			&v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{},
+				ClusterConfig: &v1alpha1.ClusterConfig{},
+			},
+		),
+	)
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, cfg))
+
+	var mockSyncer *mockSyncer
+
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				mockSyncer = suite.getMockSyncer()
+
+				if mockSyncer == nil {
+					return retry.ExpectedErrorf("syncer not created yet")
+				}
+
+				return nil
+			},
+		),
+	)
+
+	mockSyncer.setServerStatuses([]ntp.ServerStatus{
+		{
+			Server:    "127.0.0.1",
+			LastError: "no response",
+		},
+		{
+			Server:    "127.0.0.2",
+			Address:   "127.0.0.2",
+			Reachable: true,
+			Selected:  true,
+			Stratum:   2,
+			Offset:    time.Millisecond,
+		},
+	})
+
+	ctest.AssertResources(suite, []resource.ID{"127.0.0.1", "127.0.0.2"}, func(r *timeresource.SourceStatus, asrt *assert.Assertions) {
+		switch r.Metadata().ID() {
+		case "127.0.0.1":
+			asrt.Equal(0, r.TypedSpec().Priority)
+			asrt.False(r.TypedSpec().Reachable)
+			asrt.False(r.TypedSpec().Selected)
+			asrt.Equal("no response", r.TypedSpec().LastError)
+		case "127.0.0.2":
+			asrt.Equal(1, r.TypedSpec().Priority)
+			asrt.True(r.TypedSpec().Reachable)
+			asrt.True(r.TypedSpec().Selected)
+			asrt.EqualValues(2, r.TypedSpec().Stratum)
+			asrt.Equal(time.Millisecond, r.TypedSpec().Offset)
+		}
+	})
+
+	mockSyncer.setServerStatuses([]ntp.ServerStatus{
+		{
+			Server: "127.0.0.2",
+		},
+	})
+
+	ctest.AssertNoResource[*timeresource.SourceStatus](suite, "127.0.0.1")
+	ctest.AssertResource(suite, "127.0.0.2", func(r *timeresource.SourceStatus, asrt *assert.Assertions) {
+		asrt.Equal(0, r.TypedSpec().Priority)
+		asrt.False(r.TypedSpec().Selected)
+	})
+}
+
+func (suite *SyncSuite) TestReconcileNTS() {
+	suite.Require().NoError(
+		suite.runtime.RegisterController(
+			&timectrl.SyncController{
+				V1Alpha1Mode: v1alpha1runtime.ModeMetal,
+				NewNTPSyncer: suite.newMockSyncer,
+			},
+		),
+	)
+
+	suite.startRuntime()
+
+	timeServers := network.NewTimeServerStatus(network.NamespaceName, network.TimeServerID)
+	timeServers.TypedSpec().NTPServers = []string{"127.0.0.1", "nts.example.com"}
+	suite.Require().NoError(suite.state.Create(suite.ctx, timeServers))
+
+	cfg := config.NewMachineConfig(
+		container.NewV1Alpha1(
+			&v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineTime: &v1alpha1.TimeConfig{
+						TimeNTS: &v1alpha1.TimeNTSConfig{
+							NTSServers: []v1alpha1.TimeNTSServer{
+								{
+									NTSServer: "nts.example.com",
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{},
+			},
+		),
+	)
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, cfg))
+
+	var mockSyncer *mockSyncer
+
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				mockSyncer = suite.getMockSyncer()
+
+				if mockSyncer == nil {
+					return retry.ExpectedErrorf("syncer not created yet")
+				}
+
+				return nil
+			},
+		),
+	)
+
+	// plain time servers are not used without fallback
+	suite.Assert().Equal([]string{"nts.example.com"}, mockSyncer.getTimeServers())
+	suite.Assert().Equal([]ntp.NTSServer{{Server: "nts.example.com"}}, mockSyncer.getNTSServers())
+
+	mockSyncer.setServerStatuses([]ntp.ServerStatus{
+		{
+			Server:        "nts.example.com",
+			Reachable:     true,
+			Selected:      true,
+			Authenticated: true,
+		},
+	})
+
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertTimeStatus(
+					timeresource.StatusSpec{
+						Synced:        false,
+						Epoch:         0,
+						SyncDisabled:  false,
+						Authenticated: true,
+					},
+				)
+			},
+		),
+	)
+
+	ctest.AssertResource(suite, "nts.example.com", func(r *timeresource.SourceStatus, asrt *assert.Assertions) {
+		asrt.True(r.TypedSpec().Authenticated)
+	})
+
+	// allow fallback to the plain servers
+	ctest.UpdateWithConflicts(suite, cfg, func(r *config.MachineConfig) error {
+		r.Container().RawV1Alpha1().MachineConfig.MachineTime.TimeNTS.NTSAllowPlainFallback = pointer.To(true)
+
+		return nil
+	})
+
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				if !slices.Equal(mockSyncer.getTimeServers(), []string{"nts.example.com", "127.0.0.1"}) {
+					return retry.ExpectedErrorf("time servers not updated yet")
+				}
+
+				return nil
+			},
+		),
+	)
+}
+
 func (suite *SyncSuite) TearDownTest() {
 	suite.T().Log("tear down")
 
@@ -482,8 +678,11 @@ type mockSyncer struct {
 	mu sync.Mutex
 
 	timeServers []string
+	ntsServers  []ntp.NTSServer
+	statuses    []ntp.ServerStatus
 	syncedCh    chan struct{}
 	epochCh     chan struct{}
+	statusCh    chan struct{}
 }
 
 func (mock *mockSyncer) Run(ctx context.Context) {
@@ -498,6 +697,25 @@ func (mock *mockSyncer) EpochChange() <-chan struct{} {
 	return mock.epochCh
 }
 
+func (mock *mockSyncer) StatusChange() <-chan struct{} {
+	return mock.statusCh
+}
+
+func (mock *mockSyncer) ServerStatuses() []ntp.ServerStatus {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	return slices.Clone(mock.statuses)
+}
+
+func (mock *mockSyncer) setServerStatuses(statuses []ntp.ServerStatus) {
+	mock.mu.Lock()
+	mock.statuses = slices.Clone(statuses)
+	mock.mu.Unlock()
+
+	mock.statusCh <- struct{}{}
+}
+
 func (mock *mockSyncer) getTimeServers() (servers []string) {
 	mock.mu.Lock()
 	defer mock.mu.Unlock()
@@ -512,10 +730,25 @@ func (mock *mockSyncer) SetTimeServers(servers []string) {
 	mock.timeServers = slices.Clone(servers)
 }
 
+func (mock *mockSyncer) getNTSServers() []ntp.NTSServer {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	return slices.Clone(mock.ntsServers)
+}
+
+func (mock *mockSyncer) SetNTSServers(servers []ntp.NTSServer) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	mock.ntsServers = slices.Clone(servers)
+}
+
 func newMockSyncer(_ *zap.Logger, servers []string) *mockSyncer {
 	return &mockSyncer{
 		timeServers: slices.Clone(servers),
 		syncedCh:    make(chan struct{}, 1),
 		epochCh:     make(chan struct{}, 1),
+		statusCh:    make(chan struct{}, 1),
 	}
 }
@@ -10,6 +10,8 @@ import (
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/pkg/resourcehistory"
 )
 
 // TaskSetupFunc defines the function that a task will execute for a specific runtime
@@ -63,4 +65,5 @@ type V1Alpha2Controller interface {
 	Run(context.Context, *Drainer) error
 	DependencyGraph() (*controller.DependencyGraph, error)
 	MakeLogger(serviceName string) (*zap.Logger, error)
+	ResourceHistory() *resourcehistory.Recorder
 }
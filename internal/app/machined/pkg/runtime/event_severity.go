@@ -0,0 +1,92 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"fmt"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	runtimeres "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// EventSeverity is the severity of the event, it is used to filter the events persisted across reboots.
+type EventSeverity int
+
+// Event severities.
+const (
+	EventSeverityDebug EventSeverity = iota
+	EventSeverityInfo
+	EventSeverityWarning
+	EventSeverityError
+)
+
+// String implements fmt.Stringer interface.
+func (severity EventSeverity) String() string {
+	switch severity {
+	case EventSeverityDebug:
+		return "debug"
+	case EventSeverityInfo:
+		return "info"
+	case EventSeverityWarning:
+		return "warning"
+	case EventSeverityError:
+		return "error"
+	default:
+		return fmt.Sprintf("severity(%d)", int(severity))
+	}
+}
+
+// ParseEventSeverity parses the event severity from the string.
+func ParseEventSeverity(s string) (EventSeverity, error) {
+	for severity := EventSeverityDebug; severity <= EventSeverityError; severity++ {
+		if severity.String() == s {
+			return severity, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown event severity %q", s)
+}
+
+// Severity returns the severity of the event.
+//
+// Sequence progress and address changes are debug events, failures are errors,
+// events which precede the reboot or indicate a degraded machine are warnings.
+//
+//nolint:gocyclo
+func (event *Event) Severity() EventSeverity {
+	switch msg := event.Payload.(type) {
+	case *machine.TaskEvent, *machine.PhaseEvent, *machine.AddressEvent:
+		return EventSeverityDebug
+	case *machine.SequenceEvent:
+		if msg.GetError() != nil {
+			return EventSeverityError
+		}
+	case *machine.ServiceStateEvent:
+		if msg.GetAction() == machine.ServiceStateEvent_FAILED {
+			return EventSeverityError
+		}
+	case *machine.UpgradeEvent:
+		if msg.GetOutcome() != "" && msg.GetOutcome() != runtimeres.UpgradeOutcomeSucceeded {
+			return EventSeverityError
+		}
+	case *machine.DiskKeyRotationEvent:
+		if msg.GetError() != "" {
+			return EventSeverityError
+		}
+	case *machine.CertificateSigningEvent:
+		if msg.GetDecision() != constants.CertificateSigningDecisionSigned {
+			return EventSeverityWarning
+		}
+	case *machine.ConfigLoadErrorEvent, *machine.ConfigValidationErrorEvent, *machine.EtcdAlarmEvent, *machine.StaticPodRestartStormEvent:
+		return EventSeverityError
+	case *machine.RestartEvent, *machine.KubeletShutdownEvent, *machine.ShutdownImminentEvent,
+		*machine.CertificateExpiryEvent, *machine.EtcdLearnerStuckEvent, *machine.ZombieProcessesEvent, *machine.APIAuthorizationDeniedEvent,
+		*machine.SecretsBundleExportEvent, *machine.IdentityConflictEvent, *machine.CertificateRevokedEvent:
+		return EventSeverityWarning
+	}
+
+	return EventSeverityInfo
+}
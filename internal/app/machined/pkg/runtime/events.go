@@ -8,9 +8,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/rs/xid"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
 	"google.golang.org/protobuf/types/known/anypb"
 
 	"github.com/siderolabs/talos/pkg/machinery/api/machine"
@@ -26,6 +29,7 @@ type Event struct {
 	ID      xid.ID
 	Payload proto.Message
 	ActorID string
+	BootID  string
 }
 
 // EventInfo unifies event and queue information for the WatchFunc.
@@ -52,6 +56,12 @@ type WatchOptions struct {
 	TailDuration time.Duration
 	// ActorID to ID of the actor to filter events by.
 	ActorID string
+	// Replay the persisted events of the previous boots starting with the boot SinceBoot relative to the current one.
+	//
+	// SinceBoot should be negative, the current boot events are returned after the persisted ones.
+	SinceBoot int
+	// Replay all persisted events of the previous boots.
+	ReplayPersisted bool
 }
 
 // WatchOptionFunc defines the options for the watcher.
@@ -98,6 +108,38 @@ func WithTailDuration(dur time.Duration) WatchOptionFunc {
 	}
 }
 
+// WithSinceBoot sets up Watcher to replay the persisted events starting with the boot relative to the current one.
+//
+// For example, -1 replays the events of the previous boot followed by the events of the current boot.
+func WithSinceBoot(boot int) WatchOptionFunc {
+	return func(opts *WatchOptions) error {
+		if boot >= 0 {
+			return errors.New("WithSinceBoot expects a negative boot number")
+		}
+
+		if opts.TailEvents != 0 || !opts.TailID.IsNil() || opts.TailDuration != 0 {
+			return errors.New("WithSinceBoot can't be specified at the same time with WithTailEvents, WithTailID or WithTailDuration")
+		}
+
+		opts.SinceBoot = boot
+
+		return nil
+	}
+}
+
+// WithReplayPersisted sets up Watcher to replay all persisted events of the previous boots followed by the events of the current boot.
+func WithReplayPersisted() WatchOptionFunc {
+	return func(opts *WatchOptions) error {
+		if opts.TailEvents != 0 || !opts.TailID.IsNil() || opts.TailDuration != 0 {
+			return errors.New("WithReplayPersisted can't be specified at the same time with WithTailEvents, WithTailID or WithTailDuration")
+		}
+
+		opts.ReplayPersisted = true
+
+		return nil
+	}
+}
+
 // WithActorID sets up Watcher to return events filtered by given actor id.
 func WithActorID(actorID string) WatchOptionFunc {
 	return func(opts *WatchOptions) error {
@@ -117,10 +159,19 @@ type Publisher interface {
 	Publish(context.Context, proto.Message)
 }
 
+// EventHistory defines the history of the events persisted during the previous boots.
+type EventHistory interface {
+	// BootID returns the boot ID the published events are tagged with.
+	BootID() string
+	// LoadHistory replaces the events of the previous boots available for the replay.
+	LoadHistory([]Event)
+}
+
 // EventStream defines the runtime event stream.
 type EventStream interface {
 	Watcher
 	Publisher
+	EventHistory
 }
 
 // NewEvent creates a new event with the provided payload and actor ID.
@@ -155,5 +206,37 @@ func (event *Event) ToMachineEvent() (*machine.Event, error) {
 		},
 		Id:      event.ID.String(),
 		ActorId: event.ActorID,
+		BootId:  event.BootID,
+	}, nil
+}
+
+// EventFromMachineEvent deserializes Event from the proto message machine.Event.
+//
+// The payload type is resolved from the global proto registry.
+func EventFromMachineEvent(msg *machine.Event) (Event, error) {
+	id, err := xid.FromString(msg.GetId())
+	if err != nil {
+		return Event{}, fmt.Errorf("error parsing event ID: %w", err)
+	}
+
+	typeURL := msg.GetData().GetTypeUrl()
+
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(strings.TrimPrefix(typeURL, "talos/runtime/")))
+	if err != nil {
+		return Event{}, fmt.Errorf("error resolving event type %q: %w", typeURL, err)
+	}
+
+	payload := mt.New().Interface()
+
+	if err = proto.Unmarshal(msg.GetData().GetValue(), payload); err != nil {
+		return Event{}, fmt.Errorf("error unmarshaling event %q: %w", typeURL, err)
+	}
+
+	return Event{
+		TypeURL: typeURL,
+		ID:      id,
+		Payload: payload,
+		ActorID: msg.GetActorId(),
+		BootID:  msg.GetBootId(),
 	}, nil
 }
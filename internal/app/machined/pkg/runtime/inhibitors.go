@@ -0,0 +1,143 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/google/uuid"
+
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	runtimeres "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// ErrShutdownInhibitorLimit indicates that the limit of the shutdown inhibitors is reached.
+var ErrShutdownInhibitorLimit = errors.New("shutdown inhibitor limit reached")
+
+// shutdownInhibitorsMu serializes the limit checks of the concurrent acquire calls.
+var shutdownInhibitorsMu sync.Mutex
+
+// AcquireShutdownInhibitor registers a shutdown inhibitor held by the client.
+//
+// The maximum hold time is capped at constants.ShutdownInhibitorMaxHold, the number of inhibitors
+// is limited both per client and in total.
+// The per client limit is keyed on the owner (the authenticated client identity), as who is supplied by the client.
+func AcquireShutdownInhibitor(ctx context.Context, st state.State, owner, who, why string, maxHold time.Duration) (*runtimeres.ShutdownInhibitor, error) {
+	if maxHold <= 0 || maxHold > constants.ShutdownInhibitorMaxHold {
+		maxHold = constants.ShutdownInhibitorMaxHold
+	}
+
+	shutdownInhibitorsMu.Lock()
+	defer shutdownInhibitorsMu.Unlock()
+
+	// expired inhibitors don't count towards the limits
+	if _, err := RemoveExpiredShutdownInhibitors(ctx, st); err != nil {
+		return nil, err
+	}
+
+	inhibitors, err := safe.StateListAll[*runtimeres.ShutdownInhibitor](ctx, st)
+	if err != nil {
+		return nil, fmt.Errorf("error listing shutdown inhibitors: %w", err)
+	}
+
+	if inhibitors.Len() >= constants.ShutdownInhibitorsMax {
+		return nil, fmt.Errorf("%w: %d inhibitors are registered", ErrShutdownInhibitorLimit, inhibitors.Len())
+	}
+
+	var held int
+
+	for inhibitor := range inhibitors.All() {
+		if inhibitor.TypedSpec().Owner == owner {
+			held++
+		}
+	}
+
+	if held >= constants.ShutdownInhibitorsPerClient {
+		return nil, fmt.Errorf("%w: %q holds %d inhibitors", ErrShutdownInhibitorLimit, owner, held)
+	}
+
+	inhibitor := runtimeres.NewShutdownInhibitor(uuid.New().String())
+	inhibitor.TypedSpec().Who = who
+	inhibitor.TypedSpec().Why = why
+	inhibitor.TypedSpec().MaxHold = maxHold
+	inhibitor.TypedSpec().Acquired = time.Now()
+	inhibitor.TypedSpec().Owner = owner
+
+	if err = st.Create(ctx, inhibitor); err != nil {
+		return nil, fmt.Errorf("error creating shutdown inhibitor: %w", err)
+	}
+
+	return inhibitor, nil
+}
+
+// RemoveExpiredShutdownInhibitors removes the inhibitors held for longer than their maximum hold time.
+//
+// The IDs of the removed inhibitors are returned.
+func RemoveExpiredShutdownInhibitors(ctx context.Context, st state.State) ([]string, error) {
+	inhibitors, err := safe.StateListAll[*runtimeres.ShutdownInhibitor](ctx, st)
+	if err != nil {
+		return nil, fmt.Errorf("error listing shutdown inhibitors: %w", err)
+	}
+
+	var expired []string
+
+	now := time.Now()
+
+	for inhibitor := range inhibitors.All() {
+		if !inhibitor.TypedSpec().Expired(now) {
+			continue
+		}
+
+		if err = st.Destroy(ctx, inhibitor.Metadata()); err != nil && !state.IsNotFoundError(err) {
+			return nil, fmt.Errorf("error removing expired shutdown inhibitor %s: %w", inhibitor.Metadata().ID(), err)
+		}
+
+		expired = append(expired, inhibitor.Metadata().ID())
+	}
+
+	return expired, nil
+}
+
+// WaitForShutdownInhibitors waits for the shutdown inhibitors to be released.
+//
+// Each inhibitor holds the shutdown at most for its maximum hold time counted from its acquisition,
+// the inhibitors which expire are removed.
+// The IDs of the inhibitors which were not released in time are returned.
+func WaitForShutdownInhibitors(ctx context.Context, st state.State, pollInterval time.Duration) ([]string, error) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	var expired []string
+
+	for {
+		removed, err := RemoveExpiredShutdownInhibitors(ctx, st)
+		if err != nil {
+			return nil, err
+		}
+
+		expired = append(expired, removed...)
+
+		inhibitors, err := safe.StateListAll[*runtimeres.ShutdownInhibitor](ctx, st)
+		if err != nil {
+			return nil, fmt.Errorf("error listing shutdown inhibitors: %w", err)
+		}
+
+		if inhibitors.Len() == 0 {
+			return expired, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
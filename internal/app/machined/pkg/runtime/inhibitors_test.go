@@ -0,0 +1,130 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	runtimeres "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+func TestAcquireShutdownInhibitorLimits(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	inhibitor, err := runtime.AcquireShutdownInhibitor(ctx, st, "O=os:admin", "backup", "snapshot in progress", time.Hour)
+	require.NoError(t, err)
+
+	assert.Equal(t, "backup", inhibitor.TypedSpec().Who)
+	assert.Equal(t, "O=os:admin", inhibitor.TypedSpec().Owner)
+	assert.Equal(t, constants.ShutdownInhibitorMaxHold, inhibitor.TypedSpec().MaxHold)
+
+	for range constants.ShutdownInhibitorsPerClient - 1 {
+		_, err = runtime.AcquireShutdownInhibitor(ctx, st, "O=os:admin", "backup", "", time.Minute)
+		require.NoError(t, err)
+	}
+
+	_, err = runtime.AcquireShutdownInhibitor(ctx, st, "O=os:admin", "backup", "", time.Minute)
+	require.ErrorIs(t, err, runtime.ErrShutdownInhibitorLimit)
+
+	// the limit is keyed on the client identity, not on the client-supplied holder name
+	_, err = runtime.AcquireShutdownInhibitor(ctx, st, "O=os:admin", "database", "", time.Minute)
+	require.ErrorIs(t, err, runtime.ErrShutdownInhibitorLimit)
+
+	_, err = runtime.AcquireShutdownInhibitor(ctx, st, "O=os:operator", "backup", "", time.Minute)
+	require.NoError(t, err)
+}
+
+func TestWaitForShutdownInhibitors(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+	defer cancel()
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	expired, err := runtime.WaitForShutdownInhibitors(ctx, st, 10*time.Millisecond)
+	require.NoError(t, err)
+	assert.Empty(t, expired)
+
+	released, err := runtime.AcquireShutdownInhibitor(ctx, st, "O=os:admin", "backup", "", time.Minute)
+	require.NoError(t, err)
+
+	stuck, err := runtime.AcquireShutdownInhibitor(ctx, st, "O=os:operator", "database", "", 100*time.Millisecond)
+	require.NoError(t, err)
+
+	go func() {
+		time.Sleep(200 * time.Millisecond)
+
+		assert.NoError(t, st.Destroy(ctx, released.Metadata()))
+	}()
+
+	start := time.Now()
+
+	expired, err = runtime.WaitForShutdownInhibitors(ctx, st, 10*time.Millisecond)
+	require.NoError(t, err)
+
+	assert.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+	assert.Equal(t, []string{stuck.Metadata().ID()}, expired)
+
+	// expired inhibitor is removed
+	_, err = st.Get(ctx, stuck.Metadata())
+	assert.True(t, state.IsNotFoundError(err))
+}
+
+func TestShutdownInhibitorExpiry(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+	defer cancel()
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	for range constants.ShutdownInhibitorsPerClient {
+		_, err := runtime.AcquireShutdownInhibitor(ctx, st, "O=os:admin", "backup", "", 50*time.Millisecond)
+		require.NoError(t, err)
+	}
+
+	_, err := runtime.AcquireShutdownInhibitor(ctx, st, "O=os:admin", "backup", "", time.Minute)
+	require.ErrorIs(t, err, runtime.ErrShutdownInhibitorLimit)
+
+	time.Sleep(100 * time.Millisecond)
+
+	// the maximum hold time is counted from the acquisition, so the wait doesn't block
+	start := time.Now()
+
+	expired, err := runtime.WaitForShutdownInhibitors(ctx, st, time.Second)
+	require.NoError(t, err)
+
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Len(t, expired, constants.ShutdownInhibitorsPerClient)
+
+	for range constants.ShutdownInhibitorsPerClient {
+		_, err = runtime.AcquireShutdownInhibitor(ctx, st, "O=os:admin", "backup", "", 50*time.Millisecond)
+		require.NoError(t, err)
+	}
+
+	time.Sleep(100 * time.Millisecond)
+
+	// expired inhibitors don't count towards the limit
+	_, err = runtime.AcquireShutdownInhibitor(ctx, st, "O=os:admin", "backup", "", time.Minute)
+	require.NoError(t, err)
+
+	inhibitors, err := st.List(ctx, runtimeres.NewShutdownInhibitor("").Metadata())
+	require.NoError(t, err)
+	assert.Len(t, inhibitors.Items, 1)
+}
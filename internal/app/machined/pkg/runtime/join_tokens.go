@@ -0,0 +1,61 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/siderolabs/talos/pkg/machinery/meta"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+// JoinTokens are the join tokens created via the API by name.
+type JoinTokens map[string]secrets.JoinTokenSpec
+
+// ParseJoinTokens parses the join tokens as stored in META.
+func ParseJoinTokens(val []byte) (JoinTokens, error) {
+	tokens := JoinTokens{}
+
+	if len(val) == 0 {
+		return tokens, nil
+	}
+
+	if err := json.Unmarshal(val, &tokens); err != nil {
+		return nil, fmt.Errorf("error unmarshaling join tokens: %w", err)
+	}
+
+	return tokens, nil
+}
+
+// ReadJoinTokens reads the join tokens from META.
+func ReadJoinTokens(m Meta) (JoinTokens, error) {
+	val, _ := m.ReadTagBytes(meta.JoinTokens)
+
+	return ParseJoinTokens(val)
+}
+
+// WriteJoinTokens stores the join tokens in META and flushes META to the disk.
+func WriteJoinTokens(ctx context.Context, m Meta, tokens JoinTokens) error {
+	if len(tokens) == 0 {
+		if _, err := m.DeleteTag(ctx, meta.JoinTokens); err != nil {
+			return fmt.Errorf("error deleting join tokens: %w", err)
+		}
+
+		return m.Flush()
+	}
+
+	val, err := json.Marshal(tokens)
+	if err != nil {
+		return fmt.Errorf("error marshaling join tokens: %w", err)
+	}
+
+	if _, err = m.SetTagBytes(ctx, meta.JoinTokens, val); err != nil {
+		return fmt.Errorf("error setting join tokens: %w", err)
+	}
+
+	return m.Flush()
+}
@@ -0,0 +1,501 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// DropPolicy defines which events are dropped when the buffer is full.
+type DropPolicy int
+
+// Drop policies.
+const (
+	// DropOldest drops the oldest buffered events to make room for the new ones.
+	DropOldest DropPolicy = iota
+	// DropNewest drops the incoming events while the buffer is full.
+	DropNewest
+)
+
+const (
+	// eventOverhead is the approximate size of the log event structure in memory.
+	eventOverhead = 64
+
+	// defaultSendTimeout limits the duration of a single delivery attempt by default.
+	defaultSendTimeout = 5 * time.Second
+)
+
+// BufferOptions configures the buffered log sender.
+type BufferOptions struct {
+	// MemorySize is the maximum size of the events buffered in memory.
+	MemorySize int64
+	// SpoolDir enables the on-disk spool for the events which don't fit into the memory buffer.
+	//
+	// The contents of the directory are removed.
+	SpoolDir string
+	// SpoolSize is the maximum size of the on-disk spool.
+	SpoolSize int64
+	// DropPolicy defines which events are dropped when the memory buffer (or the spool) is full.
+	DropPolicy DropPolicy
+	// RetryMinBackoff and RetryMaxBackoff define the exponential backoff between the delivery attempts.
+	RetryMinBackoff time.Duration
+	RetryMaxBackoff time.Duration
+	// SendTimeout limits the duration of a single delivery attempt.
+	SendTimeout time.Duration
+}
+
+// DefaultBufferOptions returns the default buffering options.
+//
+// The events are buffered only in memory, and the oldest events are dropped once the buffer is full.
+func DefaultBufferOptions() BufferOptions {
+	return BufferOptions{
+		MemorySize:      constants.KmsgLogDefaultBufferSize,
+		DropPolicy:      DropOldest,
+		RetryMinBackoff: constants.KmsgLogDefaultRetryMinBackoff,
+		RetryMaxBackoff: constants.KmsgLogDefaultRetryMaxBackoff,
+		SendTimeout:     defaultSendTimeout,
+	}
+}
+
+// BufferStats contains the delivery counters of the buffered log sender.
+//
+// Sizes are approximate, they are based on the size of the message and the number of fields of the event.
+type BufferStats struct {
+	// SentBytes is the total size of the delivered events.
+	SentBytes uint64
+	// DroppedBytes is the total size of the events dropped because of the buffer overflow or delivery errors.
+	DroppedBytes uint64
+	// SpooledBytes is the total size of the events written to the on-disk spool.
+	SpooledBytes uint64
+	// BufferedBytes is the current size of the events buffered in memory.
+	BufferedBytes uint64
+	// SpoolBytes is the current size of the on-disk spool.
+	SpoolBytes uint64
+	// LastError is the last delivery error.
+	LastError string
+}
+
+type bufferedEvent struct {
+	event *runtime.LogEvent
+	size  int64
+}
+
+func eventSize(e *runtime.LogEvent) int64 {
+	size := int64(eventOverhead + len(e.Msg))
+
+	for k := range e.Fields {
+		size += int64(len(k)) + 16
+	}
+
+	return size
+}
+
+// BufferedSender wraps a LogSender with a bounded buffer and the delivery retries.
+//
+// Send never blocks on a slow or unavailable destination: the events are queued in memory,
+// spilled to the on-disk spool (if enabled) when the memory buffer is half full,
+// and dropped according to the drop policy once the buffer is full.
+type BufferedSender struct {
+	sender runtime.LogSender
+
+	mu          sync.Mutex
+	opts        BufferOptions
+	memory      []bufferedEvent
+	memoryBytes int64
+	// inflightBytes is the size of the event being delivered
+	inflightBytes int64
+	stats         BufferStats
+	closed        bool
+
+	// spool and spoolErrDir are accessed only by the delivery goroutine
+	spool       *spool
+	spoolErrDir string
+
+	notify    chan struct{}
+	done      chan struct{}
+	ctx       context.Context //nolint:containedctx
+	ctxCancel context.CancelFunc
+}
+
+// Check interfaces.
+var _ runtime.LogSender = &BufferedSender{}
+
+// NewBuffered returns log sender which buffers the events and delivers them with the specified sender in the background.
+func NewBuffered(sender runtime.LogSender, opts BufferOptions) *BufferedSender {
+	ctx, ctxCancel := context.WithCancel(context.Background())
+
+	s := &BufferedSender{
+		sender: sender,
+		opts:   opts,
+
+		notify:    make(chan struct{}, 1),
+		done:      make(chan struct{}),
+		ctx:       ctx,
+		ctxCancel: ctxCancel,
+	}
+
+	go s.run()
+
+	return s
+}
+
+// Update updates the buffering options.
+//
+// Changing the spool directory drops the events in the spool.
+func (s *BufferedSender) Update(opts BufferOptions) {
+	s.mu.Lock()
+	s.opts = opts
+	s.mu.Unlock()
+
+	s.wakeup()
+}
+
+// Stats returns the delivery counters.
+func (s *BufferedSender) Stats() BufferStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats := s.stats
+	stats.BufferedBytes = uint64(s.memoryBytes + s.inflightBytes)
+
+	return stats
+}
+
+// Send implements LogSender interface.
+//
+// Send queues the event for the delivery, it never blocks.
+func (s *BufferedSender) Send(_ context.Context, e *runtime.LogEvent) error {
+	event := bufferedEvent{
+		event: e,
+		size:  eventSize(e),
+	}
+
+	s.mu.Lock()
+
+	if s.closed {
+		s.mu.Unlock()
+
+		return errors.New("sender is closed")
+	}
+
+	for s.memoryBytes+event.size > s.opts.MemorySize {
+		if s.opts.DropPolicy != DropOldest || len(s.memory) == 0 {
+			s.stats.DroppedBytes += uint64(event.size)
+			s.mu.Unlock()
+
+			return nil
+		}
+
+		s.stats.DroppedBytes += uint64(s.memory[0].size)
+		s.memoryBytes -= s.memory[0].size
+		s.memory[0] = bufferedEvent{}
+		s.memory = s.memory[1:]
+	}
+
+	s.memory = append(s.memory, event)
+	s.memoryBytes += event.size
+
+	s.mu.Unlock()
+
+	s.wakeup()
+
+	return nil
+}
+
+// Close implements LogSender interface.
+//
+// Close tries to deliver the buffered events until the context is canceled.
+func (s *BufferedSender) Close(ctx context.Context) error {
+	s.mu.Lock()
+	s.closed = true
+	s.mu.Unlock()
+
+	s.wakeup()
+
+	select {
+	case <-s.done:
+	case <-ctx.Done():
+		s.ctxCancel()
+
+		<-s.done
+	}
+
+	s.ctxCancel()
+
+	return s.sender.Close(ctx)
+}
+
+func (s *BufferedSender) wakeup() {
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+//nolint:gocyclo
+func (s *BufferedSender) run() {
+	defer close(s.done)
+
+	defer func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if s.spool != nil {
+			s.stats.DroppedBytes += uint64(s.spool.close())
+			s.spool = nil
+		}
+
+		// the events which were not delivered before closing are dropped
+		s.stats.DroppedBytes += uint64(s.memoryBytes + s.inflightBytes)
+		s.stats.SpoolBytes = 0
+
+		s.memory = nil
+		s.memoryBytes, s.inflightBytes = 0, 0
+	}()
+
+	var (
+		inflight *bufferedEvent
+		backoff  time.Duration
+	)
+
+	for {
+		s.updateSpool()
+		s.spill()
+
+		if inflight == nil {
+			inflight = s.next()
+		}
+
+		if inflight == nil {
+			s.mu.Lock()
+			closed := s.closed
+			s.mu.Unlock()
+
+			if closed {
+				return
+			}
+
+			select {
+			case <-s.notify:
+			case <-s.ctx.Done():
+				return
+			}
+
+			continue
+		}
+
+		s.mu.Lock()
+		opts := s.opts
+		s.mu.Unlock()
+
+		sendCtx, sendCancel := context.WithTimeout(s.ctx, opts.SendTimeout)
+		err := s.sender.Send(sendCtx, inflight.event)
+		sendCancel()
+
+		if err == nil || errors.Is(err, runtime.ErrDontRetry) {
+			s.mu.Lock()
+
+			if err == nil {
+				s.stats.SentBytes += uint64(inflight.size)
+			} else {
+				s.stats.DroppedBytes += uint64(inflight.size)
+			}
+
+			s.inflightBytes = 0
+			s.mu.Unlock()
+
+			inflight = nil
+			backoff = 0
+
+			continue
+		}
+
+		s.mu.Lock()
+		s.stats.LastError = err.Error()
+		s.mu.Unlock()
+
+		backoff = min(max(backoff*2, opts.RetryMinBackoff), opts.RetryMaxBackoff)
+
+		if !s.wait(backoff) {
+			return
+		}
+	}
+}
+
+// wait waits for the retry backoff to expire, spilling the events to the spool while waiting.
+func (s *BufferedSender) wait(backoff time.Duration) bool {
+	timer := time.NewTimer(backoff)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return false
+		case <-timer.C:
+			return true
+		case <-s.notify:
+			s.updateSpool()
+			s.spill()
+		}
+	}
+}
+
+// next takes the oldest event for the delivery, the events in the spool are older than the events in memory.
+func (s *BufferedSender) next() *bufferedEvent {
+	if s.spool != nil && !s.spool.empty() {
+		event, ok, dropped := s.spool.pop()
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		s.stats.DroppedBytes += uint64(dropped)
+		s.stats.SpoolBytes = uint64(s.spool.size)
+
+		if ok {
+			s.inflightBytes = event.size
+
+			return &event
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.memory) == 0 {
+		return nil
+	}
+
+	event := s.memory[0]
+
+	s.memory[0] = bufferedEvent{}
+	s.memory = s.memory[1:]
+	s.memoryBytes -= event.size
+	s.inflightBytes = event.size
+
+	return &event
+}
+
+// updateSpool opens or closes the spool according to the options.
+func (s *BufferedSender) updateSpool() {
+	s.mu.Lock()
+	dir, size := s.opts.SpoolDir, s.opts.SpoolSize
+	s.mu.Unlock()
+
+	if s.spool != nil && s.spool.dir != dir {
+		dropped := s.spool.close()
+		s.spool = nil
+
+		s.mu.Lock()
+		s.stats.DroppedBytes += uint64(dropped)
+		s.stats.SpoolBytes = 0
+		s.mu.Unlock()
+	}
+
+	if dir == "" {
+		s.spoolErrDir = ""
+
+		return
+	}
+
+	if s.spool == nil {
+		if dir == s.spoolErrDir {
+			// don't retry opening the spool which failed
+			return
+		}
+
+		var err error
+
+		s.spool, err = newSpool(dir, size)
+		if err != nil {
+			s.spoolErrDir = dir
+
+			s.mu.Lock()
+			s.stats.LastError = err.Error()
+			s.mu.Unlock()
+
+			return
+		}
+	}
+
+	s.spool.maxSize = size
+}
+
+// spill moves the events from memory to the spool once the memory buffer is half full.
+//
+//nolint:gocyclo
+func (s *BufferedSender) spill() {
+	if s.spool == nil {
+		return
+	}
+
+	s.mu.Lock()
+
+	if s.memoryBytes <= s.opts.MemorySize/2 {
+		s.mu.Unlock()
+
+		return
+	}
+
+	// the batch is still accounted in memoryBytes, so that the memory buffer stays bounded while spilling
+	batch := s.memory
+	s.memory = nil
+	policy := s.opts.DropPolicy
+
+	s.mu.Unlock()
+
+	var (
+		spilled, removed, dropped int64
+		spillErr                  error
+		i                         int
+	)
+
+	for ; i < len(batch); i++ {
+		record, err := encodeSpoolRecord(batch[i].event)
+		if err != nil {
+			// the event can't be serialized, so it can't be delivered either
+			dropped += batch[i].size
+			removed += batch[i].size
+
+			continue
+		}
+
+		if policy == DropOldest {
+			for !s.spool.fits(len(record)) && !s.spool.empty() {
+				dropped += s.spool.dropOldest()
+			}
+		}
+
+		if !s.spool.fits(len(record)) {
+			break
+		}
+
+		if spillErr = s.spool.append(record, batch[i]); spillErr != nil {
+			break
+		}
+
+		spilled += batch[i].size
+		removed += batch[i].size
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// put the events which were not spilled back
+	s.memory = append(batch[i:], s.memory...)
+	s.memoryBytes -= removed
+	s.stats.SpooledBytes += uint64(spilled)
+	s.stats.DroppedBytes += uint64(dropped)
+	s.stats.SpoolBytes = uint64(s.spool.size)
+
+	if spillErr != nil {
+		s.stats.LastError = spillErr.Error()
+	}
+}
@@ -0,0 +1,394 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/gen/ensure"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/logging"
+)
+
+type fakeSender struct {
+	mu        sync.Mutex
+	fail      bool
+	attempts  int
+	delivered []string
+}
+
+func (s *fakeSender) Send(_ context.Context, e *runtime.LogEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.attempts++
+
+	if s.fail {
+		return errors.New("destination unavailable")
+	}
+
+	s.delivered = append(s.delivered, e.Msg)
+
+	return nil
+}
+
+func (s *fakeSender) Close(context.Context) error {
+	return nil
+}
+
+func (s *fakeSender) setFail(fail bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.fail = fail
+}
+
+func (s *fakeSender) getAttempts() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.attempts
+}
+
+func (s *fakeSender) getDelivered() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string(nil), s.delivered...)
+}
+
+func logEvent(msg string) *runtime.LogEvent {
+	return &runtime.LogEvent{
+		Msg:   msg,
+		Time:  time.Now(),
+		Level: zapcore.InfoLevel,
+		Fields: map[string]any{
+			"facility": "kern",
+			"seq":      uint64(42),
+		},
+	}
+}
+
+func messages(prefix string, from, to int) []string {
+	result := make([]string, 0, to-from)
+
+	for i := from; i < to; i++ {
+		result = append(result, fmt.Sprintf("%s-%04d", prefix, i))
+	}
+
+	return result
+}
+
+func testBufferOptions(memorySize int64) logging.BufferOptions {
+	return logging.BufferOptions{
+		MemorySize:      memorySize,
+		RetryMinBackoff: 5 * time.Millisecond,
+		RetryMaxBackoff: 20 * time.Millisecond,
+		SendTimeout:     100 * time.Millisecond,
+	}
+}
+
+func TestBufferedStalledEndpoint(t *testing.T) {
+	t.Parallel()
+
+	lis, err := (&net.ListenConfig{}).Listen(t.Context(), "tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	var (
+		connsMu sync.Mutex
+		conns   []net.Conn
+	)
+
+	// accept the connections, but never read from them
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+
+			connsMu.Lock()
+			conns = append(conns, conn)
+			connsMu.Unlock()
+		}
+	}()
+
+	t.Cleanup(func() {
+		lis.Close() //nolint:errcheck
+
+		connsMu.Lock()
+		defer connsMu.Unlock()
+
+		for _, conn := range conns {
+			conn.Close() //nolint:errcheck
+		}
+	})
+
+	const memorySize = 64 * 1024
+
+	sender := logging.NewBuffered(
+		logging.NewJSONLines(&loggingDestination{endpoint: ensure.Value(url.Parse("tcp://" + lis.Addr().String()))}),
+		testBufferOptions(memorySize),
+	)
+
+	msg := strings.Repeat("x", 1024)
+	maxEventSize := logging.EventSize(logEvent(msg))
+
+	var total uint64
+
+	// push much more than the socket buffers can hold
+	for range 20000 {
+		event := logEvent(msg)
+
+		require.NoError(t, sender.Send(t.Context(), event))
+
+		total += uint64(logging.EventSize(event))
+
+		stats := sender.Stats()
+		require.LessOrEqual(t, stats.BufferedBytes, uint64(memorySize+maxEventSize))
+	}
+
+	stats := sender.Stats()
+
+	assert.NotZero(t, stats.DroppedBytes)
+	assert.Equal(t, total, stats.SentBytes+stats.DroppedBytes+stats.BufferedBytes)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 100*time.Millisecond)
+	defer cancel()
+
+	sender.Close(ctx) //nolint:errcheck
+
+	stats = sender.Stats()
+
+	assert.Zero(t, stats.BufferedBytes)
+	assert.Equal(t, total, stats.SentBytes+stats.DroppedBytes)
+}
+
+func TestBufferedDropPolicy(t *testing.T) {
+	t.Parallel()
+
+	const n = 10
+
+	for _, test := range []struct {
+		name   string
+		policy logging.DropPolicy
+
+		expected []string
+	}{
+		{
+			name:   "oldest",
+			policy: logging.DropOldest,
+
+			expected: append([]string{"first"}, messages("msg", n, 2*n)...),
+		},
+		{
+			name:   "newest",
+			policy: logging.DropNewest,
+
+			expected: append([]string{"first"}, messages("msg", 0, n)...),
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			fake := &fakeSender{fail: true}
+
+			eventSize := logging.EventSize(logEvent("msg-0000"))
+
+			opts := testBufferOptions(n * eventSize)
+			opts.DropPolicy = test.policy
+
+			sender := logging.NewBuffered(fake, opts)
+
+			t.Cleanup(func() {
+				sender.Close(t.Context()) //nolint:errcheck
+			})
+
+			// the first event is taken for the delivery
+			require.NoError(t, sender.Send(t.Context(), logEvent("first")))
+
+			require.EventuallyWithT(t, func(collect *assert.CollectT) {
+				assert.NotZero(collect, fake.getAttempts())
+			}, time.Second, time.Millisecond)
+
+			for _, msg := range messages("msg", 0, 2*n) {
+				require.NoError(t, sender.Send(t.Context(), logEvent(msg)))
+			}
+
+			stats := sender.Stats()
+			assert.Equal(t, uint64(n*eventSize), stats.DroppedBytes)
+			assert.Equal(t, "destination unavailable", stats.LastError)
+
+			fake.setFail(false)
+
+			require.EventuallyWithT(t, func(collect *assert.CollectT) {
+				assert.Equal(collect, test.expected, fake.getDelivered())
+			}, time.Second, time.Millisecond)
+
+			stats = sender.Stats()
+			assert.Zero(t, stats.BufferedBytes)
+			assert.Equal(t, uint64(n*eventSize)+uint64(logging.EventSize(logEvent("first"))), stats.SentBytes)
+		})
+	}
+}
+
+func TestBufferedSpool(t *testing.T) {
+	t.Parallel()
+
+	const n = 300
+
+	eventSize := logging.EventSize(logEvent("msg-0000"))
+
+	for _, test := range []struct {
+		name      string
+		spoolSize int64
+
+		expectDrops bool
+	}{
+		{
+			name:      "fits",
+			spoolSize: 1024 * 1024,
+		},
+		{
+			name:      "overflow",
+			spoolSize: 8 * 1024,
+
+			expectDrops: true,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			fake := &fakeSender{fail: true}
+			spoolDir := t.TempDir()
+
+			opts := testBufferOptions(10 * eventSize)
+			opts.SpoolDir = spoolDir
+			opts.SpoolSize = test.spoolSize
+
+			sender := logging.NewBuffered(fake, opts)
+
+			t.Cleanup(func() {
+				sender.Close(t.Context()) //nolint:errcheck
+			})
+
+			for _, msg := range messages("msg", 0, n) {
+				require.NoError(t, sender.Send(t.Context(), logEvent(msg)))
+
+				// wait for the events to be spilled to the spool
+				require.EventuallyWithT(t, func(collect *assert.CollectT) {
+					stats := sender.Stats()
+
+					assert.LessOrEqual(collect, stats.BufferedBytes, uint64(6*eventSize))
+					assert.LessOrEqual(collect, stats.SpoolBytes, uint64(test.spoolSize))
+				}, time.Second, time.Millisecond)
+			}
+
+			stats := sender.Stats()
+			assert.NotZero(t, stats.SpooledBytes)
+			assert.NotZero(t, stats.SpoolBytes)
+
+			if test.expectDrops {
+				assert.NotZero(t, stats.DroppedBytes)
+			} else {
+				assert.Zero(t, stats.DroppedBytes)
+			}
+
+			fake.setFail(false)
+
+			require.EventuallyWithT(t, func(collect *assert.CollectT) {
+				stats := sender.Stats()
+
+				assert.Zero(collect, stats.BufferedBytes)
+				assert.Zero(collect, stats.SpoolBytes)
+			}, 5*time.Second, time.Millisecond)
+
+			delivered := fake.getDelivered()
+
+			// events are delivered in order, the most recent ones are always delivered
+			assert.IsIncreasing(t, delivered)
+			assert.Equal(t, messages("msg", n-5, n), delivered[len(delivered)-5:])
+
+			if !test.expectDrops {
+				assert.Equal(t, messages("msg", 0, n), delivered)
+			}
+
+			stats = sender.Stats()
+			assert.Equal(t, uint64(n*eventSize), stats.SentBytes+stats.DroppedBytes)
+
+			// consumed spool segments are removed
+			entries, err := os.ReadDir(spoolDir)
+			require.NoError(t, err)
+			assert.Empty(t, entries)
+		})
+	}
+}
+
+// stalledSender blocks until the delivery attempt times out.
+type stalledSender struct{}
+
+func (stalledSender) Send(ctx context.Context, _ *runtime.LogEvent) error {
+	<-ctx.Done()
+
+	return ctx.Err()
+}
+
+func (stalledSender) Close(context.Context) error {
+	return nil
+}
+
+func TestBufferedServiceLogs(t *testing.T) {
+	t.Parallel()
+
+	manager := logging.NewCircularBufferLoggingManager(log.New(io.Discard, "", 0))
+
+	healthy := &fakeSender{}
+	stalled := logging.NewBuffered(stalledSender{}, testBufferOptions(64*1024))
+
+	manager.SetSenders([]runtime.LogSender{
+		stalled,
+		logging.NewBuffered(healthy, testBufferOptions(64*1024)),
+	})
+
+	w, err := manager.ServiceLog("test").Writer()
+	require.NoError(t, err)
+
+	const n = 100
+
+	for _, msg := range messages("msg", 0, n) {
+		_, err = w.Write([]byte(msg + "\n"))
+		require.NoError(t, err)
+	}
+
+	// a stalled destination doesn't delay the delivery to the other ones
+	require.EventuallyWithT(t, func(collect *assert.CollectT) {
+		assert.Equal(collect, messages("msg", 0, n), healthy.getDelivered())
+	}, 2*time.Second, time.Millisecond)
+
+	assert.NotZero(t, stalled.Stats().BufferedBytes)
+
+	for _, sender := range manager.SetSenders(nil) {
+		ctx, cancel := context.WithTimeout(t.Context(), 100*time.Millisecond)
+
+		sender.Close(ctx) //nolint:errcheck
+
+		cancel()
+	}
+}
@@ -0,0 +1,305 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"maps"
+	"net"
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap/zapcore"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/httpdefaults"
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+)
+
+// SyslogFacility is a syslog facility code as defined in RFC 5424.
+type SyslogFacility int
+
+// Syslog facilities used by Talos.
+const (
+	SyslogFacilityKernel SyslogFacility = 0
+	SyslogFacilityDaemon SyslogFacility = 3
+)
+
+const (
+	// syslogSDID is the structured data element ID carrying Talos metadata.
+	syslogSDID = "talos@32473"
+
+	// syslogTimeFormat is RFC 3339 timestamp with microsecond precision, as recommended by RFC 5424.
+	syslogTimeFormat = "2006-01-02T15:04:05.000000Z07:00"
+)
+
+type syslogSender struct {
+	endpoint  *url.URL
+	extraTags map[string]string
+	tlsConfig config.LoggingTLSConfig
+	facility  SyslogFacility
+	hostname  string
+
+	sema chan struct{}
+	conn net.Conn
+}
+
+// NewSyslog returns log sender that sends logs as RFC 5424 syslog messages over UDP (one message per packet),
+// TCP or TLS (octet-counting framing, RFC 6587).
+func NewSyslog(cfg config.LoggingDestination, facility SyslogFacility) runtime.LogSender {
+	sema := make(chan struct{}, 1)
+	sema <- struct{}{}
+
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "-"
+	}
+
+	return &syslogSender{
+		endpoint:  cfg.Endpoint(),
+		extraTags: cfg.ExtraTags(),
+		tlsConfig: cfg.TLS(),
+		facility:  facility,
+		hostname:  hostname,
+
+		sema: sema,
+	}
+}
+
+func (s *syslogSender) tryLock(ctx context.Context) (unlock func()) {
+	select {
+	case <-s.sema:
+		unlock = func() { s.sema <- struct{}{} }
+	case <-ctx.Done():
+		unlock = nil
+	}
+
+	return
+}
+
+func syslogSeverity(level zapcore.Level) int {
+	switch level {
+	case zapcore.DebugLevel:
+		return 7
+	case zapcore.InfoLevel:
+		return 6
+	case zapcore.WarnLevel:
+		return 4
+	case zapcore.ErrorLevel:
+		return 3
+	case zapcore.DPanicLevel:
+		return 2
+	case zapcore.PanicLevel:
+		return 1
+	case zapcore.FatalLevel:
+		return 0
+	default:
+		return 5
+	}
+}
+
+// syslogEscapeParam escapes structured data parameter value as required by RFC 5424.
+func syslogEscapeParam(v string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`).Replace(v)
+}
+
+// syslogName sanitizes header fields which should consist of printable US-ASCII characters only.
+func syslogName(v string, maxLen int) string {
+	v = strings.Map(func(r rune) rune {
+		if r < 33 || r > 126 {
+			return -1
+		}
+
+		return r
+	}, v)
+
+	if v == "" {
+		return "-"
+	}
+
+	if len(v) > maxLen {
+		v = v[:maxLen]
+	}
+
+	return v
+}
+
+func (s *syslogSender) format(e *runtime.LogEvent) []byte {
+	appName := "talos"
+	if s.facility == SyslogFacilityKernel {
+		appName = "kernel"
+	}
+
+	var service string
+
+	if v, ok := e.Fields["talos-service"]; ok {
+		service = fmt.Sprint(v)
+		appName = service
+	}
+
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "<%d>1 %s %s %s - - ",
+		int(s.facility)*8+syslogSeverity(e.Level),
+		e.Time.UTC().Format(syslogTimeFormat),
+		syslogName(s.hostname, 255),
+		syslogName(appName, 48),
+	)
+
+	sb.WriteString("[" + syslogSDID)
+	fmt.Fprintf(&sb, ` node="%s"`, syslogEscapeParam(s.hostname))
+
+	if service != "" {
+		fmt.Fprintf(&sb, ` service="%s"`, syslogEscapeParam(service))
+	}
+
+	params := make(map[string]string, len(e.Fields)+len(s.extraTags))
+
+	for k, v := range e.Fields {
+		if k == "talos-service" {
+			continue
+		}
+
+		params[k] = fmt.Sprint(v)
+	}
+
+	maps.Copy(params, s.extraTags)
+
+	for _, k := range slices.Sorted(maps.Keys(params)) {
+		name := strings.Map(func(r rune) rune {
+			if r < 33 || r > 126 || r == '=' || r == ']' || r == '"' {
+				return '_'
+			}
+
+			return r
+		}, k)
+
+		if len(name) > 32 {
+			name = name[:32]
+		}
+
+		fmt.Fprintf(&sb, ` %s="%s"`, name, syslogEscapeParam(params[k]))
+	}
+
+	sb.WriteString("] ")
+	sb.WriteString(e.Msg)
+
+	return []byte(sb.String())
+}
+
+func (s *syslogSender) dial(ctx context.Context) (net.Conn, error) {
+	switch s.endpoint.Scheme {
+	case "tls":
+		tlsConfig := &tls.Config{
+			RootCAs:    httpdefaults.RootCAs(),
+			MinVersion: tls.VersionTLS12,
+		}
+
+		if s.tlsConfig != nil {
+			if ca := s.tlsConfig.CA(); len(ca) > 0 {
+				pool := x509.NewCertPool()
+
+				if !pool.AppendCertsFromPEM(ca) {
+					return nil, fmt.Errorf("%w: failed to parse CA certificate", runtime.ErrDontRetry)
+				}
+
+				tlsConfig.RootCAs = pool
+			}
+
+			if identity := s.tlsConfig.ClientIdentity(); identity != nil {
+				cert, err := tls.X509KeyPair(identity.Crt, identity.Key)
+				if err != nil {
+					return nil, fmt.Errorf("%w: failed to parse client identity: %s", runtime.ErrDontRetry, err)
+				}
+
+				tlsConfig.Certificates = []tls.Certificate{cert}
+			}
+		}
+
+		return (&tls.Dialer{Config: tlsConfig}).DialContext(ctx, "tcp", s.endpoint.Host)
+	default:
+		return new(net.Dialer).DialContext(ctx, s.endpoint.Scheme, s.endpoint.Host)
+	}
+}
+
+// Send implements LogSender interface.
+func (s *syslogSender) Send(ctx context.Context, e *runtime.LogEvent) error {
+	b := s.format(e)
+
+	if s.endpoint.Scheme != "udp" {
+		b = append([]byte(strconv.Itoa(len(b))+" "), b...)
+	}
+
+	unlock := s.tryLock(ctx)
+	if unlock == nil {
+		return ctx.Err()
+	}
+
+	defer unlock()
+
+	// Connect (or "connect" for UDP) if no connection is established already.
+	if s.conn == nil {
+		conn, err := s.dial(ctx)
+		if err != nil {
+			return err
+		}
+
+		s.conn = conn
+	}
+
+	d, _ := ctx.Deadline()
+	s.conn.SetWriteDeadline(d) //nolint:errcheck
+
+	// Close connection on send error.
+	if n, err := s.conn.Write(b); err != nil {
+		s.conn.Close() //nolint:errcheck
+		s.conn = nil
+
+		// skip partially sent events to avoid partial duplicates in the receiver
+		if n > 0 {
+			err = fmt.Errorf("%w: %s", runtime.ErrDontRetry, err)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// Close implements LogSender interface.
+func (s *syslogSender) Close(ctx context.Context) error {
+	unlock := s.tryLock(ctx)
+	if unlock == nil {
+		return ctx.Err()
+	}
+
+	defer unlock()
+
+	if s.conn == nil {
+		return nil
+	}
+
+	conn := s.conn
+	s.conn = nil
+
+	closed := make(chan error, 1)
+
+	go func() {
+		closed <- conn.Close()
+	}()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case err := <-closed:
+		return err
+	}
+}
@@ -0,0 +1,255 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging_test
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/gen/channel"
+	"github.com/siderolabs/gen/ensure"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/logging"
+)
+
+// syslogTCPHandler reads octet-counted syslog frames (RFC 6587).
+func syslogTCPHandler(ctx context.Context, t *testing.T, conn net.Listener, sendCh chan<- []byte) {
+	t.Helper()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		if err := conn.(*net.TCPListener).SetDeadline(time.Now().Add(10 * time.Millisecond)); err != nil {
+			t.Logf("failed to set accept deadline: %v", err)
+
+			return
+		}
+
+		c, err := conn.Accept()
+		if err != nil {
+			if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+				continue
+			}
+
+			t.Logf("failed to accept TCP connection: %v", err)
+
+			return
+		}
+
+		go func() {
+			defer c.Close() //nolint:errcheck
+
+			r := bufio.NewReader(c)
+
+			for {
+				length, err := r.ReadString(' ')
+				if err != nil {
+					return
+				}
+
+				n, err := strconv.Atoi(length[:len(length)-1])
+				if err != nil {
+					t.Logf("invalid frame length %q: %v", length, err)
+
+					return
+				}
+
+				frame := make([]byte, n)
+
+				if _, err = io.ReadFull(r, frame); err != nil {
+					return
+				}
+
+				if !channel.SendWithContext(ctx, sendCh, frame) {
+					return
+				}
+			}
+		}()
+	}
+}
+
+func TestSenderSyslog(t *testing.T) { //nolint:tparallel
+	t.Parallel()
+
+	hostname, err := os.Hostname()
+	require.NoError(t, err)
+
+	lisUDP, err := (&net.ListenConfig{}).ListenPacket(t.Context(), "udp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, lisUDP.Close())
+	})
+
+	lisTCP, err := (&net.ListenConfig{}).Listen(t.Context(), "tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, lisTCP.Close())
+	})
+
+	udpEndpoint := lisUDP.LocalAddr().String()
+	tcpEndpoint := lisTCP.Addr().String()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	sendCh := make(chan []byte, 32)
+
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		udpHandler(ctx, t, lisUDP, sendCh)
+	}()
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		syslogTCPHandler(ctx, t, lisTCP, sendCh)
+	}()
+
+	t.Cleanup(wg.Wait)
+
+	for _, test := range []struct {
+		name string
+
+		endpoint  *url.URL
+		extraTags map[string]string
+		facility  logging.SyslogFacility
+
+		messages []*runtime.LogEvent
+
+		expected []string
+	}{
+		{
+			name: "UDP",
+
+			endpoint: ensure.Value(url.Parse("udp://" + udpEndpoint)),
+			facility: logging.SyslogFacilityDaemon,
+
+			messages: []*runtime.LogEvent{
+				{
+					Msg:   "msg1",
+					Time:  ensure.Value(time.Parse(time.RFC3339Nano, "2021-01-01T00:00:00Z")),
+					Level: zapcore.InfoLevel,
+					Fields: map[string]any{
+						"talos-service": "kubelet",
+					},
+				},
+				{
+					Msg:   "msg2",
+					Time:  ensure.Value(time.Parse(time.RFC3339Nano, "2021-01-01T00:00:01.5Z")),
+					Level: zapcore.ErrorLevel,
+				},
+			},
+
+			expected: []string{
+				`<30>1 2021-01-01T00:00:00.000000Z ` + hostname + ` kubelet - - [talos@32473 node="` + hostname + `" service="kubelet"] msg1`,
+				`<27>1 2021-01-01T00:00:01.500000Z ` + hostname + ` talos - - [talos@32473 node="` + hostname + `"] msg2`,
+			},
+		},
+		{
+			name: "TCP multi-line",
+
+			endpoint: ensure.Value(url.Parse("tcp://" + tcpEndpoint)),
+			facility: logging.SyslogFacilityDaemon,
+			extraTags: map[string]string{
+				"cluster": `prod "east"`,
+			},
+
+			messages: []*runtime.LogEvent{
+				{
+					Msg:   "panic: boom\n\ngoroutine 1 [running]:\nmain.main()",
+					Time:  ensure.Value(time.Parse(time.RFC3339Nano, "2021-01-01T00:00:00Z")),
+					Level: zapcore.WarnLevel,
+					Fields: map[string]any{
+						"talos-service": "etcd",
+						"path":          `C:\tmp]`,
+					},
+				},
+				{
+					Msg:   "next",
+					Time:  ensure.Value(time.Parse(time.RFC3339Nano, "2021-01-01T00:00:01Z")),
+					Level: zapcore.DebugLevel,
+				},
+			},
+
+			expected: []string{
+				`<28>1 2021-01-01T00:00:00.000000Z ` + hostname + ` etcd - - [talos@32473 node="` + hostname + `" service="etcd" cluster="prod \"east\"" path="C:\\tmp\]"] ` +
+					"panic: boom\n\ngoroutine 1 [running]:\nmain.main()",
+				`<31>1 2021-01-01T00:00:01.000000Z ` + hostname + ` talos - - [talos@32473 node="` + hostname + `" cluster="prod \"east\""] next`,
+			},
+		},
+		{
+			name: "TCP kernel",
+
+			endpoint: ensure.Value(url.Parse("tcp://" + tcpEndpoint)),
+			facility: logging.SyslogFacilityKernel,
+
+			messages: []*runtime.LogEvent{
+				{
+					Msg:   "eth0: link up",
+					Time:  ensure.Value(time.Parse(time.RFC3339Nano, "2021-01-01T00:00:00Z")),
+					Level: zapcore.InfoLevel,
+					Fields: map[string]any{
+						"seq": 42,
+					},
+				},
+			},
+
+			expected: []string{
+				`<6>1 2021-01-01T00:00:00.000000Z ` + hostname + ` kernel - - [talos@32473 node="` + hostname + `" seq="42"] eth0: link up`,
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			// not parallel - need sequential execution
+			loggingCfg := &loggingDestination{
+				endpoint:  test.endpoint,
+				extraTags: test.extraTags,
+			}
+
+			sender := logging.NewSyslog(loggingCfg, test.facility)
+
+			for _, msg := range test.messages {
+				require.NoError(t, sender.Send(ctx, msg))
+			}
+
+			for _, expected := range test.expected {
+				select {
+				case <-time.After(time.Second):
+					t.Fatalf("timed out waiting for message")
+				case msg := <-sendCh:
+					require.Equal(t, expected, string(msg))
+				}
+			}
+
+			require.NoError(t, sender.Close(ctx))
+		})
+	}
+
+	cancel()
+}
@@ -0,0 +1,257 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+)
+
+// minSpoolSegmentSize is the minimum size of the spool segment file.
+const minSpoolSegmentSize = 4096
+
+// spool is a size-capped on-disk FIFO queue of the log events.
+//
+// The events are appended to the segment files, the segment file is removed once all its events are consumed
+// (or dropped to free up space).
+// The segment files are opened only for the duration of a single operation, so that the spool doesn't
+// keep the filesystem busy.
+//
+// Spool is not thread-safe.
+type spool struct {
+	dir      string
+	maxSize  int64
+	segments []*spoolSegment
+	nextSeq  int
+
+	// size is the total size of the segment files
+	size int64
+	// pendingBytes is the total size of the events in the spool (as accounted by eventSize)
+	pendingBytes int64
+}
+
+type spoolSegment struct {
+	path string
+
+	fileSize   int64
+	readOffset int64
+
+	pendingEvents int
+	pendingBytes  int64
+}
+
+type spooledEvent struct {
+	Msg    string         `json:"msg"`
+	Time   time.Time      `json:"time"`
+	Level  zapcore.Level  `json:"level"`
+	Fields map[string]any `json:"fields,omitempty"`
+}
+
+// newSpool initializes the spool in the specified directory, removing any leftovers.
+func newSpool(dir string, maxSize int64) (*spool, error) {
+	if err := os.RemoveAll(dir); err != nil {
+		return nil, fmt.Errorf("error cleaning up spool directory: %w", err)
+	}
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("error creating spool directory: %w", err)
+	}
+
+	return &spool{
+		dir:     dir,
+		maxSize: maxSize,
+	}, nil
+}
+
+func (s *spool) empty() bool {
+	return len(s.segments) == 0
+}
+
+func (s *spool) segmentSize() int64 {
+	return max(s.maxSize/4, minSpoolSegmentSize)
+}
+
+// fits returns true if the record of the specified size can be appended without exceeding the spool size.
+func (s *spool) fits(size int) bool {
+	return s.size+int64(size) <= s.maxSize
+}
+
+// encodeSpoolRecord returns the spool record for the event.
+func encodeSpoolRecord(e *runtime.LogEvent) ([]byte, error) {
+	payload, err := json.Marshal(spooledEvent{
+		Msg:    e.Msg,
+		Time:   e.Time,
+		Level:  e.Level,
+		Fields: e.Fields,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return append(binary.BigEndian.AppendUint32(nil, uint32(len(payload))), payload...), nil
+}
+
+// append writes the encoded event to the spool.
+func (s *spool) append(record []byte, event bufferedEvent) error {
+	if len(s.segments) == 0 || s.segments[len(s.segments)-1].fileSize+int64(len(record)) > s.segmentSize() {
+		s.segments = append(s.segments, &spoolSegment{
+			path: filepath.Join(s.dir, fmt.Sprintf("%08d.spool", s.nextSeq)),
+		})
+
+		s.nextSeq++
+	}
+
+	segment := s.segments[len(s.segments)-1]
+
+	if err := appendFile(segment.path, record); err != nil {
+		return err
+	}
+
+	segment.fileSize += int64(len(record))
+	segment.pendingEvents++
+	segment.pendingBytes += event.size
+
+	s.size += int64(len(record))
+	s.pendingBytes += event.size
+
+	return nil
+}
+
+func appendFile(path string, data []byte) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return err
+	}
+
+	if _, err = f.Write(data); err != nil {
+		f.Close() //nolint:errcheck
+
+		return err
+	}
+
+	return f.Close()
+}
+
+// pop removes the oldest event from the spool.
+//
+// If the event can't be read, the rest of the segment is dropped, and the size of the dropped events is returned.
+func (s *spool) pop() (event bufferedEvent, ok bool, dropped int64) {
+	for len(s.segments) > 0 {
+		segment := s.segments[0]
+
+		e, recordSize, err := segment.read()
+		if err != nil {
+			dropped += s.dropOldest()
+
+			continue
+		}
+
+		segment.readOffset += recordSize
+		segment.pendingEvents--
+
+		event = bufferedEvent{
+			event: e,
+			size:  eventSize(e),
+		}
+
+		segment.pendingBytes -= event.size
+		s.pendingBytes -= event.size
+
+		if segment.pendingEvents == 0 {
+			s.removeOldest()
+		}
+
+		return event, true, dropped
+	}
+
+	return bufferedEvent{}, false, dropped
+}
+
+func (segment *spoolSegment) read() (*runtime.LogEvent, int64, error) {
+	f, err := os.Open(segment.path)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	r := io.NewSectionReader(f, segment.readOffset, segment.fileSize-segment.readOffset)
+
+	var header [4]byte
+
+	if _, err = io.ReadFull(r, header[:]); err != nil {
+		return nil, 0, err
+	}
+
+	payload := make([]byte, binary.BigEndian.Uint32(header[:]))
+
+	if _, err = io.ReadFull(r, payload); err != nil {
+		return nil, 0, err
+	}
+
+	var spooled spooledEvent
+
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	// keep the numbers as is, so that the integer fields are not converted to floats
+	decoder.UseNumber()
+
+	if err = decoder.Decode(&spooled); err != nil {
+		return nil, 0, err
+	}
+
+	return &runtime.LogEvent{
+		Msg:    spooled.Msg,
+		Time:   spooled.Time,
+		Level:  spooled.Level,
+		Fields: spooled.Fields,
+	}, int64(len(header) + len(payload)), nil
+}
+
+// dropOldest drops the oldest segment, returning the size of the dropped events.
+func (s *spool) dropOldest() int64 {
+	if len(s.segments) == 0 {
+		return 0
+	}
+
+	dropped := s.segments[0].pendingBytes
+
+	s.pendingBytes -= dropped
+	s.removeOldest()
+
+	return dropped
+}
+
+func (s *spool) removeOldest() {
+	segment := s.segments[0]
+
+	os.Remove(segment.path) //nolint:errcheck
+
+	s.size -= segment.fileSize
+	s.segments[0] = nil
+	s.segments = s.segments[1:]
+}
+
+// close removes the spool, returning the size of the events which were not consumed.
+func (s *spool) close() int64 {
+	dropped := s.pendingBytes
+
+	os.RemoveAll(s.dir) //nolint:errcheck
+
+	s.segments = nil
+	s.size = 0
+	s.pendingBytes = 0
+
+	return dropped
+}
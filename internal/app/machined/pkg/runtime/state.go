@@ -7,6 +7,8 @@ package runtime
 import (
 	"context"
 
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/cosi-project/runtime/pkg/state/registry"
 
@@ -60,6 +62,7 @@ type V1Alpha2State interface {
 
 	NamespaceRegistry() *registry.NamespaceRegistry
 	ResourceRegistry() *registry.ResourceRegistry
+	RegisteredResource(resource.Type) (meta.ResourceWithRD, bool)
 
 	GetConfig(context.Context) (configcore.Provider, error)
 	SetConfig(context.Context, string, configcore.Provider) error
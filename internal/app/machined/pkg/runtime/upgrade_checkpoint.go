@@ -0,0 +1,46 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/siderolabs/talos/pkg/machinery/meta"
+	runtimeres "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// ReadUpgradeCheckpoint reads the checkpoint of the last upgrade attempt from META.
+//
+// If there is no checkpoint, nil is returned.
+func ReadUpgradeCheckpoint(m Meta) (*runtimeres.UpgradeStatusSpec, error) {
+	val, ok := m.ReadTagBytes(meta.UpgradeCheckpoint)
+	if !ok {
+		return nil, nil
+	}
+
+	var checkpoint runtimeres.UpgradeStatusSpec
+
+	if err := json.Unmarshal(val, &checkpoint); err != nil {
+		return nil, fmt.Errorf("error unmarshaling upgrade checkpoint: %w", err)
+	}
+
+	return &checkpoint, nil
+}
+
+// WriteUpgradeCheckpoint stores the checkpoint of the upgrade attempt in META and flushes META to the disk.
+func WriteUpgradeCheckpoint(ctx context.Context, m Meta, checkpoint *runtimeres.UpgradeStatusSpec) error {
+	val, err := json.Marshal(checkpoint)
+	if err != nil {
+		return fmt.Errorf("error marshaling upgrade checkpoint: %w", err)
+	}
+
+	if _, err = m.SetTagBytes(ctx, meta.UpgradeCheckpoint, val); err != nil {
+		return fmt.Errorf("error setting upgrade checkpoint: %w", err)
+	}
+
+	return m.Flush()
+}
@@ -6,7 +6,10 @@ package v1alpha1
 
 import (
 	"context"
+	"os"
+	"slices"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -71,11 +74,19 @@ type Events struct {
 	// gap is a safety gap between consumers and publishers
 	gap int
 
-	// mutext protects access to writePos and stream
+	// bootID tags the published events
+	bootID string
+
+	// history is the list of events persisted during the previous boots
+	history []runtime.Event
+
+	// mutext protects access to writePos, stream and history
 	mu sync.Mutex
 	c  *sync.Cond
 }
 
+const bootIDPath = "/proc/sys/kernel/random/boot_id"
+
 // NewEvents initializes and returns the v1alpha1 runtime event stream.
 //
 // Argument cap is a maximum event stream capacity (available event history).
@@ -88,6 +99,10 @@ func NewEvents(capacity, gap int) *Events {
 		gap:    gap,
 	}
 
+	if bootID, err := os.ReadFile(bootIDPath); err == nil {
+		e.bootID = strings.TrimSpace(string(bootID))
+	}
+
 	if gap >= capacity {
 		// we should never reach this, but if we do, panic so that we know.
 		panic("NewEvents: gap >= capacity")
@@ -153,13 +168,33 @@ func (e *Events) Watch(f runtime.WatchFunc, opt ...runtime.WatchOptionFunc) erro
 
 			return event.ID.Time().After(timestamp)
 		}))
+	case opts.ReplayPersisted || opts.SinceBoot < 0:
+		// persisted events are followed by all events of the current boot
+		pos = minPos
 	}
 
+	replay := e.replayHistory(opts)
+
 	e.mu.Unlock()
 
 	go func() {
 		defer close(ch)
 
+		for i, event := range replay {
+			if opts.ActorID != "" && event.ActorID != opts.ActorID {
+				continue
+			}
+
+			select {
+			case ch <- runtime.EventInfo{
+				Event:   event,
+				Backlog: len(replay) - i - 1,
+			}:
+			case <-ctx.Done():
+				return
+			}
+		}
+
 		for {
 			e.mu.Lock()
 			// while there's no data to consume (pos == e.writePos), wait for Condition variable signal,
@@ -210,6 +245,55 @@ func (e *Events) Watch(f runtime.WatchFunc, opt ...runtime.WatchOptionFunc) erro
 	return nil
 }
 
+// replayHistory returns the persisted events to be replayed according to the watch options.
+//
+// It should be called with the mutex held.
+func (e *Events) replayHistory(opts runtime.WatchOptions) []runtime.Event {
+	switch {
+	case opts.ReplayPersisted:
+		return e.history
+	case opts.SinceBoot < 0:
+		// find the first event of the requested boot, walking the boots backwards
+		boots := 0
+		start := len(e.history)
+
+		for i := len(e.history) - 1; i >= 0; i-- {
+			if i == len(e.history)-1 || e.history[i].BootID != e.history[i+1].BootID {
+				boots++
+			}
+
+			if boots > -opts.SinceBoot {
+				break
+			}
+
+			start = i
+		}
+
+		return e.history[start:]
+	default:
+		return nil
+	}
+}
+
+// BootID implements the Events interface.
+func (e *Events) BootID() string {
+	return e.bootID
+}
+
+// LoadHistory implements the Events interface.
+//
+// The events of the current boot are skipped, as they are available in the stream.
+func (e *Events) LoadHistory(history []runtime.Event) {
+	history = slices.DeleteFunc(slices.Clone(history), func(event runtime.Event) bool {
+		return event.BootID == e.bootID
+	})
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.history = history
+}
+
 // Publish implements the Events interface.
 func (e *Events) Publish(ctx context.Context, msg proto.Message) {
 	actorID, ok := ctx.Value(runtime.ActorIDCtxKey{}).(string)
@@ -218,6 +302,7 @@ func (e *Events) Publish(ctx context.Context, msg proto.Message) {
 	}
 
 	event := runtime.NewEvent(msg, actorID)
+	event.BootID = e.bootID
 
 	e.mu.Lock()
 	defer e.mu.Unlock()
@@ -13,6 +13,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/rs/xid"
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/time/rate"
 
@@ -254,6 +255,43 @@ func TestEvents_WatchOptionsTailID(t *testing.T) {
 	}
 }
 
+func TestEvents_WatchOptionsReplay(t *testing.T) {
+	e := NewEvents(100, 10)
+	e.bootID = "boot-3"
+
+	var history []runtime.Event
+
+	// boots 1 and 2 are persisted, events of the current boot are skipped on load
+	for i, bootID := range []string{"boot-1", "boot-1", "boot-2", "boot-2", "boot-2", "boot-3"} {
+		history = append(history, runtime.Event{
+			ID:      xid.New(),
+			BootID:  bootID,
+			Payload: &machine.SequenceEvent{Sequence: strconv.Itoa(i)},
+		})
+	}
+
+	e.LoadHistory(history)
+
+	for i := 10; i < 15; i++ {
+		e.Publish(t.Context(), &machine.SequenceEvent{
+			Sequence: strconv.Itoa(i),
+		})
+	}
+
+	assert.Equal(t, []int(nil), extractSeq(t, receive(t, e, 0)))
+	assert.Equal(t, append(gen(2, 5), gen(10, 15)...), extractSeq(t, receive(t, e, 8, runtime.WithSinceBoot(-1))))
+	assert.Equal(t, append(gen(0, 5), gen(10, 15)...), extractSeq(t, receive(t, e, 10, runtime.WithSinceBoot(-2))))
+	assert.Equal(t, append(gen(0, 5), gen(10, 15)...), extractSeq(t, receive(t, e, 10, runtime.WithSinceBoot(-5))))
+	assert.Equal(t, append(gen(0, 5), gen(10, 15)...), extractSeq(t, receive(t, e, 10, runtime.WithReplayPersisted())))
+
+	for _, event := range receive(t, e, 5, runtime.WithTailEvents(-1)) {
+		assert.Equal(t, "boot-3", event.BootID)
+	}
+
+	assert.Error(t, e.Watch(func(<-chan runtime.EventInfo) {}, runtime.WithSinceBoot(1)))
+	assert.Error(t, e.Watch(func(<-chan runtime.EventInfo) {}, runtime.WithTailEvents(1), runtime.WithSinceBoot(-1)))
+}
+
 func BenchmarkWatch(b *testing.B) {
 	e := NewEvents(100, 10)
 
@@ -16,6 +16,7 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/config/machine"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/meta"
+	resourceruntime "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
 )
 
 // Sequencer implements the sequencer interface.
@@ -235,6 +236,9 @@ func (*Sequencer) Boot(r runtime.Runtime) []runtime.Phase {
 // Reboot is the reboot sequence.
 func (*Sequencer) Reboot(r runtime.Runtime) []runtime.Phase {
 	phases := PhaseList{}.Append(
+		"inhibitors",
+		WaitForShutdownInhibitors,
+	).Append(
 		"cleanup",
 		StopAllPods,
 	).Append(
@@ -340,6 +344,9 @@ func (*Sequencer) Shutdown(r runtime.Runtime, in *machineapi.ShutdownRequest) []
 	skipNodeRegistration := r.Config() != nil && r.Config().Machine() != nil && r.Config().Machine().Kubelet().SkipNodeRegistration()
 
 	phases := PhaseList{}.Append(
+		"inhibitors",
+		WaitForShutdownInhibitors,
+	).Append(
 		"storeShutdown",
 		StoreShutdownEmergency,
 	).AppendWhen(
@@ -368,6 +375,9 @@ func (*Sequencer) StageUpgrade(r runtime.Runtime, in *machineapi.UpgradeRequest)
 		return nil
 	default:
 		phases = phases.Append(
+			"inhibitors",
+			WaitForShutdownInhibitors,
+		).Append(
 			"cleanup",
 			StopAllPods,
 		).Append(
@@ -422,7 +432,13 @@ func (*Sequencer) Upgrade(r runtime.Runtime, in *machineapi.UpgradeRequest) []ru
 	case runtime.ModeContainer:
 		return nil
 	default:
-		phases = phases.AppendWhen(
+		phases = phases.Append(
+			"inhibitors",
+			WaitForShutdownInhibitors,
+		).Append(
+			"checkpointPrepare",
+			CheckpointUpgrade(resourceruntime.UpgradePhasePrepare),
+		).AppendWhen(
 			!r.Config().Machine().Kubelet().SkipNodeRegistration(),
 			"drain",
 			CordonAndDrainNode,
@@ -447,12 +463,19 @@ func (*Sequencer) Upgrade(r runtime.Runtime, in *machineapi.UpgradeRequest) []ru
 		).Append(
 			"volumeFinalize",
 			TeardownVolumeLifecycle,
+		).Append(
+			"checkpointInstall",
+			CheckpointUpgrade(resourceruntime.UpgradePhaseInstall),
 		).Append(
 			"upgrade",
 			Upgrade,
 		).Append(
 			"meta",
 			ReloadMeta,
+		).Append(
+			// the checkpoint is written after META is reloaded to keep the fallback entry written by the installer
+			"checkpointInstalled",
+			CheckpointUpgrade(resourceruntime.UpgradePhaseInstalled),
 		).AppendWhen(
 			in.GetRebootMode() == machineapi.UpgradeRequest_DEFAULT,
 			"kexec",
@@ -38,6 +38,7 @@ import (
 	"github.com/siderolabs/go-procfs/procfs"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"golang.org/x/sys/unix"
+	"google.golang.org/protobuf/types/known/durationpb"
 	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
 
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
@@ -72,6 +73,7 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
 	resourceruntime "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
 	resourcev1alpha1 "github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/version"
 	"github.com/siderolabs/talos/pkg/minimal"
 )
 
@@ -983,21 +985,60 @@ func waitForKubeletLifecycleFinalizers(ctx context.Context, logger *log.Logger,
 	return r.State().V1Alpha2().Resources().Destroy(ctx, lifecycle)
 }
 
+// kubeletShutdownGracePeriod returns the shutdown grace period of the running kubelet.
+func kubeletShutdownGracePeriod(ctx context.Context, r runtime.Runtime) time.Duration {
+	kubeletSpec, err := safe.StateGetByID[*k8s.KubeletSpec](ctx, r.State().V1Alpha2().Resources(), k8s.KubeletID)
+	if err != nil {
+		return constants.KubeletShutdownGracePeriod
+	}
+
+	gracePeriodStr, ok := kubeletSpec.TypedSpec().Config["shutdownGracePeriod"].(string)
+	if !ok {
+		return constants.KubeletShutdownGracePeriod
+	}
+
+	gracePeriod, err := time.ParseDuration(gracePeriodStr)
+	if err != nil {
+		return constants.KubeletShutdownGracePeriod
+	}
+
+	return gracePeriod
+}
+
 func stopAndRemoveAllPods(stopAction cri.StopAction) runtime.TaskExecutionFunc {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
 		if err = waitForKubeletLifecycleFinalizers(ctx, logger, r); err != nil {
 			logger.Printf("failed waiting for kubelet lifecycle finalizers: %s", err)
 		}
 
-		logger.Printf("shutting down kubelet gracefully")
+		gracePeriod := kubeletShutdownGracePeriod(ctx, r)
+		waitTimeout := logind.ShutdownWaitTimeout(gracePeriod)
 
-		shutdownCtx, shutdownCtxCancel := context.WithTimeout(ctx, logind.InhibitMaxDelay)
+		logger.Printf("shutting down kubelet gracefully, grace period %s, waiting up to %s", gracePeriod, waitTimeout)
+
+		shutdownCtx, shutdownCtxCancel := context.WithTimeout(ctx, waitTimeout)
 		defer shutdownCtxCancel()
 
-		if err = r.State().Machine().DBus().WaitShutdown(shutdownCtx); err != nil {
-			logger.Printf("failed waiting for inhibit shutdown lock: %s", err)
+		waitStart := time.Now()
+		waitErr := r.State().Machine().DBus().WaitShutdown(shutdownCtx)
+		waitDuration := time.Since(waitStart)
+
+		shutdownEvent := &machineapi.KubeletShutdownEvent{
+			GracePeriod:  durationpb.New(gracePeriod),
+			WaitDuration: durationpb.New(waitDuration),
+		}
+
+		if waitErr != nil {
+			logger.Printf("failed waiting for inhibit shutdown lock after %s: %s", waitDuration.Round(time.Millisecond), waitErr)
+
+			shutdownEvent.TimedOut = errors.Is(waitErr, context.DeadlineExceeded)
+			shutdownEvent.Error = waitErr.Error()
+		} else {
+			logger.Printf("kubelet graceful shutdown finished in %s", waitDuration.Round(time.Millisecond))
 		}
 
+		r.Events().Publish(ctx, shutdownEvent)
+
 		if err = system.Services(nil).Stop(ctx, "kubelet"); err != nil {
 			return err
 		}
@@ -1324,6 +1365,10 @@ func Upgrade(_ runtime.Sequence, data any) (runtime.TaskExecutionFunc, string) {
 			install.OptionsFromUpgradeRequest(r, in)...,
 		)
 		if err != nil {
+			if checkpointErr := failUpgradeCheckpoint(ctx, r, err); checkpointErr != nil {
+				logger.Printf("failed to record upgrade failure: %s", checkpointErr)
+			}
+
 			return err
 		}
 
@@ -1333,6 +1378,121 @@ func Upgrade(_ runtime.Sequence, data any) (runtime.TaskExecutionFunc, string) {
 	}, "upgrade"
 }
 
+// CheckpointUpgrade returns a task which records the upgrade phase in the upgrade checkpoint.
+//
+// The checkpoint is flushed to META before the phase starts, so that an upgrade interrupted
+// by a crash or a power loss is resolved deterministically on the next boot.
+func CheckpointUpgrade(phase string) runtime.TaskSetupFunc {
+	return func(_ runtime.Sequence, data any) (runtime.TaskExecutionFunc, string) {
+		return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) error {
+			in, ok := data.(*machineapi.UpgradeRequest)
+			if !ok {
+				return runtime.ErrInvalidSequenceData
+			}
+
+			checkpoint, err := runtime.ReadUpgradeCheckpoint(r.State().Machine().Meta())
+			if err != nil {
+				logger.Printf("ignoring previous upgrade checkpoint: %s", err)
+			}
+
+			now := time.Now()
+
+			// the first phase starts a new upgrade attempt
+			if phase == resourceruntime.UpgradePhasePrepare || checkpoint == nil {
+				checkpoint = &resourceruntime.UpgradeStatusSpec{
+					Image:       in.GetImage(),
+					FromVersion: version.Tag,
+					StartedAt:   now,
+				}
+			}
+
+			checkpoint.Phase = phase
+			checkpoint.UpdatedAt = now
+
+			logger.Printf("upgrade checkpoint: phase %q", phase)
+
+			return runtime.WriteUpgradeCheckpoint(ctx, r.State().Machine().Meta(), checkpoint)
+		}, "checkpointUpgrade"
+	}
+}
+
+func failUpgradeCheckpoint(ctx context.Context, r runtime.Runtime, upgradeErr error) error {
+	checkpoint, err := runtime.ReadUpgradeCheckpoint(r.State().Machine().Meta())
+	if err != nil {
+		return err
+	}
+
+	// the maintenance upgrade doesn't record checkpoints, so the checkpoint might be left from the previous upgrade
+	if checkpoint == nil || checkpoint.Phase != resourceruntime.UpgradePhaseInstall || checkpoint.Outcome != "" {
+		return nil
+	}
+
+	checkpoint.Outcome = resourceruntime.UpgradeOutcomeFailed
+	checkpoint.Message = upgradeErr.Error()
+	checkpoint.UpdatedAt = time.Now()
+
+	return runtime.WriteUpgradeCheckpoint(ctx, r.State().Machine().Meta(), checkpoint)
+}
+
+// WaitForShutdownInhibitors represents the task which waits for the shutdown inhibitors to be released.
+//
+// The task announces the imminent shutdown, so that the inhibitor holders can finish their work,
+// each inhibitor holds the sequence at most for its maximum hold time counted from its acquisition.
+func WaitForShutdownInhibitors(seq runtime.Sequence, data any) (runtime.TaskExecutionFunc, string) {
+	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) error {
+		if in, ok := data.(interface{ GetIgnoreInhibitors() bool }); ok && in.GetIgnoreInhibitors() {
+			logger.Printf("ignoring shutdown inhibitors")
+
+			return nil
+		}
+
+		st := r.State().V1Alpha2().Resources()
+
+		if _, err := runtime.RemoveExpiredShutdownInhibitors(ctx, st); err != nil {
+			return err
+		}
+
+		inhibitors, err := safe.StateListAll[*resourceruntime.ShutdownInhibitor](ctx, st)
+		if err != nil {
+			return err
+		}
+
+		if inhibitors.Len() == 0 {
+			return nil
+		}
+
+		var maxWait time.Duration
+
+		ids := make([]string, 0, inhibitors.Len())
+		now := time.Now()
+
+		for inhibitor := range inhibitors.All() {
+			ids = append(ids, inhibitor.Metadata().ID())
+
+			maxWait = max(maxWait, inhibitor.TypedSpec().Remaining(now))
+		}
+
+		logger.Printf("waiting up to %s for %d shutdown inhibitor(s) to be released", maxWait, len(ids))
+
+		r.Events().Publish(ctx, &machineapi.ShutdownImminentEvent{
+			Sequence:   seq.String(),
+			Inhibitors: ids,
+			MaxWait:    durationpb.New(maxWait),
+		})
+
+		expired, err := runtime.WaitForShutdownInhibitors(ctx, st, time.Second)
+		if err != nil {
+			return err
+		}
+
+		if len(expired) > 0 {
+			logger.Printf("shutdown inhibitors %s were not released in time, proceeding", strings.Join(expired, ", "))
+		}
+
+		return nil
+	}, "waitForShutdownInhibitors"
+}
+
 // Reboot represents the Reboot task.
 func Reboot(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
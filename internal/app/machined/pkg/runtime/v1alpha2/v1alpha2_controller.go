@@ -5,6 +5,7 @@
 package v1alpha2
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"net/url"
@@ -40,6 +41,7 @@ import (
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
 	runtimelogging "github.com/siderolabs/talos/internal/app/machined/pkg/runtime/logging"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/system"
+	"github.com/siderolabs/talos/internal/pkg/resourcehistory"
 	"github.com/siderolabs/talos/pkg/logging"
 	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
@@ -56,6 +58,8 @@ type Controller struct {
 	consoleLogLevel zap.AtomicLevel
 	logger          *zap.Logger
 
+	resourceHistory *resourcehistory.Recorder
+
 	v1alpha1Runtime runtime.Runtime
 }
 
@@ -74,6 +78,8 @@ func NewController(v1alpha1Runtime runtime.Runtime) (*Controller, error) {
 		return nil, err
 	}
 
+	ctrl.resourceHistory = resourcehistory.NewRecorder(v1alpha1Runtime.State().V1Alpha2().Resources(), ctrl.logger)
+
 	ctrl.controllerRuntime, err = osruntime.NewRuntime(v1alpha1Runtime.State().V1Alpha2().Resources(), ctrl.logger)
 
 	return ctrl, err
@@ -84,6 +90,13 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 	// adjust the log level based on machine configuration
 	go ctrl.watchMachineConfig(ctx)
 
+	// record the history of the resources configured with the machine configuration
+	go func() {
+		if err := ctrl.resourceHistory.Run(ctx); err != nil {
+			ctrl.logger.Warn("resource history failed", zap.Error(err))
+		}
+	}()
+
 	dnsCacheLogger, err := ctrl.MakeLogger("dns-resolve-cache")
 	if err != nil {
 		return err
@@ -169,6 +182,9 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
 			MetaProvider: ctrl.v1alpha1Runtime.State().Machine(),
 		},
+		&block.VolumeKeyRotationController{
+			V1Alpha1Events: ctrl.v1alpha1Runtime.Events(),
+		},
 		&block.VolumeManagerController{},
 		&block.ZswapConfigController{},
 		&block.ZswapStatusController{
@@ -178,6 +194,9 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		cluster.NewConfigController(),
 		&cluster.DiscoveryServiceController{},
 		&cluster.EndpointController{},
+		&cluster.IdentityConflictController{
+			V1Alpha1Events: ctrl.v1alpha1Runtime.Events(),
+		},
 		cluster.NewInfoController(),
 		&cluster.KubernetesPullController{},
 		&cluster.KubernetesPushController{},
@@ -201,6 +220,10 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		},
 		&config.MachineTypeController{},
 		&config.PersistenceController{},
+		&config.ValidationStatusController{
+			ValidationMode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
+		},
+		&cri.DefaultSecurityProfilesController{},
 		&cri.ImageCacheConfigController{
 			V1Alpha1ServiceManager: system.Services(ctrl.v1alpha1Runtime),
 		},
@@ -212,9 +235,21 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		},
 		&etcd.AdvertisedPeerController{},
 		etcd.NewConfigController(),
+		&etcd.DefragmentationController{
+			V1Alpha1Events: ctrl.v1alpha1Runtime.Events(),
+		},
 		&etcd.PKIController{},
+		&etcd.SnapshotController{
+			V1Alpha1Events: ctrl.v1alpha1Runtime.Events(),
+		},
 		&etcd.SpecController{},
-		&etcd.MemberController{},
+		&etcd.StatusController{
+			V1Alpha1Events: ctrl.v1alpha1Runtime.Events(),
+		},
+		&etcd.MemberController{
+			V1Alpha1Events: ctrl.v1alpha1Runtime.Events(),
+		},
+		&files.ContainerdConfigPatchController{},
 		&files.CRIBaseRuntimeSpecController{},
 		&files.CRIConfigPartsController{},
 		&files.CRIRegistryConfigController{
@@ -231,6 +266,9 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&files.NQNController{
 			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
 		},
+		&hardware.CPUIsolationController{
+			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
+		},
 		&hardware.PCIDevicesController{
 			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
 		},
@@ -255,6 +293,7 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&k8s.EndpointController{},
 		&k8s.ExtraManifestController{},
 		k8s.NewKubeletConfigController(),
+		&k8s.KubeletCSRApproverController{},
 		&k8s.KubeletServiceController{
 			V1Alpha1Services: system.Services(ctrl.v1alpha1Runtime),
 			V1Alpha1Mode:     ctrl.v1alpha1Runtime.State().Platform().Mode(),
@@ -281,6 +320,9 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&k8s.RenderSecretsStaticPodController{},
 		&k8s.StaticEndpointController{},
 		&k8s.StaticPodConfigController{},
+		&k8s.StaticPodRuntimeStatusController{
+			V1Alpha1Events: ctrl.v1alpha1Runtime.Events(),
+		},
 		&k8s.StaticPodServerController{},
 		kubeaccess.NewConfigController(),
 		&kubeaccess.CRDController{},
@@ -300,7 +342,12 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		network.NewAddressMergeController(),
 		&network.AddressSpecController{},
 		&network.AddressStatusController{},
+		&network.BondStatusController{},
+		&network.BridgeStatusController{},
+		&network.DefaultRouteStatusController{},
 		&network.DeviceConfigController{},
+		&network.DHCP4LeaseLoadController{},
+		&network.DHCP4LeaseStoreController{},
 		&network.DNSResolveCacheController{
 			State:  ctrl.v1alpha1Runtime.State().V1Alpha2().Resources(),
 			Logger: dnsCacheLogger,
@@ -323,6 +370,7 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&network.HostnameSpecController{
 			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
 		},
+		&network.LLDPController{},
 		&network.LinkConfigController{
 			Cmdline: procfs.ProcCmdline(),
 		},
@@ -351,7 +399,11 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		},
 		&network.PlatformConfigLoadController{},
 		&network.PlatformConfigStoreController{},
+		&network.ProbeConfigController{},
 		&network.ProbeController{},
+		&network.ProbeEventController{
+			V1Alpha1Events: ctrl.v1alpha1Runtime.Events(),
+		},
 		&network.ResolverConfigController{
 			Cmdline: procfs.ProcCmdline(),
 		},
@@ -361,8 +413,18 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 			Cmdline: procfs.ProcCmdline(),
 		},
 		network.NewRouteMergeController(),
+		&network.RouteRuleConfigController{},
+		network.NewRouteRuleMergeController(),
+		&network.RouteRuleSpecController{},
+		&network.RouteRuleStatusController{},
 		&network.RouteSpecController{},
 		&network.RouteStatusController{},
+		&network.RouterAdvertisementController{},
+		&network.SRIOVConfigController{},
+		&network.SRIOVSpecController{},
+		&network.StaticFallbackEventController{
+			V1Alpha1Events: ctrl.v1alpha1Runtime.Events(),
+		},
 		&network.StatusController{
 			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
 		},
@@ -371,10 +433,17 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		},
 		network.NewTimeServerMergeController(),
 		&network.TimeServerSpecController{},
+		&network.VIPEventController{
+			V1Alpha1Events: ctrl.v1alpha1Runtime.Events(),
+		},
+		&network.WireguardEndpointController{},
 		&perf.StatsController{},
 		&runtimecontrollers.BootedEntryController{
 			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
 		},
+		&runtimecontrollers.ContainerdRestartController{
+			V1Alpha1Services: system.Services(ctrl.v1alpha1Runtime),
+		},
 		&runtimecontrollers.CRIImageGCController{},
 		&runtimecontrollers.DevicesStatusController{
 			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
@@ -393,6 +462,9 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 			Cmdline:      procfs.ProcCmdline(),
 			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
 		},
+		&runtimecontrollers.EventLogController{
+			V1Alpha1Events: ctrl.v1alpha1Runtime.Events(),
+		},
 		&runtimecontrollers.EventsSinkController{
 			V1Alpha1Events: ctrl.v1alpha1Runtime.Events(),
 			Drainer:        drainer,
@@ -402,6 +474,7 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 			ConfigPath:       constants.ExtensionServiceConfigPath,
 		},
 		&runtimecontrollers.ExtensionStatusController{},
+		&runtimecontrollers.ExtensionVerificationController{},
 		&runtimecontrollers.KernelCmdlineController{
 			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
 		},
@@ -434,18 +507,42 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 			V1Alpha1Events: ctrl.v1alpha1Runtime.Events(),
 		},
 		&runtimecontrollers.MountStatusController{},
+		&runtimecontrollers.ProcessReaperController{
+			V1Alpha1Events: ctrl.v1alpha1Runtime.Events(),
+		},
+		&runtimecontrollers.RecoveryServiceController{
+			Cmdline:      procfs.ProcCmdline(),
+			MetaProvider: ctrl.v1alpha1Runtime.State().Machine(),
+			State:        ctrl.v1alpha1Runtime.State().V1Alpha2().Resources(),
+		},
 		&runtimecontrollers.SBOMItemController{},
+		&runtimecontrollers.SecureBootStatusController{
+			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
+		},
 		&runtimecontrollers.SecurityStateController{
 			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
 		},
+		&runtimecontrollers.ServiceResourcesController{},
+		&runtimecontrollers.TPMStatusController{
+			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
+		},
 		&runtimecontrollers.UniqueMachineTokenController{},
+		&runtimecontrollers.UpgradeStatusController{
+			MetaProvider:   ctrl.v1alpha1Runtime.State().Machine(),
+			V1Alpha1Events: ctrl.v1alpha1Runtime.Events(),
+		},
 		&runtimecontrollers.VersionController{},
 		&runtimecontrollers.WatchdogTimerConfigController{},
 		&runtimecontrollers.WatchdogTimerController{},
 		&secrets.APICertSANsController{},
 		&secrets.APIController{},
+		secrets.NewCertificateRevocationController(),
+		&secrets.CertificateStatusController{
+			V1Alpha1Events: ctrl.v1alpha1Runtime.Events(),
+		},
 		&secrets.EncryptionSaltController{},
 		&secrets.EtcdController{},
+		&secrets.JoinTokenController{},
 		secrets.NewKubeletController(),
 		&secrets.KubernetesCertSANsController{},
 		&secrets.KubernetesDynamicCertsController{},
@@ -453,11 +550,13 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&secrets.MaintenanceController{},
 		&secrets.MaintenanceCertSANsController{},
 		&secrets.MaintenanceRootController{},
+		&secrets.OSRootStatusController{},
 		secrets.NewRootEtcdController(),
 		secrets.NewRootKubernetesController(),
 		secrets.NewRootOSController(),
 		&secrets.TrustedRootsController{},
 		&secrets.TrustdController{},
+		secrets.NewTrustdPolicyController(),
 		&siderolink.ConfigController{
 			Cmdline:      procfs.ProcCmdline(),
 			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
@@ -485,6 +584,11 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 	return ctrl.controllerRuntime.Run(ctx)
 }
 
+// ResourceHistory returns the recorder of the resource revisions.
+func (ctrl *Controller) ResourceHistory() *resourcehistory.Recorder {
+	return ctrl.resourceHistory
+}
+
 // DependencyGraph returns controller-resources dependencies.
 func (ctrl *Controller) DependencyGraph() (*controller.DependencyGraph, error) {
 	return ctrl.controllerRuntime.GetDependencyGraph()
@@ -494,6 +598,10 @@ type loggingDestination struct {
 	Format    string
 	Endpoint  *url.URL
 	ExtraTags map[string]string
+
+	TLSCA  []byte
+	TLSCrt []byte
+	TLSKey []byte
 }
 
 func (a *loggingDestination) Equal(b *loggingDestination) bool {
@@ -501,6 +609,10 @@ func (a *loggingDestination) Equal(b *loggingDestination) bool {
 		return false
 	}
 
+	if !bytes.Equal(a.TLSCA, b.TLSCA) || !bytes.Equal(a.TLSCrt, b.TLSCrt) || !bytes.Equal(a.TLSKey, b.TLSKey) {
+		return false
+	}
+
 	if a.Endpoint.String() != b.Endpoint.String() {
 		return false
 	}
@@ -575,12 +687,21 @@ func (ctrl *Controller) updateLoggingConfig(ctx context.Context, dests []talosco
 
 	for i, dest := range dests {
 		switch f := dest.Format(); f {
-		case constants.LoggingFormatJSONLines:
+		case constants.LoggingFormatJSONLines, constants.LoggingFormatSyslog:
 			loggingDestinations[i] = loggingDestination{
 				Format:    f,
 				Endpoint:  dest.Endpoint(),
 				ExtraTags: dest.ExtraTags(),
 			}
+
+			if tlsConfig := dest.TLS(); tlsConfig != nil {
+				loggingDestinations[i].TLSCA = tlsConfig.CA()
+
+				if identity := tlsConfig.ClientIdentity(); identity != nil {
+					loggingDestinations[i].TLSCrt = identity.Crt
+					loggingDestinations[i].TLSKey = identity.Key
+				}
+			}
 		default:
 			// should not be possible due to validation
 			panic(fmt.Sprintf("unhandled log destination format %q", f))
@@ -607,12 +728,23 @@ func (ctrl *Controller) updateLoggingConfig(ctx context.Context, dests []talosco
 	var prevSenders []runtime.LogSender
 
 	if len(loggingDestinations) > 0 {
-		senders := xslices.Map(dests, runtimelogging.NewJSONLines)
+		// each destination has its own buffer, so that a slow or unavailable destination doesn't block the others
+		senders := xslices.Map(dests, func(dest talosconfig.LoggingDestination) runtime.LogSender {
+			var sender runtime.LogSender
+
+			if dest.Format() == constants.LoggingFormatSyslog {
+				sender = runtimelogging.NewSyslog(dest, runtimelogging.SyslogFacilityDaemon)
+			} else {
+				sender = runtimelogging.NewJSONLines(dest)
+			}
+
+			return runtimelogging.NewBuffered(sender, runtimelogging.DefaultBufferOptions())
+		})
 
-		ctrl.logger.Info("enabling JSON logging")
+		ctrl.logger.Info("enabling remote logging")
 		prevSenders = ctrl.loggingManager.SetSenders(senders)
 	} else {
-		ctrl.logger.Info("disabling JSON logging")
+		ctrl.logger.Info("disabling remote logging")
 		prevSenders = ctrl.loggingManager.SetSenders(nil)
 	}
 
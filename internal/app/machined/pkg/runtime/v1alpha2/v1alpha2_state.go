@@ -7,6 +7,7 @@ package v1alpha2
 import (
 	"context"
 
+	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
@@ -40,11 +41,15 @@ type State struct {
 
 	namespaceRegistry *registry.NamespaceRegistry
 	resourceRegistry  *registry.ResourceRegistry
+
+	registeredResources map[resource.Type]meta.ResourceWithRD
 }
 
 // NewState creates State.
 func NewState() (*State, error) {
-	s := &State{}
+	s := &State{
+		registeredResources: map[resource.Type]meta.ResourceWithRD{},
+	}
 
 	ctx := context.TODO()
 
@@ -108,6 +113,8 @@ func NewState() (*State, error) {
 		&block.SystemDisk{},
 		&block.UserDiskConfigStatus{},
 		&block.VolumeConfig{},
+		&block.VolumeKeyRotation{},
+		&block.VolumeKeyStatus{},
 		&block.VolumeLifecycle{},
 		&block.VolumeMountRequest{},
 		&block.VolumeMountStatus{},
@@ -116,18 +123,25 @@ func NewState() (*State, error) {
 		&cluster.Affiliate{},
 		&cluster.Config{},
 		&cluster.Identity{},
+		&cluster.IdentityConflict{},
+		&cluster.IdentityReset{},
 		&cluster.Info{},
 		&cluster.Member{},
 		&config.MachineConfig{},
 		&config.MachineType{},
 		&cri.ImageCacheConfig{},
 		&cri.SeccompProfile{},
+		&cri.DefaultSecurityProfiles{},
 		&etcd.Config{},
 		&etcd.PKIStatus{},
 		&etcd.Spec{},
 		&etcd.Member{},
+		&etcd.DefragmentationStatus{},
+		&etcd.SnapshotStatus{},
+		&etcd.Status{},
 		&files.EtcFileSpec{},
 		&files.EtcFileStatus{},
+		&hardware.CPUIsolationStatus{},
 		&hardware.MemoryModule{},
 		&hardware.PCIDevice{},
 		&hardware.PCIDriverRebindConfig{},
@@ -149,6 +163,7 @@ func NewState() (*State, error) {
 		&k8s.KubeletSpec{},
 		&k8s.KubePrismConfig{},
 		&k8s.KubePrismStatuses{},
+		&k8s.KubernetesCertSANStatus{},
 		&k8s.Manifest{},
 		&k8s.ManifestStatus{},
 		&k8s.BootstrapManifestsConfig{},
@@ -163,6 +178,7 @@ func NewState() (*State, error) {
 		&k8s.SchedulerConfig{},
 		&k8s.StaticPod{},
 		&k8s.StaticPodServerStatus{},
+		&k8s.StaticPodRuntimeStatus{},
 		&k8s.StaticPodStatus{},
 		&k8s.SecretsStatus{},
 		&kubeaccess.Config{},
@@ -173,15 +189,20 @@ func NewState() (*State, error) {
 		&kubespan.PeerStatus{},
 		&network.AddressStatus{},
 		&network.AddressSpec{},
+		&network.BondStatus{},
+		&network.BridgeStatus{},
 		&network.DeviceConfigSpec{},
+		&network.DHCP4Lease{},
 		&network.DNSResolveCache{},
 		&network.DNSUpstream{},
+		&network.DefaultRouteStatus{},
 		&network.EthernetSpec{},
 		&network.EthernetStatus{},
 		&network.HardwareAddr{},
 		&network.HostDNSConfig{},
 		&network.HostnameStatus{},
 		&network.HostnameSpec{},
+		&network.LLDPNeighborStatus{},
 		&network.LinkRefresh{},
 		&network.LinkStatus{},
 		&network.LinkSpec{},
@@ -196,46 +217,70 @@ func NewState() (*State, error) {
 		&network.ResolverStatus{},
 		&network.ResolverSpec{},
 		&network.RouteStatus{},
+		&network.RouteRuleSpec{},
+		&network.RouteRuleStatus{},
 		&network.RouteSpec{},
+		&network.RouterStatus{},
+		&network.SRIOVSpec{},
+		&network.SRIOVStatus{},
+		&network.StaticFallbackStatus{},
 		&network.Status{},
 		&network.TimeServerStatus{},
 		&network.TimeServerSpec{},
+		&network.VIPStatus{},
+		&network.WireguardEndpointStatus{},
 		&perf.CPU{},
 		&perf.Memory{},
 		&cri.RegistriesConfig{},
 		&runtime.BootedEntry{},
+		&runtime.ConfigValidationStatus{},
 		&runtime.DevicesStatus{},
 		&runtime.Diagnostic{},
 		&runtime.EventSinkConfig{},
 		&runtime.ExtensionServiceConfig{},
 		&runtime.ExtensionServiceConfigStatus{},
 		&runtime.ExtensionStatus{},
+		&runtime.ExtensionVerificationStatus{},
 		&runtime.KernelCmdline{},
 		&runtime.KernelModuleSpec{},
+		&runtime.KernelModuleStatus{},
 		&runtime.KernelParamSpec{},
 		&runtime.KernelParamDefaultSpec{},
 		&runtime.KernelParamStatus{},
 		&runtime.KmsgLogConfig{},
+		&runtime.KmsgLogDeliveryStatus{},
 		&runtime.LoadedKernelModule{},
 		&runtime.MaintenanceServiceConfig{},
 		&runtime.MaintenanceServiceRequest{},
 		&runtime.MachineResetSignal{},
 		&runtime.MachineStatus{},
+		&runtime.MeasuredBootStatus{},
 		&runtime.MetaKey{},
 		&runtime.MetaLoaded{},
 		&runtime.MountStatus{},
 		&runtime.PlatformMetadata{},
+		&runtime.ProcessReaperStatus{},
 		&runtime.SBOMItem{},
+		&runtime.SecureBootStatus{},
 		&runtime.SecurityState{},
+		&runtime.ServiceResourcesStatus{},
+		&runtime.ShutdownInhibitor{},
+		&runtime.SysctlStatus{},
+		&runtime.TPMStatus{},
 		&runtime.UniqueMachineToken{},
+		&runtime.UpgradeStatus{},
 		&runtime.Version{},
 		&runtime.WatchdogTimerConfig{},
 		&runtime.WatchdogTimerStatus{},
 		&secrets.API{},
 		&secrets.CertSAN{},
+		&secrets.CertificateRevocation{},
+		&secrets.CertificateRotation{},
+		&secrets.CertificateStatus{},
 		&secrets.EncryptionSalt{},
 		&secrets.Etcd{},
 		&secrets.EtcdRoot{},
+		&secrets.JoinToken{},
 		&secrets.Kubelet{},
 		&secrets.Kubernetes{},
 		&secrets.KubernetesDynamicCerts{},
@@ -243,11 +288,14 @@ func NewState() (*State, error) {
 		&secrets.MaintenanceServiceCerts{},
 		&secrets.MaintenanceRoot{},
 		&secrets.OSRoot{},
+		&secrets.OSRootStatus{},
 		&secrets.Trustd{},
+		&secrets.TrustdPolicy{},
 		&siderolink.Config{},
 		&siderolink.Status{},
 		&siderolink.Tunnel{},
 		&time.AdjtimeStatus{},
+		&time.SourceStatus{},
 		&time.Status{},
 		&v1alpha1.AcquireConfigSpec{},
 		&v1alpha1.AcquireConfigStatus{},
@@ -256,6 +304,8 @@ func NewState() (*State, error) {
 		if err := s.resourceRegistry.Register(ctx, r); err != nil {
 			return nil, err
 		}
+
+		s.registeredResources[r.ResourceDefinition().Type] = r
 	}
 
 	return s, nil
@@ -276,6 +326,13 @@ func (s *State) ResourceRegistry() *registry.ResourceRegistry {
 	return s.resourceRegistry
 }
 
+// RegisteredResource implements runtime.V1alpha2State interface.
+func (s *State) RegisteredResource(resourceType resource.Type) (meta.ResourceWithRD, bool) {
+	r, ok := s.registeredResources[resourceType]
+
+	return r, ok
+}
+
 // GetConfig implements runtime.V1alpha2State interface.
 func (s *State) GetConfig(ctx context.Context) (talosconfig.Provider, error) {
 	cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, s.resources, config.ActiveID)
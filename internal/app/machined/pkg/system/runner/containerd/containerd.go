@@ -171,7 +171,7 @@ func (c *containerdRunner) Run(eventSink events.Recorder) error {
 		return fmt.Errorf("error creating log: %w", err)
 	}
 
-	cg, err := cgroup.CreateCgroup(c.opts.CgroupPath)
+	cg, err := cgroup.CreateCgroup(c.opts.CgroupPath, c.opts.CgroupCreateOptions()...)
 	if err != nil {
 		return fmt.Errorf("error creating cgroup: %w", err)
 	}
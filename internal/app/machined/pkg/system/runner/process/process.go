@@ -415,7 +415,7 @@ func setSchedulingPolicy(p *processRunner, pid int, schedulingPolicy uint) error
 
 //nolint:gocyclo
 func (p *processRunner) run(eventSink events.Recorder) error {
-	cg, err := cgroup.CreateCgroup(p.opts.CgroupPath)
+	cg, err := cgroup.CreateCgroup(p.opts.CgroupPath, p.opts.CgroupCreateOptions()...)
 	if err != nil {
 		return fmt.Errorf("error creating cgroup: %w", err)
 	}
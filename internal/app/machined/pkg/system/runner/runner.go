@@ -22,6 +22,7 @@ import (
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/logging"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/system/events"
+	"github.com/siderolabs/talos/internal/pkg/cgroup"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 )
 
@@ -72,6 +73,8 @@ type Options struct {
 	OOMScoreAdj int
 	// CgroupPath (optional) sets the cgroup path to use
 	CgroupPath string
+	// MemoryProtection (optional) overrides the default memory protection of the cgroup.
+	MemoryProtection optional.Optional[cgroup.MemoryProtection]
 	// OverrideSeccompProfile default Linux seccomp profile.
 	OverrideSeccompProfile func(*specs.LinuxSeccomp)
 	// DroppedCapabilities is the list of capabilities to drop.
@@ -189,6 +192,13 @@ func WithCgroupPath(path string) Option {
 	}
 }
 
+// WithMemoryProtection sets the memory protection of the cgroup.
+func WithMemoryProtection(p cgroup.MemoryProtection) Option {
+	return func(args *Options) {
+		args.MemoryProtection = optional.Some(p)
+	}
+}
+
 // WithSelinuxLabel sets the SELinux label.
 func WithSelinuxLabel(label string) Option {
 	return func(args *Options) {
@@ -245,6 +255,15 @@ func WithUID(uid uint32) Option {
 	}
 }
 
+// CgroupCreateOptions returns the options to create the cgroup of the process.
+func (o *Options) CgroupCreateOptions() []cgroup.CreateOption {
+	if p, ok := o.MemoryProtection.Get(); ok {
+		return []cgroup.CreateOption{cgroup.WithMemoryProtection(p)}
+	}
+
+	return nil
+}
+
 // WithMemoryReservation sets the memory reservation limit as on OCI spec.
 func WithMemoryReservation(limit uint64) oci.SpecOpts {
 	return func(_ context.Context, _ oci.Client, _ *ocicontainers.Container, s *oci.Spec) error {
@@ -0,0 +1,55 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package system
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/siderolabs/talos/pkg/conditions"
+	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+// ConditionKind describes the source of the condition the service waits for.
+type ConditionKind string
+
+// Condition kinds.
+const (
+	// ConditionKindService is a dependency on another service.
+	ConditionKindService = ConditionKind("service")
+	// ConditionKindVolume is a volume required by the service.
+	ConditionKindVolume = ConditionKind("volume")
+	// ConditionKindCondition is a condition declared by the service itself.
+	ConditionKindCondition = ConditionKind("condition")
+)
+
+// trackedCondition wraps the condition to record whether it was satisfied.
+type trackedCondition struct {
+	conditions.Condition
+
+	kind      ConditionKind
+	target    string
+	satisfied atomic.Bool
+}
+
+func (c *trackedCondition) Wait(ctx context.Context) error {
+	if err := c.Condition.Wait(ctx); err != nil {
+		return err
+	}
+
+	c.satisfied.Store(true)
+
+	return nil
+}
+
+// AsProto returns protobuf struct with the state of the condition.
+func (c *trackedCondition) AsProto() *machineapi.ServiceCondition {
+	return &machineapi.ServiceCondition{
+		Kind:        string(c.kind),
+		Description: c.String(),
+		Target:      c.target,
+		Satisfied:   c.satisfied.Load(),
+	}
+}
@@ -45,6 +45,8 @@ type ServiceRunner struct {
 
 	healthState health.State
 
+	conditions []*trackedCondition
+
 	stateSubscribers map[StateEvent][]chan<- struct{}
 
 	stopCh chan struct{}
@@ -219,13 +221,16 @@ func (svcrunner *ServiceRunner) Run(notifyChannels ...chan<- struct{}) error {
 		close(notifyCh)
 	}
 
-	condition := svcrunner.service.Condition(svcrunner.runtime)
+	tracked := xslices.Map(conditions.Unwrap(svcrunner.service.Condition(svcrunner.runtime)), func(c conditions.Condition) *trackedCondition {
+		return &trackedCondition{Condition: c, kind: ConditionKindCondition}
+	})
 
 	if dependencies := svcrunner.service.DependsOn(svcrunner.runtime); len(dependencies) > 0 {
-		serviceConditions := xslices.Map(dependencies, func(dep string) conditions.Condition { return waitForService(instance, StateEventUp, dep) })
-		serviceDependencies := conditions.WaitForAll(serviceConditions...)
+		serviceConditions := xslices.Map(dependencies, func(dep string) *trackedCondition {
+			return &trackedCondition{Condition: waitForService(instance, StateEventUp, dep), kind: ConditionKindService, target: dep}
+		})
 
-		condition = conditions.WaitForAll(serviceDependencies, condition)
+		tracked = append(serviceConditions, tracked...)
 	}
 
 	if volumeIDs := svcrunner.service.Volumes(svcrunner.runtime); len(volumeIDs) > 0 {
@@ -242,11 +247,15 @@ func (svcrunner *ServiceRunner) Run(notifyChannels ...chan<- struct{}) error {
 		}
 
 		// the condition will wait for volume mount statuses, and put a finalizer on it
-		volumeConditions := xslices.Map(volumeRequests, func(request volumeRequest) conditions.Condition {
-			return WaitForVolumeToBeMounted(svcrunner.runtime.State().V1Alpha2().Resources(), request.requestID, request.volumeID)
+		volumeConditions := xslices.Map(volumeRequests, func(request volumeRequest) *trackedCondition {
+			return &trackedCondition{
+				Condition: WaitForVolumeToBeMounted(svcrunner.runtime.State().V1Alpha2().Resources(), request.requestID, request.volumeID),
+				kind:      ConditionKindVolume,
+				target:    request.volumeID,
+			}
 		})
 
-		condition = conditions.WaitForAll(conditions.WaitForAll(volumeConditions...), condition)
+		tracked = append(volumeConditions, tracked...)
 
 		// cleanup volume mounts
 		defer func() {
@@ -259,7 +268,13 @@ func (svcrunner *ServiceRunner) Run(notifyChannels ...chan<- struct{}) error {
 		}()
 	}
 
-	if condition != nil {
+	svcrunner.mu.Lock()
+	svcrunner.conditions = tracked
+	svcrunner.mu.Unlock()
+
+	if len(tracked) > 0 {
+		condition := conditions.WaitForAll(xslices.Map(tracked, func(c *trackedCondition) conditions.Condition { return c })...)
+
 		if err := svcrunner.waitFor(ctx, condition); err != nil {
 			return fmt.Errorf("condition failed: %w", err)
 		}
@@ -404,6 +419,21 @@ func (svcrunner *ServiceRunner) AsProto() *machineapi.ServiceInfo {
 	}
 }
 
+// DependenciesAsProto returns protobuf struct with the dependencies and the conditions of the service.
+//
+// The conditions are reported for the last run of the service, so they are empty until the service is started.
+func (svcrunner *ServiceRunner) DependenciesAsProto() *machineapi.ServiceDependencyInfo {
+	svcrunner.mu.Lock()
+	defer svcrunner.mu.Unlock()
+
+	return &machineapi.ServiceDependencyInfo{
+		Id:         svcrunner.id,
+		State:      svcrunner.state.String(),
+		DependsOn:  svcrunner.service.DependsOn(svcrunner.runtime),
+		Conditions: xslices.Map(svcrunner.conditions, (*trackedCondition).AsProto),
+	}
+}
+
 // Subscribe to a specific event for this service.
 //
 // Channel `ch` should be buffered or it should have listener attached to it,
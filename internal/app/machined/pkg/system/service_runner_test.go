@@ -257,6 +257,65 @@ func (suite *ServiceRunnerSuite) TestWaitingDescriptionChange() {
 	suite.Assert().Equal("Waiting for cond2", events[2].Message)
 }
 
+func (suite *ServiceRunnerSuite) TestConditions() {
+	cond1 := NewMockCondition("cond1")
+	cond2 := NewMockCondition("cond2")
+	sr := system.NewServiceRunner(system.Services(nil), &MockService{
+		condition: conditions.WaitForAll(cond1, cond2),
+	}, nil)
+
+	suite.Assert().Empty(sr.DependenciesAsProto().Conditions)
+
+	errCh := make(chan error)
+
+	go func() {
+		errCh <- sr.Run()
+	}()
+
+	satisfied := func(expected ...bool) func() error {
+		return func() error {
+			conds := sr.DependenciesAsProto().Conditions
+
+			actual := make([]bool, 0, len(conds))
+
+			for _, cond := range conds {
+				actual = append(actual, cond.Satisfied)
+			}
+
+			if len(actual) != len(expected) {
+				return retry.ExpectedErrorf("expected %d conditions, got %d", len(expected), len(actual))
+			}
+
+			for i := range expected {
+				if actual[i] != expected[i] {
+					return retry.ExpectedErrorf("unexpected condition state: %v", actual)
+				}
+			}
+
+			return nil
+		}
+	}
+
+	suite.Require().NoError(retry.Constant(time.Minute, retry.WithUnits(10*time.Millisecond)).Retry(satisfied(false, false)))
+
+	conds := sr.DependenciesAsProto().Conditions
+	suite.Assert().Equal("condition", conds[0].Kind)
+	suite.Assert().Equal("cond1", conds[0].Description)
+	suite.Assert().Equal("cond2", conds[1].Description)
+
+	close(cond1.done)
+
+	suite.Require().NoError(retry.Constant(time.Minute, retry.WithUnits(10*time.Millisecond)).Retry(satisfied(true, false)))
+
+	close(cond2.done)
+
+	suite.Require().NoError(retry.Constant(time.Minute, retry.WithUnits(10*time.Millisecond)).Retry(satisfied(true, true)))
+
+	sr.Shutdown()
+
+	suite.Assert().NoError(<-errCh)
+}
+
 func (suite *ServiceRunnerSuite) TestPreStageFail() {
 	svc := &MockService{
 		preError: errors.New("pre failed"),
@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/netip"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -22,8 +23,12 @@ import (
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/cosi-project/runtime/pkg/state/protobuf/server"
 	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/siderolabs/gen/xslices"
 	"github.com/siderolabs/go-debug"
+	eventsapi "github.com/siderolabs/siderolink/api/events"
+	siderolinkevents "github.com/siderolabs/siderolink/pkg/events"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/system"
@@ -35,8 +40,10 @@ import (
 	"github.com/siderolabs/talos/internal/pkg/environment"
 	"github.com/siderolabs/talos/internal/pkg/selinux"
 	"github.com/siderolabs/talos/pkg/conditions"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/fipsmode"
+	"github.com/siderolabs/talos/pkg/machinery/resources/cluster"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
 )
@@ -67,6 +74,10 @@ func apidResourceFilter(_ context.Context, access state.Access) error {
 		// allowed, contains local node addresses
 	case access.ResourceNamespace == network.NamespaceName && access.ResourceType == network.HostnameStatusType:
 		// allowed, contains local node hostname
+	case access.ResourceNamespace == cluster.NamespaceName && access.ResourceType == cluster.MemberType:
+		// allowed, contains cluster members for proxy target checks
+	case access.ResourceNamespace == secrets.NamespaceName && access.ResourceType == secrets.CertificateRevocationType && access.ResourceID == secrets.CertificateRevocationID:
+		// allowed, contains revoked client certificates
 	default:
 		return errors.New("access denied")
 	}
@@ -113,6 +124,14 @@ func (o *APID) PreFunc(ctx context.Context, r runtime.Runtime) error {
 	)
 	v1alpha1.RegisterStateServer(o.runtimeServer, server.NewState(resources))
 
+	// apid reports the requests rejected because of the revoked client certificates, only the audit events are accepted
+	eventsapi.RegisterEventSinkServiceServer(o.runtimeServer, siderolinkevents.NewSink(
+		&auditAdapter{events: r.Events()},
+		[]proto.Message{
+			&machine.CertificateRevokedEvent{},
+		},
+	))
+
 	go o.runtimeServer.Serve(listener) //nolint:errcheck
 
 	return prepareRootfs(o.ID(r))
@@ -170,6 +189,14 @@ func (o *APID) Runner(r runtime.Runtime) (runner.Runner, error) {
 		args.ProcessArgs = append(args.ProcessArgs, "--enable-ext-key-usage-check")
 	}
 
+	if proxyTargetCheck := r.Config().Machine().Features().ApidProxyTargetCheck(); proxyTargetCheck.Enabled() {
+		args.ProcessArgs = append(args.ProcessArgs, "--enable-proxy-target-check")
+
+		if allowedCIDRs := proxyTargetCheck.AllowedCIDRs(); len(allowedCIDRs) > 0 {
+			args.ProcessArgs = append(args.ProcessArgs, "--proxy-target-allowed-cidrs="+strings.Join(xslices.Map(allowedCIDRs, netip.Prefix.String), ","))
+		}
+	}
+
 	// Set the mounts.
 	mounts := []specs.Mount{
 		{Type: "bind", Destination: "/etc/ssl", Source: "/etc/ssl", Options: []string{"bind", "ro"}},
@@ -209,6 +236,8 @@ func (o *APID) Runner(r runtime.Runtime) (runner.Runner, error) {
 		env = append(env, fipsmode.StrictEnvironmentVariable())
 	}
 
+	resources, _ := GetSystemServiceResources(r.Config(), o.ID(r))
+
 	return restart.New(containerd.NewRunner(
 		r.Config().Debug(),
 		&args,
@@ -216,7 +245,8 @@ func (o *APID) Runner(r runtime.Runtime) (runner.Runner, error) {
 		runner.WithContainerdAddress(constants.SystemContainerdAddress),
 		runner.WithEnv(env),
 		runner.WithGracefulShutdownTimeout(15*time.Second),
-		runner.WithCgroupPath(constants.CgroupApid),
+		runner.WithCgroupPath(resources.CgroupPath),
+		runner.WithMemoryProtection(resources.MemoryProtection),
 		runner.WithSelinuxLabel(constants.SelinuxLabelApid),
 		runner.WithOCISpecOpts(
 			oci.WithDroppedCapabilities(cap.Known()),
@@ -226,7 +256,7 @@ func (o *APID) Runner(r runtime.Runtime) (runner.Runner, error) {
 			oci.WithRootFSReadonly(),
 			oci.WithUser(fmt.Sprintf("%d:%d", constants.ApidUserID, constants.ApidUserID)),
 		),
-		runner.WithOOMScoreAdj(-998),
+		runner.WithOOMScoreAdj(resources.OOMScoreAdj),
 	),
 		restart.WithType(restart.Forever),
 	), nil
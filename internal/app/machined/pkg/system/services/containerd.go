@@ -105,6 +105,8 @@ func (c *Containerd) Runner(r runtime.Runtime) (runner.Runner, error) {
 		debug = r.Config().Debug()
 	}
 
+	resources, _ := GetSystemServiceResources(r.Config(), c.ID(r))
+
 	return restart.New(process.NewRunner(
 		debug,
 		args,
@@ -115,8 +117,9 @@ func (c *Containerd) Runner(r runtime.Runtime) (runner.Runner, error) {
 			// see https://specifications.freedesktop.org/basedir-spec/basedir-spec-latest.html
 			"XDG_RUNTIME_DIR=/run",
 		)),
-		runner.WithOOMScoreAdj(-999),
-		runner.WithCgroupPath(constants.CgroupSystemRuntime),
+		runner.WithOOMScoreAdj(resources.OOMScoreAdj),
+		runner.WithCgroupPath(resources.CgroupPath),
+		runner.WithMemoryProtection(resources.MemoryProtection),
 		runner.WithSelinuxLabel(constants.SelinuxLabelSystemRuntime),
 		runner.WithDroppedCapabilities(constants.DefaultDroppedCapabilities),
 	),
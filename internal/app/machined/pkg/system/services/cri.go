@@ -116,6 +116,8 @@ func (c *CRI) Runner(r runtime.Runtime) (runner.Runner, error) {
 		},
 	}
 
+	resources, _ := GetSystemServiceResources(r.Config(), c.ID(r))
+
 	return restart.New(process.NewRunner(
 		r.Config().Debug(),
 		args,
@@ -126,8 +128,9 @@ func (c *CRI) Runner(r runtime.Runtime) (runner.Runner, error) {
 			// see https://specifications.freedesktop.org/basedir-spec/basedir-spec-latest.html
 			"XDG_RUNTIME_DIR=/run",
 		)),
-		runner.WithOOMScoreAdj(-500),
-		runner.WithCgroupPath(constants.CgroupPodRuntime),
+		runner.WithOOMScoreAdj(resources.OOMScoreAdj),
+		runner.WithCgroupPath(resources.CgroupPath),
+		runner.WithMemoryProtection(resources.MemoryProtection),
 		runner.WithSelinuxLabel(constants.SelinuxLabelPodRuntime),
 		runner.WithDroppedCapabilities(constants.DefaultDroppedCapabilities),
 	),
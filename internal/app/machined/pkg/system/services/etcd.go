@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"math"
 	"net/netip"
 	"os"
 	goruntime "runtime"
@@ -207,6 +208,8 @@ func (e *Etcd) Runner(r runtime.Runtime) (runner.Runner, error) {
 		}()
 	}
 
+	resources, _ := GetSystemServiceResources(r.Config(), e.ID(r))
+
 	return restart.New(containerd.NewRunner(
 		r.Config().Debug(),
 		&args,
@@ -214,17 +217,17 @@ func (e *Etcd) Runner(r runtime.Runtime) (runner.Runner, error) {
 		runner.WithNamespace(constants.SystemContainerdNamespace),
 		runner.WithContainerImage(e.imgRef),
 		runner.WithEnv(env),
-		runner.WithCgroupPath(constants.CgroupEtcd),
+		runner.WithCgroupPath(resources.CgroupPath),
+		runner.WithMemoryProtection(resources.MemoryProtection),
 		runner.WithSelinuxLabel(constants.SELinuxLabelEtcd),
 		runner.WithOCISpecOpts(
 			oci.WithDroppedCapabilities(cap.Known()),
 			oci.WithHostNamespace(specs.NetworkNamespace),
 			oci.WithMounts(mounts),
 			oci.WithUser(fmt.Sprintf("%d:%d", constants.EtcdUserID, constants.EtcdUserID)),
-			runner.WithMemoryReservation(constants.CgroupEtcdReservedMemory),
 			oci.WithCPUShares(uint64(cgroup.MilliCoresToShares(constants.CgroupEtcdMillicores))),
 		),
-		runner.WithOOMScoreAdj(-998),
+		runner.WithOOMScoreAdj(resources.OOMScoreAdj),
 	),
 		restart.WithType(restart.Forever),
 	), nil
@@ -292,6 +295,10 @@ func addMember(ctx context.Context, r runtime.Runtime, addrs []string, name stri
 		}
 	}
 
+	if err = etcd.CheckLearnerLimit(list.Members, name, r.Config().Cluster().Etcd().MaxLearners()); err != nil {
+		return nil, 0, err
+	}
+
 	add, err := client.MemberAddAsLearner(ctx, addrs)
 	if err != nil {
 		return nil, 0, fmt.Errorf("error adding member: %w", err)
@@ -582,14 +589,27 @@ func promoteMember(ctx context.Context, r runtime.Runtime, memberID uint64) erro
 	// iterate over all endpoints until we find the one which works
 	// if we stick with the default behavior, we might hit the member being promoted, and that will never
 	// promote itself.
+	//
+	// the promotion is attempted until the service is stopped, learners which are not promoted
+	// for a long time are reported by the etcd member controller.
 	idx := 0
 
-	return retry.Constant(10*time.Minute,
+	return retry.Constant(time.Duration(math.MaxInt64),
 		retry.WithUnits(15*time.Second),
 		retry.WithAttemptTimeout(30*time.Second),
 		retry.WithJitter(time.Second),
 		retry.WithErrorLogging(true),
 	).RetryWithContext(ctx, func(ctx context.Context) error {
+		// wait for the learner to catch up according to the promotion policy
+		member, err := safe.StateGetByID[*etcdresource.Member](ctx, r.State().V1Alpha2().Resources(), etcdresource.LocalMemberID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return retry.ExpectedError(err)
+		}
+
+		if member != nil && member.TypedSpec().IsLearner && !member.TypedSpec().PromotionEligible {
+			return retry.ExpectedErrorf("learner lags behind by %d entries, waiting to catch up", member.TypedSpec().LearnerLag)
+		}
+
 		endpoints, err := etcd.GetEndpoints(ctx, r.State().V1Alpha2().Resources())
 		if err != nil {
 			return retry.ExpectedError(err)
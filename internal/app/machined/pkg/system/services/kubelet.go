@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"slices"
 	"sync"
 	"time"
 
@@ -30,9 +31,11 @@ import (
 	"github.com/siderolabs/talos/internal/pkg/capability"
 	"github.com/siderolabs/talos/internal/pkg/containers/image"
 	"github.com/siderolabs/talos/internal/pkg/environment"
+	mountv3 "github.com/siderolabs/talos/internal/pkg/mount/v3"
 	"github.com/siderolabs/talos/pkg/conditions"
 	"github.com/siderolabs/talos/pkg/machinery/config/machine"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/kubelet"
 	"github.com/siderolabs/talos/pkg/machinery/resources/cri"
 	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
@@ -96,7 +99,7 @@ func (k *Kubelet) PostFunc(runtime.Runtime, events.ServiceState) (err error) {
 func (k *Kubelet) Condition(r runtime.Runtime) conditions.Condition {
 	return conditions.WaitForAll(
 		timeresource.NewSyncCondition(r.State().V1Alpha2().Resources()),
-		network.NewReadyCondition(r.State().V1Alpha2().Resources(), network.AddressReady, network.HostnameReady, network.EtcFilesReady),
+		network.NewReadyCondition(r.State().V1Alpha2().Resources(), network.AddressReady, network.HostnameReady, network.EtcFilesReady, network.SRIOVReady),
 	)
 }
 
@@ -173,6 +176,10 @@ func (k *Kubelet) Runner(r runtime.Runtime) (runner.Runner, error) {
 	// allowlisted. There is the potential that a user can expose
 	// sensitive information.
 	for _, mount := range spec.ExtraMounts {
+		if slices.Contains(mount.Options, kubelet.MountOptionRecursiveReadOnly) && !mountv3.RecursiveReadOnlySupported() {
+			return nil, fmt.Errorf("extra mount %q: recursive read-only bind mounts (%q) are not supported by the running kernel", mount.Destination, kubelet.MountOptionRecursiveReadOnly)
+		}
+
 		if err = os.MkdirAll(mount.Source, 0o700); err != nil {
 			return nil, err
 		}
@@ -180,6 +187,8 @@ func (k *Kubelet) Runner(r runtime.Runtime) (runner.Runner, error) {
 		mounts = append(mounts, mount)
 	}
 
+	resources, _ := GetSystemServiceResources(r.Config(), k.ID(r))
+
 	return restart.New(containerd.NewRunner(
 		r.Config().Debug() && r.Config().Machine().Type() == machine.TypeWorker, // enable debug logs only for the worker nodes
 		&args,
@@ -187,7 +196,8 @@ func (k *Kubelet) Runner(r runtime.Runtime) (runner.Runner, error) {
 		runner.WithNamespace(constants.SystemContainerdNamespace),
 		runner.WithContainerImage(k.imgRef),
 		runner.WithEnv(environment.Get(r.Config())),
-		runner.WithCgroupPath(constants.CgroupKubelet),
+		runner.WithCgroupPath(resources.CgroupPath),
+		runner.WithMemoryProtection(resources.MemoryProtection),
 		runner.WithSelinuxLabel(constants.SelinuxLabelKubelet),
 		runner.WithOCISpecOpts(
 			containerd.WithRootfsPropagation("shared"),
@@ -203,7 +213,7 @@ func (k *Kubelet) Runner(r runtime.Runtime) (runner.Runner, error) {
 			oci.WithAllDevicesAllowed,
 			oci.WithCapabilities(capability.AllGrantableCapabilities()), // TODO: kubelet doesn't need all of these, we should consider limiting capabilities
 		),
-		runner.WithOOMScoreAdj(constants.KubeletOOMScoreAdj),
+		runner.WithOOMScoreAdj(resources.OOMScoreAdj),
 		runner.WithCustomSeccompProfile(kubeletSeccomp),
 	),
 		restart.WithType(restart.Forever),
@@ -11,6 +11,7 @@ import (
 	"net"
 	"os"
 	"path/filepath"
+	"slices"
 	"time"
 
 	"github.com/siderolabs/go-debug"
@@ -27,6 +28,9 @@ import (
 	"github.com/siderolabs/talos/pkg/conditions"
 	"github.com/siderolabs/talos/pkg/grpc/factory"
 	"github.com/siderolabs/talos/pkg/grpc/middleware/authz"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	machinetype "github.com/siderolabs/talos/pkg/machinery/config/machine"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/role"
 )
@@ -37,8 +41,12 @@ var rules = map[string]role.Set{
 	"/cluster.ClusterService/HealthCheck": role.MakeSet(role.Admin, role.Operator, role.Reader),
 
 	"/inspect.InspectService/ControllerRuntimeDependencies": role.MakeSet(role.Admin, role.Operator, role.Reader),
+	"/inspect.InspectService/BootstrapManifests":            role.MakeSet(role.Admin),
+	"/inspect.InspectService/ResourceSchema":                role.MakeSet(role.Admin, role.Operator, role.Reader),
+	"/inspect.InspectService/ResourceHistory":               role.MakeSet(role.Admin, role.Operator, role.Reader),
 
 	"/machine.MachineService/ApplyConfiguration":          role.MakeSet(role.Admin),
+	"/machine.MachineService/Attest":                      role.MakeSet(role.Admin, role.Attestation),
 	"/machine.MachineService/Bootstrap":                   role.MakeSet(role.Admin),
 	"/machine.MachineService/CPUInfo":                     role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/CPUFreqStats":                role.MakeSet(role.Admin, role.Operator, role.Reader),
@@ -54,6 +62,7 @@ var rules = map[string]role.Set{
 	"/machine.MachineService/EtcdLeaveCluster":            role.MakeSet(role.Admin),
 	"/machine.MachineService/EtcdMemberList":              role.MakeSet(role.Admin, role.Operator, role.Reader, role.EtcdBackup),
 	"/machine.MachineService/EtcdRecover":                 role.MakeSet(role.Admin),
+	"/machine.MachineService/EtcdPromoteMember":           role.MakeSet(role.Admin),
 	"/machine.MachineService/EtcdRemoveMemberByID":        role.MakeSet(role.Admin),
 	"/machine.MachineService/EtcdSnapshot":                role.MakeSet(role.Admin, role.Operator, role.EtcdBackup),
 	"/machine.MachineService/EtcdStatus":                  role.MakeSet(role.Admin, role.Operator, role.Reader, role.EtcdBackup),
@@ -66,7 +75,14 @@ var rules = map[string]role.Set{
 	"/machine.MachineService/Hostname":                    role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/ImageList":                   role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/ImagePull":                   role.MakeSet(role.Admin, role.Operator),
+	"/machine.MachineService/InhibitorAcquire":            role.MakeSet(role.Admin, role.Operator),
+	"/machine.MachineService/InhibitorList":               role.MakeSet(role.Admin, role.Operator, role.Reader),
+	"/machine.MachineService/InhibitorRelease":            role.MakeSet(role.Admin, role.Operator),
+	"/machine.MachineService/JoinTokenCreate":             role.MakeSet(role.Admin),
+	"/machine.MachineService/JoinTokenList":               role.MakeSet(role.Admin, role.Operator, role.Reader),
+	"/machine.MachineService/JoinTokenRevoke":             role.MakeSet(role.Admin),
 	"/machine.MachineService/Kubeconfig":                  role.MakeSet(role.Admin),
+	"/machine.MachineService/KubernetesUpgradePreflight":  role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/List":                        role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/LoadAvg":                     role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/Logs":                        role.MakeSet(role.Admin, role.Operator, role.Reader),
@@ -82,8 +98,13 @@ var rules = map[string]role.Set{
 	"/machine.MachineService/Read":                        role.MakeSet(role.Admin),
 	"/machine.MachineService/Reboot":                      role.MakeSet(role.Admin, role.Operator),
 	"/machine.MachineService/Reset":                       role.MakeSet(role.Admin),
+	"/machine.MachineService/ResetIdentity":               role.MakeSet(role.Admin),
 	"/machine.MachineService/Restart":                     role.MakeSet(role.Admin, role.Operator),
 	"/machine.MachineService/Rollback":                    role.MakeSet(role.Admin),
+	"/machine.MachineService/RotateCertificates":          role.MakeSet(role.Admin),
+	"/machine.MachineService/RotateDiskKey":               role.MakeSet(role.Admin),
+	"/machine.MachineService/SecretsBundleExport":         role.MakeSet(role.Admin),
+	"/machine.MachineService/ServiceDependencies":         role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/ServiceList":                 role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/ServiceRestart":              role.MakeSet(role.Admin, role.Operator),
 	"/machine.MachineService/ServiceStart":                role.MakeSet(role.Admin, role.Operator),
@@ -114,7 +135,7 @@ type machinedService struct {
 }
 
 // Main is an entrypoint to the API service.
-func (s *machinedService) Main(ctx context.Context, _ runtime.Runtime, logWriter io.Writer) error {
+func (s *machinedService) Main(ctx context.Context, r runtime.Runtime, logWriter io.Writer) error {
 	injector := &authz.Injector{
 		Mode: authz.MetadataOnly,
 	}
@@ -126,7 +147,16 @@ func (s *machinedService) Main(ctx context.Context, _ runtime.Runtime, logWriter
 	authorizer := &authz.Authorizer{
 		Rules:         rules,
 		FallbackRoles: role.MakeSet(role.Admin),
-		Logger:        log.New(logWriter, "machined/authz/authorizer ", log.Flags()).Printf,
+		Policy: func() []authz.PolicyRule {
+			return apiAuthorizationPolicy(r.Config())
+		},
+		OnDenied: func(ctx context.Context, method string, clientRoles role.Set) {
+			r.Events().Publish(ctx, &machine.APIAuthorizationDeniedEvent{
+				Method: method,
+				Roles:  clientRoles.Strings(),
+			})
+		},
+		Logger: log.New(logWriter, "machined/authz/authorizer ", log.Flags()).Printf,
 	}
 
 	// Start the API server.
@@ -260,3 +290,48 @@ func (m *Machined) HealthFunc(runtime.Runtime) health.Check {
 func (m *Machined) HealthSettings(runtime.Runtime) *health.Settings {
 	return &health.DefaultSettings
 }
+
+// apiAuthorizationPolicy returns the API authorization policy rules which apply to this node.
+func apiAuthorizationPolicy(cfg config.Config) []authz.PolicyRule {
+	if cfg == nil || cfg.APIAuthorizationConfig() == nil {
+		return nil
+	}
+
+	var policy []authz.PolicyRule
+
+	for _, rule := range cfg.APIAuthorizationConfig().Rules() {
+		if machineTypes := rule.MachineTypes(); len(machineTypes) > 0 {
+			if cfg.Machine() == nil || !slices.ContainsFunc(machineTypes, func(t machinetype.Type) bool {
+				// init nodes are control plane nodes as well
+				return t == cfg.Machine().Type() || (t.IsControlPlane() && cfg.Machine().Type().IsControlPlane())
+			}) {
+				continue
+			}
+		}
+
+		if nodeLabels := rule.NodeLabels(); len(nodeLabels) > 0 {
+			if cfg.Machine() == nil || !labelsMatch(cfg.Machine().NodeLabels(), nodeLabels) {
+				continue
+			}
+		}
+
+		roles, _ := role.Parse(rule.Roles())
+
+		policy = append(policy, authz.PolicyRule{
+			Roles:   roles,
+			Methods: rule.Methods(),
+		})
+	}
+
+	return policy
+}
+
+func labelsMatch(labels, selector map[string]string) bool {
+	for key, value := range selector {
+		if actual, ok := labels[key]; !ok || actual != value {
+			return false
+		}
+	}
+
+	return true
+}
@@ -18,6 +18,10 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/api/storage"
 	"github.com/siderolabs/talos/pkg/machinery/api/time"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/security"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/role"
 )
 
 func collectMethods(t *testing.T) map[string]struct{} {
@@ -73,3 +77,62 @@ func TestRules(t *testing.T) {
 		}
 	})
 }
+
+func TestAPIAuthorizationPolicy(t *testing.T) {
+	t.Parallel()
+
+	authorization := security.NewAPIAuthorizationConfigV1Alpha1()
+	authorization.ConfigRules = []security.APIAuthorizationRule{
+		{
+			RuleRoles:   []string{"ops:auditor"},
+			RuleMethods: []string{"/machine.MachineService/Logs"},
+		},
+		{
+			RuleRoles:   []string{"ops:rebooter"},
+			RuleMethods: []string{"/machine.MachineService/Reboot"},
+			RuleNodeSelector: &security.APIAuthorizationNodeSelector{
+				SelectorMachineTypes: []string{"controlplane"},
+			},
+		},
+		{
+			RuleRoles:   []string{"ops:rebooter"},
+			RuleMethods: []string{"/machine.MachineService/Shutdown"},
+			RuleNodeSelector: &security.APIAuthorizationNodeSelector{
+				SelectorMachineTypes: []string{"worker"},
+			},
+		},
+		{
+			RuleRoles:   []string{"ops:upgrader"},
+			RuleMethods: []string{"/machine.MachineService/Upgrade"},
+			RuleNodeSelector: &security.APIAuthorizationNodeSelector{
+				SelectorNodeLabels: map[string]string{"zone": "a"},
+			},
+		},
+	}
+
+	cfg, err := container.New(&v1alpha1.Config{
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineType:       "init",
+			MachineNodeLabels: map[string]string{"zone": "b"},
+		},
+	}, authorization)
+	require.NoError(t, err)
+
+	policy := apiAuthorizationPolicy(cfg)
+	require.Len(t, policy, 2)
+
+	assert.Equal(t, role.MakeSet("ops:auditor"), policy[0].Roles)
+	assert.Equal(t, []string{"/machine.MachineService/Logs"}, policy[0].Methods)
+	assert.Equal(t, role.MakeSet("ops:rebooter"), policy[1].Roles)
+	assert.Equal(t, []string{"/machine.MachineService/Reboot"}, policy[1].Methods)
+
+	cfg, err = container.New(&v1alpha1.Config{
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineType: "worker",
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Empty(t, apiAuthorizationPolicy(cfg))
+	assert.Empty(t, apiAuthorizationPolicy(nil))
+}
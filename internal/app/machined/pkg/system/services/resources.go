@@ -0,0 +1,81 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package services
+
+import (
+	"github.com/siderolabs/talos/internal/pkg/cgroup"
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// SystemServiceResources is the resource protection of a system service.
+type SystemServiceResources struct {
+	CgroupPath       string
+	OOMScoreAdj      int
+	MemoryProtection cgroup.MemoryProtection
+}
+
+// systemServiceResources is the default resource protection of the system services.
+//
+// The memory protection defaults are defined by the cgroup.
+var systemServiceResources = map[string]SystemServiceResources{
+	"apid": {
+		CgroupPath:  constants.CgroupApid,
+		OOMScoreAdj: -998,
+	},
+	"containerd": {
+		CgroupPath:  constants.CgroupSystemRuntime,
+		OOMScoreAdj: -999,
+	},
+	"cri": {
+		CgroupPath:  constants.CgroupPodRuntime,
+		OOMScoreAdj: -500,
+	},
+	"etcd": {
+		CgroupPath:  constants.CgroupEtcd,
+		OOMScoreAdj: -998,
+	},
+	"kubelet": {
+		CgroupPath:  constants.CgroupKubelet,
+		OOMScoreAdj: constants.KubeletOOMScoreAdj,
+	},
+	"trustd": {
+		CgroupPath:  constants.CgroupTrustd,
+		OOMScoreAdj: -998,
+	},
+}
+
+// GetSystemServiceResources returns the effective resource protection of the system service.
+//
+// The default memory protection is overridden by the ServiceResourcesConfig document for the service (if any).
+// The soft memory protection is never lower than the hard memory protection.
+func GetSystemServiceResources(cfg config.Config, id string) (SystemServiceResources, bool) {
+	resources, ok := systemServiceResources[id]
+	if !ok {
+		return SystemServiceResources{}, false
+	}
+
+	resources.MemoryProtection = cgroup.DefaultMemoryProtection(resources.CgroupPath)
+
+	if cfg != nil {
+		for _, override := range cfg.ServiceResourcesConfigs() {
+			if override.Name() != id {
+				continue
+			}
+
+			if value, ok := override.MemoryMin().Get(); ok {
+				resources.MemoryProtection.Min = value
+			}
+
+			if value, ok := override.MemoryLow().Get(); ok {
+				resources.MemoryProtection.Low = value
+			}
+		}
+	}
+
+	resources.MemoryProtection.Low = max(resources.MemoryProtection.Low, resources.MemoryProtection.Min)
+
+	return resources, true
+}
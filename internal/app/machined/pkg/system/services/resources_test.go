@@ -0,0 +1,70 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package services_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/system/services"
+	"github.com/siderolabs/talos/internal/pkg/cgroup"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/block"
+	runtimecfg "github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+func TestGetSystemServiceResources(t *testing.T) {
+	t.Parallel()
+
+	for _, id := range runtimecfg.ServiceResourcesServices {
+		_, ok := services.GetSystemServiceResources(nil, id)
+		assert.True(t, ok, "service %q is not known", id)
+	}
+
+	_, ok := services.GetSystemServiceResources(nil, "udevd")
+	assert.False(t, ok)
+
+	resources, ok := services.GetSystemServiceResources(nil, "apid")
+	require.True(t, ok)
+
+	assert.Equal(t, services.SystemServiceResources{
+		CgroupPath:  constants.CgroupApid,
+		OOMScoreAdj: -998,
+		MemoryProtection: cgroup.MemoryProtection{
+			Min: constants.CgroupApidReservedMemory,
+			Low: constants.CgroupApidReservedMemory * 2,
+		},
+	}, resources)
+
+	apidOverride := runtimecfg.NewServiceResourcesV1Alpha1("apid")
+	apidOverride.MemoryMinSize = block.MustByteSize("64MiB")
+
+	etcdOverride := runtimecfg.NewServiceResourcesV1Alpha1("etcd")
+	etcdOverride.MemoryMinSize = block.MustByteSize("128MiB")
+	etcdOverride.MemoryLowSize = block.MustByteSize("512MiB")
+
+	cfg, err := container.New(apidOverride, etcdOverride)
+	require.NoError(t, err)
+
+	// memory.low is raised to memory.min
+	resources, ok = services.GetSystemServiceResources(cfg, "apid")
+	require.True(t, ok)
+
+	assert.Equal(t, cgroup.MemoryProtection{Min: 64 * 1024 * 1024, Low: 64 * 1024 * 1024}, resources.MemoryProtection)
+
+	resources, ok = services.GetSystemServiceResources(cfg, "etcd")
+	require.True(t, ok)
+
+	assert.Equal(t, cgroup.MemoryProtection{Min: 128 * 1024 * 1024, Low: 512 * 1024 * 1024}, resources.MemoryProtection)
+
+	// no override
+	resources, ok = services.GetSystemServiceResources(cfg, "trustd")
+	require.True(t, ok)
+
+	assert.Equal(t, cgroup.MemoryProtection{Min: constants.CgroupTrustdReservedMemory, Low: constants.CgroupTrustdReservedMemory * 2}, resources.MemoryProtection)
+}
@@ -22,7 +22,10 @@ import (
 	"github.com/cosi-project/runtime/pkg/state/protobuf/server"
 	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/siderolabs/go-debug"
+	eventsapi "github.com/siderolabs/siderolink/api/events"
+	siderolinkevents "github.com/siderolabs/siderolink/pkg/events"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/system"
@@ -34,6 +37,7 @@ import (
 	"github.com/siderolabs/talos/internal/pkg/environment"
 	"github.com/siderolabs/talos/internal/pkg/selinux"
 	"github.com/siderolabs/talos/pkg/conditions"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/fipsmode"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
@@ -69,6 +73,9 @@ func (t *Trustd) PreFunc(ctx context.Context, r runtime.Runtime) error {
 			switch {
 			case access.ResourceNamespace == secrets.NamespaceName && access.ResourceType == secrets.TrustdType && access.ResourceID == secrets.TrustdID:
 			case access.ResourceNamespace == secrets.NamespaceName && access.ResourceType == secrets.OSRootType && access.ResourceID == secrets.OSRootID:
+			case access.ResourceNamespace == secrets.NamespaceName && access.ResourceType == secrets.TrustdPolicyType && access.ResourceID == secrets.TrustdPolicyID:
+			case access.ResourceNamespace == secrets.NamespaceName && access.ResourceType == secrets.JoinTokenType:
+			case access.ResourceNamespace == secrets.NamespaceName && access.ResourceType == secrets.CertificateRevocationType && access.ResourceID == secrets.CertificateRevocationID:
 			default:
 				return errors.New("access denied")
 			}
@@ -111,6 +118,15 @@ func (t *Trustd) PreFunc(ctx context.Context, r runtime.Runtime) error {
 	)
 	v1alpha1.RegisterStateServer(t.runtimeServer, server.NewState(resources))
 
+	// trustd reports the certificate signing decisions, only the audit events are accepted
+	eventsapi.RegisterEventSinkServiceServer(t.runtimeServer, siderolinkevents.NewSink(
+		&auditAdapter{events: r.Events()},
+		[]proto.Message{
+			&machine.CertificateSigningEvent{},
+			&machine.JoinTokenEvent{},
+		},
+	))
+
 	go t.runtimeServer.Serve(listener) //nolint:errcheck
 
 	return prepareRootfs(t.ID(r))
@@ -170,13 +186,16 @@ func (t *Trustd) Runner(r runtime.Runtime) (runner.Runner, error) {
 		env = append(env, fipsmode.StrictEnvironmentVariable())
 	}
 
+	resources, _ := GetSystemServiceResources(r.Config(), t.ID(r))
+
 	return restart.New(containerd.NewRunner(
 		r.Config().Debug(),
 		&args,
 		runner.WithLoggingManager(r.Logging()),
 		runner.WithContainerdAddress(constants.SystemContainerdAddress),
 		runner.WithEnv(env),
-		runner.WithCgroupPath(constants.CgroupTrustd),
+		runner.WithCgroupPath(resources.CgroupPath),
+		runner.WithMemoryProtection(resources.MemoryProtection),
 		runner.WithGracefulShutdownTimeout(15*time.Second),
 		runner.WithSelinuxLabel(constants.SelinuxLabelTrustd),
 		runner.WithOCISpecOpts(
@@ -187,12 +206,24 @@ func (t *Trustd) Runner(r runtime.Runtime) (runner.Runner, error) {
 			oci.WithRootFSReadonly(),
 			oci.WithUser(fmt.Sprintf("%d:%d", constants.TrustdUserID, constants.TrustdUserID)),
 		),
-		runner.WithOOMScoreAdj(-998),
+		runner.WithOOMScoreAdj(resources.OOMScoreAdj),
 	),
 		restart.WithType(restart.Forever),
 	), nil
 }
 
+// auditAdapter publishes the audit events reported by the system services (trustd, apid) as machine events.
+type auditAdapter struct {
+	events runtime.Publisher
+}
+
+// HandleEvent implements siderolinkevents.Adapter interface.
+func (a *auditAdapter) HandleEvent(ctx context.Context, event siderolinkevents.Event) error {
+	a.events.Publish(ctx, event.Payload)
+
+	return nil
+}
+
 // HealthFunc implements the HealthcheckedService interface.
 func (t *Trustd) HealthFunc(runtime.Runtime) health.Check {
 	return func(ctx context.Context) error {
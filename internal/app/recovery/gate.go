@@ -0,0 +1,92 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package recovery implements the credential recovery service.
+//
+// The credential recovery issues the admin client configuration (talosconfig) of a configured control plane node,
+// if all copies of the talosconfig are lost.
+// The recovery service is only available if the recovery gate is opened with physical access to the machine,
+// and only over the link-local connections.
+package recovery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"github.com/siderolabs/go-procfs/procfs"
+
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/meta"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+var (
+	// ErrDisabled is returned when the credential recovery is disabled by the machine configuration.
+	ErrDisabled = errors.New("credential recovery is disabled by the machine configuration")
+	// ErrGateClosed is returned when the recovery gate is not opened.
+	ErrGateClosed = errors.New("credential recovery gate is closed")
+)
+
+// GateSource describes how the recovery gate was opened.
+type GateSource int
+
+// Gate sources.
+const (
+	// GateKernelArg is the recovery gate opened with the kernel argument, it stays open until the next boot.
+	GateKernelArg GateSource = iota + 1
+	// GateMeta is the recovery gate opened with the META key, it is closed once the credentials are recovered.
+	GateMeta
+)
+
+// String implements fmt.Stringer.
+func (source GateSource) String() string {
+	switch source {
+	case GateKernelArg:
+		return fmt.Sprintf("kernel argument %s", constants.KernelParamRecovery)
+	case GateMeta:
+		return fmt.Sprintf("META key 0x%02x", meta.RecoveryGate)
+	default:
+		return "unknown"
+	}
+}
+
+// CheckGate verifies that the credential recovery is allowed, and returns the source which opened the gate.
+//
+// The gate is verified on each request, as it might be closed at any moment.
+func CheckGate(ctx context.Context, r controller.Reader, cmdline *procfs.Cmdline) (GateSource, error) {
+	cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.ActiveID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return 0, fmt.Errorf("error getting machine config: %w", err)
+	}
+
+	if cfg != nil {
+		if recoveryConfig := cfg.Config().CredentialRecoveryConfig(); recoveryConfig != nil && recoveryConfig.Disabled() {
+			return 0, ErrDisabled
+		}
+	}
+
+	if cmdline != nil {
+		if enabled, _ := strconv.ParseBool(pointer.SafeDeref(cmdline.Get(constants.KernelParamRecovery).First())); enabled { //nolint:errcheck
+			return GateKernelArg, nil
+		}
+	}
+
+	_, err = safe.ReaderGetByID[*runtime.MetaKey](ctx, r, runtime.MetaKeyTagToID(meta.RecoveryGate))
+	if err == nil {
+		return GateMeta, nil
+	}
+
+	if !state.IsNotFoundError(err) {
+		return 0, fmt.Errorf("error getting META key: %w", err)
+	}
+
+	return 0, ErrGateClosed
+}
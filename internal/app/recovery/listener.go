@@ -0,0 +1,60 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package recovery
+
+import (
+	"net"
+
+	"go.uber.org/zap"
+)
+
+// IsLinkLocal returns true if the address is a link-local (IPv4 169.254.0.0/16 or IPv6 fe80::/10) TCP address.
+//
+// Link-local addresses are never routed, so the peer is on the same link as the machine.
+func IsLinkLocal(addr net.Addr) bool {
+	tcpAddr, ok := addr.(*net.TCPAddr)
+	if !ok {
+		return false
+	}
+
+	return tcpAddr.IP.IsLinkLocalUnicast()
+}
+
+// NewListener wraps the listener to accept only link-local connections.
+//
+// Other connections are closed immediately.
+func NewListener(l net.Listener, logger *zap.Logger) net.Listener {
+	return &linkLocalListener{
+		Listener: l,
+		logger:   logger,
+	}
+}
+
+type linkLocalListener struct {
+	net.Listener
+
+	logger *zap.Logger
+}
+
+// Accept implements net.Listener.
+func (l *linkLocalListener) Accept() (net.Conn, error) {
+	for {
+		conn, err := l.Listener.Accept()
+		if err != nil {
+			return nil, err
+		}
+
+		if IsLinkLocal(conn.RemoteAddr()) && IsLinkLocal(conn.LocalAddr()) {
+			return conn, nil
+		}
+
+		l.logger.Warn("refused credential recovery connection, only link-local connections are allowed",
+			zap.Stringer("remote_addr", conn.RemoteAddr()),
+			zap.Stringer("local_addr", conn.LocalAddr()),
+		)
+
+		conn.Close() //nolint:errcheck
+	}
+}
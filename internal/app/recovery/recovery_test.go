@@ -0,0 +1,347 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package recovery_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/siderolabs/go-procfs/procfs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/siderolabs/talos/internal/app/recovery"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	clientconfig "github.com/siderolabs/talos/pkg/machinery/client/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate"
+	machinetype "github.com/siderolabs/talos/pkg/machinery/config/machine"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/security"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/meta"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+type fakeMeta struct {
+	deleted []uint8
+}
+
+func (m *fakeMeta) DeleteTag(_ context.Context, t uint8) (bool, error) {
+	m.deleted = append(m.deleted, t)
+
+	return true, nil
+}
+
+func (m *fakeMeta) Flush() error {
+	return nil
+}
+
+func newState(t *testing.T, machineType machinetype.Type, disabled, metaGate bool) state.State {
+	t.Helper()
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	input, err := generate.NewInput("test-cluster", "https://localhost:6443", "")
+	require.NoError(t, err)
+
+	cfg, err := input.Config(machineType)
+	require.NoError(t, err)
+
+	if disabled {
+		recoveryConfig := security.NewCredentialRecoveryConfigV1Alpha1()
+		recoveryConfig.ConfigDisabled = true
+
+		cfg, err = container.New(append(cfg.Documents(), recoveryConfig)...)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, st.Create(t.Context(), config.NewMachineConfig(cfg)))
+
+	if metaGate {
+		metaKey := runtime.NewMetaKey(runtime.NamespaceName, runtime.MetaKeyTagToID(meta.RecoveryGate))
+		metaKey.TypedSpec().Value = "1"
+
+		require.NoError(t, st.Create(t.Context(), metaKey))
+	}
+
+	return st
+}
+
+func recoveryCmdline(value string) *procfs.Cmdline {
+	cmdline := procfs.NewCmdline("")
+
+	if value != "" {
+		cmdline.Append(constants.KernelParamRecovery, value)
+	}
+
+	return cmdline
+}
+
+func TestCheckGate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+
+		kernelArg string
+		metaGate  bool
+		disabled  bool
+
+		expectedSource recovery.GateSource
+		expectedError  error
+	}{
+		{
+			name: "closed",
+
+			expectedError: recovery.ErrGateClosed,
+		},
+		{
+			name: "kernel arg",
+
+			kernelArg: "1",
+
+			expectedSource: recovery.GateKernelArg,
+		},
+		{
+			name: "kernel arg false",
+
+			kernelArg: "0",
+
+			expectedError: recovery.ErrGateClosed,
+		},
+		{
+			name: "meta",
+
+			metaGate: true,
+
+			expectedSource: recovery.GateMeta,
+		},
+		{
+			name: "disabled",
+
+			kernelArg: "1",
+			metaGate:  true,
+			disabled:  true,
+
+			expectedError: recovery.ErrDisabled,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			st := newState(t, machinetype.TypeControlPlane, test.disabled, test.metaGate)
+
+			source, err := recovery.CheckGate(t.Context(), st, recoveryCmdline(test.kernelArg))
+			if test.expectedError != nil {
+				require.ErrorIs(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedSource, source)
+		})
+	}
+}
+
+func peerContext(ctx context.Context, remote, local net.Addr) context.Context {
+	return peer.NewContext(ctx, &peer.Peer{
+		Addr:      remote,
+		LocalAddr: local,
+	})
+}
+
+func tcpAddr(addr string) *net.TCPAddr {
+	return net.TCPAddrFromAddrPort(netip.MustParseAddrPort(addr))
+}
+
+func TestServerRefusal(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+
+		remote, local net.Addr
+		machineType   machinetype.Type
+		kernelArg     string
+		disabled      bool
+
+		expectedCode codes.Code
+	}{
+		{
+			name: "apid proxy",
+
+			// requests proxied by apid come over the machined unix socket
+			remote:      &net.UnixAddr{Name: "@", Net: "unix"},
+			local:       &net.UnixAddr{Name: constants.MachineSocketPath, Net: "unix"},
+			machineType: machinetype.TypeControlPlane,
+			kernelArg:   "1",
+
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name: "routable address",
+
+			remote:      tcpAddr("172.20.0.1:34567"),
+			local:       tcpAddr("172.20.0.2:50000"),
+			machineType: machinetype.TypeControlPlane,
+			kernelArg:   "1",
+
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name: "routable local address",
+
+			remote:      tcpAddr("[fe80::1]:34567"),
+			local:       tcpAddr("[2001:db8::2]:50005"),
+			machineType: machinetype.TypeControlPlane,
+			kernelArg:   "1",
+
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name: "gate closed",
+
+			remote:      tcpAddr("[fe80::1]:34567"),
+			local:       tcpAddr("[fe80::2]:50005"),
+			machineType: machinetype.TypeControlPlane,
+
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name: "disabled",
+
+			remote:      tcpAddr("169.254.1.1:34567"),
+			local:       tcpAddr("169.254.1.2:50005"),
+			machineType: machinetype.TypeControlPlane,
+			kernelArg:   "1",
+			disabled:    true,
+
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name: "worker",
+
+			remote:      tcpAddr("[fe80::1]:34567"),
+			local:       tcpAddr("[fe80::2]:50005"),
+			machineType: machinetype.TypeWorker,
+			kernelArg:   "1",
+
+			expectedCode: codes.FailedPrecondition,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := &recovery.Server{
+				State:   newState(t, test.machineType, test.disabled, false),
+				Cmdline: recoveryCmdline(test.kernelArg),
+				Meta:    &fakeMeta{},
+				Logger:  zaptest.NewLogger(t),
+			}
+
+			_, err := srv.GenerateClientConfiguration(peerContext(t.Context(), test.remote, test.local), &machine.GenerateClientConfigurationRequest{
+				CrtTtl: durationpb.New(time.Hour),
+			})
+			require.Error(t, err)
+			assert.Equal(t, test.expectedCode, status.Code(err), err.Error())
+		})
+	}
+}
+
+func TestServerRecovery(t *testing.T) {
+	t.Parallel()
+
+	metaProvider := &fakeMeta{}
+
+	srv := &recovery.Server{
+		State:   newState(t, machinetype.TypeControlPlane, false, true),
+		Cmdline: recoveryCmdline(""),
+		Meta:    metaProvider,
+		Logger:  zaptest.NewLogger(t),
+	}
+
+	ctx := peerContext(t.Context(), tcpAddr("[fe80::1]:34567"), tcpAddr("[fe80::2]:50005"))
+
+	resp, err := srv.GenerateClientConfiguration(ctx, &machine.GenerateClientConfigurationRequest{
+		CrtTtl: durationpb.New(time.Hour),
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Messages, 1)
+
+	talosconfig, err := clientconfig.FromBytes(resp.Messages[0].Talosconfig)
+	require.NoError(t, err)
+
+	assert.Equal(t, "admin@test-cluster", talosconfig.Context)
+
+	// the META gate is closed once the credentials are recovered
+	assert.Equal(t, []uint8{meta.RecoveryGate}, metaProvider.deleted)
+}
+
+func TestIsLinkLocal(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		addr     net.Addr
+		expected bool
+	}{
+		{addr: tcpAddr("169.254.3.4:50005"), expected: true},
+		{addr: tcpAddr("[fe80::1]:50005"), expected: true},
+		{addr: tcpAddr("[::ffff:169.254.3.4]:50005"), expected: true},
+		{addr: tcpAddr("10.5.0.2:50005")},
+		{addr: tcpAddr("127.0.0.1:50005")},
+		{addr: tcpAddr("[2001:db8::1]:50005")},
+		{addr: &net.UnixAddr{Name: constants.MachineSocketPath, Net: "unix"}},
+	} {
+		assert.Equal(t, test.expected, recovery.IsLinkLocal(test.addr), test.addr.String())
+	}
+}
+
+func TestListenerRefusesRoutable(t *testing.T) {
+	t.Parallel()
+
+	lis, err := (&net.ListenConfig{}).Listen(t.Context(), "tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	lis = recovery.NewListener(lis, zaptest.NewLogger(t))
+
+	t.Cleanup(func() {
+		lis.Close() //nolint:errcheck
+	})
+
+	go func() {
+		conn, err := lis.Accept()
+		if err == nil {
+			// should never be reached, the connection is not link-local
+			conn.Close() //nolint:errcheck
+		}
+	}()
+
+	conn, err := (&net.Dialer{}).DialContext(t.Context(), "tcp", lis.Addr().String())
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		conn.Close() //nolint:errcheck
+	})
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+
+	// the connection is closed by the listener
+	_, err = conn.Read(make([]byte, 1))
+	require.ErrorIs(t, err, io.EOF)
+}
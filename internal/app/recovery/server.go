@@ -0,0 +1,155 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package recovery
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-procfs/procfs"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	clientconfig "github.com/siderolabs/talos/pkg/machinery/client/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate/secrets"
+	machinetype "github.com/siderolabs/talos/pkg/machinery/config/machine"
+	"github.com/siderolabs/talos/pkg/machinery/meta"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/role"
+)
+
+// Meta is the subset of the META partition access used by the recovery service.
+type Meta interface {
+	DeleteTag(ctx context.Context, t uint8) (bool, error)
+	Flush() error
+}
+
+// Server implements the credential recovery subset of [machine.MachineServiceServer].
+//
+// Only GenerateClientConfiguration is implemented.
+type Server struct {
+	machine.UnimplementedMachineServiceServer
+
+	State   state.State
+	Cmdline *procfs.Cmdline
+	Meta    Meta
+	Logger  *zap.Logger
+}
+
+// Register implements the factory.Registrator interface.
+func (s *Server) Register(obj *grpc.Server) {
+	machine.RegisterMachineServiceServer(obj, s)
+}
+
+// GenerateClientConfiguration implements the [machine.MachineServiceServer] interface.
+//
+//nolint:gocyclo
+func (s *Server) GenerateClientConfiguration(ctx context.Context, in *machine.GenerateClientConfigurationRequest) (*machine.GenerateClientConfigurationResponse, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok || !IsLinkLocal(p.Addr) || (p.LocalAddr != nil && !IsLinkLocal(p.LocalAddr)) {
+		var remoteAddr string
+
+		if ok {
+			remoteAddr = p.Addr.String()
+		}
+
+		s.Logger.Warn("refused credential recovery request, only link-local connections are allowed", zap.String("remote_addr", remoteAddr))
+
+		return nil, status.Error(codes.PermissionDenied, "credential recovery is only available over a link-local connection")
+	}
+
+	logger := s.Logger.With(zap.Stringer("remote_addr", p.Addr))
+
+	// the gate is verified on each request
+	source, err := CheckGate(ctx, s.State, s.Cmdline)
+	if err != nil {
+		logger.Warn("refused credential recovery request", zap.Error(err))
+
+		if errors.Is(err, ErrDisabled) || errors.Is(err, ErrGateClosed) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+
+		return nil, err
+	}
+
+	cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, s.State, config.ActiveID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Error(codes.FailedPrecondition, "machine is not configured")
+		}
+
+		return nil, err
+	}
+
+	if cfg.Config().Machine().Type() == machinetype.TypeWorker {
+		return nil, status.Error(codes.FailedPrecondition, "client configuration (talosconfig) can't be recovered on worker nodes")
+	}
+
+	crtTTL := in.CrtTtl.AsDuration()
+	if crtTTL <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "crt_ttl should be positive")
+	}
+
+	if len(in.Roles) == 0 {
+		in.Roles = []string{string(role.Admin)}
+	}
+
+	roles, _ := role.Parse(in.Roles)
+
+	secretsBundle := secrets.NewBundleFromConfig(secrets.NewFixedClock(time.Now()), cfg.Config())
+
+	cert, err := secretsBundle.GenerateTalosAPIClientCertificateWithTTL(roles, crtTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	contextName := cfg.Config().Cluster().Name()
+	if r := roles.Strings(); len(r) == 1 {
+		contextName = strings.TrimPrefix(r[0], role.Prefix) + "@" + contextName
+	}
+
+	talosconfig := clientconfig.NewConfig(contextName, nil, secretsBundle.Certs.OS.Crt, cert)
+
+	b, err := talosconfig.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Warn("CREDENTIAL RECOVERY: issued Talos API client certificate",
+		zap.Strings("roles", roles.Strings()),
+		zap.Duration("ttl", crtTTL),
+		zap.Stringer("gate", source),
+	)
+
+	if source == GateMeta {
+		// the META key is a one-time gate, close it once the credentials are issued
+		if _, err = s.Meta.DeleteTag(ctx, meta.RecoveryGate); err != nil {
+			return nil, err
+		}
+
+		if err = s.Meta.Flush(); err != nil {
+			return nil, err
+		}
+	}
+
+	return &machine.GenerateClientConfigurationResponse{
+		Messages: []*machine.GenerateClientConfiguration{
+			{
+				Ca:          secretsBundle.Certs.OS.Crt,
+				Crt:         cert.Crt,
+				Key:         cert.Key,
+				Talosconfig: b,
+			},
+		},
+	}, nil
+}
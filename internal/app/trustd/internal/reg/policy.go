@@ -0,0 +1,141 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package reg
+
+import (
+	stdx509 "crypto/x509"
+	"errors"
+	"fmt"
+	"net/netip"
+	"path"
+	"slices"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+// checkPolicy verifies that the certificate request is eligible to be signed under the policy.
+func checkPolicy(policy *secrets.TrustdPolicySpec, source netip.Addr, request *stdx509.CertificateRequest) error {
+	if len(policy.AllowedSubnets) > 0 {
+		if !inSubnets(policy.AllowedSubnets, source) {
+			return fmt.Errorf("source address %s is not in the allowed subnets", source)
+		}
+
+		for _, ip := range request.IPAddresses {
+			addr, ok := netip.AddrFromSlice(ip)
+			if !ok || !inSubnets(policy.AllowedSubnets, addr.Unmap()) {
+				return fmt.Errorf("certificate address %s is not in the allowed subnets", ip)
+			}
+		}
+	}
+
+	if len(policy.AllowedHostnames) > 0 {
+		names := request.DNSNames
+
+		if request.Subject.CommonName != "" {
+			names = append([]string{request.Subject.CommonName}, names...)
+		}
+
+		if len(names) == 0 {
+			return errors.New("certificate request has no hostnames")
+		}
+
+		for _, name := range names {
+			if !slices.ContainsFunc(policy.AllowedHostnames, func(pattern string) bool {
+				matched, _ := path.Match(pattern, name) //nolint:errcheck
+
+				return matched
+			}) {
+				return fmt.Errorf("certificate name %q doesn't match the allowed hostnames", name)
+			}
+		}
+	}
+
+	return nil
+}
+
+func inSubnets(subnets []netip.Prefix, addr netip.Addr) bool {
+	return slices.ContainsFunc(subnets, func(subnet netip.Prefix) bool {
+		return subnet.Contains(addr)
+	})
+}
+
+// rateLimiterPruneInterval is the interval to forget the source addresses which haven't used their limit.
+const rateLimiterPruneInterval = time.Minute
+
+// rateLimiter limits the certificate signing requests per source address.
+//
+// The zero value is ready to use.
+type rateLimiter struct {
+	mu sync.Mutex
+
+	sources   map[netip.Addr]*sourceLimiter
+	limit     rate.Limit
+	burst     int
+	lastPrune time.Time
+}
+
+type sourceLimiter struct {
+	*rate.Limiter
+
+	limited bool
+}
+
+// allow reports whether the request from the source address is allowed under the policy.
+//
+// Refusals are reported only once until the source address is allowed again, so that a flood
+// of requests doesn't flood the audit events.
+func (l *rateLimiter) allow(policy *secrets.TrustdPolicySpec, source netip.Addr, now time.Time) (allowed, report bool) {
+	if policy.RequestsPerMinute <= 0 {
+		return true, true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	limit := rate.Limit(float64(policy.RequestsPerMinute) / time.Minute.Seconds())
+	burst := max(policy.RequestBurst, 1)
+
+	// the policy was changed, start over
+	if l.sources == nil || l.limit != limit || l.burst != burst {
+		l.sources = map[netip.Addr]*sourceLimiter{}
+		l.limit = limit
+		l.burst = burst
+		l.lastPrune = now
+	}
+
+	if now.Sub(l.lastPrune) > rateLimiterPruneInterval {
+		for addr, limiter := range l.sources {
+			if limiter.TokensAt(now) >= float64(burst) {
+				delete(l.sources, addr)
+			}
+		}
+
+		l.lastPrune = now
+	}
+
+	limiter, ok := l.sources[source]
+	if !ok {
+		limiter = &sourceLimiter{
+			Limiter: rate.NewLimiter(limit, burst),
+		}
+
+		l.sources[source] = limiter
+	}
+
+	if limiter.AllowN(now, 1) {
+		limiter.limited = false
+
+		return true, true
+	}
+
+	report = !limiter.limited
+	limiter.limited = true
+
+	return false, report
+}
@@ -11,6 +11,10 @@ import (
 	"crypto/x509/pkix"
 	"encoding/pem"
 	"log"
+	"net"
+	"net/netip"
+	"slices"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/safe"
@@ -22,16 +26,25 @@ import (
 	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
 	securityapi "github.com/siderolabs/talos/pkg/machinery/api/security"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
 )
 
+// AuditFunc is called for every certificate signing decision.
+type AuditFunc func(ctx context.Context, event *machine.CertificateSigningEvent)
+
 // Registrator is the concrete type that implements the factory.Registrator and
 // securityapi.SecurityServiceServer interfaces.
 type Registrator struct {
 	securityapi.UnimplementedSecurityServiceServer
 
 	Resources state.State
+	// Audit is called for every signing decision, the refusals are logged in addition.
+	Audit AuditFunc
+
+	limiter rateLimiter
 }
 
 // Register implements the factory.Registrator interface.
@@ -45,17 +58,49 @@ func (r *Registrator) Register(s *grpc.Server) {
 //
 // This API is called by Talos worker nodes to request a server certificate for apid running on the node.
 // Control plane nodes generate certificates (client and server) directly from machine config PKI.
+//
+// The requests are validated against the signing policy (secrets.TrustdPolicy) and the revoked public keys
+// (secrets.CertificateRevocation), and every decision is audited.
+//
+//nolint:gocyclo
 func (r *Registrator) Certificate(ctx context.Context, in *securityapi.CertificateRequest) (resp *securityapi.CertificateResponse, err error) {
 	remotePeer, ok := peer.FromContext(ctx)
 	if !ok {
 		return nil, status.Error(codes.PermissionDenied, "peer not found")
 	}
 
+	source := peerAddr(remotePeer)
+
 	osRoot, err := safe.StateGet[*secrets.OSRoot](ctx, r.Resources, resource.NewMetadata(secrets.NamespaceName, secrets.OSRootType, secrets.OSRootID, resource.VersionUndefined))
 	if err != nil {
 		return nil, err
 	}
 
+	// the policy is empty if the policy was not configured
+	policy := &secrets.TrustdPolicySpec{}
+
+	policyRes, err := safe.StateGet[*secrets.TrustdPolicy](ctx, r.Resources, resource.NewMetadata(secrets.NamespaceName, secrets.TrustdPolicyType, secrets.TrustdPolicyID, resource.VersionUndefined))
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, err
+	}
+
+	if policyRes != nil {
+		policy = policyRes.TypedSpec()
+	}
+
+	// rate limit before any expensive processing of the request
+	if allowed, report := r.limiter.allow(policy, source, time.Now()); !allowed {
+		if report {
+			r.audit(ctx, &machine.CertificateSigningEvent{
+				Source:   source.String(),
+				Decision: constants.CertificateSigningDecisionRateLimited,
+				Reason:   "rate limit exceeded",
+			})
+		}
+
+		return nil, status.Errorf(codes.ResourceExhausted, "certificate signing rate limit exceeded for %s", source)
+	}
+
 	// decode and validate CSR
 	csrPemBlock, _ := pem.Decode(in.Csr)
 	if csrPemBlock == nil {
@@ -69,6 +114,36 @@ func (r *Registrator) Certificate(ctx context.Context, in *securityapi.Certifica
 
 	log.Printf("received CSR signing request from %s: subject %s dns names %s addresses %s", remotePeer.Addr, request.Subject, request.DNSNames, request.IPAddresses)
 
+	event := &machine.CertificateSigningEvent{
+		Source:      source.String(),
+		Subject:     request.Subject.String(),
+		DnsNames:    request.DNSNames,
+		IpAddresses: xslices.Map(request.IPAddresses, net.IP.String),
+	}
+
+	revocation, err := safe.StateGet[*secrets.CertificateRevocation](ctx, r.Resources, resource.NewMetadata(secrets.NamespaceName, secrets.CertificateRevocationType, secrets.CertificateRevocationID, resource.VersionUndefined))
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, err
+	}
+
+	if revocation != nil && slices.Contains(revocation.TypedSpec().Fingerprints, x509.SPKIFingerprint(&stdx509.Certificate{RawSubjectPublicKeyInfo: request.RawSubjectPublicKeyInfo}).String()) {
+		event.Decision = constants.CertificateSigningDecisionDenied
+		event.Reason = "public key is revoked"
+
+		r.audit(ctx, event)
+
+		return nil, status.Error(codes.PermissionDenied, "certificate request denied: public key is revoked")
+	}
+
+	if err = checkPolicy(policy, source, request); err != nil {
+		event.Decision = constants.CertificateSigningDecisionDenied
+		event.Reason = err.Error()
+
+		r.audit(ctx, event)
+
+		return nil, status.Errorf(codes.PermissionDenied, "certificate request denied by the signing policy: %s", err)
+	}
+
 	// allow only server auth certificates
 	x509Opts := []x509.Option{
 		x509.KeyUsage(stdx509.KeyUsageDigitalSignature),
@@ -89,6 +164,10 @@ func (r *Registrator) Certificate(ctx context.Context, in *securityapi.Certifica
 		}))
 	}
 
+	if policy.MaxCertificateLifetime > 0 && policy.MaxCertificateLifetime < x509.DefaultCertificateValidityDuration {
+		x509Opts = append(x509Opts, x509.NotAfter(time.Now().Add(policy.MaxCertificateLifetime)))
+	}
+
 	// TODO: Verify that the request is coming from the IP address declared in
 	// the CSR.
 	signed, err := x509.NewCertificateFromCSRBytes(
@@ -101,6 +180,10 @@ func (r *Registrator) Certificate(ctx context.Context, in *securityapi.Certifica
 		return nil, status.Errorf(codes.Internal, "failed to sign CSR: %s", err)
 	}
 
+	event.Decision = constants.CertificateSigningDecisionSigned
+
+	r.audit(ctx, event)
+
 	resp = &securityapi.CertificateResponse{
 		Ca: bytes.Join(
 			xslices.Map(
@@ -116,3 +199,23 @@ func (r *Registrator) Certificate(ctx context.Context, in *securityapi.Certifica
 
 	return resp, nil
 }
+
+func (r *Registrator) audit(ctx context.Context, event *machine.CertificateSigningEvent) {
+	if event.Decision != constants.CertificateSigningDecisionSigned {
+		log.Printf("CSR signing request from %s %s: %s", event.Source, event.Decision, event.Reason)
+	}
+
+	if r.Audit != nil {
+		r.Audit(ctx, event)
+	}
+}
+
+// peerAddr returns the address of the peer, or an unspecified address if the peer is not an IP peer.
+func peerAddr(remotePeer *peer.Peer) netip.Addr {
+	addrPort, err := netip.ParseAddrPort(remotePeer.Addr.String())
+	if err != nil {
+		return netip.IPv6Unspecified()
+	}
+
+	return addrPort.Addr().Unmap()
+}
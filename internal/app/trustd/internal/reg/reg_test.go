@@ -7,6 +7,7 @@ package reg_test
 import (
 	"context"
 	stdx509 "crypto/x509"
+	"encoding/pem"
 	"net"
 	"net/netip"
 	"testing"
@@ -18,11 +19,15 @@ import (
 	"github.com/siderolabs/crypto/x509"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 
 	"github.com/siderolabs/talos/internal/app/trustd/internal/reg"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/api/security"
 	gensecrets "github.com/siderolabs/talos/pkg/machinery/config/generate/secrets"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
 	"github.com/siderolabs/talos/pkg/machinery/role"
 )
@@ -107,3 +112,240 @@ func TestCertificate(t *testing.T) {
 		})
 	}
 }
+
+type policyTest struct {
+	registrator *reg.Registrator
+	events      []*machine.CertificateSigningEvent
+}
+
+func newPolicyTest(t *testing.T, policy secrets.TrustdPolicySpec) *policyTest {
+	t.Helper()
+
+	resources := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	ca, err := gensecrets.NewTalosCA(time.Now())
+	require.NoError(t, err)
+
+	osRoot := secrets.NewOSRoot(secrets.OSRootID)
+	osRoot.TypedSpec().IssuingCA = &x509.PEMEncodedCertificateAndKey{
+		Crt: ca.CrtPEM,
+		Key: ca.KeyPEM,
+	}
+	require.NoError(t, resources.Create(t.Context(), osRoot))
+
+	policyRes := secrets.NewTrustdPolicy()
+	*policyRes.TypedSpec() = policy
+	require.NoError(t, resources.Create(t.Context(), policyRes))
+
+	test := &policyTest{}
+
+	test.registrator = &reg.Registrator{
+		Resources: resources,
+		Audit: func(_ context.Context, event *machine.CertificateSigningEvent) {
+			test.events = append(test.events, event)
+		},
+	}
+
+	return test
+}
+
+func (test *policyTest) request(t *testing.T, source string, csrSetters ...x509.Option) (*stdx509.Certificate, error) {
+	t.Helper()
+
+	ctx := peer.NewContext(t.Context(), &peer.Peer{
+		Addr: &net.TCPAddr{
+			IP:   netip.MustParseAddr(source).AsSlice(),
+			Port: 30000,
+		},
+	})
+
+	serverCSR, serverCert, err := x509.NewEd25519CSRAndIdentity(csrSetters...)
+	require.NoError(t, err)
+
+	resp, err := test.registrator.Certificate(ctx, &security.CertificateRequest{
+		Csr: serverCSR.X509CertificateRequestPEM,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	serverCert.Crt = resp.Crt
+
+	cert, err := serverCert.GetCert()
+	require.NoError(t, err)
+
+	return cert, nil
+}
+
+func workerCSR(hostname, address string) []x509.Option {
+	return []x509.Option{
+		x509.IPAddresses([]net.IP{netip.MustParseAddr(address).AsSlice()}),
+		x509.DNSNames([]string{hostname}),
+		x509.CommonName(hostname),
+	}
+}
+
+func TestCertificatePolicy(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name     string
+		source   string
+		hostname string
+		address  string
+
+		expectedError string
+	}{
+		{
+			name:     "allowed",
+			source:   "10.5.0.4",
+			hostname: "worker-1",
+			address:  "10.5.0.4",
+		},
+		{
+			name:     "source not allowed",
+			source:   "192.168.3.4",
+			hostname: "worker-1",
+			address:  "10.5.0.4",
+
+			expectedError: "source address 192.168.3.4 is not in the allowed subnets",
+		},
+		{
+			name:     "certificate address not allowed",
+			source:   "10.5.0.4",
+			hostname: "worker-1",
+			address:  "10.6.0.4",
+
+			expectedError: "certificate address 10.6.0.4 is not in the allowed subnets",
+		},
+		{
+			name:     "hostname not allowed",
+			source:   "10.5.0.4",
+			hostname: "controlplane-1",
+			address:  "10.5.0.4",
+
+			expectedError: `certificate name "controlplane-1" doesn't match the allowed hostnames`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			test := newPolicyTest(t, secrets.TrustdPolicySpec{
+				AllowedSubnets:   []netip.Prefix{netip.MustParsePrefix("10.5.0.0/16")},
+				AllowedHostnames: []string{"worker-*"},
+			})
+
+			cert, err := test.request(t, tt.source, workerCSR(tt.hostname, tt.address)...)
+
+			require.Len(t, test.events, 1)
+			assert.Equal(t, tt.source, test.events[0].Source)
+			assert.Equal(t, "CN="+tt.hostname, test.events[0].Subject)
+			assert.Equal(t, []string{tt.hostname}, test.events[0].DnsNames)
+			assert.Equal(t, []string{tt.address}, test.events[0].IpAddresses)
+
+			if tt.expectedError == "" {
+				require.NoError(t, err)
+
+				assert.Equal(t, tt.hostname, cert.Subject.CommonName)
+				assert.Equal(t, constants.CertificateSigningDecisionSigned, test.events[0].Decision)
+
+				return
+			}
+
+			require.Error(t, err)
+
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+			assert.Contains(t, err.Error(), tt.expectedError)
+			assert.Equal(t, constants.CertificateSigningDecisionDenied, test.events[0].Decision)
+			assert.Equal(t, tt.expectedError, test.events[0].Reason)
+		})
+	}
+}
+
+func TestCertificateRateLimit(t *testing.T) {
+	t.Parallel()
+
+	test := newPolicyTest(t, secrets.TrustdPolicySpec{
+		RequestsPerMinute: 1,
+		RequestBurst:      2,
+	})
+
+	for range 2 {
+		_, err := test.request(t, "10.5.0.4", workerCSR("worker-1", "10.5.0.4")...)
+		require.NoError(t, err)
+	}
+
+	// the refusals are reported once
+	for range 2 {
+		_, err := test.request(t, "10.5.0.4", workerCSR("worker-1", "10.5.0.4")...)
+		require.Error(t, err)
+
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	}
+
+	// the limit is per source address
+	_, err := test.request(t, "10.5.0.5", workerCSR("worker-2", "10.5.0.5")...)
+	require.NoError(t, err)
+
+	require.Len(t, test.events, 4)
+	assert.Equal(t, constants.CertificateSigningDecisionSigned, test.events[0].Decision)
+	assert.Equal(t, constants.CertificateSigningDecisionSigned, test.events[1].Decision)
+	assert.Equal(t, constants.CertificateSigningDecisionRateLimited, test.events[2].Decision)
+	assert.Equal(t, "10.5.0.4", test.events[2].Source)
+	assert.Equal(t, constants.CertificateSigningDecisionSigned, test.events[3].Decision)
+	assert.Equal(t, "10.5.0.5", test.events[3].Source)
+}
+
+func TestCertificateMaxLifetime(t *testing.T) {
+	t.Parallel()
+
+	test := newPolicyTest(t, secrets.TrustdPolicySpec{
+		MaxCertificateLifetime: 2 * time.Hour,
+	})
+
+	cert, err := test.request(t, "10.5.0.4", workerCSR("worker-1", "10.5.0.4")...)
+	require.NoError(t, err)
+
+	assert.WithinDuration(t, time.Now().Add(2*time.Hour), cert.NotAfter, time.Minute)
+}
+
+func TestCertificateRevokedKey(t *testing.T) {
+	t.Parallel()
+
+	test := newPolicyTest(t, secrets.TrustdPolicySpec{})
+
+	serverCSR, _, err := x509.NewEd25519CSRAndIdentity(workerCSR("worker-1", "10.5.0.4")...)
+	require.NoError(t, err)
+
+	block, _ := pem.Decode(serverCSR.X509CertificateRequestPEM)
+	require.NotNil(t, block)
+
+	request, err := stdx509.ParseCertificateRequest(block.Bytes)
+	require.NoError(t, err)
+
+	revocation := secrets.NewCertificateRevocation()
+	revocation.TypedSpec().Fingerprints = []string{x509.SPKIFingerprint(&stdx509.Certificate{RawSubjectPublicKeyInfo: request.RawSubjectPublicKeyInfo}).String()}
+	require.NoError(t, test.registrator.Resources.Create(t.Context(), revocation))
+
+	ctx := peer.NewContext(t.Context(), &peer.Peer{
+		Addr: &net.TCPAddr{
+			IP:   netip.MustParseAddr("10.5.0.4").AsSlice(),
+			Port: 30000,
+		},
+	})
+
+	_, err = test.registrator.Certificate(ctx, &security.CertificateRequest{
+		Csr: serverCSR.X509CertificateRequestPEM,
+	})
+	require.Error(t, err)
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	require.Len(t, test.events, 1)
+	assert.Equal(t, constants.CertificateSigningDecisionDenied, test.events[0].Decision)
+	assert.Equal(t, "public key is revoked", test.events[0].Reason)
+
+	// other keys are still signed
+	_, err = test.request(t, "10.5.0.4", workerCSR("worker-1", "10.5.0.4")...)
+	require.NoError(t, err)
+}
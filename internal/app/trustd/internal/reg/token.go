@@ -0,0 +1,114 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package reg
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"sync"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+// TokenAuditFunc is called when a join token is used for the first time by a machine.
+type TokenAuditFunc func(ctx context.Context, event *machine.JoinTokenEvent)
+
+// TokenAuthenticator authenticates the requests with the join tokens.
+//
+// The token presented by the machine should match one of the non-expired JoinToken resources.
+type TokenAuthenticator struct {
+	Resources state.State
+	// Audit is called on the first use of each join token by each source address.
+	Audit TokenAuditFunc
+
+	mu   sync.Mutex
+	used map[tokenUse]struct{}
+}
+
+type tokenUse struct {
+	name   string
+	source netip.Addr
+}
+
+// UnaryInterceptor returns the interceptor which enforces the join token authentication.
+func (a *TokenAuthenticator) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := a.authenticate(ctx); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func (a *TokenAuthenticator) authenticate(ctx context.Context) error {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md["token"]) == 0 {
+		return fmt.Errorf("%s", codes.Unauthenticated.String())
+	}
+
+	joinTokens, err := safe.StateListAll[*secrets.JoinToken](ctx, a.Resources)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	for joinToken := range joinTokens.All() {
+		if joinToken.TypedSpec().Expired(now) || !joinToken.TypedSpec().Matches(md["token"][0]) {
+			continue
+		}
+
+		a.recordUse(ctx, joinToken.Metadata().ID())
+
+		return nil
+	}
+
+	return fmt.Errorf("%s", codes.Unauthenticated.String())
+}
+
+func (a *TokenAuthenticator) recordUse(ctx context.Context, name string) {
+	source := netip.IPv6Unspecified()
+
+	if remotePeer, ok := peer.FromContext(ctx); ok {
+		source = peerAddr(remotePeer)
+	}
+
+	use := tokenUse{name: name, source: source}
+
+	a.mu.Lock()
+
+	_, seen := a.used[use]
+
+	if !seen {
+		if a.used == nil {
+			a.used = map[tokenUse]struct{}{}
+		}
+
+		a.used[use] = struct{}{}
+	}
+
+	a.mu.Unlock()
+
+	if seen || a.Audit == nil {
+		return
+	}
+
+	a.Audit(ctx, &machine.JoinTokenEvent{
+		Name:   name,
+		Action: machine.JoinTokenEvent_USED,
+		Source: source.String(),
+	})
+}
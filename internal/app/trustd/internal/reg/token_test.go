@@ -0,0 +1,104 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package reg_test
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/siderolabs/talos/internal/app/trustd/internal/reg"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+func TestTokenAuthenticator(t *testing.T) {
+	t.Parallel()
+
+	resources := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	for _, joinToken := range []struct {
+		name      string
+		token     string
+		expiresAt time.Time
+	}{
+		{name: secrets.JoinTokenMachineConfigID, token: "machine-config-token"},
+		{name: "batch-1", token: "batch-1-token", expiresAt: time.Now().Add(time.Hour)},
+		{name: "batch-0", token: "batch-0-token", expiresAt: time.Now().Add(-time.Hour)},
+	} {
+		res := secrets.NewJoinToken(joinToken.name)
+		res.TypedSpec().TokenHash = secrets.HashJoinToken(joinToken.token)
+		res.TypedSpec().CreatedAt = time.Now().Add(-2 * time.Hour)
+		res.TypedSpec().ExpiresAt = joinToken.expiresAt
+
+		require.NoError(t, resources.Create(t.Context(), res))
+	}
+
+	var events []*machine.JoinTokenEvent
+
+	authenticator := &reg.TokenAuthenticator{
+		Resources: resources,
+		Audit: func(_ context.Context, event *machine.JoinTokenEvent) {
+			events = append(events, event)
+		},
+	}
+
+	interceptor := authenticator.UnaryInterceptor()
+
+	call := func(source, token string) error {
+		ctx := peer.NewContext(t.Context(), &peer.Peer{
+			Addr: &net.TCPAddr{
+				IP:   netip.MustParseAddr(source).AsSlice(),
+				Port: 30000,
+			},
+		})
+
+		if token != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("token", token))
+		}
+
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{}, func(context.Context, any) (any, error) {
+			return nil, nil //nolint:nilnil
+		})
+
+		return err
+	}
+
+	require.NoError(t, call("10.5.0.4", "machine-config-token"))
+	require.NoError(t, call("10.5.0.4", "batch-1-token"))
+	require.NoError(t, call("10.5.0.4", "batch-1-token"))
+	require.NoError(t, call("10.5.0.5", "batch-1-token"))
+
+	assert.EqualError(t, call("10.5.0.4", "batch-0-token"), "Unauthenticated")
+	assert.EqualError(t, call("10.5.0.4", "unknown-token"), "Unauthenticated")
+	assert.EqualError(t, call("10.5.0.4", ""), "Unauthenticated")
+
+	// the use is reported once per token and source address
+	require.Len(t, events, 3)
+
+	for i, expected := range []struct {
+		name   string
+		source string
+	}{
+		{name: secrets.JoinTokenMachineConfigID, source: "10.5.0.4"},
+		{name: "batch-1", source: "10.5.0.4"},
+		{name: "batch-1", source: "10.5.0.5"},
+	} {
+		assert.Equal(t, expected.name, events[i].Name)
+		assert.Equal(t, machine.JoinTokenEvent_USED, events[i].Action)
+		assert.Equal(t, expected.source, events[i].Source)
+	}
+}
@@ -15,22 +15,23 @@ import (
 	"time"
 
 	"github.com/cosi-project/runtime/api/v1alpha1"
-	"github.com/cosi-project/runtime/pkg/resource"
-	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/cosi-project/runtime/pkg/state/protobuf/client"
+	"github.com/rs/xid"
 	debug "github.com/siderolabs/go-debug"
+	eventsapi "github.com/siderolabs/siderolink/api/events"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
 
 	"github.com/siderolabs/talos/internal/app/trustd/internal/provider"
 	"github.com/siderolabs/talos/internal/app/trustd/internal/reg"
 	"github.com/siderolabs/talos/pkg/grpc/factory"
-	"github.com/siderolabs/talos/pkg/grpc/middleware/auth/basic"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
-	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
 	"github.com/siderolabs/talos/pkg/startup"
 )
 
@@ -90,7 +91,14 @@ func trustdMain() error {
 		return fmt.Errorf("failed to create OS-level TLS configuration: %w", err)
 	}
 
-	creds := basic.NewTokenCredentialsDynamic(tokenGetter(resources))
+	eventSink := eventsapi.NewEventSinkServiceClient(runtimeConn)
+
+	authenticator := &reg.TokenAuthenticator{
+		Resources: resources,
+		Audit: func(ctx context.Context, event *machine.JoinTokenEvent) {
+			publishEvent(ctx, eventSink, event)
+		},
+	}
 
 	networkListener, err := factory.NewListener(
 		ctx,
@@ -101,9 +109,14 @@ func trustdMain() error {
 	}
 
 	networkServer := factory.NewServer(
-		&reg.Registrator{Resources: resources},
+		&reg.Registrator{
+			Resources: resources,
+			Audit: func(ctx context.Context, event *machine.CertificateSigningEvent) {
+				publishEvent(ctx, eventSink, event)
+			},
+		},
 		factory.WithDefaultLog(),
-		factory.WithUnaryInterceptor(creds.UnaryInterceptor()),
+		factory.WithUnaryInterceptor(authenticator.UnaryInterceptor()),
 		factory.ServerOptions(
 			grpc.Creds(
 				credentials.NewTLS(serverTLSConfig),
@@ -135,13 +148,23 @@ func trustdMain() error {
 	return errGroup.Wait()
 }
 
-func tokenGetter(state state.State) basic.TokenGetterFunc {
-	return func(ctx context.Context) (string, error) {
-		osRoot, err := safe.StateGet[*secrets.OSRoot](ctx, state, resource.NewMetadata(secrets.NamespaceName, secrets.OSRootType, secrets.OSRootID, resource.VersionUndefined))
-		if err != nil {
-			return "", err
-		}
+// publishEvent publishes the audit events (certificate signing decisions, join token use) as machine events via machined.
+func publishEvent(ctx context.Context, client eventsapi.EventSinkServiceClient, event proto.Message) {
+	// publish the event even if the request is canceled
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+
+	data, err := anypb.New(event)
+	if err != nil {
+		log.Printf("failed to marshal %s event: %s", event.ProtoReflect().Descriptor().Name(), err)
+
+		return
+	}
 
-		return osRoot.TypedSpec().Token, nil
+	if _, err = client.Publish(ctx, &eventsapi.EventRequest{
+		Id:   xid.New().String(),
+		Data: data,
+	}); err != nil {
+		log.Printf("failed to publish %s event: %s", event.ProtoReflect().Descriptor().Name(), err)
 	}
 }
@@ -120,6 +120,101 @@ func (suite *RotateCASuite) TestTalos() {
 	suite.ClearConnectionRefused(suite.ctx, suite.DiscoverNodeInternalIPsByType(suite.ctx, machine.TypeWorker)...)
 }
 
+// TestTalosPhased rotates Talos CA phase by phase, resuming the rotation from the checkpoint.
+func (suite *RotateCASuite) TestTalosPhased() {
+	if suite.Cluster == nil {
+		suite.T().Skip("cluster information is not available")
+	}
+
+	nodeInternalIP := suite.RandomDiscoveredNodeInternalIP(machine.TypeControlPlane)
+
+	osRoot, err := safe.StateGetByID[*secretsres.OSRoot](client.WithNode(suite.ctx, nodeInternalIP), suite.Client.COSI, secretsres.OSRootID)
+	suite.Require().NoError(err)
+
+	newBundle, err := secrets.NewBundle(secrets.NewFixedClock(time.Now()), config.TalosVersionCurrent)
+	suite.Require().NoError(err)
+
+	var checkpoint talos.State
+
+	options := talos.Options{
+		CurrentClient: suite.Client,
+		ClusterInfo:   access.NewAdapter(suite.Cluster),
+
+		ContextName: suite.Talosconfig.Context,
+		Endpoints:   suite.Client.GetEndpoints(),
+
+		NewTalosCA: newBundle.Certs.OS,
+
+		Phase: talos.PhaseAccept,
+		Checkpoint: func(state talos.State) error {
+			checkpoint = state
+
+			return nil
+		},
+
+		EncoderOption: encoder.WithComments(encoder.CommentsAll),
+
+		Printf: suite.T().Logf,
+	}
+
+	suite.T().Logf("rotating current CA -> new CA, phase %q", talos.PhaseAccept)
+
+	newTalosconfig, err := talos.Rotate(suite.ctx, options)
+	suite.Require().NoError(err)
+	suite.Require().Nil(newTalosconfig, "new CA is not issuing yet")
+	suite.Require().Equal(talos.PhaseAccept, checkpoint.Completed)
+
+	suite.assertOSRootStatus(suite.Client, secretsres.OSRootPhaseNewCAAccepted)
+
+	suite.T().Logf("resuming rotation current CA -> new CA")
+
+	options.Resume = &checkpoint
+	options.Phase = ""
+	// the new CA from the checkpoint is used
+	options.NewTalosCA = nil
+
+	newTalosconfig, err = talos.Rotate(suite.ctx, options)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(newTalosconfig)
+	suite.Require().Equal(talos.PhaseRetire, checkpoint.Completed)
+
+	newClient, err := client.New(suite.ctx, client.WithConfig(newTalosconfig))
+	suite.Require().NoError(err)
+
+	suite.assertOSRootStatus(newClient, secretsres.OSRootPhaseStable)
+
+	suite.T().Logf("rotating back new CA -> old CA")
+
+	_, err = talos.Rotate(suite.ctx, talos.Options{
+		CurrentClient: newClient,
+		ClusterInfo:   access.NewAdapter(suite.Cluster),
+
+		ContextName: suite.Talosconfig.Context,
+		Endpoints:   suite.Client.GetEndpoints(),
+
+		NewTalosCA: osRoot.TypedSpec().IssuingCA,
+
+		EncoderOption: encoder.WithComments(encoder.CommentsAll),
+
+		Printf: suite.T().Logf,
+	})
+	suite.Require().NoError(err)
+
+	suite.AssertClusterHealthy(suite.ctx)
+
+	suite.ClearConnectionRefused(suite.ctx, suite.DiscoverNodeInternalIPsByType(suite.ctx, machine.TypeWorker)...)
+}
+
+func (suite *RotateCASuite) assertOSRootStatus(c *client.Client, phase string) {
+	for _, node := range suite.DiscoverNodeInternalIPs(suite.ctx) {
+		status, err := safe.StateGetByID[*secretsres.OSRootStatus](client.WithNode(suite.ctx, node), c.COSI, secretsres.OSRootStatusID)
+		suite.Require().NoError(err)
+
+		suite.Assert().Equal(phase, status.TypedSpec().Phase, "node %s", node)
+		suite.Assert().True(status.TypedSpec().Converged, "node %s", node)
+	}
+}
+
 // TestKubernetes updates Kubernetes CA in the cluster.
 func (suite *RotateCASuite) TestKubernetes() {
 	if suite.Cluster == nil {
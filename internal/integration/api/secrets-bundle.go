@@ -0,0 +1,205 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//go:build integration_api
+
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/siderolabs/go-retry/retry"
+	"google.golang.org/grpc/codes"
+	"gopkg.in/yaml.v3"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	"github.com/siderolabs/talos/internal/integration/base"
+	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	clientconfig "github.com/siderolabs/talos/pkg/machinery/client/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate/secrets"
+	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/role"
+)
+
+// SecretsBundleSuite verifies the secrets bundle export API.
+type SecretsBundleSuite struct {
+	base.K8sSuite
+
+	ctx       context.Context //nolint:containedctx
+	ctxCancel context.CancelFunc
+}
+
+// SuiteName ...
+func (suite *SecretsBundleSuite) SuiteName() string {
+	return "api.SecretsBundleSuite"
+}
+
+// SetupTest ...
+func (suite *SecretsBundleSuite) SetupTest() {
+	suite.ctx, suite.ctxCancel = context.WithTimeout(context.Background(), 5*time.Minute)
+}
+
+// TearDownTest ...
+func (suite *SecretsBundleSuite) TearDownTest() {
+	if suite.ctxCancel != nil {
+		suite.ctxCancel()
+	}
+}
+
+// TestExport verifies that the configuration generated from the exported bundle can join the cluster.
+func (suite *SecretsBundleSuite) TestExport() {
+	node := suite.RandomDiscoveredNodeInternalIP(machine.TypeControlPlane)
+	nodeCtx := client.WithNode(suite.ctx, node)
+
+	bundle := suite.exportBundle(nodeCtx)
+
+	provider, err := suite.ReadConfigFromNode(nodeCtx)
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal(provider.Cluster().ID(), bundle.Cluster.ID)
+	suite.Assert().Equal(provider.Cluster().Secret(), bundle.Cluster.Secret)
+
+	input, err := generate.NewInput(
+		provider.Cluster().Name(),
+		provider.Cluster().Endpoint().String(),
+		constants.DefaultKubernetesVersion,
+		generate.WithSecretsBundle(bundle),
+	)
+	suite.Require().NoError(err)
+
+	workerCfg, err := input.Config(machine.TypeWorker)
+	suite.Require().NoError(err)
+
+	// the join secrets of the generated worker should match the ones of the running cluster
+	suite.Assert().Equal(provider.Cluster().IssuingCA().Crt, workerCfg.Cluster().IssuingCA().Crt)
+	suite.Assert().Equal(provider.Machine().Security().IssuingCA().Crt, workerCfg.Machine().Security().IssuingCA().Crt)
+	suite.Assert().Equal(provider.Machine().Security().Token(), workerCfg.Machine().Security().Token())
+
+	// the bootstrap token is regenerated, and registered in the cluster
+	suite.Assert().NotEqual(provider.Cluster().Token().ID(), workerCfg.Cluster().Token().ID())
+
+	tokenSecretName := "bootstrap-token-" + workerCfg.Cluster().Token().ID()
+
+	defer suite.Clientset.CoreV1().Secrets(metav1.NamespaceSystem).Delete(suite.ctx, tokenSecretName, metav1.DeleteOptions{}) //nolint:errcheck
+
+	secret, err := suite.Clientset.CoreV1().Secrets(metav1.NamespaceSystem).Get(suite.ctx, tokenSecretName, metav1.GetOptions{})
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal(workerCfg.Cluster().Token().Secret(), string(secret.Data["token-secret"]))
+	suite.Run("kubelet bootstrap", func() {
+		// the kubelet of the new node authenticates with the bootstrap token, and verifies the API server with the cluster CA
+		clientset, err := kubernetes.NewForConfig(&rest.Config{
+			Host:        suite.RestConfig.Host,
+			BearerToken: workerCfg.Cluster().Token().ID() + "." + workerCfg.Cluster().Token().Secret(),
+			TLSClientConfig: rest.TLSClientConfig{
+				CAData: workerCfg.Cluster().IssuingCA().Crt,
+			},
+		})
+		suite.Require().NoError(err)
+
+		review, err := clientset.AuthenticationV1().SelfSubjectReviews().Create(suite.ctx, &authenticationv1.SelfSubjectReview{}, metav1.CreateOptions{})
+		suite.Require().NoError(err)
+
+		suite.Assert().Equal("system:bootstrap:"+workerCfg.Cluster().Token().ID(), review.Status.UserInfo.Username)
+	})
+
+	suite.Run("talos api", func() {
+		adminCert, err := bundle.GenerateTalosAPIClientCertificate(role.MakeSet(role.Admin))
+		suite.Require().NoError(err)
+
+		c, err := client.New(suite.ctx,
+			client.WithConfig(clientconfig.NewConfig("secrets-bundle", suite.Client.GetEndpoints(), bundle.Certs.OS.Crt, adminCert)),
+		)
+		suite.Require().NoError(err)
+
+		defer c.Close() //nolint:errcheck
+
+		_, err = c.Version(nodeCtx)
+		suite.Require().NoError(err)
+	})
+}
+
+// TestExportConfirmation verifies that the export should be confirmed by an admin.
+func (suite *SecretsBundleSuite) TestExportConfirmation() {
+	node := suite.RandomDiscoveredNodeInternalIP(machine.TypeControlPlane)
+	nodeCtx := client.WithNode(suite.ctx, node)
+
+	_, err := suite.Client.SecretsBundleExport(nodeCtx, &machineapi.SecretsBundleExportRequest{})
+	suite.Require().Error(err)
+	suite.Assert().Equal(codes.InvalidArgument, client.StatusCode(err))
+
+	bundle := suite.exportBundle(nodeCtx)
+
+	operatorCert, err := bundle.GenerateTalosAPIClientCertificate(role.MakeSet(role.Operator))
+	suite.Require().NoError(err)
+
+	c, err := client.New(suite.ctx,
+		client.WithConfig(clientconfig.NewConfig("secrets-bundle", suite.Client.GetEndpoints(), bundle.Certs.OS.Crt, operatorCert)),
+	)
+	suite.Require().NoError(err)
+
+	defer c.Close() //nolint:errcheck
+
+	_, err = c.SecretsBundleExport(nodeCtx, &machineapi.SecretsBundleExportRequest{AllowSecretsExport: true})
+	suite.Require().Error(err)
+	suite.Assert().Equal(codes.PermissionDenied, client.StatusCode(err))
+}
+
+// TestExportDisabled verifies that the export can be disabled in the machine configuration.
+func (suite *SecretsBundleSuite) TestExportDisabled() {
+	node := suite.RandomDiscoveredNodeInternalIP(machine.TypeControlPlane)
+	nodeCtx := client.WithNode(suite.ctx, node)
+
+	suite.PatchMachineConfig(nodeCtx, map[string]any{
+		"machine": map[string]any{
+			"features": map[string]any{
+				"disableSecretsBundleExport": true,
+			},
+		},
+	})
+
+	defer suite.PatchMachineConfig(nodeCtx, map[string]any{
+		"machine": map[string]any{
+			"features": map[string]any{
+				"disableSecretsBundleExport": false,
+			},
+		},
+	})
+
+	suite.Require().NoError(retry.Constant(time.Minute, retry.WithUnits(time.Second)).RetryWithContext(nodeCtx, func(ctx context.Context) error {
+		_, err := suite.Client.SecretsBundleExport(ctx, &machineapi.SecretsBundleExportRequest{AllowSecretsExport: true})
+		if client.StatusCode(err) != codes.FailedPrecondition {
+			return retry.ExpectedErrorf("expected the export to be disabled, got %v", err)
+		}
+
+		return nil
+	}))
+}
+
+func (suite *SecretsBundleSuite) exportBundle(nodeCtx context.Context) *secrets.Bundle {
+	resp, err := suite.Client.SecretsBundleExport(nodeCtx, &machineapi.SecretsBundleExportRequest{AllowSecretsExport: true})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Messages, 1)
+
+	bundle := &secrets.Bundle{
+		Clock: secrets.NewClock(),
+	}
+
+	// the bundle should be loadable the same way as the one written by 'talosctl gen secrets'
+	suite.Require().NoError(yaml.Unmarshal(resp.Messages[0].Bundle, bundle))
+	suite.Require().NoError(bundle.Validate())
+
+	return bundle
+}
+
+func init() {
+	allSuites = append(allSuites, new(SecretsBundleSuite))
+}
@@ -0,0 +1,146 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//go:build integration_api
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/siderolabs/talos/internal/integration/base"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	runtimeres "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// ServiceResourcesSuite verifies the resource protection of the system services.
+type ServiceResourcesSuite struct {
+	base.K8sSuite
+
+	ctx       context.Context //nolint:containedctx
+	ctxCancel context.CancelFunc
+}
+
+// SuiteName ...
+func (suite *ServiceResourcesSuite) SuiteName() string {
+	return "api.ServiceResourcesSuite"
+}
+
+// SetupTest ...
+func (suite *ServiceResourcesSuite) SetupTest() {
+	if !suite.Capabilities().RunsTalosKernel {
+		suite.T().Skip("skipping service resources test since cgroups are not managed by Talos")
+	}
+
+	suite.ctx, suite.ctxCancel = context.WithTimeout(context.Background(), 5*time.Minute)
+}
+
+// TearDownTest ...
+func (suite *ServiceResourcesSuite) TearDownTest() {
+	if suite.ctxCancel != nil {
+		suite.ctxCancel()
+	}
+}
+
+func (suite *ServiceResourcesSuite) readCgroupValue(nodeCtx context.Context, cgroupPath, file string) uint64 {
+	r, err := suite.Client.Read(nodeCtx, filepath.Join(constants.CgroupMountPath, cgroupPath, file))
+	suite.Require().NoError(err)
+
+	value, err := io.ReadAll(r)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(r.Close())
+
+	parsed, err := strconv.ParseUint(string(bytes.TrimSpace(value)), 10, 64)
+	suite.Require().NoError(err)
+
+	return parsed
+}
+
+// TestEffectiveValues verifies that the memory protection of the running services matches the reported values.
+func (suite *ServiceResourcesSuite) TestEffectiveValues() {
+	node := suite.RandomDiscoveredNodeInternalIP(machine.TypeControlPlane)
+	nodeCtx := client.WithNode(suite.ctx, node)
+
+	for _, id := range []string{"apid", "trustd", "containerd", "cri", "kubelet", "etcd"} {
+		status, err := safe.StateGetByID[*runtimeres.ServiceResourcesStatus](nodeCtx, suite.Client.COSI, id)
+		suite.Require().NoError(err)
+
+		suite.Assert().Equal(status.TypedSpec().MemoryMin, suite.readCgroupValue(nodeCtx, status.TypedSpec().CgroupPath, "memory.min"), "service %s", id)
+		suite.Assert().Equal(status.TypedSpec().MemoryLow, suite.readCgroupValue(nodeCtx, status.TypedSpec().CgroupPath, "memory.low"), "service %s", id)
+	}
+}
+
+// TestMemoryHog verifies that the system services survive a pod consuming all available memory.
+func (suite *ServiceResourcesSuite) TestMemoryHog() {
+	if testing.Short() {
+		suite.T().Skip("skipping in short mode")
+	}
+
+	node := suite.RandomDiscoveredNodeInternalIP()
+	nodeCtx := client.WithNode(suite.ctx, node)
+
+	k8sNode, err := suite.GetK8sNodeByInternalIP(suite.ctx, node)
+	suite.Require().NoError(err)
+
+	lastEvents := suite.serviceLastEvents(nodeCtx)
+
+	hogPodDef, err := suite.NewPod("memory-hog")
+	suite.Require().NoError(err)
+
+	hogPodDef = hogPodDef.WithNodeName(k8sNode.Name)
+
+	suite.Require().NoError(hogPodDef.Create(suite.ctx, 5*time.Minute))
+
+	defer hogPodDef.Delete(suite.ctx) //nolint:errcheck
+
+	// the hog process allocates memory until it is OOM killed
+	_, _, err = hogPodDef.Exec(suite.ctx, "tail /dev/zero")
+	suite.Require().Error(err)
+
+	suite.T().Logf("memory hog was killed on node %s: %s", node, err)
+
+	// the system services should not be restarted
+	suite.Assert().Equal(lastEvents, suite.serviceLastEvents(nodeCtx))
+
+	_, err = suite.Client.MachineClient.Version(nodeCtx, &emptypb.Empty{})
+	suite.Require().NoError(err)
+}
+
+// serviceLastEvents returns the time of the last event of each service running on the node.
+func (suite *ServiceResourcesSuite) serviceLastEvents(nodeCtx context.Context) map[string]int64 {
+	resp, err := suite.Client.ServiceList(nodeCtx)
+	suite.Require().NoError(err)
+
+	lastEvents := map[string]int64{}
+
+	for _, msg := range resp.Messages {
+		for _, svc := range msg.Services {
+			events := svc.GetEvents().GetEvents()
+
+			if svc.GetState() != "Running" || len(events) == 0 {
+				continue
+			}
+
+			lastEvents[svc.GetId()] = events[len(events)-1].GetTs().AsTime().UnixNano()
+		}
+	}
+
+	return lastEvents
+}
+
+func init() {
+	allSuites = append(allSuites, new(ServiceResourcesSuite))
+}
@@ -12,6 +12,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/siderolabs/crypto/x509"
+
 	"github.com/siderolabs/talos/internal/integration/base"
 	"github.com/siderolabs/talos/pkg/machinery/client"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/security"
@@ -69,7 +71,11 @@ func (suite *TrustedRootsSuite) TestTrustedRoots() {
 
 	cfgDocument := security.NewTrustedRootsConfigV1Alpha1()
 	cfgDocument.MetaName = name
-	cfgDocument.Certificates = "--- BEGIN CERTIFICATE ---\nMIIC0DCCAbigAwIBAgIUI\n--- END CERTIFICATE ---\n"
+
+	ca, err := x509.NewSelfSignedCertificateAuthority(x509.Organization("test-ca"))
+	suite.Require().NoError(err)
+
+	cfgDocument.Certificates = string(ca.CrtPEM)
 
 	// clean up custom config if it exists
 	suite.RemoveMachineConfigDocuments(nodeCtx, cfgDocument.MetaKind)
@@ -95,6 +95,19 @@ func (suite *WatchdogSuite) TestWatchdogSysfs() {
 	_, err = suite.Client.COSI.WatchFor(nodeCtx, runtimeres.NewWatchdogTimerStatus(runtimeres.WatchdogTimerConfigID).Metadata(), state.WithEventTypes(state.Created, state.Updated))
 	suite.Require().NoError(err)
 
+	// the watchdog should be fed periodically
+	enabledAt := time.Now()
+
+	_, err = suite.Client.COSI.WatchFor(nodeCtx, runtimeres.NewWatchdogTimerStatus(runtimeres.WatchdogTimerConfigID).Metadata(),
+		state.WithEventTypes(state.Created, state.Updated),
+		state.WithCondition(func(r resource.Resource) (bool, error) {
+			spec := r.(*runtimeres.WatchdogTimerStatus).TypedSpec()
+
+			return spec.LastFeed.After(enabledAt) && spec.FeedBlockedBy == "", nil
+		}),
+	)
+	suite.Require().NoError(err)
+
 	wdState = suite.readWatchdogSysfs(nodeCtx, watchdog, "state")
 	suite.Require().Equal("active", wdState)
 
@@ -0,0 +1,169 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//go:build integration_provision
+
+package provision
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/siderolabs/go-retry/retry"
+
+	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
+	talosclient "github.com/siderolabs/talos/pkg/machinery/client"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+	"github.com/siderolabs/talos/pkg/provision"
+)
+
+// UpgradeInterruptedSuite verifies that an upgrade interrupted by a power loss is resolved on the next boot.
+type UpgradeInterruptedSuite struct {
+	BaseSuite
+
+	spec upgradeSpec
+}
+
+// SetupSuite ...
+func (suite *UpgradeInterruptedSuite) SetupSuite() {
+	suite.spec = upgradeCurrentToCurrent()
+	suite.spec.ShortName = fmt.Sprintf("%s-intr", DefaultSettings.CurrentVersion)
+	suite.spec.ControlplaneNodes = 1
+	suite.spec.WorkerNodes = 0
+
+	suite.BaseSuite.SetupSuite()
+}
+
+// TestPowerLoss powers off the node right after an upgrade checkpoint is written.
+func (suite *UpgradeInterruptedSuite) TestPowerLoss() {
+	suite.setupCluster(clusterOptions{
+		ClusterName: suite.spec.ShortName,
+
+		ControlplaneNodes: suite.spec.ControlplaneNodes,
+		WorkerNodes:       suite.spec.WorkerNodes,
+
+		SourceKernelPath:     suite.spec.SourceKernelPath,
+		SourceInitramfsPath:  suite.spec.SourceInitramfsPath,
+		SourceInstallerImage: suite.spec.SourceInstallerImage,
+		SourceVersion:        suite.spec.SourceVersion,
+		SourceK8sVersion:     suite.spec.SourceK8sVersion,
+	})
+
+	client, err := suite.clusterAccess.Client()
+	suite.Require().NoError(err)
+
+	node := suite.Cluster.Info().Nodes[0]
+
+	for _, test := range []struct {
+		phase string
+
+		expectedPhase   string
+		expectedOutcome string
+	}{
+		{
+			// workloads are being stopped, the new image is not installed yet
+			phase: "checkpointPrepare",
+
+			expectedOutcome: runtime.UpgradeOutcomeRolledBack,
+		},
+		{
+			// the new image is installed, but the machine is not rebooted yet
+			phase: "checkpointInstalled",
+
+			expectedPhase:   runtime.UpgradePhaseBoot,
+			expectedOutcome: runtime.UpgradeOutcomeSucceeded,
+		},
+	} {
+		suite.Run(test.phase, func() {
+			suite.interruptUpgrade(client, node, test.phase)
+
+			nodeCtx := talosclient.WithNode(suite.ctx, node.IPs[0].String())
+
+			suite.Require().NoError(
+				retry.Constant(10*time.Minute, retry.WithUnits(5*time.Second)).Retry(
+					func() error {
+						status, err := safe.StateGetByID[*runtime.UpgradeStatus](nodeCtx, client.COSI, runtime.UpgradeStatusID)
+						if err != nil {
+							// API might be unresponsive while the node boots
+							return retry.ExpectedError(err)
+						}
+
+						if status.TypedSpec().Outcome != test.expectedOutcome {
+							return retry.ExpectedErrorf("upgrade outcome is %q, phase %q", status.TypedSpec().Outcome, status.TypedSpec().Phase)
+						}
+
+						if test.expectedPhase != "" {
+							suite.Assert().Equal(test.expectedPhase, status.TypedSpec().Phase)
+						}
+
+						suite.Assert().Equal(suite.spec.TargetInstallerImage, status.TypedSpec().Image)
+
+						return nil
+					},
+				),
+			)
+
+			suite.assertSameVersionCluster(client, suite.spec.TargetVersion)
+
+			suite.waitForClusterHealth()
+		})
+	}
+}
+
+// interruptUpgrade starts the upgrade and powers off the node once the phase is finished.
+func (suite *UpgradeInterruptedSuite) interruptUpgrade(client *talosclient.Client, node provision.NodeInfo, phase string) {
+	ctx, cancel := context.WithCancel(suite.ctx)
+	defer cancel()
+
+	nodeCtx := talosclient.WithNodes(ctx, node.IPs[0].String())
+
+	resp, err := client.Upgrade(nodeCtx, suite.spec.TargetInstallerImage, false, false)
+	suite.Require().NoError(err)
+
+	eventCh := make(chan talosclient.EventResult)
+
+	suite.Require().NoError(client.EventsWatchV2(nodeCtx, eventCh, talosclient.WithActorID(resp.Messages[0].ActorId), talosclient.WithTailEvents(-1)))
+
+	waitTimer := time.NewTimer(5 * time.Minute)
+	defer waitTimer.Stop()
+
+waitLoop:
+	for {
+		select {
+		case ev := <-eventCh:
+			suite.Require().NoError(ev.Error)
+
+			switch msg := ev.Event.Payload.(type) {
+			case *machineapi.SequenceEvent:
+				if msg.Error != nil {
+					suite.FailNow("upgrade failed", "%s: %s", msg.Error.Message, msg.Error.Code)
+				}
+			case *machineapi.PhaseEvent:
+				if msg.Action == machineapi.PhaseEvent_STOP && msg.Phase == phase {
+					break waitLoop
+				}
+			}
+		case <-waitTimer.C:
+			suite.FailNow("timeout waiting for upgrade phase", "phase %q", phase)
+		case <-ctx.Done():
+			suite.FailNow("context canceled")
+		}
+	}
+
+	suite.T().Logf("powering off node %s after upgrade phase %q", node.Name, phase)
+
+	suite.Require().NoError(suite.provisioner.StopNodes(suite.ctx, suite.Cluster, []string{node.Name}))
+	suite.Require().NoError(suite.provisioner.StartNodes(suite.ctx, suite.Cluster, []string{node.Name}))
+}
+
+// SuiteName ...
+func (suite *UpgradeInterruptedSuite) SuiteName() string {
+	return "provision.UpgradeInterruptedSuite"
+}
+
+func init() {
+	allSuites = append(allSuites, &UpgradeInterruptedSuite{})
+}
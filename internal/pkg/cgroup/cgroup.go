@@ -151,6 +151,12 @@ func getCgroupV2Resources(name string) *cgroup2.Resources {
 				Weight: pointer.To[uint64](MillicoresToCPUWeight(MilliCores(constants.CgroupKubeletMillicores))),
 			},
 		}
+	case constants.CgroupEtcd:
+		return &cgroup2.Resources{
+			Memory: &cgroup2.Memory{
+				Low: pointer.To[int64](constants.CgroupEtcdReservedMemory),
+			},
+		}
 	case constants.CgroupDashboard:
 		return &cgroup2.Resources{
 			Memory: &cgroup2.Memory{
@@ -189,10 +195,82 @@ func getCgroupV2Resources(name string) *cgroup2.Resources {
 	return &cgroup2.Resources{}
 }
 
+// MemoryProtection is the memory protection of the cgroup.
+type MemoryProtection struct {
+	// Min is the hard memory protection (memory.min).
+	Min uint64
+	// Low is the soft memory protection (memory.low).
+	Low uint64
+}
+
+// DefaultMemoryProtection returns the default memory protection of the cgroup.
+func DefaultMemoryProtection(name string) MemoryProtection {
+	resources := getCgroupV2Resources(name)
+
+	if resources.Memory == nil {
+		return MemoryProtection{}
+	}
+
+	return MemoryProtection{
+		Min: uint64(pointer.SafeDeref(resources.Memory.Min)),
+		Low: uint64(pointer.SafeDeref(resources.Memory.Low)),
+	}
+}
+
+func (p MemoryProtection) apply(resources *cgroup2.Resources) {
+	if resources.Memory == nil {
+		resources.Memory = &cgroup2.Memory{}
+	}
+
+	resources.Memory.Min = pointer.To(int64(p.Min))
+	resources.Memory.Low = pointer.To(int64(p.Low))
+}
+
+// CreateOption is an option for CreateCgroup.
+type CreateOption func(*cgroup2.Resources)
+
+// WithMemoryProtection overrides the default memory protection of the cgroup.
+func WithMemoryProtection(p MemoryProtection) CreateOption {
+	return p.apply
+}
+
+// UpdateMemoryProtection updates the memory protection of the existing cgroup.
+//
+// If the cgroup doesn't exist (e.g. the service is not running), the update is skipped,
+// as the memory protection is applied once the cgroup is created.
+func UpdateMemoryProtection(name string, p MemoryProtection) error {
+	if containermode.InContainer() || cgroups.Mode() != cgroups.Unified {
+		return nil
+	}
+
+	if _, err := os.Stat(filepath.Join(constants.CgroupMountPath, Path(name))); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	cg, err := cgroup2.Load(Path(name))
+	if err != nil {
+		return fmt.Errorf("failed to load cgroup: %w", err)
+	}
+
+	var resources cgroup2.Resources
+
+	p.apply(&resources)
+
+	return cg.Update(&resources)
+}
+
 // CreateCgroup creates a cgroup, with resources limits if configured and supported.
-func CreateCgroup(name string) (CommonCgroup, error) {
+func CreateCgroup(name string, opts ...CreateOption) (CommonCgroup, error) {
 	resources := getCgroupV2Resources(name)
 
+	for _, opt := range opts {
+		opt(resources)
+	}
+
 	if containermode.InContainer() {
 		// don't attempt to set resources in container mode, as they might conflict with the parent cgroup tree
 		resources = &cgroup2.Resources{}
@@ -43,8 +43,11 @@ type HostsFile struct {
 
 // GenerateHosts generates a structure describing contents of the containerd hosts configuration.
 //
-//nolint:gocyclo
-func GenerateHosts(cfg config.Registries, basePath string) (*HostsConfig, error) {
+// If trustedRootsPath is not empty, registries without explicit CA configuration verify TLS against the
+// bundle at this path. Containerd reads the bundle on each pull, so changes to it apply without a restart.
+//
+//nolint:gocyclo,cyclop
+func GenerateHosts(cfg config.Registries, basePath, trustedRootsPath string) (*HostsConfig, error) {
 	config := &HostsConfig{
 		Directories: map[string]*HostsDirectory{},
 	}
@@ -101,6 +104,14 @@ func GenerateHosts(cfg config.Registries, basePath string) (*HostsConfig, error)
 		}
 	}
 
+	configureTrustedRoots := func(hostToml *HostToml) {
+		if trustedRootsPath == "" || hostToml.CACert != "" || hostToml.SkipVerify {
+			return
+		}
+
+		hostToml.CACert = trustedRootsPath
+	}
+
 	// process mirrors
 	for registryName, endpoints := range cfg.Mirrors() {
 		directoryName := hostDirectory(registryName)
@@ -124,6 +135,7 @@ func GenerateHosts(cfg config.Registries, basePath string) (*HostsConfig, error)
 			}
 
 			configureEndpoint(u.Host, directoryName, &hostEntry.HostToml, directory)
+			configureTrustedRoots(&hostEntry.HostToml)
 
 			hostsConfig.HostEntries = append(hostsConfig.HostEntries, hostEntry)
 		}
@@ -181,6 +193,7 @@ func GenerateHosts(cfg config.Registries, basePath string) (*HostsConfig, error)
 		}
 
 		configureEndpoint(hostname, directoryName, &rootEntry.HostToml, directory)
+		configureTrustedRoots(&rootEntry.HostToml)
 
 		hostsToml := HostsConfiguration{
 			RootEntry: optional.Some(rootEntry),
@@ -202,6 +215,32 @@ func GenerateHosts(cfg config.Registries, basePath string) (*HostsConfig, error)
 		config.Directories[directoryName] = directory
 	}
 
+	// registries which are not configured explicitly fall back to the default directory
+	if _, ok := config.Directories[hostDirectory("*")]; !ok && trustedRootsPath != "" {
+		hostsToml := HostsConfiguration{
+			RootEntry: optional.Some(HostEntry{
+				HostToml: HostToml{
+					CACert: trustedRootsPath,
+				},
+			}),
+		}
+
+		cfgOut, err := hostsToml.RenderTOML()
+		if err != nil {
+			return nil, err
+		}
+
+		config.Directories[hostDirectory("*")] = &HostsDirectory{
+			Files: []*HostsFile{
+				{
+					Name:     "hosts.toml",
+					Mode:     0o600,
+					Contents: cfgOut,
+				},
+			},
+		}
+	}
+
 	return config, nil
 }
 
@@ -49,7 +49,7 @@ func TestGenerateHostsWithTLS(t *testing.T) {
 		},
 	}
 
-	result, err := containerd.GenerateHosts(cfg, "/etc/cri/conf.d/hosts")
+	result, err := containerd.GenerateHosts(cfg, "/etc/cri/conf.d/hosts", "")
 	require.NoError(t, err)
 
 	assert.Equal(t, &containerd.HostsConfig{
@@ -122,7 +122,7 @@ func TestGenerateHostsWithoutTLS(t *testing.T) {
 		},
 	}
 
-	result, err := containerd.GenerateHosts(cfg, "/etc/cri/conf.d/hosts")
+	result, err := containerd.GenerateHosts(cfg, "/etc/cri/conf.d/hosts", "")
 	require.NoError(t, err)
 
 	assert.Equal(t, &containerd.HostsConfig{
@@ -161,7 +161,7 @@ func TestGenerateHostsTLSWildcardWrong(t *testing.T) {
 		},
 	}
 
-	_, err := containerd.GenerateHosts(cfg, "/etc/cri/conf.d/hosts")
+	_, err := containerd.GenerateHosts(cfg, "/etc/cri/conf.d/hosts", "")
 	assert.EqualError(t, err, "wildcard host TLS configuration is not supported")
 }
 
@@ -181,7 +181,7 @@ func TestGenerateHostsTLSWildcard(t *testing.T) {
 		},
 	}
 
-	result, err := containerd.GenerateHosts(cfg, "/etc/cri/conf.d/hosts")
+	result, err := containerd.GenerateHosts(cfg, "/etc/cri/conf.d/hosts", "")
 	require.NoError(t, err)
 
 	assert.Equal(t, &containerd.HostsConfig{
@@ -245,7 +245,7 @@ func TestGenerateHostsWithHarbor(t *testing.T) {
 		},
 	}
 
-	result, err := containerd.GenerateHosts(cfg, "/etc/cri/conf.d/hosts")
+	result, err := containerd.GenerateHosts(cfg, "/etc/cri/conf.d/hosts", "")
 	require.NoError(t, err)
 
 	t.Logf(
@@ -301,7 +301,7 @@ func TestGenerateHostsSkipFallback(t *testing.T) {
 		},
 	}
 
-	result, err := containerd.GenerateHosts(cfg, "/etc/cri/conf.d/hosts")
+	result, err := containerd.GenerateHosts(cfg, "/etc/cri/conf.d/hosts", "")
 	require.NoError(t, err)
 
 	t.Logf(
@@ -336,3 +336,60 @@ func TestGenerateHostsSkipFallback(t *testing.T) {
 		},
 	}, result)
 }
+
+func TestGenerateHostsWithTrustedRoots(t *testing.T) {
+	cfg := &mockConfig{
+		mirrors: map[string]*v1alpha1.RegistryMirrorConfig{
+			"docker.io": {
+				MirrorEndpoints: []string{"https://registry-1.docker.io"},
+			},
+		},
+		config: map[string]*v1alpha1.RegistryConfig{
+			"some.host:123": {
+				RegistryTLS: &v1alpha1.RegistryTLSConfig{
+					TLSCA: []byte("cacert"),
+				},
+			},
+		},
+	}
+
+	result, err := containerd.GenerateHosts(cfg, "/etc/cri/conf.d/hosts", "/etc/ssl/certs/ca-certificates.crt")
+	require.NoError(t, err)
+
+	assert.Equal(t, &containerd.HostsConfig{
+		Directories: map[string]*containerd.HostsDirectory{
+			"docker.io": {
+				Files: []*containerd.HostsFile{
+					{
+						Name:     "hosts.toml",
+						Mode:     0o600,
+						Contents: []byte("[host]\n  [host.'https://registry-1.docker.io']\n    capabilities = ['pull', 'resolve']\n    ca = '/etc/ssl/certs/ca-certificates.crt'\n"), //nolint:lll
+					},
+				},
+			},
+			"some.host_123_": {
+				Files: []*containerd.HostsFile{
+					{
+						Name:     "some.host:123-ca.crt",
+						Mode:     0o600,
+						Contents: []byte("cacert"),
+					},
+					{
+						Name:     "hosts.toml",
+						Mode:     0o600,
+						Contents: []byte("server = 'https://some.host:123'\nca = '/etc/cri/conf.d/hosts/some.host_123_/some.host:123-ca.crt'\n"),
+					},
+				},
+			},
+			"_default": {
+				Files: []*containerd.HostsFile{
+					{
+						Name:     "hosts.toml",
+						Mode:     0o600,
+						Contents: []byte("ca = '/etc/ssl/certs/ca-certificates.crt'\n"),
+					},
+				},
+			},
+		},
+	}, result)
+}
@@ -237,6 +237,18 @@ func (source *Source) gather() *Data {
 				node.ServiceList = value
 			})
 
+			return nil
+		},
+		func() error {
+			resp, err := source.MachineClient.ServiceDependencies(source.ctx, &machine.ServiceDependenciesRequest{})
+			if err != nil {
+				return err
+			}
+
+			unpack(source, result.Nodes, &resultLock, resp, func(node *Node, value *machine.ServiceDependencies) {
+				node.ServiceDependencies = value
+			})
+
 			return nil
 		},
 	}
@@ -5,6 +5,9 @@
 package components
 
 import (
+	"cmp"
+	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/cosi-project/runtime/pkg/resource"
@@ -13,7 +16,9 @@ import (
 
 	"github.com/siderolabs/talos/internal/pkg/dashboard/apidata"
 	"github.com/siderolabs/talos/internal/pkg/dashboard/resourcedata"
+	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+	"github.com/siderolabs/talos/pkg/machinery/formatters"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
 )
@@ -30,8 +35,9 @@ type kubernetesInfoData struct {
 	kubernetesVersion string
 	kubeletStatus     string
 
-	podStatuses        staticPodStatuses
-	staticPodStatusMap map[resource.ID]*k8s.StaticPodStatus
+	podStatuses               staticPodStatuses
+	staticPodStatusMap        map[resource.ID]*k8s.StaticPodStatus
+	staticPodRuntimeStatusMap map[resource.ID]*k8s.StaticPodRuntimeStatus
 }
 
 // KubernetesInfo represents the kubernetes info widget.
@@ -105,7 +111,15 @@ func (widget *KubernetesInfo) updateNodeData(data resourcedata.Data) {
 			nodeData.staticPodStatusMap[res.Metadata().ID()] = res
 		}
 
-		nodeData.podStatuses = widget.staticPodStatuses(maps.Values(nodeData.staticPodStatusMap))
+		nodeData.podStatuses = widget.staticPodStatuses(maps.Values(nodeData.staticPodStatusMap), nodeData.staticPodRuntimeStatusMap)
+	case *k8s.StaticPodRuntimeStatus:
+		if data.Deleted {
+			delete(nodeData.staticPodRuntimeStatusMap, res.Metadata().ID())
+		} else {
+			nodeData.staticPodRuntimeStatusMap[res.Metadata().ID()] = res
+		}
+
+		nodeData.podStatuses = widget.staticPodStatuses(maps.Values(nodeData.staticPodStatusMap), nodeData.staticPodRuntimeStatusMap)
 	case *config.MachineType:
 		if data.Deleted {
 			nodeData.isControlPlane = false
@@ -125,12 +139,30 @@ func (widget *KubernetesInfo) updateNodeAPIData(node string, data *apidata.Node)
 			if info.Id == "kubelet" {
 				nodeData.kubeletStatus = toHealthStatus(info.GetHealth().Healthy)
 
+				if blockedOn := serviceBlockedOn(info, data.ServiceDependencies); blockedOn != "" {
+					nodeData.kubeletStatus = "Waiting for " + blockedOn
+				}
+
 				break
 			}
 		}
 	}
 }
 
+// serviceBlockedOn returns the innermost condition in the chain of conditions the starting service is blocked on.
+func serviceBlockedOn(info *machineapi.ServiceInfo, deps *machineapi.ServiceDependencies) string {
+	if info.GetState() != "Starting" && info.GetState() != "Waiting" {
+		return ""
+	}
+
+	blockers := formatters.ServiceBlockers(deps.GetServices(), info.GetId())
+	if len(blockers) == 0 {
+		return ""
+	}
+
+	return slices.MaxFunc(blockers, func(a, b formatters.ServiceBlocker) int { return cmp.Compare(a.Depth, b.Depth) }).Condition.GetDescription()
+}
+
 func (widget *KubernetesInfo) getOrCreateNodeData(node string) *kubernetesInfoData {
 	nodeData, ok := widget.nodeMap[node]
 	if !ok {
@@ -143,7 +175,8 @@ func (widget *KubernetesInfo) getOrCreateNodeData(node string) *kubernetesInfoDa
 				controllerManager: notAvailable,
 				scheduler:         notAvailable,
 			},
-			staticPodStatusMap: make(map[resource.ID]*k8s.StaticPodStatus),
+			staticPodStatusMap:        make(map[resource.ID]*k8s.StaticPodStatus),
+			staticPodRuntimeStatusMap: make(map[resource.ID]*k8s.StaticPodRuntimeStatus),
 		}
 
 		widget.nodeMap[node] = nodeData
@@ -194,7 +227,7 @@ func (widget *KubernetesInfo) redraw() {
 	widget.SetText(fields.String())
 }
 
-func (widget *KubernetesInfo) staticPodStatuses(statuses []*k8s.StaticPodStatus) staticPodStatuses {
+func (widget *KubernetesInfo) staticPodStatuses(statuses []*k8s.StaticPodStatus, runtimeStatuses map[resource.ID]*k8s.StaticPodRuntimeStatus) staticPodStatuses {
 	result := staticPodStatuses{
 		apiServer:         notAvailable,
 		controllerManager: notAvailable,
@@ -239,5 +272,32 @@ func (widget *KubernetesInfo) staticPodStatuses(statuses []*k8s.StaticPodStatus)
 		}
 	}
 
+	result.apiServer = withRuntimeStatus(result.apiServer, runtimeStatuses[k8s.APIServerID])
+	result.controllerManager = withRuntimeStatus(result.controllerManager, runtimeStatuses[k8s.ControllerManagerID])
+	result.scheduler = withRuntimeStatus(result.scheduler, runtimeStatuses[k8s.SchedulerID])
+
 	return result
 }
+
+// withRuntimeStatus amends the static pod readiness with the container runtime state.
+func withRuntimeStatus(status string, runtimeStatus *k8s.StaticPodRuntimeStatus) string {
+	if runtimeStatus == nil {
+		return status
+	}
+
+	spec := runtimeStatus.TypedSpec()
+
+	if spec.Phase != k8s.StaticPodPhaseRunning {
+		status = formatText(spec.Phase, false)
+	}
+
+	if spec.RestartStorm {
+		return status + " " + formatText(fmt.Sprintf("%d restarts, crash looping", spec.RestartCount), false)
+	}
+
+	if spec.RestartCount > 0 {
+		return fmt.Sprintf("%s (%d restarts)", status, spec.RestartCount)
+	}
+
+	return status
+}
@@ -5,6 +5,7 @@
 package components
 
 import (
+	"fmt"
 	"net/netip"
 	"slices"
 	"strings"
@@ -30,12 +31,14 @@ type networkInfoData struct {
 	connectivity string
 	resolvers    string
 	timeservers  string
+	probes       string
 
 	addresses              string
 	nodeAddressRouted      *network.NodeAddress
 	nodeAddressRoutedNoK8s *network.NodeAddress
 
 	routeStatusMap map[resource.ID]*network.RouteStatus
+	probeStatusMap map[resource.ID]*network.ProbeStatus
 }
 
 // NetworkInfo represents the network info widget.
@@ -115,6 +118,14 @@ func (widget *NetworkInfo) updateNodeData(data resourcedata.Data) {
 		}
 
 		nodeData.gateway = widget.gateway(maps.Values(nodeData.routeStatusMap))
+	case *network.ProbeStatus:
+		if data.Deleted {
+			delete(nodeData.probeStatusMap, res.Metadata().ID())
+		} else {
+			nodeData.probeStatusMap[res.Metadata().ID()] = res
+		}
+
+		nodeData.probes = widget.probes(maps.Values(nodeData.probeStatusMap))
 	case *network.NodeAddress:
 		widget.setAddresses(data, res)
 	}
@@ -130,7 +141,9 @@ func (widget *NetworkInfo) getOrCreateNodeData(node string) *networkInfoData {
 			connectivity:   notAvailable,
 			resolvers:      notAvailable,
 			timeservers:    notAvailable,
+			probes:         none,
 			routeStatusMap: make(map[resource.ID]*network.RouteStatus),
+			probeStatusMap: make(map[resource.ID]*network.ProbeStatus),
 		}
 
 		widget.nodeMap[node] = data
@@ -168,6 +181,10 @@ func (widget *NetworkInfo) redraw() {
 				Name:  "NTP",
 				Value: data.timeservers,
 			},
+			{
+				Name:  "PROBES",
+				Value: data.probes,
+			},
 		},
 	}
 
@@ -267,3 +284,25 @@ func (widget *NetworkInfo) connectivity(status *network.Status) string {
 
 	return "[red]× FAILED[-]"
 }
+
+func (widget *NetworkInfo) probes(statuses []*network.ProbeStatus) string {
+	if len(statuses) == 0 {
+		return none
+	}
+
+	var failed []string
+
+	for _, status := range statuses {
+		if !status.TypedSpec().Success {
+			failed = append(failed, status.Metadata().ID())
+		}
+	}
+
+	if len(failed) == 0 {
+		return fmt.Sprintf("[green]√ %d/%d OK[-]", len(statuses), len(statuses))
+	}
+
+	slices.Sort(failed)
+
+	return fmt.Sprintf("[red]× %d/%d FAILED: %s[-]", len(failed), len(statuses), strings.Join(failed, ", "))
+}
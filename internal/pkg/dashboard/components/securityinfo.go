@@ -0,0 +1,180 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rivo/tview"
+
+	"github.com/siderolabs/talos/internal/pkg/dashboard/resourcedata"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+type securityInfoData struct {
+	secureBoot string
+	signingKey string
+	uki        string
+	tpm        string
+	pcrBanks   string
+	eventLog   string
+}
+
+// SecurityInfo represents the SecureBoot and TPM info widget.
+type SecurityInfo struct {
+	tview.TextView
+
+	selectedNode string
+	nodeMap      map[string]*securityInfoData
+}
+
+// NewSecurityInfo initializes SecurityInfo.
+func NewSecurityInfo() *SecurityInfo {
+	widget := &SecurityInfo{
+		TextView: *tview.NewTextView(),
+		nodeMap:  make(map[string]*securityInfoData),
+	}
+
+	widget.SetDynamicColors(true).
+		SetText(noData).
+		SetBorderPadding(1, 0, 1, 0)
+
+	return widget
+}
+
+// OnNodeSelect implements the NodeSelectListener interface.
+func (widget *SecurityInfo) OnNodeSelect(node string) {
+	if node != widget.selectedNode {
+		widget.selectedNode = node
+
+		widget.redraw()
+	}
+}
+
+// OnResourceDataChange implements the ResourceDataListener interface.
+func (widget *SecurityInfo) OnResourceDataChange(data resourcedata.Data) {
+	widget.updateNodeData(data)
+
+	if data.Node == widget.selectedNode {
+		widget.redraw()
+	}
+}
+
+func (widget *SecurityInfo) updateNodeData(data resourcedata.Data) {
+	nodeData := widget.getOrCreateNodeData(data.Node)
+
+	switch res := data.Resource.(type) {
+	case *runtime.SecureBootStatus:
+		if data.Deleted {
+			nodeData.secureBoot = notAvailable
+			nodeData.signingKey = notAvailable
+			nodeData.uki = notAvailable
+
+			return
+		}
+
+		spec := res.TypedSpec()
+
+		switch {
+		case spec.Enforced():
+			nodeData.secureBoot = formatText("Enforced", true)
+		case spec.SetupMode:
+			nodeData.secureBoot = formatText("Setup mode", false)
+		default:
+			nodeData.secureBoot = formatText("Disabled", false)
+		}
+
+		nodeData.signingKey = formatText(enrolledText(spec.SigningKeyEnrolled), spec.SigningKeyEnrolled)
+		nodeData.uki = formatStatus(spec.UKIVerified)
+	case *runtime.TPMStatus:
+		if data.Deleted {
+			nodeData.tpm = notAvailable
+			nodeData.pcrBanks = notAvailable
+
+			return
+		}
+
+		spec := res.TypedSpec()
+
+		nodeData.tpm = strings.TrimSpace(spec.Manufacturer + " " + spec.FirmwareVersion)
+		nodeData.pcrBanks = strings.Join(spec.PCRBanks, ", ")
+
+		if nodeData.pcrBanks == "" {
+			nodeData.pcrBanks = none
+		}
+	case *runtime.MeasuredBootStatus:
+		if data.Deleted || res.TypedSpec().EventLogDigest == "" {
+			nodeData.eventLog = notAvailable
+
+			return
+		}
+
+		spec := res.TypedSpec()
+
+		nodeData.eventLog = formatText(fmt.Sprintf("%d events", spec.EventLogEvents), spec.EventLogVerified)
+	}
+}
+
+func enrolledText(enrolled bool) string {
+	if enrolled {
+		return "Enrolled"
+	}
+
+	return "Not enrolled"
+}
+
+func (widget *SecurityInfo) getOrCreateNodeData(node string) *securityInfoData {
+	nodeData, ok := widget.nodeMap[node]
+	if !ok {
+		nodeData = &securityInfoData{
+			secureBoot: notAvailable,
+			signingKey: notAvailable,
+			uki:        notAvailable,
+			tpm:        notAvailable,
+			pcrBanks:   notAvailable,
+			eventLog:   notAvailable,
+		}
+
+		widget.nodeMap[node] = nodeData
+	}
+
+	return nodeData
+}
+
+func (widget *SecurityInfo) redraw() {
+	data := widget.getOrCreateNodeData(widget.selectedNode)
+
+	fields := fieldGroup{
+		fields: []field{
+			{
+				Name:  "SECUREBOOT",
+				Value: data.secureBoot,
+			},
+			{
+				Name:  "SIGNING KEY",
+				Value: data.signingKey,
+			},
+			{
+				Name:  "UKI VERIFIED",
+				Value: data.uki,
+			},
+			{
+				Name:  "TPM",
+				Value: data.tpm,
+			},
+			{
+				Name:  "PCR BANKS",
+				Value: data.pcrBanks,
+			},
+			{
+				Name:  "EVENT LOG",
+				Value: data.eventLog,
+			},
+		},
+	}
+
+	widget.SetText(fields.String())
+}
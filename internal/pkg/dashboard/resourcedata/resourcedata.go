@@ -109,6 +109,9 @@ func (source *Source) runResourceWatch(ctx context.Context, node string) error {
 	watchResources := []resource.Pointer{
 		runtime.NewMachineStatus().Metadata(),
 		runtime.NewSecurityStateSpec(v1alpha1.NamespaceName).Metadata(),
+		runtime.NewSecureBootStatus().Metadata(),
+		runtime.NewTPMStatus().Metadata(),
+		runtime.NewMeasuredBootStatus().Metadata(),
 		config.NewMachineType().Metadata(),
 		k8s.NewKubeletSpec(k8s.NamespaceName, k8s.KubeletID).Metadata(),
 		network.NewResolverStatus(network.NamespaceName, network.ResolverID).Metadata(),
@@ -129,10 +132,12 @@ func (source *Source) runResourceWatch(ctx context.Context, node string) error {
 	watchKindResources := []resource.Pointer{
 		runtime.NewMetaKey(runtime.NamespaceName, "").Metadata(),
 		k8s.NewStaticPodStatus(k8s.NamespaceName, "").Metadata(),
+		k8s.NewStaticPodRuntimeStatus(k8s.NamespaceName, "").Metadata(),
 		network.NewRouteStatus(network.NamespaceName, "").Metadata(),
 		network.NewLinkStatus(network.NamespaceName, "").Metadata(),
 		cluster.NewMember(cluster.NamespaceName, "").Metadata(),
 		network.NewNodeAddress(network.NamespaceName, "").Metadata(),
+		network.NewProbeStatus(network.NamespaceName, "").Metadata(),
 		siderolink.NewStatus().Metadata(),
 		runtime.NewDiagnostic(runtime.NamespaceName, "").Metadata(),
 	}
@@ -40,7 +40,7 @@ func NewSummaryGrid(app *tview.Application) *SummaryGrid {
 		logViewers: make(map[string]*components.LogViewer),
 	}
 
-	widget.SetRows(summaryTopFixedRows, 0).SetColumns(-3, -2, -3)
+	widget.SetRows(summaryTopFixedRows, 0).SetColumns(-3, -2, -3, -3)
 
 	talosInfo := components.NewTalosInfo()
 	widget.AddItem(talosInfo, 0, 0, 1, 1, 0, 0, false)
@@ -51,6 +51,9 @@ func NewSummaryGrid(app *tview.Application) *SummaryGrid {
 	networkInfo := components.NewNetworkInfo()
 	widget.AddItem(networkInfo, 0, 2, 1, 1, 0, 0, false)
 
+	securityInfo := components.NewSecurityInfo()
+	widget.AddItem(securityInfo, 0, 3, 1, 1, 0, 0, false)
+
 	widget.diagnostics = components.NewDiagnostics()
 
 	widget.apiDataListeners = []APIDataListener{
@@ -61,6 +64,7 @@ func NewSummaryGrid(app *tview.Application) *SummaryGrid {
 		talosInfo,
 		kubernetesInfo,
 		networkInfo,
+		securityInfo,
 		widget.diagnostics,
 	}
 
@@ -68,6 +72,7 @@ func NewSummaryGrid(app *tview.Application) *SummaryGrid {
 		talosInfo,
 		kubernetesInfo,
 		networkInfo,
+		securityInfo,
 		widget.diagnostics,
 	}
 
@@ -118,7 +123,7 @@ func (widget *SummaryGrid) updateDiagnostics() {
 		widget.diagnosticsVisible = false
 	case height > 0 && !widget.diagnosticsVisible:
 		widget.SetRows(summaryTopFixedRows, 0, height)
-		widget.AddItem(widget.diagnostics, 2, 0, 1, 3, 0, 0, false)
+		widget.AddItem(widget.diagnostics, 2, 0, 1, 4, 0, 0, false)
 		widget.diagnosticsVisible = true
 	case height > 0:
 		widget.SetRows(summaryTopFixedRows, 0, height)
@@ -134,7 +139,7 @@ func (widget *SummaryGrid) updateLogViewer() {
 
 	for currNode, logViewer := range widget.logViewers {
 		if currNode == widget.node {
-			widget.AddItem(logViewer, 1, 0, 1, 3, 0, 0, false)
+			widget.AddItem(logViewer, 1, 0, 1, 4, 0, 0, false)
 
 			widget.app.SetFocus(logViewer)
 
@@ -0,0 +1,378 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dns
+
+import (
+	"cmp"
+	"container/list"
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coredns/coredns/plugin"
+	"github.com/coredns/coredns/request"
+	"github.com/miekg/dns"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// staleTTL is the TTL of the stale responses, as recommended by RFC 8767.
+const staleTTL = 30 * time.Second
+
+// CacheOptions configures the Cache.
+type CacheOptions struct {
+	// Size is the maximum number of cached responses, zero means the default size.
+	Size int
+	// MinTTL and MaxTTL clamp the TTL of the cached positive responses, zero means no clamping.
+	MinTTL time.Duration
+	MaxTTL time.Duration
+	// NegativeTTL is the maximum TTL of the cached negative responses, zero disables negative caching.
+	NegativeTTL time.Duration
+	// ServeStale is the duration expired responses are served for if all upstreams fail, zero disables serving stale responses.
+	ServeStale time.Duration
+}
+
+// DefaultCacheOptions returns the default cache options.
+func DefaultCacheOptions() CacheOptions {
+	return CacheOptions{
+		Size:        constants.HostDNSDefaultCacheSize,
+		MaxTTL:      constants.HostDNSDefaultCacheMaxTTL,
+		NegativeTTL: constants.HostDNSDefaultNegativeCacheTTL,
+	}
+}
+
+// Cache is a caching [dns.Handler] which forwards cache misses to the next [plugin.Handler].
+type Cache struct {
+	next   plugin.Handler
+	logger *zap.Logger
+
+	mx      sync.Mutex
+	opts    CacheOptions
+	entries map[cacheKey]*list.Element
+	lru     *list.List
+}
+
+type cacheKey struct {
+	name   string
+	qtype  uint16
+	qclass uint16
+	do     bool
+	cd     bool
+}
+
+type cacheEntry struct {
+	key     cacheKey
+	msg     *dns.Msg
+	expires time.Time
+}
+
+// NewCache creates a new Cache.
+func NewCache(next plugin.Handler, l *zap.Logger) *Cache {
+	return &Cache{
+		next:    next,
+		logger:  l,
+		opts:    DefaultCacheOptions(),
+		entries: map[cacheKey]*list.Element{},
+		lru:     list.New(),
+	}
+}
+
+// ServeDNS implements [dns.Handler].
+func (c *Cache) ServeDNS(wr dns.ResponseWriter, msg *dns.Msg) {
+	wr = request.NewScrubWriter(msg, wr)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 4500*time.Millisecond)
+	defer cancel()
+
+	code, err := c.serveDNS(ctx, wr, msg)
+	if err != nil {
+		// we should probably call newProxy.Healthcheck() if there are too many errors
+		c.logger.Warn("error serving dns request", zap.Error(err))
+	}
+
+	if clientWrite(code) {
+		return
+	}
+
+	// Something went wrong
+	state := request.Request{W: wr, Req: msg}
+
+	answer := new(dns.Msg)
+	answer.SetRcode(msg, code)
+	state.SizeAndDo(answer)
+
+	err = wr.WriteMsg(answer)
+	if err != nil {
+		c.logger.Warn("error writing dns response", zap.Error(err))
+	}
+}
+
+func (c *Cache) serveDNS(ctx context.Context, wr dns.ResponseWriter, msg *dns.Msg) (int, error) {
+	if msg.Opcode != dns.OpcodeQuery || len(msg.Question) != 1 {
+		return c.next.ServeDNS(ctx, wr, msg)
+	}
+
+	state := request.Request{W: wr, Req: msg}
+	key := cacheKey{
+		name:   strings.ToLower(state.Name()),
+		qtype:  state.QType(),
+		qclass: state.QClass(),
+		do:     state.Do(),
+		cd:     msg.CheckingDisabled,
+	}
+
+	if resp := c.get(key, time.Now(), false); resp != nil {
+		return dns.RcodeSuccess, c.write(state, resp)
+	}
+
+	cw := &cacheWriter{ResponseWriter: wr, cache: c, state: state, key: key}
+
+	code, err := c.next.ServeDNS(ctx, cw, msg)
+	if clientWrite(code) || cw.written {
+		return code, err
+	}
+
+	if resp := c.get(key, time.Now(), true); resp != nil {
+		c.logger.Debug("serving stale dns response", zap.String("question", state.QName()), zap.Error(err))
+
+		return dns.RcodeSuccess, c.write(state, resp)
+	}
+
+	return code, err
+}
+
+// write the cached response to the client, fixing up the message ID, question and EDNS0 options.
+func (c *Cache) write(state request.Request, resp *dns.Msg) error {
+	resp.Id = state.Req.Id
+	resp.Question = state.Req.Question
+	state.SizeAndDo(resp)
+
+	return state.W.WriteMsg(resp)
+}
+
+// get returns a copy of the cached response with adjusted TTLs.
+//
+// If stale is true, the response might be expired up to the configured ServeStale duration.
+func (c *Cache) get(key cacheKey, now time.Time, stale bool) *dns.Msg {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil
+	}
+
+	entry := elem.Value.(*cacheEntry) //nolint:forcetypeassert,errcheck
+
+	remaining := entry.expires.Sub(now)
+
+	switch {
+	case remaining > 0:
+	case now.Sub(entry.expires) > c.opts.ServeStale:
+		c.remove(elem)
+
+		return nil
+	case !stale:
+		return nil
+	default:
+		remaining = staleTTL
+	}
+
+	c.lru.MoveToFront(elem)
+
+	resp := entry.msg.Copy()
+	setTTL(resp, uint32((remaining+time.Second-1)/time.Second))
+
+	return resp
+}
+
+// set stores the response in the cache.
+func (c *Cache) set(key cacheKey, resp *dns.Msg, now time.Time) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	ttl := c.ttl(resp)
+	if ttl <= 0 {
+		return
+	}
+
+	msg := resp.Copy()
+	msg.Extra = filterOPT(msg.Extra)
+	msg.Authoritative = false
+
+	entry := &cacheEntry{key: key, msg: msg, expires: now.Add(ttl)}
+
+	if elem, ok := c.entries[key]; ok {
+		elem.Value = entry
+		c.lru.MoveToFront(elem)
+
+		return
+	}
+
+	c.entries[key] = c.lru.PushFront(entry)
+
+	c.evict()
+}
+
+// ttl returns the duration the response should be cached for, zero if the response shouldn't be cached.
+func (c *Cache) ttl(resp *dns.Msg) time.Duration {
+	if resp.Truncated {
+		return 0
+	}
+
+	switch {
+	case resp.Rcode == dns.RcodeSuccess && len(resp.Answer) > 0:
+		ttl := minTTL(resp.Answer, resp.Ns)
+
+		if c.opts.MaxTTL > 0 {
+			ttl = min(ttl, c.opts.MaxTTL)
+		}
+
+		return max(ttl, c.opts.MinTTL)
+	case resp.Rcode == dns.RcodeNameError, resp.Rcode == dns.RcodeSuccess:
+		if c.opts.NegativeTTL == 0 {
+			return 0
+		}
+
+		// RFC 2308: negative responses are cached for the minimum of the SOA TTL and SOA MINIMUM
+		ttl := c.opts.NegativeTTL
+
+		for _, rr := range resp.Ns {
+			if soa, ok := rr.(*dns.SOA); ok {
+				ttl = min(ttl, time.Duration(min(soa.Hdr.Ttl, soa.Minttl))*time.Second)
+			}
+		}
+
+		return ttl
+	default:
+		return 0
+	}
+}
+
+func (c *Cache) remove(elem *list.Element) {
+	delete(c.entries, elem.Value.(*cacheEntry).key) //nolint:forcetypeassert,errcheck
+	c.lru.Remove(elem)
+}
+
+// evict removes the least recently used entries over the cache size.
+func (c *Cache) evict() {
+	for c.lru.Len() > cmp.Or(c.opts.Size, constants.HostDNSDefaultCacheSize) {
+		c.remove(c.lru.Back())
+	}
+}
+
+// SetOptions updates the cache options. It returns true if the options were updated.
+func (c *Cache) SetOptions(opts CacheOptions) bool {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if c.opts == opts {
+		return false
+	}
+
+	c.opts = opts
+	c.evict()
+
+	return true
+}
+
+// Len returns the number of cached responses.
+func (c *Cache) Len() int {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	return c.lru.Len()
+}
+
+// Clear clears the cache.
+func (c *Cache) Clear() {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	clear(c.entries)
+	c.lru.Init()
+}
+
+// cacheWriter stores the upstream responses in the cache before writing them to the client.
+type cacheWriter struct {
+	dns.ResponseWriter
+
+	cache   *Cache
+	state   request.Request
+	key     cacheKey
+	written bool
+}
+
+// WriteMsg implements [dns.ResponseWriter].
+func (w *cacheWriter) WriteMsg(resp *dns.Msg) error {
+	w.written = true
+
+	if failedResponse(resp) {
+		if stale := w.cache.get(w.key, time.Now(), true); stale != nil {
+			w.cache.logger.Debug("serving stale dns response", zap.String("question", w.state.QName()), zap.String("rcode", dns.RcodeToString[resp.Rcode]))
+
+			return w.cache.write(w.state, stale)
+		}
+
+		return w.ResponseWriter.WriteMsg(resp)
+	}
+
+	now := time.Now()
+
+	w.cache.set(w.key, resp, now)
+
+	// serve the cached copy, so that the client sees the same (clamped) TTLs as for the cache hits
+	if cached := w.cache.get(w.key, now, false); cached != nil {
+		return w.cache.write(w.state, cached)
+	}
+
+	return w.ResponseWriter.WriteMsg(resp)
+}
+
+// minTTL returns the minimum TTL of the records, ignoring OPT pseudo-records.
+func minTTL(sections ...[]dns.RR) time.Duration {
+	ttl := uint32(0)
+	found := false
+
+	for _, section := range sections {
+		for _, rr := range section {
+			if rr.Header().Rrtype == dns.TypeOPT {
+				continue
+			}
+
+			if !found || rr.Header().Ttl < ttl {
+				ttl = rr.Header().Ttl
+				found = true
+			}
+		}
+	}
+
+	return time.Duration(ttl) * time.Second
+}
+
+// setTTL sets the TTL of all records in the message.
+func setTTL(msg *dns.Msg, ttl uint32) {
+	for _, section := range [][]dns.RR{msg.Answer, msg.Ns, msg.Extra} {
+		for _, rr := range section {
+			if rr.Header().Rrtype != dns.TypeOPT {
+				rr.Header().Ttl = ttl
+			}
+		}
+	}
+}
+
+func filterOPT(rrs []dns.RR) []dns.RR {
+	result := rrs[:0]
+
+	for _, rr := range rrs {
+		if rr.Header().Rrtype != dns.TypeOPT {
+			result = append(result, rr)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,247 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dns_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/coredns/coredns/plugin"
+	dnssrv "github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/siderolabs/talos/internal/pkg/dns"
+)
+
+func TestCache(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		opts dns.CacheOptions
+
+		rcode int
+		ttl   uint32
+
+		expectedCalls int
+		expectedTTL   uint32
+	}{
+		{
+			name:          "positive",
+			opts:          dns.DefaultCacheOptions(),
+			rcode:         dnssrv.RcodeSuccess,
+			ttl:           300,
+			expectedCalls: 1,
+			expectedTTL:   300,
+		},
+		{
+			name:          "max ttl",
+			opts:          dns.CacheOptions{MaxTTL: time.Minute},
+			rcode:         dnssrv.RcodeSuccess,
+			ttl:           300,
+			expectedCalls: 1,
+			expectedTTL:   60,
+		},
+		{
+			name:          "min ttl",
+			opts:          dns.CacheOptions{MinTTL: time.Minute},
+			rcode:         dnssrv.RcodeSuccess,
+			ttl:           0,
+			expectedCalls: 1,
+			expectedTTL:   60,
+		},
+		{
+			name:          "negative",
+			opts:          dns.CacheOptions{NegativeTTL: 10 * time.Second},
+			rcode:         dnssrv.RcodeNameError,
+			ttl:           300,
+			expectedCalls: 1,
+			expectedTTL:   10,
+		},
+		{
+			name:          "negative disabled",
+			opts:          dns.CacheOptions{},
+			rcode:         dnssrv.RcodeNameError,
+			ttl:           300,
+			expectedCalls: 2,
+			expectedTTL:   300,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			upstream := &testUpstream{rcode: test.rcode, ttl: test.ttl}
+
+			cache := dns.NewCache(upstream.handler(), zaptest.NewLogger(t))
+			cache.SetOptions(test.opts)
+
+			for range 2 {
+				w := &testWriter{}
+				cache.ServeDNS(w, createQuery("example.com"))
+
+				require.NotNil(t, w.msg)
+				assert.Equal(t, test.rcode, w.msg.Rcode)
+				assert.Equal(t, test.expectedTTL, responseTTL(w.msg))
+			}
+
+			assert.Equal(t, test.expectedCalls, upstream.calls)
+		})
+	}
+}
+
+func TestCacheServeStale(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name       string
+		serveStale time.Duration
+
+		expectedRcode int
+		expectedTTL   uint32
+	}{
+		{
+			name:          "disabled",
+			expectedRcode: dnssrv.RcodeServerFailure,
+		},
+		{
+			name:          "enabled",
+			serveStale:    time.Minute,
+			expectedRcode: dnssrv.RcodeSuccess,
+			expectedTTL:   30,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			upstream := &testUpstream{rcode: dnssrv.RcodeSuccess, ttl: 1}
+
+			cache := dns.NewCache(upstream.handler(), zaptest.NewLogger(t))
+			cache.SetOptions(dns.CacheOptions{ServeStale: test.serveStale})
+
+			w := &testWriter{}
+			cache.ServeDNS(w, createQuery("example.com"))
+
+			require.NotNil(t, w.msg)
+			require.Equal(t, dnssrv.RcodeSuccess, w.msg.Rcode)
+
+			// let the entry expire, and fail the upstream
+			time.Sleep(1100 * time.Millisecond)
+
+			upstream.fail = true
+
+			w = &testWriter{}
+			cache.ServeDNS(w, createQuery("example.com"))
+
+			require.NotNil(t, w.msg)
+			assert.Equal(t, test.expectedRcode, w.msg.Rcode)
+			assert.Equal(t, test.expectedTTL, responseTTL(w.msg))
+		})
+	}
+}
+
+func TestCacheSize(t *testing.T) {
+	t.Parallel()
+
+	upstream := &testUpstream{rcode: dnssrv.RcodeSuccess, ttl: 300}
+
+	cache := dns.NewCache(upstream.handler(), zaptest.NewLogger(t))
+	cache.SetOptions(dns.CacheOptions{Size: 2})
+
+	for _, name := range []string{"a.example.com", "b.example.com", "c.example.com", "a.example.com"} {
+		cache.ServeDNS(&testWriter{}, createQuery(name))
+	}
+
+	assert.Equal(t, 2, cache.Len())
+
+	// a.example.com was evicted as the least recently used entry
+	assert.Equal(t, 4, upstream.calls)
+
+	// shrinking the cache evicts the entries immediately
+	cache.SetOptions(dns.CacheOptions{Size: 1})
+	assert.Equal(t, 1, cache.Len())
+}
+
+type testUpstream struct {
+	rcode int
+	ttl   uint32
+	fail  bool
+	calls int
+}
+
+func (u *testUpstream) handler() plugin.Handler {
+	return plugin.HandlerFunc(func(_ context.Context, w dnssrv.ResponseWriter, r *dnssrv.Msg) (int, error) {
+		u.calls++
+
+		if u.fail {
+			return dnssrv.RcodeServerFailure, nil
+		}
+
+		resp := new(dnssrv.Msg).SetRcode(r, u.rcode)
+
+		if u.rcode == dnssrv.RcodeSuccess {
+			resp.Answer = []dnssrv.RR{
+				&dnssrv.A{
+					Hdr: dnssrv.RR_Header{Name: r.Question[0].Name, Rrtype: dnssrv.TypeA, Class: dnssrv.ClassINET, Ttl: u.ttl},
+					A:   net.ParseIP("10.5.0.1"),
+				},
+			}
+		} else {
+			resp.Ns = []dnssrv.RR{
+				&dnssrv.SOA{
+					Hdr:    dnssrv.RR_Header{Name: "example.com.", Rrtype: dnssrv.TypeSOA, Class: dnssrv.ClassINET, Ttl: u.ttl},
+					Ns:     "ns.example.com.",
+					Mbox:   "hostmaster.example.com.",
+					Minttl: u.ttl,
+				},
+			}
+		}
+
+		return dnssrv.RcodeSuccess, w.WriteMsg(resp)
+	})
+}
+
+func responseTTL(msg *dnssrv.Msg) uint32 {
+	for _, section := range [][]dnssrv.RR{msg.Answer, msg.Ns} {
+		for _, rr := range section {
+			return rr.Header().Ttl
+		}
+	}
+
+	return 0
+}
+
+// testWriter is a [dnssrv.ResponseWriter] which records the written message.
+type testWriter struct {
+	msg    *dnssrv.Msg
+	remote net.Addr
+}
+
+func (w *testWriter) LocalAddr() net.Addr {
+	return &net.UDPAddr{IP: net.IPv4(127, 0, 0, 53), Port: 53}
+}
+
+func (w *testWriter) RemoteAddr() net.Addr {
+	if w.remote != nil {
+		return w.remote
+	}
+
+	return &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 40000}
+}
+
+func (w *testWriter) WriteMsg(msg *dnssrv.Msg) error {
+	w.msg = msg
+
+	return nil
+}
+
+func (w *testWriter) Write([]byte) (int, error) { return 0, nil }
+func (w *testWriter) Close() error              { return nil }
+func (w *testWriter) TsigStatus() error         { return nil }
+func (w *testWriter) TsigTimersOnly(bool)       {}
+func (w *testWriter) Hijack()                   {}
@@ -19,8 +19,6 @@ import (
 	"time"
 
 	"github.com/coredns/coredns/plugin"
-	"github.com/coredns/coredns/plugin/cache"
-	"github.com/coredns/coredns/plugin/pkg/dnsutil"
 	"github.com/coredns/coredns/plugin/pkg/proxy"
 	"github.com/coredns/coredns/request"
 	"github.com/miekg/dns"
@@ -29,57 +27,6 @@ import (
 	"golang.org/x/sys/unix"
 )
 
-// Cache is a [dns.Handler] to [plugin.Handler] adapter.
-type Cache struct {
-	cache  *cache.Cache
-	logger *zap.Logger
-}
-
-// NewCache creates a new Cache.
-func NewCache(next plugin.Handler, l *zap.Logger) *Cache {
-	c := cache.NewCache(
-		"zones",
-		"view",
-		cache.WithNegativeTTL(10*time.Second, dnsutil.MinimalDefaultTTL),
-	)
-	c.Next = next
-
-	return &Cache{cache: c, logger: l}
-}
-
-// ServeDNS implements [dns.Handler].
-func (c *Cache) ServeDNS(wr dns.ResponseWriter, msg *dns.Msg) {
-	wr = request.NewScrubWriter(msg, wr)
-
-	ctx, cancel := context.WithTimeout(context.Background(), 4500*time.Millisecond)
-	defer cancel()
-
-	code, err := c.cache.ServeDNS(ctx, wr, msg)
-	if err != nil {
-		// we should probably call newProxy.Healthcheck() if there are too many errors
-		c.logger.Warn("error serving dns request", zap.Error(err))
-	}
-
-	if clientWrite(code) {
-		return
-	}
-
-	// Something went wrong
-	state := request.Request{W: wr, Req: msg}
-
-	answer := new(dns.Msg)
-	answer.SetRcode(msg, code)
-	state.SizeAndDo(answer)
-
-	err = wr.WriteMsg(answer)
-	if err != nil {
-		c.logger.Warn("error writing dns response", zap.Error(err))
-	}
-}
-
-// Clear clears the cache.
-func (c *Cache) Clear() { c.cache.Clear() }
-
 // clientWrite returns true if the response has been written to the client.
 func clientWrite(rcode int) bool {
 	switch rcode {
@@ -90,11 +37,29 @@ func clientWrite(rcode int) bool {
 	}
 }
 
+// UpstreamPolicy describes how the upstreams are queried.
+type UpstreamPolicy struct {
+	// Parallel queries all upstreams at once and uses the first successful response.
+	Parallel bool
+	// Rotate starts each query with the next upstream, only applies to sequential queries.
+	Rotate bool
+	// Timeout bounds a query to a single upstream, zero means no additional timeout.
+	Timeout time.Duration
+}
+
+// QueryObserver is called with the result of each upstream query.
+//
+// The err is set if the upstream failed to serve the request, otherwise rtt is the round-trip time of the query.
+type QueryObserver func(addr string, rtt time.Duration, err error)
+
 // Handler is a dns proxy selector.
 type Handler struct {
-	mx     sync.RWMutex
-	dests  iter.Seq[*proxy.Proxy]
-	logger *zap.Logger
+	mx       sync.RWMutex
+	dests    iter.Seq[*proxy.Proxy]
+	policy   UpstreamPolicy
+	observer QueryObserver
+	next     atomic.Uint64
+	logger   *zap.Logger
 }
 
 // NewHandler creates a new Handler.
@@ -111,8 +76,6 @@ func (h *Handler) Name() string {
 }
 
 // ServeDNS implements plugin.Handler.
-//
-//nolint:gocyclo
 func (h *Handler) ServeDNS(ctx context.Context, wrt dns.ResponseWriter, msg *dns.Msg) (int, error) {
 	h.mx.RLock()
 	defer h.mx.RUnlock()
@@ -126,46 +89,25 @@ func (h *Handler) ServeDNS(ctx context.Context, wrt dns.ResponseWriter, msg *dns
 		zap.Stringer("remote_addr", wrt.RemoteAddr()),
 	)
 
+	dests := slices.Collect(h.dests)
+	if len(dests) == 0 {
+		return dns.RcodeServerFailure, errors.New("no destination available")
+	}
+
 	var (
-		called bool
-		resp   *dns.Msg
-		err    error
+		resp *dns.Msg
+		err  error
 	)
 
-	for ups := range h.dests {
-		called = true
-		opts := proxy.Options{}
-
-		logger.Debug("making dns request", zap.String("upstream", ups.Addr()))
-
-		for {
-			resp, err = ups.Connect(ctx, req, opts)
-
-			switch {
-			case errors.Is(err, proxy.ErrCachedClosed): // Remote side closed conn, can only happen with TCP.
-				continue
-			case resp != nil && resp.Truncated && !opts.ForceTCP: // Retry with TCP if truncated
-				opts.ForceTCP = true
-
-				continue
-			}
-
-			break
+	if h.policy.Parallel && len(dests) > 1 {
+		resp, err = h.queryParallel(ctx, dests, req, logger)
+	} else {
+		if h.policy.Rotate {
+			start := int(h.next.Add(1)-1) % len(dests)
+			dests = slices.Concat(dests[start:], dests[:start])
 		}
 
-		if resp != nil && (resp.Rcode == dns.RcodeServerFailure || resp.Rcode == dns.RcodeRefused) {
-			continue
-		}
-
-		if ctx.Err() != nil || err == nil {
-			break
-		}
-
-		continue
-	}
-
-	if !called {
-		return dns.RcodeServerFailure, errors.New("no destination available")
+		resp, err = h.querySequential(ctx, dests, req, logger)
 	}
 
 	if ctx.Err() != nil {
@@ -191,6 +133,180 @@ func (h *Handler) ServeDNS(ctx context.Context, wrt dns.ResponseWriter, msg *dns
 	return dns.RcodeSuccess, nil
 }
 
+// querySequential queries upstreams one by one until a successful response is received.
+func (h *Handler) querySequential(ctx context.Context, dests []*proxy.Proxy, req request.Request, logger *zap.Logger) (*dns.Msg, error) {
+	var (
+		resp *dns.Msg
+		err  error
+	)
+
+	for _, ups := range dests {
+		logger.Debug("making dns request", zap.String("upstream", ups.Addr()))
+
+		resp, err = h.connect(ctx, ups, req)
+
+		if resp != nil && failedResponse(resp) {
+			continue
+		}
+
+		if ctx.Err() != nil || err == nil {
+			break
+		}
+	}
+
+	return resp, err
+}
+
+// queryParallel queries all upstreams at once and returns the first successful response.
+//
+// If no upstream returns a successful response, the last received result is returned.
+func (h *Handler) queryParallel(ctx context.Context, dests []*proxy.Proxy, req request.Request, logger *zap.Logger) (*dns.Msg, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type result struct {
+		resp *dns.Msg
+		err  error
+	}
+
+	results := make(chan result, len(dests))
+
+	for _, ups := range dests {
+		logger.Debug("making parallel dns request", zap.String("upstream", ups.Addr()))
+
+		// each request gets its own copy of the message, as the proxy rewrites the message ID
+		upsReq := request.Request{W: req.W, Req: req.Req.Copy()}
+
+		go func() {
+			resp, err := h.connect(ctx, ups, upsReq)
+
+			results <- result{resp: resp, err: err}
+		}()
+	}
+
+	var last result
+
+	for range dests {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case res := <-results:
+			if res.err == nil && res.resp != nil && !failedResponse(res.resp) {
+				return res.resp, nil
+			}
+
+			if res.resp != nil || last.resp == nil {
+				last = res
+			}
+		}
+	}
+
+	return last.resp, last.err
+}
+
+// connect sends the request to a single upstream, bounding it with the per-upstream timeout.
+func (h *Handler) connect(ctx context.Context, ups *proxy.Proxy, req request.Request) (*dns.Msg, error) {
+	start := time.Now()
+
+	var (
+		resp *dns.Msg
+		err  error
+	)
+
+	if h.policy.Timeout > 0 {
+		resp, err = exchangeWithTimeout(ctx, ups, req, h.policy.Timeout)
+	} else {
+		resp, err = exchange(ctx, ups, req)
+	}
+
+	if h.observer != nil {
+		switch {
+		case errors.Is(err, context.Canceled):
+			// the query was abandoned (e.g. another upstream answered first), not an upstream failure
+		case err != nil:
+			h.observer(ups.Addr(), 0, err)
+		case failedResponse(resp):
+			h.observer(ups.Addr(), 0, fmt.Errorf("upstream responded with %s", dns.RcodeToString[resp.Rcode]))
+		default:
+			h.observer(ups.Addr(), time.Since(start), nil)
+		}
+	}
+
+	return resp, err
+}
+
+// exchangeWithTimeout runs the exchange in the background, so that the timeout is enforced even if the proxy doesn't honor the context.
+func exchangeWithTimeout(ctx context.Context, ups *proxy.Proxy, req request.Request, timeout time.Duration) (*dns.Msg, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	type result struct {
+		resp *dns.Msg
+		err  error
+	}
+
+	ch := make(chan result, 1)
+
+	go func() {
+		resp, err := exchange(ctx, ups, req)
+
+		ch <- result{resp: resp, err: err}
+	}()
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("upstream %s: %w", ups.Addr(), ctx.Err())
+	case res := <-ch:
+		return res.resp, res.err
+	}
+}
+
+func exchange(ctx context.Context, ups *proxy.Proxy, req request.Request) (*dns.Msg, error) {
+	opts := proxy.Options{}
+
+	for {
+		resp, err := ups.Connect(ctx, req, opts)
+
+		switch {
+		case errors.Is(err, proxy.ErrCachedClosed): // Remote side closed conn, can only happen with TCP.
+			continue
+		case resp != nil && resp.Truncated && !opts.ForceTCP: // Retry with TCP if truncated
+			opts.ForceTCP = true
+
+			continue
+		}
+
+		return resp, err
+	}
+}
+
+// failedResponse returns true if the upstream failed to serve the request, so the next upstream should be tried.
+func failedResponse(resp *dns.Msg) bool {
+	return resp.Rcode == dns.RcodeServerFailure || resp.Rcode == dns.RcodeRefused
+}
+
+// SetPolicy sets the upstream selection policy.
+func (h *Handler) SetPolicy(policy UpstreamPolicy) bool {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	if h.policy == policy {
+		return false
+	}
+
+	h.policy = policy
+
+	return true
+}
+
+// SetQueryObserver sets the function which is called with the result of each upstream query.
+func (h *Handler) SetQueryObserver(observer QueryObserver) {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	h.observer = observer
+}
+
 // SetProxy sets destination dns proxy servers.
 func (h *Handler) SetProxy(prxs iter.Seq[*proxy.Proxy]) bool {
 	h.mx.Lock()
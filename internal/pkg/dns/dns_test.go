@@ -126,6 +126,117 @@ func TestDNSEmptyDestinations(t *testing.T) {
 	stop()
 }
 
+func TestDNSUpstreamPolicy(t *testing.T) {
+	// the first upstream never answers, the second one answers immediately
+	silentAddr := startUpstream(t, func(dnssrv.ResponseWriter, *dnssrv.Msg) {})
+	answeringAddr := startUpstream(t, func(w dnssrv.ResponseWriter, r *dnssrv.Msg) {
+		resp := new(dnssrv.Msg).SetReply(r)
+		resp.Answer = []dnssrv.RR{
+			&dnssrv.A{
+				Hdr: dnssrv.RR_Header{Name: r.Question[0].Name, Rrtype: dnssrv.TypeA, Class: dnssrv.ClassINET, Ttl: 60},
+				A:   net.ParseIP("10.5.0.1"),
+			},
+		}
+
+		w.WriteMsg(resp) //nolint:errcheck
+	})
+
+	for _, test := range []struct {
+		name   string
+		policy dns.UpstreamPolicy
+	}{
+		{
+			name:   "parallel",
+			policy: dns.UpstreamPolicy{Parallel: true},
+		},
+		{
+			name:   "sequential with timeout",
+			policy: dns.UpstreamPolicy{Timeout: 200 * time.Millisecond},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			m := dns.NewManager(&testReader{}, func(e suture.Event) { t.Log("dns-runners event:", e) }, zaptest.NewLogger(t))
+
+			ctx, cancel := context.WithCancel(t.Context())
+			t.Cleanup(cancel)
+
+			t.Cleanup(func() {
+				if err := m.ClearAll(false); err != nil {
+					t.Logf("error stopping dns runners: %v", err)
+				}
+			})
+
+			pxs := xslices.Map([]string{silentAddr, answeringAddr}, func(addr string) *proxy.Proxy {
+				p := proxy.NewProxy(addr, addr, "dns")
+				p.Start(500 * time.Millisecond)
+
+				return p
+			})
+
+			t.Cleanup(func() {
+				for _, p := range pxs {
+					p.Close()
+				}
+			})
+
+			observed := make(chan string, 10)
+
+			m.SetQueryObserver(func(addr string, _ time.Duration, err error) {
+				if err == nil {
+					observed <- addr
+				}
+			})
+
+			require.True(t, m.SetUpstreamPolicy(test.policy))
+			require.False(t, m.SetUpstreamPolicy(test.policy))
+
+			m.SetUpstreams(slices.Values(pxs))
+			m.ServeBackground(ctx)
+
+			for _, err := range m.RunAll(slices.Values([]dns.AddressPair{
+				{Network: "udp", Addr: netip.MustParseAddrPort("127.0.0.1:10700")},
+			}), false) {
+				require.NoError(t, err)
+			}
+
+			time.Sleep(10 * time.Millisecond)
+
+			c := dnssrv.Client{
+				Timeout: time.Second,
+			}
+
+			r, _, err := c.Exchange(createQuery("example.com"), "127.0.0.1:10700")
+			require.NoError(t, err)
+			require.Equal(t, dnssrv.RcodeSuccess, r.Rcode, r)
+			require.Len(t, r.Answer, 1)
+
+			select {
+			case addr := <-observed:
+				require.Equal(t, answeringAddr, addr)
+			case <-time.After(time.Second):
+				t.Fatal("latency was not observed")
+			}
+		})
+	}
+}
+
+func startUpstream(t *testing.T, handler dnssrv.HandlerFunc) string {
+	t.Helper()
+
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0") //nolint:noctx
+	require.NoError(t, err)
+
+	srv := &dnssrv.Server{PacketConn: pc, Handler: handler}
+
+	go srv.ActivateAndServe() //nolint:errcheck
+
+	t.Cleanup(func() {
+		srv.Shutdown() //nolint:errcheck
+	})
+
+	return pc.LocalAddr().String()
+}
+
 func Test_ServeBackground(t *testing.T) {
 	t.Cleanup(func() { goleak.VerifyNone(t) })
 
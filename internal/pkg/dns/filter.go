@@ -0,0 +1,114 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dns
+
+import (
+	"net"
+	"net/netip"
+	"slices"
+	"sync/atomic"
+
+	"github.com/miekg/dns"
+	"go.uber.org/zap"
+)
+
+// ClientAllowlist holds the prefixes of the clients which are allowed to query the DNS server.
+//
+// The allowlist can be updated at any time, the changes apply to the running servers immediately.
+type ClientAllowlist struct {
+	prefixes atomic.Pointer[[]netip.Prefix]
+}
+
+// Set updates the allowed prefixes, nil allows all clients. It returns true if the allowlist was updated.
+func (a *ClientAllowlist) Set(prefixes []netip.Prefix) bool {
+	current := a.prefixes.Load()
+
+	switch {
+	case current == nil && prefixes == nil:
+		return false
+	case current != nil && prefixes != nil && slices.Equal(*current, prefixes):
+		return false
+	case prefixes == nil:
+		a.prefixes.Store(nil)
+	default:
+		prefixes = slices.Clone(prefixes)
+
+		a.prefixes.Store(&prefixes)
+	}
+
+	return true
+}
+
+// Allowed returns true if the client address is allowed.
+func (a *ClientAllowlist) Allowed(addr netip.Addr) bool {
+	prefixes := a.prefixes.Load()
+	if prefixes == nil {
+		return true
+	}
+
+	addr = addr.Unmap()
+
+	return slices.ContainsFunc(*prefixes, func(prefix netip.Prefix) bool { return prefix.Contains(addr) })
+}
+
+// ClientFilter is a [dns.Handler] which refuses the queries from the clients which are not in the allowlist.
+type ClientFilter struct {
+	next      dns.Handler
+	allowlist *ClientAllowlist
+	logger    *zap.Logger
+
+	rejected atomic.Uint64
+}
+
+// NewClientFilter creates a new ClientFilter.
+func NewClientFilter(next dns.Handler, allowlist *ClientAllowlist, logger *zap.Logger) *ClientFilter {
+	return &ClientFilter{
+		next:      next,
+		allowlist: allowlist,
+		logger:    logger,
+	}
+}
+
+// ServeDNS implements [dns.Handler].
+func (f *ClientFilter) ServeDNS(wr dns.ResponseWriter, msg *dns.Msg) {
+	addr, ok := clientAddr(wr.RemoteAddr())
+	if ok && f.allowlist.Allowed(addr) {
+		f.next.ServeDNS(wr, msg)
+
+		return
+	}
+
+	f.rejected.Add(1)
+
+	f.logger.Debug("refusing dns request from a client which is not allowed", zap.Stringer("remote_addr", wr.RemoteAddr()))
+
+	answer := new(dns.Msg)
+	answer.SetRcode(msg, dns.RcodeRefused)
+
+	if err := wr.WriteMsg(answer); err != nil {
+		f.logger.Warn("error writing dns response", zap.Error(err))
+	}
+}
+
+// Rejected returns the number of refused queries.
+func (f *ClientFilter) Rejected() uint64 { return f.rejected.Load() }
+
+func clientAddr(addr net.Addr) (netip.Addr, bool) {
+	switch addr := addr.(type) {
+	case *net.UDPAddr:
+		return addr.AddrPort().Addr(), true
+	case *net.TCPAddr:
+		return addr.AddrPort().Addr(), true
+	case nil:
+		return netip.Addr{}, false
+	default:
+		addrPort, err := netip.ParseAddrPort(addr.String())
+		if err != nil {
+			return netip.Addr{}, false
+		}
+
+		return addrPort.Addr(), true
+	}
+}
@@ -0,0 +1,114 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dns_test
+
+import (
+	"net"
+	"net/netip"
+	"testing"
+
+	dnssrv "github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/siderolabs/talos/internal/pkg/dns"
+)
+
+func TestClientFilter(t *testing.T) {
+	t.Parallel()
+
+	upstream := &testUpstream{rcode: dnssrv.RcodeSuccess, ttl: 300}
+
+	var allowlist dns.ClientAllowlist
+
+	assert.True(t, allowlist.Set([]netip.Prefix{
+		netip.MustParsePrefix("127.0.0.0/8"),
+		netip.MustParsePrefix("10.244.0.0/16"),
+		netip.MustParsePrefix("fd00:10:244::/56"),
+	}))
+
+	filter := dns.NewClientFilter(dns.NewCache(upstream.handler(), zaptest.NewLogger(t)), &allowlist, zaptest.NewLogger(t))
+
+	for _, test := range []struct {
+		name   string
+		remote net.Addr
+
+		expectedRcode int
+	}{
+		{
+			name:          "loopback udp",
+			remote:        &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 40000},
+			expectedRcode: dnssrv.RcodeSuccess,
+		},
+		{
+			name:          "pod tcp",
+			remote:        &net.TCPAddr{IP: net.IPv4(10, 244, 1, 5), Port: 40000},
+			expectedRcode: dnssrv.RcodeSuccess,
+		},
+		{
+			name:          "pod ipv4-mapped",
+			remote:        &net.UDPAddr{IP: net.ParseIP("::ffff:10.244.1.5"), Port: 40000},
+			expectedRcode: dnssrv.RcodeSuccess,
+		},
+		{
+			name:          "pod ipv6",
+			remote:        &net.UDPAddr{IP: net.ParseIP("fd00:10:244::5"), Port: 40000},
+			expectedRcode: dnssrv.RcodeSuccess,
+		},
+		{
+			name:          "host network",
+			remote:        &net.UDPAddr{IP: net.IPv4(192, 168, 1, 5), Port: 40000},
+			expectedRcode: dnssrv.RcodeRefused,
+		},
+		{
+			name:          "host network tcp",
+			remote:        &net.TCPAddr{IP: net.IPv4(172, 20, 0, 2), Port: 40000},
+			expectedRcode: dnssrv.RcodeRefused,
+		},
+	} {
+		w := &testWriter{remote: test.remote}
+		filter.ServeDNS(w, createQuery("example.com"))
+
+		require.NotNil(t, w.msg, test.name)
+		assert.Equal(t, test.expectedRcode, w.msg.Rcode, test.name)
+	}
+
+	assert.EqualValues(t, 2, filter.Rejected())
+
+	// the upstream is only queried once, the rest is served from the cache
+	assert.Equal(t, 1, upstream.calls)
+
+	// the allowlist is applied without restarting the filter
+	assert.False(t, allowlist.Set([]netip.Prefix{
+		netip.MustParsePrefix("127.0.0.0/8"),
+		netip.MustParsePrefix("10.244.0.0/16"),
+		netip.MustParsePrefix("fd00:10:244::/56"),
+	}))
+	assert.True(t, allowlist.Set([]netip.Prefix{netip.MustParsePrefix("192.168.1.0/24")}))
+
+	w := &testWriter{remote: &net.UDPAddr{IP: net.IPv4(192, 168, 1, 5), Port: 40000}}
+	filter.ServeDNS(w, createQuery("example.com"))
+
+	require.NotNil(t, w.msg)
+	assert.Equal(t, dnssrv.RcodeSuccess, w.msg.Rcode)
+
+	w = &testWriter{remote: &net.UDPAddr{IP: net.IPv4(10, 244, 1, 5), Port: 40000}}
+	filter.ServeDNS(w, createQuery("example.com"))
+
+	require.NotNil(t, w.msg)
+	assert.Equal(t, dnssrv.RcodeRefused, w.msg.Rcode)
+
+	assert.EqualValues(t, 3, filter.Rejected())
+
+	// nil allowlist allows all clients
+	assert.True(t, allowlist.Set(nil))
+
+	w = &testWriter{remote: &net.UDPAddr{IP: net.IPv4(10, 244, 1, 5), Port: 40000}}
+	filter.ServeDNS(w, createQuery("example.com"))
+
+	require.NotNil(t, w.msg)
+	assert.Equal(t, dnssrv.RcodeSuccess, w.msg.Rcode)
+}
@@ -33,10 +33,12 @@ type Manager struct {
 	handler      *Handler
 	nodeHandler  *NodeHandler
 	rootHandler  *Cache
+	allowlist    *ClientAllowlist
 	s            *suture.Supervisor
 	supervisorCh <-chan error
 	logger       *zap.Logger
 	runners      map[AddressPair]suture.ServiceToken
+	filters      map[AddressPair]*ClientFilter
 }
 
 // NewManager creates a new manager.
@@ -49,9 +51,11 @@ func NewManager(mr MemberReader, hook suture.EventHook, logger *zap.Logger) *Man
 		handler:     handler,
 		nodeHandler: nodeHandler,
 		rootHandler: rootHandler,
+		allowlist:   &ClientAllowlist{},
 		s:           suture.New("dns-resolve-cache-runners", suture.Spec{EventHook: hook}),
 		logger:      logger,
 		runners:     map[AddressPair]suture.ServiceToken{},
+		filters:     map[AddressPair]*ClientFilter{},
 	}
 
 	return m
@@ -98,11 +102,14 @@ func (m *Manager) RunAll(pairs iter.Seq[AddressPair], forwardEnabled bool) iter.
 				continue
 			}
 
-			opts, err := newDNSRunnerOpts(cfg, m.rootHandler, forwardEnabled)
+			filter := NewClientFilter(m.rootHandler, m.allowlist, m.logger)
+
+			opts, err := newDNSRunnerOpts(cfg, filter, forwardEnabled)
 			if err != nil {
 				err = fmt.Errorf("%w: %w", ErrCreatingRunner, err)
 			} else {
 				m.runners[cfg] = m.s.Add(NewRunner(opts, m.logger))
+				m.filters[cfg] = filter
 			}
 
 			if !yield(makeResult(cfg, StatusNew), err) {
@@ -125,6 +132,7 @@ func (m *Manager) RunAll(pairs iter.Seq[AddressPair], forwardEnabled bool) iter.
 			}
 
 			delete(m.runners, cfg)
+			delete(m.filters, cfg)
 		}
 	}
 }
@@ -146,6 +154,31 @@ func (m *Manager) SetUpstreams(prxs iter.Seq[*proxy.Proxy]) bool {
 	return true
 }
 
+// SetUpstreamPolicy sets the upstream selection policy for the DNS handler. It returns true if the policy was updated.
+func (m *Manager) SetUpstreamPolicy(policy UpstreamPolicy) bool { return m.handler.SetPolicy(policy) }
+
+// SetQueryObserver sets the function which is called with the result of each upstream query.
+func (m *Manager) SetQueryObserver(observer QueryObserver) {
+	m.handler.SetQueryObserver(observer)
+}
+
+// SetCacheOptions sets the cache options. It returns true if the options were updated.
+func (m *Manager) SetCacheOptions(opts CacheOptions) bool { return m.rootHandler.SetOptions(opts) }
+
+// SetAllowedClients sets the prefixes of the clients which are allowed to query the DNS server, nil allows all clients.
+// It returns true if the allowlist was updated.
+func (m *Manager) SetAllowedClients(prefixes []netip.Prefix) bool { return m.allowlist.Set(prefixes) }
+
+// RejectedQueries returns the number of queries refused by the runner because the client is not allowed.
+func (m *Manager) RejectedQueries(cfg AddressPair) uint64 {
+	filter, ok := m.filters[cfg]
+	if !ok {
+		return 0
+	}
+
+	return filter.Rejected()
+}
+
 // ClearAll stops and removes all runners. Returns all errors if any runner failed to properly stop.
 func (m *Manager) ClearAll(dry bool) error {
 	if dry {
@@ -184,6 +217,7 @@ func (m *Manager) clearAll() iter.Seq2[AddressPair, error] {
 			}
 
 			delete(m.runners, runData)
+			delete(m.filters, runData)
 		}
 	}
 }
@@ -23,6 +23,7 @@ import (
 
 	"github.com/siderolabs/talos/internal/pkg/encryption/helpers"
 	"github.com/siderolabs/talos/internal/pkg/encryption/keys"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/resources/block"
 )
 
@@ -113,10 +114,34 @@ func (h *Handler) Open(ctx context.Context, logger *zap.Logger, devicePath, mapp
 		return "", -1, nil, err
 	}
 
-	var usedKey *encryption.Key
+	var (
+		usedKey   *encryption.Key
+		usedToken token.Token
+	)
+
+	rotation := h.newKeyRotation(logger, devicePath, nil)
+
+	pending, err := rotation.pending(ctx)
+	if err != nil {
+		// the configured keys are still tried, the rotation is resumed on the next attempt
+		logger.Warn("failed to read the interrupted key rotation", zap.Error(err))
+	}
 
-	if !isOpen {
-		handler, key, _, err := h.tryHandlers(ctx, logger, func(ctx context.Context, handler keys.Handler) (*encryption.Key, token.Token, error) {
+	if !isOpen && pending != nil && pending.staged {
+		// the rotation might have been interrupted after the old key was removed
+		path, err = h.encryptionProvider.Open(ctx, devicePath, mappedName, pending.key)
+		if err != nil {
+			logger.Warn("failed to open encrypted device with the key being rotated", zap.Int("slot", pending.slot), zap.Error(err))
+		} else {
+			logger.Info("opened encrypted device with the key being rotated", zap.Int("slot", pending.slot))
+
+			usedKey = pending.key
+			usedToken = pending.token
+		}
+	}
+
+	if !isOpen && usedKey == nil {
+		handler, key, token, err := h.tryHandlers(ctx, logger, func(ctx context.Context, handler keys.Handler) (*encryption.Key, token.Token, error) {
 			slotToken, err := h.readToken(ctx, devicePath, handler.Slot())
 			if err != nil {
 				return nil, nil, err
@@ -142,13 +167,32 @@ func (h *Handler) Open(ctx context.Context, logger *zap.Logger, devicePath, mapp
 		logger.Info("opened encrypted device", zap.Int("slot", handler.Slot()), zap.String("type", fmt.Sprintf("%T", handler)))
 
 		usedKey = key
+		usedToken = token
 	}
 
-	failedSyncs, err := h.syncKeys(ctx, logger, devicePath, usedKey)
+	var failedRotation []string
+
+	if pending != nil {
+		result, err := rotation.resume(ctx, pending, usedKey)
+
+		switch {
+		case err != nil:
+			logger.Error("failed to resume the interrupted key rotation", zap.Error(err))
+
+			failedRotation = append(failedRotation, fmt.Sprintf("error resuming key rotation: %s", err))
+		case result != nil && usedKey != nil && usedKey.Slot == constants.EncryptionKeyRotationSlot:
+			usedKey = result.key
+			usedToken = result.token
+		}
+	}
+
+	failedSyncs, err := h.syncKeys(ctx, logger, devicePath, usedKey, usedToken)
 	if err != nil {
 		return "", -1, nil, err
 	}
 
+	failedSyncs = append(failedRotation, failedSyncs...)
+
 	return path, usedKey.Slot, failedSyncs, nil
 }
 
@@ -198,7 +242,7 @@ func (h *Handler) FormatAndEncrypt(ctx context.Context, logger *zap.Logger, path
 }
 
 //nolint:gocyclo
-func (h *Handler) syncKeys(ctx context.Context, logger *zap.Logger, path string, k *encryption.Key) ([]string, error) {
+func (h *Handler) syncKeys(ctx context.Context, logger *zap.Logger, path string, k *encryption.Key, t token.Token) ([]string, error) {
 	keyslots, err := h.encryptionProvider.ReadKeyslots(path)
 	if err != nil {
 		return nil, err
@@ -211,8 +255,18 @@ func (h *Handler) syncKeys(ctx context.Context, logger *zap.Logger, path string,
 	for _, handler := range h.keyHandlers {
 		slot := strconv.Itoa(handler.Slot())
 		visited[slot] = true
-		// no need to update the key which we already detected as unchanged
+		// no need to update the key which we already detected as unchanged,
+		// but the policy it is sealed with might have changed
 		if k != nil && k.Slot == handler.Slot() {
+			resealed, err := h.resealKey(ctx, path, handler, t)
+			if err != nil {
+				logger.Error("failed to re-seal key", zap.Int("slot", handler.Slot()), zap.String("handler", fmt.Sprintf("%T", handler)), zap.Error(err))
+
+				failedSyncs = append(failedSyncs, fmt.Sprintf("error re-sealing key slot %s %T: %s", slot, handler, err))
+			} else if resealed {
+				logger.Info("re-sealed encryption key", zap.Int("slot", handler.Slot()))
+			}
+
 			continue
 		}
 
@@ -237,6 +291,9 @@ func (h *Handler) syncKeys(ctx context.Context, logger *zap.Logger, path string,
 		}
 	}
 
+	// the temporary key slot is managed by the key rotation
+	visited[strconv.Itoa(constants.EncryptionKeyRotationSlot)] = true
+
 	// cleanup deleted key slots
 	for slot := range keyslots.Keyslots {
 		if !visited[slot] {
@@ -259,13 +316,15 @@ func (h *Handler) syncKeys(ctx context.Context, logger *zap.Logger, path string,
 }
 
 func (h *Handler) updateKey(ctx context.Context, path string, existingKey *encryption.Key, handler keys.Handler) error {
-	valid, err := h.checkKey(ctx, path, handler)
+	valid, token, err := h.checkKey(ctx, path, handler)
 	if err != nil {
 		return err
 	}
 
 	if valid {
-		return nil
+		_, err = h.resealKey(ctx, path, handler, token)
+
+		return err
 	}
 
 	// re-add the key to the slot
@@ -282,22 +341,49 @@ func (h *Handler) updateKey(ctx context.Context, path string, existingKey *encry
 	return err
 }
 
-func (h *Handler) checkKey(ctx context.Context, path string, handler keys.Handler) (bool, error) {
+func (h *Handler) checkKey(ctx context.Context, path string, handler keys.Handler) (bool, token.Token, error) {
 	token, err := h.readToken(ctx, path, handler.Slot())
 	if err != nil {
-		return false, err
+		return false, nil, err
 	}
 
 	key, err := handler.GetKey(ctx, token)
 	if err != nil {
 		if errors.Is(err, keys.ErrTokenInvalid) {
-			return false, nil
+			return false, nil, nil
 		}
 
-		return false, err
+		return false, nil, err
+	}
+
+	valid, err := h.encryptionProvider.CheckKey(ctx, path, key)
+
+	return valid, token, err
+}
+
+// resealKey seals the key again if the key handler policy doesn't match the policy the key was sealed with.
+//
+// The key itself is not changed, so only the token is replaced.
+func (h *Handler) resealKey(ctx context.Context, path string, handler keys.Handler, t token.Token) (bool, error) {
+	resealer, ok := handler.(keys.Resealer)
+	if !ok || t == nil || !resealer.NeedsReseal(t) {
+		return false, nil
+	}
+
+	newToken, err := resealer.Reseal(ctx, t)
+	if err != nil {
+		return false, fmt.Errorf("failed to re-seal key: %w", err)
+	}
+
+	if err = h.encryptionProvider.RemoveToken(ctx, path, handler.Slot()); err != nil {
+		return false, fmt.Errorf("failed to remove old token: %w", err)
+	}
+
+	if err = h.encryptionProvider.SetToken(ctx, path, handler.Slot(), newToken); err != nil {
+		return false, fmt.Errorf("failed to set new token: %w", err)
 	}
 
-	return h.encryptionProvider.CheckKey(ctx, path, key)
+	return true, nil
 }
 
 func (h *Handler) addKey(ctx context.Context, path string, existingKey *encryption.Key, handler keys.Handler) error {
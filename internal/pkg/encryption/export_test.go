@@ -0,0 +1,50 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package encryption
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/pkg/encryption/keys"
+)
+
+// KeyslotManager is exported for testing.
+type KeyslotManager = keyslotManager
+
+// RotateKey rotates the key in the slot using the provided key slots.
+func RotateKey(ctx context.Context, logger *zap.Logger, keyslots KeyslotManager, handlers []keys.Handler, slot int) (int, error) {
+	rotation := &keyRotation{
+		keyslots: keyslots,
+		handlers: handlers,
+		logger:   logger,
+	}
+
+	result, err := rotation.rotate(ctx, slot)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.epoch, nil
+}
+
+// ResumeRotation resumes the interrupted key rotation the same way it is done when the volume is opened.
+func ResumeRotation(ctx context.Context, logger *zap.Logger, keyslots KeyslotManager, handlers []keys.Handler) error {
+	rotation := &keyRotation{
+		keyslots: keyslots,
+		handlers: handlers,
+		logger:   logger,
+	}
+
+	pending, err := rotation.pending(ctx)
+	if err != nil || pending == nil {
+		return err
+	}
+
+	_, err = rotation.resume(ctx, pending, nil)
+
+	return err
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/siderolabs/go-blockdevice/v2/encryption"
 	"github.com/siderolabs/go-blockdevice/v2/encryption/token"
 
+	"github.com/siderolabs/talos/internal/pkg/secureboot/tpm2"
 	"github.com/siderolabs/talos/pkg/machinery/resources/block"
 )
 
@@ -62,7 +63,11 @@ func NewHandler(cfg block.EncryptionKey, options ...KeyOption) (Handler, error)
 		handler, err = NewTPMKeyHandler(
 			key,
 			cfg.TPMCheckSecurebootStatusOnEnroll,
-			cfg.TPMPCRs,
+			tpm2.SealOptions{
+				PCRs:                   cfg.TPMPCRs,
+				PCRBank:                cfg.TPMPCRBank,
+				DisableSignedPCRPolicy: len(cfg.TPMPubKeyPCRs) == 0,
+			},
 			opts.TPMLocker)
 		if err != nil {
 			return nil, err
@@ -89,6 +94,14 @@ type Handler interface {
 	Slot() int
 }
 
+// Resealer is implemented by the key handlers which seal the key with a configurable policy.
+type Resealer interface {
+	// NeedsReseal returns true if the key in the token was sealed with a policy different from the configured one.
+	NeedsReseal(token.Token) bool
+	// Reseal seals the key from the token with the configured policy and returns the new token.
+	Reseal(context.Context, token.Token) (token.Token, error)
+}
+
 // KeyHandler is the base class for all key handlers.
 type KeyHandler struct {
 	slot int
@@ -29,6 +29,8 @@ import (
 
 // KMSToken is the userdata stored in the partition token metadata.
 type KMSToken struct {
+	RotationMetadata
+
 	SealedData []byte `json:"sealedData"`
 }
 
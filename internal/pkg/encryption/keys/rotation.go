@@ -0,0 +1,51 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package keys
+
+import (
+	"github.com/siderolabs/go-blockdevice/v2/encryption/luks"
+	"github.com/siderolabs/go-blockdevice/v2/encryption/token"
+)
+
+// RotationMetadata is the key rotation state stored along with the token userdata.
+type RotationMetadata struct {
+	// Epoch is incremented each time the key is rotated.
+	Epoch int `json:"talos_key_epoch,omitempty"`
+	// RotatingSlot is set on the token of the temporary key slot while the key of that slot is being rotated.
+	RotatingSlot *int `json:"talos_rotating_slot,omitempty"`
+}
+
+// TokenRotationMetadata returns the rotation metadata of the token.
+//
+// Only the tokens of the keys wrapped by a key provider carry the metadata, for other tokens nil is returned.
+func TokenRotationMetadata(t token.Token) *RotationMetadata {
+	switch t := t.(type) {
+	case *luks.Token[*KMSToken]:
+		return &t.UserData.RotationMetadata
+	case *luks.Token[*TPMToken]:
+		return &t.UserData.RotationMetadata
+	default:
+		return nil
+	}
+}
+
+// TokenProvider returns the name of the key provider which wrapped the key of the token.
+func TokenProvider(t token.Token) string {
+	switch t.(type) {
+	case *luks.Token[*KMSToken]:
+		return "kms"
+	case *luks.Token[*TPMToken]:
+		return "tpm"
+	default:
+		return ""
+	}
+}
+
+// SetTokenKeySlot updates the key slot references in the token, as the token is moved to another slot.
+func SetTokenKeySlot(t token.Token, slot int) {
+	if t, ok := t.(*luks.Token[*TPMToken]); ok {
+		t.UserData.KeySlots = []int{slot}
+	}
+}
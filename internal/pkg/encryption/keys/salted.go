@@ -63,6 +63,28 @@ func (k *SaltedHandler) GetKey(ctx context.Context, token token.Token) (*encrypt
 	return key, nil
 }
 
+// NeedsReseal implements the keys.Resealer interface.
+func (k *SaltedHandler) NeedsReseal(token token.Token) bool {
+	resealer, ok := k.wrapped.(Resealer)
+	if !ok {
+		return false
+	}
+
+	return resealer.NeedsReseal(token)
+}
+
+// Reseal implements the keys.Resealer interface.
+//
+// The salt is not part of the sealed key, so resealing is delegated to the wrapped handler.
+func (k *SaltedHandler) Reseal(ctx context.Context, token token.Token) (token.Token, error) {
+	resealer, ok := k.wrapped.(Resealer)
+	if !ok {
+		return nil, fmt.Errorf("key handler %T does not support resealing", k.wrapped)
+	}
+
+	return resealer.Reseal(ctx, token)
+}
+
 // Slot implements the keys.Handler interface.
 func (k *SaltedHandler) Slot() int {
 	return k.wrapped.Slot()
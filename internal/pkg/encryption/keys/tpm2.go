@@ -5,11 +5,13 @@
 package keys
 
 import (
+	"cmp"
 	"context"
 	"crypto/rand"
 	"encoding/base64"
 	"fmt"
 	"io"
+	"slices"
 
 	"github.com/foxboron/go-uefi/efi"
 	"github.com/siderolabs/go-blockdevice/v2/encryption"
@@ -18,10 +20,13 @@ import (
 
 	"github.com/siderolabs/talos/internal/pkg/encryption/helpers"
 	"github.com/siderolabs/talos/internal/pkg/secureboot/tpm2"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
 )
 
 // TPMToken is the userdata stored in the partition token metadata.
 type TPMToken struct {
+	RotationMetadata
+
 	KeySlots          []int  `json:"keyslots"`
 	SealedBlobPrivate []byte `json:"sealed_blob_private"`
 	SealedBlobPublic  []byte `json:"sealed_blob_public"`
@@ -39,16 +44,16 @@ type TPMKeyHandler struct {
 
 	tpmLocker               helpers.TPMLockFunc
 	checkSecurebootOnEnroll bool
-	tpmPCRs                 []int
+	sealOptions             tpm2.SealOptions
 }
 
 // NewTPMKeyHandler creates new TPMKeyHandler.
-func NewTPMKeyHandler(key KeyHandler, checkSecurebootOnEnroll bool, tpmPCRs []int, tpmLocker helpers.TPMLockFunc) (*TPMKeyHandler, error) {
+func NewTPMKeyHandler(key KeyHandler, checkSecurebootOnEnroll bool, sealOptions tpm2.SealOptions, tpmLocker helpers.TPMLockFunc) (*TPMKeyHandler, error) {
 	return &TPMKeyHandler{
 		KeyHandler:              key,
 		tpmLocker:               tpmLocker,
 		checkSecurebootOnEnroll: checkSecurebootOnEnroll,
-		tpmPCRs:                 tpmPCRs,
+		sealOptions:             sealOptions,
 	}, nil
 }
 
@@ -69,19 +74,80 @@ func (h *TPMKeyHandler) NewKey(ctx context.Context) (*encryption.Key, token.Toke
 		return nil, nil, err
 	}
 
+	token, err := h.seal(ctx, key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return encryption.NewKey(h.slot, []byte(base64.StdEncoding.EncodeToString(key))), token, nil
+}
+
+// GetKey implements Handler interface.
+func (h *TPMKeyHandler) GetKey(ctx context.Context, t token.Token) (*encryption.Key, error) {
+	token, ok := t.(*luks.Token[*TPMToken])
+	if !ok {
+		return nil, ErrTokenInvalid
+	}
+
+	key, err := h.unseal(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return encryption.NewKey(h.slot, []byte(base64.StdEncoding.EncodeToString(key))), nil
+}
+
+// NeedsReseal implements Resealer interface.
+func (h *TPMKeyHandler) NeedsReseal(t token.Token) bool {
+	token, ok := t.(*luks.Token[*TPMToken])
+	if !ok {
+		return false
+	}
+
+	current := token.UserData.sealOptions()
+
+	return current.PCRBank != cmp.Or(h.sealOptions.PCRBank, constants.DefaultTPMPCRBank) ||
+		current.DisableSignedPCRPolicy != h.sealOptions.DisableSignedPCRPolicy ||
+		!slices.Equal(sortedPCRs(current.PCRs), sortedPCRs(h.sealOptions.PCRs))
+}
+
+// Reseal implements Resealer interface.
+func (h *TPMKeyHandler) Reseal(ctx context.Context, t token.Token) (token.Token, error) {
+	token, ok := t.(*luks.Token[*TPMToken])
+	if !ok {
+		return nil, ErrTokenInvalid
+	}
+
+	key, err := h.unseal(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unseal the key with the current policy: %w", err)
+	}
+
+	newToken, err := h.seal(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	newToken.UserData.KeySlots = token.UserData.KeySlots
+	newToken.UserData.RotationMetadata = token.UserData.RotationMetadata
+
+	return newToken, nil
+}
+
+func (h *TPMKeyHandler) seal(ctx context.Context, key []byte) (*luks.Token[*TPMToken], error) {
 	var resp *tpm2.SealedResponse
 
 	if err := h.tpmLocker(ctx, func() error {
 		var err error
 
-		resp, err = tpm2.Seal(key, h.tpmPCRs)
+		resp, err = tpm2.Seal(key, h.sealOptions)
 
 		return err
 	}); err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	token := &luks.Token[*TPMToken]{
+	return &luks.Token[*TPMToken]{
 		Type: TokenTypeTPM,
 		UserData: &TPMToken{
 			KeySlots:          []int{h.slot},
@@ -94,18 +160,10 @@ func (h *TPMKeyHandler) NewKey(ctx context.Context) (*encryption.Key, token.Toke
 			PolicyHash:        resp.PolicyDigest,
 			KeyName:           resp.KeyName,
 		},
-	}
-
-	return encryption.NewKey(h.slot, []byte(base64.StdEncoding.EncodeToString(key))), token, nil
+	}, nil
 }
 
-// GetKey implements Handler interface.
-func (h *TPMKeyHandler) GetKey(ctx context.Context, t token.Token) (*encryption.Key, error) {
-	token, ok := t.(*luks.Token[*TPMToken])
-	if !ok {
-		return nil, ErrTokenInvalid
-	}
-
+func (h *TPMKeyHandler) unseal(ctx context.Context, token *luks.Token[*TPMToken]) ([]byte, error) {
 	sealed := tpm2.SealedResponse{
 		SealedBlobPrivate: token.UserData.SealedBlobPrivate,
 		SealedBlobPublic:  token.UserData.SealedBlobPublic,
@@ -114,6 +172,7 @@ func (h *TPMKeyHandler) GetKey(ctx context.Context, t token.Token) (*encryption.
 		PCRs:              token.UserData.PCRs,
 		PubKeyPCRs:        token.UserData.PubKeyPCRs,
 		EncryptionVersion: token.UserData.EncryptionVersion,
+		Alg:               token.UserData.Alg,
 	}
 
 	var key []byte
@@ -128,5 +187,30 @@ func (h *TPMKeyHandler) GetKey(ctx context.Context, t token.Token) (*encryption.
 		return nil, err
 	}
 
-	return encryption.NewKey(h.slot, []byte(base64.StdEncoding.EncodeToString(key))), nil
+	return key, nil
+}
+
+// sealOptions returns the policy the token was sealed with.
+func (t *TPMToken) sealOptions() tpm2.SealOptions {
+	// tokens without the encryption version were always sealed to PCR 7 and the signed PCR 11 policy,
+	// even though only PCR 11 was recorded
+	if t.EncryptionVersion == "" {
+		return tpm2.SealOptions{
+			PCRs:    []int{constants.SecureBootStatePCR},
+			PCRBank: constants.DefaultTPMPCRBank,
+		}
+	}
+
+	return tpm2.SealOptions{
+		PCRs:                   t.PCRs,
+		PCRBank:                cmp.Or(t.Alg, constants.DefaultTPMPCRBank),
+		DisableSignedPCRPolicy: len(t.PubKeyPCRs) == 0,
+	}
+}
+
+func sortedPCRs(pcrs []int) []int {
+	pcrs = slices.Clone(pcrs)
+	slices.Sort(pcrs)
+
+	return slices.Compact(pcrs)
 }
@@ -0,0 +1,132 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package keys_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/siderolabs/go-blockdevice/v2/encryption/luks"
+	"github.com/siderolabs/go-blockdevice/v2/encryption/token"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/encryption/keys"
+	"github.com/siderolabs/talos/internal/pkg/secureboot/tpm2"
+)
+
+func TestTPMNeedsReseal(t *testing.T) {
+	t.Parallel()
+
+	tpmToken := func(userData *keys.TPMToken) token.Token {
+		return &luks.Token[*keys.TPMToken]{
+			Type:     keys.TokenTypeTPM,
+			UserData: userData,
+		}
+	}
+
+	for _, test := range []struct {
+		name string
+
+		opts  tpm2.SealOptions
+		token token.Token
+
+		expected bool
+	}{
+		{
+			name: "legacy token with default policy",
+			opts: tpm2.SealOptions{PCRs: []int{7}},
+			token: tpmToken(&keys.TPMToken{
+				PCRs: []int{11},
+				Alg:  "sha256",
+			}),
+			expected: false,
+		},
+		{
+			name: "same policy",
+			opts: tpm2.SealOptions{PCRs: []int{7}, PCRBank: "sha256"},
+			token: tpmToken(&keys.TPMToken{
+				PCRs:              []int{7},
+				PubKeyPCRs:        []int{11},
+				Alg:               "sha256",
+				EncryptionVersion: tpm2.EncryptionSchemaVersionErrata,
+			}),
+			expected: false,
+		},
+		{
+			name: "same policy with different PCR order",
+			opts: tpm2.SealOptions{PCRs: []int{7, 0}},
+			token: tpmToken(&keys.TPMToken{
+				PCRs:              []int{0, 7},
+				PubKeyPCRs:        []int{11},
+				Alg:               "sha256",
+				EncryptionVersion: tpm2.EncryptionSchemaVersionErrata,
+			}),
+			expected: false,
+		},
+		{
+			name: "PCRs changed",
+			opts: tpm2.SealOptions{PCRs: []int{0, 7}},
+			token: tpmToken(&keys.TPMToken{
+				PCRs:              []int{7},
+				PubKeyPCRs:        []int{11},
+				Alg:               "sha256",
+				EncryptionVersion: tpm2.EncryptionSchemaVersionErrata,
+			}),
+			expected: true,
+		},
+		{
+			name: "bank changed",
+			opts: tpm2.SealOptions{PCRs: []int{7}, PCRBank: "sha384"},
+			token: tpmToken(&keys.TPMToken{
+				PCRs:              []int{7},
+				PubKeyPCRs:        []int{11},
+				Alg:               "sha256",
+				EncryptionVersion: tpm2.EncryptionSchemaVersionErrata,
+			}),
+			expected: true,
+		},
+		{
+			name: "signed policy disabled",
+			opts: tpm2.SealOptions{PCRs: []int{7}, DisableSignedPCRPolicy: true},
+			token: tpmToken(&keys.TPMToken{
+				PCRs:              []int{7},
+				PubKeyPCRs:        []int{11},
+				Alg:               "sha256",
+				EncryptionVersion: tpm2.EncryptionSchemaVersionErrata,
+			}),
+			expected: true,
+		},
+		{
+			name: "legacy token with signed policy disabled",
+			opts: tpm2.SealOptions{PCRs: []int{7}, DisableSignedPCRPolicy: true},
+			token: tpmToken(&keys.TPMToken{
+				PCRs: []int{11},
+				Alg:  "sha256",
+			}),
+			expected: true,
+		},
+		{
+			name: "not a TPM token",
+			opts: tpm2.SealOptions{PCRs: []int{7}},
+			token: &luks.Token[*keys.KMSToken]{
+				Type:     keys.TokenTypeKMS,
+				UserData: &keys.KMSToken{},
+			},
+			expected: false,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			handler, err := keys.NewTPMKeyHandler(keys.KeyHandler{}, false, test.opts, func(context.Context, func() error) error {
+				return nil
+			})
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, handler.NeedsReseal(test.token))
+		})
+	}
+}
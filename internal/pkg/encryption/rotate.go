@@ -0,0 +1,621 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package encryption
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/siderolabs/go-blockdevice/v2/encryption"
+	"github.com/siderolabs/go-blockdevice/v2/encryption/token"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/pkg/encryption/keys"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// RotationStep is the step of the key rotation.
+type RotationStep string
+
+// Key rotation steps.
+const (
+	// RotationStepSeal generates the new key and wraps it with the key provider.
+	RotationStepSeal RotationStep = "seal"
+	// RotationStepStage adds the new key to the temporary key slot.
+	RotationStepStage RotationStep = "stage"
+	// RotationStepReplace replaces the old key with the new one in the rotated key slot.
+	RotationStepReplace RotationStep = "replace"
+	// RotationStepCleanup removes the temporary key slot.
+	RotationStepCleanup RotationStep = "cleanup"
+	// RotationStepRemove removes the key slot which is no longer configured.
+	RotationStepRemove RotationStep = "remove"
+)
+
+// KeySlotInfo describes the key in the LUKS2 key slot.
+type KeySlotInfo struct {
+	Slot     int
+	Provider string
+	Epoch    int
+}
+
+// RotateKey replaces the key in the key slot with a freshly generated and wrapped one.
+//
+// The volume stays unlockable at any point of the rotation: the new key is staged in the temporary key slot
+// before the old key is removed, and the rotation is resumed with the next call or the next [Handler.Open].
+// If the key slot is not configured anymore, it is removed from the volume.
+//
+// RotateKey returns the new key epoch.
+func (h *Handler) RotateKey(ctx context.Context, logger *zap.Logger, path string, slot int, progress func(RotationStep)) (int, error) {
+	rotation := h.newKeyRotation(logger, path, progress)
+
+	result, err := rotation.rotate(ctx, slot)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.epoch, nil
+}
+
+// KeySlots returns the key slots of the volume with the key provider and the key epoch.
+func (h *Handler) KeySlots(ctx context.Context, path string) ([]KeySlotInfo, error) {
+	keyslots, err := h.encryptionProvider.ReadKeyslots(path)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]KeySlotInfo, 0, len(keyslots.Keyslots))
+
+	for slot := range keyslots.Keyslots {
+		s, err := strconv.Atoi(slot)
+		if err != nil {
+			return nil, err
+		}
+
+		t, err := h.readToken(ctx, path, s)
+		if err != nil {
+			return nil, err
+		}
+
+		info := KeySlotInfo{
+			Slot:     s,
+			Provider: keys.TokenProvider(t),
+		}
+
+		if metadata := keys.TokenRotationMetadata(t); metadata != nil {
+			info.Epoch = metadata.Epoch
+		}
+
+		result = append(result, info)
+	}
+
+	slices.SortFunc(result, func(a, b KeySlotInfo) int { return cmp.Compare(a.Slot, b.Slot) })
+
+	return result, nil
+}
+
+func (h *Handler) newKeyRotation(logger *zap.Logger, path string, progress func(RotationStep)) *keyRotation {
+	return &keyRotation{
+		keyslots: providerKeyslots{Provider: h.encryptionProvider, handler: h},
+		handlers: h.keyHandlers,
+		path:     path,
+		logger:   logger,
+		progress: progress,
+	}
+}
+
+// keyslotManager is the subset of the encryption provider operations used by the key rotation.
+type keyslotManager interface {
+	AddKey(ctx context.Context, path string, existingKey, newKey *encryption.Key) error
+	RemoveKey(ctx context.Context, path string, slot int, key *encryption.Key) error
+	CheckKey(ctx context.Context, path string, key *encryption.Key) (bool, error)
+	SetToken(ctx context.Context, path string, slot int, token token.Token) error
+	RemoveToken(ctx context.Context, path string, slot int) error
+	ReadToken(ctx context.Context, path string, slot int) (token.Token, error)
+	KeyslotExists(path string, slot int) (bool, error)
+}
+
+// providerKeyslots implements keyslotManager on top of the encryption provider.
+type providerKeyslots struct {
+	encryption.Provider
+
+	handler *Handler
+}
+
+// ReadToken implements keyslotManager interface.
+func (p providerKeyslots) ReadToken(ctx context.Context, path string, slot int) (token.Token, error) {
+	return p.handler.readToken(ctx, path, slot)
+}
+
+// KeyslotExists implements keyslotManager interface.
+func (p providerKeyslots) KeyslotExists(path string, slot int) (bool, error) {
+	keyslots, err := p.ReadKeyslots(path)
+	if err != nil {
+		return false, err
+	}
+
+	_, ok := keyslots.Keyslots[strconv.Itoa(slot)]
+
+	return ok, nil
+}
+
+// keyRotation replaces the key in a key slot without losing access to the volume.
+//
+// The rotation goes through the temporary key slot (constants.EncryptionKeyRotationSlot):
+//
+//  1. the new key is wrapped by the key provider, and the token is stored in the temporary slot;
+//     the token records the rotated slot, so it serves as the rotation journal;
+//  2. the new key is added to the temporary key slot;
+//  3. the old key is removed from the rotated slot, and the new key with its token is added instead;
+//  4. the temporary key slot and its token are removed.
+//
+// Each step is verified and can be repeated, so an interrupted rotation is either completed
+// (if the new key made it to the temporary slot), or discarded, with the old key still in place.
+type keyRotation struct {
+	keyslots keyslotManager
+	handlers []keys.Handler
+	path     string
+	logger   *zap.Logger
+	progress func(RotationStep)
+}
+
+// pendingRotation is the rotation interrupted before completion.
+type pendingRotation struct {
+	key   *encryption.Key
+	token token.Token
+
+	slot  int
+	epoch int
+
+	// staged is true if the new key is in the temporary key slot.
+	staged bool
+	// stale is true if the rotation can't be completed and should be discarded.
+	stale bool
+}
+
+type rotationResult struct {
+	key   *encryption.Key
+	token token.Token
+
+	slot  int
+	epoch int
+}
+
+func (r *keyRotation) rotate(ctx context.Context, slot int) (*rotationResult, error) {
+	if slot == constants.EncryptionKeyRotationSlot {
+		return nil, fmt.Errorf("key slot %d is reserved for the key rotation", slot)
+	}
+
+	pending, err := r.pending(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if pending != nil && !pending.stale {
+		result, err := r.complete(ctx, pending)
+		if err != nil {
+			return nil, fmt.Errorf("failed to complete the interrupted rotation of key slot %d: %w", pending.slot, err)
+		}
+
+		if pending.slot == slot {
+			return result, nil
+		}
+
+		pending = nil
+	}
+
+	unlockKey, err := r.unlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if pending != nil {
+		if err = r.discard(ctx, unlockKey); err != nil {
+			return nil, err
+		}
+	}
+
+	handler := r.handler(slot)
+	if handler == nil {
+		return r.remove(ctx, slot, unlockKey)
+	}
+
+	pending, err = r.stage(ctx, handler, unlockKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.complete(ctx, pending)
+}
+
+// resume completes or discards the interrupted rotation.
+//
+// The unlockKey is used to discard the stale rotation, if it is nil, the key is looked up with the configured key handlers.
+func (r *keyRotation) resume(ctx context.Context, pending *pendingRotation, unlockKey *encryption.Key) (*rotationResult, error) {
+	if !pending.stale {
+		return r.complete(ctx, pending)
+	}
+
+	if unlockKey == nil {
+		var err error
+
+		if unlockKey, err = r.unlock(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return nil, r.discard(ctx, unlockKey)
+}
+
+// pending reads the rotation journal from the temporary key slot token.
+//
+// It returns nil if there is no rotation in progress.
+//
+//nolint:gocyclo
+func (r *keyRotation) pending(ctx context.Context) (*pendingRotation, error) {
+	t, err := r.keyslots.ReadToken(ctx, r.path, constants.EncryptionKeyRotationSlot)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the key rotation token: %w", err)
+	}
+
+	if t == nil {
+		return nil, nil //nolint:nilnil
+	}
+
+	metadata := keys.TokenRotationMetadata(t)
+	if metadata == nil || metadata.RotatingSlot == nil {
+		return &pendingRotation{stale: true}, nil
+	}
+
+	pending := &pendingRotation{
+		token: t,
+		slot:  *metadata.RotatingSlot,
+		epoch: metadata.Epoch,
+	}
+
+	handler := r.handler(pending.slot)
+	if handler == nil {
+		// the rotated slot was removed from the configuration
+		pending.stale = true
+
+		return pending, nil
+	}
+
+	key, err := handler.GetKey(ctx, t)
+	if err != nil {
+		if errors.Is(err, keys.ErrTokenInvalid) {
+			// the key provider of the rotated slot was changed in the configuration
+			pending.stale = true
+
+			return pending, nil
+		}
+
+		// the new key might be the only one left, so the rotation should never be discarded if the key is not available
+		return nil, fmt.Errorf("failed to get the new key of key slot %d: %w", pending.slot, err)
+	}
+
+	pending.key = encryption.NewKey(constants.EncryptionKeyRotationSlot, key.Value)
+
+	exists, err := r.keyslots.KeyslotExists(r.path, constants.EncryptionKeyRotationSlot)
+	if err != nil {
+		return nil, err
+	}
+
+	if exists {
+		if pending.staged, err = r.keyslots.CheckKey(ctx, r.path, pending.key); err != nil {
+			return nil, err
+		}
+	}
+
+	if !pending.staged {
+		// the temporary key slot is already removed, but the token is not
+		replaced, _, err := r.replaced(ctx, pending)
+		if err != nil {
+			return nil, err
+		}
+
+		pending.stale = !replaced
+	}
+
+	return pending, nil
+}
+
+// unlock finds a valid key among the configured key slots.
+func (r *keyRotation) unlock(ctx context.Context) (*encryption.Key, error) {
+	var errs error
+
+	for _, handler := range r.handlers {
+		key, err := r.unlockSlot(ctx, handler)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("key slot %d: %w", handler.Slot(), err))
+
+			continue
+		}
+
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("no valid key found to unlock the volume: %w", errs)
+}
+
+func (r *keyRotation) unlockSlot(ctx context.Context, handler keys.Handler) (*encryption.Key, error) {
+	ctx, cancel := context.WithTimeout(ctx, keyHandlerTimeout)
+	defer cancel()
+
+	t, err := r.keyslots.ReadToken(ctx, r.path, handler.Slot())
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := handler.GetKey(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+
+	valid, err := r.keyslots.CheckKey(ctx, r.path, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if !valid {
+		return nil, errors.New("key is not valid")
+	}
+
+	return key, nil
+}
+
+// stage generates the new key and adds it to the temporary key slot.
+func (r *keyRotation) stage(ctx context.Context, handler keys.Handler, unlockKey *encryption.Key) (*pendingRotation, error) {
+	slot := handler.Slot()
+
+	oldToken, err := r.keyslots.ReadToken(ctx, r.path, slot)
+	if err != nil {
+		return nil, err
+	}
+
+	epoch := 1
+
+	if metadata := keys.TokenRotationMetadata(oldToken); metadata != nil {
+		epoch = metadata.Epoch + 1
+	}
+
+	r.step(RotationStepSeal)
+
+	key, t, err := handler.NewKey(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate the new key: %w", err)
+	}
+
+	metadata := keys.TokenRotationMetadata(t)
+	if metadata == nil {
+		return nil, fmt.Errorf("key slot %d is not wrapped by a key provider and can't be rotated", slot)
+	}
+
+	metadata.Epoch = epoch
+	metadata.RotatingSlot = &slot
+
+	keys.SetTokenKeySlot(t, constants.EncryptionKeyRotationSlot)
+
+	pending := &pendingRotation{
+		key:    encryption.NewKey(constants.EncryptionKeyRotationSlot, key.Value),
+		token:  t,
+		slot:   slot,
+		epoch:  epoch,
+		staged: true,
+	}
+
+	r.step(RotationStepStage)
+
+	// the token goes first, so that the key in the temporary slot can always be recovered
+	if err = r.keyslots.SetToken(ctx, r.path, constants.EncryptionKeyRotationSlot, t); err != nil {
+		return nil, fmt.Errorf("failed to store the key rotation token: %w", err)
+	}
+
+	if err = r.keyslots.AddKey(ctx, r.path, unlockKey, pending.key); err != nil {
+		return nil, fmt.Errorf("failed to add the new key to the temporary key slot: %w", err)
+	}
+
+	if err = r.verify(ctx, pending.key); err != nil {
+		return nil, err
+	}
+
+	return pending, nil
+}
+
+// complete moves the staged key to the rotated key slot and removes the temporary key slot.
+//
+//nolint:gocyclo
+func (r *keyRotation) complete(ctx context.Context, pending *pendingRotation) (*rotationResult, error) {
+	key := encryption.NewKey(pending.slot, pending.key.Value)
+
+	replaced, t, err := r.replaced(ctx, pending)
+	if err != nil {
+		return nil, err
+	}
+
+	if !replaced {
+		r.step(RotationStepReplace)
+
+		exists, err := r.keyslots.KeyslotExists(r.path, pending.slot)
+		if err != nil {
+			return nil, err
+		}
+
+		// the staged key authorizes the removal, as the old key might be not available anymore
+		if exists {
+			if err = r.keyslots.RemoveKey(ctx, r.path, pending.slot, pending.key); err != nil {
+				return nil, fmt.Errorf("failed to remove the old key: %w", err)
+			}
+		}
+
+		if err = r.removeToken(ctx, pending.slot); err != nil {
+			return nil, err
+		}
+
+		t = pending.token
+
+		keys.TokenRotationMetadata(t).RotatingSlot = nil
+		keys.SetTokenKeySlot(t, pending.slot)
+
+		if err = r.keyslots.SetToken(ctx, r.path, pending.slot, t); err != nil {
+			return nil, fmt.Errorf("failed to store the new token: %w", err)
+		}
+
+		if err = r.keyslots.AddKey(ctx, r.path, pending.key, key); err != nil {
+			return nil, fmt.Errorf("failed to add the new key: %w", err)
+		}
+
+		if err = r.verify(ctx, key); err != nil {
+			return nil, err
+		}
+	}
+
+	r.step(RotationStepCleanup)
+
+	// the key slot goes first, as the token is required to recover the key while the key slot exists
+	exists, err := r.keyslots.KeyslotExists(r.path, constants.EncryptionKeyRotationSlot)
+	if err != nil {
+		return nil, err
+	}
+
+	if exists {
+		if err = r.keyslots.RemoveKey(ctx, r.path, constants.EncryptionKeyRotationSlot, key); err != nil {
+			return nil, fmt.Errorf("failed to remove the temporary key slot: %w", err)
+		}
+	}
+
+	if err = r.removeToken(ctx, constants.EncryptionKeyRotationSlot); err != nil {
+		return nil, err
+	}
+
+	r.logger.Info("rotated encryption key", zap.Int("slot", pending.slot), zap.Int("epoch", pending.epoch))
+
+	return &rotationResult{
+		key:   key,
+		token: t,
+		slot:  pending.slot,
+		epoch: pending.epoch,
+	}, nil
+}
+
+// replaced checks whether the rotated key slot already holds the new key.
+func (r *keyRotation) replaced(ctx context.Context, pending *pendingRotation) (bool, token.Token, error) {
+	exists, err := r.keyslots.KeyslotExists(r.path, pending.slot)
+	if err != nil || !exists {
+		return false, nil, err
+	}
+
+	t, err := r.keyslots.ReadToken(ctx, r.path, pending.slot)
+	if err != nil {
+		return false, nil, err
+	}
+
+	metadata := keys.TokenRotationMetadata(t)
+	if metadata == nil || metadata.Epoch != pending.epoch || metadata.RotatingSlot != nil {
+		return false, nil, nil
+	}
+
+	valid, err := r.keyslots.CheckKey(ctx, r.path, encryption.NewKey(pending.slot, pending.key.Value))
+	if err != nil {
+		return false, nil, err
+	}
+
+	return valid, t, nil
+}
+
+// discard removes the leftovers of the rotation which can't be completed.
+func (r *keyRotation) discard(ctx context.Context, unlockKey *encryption.Key) error {
+	exists, err := r.keyslots.KeyslotExists(r.path, constants.EncryptionKeyRotationSlot)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		if err = r.keyslots.RemoveKey(ctx, r.path, constants.EncryptionKeyRotationSlot, unlockKey); err != nil {
+			return fmt.Errorf("failed to remove the temporary key slot: %w", err)
+		}
+	}
+
+	if err = r.removeToken(ctx, constants.EncryptionKeyRotationSlot); err != nil {
+		return err
+	}
+
+	r.logger.Info("discarded interrupted encryption key rotation")
+
+	return nil
+}
+
+// remove drops the key slot which is not configured anymore.
+func (r *keyRotation) remove(ctx context.Context, slot int, unlockKey *encryption.Key) (*rotationResult, error) {
+	r.step(RotationStepRemove)
+
+	exists, err := r.keyslots.KeyslotExists(r.path, slot)
+	if err != nil {
+		return nil, err
+	}
+
+	if exists {
+		if err = r.keyslots.RemoveKey(ctx, r.path, slot, unlockKey); err != nil {
+			return nil, fmt.Errorf("failed to remove key slot %d: %w", slot, err)
+		}
+	}
+
+	if err = r.removeToken(ctx, slot); err != nil {
+		return nil, err
+	}
+
+	r.logger.Info("removed encryption key", zap.Int("slot", slot))
+
+	return &rotationResult{slot: slot}, nil
+}
+
+func (r *keyRotation) removeToken(ctx context.Context, slot int) error {
+	t, err := r.keyslots.ReadToken(ctx, r.path, slot)
+	if err != nil {
+		return err
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	if err = r.keyslots.RemoveToken(ctx, r.path, slot); err != nil {
+		return fmt.Errorf("failed to remove the token of key slot %d: %w", slot, err)
+	}
+
+	return nil
+}
+
+func (r *keyRotation) verify(ctx context.Context, key *encryption.Key) error {
+	valid, err := r.keyslots.CheckKey(ctx, r.path, key)
+	if err != nil {
+		return fmt.Errorf("failed to verify the key in slot %d: %w", key.Slot, err)
+	}
+
+	if !valid {
+		return fmt.Errorf("the key in slot %d failed verification", key.Slot)
+	}
+
+	return nil
+}
+
+func (r *keyRotation) handler(slot int) keys.Handler {
+	for _, handler := range r.handlers {
+		if handler.Slot() == slot {
+			return handler
+		}
+	}
+
+	return nil
+}
+
+func (r *keyRotation) step(step RotationStep) {
+	if r.progress != nil {
+		r.progress(step)
+	}
+}
@@ -0,0 +1,391 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package encryption_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/siderolabs/go-blockdevice/v2/encryption"
+	"github.com/siderolabs/go-blockdevice/v2/encryption/luks"
+	"github.com/siderolabs/go-blockdevice/v2/encryption/token"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	encryptionpkg "github.com/siderolabs/talos/internal/pkg/encryption"
+	"github.com/siderolabs/talos/internal/pkg/encryption/keys"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+var errPowerLoss = errors.New("power loss")
+
+// fakeVolume simulates LUKS2 key slots and tokens.
+//
+// Every mutating operation is atomic, and the volume "loses power" (fails all operations) after the configured number of them.
+type fakeVolume struct {
+	keyslots map[int][]byte
+	tokens   map[int]*keys.KMSToken
+
+	operations    int
+	maxOperations int
+}
+
+func newFakeVolume() *fakeVolume {
+	return &fakeVolume{
+		keyslots:      map[int][]byte{},
+		tokens:        map[int]*keys.KMSToken{},
+		maxOperations: -1,
+	}
+}
+
+func (v *fakeVolume) mutate() error {
+	if v.maxOperations >= 0 && v.operations >= v.maxOperations {
+		return errPowerLoss
+	}
+
+	v.operations++
+
+	return nil
+}
+
+func (v *fakeVolume) authorized(key *encryption.Key) bool {
+	for _, value := range v.keyslots {
+		if bytes.Equal(value, key.Value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (v *fakeVolume) AddKey(_ context.Context, _ string, existingKey, newKey *encryption.Key) error {
+	if !v.authorized(existingKey) {
+		return errors.New("no key available with this passphrase")
+	}
+
+	if _, ok := v.keyslots[newKey.Slot]; ok {
+		return fmt.Errorf("key slot %d is in use", newKey.Slot)
+	}
+
+	if err := v.mutate(); err != nil {
+		return err
+	}
+
+	v.keyslots[newKey.Slot] = bytes.Clone(newKey.Value)
+
+	return nil
+}
+
+func (v *fakeVolume) RemoveKey(_ context.Context, _ string, slot int, key *encryption.Key) error {
+	if !v.authorized(key) {
+		return errors.New("no key available with this passphrase")
+	}
+
+	if err := v.mutate(); err != nil {
+		return err
+	}
+
+	delete(v.keyslots, slot)
+
+	return nil
+}
+
+func (v *fakeVolume) CheckKey(_ context.Context, _ string, key *encryption.Key) (bool, error) {
+	value, ok := v.keyslots[key.Slot]
+
+	return ok && bytes.Equal(value, key.Value), nil
+}
+
+func (v *fakeVolume) SetToken(_ context.Context, _ string, slot int, t token.Token) error {
+	if _, ok := v.tokens[slot]; ok {
+		return fmt.Errorf("token %d already exists", slot)
+	}
+
+	if err := v.mutate(); err != nil {
+		return err
+	}
+
+	v.tokens[slot] = cloneToken(t.(*luks.Token[*keys.KMSToken]).UserData)
+
+	return nil
+}
+
+func (v *fakeVolume) RemoveToken(_ context.Context, _ string, slot int) error {
+	if err := v.mutate(); err != nil {
+		return err
+	}
+
+	delete(v.tokens, slot)
+
+	return nil
+}
+
+func (v *fakeVolume) ReadToken(_ context.Context, _ string, slot int) (token.Token, error) {
+	t, ok := v.tokens[slot]
+	if !ok {
+		return nil, nil //nolint:nilnil
+	}
+
+	return &luks.Token[*keys.KMSToken]{
+		Type:     keys.TokenTypeKMS,
+		UserData: cloneToken(t),
+	}, nil
+}
+
+func (v *fakeVolume) KeyslotExists(_ string, slot int) (bool, error) {
+	_, ok := v.keyslots[slot]
+
+	return ok, nil
+}
+
+// unlockable checks whether the volume can be opened the same way it happens on boot.
+func (v *fakeVolume) unlockable(handler keys.Handler) bool {
+	for _, slot := range []int{handler.Slot(), constants.EncryptionKeyRotationSlot} {
+		t, ok := v.tokens[slot]
+		if !ok {
+			continue
+		}
+
+		key, err := handler.GetKey(context.Background(), &luks.Token[*keys.KMSToken]{Type: keys.TokenTypeKMS, UserData: t})
+		if err != nil {
+			continue
+		}
+
+		if valid, _ := v.CheckKey(context.Background(), "", encryption.NewKey(slot, key.Value)); valid { //nolint:errcheck
+			return true
+		}
+	}
+
+	return false
+}
+
+func cloneToken(t *keys.KMSToken) *keys.KMSToken {
+	clone := *t
+	clone.SealedData = bytes.Clone(t.SealedData)
+
+	if t.RotatingSlot != nil {
+		slot := *t.RotatingSlot
+		clone.RotatingSlot = &slot
+	}
+
+	return &clone
+}
+
+// fakeKMSHandler "seals" the key by storing it in the token as is.
+type fakeKMSHandler struct {
+	slot    int
+	counter byte
+}
+
+func (h *fakeKMSHandler) NewKey(context.Context) (*encryption.Key, token.Token, error) {
+	h.counter++
+
+	value := bytes.Repeat([]byte{byte(h.slot), h.counter}, 16)
+
+	return encryption.NewKey(h.slot, value), &luks.Token[*keys.KMSToken]{
+		Type: keys.TokenTypeKMS,
+		UserData: &keys.KMSToken{
+			SealedData: value,
+		},
+	}, nil
+}
+
+func (h *fakeKMSHandler) GetKey(_ context.Context, t token.Token) (*encryption.Key, error) {
+	kmsToken, ok := t.(*luks.Token[*keys.KMSToken])
+	if !ok {
+		return nil, keys.ErrTokenInvalid
+	}
+
+	return encryption.NewKey(h.slot, kmsToken.UserData.SealedData), nil
+}
+
+func (h *fakeKMSHandler) Slot() int {
+	return h.slot
+}
+
+// fakeStaticHandler returns the fixed key without a token.
+type fakeStaticHandler struct {
+	slot  int
+	value []byte
+}
+
+func (h *fakeStaticHandler) NewKey(context.Context) (*encryption.Key, token.Token, error) {
+	return encryption.NewKey(h.slot, h.value), nil, nil
+}
+
+func (h *fakeStaticHandler) GetKey(context.Context, token.Token) (*encryption.Key, error) {
+	return encryption.NewKey(h.slot, h.value), nil
+}
+
+func (h *fakeStaticHandler) Slot() int {
+	return h.slot
+}
+
+func enroll(t *testing.T, volume *fakeVolume, handler keys.Handler) {
+	t.Helper()
+
+	key, tok, err := handler.NewKey(t.Context())
+	require.NoError(t, err)
+
+	volume.keyslots[handler.Slot()] = key.Value
+
+	if tok != nil {
+		volume.tokens[handler.Slot()] = cloneToken(tok.(*luks.Token[*keys.KMSToken]).UserData)
+	}
+}
+
+func TestRotateKey(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	logger := zaptest.NewLogger(t)
+
+	volume := newFakeVolume()
+	handler := &fakeKMSHandler{slot: 0}
+	handlers := []keys.Handler{handler}
+
+	enroll(t, volume, handler)
+
+	oldKey := volume.keyslots[0]
+
+	epoch, err := encryptionpkg.RotateKey(ctx, logger, volume, handlers, 0)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, epoch)
+	assert.NotEqual(t, oldKey, volume.keyslots[0])
+	assert.Equal(t, volume.tokens[0].SealedData, volume.keyslots[0])
+	assert.Equal(t, 1, volume.tokens[0].Epoch)
+	assert.Nil(t, volume.tokens[0].RotatingSlot)
+	assert.NotContains(t, volume.keyslots, constants.EncryptionKeyRotationSlot)
+	assert.NotContains(t, volume.tokens, constants.EncryptionKeyRotationSlot)
+
+	epoch, err = encryptionpkg.RotateKey(ctx, logger, volume, handlers, 0)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, epoch)
+	assert.Len(t, volume.keyslots, 1)
+}
+
+func TestRotateKeyNotWrapped(t *testing.T) {
+	t.Parallel()
+
+	volume := newFakeVolume()
+	handler := &fakeStaticHandler{slot: 0, value: []byte("static")}
+
+	enroll(t, volume, handler)
+
+	_, err := encryptionpkg.RotateKey(t.Context(), zaptest.NewLogger(t), volume, []keys.Handler{handler}, 0)
+	require.EqualError(t, err, "key slot 0 is not wrapped by a key provider and can't be rotated")
+
+	assert.Equal(t, map[int][]byte{0: []byte("static")}, volume.keyslots)
+	assert.Empty(t, volume.tokens)
+}
+
+func TestRotateKeyProviderChange(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	logger := zaptest.NewLogger(t)
+
+	volume := newFakeVolume()
+	oldHandler := &fakeKMSHandler{slot: 0}
+	newHandler := &fakeKMSHandler{slot: 1}
+
+	enroll(t, volume, oldHandler)
+
+	// the new provider is added to the configuration
+	epoch, err := encryptionpkg.RotateKey(ctx, logger, volume, []keys.Handler{oldHandler, newHandler}, 1)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, epoch)
+	assert.True(t, volume.unlockable(newHandler))
+
+	// the old provider is removed from the configuration
+	_, err = encryptionpkg.RotateKey(ctx, logger, volume, []keys.Handler{newHandler}, 0)
+	require.NoError(t, err)
+
+	assert.NotContains(t, volume.keyslots, 0)
+	assert.NotContains(t, volume.tokens, 0)
+	assert.True(t, volume.unlockable(newHandler))
+}
+
+func TestRotateKeyPowerLoss(t *testing.T) {
+	t.Parallel()
+
+	// count the operations of the full rotation
+	volume := newFakeVolume()
+	handler := &fakeKMSHandler{slot: 0}
+
+	enroll(t, volume, handler)
+
+	_, err := encryptionpkg.RotateKey(t.Context(), zaptest.NewLogger(t), volume, []keys.Handler{handler}, 0)
+	require.NoError(t, err)
+
+	totalOperations := volume.operations
+
+	for _, resume := range []struct {
+		name string
+		fn   func(context.Context, *testing.T, *fakeVolume, []keys.Handler)
+	}{
+		{
+			name: "open",
+			fn: func(ctx context.Context, t *testing.T, volume *fakeVolume, handlers []keys.Handler) {
+				require.NoError(t, encryptionpkg.ResumeRotation(ctx, zaptest.NewLogger(t), volume, handlers))
+			},
+		},
+		{
+			name: "retry",
+			fn: func(ctx context.Context, t *testing.T, volume *fakeVolume, handlers []keys.Handler) {
+				_, err := encryptionpkg.RotateKey(ctx, zaptest.NewLogger(t), volume, handlers, 0)
+				require.NoError(t, err)
+			},
+		},
+	} {
+		for crashAfter := range totalOperations {
+			t.Run(fmt.Sprintf("%s-%d", resume.name, crashAfter), func(t *testing.T) {
+				t.Parallel()
+
+				ctx := t.Context()
+
+				volume := newFakeVolume()
+				handler := &fakeKMSHandler{slot: 0}
+				handlers := []keys.Handler{handler}
+
+				enroll(t, volume, handler)
+
+				volume.maxOperations = crashAfter
+
+				_, err := encryptionpkg.RotateKey(ctx, zaptest.NewLogger(t), volume, handlers, 0)
+				require.ErrorIs(t, err, errPowerLoss)
+
+				assert.True(t, volume.unlockable(handler), "volume is not unlockable after power loss")
+
+				volume.maxOperations = -1
+
+				resume.fn(ctx, t, volume, handlers)
+
+				assert.True(t, volume.unlockable(handler))
+				assert.Len(t, volume.keyslots, 1)
+				assert.Len(t, volume.tokens, 1)
+				assert.Equal(t, volume.tokens[0].SealedData, volume.keyslots[0])
+				assert.Nil(t, volume.tokens[0].RotatingSlot)
+
+				// the rotation is either completed or rolled back
+				epochBefore := volume.tokens[0].Epoch
+				assert.LessOrEqual(t, epochBefore, 1)
+
+				epoch, err := encryptionpkg.RotateKey(ctx, zaptest.NewLogger(t), volume, handlers, 0)
+				require.NoError(t, err)
+
+				assert.Equal(t, epochBefore+1, epoch)
+				assert.Len(t, volume.keyslots, 1)
+			})
+		}
+	}
+}
@@ -26,6 +26,11 @@ func GetEndpoints(ctx context.Context, resources state.State) ([]string, error)
 		return nil, fmt.Errorf("error getting endpoints resources: %w", err)
 	}
 
+	return EndpointsFromList(endpointResources)
+}
+
+// EndpointsFromList returns expected endpoints of etcd cluster members from the list of endpoint resources.
+func EndpointsFromList(endpointResources safe.List[*k8s.Endpoint]) ([]string, error) {
 	var endpointAddrs k8s.EndpointList
 
 	// merge all endpoints into a single list
@@ -0,0 +1,120 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+// LearnerStatus describes the learner state of the local etcd member.
+type LearnerStatus struct {
+	MemberID  uint64
+	IsLearner bool
+	// Lag is the number of raft log entries the learner lags behind the voting members.
+	Lag uint64
+}
+
+// GetLearnerStatus returns the learner state of the local etcd member.
+//
+// The lag is calculated against the voting members reachable via the endpoints.
+func GetLearnerStatus(ctx context.Context, endpoints []string) (LearnerStatus, error) {
+	localClient, err := NewLocalClient(ctx)
+	if err != nil {
+		return LearnerStatus{}, err
+	}
+
+	defer localClient.Close() //nolint:errcheck
+
+	local, err := localClient.Status(ctx, nethelpers.JoinHostPort("localhost", constants.EtcdClientPort))
+	if err != nil {
+		return LearnerStatus{}, fmt.Errorf("error getting local member status: %w", err)
+	}
+
+	status := LearnerStatus{
+		MemberID:  local.Header.MemberId,
+		IsLearner: local.IsLearner,
+	}
+
+	if !status.IsLearner {
+		return status, nil
+	}
+
+	client, err := NewClient(ctx, endpoints)
+	if err != nil {
+		return status, err
+	}
+
+	defer client.Close() //nolint:errcheck
+
+	members := make([]*clientv3.StatusResponse, 0, len(endpoints))
+
+	for _, endpoint := range endpoints {
+		// some members might be down, the lag is calculated against the reachable ones
+		resp, err := client.Status(ctx, endpoint)
+		if err != nil {
+			continue
+		}
+
+		members = append(members, resp)
+	}
+
+	status.Lag, err = LearnerLag(local, members)
+
+	return status, err
+}
+
+// LearnerLag returns the number of raft log entries the learner lags behind the most up-to-date voting member.
+func LearnerLag(learner *clientv3.StatusResponse, members []*clientv3.StatusResponse) (uint64, error) {
+	var (
+		raftIndex uint64
+		found     bool
+	)
+
+	for _, member := range members {
+		if member.IsLearner {
+			continue
+		}
+
+		found = true
+		raftIndex = max(raftIndex, member.RaftIndex)
+	}
+
+	if !found {
+		return 0, errors.New("no voting members are reachable")
+	}
+
+	if learner.RaftIndex >= raftIndex {
+		return 0, nil
+	}
+
+	return raftIndex - learner.RaftIndex, nil
+}
+
+// CheckLearnerLimit returns an error if the number of learners in the cluster reached the limit.
+//
+// The member with the given name is not counted, as it is replaced by the joining member.
+func CheckLearnerLimit(members []*etcdserverpb.Member, name string, maxLearners int) error {
+	learners := 0
+
+	for _, member := range members {
+		if member.IsLearner && member.Name != name {
+			learners++
+		}
+	}
+
+	if learners >= maxLearners {
+		return fmt.Errorf("cluster has %d learner(s), waiting for the promotion before joining (max learners %d)", learners, maxLearners)
+	}
+
+	return nil
+}
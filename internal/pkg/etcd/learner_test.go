@@ -0,0 +1,60 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/siderolabs/talos/internal/pkg/etcd"
+)
+
+func TestLearnerLag(t *testing.T) {
+	t.Parallel()
+
+	// 3-node cluster: two voting members, one of them is slightly behind the leader, and a lagging learner
+	learner := &clientv3.StatusResponse{IsLearner: true, RaftIndex: 1200}
+
+	members := []*clientv3.StatusResponse{
+		{RaftIndex: 5000},
+		{RaftIndex: 4990},
+		learner,
+	}
+
+	lag, err := etcd.LearnerLag(learner, members)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3800, lag)
+
+	// learner catches up
+	learner.RaftIndex = 5000
+
+	lag, err = etcd.LearnerLag(learner, members)
+	require.NoError(t, err)
+	assert.Zero(t, lag)
+
+	// voting members are not reachable
+	_, err = etcd.LearnerLag(learner, []*clientv3.StatusResponse{learner})
+	assert.EqualError(t, err, "no voting members are reachable")
+}
+
+func TestCheckLearnerLimit(t *testing.T) {
+	t.Parallel()
+
+	members := []*etcdserverpb.Member{
+		{Name: "cp-1"},
+		{Name: "cp-2"},
+		{Name: "cp-3", IsLearner: true},
+	}
+
+	assert.EqualError(t, etcd.CheckLearnerLimit(members, "cp-4", 1), "cluster has 1 learner(s), waiting for the promotion before joining (max learners 1)")
+	assert.NoError(t, etcd.CheckLearnerLimit(members, "cp-4", 2))
+
+	// the member re-joining replaces itself
+	assert.NoError(t, etcd.CheckLearnerLimit(members, "cp-3", 1))
+}
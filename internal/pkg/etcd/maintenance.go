@@ -0,0 +1,37 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrMaintenanceInProgress is returned when another maintenance operation of the local etcd member is in progress.
+var ErrMaintenanceInProgress = errors.New("etcd maintenance operation is in progress")
+
+// maintenanceMu serializes maintenance operations (snapshot, defragmentation) of the local etcd member.
+var maintenanceMu sync.Mutex
+
+// WithMaintenance executes the given function exclusively with other maintenance operations of the local etcd member.
+func WithMaintenance(f func() error) error {
+	maintenanceMu.Lock()
+	defer maintenanceMu.Unlock()
+
+	return f()
+}
+
+// TryMaintenance executes the given function if no other maintenance operation of the local etcd member is in progress.
+//
+// If another operation is in progress, ErrMaintenanceInProgress is returned.
+func TryMaintenance(f func() error) error {
+	if !maintenanceMu.TryLock() {
+		return ErrMaintenanceInProgress
+	}
+
+	defer maintenanceMu.Unlock()
+
+	return f()
+}
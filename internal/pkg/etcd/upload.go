@@ -0,0 +1,76 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+)
+
+// UploadSnapshot uploads the etcd snapshot to the S3-compatible object storage, and returns the location of the uploaded object.
+//
+// The object is addressed in the path style, and the SHA256 hash of the snapshot (hex) is stored
+// in the object metadata to verify the integrity of the snapshot before the recovery.
+func UploadSnapshot(ctx context.Context, dest config.EtcdSnapshotUpload, key string, snapshot io.ReadSeeker, size int64, sha256sum string) (string, error) {
+	endpoint, err := url.Parse(dest.Endpoint())
+	if err != nil {
+		return "", fmt.Errorf("error parsing object storage endpoint: %w", err)
+	}
+
+	location := endpoint.JoinPath(dest.Bucket(), key)
+
+	if _, err = snapshot.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, location.String(), snapshot)
+	if err != nil {
+		return "", err
+	}
+
+	req.ContentLength = size
+
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("X-Amz-Content-Sha256", sha256sum)
+	req.Header.Set("X-Amz-Meta-Sha256", sha256sum)
+
+	if dest.ServerSideEncryption() {
+		req.Header.Set("X-Amz-Server-Side-Encryption", "AES256")
+	}
+
+	credentials := aws.Credentials{
+		AccessKeyID:     dest.AccessKeyID(),
+		SecretAccessKey: dest.SecretAccessKey(),
+	}
+
+	if err = v4.NewSigner().SignHTTP(ctx, credentials, req, sha256sum, "s3", dest.Region(), time.Now()); err != nil {
+		return "", fmt.Errorf("error signing the upload request: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024)) //nolint:errcheck
+
+		return "", fmt.Errorf("unexpected response status %q: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	return location.String(), nil
+}
@@ -0,0 +1,77 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/etcd"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+)
+
+func TestUploadSnapshot(t *testing.T) {
+	t.Parallel()
+
+	const snapshot = "etcd snapshot"
+
+	hash := sha256.Sum256([]byte(snapshot))
+	sha256sum := hex.EncodeToString(hash[:])
+
+	var uploaded string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Amz-Meta-Sha256") != sha256sum {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=key/") {
+			w.WriteHeader(http.StatusForbidden)
+			io.WriteString(w, "<Error><Code>AccessDenied</Code></Error>") //nolint:errcheck
+
+			return
+		}
+
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/backups/cluster/snapshot.db", r.URL.Path)
+		assert.Equal(t, "AES256", r.Header.Get("X-Amz-Server-Side-Encryption"))
+
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+
+		uploaded = string(body)
+	}))
+
+	t.Cleanup(srv.Close)
+
+	dest := &v1alpha1.EtcdSnapshotsS3Config{
+		S3Endpoint:             srv.URL,
+		S3Bucket:               "backups",
+		S3AccessKeyID:          "key",
+		S3SecretAccessKey:      "secret",
+		S3ServerSideEncryption: true,
+	}
+
+	location, err := etcd.UploadSnapshot(t.Context(), dest, "cluster/snapshot.db", strings.NewReader(snapshot), int64(len(snapshot)), sha256sum)
+	require.NoError(t, err)
+
+	assert.Equal(t, srv.URL+"/backups/cluster/snapshot.db", location)
+	assert.Equal(t, snapshot, uploaded)
+
+	dest.S3AccessKeyID = "other"
+
+	_, err = etcd.UploadSnapshot(t.Context(), dest, "cluster/snapshot.db", strings.NewReader(snapshot), int64(len(snapshot)), sha256sum)
+	assert.EqualError(t, err, `unexpected response status "403 Forbidden": <Error><Code>AccessDenied</Code></Error>`)
+}
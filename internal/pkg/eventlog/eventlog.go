@@ -0,0 +1,228 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package eventlog implements a size-capped append-only log of records persisted across reboots.
+//
+// Each record is framed with the payload length and the CRC32 checksum of the payload,
+// so that the records torn by a power loss are detected and skipped.
+// The log is rotated once the current file reaches half of the maximum size,
+// the rotated file is kept, so the log never takes more than the maximum size.
+package eventlog
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/fs"
+	"os"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/pkg/xfs"
+)
+
+const (
+	headerSize = 8
+
+	// MaxRecordSize is the maximum size of a single record payload.
+	MaxRecordSize = 64 * 1024
+)
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// Log is a persisted append-only log.
+//
+// Log is not safe for concurrent use.
+type Log struct {
+	path    string
+	maxSize int64
+
+	// size of the valid records in the current file, -1 if not known yet
+	size int64
+}
+
+// New creates a new Log stored at the path with the maximum size (including the rotated file).
+func New(path string, maxSize int64) *Log {
+	return &Log{
+		path:    path,
+		maxSize: maxSize,
+		size:    -1,
+	}
+}
+
+// SetMaxSize updates the maximum size of the log, it takes effect on the next rotation.
+func (l *Log) SetMaxSize(maxSize int64) {
+	l.maxSize = maxSize
+}
+
+func (l *Log) rotatedPath() string {
+	return l.path + ".1"
+}
+
+// ReadAll reads the records from the rotated and the current file, oldest first.
+//
+// The records after the first corrupted record of each file are skipped.
+func (l *Log) ReadAll(root xfs.Root) ([][]byte, error) {
+	var records [][]byte
+
+	for _, path := range []string{l.rotatedPath(), l.path} {
+		f, err := root.Open(path)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		_, err = scan(f, func(record []byte) {
+			records = append(records, record)
+		})
+
+		f.Close() //nolint:errcheck
+
+		if err != nil {
+			return nil, fmt.Errorf("error reading %q: %w", path, err)
+		}
+	}
+
+	return records, nil
+}
+
+// Append appends the records to the log and syncs the log to the disk.
+//
+// The corrupted tail of the current file is truncated before appending.
+// The records bigger than MaxRecordSize are dropped.
+//
+//nolint:gocyclo
+func (l *Log) Append(root xfs.Root, records [][]byte) error {
+	var buf []byte
+
+	for _, record := range records {
+		if len(record) > MaxRecordSize {
+			continue
+		}
+
+		buf = binary.BigEndian.AppendUint32(buf, uint32(len(record)))
+		buf = binary.BigEndian.AppendUint32(buf, crc32.Checksum(record, crcTable))
+		buf = append(buf, record...)
+	}
+
+	if len(buf) == 0 {
+		return nil
+	}
+
+	f, err := root.OpenFile(l.path, os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if f != nil {
+			f.Close() //nolint:errcheck
+		}
+	}()
+
+	if l.size < 0 {
+		if l.size, err = scan(f, nil); err != nil {
+			return fmt.Errorf("error reading %q: %w", l.path, err)
+		}
+	}
+
+	if l.size > 0 && l.size+int64(len(buf)) > l.maxSize/2 {
+		if err = f.Close(); err != nil {
+			return err
+		}
+
+		f = nil
+
+		if err = root.Rename(l.path, l.rotatedPath()); err != nil {
+			return fmt.Errorf("error rotating %q: %w", l.path, err)
+		}
+
+		if f, err = root.OpenFile(l.path, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o600); err != nil {
+			return err
+		}
+
+		l.size = 0
+	}
+
+	end, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+
+	if end != l.size {
+		// drop the corrupted tail, so that the new records are readable
+		if err = unix.Ftruncate(int(f.Fd()), l.size); err != nil {
+			return fmt.Errorf("error truncating %q: %w", l.path, err)
+		}
+	}
+
+	if _, err = f.WriteAt(buf, l.size); err != nil {
+		l.size = -1
+
+		return fmt.Errorf("error writing %q: %w", l.path, err)
+	}
+
+	if err = unix.Fsync(int(f.Fd())); err != nil {
+		l.size = -1
+
+		return fmt.Errorf("error syncing %q: %w", l.path, err)
+	}
+
+	l.size += int64(len(buf))
+
+	return nil
+}
+
+// scan reads the records until the end of the file or the first corrupted record.
+//
+// It returns the size of the valid records.
+func scan(r io.Reader, fn func(record []byte)) (int64, error) {
+	br := bufio.NewReader(r)
+
+	var (
+		size   int64
+		header [headerSize]byte
+	)
+
+	for {
+		if _, err := io.ReadFull(br, header[:]); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return size, nil
+			}
+
+			return size, err
+		}
+
+		length := binary.BigEndian.Uint32(header[:4])
+		if length > MaxRecordSize {
+			return size, nil
+		}
+
+		record := make([]byte, length)
+
+		if _, err := io.ReadFull(br, record); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return size, nil
+			}
+
+			return size, err
+		}
+
+		if crc32.Checksum(record, crcTable) != binary.BigEndian.Uint32(header[4:]) {
+			return size, nil
+		}
+
+		if fn != nil {
+			fn(record)
+		}
+
+		size += headerSize + int64(length)
+	}
+}
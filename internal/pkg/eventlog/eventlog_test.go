@@ -0,0 +1,143 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package eventlog_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/eventlog"
+	"github.com/siderolabs/talos/pkg/xfs"
+)
+
+func records(prefix string, n int) [][]byte {
+	result := make([][]byte, 0, n)
+
+	for i := range n {
+		result = append(result, fmt.Appendf(nil, "%s-%03d", prefix, i))
+	}
+
+	return result
+}
+
+func TestAppendRead(t *testing.T) {
+	t.Parallel()
+
+	root := &xfs.OSRoot{Shadow: t.TempDir()}
+
+	log := eventlog.New("events.log", 1024*1024)
+
+	all, err := log.ReadAll(root)
+	require.NoError(t, err)
+	assert.Empty(t, all)
+
+	require.NoError(t, log.Append(root, records("a", 3)))
+	require.NoError(t, log.Append(root, records("b", 2)))
+
+	// the log is reopened after the reboot
+	all, err = eventlog.New("events.log", 1024*1024).ReadAll(root)
+	require.NoError(t, err)
+	assert.Equal(t, append(records("a", 3), records("b", 2)...), all)
+}
+
+func TestRotation(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	root := &xfs.OSRoot{Shadow: dir}
+
+	// each record takes 8+5 bytes, the current file is rotated after 7 records
+	log := eventlog.New("events.log", 200)
+
+	for i := range 30 {
+		require.NoError(t, log.Append(root, [][]byte{fmt.Appendf(nil, "r-%03d", i)}))
+	}
+
+	all, err := log.ReadAll(root)
+	require.NoError(t, err)
+
+	assert.Less(t, len(all), 30)
+	assert.Equal(t, "r-029", string(all[len(all)-1]))
+
+	for i := 1; i < len(all); i++ {
+		assert.Less(t, string(all[i-1]), string(all[i]))
+	}
+
+	var total int64
+
+	for _, name := range []string{"events.log", "events.log.1"} {
+		st, err := os.Stat(filepath.Join(dir, name))
+		require.NoError(t, err)
+
+		total += st.Size()
+	}
+
+	assert.LessOrEqual(t, total, int64(200))
+}
+
+func TestCorruptedTail(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	root := &xfs.OSRoot{Shadow: dir}
+
+	require.NoError(t, eventlog.New("events.log", 1024*1024).Append(root, records("a", 3)))
+
+	path := filepath.Join(dir, "events.log")
+
+	contents, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	for _, test := range []struct {
+		name     string
+		contents []byte
+	}{
+		{
+			name:     "torn record",
+			contents: contents[:len(contents)-2],
+		},
+		{
+			name:     "torn header",
+			contents: append(append([]byte(nil), contents...), 0, 0, 0),
+		},
+		{
+			name:     "garbage",
+			contents: append(append([]byte(nil), contents...), []byte("garbage after power loss")...),
+		},
+		{
+			name:     "checksum mismatch",
+			contents: append(append([]byte(nil), contents[:len(contents)-1]...), 'x'),
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			testDir := t.TempDir()
+			testRoot := &xfs.OSRoot{Shadow: testDir}
+
+			require.NoError(t, os.WriteFile(filepath.Join(testDir, "events.log"), test.contents, 0o600))
+
+			log := eventlog.New("events.log", 1024*1024)
+
+			all, err := log.ReadAll(testRoot)
+			require.NoError(t, err)
+
+			valid := len(all)
+			assert.GreaterOrEqual(t, valid, 2)
+
+			// new records are readable after the corrupted tail
+			require.NoError(t, log.Append(testRoot, records("b", 1)))
+
+			all, err = log.ReadAll(testRoot)
+			require.NoError(t, err)
+
+			assert.Len(t, all, valid+1)
+			assert.Equal(t, "b-000", string(all[len(all)-1]))
+		})
+	}
+}
@@ -27,6 +27,9 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/imager/quirks"
 )
 
+// KernelModulesDependencyExtensionName is the name of the extension generated with the combined modules.dep.
+const KernelModulesDependencyExtensionName = "modules.dep"
+
 // ProvidesKernelModules returns true if the extension provides kernel modules.
 func (ext *Extension) ProvidesKernelModules(quirks quirks.Quirks) bool {
 	if _, err := os.Stat(ext.KernelModuleDirectory(quirks)); errors.Is(err, fs.ErrNotExist) {
@@ -154,11 +157,11 @@ func GenerateKernelModuleDependencyTreeExtension(
 	}
 
 	kernelModulesDepTreeExtension := extensions.New(
-		kernelModulesDependencyTreeStagingDir, "modules.dep",
+		kernelModulesDependencyTreeStagingDir, KernelModulesDependencyExtensionName,
 		extensions.Manifest{
 			Version: kernelVersionPath,
 			Metadata: extensions.Metadata{
-				Name:        "modules.dep",
+				Name:        KernelModulesDependencyExtensionName,
 				Version:     kernelVersionPath,
 				Author:      "Talos Machinery",
 				Description: "Combined modules.dep for all extensions",
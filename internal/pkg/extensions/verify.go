@@ -0,0 +1,73 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package extensions
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/extensions"
+)
+
+// VerificationResult is the result of the signature verification of a system extension.
+type VerificationResult struct {
+	Layer *extensions.Layer
+	// Signer is the name of the trusted key which signed the extension image.
+	Signer string
+	// Err is set if the extension image is not signed by a trusted key.
+	Err error
+}
+
+// Verify checks the signatures of the system extensions against the verification policy.
+//
+// The error names every extension which is not signed by a trusted key, unless the verification is disabled by the policy.
+func Verify(layers []*extensions.Layer, policy config.ExtensionVerificationConfig) ([]VerificationResult, error) {
+	trustedKeys := make([]extensions.TrustedKey, 0, len(policy.TrustedKeys()))
+
+	for _, key := range policy.TrustedKeys() {
+		publicKey, err := extensions.ParsePublicKey([]byte(key.PublicKey()))
+		if err != nil {
+			return nil, fmt.Errorf("error parsing trusted key %q: %w", key.Name(), err)
+		}
+
+		trustedKeys = append(trustedKeys, extensions.TrustedKey{Name: key.Name(), PublicKey: publicKey})
+	}
+
+	results := make([]VerificationResult, 0, len(layers))
+
+	var errs []error
+
+	for _, layer := range layers {
+		// the combined modules.dep is generated by the imager from the other extensions
+		if layer.Source == nil && layer.Metadata.Name == KernelModulesDependencyExtensionName {
+			continue
+		}
+
+		signer, err := layer.VerifySignature(trustedKeys)
+
+		results = append(results, VerificationResult{
+			Layer:  layer,
+			Signer: signer,
+			Err:    err,
+		})
+
+		if err != nil {
+			imageRef := "unknown image"
+
+			if layer.Source != nil {
+				imageRef = layer.Source.ImageRef
+			}
+
+			errs = append(errs, fmt.Errorf("system extension %q (%s): %w", layer.Metadata.Name, imageRef, err))
+		}
+	}
+
+	if policy.InsecureNoVerify() {
+		return results, nil
+	}
+
+	return results, errors.Join(errs...)
+}
@@ -12,7 +12,9 @@ import (
 	"io"
 	"log"
 	"os"
+	"slices"
 	"strconv"
+	"strings"
 
 	containerd "github.com/containerd/containerd/v2/client"
 	"github.com/containerd/containerd/v2/pkg/cio"
@@ -152,6 +154,21 @@ func RunInstallerContainer(
 		args = append(args, "--extra-kernel-arg", arg)
 	}
 
+	if cfg != nil && cfg.CPUIsolationConfig() != nil {
+		for _, arg := range cfg.CPUIsolationConfig().KernelArgs() {
+			key, _, _ := strings.Cut(arg, "=")
+
+			// explicitly configured extra kernel args take precedence
+			if slices.ContainsFunc(options.ExtraKernelArgs, func(extraArg string) bool {
+				return strings.HasPrefix(extraArg, key+"=")
+			}) {
+				continue
+			}
+
+			args = append(args, "--extra-kernel-arg", arg)
+		}
+	}
+
 	for _, preservedArg := range []string{
 		constants.KernelParamSideroLink,
 		constants.KernelParamEventsSink,
@@ -24,7 +24,15 @@ const (
 )
 
 // InhibitMaxDelay is the maximum delay for graceful shutdown.
-const InhibitMaxDelay = 40 * constants.KubeletShutdownGracePeriod
+const InhibitMaxDelay = constants.KubeletShutdownGracePeriodMax
+
+// ShutdownWaitTimeout returns the maximum time to wait for the kubelet to release the inhibit lock.
+//
+// The kubelet is given the shutdown grace period plus a margin, but never more than InhibitMaxDelay,
+// so that a stuck kubelet shutdown doesn't block the shutdown sequence forever.
+func ShutdownWaitTimeout(gracePeriod time.Duration) time.Duration {
+	return min(gracePeriod+constants.KubeletShutdownWaitMargin, InhibitMaxDelay)
+}
 
 type logindMock struct {
 	mu          sync.Mutex
@@ -8,6 +8,7 @@ import (
 	"context"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -83,3 +84,102 @@ func TestIntegration(t *testing.T) {
 
 	assert.NoError(t, <-errCh)
 }
+
+func setupShutdown(t *testing.T) (*logind.ServiceMock, *DBusCon) {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	socketPathService := filepath.Join(dir, "system_bus_service")
+	socketPathClient := filepath.Join(dir, "system_bus_client")
+
+	ctx, cancel := context.WithCancel(t.Context())
+
+	broker, err := logind.NewBroker(ctx, socketPathService, socketPathClient)
+	require.NoError(t, err)
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- broker.Run(ctx)
+	}()
+
+	serviceConn, err := logind.NewServiceMock(socketPathService)
+	require.NoError(t, err)
+
+	kubeletConn, err := NewDBusCon("unix:path=" + socketPathClient)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		assert.NoError(t, kubeletConn.Close())
+		assert.NoError(t, serviceConn.Close())
+		assert.NoError(t, broker.Close())
+
+		cancel()
+
+		assert.NoError(t, <-errCh)
+	})
+
+	return serviceConn, kubeletConn
+}
+
+func TestShutdownKubeletHonorsInhibit(t *testing.T) {
+	serviceConn, kubeletConn := setupShutdown(t)
+
+	const podsTerminationTime = 200 * time.Millisecond
+
+	l, err := kubeletConn.InhibitShutdown()
+	require.NoError(t, err)
+
+	ch, err := kubeletConn.MonitorShutdown()
+	require.NoError(t, err)
+
+	go func() {
+		// kubelet terminates the pods and releases the lock
+		if <-ch {
+			time.Sleep(podsTerminationTime)
+
+			assert.NoError(t, kubeletConn.ReleaseInhibitLock(l))
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(t.Context(), logind.ShutdownWaitTimeout(constants.KubeletShutdownGracePeriod))
+	defer cancel()
+
+	start := time.Now()
+
+	require.NoError(t, serviceConn.EmitShutdown())
+	require.NoError(t, serviceConn.WaitLockRelease(ctx))
+
+	assert.GreaterOrEqual(t, time.Since(start), podsTerminationTime)
+}
+
+func TestShutdownKubeletIgnoresInhibit(t *testing.T) {
+	serviceConn, kubeletConn := setupShutdown(t)
+
+	// kubelet takes the lock, but never releases it
+	_, err := kubeletConn.InhibitShutdown()
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 200*time.Millisecond)
+	defer cancel()
+
+	require.NoError(t, serviceConn.EmitShutdown())
+	assert.ErrorIs(t, serviceConn.WaitLockRelease(ctx), context.DeadlineExceeded)
+}
+
+func TestShutdownNoInhibit(t *testing.T) {
+	serviceConn, _ := setupShutdown(t)
+
+	// kubelet with disabled graceful shutdown never takes the lock
+	require.NoError(t, serviceConn.EmitShutdown())
+	assert.NoError(t, serviceConn.WaitLockRelease(t.Context()))
+}
+
+func TestShutdownWaitTimeout(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, constants.KubeletShutdownWaitMargin, logind.ShutdownWaitTimeout(0))
+	assert.Equal(t, constants.KubeletShutdownGracePeriod+constants.KubeletShutdownWaitMargin, logind.ShutdownWaitTimeout(constants.KubeletShutdownGracePeriod))
+	assert.Equal(t, logind.InhibitMaxDelay, logind.ShutdownWaitTimeout(time.Hour))
+}
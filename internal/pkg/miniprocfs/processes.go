@@ -20,6 +20,17 @@ const (
 	userHz        = 100
 )
 
+// StateZombie is the state of the zombie process.
+const StateZombie = "Z"
+
+// ProcessStat is a short process stat.
+type ProcessStat struct {
+	Command string
+	State   string
+	PID     int32
+	PPID    int32
+}
+
 // Processes wraps iterative walker over processes under /proc.
 type Processes struct {
 	fd       *os.File
@@ -67,31 +78,98 @@ func (procs *Processes) Close() error {
 // Next skips processes which can't be analyzed.
 func (procs *Processes) Next() (*machine.ProcessInfo, error) {
 	for {
-		if procs.idx >= len(procs.dirnames) {
-			var err error
+		pidString, err := procs.nextDirname()
+		if err != nil || pidString == "" {
+			return nil, err
+		}
 
-			procs.dirnames, err = procs.fd.Readdirnames(procsPageSize)
-			if err == io.EOF {
-				return nil, nil
-			}
+		info, err := procs.readProc(pidString)
 
-			if err != nil {
-				return nil, err
-			}
+		// if err != nil, this process was killed before we were able to read /proc data
+		if err == nil {
+			return info, nil
+		}
+	}
+}
 
-			procs.idx = 0
+// NextStat returns short process stat until the list of processes is exhausted.
+//
+// NextStat only reads /proc/<pid>/stat, so it is much cheaper than Next.
+// NextStat returns nil, nil when all processes were processed.
+func (procs *Processes) NextStat() (*ProcessStat, error) {
+	for {
+		pidString, err := procs.nextDirname()
+		if err != nil || pidString == "" {
+			return nil, err
 		}
 
-		info, err := procs.readProc(procs.dirnames[procs.idx])
-		procs.idx++
+		stat, err := procs.readStat(pidString)
 
 		// if err != nil, this process was killed before we were able to read /proc data
 		if err == nil {
-			return info, nil
+			return stat, nil
 		}
 	}
 }
 
+// nextDirname returns the next entry under /proc, or empty string if the list is exhausted.
+func (procs *Processes) nextDirname() (string, error) {
+	if procs.idx >= len(procs.dirnames) {
+		var err error
+
+		procs.dirnames, err = procs.fd.Readdirnames(procsPageSize)
+		if err == io.EOF {
+			return "", nil
+		}
+
+		if err != nil {
+			return "", err
+		}
+
+		procs.idx = 0
+	}
+
+	dirname := procs.dirnames[procs.idx]
+	procs.idx++
+
+	return dirname, nil
+}
+
+func (procs *Processes) readStat(pidString string) (*ProcessStat, error) {
+	pid, err := strconv.ParseInt(pidString, 10, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = procs.readFileIntoBuf(procs.RootPath + "/" + pidString + "/stat"); err != nil {
+		return nil, err
+	}
+
+	lbracket := bytes.IndexByte(procs.buf, '(')
+	rbracket := bytes.LastIndexByte(procs.buf, ')')
+
+	if lbracket == -1 || rbracket < lbracket {
+		return nil, errors.New("unexpected format")
+	}
+
+	fields := bytes.Fields(procs.buf[rbracket+1:])
+	if len(fields) < 2 {
+		return nil, errors.New("unexpected format")
+	}
+
+	ppid, err := strconv.ParseInt(string(fields[1]), 10, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProcessStat{
+		PID:     int32(pid),
+		PPID:    int32(ppid),
+		State:   string(fields[0]),
+		Command: string(procs.buf[lbracket+1 : rbracket]),
+	}, nil
+}
+
 //nolint:gocyclo
 func (procs *Processes) readProc(pidString string) (*machine.ProcessInfo, error) {
 	pid, err := strconv.ParseInt(pidString, 10, 32)
@@ -103,7 +181,10 @@ func (procs *Processes) readProc(pidString string) (*machine.ProcessInfo, error)
 
 	executable, err := os.Readlink(path + "exe")
 	if err != nil {
-		return nil, err
+		// zombie processes don't have an executable, but they are still reported
+		if stat, statErr := procs.readStat(pidString); statErr != nil || stat.State != StateZombie {
+			return nil, err
+		}
 	}
 
 	if err = procs.readFileIntoBuf(path + "comm"); err != nil {
@@ -193,7 +274,7 @@ func (procs *Processes) readFileIntoBuf(path string) error {
 	procs.buf = procs.buf[:cap(procs.buf)]
 
 	n, err := f.Read(procs.buf)
-	if err != nil {
+	if err != nil && !errors.Is(err, io.EOF) {
 		return err
 	}
 
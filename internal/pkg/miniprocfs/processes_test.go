@@ -84,6 +84,60 @@ func TestMock(t *testing.T) {
 	assert.NoError(t, processes.Close())
 }
 
+func TestMockZombie(t *testing.T) {
+	processes, err := miniprocfs.NewProcessesWithPath("testdata/")
+	require.NoError(t, err)
+
+	var zombies []*machine.ProcessInfo
+
+	for {
+		proc, err := processes.Next()
+		require.NoError(t, err)
+
+		if proc == nil {
+			break
+		}
+
+		if proc.State == miniprocfs.StateZombie {
+			zombies = append(zombies, proc)
+		}
+	}
+
+	assert.NoError(t, processes.Close())
+
+	require.Len(t, zombies, 1)
+	assert.EqualValues(t, 3731090, zombies[0].Pid)
+	assert.EqualValues(t, 1920080, zombies[0].Ppid)
+	assert.Equal(t, "sh", zombies[0].Command)
+	assert.Empty(t, zombies[0].Executable)
+}
+
+func TestMockStat(t *testing.T) {
+	processes, err := miniprocfs.NewProcessesWithPath("testdata/")
+	require.NoError(t, err)
+
+	var stats []miniprocfs.ProcessStat
+
+	for {
+		stat, err := processes.NextStat()
+		require.NoError(t, err)
+
+		if stat == nil {
+			break
+		}
+
+		stats = append(stats, *stat)
+	}
+
+	assert.NoError(t, processes.Close())
+
+	assert.ElementsMatch(t, []miniprocfs.ProcessStat{
+		{PID: 1920080, PPID: 1920079, State: "S", Command: "fish"},
+		{PID: 3731034, PPID: 1919986, State: "S", Command: "tail"},
+		{PID: 3731090, PPID: 1920080, State: miniprocfs.StateZombie, Command: "sh"},
+	}, stats)
+}
+
 func BenchmarkPrometheusProcfs(b *testing.B) {
 	b.ReportAllocs()
 
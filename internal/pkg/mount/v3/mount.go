@@ -6,7 +6,9 @@
 package mount
 
 import (
+	"errors"
 	"fmt"
+	"sync"
 
 	"golang.org/x/sys/unix"
 )
@@ -54,3 +56,13 @@ func BindReadonlyFd(dfd int, dst string) error {
 
 	return nil
 }
+
+// RecursiveReadOnlySupported returns true if the running kernel supports recursive read-only bind mounts.
+//
+// Recursive read-only mounts are implemented via mount_setattr(2) which is available since Linux 5.12.
+var RecursiveReadOnlySupported = sync.OnceValue(func() bool {
+	// the call fails with EBADF for an invalid file descriptor if the syscall is implemented
+	err := unix.MountSetattr(-1, "", unix.AT_EMPTY_PATH, &unix.MountAttr{})
+
+	return !errors.Is(err, unix.ENOSYS)
+})
@@ -13,6 +13,8 @@ const (
 	MaxAllowablePoll = 2048 * time.Second
 	// RetryPoll is the interval between retries if the error is not Kiss-o-Death.
 	RetryPoll = time.Second
+	// FallbackRetryPoll is the interval to retry more preferred time servers when synced via a fallback server.
+	FallbackRetryPoll = 15 * time.Minute
 	// AdjustTimeLimit is a maximum time drift to compensate via adjtimex().
 	//
 	// Deltas smaller than AdjustTimeLimit are gradually adjusted (slewed) to approach the network time.
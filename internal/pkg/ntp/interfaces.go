@@ -5,6 +5,7 @@
 package ntp
 
 import (
+	"context"
 	"syscall"
 	"time"
 
@@ -20,6 +21,9 @@ type CurrentTimeFunc func() time.Time
 // QueryFunc provides a function which performs NTP query.
 type QueryFunc func(server string) (*ntp.Response, error)
 
+// NTSQueryFunc provides a function which performs NTS-authenticated NTP query.
+type NTSQueryFunc func(ctx context.Context, server NTSServer) (*ntp.Response, error)
+
 // SetTimeFunc provides a function to set system time.
 type SetTimeFunc func(tv *syscall.Timeval) error
 
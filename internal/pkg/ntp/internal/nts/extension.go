@@ -0,0 +1,251 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nts
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// NTP extension field types, see RFC 8915, section 5.7.
+const (
+	extUniqueIdentifier  = 0x0104
+	extCookie            = 0x0204
+	extCookiePlaceholder = 0x0304
+	extAuthenticator     = 0x0404
+)
+
+const (
+	extHeaderSize        = 4
+	uniqueIdentifierSize = 32
+	nonceSize            = 16
+
+	ntpHeaderSize        = 48
+	ntpStratumOffset     = 1
+	ntpReferenceIDOffset = 12
+	ntpReferenceIDSize   = 4
+
+	// kissCodeNAK is the Kiss-o'-Death code sent by the server when it can't process the cookie.
+	kissCodeNAK = "NTSN"
+
+	// maxCookies is the size of the cookie pool the client tries to maintain.
+	maxCookies = 8
+)
+
+// ErrNAK is returned when the server can't process the cookie (NTS negative-acknowledgment).
+//
+// Client should perform key establishment again.
+var ErrNAK = errors.New("NTS negative-acknowledgment received")
+
+// session keeps the keys and cookies negotiated with NTS-KE.
+type session struct {
+	c2s, s2c *aesSIV
+
+	cookies    [][]byte
+	ntpAddress string
+}
+
+// newExtension builds an extension for the next NTP query, consuming a cookie.
+func (s *session) newExtension() *extension {
+	cookie := s.cookies[0]
+	s.cookies = s.cookies[1:]
+
+	// ask for enough cookies to refill the pool, each request returns one cookie for each cookie and placeholder
+	return &extension{
+		session:      s,
+		cookie:       cookie,
+		placeholders: max(maxCookies-len(s.cookies)-1, 0),
+	}
+}
+
+func (s *session) addCookies(cookies [][]byte) {
+	for _, cookie := range cookies {
+		if len(s.cookies) >= maxCookies {
+			return
+		}
+
+		s.cookies = append(s.cookies, cookie)
+	}
+}
+
+// extension implements ntp.Extension interface for a single NTS-protected NTP query.
+type extension struct {
+	session *session
+
+	cookie       []byte
+	placeholders int
+	uniqueID     []byte
+}
+
+// ProcessQuery implements ntp.Extension interface.
+func (ext *extension) ProcessQuery(buf *bytes.Buffer) error {
+	ext.uniqueID = make([]byte, uniqueIdentifierSize)
+
+	if _, err := rand.Read(ext.uniqueID); err != nil {
+		return err
+	}
+
+	writeExtensionField(buf, extUniqueIdentifier, ext.uniqueID)
+	writeExtensionField(buf, extCookie, ext.cookie)
+
+	for range ext.placeholders {
+		writeExtensionField(buf, extCookiePlaceholder, make([]byte, len(ext.cookie)))
+	}
+
+	nonce := make([]byte, nonceSize)
+
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+
+	// the packet up to the authenticator is the associated data, there's no plaintext to encrypt
+	ciphertext := ext.session.c2s.Seal(nil, nonce, nil, buf.Bytes())
+
+	body := make([]byte, 0, 4+len(nonce)+len(ciphertext))
+	body = binary.BigEndian.AppendUint16(body, uint16(len(nonce)))
+	body = binary.BigEndian.AppendUint16(body, uint16(len(ciphertext)))
+	body = append(body, nonce...)
+	body = append(body, ciphertext...)
+
+	writeExtensionField(buf, extAuthenticator, body)
+
+	return nil
+}
+
+// ProcessResponse implements ntp.Extension interface.
+//
+//nolint:gocyclo
+func (ext *extension) ProcessResponse(buf []byte) error {
+	if len(buf) < ntpHeaderSize {
+		return errors.New("NTP response is too short")
+	}
+
+	if buf[ntpStratumOffset] == 0 && string(buf[ntpReferenceIDOffset:ntpReferenceIDOffset+ntpReferenceIDSize]) == kissCodeNAK {
+		return ErrNAK
+	}
+
+	var uniqueIDFound bool
+
+	for offset := ntpHeaderSize; offset < len(buf); {
+		typ, body, next, err := readExtensionField(buf, offset)
+		if err != nil {
+			return err
+		}
+
+		switch typ {
+		case extUniqueIdentifier:
+			if !bytes.Equal(body, ext.uniqueID) {
+				return errors.New("NTS unique identifier mismatch")
+			}
+
+			uniqueIDFound = true
+		case extAuthenticator:
+			if !uniqueIDFound {
+				return errors.New("NTS unique identifier is missing")
+			}
+
+			plaintext, err := ext.openAuthenticator(buf[:offset], body)
+			if err != nil {
+				return err
+			}
+
+			cookies, err := parseCookies(plaintext)
+			if err != nil {
+				return err
+			}
+
+			ext.session.addCookies(cookies)
+
+			// extension fields after the authenticator are not authenticated, so they are ignored
+			return nil
+		}
+
+		offset = next
+	}
+
+	return errors.New("NTP response is not authenticated")
+}
+
+func (ext *extension) openAuthenticator(associatedData, body []byte) ([]byte, error) {
+	if len(body) < 4 {
+		return nil, errors.New("malformed NTS authenticator")
+	}
+
+	nonceLen := int(binary.BigEndian.Uint16(body))
+	ciphertextLen := int(binary.BigEndian.Uint16(body[2:]))
+
+	ciphertextStart := 4 + padded(nonceLen)
+
+	if ciphertextStart+ciphertextLen > len(body) {
+		return nil, errors.New("malformed NTS authenticator")
+	}
+
+	nonce := body[4 : 4+nonceLen]
+	ciphertext := body[ciphertextStart : ciphertextStart+ciphertextLen]
+
+	plaintext, err := ext.session.s2c.Open(nil, nonce, ciphertext, associatedData)
+	if err != nil {
+		return nil, fmt.Errorf("NTS authentication failed: %w", err)
+	}
+
+	return plaintext, nil
+}
+
+// parseCookies extracts new cookies from the decrypted extension fields.
+func parseCookies(buf []byte) ([][]byte, error) {
+	var cookies [][]byte
+
+	for offset := 0; offset < len(buf); {
+		typ, body, next, err := readExtensionField(buf, offset)
+		if err != nil {
+			return nil, err
+		}
+
+		if typ == extCookie {
+			cookies = append(cookies, bytes.Clone(body))
+		}
+
+		offset = next
+	}
+
+	return cookies, nil
+}
+
+func padded(n int) int {
+	return (n + 3) &^ 3
+}
+
+// writeExtensionField writes NTP extension field (RFC 7822), padding the body to a multiple of 4 bytes.
+func writeExtensionField(buf *bytes.Buffer, typ uint16, body []byte) {
+	length := extHeaderSize + padded(len(body))
+
+	var header [extHeaderSize]byte
+
+	binary.BigEndian.PutUint16(header[:], typ)
+	binary.BigEndian.PutUint16(header[2:], uint16(length))
+
+	buf.Write(header[:])
+	buf.Write(body)
+	buf.Write(make([]byte, padded(len(body))-len(body)))
+}
+
+// readExtensionField reads NTP extension field at the offset, returning the offset of the next field.
+func readExtensionField(buf []byte, offset int) (typ uint16, body []byte, next int, err error) {
+	if offset+extHeaderSize > len(buf) {
+		return 0, nil, 0, errors.New("truncated NTP extension field")
+	}
+
+	typ = binary.BigEndian.Uint16(buf[offset:])
+	length := int(binary.BigEndian.Uint16(buf[offset+2:]))
+
+	if length < extHeaderSize || length%4 != 0 || offset+length > len(buf) {
+		return 0, nil, 0, fmt.Errorf("malformed NTP extension field of type %#04x", typ)
+	}
+
+	return typ, buf[offset+extHeaderSize : offset+length], offset + length, nil
+}
@@ -0,0 +1,281 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nts
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// NTS-KE constants, see RFC 8915, section 4.
+const (
+	// DefaultKEPort is the default NTS-KE server port.
+	DefaultKEPort = 4460
+
+	defaultNTPPort = 123
+
+	alpnNTSKE = "ntske/1"
+
+	exporterLabel = "EXPORTER-network-time-security"
+
+	protocolNTPv4 = 0
+
+	aeadAESSIVCMAC256 = 15
+)
+
+// NTS-KE record types.
+const (
+	recordEndOfMessage  = 0
+	recordNextProtocol  = 1
+	recordError         = 2
+	recordWarning       = 3
+	recordAEADAlgorithm = 4
+	recordNewCookie     = 5
+	recordNTPv4Server   = 6
+	recordNTPv4Port     = 7
+
+	recordCriticalBit = 0x8000
+	recordTypeMask    = 0x7fff
+	recordHeaderSize  = 4
+
+	maxRecordsInResponse = 64
+	maxCookiesInResponse = 16
+)
+
+// keResponse is the parsed NTS-KE server response.
+type keResponse struct {
+	cookies [][]byte
+	server  string
+	port    uint16
+}
+
+// keyExchange performs NTS key establishment with the NTS-KE server.
+func keyExchange(ctx context.Context, server string, tlsConfig *tls.Config) (*session, error) {
+	host, port, err := splitHostPort(server, DefaultKEPort)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := tlsConfig.Clone()
+	cfg.ServerName = host
+	cfg.NextProtos = []string{alpnNTSKE}
+	cfg.MinVersion = tls.VersionTLS13
+
+	dialer := tls.Dialer{
+		Config: cfg,
+	}
+
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(host, strconv.Itoa(port)))
+	if err != nil {
+		return nil, err
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err = conn.SetDeadline(deadline); err != nil {
+			return nil, err
+		}
+	}
+
+	tlsConn := conn.(*tls.Conn) //nolint:forcetypeassert
+
+	if proto := tlsConn.ConnectionState().NegotiatedProtocol; proto != alpnNTSKE {
+		return nil, fmt.Errorf("unexpected ALPN protocol %q", proto)
+	}
+
+	if _, err = tlsConn.Write(keRequest()); err != nil {
+		return nil, fmt.Errorf("error sending NTS-KE request: %w", err)
+	}
+
+	resp, err := readKEResponse(tlsConn)
+	if err != nil {
+		return nil, err
+	}
+
+	state := tlsConn.ConnectionState()
+
+	c2s, s2c, err := exportKeys(&state)
+	if err != nil {
+		return nil, err
+	}
+
+	ntpHost := host
+	if resp.server != "" {
+		ntpHost = resp.server
+	}
+
+	ntpPort := defaultNTPPort
+	if resp.port != 0 {
+		ntpPort = int(resp.port)
+	}
+
+	return &session{
+		c2s:        c2s,
+		s2c:        s2c,
+		cookies:    resp.cookies,
+		ntpAddress: net.JoinHostPort(ntpHost, strconv.Itoa(ntpPort)),
+	}, nil
+}
+
+// splitHostPort splits the server address into host and port, using the default port if not set.
+func splitHostPort(server string, defaultPort int) (string, int, error) {
+	host, portStr, err := net.SplitHostPort(server)
+	if err != nil {
+		// no port specified
+		return strings.Trim(server, "[]"), defaultPort, nil //nolint:nilerr
+	}
+
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid port in %q: %w", server, err)
+	}
+
+	return host, int(port), nil
+}
+
+func appendRecord(buf []byte, critical bool, typ uint16, body []byte) []byte {
+	if critical {
+		typ |= recordCriticalBit
+	}
+
+	buf = binary.BigEndian.AppendUint16(buf, typ)
+	buf = binary.BigEndian.AppendUint16(buf, uint16(len(body)))
+
+	return append(buf, body...)
+}
+
+// keRequest builds the NTS-KE request asking for NTPv4 with AEAD_AES_SIV_CMAC_256.
+func keRequest() []byte {
+	var buf []byte
+
+	buf = appendRecord(buf, true, recordNextProtocol, binary.BigEndian.AppendUint16(nil, protocolNTPv4))
+	buf = appendRecord(buf, false, recordAEADAlgorithm, binary.BigEndian.AppendUint16(nil, aeadAESSIVCMAC256))
+	buf = appendRecord(buf, true, recordEndOfMessage, nil)
+
+	return buf
+}
+
+//nolint:gocyclo,cyclop
+func readKEResponse(r io.Reader) (*keResponse, error) {
+	var (
+		resp                   keResponse
+		protocolOK, aeadOK     bool
+		protocolSeen, aeadSeen bool
+	)
+
+	for range maxRecordsInResponse {
+		var header [recordHeaderSize]byte
+
+		if _, err := io.ReadFull(r, header[:]); err != nil {
+			return nil, fmt.Errorf("error reading NTS-KE response: %w", err)
+		}
+
+		typ := binary.BigEndian.Uint16(header[:])
+		critical := typ&recordCriticalBit != 0
+		typ &= recordTypeMask
+
+		body := make([]byte, binary.BigEndian.Uint16(header[2:]))
+
+		if _, err := io.ReadFull(r, body); err != nil {
+			return nil, fmt.Errorf("error reading NTS-KE response: %w", err)
+		}
+
+		switch typ {
+		case recordEndOfMessage:
+			switch {
+			case !protocolOK:
+				return nil, errors.New("NTS-KE server doesn't support NTPv4")
+			case !aeadOK:
+				return nil, errors.New("NTS-KE server doesn't support AEAD_AES_SIV_CMAC_256")
+			case len(resp.cookies) == 0:
+				return nil, errors.New("NTS-KE server didn't provide any cookies")
+			}
+
+			return &resp, nil
+		case recordNextProtocol:
+			if protocolSeen {
+				return nil, errors.New("duplicate NTS-KE next protocol record")
+			}
+
+			protocolSeen = true
+
+			for i := 0; i+1 < len(body); i += 2 {
+				if binary.BigEndian.Uint16(body[i:]) == protocolNTPv4 {
+					protocolOK = true
+				}
+			}
+		case recordError:
+			if len(body) < 2 {
+				return nil, errors.New("malformed NTS-KE error record")
+			}
+
+			return nil, fmt.Errorf("NTS-KE server returned error code %d", binary.BigEndian.Uint16(body))
+		case recordWarning:
+			// warnings are informational
+		case recordAEADAlgorithm:
+			if aeadSeen {
+				return nil, errors.New("duplicate NTS-KE AEAD algorithm record")
+			}
+
+			aeadSeen = true
+
+			aeadOK = len(body) == 2 && binary.BigEndian.Uint16(body) == aeadAESSIVCMAC256
+		case recordNewCookie:
+			if len(resp.cookies) < maxCookiesInResponse && len(body) > 0 {
+				resp.cookies = append(resp.cookies, body)
+			}
+		case recordNTPv4Server:
+			resp.server = string(body)
+		case recordNTPv4Port:
+			if len(body) != 2 {
+				return nil, errors.New("malformed NTS-KE port record")
+			}
+
+			resp.port = binary.BigEndian.Uint16(body)
+		default:
+			if critical {
+				return nil, fmt.Errorf("unsupported critical NTS-KE record type %d", typ)
+			}
+		}
+	}
+
+	return nil, errors.New("too many records in NTS-KE response")
+}
+
+// exportKeys derives client-to-server and server-to-client keys from the TLS session (RFC 8915, section 5.1).
+func exportKeys(state *tls.ConnectionState) (c2s, s2c *aesSIV, err error) {
+	// context is the protocol ID, the AEAD algorithm ID and the direction (0 for c2s, 1 for s2c)
+	keyContext := func(direction byte) []byte {
+		return []byte{0, protocolNTPv4, 0, aeadAESSIVCMAC256, direction}
+	}
+
+	c2sKey, err := state.ExportKeyingMaterial(exporterLabel, keyContext(0), sivKeySize)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error exporting NTS keys: %w", err)
+	}
+
+	s2cKey, err := state.ExportKeyingMaterial(exporterLabel, keyContext(1), sivKeySize)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error exporting NTS keys: %w", err)
+	}
+
+	if c2s, err = newAESSIV(c2sKey); err != nil {
+		return nil, nil, err
+	}
+
+	if s2c, err = newAESSIV(s2cKey); err != nil {
+		return nil, nil, err
+	}
+
+	return c2s, s2c, nil
+}
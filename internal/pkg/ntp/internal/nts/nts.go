@@ -0,0 +1,89 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package nts implements Network Time Security (RFC 8915) for the NTP client.
+package nts
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/beevik/ntp"
+)
+
+const (
+	// KeyExchangeTimeout is the timeout for the NTS key establishment.
+	KeyExchangeTimeout = 10 * time.Second
+	// QueryTimeout is the timeout for the NTS-protected NTP query.
+	QueryTimeout = 5 * time.Second
+)
+
+// Client queries time via NTP authenticated with NTS.
+//
+// Client performs NTS key establishment on the first query, and repeats it
+// when the cookies are exhausted or the server rejects them.
+//
+// Client is not safe for concurrent use.
+type Client struct {
+	server    string
+	tlsConfig *tls.Config
+
+	session *session
+}
+
+// NewClient creates a new NTS client for the NTS-KE server (host or host:port).
+//
+// If rootCAs is nil, system root CAs are used to verify the NTS-KE server.
+func NewClient(server string, rootCAs *x509.CertPool) *Client {
+	return &Client{
+		server: server,
+		tlsConfig: &tls.Config{
+			RootCAs:    rootCAs,
+			MinVersion: tls.VersionTLS13,
+		},
+	}
+}
+
+// Address returns the address of the NTP server negotiated via NTS-KE.
+func (c *Client) Address() string {
+	if c.session == nil {
+		return ""
+	}
+
+	return c.session.ntpAddress
+}
+
+// Query performs an authenticated NTP query, performing the key establishment if needed.
+func (c *Client) Query(ctx context.Context) (*ntp.Response, error) {
+	if c.session == nil || len(c.session.cookies) == 0 {
+		keCtx, keCancel := context.WithTimeout(ctx, KeyExchangeTimeout)
+		defer keCancel()
+
+		session, err := keyExchange(keCtx, c.server, c.tlsConfig)
+		if err != nil {
+			return nil, fmt.Errorf("NTS key establishment with %q failed: %w", c.server, err)
+		}
+
+		c.session = session
+	}
+
+	resp, err := ntp.QueryWithOptions(c.session.ntpAddress, ntp.QueryOptions{
+		Timeout:    QueryTimeout,
+		Extensions: []ntp.Extension{c.session.newExtension()},
+	})
+	if err != nil {
+		if errors.Is(err, ErrNAK) {
+			// server can't use our cookies anymore, start over with the key establishment
+			c.session = nil
+		}
+
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,318 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nts
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/binary"
+	"io"
+	"math/big"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testSession(t *testing.T, cookies ...[]byte) *session {
+	t.Helper()
+
+	c2s, err := newAESSIV(bytes.Repeat([]byte{1}, sivKeySize))
+	require.NoError(t, err)
+
+	s2c, err := newAESSIV(bytes.Repeat([]byte{2}, sivKeySize))
+	require.NoError(t, err)
+
+	return &session{
+		c2s:        c2s,
+		s2c:        s2c,
+		cookies:    cookies,
+		ntpAddress: "127.0.0.1:123",
+	}
+}
+
+// serverResponse emulates the NTS-protected NTP server response to the request.
+func serverResponse(t *testing.T, s *session, request []byte, newCookies ...[]byte) []byte {
+	t.Helper()
+
+	var (
+		uniqueID []byte
+		cookie   []byte
+	)
+
+	placeholders := 0
+
+	for offset := ntpHeaderSize; offset < len(request); {
+		typ, body, next, err := readExtensionField(request, offset)
+		require.NoError(t, err)
+
+		switch typ {
+		case extUniqueIdentifier:
+			uniqueID = body
+		case extCookie:
+			cookie = body
+		case extCookiePlaceholder:
+			placeholders++
+		case extAuthenticator:
+			nonceLen := int(binary.BigEndian.Uint16(body))
+			ciphertextLen := int(binary.BigEndian.Uint16(body[2:]))
+
+			_, err = s.c2s.Open(nil, body[4:4+nonceLen], body[4+nonceLen:4+nonceLen+ciphertextLen], request[:offset])
+			require.NoError(t, err)
+		}
+
+		offset = next
+	}
+
+	require.NotEmpty(t, uniqueID)
+	require.NotEmpty(t, cookie)
+	require.Len(t, newCookies, placeholders+1)
+
+	var resp, plaintext bytes.Buffer
+
+	resp.Write(request[:ntpHeaderSize])
+	writeExtensionField(&resp, extUniqueIdentifier, uniqueID)
+
+	for _, newCookie := range newCookies {
+		writeExtensionField(&plaintext, extCookie, newCookie)
+	}
+
+	nonce := bytes.Repeat([]byte{3}, nonceSize)
+	ciphertext := s.s2c.Seal(nil, nonce, plaintext.Bytes(), resp.Bytes())
+
+	body := binary.BigEndian.AppendUint16(nil, nonceSize)
+	body = binary.BigEndian.AppendUint16(body, uint16(len(ciphertext)))
+	body = append(body, nonce...)
+	body = append(body, ciphertext...)
+
+	writeExtensionField(&resp, extAuthenticator, body)
+
+	return resp.Bytes()
+}
+
+func TestExtension(t *testing.T) {
+	t.Parallel()
+
+	s := testSession(t, []byte("cookie01"), []byte("cookie02"))
+
+	ext := s.newExtension()
+	assert.Equal(t, []byte("cookie01"), ext.cookie)
+	assert.Equal(t, maxCookies-2, ext.placeholders)
+
+	var request bytes.Buffer
+
+	request.Write(make([]byte, ntpHeaderSize))
+	require.NoError(t, ext.ProcessQuery(&request))
+
+	newCookies := make([][]byte, maxCookies-1)
+	for i := range newCookies {
+		newCookies[i] = []byte("new-cookie-" + strconv.Itoa(i))
+	}
+
+	resp := serverResponse(t, s, request.Bytes(), newCookies...)
+
+	require.NoError(t, ext.ProcessResponse(resp))
+	assert.Len(t, s.cookies, maxCookies)
+	assert.Equal(t, []byte("cookie02"), s.cookies[0])
+	assert.Equal(t, newCookies[0], s.cookies[1])
+
+	// tampered response is rejected
+	tampered := bytes.Clone(resp)
+	tampered[40] ^= 1
+
+	require.ErrorContains(t, ext.ProcessResponse(tampered), "NTS authentication failed")
+
+	// response to another request is rejected
+	otherExt := s.newExtension()
+
+	var otherRequest bytes.Buffer
+
+	otherRequest.Write(make([]byte, ntpHeaderSize))
+	require.NoError(t, otherExt.ProcessQuery(&otherRequest))
+
+	require.ErrorContains(t, otherExt.ProcessResponse(resp), "NTS unique identifier mismatch")
+
+	// unauthenticated response is rejected
+	require.ErrorContains(t, otherExt.ProcessResponse(make([]byte, ntpHeaderSize)), "NTP response is not authenticated")
+
+	// NTS NAK
+	nak := make([]byte, ntpHeaderSize)
+	copy(nak[ntpReferenceIDOffset:], kissCodeNAK)
+
+	require.ErrorIs(t, otherExt.ProcessResponse(nak), ErrNAK)
+}
+
+func testCertificate(t *testing.T) (tls.Certificate, *x509.CertPool) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "nts.example.com"},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+
+	return tls.Certificate{
+		Certificate: [][]byte{der},
+		PrivateKey:  key,
+	}, pool
+}
+
+func TestKeyExchange(t *testing.T) {
+	t.Parallel()
+
+	cert, pool := testCertificate(t)
+
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		NextProtos:   []string{alpnNTSKE},
+		MinVersion:   tls.VersionTLS13,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() { listener.Close() }) //nolint:errcheck
+
+	serverKeys := make(chan [2][]byte, 1)
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+
+		defer conn.Close() //nolint:errcheck
+
+		tlsConn := conn.(*tls.Conn) //nolint:forcetypeassert
+
+		request := make([]byte, len(keRequest()))
+
+		if _, err = io.ReadFull(tlsConn, request); err != nil {
+			return
+		}
+
+		var resp []byte
+
+		resp = appendRecord(resp, true, recordNextProtocol, binary.BigEndian.AppendUint16(nil, protocolNTPv4))
+		resp = appendRecord(resp, true, recordAEADAlgorithm, binary.BigEndian.AppendUint16(nil, aeadAESSIVCMAC256))
+		resp = appendRecord(resp, false, recordNewCookie, []byte("cookie01"))
+		resp = appendRecord(resp, false, recordNewCookie, []byte("cookie02"))
+		resp = appendRecord(resp, true, recordNTPv4Server, []byte("ntp.example.com"))
+		resp = appendRecord(resp, true, recordNTPv4Port, binary.BigEndian.AppendUint16(nil, 1123))
+		resp = appendRecord(resp, true, recordEndOfMessage, nil)
+
+		if _, err = tlsConn.Write(resp); err != nil {
+			return
+		}
+
+		state := tlsConn.ConnectionState()
+
+		c2sKey, _ := state.ExportKeyingMaterial(exporterLabel, []byte{0, 0, 0, 15, 0}, sivKeySize) //nolint:errcheck
+		s2cKey, _ := state.ExportKeyingMaterial(exporterLabel, []byte{0, 0, 0, 15, 1}, sivKeySize) //nolint:errcheck
+
+		serverKeys <- [2][]byte{c2sKey, s2cKey}
+	}()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	s, err := keyExchange(ctx, listener.Addr().String(), &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS13})
+	require.NoError(t, err)
+
+	assert.Equal(t, [][]byte{[]byte("cookie01"), []byte("cookie02")}, s.cookies)
+	assert.Equal(t, "ntp.example.com:1123", s.ntpAddress)
+
+	keys := <-serverKeys
+
+	c2s, err := newAESSIV(keys[0])
+	require.NoError(t, err)
+
+	s2c, err := newAESSIV(keys[1])
+	require.NoError(t, err)
+
+	nonce := bytes.Repeat([]byte{1}, nonceSize)
+
+	_, err = c2s.Open(nil, nonce, s.c2s.Seal(nil, nonce, nil, []byte("request")), []byte("request"))
+	require.NoError(t, err)
+
+	_, err = s.s2c.Open(nil, nonce, s2c.Seal(nil, nonce, nil, []byte("response")), []byte("response"))
+	require.NoError(t, err)
+}
+
+func TestReadKEResponseErrors(t *testing.T) {
+	t.Parallel()
+
+	nextProtocol := appendRecord(nil, true, recordNextProtocol, binary.BigEndian.AppendUint16(nil, protocolNTPv4))
+	aead := appendRecord(nil, true, recordAEADAlgorithm, binary.BigEndian.AppendUint16(nil, aeadAESSIVCMAC256))
+	cookie := appendRecord(nil, false, recordNewCookie, []byte("cookie"))
+	end := appendRecord(nil, true, recordEndOfMessage, nil)
+
+	for _, test := range []struct {
+		name     string
+		response [][]byte
+
+		expectedError string
+	}{
+		{
+			name:          "server error",
+			response:      [][]byte{appendRecord(nil, true, recordError, binary.BigEndian.AppendUint16(nil, 1)), end},
+			expectedError: "NTS-KE server returned error code 1",
+		},
+		{
+			name:          "no aead",
+			response:      [][]byte{nextProtocol, cookie, end},
+			expectedError: "NTS-KE server doesn't support AEAD_AES_SIV_CMAC_256",
+		},
+		{
+			name:          "no cookies",
+			response:      [][]byte{nextProtocol, aead, end},
+			expectedError: "NTS-KE server didn't provide any cookies",
+		},
+		{
+			name:          "unknown critical record",
+			response:      [][]byte{nextProtocol, aead, appendRecord(nil, true, 100, nil), cookie, end},
+			expectedError: "unsupported critical NTS-KE record type 100",
+		},
+		{
+			name:          "truncated",
+			response:      [][]byte{nextProtocol, aead, cookie},
+			expectedError: "error reading NTS-KE response: EOF",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := readKEResponse(bytes.NewReader(bytes.Join(test.response, nil)))
+			assert.EqualError(t, err, test.expectedError)
+		})
+	}
+}
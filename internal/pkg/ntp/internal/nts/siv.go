@@ -0,0 +1,182 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nts
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+)
+
+// sivKeySize is the key size of AEAD_AES_SIV_CMAC_256.
+const sivKeySize = 32
+
+type block = [aes.BlockSize]byte
+
+// aesSIV implements AEAD_AES_SIV_CMAC_256 (RFC 5297), the mandatory AEAD algorithm for NTS.
+//
+// The first half of the key is used for S2V (CMAC), the second half is used for CTR encryption.
+type aesSIV struct {
+	mac cipher.Block
+	ctr cipher.Block
+
+	// CMAC subkeys
+	k1, k2 block
+}
+
+func newAESSIV(key []byte) (*aesSIV, error) {
+	if len(key) != sivKeySize {
+		return nil, fmt.Errorf("invalid AES-SIV key size %d", len(key))
+	}
+
+	mac, err := aes.NewCipher(key[:sivKeySize/2])
+	if err != nil {
+		return nil, err
+	}
+
+	ctr, err := aes.NewCipher(key[sivKeySize/2:])
+	if err != nil {
+		return nil, err
+	}
+
+	s := &aesSIV{
+		mac: mac,
+		ctr: ctr,
+	}
+
+	var l block
+
+	mac.Encrypt(l[:], l[:])
+
+	s.k1 = dbl(l)
+	s.k2 = dbl(s.k1)
+
+	return s, nil
+}
+
+// Seal encrypts and authenticates plaintext with the associated data and nonce, appending the result to dst.
+func (s *aesSIV) Seal(dst, nonce, plaintext, additionalData []byte) []byte {
+	return s.seal(dst, plaintext, additionalData, nonce)
+}
+
+// Open authenticates and decrypts ciphertext with the associated data and nonce, appending the result to dst.
+func (s *aesSIV) Open(dst, nonce, ciphertext, additionalData []byte) ([]byte, error) {
+	return s.open(dst, ciphertext, additionalData, nonce)
+}
+
+func (s *aesSIV) seal(dst, plaintext []byte, headers ...[]byte) []byte {
+	v := s.s2v(plaintext, headers...)
+
+	dst = append(dst, v[:]...)
+
+	out := make([]byte, len(plaintext))
+	s.xorKeyStream(out, plaintext, v)
+
+	return append(dst, out...)
+}
+
+func (s *aesSIV) open(dst, ciphertext []byte, headers ...[]byte) ([]byte, error) {
+	if len(ciphertext) < aes.BlockSize {
+		return nil, errors.New("ciphertext too short")
+	}
+
+	var v block
+
+	copy(v[:], ciphertext)
+
+	plaintext := make([]byte, len(ciphertext)-aes.BlockSize)
+	s.xorKeyStream(plaintext, ciphertext[aes.BlockSize:], v)
+
+	expected := s.s2v(plaintext, headers...)
+
+	if subtle.ConstantTimeCompare(v[:], expected[:]) != 1 {
+		return nil, errors.New("message authentication failed")
+	}
+
+	return append(dst, plaintext...), nil
+}
+
+// s2v implements the S2V construction (RFC 5297, section 2.4).
+func (s *aesSIV) s2v(plaintext []byte, headers ...[]byte) block {
+	var zero block
+
+	d := s.cmac(zero[:])
+
+	for _, header := range headers {
+		d = dbl(d)
+		mac := s.cmac(header)
+		subtle.XORBytes(d[:], d[:], mac[:])
+	}
+
+	var t []byte
+
+	if len(plaintext) >= aes.BlockSize {
+		t = append([]byte(nil), plaintext...)
+
+		tail := t[len(t)-aes.BlockSize:]
+		subtle.XORBytes(tail, tail, d[:])
+	} else {
+		d = dbl(d)
+
+		t = make([]byte, aes.BlockSize)
+		copy(t, plaintext)
+		t[len(plaintext)] = 0x80
+
+		subtle.XORBytes(t, t, d[:])
+	}
+
+	return s.cmac(t)
+}
+
+// cmac implements AES-CMAC (RFC 4493).
+func (s *aesSIV) cmac(msg []byte) block {
+	var x, last block
+
+	complete := len(msg) > 0 && len(msg)%aes.BlockSize == 0
+
+	for len(msg) > aes.BlockSize {
+		subtle.XORBytes(x[:], x[:], msg[:aes.BlockSize])
+		s.mac.Encrypt(x[:], x[:])
+
+		msg = msg[aes.BlockSize:]
+	}
+
+	if complete {
+		subtle.XORBytes(last[:], msg, s.k1[:])
+	} else {
+		copy(last[:], msg)
+		last[len(msg)] = 0x80
+
+		subtle.XORBytes(last[:], last[:], s.k2[:])
+	}
+
+	subtle.XORBytes(x[:], x[:], last[:])
+	s.mac.Encrypt(x[:], x[:])
+
+	return x
+}
+
+func (s *aesSIV) xorKeyStream(dst, src []byte, v block) {
+	// clear the 31st and 63rd bits (counting from the right) of the counter, see RFC 5297, section 2.5
+	v[8] &= 0x7f
+	v[12] &= 0x7f
+
+	cipher.NewCTR(s.ctr, v[:]).XORKeyStream(dst, src)
+}
+
+// dbl multiplies the block by x in GF(2^128).
+func dbl(b block) block {
+	var r block
+
+	for i := range len(b) - 1 {
+		r[i] = b[i]<<1 | b[i+1]>>7
+	}
+
+	r[len(b)-1] = b[len(b)-1]<<1 ^ 0x87*(b[0]>>7)
+
+	return r
+}
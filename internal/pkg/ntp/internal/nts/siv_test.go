@@ -0,0 +1,72 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nts
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	t.Helper()
+
+	b, err := hex.DecodeString(s)
+	require.NoError(t, err)
+
+	return b
+}
+
+func TestAESSIVDeterministic(t *testing.T) {
+	t.Parallel()
+
+	// RFC 5297, appendix A.1
+	s, err := newAESSIV(mustDecodeHex(t, "fffefdfcfbfaf9f8f7f6f5f4f3f2f1f0f0f1f2f3f4f5f6f7f8f9fafbfcfdfeff"))
+	require.NoError(t, err)
+
+	ad := mustDecodeHex(t, "101112131415161718191a1b1c1d1e1f2021222324252627")
+	plaintext := mustDecodeHex(t, "112233445566778899aabbccddee")
+
+	ciphertext := s.seal(nil, plaintext, ad)
+	assert.Equal(t, "85632d07c6e8f37f950acd320a2ecc9340c02b9690c4dc04daef7f6afe5c", hex.EncodeToString(ciphertext))
+
+	decrypted, err := s.open(nil, ciphertext, ad)
+	require.NoError(t, err)
+	assert.Equal(t, plaintext, decrypted)
+}
+
+func TestAESSIVSealOpen(t *testing.T) {
+	t.Parallel()
+
+	s, err := newAESSIV(make([]byte, sivKeySize))
+	require.NoError(t, err)
+
+	nonce := []byte("0123456789abcdef")
+	ad := []byte("associated data")
+
+	for _, plaintext := range [][]byte{
+		nil,
+		[]byte("short"),
+		[]byte("exactly 16 bytes"),
+		[]byte("a longer plaintext which spans multiple AES blocks"),
+	} {
+		ciphertext := s.Seal(nil, nonce, plaintext, ad)
+		assert.Len(t, ciphertext, len(plaintext)+16)
+
+		decrypted, err := s.Open(nil, nonce, ciphertext, ad)
+		require.NoError(t, err)
+		assert.Equal(t, string(plaintext), string(decrypted))
+
+		_, err = s.Open(nil, nonce, ciphertext, []byte("other data"))
+		assert.Error(t, err)
+
+		ciphertext[len(ciphertext)-1] ^= 1
+
+		_, err = s.Open(nil, nonce, ciphertext, ad)
+		assert.Error(t, err)
+	}
+}
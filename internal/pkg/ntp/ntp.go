@@ -8,7 +8,10 @@ package ntp
 import (
 	"bytes"
 	"context"
+	"crypto/x509"
+	"errors"
 	"fmt"
+	"maps"
 	"math/bits"
 	"net"
 	"os"
@@ -23,6 +26,7 @@ import (
 	"go.uber.org/zap/zapcore"
 	"golang.org/x/sys/unix"
 
+	"github.com/siderolabs/talos/internal/pkg/ntp/internal/nts"
 	"github.com/siderolabs/talos/internal/pkg/ntp/internal/spike"
 	"github.com/siderolabs/talos/internal/pkg/timex"
 )
@@ -34,12 +38,18 @@ type Syncer struct {
 	timeServersMu  sync.Mutex
 	timeServers    []string
 	lastSyncServer string
+	lastSyncSource string
+	fallbackSince  time.Time
+	serverStatuses map[string]*ServerStatus
+	ntsServers     []NTSServer
+	ntsClients     map[string]*nts.Client
 
 	timeSyncNotified bool
 	timeSynced       chan struct{}
 
-	restartSyncCh chan struct{}
-	epochChangeCh chan struct{}
+	restartSyncCh  chan struct{}
+	epochChangeCh  chan struct{}
+	statusChangeCh chan struct{}
 
 	firstSync bool
 
@@ -47,9 +57,13 @@ type Syncer struct {
 
 	MinPoll, MaxPoll, RetryPoll time.Duration
 
+	// FallbackRetryPoll is the interval to retry preferred time servers when synced via a fallback server.
+	FallbackRetryPoll time.Duration
+
 	// these functions are overridden in tests for mocking support
 	CurrentTime CurrentTimeFunc
 	NTPQuery    QueryFunc
+	NTSQuery    NTSQueryFunc
 	AdjustTime  AdjustTimeFunc
 	DisableRTC  bool
 }
@@ -57,8 +71,46 @@ type Syncer struct {
 // Measurement is a struct containing correction data based on a time request.
 type Measurement struct {
 	ClockOffset time.Duration
+	RTT         time.Duration
 	Leap        ntp.LeapIndicator
+	Stratum     uint8
 	Spike       bool
+
+	// Authenticated is set if the measurement was authenticated with NTS.
+	Authenticated bool
+}
+
+// NTSServer configures Network Time Security (NTS) for a time server.
+type NTSServer struct {
+	// Server is the NTS-KE server (hostname or hostname:port) as it appears in the list of time servers.
+	Server string
+	// CACertificate is the PEM-encoded CA bundle to verify the NTS-KE server, system roots are used if empty.
+	CACertificate string
+}
+
+// ServerStatus describes the state of a configured time server as observed by the Syncer.
+type ServerStatus struct {
+	// Server is the time server as configured (hostname, IP or PTP device).
+	Server string
+	// Address is the last queried address of the server.
+	Address string
+
+	Reachable bool
+	Selected  bool
+
+	// Authenticated is set if the last successful query to the server was authenticated with NTS.
+	Authenticated bool
+
+	Stratum   uint8
+	Offset    time.Duration
+	RTT       time.Duration
+	LastQuery time.Time
+	LastError string
+}
+
+type resolvedServer struct {
+	server  string
+	address string
 }
 
 // NewSyncer creates new Syncer with default configuration.
@@ -66,25 +118,31 @@ func NewSyncer(logger *zap.Logger, timeServers []string) *Syncer {
 	syncer := &Syncer{
 		logger: logger,
 
-		timeServers: slices.Clone(timeServers),
-		timeSynced:  make(chan struct{}),
+		timeServers:    slices.Clone(timeServers),
+		serverStatuses: map[string]*ServerStatus{},
+		ntsClients:     map[string]*nts.Client{},
+		timeSynced:     make(chan struct{}),
 
-		restartSyncCh: make(chan struct{}, 1),
-		epochChangeCh: make(chan struct{}, 1),
+		restartSyncCh:  make(chan struct{}, 1),
+		epochChangeCh:  make(chan struct{}, 1),
+		statusChangeCh: make(chan struct{}, 1),
 
 		firstSync: true,
 
 		spikeDetector: spike.Detector{},
 
-		MinPoll:   MinAllowablePoll,
-		MaxPoll:   MaxAllowablePoll,
-		RetryPoll: RetryPoll,
+		MinPoll:           MinAllowablePoll,
+		MaxPoll:           MaxAllowablePoll,
+		RetryPoll:         RetryPoll,
+		FallbackRetryPoll: FallbackRetryPoll,
 
 		CurrentTime: time.Now,
 		NTPQuery:    ntp.Query,
 		AdjustTime:  timex.Adjtimex,
 	}
 
+	syncer.NTSQuery = syncer.queryNTSClient
+
 	return syncer
 }
 
@@ -98,6 +156,35 @@ func (syncer *Syncer) EpochChange() <-chan struct{} {
 	return syncer.epochChangeCh
 }
 
+// StatusChange returns a channel which receives a value each time the time server statuses change.
+func (syncer *Syncer) StatusChange() <-chan struct{} {
+	return syncer.statusChangeCh
+}
+
+// ServerStatuses returns the status of each configured time server, in the order of preference.
+func (syncer *Syncer) ServerStatuses() []ServerStatus {
+	syncer.timeServersMu.Lock()
+	defer syncer.timeServersMu.Unlock()
+
+	statuses := make([]ServerStatus, 0, len(syncer.timeServers))
+
+	for _, server := range syncer.timeServers {
+		status := ServerStatus{
+			Server: server,
+		}
+
+		if s, ok := syncer.serverStatuses[server]; ok {
+			status = *s
+		}
+
+		status.Selected = server == syncer.lastSyncSource && status.Reachable
+
+		statuses = append(statuses, status)
+	}
+
+	return statuses
+}
+
 func (syncer *Syncer) getTimeServers() []string {
 	syncer.timeServersMu.Lock()
 	defer syncer.timeServersMu.Unlock()
@@ -105,18 +192,76 @@ func (syncer *Syncer) getTimeServers() []string {
 	return syncer.timeServers
 }
 
-func (syncer *Syncer) getLastSyncServer() string {
+func (syncer *Syncer) getLastSyncServer() (address, source string) {
 	syncer.timeServersMu.Lock()
 	defer syncer.timeServersMu.Unlock()
 
-	return syncer.lastSyncServer
+	if syncer.lastSyncServer != "" && !syncer.fallbackSince.IsZero() && time.Since(syncer.fallbackSince) > syncer.FallbackRetryPoll {
+		// synced via a fallback server for a while, start over with the preferred servers
+		return "", ""
+	}
+
+	return syncer.lastSyncServer, syncer.lastSyncSource
 }
 
-func (syncer *Syncer) setLastSyncServer(lastSyncServer string) {
+func (syncer *Syncer) setLastSyncServer(server resolvedServer) {
 	syncer.timeServersMu.Lock()
 	defer syncer.timeServersMu.Unlock()
 
-	syncer.lastSyncServer = lastSyncServer
+	syncer.lastSyncServer = server.address
+	syncer.lastSyncSource = server.server
+
+	if len(syncer.timeServers) > 0 && syncer.timeServers[0] != server.server {
+		syncer.fallbackSince = time.Now()
+	} else {
+		syncer.fallbackSince = time.Time{}
+	}
+
+	syncer.notifyStatusChange()
+}
+
+func (syncer *Syncer) updateServerStatus(server resolvedServer, measurement *Measurement, err error) {
+	syncer.timeServersMu.Lock()
+	defer syncer.timeServersMu.Unlock()
+
+	if !slices.Contains(syncer.timeServers, server.server) {
+		// time servers got changed while the query was in flight
+		return
+	}
+
+	status, ok := syncer.serverStatuses[server.server]
+	if !ok {
+		status = &ServerStatus{
+			Server: server.server,
+		}
+
+		syncer.serverStatuses[server.server] = status
+	}
+
+	status.Address = server.address
+	status.LastQuery = time.Now()
+
+	if err != nil {
+		status.Reachable = false
+		status.Authenticated = false
+		status.LastError = err.Error()
+	} else {
+		status.Reachable = true
+		status.Authenticated = measurement.Authenticated
+		status.LastError = ""
+		status.Stratum = measurement.Stratum
+		status.Offset = measurement.ClockOffset
+		status.RTT = measurement.RTT
+	}
+
+	syncer.notifyStatusChange()
+}
+
+func (syncer *Syncer) notifyStatusChange() {
+	select {
+	case syncer.statusChangeCh <- struct{}{}:
+	default:
+	}
 }
 
 // SetTimeServers sets the list of time servers to use.
@@ -130,10 +275,73 @@ func (syncer *Syncer) SetTimeServers(timeServers []string) {
 
 	syncer.timeServers = slices.Clone(timeServers)
 	syncer.lastSyncServer = ""
+	syncer.lastSyncSource = ""
+	syncer.fallbackSince = time.Time{}
+
+	maps.DeleteFunc(syncer.serverStatuses, func(server string, _ *ServerStatus) bool {
+		return !slices.Contains(timeServers, server)
+	})
+
+	syncer.notifyStatusChange()
+	syncer.restartSync()
+}
+
+// SetNTSServers sets the list of time servers which should be queried with NTS.
+//
+// Each NTS server should also be present in the list of time servers.
+func (syncer *Syncer) SetNTSServers(ntsServers []NTSServer) {
+	syncer.timeServersMu.Lock()
+	defer syncer.timeServersMu.Unlock()
+
+	if slices.Equal(ntsServers, syncer.ntsServers) {
+		return
+	}
+
+	syncer.ntsServers = slices.Clone(ntsServers)
+	syncer.ntsClients = map[string]*nts.Client{}
+	syncer.lastSyncServer = ""
+	syncer.lastSyncSource = ""
+	syncer.fallbackSince = time.Time{}
 
 	syncer.restartSync()
 }
 
+func (syncer *Syncer) getNTSServer(server string) (NTSServer, bool) {
+	syncer.timeServersMu.Lock()
+	defer syncer.timeServersMu.Unlock()
+
+	idx := slices.IndexFunc(syncer.ntsServers, func(ntsServer NTSServer) bool { return ntsServer.Server == server })
+	if idx == -1 {
+		return NTSServer{}, false
+	}
+
+	return syncer.ntsServers[idx], true
+}
+
+func (syncer *Syncer) getNTSClient(server NTSServer) (*nts.Client, error) {
+	syncer.timeServersMu.Lock()
+	defer syncer.timeServersMu.Unlock()
+
+	if client, ok := syncer.ntsClients[server.Server]; ok {
+		return client, nil
+	}
+
+	var rootCAs *x509.CertPool
+
+	if server.CACertificate != "" {
+		rootCAs = x509.NewCertPool()
+
+		if !rootCAs.AppendCertsFromPEM([]byte(server.CACertificate)) {
+			return nil, fmt.Errorf("failed to parse CA certificate for NTS server %q", server.Server)
+		}
+	}
+
+	client := nts.NewClient(server.Server, rootCAs)
+	syncer.ntsClients[server.Server] = client
+
+	return client, nil
+}
+
 func (syncer *Syncer) restartSync() {
 	select {
 	case syncer.restartSyncCh <- struct{}{}:
@@ -246,11 +454,11 @@ func (syncer *Syncer) Run(ctx context.Context) {
 }
 
 func (syncer *Syncer) query(ctx context.Context) (lastSyncServer string, measurement *Measurement, err error) {
-	lastSyncServer = syncer.getLastSyncServer()
+	lastSyncServer, lastSyncSource := syncer.getLastSyncServer()
 	failedServer := ""
 
 	if lastSyncServer != "" {
-		measurement, err = syncer.queryServer(lastSyncServer)
+		measurement, err = syncer.queryServer(ctx, resolvedServer{server: lastSyncSource, address: lastSyncServer})
 		if err != nil {
 			syncer.logger.Error(fmt.Sprintf("time query error with server %q", lastSyncServer), zap.Error(err))
 
@@ -261,15 +469,16 @@ func (syncer *Syncer) query(ctx context.Context) (lastSyncServer string, measure
 	}
 
 	if lastSyncServer == "" {
-		var serverList []string
+		var serverList []resolvedServer
 
+		// servers are tried in the order of preference, first one to respond is used
 		serverList, err = syncer.resolveServers(ctx)
 		if err != nil {
 			return lastSyncServer, measurement, err
 		}
 
 		for _, server := range serverList {
-			if server == failedServer {
+			if server.address == failedServer {
 				// skip server which failed in previous sync to avoid sending requests with short interval
 				continue
 			}
@@ -282,13 +491,13 @@ func (syncer *Syncer) query(ctx context.Context) (lastSyncServer string, measure
 			default:
 			}
 
-			measurement, err = syncer.queryServer(server)
+			measurement, err = syncer.queryServer(ctx, server)
 			if err != nil {
-				syncer.logger.Error(fmt.Sprintf("time query error with server %q", server), zap.Error(err))
+				syncer.logger.Error(fmt.Sprintf("time query error with server %q", server.address), zap.Error(err))
 				err = nil
 			} else {
 				syncer.setLastSyncServer(server)
-				lastSyncServer = server
+				lastSyncServer = server.address
 
 				break
 			}
@@ -303,20 +512,25 @@ func IsPTPDevice(server string) bool {
 	return strings.HasPrefix(server, "/dev/")
 }
 
-func (syncer *Syncer) resolveServers(ctx context.Context) ([]string, error) {
-	var serverList []string
+func (syncer *Syncer) resolveServers(ctx context.Context) ([]resolvedServer, error) {
+	var serverList []resolvedServer
 
 	for _, server := range syncer.getTimeServers() {
-		if IsPTPDevice(server) {
-			serverList = append(serverList, server)
+		_, isNTS := syncer.getNTSServer(server)
+
+		if IsPTPDevice(server) || isNTS {
+			// PTP devices are used as is, NTS servers are resolved during the key establishment
+			serverList = append(serverList, resolvedServer{server: server, address: server})
 		} else {
 			ips, err := (&net.Resolver{}).LookupIPAddr(ctx, server)
 			if err != nil {
 				syncer.logger.Error(fmt.Sprintf("failed looking up %q, ignored", server), zap.Error(err))
+
+				syncer.updateServerStatus(resolvedServer{server: server}, nil, err)
 			}
 
 			for _, ip := range ips {
-				serverList = append(serverList, ip.String())
+				serverList = append(serverList, resolvedServer{server: server, address: ip.String()})
 			}
 		}
 
@@ -330,12 +544,21 @@ func (syncer *Syncer) resolveServers(ctx context.Context) ([]string, error) {
 	return serverList, nil
 }
 
-func (syncer *Syncer) queryServer(server string) (*Measurement, error) {
-	if IsPTPDevice(server) {
-		return syncer.queryPTP(server)
+func (syncer *Syncer) queryServer(ctx context.Context, server resolvedServer) (measurement *Measurement, err error) {
+	ntsServer, isNTS := syncer.getNTSServer(server.server)
+
+	switch {
+	case IsPTPDevice(server.address):
+		measurement, err = syncer.queryPTP(server.address)
+	case isNTS:
+		measurement, err = syncer.queryNTS(ctx, ntsServer)
+	default:
+		measurement, err = syncer.queryNTP(server.address)
 	}
 
-	return syncer.queryNTP(server)
+	syncer.updateServerStatus(server, measurement, err)
+
+	return measurement, err
 }
 
 func (syncer *Syncer) queryPTP(device string) (*Measurement, error) {
@@ -396,6 +619,44 @@ func (syncer *Syncer) queryNTP(server string) (*Measurement, error) {
 		return nil, err
 	}
 
+	return syncer.measure(resp)
+}
+
+func (syncer *Syncer) queryNTS(ctx context.Context, server NTSServer) (*Measurement, error) {
+	resp, err := syncer.NTSQuery(ctx, server)
+	if err != nil {
+		return nil, err
+	}
+
+	measurement, err := syncer.measure(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	measurement.Authenticated = true
+
+	return measurement, nil
+}
+
+func (syncer *Syncer) queryNTSClient(ctx context.Context, server NTSServer) (*ntp.Response, error) {
+	client, err := syncer.getNTSClient(server)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Query(ctx)
+	if err != nil {
+		if errors.Is(err, nts.ErrNAK) {
+			syncer.logger.Info("NTS cookies rejected, re-keying", zap.String("server", server.Server))
+		}
+
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (syncer *Syncer) measure(resp *ntp.Response) (*Measurement, error) {
 	syncer.logger.Debug("NTP response",
 		zap.Duration("clock_offset", resp.ClockOffset),
 		zap.Duration("rtt", resp.RTT),
@@ -414,7 +675,9 @@ func (syncer *Syncer) queryNTP(server string) (*Measurement, error) {
 
 	return &Measurement{
 		ClockOffset: resp.ClockOffset,
+		RTT:         resp.RTT,
 		Leap:        resp.Leap,
+		Stratum:     resp.Stratum,
 		Spike:       syncer.isSpike(resp),
 	}, nil
 }
@@ -557,3 +557,179 @@ func (suite *NTPSuite) TestSyncSwitchTimeservers() {
 		suite.Assert().Equal(2*time.Millisecond, suite.clockAdjustments[i])
 	}
 }
+
+func (suite *NTPSuite) TestSyncFallbackToPreferred() {
+	syncer := ntp.NewSyncer(zaptest.NewLogger(suite.T()).With(zap.String("controller", "ntp")), []string{"127.0.0.6", "127.0.0.4"})
+
+	syncer.AdjustTime = suite.adjustSystemClock
+	syncer.CurrentTime = suite.getSystemClock
+	syncer.NTPQuery = suite.fakeQuery
+	syncer.DisableRTC = true
+
+	syncer.MinPoll = time.Second
+	syncer.MaxPoll = time.Second
+	syncer.FallbackRetryPoll = time.Nanosecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		syncer.Run(ctx)
+	}()
+
+	select {
+	case <-syncer.Synced():
+	case <-time.After(10 * time.Second):
+		suite.Assert().Fail("time sync timeout")
+	}
+
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(func() error {
+			suite.clockLock.Lock()
+			defer suite.clockLock.Unlock()
+
+			if len(suite.clockAdjustments) < 4 {
+				return retry.ExpectedErrorf("not enough syncs")
+			}
+
+			return nil
+		}),
+	)
+
+	cancel()
+
+	wg.Wait()
+
+	// should fall back to 127.0.0.4 when 127.0.0.6 fails, and return back to 127.0.0.6 on next poll
+	suite.Assert().Equal(
+		[]time.Duration{time.Millisecond, 2 * time.Millisecond, time.Millisecond, 2 * time.Millisecond},
+		suite.clockAdjustments[:4],
+	)
+}
+
+func (suite *NTPSuite) TestServerStatuses() {
+	syncer := ntp.NewSyncer(zaptest.NewLogger(suite.T()).With(zap.String("controller", "ntp")), []string{"127.0.0.1", "127.0.0.3", "127.0.0.4"})
+
+	syncer.AdjustTime = suite.adjustSystemClock
+	syncer.CurrentTime = suite.getSystemClock
+	syncer.NTPQuery = suite.fakeQuery
+	syncer.DisableRTC = true
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		syncer.Run(ctx)
+	}()
+
+	select {
+	case <-syncer.Synced():
+	case <-time.After(10 * time.Second):
+		suite.Assert().Fail("time sync timeout")
+	}
+
+	select {
+	case <-syncer.StatusChange():
+	case <-time.After(10 * time.Second):
+		suite.Assert().Fail("status change timeout")
+	}
+
+	cancel()
+
+	wg.Wait()
+
+	statuses := syncer.ServerStatuses()
+	suite.Require().Len(statuses, 3)
+
+	suite.Assert().Equal("127.0.0.1", statuses[0].Server)
+	suite.Assert().False(statuses[0].Reachable)
+	suite.Assert().False(statuses[0].Selected)
+	suite.Assert().Equal("no response", statuses[0].LastError)
+
+	suite.Assert().Equal("127.0.0.3", statuses[1].Server)
+	suite.Assert().Equal("127.0.0.3", statuses[1].Address)
+	suite.Assert().True(statuses[1].Reachable)
+	suite.Assert().True(statuses[1].Selected)
+	suite.Assert().EqualValues(1, statuses[1].Stratum)
+	suite.Assert().Equal(time.Millisecond, statuses[1].Offset)
+	suite.Assert().False(statuses[1].LastQuery.IsZero())
+
+	// never queried, as the preferred server responded
+	suite.Assert().Equal("127.0.0.4", statuses[2].Server)
+	suite.Assert().False(statuses[2].Reachable)
+	suite.Assert().True(statuses[2].LastQuery.IsZero())
+
+	syncer.SetTimeServers([]string{"127.0.0.3"})
+
+	statuses = syncer.ServerStatuses()
+	suite.Require().Len(statuses, 1)
+	suite.Assert().False(statuses[0].Selected)
+	suite.Assert().True(statuses[0].Reachable)
+}
+
+func (suite *NTPSuite) TestSyncNTS() {
+	syncer := ntp.NewSyncer(zaptest.NewLogger(suite.T()).With(zap.String("controller", "ntp")), []string{"nts.example.com", "127.0.0.4"})
+	syncer.SetNTSServers([]ntp.NTSServer{{Server: "nts.example.com"}})
+
+	var ntsQueries []string
+
+	syncer.AdjustTime = suite.adjustSystemClock
+	syncer.CurrentTime = suite.getSystemClock
+	syncer.NTPQuery = suite.fakeQuery
+	syncer.NTSQuery = func(_ context.Context, server ntp.NTSServer) (*beevikntp.Response, error) {
+		ntsQueries = append(ntsQueries, server.Server)
+
+		return suite.fakeQuery("127.0.0.3")
+	}
+	syncer.DisableRTC = true
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		syncer.Run(ctx)
+	}()
+
+	select {
+	case <-syncer.Synced():
+	case <-time.After(10 * time.Second):
+		suite.Assert().Fail("time sync timeout")
+	}
+
+	cancel()
+
+	wg.Wait()
+
+	suite.Assert().Equal([]string{"nts.example.com"}, ntsQueries)
+
+	statuses := syncer.ServerStatuses()
+	suite.Require().Len(statuses, 2)
+
+	suite.Assert().Equal("nts.example.com", statuses[0].Server)
+	suite.Assert().True(statuses[0].Reachable)
+	suite.Assert().True(statuses[0].Selected)
+	suite.Assert().True(statuses[0].Authenticated)
+
+	// never queried, as the NTS server responded
+	suite.Assert().Equal("127.0.0.4", statuses[1].Server)
+	suite.Assert().False(statuses[1].Authenticated)
+	suite.Assert().True(statuses[1].LastQuery.IsZero())
+}
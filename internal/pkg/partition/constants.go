@@ -28,3 +28,6 @@ const (
 	FilesystemTypeVFAT   FileSystemType = "vfat"
 	FileSystemTypeExt4   FileSystemType = "ext4"
 )
+
+// MetalConfigPartitionSize is the size of the partition carrying the machine config embedded into the disk image.
+const MetalConfigPartitionSize = 8 * 1024 * 1024
@@ -109,6 +109,12 @@ func systemPartitionsFormatOptions(label string) *FormatOptions {
 			Label:          constants.ImageCachePartitionLabel,
 			FileSystemType: FileSystemTypeExt4,
 		}
+	case constants.MetalConfigISOLabel:
+		return &FormatOptions{
+			Label:          constants.MetalConfigISOLabel,
+			FileSystemType: FilesystemTypeVFAT,
+			Force:          true,
+		}
 	default:
 		return nil
 	}
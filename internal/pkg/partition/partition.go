@@ -98,6 +98,13 @@ func NewPartitionOptions(label string, uki bool, quirk quirks.Quirks) Options {
 			PartitionType:  LinuxFilesystemData,
 			Size:           0,
 		}
+	case constants.MetalConfigISOLabel:
+		return Options{
+			FormatOptions:  *formatOptions,
+			PartitionLabel: label,
+			PartitionType:  LinuxFilesystemData,
+			Size:           MetalConfigPartitionSize,
+		}
 	default:
 		panic(fmt.Sprintf("unknown partition label %q", label))
 	}
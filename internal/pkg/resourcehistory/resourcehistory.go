@@ -0,0 +1,400 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package resourcehistory keeps the recent revisions of the selected resources in memory.
+//
+// The resource types to record and the number of the revisions kept per type are configured
+// with the ResourceHistoryConfig document. The history is bounded per resource type: once the limit is reached,
+// the oldest revision of the type is evicted.
+//
+// Resources holding secrets are recorded redacted: the machine configuration is recorded with the secrets replaced,
+// other sensitive resources are recorded without the spec.
+package resourcehistory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/hexops/gotextdiff"
+	"github.com/hexops/gotextdiff/myers"
+	"github.com/hexops/gotextdiff/span"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+)
+
+// Redacted replaces the secrets in the recorded machine configuration.
+const Redacted = "REDACTED"
+
+var (
+	// ErrNotRecorded is returned when the history of the resource type is not recorded.
+	ErrNotRecorded = errors.New("resource history is not recorded for the type")
+
+	// ErrRevisionNotFound is returned when the revision is not recorded (or already evicted).
+	ErrRevisionNotFound = errors.New("revision not found")
+)
+
+// Revision is a recorded revision of the resource.
+type Revision struct {
+	Timestamp time.Time
+
+	Namespace resource.Namespace
+	ID        resource.ID
+	Version   resource.Version
+
+	// Spec is the resource spec marshaled to YAML.
+	Spec string
+
+	// Number is the revision number, sequential per resource type.
+	Number uint64
+
+	// Event is one of state.Created, state.Updated, state.Destroyed.
+	Event state.EventType
+
+	// Redacted is set if the secrets were removed from the spec.
+	Redacted bool
+}
+
+// Recorder records the revisions of the resources.
+type Recorder struct {
+	state  state.State
+	logger *zap.Logger
+
+	mu    sync.Mutex
+	depth int
+	types map[resource.Type]*typeHistory
+}
+
+type typeHistory struct {
+	rd     meta.ResourceDefinitionSpec
+	cancel context.CancelFunc
+
+	revisions []Revision
+	next      uint64
+}
+
+// NewRecorder creates a new Recorder.
+func NewRecorder(st state.State, logger *zap.Logger) *Recorder {
+	return &Recorder{
+		state:  st,
+		logger: logger,
+		types:  map[resource.Type]*typeHistory{},
+	}
+}
+
+// Run watches the machine configuration and (re)starts recording the configured resource types.
+func (r *Recorder) Run(ctx context.Context) error {
+	watchCh := make(chan state.Event)
+
+	if err := r.state.Watch(
+		ctx,
+		resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.ActiveID, resource.VersionUndefined),
+		watchCh,
+	); err != nil {
+		return fmt.Errorf("error watching machine configuration: %w", err)
+	}
+
+	defer r.reconfigure(ctx, nil) //nolint:errcheck
+
+	for {
+		var historyConfig talosconfig.ResourceHistoryConfig
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case event := <-watchCh:
+			switch event.Type {
+			case state.Created, state.Updated:
+				if cfg, ok := event.Resource.(*config.MachineConfig); ok && cfg.Config() != nil {
+					historyConfig = cfg.Config().ResourceHistoryConfig()
+				}
+			case state.Destroyed:
+				// disable the history
+			case state.Errored:
+				return fmt.Errorf("error watching machine configuration: %w", event.Error)
+			case state.Bootstrapped, state.Noop:
+				continue
+			}
+		}
+
+		if err := r.reconfigure(ctx, historyConfig); err != nil {
+			r.logger.Warn("error configuring resource history", zap.Error(err))
+		}
+	}
+}
+
+// reconfigure starts recording the newly configured resource types, and drops the history of the types not configured anymore.
+//
+//nolint:gocyclo
+func (r *Recorder) reconfigure(ctx context.Context, cfg talosconfig.ResourceHistoryConfig) error {
+	var errs error
+
+	desired := map[resource.Type]meta.ResourceDefinitionSpec{}
+
+	if cfg != nil {
+		rds, err := safe.StateListAll[*meta.ResourceDefinition](ctx, r.state)
+		if err != nil {
+			return fmt.Errorf("error listing resource definitions: %w", err)
+		}
+
+		for _, resourceType := range cfg.Resources() {
+			rd, err := resolve(rds, resourceType)
+			if err != nil {
+				errs = errors.Join(errs, err)
+
+				continue
+			}
+
+			desired[rd.Type] = rd
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if cfg != nil {
+		r.depth = cfg.Depth()
+	}
+
+	for resourceType, history := range r.types {
+		if _, ok := desired[resourceType]; !ok {
+			history.cancel()
+
+			delete(r.types, resourceType)
+		}
+	}
+
+	for resourceType, rd := range desired {
+		if history, ok := r.types[resourceType]; ok {
+			history.trim(r.depth)
+
+			continue
+		}
+
+		watchCtx, cancel := context.WithCancel(ctx)
+		eventCh := make(chan state.Event)
+
+		if err := r.state.WatchKind(watchCtx, resource.NewMetadata(rd.DefaultNamespace, rd.Type, "", resource.VersionUndefined), eventCh, state.WithBootstrapContents(true)); err != nil {
+			cancel()
+
+			errs = errors.Join(errs, fmt.Errorf("error watching %s: %w", rd.Type, err))
+
+			continue
+		}
+
+		history := &typeHistory{
+			rd:     rd,
+			cancel: cancel,
+		}
+
+		r.types[resourceType] = history
+
+		go r.watch(watchCtx, history, eventCh)
+	}
+
+	return errs
+}
+
+// resolve finds the resource definition by the type or alias.
+func resolve(rds safe.List[*meta.ResourceDefinition], resourceType string) (meta.ResourceDefinitionSpec, error) {
+	for rd := range rds.All() {
+		spec := rd.TypedSpec()
+
+		if strings.EqualFold(rd.Metadata().ID(), resourceType) || slices.ContainsFunc(spec.AllAliases, func(alias resource.Type) bool {
+			return strings.EqualFold(alias, resourceType)
+		}) {
+			return *spec, nil
+		}
+	}
+
+	return meta.ResourceDefinitionSpec{}, fmt.Errorf("resource %q is not registered", resourceType)
+}
+
+func (r *Recorder) watch(ctx context.Context, history *typeHistory, eventCh <-chan state.Event) {
+	for {
+		var event state.Event
+
+		select {
+		case <-ctx.Done():
+			return
+		case event = <-eventCh:
+		}
+
+		switch event.Type {
+		case state.Created, state.Updated, state.Destroyed:
+			revision, err := newRevision(history.rd, event)
+			if err != nil {
+				r.logger.Warn("error recording resource revision", zap.String("type", history.rd.Type), zap.Error(err))
+
+				continue
+			}
+
+			r.mu.Lock()
+			history.add(revision, r.depth)
+			r.mu.Unlock()
+		case state.Errored:
+			r.logger.Warn("error watching resources", zap.String("type", history.rd.Type), zap.Error(event.Error))
+
+			return
+		case state.Bootstrapped, state.Noop:
+		}
+	}
+}
+
+func newRevision(rd meta.ResourceDefinitionSpec, event state.Event) (Revision, error) {
+	md := event.Resource.Metadata()
+
+	revision := Revision{
+		Timestamp: time.Now(),
+		Namespace: md.Namespace(),
+		ID:        md.ID(),
+		Version:   md.Version(),
+		Event:     event.Type,
+	}
+
+	if event.Type == state.Destroyed {
+		return revision, nil
+	}
+
+	switch {
+	case rd.Type == config.MachineConfigType:
+		cfg, ok := event.Resource.(*config.MachineConfig)
+		if !ok {
+			return revision, fmt.Errorf("unexpected resource %T", event.Resource)
+		}
+
+		spec, err := cfg.Provider().RedactSecrets(Redacted).EncodeString(encoder.WithComments(encoder.CommentsDisabled))
+		if err != nil {
+			return revision, err
+		}
+
+		revision.Spec = spec
+		revision.Redacted = true
+	case rd.Sensitivity == meta.Sensitive:
+		revision.Redacted = true
+	default:
+		spec, err := yaml.Marshal(event.Resource.Spec())
+		if err != nil {
+			return revision, err
+		}
+
+		revision.Spec = string(spec)
+	}
+
+	return revision, nil
+}
+
+// add appends the revision to the history, unless the spec of the resource is not changed.
+func (h *typeHistory) add(revision Revision, depth int) {
+	if revision.Event == state.Updated {
+		for _, previous := range slices.Backward(h.revisions) {
+			if previous.Namespace != revision.Namespace || previous.ID != revision.ID {
+				continue
+			}
+
+			// the spec of the sensitive resources is not recorded, so the changes can't be detected
+			if previous.Event != state.Destroyed && revision.Spec != "" && previous.Spec == revision.Spec {
+				return
+			}
+
+			break
+		}
+	}
+
+	h.next++
+	revision.Number = h.next
+
+	h.revisions = append(h.revisions, revision)
+	h.trim(depth)
+}
+
+func (h *typeHistory) trim(depth int) {
+	if len(h.revisions) > depth {
+		h.revisions = slices.Delete(h.revisions, 0, len(h.revisions)-depth)
+	}
+}
+
+func (r *Recorder) lookup(resourceType resource.Type) (*typeHistory, error) {
+	for recordedType, history := range r.types {
+		if strings.EqualFold(recordedType, resourceType) {
+			return history, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %q", ErrNotRecorded, resourceType)
+}
+
+// Revisions returns the recorded revisions of the resource type, oldest first.
+//
+// If the id is not empty, only the revisions of the resource with the ID are returned.
+func (r *Recorder) Revisions(resourceType resource.Type, id resource.ID) ([]Revision, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	history, err := r.lookup(resourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := make([]Revision, 0, len(history.revisions))
+
+	for _, revision := range history.revisions {
+		if id == "" || revision.ID == id {
+			revisions = append(revisions, revision)
+		}
+	}
+
+	return revisions, nil
+}
+
+// Diff returns the unified diff of the specs between two recorded revisions of the resource type.
+func (r *Recorder) Diff(resourceType resource.Type, from, to uint64) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	history, err := r.lookup(resourceType)
+	if err != nil {
+		return "", err
+	}
+
+	fromRevision, err := history.get(from)
+	if err != nil {
+		return "", err
+	}
+
+	toRevision, err := history.get(to)
+	if err != nil {
+		return "", err
+	}
+
+	edits := myers.ComputeEdits(span.URIFromPath(fromRevision.ID), fromRevision.Spec, toRevision.Spec)
+
+	return fmt.Sprint(gotextdiff.ToUnified(label(fromRevision), label(toRevision), fromRevision.Spec, edits)), nil
+}
+
+func (h *typeHistory) get(number uint64) (Revision, error) {
+	for _, revision := range h.revisions {
+		if revision.Number == number {
+			return revision, nil
+		}
+	}
+
+	return Revision{}, fmt.Errorf("%w: %d", ErrRevisionNotFound, number)
+}
+
+func label(revision Revision) string {
+	return fmt.Sprintf("%s@%d", revision.ID, revision.Number)
+}
@@ -0,0 +1,164 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package resourcehistory_test
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/cosi-project/runtime/pkg/state/registry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/siderolabs/talos/internal/pkg/resourcehistory"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+func machineConfig(t *testing.T, token string, depth int, resources ...string) *config.MachineConfig {
+	t.Helper()
+
+	historyConfig := runtime.NewResourceHistoryV1Alpha1()
+	historyConfig.DepthConfig = depth
+	historyConfig.ResourcesConfig = resources
+
+	cfg, err := container.New(
+		&v1alpha1.Config{
+			ConfigVersion: "v1alpha1",
+			MachineConfig: &v1alpha1.MachineConfig{
+				MachineToken: token,
+			},
+		},
+		historyConfig,
+	)
+	require.NoError(t, err)
+
+	return config.NewMachineConfig(cfg)
+}
+
+func revisions(t *testing.T, recorder *resourcehistory.Recorder, resourceType resource.Type, id resource.ID, count int) []resourcehistory.Revision {
+	t.Helper()
+
+	var result []resourcehistory.Revision
+
+	require.EventuallyWithT(t, func(collect *assert.CollectT) {
+		var err error
+
+		result, err = recorder.Revisions(resourceType, id)
+		if !assert.NoError(collect, err) {
+			return
+		}
+
+		assert.Len(collect, result, count)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	return result
+}
+
+func TestRecorder(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+	resourceRegistry := registry.NewResourceRegistry(st)
+
+	for _, r := range []meta.ResourceWithRD{
+		&config.MachineConfig{},
+		&network.AddressStatus{},
+		&secrets.OSRoot{},
+	} {
+		require.NoError(t, resourceRegistry.Register(ctx, r))
+	}
+
+	recorder := resourcehistory.NewRecorder(st, zaptest.NewLogger(t))
+
+	go recorder.Run(ctx) //nolint:errcheck
+
+	// history is not enabled
+	_, err := recorder.Revisions(network.AddressStatusType, "")
+	require.ErrorIs(t, err, resourcehistory.ErrNotRecorded)
+
+	cfg := machineConfig(t, "secret-token", 3, "machineconfig", "addresses", secrets.OSRootType)
+	require.NoError(t, st.Create(ctx, cfg))
+
+	// the machine config is recorded redacted
+	mcRevisions := revisions(t, recorder, config.MachineConfigType, config.ActiveID, 1)
+	assert.True(t, mcRevisions[0].Redacted)
+	assert.Equal(t, state.Created, mcRevisions[0].Event)
+	assert.Contains(t, mcRevisions[0].Spec, "token: REDACTED")
+	assert.NotContains(t, mcRevisions[0].Spec, "secret-token")
+
+	address := network.NewAddressStatus(network.NamespaceName, "eth0/10.0.0.1/24")
+	address.TypedSpec().Address = netip.MustParsePrefix("10.0.0.1/24")
+	require.NoError(t, st.Create(ctx, address))
+
+	address.TypedSpec().Address = netip.MustParsePrefix("10.0.0.2/24")
+	require.NoError(t, st.Update(ctx, address))
+
+	// no changes in the spec, not recorded
+	require.NoError(t, st.Update(ctx, address))
+
+	require.NoError(t, st.Destroy(ctx, address.Metadata()))
+
+	addressRevisions := revisions(t, recorder, network.AddressStatusType, "", 3)
+	assert.Equal(t, []uint64{1, 2, 3}, []uint64{addressRevisions[0].Number, addressRevisions[1].Number, addressRevisions[2].Number})
+	assert.Equal(t, []state.EventType{state.Created, state.Updated, state.Destroyed},
+		[]state.EventType{addressRevisions[0].Event, addressRevisions[1].Event, addressRevisions[2].Event})
+	assert.Contains(t, addressRevisions[1].Spec, "address: 10.0.0.2/24")
+	assert.False(t, addressRevisions[1].Redacted)
+
+	diff, err := recorder.Diff(network.AddressStatusType, 1, 2)
+	require.NoError(t, err)
+	assert.Contains(t, diff, "--- eth0/10.0.0.1/24@1\n+++ eth0/10.0.0.1/24@2\n")
+	assert.Contains(t, diff, "-address: 10.0.0.1/24\n+address: 10.0.0.2/24\n")
+
+	// the history is bounded
+	for _, ip := range []string{"10.0.0.3/24", "10.0.0.4/24"} {
+		addr := network.NewAddressStatus(network.NamespaceName, "eth0/"+ip)
+		addr.TypedSpec().Address = netip.MustParsePrefix(ip)
+		require.NoError(t, st.Create(ctx, addr))
+	}
+
+	addressRevisions = revisions(t, recorder, network.AddressStatusType, "", 3)
+	assert.EqualValues(t, 3, addressRevisions[0].Number)
+	assert.EqualValues(t, 5, addressRevisions[2].Number)
+
+	_, err = recorder.Diff(network.AddressStatusType, 1, 5)
+	require.ErrorIs(t, err, resourcehistory.ErrRevisionNotFound)
+
+	revisions(t, recorder, network.AddressStatusType, "eth0/10.0.0.4/24", 1)
+
+	// the spec of the sensitive resources is not recorded
+	osRoot := secrets.NewOSRoot(secrets.OSRootID)
+	require.NoError(t, st.Create(ctx, osRoot))
+
+	osRootRevisions := revisions(t, recorder, secrets.OSRootType, "", 1)
+	assert.True(t, osRootRevisions[0].Redacted)
+	assert.Empty(t, osRootRevisions[0].Spec)
+
+	// the history of the types not configured anymore is dropped
+	newCfg := machineConfig(t, "secret-token", 3, "machineconfig")
+	newCfg.Metadata().SetVersion(cfg.Metadata().Version())
+	require.NoError(t, st.Update(ctx, newCfg))
+
+	require.EventuallyWithT(t, func(collect *assert.CollectT) {
+		_, err := recorder.Revisions(network.AddressStatusType, "")
+		assert.ErrorIs(collect, err, resourcehistory.ErrNotRecorded)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	revisions(t, recorder, config.MachineConfigType, config.ActiveID, 2)
+}
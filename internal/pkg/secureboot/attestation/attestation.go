@@ -0,0 +1,389 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package attestation implements the TPM 2.0 structures of the quote used for the remote attestation.
+//
+// ref: TPM 2.0 Library Specification, Part 2: Structures, sections 10.12 (TPMS_ATTEST),
+// 11.3 (TPMT_SIGNATURE) and 12.2 (TPMT_PUBLIC).
+package attestation
+
+import (
+	"crypto"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// Algorithm is the TPM algorithm ID (TPM_ALG_ID).
+type Algorithm uint16
+
+// Algorithms used by the attestation structures.
+const (
+	AlgorithmRSA    Algorithm = 0x0001
+	AlgorithmSHA1   Algorithm = 0x0004
+	AlgorithmSHA256 Algorithm = 0x000b
+	AlgorithmSHA384 Algorithm = 0x000c
+	AlgorithmSHA512 Algorithm = 0x000d
+	AlgorithmNull   Algorithm = 0x0010
+	AlgorithmRSASSA Algorithm = 0x0014
+	AlgorithmRSAPSS Algorithm = 0x0016
+	AlgorithmECDSA  Algorithm = 0x0018
+	AlgorithmECC    Algorithm = 0x0023
+)
+
+// Hash returns the hash function of the hash algorithm.
+func (alg Algorithm) Hash() (crypto.Hash, error) {
+	switch alg { //nolint:exhaustive
+	case AlgorithmSHA1:
+		return crypto.SHA1, nil
+	case AlgorithmSHA256:
+		return crypto.SHA256, nil
+	case AlgorithmSHA384:
+		return crypto.SHA384, nil
+	case AlgorithmSHA512:
+		return crypto.SHA512, nil
+	default:
+		return 0, fmt.Errorf("unsupported hash algorithm %s", alg)
+	}
+}
+
+// String implements fmt.Stringer interface.
+func (alg Algorithm) String() string {
+	switch alg {
+	case AlgorithmRSA:
+		return "rsa"
+	case AlgorithmSHA1:
+		return "sha1"
+	case AlgorithmSHA256:
+		return "sha256"
+	case AlgorithmSHA384:
+		return "sha384"
+	case AlgorithmSHA512:
+		return "sha512"
+	case AlgorithmNull:
+		return "null"
+	case AlgorithmRSASSA:
+		return "rsassa"
+	case AlgorithmRSAPSS:
+		return "rsapss"
+	case AlgorithmECDSA:
+		return "ecdsa"
+	case AlgorithmECC:
+		return "ecc"
+	default:
+		return fmt.Sprintf("0x%04x", uint16(alg))
+	}
+}
+
+const (
+	// generatedValue is TPM_GENERATED_VALUE, all the structures signed by the TPM start with it.
+	generatedValue uint32 = 0xff544347
+	// tagAttestQuote is TPM_ST_ATTEST_QUOTE.
+	tagAttestQuote uint16 = 0x8018
+
+	// MaxNonceSize is the maximum size of the nonce (the qualifying data of the quote).
+	//
+	// The qualifying data is limited by the size of the largest digest supported by the TPM (sizeof(TPMT_HA)),
+	// so the nonce of the SHA256 digest size is accepted by any TPM.
+	MaxNonceSize = 32
+
+	maxPCRIndex      = 23
+	maxPCRSelections = 16
+	maxPCRSelectSize = 4
+	maxBufferSize    = 1024
+)
+
+// PCRSelection is the selection of the PCRs in a single bank (TPMS_PCR_SELECTION).
+type PCRSelection struct {
+	Hash Algorithm
+	// PCRs in the ascending order.
+	PCRs []int
+}
+
+// ClockInfo is the TPM clock state at the moment of the attestation (TPMS_CLOCK_INFO).
+type ClockInfo struct {
+	// Clock is the time in milliseconds the TPM has been powered.
+	Clock        uint64
+	ResetCount   uint32
+	RestartCount uint32
+	Safe         bool
+}
+
+// Quote is the attestation structure of the quote (TPMS_ATTEST with TPMS_QUOTE_INFO).
+type Quote struct {
+	// QualifiedSigner is the qualified name of the attestation key.
+	QualifiedSigner []byte
+	// ExtraData is the qualifying data (nonce) supplied by the caller.
+	ExtraData       []byte
+	ClockInfo       ClockInfo
+	FirmwareVersion uint64
+	PCRSelections   []PCRSelection
+	// PCRDigest is the digest of the selected PCR values, hashed with the hash algorithm of the signing scheme.
+	PCRDigest []byte
+}
+
+// ParseQuote parses the TPMS_ATTEST structure of the quote.
+func ParseQuote(data []byte) (*Quote, error) {
+	r := reader{data: data}
+
+	magic, tag := r.uint32(), r.uint16()
+	if r.failed {
+		return nil, errors.New("quote is truncated")
+	}
+
+	if magic != generatedValue {
+		return nil, fmt.Errorf("quote was not generated by the TPM: unexpected magic 0x%08x", magic)
+	}
+
+	if tag != tagAttestQuote {
+		return nil, fmt.Errorf("attestation structure is not a quote: unexpected type 0x%04x", tag)
+	}
+
+	var quote Quote
+
+	quote.QualifiedSigner = r.sized()
+	quote.ExtraData = r.sized()
+	quote.ClockInfo = ClockInfo{
+		Clock:        r.uint64(),
+		ResetCount:   r.uint32(),
+		RestartCount: r.uint32(),
+		Safe:         r.uint8() != 0,
+	}
+	quote.FirmwareVersion = r.uint64()
+
+	selections, err := r.pcrSelections()
+	if err != nil {
+		return nil, err
+	}
+
+	quote.PCRSelections = selections
+	quote.PCRDigest = r.sized()
+
+	if r.failed {
+		return nil, errors.New("quote is truncated")
+	}
+
+	if r.remaining() > 0 {
+		return nil, fmt.Errorf("quote has %d trailing bytes", r.remaining())
+	}
+
+	return &quote, nil
+}
+
+// Marshal encodes the quote as the TPMS_ATTEST structure.
+func (q *Quote) Marshal() []byte {
+	var w writer
+
+	w.uint32(generatedValue)
+	w.uint16(tagAttestQuote)
+	w.sized(q.QualifiedSigner)
+	w.sized(q.ExtraData)
+	w.uint64(q.ClockInfo.Clock)
+	w.uint32(q.ClockInfo.ResetCount)
+	w.uint32(q.ClockInfo.RestartCount)
+
+	if q.ClockInfo.Safe {
+		w.uint8(1)
+	} else {
+		w.uint8(0)
+	}
+
+	w.uint64(q.FirmwareVersion)
+	w.pcrSelections(q.PCRSelections)
+	w.sized(q.PCRDigest)
+
+	return w.data
+}
+
+// ComputePCRDigest computes the digest of the PCR values in the order of the selection, as the TPM does for the quote.
+//
+// The values are the PCR values of the selected banks by the hash algorithm.
+func ComputePCRDigest(hash crypto.Hash, selections []PCRSelection, values map[Algorithm]map[int][]byte) ([]byte, error) {
+	h := hash.New()
+
+	for _, selection := range selections {
+		for _, pcr := range selection.PCRs {
+			value, ok := values[selection.Hash][pcr]
+			if !ok {
+				return nil, fmt.Errorf("value of PCR %d in the %s bank is missing", pcr, selection.Hash)
+			}
+
+			h.Write(value)
+		}
+	}
+
+	return h.Sum(nil), nil
+}
+
+// reader reads big-endian values, once a read fails all subsequent reads fail as well.
+type reader struct {
+	data   []byte
+	offset int
+	failed bool
+}
+
+func (r *reader) remaining() int {
+	return len(r.data) - r.offset
+}
+
+func (r *reader) bytes(n int) []byte {
+	if r.failed || n < 0 || r.remaining() < n {
+		r.failed = true
+
+		return nil
+	}
+
+	b := r.data[r.offset : r.offset+n]
+	r.offset += n
+
+	return b
+}
+
+func (r *reader) uint8() uint8 {
+	b := r.bytes(1)
+	if b == nil {
+		return 0
+	}
+
+	return b[0]
+}
+
+func (r *reader) uint16() uint16 {
+	b := r.bytes(2)
+	if b == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint16(b)
+}
+
+func (r *reader) uint32() uint32 {
+	b := r.bytes(4)
+	if b == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint32(b)
+}
+
+func (r *reader) uint64() uint64 {
+	b := r.bytes(8)
+	if b == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(b)
+}
+
+// sized reads the TPM2B structure: the 16-bit size followed by the buffer.
+func (r *reader) sized() []byte {
+	size := int(r.uint16())
+	if size > maxBufferSize {
+		r.failed = true
+	}
+
+	b := r.bytes(size)
+	if len(b) == 0 {
+		return nil
+	}
+
+	return slices.Clone(b)
+}
+
+// pcrSelections reads the TPML_PCR_SELECTION structure.
+func (r *reader) pcrSelections() ([]PCRSelection, error) {
+	count := r.uint32()
+	if r.failed {
+		return nil, errors.New("PCR selection is truncated")
+	}
+
+	if count > maxPCRSelections {
+		return nil, fmt.Errorf("too many PCR selections %d", count)
+	}
+
+	selections := make([]PCRSelection, 0, count)
+
+	for range count {
+		selection := PCRSelection{
+			Hash: Algorithm(r.uint16()),
+		}
+
+		size := int(r.uint8())
+		if size > maxPCRSelectSize {
+			return nil, fmt.Errorf("PCR selection bitmap is too long: %d bytes", size)
+		}
+
+		bitmap := r.bytes(size)
+		if r.failed {
+			return nil, errors.New("PCR selection is truncated")
+		}
+
+		for i, b := range bitmap {
+			for bit := range 8 {
+				if b&(1<<bit) == 0 {
+					continue
+				}
+
+				pcr := i*8 + bit
+				if pcr > maxPCRIndex {
+					return nil, fmt.Errorf("invalid PCR index %d in the selection", pcr)
+				}
+
+				selection.PCRs = append(selection.PCRs, pcr)
+			}
+		}
+
+		selections = append(selections, selection)
+	}
+
+	return selections, nil
+}
+
+// writer writes big-endian values.
+type writer struct {
+	data []byte
+}
+
+func (w *writer) uint8(v uint8) {
+	w.data = append(w.data, v)
+}
+
+func (w *writer) uint16(v uint16) {
+	w.data = binary.BigEndian.AppendUint16(w.data, v)
+}
+
+func (w *writer) uint32(v uint32) {
+	w.data = binary.BigEndian.AppendUint32(w.data, v)
+}
+
+func (w *writer) uint64(v uint64) {
+	w.data = binary.BigEndian.AppendUint64(w.data, v)
+}
+
+func (w *writer) sized(b []byte) {
+	w.uint16(uint16(len(b)))
+	w.data = append(w.data, b...)
+}
+
+// pcrSelections writes the TPML_PCR_SELECTION structure, the bitmaps are at least 3 bytes long as required by the PC Client TPMs.
+func (w *writer) pcrSelections(selections []PCRSelection) {
+	w.uint32(uint32(len(selections)))
+
+	for _, selection := range selections {
+		bitmap := make([]byte, 3)
+
+		for _, pcr := range selection.PCRs {
+			for len(bitmap) <= pcr/8 {
+				bitmap = append(bitmap, 0)
+			}
+
+			bitmap[pcr/8] |= 1 << (pcr % 8)
+		}
+
+		w.uint16(uint16(selection.Hash))
+		w.uint8(uint8(len(bitmap)))
+		w.data = append(w.data, bitmap...)
+	}
+}
@@ -0,0 +1,461 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package attestation_test
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	_ "embed"
+	"encoding/hex"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/secureboot/attestation"
+)
+
+// The quotes are the TPM 2.0 wire format structures over the same nonce and the SHA256 PCRs 0-7 and 11,
+// signed by the restricted signing keys: ECC NIST P-256 with ECDSA/SHA256 and RSA 2048 with RSASSA/SHA256.
+// The qualified signer of each quote is the name of its attestation key.
+var (
+	//go:embed testdata/ecc-ak.bin
+	eccAK []byte
+	//go:embed testdata/ecc-quote.bin
+	eccQuote []byte
+	//go:embed testdata/ecc-signature.bin
+	eccSignature []byte
+
+	//go:embed testdata/rsa-ak.bin
+	rsaAK []byte
+	//go:embed testdata/rsa-quote.bin
+	rsaQuote []byte
+	//go:embed testdata/rsa-signature.bin
+	rsaSignature []byte
+)
+
+const nonceHex = "8f6b0f5d3c3a0e1c2b4f7a9d6e5c4b3a2f1e0d9c8b7a69584736251403f2e1d0"
+
+var pcrValues = map[int]string{
+	0:  "0ed2813f4664f96ecc56e73c63d6dc50267c491a9101813de76fcbf630c94215",
+	1:  "971c5d33a91fdec144fca4181f94b2a78020110f2cfaa1aa17747e51f5a1f14d",
+	2:  "f64260d3b25641b83e9ea7ebacc9930674c84eb1bc33dcac1d70d6cb14f3270b",
+	3:  "36c82080166c6031d1ea0b9d8d619fa9490076c6143a4e9bf3e914569b18fa46",
+	4:  "698097ea707bce128e120f72a64130535a8b53f6c44af7d5801125dec082d1c3",
+	5:  "5b9e8b1cf5afbddf5efd5e912e6460a60ceb3b7b60bd939b6787981b88d7fdfd",
+	6:  "50026d40a742800662704592a989f7f31fc86c3754152f6ab6f241badc2763fa",
+	7:  "8f4c9e1f4b57c4d76a7a16b1b27f214d22a092b08e3823a6caf53876bc9b4c51",
+	11: "4c0c28ffd868afddf8dc9ad92bc018b6afcb013b59aa09fbdad6e2f0f92b7304",
+}
+
+func nonce(t *testing.T) []byte {
+	t.Helper()
+
+	n, err := hex.DecodeString(nonceHex)
+	require.NoError(t, err)
+
+	return n
+}
+
+func pcrs(t *testing.T) map[int][]byte {
+	t.Helper()
+
+	result := make(map[int][]byte, len(pcrValues))
+
+	for pcr, value := range pcrValues {
+		v, err := hex.DecodeString(value)
+		require.NoError(t, err)
+
+		result[pcr] = v
+	}
+
+	return result
+}
+
+func TestParseQuote(t *testing.T) {
+	t.Parallel()
+
+	quote, err := attestation.ParseQuote(eccQuote)
+	require.NoError(t, err)
+
+	assert.Equal(t, nonce(t), quote.ExtraData)
+	assert.Equal(t, attestation.ClockInfo{
+		Clock:      123456789,
+		ResetCount: 7,
+		Safe:       true,
+	}, quote.ClockInfo)
+	assert.EqualValues(t, 0x0002000100000000, quote.FirmwareVersion)
+	assert.Equal(t, []attestation.PCRSelection{
+		{
+			Hash: attestation.AlgorithmSHA256,
+			PCRs: []int{0, 1, 2, 3, 4, 5, 6, 7, 11},
+		},
+	}, quote.PCRSelections)
+	assert.Len(t, quote.PCRDigest, 32)
+
+	ak, err := attestation.ParsePublic(eccAK)
+	require.NoError(t, err)
+
+	name, err := ak.Name()
+	require.NoError(t, err)
+
+	assert.Equal(t, name, quote.QualifiedSigner)
+}
+
+func TestParsePublic(t *testing.T) {
+	t.Parallel()
+
+	const akAttributes = attestation.AttributeFixedTPM | attestation.AttributeFixedParent | attestation.AttributeSensitiveDataOrigin |
+		attestation.AttributeUserWithAuth | attestation.AttributeRestricted | attestation.AttributeSign
+
+	ecc, err := attestation.ParsePublic(eccAK)
+	require.NoError(t, err)
+
+	assert.Equal(t, attestation.AlgorithmECC, ecc.Type)
+	assert.Equal(t, attestation.AlgorithmSHA256, ecc.NameAlg)
+	assert.Equal(t, akAttributes, ecc.Attributes)
+	assert.Equal(t, attestation.AlgorithmECDSA, ecc.Scheme)
+	assert.Equal(t, attestation.AlgorithmSHA256, ecc.SchemeHash)
+	assert.Equal(t, attestation.ECCCurveNISTP256, ecc.Curve)
+
+	eccKey, err := ecc.PublicKey()
+	require.NoError(t, err)
+
+	assert.IsType(t, &ecdsa.PublicKey{}, eccKey)
+
+	rsaPub, err := attestation.ParsePublic(rsaAK)
+	require.NoError(t, err)
+
+	assert.Equal(t, attestation.AlgorithmRSA, rsaPub.Type)
+	assert.Equal(t, akAttributes, rsaPub.Attributes)
+	assert.Equal(t, attestation.AlgorithmRSASSA, rsaPub.Scheme)
+	assert.EqualValues(t, 2048, rsaPub.KeyBits)
+
+	rsaKey, err := rsaPub.PublicKey()
+	require.NoError(t, err)
+
+	require.IsType(t, &rsa.PublicKey{}, rsaKey)
+	assert.Equal(t, 65537, rsaKey.(*rsa.PublicKey).E) //nolint:forcetypeassert
+}
+
+func TestParseSignature(t *testing.T) {
+	t.Parallel()
+
+	ecc, err := attestation.ParseSignature(eccSignature)
+	require.NoError(t, err)
+
+	assert.Equal(t, attestation.AlgorithmECDSA, ecc.Algorithm)
+	assert.Equal(t, attestation.AlgorithmSHA256, ecc.Hash)
+	assert.NotEmpty(t, ecc.R)
+	assert.NotEmpty(t, ecc.S)
+
+	rsaSig, err := attestation.ParseSignature(rsaSignature)
+	require.NoError(t, err)
+
+	assert.Equal(t, attestation.AlgorithmRSASSA, rsaSig.Algorithm)
+	assert.Len(t, rsaSig.RSA, 256)
+
+	_, err = attestation.ParseSignature([]byte{0x00, 0x10})
+	assert.EqualError(t, err, "unsupported signature algorithm null")
+}
+
+func TestMarshal(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name  string
+		data  []byte
+		parse func([]byte) ([]byte, error)
+	}{
+		{
+			name: "ecc quote",
+			data: eccQuote,
+			parse: func(b []byte) ([]byte, error) {
+				q, err := attestation.ParseQuote(b)
+				if err != nil {
+					return nil, err
+				}
+
+				return q.Marshal(), nil
+			},
+		},
+		{
+			name: "rsa quote",
+			data: rsaQuote,
+			parse: func(b []byte) ([]byte, error) {
+				q, err := attestation.ParseQuote(b)
+				if err != nil {
+					return nil, err
+				}
+
+				return q.Marshal(), nil
+			},
+		},
+		{
+			name: "ecc ak",
+			data: eccAK,
+			parse: func(b []byte) ([]byte, error) {
+				pub, err := attestation.ParsePublic(b)
+				if err != nil {
+					return nil, err
+				}
+
+				return pub.Marshal(), nil
+			},
+		},
+		{
+			name: "rsa ak",
+			data: rsaAK,
+			parse: func(b []byte) ([]byte, error) {
+				pub, err := attestation.ParsePublic(b)
+				if err != nil {
+					return nil, err
+				}
+
+				return pub.Marshal(), nil
+			},
+		},
+		{
+			name: "ecc signature",
+			data: eccSignature,
+			parse: func(b []byte) ([]byte, error) {
+				sig, err := attestation.ParseSignature(b)
+				if err != nil {
+					return nil, err
+				}
+
+				return sig.Marshal(), nil
+			},
+		},
+		{
+			name: "rsa signature",
+			data: rsaSignature,
+			parse: func(b []byte) ([]byte, error) {
+				sig, err := attestation.ParseSignature(b)
+				if err != nil {
+					return nil, err
+				}
+
+				return sig.Marshal(), nil
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			marshaled, err := test.parse(test.data)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.data, marshaled)
+
+			// every truncated structure is rejected
+			for size := range len(test.data) {
+				_, err = test.parse(test.data[:size])
+				assert.Error(t, err, "size %d", size)
+			}
+
+			_, err = test.parse(append(bytes.Clone(test.data), 0))
+			assert.ErrorContains(t, err, "1 trailing bytes")
+		})
+	}
+}
+
+func TestMarshalQuote(t *testing.T) {
+	t.Parallel()
+
+	quote := &attestation.Quote{
+		ExtraData: []byte("nonce"),
+		PCRSelections: []attestation.PCRSelection{
+			{
+				Hash: attestation.AlgorithmSHA1,
+				PCRs: []int{7},
+			},
+			{
+				Hash: attestation.AlgorithmSHA256,
+				PCRs: []int{0, 23},
+			},
+		},
+		PCRDigest: bytes.Repeat([]byte{0xaa}, 32),
+	}
+
+	parsed, err := attestation.ParseQuote(quote.Marshal())
+	require.NoError(t, err)
+
+	assert.Equal(t, quote, parsed)
+}
+
+func TestComputePCRDigest(t *testing.T) {
+	t.Parallel()
+
+	quote, err := attestation.ParseQuote(eccQuote)
+	require.NoError(t, err)
+
+	hash, err := attestation.AlgorithmSHA256.Hash()
+	require.NoError(t, err)
+
+	digest, err := attestation.ComputePCRDigest(hash, quote.PCRSelections, map[attestation.Algorithm]map[int][]byte{
+		attestation.AlgorithmSHA256: pcrs(t),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, quote.PCRDigest, digest)
+
+	_, err = attestation.ComputePCRDigest(hash, quote.PCRSelections, map[attestation.Algorithm]map[int][]byte{
+		attestation.AlgorithmSHA1: pcrs(t),
+	})
+	assert.EqualError(t, err, "value of PCR 0 in the sha256 bank is missing")
+}
+
+//nolint:maintidx
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	evidence := func(t *testing.T, ak, quote, signature []byte) *attestation.Evidence {
+		return &attestation.Evidence{
+			Quote:     bytes.Clone(quote),
+			Signature: bytes.Clone(signature),
+			AKPublic:  bytes.Clone(ak),
+			PCRBank:   attestation.AlgorithmSHA256,
+			PCRs:      pcrs(t),
+		}
+	}
+
+	for _, test := range []struct {
+		name   string
+		modify func(*testing.T, *attestation.Evidence) []byte
+
+		expectedError string
+	}{
+		{
+			name: "valid",
+		},
+		{
+			// the digest covers all the quoted PCRs, so the values of all of them are required
+			name: "subset of PCRs",
+			modify: func(t *testing.T, e *attestation.Evidence) []byte {
+				delete(e.PCRs, 11)
+
+				return nonce(t)
+			},
+			expectedError: "value of PCR 11 in the sha256 bank is missing",
+		},
+		{
+			name: "wrong nonce",
+			modify: func(t *testing.T, e *attestation.Evidence) []byte {
+				n := nonce(t)
+				n[0] ^= 0xff
+
+				return n
+			},
+			expectedError: "quote nonce doesn't match",
+		},
+		{
+			name: "tampered PCR value",
+			modify: func(t *testing.T, e *attestation.Evidence) []byte {
+				e.PCRs[7][0] ^= 0xff
+
+				return nonce(t)
+			},
+			expectedError: "PCR values don't match the quoted PCR digest",
+		},
+		{
+			name: "PCR not quoted",
+			modify: func(t *testing.T, e *attestation.Evidence) []byte {
+				e.PCRs[9] = make([]byte, 32)
+
+				return nonce(t)
+			},
+			expectedError: "PCR 9 is not covered by the quote",
+		},
+		{
+			name: "short PCR value",
+			modify: func(t *testing.T, e *attestation.Evidence) []byte {
+				e.PCRs[0] = e.PCRs[0][:20]
+
+				return nonce(t)
+			},
+			expectedError: "PCR 0 value has unexpected size 20",
+		},
+		{
+			name: "wrong bank",
+			modify: func(t *testing.T, e *attestation.Evidence) []byte {
+				e.PCRBank = attestation.AlgorithmSHA1
+
+				return nonce(t)
+			},
+			expectedError: "quote covers the sha256 PCR bank, expected sha1",
+		},
+		{
+			name: "tampered quote",
+			modify: func(t *testing.T, e *attestation.Evidence) []byte {
+				e.Quote[len(e.Quote)-1] ^= 0xff
+
+				return nonce(t)
+			},
+			expectedError: "quote signature verification failed",
+		},
+		{
+			name: "unrestricted key",
+			modify: func(t *testing.T, e *attestation.Evidence) []byte {
+				ak, err := attestation.ParsePublic(e.AKPublic)
+				require.NoError(t, err)
+
+				ak.Attributes &^= attestation.AttributeRestricted
+				e.AKPublic = ak.Marshal()
+
+				return nonce(t)
+			},
+			expectedError: "attestation key is not a restricted signing key bound to the TPM",
+		},
+		{
+			name: "signature by another key",
+			modify: func(t *testing.T, e *attestation.Evidence) []byte {
+				if bytes.Equal(e.AKPublic, eccAK) {
+					e.AKPublic = rsaAK
+				} else {
+					e.AKPublic = eccAK
+				}
+
+				return nonce(t)
+			},
+			expectedError: "signature scheme",
+		},
+	} {
+		for _, key := range []struct {
+			name                 string
+			ak, quote, signature []byte
+		}{
+			{"ecc", eccAK, eccQuote, eccSignature},
+			{"rsa", rsaAK, rsaQuote, rsaSignature},
+		} {
+			t.Run(key.name+"/"+test.name, func(t *testing.T) {
+				t.Parallel()
+
+				e := evidence(t, key.ak, key.quote, key.signature)
+				n := nonce(t)
+
+				if test.modify != nil {
+					n = test.modify(t, e)
+				}
+
+				quote, err := e.Verify(n)
+
+				if test.expectedError != "" {
+					require.ErrorContains(t, err, test.expectedError)
+
+					return
+				}
+
+				require.NoError(t, err)
+
+				assert.Equal(t, n, quote.ExtraData)
+				assert.ElementsMatch(t, quote.PCRSelections[0].PCRs, slices.Collect(maps.Keys(e.PCRs)))
+			})
+		}
+	}
+}
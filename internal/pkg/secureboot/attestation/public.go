@@ -0,0 +1,329 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package attestation
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// ObjectAttributes are the attributes of the TPM object (TPMA_OBJECT).
+type ObjectAttributes uint32
+
+// Object attributes relevant for the attestation keys.
+const (
+	AttributeFixedTPM            ObjectAttributes = 1 << 1
+	AttributeFixedParent         ObjectAttributes = 1 << 4
+	AttributeSensitiveDataOrigin ObjectAttributes = 1 << 5
+	AttributeUserWithAuth        ObjectAttributes = 1 << 6
+	AttributeRestricted          ObjectAttributes = 1 << 16
+	AttributeDecrypt             ObjectAttributes = 1 << 17
+	AttributeSign                ObjectAttributes = 1 << 18
+)
+
+// ECCCurve is the TPM elliptic curve ID (TPM_ECC_CURVE).
+type ECCCurve uint16
+
+// Elliptic curves supported for the attestation keys.
+const (
+	ECCCurveNISTP256 ECCCurve = 0x0003
+	ECCCurveNISTP384 ECCCurve = 0x0004
+	ECCCurveNISTP521 ECCCurve = 0x0005
+)
+
+func (curve ECCCurve) curve() (elliptic.Curve, error) {
+	switch curve {
+	case ECCCurveNISTP256:
+		return elliptic.P256(), nil
+	case ECCCurveNISTP384:
+		return elliptic.P384(), nil
+	case ECCCurveNISTP521:
+		return elliptic.P521(), nil
+	default:
+		return nil, fmt.Errorf("unsupported elliptic curve 0x%04x", uint16(curve))
+	}
+}
+
+// Public is the public area of the attestation key (TPMT_PUBLIC).
+//
+// Only the signing keys are supported: the symmetric algorithm and the key derivation scheme should be null.
+type Public struct {
+	Type       Algorithm
+	NameAlg    Algorithm
+	Attributes ObjectAttributes
+	AuthPolicy []byte
+
+	// Scheme is the signing scheme of the key, it is enforced by the TPM for the restricted keys.
+	Scheme     Algorithm
+	SchemeHash Algorithm
+
+	// RSA keys.
+	KeyBits  uint16
+	Exponent uint32
+	Modulus  []byte
+
+	// ECC keys.
+	Curve ECCCurve
+	X, Y  []byte
+}
+
+// ParsePublic parses the TPMT_PUBLIC structure.
+//
+//nolint:gocyclo,cyclop
+func ParsePublic(data []byte) (*Public, error) {
+	r := reader{data: data}
+
+	pub := Public{
+		Type:       Algorithm(r.uint16()),
+		NameAlg:    Algorithm(r.uint16()),
+		Attributes: ObjectAttributes(r.uint32()),
+		AuthPolicy: r.sized(),
+	}
+
+	if r.failed {
+		return nil, errors.New("public area is truncated")
+	}
+
+	if pub.Type != AlgorithmRSA && pub.Type != AlgorithmECC {
+		return nil, fmt.Errorf("unsupported key type %s", pub.Type)
+	}
+
+	if symmetric := Algorithm(r.uint16()); !r.failed && symmetric != AlgorithmNull {
+		return nil, fmt.Errorf("keys with the symmetric algorithm %s are not supported", symmetric)
+	}
+
+	pub.Scheme = Algorithm(r.uint16())
+	if pub.Scheme != AlgorithmNull {
+		pub.SchemeHash = Algorithm(r.uint16())
+	}
+
+	switch pub.Type { //nolint:exhaustive
+	case AlgorithmRSA:
+		pub.KeyBits = r.uint16()
+		pub.Exponent = r.uint32()
+		pub.Modulus = r.sized()
+	case AlgorithmECC:
+		pub.Curve = ECCCurve(r.uint16())
+
+		if kdf := Algorithm(r.uint16()); !r.failed && kdf != AlgorithmNull {
+			return nil, fmt.Errorf("keys with the key derivation scheme %s are not supported", kdf)
+		}
+
+		pub.X = r.sized()
+		pub.Y = r.sized()
+	}
+
+	if r.failed {
+		return nil, errors.New("public area is truncated")
+	}
+
+	if r.remaining() > 0 {
+		return nil, fmt.Errorf("public area has %d trailing bytes", r.remaining())
+	}
+
+	return &pub, nil
+}
+
+// Marshal encodes the public area as the TPMT_PUBLIC structure.
+func (pub *Public) Marshal() []byte {
+	var w writer
+
+	w.uint16(uint16(pub.Type))
+	w.uint16(uint16(pub.NameAlg))
+	w.uint32(uint32(pub.Attributes))
+	w.sized(pub.AuthPolicy)
+	w.uint16(uint16(AlgorithmNull))
+	w.uint16(uint16(pub.Scheme))
+
+	if pub.Scheme != AlgorithmNull {
+		w.uint16(uint16(pub.SchemeHash))
+	}
+
+	switch pub.Type { //nolint:exhaustive
+	case AlgorithmRSA:
+		w.uint16(pub.KeyBits)
+		w.uint32(pub.Exponent)
+		w.sized(pub.Modulus)
+	case AlgorithmECC:
+		w.uint16(uint16(pub.Curve))
+		w.uint16(uint16(AlgorithmNull))
+		w.sized(pub.X)
+		w.sized(pub.Y)
+	}
+
+	return w.data
+}
+
+// Name returns the TPM name of the key: the name algorithm followed by the digest of the public area.
+func (pub *Public) Name() ([]byte, error) {
+	hash, err := pub.NameAlg.Hash()
+	if err != nil {
+		return nil, err
+	}
+
+	h := hash.New()
+	h.Write(pub.Marshal())
+
+	var w writer
+
+	w.uint16(uint16(pub.NameAlg))
+
+	return h.Sum(w.data), nil
+}
+
+// PublicKey returns the public key.
+func (pub *Public) PublicKey() (crypto.PublicKey, error) {
+	switch pub.Type { //nolint:exhaustive
+	case AlgorithmRSA:
+		exponent := int(pub.Exponent)
+		if exponent == 0 {
+			// zero means the default exponent
+			exponent = 65537
+		}
+
+		if len(pub.Modulus) == 0 || len(pub.Modulus)*8 != int(pub.KeyBits) {
+			return nil, fmt.Errorf("RSA modulus size %d doesn't match the key size %d", len(pub.Modulus)*8, pub.KeyBits)
+		}
+
+		return &rsa.PublicKey{
+			N: new(big.Int).SetBytes(pub.Modulus),
+			E: exponent,
+		}, nil
+	case AlgorithmECC:
+		curve, err := pub.Curve.curve()
+		if err != nil {
+			return nil, err
+		}
+
+		size := (curve.Params().BitSize + 7) / 8
+
+		if len(pub.X) > size || len(pub.Y) > size {
+			return nil, errors.New("ECC point coordinates are too long")
+		}
+
+		// the coordinates might have leading zeroes stripped
+		point := make([]byte, 1+2*size)
+		point[0] = 4
+		copy(point[1+size-len(pub.X):1+size], pub.X)
+		copy(point[1+2*size-len(pub.Y):], pub.Y)
+
+		key, err := ecdsa.ParseUncompressedPublicKey(curve, point)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ECC public key: %w", err)
+		}
+
+		return key, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %s", pub.Type)
+	}
+}
+
+// Signature is the signature of the quote (TPMT_SIGNATURE).
+type Signature struct {
+	Algorithm Algorithm
+	Hash      Algorithm
+
+	// RSA is the RSASSA or RSAPSS signature.
+	RSA []byte
+
+	// R and S are the ECDSA signature.
+	R, S []byte
+}
+
+// ParseSignature parses the TPMT_SIGNATURE structure.
+func ParseSignature(data []byte) (*Signature, error) {
+	r := reader{data: data}
+
+	sig := Signature{
+		Algorithm: Algorithm(r.uint16()),
+	}
+
+	switch sig.Algorithm { //nolint:exhaustive
+	case AlgorithmRSASSA, AlgorithmRSAPSS:
+		sig.Hash = Algorithm(r.uint16())
+		sig.RSA = r.sized()
+	case AlgorithmECDSA:
+		sig.Hash = Algorithm(r.uint16())
+		sig.R = r.sized()
+		sig.S = r.sized()
+	default:
+		if !r.failed {
+			return nil, fmt.Errorf("unsupported signature algorithm %s", sig.Algorithm)
+		}
+	}
+
+	if r.failed {
+		return nil, errors.New("signature is truncated")
+	}
+
+	if r.remaining() > 0 {
+		return nil, fmt.Errorf("signature has %d trailing bytes", r.remaining())
+	}
+
+	return &sig, nil
+}
+
+// Marshal encodes the signature as the TPMT_SIGNATURE structure.
+func (sig *Signature) Marshal() []byte {
+	var w writer
+
+	w.uint16(uint16(sig.Algorithm))
+
+	switch sig.Algorithm { //nolint:exhaustive
+	case AlgorithmRSASSA, AlgorithmRSAPSS:
+		w.uint16(uint16(sig.Hash))
+		w.sized(sig.RSA)
+	case AlgorithmECDSA:
+		w.uint16(uint16(sig.Hash))
+		w.sized(sig.R)
+		w.sized(sig.S)
+	}
+
+	return w.data
+}
+
+// verify verifies the signature of the data with the public key.
+func (sig *Signature) verify(key crypto.PublicKey, data []byte) error {
+	hash, err := sig.Hash.Hash()
+	if err != nil {
+		return err
+	}
+
+	h := hash.New()
+	h.Write(data)
+	digest := h.Sum(nil)
+
+	switch sig.Algorithm { //nolint:exhaustive
+	case AlgorithmRSASSA, AlgorithmRSAPSS:
+		rsaKey, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("%s signature requires an RSA key", sig.Algorithm)
+		}
+
+		if sig.Algorithm == AlgorithmRSASSA {
+			return rsa.VerifyPKCS1v15(rsaKey, hash, digest, sig.RSA)
+		}
+
+		return rsa.VerifyPSS(rsaKey, hash, digest, sig.RSA, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthAuto})
+	case AlgorithmECDSA:
+		ecdsaKey, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("%s signature requires an ECC key", sig.Algorithm)
+		}
+
+		if !ecdsa.Verify(ecdsaKey, digest, new(big.Int).SetBytes(sig.R), new(big.Int).SetBytes(sig.S)) {
+			return errors.New("ECDSA verification failure")
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unsupported signature algorithm %s", sig.Algorithm)
+	}
+}
@@ -0,0 +1,116 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package attestation
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+// Evidence is the quote with the data required to verify it.
+type Evidence struct {
+	// Quote is the TPMS_ATTEST structure.
+	Quote []byte
+	// Signature is the TPMT_SIGNATURE of the quote.
+	Signature []byte
+	// AKPublic is the TPMT_PUBLIC area of the attestation key.
+	AKPublic []byte
+
+	// PCRBank is the hash algorithm of the quoted PCR bank.
+	PCRBank Algorithm
+	// PCRs are the values of the quoted PCRs.
+	PCRs map[int][]byte
+}
+
+// Verify checks that the quote is signed by the attestation key, that it carries the nonce,
+// and that the PCR values match the quoted PCR digest.
+//
+// Verify doesn't establish the trust in the attestation key itself, it is up to the verifier
+// to check that the key belongs to the node (e.g. by comparing it with the enrolled one).
+//
+//nolint:gocyclo,cyclop
+func (e *Evidence) Verify(nonce []byte) (*Quote, error) {
+	ak, err := ParsePublic(e.AKPublic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the attestation key: %w", err)
+	}
+
+	// an unrestricted key can sign any data, including a forged quote
+	if ak.Attributes&(AttributeRestricted|AttributeSign|AttributeFixedTPM) != AttributeRestricted|AttributeSign|AttributeFixedTPM {
+		return nil, errors.New("attestation key is not a restricted signing key bound to the TPM")
+	}
+
+	key, err := ak.PublicKey()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the attestation key: %w", err)
+	}
+
+	sig, err := ParseSignature(e.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the quote signature: %w", err)
+	}
+
+	if ak.Scheme != AlgorithmNull && (sig.Algorithm != ak.Scheme || sig.Hash != ak.SchemeHash) {
+		return nil, fmt.Errorf("signature scheme %s/%s doesn't match the attestation key scheme %s/%s", sig.Algorithm, sig.Hash, ak.Scheme, ak.SchemeHash)
+	}
+
+	if err = sig.verify(key, e.Quote); err != nil {
+		return nil, fmt.Errorf("quote signature verification failed: %w", err)
+	}
+
+	quote, err := ParseQuote(e.Quote)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(quote.ExtraData, nonce) {
+		return nil, errors.New("quote nonce doesn't match")
+	}
+
+	pcrBankHash, err := e.PCRBank.Hash()
+	if err != nil {
+		return nil, err
+	}
+
+	quoted := map[int]struct{}{}
+
+	for _, selection := range quote.PCRSelections {
+		if selection.Hash != e.PCRBank {
+			return nil, fmt.Errorf("quote covers the %s PCR bank, expected %s", selection.Hash, e.PCRBank)
+		}
+
+		for _, pcr := range selection.PCRs {
+			quoted[pcr] = struct{}{}
+		}
+	}
+
+	for pcr, value := range e.PCRs {
+		if _, ok := quoted[pcr]; !ok {
+			return nil, fmt.Errorf("PCR %d is not covered by the quote", pcr)
+		}
+
+		if len(value) != pcrBankHash.Size() {
+			return nil, fmt.Errorf("PCR %d value has unexpected size %d", pcr, len(value))
+		}
+	}
+
+	// the PCR digest is computed with the hash algorithm of the signing scheme
+	signatureHash, err := sig.Hash.Hash()
+	if err != nil {
+		return nil, err
+	}
+
+	digest, err := ComputePCRDigest(signatureHash, quote.PCRSelections, map[Algorithm]map[int][]byte{e.PCRBank: e.PCRs})
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(digest, quote.PCRDigest) {
+		return nil, errors.New("PCR values don't match the quoted PCR digest")
+	}
+
+	return quote, nil
+}
@@ -10,6 +10,7 @@ import (
 	"crypto/x509"
 	"embed"
 	"path/filepath"
+	"slices"
 	"sync"
 
 	"github.com/foxboron/go-uefi/efi/signature"
@@ -84,6 +85,11 @@ var wellKnownKEKCertificates = sync.OnceValue(func() []*x509.Certificate {
 	return certs
 })
 
+// IsWellKnownCertificate returns true if the certificate is one of the well-known vendor db or KEK certificates.
+func IsWellKnownCertificate(cert *x509.Certificate) bool {
+	return slices.ContainsFunc(slices.Concat(wellKnownDBCertificates(), wellKnownKEKCertificates()), cert.Equal)
+}
+
 // Options for Generate.
 type Options struct {
 	IncludeWellKnownCertificates bool
@@ -0,0 +1,419 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package eventlog parses the TPM 2.0 event log recorded by the firmware and the boot loader.
+//
+// ref: TCG PC Client Platform Firmware Profile Specification, section 10.
+package eventlog
+
+import (
+	"bytes"
+	"crypto"
+	_ "crypto/sha1" // register SHA1 for the event log replay
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"crypto/x509"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// Path is the path to the TPM event log exposed by the kernel.
+const Path = "/sys/kernel/security/tpm0/binary_bios_measurements"
+
+// Algorithm is the TPM hash algorithm ID (TPM_ALG_ID).
+type Algorithm uint16
+
+// Hash algorithms supported by the event log replay.
+const (
+	AlgorithmSHA1   Algorithm = 0x0004
+	AlgorithmSHA256 Algorithm = 0x000b
+	AlgorithmSHA384 Algorithm = 0x000c
+	AlgorithmSHA512 Algorithm = 0x000d
+)
+
+// EventType is the type of the event log entry.
+type EventType uint32
+
+// Event types used by this package.
+const (
+	EventNoAction             EventType = 0x00000003
+	EventEFIVariableAuthority EventType = 0x800000e0
+)
+
+const (
+	specIDEventSignature     = "Spec ID Event03\x00"
+	startupLocalitySignature = "StartupLocality\x00"
+
+	// signature, platform class, version, errata, uintn size and the number of algorithms.
+	specIDEventMinSize = len(specIDEventSignature) + 4 + 4 + 4
+
+	efiGUIDSize         = 16
+	sha1DigestSize      = 20
+	maxEventSize        = 16 * 1024 * 1024
+	maxDigestAlgorithms = 16
+	maxPCRIndex         = 23
+)
+
+// Event is a single entry of the event log.
+type Event struct {
+	// Digests of the event by the hash algorithm.
+	Digests map[Algorithm][]byte
+	Data    []byte
+	PCR     int
+	Type    EventType
+}
+
+// Log is a parsed TPM 2.0 event log.
+type Log struct {
+	// Digest sizes by the hash algorithm, as declared in the log header.
+	Algorithms map[Algorithm]int
+	Events     []Event
+
+	// Locality of the TPM2_Startup command, it defines the initial value of PCR 0.
+	StartupLocality byte
+}
+
+// Read reads and parses the event log from the kernel.
+func Read() (*Log, []byte, error) {
+	data, err := os.ReadFile(Path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	log, err := Parse(data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return log, data, nil
+}
+
+// Parse parses the crypto agile (TPM 2.0) event log.
+//
+//nolint:gocyclo,cyclop
+func Parse(data []byte) (*Log, error) {
+	r := reader{data: data}
+
+	// the first event is always in the legacy (SHA1) format, and it describes the log format
+	pcr, eventType := r.uint32(), EventType(r.uint32())
+	r.skip(sha1DigestSize)
+
+	header, ok := r.sizedBytes()
+	if !ok {
+		return nil, errors.New("event log is truncated")
+	}
+
+	if pcr != 0 || eventType != EventNoAction || !bytes.HasPrefix(header, []byte(specIDEventSignature)) {
+		return nil, errors.New("event log is not in the crypto agile format")
+	}
+
+	algorithms, err := parseSpecIDEvent(header)
+	if err != nil {
+		return nil, err
+	}
+
+	log := &Log{
+		Algorithms: algorithms,
+	}
+
+	for r.remaining() > 0 {
+		offset := r.offset
+
+		var event Event
+
+		pcr, rawType, count := r.uint32(), r.uint32(), r.uint32()
+		if r.failed {
+			return nil, fmt.Errorf("event at offset %d is truncated", offset)
+		}
+
+		if pcr > maxPCRIndex {
+			return nil, fmt.Errorf("event at offset %d: invalid PCR index %d", offset, pcr)
+		}
+
+		if count > maxDigestAlgorithms {
+			return nil, fmt.Errorf("event at offset %d: too many digests %d", offset, count)
+		}
+
+		event.PCR = int(pcr)
+		event.Type = EventType(rawType)
+		event.Digests = make(map[Algorithm][]byte, count)
+
+		for range count {
+			alg := Algorithm(r.uint16())
+			if r.failed {
+				return nil, fmt.Errorf("event at offset %d is truncated", offset)
+			}
+
+			size, known := algorithms[alg]
+			if !known {
+				return nil, fmt.Errorf("event at offset %d: digest algorithm 0x%04x is not declared in the log header", offset, uint16(alg))
+			}
+
+			digest, ok := r.bytes(size)
+			if !ok {
+				return nil, fmt.Errorf("event at offset %d is truncated", offset)
+			}
+
+			event.Digests[alg] = digest
+		}
+
+		event.Data, ok = r.sizedBytes()
+		if !ok {
+			return nil, fmt.Errorf("event at offset %d is truncated", offset)
+		}
+
+		if event.Type == EventNoAction && event.PCR == 0 &&
+			len(event.Data) > len(startupLocalitySignature) && bytes.HasPrefix(event.Data, []byte(startupLocalitySignature)) {
+			log.StartupLocality = event.Data[len(startupLocalitySignature)]
+		}
+
+		log.Events = append(log.Events, event)
+	}
+
+	return log, nil
+}
+
+func parseSpecIDEvent(header []byte) (map[Algorithm]int, error) {
+	if len(header) < specIDEventMinSize {
+		return nil, errors.New("spec ID event is truncated")
+	}
+
+	// skip the signature, platform class, version, errata and uintn size
+	r := reader{data: header[len(specIDEventSignature)+4+4:]}
+
+	count := r.uint32()
+	if r.failed || count == 0 || count > maxDigestAlgorithms {
+		return nil, errors.New("spec ID event has invalid number of algorithms")
+	}
+
+	algorithms := make(map[Algorithm]int, count)
+
+	for range count {
+		alg, size := Algorithm(r.uint16()), int(r.uint16())
+		if r.failed {
+			return nil, errors.New("spec ID event is truncated")
+		}
+
+		algorithms[alg] = size
+	}
+
+	return algorithms, nil
+}
+
+// Replay computes the values of the PCRs in the bank by replaying the event log.
+func (log *Log) Replay(alg Algorithm) (map[int][]byte, error) {
+	size, ok := log.Algorithms[alg]
+	if !ok {
+		return nil, fmt.Errorf("event log doesn't have the digests in the 0x%04x bank", uint16(alg))
+	}
+
+	hash, err := hashByAlg(alg)
+	if err != nil {
+		return nil, err
+	}
+
+	if hash.Size() != size {
+		return nil, fmt.Errorf("unexpected digest size %d for the 0x%04x bank", size, uint16(alg))
+	}
+
+	pcrs := map[int][]byte{}
+
+	for _, event := range log.Events {
+		// EV_NO_ACTION events are informational, they are not extended into the PCRs
+		if event.Type == EventNoAction {
+			continue
+		}
+
+		digest, ok := event.Digests[alg]
+		if !ok {
+			return nil, fmt.Errorf("event for PCR %d doesn't have a digest in the 0x%04x bank", event.PCR, uint16(alg))
+		}
+
+		value, ok := pcrs[event.PCR]
+		if !ok {
+			value = make([]byte, size)
+
+			if event.PCR == 0 {
+				value[size-1] = log.StartupLocality
+			}
+		}
+
+		pcrs[event.PCR] = extend(hash, value, digest)
+	}
+
+	return pcrs, nil
+}
+
+func hashByAlg(alg Algorithm) (crypto.Hash, error) {
+	switch alg { //nolint:exhaustive
+	case AlgorithmSHA1:
+		return crypto.SHA1, nil
+	case AlgorithmSHA256:
+		return crypto.SHA256, nil
+	case AlgorithmSHA384:
+		return crypto.SHA384, nil
+	case AlgorithmSHA512:
+		return crypto.SHA512, nil
+	default:
+		return 0, fmt.Errorf("unsupported digest algorithm 0x%04x", uint16(alg))
+	}
+}
+
+func extend(hash crypto.Hash, value, digest []byte) []byte {
+	h := hash.New()
+	h.Write(value)
+	h.Write(digest)
+
+	return h.Sum(nil)
+}
+
+// Authorities returns the certificates which verified the boot components.
+//
+// The certificates are recorded by the firmware in the EV_EFI_VARIABLE_AUTHORITY events as the
+// UEFI db entries used to verify each loaded image.
+func (log *Log) Authorities() ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+
+	for _, event := range log.Events {
+		if event.Type != EventEFIVariableAuthority {
+			continue
+		}
+
+		cert, err := parseAuthority(event.Data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse authority event for PCR %d: %w", event.PCR, err)
+		}
+
+		if cert == nil {
+			continue
+		}
+
+		if !containsCertificate(certs, cert) {
+			certs = append(certs, cert)
+		}
+	}
+
+	return certs, nil
+}
+
+// parseAuthority parses the UEFI_VARIABLE_DATA structure of the authority event.
+//
+// The variable data is an EFI_SIGNATURE_DATA: the owner GUID followed by the certificate.
+// Authorities which are not certificates (e.g. the image hash from db) are skipped.
+func parseAuthority(data []byte) (*x509.Certificate, error) {
+	r := reader{data: data}
+
+	if !r.skip(efiGUIDSize) {
+		return nil, errors.New("variable data is truncated")
+	}
+
+	nameLength, dataLength := r.uint64(), r.uint64()
+	if r.failed || nameLength > maxEventSize || dataLength > maxEventSize {
+		return nil, errors.New("variable data is truncated")
+	}
+
+	if !r.skip(int(nameLength) * 2) {
+		return nil, errors.New("variable data is truncated")
+	}
+
+	variableData, ok := r.bytes(int(dataLength))
+	if !ok {
+		return nil, errors.New("variable data is truncated")
+	}
+
+	if len(variableData) <= efiGUIDSize {
+		return nil, nil //nolint:nilnil
+	}
+
+	cert, err := x509.ParseCertificate(variableData[efiGUIDSize:])
+	if err != nil {
+		return nil, nil //nolint:nilerr,nilnil
+	}
+
+	return cert, nil
+}
+
+func containsCertificate(certs []*x509.Certificate, cert *x509.Certificate) bool {
+	for _, c := range certs {
+		if c.Equal(cert) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// reader reads little-endian values, once a read fails all subsequent reads fail as well.
+type reader struct {
+	data   []byte
+	offset int
+	failed bool
+}
+
+func (r *reader) remaining() int {
+	return len(r.data) - r.offset
+}
+
+func (r *reader) bytes(n int) ([]byte, bool) {
+	if r.failed || n < 0 || r.remaining() < n {
+		r.failed = true
+
+		return nil, false
+	}
+
+	b := r.data[r.offset : r.offset+n]
+	r.offset += n
+
+	return b, true
+}
+
+func (r *reader) skip(n int) bool {
+	_, ok := r.bytes(n)
+
+	return ok
+}
+
+func (r *reader) uint16() uint16 {
+	b, ok := r.bytes(2)
+	if !ok {
+		return 0
+	}
+
+	return binary.LittleEndian.Uint16(b)
+}
+
+func (r *reader) uint32() uint32 {
+	b, ok := r.bytes(4)
+	if !ok {
+		return 0
+	}
+
+	return binary.LittleEndian.Uint32(b)
+}
+
+func (r *reader) uint64() uint64 {
+	b, ok := r.bytes(8)
+	if !ok {
+		return 0
+	}
+
+	return binary.LittleEndian.Uint64(b)
+}
+
+func (r *reader) sizedBytes() ([]byte, bool) {
+	size := r.uint32()
+	if r.failed {
+		return nil, false
+	}
+
+	if size > maxEventSize {
+		r.failed = true
+
+		return nil, false
+	}
+
+	return r.bytes(int(size))
+}
@@ -0,0 +1,124 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package eventlog_test
+
+import (
+	_ "embed"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/secureboot/eventlog"
+)
+
+// eventlog.bin is a crypto agile event log with SHA1 and SHA256 banks,
+// which has the startup locality set to 3, and the same db certificate recorded twice as an authority.
+//
+//go:embed testdata/eventlog.bin
+var eventLog []byte
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	log, err := eventlog.Parse(eventLog)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[eventlog.Algorithm]int{
+		eventlog.AlgorithmSHA1:   20,
+		eventlog.AlgorithmSHA256: 32,
+	}, log.Algorithms)
+	assert.Len(t, log.Events, 16)
+	assert.EqualValues(t, 3, log.StartupLocality)
+
+	assert.Equal(t, eventlog.EventNoAction, log.Events[0].Type)
+	assert.Equal(t, 11, log.Events[15].PCR)
+	assert.Equal(t, []byte(".linux\x00"), log.Events[15].Data)
+}
+
+func TestReplay(t *testing.T) {
+	t.Parallel()
+
+	log, err := eventlog.Parse(eventLog)
+	require.NoError(t, err)
+
+	pcrs, err := log.Replay(eventlog.AlgorithmSHA256)
+	require.NoError(t, err)
+
+	expected := map[int]string{
+		0:  "4b276fd208b62e35f875ec118293224b886e5e169b8214903d80a1726bf8b8c2",
+		1:  "3d458cfe55cc03ea1f443f1562beec8df51c75e14a9fcf9a7234a13f198e7969",
+		2:  "3d458cfe55cc03ea1f443f1562beec8df51c75e14a9fcf9a7234a13f198e7969",
+		3:  "3d458cfe55cc03ea1f443f1562beec8df51c75e14a9fcf9a7234a13f198e7969",
+		4:  "62cfc8cd4f3044d8af70c167294a3c63d74e8e5c1b5066c27dca1586d07f22cd",
+		5:  "3d458cfe55cc03ea1f443f1562beec8df51c75e14a9fcf9a7234a13f198e7969",
+		6:  "3d458cfe55cc03ea1f443f1562beec8df51c75e14a9fcf9a7234a13f198e7969",
+		7:  "268d9fc05976fdd883e67819f18d29cb977ef74b6fa6c4f148c61c17c17e73a1",
+		11: "457040d352c9be3893642229b99cb41ab79c24f00c00bfc2dbfbac0f8cf207fe",
+	}
+
+	actual := map[int]string{}
+
+	for pcr, value := range pcrs {
+		actual[pcr] = hex.EncodeToString(value)
+	}
+
+	assert.Equal(t, expected, actual)
+
+	_, err = log.Replay(eventlog.AlgorithmSHA384)
+	assert.EqualError(t, err, "event log doesn't have the digests in the 0x000c bank")
+}
+
+func TestAuthorities(t *testing.T) {
+	t.Parallel()
+
+	log, err := eventlog.Parse(eventLog)
+	require.NoError(t, err)
+
+	certs, err := log.Authorities()
+	require.NoError(t, err)
+
+	require.Len(t, certs, 1)
+	assert.Equal(t, "Test SecureBoot Signing Key", certs[0].Subject.CommonName)
+}
+
+func TestParseInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		data []byte
+
+		expectedError string
+	}{
+		{
+			name:          "empty",
+			expectedError: "event log is truncated",
+		},
+		{
+			name:          "truncated header",
+			data:          eventLog[:40],
+			expectedError: "event log is truncated",
+		},
+		{
+			name:          "legacy format",
+			data:          append([]byte{0, 0, 0, 0, 8, 0, 0, 0}, make([]byte, 24)...),
+			expectedError: "event log is not in the crypto agile format",
+		},
+		{
+			name:          "truncated event",
+			data:          eventLog[:len(eventLog)-3],
+			expectedError: "event at offset 2343 is truncated",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := eventlog.Parse(test.data)
+			assert.EqualError(t, err, test.expectedError)
+		})
+	}
+}
@@ -7,11 +7,13 @@ package tpm2
 
 import (
 	"bytes"
+	"cmp"
 	"crypto/sha256"
 	"errors"
 	"fmt"
 	"io/fs"
 	"log"
+	"slices"
 
 	"github.com/google/go-tpm/tpm2"
 	"github.com/google/go-tpm/tpm2/transport"
@@ -37,8 +39,29 @@ func CreateSelector(pcrs []int) ([]byte, error) {
 	return mask, nil
 }
 
-// ReadPCR reads the value of a single PCR.
+// PCRBankAlg returns the hash algorithm of the PCR bank by its name.
+func PCRBankAlg(bank string) (tpm2.TPMAlgID, error) {
+	switch bank {
+	case "sha1":
+		return tpm2.TPMAlgSHA1, nil
+	case "", "sha256":
+		return tpm2.TPMAlgSHA256, nil
+	case "sha384":
+		return tpm2.TPMAlgSHA384, nil
+	case "sha512":
+		return tpm2.TPMAlgSHA512, nil
+	default:
+		return 0, fmt.Errorf("unsupported PCR bank %q", bank)
+	}
+}
+
+// ReadPCR reads the value of a single PCR from the SHA256 bank.
 func ReadPCR(t transport.TPM, pcr int) ([]byte, error) {
+	return ReadPCRFromBank(t, tpm2.TPMAlgSHA256, pcr)
+}
+
+// ReadPCRFromBank reads the value of a single PCR from the specified bank.
+func ReadPCRFromBank(t transport.TPM, bank tpm2.TPMAlgID, pcr int) ([]byte, error) {
 	pcrSelector, err := CreateSelector([]int{pcr})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create PCR selection: %w", err)
@@ -48,7 +71,7 @@ func ReadPCR(t transport.TPM, pcr int) ([]byte, error) {
 		PCRSelectionIn: tpm2.TPMLPCRSelection{
 			PCRSelections: []tpm2.TPMSPCRSelection{
 				{
-					Hash:      tpm2.TPMAlgSHA256,
+					Hash:      bank,
 					PCRSelect: pcrSelector,
 				},
 			},
@@ -60,6 +83,11 @@ func ReadPCR(t transport.TPM, pcr int) ([]byte, error) {
 		return nil, fmt.Errorf("failed to read PCR: %w", err)
 	}
 
+	// the TPM returns an empty list of digests if the bank is not allocated
+	if len(pcrValue.PCRValues.Digests) == 0 {
+		return nil, fmt.Errorf("PCR %d is not available in the requested bank", pcr)
+	}
+
 	return pcrValue.PCRValues.Digests[0].Buffer, nil
 }
 
@@ -141,29 +169,17 @@ func PolicyPCRDigest(t transport.TPM, policyHandle tpm2.TPMHandle, pcrSelection
 	return &policyGetDigestResponse.PolicyDigest, nil
 }
 
+// validatePCRBanks checks that the PCR banks required by the policy are allocated, and that the PCRs are populated.
+//
+// If signed is true, PCR 11 in the SHA256 bank is checked as well, as the signed PCR policy is always using it.
+//
 //nolint:gocyclo
-func validatePCRBanks(t transport.TPM, tpmPCRs []int) error {
-	pcrValue, err := ReadPCR(t, constants.UKIPCR)
+func validatePCRBanks(t transport.TPM, tpmPCRs []int, bank string, signed bool) error {
+	bankAlg, err := PCRBankAlg(bank)
 	if err != nil {
-		return fmt.Errorf("failed to read PCR: %w", err)
-	}
-
-	if err = validatePCRNotZeroAndNotFilled(pcrValue, constants.UKIPCR); err != nil {
 		return err
 	}
 
-	// here we need to read individual PCRs and not the overall PCR state to make sure each is not empty
-	for _, pcr := range tpmPCRs {
-		pcrValue, err = ReadPCR(t, pcr)
-		if err != nil {
-			return fmt.Errorf("failed to read PCR %d: %w", pcr, err)
-		}
-
-		if err = validatePCRNotZeroAndNotFilled(pcrValue, pcr); err != nil {
-			return err
-		}
-	}
-
 	caps := tpm2.GetCapability{
 		Capability:    tpm2.TPMCapPCRs,
 		Property:      0,
@@ -180,19 +196,53 @@ func validatePCRBanks(t transport.TPM, tpmPCRs []int) error {
 		return fmt.Errorf("failed to parse assigned PCRs: %w", err)
 	}
 
-	for _, s := range assignedPCRs.PCRSelections {
-		if s.Hash != tpm2.TPMAlgSHA256 {
-			continue
+	requiredBanks := map[tpm2.TPMAlgID]string{
+		bankAlg: cmp.Or(bank, constants.DefaultTPMPCRBank),
+	}
+
+	if signed {
+		requiredBanks[tpm2.TPMAlgSHA256] = constants.DefaultTPMPCRBank
+	}
+
+	for alg, name := range requiredBanks {
+		idx := slices.IndexFunc(assignedPCRs.PCRSelections, func(s tpm2.TPMSPCRSelection) bool { return s.Hash == alg })
+		if idx == -1 {
+			return fmt.Errorf("TPM does not have the %s PCR bank allocated", name)
 		}
 
+		s := assignedPCRs.PCRSelections[idx]
+
 		// check if 24 banks are available
 		if len(s.PCRSelect) != 24/8 {
-			return fmt.Errorf("unexpected number of PCR banks: %d", len(s.PCRSelect))
+			return fmt.Errorf("unexpected number of PCR banks in %s bank: %d", name, len(s.PCRSelect))
 		}
 
 		// check if all banks are available
 		if s.PCRSelect[0] != 0xff || s.PCRSelect[1] != 0xff || s.PCRSelect[2] != 0xff {
-			return fmt.Errorf("unexpected PCR banks: %v", s.PCRSelect)
+			return fmt.Errorf("unexpected PCR banks in %s bank: %v", name, s.PCRSelect)
+		}
+	}
+
+	if signed {
+		pcrValue, err := ReadPCR(t, constants.UKIPCR)
+		if err != nil {
+			return fmt.Errorf("failed to read PCR: %w", err)
+		}
+
+		if err = validatePCRNotZeroAndNotFilled(pcrValue, constants.UKIPCR); err != nil {
+			return err
+		}
+	}
+
+	// here we need to read individual PCRs and not the overall PCR state to make sure each is not empty
+	for _, pcr := range tpmPCRs {
+		pcrValue, err := ReadPCRFromBank(t, bankAlg, pcr)
+		if err != nil {
+			return fmt.Errorf("failed to read PCR %d: %w", pcr, err)
+		}
+
+		if err = validatePCRNotZeroAndNotFilled(pcrValue, pcr); err != nil {
+			return err
 		}
 	}
 
@@ -200,11 +250,11 @@ func validatePCRBanks(t transport.TPM, tpmPCRs []int) error {
 }
 
 func validatePCRNotZeroAndNotFilled(pcrValue []byte, pcr int) error {
-	if bytes.Equal(pcrValue, bytes.Repeat([]byte{0x00}, sha256.Size)) {
+	if bytes.Equal(pcrValue, bytes.Repeat([]byte{0x00}, len(pcrValue))) {
 		return fmt.Errorf("PCR bank %d is populated with all zeroes", pcr)
 	}
 
-	if bytes.Equal(pcrValue, bytes.Repeat([]byte{0xFF}, sha256.Size)) {
+	if bytes.Equal(pcrValue, bytes.Repeat([]byte{0xFF}, len(pcrValue))) {
 		return fmt.Errorf("PCR bank %d is populated with all 0xFF", pcr)
 	}
 
@@ -6,6 +6,7 @@
 package tpm2
 
 import (
+	"cmp"
 	"crypto/sha256"
 	"fmt"
 
@@ -59,8 +60,11 @@ func calculatePolicyAuthorize(calculator *tpm2.PolicyCalculator, pubKey string)
 
 // SealingPolicyDigestInfo holds the information needed to calculate a sealing policy digest.
 type SealingPolicyDigestInfo struct {
-	PublicKey   string
-	PCRs        []int
+	// PublicKey is the path to the PCR signing public key, if empty, the signed PCR policy is not used.
+	PublicKey string
+	PCRs      []int
+	// PCRBank is the bank PCR values are read from, defaults to SHA256.
+	PCRBank     tpm2.TPMAlgID
 	ReadPCRFunc func(t transport.TPM, pcr int) ([]byte, error)
 }
 
@@ -71,8 +75,10 @@ func CalculateSealingPolicyDigest(t transport.TPM, spInfo SealingPolicyDigestInf
 		return nil, fmt.Errorf("failed to create policy calculator: %v", err)
 	}
 
-	if err := calculatePolicyAuthorize(calculator, spInfo.PublicKey); err != nil {
-		return nil, fmt.Errorf("failed to calculate policy authorize: %v", err)
+	if spInfo.PublicKey != "" {
+		if err := calculatePolicyAuthorize(calculator, spInfo.PublicKey); err != nil {
+			return nil, fmt.Errorf("failed to calculate policy authorize: %v", err)
+		}
 	}
 
 	if len(spInfo.PCRs) == 0 {
@@ -87,7 +93,7 @@ func CalculateSealingPolicyDigest(t transport.TPM, spInfo SealingPolicyDigestInf
 	pcrSelection := tpm2.TPMLPCRSelection{
 		PCRSelections: []tpm2.TPMSPCRSelection{
 			{
-				Hash:      tpm2.TPMAlgSHA256,
+				Hash:      cmp.Or(spInfo.PCRBank, tpm2.TPMAlgSHA256),
 				PCRSelect: pcrSelector,
 			},
 		},
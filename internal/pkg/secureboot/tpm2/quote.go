@@ -0,0 +1,170 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package tpm2 provides TPM2.0 related functionality helpers.
+package tpm2
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/google/go-tpm/tpm2"
+
+	"github.com/siderolabs/talos/internal/pkg/tpm"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// DefaultQuotePCRs are the PCRs quoted by default: the firmware, the boot loader and the UKI measurements.
+var DefaultQuotePCRs = []int{0, 1, 2, 3, 4, 5, 6, 7, constants.UKIPCR}
+
+// AKTemplate is the template of the attestation key: a restricted ECC P-256 signing key.
+//
+// The key is created as a primary key in the endorsement hierarchy, so it is derived from the endorsement seed,
+// and the same key is returned on every boot until the TPM is cleared.
+var AKTemplate = tpm2.TPMTPublic{
+	Type:    tpm2.TPMAlgECC,
+	NameAlg: tpm2.TPMAlgSHA256,
+	ObjectAttributes: tpm2.TPMAObject{
+		FixedTPM:            true,
+		FixedParent:         true,
+		SensitiveDataOrigin: true,
+		UserWithAuth:        true,
+		Restricted:          true,
+		SignEncrypt:         true,
+	},
+	Parameters: tpm2.NewTPMUPublicParms(tpm2.TPMAlgECC, &tpm2.TPMSECCParms{
+		Symmetric: tpm2.TPMTSymDefObject{
+			Algorithm: tpm2.TPMAlgNull,
+		},
+		Scheme: tpm2.TPMTECCScheme{
+			Scheme: tpm2.TPMAlgECDSA,
+			Details: tpm2.NewTPMUAsymScheme(tpm2.TPMAlgECDSA, &tpm2.TPMSSigSchemeECDSA{
+				HashAlg: tpm2.TPMAlgSHA256,
+			}),
+		},
+		CurveID: tpm2.TPMECCNistP256,
+		KDF: tpm2.TPMTKDFScheme{
+			Scheme: tpm2.TPMAlgNull,
+		},
+	}),
+	Unique: tpm2.NewTPMUPublicID(tpm2.TPMAlgECC, &tpm2.TPMSECCPoint{
+		X: tpm2.TPM2BECCParameter{Buffer: make([]byte, 32)},
+		Y: tpm2.TPM2BECCParameter{Buffer: make([]byte, 32)},
+	}),
+}
+
+// QuoteOptions defines the PCRs to quote.
+type QuoteOptions struct {
+	// PCRs to quote, defaults to DefaultQuotePCRs.
+	PCRs []int
+	// PCRBank to quote, defaults to sha256.
+	PCRBank string
+}
+
+// QuoteResponse is the quote signed by the attestation key.
+type QuoteResponse struct {
+	// Quote is the TPMS_ATTEST structure.
+	Quote []byte
+	// Signature is the TPMT_SIGNATURE of the quote.
+	Signature []byte
+	// AKPublic is the TPMT_PUBLIC area of the attestation key.
+	AKPublic []byte
+	// PCRs are the values of the quoted PCRs.
+	PCRs map[int][]byte
+}
+
+// Quote produces the quote of the PCR values over the nonce signed by the attestation key.
+func Quote(nonce []byte, opts QuoteOptions) (resp *QuoteResponse, err error) {
+	bankAlg, err := PCRBankAlg(cmp.Or(opts.PCRBank, constants.DefaultTPMPCRBank))
+	if err != nil {
+		return nil, err
+	}
+
+	pcrs := slices.Clone(opts.PCRs)
+	if len(pcrs) == 0 {
+		pcrs = slices.Clone(DefaultQuotePCRs)
+	}
+
+	slices.Sort(pcrs)
+	pcrs = slices.Compact(pcrs)
+
+	pcrSelector, err := CreateSelector(pcrs)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := tpm.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer t.Close() //nolint:errcheck
+
+	primary := tpm2.CreatePrimary{
+		PrimaryHandle: tpm2.TPMRHEndorsement,
+		InPublic:      tpm2.New2B(AKTemplate),
+	}
+
+	createPrimaryResponse, err := primary.Execute(t)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the attestation key: %w", err)
+	}
+
+	defer func() {
+		flush := tpm2.FlushContext{
+			FlushHandle: createPrimaryResponse.ObjectHandle,
+		}
+
+		_, flushErr := flush.Execute(t)
+		if flushErr != nil && err == nil {
+			err = flushErr
+		}
+	}()
+
+	quote := tpm2.Quote{
+		SignHandle: tpm2.AuthHandle{
+			Handle: createPrimaryResponse.ObjectHandle,
+			Name:   createPrimaryResponse.Name,
+			Auth:   tpm2.PasswordAuth(nil),
+		},
+		QualifyingData: tpm2.TPM2BData{
+			Buffer: nonce,
+		},
+		InScheme: tpm2.TPMTSigScheme{
+			Scheme: tpm2.TPMAlgNull,
+		},
+		PCRSelect: tpm2.TPMLPCRSelection{
+			PCRSelections: []tpm2.TPMSPCRSelection{
+				{
+					Hash:      bankAlg,
+					PCRSelect: pcrSelector,
+				},
+			},
+		},
+	}
+
+	quoteResponse, err := quote.Execute(t)
+	if err != nil {
+		return nil, fmt.Errorf("failed to quote PCRs: %w", err)
+	}
+
+	// the PCR values might change between the quote and the read, the verifier detects that by the PCR digest mismatch
+	pcrValues := make(map[int][]byte, len(pcrs))
+
+	for _, pcr := range pcrs {
+		value, err := ReadPCRFromBank(t, bankAlg, pcr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read PCR %d: %w", pcr, err)
+		}
+
+		pcrValues[pcr] = value
+	}
+
+	return &QuoteResponse{
+		Quote:     quoteResponse.Quoted.Bytes(),
+		Signature: tpm2.Marshal(quoteResponse.Signature),
+		AKPublic:  createPrimaryResponse.OutPublic.Bytes(),
+		PCRs:      pcrValues,
+	}, nil
+}
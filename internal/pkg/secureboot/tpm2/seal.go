@@ -6,16 +6,40 @@
 package tpm2
 
 import (
+	"cmp"
+	"errors"
 	"fmt"
 
 	"github.com/google/go-tpm/tpm2"
+	"github.com/google/go-tpm/tpm2/transport"
 
 	"github.com/siderolabs/talos/internal/pkg/tpm"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 )
 
+// SealOptions defines the policy the key is sealed with.
+type SealOptions struct {
+	// PCRs to bind the key to.
+	PCRs []int
+	// PCRBank to read the PCR values from, defaults to sha256.
+	PCRBank string
+	// DisableSignedPCRPolicy disables binding the key to the signed PCR 11 policy.
+	DisableSignedPCRPolicy bool
+}
+
 // Seal seals the key using TPM2.0.
-func Seal(key []byte, tpmPCRs []int) (*SealedResponse, error) {
+func Seal(key []byte, opts SealOptions) (*SealedResponse, error) {
+	if opts.DisableSignedPCRPolicy && len(opts.PCRs) == 0 {
+		return nil, errors.New("at least one PCR is required when the signed PCR policy is disabled")
+	}
+
+	bank := cmp.Or(opts.PCRBank, constants.DefaultTPMPCRBank)
+
+	bankAlg, err := PCRBankAlg(bank)
+	if err != nil {
+		return nil, err
+	}
+
 	t, err := tpm.Open()
 	if err != nil {
 		return nil, err
@@ -23,15 +47,27 @@ func Seal(key []byte, tpmPCRs []int) (*SealedResponse, error) {
 	defer t.Close() //nolint:errcheck
 
 	// fail early if PCR banks are not present or filled with all zeroes or 0xff
-	if err = validatePCRBanks(t, tpmPCRs); err != nil {
+	if err = validatePCRBanks(t, opts.PCRs, bank, !opts.DisableSignedPCRPolicy); err != nil {
 		return nil, err
 	}
 
-	sealingPolicyDigest, err := CalculateSealingPolicyDigest(t, SealingPolicyDigestInfo{
-		PublicKey:   constants.PCRPublicKey,
-		PCRs:        tpmPCRs,
-		ReadPCRFunc: ReadPCR,
-	})
+	spInfo := SealingPolicyDigestInfo{
+		PCRs:    opts.PCRs,
+		PCRBank: bankAlg,
+		ReadPCRFunc: func(t transport.TPM, pcr int) ([]byte, error) {
+			return ReadPCRFromBank(t, bankAlg, pcr)
+		},
+	}
+
+	pubKeyPCRs := []int{constants.UKIPCR}
+
+	if opts.DisableSignedPCRPolicy {
+		pubKeyPCRs = nil
+	} else {
+		spInfo.PublicKey = constants.PCRPublicKey
+	}
+
+	sealingPolicyDigest, err := CalculateSealingPolicyDigest(t, spInfo)
 	if err != nil {
 		return nil, fmt.Errorf("failed to calculate sealing policy digest: %v", err)
 	}
@@ -111,10 +147,10 @@ func Seal(key []byte, tpmPCRs []int) (*SealedResponse, error) {
 		SealedBlobPublic:  tpm2.Marshal(createResp.OutPublic),
 		KeyName:           tpm2.Marshal(createPrimaryResponse.Name),
 		PolicyDigest:      sealingPolicyDigest,
-		PCRs:              tpmPCRs,
-		PubKeyPCRs:        []int{constants.UKIPCR},
+		PCRs:              opts.PCRs,
+		PubKeyPCRs:        pubKeyPCRs,
 		EncryptionVersion: EncryptionSchemaVersionErrata,
-		Alg:               "sha256",
+		Alg:               bank,
 	}
 
 	return &resp, nil
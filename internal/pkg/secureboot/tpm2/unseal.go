@@ -7,6 +7,7 @@ package tpm2
 
 import (
 	"bytes"
+	"cmp"
 	"crypto/sha256"
 	"crypto/x509"
 	"encoding/base64"
@@ -31,8 +32,19 @@ func Unseal(sealed SealedResponse) ([]byte, error) {
 	}
 	defer t.Close() //nolint:errcheck
 
+	bank := cmp.Or(sealed.Alg, constants.DefaultTPMPCRBank)
+
+	bankAlg, err := PCRBankAlg(bank)
+	if err != nil {
+		return nil, err
+	}
+
+	// keys sealed before the EncryptionVersion was introduced are always using the signed PCR policy,
+	// otherwise the signed PCR policy is used if the PubKeyPCRs are set
+	signed := sealed.EncryptionVersion == "" || len(sealed.PubKeyPCRs) > 0
+
 	// fail early if PCR banks are not present or filled with all zeroes or 0xff
-	if err = validatePCRBanks(t, sealed.PCRs); err != nil {
+	if err = validatePCRBanks(t, sealed.PCRs, bank, signed); err != nil {
 		return nil, err
 	}
 
@@ -110,9 +122,60 @@ func Unseal(sealed SealedResponse) ([]byte, error) {
 
 	defer policyCloseFunc() //nolint:errcheck
 
+	if signed {
+		if err = executeSignedPCRPolicy(t, policySess.Handle()); err != nil {
+			return nil, err
+		}
+	}
+
+	// this handles the case when talos is upgraded from pre Talos 1.12 and we had PCRs field
+	// set to just PCR 11 but locked to PCR 7
+	// in this case the EncryptionVersion is empty and the PubKeyPCRs field is also empty
+	// since both EncryptionVersion and PubKeyPCRs are introduced for Talos 1.12+ only
+	if sealed.EncryptionVersion == "" && len(sealed.PubKeyPCRs) == 0 {
+		if len(sealed.PCRs) == 1 && sealed.PCRs[0] == constants.UKIPCR {
+			if err := validatePCRPolicyDigest(t, policySess.Handle(), []int{constants.SecureBootStatePCR}, tpm2.TPMAlgSHA256, sealed.PolicyDigest); err != nil {
+				return nil, fmt.Errorf("failed to validate PCR policy digest for PCRs %v: %w", []int{constants.SecureBootStatePCR}, err)
+			}
+		}
+	}
+
+	// Talos 1.12+ sets the EncryptionVersion and PubKeyPCRs to PCR 11 and any other PCR used to PCRs field
+	if sealed.EncryptionVersion != "" && len(sealed.PCRs) > 0 {
+		if err := validatePCRPolicyDigest(t, policySess.Handle(), sealed.PCRs, bankAlg, sealed.PolicyDigest); err != nil {
+			return nil, fmt.Errorf("failed to validate PCR policy digest for PCRs %v: %w", sealed.PCRs, err)
+		}
+	}
+
+	unsealOp := tpm2.Unseal{
+		ItemHandle: tpm2.AuthHandle{
+			Handle: loadResponse.ObjectHandle,
+			Name:   loadResponse.Name,
+			Auth:   policySess,
+		},
+	}
+
+	unsealResponse, err := unsealOp.Execute(t, tpm2.HMAC(
+		tpm2.TPMAlgSHA256,
+		20,
+		tpm2.Salted(createPrimaryResponse.ObjectHandle, *outPub),
+		tpm2.AESEncryption(128, tpm2.EncryptOut),
+		tpm2.Bound(loadResponse.ObjectHandle, loadResponse.Name, nil),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to unseal op: %w", err)
+	}
+
+	return unsealResponse.OutData.Buffer, nil
+}
+
+// executeSignedPCRPolicy authorizes the policy session with the PCR 11 policy signed by the UKI signing key.
+//
+//nolint:gocyclo
+func executeSignedPCRPolicy(t transport.TPM, policyHandle tpm2.TPMHandle) error {
 	pubKey, err := ParsePCRSigningPubKey(constants.PCRPublicKey)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	loadExternal := tpm2.LoadExternal{
@@ -122,7 +185,7 @@ func Unseal(sealed SealedResponse) ([]byte, error) {
 
 	loadExternalResponse, err := loadExternal.Execute(t)
 	if err != nil {
-		return nil, fmt.Errorf("failed to load external key: %w", err)
+		return fmt.Errorf("failed to load external key: %w", err)
 	}
 
 	defer func() {
@@ -138,10 +201,10 @@ func Unseal(sealed SealedResponse) ([]byte, error) {
 
 	pcrSelector, err := CreateSelector([]int{constants.UKIPCR})
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	policyDigest, err := PolicyPCRDigest(t, policySess.Handle(), tpm2.TPMLPCRSelection{
+	policyDigest, err := PolicyPCRDigest(t, policyHandle, tpm2.TPMLPCRSelection{
 		PCRSelections: []tpm2.TPMSPCRSelection{
 			{
 				Hash:      tpm2.TPMAlgSHA256,
@@ -150,12 +213,12 @@ func Unseal(sealed SealedResponse) ([]byte, error) {
 		},
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve policy digest: %w", err)
+		return fmt.Errorf("failed to retrieve policy digest: %w", err)
 	}
 
 	sigJSON, err := ParsePCRSignature()
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	pubKeyFingerprint := sha256.Sum256(x509.MarshalPKCS1PublicKey(pubKey))
@@ -166,14 +229,14 @@ func Unseal(sealed SealedResponse) ([]byte, error) {
 	for _, bank := range sigJSON.SHA256 {
 		digest, decodeErr := hex.DecodeString(bank.Pol)
 		if decodeErr != nil {
-			return nil, decodeErr
+			return decodeErr
 		}
 
 		if bytes.Equal(digest, policyDigest.Buffer) {
 			signature = bank.Sig
 
 			if hex.EncodeToString(pubKeyFingerprint[:]) != bank.PKFP {
-				return nil, errors.New("certificate fingerprint does not match")
+				return errors.New("certificate fingerprint does not match")
 			}
 
 			break
@@ -181,12 +244,12 @@ func Unseal(sealed SealedResponse) ([]byte, error) {
 	}
 
 	if signature == "" {
-		return nil, errors.New("no signatures matching PCR SHA256 digest found in signature JSON")
+		return errors.New("no signatures matching PCR SHA256 digest found in signature JSON")
 	}
 
 	signatureDecoded, err := base64.StdEncoding.DecodeString(signature)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// Verify will only verify the RSA part of the RSA+SHA256 signature,
@@ -211,62 +274,24 @@ func Unseal(sealed SealedResponse) ([]byte, error) {
 
 	verifySignatureResponse, err := verifySignature.Execute(t)
 	if err != nil {
-		return nil, fmt.Errorf("failed to verify signature: %w", err)
+		return fmt.Errorf("failed to verify signature: %w", err)
 	}
 
 	policyAuthorize := tpm2.PolicyAuthorize{
-		PolicySession:  policySess.Handle(),
+		PolicySession:  policyHandle,
 		ApprovedPolicy: *policyDigest,
 		KeySign:        loadExternalResponse.Name,
 		CheckTicket:    verifySignatureResponse.Validation,
 	}
 
 	if _, err = policyAuthorize.Execute(t); err != nil {
-		return nil, fmt.Errorf("failed to execute policy authorize: %w", err)
-	}
-
-	// this handles the case when talos is upgraded from pre Talos 1.12 and we had PCRs field
-	// set to just PCR 11 but locked to PCR 7
-	// in this case the EncryptionVersion is empty and the PubKeyPCRs field is also empty
-	// since both EncryptionVersion and PubKeyPCRs are introduced for Talos 1.12+ only
-	if sealed.EncryptionVersion == "" && len(sealed.PubKeyPCRs) == 0 {
-		if len(sealed.PCRs) == 1 && sealed.PCRs[0] == constants.UKIPCR {
-			if err := validatePCRPolicyDigest(t, policySess.Handle(), []int{constants.SecureBootStatePCR}, sealed.PolicyDigest); err != nil {
-				return nil, fmt.Errorf("failed to validate PCR policy digest for PCRs %v: %w", []int{constants.SecureBootStatePCR}, err)
-			}
-		}
-	}
-
-	// Talos 1.12+ sets the EncryptionVersion and PubKeyPCRs to PCR 11 and any other PCR used to PCRs field
-	if sealed.EncryptionVersion != "" && len(sealed.PCRs) > 0 {
-		if err := validatePCRPolicyDigest(t, policySess.Handle(), sealed.PCRs, sealed.PolicyDigest); err != nil {
-			return nil, fmt.Errorf("failed to validate PCR policy digest for PCRs %v: %w", sealed.PCRs, err)
-		}
-	}
-
-	unsealOp := tpm2.Unseal{
-		ItemHandle: tpm2.AuthHandle{
-			Handle: loadResponse.ObjectHandle,
-			Name:   loadResponse.Name,
-			Auth:   policySess,
-		},
+		return fmt.Errorf("failed to execute policy authorize: %w", err)
 	}
 
-	unsealResponse, err := unsealOp.Execute(t, tpm2.HMAC(
-		tpm2.TPMAlgSHA256,
-		20,
-		tpm2.Salted(createPrimaryResponse.ObjectHandle, *outPub),
-		tpm2.AESEncryption(128, tpm2.EncryptOut),
-		tpm2.Bound(loadResponse.ObjectHandle, loadResponse.Name, nil),
-	))
-	if err != nil {
-		return nil, fmt.Errorf("failed to unseal op: %w", err)
-	}
-
-	return unsealResponse.OutData.Buffer, nil
+	return nil
 }
 
-func validatePCRPolicyDigest(t transport.TPM, handle tpm2.TPMHandle, pcrs []int, digest []byte) error {
+func validatePCRPolicyDigest(t transport.TPM, handle tpm2.TPMHandle, pcrs []int, bank tpm2.TPMAlgID, digest []byte) error {
 	pcrSelector, err := CreateSelector(pcrs)
 	if err != nil {
 		return fmt.Errorf("failed to create PCR selector for PCRs %v: %w", pcrs, err)
@@ -275,7 +300,7 @@ func validatePCRPolicyDigest(t transport.TPM, handle tpm2.TPMHandle, pcrs []int,
 	pcrPolicyDigest, err := PolicyPCRDigest(t, handle, tpm2.TPMLPCRSelection{
 		PCRSelections: []tpm2.TPMSPCRSelection{
 			{
-				Hash:      tpm2.TPMAlgSHA256,
+				Hash:      bank,
 				PCRSelect: pcrSelector,
 			},
 		},
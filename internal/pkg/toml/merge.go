@@ -71,3 +71,32 @@ func Merge(parts []string) ([]byte, map[string][]byte, error) {
 
 	return out.Bytes(), checksums, nil
 }
+
+// CheckMerge verifies that the patch can be decoded and merged on top of the TOML documents in files.
+func CheckMerge(parts []string, patch []byte) error {
+	merged := map[string]any{}
+
+	for _, part := range parts {
+		partial := map[string]any{}
+
+		if _, err := tomlDecodeFile(part, &partial); err != nil {
+			return fmt.Errorf("error decoding %q: %w", part, err)
+		}
+
+		if err := merge.Merge(merged, partial); err != nil {
+			return fmt.Errorf("error merging %q: %w", part, err)
+		}
+	}
+
+	partial := map[string]any{}
+
+	if err := toml.Unmarshal(patch, &partial); err != nil {
+		return fmt.Errorf("error decoding patch: %w", err)
+	}
+
+	if err := merge.Merge(merged, partial); err != nil {
+		return fmt.Errorf("error merging patch: %w", err)
+	}
+
+	return nil
+}
@@ -30,3 +30,19 @@ func TestMerge(t *testing.T) {
 	assert.Contains(t, checksums, "testdata/2.toml")
 	assert.Contains(t, checksums, "testdata/3.toml")
 }
+
+func TestCheckMerge(t *testing.T) {
+	parts := []string{
+		"testdata/1.toml",
+		"testdata/2.toml",
+	}
+
+	require.NoError(t, toml.CheckMerge(parts, []byte(`[plugins."io.containerd.grpc.v1.cri".containerd.runtimes.runc]
+    discard_unpacked_layers = false
+`)))
+
+	assert.ErrorContains(t, toml.CheckMerge(parts, []byte(`[plugins`)), "error decoding patch")
+	assert.ErrorContains(t, toml.CheckMerge(parts, []byte(`[plugins."io.containerd.grpc.v1.cri".containerd.runtimes.runc]
+    discard_unpacked_layers = "yes"
+`)), "error merging patch: merge map key map[string]interface {}[plugins]")
+}
@@ -7,8 +7,10 @@ package cluster
 import (
 	"context"
 	"crypto/tls"
+	"errors"
 	"fmt"
 	"io"
+	"sync"
 	"time"
 
 	"github.com/siderolabs/go-retry/retry"
@@ -24,43 +26,61 @@ type ApplyConfigClient struct {
 	Info
 }
 
-// ApplyConfig on the node via the API using insecure mode.
+// ApplyConfig on the nodes via the API using insecure mode.
+//
+// The config is applied to the nodes in parallel, errors are aggregated per node.
 func (s *APIBootstrapper) ApplyConfig(ctx context.Context, nodes []provision.NodeRequest, sl provision.SiderolinkRequest, out io.Writer) error {
-	for _, node := range nodes {
-		configureNode := func() error {
-			ep := node.IPs[0].String()
+	var (
+		wg   sync.WaitGroup
+		errs = make([]error, len(nodes))
+	)
 
-			if addr, ok := sl.GetAddr(node.UUID); ok {
-				fmt.Fprintln(out, "using SideroLink node address for 'with-apply-config'", node.UUID, "=", addr.String())
-
-				ep = addr.String()
+	for i, node := range nodes {
+		wg.Go(func() {
+			if err := applyConfig(ctx, node, sl, out); err != nil {
+				errs[i] = fmt.Errorf("error applying config to node %q: %w", node.Name, err)
 			}
+		})
+	}
 
-			c, err := client.New(ctx, client.WithTLSConfig(&tls.Config{
-				InsecureSkipVerify: true,
-			}), client.WithEndpoints(ep))
-			if err != nil {
-				return err
-			}
+	wg.Wait()
 
-			cfgBytes, err := node.Config.Bytes()
-			if err != nil {
-				return err
-			}
+	return errors.Join(errs...)
+}
 
-			_, err = c.ApplyConfiguration(ctx, &machineapi.ApplyConfigurationRequest{
-				Data: cfgBytes,
-			})
-			if err != nil {
-				return retry.ExpectedError(err)
-			}
+func applyConfig(ctx context.Context, node provision.NodeRequest, sl provision.SiderolinkRequest, out io.Writer) error {
+	configureNode := func() error {
+		ep := node.IPs[0].String()
 
-			return nil
+		if addr, ok := sl.GetAddr(node.UUID); ok {
+			fmt.Fprintln(out, "using SideroLink node address for 'with-apply-config'", node.UUID, "=", addr.String())
+
+			ep = addr.String()
 		}
-		if err := retry.Constant(2*time.Minute, retry.WithUnits(250*time.Millisecond), retry.WithJitter(50*time.Millisecond)).Retry(configureNode); err != nil {
+
+		c, err := client.New(ctx, client.WithTLSConfig(&tls.Config{
+			InsecureSkipVerify: true,
+		}), client.WithEndpoints(ep))
+		if err != nil {
 			return err
 		}
+
+		defer c.Close() //nolint:errcheck
+
+		cfgBytes, err := node.Config.Bytes()
+		if err != nil {
+			return err
+		}
+
+		_, err = c.ApplyConfiguration(ctx, &machineapi.ApplyConfigurationRequest{
+			Data: cfgBytes,
+		})
+		if err != nil {
+			return retry.ExpectedError(err)
+		}
+
+		return nil
 	}
 
-	return nil
+	return retry.Constant(2*time.Minute, retry.WithUnits(250*time.Millisecond), retry.WithJitter(50*time.Millisecond)).Retry(configureNode)
 }
@@ -120,6 +120,13 @@ func PreBootSequenceChecks() []ClusterCheck {
 			}, 5*time.Minute, 5*time.Second)
 		},
 
+		// wait for etcd to have no active alarms and the database quota usage below the threshold
+		func(cluster ClusterInfo) conditions.Condition {
+			return conditions.PollingCondition("etcd to have no active alarms", func(ctx context.Context) error {
+				return EtcdAlarmsAssertion(ctx, cluster)
+			}, 5*time.Minute, 5*time.Second)
+		},
+
 		// wait for etcd members to be the control plane nodes
 		func(cluster ClusterInfo) conditions.Condition {
 			return conditions.PollingCondition("etcd members to be control plane nodes", func(ctx context.Context) error {
@@ -11,14 +11,20 @@ import (
 	"fmt"
 	"net/url"
 	"slices"
+	"strings"
+	"time"
 
+	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/siderolabs/gen/maps"
 	"github.com/siderolabs/gen/xslices"
+	"google.golang.org/grpc/codes"
 
 	"github.com/siderolabs/talos/pkg/cluster"
 	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/client"
 	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/etcd"
 )
 
 // EtcdConsistentAssertion checks that etcd membership is consistent across nodes.
@@ -128,3 +134,51 @@ func EtcdControlPlaneNodesAssertion(ctx context.Context, cl ClusterInfo) error {
 
 	return nil
 }
+
+// EtcdAlarmsAssertion checks that etcd has no active alarms, and the database quota usage is below the threshold.
+func EtcdAlarmsAssertion(ctx context.Context, cl ClusterInfo) error {
+	cli, err := cl.Client()
+	if err != nil {
+		return err
+	}
+
+	nodes := append(cl.NodesByType(machine.TypeInit), cl.NodesByType(machine.TypeControlPlane)...)
+
+	for _, node := range nodes {
+		nodeCtx := client.WithNode(ctx, node.InternalIP.String())
+
+		items, err := safe.StateListAll[*etcd.Status](nodeCtx, cli.COSI)
+		if err != nil {
+			switch client.StatusCode(err) { //nolint:exhaustive
+			case codes.Unimplemented, codes.NotFound:
+				// old version of Talos without etcd status
+				continue
+			}
+
+			return fmt.Errorf("error listing etcd status on node %s: %w", node.InternalIP, err)
+		}
+
+		status, found := items.Find(func(res *etcd.Status) bool {
+			return res.Metadata().ID() == etcd.LocalMemberID
+		})
+		if !found {
+			return fmt.Errorf("etcd status is not reported yet on node %s", node.InternalIP)
+		}
+
+		spec := status.TypedSpec()
+
+		if len(spec.Alarms) > 0 {
+			alarms := xslices.Map(spec.Alarms, func(alarm etcd.Alarm) string {
+				return fmt.Sprintf("%s (member %s, since %s)", alarm.Type, alarm.MemberID, alarm.Since.Format(time.RFC3339))
+			})
+
+			return fmt.Errorf("etcd on node %s has active alarms: %s", node.InternalIP, strings.Join(alarms, ", "))
+		}
+
+		if usage := spec.QuotaUsage(); usage > constants.EtcdQuotaUsageThreshold {
+			return fmt.Errorf("etcd on node %s database size %d bytes is %d%% of the quota %d bytes", node.InternalIP, spec.DBSize, usage, spec.DBSizeQuota)
+		}
+	}
+
+	return nil
+}
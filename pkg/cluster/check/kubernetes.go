@@ -413,37 +413,80 @@ func K8sControlPlaneStaticPods(ctx context.Context, cl ClusterInfo) error {
 	}
 
 	for _, node := range expectedNodes {
-		expectedStaticPods := map[string]struct{}{
-			"kube-system/kube-apiserver":          {},
-			"kube-system/kube-controller-manager": {},
-			"kube-system/kube-scheduler":          {},
-		}
+		nodeCtx := client.WithNode(ctx, node.InternalIP.String())
 
-		items, err := safe.StateListAll[*k8s.StaticPodStatus](client.WithNode(ctx, node.InternalIP.String()), c.COSI)
+		items, err := safe.StateListAll[*k8s.StaticPodRuntimeStatus](nodeCtx, c.COSI)
 		if err != nil {
-			if client.StatusCode(err) == codes.Unimplemented {
-				// old version of Talos without COSI API
-				return nil
+			switch client.StatusCode(err) { //nolint:exhaustive
+			case codes.Unimplemented, codes.NotFound:
+				// old version of Talos without static pod runtime status
+				if err = k8sControlPlaneStaticPodsPresent(nodeCtx, c, node); err != nil {
+					return err
+				}
+
+				continue
 			}
 
-			return fmt.Errorf("error listing static pods on node %s: %w", node.InternalIP, err)
+			return fmt.Errorf("error listing static pod runtime statuses on node %s: %w", node.InternalIP, err)
 		}
 
-		for res := range items.All() {
-			for expectedStaticPod := range expectedStaticPods {
-				if strings.HasPrefix(res.Metadata().ID(), expectedStaticPod) {
-					delete(expectedStaticPods, expectedStaticPod)
-				}
+		var missingStaticPods []string
+
+		for _, id := range []string{k8s.APIServerID, k8s.ControllerManagerID, k8s.SchedulerID} {
+			res, found := items.Find(func(res *k8s.StaticPodRuntimeStatus) bool {
+				return res.Metadata().ID() == id
+			})
+			if !found {
+				missingStaticPods = append(missingStaticPods, id)
+
+				continue
 			}
-		}
 
-		if len(expectedStaticPods) > 0 {
-			missingStaticPods := maps.Keys(expectedStaticPods)
-			slices.Sort(missingStaticPods)
+			if spec := res.TypedSpec(); spec.Phase != k8s.StaticPodPhaseRunning {
+				return fmt.Errorf("static pod %s on node %s is not running: phase %s, restarts %d, last exit code %d %s",
+					id, node.InternalIP, spec.Phase, spec.RestartCount, spec.LastExitCode, spec.LastExitReason)
+			}
+		}
 
+		if len(missingStaticPods) > 0 {
 			return fmt.Errorf("missing static pods on node %s: %v", node.InternalIP, missingStaticPods)
 		}
 	}
 
 	return nil
 }
+
+func k8sControlPlaneStaticPodsPresent(ctx context.Context, c *client.Client, node cluster.NodeInfo) error {
+	expectedStaticPods := map[string]struct{}{
+		"kube-system/kube-apiserver":          {},
+		"kube-system/kube-controller-manager": {},
+		"kube-system/kube-scheduler":          {},
+	}
+
+	items, err := safe.StateListAll[*k8s.StaticPodStatus](ctx, c.COSI)
+	if err != nil {
+		if client.StatusCode(err) == codes.Unimplemented {
+			// old version of Talos without COSI API
+			return nil
+		}
+
+		return fmt.Errorf("error listing static pods on node %s: %w", node.InternalIP, err)
+	}
+
+	for res := range items.All() {
+		for expectedStaticPod := range expectedStaticPods {
+			if strings.HasPrefix(res.Metadata().ID(), expectedStaticPod) {
+				delete(expectedStaticPods, expectedStaticPod)
+			}
+		}
+	}
+
+	if len(expectedStaticPods) > 0 {
+		missingStaticPods := maps.Keys(expectedStaticPods)
+		slices.Sort(missingStaticPods)
+
+		return fmt.Errorf("missing static pods on node %s: %v", node.InternalIP, missingStaticPods)
+	}
+
+	return nil
+}
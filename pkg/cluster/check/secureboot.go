@@ -0,0 +1,76 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package check
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"google.golang.org/grpc/codes"
+
+	"github.com/siderolabs/talos/pkg/conditions"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// SecureBootChecks returns a set of checks which verify that all nodes are booted with SecureBoot enforced.
+func SecureBootChecks() []ClusterCheck {
+	return []ClusterCheck{
+		// wait for all the nodes to report SecureBoot enforced
+		func(cluster ClusterInfo) conditions.Condition {
+			return conditions.PollingCondition("all nodes to have SecureBoot enforced", func(ctx context.Context) error {
+				return SecureBootEnforcedAssertion(ctx, cluster)
+			}, time.Minute, 5*time.Second)
+		},
+	}
+}
+
+// SecureBootEnforcedAssertion checks whether all nodes are booted with SecureBoot enforced by the firmware.
+func SecureBootEnforcedAssertion(ctx context.Context, cluster ClusterInfo) error {
+	cli, err := cluster.Client()
+	if err != nil {
+		return err
+	}
+
+	nodeInternalIPs := mapIPsToStrings(mapNodeInfosToInternalIPs(cluster.Nodes()))
+
+	var notEnforced []string
+
+	for _, nodeIP := range nodeInternalIPs {
+		status, err := safe.StateGetByID[*runtime.SecureBootStatus](client.WithNode(ctx, nodeIP), cli.COSI, runtime.SecureBootStatusID)
+		if err != nil {
+			if client.StatusCode(err) == codes.PermissionDenied {
+				// not supported, skip
+				return conditions.ErrSkipAssertion
+			}
+
+			if state.IsNotFoundError(err) {
+				// the resource is not created if the node is not booted with UEFI
+				notEnforced = append(notEnforced, nodeIP)
+
+				continue
+			}
+
+			return err
+		}
+
+		if !status.TypedSpec().Enforced() {
+			notEnforced = append(notEnforced, nodeIP)
+		}
+	}
+
+	if len(notEnforced) > 0 {
+		slices.Sort(notEnforced)
+
+		return fmt.Errorf("SecureBoot is not enforced on nodes: %s", strings.Join(notEnforced, ", "))
+	}
+
+	return nil
+}
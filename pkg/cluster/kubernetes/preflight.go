@@ -0,0 +1,263 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/siderolabs/go-kubernetes/kubernetes/upgrade"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8s "k8s.io/client-go/kubernetes"
+
+	"github.com/siderolabs/talos/pkg/kubernetes"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	machinetype "github.com/siderolabs/talos/pkg/machinery/config/machine"
+)
+
+// PreflightSeverity is the severity of the pre-flight check result.
+type PreflightSeverity string
+
+// Pre-flight check severities.
+const (
+	PreflightSeverityInfo    PreflightSeverity = "info"
+	PreflightSeverityWarning PreflightSeverity = "warning"
+	PreflightSeverityError   PreflightSeverity = "error"
+)
+
+// PreflightCheck is a result of a single pre-flight check.
+type PreflightCheck struct {
+	Node     string            `json:"node,omitempty"`
+	Name     string            `json:"name"`
+	Severity PreflightSeverity `json:"severity"`
+	Message  string            `json:"message"`
+}
+
+// PreflightReport is the result of the Kubernetes upgrade pre-flight checks.
+type PreflightReport struct {
+	FromVersion string           `json:"fromVersion"`
+	ToVersion   string           `json:"toVersion"`
+	Checks      []PreflightCheck `json:"checks"`
+}
+
+// HasErrors returns true if any of the checks failed with an error.
+func (report *PreflightReport) HasErrors() bool {
+	return slices.ContainsFunc(report.Checks, func(check PreflightCheck) bool {
+		return check.Severity == PreflightSeverityError
+	})
+}
+
+func (report *PreflightReport) add(node, name string, severity PreflightSeverity, message string) {
+	report.Checks = append(report.Checks, PreflightCheck{
+		Node:     node,
+		Name:     name,
+		Severity: severity,
+		Message:  message,
+	})
+}
+
+// Preflight runs the Kubernetes upgrade pre-flight checks without changing anything in the cluster.
+//
+// Errors returned by the checks are reported in the returned report, the error is returned
+// only if the checks can't be run at all.
+func Preflight(ctx context.Context, cluster UpgradeProvider, options UpgradeOptions) (*PreflightReport, error) {
+	report := &PreflightReport{
+		FromVersion: options.Path.FromVersion(),
+		ToVersion:   options.Path.ToVersion(),
+	}
+
+	if !options.Path.IsSupported() {
+		report.add("", "upgrade path", PreflightSeverityError, fmt.Sprintf("unsupported upgrade path %s", options.Path))
+
+		return report, nil
+	}
+
+	k8sClient, err := cluster.K8sHelper(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error building kubernetes client: %w", err)
+	}
+
+	defer k8sClient.Close() //nolint:errcheck
+
+	if err = discoverNodes(ctx, k8sClient, &options); err != nil {
+		return nil, err
+	}
+
+	return preflight(ctx, cluster, k8sClient, options, report)
+}
+
+// preflight runs the pre-flight checks against the nodes already discovered in the options.
+func preflight(ctx context.Context, cluster UpgradeProvider, k8sClient k8s.Interface, options UpgradeOptions, report *PreflightReport) (*PreflightReport, error) {
+	talosClient, err := cluster.Client()
+	if err != nil {
+		return nil, err
+	}
+
+	k8sConfig, err := cluster.K8sRestConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = VerifyVersionCompatibility(ctx, talosClient, slices.Concat(options.controlPlaneNodes, options.workerNodes), options.Path.ToVersion(), options.Log); err != nil {
+		report.add("", "talos compatibility", PreflightSeverityError, err.Error())
+	} else {
+		report.add("", "talos compatibility", PreflightSeverityInfo, fmt.Sprintf("Talos version is compatible with Kubernetes version %s", options.Path.ToVersion()))
+	}
+
+	// upgrade checks verify the removed component flags, feature gates and the use of the removed APIs
+	upgradeChecks, err := upgrade.NewChecks(options.Path, talosClient.COSI, k8sConfig, options.controlPlaneNodes, options.workerNodes, options.Log)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = upgradeChecks.Run(ctx); err != nil {
+		report.add("", "removed flags and APIs", PreflightSeverityError, err.Error())
+	} else {
+		report.add("", "removed flags and APIs", PreflightSeverityInfo, "no removed flags, feature gates or APIs are in use")
+	}
+
+	if err = nodeReadinessChecks(ctx, k8sClient, report); err != nil {
+		return nil, err
+	}
+
+	if err = podDisruptionBudgetChecks(ctx, k8sClient, report); err != nil {
+		return nil, err
+	}
+
+	for _, node := range slices.Concat(options.controlPlaneNodes, options.workerNodes) {
+		nodeChecks(ctx, talosClient, node, options, report)
+	}
+
+	return report, nil
+}
+
+// checkPreflightReport logs the pre-flight check results and fails the upgrade on errors, unless forced.
+func checkPreflightReport(report *PreflightReport, options UpgradeOptions) error {
+	for _, check := range report.Checks {
+		if check.Severity == PreflightSeverityInfo {
+			continue
+		}
+
+		options.Log("pre-flight %s: %q: %s: %s", check.Severity, check.Node, check.Name, check.Message)
+	}
+
+	if !report.HasErrors() {
+		return nil
+	}
+
+	if !options.Force {
+		return errors.New("pre-flight checks failed, use --force to proceed with the upgrade")
+	}
+
+	options.Log("pre-flight checks failed, proceeding with the upgrade anyway")
+
+	return nil
+}
+
+func discoverNodes(ctx context.Context, k8sClient *kubernetes.Client, options *UpgradeOptions) error {
+	var err error
+
+	options.controlPlaneNodes, err = k8sClient.NodeIPs(ctx, machinetype.TypeControlPlane)
+	if err != nil {
+		return fmt.Errorf("error fetching controlplane nodes: %w", err)
+	}
+
+	if len(options.controlPlaneNodes) == 0 {
+		return errors.New("no controlplane nodes discovered")
+	}
+
+	options.Log("discovered controlplane nodes %q", options.controlPlaneNodes)
+
+	if options.UpgradeKubelet {
+		options.workerNodes, err = k8sClient.NodeIPs(ctx, machinetype.TypeWorker)
+		if err != nil {
+			return fmt.Errorf("error fetching worker nodes: %w", err)
+		}
+
+		options.Log("discovered worker nodes %q", options.workerNodes)
+	}
+
+	return nil
+}
+
+func nodeReadinessChecks(ctx context.Context, k8sClient k8s.Interface, report *PreflightReport) error {
+	nodes, err := k8sClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error listing nodes: %w", err)
+	}
+
+	for _, node := range nodes.Items {
+		ready := slices.ContainsFunc(node.Status.Conditions, func(condition corev1.NodeCondition) bool {
+			return condition.Type == corev1.NodeReady && condition.Status == corev1.ConditionTrue
+		})
+
+		if ready {
+			continue
+		}
+
+		report.add(node.Name, "node readiness", PreflightSeverityError, "node is not ready")
+	}
+
+	return nil
+}
+
+func podDisruptionBudgetChecks(ctx context.Context, k8sClient k8s.Interface, report *PreflightReport) error {
+	pdbs, err := k8sClient.PolicyV1().PodDisruptionBudgets(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error listing pod disruption budgets: %w", err)
+	}
+
+	for _, pdb := range pdbs.Items {
+		if pdb.Status.ExpectedPods == 0 || pdb.Status.DisruptionsAllowed > 0 {
+			continue
+		}
+
+		report.add("", "pod disruption budget", PreflightSeverityWarning,
+			fmt.Sprintf("pod disruption budget %s/%s allows no disruptions, node drains will be blocked", pdb.Namespace, pdb.Name))
+	}
+
+	return nil
+}
+
+func nodeChecks(ctx context.Context, talosClient *client.Client, node string, options UpgradeOptions, report *PreflightReport) {
+	resp, err := talosClient.KubernetesUpgradePreflight(client.WithNode(ctx, node), &machine.KubernetesUpgradePreflightRequest{
+		ToVersion:      options.Path.ToVersion(),
+		UpgradeKubelet: options.UpgradeKubelet,
+	})
+	if err != nil {
+		if status.Code(err) == codes.Unimplemented {
+			report.add(node, "node checks", PreflightSeverityInfo, "node checks are not supported by the Talos version")
+
+			return
+		}
+
+		report.add(node, "node checks", PreflightSeverityWarning, fmt.Sprintf("failed to run node checks: %s", err))
+
+		return
+	}
+
+	for _, msg := range resp.GetMessages() {
+		for _, check := range msg.GetChecks() {
+			report.add(node, check.GetName(), preflightSeverity(check.GetSeverity()), check.GetMessage())
+		}
+	}
+}
+
+func preflightSeverity(severity machine.KubernetesUpgradePreflightCheck_Severity) PreflightSeverity {
+	switch severity {
+	case machine.KubernetesUpgradePreflightCheck_ERROR:
+		return PreflightSeverityError
+	case machine.KubernetesUpgradePreflightCheck_WARNING:
+		return PreflightSeverityWarning
+	default:
+		return PreflightSeverityInfo
+	}
+}
@@ -0,0 +1,232 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kubernetes //nolint:testpackage
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+func readyNode(name string, status corev1.ConditionStatus) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{
+				{
+					Type:   corev1.NodeReady,
+					Status: status,
+				},
+			},
+		},
+	}
+}
+
+func TestNodeReadinessChecks(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name    string
+		objects []runtime.Object
+
+		expected []PreflightCheck
+	}{
+		{
+			name: "all ready",
+			objects: []runtime.Object{
+				readyNode("cp-1", corev1.ConditionTrue),
+				readyNode("worker-1", corev1.ConditionTrue),
+			},
+		},
+		{
+			name: "not ready",
+			objects: []runtime.Object{
+				readyNode("cp-1", corev1.ConditionTrue),
+				readyNode("worker-1", corev1.ConditionFalse),
+				readyNode("worker-2", corev1.ConditionUnknown),
+			},
+			expected: []PreflightCheck{
+				{Node: "worker-1", Name: "node readiness", Severity: PreflightSeverityError, Message: "node is not ready"},
+				{Node: "worker-2", Name: "node readiness", Severity: PreflightSeverityError, Message: "node is not ready"},
+			},
+		},
+		{
+			name: "no ready condition",
+			objects: []runtime.Object{
+				&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "cp-1"}},
+			},
+			expected: []PreflightCheck{
+				{Node: "cp-1", Name: "node readiness", Severity: PreflightSeverityError, Message: "node is not ready"},
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			report := &PreflightReport{}
+
+			require.NoError(t, nodeReadinessChecks(t.Context(), fake.NewClientset(test.objects...), report))
+
+			assert.Equal(t, test.expected, report.Checks)
+		})
+	}
+}
+
+func TestPodDisruptionBudgetChecks(t *testing.T) {
+	t.Parallel()
+
+	pdb := func(name string, expectedPods, disruptionsAllowed int32) *policyv1.PodDisruptionBudget {
+		return &policyv1.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      name,
+			},
+			Status: policyv1.PodDisruptionBudgetStatus{
+				ExpectedPods:       expectedPods,
+				DisruptionsAllowed: disruptionsAllowed,
+			},
+		}
+	}
+
+	for _, test := range []struct {
+		name    string
+		objects []runtime.Object
+
+		expected []PreflightCheck
+	}{
+		{
+			name: "no pdbs",
+		},
+		{
+			name: "disruptions allowed",
+			objects: []runtime.Object{
+				pdb("web", 3, 1),
+			},
+		},
+		{
+			name: "no pods",
+			objects: []runtime.Object{
+				pdb("idle", 0, 0),
+			},
+		},
+		{
+			name: "blocking",
+			objects: []runtime.Object{
+				pdb("web", 3, 1),
+				pdb("db", 1, 0),
+			},
+			expected: []PreflightCheck{
+				{
+					Name:     "pod disruption budget",
+					Severity: PreflightSeverityWarning,
+					Message:  "pod disruption budget default/db allows no disruptions, node drains will be blocked",
+				},
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			report := &PreflightReport{}
+
+			require.NoError(t, podDisruptionBudgetChecks(t.Context(), fake.NewClientset(test.objects...), report))
+
+			assert.Equal(t, test.expected, report.Checks)
+		})
+	}
+}
+
+func TestPreflightSeverity(t *testing.T) {
+	t.Parallel()
+
+	for severity, expected := range map[machine.KubernetesUpgradePreflightCheck_Severity]PreflightSeverity{
+		machine.KubernetesUpgradePreflightCheck_INFO:    PreflightSeverityInfo,
+		machine.KubernetesUpgradePreflightCheck_WARNING: PreflightSeverityWarning,
+		machine.KubernetesUpgradePreflightCheck_ERROR:   PreflightSeverityError,
+	} {
+		assert.Equal(t, expected, preflightSeverity(severity), severity.String())
+	}
+}
+
+func TestCheckPreflightReport(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name   string
+		checks []PreflightCheck
+		force  bool
+
+		expectedError string
+		expectedLog   []string
+	}{
+		{
+			name: "info only",
+			checks: []PreflightCheck{
+				{Name: "talos compatibility", Severity: PreflightSeverityInfo, Message: "compatible"},
+			},
+		},
+		{
+			name: "warnings",
+			checks: []PreflightCheck{
+				{Name: "talos compatibility", Severity: PreflightSeverityInfo, Message: "compatible"},
+				{Name: "pod disruption budget", Severity: PreflightSeverityWarning, Message: "blocked"},
+			},
+			expectedLog: []string{
+				`pre-flight warning: "": pod disruption budget: blocked`,
+			},
+		},
+		{
+			name: "errors",
+			checks: []PreflightCheck{
+				{Node: "worker-1", Name: "node readiness", Severity: PreflightSeverityError, Message: "node is not ready"},
+			},
+			expectedError: "pre-flight checks failed, use --force to proceed with the upgrade",
+			expectedLog: []string{
+				`pre-flight error: "worker-1": node readiness: node is not ready`,
+			},
+		},
+		{
+			name: "errors forced",
+			checks: []PreflightCheck{
+				{Node: "worker-1", Name: "node readiness", Severity: PreflightSeverityError, Message: "node is not ready"},
+			},
+			force: true,
+			expectedLog: []string{
+				`pre-flight error: "worker-1": node readiness: node is not ready`,
+				"pre-flight checks failed, proceeding with the upgrade anyway",
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var logOutput strings.Builder
+
+			err := checkPreflightReport(&PreflightReport{Checks: test.checks}, UpgradeOptions{
+				Force:     test.force,
+				LogOutput: &logOutput,
+			})
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, strings.Join(test.expectedLog, ""), logOutput.String())
+		})
+	}
+}
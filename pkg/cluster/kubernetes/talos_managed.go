@@ -19,7 +19,6 @@ import (
 	"github.com/siderolabs/gen/channel"
 	"github.com/siderolabs/gen/xiter"
 	"github.com/siderolabs/go-kubernetes/kubernetes/manifests"
-	"github.com/siderolabs/go-kubernetes/kubernetes/upgrade"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
@@ -32,6 +31,7 @@ import (
 	"github.com/siderolabs/talos/pkg/cluster"
 	"github.com/siderolabs/talos/pkg/machinery/api/common"
 	"github.com/siderolabs/talos/pkg/machinery/client"
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
 	machinetype "github.com/siderolabs/talos/pkg/machinery/config/machine"
 	v1alpha1config "github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
@@ -92,49 +92,27 @@ func Upgrade(ctx context.Context, cluster UpgradeProvider, options UpgradeOption
 
 	defer k8sClient.Close() //nolint:errcheck
 
-	options.controlPlaneNodes, err = k8sClient.NodeIPs(ctx, machinetype.TypeControlPlane)
-	if err != nil {
-		return fmt.Errorf("error fetching controlplane nodes: %w", err)
-	}
-
-	if len(options.controlPlaneNodes) == 0 {
-		return errors.New("no controlplane nodes discovered")
-	}
-
-	options.Log("discovered controlplane nodes %q", options.controlPlaneNodes)
-
-	if options.UpgradeKubelet {
-		options.workerNodes, err = k8sClient.NodeIPs(ctx, machinetype.TypeWorker)
-		if err != nil {
-			return fmt.Errorf("error fetching worker nodes: %w", err)
-		}
-
-		options.Log("discovered worker nodes %q", options.workerNodes)
-	}
-
-	talosClient, err := cluster.Client()
-	if err != nil {
+	if err = discoverNodes(ctx, k8sClient, &options); err != nil {
 		return err
 	}
 
-	k8sConfig, err := cluster.K8sRestConfig(ctx)
+	report, err := preflight(ctx, cluster, k8sClient, options, &PreflightReport{
+		FromVersion: options.Path.FromVersion(),
+		ToVersion:   options.Path.ToVersion(),
+	})
 	if err != nil {
-		return err
+		return fmt.Errorf("error running pre-flight checks: %w", err)
 	}
 
-	if err = VerifyVersionCompatibility(ctx, talosClient, slices.Concat(options.controlPlaneNodes, options.workerNodes), options.Path.ToVersion(), options.Log); err != nil {
+	if err = checkPreflightReport(report, options); err != nil {
 		return err
 	}
 
-	upgradeChecks, err := upgrade.NewChecks(options.Path, talosClient.COSI, k8sConfig, options.controlPlaneNodes, options.workerNodes, options.Log)
+	talosClient, err := cluster.Client()
 	if err != nil {
 		return err
 	}
 
-	if err = upgradeChecks.Run(ctx); err != nil {
-		return err
-	}
-
 	if options.PrePullImages {
 		if err = prePullImages(ctx, talosClient, options); err != nil {
 			return fmt.Errorf("failed pre-pulling images: %w", err)
@@ -479,9 +457,27 @@ func syncManifests(ctx context.Context, objects []*unstructured.Unstructured, cl
 		return err
 	}
 
+	objects = slices.DeleteFunc(objects, func(obj *unstructured.Unstructured) bool {
+		if obj.GetAnnotations()[constants.AnnotationManifestPolicy] != talosconfig.BootstrapManifestPolicyCreateOnly {
+			return false
+		}
+
+		options.Log(" > skipping create-only object %s %s", obj.GetKind(), manifestObjectName(obj))
+
+		return true
+	})
+
 	return manifests.SyncWithLog(ctx, objects, config, options.DryRun, options.Log)
 }
 
+func manifestObjectName(obj *unstructured.Unstructured) string {
+	if obj.GetNamespace() == "" {
+		return obj.GetName()
+	}
+
+	return obj.GetNamespace() + "/" + obj.GetName()
+}
+
 //nolint:gocyclo
 func checkPodStatus(ctx context.Context, cluster UpgradeProvider, options UpgradeOptions, service, node, configVersion string) error {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
@@ -30,6 +30,7 @@ type UpgradeOptions struct {
 	PrePullImages        bool
 	UpgradeKubelet       bool
 	DryRun               bool
+	Force                bool
 	EncoderOpt           encoder.Option
 
 	KubeletImage           string
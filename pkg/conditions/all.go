@@ -7,6 +7,7 @@ package conditions
 
 import (
 	"context"
+	"slices"
 	"strings"
 	"sync"
 
@@ -102,3 +103,23 @@ func WaitForAll(conditions ...Condition) Condition {
 
 	return res
 }
+
+// Unwrap returns the list of conditions combined by WaitForAll.
+//
+// If the condition is not a combination of conditions, it is returned as the only element.
+// Unwrap(nil) returns nil.
+func Unwrap(c Condition) []Condition {
+	if c == nil {
+		return nil
+	}
+
+	multi, ok := c.(*all)
+	if !ok {
+		return []Condition{c}
+	}
+
+	multi.mu.Lock()
+	defer multi.mu.Unlock()
+
+	return slices.DeleteFunc(slices.Clone(multi.conditions), func(c Condition) bool { return c == nil })
+}
@@ -108,6 +108,17 @@ func (suite *AllSuite) TestFlatten() {
 	suite.Require().Equal(context.Canceled, <-done)
 }
 
+func (suite *AllSuite) TestUnwrap() {
+	a := &MockCondition{description: "A"}
+	b := &MockCondition{description: "B"}
+	c := &MockCondition{description: "C"}
+
+	suite.Require().Nil(conditions.Unwrap(nil))
+	suite.Require().Equal([]conditions.Condition{a}, conditions.Unwrap(a))
+	suite.Require().Equal([]conditions.Condition{a, b, c}, conditions.Unwrap(conditions.WaitForAll(conditions.WaitForAll(a, b), c)))
+	suite.Require().Empty(conditions.Unwrap(conditions.WaitForAll()))
+}
+
 func TestAllSuite(t *testing.T) {
 	suite.Run(t, new(AllSuite))
 }
@@ -15,6 +15,8 @@ import (
 	"github.com/siderolabs/crypto/x509"
 	"github.com/siderolabs/go-retry/retry"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/siderolabs/talos/pkg/grpc/middleware/auth/basic"
 	securityapi "github.com/siderolabs/talos/pkg/machinery/api/security"
@@ -24,6 +26,9 @@ import (
 
 var remoteGeneratorPprof = pprof.NewProfile("pkg/grpc/gen.RemoteGenerator")
 
+// ErrCertificateDenied is returned when the certificate request is refused by the signing policy of trustd.
+var ErrCertificateDenied = errors.New("trustd refused the certificate request")
+
 // RemoteGenerator represents the OS identity generator.
 type RemoteGenerator struct {
 	conn   *grpc.ClientConn
@@ -76,6 +81,11 @@ func (g *RemoteGenerator) IdentityContext(ctx context.Context, csr *x509.Certifi
 
 		resp, err = g.client.Certificate(ctx, req)
 		if err != nil {
+			// the policy refusal is not going to change on retry
+			if status.Code(err) == codes.PermissionDenied {
+				return fmt.Errorf("%w: %s", ErrCertificateDenied, status.Convert(err).Message())
+			}
+
 			return retry.ExpectedError(err)
 		}
 
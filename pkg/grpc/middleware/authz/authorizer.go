@@ -6,6 +6,8 @@ package authz
 
 import (
 	"context"
+	"path"
+	"slices"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -17,6 +19,15 @@ import (
 // ErrNotAuthorized should be returned to the client when they are not authorized.
 var ErrNotAuthorized = status.Error(codes.PermissionDenied, "not authorized")
 
+// PolicyRule allows the roles to call gRPC methods matching the patterns.
+type PolicyRule struct {
+	// Roles the rule applies to.
+	Roles role.Set
+
+	// Full gRPC method name patterns, see path.Match for the syntax.
+	Methods []string
+}
+
 // Authorizer checks that the user is authorized (has a valid role) to call intercepted gRPC method.
 // User roles should be set the Injector interceptor.
 type Authorizer struct {
@@ -26,6 +37,15 @@ type Authorizer struct {
 	// Defines roles for gRPC methods not present in Rules.
 	FallbackRoles role.Set
 
+	// Returns policy rules on top of Rules, might be nil.
+	//
+	// Roles mentioned in the policy rules are allowed to call only the methods listed in the policy rules,
+	// built-in permissions of such roles in Rules and FallbackRoles are ignored.
+	Policy func() []PolicyRule
+
+	// Called when the user is not authorized, might be nil.
+	OnDenied func(ctx context.Context, method string, clientRoles role.Set)
+
 	// Logger.
 	Logger func(format string, v ...any)
 }
@@ -46,13 +66,41 @@ func (a *Authorizer) authorize(ctx context.Context, method string) error {
 	}
 
 	clientRoles := GetRoles(ctx)
-	if allowedRoles.IncludesAny(clientRoles) {
-		a.logf("authorized (%v includes %v)", allowedRoles.Strings(), clientRoles.Strings())
+
+	// roles restricted by the policy don't get the built-in permissions
+	builtinRoles := clientRoles
+
+	if a.Policy != nil {
+		for _, rule := range a.Policy() {
+			if !rule.Roles.IncludesAny(clientRoles) {
+				continue
+			}
+
+			if slices.ContainsFunc(rule.Methods, func(pattern string) bool {
+				matched, _ := path.Match(pattern, method) //nolint:errcheck
+
+				return matched
+			}) {
+				a.logf("authorized by policy (%v includes %v)", rule.Roles.Strings(), clientRoles.Strings())
+
+				return nil
+			}
+
+			builtinRoles = builtinRoles.Difference(rule.Roles)
+		}
+	}
+
+	if allowedRoles.IncludesAny(builtinRoles) {
+		a.logf("authorized (%v includes %v)", allowedRoles.Strings(), builtinRoles.Strings())
 
 		return nil
 	}
 
-	a.logf("not authorized (%v doesn't include %v)", allowedRoles.Strings(), clientRoles.Strings())
+	a.logf("not authorized (%v doesn't include %v)", allowedRoles.Strings(), builtinRoles.Strings())
+
+	if a.OnDenied != nil {
+		a.OnDenied(ctx, method, clientRoles)
+	}
 
 	return ErrNotAuthorized
 }
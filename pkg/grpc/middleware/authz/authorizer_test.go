@@ -4,11 +4,124 @@
 
 package authz_test
 
-import "testing"
+import (
+	"context"
+	"testing"
 
-func TestEmpty(t *testing.T) {
-	// added for accurate coverage estimation
-	//
-	// please remove it once any unit-test is added
-	// for this package
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	"github.com/siderolabs/talos/pkg/grpc/middleware/authz"
+	"github.com/siderolabs/talos/pkg/machinery/role"
+)
+
+func TestAuthorizerPolicy(t *testing.T) {
+	t.Parallel()
+
+	const rebooter = role.Role("ops:rebooter")
+
+	var denied []string
+
+	authorizer := &authz.Authorizer{
+		Rules: map[string]role.Set{
+			"/machine.MachineService/Reboot":  role.MakeSet(role.Admin, role.Operator),
+			"/machine.MachineService/Version": role.MakeSet(role.Admin, role.Operator, role.Reader),
+		},
+		FallbackRoles: role.MakeSet(role.Admin),
+		Policy: func() []authz.PolicyRule {
+			return []authz.PolicyRule{
+				{
+					Roles:   role.MakeSet(rebooter),
+					Methods: []string{"/machine.MachineService/Reboot"},
+				},
+				{
+					Roles:   role.MakeSet(role.Reader),
+					Methods: []string{"/machine.MachineService/Dmesg", "/storage.StorageService/*"},
+				},
+			}
+		},
+		OnDenied: func(_ context.Context, method string, clientRoles role.Set) {
+			denied = append(denied, method)
+
+			assert.NotEmpty(t, clientRoles.Strings())
+		},
+	}
+
+	interceptor := authorizer.UnaryInterceptor()
+
+	call := func(method string, roles ...role.Role) error {
+		ctx := authz.ContextWithRoles(t.Context(), role.MakeSet(roles...))
+
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(context.Context, any) (any, error) {
+			return nil, nil //nolint:nilnil
+		})
+
+		return err
+	}
+
+	for _, test := range []struct {
+		name   string
+		method string
+		roles  []role.Role
+
+		allowed bool
+	}{
+		{
+			name:    "custom role allowed by policy",
+			method:  "/machine.MachineService/Reboot",
+			roles:   []role.Role{rebooter},
+			allowed: true,
+		},
+		{
+			name:   "custom role denied by default",
+			method: "/machine.MachineService/Version",
+			roles:  []role.Role{rebooter},
+		},
+		{
+			name:   "unknown custom role",
+			method: "/machine.MachineService/Reboot",
+			roles:  []role.Role{"ops:other"},
+		},
+		{
+			name:    "built-in role not mentioned in the policy",
+			method:  "/machine.MachineService/Version",
+			roles:   []role.Role{role.Operator},
+			allowed: true,
+		},
+		{
+			name:    "built-in role extended by the policy",
+			method:  "/storage.StorageService/Disks",
+			roles:   []role.Role{role.Reader},
+			allowed: true,
+		},
+		{
+			name:   "built-in role restricted by the policy",
+			method: "/machine.MachineService/Version",
+			roles:  []role.Role{role.Reader},
+		},
+		{
+			name:    "built-in role and custom role",
+			method:  "/machine.MachineService/Version",
+			roles:   []role.Role{role.Operator, rebooter},
+			allowed: true,
+		},
+		{
+			name:    "admin is not restricted",
+			method:  "/machine.MachineService/Upgrade",
+			roles:   []role.Role{role.Admin, rebooter},
+			allowed: true,
+		},
+	} {
+		if test.allowed {
+			assert.NoError(t, call(test.method, test.roles...), test.name)
+		} else {
+			assert.ErrorIs(t, call(test.method, test.roles...), authz.ErrNotAuthorized, test.name)
+		}
+	}
+
+	assert.Equal(t, []string{
+		"/machine.MachineService/Version",
+		"/machine.MachineService/Reboot",
+		"/machine.MachineService/Version",
+	}, denied)
 }
@@ -48,7 +48,7 @@ var (
 // SideroLinkPeerCheckFunc checks if the peer is a SideroLink peer.
 type SideroLinkPeerCheckFunc func(ctx context.Context) (netip.Addr, bool)
 
-// Injector sets roles to the context.
+// Injector sets roles and the client identity to the context.
 type Injector struct {
 	// Mode.
 	Mode InjectorMode
@@ -151,6 +151,7 @@ func (i *Injector) extractRoles(ctx context.Context) role.Set {
 func (i *Injector) UnaryInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
 		ctx = ContextWithRoles(ctx, i.extractRoles(ctx))
+		ctx = ContextWithPeer(ctx, extractPeer(ctx))
 
 		return handler(ctx, req)
 	}
@@ -161,6 +162,7 @@ func (i *Injector) StreamInterceptor() grpc.StreamServerInterceptor {
 	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
 		ctx := stream.Context()
 		ctx = ContextWithRoles(ctx, i.extractRoles(ctx))
+		ctx = ContextWithPeer(ctx, extractPeer(ctx))
 
 		wrapped := grpc_middleware.WrapServerStream(stream)
 		wrapped.WrappedContext = ctx
@@ -0,0 +1,107 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package authz
+
+import (
+	"context"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/role"
+)
+
+// peerMdKey is used to store the client identity in gRPC metadata.
+const peerMdKey = constants.APIAuthzPeerMetadataKey
+
+// peerCtxKey is used to store the client identity in the context.
+type peerCtxKey struct{}
+
+// GetPeer returns the authenticated client identity stored in the context by the Injector interceptor.
+//
+// The identity is the subject of the client certificate, or the peer address if there is no client certificate.
+func GetPeer(ctx context.Context) string {
+	identity, _ := ctx.Value(peerCtxKey{}).(string) //nolint:errcheck
+
+	return identity
+}
+
+// ContextWithPeer returns derived context with the client identity set.
+func ContextWithPeer(ctx context.Context, identity string) context.Context {
+	return context.WithValue(ctx, peerCtxKey{}, identity)
+}
+
+// SetPeerMetadata sets the client identity in gRPC metadata, replacing the one submitted by the client.
+func SetPeerMetadata(md metadata.MD, identity string) {
+	if identity == "" {
+		delete(md, peerMdKey)
+
+		return
+	}
+
+	md.Set(peerMdKey, identity)
+}
+
+// extractPeer returns the client identity from the client certificate (in case of the first apid instance),
+// or from gRPC metadata (in case of subsequent apid instances, machined, or user with impersonator role).
+func extractPeer(ctx context.Context) string {
+	remotePeer, ok := peer.FromContext(ctx)
+	if !ok {
+		return peerFromMetadata(ctx)
+	}
+
+	tlsInfo, isTLS := remotePeer.AuthInfo.(credentials.TLSInfo)
+	if !isTLS {
+		// local socket, the requests are proxied by apid
+		if identity := peerFromMetadata(ctx); identity != "" {
+			return identity
+		}
+
+		return peerAddr(remotePeer)
+	}
+
+	if len(tlsInfo.State.PeerCertificates) == 0 {
+		// no client certificate (e.g. maintenance mode)
+		return peerAddr(remotePeer)
+	}
+
+	cert := tlsInfo.State.PeerCertificates[0]
+
+	// trust gRPC metadata from clients with impersonator role (requests proxied from other apid instances)
+	if roles, _ := role.Parse(cert.Subject.Organization); roles.Includes(role.Impersonator) {
+		if identity := peerFromMetadata(ctx); identity != "" {
+			return identity
+		}
+	}
+
+	if subject := cert.Subject.String(); subject != "" {
+		return subject
+	}
+
+	return peerAddr(remotePeer)
+}
+
+func peerFromMetadata(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	if values := md.Get(peerMdKey); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
+
+func peerAddr(remotePeer *peer.Peer) string {
+	if remotePeer.Addr == nil {
+		return ""
+	}
+
+	return remotePeer.Addr.String()
+}
@@ -0,0 +1,118 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package authz_test
+
+import (
+	"context"
+	"crypto/tls"
+	stdx509 "crypto/x509"
+	"crypto/x509/pkix"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/siderolabs/talos/pkg/grpc/middleware/authz"
+)
+
+func TestInjectorPeer(t *testing.T) {
+	t.Parallel()
+
+	tcpAddr := &net.TCPAddr{IP: net.ParseIP("172.20.0.1"), Port: 52000}
+
+	tlsPeer := func(organizations ...string) *peer.Peer {
+		return &peer.Peer{
+			Addr: tcpAddr,
+			AuthInfo: credentials.TLSInfo{
+				State: tls.ConnectionState{
+					PeerCertificates: []*stdx509.Certificate{
+						{
+							Subject: pkix.Name{Organization: organizations},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	spoofed := metadata.Pairs("talos-peer", "O=os:admin")
+
+	for _, test := range []struct {
+		name string
+		peer *peer.Peer
+		md   metadata.MD
+
+		expected string
+	}{
+		{
+			name:     "client certificate",
+			peer:     tlsPeer("os:operator"),
+			md:       spoofed,
+			expected: "O=os:operator",
+		},
+		{
+			name:     "impersonator",
+			peer:     tlsPeer("os:impersonator"),
+			md:       spoofed,
+			expected: "O=os:admin",
+		},
+		{
+			name:     "impersonator without metadata",
+			peer:     tlsPeer("os:impersonator"),
+			expected: "O=os:impersonator",
+		},
+		{
+			name:     "empty subject",
+			peer:     tlsPeer(),
+			expected: "172.20.0.1:52000",
+		},
+		{
+			name: "no client certificate",
+			peer: &peer.Peer{
+				Addr:     tcpAddr,
+				AuthInfo: credentials.TLSInfo{},
+			},
+			md:       spoofed,
+			expected: "172.20.0.1:52000",
+		},
+		{
+			name: "local socket",
+			peer: &peer.Peer{
+				Addr: &net.UnixAddr{Name: "/system/run/machined/machine.sock", Net: "unix"},
+			},
+			md:       spoofed,
+			expected: "O=os:admin",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			md := test.md
+			if md == nil {
+				md = metadata.MD{}
+			}
+
+			ctx := metadata.NewIncomingContext(peer.NewContext(t.Context(), test.peer), md)
+
+			injector := &authz.Injector{Mode: authz.Disabled}
+
+			var identity string
+
+			_, err := injector.UnaryInterceptor()(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, _ any) (any, error) {
+				identity = authz.GetPeer(ctx)
+
+				return nil, nil //nolint:nilnil
+			})
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, identity)
+		})
+	}
+}
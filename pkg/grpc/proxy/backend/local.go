@@ -46,6 +46,7 @@ func (l *Local) GetConnection(ctx context.Context, _ string) (context.Context, *
 	md = md.Copy()
 
 	authz.SetMetadata(md, authz.GetRoles(ctx))
+	authz.SetPeerMetadata(md, authz.GetPeer(ctx))
 
 	outCtx := metadata.NewOutgoingContext(ctx, md)
 
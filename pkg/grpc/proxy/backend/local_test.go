@@ -67,3 +67,30 @@ func TestLocalGetConnection(t *testing.T) {
 		assert.Equal(t, []string{"os:reader"}, mdOut3.Get("talos-role"))
 	})
 }
+
+func TestLocalGetConnectionPeer(t *testing.T) {
+	t.Parallel()
+
+	l := backend.NewLocal("test", "/tmp/test.sock")
+
+	// the client identity submitted by the client is replaced
+	md := metadata.New(nil)
+	md.Set("talos-peer", "spoofed")
+
+	ctx := authz.ContextWithPeer(authz.ContextWithRoles(t.Context(), role.MakeSet(role.Admin)), "O=os:admin")
+
+	outCtx, _, err := l.GetConnection(metadata.NewIncomingContext(ctx, md), "")
+	assert.NoError(t, err)
+
+	mdOut, ok := metadata.FromOutgoingContext(outCtx)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"O=os:admin"}, mdOut.Get("talos-peer"))
+
+	// no client identity, the submitted one is dropped
+	outCtx, _, err = l.GetConnection(metadata.NewIncomingContext(authz.ContextWithRoles(t.Context(), role.MakeSet(role.Admin)), md), "")
+	assert.NoError(t, err)
+
+	mdOut, ok = metadata.FromOutgoingContext(outCtx)
+	assert.True(t, ok)
+	assert.Empty(t, mdOut.Get("talos-peer"))
+}
@@ -6,7 +6,6 @@
 package httpdefaults
 
 import (
-	"crypto/tls"
 	"net/http"
 	"net/url"
 
@@ -26,9 +25,7 @@ func PatchTransport(transport *http.Transport) *http.Transport {
 
 	// Override the TLS config to allow refreshing CA list which might be updated
 	// via the machine config on the fly.
-	transport.TLSClientConfig = &tls.Config{
-		RootCAs: RootCAs(),
-	}
+	transport.TLSClientConfig = TLSConfig()
 
 	return transport
 }
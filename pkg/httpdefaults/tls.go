@@ -5,7 +5,9 @@
 package httpdefaults
 
 import (
+	"crypto/tls"
 	"crypto/x509"
+	"errors"
 	"io/fs"
 	"os"
 	"sync"
@@ -54,3 +56,35 @@ func RootCAs() *x509.CertPool {
 
 	return cachedPool.Clone()
 }
+
+// TLSConfig returns TLS client configuration which verifies server certificates against RootCAs.
+//
+// RootCAs are evaluated on each handshake, so long-lived transports pick up changes
+// to the trusted roots without being re-created.
+func TLSConfig() *tls.Config {
+	return &tls.Config{
+		// standard verification is replaced with VerifyConnection below
+		InsecureSkipVerify: true, //nolint:gosec
+		VerifyConnection:   verifyConnection,
+	}
+}
+
+func verifyConnection(cs tls.ConnectionState) error {
+	if len(cs.PeerCertificates) == 0 {
+		return errors.New("no peer certificates presented")
+	}
+
+	opts := x509.VerifyOptions{
+		Roots:         RootCAs(),
+		DNSName:       cs.ServerName,
+		Intermediates: x509.NewCertPool(),
+	}
+
+	for _, cert := range cs.PeerCertificates[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+
+	_, err := cs.PeerCertificates[0].Verify(opts)
+
+	return err
+}
@@ -0,0 +1,56 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package imager
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/siderolabs/go-copy/copy"
+
+	"github.com/siderolabs/talos/pkg/imager/profile"
+)
+
+// assetCache keeps extracted container assets shared between the variants of the profile.
+type assetCache struct {
+	dir     string
+	entries map[assetCacheKey]string
+}
+
+type assetCacheKey struct {
+	asset profile.ContainerAsset
+	arch  string
+}
+
+// extractAsset extracts the container asset to the destination, re-using the extracted copy if the cache is enabled.
+func (i *Imager) extractAsset(ctx context.Context, asset profile.ContainerAsset, destination, arch string, printf func(string, ...any)) error {
+	if i.assetCache == nil {
+		return asset.Extract(ctx, destination, arch, printf)
+	}
+
+	key := assetCacheKey{asset: asset, arch: arch}
+
+	cachedPath, ok := i.assetCache.entries[key]
+	if ok {
+		printf("re-using extracted container asset")
+	} else {
+		cachedPath = filepath.Join(i.assetCache.dir, strconv.Itoa(len(i.assetCache.entries)))
+
+		if err := os.MkdirAll(cachedPath, 0o755); err != nil {
+			return fmt.Errorf("failed to create cache directory: %w", err)
+		}
+
+		if err := asset.Extract(ctx, cachedPath, arch, printf); err != nil {
+			return err
+		}
+
+		i.assetCache.entries[key] = cachedPath
+	}
+
+	return copy.Dir(cachedPath, destination)
+}
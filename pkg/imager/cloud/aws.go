@@ -0,0 +1,105 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cloud
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+)
+
+// AWS disk image formats.
+const (
+	AWSFormatRaw  = "RAW"
+	AWSFormatVMDK = "VMDK"
+)
+
+const (
+	vmdkMagic      = 0x564d444b // "KDMV"
+	vmdkSectorSize = 512
+)
+
+// AWSImportManifest is the disk container description for `aws ec2 import-snapshot --disk-container`.
+type AWSImportManifest struct {
+	Description string        `json:"Description"`
+	Format      string        `json:"Format"`
+	UserBucket  AWSUserBucket `json:"UserBucket"`
+}
+
+// AWSUserBucket describes the location of the disk image in S3.
+type AWSUserBucket struct {
+	S3Bucket string `json:"S3Bucket"`
+	S3Key    string `json:"S3Key"`
+}
+
+// NewAWSImportManifest creates the import manifest for the disk image uploaded to the S3 bucket under the key prefix.
+func NewAWSImportManifest(imagePath, format, bucket, keyPrefix, description string) *AWSImportManifest {
+	return &AWSImportManifest{
+		Description: description,
+		Format:      format,
+		UserBucket: AWSUserBucket{
+			S3Bucket: bucket,
+			S3Key:    path.Join(keyPrefix, filepath.Base(imagePath)),
+		},
+	}
+}
+
+// Write writes the import manifest to the file.
+func (manifest *AWSImportManifest) Write(path string) error {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// ValidateAWSImage validates the disk image for the EBS snapshot import.
+func ValidateAWSImage(path, format string) error {
+	switch format {
+	case AWSFormatRaw:
+		st, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+
+		return validateSize("aws", st.Size(), AWSAlignment, AWSMaxSize)
+	case AWSFormatVMDK:
+		capacity, err := vmdkCapacity(path)
+		if err != nil {
+			return err
+		}
+
+		return validateSize("aws", capacity, AWSAlignment, AWSMaxSize)
+	default:
+		return fmt.Errorf("aws: unsupported disk image format %q", format)
+	}
+}
+
+// vmdkCapacity returns the capacity of the sparse (stream optimized) VMDK in bytes.
+func vmdkCapacity(path string) (int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	// magic (4), version (4), flags (4), capacity in sectors (8)
+	header := make([]byte, 20)
+
+	if _, err = f.ReadAt(header, 0); err != nil {
+		return 0, fmt.Errorf("aws: error reading VMDK header: %w", err)
+	}
+
+	if binary.LittleEndian.Uint32(header[0:4]) != vmdkMagic {
+		return 0, fmt.Errorf("aws: %s is not a sparse VMDK", filepath.Base(path))
+	}
+
+	return int64(binary.LittleEndian.Uint64(header[12:20])) * vmdkSectorSize, nil
+}
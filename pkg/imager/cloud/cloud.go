@@ -0,0 +1,59 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package cloud implements the cloud specific disk image artifacts, and their validation.
+//
+// The artifacts can be imported into the cloud as is:
+//   - GCE: gzipped tarball containing a single `disk.raw` file, sized in whole GiB
+//   - Azure: fixed VHD sized in whole MiB, with the VHD footer
+//   - AWS: raw or stream optimized VMDK disk image sized in whole GiB, with an optional import manifest
+package cloud
+
+import (
+	"fmt"
+)
+
+const (
+	mib = 1024 * 1024
+	gib = 1024 * mib
+)
+
+// Disk size constraints of the cloud platforms.
+const (
+	// GCEAlignment is the disk image size alignment for GCE, images are sized in whole GiB.
+	GCEAlignment = gib
+	// GCEMaxSize is the maximum disk image size for GCE.
+	GCEMaxSize = 2048 * gib
+
+	// AzureAlignment is the disk image size alignment for Azure, the virtual size of the VHD should be in whole MiB.
+	AzureAlignment = mib
+	// AzureMaxSize is the maximum size of the VHD.
+	AzureMaxSize = 2040 * gib
+
+	// AWSAlignment is the disk image size alignment for AWS, EBS snapshots are sized in whole GiB.
+	AWSAlignment = gib
+	// AWSMaxSize is the maximum disk image size for the EBS snapshot import.
+	AWSMaxSize = 16384 * gib
+)
+
+// AlignSize rounds up the size to the alignment.
+func AlignSize(size, alignment int64) int64 {
+	return (size + alignment - 1) / alignment * alignment
+}
+
+func validateSize(platform string, size, alignment, maxSize int64) error {
+	if size == 0 {
+		return fmt.Errorf("%s: disk image is empty", platform)
+	}
+
+	if size%alignment != 0 {
+		return fmt.Errorf("%s: disk image size %d is not aligned to %d bytes", platform, size, alignment)
+	}
+
+	if size > maxSize {
+		return fmt.Errorf("%s: disk image size %d exceeds the maximum size %d", platform, size, maxSize)
+	}
+
+	return nil
+}
@@ -0,0 +1,236 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cloud_test
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/imager/cloud"
+)
+
+const (
+	mib = 1024 * 1024
+	gib = 1024 * mib
+)
+
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	clear(p)
+
+	return len(p), nil
+}
+
+func writeRawImage(t *testing.T, size int64) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "disk.raw")
+
+	f, err := os.Create(path)
+	require.NoError(t, err)
+
+	require.NoError(t, f.Truncate(size))
+	require.NoError(t, f.Close())
+
+	return path
+}
+
+func writeTarball(t *testing.T, files map[string]int64) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "gcp-amd64.raw.tar.gz")
+
+	f, err := os.Create(path)
+	require.NoError(t, err)
+
+	gz, err := gzip.NewWriterLevel(f, gzip.BestSpeed)
+	require.NoError(t, err)
+
+	tw := tar.NewWriter(gz)
+
+	for name, size := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name:     name,
+			Size:     size,
+			Mode:     0o644,
+			Typeflag: tar.TypeReg,
+			Format:   tar.FormatGNU,
+		}))
+
+		_, err = io.CopyN(tw, zeroReader{}, size)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	require.NoError(t, f.Close())
+
+	return path
+}
+
+func TestGCE(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("skipping in short mode")
+	}
+
+	require.NoError(t, cloud.ValidateGCETar(writeTarball(t, map[string]int64{cloud.GCEDiskName: gib})))
+
+	assert.EqualError(t, cloud.ValidateGCETar(writeTarball(t, map[string]int64{"disk.img": gib})),
+		`gce: unexpected file "disk.img" in the tarball, expected "disk.raw"`)
+	assert.EqualError(t, cloud.ValidateGCETar(writeTarball(t, map[string]int64{cloud.GCEDiskName: 100 * mib})),
+		"gce: disk image size 104857600 is not aligned to 1073741824 bytes")
+	assert.EqualError(t, cloud.ValidateGCETar(writeRawImage(t, gib)),
+		"gce: invalid gzip stream: gzip: invalid header")
+}
+
+func TestAzureVHD(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		size int64
+
+		expectedSize     int64
+		expectedGeometry [3]int
+	}{
+		{
+			name:             "aligned",
+			size:             8 * gib,
+			expectedSize:     8 * gib,
+			expectedGeometry: [3]int{16644, 16, 63},
+		},
+		{
+			name:             "unaligned",
+			size:             100*mib + 1,
+			expectedSize:     101 * mib,
+			expectedGeometry: [3]int{1013, 12, 17},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := writeRawImage(t, test.size)
+			timestamp := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+			require.NoError(t, cloud.ConvertToFixedVHD(path, timestamp))
+			require.NoError(t, cloud.ValidateFixedVHD(path))
+
+			st, err := os.Stat(path)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expectedSize+512, st.Size())
+
+			footer := make([]byte, 512)
+
+			f, err := os.Open(path)
+			require.NoError(t, err)
+
+			t.Cleanup(func() { f.Close() }) //nolint:errcheck
+
+			_, err = f.ReadAt(footer, test.expectedSize)
+			require.NoError(t, err)
+
+			assert.Equal(t, "conectix", string(footer[0:8]))
+			assert.Equal(t, uint64(0xFFFFFFFFFFFFFFFF), binary.BigEndian.Uint64(footer[16:24]))
+			assert.Equal(t, uint32(timestamp.Sub(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC))/time.Second), binary.BigEndian.Uint32(footer[24:28]))
+			assert.EqualValues(t, test.expectedSize, binary.BigEndian.Uint64(footer[40:48]))
+			assert.EqualValues(t, test.expectedSize, binary.BigEndian.Uint64(footer[48:56]))
+			assert.Equal(t, test.expectedGeometry, [3]int{int(binary.BigEndian.Uint16(footer[56:58])), int(footer[58]), int(footer[59])})
+			assert.EqualValues(t, 2, binary.BigEndian.Uint32(footer[60:64]))
+
+			// the output is reproducible
+			path2 := writeRawImage(t, test.size)
+
+			require.NoError(t, cloud.ConvertToFixedVHD(path2, timestamp))
+
+			footer2 := make([]byte, 512)
+
+			f2, err := os.Open(path2)
+			require.NoError(t, err)
+
+			t.Cleanup(func() { f2.Close() }) //nolint:errcheck
+
+			_, err = f2.ReadAt(footer2, test.expectedSize)
+			require.NoError(t, err)
+
+			assert.Equal(t, footer, footer2)
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		assert.EqualError(t, cloud.ValidateFixedVHD(writeRawImage(t, mib)), "azure: VHD footer not found")
+
+		path := writeRawImage(t, mib)
+
+		require.NoError(t, cloud.ConvertToFixedVHD(path, time.Now()))
+
+		f, err := os.OpenFile(path, os.O_RDWR, 0)
+		require.NoError(t, err)
+
+		// corrupt the disk type
+		_, err = f.WriteAt([]byte{0, 0, 0, 3}, mib+60)
+		require.NoError(t, err)
+
+		require.NoError(t, f.Close())
+
+		assert.ErrorContains(t, cloud.ValidateFixedVHD(path), "azure: VHD footer checksum mismatch")
+	})
+}
+
+func TestAWS(t *testing.T) {
+	t.Parallel()
+
+	raw := writeRawImage(t, 2*gib)
+
+	require.NoError(t, cloud.ValidateAWSImage(raw, cloud.AWSFormatRaw))
+	assert.EqualError(t, cloud.ValidateAWSImage(writeRawImage(t, gib+mib), cloud.AWSFormatRaw),
+		"aws: disk image size 1074790400 is not aligned to 1073741824 bytes")
+	assert.EqualError(t, cloud.ValidateAWSImage(raw, cloud.AWSFormatVMDK), "aws: disk.raw is not a sparse VMDK")
+
+	vmdk := filepath.Join(t.TempDir(), "aws-amd64.vmdk")
+	header := make([]byte, 512)
+
+	copy(header, "KDMV")
+	binary.LittleEndian.PutUint32(header[4:8], 3)
+	binary.LittleEndian.PutUint64(header[12:20], 2*gib/512)
+
+	require.NoError(t, os.WriteFile(vmdk, header, 0o644))
+	require.NoError(t, cloud.ValidateAWSImage(vmdk, cloud.AWSFormatVMDK))
+
+	manifestPath := filepath.Join(t.TempDir(), "aws-amd64.vmdk.import.json")
+
+	require.NoError(t, cloud.NewAWSImportManifest(vmdk, cloud.AWSFormatVMDK, "talos-images", "v1.12.0", "Talos v1.12.0").Write(manifestPath))
+
+	data, err := os.ReadFile(manifestPath)
+	require.NoError(t, err)
+
+	var manifest map[string]any
+
+	require.NoError(t, json.Unmarshal(data, &manifest))
+
+	assert.Equal(t, map[string]any{
+		"Description": "Talos v1.12.0",
+		"Format":      "VMDK",
+		"UserBucket": map[string]any{
+			"S3Bucket": "talos-images",
+			"S3Key":    "v1.12.0/aws-amd64.vmdk",
+		},
+	}, manifest)
+}
@@ -0,0 +1,66 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cloud
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// GCEDiskName is the name of the disk image in the GCE tarball.
+const GCEDiskName = "disk.raw"
+
+// ValidateGCETar validates the GCE image tarball.
+//
+// The tarball should be gzipped, and it should contain only the `disk.raw` file.
+func ValidateGCETar(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return fmt.Errorf("gce: invalid gzip stream: %w", err)
+	}
+
+	defer gz.Close() //nolint:errcheck
+
+	tr := tar.NewReader(gz)
+
+	hdr, err := tr.Next()
+	if err != nil {
+		return fmt.Errorf("gce: invalid tarball: %w", err)
+	}
+
+	if hdr.Name != GCEDiskName {
+		return fmt.Errorf("gce: unexpected file %q in the tarball, expected %q", hdr.Name, GCEDiskName)
+	}
+
+	if hdr.Typeflag != tar.TypeReg && hdr.Typeflag != tar.TypeGNUSparse {
+		return fmt.Errorf("gce: %q is not a regular file", hdr.Name)
+	}
+
+	if err = validateSize("gce", hdr.Size, GCEAlignment, GCEMaxSize); err != nil {
+		return err
+	}
+
+	_, err = tr.Next()
+
+	switch {
+	case errors.Is(err, io.EOF):
+		return nil
+	case err != nil:
+		return fmt.Errorf("gce: invalid tarball: %w", err)
+	default:
+		return fmt.Errorf("gce: tarball should contain only %q", GCEDiskName)
+	}
+}
@@ -0,0 +1,432 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package delta implements binary deltas between the imager outputs.
+//
+// The delta is a zstd compressed stream of operations which either copy a range of the base file,
+// or insert the literal data. Matching ranges are found with a rolling checksum over the blocks of the base file
+// (rsync algorithm), so that the content shifted in the target is still matched.
+package delta
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// DefaultBlockSize is the default size of the matched blocks.
+const DefaultBlockSize = 4096
+
+var magic = []byte("TDELTA01")
+
+const (
+	opEnd byte = iota
+	opCopy
+	opData
+)
+
+// encode writes the delta between the base and the target to w.
+func encode(base io.Reader, target io.Reader, w io.Writer, blockSize int) error {
+	idx, err := buildIndex(base, blockSize)
+	if err != nil {
+		return fmt.Errorf("error indexing the base: %w", err)
+	}
+
+	enc := encoder{
+		w:         bufio.NewWriter(w),
+		index:     idx,
+		blockSize: blockSize,
+	}
+
+	if _, err = enc.w.Write(magic); err != nil {
+		return err
+	}
+
+	if err = enc.run(target); err != nil {
+		return err
+	}
+
+	if err = enc.w.WriteByte(opEnd); err != nil {
+		return err
+	}
+
+	return enc.w.Flush()
+}
+
+type blockRef struct {
+	offset int64
+	strong [sha256.Size]byte
+}
+
+// buildIndex indexes the non-overlapping blocks of the base by the rolling checksum.
+func buildIndex(base io.Reader, blockSize int) (map[uint32][]blockRef, error) {
+	idx := map[uint32][]blockRef{}
+	buf := make([]byte, blockSize)
+
+	var offset int64
+
+	for {
+		_, err := io.ReadFull(base, buf)
+		if err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				// the trailing partial block is not indexed
+				return idx, nil
+			}
+
+			return nil, err
+		}
+
+		var h rollingHash
+
+		h.init(buf)
+
+		weak := h.sum()
+		strong := sha256.Sum256(buf)
+
+		// identical blocks (e.g. zeroes) are only indexed once
+		duplicate := false
+
+		for _, ref := range idx[weak] {
+			if ref.strong == strong {
+				duplicate = true
+
+				break
+			}
+		}
+
+		if !duplicate {
+			idx[weak] = append(idx[weak], blockRef{offset: offset, strong: strong})
+		}
+
+		offset += int64(blockSize)
+	}
+}
+
+type encoder struct {
+	w         *bufio.Writer
+	index     map[uint32][]blockRef
+	blockSize int
+
+	// pending copy operation, adjacent copies are merged
+	copyOffset, copyLength int64
+}
+
+//nolint:gocyclo
+func (enc *encoder) run(target io.Reader) error {
+	var (
+		data     = make([]byte, 0, 64*enc.blockSize)
+		p        int // start of the window
+		litStart int // start of the pending literal data
+		eof      bool
+		h        rollingHash
+		hashed   bool
+	)
+
+	// fill makes sure that at least n bytes starting from the window are in the buffer, unless the target is exhausted
+	fill := func(n int) error {
+		if len(data)-p >= n || eof {
+			return nil
+		}
+
+		// flush the pending literal data before the buffer is compacted
+		if err := enc.emitData(data[litStart:p]); err != nil {
+			return err
+		}
+
+		data = data[:copy(data[:cap(data)], data[p:])]
+		p, litStart = 0, 0
+
+		for len(data) < n && !eof {
+			read, err := target.Read(data[len(data):cap(data)])
+			data = data[:len(data)+read]
+
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					eof = true
+
+					break
+				}
+
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	for {
+		if err := fill(enc.blockSize); err != nil {
+			return err
+		}
+
+		if len(data)-p < enc.blockSize {
+			// the tail is shorter than the block
+			if err := enc.emitData(data[litStart:]); err != nil {
+				return err
+			}
+
+			return enc.flushCopy()
+		}
+
+		window := data[p : p+enc.blockSize]
+
+		if !hashed {
+			h.init(window)
+
+			hashed = true
+		}
+
+		if offset, ok := enc.lookup(h.sum(), window); ok {
+			if err := enc.emitData(data[litStart:p]); err != nil {
+				return err
+			}
+
+			if err := enc.emitCopy(offset, int64(enc.blockSize)); err != nil {
+				return err
+			}
+
+			p += enc.blockSize
+			litStart = p
+			hashed = false
+
+			continue
+		}
+
+		// roll the window by one byte
+		if err := fill(enc.blockSize + 1); err != nil {
+			return err
+		}
+
+		if len(data)-p <= enc.blockSize {
+			if err := enc.emitData(data[litStart:]); err != nil {
+				return err
+			}
+
+			return enc.flushCopy()
+		}
+
+		h.roll(data[p], data[p+enc.blockSize])
+		p++
+	}
+}
+
+func (enc *encoder) lookup(weak uint32, window []byte) (int64, bool) {
+	refs, ok := enc.index[weak]
+	if !ok {
+		return 0, false
+	}
+
+	strong := sha256.Sum256(window)
+
+	for _, ref := range refs {
+		if ref.strong == strong {
+			return ref.offset, true
+		}
+	}
+
+	return 0, false
+}
+
+func (enc *encoder) emitCopy(offset, length int64) error {
+	if enc.copyLength > 0 && enc.copyOffset+enc.copyLength == offset {
+		enc.copyLength += length
+
+		return nil
+	}
+
+	if err := enc.flushCopy(); err != nil {
+		return err
+	}
+
+	enc.copyOffset, enc.copyLength = offset, length
+
+	return nil
+}
+
+func (enc *encoder) flushCopy() error {
+	if enc.copyLength == 0 {
+		return nil
+	}
+
+	buf := []byte{opCopy}
+	buf = binary.AppendUvarint(buf, uint64(enc.copyOffset))
+	buf = binary.AppendUvarint(buf, uint64(enc.copyLength))
+
+	enc.copyOffset, enc.copyLength = 0, 0
+
+	_, err := enc.w.Write(buf)
+
+	return err
+}
+
+func (enc *encoder) emitData(literal []byte) error {
+	if len(literal) == 0 {
+		return nil
+	}
+
+	if err := enc.flushCopy(); err != nil {
+		return err
+	}
+
+	buf := []byte{opData}
+	buf = binary.AppendUvarint(buf, uint64(len(literal)))
+
+	if _, err := enc.w.Write(buf); err != nil {
+		return err
+	}
+
+	_, err := enc.w.Write(literal)
+
+	return err
+}
+
+// decode reconstructs the target from the base and the delta.
+func decode(base io.ReaderAt, delta io.Reader, w io.Writer) error {
+	r := bufio.NewReader(delta)
+
+	header := make([]byte, len(magic))
+
+	if _, err := io.ReadFull(r, header); err != nil {
+		return fmt.Errorf("error reading delta header: %w", err)
+	}
+
+	if !bytes.Equal(header, magic) {
+		return errors.New("unsupported delta format")
+	}
+
+	for {
+		op, err := r.ReadByte()
+		if err != nil {
+			return fmt.Errorf("error reading delta: %w", err)
+		}
+
+		switch op {
+		case opEnd:
+			return nil
+		case opCopy:
+			offset, err := binary.ReadUvarint(r)
+			if err != nil {
+				return fmt.Errorf("error reading delta: %w", err)
+			}
+
+			length, err := binary.ReadUvarint(r)
+			if err != nil {
+				return fmt.Errorf("error reading delta: %w", err)
+			}
+
+			if _, err = io.Copy(w, io.NewSectionReader(base, int64(offset), int64(length))); err != nil {
+				return err
+			}
+		case opData:
+			length, err := binary.ReadUvarint(r)
+			if err != nil {
+				return fmt.Errorf("error reading delta: %w", err)
+			}
+
+			if _, err = io.CopyN(w, r, int64(length)); err != nil {
+				return fmt.Errorf("error reading delta: %w", err)
+			}
+		default:
+			return fmt.Errorf("unknown delta operation %d", op)
+		}
+	}
+}
+
+// rollingHash is the rsync rolling checksum.
+type rollingHash struct {
+	a, b uint32
+	n    uint32
+}
+
+func (h *rollingHash) init(window []byte) {
+	h.a, h.b, h.n = 0, 0, uint32(len(window))
+
+	for i, c := range window {
+		h.a += uint32(c)
+		h.b += (h.n - uint32(i)) * uint32(c)
+	}
+}
+
+func (h *rollingHash) roll(out, in byte) {
+	h.a += uint32(in) - uint32(out)
+	h.b += h.a - h.n*uint32(out)
+}
+
+func (h *rollingHash) sum() uint32 {
+	return (h.a & 0xffff) | (h.b << 16)
+}
+
+// compress writes the zstd compressed delta between the base and the target files to deltaPath.
+func compress(basePath, targetPath, deltaPath string, blockSize int) error {
+	base, err := os.Open(basePath)
+	if err != nil {
+		return err
+	}
+
+	defer base.Close() //nolint:errcheck
+
+	target, err := os.Open(targetPath)
+	if err != nil {
+		return err
+	}
+
+	defer target.Close() //nolint:errcheck
+
+	out, err := os.Create(deltaPath)
+	if err != nil {
+		return err
+	}
+
+	defer out.Close() //nolint:errcheck
+
+	zw, err := zstd.NewWriter(out, zstd.WithEncoderLevel(zstd.SpeedBetterCompression))
+	if err != nil {
+		return err
+	}
+
+	if err = encode(bufio.NewReader(base), target, zw, blockSize); err != nil {
+		zw.Close() //nolint:errcheck
+
+		return err
+	}
+
+	if err = zw.Close(); err != nil {
+		return err
+	}
+
+	return out.Close()
+}
+
+// decompress reconstructs the target file from the base file and the zstd compressed delta.
+func decompress(basePath, deltaPath string, w io.Writer) error {
+	base, err := os.Open(basePath)
+	if err != nil {
+		return err
+	}
+
+	defer base.Close() //nolint:errcheck
+
+	in, err := os.Open(deltaPath)
+	if err != nil {
+		return err
+	}
+
+	defer in.Close() //nolint:errcheck
+
+	zr, err := zstd.NewReader(in)
+	if err != nil {
+		return err
+	}
+
+	defer zr.Close()
+
+	return decode(base, zr, w)
+}
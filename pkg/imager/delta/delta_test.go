@@ -0,0 +1,184 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package delta_test
+
+import (
+	"context"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/imager/delta"
+)
+
+// referenceImages generates two adjacent images: the next one has some blocks changed, shifted content and a longer tail.
+func referenceImages(t *testing.T) (basePath, targetPath string, target []byte) {
+	t.Helper()
+
+	rnd := rand.New(rand.NewPCG(1, 2))
+
+	random := func(n int) []byte {
+		b := make([]byte, n)
+
+		for i := range b {
+			b[i] = byte(rnd.Uint32())
+		}
+
+		return b
+	}
+
+	base := slices.Concat(random(4<<20), make([]byte, 1<<20), random(1<<20))
+
+	target = slices.Clone(base)
+	copy(target[2<<20:], random(64<<10))                                    // changed blocks
+	target = slices.Concat(target[:1<<20], random(1000), target[1<<20:])    // shifted content
+	target = slices.Concat(target[:5<<20], random(100), target[5<<20+200:]) // shifted zeroes
+	target = append(target, random(16<<10)...)                              // longer tail
+
+	dir := t.TempDir()
+
+	basePath = filepath.Join(dir, "base.raw")
+	targetPath = filepath.Join(dir, "target.raw")
+
+	require.NoError(t, os.WriteFile(basePath, base, 0o644))
+	require.NoError(t, os.WriteFile(targetPath, target, 0o644))
+
+	return basePath, targetPath, target
+}
+
+func TestDelta(t *testing.T) {
+	t.Parallel()
+
+	basePath, targetPath, target := referenceImages(t)
+	dir := filepath.Dir(basePath)
+
+	deltaPath := filepath.Join(dir, "target.raw.delta")
+
+	manifest, err := delta.Create(basePath, targetPath, deltaPath, 0)
+	require.NoError(t, err)
+
+	assert.Equal(t, delta.DefaultBlockSize, manifest.BlockSize)
+	assert.EqualValues(t, len(target), manifest.Target.Size)
+
+	t.Logf("target size %d, delta size %d", manifest.Target.Size, manifest.Delta.Size)
+
+	// the changed content is ~80KiB of random data
+	assert.Less(t, manifest.Delta.Size, int64(128<<10))
+
+	manifestPath := filepath.Join(dir, "target.raw.delta.json")
+
+	require.NoError(t, manifest.Write(manifestPath))
+
+	manifest, err = delta.LoadManifest(manifestPath)
+	require.NoError(t, err)
+
+	outPath := filepath.Join(dir, "out.raw")
+
+	require.NoError(t, delta.Apply(basePath, deltaPath, manifest, outPath))
+
+	out, err := os.ReadFile(outPath)
+	require.NoError(t, err)
+
+	assert.Equal(t, target, out)
+
+	// the target is not a base for the delta
+	require.ErrorIs(t, delta.Apply(targetPath, deltaPath, manifest, outPath), delta.ErrBaseMismatch)
+	require.ErrorIs(t, delta.Apply(filepath.Join(dir, "missing.raw"), deltaPath, manifest, outPath), delta.ErrBaseMismatch)
+}
+
+func TestReconstruct(t *testing.T) {
+	t.Parallel()
+
+	basePath, targetPath, target := referenceImages(t)
+	dir := filepath.Dir(basePath)
+
+	deltaPath := filepath.Join(dir, "target.raw.delta")
+
+	manifest, err := delta.Create(basePath, targetPath, deltaPath, 0)
+	require.NoError(t, err)
+
+	var fullDownloads int
+
+	fetchFull := func(_ context.Context, outPath string) error {
+		fullDownloads++
+
+		return os.WriteFile(outPath, target, 0o644)
+	}
+
+	for _, test := range []struct {
+		name     string
+		basePath string
+
+		expectedDelta bool
+	}{
+		{
+			name:          "matching base",
+			basePath:      basePath,
+			expectedDelta: true,
+		},
+		{
+			name:     "other base",
+			basePath: targetPath,
+		},
+		{
+			name:     "missing base",
+			basePath: filepath.Join(dir, "missing.raw"),
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			fullDownloads = 0
+
+			outPath := filepath.Join(t.TempDir(), "out.raw")
+
+			usedDelta, err := delta.Reconstruct(t.Context(), test.basePath, deltaPath, manifest, outPath, fetchFull)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expectedDelta, usedDelta)
+
+			if test.expectedDelta {
+				assert.Zero(t, fullDownloads)
+			} else {
+				assert.Equal(t, 1, fullDownloads)
+			}
+
+			out, err := os.ReadFile(outPath)
+			require.NoError(t, err)
+
+			assert.Equal(t, target, out)
+		})
+	}
+}
+
+func TestReconstructFullMismatch(t *testing.T) {
+	t.Parallel()
+
+	basePath, targetPath, _ := referenceImages(t)
+	dir := filepath.Dir(basePath)
+
+	deltaPath := filepath.Join(dir, "target.raw.delta")
+
+	manifest, err := delta.Create(basePath, targetPath, deltaPath, 0)
+	require.NoError(t, err)
+
+	outPath := filepath.Join(dir, "out.raw")
+
+	// the base doesn't match, and the full download is corrupted
+	_, err = delta.Reconstruct(t.Context(), targetPath, deltaPath, manifest, outPath, func(_ context.Context, outPath string) error {
+		return os.WriteFile(outPath, []byte("corrupted"), 0o644)
+	})
+	require.ErrorContains(t, err, "downloaded file checksum mismatch")
+
+	// neither the corrupted download nor the temporary files are left behind
+	assert.NoFileExists(t, outPath)
+
+	matches, err := filepath.Glob(outPath + ".*")
+	require.NoError(t, err)
+	assert.Empty(t, matches)
+}
@@ -0,0 +1,215 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package delta
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// ManifestVersion is the version of the delta manifest.
+const ManifestVersion = 1
+
+// ErrBaseMismatch is returned when the base file doesn't match the base of the delta.
+var ErrBaseMismatch = errors.New("base doesn't match the delta")
+
+// Manifest describes the delta between the base and the target files.
+type Manifest struct {
+	Version   int  `json:"version"`
+	BlockSize int  `json:"blockSize"`
+	Base      File `json:"base"`
+	Target    File `json:"target"`
+	Delta     File `json:"delta"`
+}
+
+// File describes a file referenced by the manifest.
+type File struct {
+	Name   string `json:"name"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// Create writes the delta from the base to the target file to deltaPath, and returns the manifest of the delta.
+func Create(basePath, targetPath, deltaPath string, blockSize int) (*Manifest, error) {
+	if blockSize <= 0 {
+		blockSize = DefaultBlockSize
+	}
+
+	base, err := describe(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := describe(targetPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = compress(basePath, targetPath, deltaPath, blockSize); err != nil {
+		return nil, fmt.Errorf("error creating delta: %w", err)
+	}
+
+	delta, err := describe(deltaPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Manifest{
+		Version:   ManifestVersion,
+		BlockSize: blockSize,
+		Base:      base,
+		Target:    target,
+		Delta:     delta,
+	}, nil
+}
+
+// LoadManifest reads the manifest from the file.
+func LoadManifest(path string) (*Manifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var manifest Manifest
+
+	if err = json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("error parsing delta manifest: %w", err)
+	}
+
+	if manifest.Version != ManifestVersion {
+		return nil, fmt.Errorf("unsupported delta manifest version %d", manifest.Version)
+	}
+
+	return &manifest, nil
+}
+
+// Write writes the manifest to the file.
+func (manifest *Manifest) Write(path string) error {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// Apply reconstructs the target file from the base file and the delta into outPath.
+//
+// The base, the delta and the reconstructed target are verified against the manifest,
+// ErrBaseMismatch is returned if the base doesn't match.
+func Apply(basePath, deltaPath string, manifest *Manifest, outPath string) error {
+	base, err := describe(basePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("%w: %w", ErrBaseMismatch, err)
+		}
+
+		return err
+	}
+
+	if base.Size != manifest.Base.Size || base.SHA256 != manifest.Base.SHA256 {
+		return fmt.Errorf("%w: expected sha256 %s, got %s", ErrBaseMismatch, manifest.Base.SHA256, base.SHA256)
+	}
+
+	delta, err := describe(deltaPath)
+	if err != nil {
+		return err
+	}
+
+	if delta.SHA256 != manifest.Delta.SHA256 {
+		return fmt.Errorf("delta checksum mismatch: expected sha256 %s, got %s", manifest.Delta.SHA256, delta.SHA256)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(outPath), filepath.Base(outPath)+".*")
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(tmp.Name()) //nolint:errcheck
+	defer tmp.Close()           //nolint:errcheck
+
+	hash := sha256.New()
+
+	if err = decompress(basePath, deltaPath, io.MultiWriter(tmp, hash)); err != nil {
+		return fmt.Errorf("error applying delta: %w", err)
+	}
+
+	if actual := hex.EncodeToString(hash.Sum(nil)); actual != manifest.Target.SHA256 {
+		return fmt.Errorf("reconstructed file checksum mismatch: expected sha256 %s, got %s", manifest.Target.SHA256, actual)
+	}
+
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), outPath)
+}
+
+// Reconstruct reconstructs the target file with the delta, and falls back to the full download if the delta can't be applied.
+//
+// The full download is verified against the manifest before it replaces outPath,
+// so that a corrupted or a mismatched download is never left in place.
+//
+// It returns true if the target was reconstructed from the delta.
+func Reconstruct(ctx context.Context, basePath, deltaPath string, manifest *Manifest, outPath string, fetchFull func(ctx context.Context, outPath string) error) (bool, error) {
+	if err := Apply(basePath, deltaPath, manifest, outPath); err == nil {
+		return true, nil
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(outPath), filepath.Base(outPath)+".*")
+	if err != nil {
+		return false, err
+	}
+
+	if err = tmp.Close(); err != nil {
+		return false, err
+	}
+
+	defer os.Remove(tmp.Name()) //nolint:errcheck
+
+	if err = fetchFull(ctx, tmp.Name()); err != nil {
+		return false, fmt.Errorf("error downloading the full image: %w", err)
+	}
+
+	target, err := describe(tmp.Name())
+	if err != nil {
+		return false, err
+	}
+
+	if target.SHA256 != manifest.Target.SHA256 {
+		return false, fmt.Errorf("downloaded file checksum mismatch: expected sha256 %s, got %s", manifest.Target.SHA256, target.SHA256)
+	}
+
+	return false, os.Rename(tmp.Name(), outPath)
+}
+
+func describe(path string) (File, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return File{}, err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	hash := sha256.New()
+
+	size, err := io.Copy(hash, f)
+	if err != nil {
+		return File{}, err
+	}
+
+	return File{
+		Name:   filepath.Base(path),
+		Size:   size,
+		SHA256: hex.EncodeToString(hash.Sum(nil)),
+	}, nil
+}
@@ -27,6 +27,10 @@ type Builder struct {
 	ExtensionTreePath string
 	// ExtensionValidateContents enables validation of the extension contents.
 	ExtensionValidateContents bool
+	// ExtensionSources maps the extension directory name to the container image the extension was pulled from.
+	ExtensionSources map[string]*extinterface.Source
+	// ExtensionsConfigPath is an optional path to write a copy of the extensions configuration to.
+	ExtensionsConfigPath string
 	// Printf is used for logging.
 	Printf func(format string, v ...any)
 	// Quirks for the Talos version being used.
@@ -92,6 +96,12 @@ func (builder *Builder) Build(ctx context.Context) error {
 		return err
 	}
 
+	if builder.ExtensionsConfigPath != "" {
+		if err = cfg.Write(builder.ExtensionsConfigPath); err != nil {
+			return err
+		}
+	}
+
 	return builder.rebuildInitramfs(ctx, tempDir, builder.Quirks)
 }
 
@@ -130,6 +140,7 @@ func (builder *Builder) compressExtensions(ctx context.Context, extensions []*ex
 		cfg.Layers = append(cfg.Layers, &extinterface.Layer{
 			Image:    filepath.Base(path),
 			Metadata: ext.Manifest.Metadata,
+			Source:   builder.ExtensionSources[ext.Directory()],
 		})
 	}
 
@@ -27,6 +27,7 @@ import (
 	"github.com/siderolabs/talos/pkg/imager/utils"
 	"github.com/siderolabs/talos/pkg/machinery/config/merge"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
+	extinterface "github.com/siderolabs/talos/pkg/machinery/extensions"
 	"github.com/siderolabs/talos/pkg/machinery/imager/quirks"
 	"github.com/siderolabs/talos/pkg/machinery/kernel"
 	"github.com/siderolabs/talos/pkg/machinery/overlay"
@@ -43,10 +44,16 @@ type Imager struct {
 
 	tempDir string
 
+	// assetCache is shared between the variants of the profile
+	assetCache *assetCache
+
 	// boot assets
 	initramfsPath string
 	cmdline       string
 
+	// extensionsConfigPath is the extensions configuration of the rebuilt initramfs
+	extensionsConfigPath string
+
 	sdBootPath string
 	ukiPath    string
 }
@@ -58,10 +65,68 @@ func New(prof profile.Profile) (*Imager, error) {
 	}, nil
 }
 
+// ExecuteVariants runs image generation for every variant of the profile.
+//
+// If the profile has no variants, it is equivalent to Execute.
+// Container assets (overlay, system extensions, image cache) are pulled once and shared between the variants.
+func (i *Imager) ExecuteVariants(ctx context.Context, outputPath string, report *reporter.Reporter) ([]string, error) {
+	if len(i.prof.Variants) == 0 {
+		outputAssetPath, err := i.Execute(ctx, outputPath, report)
+		if err != nil {
+			return nil, err
+		}
+
+		return []string{outputAssetPath}, nil
+	}
+
+	if err := i.prof.ValidateVariants(); err != nil {
+		return nil, err
+	}
+
+	cacheDir, err := os.MkdirTemp("", "imager-cache")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	defer os.RemoveAll(cacheDir) //nolint:errcheck
+
+	cache := &assetCache{
+		dir:     cacheDir,
+		entries: map[assetCacheKey]string{},
+	}
+
+	outputAssetPaths := make([]string, 0, len(i.prof.Variants))
+
+	for _, variant := range i.prof.Variants {
+		report.Report(reporter.Update{
+			Message: fmt.Sprintf("building variant %q", variant.Name),
+			Status:  reporter.StatusRunning,
+		})
+
+		variantImager := &Imager{
+			prof:       i.prof.WithVariant(variant),
+			assetCache: cache,
+		}
+
+		outputAssetPath, err := variantImager.Execute(ctx, outputPath, report)
+		if err != nil {
+			return nil, fmt.Errorf("variant %q: %w", variant.Name, err)
+		}
+
+		outputAssetPaths = append(outputAssetPaths, outputAssetPath)
+	}
+
+	return outputAssetPaths, nil
+}
+
 // Execute image generation.
 //
 //nolint:gocyclo,cyclop
 func (i *Imager) Execute(ctx context.Context, outputPath string, report *reporter.Reporter) (outputAssetPath string, err error) {
+	if len(i.prof.Variants) > 0 {
+		return "", fmt.Errorf("profile with variants should be built with ExecuteVariants")
+	}
+
 	i.tempDir, err = os.MkdirTemp("", "imager")
 	if err != nil {
 		return "", fmt.Errorf("failed to create temporary directory: %w", err)
@@ -111,7 +176,7 @@ func (i *Imager) Execute(ctx context.Context, outputPath string, report *reporte
 		if !needBuildUKI {
 			return "", fmt.Errorf("UKI output is not supported in this Talos version")
 		}
-	case profile.OutKindISO, profile.OutKindInstaller, profile.OutKindImage:
+	case profile.OutKindISO, profile.OutKindInstaller, profile.OutKindImage, profile.OutKindGCE, profile.OutKindAzureVHD, profile.OutKindAMI:
 		needBuildUKI = needBuildUKI || quirks.New(i.prof.Version).UseSDBootForUEFI()
 	case profile.OutKindCmdline, profile.OutKindKernel, profile.OutKindInitramfs:
 		needBuildUKI = false
@@ -143,6 +208,12 @@ func (i *Imager) Execute(ctx context.Context, outputPath string, report *reporte
 		err = i.outCmdline(outputAssetPath)
 	case profile.OutKindImage:
 		err = i.outImage(ctx, outputAssetPath, report)
+	case profile.OutKindGCE:
+		err = i.outGCE(ctx, outputAssetPath, report)
+	case profile.OutKindAzureVHD:
+		err = i.outAzureVHD(ctx, outputAssetPath, report)
+	case profile.OutKindAMI:
+		err = i.outAMI(ctx, outputAssetPath, report)
 	case profile.OutKindInstaller:
 		err = i.outInstaller(ctx, outputAssetPath, report)
 	case profile.OutKindUnknown:
@@ -164,20 +235,39 @@ func (i *Imager) Execute(ctx context.Context, outputPath string, report *reporte
 	switch i.prof.Output.OutFormat {
 	case profile.OutFormatRaw:
 		// do nothing
-		return outputAssetPath, nil
 	case profile.OutFormatXZ:
-		return i.postProcessXz(outputAssetPath, report)
+		outputAssetPath, err = i.postProcessXz(outputAssetPath, report)
 	case profile.OutFormatGZ:
-		return i.postProcessGz(outputAssetPath, report)
+		outputAssetPath, err = i.postProcessGz(outputAssetPath, report)
 	case profile.OutFormatZSTD:
-		return i.postProcessZstd(outputAssetPath, report)
+		outputAssetPath, err = i.postProcessZstd(outputAssetPath, report)
 	case profile.OutFormatTar:
-		return i.postProcessTar(ctx, outputAssetPath, report)
+		outputAssetPath, err = i.postProcessTar(ctx, outputAssetPath, report)
 	case profile.OutFormatUnknown:
 		fallthrough
 	default:
 		return "", fmt.Errorf("unknown output format: %s", i.prof.Output.OutFormat)
 	}
+
+	if err != nil {
+		return "", err
+	}
+
+	// 7. Write the checksum.
+	if i.prof.Output.Checksums {
+		if err = i.writeChecksum(outputAssetPath, report); err != nil {
+			return "", err
+		}
+	}
+
+	// 8. Write the delta from the previous build.
+	if i.prof.Output.DeltaBase != "" {
+		if err = i.writeDelta(outputAssetPath, report); err != nil {
+			return "", err
+		}
+	}
+
+	return outputAssetPath, nil
 }
 
 func (i *Imager) handleOverlay(ctx context.Context, report *reporter.Reporter) error {
@@ -196,7 +286,7 @@ func (i *Imager) handleOverlay(ctx context.Context, report *reporter.Reporter) e
 		return fmt.Errorf("failed to create overlay directory: %w", err)
 	}
 
-	if err := i.prof.Overlay.Image.Extract(ctx, tempOverlayPath, runtime.GOARCH, progressPrintf(report, reporter.Update{Message: "pulling overlay...", Status: reporter.StatusRunning})); err != nil {
+	if err := i.extractAsset(ctx, i.prof.Overlay.Image, tempOverlayPath, runtime.GOARCH, progressPrintf(report, reporter.Update{Message: "pulling overlay...", Status: reporter.StatusRunning})); err != nil {
 		return err
 	}
 
@@ -251,6 +341,12 @@ func (i *Imager) handleProf() error {
 
 		baseProfile = baseProfile.DeepCopy()
 
+		// disk format options are specific to the disk format, so they are dropped if only the disk format is overridden
+		if imageOptions, baseImageOptions := i.prof.Output.ImageOptions, baseProfile.Output.ImageOptions; imageOptions != nil && baseImageOptions != nil &&
+			imageOptions.DiskFormat != profile.DiskFormatUnknown && imageOptions.DiskFormat != baseImageOptions.DiskFormat && imageOptions.DiskFormatOptions == "" {
+			baseImageOptions.DiskFormatOptions = ""
+		}
+
 		// merge the profiles
 		if err := merge.Merge(&baseProfile, &i.prof); err != nil {
 			return err
@@ -271,9 +367,33 @@ func (i *Imager) handleProf() error {
 		return fmt.Errorf("profile is invalid: %w", err)
 	}
 
+	if i.prof.Customization.MachineConfig != "" {
+		// the profile was validated, so the secrets are allowed at this point
+		hasSecrets, err := i.prof.Customization.ValidateMachineConfig(i.prof.Version)
+		if err != nil {
+			return err
+		}
+
+		if hasSecrets {
+			fmt.Fprintln(os.Stderr, "WARNING: the machine config embedded into the image contains secrets!")
+			fmt.Fprintln(os.Stderr, "WARNING: anyone with access to the image can read them and gain access to the machine and the cluster.")
+		}
+	}
+
 	return nil
 }
 
+// writeMachineConfig writes the embedded machine config to the temporary directory.
+func (i *Imager) writeMachineConfig() (string, error) {
+	path := filepath.Join(i.tempDir, constants.ConfigFilename)
+
+	if err := os.WriteFile(path, []byte(i.prof.Customization.MachineConfig), 0o600); err != nil {
+		return "", fmt.Errorf("failed to write machine config: %w", err)
+	}
+
+	return path, nil
+}
+
 // buildInitramfs transforms `initramfs.xz` with system extensions.
 func (i *Imager) buildInitramfs(ctx context.Context, report *reporter.Reporter) error {
 	if len(i.prof.Input.SystemExtensions) == 0 {
@@ -305,6 +425,7 @@ func (i *Imager) buildInitramfs(ctx context.Context, report *reporter.Reporter)
 	i.initramfsPath = tempInitramfsPath
 
 	extensionsCheckoutDir := filepath.Join(i.tempDir, "extensions")
+	extensionSources := map[string]*extinterface.Source{}
 
 	// pull every extension to a temporary location
 	for j, ext := range i.prof.Input.SystemExtensions {
@@ -314,24 +435,40 @@ func (i *Imager) buildInitramfs(ctx context.Context, report *reporter.Reporter)
 			return fmt.Errorf("failed to create extension directory: %w", err)
 		}
 
-		if err := ext.Extract(ctx, extensionDir, i.prof.Arch, printf); err != nil {
+		// record the image digest and signatures, and pull the extension by the digest
+		source, err := ext.Source(ctx, printf)
+		if err != nil {
+			return err
+		}
+
+		if source != nil {
+			extensionSources[strconv.Itoa(j)] = source
+		}
+
+		if err := i.extractAsset(ctx, ext, extensionDir, i.prof.Arch, printf); err != nil {
 			return err
 		}
 	}
 
+	extensionsConfigPath := filepath.Join(i.tempDir, "extensions.yaml")
+
 	// rebuild initramfs
 	builder := extensions.Builder{
-		InitramfsPath:     i.initramfsPath,
-		Arch:              i.prof.Arch,
-		ExtensionTreePath: extensionsCheckoutDir,
-		Printf:            printf,
-		Quirks:            quirks.New(i.prof.Version),
+		InitramfsPath:        i.initramfsPath,
+		Arch:                 i.prof.Arch,
+		ExtensionTreePath:    extensionsCheckoutDir,
+		ExtensionSources:     extensionSources,
+		ExtensionsConfigPath: extensionsConfigPath,
+		Printf:               printf,
+		Quirks:               quirks.New(i.prof.Version),
 	}
 
 	if err := builder.Build(ctx); err != nil {
 		return err
 	}
 
+	i.extensionsConfigPath = extensionsConfigPath
+
 	report.Report(reporter.Update{
 		Message: "initramfs ready",
 		Status:  reporter.StatusSucceeded,
@@ -396,8 +533,8 @@ func (i *Imager) buildCmdline(ctx context.Context) error {
 		}
 	}
 
-	// meta values can be written only to the "image" output
-	if len(i.prof.Customization.MetaContents) > 0 && i.prof.Output.Kind != profile.OutKindImage {
+	// meta values can be written only to the disk image outputs
+	if len(i.prof.Customization.MetaContents) > 0 && !i.prof.Output.Kind.IsDiskImage() {
 		// pass META values as kernel talos.environment args which will be passed via the environment to the installer
 		cmdline.Append(
 			constants.KernelParamEnvironment,
@@ -405,6 +542,11 @@ func (i *Imager) buildCmdline(ctx context.Context) error {
 		)
 	}
 
+	// embedded machine config is read from the boot media by the metal platform
+	if i.prof.Customization.MachineConfig != "" {
+		cmdline.Append(constants.KernelParamConfig, constants.MetalConfigISOLabel)
+	}
+
 	// apply customization
 	if err = cmdline.AppendAll(
 		i.prof.Customization.ExtraKernelArgs,
@@ -447,7 +589,7 @@ func (i *Imager) buildUKI(ctx context.Context, report *reporter.Reporter) error
 			Title:   "Reset system disk",
 			Cmdline: builder.Cmdline + fmt.Sprintf(" %s=system", constants.KernelParamWipe),
 		})
-	case profile.OutKindImage, profile.OutKindInstaller:
+	case profile.OutKindImage, profile.OutKindGCE, profile.OutKindAzureVHD, profile.OutKindAMI, profile.OutKindInstaller:
 		builder.Profiles = append(builder.Profiles, uki.Profile{
 			ID:    "reset-maintenance",
 			Title: "Reset to maintenance mode",
@@ -0,0 +1,45 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package iso
+
+import (
+	"github.com/siderolabs/go-cmd/pkg/cmd"
+
+	"github.com/siderolabs/talos/internal/pkg/partition"
+	"github.com/siderolabs/talos/pkg/imager/utils"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/makefs"
+)
+
+// CreateConfigImage creates a vFAT image carrying the machine config.
+//
+// The image is labeled `metal-iso`, and the machine config is stored as `config.yaml` in the root of the filesystem,
+// so that the metal platform picks it up with `talos.config=metal-iso`.
+func CreateConfigImage(printf func(string, ...any), configPath, imagePath string) error {
+	printf("creating machine config image")
+
+	if err := utils.CreateRawDisk(printf, imagePath, partition.MetalConfigPartitionSize); err != nil {
+		return err
+	}
+
+	if err := makefs.VFAT(imagePath,
+		makefs.WithLabel(constants.MetalConfigISOLabel),
+		makefs.WithReproducible(true),
+	); err != nil {
+		return err
+	}
+
+	_, err := cmd.Run(
+		"mcopy",
+		"-p", // preserve attributes
+		"-Q", // quit on error
+		"-i",
+		imagePath,
+		configPath,
+		"::"+constants.ConfigFilename,
+	)
+
+	return err
+}
@@ -81,5 +81,6 @@ func (options Options) CreateGRUB(printf func(string, ...any)) (Generator, error
 			"-iso-level", "3",
 			"--",
 		},
+		ConfigImagePath: options.ConfigImagePath,
 	}, nil
 }
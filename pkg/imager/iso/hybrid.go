@@ -40,5 +40,6 @@ func (options Options) CreateHybrid(printf func(string, ...any)) (Generator, err
 			"-no-emul-boot",
 			"--",
 		},
+		ConfigImagePath: options.ConfigImagePath,
 	}, nil
 }
@@ -57,6 +57,9 @@ type ExecutorOptions struct {
 	Command   string
 	Version   string
 	Arguments []string
+
+	// ConfigImagePath is the optional machine config image.
+	ConfigImagePath string
 }
 
 // Generator is an interface for executing the iso generation.
@@ -87,6 +90,9 @@ type Options struct {
 	KeyExchangeKeyPath string
 	SignatureKeyPath   string
 
+	// optional, vFAT image with the embedded machine config
+	ConfigImagePath string
+
 	ScratchDir string
 	OutPath    string
 }
@@ -116,6 +122,13 @@ func (e *ExecutorOptions) Generate() error {
 		)
 	}
 
+	if e.ConfigImagePath != "" {
+		// the machine config image is appended as an extra partition, and located by the filesystem label
+		e.Arguments = append(e.Arguments,
+			"-append_partition", "3", "0x0c", e.ConfigImagePath,
+		)
+	}
+
 	_, err := cmd.Run(e.Command, e.Arguments...)
 	if err != nil {
 		return fmt.Errorf("failed to create ISO: %w", err)
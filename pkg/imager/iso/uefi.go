@@ -210,5 +210,6 @@ func (options Options) CreateUEFI(printf func(string, ...any)) (Generator, error
 			options.ScratchDir,
 			"--",
 		},
+		ConfigImagePath: options.ConfigImagePath,
 	}, nil
 }
@@ -33,13 +33,16 @@ import (
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader/options"
 	"github.com/siderolabs/talos/internal/pkg/secureboot/database"
 	"github.com/siderolabs/talos/internal/pkg/secureboot/pesign"
+	"github.com/siderolabs/talos/pkg/imager/cloud"
 	"github.com/siderolabs/talos/pkg/imager/filemap"
 	"github.com/siderolabs/talos/pkg/imager/iso"
 	"github.com/siderolabs/talos/pkg/imager/ova"
 	"github.com/siderolabs/talos/pkg/imager/profile"
 	"github.com/siderolabs/talos/pkg/imager/qemuimg"
 	"github.com/siderolabs/talos/pkg/imager/utils"
+	"github.com/siderolabs/talos/pkg/imager/vmdkconvert"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
+	extinterface "github.com/siderolabs/talos/pkg/machinery/extensions"
 	"github.com/siderolabs/talos/pkg/machinery/imager/quirks"
 	"github.com/siderolabs/talos/pkg/machinery/meta"
 	"github.com/siderolabs/talos/pkg/reporter"
@@ -101,7 +104,24 @@ func (i *Imager) outISO(ctx context.Context, path string, report *reporter.Repor
 			return err
 		}
 
-		if err := i.prof.Input.ImageCache.Extract(ctx, filepath.Join(scratchSpace, "imagecache"), i.prof.Arch, printf); err != nil {
+		if err := i.extractAsset(ctx, i.prof.Input.ImageCache, filepath.Join(scratchSpace, "imagecache"), i.prof.Arch, printf); err != nil {
+			return err
+		}
+	}
+
+	var configImagePath string
+
+	if i.prof.Customization.MachineConfig != "" {
+		var configPath string
+
+		configPath, err = i.writeMachineConfig()
+		if err != nil {
+			return err
+		}
+
+		configImagePath = filepath.Join(i.tempDir, "metal-iso.img")
+
+		if err = iso.CreateConfigImage(printf, configPath, configImagePath); err != nil {
 			return err
 		}
 	}
@@ -140,6 +160,8 @@ func (i *Imager) outISO(ctx context.Context, path string, report *reporter.Repor
 			Arch:    i.prof.Arch,
 			Version: i.prof.Version,
 
+			ConfigImagePath: configImagePath,
+
 			ScratchDir: scratchSpace,
 			OutPath:    path,
 		}
@@ -202,6 +224,8 @@ func (i *Imager) outISO(ctx context.Context, path string, report *reporter.Repor
 			Arch:    i.prof.Arch,
 			Version: i.prof.Version,
 
+			ConfigImagePath: configImagePath,
+
 			ScratchDir: scratchSpace,
 			OutPath:    path,
 		}
@@ -219,6 +243,8 @@ func (i *Imager) outISO(ctx context.Context, path string, report *reporter.Repor
 			Arch:    i.prof.Arch,
 			Version: i.prof.Version,
 
+			ConfigImagePath: configImagePath,
+
 			ScratchDir: scratchSpace,
 			OutPath:    path,
 		}
@@ -241,7 +267,7 @@ func (i *Imager) outISO(ctx context.Context, path string, report *reporter.Repor
 func (i *Imager) outImage(ctx context.Context, path string, report *reporter.Reporter) error {
 	printf := progressPrintf(report, reporter.Update{Message: "creating disk image...", Status: reporter.StatusRunning})
 
-	if err := i.buildImage(ctx, path, printf); err != nil {
+	if err := i.buildRawImage(ctx, path, printf); err != nil {
 		return err
 	}
 
@@ -256,6 +282,23 @@ func (i *Imager) outImage(ctx context.Context, path string, report *reporter.Rep
 		if err := qemuimg.Convert("raw", "vpc", i.prof.Output.ImageOptions.DiskFormatOptions, path, printf); err != nil {
 			return err
 		}
+	case profile.DiskFormatVHDX:
+		if err := qemuimg.Convert("raw", "vhdx", i.prof.Output.ImageOptions.DiskFormatOptions, path, printf); err != nil {
+			return err
+		}
+	case profile.DiskFormatVMDK:
+		var err error
+
+		if i.prof.Output.ImageOptions.DiskFormatOptions == "" {
+			// default to the stream optimized VMDK, which is compressed and can be imported directly
+			err = vmdkconvert.ConvertToStreamOptimizedVMDK(path, printf)
+		} else {
+			err = qemuimg.Convert("raw", "vmdk", i.prof.Output.ImageOptions.DiskFormatOptions, path, printf)
+		}
+
+		if err != nil {
+			return err
+		}
 	case profile.DiskFormatOVA:
 		scratchPath := filepath.Join(i.tempDir, "ova")
 
@@ -273,6 +316,113 @@ func (i *Imager) outImage(ctx context.Context, path string, report *reporter.Rep
 	return nil
 }
 
+func (i *Imager) outGCE(ctx context.Context, path string, report *reporter.Reporter) error {
+	printf := progressPrintf(report, reporter.Update{Message: "creating GCE image...", Status: reporter.StatusRunning})
+
+	rawPath := strings.TrimSuffix(path, ".tar.gz")
+
+	if err := i.buildRawImage(ctx, rawPath, printf); err != nil {
+		return err
+	}
+
+	if _, err := i.postProcessTar(ctx, rawPath, report); err != nil {
+		return err
+	}
+
+	if err := cloud.ValidateGCETar(path); err != nil {
+		return err
+	}
+
+	report.Report(reporter.Update{Message: "GCE image ready", Status: reporter.StatusSucceeded})
+
+	return nil
+}
+
+func (i *Imager) outAzureVHD(ctx context.Context, path string, report *reporter.Reporter) error {
+	printf := progressPrintf(report, reporter.Update{Message: "creating Azure VHD...", Status: reporter.StatusRunning})
+
+	if err := i.buildRawImage(ctx, path, printf); err != nil {
+		return err
+	}
+
+	timestamp := time.Now()
+
+	epoch, ok, err := utils.SourceDateEpoch()
+	if err != nil {
+		return err
+	}
+
+	if ok {
+		timestamp = time.Unix(epoch, 0)
+	}
+
+	printf("converting disk image to fixed vhd")
+
+	if err = cloud.ConvertToFixedVHD(path, timestamp); err != nil {
+		return err
+	}
+
+	if err = cloud.ValidateFixedVHD(path); err != nil {
+		return err
+	}
+
+	report.Report(reporter.Update{Message: "Azure VHD ready", Status: reporter.StatusSucceeded})
+
+	return nil
+}
+
+func (i *Imager) outAMI(ctx context.Context, path string, report *reporter.Reporter) error {
+	printf := progressPrintf(report, reporter.Update{Message: "creating AMI disk image...", Status: reporter.StatusRunning})
+
+	if err := i.buildRawImage(ctx, path, printf); err != nil {
+		return err
+	}
+
+	format := cloud.AWSFormatRaw
+
+	if i.prof.Output.ImageOptions.DiskFormat == profile.DiskFormatVMDK {
+		format = cloud.AWSFormatVMDK
+
+		if err := vmdkconvert.ConvertToStreamOptimizedVMDK(path, printf); err != nil {
+			return err
+		}
+	}
+
+	if err := cloud.ValidateAWSImage(path, format); err != nil {
+		return err
+	}
+
+	if i.prof.Output.AMIOptions != nil && i.prof.Output.AMIOptions.ImportBucket != "" {
+		manifest := cloud.NewAWSImportManifest(
+			path,
+			format,
+			i.prof.Output.AMIOptions.ImportBucket,
+			i.prof.Output.AMIOptions.ImportKeyPrefix,
+			fmt.Sprintf("Talos %s (%s)", i.prof.Version, i.prof.Arch),
+		)
+
+		if err := manifest.Write(path + ".import.json"); err != nil {
+			return err
+		}
+
+		printf("import manifest ready: %s", path+".import.json")
+	}
+
+	report.Report(reporter.Update{Message: "AMI disk image ready", Status: reporter.StatusSucceeded})
+
+	return nil
+}
+
+// buildRawImage builds the raw disk image, keeping it sparse.
+func (i *Imager) buildRawImage(ctx context.Context, path string, printf func(string, ...any)) error {
+	if err := i.buildImage(ctx, path, printf); err != nil {
+		return err
+	}
+
+	// keep the image sparse, so that the conversion and compression skip the unused space
+	return utils.PunchHoles(printf, path)
+}
+
 //nolint:gocyclo
 func (i *Imager) buildImage(ctx context.Context, path string, printf func(string, ...any)) error {
 	if err := utils.CreateRawDisk(printf, path, i.prof.Output.ImageOptions.DiskSize); err != nil {
@@ -355,6 +505,12 @@ func (i *Imager) buildImage(ctx context.Context, path string, printf func(string
 		Printf:      printf,
 	}
 
+	if i.prof.Customization.MachineConfig != "" {
+		if opts.EmbeddedConfigPath, err = i.writeMachineConfig(); err != nil {
+			return err
+		}
+	}
+
 	if i.overlayInstaller != nil {
 		opts.OverlayInstaller = i.overlayInstaller
 		opts.ExtraOptions = i.prof.Overlay.ExtraOptions
@@ -372,7 +528,7 @@ func (i *Imager) buildImage(ctx context.Context, path string, printf func(string
 			return err
 		}
 
-		if err := i.prof.Input.ImageCache.Extract(ctx, imageCacheDir, i.prof.Arch, printf); err != nil {
+		if err := i.extractAsset(ctx, i.prof.Input.ImageCache, imageCacheDir, i.prof.Arch, printf); err != nil {
 			return err
 		}
 
@@ -483,6 +639,22 @@ func (i *Imager) outInstaller(ctx context.Context, path string, report *reporter
 		)
 	}
 
+	extensionsConfigPath := i.extensionsConfigPath
+
+	if extensionsConfigPath == "" {
+		// no system extensions, the empty record tells the installer there is nothing to verify
+		extensionsConfigPath = filepath.Join(i.tempDir, "extensions.yaml")
+
+		if err = (&extinterface.Config{}).Write(extensionsConfigPath); err != nil {
+			return fmt.Errorf("failed to write extensions config: %w", err)
+		}
+	}
+
+	artifacts = append(artifacts, filemap.File{
+		ImagePath:  strings.TrimLeft(fmt.Sprintf(constants.ExtensionsAssetPath, i.prof.Arch), "/"),
+		SourcePath: extensionsConfigPath,
+	})
+
 	if !quirks.SupportsOverlay() {
 		for _, extraArtifact := range []struct {
 			sourcePath string
@@ -5,14 +5,20 @@
 package imager
 
 import (
+	"cmp"
 	"context"
+	"crypto/sha256"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strconv"
 
+	"github.com/dustin/go-humanize"
 	"github.com/siderolabs/go-cmd/pkg/cmd"
 
+	"github.com/siderolabs/talos/pkg/imager/delta"
 	"github.com/siderolabs/talos/pkg/reporter"
 )
 
@@ -34,7 +40,7 @@ func (i *Imager) postProcessTar(ctx context.Context, filename string, report *re
 		return "", err
 	}
 
-	cmd1 := exec.CommandContext(ctx, "tar", "-cvf", "-", "-C", dir, "--sparse", src)
+	cmd1 := exec.CommandContext(ctx, "tar", "-cvf", "-", "-C", dir, "--sparse", "--format=oldgnu", src)
 
 	cmd1.Stdout = pipeW
 	cmd1.Stderr = os.Stderr
@@ -54,7 +60,7 @@ func (i *Imager) postProcessTar(ctx context.Context, filename string, report *re
 
 	defer destination.Close() //nolint:errcheck
 
-	cmd2 := exec.CommandContext(ctx, "pigz", "-6", "-f", "-")
+	cmd2 := exec.CommandContext(ctx, "pigz", i.compressionLevel(6), "-f", "-")
 	cmd2.Stdin = pipeR
 	cmd2.Stdout = destination
 	cmd2.Stderr = os.Stderr
@@ -99,7 +105,7 @@ func (i *Imager) postProcessTar(ctx context.Context, filename string, report *re
 func (i *Imager) postProcessGz(filename string, report *reporter.Reporter) (string, error) {
 	report.Report(reporter.Update{Message: "compressing .gz", Status: reporter.StatusRunning})
 
-	if _, err := cmd.Run("pigz", "-6", "-f", filename); err != nil {
+	if _, err := cmd.Run("pigz", i.compressionLevel(6), "-f", filename); err != nil {
 		return "", err
 	}
 
@@ -111,7 +117,7 @@ func (i *Imager) postProcessGz(filename string, report *reporter.Reporter) (stri
 func (i *Imager) postProcessXz(filename string, report *reporter.Reporter) (string, error) {
 	report.Report(reporter.Update{Message: "compressing .xz", Status: reporter.StatusRunning})
 
-	if _, err := cmd.Run("xz", "-0", "-f", "-T", "0", filename); err != nil {
+	if _, err := cmd.Run("xz", i.compressionLevel(0), "-f", "-T", "0", filename); err != nil {
 		return "", err
 	}
 
@@ -125,7 +131,7 @@ func (i *Imager) postProcessZstd(filename string, report *reporter.Reporter) (st
 
 	out := filename + ".zst"
 
-	if _, err := cmd.Run("zstd", "-T0", "--rm", "-18", "--quiet", "--force", "-o", out, filename); err != nil {
+	if _, err := cmd.Run("zstd", "-T0", "--rm", i.compressionLevel(18), "--quiet", "--force", "-o", out, filename); err != nil {
 		return "", err
 	}
 
@@ -133,3 +139,59 @@ func (i *Imager) postProcessZstd(filename string, report *reporter.Reporter) (st
 
 	return filename + ".zst", nil
 }
+
+// compressionLevel returns the compression level flag, defaulting to the given level.
+func (i *Imager) compressionLevel(defaultLevel int) string {
+	return "-" + strconv.Itoa(cmp.Or(i.prof.Output.CompressionLevel, defaultLevel))
+}
+
+// writeChecksum writes the sha256 checksum of the output to the sidecar file in the sha256sum format.
+func (i *Imager) writeChecksum(filename string, report *reporter.Reporter) error {
+	report.Report(reporter.Update{Message: "calculating sha256 checksum", Status: reporter.StatusRunning})
+
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	hash := sha256.New()
+
+	if _, err = io.Copy(hash, f); err != nil {
+		return err
+	}
+
+	checksumPath := filename + ".sha256"
+
+	if err = os.WriteFile(checksumPath, fmt.Appendf(nil, "%x  %s\n", hash.Sum(nil), filepath.Base(filename)), 0o644); err != nil {
+		return err
+	}
+
+	report.Report(reporter.Update{Message: fmt.Sprintf("checksum ready: %s", checksumPath), Status: reporter.StatusSucceeded})
+
+	return nil
+}
+
+// writeDelta writes the binary delta from the delta base to the output, and the manifest of the delta.
+func (i *Imager) writeDelta(filename string, report *reporter.Reporter) error {
+	report.Report(reporter.Update{Message: fmt.Sprintf("calculating delta from %s", i.prof.Output.DeltaBase), Status: reporter.StatusRunning})
+
+	manifest, err := delta.Create(i.prof.Output.DeltaBase, filename, filename+".delta", 0)
+	if err != nil {
+		return err
+	}
+
+	manifestPath := filename + ".delta.json"
+
+	if err = manifest.Write(manifestPath); err != nil {
+		return err
+	}
+
+	report.Report(reporter.Update{
+		Message: fmt.Sprintf("delta ready: %s (%s of %s)", manifestPath, humanize.IBytes(uint64(manifest.Delta.Size)), humanize.IBytes(uint64(manifest.Target.Size))),
+		Status:  reporter.StatusSucceeded,
+	})
+
+	return nil
+}
@@ -5,7 +5,15 @@
 package profile
 
 import (
+	"bytes"
+	"fmt"
+
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
 	"github.com/siderolabs/talos/pkg/machinery/meta"
+	"github.com/siderolabs/talos/pkg/machinery/version"
 )
 
 // CustomizationProfile describes customizations that can be applied to the image.
@@ -14,4 +22,62 @@ type CustomizationProfile struct {
 	ExtraKernelArgs []string `yaml:"extraKernelArgs,omitempty"`
 	// MetaContents is a list of META partition contents.
 	MetaContents meta.Values `yaml:"metaContents,omitempty"`
+	// MachineConfig is the machine config embedded into the boot media (metal ISO and disk image only).
+	MachineConfig string `yaml:"machineConfig,omitempty"`
+	// AllowMachineConfigSecrets allows embedding a machine config which contains secrets.
+	AllowMachineConfigSecrets bool `yaml:"allowMachineConfigSecrets,omitempty"`
+}
+
+// ValidateMachineConfig validates the embedded machine config against the Talos version of the image.
+//
+// It returns true if the machine config contains secrets.
+func (c *CustomizationProfile) ValidateMachineConfig(talosVersion string) (bool, error) {
+	cfg, err := configloader.NewFromBytes([]byte(c.MachineConfig))
+	if err != nil {
+		return false, fmt.Errorf("failed to load machine config: %w", err)
+	}
+
+	imageContract, err := config.ParseContractFromVersion(talosVersion)
+	if err != nil {
+		return false, err
+	}
+
+	if currentContract, err := config.ParseContractFromVersion(version.Tag); err == nil && imageContract.Greater(currentContract) {
+		return false, fmt.Errorf("machine config for Talos %s can't be validated by the imager %s", talosVersion, version.Tag)
+	}
+
+	if !imageContract.Greater(config.TalosVersion1_4) && len(cfg.Documents()) > 1 {
+		return false, fmt.Errorf("multi-document machine config is not supported in Talos %s", talosVersion)
+	}
+
+	if _, err = cfg.Validate(metalMode{}, validation.WithLocal()); err != nil {
+		return false, fmt.Errorf("machine config is not valid for Talos %s: %w", talosVersion, err)
+	}
+
+	encoded, err := cfg.EncodeBytes(encoder.WithComments(encoder.CommentsDisabled))
+	if err != nil {
+		return false, err
+	}
+
+	redacted, err := cfg.RedactSecrets("").EncodeBytes(encoder.WithComments(encoder.CommentsDisabled))
+	if err != nil {
+		return false, err
+	}
+
+	return !bytes.Equal(encoded, redacted), nil
+}
+
+// metalMode is the runtime mode of the node booted from the image with the embedded machine config.
+type metalMode struct{}
+
+func (metalMode) String() string {
+	return "metal"
+}
+
+func (metalMode) RequiresInstall() bool {
+	return true
+}
+
+func (metalMode) InContainer() bool {
+	return false
 }
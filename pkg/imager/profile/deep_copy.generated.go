@@ -51,6 +51,24 @@ func (o Profile) DeepCopy() Profile {
 		cp.Output.ISOOptions = new(ISOOptions)
 		*cp.Output.ISOOptions = *o.Output.ISOOptions
 	}
+	if o.Output.AMIOptions != nil {
+		cp.Output.AMIOptions = new(AMIOptions)
+		*cp.Output.AMIOptions = *o.Output.AMIOptions
+	}
+	if o.Variants != nil {
+		cp.Variants = make([]Variant, len(o.Variants))
+		copy(cp.Variants, o.Variants)
+		for i2 := range o.Variants {
+			if o.Variants[i2].ExtraKernelArgs != nil {
+				cp.Variants[i2].ExtraKernelArgs = make([]string, len(o.Variants[i2].ExtraKernelArgs))
+				copy(cp.Variants[i2].ExtraKernelArgs, o.Variants[i2].ExtraKernelArgs)
+			}
+			if o.Variants[i2].SystemExtensions != nil {
+				cp.Variants[i2].SystemExtensions = make([]ContainerAsset, len(o.Variants[i2].SystemExtensions))
+				copy(cp.Variants[i2].SystemExtensions, o.Variants[i2].SystemExtensions)
+			}
+		}
+	}
 	return cp
 }
 
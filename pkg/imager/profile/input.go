@@ -6,18 +6,23 @@ package profile
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/google/go-containerregistry/pkg/authn"
 	"github.com/google/go-containerregistry/pkg/authn/github"
 	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/name"
 	v1 "github.com/google/go-containerregistry/pkg/v1"
 	"github.com/google/go-containerregistry/pkg/v1/google"
 	"github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
 	"github.com/siderolabs/gen/value"
 	"golang.org/x/sync/errgroup"
 
@@ -29,6 +34,7 @@ import (
 	"github.com/siderolabs/talos/pkg/imager/profile/internal/signer/file"
 	"github.com/siderolabs/talos/pkg/images"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/extensions"
 	"github.com/siderolabs/talos/pkg/machinery/imager/quirks"
 )
 
@@ -37,6 +43,9 @@ const (
 	amd64 = "amd64"
 )
 
+// cosignSignatureAnnotation is the annotation of the signature layer which holds the base64-encoded signature.
+const cosignSignatureAnnotation = "dev.cosignproject.cosign/signature"
+
 // Input describes inputs for image generation.
 type Input struct {
 	// Kernel is a vmlinuz file.
@@ -275,11 +284,23 @@ func (c *ContainerAsset) Pull(ctx context.Context, arch string, printf func(stri
 
 	printf("pulling %s...", c.ImageRef)
 
-	opts := []crane.Option{
+	opts := append(c.craneOptions(ctx),
 		crane.WithPlatform(&v1.Platform{
 			Architecture: arch,
 			OS:           "linux",
 		}),
+	)
+
+	img, err := crane.Pull(c.ImageRef, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error pulling image %s: %w", c.ImageRef, err)
+	}
+
+	return img, nil
+}
+
+func (c *ContainerAsset) craneOptions(ctx context.Context) []crane.Option {
+	opts := []crane.Option{
 		crane.WithContext(ctx),
 		crane.WithAuthFromKeychain(
 			authn.NewMultiKeychain(
@@ -294,12 +315,103 @@ func (c *ContainerAsset) Pull(ctx context.Context, arch string, printf func(stri
 		opts = append(opts, crane.Insecure)
 	}
 
-	img, err := crane.Pull(c.ImageRef, opts...)
+	return opts
+}
+
+// Source resolves the image digest of the container asset and fetches the cosign-style signatures attached to it.
+//
+// The container asset is pinned to the resolved digest, so that the content matches the recorded source.
+// Local container assets (tarball, OCI layout) don't have a source, and nil is returned.
+func (c *ContainerAsset) Source(ctx context.Context, printf func(string, ...any)) (*extensions.Source, error) {
+	if c.TarballPath != "" || c.OCIPath != "" {
+		return nil, nil //nolint:nilnil
+	}
+
+	opts := c.craneOptions(ctx)
+
+	var nameOpts []name.Option
+
+	if c.ForceInsecure {
+		nameOpts = append(nameOpts, name.Insecure)
+	}
+
+	ref, err := name.ParseReference(c.ImageRef, nameOpts...)
 	if err != nil {
-		return nil, fmt.Errorf("error pulling image %s: %w", c.ImageRef, err)
+		return nil, fmt.Errorf("error parsing image reference %s: %w", c.ImageRef, err)
 	}
 
-	return img, nil
+	digest, err := crane.Digest(c.ImageRef, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error resolving image digest %s: %w", c.ImageRef, err)
+	}
+
+	source := &extensions.Source{
+		ImageRef: c.ImageRef,
+		Digest:   digest,
+	}
+
+	c.ImageRef = ref.Context().Digest(digest).String()
+
+	// cosign stores the signatures as layers of the image tagged after the signed digest
+	signatureRef := ref.Context().Tag(strings.Replace(digest, ":", "-", 1) + ".sig")
+
+	printf("fetching signatures %s...", signatureRef)
+
+	signatureImg, err := crane.Pull(signatureRef.String(), opts...)
+	if err != nil {
+		var transportErr *transport.Error
+
+		if errors.As(err, &transportErr) && transportErr.StatusCode == http.StatusNotFound {
+			return source, nil
+		}
+
+		return nil, fmt.Errorf("error fetching signatures %s: %w", signatureRef, err)
+	}
+
+	manifest, err := signatureImg.Manifest()
+	if err != nil {
+		return nil, fmt.Errorf("error reading signatures manifest: %w", err)
+	}
+
+	for _, desc := range manifest.Layers {
+		encodedSignature, ok := desc.Annotations[cosignSignatureAnnotation]
+		if !ok {
+			continue
+		}
+
+		signature, decodeErr := base64.StdEncoding.DecodeString(encodedSignature)
+		if decodeErr != nil {
+			return nil, fmt.Errorf("error decoding signature %s: %w", desc.Digest, decodeErr)
+		}
+
+		payload, readErr := readBlob(signatureImg, desc.Digest)
+		if readErr != nil {
+			return nil, fmt.Errorf("error reading signature payload %s: %w", desc.Digest, readErr)
+		}
+
+		source.Signatures = append(source.Signatures, extensions.Signature{
+			Payload:   payload,
+			Signature: signature,
+		})
+	}
+
+	return source, nil
+}
+
+func readBlob(img v1.Image, digest v1.Hash) ([]byte, error) {
+	layer, err := img.LayerByDigest(digest)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := layer.Compressed()
+	if err != nil {
+		return nil, err
+	}
+
+	defer r.Close() //nolint:errcheck
+
+	return io.ReadAll(r)
 }
 
 func (c *ContainerAsset) pullFromOCI(arch string) (v1.Image, error) {
@@ -5,6 +5,9 @@
 package profile
 
 import (
+	"fmt"
+
+	"github.com/siderolabs/talos/pkg/imager/cloud"
 	"github.com/siderolabs/talos/pkg/machinery/imager/quirks"
 )
 
@@ -16,17 +19,32 @@ type Output struct {
 	//  * installer - installer container
 	//  * kernel - Linux kernel
 	//  * initramfs - initramfs image
+	//  * gce - GCE image tarball (disk image as disk.raw)
+	//  * azure-vhd - Azure fixed VHD disk image
+	//  * ami - AWS raw or VMDK disk image for the snapshot import
 	Kind OutputKind `yaml:"kind"`
-	// Options for the 'image' output.
+	// Options for the 'image' output (and the cloud image outputs).
 	ImageOptions *ImageOptions `yaml:"imageOptions,omitempty"`
 	// Options for the 'iso' output.
 	ISOOptions *ISOOptions `yaml:"isoOptions,omitempty"`
+	// Options for the 'ami' output.
+	AMIOptions *AMIOptions `yaml:"amiOptions,omitempty"`
 	// OutFormat is the format for the output:
 	//  * raw - output raw file
 	//  * .tar.gz - output tar.gz archive
 	//  * .xz - output xz archive
 	//  * .gz - output gz archive
+	//  * .zst - output zstd archive
 	OutFormat OutFormat `yaml:"outFormat"`
+	// CompressionLevel overrides the default compression level of the output format (if not zero).
+	CompressionLevel int `yaml:"compressionLevel,omitempty"`
+	// Checksums enables writing `.sha256` sidecar files for the output.
+	Checksums bool `yaml:"checksums,omitempty"`
+	// DeltaBase is the path to the previous build of the output.
+	//
+	// If set, the binary delta from the base to the output is written as `.delta` sidecar file
+	// along with the `.delta.json` manifest.
+	DeltaBase string `yaml:"deltaBase,omitempty"`
 }
 
 // ImageOptions describes options for the 'image' output.
@@ -38,6 +56,8 @@ type ImageOptions struct {
 	//  * qcow2 - qcow2 disk image
 	//  * vhd - VPC disk image
 	//  * ova - VMWare disk image
+	//  * vhdx - VHDX disk image
+	//  * vmdk - VMDK disk image (streamOptimized by default)
 	DiskFormat DiskFormat `yaml:"diskFormat,omitempty"`
 	// DiskFormatOptions are additional options for the disk format
 	DiskFormatOptions string `yaml:"diskFormatOptions,omitempty"`
@@ -54,6 +74,16 @@ type ISOOptions struct {
 	SDBootEnrollKeys SDBootEnrollKeys `yaml:"sdBootEnrollKeys"`
 }
 
+// AMIOptions describes options for the 'ami' output.
+type AMIOptions struct {
+	// ImportBucket is the S3 bucket the image is going to be uploaded to.
+	//
+	// If set, the `.import.json` manifest for `aws ec2 import-snapshot` is written next to the output.
+	ImportBucket string `yaml:"importBucket,omitempty"`
+	// ImportKeyPrefix is the S3 key prefix the image is going to be uploaded with.
+	ImportKeyPrefix string `yaml:"importKeyPrefix,omitempty"`
+}
+
 // OutputKind is output specification.
 type OutputKind int
 
@@ -67,8 +97,40 @@ const (
 	OutKindInitramfs                   // initramfs
 	OutKindUKI                         // uki
 	OutKindCmdline                     // cmdline
+	OutKindGCE                         // gce
+	OutKindAzureVHD                    // azure-vhd
+	OutKindAMI                         // ami
 )
 
+// IsDiskImage returns true if the output kind is built from the disk image.
+func (k OutputKind) IsDiskImage() bool {
+	switch k { //nolint:exhaustive
+	case OutKindImage, OutKindGCE, OutKindAzureVHD, OutKindAMI:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsCloudImage returns true if the output kind is the cloud specific disk image.
+func (k OutputKind) IsCloudImage() bool {
+	return k.IsDiskImage() && k != OutKindImage
+}
+
+// cloudConstraints returns the disk size alignment and the maximum size for the cloud image.
+func (k OutputKind) cloudConstraints() (alignment, maxSize int64) {
+	switch k { //nolint:exhaustive
+	case OutKindGCE:
+		return cloud.GCEAlignment, cloud.GCEMaxSize
+	case OutKindAzureVHD:
+		return cloud.AzureAlignment, cloud.AzureMaxSize
+	case OutKindAMI:
+		return cloud.AWSAlignment, cloud.AWSMaxSize
+	default:
+		return 1, 0
+	}
+}
+
 // OutFormat is output format specification.
 type OutFormat int
 
@@ -92,6 +154,8 @@ const (
 	DiskFormatQCOW2                     // qcow2
 	DiskFormatVPC                       // vhd
 	DiskFormatOVA                       // ova
+	DiskFormatVHDX                      // vhdx
+	DiskFormatVMDK                      // vmdk
 )
 
 // SDBootEnrollKeys is a value in loader.conf secure-boot-enroll: off, manual, if-safe, force.
@@ -118,9 +182,31 @@ const (
 	DiskImageBootloaderGrub // grub
 )
 
+// validateCompressionLevel checks the compression level against the output format.
+func (o *Output) validateCompressionLevel() error {
+	var minLevel, maxLevel int
+
+	switch o.OutFormat {
+	case OutFormatGZ, OutFormatTar:
+		minLevel, maxLevel = 1, 9
+	case OutFormatXZ:
+		minLevel, maxLevel = 0, 9
+	case OutFormatZSTD:
+		minLevel, maxLevel = 1, 19
+	case OutFormatUnknown, OutFormatRaw:
+		return fmt.Errorf("compression level is not supported for %s output format", o.OutFormat)
+	}
+
+	if o.CompressionLevel < minLevel || o.CompressionLevel > maxLevel {
+		return fmt.Errorf("compression level %d is out of range [%d, %d] for %s output format", o.CompressionLevel, minLevel, maxLevel, o.OutFormat)
+	}
+
+	return nil
+}
+
 // FillDefaults fills default values for the output.
 func (o *Output) FillDefaults(arch, version string, secureboot bool) {
-	if o.Kind == OutKindImage {
+	if o.Kind.IsDiskImage() {
 		if o.ImageOptions == nil {
 			o.ImageOptions = &ImageOptions{}
 		}
@@ -153,5 +239,33 @@ func (o *Output) FillDefaults(arch, version string, secureboot bool) {
 			// add extra space for BIOS and BOOT partitions
 			o.ImageOptions.DiskSize += int64(ps.GrubBIOSSize()) + int64(ps.GrubBootSize())
 		}
+
+		if o.Kind.IsCloudImage() {
+			// round up the disk size to match the cloud constraints
+			alignment, _ := o.Kind.cloudConstraints()
+
+			o.ImageOptions.DiskSize = cloud.AlignSize(o.ImageOptions.DiskSize, alignment)
+		}
+	}
+}
+
+// validateCloudImage checks the cloud image output options.
+func (o *Output) validateCloudImage() error {
+	if o.OutFormat != OutFormatRaw {
+		return fmt.Errorf("%s output doesn't support %s output format, the output is ready to be imported", o.Kind, o.OutFormat)
+	}
+
+	if o.Kind == OutKindAMI && o.ImageOptions.DiskFormat != DiskFormatRaw && o.ImageOptions.DiskFormat != DiskFormatVMDK {
+		return fmt.Errorf("%s output supports only raw and vmdk disk formats", o.Kind)
+	}
+
+	if o.Kind != OutKindAMI && o.AMIOptions != nil {
+		return fmt.Errorf("AMI options are not supported for %s output", o.Kind)
+	}
+
+	if _, maxSize := o.Kind.cloudConstraints(); o.ImageOptions.DiskSize > maxSize {
+		return fmt.Errorf("disk size %d exceeds the maximum size %d for %s output", o.ImageOptions.DiskSize, maxSize, o.Kind)
 	}
+
+	return nil
 }
@@ -7,11 +7,11 @@ import (
 	"strings"
 )
 
-const _OutputKindName = "unknownisoimageinstallerkernelinitramfsukicmdline"
+const _OutputKindName = "unknownisoimageinstallerkernelinitramfsukicmdlinegceazure-vhdami"
 
-var _OutputKindIndex = [...]uint8{0, 7, 10, 15, 24, 30, 39, 42, 49}
+var _OutputKindIndex = [...]uint8{0, 7, 10, 15, 24, 30, 39, 42, 49, 52, 61, 64}
 
-const _OutputKindLowerName = "unknownisoimageinstallerkernelinitramfsukicmdline"
+const _OutputKindLowerName = "unknownisoimageinstallerkernelinitramfsukicmdlinegceazure-vhdami"
 
 func (i OutputKind) String() string {
 	if i < 0 || i >= OutputKind(len(_OutputKindIndex)-1) {
@@ -32,9 +32,12 @@ func _OutputKindNoOp() {
 	_ = x[OutKindInitramfs-(5)]
 	_ = x[OutKindUKI-(6)]
 	_ = x[OutKindCmdline-(7)]
+	_ = x[OutKindGCE-(8)]
+	_ = x[OutKindAzureVHD-(9)]
+	_ = x[OutKindAMI-(10)]
 }
 
-var _OutputKindValues = []OutputKind{OutKindUnknown, OutKindISO, OutKindImage, OutKindInstaller, OutKindKernel, OutKindInitramfs, OutKindUKI, OutKindCmdline}
+var _OutputKindValues = []OutputKind{OutKindUnknown, OutKindISO, OutKindImage, OutKindInstaller, OutKindKernel, OutKindInitramfs, OutKindUKI, OutKindCmdline, OutKindGCE, OutKindAzureVHD, OutKindAMI}
 
 var _OutputKindNameToValueMap = map[string]OutputKind{
 	_OutputKindName[0:7]:        OutKindUnknown,
@@ -53,6 +56,12 @@ var _OutputKindNameToValueMap = map[string]OutputKind{
 	_OutputKindLowerName[39:42]: OutKindUKI,
 	_OutputKindName[42:49]:      OutKindCmdline,
 	_OutputKindLowerName[42:49]: OutKindCmdline,
+	_OutputKindName[49:52]:      OutKindGCE,
+	_OutputKindLowerName[49:52]: OutKindGCE,
+	_OutputKindName[52:61]:      OutKindAzureVHD,
+	_OutputKindLowerName[52:61]: OutKindAzureVHD,
+	_OutputKindName[61:64]:      OutKindAMI,
+	_OutputKindLowerName[61:64]: OutKindAMI,
 }
 
 var _OutputKindNames = []string{
@@ -64,6 +73,9 @@ var _OutputKindNames = []string{
 	_OutputKindName[30:39],
 	_OutputKindName[39:42],
 	_OutputKindName[42:49],
+	_OutputKindName[49:52],
+	_OutputKindName[52:61],
+	_OutputKindName[61:64],
 }
 
 // OutputKindString retrieves an enum value from the enum constants string name.
@@ -211,11 +223,11 @@ func (i *OutFormat) UnmarshalText(text []byte) error {
 	return err
 }
 
-const _DiskFormatName = "unknownrawqcow2vhdova"
+const _DiskFormatName = "unknownrawqcow2vhdovavhdxvmdk"
 
-var _DiskFormatIndex = [...]uint8{0, 7, 10, 15, 18, 21}
+var _DiskFormatIndex = [...]uint8{0, 7, 10, 15, 18, 21, 25, 29}
 
-const _DiskFormatLowerName = "unknownrawqcow2vhdova"
+const _DiskFormatLowerName = "unknownrawqcow2vhdovavhdxvmdk"
 
 func (i DiskFormat) String() string {
 	if i < 0 || i >= DiskFormat(len(_DiskFormatIndex)-1) {
@@ -233,9 +245,11 @@ func _DiskFormatNoOp() {
 	_ = x[DiskFormatQCOW2-(2)]
 	_ = x[DiskFormatVPC-(3)]
 	_ = x[DiskFormatOVA-(4)]
+	_ = x[DiskFormatVHDX-(5)]
+	_ = x[DiskFormatVMDK-(6)]
 }
 
-var _DiskFormatValues = []DiskFormat{DiskFormatUnknown, DiskFormatRaw, DiskFormatQCOW2, DiskFormatVPC, DiskFormatOVA}
+var _DiskFormatValues = []DiskFormat{DiskFormatUnknown, DiskFormatRaw, DiskFormatQCOW2, DiskFormatVPC, DiskFormatOVA, DiskFormatVHDX, DiskFormatVMDK}
 
 var _DiskFormatNameToValueMap = map[string]DiskFormat{
 	_DiskFormatName[0:7]:        DiskFormatUnknown,
@@ -248,6 +262,10 @@ var _DiskFormatNameToValueMap = map[string]DiskFormat{
 	_DiskFormatLowerName[15:18]: DiskFormatVPC,
 	_DiskFormatName[18:21]:      DiskFormatOVA,
 	_DiskFormatLowerName[18:21]: DiskFormatOVA,
+	_DiskFormatName[21:25]:      DiskFormatVHDX,
+	_DiskFormatLowerName[21:25]: DiskFormatVHDX,
+	_DiskFormatName[25:29]:      DiskFormatVMDK,
+	_DiskFormatLowerName[25:29]: DiskFormatVMDK,
 }
 
 var _DiskFormatNames = []string{
@@ -256,6 +274,8 @@ var _DiskFormatNames = []string{
 	_DiskFormatName[10:15],
 	_DiskFormatName[15:18],
 	_DiskFormatName[18:21],
+	_DiskFormatName[21:25],
+	_DiskFormatName[25:29],
 }
 
 // DiskFormatString retrieves an enum value from the enum constants string name.
@@ -9,10 +9,12 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/siderolabs/go-pointer"
 	"gopkg.in/yaml.v3"
 
+	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/overlay"
 )
 
@@ -43,6 +45,10 @@ type Profile struct {
 	Overlay *OverlayOptions `yaml:"overlay,omitempty"`
 	// Output describes image generation result.
 	Output Output `yaml:"output"`
+	// Variants describes named variants of the output, each producing a separate artifact.
+	Variants []Variant `yaml:"variants,omitempty"`
+	// VariantName is the name of the variant the profile was built for, included in the output path.
+	VariantName string `yaml:"variantName,omitempty"`
 }
 
 // OverlayOptions describes overlay options for image generation.
@@ -89,14 +95,20 @@ func (p *Profile) Validate() error {
 		// ISO supports all kinds of customization
 	case OutKindCmdline:
 		// cmdline supports all kinds of customization
-	case OutKindImage:
+	case OutKindImage, OutKindGCE, OutKindAzureVHD, OutKindAMI:
 		// Image supports all kinds of customization
 		if p.Output.ImageOptions == nil {
-			return errors.New("image options are required for image output")
+			return fmt.Errorf("image options are required for %s output", p.Output.Kind)
 		}
 
 		if p.Output.ImageOptions.DiskSize == 0 {
-			return errors.New("disk size is required for image output")
+			return fmt.Errorf("disk size is required for %s output", p.Output.Kind)
+		}
+
+		if p.Output.Kind.IsCloudImage() {
+			if err := p.Output.validateCloudImage(); err != nil {
+				return err
+			}
 		}
 	case OutKindInstaller:
 		if len(p.Customization.MetaContents) > 0 {
@@ -117,6 +129,49 @@ func (p *Profile) Validate() error {
 	case OutKindUKI:
 	}
 
+	if err := p.ValidateVariants(); err != nil {
+		return err
+	}
+
+	if p.Output.CompressionLevel != 0 {
+		if err := p.Output.validateCompressionLevel(); err != nil {
+			return err
+		}
+	}
+
+	if p.Customization.MachineConfig != "" {
+		if err := p.validateMachineConfig(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *Profile) validateMachineConfig() error {
+	if p.Platform != "metal" {
+		return fmt.Errorf("embedding machine config is not supported for platform %q", p.Platform)
+	}
+
+	if p.Output.Kind != OutKindISO && !p.Output.Kind.IsDiskImage() {
+		return fmt.Errorf("embedding machine config is not supported for %s output", p.Output.Kind)
+	}
+
+	for _, arg := range p.Customization.ExtraKernelArgs {
+		if strings.HasPrefix(arg, constants.KernelParamConfig+"=") {
+			return fmt.Errorf("kernel argument %q conflicts with the embedded machine config", arg)
+		}
+	}
+
+	hasSecrets, err := p.Customization.ValidateMachineConfig(p.Version)
+	if err != nil {
+		return err
+	}
+
+	if hasSecrets && !p.Customization.AllowMachineConfigSecrets {
+		return errors.New("machine config contains secrets, embedding it into the image requires allowMachineConfigSecrets to be set")
+	}
+
 	return nil
 }
 
@@ -136,6 +191,10 @@ func (p *Profile) OutputPath() string {
 		path += "-secureboot"
 	}
 
+	if p.VariantName != "" {
+		path += "-" + p.VariantName
+	}
+
 	switch p.Output.Kind {
 	case OutKindUnknown:
 		panic("unknown output kind")
@@ -150,15 +209,29 @@ func (p *Profile) OutputPath() string {
 			path += "-secureboot"
 		}
 
+		if p.VariantName != "" {
+			path += "-" + p.VariantName
+		}
+
 		path += ".tar"
 	case OutKindKernel:
 		path = "kernel-" + p.Arch
+
+		if p.VariantName != "" {
+			path += "-" + p.VariantName
+		}
 	case OutKindInitramfs:
 		path = "initramfs-" + path + ".xz"
 	case OutKindUKI:
 		path += "-uki.efi"
 	case OutKindCmdline:
 		path = "cmdline-" + path
+	case OutKindGCE:
+		path += ".raw.tar.gz"
+	case OutKindAzureVHD:
+		path += ".vhd"
+	case OutKindAMI:
+		path += "." + p.Output.ImageOptions.DiskFormat.String()
 	}
 
 	return path
@@ -166,6 +239,14 @@ func (p *Profile) OutputPath() string {
 
 // Dump the profile as YAML.
 func (p *Profile) Dump(w io.Writer) error {
+	// the embedded machine config might contain secrets, so it is not dumped
+	if p.Customization.MachineConfig != "" {
+		redacted := p.DeepCopy()
+		redacted.Customization.MachineConfig = fmt.Sprintf("<%d bytes>", len(p.Customization.MachineConfig))
+
+		p = &redacted
+	}
+
 	encoder := yaml.NewEncoder(w)
 	encoder.SetIndent(2)
 
@@ -95,3 +95,395 @@ func TestFillDefaults(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateMachineConfig(t *testing.T) {
+	t.Parallel()
+
+	const (
+		configWithSecrets = `apiVersion: v1alpha1
+kind: SideroLinkConfig
+apiUrl: https://siderolink.api/?jointoken=secret
+`
+		configWithoutSecrets = `apiVersion: v1alpha1
+kind: SideroLinkConfig
+apiUrl: https://siderolink.api/
+`
+		kmsgLogConfig = `---
+apiVersion: v1alpha1
+kind: KmsgLogConfig
+name: remote
+url: tcp://192.168.3.7:3478/
+`
+	)
+
+	for _, test := range []struct {
+		name   string
+		modify func(*profile.Profile)
+
+		expectedError string
+	}{
+		{
+			name: "no secrets",
+		},
+		{
+			name: "disk image",
+			modify: func(p *profile.Profile) {
+				p.Output.Kind = profile.OutKindImage
+				p.Output.ImageOptions = &profile.ImageOptions{DiskSize: profile.MinRAWDiskSize}
+			},
+		},
+		{
+			name: "secrets",
+			modify: func(p *profile.Profile) {
+				p.Customization.MachineConfig = configWithSecrets
+			},
+			expectedError: "machine config contains secrets, embedding it into the image requires allowMachineConfigSecrets to be set",
+		},
+		{
+			name: "secrets allowed",
+			modify: func(p *profile.Profile) {
+				p.Customization.MachineConfig = configWithSecrets
+				p.Customization.AllowMachineConfigSecrets = true
+			},
+		},
+		{
+			name: "invalid config",
+			modify: func(p *profile.Profile) {
+				p.Customization.MachineConfig = "apiVersion: v1alpha1\nkind: UnknownConfig\n"
+			},
+			expectedError: "failed to load machine config",
+		},
+		{
+			name: "multi-document on old version",
+			modify: func(p *profile.Profile) {
+				p.Version = "v1.4.0"
+				p.Customization.MachineConfig = configWithoutSecrets + kmsgLogConfig
+			},
+			expectedError: "multi-document machine config is not supported in Talos v1.4.0",
+		},
+		{
+			name: "multi-document",
+			modify: func(p *profile.Profile) {
+				p.Customization.MachineConfig = configWithoutSecrets + kmsgLogConfig
+			},
+		},
+		{
+			name: "platform",
+			modify: func(p *profile.Profile) {
+				p.Platform = "aws"
+			},
+			expectedError: `embedding machine config is not supported for platform "aws"`,
+		},
+		{
+			name: "output kind",
+			modify: func(p *profile.Profile) {
+				p.Output.Kind = profile.OutKindInstaller
+			},
+			expectedError: "embedding machine config is not supported for installer output",
+		},
+		{
+			name: "config kernel argument",
+			modify: func(p *profile.Profile) {
+				p.Customization.ExtraKernelArgs = []string{"talos.config=https://example.com/config.yaml"}
+			},
+			expectedError: `kernel argument "talos.config=https://example.com/config.yaml" conflicts with the embedded machine config`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			prof := profile.Profile{
+				Arch:     "amd64",
+				Platform: "metal",
+				Version:  version.Tag,
+				Customization: profile.CustomizationProfile{
+					MachineConfig: configWithoutSecrets,
+				},
+				Output: profile.Output{
+					Kind: profile.OutKindISO,
+				},
+			}
+
+			if test.modify != nil {
+				test.modify(&prof)
+			}
+
+			err := prof.Validate()
+			if test.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, test.expectedError)
+			}
+		})
+	}
+}
+
+func TestValidateCompressionLevel(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+
+		outFormat        profile.OutFormat
+		compressionLevel int
+
+		expectedError string
+	}{
+		{
+			name:      "default",
+			outFormat: profile.OutFormatZSTD,
+		},
+		{
+			name:             "zstd",
+			outFormat:        profile.OutFormatZSTD,
+			compressionLevel: 3,
+		},
+		{
+			name:             "zstd out of range",
+			outFormat:        profile.OutFormatZSTD,
+			compressionLevel: 22,
+			expectedError:    "compression level 22 is out of range [1, 19] for .zst output format",
+		},
+		{
+			name:             "gzip",
+			outFormat:        profile.OutFormatGZ,
+			compressionLevel: 9,
+		},
+		{
+			name:             "raw",
+			outFormat:        profile.OutFormatRaw,
+			compressionLevel: 1,
+			expectedError:    "compression level is not supported for raw output format",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			prof := profile.Profile{
+				Arch:     "amd64",
+				Platform: "metal",
+				Output: profile.Output{
+					Kind:             profile.OutKindImage,
+					ImageOptions:     &profile.ImageOptions{DiskSize: profile.MinRAWDiskSize, DiskFormat: profile.DiskFormatVMDK},
+					OutFormat:        test.outFormat,
+					CompressionLevel: test.compressionLevel,
+				},
+			}
+
+			err := prof.Validate()
+			if test.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, test.expectedError)
+			}
+		})
+	}
+}
+
+func TestCloudImage(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+
+		kind       profile.OutputKind
+		outFormat  profile.OutFormat
+		diskFormat profile.DiskFormat
+		amiOptions *profile.AMIOptions
+
+		expectedAlignment int64
+		expectedPath      string
+		expectedError     string
+	}{
+		{
+			name:              "gce",
+			kind:              profile.OutKindGCE,
+			outFormat:         profile.OutFormatRaw,
+			diskFormat:        profile.DiskFormatRaw,
+			expectedAlignment: 1024 * 1024 * 1024,
+			expectedPath:      "gcp-amd64.raw.tar.gz",
+		},
+		{
+			name:              "azure",
+			kind:              profile.OutKindAzureVHD,
+			outFormat:         profile.OutFormatRaw,
+			diskFormat:        profile.DiskFormatVPC,
+			expectedAlignment: 1024 * 1024,
+			expectedPath:      "gcp-amd64.vhd",
+		},
+		{
+			name:              "ami vmdk",
+			kind:              profile.OutKindAMI,
+			outFormat:         profile.OutFormatRaw,
+			diskFormat:        profile.DiskFormatVMDK,
+			amiOptions:        &profile.AMIOptions{ImportBucket: "talos-images"},
+			expectedAlignment: 1024 * 1024 * 1024,
+			expectedPath:      "gcp-amd64.vmdk",
+		},
+		{
+			name:          "compressed",
+			kind:          profile.OutKindAMI,
+			outFormat:     profile.OutFormatZSTD,
+			diskFormat:    profile.DiskFormatRaw,
+			expectedError: "ami output doesn't support .zst output format, the output is ready to be imported",
+		},
+		{
+			name:          "ami qcow2",
+			kind:          profile.OutKindAMI,
+			outFormat:     profile.OutFormatRaw,
+			diskFormat:    profile.DiskFormatQCOW2,
+			expectedError: "ami output supports only raw and vmdk disk formats",
+		},
+		{
+			name:          "ami options",
+			kind:          profile.OutKindGCE,
+			outFormat:     profile.OutFormatRaw,
+			diskFormat:    profile.DiskFormatRaw,
+			amiOptions:    &profile.AMIOptions{ImportBucket: "talos-images"},
+			expectedError: "AMI options are not supported for gce output",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			prof := profile.Profile{
+				Arch:     "amd64",
+				Platform: "gcp",
+				Version:  "1.12.0",
+				Output: profile.Output{
+					Kind:         test.kind,
+					ImageOptions: &profile.ImageOptions{DiskSize: profile.DefaultRAWDiskSize, DiskFormat: test.diskFormat},
+					AMIOptions:   test.amiOptions,
+					OutFormat:    test.outFormat,
+				},
+			}
+
+			prof.Output.FillDefaults(prof.Arch, prof.Version, false)
+
+			err := prof.Validate()
+			if test.expectedError != "" {
+				require.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			require.Zero(t, prof.Output.ImageOptions.DiskSize%test.expectedAlignment)
+			require.GreaterOrEqual(t, prof.Output.ImageOptions.DiskSize, int64(profile.DefaultRAWDiskSize))
+			require.Equal(t, test.expectedPath, prof.OutputPath())
+		})
+	}
+}
+
+func TestValidateVariants(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+
+		extraKernelArgs []string
+		deltaBase       string
+		variants        []profile.Variant
+
+		expectedError string
+	}{
+		{
+			name:            "no conflicts",
+			extraKernelArgs: []string{"console=ttyS0", "net.ifnames=0"},
+			variants: []profile.Variant{
+				{Name: "gpu", ExtraKernelArgs: []string{"nvidia.modeset=1"}},
+				{Name: "serial", ExtraKernelArgs: []string{"console=ttyS0", "-net.ifnames"}},
+			},
+		},
+		{
+			name:            "conflicting kernel argument",
+			extraKernelArgs: []string{"console=ttyS0"},
+			variants: []profile.Variant{
+				{Name: "vga", ExtraKernelArgs: []string{"console=tty0"}},
+			},
+			expectedError: `variant "vga": kernel argument "console=tty0" conflicts with "console=ttyS0" in the base profile`,
+		},
+		{
+			name: "duplicate name",
+			variants: []profile.Variant{
+				{Name: "gpu"},
+				{Name: "gpu"},
+			},
+			expectedError: `duplicate variant name "gpu"`,
+		},
+		{
+			name: "invalid name",
+			variants: []profile.Variant{
+				{Name: "../gpu"},
+			},
+			expectedError: `invalid variant name "../gpu"`,
+		},
+		{
+			name:      "delta base",
+			deltaBase: "/out/metal-amd64.raw",
+			variants: []profile.Variant{
+				{Name: "gpu"},
+			},
+			expectedError: "delta base can't be used with variants, as the variants have different outputs",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			prof := profile.Profile{
+				Customization: profile.CustomizationProfile{
+					ExtraKernelArgs: test.extraKernelArgs,
+				},
+				Output: profile.Output{
+					DeltaBase: test.deltaBase,
+				},
+				Variants: test.variants,
+			}
+
+			err := prof.ValidateVariants()
+			if test.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, test.expectedError)
+			}
+		})
+	}
+}
+
+func TestWithVariant(t *testing.T) {
+	t.Parallel()
+
+	prof := profile.Profile{
+		Arch:     "amd64",
+		Platform: "metal",
+		Customization: profile.CustomizationProfile{
+			ExtraKernelArgs: []string{"console=ttyS0"},
+		},
+		Input: profile.Input{
+			SystemExtensions: []profile.ContainerAsset{{ImageRef: "ghcr.io/siderolabs/gvisor:20231214.0-v1.7.0"}},
+		},
+		Output: profile.Output{
+			Kind:      profile.OutKindISO,
+			OutFormat: profile.OutFormatRaw,
+		},
+		Variants: []profile.Variant{
+			{
+				Name:             "gpu",
+				ExtraKernelArgs:  []string{"nvidia.modeset=1"},
+				SystemExtensions: []profile.ContainerAsset{{ImageRef: "ghcr.io/siderolabs/nvidia-open-gpu-kernel-modules:535.129.03-v1.7.0"}},
+			},
+		},
+	}
+
+	variantProf := prof.WithVariant(prof.Variants[0])
+
+	require.Empty(t, variantProf.Variants)
+	require.Equal(t, []string{"console=ttyS0", "nvidia.modeset=1"}, variantProf.Customization.ExtraKernelArgs)
+	require.Len(t, variantProf.Input.SystemExtensions, 2)
+	require.Equal(t, "metal-amd64-gpu.iso", variantProf.OutputPath())
+
+	// base profile is not modified
+	require.Equal(t, []string{"console=ttyS0"}, prof.Customization.ExtraKernelArgs)
+	require.Len(t, prof.Input.SystemExtensions, 1)
+}
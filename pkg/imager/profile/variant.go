@@ -0,0 +1,87 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package profile
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Variant describes a named variant of the output.
+//
+// Variant overrides are layered on top of the base profile.
+type Variant struct {
+	// Name of the variant, included in the output path.
+	Name string `yaml:"name"`
+	// ExtraKernelArgs is a list of extra kernel arguments appended to the base profile ones.
+	ExtraKernelArgs []string `yaml:"extraKernelArgs,omitempty"`
+	// SystemExtensions is a list of system extensions appended to the base profile ones.
+	SystemExtensions []ContainerAsset `yaml:"systemExtensions,omitempty"`
+}
+
+var variantNameRegexp = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+
+// WithVariant returns a copy of the profile with the variant overrides applied.
+func (p *Profile) WithVariant(variant Variant) Profile {
+	prof := p.DeepCopy()
+
+	prof.Variants = nil
+	prof.VariantName = variant.Name
+	prof.Customization.ExtraKernelArgs = append(prof.Customization.ExtraKernelArgs, variant.ExtraKernelArgs...)
+	prof.Input.SystemExtensions = append(prof.Input.SystemExtensions, variant.SystemExtensions...)
+
+	return prof
+}
+
+// ValidateVariants validates the variants of the profile.
+func (p *Profile) ValidateVariants() error {
+	if p.Output.DeltaBase != "" {
+		return errors.New("delta base can't be used with variants, as the variants have different outputs")
+	}
+
+	// base kernel arguments by key
+	baseArgs := map[string][]string{}
+
+	for _, arg := range p.Customization.ExtraKernelArgs {
+		if strings.HasPrefix(arg, "-") {
+			continue
+		}
+
+		key, _, _ := strings.Cut(arg, "=")
+
+		baseArgs[key] = append(baseArgs[key], arg)
+	}
+
+	names := map[string]struct{}{}
+
+	for _, variant := range p.Variants {
+		if !variantNameRegexp.MatchString(variant.Name) {
+			return fmt.Errorf("invalid variant name %q", variant.Name)
+		}
+
+		if _, ok := names[variant.Name]; ok {
+			return fmt.Errorf("duplicate variant name %q", variant.Name)
+		}
+
+		names[variant.Name] = struct{}{}
+
+		for _, arg := range variant.ExtraKernelArgs {
+			if strings.HasPrefix(arg, "-") {
+				continue
+			}
+
+			key, _, _ := strings.Cut(arg, "=")
+
+			if base, ok := baseArgs[key]; ok && !slices.Contains(base, arg) {
+				return fmt.Errorf("variant %q: kernel argument %q conflicts with %q in the base profile", variant.Name, arg, strings.Join(base, " "))
+			}
+		}
+	}
+
+	return nil
+}
@@ -6,11 +6,16 @@
 package utils
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
+	"slices"
 	"syscall"
 
 	"github.com/dustin/go-humanize"
+	"golang.org/x/sys/unix"
 )
 
 // CreateRawDisk creates a raw disk image of the specified size.
@@ -34,3 +39,42 @@ func CreateRawDisk(printf func(string, ...any), path string, diskSize int64) err
 
 	return f.Close()
 }
+
+// PunchHoles deallocates zeroed blocks of the raw disk image, so that the image becomes a sparse file.
+func PunchHoles(printf func(string, ...any), path string) error {
+	printf("punching holes in %s", filepath.Base(path))
+
+	f, err := os.OpenFile(path, os.O_RDWR, 0)
+	if err != nil {
+		return err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	const blockSize = 64 * 1024
+
+	buf := make([]byte, blockSize)
+
+	for offset := int64(0); ; offset += blockSize {
+		n, err := io.ReadFull(f, buf)
+		if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			return fmt.Errorf("failed to read raw disk: %w", err)
+		}
+
+		if n > 0 && !slices.ContainsFunc(buf[:n], func(b byte) bool { return b != 0 }) {
+			if err = unix.Fallocate(int(f.Fd()), unix.FALLOC_FL_PUNCH_HOLE|unix.FALLOC_FL_KEEP_SIZE, offset, int64(n)); err != nil {
+				return fmt.Errorf("failed to punch hole in raw disk: %w", err)
+			}
+		}
+
+		if n < blockSize {
+			break
+		}
+	}
+
+	return f.Close()
+}
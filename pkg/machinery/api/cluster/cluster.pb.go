@@ -82,6 +82,7 @@ type ClusterInfo struct {
 	ControlPlaneNodes []string               `protobuf:"bytes,1,rep,name=control_plane_nodes,json=controlPlaneNodes,proto3" json:"control_plane_nodes,omitempty"`
 	WorkerNodes       []string               `protobuf:"bytes,2,rep,name=worker_nodes,json=workerNodes,proto3" json:"worker_nodes,omitempty"`
 	ForceEndpoint     string                 `protobuf:"bytes,3,opt,name=force_endpoint,json=forceEndpoint,proto3" json:"force_endpoint,omitempty"`
+	RequireSecureBoot bool                   `protobuf:"varint,4,opt,name=require_secure_boot,json=requireSecureBoot,proto3" json:"require_secure_boot,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -137,6 +138,13 @@ func (x *ClusterInfo) GetForceEndpoint() string {
 	return ""
 }
 
+func (x *ClusterInfo) GetRequireSecureBoot() bool {
+	if x != nil {
+		return x.RequireSecureBoot
+	}
+	return false
+}
+
 type HealthCheckProgress struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Metadata      *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
@@ -196,11 +204,12 @@ const file_cluster_cluster_proto_rawDesc = "" +
 	"\x15cluster/cluster.proto\x12\acluster\x1a\x13common/common.proto\x1a\x1egoogle/protobuf/duration.proto\"\x8b\x01\n" +
 	"\x12HealthCheckRequest\x12<\n" +
 	"\fwait_timeout\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\vwaitTimeout\x127\n" +
-	"\fcluster_info\x18\x02 \x01(\v2\x14.cluster.ClusterInfoR\vclusterInfo\"\x87\x01\n" +
+	"\fcluster_info\x18\x02 \x01(\v2\x14.cluster.ClusterInfoR\vclusterInfo\"\xb7\x01\n" +
 	"\vClusterInfo\x12.\n" +
 	"\x13control_plane_nodes\x18\x01 \x03(\tR\x11controlPlaneNodes\x12!\n" +
 	"\fworker_nodes\x18\x02 \x03(\tR\vworkerNodes\x12%\n" +
-	"\x0eforce_endpoint\x18\x03 \x01(\tR\rforceEndpoint\"]\n" +
+	"\x0eforce_endpoint\x18\x03 \x01(\tR\rforceEndpoint\x12.\n" +
+	"\x13require_secure_boot\x18\x04 \x01(\bR\x11requireSecureBoot\"]\n" +
 	"\x13HealthCheckProgress\x12,\n" +
 	"\bmetadata\x18\x01 \x01(\v2\x10.common.MetadataR\bmetadata\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage2\\\n" +
@@ -107,6 +107,16 @@ func (m *ClusterInfo) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if m.RequireSecureBoot {
+		i--
+		if m.RequireSecureBoot {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.ForceEndpoint) > 0 {
 		i -= len(m.ForceEndpoint)
 		copy(dAtA[i:], m.ForceEndpoint)
@@ -237,6 +247,9 @@ func (m *ClusterInfo) SizeVT() (n int) {
 	if l > 0 {
 		n += 1 + l + protohelpers.SizeOfVarint(uint64(l))
 	}
+	if m.RequireSecureBoot {
+		n += 2
+	}
 	n += len(m.unknownFields)
 	return n
 }
@@ -513,6 +526,26 @@ func (m *ClusterInfo) UnmarshalVT(dAtA []byte) error {
 			}
 			m.ForceEndpoint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireSecureBoot", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireSecureBoot = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
@@ -14,6 +14,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 
 	common "github.com/siderolabs/talos/pkg/machinery/api/common"
 )
@@ -80,6 +81,107 @@ func (DependencyEdgeType) EnumDescriptor() ([]byte, []int) {
 	return file_inspect_inspect_proto_rawDescGZIP(), []int{0}
 }
 
+type BootstrapManifestAction int32
+
+const (
+	BootstrapManifestAction_UNCHANGED BootstrapManifestAction = 0
+	BootstrapManifestAction_CREATE    BootstrapManifestAction = 1
+	BootstrapManifestAction_UPDATE    BootstrapManifestAction = 2
+	BootstrapManifestAction_SKIP      BootstrapManifestAction = 3
+)
+
+// Enum value maps for BootstrapManifestAction.
+var (
+	BootstrapManifestAction_name = map[int32]string{
+		0: "UNCHANGED",
+		1: "CREATE",
+		2: "UPDATE",
+		3: "SKIP",
+	}
+	BootstrapManifestAction_value = map[string]int32{
+		"UNCHANGED": 0,
+		"CREATE":    1,
+		"UPDATE":    2,
+		"SKIP":      3,
+	}
+)
+
+func (x BootstrapManifestAction) Enum() *BootstrapManifestAction {
+	p := new(BootstrapManifestAction)
+	*p = x
+	return p
+}
+
+func (x BootstrapManifestAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BootstrapManifestAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_inspect_inspect_proto_enumTypes[1].Descriptor()
+}
+
+func (BootstrapManifestAction) Type() protoreflect.EnumType {
+	return &file_inspect_inspect_proto_enumTypes[1]
+}
+
+func (x BootstrapManifestAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BootstrapManifestAction.Descriptor instead.
+func (BootstrapManifestAction) EnumDescriptor() ([]byte, []int) {
+	return file_inspect_inspect_proto_rawDescGZIP(), []int{1}
+}
+
+type ResourceRevisionEvent int32
+
+const (
+	ResourceRevisionEvent_CREATED   ResourceRevisionEvent = 0
+	ResourceRevisionEvent_UPDATED   ResourceRevisionEvent = 1
+	ResourceRevisionEvent_DESTROYED ResourceRevisionEvent = 2
+)
+
+// Enum value maps for ResourceRevisionEvent.
+var (
+	ResourceRevisionEvent_name = map[int32]string{
+		0: "CREATED",
+		1: "UPDATED",
+		2: "DESTROYED",
+	}
+	ResourceRevisionEvent_value = map[string]int32{
+		"CREATED":   0,
+		"UPDATED":   1,
+		"DESTROYED": 2,
+	}
+)
+
+func (x ResourceRevisionEvent) Enum() *ResourceRevisionEvent {
+	p := new(ResourceRevisionEvent)
+	*p = x
+	return p
+}
+
+func (x ResourceRevisionEvent) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResourceRevisionEvent) Descriptor() protoreflect.EnumDescriptor {
+	return file_inspect_inspect_proto_enumTypes[2].Descriptor()
+}
+
+func (ResourceRevisionEvent) Type() protoreflect.EnumType {
+	return &file_inspect_inspect_proto_enumTypes[2]
+}
+
+func (x ResourceRevisionEvent) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ResourceRevisionEvent.Descriptor instead.
+func (ResourceRevisionEvent) EnumDescriptor() ([]byte, []int) {
+	return file_inspect_inspect_proto_rawDescGZIP(), []int{2}
+}
+
 // The ControllerRuntimeDependency message contains the graph of controller-resource dependencies.
 type ControllerRuntimeDependency struct {
 	state         protoimpl.MessageState      `protogen:"open.v1"`
@@ -253,11 +355,690 @@ func (x *ControllerDependencyEdge) GetResourceId() string {
 	return ""
 }
 
+type BootstrapManifestsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Compute the diff against the live cluster objects.
+	Diff          bool `protobuf:"varint,1,opt,name=diff,proto3" json:"diff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BootstrapManifestsRequest) Reset() {
+	*x = BootstrapManifestsRequest{}
+	mi := &file_inspect_inspect_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BootstrapManifestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BootstrapManifestsRequest) ProtoMessage() {}
+
+func (x *BootstrapManifestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inspect_inspect_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BootstrapManifestsRequest.ProtoReflect.Descriptor instead.
+func (*BootstrapManifestsRequest) Descriptor() ([]byte, []int) {
+	return file_inspect_inspect_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BootstrapManifestsRequest) GetDiff() bool {
+	if x != nil {
+		return x.Diff
+	}
+	return false
+}
+
+type BootstrapManifestObject struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Manifest      string                  `protobuf:"bytes,1,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	ApiVersion    string                  `protobuf:"bytes,2,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	Kind          string                  `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Namespace     string                  `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                  `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Action        BootstrapManifestAction `protobuf:"varint,6,opt,name=action,proto3,enum=inspect.BootstrapManifestAction" json:"action,omitempty"`
+	Policy        string                  `protobuf:"bytes,7,opt,name=policy,proto3" json:"policy,omitempty"`
+	Diff          string                  `protobuf:"bytes,8,opt,name=diff,proto3" json:"diff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BootstrapManifestObject) Reset() {
+	*x = BootstrapManifestObject{}
+	mi := &file_inspect_inspect_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BootstrapManifestObject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BootstrapManifestObject) ProtoMessage() {}
+
+func (x *BootstrapManifestObject) ProtoReflect() protoreflect.Message {
+	mi := &file_inspect_inspect_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BootstrapManifestObject.ProtoReflect.Descriptor instead.
+func (*BootstrapManifestObject) Descriptor() ([]byte, []int) {
+	return file_inspect_inspect_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BootstrapManifestObject) GetManifest() string {
+	if x != nil {
+		return x.Manifest
+	}
+	return ""
+}
+
+func (x *BootstrapManifestObject) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *BootstrapManifestObject) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *BootstrapManifestObject) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *BootstrapManifestObject) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BootstrapManifestObject) GetAction() BootstrapManifestAction {
+	if x != nil {
+		return x.Action
+	}
+	return BootstrapManifestAction_UNCHANGED
+}
+
+func (x *BootstrapManifestObject) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *BootstrapManifestObject) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+// The BootstrapManifests message contains the objects of the rendered bootstrap manifests.
+type BootstrapManifests struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Metadata      *common.Metadata           `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Objects       []*BootstrapManifestObject `protobuf:"bytes,2,rep,name=objects,proto3" json:"objects,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BootstrapManifests) Reset() {
+	*x = BootstrapManifests{}
+	mi := &file_inspect_inspect_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BootstrapManifests) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BootstrapManifests) ProtoMessage() {}
+
+func (x *BootstrapManifests) ProtoReflect() protoreflect.Message {
+	mi := &file_inspect_inspect_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BootstrapManifests.ProtoReflect.Descriptor instead.
+func (*BootstrapManifests) Descriptor() ([]byte, []int) {
+	return file_inspect_inspect_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BootstrapManifests) GetMetadata() *common.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *BootstrapManifests) GetObjects() []*BootstrapManifestObject {
+	if x != nil {
+		return x.Objects
+	}
+	return nil
+}
+
+type BootstrapManifestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*BootstrapManifests  `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BootstrapManifestsResponse) Reset() {
+	*x = BootstrapManifestsResponse{}
+	mi := &file_inspect_inspect_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BootstrapManifestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BootstrapManifestsResponse) ProtoMessage() {}
+
+func (x *BootstrapManifestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inspect_inspect_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BootstrapManifestsResponse.ProtoReflect.Descriptor instead.
+func (*BootstrapManifestsResponse) Descriptor() ([]byte, []int) {
+	return file_inspect_inspect_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BootstrapManifestsResponse) GetMessages() []*BootstrapManifests {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type ResourceSchemaRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Resource type, e.g. AddressSpecs.net.talos.dev.
+	Type          string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceSchemaRequest) Reset() {
+	*x = ResourceSchemaRequest{}
+	mi := &file_inspect_inspect_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceSchemaRequest) ProtoMessage() {}
+
+func (x *ResourceSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inspect_inspect_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceSchemaRequest.ProtoReflect.Descriptor instead.
+func (*ResourceSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_inspect_inspect_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ResourceSchemaRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+// The ResourceSchema message contains the JSON Schema of the resource spec.
+type ResourceSchema struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metadata      *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Schema        []byte                 `protobuf:"bytes,3,opt,name=schema,proto3" json:"schema,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceSchema) Reset() {
+	*x = ResourceSchema{}
+	mi := &file_inspect_inspect_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceSchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceSchema) ProtoMessage() {}
+
+func (x *ResourceSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_inspect_inspect_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceSchema.ProtoReflect.Descriptor instead.
+func (*ResourceSchema) Descriptor() ([]byte, []int) {
+	return file_inspect_inspect_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ResourceSchema) GetMetadata() *common.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *ResourceSchema) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ResourceSchema) GetSchema() []byte {
+	if x != nil {
+		return x.Schema
+	}
+	return nil
+}
+
+type ResourceSchemaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*ResourceSchema      `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceSchemaResponse) Reset() {
+	*x = ResourceSchemaResponse{}
+	mi := &file_inspect_inspect_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceSchemaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceSchemaResponse) ProtoMessage() {}
+
+func (x *ResourceSchemaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inspect_inspect_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceSchemaResponse.ProtoReflect.Descriptor instead.
+func (*ResourceSchemaResponse) Descriptor() ([]byte, []int) {
+	return file_inspect_inspect_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ResourceSchemaResponse) GetMessages() []*ResourceSchema {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type ResourceHistoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Resource type, e.g. MachineConfigs.config.talos.dev.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Resource ID, if empty, the revisions of all resources of the type are returned.
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Revisions to compute the diff between, the diff is computed if both are set.
+	FromRevision  uint64 `protobuf:"varint,3,opt,name=from_revision,json=fromRevision,proto3" json:"from_revision,omitempty"`
+	ToRevision    uint64 `protobuf:"varint,4,opt,name=to_revision,json=toRevision,proto3" json:"to_revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceHistoryRequest) Reset() {
+	*x = ResourceHistoryRequest{}
+	mi := &file_inspect_inspect_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceHistoryRequest) ProtoMessage() {}
+
+func (x *ResourceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inspect_inspect_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ResourceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_inspect_inspect_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ResourceHistoryRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ResourceHistoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ResourceHistoryRequest) GetFromRevision() uint64 {
+	if x != nil {
+		return x.FromRevision
+	}
+	return 0
+}
+
+func (x *ResourceHistoryRequest) GetToRevision() uint64 {
+	if x != nil {
+		return x.ToRevision
+	}
+	return 0
+}
+
+type ResourceRevision struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Revision  uint64                 `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Event     ResourceRevisionEvent  `protobuf:"varint,3,opt,name=event,proto3,enum=inspect.ResourceRevisionEvent" json:"event,omitempty"`
+	Namespace string                 `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Id        string                 `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
+	Version   string                 `protobuf:"bytes,6,opt,name=version,proto3" json:"version,omitempty"`
+	// Resource spec marshaled to YAML.
+	Spec string `protobuf:"bytes,7,opt,name=spec,proto3" json:"spec,omitempty"`
+	// Set if the secrets were removed from the spec.
+	Redacted      bool `protobuf:"varint,8,opt,name=redacted,proto3" json:"redacted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceRevision) Reset() {
+	*x = ResourceRevision{}
+	mi := &file_inspect_inspect_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceRevision) ProtoMessage() {}
+
+func (x *ResourceRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_inspect_inspect_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceRevision.ProtoReflect.Descriptor instead.
+func (*ResourceRevision) Descriptor() ([]byte, []int) {
+	return file_inspect_inspect_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ResourceRevision) GetRevision() uint64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *ResourceRevision) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *ResourceRevision) GetEvent() ResourceRevisionEvent {
+	if x != nil {
+		return x.Event
+	}
+	return ResourceRevisionEvent_CREATED
+}
+
+func (x *ResourceRevision) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ResourceRevision) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ResourceRevision) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ResourceRevision) GetSpec() string {
+	if x != nil {
+		return x.Spec
+	}
+	return ""
+}
+
+func (x *ResourceRevision) GetRedacted() bool {
+	if x != nil {
+		return x.Redacted
+	}
+	return false
+}
+
+// The ResourceHistory message contains the recorded revisions of the resources.
+type ResourceHistory struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Metadata  *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Type      string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Revisions []*ResourceRevision    `protobuf:"bytes,3,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	// Unified diff between the from and to revisions.
+	Diff          string `protobuf:"bytes,4,opt,name=diff,proto3" json:"diff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceHistory) Reset() {
+	*x = ResourceHistory{}
+	mi := &file_inspect_inspect_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceHistory) ProtoMessage() {}
+
+func (x *ResourceHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_inspect_inspect_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceHistory.ProtoReflect.Descriptor instead.
+func (*ResourceHistory) Descriptor() ([]byte, []int) {
+	return file_inspect_inspect_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ResourceHistory) GetMetadata() *common.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *ResourceHistory) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ResourceHistory) GetRevisions() []*ResourceRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+func (x *ResourceHistory) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+type ResourceHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*ResourceHistory     `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceHistoryResponse) Reset() {
+	*x = ResourceHistoryResponse{}
+	mi := &file_inspect_inspect_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceHistoryResponse) ProtoMessage() {}
+
+func (x *ResourceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inspect_inspect_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ResourceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_inspect_inspect_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ResourceHistoryResponse) GetMessages() []*ResourceHistory {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
 var File_inspect_inspect_proto protoreflect.FileDescriptor
 
 const file_inspect_inspect_proto_rawDesc = "" +
 	"\n" +
-	"\x15inspect/inspect.proto\x12\ainspect\x1a\x13common/common.proto\x1a\x1bgoogle/protobuf/empty.proto\"\x84\x01\n" +
+	"\x15inspect/inspect.proto\x12\ainspect\x1a\x13common/common.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x84\x01\n" +
 	"\x1bControllerRuntimeDependency\x12,\n" +
 	"\bmetadata\x18\x01 \x01(\v2\x10.common.MetadataR\bmetadata\x127\n" +
 	"\x05edges\x18\x02 \x03(\v2!.inspect.ControllerDependencyEdgeR\x05edges\"i\n" +
@@ -269,16 +1050,77 @@ const file_inspect_inspect_proto_rawDesc = "" +
 	"\x12resource_namespace\x18\x03 \x01(\tR\x11resourceNamespace\x12#\n" +
 	"\rresource_type\x18\x04 \x01(\tR\fresourceType\x12\x1f\n" +
 	"\vresource_id\x18\x05 \x01(\tR\n" +
-	"resourceId*x\n" +
+	"resourceId\"/\n" +
+	"\x19BootstrapManifestsRequest\x12\x12\n" +
+	"\x04diff\x18\x01 \x01(\bR\x04diff\"\x82\x02\n" +
+	"\x17BootstrapManifestObject\x12\x1a\n" +
+	"\bmanifest\x18\x01 \x01(\tR\bmanifest\x12\x1f\n" +
+	"\vapi_version\x18\x02 \x01(\tR\n" +
+	"apiVersion\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x1c\n" +
+	"\tnamespace\x18\x04 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x128\n" +
+	"\x06action\x18\x06 \x01(\x0e2 .inspect.BootstrapManifestActionR\x06action\x12\x16\n" +
+	"\x06policy\x18\a \x01(\tR\x06policy\x12\x12\n" +
+	"\x04diff\x18\b \x01(\tR\x04diff\"~\n" +
+	"\x12BootstrapManifests\x12,\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x10.common.MetadataR\bmetadata\x12:\n" +
+	"\aobjects\x18\x02 \x03(\v2 .inspect.BootstrapManifestObjectR\aobjects\"U\n" +
+	"\x1aBootstrapManifestsResponse\x127\n" +
+	"\bmessages\x18\x01 \x03(\v2\x1b.inspect.BootstrapManifestsR\bmessages\"+\n" +
+	"\x15ResourceSchemaRequest\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\"j\n" +
+	"\x0eResourceSchema\x12,\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x10.common.MetadataR\bmetadata\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x16\n" +
+	"\x06schema\x18\x03 \x01(\fR\x06schema\"M\n" +
+	"\x16ResourceSchemaResponse\x123\n" +
+	"\bmessages\x18\x01 \x03(\v2\x17.inspect.ResourceSchemaR\bmessages\"\x82\x01\n" +
+	"\x16ResourceHistoryRequest\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12#\n" +
+	"\rfrom_revision\x18\x03 \x01(\x04R\ffromRevision\x12\x1f\n" +
+	"\vto_revision\x18\x04 \x01(\x04R\n" +
+	"toRevision\"\x96\x02\n" +
+	"\x10ResourceRevision\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\x04R\brevision\x128\n" +
+	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x124\n" +
+	"\x05event\x18\x03 \x01(\x0e2\x1e.inspect.ResourceRevisionEventR\x05event\x12\x1c\n" +
+	"\tnamespace\x18\x04 \x01(\tR\tnamespace\x12\x0e\n" +
+	"\x02id\x18\x05 \x01(\tR\x02id\x12\x18\n" +
+	"\aversion\x18\x06 \x01(\tR\aversion\x12\x12\n" +
+	"\x04spec\x18\a \x01(\tR\x04spec\x12\x1a\n" +
+	"\bredacted\x18\b \x01(\bR\bredacted\"\xa0\x01\n" +
+	"\x0fResourceHistory\x12,\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x10.common.MetadataR\bmetadata\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x127\n" +
+	"\trevisions\x18\x03 \x03(\v2\x19.inspect.ResourceRevisionR\trevisions\x12\x12\n" +
+	"\x04diff\x18\x04 \x01(\tR\x04diff\"O\n" +
+	"\x17ResourceHistoryResponse\x124\n" +
+	"\bmessages\x18\x01 \x03(\v2\x18.inspect.ResourceHistoryR\bmessages*x\n" +
 	"\x12DependencyEdgeType\x12\x14\n" +
 	"\x10OUTPUT_EXCLUSIVE\x10\x00\x12\x11\n" +
 	"\rOUTPUT_SHARED\x10\x03\x12\x10\n" +
 	"\fINPUT_STRONG\x10\x01\x12\x0e\n" +
 	"\n" +
 	"INPUT_WEAK\x10\x02\x12\x17\n" +
-	"\x13INPUT_DESTROY_READY\x10\x042y\n" +
+	"\x13INPUT_DESTROY_READY\x10\x04*J\n" +
+	"\x17BootstrapManifestAction\x12\r\n" +
+	"\tUNCHANGED\x10\x00\x12\n" +
+	"\n" +
+	"\x06CREATE\x10\x01\x12\n" +
+	"\n" +
+	"\x06UPDATE\x10\x02\x12\b\n" +
+	"\x04SKIP\x10\x03*@\n" +
+	"\x15ResourceRevisionEvent\x12\v\n" +
+	"\aCREATED\x10\x00\x12\v\n" +
+	"\aUPDATED\x10\x01\x12\r\n" +
+	"\tDESTROYED\x10\x022\x81\x03\n" +
 	"\x0eInspectService\x12g\n" +
-	"\x1dControllerRuntimeDependencies\x12\x16.google.protobuf.Empty\x1a..inspect.ControllerRuntimeDependenciesResponseBN\n" +
+	"\x1dControllerRuntimeDependencies\x12\x16.google.protobuf.Empty\x1a..inspect.ControllerRuntimeDependenciesResponse\x12]\n" +
+	"\x12BootstrapManifests\x12\".inspect.BootstrapManifestsRequest\x1a#.inspect.BootstrapManifestsResponse\x12Q\n" +
+	"\x0eResourceSchema\x12\x1e.inspect.ResourceSchemaRequest\x1a\x1f.inspect.ResourceSchemaResponse\x12T\n" +
+	"\x0fResourceHistory\x12\x1f.inspect.ResourceHistoryRequest\x1a .inspect.ResourceHistoryResponseBN\n" +
 	"\x15dev.talos.api.inspectZ5github.com/siderolabs/talos/pkg/machinery/api/inspectb\x06proto3"
 
 var (
@@ -293,28 +1135,59 @@ func file_inspect_inspect_proto_rawDescGZIP() []byte {
 	return file_inspect_inspect_proto_rawDescData
 }
 
-var file_inspect_inspect_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_inspect_inspect_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_inspect_inspect_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_inspect_inspect_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_inspect_inspect_proto_goTypes = []any{
 	(DependencyEdgeType)(0),                       // 0: inspect.DependencyEdgeType
-	(*ControllerRuntimeDependency)(nil),           // 1: inspect.ControllerRuntimeDependency
-	(*ControllerRuntimeDependenciesResponse)(nil), // 2: inspect.ControllerRuntimeDependenciesResponse
-	(*ControllerDependencyEdge)(nil),              // 3: inspect.ControllerDependencyEdge
-	(*common.Metadata)(nil),                       // 4: common.Metadata
-	(*emptypb.Empty)(nil),                         // 5: google.protobuf.Empty
+	(BootstrapManifestAction)(0),                  // 1: inspect.BootstrapManifestAction
+	(ResourceRevisionEvent)(0),                    // 2: inspect.ResourceRevisionEvent
+	(*ControllerRuntimeDependency)(nil),           // 3: inspect.ControllerRuntimeDependency
+	(*ControllerRuntimeDependenciesResponse)(nil), // 4: inspect.ControllerRuntimeDependenciesResponse
+	(*ControllerDependencyEdge)(nil),              // 5: inspect.ControllerDependencyEdge
+	(*BootstrapManifestsRequest)(nil),             // 6: inspect.BootstrapManifestsRequest
+	(*BootstrapManifestObject)(nil),               // 7: inspect.BootstrapManifestObject
+	(*BootstrapManifests)(nil),                    // 8: inspect.BootstrapManifests
+	(*BootstrapManifestsResponse)(nil),            // 9: inspect.BootstrapManifestsResponse
+	(*ResourceSchemaRequest)(nil),                 // 10: inspect.ResourceSchemaRequest
+	(*ResourceSchema)(nil),                        // 11: inspect.ResourceSchema
+	(*ResourceSchemaResponse)(nil),                // 12: inspect.ResourceSchemaResponse
+	(*ResourceHistoryRequest)(nil),                // 13: inspect.ResourceHistoryRequest
+	(*ResourceRevision)(nil),                      // 14: inspect.ResourceRevision
+	(*ResourceHistory)(nil),                       // 15: inspect.ResourceHistory
+	(*ResourceHistoryResponse)(nil),               // 16: inspect.ResourceHistoryResponse
+	(*common.Metadata)(nil),                       // 17: common.Metadata
+	(*timestamppb.Timestamp)(nil),                 // 18: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                         // 19: google.protobuf.Empty
 }
 var file_inspect_inspect_proto_depIdxs = []int32{
-	4, // 0: inspect.ControllerRuntimeDependency.metadata:type_name -> common.Metadata
-	3, // 1: inspect.ControllerRuntimeDependency.edges:type_name -> inspect.ControllerDependencyEdge
-	1, // 2: inspect.ControllerRuntimeDependenciesResponse.messages:type_name -> inspect.ControllerRuntimeDependency
-	0, // 3: inspect.ControllerDependencyEdge.edge_type:type_name -> inspect.DependencyEdgeType
-	5, // 4: inspect.InspectService.ControllerRuntimeDependencies:input_type -> google.protobuf.Empty
-	2, // 5: inspect.InspectService.ControllerRuntimeDependencies:output_type -> inspect.ControllerRuntimeDependenciesResponse
-	5, // [5:6] is the sub-list for method output_type
-	4, // [4:5] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	17, // 0: inspect.ControllerRuntimeDependency.metadata:type_name -> common.Metadata
+	5,  // 1: inspect.ControllerRuntimeDependency.edges:type_name -> inspect.ControllerDependencyEdge
+	3,  // 2: inspect.ControllerRuntimeDependenciesResponse.messages:type_name -> inspect.ControllerRuntimeDependency
+	0,  // 3: inspect.ControllerDependencyEdge.edge_type:type_name -> inspect.DependencyEdgeType
+	1,  // 4: inspect.BootstrapManifestObject.action:type_name -> inspect.BootstrapManifestAction
+	17, // 5: inspect.BootstrapManifests.metadata:type_name -> common.Metadata
+	7,  // 6: inspect.BootstrapManifests.objects:type_name -> inspect.BootstrapManifestObject
+	8,  // 7: inspect.BootstrapManifestsResponse.messages:type_name -> inspect.BootstrapManifests
+	17, // 8: inspect.ResourceSchema.metadata:type_name -> common.Metadata
+	11, // 9: inspect.ResourceSchemaResponse.messages:type_name -> inspect.ResourceSchema
+	18, // 10: inspect.ResourceRevision.timestamp:type_name -> google.protobuf.Timestamp
+	2,  // 11: inspect.ResourceRevision.event:type_name -> inspect.ResourceRevisionEvent
+	17, // 12: inspect.ResourceHistory.metadata:type_name -> common.Metadata
+	14, // 13: inspect.ResourceHistory.revisions:type_name -> inspect.ResourceRevision
+	15, // 14: inspect.ResourceHistoryResponse.messages:type_name -> inspect.ResourceHistory
+	19, // 15: inspect.InspectService.ControllerRuntimeDependencies:input_type -> google.protobuf.Empty
+	6,  // 16: inspect.InspectService.BootstrapManifests:input_type -> inspect.BootstrapManifestsRequest
+	10, // 17: inspect.InspectService.ResourceSchema:input_type -> inspect.ResourceSchemaRequest
+	13, // 18: inspect.InspectService.ResourceHistory:input_type -> inspect.ResourceHistoryRequest
+	4,  // 19: inspect.InspectService.ControllerRuntimeDependencies:output_type -> inspect.ControllerRuntimeDependenciesResponse
+	9,  // 20: inspect.InspectService.BootstrapManifests:output_type -> inspect.BootstrapManifestsResponse
+	12, // 21: inspect.InspectService.ResourceSchema:output_type -> inspect.ResourceSchemaResponse
+	16, // 22: inspect.InspectService.ResourceHistory:output_type -> inspect.ResourceHistoryResponse
+	19, // [19:23] is the sub-list for method output_type
+	15, // [15:19] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_inspect_inspect_proto_init() }
@@ -327,8 +1200,8 @@ func file_inspect_inspect_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_inspect_inspect_proto_rawDesc), len(file_inspect_inspect_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   3,
+			NumEnums:      3,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -22,6 +22,9 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	InspectService_ControllerRuntimeDependencies_FullMethodName = "/inspect.InspectService/ControllerRuntimeDependencies"
+	InspectService_BootstrapManifests_FullMethodName            = "/inspect.InspectService/BootstrapManifests"
+	InspectService_ResourceSchema_FullMethodName                = "/inspect.InspectService/ResourceSchema"
+	InspectService_ResourceHistory_FullMethodName               = "/inspect.InspectService/ResourceHistory"
 )
 
 // InspectServiceClient is the client API for InspectService service.
@@ -33,6 +36,9 @@ const (
 // InspectService provides auxiliary API to inspect OS internals.
 type InspectServiceClient interface {
 	ControllerRuntimeDependencies(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ControllerRuntimeDependenciesResponse, error)
+	BootstrapManifests(ctx context.Context, in *BootstrapManifestsRequest, opts ...grpc.CallOption) (*BootstrapManifestsResponse, error)
+	ResourceSchema(ctx context.Context, in *ResourceSchemaRequest, opts ...grpc.CallOption) (*ResourceSchemaResponse, error)
+	ResourceHistory(ctx context.Context, in *ResourceHistoryRequest, opts ...grpc.CallOption) (*ResourceHistoryResponse, error)
 }
 
 type inspectServiceClient struct {
@@ -53,6 +59,36 @@ func (c *inspectServiceClient) ControllerRuntimeDependencies(ctx context.Context
 	return out, nil
 }
 
+func (c *inspectServiceClient) BootstrapManifests(ctx context.Context, in *BootstrapManifestsRequest, opts ...grpc.CallOption) (*BootstrapManifestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BootstrapManifestsResponse)
+	err := c.cc.Invoke(ctx, InspectService_BootstrapManifests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inspectServiceClient) ResourceSchema(ctx context.Context, in *ResourceSchemaRequest, opts ...grpc.CallOption) (*ResourceSchemaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResourceSchemaResponse)
+	err := c.cc.Invoke(ctx, InspectService_ResourceSchema_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inspectServiceClient) ResourceHistory(ctx context.Context, in *ResourceHistoryRequest, opts ...grpc.CallOption) (*ResourceHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResourceHistoryResponse)
+	err := c.cc.Invoke(ctx, InspectService_ResourceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InspectServiceServer is the server API for InspectService service.
 // All implementations must embed UnimplementedInspectServiceServer
 // for forward compatibility.
@@ -62,6 +98,9 @@ func (c *inspectServiceClient) ControllerRuntimeDependencies(ctx context.Context
 // InspectService provides auxiliary API to inspect OS internals.
 type InspectServiceServer interface {
 	ControllerRuntimeDependencies(context.Context, *emptypb.Empty) (*ControllerRuntimeDependenciesResponse, error)
+	BootstrapManifests(context.Context, *BootstrapManifestsRequest) (*BootstrapManifestsResponse, error)
+	ResourceSchema(context.Context, *ResourceSchemaRequest) (*ResourceSchemaResponse, error)
+	ResourceHistory(context.Context, *ResourceHistoryRequest) (*ResourceHistoryResponse, error)
 	mustEmbedUnimplementedInspectServiceServer()
 }
 
@@ -75,6 +114,15 @@ type UnimplementedInspectServiceServer struct{}
 func (UnimplementedInspectServiceServer) ControllerRuntimeDependencies(context.Context, *emptypb.Empty) (*ControllerRuntimeDependenciesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ControllerRuntimeDependencies not implemented")
 }
+func (UnimplementedInspectServiceServer) BootstrapManifests(context.Context, *BootstrapManifestsRequest) (*BootstrapManifestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BootstrapManifests not implemented")
+}
+func (UnimplementedInspectServiceServer) ResourceSchema(context.Context, *ResourceSchemaRequest) (*ResourceSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourceSchema not implemented")
+}
+func (UnimplementedInspectServiceServer) ResourceHistory(context.Context, *ResourceHistoryRequest) (*ResourceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourceHistory not implemented")
+}
 func (UnimplementedInspectServiceServer) mustEmbedUnimplementedInspectServiceServer() {}
 func (UnimplementedInspectServiceServer) testEmbeddedByValue()                        {}
 
@@ -114,6 +162,60 @@ func _InspectService_ControllerRuntimeDependencies_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InspectService_BootstrapManifests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BootstrapManifestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InspectServiceServer).BootstrapManifests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InspectService_BootstrapManifests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InspectServiceServer).BootstrapManifests(ctx, req.(*BootstrapManifestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InspectService_ResourceSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResourceSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InspectServiceServer).ResourceSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InspectService_ResourceSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InspectServiceServer).ResourceSchema(ctx, req.(*ResourceSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InspectService_ResourceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResourceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InspectServiceServer).ResourceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InspectService_ResourceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InspectServiceServer).ResourceHistory(ctx, req.(*ResourceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // InspectService_ServiceDesc is the grpc.ServiceDesc for InspectService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -125,6 +227,18 @@ var InspectService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ControllerRuntimeDependencies",
 			Handler:    _InspectService_ControllerRuntimeDependencies_Handler,
 		},
+		{
+			MethodName: "BootstrapManifests",
+			Handler:    _InspectService_BootstrapManifests_Handler,
+		},
+		{
+			MethodName: "ResourceSchema",
+			Handler:    _InspectService_ResourceSchema_Handler,
+		},
+		{
+			MethodName: "ResourceHistory",
+			Handler:    _InspectService_ResourceHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inspect/inspect.proto",
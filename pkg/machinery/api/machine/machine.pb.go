@@ -126,7 +126,7 @@ func (x RebootRequest_Mode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use RebootRequest_Mode.Descriptor instead.
 func (RebootRequest_Mode) EnumDescriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{3, 0}
+	return file_machine_machine_proto_rawDescGZIP(), []int{7, 0}
 }
 
 type SequenceEvent_Action int32
@@ -175,7 +175,7 @@ func (x SequenceEvent_Action) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SequenceEvent_Action.Descriptor instead.
 func (SequenceEvent_Action) EnumDescriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{9, 0}
+	return file_machine_machine_proto_rawDescGZIP(), []int{13, 0}
 }
 
 type PhaseEvent_Action int32
@@ -221,7 +221,7 @@ func (x PhaseEvent_Action) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PhaseEvent_Action.Descriptor instead.
 func (PhaseEvent_Action) EnumDescriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{10, 0}
+	return file_machine_machine_proto_rawDescGZIP(), []int{14, 0}
 }
 
 type TaskEvent_Action int32
@@ -267,7 +267,7 @@ func (x TaskEvent_Action) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TaskEvent_Action.Descriptor instead.
 func (TaskEvent_Action) EnumDescriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{11, 0}
+	return file_machine_machine_proto_rawDescGZIP(), []int{15, 0}
 }
 
 type ServiceStateEvent_Action int32
@@ -334,7 +334,56 @@ func (x ServiceStateEvent_Action) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ServiceStateEvent_Action.Descriptor instead.
 func (ServiceStateEvent_Action) EnumDescriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{12, 0}
+	return file_machine_machine_proto_rawDescGZIP(), []int{16, 0}
+}
+
+type JoinTokenEvent_Action int32
+
+const (
+	JoinTokenEvent_CREATED JoinTokenEvent_Action = 0
+	JoinTokenEvent_USED    JoinTokenEvent_Action = 1
+	JoinTokenEvent_REVOKED JoinTokenEvent_Action = 2
+)
+
+// Enum value maps for JoinTokenEvent_Action.
+var (
+	JoinTokenEvent_Action_name = map[int32]string{
+		0: "CREATED",
+		1: "USED",
+		2: "REVOKED",
+	}
+	JoinTokenEvent_Action_value = map[string]int32{
+		"CREATED": 0,
+		"USED":    1,
+		"REVOKED": 2,
+	}
+)
+
+func (x JoinTokenEvent_Action) Enum() *JoinTokenEvent_Action {
+	p := new(JoinTokenEvent_Action)
+	*p = x
+	return p
+}
+
+func (x JoinTokenEvent_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JoinTokenEvent_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_machine_machine_proto_enumTypes[6].Descriptor()
+}
+
+func (JoinTokenEvent_Action) Type() protoreflect.EnumType {
+	return &file_machine_machine_proto_enumTypes[6]
+}
+
+func (x JoinTokenEvent_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JoinTokenEvent_Action.Descriptor instead.
+func (JoinTokenEvent_Action) EnumDescriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{37, 0}
 }
 
 type MachineStatusEvent_MachineStage int32
@@ -388,11 +437,11 @@ func (x MachineStatusEvent_MachineStage) String() string {
 }
 
 func (MachineStatusEvent_MachineStage) Descriptor() protoreflect.EnumDescriptor {
-	return file_machine_machine_proto_enumTypes[6].Descriptor()
+	return file_machine_machine_proto_enumTypes[7].Descriptor()
 }
 
 func (MachineStatusEvent_MachineStage) Type() protoreflect.EnumType {
-	return &file_machine_machine_proto_enumTypes[6]
+	return &file_machine_machine_proto_enumTypes[7]
 }
 
 func (x MachineStatusEvent_MachineStage) Number() protoreflect.EnumNumber {
@@ -401,7 +450,7 @@ func (x MachineStatusEvent_MachineStage) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MachineStatusEvent_MachineStage.Descriptor instead.
 func (MachineStatusEvent_MachineStage) EnumDescriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{17, 0}
+	return file_machine_machine_proto_rawDescGZIP(), []int{41, 0}
 }
 
 type ResetRequest_WipeMode int32
@@ -437,11 +486,11 @@ func (x ResetRequest_WipeMode) String() string {
 }
 
 func (ResetRequest_WipeMode) Descriptor() protoreflect.EnumDescriptor {
-	return file_machine_machine_proto_enumTypes[7].Descriptor()
+	return file_machine_machine_proto_enumTypes[8].Descriptor()
 }
 
 func (ResetRequest_WipeMode) Type() protoreflect.EnumType {
-	return &file_machine_machine_proto_enumTypes[7]
+	return &file_machine_machine_proto_enumTypes[8]
 }
 
 func (x ResetRequest_WipeMode) Number() protoreflect.EnumNumber {
@@ -450,7 +499,7 @@ func (x ResetRequest_WipeMode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ResetRequest_WipeMode.Descriptor instead.
 func (ResetRequest_WipeMode) EnumDescriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{21, 0}
+	return file_machine_machine_proto_rawDescGZIP(), []int{45, 0}
 }
 
 type UpgradeRequest_RebootMode int32
@@ -483,11 +532,11 @@ func (x UpgradeRequest_RebootMode) String() string {
 }
 
 func (UpgradeRequest_RebootMode) Descriptor() protoreflect.EnumDescriptor {
-	return file_machine_machine_proto_enumTypes[8].Descriptor()
+	return file_machine_machine_proto_enumTypes[9].Descriptor()
 }
 
 func (UpgradeRequest_RebootMode) Type() protoreflect.EnumType {
-	return &file_machine_machine_proto_enumTypes[8]
+	return &file_machine_machine_proto_enumTypes[9]
 }
 
 func (x UpgradeRequest_RebootMode) Number() protoreflect.EnumNumber {
@@ -496,7 +545,7 @@ func (x UpgradeRequest_RebootMode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UpgradeRequest_RebootMode.Descriptor instead.
 func (UpgradeRequest_RebootMode) EnumDescriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{27, 0}
+	return file_machine_machine_proto_rawDescGZIP(), []int{72, 0}
 }
 
 // File type.
@@ -536,11 +585,11 @@ func (x ListRequest_Type) String() string {
 }
 
 func (ListRequest_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_machine_machine_proto_enumTypes[9].Descriptor()
+	return file_machine_machine_proto_enumTypes[10].Descriptor()
 }
 
 func (ListRequest_Type) Type() protoreflect.EnumType {
-	return &file_machine_machine_proto_enumTypes[9]
+	return &file_machine_machine_proto_enumTypes[10]
 }
 
 func (x ListRequest_Type) Number() protoreflect.EnumNumber {
@@ -549,7 +598,56 @@ func (x ListRequest_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ListRequest_Type.Descriptor instead.
 func (ListRequest_Type) EnumDescriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{46, 0}
+	return file_machine_machine_proto_rawDescGZIP(), []int{99, 0}
+}
+
+type KubernetesUpgradePreflightCheck_Severity int32
+
+const (
+	KubernetesUpgradePreflightCheck_INFO    KubernetesUpgradePreflightCheck_Severity = 0
+	KubernetesUpgradePreflightCheck_WARNING KubernetesUpgradePreflightCheck_Severity = 1
+	KubernetesUpgradePreflightCheck_ERROR   KubernetesUpgradePreflightCheck_Severity = 2
+)
+
+// Enum value maps for KubernetesUpgradePreflightCheck_Severity.
+var (
+	KubernetesUpgradePreflightCheck_Severity_name = map[int32]string{
+		0: "INFO",
+		1: "WARNING",
+		2: "ERROR",
+	}
+	KubernetesUpgradePreflightCheck_Severity_value = map[string]int32{
+		"INFO":    0,
+		"WARNING": 1,
+		"ERROR":   2,
+	}
+)
+
+func (x KubernetesUpgradePreflightCheck_Severity) Enum() *KubernetesUpgradePreflightCheck_Severity {
+	p := new(KubernetesUpgradePreflightCheck_Severity)
+	*p = x
+	return p
+}
+
+func (x KubernetesUpgradePreflightCheck_Severity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (KubernetesUpgradePreflightCheck_Severity) Descriptor() protoreflect.EnumDescriptor {
+	return file_machine_machine_proto_enumTypes[11].Descriptor()
+}
+
+func (KubernetesUpgradePreflightCheck_Severity) Type() protoreflect.EnumType {
+	return &file_machine_machine_proto_enumTypes[11]
+}
+
+func (x KubernetesUpgradePreflightCheck_Severity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use KubernetesUpgradePreflightCheck_Severity.Descriptor instead.
+func (KubernetesUpgradePreflightCheck_Severity) EnumDescriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{127, 0}
 }
 
 type EtcdMemberAlarm_AlarmType int32
@@ -585,11 +683,11 @@ func (x EtcdMemberAlarm_AlarmType) String() string {
 }
 
 func (EtcdMemberAlarm_AlarmType) Descriptor() protoreflect.EnumDescriptor {
-	return file_machine_machine_proto_enumTypes[10].Descriptor()
+	return file_machine_machine_proto_enumTypes[12].Descriptor()
 }
 
 func (EtcdMemberAlarm_AlarmType) Type() protoreflect.EnumType {
-	return &file_machine_machine_proto_enumTypes[10]
+	return &file_machine_machine_proto_enumTypes[12]
 }
 
 func (x EtcdMemberAlarm_AlarmType) Number() protoreflect.EnumNumber {
@@ -598,7 +696,7 @@ func (x EtcdMemberAlarm_AlarmType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EtcdMemberAlarm_AlarmType.Descriptor instead.
 func (EtcdMemberAlarm_AlarmType) EnumDescriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{125, 0}
+	return file_machine_machine_proto_rawDescGZIP(), []int{192, 0}
 }
 
 type MachineConfig_MachineType int32
@@ -637,11 +735,11 @@ func (x MachineConfig_MachineType) String() string {
 }
 
 func (MachineConfig_MachineType) Descriptor() protoreflect.EnumDescriptor {
-	return file_machine_machine_proto_enumTypes[11].Descriptor()
+	return file_machine_machine_proto_enumTypes[13].Descriptor()
 }
 
 func (MachineConfig_MachineType) Type() protoreflect.EnumType {
-	return &file_machine_machine_proto_enumTypes[11]
+	return &file_machine_machine_proto_enumTypes[13]
 }
 
 func (x MachineConfig_MachineType) Number() protoreflect.EnumNumber {
@@ -650,7 +748,7 @@ func (x MachineConfig_MachineType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MachineConfig_MachineType.Descriptor instead.
 func (MachineConfig_MachineType) EnumDescriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{147, 0}
+	return file_machine_machine_proto_rawDescGZIP(), []int{214, 0}
 }
 
 type NetstatRequest_Filter int32
@@ -686,11 +784,11 @@ func (x NetstatRequest_Filter) String() string {
 }
 
 func (NetstatRequest_Filter) Descriptor() protoreflect.EnumDescriptor {
-	return file_machine_machine_proto_enumTypes[12].Descriptor()
+	return file_machine_machine_proto_enumTypes[14].Descriptor()
 }
 
 func (NetstatRequest_Filter) Type() protoreflect.EnumType {
-	return &file_machine_machine_proto_enumTypes[12]
+	return &file_machine_machine_proto_enumTypes[14]
 }
 
 func (x NetstatRequest_Filter) Number() protoreflect.EnumNumber {
@@ -699,7 +797,7 @@ func (x NetstatRequest_Filter) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NetstatRequest_Filter.Descriptor instead.
 func (NetstatRequest_Filter) EnumDescriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{160, 0}
+	return file_machine_machine_proto_rawDescGZIP(), []int{227, 0}
 }
 
 type ConnectRecord_State int32
@@ -762,11 +860,11 @@ func (x ConnectRecord_State) String() string {
 }
 
 func (ConnectRecord_State) Descriptor() protoreflect.EnumDescriptor {
-	return file_machine_machine_proto_enumTypes[13].Descriptor()
+	return file_machine_machine_proto_enumTypes[15].Descriptor()
 }
 
 func (ConnectRecord_State) Type() protoreflect.EnumType {
-	return &file_machine_machine_proto_enumTypes[13]
+	return &file_machine_machine_proto_enumTypes[15]
 }
 
 func (x ConnectRecord_State) Number() protoreflect.EnumNumber {
@@ -775,7 +873,7 @@ func (x ConnectRecord_State) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ConnectRecord_State.Descriptor instead.
 func (ConnectRecord_State) EnumDescriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{161, 0}
+	return file_machine_machine_proto_rawDescGZIP(), []int{228, 0}
 }
 
 type ConnectRecord_TimerActive int32
@@ -817,11 +915,11 @@ func (x ConnectRecord_TimerActive) String() string {
 }
 
 func (ConnectRecord_TimerActive) Descriptor() protoreflect.EnumDescriptor {
-	return file_machine_machine_proto_enumTypes[14].Descriptor()
+	return file_machine_machine_proto_enumTypes[16].Descriptor()
 }
 
 func (ConnectRecord_TimerActive) Type() protoreflect.EnumType {
-	return &file_machine_machine_proto_enumTypes[14]
+	return &file_machine_machine_proto_enumTypes[16]
 }
 
 func (x ConnectRecord_TimerActive) Number() protoreflect.EnumNumber {
@@ -830,7 +928,7 @@ func (x ConnectRecord_TimerActive) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ConnectRecord_TimerActive.Descriptor instead.
 func (ConnectRecord_TimerActive) EnumDescriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{161, 1}
+	return file_machine_machine_proto_rawDescGZIP(), []int{228, 1}
 }
 
 // rpc applyConfiguration
@@ -1020,28 +1118,33 @@ func (x *ApplyConfigurationResponse) GetMessages() []*ApplyConfiguration {
 	return nil
 }
 
-// rpc reboot
-type RebootRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Mode          RebootRequest_Mode     `protobuf:"varint,1,opt,name=mode,proto3,enum=machine.RebootRequest_Mode" json:"mode,omitempty"`
+// rpc attest
+type AttestRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Nonce to be included into the quote, up to 32 bytes; it should be fresh and unpredictable.
+	Nonce []byte `protobuf:"bytes,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// PCRs to quote, defaults to the PCRs 0-7 and 11.
+	Pcrs []int32 `protobuf:"varint,2,rep,packed,name=pcrs,proto3" json:"pcrs,omitempty"`
+	// PCR bank to quote: sha1, sha256, sha384 or sha512, defaults to sha256.
+	PcrBank       string `protobuf:"bytes,3,opt,name=pcr_bank,json=pcrBank,proto3" json:"pcr_bank,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RebootRequest) Reset() {
-	*x = RebootRequest{}
+func (x *AttestRequest) Reset() {
+	*x = AttestRequest{}
 	mi := &file_machine_machine_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RebootRequest) String() string {
+func (x *AttestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RebootRequest) ProtoMessage() {}
+func (*AttestRequest) ProtoMessage() {}
 
-func (x *RebootRequest) ProtoReflect() protoreflect.Message {
+func (x *AttestRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_machine_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1053,41 +1156,54 @@ func (x *RebootRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RebootRequest.ProtoReflect.Descriptor instead.
-func (*RebootRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AttestRequest.ProtoReflect.Descriptor instead.
+func (*AttestRequest) Descriptor() ([]byte, []int) {
 	return file_machine_machine_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *RebootRequest) GetMode() RebootRequest_Mode {
+func (x *AttestRequest) GetNonce() []byte {
 	if x != nil {
-		return x.Mode
+		return x.Nonce
 	}
-	return RebootRequest_DEFAULT
+	return nil
 }
 
-// The reboot message containing the reboot status.
-type Reboot struct {
+func (x *AttestRequest) GetPcrs() []int32 {
+	if x != nil {
+		return x.Pcrs
+	}
+	return nil
+}
+
+func (x *AttestRequest) GetPcrBank() string {
+	if x != nil {
+		return x.PcrBank
+	}
+	return ""
+}
+
+type AttestationPCR struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Metadata      *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	ActorId       string                 `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Value         []byte                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Reboot) Reset() {
-	*x = Reboot{}
+func (x *AttestationPCR) Reset() {
+	*x = AttestationPCR{}
 	mi := &file_machine_machine_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Reboot) String() string {
+func (x *AttestationPCR) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Reboot) ProtoMessage() {}
+func (*AttestationPCR) ProtoMessage() {}
 
-func (x *Reboot) ProtoReflect() protoreflect.Message {
+func (x *AttestationPCR) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_machine_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1099,46 +1215,58 @@ func (x *Reboot) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Reboot.ProtoReflect.Descriptor instead.
-func (*Reboot) Descriptor() ([]byte, []int) {
+// Deprecated: Use AttestationPCR.ProtoReflect.Descriptor instead.
+func (*AttestationPCR) Descriptor() ([]byte, []int) {
 	return file_machine_machine_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *Reboot) GetMetadata() *common.Metadata {
+func (x *AttestationPCR) GetIndex() int32 {
 	if x != nil {
-		return x.Metadata
+		return x.Index
 	}
-	return nil
+	return 0
 }
 
-func (x *Reboot) GetActorId() string {
+func (x *AttestationPCR) GetValue() []byte {
 	if x != nil {
-		return x.ActorId
+		return x.Value
 	}
-	return ""
+	return nil
 }
 
-type RebootResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Messages      []*Reboot              `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+type Attestation struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Metadata *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// TPMS_ATTEST structure of the quote.
+	Quote []byte `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"`
+	// TPMT_SIGNATURE of the quote by the attestation key.
+	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	// TPMT_PUBLIC area of the attestation key.
+	AkPublic []byte `protobuf:"bytes,4,opt,name=ak_public,json=akPublic,proto3" json:"ak_public,omitempty"`
+	// PCR bank of the quoted PCRs.
+	PcrBank string `protobuf:"bytes,5,opt,name=pcr_bank,json=pcrBank,proto3" json:"pcr_bank,omitempty"`
+	// Values of the quoted PCRs.
+	Pcrs []*AttestationPCR `protobuf:"bytes,6,rep,name=pcrs,proto3" json:"pcrs,omitempty"`
+	// TPM 2.0 measured boot event log, if available.
+	EventLog      []byte `protobuf:"bytes,7,opt,name=event_log,json=eventLog,proto3" json:"event_log,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RebootResponse) Reset() {
-	*x = RebootResponse{}
+func (x *Attestation) Reset() {
+	*x = Attestation{}
 	mi := &file_machine_machine_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RebootResponse) String() string {
+func (x *Attestation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RebootResponse) ProtoMessage() {}
+func (*Attestation) ProtoMessage() {}
 
-func (x *RebootResponse) ProtoReflect() protoreflect.Message {
+func (x *Attestation) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_machine_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1150,98 +1278,82 @@ func (x *RebootResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RebootResponse.ProtoReflect.Descriptor instead.
-func (*RebootResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Attestation.ProtoReflect.Descriptor instead.
+func (*Attestation) Descriptor() ([]byte, []int) {
 	return file_machine_machine_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *RebootResponse) GetMessages() []*Reboot {
+func (x *Attestation) GetMetadata() *common.Metadata {
 	if x != nil {
-		return x.Messages
+		return x.Metadata
 	}
 	return nil
 }
 
-// rpc Bootstrap
-type BootstrapRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Enable etcd recovery from the snapshot.
-	// Snapshot should be uploaded before this call via EtcdRecover RPC.
-	RecoverEtcd bool `protobuf:"varint,1,opt,name=recover_etcd,json=recoverEtcd,proto3" json:"recover_etcd,omitempty"`
-	// Skip hash check on the snapshot (etcd).
-	// Enable this when recovering from data directory copy to skip integrity check.
-	RecoverSkipHashCheck bool `protobuf:"varint,2,opt,name=recover_skip_hash_check,json=recoverSkipHashCheck,proto3" json:"recover_skip_hash_check,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
-}
-
-func (x *BootstrapRequest) Reset() {
-	*x = BootstrapRequest{}
-	mi := &file_machine_machine_proto_msgTypes[6]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Attestation) GetQuote() []byte {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
 }
 
-func (x *BootstrapRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Attestation) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
 }
 
-func (*BootstrapRequest) ProtoMessage() {}
-
-func (x *BootstrapRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[6]
+func (x *Attestation) GetAkPublic() []byte {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.AkPublic
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use BootstrapRequest.ProtoReflect.Descriptor instead.
-func (*BootstrapRequest) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{6}
+func (x *Attestation) GetPcrBank() string {
+	if x != nil {
+		return x.PcrBank
+	}
+	return ""
 }
 
-func (x *BootstrapRequest) GetRecoverEtcd() bool {
+func (x *Attestation) GetPcrs() []*AttestationPCR {
 	if x != nil {
-		return x.RecoverEtcd
+		return x.Pcrs
 	}
-	return false
+	return nil
 }
 
-func (x *BootstrapRequest) GetRecoverSkipHashCheck() bool {
+func (x *Attestation) GetEventLog() []byte {
 	if x != nil {
-		return x.RecoverSkipHashCheck
+		return x.EventLog
 	}
-	return false
+	return nil
 }
 
-// The bootstrap message containing the bootstrap status.
-type Bootstrap struct {
+type AttestResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Metadata      *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Messages      []*Attestation         `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Bootstrap) Reset() {
-	*x = Bootstrap{}
-	mi := &file_machine_machine_proto_msgTypes[7]
+func (x *AttestResponse) Reset() {
+	*x = AttestResponse{}
+	mi := &file_machine_machine_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Bootstrap) String() string {
+func (x *AttestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Bootstrap) ProtoMessage() {}
+func (*AttestResponse) ProtoMessage() {}
 
-func (x *Bootstrap) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[7]
+func (x *AttestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1252,40 +1364,43 @@ func (x *Bootstrap) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Bootstrap.ProtoReflect.Descriptor instead.
-func (*Bootstrap) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use AttestResponse.ProtoReflect.Descriptor instead.
+func (*AttestResponse) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Bootstrap) GetMetadata() *common.Metadata {
+func (x *AttestResponse) GetMessages() []*Attestation {
 	if x != nil {
-		return x.Metadata
+		return x.Messages
 	}
 	return nil
 }
 
-type BootstrapResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Messages      []*Bootstrap           `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// rpc reboot
+type RebootRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Mode  RebootRequest_Mode     `protobuf:"varint,1,opt,name=mode,proto3,enum=machine.RebootRequest_Mode" json:"mode,omitempty"`
+	// IgnoreInhibitors indicates whether the reboot should proceed without waiting for the shutdown inhibitors.
+	IgnoreInhibitors bool `protobuf:"varint,2,opt,name=ignore_inhibitors,json=ignoreInhibitors,proto3" json:"ignore_inhibitors,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *BootstrapResponse) Reset() {
-	*x = BootstrapResponse{}
-	mi := &file_machine_machine_proto_msgTypes[8]
+func (x *RebootRequest) Reset() {
+	*x = RebootRequest{}
+	mi := &file_machine_machine_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BootstrapResponse) String() string {
+func (x *RebootRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BootstrapResponse) ProtoMessage() {}
+func (*RebootRequest) ProtoMessage() {}
 
-func (x *BootstrapResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[8]
+func (x *RebootRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1296,43 +1411,49 @@ func (x *BootstrapResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BootstrapResponse.ProtoReflect.Descriptor instead.
-func (*BootstrapResponse) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use RebootRequest.ProtoReflect.Descriptor instead.
+func (*RebootRequest) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *BootstrapResponse) GetMessages() []*Bootstrap {
+func (x *RebootRequest) GetMode() RebootRequest_Mode {
 	if x != nil {
-		return x.Messages
+		return x.Mode
 	}
-	return nil
+	return RebootRequest_DEFAULT
 }
 
-// rpc events
-type SequenceEvent struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Sequence      string                 `protobuf:"bytes,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
-	Action        SequenceEvent_Action   `protobuf:"varint,2,opt,name=action,proto3,enum=machine.SequenceEvent_Action" json:"action,omitempty"`
-	Error         *common.Error          `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+func (x *RebootRequest) GetIgnoreInhibitors() bool {
+	if x != nil {
+		return x.IgnoreInhibitors
+	}
+	return false
+}
+
+// The reboot message containing the reboot status.
+type Reboot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metadata      *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ActorId       string                 `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SequenceEvent) Reset() {
-	*x = SequenceEvent{}
-	mi := &file_machine_machine_proto_msgTypes[9]
+func (x *Reboot) Reset() {
+	*x = Reboot{}
+	mi := &file_machine_machine_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SequenceEvent) String() string {
+func (x *Reboot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SequenceEvent) ProtoMessage() {}
+func (*Reboot) ProtoMessage() {}
 
-func (x *SequenceEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[9]
+func (x *Reboot) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1343,55 +1464,47 @@ func (x *SequenceEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SequenceEvent.ProtoReflect.Descriptor instead.
-func (*SequenceEvent) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *SequenceEvent) GetSequence() string {
-	if x != nil {
-		return x.Sequence
-	}
-	return ""
+// Deprecated: Use Reboot.ProtoReflect.Descriptor instead.
+func (*Reboot) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *SequenceEvent) GetAction() SequenceEvent_Action {
+func (x *Reboot) GetMetadata() *common.Metadata {
 	if x != nil {
-		return x.Action
+		return x.Metadata
 	}
-	return SequenceEvent_NOOP
+	return nil
 }
 
-func (x *SequenceEvent) GetError() *common.Error {
+func (x *Reboot) GetActorId() string {
 	if x != nil {
-		return x.Error
+		return x.ActorId
 	}
-	return nil
+	return ""
 }
 
-type PhaseEvent struct {
+type RebootResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Phase         string                 `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`
-	Action        PhaseEvent_Action      `protobuf:"varint,2,opt,name=action,proto3,enum=machine.PhaseEvent_Action" json:"action,omitempty"`
+	Messages      []*Reboot              `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PhaseEvent) Reset() {
-	*x = PhaseEvent{}
-	mi := &file_machine_machine_proto_msgTypes[10]
+func (x *RebootResponse) Reset() {
+	*x = RebootResponse{}
+	mi := &file_machine_machine_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PhaseEvent) String() string {
+func (x *RebootResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PhaseEvent) ProtoMessage() {}
+func (*RebootResponse) ProtoMessage() {}
 
-func (x *PhaseEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[10]
+func (x *RebootResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1402,48 +1515,46 @@ func (x *PhaseEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PhaseEvent.ProtoReflect.Descriptor instead.
-func (*PhaseEvent) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *PhaseEvent) GetPhase() string {
-	if x != nil {
-		return x.Phase
-	}
-	return ""
+// Deprecated: Use RebootResponse.ProtoReflect.Descriptor instead.
+func (*RebootResponse) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *PhaseEvent) GetAction() PhaseEvent_Action {
+func (x *RebootResponse) GetMessages() []*Reboot {
 	if x != nil {
-		return x.Action
+		return x.Messages
 	}
-	return PhaseEvent_START
+	return nil
 }
 
-type TaskEvent struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Task          string                 `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
-	Action        TaskEvent_Action       `protobuf:"varint,2,opt,name=action,proto3,enum=machine.TaskEvent_Action" json:"action,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// rpc Bootstrap
+type BootstrapRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Enable etcd recovery from the snapshot.
+	// Snapshot should be uploaded before this call via EtcdRecover RPC.
+	RecoverEtcd bool `protobuf:"varint,1,opt,name=recover_etcd,json=recoverEtcd,proto3" json:"recover_etcd,omitempty"`
+	// Skip hash check on the snapshot (etcd).
+	// Enable this when recovering from data directory copy to skip integrity check.
+	RecoverSkipHashCheck bool `protobuf:"varint,2,opt,name=recover_skip_hash_check,json=recoverSkipHashCheck,proto3" json:"recover_skip_hash_check,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *TaskEvent) Reset() {
-	*x = TaskEvent{}
-	mi := &file_machine_machine_proto_msgTypes[11]
+func (x *BootstrapRequest) Reset() {
+	*x = BootstrapRequest{}
+	mi := &file_machine_machine_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TaskEvent) String() string {
+func (x *BootstrapRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskEvent) ProtoMessage() {}
+func (*BootstrapRequest) ProtoMessage() {}
 
-func (x *TaskEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[11]
+func (x *BootstrapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1454,50 +1565,48 @@ func (x *TaskEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskEvent.ProtoReflect.Descriptor instead.
-func (*TaskEvent) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use BootstrapRequest.ProtoReflect.Descriptor instead.
+func (*BootstrapRequest) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *TaskEvent) GetTask() string {
+func (x *BootstrapRequest) GetRecoverEtcd() bool {
 	if x != nil {
-		return x.Task
+		return x.RecoverEtcd
 	}
-	return ""
+	return false
 }
 
-func (x *TaskEvent) GetAction() TaskEvent_Action {
+func (x *BootstrapRequest) GetRecoverSkipHashCheck() bool {
 	if x != nil {
-		return x.Action
+		return x.RecoverSkipHashCheck
 	}
-	return TaskEvent_START
+	return false
 }
 
-type ServiceStateEvent struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Service       string                   `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
-	Action        ServiceStateEvent_Action `protobuf:"varint,2,opt,name=action,proto3,enum=machine.ServiceStateEvent_Action" json:"action,omitempty"`
-	Message       string                   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	Health        *ServiceHealth           `protobuf:"bytes,4,opt,name=health,proto3" json:"health,omitempty"`
+// The bootstrap message containing the bootstrap status.
+type Bootstrap struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metadata      *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ServiceStateEvent) Reset() {
-	*x = ServiceStateEvent{}
-	mi := &file_machine_machine_proto_msgTypes[12]
+func (x *Bootstrap) Reset() {
+	*x = Bootstrap{}
+	mi := &file_machine_machine_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ServiceStateEvent) String() string {
+func (x *Bootstrap) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServiceStateEvent) ProtoMessage() {}
+func (*Bootstrap) ProtoMessage() {}
 
-func (x *ServiceStateEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[12]
+func (x *Bootstrap) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1508,61 +1617,40 @@ func (x *ServiceStateEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServiceStateEvent.ProtoReflect.Descriptor instead.
-func (*ServiceStateEvent) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *ServiceStateEvent) GetService() string {
-	if x != nil {
-		return x.Service
-	}
-	return ""
-}
-
-func (x *ServiceStateEvent) GetAction() ServiceStateEvent_Action {
-	if x != nil {
-		return x.Action
-	}
-	return ServiceStateEvent_INITIALIZED
-}
-
-func (x *ServiceStateEvent) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
+// Deprecated: Use Bootstrap.ProtoReflect.Descriptor instead.
+func (*Bootstrap) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ServiceStateEvent) GetHealth() *ServiceHealth {
+func (x *Bootstrap) GetMetadata() *common.Metadata {
 	if x != nil {
-		return x.Health
+		return x.Metadata
 	}
 	return nil
 }
 
-type RestartEvent struct {
+type BootstrapResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Cmd           int64                  `protobuf:"varint,1,opt,name=cmd,proto3" json:"cmd,omitempty"`
+	Messages      []*Bootstrap           `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RestartEvent) Reset() {
-	*x = RestartEvent{}
-	mi := &file_machine_machine_proto_msgTypes[13]
+func (x *BootstrapResponse) Reset() {
+	*x = BootstrapResponse{}
+	mi := &file_machine_machine_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RestartEvent) String() string {
+func (x *BootstrapResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RestartEvent) ProtoMessage() {}
+func (*BootstrapResponse) ProtoMessage() {}
 
-func (x *RestartEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[13]
+func (x *BootstrapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1573,41 +1661,43 @@ func (x *RestartEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RestartEvent.ProtoReflect.Descriptor instead.
-func (*RestartEvent) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use BootstrapResponse.ProtoReflect.Descriptor instead.
+func (*BootstrapResponse) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *RestartEvent) GetCmd() int64 {
+func (x *BootstrapResponse) GetMessages() []*Bootstrap {
 	if x != nil {
-		return x.Cmd
+		return x.Messages
 	}
-	return 0
+	return nil
 }
 
-// ConfigLoadErrorEvent is reported when the config loading has failed.
-type ConfigLoadErrorEvent struct {
+// rpc events
+type SequenceEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Error         string                 `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Sequence      string                 `protobuf:"bytes,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Action        SequenceEvent_Action   `protobuf:"varint,2,opt,name=action,proto3,enum=machine.SequenceEvent_Action" json:"action,omitempty"`
+	Error         *common.Error          `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConfigLoadErrorEvent) Reset() {
-	*x = ConfigLoadErrorEvent{}
-	mi := &file_machine_machine_proto_msgTypes[14]
+func (x *SequenceEvent) Reset() {
+	*x = SequenceEvent{}
+	mi := &file_machine_machine_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConfigLoadErrorEvent) String() string {
+func (x *SequenceEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfigLoadErrorEvent) ProtoMessage() {}
+func (*SequenceEvent) ProtoMessage() {}
 
-func (x *ConfigLoadErrorEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[14]
+func (x *SequenceEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1618,87 +1708,55 @@ func (x *ConfigLoadErrorEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfigLoadErrorEvent.ProtoReflect.Descriptor instead.
-func (*ConfigLoadErrorEvent) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use SequenceEvent.ProtoReflect.Descriptor instead.
+func (*SequenceEvent) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ConfigLoadErrorEvent) GetError() string {
+func (x *SequenceEvent) GetSequence() string {
 	if x != nil {
-		return x.Error
+		return x.Sequence
 	}
 	return ""
 }
 
-// ConfigValidationErrorEvent is reported when config validation has failed.
-type ConfigValidationErrorEvent struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Error         string                 `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ConfigValidationErrorEvent) Reset() {
-	*x = ConfigValidationErrorEvent{}
-	mi := &file_machine_machine_proto_msgTypes[15]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ConfigValidationErrorEvent) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ConfigValidationErrorEvent) ProtoMessage() {}
-
-func (x *ConfigValidationErrorEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[15]
+func (x *SequenceEvent) GetAction() SequenceEvent_Action {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Action
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ConfigValidationErrorEvent.ProtoReflect.Descriptor instead.
-func (*ConfigValidationErrorEvent) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{15}
+	return SequenceEvent_NOOP
 }
 
-func (x *ConfigValidationErrorEvent) GetError() string {
+func (x *SequenceEvent) GetError() *common.Error {
 	if x != nil {
 		return x.Error
 	}
-	return ""
+	return nil
 }
 
-// AddressEvent reports node endpoints aggregated from k8s.Endpoints and network.Hostname.
-type AddressEvent struct {
+type PhaseEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Hostname      string                 `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
-	Addresses     []string               `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Phase         string                 `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`
+	Action        PhaseEvent_Action      `protobuf:"varint,2,opt,name=action,proto3,enum=machine.PhaseEvent_Action" json:"action,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddressEvent) Reset() {
-	*x = AddressEvent{}
-	mi := &file_machine_machine_proto_msgTypes[16]
+func (x *PhaseEvent) Reset() {
+	*x = PhaseEvent{}
+	mi := &file_machine_machine_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddressEvent) String() string {
+func (x *PhaseEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddressEvent) ProtoMessage() {}
+func (*PhaseEvent) ProtoMessage() {}
 
-func (x *AddressEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[16]
+func (x *PhaseEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1709,49 +1767,48 @@ func (x *AddressEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddressEvent.ProtoReflect.Descriptor instead.
-func (*AddressEvent) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use PhaseEvent.ProtoReflect.Descriptor instead.
+func (*PhaseEvent) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *AddressEvent) GetHostname() string {
+func (x *PhaseEvent) GetPhase() string {
 	if x != nil {
-		return x.Hostname
+		return x.Phase
 	}
 	return ""
 }
 
-func (x *AddressEvent) GetAddresses() []string {
+func (x *PhaseEvent) GetAction() PhaseEvent_Action {
 	if x != nil {
-		return x.Addresses
+		return x.Action
 	}
-	return nil
+	return PhaseEvent_START
 }
 
-// MachineStatusEvent reports changes to the MachineStatus resource.
-type MachineStatusEvent struct {
-	state         protoimpl.MessageState            `protogen:"open.v1"`
-	Stage         MachineStatusEvent_MachineStage   `protobuf:"varint,1,opt,name=stage,proto3,enum=machine.MachineStatusEvent_MachineStage" json:"stage,omitempty"`
-	Status        *MachineStatusEvent_MachineStatus `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+type TaskEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          string                 `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Action        TaskEvent_Action       `protobuf:"varint,2,opt,name=action,proto3,enum=machine.TaskEvent_Action" json:"action,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MachineStatusEvent) Reset() {
-	*x = MachineStatusEvent{}
-	mi := &file_machine_machine_proto_msgTypes[17]
+func (x *TaskEvent) Reset() {
+	*x = TaskEvent{}
+	mi := &file_machine_machine_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MachineStatusEvent) String() string {
+func (x *TaskEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MachineStatusEvent) ProtoMessage() {}
+func (*TaskEvent) ProtoMessage() {}
 
-func (x *MachineStatusEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[17]
+func (x *TaskEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1762,50 +1819,50 @@ func (x *MachineStatusEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MachineStatusEvent.ProtoReflect.Descriptor instead.
-func (*MachineStatusEvent) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use TaskEvent.ProtoReflect.Descriptor instead.
+func (*TaskEvent) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *MachineStatusEvent) GetStage() MachineStatusEvent_MachineStage {
+func (x *TaskEvent) GetTask() string {
 	if x != nil {
-		return x.Stage
+		return x.Task
 	}
-	return MachineStatusEvent_UNKNOWN
+	return ""
 }
 
-func (x *MachineStatusEvent) GetStatus() *MachineStatusEvent_MachineStatus {
+func (x *TaskEvent) GetAction() TaskEvent_Action {
 	if x != nil {
-		return x.Status
+		return x.Action
 	}
-	return nil
+	return TaskEvent_START
 }
 
-type EventsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TailEvents    int32                  `protobuf:"varint,1,opt,name=tail_events,json=tailEvents,proto3" json:"tail_events,omitempty"`
-	TailId        string                 `protobuf:"bytes,2,opt,name=tail_id,json=tailId,proto3" json:"tail_id,omitempty"`
-	TailSeconds   int32                  `protobuf:"varint,3,opt,name=tail_seconds,json=tailSeconds,proto3" json:"tail_seconds,omitempty"`
-	WithActorId   string                 `protobuf:"bytes,4,opt,name=with_actor_id,json=withActorId,proto3" json:"with_actor_id,omitempty"`
+type ServiceStateEvent struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Service       string                   `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Action        ServiceStateEvent_Action `protobuf:"varint,2,opt,name=action,proto3,enum=machine.ServiceStateEvent_Action" json:"action,omitempty"`
+	Message       string                   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Health        *ServiceHealth           `protobuf:"bytes,4,opt,name=health,proto3" json:"health,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EventsRequest) Reset() {
-	*x = EventsRequest{}
-	mi := &file_machine_machine_proto_msgTypes[18]
+func (x *ServiceStateEvent) Reset() {
+	*x = ServiceStateEvent{}
+	mi := &file_machine_machine_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EventsRequest) String() string {
+func (x *ServiceStateEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventsRequest) ProtoMessage() {}
+func (*ServiceStateEvent) ProtoMessage() {}
 
-func (x *EventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[18]
+func (x *ServiceStateEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1816,64 +1873,61 @@ func (x *EventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventsRequest.ProtoReflect.Descriptor instead.
-func (*EventsRequest) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ServiceStateEvent.ProtoReflect.Descriptor instead.
+func (*ServiceStateEvent) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *EventsRequest) GetTailEvents() int32 {
+func (x *ServiceStateEvent) GetService() string {
 	if x != nil {
-		return x.TailEvents
+		return x.Service
 	}
-	return 0
+	return ""
 }
 
-func (x *EventsRequest) GetTailId() string {
+func (x *ServiceStateEvent) GetAction() ServiceStateEvent_Action {
 	if x != nil {
-		return x.TailId
+		return x.Action
 	}
-	return ""
+	return ServiceStateEvent_INITIALIZED
 }
 
-func (x *EventsRequest) GetTailSeconds() int32 {
+func (x *ServiceStateEvent) GetMessage() string {
 	if x != nil {
-		return x.TailSeconds
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-func (x *EventsRequest) GetWithActorId() string {
+func (x *ServiceStateEvent) GetHealth() *ServiceHealth {
 	if x != nil {
-		return x.WithActorId
+		return x.Health
 	}
-	return ""
+	return nil
 }
 
-type Event struct {
+type RestartEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Metadata      *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Data          *anypb.Any             `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	Id            string                 `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
-	ActorId       string                 `protobuf:"bytes,4,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Cmd           int64                  `protobuf:"varint,1,opt,name=cmd,proto3" json:"cmd,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Event) Reset() {
-	*x = Event{}
-	mi := &file_machine_machine_proto_msgTypes[19]
+func (x *RestartEvent) Reset() {
+	*x = RestartEvent{}
+	mi := &file_machine_machine_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Event) String() string {
+func (x *RestartEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event) ProtoMessage() {}
+func (*RestartEvent) ProtoMessage() {}
 
-func (x *Event) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[19]
+func (x *RestartEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1884,63 +1938,41 @@ func (x *Event) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event.ProtoReflect.Descriptor instead.
-func (*Event) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *Event) GetMetadata() *common.Metadata {
-	if x != nil {
-		return x.Metadata
-	}
-	return nil
-}
-
-func (x *Event) GetData() *anypb.Any {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-func (x *Event) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use RestartEvent.ProtoReflect.Descriptor instead.
+func (*RestartEvent) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *Event) GetActorId() string {
+func (x *RestartEvent) GetCmd() int64 {
 	if x != nil {
-		return x.ActorId
+		return x.Cmd
 	}
-	return ""
+	return 0
 }
 
-// rpc reset
-type ResetPartitionSpec struct {
+// ConfigLoadErrorEvent is reported when the config loading has failed.
+type ConfigLoadErrorEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
-	Wipe          bool                   `protobuf:"varint,2,opt,name=wipe,proto3" json:"wipe,omitempty"`
+	Error         string                 `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResetPartitionSpec) Reset() {
-	*x = ResetPartitionSpec{}
-	mi := &file_machine_machine_proto_msgTypes[20]
+func (x *ConfigLoadErrorEvent) Reset() {
+	*x = ConfigLoadErrorEvent{}
+	mi := &file_machine_machine_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResetPartitionSpec) String() string {
+func (x *ConfigLoadErrorEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetPartitionSpec) ProtoMessage() {}
+func (*ConfigLoadErrorEvent) ProtoMessage() {}
 
-func (x *ResetPartitionSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[20]
+func (x *ConfigLoadErrorEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1951,57 +1983,144 @@ func (x *ResetPartitionSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetPartitionSpec.ProtoReflect.Descriptor instead.
-func (*ResetPartitionSpec) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ConfigLoadErrorEvent.ProtoReflect.Descriptor instead.
+func (*ConfigLoadErrorEvent) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ResetPartitionSpec) GetLabel() string {
+func (x *ConfigLoadErrorEvent) GetError() string {
 	if x != nil {
-		return x.Label
+		return x.Error
 	}
 	return ""
 }
 
-func (x *ResetPartitionSpec) GetWipe() bool {
-	if x != nil {
-		return x.Wipe
+// ConfigValidationErrorEvent is reported when config validation has failed.
+type ConfigValidationErrorEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Error         string                 `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigValidationErrorEvent) Reset() {
+	*x = ConfigValidationErrorEvent{}
+	mi := &file_machine_machine_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigValidationErrorEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigValidationErrorEvent) ProtoMessage() {}
+
+func (x *ConfigValidationErrorEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-type ResetRequest struct {
+// Deprecated: Use ConfigValidationErrorEvent.ProtoReflect.Descriptor instead.
+func (*ConfigValidationErrorEvent) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ConfigValidationErrorEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// AddressEvent reports node endpoints aggregated from k8s.Endpoints and network.Hostname.
+type AddressEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hostname      string                 `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Addresses     []string               `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddressEvent) Reset() {
+	*x = AddressEvent{}
+	mi := &file_machine_machine_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddressEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressEvent) ProtoMessage() {}
+
+func (x *AddressEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressEvent.ProtoReflect.Descriptor instead.
+func (*AddressEvent) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AddressEvent) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *AddressEvent) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+// VIPEvent reports changes of the owner of the shared IP (VIP).
+type VIPEvent struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Graceful indicates whether node should leave etcd before the upgrade, it also
-	// enforces etcd checks before leaving.
-	Graceful bool `protobuf:"varint,1,opt,name=graceful,proto3" json:"graceful,omitempty"`
-	// Reboot indicates whether node should reboot or halt after resetting.
-	Reboot bool `protobuf:"varint,2,opt,name=reboot,proto3" json:"reboot,omitempty"`
-	// System_partitions_to_wipe lists specific system disk partitions to be reset (wiped).
-	// If system_partitions_to_wipe is empty, all the partitions are erased.
-	SystemPartitionsToWipe []*ResetPartitionSpec `protobuf:"bytes,3,rep,name=system_partitions_to_wipe,json=systemPartitionsToWipe,proto3" json:"system_partitions_to_wipe,omitempty"`
-	// UserDisksToWipe lists specific connected block devices to be reset (wiped).
-	UserDisksToWipe []string `protobuf:"bytes,4,rep,name=user_disks_to_wipe,json=userDisksToWipe,proto3" json:"user_disks_to_wipe,omitempty"`
-	// WipeMode defines which devices should be wiped.
-	Mode          ResetRequest_WipeMode `protobuf:"varint,5,opt,name=mode,proto3,enum=machine.ResetRequest_WipeMode" json:"mode,omitempty"`
+	Ip    string                 `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	Link  string                 `protobuf:"bytes,2,opt,name=link,proto3" json:"link,omitempty"`
+	// Hostname and priority of the node which owns the shared IP.
+	Owner         string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	OwnerPriority uint32 `protobuf:"varint,4,opt,name=owner_priority,json=ownerPriority,proto3" json:"owner_priority,omitempty"`
+	// Whether the node reporting the event owns the shared IP.
+	Owned         bool `protobuf:"varint,5,opt,name=owned,proto3" json:"owned,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResetRequest) Reset() {
-	*x = ResetRequest{}
+func (x *VIPEvent) Reset() {
+	*x = VIPEvent{}
 	mi := &file_machine_machine_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResetRequest) String() string {
+func (x *VIPEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetRequest) ProtoMessage() {}
+func (*VIPEvent) ProtoMessage() {}
 
-func (x *ResetRequest) ProtoReflect() protoreflect.Message {
+func (x *VIPEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_machine_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2013,69 +2132,72 @@ func (x *ResetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetRequest.ProtoReflect.Descriptor instead.
-func (*ResetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use VIPEvent.ProtoReflect.Descriptor instead.
+func (*VIPEvent) Descriptor() ([]byte, []int) {
 	return file_machine_machine_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ResetRequest) GetGraceful() bool {
+func (x *VIPEvent) GetIp() string {
 	if x != nil {
-		return x.Graceful
+		return x.Ip
 	}
-	return false
+	return ""
 }
 
-func (x *ResetRequest) GetReboot() bool {
+func (x *VIPEvent) GetLink() string {
 	if x != nil {
-		return x.Reboot
+		return x.Link
 	}
-	return false
+	return ""
 }
 
-func (x *ResetRequest) GetSystemPartitionsToWipe() []*ResetPartitionSpec {
+func (x *VIPEvent) GetOwner() string {
 	if x != nil {
-		return x.SystemPartitionsToWipe
+		return x.Owner
 	}
-	return nil
+	return ""
 }
 
-func (x *ResetRequest) GetUserDisksToWipe() []string {
+func (x *VIPEvent) GetOwnerPriority() uint32 {
 	if x != nil {
-		return x.UserDisksToWipe
+		return x.OwnerPriority
 	}
-	return nil
+	return 0
 }
 
-func (x *ResetRequest) GetMode() ResetRequest_WipeMode {
+func (x *VIPEvent) GetOwned() bool {
 	if x != nil {
-		return x.Mode
+		return x.Owned
 	}
-	return ResetRequest_ALL
+	return false
 }
 
-// The reset message containing the restart status.
-type Reset struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Metadata      *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	ActorId       string                 `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// StaticFallbackEvent reports transitions between the static configuration and the DHCP fallback of a link.
+type StaticFallbackEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Link  string                 `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+	// Mode which is active after the transition: static or dhcp4.
+	Mode             string `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	Gateway          string `protobuf:"bytes,3,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	GatewayReachable bool   `protobuf:"varint,4,opt,name=gateway_reachable,json=gatewayReachable,proto3" json:"gateway_reachable,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *Reset) Reset() {
-	*x = Reset{}
+func (x *StaticFallbackEvent) Reset() {
+	*x = StaticFallbackEvent{}
 	mi := &file_machine_machine_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Reset) String() string {
+func (x *StaticFallbackEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Reset) ProtoMessage() {}
+func (*StaticFallbackEvent) ProtoMessage() {}
 
-func (x *Reset) ProtoReflect() protoreflect.Message {
+func (x *StaticFallbackEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_machine_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2087,46 +2209,64 @@ func (x *Reset) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Reset.ProtoReflect.Descriptor instead.
-func (*Reset) Descriptor() ([]byte, []int) {
+// Deprecated: Use StaticFallbackEvent.ProtoReflect.Descriptor instead.
+func (*StaticFallbackEvent) Descriptor() ([]byte, []int) {
 	return file_machine_machine_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *Reset) GetMetadata() *common.Metadata {
+func (x *StaticFallbackEvent) GetLink() string {
 	if x != nil {
-		return x.Metadata
+		return x.Link
 	}
-	return nil
+	return ""
 }
 
-func (x *Reset) GetActorId() string {
+func (x *StaticFallbackEvent) GetMode() string {
 	if x != nil {
-		return x.ActorId
+		return x.Mode
 	}
 	return ""
 }
 
-type ResetResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Messages      []*Reset               `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *StaticFallbackEvent) GetGateway() string {
+	if x != nil {
+		return x.Gateway
+	}
+	return ""
 }
 
-func (x *ResetResponse) Reset() {
-	*x = ResetResponse{}
+func (x *StaticFallbackEvent) GetGatewayReachable() bool {
+	if x != nil {
+		return x.GatewayReachable
+	}
+	return false
+}
+
+// ProbeEvent reports transitions of the network probe between the success and failure states.
+type ProbeEvent struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Success             bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	LastError           string                 `protobuf:"bytes,3,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	ConsecutiveFailures int64                  `protobuf:"varint,4,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ProbeEvent) Reset() {
+	*x = ProbeEvent{}
 	mi := &file_machine_machine_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResetResponse) String() string {
+func (x *ProbeEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetResponse) ProtoMessage() {}
+func (*ProbeEvent) ProtoMessage() {}
 
-func (x *ResetResponse) ProtoReflect() protoreflect.Message {
+func (x *ProbeEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_machine_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2138,42 +2278,65 @@ func (x *ResetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetResponse.ProtoReflect.Descriptor instead.
-func (*ResetResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProbeEvent.ProtoReflect.Descriptor instead.
+func (*ProbeEvent) Descriptor() ([]byte, []int) {
 	return file_machine_machine_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *ResetResponse) GetMessages() []*Reset {
+func (x *ProbeEvent) GetId() string {
 	if x != nil {
-		return x.Messages
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-// rpc shutdown
-// The messages message containing the shutdown status.
-type Shutdown struct {
+func (x *ProbeEvent) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ProbeEvent) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *ProbeEvent) GetConsecutiveFailures() int64 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+// EtcdDefragmentationEvent reports the results of the scheduled defragmentation of the local etcd member.
+type EtcdDefragmentationEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Metadata      *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	ActorId       string                 `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Duration      *durationpb.Duration   `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	DbSizeBefore  int64                  `protobuf:"varint,3,opt,name=db_size_before,json=dbSizeBefore,proto3" json:"db_size_before,omitempty"`
+	DbSizeAfter   int64                  `protobuf:"varint,4,opt,name=db_size_after,json=dbSizeAfter,proto3" json:"db_size_after,omitempty"`
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Shutdown) Reset() {
-	*x = Shutdown{}
+func (x *EtcdDefragmentationEvent) Reset() {
+	*x = EtcdDefragmentationEvent{}
 	mi := &file_machine_machine_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Shutdown) String() string {
+func (x *EtcdDefragmentationEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Shutdown) ProtoMessage() {}
+func (*EtcdDefragmentationEvent) ProtoMessage() {}
 
-func (x *Shutdown) ProtoReflect() protoreflect.Message {
+func (x *EtcdDefragmentationEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_machine_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2185,47 +2348,70 @@ func (x *Shutdown) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Shutdown.ProtoReflect.Descriptor instead.
-func (*Shutdown) Descriptor() ([]byte, []int) {
+// Deprecated: Use EtcdDefragmentationEvent.ProtoReflect.Descriptor instead.
+func (*EtcdDefragmentationEvent) Descriptor() ([]byte, []int) {
 	return file_machine_machine_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *Shutdown) GetMetadata() *common.Metadata {
+func (x *EtcdDefragmentationEvent) GetMemberId() string {
 	if x != nil {
-		return x.Metadata
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *EtcdDefragmentationEvent) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
 	}
 	return nil
 }
 
-func (x *Shutdown) GetActorId() string {
+func (x *EtcdDefragmentationEvent) GetDbSizeBefore() int64 {
 	if x != nil {
-		return x.ActorId
+		return x.DbSizeBefore
+	}
+	return 0
+}
+
+func (x *EtcdDefragmentationEvent) GetDbSizeAfter() int64 {
+	if x != nil {
+		return x.DbSizeAfter
+	}
+	return 0
+}
+
+func (x *EtcdDefragmentationEvent) GetError() string {
+	if x != nil {
+		return x.Error
 	}
 	return ""
 }
 
-type ShutdownRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Force indicates whether node should shutdown without first cordening and draining
-	Force         bool `protobuf:"varint,1,opt,name=force,proto3" json:"force,omitempty"`
+// EtcdLearnerStuckEvent reports the local etcd learner which is not promoted to a voting member for a long time.
+type EtcdLearnerStuckEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Lag           uint64                 `protobuf:"varint,2,opt,name=lag,proto3" json:"lag,omitempty"`
+	LearnerFor    *durationpb.Duration   `protobuf:"bytes,3,opt,name=learner_for,json=learnerFor,proto3" json:"learner_for,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ShutdownRequest) Reset() {
-	*x = ShutdownRequest{}
+func (x *EtcdLearnerStuckEvent) Reset() {
+	*x = EtcdLearnerStuckEvent{}
 	mi := &file_machine_machine_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ShutdownRequest) String() string {
+func (x *EtcdLearnerStuckEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShutdownRequest) ProtoMessage() {}
+func (*EtcdLearnerStuckEvent) ProtoMessage() {}
 
-func (x *ShutdownRequest) ProtoReflect() protoreflect.Message {
+func (x *EtcdLearnerStuckEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_machine_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2237,39 +2423,61 @@ func (x *ShutdownRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShutdownRequest.ProtoReflect.Descriptor instead.
-func (*ShutdownRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use EtcdLearnerStuckEvent.ProtoReflect.Descriptor instead.
+func (*EtcdLearnerStuckEvent) Descriptor() ([]byte, []int) {
 	return file_machine_machine_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *ShutdownRequest) GetForce() bool {
+func (x *EtcdLearnerStuckEvent) GetMemberId() string {
 	if x != nil {
-		return x.Force
+		return x.MemberId
 	}
-	return false
+	return ""
 }
 
-type ShutdownResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Messages      []*Shutdown            `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *EtcdLearnerStuckEvent) GetLag() uint64 {
+	if x != nil {
+		return x.Lag
+	}
+	return 0
 }
 
-func (x *ShutdownResponse) Reset() {
-	*x = ShutdownResponse{}
+func (x *EtcdLearnerStuckEvent) GetLearnerFor() *durationpb.Duration {
+	if x != nil {
+		return x.LearnerFor
+	}
+	return nil
+}
+
+// EtcdSnapshotEvent reports the results of the scheduled snapshot of the local etcd member.
+type EtcdSnapshotEvent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MemberId       string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Path           string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Size           int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Sha256         string                 `protobuf:"bytes,4,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	Duration       *durationpb.Duration   `protobuf:"bytes,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	UploadLocation string                 `protobuf:"bytes,6,opt,name=upload_location,json=uploadLocation,proto3" json:"upload_location,omitempty"`
+	Error          string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	UploadError    string                 `protobuf:"bytes,8,opt,name=upload_error,json=uploadError,proto3" json:"upload_error,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *EtcdSnapshotEvent) Reset() {
+	*x = EtcdSnapshotEvent{}
 	mi := &file_machine_machine_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ShutdownResponse) String() string {
+func (x *EtcdSnapshotEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShutdownResponse) ProtoMessage() {}
+func (*EtcdSnapshotEvent) ProtoMessage() {}
 
-func (x *ShutdownResponse) ProtoReflect() protoreflect.Message {
+func (x *EtcdSnapshotEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_machine_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2281,44 +2489,91 @@ func (x *ShutdownResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShutdownResponse.ProtoReflect.Descriptor instead.
-func (*ShutdownResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use EtcdSnapshotEvent.ProtoReflect.Descriptor instead.
+func (*EtcdSnapshotEvent) Descriptor() ([]byte, []int) {
 	return file_machine_machine_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *ShutdownResponse) GetMessages() []*Shutdown {
+func (x *EtcdSnapshotEvent) GetMemberId() string {
 	if x != nil {
-		return x.Messages
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *EtcdSnapshotEvent) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *EtcdSnapshotEvent) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *EtcdSnapshotEvent) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *EtcdSnapshotEvent) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
 	}
 	return nil
 }
 
-// rpc upgrade
-type UpgradeRequest struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Image         string                    `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
-	Preserve      bool                      `protobuf:"varint,2,opt,name=preserve,proto3" json:"preserve,omitempty"`
-	Stage         bool                      `protobuf:"varint,3,opt,name=stage,proto3" json:"stage,omitempty"`
-	Force         bool                      `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"`
-	RebootMode    UpgradeRequest_RebootMode `protobuf:"varint,5,opt,name=reboot_mode,json=rebootMode,proto3,enum=machine.UpgradeRequest_RebootMode" json:"reboot_mode,omitempty"`
+func (x *EtcdSnapshotEvent) GetUploadLocation() string {
+	if x != nil {
+		return x.UploadLocation
+	}
+	return ""
+}
+
+func (x *EtcdSnapshotEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *EtcdSnapshotEvent) GetUploadError() string {
+	if x != nil {
+		return x.UploadError
+	}
+	return ""
+}
+
+// EtcdAlarmEvent reports the etcd alarm which was raised or cleared.
+type EtcdAlarmEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Alarm         string                 `protobuf:"bytes,2,opt,name=alarm,proto3" json:"alarm,omitempty"`
+	Active        bool                   `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpgradeRequest) Reset() {
-	*x = UpgradeRequest{}
+func (x *EtcdAlarmEvent) Reset() {
+	*x = EtcdAlarmEvent{}
 	mi := &file_machine_machine_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpgradeRequest) String() string {
+func (x *EtcdAlarmEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpgradeRequest) ProtoMessage() {}
+func (*EtcdAlarmEvent) ProtoMessage() {}
 
-func (x *UpgradeRequest) ProtoReflect() protoreflect.Message {
+func (x *EtcdAlarmEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_machine_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2330,69 +2585,58 @@ func (x *UpgradeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpgradeRequest.ProtoReflect.Descriptor instead.
-func (*UpgradeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use EtcdAlarmEvent.ProtoReflect.Descriptor instead.
+func (*EtcdAlarmEvent) Descriptor() ([]byte, []int) {
 	return file_machine_machine_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *UpgradeRequest) GetImage() string {
+func (x *EtcdAlarmEvent) GetMemberId() string {
 	if x != nil {
-		return x.Image
+		return x.MemberId
 	}
 	return ""
 }
 
-func (x *UpgradeRequest) GetPreserve() bool {
-	if x != nil {
-		return x.Preserve
-	}
-	return false
-}
-
-func (x *UpgradeRequest) GetStage() bool {
+func (x *EtcdAlarmEvent) GetAlarm() string {
 	if x != nil {
-		return x.Stage
+		return x.Alarm
 	}
-	return false
+	return ""
 }
 
-func (x *UpgradeRequest) GetForce() bool {
+func (x *EtcdAlarmEvent) GetActive() bool {
 	if x != nil {
-		return x.Force
+		return x.Active
 	}
 	return false
 }
 
-func (x *UpgradeRequest) GetRebootMode() UpgradeRequest_RebootMode {
-	if x != nil {
-		return x.RebootMode
-	}
-	return UpgradeRequest_DEFAULT
-}
-
-type Upgrade struct {
+// CertificateExpiryEvent reports the certificate which crossed the expiry threshold.
+type CertificateExpiryEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Metadata      *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Ack           string                 `protobuf:"bytes,2,opt,name=ack,proto3" json:"ack,omitempty"`
-	ActorId       string                 `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Component     string                 `protobuf:"bytes,2,opt,name=component,proto3" json:"component,omitempty"`
+	NotAfter      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
+	DaysRemaining int64                  `protobuf:"varint,4,opt,name=days_remaining,json=daysRemaining,proto3" json:"days_remaining,omitempty"`
+	Threshold     int64                  `protobuf:"varint,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Upgrade) Reset() {
-	*x = Upgrade{}
+func (x *CertificateExpiryEvent) Reset() {
+	*x = CertificateExpiryEvent{}
 	mi := &file_machine_machine_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Upgrade) String() string {
+func (x *CertificateExpiryEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Upgrade) ProtoMessage() {}
+func (*CertificateExpiryEvent) ProtoMessage() {}
 
-func (x *Upgrade) ProtoReflect() protoreflect.Message {
+func (x *CertificateExpiryEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_machine_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2404,53 +2648,73 @@ func (x *Upgrade) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Upgrade.ProtoReflect.Descriptor instead.
-func (*Upgrade) Descriptor() ([]byte, []int) {
+// Deprecated: Use CertificateExpiryEvent.ProtoReflect.Descriptor instead.
+func (*CertificateExpiryEvent) Descriptor() ([]byte, []int) {
 	return file_machine_machine_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *Upgrade) GetMetadata() *common.Metadata {
+func (x *CertificateExpiryEvent) GetId() string {
 	if x != nil {
-		return x.Metadata
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *Upgrade) GetAck() string {
+func (x *CertificateExpiryEvent) GetComponent() string {
 	if x != nil {
-		return x.Ack
+		return x.Component
 	}
 	return ""
 }
 
-func (x *Upgrade) GetActorId() string {
+func (x *CertificateExpiryEvent) GetNotAfter() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ActorId
+		return x.NotAfter
 	}
-	return ""
+	return nil
 }
 
-type UpgradeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Messages      []*Upgrade             `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CertificateExpiryEvent) GetDaysRemaining() int64 {
+	if x != nil {
+		return x.DaysRemaining
+	}
+	return 0
 }
 
-func (x *UpgradeResponse) Reset() {
-	*x = UpgradeResponse{}
+func (x *CertificateExpiryEvent) GetThreshold() int64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+// StaticPodRestartStormEvent reports the control plane static pod which restarts repeatedly.
+type StaticPodRestartStormEvent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PodName        string                 `protobuf:"bytes,2,opt,name=pod_name,json=podName,proto3" json:"pod_name,omitempty"`
+	RestartCount   int64                  `protobuf:"varint,3,opt,name=restart_count,json=restartCount,proto3" json:"restart_count,omitempty"`
+	RecentRestarts int64                  `protobuf:"varint,4,opt,name=recent_restarts,json=recentRestarts,proto3" json:"recent_restarts,omitempty"`
+	LastExitCode   int64                  `protobuf:"varint,5,opt,name=last_exit_code,json=lastExitCode,proto3" json:"last_exit_code,omitempty"`
+	LastExitReason string                 `protobuf:"bytes,6,opt,name=last_exit_reason,json=lastExitReason,proto3" json:"last_exit_reason,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StaticPodRestartStormEvent) Reset() {
+	*x = StaticPodRestartStormEvent{}
 	mi := &file_machine_machine_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpgradeResponse) String() string {
+func (x *StaticPodRestartStormEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpgradeResponse) ProtoMessage() {}
+func (*StaticPodRestartStormEvent) ProtoMessage() {}
 
-func (x *UpgradeResponse) ProtoReflect() protoreflect.Message {
+func (x *StaticPodRestartStormEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_machine_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2462,41 +2726,78 @@ func (x *UpgradeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpgradeResponse.ProtoReflect.Descriptor instead.
-func (*UpgradeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StaticPodRestartStormEvent.ProtoReflect.Descriptor instead.
+func (*StaticPodRestartStormEvent) Descriptor() ([]byte, []int) {
 	return file_machine_machine_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *UpgradeResponse) GetMessages() []*Upgrade {
+func (x *StaticPodRestartStormEvent) GetId() string {
 	if x != nil {
-		return x.Messages
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-// rpc servicelist
-type ServiceList struct {
+func (x *StaticPodRestartStormEvent) GetPodName() string {
+	if x != nil {
+		return x.PodName
+	}
+	return ""
+}
+
+func (x *StaticPodRestartStormEvent) GetRestartCount() int64 {
+	if x != nil {
+		return x.RestartCount
+	}
+	return 0
+}
+
+func (x *StaticPodRestartStormEvent) GetRecentRestarts() int64 {
+	if x != nil {
+		return x.RecentRestarts
+	}
+	return 0
+}
+
+func (x *StaticPodRestartStormEvent) GetLastExitCode() int64 {
+	if x != nil {
+		return x.LastExitCode
+	}
+	return 0
+}
+
+func (x *StaticPodRestartStormEvent) GetLastExitReason() string {
+	if x != nil {
+		return x.LastExitReason
+	}
+	return ""
+}
+
+// KubeletShutdownEvent reports the result of the kubelet graceful node shutdown.
+type KubeletShutdownEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Metadata      *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Services      []*ServiceInfo         `protobuf:"bytes,2,rep,name=services,proto3" json:"services,omitempty"`
+	GracePeriod   *durationpb.Duration   `protobuf:"bytes,1,opt,name=grace_period,json=gracePeriod,proto3" json:"grace_period,omitempty"`
+	WaitDuration  *durationpb.Duration   `protobuf:"bytes,2,opt,name=wait_duration,json=waitDuration,proto3" json:"wait_duration,omitempty"`
+	TimedOut      bool                   `protobuf:"varint,3,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ServiceList) Reset() {
-	*x = ServiceList{}
+func (x *KubeletShutdownEvent) Reset() {
+	*x = KubeletShutdownEvent{}
 	mi := &file_machine_machine_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ServiceList) String() string {
+func (x *KubeletShutdownEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServiceList) ProtoMessage() {}
+func (*KubeletShutdownEvent) ProtoMessage() {}
 
-func (x *ServiceList) ProtoReflect() protoreflect.Message {
+func (x *KubeletShutdownEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_machine_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2508,46 +2809,65 @@ func (x *ServiceList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServiceList.ProtoReflect.Descriptor instead.
-func (*ServiceList) Descriptor() ([]byte, []int) {
+// Deprecated: Use KubeletShutdownEvent.ProtoReflect.Descriptor instead.
+func (*KubeletShutdownEvent) Descriptor() ([]byte, []int) {
 	return file_machine_machine_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *ServiceList) GetMetadata() *common.Metadata {
+func (x *KubeletShutdownEvent) GetGracePeriod() *durationpb.Duration {
 	if x != nil {
-		return x.Metadata
+		return x.GracePeriod
 	}
 	return nil
 }
 
-func (x *ServiceList) GetServices() []*ServiceInfo {
+func (x *KubeletShutdownEvent) GetWaitDuration() *durationpb.Duration {
 	if x != nil {
-		return x.Services
+		return x.WaitDuration
 	}
 	return nil
 }
 
-type ServiceListResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Messages      []*ServiceList         `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+func (x *KubeletShutdownEvent) GetTimedOut() bool {
+	if x != nil {
+		return x.TimedOut
+	}
+	return false
+}
+
+func (x *KubeletShutdownEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// ShutdownImminentEvent is sent before the reboot, shutdown or upgrade waits for the shutdown inhibitors to be released.
+type ShutdownImminentEvent struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Sequence string                 `protobuf:"bytes,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// IDs of the shutdown inhibitors the sequence waits for.
+	Inhibitors []string `protobuf:"bytes,2,rep,name=inhibitors,proto3" json:"inhibitors,omitempty"`
+	// MaxWait is the maximum time the sequence waits for the inhibitors.
+	MaxWait       *durationpb.Duration `protobuf:"bytes,3,opt,name=max_wait,json=maxWait,proto3" json:"max_wait,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ServiceListResponse) Reset() {
-	*x = ServiceListResponse{}
+func (x *ShutdownImminentEvent) Reset() {
+	*x = ShutdownImminentEvent{}
 	mi := &file_machine_machine_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ServiceListResponse) String() string {
+func (x *ShutdownImminentEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServiceListResponse) ProtoMessage() {}
+func (*ShutdownImminentEvent) ProtoMessage() {}
 
-func (x *ServiceListResponse) ProtoReflect() protoreflect.Message {
+func (x *ShutdownImminentEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_machine_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2559,42 +2879,59 @@ func (x *ServiceListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServiceListResponse.ProtoReflect.Descriptor instead.
-func (*ServiceListResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ShutdownImminentEvent.ProtoReflect.Descriptor instead.
+func (*ShutdownImminentEvent) Descriptor() ([]byte, []int) {
 	return file_machine_machine_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *ServiceListResponse) GetMessages() []*ServiceList {
+func (x *ShutdownImminentEvent) GetSequence() string {
 	if x != nil {
-		return x.Messages
+		return x.Sequence
+	}
+	return ""
+}
+
+func (x *ShutdownImminentEvent) GetInhibitors() []string {
+	if x != nil {
+		return x.Inhibitors
 	}
 	return nil
 }
 
-type ServiceInfo struct {
+func (x *ShutdownImminentEvent) GetMaxWait() *durationpb.Duration {
+	if x != nil {
+		return x.MaxWait
+	}
+	return nil
+}
+
+// UpgradeEvent reports the progress of the upgrade resolved on boot from the upgrade checkpoint.
+type UpgradeEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
-	Events        *ServiceEvents         `protobuf:"bytes,3,opt,name=events,proto3" json:"events,omitempty"`
-	Health        *ServiceHealth         `protobuf:"bytes,4,opt,name=health,proto3" json:"health,omitempty"`
+	Image         string                 `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	FromVersion   string                 `protobuf:"bytes,2,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"`
+	ToVersion     string                 `protobuf:"bytes,3,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"`
+	Phase         string                 `protobuf:"bytes,4,opt,name=phase,proto3" json:"phase,omitempty"`
+	Outcome       string                 `protobuf:"bytes,5,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Message       string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ServiceInfo) Reset() {
-	*x = ServiceInfo{}
+func (x *UpgradeEvent) Reset() {
+	*x = UpgradeEvent{}
 	mi := &file_machine_machine_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ServiceInfo) String() string {
+func (x *UpgradeEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServiceInfo) ProtoMessage() {}
+func (*UpgradeEvent) ProtoMessage() {}
 
-func (x *ServiceInfo) ProtoReflect() protoreflect.Message {
+func (x *UpgradeEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_machine_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2606,60 +2943,78 @@ func (x *ServiceInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServiceInfo.ProtoReflect.Descriptor instead.
-func (*ServiceInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpgradeEvent.ProtoReflect.Descriptor instead.
+func (*UpgradeEvent) Descriptor() ([]byte, []int) {
 	return file_machine_machine_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *ServiceInfo) GetId() string {
+func (x *UpgradeEvent) GetImage() string {
 	if x != nil {
-		return x.Id
+		return x.Image
 	}
 	return ""
 }
 
-func (x *ServiceInfo) GetState() string {
+func (x *UpgradeEvent) GetFromVersion() string {
 	if x != nil {
-		return x.State
+		return x.FromVersion
 	}
 	return ""
 }
 
-func (x *ServiceInfo) GetEvents() *ServiceEvents {
+func (x *UpgradeEvent) GetToVersion() string {
 	if x != nil {
-		return x.Events
+		return x.ToVersion
 	}
-	return nil
+	return ""
 }
 
-func (x *ServiceInfo) GetHealth() *ServiceHealth {
+func (x *UpgradeEvent) GetPhase() string {
 	if x != nil {
-		return x.Health
+		return x.Phase
 	}
-	return nil
+	return ""
 }
 
-type ServiceEvents struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Events        []*ServiceEvent        `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UpgradeEvent) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
 }
 
-func (x *ServiceEvents) Reset() {
-	*x = ServiceEvents{}
+func (x *UpgradeEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ZombieProcessesEvent reports the number of zombie processes crossing the threshold.
+type ZombieProcessesEvent struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Zombies   int64                  `protobuf:"varint,1,opt,name=zombies,proto3" json:"zombies,omitempty"`
+	Threshold int64                  `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// Parent commands of the zombie processes, with the most zombies first.
+	ParentCommands []string `protobuf:"bytes,3,rep,name=parent_commands,json=parentCommands,proto3" json:"parent_commands,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ZombieProcessesEvent) Reset() {
+	*x = ZombieProcessesEvent{}
 	mi := &file_machine_machine_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ServiceEvents) String() string {
+func (x *ZombieProcessesEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServiceEvents) ProtoMessage() {}
+func (*ZombieProcessesEvent) ProtoMessage() {}
 
-func (x *ServiceEvents) ProtoReflect() protoreflect.Message {
+func (x *ZombieProcessesEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_machine_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2671,41 +3026,61 @@ func (x *ServiceEvents) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServiceEvents.ProtoReflect.Descriptor instead.
-func (*ServiceEvents) Descriptor() ([]byte, []int) {
+// Deprecated: Use ZombieProcessesEvent.ProtoReflect.Descriptor instead.
+func (*ZombieProcessesEvent) Descriptor() ([]byte, []int) {
 	return file_machine_machine_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *ServiceEvents) GetEvents() []*ServiceEvent {
+func (x *ZombieProcessesEvent) GetZombies() int64 {
 	if x != nil {
-		return x.Events
+		return x.Zombies
+	}
+	return 0
+}
+
+func (x *ZombieProcessesEvent) GetThreshold() int64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *ZombieProcessesEvent) GetParentCommands() []string {
+	if x != nil {
+		return x.ParentCommands
 	}
 	return nil
 }
 
-type ServiceEvent struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Msg           string                 `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
-	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
-	Ts            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=ts,proto3" json:"ts,omitempty"`
+// CertificateSigningEvent reports a certificate signing decision of trustd.
+type CertificateSigningEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Source address of the certificate signing request.
+	Source      string   `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Subject     string   `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	DnsNames    []string `protobuf:"bytes,3,rep,name=dns_names,json=dnsNames,proto3" json:"dns_names,omitempty"`
+	IpAddresses []string `protobuf:"bytes,4,rep,name=ip_addresses,json=ipAddresses,proto3" json:"ip_addresses,omitempty"`
+	// Decision is one of "signed", "denied" or "rate-limited".
+	Decision      string `protobuf:"bytes,5,opt,name=decision,proto3" json:"decision,omitempty"`
+	Reason        string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ServiceEvent) Reset() {
-	*x = ServiceEvent{}
+func (x *CertificateSigningEvent) Reset() {
+	*x = CertificateSigningEvent{}
 	mi := &file_machine_machine_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ServiceEvent) String() string {
+func (x *CertificateSigningEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServiceEvent) ProtoMessage() {}
+func (*CertificateSigningEvent) ProtoMessage() {}
 
-func (x *ServiceEvent) ProtoReflect() protoreflect.Message {
+func (x *CertificateSigningEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_machine_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2717,56 +3092,78 @@ func (x *ServiceEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServiceEvent.ProtoReflect.Descriptor instead.
-func (*ServiceEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use CertificateSigningEvent.ProtoReflect.Descriptor instead.
+func (*CertificateSigningEvent) Descriptor() ([]byte, []int) {
 	return file_machine_machine_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *ServiceEvent) GetMsg() string {
+func (x *CertificateSigningEvent) GetSource() string {
 	if x != nil {
-		return x.Msg
+		return x.Source
 	}
 	return ""
 }
 
-func (x *ServiceEvent) GetState() string {
+func (x *CertificateSigningEvent) GetSubject() string {
 	if x != nil {
-		return x.State
+		return x.Subject
 	}
 	return ""
 }
 
-func (x *ServiceEvent) GetTs() *timestamppb.Timestamp {
+func (x *CertificateSigningEvent) GetDnsNames() []string {
 	if x != nil {
-		return x.Ts
+		return x.DnsNames
 	}
 	return nil
 }
 
-type ServiceHealth struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Unknown       bool                   `protobuf:"varint,1,opt,name=unknown,proto3" json:"unknown,omitempty"`
-	Healthy       bool                   `protobuf:"varint,2,opt,name=healthy,proto3" json:"healthy,omitempty"`
-	LastMessage   string                 `protobuf:"bytes,3,opt,name=last_message,json=lastMessage,proto3" json:"last_message,omitempty"`
-	LastChange    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_change,json=lastChange,proto3" json:"last_change,omitempty"`
+func (x *CertificateSigningEvent) GetIpAddresses() []string {
+	if x != nil {
+		return x.IpAddresses
+	}
+	return nil
+}
+
+func (x *CertificateSigningEvent) GetDecision() string {
+	if x != nil {
+		return x.Decision
+	}
+	return ""
+}
+
+func (x *CertificateSigningEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// APIAuthorizationDeniedEvent reports a Talos API call denied by the authorization.
+type APIAuthorizationDeniedEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Full gRPC method name.
+	Method string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	// Roles of the client.
+	Roles         []string `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ServiceHealth) Reset() {
-	*x = ServiceHealth{}
+func (x *APIAuthorizationDeniedEvent) Reset() {
+	*x = APIAuthorizationDeniedEvent{}
 	mi := &file_machine_machine_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ServiceHealth) String() string {
+func (x *APIAuthorizationDeniedEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServiceHealth) ProtoMessage() {}
+func (*APIAuthorizationDeniedEvent) ProtoMessage() {}
 
-func (x *ServiceHealth) ProtoReflect() protoreflect.Message {
+func (x *APIAuthorizationDeniedEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_machine_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2778,61 +3175,48 @@ func (x *ServiceHealth) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServiceHealth.ProtoReflect.Descriptor instead.
-func (*ServiceHealth) Descriptor() ([]byte, []int) {
+// Deprecated: Use APIAuthorizationDeniedEvent.ProtoReflect.Descriptor instead.
+func (*APIAuthorizationDeniedEvent) Descriptor() ([]byte, []int) {
 	return file_machine_machine_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *ServiceHealth) GetUnknown() bool {
-	if x != nil {
-		return x.Unknown
-	}
-	return false
-}
-
-func (x *ServiceHealth) GetHealthy() bool {
-	if x != nil {
-		return x.Healthy
-	}
-	return false
-}
-
-func (x *ServiceHealth) GetLastMessage() string {
+func (x *APIAuthorizationDeniedEvent) GetMethod() string {
 	if x != nil {
-		return x.LastMessage
+		return x.Method
 	}
 	return ""
 }
 
-func (x *ServiceHealth) GetLastChange() *timestamppb.Timestamp {
+func (x *APIAuthorizationDeniedEvent) GetRoles() []string {
 	if x != nil {
-		return x.LastChange
+		return x.Roles
 	}
 	return nil
 }
 
-// rpc servicestart
-type ServiceStartRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+// SecretsBundleExportEvent reports the export of the secrets bundle.
+type SecretsBundleExportEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Roles of the client.
+	Roles         []string `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ServiceStartRequest) Reset() {
-	*x = ServiceStartRequest{}
+func (x *SecretsBundleExportEvent) Reset() {
+	*x = SecretsBundleExportEvent{}
 	mi := &file_machine_machine_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ServiceStartRequest) String() string {
+func (x *SecretsBundleExportEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServiceStartRequest) ProtoMessage() {}
+func (*SecretsBundleExportEvent) ProtoMessage() {}
 
-func (x *ServiceStartRequest) ProtoReflect() protoreflect.Message {
+func (x *SecretsBundleExportEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_machine_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2844,40 +3228,43 @@ func (x *ServiceStartRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServiceStartRequest.ProtoReflect.Descriptor instead.
-func (*ServiceStartRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SecretsBundleExportEvent.ProtoReflect.Descriptor instead.
+func (*SecretsBundleExportEvent) Descriptor() ([]byte, []int) {
 	return file_machine_machine_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *ServiceStartRequest) GetId() string {
+func (x *SecretsBundleExportEvent) GetRoles() []string {
 	if x != nil {
-		return x.Id
+		return x.Roles
 	}
-	return ""
+	return nil
 }
 
-type ServiceStart struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Metadata      *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Resp          string                 `protobuf:"bytes,2,opt,name=resp,proto3" json:"resp,omitempty"`
+// JoinTokenEvent reports the lifecycle of the join tokens accepted by trustd.
+type JoinTokenEvent struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Name   string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Action JoinTokenEvent_Action  `protobuf:"varint,2,opt,name=action,proto3,enum=machine.JoinTokenEvent_Action" json:"action,omitempty"`
+	// Source address of the machine which used the token for the first time.
+	Source        string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ServiceStart) Reset() {
-	*x = ServiceStart{}
+func (x *JoinTokenEvent) Reset() {
+	*x = JoinTokenEvent{}
 	mi := &file_machine_machine_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ServiceStart) String() string {
+func (x *JoinTokenEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServiceStart) ProtoMessage() {}
+func (*JoinTokenEvent) ProtoMessage() {}
 
-func (x *ServiceStart) ProtoReflect() protoreflect.Message {
+func (x *JoinTokenEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_machine_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2889,46 +3276,58 @@ func (x *ServiceStart) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServiceStart.ProtoReflect.Descriptor instead.
-func (*ServiceStart) Descriptor() ([]byte, []int) {
+// Deprecated: Use JoinTokenEvent.ProtoReflect.Descriptor instead.
+func (*JoinTokenEvent) Descriptor() ([]byte, []int) {
 	return file_machine_machine_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *ServiceStart) GetMetadata() *common.Metadata {
+func (x *JoinTokenEvent) GetName() string {
 	if x != nil {
-		return x.Metadata
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *ServiceStart) GetResp() string {
+func (x *JoinTokenEvent) GetAction() JoinTokenEvent_Action {
 	if x != nil {
-		return x.Resp
+		return x.Action
+	}
+	return JoinTokenEvent_CREATED
+}
+
+func (x *JoinTokenEvent) GetSource() string {
+	if x != nil {
+		return x.Source
 	}
 	return ""
 }
 
-type ServiceStartResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Messages      []*ServiceStart        `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+// IdentityConflictEvent reports another machine which presents the same node identity.
+type IdentityConflictEvent struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	NodeId string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// Hostname of the conflicting machine.
+	Hostname string `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	// Addresses of the conflicting machine.
+	Addresses     []string `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ServiceStartResponse) Reset() {
-	*x = ServiceStartResponse{}
+func (x *IdentityConflictEvent) Reset() {
+	*x = IdentityConflictEvent{}
 	mi := &file_machine_machine_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ServiceStartResponse) String() string {
+func (x *IdentityConflictEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServiceStartResponse) ProtoMessage() {}
+func (*IdentityConflictEvent) ProtoMessage() {}
 
-func (x *ServiceStartResponse) ProtoReflect() protoreflect.Message {
+func (x *IdentityConflictEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_machine_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2940,39 +3339,63 @@ func (x *ServiceStartResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServiceStartResponse.ProtoReflect.Descriptor instead.
-func (*ServiceStartResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use IdentityConflictEvent.ProtoReflect.Descriptor instead.
+func (*IdentityConflictEvent) Descriptor() ([]byte, []int) {
 	return file_machine_machine_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *ServiceStartResponse) GetMessages() []*ServiceStart {
+func (x *IdentityConflictEvent) GetNodeId() string {
 	if x != nil {
-		return x.Messages
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *IdentityConflictEvent) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *IdentityConflictEvent) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
 	}
 	return nil
 }
 
-type ServiceStopRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+// CertificateRevokedEvent reports a Talos API request rejected because of the revoked client certificate.
+type CertificateRevokedEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Source address of the request.
+	Source  string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Subject string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	// Serial number of the client certificate in hexadecimal.
+	SerialNumber string `protobuf:"bytes,3,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	// SPKI fingerprint of the client certificate.
+	Fingerprint string `protobuf:"bytes,4,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	// Full gRPC method name.
+	Method        string `protobuf:"bytes,5,opt,name=method,proto3" json:"method,omitempty"`
+	Reason        string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ServiceStopRequest) Reset() {
-	*x = ServiceStopRequest{}
+func (x *CertificateRevokedEvent) Reset() {
+	*x = CertificateRevokedEvent{}
 	mi := &file_machine_machine_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ServiceStopRequest) String() string {
+func (x *CertificateRevokedEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServiceStopRequest) ProtoMessage() {}
+func (*CertificateRevokedEvent) ProtoMessage() {}
 
-func (x *ServiceStopRequest) ProtoReflect() protoreflect.Message {
+func (x *CertificateRevokedEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_machine_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2984,92 +3407,82 @@ func (x *ServiceStopRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServiceStopRequest.ProtoReflect.Descriptor instead.
-func (*ServiceStopRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CertificateRevokedEvent.ProtoReflect.Descriptor instead.
+func (*CertificateRevokedEvent) Descriptor() ([]byte, []int) {
 	return file_machine_machine_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *ServiceStopRequest) GetId() string {
+func (x *CertificateRevokedEvent) GetSource() string {
 	if x != nil {
-		return x.Id
+		return x.Source
 	}
 	return ""
 }
 
-type ServiceStop struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Metadata      *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Resp          string                 `protobuf:"bytes,2,opt,name=resp,proto3" json:"resp,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ServiceStop) Reset() {
-	*x = ServiceStop{}
-	mi := &file_machine_machine_proto_msgTypes[40]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ServiceStop) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CertificateRevokedEvent) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
 }
 
-func (*ServiceStop) ProtoMessage() {}
-
-func (x *ServiceStop) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[40]
+func (x *CertificateRevokedEvent) GetSerialNumber() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.SerialNumber
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ServiceStop.ProtoReflect.Descriptor instead.
-func (*ServiceStop) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{40}
+func (x *CertificateRevokedEvent) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
 }
 
-func (x *ServiceStop) GetMetadata() *common.Metadata {
+func (x *CertificateRevokedEvent) GetMethod() string {
 	if x != nil {
-		return x.Metadata
+		return x.Method
 	}
-	return nil
+	return ""
 }
 
-func (x *ServiceStop) GetResp() string {
+func (x *CertificateRevokedEvent) GetReason() string {
 	if x != nil {
-		return x.Resp
+		return x.Reason
 	}
 	return ""
 }
 
-type ServiceStopResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Messages      []*ServiceStop         `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+// DiskKeyRotationEvent reports the progress of the disk encryption key rotation.
+type DiskKeyRotationEvent struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	VolumeId string                 `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	Slot     int64                  `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	// Rotation step, empty when the rotation of the slot is finished.
+	Step string `protobuf:"bytes,3,opt,name=step,proto3" json:"step,omitempty"`
+	// Key epoch after the rotation.
+	Epoch         int64  `protobuf:"varint,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Error         string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ServiceStopResponse) Reset() {
-	*x = ServiceStopResponse{}
-	mi := &file_machine_machine_proto_msgTypes[41]
+func (x *DiskKeyRotationEvent) Reset() {
+	*x = DiskKeyRotationEvent{}
+	mi := &file_machine_machine_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ServiceStopResponse) String() string {
+func (x *DiskKeyRotationEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServiceStopResponse) ProtoMessage() {}
+func (*DiskKeyRotationEvent) ProtoMessage() {}
 
-func (x *ServiceStopResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[41]
+func (x *DiskKeyRotationEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3080,85 +3493,70 @@ func (x *ServiceStopResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServiceStopResponse.ProtoReflect.Descriptor instead.
-func (*ServiceStopResponse) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use DiskKeyRotationEvent.ProtoReflect.Descriptor instead.
+func (*DiskKeyRotationEvent) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *ServiceStopResponse) GetMessages() []*ServiceStop {
+func (x *DiskKeyRotationEvent) GetVolumeId() string {
 	if x != nil {
-		return x.Messages
+		return x.VolumeId
 	}
-	return nil
+	return ""
 }
 
-type ServiceRestartRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ServiceRestartRequest) Reset() {
-	*x = ServiceRestartRequest{}
-	mi := &file_machine_machine_proto_msgTypes[42]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ServiceRestartRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *DiskKeyRotationEvent) GetSlot() int64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
 }
 
-func (*ServiceRestartRequest) ProtoMessage() {}
-
-func (x *ServiceRestartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[42]
+func (x *DiskKeyRotationEvent) GetStep() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Step
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ServiceRestartRequest.ProtoReflect.Descriptor instead.
-func (*ServiceRestartRequest) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{42}
+func (x *DiskKeyRotationEvent) GetEpoch() int64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
 }
 
-func (x *ServiceRestartRequest) GetId() string {
+func (x *DiskKeyRotationEvent) GetError() string {
 	if x != nil {
-		return x.Id
+		return x.Error
 	}
 	return ""
 }
 
-type ServiceRestart struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Metadata      *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Resp          string                 `protobuf:"bytes,2,opt,name=resp,proto3" json:"resp,omitempty"`
+// MachineStatusEvent reports changes to the MachineStatus resource.
+type MachineStatusEvent struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Stage         MachineStatusEvent_MachineStage   `protobuf:"varint,1,opt,name=stage,proto3,enum=machine.MachineStatusEvent_MachineStage" json:"stage,omitempty"`
+	Status        *MachineStatusEvent_MachineStatus `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ServiceRestart) Reset() {
-	*x = ServiceRestart{}
-	mi := &file_machine_machine_proto_msgTypes[43]
+func (x *MachineStatusEvent) Reset() {
+	*x = MachineStatusEvent{}
+	mi := &file_machine_machine_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ServiceRestart) String() string {
+func (x *MachineStatusEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServiceRestart) ProtoMessage() {}
+func (*MachineStatusEvent) ProtoMessage() {}
 
-func (x *ServiceRestart) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[43]
+func (x *MachineStatusEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3169,47 +3567,54 @@ func (x *ServiceRestart) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServiceRestart.ProtoReflect.Descriptor instead.
-func (*ServiceRestart) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use MachineStatusEvent.ProtoReflect.Descriptor instead.
+func (*MachineStatusEvent) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *ServiceRestart) GetMetadata() *common.Metadata {
+func (x *MachineStatusEvent) GetStage() MachineStatusEvent_MachineStage {
 	if x != nil {
-		return x.Metadata
+		return x.Stage
 	}
-	return nil
+	return MachineStatusEvent_UNKNOWN
 }
 
-func (x *ServiceRestart) GetResp() string {
+func (x *MachineStatusEvent) GetStatus() *MachineStatusEvent_MachineStatus {
 	if x != nil {
-		return x.Resp
+		return x.Status
 	}
-	return ""
+	return nil
 }
 
-type ServiceRestartResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Messages      []*ServiceRestart      `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type EventsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	TailEvents  int32                  `protobuf:"varint,1,opt,name=tail_events,json=tailEvents,proto3" json:"tail_events,omitempty"`
+	TailId      string                 `protobuf:"bytes,2,opt,name=tail_id,json=tailId,proto3" json:"tail_id,omitempty"`
+	TailSeconds int32                  `protobuf:"varint,3,opt,name=tail_seconds,json=tailSeconds,proto3" json:"tail_seconds,omitempty"`
+	WithActorId string                 `protobuf:"bytes,4,opt,name=with_actor_id,json=withActorId,proto3" json:"with_actor_id,omitempty"`
+	// SinceBoot replays the events persisted since the specified boot relative to the current one, e.g. -1 for the previous boot.
+	SinceBoot int32 `protobuf:"varint,5,opt,name=since_boot,json=sinceBoot,proto3" json:"since_boot,omitempty"`
+	// ReplayPersisted replays all events persisted on the STATE partition, including the previous boots.
+	ReplayPersisted bool `protobuf:"varint,6,opt,name=replay_persisted,json=replayPersisted,proto3" json:"replay_persisted,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ServiceRestartResponse) Reset() {
-	*x = ServiceRestartResponse{}
-	mi := &file_machine_machine_proto_msgTypes[44]
+func (x *EventsRequest) Reset() {
+	*x = EventsRequest{}
+	mi := &file_machine_machine_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ServiceRestartResponse) String() string {
+func (x *EventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServiceRestartResponse) ProtoMessage() {}
+func (*EventsRequest) ProtoMessage() {}
 
-func (x *ServiceRestartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[44]
+func (x *EventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3220,101 +3625,80 @@ func (x *ServiceRestartResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServiceRestartResponse.ProtoReflect.Descriptor instead.
-func (*ServiceRestartResponse) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use EventsRequest.ProtoReflect.Descriptor instead.
+func (*EventsRequest) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *ServiceRestartResponse) GetMessages() []*ServiceRestart {
+func (x *EventsRequest) GetTailEvents() int32 {
 	if x != nil {
-		return x.Messages
+		return x.TailEvents
 	}
-	return nil
-}
-
-// CopyRequest describes a request to copy data out of Talos node
-//
-// Copy produces .tar.gz archive which is streamed back to the caller
-type CopyRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Root path to start copying data out, it might be either a file or directory
-	RootPath      string `protobuf:"bytes,1,opt,name=root_path,json=rootPath,proto3" json:"root_path,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return 0
 }
 
-func (x *CopyRequest) Reset() {
-	*x = CopyRequest{}
-	mi := &file_machine_machine_proto_msgTypes[45]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *EventsRequest) GetTailId() string {
+	if x != nil {
+		return x.TailId
+	}
+	return ""
 }
 
-func (x *CopyRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *EventsRequest) GetTailSeconds() int32 {
+	if x != nil {
+		return x.TailSeconds
+	}
+	return 0
 }
 
-func (*CopyRequest) ProtoMessage() {}
-
-func (x *CopyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[45]
+func (x *EventsRequest) GetWithActorId() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.WithActorId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CopyRequest.ProtoReflect.Descriptor instead.
-func (*CopyRequest) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{45}
+func (x *EventsRequest) GetSinceBoot() int32 {
+	if x != nil {
+		return x.SinceBoot
+	}
+	return 0
 }
 
-func (x *CopyRequest) GetRootPath() string {
+func (x *EventsRequest) GetReplayPersisted() bool {
 	if x != nil {
-		return x.RootPath
+		return x.ReplayPersisted
 	}
-	return ""
+	return false
 }
 
-// ListRequest describes a request to list the contents of a directory.
-type ListRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Root indicates the root directory for the list. If not indicated, '/' is
-	// presumed.
-	Root string `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
-	// Recurse indicates that subdirectories should be recursed.
-	Recurse bool `protobuf:"varint,2,opt,name=recurse,proto3" json:"recurse,omitempty"`
-	// RecursionDepth indicates how many levels of subdirectories should be
-	// recursed. The default (0) indicates that no limit should be enforced.
-	RecursionDepth int32 `protobuf:"varint,3,opt,name=recursion_depth,json=recursionDepth,proto3" json:"recursion_depth,omitempty"`
-	// Types indicates what file type should be returned. If not indicated,
-	// all files will be returned.
-	Types []ListRequest_Type `protobuf:"varint,4,rep,packed,name=types,proto3,enum=machine.ListRequest_Type" json:"types,omitempty"`
-	// Report xattrs
-	ReportXattrs  bool `protobuf:"varint,5,opt,name=report_xattrs,json=reportXattrs,proto3" json:"report_xattrs,omitempty"`
+type Event struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Metadata *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Data     *anypb.Any             `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Id       string                 `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	ActorId  string                 `protobuf:"bytes,4,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	// BootID is the boot ID of the machine when the event was published.
+	BootId        string `protobuf:"bytes,5,opt,name=boot_id,json=bootId,proto3" json:"boot_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRequest) Reset() {
-	*x = ListRequest{}
-	mi := &file_machine_machine_proto_msgTypes[46]
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_machine_machine_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRequest) String() string {
+func (x *Event) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRequest) ProtoMessage() {}
+func (*Event) ProtoMessage() {}
 
-func (x *ListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[46]
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3325,78 +3709,3561 @@ func (x *ListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRequest.ProtoReflect.Descriptor instead.
-func (*ListRequest) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *ListRequest) GetRoot() string {
+func (x *Event) GetMetadata() *common.Metadata {
 	if x != nil {
-		return x.Root
+		return x.Metadata
 	}
-	return ""
+	return nil
 }
 
-func (x *ListRequest) GetRecurse() bool {
+func (x *Event) GetData() *anypb.Any {
 	if x != nil {
-		return x.Recurse
+		return x.Data
 	}
-	return false
+	return nil
 }
 
-func (x *ListRequest) GetRecursionDepth() int32 {
+func (x *Event) GetId() string {
 	if x != nil {
-		return x.RecursionDepth
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *ListRequest) GetTypes() []ListRequest_Type {
+func (x *Event) GetActorId() string {
 	if x != nil {
-		return x.Types
+		return x.ActorId
 	}
-	return nil
+	return ""
 }
 
-func (x *ListRequest) GetReportXattrs() bool {
+func (x *Event) GetBootId() string {
 	if x != nil {
-		return x.ReportXattrs
+		return x.BootId
 	}
-	return false
+	return ""
 }
 
-// DiskUsageRequest describes a request to list disk usage of directories and regular files
-type DiskUsageRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// RecursionDepth indicates how many levels of subdirectories should be
-	// recursed. The default (0) indicates that no limit should be enforced.
-	RecursionDepth int32 `protobuf:"varint,1,opt,name=recursion_depth,json=recursionDepth,proto3" json:"recursion_depth,omitempty"`
-	// All write sizes for all files, not just directories.
-	All bool `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"`
-	// Threshold exclude entries smaller than SIZE if positive,
-	// or entries greater than SIZE if negative.
-	Threshold int64 `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// DiskUsagePaths is the list of directories to calculate disk usage for.
-	Paths         []string `protobuf:"bytes,4,rep,name=paths,proto3" json:"paths,omitempty"`
+// rpc reset
+type ResetPartitionSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Wipe          bool                   `protobuf:"varint,2,opt,name=wipe,proto3" json:"wipe,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DiskUsageRequest) Reset() {
-	*x = DiskUsageRequest{}
-	mi := &file_machine_machine_proto_msgTypes[47]
+func (x *ResetPartitionSpec) Reset() {
+	*x = ResetPartitionSpec{}
+	mi := &file_machine_machine_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DiskUsageRequest) String() string {
+func (x *ResetPartitionSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DiskUsageRequest) ProtoMessage() {}
+func (*ResetPartitionSpec) ProtoMessage() {}
+
+func (x *ResetPartitionSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPartitionSpec.ProtoReflect.Descriptor instead.
+func (*ResetPartitionSpec) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ResetPartitionSpec) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ResetPartitionSpec) GetWipe() bool {
+	if x != nil {
+		return x.Wipe
+	}
+	return false
+}
+
+type ResetRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Graceful indicates whether node should leave etcd before the upgrade, it also
+	// enforces etcd checks before leaving.
+	Graceful bool `protobuf:"varint,1,opt,name=graceful,proto3" json:"graceful,omitempty"`
+	// Reboot indicates whether node should reboot or halt after resetting.
+	Reboot bool `protobuf:"varint,2,opt,name=reboot,proto3" json:"reboot,omitempty"`
+	// System_partitions_to_wipe lists specific system disk partitions to be reset (wiped).
+	// If system_partitions_to_wipe is empty, all the partitions are erased.
+	SystemPartitionsToWipe []*ResetPartitionSpec `protobuf:"bytes,3,rep,name=system_partitions_to_wipe,json=systemPartitionsToWipe,proto3" json:"system_partitions_to_wipe,omitempty"`
+	// UserDisksToWipe lists specific connected block devices to be reset (wiped).
+	UserDisksToWipe []string `protobuf:"bytes,4,rep,name=user_disks_to_wipe,json=userDisksToWipe,proto3" json:"user_disks_to_wipe,omitempty"`
+	// WipeMode defines which devices should be wiped.
+	Mode          ResetRequest_WipeMode `protobuf:"varint,5,opt,name=mode,proto3,enum=machine.ResetRequest_WipeMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetRequest) Reset() {
+	*x = ResetRequest{}
+	mi := &file_machine_machine_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetRequest) ProtoMessage() {}
+
+func (x *ResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetRequest.ProtoReflect.Descriptor instead.
+func (*ResetRequest) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ResetRequest) GetGraceful() bool {
+	if x != nil {
+		return x.Graceful
+	}
+	return false
+}
+
+func (x *ResetRequest) GetReboot() bool {
+	if x != nil {
+		return x.Reboot
+	}
+	return false
+}
+
+func (x *ResetRequest) GetSystemPartitionsToWipe() []*ResetPartitionSpec {
+	if x != nil {
+		return x.SystemPartitionsToWipe
+	}
+	return nil
+}
+
+func (x *ResetRequest) GetUserDisksToWipe() []string {
+	if x != nil {
+		return x.UserDisksToWipe
+	}
+	return nil
+}
+
+func (x *ResetRequest) GetMode() ResetRequest_WipeMode {
+	if x != nil {
+		return x.Mode
+	}
+	return ResetRequest_ALL
+}
+
+// The reset message containing the restart status.
+type Reset struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metadata      *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ActorId       string                 `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reset) Reset() {
+	*x = Reset{}
+	mi := &file_machine_machine_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reset) ProtoMessage() {}
+
+func (x *Reset) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reset.ProtoReflect.Descriptor instead.
+func (*Reset) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *Reset) GetMetadata() *common.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Reset) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+type ResetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*Reset               `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetResponse) Reset() {
+	*x = ResetResponse{}
+	mi := &file_machine_machine_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetResponse) ProtoMessage() {}
+
+func (x *ResetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetResponse.ProtoReflect.Descriptor instead.
+func (*ResetResponse) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ResetResponse) GetMessages() []*Reset {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+// rpc resetIdentity
+type ResetIdentityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Confirms that the node identity is going to be regenerated.
+	Confirm       bool `protobuf:"varint,1,opt,name=confirm,proto3" json:"confirm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetIdentityRequest) Reset() {
+	*x = ResetIdentityRequest{}
+	mi := &file_machine_machine_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetIdentityRequest) ProtoMessage() {}
+
+func (x *ResetIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetIdentityRequest.ProtoReflect.Descriptor instead.
+func (*ResetIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ResetIdentityRequest) GetConfirm() bool {
+	if x != nil {
+		return x.Confirm
+	}
+	return false
+}
+
+type ResetIdentity struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Metadata       *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	PreviousNodeId string                 `protobuf:"bytes,2,opt,name=previous_node_id,json=previousNodeId,proto3" json:"previous_node_id,omitempty"`
+	NodeId         string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// KubeSpan public key after the reset, empty if KubeSpan is disabled.
+	KubespanPublicKey string `protobuf:"bytes,4,opt,name=kubespan_public_key,json=kubespanPublicKey,proto3" json:"kubespan_public_key,omitempty"`
+	// Notes describe the effects of the reset which might require an action.
+	Notes         []string `protobuf:"bytes,5,rep,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetIdentity) Reset() {
+	*x = ResetIdentity{}
+	mi := &file_machine_machine_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetIdentity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetIdentity) ProtoMessage() {}
+
+func (x *ResetIdentity) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetIdentity.ProtoReflect.Descriptor instead.
+func (*ResetIdentity) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ResetIdentity) GetMetadata() *common.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *ResetIdentity) GetPreviousNodeId() string {
+	if x != nil {
+		return x.PreviousNodeId
+	}
+	return ""
+}
+
+func (x *ResetIdentity) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *ResetIdentity) GetKubespanPublicKey() string {
+	if x != nil {
+		return x.KubespanPublicKey
+	}
+	return ""
+}
+
+func (x *ResetIdentity) GetNotes() []string {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+type ResetIdentityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*ResetIdentity       `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetIdentityResponse) Reset() {
+	*x = ResetIdentityResponse{}
+	mi := &file_machine_machine_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetIdentityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetIdentityResponse) ProtoMessage() {}
+
+func (x *ResetIdentityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetIdentityResponse.ProtoReflect.Descriptor instead.
+func (*ResetIdentityResponse) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ResetIdentityResponse) GetMessages() []*ResetIdentity {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+// rpc shutdown
+// The messages message containing the shutdown status.
+type Shutdown struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metadata      *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ActorId       string                 `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Shutdown) Reset() {
+	*x = Shutdown{}
+	mi := &file_machine_machine_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Shutdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Shutdown) ProtoMessage() {}
+
+func (x *Shutdown) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Shutdown.ProtoReflect.Descriptor instead.
+func (*Shutdown) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *Shutdown) GetMetadata() *common.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Shutdown) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+type ShutdownRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Force indicates whether node should shutdown without first cordening and draining
+	Force bool `protobuf:"varint,1,opt,name=force,proto3" json:"force,omitempty"`
+	// IgnoreInhibitors indicates whether the shutdown should proceed without waiting for the shutdown inhibitors.
+	IgnoreInhibitors bool `protobuf:"varint,2,opt,name=ignore_inhibitors,json=ignoreInhibitors,proto3" json:"ignore_inhibitors,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ShutdownRequest) Reset() {
+	*x = ShutdownRequest{}
+	mi := &file_machine_machine_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShutdownRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShutdownRequest) ProtoMessage() {}
+
+func (x *ShutdownRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShutdownRequest.ProtoReflect.Descriptor instead.
+func (*ShutdownRequest) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ShutdownRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *ShutdownRequest) GetIgnoreInhibitors() bool {
+	if x != nil {
+		return x.IgnoreInhibitors
+	}
+	return false
+}
+
+type ShutdownResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*Shutdown            `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShutdownResponse) Reset() {
+	*x = ShutdownResponse{}
+	mi := &file_machine_machine_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShutdownResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShutdownResponse) ProtoMessage() {}
+
+func (x *ShutdownResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShutdownResponse.ProtoReflect.Descriptor instead.
+func (*ShutdownResponse) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ShutdownResponse) GetMessages() []*Shutdown {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+// rpc inhibitorAcquire
+type InhibitorAcquireRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Who is the name of the client holding the inhibitor, the number of inhibitors is limited per client.
+	Who string `protobuf:"bytes,1,opt,name=who,proto3" json:"who,omitempty"`
+	// Why is the human-readable reason for the inhibitor.
+	Why string `protobuf:"bytes,2,opt,name=why,proto3" json:"why,omitempty"`
+	// MaxHold is the maximum time the inhibitor holds the shutdown, capped at the machine limit.
+	MaxHold       *durationpb.Duration `protobuf:"bytes,3,opt,name=max_hold,json=maxHold,proto3" json:"max_hold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InhibitorAcquireRequest) Reset() {
+	*x = InhibitorAcquireRequest{}
+	mi := &file_machine_machine_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InhibitorAcquireRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InhibitorAcquireRequest) ProtoMessage() {}
+
+func (x *InhibitorAcquireRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InhibitorAcquireRequest.ProtoReflect.Descriptor instead.
+func (*InhibitorAcquireRequest) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *InhibitorAcquireRequest) GetWho() string {
+	if x != nil {
+		return x.Who
+	}
+	return ""
+}
+
+func (x *InhibitorAcquireRequest) GetWhy() string {
+	if x != nil {
+		return x.Why
+	}
+	return ""
+}
+
+func (x *InhibitorAcquireRequest) GetMaxHold() *durationpb.Duration {
+	if x != nil {
+		return x.MaxHold
+	}
+	return nil
+}
+
+// Inhibitor describes a registered shutdown inhibitor.
+type Inhibitor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Who           string                 `protobuf:"bytes,2,opt,name=who,proto3" json:"who,omitempty"`
+	Why           string                 `protobuf:"bytes,3,opt,name=why,proto3" json:"why,omitempty"`
+	MaxHold       *durationpb.Duration   `protobuf:"bytes,4,opt,name=max_hold,json=maxHold,proto3" json:"max_hold,omitempty"`
+	Acquired      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=acquired,proto3" json:"acquired,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Inhibitor) Reset() {
+	*x = Inhibitor{}
+	mi := &file_machine_machine_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Inhibitor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Inhibitor) ProtoMessage() {}
+
+func (x *Inhibitor) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Inhibitor.ProtoReflect.Descriptor instead.
+func (*Inhibitor) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *Inhibitor) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Inhibitor) GetWho() string {
+	if x != nil {
+		return x.Who
+	}
+	return ""
+}
+
+func (x *Inhibitor) GetWhy() string {
+	if x != nil {
+		return x.Why
+	}
+	return ""
+}
+
+func (x *Inhibitor) GetMaxHold() *durationpb.Duration {
+	if x != nil {
+		return x.MaxHold
+	}
+	return nil
+}
+
+func (x *Inhibitor) GetAcquired() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Acquired
+	}
+	return nil
+}
+
+type InhibitorAcquire struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metadata      *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Inhibitor     *Inhibitor             `protobuf:"bytes,2,opt,name=inhibitor,proto3" json:"inhibitor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InhibitorAcquire) Reset() {
+	*x = InhibitorAcquire{}
+	mi := &file_machine_machine_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InhibitorAcquire) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InhibitorAcquire) ProtoMessage() {}
+
+func (x *InhibitorAcquire) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InhibitorAcquire.ProtoReflect.Descriptor instead.
+func (*InhibitorAcquire) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *InhibitorAcquire) GetMetadata() *common.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *InhibitorAcquire) GetInhibitor() *Inhibitor {
+	if x != nil {
+		return x.Inhibitor
+	}
+	return nil
+}
+
+type InhibitorAcquireResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*InhibitorAcquire    `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InhibitorAcquireResponse) Reset() {
+	*x = InhibitorAcquireResponse{}
+	mi := &file_machine_machine_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InhibitorAcquireResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InhibitorAcquireResponse) ProtoMessage() {}
+
+func (x *InhibitorAcquireResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InhibitorAcquireResponse.ProtoReflect.Descriptor instead.
+func (*InhibitorAcquireResponse) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *InhibitorAcquireResponse) GetMessages() []*InhibitorAcquire {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+// rpc inhibitorList
+type InhibitorList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metadata      *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Inhibitors    []*Inhibitor           `protobuf:"bytes,2,rep,name=inhibitors,proto3" json:"inhibitors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InhibitorList) Reset() {
+	*x = InhibitorList{}
+	mi := &file_machine_machine_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InhibitorList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InhibitorList) ProtoMessage() {}
+
+func (x *InhibitorList) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InhibitorList.ProtoReflect.Descriptor instead.
+func (*InhibitorList) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *InhibitorList) GetMetadata() *common.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *InhibitorList) GetInhibitors() []*Inhibitor {
+	if x != nil {
+		return x.Inhibitors
+	}
+	return nil
+}
+
+type InhibitorListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*InhibitorList       `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InhibitorListResponse) Reset() {
+	*x = InhibitorListResponse{}
+	mi := &file_machine_machine_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InhibitorListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InhibitorListResponse) ProtoMessage() {}
+
+func (x *InhibitorListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InhibitorListResponse.ProtoReflect.Descriptor instead.
+func (*InhibitorListResponse) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *InhibitorListResponse) GetMessages() []*InhibitorList {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+// rpc inhibitorRelease
+type InhibitorReleaseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InhibitorReleaseRequest) Reset() {
+	*x = InhibitorReleaseRequest{}
+	mi := &file_machine_machine_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InhibitorReleaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InhibitorReleaseRequest) ProtoMessage() {}
+
+func (x *InhibitorReleaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InhibitorReleaseRequest.ProtoReflect.Descriptor instead.
+func (*InhibitorReleaseRequest) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *InhibitorReleaseRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type InhibitorRelease struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metadata      *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InhibitorRelease) Reset() {
+	*x = InhibitorRelease{}
+	mi := &file_machine_machine_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InhibitorRelease) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InhibitorRelease) ProtoMessage() {}
+
+func (x *InhibitorRelease) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InhibitorRelease.ProtoReflect.Descriptor instead.
+func (*InhibitorRelease) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *InhibitorRelease) GetMetadata() *common.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type InhibitorReleaseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*InhibitorRelease    `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InhibitorReleaseResponse) Reset() {
+	*x = InhibitorReleaseResponse{}
+	mi := &file_machine_machine_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InhibitorReleaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InhibitorReleaseResponse) ProtoMessage() {}
+
+func (x *InhibitorReleaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InhibitorReleaseResponse.ProtoReflect.Descriptor instead.
+func (*InhibitorReleaseResponse) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *InhibitorReleaseResponse) GetMessages() []*InhibitorRelease {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+// rpc joinTokenCreate
+type JoinTokenCreateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Token in the format of the machine configuration token, e.g. `abcdef.0123456789abcdef`.
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	// Expiration time of the token, the token never expires if not set.
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinTokenCreateRequest) Reset() {
+	*x = JoinTokenCreateRequest{}
+	mi := &file_machine_machine_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinTokenCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinTokenCreateRequest) ProtoMessage() {}
+
+func (x *JoinTokenCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinTokenCreateRequest.ProtoReflect.Descriptor instead.
+func (*JoinTokenCreateRequest) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *JoinTokenCreateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *JoinTokenCreateRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *JoinTokenCreateRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type JoinTokenCreate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metadata      *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinTokenCreate) Reset() {
+	*x = JoinTokenCreate{}
+	mi := &file_machine_machine_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinTokenCreate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinTokenCreate) ProtoMessage() {}
+
+func (x *JoinTokenCreate) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinTokenCreate.ProtoReflect.Descriptor instead.
+func (*JoinTokenCreate) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *JoinTokenCreate) GetMetadata() *common.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type JoinTokenCreateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*JoinTokenCreate     `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinTokenCreateResponse) Reset() {
+	*x = JoinTokenCreateResponse{}
+	mi := &file_machine_machine_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinTokenCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinTokenCreateResponse) ProtoMessage() {}
+
+func (x *JoinTokenCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinTokenCreateResponse.ProtoReflect.Descriptor instead.
+func (*JoinTokenCreateResponse) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *JoinTokenCreateResponse) GetMessages() []*JoinTokenCreate {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+// rpc joinTokenList
+type JoinTokenInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Expired       bool                   `protobuf:"varint,4,opt,name=expired,proto3" json:"expired,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinTokenInfo) Reset() {
+	*x = JoinTokenInfo{}
+	mi := &file_machine_machine_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinTokenInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinTokenInfo) ProtoMessage() {}
+
+func (x *JoinTokenInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinTokenInfo.ProtoReflect.Descriptor instead.
+func (*JoinTokenInfo) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *JoinTokenInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *JoinTokenInfo) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *JoinTokenInfo) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *JoinTokenInfo) GetExpired() bool {
+	if x != nil {
+		return x.Expired
+	}
+	return false
+}
+
+type JoinTokenList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metadata      *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Tokens        []*JoinTokenInfo       `protobuf:"bytes,2,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinTokenList) Reset() {
+	*x = JoinTokenList{}
+	mi := &file_machine_machine_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinTokenList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinTokenList) ProtoMessage() {}
+
+func (x *JoinTokenList) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinTokenList.ProtoReflect.Descriptor instead.
+func (*JoinTokenList) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *JoinTokenList) GetMetadata() *common.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *JoinTokenList) GetTokens() []*JoinTokenInfo {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+type JoinTokenListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*JoinTokenList       `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinTokenListResponse) Reset() {
+	*x = JoinTokenListResponse{}
+	mi := &file_machine_machine_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinTokenListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinTokenListResponse) ProtoMessage() {}
+
+func (x *JoinTokenListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinTokenListResponse.ProtoReflect.Descriptor instead.
+func (*JoinTokenListResponse) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *JoinTokenListResponse) GetMessages() []*JoinTokenList {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+// rpc joinTokenRevoke
+type JoinTokenRevokeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinTokenRevokeRequest) Reset() {
+	*x = JoinTokenRevokeRequest{}
+	mi := &file_machine_machine_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinTokenRevokeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinTokenRevokeRequest) ProtoMessage() {}
+
+func (x *JoinTokenRevokeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinTokenRevokeRequest.ProtoReflect.Descriptor instead.
+func (*JoinTokenRevokeRequest) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *JoinTokenRevokeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type JoinTokenRevoke struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metadata      *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinTokenRevoke) Reset() {
+	*x = JoinTokenRevoke{}
+	mi := &file_machine_machine_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinTokenRevoke) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinTokenRevoke) ProtoMessage() {}
+
+func (x *JoinTokenRevoke) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinTokenRevoke.ProtoReflect.Descriptor instead.
+func (*JoinTokenRevoke) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *JoinTokenRevoke) GetMetadata() *common.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type JoinTokenRevokeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*JoinTokenRevoke     `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinTokenRevokeResponse) Reset() {
+	*x = JoinTokenRevokeResponse{}
+	mi := &file_machine_machine_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinTokenRevokeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinTokenRevokeResponse) ProtoMessage() {}
+
+func (x *JoinTokenRevokeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinTokenRevokeResponse.ProtoReflect.Descriptor instead.
+func (*JoinTokenRevokeResponse) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *JoinTokenRevokeResponse) GetMessages() []*JoinTokenRevoke {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+// rpc upgrade
+type UpgradeRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Image         string                    `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	Preserve      bool                      `protobuf:"varint,2,opt,name=preserve,proto3" json:"preserve,omitempty"`
+	Stage         bool                      `protobuf:"varint,3,opt,name=stage,proto3" json:"stage,omitempty"`
+	Force         bool                      `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"`
+	RebootMode    UpgradeRequest_RebootMode `protobuf:"varint,5,opt,name=reboot_mode,json=rebootMode,proto3,enum=machine.UpgradeRequest_RebootMode" json:"reboot_mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpgradeRequest) Reset() {
+	*x = UpgradeRequest{}
+	mi := &file_machine_machine_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpgradeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradeRequest) ProtoMessage() {}
+
+func (x *UpgradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpgradeRequest.ProtoReflect.Descriptor instead.
+func (*UpgradeRequest) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *UpgradeRequest) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *UpgradeRequest) GetPreserve() bool {
+	if x != nil {
+		return x.Preserve
+	}
+	return false
+}
+
+func (x *UpgradeRequest) GetStage() bool {
+	if x != nil {
+		return x.Stage
+	}
+	return false
+}
+
+func (x *UpgradeRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *UpgradeRequest) GetRebootMode() UpgradeRequest_RebootMode {
+	if x != nil {
+		return x.RebootMode
+	}
+	return UpgradeRequest_DEFAULT
+}
+
+type Upgrade struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metadata      *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Ack           string                 `protobuf:"bytes,2,opt,name=ack,proto3" json:"ack,omitempty"`
+	ActorId       string                 `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Upgrade) Reset() {
+	*x = Upgrade{}
+	mi := &file_machine_machine_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Upgrade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Upgrade) ProtoMessage() {}
+
+func (x *Upgrade) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Upgrade.ProtoReflect.Descriptor instead.
+func (*Upgrade) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *Upgrade) GetMetadata() *common.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Upgrade) GetAck() string {
+	if x != nil {
+		return x.Ack
+	}
+	return ""
+}
+
+func (x *Upgrade) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+type UpgradeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*Upgrade             `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpgradeResponse) Reset() {
+	*x = UpgradeResponse{}
+	mi := &file_machine_machine_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpgradeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradeResponse) ProtoMessage() {}
+
+func (x *UpgradeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpgradeResponse.ProtoReflect.Descriptor instead.
+func (*UpgradeResponse) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *UpgradeResponse) GetMessages() []*Upgrade {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+// rpc secretsBundleExport
+type SecretsBundleExportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Confirms that the cluster secrets are going to be exported.
+	AllowSecretsExport bool `protobuf:"varint,1,opt,name=allow_secrets_export,json=allowSecretsExport,proto3" json:"allow_secrets_export,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SecretsBundleExportRequest) Reset() {
+	*x = SecretsBundleExportRequest{}
+	mi := &file_machine_machine_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretsBundleExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretsBundleExportRequest) ProtoMessage() {}
+
+func (x *SecretsBundleExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretsBundleExportRequest.ProtoReflect.Descriptor instead.
+func (*SecretsBundleExportRequest) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *SecretsBundleExportRequest) GetAllowSecretsExport() bool {
+	if x != nil {
+		return x.AllowSecretsExport
+	}
+	return false
+}
+
+type SecretsBundleExport struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Metadata *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Secrets bundle in the YAML format of `talosctl gen secrets`.
+	Bundle        []byte `protobuf:"bytes,2,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecretsBundleExport) Reset() {
+	*x = SecretsBundleExport{}
+	mi := &file_machine_machine_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretsBundleExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretsBundleExport) ProtoMessage() {}
+
+func (x *SecretsBundleExport) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretsBundleExport.ProtoReflect.Descriptor instead.
+func (*SecretsBundleExport) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *SecretsBundleExport) GetMetadata() *common.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *SecretsBundleExport) GetBundle() []byte {
+	if x != nil {
+		return x.Bundle
+	}
+	return nil
+}
+
+type SecretsBundleExportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*SecretsBundleExport `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecretsBundleExportResponse) Reset() {
+	*x = SecretsBundleExportResponse{}
+	mi := &file_machine_machine_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretsBundleExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretsBundleExportResponse) ProtoMessage() {}
+
+func (x *SecretsBundleExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretsBundleExportResponse.ProtoReflect.Descriptor instead.
+func (*SecretsBundleExportResponse) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *SecretsBundleExportResponse) GetMessages() []*SecretsBundleExport {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+// rpc servicelist
+type ServiceList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metadata      *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Services      []*ServiceInfo         `protobuf:"bytes,2,rep,name=services,proto3" json:"services,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceList) Reset() {
+	*x = ServiceList{}
+	mi := &file_machine_machine_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceList) ProtoMessage() {}
+
+func (x *ServiceList) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceList.ProtoReflect.Descriptor instead.
+func (*ServiceList) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ServiceList) GetMetadata() *common.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *ServiceList) GetServices() []*ServiceInfo {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+type ServiceListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*ServiceList         `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceListResponse) Reset() {
+	*x = ServiceListResponse{}
+	mi := &file_machine_machine_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceListResponse) ProtoMessage() {}
+
+func (x *ServiceListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceListResponse.ProtoReflect.Descriptor instead.
+func (*ServiceListResponse) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ServiceListResponse) GetMessages() []*ServiceList {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type ServiceInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Events        *ServiceEvents         `protobuf:"bytes,3,opt,name=events,proto3" json:"events,omitempty"`
+	Health        *ServiceHealth         `protobuf:"bytes,4,opt,name=health,proto3" json:"health,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceInfo) Reset() {
+	*x = ServiceInfo{}
+	mi := &file_machine_machine_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceInfo) ProtoMessage() {}
+
+func (x *ServiceInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceInfo.ProtoReflect.Descriptor instead.
+func (*ServiceInfo) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ServiceInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ServiceInfo) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ServiceInfo) GetEvents() *ServiceEvents {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ServiceInfo) GetHealth() *ServiceHealth {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+type ServiceEvents struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*ServiceEvent        `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceEvents) Reset() {
+	*x = ServiceEvents{}
+	mi := &file_machine_machine_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceEvents) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceEvents) ProtoMessage() {}
+
+func (x *ServiceEvents) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceEvents.ProtoReflect.Descriptor instead.
+func (*ServiceEvents) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ServiceEvents) GetEvents() []*ServiceEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type ServiceEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Msg           string                 `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Ts            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=ts,proto3" json:"ts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceEvent) Reset() {
+	*x = ServiceEvent{}
+	mi := &file_machine_machine_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceEvent) ProtoMessage() {}
+
+func (x *ServiceEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceEvent.ProtoReflect.Descriptor instead.
+func (*ServiceEvent) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ServiceEvent) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ServiceEvent) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ServiceEvent) GetTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Ts
+	}
+	return nil
+}
+
+type ServiceHealth struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Unknown       bool                   `protobuf:"varint,1,opt,name=unknown,proto3" json:"unknown,omitempty"`
+	Healthy       bool                   `protobuf:"varint,2,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	LastMessage   string                 `protobuf:"bytes,3,opt,name=last_message,json=lastMessage,proto3" json:"last_message,omitempty"`
+	LastChange    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_change,json=lastChange,proto3" json:"last_change,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceHealth) Reset() {
+	*x = ServiceHealth{}
+	mi := &file_machine_machine_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceHealth) ProtoMessage() {}
+
+func (x *ServiceHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceHealth.ProtoReflect.Descriptor instead.
+func (*ServiceHealth) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ServiceHealth) GetUnknown() bool {
+	if x != nil {
+		return x.Unknown
+	}
+	return false
+}
+
+func (x *ServiceHealth) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+func (x *ServiceHealth) GetLastMessage() string {
+	if x != nil {
+		return x.LastMessage
+	}
+	return ""
+}
+
+func (x *ServiceHealth) GetLastChange() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastChange
+	}
+	return nil
+}
+
+// rpc servicedependencies
+type ServiceDependenciesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the service, all services are returned if empty.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceDependenciesRequest) Reset() {
+	*x = ServiceDependenciesRequest{}
+	mi := &file_machine_machine_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceDependenciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceDependenciesRequest) ProtoMessage() {}
+
+func (x *ServiceDependenciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceDependenciesRequest.ProtoReflect.Descriptor instead.
+func (*ServiceDependenciesRequest) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ServiceDependenciesRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// ServiceCondition is a condition the service waits for before it is started.
+type ServiceCondition struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Kind of the condition: "service", "volume" or "condition".
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Human-readable description of the condition.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// ID of the service or the volume the condition refers to, empty for other conditions.
+	Target string `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	// Whether the condition is satisfied.
+	Satisfied     bool `protobuf:"varint,4,opt,name=satisfied,proto3" json:"satisfied,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceCondition) Reset() {
+	*x = ServiceCondition{}
+	mi := &file_machine_machine_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceCondition) ProtoMessage() {}
+
+func (x *ServiceCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceCondition.ProtoReflect.Descriptor instead.
+func (*ServiceCondition) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ServiceCondition) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ServiceCondition) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ServiceCondition) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *ServiceCondition) GetSatisfied() bool {
+	if x != nil {
+		return x.Satisfied
+	}
+	return false
+}
+
+type ServiceDependencyInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	State string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	// IDs of the services this service depends on.
+	DependsOn []string `protobuf:"bytes,3,rep,name=depends_on,json=dependsOn,proto3" json:"depends_on,omitempty"`
+	// Conditions the service waits for, empty if the service is not started yet.
+	Conditions    []*ServiceCondition `protobuf:"bytes,4,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceDependencyInfo) Reset() {
+	*x = ServiceDependencyInfo{}
+	mi := &file_machine_machine_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceDependencyInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceDependencyInfo) ProtoMessage() {}
+
+func (x *ServiceDependencyInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceDependencyInfo.ProtoReflect.Descriptor instead.
+func (*ServiceDependencyInfo) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ServiceDependencyInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ServiceDependencyInfo) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ServiceDependencyInfo) GetDependsOn() []string {
+	if x != nil {
+		return x.DependsOn
+	}
+	return nil
+}
+
+func (x *ServiceDependencyInfo) GetConditions() []*ServiceCondition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+type ServiceDependencies struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Metadata      *common.Metadata         `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Services      []*ServiceDependencyInfo `protobuf:"bytes,2,rep,name=services,proto3" json:"services,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceDependencies) Reset() {
+	*x = ServiceDependencies{}
+	mi := &file_machine_machine_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceDependencies) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceDependencies) ProtoMessage() {}
+
+func (x *ServiceDependencies) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceDependencies.ProtoReflect.Descriptor instead.
+func (*ServiceDependencies) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ServiceDependencies) GetMetadata() *common.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *ServiceDependencies) GetServices() []*ServiceDependencyInfo {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+type ServiceDependenciesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*ServiceDependencies `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceDependenciesResponse) Reset() {
+	*x = ServiceDependenciesResponse{}
+	mi := &file_machine_machine_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceDependenciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceDependenciesResponse) ProtoMessage() {}
+
+func (x *ServiceDependenciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceDependenciesResponse.ProtoReflect.Descriptor instead.
+func (*ServiceDependenciesResponse) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ServiceDependenciesResponse) GetMessages() []*ServiceDependencies {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+// rpc servicestart
+type ServiceStartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceStartRequest) Reset() {
+	*x = ServiceStartRequest{}
+	mi := &file_machine_machine_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceStartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceStartRequest) ProtoMessage() {}
+
+func (x *ServiceStartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceStartRequest.ProtoReflect.Descriptor instead.
+func (*ServiceStartRequest) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ServiceStartRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ServiceStart struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metadata      *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Resp          string                 `protobuf:"bytes,2,opt,name=resp,proto3" json:"resp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceStart) Reset() {
+	*x = ServiceStart{}
+	mi := &file_machine_machine_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceStart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceStart) ProtoMessage() {}
+
+func (x *ServiceStart) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceStart.ProtoReflect.Descriptor instead.
+func (*ServiceStart) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ServiceStart) GetMetadata() *common.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *ServiceStart) GetResp() string {
+	if x != nil {
+		return x.Resp
+	}
+	return ""
+}
+
+type ServiceStartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*ServiceStart        `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceStartResponse) Reset() {
+	*x = ServiceStartResponse{}
+	mi := &file_machine_machine_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceStartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceStartResponse) ProtoMessage() {}
+
+func (x *ServiceStartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceStartResponse.ProtoReflect.Descriptor instead.
+func (*ServiceStartResponse) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ServiceStartResponse) GetMessages() []*ServiceStart {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type ServiceStopRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceStopRequest) Reset() {
+	*x = ServiceStopRequest{}
+	mi := &file_machine_machine_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceStopRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceStopRequest) ProtoMessage() {}
+
+func (x *ServiceStopRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceStopRequest.ProtoReflect.Descriptor instead.
+func (*ServiceStopRequest) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ServiceStopRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ServiceStop struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metadata      *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Resp          string                 `protobuf:"bytes,2,opt,name=resp,proto3" json:"resp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceStop) Reset() {
+	*x = ServiceStop{}
+	mi := &file_machine_machine_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceStop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceStop) ProtoMessage() {}
+
+func (x *ServiceStop) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceStop.ProtoReflect.Descriptor instead.
+func (*ServiceStop) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ServiceStop) GetMetadata() *common.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *ServiceStop) GetResp() string {
+	if x != nil {
+		return x.Resp
+	}
+	return ""
+}
+
+type ServiceStopResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*ServiceStop         `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceStopResponse) Reset() {
+	*x = ServiceStopResponse{}
+	mi := &file_machine_machine_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceStopResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceStopResponse) ProtoMessage() {}
+
+func (x *ServiceStopResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceStopResponse.ProtoReflect.Descriptor instead.
+func (*ServiceStopResponse) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ServiceStopResponse) GetMessages() []*ServiceStop {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type ServiceRestartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceRestartRequest) Reset() {
+	*x = ServiceRestartRequest{}
+	mi := &file_machine_machine_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceRestartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceRestartRequest) ProtoMessage() {}
+
+func (x *ServiceRestartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceRestartRequest.ProtoReflect.Descriptor instead.
+func (*ServiceRestartRequest) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ServiceRestartRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ServiceRestart struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metadata      *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Resp          string                 `protobuf:"bytes,2,opt,name=resp,proto3" json:"resp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceRestart) Reset() {
+	*x = ServiceRestart{}
+	mi := &file_machine_machine_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceRestart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceRestart) ProtoMessage() {}
+
+func (x *ServiceRestart) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceRestart.ProtoReflect.Descriptor instead.
+func (*ServiceRestart) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ServiceRestart) GetMetadata() *common.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *ServiceRestart) GetResp() string {
+	if x != nil {
+		return x.Resp
+	}
+	return ""
+}
+
+type ServiceRestartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*ServiceRestart      `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceRestartResponse) Reset() {
+	*x = ServiceRestartResponse{}
+	mi := &file_machine_machine_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceRestartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceRestartResponse) ProtoMessage() {}
+
+func (x *ServiceRestartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceRestartResponse.ProtoReflect.Descriptor instead.
+func (*ServiceRestartResponse) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ServiceRestartResponse) GetMessages() []*ServiceRestart {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+// CopyRequest describes a request to copy data out of Talos node
+//
+// Copy produces .tar.gz archive which is streamed back to the caller
+type CopyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Root path to start copying data out, it might be either a file or directory
+	RootPath      string `protobuf:"bytes,1,opt,name=root_path,json=rootPath,proto3" json:"root_path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CopyRequest) Reset() {
+	*x = CopyRequest{}
+	mi := &file_machine_machine_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CopyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyRequest) ProtoMessage() {}
+
+func (x *CopyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyRequest.ProtoReflect.Descriptor instead.
+func (*CopyRequest) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *CopyRequest) GetRootPath() string {
+	if x != nil {
+		return x.RootPath
+	}
+	return ""
+}
+
+// ListRequest describes a request to list the contents of a directory.
+type ListRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Root indicates the root directory for the list. If not indicated, '/' is
+	// presumed.
+	Root string `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	// Recurse indicates that subdirectories should be recursed.
+	Recurse bool `protobuf:"varint,2,opt,name=recurse,proto3" json:"recurse,omitempty"`
+	// RecursionDepth indicates how many levels of subdirectories should be
+	// recursed. The default (0) indicates that no limit should be enforced.
+	RecursionDepth int32 `protobuf:"varint,3,opt,name=recursion_depth,json=recursionDepth,proto3" json:"recursion_depth,omitempty"`
+	// Types indicates what file type should be returned. If not indicated,
+	// all files will be returned.
+	Types []ListRequest_Type `protobuf:"varint,4,rep,packed,name=types,proto3,enum=machine.ListRequest_Type" json:"types,omitempty"`
+	// Report xattrs
+	ReportXattrs  bool `protobuf:"varint,5,opt,name=report_xattrs,json=reportXattrs,proto3" json:"report_xattrs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRequest) Reset() {
+	*x = ListRequest{}
+	mi := &file_machine_machine_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRequest) ProtoMessage() {}
+
+func (x *ListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRequest.ProtoReflect.Descriptor instead.
+func (*ListRequest) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ListRequest) GetRoot() string {
+	if x != nil {
+		return x.Root
+	}
+	return ""
+}
+
+func (x *ListRequest) GetRecurse() bool {
+	if x != nil {
+		return x.Recurse
+	}
+	return false
+}
+
+func (x *ListRequest) GetRecursionDepth() int32 {
+	if x != nil {
+		return x.RecursionDepth
+	}
+	return 0
+}
+
+func (x *ListRequest) GetTypes() []ListRequest_Type {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *ListRequest) GetReportXattrs() bool {
+	if x != nil {
+		return x.ReportXattrs
+	}
+	return false
+}
+
+// DiskUsageRequest describes a request to list disk usage of directories and regular files
+type DiskUsageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// RecursionDepth indicates how many levels of subdirectories should be
+	// recursed. The default (0) indicates that no limit should be enforced.
+	RecursionDepth int32 `protobuf:"varint,1,opt,name=recursion_depth,json=recursionDepth,proto3" json:"recursion_depth,omitempty"`
+	// All write sizes for all files, not just directories.
+	All bool `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"`
+	// Threshold exclude entries smaller than SIZE if positive,
+	// or entries greater than SIZE if negative.
+	Threshold int64 `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// DiskUsagePaths is the list of directories to calculate disk usage for.
+	Paths         []string `protobuf:"bytes,4,rep,name=paths,proto3" json:"paths,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiskUsageRequest) Reset() {
+	*x = DiskUsageRequest{}
+	mi := &file_machine_machine_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiskUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskUsageRequest) ProtoMessage() {}
+
+func (x *DiskUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskUsageRequest.ProtoReflect.Descriptor instead.
+func (*DiskUsageRequest) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *DiskUsageRequest) GetRecursionDepth() int32 {
+	if x != nil {
+		return x.RecursionDepth
+	}
+	return 0
+}
+
+func (x *DiskUsageRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+func (x *DiskUsageRequest) GetThreshold() int64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *DiskUsageRequest) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+// FileInfo describes a file or directory's information
+type FileInfo struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Metadata *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Name is the name (including prefixed path) of the file or directory
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Size indicates the number of bytes contained within the file
+	Size int64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// Mode is the bitmap of UNIX mode/permission flags of the file
+	Mode uint32 `protobuf:"varint,4,opt,name=mode,proto3" json:"mode,omitempty"`
+	// Modified indicates the UNIX timestamp at which the file was last modified
+	Modified int64 `protobuf:"varint,5,opt,name=modified,proto3" json:"modified,omitempty"`
+	// IsDir indicates that the file is a directory
+	IsDir bool `protobuf:"varint,6,opt,name=is_dir,json=isDir,proto3" json:"is_dir,omitempty"`
+	// Error describes any error encountered while trying to read the file
+	// information.
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	// Link is filled with symlink target
+	Link string `protobuf:"bytes,8,opt,name=link,proto3" json:"link,omitempty"`
+	// RelativeName is the name of the file or directory relative to the RootPath
+	RelativeName string `protobuf:"bytes,9,opt,name=relative_name,json=relativeName,proto3" json:"relative_name,omitempty"`
+	// Owner uid
+	Uid uint32 `protobuf:"varint,10,opt,name=uid,proto3" json:"uid,omitempty"`
+	// Owner gid
+	Gid uint32 `protobuf:"varint,11,opt,name=gid,proto3" json:"gid,omitempty"`
+	// Extended attributes (if present and requested)
+	Xattrs        []*Xattr `protobuf:"bytes,12,rep,name=xattrs,proto3" json:"xattrs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileInfo) Reset() {
+	*x = FileInfo{}
+	mi := &file_machine_machine_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileInfo) ProtoMessage() {}
+
+func (x *FileInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileInfo.ProtoReflect.Descriptor instead.
+func (*FileInfo) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *FileInfo) GetMetadata() *common.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *FileInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FileInfo) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *FileInfo) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+func (x *FileInfo) GetModified() int64 {
+	if x != nil {
+		return x.Modified
+	}
+	return 0
+}
+
+func (x *FileInfo) GetIsDir() bool {
+	if x != nil {
+		return x.IsDir
+	}
+	return false
+}
+
+func (x *FileInfo) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *FileInfo) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *FileInfo) GetRelativeName() string {
+	if x != nil {
+		return x.RelativeName
+	}
+	return ""
+}
+
+func (x *FileInfo) GetUid() uint32 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *FileInfo) GetGid() uint32 {
+	if x != nil {
+		return x.Gid
+	}
+	return 0
+}
+
+func (x *FileInfo) GetXattrs() []*Xattr {
+	if x != nil {
+		return x.Xattrs
+	}
+	return nil
+}
+
+type Xattr struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Xattr) Reset() {
+	*x = Xattr{}
+	mi := &file_machine_machine_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Xattr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Xattr) ProtoMessage() {}
+
+func (x *Xattr) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Xattr.ProtoReflect.Descriptor instead.
+func (*Xattr) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *Xattr) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Xattr) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// DiskUsageInfo describes a file or directory's information for du command
+type DiskUsageInfo struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Metadata *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Name is the name (including prefixed path) of the file or directory
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Size indicates the number of bytes contained within the file
+	Size int64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// Error describes any error encountered while trying to read the file
+	// information.
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// RelativeName is the name of the file or directory relative to the RootPath
+	RelativeName  string `protobuf:"bytes,5,opt,name=relative_name,json=relativeName,proto3" json:"relative_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiskUsageInfo) Reset() {
+	*x = DiskUsageInfo{}
+	mi := &file_machine_machine_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiskUsageInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskUsageInfo) ProtoMessage() {}
+
+func (x *DiskUsageInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskUsageInfo.ProtoReflect.Descriptor instead.
+func (*DiskUsageInfo) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *DiskUsageInfo) GetMetadata() *common.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *DiskUsageInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DiskUsageInfo) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *DiskUsageInfo) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DiskUsageInfo) GetRelativeName() string {
+	if x != nil {
+		return x.RelativeName
+	}
+	return ""
+}
+
+// The messages message containing the requested df stats.
+type Mounts struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metadata      *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Stats         []*MountStat           `protobuf:"bytes,2,rep,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Mounts) Reset() {
+	*x = Mounts{}
+	mi := &file_machine_machine_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Mounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mounts) ProtoMessage() {}
+
+func (x *Mounts) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mounts.ProtoReflect.Descriptor instead.
+func (*Mounts) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *Mounts) GetMetadata() *common.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Mounts) GetStats() []*MountStat {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type MountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*Mounts              `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MountsResponse) Reset() {
+	*x = MountsResponse{}
+	mi := &file_machine_machine_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MountsResponse) ProtoMessage() {}
+
+func (x *MountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MountsResponse.ProtoReflect.Descriptor instead.
+func (*MountsResponse) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *MountsResponse) GetMessages() []*Mounts {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+// The messages message containing the requested processes.
+type MountStat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filesystem    string                 `protobuf:"bytes,1,opt,name=filesystem,proto3" json:"filesystem,omitempty"`
+	Size          uint64                 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Available     uint64                 `protobuf:"varint,3,opt,name=available,proto3" json:"available,omitempty"`
+	MountedOn     string                 `protobuf:"bytes,4,opt,name=mounted_on,json=mountedOn,proto3" json:"mounted_on,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MountStat) Reset() {
+	*x = MountStat{}
+	mi := &file_machine_machine_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MountStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MountStat) ProtoMessage() {}
+
+func (x *MountStat) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MountStat.ProtoReflect.Descriptor instead.
+func (*MountStat) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *MountStat) GetFilesystem() string {
+	if x != nil {
+		return x.Filesystem
+	}
+	return ""
+}
+
+func (x *MountStat) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *MountStat) GetAvailable() uint64 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+func (x *MountStat) GetMountedOn() string {
+	if x != nil {
+		return x.MountedOn
+	}
+	return ""
+}
+
+type Version struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Metadata *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Version  *VersionInfo           `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Platform *PlatformInfo          `protobuf:"bytes,3,opt,name=platform,proto3" json:"platform,omitempty"`
+	// Features describe individual Talos features that can be switched on or off.
+	Features      *FeaturesInfo `protobuf:"bytes,4,opt,name=features,proto3" json:"features,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Version) Reset() {
+	*x = Version{}
+	mi := &file_machine_machine_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-func (x *DiskUsageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[47]
+func (x *Version) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Version) ProtoMessage() {}
+
+func (x *Version) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3407,85 +7274,61 @@ func (x *DiskUsageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DiskUsageRequest.ProtoReflect.Descriptor instead.
-func (*DiskUsageRequest) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use Version.ProtoReflect.Descriptor instead.
+func (*Version) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *DiskUsageRequest) GetRecursionDepth() int32 {
+func (x *Version) GetMetadata() *common.Metadata {
 	if x != nil {
-		return x.RecursionDepth
+		return x.Metadata
 	}
-	return 0
+	return nil
 }
 
-func (x *DiskUsageRequest) GetAll() bool {
+func (x *Version) GetVersion() *VersionInfo {
 	if x != nil {
-		return x.All
+		return x.Version
 	}
-	return false
+	return nil
 }
 
-func (x *DiskUsageRequest) GetThreshold() int64 {
+func (x *Version) GetPlatform() *PlatformInfo {
 	if x != nil {
-		return x.Threshold
+		return x.Platform
 	}
-	return 0
+	return nil
 }
 
-func (x *DiskUsageRequest) GetPaths() []string {
+func (x *Version) GetFeatures() *FeaturesInfo {
 	if x != nil {
-		return x.Paths
+		return x.Features
 	}
 	return nil
 }
 
-// FileInfo describes a file or directory's information
-type FileInfo struct {
-	state    protoimpl.MessageState `protogen:"open.v1"`
-	Metadata *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	// Name is the name (including prefixed path) of the file or directory
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Size indicates the number of bytes contained within the file
-	Size int64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
-	// Mode is the bitmap of UNIX mode/permission flags of the file
-	Mode uint32 `protobuf:"varint,4,opt,name=mode,proto3" json:"mode,omitempty"`
-	// Modified indicates the UNIX timestamp at which the file was last modified
-	Modified int64 `protobuf:"varint,5,opt,name=modified,proto3" json:"modified,omitempty"`
-	// IsDir indicates that the file is a directory
-	IsDir bool `protobuf:"varint,6,opt,name=is_dir,json=isDir,proto3" json:"is_dir,omitempty"`
-	// Error describes any error encountered while trying to read the file
-	// information.
-	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
-	// Link is filled with symlink target
-	Link string `protobuf:"bytes,8,opt,name=link,proto3" json:"link,omitempty"`
-	// RelativeName is the name of the file or directory relative to the RootPath
-	RelativeName string `protobuf:"bytes,9,opt,name=relative_name,json=relativeName,proto3" json:"relative_name,omitempty"`
-	// Owner uid
-	Uid uint32 `protobuf:"varint,10,opt,name=uid,proto3" json:"uid,omitempty"`
-	// Owner gid
-	Gid uint32 `protobuf:"varint,11,opt,name=gid,proto3" json:"gid,omitempty"`
-	// Extended attributes (if present and requested)
-	Xattrs        []*Xattr `protobuf:"bytes,12,rep,name=xattrs,proto3" json:"xattrs,omitempty"`
+type VersionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*Version             `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FileInfo) Reset() {
-	*x = FileInfo{}
-	mi := &file_machine_machine_proto_msgTypes[48]
+func (x *VersionResponse) Reset() {
+	*x = VersionResponse{}
+	mi := &file_machine_machine_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FileInfo) String() string {
+func (x *VersionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FileInfo) ProtoMessage() {}
+func (*VersionResponse) ProtoMessage() {}
 
-func (x *FileInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[48]
+func (x *VersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3496,118 +7339,125 @@ func (x *FileInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FileInfo.ProtoReflect.Descriptor instead.
-func (*FileInfo) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use VersionResponse.ProtoReflect.Descriptor instead.
+func (*VersionResponse) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *FileInfo) GetMetadata() *common.Metadata {
+func (x *VersionResponse) GetMessages() []*Version {
 	if x != nil {
-		return x.Metadata
+		return x.Messages
 	}
 	return nil
 }
 
-func (x *FileInfo) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+type VersionInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Sha           string                 `protobuf:"bytes,2,opt,name=sha,proto3" json:"sha,omitempty"`
+	Built         string                 `protobuf:"bytes,3,opt,name=built,proto3" json:"built,omitempty"`
+	GoVersion     string                 `protobuf:"bytes,4,opt,name=go_version,json=goVersion,proto3" json:"go_version,omitempty"`
+	Os            string                 `protobuf:"bytes,5,opt,name=os,proto3" json:"os,omitempty"`
+	Arch          string                 `protobuf:"bytes,6,opt,name=arch,proto3" json:"arch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FileInfo) GetSize() int64 {
-	if x != nil {
-		return x.Size
-	}
-	return 0
+func (x *VersionInfo) Reset() {
+	*x = VersionInfo{}
+	mi := &file_machine_machine_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *FileInfo) GetMode() uint32 {
-	if x != nil {
-		return x.Mode
-	}
-	return 0
+func (x *VersionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *FileInfo) GetModified() int64 {
+func (*VersionInfo) ProtoMessage() {}
+
+func (x *VersionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[109]
 	if x != nil {
-		return x.Modified
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *FileInfo) GetIsDir() bool {
-	if x != nil {
-		return x.IsDir
-	}
-	return false
+// Deprecated: Use VersionInfo.ProtoReflect.Descriptor instead.
+func (*VersionInfo) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *FileInfo) GetError() string {
+func (x *VersionInfo) GetTag() string {
 	if x != nil {
-		return x.Error
+		return x.Tag
 	}
 	return ""
 }
 
-func (x *FileInfo) GetLink() string {
+func (x *VersionInfo) GetSha() string {
 	if x != nil {
-		return x.Link
+		return x.Sha
 	}
 	return ""
 }
 
-func (x *FileInfo) GetRelativeName() string {
+func (x *VersionInfo) GetBuilt() string {
 	if x != nil {
-		return x.RelativeName
+		return x.Built
 	}
 	return ""
 }
 
-func (x *FileInfo) GetUid() uint32 {
+func (x *VersionInfo) GetGoVersion() string {
 	if x != nil {
-		return x.Uid
+		return x.GoVersion
 	}
-	return 0
+	return ""
 }
 
-func (x *FileInfo) GetGid() uint32 {
+func (x *VersionInfo) GetOs() string {
 	if x != nil {
-		return x.Gid
+		return x.Os
 	}
-	return 0
+	return ""
 }
 
-func (x *FileInfo) GetXattrs() []*Xattr {
+func (x *VersionInfo) GetArch() string {
 	if x != nil {
-		return x.Xattrs
+		return x.Arch
 	}
-	return nil
+	return ""
 }
 
-type Xattr struct {
+type PlatformInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Mode          string                 `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Xattr) Reset() {
-	*x = Xattr{}
-	mi := &file_machine_machine_proto_msgTypes[49]
+func (x *PlatformInfo) Reset() {
+	*x = PlatformInfo{}
+	mi := &file_machine_machine_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Xattr) String() string {
+func (x *PlatformInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Xattr) ProtoMessage() {}
+func (*PlatformInfo) ProtoMessage() {}
 
-func (x *Xattr) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[49]
+func (x *PlatformInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3618,57 +7468,100 @@ func (x *Xattr) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Xattr.ProtoReflect.Descriptor instead.
-func (*Xattr) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use PlatformInfo.ProtoReflect.Descriptor instead.
+func (*PlatformInfo) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *Xattr) GetName() string {
+func (x *PlatformInfo) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *Xattr) GetData() []byte {
+func (x *PlatformInfo) GetMode() string {
 	if x != nil {
-		return x.Data
+		return x.Mode
 	}
-	return nil
+	return ""
+}
+
+// FeaturesInfo describes individual Talos features that can be switched on or off.
+type FeaturesInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// RBAC is true if role-based access control is enabled.
+	Rbac          bool `protobuf:"varint,1,opt,name=rbac,proto3" json:"rbac,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeaturesInfo) Reset() {
+	*x = FeaturesInfo{}
+	mi := &file_machine_machine_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeaturesInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeaturesInfo) ProtoMessage() {}
+
+func (x *FeaturesInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeaturesInfo.ProtoReflect.Descriptor instead.
+func (*FeaturesInfo) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *FeaturesInfo) GetRbac() bool {
+	if x != nil {
+		return x.Rbac
+	}
+	return false
 }
 
-// DiskUsageInfo describes a file or directory's information for du command
-type DiskUsageInfo struct {
-	state    protoimpl.MessageState `protogen:"open.v1"`
-	Metadata *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	// Name is the name (including prefixed path) of the file or directory
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Size indicates the number of bytes contained within the file
-	Size int64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
-	// Error describes any error encountered while trying to read the file
-	// information.
-	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
-	// RelativeName is the name of the file or directory relative to the RootPath
-	RelativeName  string `protobuf:"bytes,5,opt,name=relative_name,json=relativeName,proto3" json:"relative_name,omitempty"`
+// rpc logs
+// The request message containing the process name.
+type LogsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Id        string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// driver might be default "containerd" or "cri"
+	Driver        common.ContainerDriver `protobuf:"varint,3,opt,name=driver,proto3,enum=common.ContainerDriver" json:"driver,omitempty"`
+	Follow        bool                   `protobuf:"varint,4,opt,name=follow,proto3" json:"follow,omitempty"`
+	TailLines     int32                  `protobuf:"varint,5,opt,name=tail_lines,json=tailLines,proto3" json:"tail_lines,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DiskUsageInfo) Reset() {
-	*x = DiskUsageInfo{}
-	mi := &file_machine_machine_proto_msgTypes[50]
+func (x *LogsRequest) Reset() {
+	*x = LogsRequest{}
+	mi := &file_machine_machine_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DiskUsageInfo) String() string {
+func (x *LogsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DiskUsageInfo) ProtoMessage() {}
+func (*LogsRequest) ProtoMessage() {}
 
-func (x *DiskUsageInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[50]
+func (x *LogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3679,70 +7572,68 @@ func (x *DiskUsageInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DiskUsageInfo.ProtoReflect.Descriptor instead.
-func (*DiskUsageInfo) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use LogsRequest.ProtoReflect.Descriptor instead.
+func (*LogsRequest) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *DiskUsageInfo) GetMetadata() *common.Metadata {
+func (x *LogsRequest) GetNamespace() string {
 	if x != nil {
-		return x.Metadata
+		return x.Namespace
 	}
-	return nil
+	return ""
 }
 
-func (x *DiskUsageInfo) GetName() string {
+func (x *LogsRequest) GetId() string {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
 	return ""
 }
 
-func (x *DiskUsageInfo) GetSize() int64 {
+func (x *LogsRequest) GetDriver() common.ContainerDriver {
 	if x != nil {
-		return x.Size
+		return x.Driver
 	}
-	return 0
+	return common.ContainerDriver(0)
 }
 
-func (x *DiskUsageInfo) GetError() string {
+func (x *LogsRequest) GetFollow() bool {
 	if x != nil {
-		return x.Error
+		return x.Follow
 	}
-	return ""
+	return false
 }
 
-func (x *DiskUsageInfo) GetRelativeName() string {
+func (x *LogsRequest) GetTailLines() int32 {
 	if x != nil {
-		return x.RelativeName
+		return x.TailLines
 	}
-	return ""
+	return 0
 }
 
-// The messages message containing the requested df stats.
-type Mounts struct {
+type ReadRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Metadata      *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Stats         []*MountStat           `protobuf:"bytes,2,rep,name=stats,proto3" json:"stats,omitempty"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Mounts) Reset() {
-	*x = Mounts{}
-	mi := &file_machine_machine_proto_msgTypes[51]
+func (x *ReadRequest) Reset() {
+	*x = ReadRequest{}
+	mi := &file_machine_machine_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Mounts) String() string {
+func (x *ReadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Mounts) ProtoMessage() {}
+func (*ReadRequest) ProtoMessage() {}
 
-func (x *Mounts) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[51]
+func (x *ReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3753,47 +7644,42 @@ func (x *Mounts) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Mounts.ProtoReflect.Descriptor instead.
-func (*Mounts) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{51}
-}
-
-func (x *Mounts) GetMetadata() *common.Metadata {
-	if x != nil {
-		return x.Metadata
-	}
-	return nil
+// Deprecated: Use ReadRequest.ProtoReflect.Descriptor instead.
+func (*ReadRequest) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *Mounts) GetStats() []*MountStat {
+func (x *ReadRequest) GetPath() string {
 	if x != nil {
-		return x.Stats
+		return x.Path
 	}
-	return nil
+	return ""
 }
 
-type MountsResponse struct {
+// LogsContainer desribes all avalaible registered log containers.
+type LogsContainer struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Messages      []*Mounts              `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	Metadata      *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Ids           []string               `protobuf:"bytes,2,rep,name=ids,proto3" json:"ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MountsResponse) Reset() {
-	*x = MountsResponse{}
-	mi := &file_machine_machine_proto_msgTypes[52]
+func (x *LogsContainer) Reset() {
+	*x = LogsContainer{}
+	mi := &file_machine_machine_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MountsResponse) String() string {
+func (x *LogsContainer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MountsResponse) ProtoMessage() {}
+func (*LogsContainer) ProtoMessage() {}
 
-func (x *MountsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[52]
+func (x *LogsContainer) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3804,44 +7690,47 @@ func (x *MountsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MountsResponse.ProtoReflect.Descriptor instead.
-func (*MountsResponse) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use LogsContainer.ProtoReflect.Descriptor instead.
+func (*LogsContainer) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *MountsResponse) GetMessages() []*Mounts {
+func (x *LogsContainer) GetMetadata() *common.Metadata {
 	if x != nil {
-		return x.Messages
+		return x.Metadata
 	}
 	return nil
 }
 
-// The messages message containing the requested processes.
-type MountStat struct {
+func (x *LogsContainer) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type LogsContainersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Filesystem    string                 `protobuf:"bytes,1,opt,name=filesystem,proto3" json:"filesystem,omitempty"`
-	Size          uint64                 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
-	Available     uint64                 `protobuf:"varint,3,opt,name=available,proto3" json:"available,omitempty"`
-	MountedOn     string                 `protobuf:"bytes,4,opt,name=mounted_on,json=mountedOn,proto3" json:"mounted_on,omitempty"`
+	Messages      []*LogsContainer       `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MountStat) Reset() {
-	*x = MountStat{}
-	mi := &file_machine_machine_proto_msgTypes[53]
+func (x *LogsContainersResponse) Reset() {
+	*x = LogsContainersResponse{}
+	mi := &file_machine_machine_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MountStat) String() string {
+func (x *LogsContainersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MountStat) ProtoMessage() {}
+func (*LogsContainersResponse) ProtoMessage() {}
 
-func (x *MountStat) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[53]
+func (x *LogsContainersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3852,65 +7741,40 @@ func (x *MountStat) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MountStat.ProtoReflect.Descriptor instead.
-func (*MountStat) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{53}
-}
-
-func (x *MountStat) GetFilesystem() string {
-	if x != nil {
-		return x.Filesystem
-	}
-	return ""
-}
-
-func (x *MountStat) GetSize() uint64 {
-	if x != nil {
-		return x.Size
-	}
-	return 0
-}
-
-func (x *MountStat) GetAvailable() uint64 {
-	if x != nil {
-		return x.Available
-	}
-	return 0
+// Deprecated: Use LogsContainersResponse.ProtoReflect.Descriptor instead.
+func (*LogsContainersResponse) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *MountStat) GetMountedOn() string {
+func (x *LogsContainersResponse) GetMessages() []*LogsContainer {
 	if x != nil {
-		return x.MountedOn
+		return x.Messages
 	}
-	return ""
+	return nil
 }
 
-type Version struct {
-	state    protoimpl.MessageState `protogen:"open.v1"`
-	Metadata *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Version  *VersionInfo           `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	Platform *PlatformInfo          `protobuf:"bytes,3,opt,name=platform,proto3" json:"platform,omitempty"`
-	// Features describe individual Talos features that can be switched on or off.
-	Features      *FeaturesInfo `protobuf:"bytes,4,opt,name=features,proto3" json:"features,omitempty"`
+// rpc rollback
+type RollbackRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Version) Reset() {
-	*x = Version{}
-	mi := &file_machine_machine_proto_msgTypes[54]
+func (x *RollbackRequest) Reset() {
+	*x = RollbackRequest{}
+	mi := &file_machine_machine_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Version) String() string {
+func (x *RollbackRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Version) ProtoMessage() {}
+func (*RollbackRequest) ProtoMessage() {}
 
-func (x *Version) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[54]
+func (x *RollbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3921,61 +7785,77 @@ func (x *Version) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Version.ProtoReflect.Descriptor instead.
-func (*Version) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use RollbackRequest.ProtoReflect.Descriptor instead.
+func (*RollbackRequest) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *Version) GetMetadata() *common.Metadata {
-	if x != nil {
-		return x.Metadata
-	}
-	return nil
+type Rollback struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metadata      *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Version) GetVersion() *VersionInfo {
-	if x != nil {
-		return x.Version
-	}
-	return nil
+func (x *Rollback) Reset() {
+	*x = Rollback{}
+	mi := &file_machine_machine_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Rollback) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Version) GetPlatform() *PlatformInfo {
+func (*Rollback) ProtoMessage() {}
+
+func (x *Rollback) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[117]
 	if x != nil {
-		return x.Platform
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Version) GetFeatures() *FeaturesInfo {
+// Deprecated: Use Rollback.ProtoReflect.Descriptor instead.
+func (*Rollback) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *Rollback) GetMetadata() *common.Metadata {
 	if x != nil {
-		return x.Features
+		return x.Metadata
 	}
 	return nil
 }
 
-type VersionResponse struct {
+type RollbackResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Messages      []*Version             `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	Messages      []*Rollback            `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VersionResponse) Reset() {
-	*x = VersionResponse{}
-	mi := &file_machine_machine_proto_msgTypes[55]
+func (x *RollbackResponse) Reset() {
+	*x = RollbackResponse{}
+	mi := &file_machine_machine_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VersionResponse) String() string {
+func (x *RollbackResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VersionResponse) ProtoMessage() {}
+func (*RollbackResponse) ProtoMessage() {}
 
-func (x *VersionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[55]
+func (x *RollbackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3986,45 +7866,42 @@ func (x *VersionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VersionResponse.ProtoReflect.Descriptor instead.
-func (*VersionResponse) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use RollbackResponse.ProtoReflect.Descriptor instead.
+func (*RollbackResponse) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *VersionResponse) GetMessages() []*Version {
+func (x *RollbackResponse) GetMessages() []*Rollback {
 	if x != nil {
 		return x.Messages
 	}
 	return nil
 }
 
-type VersionInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
-	Sha           string                 `protobuf:"bytes,2,opt,name=sha,proto3" json:"sha,omitempty"`
-	Built         string                 `protobuf:"bytes,3,opt,name=built,proto3" json:"built,omitempty"`
-	GoVersion     string                 `protobuf:"bytes,4,opt,name=go_version,json=goVersion,proto3" json:"go_version,omitempty"`
-	Os            string                 `protobuf:"bytes,5,opt,name=os,proto3" json:"os,omitempty"`
-	Arch          string                 `protobuf:"bytes,6,opt,name=arch,proto3" json:"arch,omitempty"`
+// rpc rotateCertificates
+type RotateCertificatesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Components to rotate: etcd, apiserver, kubeconfig, kubelet, talos-api.
+	Components    []string `protobuf:"bytes,1,rep,name=components,proto3" json:"components,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VersionInfo) Reset() {
-	*x = VersionInfo{}
-	mi := &file_machine_machine_proto_msgTypes[56]
+func (x *RotateCertificatesRequest) Reset() {
+	*x = RotateCertificatesRequest{}
+	mi := &file_machine_machine_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VersionInfo) String() string {
+func (x *RotateCertificatesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VersionInfo) ProtoMessage() {}
+func (*RotateCertificatesRequest) ProtoMessage() {}
 
-func (x *VersionInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[56]
+func (x *RotateCertificatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4035,76 +7912,93 @@ func (x *VersionInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VersionInfo.ProtoReflect.Descriptor instead.
-func (*VersionInfo) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use RotateCertificatesRequest.ProtoReflect.Descriptor instead.
+func (*RotateCertificatesRequest) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *VersionInfo) GetTag() string {
+func (x *RotateCertificatesRequest) GetComponents() []string {
 	if x != nil {
-		return x.Tag
+		return x.Components
 	}
-	return ""
+	return nil
 }
 
-func (x *VersionInfo) GetSha() string {
-	if x != nil {
-		return x.Sha
-	}
-	return ""
+type RotateCertificates struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Metadata *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Components which were rotated, in the rotation order.
+	Components    []string `protobuf:"bytes,2,rep,name=components,proto3" json:"components,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VersionInfo) GetBuilt() string {
-	if x != nil {
-		return x.Built
-	}
-	return ""
+func (x *RotateCertificates) Reset() {
+	*x = RotateCertificates{}
+	mi := &file_machine_machine_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *VersionInfo) GetGoVersion() string {
+func (x *RotateCertificates) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateCertificates) ProtoMessage() {}
+
+func (x *RotateCertificates) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[120]
 	if x != nil {
-		return x.GoVersion
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *VersionInfo) GetOs() string {
+// Deprecated: Use RotateCertificates.ProtoReflect.Descriptor instead.
+func (*RotateCertificates) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *RotateCertificates) GetMetadata() *common.Metadata {
 	if x != nil {
-		return x.Os
+		return x.Metadata
 	}
-	return ""
+	return nil
 }
 
-func (x *VersionInfo) GetArch() string {
+func (x *RotateCertificates) GetComponents() []string {
 	if x != nil {
-		return x.Arch
+		return x.Components
 	}
-	return ""
+	return nil
 }
 
-type PlatformInfo struct {
+type RotateCertificatesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Mode          string                 `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	Messages      []*RotateCertificates  `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlatformInfo) Reset() {
-	*x = PlatformInfo{}
-	mi := &file_machine_machine_proto_msgTypes[57]
+func (x *RotateCertificatesResponse) Reset() {
+	*x = RotateCertificatesResponse{}
+	mi := &file_machine_machine_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlatformInfo) String() string {
+func (x *RotateCertificatesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlatformInfo) ProtoMessage() {}
+func (*RotateCertificatesResponse) ProtoMessage() {}
 
-func (x *PlatformInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[57]
+func (x *RotateCertificatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4115,49 +8009,44 @@ func (x *PlatformInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlatformInfo.ProtoReflect.Descriptor instead.
-func (*PlatformInfo) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{57}
-}
-
-func (x *PlatformInfo) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use RotateCertificatesResponse.ProtoReflect.Descriptor instead.
+func (*RotateCertificatesResponse) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *PlatformInfo) GetMode() string {
+func (x *RotateCertificatesResponse) GetMessages() []*RotateCertificates {
 	if x != nil {
-		return x.Mode
+		return x.Messages
 	}
-	return ""
+	return nil
 }
 
-// FeaturesInfo describes individual Talos features that can be switched on or off.
-type FeaturesInfo struct {
+// rpc rotateDiskKey
+type RotateDiskKeyRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// RBAC is true if role-based access control is enabled.
-	Rbac          bool `protobuf:"varint,1,opt,name=rbac,proto3" json:"rbac,omitempty"`
+	// Volume ID, e.g. STATE or EPHEMERAL.
+	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	// Key slots to rotate, if empty, all key slots wrapped by a key provider are rotated.
+	Slots         []int64 `protobuf:"varint,2,rep,packed,name=slots,proto3" json:"slots,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FeaturesInfo) Reset() {
-	*x = FeaturesInfo{}
-	mi := &file_machine_machine_proto_msgTypes[58]
+func (x *RotateDiskKeyRequest) Reset() {
+	*x = RotateDiskKeyRequest{}
+	mi := &file_machine_machine_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FeaturesInfo) String() string {
+func (x *RotateDiskKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FeaturesInfo) ProtoMessage() {}
+func (*RotateDiskKeyRequest) ProtoMessage() {}
 
-func (x *FeaturesInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[58]
+func (x *RotateDiskKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4168,47 +8057,49 @@ func (x *FeaturesInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FeaturesInfo.ProtoReflect.Descriptor instead.
-func (*FeaturesInfo) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use RotateDiskKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateDiskKeyRequest) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *FeaturesInfo) GetRbac() bool {
+func (x *RotateDiskKeyRequest) GetVolumeId() string {
 	if x != nil {
-		return x.Rbac
+		return x.VolumeId
 	}
-	return false
+	return ""
 }
 
-// rpc logs
-// The request message containing the process name.
-type LogsRequest struct {
-	state     protoimpl.MessageState `protogen:"open.v1"`
-	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Id        string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	// driver might be default "containerd" or "cri"
-	Driver        common.ContainerDriver `protobuf:"varint,3,opt,name=driver,proto3,enum=common.ContainerDriver" json:"driver,omitempty"`
-	Follow        bool                   `protobuf:"varint,4,opt,name=follow,proto3" json:"follow,omitempty"`
-	TailLines     int32                  `protobuf:"varint,5,opt,name=tail_lines,json=tailLines,proto3" json:"tail_lines,omitempty"`
+func (x *RotateDiskKeyRequest) GetSlots() []int64 {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+type RotateDiskKeySlot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slot          int64                  `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Provider      string                 `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Epoch         int64                  `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LogsRequest) Reset() {
-	*x = LogsRequest{}
-	mi := &file_machine_machine_proto_msgTypes[59]
+func (x *RotateDiskKeySlot) Reset() {
+	*x = RotateDiskKeySlot{}
+	mi := &file_machine_machine_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogsRequest) String() string {
+func (x *RotateDiskKeySlot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogsRequest) ProtoMessage() {}
+func (*RotateDiskKeySlot) ProtoMessage() {}
 
-func (x *LogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[59]
+func (x *RotateDiskKeySlot) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4219,68 +8110,57 @@ func (x *LogsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogsRequest.ProtoReflect.Descriptor instead.
-func (*LogsRequest) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use RotateDiskKeySlot.ProtoReflect.Descriptor instead.
+func (*RotateDiskKeySlot) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{123}
 }
 
-func (x *LogsRequest) GetNamespace() string {
+func (x *RotateDiskKeySlot) GetSlot() int64 {
 	if x != nil {
-		return x.Namespace
+		return x.Slot
 	}
-	return ""
+	return 0
 }
 
-func (x *LogsRequest) GetId() string {
+func (x *RotateDiskKeySlot) GetProvider() string {
 	if x != nil {
-		return x.Id
+		return x.Provider
 	}
 	return ""
 }
 
-func (x *LogsRequest) GetDriver() common.ContainerDriver {
-	if x != nil {
-		return x.Driver
-	}
-	return common.ContainerDriver(0)
-}
-
-func (x *LogsRequest) GetFollow() bool {
-	if x != nil {
-		return x.Follow
-	}
-	return false
-}
-
-func (x *LogsRequest) GetTailLines() int32 {
+func (x *RotateDiskKeySlot) GetEpoch() int64 {
 	if x != nil {
-		return x.TailLines
+		return x.Epoch
 	}
 	return 0
 }
 
-type ReadRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+type RotateDiskKey struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Metadata *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	VolumeId string                 `protobuf:"bytes,2,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	// Key slots of the volume after the rotation.
+	Slots         []*RotateDiskKeySlot `protobuf:"bytes,3,rep,name=slots,proto3" json:"slots,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReadRequest) Reset() {
-	*x = ReadRequest{}
-	mi := &file_machine_machine_proto_msgTypes[60]
+func (x *RotateDiskKey) Reset() {
+	*x = RotateDiskKey{}
+	mi := &file_machine_machine_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReadRequest) String() string {
+func (x *RotateDiskKey) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadRequest) ProtoMessage() {}
+func (*RotateDiskKey) ProtoMessage() {}
 
-func (x *ReadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[60]
+func (x *RotateDiskKey) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4291,42 +8171,54 @@ func (x *ReadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadRequest.ProtoReflect.Descriptor instead.
-func (*ReadRequest) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use RotateDiskKey.ProtoReflect.Descriptor instead.
+func (*RotateDiskKey) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{124}
 }
 
-func (x *ReadRequest) GetPath() string {
+func (x *RotateDiskKey) GetMetadata() *common.Metadata {
 	if x != nil {
-		return x.Path
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *RotateDiskKey) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
 	}
 	return ""
 }
 
-// LogsContainer desribes all avalaible registered log containers.
-type LogsContainer struct {
+func (x *RotateDiskKey) GetSlots() []*RotateDiskKeySlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+type RotateDiskKeyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Metadata      *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Ids           []string               `protobuf:"bytes,2,rep,name=ids,proto3" json:"ids,omitempty"`
+	Messages      []*RotateDiskKey       `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
-
-func (x *LogsContainer) Reset() {
-	*x = LogsContainer{}
-	mi := &file_machine_machine_proto_msgTypes[61]
+
+func (x *RotateDiskKeyResponse) Reset() {
+	*x = RotateDiskKeyResponse{}
+	mi := &file_machine_machine_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogsContainer) String() string {
+func (x *RotateDiskKeyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogsContainer) ProtoMessage() {}
+func (*RotateDiskKeyResponse) ProtoMessage() {}
 
-func (x *LogsContainer) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[61]
+func (x *RotateDiskKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4337,47 +8229,44 @@ func (x *LogsContainer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogsContainer.ProtoReflect.Descriptor instead.
-func (*LogsContainer) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{61}
-}
-
-func (x *LogsContainer) GetMetadata() *common.Metadata {
-	if x != nil {
-		return x.Metadata
-	}
-	return nil
+// Deprecated: Use RotateDiskKeyResponse.ProtoReflect.Descriptor instead.
+func (*RotateDiskKeyResponse) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *LogsContainer) GetIds() []string {
+func (x *RotateDiskKeyResponse) GetMessages() []*RotateDiskKey {
 	if x != nil {
-		return x.Ids
+		return x.Messages
 	}
 	return nil
 }
 
-type LogsContainersResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Messages      []*LogsContainer       `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// rpc kubernetesUpgradePreflight
+type KubernetesUpgradePreflightRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Kubernetes version to upgrade to.
+	ToVersion string `protobuf:"bytes,1,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"`
+	// Whether the kubelet is going to be upgraded.
+	UpgradeKubelet bool `protobuf:"varint,2,opt,name=upgrade_kubelet,json=upgradeKubelet,proto3" json:"upgrade_kubelet,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *LogsContainersResponse) Reset() {
-	*x = LogsContainersResponse{}
-	mi := &file_machine_machine_proto_msgTypes[62]
+func (x *KubernetesUpgradePreflightRequest) Reset() {
+	*x = KubernetesUpgradePreflightRequest{}
+	mi := &file_machine_machine_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogsContainersResponse) String() string {
+func (x *KubernetesUpgradePreflightRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogsContainersResponse) ProtoMessage() {}
+func (*KubernetesUpgradePreflightRequest) ProtoMessage() {}
 
-func (x *LogsContainersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[62]
+func (x *KubernetesUpgradePreflightRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4388,40 +8277,49 @@ func (x *LogsContainersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogsContainersResponse.ProtoReflect.Descriptor instead.
-func (*LogsContainersResponse) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use KubernetesUpgradePreflightRequest.ProtoReflect.Descriptor instead.
+func (*KubernetesUpgradePreflightRequest) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{126}
 }
 
-func (x *LogsContainersResponse) GetMessages() []*LogsContainer {
+func (x *KubernetesUpgradePreflightRequest) GetToVersion() string {
 	if x != nil {
-		return x.Messages
+		return x.ToVersion
 	}
-	return nil
+	return ""
 }
 
-// rpc rollback
-type RollbackRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *KubernetesUpgradePreflightRequest) GetUpgradeKubelet() bool {
+	if x != nil {
+		return x.UpgradeKubelet
+	}
+	return false
+}
+
+type KubernetesUpgradePreflightCheck struct {
+	state         protoimpl.MessageState                   `protogen:"open.v1"`
+	Name          string                                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Severity      KubernetesUpgradePreflightCheck_Severity `protobuf:"varint,2,opt,name=severity,proto3,enum=machine.KubernetesUpgradePreflightCheck_Severity" json:"severity,omitempty"`
+	Message       string                                   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RollbackRequest) Reset() {
-	*x = RollbackRequest{}
-	mi := &file_machine_machine_proto_msgTypes[63]
+func (x *KubernetesUpgradePreflightCheck) Reset() {
+	*x = KubernetesUpgradePreflightCheck{}
+	mi := &file_machine_machine_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RollbackRequest) String() string {
+func (x *KubernetesUpgradePreflightCheck) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RollbackRequest) ProtoMessage() {}
+func (*KubernetesUpgradePreflightCheck) ProtoMessage() {}
 
-func (x *RollbackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[63]
+func (x *KubernetesUpgradePreflightCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4432,33 +8330,55 @@ func (x *RollbackRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RollbackRequest.ProtoReflect.Descriptor instead.
-func (*RollbackRequest) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use KubernetesUpgradePreflightCheck.ProtoReflect.Descriptor instead.
+func (*KubernetesUpgradePreflightCheck) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{127}
 }
 
-type Rollback struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Metadata      *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+func (x *KubernetesUpgradePreflightCheck) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *KubernetesUpgradePreflightCheck) GetSeverity() KubernetesUpgradePreflightCheck_Severity {
+	if x != nil {
+		return x.Severity
+	}
+	return KubernetesUpgradePreflightCheck_INFO
+}
+
+func (x *KubernetesUpgradePreflightCheck) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type KubernetesUpgradePreflight struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	Metadata      *common.Metadata                   `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Checks        []*KubernetesUpgradePreflightCheck `protobuf:"bytes,2,rep,name=checks,proto3" json:"checks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Rollback) Reset() {
-	*x = Rollback{}
-	mi := &file_machine_machine_proto_msgTypes[64]
+func (x *KubernetesUpgradePreflight) Reset() {
+	*x = KubernetesUpgradePreflight{}
+	mi := &file_machine_machine_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Rollback) String() string {
+func (x *KubernetesUpgradePreflight) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Rollback) ProtoMessage() {}
+func (*KubernetesUpgradePreflight) ProtoMessage() {}
 
-func (x *Rollback) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[64]
+func (x *KubernetesUpgradePreflight) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4469,40 +8389,47 @@ func (x *Rollback) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Rollback.ProtoReflect.Descriptor instead.
-func (*Rollback) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use KubernetesUpgradePreflight.ProtoReflect.Descriptor instead.
+func (*KubernetesUpgradePreflight) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *Rollback) GetMetadata() *common.Metadata {
+func (x *KubernetesUpgradePreflight) GetMetadata() *common.Metadata {
 	if x != nil {
 		return x.Metadata
 	}
 	return nil
 }
 
-type RollbackResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Messages      []*Rollback            `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+func (x *KubernetesUpgradePreflight) GetChecks() []*KubernetesUpgradePreflightCheck {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+type KubernetesUpgradePreflightResponse struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Messages      []*KubernetesUpgradePreflight `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RollbackResponse) Reset() {
-	*x = RollbackResponse{}
-	mi := &file_machine_machine_proto_msgTypes[65]
+func (x *KubernetesUpgradePreflightResponse) Reset() {
+	*x = KubernetesUpgradePreflightResponse{}
+	mi := &file_machine_machine_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RollbackResponse) String() string {
+func (x *KubernetesUpgradePreflightResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RollbackResponse) ProtoMessage() {}
+func (*KubernetesUpgradePreflightResponse) ProtoMessage() {}
 
-func (x *RollbackResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[65]
+func (x *KubernetesUpgradePreflightResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4513,12 +8440,12 @@ func (x *RollbackResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RollbackResponse.ProtoReflect.Descriptor instead.
-func (*RollbackResponse) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use KubernetesUpgradePreflightResponse.ProtoReflect.Descriptor instead.
+func (*KubernetesUpgradePreflightResponse) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{129}
 }
 
-func (x *RollbackResponse) GetMessages() []*Rollback {
+func (x *KubernetesUpgradePreflightResponse) GetMessages() []*KubernetesUpgradePreflight {
 	if x != nil {
 		return x.Messages
 	}
@@ -4536,7 +8463,7 @@ type ContainersRequest struct {
 
 func (x *ContainersRequest) Reset() {
 	*x = ContainersRequest{}
-	mi := &file_machine_machine_proto_msgTypes[66]
+	mi := &file_machine_machine_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4548,7 +8475,7 @@ func (x *ContainersRequest) String() string {
 func (*ContainersRequest) ProtoMessage() {}
 
 func (x *ContainersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[66]
+	mi := &file_machine_machine_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4561,7 +8488,7 @@ func (x *ContainersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ContainersRequest.ProtoReflect.Descriptor instead.
 func (*ContainersRequest) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{66}
+	return file_machine_machine_proto_rawDescGZIP(), []int{130}
 }
 
 func (x *ContainersRequest) GetNamespace() string {
@@ -4597,7 +8524,7 @@ type ContainerInfo struct {
 
 func (x *ContainerInfo) Reset() {
 	*x = ContainerInfo{}
-	mi := &file_machine_machine_proto_msgTypes[67]
+	mi := &file_machine_machine_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4609,7 +8536,7 @@ func (x *ContainerInfo) String() string {
 func (*ContainerInfo) ProtoMessage() {}
 
 func (x *ContainerInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[67]
+	mi := &file_machine_machine_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4622,7 +8549,7 @@ func (x *ContainerInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ContainerInfo.ProtoReflect.Descriptor instead.
 func (*ContainerInfo) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{67}
+	return file_machine_machine_proto_rawDescGZIP(), []int{131}
 }
 
 func (x *ContainerInfo) GetNamespace() string {
@@ -4706,7 +8633,7 @@ type Container struct {
 
 func (x *Container) Reset() {
 	*x = Container{}
-	mi := &file_machine_machine_proto_msgTypes[68]
+	mi := &file_machine_machine_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4718,7 +8645,7 @@ func (x *Container) String() string {
 func (*Container) ProtoMessage() {}
 
 func (x *Container) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[68]
+	mi := &file_machine_machine_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4731,7 +8658,7 @@ func (x *Container) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Container.ProtoReflect.Descriptor instead.
 func (*Container) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{68}
+	return file_machine_machine_proto_rawDescGZIP(), []int{132}
 }
 
 func (x *Container) GetMetadata() *common.Metadata {
@@ -4757,7 +8684,7 @@ type ContainersResponse struct {
 
 func (x *ContainersResponse) Reset() {
 	*x = ContainersResponse{}
-	mi := &file_machine_machine_proto_msgTypes[69]
+	mi := &file_machine_machine_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4769,7 +8696,7 @@ func (x *ContainersResponse) String() string {
 func (*ContainersResponse) ProtoMessage() {}
 
 func (x *ContainersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[69]
+	mi := &file_machine_machine_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4782,7 +8709,7 @@ func (x *ContainersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ContainersResponse.ProtoReflect.Descriptor instead.
 func (*ContainersResponse) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{69}
+	return file_machine_machine_proto_rawDescGZIP(), []int{133}
 }
 
 func (x *ContainersResponse) GetMessages() []*Container {
@@ -4803,7 +8730,7 @@ type DmesgRequest struct {
 
 func (x *DmesgRequest) Reset() {
 	*x = DmesgRequest{}
-	mi := &file_machine_machine_proto_msgTypes[70]
+	mi := &file_machine_machine_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4815,7 +8742,7 @@ func (x *DmesgRequest) String() string {
 func (*DmesgRequest) ProtoMessage() {}
 
 func (x *DmesgRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[70]
+	mi := &file_machine_machine_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4828,7 +8755,7 @@ func (x *DmesgRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DmesgRequest.ProtoReflect.Descriptor instead.
 func (*DmesgRequest) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{70}
+	return file_machine_machine_proto_rawDescGZIP(), []int{134}
 }
 
 func (x *DmesgRequest) GetFollow() bool {
@@ -4855,7 +8782,7 @@ type ProcessesResponse struct {
 
 func (x *ProcessesResponse) Reset() {
 	*x = ProcessesResponse{}
-	mi := &file_machine_machine_proto_msgTypes[71]
+	mi := &file_machine_machine_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4867,7 +8794,7 @@ func (x *ProcessesResponse) String() string {
 func (*ProcessesResponse) ProtoMessage() {}
 
 func (x *ProcessesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[71]
+	mi := &file_machine_machine_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4880,7 +8807,7 @@ func (x *ProcessesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProcessesResponse.ProtoReflect.Descriptor instead.
 func (*ProcessesResponse) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{71}
+	return file_machine_machine_proto_rawDescGZIP(), []int{135}
 }
 
 func (x *ProcessesResponse) GetMessages() []*Process {
@@ -4900,7 +8827,7 @@ type Process struct {
 
 func (x *Process) Reset() {
 	*x = Process{}
-	mi := &file_machine_machine_proto_msgTypes[72]
+	mi := &file_machine_machine_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4912,7 +8839,7 @@ func (x *Process) String() string {
 func (*Process) ProtoMessage() {}
 
 func (x *Process) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[72]
+	mi := &file_machine_machine_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4925,7 +8852,7 @@ func (x *Process) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Process.ProtoReflect.Descriptor instead.
 func (*Process) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{72}
+	return file_machine_machine_proto_rawDescGZIP(), []int{136}
 }
 
 func (x *Process) GetMetadata() *common.Metadata {
@@ -4961,7 +8888,7 @@ type ProcessInfo struct {
 
 func (x *ProcessInfo) Reset() {
 	*x = ProcessInfo{}
-	mi := &file_machine_machine_proto_msgTypes[73]
+	mi := &file_machine_machine_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4973,7 +8900,7 @@ func (x *ProcessInfo) String() string {
 func (*ProcessInfo) ProtoMessage() {}
 
 func (x *ProcessInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[73]
+	mi := &file_machine_machine_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4986,7 +8913,7 @@ func (x *ProcessInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProcessInfo.ProtoReflect.Descriptor instead.
 func (*ProcessInfo) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{73}
+	return file_machine_machine_proto_rawDescGZIP(), []int{137}
 }
 
 func (x *ProcessInfo) GetPid() int32 {
@@ -5080,7 +9007,7 @@ type RestartRequest struct {
 
 func (x *RestartRequest) Reset() {
 	*x = RestartRequest{}
-	mi := &file_machine_machine_proto_msgTypes[74]
+	mi := &file_machine_machine_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5092,7 +9019,7 @@ func (x *RestartRequest) String() string {
 func (*RestartRequest) ProtoMessage() {}
 
 func (x *RestartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[74]
+	mi := &file_machine_machine_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5105,7 +9032,7 @@ func (x *RestartRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RestartRequest.ProtoReflect.Descriptor instead.
 func (*RestartRequest) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{74}
+	return file_machine_machine_proto_rawDescGZIP(), []int{138}
 }
 
 func (x *RestartRequest) GetNamespace() string {
@@ -5138,7 +9065,7 @@ type Restart struct {
 
 func (x *Restart) Reset() {
 	*x = Restart{}
-	mi := &file_machine_machine_proto_msgTypes[75]
+	mi := &file_machine_machine_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5150,7 +9077,7 @@ func (x *Restart) String() string {
 func (*Restart) ProtoMessage() {}
 
 func (x *Restart) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[75]
+	mi := &file_machine_machine_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5163,7 +9090,7 @@ func (x *Restart) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Restart.ProtoReflect.Descriptor instead.
 func (*Restart) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{75}
+	return file_machine_machine_proto_rawDescGZIP(), []int{139}
 }
 
 func (x *Restart) GetMetadata() *common.Metadata {
@@ -5183,7 +9110,7 @@ type RestartResponse struct {
 
 func (x *RestartResponse) Reset() {
 	*x = RestartResponse{}
-	mi := &file_machine_machine_proto_msgTypes[76]
+	mi := &file_machine_machine_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5195,7 +9122,7 @@ func (x *RestartResponse) String() string {
 func (*RestartResponse) ProtoMessage() {}
 
 func (x *RestartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[76]
+	mi := &file_machine_machine_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5208,7 +9135,7 @@ func (x *RestartResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RestartResponse.ProtoReflect.Descriptor instead.
 func (*RestartResponse) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{76}
+	return file_machine_machine_proto_rawDescGZIP(), []int{140}
 }
 
 func (x *RestartResponse) GetMessages() []*Restart {
@@ -5230,7 +9157,7 @@ type StatsRequest struct {
 
 func (x *StatsRequest) Reset() {
 	*x = StatsRequest{}
-	mi := &file_machine_machine_proto_msgTypes[77]
+	mi := &file_machine_machine_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5242,7 +9169,7 @@ func (x *StatsRequest) String() string {
 func (*StatsRequest) ProtoMessage() {}
 
 func (x *StatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[77]
+	mi := &file_machine_machine_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5255,7 +9182,7 @@ func (x *StatsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatsRequest.ProtoReflect.Descriptor instead.
 func (*StatsRequest) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{77}
+	return file_machine_machine_proto_rawDescGZIP(), []int{141}
 }
 
 func (x *StatsRequest) GetNamespace() string {
@@ -5283,7 +9210,7 @@ type Stats struct {
 
 func (x *Stats) Reset() {
 	*x = Stats{}
-	mi := &file_machine_machine_proto_msgTypes[78]
+	mi := &file_machine_machine_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5295,7 +9222,7 @@ func (x *Stats) String() string {
 func (*Stats) ProtoMessage() {}
 
 func (x *Stats) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[78]
+	mi := &file_machine_machine_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5308,7 +9235,7 @@ func (x *Stats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Stats.ProtoReflect.Descriptor instead.
 func (*Stats) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{78}
+	return file_machine_machine_proto_rawDescGZIP(), []int{142}
 }
 
 func (x *Stats) GetMetadata() *common.Metadata {
@@ -5334,7 +9261,7 @@ type StatsResponse struct {
 
 func (x *StatsResponse) Reset() {
 	*x = StatsResponse{}
-	mi := &file_machine_machine_proto_msgTypes[79]
+	mi := &file_machine_machine_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5346,7 +9273,7 @@ func (x *StatsResponse) String() string {
 func (*StatsResponse) ProtoMessage() {}
 
 func (x *StatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[79]
+	mi := &file_machine_machine_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5359,7 +9286,7 @@ func (x *StatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatsResponse.ProtoReflect.Descriptor instead.
 func (*StatsResponse) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{79}
+	return file_machine_machine_proto_rawDescGZIP(), []int{143}
 }
 
 func (x *StatsResponse) GetMessages() []*Stats {
@@ -5384,7 +9311,7 @@ type Stat struct {
 
 func (x *Stat) Reset() {
 	*x = Stat{}
-	mi := &file_machine_machine_proto_msgTypes[80]
+	mi := &file_machine_machine_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5396,7 +9323,7 @@ func (x *Stat) String() string {
 func (*Stat) ProtoMessage() {}
 
 func (x *Stat) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[80]
+	mi := &file_machine_machine_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5409,7 +9336,7 @@ func (x *Stat) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Stat.ProtoReflect.Descriptor instead.
 func (*Stat) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{80}
+	return file_machine_machine_proto_rawDescGZIP(), []int{144}
 }
 
 func (x *Stat) GetNamespace() string {
@@ -5464,7 +9391,7 @@ type Memory struct {
 
 func (x *Memory) Reset() {
 	*x = Memory{}
-	mi := &file_machine_machine_proto_msgTypes[81]
+	mi := &file_machine_machine_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5476,7 +9403,7 @@ func (x *Memory) String() string {
 func (*Memory) ProtoMessage() {}
 
 func (x *Memory) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[81]
+	mi := &file_machine_machine_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5489,7 +9416,7 @@ func (x *Memory) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Memory.ProtoReflect.Descriptor instead.
 func (*Memory) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{81}
+	return file_machine_machine_proto_rawDescGZIP(), []int{145}
 }
 
 func (x *Memory) GetMetadata() *common.Metadata {
@@ -5515,7 +9442,7 @@ type MemoryResponse struct {
 
 func (x *MemoryResponse) Reset() {
 	*x = MemoryResponse{}
-	mi := &file_machine_machine_proto_msgTypes[82]
+	mi := &file_machine_machine_proto_msgTypes[146]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5527,7 +9454,7 @@ func (x *MemoryResponse) String() string {
 func (*MemoryResponse) ProtoMessage() {}
 
 func (x *MemoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[82]
+	mi := &file_machine_machine_proto_msgTypes[146]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5540,7 +9467,7 @@ func (x *MemoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MemoryResponse.ProtoReflect.Descriptor instead.
 func (*MemoryResponse) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{82}
+	return file_machine_machine_proto_rawDescGZIP(), []int{146}
 }
 
 func (x *MemoryResponse) GetMessages() []*Memory {
@@ -5606,7 +9533,7 @@ type MemInfo struct {
 
 func (x *MemInfo) Reset() {
 	*x = MemInfo{}
-	mi := &file_machine_machine_proto_msgTypes[83]
+	mi := &file_machine_machine_proto_msgTypes[147]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5618,7 +9545,7 @@ func (x *MemInfo) String() string {
 func (*MemInfo) ProtoMessage() {}
 
 func (x *MemInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[83]
+	mi := &file_machine_machine_proto_msgTypes[147]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5631,7 +9558,7 @@ func (x *MemInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MemInfo.ProtoReflect.Descriptor instead.
 func (*MemInfo) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{83}
+	return file_machine_machine_proto_rawDescGZIP(), []int{147}
 }
 
 func (x *MemInfo) GetMemtotal() uint64 {
@@ -5979,7 +9906,7 @@ type HostnameResponse struct {
 
 func (x *HostnameResponse) Reset() {
 	*x = HostnameResponse{}
-	mi := &file_machine_machine_proto_msgTypes[84]
+	mi := &file_machine_machine_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5991,7 +9918,7 @@ func (x *HostnameResponse) String() string {
 func (*HostnameResponse) ProtoMessage() {}
 
 func (x *HostnameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[84]
+	mi := &file_machine_machine_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6004,7 +9931,7 @@ func (x *HostnameResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HostnameResponse.ProtoReflect.Descriptor instead.
 func (*HostnameResponse) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{84}
+	return file_machine_machine_proto_rawDescGZIP(), []int{148}
 }
 
 func (x *HostnameResponse) GetMessages() []*Hostname {
@@ -6024,7 +9951,7 @@ type Hostname struct {
 
 func (x *Hostname) Reset() {
 	*x = Hostname{}
-	mi := &file_machine_machine_proto_msgTypes[85]
+	mi := &file_machine_machine_proto_msgTypes[149]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6036,7 +9963,7 @@ func (x *Hostname) String() string {
 func (*Hostname) ProtoMessage() {}
 
 func (x *Hostname) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[85]
+	mi := &file_machine_machine_proto_msgTypes[149]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6049,7 +9976,7 @@ func (x *Hostname) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Hostname.ProtoReflect.Descriptor instead.
 func (*Hostname) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{85}
+	return file_machine_machine_proto_rawDescGZIP(), []int{149}
 }
 
 func (x *Hostname) GetMetadata() *common.Metadata {
@@ -6075,7 +10002,7 @@ type LoadAvgResponse struct {
 
 func (x *LoadAvgResponse) Reset() {
 	*x = LoadAvgResponse{}
-	mi := &file_machine_machine_proto_msgTypes[86]
+	mi := &file_machine_machine_proto_msgTypes[150]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6087,7 +10014,7 @@ func (x *LoadAvgResponse) String() string {
 func (*LoadAvgResponse) ProtoMessage() {}
 
 func (x *LoadAvgResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[86]
+	mi := &file_machine_machine_proto_msgTypes[150]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6100,7 +10027,7 @@ func (x *LoadAvgResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoadAvgResponse.ProtoReflect.Descriptor instead.
 func (*LoadAvgResponse) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{86}
+	return file_machine_machine_proto_rawDescGZIP(), []int{150}
 }
 
 func (x *LoadAvgResponse) GetMessages() []*LoadAvg {
@@ -6122,7 +10049,7 @@ type LoadAvg struct {
 
 func (x *LoadAvg) Reset() {
 	*x = LoadAvg{}
-	mi := &file_machine_machine_proto_msgTypes[87]
+	mi := &file_machine_machine_proto_msgTypes[151]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6134,7 +10061,7 @@ func (x *LoadAvg) String() string {
 func (*LoadAvg) ProtoMessage() {}
 
 func (x *LoadAvg) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[87]
+	mi := &file_machine_machine_proto_msgTypes[151]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6147,7 +10074,7 @@ func (x *LoadAvg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoadAvg.ProtoReflect.Descriptor instead.
 func (*LoadAvg) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{87}
+	return file_machine_machine_proto_rawDescGZIP(), []int{151}
 }
 
 func (x *LoadAvg) GetMetadata() *common.Metadata {
@@ -6187,7 +10114,7 @@ type SystemStatResponse struct {
 
 func (x *SystemStatResponse) Reset() {
 	*x = SystemStatResponse{}
-	mi := &file_machine_machine_proto_msgTypes[88]
+	mi := &file_machine_machine_proto_msgTypes[152]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6199,7 +10126,7 @@ func (x *SystemStatResponse) String() string {
 func (*SystemStatResponse) ProtoMessage() {}
 
 func (x *SystemStatResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[88]
+	mi := &file_machine_machine_proto_msgTypes[152]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6212,7 +10139,7 @@ func (x *SystemStatResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemStatResponse.ProtoReflect.Descriptor instead.
 func (*SystemStatResponse) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{88}
+	return file_machine_machine_proto_rawDescGZIP(), []int{152}
 }
 
 func (x *SystemStatResponse) GetMessages() []*SystemStat {
@@ -6242,7 +10169,7 @@ type SystemStat struct {
 
 func (x *SystemStat) Reset() {
 	*x = SystemStat{}
-	mi := &file_machine_machine_proto_msgTypes[89]
+	mi := &file_machine_machine_proto_msgTypes[153]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6254,7 +10181,7 @@ func (x *SystemStat) String() string {
 func (*SystemStat) ProtoMessage() {}
 
 func (x *SystemStat) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[89]
+	mi := &file_machine_machine_proto_msgTypes[153]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6267,7 +10194,7 @@ func (x *SystemStat) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemStat.ProtoReflect.Descriptor instead.
 func (*SystemStat) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{89}
+	return file_machine_machine_proto_rawDescGZIP(), []int{153}
 }
 
 func (x *SystemStat) GetMetadata() *common.Metadata {
@@ -6372,7 +10299,7 @@ type CPUStat struct {
 
 func (x *CPUStat) Reset() {
 	*x = CPUStat{}
-	mi := &file_machine_machine_proto_msgTypes[90]
+	mi := &file_machine_machine_proto_msgTypes[154]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6384,7 +10311,7 @@ func (x *CPUStat) String() string {
 func (*CPUStat) ProtoMessage() {}
 
 func (x *CPUStat) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[90]
+	mi := &file_machine_machine_proto_msgTypes[154]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6397,7 +10324,7 @@ func (x *CPUStat) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CPUStat.ProtoReflect.Descriptor instead.
 func (*CPUStat) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{90}
+	return file_machine_machine_proto_rawDescGZIP(), []int{154}
 }
 
 func (x *CPUStat) GetUser() float64 {
@@ -6488,7 +10415,7 @@ type SoftIRQStat struct {
 
 func (x *SoftIRQStat) Reset() {
 	*x = SoftIRQStat{}
-	mi := &file_machine_machine_proto_msgTypes[91]
+	mi := &file_machine_machine_proto_msgTypes[155]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6500,7 +10427,7 @@ func (x *SoftIRQStat) String() string {
 func (*SoftIRQStat) ProtoMessage() {}
 
 func (x *SoftIRQStat) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[91]
+	mi := &file_machine_machine_proto_msgTypes[155]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6513,7 +10440,7 @@ func (x *SoftIRQStat) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SoftIRQStat.ProtoReflect.Descriptor instead.
 func (*SoftIRQStat) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{91}
+	return file_machine_machine_proto_rawDescGZIP(), []int{155}
 }
 
 func (x *SoftIRQStat) GetHi() uint64 {
@@ -6595,7 +10522,7 @@ type CPUFreqStatsResponse struct {
 
 func (x *CPUFreqStatsResponse) Reset() {
 	*x = CPUFreqStatsResponse{}
-	mi := &file_machine_machine_proto_msgTypes[92]
+	mi := &file_machine_machine_proto_msgTypes[156]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6607,7 +10534,7 @@ func (x *CPUFreqStatsResponse) String() string {
 func (*CPUFreqStatsResponse) ProtoMessage() {}
 
 func (x *CPUFreqStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[92]
+	mi := &file_machine_machine_proto_msgTypes[156]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6620,7 +10547,7 @@ func (x *CPUFreqStatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CPUFreqStatsResponse.ProtoReflect.Descriptor instead.
 func (*CPUFreqStatsResponse) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{92}
+	return file_machine_machine_proto_rawDescGZIP(), []int{156}
 }
 
 func (x *CPUFreqStatsResponse) GetMessages() []*CPUsFreqStats {
@@ -6640,7 +10567,7 @@ type CPUsFreqStats struct {
 
 func (x *CPUsFreqStats) Reset() {
 	*x = CPUsFreqStats{}
-	mi := &file_machine_machine_proto_msgTypes[93]
+	mi := &file_machine_machine_proto_msgTypes[157]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6652,7 +10579,7 @@ func (x *CPUsFreqStats) String() string {
 func (*CPUsFreqStats) ProtoMessage() {}
 
 func (x *CPUsFreqStats) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[93]
+	mi := &file_machine_machine_proto_msgTypes[157]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6665,7 +10592,7 @@ func (x *CPUsFreqStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CPUsFreqStats.ProtoReflect.Descriptor instead.
 func (*CPUsFreqStats) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{93}
+	return file_machine_machine_proto_rawDescGZIP(), []int{157}
 }
 
 func (x *CPUsFreqStats) GetMetadata() *common.Metadata {
@@ -6694,7 +10621,7 @@ type CPUFreqStats struct {
 
 func (x *CPUFreqStats) Reset() {
 	*x = CPUFreqStats{}
-	mi := &file_machine_machine_proto_msgTypes[94]
+	mi := &file_machine_machine_proto_msgTypes[158]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6706,7 +10633,7 @@ func (x *CPUFreqStats) String() string {
 func (*CPUFreqStats) ProtoMessage() {}
 
 func (x *CPUFreqStats) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[94]
+	mi := &file_machine_machine_proto_msgTypes[158]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6719,7 +10646,7 @@ func (x *CPUFreqStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CPUFreqStats.ProtoReflect.Descriptor instead.
 func (*CPUFreqStats) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{94}
+	return file_machine_machine_proto_rawDescGZIP(), []int{158}
 }
 
 func (x *CPUFreqStats) GetCurrentFrequency() uint64 {
@@ -6759,7 +10686,7 @@ type CPUInfoResponse struct {
 
 func (x *CPUInfoResponse) Reset() {
 	*x = CPUInfoResponse{}
-	mi := &file_machine_machine_proto_msgTypes[95]
+	mi := &file_machine_machine_proto_msgTypes[159]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6771,7 +10698,7 @@ func (x *CPUInfoResponse) String() string {
 func (*CPUInfoResponse) ProtoMessage() {}
 
 func (x *CPUInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[95]
+	mi := &file_machine_machine_proto_msgTypes[159]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6784,7 +10711,7 @@ func (x *CPUInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CPUInfoResponse.ProtoReflect.Descriptor instead.
 func (*CPUInfoResponse) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{95}
+	return file_machine_machine_proto_rawDescGZIP(), []int{159}
 }
 
 func (x *CPUInfoResponse) GetMessages() []*CPUsInfo {
@@ -6804,7 +10731,7 @@ type CPUsInfo struct {
 
 func (x *CPUsInfo) Reset() {
 	*x = CPUsInfo{}
-	mi := &file_machine_machine_proto_msgTypes[96]
+	mi := &file_machine_machine_proto_msgTypes[160]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6816,7 +10743,7 @@ func (x *CPUsInfo) String() string {
 func (*CPUsInfo) ProtoMessage() {}
 
 func (x *CPUsInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[96]
+	mi := &file_machine_machine_proto_msgTypes[160]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6829,7 +10756,7 @@ func (x *CPUsInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CPUsInfo.ProtoReflect.Descriptor instead.
 func (*CPUsInfo) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{96}
+	return file_machine_machine_proto_rawDescGZIP(), []int{160}
 }
 
 func (x *CPUsInfo) GetMetadata() *common.Metadata {
@@ -6880,7 +10807,7 @@ type CPUInfo struct {
 
 func (x *CPUInfo) Reset() {
 	*x = CPUInfo{}
-	mi := &file_machine_machine_proto_msgTypes[97]
+	mi := &file_machine_machine_proto_msgTypes[161]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6892,7 +10819,7 @@ func (x *CPUInfo) String() string {
 func (*CPUInfo) ProtoMessage() {}
 
 func (x *CPUInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[97]
+	mi := &file_machine_machine_proto_msgTypes[161]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6905,7 +10832,7 @@ func (x *CPUInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CPUInfo.ProtoReflect.Descriptor instead.
 func (*CPUInfo) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{97}
+	return file_machine_machine_proto_rawDescGZIP(), []int{161}
 }
 
 func (x *CPUInfo) GetProcessor() uint32 {
@@ -7099,7 +11026,7 @@ type NetworkDeviceStatsResponse struct {
 
 func (x *NetworkDeviceStatsResponse) Reset() {
 	*x = NetworkDeviceStatsResponse{}
-	mi := &file_machine_machine_proto_msgTypes[98]
+	mi := &file_machine_machine_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7111,7 +11038,7 @@ func (x *NetworkDeviceStatsResponse) String() string {
 func (*NetworkDeviceStatsResponse) ProtoMessage() {}
 
 func (x *NetworkDeviceStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[98]
+	mi := &file_machine_machine_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7124,7 +11051,7 @@ func (x *NetworkDeviceStatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NetworkDeviceStatsResponse.ProtoReflect.Descriptor instead.
 func (*NetworkDeviceStatsResponse) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{98}
+	return file_machine_machine_proto_rawDescGZIP(), []int{162}
 }
 
 func (x *NetworkDeviceStatsResponse) GetMessages() []*NetworkDeviceStats {
@@ -7145,7 +11072,7 @@ type NetworkDeviceStats struct {
 
 func (x *NetworkDeviceStats) Reset() {
 	*x = NetworkDeviceStats{}
-	mi := &file_machine_machine_proto_msgTypes[99]
+	mi := &file_machine_machine_proto_msgTypes[163]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7157,7 +11084,7 @@ func (x *NetworkDeviceStats) String() string {
 func (*NetworkDeviceStats) ProtoMessage() {}
 
 func (x *NetworkDeviceStats) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[99]
+	mi := &file_machine_machine_proto_msgTypes[163]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7170,7 +11097,7 @@ func (x *NetworkDeviceStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NetworkDeviceStats.ProtoReflect.Descriptor instead.
 func (*NetworkDeviceStats) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{99}
+	return file_machine_machine_proto_rawDescGZIP(), []int{163}
 }
 
 func (x *NetworkDeviceStats) GetMetadata() *common.Metadata {
@@ -7219,7 +11146,7 @@ type NetDev struct {
 
 func (x *NetDev) Reset() {
 	*x = NetDev{}
-	mi := &file_machine_machine_proto_msgTypes[100]
+	mi := &file_machine_machine_proto_msgTypes[164]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7231,7 +11158,7 @@ func (x *NetDev) String() string {
 func (*NetDev) ProtoMessage() {}
 
 func (x *NetDev) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[100]
+	mi := &file_machine_machine_proto_msgTypes[164]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7244,7 +11171,7 @@ func (x *NetDev) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NetDev.ProtoReflect.Descriptor instead.
 func (*NetDev) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{100}
+	return file_machine_machine_proto_rawDescGZIP(), []int{164}
 }
 
 func (x *NetDev) GetName() string {
@@ -7375,7 +11302,7 @@ type DiskStatsResponse struct {
 
 func (x *DiskStatsResponse) Reset() {
 	*x = DiskStatsResponse{}
-	mi := &file_machine_machine_proto_msgTypes[101]
+	mi := &file_machine_machine_proto_msgTypes[165]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7387,7 +11314,7 @@ func (x *DiskStatsResponse) String() string {
 func (*DiskStatsResponse) ProtoMessage() {}
 
 func (x *DiskStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[101]
+	mi := &file_machine_machine_proto_msgTypes[165]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7400,7 +11327,7 @@ func (x *DiskStatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiskStatsResponse.ProtoReflect.Descriptor instead.
 func (*DiskStatsResponse) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{101}
+	return file_machine_machine_proto_rawDescGZIP(), []int{165}
 }
 
 func (x *DiskStatsResponse) GetMessages() []*DiskStats {
@@ -7421,7 +11348,7 @@ type DiskStats struct {
 
 func (x *DiskStats) Reset() {
 	*x = DiskStats{}
-	mi := &file_machine_machine_proto_msgTypes[102]
+	mi := &file_machine_machine_proto_msgTypes[166]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7433,7 +11360,7 @@ func (x *DiskStats) String() string {
 func (*DiskStats) ProtoMessage() {}
 
 func (x *DiskStats) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[102]
+	mi := &file_machine_machine_proto_msgTypes[166]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7446,7 +11373,7 @@ func (x *DiskStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiskStats.ProtoReflect.Descriptor instead.
 func (*DiskStats) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{102}
+	return file_machine_machine_proto_rawDescGZIP(), []int{166}
 }
 
 func (x *DiskStats) GetMetadata() *common.Metadata {
@@ -7494,7 +11421,7 @@ type DiskStat struct {
 
 func (x *DiskStat) Reset() {
 	*x = DiskStat{}
-	mi := &file_machine_machine_proto_msgTypes[103]
+	mi := &file_machine_machine_proto_msgTypes[167]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7506,7 +11433,7 @@ func (x *DiskStat) String() string {
 func (*DiskStat) ProtoMessage() {}
 
 func (x *DiskStat) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[103]
+	mi := &file_machine_machine_proto_msgTypes[167]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7519,7 +11446,7 @@ func (x *DiskStat) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiskStat.ProtoReflect.Descriptor instead.
 func (*DiskStat) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{103}
+	return file_machine_machine_proto_rawDescGZIP(), []int{167}
 }
 
 func (x *DiskStat) GetName() string {
@@ -7642,7 +11569,7 @@ type EtcdLeaveClusterRequest struct {
 
 func (x *EtcdLeaveClusterRequest) Reset() {
 	*x = EtcdLeaveClusterRequest{}
-	mi := &file_machine_machine_proto_msgTypes[104]
+	mi := &file_machine_machine_proto_msgTypes[168]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7654,7 +11581,7 @@ func (x *EtcdLeaveClusterRequest) String() string {
 func (*EtcdLeaveClusterRequest) ProtoMessage() {}
 
 func (x *EtcdLeaveClusterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[104]
+	mi := &file_machine_machine_proto_msgTypes[168]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7667,7 +11594,7 @@ func (x *EtcdLeaveClusterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdLeaveClusterRequest.ProtoReflect.Descriptor instead.
 func (*EtcdLeaveClusterRequest) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{104}
+	return file_machine_machine_proto_rawDescGZIP(), []int{168}
 }
 
 type EtcdLeaveCluster struct {
@@ -7679,7 +11606,7 @@ type EtcdLeaveCluster struct {
 
 func (x *EtcdLeaveCluster) Reset() {
 	*x = EtcdLeaveCluster{}
-	mi := &file_machine_machine_proto_msgTypes[105]
+	mi := &file_machine_machine_proto_msgTypes[169]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7691,7 +11618,7 @@ func (x *EtcdLeaveCluster) String() string {
 func (*EtcdLeaveCluster) ProtoMessage() {}
 
 func (x *EtcdLeaveCluster) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[105]
+	mi := &file_machine_machine_proto_msgTypes[169]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7704,7 +11631,7 @@ func (x *EtcdLeaveCluster) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdLeaveCluster.ProtoReflect.Descriptor instead.
 func (*EtcdLeaveCluster) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{105}
+	return file_machine_machine_proto_rawDescGZIP(), []int{169}
 }
 
 func (x *EtcdLeaveCluster) GetMetadata() *common.Metadata {
@@ -7723,7 +11650,7 @@ type EtcdLeaveClusterResponse struct {
 
 func (x *EtcdLeaveClusterResponse) Reset() {
 	*x = EtcdLeaveClusterResponse{}
-	mi := &file_machine_machine_proto_msgTypes[106]
+	mi := &file_machine_machine_proto_msgTypes[170]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7735,7 +11662,7 @@ func (x *EtcdLeaveClusterResponse) String() string {
 func (*EtcdLeaveClusterResponse) ProtoMessage() {}
 
 func (x *EtcdLeaveClusterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[106]
+	mi := &file_machine_machine_proto_msgTypes[170]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7748,7 +11675,7 @@ func (x *EtcdLeaveClusterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdLeaveClusterResponse.ProtoReflect.Descriptor instead.
 func (*EtcdLeaveClusterResponse) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{106}
+	return file_machine_machine_proto_rawDescGZIP(), []int{170}
 }
 
 func (x *EtcdLeaveClusterResponse) GetMessages() []*EtcdLeaveCluster {
@@ -7767,7 +11694,7 @@ type EtcdRemoveMemberRequest struct {
 
 func (x *EtcdRemoveMemberRequest) Reset() {
 	*x = EtcdRemoveMemberRequest{}
-	mi := &file_machine_machine_proto_msgTypes[107]
+	mi := &file_machine_machine_proto_msgTypes[171]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7779,7 +11706,7 @@ func (x *EtcdRemoveMemberRequest) String() string {
 func (*EtcdRemoveMemberRequest) ProtoMessage() {}
 
 func (x *EtcdRemoveMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[107]
+	mi := &file_machine_machine_proto_msgTypes[171]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7792,7 +11719,7 @@ func (x *EtcdRemoveMemberRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdRemoveMemberRequest.ProtoReflect.Descriptor instead.
 func (*EtcdRemoveMemberRequest) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{107}
+	return file_machine_machine_proto_rawDescGZIP(), []int{171}
 }
 
 func (x *EtcdRemoveMemberRequest) GetMember() string {
@@ -7811,7 +11738,7 @@ type EtcdRemoveMember struct {
 
 func (x *EtcdRemoveMember) Reset() {
 	*x = EtcdRemoveMember{}
-	mi := &file_machine_machine_proto_msgTypes[108]
+	mi := &file_machine_machine_proto_msgTypes[172]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7823,7 +11750,7 @@ func (x *EtcdRemoveMember) String() string {
 func (*EtcdRemoveMember) ProtoMessage() {}
 
 func (x *EtcdRemoveMember) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[108]
+	mi := &file_machine_machine_proto_msgTypes[172]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7836,7 +11763,7 @@ func (x *EtcdRemoveMember) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdRemoveMember.ProtoReflect.Descriptor instead.
 func (*EtcdRemoveMember) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{108}
+	return file_machine_machine_proto_rawDescGZIP(), []int{172}
 }
 
 func (x *EtcdRemoveMember) GetMetadata() *common.Metadata {
@@ -7855,7 +11782,7 @@ type EtcdRemoveMemberResponse struct {
 
 func (x *EtcdRemoveMemberResponse) Reset() {
 	*x = EtcdRemoveMemberResponse{}
-	mi := &file_machine_machine_proto_msgTypes[109]
+	mi := &file_machine_machine_proto_msgTypes[173]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7867,7 +11794,7 @@ func (x *EtcdRemoveMemberResponse) String() string {
 func (*EtcdRemoveMemberResponse) ProtoMessage() {}
 
 func (x *EtcdRemoveMemberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[109]
+	mi := &file_machine_machine_proto_msgTypes[173]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7880,7 +11807,7 @@ func (x *EtcdRemoveMemberResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdRemoveMemberResponse.ProtoReflect.Descriptor instead.
 func (*EtcdRemoveMemberResponse) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{109}
+	return file_machine_machine_proto_rawDescGZIP(), []int{173}
 }
 
 func (x *EtcdRemoveMemberResponse) GetMessages() []*EtcdRemoveMember {
@@ -7899,7 +11826,7 @@ type EtcdRemoveMemberByIDRequest struct {
 
 func (x *EtcdRemoveMemberByIDRequest) Reset() {
 	*x = EtcdRemoveMemberByIDRequest{}
-	mi := &file_machine_machine_proto_msgTypes[110]
+	mi := &file_machine_machine_proto_msgTypes[174]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7911,7 +11838,7 @@ func (x *EtcdRemoveMemberByIDRequest) String() string {
 func (*EtcdRemoveMemberByIDRequest) ProtoMessage() {}
 
 func (x *EtcdRemoveMemberByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[110]
+	mi := &file_machine_machine_proto_msgTypes[174]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7924,38 +11851,170 @@ func (x *EtcdRemoveMemberByIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdRemoveMemberByIDRequest.ProtoReflect.Descriptor instead.
 func (*EtcdRemoveMemberByIDRequest) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{110}
+	return file_machine_machine_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *EtcdRemoveMemberByIDRequest) GetMemberId() uint64 {
+	if x != nil {
+		return x.MemberId
+	}
+	return 0
+}
+
+type EtcdRemoveMemberByID struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metadata      *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EtcdRemoveMemberByID) Reset() {
+	*x = EtcdRemoveMemberByID{}
+	mi := &file_machine_machine_proto_msgTypes[175]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EtcdRemoveMemberByID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EtcdRemoveMemberByID) ProtoMessage() {}
+
+func (x *EtcdRemoveMemberByID) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[175]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EtcdRemoveMemberByID.ProtoReflect.Descriptor instead.
+func (*EtcdRemoveMemberByID) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *EtcdRemoveMemberByID) GetMetadata() *common.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type EtcdRemoveMemberByIDResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Messages      []*EtcdRemoveMemberByID `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EtcdRemoveMemberByIDResponse) Reset() {
+	*x = EtcdRemoveMemberByIDResponse{}
+	mi := &file_machine_machine_proto_msgTypes[176]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EtcdRemoveMemberByIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EtcdRemoveMemberByIDResponse) ProtoMessage() {}
+
+func (x *EtcdRemoveMemberByIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[176]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EtcdRemoveMemberByIDResponse.ProtoReflect.Descriptor instead.
+func (*EtcdRemoveMemberByIDResponse) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *EtcdRemoveMemberByIDResponse) GetMessages() []*EtcdRemoveMemberByID {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type EtcdPromoteMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberId      uint64                 `protobuf:"varint,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EtcdPromoteMemberRequest) Reset() {
+	*x = EtcdPromoteMemberRequest{}
+	mi := &file_machine_machine_proto_msgTypes[177]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EtcdPromoteMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EtcdPromoteMemberRequest) ProtoMessage() {}
+
+func (x *EtcdPromoteMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[177]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EtcdPromoteMemberRequest.ProtoReflect.Descriptor instead.
+func (*EtcdPromoteMemberRequest) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{177}
 }
 
-func (x *EtcdRemoveMemberByIDRequest) GetMemberId() uint64 {
+func (x *EtcdPromoteMemberRequest) GetMemberId() uint64 {
 	if x != nil {
 		return x.MemberId
 	}
 	return 0
 }
 
-type EtcdRemoveMemberByID struct {
+type EtcdPromoteMember struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Metadata      *common.Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EtcdRemoveMemberByID) Reset() {
-	*x = EtcdRemoveMemberByID{}
-	mi := &file_machine_machine_proto_msgTypes[111]
+func (x *EtcdPromoteMember) Reset() {
+	*x = EtcdPromoteMember{}
+	mi := &file_machine_machine_proto_msgTypes[178]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EtcdRemoveMemberByID) String() string {
+func (x *EtcdPromoteMember) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EtcdRemoveMemberByID) ProtoMessage() {}
+func (*EtcdPromoteMember) ProtoMessage() {}
 
-func (x *EtcdRemoveMemberByID) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[111]
+func (x *EtcdPromoteMember) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[178]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7966,40 +12025,40 @@ func (x *EtcdRemoveMemberByID) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EtcdRemoveMemberByID.ProtoReflect.Descriptor instead.
-func (*EtcdRemoveMemberByID) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{111}
+// Deprecated: Use EtcdPromoteMember.ProtoReflect.Descriptor instead.
+func (*EtcdPromoteMember) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{178}
 }
 
-func (x *EtcdRemoveMemberByID) GetMetadata() *common.Metadata {
+func (x *EtcdPromoteMember) GetMetadata() *common.Metadata {
 	if x != nil {
 		return x.Metadata
 	}
 	return nil
 }
 
-type EtcdRemoveMemberByIDResponse struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Messages      []*EtcdRemoveMemberByID `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+type EtcdPromoteMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*EtcdPromoteMember   `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EtcdRemoveMemberByIDResponse) Reset() {
-	*x = EtcdRemoveMemberByIDResponse{}
-	mi := &file_machine_machine_proto_msgTypes[112]
+func (x *EtcdPromoteMemberResponse) Reset() {
+	*x = EtcdPromoteMemberResponse{}
+	mi := &file_machine_machine_proto_msgTypes[179]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EtcdRemoveMemberByIDResponse) String() string {
+func (x *EtcdPromoteMemberResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EtcdRemoveMemberByIDResponse) ProtoMessage() {}
+func (*EtcdPromoteMemberResponse) ProtoMessage() {}
 
-func (x *EtcdRemoveMemberByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[112]
+func (x *EtcdPromoteMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[179]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8010,12 +12069,12 @@ func (x *EtcdRemoveMemberByIDResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EtcdRemoveMemberByIDResponse.ProtoReflect.Descriptor instead.
-func (*EtcdRemoveMemberByIDResponse) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{112}
+// Deprecated: Use EtcdPromoteMemberResponse.ProtoReflect.Descriptor instead.
+func (*EtcdPromoteMemberResponse) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{179}
 }
 
-func (x *EtcdRemoveMemberByIDResponse) GetMessages() []*EtcdRemoveMemberByID {
+func (x *EtcdPromoteMemberResponse) GetMessages() []*EtcdPromoteMember {
 	if x != nil {
 		return x.Messages
 	}
@@ -8030,7 +12089,7 @@ type EtcdForfeitLeadershipRequest struct {
 
 func (x *EtcdForfeitLeadershipRequest) Reset() {
 	*x = EtcdForfeitLeadershipRequest{}
-	mi := &file_machine_machine_proto_msgTypes[113]
+	mi := &file_machine_machine_proto_msgTypes[180]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8042,7 +12101,7 @@ func (x *EtcdForfeitLeadershipRequest) String() string {
 func (*EtcdForfeitLeadershipRequest) ProtoMessage() {}
 
 func (x *EtcdForfeitLeadershipRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[113]
+	mi := &file_machine_machine_proto_msgTypes[180]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8055,7 +12114,7 @@ func (x *EtcdForfeitLeadershipRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdForfeitLeadershipRequest.ProtoReflect.Descriptor instead.
 func (*EtcdForfeitLeadershipRequest) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{113}
+	return file_machine_machine_proto_rawDescGZIP(), []int{180}
 }
 
 type EtcdForfeitLeadership struct {
@@ -8068,7 +12127,7 @@ type EtcdForfeitLeadership struct {
 
 func (x *EtcdForfeitLeadership) Reset() {
 	*x = EtcdForfeitLeadership{}
-	mi := &file_machine_machine_proto_msgTypes[114]
+	mi := &file_machine_machine_proto_msgTypes[181]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8080,7 +12139,7 @@ func (x *EtcdForfeitLeadership) String() string {
 func (*EtcdForfeitLeadership) ProtoMessage() {}
 
 func (x *EtcdForfeitLeadership) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[114]
+	mi := &file_machine_machine_proto_msgTypes[181]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8093,7 +12152,7 @@ func (x *EtcdForfeitLeadership) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdForfeitLeadership.ProtoReflect.Descriptor instead.
 func (*EtcdForfeitLeadership) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{114}
+	return file_machine_machine_proto_rawDescGZIP(), []int{181}
 }
 
 func (x *EtcdForfeitLeadership) GetMetadata() *common.Metadata {
@@ -8119,7 +12178,7 @@ type EtcdForfeitLeadershipResponse struct {
 
 func (x *EtcdForfeitLeadershipResponse) Reset() {
 	*x = EtcdForfeitLeadershipResponse{}
-	mi := &file_machine_machine_proto_msgTypes[115]
+	mi := &file_machine_machine_proto_msgTypes[182]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8131,7 +12190,7 @@ func (x *EtcdForfeitLeadershipResponse) String() string {
 func (*EtcdForfeitLeadershipResponse) ProtoMessage() {}
 
 func (x *EtcdForfeitLeadershipResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[115]
+	mi := &file_machine_machine_proto_msgTypes[182]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8144,7 +12203,7 @@ func (x *EtcdForfeitLeadershipResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdForfeitLeadershipResponse.ProtoReflect.Descriptor instead.
 func (*EtcdForfeitLeadershipResponse) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{115}
+	return file_machine_machine_proto_rawDescGZIP(), []int{182}
 }
 
 func (x *EtcdForfeitLeadershipResponse) GetMessages() []*EtcdForfeitLeadership {
@@ -8163,7 +12222,7 @@ type EtcdMemberListRequest struct {
 
 func (x *EtcdMemberListRequest) Reset() {
 	*x = EtcdMemberListRequest{}
-	mi := &file_machine_machine_proto_msgTypes[116]
+	mi := &file_machine_machine_proto_msgTypes[183]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8175,7 +12234,7 @@ func (x *EtcdMemberListRequest) String() string {
 func (*EtcdMemberListRequest) ProtoMessage() {}
 
 func (x *EtcdMemberListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[116]
+	mi := &file_machine_machine_proto_msgTypes[183]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8188,7 +12247,7 @@ func (x *EtcdMemberListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdMemberListRequest.ProtoReflect.Descriptor instead.
 func (*EtcdMemberListRequest) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{116}
+	return file_machine_machine_proto_rawDescGZIP(), []int{183}
 }
 
 func (x *EtcdMemberListRequest) GetQueryLocal() bool {
@@ -8217,7 +12276,7 @@ type EtcdMember struct {
 
 func (x *EtcdMember) Reset() {
 	*x = EtcdMember{}
-	mi := &file_machine_machine_proto_msgTypes[117]
+	mi := &file_machine_machine_proto_msgTypes[184]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8229,7 +12288,7 @@ func (x *EtcdMember) String() string {
 func (*EtcdMember) ProtoMessage() {}
 
 func (x *EtcdMember) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[117]
+	mi := &file_machine_machine_proto_msgTypes[184]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8242,7 +12301,7 @@ func (x *EtcdMember) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdMember.ProtoReflect.Descriptor instead.
 func (*EtcdMember) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{117}
+	return file_machine_machine_proto_rawDescGZIP(), []int{184}
 }
 
 func (x *EtcdMember) GetId() uint64 {
@@ -8294,7 +12353,7 @@ type EtcdMembers struct {
 
 func (x *EtcdMembers) Reset() {
 	*x = EtcdMembers{}
-	mi := &file_machine_machine_proto_msgTypes[118]
+	mi := &file_machine_machine_proto_msgTypes[185]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8306,7 +12365,7 @@ func (x *EtcdMembers) String() string {
 func (*EtcdMembers) ProtoMessage() {}
 
 func (x *EtcdMembers) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[118]
+	mi := &file_machine_machine_proto_msgTypes[185]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8319,7 +12378,7 @@ func (x *EtcdMembers) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdMembers.ProtoReflect.Descriptor instead.
 func (*EtcdMembers) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{118}
+	return file_machine_machine_proto_rawDescGZIP(), []int{185}
 }
 
 func (x *EtcdMembers) GetMetadata() *common.Metadata {
@@ -8352,7 +12411,7 @@ type EtcdMemberListResponse struct {
 
 func (x *EtcdMemberListResponse) Reset() {
 	*x = EtcdMemberListResponse{}
-	mi := &file_machine_machine_proto_msgTypes[119]
+	mi := &file_machine_machine_proto_msgTypes[186]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8364,7 +12423,7 @@ func (x *EtcdMemberListResponse) String() string {
 func (*EtcdMemberListResponse) ProtoMessage() {}
 
 func (x *EtcdMemberListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[119]
+	mi := &file_machine_machine_proto_msgTypes[186]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8377,7 +12436,7 @@ func (x *EtcdMemberListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdMemberListResponse.ProtoReflect.Descriptor instead.
 func (*EtcdMemberListResponse) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{119}
+	return file_machine_machine_proto_rawDescGZIP(), []int{186}
 }
 
 func (x *EtcdMemberListResponse) GetMessages() []*EtcdMembers {
@@ -8395,7 +12454,7 @@ type EtcdSnapshotRequest struct {
 
 func (x *EtcdSnapshotRequest) Reset() {
 	*x = EtcdSnapshotRequest{}
-	mi := &file_machine_machine_proto_msgTypes[120]
+	mi := &file_machine_machine_proto_msgTypes[187]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8407,7 +12466,7 @@ func (x *EtcdSnapshotRequest) String() string {
 func (*EtcdSnapshotRequest) ProtoMessage() {}
 
 func (x *EtcdSnapshotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[120]
+	mi := &file_machine_machine_proto_msgTypes[187]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8420,7 +12479,7 @@ func (x *EtcdSnapshotRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdSnapshotRequest.ProtoReflect.Descriptor instead.
 func (*EtcdSnapshotRequest) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{120}
+	return file_machine_machine_proto_rawDescGZIP(), []int{187}
 }
 
 type EtcdRecover struct {
@@ -8432,7 +12491,7 @@ type EtcdRecover struct {
 
 func (x *EtcdRecover) Reset() {
 	*x = EtcdRecover{}
-	mi := &file_machine_machine_proto_msgTypes[121]
+	mi := &file_machine_machine_proto_msgTypes[188]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8444,7 +12503,7 @@ func (x *EtcdRecover) String() string {
 func (*EtcdRecover) ProtoMessage() {}
 
 func (x *EtcdRecover) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[121]
+	mi := &file_machine_machine_proto_msgTypes[188]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8457,7 +12516,7 @@ func (x *EtcdRecover) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdRecover.ProtoReflect.Descriptor instead.
 func (*EtcdRecover) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{121}
+	return file_machine_machine_proto_rawDescGZIP(), []int{188}
 }
 
 func (x *EtcdRecover) GetMetadata() *common.Metadata {
@@ -8476,7 +12535,7 @@ type EtcdRecoverResponse struct {
 
 func (x *EtcdRecoverResponse) Reset() {
 	*x = EtcdRecoverResponse{}
-	mi := &file_machine_machine_proto_msgTypes[122]
+	mi := &file_machine_machine_proto_msgTypes[189]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8488,7 +12547,7 @@ func (x *EtcdRecoverResponse) String() string {
 func (*EtcdRecoverResponse) ProtoMessage() {}
 
 func (x *EtcdRecoverResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[122]
+	mi := &file_machine_machine_proto_msgTypes[189]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8501,7 +12560,7 @@ func (x *EtcdRecoverResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdRecoverResponse.ProtoReflect.Descriptor instead.
 func (*EtcdRecoverResponse) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{122}
+	return file_machine_machine_proto_rawDescGZIP(), []int{189}
 }
 
 func (x *EtcdRecoverResponse) GetMessages() []*EtcdRecover {
@@ -8520,7 +12579,7 @@ type EtcdAlarmListResponse struct {
 
 func (x *EtcdAlarmListResponse) Reset() {
 	*x = EtcdAlarmListResponse{}
-	mi := &file_machine_machine_proto_msgTypes[123]
+	mi := &file_machine_machine_proto_msgTypes[190]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8532,7 +12591,7 @@ func (x *EtcdAlarmListResponse) String() string {
 func (*EtcdAlarmListResponse) ProtoMessage() {}
 
 func (x *EtcdAlarmListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[123]
+	mi := &file_machine_machine_proto_msgTypes[190]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8545,7 +12604,7 @@ func (x *EtcdAlarmListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdAlarmListResponse.ProtoReflect.Descriptor instead.
 func (*EtcdAlarmListResponse) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{123}
+	return file_machine_machine_proto_rawDescGZIP(), []int{190}
 }
 
 func (x *EtcdAlarmListResponse) GetMessages() []*EtcdAlarm {
@@ -8565,7 +12624,7 @@ type EtcdAlarm struct {
 
 func (x *EtcdAlarm) Reset() {
 	*x = EtcdAlarm{}
-	mi := &file_machine_machine_proto_msgTypes[124]
+	mi := &file_machine_machine_proto_msgTypes[191]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8577,7 +12636,7 @@ func (x *EtcdAlarm) String() string {
 func (*EtcdAlarm) ProtoMessage() {}
 
 func (x *EtcdAlarm) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[124]
+	mi := &file_machine_machine_proto_msgTypes[191]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8590,7 +12649,7 @@ func (x *EtcdAlarm) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdAlarm.ProtoReflect.Descriptor instead.
 func (*EtcdAlarm) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{124}
+	return file_machine_machine_proto_rawDescGZIP(), []int{191}
 }
 
 func (x *EtcdAlarm) GetMetadata() *common.Metadata {
@@ -8617,7 +12676,7 @@ type EtcdMemberAlarm struct {
 
 func (x *EtcdMemberAlarm) Reset() {
 	*x = EtcdMemberAlarm{}
-	mi := &file_machine_machine_proto_msgTypes[125]
+	mi := &file_machine_machine_proto_msgTypes[192]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8629,7 +12688,7 @@ func (x *EtcdMemberAlarm) String() string {
 func (*EtcdMemberAlarm) ProtoMessage() {}
 
 func (x *EtcdMemberAlarm) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[125]
+	mi := &file_machine_machine_proto_msgTypes[192]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8642,7 +12701,7 @@ func (x *EtcdMemberAlarm) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdMemberAlarm.ProtoReflect.Descriptor instead.
 func (*EtcdMemberAlarm) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{125}
+	return file_machine_machine_proto_rawDescGZIP(), []int{192}
 }
 
 func (x *EtcdMemberAlarm) GetMemberId() uint64 {
@@ -8668,7 +12727,7 @@ type EtcdAlarmDisarmResponse struct {
 
 func (x *EtcdAlarmDisarmResponse) Reset() {
 	*x = EtcdAlarmDisarmResponse{}
-	mi := &file_machine_machine_proto_msgTypes[126]
+	mi := &file_machine_machine_proto_msgTypes[193]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8680,7 +12739,7 @@ func (x *EtcdAlarmDisarmResponse) String() string {
 func (*EtcdAlarmDisarmResponse) ProtoMessage() {}
 
 func (x *EtcdAlarmDisarmResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[126]
+	mi := &file_machine_machine_proto_msgTypes[193]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8693,7 +12752,7 @@ func (x *EtcdAlarmDisarmResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdAlarmDisarmResponse.ProtoReflect.Descriptor instead.
 func (*EtcdAlarmDisarmResponse) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{126}
+	return file_machine_machine_proto_rawDescGZIP(), []int{193}
 }
 
 func (x *EtcdAlarmDisarmResponse) GetMessages() []*EtcdAlarmDisarm {
@@ -8713,7 +12772,7 @@ type EtcdAlarmDisarm struct {
 
 func (x *EtcdAlarmDisarm) Reset() {
 	*x = EtcdAlarmDisarm{}
-	mi := &file_machine_machine_proto_msgTypes[127]
+	mi := &file_machine_machine_proto_msgTypes[194]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8725,7 +12784,7 @@ func (x *EtcdAlarmDisarm) String() string {
 func (*EtcdAlarmDisarm) ProtoMessage() {}
 
 func (x *EtcdAlarmDisarm) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[127]
+	mi := &file_machine_machine_proto_msgTypes[194]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8738,7 +12797,7 @@ func (x *EtcdAlarmDisarm) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdAlarmDisarm.ProtoReflect.Descriptor instead.
 func (*EtcdAlarmDisarm) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{127}
+	return file_machine_machine_proto_rawDescGZIP(), []int{194}
 }
 
 func (x *EtcdAlarmDisarm) GetMetadata() *common.Metadata {
@@ -8764,7 +12823,7 @@ type EtcdDefragmentResponse struct {
 
 func (x *EtcdDefragmentResponse) Reset() {
 	*x = EtcdDefragmentResponse{}
-	mi := &file_machine_machine_proto_msgTypes[128]
+	mi := &file_machine_machine_proto_msgTypes[195]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8776,7 +12835,7 @@ func (x *EtcdDefragmentResponse) String() string {
 func (*EtcdDefragmentResponse) ProtoMessage() {}
 
 func (x *EtcdDefragmentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[128]
+	mi := &file_machine_machine_proto_msgTypes[195]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8789,7 +12848,7 @@ func (x *EtcdDefragmentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdDefragmentResponse.ProtoReflect.Descriptor instead.
 func (*EtcdDefragmentResponse) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{128}
+	return file_machine_machine_proto_rawDescGZIP(), []int{195}
 }
 
 func (x *EtcdDefragmentResponse) GetMessages() []*EtcdDefragment {
@@ -8808,7 +12867,7 @@ type EtcdDefragment struct {
 
 func (x *EtcdDefragment) Reset() {
 	*x = EtcdDefragment{}
-	mi := &file_machine_machine_proto_msgTypes[129]
+	mi := &file_machine_machine_proto_msgTypes[196]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8820,7 +12879,7 @@ func (x *EtcdDefragment) String() string {
 func (*EtcdDefragment) ProtoMessage() {}
 
 func (x *EtcdDefragment) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[129]
+	mi := &file_machine_machine_proto_msgTypes[196]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8833,7 +12892,7 @@ func (x *EtcdDefragment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdDefragment.ProtoReflect.Descriptor instead.
 func (*EtcdDefragment) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{129}
+	return file_machine_machine_proto_rawDescGZIP(), []int{196}
 }
 
 func (x *EtcdDefragment) GetMetadata() *common.Metadata {
@@ -8852,7 +12911,7 @@ type EtcdStatusResponse struct {
 
 func (x *EtcdStatusResponse) Reset() {
 	*x = EtcdStatusResponse{}
-	mi := &file_machine_machine_proto_msgTypes[130]
+	mi := &file_machine_machine_proto_msgTypes[197]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8864,7 +12923,7 @@ func (x *EtcdStatusResponse) String() string {
 func (*EtcdStatusResponse) ProtoMessage() {}
 
 func (x *EtcdStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[130]
+	mi := &file_machine_machine_proto_msgTypes[197]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8877,7 +12936,7 @@ func (x *EtcdStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdStatusResponse.ProtoReflect.Descriptor instead.
 func (*EtcdStatusResponse) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{130}
+	return file_machine_machine_proto_rawDescGZIP(), []int{197}
 }
 
 func (x *EtcdStatusResponse) GetMessages() []*EtcdStatus {
@@ -8897,7 +12956,7 @@ type EtcdStatus struct {
 
 func (x *EtcdStatus) Reset() {
 	*x = EtcdStatus{}
-	mi := &file_machine_machine_proto_msgTypes[131]
+	mi := &file_machine_machine_proto_msgTypes[198]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8909,7 +12968,7 @@ func (x *EtcdStatus) String() string {
 func (*EtcdStatus) ProtoMessage() {}
 
 func (x *EtcdStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[131]
+	mi := &file_machine_machine_proto_msgTypes[198]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8922,7 +12981,7 @@ func (x *EtcdStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdStatus.ProtoReflect.Descriptor instead.
 func (*EtcdStatus) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{131}
+	return file_machine_machine_proto_rawDescGZIP(), []int{198}
 }
 
 func (x *EtcdStatus) GetMetadata() *common.Metadata {
@@ -8958,7 +13017,7 @@ type EtcdMemberStatus struct {
 
 func (x *EtcdMemberStatus) Reset() {
 	*x = EtcdMemberStatus{}
-	mi := &file_machine_machine_proto_msgTypes[132]
+	mi := &file_machine_machine_proto_msgTypes[199]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8970,7 +13029,7 @@ func (x *EtcdMemberStatus) String() string {
 func (*EtcdMemberStatus) ProtoMessage() {}
 
 func (x *EtcdMemberStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[132]
+	mi := &file_machine_machine_proto_msgTypes[199]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8983,7 +13042,7 @@ func (x *EtcdMemberStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdMemberStatus.ProtoReflect.Descriptor instead.
 func (*EtcdMemberStatus) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{132}
+	return file_machine_machine_proto_rawDescGZIP(), []int{199}
 }
 
 func (x *EtcdMemberStatus) GetStorageVersion() string {
@@ -9072,7 +13131,7 @@ type EtcdDowngradeValidateRequest struct {
 
 func (x *EtcdDowngradeValidateRequest) Reset() {
 	*x = EtcdDowngradeValidateRequest{}
-	mi := &file_machine_machine_proto_msgTypes[133]
+	mi := &file_machine_machine_proto_msgTypes[200]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9084,7 +13143,7 @@ func (x *EtcdDowngradeValidateRequest) String() string {
 func (*EtcdDowngradeValidateRequest) ProtoMessage() {}
 
 func (x *EtcdDowngradeValidateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[133]
+	mi := &file_machine_machine_proto_msgTypes[200]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9097,7 +13156,7 @@ func (x *EtcdDowngradeValidateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdDowngradeValidateRequest.ProtoReflect.Descriptor instead.
 func (*EtcdDowngradeValidateRequest) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{133}
+	return file_machine_machine_proto_rawDescGZIP(), []int{200}
 }
 
 func (x *EtcdDowngradeValidateRequest) GetVersion() string {
@@ -9116,7 +13175,7 @@ type EtcdDowngradeValidateResponse struct {
 
 func (x *EtcdDowngradeValidateResponse) Reset() {
 	*x = EtcdDowngradeValidateResponse{}
-	mi := &file_machine_machine_proto_msgTypes[134]
+	mi := &file_machine_machine_proto_msgTypes[201]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9128,7 +13187,7 @@ func (x *EtcdDowngradeValidateResponse) String() string {
 func (*EtcdDowngradeValidateResponse) ProtoMessage() {}
 
 func (x *EtcdDowngradeValidateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[134]
+	mi := &file_machine_machine_proto_msgTypes[201]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9141,7 +13200,7 @@ func (x *EtcdDowngradeValidateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdDowngradeValidateResponse.ProtoReflect.Descriptor instead.
 func (*EtcdDowngradeValidateResponse) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{134}
+	return file_machine_machine_proto_rawDescGZIP(), []int{201}
 }
 
 func (x *EtcdDowngradeValidateResponse) GetMessages() []*EtcdDowngradeValidate {
@@ -9161,7 +13220,7 @@ type EtcdDowngradeValidate struct {
 
 func (x *EtcdDowngradeValidate) Reset() {
 	*x = EtcdDowngradeValidate{}
-	mi := &file_machine_machine_proto_msgTypes[135]
+	mi := &file_machine_machine_proto_msgTypes[202]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9173,7 +13232,7 @@ func (x *EtcdDowngradeValidate) String() string {
 func (*EtcdDowngradeValidate) ProtoMessage() {}
 
 func (x *EtcdDowngradeValidate) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[135]
+	mi := &file_machine_machine_proto_msgTypes[202]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9186,7 +13245,7 @@ func (x *EtcdDowngradeValidate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdDowngradeValidate.ProtoReflect.Descriptor instead.
 func (*EtcdDowngradeValidate) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{135}
+	return file_machine_machine_proto_rawDescGZIP(), []int{202}
 }
 
 func (x *EtcdDowngradeValidate) GetMetadata() *common.Metadata {
@@ -9212,7 +13271,7 @@ type EtcdDowngradeEnableRequest struct {
 
 func (x *EtcdDowngradeEnableRequest) Reset() {
 	*x = EtcdDowngradeEnableRequest{}
-	mi := &file_machine_machine_proto_msgTypes[136]
+	mi := &file_machine_machine_proto_msgTypes[203]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9224,7 +13283,7 @@ func (x *EtcdDowngradeEnableRequest) String() string {
 func (*EtcdDowngradeEnableRequest) ProtoMessage() {}
 
 func (x *EtcdDowngradeEnableRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[136]
+	mi := &file_machine_machine_proto_msgTypes[203]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9237,7 +13296,7 @@ func (x *EtcdDowngradeEnableRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdDowngradeEnableRequest.ProtoReflect.Descriptor instead.
 func (*EtcdDowngradeEnableRequest) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{136}
+	return file_machine_machine_proto_rawDescGZIP(), []int{203}
 }
 
 func (x *EtcdDowngradeEnableRequest) GetVersion() string {
@@ -9256,7 +13315,7 @@ type EtcdDowngradeEnableResponse struct {
 
 func (x *EtcdDowngradeEnableResponse) Reset() {
 	*x = EtcdDowngradeEnableResponse{}
-	mi := &file_machine_machine_proto_msgTypes[137]
+	mi := &file_machine_machine_proto_msgTypes[204]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9268,7 +13327,7 @@ func (x *EtcdDowngradeEnableResponse) String() string {
 func (*EtcdDowngradeEnableResponse) ProtoMessage() {}
 
 func (x *EtcdDowngradeEnableResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[137]
+	mi := &file_machine_machine_proto_msgTypes[204]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9281,7 +13340,7 @@ func (x *EtcdDowngradeEnableResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdDowngradeEnableResponse.ProtoReflect.Descriptor instead.
 func (*EtcdDowngradeEnableResponse) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{137}
+	return file_machine_machine_proto_rawDescGZIP(), []int{204}
 }
 
 func (x *EtcdDowngradeEnableResponse) GetMessages() []*EtcdDowngradeEnable {
@@ -9301,7 +13360,7 @@ type EtcdDowngradeEnable struct {
 
 func (x *EtcdDowngradeEnable) Reset() {
 	*x = EtcdDowngradeEnable{}
-	mi := &file_machine_machine_proto_msgTypes[138]
+	mi := &file_machine_machine_proto_msgTypes[205]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9313,7 +13372,7 @@ func (x *EtcdDowngradeEnable) String() string {
 func (*EtcdDowngradeEnable) ProtoMessage() {}
 
 func (x *EtcdDowngradeEnable) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[138]
+	mi := &file_machine_machine_proto_msgTypes[205]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9326,7 +13385,7 @@ func (x *EtcdDowngradeEnable) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdDowngradeEnable.ProtoReflect.Descriptor instead.
 func (*EtcdDowngradeEnable) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{138}
+	return file_machine_machine_proto_rawDescGZIP(), []int{205}
 }
 
 func (x *EtcdDowngradeEnable) GetMetadata() *common.Metadata {
@@ -9352,7 +13411,7 @@ type EtcdDowngradeCancelResponse struct {
 
 func (x *EtcdDowngradeCancelResponse) Reset() {
 	*x = EtcdDowngradeCancelResponse{}
-	mi := &file_machine_machine_proto_msgTypes[139]
+	mi := &file_machine_machine_proto_msgTypes[206]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9364,7 +13423,7 @@ func (x *EtcdDowngradeCancelResponse) String() string {
 func (*EtcdDowngradeCancelResponse) ProtoMessage() {}
 
 func (x *EtcdDowngradeCancelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[139]
+	mi := &file_machine_machine_proto_msgTypes[206]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9377,7 +13436,7 @@ func (x *EtcdDowngradeCancelResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdDowngradeCancelResponse.ProtoReflect.Descriptor instead.
 func (*EtcdDowngradeCancelResponse) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{139}
+	return file_machine_machine_proto_rawDescGZIP(), []int{206}
 }
 
 func (x *EtcdDowngradeCancelResponse) GetMessages() []*EtcdDowngradeCancel {
@@ -9397,7 +13456,7 @@ type EtcdDowngradeCancel struct {
 
 func (x *EtcdDowngradeCancel) Reset() {
 	*x = EtcdDowngradeCancel{}
-	mi := &file_machine_machine_proto_msgTypes[140]
+	mi := &file_machine_machine_proto_msgTypes[207]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9409,7 +13468,7 @@ func (x *EtcdDowngradeCancel) String() string {
 func (*EtcdDowngradeCancel) ProtoMessage() {}
 
 func (x *EtcdDowngradeCancel) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[140]
+	mi := &file_machine_machine_proto_msgTypes[207]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9422,7 +13481,7 @@ func (x *EtcdDowngradeCancel) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdDowngradeCancel.ProtoReflect.Descriptor instead.
 func (*EtcdDowngradeCancel) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{140}
+	return file_machine_machine_proto_rawDescGZIP(), []int{207}
 }
 
 func (x *EtcdDowngradeCancel) GetMetadata() *common.Metadata {
@@ -9448,7 +13507,7 @@ type EtcdClusterDowngrade struct {
 
 func (x *EtcdClusterDowngrade) Reset() {
 	*x = EtcdClusterDowngrade{}
-	mi := &file_machine_machine_proto_msgTypes[141]
+	mi := &file_machine_machine_proto_msgTypes[208]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9460,7 +13519,7 @@ func (x *EtcdClusterDowngrade) String() string {
 func (*EtcdClusterDowngrade) ProtoMessage() {}
 
 func (x *EtcdClusterDowngrade) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[141]
+	mi := &file_machine_machine_proto_msgTypes[208]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9473,7 +13532,7 @@ func (x *EtcdClusterDowngrade) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdClusterDowngrade.ProtoReflect.Descriptor instead.
 func (*EtcdClusterDowngrade) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{141}
+	return file_machine_machine_proto_rawDescGZIP(), []int{208}
 }
 
 func (x *EtcdClusterDowngrade) GetClusterVersion() string {
@@ -9494,7 +13553,7 @@ type RouteConfig struct {
 
 func (x *RouteConfig) Reset() {
 	*x = RouteConfig{}
-	mi := &file_machine_machine_proto_msgTypes[142]
+	mi := &file_machine_machine_proto_msgTypes[209]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9506,7 +13565,7 @@ func (x *RouteConfig) String() string {
 func (*RouteConfig) ProtoMessage() {}
 
 func (x *RouteConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[142]
+	mi := &file_machine_machine_proto_msgTypes[209]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9519,7 +13578,7 @@ func (x *RouteConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RouteConfig.ProtoReflect.Descriptor instead.
 func (*RouteConfig) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{142}
+	return file_machine_machine_proto_rawDescGZIP(), []int{209}
 }
 
 func (x *RouteConfig) GetNetwork() string {
@@ -9552,7 +13611,7 @@ type DHCPOptionsConfig struct {
 
 func (x *DHCPOptionsConfig) Reset() {
 	*x = DHCPOptionsConfig{}
-	mi := &file_machine_machine_proto_msgTypes[143]
+	mi := &file_machine_machine_proto_msgTypes[210]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9564,7 +13623,7 @@ func (x *DHCPOptionsConfig) String() string {
 func (*DHCPOptionsConfig) ProtoMessage() {}
 
 func (x *DHCPOptionsConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[143]
+	mi := &file_machine_machine_proto_msgTypes[210]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9577,7 +13636,7 @@ func (x *DHCPOptionsConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DHCPOptionsConfig.ProtoReflect.Descriptor instead.
 func (*DHCPOptionsConfig) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{143}
+	return file_machine_machine_proto_rawDescGZIP(), []int{210}
 }
 
 func (x *DHCPOptionsConfig) GetRouteMetric() uint32 {
@@ -9602,7 +13661,7 @@ type NetworkDeviceConfig struct {
 
 func (x *NetworkDeviceConfig) Reset() {
 	*x = NetworkDeviceConfig{}
-	mi := &file_machine_machine_proto_msgTypes[144]
+	mi := &file_machine_machine_proto_msgTypes[211]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9614,7 +13673,7 @@ func (x *NetworkDeviceConfig) String() string {
 func (*NetworkDeviceConfig) ProtoMessage() {}
 
 func (x *NetworkDeviceConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[144]
+	mi := &file_machine_machine_proto_msgTypes[211]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9627,7 +13686,7 @@ func (x *NetworkDeviceConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NetworkDeviceConfig.ProtoReflect.Descriptor instead.
 func (*NetworkDeviceConfig) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{144}
+	return file_machine_machine_proto_rawDescGZIP(), []int{211}
 }
 
 func (x *NetworkDeviceConfig) GetInterface() string {
@@ -9689,7 +13748,7 @@ type NetworkConfig struct {
 
 func (x *NetworkConfig) Reset() {
 	*x = NetworkConfig{}
-	mi := &file_machine_machine_proto_msgTypes[145]
+	mi := &file_machine_machine_proto_msgTypes[212]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9701,7 +13760,7 @@ func (x *NetworkConfig) String() string {
 func (*NetworkConfig) ProtoMessage() {}
 
 func (x *NetworkConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[145]
+	mi := &file_machine_machine_proto_msgTypes[212]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9714,7 +13773,7 @@ func (x *NetworkConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NetworkConfig.ProtoReflect.Descriptor instead.
 func (*NetworkConfig) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{145}
+	return file_machine_machine_proto_rawDescGZIP(), []int{212}
 }
 
 func (x *NetworkConfig) GetHostname() string {
@@ -9741,7 +13800,7 @@ type InstallConfig struct {
 
 func (x *InstallConfig) Reset() {
 	*x = InstallConfig{}
-	mi := &file_machine_machine_proto_msgTypes[146]
+	mi := &file_machine_machine_proto_msgTypes[213]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9753,7 +13812,7 @@ func (x *InstallConfig) String() string {
 func (*InstallConfig) ProtoMessage() {}
 
 func (x *InstallConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[146]
+	mi := &file_machine_machine_proto_msgTypes[213]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9766,7 +13825,7 @@ func (x *InstallConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InstallConfig.ProtoReflect.Descriptor instead.
 func (*InstallConfig) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{146}
+	return file_machine_machine_proto_rawDescGZIP(), []int{213}
 }
 
 func (x *InstallConfig) GetInstallDisk() string {
@@ -9795,7 +13854,7 @@ type MachineConfig struct {
 
 func (x *MachineConfig) Reset() {
 	*x = MachineConfig{}
-	mi := &file_machine_machine_proto_msgTypes[147]
+	mi := &file_machine_machine_proto_msgTypes[214]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9807,7 +13866,7 @@ func (x *MachineConfig) String() string {
 func (*MachineConfig) ProtoMessage() {}
 
 func (x *MachineConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[147]
+	mi := &file_machine_machine_proto_msgTypes[214]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9820,7 +13879,7 @@ func (x *MachineConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MachineConfig.ProtoReflect.Descriptor instead.
 func (*MachineConfig) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{147}
+	return file_machine_machine_proto_rawDescGZIP(), []int{214}
 }
 
 func (x *MachineConfig) GetType() MachineConfig_MachineType {
@@ -9860,7 +13919,7 @@ type ControlPlaneConfig struct {
 
 func (x *ControlPlaneConfig) Reset() {
 	*x = ControlPlaneConfig{}
-	mi := &file_machine_machine_proto_msgTypes[148]
+	mi := &file_machine_machine_proto_msgTypes[215]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9872,7 +13931,7 @@ func (x *ControlPlaneConfig) String() string {
 func (*ControlPlaneConfig) ProtoMessage() {}
 
 func (x *ControlPlaneConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[148]
+	mi := &file_machine_machine_proto_msgTypes[215]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9885,7 +13944,7 @@ func (x *ControlPlaneConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ControlPlaneConfig.ProtoReflect.Descriptor instead.
 func (*ControlPlaneConfig) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{148}
+	return file_machine_machine_proto_rawDescGZIP(), []int{215}
 }
 
 func (x *ControlPlaneConfig) GetEndpoint() string {
@@ -9905,7 +13964,7 @@ type CNIConfig struct {
 
 func (x *CNIConfig) Reset() {
 	*x = CNIConfig{}
-	mi := &file_machine_machine_proto_msgTypes[149]
+	mi := &file_machine_machine_proto_msgTypes[216]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9917,7 +13976,7 @@ func (x *CNIConfig) String() string {
 func (*CNIConfig) ProtoMessage() {}
 
 func (x *CNIConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[149]
+	mi := &file_machine_machine_proto_msgTypes[216]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9930,7 +13989,7 @@ func (x *CNIConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CNIConfig.ProtoReflect.Descriptor instead.
 func (*CNIConfig) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{149}
+	return file_machine_machine_proto_rawDescGZIP(), []int{216}
 }
 
 func (x *CNIConfig) GetName() string {
@@ -9957,7 +14016,7 @@ type ClusterNetworkConfig struct {
 
 func (x *ClusterNetworkConfig) Reset() {
 	*x = ClusterNetworkConfig{}
-	mi := &file_machine_machine_proto_msgTypes[150]
+	mi := &file_machine_machine_proto_msgTypes[217]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9969,7 +14028,7 @@ func (x *ClusterNetworkConfig) String() string {
 func (*ClusterNetworkConfig) ProtoMessage() {}
 
 func (x *ClusterNetworkConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[150]
+	mi := &file_machine_machine_proto_msgTypes[217]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9982,7 +14041,7 @@ func (x *ClusterNetworkConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClusterNetworkConfig.ProtoReflect.Descriptor instead.
 func (*ClusterNetworkConfig) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{150}
+	return file_machine_machine_proto_rawDescGZIP(), []int{217}
 }
 
 func (x *ClusterNetworkConfig) GetDnsDomain() string {
@@ -10011,7 +14070,7 @@ type ClusterConfig struct {
 
 func (x *ClusterConfig) Reset() {
 	*x = ClusterConfig{}
-	mi := &file_machine_machine_proto_msgTypes[151]
+	mi := &file_machine_machine_proto_msgTypes[218]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10023,7 +14082,7 @@ func (x *ClusterConfig) String() string {
 func (*ClusterConfig) ProtoMessage() {}
 
 func (x *ClusterConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[151]
+	mi := &file_machine_machine_proto_msgTypes[218]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10036,7 +14095,7 @@ func (x *ClusterConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClusterConfig.ProtoReflect.Descriptor instead.
 func (*ClusterConfig) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{151}
+	return file_machine_machine_proto_rawDescGZIP(), []int{218}
 }
 
 func (x *ClusterConfig) GetName() string {
@@ -10081,7 +14140,7 @@ type GenerateConfigurationRequest struct {
 
 func (x *GenerateConfigurationRequest) Reset() {
 	*x = GenerateConfigurationRequest{}
-	mi := &file_machine_machine_proto_msgTypes[152]
+	mi := &file_machine_machine_proto_msgTypes[219]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10093,7 +14152,7 @@ func (x *GenerateConfigurationRequest) String() string {
 func (*GenerateConfigurationRequest) ProtoMessage() {}
 
 func (x *GenerateConfigurationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[152]
+	mi := &file_machine_machine_proto_msgTypes[219]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10106,7 +14165,7 @@ func (x *GenerateConfigurationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateConfigurationRequest.ProtoReflect.Descriptor instead.
 func (*GenerateConfigurationRequest) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{152}
+	return file_machine_machine_proto_rawDescGZIP(), []int{219}
 }
 
 func (x *GenerateConfigurationRequest) GetConfigVersion() string {
@@ -10149,7 +14208,7 @@ type GenerateConfiguration struct {
 
 func (x *GenerateConfiguration) Reset() {
 	*x = GenerateConfiguration{}
-	mi := &file_machine_machine_proto_msgTypes[153]
+	mi := &file_machine_machine_proto_msgTypes[220]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10161,7 +14220,7 @@ func (x *GenerateConfiguration) String() string {
 func (*GenerateConfiguration) ProtoMessage() {}
 
 func (x *GenerateConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[153]
+	mi := &file_machine_machine_proto_msgTypes[220]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10174,7 +14233,7 @@ func (x *GenerateConfiguration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateConfiguration.ProtoReflect.Descriptor instead.
 func (*GenerateConfiguration) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{153}
+	return file_machine_machine_proto_rawDescGZIP(), []int{220}
 }
 
 func (x *GenerateConfiguration) GetMetadata() *common.Metadata {
@@ -10207,7 +14266,7 @@ type GenerateConfigurationResponse struct {
 
 func (x *GenerateConfigurationResponse) Reset() {
 	*x = GenerateConfigurationResponse{}
-	mi := &file_machine_machine_proto_msgTypes[154]
+	mi := &file_machine_machine_proto_msgTypes[221]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10219,7 +14278,7 @@ func (x *GenerateConfigurationResponse) String() string {
 func (*GenerateConfigurationResponse) ProtoMessage() {}
 
 func (x *GenerateConfigurationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[154]
+	mi := &file_machine_machine_proto_msgTypes[221]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10232,7 +14291,7 @@ func (x *GenerateConfigurationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateConfigurationResponse.ProtoReflect.Descriptor instead.
 func (*GenerateConfigurationResponse) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{154}
+	return file_machine_machine_proto_rawDescGZIP(), []int{221}
 }
 
 func (x *GenerateConfigurationResponse) GetMessages() []*GenerateConfiguration {
@@ -10254,7 +14313,7 @@ type GenerateClientConfigurationRequest struct {
 
 func (x *GenerateClientConfigurationRequest) Reset() {
 	*x = GenerateClientConfigurationRequest{}
-	mi := &file_machine_machine_proto_msgTypes[155]
+	mi := &file_machine_machine_proto_msgTypes[222]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10266,7 +14325,7 @@ func (x *GenerateClientConfigurationRequest) String() string {
 func (*GenerateClientConfigurationRequest) ProtoMessage() {}
 
 func (x *GenerateClientConfigurationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[155]
+	mi := &file_machine_machine_proto_msgTypes[222]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10279,7 +14338,7 @@ func (x *GenerateClientConfigurationRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GenerateClientConfigurationRequest.ProtoReflect.Descriptor instead.
 func (*GenerateClientConfigurationRequest) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{155}
+	return file_machine_machine_proto_rawDescGZIP(), []int{222}
 }
 
 func (x *GenerateClientConfigurationRequest) GetRoles() []string {
@@ -10313,7 +14372,7 @@ type GenerateClientConfiguration struct {
 
 func (x *GenerateClientConfiguration) Reset() {
 	*x = GenerateClientConfiguration{}
-	mi := &file_machine_machine_proto_msgTypes[156]
+	mi := &file_machine_machine_proto_msgTypes[223]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10325,7 +14384,7 @@ func (x *GenerateClientConfiguration) String() string {
 func (*GenerateClientConfiguration) ProtoMessage() {}
 
 func (x *GenerateClientConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[156]
+	mi := &file_machine_machine_proto_msgTypes[223]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10338,7 +14397,7 @@ func (x *GenerateClientConfiguration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateClientConfiguration.ProtoReflect.Descriptor instead.
 func (*GenerateClientConfiguration) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{156}
+	return file_machine_machine_proto_rawDescGZIP(), []int{223}
 }
 
 func (x *GenerateClientConfiguration) GetMetadata() *common.Metadata {
@@ -10385,7 +14444,7 @@ type GenerateClientConfigurationResponse struct {
 
 func (x *GenerateClientConfigurationResponse) Reset() {
 	*x = GenerateClientConfigurationResponse{}
-	mi := &file_machine_machine_proto_msgTypes[157]
+	mi := &file_machine_machine_proto_msgTypes[224]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10397,7 +14456,7 @@ func (x *GenerateClientConfigurationResponse) String() string {
 func (*GenerateClientConfigurationResponse) ProtoMessage() {}
 
 func (x *GenerateClientConfigurationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[157]
+	mi := &file_machine_machine_proto_msgTypes[224]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10410,7 +14469,7 @@ func (x *GenerateClientConfigurationResponse) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use GenerateClientConfigurationResponse.ProtoReflect.Descriptor instead.
 func (*GenerateClientConfigurationResponse) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{157}
+	return file_machine_machine_proto_rawDescGZIP(), []int{224}
 }
 
 func (x *GenerateClientConfigurationResponse) GetMessages() []*GenerateClientConfiguration {
@@ -10436,7 +14495,7 @@ type PacketCaptureRequest struct {
 
 func (x *PacketCaptureRequest) Reset() {
 	*x = PacketCaptureRequest{}
-	mi := &file_machine_machine_proto_msgTypes[158]
+	mi := &file_machine_machine_proto_msgTypes[225]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10448,7 +14507,7 @@ func (x *PacketCaptureRequest) String() string {
 func (*PacketCaptureRequest) ProtoMessage() {}
 
 func (x *PacketCaptureRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[158]
+	mi := &file_machine_machine_proto_msgTypes[225]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10461,7 +14520,7 @@ func (x *PacketCaptureRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PacketCaptureRequest.ProtoReflect.Descriptor instead.
 func (*PacketCaptureRequest) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{158}
+	return file_machine_machine_proto_rawDescGZIP(), []int{225}
 }
 
 func (x *PacketCaptureRequest) GetInterface() string {
@@ -10504,7 +14563,7 @@ type BPFInstruction struct {
 
 func (x *BPFInstruction) Reset() {
 	*x = BPFInstruction{}
-	mi := &file_machine_machine_proto_msgTypes[159]
+	mi := &file_machine_machine_proto_msgTypes[226]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10516,7 +14575,7 @@ func (x *BPFInstruction) String() string {
 func (*BPFInstruction) ProtoMessage() {}
 
 func (x *BPFInstruction) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[159]
+	mi := &file_machine_machine_proto_msgTypes[226]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10529,7 +14588,7 @@ func (x *BPFInstruction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BPFInstruction.ProtoReflect.Descriptor instead.
 func (*BPFInstruction) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{159}
+	return file_machine_machine_proto_rawDescGZIP(), []int{226}
 }
 
 func (x *BPFInstruction) GetOp() uint32 {
@@ -10572,7 +14631,7 @@ type NetstatRequest struct {
 
 func (x *NetstatRequest) Reset() {
 	*x = NetstatRequest{}
-	mi := &file_machine_machine_proto_msgTypes[160]
+	mi := &file_machine_machine_proto_msgTypes[227]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10584,7 +14643,7 @@ func (x *NetstatRequest) String() string {
 func (*NetstatRequest) ProtoMessage() {}
 
 func (x *NetstatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[160]
+	mi := &file_machine_machine_proto_msgTypes[227]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10597,7 +14656,7 @@ func (x *NetstatRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NetstatRequest.ProtoReflect.Descriptor instead.
 func (*NetstatRequest) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{160}
+	return file_machine_machine_proto_rawDescGZIP(), []int{227}
 }
 
 func (x *NetstatRequest) GetFilter() NetstatRequest_Filter {
@@ -10654,7 +14713,7 @@ type ConnectRecord struct {
 
 func (x *ConnectRecord) Reset() {
 	*x = ConnectRecord{}
-	mi := &file_machine_machine_proto_msgTypes[161]
+	mi := &file_machine_machine_proto_msgTypes[228]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10666,7 +14725,7 @@ func (x *ConnectRecord) String() string {
 func (*ConnectRecord) ProtoMessage() {}
 
 func (x *ConnectRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[161]
+	mi := &file_machine_machine_proto_msgTypes[228]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10679,7 +14738,7 @@ func (x *ConnectRecord) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConnectRecord.ProtoReflect.Descriptor instead.
 func (*ConnectRecord) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{161}
+	return file_machine_machine_proto_rawDescGZIP(), []int{228}
 }
 
 func (x *ConnectRecord) GetL4Proto() string {
@@ -10818,7 +14877,7 @@ type Netstat struct {
 
 func (x *Netstat) Reset() {
 	*x = Netstat{}
-	mi := &file_machine_machine_proto_msgTypes[162]
+	mi := &file_machine_machine_proto_msgTypes[229]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10830,7 +14889,7 @@ func (x *Netstat) String() string {
 func (*Netstat) ProtoMessage() {}
 
 func (x *Netstat) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[162]
+	mi := &file_machine_machine_proto_msgTypes[229]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10843,7 +14902,7 @@ func (x *Netstat) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Netstat.ProtoReflect.Descriptor instead.
 func (*Netstat) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{162}
+	return file_machine_machine_proto_rawDescGZIP(), []int{229}
 }
 
 func (x *Netstat) GetMetadata() *common.Metadata {
@@ -10869,7 +14928,7 @@ type NetstatResponse struct {
 
 func (x *NetstatResponse) Reset() {
 	*x = NetstatResponse{}
-	mi := &file_machine_machine_proto_msgTypes[163]
+	mi := &file_machine_machine_proto_msgTypes[230]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10881,7 +14940,7 @@ func (x *NetstatResponse) String() string {
 func (*NetstatResponse) ProtoMessage() {}
 
 func (x *NetstatResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[163]
+	mi := &file_machine_machine_proto_msgTypes[230]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10894,7 +14953,7 @@ func (x *NetstatResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NetstatResponse.ProtoReflect.Descriptor instead.
 func (*NetstatResponse) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{163}
+	return file_machine_machine_proto_rawDescGZIP(), []int{230}
 }
 
 func (x *NetstatResponse) GetMessages() []*Netstat {
@@ -10914,7 +14973,7 @@ type MetaWriteRequest struct {
 
 func (x *MetaWriteRequest) Reset() {
 	*x = MetaWriteRequest{}
-	mi := &file_machine_machine_proto_msgTypes[164]
+	mi := &file_machine_machine_proto_msgTypes[231]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10926,7 +14985,7 @@ func (x *MetaWriteRequest) String() string {
 func (*MetaWriteRequest) ProtoMessage() {}
 
 func (x *MetaWriteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[164]
+	mi := &file_machine_machine_proto_msgTypes[231]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10939,7 +14998,7 @@ func (x *MetaWriteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MetaWriteRequest.ProtoReflect.Descriptor instead.
 func (*MetaWriteRequest) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{164}
+	return file_machine_machine_proto_rawDescGZIP(), []int{231}
 }
 
 func (x *MetaWriteRequest) GetKey() uint32 {
@@ -10965,7 +15024,7 @@ type MetaWrite struct {
 
 func (x *MetaWrite) Reset() {
 	*x = MetaWrite{}
-	mi := &file_machine_machine_proto_msgTypes[165]
+	mi := &file_machine_machine_proto_msgTypes[232]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10977,7 +15036,7 @@ func (x *MetaWrite) String() string {
 func (*MetaWrite) ProtoMessage() {}
 
 func (x *MetaWrite) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[165]
+	mi := &file_machine_machine_proto_msgTypes[232]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10990,7 +15049,7 @@ func (x *MetaWrite) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MetaWrite.ProtoReflect.Descriptor instead.
 func (*MetaWrite) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{165}
+	return file_machine_machine_proto_rawDescGZIP(), []int{232}
 }
 
 func (x *MetaWrite) GetMetadata() *common.Metadata {
@@ -11009,7 +15068,7 @@ type MetaWriteResponse struct {
 
 func (x *MetaWriteResponse) Reset() {
 	*x = MetaWriteResponse{}
-	mi := &file_machine_machine_proto_msgTypes[166]
+	mi := &file_machine_machine_proto_msgTypes[233]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11021,7 +15080,7 @@ func (x *MetaWriteResponse) String() string {
 func (*MetaWriteResponse) ProtoMessage() {}
 
 func (x *MetaWriteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[166]
+	mi := &file_machine_machine_proto_msgTypes[233]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11034,7 +15093,7 @@ func (x *MetaWriteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MetaWriteResponse.ProtoReflect.Descriptor instead.
 func (*MetaWriteResponse) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{166}
+	return file_machine_machine_proto_rawDescGZIP(), []int{233}
 }
 
 func (x *MetaWriteResponse) GetMessages() []*MetaWrite {
@@ -11053,7 +15112,7 @@ type MetaDeleteRequest struct {
 
 func (x *MetaDeleteRequest) Reset() {
 	*x = MetaDeleteRequest{}
-	mi := &file_machine_machine_proto_msgTypes[167]
+	mi := &file_machine_machine_proto_msgTypes[234]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11065,7 +15124,7 @@ func (x *MetaDeleteRequest) String() string {
 func (*MetaDeleteRequest) ProtoMessage() {}
 
 func (x *MetaDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[167]
+	mi := &file_machine_machine_proto_msgTypes[234]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11078,7 +15137,7 @@ func (x *MetaDeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MetaDeleteRequest.ProtoReflect.Descriptor instead.
 func (*MetaDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{167}
+	return file_machine_machine_proto_rawDescGZIP(), []int{234}
 }
 
 func (x *MetaDeleteRequest) GetKey() uint32 {
@@ -11097,7 +15156,7 @@ type MetaDelete struct {
 
 func (x *MetaDelete) Reset() {
 	*x = MetaDelete{}
-	mi := &file_machine_machine_proto_msgTypes[168]
+	mi := &file_machine_machine_proto_msgTypes[235]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11109,7 +15168,7 @@ func (x *MetaDelete) String() string {
 func (*MetaDelete) ProtoMessage() {}
 
 func (x *MetaDelete) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[168]
+	mi := &file_machine_machine_proto_msgTypes[235]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11122,7 +15181,7 @@ func (x *MetaDelete) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MetaDelete.ProtoReflect.Descriptor instead.
 func (*MetaDelete) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{168}
+	return file_machine_machine_proto_rawDescGZIP(), []int{235}
 }
 
 func (x *MetaDelete) GetMetadata() *common.Metadata {
@@ -11141,7 +15200,7 @@ type MetaDeleteResponse struct {
 
 func (x *MetaDeleteResponse) Reset() {
 	*x = MetaDeleteResponse{}
-	mi := &file_machine_machine_proto_msgTypes[169]
+	mi := &file_machine_machine_proto_msgTypes[236]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11153,7 +15212,7 @@ func (x *MetaDeleteResponse) String() string {
 func (*MetaDeleteResponse) ProtoMessage() {}
 
 func (x *MetaDeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[169]
+	mi := &file_machine_machine_proto_msgTypes[236]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11166,7 +15225,7 @@ func (x *MetaDeleteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MetaDeleteResponse.ProtoReflect.Descriptor instead.
 func (*MetaDeleteResponse) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{169}
+	return file_machine_machine_proto_rawDescGZIP(), []int{236}
 }
 
 func (x *MetaDeleteResponse) GetMessages() []*MetaDelete {
@@ -11186,7 +15245,7 @@ type ImageListRequest struct {
 
 func (x *ImageListRequest) Reset() {
 	*x = ImageListRequest{}
-	mi := &file_machine_machine_proto_msgTypes[170]
+	mi := &file_machine_machine_proto_msgTypes[237]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11198,7 +15257,7 @@ func (x *ImageListRequest) String() string {
 func (*ImageListRequest) ProtoMessage() {}
 
 func (x *ImageListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[170]
+	mi := &file_machine_machine_proto_msgTypes[237]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11211,7 +15270,7 @@ func (x *ImageListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImageListRequest.ProtoReflect.Descriptor instead.
 func (*ImageListRequest) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{170}
+	return file_machine_machine_proto_rawDescGZIP(), []int{237}
 }
 
 func (x *ImageListRequest) GetNamespace() common.ContainerdNamespace {
@@ -11234,7 +15293,7 @@ type ImageListResponse struct {
 
 func (x *ImageListResponse) Reset() {
 	*x = ImageListResponse{}
-	mi := &file_machine_machine_proto_msgTypes[171]
+	mi := &file_machine_machine_proto_msgTypes[238]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11246,7 +15305,7 @@ func (x *ImageListResponse) String() string {
 func (*ImageListResponse) ProtoMessage() {}
 
 func (x *ImageListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[171]
+	mi := &file_machine_machine_proto_msgTypes[238]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11259,7 +15318,7 @@ func (x *ImageListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImageListResponse.ProtoReflect.Descriptor instead.
 func (*ImageListResponse) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{171}
+	return file_machine_machine_proto_rawDescGZIP(), []int{238}
 }
 
 func (x *ImageListResponse) GetMetadata() *common.Metadata {
@@ -11309,7 +15368,7 @@ type ImagePullRequest struct {
 
 func (x *ImagePullRequest) Reset() {
 	*x = ImagePullRequest{}
-	mi := &file_machine_machine_proto_msgTypes[172]
+	mi := &file_machine_machine_proto_msgTypes[239]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11321,7 +15380,7 @@ func (x *ImagePullRequest) String() string {
 func (*ImagePullRequest) ProtoMessage() {}
 
 func (x *ImagePullRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[172]
+	mi := &file_machine_machine_proto_msgTypes[239]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11334,7 +15393,7 @@ func (x *ImagePullRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImagePullRequest.ProtoReflect.Descriptor instead.
 func (*ImagePullRequest) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{172}
+	return file_machine_machine_proto_rawDescGZIP(), []int{239}
 }
 
 func (x *ImagePullRequest) GetNamespace() common.ContainerdNamespace {
@@ -11360,7 +15419,7 @@ type ImagePull struct {
 
 func (x *ImagePull) Reset() {
 	*x = ImagePull{}
-	mi := &file_machine_machine_proto_msgTypes[173]
+	mi := &file_machine_machine_proto_msgTypes[240]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11372,7 +15431,7 @@ func (x *ImagePull) String() string {
 func (*ImagePull) ProtoMessage() {}
 
 func (x *ImagePull) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[173]
+	mi := &file_machine_machine_proto_msgTypes[240]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11385,7 +15444,7 @@ func (x *ImagePull) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImagePull.ProtoReflect.Descriptor instead.
 func (*ImagePull) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{173}
+	return file_machine_machine_proto_rawDescGZIP(), []int{240}
 }
 
 func (x *ImagePull) GetMetadata() *common.Metadata {
@@ -11404,7 +15463,7 @@ type ImagePullResponse struct {
 
 func (x *ImagePullResponse) Reset() {
 	*x = ImagePullResponse{}
-	mi := &file_machine_machine_proto_msgTypes[174]
+	mi := &file_machine_machine_proto_msgTypes[241]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11416,7 +15475,7 @@ func (x *ImagePullResponse) String() string {
 func (*ImagePullResponse) ProtoMessage() {}
 
 func (x *ImagePullResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[174]
+	mi := &file_machine_machine_proto_msgTypes[241]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11429,7 +15488,7 @@ func (x *ImagePullResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImagePullResponse.ProtoReflect.Descriptor instead.
 func (*ImagePullResponse) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{174}
+	return file_machine_machine_proto_rawDescGZIP(), []int{241}
 }
 
 func (x *ImagePullResponse) GetMessages() []*ImagePull {
@@ -11449,7 +15508,7 @@ type MachineStatusEvent_MachineStatus struct {
 
 func (x *MachineStatusEvent_MachineStatus) Reset() {
 	*x = MachineStatusEvent_MachineStatus{}
-	mi := &file_machine_machine_proto_msgTypes[175]
+	mi := &file_machine_machine_proto_msgTypes[242]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11461,7 +15520,7 @@ func (x *MachineStatusEvent_MachineStatus) String() string {
 func (*MachineStatusEvent_MachineStatus) ProtoMessage() {}
 
 func (x *MachineStatusEvent_MachineStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[175]
+	mi := &file_machine_machine_proto_msgTypes[242]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11474,7 +15533,7 @@ func (x *MachineStatusEvent_MachineStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MachineStatusEvent_MachineStatus.ProtoReflect.Descriptor instead.
 func (*MachineStatusEvent_MachineStatus) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{17, 0}
+	return file_machine_machine_proto_rawDescGZIP(), []int{41, 0}
 }
 
 func (x *MachineStatusEvent_MachineStatus) GetReady() bool {
@@ -11501,7 +15560,7 @@ type MachineStatusEvent_MachineStatus_UnmetCondition struct {
 
 func (x *MachineStatusEvent_MachineStatus_UnmetCondition) Reset() {
 	*x = MachineStatusEvent_MachineStatus_UnmetCondition{}
-	mi := &file_machine_machine_proto_msgTypes[176]
+	mi := &file_machine_machine_proto_msgTypes[243]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11513,7 +15572,7 @@ func (x *MachineStatusEvent_MachineStatus_UnmetCondition) String() string {
 func (*MachineStatusEvent_MachineStatus_UnmetCondition) ProtoMessage() {}
 
 func (x *MachineStatusEvent_MachineStatus_UnmetCondition) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[176]
+	mi := &file_machine_machine_proto_msgTypes[243]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11526,7 +15585,7 @@ func (x *MachineStatusEvent_MachineStatus_UnmetCondition) ProtoReflect() protore
 
 // Deprecated: Use MachineStatusEvent_MachineStatus_UnmetCondition.ProtoReflect.Descriptor instead.
 func (*MachineStatusEvent_MachineStatus_UnmetCondition) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{17, 0, 0}
+	return file_machine_machine_proto_rawDescGZIP(), []int{41, 0, 0}
 }
 
 func (x *MachineStatusEvent_MachineStatus_UnmetCondition) GetName() string {
@@ -11552,7 +15611,7 @@ type NetstatRequest_Feature struct {
 
 func (x *NetstatRequest_Feature) Reset() {
 	*x = NetstatRequest_Feature{}
-	mi := &file_machine_machine_proto_msgTypes[177]
+	mi := &file_machine_machine_proto_msgTypes[244]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11564,7 +15623,7 @@ func (x *NetstatRequest_Feature) String() string {
 func (*NetstatRequest_Feature) ProtoMessage() {}
 
 func (x *NetstatRequest_Feature) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[177]
+	mi := &file_machine_machine_proto_msgTypes[244]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11577,7 +15636,7 @@ func (x *NetstatRequest_Feature) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NetstatRequest_Feature.ProtoReflect.Descriptor instead.
 func (*NetstatRequest_Feature) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{160, 0}
+	return file_machine_machine_proto_rawDescGZIP(), []int{227, 0}
 }
 
 func (x *NetstatRequest_Feature) GetPid() bool {
@@ -11603,7 +15662,7 @@ type NetstatRequest_L4Proto struct {
 
 func (x *NetstatRequest_L4Proto) Reset() {
 	*x = NetstatRequest_L4Proto{}
-	mi := &file_machine_machine_proto_msgTypes[178]
+	mi := &file_machine_machine_proto_msgTypes[245]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11615,7 +15674,7 @@ func (x *NetstatRequest_L4Proto) String() string {
 func (*NetstatRequest_L4Proto) ProtoMessage() {}
 
 func (x *NetstatRequest_L4Proto) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[178]
+	mi := &file_machine_machine_proto_msgTypes[245]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11628,7 +15687,7 @@ func (x *NetstatRequest_L4Proto) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NetstatRequest_L4Proto.ProtoReflect.Descriptor instead.
 func (*NetstatRequest_L4Proto) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{160, 1}
+	return file_machine_machine_proto_rawDescGZIP(), []int{227, 1}
 }
 
 func (x *NetstatRequest_L4Proto) GetTcp() bool {
@@ -11698,7 +15757,7 @@ type NetstatRequest_NetNS struct {
 
 func (x *NetstatRequest_NetNS) Reset() {
 	*x = NetstatRequest_NetNS{}
-	mi := &file_machine_machine_proto_msgTypes[179]
+	mi := &file_machine_machine_proto_msgTypes[246]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11710,7 +15769,7 @@ func (x *NetstatRequest_NetNS) String() string {
 func (*NetstatRequest_NetNS) ProtoMessage() {}
 
 func (x *NetstatRequest_NetNS) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[179]
+	mi := &file_machine_machine_proto_msgTypes[246]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11723,7 +15782,7 @@ func (x *NetstatRequest_NetNS) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NetstatRequest_NetNS.ProtoReflect.Descriptor instead.
 func (*NetstatRequest_NetNS) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{160, 2}
+	return file_machine_machine_proto_rawDescGZIP(), []int{227, 2}
 }
 
 func (x *NetstatRequest_NetNS) GetHostnetwork() bool {
@@ -11757,7 +15816,7 @@ type ConnectRecord_Process struct {
 
 func (x *ConnectRecord_Process) Reset() {
 	*x = ConnectRecord_Process{}
-	mi := &file_machine_machine_proto_msgTypes[180]
+	mi := &file_machine_machine_proto_msgTypes[247]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11769,7 +15828,7 @@ func (x *ConnectRecord_Process) String() string {
 func (*ConnectRecord_Process) ProtoMessage() {}
 
 func (x *ConnectRecord_Process) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[180]
+	mi := &file_machine_machine_proto_msgTypes[247]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11782,7 +15841,7 @@ func (x *ConnectRecord_Process) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConnectRecord_Process.ProtoReflect.Descriptor instead.
 func (*ConnectRecord_Process) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{161, 0}
+	return file_machine_machine_proto_rawDescGZIP(), []int{228, 0}
 }
 
 func (x *ConnectRecord_Process) GetPid() uint32 {
@@ -11823,9 +15882,27 @@ const file_machine_machine_proto_rawDesc = "" +
 	"\x04mode\x18\x03 \x01(\x0e2'.machine.ApplyConfigurationRequest.ModeR\x04mode\x12!\n" +
 	"\fmode_details\x18\x04 \x01(\tR\vmodeDetails\"U\n" +
 	"\x1aApplyConfigurationResponse\x127\n" +
-	"\bmessages\x18\x01 \x03(\v2\x1b.machine.ApplyConfigurationR\bmessages\"e\n" +
+	"\bmessages\x18\x01 \x03(\v2\x1b.machine.ApplyConfigurationR\bmessages\"T\n" +
+	"\rAttestRequest\x12\x14\n" +
+	"\x05nonce\x18\x01 \x01(\fR\x05nonce\x12\x12\n" +
+	"\x04pcrs\x18\x02 \x03(\x05R\x04pcrs\x12\x19\n" +
+	"\bpcr_bank\x18\x03 \x01(\tR\apcrBank\"<\n" +
+	"\x0eAttestationPCR\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value\"\xf1\x01\n" +
+	"\vAttestation\x12,\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x10.common.MetadataR\bmetadata\x12\x14\n" +
+	"\x05quote\x18\x02 \x01(\fR\x05quote\x12\x1c\n" +
+	"\tsignature\x18\x03 \x01(\fR\tsignature\x12\x1b\n" +
+	"\tak_public\x18\x04 \x01(\fR\bakPublic\x12\x19\n" +
+	"\bpcr_bank\x18\x05 \x01(\tR\apcrBank\x12+\n" +
+	"\x04pcrs\x18\x06 \x03(\v2\x17.machine.AttestationPCRR\x04pcrs\x12\x1b\n" +
+	"\tevent_log\x18\a \x01(\fR\beventLog\"B\n" +
+	"\x0eAttestResponse\x120\n" +
+	"\bmessages\x18\x01 \x03(\v2\x14.machine.AttestationR\bmessages\"\x92\x01\n" +
 	"\rRebootRequest\x12/\n" +
-	"\x04mode\x18\x01 \x01(\x0e2\x1b.machine.RebootRequest.ModeR\x04mode\"#\n" +
+	"\x04mode\x18\x01 \x01(\x0e2\x1b.machine.RebootRequest.ModeR\x04mode\x12+\n" +
+	"\x11ignore_inhibitors\x18\x02 \x01(\bR\x10ignoreInhibitors\"#\n" +
 	"\x04Mode\x12\v\n" +
 	"\aDEFAULT\x10\x00\x12\x0e\n" +
 	"\n" +
@@ -11887,7 +15964,122 @@ const file_machine_machine_proto_rawDesc = "" +
 	"\x05error\x18\x01 \x01(\tR\x05error\"H\n" +
 	"\fAddressEvent\x12\x1a\n" +
 	"\bhostname\x18\x01 \x01(\tR\bhostname\x12\x1c\n" +
-	"\taddresses\x18\x02 \x03(\tR\taddresses\"\xfb\x03\n" +
+	"\taddresses\x18\x02 \x03(\tR\taddresses\"\x81\x01\n" +
+	"\bVIPEvent\x12\x0e\n" +
+	"\x02ip\x18\x01 \x01(\tR\x02ip\x12\x12\n" +
+	"\x04link\x18\x02 \x01(\tR\x04link\x12\x14\n" +
+	"\x05owner\x18\x03 \x01(\tR\x05owner\x12%\n" +
+	"\x0eowner_priority\x18\x04 \x01(\rR\rownerPriority\x12\x14\n" +
+	"\x05owned\x18\x05 \x01(\bR\x05owned\"\x84\x01\n" +
+	"\x13StaticFallbackEvent\x12\x12\n" +
+	"\x04link\x18\x01 \x01(\tR\x04link\x12\x12\n" +
+	"\x04mode\x18\x02 \x01(\tR\x04mode\x12\x18\n" +
+	"\agateway\x18\x03 \x01(\tR\agateway\x12+\n" +
+	"\x11gateway_reachable\x18\x04 \x01(\bR\x10gatewayReachable\"\x88\x01\n" +
+	"\n" +
+	"ProbeEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x03 \x01(\tR\tlastError\x121\n" +
+	"\x14consecutive_failures\x18\x04 \x01(\x03R\x13consecutiveFailures\"\xce\x01\n" +
+	"\x18EtcdDefragmentationEvent\x12\x1b\n" +
+	"\tmember_id\x18\x01 \x01(\tR\bmemberId\x125\n" +
+	"\bduration\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12$\n" +
+	"\x0edb_size_before\x18\x03 \x01(\x03R\fdbSizeBefore\x12\"\n" +
+	"\rdb_size_after\x18\x04 \x01(\x03R\vdbSizeAfter\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"\x82\x01\n" +
+	"\x15EtcdLearnerStuckEvent\x12\x1b\n" +
+	"\tmember_id\x18\x01 \x01(\tR\bmemberId\x12\x10\n" +
+	"\x03lag\x18\x02 \x01(\x04R\x03lag\x12:\n" +
+	"\vlearner_for\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"learnerFor\"\x89\x02\n" +
+	"\x11EtcdSnapshotEvent\x12\x1b\n" +
+	"\tmember_id\x18\x01 \x01(\tR\bmemberId\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x03R\x04size\x12\x16\n" +
+	"\x06sha256\x18\x04 \x01(\tR\x06sha256\x125\n" +
+	"\bduration\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12'\n" +
+	"\x0fupload_location\x18\x06 \x01(\tR\x0euploadLocation\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\x12!\n" +
+	"\fupload_error\x18\b \x01(\tR\vuploadError\"[\n" +
+	"\x0eEtcdAlarmEvent\x12\x1b\n" +
+	"\tmember_id\x18\x01 \x01(\tR\bmemberId\x12\x14\n" +
+	"\x05alarm\x18\x02 \x01(\tR\x05alarm\x12\x16\n" +
+	"\x06active\x18\x03 \x01(\bR\x06active\"\xc4\x01\n" +
+	"\x16CertificateExpiryEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tcomponent\x18\x02 \x01(\tR\tcomponent\x127\n" +
+	"\tnot_after\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bnotAfter\x12%\n" +
+	"\x0edays_remaining\x18\x04 \x01(\x03R\rdaysRemaining\x12\x1c\n" +
+	"\tthreshold\x18\x05 \x01(\x03R\tthreshold\"\xe5\x01\n" +
+	"\x1aStaticPodRestartStormEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bpod_name\x18\x02 \x01(\tR\apodName\x12#\n" +
+	"\rrestart_count\x18\x03 \x01(\x03R\frestartCount\x12'\n" +
+	"\x0frecent_restarts\x18\x04 \x01(\x03R\x0erecentRestarts\x12$\n" +
+	"\x0elast_exit_code\x18\x05 \x01(\x03R\flastExitCode\x12(\n" +
+	"\x10last_exit_reason\x18\x06 \x01(\tR\x0elastExitReason\"\xc7\x01\n" +
+	"\x14KubeletShutdownEvent\x12<\n" +
+	"\fgrace_period\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\vgracePeriod\x12>\n" +
+	"\rwait_duration\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\fwaitDuration\x12\x1b\n" +
+	"\ttimed_out\x18\x03 \x01(\bR\btimedOut\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\x89\x01\n" +
+	"\x15ShutdownImminentEvent\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\tR\bsequence\x12\x1e\n" +
+	"\n" +
+	"inhibitors\x18\x02 \x03(\tR\n" +
+	"inhibitors\x124\n" +
+	"\bmax_wait\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\amaxWait\"\xb0\x01\n" +
+	"\fUpgradeEvent\x12\x14\n" +
+	"\x05image\x18\x01 \x01(\tR\x05image\x12!\n" +
+	"\ffrom_version\x18\x02 \x01(\tR\vfromVersion\x12\x1d\n" +
+	"\n" +
+	"to_version\x18\x03 \x01(\tR\ttoVersion\x12\x14\n" +
+	"\x05phase\x18\x04 \x01(\tR\x05phase\x12\x18\n" +
+	"\aoutcome\x18\x05 \x01(\tR\aoutcome\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\"w\n" +
+	"\x14ZombieProcessesEvent\x12\x18\n" +
+	"\azombies\x18\x01 \x01(\x03R\azombies\x12\x1c\n" +
+	"\tthreshold\x18\x02 \x01(\x03R\tthreshold\x12'\n" +
+	"\x0fparent_commands\x18\x03 \x03(\tR\x0eparentCommands\"\xbf\x01\n" +
+	"\x17CertificateSigningEvent\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x18\n" +
+	"\asubject\x18\x02 \x01(\tR\asubject\x12\x1b\n" +
+	"\tdns_names\x18\x03 \x03(\tR\bdnsNames\x12!\n" +
+	"\fip_addresses\x18\x04 \x03(\tR\vipAddresses\x12\x1a\n" +
+	"\bdecision\x18\x05 \x01(\tR\bdecision\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\"K\n" +
+	"\x1bAPIAuthorizationDeniedEvent\x12\x16\n" +
+	"\x06method\x18\x01 \x01(\tR\x06method\x12\x14\n" +
+	"\x05roles\x18\x02 \x03(\tR\x05roles\"0\n" +
+	"\x18SecretsBundleExportEvent\x12\x14\n" +
+	"\x05roles\x18\x01 \x03(\tR\x05roles\"\xa2\x01\n" +
+	"\x0eJoinTokenEvent\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x126\n" +
+	"\x06action\x18\x02 \x01(\x0e2\x1e.machine.JoinTokenEvent.ActionR\x06action\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\",\n" +
+	"\x06Action\x12\v\n" +
+	"\aCREATED\x10\x00\x12\b\n" +
+	"\x04USED\x10\x01\x12\v\n" +
+	"\aREVOKED\x10\x02\"j\n" +
+	"\x15IdentityConflictEvent\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x1a\n" +
+	"\bhostname\x18\x02 \x01(\tR\bhostname\x12\x1c\n" +
+	"\taddresses\x18\x03 \x03(\tR\taddresses\"\xc2\x01\n" +
+	"\x17CertificateRevokedEvent\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x18\n" +
+	"\asubject\x18\x02 \x01(\tR\asubject\x12#\n" +
+	"\rserial_number\x18\x03 \x01(\tR\fserialNumber\x12 \n" +
+	"\vfingerprint\x18\x04 \x01(\tR\vfingerprint\x12\x16\n" +
+	"\x06method\x18\x05 \x01(\tR\x06method\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\"\x87\x01\n" +
+	"\x14DiskKeyRotationEvent\x12\x1b\n" +
+	"\tvolume_id\x18\x01 \x01(\tR\bvolumeId\x12\x12\n" +
+	"\x04slot\x18\x02 \x01(\x03R\x04slot\x12\x12\n" +
+	"\x04step\x18\x03 \x01(\tR\x04step\x12\x14\n" +
+	"\x05epoch\x18\x04 \x01(\x03R\x05epoch\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"\xfb\x03\n" +
 	"\x12MachineStatusEvent\x12>\n" +
 	"\x05stage\x18\x01 \x01(\x0e2(.machine.MachineStatusEvent.MachineStageR\x05stage\x12A\n" +
 	"\x06status\x18\x02 \x01(\v2).machine.MachineStatusEvent.MachineStatusR\x06status\x1a\xc8\x01\n" +
@@ -11907,18 +16099,22 @@ const file_machine_machine_proto_rawDesc = "" +
 	"\tREBOOTING\x10\x05\x12\x11\n" +
 	"\rSHUTTING_DOWN\x10\x06\x12\r\n" +
 	"\tRESETTING\x10\a\x12\r\n" +
-	"\tUPGRADING\x10\b\"\x90\x01\n" +
+	"\tUPGRADING\x10\b\"\xda\x01\n" +
 	"\rEventsRequest\x12\x1f\n" +
 	"\vtail_events\x18\x01 \x01(\x05R\n" +
 	"tailEvents\x12\x17\n" +
 	"\atail_id\x18\x02 \x01(\tR\x06tailId\x12!\n" +
 	"\ftail_seconds\x18\x03 \x01(\x05R\vtailSeconds\x12\"\n" +
-	"\rwith_actor_id\x18\x04 \x01(\tR\vwithActorId\"\x8a\x01\n" +
+	"\rwith_actor_id\x18\x04 \x01(\tR\vwithActorId\x12\x1d\n" +
+	"\n" +
+	"since_boot\x18\x05 \x01(\x05R\tsinceBoot\x12)\n" +
+	"\x10replay_persisted\x18\x06 \x01(\bR\x0freplayPersisted\"\xa3\x01\n" +
 	"\x05Event\x12,\n" +
 	"\bmetadata\x18\x01 \x01(\v2\x10.common.MetadataR\bmetadata\x12(\n" +
 	"\x04data\x18\x02 \x01(\v2\x14.google.protobuf.AnyR\x04data\x12\x0e\n" +
 	"\x02id\x18\x03 \x01(\tR\x02id\x12\x19\n" +
-	"\bactor_id\x18\x04 \x01(\tR\aactorId\">\n" +
+	"\bactor_id\x18\x04 \x01(\tR\aactorId\x12\x17\n" +
+	"\aboot_id\x18\x05 \x01(\tR\x06bootId\">\n" +
 	"\x12ResetPartitionSpec\x12\x14\n" +
 	"\x05label\x18\x01 \x01(\tR\x05label\x12\x12\n" +
 	"\x04wipe\x18\x02 \x01(\bR\x04wipe\"\xb1\x02\n" +
@@ -11937,14 +16133,80 @@ const file_machine_machine_proto_rawDesc = "" +
 	"\bmetadata\x18\x01 \x01(\v2\x10.common.MetadataR\bmetadata\x12\x19\n" +
 	"\bactor_id\x18\x02 \x01(\tR\aactorId\";\n" +
 	"\rResetResponse\x12*\n" +
-	"\bmessages\x18\x01 \x03(\v2\x0e.machine.ResetR\bmessages\"S\n" +
+	"\bmessages\x18\x01 \x03(\v2\x0e.machine.ResetR\bmessages\"0\n" +
+	"\x14ResetIdentityRequest\x12\x18\n" +
+	"\aconfirm\x18\x01 \x01(\bR\aconfirm\"\xc6\x01\n" +
+	"\rResetIdentity\x12,\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x10.common.MetadataR\bmetadata\x12(\n" +
+	"\x10previous_node_id\x18\x02 \x01(\tR\x0epreviousNodeId\x12\x17\n" +
+	"\anode_id\x18\x03 \x01(\tR\x06nodeId\x12.\n" +
+	"\x13kubespan_public_key\x18\x04 \x01(\tR\x11kubespanPublicKey\x12\x14\n" +
+	"\x05notes\x18\x05 \x03(\tR\x05notes\"K\n" +
+	"\x15ResetIdentityResponse\x122\n" +
+	"\bmessages\x18\x01 \x03(\v2\x16.machine.ResetIdentityR\bmessages\"S\n" +
 	"\bShutdown\x12,\n" +
 	"\bmetadata\x18\x01 \x01(\v2\x10.common.MetadataR\bmetadata\x12\x19\n" +
-	"\bactor_id\x18\x02 \x01(\tR\aactorId\"'\n" +
+	"\bactor_id\x18\x02 \x01(\tR\aactorId\"T\n" +
 	"\x0fShutdownRequest\x12\x14\n" +
-	"\x05force\x18\x01 \x01(\bR\x05force\"A\n" +
+	"\x05force\x18\x01 \x01(\bR\x05force\x12+\n" +
+	"\x11ignore_inhibitors\x18\x02 \x01(\bR\x10ignoreInhibitors\"A\n" +
 	"\x10ShutdownResponse\x12-\n" +
-	"\bmessages\x18\x01 \x03(\v2\x11.machine.ShutdownR\bmessages\"\xde\x01\n" +
+	"\bmessages\x18\x01 \x03(\v2\x11.machine.ShutdownR\bmessages\"s\n" +
+	"\x17InhibitorAcquireRequest\x12\x10\n" +
+	"\x03who\x18\x01 \x01(\tR\x03who\x12\x10\n" +
+	"\x03why\x18\x02 \x01(\tR\x03why\x124\n" +
+	"\bmax_hold\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\amaxHold\"\xad\x01\n" +
+	"\tInhibitor\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03who\x18\x02 \x01(\tR\x03who\x12\x10\n" +
+	"\x03why\x18\x03 \x01(\tR\x03why\x124\n" +
+	"\bmax_hold\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\amaxHold\x126\n" +
+	"\bacquired\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bacquired\"r\n" +
+	"\x10InhibitorAcquire\x12,\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x10.common.MetadataR\bmetadata\x120\n" +
+	"\tinhibitor\x18\x02 \x01(\v2\x12.machine.InhibitorR\tinhibitor\"Q\n" +
+	"\x18InhibitorAcquireResponse\x125\n" +
+	"\bmessages\x18\x01 \x03(\v2\x19.machine.InhibitorAcquireR\bmessages\"q\n" +
+	"\rInhibitorList\x12,\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x10.common.MetadataR\bmetadata\x122\n" +
+	"\n" +
+	"inhibitors\x18\x02 \x03(\v2\x12.machine.InhibitorR\n" +
+	"inhibitors\"K\n" +
+	"\x15InhibitorListResponse\x122\n" +
+	"\bmessages\x18\x01 \x03(\v2\x16.machine.InhibitorListR\bmessages\")\n" +
+	"\x17InhibitorReleaseRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"@\n" +
+	"\x10InhibitorRelease\x12,\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x10.common.MetadataR\bmetadata\"Q\n" +
+	"\x18InhibitorReleaseResponse\x125\n" +
+	"\bmessages\x18\x01 \x03(\v2\x19.machine.InhibitorReleaseR\bmessages\"}\n" +
+	"\x16JoinTokenCreateRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"?\n" +
+	"\x0fJoinTokenCreate\x12,\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x10.common.MetadataR\bmetadata\"O\n" +
+	"\x17JoinTokenCreateResponse\x124\n" +
+	"\bmessages\x18\x01 \x03(\v2\x18.machine.JoinTokenCreateR\bmessages\"\xb3\x01\n" +
+	"\rJoinTokenInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x18\n" +
+	"\aexpired\x18\x04 \x01(\bR\aexpired\"m\n" +
+	"\rJoinTokenList\x12,\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x10.common.MetadataR\bmetadata\x12.\n" +
+	"\x06tokens\x18\x02 \x03(\v2\x16.machine.JoinTokenInfoR\x06tokens\"K\n" +
+	"\x15JoinTokenListResponse\x122\n" +
+	"\bmessages\x18\x01 \x03(\v2\x16.machine.JoinTokenListR\bmessages\",\n" +
+	"\x16JoinTokenRevokeRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"?\n" +
+	"\x0fJoinTokenRevoke\x12,\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x10.common.MetadataR\bmetadata\"O\n" +
+	"\x17JoinTokenRevokeResponse\x124\n" +
+	"\bmessages\x18\x01 \x03(\v2\x18.machine.JoinTokenRevokeR\bmessages\"\xde\x01\n" +
 	"\x0eUpgradeRequest\x12\x14\n" +
 	"\x05image\x18\x01 \x01(\tR\x05image\x12\x1a\n" +
 	"\bpreserve\x18\x02 \x01(\bR\bpreserve\x12\x14\n" +
@@ -11962,7 +16224,14 @@ const file_machine_machine_proto_rawDesc = "" +
 	"\x03ack\x18\x02 \x01(\tR\x03ack\x12\x19\n" +
 	"\bactor_id\x18\x03 \x01(\tR\aactorId\"?\n" +
 	"\x0fUpgradeResponse\x12,\n" +
-	"\bmessages\x18\x01 \x03(\v2\x10.machine.UpgradeR\bmessages\"m\n" +
+	"\bmessages\x18\x01 \x03(\v2\x10.machine.UpgradeR\bmessages\"N\n" +
+	"\x1aSecretsBundleExportRequest\x120\n" +
+	"\x14allow_secrets_export\x18\x01 \x01(\bR\x12allowSecretsExport\"[\n" +
+	"\x13SecretsBundleExport\x12,\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x10.common.MetadataR\bmetadata\x12\x16\n" +
+	"\x06bundle\x18\x02 \x01(\fR\x06bundle\"W\n" +
+	"\x1bSecretsBundleExportResponse\x128\n" +
+	"\bmessages\x18\x01 \x03(\v2\x1c.machine.SecretsBundleExportR\bmessages\"m\n" +
 	"\vServiceList\x12,\n" +
 	"\bmetadata\x18\x01 \x01(\v2\x10.common.MetadataR\bmetadata\x120\n" +
 	"\bservices\x18\x02 \x03(\v2\x14.machine.ServiceInfoR\bservices\"G\n" +
@@ -11984,7 +16253,27 @@ const file_machine_machine_proto_rawDesc = "" +
 	"\ahealthy\x18\x02 \x01(\bR\ahealthy\x12!\n" +
 	"\flast_message\x18\x03 \x01(\tR\vlastMessage\x12;\n" +
 	"\vlast_change\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"lastChange\"%\n" +
+	"lastChange\",\n" +
+	"\x1aServiceDependenciesRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"~\n" +
+	"\x10ServiceCondition\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06target\x18\x03 \x01(\tR\x06target\x12\x1c\n" +
+	"\tsatisfied\x18\x04 \x01(\bR\tsatisfied\"\x97\x01\n" +
+	"\x15ServiceDependencyInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12\x1d\n" +
+	"\n" +
+	"depends_on\x18\x03 \x03(\tR\tdependsOn\x129\n" +
+	"\n" +
+	"conditions\x18\x04 \x03(\v2\x19.machine.ServiceConditionR\n" +
+	"conditions\"\x7f\n" +
+	"\x13ServiceDependencies\x12,\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x10.common.MetadataR\bmetadata\x12:\n" +
+	"\bservices\x18\x02 \x03(\v2\x1e.machine.ServiceDependencyInfoR\bservices\"W\n" +
+	"\x1bServiceDependenciesResponse\x128\n" +
+	"\bmessages\x18\x01 \x03(\v2\x1c.machine.ServiceDependenciesR\bmessages\"%\n" +
 	"\x13ServiceStartRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"P\n" +
 	"\fServiceStart\x12,\n" +
@@ -12097,7 +16386,48 @@ const file_machine_machine_proto_rawDesc = "" +
 	"\bRollback\x12,\n" +
 	"\bmetadata\x18\x01 \x01(\v2\x10.common.MetadataR\bmetadata\"A\n" +
 	"\x10RollbackResponse\x12-\n" +
-	"\bmessages\x18\x01 \x03(\v2\x11.machine.RollbackR\bmessages\"b\n" +
+	"\bmessages\x18\x01 \x03(\v2\x11.machine.RollbackR\bmessages\";\n" +
+	"\x19RotateCertificatesRequest\x12\x1e\n" +
+	"\n" +
+	"components\x18\x01 \x03(\tR\n" +
+	"components\"b\n" +
+	"\x12RotateCertificates\x12,\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x10.common.MetadataR\bmetadata\x12\x1e\n" +
+	"\n" +
+	"components\x18\x02 \x03(\tR\n" +
+	"components\"U\n" +
+	"\x1aRotateCertificatesResponse\x127\n" +
+	"\bmessages\x18\x01 \x03(\v2\x1b.machine.RotateCertificatesR\bmessages\"I\n" +
+	"\x14RotateDiskKeyRequest\x12\x1b\n" +
+	"\tvolume_id\x18\x01 \x01(\tR\bvolumeId\x12\x14\n" +
+	"\x05slots\x18\x02 \x03(\x03R\x05slots\"Y\n" +
+	"\x11RotateDiskKeySlot\x12\x12\n" +
+	"\x04slot\x18\x01 \x01(\x03R\x04slot\x12\x1a\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\x12\x14\n" +
+	"\x05epoch\x18\x03 \x01(\x03R\x05epoch\"\x8c\x01\n" +
+	"\rRotateDiskKey\x12,\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x10.common.MetadataR\bmetadata\x12\x1b\n" +
+	"\tvolume_id\x18\x02 \x01(\tR\bvolumeId\x120\n" +
+	"\x05slots\x18\x03 \x03(\v2\x1a.machine.RotateDiskKeySlotR\x05slots\"K\n" +
+	"\x15RotateDiskKeyResponse\x122\n" +
+	"\bmessages\x18\x01 \x03(\v2\x16.machine.RotateDiskKeyR\bmessages\"k\n" +
+	"!KubernetesUpgradePreflightRequest\x12\x1d\n" +
+	"\n" +
+	"to_version\x18\x01 \x01(\tR\ttoVersion\x12'\n" +
+	"\x0fupgrade_kubelet\x18\x02 \x01(\bR\x0eupgradeKubelet\"\xcc\x01\n" +
+	"\x1fKubernetesUpgradePreflightCheck\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12M\n" +
+	"\bseverity\x18\x02 \x01(\x0e21.machine.KubernetesUpgradePreflightCheck.SeverityR\bseverity\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\",\n" +
+	"\bSeverity\x12\b\n" +
+	"\x04INFO\x10\x00\x12\v\n" +
+	"\aWARNING\x10\x01\x12\t\n" +
+	"\x05ERROR\x10\x02\"\x8c\x01\n" +
+	"\x1aKubernetesUpgradePreflight\x12,\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x10.common.MetadataR\bmetadata\x12@\n" +
+	"\x06checks\x18\x02 \x03(\v2(.machine.KubernetesUpgradePreflightCheckR\x06checks\"e\n" +
+	"\"KubernetesUpgradePreflightResponse\x12?\n" +
+	"\bmessages\x18\x01 \x03(\v2#.machine.KubernetesUpgradePreflightR\bmessages\"b\n" +
 	"\x11ContainersRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12/\n" +
 	"\x06driver\x18\x02 \x01(\x0e2\x17.common.ContainerDriverR\x06driver\"\x88\x02\n" +
@@ -12407,7 +16737,13 @@ const file_machine_machine_proto_rawDesc = "" +
 	"\x14EtcdRemoveMemberByID\x12,\n" +
 	"\bmetadata\x18\x01 \x01(\v2\x10.common.MetadataR\bmetadata\"Y\n" +
 	"\x1cEtcdRemoveMemberByIDResponse\x129\n" +
-	"\bmessages\x18\x01 \x03(\v2\x1d.machine.EtcdRemoveMemberByIDR\bmessages\"\x1e\n" +
+	"\bmessages\x18\x01 \x03(\v2\x1d.machine.EtcdRemoveMemberByIDR\bmessages\"7\n" +
+	"\x18EtcdPromoteMemberRequest\x12\x1b\n" +
+	"\tmember_id\x18\x01 \x01(\x04R\bmemberId\"A\n" +
+	"\x11EtcdPromoteMember\x12,\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x10.common.MetadataR\bmetadata\"S\n" +
+	"\x19EtcdPromoteMemberResponse\x126\n" +
+	"\bmessages\x18\x01 \x03(\v2\x1a.machine.EtcdPromoteMemberR\bmessages\"\x1e\n" +
 	"\x1cEtcdForfeitLeadershipRequest\"]\n" +
 	"\x15EtcdForfeitLeadership\x12,\n" +
 	"\bmetadata\x18\x01 \x01(\v2\x10.common.MetadataR\bmetadata\x12\x16\n" +
@@ -12684,9 +17020,10 @@ const file_machine_machine_proto_rawDesc = "" +
 	"\tImagePull\x12,\n" +
 	"\bmetadata\x18\x01 \x01(\v2\x10.common.MetadataR\bmetadata\"C\n" +
 	"\x11ImagePullResponse\x12.\n" +
-	"\bmessages\x18\x01 \x03(\v2\x12.machine.ImagePullR\bmessages2\xad\x1e\n" +
+	"\bmessages\x18\x01 \x03(\v2\x12.machine.ImagePullR\bmessages2\xee'\n" +
 	"\x0eMachineService\x12]\n" +
-	"\x12ApplyConfiguration\x12\".machine.ApplyConfigurationRequest\x1a#.machine.ApplyConfigurationResponse\x12B\n" +
+	"\x12ApplyConfiguration\x12\".machine.ApplyConfigurationRequest\x1a#.machine.ApplyConfigurationResponse\x129\n" +
+	"\x06Attest\x12\x16.machine.AttestRequest\x1a\x17.machine.AttestResponse\x12B\n" +
 	"\tBootstrap\x12\x19.machine.BootstrapRequest\x1a\x1a.machine.BootstrapResponse\x12E\n" +
 	"\n" +
 	"Containers\x12\x1a.machine.ContainersRequest\x1a\x1b.machine.ContainersResponse\x12,\n" +
@@ -12698,7 +17035,8 @@ const file_machine_machine_proto_rawDesc = "" +
 	"\x06Events\x12\x16.machine.EventsRequest\x1a\x0e.machine.Event0\x01\x12Q\n" +
 	"\x0eEtcdMemberList\x12\x1e.machine.EtcdMemberListRequest\x1a\x1f.machine.EtcdMemberListResponse\x12c\n" +
 	"\x14EtcdRemoveMemberByID\x12$.machine.EtcdRemoveMemberByIDRequest\x1a%.machine.EtcdRemoveMemberByIDResponse\x12W\n" +
-	"\x10EtcdLeaveCluster\x12 .machine.EtcdLeaveClusterRequest\x1a!.machine.EtcdLeaveClusterResponse\x12f\n" +
+	"\x10EtcdLeaveCluster\x12 .machine.EtcdLeaveClusterRequest\x1a!.machine.EtcdLeaveClusterResponse\x12Z\n" +
+	"\x11EtcdPromoteMember\x12!.machine.EtcdPromoteMemberRequest\x1a\".machine.EtcdPromoteMemberResponse\x12f\n" +
 	"\x15EtcdForfeitLeadership\x12%.machine.EtcdForfeitLeadershipRequest\x1a&.machine.EtcdForfeitLeadershipResponse\x12;\n" +
 	"\vEtcdRecover\x12\f.common.Data\x1a\x1c.machine.EtcdRecoverResponse(\x01\x12<\n" +
 	"\fEtcdSnapshot\x12\x1c.machine.EtcdSnapshotRequest\x1a\f.common.Data0\x01\x12G\n" +
@@ -12711,9 +17049,16 @@ const file_machine_machine_proto_rawDesc = "" +
 	"\x13EtcdDowngradeEnable\x12#.machine.EtcdDowngradeEnableRequest\x1a$.machine.EtcdDowngradeEnableResponse\x12S\n" +
 	"\x13EtcdDowngradeCancel\x12\x16.google.protobuf.Empty\x1a$.machine.EtcdDowngradeCancelResponse\x12f\n" +
 	"\x15GenerateConfiguration\x12%.machine.GenerateConfigurationRequest\x1a&.machine.GenerateConfigurationResponse\x12=\n" +
-	"\bHostname\x12\x16.google.protobuf.Empty\x1a\x19.machine.HostnameResponse\x124\n" +
+	"\bHostname\x12\x16.google.protobuf.Empty\x1a\x19.machine.HostnameResponse\x12W\n" +
+	"\x10InhibitorAcquire\x12 .machine.InhibitorAcquireRequest\x1a!.machine.InhibitorAcquireResponse\x12G\n" +
+	"\rInhibitorList\x12\x16.google.protobuf.Empty\x1a\x1e.machine.InhibitorListResponse\x12W\n" +
+	"\x10InhibitorRelease\x12 .machine.InhibitorReleaseRequest\x1a!.machine.InhibitorReleaseResponse\x12T\n" +
+	"\x0fJoinTokenCreate\x12\x1f.machine.JoinTokenCreateRequest\x1a .machine.JoinTokenCreateResponse\x12G\n" +
+	"\rJoinTokenList\x12\x16.google.protobuf.Empty\x1a\x1e.machine.JoinTokenListResponse\x12T\n" +
+	"\x0fJoinTokenRevoke\x12\x1f.machine.JoinTokenRevokeRequest\x1a .machine.JoinTokenRevokeResponse\x124\n" +
 	"\n" +
-	"Kubeconfig\x12\x16.google.protobuf.Empty\x1a\f.common.Data0\x01\x121\n" +
+	"Kubeconfig\x12\x16.google.protobuf.Empty\x1a\f.common.Data0\x01\x12u\n" +
+	"\x1aKubernetesUpgradePreflight\x12*.machine.KubernetesUpgradePreflightRequest\x1a+.machine.KubernetesUpgradePreflightResponse\x121\n" +
 	"\x04List\x12\x14.machine.ListRequest\x1a\x11.machine.FileInfo0\x01\x12@\n" +
 	"\tDiskUsage\x12\x19.machine.DiskUsageRequest\x1a\x16.machine.DiskUsageInfo0\x01\x12;\n" +
 	"\aLoadAvg\x12\x16.google.protobuf.Empty\x1a\x18.machine.LoadAvgResponse\x12,\n" +
@@ -12726,8 +17071,13 @@ const file_machine_machine_proto_rawDesc = "" +
 	"\x04Read\x12\x14.machine.ReadRequest\x1a\f.common.Data0\x01\x129\n" +
 	"\x06Reboot\x12\x16.machine.RebootRequest\x1a\x17.machine.RebootResponse\x12<\n" +
 	"\aRestart\x12\x17.machine.RestartRequest\x1a\x18.machine.RestartResponse\x12?\n" +
-	"\bRollback\x12\x18.machine.RollbackRequest\x1a\x19.machine.RollbackResponse\x126\n" +
-	"\x05Reset\x12\x15.machine.ResetRequest\x1a\x16.machine.ResetResponse\x12C\n" +
+	"\bRollback\x12\x18.machine.RollbackRequest\x1a\x19.machine.RollbackResponse\x12]\n" +
+	"\x12RotateCertificates\x12\".machine.RotateCertificatesRequest\x1a#.machine.RotateCertificatesResponse\x12N\n" +
+	"\rRotateDiskKey\x12\x1d.machine.RotateDiskKeyRequest\x1a\x1e.machine.RotateDiskKeyResponse\x126\n" +
+	"\x05Reset\x12\x15.machine.ResetRequest\x1a\x16.machine.ResetResponse\x12N\n" +
+	"\rResetIdentity\x12\x1d.machine.ResetIdentityRequest\x1a\x1e.machine.ResetIdentityResponse\x12`\n" +
+	"\x13SecretsBundleExport\x12#.machine.SecretsBundleExportRequest\x1a$.machine.SecretsBundleExportResponse\x12`\n" +
+	"\x13ServiceDependencies\x12#.machine.ServiceDependenciesRequest\x1a$.machine.ServiceDependenciesResponse\x12C\n" +
 	"\vServiceList\x12\x16.google.protobuf.Empty\x1a\x1c.machine.ServiceListResponse\x12Q\n" +
 	"\x0eServiceRestart\x12\x1e.machine.ServiceRestartRequest\x1a\x1f.machine.ServiceRestartResponse\x12K\n" +
 	"\fServiceStart\x12\x1c.machine.ServiceStartRequest\x1a\x1d.machine.ServiceStartResponse\x12H\n" +
@@ -12760,8 +17110,8 @@ func file_machine_machine_proto_rawDescGZIP() []byte {
 	return file_machine_machine_proto_rawDescData
 }
 
-var file_machine_machine_proto_enumTypes = make([]protoimpl.EnumInfo, 15)
-var file_machine_machine_proto_msgTypes = make([]protoimpl.MessageInfo, 181)
+var file_machine_machine_proto_enumTypes = make([]protoimpl.EnumInfo, 17)
+var file_machine_machine_proto_msgTypes = make([]protoimpl.MessageInfo, 248)
 var file_machine_machine_proto_goTypes = []any{
 	(ApplyConfigurationRequest_Mode)(0),                     // 0: machine.ApplyConfigurationRequest.Mode
 	(RebootRequest_Mode)(0),                                 // 1: machine.RebootRequest.Mode
@@ -12769,495 +17119,643 @@ var file_machine_machine_proto_goTypes = []any{
 	(PhaseEvent_Action)(0),                                  // 3: machine.PhaseEvent.Action
 	(TaskEvent_Action)(0),                                   // 4: machine.TaskEvent.Action
 	(ServiceStateEvent_Action)(0),                           // 5: machine.ServiceStateEvent.Action
-	(MachineStatusEvent_MachineStage)(0),                    // 6: machine.MachineStatusEvent.MachineStage
-	(ResetRequest_WipeMode)(0),                              // 7: machine.ResetRequest.WipeMode
-	(UpgradeRequest_RebootMode)(0),                          // 8: machine.UpgradeRequest.RebootMode
-	(ListRequest_Type)(0),                                   // 9: machine.ListRequest.Type
-	(EtcdMemberAlarm_AlarmType)(0),                          // 10: machine.EtcdMemberAlarm.AlarmType
-	(MachineConfig_MachineType)(0),                          // 11: machine.MachineConfig.MachineType
-	(NetstatRequest_Filter)(0),                              // 12: machine.NetstatRequest.Filter
-	(ConnectRecord_State)(0),                                // 13: machine.ConnectRecord.State
-	(ConnectRecord_TimerActive)(0),                          // 14: machine.ConnectRecord.TimerActive
-	(*ApplyConfigurationRequest)(nil),                       // 15: machine.ApplyConfigurationRequest
-	(*ApplyConfiguration)(nil),                              // 16: machine.ApplyConfiguration
-	(*ApplyConfigurationResponse)(nil),                      // 17: machine.ApplyConfigurationResponse
-	(*RebootRequest)(nil),                                   // 18: machine.RebootRequest
-	(*Reboot)(nil),                                          // 19: machine.Reboot
-	(*RebootResponse)(nil),                                  // 20: machine.RebootResponse
-	(*BootstrapRequest)(nil),                                // 21: machine.BootstrapRequest
-	(*Bootstrap)(nil),                                       // 22: machine.Bootstrap
-	(*BootstrapResponse)(nil),                               // 23: machine.BootstrapResponse
-	(*SequenceEvent)(nil),                                   // 24: machine.SequenceEvent
-	(*PhaseEvent)(nil),                                      // 25: machine.PhaseEvent
-	(*TaskEvent)(nil),                                       // 26: machine.TaskEvent
-	(*ServiceStateEvent)(nil),                               // 27: machine.ServiceStateEvent
-	(*RestartEvent)(nil),                                    // 28: machine.RestartEvent
-	(*ConfigLoadErrorEvent)(nil),                            // 29: machine.ConfigLoadErrorEvent
-	(*ConfigValidationErrorEvent)(nil),                      // 30: machine.ConfigValidationErrorEvent
-	(*AddressEvent)(nil),                                    // 31: machine.AddressEvent
-	(*MachineStatusEvent)(nil),                              // 32: machine.MachineStatusEvent
-	(*EventsRequest)(nil),                                   // 33: machine.EventsRequest
-	(*Event)(nil),                                           // 34: machine.Event
-	(*ResetPartitionSpec)(nil),                              // 35: machine.ResetPartitionSpec
-	(*ResetRequest)(nil),                                    // 36: machine.ResetRequest
-	(*Reset)(nil),                                           // 37: machine.Reset
-	(*ResetResponse)(nil),                                   // 38: machine.ResetResponse
-	(*Shutdown)(nil),                                        // 39: machine.Shutdown
-	(*ShutdownRequest)(nil),                                 // 40: machine.ShutdownRequest
-	(*ShutdownResponse)(nil),                                // 41: machine.ShutdownResponse
-	(*UpgradeRequest)(nil),                                  // 42: machine.UpgradeRequest
-	(*Upgrade)(nil),                                         // 43: machine.Upgrade
-	(*UpgradeResponse)(nil),                                 // 44: machine.UpgradeResponse
-	(*ServiceList)(nil),                                     // 45: machine.ServiceList
-	(*ServiceListResponse)(nil),                             // 46: machine.ServiceListResponse
-	(*ServiceInfo)(nil),                                     // 47: machine.ServiceInfo
-	(*ServiceEvents)(nil),                                   // 48: machine.ServiceEvents
-	(*ServiceEvent)(nil),                                    // 49: machine.ServiceEvent
-	(*ServiceHealth)(nil),                                   // 50: machine.ServiceHealth
-	(*ServiceStartRequest)(nil),                             // 51: machine.ServiceStartRequest
-	(*ServiceStart)(nil),                                    // 52: machine.ServiceStart
-	(*ServiceStartResponse)(nil),                            // 53: machine.ServiceStartResponse
-	(*ServiceStopRequest)(nil),                              // 54: machine.ServiceStopRequest
-	(*ServiceStop)(nil),                                     // 55: machine.ServiceStop
-	(*ServiceStopResponse)(nil),                             // 56: machine.ServiceStopResponse
-	(*ServiceRestartRequest)(nil),                           // 57: machine.ServiceRestartRequest
-	(*ServiceRestart)(nil),                                  // 58: machine.ServiceRestart
-	(*ServiceRestartResponse)(nil),                          // 59: machine.ServiceRestartResponse
-	(*CopyRequest)(nil),                                     // 60: machine.CopyRequest
-	(*ListRequest)(nil),                                     // 61: machine.ListRequest
-	(*DiskUsageRequest)(nil),                                // 62: machine.DiskUsageRequest
-	(*FileInfo)(nil),                                        // 63: machine.FileInfo
-	(*Xattr)(nil),                                           // 64: machine.Xattr
-	(*DiskUsageInfo)(nil),                                   // 65: machine.DiskUsageInfo
-	(*Mounts)(nil),                                          // 66: machine.Mounts
-	(*MountsResponse)(nil),                                  // 67: machine.MountsResponse
-	(*MountStat)(nil),                                       // 68: machine.MountStat
-	(*Version)(nil),                                         // 69: machine.Version
-	(*VersionResponse)(nil),                                 // 70: machine.VersionResponse
-	(*VersionInfo)(nil),                                     // 71: machine.VersionInfo
-	(*PlatformInfo)(nil),                                    // 72: machine.PlatformInfo
-	(*FeaturesInfo)(nil),                                    // 73: machine.FeaturesInfo
-	(*LogsRequest)(nil),                                     // 74: machine.LogsRequest
-	(*ReadRequest)(nil),                                     // 75: machine.ReadRequest
-	(*LogsContainer)(nil),                                   // 76: machine.LogsContainer
-	(*LogsContainersResponse)(nil),                          // 77: machine.LogsContainersResponse
-	(*RollbackRequest)(nil),                                 // 78: machine.RollbackRequest
-	(*Rollback)(nil),                                        // 79: machine.Rollback
-	(*RollbackResponse)(nil),                                // 80: machine.RollbackResponse
-	(*ContainersRequest)(nil),                               // 81: machine.ContainersRequest
-	(*ContainerInfo)(nil),                                   // 82: machine.ContainerInfo
-	(*Container)(nil),                                       // 83: machine.Container
-	(*ContainersResponse)(nil),                              // 84: machine.ContainersResponse
-	(*DmesgRequest)(nil),                                    // 85: machine.DmesgRequest
-	(*ProcessesResponse)(nil),                               // 86: machine.ProcessesResponse
-	(*Process)(nil),                                         // 87: machine.Process
-	(*ProcessInfo)(nil),                                     // 88: machine.ProcessInfo
-	(*RestartRequest)(nil),                                  // 89: machine.RestartRequest
-	(*Restart)(nil),                                         // 90: machine.Restart
-	(*RestartResponse)(nil),                                 // 91: machine.RestartResponse
-	(*StatsRequest)(nil),                                    // 92: machine.StatsRequest
-	(*Stats)(nil),                                           // 93: machine.Stats
-	(*StatsResponse)(nil),                                   // 94: machine.StatsResponse
-	(*Stat)(nil),                                            // 95: machine.Stat
-	(*Memory)(nil),                                          // 96: machine.Memory
-	(*MemoryResponse)(nil),                                  // 97: machine.MemoryResponse
-	(*MemInfo)(nil),                                         // 98: machine.MemInfo
-	(*HostnameResponse)(nil),                                // 99: machine.HostnameResponse
-	(*Hostname)(nil),                                        // 100: machine.Hostname
-	(*LoadAvgResponse)(nil),                                 // 101: machine.LoadAvgResponse
-	(*LoadAvg)(nil),                                         // 102: machine.LoadAvg
-	(*SystemStatResponse)(nil),                              // 103: machine.SystemStatResponse
-	(*SystemStat)(nil),                                      // 104: machine.SystemStat
-	(*CPUStat)(nil),                                         // 105: machine.CPUStat
-	(*SoftIRQStat)(nil),                                     // 106: machine.SoftIRQStat
-	(*CPUFreqStatsResponse)(nil),                            // 107: machine.CPUFreqStatsResponse
-	(*CPUsFreqStats)(nil),                                   // 108: machine.CPUsFreqStats
-	(*CPUFreqStats)(nil),                                    // 109: machine.CPUFreqStats
-	(*CPUInfoResponse)(nil),                                 // 110: machine.CPUInfoResponse
-	(*CPUsInfo)(nil),                                        // 111: machine.CPUsInfo
-	(*CPUInfo)(nil),                                         // 112: machine.CPUInfo
-	(*NetworkDeviceStatsResponse)(nil),                      // 113: machine.NetworkDeviceStatsResponse
-	(*NetworkDeviceStats)(nil),                              // 114: machine.NetworkDeviceStats
-	(*NetDev)(nil),                                          // 115: machine.NetDev
-	(*DiskStatsResponse)(nil),                               // 116: machine.DiskStatsResponse
-	(*DiskStats)(nil),                                       // 117: machine.DiskStats
-	(*DiskStat)(nil),                                        // 118: machine.DiskStat
-	(*EtcdLeaveClusterRequest)(nil),                         // 119: machine.EtcdLeaveClusterRequest
-	(*EtcdLeaveCluster)(nil),                                // 120: machine.EtcdLeaveCluster
-	(*EtcdLeaveClusterResponse)(nil),                        // 121: machine.EtcdLeaveClusterResponse
-	(*EtcdRemoveMemberRequest)(nil),                         // 122: machine.EtcdRemoveMemberRequest
-	(*EtcdRemoveMember)(nil),                                // 123: machine.EtcdRemoveMember
-	(*EtcdRemoveMemberResponse)(nil),                        // 124: machine.EtcdRemoveMemberResponse
-	(*EtcdRemoveMemberByIDRequest)(nil),                     // 125: machine.EtcdRemoveMemberByIDRequest
-	(*EtcdRemoveMemberByID)(nil),                            // 126: machine.EtcdRemoveMemberByID
-	(*EtcdRemoveMemberByIDResponse)(nil),                    // 127: machine.EtcdRemoveMemberByIDResponse
-	(*EtcdForfeitLeadershipRequest)(nil),                    // 128: machine.EtcdForfeitLeadershipRequest
-	(*EtcdForfeitLeadership)(nil),                           // 129: machine.EtcdForfeitLeadership
-	(*EtcdForfeitLeadershipResponse)(nil),                   // 130: machine.EtcdForfeitLeadershipResponse
-	(*EtcdMemberListRequest)(nil),                           // 131: machine.EtcdMemberListRequest
-	(*EtcdMember)(nil),                                      // 132: machine.EtcdMember
-	(*EtcdMembers)(nil),                                     // 133: machine.EtcdMembers
-	(*EtcdMemberListResponse)(nil),                          // 134: machine.EtcdMemberListResponse
-	(*EtcdSnapshotRequest)(nil),                             // 135: machine.EtcdSnapshotRequest
-	(*EtcdRecover)(nil),                                     // 136: machine.EtcdRecover
-	(*EtcdRecoverResponse)(nil),                             // 137: machine.EtcdRecoverResponse
-	(*EtcdAlarmListResponse)(nil),                           // 138: machine.EtcdAlarmListResponse
-	(*EtcdAlarm)(nil),                                       // 139: machine.EtcdAlarm
-	(*EtcdMemberAlarm)(nil),                                 // 140: machine.EtcdMemberAlarm
-	(*EtcdAlarmDisarmResponse)(nil),                         // 141: machine.EtcdAlarmDisarmResponse
-	(*EtcdAlarmDisarm)(nil),                                 // 142: machine.EtcdAlarmDisarm
-	(*EtcdDefragmentResponse)(nil),                          // 143: machine.EtcdDefragmentResponse
-	(*EtcdDefragment)(nil),                                  // 144: machine.EtcdDefragment
-	(*EtcdStatusResponse)(nil),                              // 145: machine.EtcdStatusResponse
-	(*EtcdStatus)(nil),                                      // 146: machine.EtcdStatus
-	(*EtcdMemberStatus)(nil),                                // 147: machine.EtcdMemberStatus
-	(*EtcdDowngradeValidateRequest)(nil),                    // 148: machine.EtcdDowngradeValidateRequest
-	(*EtcdDowngradeValidateResponse)(nil),                   // 149: machine.EtcdDowngradeValidateResponse
-	(*EtcdDowngradeValidate)(nil),                           // 150: machine.EtcdDowngradeValidate
-	(*EtcdDowngradeEnableRequest)(nil),                      // 151: machine.EtcdDowngradeEnableRequest
-	(*EtcdDowngradeEnableResponse)(nil),                     // 152: machine.EtcdDowngradeEnableResponse
-	(*EtcdDowngradeEnable)(nil),                             // 153: machine.EtcdDowngradeEnable
-	(*EtcdDowngradeCancelResponse)(nil),                     // 154: machine.EtcdDowngradeCancelResponse
-	(*EtcdDowngradeCancel)(nil),                             // 155: machine.EtcdDowngradeCancel
-	(*EtcdClusterDowngrade)(nil),                            // 156: machine.EtcdClusterDowngrade
-	(*RouteConfig)(nil),                                     // 157: machine.RouteConfig
-	(*DHCPOptionsConfig)(nil),                               // 158: machine.DHCPOptionsConfig
-	(*NetworkDeviceConfig)(nil),                             // 159: machine.NetworkDeviceConfig
-	(*NetworkConfig)(nil),                                   // 160: machine.NetworkConfig
-	(*InstallConfig)(nil),                                   // 161: machine.InstallConfig
-	(*MachineConfig)(nil),                                   // 162: machine.MachineConfig
-	(*ControlPlaneConfig)(nil),                              // 163: machine.ControlPlaneConfig
-	(*CNIConfig)(nil),                                       // 164: machine.CNIConfig
-	(*ClusterNetworkConfig)(nil),                            // 165: machine.ClusterNetworkConfig
-	(*ClusterConfig)(nil),                                   // 166: machine.ClusterConfig
-	(*GenerateConfigurationRequest)(nil),                    // 167: machine.GenerateConfigurationRequest
-	(*GenerateConfiguration)(nil),                           // 168: machine.GenerateConfiguration
-	(*GenerateConfigurationResponse)(nil),                   // 169: machine.GenerateConfigurationResponse
-	(*GenerateClientConfigurationRequest)(nil),              // 170: machine.GenerateClientConfigurationRequest
-	(*GenerateClientConfiguration)(nil),                     // 171: machine.GenerateClientConfiguration
-	(*GenerateClientConfigurationResponse)(nil),             // 172: machine.GenerateClientConfigurationResponse
-	(*PacketCaptureRequest)(nil),                            // 173: machine.PacketCaptureRequest
-	(*BPFInstruction)(nil),                                  // 174: machine.BPFInstruction
-	(*NetstatRequest)(nil),                                  // 175: machine.NetstatRequest
-	(*ConnectRecord)(nil),                                   // 176: machine.ConnectRecord
-	(*Netstat)(nil),                                         // 177: machine.Netstat
-	(*NetstatResponse)(nil),                                 // 178: machine.NetstatResponse
-	(*MetaWriteRequest)(nil),                                // 179: machine.MetaWriteRequest
-	(*MetaWrite)(nil),                                       // 180: machine.MetaWrite
-	(*MetaWriteResponse)(nil),                               // 181: machine.MetaWriteResponse
-	(*MetaDeleteRequest)(nil),                               // 182: machine.MetaDeleteRequest
-	(*MetaDelete)(nil),                                      // 183: machine.MetaDelete
-	(*MetaDeleteResponse)(nil),                              // 184: machine.MetaDeleteResponse
-	(*ImageListRequest)(nil),                                // 185: machine.ImageListRequest
-	(*ImageListResponse)(nil),                               // 186: machine.ImageListResponse
-	(*ImagePullRequest)(nil),                                // 187: machine.ImagePullRequest
-	(*ImagePull)(nil),                                       // 188: machine.ImagePull
-	(*ImagePullResponse)(nil),                               // 189: machine.ImagePullResponse
-	(*MachineStatusEvent_MachineStatus)(nil),                // 190: machine.MachineStatusEvent.MachineStatus
-	(*MachineStatusEvent_MachineStatus_UnmetCondition)(nil), // 191: machine.MachineStatusEvent.MachineStatus.UnmetCondition
-	(*NetstatRequest_Feature)(nil),                          // 192: machine.NetstatRequest.Feature
-	(*NetstatRequest_L4Proto)(nil),                          // 193: machine.NetstatRequest.L4proto
-	(*NetstatRequest_NetNS)(nil),                            // 194: machine.NetstatRequest.NetNS
-	(*ConnectRecord_Process)(nil),                           // 195: machine.ConnectRecord.Process
-	(*durationpb.Duration)(nil),                             // 196: google.protobuf.Duration
-	(*common.Metadata)(nil),                                 // 197: common.Metadata
-	(*common.Error)(nil),                                    // 198: common.Error
-	(*anypb.Any)(nil),                                       // 199: google.protobuf.Any
-	(*timestamppb.Timestamp)(nil),                           // 200: google.protobuf.Timestamp
-	(common.ContainerDriver)(0),                             // 201: common.ContainerDriver
-	(common.ContainerdNamespace)(0),                         // 202: common.ContainerdNamespace
-	(*emptypb.Empty)(nil),                                   // 203: google.protobuf.Empty
-	(*common.Data)(nil),                                     // 204: common.Data
+	(JoinTokenEvent_Action)(0),                              // 6: machine.JoinTokenEvent.Action
+	(MachineStatusEvent_MachineStage)(0),                    // 7: machine.MachineStatusEvent.MachineStage
+	(ResetRequest_WipeMode)(0),                              // 8: machine.ResetRequest.WipeMode
+	(UpgradeRequest_RebootMode)(0),                          // 9: machine.UpgradeRequest.RebootMode
+	(ListRequest_Type)(0),                                   // 10: machine.ListRequest.Type
+	(KubernetesUpgradePreflightCheck_Severity)(0),           // 11: machine.KubernetesUpgradePreflightCheck.Severity
+	(EtcdMemberAlarm_AlarmType)(0),                          // 12: machine.EtcdMemberAlarm.AlarmType
+	(MachineConfig_MachineType)(0),                          // 13: machine.MachineConfig.MachineType
+	(NetstatRequest_Filter)(0),                              // 14: machine.NetstatRequest.Filter
+	(ConnectRecord_State)(0),                                // 15: machine.ConnectRecord.State
+	(ConnectRecord_TimerActive)(0),                          // 16: machine.ConnectRecord.TimerActive
+	(*ApplyConfigurationRequest)(nil),                       // 17: machine.ApplyConfigurationRequest
+	(*ApplyConfiguration)(nil),                              // 18: machine.ApplyConfiguration
+	(*ApplyConfigurationResponse)(nil),                      // 19: machine.ApplyConfigurationResponse
+	(*AttestRequest)(nil),                                   // 20: machine.AttestRequest
+	(*AttestationPCR)(nil),                                  // 21: machine.AttestationPCR
+	(*Attestation)(nil),                                     // 22: machine.Attestation
+	(*AttestResponse)(nil),                                  // 23: machine.AttestResponse
+	(*RebootRequest)(nil),                                   // 24: machine.RebootRequest
+	(*Reboot)(nil),                                          // 25: machine.Reboot
+	(*RebootResponse)(nil),                                  // 26: machine.RebootResponse
+	(*BootstrapRequest)(nil),                                // 27: machine.BootstrapRequest
+	(*Bootstrap)(nil),                                       // 28: machine.Bootstrap
+	(*BootstrapResponse)(nil),                               // 29: machine.BootstrapResponse
+	(*SequenceEvent)(nil),                                   // 30: machine.SequenceEvent
+	(*PhaseEvent)(nil),                                      // 31: machine.PhaseEvent
+	(*TaskEvent)(nil),                                       // 32: machine.TaskEvent
+	(*ServiceStateEvent)(nil),                               // 33: machine.ServiceStateEvent
+	(*RestartEvent)(nil),                                    // 34: machine.RestartEvent
+	(*ConfigLoadErrorEvent)(nil),                            // 35: machine.ConfigLoadErrorEvent
+	(*ConfigValidationErrorEvent)(nil),                      // 36: machine.ConfigValidationErrorEvent
+	(*AddressEvent)(nil),                                    // 37: machine.AddressEvent
+	(*VIPEvent)(nil),                                        // 38: machine.VIPEvent
+	(*StaticFallbackEvent)(nil),                             // 39: machine.StaticFallbackEvent
+	(*ProbeEvent)(nil),                                      // 40: machine.ProbeEvent
+	(*EtcdDefragmentationEvent)(nil),                        // 41: machine.EtcdDefragmentationEvent
+	(*EtcdLearnerStuckEvent)(nil),                           // 42: machine.EtcdLearnerStuckEvent
+	(*EtcdSnapshotEvent)(nil),                               // 43: machine.EtcdSnapshotEvent
+	(*EtcdAlarmEvent)(nil),                                  // 44: machine.EtcdAlarmEvent
+	(*CertificateExpiryEvent)(nil),                          // 45: machine.CertificateExpiryEvent
+	(*StaticPodRestartStormEvent)(nil),                      // 46: machine.StaticPodRestartStormEvent
+	(*KubeletShutdownEvent)(nil),                            // 47: machine.KubeletShutdownEvent
+	(*ShutdownImminentEvent)(nil),                           // 48: machine.ShutdownImminentEvent
+	(*UpgradeEvent)(nil),                                    // 49: machine.UpgradeEvent
+	(*ZombieProcessesEvent)(nil),                            // 50: machine.ZombieProcessesEvent
+	(*CertificateSigningEvent)(nil),                         // 51: machine.CertificateSigningEvent
+	(*APIAuthorizationDeniedEvent)(nil),                     // 52: machine.APIAuthorizationDeniedEvent
+	(*SecretsBundleExportEvent)(nil),                        // 53: machine.SecretsBundleExportEvent
+	(*JoinTokenEvent)(nil),                                  // 54: machine.JoinTokenEvent
+	(*IdentityConflictEvent)(nil),                           // 55: machine.IdentityConflictEvent
+	(*CertificateRevokedEvent)(nil),                         // 56: machine.CertificateRevokedEvent
+	(*DiskKeyRotationEvent)(nil),                            // 57: machine.DiskKeyRotationEvent
+	(*MachineStatusEvent)(nil),                              // 58: machine.MachineStatusEvent
+	(*EventsRequest)(nil),                                   // 59: machine.EventsRequest
+	(*Event)(nil),                                           // 60: machine.Event
+	(*ResetPartitionSpec)(nil),                              // 61: machine.ResetPartitionSpec
+	(*ResetRequest)(nil),                                    // 62: machine.ResetRequest
+	(*Reset)(nil),                                           // 63: machine.Reset
+	(*ResetResponse)(nil),                                   // 64: machine.ResetResponse
+	(*ResetIdentityRequest)(nil),                            // 65: machine.ResetIdentityRequest
+	(*ResetIdentity)(nil),                                   // 66: machine.ResetIdentity
+	(*ResetIdentityResponse)(nil),                           // 67: machine.ResetIdentityResponse
+	(*Shutdown)(nil),                                        // 68: machine.Shutdown
+	(*ShutdownRequest)(nil),                                 // 69: machine.ShutdownRequest
+	(*ShutdownResponse)(nil),                                // 70: machine.ShutdownResponse
+	(*InhibitorAcquireRequest)(nil),                         // 71: machine.InhibitorAcquireRequest
+	(*Inhibitor)(nil),                                       // 72: machine.Inhibitor
+	(*InhibitorAcquire)(nil),                                // 73: machine.InhibitorAcquire
+	(*InhibitorAcquireResponse)(nil),                        // 74: machine.InhibitorAcquireResponse
+	(*InhibitorList)(nil),                                   // 75: machine.InhibitorList
+	(*InhibitorListResponse)(nil),                           // 76: machine.InhibitorListResponse
+	(*InhibitorReleaseRequest)(nil),                         // 77: machine.InhibitorReleaseRequest
+	(*InhibitorRelease)(nil),                                // 78: machine.InhibitorRelease
+	(*InhibitorReleaseResponse)(nil),                        // 79: machine.InhibitorReleaseResponse
+	(*JoinTokenCreateRequest)(nil),                          // 80: machine.JoinTokenCreateRequest
+	(*JoinTokenCreate)(nil),                                 // 81: machine.JoinTokenCreate
+	(*JoinTokenCreateResponse)(nil),                         // 82: machine.JoinTokenCreateResponse
+	(*JoinTokenInfo)(nil),                                   // 83: machine.JoinTokenInfo
+	(*JoinTokenList)(nil),                                   // 84: machine.JoinTokenList
+	(*JoinTokenListResponse)(nil),                           // 85: machine.JoinTokenListResponse
+	(*JoinTokenRevokeRequest)(nil),                          // 86: machine.JoinTokenRevokeRequest
+	(*JoinTokenRevoke)(nil),                                 // 87: machine.JoinTokenRevoke
+	(*JoinTokenRevokeResponse)(nil),                         // 88: machine.JoinTokenRevokeResponse
+	(*UpgradeRequest)(nil),                                  // 89: machine.UpgradeRequest
+	(*Upgrade)(nil),                                         // 90: machine.Upgrade
+	(*UpgradeResponse)(nil),                                 // 91: machine.UpgradeResponse
+	(*SecretsBundleExportRequest)(nil),                      // 92: machine.SecretsBundleExportRequest
+	(*SecretsBundleExport)(nil),                             // 93: machine.SecretsBundleExport
+	(*SecretsBundleExportResponse)(nil),                     // 94: machine.SecretsBundleExportResponse
+	(*ServiceList)(nil),                                     // 95: machine.ServiceList
+	(*ServiceListResponse)(nil),                             // 96: machine.ServiceListResponse
+	(*ServiceInfo)(nil),                                     // 97: machine.ServiceInfo
+	(*ServiceEvents)(nil),                                   // 98: machine.ServiceEvents
+	(*ServiceEvent)(nil),                                    // 99: machine.ServiceEvent
+	(*ServiceHealth)(nil),                                   // 100: machine.ServiceHealth
+	(*ServiceDependenciesRequest)(nil),                      // 101: machine.ServiceDependenciesRequest
+	(*ServiceCondition)(nil),                                // 102: machine.ServiceCondition
+	(*ServiceDependencyInfo)(nil),                           // 103: machine.ServiceDependencyInfo
+	(*ServiceDependencies)(nil),                             // 104: machine.ServiceDependencies
+	(*ServiceDependenciesResponse)(nil),                     // 105: machine.ServiceDependenciesResponse
+	(*ServiceStartRequest)(nil),                             // 106: machine.ServiceStartRequest
+	(*ServiceStart)(nil),                                    // 107: machine.ServiceStart
+	(*ServiceStartResponse)(nil),                            // 108: machine.ServiceStartResponse
+	(*ServiceStopRequest)(nil),                              // 109: machine.ServiceStopRequest
+	(*ServiceStop)(nil),                                     // 110: machine.ServiceStop
+	(*ServiceStopResponse)(nil),                             // 111: machine.ServiceStopResponse
+	(*ServiceRestartRequest)(nil),                           // 112: machine.ServiceRestartRequest
+	(*ServiceRestart)(nil),                                  // 113: machine.ServiceRestart
+	(*ServiceRestartResponse)(nil),                          // 114: machine.ServiceRestartResponse
+	(*CopyRequest)(nil),                                     // 115: machine.CopyRequest
+	(*ListRequest)(nil),                                     // 116: machine.ListRequest
+	(*DiskUsageRequest)(nil),                                // 117: machine.DiskUsageRequest
+	(*FileInfo)(nil),                                        // 118: machine.FileInfo
+	(*Xattr)(nil),                                           // 119: machine.Xattr
+	(*DiskUsageInfo)(nil),                                   // 120: machine.DiskUsageInfo
+	(*Mounts)(nil),                                          // 121: machine.Mounts
+	(*MountsResponse)(nil),                                  // 122: machine.MountsResponse
+	(*MountStat)(nil),                                       // 123: machine.MountStat
+	(*Version)(nil),                                         // 124: machine.Version
+	(*VersionResponse)(nil),                                 // 125: machine.VersionResponse
+	(*VersionInfo)(nil),                                     // 126: machine.VersionInfo
+	(*PlatformInfo)(nil),                                    // 127: machine.PlatformInfo
+	(*FeaturesInfo)(nil),                                    // 128: machine.FeaturesInfo
+	(*LogsRequest)(nil),                                     // 129: machine.LogsRequest
+	(*ReadRequest)(nil),                                     // 130: machine.ReadRequest
+	(*LogsContainer)(nil),                                   // 131: machine.LogsContainer
+	(*LogsContainersResponse)(nil),                          // 132: machine.LogsContainersResponse
+	(*RollbackRequest)(nil),                                 // 133: machine.RollbackRequest
+	(*Rollback)(nil),                                        // 134: machine.Rollback
+	(*RollbackResponse)(nil),                                // 135: machine.RollbackResponse
+	(*RotateCertificatesRequest)(nil),                       // 136: machine.RotateCertificatesRequest
+	(*RotateCertificates)(nil),                              // 137: machine.RotateCertificates
+	(*RotateCertificatesResponse)(nil),                      // 138: machine.RotateCertificatesResponse
+	(*RotateDiskKeyRequest)(nil),                            // 139: machine.RotateDiskKeyRequest
+	(*RotateDiskKeySlot)(nil),                               // 140: machine.RotateDiskKeySlot
+	(*RotateDiskKey)(nil),                                   // 141: machine.RotateDiskKey
+	(*RotateDiskKeyResponse)(nil),                           // 142: machine.RotateDiskKeyResponse
+	(*KubernetesUpgradePreflightRequest)(nil),               // 143: machine.KubernetesUpgradePreflightRequest
+	(*KubernetesUpgradePreflightCheck)(nil),                 // 144: machine.KubernetesUpgradePreflightCheck
+	(*KubernetesUpgradePreflight)(nil),                      // 145: machine.KubernetesUpgradePreflight
+	(*KubernetesUpgradePreflightResponse)(nil),              // 146: machine.KubernetesUpgradePreflightResponse
+	(*ContainersRequest)(nil),                               // 147: machine.ContainersRequest
+	(*ContainerInfo)(nil),                                   // 148: machine.ContainerInfo
+	(*Container)(nil),                                       // 149: machine.Container
+	(*ContainersResponse)(nil),                              // 150: machine.ContainersResponse
+	(*DmesgRequest)(nil),                                    // 151: machine.DmesgRequest
+	(*ProcessesResponse)(nil),                               // 152: machine.ProcessesResponse
+	(*Process)(nil),                                         // 153: machine.Process
+	(*ProcessInfo)(nil),                                     // 154: machine.ProcessInfo
+	(*RestartRequest)(nil),                                  // 155: machine.RestartRequest
+	(*Restart)(nil),                                         // 156: machine.Restart
+	(*RestartResponse)(nil),                                 // 157: machine.RestartResponse
+	(*StatsRequest)(nil),                                    // 158: machine.StatsRequest
+	(*Stats)(nil),                                           // 159: machine.Stats
+	(*StatsResponse)(nil),                                   // 160: machine.StatsResponse
+	(*Stat)(nil),                                            // 161: machine.Stat
+	(*Memory)(nil),                                          // 162: machine.Memory
+	(*MemoryResponse)(nil),                                  // 163: machine.MemoryResponse
+	(*MemInfo)(nil),                                         // 164: machine.MemInfo
+	(*HostnameResponse)(nil),                                // 165: machine.HostnameResponse
+	(*Hostname)(nil),                                        // 166: machine.Hostname
+	(*LoadAvgResponse)(nil),                                 // 167: machine.LoadAvgResponse
+	(*LoadAvg)(nil),                                         // 168: machine.LoadAvg
+	(*SystemStatResponse)(nil),                              // 169: machine.SystemStatResponse
+	(*SystemStat)(nil),                                      // 170: machine.SystemStat
+	(*CPUStat)(nil),                                         // 171: machine.CPUStat
+	(*SoftIRQStat)(nil),                                     // 172: machine.SoftIRQStat
+	(*CPUFreqStatsResponse)(nil),                            // 173: machine.CPUFreqStatsResponse
+	(*CPUsFreqStats)(nil),                                   // 174: machine.CPUsFreqStats
+	(*CPUFreqStats)(nil),                                    // 175: machine.CPUFreqStats
+	(*CPUInfoResponse)(nil),                                 // 176: machine.CPUInfoResponse
+	(*CPUsInfo)(nil),                                        // 177: machine.CPUsInfo
+	(*CPUInfo)(nil),                                         // 178: machine.CPUInfo
+	(*NetworkDeviceStatsResponse)(nil),                      // 179: machine.NetworkDeviceStatsResponse
+	(*NetworkDeviceStats)(nil),                              // 180: machine.NetworkDeviceStats
+	(*NetDev)(nil),                                          // 181: machine.NetDev
+	(*DiskStatsResponse)(nil),                               // 182: machine.DiskStatsResponse
+	(*DiskStats)(nil),                                       // 183: machine.DiskStats
+	(*DiskStat)(nil),                                        // 184: machine.DiskStat
+	(*EtcdLeaveClusterRequest)(nil),                         // 185: machine.EtcdLeaveClusterRequest
+	(*EtcdLeaveCluster)(nil),                                // 186: machine.EtcdLeaveCluster
+	(*EtcdLeaveClusterResponse)(nil),                        // 187: machine.EtcdLeaveClusterResponse
+	(*EtcdRemoveMemberRequest)(nil),                         // 188: machine.EtcdRemoveMemberRequest
+	(*EtcdRemoveMember)(nil),                                // 189: machine.EtcdRemoveMember
+	(*EtcdRemoveMemberResponse)(nil),                        // 190: machine.EtcdRemoveMemberResponse
+	(*EtcdRemoveMemberByIDRequest)(nil),                     // 191: machine.EtcdRemoveMemberByIDRequest
+	(*EtcdRemoveMemberByID)(nil),                            // 192: machine.EtcdRemoveMemberByID
+	(*EtcdRemoveMemberByIDResponse)(nil),                    // 193: machine.EtcdRemoveMemberByIDResponse
+	(*EtcdPromoteMemberRequest)(nil),                        // 194: machine.EtcdPromoteMemberRequest
+	(*EtcdPromoteMember)(nil),                               // 195: machine.EtcdPromoteMember
+	(*EtcdPromoteMemberResponse)(nil),                       // 196: machine.EtcdPromoteMemberResponse
+	(*EtcdForfeitLeadershipRequest)(nil),                    // 197: machine.EtcdForfeitLeadershipRequest
+	(*EtcdForfeitLeadership)(nil),                           // 198: machine.EtcdForfeitLeadership
+	(*EtcdForfeitLeadershipResponse)(nil),                   // 199: machine.EtcdForfeitLeadershipResponse
+	(*EtcdMemberListRequest)(nil),                           // 200: machine.EtcdMemberListRequest
+	(*EtcdMember)(nil),                                      // 201: machine.EtcdMember
+	(*EtcdMembers)(nil),                                     // 202: machine.EtcdMembers
+	(*EtcdMemberListResponse)(nil),                          // 203: machine.EtcdMemberListResponse
+	(*EtcdSnapshotRequest)(nil),                             // 204: machine.EtcdSnapshotRequest
+	(*EtcdRecover)(nil),                                     // 205: machine.EtcdRecover
+	(*EtcdRecoverResponse)(nil),                             // 206: machine.EtcdRecoverResponse
+	(*EtcdAlarmListResponse)(nil),                           // 207: machine.EtcdAlarmListResponse
+	(*EtcdAlarm)(nil),                                       // 208: machine.EtcdAlarm
+	(*EtcdMemberAlarm)(nil),                                 // 209: machine.EtcdMemberAlarm
+	(*EtcdAlarmDisarmResponse)(nil),                         // 210: machine.EtcdAlarmDisarmResponse
+	(*EtcdAlarmDisarm)(nil),                                 // 211: machine.EtcdAlarmDisarm
+	(*EtcdDefragmentResponse)(nil),                          // 212: machine.EtcdDefragmentResponse
+	(*EtcdDefragment)(nil),                                  // 213: machine.EtcdDefragment
+	(*EtcdStatusResponse)(nil),                              // 214: machine.EtcdStatusResponse
+	(*EtcdStatus)(nil),                                      // 215: machine.EtcdStatus
+	(*EtcdMemberStatus)(nil),                                // 216: machine.EtcdMemberStatus
+	(*EtcdDowngradeValidateRequest)(nil),                    // 217: machine.EtcdDowngradeValidateRequest
+	(*EtcdDowngradeValidateResponse)(nil),                   // 218: machine.EtcdDowngradeValidateResponse
+	(*EtcdDowngradeValidate)(nil),                           // 219: machine.EtcdDowngradeValidate
+	(*EtcdDowngradeEnableRequest)(nil),                      // 220: machine.EtcdDowngradeEnableRequest
+	(*EtcdDowngradeEnableResponse)(nil),                     // 221: machine.EtcdDowngradeEnableResponse
+	(*EtcdDowngradeEnable)(nil),                             // 222: machine.EtcdDowngradeEnable
+	(*EtcdDowngradeCancelResponse)(nil),                     // 223: machine.EtcdDowngradeCancelResponse
+	(*EtcdDowngradeCancel)(nil),                             // 224: machine.EtcdDowngradeCancel
+	(*EtcdClusterDowngrade)(nil),                            // 225: machine.EtcdClusterDowngrade
+	(*RouteConfig)(nil),                                     // 226: machine.RouteConfig
+	(*DHCPOptionsConfig)(nil),                               // 227: machine.DHCPOptionsConfig
+	(*NetworkDeviceConfig)(nil),                             // 228: machine.NetworkDeviceConfig
+	(*NetworkConfig)(nil),                                   // 229: machine.NetworkConfig
+	(*InstallConfig)(nil),                                   // 230: machine.InstallConfig
+	(*MachineConfig)(nil),                                   // 231: machine.MachineConfig
+	(*ControlPlaneConfig)(nil),                              // 232: machine.ControlPlaneConfig
+	(*CNIConfig)(nil),                                       // 233: machine.CNIConfig
+	(*ClusterNetworkConfig)(nil),                            // 234: machine.ClusterNetworkConfig
+	(*ClusterConfig)(nil),                                   // 235: machine.ClusterConfig
+	(*GenerateConfigurationRequest)(nil),                    // 236: machine.GenerateConfigurationRequest
+	(*GenerateConfiguration)(nil),                           // 237: machine.GenerateConfiguration
+	(*GenerateConfigurationResponse)(nil),                   // 238: machine.GenerateConfigurationResponse
+	(*GenerateClientConfigurationRequest)(nil),              // 239: machine.GenerateClientConfigurationRequest
+	(*GenerateClientConfiguration)(nil),                     // 240: machine.GenerateClientConfiguration
+	(*GenerateClientConfigurationResponse)(nil),             // 241: machine.GenerateClientConfigurationResponse
+	(*PacketCaptureRequest)(nil),                            // 242: machine.PacketCaptureRequest
+	(*BPFInstruction)(nil),                                  // 243: machine.BPFInstruction
+	(*NetstatRequest)(nil),                                  // 244: machine.NetstatRequest
+	(*ConnectRecord)(nil),                                   // 245: machine.ConnectRecord
+	(*Netstat)(nil),                                         // 246: machine.Netstat
+	(*NetstatResponse)(nil),                                 // 247: machine.NetstatResponse
+	(*MetaWriteRequest)(nil),                                // 248: machine.MetaWriteRequest
+	(*MetaWrite)(nil),                                       // 249: machine.MetaWrite
+	(*MetaWriteResponse)(nil),                               // 250: machine.MetaWriteResponse
+	(*MetaDeleteRequest)(nil),                               // 251: machine.MetaDeleteRequest
+	(*MetaDelete)(nil),                                      // 252: machine.MetaDelete
+	(*MetaDeleteResponse)(nil),                              // 253: machine.MetaDeleteResponse
+	(*ImageListRequest)(nil),                                // 254: machine.ImageListRequest
+	(*ImageListResponse)(nil),                               // 255: machine.ImageListResponse
+	(*ImagePullRequest)(nil),                                // 256: machine.ImagePullRequest
+	(*ImagePull)(nil),                                       // 257: machine.ImagePull
+	(*ImagePullResponse)(nil),                               // 258: machine.ImagePullResponse
+	(*MachineStatusEvent_MachineStatus)(nil),                // 259: machine.MachineStatusEvent.MachineStatus
+	(*MachineStatusEvent_MachineStatus_UnmetCondition)(nil), // 260: machine.MachineStatusEvent.MachineStatus.UnmetCondition
+	(*NetstatRequest_Feature)(nil),                          // 261: machine.NetstatRequest.Feature
+	(*NetstatRequest_L4Proto)(nil),                          // 262: machine.NetstatRequest.L4proto
+	(*NetstatRequest_NetNS)(nil),                            // 263: machine.NetstatRequest.NetNS
+	(*ConnectRecord_Process)(nil),                           // 264: machine.ConnectRecord.Process
+	(*durationpb.Duration)(nil),                             // 265: google.protobuf.Duration
+	(*common.Metadata)(nil),                                 // 266: common.Metadata
+	(*common.Error)(nil),                                    // 267: common.Error
+	(*timestamppb.Timestamp)(nil),                           // 268: google.protobuf.Timestamp
+	(*anypb.Any)(nil),                                       // 269: google.protobuf.Any
+	(common.ContainerDriver)(0),                             // 270: common.ContainerDriver
+	(common.ContainerdNamespace)(0),                         // 271: common.ContainerdNamespace
+	(*emptypb.Empty)(nil),                                   // 272: google.protobuf.Empty
+	(*common.Data)(nil),                                     // 273: common.Data
 }
 var file_machine_machine_proto_depIdxs = []int32{
 	0,   // 0: machine.ApplyConfigurationRequest.mode:type_name -> machine.ApplyConfigurationRequest.Mode
-	196, // 1: machine.ApplyConfigurationRequest.try_mode_timeout:type_name -> google.protobuf.Duration
-	197, // 2: machine.ApplyConfiguration.metadata:type_name -> common.Metadata
+	265, // 1: machine.ApplyConfigurationRequest.try_mode_timeout:type_name -> google.protobuf.Duration
+	266, // 2: machine.ApplyConfiguration.metadata:type_name -> common.Metadata
 	0,   // 3: machine.ApplyConfiguration.mode:type_name -> machine.ApplyConfigurationRequest.Mode
-	16,  // 4: machine.ApplyConfigurationResponse.messages:type_name -> machine.ApplyConfiguration
-	1,   // 5: machine.RebootRequest.mode:type_name -> machine.RebootRequest.Mode
-	197, // 6: machine.Reboot.metadata:type_name -> common.Metadata
-	19,  // 7: machine.RebootResponse.messages:type_name -> machine.Reboot
-	197, // 8: machine.Bootstrap.metadata:type_name -> common.Metadata
-	22,  // 9: machine.BootstrapResponse.messages:type_name -> machine.Bootstrap
-	2,   // 10: machine.SequenceEvent.action:type_name -> machine.SequenceEvent.Action
-	198, // 11: machine.SequenceEvent.error:type_name -> common.Error
-	3,   // 12: machine.PhaseEvent.action:type_name -> machine.PhaseEvent.Action
-	4,   // 13: machine.TaskEvent.action:type_name -> machine.TaskEvent.Action
-	5,   // 14: machine.ServiceStateEvent.action:type_name -> machine.ServiceStateEvent.Action
-	50,  // 15: machine.ServiceStateEvent.health:type_name -> machine.ServiceHealth
-	6,   // 16: machine.MachineStatusEvent.stage:type_name -> machine.MachineStatusEvent.MachineStage
-	190, // 17: machine.MachineStatusEvent.status:type_name -> machine.MachineStatusEvent.MachineStatus
-	197, // 18: machine.Event.metadata:type_name -> common.Metadata
-	199, // 19: machine.Event.data:type_name -> google.protobuf.Any
-	35,  // 20: machine.ResetRequest.system_partitions_to_wipe:type_name -> machine.ResetPartitionSpec
-	7,   // 21: machine.ResetRequest.mode:type_name -> machine.ResetRequest.WipeMode
-	197, // 22: machine.Reset.metadata:type_name -> common.Metadata
-	37,  // 23: machine.ResetResponse.messages:type_name -> machine.Reset
-	197, // 24: machine.Shutdown.metadata:type_name -> common.Metadata
-	39,  // 25: machine.ShutdownResponse.messages:type_name -> machine.Shutdown
-	8,   // 26: machine.UpgradeRequest.reboot_mode:type_name -> machine.UpgradeRequest.RebootMode
-	197, // 27: machine.Upgrade.metadata:type_name -> common.Metadata
-	43,  // 28: machine.UpgradeResponse.messages:type_name -> machine.Upgrade
-	197, // 29: machine.ServiceList.metadata:type_name -> common.Metadata
-	47,  // 30: machine.ServiceList.services:type_name -> machine.ServiceInfo
-	45,  // 31: machine.ServiceListResponse.messages:type_name -> machine.ServiceList
-	48,  // 32: machine.ServiceInfo.events:type_name -> machine.ServiceEvents
-	50,  // 33: machine.ServiceInfo.health:type_name -> machine.ServiceHealth
-	49,  // 34: machine.ServiceEvents.events:type_name -> machine.ServiceEvent
-	200, // 35: machine.ServiceEvent.ts:type_name -> google.protobuf.Timestamp
-	200, // 36: machine.ServiceHealth.last_change:type_name -> google.protobuf.Timestamp
-	197, // 37: machine.ServiceStart.metadata:type_name -> common.Metadata
-	52,  // 38: machine.ServiceStartResponse.messages:type_name -> machine.ServiceStart
-	197, // 39: machine.ServiceStop.metadata:type_name -> common.Metadata
-	55,  // 40: machine.ServiceStopResponse.messages:type_name -> machine.ServiceStop
-	197, // 41: machine.ServiceRestart.metadata:type_name -> common.Metadata
-	58,  // 42: machine.ServiceRestartResponse.messages:type_name -> machine.ServiceRestart
-	9,   // 43: machine.ListRequest.types:type_name -> machine.ListRequest.Type
-	197, // 44: machine.FileInfo.metadata:type_name -> common.Metadata
-	64,  // 45: machine.FileInfo.xattrs:type_name -> machine.Xattr
-	197, // 46: machine.DiskUsageInfo.metadata:type_name -> common.Metadata
-	197, // 47: machine.Mounts.metadata:type_name -> common.Metadata
-	68,  // 48: machine.Mounts.stats:type_name -> machine.MountStat
-	66,  // 49: machine.MountsResponse.messages:type_name -> machine.Mounts
-	197, // 50: machine.Version.metadata:type_name -> common.Metadata
-	71,  // 51: machine.Version.version:type_name -> machine.VersionInfo
-	72,  // 52: machine.Version.platform:type_name -> machine.PlatformInfo
-	73,  // 53: machine.Version.features:type_name -> machine.FeaturesInfo
-	69,  // 54: machine.VersionResponse.messages:type_name -> machine.Version
-	201, // 55: machine.LogsRequest.driver:type_name -> common.ContainerDriver
-	197, // 56: machine.LogsContainer.metadata:type_name -> common.Metadata
-	76,  // 57: machine.LogsContainersResponse.messages:type_name -> machine.LogsContainer
-	197, // 58: machine.Rollback.metadata:type_name -> common.Metadata
-	79,  // 59: machine.RollbackResponse.messages:type_name -> machine.Rollback
-	201, // 60: machine.ContainersRequest.driver:type_name -> common.ContainerDriver
-	197, // 61: machine.Container.metadata:type_name -> common.Metadata
-	82,  // 62: machine.Container.containers:type_name -> machine.ContainerInfo
-	83,  // 63: machine.ContainersResponse.messages:type_name -> machine.Container
-	87,  // 64: machine.ProcessesResponse.messages:type_name -> machine.Process
-	197, // 65: machine.Process.metadata:type_name -> common.Metadata
-	88,  // 66: machine.Process.processes:type_name -> machine.ProcessInfo
-	201, // 67: machine.RestartRequest.driver:type_name -> common.ContainerDriver
-	197, // 68: machine.Restart.metadata:type_name -> common.Metadata
-	90,  // 69: machine.RestartResponse.messages:type_name -> machine.Restart
-	201, // 70: machine.StatsRequest.driver:type_name -> common.ContainerDriver
-	197, // 71: machine.Stats.metadata:type_name -> common.Metadata
-	95,  // 72: machine.Stats.stats:type_name -> machine.Stat
-	93,  // 73: machine.StatsResponse.messages:type_name -> machine.Stats
-	197, // 74: machine.Memory.metadata:type_name -> common.Metadata
-	98,  // 75: machine.Memory.meminfo:type_name -> machine.MemInfo
-	96,  // 76: machine.MemoryResponse.messages:type_name -> machine.Memory
-	100, // 77: machine.HostnameResponse.messages:type_name -> machine.Hostname
-	197, // 78: machine.Hostname.metadata:type_name -> common.Metadata
-	102, // 79: machine.LoadAvgResponse.messages:type_name -> machine.LoadAvg
-	197, // 80: machine.LoadAvg.metadata:type_name -> common.Metadata
-	104, // 81: machine.SystemStatResponse.messages:type_name -> machine.SystemStat
-	197, // 82: machine.SystemStat.metadata:type_name -> common.Metadata
-	105, // 83: machine.SystemStat.cpu_total:type_name -> machine.CPUStat
-	105, // 84: machine.SystemStat.cpu:type_name -> machine.CPUStat
-	106, // 85: machine.SystemStat.soft_irq:type_name -> machine.SoftIRQStat
-	108, // 86: machine.CPUFreqStatsResponse.messages:type_name -> machine.CPUsFreqStats
-	197, // 87: machine.CPUsFreqStats.metadata:type_name -> common.Metadata
-	109, // 88: machine.CPUsFreqStats.cpu_freq_stats:type_name -> machine.CPUFreqStats
-	111, // 89: machine.CPUInfoResponse.messages:type_name -> machine.CPUsInfo
-	197, // 90: machine.CPUsInfo.metadata:type_name -> common.Metadata
-	112, // 91: machine.CPUsInfo.cpu_info:type_name -> machine.CPUInfo
-	114, // 92: machine.NetworkDeviceStatsResponse.messages:type_name -> machine.NetworkDeviceStats
-	197, // 93: machine.NetworkDeviceStats.metadata:type_name -> common.Metadata
-	115, // 94: machine.NetworkDeviceStats.total:type_name -> machine.NetDev
-	115, // 95: machine.NetworkDeviceStats.devices:type_name -> machine.NetDev
-	117, // 96: machine.DiskStatsResponse.messages:type_name -> machine.DiskStats
-	197, // 97: machine.DiskStats.metadata:type_name -> common.Metadata
-	118, // 98: machine.DiskStats.total:type_name -> machine.DiskStat
-	118, // 99: machine.DiskStats.devices:type_name -> machine.DiskStat
-	197, // 100: machine.EtcdLeaveCluster.metadata:type_name -> common.Metadata
-	120, // 101: machine.EtcdLeaveClusterResponse.messages:type_name -> machine.EtcdLeaveCluster
-	197, // 102: machine.EtcdRemoveMember.metadata:type_name -> common.Metadata
-	123, // 103: machine.EtcdRemoveMemberResponse.messages:type_name -> machine.EtcdRemoveMember
-	197, // 104: machine.EtcdRemoveMemberByID.metadata:type_name -> common.Metadata
-	126, // 105: machine.EtcdRemoveMemberByIDResponse.messages:type_name -> machine.EtcdRemoveMemberByID
-	197, // 106: machine.EtcdForfeitLeadership.metadata:type_name -> common.Metadata
-	129, // 107: machine.EtcdForfeitLeadershipResponse.messages:type_name -> machine.EtcdForfeitLeadership
-	197, // 108: machine.EtcdMembers.metadata:type_name -> common.Metadata
-	132, // 109: machine.EtcdMembers.members:type_name -> machine.EtcdMember
-	133, // 110: machine.EtcdMemberListResponse.messages:type_name -> machine.EtcdMembers
-	197, // 111: machine.EtcdRecover.metadata:type_name -> common.Metadata
-	136, // 112: machine.EtcdRecoverResponse.messages:type_name -> machine.EtcdRecover
-	139, // 113: machine.EtcdAlarmListResponse.messages:type_name -> machine.EtcdAlarm
-	197, // 114: machine.EtcdAlarm.metadata:type_name -> common.Metadata
-	140, // 115: machine.EtcdAlarm.member_alarms:type_name -> machine.EtcdMemberAlarm
-	10,  // 116: machine.EtcdMemberAlarm.alarm:type_name -> machine.EtcdMemberAlarm.AlarmType
-	142, // 117: machine.EtcdAlarmDisarmResponse.messages:type_name -> machine.EtcdAlarmDisarm
-	197, // 118: machine.EtcdAlarmDisarm.metadata:type_name -> common.Metadata
-	140, // 119: machine.EtcdAlarmDisarm.member_alarms:type_name -> machine.EtcdMemberAlarm
-	144, // 120: machine.EtcdDefragmentResponse.messages:type_name -> machine.EtcdDefragment
-	197, // 121: machine.EtcdDefragment.metadata:type_name -> common.Metadata
-	146, // 122: machine.EtcdStatusResponse.messages:type_name -> machine.EtcdStatus
-	197, // 123: machine.EtcdStatus.metadata:type_name -> common.Metadata
-	147, // 124: machine.EtcdStatus.member_status:type_name -> machine.EtcdMemberStatus
-	150, // 125: machine.EtcdDowngradeValidateResponse.messages:type_name -> machine.EtcdDowngradeValidate
-	197, // 126: machine.EtcdDowngradeValidate.metadata:type_name -> common.Metadata
-	156, // 127: machine.EtcdDowngradeValidate.cluster_downgrade:type_name -> machine.EtcdClusterDowngrade
-	153, // 128: machine.EtcdDowngradeEnableResponse.messages:type_name -> machine.EtcdDowngradeEnable
-	197, // 129: machine.EtcdDowngradeEnable.metadata:type_name -> common.Metadata
-	156, // 130: machine.EtcdDowngradeEnable.cluster_downgrade:type_name -> machine.EtcdClusterDowngrade
-	155, // 131: machine.EtcdDowngradeCancelResponse.messages:type_name -> machine.EtcdDowngradeCancel
-	197, // 132: machine.EtcdDowngradeCancel.metadata:type_name -> common.Metadata
-	156, // 133: machine.EtcdDowngradeCancel.cluster_downgrade:type_name -> machine.EtcdClusterDowngrade
-	158, // 134: machine.NetworkDeviceConfig.dhcp_options:type_name -> machine.DHCPOptionsConfig
-	157, // 135: machine.NetworkDeviceConfig.routes:type_name -> machine.RouteConfig
-	159, // 136: machine.NetworkConfig.interfaces:type_name -> machine.NetworkDeviceConfig
-	11,  // 137: machine.MachineConfig.type:type_name -> machine.MachineConfig.MachineType
-	161, // 138: machine.MachineConfig.install_config:type_name -> machine.InstallConfig
-	160, // 139: machine.MachineConfig.network_config:type_name -> machine.NetworkConfig
-	164, // 140: machine.ClusterNetworkConfig.cni_config:type_name -> machine.CNIConfig
-	163, // 141: machine.ClusterConfig.control_plane:type_name -> machine.ControlPlaneConfig
-	165, // 142: machine.ClusterConfig.cluster_network:type_name -> machine.ClusterNetworkConfig
-	166, // 143: machine.GenerateConfigurationRequest.cluster_config:type_name -> machine.ClusterConfig
-	162, // 144: machine.GenerateConfigurationRequest.machine_config:type_name -> machine.MachineConfig
-	200, // 145: machine.GenerateConfigurationRequest.override_time:type_name -> google.protobuf.Timestamp
-	197, // 146: machine.GenerateConfiguration.metadata:type_name -> common.Metadata
-	168, // 147: machine.GenerateConfigurationResponse.messages:type_name -> machine.GenerateConfiguration
-	196, // 148: machine.GenerateClientConfigurationRequest.crt_ttl:type_name -> google.protobuf.Duration
-	197, // 149: machine.GenerateClientConfiguration.metadata:type_name -> common.Metadata
-	171, // 150: machine.GenerateClientConfigurationResponse.messages:type_name -> machine.GenerateClientConfiguration
-	174, // 151: machine.PacketCaptureRequest.bpf_filter:type_name -> machine.BPFInstruction
-	12,  // 152: machine.NetstatRequest.filter:type_name -> machine.NetstatRequest.Filter
-	192, // 153: machine.NetstatRequest.feature:type_name -> machine.NetstatRequest.Feature
-	193, // 154: machine.NetstatRequest.l4proto:type_name -> machine.NetstatRequest.L4proto
-	194, // 155: machine.NetstatRequest.netns:type_name -> machine.NetstatRequest.NetNS
-	13,  // 156: machine.ConnectRecord.state:type_name -> machine.ConnectRecord.State
-	14,  // 157: machine.ConnectRecord.tr:type_name -> machine.ConnectRecord.TimerActive
-	195, // 158: machine.ConnectRecord.process:type_name -> machine.ConnectRecord.Process
-	197, // 159: machine.Netstat.metadata:type_name -> common.Metadata
-	176, // 160: machine.Netstat.connectrecord:type_name -> machine.ConnectRecord
-	177, // 161: machine.NetstatResponse.messages:type_name -> machine.Netstat
-	197, // 162: machine.MetaWrite.metadata:type_name -> common.Metadata
-	180, // 163: machine.MetaWriteResponse.messages:type_name -> machine.MetaWrite
-	197, // 164: machine.MetaDelete.metadata:type_name -> common.Metadata
-	183, // 165: machine.MetaDeleteResponse.messages:type_name -> machine.MetaDelete
-	202, // 166: machine.ImageListRequest.namespace:type_name -> common.ContainerdNamespace
-	197, // 167: machine.ImageListResponse.metadata:type_name -> common.Metadata
-	200, // 168: machine.ImageListResponse.created_at:type_name -> google.protobuf.Timestamp
-	202, // 169: machine.ImagePullRequest.namespace:type_name -> common.ContainerdNamespace
-	197, // 170: machine.ImagePull.metadata:type_name -> common.Metadata
-	188, // 171: machine.ImagePullResponse.messages:type_name -> machine.ImagePull
-	191, // 172: machine.MachineStatusEvent.MachineStatus.unmet_conditions:type_name -> machine.MachineStatusEvent.MachineStatus.UnmetCondition
-	15,  // 173: machine.MachineService.ApplyConfiguration:input_type -> machine.ApplyConfigurationRequest
-	21,  // 174: machine.MachineService.Bootstrap:input_type -> machine.BootstrapRequest
-	81,  // 175: machine.MachineService.Containers:input_type -> machine.ContainersRequest
-	60,  // 176: machine.MachineService.Copy:input_type -> machine.CopyRequest
-	203, // 177: machine.MachineService.CPUFreqStats:input_type -> google.protobuf.Empty
-	203, // 178: machine.MachineService.CPUInfo:input_type -> google.protobuf.Empty
-	203, // 179: machine.MachineService.DiskStats:input_type -> google.protobuf.Empty
-	85,  // 180: machine.MachineService.Dmesg:input_type -> machine.DmesgRequest
-	33,  // 181: machine.MachineService.Events:input_type -> machine.EventsRequest
-	131, // 182: machine.MachineService.EtcdMemberList:input_type -> machine.EtcdMemberListRequest
-	125, // 183: machine.MachineService.EtcdRemoveMemberByID:input_type -> machine.EtcdRemoveMemberByIDRequest
-	119, // 184: machine.MachineService.EtcdLeaveCluster:input_type -> machine.EtcdLeaveClusterRequest
-	128, // 185: machine.MachineService.EtcdForfeitLeadership:input_type -> machine.EtcdForfeitLeadershipRequest
-	204, // 186: machine.MachineService.EtcdRecover:input_type -> common.Data
-	135, // 187: machine.MachineService.EtcdSnapshot:input_type -> machine.EtcdSnapshotRequest
-	203, // 188: machine.MachineService.EtcdAlarmList:input_type -> google.protobuf.Empty
-	203, // 189: machine.MachineService.EtcdAlarmDisarm:input_type -> google.protobuf.Empty
-	203, // 190: machine.MachineService.EtcdDefragment:input_type -> google.protobuf.Empty
-	203, // 191: machine.MachineService.EtcdStatus:input_type -> google.protobuf.Empty
-	148, // 192: machine.MachineService.EtcdDowngradeValidate:input_type -> machine.EtcdDowngradeValidateRequest
-	151, // 193: machine.MachineService.EtcdDowngradeEnable:input_type -> machine.EtcdDowngradeEnableRequest
-	203, // 194: machine.MachineService.EtcdDowngradeCancel:input_type -> google.protobuf.Empty
-	167, // 195: machine.MachineService.GenerateConfiguration:input_type -> machine.GenerateConfigurationRequest
-	203, // 196: machine.MachineService.Hostname:input_type -> google.protobuf.Empty
-	203, // 197: machine.MachineService.Kubeconfig:input_type -> google.protobuf.Empty
-	61,  // 198: machine.MachineService.List:input_type -> machine.ListRequest
-	62,  // 199: machine.MachineService.DiskUsage:input_type -> machine.DiskUsageRequest
-	203, // 200: machine.MachineService.LoadAvg:input_type -> google.protobuf.Empty
-	74,  // 201: machine.MachineService.Logs:input_type -> machine.LogsRequest
-	203, // 202: machine.MachineService.LogsContainers:input_type -> google.protobuf.Empty
-	203, // 203: machine.MachineService.Memory:input_type -> google.protobuf.Empty
-	203, // 204: machine.MachineService.Mounts:input_type -> google.protobuf.Empty
-	203, // 205: machine.MachineService.NetworkDeviceStats:input_type -> google.protobuf.Empty
-	203, // 206: machine.MachineService.Processes:input_type -> google.protobuf.Empty
-	75,  // 207: machine.MachineService.Read:input_type -> machine.ReadRequest
-	18,  // 208: machine.MachineService.Reboot:input_type -> machine.RebootRequest
-	89,  // 209: machine.MachineService.Restart:input_type -> machine.RestartRequest
-	78,  // 210: machine.MachineService.Rollback:input_type -> machine.RollbackRequest
-	36,  // 211: machine.MachineService.Reset:input_type -> machine.ResetRequest
-	203, // 212: machine.MachineService.ServiceList:input_type -> google.protobuf.Empty
-	57,  // 213: machine.MachineService.ServiceRestart:input_type -> machine.ServiceRestartRequest
-	51,  // 214: machine.MachineService.ServiceStart:input_type -> machine.ServiceStartRequest
-	54,  // 215: machine.MachineService.ServiceStop:input_type -> machine.ServiceStopRequest
-	40,  // 216: machine.MachineService.Shutdown:input_type -> machine.ShutdownRequest
-	92,  // 217: machine.MachineService.Stats:input_type -> machine.StatsRequest
-	203, // 218: machine.MachineService.SystemStat:input_type -> google.protobuf.Empty
-	42,  // 219: machine.MachineService.Upgrade:input_type -> machine.UpgradeRequest
-	203, // 220: machine.MachineService.Version:input_type -> google.protobuf.Empty
-	170, // 221: machine.MachineService.GenerateClientConfiguration:input_type -> machine.GenerateClientConfigurationRequest
-	173, // 222: machine.MachineService.PacketCapture:input_type -> machine.PacketCaptureRequest
-	175, // 223: machine.MachineService.Netstat:input_type -> machine.NetstatRequest
-	179, // 224: machine.MachineService.MetaWrite:input_type -> machine.MetaWriteRequest
-	182, // 225: machine.MachineService.MetaDelete:input_type -> machine.MetaDeleteRequest
-	185, // 226: machine.MachineService.ImageList:input_type -> machine.ImageListRequest
-	187, // 227: machine.MachineService.ImagePull:input_type -> machine.ImagePullRequest
-	17,  // 228: machine.MachineService.ApplyConfiguration:output_type -> machine.ApplyConfigurationResponse
-	23,  // 229: machine.MachineService.Bootstrap:output_type -> machine.BootstrapResponse
-	84,  // 230: machine.MachineService.Containers:output_type -> machine.ContainersResponse
-	204, // 231: machine.MachineService.Copy:output_type -> common.Data
-	107, // 232: machine.MachineService.CPUFreqStats:output_type -> machine.CPUFreqStatsResponse
-	110, // 233: machine.MachineService.CPUInfo:output_type -> machine.CPUInfoResponse
-	116, // 234: machine.MachineService.DiskStats:output_type -> machine.DiskStatsResponse
-	204, // 235: machine.MachineService.Dmesg:output_type -> common.Data
-	34,  // 236: machine.MachineService.Events:output_type -> machine.Event
-	134, // 237: machine.MachineService.EtcdMemberList:output_type -> machine.EtcdMemberListResponse
-	127, // 238: machine.MachineService.EtcdRemoveMemberByID:output_type -> machine.EtcdRemoveMemberByIDResponse
-	121, // 239: machine.MachineService.EtcdLeaveCluster:output_type -> machine.EtcdLeaveClusterResponse
-	130, // 240: machine.MachineService.EtcdForfeitLeadership:output_type -> machine.EtcdForfeitLeadershipResponse
-	137, // 241: machine.MachineService.EtcdRecover:output_type -> machine.EtcdRecoverResponse
-	204, // 242: machine.MachineService.EtcdSnapshot:output_type -> common.Data
-	138, // 243: machine.MachineService.EtcdAlarmList:output_type -> machine.EtcdAlarmListResponse
-	141, // 244: machine.MachineService.EtcdAlarmDisarm:output_type -> machine.EtcdAlarmDisarmResponse
-	143, // 245: machine.MachineService.EtcdDefragment:output_type -> machine.EtcdDefragmentResponse
-	145, // 246: machine.MachineService.EtcdStatus:output_type -> machine.EtcdStatusResponse
-	149, // 247: machine.MachineService.EtcdDowngradeValidate:output_type -> machine.EtcdDowngradeValidateResponse
-	152, // 248: machine.MachineService.EtcdDowngradeEnable:output_type -> machine.EtcdDowngradeEnableResponse
-	154, // 249: machine.MachineService.EtcdDowngradeCancel:output_type -> machine.EtcdDowngradeCancelResponse
-	169, // 250: machine.MachineService.GenerateConfiguration:output_type -> machine.GenerateConfigurationResponse
-	99,  // 251: machine.MachineService.Hostname:output_type -> machine.HostnameResponse
-	204, // 252: machine.MachineService.Kubeconfig:output_type -> common.Data
-	63,  // 253: machine.MachineService.List:output_type -> machine.FileInfo
-	65,  // 254: machine.MachineService.DiskUsage:output_type -> machine.DiskUsageInfo
-	101, // 255: machine.MachineService.LoadAvg:output_type -> machine.LoadAvgResponse
-	204, // 256: machine.MachineService.Logs:output_type -> common.Data
-	77,  // 257: machine.MachineService.LogsContainers:output_type -> machine.LogsContainersResponse
-	97,  // 258: machine.MachineService.Memory:output_type -> machine.MemoryResponse
-	67,  // 259: machine.MachineService.Mounts:output_type -> machine.MountsResponse
-	113, // 260: machine.MachineService.NetworkDeviceStats:output_type -> machine.NetworkDeviceStatsResponse
-	86,  // 261: machine.MachineService.Processes:output_type -> machine.ProcessesResponse
-	204, // 262: machine.MachineService.Read:output_type -> common.Data
-	20,  // 263: machine.MachineService.Reboot:output_type -> machine.RebootResponse
-	91,  // 264: machine.MachineService.Restart:output_type -> machine.RestartResponse
-	80,  // 265: machine.MachineService.Rollback:output_type -> machine.RollbackResponse
-	38,  // 266: machine.MachineService.Reset:output_type -> machine.ResetResponse
-	46,  // 267: machine.MachineService.ServiceList:output_type -> machine.ServiceListResponse
-	59,  // 268: machine.MachineService.ServiceRestart:output_type -> machine.ServiceRestartResponse
-	53,  // 269: machine.MachineService.ServiceStart:output_type -> machine.ServiceStartResponse
-	56,  // 270: machine.MachineService.ServiceStop:output_type -> machine.ServiceStopResponse
-	41,  // 271: machine.MachineService.Shutdown:output_type -> machine.ShutdownResponse
-	94,  // 272: machine.MachineService.Stats:output_type -> machine.StatsResponse
-	103, // 273: machine.MachineService.SystemStat:output_type -> machine.SystemStatResponse
-	44,  // 274: machine.MachineService.Upgrade:output_type -> machine.UpgradeResponse
-	70,  // 275: machine.MachineService.Version:output_type -> machine.VersionResponse
-	172, // 276: machine.MachineService.GenerateClientConfiguration:output_type -> machine.GenerateClientConfigurationResponse
-	204, // 277: machine.MachineService.PacketCapture:output_type -> common.Data
-	178, // 278: machine.MachineService.Netstat:output_type -> machine.NetstatResponse
-	181, // 279: machine.MachineService.MetaWrite:output_type -> machine.MetaWriteResponse
-	184, // 280: machine.MachineService.MetaDelete:output_type -> machine.MetaDeleteResponse
-	186, // 281: machine.MachineService.ImageList:output_type -> machine.ImageListResponse
-	189, // 282: machine.MachineService.ImagePull:output_type -> machine.ImagePullResponse
-	228, // [228:283] is the sub-list for method output_type
-	173, // [173:228] is the sub-list for method input_type
-	173, // [173:173] is the sub-list for extension type_name
-	173, // [173:173] is the sub-list for extension extendee
-	0,   // [0:173] is the sub-list for field type_name
+	18,  // 4: machine.ApplyConfigurationResponse.messages:type_name -> machine.ApplyConfiguration
+	266, // 5: machine.Attestation.metadata:type_name -> common.Metadata
+	21,  // 6: machine.Attestation.pcrs:type_name -> machine.AttestationPCR
+	22,  // 7: machine.AttestResponse.messages:type_name -> machine.Attestation
+	1,   // 8: machine.RebootRequest.mode:type_name -> machine.RebootRequest.Mode
+	266, // 9: machine.Reboot.metadata:type_name -> common.Metadata
+	25,  // 10: machine.RebootResponse.messages:type_name -> machine.Reboot
+	266, // 11: machine.Bootstrap.metadata:type_name -> common.Metadata
+	28,  // 12: machine.BootstrapResponse.messages:type_name -> machine.Bootstrap
+	2,   // 13: machine.SequenceEvent.action:type_name -> machine.SequenceEvent.Action
+	267, // 14: machine.SequenceEvent.error:type_name -> common.Error
+	3,   // 15: machine.PhaseEvent.action:type_name -> machine.PhaseEvent.Action
+	4,   // 16: machine.TaskEvent.action:type_name -> machine.TaskEvent.Action
+	5,   // 17: machine.ServiceStateEvent.action:type_name -> machine.ServiceStateEvent.Action
+	100, // 18: machine.ServiceStateEvent.health:type_name -> machine.ServiceHealth
+	265, // 19: machine.EtcdDefragmentationEvent.duration:type_name -> google.protobuf.Duration
+	265, // 20: machine.EtcdLearnerStuckEvent.learner_for:type_name -> google.protobuf.Duration
+	265, // 21: machine.EtcdSnapshotEvent.duration:type_name -> google.protobuf.Duration
+	268, // 22: machine.CertificateExpiryEvent.not_after:type_name -> google.protobuf.Timestamp
+	265, // 23: machine.KubeletShutdownEvent.grace_period:type_name -> google.protobuf.Duration
+	265, // 24: machine.KubeletShutdownEvent.wait_duration:type_name -> google.protobuf.Duration
+	265, // 25: machine.ShutdownImminentEvent.max_wait:type_name -> google.protobuf.Duration
+	6,   // 26: machine.JoinTokenEvent.action:type_name -> machine.JoinTokenEvent.Action
+	7,   // 27: machine.MachineStatusEvent.stage:type_name -> machine.MachineStatusEvent.MachineStage
+	259, // 28: machine.MachineStatusEvent.status:type_name -> machine.MachineStatusEvent.MachineStatus
+	266, // 29: machine.Event.metadata:type_name -> common.Metadata
+	269, // 30: machine.Event.data:type_name -> google.protobuf.Any
+	61,  // 31: machine.ResetRequest.system_partitions_to_wipe:type_name -> machine.ResetPartitionSpec
+	8,   // 32: machine.ResetRequest.mode:type_name -> machine.ResetRequest.WipeMode
+	266, // 33: machine.Reset.metadata:type_name -> common.Metadata
+	63,  // 34: machine.ResetResponse.messages:type_name -> machine.Reset
+	266, // 35: machine.ResetIdentity.metadata:type_name -> common.Metadata
+	66,  // 36: machine.ResetIdentityResponse.messages:type_name -> machine.ResetIdentity
+	266, // 37: machine.Shutdown.metadata:type_name -> common.Metadata
+	68,  // 38: machine.ShutdownResponse.messages:type_name -> machine.Shutdown
+	265, // 39: machine.InhibitorAcquireRequest.max_hold:type_name -> google.protobuf.Duration
+	265, // 40: machine.Inhibitor.max_hold:type_name -> google.protobuf.Duration
+	268, // 41: machine.Inhibitor.acquired:type_name -> google.protobuf.Timestamp
+	266, // 42: machine.InhibitorAcquire.metadata:type_name -> common.Metadata
+	72,  // 43: machine.InhibitorAcquire.inhibitor:type_name -> machine.Inhibitor
+	73,  // 44: machine.InhibitorAcquireResponse.messages:type_name -> machine.InhibitorAcquire
+	266, // 45: machine.InhibitorList.metadata:type_name -> common.Metadata
+	72,  // 46: machine.InhibitorList.inhibitors:type_name -> machine.Inhibitor
+	75,  // 47: machine.InhibitorListResponse.messages:type_name -> machine.InhibitorList
+	266, // 48: machine.InhibitorRelease.metadata:type_name -> common.Metadata
+	78,  // 49: machine.InhibitorReleaseResponse.messages:type_name -> machine.InhibitorRelease
+	268, // 50: machine.JoinTokenCreateRequest.expires_at:type_name -> google.protobuf.Timestamp
+	266, // 51: machine.JoinTokenCreate.metadata:type_name -> common.Metadata
+	81,  // 52: machine.JoinTokenCreateResponse.messages:type_name -> machine.JoinTokenCreate
+	268, // 53: machine.JoinTokenInfo.created_at:type_name -> google.protobuf.Timestamp
+	268, // 54: machine.JoinTokenInfo.expires_at:type_name -> google.protobuf.Timestamp
+	266, // 55: machine.JoinTokenList.metadata:type_name -> common.Metadata
+	83,  // 56: machine.JoinTokenList.tokens:type_name -> machine.JoinTokenInfo
+	84,  // 57: machine.JoinTokenListResponse.messages:type_name -> machine.JoinTokenList
+	266, // 58: machine.JoinTokenRevoke.metadata:type_name -> common.Metadata
+	87,  // 59: machine.JoinTokenRevokeResponse.messages:type_name -> machine.JoinTokenRevoke
+	9,   // 60: machine.UpgradeRequest.reboot_mode:type_name -> machine.UpgradeRequest.RebootMode
+	266, // 61: machine.Upgrade.metadata:type_name -> common.Metadata
+	90,  // 62: machine.UpgradeResponse.messages:type_name -> machine.Upgrade
+	266, // 63: machine.SecretsBundleExport.metadata:type_name -> common.Metadata
+	93,  // 64: machine.SecretsBundleExportResponse.messages:type_name -> machine.SecretsBundleExport
+	266, // 65: machine.ServiceList.metadata:type_name -> common.Metadata
+	97,  // 66: machine.ServiceList.services:type_name -> machine.ServiceInfo
+	95,  // 67: machine.ServiceListResponse.messages:type_name -> machine.ServiceList
+	98,  // 68: machine.ServiceInfo.events:type_name -> machine.ServiceEvents
+	100, // 69: machine.ServiceInfo.health:type_name -> machine.ServiceHealth
+	99,  // 70: machine.ServiceEvents.events:type_name -> machine.ServiceEvent
+	268, // 71: machine.ServiceEvent.ts:type_name -> google.protobuf.Timestamp
+	268, // 72: machine.ServiceHealth.last_change:type_name -> google.protobuf.Timestamp
+	102, // 73: machine.ServiceDependencyInfo.conditions:type_name -> machine.ServiceCondition
+	266, // 74: machine.ServiceDependencies.metadata:type_name -> common.Metadata
+	103, // 75: machine.ServiceDependencies.services:type_name -> machine.ServiceDependencyInfo
+	104, // 76: machine.ServiceDependenciesResponse.messages:type_name -> machine.ServiceDependencies
+	266, // 77: machine.ServiceStart.metadata:type_name -> common.Metadata
+	107, // 78: machine.ServiceStartResponse.messages:type_name -> machine.ServiceStart
+	266, // 79: machine.ServiceStop.metadata:type_name -> common.Metadata
+	110, // 80: machine.ServiceStopResponse.messages:type_name -> machine.ServiceStop
+	266, // 81: machine.ServiceRestart.metadata:type_name -> common.Metadata
+	113, // 82: machine.ServiceRestartResponse.messages:type_name -> machine.ServiceRestart
+	10,  // 83: machine.ListRequest.types:type_name -> machine.ListRequest.Type
+	266, // 84: machine.FileInfo.metadata:type_name -> common.Metadata
+	119, // 85: machine.FileInfo.xattrs:type_name -> machine.Xattr
+	266, // 86: machine.DiskUsageInfo.metadata:type_name -> common.Metadata
+	266, // 87: machine.Mounts.metadata:type_name -> common.Metadata
+	123, // 88: machine.Mounts.stats:type_name -> machine.MountStat
+	121, // 89: machine.MountsResponse.messages:type_name -> machine.Mounts
+	266, // 90: machine.Version.metadata:type_name -> common.Metadata
+	126, // 91: machine.Version.version:type_name -> machine.VersionInfo
+	127, // 92: machine.Version.platform:type_name -> machine.PlatformInfo
+	128, // 93: machine.Version.features:type_name -> machine.FeaturesInfo
+	124, // 94: machine.VersionResponse.messages:type_name -> machine.Version
+	270, // 95: machine.LogsRequest.driver:type_name -> common.ContainerDriver
+	266, // 96: machine.LogsContainer.metadata:type_name -> common.Metadata
+	131, // 97: machine.LogsContainersResponse.messages:type_name -> machine.LogsContainer
+	266, // 98: machine.Rollback.metadata:type_name -> common.Metadata
+	134, // 99: machine.RollbackResponse.messages:type_name -> machine.Rollback
+	266, // 100: machine.RotateCertificates.metadata:type_name -> common.Metadata
+	137, // 101: machine.RotateCertificatesResponse.messages:type_name -> machine.RotateCertificates
+	266, // 102: machine.RotateDiskKey.metadata:type_name -> common.Metadata
+	140, // 103: machine.RotateDiskKey.slots:type_name -> machine.RotateDiskKeySlot
+	141, // 104: machine.RotateDiskKeyResponse.messages:type_name -> machine.RotateDiskKey
+	11,  // 105: machine.KubernetesUpgradePreflightCheck.severity:type_name -> machine.KubernetesUpgradePreflightCheck.Severity
+	266, // 106: machine.KubernetesUpgradePreflight.metadata:type_name -> common.Metadata
+	144, // 107: machine.KubernetesUpgradePreflight.checks:type_name -> machine.KubernetesUpgradePreflightCheck
+	145, // 108: machine.KubernetesUpgradePreflightResponse.messages:type_name -> machine.KubernetesUpgradePreflight
+	270, // 109: machine.ContainersRequest.driver:type_name -> common.ContainerDriver
+	266, // 110: machine.Container.metadata:type_name -> common.Metadata
+	148, // 111: machine.Container.containers:type_name -> machine.ContainerInfo
+	149, // 112: machine.ContainersResponse.messages:type_name -> machine.Container
+	153, // 113: machine.ProcessesResponse.messages:type_name -> machine.Process
+	266, // 114: machine.Process.metadata:type_name -> common.Metadata
+	154, // 115: machine.Process.processes:type_name -> machine.ProcessInfo
+	270, // 116: machine.RestartRequest.driver:type_name -> common.ContainerDriver
+	266, // 117: machine.Restart.metadata:type_name -> common.Metadata
+	156, // 118: machine.RestartResponse.messages:type_name -> machine.Restart
+	270, // 119: machine.StatsRequest.driver:type_name -> common.ContainerDriver
+	266, // 120: machine.Stats.metadata:type_name -> common.Metadata
+	161, // 121: machine.Stats.stats:type_name -> machine.Stat
+	159, // 122: machine.StatsResponse.messages:type_name -> machine.Stats
+	266, // 123: machine.Memory.metadata:type_name -> common.Metadata
+	164, // 124: machine.Memory.meminfo:type_name -> machine.MemInfo
+	162, // 125: machine.MemoryResponse.messages:type_name -> machine.Memory
+	166, // 126: machine.HostnameResponse.messages:type_name -> machine.Hostname
+	266, // 127: machine.Hostname.metadata:type_name -> common.Metadata
+	168, // 128: machine.LoadAvgResponse.messages:type_name -> machine.LoadAvg
+	266, // 129: machine.LoadAvg.metadata:type_name -> common.Metadata
+	170, // 130: machine.SystemStatResponse.messages:type_name -> machine.SystemStat
+	266, // 131: machine.SystemStat.metadata:type_name -> common.Metadata
+	171, // 132: machine.SystemStat.cpu_total:type_name -> machine.CPUStat
+	171, // 133: machine.SystemStat.cpu:type_name -> machine.CPUStat
+	172, // 134: machine.SystemStat.soft_irq:type_name -> machine.SoftIRQStat
+	174, // 135: machine.CPUFreqStatsResponse.messages:type_name -> machine.CPUsFreqStats
+	266, // 136: machine.CPUsFreqStats.metadata:type_name -> common.Metadata
+	175, // 137: machine.CPUsFreqStats.cpu_freq_stats:type_name -> machine.CPUFreqStats
+	177, // 138: machine.CPUInfoResponse.messages:type_name -> machine.CPUsInfo
+	266, // 139: machine.CPUsInfo.metadata:type_name -> common.Metadata
+	178, // 140: machine.CPUsInfo.cpu_info:type_name -> machine.CPUInfo
+	180, // 141: machine.NetworkDeviceStatsResponse.messages:type_name -> machine.NetworkDeviceStats
+	266, // 142: machine.NetworkDeviceStats.metadata:type_name -> common.Metadata
+	181, // 143: machine.NetworkDeviceStats.total:type_name -> machine.NetDev
+	181, // 144: machine.NetworkDeviceStats.devices:type_name -> machine.NetDev
+	183, // 145: machine.DiskStatsResponse.messages:type_name -> machine.DiskStats
+	266, // 146: machine.DiskStats.metadata:type_name -> common.Metadata
+	184, // 147: machine.DiskStats.total:type_name -> machine.DiskStat
+	184, // 148: machine.DiskStats.devices:type_name -> machine.DiskStat
+	266, // 149: machine.EtcdLeaveCluster.metadata:type_name -> common.Metadata
+	186, // 150: machine.EtcdLeaveClusterResponse.messages:type_name -> machine.EtcdLeaveCluster
+	266, // 151: machine.EtcdRemoveMember.metadata:type_name -> common.Metadata
+	189, // 152: machine.EtcdRemoveMemberResponse.messages:type_name -> machine.EtcdRemoveMember
+	266, // 153: machine.EtcdRemoveMemberByID.metadata:type_name -> common.Metadata
+	192, // 154: machine.EtcdRemoveMemberByIDResponse.messages:type_name -> machine.EtcdRemoveMemberByID
+	266, // 155: machine.EtcdPromoteMember.metadata:type_name -> common.Metadata
+	195, // 156: machine.EtcdPromoteMemberResponse.messages:type_name -> machine.EtcdPromoteMember
+	266, // 157: machine.EtcdForfeitLeadership.metadata:type_name -> common.Metadata
+	198, // 158: machine.EtcdForfeitLeadershipResponse.messages:type_name -> machine.EtcdForfeitLeadership
+	266, // 159: machine.EtcdMembers.metadata:type_name -> common.Metadata
+	201, // 160: machine.EtcdMembers.members:type_name -> machine.EtcdMember
+	202, // 161: machine.EtcdMemberListResponse.messages:type_name -> machine.EtcdMembers
+	266, // 162: machine.EtcdRecover.metadata:type_name -> common.Metadata
+	205, // 163: machine.EtcdRecoverResponse.messages:type_name -> machine.EtcdRecover
+	208, // 164: machine.EtcdAlarmListResponse.messages:type_name -> machine.EtcdAlarm
+	266, // 165: machine.EtcdAlarm.metadata:type_name -> common.Metadata
+	209, // 166: machine.EtcdAlarm.member_alarms:type_name -> machine.EtcdMemberAlarm
+	12,  // 167: machine.EtcdMemberAlarm.alarm:type_name -> machine.EtcdMemberAlarm.AlarmType
+	211, // 168: machine.EtcdAlarmDisarmResponse.messages:type_name -> machine.EtcdAlarmDisarm
+	266, // 169: machine.EtcdAlarmDisarm.metadata:type_name -> common.Metadata
+	209, // 170: machine.EtcdAlarmDisarm.member_alarms:type_name -> machine.EtcdMemberAlarm
+	213, // 171: machine.EtcdDefragmentResponse.messages:type_name -> machine.EtcdDefragment
+	266, // 172: machine.EtcdDefragment.metadata:type_name -> common.Metadata
+	215, // 173: machine.EtcdStatusResponse.messages:type_name -> machine.EtcdStatus
+	266, // 174: machine.EtcdStatus.metadata:type_name -> common.Metadata
+	216, // 175: machine.EtcdStatus.member_status:type_name -> machine.EtcdMemberStatus
+	219, // 176: machine.EtcdDowngradeValidateResponse.messages:type_name -> machine.EtcdDowngradeValidate
+	266, // 177: machine.EtcdDowngradeValidate.metadata:type_name -> common.Metadata
+	225, // 178: machine.EtcdDowngradeValidate.cluster_downgrade:type_name -> machine.EtcdClusterDowngrade
+	222, // 179: machine.EtcdDowngradeEnableResponse.messages:type_name -> machine.EtcdDowngradeEnable
+	266, // 180: machine.EtcdDowngradeEnable.metadata:type_name -> common.Metadata
+	225, // 181: machine.EtcdDowngradeEnable.cluster_downgrade:type_name -> machine.EtcdClusterDowngrade
+	224, // 182: machine.EtcdDowngradeCancelResponse.messages:type_name -> machine.EtcdDowngradeCancel
+	266, // 183: machine.EtcdDowngradeCancel.metadata:type_name -> common.Metadata
+	225, // 184: machine.EtcdDowngradeCancel.cluster_downgrade:type_name -> machine.EtcdClusterDowngrade
+	227, // 185: machine.NetworkDeviceConfig.dhcp_options:type_name -> machine.DHCPOptionsConfig
+	226, // 186: machine.NetworkDeviceConfig.routes:type_name -> machine.RouteConfig
+	228, // 187: machine.NetworkConfig.interfaces:type_name -> machine.NetworkDeviceConfig
+	13,  // 188: machine.MachineConfig.type:type_name -> machine.MachineConfig.MachineType
+	230, // 189: machine.MachineConfig.install_config:type_name -> machine.InstallConfig
+	229, // 190: machine.MachineConfig.network_config:type_name -> machine.NetworkConfig
+	233, // 191: machine.ClusterNetworkConfig.cni_config:type_name -> machine.CNIConfig
+	232, // 192: machine.ClusterConfig.control_plane:type_name -> machine.ControlPlaneConfig
+	234, // 193: machine.ClusterConfig.cluster_network:type_name -> machine.ClusterNetworkConfig
+	235, // 194: machine.GenerateConfigurationRequest.cluster_config:type_name -> machine.ClusterConfig
+	231, // 195: machine.GenerateConfigurationRequest.machine_config:type_name -> machine.MachineConfig
+	268, // 196: machine.GenerateConfigurationRequest.override_time:type_name -> google.protobuf.Timestamp
+	266, // 197: machine.GenerateConfiguration.metadata:type_name -> common.Metadata
+	237, // 198: machine.GenerateConfigurationResponse.messages:type_name -> machine.GenerateConfiguration
+	265, // 199: machine.GenerateClientConfigurationRequest.crt_ttl:type_name -> google.protobuf.Duration
+	266, // 200: machine.GenerateClientConfiguration.metadata:type_name -> common.Metadata
+	240, // 201: machine.GenerateClientConfigurationResponse.messages:type_name -> machine.GenerateClientConfiguration
+	243, // 202: machine.PacketCaptureRequest.bpf_filter:type_name -> machine.BPFInstruction
+	14,  // 203: machine.NetstatRequest.filter:type_name -> machine.NetstatRequest.Filter
+	261, // 204: machine.NetstatRequest.feature:type_name -> machine.NetstatRequest.Feature
+	262, // 205: machine.NetstatRequest.l4proto:type_name -> machine.NetstatRequest.L4proto
+	263, // 206: machine.NetstatRequest.netns:type_name -> machine.NetstatRequest.NetNS
+	15,  // 207: machine.ConnectRecord.state:type_name -> machine.ConnectRecord.State
+	16,  // 208: machine.ConnectRecord.tr:type_name -> machine.ConnectRecord.TimerActive
+	264, // 209: machine.ConnectRecord.process:type_name -> machine.ConnectRecord.Process
+	266, // 210: machine.Netstat.metadata:type_name -> common.Metadata
+	245, // 211: machine.Netstat.connectrecord:type_name -> machine.ConnectRecord
+	246, // 212: machine.NetstatResponse.messages:type_name -> machine.Netstat
+	266, // 213: machine.MetaWrite.metadata:type_name -> common.Metadata
+	249, // 214: machine.MetaWriteResponse.messages:type_name -> machine.MetaWrite
+	266, // 215: machine.MetaDelete.metadata:type_name -> common.Metadata
+	252, // 216: machine.MetaDeleteResponse.messages:type_name -> machine.MetaDelete
+	271, // 217: machine.ImageListRequest.namespace:type_name -> common.ContainerdNamespace
+	266, // 218: machine.ImageListResponse.metadata:type_name -> common.Metadata
+	268, // 219: machine.ImageListResponse.created_at:type_name -> google.protobuf.Timestamp
+	271, // 220: machine.ImagePullRequest.namespace:type_name -> common.ContainerdNamespace
+	266, // 221: machine.ImagePull.metadata:type_name -> common.Metadata
+	257, // 222: machine.ImagePullResponse.messages:type_name -> machine.ImagePull
+	260, // 223: machine.MachineStatusEvent.MachineStatus.unmet_conditions:type_name -> machine.MachineStatusEvent.MachineStatus.UnmetCondition
+	17,  // 224: machine.MachineService.ApplyConfiguration:input_type -> machine.ApplyConfigurationRequest
+	20,  // 225: machine.MachineService.Attest:input_type -> machine.AttestRequest
+	27,  // 226: machine.MachineService.Bootstrap:input_type -> machine.BootstrapRequest
+	147, // 227: machine.MachineService.Containers:input_type -> machine.ContainersRequest
+	115, // 228: machine.MachineService.Copy:input_type -> machine.CopyRequest
+	272, // 229: machine.MachineService.CPUFreqStats:input_type -> google.protobuf.Empty
+	272, // 230: machine.MachineService.CPUInfo:input_type -> google.protobuf.Empty
+	272, // 231: machine.MachineService.DiskStats:input_type -> google.protobuf.Empty
+	151, // 232: machine.MachineService.Dmesg:input_type -> machine.DmesgRequest
+	59,  // 233: machine.MachineService.Events:input_type -> machine.EventsRequest
+	200, // 234: machine.MachineService.EtcdMemberList:input_type -> machine.EtcdMemberListRequest
+	191, // 235: machine.MachineService.EtcdRemoveMemberByID:input_type -> machine.EtcdRemoveMemberByIDRequest
+	185, // 236: machine.MachineService.EtcdLeaveCluster:input_type -> machine.EtcdLeaveClusterRequest
+	194, // 237: machine.MachineService.EtcdPromoteMember:input_type -> machine.EtcdPromoteMemberRequest
+	197, // 238: machine.MachineService.EtcdForfeitLeadership:input_type -> machine.EtcdForfeitLeadershipRequest
+	273, // 239: machine.MachineService.EtcdRecover:input_type -> common.Data
+	204, // 240: machine.MachineService.EtcdSnapshot:input_type -> machine.EtcdSnapshotRequest
+	272, // 241: machine.MachineService.EtcdAlarmList:input_type -> google.protobuf.Empty
+	272, // 242: machine.MachineService.EtcdAlarmDisarm:input_type -> google.protobuf.Empty
+	272, // 243: machine.MachineService.EtcdDefragment:input_type -> google.protobuf.Empty
+	272, // 244: machine.MachineService.EtcdStatus:input_type -> google.protobuf.Empty
+	217, // 245: machine.MachineService.EtcdDowngradeValidate:input_type -> machine.EtcdDowngradeValidateRequest
+	220, // 246: machine.MachineService.EtcdDowngradeEnable:input_type -> machine.EtcdDowngradeEnableRequest
+	272, // 247: machine.MachineService.EtcdDowngradeCancel:input_type -> google.protobuf.Empty
+	236, // 248: machine.MachineService.GenerateConfiguration:input_type -> machine.GenerateConfigurationRequest
+	272, // 249: machine.MachineService.Hostname:input_type -> google.protobuf.Empty
+	71,  // 250: machine.MachineService.InhibitorAcquire:input_type -> machine.InhibitorAcquireRequest
+	272, // 251: machine.MachineService.InhibitorList:input_type -> google.protobuf.Empty
+	77,  // 252: machine.MachineService.InhibitorRelease:input_type -> machine.InhibitorReleaseRequest
+	80,  // 253: machine.MachineService.JoinTokenCreate:input_type -> machine.JoinTokenCreateRequest
+	272, // 254: machine.MachineService.JoinTokenList:input_type -> google.protobuf.Empty
+	86,  // 255: machine.MachineService.JoinTokenRevoke:input_type -> machine.JoinTokenRevokeRequest
+	272, // 256: machine.MachineService.Kubeconfig:input_type -> google.protobuf.Empty
+	143, // 257: machine.MachineService.KubernetesUpgradePreflight:input_type -> machine.KubernetesUpgradePreflightRequest
+	116, // 258: machine.MachineService.List:input_type -> machine.ListRequest
+	117, // 259: machine.MachineService.DiskUsage:input_type -> machine.DiskUsageRequest
+	272, // 260: machine.MachineService.LoadAvg:input_type -> google.protobuf.Empty
+	129, // 261: machine.MachineService.Logs:input_type -> machine.LogsRequest
+	272, // 262: machine.MachineService.LogsContainers:input_type -> google.protobuf.Empty
+	272, // 263: machine.MachineService.Memory:input_type -> google.protobuf.Empty
+	272, // 264: machine.MachineService.Mounts:input_type -> google.protobuf.Empty
+	272, // 265: machine.MachineService.NetworkDeviceStats:input_type -> google.protobuf.Empty
+	272, // 266: machine.MachineService.Processes:input_type -> google.protobuf.Empty
+	130, // 267: machine.MachineService.Read:input_type -> machine.ReadRequest
+	24,  // 268: machine.MachineService.Reboot:input_type -> machine.RebootRequest
+	155, // 269: machine.MachineService.Restart:input_type -> machine.RestartRequest
+	133, // 270: machine.MachineService.Rollback:input_type -> machine.RollbackRequest
+	136, // 271: machine.MachineService.RotateCertificates:input_type -> machine.RotateCertificatesRequest
+	139, // 272: machine.MachineService.RotateDiskKey:input_type -> machine.RotateDiskKeyRequest
+	62,  // 273: machine.MachineService.Reset:input_type -> machine.ResetRequest
+	65,  // 274: machine.MachineService.ResetIdentity:input_type -> machine.ResetIdentityRequest
+	92,  // 275: machine.MachineService.SecretsBundleExport:input_type -> machine.SecretsBundleExportRequest
+	101, // 276: machine.MachineService.ServiceDependencies:input_type -> machine.ServiceDependenciesRequest
+	272, // 277: machine.MachineService.ServiceList:input_type -> google.protobuf.Empty
+	112, // 278: machine.MachineService.ServiceRestart:input_type -> machine.ServiceRestartRequest
+	106, // 279: machine.MachineService.ServiceStart:input_type -> machine.ServiceStartRequest
+	109, // 280: machine.MachineService.ServiceStop:input_type -> machine.ServiceStopRequest
+	69,  // 281: machine.MachineService.Shutdown:input_type -> machine.ShutdownRequest
+	158, // 282: machine.MachineService.Stats:input_type -> machine.StatsRequest
+	272, // 283: machine.MachineService.SystemStat:input_type -> google.protobuf.Empty
+	89,  // 284: machine.MachineService.Upgrade:input_type -> machine.UpgradeRequest
+	272, // 285: machine.MachineService.Version:input_type -> google.protobuf.Empty
+	239, // 286: machine.MachineService.GenerateClientConfiguration:input_type -> machine.GenerateClientConfigurationRequest
+	242, // 287: machine.MachineService.PacketCapture:input_type -> machine.PacketCaptureRequest
+	244, // 288: machine.MachineService.Netstat:input_type -> machine.NetstatRequest
+	248, // 289: machine.MachineService.MetaWrite:input_type -> machine.MetaWriteRequest
+	251, // 290: machine.MachineService.MetaDelete:input_type -> machine.MetaDeleteRequest
+	254, // 291: machine.MachineService.ImageList:input_type -> machine.ImageListRequest
+	256, // 292: machine.MachineService.ImagePull:input_type -> machine.ImagePullRequest
+	19,  // 293: machine.MachineService.ApplyConfiguration:output_type -> machine.ApplyConfigurationResponse
+	23,  // 294: machine.MachineService.Attest:output_type -> machine.AttestResponse
+	29,  // 295: machine.MachineService.Bootstrap:output_type -> machine.BootstrapResponse
+	150, // 296: machine.MachineService.Containers:output_type -> machine.ContainersResponse
+	273, // 297: machine.MachineService.Copy:output_type -> common.Data
+	173, // 298: machine.MachineService.CPUFreqStats:output_type -> machine.CPUFreqStatsResponse
+	176, // 299: machine.MachineService.CPUInfo:output_type -> machine.CPUInfoResponse
+	182, // 300: machine.MachineService.DiskStats:output_type -> machine.DiskStatsResponse
+	273, // 301: machine.MachineService.Dmesg:output_type -> common.Data
+	60,  // 302: machine.MachineService.Events:output_type -> machine.Event
+	203, // 303: machine.MachineService.EtcdMemberList:output_type -> machine.EtcdMemberListResponse
+	193, // 304: machine.MachineService.EtcdRemoveMemberByID:output_type -> machine.EtcdRemoveMemberByIDResponse
+	187, // 305: machine.MachineService.EtcdLeaveCluster:output_type -> machine.EtcdLeaveClusterResponse
+	196, // 306: machine.MachineService.EtcdPromoteMember:output_type -> machine.EtcdPromoteMemberResponse
+	199, // 307: machine.MachineService.EtcdForfeitLeadership:output_type -> machine.EtcdForfeitLeadershipResponse
+	206, // 308: machine.MachineService.EtcdRecover:output_type -> machine.EtcdRecoverResponse
+	273, // 309: machine.MachineService.EtcdSnapshot:output_type -> common.Data
+	207, // 310: machine.MachineService.EtcdAlarmList:output_type -> machine.EtcdAlarmListResponse
+	210, // 311: machine.MachineService.EtcdAlarmDisarm:output_type -> machine.EtcdAlarmDisarmResponse
+	212, // 312: machine.MachineService.EtcdDefragment:output_type -> machine.EtcdDefragmentResponse
+	214, // 313: machine.MachineService.EtcdStatus:output_type -> machine.EtcdStatusResponse
+	218, // 314: machine.MachineService.EtcdDowngradeValidate:output_type -> machine.EtcdDowngradeValidateResponse
+	221, // 315: machine.MachineService.EtcdDowngradeEnable:output_type -> machine.EtcdDowngradeEnableResponse
+	223, // 316: machine.MachineService.EtcdDowngradeCancel:output_type -> machine.EtcdDowngradeCancelResponse
+	238, // 317: machine.MachineService.GenerateConfiguration:output_type -> machine.GenerateConfigurationResponse
+	165, // 318: machine.MachineService.Hostname:output_type -> machine.HostnameResponse
+	74,  // 319: machine.MachineService.InhibitorAcquire:output_type -> machine.InhibitorAcquireResponse
+	76,  // 320: machine.MachineService.InhibitorList:output_type -> machine.InhibitorListResponse
+	79,  // 321: machine.MachineService.InhibitorRelease:output_type -> machine.InhibitorReleaseResponse
+	82,  // 322: machine.MachineService.JoinTokenCreate:output_type -> machine.JoinTokenCreateResponse
+	85,  // 323: machine.MachineService.JoinTokenList:output_type -> machine.JoinTokenListResponse
+	88,  // 324: machine.MachineService.JoinTokenRevoke:output_type -> machine.JoinTokenRevokeResponse
+	273, // 325: machine.MachineService.Kubeconfig:output_type -> common.Data
+	146, // 326: machine.MachineService.KubernetesUpgradePreflight:output_type -> machine.KubernetesUpgradePreflightResponse
+	118, // 327: machine.MachineService.List:output_type -> machine.FileInfo
+	120, // 328: machine.MachineService.DiskUsage:output_type -> machine.DiskUsageInfo
+	167, // 329: machine.MachineService.LoadAvg:output_type -> machine.LoadAvgResponse
+	273, // 330: machine.MachineService.Logs:output_type -> common.Data
+	132, // 331: machine.MachineService.LogsContainers:output_type -> machine.LogsContainersResponse
+	163, // 332: machine.MachineService.Memory:output_type -> machine.MemoryResponse
+	122, // 333: machine.MachineService.Mounts:output_type -> machine.MountsResponse
+	179, // 334: machine.MachineService.NetworkDeviceStats:output_type -> machine.NetworkDeviceStatsResponse
+	152, // 335: machine.MachineService.Processes:output_type -> machine.ProcessesResponse
+	273, // 336: machine.MachineService.Read:output_type -> common.Data
+	26,  // 337: machine.MachineService.Reboot:output_type -> machine.RebootResponse
+	157, // 338: machine.MachineService.Restart:output_type -> machine.RestartResponse
+	135, // 339: machine.MachineService.Rollback:output_type -> machine.RollbackResponse
+	138, // 340: machine.MachineService.RotateCertificates:output_type -> machine.RotateCertificatesResponse
+	142, // 341: machine.MachineService.RotateDiskKey:output_type -> machine.RotateDiskKeyResponse
+	64,  // 342: machine.MachineService.Reset:output_type -> machine.ResetResponse
+	67,  // 343: machine.MachineService.ResetIdentity:output_type -> machine.ResetIdentityResponse
+	94,  // 344: machine.MachineService.SecretsBundleExport:output_type -> machine.SecretsBundleExportResponse
+	105, // 345: machine.MachineService.ServiceDependencies:output_type -> machine.ServiceDependenciesResponse
+	96,  // 346: machine.MachineService.ServiceList:output_type -> machine.ServiceListResponse
+	114, // 347: machine.MachineService.ServiceRestart:output_type -> machine.ServiceRestartResponse
+	108, // 348: machine.MachineService.ServiceStart:output_type -> machine.ServiceStartResponse
+	111, // 349: machine.MachineService.ServiceStop:output_type -> machine.ServiceStopResponse
+	70,  // 350: machine.MachineService.Shutdown:output_type -> machine.ShutdownResponse
+	160, // 351: machine.MachineService.Stats:output_type -> machine.StatsResponse
+	169, // 352: machine.MachineService.SystemStat:output_type -> machine.SystemStatResponse
+	91,  // 353: machine.MachineService.Upgrade:output_type -> machine.UpgradeResponse
+	125, // 354: machine.MachineService.Version:output_type -> machine.VersionResponse
+	241, // 355: machine.MachineService.GenerateClientConfiguration:output_type -> machine.GenerateClientConfigurationResponse
+	273, // 356: machine.MachineService.PacketCapture:output_type -> common.Data
+	247, // 357: machine.MachineService.Netstat:output_type -> machine.NetstatResponse
+	250, // 358: machine.MachineService.MetaWrite:output_type -> machine.MetaWriteResponse
+	253, // 359: machine.MachineService.MetaDelete:output_type -> machine.MetaDeleteResponse
+	255, // 360: machine.MachineService.ImageList:output_type -> machine.ImageListResponse
+	258, // 361: machine.MachineService.ImagePull:output_type -> machine.ImagePullResponse
+	293, // [293:362] is the sub-list for method output_type
+	224, // [224:293] is the sub-list for method input_type
+	224, // [224:224] is the sub-list for extension type_name
+	224, // [224:224] is the sub-list for extension extendee
+	0,   // [0:224] is the sub-list for field type_name
 }
 
 func init() { file_machine_machine_proto_init() }
@@ -13270,8 +17768,8 @@ func file_machine_machine_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_machine_machine_proto_rawDesc), len(file_machine_machine_proto_rawDesc)),
-			NumEnums:      15,
-			NumMessages:   181,
+			NumEnums:      17,
+			NumMessages:   248,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
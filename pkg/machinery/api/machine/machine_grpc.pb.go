@@ -24,6 +24,7 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	MachineService_ApplyConfiguration_FullMethodName          = "/machine.MachineService/ApplyConfiguration"
+	MachineService_Attest_FullMethodName                      = "/machine.MachineService/Attest"
 	MachineService_Bootstrap_FullMethodName                   = "/machine.MachineService/Bootstrap"
 	MachineService_Containers_FullMethodName                  = "/machine.MachineService/Containers"
 	MachineService_Copy_FullMethodName                        = "/machine.MachineService/Copy"
@@ -35,6 +36,7 @@ const (
 	MachineService_EtcdMemberList_FullMethodName              = "/machine.MachineService/EtcdMemberList"
 	MachineService_EtcdRemoveMemberByID_FullMethodName        = "/machine.MachineService/EtcdRemoveMemberByID"
 	MachineService_EtcdLeaveCluster_FullMethodName            = "/machine.MachineService/EtcdLeaveCluster"
+	MachineService_EtcdPromoteMember_FullMethodName           = "/machine.MachineService/EtcdPromoteMember"
 	MachineService_EtcdForfeitLeadership_FullMethodName       = "/machine.MachineService/EtcdForfeitLeadership"
 	MachineService_EtcdRecover_FullMethodName                 = "/machine.MachineService/EtcdRecover"
 	MachineService_EtcdSnapshot_FullMethodName                = "/machine.MachineService/EtcdSnapshot"
@@ -47,7 +49,14 @@ const (
 	MachineService_EtcdDowngradeCancel_FullMethodName         = "/machine.MachineService/EtcdDowngradeCancel"
 	MachineService_GenerateConfiguration_FullMethodName       = "/machine.MachineService/GenerateConfiguration"
 	MachineService_Hostname_FullMethodName                    = "/machine.MachineService/Hostname"
+	MachineService_InhibitorAcquire_FullMethodName            = "/machine.MachineService/InhibitorAcquire"
+	MachineService_InhibitorList_FullMethodName               = "/machine.MachineService/InhibitorList"
+	MachineService_InhibitorRelease_FullMethodName            = "/machine.MachineService/InhibitorRelease"
+	MachineService_JoinTokenCreate_FullMethodName             = "/machine.MachineService/JoinTokenCreate"
+	MachineService_JoinTokenList_FullMethodName               = "/machine.MachineService/JoinTokenList"
+	MachineService_JoinTokenRevoke_FullMethodName             = "/machine.MachineService/JoinTokenRevoke"
 	MachineService_Kubeconfig_FullMethodName                  = "/machine.MachineService/Kubeconfig"
+	MachineService_KubernetesUpgradePreflight_FullMethodName  = "/machine.MachineService/KubernetesUpgradePreflight"
 	MachineService_List_FullMethodName                        = "/machine.MachineService/List"
 	MachineService_DiskUsage_FullMethodName                   = "/machine.MachineService/DiskUsage"
 	MachineService_LoadAvg_FullMethodName                     = "/machine.MachineService/LoadAvg"
@@ -61,7 +70,12 @@ const (
 	MachineService_Reboot_FullMethodName                      = "/machine.MachineService/Reboot"
 	MachineService_Restart_FullMethodName                     = "/machine.MachineService/Restart"
 	MachineService_Rollback_FullMethodName                    = "/machine.MachineService/Rollback"
+	MachineService_RotateCertificates_FullMethodName          = "/machine.MachineService/RotateCertificates"
+	MachineService_RotateDiskKey_FullMethodName               = "/machine.MachineService/RotateDiskKey"
 	MachineService_Reset_FullMethodName                       = "/machine.MachineService/Reset"
+	MachineService_ResetIdentity_FullMethodName               = "/machine.MachineService/ResetIdentity"
+	MachineService_SecretsBundleExport_FullMethodName         = "/machine.MachineService/SecretsBundleExport"
+	MachineService_ServiceDependencies_FullMethodName         = "/machine.MachineService/ServiceDependencies"
 	MachineService_ServiceList_FullMethodName                 = "/machine.MachineService/ServiceList"
 	MachineService_ServiceRestart_FullMethodName              = "/machine.MachineService/ServiceRestart"
 	MachineService_ServiceStart_FullMethodName                = "/machine.MachineService/ServiceStart"
@@ -87,6 +101,11 @@ const (
 // The machine service definition.
 type MachineServiceClient interface {
 	ApplyConfiguration(ctx context.Context, in *ApplyConfigurationRequest, opts ...grpc.CallOption) (*ApplyConfigurationResponse, error)
+	// Attest produces a TPM quote of the PCR values over the nonce supplied by the caller.
+	//
+	// The quote is returned along with the public area of the attestation key and the measured boot event log,
+	// so that an external verifier can appraise the node. The method is rate limited.
+	Attest(ctx context.Context, in *AttestRequest, opts ...grpc.CallOption) (*AttestResponse, error)
 	// Bootstrap method makes control plane node enter etcd bootstrap mode.
 	// Node aborts etcd join sequence and creates single-node etcd cluster.
 	// If recover_etcd argument is specified, etcd is recovered from a snapshot
@@ -105,6 +124,9 @@ type MachineServiceClient interface {
 	// To remove a member with a running Talos node, use EtcdLeaveCluster API on the node to be removed.
 	EtcdRemoveMemberByID(ctx context.Context, in *EtcdRemoveMemberByIDRequest, opts ...grpc.CallOption) (*EtcdRemoveMemberByIDResponse, error)
 	EtcdLeaveCluster(ctx context.Context, in *EtcdLeaveClusterRequest, opts ...grpc.CallOption) (*EtcdLeaveClusterResponse, error)
+	// EtcdPromoteMember promotes a learner member identified by member ID to a voting member.
+	// This API should be used to promote learners which are not promoted automatically.
+	EtcdPromoteMember(ctx context.Context, in *EtcdPromoteMemberRequest, opts ...grpc.CallOption) (*EtcdPromoteMemberResponse, error)
 	EtcdForfeitLeadership(ctx context.Context, in *EtcdForfeitLeadershipRequest, opts ...grpc.CallOption) (*EtcdForfeitLeadershipResponse, error)
 	// EtcdRecover method uploads etcd data snapshot created with EtcdSnapshot
 	// to the node.
@@ -139,7 +161,25 @@ type MachineServiceClient interface {
 	EtcdDowngradeCancel(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*EtcdDowngradeCancelResponse, error)
 	GenerateConfiguration(ctx context.Context, in *GenerateConfigurationRequest, opts ...grpc.CallOption) (*GenerateConfigurationResponse, error)
 	Hostname(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*HostnameResponse, error)
+	// InhibitorAcquire registers a shutdown inhibitor which delays the reboot, shutdown and upgrade until it is released.
+	//
+	// The inhibitor holds the shutdown at most for its maximum hold time.
+	InhibitorAcquire(ctx context.Context, in *InhibitorAcquireRequest, opts ...grpc.CallOption) (*InhibitorAcquireResponse, error)
+	// InhibitorList lists the registered shutdown inhibitors.
+	InhibitorList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*InhibitorListResponse, error)
+	// InhibitorRelease releases the shutdown inhibitor.
+	InhibitorRelease(ctx context.Context, in *InhibitorReleaseRequest, opts ...grpc.CallOption) (*InhibitorReleaseResponse, error)
+	// JoinTokenCreate stores a named join token accepted by trustd in addition to the machine configuration token.
+	//
+	// The token is generated by the client, so that the same token can be created on every control plane node.
+	JoinTokenCreate(ctx context.Context, in *JoinTokenCreateRequest, opts ...grpc.CallOption) (*JoinTokenCreateResponse, error)
+	// JoinTokenList lists the join tokens accepted by trustd, the tokens themselves are never returned.
+	JoinTokenList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*JoinTokenListResponse, error)
+	// JoinTokenRevoke removes the named join token.
+	JoinTokenRevoke(ctx context.Context, in *JoinTokenRevokeRequest, opts ...grpc.CallOption) (*JoinTokenRevokeResponse, error)
 	Kubeconfig(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[common.Data], error)
+	// KubernetesUpgradePreflight runs the node-local pre-flight checks for the Kubernetes upgrade to the target version.
+	KubernetesUpgradePreflight(ctx context.Context, in *KubernetesUpgradePreflightRequest, opts ...grpc.CallOption) (*KubernetesUpgradePreflightResponse, error)
 	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FileInfo], error)
 	DiskUsage(ctx context.Context, in *DiskUsageRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DiskUsageInfo], error)
 	LoadAvg(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*LoadAvgResponse, error)
@@ -153,7 +193,28 @@ type MachineServiceClient interface {
 	Reboot(ctx context.Context, in *RebootRequest, opts ...grpc.CallOption) (*RebootResponse, error)
 	Restart(ctx context.Context, in *RestartRequest, opts ...grpc.CallOption) (*RestartResponse, error)
 	Rollback(ctx context.Context, in *RollbackRequest, opts ...grpc.CallOption) (*RollbackResponse, error)
+	// RotateCertificates forces early rotation of the certificates of the selected components.
+	//
+	// The components are rotated one by one in the fixed order, and the call returns once the new certificates are issued.
+	RotateCertificates(ctx context.Context, in *RotateCertificatesRequest, opts ...grpc.CallOption) (*RotateCertificatesResponse, error)
+	// RotateDiskKey rotates the disk encryption keys of the volume which are wrapped by a key provider (KMS, TPM).
+	//
+	// The new key is added to a temporary key slot before the old key is removed, so the volume stays unlockable at any point.
+	// The call returns once the rotation is finished.
+	RotateDiskKey(ctx context.Context, in *RotateDiskKeyRequest, opts ...grpc.CallOption) (*RotateDiskKeyResponse, error)
 	Reset(ctx context.Context, in *ResetRequest, opts ...grpc.CallOption) (*ResetResponse, error)
+	// ResetIdentity regenerates the node identity and the KubeSpan key of the machine.
+	//
+	// The reset is meant for the machines cloned from the same disk image, so it should be confirmed explicitly.
+	// The call returns once the new identity is established.
+	ResetIdentity(ctx context.Context, in *ResetIdentityRequest, opts ...grpc.CallOption) (*ResetIdentityResponse, error)
+	// SecretsBundleExport reconstructs the secrets bundle from the control plane node configuration.
+	//
+	// The bundle contains the cluster CAs and keys, so the export should be confirmed explicitly, and each export is reported with an event.
+	// The export can be disabled in the machine configuration.
+	SecretsBundleExport(ctx context.Context, in *SecretsBundleExportRequest, opts ...grpc.CallOption) (*SecretsBundleExportResponse, error)
+	// ServiceDependencies returns the dependencies and the conditions of the services along with their current state.
+	ServiceDependencies(ctx context.Context, in *ServiceDependenciesRequest, opts ...grpc.CallOption) (*ServiceDependenciesResponse, error)
 	ServiceList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ServiceListResponse, error)
 	ServiceRestart(ctx context.Context, in *ServiceRestartRequest, opts ...grpc.CallOption) (*ServiceRestartResponse, error)
 	ServiceStart(ctx context.Context, in *ServiceStartRequest, opts ...grpc.CallOption) (*ServiceStartResponse, error)
@@ -197,6 +258,16 @@ func (c *machineServiceClient) ApplyConfiguration(ctx context.Context, in *Apply
 	return out, nil
 }
 
+func (c *machineServiceClient) Attest(ctx context.Context, in *AttestRequest, opts ...grpc.CallOption) (*AttestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttestResponse)
+	err := c.cc.Invoke(ctx, MachineService_Attest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *machineServiceClient) Bootstrap(ctx context.Context, in *BootstrapRequest, opts ...grpc.CallOption) (*BootstrapResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BootstrapResponse)
@@ -334,6 +405,16 @@ func (c *machineServiceClient) EtcdLeaveCluster(ctx context.Context, in *EtcdLea
 	return out, nil
 }
 
+func (c *machineServiceClient) EtcdPromoteMember(ctx context.Context, in *EtcdPromoteMemberRequest, opts ...grpc.CallOption) (*EtcdPromoteMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EtcdPromoteMemberResponse)
+	err := c.cc.Invoke(ctx, MachineService_EtcdPromoteMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *machineServiceClient) EtcdForfeitLeadership(ctx context.Context, in *EtcdForfeitLeadershipRequest, opts ...grpc.CallOption) (*EtcdForfeitLeadershipResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(EtcdForfeitLeadershipResponse)
@@ -466,6 +547,66 @@ func (c *machineServiceClient) Hostname(ctx context.Context, in *emptypb.Empty,
 	return out, nil
 }
 
+func (c *machineServiceClient) InhibitorAcquire(ctx context.Context, in *InhibitorAcquireRequest, opts ...grpc.CallOption) (*InhibitorAcquireResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InhibitorAcquireResponse)
+	err := c.cc.Invoke(ctx, MachineService_InhibitorAcquire_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) InhibitorList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*InhibitorListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InhibitorListResponse)
+	err := c.cc.Invoke(ctx, MachineService_InhibitorList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) InhibitorRelease(ctx context.Context, in *InhibitorReleaseRequest, opts ...grpc.CallOption) (*InhibitorReleaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InhibitorReleaseResponse)
+	err := c.cc.Invoke(ctx, MachineService_InhibitorRelease_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) JoinTokenCreate(ctx context.Context, in *JoinTokenCreateRequest, opts ...grpc.CallOption) (*JoinTokenCreateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinTokenCreateResponse)
+	err := c.cc.Invoke(ctx, MachineService_JoinTokenCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) JoinTokenList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*JoinTokenListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinTokenListResponse)
+	err := c.cc.Invoke(ctx, MachineService_JoinTokenList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) JoinTokenRevoke(ctx context.Context, in *JoinTokenRevokeRequest, opts ...grpc.CallOption) (*JoinTokenRevokeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinTokenRevokeResponse)
+	err := c.cc.Invoke(ctx, MachineService_JoinTokenRevoke_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *machineServiceClient) Kubeconfig(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[common.Data], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[5], MachineService_Kubeconfig_FullMethodName, cOpts...)
@@ -485,6 +626,16 @@ func (c *machineServiceClient) Kubeconfig(ctx context.Context, in *emptypb.Empty
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type MachineService_KubeconfigClient = grpc.ServerStreamingClient[common.Data]
 
+func (c *machineServiceClient) KubernetesUpgradePreflight(ctx context.Context, in *KubernetesUpgradePreflightRequest, opts ...grpc.CallOption) (*KubernetesUpgradePreflightResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KubernetesUpgradePreflightResponse)
+	err := c.cc.Invoke(ctx, MachineService_KubernetesUpgradePreflight_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *machineServiceClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FileInfo], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[6], MachineService_List_FullMethodName, cOpts...)
@@ -651,6 +802,26 @@ func (c *machineServiceClient) Rollback(ctx context.Context, in *RollbackRequest
 	return out, nil
 }
 
+func (c *machineServiceClient) RotateCertificates(ctx context.Context, in *RotateCertificatesRequest, opts ...grpc.CallOption) (*RotateCertificatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateCertificatesResponse)
+	err := c.cc.Invoke(ctx, MachineService_RotateCertificates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) RotateDiskKey(ctx context.Context, in *RotateDiskKeyRequest, opts ...grpc.CallOption) (*RotateDiskKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateDiskKeyResponse)
+	err := c.cc.Invoke(ctx, MachineService_RotateDiskKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *machineServiceClient) Reset(ctx context.Context, in *ResetRequest, opts ...grpc.CallOption) (*ResetResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ResetResponse)
@@ -661,6 +832,36 @@ func (c *machineServiceClient) Reset(ctx context.Context, in *ResetRequest, opts
 	return out, nil
 }
 
+func (c *machineServiceClient) ResetIdentity(ctx context.Context, in *ResetIdentityRequest, opts ...grpc.CallOption) (*ResetIdentityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetIdentityResponse)
+	err := c.cc.Invoke(ctx, MachineService_ResetIdentity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) SecretsBundleExport(ctx context.Context, in *SecretsBundleExportRequest, opts ...grpc.CallOption) (*SecretsBundleExportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SecretsBundleExportResponse)
+	err := c.cc.Invoke(ctx, MachineService_SecretsBundleExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) ServiceDependencies(ctx context.Context, in *ServiceDependenciesRequest, opts ...grpc.CallOption) (*ServiceDependenciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServiceDependenciesResponse)
+	err := c.cc.Invoke(ctx, MachineService_ServiceDependencies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *machineServiceClient) ServiceList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ServiceListResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ServiceListResponse)
@@ -846,6 +1047,11 @@ func (c *machineServiceClient) ImagePull(ctx context.Context, in *ImagePullReque
 // The machine service definition.
 type MachineServiceServer interface {
 	ApplyConfiguration(context.Context, *ApplyConfigurationRequest) (*ApplyConfigurationResponse, error)
+	// Attest produces a TPM quote of the PCR values over the nonce supplied by the caller.
+	//
+	// The quote is returned along with the public area of the attestation key and the measured boot event log,
+	// so that an external verifier can appraise the node. The method is rate limited.
+	Attest(context.Context, *AttestRequest) (*AttestResponse, error)
 	// Bootstrap method makes control plane node enter etcd bootstrap mode.
 	// Node aborts etcd join sequence and creates single-node etcd cluster.
 	// If recover_etcd argument is specified, etcd is recovered from a snapshot
@@ -864,6 +1070,9 @@ type MachineServiceServer interface {
 	// To remove a member with a running Talos node, use EtcdLeaveCluster API on the node to be removed.
 	EtcdRemoveMemberByID(context.Context, *EtcdRemoveMemberByIDRequest) (*EtcdRemoveMemberByIDResponse, error)
 	EtcdLeaveCluster(context.Context, *EtcdLeaveClusterRequest) (*EtcdLeaveClusterResponse, error)
+	// EtcdPromoteMember promotes a learner member identified by member ID to a voting member.
+	// This API should be used to promote learners which are not promoted automatically.
+	EtcdPromoteMember(context.Context, *EtcdPromoteMemberRequest) (*EtcdPromoteMemberResponse, error)
 	EtcdForfeitLeadership(context.Context, *EtcdForfeitLeadershipRequest) (*EtcdForfeitLeadershipResponse, error)
 	// EtcdRecover method uploads etcd data snapshot created with EtcdSnapshot
 	// to the node.
@@ -898,7 +1107,25 @@ type MachineServiceServer interface {
 	EtcdDowngradeCancel(context.Context, *emptypb.Empty) (*EtcdDowngradeCancelResponse, error)
 	GenerateConfiguration(context.Context, *GenerateConfigurationRequest) (*GenerateConfigurationResponse, error)
 	Hostname(context.Context, *emptypb.Empty) (*HostnameResponse, error)
+	// InhibitorAcquire registers a shutdown inhibitor which delays the reboot, shutdown and upgrade until it is released.
+	//
+	// The inhibitor holds the shutdown at most for its maximum hold time.
+	InhibitorAcquire(context.Context, *InhibitorAcquireRequest) (*InhibitorAcquireResponse, error)
+	// InhibitorList lists the registered shutdown inhibitors.
+	InhibitorList(context.Context, *emptypb.Empty) (*InhibitorListResponse, error)
+	// InhibitorRelease releases the shutdown inhibitor.
+	InhibitorRelease(context.Context, *InhibitorReleaseRequest) (*InhibitorReleaseResponse, error)
+	// JoinTokenCreate stores a named join token accepted by trustd in addition to the machine configuration token.
+	//
+	// The token is generated by the client, so that the same token can be created on every control plane node.
+	JoinTokenCreate(context.Context, *JoinTokenCreateRequest) (*JoinTokenCreateResponse, error)
+	// JoinTokenList lists the join tokens accepted by trustd, the tokens themselves are never returned.
+	JoinTokenList(context.Context, *emptypb.Empty) (*JoinTokenListResponse, error)
+	// JoinTokenRevoke removes the named join token.
+	JoinTokenRevoke(context.Context, *JoinTokenRevokeRequest) (*JoinTokenRevokeResponse, error)
 	Kubeconfig(*emptypb.Empty, grpc.ServerStreamingServer[common.Data]) error
+	// KubernetesUpgradePreflight runs the node-local pre-flight checks for the Kubernetes upgrade to the target version.
+	KubernetesUpgradePreflight(context.Context, *KubernetesUpgradePreflightRequest) (*KubernetesUpgradePreflightResponse, error)
 	List(*ListRequest, grpc.ServerStreamingServer[FileInfo]) error
 	DiskUsage(*DiskUsageRequest, grpc.ServerStreamingServer[DiskUsageInfo]) error
 	LoadAvg(context.Context, *emptypb.Empty) (*LoadAvgResponse, error)
@@ -912,7 +1139,28 @@ type MachineServiceServer interface {
 	Reboot(context.Context, *RebootRequest) (*RebootResponse, error)
 	Restart(context.Context, *RestartRequest) (*RestartResponse, error)
 	Rollback(context.Context, *RollbackRequest) (*RollbackResponse, error)
+	// RotateCertificates forces early rotation of the certificates of the selected components.
+	//
+	// The components are rotated one by one in the fixed order, and the call returns once the new certificates are issued.
+	RotateCertificates(context.Context, *RotateCertificatesRequest) (*RotateCertificatesResponse, error)
+	// RotateDiskKey rotates the disk encryption keys of the volume which are wrapped by a key provider (KMS, TPM).
+	//
+	// The new key is added to a temporary key slot before the old key is removed, so the volume stays unlockable at any point.
+	// The call returns once the rotation is finished.
+	RotateDiskKey(context.Context, *RotateDiskKeyRequest) (*RotateDiskKeyResponse, error)
 	Reset(context.Context, *ResetRequest) (*ResetResponse, error)
+	// ResetIdentity regenerates the node identity and the KubeSpan key of the machine.
+	//
+	// The reset is meant for the machines cloned from the same disk image, so it should be confirmed explicitly.
+	// The call returns once the new identity is established.
+	ResetIdentity(context.Context, *ResetIdentityRequest) (*ResetIdentityResponse, error)
+	// SecretsBundleExport reconstructs the secrets bundle from the control plane node configuration.
+	//
+	// The bundle contains the cluster CAs and keys, so the export should be confirmed explicitly, and each export is reported with an event.
+	// The export can be disabled in the machine configuration.
+	SecretsBundleExport(context.Context, *SecretsBundleExportRequest) (*SecretsBundleExportResponse, error)
+	// ServiceDependencies returns the dependencies and the conditions of the services along with their current state.
+	ServiceDependencies(context.Context, *ServiceDependenciesRequest) (*ServiceDependenciesResponse, error)
 	ServiceList(context.Context, *emptypb.Empty) (*ServiceListResponse, error)
 	ServiceRestart(context.Context, *ServiceRestartRequest) (*ServiceRestartResponse, error)
 	ServiceStart(context.Context, *ServiceStartRequest) (*ServiceStartResponse, error)
@@ -949,6 +1197,9 @@ type UnimplementedMachineServiceServer struct{}
 func (UnimplementedMachineServiceServer) ApplyConfiguration(context.Context, *ApplyConfigurationRequest) (*ApplyConfigurationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ApplyConfiguration not implemented")
 }
+func (UnimplementedMachineServiceServer) Attest(context.Context, *AttestRequest) (*AttestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Attest not implemented")
+}
 func (UnimplementedMachineServiceServer) Bootstrap(context.Context, *BootstrapRequest) (*BootstrapResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Bootstrap not implemented")
 }
@@ -982,6 +1233,9 @@ func (UnimplementedMachineServiceServer) EtcdRemoveMemberByID(context.Context, *
 func (UnimplementedMachineServiceServer) EtcdLeaveCluster(context.Context, *EtcdLeaveClusterRequest) (*EtcdLeaveClusterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EtcdLeaveCluster not implemented")
 }
+func (UnimplementedMachineServiceServer) EtcdPromoteMember(context.Context, *EtcdPromoteMemberRequest) (*EtcdPromoteMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EtcdPromoteMember not implemented")
+}
 func (UnimplementedMachineServiceServer) EtcdForfeitLeadership(context.Context, *EtcdForfeitLeadershipRequest) (*EtcdForfeitLeadershipResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EtcdForfeitLeadership not implemented")
 }
@@ -1018,9 +1272,30 @@ func (UnimplementedMachineServiceServer) GenerateConfiguration(context.Context,
 func (UnimplementedMachineServiceServer) Hostname(context.Context, *emptypb.Empty) (*HostnameResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Hostname not implemented")
 }
+func (UnimplementedMachineServiceServer) InhibitorAcquire(context.Context, *InhibitorAcquireRequest) (*InhibitorAcquireResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InhibitorAcquire not implemented")
+}
+func (UnimplementedMachineServiceServer) InhibitorList(context.Context, *emptypb.Empty) (*InhibitorListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InhibitorList not implemented")
+}
+func (UnimplementedMachineServiceServer) InhibitorRelease(context.Context, *InhibitorReleaseRequest) (*InhibitorReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InhibitorRelease not implemented")
+}
+func (UnimplementedMachineServiceServer) JoinTokenCreate(context.Context, *JoinTokenCreateRequest) (*JoinTokenCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinTokenCreate not implemented")
+}
+func (UnimplementedMachineServiceServer) JoinTokenList(context.Context, *emptypb.Empty) (*JoinTokenListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinTokenList not implemented")
+}
+func (UnimplementedMachineServiceServer) JoinTokenRevoke(context.Context, *JoinTokenRevokeRequest) (*JoinTokenRevokeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinTokenRevoke not implemented")
+}
 func (UnimplementedMachineServiceServer) Kubeconfig(*emptypb.Empty, grpc.ServerStreamingServer[common.Data]) error {
 	return status.Errorf(codes.Unimplemented, "method Kubeconfig not implemented")
 }
+func (UnimplementedMachineServiceServer) KubernetesUpgradePreflight(context.Context, *KubernetesUpgradePreflightRequest) (*KubernetesUpgradePreflightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KubernetesUpgradePreflight not implemented")
+}
 func (UnimplementedMachineServiceServer) List(*ListRequest, grpc.ServerStreamingServer[FileInfo]) error {
 	return status.Errorf(codes.Unimplemented, "method List not implemented")
 }
@@ -1060,9 +1335,24 @@ func (UnimplementedMachineServiceServer) Restart(context.Context, *RestartReques
 func (UnimplementedMachineServiceServer) Rollback(context.Context, *RollbackRequest) (*RollbackResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Rollback not implemented")
 }
+func (UnimplementedMachineServiceServer) RotateCertificates(context.Context, *RotateCertificatesRequest) (*RotateCertificatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateCertificates not implemented")
+}
+func (UnimplementedMachineServiceServer) RotateDiskKey(context.Context, *RotateDiskKeyRequest) (*RotateDiskKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateDiskKey not implemented")
+}
 func (UnimplementedMachineServiceServer) Reset(context.Context, *ResetRequest) (*ResetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Reset not implemented")
 }
+func (UnimplementedMachineServiceServer) ResetIdentity(context.Context, *ResetIdentityRequest) (*ResetIdentityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetIdentity not implemented")
+}
+func (UnimplementedMachineServiceServer) SecretsBundleExport(context.Context, *SecretsBundleExportRequest) (*SecretsBundleExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SecretsBundleExport not implemented")
+}
+func (UnimplementedMachineServiceServer) ServiceDependencies(context.Context, *ServiceDependenciesRequest) (*ServiceDependenciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceDependencies not implemented")
+}
 func (UnimplementedMachineServiceServer) ServiceList(context.Context, *emptypb.Empty) (*ServiceListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ServiceList not implemented")
 }
@@ -1150,6 +1440,24 @@ func _MachineService_ApplyConfiguration_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MachineService_Attest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).Attest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_Attest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).Attest(ctx, req.(*AttestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MachineService_Bootstrap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BootstrapRequest)
 	if err := dec(in); err != nil {
@@ -1327,6 +1635,24 @@ func _MachineService_EtcdLeaveCluster_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MachineService_EtcdPromoteMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EtcdPromoteMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).EtcdPromoteMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_EtcdPromoteMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).EtcdPromoteMember(ctx, req.(*EtcdPromoteMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MachineService_EtcdForfeitLeadership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EtcdForfeitLeadershipRequest)
 	if err := dec(in); err != nil {
@@ -1525,6 +1851,114 @@ func _MachineService_Hostname_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MachineService_InhibitorAcquire_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InhibitorAcquireRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).InhibitorAcquire(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_InhibitorAcquire_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).InhibitorAcquire(ctx, req.(*InhibitorAcquireRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_InhibitorList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).InhibitorList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_InhibitorList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).InhibitorList(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_InhibitorRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InhibitorReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).InhibitorRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_InhibitorRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).InhibitorRelease(ctx, req.(*InhibitorReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_JoinTokenCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinTokenCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).JoinTokenCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_JoinTokenCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).JoinTokenCreate(ctx, req.(*JoinTokenCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_JoinTokenList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).JoinTokenList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_JoinTokenList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).JoinTokenList(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_JoinTokenRevoke_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinTokenRevokeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).JoinTokenRevoke(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_JoinTokenRevoke_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).JoinTokenRevoke(ctx, req.(*JoinTokenRevokeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MachineService_Kubeconfig_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(emptypb.Empty)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1536,6 +1970,24 @@ func _MachineService_Kubeconfig_Handler(srv interface{}, stream grpc.ServerStrea
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type MachineService_KubeconfigServer = grpc.ServerStreamingServer[common.Data]
 
+func _MachineService_KubernetesUpgradePreflight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KubernetesUpgradePreflightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).KubernetesUpgradePreflight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_KubernetesUpgradePreflight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).KubernetesUpgradePreflight(ctx, req.(*KubernetesUpgradePreflightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MachineService_List_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(ListRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1742,6 +2194,42 @@ func _MachineService_Rollback_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MachineService_RotateCertificates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateCertificatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).RotateCertificates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_RotateCertificates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).RotateCertificates(ctx, req.(*RotateCertificatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_RotateDiskKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateDiskKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).RotateDiskKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_RotateDiskKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).RotateDiskKey(ctx, req.(*RotateDiskKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MachineService_Reset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResetRequest)
 	if err := dec(in); err != nil {
@@ -1760,6 +2248,60 @@ func _MachineService_Reset_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MachineService_ResetIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).ResetIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_ResetIdentity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).ResetIdentity(ctx, req.(*ResetIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_SecretsBundleExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SecretsBundleExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).SecretsBundleExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_SecretsBundleExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).SecretsBundleExport(ctx, req.(*SecretsBundleExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_ServiceDependencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceDependenciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).ServiceDependencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_ServiceDependencies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).ServiceDependencies(ctx, req.(*ServiceDependenciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MachineService_ServiceList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -2045,6 +2587,10 @@ var MachineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ApplyConfiguration",
 			Handler:    _MachineService_ApplyConfiguration_Handler,
 		},
+		{
+			MethodName: "Attest",
+			Handler:    _MachineService_Attest_Handler,
+		},
 		{
 			MethodName: "Bootstrap",
 			Handler:    _MachineService_Bootstrap_Handler,
@@ -2077,6 +2623,10 @@ var MachineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EtcdLeaveCluster",
 			Handler:    _MachineService_EtcdLeaveCluster_Handler,
 		},
+		{
+			MethodName: "EtcdPromoteMember",
+			Handler:    _MachineService_EtcdPromoteMember_Handler,
+		},
 		{
 			MethodName: "EtcdForfeitLeadership",
 			Handler:    _MachineService_EtcdForfeitLeadership_Handler,
@@ -2117,6 +2667,34 @@ var MachineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Hostname",
 			Handler:    _MachineService_Hostname_Handler,
 		},
+		{
+			MethodName: "InhibitorAcquire",
+			Handler:    _MachineService_InhibitorAcquire_Handler,
+		},
+		{
+			MethodName: "InhibitorList",
+			Handler:    _MachineService_InhibitorList_Handler,
+		},
+		{
+			MethodName: "InhibitorRelease",
+			Handler:    _MachineService_InhibitorRelease_Handler,
+		},
+		{
+			MethodName: "JoinTokenCreate",
+			Handler:    _MachineService_JoinTokenCreate_Handler,
+		},
+		{
+			MethodName: "JoinTokenList",
+			Handler:    _MachineService_JoinTokenList_Handler,
+		},
+		{
+			MethodName: "JoinTokenRevoke",
+			Handler:    _MachineService_JoinTokenRevoke_Handler,
+		},
+		{
+			MethodName: "KubernetesUpgradePreflight",
+			Handler:    _MachineService_KubernetesUpgradePreflight_Handler,
+		},
 		{
 			MethodName: "LoadAvg",
 			Handler:    _MachineService_LoadAvg_Handler,
@@ -2153,10 +2731,30 @@ var MachineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Rollback",
 			Handler:    _MachineService_Rollback_Handler,
 		},
+		{
+			MethodName: "RotateCertificates",
+			Handler:    _MachineService_RotateCertificates_Handler,
+		},
+		{
+			MethodName: "RotateDiskKey",
+			Handler:    _MachineService_RotateDiskKey_Handler,
+		},
 		{
 			MethodName: "Reset",
 			Handler:    _MachineService_Reset_Handler,
 		},
+		{
+			MethodName: "ResetIdentity",
+			Handler:    _MachineService_ResetIdentity_Handler,
+		},
+		{
+			MethodName: "SecretsBundleExport",
+			Handler:    _MachineService_SecretsBundleExport_Handler,
+		},
+		{
+			MethodName: "ServiceDependencies",
+			Handler:    _MachineService_ServiceDependencies_Handler,
+		},
 		{
 			MethodName: "ServiceList",
 			Handler:    _MachineService_ServiceList_Handler,
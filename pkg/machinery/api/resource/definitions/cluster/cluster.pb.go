@@ -13,6 +13,7 @@ import (
 
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 
 	common "github.com/siderolabs/talos/pkg/machinery/api/common"
 	enums "github.com/siderolabs/talos/pkg/machinery/api/resource/definitions/enums"
@@ -264,6 +265,120 @@ func (x *ControlPlane) GetApiServerPort() int64 {
 	return 0
 }
 
+// IdentityConflictSpec describes the conflicting machine.
+type IdentityConflictSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Hostname      string                 `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Nodename      string                 `protobuf:"bytes,3,opt,name=nodename,proto3" json:"nodename,omitempty"`
+	Addresses     []*common.NetIP        `protobuf:"bytes,4,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IdentityConflictSpec) Reset() {
+	*x = IdentityConflictSpec{}
+	mi := &file_resource_definitions_cluster_cluster_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdentityConflictSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentityConflictSpec) ProtoMessage() {}
+
+func (x *IdentityConflictSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_cluster_cluster_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentityConflictSpec.ProtoReflect.Descriptor instead.
+func (*IdentityConflictSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_cluster_cluster_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *IdentityConflictSpec) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *IdentityConflictSpec) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *IdentityConflictSpec) GetNodename() string {
+	if x != nil {
+		return x.Nodename
+	}
+	return ""
+}
+
+func (x *IdentityConflictSpec) GetAddresses() []*common.NetIP {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+// IdentityResetSpec describes the identity reset request.
+type IdentityResetSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestedAt   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IdentityResetSpec) Reset() {
+	*x = IdentityResetSpec{}
+	mi := &file_resource_definitions_cluster_cluster_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdentityResetSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentityResetSpec) ProtoMessage() {}
+
+func (x *IdentityResetSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_cluster_cluster_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentityResetSpec.ProtoReflect.Descriptor instead.
+func (*IdentityResetSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_cluster_cluster_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *IdentityResetSpec) GetRequestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return nil
+}
+
 // IdentitySpec describes status of rendered secrets.
 //
 // Note: IdentitySpec is persisted on disk in the STATE partition,
@@ -277,7 +392,7 @@ type IdentitySpec struct {
 
 func (x *IdentitySpec) Reset() {
 	*x = IdentitySpec{}
-	mi := &file_resource_definitions_cluster_cluster_proto_msgTypes[3]
+	mi := &file_resource_definitions_cluster_cluster_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -289,7 +404,7 @@ func (x *IdentitySpec) String() string {
 func (*IdentitySpec) ProtoMessage() {}
 
 func (x *IdentitySpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_cluster_cluster_proto_msgTypes[3]
+	mi := &file_resource_definitions_cluster_cluster_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -302,7 +417,7 @@ func (x *IdentitySpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IdentitySpec.ProtoReflect.Descriptor instead.
 func (*IdentitySpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_cluster_cluster_proto_rawDescGZIP(), []int{3}
+	return file_resource_definitions_cluster_cluster_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *IdentitySpec) GetNodeId() string {
@@ -323,7 +438,7 @@ type InfoSpec struct {
 
 func (x *InfoSpec) Reset() {
 	*x = InfoSpec{}
-	mi := &file_resource_definitions_cluster_cluster_proto_msgTypes[4]
+	mi := &file_resource_definitions_cluster_cluster_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -335,7 +450,7 @@ func (x *InfoSpec) String() string {
 func (*InfoSpec) ProtoMessage() {}
 
 func (x *InfoSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_cluster_cluster_proto_msgTypes[4]
+	mi := &file_resource_definitions_cluster_cluster_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -348,7 +463,7 @@ func (x *InfoSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InfoSpec.ProtoReflect.Descriptor instead.
 func (*InfoSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_cluster_cluster_proto_rawDescGZIP(), []int{4}
+	return file_resource_definitions_cluster_cluster_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *InfoSpec) GetClusterId() string {
@@ -378,7 +493,7 @@ type KubeSpanAffiliateSpec struct {
 
 func (x *KubeSpanAffiliateSpec) Reset() {
 	*x = KubeSpanAffiliateSpec{}
-	mi := &file_resource_definitions_cluster_cluster_proto_msgTypes[5]
+	mi := &file_resource_definitions_cluster_cluster_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -390,7 +505,7 @@ func (x *KubeSpanAffiliateSpec) String() string {
 func (*KubeSpanAffiliateSpec) ProtoMessage() {}
 
 func (x *KubeSpanAffiliateSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_cluster_cluster_proto_msgTypes[5]
+	mi := &file_resource_definitions_cluster_cluster_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -403,7 +518,7 @@ func (x *KubeSpanAffiliateSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KubeSpanAffiliateSpec.ProtoReflect.Descriptor instead.
 func (*KubeSpanAffiliateSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_cluster_cluster_proto_rawDescGZIP(), []int{5}
+	return file_resource_definitions_cluster_cluster_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *KubeSpanAffiliateSpec) GetPublicKey() string {
@@ -449,7 +564,7 @@ type MemberSpec struct {
 
 func (x *MemberSpec) Reset() {
 	*x = MemberSpec{}
-	mi := &file_resource_definitions_cluster_cluster_proto_msgTypes[6]
+	mi := &file_resource_definitions_cluster_cluster_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -461,7 +576,7 @@ func (x *MemberSpec) String() string {
 func (*MemberSpec) ProtoMessage() {}
 
 func (x *MemberSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_cluster_cluster_proto_msgTypes[6]
+	mi := &file_resource_definitions_cluster_cluster_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -474,7 +589,7 @@ func (x *MemberSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MemberSpec.ProtoReflect.Descriptor instead.
 func (*MemberSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_cluster_cluster_proto_rawDescGZIP(), []int{6}
+	return file_resource_definitions_cluster_cluster_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *MemberSpec) GetNodeId() string {
@@ -523,7 +638,7 @@ var File_resource_definitions_cluster_cluster_proto protoreflect.FileDescriptor
 
 const file_resource_definitions_cluster_cluster_proto_rawDesc = "" +
 	"\n" +
-	"*resource/definitions/cluster/cluster.proto\x12\"talos.resource.definitions.cluster\x1a\x13common/common.proto\x1a&resource/definitions/enums/enums.proto\"\xb9\x03\n" +
+	"*resource/definitions/cluster/cluster.proto\x12\"talos.resource.definitions.cluster\x1a\x13common/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a&resource/definitions/enums/enums.proto\"\xb9\x03\n" +
 	"\rAffiliateSpec\x12\x17\n" +
 	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12+\n" +
 	"\taddresses\x18\x02 \x03(\v2\r.common.NetIPR\taddresses\x12\x1a\n" +
@@ -543,7 +658,14 @@ const file_resource_definitions_cluster_cluster_proto_rawDesc = "" +
 	"\x16service_encryption_key\x18\x06 \x01(\fR\x14serviceEncryptionKey\x12,\n" +
 	"\x12service_cluster_id\x18\a \x01(\tR\x10serviceClusterId\"6\n" +
 	"\fControlPlane\x12&\n" +
-	"\x0fapi_server_port\x18\x01 \x01(\x03R\rapiServerPort\"'\n" +
+	"\x0fapi_server_port\x18\x01 \x01(\x03R\rapiServerPort\"\x94\x01\n" +
+	"\x14IdentityConflictSpec\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x1a\n" +
+	"\bhostname\x18\x02 \x01(\tR\bhostname\x12\x1a\n" +
+	"\bnodename\x18\x03 \x01(\tR\bnodename\x12+\n" +
+	"\taddresses\x18\x04 \x03(\v2\r.common.NetIPR\taddresses\"R\n" +
+	"\x11IdentityResetSpec\x12=\n" +
+	"\frequested_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vrequestedAt\"'\n" +
 	"\fIdentitySpec\x12\x17\n" +
 	"\anode_id\x18\x01 \x01(\tR\x06nodeId\"L\n" +
 	"\bInfoSpec\x12\x1d\n" +
@@ -578,36 +700,41 @@ func file_resource_definitions_cluster_cluster_proto_rawDescGZIP() []byte {
 	return file_resource_definitions_cluster_cluster_proto_rawDescData
 }
 
-var file_resource_definitions_cluster_cluster_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_resource_definitions_cluster_cluster_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_resource_definitions_cluster_cluster_proto_goTypes = []any{
 	(*AffiliateSpec)(nil),         // 0: talos.resource.definitions.cluster.AffiliateSpec
 	(*ConfigSpec)(nil),            // 1: talos.resource.definitions.cluster.ConfigSpec
 	(*ControlPlane)(nil),          // 2: talos.resource.definitions.cluster.ControlPlane
-	(*IdentitySpec)(nil),          // 3: talos.resource.definitions.cluster.IdentitySpec
-	(*InfoSpec)(nil),              // 4: talos.resource.definitions.cluster.InfoSpec
-	(*KubeSpanAffiliateSpec)(nil), // 5: talos.resource.definitions.cluster.KubeSpanAffiliateSpec
-	(*MemberSpec)(nil),            // 6: talos.resource.definitions.cluster.MemberSpec
-	(*common.NetIP)(nil),          // 7: common.NetIP
-	(enums.MachineType)(0),        // 8: talos.resource.definitions.enums.MachineType
-	(*common.NetIPPrefix)(nil),    // 9: common.NetIPPrefix
-	(*common.NetIPPort)(nil),      // 10: common.NetIPPort
+	(*IdentityConflictSpec)(nil),  // 3: talos.resource.definitions.cluster.IdentityConflictSpec
+	(*IdentityResetSpec)(nil),     // 4: talos.resource.definitions.cluster.IdentityResetSpec
+	(*IdentitySpec)(nil),          // 5: talos.resource.definitions.cluster.IdentitySpec
+	(*InfoSpec)(nil),              // 6: talos.resource.definitions.cluster.InfoSpec
+	(*KubeSpanAffiliateSpec)(nil), // 7: talos.resource.definitions.cluster.KubeSpanAffiliateSpec
+	(*MemberSpec)(nil),            // 8: talos.resource.definitions.cluster.MemberSpec
+	(*common.NetIP)(nil),          // 9: common.NetIP
+	(enums.MachineType)(0),        // 10: talos.resource.definitions.enums.MachineType
+	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(*common.NetIPPrefix)(nil),    // 12: common.NetIPPrefix
+	(*common.NetIPPort)(nil),      // 13: common.NetIPPort
 }
 var file_resource_definitions_cluster_cluster_proto_depIdxs = []int32{
-	7,  // 0: talos.resource.definitions.cluster.AffiliateSpec.addresses:type_name -> common.NetIP
-	8,  // 1: talos.resource.definitions.cluster.AffiliateSpec.machine_type:type_name -> talos.resource.definitions.enums.MachineType
-	5,  // 2: talos.resource.definitions.cluster.AffiliateSpec.kube_span:type_name -> talos.resource.definitions.cluster.KubeSpanAffiliateSpec
+	9,  // 0: talos.resource.definitions.cluster.AffiliateSpec.addresses:type_name -> common.NetIP
+	10, // 1: talos.resource.definitions.cluster.AffiliateSpec.machine_type:type_name -> talos.resource.definitions.enums.MachineType
+	7,  // 2: talos.resource.definitions.cluster.AffiliateSpec.kube_span:type_name -> talos.resource.definitions.cluster.KubeSpanAffiliateSpec
 	2,  // 3: talos.resource.definitions.cluster.AffiliateSpec.control_plane:type_name -> talos.resource.definitions.cluster.ControlPlane
-	7,  // 4: talos.resource.definitions.cluster.KubeSpanAffiliateSpec.address:type_name -> common.NetIP
-	9,  // 5: talos.resource.definitions.cluster.KubeSpanAffiliateSpec.additional_addresses:type_name -> common.NetIPPrefix
-	10, // 6: talos.resource.definitions.cluster.KubeSpanAffiliateSpec.endpoints:type_name -> common.NetIPPort
-	7,  // 7: talos.resource.definitions.cluster.MemberSpec.addresses:type_name -> common.NetIP
-	8,  // 8: talos.resource.definitions.cluster.MemberSpec.machine_type:type_name -> talos.resource.definitions.enums.MachineType
-	2,  // 9: talos.resource.definitions.cluster.MemberSpec.control_plane:type_name -> talos.resource.definitions.cluster.ControlPlane
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	9,  // 4: talos.resource.definitions.cluster.IdentityConflictSpec.addresses:type_name -> common.NetIP
+	11, // 5: talos.resource.definitions.cluster.IdentityResetSpec.requested_at:type_name -> google.protobuf.Timestamp
+	9,  // 6: talos.resource.definitions.cluster.KubeSpanAffiliateSpec.address:type_name -> common.NetIP
+	12, // 7: talos.resource.definitions.cluster.KubeSpanAffiliateSpec.additional_addresses:type_name -> common.NetIPPrefix
+	13, // 8: talos.resource.definitions.cluster.KubeSpanAffiliateSpec.endpoints:type_name -> common.NetIPPort
+	9,  // 9: talos.resource.definitions.cluster.MemberSpec.addresses:type_name -> common.NetIP
+	10, // 10: talos.resource.definitions.cluster.MemberSpec.machine_type:type_name -> talos.resource.definitions.enums.MachineType
+	2,  // 11: talos.resource.definitions.cluster.MemberSpec.control_plane:type_name -> talos.resource.definitions.cluster.ControlPlane
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_resource_definitions_cluster_cluster_proto_init() }
@@ -621,7 +748,7 @@ func file_resource_definitions_cluster_cluster_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resource_definitions_cluster_cluster_proto_rawDesc), len(file_resource_definitions_cluster_cluster_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -26,6 +26,75 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// DefaultSecurityProfilesSpec describes the effective default security profiles of the CRI containers.
+type DefaultSecurityProfilesSpec struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Seccomp                 string                 `protobuf:"bytes,1,opt,name=seccomp,proto3" json:"seccomp,omitempty"`
+	SeccompLocalhostProfile string                 `protobuf:"bytes,2,opt,name=seccomp_localhost_profile,json=seccompLocalhostProfile,proto3" json:"seccomp_localhost_profile,omitempty"`
+	AppArmor                string                 `protobuf:"bytes,3,opt,name=app_armor,json=appArmor,proto3" json:"app_armor,omitempty"`
+	AppArmorSupported       bool                   `protobuf:"varint,4,opt,name=app_armor_supported,json=appArmorSupported,proto3" json:"app_armor_supported,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *DefaultSecurityProfilesSpec) Reset() {
+	*x = DefaultSecurityProfilesSpec{}
+	mi := &file_resource_definitions_cri_cri_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DefaultSecurityProfilesSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DefaultSecurityProfilesSpec) ProtoMessage() {}
+
+func (x *DefaultSecurityProfilesSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_cri_cri_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DefaultSecurityProfilesSpec.ProtoReflect.Descriptor instead.
+func (*DefaultSecurityProfilesSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_cri_cri_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DefaultSecurityProfilesSpec) GetSeccomp() string {
+	if x != nil {
+		return x.Seccomp
+	}
+	return ""
+}
+
+func (x *DefaultSecurityProfilesSpec) GetSeccompLocalhostProfile() string {
+	if x != nil {
+		return x.SeccompLocalhostProfile
+	}
+	return ""
+}
+
+func (x *DefaultSecurityProfilesSpec) GetAppArmor() string {
+	if x != nil {
+		return x.AppArmor
+	}
+	return ""
+}
+
+func (x *DefaultSecurityProfilesSpec) GetAppArmorSupported() bool {
+	if x != nil {
+		return x.AppArmorSupported
+	}
+	return false
+}
+
 // ImageCacheConfigSpec represents the ImageCacheConfig.
 type ImageCacheConfigSpec struct {
 	state         protoimpl.MessageState        `protogen:"open.v1"`
@@ -38,7 +107,7 @@ type ImageCacheConfigSpec struct {
 
 func (x *ImageCacheConfigSpec) Reset() {
 	*x = ImageCacheConfigSpec{}
-	mi := &file_resource_definitions_cri_cri_proto_msgTypes[0]
+	mi := &file_resource_definitions_cri_cri_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -50,7 +119,7 @@ func (x *ImageCacheConfigSpec) String() string {
 func (*ImageCacheConfigSpec) ProtoMessage() {}
 
 func (x *ImageCacheConfigSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_cri_cri_proto_msgTypes[0]
+	mi := &file_resource_definitions_cri_cri_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -63,7 +132,7 @@ func (x *ImageCacheConfigSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImageCacheConfigSpec.ProtoReflect.Descriptor instead.
 func (*ImageCacheConfigSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_cri_cri_proto_rawDescGZIP(), []int{0}
+	return file_resource_definitions_cri_cri_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *ImageCacheConfigSpec) GetStatus() enums.CriImageCacheStatus {
@@ -98,7 +167,7 @@ type RegistriesConfigSpec struct {
 
 func (x *RegistriesConfigSpec) Reset() {
 	*x = RegistriesConfigSpec{}
-	mi := &file_resource_definitions_cri_cri_proto_msgTypes[1]
+	mi := &file_resource_definitions_cri_cri_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -110,7 +179,7 @@ func (x *RegistriesConfigSpec) String() string {
 func (*RegistriesConfigSpec) ProtoMessage() {}
 
 func (x *RegistriesConfigSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_cri_cri_proto_msgTypes[1]
+	mi := &file_resource_definitions_cri_cri_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -123,7 +192,7 @@ func (x *RegistriesConfigSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegistriesConfigSpec.ProtoReflect.Descriptor instead.
 func (*RegistriesConfigSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_cri_cri_proto_rawDescGZIP(), []int{1}
+	return file_resource_definitions_cri_cri_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *RegistriesConfigSpec) GetRegistryMirrors() map[string]*RegistryMirrorConfig {
@@ -153,7 +222,7 @@ type RegistryAuthConfig struct {
 
 func (x *RegistryAuthConfig) Reset() {
 	*x = RegistryAuthConfig{}
-	mi := &file_resource_definitions_cri_cri_proto_msgTypes[2]
+	mi := &file_resource_definitions_cri_cri_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -165,7 +234,7 @@ func (x *RegistryAuthConfig) String() string {
 func (*RegistryAuthConfig) ProtoMessage() {}
 
 func (x *RegistryAuthConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_cri_cri_proto_msgTypes[2]
+	mi := &file_resource_definitions_cri_cri_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -178,7 +247,7 @@ func (x *RegistryAuthConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegistryAuthConfig.ProtoReflect.Descriptor instead.
 func (*RegistryAuthConfig) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_cri_cri_proto_rawDescGZIP(), []int{2}
+	return file_resource_definitions_cri_cri_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *RegistryAuthConfig) GetRegistryUsername() string {
@@ -220,7 +289,7 @@ type RegistryConfig struct {
 
 func (x *RegistryConfig) Reset() {
 	*x = RegistryConfig{}
-	mi := &file_resource_definitions_cri_cri_proto_msgTypes[3]
+	mi := &file_resource_definitions_cri_cri_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -232,7 +301,7 @@ func (x *RegistryConfig) String() string {
 func (*RegistryConfig) ProtoMessage() {}
 
 func (x *RegistryConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_cri_cri_proto_msgTypes[3]
+	mi := &file_resource_definitions_cri_cri_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -245,7 +314,7 @@ func (x *RegistryConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegistryConfig.ProtoReflect.Descriptor instead.
 func (*RegistryConfig) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_cri_cri_proto_rawDescGZIP(), []int{3}
+	return file_resource_definitions_cri_cri_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *RegistryConfig) GetRegistryTls() *RegistryTLSConfig {
@@ -273,7 +342,7 @@ type RegistryEndpointConfig struct {
 
 func (x *RegistryEndpointConfig) Reset() {
 	*x = RegistryEndpointConfig{}
-	mi := &file_resource_definitions_cri_cri_proto_msgTypes[4]
+	mi := &file_resource_definitions_cri_cri_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -285,7 +354,7 @@ func (x *RegistryEndpointConfig) String() string {
 func (*RegistryEndpointConfig) ProtoMessage() {}
 
 func (x *RegistryEndpointConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_cri_cri_proto_msgTypes[4]
+	mi := &file_resource_definitions_cri_cri_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -298,7 +367,7 @@ func (x *RegistryEndpointConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegistryEndpointConfig.ProtoReflect.Descriptor instead.
 func (*RegistryEndpointConfig) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_cri_cri_proto_rawDescGZIP(), []int{4}
+	return file_resource_definitions_cri_cri_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *RegistryEndpointConfig) GetEndpointEndpoint() string {
@@ -326,7 +395,7 @@ type RegistryMirrorConfig struct {
 
 func (x *RegistryMirrorConfig) Reset() {
 	*x = RegistryMirrorConfig{}
-	mi := &file_resource_definitions_cri_cri_proto_msgTypes[5]
+	mi := &file_resource_definitions_cri_cri_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -338,7 +407,7 @@ func (x *RegistryMirrorConfig) String() string {
 func (*RegistryMirrorConfig) ProtoMessage() {}
 
 func (x *RegistryMirrorConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_cri_cri_proto_msgTypes[5]
+	mi := &file_resource_definitions_cri_cri_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -351,7 +420,7 @@ func (x *RegistryMirrorConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegistryMirrorConfig.ProtoReflect.Descriptor instead.
 func (*RegistryMirrorConfig) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_cri_cri_proto_rawDescGZIP(), []int{5}
+	return file_resource_definitions_cri_cri_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *RegistryMirrorConfig) GetMirrorEndpoints() []*RegistryEndpointConfig {
@@ -380,7 +449,7 @@ type RegistryTLSConfig struct {
 
 func (x *RegistryTLSConfig) Reset() {
 	*x = RegistryTLSConfig{}
-	mi := &file_resource_definitions_cri_cri_proto_msgTypes[6]
+	mi := &file_resource_definitions_cri_cri_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -392,7 +461,7 @@ func (x *RegistryTLSConfig) String() string {
 func (*RegistryTLSConfig) ProtoMessage() {}
 
 func (x *RegistryTLSConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_cri_cri_proto_msgTypes[6]
+	mi := &file_resource_definitions_cri_cri_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -405,7 +474,7 @@ func (x *RegistryTLSConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegistryTLSConfig.ProtoReflect.Descriptor instead.
 func (*RegistryTLSConfig) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_cri_cri_proto_rawDescGZIP(), []int{6}
+	return file_resource_definitions_cri_cri_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *RegistryTLSConfig) GetTlsClientIdentity() *common.PEMEncodedCertificateAndKey {
@@ -440,7 +509,7 @@ type SeccompProfileSpec struct {
 
 func (x *SeccompProfileSpec) Reset() {
 	*x = SeccompProfileSpec{}
-	mi := &file_resource_definitions_cri_cri_proto_msgTypes[7]
+	mi := &file_resource_definitions_cri_cri_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -452,7 +521,7 @@ func (x *SeccompProfileSpec) String() string {
 func (*SeccompProfileSpec) ProtoMessage() {}
 
 func (x *SeccompProfileSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_cri_cri_proto_msgTypes[7]
+	mi := &file_resource_definitions_cri_cri_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -465,7 +534,7 @@ func (x *SeccompProfileSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SeccompProfileSpec.ProtoReflect.Descriptor instead.
 func (*SeccompProfileSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_cri_cri_proto_rawDescGZIP(), []int{7}
+	return file_resource_definitions_cri_cri_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SeccompProfileSpec) GetName() string {
@@ -486,7 +555,12 @@ var File_resource_definitions_cri_cri_proto protoreflect.FileDescriptor
 
 const file_resource_definitions_cri_cri_proto_rawDesc = "" +
 	"\n" +
-	"\"resource/definitions/cri/cri.proto\x12\x1etalos.resource.definitions.cri\x1a\x13common/common.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a&resource/definitions/enums/enums.proto\"\xd7\x01\n" +
+	"\"resource/definitions/cri/cri.proto\x12\x1etalos.resource.definitions.cri\x1a\x13common/common.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a&resource/definitions/enums/enums.proto\"\xc0\x01\n" +
+	"\x1bDefaultSecurityProfilesSpec\x12\x18\n" +
+	"\aseccomp\x18\x01 \x01(\tR\aseccomp\x12:\n" +
+	"\x19seccomp_localhost_profile\x18\x02 \x01(\tR\x17seccompLocalhostProfile\x12\x1b\n" +
+	"\tapp_armor\x18\x03 \x01(\tR\bappArmor\x12.\n" +
+	"\x13app_armor_supported\x18\x04 \x01(\bR\x11appArmorSupported\"\xd7\x01\n" +
 	"\x14ImageCacheConfigSpec\x12M\n" +
 	"\x06status\x18\x01 \x01(\x0e25.talos.resource.definitions.enums.CriImageCacheStatusR\x06status\x12\x14\n" +
 	"\x05roots\x18\x02 \x03(\tR\x05roots\x12Z\n" +
@@ -536,35 +610,36 @@ func file_resource_definitions_cri_cri_proto_rawDescGZIP() []byte {
 	return file_resource_definitions_cri_cri_proto_rawDescData
 }
 
-var file_resource_definitions_cri_cri_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_resource_definitions_cri_cri_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_resource_definitions_cri_cri_proto_goTypes = []any{
-	(*ImageCacheConfigSpec)(nil),               // 0: talos.resource.definitions.cri.ImageCacheConfigSpec
-	(*RegistriesConfigSpec)(nil),               // 1: talos.resource.definitions.cri.RegistriesConfigSpec
-	(*RegistryAuthConfig)(nil),                 // 2: talos.resource.definitions.cri.RegistryAuthConfig
-	(*RegistryConfig)(nil),                     // 3: talos.resource.definitions.cri.RegistryConfig
-	(*RegistryEndpointConfig)(nil),             // 4: talos.resource.definitions.cri.RegistryEndpointConfig
-	(*RegistryMirrorConfig)(nil),               // 5: talos.resource.definitions.cri.RegistryMirrorConfig
-	(*RegistryTLSConfig)(nil),                  // 6: talos.resource.definitions.cri.RegistryTLSConfig
-	(*SeccompProfileSpec)(nil),                 // 7: talos.resource.definitions.cri.SeccompProfileSpec
-	nil,                                        // 8: talos.resource.definitions.cri.RegistriesConfigSpec.RegistryMirrorsEntry
-	nil,                                        // 9: talos.resource.definitions.cri.RegistriesConfigSpec.RegistryConfigEntry
-	(enums.CriImageCacheStatus)(0),             // 10: talos.resource.definitions.enums.CriImageCacheStatus
-	(enums.CriImageCacheCopyStatus)(0),         // 11: talos.resource.definitions.enums.CriImageCacheCopyStatus
-	(*common.PEMEncodedCertificateAndKey)(nil), // 12: common.PEMEncodedCertificateAndKey
-	(*structpb.Struct)(nil),                    // 13: google.protobuf.Struct
+	(*DefaultSecurityProfilesSpec)(nil),        // 0: talos.resource.definitions.cri.DefaultSecurityProfilesSpec
+	(*ImageCacheConfigSpec)(nil),               // 1: talos.resource.definitions.cri.ImageCacheConfigSpec
+	(*RegistriesConfigSpec)(nil),               // 2: talos.resource.definitions.cri.RegistriesConfigSpec
+	(*RegistryAuthConfig)(nil),                 // 3: talos.resource.definitions.cri.RegistryAuthConfig
+	(*RegistryConfig)(nil),                     // 4: talos.resource.definitions.cri.RegistryConfig
+	(*RegistryEndpointConfig)(nil),             // 5: talos.resource.definitions.cri.RegistryEndpointConfig
+	(*RegistryMirrorConfig)(nil),               // 6: talos.resource.definitions.cri.RegistryMirrorConfig
+	(*RegistryTLSConfig)(nil),                  // 7: talos.resource.definitions.cri.RegistryTLSConfig
+	(*SeccompProfileSpec)(nil),                 // 8: talos.resource.definitions.cri.SeccompProfileSpec
+	nil,                                        // 9: talos.resource.definitions.cri.RegistriesConfigSpec.RegistryMirrorsEntry
+	nil,                                        // 10: talos.resource.definitions.cri.RegistriesConfigSpec.RegistryConfigEntry
+	(enums.CriImageCacheStatus)(0),             // 11: talos.resource.definitions.enums.CriImageCacheStatus
+	(enums.CriImageCacheCopyStatus)(0),         // 12: talos.resource.definitions.enums.CriImageCacheCopyStatus
+	(*common.PEMEncodedCertificateAndKey)(nil), // 13: common.PEMEncodedCertificateAndKey
+	(*structpb.Struct)(nil),                    // 14: google.protobuf.Struct
 }
 var file_resource_definitions_cri_cri_proto_depIdxs = []int32{
-	10, // 0: talos.resource.definitions.cri.ImageCacheConfigSpec.status:type_name -> talos.resource.definitions.enums.CriImageCacheStatus
-	11, // 1: talos.resource.definitions.cri.ImageCacheConfigSpec.copy_status:type_name -> talos.resource.definitions.enums.CriImageCacheCopyStatus
-	8,  // 2: talos.resource.definitions.cri.RegistriesConfigSpec.registry_mirrors:type_name -> talos.resource.definitions.cri.RegistriesConfigSpec.RegistryMirrorsEntry
-	9,  // 3: talos.resource.definitions.cri.RegistriesConfigSpec.registry_config:type_name -> talos.resource.definitions.cri.RegistriesConfigSpec.RegistryConfigEntry
-	6,  // 4: talos.resource.definitions.cri.RegistryConfig.registry_tls:type_name -> talos.resource.definitions.cri.RegistryTLSConfig
-	2,  // 5: talos.resource.definitions.cri.RegistryConfig.registry_auth:type_name -> talos.resource.definitions.cri.RegistryAuthConfig
-	4,  // 6: talos.resource.definitions.cri.RegistryMirrorConfig.mirror_endpoints:type_name -> talos.resource.definitions.cri.RegistryEndpointConfig
-	12, // 7: talos.resource.definitions.cri.RegistryTLSConfig.tls_client_identity:type_name -> common.PEMEncodedCertificateAndKey
-	13, // 8: talos.resource.definitions.cri.SeccompProfileSpec.value:type_name -> google.protobuf.Struct
-	5,  // 9: talos.resource.definitions.cri.RegistriesConfigSpec.RegistryMirrorsEntry.value:type_name -> talos.resource.definitions.cri.RegistryMirrorConfig
-	3,  // 10: talos.resource.definitions.cri.RegistriesConfigSpec.RegistryConfigEntry.value:type_name -> talos.resource.definitions.cri.RegistryConfig
+	11, // 0: talos.resource.definitions.cri.ImageCacheConfigSpec.status:type_name -> talos.resource.definitions.enums.CriImageCacheStatus
+	12, // 1: talos.resource.definitions.cri.ImageCacheConfigSpec.copy_status:type_name -> talos.resource.definitions.enums.CriImageCacheCopyStatus
+	9,  // 2: talos.resource.definitions.cri.RegistriesConfigSpec.registry_mirrors:type_name -> talos.resource.definitions.cri.RegistriesConfigSpec.RegistryMirrorsEntry
+	10, // 3: talos.resource.definitions.cri.RegistriesConfigSpec.registry_config:type_name -> talos.resource.definitions.cri.RegistriesConfigSpec.RegistryConfigEntry
+	7,  // 4: talos.resource.definitions.cri.RegistryConfig.registry_tls:type_name -> talos.resource.definitions.cri.RegistryTLSConfig
+	3,  // 5: talos.resource.definitions.cri.RegistryConfig.registry_auth:type_name -> talos.resource.definitions.cri.RegistryAuthConfig
+	5,  // 6: talos.resource.definitions.cri.RegistryMirrorConfig.mirror_endpoints:type_name -> talos.resource.definitions.cri.RegistryEndpointConfig
+	13, // 7: talos.resource.definitions.cri.RegistryTLSConfig.tls_client_identity:type_name -> common.PEMEncodedCertificateAndKey
+	14, // 8: talos.resource.definitions.cri.SeccompProfileSpec.value:type_name -> google.protobuf.Struct
+	6,  // 9: talos.resource.definitions.cri.RegistriesConfigSpec.RegistryMirrorsEntry.value:type_name -> talos.resource.definitions.cri.RegistryMirrorConfig
+	4,  // 10: talos.resource.definitions.cri.RegistriesConfigSpec.RegistryConfigEntry.value:type_name -> talos.resource.definitions.cri.RegistryConfig
 	11, // [11:11] is the sub-list for method output_type
 	11, // [11:11] is the sub-list for method input_type
 	11, // [11:11] is the sub-list for extension type_name
@@ -583,7 +658,7 @@ func file_resource_definitions_cri_cri_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resource_definitions_cri_cri_proto_rawDesc), len(file_resource_definitions_cri_cri_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
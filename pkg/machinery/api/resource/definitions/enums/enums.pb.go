@@ -475,11 +475,12 @@ func (NethelpersBondMode) EnumDescriptor() ([]byte, []int) {
 type NethelpersBondXmitHashPolicy int32
 
 const (
-	NethelpersBondXmitHashPolicy_BOND_XMIT_POLICY_LAYER2  NethelpersBondXmitHashPolicy = 0
-	NethelpersBondXmitHashPolicy_BOND_XMIT_POLICY_LAYER34 NethelpersBondXmitHashPolicy = 1
-	NethelpersBondXmitHashPolicy_BOND_XMIT_POLICY_LAYER23 NethelpersBondXmitHashPolicy = 2
-	NethelpersBondXmitHashPolicy_BOND_XMIT_POLICY_ENCAP23 NethelpersBondXmitHashPolicy = 3
-	NethelpersBondXmitHashPolicy_BOND_XMIT_POLICY_ENCAP34 NethelpersBondXmitHashPolicy = 4
+	NethelpersBondXmitHashPolicy_BOND_XMIT_POLICY_LAYER2     NethelpersBondXmitHashPolicy = 0
+	NethelpersBondXmitHashPolicy_BOND_XMIT_POLICY_LAYER34    NethelpersBondXmitHashPolicy = 1
+	NethelpersBondXmitHashPolicy_BOND_XMIT_POLICY_LAYER23    NethelpersBondXmitHashPolicy = 2
+	NethelpersBondXmitHashPolicy_BOND_XMIT_POLICY_ENCAP23    NethelpersBondXmitHashPolicy = 3
+	NethelpersBondXmitHashPolicy_BOND_XMIT_POLICY_ENCAP34    NethelpersBondXmitHashPolicy = 4
+	NethelpersBondXmitHashPolicy_BOND_XMIT_POLICY_VLANSRCMAC NethelpersBondXmitHashPolicy = 5
 )
 
 // Enum value maps for NethelpersBondXmitHashPolicy.
@@ -490,13 +491,15 @@ var (
 		2: "BOND_XMIT_POLICY_LAYER23",
 		3: "BOND_XMIT_POLICY_ENCAP23",
 		4: "BOND_XMIT_POLICY_ENCAP34",
+		5: "BOND_XMIT_POLICY_VLANSRCMAC",
 	}
 	NethelpersBondXmitHashPolicy_value = map[string]int32{
-		"BOND_XMIT_POLICY_LAYER2":  0,
-		"BOND_XMIT_POLICY_LAYER34": 1,
-		"BOND_XMIT_POLICY_LAYER23": 2,
-		"BOND_XMIT_POLICY_ENCAP23": 3,
-		"BOND_XMIT_POLICY_ENCAP34": 4,
+		"BOND_XMIT_POLICY_LAYER2":     0,
+		"BOND_XMIT_POLICY_LAYER34":    1,
+		"BOND_XMIT_POLICY_LAYER23":    2,
+		"BOND_XMIT_POLICY_ENCAP23":    3,
+		"BOND_XMIT_POLICY_ENCAP34":    4,
+		"BOND_XMIT_POLICY_VLANSRCMAC": 5,
 	}
 )
 
@@ -2652,9 +2655,10 @@ func (NetworkConfigLayer) EnumDescriptor() ([]byte, []int) {
 type NetworkOperator int32
 
 const (
-	NetworkOperator_OPERATOR_DHCP4 NetworkOperator = 0
-	NetworkOperator_OPERATOR_DHCP6 NetworkOperator = 1
-	NetworkOperator_OPERATOR_VIP   NetworkOperator = 2
+	NetworkOperator_OPERATOR_DHCP4           NetworkOperator = 0
+	NetworkOperator_OPERATOR_DHCP6           NetworkOperator = 1
+	NetworkOperator_OPERATOR_VIP             NetworkOperator = 2
+	NetworkOperator_OPERATOR_STATIC_FALLBACK NetworkOperator = 3
 )
 
 // Enum value maps for NetworkOperator.
@@ -2663,11 +2667,13 @@ var (
 		0: "OPERATOR_DHCP4",
 		1: "OPERATOR_DHCP6",
 		2: "OPERATOR_VIP",
+		3: "OPERATOR_STATIC_FALLBACK",
 	}
 	NetworkOperator_value = map[string]int32{
-		"OPERATOR_DHCP4": 0,
-		"OPERATOR_DHCP6": 1,
-		"OPERATOR_VIP":   2,
+		"OPERATOR_DHCP4":           0,
+		"OPERATOR_DHCP6":           1,
+		"OPERATOR_VIP":             2,
+		"OPERATOR_STATIC_FALLBACK": 3,
 	}
 )
 
@@ -2748,13 +2754,14 @@ const file_resource_definitions_enums_enums_proto_rawDesc = "" +
 	"\x13BOND_MODE_BROADCAST\x10\x03\x12\x14\n" +
 	"\x10BOND_MODE8023_AD\x10\x04\x12\x11\n" +
 	"\rBOND_MODE_TLB\x10\x05\x12\x11\n" +
-	"\rBOND_MODE_ALB\x10\x06*\xb3\x01\n" +
+	"\rBOND_MODE_ALB\x10\x06*\xd4\x01\n" +
 	"\x1cNethelpersBondXmitHashPolicy\x12\x1b\n" +
 	"\x17BOND_XMIT_POLICY_LAYER2\x10\x00\x12\x1c\n" +
 	"\x18BOND_XMIT_POLICY_LAYER34\x10\x01\x12\x1c\n" +
 	"\x18BOND_XMIT_POLICY_LAYER23\x10\x02\x12\x1c\n" +
 	"\x18BOND_XMIT_POLICY_ENCAP23\x10\x03\x12\x1c\n" +
-	"\x18BOND_XMIT_POLICY_ENCAP34\x10\x04*\xb9\x01\n" +
+	"\x18BOND_XMIT_POLICY_ENCAP34\x10\x04\x12\x1f\n" +
+	"\x1bBOND_XMIT_POLICY_VLANSRCMAC\x10\x05*\xb9\x01\n" +
 	"\x18NethelpersConntrackState\x12)\n" +
 	"%NETHELPERS_CONNTRACKSTATE_UNSPECIFIED\x10\x00\x12\x17\n" +
 	"\x13CONNTRACK_STATE_NEW\x10\b\x12\x1b\n" +
@@ -3068,11 +3075,12 @@ const file_resource_definitions_enums_enums_proto_rawDesc = "" +
 	"\x0eCONFIG_CMDLINE\x10\x01\x12\x13\n" +
 	"\x0fCONFIG_PLATFORM\x10\x02\x12\x13\n" +
 	"\x0fCONFIG_OPERATOR\x10\x03\x12 \n" +
-	"\x1cCONFIG_MACHINE_CONFIGURATION\x10\x04*K\n" +
+	"\x1cCONFIG_MACHINE_CONFIGURATION\x10\x04*i\n" +
 	"\x0fNetworkOperator\x12\x12\n" +
 	"\x0eOPERATOR_DHCP4\x10\x00\x12\x12\n" +
 	"\x0eOPERATOR_DHCP6\x10\x01\x12\x10\n" +
-	"\fOPERATOR_VIP\x10\x02Bt\n" +
+	"\fOPERATOR_VIP\x10\x02\x12\x1c\n" +
+	"\x18OPERATOR_STATIC_FALLBACK\x10\x03Bt\n" +
 	"(dev.talos.api.resource.definitions.enumsZHgithub.com/siderolabs/talos/pkg/machinery/api/resource/definitions/enumsb\x06proto3"
 
 var (
@@ -13,6 +13,8 @@ import (
 
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 
 	common "github.com/siderolabs/talos/pkg/machinery/api/common"
 )
@@ -24,6 +26,67 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Alarm describes an active etcd alarm.
+type Alarm struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	MemberId      string                 `protobuf:"bytes,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Since         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Alarm) Reset() {
+	*x = Alarm{}
+	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Alarm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alarm) ProtoMessage() {}
+
+func (x *Alarm) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alarm.ProtoReflect.Descriptor instead.
+func (*Alarm) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_etcd_etcd_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Alarm) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Alarm) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *Alarm) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
 // ConfigSpec describes (some) configuration settings of etcd.
 type ConfigSpec struct {
 	state                   protoimpl.MessageState `protogen:"open.v1"`
@@ -39,7 +102,7 @@ type ConfigSpec struct {
 
 func (x *ConfigSpec) Reset() {
 	*x = ConfigSpec{}
-	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[0]
+	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -51,7 +114,7 @@ func (x *ConfigSpec) String() string {
 func (*ConfigSpec) ProtoMessage() {}
 
 func (x *ConfigSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[0]
+	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -64,7 +127,7 @@ func (x *ConfigSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConfigSpec.ProtoReflect.Descriptor instead.
 func (*ConfigSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_etcd_etcd_proto_rawDescGZIP(), []int{0}
+	return file_resource_definitions_etcd_etcd_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *ConfigSpec) GetAdvertiseValidSubnets() []string {
@@ -109,17 +172,122 @@ func (x *ConfigSpec) GetListenExcludeSubnets() []string {
 	return nil
 }
 
-// MemberSpec holds information about an etcd member.
-type MemberSpec struct {
+// DefragmentationStatusSpec describes the results of the scheduled defragmentation of the local etcd member.
+type DefragmentationStatusSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	LastRun       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_run,json=lastRun,proto3" json:"last_run,omitempty"`
+	NextRun       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=next_run,json=nextRun,proto3" json:"next_run,omitempty"`
+	Duration      *durationpb.Duration   `protobuf:"bytes,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	DbSizeBefore  int64                  `protobuf:"varint,5,opt,name=db_size_before,json=dbSizeBefore,proto3" json:"db_size_before,omitempty"`
+	DbSizeAfter   int64                  `protobuf:"varint,6,opt,name=db_size_after,json=dbSizeAfter,proto3" json:"db_size_after,omitempty"`
+	LastError     string                 `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	SkipReason    string                 `protobuf:"bytes,8,opt,name=skip_reason,json=skipReason,proto3" json:"skip_reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *DefragmentationStatusSpec) Reset() {
+	*x = DefragmentationStatusSpec{}
+	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DefragmentationStatusSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DefragmentationStatusSpec) ProtoMessage() {}
+
+func (x *DefragmentationStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DefragmentationStatusSpec.ProtoReflect.Descriptor instead.
+func (*DefragmentationStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_etcd_etcd_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DefragmentationStatusSpec) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *DefragmentationStatusSpec) GetLastRun() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRun
+	}
+	return nil
+}
+
+func (x *DefragmentationStatusSpec) GetNextRun() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRun
+	}
+	return nil
+}
+
+func (x *DefragmentationStatusSpec) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *DefragmentationStatusSpec) GetDbSizeBefore() int64 {
+	if x != nil {
+		return x.DbSizeBefore
+	}
+	return 0
+}
+
+func (x *DefragmentationStatusSpec) GetDbSizeAfter() int64 {
+	if x != nil {
+		return x.DbSizeAfter
+	}
+	return 0
+}
+
+func (x *DefragmentationStatusSpec) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *DefragmentationStatusSpec) GetSkipReason() string {
+	if x != nil {
+		return x.SkipReason
+	}
+	return ""
+}
+
+// MemberSpec holds information about an etcd member.
+type MemberSpec struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	MemberId          string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	IsLearner         bool                   `protobuf:"varint,2,opt,name=is_learner,json=isLearner,proto3" json:"is_learner,omitempty"`
+	LearnerLag        uint64                 `protobuf:"varint,3,opt,name=learner_lag,json=learnerLag,proto3" json:"learner_lag,omitempty"`
+	PromotionEligible bool                   `protobuf:"varint,4,opt,name=promotion_eligible,json=promotionEligible,proto3" json:"promotion_eligible,omitempty"`
+	LearnerSince      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=learner_since,json=learnerSince,proto3" json:"learner_since,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
 func (x *MemberSpec) Reset() {
 	*x = MemberSpec{}
-	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[1]
+	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -131,7 +299,7 @@ func (x *MemberSpec) String() string {
 func (*MemberSpec) ProtoMessage() {}
 
 func (x *MemberSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[1]
+	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -144,7 +312,7 @@ func (x *MemberSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MemberSpec.ProtoReflect.Descriptor instead.
 func (*MemberSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_etcd_etcd_proto_rawDescGZIP(), []int{1}
+	return file_resource_definitions_etcd_etcd_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *MemberSpec) GetMemberId() string {
@@ -154,6 +322,34 @@ func (x *MemberSpec) GetMemberId() string {
 	return ""
 }
 
+func (x *MemberSpec) GetIsLearner() bool {
+	if x != nil {
+		return x.IsLearner
+	}
+	return false
+}
+
+func (x *MemberSpec) GetLearnerLag() uint64 {
+	if x != nil {
+		return x.LearnerLag
+	}
+	return 0
+}
+
+func (x *MemberSpec) GetPromotionEligible() bool {
+	if x != nil {
+		return x.PromotionEligible
+	}
+	return false
+}
+
+func (x *MemberSpec) GetLearnerSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LearnerSince
+	}
+	return nil
+}
+
 // PKIStatusSpec describes status of rendered secrets.
 type PKIStatusSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -165,7 +361,7 @@ type PKIStatusSpec struct {
 
 func (x *PKIStatusSpec) Reset() {
 	*x = PKIStatusSpec{}
-	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[2]
+	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -177,7 +373,7 @@ func (x *PKIStatusSpec) String() string {
 func (*PKIStatusSpec) ProtoMessage() {}
 
 func (x *PKIStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[2]
+	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -190,7 +386,7 @@ func (x *PKIStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PKIStatusSpec.ProtoReflect.Descriptor instead.
 func (*PKIStatusSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_etcd_etcd_proto_rawDescGZIP(), []int{2}
+	return file_resource_definitions_etcd_etcd_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *PKIStatusSpec) GetReady() bool {
@@ -207,6 +403,131 @@ func (x *PKIStatusSpec) GetVersion() string {
 	return ""
 }
 
+// SnapshotStatusSpec describes the results of the scheduled snapshot of the local etcd member.
+type SnapshotStatusSpec struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MemberId       string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	LastRun        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_run,json=lastRun,proto3" json:"last_run,omitempty"`
+	NextRun        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=next_run,json=nextRun,proto3" json:"next_run,omitempty"`
+	Duration       *durationpb.Duration   `protobuf:"bytes,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	Path           string                 `protobuf:"bytes,5,opt,name=path,proto3" json:"path,omitempty"`
+	Size           int64                  `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
+	Sha256         string                 `protobuf:"bytes,7,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	LastError      string                 `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	SkipReason     string                 `protobuf:"bytes,9,opt,name=skip_reason,json=skipReason,proto3" json:"skip_reason,omitempty"`
+	UploadLocation string                 `protobuf:"bytes,10,opt,name=upload_location,json=uploadLocation,proto3" json:"upload_location,omitempty"`
+	UploadError    string                 `protobuf:"bytes,11,opt,name=upload_error,json=uploadError,proto3" json:"upload_error,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SnapshotStatusSpec) Reset() {
+	*x = SnapshotStatusSpec{}
+	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnapshotStatusSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotStatusSpec) ProtoMessage() {}
+
+func (x *SnapshotStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotStatusSpec.ProtoReflect.Descriptor instead.
+func (*SnapshotStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_etcd_etcd_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SnapshotStatusSpec) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *SnapshotStatusSpec) GetLastRun() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRun
+	}
+	return nil
+}
+
+func (x *SnapshotStatusSpec) GetNextRun() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRun
+	}
+	return nil
+}
+
+func (x *SnapshotStatusSpec) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *SnapshotStatusSpec) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *SnapshotStatusSpec) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *SnapshotStatusSpec) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *SnapshotStatusSpec) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *SnapshotStatusSpec) GetSkipReason() string {
+	if x != nil {
+		return x.SkipReason
+	}
+	return ""
+}
+
+func (x *SnapshotStatusSpec) GetUploadLocation() string {
+	if x != nil {
+		return x.UploadLocation
+	}
+	return ""
+}
+
+func (x *SnapshotStatusSpec) GetUploadError() string {
+	if x != nil {
+		return x.UploadError
+	}
+	return ""
+}
+
 // SpecSpec describes (some) Specuration settings of etcd.
 type SpecSpec struct {
 	state                 protoimpl.MessageState `protogen:"open.v1"`
@@ -222,7 +543,7 @@ type SpecSpec struct {
 
 func (x *SpecSpec) Reset() {
 	*x = SpecSpec{}
-	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[3]
+	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -234,7 +555,7 @@ func (x *SpecSpec) String() string {
 func (*SpecSpec) ProtoMessage() {}
 
 func (x *SpecSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[3]
+	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -247,7 +568,7 @@ func (x *SpecSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SpecSpec.ProtoReflect.Descriptor instead.
 func (*SpecSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_etcd_etcd_proto_rawDescGZIP(), []int{3}
+	return file_resource_definitions_etcd_etcd_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SpecSpec) GetName() string {
@@ -292,11 +613,92 @@ func (x *SpecSpec) GetListenClientAddresses() []*common.NetIP {
 	return nil
 }
 
+// StatusSpec describes the alarms and the database quota usage of the local etcd member.
+type StatusSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	DbSize        int64                  `protobuf:"varint,2,opt,name=db_size,json=dbSize,proto3" json:"db_size,omitempty"`
+	DbSizeInUse   int64                  `protobuf:"varint,3,opt,name=db_size_in_use,json=dbSizeInUse,proto3" json:"db_size_in_use,omitempty"`
+	DbSizeQuota   int64                  `protobuf:"varint,4,opt,name=db_size_quota,json=dbSizeQuota,proto3" json:"db_size_quota,omitempty"`
+	Alarms        []*Alarm               `protobuf:"bytes,5,rep,name=alarms,proto3" json:"alarms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusSpec) Reset() {
+	*x = StatusSpec{}
+	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusSpec) ProtoMessage() {}
+
+func (x *StatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusSpec.ProtoReflect.Descriptor instead.
+func (*StatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_etcd_etcd_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StatusSpec) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *StatusSpec) GetDbSize() int64 {
+	if x != nil {
+		return x.DbSize
+	}
+	return 0
+}
+
+func (x *StatusSpec) GetDbSizeInUse() int64 {
+	if x != nil {
+		return x.DbSizeInUse
+	}
+	return 0
+}
+
+func (x *StatusSpec) GetDbSizeQuota() int64 {
+	if x != nil {
+		return x.DbSizeQuota
+	}
+	return 0
+}
+
+func (x *StatusSpec) GetAlarms() []*Alarm {
+	if x != nil {
+		return x.Alarms
+	}
+	return nil
+}
+
 var File_resource_definitions_etcd_etcd_proto protoreflect.FileDescriptor
 
 const file_resource_definitions_etcd_etcd_proto_rawDesc = "" +
 	"\n" +
-	"$resource/definitions/etcd/etcd.proto\x12\x1ftalos.resource.definitions.etcd\x1a\x13common/common.proto\"\x97\x03\n" +
+	"$resource/definitions/etcd/etcd.proto\x12\x1ftalos.resource.definitions.etcd\x1a\x13common/common.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"j\n" +
+	"\x05Alarm\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x1b\n" +
+	"\tmember_id\x18\x02 \x01(\tR\bmemberId\x120\n" +
+	"\x05since\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\"\x97\x03\n" +
 	"\n" +
 	"ConfigSpec\x126\n" +
 	"\x17advertise_valid_subnets\x18\x01 \x03(\tR\x15advertiseValidSubnets\x12:\n" +
@@ -308,13 +710,45 @@ const file_resource_definitions_etcd_etcd_proto_rawDesc = "" +
 	"\x16listen_exclude_subnets\x18\x06 \x03(\tR\x14listenExcludeSubnets\x1a<\n" +
 	"\x0eExtraArgsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\")\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xe7\x02\n" +
+	"\x19DefragmentationStatusSpec\x12\x1b\n" +
+	"\tmember_id\x18\x01 \x01(\tR\bmemberId\x125\n" +
+	"\blast_run\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\alastRun\x125\n" +
+	"\bnext_run\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\anextRun\x125\n" +
+	"\bduration\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12$\n" +
+	"\x0edb_size_before\x18\x05 \x01(\x03R\fdbSizeBefore\x12\"\n" +
+	"\rdb_size_after\x18\x06 \x01(\x03R\vdbSizeAfter\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\a \x01(\tR\tlastError\x12\x1f\n" +
+	"\vskip_reason\x18\b \x01(\tR\n" +
+	"skipReason\"\xd9\x01\n" +
 	"\n" +
 	"MemberSpec\x12\x1b\n" +
-	"\tmember_id\x18\x01 \x01(\tR\bmemberId\"?\n" +
+	"\tmember_id\x18\x01 \x01(\tR\bmemberId\x12\x1d\n" +
+	"\n" +
+	"is_learner\x18\x02 \x01(\bR\tisLearner\x12\x1f\n" +
+	"\vlearner_lag\x18\x03 \x01(\x04R\n" +
+	"learnerLag\x12-\n" +
+	"\x12promotion_eligible\x18\x04 \x01(\bR\x11promotionEligible\x12?\n" +
+	"\rlearner_since\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\flearnerSince\"?\n" +
 	"\rPKIStatusSpec\x12\x14\n" +
 	"\x05ready\x18\x01 \x01(\bR\x05ready\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\tR\aversion\"\x97\x03\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\"\xa2\x03\n" +
+	"\x12SnapshotStatusSpec\x12\x1b\n" +
+	"\tmember_id\x18\x01 \x01(\tR\bmemberId\x125\n" +
+	"\blast_run\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\alastRun\x125\n" +
+	"\bnext_run\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\anextRun\x125\n" +
+	"\bduration\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12\x12\n" +
+	"\x04path\x18\x05 \x01(\tR\x04path\x12\x12\n" +
+	"\x04size\x18\x06 \x01(\x03R\x04size\x12\x16\n" +
+	"\x06sha256\x18\a \x01(\tR\x06sha256\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\b \x01(\tR\tlastError\x12\x1f\n" +
+	"\vskip_reason\x18\t \x01(\tR\n" +
+	"skipReason\x12'\n" +
+	"\x0fupload_location\x18\n" +
+	" \x01(\tR\x0euploadLocation\x12!\n" +
+	"\fupload_error\x18\v \x01(\tR\vuploadError\"\x97\x03\n" +
 	"\bSpecSpec\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12@\n" +
 	"\x14advertised_addresses\x18\x02 \x03(\v2\r.common.NetIPR\x13advertisedAddresses\x12\x14\n" +
@@ -325,7 +759,14 @@ const file_resource_definitions_etcd_etcd_proto_rawDesc = "" +
 	"\x17listen_client_addresses\x18\x06 \x03(\v2\r.common.NetIPR\x15listenClientAddresses\x1a<\n" +
 	"\x0eExtraArgsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01Br\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xcb\x01\n" +
+	"\n" +
+	"StatusSpec\x12\x1b\n" +
+	"\tmember_id\x18\x01 \x01(\tR\bmemberId\x12\x17\n" +
+	"\adb_size\x18\x02 \x01(\x03R\x06dbSize\x12#\n" +
+	"\x0edb_size_in_use\x18\x03 \x01(\x03R\vdbSizeInUse\x12\"\n" +
+	"\rdb_size_quota\x18\x04 \x01(\x03R\vdbSizeQuota\x12>\n" +
+	"\x06alarms\x18\x05 \x03(\v2&.talos.resource.definitions.etcd.AlarmR\x06alarmsBr\n" +
 	"'dev.talos.api.resource.definitions.etcdZGgithub.com/siderolabs/talos/pkg/machinery/api/resource/definitions/etcdb\x06proto3"
 
 var (
@@ -340,27 +781,42 @@ func file_resource_definitions_etcd_etcd_proto_rawDescGZIP() []byte {
 	return file_resource_definitions_etcd_etcd_proto_rawDescData
 }
 
-var file_resource_definitions_etcd_etcd_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_resource_definitions_etcd_etcd_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_resource_definitions_etcd_etcd_proto_goTypes = []any{
-	(*ConfigSpec)(nil),    // 0: talos.resource.definitions.etcd.ConfigSpec
-	(*MemberSpec)(nil),    // 1: talos.resource.definitions.etcd.MemberSpec
-	(*PKIStatusSpec)(nil), // 2: talos.resource.definitions.etcd.PKIStatusSpec
-	(*SpecSpec)(nil),      // 3: talos.resource.definitions.etcd.SpecSpec
-	nil,                   // 4: talos.resource.definitions.etcd.ConfigSpec.ExtraArgsEntry
-	nil,                   // 5: talos.resource.definitions.etcd.SpecSpec.ExtraArgsEntry
-	(*common.NetIP)(nil),  // 6: common.NetIP
+	(*Alarm)(nil),                     // 0: talos.resource.definitions.etcd.Alarm
+	(*ConfigSpec)(nil),                // 1: talos.resource.definitions.etcd.ConfigSpec
+	(*DefragmentationStatusSpec)(nil), // 2: talos.resource.definitions.etcd.DefragmentationStatusSpec
+	(*MemberSpec)(nil),                // 3: talos.resource.definitions.etcd.MemberSpec
+	(*PKIStatusSpec)(nil),             // 4: talos.resource.definitions.etcd.PKIStatusSpec
+	(*SnapshotStatusSpec)(nil),        // 5: talos.resource.definitions.etcd.SnapshotStatusSpec
+	(*SpecSpec)(nil),                  // 6: talos.resource.definitions.etcd.SpecSpec
+	(*StatusSpec)(nil),                // 7: talos.resource.definitions.etcd.StatusSpec
+	nil,                               // 8: talos.resource.definitions.etcd.ConfigSpec.ExtraArgsEntry
+	nil,                               // 9: talos.resource.definitions.etcd.SpecSpec.ExtraArgsEntry
+	(*timestamppb.Timestamp)(nil),     // 10: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),       // 11: google.protobuf.Duration
+	(*common.NetIP)(nil),              // 12: common.NetIP
 }
 var file_resource_definitions_etcd_etcd_proto_depIdxs = []int32{
-	4, // 0: talos.resource.definitions.etcd.ConfigSpec.extra_args:type_name -> talos.resource.definitions.etcd.ConfigSpec.ExtraArgsEntry
-	6, // 1: talos.resource.definitions.etcd.SpecSpec.advertised_addresses:type_name -> common.NetIP
-	5, // 2: talos.resource.definitions.etcd.SpecSpec.extra_args:type_name -> talos.resource.definitions.etcd.SpecSpec.ExtraArgsEntry
-	6, // 3: talos.resource.definitions.etcd.SpecSpec.listen_peer_addresses:type_name -> common.NetIP
-	6, // 4: talos.resource.definitions.etcd.SpecSpec.listen_client_addresses:type_name -> common.NetIP
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	10, // 0: talos.resource.definitions.etcd.Alarm.since:type_name -> google.protobuf.Timestamp
+	8,  // 1: talos.resource.definitions.etcd.ConfigSpec.extra_args:type_name -> talos.resource.definitions.etcd.ConfigSpec.ExtraArgsEntry
+	10, // 2: talos.resource.definitions.etcd.DefragmentationStatusSpec.last_run:type_name -> google.protobuf.Timestamp
+	10, // 3: talos.resource.definitions.etcd.DefragmentationStatusSpec.next_run:type_name -> google.protobuf.Timestamp
+	11, // 4: talos.resource.definitions.etcd.DefragmentationStatusSpec.duration:type_name -> google.protobuf.Duration
+	10, // 5: talos.resource.definitions.etcd.MemberSpec.learner_since:type_name -> google.protobuf.Timestamp
+	10, // 6: talos.resource.definitions.etcd.SnapshotStatusSpec.last_run:type_name -> google.protobuf.Timestamp
+	10, // 7: talos.resource.definitions.etcd.SnapshotStatusSpec.next_run:type_name -> google.protobuf.Timestamp
+	11, // 8: talos.resource.definitions.etcd.SnapshotStatusSpec.duration:type_name -> google.protobuf.Duration
+	12, // 9: talos.resource.definitions.etcd.SpecSpec.advertised_addresses:type_name -> common.NetIP
+	9,  // 10: talos.resource.definitions.etcd.SpecSpec.extra_args:type_name -> talos.resource.definitions.etcd.SpecSpec.ExtraArgsEntry
+	12, // 11: talos.resource.definitions.etcd.SpecSpec.listen_peer_addresses:type_name -> common.NetIP
+	12, // 12: talos.resource.definitions.etcd.SpecSpec.listen_client_addresses:type_name -> common.NetIP
+	0,  // 13: talos.resource.definitions.etcd.StatusSpec.alarms:type_name -> talos.resource.definitions.etcd.Alarm
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_resource_definitions_etcd_etcd_proto_init() }
@@ -374,7 +830,7 @@ func file_resource_definitions_etcd_etcd_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resource_definitions_etcd_etcd_proto_rawDesc), len(file_resource_definitions_etcd_etcd_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
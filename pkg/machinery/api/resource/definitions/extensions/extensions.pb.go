@@ -117,6 +117,7 @@ type Layer struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Image         string                 `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
 	Metadata      *Metadata              `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Source        *Source                `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -165,6 +166,13 @@ func (x *Layer) GetMetadata() *Metadata {
 	return nil
 }
 
+func (x *Layer) GetSource() *Source {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
 // Metadata describes base extension metadata.
 type Metadata struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -250,6 +258,120 @@ func (x *Metadata) GetExtraInfo() string {
 	return ""
 }
 
+// Signature is a cosign-style signature of the image manifest digest.
+type Signature struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payload       []byte                 `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature     []byte                 `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Signature) Reset() {
+	*x = Signature{}
+	mi := &file_resource_definitions_extensions_extensions_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Signature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Signature) ProtoMessage() {}
+
+func (x *Signature) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_extensions_extensions_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Signature.ProtoReflect.Descriptor instead.
+func (*Signature) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_extensions_extensions_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Signature) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *Signature) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// Source describes the container image the extension was installed from.
+type Source struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ImageRef      string                 `protobuf:"bytes,1,opt,name=image_ref,json=imageRef,proto3" json:"image_ref,omitempty"`
+	Digest        string                 `protobuf:"bytes,2,opt,name=digest,proto3" json:"digest,omitempty"`
+	Signatures    []*Signature           `protobuf:"bytes,3,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Source) Reset() {
+	*x = Source{}
+	mi := &file_resource_definitions_extensions_extensions_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Source) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Source) ProtoMessage() {}
+
+func (x *Source) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_extensions_extensions_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Source.ProtoReflect.Descriptor instead.
+func (*Source) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_extensions_extensions_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Source) GetImageRef() string {
+	if x != nil {
+		return x.ImageRef
+	}
+	return ""
+}
+
+func (x *Source) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+func (x *Source) GetSignatures() []*Signature {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
 var File_resource_definitions_extensions_extensions_proto protoreflect.FileDescriptor
 
 const file_resource_definitions_extensions_extensions_proto_rawDesc = "" +
@@ -259,10 +381,11 @@ const file_resource_definitions_extensions_extensions_proto_rawDesc = "" +
 	"\x05talos\x18\x01 \x01(\v21.talos.resource.definitions.extensions.ConstraintR\x05talos\"&\n" +
 	"\n" +
 	"Constraint\x12\x18\n" +
-	"\aversion\x18\x01 \x01(\tR\aversion\"j\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\"\xb1\x01\n" +
 	"\x05Layer\x12\x14\n" +
 	"\x05image\x18\x01 \x01(\tR\x05image\x12K\n" +
-	"\bmetadata\x18\x02 \x01(\v2/.talos.resource.definitions.extensions.MetadataR\bmetadata\"\xed\x01\n" +
+	"\bmetadata\x18\x02 \x01(\v2/.talos.resource.definitions.extensions.MetadataR\bmetadata\x12E\n" +
+	"\x06source\x18\x03 \x01(\v2-.talos.resource.definitions.extensions.SourceR\x06source\"\xed\x01\n" +
 	"\bMetadata\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
 	"\aversion\x18\x02 \x01(\tR\aversion\x12\x16\n" +
@@ -270,7 +393,16 @@ const file_resource_definitions_extensions_extensions_proto_rawDesc = "" +
 	"\vdescription\x18\x04 \x01(\tR\vdescription\x12Z\n" +
 	"\rcompatibility\x18\x05 \x01(\v24.talos.resource.definitions.extensions.CompatibilityR\rcompatibility\x12\x1d\n" +
 	"\n" +
-	"extra_info\x18\x06 \x01(\tR\textraInfoB~\n" +
+	"extra_info\x18\x06 \x01(\tR\textraInfo\"C\n" +
+	"\tSignature\x12\x18\n" +
+	"\apayload\x18\x01 \x01(\fR\apayload\x12\x1c\n" +
+	"\tsignature\x18\x02 \x01(\fR\tsignature\"\x8f\x01\n" +
+	"\x06Source\x12\x1b\n" +
+	"\timage_ref\x18\x01 \x01(\tR\bimageRef\x12\x16\n" +
+	"\x06digest\x18\x02 \x01(\tR\x06digest\x12P\n" +
+	"\n" +
+	"signatures\x18\x03 \x03(\v20.talos.resource.definitions.extensions.SignatureR\n" +
+	"signaturesB~\n" +
 	"-dev.talos.api.resource.definitions.extensionsZMgithub.com/siderolabs/talos/pkg/machinery/api/resource/definitions/extensionsb\x06proto3"
 
 var (
@@ -285,22 +417,26 @@ func file_resource_definitions_extensions_extensions_proto_rawDescGZIP() []byte
 	return file_resource_definitions_extensions_extensions_proto_rawDescData
 }
 
-var file_resource_definitions_extensions_extensions_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_resource_definitions_extensions_extensions_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_resource_definitions_extensions_extensions_proto_goTypes = []any{
 	(*Compatibility)(nil), // 0: talos.resource.definitions.extensions.Compatibility
 	(*Constraint)(nil),    // 1: talos.resource.definitions.extensions.Constraint
 	(*Layer)(nil),         // 2: talos.resource.definitions.extensions.Layer
 	(*Metadata)(nil),      // 3: talos.resource.definitions.extensions.Metadata
+	(*Signature)(nil),     // 4: talos.resource.definitions.extensions.Signature
+	(*Source)(nil),        // 5: talos.resource.definitions.extensions.Source
 }
 var file_resource_definitions_extensions_extensions_proto_depIdxs = []int32{
 	1, // 0: talos.resource.definitions.extensions.Compatibility.talos:type_name -> talos.resource.definitions.extensions.Constraint
 	3, // 1: talos.resource.definitions.extensions.Layer.metadata:type_name -> talos.resource.definitions.extensions.Metadata
-	0, // 2: talos.resource.definitions.extensions.Metadata.compatibility:type_name -> talos.resource.definitions.extensions.Compatibility
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	5, // 2: talos.resource.definitions.extensions.Layer.source:type_name -> talos.resource.definitions.extensions.Source
+	0, // 3: talos.resource.definitions.extensions.Metadata.compatibility:type_name -> talos.resource.definitions.extensions.Compatibility
+	4, // 4: talos.resource.definitions.extensions.Source.signatures:type_name -> talos.resource.definitions.extensions.Signature
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_resource_definitions_extensions_extensions_proto_init() }
@@ -314,7 +450,7 @@ func file_resource_definitions_extensions_extensions_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resource_definitions_extensions_extensions_proto_rawDesc), len(file_resource_definitions_extensions_extensions_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
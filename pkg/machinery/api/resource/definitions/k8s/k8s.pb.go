@@ -13,6 +13,7 @@ import (
 
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	structpb "google.golang.org/protobuf/types/known/structpb"
 
 	common "github.com/siderolabs/talos/pkg/machinery/api/common"
@@ -259,10 +260,17 @@ func (x *AdmissionPluginSpec) GetConfiguration() *structpb.Struct {
 
 // AuditPolicyConfigSpec is audit policy configuration for kube-apiserver.
 type AuditPolicyConfigSpec struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Config        *structpb.Struct       `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Config               *structpb.Struct       `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	LogPath              string                 `protobuf:"bytes,2,opt,name=log_path,json=logPath,proto3" json:"log_path,omitempty"`
+	LogMaxAge            int64                  `protobuf:"varint,3,opt,name=log_max_age,json=logMaxAge,proto3" json:"log_max_age,omitempty"`
+	LogMaxBackup         int64                  `protobuf:"varint,4,opt,name=log_max_backup,json=logMaxBackup,proto3" json:"log_max_backup,omitempty"`
+	LogMaxSize           int64                  `protobuf:"varint,5,opt,name=log_max_size,json=logMaxSize,proto3" json:"log_max_size,omitempty"`
+	WebhookUrl           string                 `protobuf:"bytes,6,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`
+	WebhookCaCertificate string                 `protobuf:"bytes,7,opt,name=webhook_ca_certificate,json=webhookCaCertificate,proto3" json:"webhook_ca_certificate,omitempty"`
+	WebhookToken         string                 `protobuf:"bytes,8,opt,name=webhook_token,json=webhookToken,proto3" json:"webhook_token,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *AuditPolicyConfigSpec) Reset() {
@@ -302,6 +310,55 @@ func (x *AuditPolicyConfigSpec) GetConfig() *structpb.Struct {
 	return nil
 }
 
+func (x *AuditPolicyConfigSpec) GetLogPath() string {
+	if x != nil {
+		return x.LogPath
+	}
+	return ""
+}
+
+func (x *AuditPolicyConfigSpec) GetLogMaxAge() int64 {
+	if x != nil {
+		return x.LogMaxAge
+	}
+	return 0
+}
+
+func (x *AuditPolicyConfigSpec) GetLogMaxBackup() int64 {
+	if x != nil {
+		return x.LogMaxBackup
+	}
+	return 0
+}
+
+func (x *AuditPolicyConfigSpec) GetLogMaxSize() int64 {
+	if x != nil {
+		return x.LogMaxSize
+	}
+	return 0
+}
+
+func (x *AuditPolicyConfigSpec) GetWebhookUrl() string {
+	if x != nil {
+		return x.WebhookUrl
+	}
+	return ""
+}
+
+func (x *AuditPolicyConfigSpec) GetWebhookCaCertificate() string {
+	if x != nil {
+		return x.WebhookCaCertificate
+	}
+	return ""
+}
+
+func (x *AuditPolicyConfigSpec) GetWebhookToken() string {
+	if x != nil {
+		return x.WebhookToken
+	}
+	return ""
+}
+
 // AuthorizationAuthorizersSpec is a configuration of authorization authorizers.
 type AuthorizationAuthorizersSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -416,33 +473,116 @@ func (x *AuthorizationConfigSpec) GetConfig() []*AuthorizationAuthorizersSpec {
 	return nil
 }
 
+// BootstrapManifestObjectPolicy is a synchronization policy for the bootstrap manifest objects.
+type BootstrapManifestObjectPolicy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Manifest      string                 `protobuf:"bytes,1,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Namespace     string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Policy        string                 `protobuf:"bytes,5,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BootstrapManifestObjectPolicy) Reset() {
+	*x = BootstrapManifestObjectPolicy{}
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BootstrapManifestObjectPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BootstrapManifestObjectPolicy) ProtoMessage() {}
+
+func (x *BootstrapManifestObjectPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BootstrapManifestObjectPolicy.ProtoReflect.Descriptor instead.
+func (*BootstrapManifestObjectPolicy) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BootstrapManifestObjectPolicy) GetManifest() string {
+	if x != nil {
+		return x.Manifest
+	}
+	return ""
+}
+
+func (x *BootstrapManifestObjectPolicy) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *BootstrapManifestObjectPolicy) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *BootstrapManifestObjectPolicy) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BootstrapManifestObjectPolicy) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
 // BootstrapManifestsConfigSpec is configuration for bootstrap manifests.
 type BootstrapManifestsConfigSpec struct {
-	state                    protoimpl.MessageState `protogen:"open.v1"`
-	Server                   string                 `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
-	ClusterDomain            string                 `protobuf:"bytes,2,opt,name=cluster_domain,json=clusterDomain,proto3" json:"cluster_domain,omitempty"`
-	PodCidRs                 []string               `protobuf:"bytes,3,rep,name=pod_cid_rs,json=podCidRs,proto3" json:"pod_cid_rs,omitempty"`
-	ProxyEnabled             bool                   `protobuf:"varint,4,opt,name=proxy_enabled,json=proxyEnabled,proto3" json:"proxy_enabled,omitempty"`
-	ProxyImage               string                 `protobuf:"bytes,5,opt,name=proxy_image,json=proxyImage,proto3" json:"proxy_image,omitempty"`
-	ProxyArgs                []string               `protobuf:"bytes,6,rep,name=proxy_args,json=proxyArgs,proto3" json:"proxy_args,omitempty"`
-	CoreDnsEnabled           bool                   `protobuf:"varint,7,opt,name=core_dns_enabled,json=coreDnsEnabled,proto3" json:"core_dns_enabled,omitempty"`
-	CoreDnsImage             string                 `protobuf:"bytes,8,opt,name=core_dns_image,json=coreDnsImage,proto3" json:"core_dns_image,omitempty"`
-	DnsServiceIp             string                 `protobuf:"bytes,9,opt,name=dns_service_ip,json=dnsServiceIp,proto3" json:"dns_service_ip,omitempty"`
-	DnsServiceIPv6           string                 `protobuf:"bytes,10,opt,name=dns_service_i_pv6,json=dnsServiceIPv6,proto3" json:"dns_service_i_pv6,omitempty"`
-	FlannelEnabled           bool                   `protobuf:"varint,11,opt,name=flannel_enabled,json=flannelEnabled,proto3" json:"flannel_enabled,omitempty"`
-	FlannelImage             string                 `protobuf:"bytes,12,opt,name=flannel_image,json=flannelImage,proto3" json:"flannel_image,omitempty"`
-	PodSecurityPolicyEnabled bool                   `protobuf:"varint,14,opt,name=pod_security_policy_enabled,json=podSecurityPolicyEnabled,proto3" json:"pod_security_policy_enabled,omitempty"`
-	TalosApiServiceEnabled   bool                   `protobuf:"varint,15,opt,name=talos_api_service_enabled,json=talosApiServiceEnabled,proto3" json:"talos_api_service_enabled,omitempty"`
-	FlannelExtraArgs         []string               `protobuf:"bytes,16,rep,name=flannel_extra_args,json=flannelExtraArgs,proto3" json:"flannel_extra_args,omitempty"`
-	FlannelKubeServiceHost   string                 `protobuf:"bytes,17,opt,name=flannel_kube_service_host,json=flannelKubeServiceHost,proto3" json:"flannel_kube_service_host,omitempty"`
-	FlannelKubeServicePort   string                 `protobuf:"bytes,18,opt,name=flannel_kube_service_port,json=flannelKubeServicePort,proto3" json:"flannel_kube_service_port,omitempty"`
+	state                    protoimpl.MessageState           `protogen:"open.v1"`
+	Server                   string                           `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	ClusterDomain            string                           `protobuf:"bytes,2,opt,name=cluster_domain,json=clusterDomain,proto3" json:"cluster_domain,omitempty"`
+	PodCidRs                 []string                         `protobuf:"bytes,3,rep,name=pod_cid_rs,json=podCidRs,proto3" json:"pod_cid_rs,omitempty"`
+	ProxyEnabled             bool                             `protobuf:"varint,4,opt,name=proxy_enabled,json=proxyEnabled,proto3" json:"proxy_enabled,omitempty"`
+	ProxyImage               string                           `protobuf:"bytes,5,opt,name=proxy_image,json=proxyImage,proto3" json:"proxy_image,omitempty"`
+	ProxyArgs                []string                         `protobuf:"bytes,6,rep,name=proxy_args,json=proxyArgs,proto3" json:"proxy_args,omitempty"`
+	CoreDnsEnabled           bool                             `protobuf:"varint,7,opt,name=core_dns_enabled,json=coreDnsEnabled,proto3" json:"core_dns_enabled,omitempty"`
+	CoreDnsImage             string                           `protobuf:"bytes,8,opt,name=core_dns_image,json=coreDnsImage,proto3" json:"core_dns_image,omitempty"`
+	DnsServiceIp             string                           `protobuf:"bytes,9,opt,name=dns_service_ip,json=dnsServiceIp,proto3" json:"dns_service_ip,omitempty"`
+	DnsServiceIPv6           string                           `protobuf:"bytes,10,opt,name=dns_service_i_pv6,json=dnsServiceIPv6,proto3" json:"dns_service_i_pv6,omitempty"`
+	FlannelEnabled           bool                             `protobuf:"varint,11,opt,name=flannel_enabled,json=flannelEnabled,proto3" json:"flannel_enabled,omitempty"`
+	FlannelImage             string                           `protobuf:"bytes,12,opt,name=flannel_image,json=flannelImage,proto3" json:"flannel_image,omitempty"`
+	PodSecurityPolicyEnabled bool                             `protobuf:"varint,14,opt,name=pod_security_policy_enabled,json=podSecurityPolicyEnabled,proto3" json:"pod_security_policy_enabled,omitempty"`
+	TalosApiServiceEnabled   bool                             `protobuf:"varint,15,opt,name=talos_api_service_enabled,json=talosApiServiceEnabled,proto3" json:"talos_api_service_enabled,omitempty"`
+	FlannelExtraArgs         []string                         `protobuf:"bytes,16,rep,name=flannel_extra_args,json=flannelExtraArgs,proto3" json:"flannel_extra_args,omitempty"`
+	FlannelKubeServiceHost   string                           `protobuf:"bytes,17,opt,name=flannel_kube_service_host,json=flannelKubeServiceHost,proto3" json:"flannel_kube_service_host,omitempty"`
+	FlannelKubeServicePort   string                           `protobuf:"bytes,18,opt,name=flannel_kube_service_port,json=flannelKubeServicePort,proto3" json:"flannel_kube_service_port,omitempty"`
+	ObjectPolicies           []*BootstrapManifestObjectPolicy `protobuf:"bytes,19,rep,name=object_policies,json=objectPolicies,proto3" json:"object_policies,omitempty"`
+	CoreDnsOverridesEnabled  bool                             `protobuf:"varint,20,opt,name=core_dns_overrides_enabled,json=coreDnsOverridesEnabled,proto3" json:"core_dns_overrides_enabled,omitempty"`
+	CoreDnsStubDomains       []*CoreDNSStubDomain             `protobuf:"bytes,21,rep,name=core_dns_stub_domains,json=coreDnsStubDomains,proto3" json:"core_dns_stub_domains,omitempty"`
+	CoreDnsExtraServerBlocks string                           `protobuf:"bytes,22,opt,name=core_dns_extra_server_blocks,json=coreDnsExtraServerBlocks,proto3" json:"core_dns_extra_server_blocks,omitempty"`
+	CoreDnsReplicas          int64                            `protobuf:"varint,23,opt,name=core_dns_replicas,json=coreDnsReplicas,proto3" json:"core_dns_replicas,omitempty"`
+	CoreDnsResources         *Resources                       `protobuf:"bytes,24,opt,name=core_dns_resources,json=coreDnsResources,proto3" json:"core_dns_resources,omitempty"`
 	unknownFields            protoimpl.UnknownFields
 	sizeCache                protoimpl.SizeCache
 }
 
 func (x *BootstrapManifestsConfigSpec) Reset() {
 	*x = BootstrapManifestsConfigSpec{}
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[6]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -454,7 +594,7 @@ func (x *BootstrapManifestsConfigSpec) String() string {
 func (*BootstrapManifestsConfigSpec) ProtoMessage() {}
 
 func (x *BootstrapManifestsConfigSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[6]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -467,7 +607,7 @@ func (x *BootstrapManifestsConfigSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BootstrapManifestsConfigSpec.ProtoReflect.Descriptor instead.
 func (*BootstrapManifestsConfigSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{6}
+	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *BootstrapManifestsConfigSpec) GetServer() string {
@@ -589,6 +729,48 @@ func (x *BootstrapManifestsConfigSpec) GetFlannelKubeServicePort() string {
 	return ""
 }
 
+func (x *BootstrapManifestsConfigSpec) GetObjectPolicies() []*BootstrapManifestObjectPolicy {
+	if x != nil {
+		return x.ObjectPolicies
+	}
+	return nil
+}
+
+func (x *BootstrapManifestsConfigSpec) GetCoreDnsOverridesEnabled() bool {
+	if x != nil {
+		return x.CoreDnsOverridesEnabled
+	}
+	return false
+}
+
+func (x *BootstrapManifestsConfigSpec) GetCoreDnsStubDomains() []*CoreDNSStubDomain {
+	if x != nil {
+		return x.CoreDnsStubDomains
+	}
+	return nil
+}
+
+func (x *BootstrapManifestsConfigSpec) GetCoreDnsExtraServerBlocks() string {
+	if x != nil {
+		return x.CoreDnsExtraServerBlocks
+	}
+	return ""
+}
+
+func (x *BootstrapManifestsConfigSpec) GetCoreDnsReplicas() int64 {
+	if x != nil {
+		return x.CoreDnsReplicas
+	}
+	return 0
+}
+
+func (x *BootstrapManifestsConfigSpec) GetCoreDnsResources() *Resources {
+	if x != nil {
+		return x.CoreDnsResources
+	}
+	return nil
+}
+
 // ConfigStatusSpec describes status of rendered secrets.
 type ConfigStatusSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -600,7 +782,7 @@ type ConfigStatusSpec struct {
 
 func (x *ConfigStatusSpec) Reset() {
 	*x = ConfigStatusSpec{}
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[7]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -612,7 +794,7 @@ func (x *ConfigStatusSpec) String() string {
 func (*ConfigStatusSpec) ProtoMessage() {}
 
 func (x *ConfigStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[7]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -625,7 +807,7 @@ func (x *ConfigStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConfigStatusSpec.ProtoReflect.Descriptor instead.
 func (*ConfigStatusSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{7}
+	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ConfigStatusSpec) GetReady() bool {
@@ -660,7 +842,7 @@ type ControllerManagerConfigSpec struct {
 
 func (x *ControllerManagerConfigSpec) Reset() {
 	*x = ControllerManagerConfigSpec{}
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[8]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -672,7 +854,7 @@ func (x *ControllerManagerConfigSpec) String() string {
 func (*ControllerManagerConfigSpec) ProtoMessage() {}
 
 func (x *ControllerManagerConfigSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[8]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -685,7 +867,7 @@ func (x *ControllerManagerConfigSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ControllerManagerConfigSpec.ProtoReflect.Descriptor instead.
 func (*ControllerManagerConfigSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{8}
+	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ControllerManagerConfigSpec) GetEnabled() bool {
@@ -751,6 +933,59 @@ func (x *ControllerManagerConfigSpec) GetResources() *Resources {
 	return nil
 }
 
+// CoreDNSStubDomain is a CoreDNS stub domain forwarded to the upstream servers.
+type CoreDNSStubDomain struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	Upstreams     []string               `protobuf:"bytes,2,rep,name=upstreams,proto3" json:"upstreams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CoreDNSStubDomain) Reset() {
+	*x = CoreDNSStubDomain{}
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CoreDNSStubDomain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoreDNSStubDomain) ProtoMessage() {}
+
+func (x *CoreDNSStubDomain) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoreDNSStubDomain.ProtoReflect.Descriptor instead.
+func (*CoreDNSStubDomain) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CoreDNSStubDomain) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *CoreDNSStubDomain) GetUpstreams() []string {
+	if x != nil {
+		return x.Upstreams
+	}
+	return nil
+}
+
 // EndpointSpec describes status of rendered secrets.
 type EndpointSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -761,7 +996,7 @@ type EndpointSpec struct {
 
 func (x *EndpointSpec) Reset() {
 	*x = EndpointSpec{}
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[9]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -773,7 +1008,7 @@ func (x *EndpointSpec) String() string {
 func (*EndpointSpec) ProtoMessage() {}
 
 func (x *EndpointSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[9]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -786,7 +1021,7 @@ func (x *EndpointSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EndpointSpec.ProtoReflect.Descriptor instead.
 func (*EndpointSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{9}
+	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *EndpointSpec) GetAddresses() []*common.NetIP {
@@ -810,7 +1045,7 @@ type ExtraManifest struct {
 
 func (x *ExtraManifest) Reset() {
 	*x = ExtraManifest{}
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[10]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -822,7 +1057,7 @@ func (x *ExtraManifest) String() string {
 func (*ExtraManifest) ProtoMessage() {}
 
 func (x *ExtraManifest) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[10]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -835,7 +1070,7 @@ func (x *ExtraManifest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtraManifest.ProtoReflect.Descriptor instead.
 func (*ExtraManifest) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{10}
+	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ExtraManifest) GetName() string {
@@ -883,7 +1118,7 @@ type ExtraManifestsConfigSpec struct {
 
 func (x *ExtraManifestsConfigSpec) Reset() {
 	*x = ExtraManifestsConfigSpec{}
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[11]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -895,7 +1130,7 @@ func (x *ExtraManifestsConfigSpec) String() string {
 func (*ExtraManifestsConfigSpec) ProtoMessage() {}
 
 func (x *ExtraManifestsConfigSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[11]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -908,7 +1143,7 @@ func (x *ExtraManifestsConfigSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtraManifestsConfigSpec.ProtoReflect.Descriptor instead.
 func (*ExtraManifestsConfigSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{11}
+	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ExtraManifestsConfigSpec) GetExtraManifests() []*ExtraManifest {
@@ -931,7 +1166,7 @@ type ExtraVolume struct {
 
 func (x *ExtraVolume) Reset() {
 	*x = ExtraVolume{}
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[12]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -943,7 +1178,7 @@ func (x *ExtraVolume) String() string {
 func (*ExtraVolume) ProtoMessage() {}
 
 func (x *ExtraVolume) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[12]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -956,7 +1191,7 @@ func (x *ExtraVolume) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtraVolume.ProtoReflect.Descriptor instead.
 func (*ExtraVolume) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{12}
+	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ExtraVolume) GetName() string {
@@ -989,17 +1224,19 @@ func (x *ExtraVolume) GetReadOnly() bool {
 
 // KubePrismConfigSpec describes KubePrismConfig data.
 type KubePrismConfigSpec struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Host          string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
-	Port          int64                  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
-	Endpoints     []*KubePrismEndpoint   `protobuf:"bytes,3,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Host            string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Port            int64                  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	Endpoints       []*KubePrismEndpoint   `protobuf:"bytes,3,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	FailoverOnly    bool                   `protobuf:"varint,4,opt,name=failover_only,json=failoverOnly,proto3" json:"failover_only,omitempty"`
+	PrimaryEndpoint *KubePrismEndpoint     `protobuf:"bytes,5,opt,name=primary_endpoint,json=primaryEndpoint,proto3" json:"primary_endpoint,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *KubePrismConfigSpec) Reset() {
 	*x = KubePrismConfigSpec{}
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[13]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1011,7 +1248,7 @@ func (x *KubePrismConfigSpec) String() string {
 func (*KubePrismConfigSpec) ProtoMessage() {}
 
 func (x *KubePrismConfigSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[13]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1024,7 +1261,7 @@ func (x *KubePrismConfigSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KubePrismConfigSpec.ProtoReflect.Descriptor instead.
 func (*KubePrismConfigSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{13}
+	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *KubePrismConfigSpec) GetHost() string {
@@ -1048,6 +1285,20 @@ func (x *KubePrismConfigSpec) GetEndpoints() []*KubePrismEndpoint {
 	return nil
 }
 
+func (x *KubePrismConfigSpec) GetFailoverOnly() bool {
+	if x != nil {
+		return x.FailoverOnly
+	}
+	return false
+}
+
+func (x *KubePrismConfigSpec) GetPrimaryEndpoint() *KubePrismEndpoint {
+	if x != nil {
+		return x.PrimaryEndpoint
+	}
+	return nil
+}
+
 // KubePrismEndpoint holds data for control plane endpoint.
 type KubePrismEndpoint struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1059,7 +1310,7 @@ type KubePrismEndpoint struct {
 
 func (x *KubePrismEndpoint) Reset() {
 	*x = KubePrismEndpoint{}
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[14]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1071,7 +1322,7 @@ func (x *KubePrismEndpoint) String() string {
 func (*KubePrismEndpoint) ProtoMessage() {}
 
 func (x *KubePrismEndpoint) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[14]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1084,7 +1335,7 @@ func (x *KubePrismEndpoint) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KubePrismEndpoint.ProtoReflect.Descriptor instead.
 func (*KubePrismEndpoint) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{14}
+	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *KubePrismEndpoint) GetHost() string {
@@ -1111,7 +1362,7 @@ type KubePrismEndpointsSpec struct {
 
 func (x *KubePrismEndpointsSpec) Reset() {
 	*x = KubePrismEndpointsSpec{}
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[15]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1123,7 +1374,7 @@ func (x *KubePrismEndpointsSpec) String() string {
 func (*KubePrismEndpointsSpec) ProtoMessage() {}
 
 func (x *KubePrismEndpointsSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[15]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1136,7 +1387,7 @@ func (x *KubePrismEndpointsSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KubePrismEndpointsSpec.ProtoReflect.Descriptor instead.
 func (*KubePrismEndpointsSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{15}
+	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *KubePrismEndpointsSpec) GetEndpoints() []*KubePrismEndpoint {
@@ -1148,16 +1399,18 @@ func (x *KubePrismEndpointsSpec) GetEndpoints() []*KubePrismEndpoint {
 
 // KubePrismStatusesSpec describes KubePrismStatuses data.
 type KubePrismStatusesSpec struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Host          string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
-	Healthy       bool                   `protobuf:"varint,2,opt,name=healthy,proto3" json:"healthy,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Host           string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Healthy        bool                   `protobuf:"varint,2,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	ActiveEndpoint string                 `protobuf:"bytes,3,opt,name=active_endpoint,json=activeEndpoint,proto3" json:"active_endpoint,omitempty"`
+	FailedOver     bool                   `protobuf:"varint,4,opt,name=failed_over,json=failedOver,proto3" json:"failed_over,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *KubePrismStatusesSpec) Reset() {
 	*x = KubePrismStatusesSpec{}
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[16]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1169,7 +1422,7 @@ func (x *KubePrismStatusesSpec) String() string {
 func (*KubePrismStatusesSpec) ProtoMessage() {}
 
 func (x *KubePrismStatusesSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[16]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1182,7 +1435,7 @@ func (x *KubePrismStatusesSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KubePrismStatusesSpec.ProtoReflect.Descriptor instead.
 func (*KubePrismStatusesSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{16}
+	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *KubePrismStatusesSpec) GetHost() string {
@@ -1199,30 +1452,46 @@ func (x *KubePrismStatusesSpec) GetHealthy() bool {
 	return false
 }
 
+func (x *KubePrismStatusesSpec) GetActiveEndpoint() string {
+	if x != nil {
+		return x.ActiveEndpoint
+	}
+	return ""
+}
+
+func (x *KubePrismStatusesSpec) GetFailedOver() bool {
+	if x != nil {
+		return x.FailedOver
+	}
+	return false
+}
+
 // KubeletConfigSpec holds the source of kubelet configuration.
 type KubeletConfigSpec struct {
-	state                         protoimpl.MessageState `protogen:"open.v1"`
-	Image                         string                 `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
-	ClusterDns                    []string               `protobuf:"bytes,2,rep,name=cluster_dns,json=clusterDns,proto3" json:"cluster_dns,omitempty"`
-	ClusterDomain                 string                 `protobuf:"bytes,3,opt,name=cluster_domain,json=clusterDomain,proto3" json:"cluster_domain,omitempty"`
-	ExtraArgs                     map[string]string      `protobuf:"bytes,4,rep,name=extra_args,json=extraArgs,proto3" json:"extra_args,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	ExtraMounts                   []*proto.Mount         `protobuf:"bytes,5,rep,name=extra_mounts,json=extraMounts,proto3" json:"extra_mounts,omitempty"`
-	ExtraConfig                   *structpb.Struct       `protobuf:"bytes,6,opt,name=extra_config,json=extraConfig,proto3" json:"extra_config,omitempty"`
-	CloudProviderExternal         bool                   `protobuf:"varint,7,opt,name=cloud_provider_external,json=cloudProviderExternal,proto3" json:"cloud_provider_external,omitempty"`
-	DefaultRuntimeSeccompEnabled  bool                   `protobuf:"varint,8,opt,name=default_runtime_seccomp_enabled,json=defaultRuntimeSeccompEnabled,proto3" json:"default_runtime_seccomp_enabled,omitempty"`
-	SkipNodeRegistration          bool                   `protobuf:"varint,9,opt,name=skip_node_registration,json=skipNodeRegistration,proto3" json:"skip_node_registration,omitempty"`
-	StaticPodListUrl              string                 `protobuf:"bytes,10,opt,name=static_pod_list_url,json=staticPodListUrl,proto3" json:"static_pod_list_url,omitempty"`
-	DisableManifestsDirectory     bool                   `protobuf:"varint,11,opt,name=disable_manifests_directory,json=disableManifestsDirectory,proto3" json:"disable_manifests_directory,omitempty"`
-	EnableFsQuotaMonitoring       bool                   `protobuf:"varint,12,opt,name=enable_fs_quota_monitoring,json=enableFsQuotaMonitoring,proto3" json:"enable_fs_quota_monitoring,omitempty"`
-	CredentialProviderConfig      *structpb.Struct       `protobuf:"bytes,13,opt,name=credential_provider_config,json=credentialProviderConfig,proto3" json:"credential_provider_config,omitempty"`
-	AllowSchedulingOnControlPlane bool                   `protobuf:"varint,14,opt,name=allow_scheduling_on_control_plane,json=allowSchedulingOnControlPlane,proto3" json:"allow_scheduling_on_control_plane,omitempty"`
-	unknownFields                 protoimpl.UnknownFields
-	sizeCache                     protoimpl.SizeCache
+	state                           protoimpl.MessageState `protogen:"open.v1"`
+	Image                           string                 `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	ClusterDns                      []string               `protobuf:"bytes,2,rep,name=cluster_dns,json=clusterDns,proto3" json:"cluster_dns,omitempty"`
+	ClusterDomain                   string                 `protobuf:"bytes,3,opt,name=cluster_domain,json=clusterDomain,proto3" json:"cluster_domain,omitempty"`
+	ExtraArgs                       map[string]string      `protobuf:"bytes,4,rep,name=extra_args,json=extraArgs,proto3" json:"extra_args,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ExtraMounts                     []*proto.Mount         `protobuf:"bytes,5,rep,name=extra_mounts,json=extraMounts,proto3" json:"extra_mounts,omitempty"`
+	ExtraConfig                     *structpb.Struct       `protobuf:"bytes,6,opt,name=extra_config,json=extraConfig,proto3" json:"extra_config,omitempty"`
+	CloudProviderExternal           bool                   `protobuf:"varint,7,opt,name=cloud_provider_external,json=cloudProviderExternal,proto3" json:"cloud_provider_external,omitempty"`
+	DefaultRuntimeSeccompEnabled    bool                   `protobuf:"varint,8,opt,name=default_runtime_seccomp_enabled,json=defaultRuntimeSeccompEnabled,proto3" json:"default_runtime_seccomp_enabled,omitempty"`
+	SkipNodeRegistration            bool                   `protobuf:"varint,9,opt,name=skip_node_registration,json=skipNodeRegistration,proto3" json:"skip_node_registration,omitempty"`
+	StaticPodListUrl                string                 `protobuf:"bytes,10,opt,name=static_pod_list_url,json=staticPodListUrl,proto3" json:"static_pod_list_url,omitempty"`
+	DisableManifestsDirectory       bool                   `protobuf:"varint,11,opt,name=disable_manifests_directory,json=disableManifestsDirectory,proto3" json:"disable_manifests_directory,omitempty"`
+	EnableFsQuotaMonitoring         bool                   `protobuf:"varint,12,opt,name=enable_fs_quota_monitoring,json=enableFsQuotaMonitoring,proto3" json:"enable_fs_quota_monitoring,omitempty"`
+	CredentialProviderConfig        *structpb.Struct       `protobuf:"bytes,13,opt,name=credential_provider_config,json=credentialProviderConfig,proto3" json:"credential_provider_config,omitempty"`
+	AllowSchedulingOnControlPlane   bool                   `protobuf:"varint,14,opt,name=allow_scheduling_on_control_plane,json=allowSchedulingOnControlPlane,proto3" json:"allow_scheduling_on_control_plane,omitempty"`
+	ShutdownGracePeriod             *durationpb.Duration   `protobuf:"bytes,15,opt,name=shutdown_grace_period,json=shutdownGracePeriod,proto3" json:"shutdown_grace_period,omitempty"`
+	ShutdownGracePeriodCriticalPods *durationpb.Duration   `protobuf:"bytes,16,opt,name=shutdown_grace_period_critical_pods,json=shutdownGracePeriodCriticalPods,proto3" json:"shutdown_grace_period_critical_pods,omitempty"`
+	unknownFields                   protoimpl.UnknownFields
+	sizeCache                       protoimpl.SizeCache
 }
 
 func (x *KubeletConfigSpec) Reset() {
 	*x = KubeletConfigSpec{}
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[17]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1234,7 +1503,7 @@ func (x *KubeletConfigSpec) String() string {
 func (*KubeletConfigSpec) ProtoMessage() {}
 
 func (x *KubeletConfigSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[17]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1247,7 +1516,7 @@ func (x *KubeletConfigSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KubeletConfigSpec.ProtoReflect.Descriptor instead.
 func (*KubeletConfigSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{17}
+	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *KubeletConfigSpec) GetImage() string {
@@ -1348,6 +1617,20 @@ func (x *KubeletConfigSpec) GetAllowSchedulingOnControlPlane() bool {
 	return false
 }
 
+func (x *KubeletConfigSpec) GetShutdownGracePeriod() *durationpb.Duration {
+	if x != nil {
+		return x.ShutdownGracePeriod
+	}
+	return nil
+}
+
+func (x *KubeletConfigSpec) GetShutdownGracePeriodCriticalPods() *durationpb.Duration {
+	if x != nil {
+		return x.ShutdownGracePeriodCriticalPods
+	}
+	return nil
+}
+
 // KubeletSpecSpec holds the source of kubelet configuration.
 type KubeletSpecSpec struct {
 	state                    protoimpl.MessageState `protogen:"open.v1"`
@@ -1363,7 +1646,7 @@ type KubeletSpecSpec struct {
 
 func (x *KubeletSpecSpec) Reset() {
 	*x = KubeletSpecSpec{}
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[18]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1375,7 +1658,7 @@ func (x *KubeletSpecSpec) String() string {
 func (*KubeletSpecSpec) ProtoMessage() {}
 
 func (x *KubeletSpecSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[18]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1388,7 +1671,7 @@ func (x *KubeletSpecSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KubeletSpecSpec.ProtoReflect.Descriptor instead.
 func (*KubeletSpecSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{18}
+	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *KubeletSpecSpec) GetImage() string {
@@ -1433,6 +1716,59 @@ func (x *KubeletSpecSpec) GetCredentialProviderConfig() *structpb.Struct {
 	return nil
 }
 
+// KubernetesCertSANStatusSpec describes the SANs of the active kube-apiserver serving certificate.
+type KubernetesCertSANStatusSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DnsNames      []string               `protobuf:"bytes,1,rep,name=dns_names,json=dnsNames,proto3" json:"dns_names,omitempty"`
+	IPs           []*common.NetIP        `protobuf:"bytes,2,rep,name=i_ps,json=iPs,proto3" json:"i_ps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KubernetesCertSANStatusSpec) Reset() {
+	*x = KubernetesCertSANStatusSpec{}
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KubernetesCertSANStatusSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KubernetesCertSANStatusSpec) ProtoMessage() {}
+
+func (x *KubernetesCertSANStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KubernetesCertSANStatusSpec.ProtoReflect.Descriptor instead.
+func (*KubernetesCertSANStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *KubernetesCertSANStatusSpec) GetDnsNames() []string {
+	if x != nil {
+		return x.DnsNames
+	}
+	return nil
+}
+
+func (x *KubernetesCertSANStatusSpec) GetIPs() []*common.NetIP {
+	if x != nil {
+		return x.IPs
+	}
+	return nil
+}
+
 // ManifestSpec holds the Kubernetes resources spec.
 type ManifestSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1443,7 +1779,7 @@ type ManifestSpec struct {
 
 func (x *ManifestSpec) Reset() {
 	*x = ManifestSpec{}
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[19]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1455,7 +1791,7 @@ func (x *ManifestSpec) String() string {
 func (*ManifestSpec) ProtoMessage() {}
 
 func (x *ManifestSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[19]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1468,7 +1804,7 @@ func (x *ManifestSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ManifestSpec.ProtoReflect.Descriptor instead.
 func (*ManifestSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{19}
+	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ManifestSpec) GetItems() []*SingleManifest {
@@ -1488,7 +1824,7 @@ type ManifestStatusSpec struct {
 
 func (x *ManifestStatusSpec) Reset() {
 	*x = ManifestStatusSpec{}
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[20]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1500,7 +1836,7 @@ func (x *ManifestStatusSpec) String() string {
 func (*ManifestStatusSpec) ProtoMessage() {}
 
 func (x *ManifestStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[20]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1513,7 +1849,7 @@ func (x *ManifestStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ManifestStatusSpec.ProtoReflect.Descriptor instead.
 func (*ManifestStatusSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{20}
+	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ManifestStatusSpec) GetManifestsApplied() []string {
@@ -1534,7 +1870,7 @@ type NodeAnnotationSpecSpec struct {
 
 func (x *NodeAnnotationSpecSpec) Reset() {
 	*x = NodeAnnotationSpecSpec{}
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[21]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1546,7 +1882,7 @@ func (x *NodeAnnotationSpecSpec) String() string {
 func (*NodeAnnotationSpecSpec) ProtoMessage() {}
 
 func (x *NodeAnnotationSpecSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[21]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1559,7 +1895,7 @@ func (x *NodeAnnotationSpecSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeAnnotationSpecSpec.ProtoReflect.Descriptor instead.
 func (*NodeAnnotationSpecSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{21}
+	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *NodeAnnotationSpecSpec) GetKey() string {
@@ -1587,7 +1923,7 @@ type NodeIPConfigSpec struct {
 
 func (x *NodeIPConfigSpec) Reset() {
 	*x = NodeIPConfigSpec{}
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[22]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1599,7 +1935,7 @@ func (x *NodeIPConfigSpec) String() string {
 func (*NodeIPConfigSpec) ProtoMessage() {}
 
 func (x *NodeIPConfigSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[22]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1612,7 +1948,7 @@ func (x *NodeIPConfigSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeIPConfigSpec.ProtoReflect.Descriptor instead.
 func (*NodeIPConfigSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{22}
+	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *NodeIPConfigSpec) GetValidSubnets() []string {
@@ -1639,7 +1975,7 @@ type NodeIPSpec struct {
 
 func (x *NodeIPSpec) Reset() {
 	*x = NodeIPSpec{}
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[23]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1651,7 +1987,7 @@ func (x *NodeIPSpec) String() string {
 func (*NodeIPSpec) ProtoMessage() {}
 
 func (x *NodeIPSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[23]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1664,7 +2000,7 @@ func (x *NodeIPSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeIPSpec.ProtoReflect.Descriptor instead.
 func (*NodeIPSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{23}
+	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *NodeIPSpec) GetAddresses() []*common.NetIP {
@@ -1685,7 +2021,7 @@ type NodeLabelSpecSpec struct {
 
 func (x *NodeLabelSpecSpec) Reset() {
 	*x = NodeLabelSpecSpec{}
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[24]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1697,7 +2033,7 @@ func (x *NodeLabelSpecSpec) String() string {
 func (*NodeLabelSpecSpec) ProtoMessage() {}
 
 func (x *NodeLabelSpecSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[24]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1710,7 +2046,7 @@ func (x *NodeLabelSpecSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeLabelSpecSpec.ProtoReflect.Descriptor instead.
 func (*NodeLabelSpecSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{24}
+	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *NodeLabelSpecSpec) GetKey() string {
@@ -1741,7 +2077,7 @@ type NodeStatusSpec struct {
 
 func (x *NodeStatusSpec) Reset() {
 	*x = NodeStatusSpec{}
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[25]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1753,7 +2089,7 @@ func (x *NodeStatusSpec) String() string {
 func (*NodeStatusSpec) ProtoMessage() {}
 
 func (x *NodeStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[25]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1766,7 +2102,7 @@ func (x *NodeStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeStatusSpec.ProtoReflect.Descriptor instead.
 func (*NodeStatusSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{25}
+	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *NodeStatusSpec) GetNodename() string {
@@ -1816,7 +2152,7 @@ type NodeTaintSpecSpec struct {
 
 func (x *NodeTaintSpecSpec) Reset() {
 	*x = NodeTaintSpecSpec{}
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[26]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1828,7 +2164,7 @@ func (x *NodeTaintSpecSpec) String() string {
 func (*NodeTaintSpecSpec) ProtoMessage() {}
 
 func (x *NodeTaintSpecSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[26]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1841,7 +2177,7 @@ func (x *NodeTaintSpecSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeTaintSpecSpec.ProtoReflect.Descriptor instead.
 func (*NodeTaintSpecSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{26}
+	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *NodeTaintSpecSpec) GetKey() string {
@@ -1877,7 +2213,7 @@ type NodenameSpec struct {
 
 func (x *NodenameSpec) Reset() {
 	*x = NodenameSpec{}
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[27]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1889,7 +2225,7 @@ func (x *NodenameSpec) String() string {
 func (*NodenameSpec) ProtoMessage() {}
 
 func (x *NodenameSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[27]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1902,7 +2238,7 @@ func (x *NodenameSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodenameSpec.ProtoReflect.Descriptor instead.
 func (*NodenameSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{27}
+	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *NodenameSpec) GetNodename() string {
@@ -1937,7 +2273,7 @@ type Resources struct {
 
 func (x *Resources) Reset() {
 	*x = Resources{}
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[28]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1949,7 +2285,7 @@ func (x *Resources) String() string {
 func (*Resources) ProtoMessage() {}
 
 func (x *Resources) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[28]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1962,7 +2298,7 @@ func (x *Resources) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Resources.ProtoReflect.Descriptor instead.
 func (*Resources) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{28}
+	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *Resources) GetRequests() map[string]string {
@@ -1995,7 +2331,7 @@ type SchedulerConfigSpec struct {
 
 func (x *SchedulerConfigSpec) Reset() {
 	*x = SchedulerConfigSpec{}
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[29]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2007,7 +2343,7 @@ func (x *SchedulerConfigSpec) String() string {
 func (*SchedulerConfigSpec) ProtoMessage() {}
 
 func (x *SchedulerConfigSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[29]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2020,7 +2356,7 @@ func (x *SchedulerConfigSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SchedulerConfigSpec.ProtoReflect.Descriptor instead.
 func (*SchedulerConfigSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{29}
+	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *SchedulerConfigSpec) GetEnabled() bool {
@@ -2083,7 +2419,7 @@ type SecretsStatusSpec struct {
 
 func (x *SecretsStatusSpec) Reset() {
 	*x = SecretsStatusSpec{}
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[30]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2095,7 +2431,7 @@ func (x *SecretsStatusSpec) String() string {
 func (*SecretsStatusSpec) ProtoMessage() {}
 
 func (x *SecretsStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[30]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2108,7 +2444,7 @@ func (x *SecretsStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SecretsStatusSpec.ProtoReflect.Descriptor instead.
 func (*SecretsStatusSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{30}
+	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *SecretsStatusSpec) GetReady() bool {
@@ -2135,7 +2471,7 @@ type SingleManifest struct {
 
 func (x *SingleManifest) Reset() {
 	*x = SingleManifest{}
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[31]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2147,7 +2483,7 @@ func (x *SingleManifest) String() string {
 func (*SingleManifest) ProtoMessage() {}
 
 func (x *SingleManifest) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[31]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2160,7 +2496,7 @@ func (x *SingleManifest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SingleManifest.ProtoReflect.Descriptor instead.
 func (*SingleManifest) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{31}
+	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *SingleManifest) GetObject() *structpb.Struct {
@@ -2180,7 +2516,7 @@ type StaticPodServerStatusSpec struct {
 
 func (x *StaticPodServerStatusSpec) Reset() {
 	*x = StaticPodServerStatusSpec{}
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[32]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2192,7 +2528,7 @@ func (x *StaticPodServerStatusSpec) String() string {
 func (*StaticPodServerStatusSpec) ProtoMessage() {}
 
 func (x *StaticPodServerStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[32]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2205,7 +2541,7 @@ func (x *StaticPodServerStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StaticPodServerStatusSpec.ProtoReflect.Descriptor instead.
 func (*StaticPodServerStatusSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{32}
+	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *StaticPodServerStatusSpec) GetUrl() string {
@@ -2225,7 +2561,7 @@ type StaticPodSpec struct {
 
 func (x *StaticPodSpec) Reset() {
 	*x = StaticPodSpec{}
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[33]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2237,7 +2573,7 @@ func (x *StaticPodSpec) String() string {
 func (*StaticPodSpec) ProtoMessage() {}
 
 func (x *StaticPodSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[33]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2250,7 +2586,7 @@ func (x *StaticPodSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StaticPodSpec.ProtoReflect.Descriptor instead.
 func (*StaticPodSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{33}
+	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *StaticPodSpec) GetPod() *structpb.Struct {
@@ -2260,6 +2596,123 @@ func (x *StaticPodSpec) GetPod() *structpb.Struct {
 	return nil
 }
 
+// StaticPodRuntimeStatusSpec describes the container runtime state of the static pod.
+type StaticPodRuntimeStatusSpec struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	PodName          string                 `protobuf:"bytes,1,opt,name=pod_name,json=podName,proto3" json:"pod_name,omitempty"`
+	Phase            string                 `protobuf:"bytes,2,opt,name=phase,proto3" json:"phase,omitempty"`
+	Ready            bool                   `protobuf:"varint,3,opt,name=ready,proto3" json:"ready,omitempty"`
+	RestartCount     int64                  `protobuf:"varint,4,opt,name=restart_count,json=restartCount,proto3" json:"restart_count,omitempty"`
+	RestartStorm     bool                   `protobuf:"varint,5,opt,name=restart_storm,json=restartStorm,proto3" json:"restart_storm,omitempty"`
+	LastExitCode     int64                  `protobuf:"varint,6,opt,name=last_exit_code,json=lastExitCode,proto3" json:"last_exit_code,omitempty"`
+	LastExitReason   string                 `protobuf:"bytes,7,opt,name=last_exit_reason,json=lastExitReason,proto3" json:"last_exit_reason,omitempty"`
+	LastProbeFailure string                 `protobuf:"bytes,8,opt,name=last_probe_failure,json=lastProbeFailure,proto3" json:"last_probe_failure,omitempty"`
+	Image            string                 `protobuf:"bytes,9,opt,name=image,proto3" json:"image,omitempty"`
+	ImageRef         string                 `protobuf:"bytes,10,opt,name=image_ref,json=imageRef,proto3" json:"image_ref,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *StaticPodRuntimeStatusSpec) Reset() {
+	*x = StaticPodRuntimeStatusSpec{}
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StaticPodRuntimeStatusSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaticPodRuntimeStatusSpec) ProtoMessage() {}
+
+func (x *StaticPodRuntimeStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaticPodRuntimeStatusSpec.ProtoReflect.Descriptor instead.
+func (*StaticPodRuntimeStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *StaticPodRuntimeStatusSpec) GetPodName() string {
+	if x != nil {
+		return x.PodName
+	}
+	return ""
+}
+
+func (x *StaticPodRuntimeStatusSpec) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *StaticPodRuntimeStatusSpec) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *StaticPodRuntimeStatusSpec) GetRestartCount() int64 {
+	if x != nil {
+		return x.RestartCount
+	}
+	return 0
+}
+
+func (x *StaticPodRuntimeStatusSpec) GetRestartStorm() bool {
+	if x != nil {
+		return x.RestartStorm
+	}
+	return false
+}
+
+func (x *StaticPodRuntimeStatusSpec) GetLastExitCode() int64 {
+	if x != nil {
+		return x.LastExitCode
+	}
+	return 0
+}
+
+func (x *StaticPodRuntimeStatusSpec) GetLastExitReason() string {
+	if x != nil {
+		return x.LastExitReason
+	}
+	return ""
+}
+
+func (x *StaticPodRuntimeStatusSpec) GetLastProbeFailure() string {
+	if x != nil {
+		return x.LastProbeFailure
+	}
+	return ""
+}
+
+func (x *StaticPodRuntimeStatusSpec) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *StaticPodRuntimeStatusSpec) GetImageRef() string {
+	if x != nil {
+		return x.ImageRef
+	}
+	return ""
+}
+
 // StaticPodStatusSpec describes kubelet static pod status.
 type StaticPodStatusSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2270,7 +2723,7 @@ type StaticPodStatusSpec struct {
 
 func (x *StaticPodStatusSpec) Reset() {
 	*x = StaticPodStatusSpec{}
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[34]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2282,7 +2735,7 @@ func (x *StaticPodStatusSpec) String() string {
 func (*StaticPodStatusSpec) ProtoMessage() {}
 
 func (x *StaticPodStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[34]
+	mi := &file_resource_definitions_k8s_k8s_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2295,7 +2748,7 @@ func (x *StaticPodStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StaticPodStatusSpec.ProtoReflect.Descriptor instead.
 func (*StaticPodStatusSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{34}
+	return file_resource_definitions_k8s_k8s_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *StaticPodStatusSpec) GetPodStatus() *structpb.Struct {
@@ -2309,7 +2762,7 @@ var File_resource_definitions_k8s_k8s_proto protoreflect.FileDescriptor
 
 const file_resource_definitions_k8s_k8s_proto_rawDesc = "" +
 	"\n" +
-	"\"resource/definitions/k8s/k8s.proto\x12\x1etalos.resource.definitions.k8s\x1a\x13common/common.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a&resource/definitions/proto/proto.proto\"\xe8\x06\n" +
+	"\"resource/definitions/k8s/k8s.proto\x12\x1etalos.resource.definitions.k8s\x1a\x13common/common.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a&resource/definitions/proto/proto.proto\"\xe8\x06\n" +
 	"\x13APIServerConfigSpec\x12\x14\n" +
 	"\x05image\x18\x01 \x01(\tR\x05image\x12%\n" +
 	"\x0ecloud_provider\x18\x02 \x01(\tR\rcloudProvider\x124\n" +
@@ -2336,16 +2789,31 @@ const file_resource_definitions_k8s_k8s_proto_rawDesc = "" +
 	"\x06config\x18\x01 \x03(\v23.talos.resource.definitions.k8s.AdmissionPluginSpecR\x06config\"h\n" +
 	"\x13AdmissionPluginSpec\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12=\n" +
-	"\rconfiguration\x18\x02 \x01(\v2\x17.google.protobuf.StructR\rconfiguration\"H\n" +
+	"\rconfiguration\x18\x02 \x01(\v2\x17.google.protobuf.StructR\rconfiguration\"\xc7\x02\n" +
 	"\x15AuditPolicyConfigSpec\x12/\n" +
-	"\x06config\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x06config\"y\n" +
+	"\x06config\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x06config\x12\x19\n" +
+	"\blog_path\x18\x02 \x01(\tR\alogPath\x12\x1e\n" +
+	"\vlog_max_age\x18\x03 \x01(\x03R\tlogMaxAge\x12$\n" +
+	"\x0elog_max_backup\x18\x04 \x01(\x03R\flogMaxBackup\x12 \n" +
+	"\flog_max_size\x18\x05 \x01(\x03R\n" +
+	"logMaxSize\x12\x1f\n" +
+	"\vwebhook_url\x18\x06 \x01(\tR\n" +
+	"webhookUrl\x124\n" +
+	"\x16webhook_ca_certificate\x18\a \x01(\tR\x14webhookCaCertificate\x12#\n" +
+	"\rwebhook_token\x18\b \x01(\tR\fwebhookToken\"y\n" +
 	"\x1cAuthorizationAuthorizersSpec\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x121\n" +
 	"\awebhook\x18\x03 \x01(\v2\x17.google.protobuf.StructR\awebhook\"\x85\x01\n" +
 	"\x17AuthorizationConfigSpec\x12\x14\n" +
 	"\x05image\x18\x01 \x01(\tR\x05image\x12T\n" +
-	"\x06config\x18\x02 \x03(\v2<.talos.resource.definitions.k8s.AuthorizationAuthorizersSpecR\x06config\"\xed\x05\n" +
+	"\x06config\x18\x02 \x03(\v2<.talos.resource.definitions.k8s.AuthorizationAuthorizersSpecR\x06config\"\x99\x01\n" +
+	"\x1dBootstrapManifestObjectPolicy\x12\x1a\n" +
+	"\bmanifest\x18\x01 \x01(\tR\bmanifest\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x16\n" +
+	"\x06policy\x18\x05 \x01(\tR\x06policy\"\xbd\t\n" +
 	"\x1cBootstrapManifestsConfigSpec\x12\x16\n" +
 	"\x06server\x18\x01 \x01(\tR\x06server\x12%\n" +
 	"\x0ecluster_domain\x18\x02 \x01(\tR\rclusterDomain\x12\x1c\n" +
@@ -2367,7 +2835,13 @@ const file_resource_definitions_k8s_k8s_proto_rawDesc = "" +
 	"\x19talos_api_service_enabled\x18\x0f \x01(\bR\x16talosApiServiceEnabled\x12,\n" +
 	"\x12flannel_extra_args\x18\x10 \x03(\tR\x10flannelExtraArgs\x129\n" +
 	"\x19flannel_kube_service_host\x18\x11 \x01(\tR\x16flannelKubeServiceHost\x129\n" +
-	"\x19flannel_kube_service_port\x18\x12 \x01(\tR\x16flannelKubeServicePort\"B\n" +
+	"\x19flannel_kube_service_port\x18\x12 \x01(\tR\x16flannelKubeServicePort\x12f\n" +
+	"\x0fobject_policies\x18\x13 \x03(\v2=.talos.resource.definitions.k8s.BootstrapManifestObjectPolicyR\x0eobjectPolicies\x12;\n" +
+	"\x1acore_dns_overrides_enabled\x18\x14 \x01(\bR\x17coreDnsOverridesEnabled\x12d\n" +
+	"\x15core_dns_stub_domains\x18\x15 \x03(\v21.talos.resource.definitions.k8s.CoreDNSStubDomainR\x12coreDnsStubDomains\x12>\n" +
+	"\x1ccore_dns_extra_server_blocks\x18\x16 \x01(\tR\x18coreDnsExtraServerBlocks\x12*\n" +
+	"\x11core_dns_replicas\x18\x17 \x01(\x03R\x0fcoreDnsReplicas\x12W\n" +
+	"\x12core_dns_resources\x18\x18 \x01(\v2).talos.resource.definitions.k8s.ResourcesR\x10coreDnsResources\"B\n" +
 	"\x10ConfigStatusSpec\x12\x14\n" +
 	"\x05ready\x18\x01 \x01(\bR\x05ready\x12\x18\n" +
 	"\aversion\x18\x02 \x01(\tR\aversion\"\xd2\x05\n" +
@@ -2388,7 +2862,10 @@ const file_resource_definitions_k8s_k8s_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aG\n" +
 	"\x19EnvironmentVariablesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\";\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"I\n" +
+	"\x11CoreDNSStubDomain\x12\x16\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\x12\x1c\n" +
+	"\tupstreams\x18\x02 \x03(\tR\tupstreams\";\n" +
 	"\fEndpointSpec\x12+\n" +
 	"\taddresses\x18\x01 \x03(\v2\r.common.NetIPR\taddresses\"\xa1\x02\n" +
 	"\rExtraManifest\x12\x12\n" +
@@ -2407,19 +2884,24 @@ const file_resource_definitions_k8s_k8s_proto_rawDesc = "" +
 	"\thost_path\x18\x02 \x01(\tR\bhostPath\x12\x1d\n" +
 	"\n" +
 	"mount_path\x18\x03 \x01(\tR\tmountPath\x12\x1b\n" +
-	"\tread_only\x18\x04 \x01(\bR\breadOnly\"\x8e\x01\n" +
+	"\tread_only\x18\x04 \x01(\bR\breadOnly\"\x91\x02\n" +
 	"\x13KubePrismConfigSpec\x12\x12\n" +
 	"\x04host\x18\x01 \x01(\tR\x04host\x12\x12\n" +
 	"\x04port\x18\x02 \x01(\x03R\x04port\x12O\n" +
-	"\tendpoints\x18\x03 \x03(\v21.talos.resource.definitions.k8s.KubePrismEndpointR\tendpoints\";\n" +
+	"\tendpoints\x18\x03 \x03(\v21.talos.resource.definitions.k8s.KubePrismEndpointR\tendpoints\x12#\n" +
+	"\rfailover_only\x18\x04 \x01(\bR\ffailoverOnly\x12\\\n" +
+	"\x10primary_endpoint\x18\x05 \x01(\v21.talos.resource.definitions.k8s.KubePrismEndpointR\x0fprimaryEndpoint\";\n" +
 	"\x11KubePrismEndpoint\x12\x12\n" +
 	"\x04host\x18\x01 \x01(\tR\x04host\x12\x12\n" +
 	"\x04port\x18\x02 \x01(\rR\x04port\"i\n" +
 	"\x16KubePrismEndpointsSpec\x12O\n" +
-	"\tendpoints\x18\x01 \x03(\v21.talos.resource.definitions.k8s.KubePrismEndpointR\tendpoints\"E\n" +
+	"\tendpoints\x18\x01 \x03(\v21.talos.resource.definitions.k8s.KubePrismEndpointR\tendpoints\"\x8f\x01\n" +
 	"\x15KubePrismStatusesSpec\x12\x12\n" +
 	"\x04host\x18\x01 \x01(\tR\x04host\x12\x18\n" +
-	"\ahealthy\x18\x02 \x01(\bR\ahealthy\"\x9a\a\n" +
+	"\ahealthy\x18\x02 \x01(\bR\ahealthy\x12'\n" +
+	"\x0factive_endpoint\x18\x03 \x01(\tR\x0eactiveEndpoint\x12\x1f\n" +
+	"\vfailed_over\x18\x04 \x01(\bR\n" +
+	"failedOver\"\xd2\b\n" +
 	"\x11KubeletConfigSpec\x12\x14\n" +
 	"\x05image\x18\x01 \x01(\tR\x05image\x12\x1f\n" +
 	"\vcluster_dns\x18\x02 \x03(\tR\n" +
@@ -2437,7 +2919,9 @@ const file_resource_definitions_k8s_k8s_proto_rawDesc = "" +
 	"\x1bdisable_manifests_directory\x18\v \x01(\bR\x19disableManifestsDirectory\x12;\n" +
 	"\x1aenable_fs_quota_monitoring\x18\f \x01(\bR\x17enableFsQuotaMonitoring\x12U\n" +
 	"\x1acredential_provider_config\x18\r \x01(\v2\x17.google.protobuf.StructR\x18credentialProviderConfig\x12H\n" +
-	"!allow_scheduling_on_control_plane\x18\x0e \x01(\bR\x1dallowSchedulingOnControlPlane\x1a<\n" +
+	"!allow_scheduling_on_control_plane\x18\x0e \x01(\bR\x1dallowSchedulingOnControlPlane\x12M\n" +
+	"\x15shutdown_grace_period\x18\x0f \x01(\v2\x19.google.protobuf.DurationR\x13shutdownGracePeriod\x12g\n" +
+	"#shutdown_grace_period_critical_pods\x18\x10 \x01(\v2\x19.google.protobuf.DurationR\x1fshutdownGracePeriodCriticalPods\x1a<\n" +
 	"\x0eExtraArgsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xbc\x02\n" +
@@ -2447,7 +2931,10 @@ const file_resource_definitions_k8s_k8s_proto_rawDesc = "" +
 	"\fextra_mounts\x18\x03 \x03(\v2'.talos.resource.definitions.proto.MountR\vextraMounts\x12+\n" +
 	"\x11expected_nodename\x18\x04 \x01(\tR\x10expectedNodename\x12/\n" +
 	"\x06config\x18\x05 \x01(\v2\x17.google.protobuf.StructR\x06config\x12U\n" +
-	"\x1acredential_provider_config\x18\x06 \x01(\v2\x17.google.protobuf.StructR\x18credentialProviderConfig\"T\n" +
+	"\x1acredential_provider_config\x18\x06 \x01(\v2\x17.google.protobuf.StructR\x18credentialProviderConfig\"\\\n" +
+	"\x1bKubernetesCertSANStatusSpec\x12\x1b\n" +
+	"\tdns_names\x18\x01 \x03(\tR\bdnsNames\x12 \n" +
+	"\x04i_ps\x18\x02 \x03(\v2\r.common.NetIPR\x03iPs\"T\n" +
 	"\fManifestSpec\x12D\n" +
 	"\x05items\x18\x01 \x03(\v2..talos.resource.definitions.k8s.SingleManifestR\x05items\"A\n" +
 	"\x12ManifestStatusSpec\x12+\n" +
@@ -2517,7 +3004,19 @@ const file_resource_definitions_k8s_k8s_proto_rawDesc = "" +
 	"\x19StaticPodServerStatusSpec\x12\x10\n" +
 	"\x03url\x18\x01 \x01(\tR\x03url\":\n" +
 	"\rStaticPodSpec\x12)\n" +
-	"\x03pod\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x03pod\"M\n" +
+	"\x03pod\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x03pod\"\xde\x02\n" +
+	"\x1aStaticPodRuntimeStatusSpec\x12\x19\n" +
+	"\bpod_name\x18\x01 \x01(\tR\apodName\x12\x14\n" +
+	"\x05phase\x18\x02 \x01(\tR\x05phase\x12\x14\n" +
+	"\x05ready\x18\x03 \x01(\bR\x05ready\x12#\n" +
+	"\rrestart_count\x18\x04 \x01(\x03R\frestartCount\x12#\n" +
+	"\rrestart_storm\x18\x05 \x01(\bR\frestartStorm\x12$\n" +
+	"\x0elast_exit_code\x18\x06 \x01(\x03R\flastExitCode\x12(\n" +
+	"\x10last_exit_reason\x18\a \x01(\tR\x0elastExitReason\x12,\n" +
+	"\x12last_probe_failure\x18\b \x01(\tR\x10lastProbeFailure\x12\x14\n" +
+	"\x05image\x18\t \x01(\tR\x05image\x12\x1b\n" +
+	"\timage_ref\x18\n" +
+	" \x01(\tR\bimageRef\"M\n" +
 	"\x13StaticPodStatusSpec\x126\n" +
 	"\n" +
 	"pod_status\x18\x01 \x01(\v2\x17.google.protobuf.StructR\tpodStatusBp\n" +
@@ -2535,104 +3034,116 @@ func file_resource_definitions_k8s_k8s_proto_rawDescGZIP() []byte {
 	return file_resource_definitions_k8s_k8s_proto_rawDescData
 }
 
-var file_resource_definitions_k8s_k8s_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
+var file_resource_definitions_k8s_k8s_proto_msgTypes = make([]protoimpl.MessageInfo, 51)
 var file_resource_definitions_k8s_k8s_proto_goTypes = []any{
-	(*APIServerConfigSpec)(nil),          // 0: talos.resource.definitions.k8s.APIServerConfigSpec
-	(*AdmissionControlConfigSpec)(nil),   // 1: talos.resource.definitions.k8s.AdmissionControlConfigSpec
-	(*AdmissionPluginSpec)(nil),          // 2: talos.resource.definitions.k8s.AdmissionPluginSpec
-	(*AuditPolicyConfigSpec)(nil),        // 3: talos.resource.definitions.k8s.AuditPolicyConfigSpec
-	(*AuthorizationAuthorizersSpec)(nil), // 4: talos.resource.definitions.k8s.AuthorizationAuthorizersSpec
-	(*AuthorizationConfigSpec)(nil),      // 5: talos.resource.definitions.k8s.AuthorizationConfigSpec
-	(*BootstrapManifestsConfigSpec)(nil), // 6: talos.resource.definitions.k8s.BootstrapManifestsConfigSpec
-	(*ConfigStatusSpec)(nil),             // 7: talos.resource.definitions.k8s.ConfigStatusSpec
-	(*ControllerManagerConfigSpec)(nil),  // 8: talos.resource.definitions.k8s.ControllerManagerConfigSpec
-	(*EndpointSpec)(nil),                 // 9: talos.resource.definitions.k8s.EndpointSpec
-	(*ExtraManifest)(nil),                // 10: talos.resource.definitions.k8s.ExtraManifest
-	(*ExtraManifestsConfigSpec)(nil),     // 11: talos.resource.definitions.k8s.ExtraManifestsConfigSpec
-	(*ExtraVolume)(nil),                  // 12: talos.resource.definitions.k8s.ExtraVolume
-	(*KubePrismConfigSpec)(nil),          // 13: talos.resource.definitions.k8s.KubePrismConfigSpec
-	(*KubePrismEndpoint)(nil),            // 14: talos.resource.definitions.k8s.KubePrismEndpoint
-	(*KubePrismEndpointsSpec)(nil),       // 15: talos.resource.definitions.k8s.KubePrismEndpointsSpec
-	(*KubePrismStatusesSpec)(nil),        // 16: talos.resource.definitions.k8s.KubePrismStatusesSpec
-	(*KubeletConfigSpec)(nil),            // 17: talos.resource.definitions.k8s.KubeletConfigSpec
-	(*KubeletSpecSpec)(nil),              // 18: talos.resource.definitions.k8s.KubeletSpecSpec
-	(*ManifestSpec)(nil),                 // 19: talos.resource.definitions.k8s.ManifestSpec
-	(*ManifestStatusSpec)(nil),           // 20: talos.resource.definitions.k8s.ManifestStatusSpec
-	(*NodeAnnotationSpecSpec)(nil),       // 21: talos.resource.definitions.k8s.NodeAnnotationSpecSpec
-	(*NodeIPConfigSpec)(nil),             // 22: talos.resource.definitions.k8s.NodeIPConfigSpec
-	(*NodeIPSpec)(nil),                   // 23: talos.resource.definitions.k8s.NodeIPSpec
-	(*NodeLabelSpecSpec)(nil),            // 24: talos.resource.definitions.k8s.NodeLabelSpecSpec
-	(*NodeStatusSpec)(nil),               // 25: talos.resource.definitions.k8s.NodeStatusSpec
-	(*NodeTaintSpecSpec)(nil),            // 26: talos.resource.definitions.k8s.NodeTaintSpecSpec
-	(*NodenameSpec)(nil),                 // 27: talos.resource.definitions.k8s.NodenameSpec
-	(*Resources)(nil),                    // 28: talos.resource.definitions.k8s.Resources
-	(*SchedulerConfigSpec)(nil),          // 29: talos.resource.definitions.k8s.SchedulerConfigSpec
-	(*SecretsStatusSpec)(nil),            // 30: talos.resource.definitions.k8s.SecretsStatusSpec
-	(*SingleManifest)(nil),               // 31: talos.resource.definitions.k8s.SingleManifest
-	(*StaticPodServerStatusSpec)(nil),    // 32: talos.resource.definitions.k8s.StaticPodServerStatusSpec
-	(*StaticPodSpec)(nil),                // 33: talos.resource.definitions.k8s.StaticPodSpec
-	(*StaticPodStatusSpec)(nil),          // 34: talos.resource.definitions.k8s.StaticPodStatusSpec
-	nil,                                  // 35: talos.resource.definitions.k8s.APIServerConfigSpec.ExtraArgsEntry
-	nil,                                  // 36: talos.resource.definitions.k8s.APIServerConfigSpec.EnvironmentVariablesEntry
-	nil,                                  // 37: talos.resource.definitions.k8s.ControllerManagerConfigSpec.ExtraArgsEntry
-	nil,                                  // 38: talos.resource.definitions.k8s.ControllerManagerConfigSpec.EnvironmentVariablesEntry
-	nil,                                  // 39: talos.resource.definitions.k8s.ExtraManifest.ExtraHeadersEntry
-	nil,                                  // 40: talos.resource.definitions.k8s.KubeletConfigSpec.ExtraArgsEntry
-	nil,                                  // 41: talos.resource.definitions.k8s.NodeStatusSpec.LabelsEntry
-	nil,                                  // 42: talos.resource.definitions.k8s.NodeStatusSpec.AnnotationsEntry
-	nil,                                  // 43: talos.resource.definitions.k8s.Resources.RequestsEntry
-	nil,                                  // 44: talos.resource.definitions.k8s.Resources.LimitsEntry
-	nil,                                  // 45: talos.resource.definitions.k8s.SchedulerConfigSpec.ExtraArgsEntry
-	nil,                                  // 46: talos.resource.definitions.k8s.SchedulerConfigSpec.EnvironmentVariablesEntry
-	(*structpb.Struct)(nil),              // 47: google.protobuf.Struct
-	(*common.NetIP)(nil),                 // 48: common.NetIP
-	(*proto.Mount)(nil),                  // 49: talos.resource.definitions.proto.Mount
+	(*APIServerConfigSpec)(nil),           // 0: talos.resource.definitions.k8s.APIServerConfigSpec
+	(*AdmissionControlConfigSpec)(nil),    // 1: talos.resource.definitions.k8s.AdmissionControlConfigSpec
+	(*AdmissionPluginSpec)(nil),           // 2: talos.resource.definitions.k8s.AdmissionPluginSpec
+	(*AuditPolicyConfigSpec)(nil),         // 3: talos.resource.definitions.k8s.AuditPolicyConfigSpec
+	(*AuthorizationAuthorizersSpec)(nil),  // 4: talos.resource.definitions.k8s.AuthorizationAuthorizersSpec
+	(*AuthorizationConfigSpec)(nil),       // 5: talos.resource.definitions.k8s.AuthorizationConfigSpec
+	(*BootstrapManifestObjectPolicy)(nil), // 6: talos.resource.definitions.k8s.BootstrapManifestObjectPolicy
+	(*BootstrapManifestsConfigSpec)(nil),  // 7: talos.resource.definitions.k8s.BootstrapManifestsConfigSpec
+	(*ConfigStatusSpec)(nil),              // 8: talos.resource.definitions.k8s.ConfigStatusSpec
+	(*ControllerManagerConfigSpec)(nil),   // 9: talos.resource.definitions.k8s.ControllerManagerConfigSpec
+	(*CoreDNSStubDomain)(nil),             // 10: talos.resource.definitions.k8s.CoreDNSStubDomain
+	(*EndpointSpec)(nil),                  // 11: talos.resource.definitions.k8s.EndpointSpec
+	(*ExtraManifest)(nil),                 // 12: talos.resource.definitions.k8s.ExtraManifest
+	(*ExtraManifestsConfigSpec)(nil),      // 13: talos.resource.definitions.k8s.ExtraManifestsConfigSpec
+	(*ExtraVolume)(nil),                   // 14: talos.resource.definitions.k8s.ExtraVolume
+	(*KubePrismConfigSpec)(nil),           // 15: talos.resource.definitions.k8s.KubePrismConfigSpec
+	(*KubePrismEndpoint)(nil),             // 16: talos.resource.definitions.k8s.KubePrismEndpoint
+	(*KubePrismEndpointsSpec)(nil),        // 17: talos.resource.definitions.k8s.KubePrismEndpointsSpec
+	(*KubePrismStatusesSpec)(nil),         // 18: talos.resource.definitions.k8s.KubePrismStatusesSpec
+	(*KubeletConfigSpec)(nil),             // 19: talos.resource.definitions.k8s.KubeletConfigSpec
+	(*KubeletSpecSpec)(nil),               // 20: talos.resource.definitions.k8s.KubeletSpecSpec
+	(*KubernetesCertSANStatusSpec)(nil),   // 21: talos.resource.definitions.k8s.KubernetesCertSANStatusSpec
+	(*ManifestSpec)(nil),                  // 22: talos.resource.definitions.k8s.ManifestSpec
+	(*ManifestStatusSpec)(nil),            // 23: talos.resource.definitions.k8s.ManifestStatusSpec
+	(*NodeAnnotationSpecSpec)(nil),        // 24: talos.resource.definitions.k8s.NodeAnnotationSpecSpec
+	(*NodeIPConfigSpec)(nil),              // 25: talos.resource.definitions.k8s.NodeIPConfigSpec
+	(*NodeIPSpec)(nil),                    // 26: talos.resource.definitions.k8s.NodeIPSpec
+	(*NodeLabelSpecSpec)(nil),             // 27: talos.resource.definitions.k8s.NodeLabelSpecSpec
+	(*NodeStatusSpec)(nil),                // 28: talos.resource.definitions.k8s.NodeStatusSpec
+	(*NodeTaintSpecSpec)(nil),             // 29: talos.resource.definitions.k8s.NodeTaintSpecSpec
+	(*NodenameSpec)(nil),                  // 30: talos.resource.definitions.k8s.NodenameSpec
+	(*Resources)(nil),                     // 31: talos.resource.definitions.k8s.Resources
+	(*SchedulerConfigSpec)(nil),           // 32: talos.resource.definitions.k8s.SchedulerConfigSpec
+	(*SecretsStatusSpec)(nil),             // 33: talos.resource.definitions.k8s.SecretsStatusSpec
+	(*SingleManifest)(nil),                // 34: talos.resource.definitions.k8s.SingleManifest
+	(*StaticPodServerStatusSpec)(nil),     // 35: talos.resource.definitions.k8s.StaticPodServerStatusSpec
+	(*StaticPodSpec)(nil),                 // 36: talos.resource.definitions.k8s.StaticPodSpec
+	(*StaticPodRuntimeStatusSpec)(nil),    // 37: talos.resource.definitions.k8s.StaticPodRuntimeStatusSpec
+	(*StaticPodStatusSpec)(nil),           // 38: talos.resource.definitions.k8s.StaticPodStatusSpec
+	nil,                                   // 39: talos.resource.definitions.k8s.APIServerConfigSpec.ExtraArgsEntry
+	nil,                                   // 40: talos.resource.definitions.k8s.APIServerConfigSpec.EnvironmentVariablesEntry
+	nil,                                   // 41: talos.resource.definitions.k8s.ControllerManagerConfigSpec.ExtraArgsEntry
+	nil,                                   // 42: talos.resource.definitions.k8s.ControllerManagerConfigSpec.EnvironmentVariablesEntry
+	nil,                                   // 43: talos.resource.definitions.k8s.ExtraManifest.ExtraHeadersEntry
+	nil,                                   // 44: talos.resource.definitions.k8s.KubeletConfigSpec.ExtraArgsEntry
+	nil,                                   // 45: talos.resource.definitions.k8s.NodeStatusSpec.LabelsEntry
+	nil,                                   // 46: talos.resource.definitions.k8s.NodeStatusSpec.AnnotationsEntry
+	nil,                                   // 47: talos.resource.definitions.k8s.Resources.RequestsEntry
+	nil,                                   // 48: talos.resource.definitions.k8s.Resources.LimitsEntry
+	nil,                                   // 49: talos.resource.definitions.k8s.SchedulerConfigSpec.ExtraArgsEntry
+	nil,                                   // 50: talos.resource.definitions.k8s.SchedulerConfigSpec.EnvironmentVariablesEntry
+	(*structpb.Struct)(nil),               // 51: google.protobuf.Struct
+	(*common.NetIP)(nil),                  // 52: common.NetIP
+	(*proto.Mount)(nil),                   // 53: talos.resource.definitions.proto.Mount
+	(*durationpb.Duration)(nil),           // 54: google.protobuf.Duration
 }
 var file_resource_definitions_k8s_k8s_proto_depIdxs = []int32{
-	35, // 0: talos.resource.definitions.k8s.APIServerConfigSpec.extra_args:type_name -> talos.resource.definitions.k8s.APIServerConfigSpec.ExtraArgsEntry
-	12, // 1: talos.resource.definitions.k8s.APIServerConfigSpec.extra_volumes:type_name -> talos.resource.definitions.k8s.ExtraVolume
-	36, // 2: talos.resource.definitions.k8s.APIServerConfigSpec.environment_variables:type_name -> talos.resource.definitions.k8s.APIServerConfigSpec.EnvironmentVariablesEntry
-	28, // 3: talos.resource.definitions.k8s.APIServerConfigSpec.resources:type_name -> talos.resource.definitions.k8s.Resources
+	39, // 0: talos.resource.definitions.k8s.APIServerConfigSpec.extra_args:type_name -> talos.resource.definitions.k8s.APIServerConfigSpec.ExtraArgsEntry
+	14, // 1: talos.resource.definitions.k8s.APIServerConfigSpec.extra_volumes:type_name -> talos.resource.definitions.k8s.ExtraVolume
+	40, // 2: talos.resource.definitions.k8s.APIServerConfigSpec.environment_variables:type_name -> talos.resource.definitions.k8s.APIServerConfigSpec.EnvironmentVariablesEntry
+	31, // 3: talos.resource.definitions.k8s.APIServerConfigSpec.resources:type_name -> talos.resource.definitions.k8s.Resources
 	2,  // 4: talos.resource.definitions.k8s.AdmissionControlConfigSpec.config:type_name -> talos.resource.definitions.k8s.AdmissionPluginSpec
-	47, // 5: talos.resource.definitions.k8s.AdmissionPluginSpec.configuration:type_name -> google.protobuf.Struct
-	47, // 6: talos.resource.definitions.k8s.AuditPolicyConfigSpec.config:type_name -> google.protobuf.Struct
-	47, // 7: talos.resource.definitions.k8s.AuthorizationAuthorizersSpec.webhook:type_name -> google.protobuf.Struct
+	51, // 5: talos.resource.definitions.k8s.AdmissionPluginSpec.configuration:type_name -> google.protobuf.Struct
+	51, // 6: talos.resource.definitions.k8s.AuditPolicyConfigSpec.config:type_name -> google.protobuf.Struct
+	51, // 7: talos.resource.definitions.k8s.AuthorizationAuthorizersSpec.webhook:type_name -> google.protobuf.Struct
 	4,  // 8: talos.resource.definitions.k8s.AuthorizationConfigSpec.config:type_name -> talos.resource.definitions.k8s.AuthorizationAuthorizersSpec
-	37, // 9: talos.resource.definitions.k8s.ControllerManagerConfigSpec.extra_args:type_name -> talos.resource.definitions.k8s.ControllerManagerConfigSpec.ExtraArgsEntry
-	12, // 10: talos.resource.definitions.k8s.ControllerManagerConfigSpec.extra_volumes:type_name -> talos.resource.definitions.k8s.ExtraVolume
-	38, // 11: talos.resource.definitions.k8s.ControllerManagerConfigSpec.environment_variables:type_name -> talos.resource.definitions.k8s.ControllerManagerConfigSpec.EnvironmentVariablesEntry
-	28, // 12: talos.resource.definitions.k8s.ControllerManagerConfigSpec.resources:type_name -> talos.resource.definitions.k8s.Resources
-	48, // 13: talos.resource.definitions.k8s.EndpointSpec.addresses:type_name -> common.NetIP
-	39, // 14: talos.resource.definitions.k8s.ExtraManifest.extra_headers:type_name -> talos.resource.definitions.k8s.ExtraManifest.ExtraHeadersEntry
-	10, // 15: talos.resource.definitions.k8s.ExtraManifestsConfigSpec.extra_manifests:type_name -> talos.resource.definitions.k8s.ExtraManifest
-	14, // 16: talos.resource.definitions.k8s.KubePrismConfigSpec.endpoints:type_name -> talos.resource.definitions.k8s.KubePrismEndpoint
-	14, // 17: talos.resource.definitions.k8s.KubePrismEndpointsSpec.endpoints:type_name -> talos.resource.definitions.k8s.KubePrismEndpoint
-	40, // 18: talos.resource.definitions.k8s.KubeletConfigSpec.extra_args:type_name -> talos.resource.definitions.k8s.KubeletConfigSpec.ExtraArgsEntry
-	49, // 19: talos.resource.definitions.k8s.KubeletConfigSpec.extra_mounts:type_name -> talos.resource.definitions.proto.Mount
-	47, // 20: talos.resource.definitions.k8s.KubeletConfigSpec.extra_config:type_name -> google.protobuf.Struct
-	47, // 21: talos.resource.definitions.k8s.KubeletConfigSpec.credential_provider_config:type_name -> google.protobuf.Struct
-	49, // 22: talos.resource.definitions.k8s.KubeletSpecSpec.extra_mounts:type_name -> talos.resource.definitions.proto.Mount
-	47, // 23: talos.resource.definitions.k8s.KubeletSpecSpec.config:type_name -> google.protobuf.Struct
-	47, // 24: talos.resource.definitions.k8s.KubeletSpecSpec.credential_provider_config:type_name -> google.protobuf.Struct
-	31, // 25: talos.resource.definitions.k8s.ManifestSpec.items:type_name -> talos.resource.definitions.k8s.SingleManifest
-	48, // 26: talos.resource.definitions.k8s.NodeIPSpec.addresses:type_name -> common.NetIP
-	41, // 27: talos.resource.definitions.k8s.NodeStatusSpec.labels:type_name -> talos.resource.definitions.k8s.NodeStatusSpec.LabelsEntry
-	42, // 28: talos.resource.definitions.k8s.NodeStatusSpec.annotations:type_name -> talos.resource.definitions.k8s.NodeStatusSpec.AnnotationsEntry
-	43, // 29: talos.resource.definitions.k8s.Resources.requests:type_name -> talos.resource.definitions.k8s.Resources.RequestsEntry
-	44, // 30: talos.resource.definitions.k8s.Resources.limits:type_name -> talos.resource.definitions.k8s.Resources.LimitsEntry
-	45, // 31: talos.resource.definitions.k8s.SchedulerConfigSpec.extra_args:type_name -> talos.resource.definitions.k8s.SchedulerConfigSpec.ExtraArgsEntry
-	12, // 32: talos.resource.definitions.k8s.SchedulerConfigSpec.extra_volumes:type_name -> talos.resource.definitions.k8s.ExtraVolume
-	46, // 33: talos.resource.definitions.k8s.SchedulerConfigSpec.environment_variables:type_name -> talos.resource.definitions.k8s.SchedulerConfigSpec.EnvironmentVariablesEntry
-	28, // 34: talos.resource.definitions.k8s.SchedulerConfigSpec.resources:type_name -> talos.resource.definitions.k8s.Resources
-	47, // 35: talos.resource.definitions.k8s.SchedulerConfigSpec.config:type_name -> google.protobuf.Struct
-	47, // 36: talos.resource.definitions.k8s.SingleManifest.object:type_name -> google.protobuf.Struct
-	47, // 37: talos.resource.definitions.k8s.StaticPodSpec.pod:type_name -> google.protobuf.Struct
-	47, // 38: talos.resource.definitions.k8s.StaticPodStatusSpec.pod_status:type_name -> google.protobuf.Struct
-	39, // [39:39] is the sub-list for method output_type
-	39, // [39:39] is the sub-list for method input_type
-	39, // [39:39] is the sub-list for extension type_name
-	39, // [39:39] is the sub-list for extension extendee
-	0,  // [0:39] is the sub-list for field type_name
+	6,  // 9: talos.resource.definitions.k8s.BootstrapManifestsConfigSpec.object_policies:type_name -> talos.resource.definitions.k8s.BootstrapManifestObjectPolicy
+	10, // 10: talos.resource.definitions.k8s.BootstrapManifestsConfigSpec.core_dns_stub_domains:type_name -> talos.resource.definitions.k8s.CoreDNSStubDomain
+	31, // 11: talos.resource.definitions.k8s.BootstrapManifestsConfigSpec.core_dns_resources:type_name -> talos.resource.definitions.k8s.Resources
+	41, // 12: talos.resource.definitions.k8s.ControllerManagerConfigSpec.extra_args:type_name -> talos.resource.definitions.k8s.ControllerManagerConfigSpec.ExtraArgsEntry
+	14, // 13: talos.resource.definitions.k8s.ControllerManagerConfigSpec.extra_volumes:type_name -> talos.resource.definitions.k8s.ExtraVolume
+	42, // 14: talos.resource.definitions.k8s.ControllerManagerConfigSpec.environment_variables:type_name -> talos.resource.definitions.k8s.ControllerManagerConfigSpec.EnvironmentVariablesEntry
+	31, // 15: talos.resource.definitions.k8s.ControllerManagerConfigSpec.resources:type_name -> talos.resource.definitions.k8s.Resources
+	52, // 16: talos.resource.definitions.k8s.EndpointSpec.addresses:type_name -> common.NetIP
+	43, // 17: talos.resource.definitions.k8s.ExtraManifest.extra_headers:type_name -> talos.resource.definitions.k8s.ExtraManifest.ExtraHeadersEntry
+	12, // 18: talos.resource.definitions.k8s.ExtraManifestsConfigSpec.extra_manifests:type_name -> talos.resource.definitions.k8s.ExtraManifest
+	16, // 19: talos.resource.definitions.k8s.KubePrismConfigSpec.endpoints:type_name -> talos.resource.definitions.k8s.KubePrismEndpoint
+	16, // 20: talos.resource.definitions.k8s.KubePrismConfigSpec.primary_endpoint:type_name -> talos.resource.definitions.k8s.KubePrismEndpoint
+	16, // 21: talos.resource.definitions.k8s.KubePrismEndpointsSpec.endpoints:type_name -> talos.resource.definitions.k8s.KubePrismEndpoint
+	44, // 22: talos.resource.definitions.k8s.KubeletConfigSpec.extra_args:type_name -> talos.resource.definitions.k8s.KubeletConfigSpec.ExtraArgsEntry
+	53, // 23: talos.resource.definitions.k8s.KubeletConfigSpec.extra_mounts:type_name -> talos.resource.definitions.proto.Mount
+	51, // 24: talos.resource.definitions.k8s.KubeletConfigSpec.extra_config:type_name -> google.protobuf.Struct
+	51, // 25: talos.resource.definitions.k8s.KubeletConfigSpec.credential_provider_config:type_name -> google.protobuf.Struct
+	54, // 26: talos.resource.definitions.k8s.KubeletConfigSpec.shutdown_grace_period:type_name -> google.protobuf.Duration
+	54, // 27: talos.resource.definitions.k8s.KubeletConfigSpec.shutdown_grace_period_critical_pods:type_name -> google.protobuf.Duration
+	53, // 28: talos.resource.definitions.k8s.KubeletSpecSpec.extra_mounts:type_name -> talos.resource.definitions.proto.Mount
+	51, // 29: talos.resource.definitions.k8s.KubeletSpecSpec.config:type_name -> google.protobuf.Struct
+	51, // 30: talos.resource.definitions.k8s.KubeletSpecSpec.credential_provider_config:type_name -> google.protobuf.Struct
+	52, // 31: talos.resource.definitions.k8s.KubernetesCertSANStatusSpec.i_ps:type_name -> common.NetIP
+	34, // 32: talos.resource.definitions.k8s.ManifestSpec.items:type_name -> talos.resource.definitions.k8s.SingleManifest
+	52, // 33: talos.resource.definitions.k8s.NodeIPSpec.addresses:type_name -> common.NetIP
+	45, // 34: talos.resource.definitions.k8s.NodeStatusSpec.labels:type_name -> talos.resource.definitions.k8s.NodeStatusSpec.LabelsEntry
+	46, // 35: talos.resource.definitions.k8s.NodeStatusSpec.annotations:type_name -> talos.resource.definitions.k8s.NodeStatusSpec.AnnotationsEntry
+	47, // 36: talos.resource.definitions.k8s.Resources.requests:type_name -> talos.resource.definitions.k8s.Resources.RequestsEntry
+	48, // 37: talos.resource.definitions.k8s.Resources.limits:type_name -> talos.resource.definitions.k8s.Resources.LimitsEntry
+	49, // 38: talos.resource.definitions.k8s.SchedulerConfigSpec.extra_args:type_name -> talos.resource.definitions.k8s.SchedulerConfigSpec.ExtraArgsEntry
+	14, // 39: talos.resource.definitions.k8s.SchedulerConfigSpec.extra_volumes:type_name -> talos.resource.definitions.k8s.ExtraVolume
+	50, // 40: talos.resource.definitions.k8s.SchedulerConfigSpec.environment_variables:type_name -> talos.resource.definitions.k8s.SchedulerConfigSpec.EnvironmentVariablesEntry
+	31, // 41: talos.resource.definitions.k8s.SchedulerConfigSpec.resources:type_name -> talos.resource.definitions.k8s.Resources
+	51, // 42: talos.resource.definitions.k8s.SchedulerConfigSpec.config:type_name -> google.protobuf.Struct
+	51, // 43: talos.resource.definitions.k8s.SingleManifest.object:type_name -> google.protobuf.Struct
+	51, // 44: talos.resource.definitions.k8s.StaticPodSpec.pod:type_name -> google.protobuf.Struct
+	51, // 45: talos.resource.definitions.k8s.StaticPodStatusSpec.pod_status:type_name -> google.protobuf.Struct
+	46, // [46:46] is the sub-list for method output_type
+	46, // [46:46] is the sub-list for method input_type
+	46, // [46:46] is the sub-list for extension type_name
+	46, // [46:46] is the sub-list for extension extendee
+	0,  // [0:46] is the sub-list for field type_name
 }
 
 func init() { file_resource_definitions_k8s_k8s_proto_init() }
@@ -2646,7 +3157,7 @@ func file_resource_definitions_k8s_k8s_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resource_definitions_k8s_k8s_proto_rawDesc), len(file_resource_definitions_k8s_k8s_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   47,
+			NumMessages:   51,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
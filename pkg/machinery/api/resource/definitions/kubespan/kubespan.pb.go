@@ -13,6 +13,7 @@ import (
 
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 
 	common "github.com/siderolabs/talos/pkg/machinery/api/common"
@@ -28,18 +29,23 @@ const (
 
 // ConfigSpec describes KubeSpan configuration..
 type ConfigSpec struct {
-	state                       protoimpl.MessageState `protogen:"open.v1"`
-	Enabled                     bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	ClusterId                   string                 `protobuf:"bytes,2,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
-	SharedSecret                string                 `protobuf:"bytes,3,opt,name=shared_secret,json=sharedSecret,proto3" json:"shared_secret,omitempty"`
-	ForceRouting                bool                   `protobuf:"varint,4,opt,name=force_routing,json=forceRouting,proto3" json:"force_routing,omitempty"`
-	AdvertiseKubernetesNetworks bool                   `protobuf:"varint,5,opt,name=advertise_kubernetes_networks,json=advertiseKubernetesNetworks,proto3" json:"advertise_kubernetes_networks,omitempty"`
-	Mtu                         uint32                 `protobuf:"varint,6,opt,name=mtu,proto3" json:"mtu,omitempty"`
-	EndpointFilters             []string               `protobuf:"bytes,7,rep,name=endpoint_filters,json=endpointFilters,proto3" json:"endpoint_filters,omitempty"`
-	HarvestExtraEndpoints       bool                   `protobuf:"varint,8,opt,name=harvest_extra_endpoints,json=harvestExtraEndpoints,proto3" json:"harvest_extra_endpoints,omitempty"`
-	ExtraEndpoints              []*common.NetIPPort    `protobuf:"bytes,9,rep,name=extra_endpoints,json=extraEndpoints,proto3" json:"extra_endpoints,omitempty"`
-	unknownFields               protoimpl.UnknownFields
-	sizeCache                   protoimpl.SizeCache
+	state                        protoimpl.MessageState `protogen:"open.v1"`
+	Enabled                      bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	ClusterId                    string                 `protobuf:"bytes,2,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	SharedSecret                 string                 `protobuf:"bytes,3,opt,name=shared_secret,json=sharedSecret,proto3" json:"shared_secret,omitempty"`
+	ForceRouting                 bool                   `protobuf:"varint,4,opt,name=force_routing,json=forceRouting,proto3" json:"force_routing,omitempty"`
+	AdvertiseKubernetesNetworks  bool                   `protobuf:"varint,5,opt,name=advertise_kubernetes_networks,json=advertiseKubernetesNetworks,proto3" json:"advertise_kubernetes_networks,omitempty"`
+	Mtu                          uint32                 `protobuf:"varint,6,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	EndpointFilters              []string               `protobuf:"bytes,7,rep,name=endpoint_filters,json=endpointFilters,proto3" json:"endpoint_filters,omitempty"`
+	HarvestExtraEndpoints        bool                   `protobuf:"varint,8,opt,name=harvest_extra_endpoints,json=harvestExtraEndpoints,proto3" json:"harvest_extra_endpoints,omitempty"`
+	ExtraEndpoints               []*common.NetIPPort    `protobuf:"bytes,9,rep,name=extra_endpoints,json=extraEndpoints,proto3" json:"extra_endpoints,omitempty"`
+	PeerEndpointFilters          []string               `protobuf:"bytes,10,rep,name=peer_endpoint_filters,json=peerEndpointFilters,proto3" json:"peer_endpoint_filters,omitempty"`
+	PersistentKeepaliveInterval  *durationpb.Duration   `protobuf:"bytes,11,opt,name=persistent_keepalive_interval,json=persistentKeepaliveInterval,proto3" json:"persistent_keepalive_interval,omitempty"`
+	PersistentKeepaliveNatOnly   bool                   `protobuf:"varint,12,opt,name=persistent_keepalive_nat_only,json=persistentKeepaliveNatOnly,proto3" json:"persistent_keepalive_nat_only,omitempty"`
+	PersistentKeepaliveOverrides []*KeepaliveOverride   `protobuf:"bytes,13,rep,name=persistent_keepalive_overrides,json=persistentKeepaliveOverrides,proto3" json:"persistent_keepalive_overrides,omitempty"`
+	ExtraHostnameEndpoints       []string               `protobuf:"bytes,14,rep,name=extra_hostname_endpoints,json=extraHostnameEndpoints,proto3" json:"extra_hostname_endpoints,omitempty"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
 }
 
 func (x *ConfigSpec) Reset() {
@@ -135,6 +141,41 @@ func (x *ConfigSpec) GetExtraEndpoints() []*common.NetIPPort {
 	return nil
 }
 
+func (x *ConfigSpec) GetPeerEndpointFilters() []string {
+	if x != nil {
+		return x.PeerEndpointFilters
+	}
+	return nil
+}
+
+func (x *ConfigSpec) GetPersistentKeepaliveInterval() *durationpb.Duration {
+	if x != nil {
+		return x.PersistentKeepaliveInterval
+	}
+	return nil
+}
+
+func (x *ConfigSpec) GetPersistentKeepaliveNatOnly() bool {
+	if x != nil {
+		return x.PersistentKeepaliveNatOnly
+	}
+	return false
+}
+
+func (x *ConfigSpec) GetPersistentKeepaliveOverrides() []*KeepaliveOverride {
+	if x != nil {
+		return x.PersistentKeepaliveOverrides
+	}
+	return nil
+}
+
+func (x *ConfigSpec) GetExtraHostnameEndpoints() []string {
+	if x != nil {
+		return x.ExtraHostnameEndpoints
+	}
+	return nil
+}
+
 // EndpointSpec describes Endpoint state.
 type EndpointSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -260,6 +301,67 @@ func (x *IdentitySpec) GetPublicKey() string {
 	return ""
 }
 
+// KeepaliveOverride describes persistent keepalive override for a set of peers.
+type KeepaliveOverride struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Cidr          *common.NetIPPrefix    `protobuf:"bytes,2,opt,name=cidr,proto3" json:"cidr,omitempty"`
+	Interval      *durationpb.Duration   `protobuf:"bytes,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeepaliveOverride) Reset() {
+	*x = KeepaliveOverride{}
+	mi := &file_resource_definitions_kubespan_kubespan_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeepaliveOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeepaliveOverride) ProtoMessage() {}
+
+func (x *KeepaliveOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_kubespan_kubespan_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeepaliveOverride.ProtoReflect.Descriptor instead.
+func (*KeepaliveOverride) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_kubespan_kubespan_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *KeepaliveOverride) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *KeepaliveOverride) GetCidr() *common.NetIPPrefix {
+	if x != nil {
+		return x.Cidr
+	}
+	return nil
+}
+
+func (x *KeepaliveOverride) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
 // PeerSpecSpec describes PeerSpec state.
 type PeerSpecSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -273,7 +375,7 @@ type PeerSpecSpec struct {
 
 func (x *PeerSpecSpec) Reset() {
 	*x = PeerSpecSpec{}
-	mi := &file_resource_definitions_kubespan_kubespan_proto_msgTypes[3]
+	mi := &file_resource_definitions_kubespan_kubespan_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -285,7 +387,7 @@ func (x *PeerSpecSpec) String() string {
 func (*PeerSpecSpec) ProtoMessage() {}
 
 func (x *PeerSpecSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_kubespan_kubespan_proto_msgTypes[3]
+	mi := &file_resource_definitions_kubespan_kubespan_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -298,7 +400,7 @@ func (x *PeerSpecSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PeerSpecSpec.ProtoReflect.Descriptor instead.
 func (*PeerSpecSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_kubespan_kubespan_proto_rawDescGZIP(), []int{3}
+	return file_resource_definitions_kubespan_kubespan_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *PeerSpecSpec) GetAddress() *common.NetIP {
@@ -331,22 +433,25 @@ func (x *PeerSpecSpec) GetLabel() string {
 
 // PeerStatusSpec describes PeerStatus state.
 type PeerStatusSpec struct {
-	state              protoimpl.MessageState  `protogen:"open.v1"`
-	Endpoint           *common.NetIPPort       `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	Label              string                  `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
-	State              enums.KubespanPeerState `protobuf:"varint,3,opt,name=state,proto3,enum=talos.resource.definitions.enums.KubespanPeerState" json:"state,omitempty"`
-	ReceiveBytes       int64                   `protobuf:"varint,4,opt,name=receive_bytes,json=receiveBytes,proto3" json:"receive_bytes,omitempty"`
-	TransmitBytes      int64                   `protobuf:"varint,5,opt,name=transmit_bytes,json=transmitBytes,proto3" json:"transmit_bytes,omitempty"`
-	LastHandshakeTime  *timestamppb.Timestamp  `protobuf:"bytes,6,opt,name=last_handshake_time,json=lastHandshakeTime,proto3" json:"last_handshake_time,omitempty"`
-	LastUsedEndpoint   *common.NetIPPort       `protobuf:"bytes,7,opt,name=last_used_endpoint,json=lastUsedEndpoint,proto3" json:"last_used_endpoint,omitempty"`
-	LastEndpointChange *timestamppb.Timestamp  `protobuf:"bytes,8,opt,name=last_endpoint_change,json=lastEndpointChange,proto3" json:"last_endpoint_change,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	state                       protoimpl.MessageState  `protogen:"open.v1"`
+	Endpoint                    *common.NetIPPort       `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Label                       string                  `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	State                       enums.KubespanPeerState `protobuf:"varint,3,opt,name=state,proto3,enum=talos.resource.definitions.enums.KubespanPeerState" json:"state,omitempty"`
+	ReceiveBytes                int64                   `protobuf:"varint,4,opt,name=receive_bytes,json=receiveBytes,proto3" json:"receive_bytes,omitempty"`
+	TransmitBytes               int64                   `protobuf:"varint,5,opt,name=transmit_bytes,json=transmitBytes,proto3" json:"transmit_bytes,omitempty"`
+	LastHandshakeTime           *timestamppb.Timestamp  `protobuf:"bytes,6,opt,name=last_handshake_time,json=lastHandshakeTime,proto3" json:"last_handshake_time,omitempty"`
+	LastUsedEndpoint            *common.NetIPPort       `protobuf:"bytes,7,opt,name=last_used_endpoint,json=lastUsedEndpoint,proto3" json:"last_used_endpoint,omitempty"`
+	LastEndpointChange          *timestamppb.Timestamp  `protobuf:"bytes,8,opt,name=last_endpoint_change,json=lastEndpointChange,proto3" json:"last_endpoint_change,omitempty"`
+	FilteredEndpoints           []*common.NetIPPort     `protobuf:"bytes,9,rep,name=filtered_endpoints,json=filteredEndpoints,proto3" json:"filtered_endpoints,omitempty"`
+	PersistentKeepaliveInterval *durationpb.Duration    `protobuf:"bytes,10,opt,name=persistent_keepalive_interval,json=persistentKeepaliveInterval,proto3" json:"persistent_keepalive_interval,omitempty"`
+	BehindNat                   bool                    `protobuf:"varint,11,opt,name=behind_nat,json=behindNat,proto3" json:"behind_nat,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
 }
 
 func (x *PeerStatusSpec) Reset() {
 	*x = PeerStatusSpec{}
-	mi := &file_resource_definitions_kubespan_kubespan_proto_msgTypes[4]
+	mi := &file_resource_definitions_kubespan_kubespan_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -358,7 +463,7 @@ func (x *PeerStatusSpec) String() string {
 func (*PeerStatusSpec) ProtoMessage() {}
 
 func (x *PeerStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_kubespan_kubespan_proto_msgTypes[4]
+	mi := &file_resource_definitions_kubespan_kubespan_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -371,7 +476,7 @@ func (x *PeerStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PeerStatusSpec.ProtoReflect.Descriptor instead.
 func (*PeerStatusSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_kubespan_kubespan_proto_rawDescGZIP(), []int{4}
+	return file_resource_definitions_kubespan_kubespan_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *PeerStatusSpec) GetEndpoint() *common.NetIPPort {
@@ -430,11 +535,32 @@ func (x *PeerStatusSpec) GetLastEndpointChange() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *PeerStatusSpec) GetFilteredEndpoints() []*common.NetIPPort {
+	if x != nil {
+		return x.FilteredEndpoints
+	}
+	return nil
+}
+
+func (x *PeerStatusSpec) GetPersistentKeepaliveInterval() *durationpb.Duration {
+	if x != nil {
+		return x.PersistentKeepaliveInterval
+	}
+	return nil
+}
+
+func (x *PeerStatusSpec) GetBehindNat() bool {
+	if x != nil {
+		return x.BehindNat
+	}
+	return false
+}
+
 var File_resource_definitions_kubespan_kubespan_proto protoreflect.FileDescriptor
 
 const file_resource_definitions_kubespan_kubespan_proto_rawDesc = "" +
 	"\n" +
-	",resource/definitions/kubespan/kubespan.proto\x12#talos.resource.definitions.kubespan\x1a\x13common/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a&resource/definitions/enums/enums.proto\"\x84\x03\n" +
+	",resource/definitions/kubespan/kubespan.proto\x12#talos.resource.definitions.kubespan\x1a\x13common/common.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a&resource/definitions/enums/enums.proto\"\x92\x06\n" +
 	"\n" +
 	"ConfigSpec\x12\x18\n" +
 	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1d\n" +
@@ -446,7 +572,13 @@ const file_resource_definitions_kubespan_kubespan_proto_rawDesc = "" +
 	"\x03mtu\x18\x06 \x01(\rR\x03mtu\x12)\n" +
 	"\x10endpoint_filters\x18\a \x03(\tR\x0fendpointFilters\x126\n" +
 	"\x17harvest_extra_endpoints\x18\b \x01(\bR\x15harvestExtraEndpoints\x12:\n" +
-	"\x0fextra_endpoints\x18\t \x03(\v2\x11.common.NetIPPortR\x0eextraEndpoints\"`\n" +
+	"\x0fextra_endpoints\x18\t \x03(\v2\x11.common.NetIPPortR\x0eextraEndpoints\x122\n" +
+	"\x15peer_endpoint_filters\x18\n" +
+	" \x03(\tR\x13peerEndpointFilters\x12]\n" +
+	"\x1dpersistent_keepalive_interval\x18\v \x01(\v2\x19.google.protobuf.DurationR\x1bpersistentKeepaliveInterval\x12A\n" +
+	"\x1dpersistent_keepalive_nat_only\x18\f \x01(\bR\x1apersistentKeepaliveNatOnly\x12|\n" +
+	"\x1epersistent_keepalive_overrides\x18\r \x03(\v26.talos.resource.definitions.kubespan.KeepaliveOverrideR\x1cpersistentKeepaliveOverrides\x128\n" +
+	"\x18extra_hostname_endpoints\x18\x0e \x03(\tR\x16extraHostnameEndpoints\"`\n" +
 	"\fEndpointSpec\x12!\n" +
 	"\faffiliate_id\x18\x01 \x01(\tR\vaffiliateId\x12-\n" +
 	"\bendpoint\x18\x02 \x01(\v2\x11.common.NetIPPortR\bendpoint\"\xaa\x01\n" +
@@ -456,13 +588,17 @@ const file_resource_definitions_kubespan_kubespan_proto_rawDesc = "" +
 	"\vprivate_key\x18\x03 \x01(\tR\n" +
 	"privateKey\x12\x1d\n" +
 	"\n" +
-	"public_key\x18\x04 \x01(\tR\tpublicKey\"\xb4\x01\n" +
+	"public_key\x18\x04 \x01(\tR\tpublicKey\"\x89\x01\n" +
+	"\x11KeepaliveOverride\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x12'\n" +
+	"\x04cidr\x18\x02 \x01(\v2\x13.common.NetIPPrefixR\x04cidr\x125\n" +
+	"\binterval\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\binterval\"\xb4\x01\n" +
 	"\fPeerSpecSpec\x12'\n" +
 	"\aaddress\x18\x01 \x01(\v2\r.common.NetIPR\aaddress\x124\n" +
 	"\vallowed_ips\x18\x02 \x03(\v2\x13.common.NetIPPrefixR\n" +
 	"allowedIps\x12/\n" +
 	"\tendpoints\x18\x03 \x03(\v2\x11.common.NetIPPortR\tendpoints\x12\x14\n" +
-	"\x05label\x18\x04 \x01(\tR\x05label\"\xc7\x03\n" +
+	"\x05label\x18\x04 \x01(\tR\x05label\"\x87\x05\n" +
 	"\x0ePeerStatusSpec\x12-\n" +
 	"\bendpoint\x18\x01 \x01(\v2\x11.common.NetIPPortR\bendpoint\x12\x14\n" +
 	"\x05label\x18\x02 \x01(\tR\x05label\x12I\n" +
@@ -471,7 +607,12 @@ const file_resource_definitions_kubespan_kubespan_proto_rawDesc = "" +
 	"\x0etransmit_bytes\x18\x05 \x01(\x03R\rtransmitBytes\x12J\n" +
 	"\x13last_handshake_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x11lastHandshakeTime\x12?\n" +
 	"\x12last_used_endpoint\x18\a \x01(\v2\x11.common.NetIPPortR\x10lastUsedEndpoint\x12L\n" +
-	"\x14last_endpoint_change\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x12lastEndpointChangeBz\n" +
+	"\x14last_endpoint_change\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x12lastEndpointChange\x12@\n" +
+	"\x12filtered_endpoints\x18\t \x03(\v2\x11.common.NetIPPortR\x11filteredEndpoints\x12]\n" +
+	"\x1dpersistent_keepalive_interval\x18\n" +
+	" \x01(\v2\x19.google.protobuf.DurationR\x1bpersistentKeepaliveInterval\x12\x1d\n" +
+	"\n" +
+	"behind_nat\x18\v \x01(\bR\tbehindNatBz\n" +
 	"+dev.talos.api.resource.definitions.kubespanZKgithub.com/siderolabs/talos/pkg/machinery/api/resource/definitions/kubespanb\x06proto3"
 
 var (
@@ -486,37 +627,45 @@ func file_resource_definitions_kubespan_kubespan_proto_rawDescGZIP() []byte {
 	return file_resource_definitions_kubespan_kubespan_proto_rawDescData
 }
 
-var file_resource_definitions_kubespan_kubespan_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_resource_definitions_kubespan_kubespan_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_resource_definitions_kubespan_kubespan_proto_goTypes = []any{
 	(*ConfigSpec)(nil),            // 0: talos.resource.definitions.kubespan.ConfigSpec
 	(*EndpointSpec)(nil),          // 1: talos.resource.definitions.kubespan.EndpointSpec
 	(*IdentitySpec)(nil),          // 2: talos.resource.definitions.kubespan.IdentitySpec
-	(*PeerSpecSpec)(nil),          // 3: talos.resource.definitions.kubespan.PeerSpecSpec
-	(*PeerStatusSpec)(nil),        // 4: talos.resource.definitions.kubespan.PeerStatusSpec
-	(*common.NetIPPort)(nil),      // 5: common.NetIPPort
-	(*common.NetIPPrefix)(nil),    // 6: common.NetIPPrefix
-	(*common.NetIP)(nil),          // 7: common.NetIP
-	(enums.KubespanPeerState)(0),  // 8: talos.resource.definitions.enums.KubespanPeerState
-	(*timestamppb.Timestamp)(nil), // 9: google.protobuf.Timestamp
+	(*KeepaliveOverride)(nil),     // 3: talos.resource.definitions.kubespan.KeepaliveOverride
+	(*PeerSpecSpec)(nil),          // 4: talos.resource.definitions.kubespan.PeerSpecSpec
+	(*PeerStatusSpec)(nil),        // 5: talos.resource.definitions.kubespan.PeerStatusSpec
+	(*common.NetIPPort)(nil),      // 6: common.NetIPPort
+	(*durationpb.Duration)(nil),   // 7: google.protobuf.Duration
+	(*common.NetIPPrefix)(nil),    // 8: common.NetIPPrefix
+	(*common.NetIP)(nil),          // 9: common.NetIP
+	(enums.KubespanPeerState)(0),  // 10: talos.resource.definitions.enums.KubespanPeerState
+	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
 }
 var file_resource_definitions_kubespan_kubespan_proto_depIdxs = []int32{
-	5,  // 0: talos.resource.definitions.kubespan.ConfigSpec.extra_endpoints:type_name -> common.NetIPPort
-	5,  // 1: talos.resource.definitions.kubespan.EndpointSpec.endpoint:type_name -> common.NetIPPort
-	6,  // 2: talos.resource.definitions.kubespan.IdentitySpec.address:type_name -> common.NetIPPrefix
-	6,  // 3: talos.resource.definitions.kubespan.IdentitySpec.subnet:type_name -> common.NetIPPrefix
-	7,  // 4: talos.resource.definitions.kubespan.PeerSpecSpec.address:type_name -> common.NetIP
-	6,  // 5: talos.resource.definitions.kubespan.PeerSpecSpec.allowed_ips:type_name -> common.NetIPPrefix
-	5,  // 6: talos.resource.definitions.kubespan.PeerSpecSpec.endpoints:type_name -> common.NetIPPort
-	5,  // 7: talos.resource.definitions.kubespan.PeerStatusSpec.endpoint:type_name -> common.NetIPPort
-	8,  // 8: talos.resource.definitions.kubespan.PeerStatusSpec.state:type_name -> talos.resource.definitions.enums.KubespanPeerState
-	9,  // 9: talos.resource.definitions.kubespan.PeerStatusSpec.last_handshake_time:type_name -> google.protobuf.Timestamp
-	5,  // 10: talos.resource.definitions.kubespan.PeerStatusSpec.last_used_endpoint:type_name -> common.NetIPPort
-	9,  // 11: talos.resource.definitions.kubespan.PeerStatusSpec.last_endpoint_change:type_name -> google.protobuf.Timestamp
-	12, // [12:12] is the sub-list for method output_type
-	12, // [12:12] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	6,  // 0: talos.resource.definitions.kubespan.ConfigSpec.extra_endpoints:type_name -> common.NetIPPort
+	7,  // 1: talos.resource.definitions.kubespan.ConfigSpec.persistent_keepalive_interval:type_name -> google.protobuf.Duration
+	3,  // 2: talos.resource.definitions.kubespan.ConfigSpec.persistent_keepalive_overrides:type_name -> talos.resource.definitions.kubespan.KeepaliveOverride
+	6,  // 3: talos.resource.definitions.kubespan.EndpointSpec.endpoint:type_name -> common.NetIPPort
+	8,  // 4: talos.resource.definitions.kubespan.IdentitySpec.address:type_name -> common.NetIPPrefix
+	8,  // 5: talos.resource.definitions.kubespan.IdentitySpec.subnet:type_name -> common.NetIPPrefix
+	8,  // 6: talos.resource.definitions.kubespan.KeepaliveOverride.cidr:type_name -> common.NetIPPrefix
+	7,  // 7: talos.resource.definitions.kubespan.KeepaliveOverride.interval:type_name -> google.protobuf.Duration
+	9,  // 8: talos.resource.definitions.kubespan.PeerSpecSpec.address:type_name -> common.NetIP
+	8,  // 9: talos.resource.definitions.kubespan.PeerSpecSpec.allowed_ips:type_name -> common.NetIPPrefix
+	6,  // 10: talos.resource.definitions.kubespan.PeerSpecSpec.endpoints:type_name -> common.NetIPPort
+	6,  // 11: talos.resource.definitions.kubespan.PeerStatusSpec.endpoint:type_name -> common.NetIPPort
+	10, // 12: talos.resource.definitions.kubespan.PeerStatusSpec.state:type_name -> talos.resource.definitions.enums.KubespanPeerState
+	11, // 13: talos.resource.definitions.kubespan.PeerStatusSpec.last_handshake_time:type_name -> google.protobuf.Timestamp
+	6,  // 14: talos.resource.definitions.kubespan.PeerStatusSpec.last_used_endpoint:type_name -> common.NetIPPort
+	11, // 15: talos.resource.definitions.kubespan.PeerStatusSpec.last_endpoint_change:type_name -> google.protobuf.Timestamp
+	6,  // 16: talos.resource.definitions.kubespan.PeerStatusSpec.filtered_endpoints:type_name -> common.NetIPPort
+	7,  // 17: talos.resource.definitions.kubespan.PeerStatusSpec.persistent_keepalive_interval:type_name -> google.protobuf.Duration
+	18, // [18:18] is the sub-list for method output_type
+	18, // [18:18] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_resource_definitions_kubespan_kubespan_proto_init() }
@@ -530,7 +679,7 @@ func file_resource_definitions_kubespan_kubespan_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resource_definitions_kubespan_kubespan_proto_rawDesc), len(file_resource_definitions_kubespan_kubespan_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
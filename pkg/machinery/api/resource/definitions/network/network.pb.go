@@ -14,6 +14,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 
 	common "github.com/siderolabs/talos/pkg/machinery/api/common"
 	enums "github.com/siderolabs/talos/pkg/machinery/api/resource/definitions/enums"
@@ -253,6 +254,59 @@ func (x *AddressStatusSpec) GetPriority() uint32 {
 	return 0
 }
 
+// BondARPTargetStatus describes the reachability of the bond ARP/NS monitoring target.
+type BondARPTargetStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       *common.NetIP          `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BondARPTargetStatus) Reset() {
+	*x = BondARPTargetStatus{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BondARPTargetStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BondARPTargetStatus) ProtoMessage() {}
+
+func (x *BondARPTargetStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BondARPTargetStatus.ProtoReflect.Descriptor instead.
+func (*BondARPTargetStatus) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BondARPTargetStatus) GetAddress() *common.NetIP {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *BondARPTargetStatus) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
 // BondMasterSpec describes bond settings if Kind == "bond".
 type BondMasterSpec struct {
 	state           protoimpl.MessageState             `protogen:"open.v1"`
@@ -280,13 +334,15 @@ type BondMasterSpec struct {
 	AdActorSysPrio  uint32                             `protobuf:"fixed32,22,opt,name=ad_actor_sys_prio,json=adActorSysPrio,proto3" json:"ad_actor_sys_prio,omitempty"`
 	AdUserPortKey   uint32                             `protobuf:"fixed32,23,opt,name=ad_user_port_key,json=adUserPortKey,proto3" json:"ad_user_port_key,omitempty"`
 	PeerNotifyDelay uint32                             `protobuf:"varint,24,opt,name=peer_notify_delay,json=peerNotifyDelay,proto3" json:"peer_notify_delay,omitempty"`
+	ArpipTargets    []*common.NetIP                    `protobuf:"bytes,25,rep,name=arpip_targets,json=arpipTargets,proto3" json:"arpip_targets,omitempty"`
+	Nsip6Targets    []*common.NetIP                    `protobuf:"bytes,26,rep,name=nsip6_targets,json=nsip6Targets,proto3" json:"nsip6_targets,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *BondMasterSpec) Reset() {
 	*x = BondMasterSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[2]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -298,7 +354,7 @@ func (x *BondMasterSpec) String() string {
 func (*BondMasterSpec) ProtoMessage() {}
 
 func (x *BondMasterSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[2]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -311,7 +367,7 @@ func (x *BondMasterSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BondMasterSpec.ProtoReflect.Descriptor instead.
 func (*BondMasterSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{2}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *BondMasterSpec) GetMode() enums.NethelpersBondMode {
@@ -482,6 +538,20 @@ func (x *BondMasterSpec) GetPeerNotifyDelay() uint32 {
 	return 0
 }
 
+func (x *BondMasterSpec) GetArpipTargets() []*common.NetIP {
+	if x != nil {
+		return x.ArpipTargets
+	}
+	return nil
+}
+
+func (x *BondMasterSpec) GetNsip6Targets() []*common.NetIP {
+	if x != nil {
+		return x.Nsip6Targets
+	}
+	return nil
+}
+
 // BondSlave contains a bond's master name and slave index.
 type BondSlave struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -493,7 +563,7 @@ type BondSlave struct {
 
 func (x *BondSlave) Reset() {
 	*x = BondSlave{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[3]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -505,7 +575,7 @@ func (x *BondSlave) String() string {
 func (*BondSlave) ProtoMessage() {}
 
 func (x *BondSlave) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[3]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -518,7 +588,7 @@ func (x *BondSlave) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BondSlave.ProtoReflect.Descriptor instead.
 func (*BondSlave) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{3}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *BondSlave) GetMasterName() string {
@@ -535,30 +605,36 @@ func (x *BondSlave) GetSlaveIndex() int64 {
 	return 0
 }
 
-// BridgeMasterSpec describes bridge settings if Kind == "bridge".
-type BridgeMasterSpec struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Stp           *STPSpec               `protobuf:"bytes,1,opt,name=stp,proto3" json:"stp,omitempty"`
-	Vlan          *BridgeVLANSpec        `protobuf:"bytes,2,opt,name=vlan,proto3" json:"vlan,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// BondSlaveStatus describes the runtime state of the bond slave link.
+type BondSlaveStatus struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Name                 string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	State                string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	MiiStatus            string                 `protobuf:"bytes,3,opt,name=mii_status,json=miiStatus,proto3" json:"mii_status,omitempty"`
+	LinkFailureCount     uint32                 `protobuf:"varint,4,opt,name=link_failure_count,json=linkFailureCount,proto3" json:"link_failure_count,omitempty"`
+	PermanentAddr        []byte                 `protobuf:"bytes,5,opt,name=permanent_addr,json=permanentAddr,proto3" json:"permanent_addr,omitempty"`
+	AggregatorId         uint32                 `protobuf:"fixed32,6,opt,name=aggregator_id,json=aggregatorId,proto3" json:"aggregator_id,omitempty"`
+	ActorOperPortState   uint32                 `protobuf:"fixed32,7,opt,name=actor_oper_port_state,json=actorOperPortState,proto3" json:"actor_oper_port_state,omitempty"`
+	PartnerOperPortState uint32                 `protobuf:"fixed32,8,opt,name=partner_oper_port_state,json=partnerOperPortState,proto3" json:"partner_oper_port_state,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *BridgeMasterSpec) Reset() {
-	*x = BridgeMasterSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[4]
+func (x *BondSlaveStatus) Reset() {
+	*x = BondSlaveStatus{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BridgeMasterSpec) String() string {
+func (x *BondSlaveStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BridgeMasterSpec) ProtoMessage() {}
+func (*BondSlaveStatus) ProtoMessage() {}
 
-func (x *BridgeMasterSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[4]
+func (x *BondSlaveStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -569,92 +645,99 @@ func (x *BridgeMasterSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BridgeMasterSpec.ProtoReflect.Descriptor instead.
-func (*BridgeMasterSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use BondSlaveStatus.ProtoReflect.Descriptor instead.
+func (*BondSlaveStatus) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *BridgeMasterSpec) GetStp() *STPSpec {
+func (x *BondSlaveStatus) GetName() string {
 	if x != nil {
-		return x.Stp
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *BridgeMasterSpec) GetVlan() *BridgeVLANSpec {
+func (x *BondSlaveStatus) GetState() string {
 	if x != nil {
-		return x.Vlan
+		return x.State
 	}
-	return nil
+	return ""
 }
 
-// BridgeSlave contains the name of the master bridge of a bridged interface
-type BridgeSlave struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MasterName    string                 `protobuf:"bytes,1,opt,name=master_name,json=masterName,proto3" json:"master_name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *BondSlaveStatus) GetMiiStatus() string {
+	if x != nil {
+		return x.MiiStatus
+	}
+	return ""
 }
 
-func (x *BridgeSlave) Reset() {
-	*x = BridgeSlave{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[5]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *BondSlaveStatus) GetLinkFailureCount() uint32 {
+	if x != nil {
+		return x.LinkFailureCount
+	}
+	return 0
 }
 
-func (x *BridgeSlave) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *BondSlaveStatus) GetPermanentAddr() []byte {
+	if x != nil {
+		return x.PermanentAddr
+	}
+	return nil
 }
 
-func (*BridgeSlave) ProtoMessage() {}
-
-func (x *BridgeSlave) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[5]
+func (x *BondSlaveStatus) GetAggregatorId() uint32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.AggregatorId
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use BridgeSlave.ProtoReflect.Descriptor instead.
-func (*BridgeSlave) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{5}
+func (x *BondSlaveStatus) GetActorOperPortState() uint32 {
+	if x != nil {
+		return x.ActorOperPortState
+	}
+	return 0
 }
 
-func (x *BridgeSlave) GetMasterName() string {
+func (x *BondSlaveStatus) GetPartnerOperPortState() uint32 {
 	if x != nil {
-		return x.MasterName
+		return x.PartnerOperPortState
 	}
-	return ""
+	return 0
 }
 
-// BridgeVLANSpec describes VLAN settings of a bridge.
-type BridgeVLANSpec struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	FilteringEnabled bool                   `protobuf:"varint,1,opt,name=filtering_enabled,json=filteringEnabled,proto3" json:"filtering_enabled,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// BondStatusSpec describes the runtime state of the bond link.
+type BondStatusSpec struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	Mode          enums.NethelpersBondMode           `protobuf:"varint,1,opt,name=mode,proto3,enum=talos.resource.definitions.enums.NethelpersBondMode" json:"mode,omitempty"`
+	HashPolicy    enums.NethelpersBondXmitHashPolicy `protobuf:"varint,2,opt,name=hash_policy,json=hashPolicy,proto3,enum=talos.resource.definitions.enums.NethelpersBondXmitHashPolicy" json:"hash_policy,omitempty"`
+	LacpRate      enums.NethelpersLACPRate           `protobuf:"varint,3,opt,name=lacp_rate,json=lacpRate,proto3,enum=talos.resource.definitions.enums.NethelpersLACPRate" json:"lacp_rate,omitempty"`
+	ActiveSlave   string                             `protobuf:"bytes,4,opt,name=active_slave,json=activeSlave,proto3" json:"active_slave,omitempty"`
+	AggregatorId  uint32                             `protobuf:"fixed32,5,opt,name=aggregator_id,json=aggregatorId,proto3" json:"aggregator_id,omitempty"`
+	NumPorts      uint32                             `protobuf:"fixed32,6,opt,name=num_ports,json=numPorts,proto3" json:"num_ports,omitempty"`
+	ActorKey      uint32                             `protobuf:"fixed32,7,opt,name=actor_key,json=actorKey,proto3" json:"actor_key,omitempty"`
+	PartnerKey    uint32                             `protobuf:"fixed32,8,opt,name=partner_key,json=partnerKey,proto3" json:"partner_key,omitempty"`
+	PartnerMac    []byte                             `protobuf:"bytes,9,opt,name=partner_mac,json=partnerMac,proto3" json:"partner_mac,omitempty"`
+	Slaves        []*BondSlaveStatus                 `protobuf:"bytes,10,rep,name=slaves,proto3" json:"slaves,omitempty"`
+	ArpTargets    []*BondARPTargetStatus             `protobuf:"bytes,11,rep,name=arp_targets,json=arpTargets,proto3" json:"arp_targets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BridgeVLANSpec) Reset() {
-	*x = BridgeVLANSpec{}
+func (x *BondStatusSpec) Reset() {
+	*x = BondStatusSpec{}
 	mi := &file_resource_definitions_network_network_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BridgeVLANSpec) String() string {
+func (x *BondStatusSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BridgeVLANSpec) ProtoMessage() {}
+func (*BondStatusSpec) ProtoMessage() {}
 
-func (x *BridgeVLANSpec) ProtoReflect() protoreflect.Message {
+func (x *BondStatusSpec) ProtoReflect() protoreflect.Message {
 	mi := &file_resource_definitions_network_network_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -666,96 +749,112 @@ func (x *BridgeVLANSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BridgeVLANSpec.ProtoReflect.Descriptor instead.
-func (*BridgeVLANSpec) Descriptor() ([]byte, []int) {
+// Deprecated: Use BondStatusSpec.ProtoReflect.Descriptor instead.
+func (*BondStatusSpec) Descriptor() ([]byte, []int) {
 	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *BridgeVLANSpec) GetFilteringEnabled() bool {
+func (x *BondStatusSpec) GetMode() enums.NethelpersBondMode {
 	if x != nil {
-		return x.FilteringEnabled
+		return x.Mode
 	}
-	return false
+	return enums.NethelpersBondMode(0)
 }
 
-// DHCP4OperatorSpec describes DHCP4 operator options.
-type DHCP4OperatorSpec struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	RouteMetric         uint32                 `protobuf:"varint,1,opt,name=route_metric,json=routeMetric,proto3" json:"route_metric,omitempty"`
-	SkipHostnameRequest bool                   `protobuf:"varint,2,opt,name=skip_hostname_request,json=skipHostnameRequest,proto3" json:"skip_hostname_request,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+func (x *BondStatusSpec) GetHashPolicy() enums.NethelpersBondXmitHashPolicy {
+	if x != nil {
+		return x.HashPolicy
+	}
+	return enums.NethelpersBondXmitHashPolicy(0)
 }
 
-func (x *DHCP4OperatorSpec) Reset() {
-	*x = DHCP4OperatorSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[7]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *BondStatusSpec) GetLacpRate() enums.NethelpersLACPRate {
+	if x != nil {
+		return x.LacpRate
+	}
+	return enums.NethelpersLACPRate(0)
 }
 
-func (x *DHCP4OperatorSpec) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *BondStatusSpec) GetActiveSlave() string {
+	if x != nil {
+		return x.ActiveSlave
+	}
+	return ""
 }
 
-func (*DHCP4OperatorSpec) ProtoMessage() {}
+func (x *BondStatusSpec) GetAggregatorId() uint32 {
+	if x != nil {
+		return x.AggregatorId
+	}
+	return 0
+}
 
-func (x *DHCP4OperatorSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[7]
+func (x *BondStatusSpec) GetNumPorts() uint32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.NumPorts
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use DHCP4OperatorSpec.ProtoReflect.Descriptor instead.
-func (*DHCP4OperatorSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{7}
+func (x *BondStatusSpec) GetActorKey() uint32 {
+	if x != nil {
+		return x.ActorKey
+	}
+	return 0
 }
 
-func (x *DHCP4OperatorSpec) GetRouteMetric() uint32 {
+func (x *BondStatusSpec) GetPartnerKey() uint32 {
 	if x != nil {
-		return x.RouteMetric
+		return x.PartnerKey
 	}
 	return 0
 }
 
-func (x *DHCP4OperatorSpec) GetSkipHostnameRequest() bool {
+func (x *BondStatusSpec) GetPartnerMac() []byte {
 	if x != nil {
-		return x.SkipHostnameRequest
+		return x.PartnerMac
 	}
-	return false
+	return nil
 }
 
-// DHCP6OperatorSpec describes DHCP6 operator options.
-type DHCP6OperatorSpec struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	Duid                string                 `protobuf:"bytes,1,opt,name=duid,proto3" json:"duid,omitempty"`
-	RouteMetric         uint32                 `protobuf:"varint,2,opt,name=route_metric,json=routeMetric,proto3" json:"route_metric,omitempty"`
-	SkipHostnameRequest bool                   `protobuf:"varint,3,opt,name=skip_hostname_request,json=skipHostnameRequest,proto3" json:"skip_hostname_request,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+func (x *BondStatusSpec) GetSlaves() []*BondSlaveStatus {
+	if x != nil {
+		return x.Slaves
+	}
+	return nil
 }
 
-func (x *DHCP6OperatorSpec) Reset() {
-	*x = DHCP6OperatorSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[8]
+func (x *BondStatusSpec) GetArpTargets() []*BondARPTargetStatus {
+	if x != nil {
+		return x.ArpTargets
+	}
+	return nil
+}
+
+// BridgeMasterSpec describes bridge settings if Kind == "bridge".
+type BridgeMasterSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stp           *STPSpec               `protobuf:"bytes,1,opt,name=stp,proto3" json:"stp,omitempty"`
+	Vlan          *BridgeVLANSpec        `protobuf:"bytes,2,opt,name=vlan,proto3" json:"vlan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BridgeMasterSpec) Reset() {
+	*x = BridgeMasterSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DHCP6OperatorSpec) String() string {
+func (x *BridgeMasterSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DHCP6OperatorSpec) ProtoMessage() {}
+func (*BridgeMasterSpec) ProtoMessage() {}
 
-func (x *DHCP6OperatorSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[8]
+func (x *BridgeMasterSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -766,55 +865,49 @@ func (x *DHCP6OperatorSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DHCP6OperatorSpec.ProtoReflect.Descriptor instead.
-func (*DHCP6OperatorSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use BridgeMasterSpec.ProtoReflect.Descriptor instead.
+func (*BridgeMasterSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *DHCP6OperatorSpec) GetDuid() string {
+func (x *BridgeMasterSpec) GetStp() *STPSpec {
 	if x != nil {
-		return x.Duid
+		return x.Stp
 	}
-	return ""
+	return nil
 }
 
-func (x *DHCP6OperatorSpec) GetRouteMetric() uint32 {
-	if x != nil {
-		return x.RouteMetric
-	}
-	return 0
-}
-
-func (x *DHCP6OperatorSpec) GetSkipHostnameRequest() bool {
+func (x *BridgeMasterSpec) GetVlan() *BridgeVLANSpec {
 	if x != nil {
-		return x.SkipHostnameRequest
+		return x.Vlan
 	}
-	return false
+	return nil
 }
 
-// DNSResolveCacheSpec describes DNS servers status.
-type DNSResolveCacheSpec struct {
+// BridgePortStatus describes the runtime state of the bridge port.
+type BridgePortStatus struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	VlaNs         []*BridgeVLANEntry     `protobuf:"bytes,2,rep,name=vla_ns,json=vlaNs,proto3" json:"vla_ns,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DNSResolveCacheSpec) Reset() {
-	*x = DNSResolveCacheSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[9]
+func (x *BridgePortStatus) Reset() {
+	*x = BridgePortStatus{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DNSResolveCacheSpec) String() string {
+func (x *BridgePortStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DNSResolveCacheSpec) ProtoMessage() {}
+func (*BridgePortStatus) ProtoMessage() {}
 
-func (x *DNSResolveCacheSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[9]
+func (x *BridgePortStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -825,44 +918,49 @@ func (x *DNSResolveCacheSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DNSResolveCacheSpec.ProtoReflect.Descriptor instead.
-func (*DNSResolveCacheSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use BridgePortStatus.ProtoReflect.Descriptor instead.
+func (*BridgePortStatus) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *DNSResolveCacheSpec) GetStatus() string {
+func (x *BridgePortStatus) GetName() string {
 	if x != nil {
-		return x.Status
+		return x.Name
 	}
 	return ""
 }
 
-// EthernetChannelsSpec describes config of Ethernet channels.
-type EthernetChannelsSpec struct {
+func (x *BridgePortStatus) GetVlaNs() []*BridgeVLANEntry {
+	if x != nil {
+		return x.VlaNs
+	}
+	return nil
+}
+
+// BridgeSlave contains the name of the master bridge of a bridged interface
+type BridgeSlave struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rx            uint32                 `protobuf:"varint,1,opt,name=rx,proto3" json:"rx,omitempty"`
-	Tx            uint32                 `protobuf:"varint,2,opt,name=tx,proto3" json:"tx,omitempty"`
-	Other         uint32                 `protobuf:"varint,3,opt,name=other,proto3" json:"other,omitempty"`
-	Combined      uint32                 `protobuf:"varint,4,opt,name=combined,proto3" json:"combined,omitempty"`
+	MasterName    string                 `protobuf:"bytes,1,opt,name=master_name,json=masterName,proto3" json:"master_name,omitempty"`
+	VlaNs         []*BridgeVLANEntry     `protobuf:"bytes,2,rep,name=vla_ns,json=vlaNs,proto3" json:"vla_ns,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EthernetChannelsSpec) Reset() {
-	*x = EthernetChannelsSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[10]
+func (x *BridgeSlave) Reset() {
+	*x = BridgeSlave{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EthernetChannelsSpec) String() string {
+func (x *BridgeSlave) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EthernetChannelsSpec) ProtoMessage() {}
+func (*BridgeSlave) ProtoMessage() {}
 
-func (x *EthernetChannelsSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[10]
+func (x *BridgeSlave) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -873,69 +971,49 @@ func (x *EthernetChannelsSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EthernetChannelsSpec.ProtoReflect.Descriptor instead.
-func (*EthernetChannelsSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *EthernetChannelsSpec) GetRx() uint32 {
-	if x != nil {
-		return x.Rx
-	}
-	return 0
-}
-
-func (x *EthernetChannelsSpec) GetTx() uint32 {
-	if x != nil {
-		return x.Tx
-	}
-	return 0
+// Deprecated: Use BridgeSlave.ProtoReflect.Descriptor instead.
+func (*BridgeSlave) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *EthernetChannelsSpec) GetOther() uint32 {
+func (x *BridgeSlave) GetMasterName() string {
 	if x != nil {
-		return x.Other
+		return x.MasterName
 	}
-	return 0
+	return ""
 }
 
-func (x *EthernetChannelsSpec) GetCombined() uint32 {
+func (x *BridgeSlave) GetVlaNs() []*BridgeVLANEntry {
 	if x != nil {
-		return x.Combined
+		return x.VlaNs
 	}
-	return 0
+	return nil
 }
 
-// EthernetChannelsStatus describes status of Ethernet channels.
-type EthernetChannelsStatus struct {
+// BridgeStatusSpec describes the runtime state of the bridge link.
+type BridgeStatusSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RxMax         uint32                 `protobuf:"varint,1,opt,name=rx_max,json=rxMax,proto3" json:"rx_max,omitempty"`
-	TxMax         uint32                 `protobuf:"varint,2,opt,name=tx_max,json=txMax,proto3" json:"tx_max,omitempty"`
-	OtherMax      uint32                 `protobuf:"varint,3,opt,name=other_max,json=otherMax,proto3" json:"other_max,omitempty"`
-	CombinedMax   uint32                 `protobuf:"varint,4,opt,name=combined_max,json=combinedMax,proto3" json:"combined_max,omitempty"`
-	Rx            uint32                 `protobuf:"varint,5,opt,name=rx,proto3" json:"rx,omitempty"`
-	Tx            uint32                 `protobuf:"varint,6,opt,name=tx,proto3" json:"tx,omitempty"`
-	Other         uint32                 `protobuf:"varint,7,opt,name=other,proto3" json:"other,omitempty"`
-	Combined      uint32                 `protobuf:"varint,8,opt,name=combined,proto3" json:"combined,omitempty"`
+	VlanFiltering bool                   `protobuf:"varint,1,opt,name=vlan_filtering,json=vlanFiltering,proto3" json:"vlan_filtering,omitempty"`
+	Ports         []*BridgePortStatus    `protobuf:"bytes,2,rep,name=ports,proto3" json:"ports,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EthernetChannelsStatus) Reset() {
-	*x = EthernetChannelsStatus{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[11]
+func (x *BridgeStatusSpec) Reset() {
+	*x = BridgeStatusSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EthernetChannelsStatus) String() string {
+func (x *BridgeStatusSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EthernetChannelsStatus) ProtoMessage() {}
+func (*BridgeStatusSpec) ProtoMessage() {}
 
-func (x *EthernetChannelsStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[11]
+func (x *BridgeStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -946,90 +1024,108 @@ func (x *EthernetChannelsStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EthernetChannelsStatus.ProtoReflect.Descriptor instead.
-func (*EthernetChannelsStatus) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use BridgeStatusSpec.ProtoReflect.Descriptor instead.
+func (*BridgeStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *EthernetChannelsStatus) GetRxMax() uint32 {
+func (x *BridgeStatusSpec) GetVlanFiltering() bool {
 	if x != nil {
-		return x.RxMax
+		return x.VlanFiltering
 	}
-	return 0
+	return false
 }
 
-func (x *EthernetChannelsStatus) GetTxMax() uint32 {
+func (x *BridgeStatusSpec) GetPorts() []*BridgePortStatus {
 	if x != nil {
-		return x.TxMax
+		return x.Ports
 	}
-	return 0
+	return nil
 }
 
-func (x *EthernetChannelsStatus) GetOtherMax() uint32 {
-	if x != nil {
-		return x.OtherMax
-	}
-	return 0
+// BridgeVLANEntry describes a VLAN of the bridge port.
+type BridgeVLANEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vid           uint32                 `protobuf:"varint,1,opt,name=vid,proto3" json:"vid,omitempty"`
+	Pvid          bool                   `protobuf:"varint,2,opt,name=pvid,proto3" json:"pvid,omitempty"`
+	Untagged      bool                   `protobuf:"varint,3,opt,name=untagged,proto3" json:"untagged,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EthernetChannelsStatus) GetCombinedMax() uint32 {
-	if x != nil {
-		return x.CombinedMax
-	}
-	return 0
+func (x *BridgeVLANEntry) Reset() {
+	*x = BridgeVLANEntry{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *EthernetChannelsStatus) GetRx() uint32 {
+func (x *BridgeVLANEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BridgeVLANEntry) ProtoMessage() {}
+
+func (x *BridgeVLANEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[11]
 	if x != nil {
-		return x.Rx
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *EthernetChannelsStatus) GetTx() uint32 {
+// Deprecated: Use BridgeVLANEntry.ProtoReflect.Descriptor instead.
+func (*BridgeVLANEntry) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BridgeVLANEntry) GetVid() uint32 {
 	if x != nil {
-		return x.Tx
+		return x.Vid
 	}
 	return 0
 }
 
-func (x *EthernetChannelsStatus) GetOther() uint32 {
+func (x *BridgeVLANEntry) GetPvid() bool {
 	if x != nil {
-		return x.Other
+		return x.Pvid
 	}
-	return 0
+	return false
 }
 
-func (x *EthernetChannelsStatus) GetCombined() uint32 {
+func (x *BridgeVLANEntry) GetUntagged() bool {
 	if x != nil {
-		return x.Combined
+		return x.Untagged
 	}
-	return 0
+	return false
 }
 
-// EthernetFeatureStatus describes status of Ethernet features.
-type EthernetFeatureStatus struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// BridgeVLANSpec describes VLAN settings of a bridge.
+type BridgeVLANSpec struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	FilteringEnabled bool                   `protobuf:"varint,1,opt,name=filtering_enabled,json=filteringEnabled,proto3" json:"filtering_enabled,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *EthernetFeatureStatus) Reset() {
-	*x = EthernetFeatureStatus{}
+func (x *BridgeVLANSpec) Reset() {
+	*x = BridgeVLANSpec{}
 	mi := &file_resource_definitions_network_network_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EthernetFeatureStatus) String() string {
+func (x *BridgeVLANSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EthernetFeatureStatus) ProtoMessage() {}
+func (*BridgeVLANSpec) ProtoMessage() {}
 
-func (x *EthernetFeatureStatus) ProtoReflect() protoreflect.Message {
+func (x *BridgeVLANSpec) ProtoReflect() protoreflect.Message {
 	mi := &file_resource_definitions_network_network_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1041,56 +1137,47 @@ func (x *EthernetFeatureStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EthernetFeatureStatus.ProtoReflect.Descriptor instead.
-func (*EthernetFeatureStatus) Descriptor() ([]byte, []int) {
+// Deprecated: Use BridgeVLANSpec.ProtoReflect.Descriptor instead.
+func (*BridgeVLANSpec) Descriptor() ([]byte, []int) {
 	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *EthernetFeatureStatus) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *EthernetFeatureStatus) GetStatus() string {
+func (x *BridgeVLANSpec) GetFilteringEnabled() bool {
 	if x != nil {
-		return x.Status
+		return x.FilteringEnabled
 	}
-	return ""
+	return false
 }
 
-// EthernetRingsSpec describes config of Ethernet rings.
-type EthernetRingsSpec struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rx            uint32                 `protobuf:"varint,1,opt,name=rx,proto3" json:"rx,omitempty"`
-	RxMini        uint32                 `protobuf:"varint,2,opt,name=rx_mini,json=rxMini,proto3" json:"rx_mini,omitempty"`
-	RxJumbo       uint32                 `protobuf:"varint,3,opt,name=rx_jumbo,json=rxJumbo,proto3" json:"rx_jumbo,omitempty"`
-	Tx            uint32                 `protobuf:"varint,4,opt,name=tx,proto3" json:"tx,omitempty"`
-	RxBufLen      uint32                 `protobuf:"varint,5,opt,name=rx_buf_len,json=rxBufLen,proto3" json:"rx_buf_len,omitempty"`
-	CqeSize       uint32                 `protobuf:"varint,6,opt,name=cqe_size,json=cqeSize,proto3" json:"cqe_size,omitempty"`
-	TxPush        bool                   `protobuf:"varint,7,opt,name=tx_push,json=txPush,proto3" json:"tx_push,omitempty"`
-	RxPush        bool                   `protobuf:"varint,8,opt,name=rx_push,json=rxPush,proto3" json:"rx_push,omitempty"`
-	TxPushBufLen  uint32                 `protobuf:"varint,9,opt,name=tx_push_buf_len,json=txPushBufLen,proto3" json:"tx_push_buf_len,omitempty"`
-	TcpDataSplit  bool                   `protobuf:"varint,10,opt,name=tcp_data_split,json=tcpDataSplit,proto3" json:"tcp_data_split,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// DHCP4LeaseSpec describes a DHCPv4 lease.
+type DHCP4LeaseSpec struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	LinkName         string                 `protobuf:"bytes,1,opt,name=link_name,json=linkName,proto3" json:"link_name,omitempty"`
+	Address          *common.NetIPPrefix    `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	ServerId         *common.NetIP          `protobuf:"bytes,3,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	LeaseTime        *durationpb.Duration   `protobuf:"bytes,4,opt,name=lease_time,json=leaseTime,proto3" json:"lease_time,omitempty"`
+	Expiry           *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	Ack              []byte                 `protobuf:"bytes,6,opt,name=ack,proto3" json:"ack,omitempty"`
+	ClientIdentifier string                 `protobuf:"bytes,7,opt,name=client_identifier,json=clientIdentifier,proto3" json:"client_identifier,omitempty"`
+	Hostname         string                 `protobuf:"bytes,8,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *EthernetRingsSpec) Reset() {
-	*x = EthernetRingsSpec{}
+func (x *DHCP4LeaseSpec) Reset() {
+	*x = DHCP4LeaseSpec{}
 	mi := &file_resource_definitions_network_network_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EthernetRingsSpec) String() string {
+func (x *DHCP4LeaseSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EthernetRingsSpec) ProtoMessage() {}
+func (*DHCP4LeaseSpec) ProtoMessage() {}
 
-func (x *EthernetRingsSpec) ProtoReflect() protoreflect.Message {
+func (x *DHCP4LeaseSpec) ProtoReflect() protoreflect.Message {
 	mi := &file_resource_definitions_network_network_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1102,117 +1189,95 @@ func (x *EthernetRingsSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EthernetRingsSpec.ProtoReflect.Descriptor instead.
-func (*EthernetRingsSpec) Descriptor() ([]byte, []int) {
+// Deprecated: Use DHCP4LeaseSpec.ProtoReflect.Descriptor instead.
+func (*DHCP4LeaseSpec) Descriptor() ([]byte, []int) {
 	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *EthernetRingsSpec) GetRx() uint32 {
+func (x *DHCP4LeaseSpec) GetLinkName() string {
 	if x != nil {
-		return x.Rx
+		return x.LinkName
 	}
-	return 0
+	return ""
 }
 
-func (x *EthernetRingsSpec) GetRxMini() uint32 {
+func (x *DHCP4LeaseSpec) GetAddress() *common.NetIPPrefix {
 	if x != nil {
-		return x.RxMini
+		return x.Address
 	}
-	return 0
+	return nil
 }
 
-func (x *EthernetRingsSpec) GetRxJumbo() uint32 {
+func (x *DHCP4LeaseSpec) GetServerId() *common.NetIP {
 	if x != nil {
-		return x.RxJumbo
+		return x.ServerId
 	}
-	return 0
+	return nil
 }
 
-func (x *EthernetRingsSpec) GetTx() uint32 {
+func (x *DHCP4LeaseSpec) GetLeaseTime() *durationpb.Duration {
 	if x != nil {
-		return x.Tx
+		return x.LeaseTime
 	}
-	return 0
+	return nil
 }
 
-func (x *EthernetRingsSpec) GetRxBufLen() uint32 {
+func (x *DHCP4LeaseSpec) GetExpiry() *timestamppb.Timestamp {
 	if x != nil {
-		return x.RxBufLen
+		return x.Expiry
 	}
-	return 0
+	return nil
 }
 
-func (x *EthernetRingsSpec) GetCqeSize() uint32 {
+func (x *DHCP4LeaseSpec) GetAck() []byte {
 	if x != nil {
-		return x.CqeSize
+		return x.Ack
 	}
-	return 0
+	return nil
 }
 
-func (x *EthernetRingsSpec) GetTxPush() bool {
-	if x != nil {
-		return x.TxPush
-	}
-	return false
-}
-
-func (x *EthernetRingsSpec) GetRxPush() bool {
+func (x *DHCP4LeaseSpec) GetClientIdentifier() string {
 	if x != nil {
-		return x.RxPush
-	}
-	return false
-}
-
-func (x *EthernetRingsSpec) GetTxPushBufLen() uint32 {
-	if x != nil {
-		return x.TxPushBufLen
+		return x.ClientIdentifier
 	}
-	return 0
+	return ""
 }
 
-func (x *EthernetRingsSpec) GetTcpDataSplit() bool {
+func (x *DHCP4LeaseSpec) GetHostname() string {
 	if x != nil {
-		return x.TcpDataSplit
+		return x.Hostname
 	}
-	return false
+	return ""
 }
 
-// EthernetRingsStatus describes status of Ethernet rings.
-type EthernetRingsStatus struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	RxMax           uint32                 `protobuf:"varint,1,opt,name=rx_max,json=rxMax,proto3" json:"rx_max,omitempty"`
-	RxMiniMax       uint32                 `protobuf:"varint,2,opt,name=rx_mini_max,json=rxMiniMax,proto3" json:"rx_mini_max,omitempty"`
-	RxJumboMax      uint32                 `protobuf:"varint,3,opt,name=rx_jumbo_max,json=rxJumboMax,proto3" json:"rx_jumbo_max,omitempty"`
-	TxMax           uint32                 `protobuf:"varint,4,opt,name=tx_max,json=txMax,proto3" json:"tx_max,omitempty"`
-	TxPushBufLenMax uint32                 `protobuf:"varint,5,opt,name=tx_push_buf_len_max,json=txPushBufLenMax,proto3" json:"tx_push_buf_len_max,omitempty"`
-	Rx              uint32                 `protobuf:"varint,6,opt,name=rx,proto3" json:"rx,omitempty"`
-	RxMini          uint32                 `protobuf:"varint,7,opt,name=rx_mini,json=rxMini,proto3" json:"rx_mini,omitempty"`
-	RxJumbo         uint32                 `protobuf:"varint,8,opt,name=rx_jumbo,json=rxJumbo,proto3" json:"rx_jumbo,omitempty"`
-	Tx              uint32                 `protobuf:"varint,9,opt,name=tx,proto3" json:"tx,omitempty"`
-	RxBufLen        uint32                 `protobuf:"varint,10,opt,name=rx_buf_len,json=rxBufLen,proto3" json:"rx_buf_len,omitempty"`
-	CqeSize         uint32                 `protobuf:"varint,11,opt,name=cqe_size,json=cqeSize,proto3" json:"cqe_size,omitempty"`
-	TxPush          bool                   `protobuf:"varint,12,opt,name=tx_push,json=txPush,proto3" json:"tx_push,omitempty"`
-	RxPush          bool                   `protobuf:"varint,13,opt,name=rx_push,json=rxPush,proto3" json:"rx_push,omitempty"`
-	TxPushBufLen    uint32                 `protobuf:"varint,14,opt,name=tx_push_buf_len,json=txPushBufLen,proto3" json:"tx_push_buf_len,omitempty"`
-	TcpDataSplit    bool                   `protobuf:"varint,15,opt,name=tcp_data_split,json=tcpDataSplit,proto3" json:"tcp_data_split,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// DHCP4OperatorSpec describes DHCP4 operator options.
+type DHCP4OperatorSpec struct {
+	state                       protoimpl.MessageState `protogen:"open.v1"`
+	RouteMetric                 uint32                 `protobuf:"varint,1,opt,name=route_metric,json=routeMetric,proto3" json:"route_metric,omitempty"`
+	SkipHostnameRequest         bool                   `protobuf:"varint,2,opt,name=skip_hostname_request,json=skipHostnameRequest,proto3" json:"skip_hostname_request,omitempty"`
+	IgnoreClasslessStaticRoutes bool                   `protobuf:"varint,3,opt,name=ignore_classless_static_routes,json=ignoreClasslessStaticRoutes,proto3" json:"ignore_classless_static_routes,omitempty"`
+	OptimisticLease             bool                   `protobuf:"varint,4,opt,name=optimistic_lease,json=optimisticLease,proto3" json:"optimistic_lease,omitempty"`
+	ClientIdentifier            string                 `protobuf:"bytes,5,opt,name=client_identifier,json=clientIdentifier,proto3" json:"client_identifier,omitempty"`
+	SkipSendHostname            bool                   `protobuf:"varint,6,opt,name=skip_send_hostname,json=skipSendHostname,proto3" json:"skip_send_hostname,omitempty"`
+	RequestedOptions            []uint32               `protobuf:"varint,7,rep,packed,name=requested_options,json=requestedOptions,proto3" json:"requested_options,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
 }
 
-func (x *EthernetRingsStatus) Reset() {
-	*x = EthernetRingsStatus{}
+func (x *DHCP4OperatorSpec) Reset() {
+	*x = DHCP4OperatorSpec{}
 	mi := &file_resource_definitions_network_network_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EthernetRingsStatus) String() string {
+func (x *DHCP4OperatorSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EthernetRingsStatus) ProtoMessage() {}
+func (*DHCP4OperatorSpec) ProtoMessage() {}
 
-func (x *EthernetRingsStatus) ProtoReflect() protoreflect.Message {
+func (x *DHCP4OperatorSpec) ProtoReflect() protoreflect.Message {
 	mi := &file_resource_definitions_network_network_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1224,141 +1289,153 @@ func (x *EthernetRingsStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EthernetRingsStatus.ProtoReflect.Descriptor instead.
-func (*EthernetRingsStatus) Descriptor() ([]byte, []int) {
+// Deprecated: Use DHCP4OperatorSpec.ProtoReflect.Descriptor instead.
+func (*DHCP4OperatorSpec) Descriptor() ([]byte, []int) {
 	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *EthernetRingsStatus) GetRxMax() uint32 {
+func (x *DHCP4OperatorSpec) GetRouteMetric() uint32 {
 	if x != nil {
-		return x.RxMax
+		return x.RouteMetric
 	}
 	return 0
 }
 
-func (x *EthernetRingsStatus) GetRxMiniMax() uint32 {
+func (x *DHCP4OperatorSpec) GetSkipHostnameRequest() bool {
 	if x != nil {
-		return x.RxMiniMax
+		return x.SkipHostnameRequest
 	}
-	return 0
+	return false
 }
 
-func (x *EthernetRingsStatus) GetRxJumboMax() uint32 {
+func (x *DHCP4OperatorSpec) GetIgnoreClasslessStaticRoutes() bool {
 	if x != nil {
-		return x.RxJumboMax
+		return x.IgnoreClasslessStaticRoutes
 	}
-	return 0
+	return false
 }
 
-func (x *EthernetRingsStatus) GetTxMax() uint32 {
+func (x *DHCP4OperatorSpec) GetOptimisticLease() bool {
 	if x != nil {
-		return x.TxMax
+		return x.OptimisticLease
 	}
-	return 0
+	return false
 }
 
-func (x *EthernetRingsStatus) GetTxPushBufLenMax() uint32 {
+func (x *DHCP4OperatorSpec) GetClientIdentifier() string {
 	if x != nil {
-		return x.TxPushBufLenMax
+		return x.ClientIdentifier
 	}
-	return 0
+	return ""
 }
 
-func (x *EthernetRingsStatus) GetRx() uint32 {
+func (x *DHCP4OperatorSpec) GetSkipSendHostname() bool {
 	if x != nil {
-		return x.Rx
+		return x.SkipSendHostname
 	}
-	return 0
+	return false
 }
 
-func (x *EthernetRingsStatus) GetRxMini() uint32 {
+func (x *DHCP4OperatorSpec) GetRequestedOptions() []uint32 {
 	if x != nil {
-		return x.RxMini
+		return x.RequestedOptions
 	}
-	return 0
+	return nil
 }
 
-func (x *EthernetRingsStatus) GetRxJumbo() uint32 {
-	if x != nil {
-		return x.RxJumbo
-	}
-	return 0
+// DHCP6OperatorSpec describes DHCP6 operator options.
+type DHCP6OperatorSpec struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Duid                string                 `protobuf:"bytes,1,opt,name=duid,proto3" json:"duid,omitempty"`
+	RouteMetric         uint32                 `protobuf:"varint,2,opt,name=route_metric,json=routeMetric,proto3" json:"route_metric,omitempty"`
+	SkipHostnameRequest bool                   `protobuf:"varint,3,opt,name=skip_hostname_request,json=skipHostnameRequest,proto3" json:"skip_hostname_request,omitempty"`
+	Iaid                uint32                 `protobuf:"varint,4,opt,name=iaid,proto3" json:"iaid,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *EthernetRingsStatus) GetTx() uint32 {
-	if x != nil {
-		return x.Tx
-	}
-	return 0
+func (x *DHCP6OperatorSpec) Reset() {
+	*x = DHCP6OperatorSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *EthernetRingsStatus) GetRxBufLen() uint32 {
-	if x != nil {
-		return x.RxBufLen
-	}
-	return 0
+func (x *DHCP6OperatorSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *EthernetRingsStatus) GetCqeSize() uint32 {
+func (*DHCP6OperatorSpec) ProtoMessage() {}
+
+func (x *DHCP6OperatorSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[15]
 	if x != nil {
-		return x.CqeSize
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *EthernetRingsStatus) GetTxPush() bool {
-	if x != nil {
-		return x.TxPush
-	}
-	return false
+// Deprecated: Use DHCP6OperatorSpec.ProtoReflect.Descriptor instead.
+func (*DHCP6OperatorSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *EthernetRingsStatus) GetRxPush() bool {
+func (x *DHCP6OperatorSpec) GetDuid() string {
 	if x != nil {
-		return x.RxPush
+		return x.Duid
 	}
-	return false
+	return ""
 }
 
-func (x *EthernetRingsStatus) GetTxPushBufLen() uint32 {
+func (x *DHCP6OperatorSpec) GetRouteMetric() uint32 {
 	if x != nil {
-		return x.TxPushBufLen
+		return x.RouteMetric
 	}
 	return 0
 }
 
-func (x *EthernetRingsStatus) GetTcpDataSplit() bool {
+func (x *DHCP6OperatorSpec) GetSkipHostnameRequest() bool {
 	if x != nil {
-		return x.TcpDataSplit
+		return x.SkipHostnameRequest
 	}
 	return false
 }
 
-// EthernetSpecSpec describes config of Ethernet link.
-type EthernetSpecSpec struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rings         *EthernetRingsSpec     `protobuf:"bytes,1,opt,name=rings,proto3" json:"rings,omitempty"`
-	Features      map[string]bool        `protobuf:"bytes,2,rep,name=features,proto3" json:"features,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
-	Channels      *EthernetChannelsSpec  `protobuf:"bytes,3,opt,name=channels,proto3" json:"channels,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *DHCP6OperatorSpec) GetIaid() uint32 {
+	if x != nil {
+		return x.Iaid
+	}
+	return 0
 }
 
-func (x *EthernetSpecSpec) Reset() {
-	*x = EthernetSpecSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[15]
+// DNSResolveCacheSpec describes DNS servers status.
+type DNSResolveCacheSpec struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Status          string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	RejectedQueries uint64                 `protobuf:"varint,2,opt,name=rejected_queries,json=rejectedQueries,proto3" json:"rejected_queries,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DNSResolveCacheSpec) Reset() {
+	*x = DNSResolveCacheSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EthernetSpecSpec) String() string {
+func (x *DNSResolveCacheSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EthernetSpecSpec) ProtoMessage() {}
+func (*DNSResolveCacheSpec) ProtoMessage() {}
 
-func (x *EthernetSpecSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[15]
+func (x *DNSResolveCacheSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1369,63 +1446,51 @@ func (x *EthernetSpecSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EthernetSpecSpec.ProtoReflect.Descriptor instead.
-func (*EthernetSpecSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *EthernetSpecSpec) GetRings() *EthernetRingsSpec {
-	if x != nil {
-		return x.Rings
-	}
-	return nil
+// Deprecated: Use DNSResolveCacheSpec.ProtoReflect.Descriptor instead.
+func (*DNSResolveCacheSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *EthernetSpecSpec) GetFeatures() map[string]bool {
+func (x *DNSResolveCacheSpec) GetStatus() string {
 	if x != nil {
-		return x.Features
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-func (x *EthernetSpecSpec) GetChannels() *EthernetChannelsSpec {
+func (x *DNSResolveCacheSpec) GetRejectedQueries() uint64 {
 	if x != nil {
-		return x.Channels
+		return x.RejectedQueries
 	}
-	return nil
+	return 0
 }
 
-// EthernetStatusSpec describes status of rendered secrets.
-type EthernetStatusSpec struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	LinkState     bool                     `protobuf:"varint,1,opt,name=link_state,json=linkState,proto3" json:"link_state,omitempty"`
-	SpeedMegabits int64                    `protobuf:"varint,2,opt,name=speed_megabits,json=speedMegabits,proto3" json:"speed_megabits,omitempty"`
-	Port          enums.NethelpersPort     `protobuf:"varint,3,opt,name=port,proto3,enum=talos.resource.definitions.enums.NethelpersPort" json:"port,omitempty"`
-	Duplex        enums.NethelpersDuplex   `protobuf:"varint,4,opt,name=duplex,proto3,enum=talos.resource.definitions.enums.NethelpersDuplex" json:"duplex,omitempty"`
-	OurModes      []string                 `protobuf:"bytes,5,rep,name=our_modes,json=ourModes,proto3" json:"our_modes,omitempty"`
-	PeerModes     []string                 `protobuf:"bytes,6,rep,name=peer_modes,json=peerModes,proto3" json:"peer_modes,omitempty"`
-	Rings         *EthernetRingsStatus     `protobuf:"bytes,7,opt,name=rings,proto3" json:"rings,omitempty"`
-	Features      []*EthernetFeatureStatus `protobuf:"bytes,8,rep,name=features,proto3" json:"features,omitempty"`
-	Channels      *EthernetChannelsStatus  `protobuf:"bytes,9,opt,name=channels,proto3" json:"channels,omitempty"`
+// DefaultRouteStatusSpec describes the link which owns the default route of the address family.
+type DefaultRouteStatusSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Family        enums.NethelpersFamily `protobuf:"varint,1,opt,name=family,proto3,enum=talos.resource.definitions.enums.NethelpersFamily" json:"family,omitempty"`
+	LinkName      string                 `protobuf:"bytes,2,opt,name=link_name,json=linkName,proto3" json:"link_name,omitempty"`
+	Candidates    []string               `protobuf:"bytes,3,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EthernetStatusSpec) Reset() {
-	*x = EthernetStatusSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[16]
+func (x *DefaultRouteStatusSpec) Reset() {
+	*x = DefaultRouteStatusSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EthernetStatusSpec) String() string {
+func (x *DefaultRouteStatusSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EthernetStatusSpec) ProtoMessage() {}
+func (*DefaultRouteStatusSpec) ProtoMessage() {}
 
-func (x *EthernetStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[16]
+func (x *DefaultRouteStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1436,98 +1501,65 @@ func (x *EthernetStatusSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EthernetStatusSpec.ProtoReflect.Descriptor instead.
-func (*EthernetStatusSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use DefaultRouteStatusSpec.ProtoReflect.Descriptor instead.
+func (*DefaultRouteStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *EthernetStatusSpec) GetLinkState() bool {
+func (x *DefaultRouteStatusSpec) GetFamily() enums.NethelpersFamily {
 	if x != nil {
-		return x.LinkState
+		return x.Family
 	}
-	return false
+	return enums.NethelpersFamily(0)
 }
 
-func (x *EthernetStatusSpec) GetSpeedMegabits() int64 {
+func (x *DefaultRouteStatusSpec) GetLinkName() string {
 	if x != nil {
-		return x.SpeedMegabits
+		return x.LinkName
 	}
-	return 0
+	return ""
 }
 
-func (x *EthernetStatusSpec) GetPort() enums.NethelpersPort {
+func (x *DefaultRouteStatusSpec) GetCandidates() []string {
 	if x != nil {
-		return x.Port
+		return x.Candidates
 	}
-	return enums.NethelpersPort(0)
+	return nil
 }
 
-func (x *EthernetStatusSpec) GetDuplex() enums.NethelpersDuplex {
+func (x *DefaultRouteStatusSpec) GetReason() string {
 	if x != nil {
-		return x.Duplex
+		return x.Reason
 	}
-	return enums.NethelpersDuplex(0)
+	return ""
 }
 
-func (x *EthernetStatusSpec) GetOurModes() []string {
-	if x != nil {
-		return x.OurModes
-	}
-	return nil
-}
-
-func (x *EthernetStatusSpec) GetPeerModes() []string {
-	if x != nil {
-		return x.PeerModes
-	}
-	return nil
-}
-
-func (x *EthernetStatusSpec) GetRings() *EthernetRingsStatus {
-	if x != nil {
-		return x.Rings
-	}
-	return nil
-}
-
-func (x *EthernetStatusSpec) GetFeatures() []*EthernetFeatureStatus {
-	if x != nil {
-		return x.Features
-	}
-	return nil
-}
-
-func (x *EthernetStatusSpec) GetChannels() *EthernetChannelsStatus {
-	if x != nil {
-		return x.Channels
-	}
-	return nil
-}
-
-// HardwareAddrSpec describes spec for the link.
-type HardwareAddrSpec struct {
+// EthernetChannelsSpec describes config of Ethernet channels.
+type EthernetChannelsSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	HardwareAddr  []byte                 `protobuf:"bytes,2,opt,name=hardware_addr,json=hardwareAddr,proto3" json:"hardware_addr,omitempty"`
+	Rx            uint32                 `protobuf:"varint,1,opt,name=rx,proto3" json:"rx,omitempty"`
+	Tx            uint32                 `protobuf:"varint,2,opt,name=tx,proto3" json:"tx,omitempty"`
+	Other         uint32                 `protobuf:"varint,3,opt,name=other,proto3" json:"other,omitempty"`
+	Combined      uint32                 `protobuf:"varint,4,opt,name=combined,proto3" json:"combined,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HardwareAddrSpec) Reset() {
-	*x = HardwareAddrSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[17]
+func (x *EthernetChannelsSpec) Reset() {
+	*x = EthernetChannelsSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HardwareAddrSpec) String() string {
+func (x *EthernetChannelsSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HardwareAddrSpec) ProtoMessage() {}
+func (*EthernetChannelsSpec) ProtoMessage() {}
 
-func (x *HardwareAddrSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[17]
+func (x *EthernetChannelsSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1538,51 +1570,69 @@ func (x *HardwareAddrSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HardwareAddrSpec.ProtoReflect.Descriptor instead.
-func (*HardwareAddrSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use EthernetChannelsSpec.ProtoReflect.Descriptor instead.
+func (*EthernetChannelsSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *HardwareAddrSpec) GetName() string {
+func (x *EthernetChannelsSpec) GetRx() uint32 {
 	if x != nil {
-		return x.Name
+		return x.Rx
 	}
-	return ""
+	return 0
 }
 
-func (x *HardwareAddrSpec) GetHardwareAddr() []byte {
+func (x *EthernetChannelsSpec) GetTx() uint32 {
 	if x != nil {
-		return x.HardwareAddr
+		return x.Tx
 	}
-	return nil
+	return 0
 }
 
-// HostDNSConfigSpec describes host DNS config.
-type HostDNSConfigSpec struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	Enabled               bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	ListenAddresses       []*common.NetIPPort    `protobuf:"bytes,2,rep,name=listen_addresses,json=listenAddresses,proto3" json:"listen_addresses,omitempty"`
-	ServiceHostDnsAddress *common.NetIP          `protobuf:"bytes,3,opt,name=service_host_dns_address,json=serviceHostDnsAddress,proto3" json:"service_host_dns_address,omitempty"`
-	ResolveMemberNames    bool                   `protobuf:"varint,4,opt,name=resolve_member_names,json=resolveMemberNames,proto3" json:"resolve_member_names,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+func (x *EthernetChannelsSpec) GetOther() uint32 {
+	if x != nil {
+		return x.Other
+	}
+	return 0
 }
 
-func (x *HostDNSConfigSpec) Reset() {
-	*x = HostDNSConfigSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[18]
+func (x *EthernetChannelsSpec) GetCombined() uint32 {
+	if x != nil {
+		return x.Combined
+	}
+	return 0
+}
+
+// EthernetChannelsStatus describes status of Ethernet channels.
+type EthernetChannelsStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RxMax         uint32                 `protobuf:"varint,1,opt,name=rx_max,json=rxMax,proto3" json:"rx_max,omitempty"`
+	TxMax         uint32                 `protobuf:"varint,2,opt,name=tx_max,json=txMax,proto3" json:"tx_max,omitempty"`
+	OtherMax      uint32                 `protobuf:"varint,3,opt,name=other_max,json=otherMax,proto3" json:"other_max,omitempty"`
+	CombinedMax   uint32                 `protobuf:"varint,4,opt,name=combined_max,json=combinedMax,proto3" json:"combined_max,omitempty"`
+	Rx            uint32                 `protobuf:"varint,5,opt,name=rx,proto3" json:"rx,omitempty"`
+	Tx            uint32                 `protobuf:"varint,6,opt,name=tx,proto3" json:"tx,omitempty"`
+	Other         uint32                 `protobuf:"varint,7,opt,name=other,proto3" json:"other,omitempty"`
+	Combined      uint32                 `protobuf:"varint,8,opt,name=combined,proto3" json:"combined,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EthernetChannelsStatus) Reset() {
+	*x = EthernetChannelsStatus{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HostDNSConfigSpec) String() string {
+func (x *EthernetChannelsStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostDNSConfigSpec) ProtoMessage() {}
+func (*EthernetChannelsStatus) ProtoMessage() {}
 
-func (x *HostDNSConfigSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[18]
+func (x *EthernetChannelsStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1593,123 +1643,90 @@ func (x *HostDNSConfigSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostDNSConfigSpec.ProtoReflect.Descriptor instead.
-func (*HostDNSConfigSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use EthernetChannelsStatus.ProtoReflect.Descriptor instead.
+func (*EthernetChannelsStatus) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *HostDNSConfigSpec) GetEnabled() bool {
+func (x *EthernetChannelsStatus) GetRxMax() uint32 {
 	if x != nil {
-		return x.Enabled
+		return x.RxMax
 	}
-	return false
+	return 0
 }
 
-func (x *HostDNSConfigSpec) GetListenAddresses() []*common.NetIPPort {
+func (x *EthernetChannelsStatus) GetTxMax() uint32 {
 	if x != nil {
-		return x.ListenAddresses
+		return x.TxMax
 	}
-	return nil
+	return 0
 }
 
-func (x *HostDNSConfigSpec) GetServiceHostDnsAddress() *common.NetIP {
+func (x *EthernetChannelsStatus) GetOtherMax() uint32 {
 	if x != nil {
-		return x.ServiceHostDnsAddress
+		return x.OtherMax
 	}
-	return nil
+	return 0
 }
 
-func (x *HostDNSConfigSpec) GetResolveMemberNames() bool {
+func (x *EthernetChannelsStatus) GetCombinedMax() uint32 {
 	if x != nil {
-		return x.ResolveMemberNames
+		return x.CombinedMax
 	}
-	return false
-}
-
-// HostnameSpecSpec describes node hostname.
-type HostnameSpecSpec struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Hostname      string                   `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
-	Domainname    string                   `protobuf:"bytes,2,opt,name=domainname,proto3" json:"domainname,omitempty"`
-	ConfigLayer   enums.NetworkConfigLayer `protobuf:"varint,3,opt,name=config_layer,json=configLayer,proto3,enum=talos.resource.definitions.enums.NetworkConfigLayer" json:"config_layer,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *HostnameSpecSpec) Reset() {
-	*x = HostnameSpecSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[19]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *HostnameSpecSpec) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return 0
 }
 
-func (*HostnameSpecSpec) ProtoMessage() {}
-
-func (x *HostnameSpecSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[19]
+func (x *EthernetChannelsStatus) GetRx() uint32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Rx
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use HostnameSpecSpec.ProtoReflect.Descriptor instead.
-func (*HostnameSpecSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{19}
+	return 0
 }
 
-func (x *HostnameSpecSpec) GetHostname() string {
+func (x *EthernetChannelsStatus) GetTx() uint32 {
 	if x != nil {
-		return x.Hostname
+		return x.Tx
 	}
-	return ""
+	return 0
 }
 
-func (x *HostnameSpecSpec) GetDomainname() string {
+func (x *EthernetChannelsStatus) GetOther() uint32 {
 	if x != nil {
-		return x.Domainname
+		return x.Other
 	}
-	return ""
+	return 0
 }
 
-func (x *HostnameSpecSpec) GetConfigLayer() enums.NetworkConfigLayer {
+func (x *EthernetChannelsStatus) GetCombined() uint32 {
 	if x != nil {
-		return x.ConfigLayer
+		return x.Combined
 	}
-	return enums.NetworkConfigLayer(0)
+	return 0
 }
 
-// HostnameStatusSpec describes node hostname.
-type HostnameStatusSpec struct {
+// EthernetConfigError describes a configuration setting which doesn't match the current link setting.
+type EthernetConfigError struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Hostname      string                 `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
-	Domainname    string                 `protobuf:"bytes,2,opt,name=domainname,proto3" json:"domainname,omitempty"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HostnameStatusSpec) Reset() {
-	*x = HostnameStatusSpec{}
+func (x *EthernetConfigError) Reset() {
+	*x = EthernetConfigError{}
 	mi := &file_resource_definitions_network_network_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HostnameStatusSpec) String() string {
+func (x *EthernetConfigError) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostnameStatusSpec) ProtoMessage() {}
+func (*EthernetConfigError) ProtoMessage() {}
 
-func (x *HostnameStatusSpec) ProtoReflect() protoreflect.Message {
+func (x *EthernetConfigError) ProtoReflect() protoreflect.Message {
 	mi := &file_resource_definitions_network_network_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1721,47 +1738,48 @@ func (x *HostnameStatusSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostnameStatusSpec.ProtoReflect.Descriptor instead.
-func (*HostnameStatusSpec) Descriptor() ([]byte, []int) {
+// Deprecated: Use EthernetConfigError.ProtoReflect.Descriptor instead.
+func (*EthernetConfigError) Descriptor() ([]byte, []int) {
 	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *HostnameStatusSpec) GetHostname() string {
+func (x *EthernetConfigError) GetField() string {
 	if x != nil {
-		return x.Hostname
+		return x.Field
 	}
 	return ""
 }
 
-func (x *HostnameStatusSpec) GetDomainname() string {
+func (x *EthernetConfigError) GetError() string {
 	if x != nil {
-		return x.Domainname
+		return x.Error
 	}
 	return ""
 }
 
-// LinkRefreshSpec describes status of rendered secrets.
-type LinkRefreshSpec struct {
+// EthernetFeatureStatus describes status of Ethernet features.
+type EthernetFeatureStatus struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Generation    int64                  `protobuf:"varint,1,opt,name=generation,proto3" json:"generation,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LinkRefreshSpec) Reset() {
-	*x = LinkRefreshSpec{}
+func (x *EthernetFeatureStatus) Reset() {
+	*x = EthernetFeatureStatus{}
 	mi := &file_resource_definitions_network_network_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LinkRefreshSpec) String() string {
+func (x *EthernetFeatureStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LinkRefreshSpec) ProtoMessage() {}
+func (*EthernetFeatureStatus) ProtoMessage() {}
 
-func (x *LinkRefreshSpec) ProtoReflect() protoreflect.Message {
+func (x *EthernetFeatureStatus) ProtoReflect() protoreflect.Message {
 	mi := &file_resource_definitions_network_network_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1773,53 +1791,56 @@ func (x *LinkRefreshSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LinkRefreshSpec.ProtoReflect.Descriptor instead.
-func (*LinkRefreshSpec) Descriptor() ([]byte, []int) {
+// Deprecated: Use EthernetFeatureStatus.ProtoReflect.Descriptor instead.
+func (*EthernetFeatureStatus) Descriptor() ([]byte, []int) {
 	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *LinkRefreshSpec) GetGeneration() int64 {
+func (x *EthernetFeatureStatus) GetName() string {
 	if x != nil {
-		return x.Generation
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-// LinkSpecSpec describes spec for the link.
-type LinkSpecSpec struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Name          string                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Logical       bool                     `protobuf:"varint,2,opt,name=logical,proto3" json:"logical,omitempty"`
-	Up            bool                     `protobuf:"varint,3,opt,name=up,proto3" json:"up,omitempty"`
-	Mtu           uint32                   `protobuf:"varint,4,opt,name=mtu,proto3" json:"mtu,omitempty"`
-	Kind          string                   `protobuf:"bytes,5,opt,name=kind,proto3" json:"kind,omitempty"`
-	Type          enums.NethelpersLinkType `protobuf:"varint,6,opt,name=type,proto3,enum=talos.resource.definitions.enums.NethelpersLinkType" json:"type,omitempty"`
-	ParentName    string                   `protobuf:"bytes,7,opt,name=parent_name,json=parentName,proto3" json:"parent_name,omitempty"`
-	BondSlave     *BondSlave               `protobuf:"bytes,8,opt,name=bond_slave,json=bondSlave,proto3" json:"bond_slave,omitempty"`
-	BridgeSlave   *BridgeSlave             `protobuf:"bytes,9,opt,name=bridge_slave,json=bridgeSlave,proto3" json:"bridge_slave,omitempty"`
-	Vlan          *VLANSpec                `protobuf:"bytes,10,opt,name=vlan,proto3" json:"vlan,omitempty"`
-	BondMaster    *BondMasterSpec          `protobuf:"bytes,11,opt,name=bond_master,json=bondMaster,proto3" json:"bond_master,omitempty"`
-	BridgeMaster  *BridgeMasterSpec        `protobuf:"bytes,12,opt,name=bridge_master,json=bridgeMaster,proto3" json:"bridge_master,omitempty"`
-	Wireguard     *WireguardSpec           `protobuf:"bytes,13,opt,name=wireguard,proto3" json:"wireguard,omitempty"`
-	ConfigLayer   enums.NetworkConfigLayer `protobuf:"varint,14,opt,name=config_layer,json=configLayer,proto3,enum=talos.resource.definitions.enums.NetworkConfigLayer" json:"config_layer,omitempty"`
+func (x *EthernetFeatureStatus) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// EthernetRingsSpec describes config of Ethernet rings.
+type EthernetRingsSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rx            uint32                 `protobuf:"varint,1,opt,name=rx,proto3" json:"rx,omitempty"`
+	RxMini        uint32                 `protobuf:"varint,2,opt,name=rx_mini,json=rxMini,proto3" json:"rx_mini,omitempty"`
+	RxJumbo       uint32                 `protobuf:"varint,3,opt,name=rx_jumbo,json=rxJumbo,proto3" json:"rx_jumbo,omitempty"`
+	Tx            uint32                 `protobuf:"varint,4,opt,name=tx,proto3" json:"tx,omitempty"`
+	RxBufLen      uint32                 `protobuf:"varint,5,opt,name=rx_buf_len,json=rxBufLen,proto3" json:"rx_buf_len,omitempty"`
+	CqeSize       uint32                 `protobuf:"varint,6,opt,name=cqe_size,json=cqeSize,proto3" json:"cqe_size,omitempty"`
+	TxPush        bool                   `protobuf:"varint,7,opt,name=tx_push,json=txPush,proto3" json:"tx_push,omitempty"`
+	RxPush        bool                   `protobuf:"varint,8,opt,name=rx_push,json=rxPush,proto3" json:"rx_push,omitempty"`
+	TxPushBufLen  uint32                 `protobuf:"varint,9,opt,name=tx_push_buf_len,json=txPushBufLen,proto3" json:"tx_push_buf_len,omitempty"`
+	TcpDataSplit  bool                   `protobuf:"varint,10,opt,name=tcp_data_split,json=tcpDataSplit,proto3" json:"tcp_data_split,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LinkSpecSpec) Reset() {
-	*x = LinkSpecSpec{}
+func (x *EthernetRingsSpec) Reset() {
+	*x = EthernetRingsSpec{}
 	mi := &file_resource_definitions_network_network_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LinkSpecSpec) String() string {
+func (x *EthernetRingsSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LinkSpecSpec) ProtoMessage() {}
+func (*EthernetRingsSpec) ProtoMessage() {}
 
-func (x *LinkSpecSpec) ProtoReflect() protoreflect.Message {
+func (x *EthernetRingsSpec) ProtoReflect() protoreflect.Message {
 	mi := &file_resource_definitions_network_network_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1831,162 +1852,117 @@ func (x *LinkSpecSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LinkSpecSpec.ProtoReflect.Descriptor instead.
-func (*LinkSpecSpec) Descriptor() ([]byte, []int) {
+// Deprecated: Use EthernetRingsSpec.ProtoReflect.Descriptor instead.
+func (*EthernetRingsSpec) Descriptor() ([]byte, []int) {
 	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *LinkSpecSpec) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *LinkSpecSpec) GetLogical() bool {
-	if x != nil {
-		return x.Logical
-	}
-	return false
-}
-
-func (x *LinkSpecSpec) GetUp() bool {
-	if x != nil {
-		return x.Up
-	}
-	return false
-}
-
-func (x *LinkSpecSpec) GetMtu() uint32 {
+func (x *EthernetRingsSpec) GetRx() uint32 {
 	if x != nil {
-		return x.Mtu
+		return x.Rx
 	}
 	return 0
 }
 
-func (x *LinkSpecSpec) GetKind() string {
-	if x != nil {
-		return x.Kind
-	}
-	return ""
-}
-
-func (x *LinkSpecSpec) GetType() enums.NethelpersLinkType {
+func (x *EthernetRingsSpec) GetRxMini() uint32 {
 	if x != nil {
-		return x.Type
+		return x.RxMini
 	}
-	return enums.NethelpersLinkType(0)
+	return 0
 }
 
-func (x *LinkSpecSpec) GetParentName() string {
+func (x *EthernetRingsSpec) GetRxJumbo() uint32 {
 	if x != nil {
-		return x.ParentName
+		return x.RxJumbo
 	}
-	return ""
+	return 0
 }
 
-func (x *LinkSpecSpec) GetBondSlave() *BondSlave {
+func (x *EthernetRingsSpec) GetTx() uint32 {
 	if x != nil {
-		return x.BondSlave
+		return x.Tx
 	}
-	return nil
+	return 0
 }
 
-func (x *LinkSpecSpec) GetBridgeSlave() *BridgeSlave {
+func (x *EthernetRingsSpec) GetRxBufLen() uint32 {
 	if x != nil {
-		return x.BridgeSlave
+		return x.RxBufLen
 	}
-	return nil
+	return 0
 }
 
-func (x *LinkSpecSpec) GetVlan() *VLANSpec {
+func (x *EthernetRingsSpec) GetCqeSize() uint32 {
 	if x != nil {
-		return x.Vlan
+		return x.CqeSize
 	}
-	return nil
+	return 0
 }
 
-func (x *LinkSpecSpec) GetBondMaster() *BondMasterSpec {
+func (x *EthernetRingsSpec) GetTxPush() bool {
 	if x != nil {
-		return x.BondMaster
+		return x.TxPush
 	}
-	return nil
+	return false
 }
 
-func (x *LinkSpecSpec) GetBridgeMaster() *BridgeMasterSpec {
+func (x *EthernetRingsSpec) GetRxPush() bool {
 	if x != nil {
-		return x.BridgeMaster
+		return x.RxPush
 	}
-	return nil
+	return false
 }
 
-func (x *LinkSpecSpec) GetWireguard() *WireguardSpec {
+func (x *EthernetRingsSpec) GetTxPushBufLen() uint32 {
 	if x != nil {
-		return x.Wireguard
+		return x.TxPushBufLen
 	}
-	return nil
+	return 0
 }
 
-func (x *LinkSpecSpec) GetConfigLayer() enums.NetworkConfigLayer {
+func (x *EthernetRingsSpec) GetTcpDataSplit() bool {
 	if x != nil {
-		return x.ConfigLayer
+		return x.TcpDataSplit
 	}
-	return enums.NetworkConfigLayer(0)
+	return false
 }
 
-// LinkStatusSpec describes status of rendered secrets.
-type LinkStatusSpec struct {
-	state            protoimpl.MessageState           `protogen:"open.v1"`
-	Index            uint32                           `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
-	Type             enums.NethelpersLinkType         `protobuf:"varint,2,opt,name=type,proto3,enum=talos.resource.definitions.enums.NethelpersLinkType" json:"type,omitempty"`
-	LinkIndex        uint32                           `protobuf:"varint,3,opt,name=link_index,json=linkIndex,proto3" json:"link_index,omitempty"`
-	Flags            uint32                           `protobuf:"varint,4,opt,name=flags,proto3" json:"flags,omitempty"`
-	HardwareAddr     []byte                           `protobuf:"bytes,5,opt,name=hardware_addr,json=hardwareAddr,proto3" json:"hardware_addr,omitempty"`
-	BroadcastAddr    []byte                           `protobuf:"bytes,6,opt,name=broadcast_addr,json=broadcastAddr,proto3" json:"broadcast_addr,omitempty"`
-	Mtu              uint32                           `protobuf:"varint,7,opt,name=mtu,proto3" json:"mtu,omitempty"`
-	QueueDisc        string                           `protobuf:"bytes,8,opt,name=queue_disc,json=queueDisc,proto3" json:"queue_disc,omitempty"`
-	MasterIndex      uint32                           `protobuf:"varint,9,opt,name=master_index,json=masterIndex,proto3" json:"master_index,omitempty"`
-	OperationalState enums.NethelpersOperationalState `protobuf:"varint,10,opt,name=operational_state,json=operationalState,proto3,enum=talos.resource.definitions.enums.NethelpersOperationalState" json:"operational_state,omitempty"`
-	Kind             string                           `protobuf:"bytes,11,opt,name=kind,proto3" json:"kind,omitempty"`
-	SlaveKind        string                           `protobuf:"bytes,12,opt,name=slave_kind,json=slaveKind,proto3" json:"slave_kind,omitempty"`
-	BusPath          string                           `protobuf:"bytes,13,opt,name=bus_path,json=busPath,proto3" json:"bus_path,omitempty"`
-	Pciid            string                           `protobuf:"bytes,14,opt,name=pciid,proto3" json:"pciid,omitempty"`
-	Driver           string                           `protobuf:"bytes,15,opt,name=driver,proto3" json:"driver,omitempty"`
-	DriverVersion    string                           `protobuf:"bytes,16,opt,name=driver_version,json=driverVersion,proto3" json:"driver_version,omitempty"`
-	FirmwareVersion  string                           `protobuf:"bytes,17,opt,name=firmware_version,json=firmwareVersion,proto3" json:"firmware_version,omitempty"`
-	ProductId        string                           `protobuf:"bytes,18,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	VendorId         string                           `protobuf:"bytes,19,opt,name=vendor_id,json=vendorId,proto3" json:"vendor_id,omitempty"`
-	Product          string                           `protobuf:"bytes,20,opt,name=product,proto3" json:"product,omitempty"`
-	Vendor           string                           `protobuf:"bytes,21,opt,name=vendor,proto3" json:"vendor,omitempty"`
-	LinkState        bool                             `protobuf:"varint,22,opt,name=link_state,json=linkState,proto3" json:"link_state,omitempty"`
-	SpeedMegabits    int64                            `protobuf:"varint,23,opt,name=speed_megabits,json=speedMegabits,proto3" json:"speed_megabits,omitempty"`
-	Port             enums.NethelpersPort             `protobuf:"varint,24,opt,name=port,proto3,enum=talos.resource.definitions.enums.NethelpersPort" json:"port,omitempty"`
-	Duplex           enums.NethelpersDuplex           `protobuf:"varint,25,opt,name=duplex,proto3,enum=talos.resource.definitions.enums.NethelpersDuplex" json:"duplex,omitempty"`
-	Vlan             *VLANSpec                        `protobuf:"bytes,26,opt,name=vlan,proto3" json:"vlan,omitempty"`
-	BridgeMaster     *BridgeMasterSpec                `protobuf:"bytes,27,opt,name=bridge_master,json=bridgeMaster,proto3" json:"bridge_master,omitempty"`
-	BondMaster       *BondMasterSpec                  `protobuf:"bytes,28,opt,name=bond_master,json=bondMaster,proto3" json:"bond_master,omitempty"`
-	Wireguard        *WireguardSpec                   `protobuf:"bytes,29,opt,name=wireguard,proto3" json:"wireguard,omitempty"`
-	PermanentAddr    []byte                           `protobuf:"bytes,30,opt,name=permanent_addr,json=permanentAddr,proto3" json:"permanent_addr,omitempty"`
-	Alias            string                           `protobuf:"bytes,31,opt,name=alias,proto3" json:"alias,omitempty"`
-	AltNames         []string                         `protobuf:"bytes,32,rep,name=alt_names,json=altNames,proto3" json:"alt_names,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// EthernetRingsStatus describes status of Ethernet rings.
+type EthernetRingsStatus struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RxMax           uint32                 `protobuf:"varint,1,opt,name=rx_max,json=rxMax,proto3" json:"rx_max,omitempty"`
+	RxMiniMax       uint32                 `protobuf:"varint,2,opt,name=rx_mini_max,json=rxMiniMax,proto3" json:"rx_mini_max,omitempty"`
+	RxJumboMax      uint32                 `protobuf:"varint,3,opt,name=rx_jumbo_max,json=rxJumboMax,proto3" json:"rx_jumbo_max,omitempty"`
+	TxMax           uint32                 `protobuf:"varint,4,opt,name=tx_max,json=txMax,proto3" json:"tx_max,omitempty"`
+	TxPushBufLenMax uint32                 `protobuf:"varint,5,opt,name=tx_push_buf_len_max,json=txPushBufLenMax,proto3" json:"tx_push_buf_len_max,omitempty"`
+	Rx              uint32                 `protobuf:"varint,6,opt,name=rx,proto3" json:"rx,omitempty"`
+	RxMini          uint32                 `protobuf:"varint,7,opt,name=rx_mini,json=rxMini,proto3" json:"rx_mini,omitempty"`
+	RxJumbo         uint32                 `protobuf:"varint,8,opt,name=rx_jumbo,json=rxJumbo,proto3" json:"rx_jumbo,omitempty"`
+	Tx              uint32                 `protobuf:"varint,9,opt,name=tx,proto3" json:"tx,omitempty"`
+	RxBufLen        uint32                 `protobuf:"varint,10,opt,name=rx_buf_len,json=rxBufLen,proto3" json:"rx_buf_len,omitempty"`
+	CqeSize         uint32                 `protobuf:"varint,11,opt,name=cqe_size,json=cqeSize,proto3" json:"cqe_size,omitempty"`
+	TxPush          bool                   `protobuf:"varint,12,opt,name=tx_push,json=txPush,proto3" json:"tx_push,omitempty"`
+	RxPush          bool                   `protobuf:"varint,13,opt,name=rx_push,json=rxPush,proto3" json:"rx_push,omitempty"`
+	TxPushBufLen    uint32                 `protobuf:"varint,14,opt,name=tx_push_buf_len,json=txPushBufLen,proto3" json:"tx_push_buf_len,omitempty"`
+	TcpDataSplit    bool                   `protobuf:"varint,15,opt,name=tcp_data_split,json=tcpDataSplit,proto3" json:"tcp_data_split,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *LinkStatusSpec) Reset() {
-	*x = LinkStatusSpec{}
+func (x *EthernetRingsStatus) Reset() {
+	*x = EthernetRingsStatus{}
 	mi := &file_resource_definitions_network_network_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LinkStatusSpec) String() string {
+func (x *EthernetRingsStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LinkStatusSpec) ProtoMessage() {}
+func (*EthernetRingsStatus) ProtoMessage() {}
 
-func (x *LinkStatusSpec) ProtoReflect() protoreflect.Message {
+func (x *EthernetRingsStatus) ProtoReflect() protoreflect.Message {
 	mi := &file_resource_definitions_network_network_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1998,260 +1974,318 @@ func (x *LinkStatusSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LinkStatusSpec.ProtoReflect.Descriptor instead.
-func (*LinkStatusSpec) Descriptor() ([]byte, []int) {
+// Deprecated: Use EthernetRingsStatus.ProtoReflect.Descriptor instead.
+func (*EthernetRingsStatus) Descriptor() ([]byte, []int) {
 	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *LinkStatusSpec) GetIndex() uint32 {
+func (x *EthernetRingsStatus) GetRxMax() uint32 {
 	if x != nil {
-		return x.Index
+		return x.RxMax
 	}
 	return 0
 }
 
-func (x *LinkStatusSpec) GetType() enums.NethelpersLinkType {
+func (x *EthernetRingsStatus) GetRxMiniMax() uint32 {
 	if x != nil {
-		return x.Type
+		return x.RxMiniMax
 	}
-	return enums.NethelpersLinkType(0)
+	return 0
 }
 
-func (x *LinkStatusSpec) GetLinkIndex() uint32 {
+func (x *EthernetRingsStatus) GetRxJumboMax() uint32 {
 	if x != nil {
-		return x.LinkIndex
+		return x.RxJumboMax
 	}
 	return 0
 }
 
-func (x *LinkStatusSpec) GetFlags() uint32 {
+func (x *EthernetRingsStatus) GetTxMax() uint32 {
 	if x != nil {
-		return x.Flags
+		return x.TxMax
 	}
 	return 0
 }
 
-func (x *LinkStatusSpec) GetHardwareAddr() []byte {
+func (x *EthernetRingsStatus) GetTxPushBufLenMax() uint32 {
 	if x != nil {
-		return x.HardwareAddr
+		return x.TxPushBufLenMax
 	}
-	return nil
+	return 0
 }
 
-func (x *LinkStatusSpec) GetBroadcastAddr() []byte {
+func (x *EthernetRingsStatus) GetRx() uint32 {
 	if x != nil {
-		return x.BroadcastAddr
+		return x.Rx
 	}
-	return nil
+	return 0
 }
 
-func (x *LinkStatusSpec) GetMtu() uint32 {
+func (x *EthernetRingsStatus) GetRxMini() uint32 {
 	if x != nil {
-		return x.Mtu
+		return x.RxMini
 	}
 	return 0
 }
 
-func (x *LinkStatusSpec) GetQueueDisc() string {
+func (x *EthernetRingsStatus) GetRxJumbo() uint32 {
 	if x != nil {
-		return x.QueueDisc
+		return x.RxJumbo
 	}
-	return ""
+	return 0
 }
 
-func (x *LinkStatusSpec) GetMasterIndex() uint32 {
+func (x *EthernetRingsStatus) GetTx() uint32 {
 	if x != nil {
-		return x.MasterIndex
+		return x.Tx
 	}
 	return 0
 }
 
-func (x *LinkStatusSpec) GetOperationalState() enums.NethelpersOperationalState {
+func (x *EthernetRingsStatus) GetRxBufLen() uint32 {
 	if x != nil {
-		return x.OperationalState
+		return x.RxBufLen
 	}
-	return enums.NethelpersOperationalState(0)
+	return 0
 }
 
-func (x *LinkStatusSpec) GetKind() string {
+func (x *EthernetRingsStatus) GetCqeSize() uint32 {
 	if x != nil {
-		return x.Kind
+		return x.CqeSize
 	}
-	return ""
+	return 0
 }
 
-func (x *LinkStatusSpec) GetSlaveKind() string {
+func (x *EthernetRingsStatus) GetTxPush() bool {
 	if x != nil {
-		return x.SlaveKind
+		return x.TxPush
 	}
-	return ""
+	return false
 }
 
-func (x *LinkStatusSpec) GetBusPath() string {
+func (x *EthernetRingsStatus) GetRxPush() bool {
 	if x != nil {
-		return x.BusPath
+		return x.RxPush
 	}
-	return ""
+	return false
 }
 
-func (x *LinkStatusSpec) GetPciid() string {
+func (x *EthernetRingsStatus) GetTxPushBufLen() uint32 {
 	if x != nil {
-		return x.Pciid
+		return x.TxPushBufLen
 	}
-	return ""
+	return 0
 }
 
-func (x *LinkStatusSpec) GetDriver() string {
+func (x *EthernetRingsStatus) GetTcpDataSplit() bool {
 	if x != nil {
-		return x.Driver
+		return x.TcpDataSplit
 	}
-	return ""
+	return false
 }
 
-func (x *LinkStatusSpec) GetDriverVersion() string {
-	if x != nil {
-		return x.DriverVersion
-	}
-	return ""
+// EthernetSpecSpec describes config of Ethernet link.
+type EthernetSpecSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rings         *EthernetRingsSpec     `protobuf:"bytes,1,opt,name=rings,proto3" json:"rings,omitempty"`
+	Features      map[string]bool        `protobuf:"bytes,2,rep,name=features,proto3" json:"features,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Channels      *EthernetChannelsSpec  `protobuf:"bytes,3,opt,name=channels,proto3" json:"channels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LinkStatusSpec) GetFirmwareVersion() string {
+func (x *EthernetSpecSpec) Reset() {
+	*x = EthernetSpecSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EthernetSpecSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EthernetSpecSpec) ProtoMessage() {}
+
+func (x *EthernetSpecSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[24]
 	if x != nil {
-		return x.FirmwareVersion
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *LinkStatusSpec) GetProductId() string {
+// Deprecated: Use EthernetSpecSpec.ProtoReflect.Descriptor instead.
+func (*EthernetSpecSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *EthernetSpecSpec) GetRings() *EthernetRingsSpec {
 	if x != nil {
-		return x.ProductId
+		return x.Rings
 	}
-	return ""
+	return nil
 }
 
-func (x *LinkStatusSpec) GetVendorId() string {
+func (x *EthernetSpecSpec) GetFeatures() map[string]bool {
 	if x != nil {
-		return x.VendorId
+		return x.Features
 	}
-	return ""
+	return nil
 }
 
-func (x *LinkStatusSpec) GetProduct() string {
+func (x *EthernetSpecSpec) GetChannels() *EthernetChannelsSpec {
 	if x != nil {
-		return x.Product
+		return x.Channels
 	}
-	return ""
+	return nil
 }
 
-func (x *LinkStatusSpec) GetVendor() string {
+// EthernetStatusSpec describes status of rendered secrets.
+type EthernetStatusSpec struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	LinkState     bool                     `protobuf:"varint,1,opt,name=link_state,json=linkState,proto3" json:"link_state,omitempty"`
+	SpeedMegabits int64                    `protobuf:"varint,2,opt,name=speed_megabits,json=speedMegabits,proto3" json:"speed_megabits,omitempty"`
+	Port          enums.NethelpersPort     `protobuf:"varint,3,opt,name=port,proto3,enum=talos.resource.definitions.enums.NethelpersPort" json:"port,omitempty"`
+	Duplex        enums.NethelpersDuplex   `protobuf:"varint,4,opt,name=duplex,proto3,enum=talos.resource.definitions.enums.NethelpersDuplex" json:"duplex,omitempty"`
+	OurModes      []string                 `protobuf:"bytes,5,rep,name=our_modes,json=ourModes,proto3" json:"our_modes,omitempty"`
+	PeerModes     []string                 `protobuf:"bytes,6,rep,name=peer_modes,json=peerModes,proto3" json:"peer_modes,omitempty"`
+	Rings         *EthernetRingsStatus     `protobuf:"bytes,7,opt,name=rings,proto3" json:"rings,omitempty"`
+	Features      []*EthernetFeatureStatus `protobuf:"bytes,8,rep,name=features,proto3" json:"features,omitempty"`
+	Channels      *EthernetChannelsStatus  `protobuf:"bytes,9,opt,name=channels,proto3" json:"channels,omitempty"`
+	ConfigErrors  []*EthernetConfigError   `protobuf:"bytes,10,rep,name=config_errors,json=configErrors,proto3" json:"config_errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EthernetStatusSpec) Reset() {
+	*x = EthernetStatusSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EthernetStatusSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EthernetStatusSpec) ProtoMessage() {}
+
+func (x *EthernetStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[25]
 	if x != nil {
-		return x.Vendor
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *LinkStatusSpec) GetLinkState() bool {
+// Deprecated: Use EthernetStatusSpec.ProtoReflect.Descriptor instead.
+func (*EthernetStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *EthernetStatusSpec) GetLinkState() bool {
 	if x != nil {
 		return x.LinkState
 	}
 	return false
 }
 
-func (x *LinkStatusSpec) GetSpeedMegabits() int64 {
+func (x *EthernetStatusSpec) GetSpeedMegabits() int64 {
 	if x != nil {
 		return x.SpeedMegabits
 	}
 	return 0
 }
 
-func (x *LinkStatusSpec) GetPort() enums.NethelpersPort {
+func (x *EthernetStatusSpec) GetPort() enums.NethelpersPort {
 	if x != nil {
 		return x.Port
 	}
 	return enums.NethelpersPort(0)
 }
 
-func (x *LinkStatusSpec) GetDuplex() enums.NethelpersDuplex {
+func (x *EthernetStatusSpec) GetDuplex() enums.NethelpersDuplex {
 	if x != nil {
 		return x.Duplex
 	}
 	return enums.NethelpersDuplex(0)
 }
 
-func (x *LinkStatusSpec) GetVlan() *VLANSpec {
+func (x *EthernetStatusSpec) GetOurModes() []string {
 	if x != nil {
-		return x.Vlan
+		return x.OurModes
 	}
 	return nil
 }
 
-func (x *LinkStatusSpec) GetBridgeMaster() *BridgeMasterSpec {
+func (x *EthernetStatusSpec) GetPeerModes() []string {
 	if x != nil {
-		return x.BridgeMaster
+		return x.PeerModes
 	}
 	return nil
 }
 
-func (x *LinkStatusSpec) GetBondMaster() *BondMasterSpec {
+func (x *EthernetStatusSpec) GetRings() *EthernetRingsStatus {
 	if x != nil {
-		return x.BondMaster
+		return x.Rings
 	}
 	return nil
 }
 
-func (x *LinkStatusSpec) GetWireguard() *WireguardSpec {
+func (x *EthernetStatusSpec) GetFeatures() []*EthernetFeatureStatus {
 	if x != nil {
-		return x.Wireguard
+		return x.Features
 	}
 	return nil
 }
 
-func (x *LinkStatusSpec) GetPermanentAddr() []byte {
+func (x *EthernetStatusSpec) GetChannels() *EthernetChannelsStatus {
 	if x != nil {
-		return x.PermanentAddr
+		return x.Channels
 	}
 	return nil
 }
 
-func (x *LinkStatusSpec) GetAlias() string {
-	if x != nil {
-		return x.Alias
-	}
-	return ""
-}
-
-func (x *LinkStatusSpec) GetAltNames() []string {
+func (x *EthernetStatusSpec) GetConfigErrors() []*EthernetConfigError {
 	if x != nil {
-		return x.AltNames
+		return x.ConfigErrors
 	}
 	return nil
 }
 
-// NfTablesAddressMatch describes the match on the IP address.
-type NfTablesAddressMatch struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	IncludeSubnets []*common.NetIPPrefix  `protobuf:"bytes,1,rep,name=include_subnets,json=includeSubnets,proto3" json:"include_subnets,omitempty"`
-	ExcludeSubnets []*common.NetIPPrefix  `protobuf:"bytes,2,rep,name=exclude_subnets,json=excludeSubnets,proto3" json:"exclude_subnets,omitempty"`
-	Invert         bool                   `protobuf:"varint,3,opt,name=invert,proto3" json:"invert,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// HTTPProbeSpec describes the HTTP Probe.
+type HTTPProbeSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Timeout       *durationpb.Duration   `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NfTablesAddressMatch) Reset() {
-	*x = NfTablesAddressMatch{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[24]
+func (x *HTTPProbeSpec) Reset() {
+	*x = HTTPProbeSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NfTablesAddressMatch) String() string {
+func (x *HTTPProbeSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NfTablesAddressMatch) ProtoMessage() {}
+func (*HTTPProbeSpec) ProtoMessage() {}
 
-func (x *NfTablesAddressMatch) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[24]
+func (x *HTTPProbeSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2262,59 +2296,49 @@ func (x *NfTablesAddressMatch) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NfTablesAddressMatch.ProtoReflect.Descriptor instead.
-func (*NfTablesAddressMatch) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use HTTPProbeSpec.ProtoReflect.Descriptor instead.
+func (*HTTPProbeSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *NfTablesAddressMatch) GetIncludeSubnets() []*common.NetIPPrefix {
+func (x *HTTPProbeSpec) GetUrl() string {
 	if x != nil {
-		return x.IncludeSubnets
+		return x.Url
 	}
-	return nil
+	return ""
 }
 
-func (x *NfTablesAddressMatch) GetExcludeSubnets() []*common.NetIPPrefix {
+func (x *HTTPProbeSpec) GetTimeout() *durationpb.Duration {
 	if x != nil {
-		return x.ExcludeSubnets
+		return x.Timeout
 	}
 	return nil
 }
 
-func (x *NfTablesAddressMatch) GetInvert() bool {
-	if x != nil {
-		return x.Invert
-	}
-	return false
-}
-
-// NfTablesChainSpec describes status of rendered secrets.
-type NfTablesChainSpec struct {
-	state         protoimpl.MessageState                `protogen:"open.v1"`
-	Type          string                                `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Hook          enums.NethelpersNfTablesChainHook     `protobuf:"varint,2,opt,name=hook,proto3,enum=talos.resource.definitions.enums.NethelpersNfTablesChainHook" json:"hook,omitempty"`
-	Priority      enums.NethelpersNfTablesChainPriority `protobuf:"varint,3,opt,name=priority,proto3,enum=talos.resource.definitions.enums.NethelpersNfTablesChainPriority" json:"priority,omitempty"`
-	Rules         []*NfTablesRule                       `protobuf:"bytes,4,rep,name=rules,proto3" json:"rules,omitempty"`
-	Policy        enums.NethelpersNfTablesVerdict       `protobuf:"varint,5,opt,name=policy,proto3,enum=talos.resource.definitions.enums.NethelpersNfTablesVerdict" json:"policy,omitempty"`
+// HardwareAddrSpec describes spec for the link.
+type HardwareAddrSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	HardwareAddr  []byte                 `protobuf:"bytes,2,opt,name=hardware_addr,json=hardwareAddr,proto3" json:"hardware_addr,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NfTablesChainSpec) Reset() {
-	*x = NfTablesChainSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[25]
+func (x *HardwareAddrSpec) Reset() {
+	*x = HardwareAddrSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NfTablesChainSpec) String() string {
+func (x *HardwareAddrSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NfTablesChainSpec) ProtoMessage() {}
+func (*HardwareAddrSpec) ProtoMessage() {}
 
-func (x *NfTablesChainSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[25]
+func (x *HardwareAddrSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2325,118 +2349,167 @@ func (x *NfTablesChainSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NfTablesChainSpec.ProtoReflect.Descriptor instead.
-func (*NfTablesChainSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use HardwareAddrSpec.ProtoReflect.Descriptor instead.
+func (*HardwareAddrSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *NfTablesChainSpec) GetType() string {
+func (x *HardwareAddrSpec) GetName() string {
 	if x != nil {
-		return x.Type
+		return x.Name
 	}
 	return ""
 }
 
-func (x *NfTablesChainSpec) GetHook() enums.NethelpersNfTablesChainHook {
+func (x *HardwareAddrSpec) GetHardwareAddr() []byte {
 	if x != nil {
-		return x.Hook
+		return x.HardwareAddr
 	}
-	return enums.NethelpersNfTablesChainHook(0)
+	return nil
 }
 
-func (x *NfTablesChainSpec) GetPriority() enums.NethelpersNfTablesChainPriority {
+// HostDNSConfigSpec describes host DNS config.
+type HostDNSConfigSpec struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Enabled               bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	ListenAddresses       []*common.NetIPPort    `protobuf:"bytes,2,rep,name=listen_addresses,json=listenAddresses,proto3" json:"listen_addresses,omitempty"`
+	ServiceHostDnsAddress *common.NetIP          `protobuf:"bytes,3,opt,name=service_host_dns_address,json=serviceHostDnsAddress,proto3" json:"service_host_dns_address,omitempty"`
+	ResolveMemberNames    bool                   `protobuf:"varint,4,opt,name=resolve_member_names,json=resolveMemberNames,proto3" json:"resolve_member_names,omitempty"`
+	CacheSize             int64                  `protobuf:"varint,5,opt,name=cache_size,json=cacheSize,proto3" json:"cache_size,omitempty"`
+	CacheMinTtl           *durationpb.Duration   `protobuf:"bytes,6,opt,name=cache_min_ttl,json=cacheMinTtl,proto3" json:"cache_min_ttl,omitempty"`
+	CacheMaxTtl           *durationpb.Duration   `protobuf:"bytes,7,opt,name=cache_max_ttl,json=cacheMaxTtl,proto3" json:"cache_max_ttl,omitempty"`
+	NegativeCacheTtl      *durationpb.Duration   `protobuf:"bytes,8,opt,name=negative_cache_ttl,json=negativeCacheTtl,proto3" json:"negative_cache_ttl,omitempty"`
+	ServeStale            *durationpb.Duration   `protobuf:"bytes,9,opt,name=serve_stale,json=serveStale,proto3" json:"serve_stale,omitempty"`
+	AllowedClients        []*common.NetIPPrefix  `protobuf:"bytes,10,rep,name=allowed_clients,json=allowedClients,proto3" json:"allowed_clients,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *HostDNSConfigSpec) Reset() {
+	*x = HostDNSConfigSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HostDNSConfigSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostDNSConfigSpec) ProtoMessage() {}
+
+func (x *HostDNSConfigSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[28]
 	if x != nil {
-		return x.Priority
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return enums.NethelpersNfTablesChainPriority(0)
+	return mi.MessageOf(x)
 }
 
-func (x *NfTablesChainSpec) GetRules() []*NfTablesRule {
+// Deprecated: Use HostDNSConfigSpec.ProtoReflect.Descriptor instead.
+func (*HostDNSConfigSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *HostDNSConfigSpec) GetEnabled() bool {
 	if x != nil {
-		return x.Rules
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *HostDNSConfigSpec) GetListenAddresses() []*common.NetIPPort {
+	if x != nil {
+		return x.ListenAddresses
 	}
 	return nil
 }
 
-func (x *NfTablesChainSpec) GetPolicy() enums.NethelpersNfTablesVerdict {
+func (x *HostDNSConfigSpec) GetServiceHostDnsAddress() *common.NetIP {
 	if x != nil {
-		return x.Policy
+		return x.ServiceHostDnsAddress
 	}
-	return enums.NethelpersNfTablesVerdict(0)
+	return nil
 }
 
-// NfTablesClampMSS describes the TCP MSS clamping operation.
-//
-// MSS is limited by the `MaxMTU` so that:
-// - IPv4: MSS = MaxMTU - 40
-// - IPv6: MSS = MaxMTU - 60.
-type NfTablesClampMSS struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Mtu           uint32                 `protobuf:"fixed32,1,opt,name=mtu,proto3" json:"mtu,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *HostDNSConfigSpec) GetResolveMemberNames() bool {
+	if x != nil {
+		return x.ResolveMemberNames
+	}
+	return false
 }
 
-func (x *NfTablesClampMSS) Reset() {
-	*x = NfTablesClampMSS{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[26]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *HostDNSConfigSpec) GetCacheSize() int64 {
+	if x != nil {
+		return x.CacheSize
+	}
+	return 0
 }
 
-func (x *NfTablesClampMSS) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *HostDNSConfigSpec) GetCacheMinTtl() *durationpb.Duration {
+	if x != nil {
+		return x.CacheMinTtl
+	}
+	return nil
 }
 
-func (*NfTablesClampMSS) ProtoMessage() {}
+func (x *HostDNSConfigSpec) GetCacheMaxTtl() *durationpb.Duration {
+	if x != nil {
+		return x.CacheMaxTtl
+	}
+	return nil
+}
 
-func (x *NfTablesClampMSS) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[26]
+func (x *HostDNSConfigSpec) GetNegativeCacheTtl() *durationpb.Duration {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.NegativeCacheTtl
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use NfTablesClampMSS.ProtoReflect.Descriptor instead.
-func (*NfTablesClampMSS) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{26}
+func (x *HostDNSConfigSpec) GetServeStale() *durationpb.Duration {
+	if x != nil {
+		return x.ServeStale
+	}
+	return nil
 }
 
-func (x *NfTablesClampMSS) GetMtu() uint32 {
+func (x *HostDNSConfigSpec) GetAllowedClients() []*common.NetIPPrefix {
 	if x != nil {
-		return x.Mtu
+		return x.AllowedClients
 	}
-	return 0
+	return nil
 }
 
-// NfTablesConntrackStateMatch describes the match on the connection tracking state.
-type NfTablesConntrackStateMatch struct {
-	state         protoimpl.MessageState           `protogen:"open.v1"`
-	States        []enums.NethelpersConntrackState `protobuf:"varint,1,rep,packed,name=states,proto3,enum=talos.resource.definitions.enums.NethelpersConntrackState" json:"states,omitempty"`
+// HostnameSpecSpec describes node hostname.
+type HostnameSpecSpec struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Hostname      string                   `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Domainname    string                   `protobuf:"bytes,2,opt,name=domainname,proto3" json:"domainname,omitempty"`
+	ConfigLayer   enums.NetworkConfigLayer `protobuf:"varint,3,opt,name=config_layer,json=configLayer,proto3,enum=talos.resource.definitions.enums.NetworkConfigLayer" json:"config_layer,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NfTablesConntrackStateMatch) Reset() {
-	*x = NfTablesConntrackStateMatch{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[27]
+func (x *HostnameSpecSpec) Reset() {
+	*x = HostnameSpecSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NfTablesConntrackStateMatch) String() string {
+func (x *HostnameSpecSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NfTablesConntrackStateMatch) ProtoMessage() {}
+func (*HostnameSpecSpec) ProtoMessage() {}
 
-func (x *NfTablesConntrackStateMatch) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[27]
+func (x *HostnameSpecSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2447,41 +2520,56 @@ func (x *NfTablesConntrackStateMatch) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NfTablesConntrackStateMatch.ProtoReflect.Descriptor instead.
-func (*NfTablesConntrackStateMatch) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use HostnameSpecSpec.ProtoReflect.Descriptor instead.
+func (*HostnameSpecSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *NfTablesConntrackStateMatch) GetStates() []enums.NethelpersConntrackState {
+func (x *HostnameSpecSpec) GetHostname() string {
 	if x != nil {
-		return x.States
+		return x.Hostname
 	}
-	return nil
+	return ""
 }
 
-// NfTablesICMPTypeMatch describes the match on the ICMP type.
-type NfTablesICMPTypeMatch struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	Types         []enums.NethelpersICMPType `protobuf:"varint,1,rep,packed,name=types,proto3,enum=talos.resource.definitions.enums.NethelpersICMPType" json:"types,omitempty"`
+func (x *HostnameSpecSpec) GetDomainname() string {
+	if x != nil {
+		return x.Domainname
+	}
+	return ""
+}
+
+func (x *HostnameSpecSpec) GetConfigLayer() enums.NetworkConfigLayer {
+	if x != nil {
+		return x.ConfigLayer
+	}
+	return enums.NetworkConfigLayer(0)
+}
+
+// HostnameStatusSpec describes node hostname.
+type HostnameStatusSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hostname      string                 `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Domainname    string                 `protobuf:"bytes,2,opt,name=domainname,proto3" json:"domainname,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NfTablesICMPTypeMatch) Reset() {
-	*x = NfTablesICMPTypeMatch{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[28]
+func (x *HostnameStatusSpec) Reset() {
+	*x = HostnameStatusSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NfTablesICMPTypeMatch) String() string {
+func (x *HostnameStatusSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NfTablesICMPTypeMatch) ProtoMessage() {}
+func (*HostnameStatusSpec) ProtoMessage() {}
 
-func (x *NfTablesICMPTypeMatch) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[28]
+func (x *HostnameStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2492,42 +2580,49 @@ func (x *NfTablesICMPTypeMatch) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NfTablesICMPTypeMatch.ProtoReflect.Descriptor instead.
-func (*NfTablesICMPTypeMatch) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use HostnameStatusSpec.ProtoReflect.Descriptor instead.
+func (*HostnameStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *NfTablesICMPTypeMatch) GetTypes() []enums.NethelpersICMPType {
+func (x *HostnameStatusSpec) GetHostname() string {
 	if x != nil {
-		return x.Types
+		return x.Hostname
 	}
-	return nil
+	return ""
 }
 
-// NfTablesIfNameMatch describes the match on the interface name.
-type NfTablesIfNameMatch struct {
-	state          protoimpl.MessageState        `protogen:"open.v1"`
-	Operator       enums.NethelpersMatchOperator `protobuf:"varint,2,opt,name=operator,proto3,enum=talos.resource.definitions.enums.NethelpersMatchOperator" json:"operator,omitempty"`
-	InterfaceNames []string                      `protobuf:"bytes,3,rep,name=interface_names,json=interfaceNames,proto3" json:"interface_names,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *HostnameStatusSpec) GetDomainname() string {
+	if x != nil {
+		return x.Domainname
+	}
+	return ""
 }
 
-func (x *NfTablesIfNameMatch) Reset() {
-	*x = NfTablesIfNameMatch{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[29]
+// ICMPProbeSpec describes the ICMP Probe.
+type ICMPProbeSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Host          string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Timeout       *durationpb.Duration   `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ICMPProbeSpec) Reset() {
+	*x = ICMPProbeSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NfTablesIfNameMatch) String() string {
+func (x *ICMPProbeSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NfTablesIfNameMatch) ProtoMessage() {}
+func (*ICMPProbeSpec) ProtoMessage() {}
 
-func (x *NfTablesIfNameMatch) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[29]
+func (x *ICMPProbeSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2538,51 +2633,56 @@ func (x *NfTablesIfNameMatch) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NfTablesIfNameMatch.ProtoReflect.Descriptor instead.
-func (*NfTablesIfNameMatch) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use ICMPProbeSpec.ProtoReflect.Descriptor instead.
+func (*ICMPProbeSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *NfTablesIfNameMatch) GetOperator() enums.NethelpersMatchOperator {
+func (x *ICMPProbeSpec) GetHost() string {
 	if x != nil {
-		return x.Operator
+		return x.Host
 	}
-	return enums.NethelpersMatchOperator(0)
+	return ""
 }
 
-func (x *NfTablesIfNameMatch) GetInterfaceNames() []string {
+func (x *ICMPProbeSpec) GetTimeout() *durationpb.Duration {
 	if x != nil {
-		return x.InterfaceNames
+		return x.Timeout
 	}
 	return nil
 }
 
-// NfTablesLayer4Match describes the match on the transport layer protocol.
-type NfTablesLayer4Match struct {
-	state                protoimpl.MessageState   `protogen:"open.v1"`
-	Protocol             enums.NethelpersProtocol `protobuf:"varint,1,opt,name=protocol,proto3,enum=talos.resource.definitions.enums.NethelpersProtocol" json:"protocol,omitempty"`
-	MatchSourcePort      *NfTablesPortMatch       `protobuf:"bytes,2,opt,name=match_source_port,json=matchSourcePort,proto3" json:"match_source_port,omitempty"`
-	MatchDestinationPort *NfTablesPortMatch       `protobuf:"bytes,3,opt,name=match_destination_port,json=matchDestinationPort,proto3" json:"match_destination_port,omitempty"`
-	MatchIcmpType        *NfTablesICMPTypeMatch   `protobuf:"bytes,4,opt,name=match_icmp_type,json=matchIcmpType,proto3" json:"match_icmp_type,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+// LLDPNeighborStatusSpec describes the LLDP neighbor discovered on a link.
+type LLDPNeighborStatusSpec struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	LinkName          string                 `protobuf:"bytes,1,opt,name=link_name,json=linkName,proto3" json:"link_name,omitempty"`
+	ChassisId         string                 `protobuf:"bytes,2,opt,name=chassis_id,json=chassisId,proto3" json:"chassis_id,omitempty"`
+	PortId            string                 `protobuf:"bytes,3,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	PortDescription   string                 `protobuf:"bytes,4,opt,name=port_description,json=portDescription,proto3" json:"port_description,omitempty"`
+	SystemName        string                 `protobuf:"bytes,5,opt,name=system_name,json=systemName,proto3" json:"system_name,omitempty"`
+	SystemDescription string                 `protobuf:"bytes,6,opt,name=system_description,json=systemDescription,proto3" json:"system_description,omitempty"`
+	PortVlanid        uint32                 `protobuf:"varint,7,opt,name=port_vlanid,json=portVlanid,proto3" json:"port_vlanid,omitempty"`
+	Ttl               *durationpb.Duration   `protobuf:"bytes,8,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	Expires           *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expires,proto3" json:"expires,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *NfTablesLayer4Match) Reset() {
-	*x = NfTablesLayer4Match{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[30]
+func (x *LLDPNeighborStatusSpec) Reset() {
+	*x = LLDPNeighborStatusSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NfTablesLayer4Match) String() string {
+func (x *LLDPNeighborStatusSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NfTablesLayer4Match) ProtoMessage() {}
+func (*LLDPNeighborStatusSpec) ProtoMessage() {}
 
-func (x *NfTablesLayer4Match) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[30]
+func (x *LLDPNeighborStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2593,62 +2693,1598 @@ func (x *NfTablesLayer4Match) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NfTablesLayer4Match.ProtoReflect.Descriptor instead.
-func (*NfTablesLayer4Match) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use LLDPNeighborStatusSpec.ProtoReflect.Descriptor instead.
+func (*LLDPNeighborStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *LLDPNeighborStatusSpec) GetLinkName() string {
+	if x != nil {
+		return x.LinkName
+	}
+	return ""
+}
+
+func (x *LLDPNeighborStatusSpec) GetChassisId() string {
+	if x != nil {
+		return x.ChassisId
+	}
+	return ""
+}
+
+func (x *LLDPNeighborStatusSpec) GetPortId() string {
+	if x != nil {
+		return x.PortId
+	}
+	return ""
+}
+
+func (x *LLDPNeighborStatusSpec) GetPortDescription() string {
+	if x != nil {
+		return x.PortDescription
+	}
+	return ""
+}
+
+func (x *LLDPNeighborStatusSpec) GetSystemName() string {
+	if x != nil {
+		return x.SystemName
+	}
+	return ""
+}
+
+func (x *LLDPNeighborStatusSpec) GetSystemDescription() string {
+	if x != nil {
+		return x.SystemDescription
+	}
+	return ""
+}
+
+func (x *LLDPNeighborStatusSpec) GetPortVlanid() uint32 {
+	if x != nil {
+		return x.PortVlanid
+	}
+	return 0
+}
+
+func (x *LLDPNeighborStatusSpec) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *LLDPNeighborStatusSpec) GetExpires() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expires
+	}
+	return nil
+}
+
+// LinkRefreshSpec describes status of rendered secrets.
+type LinkRefreshSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Generation    int64                  `protobuf:"varint,1,opt,name=generation,proto3" json:"generation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkRefreshSpec) Reset() {
+	*x = LinkRefreshSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkRefreshSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkRefreshSpec) ProtoMessage() {}
+
+func (x *LinkRefreshSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkRefreshSpec.ProtoReflect.Descriptor instead.
+func (*LinkRefreshSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *LinkRefreshSpec) GetGeneration() int64 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
+// LinkSpecSpec describes spec for the link.
+type LinkSpecSpec struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Name          string                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Logical       bool                     `protobuf:"varint,2,opt,name=logical,proto3" json:"logical,omitempty"`
+	Up            bool                     `protobuf:"varint,3,opt,name=up,proto3" json:"up,omitempty"`
+	Mtu           uint32                   `protobuf:"varint,4,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	Kind          string                   `protobuf:"bytes,5,opt,name=kind,proto3" json:"kind,omitempty"`
+	Type          enums.NethelpersLinkType `protobuf:"varint,6,opt,name=type,proto3,enum=talos.resource.definitions.enums.NethelpersLinkType" json:"type,omitempty"`
+	ParentName    string                   `protobuf:"bytes,7,opt,name=parent_name,json=parentName,proto3" json:"parent_name,omitempty"`
+	BondSlave     *BondSlave               `protobuf:"bytes,8,opt,name=bond_slave,json=bondSlave,proto3" json:"bond_slave,omitempty"`
+	BridgeSlave   *BridgeSlave             `protobuf:"bytes,9,opt,name=bridge_slave,json=bridgeSlave,proto3" json:"bridge_slave,omitempty"`
+	Vlan          *VLANSpec                `protobuf:"bytes,10,opt,name=vlan,proto3" json:"vlan,omitempty"`
+	BondMaster    *BondMasterSpec          `protobuf:"bytes,11,opt,name=bond_master,json=bondMaster,proto3" json:"bond_master,omitempty"`
+	BridgeMaster  *BridgeMasterSpec        `protobuf:"bytes,12,opt,name=bridge_master,json=bridgeMaster,proto3" json:"bridge_master,omitempty"`
+	Wireguard     *WireguardSpec           `protobuf:"bytes,13,opt,name=wireguard,proto3" json:"wireguard,omitempty"`
+	ConfigLayer   enums.NetworkConfigLayer `protobuf:"varint,14,opt,name=config_layer,json=configLayer,proto3,enum=talos.resource.definitions.enums.NetworkConfigLayer" json:"config_layer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkSpecSpec) Reset() {
+	*x = LinkSpecSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkSpecSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkSpecSpec) ProtoMessage() {}
+
+func (x *LinkSpecSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkSpecSpec.ProtoReflect.Descriptor instead.
+func (*LinkSpecSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *LinkSpecSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LinkSpecSpec) GetLogical() bool {
+	if x != nil {
+		return x.Logical
+	}
+	return false
+}
+
+func (x *LinkSpecSpec) GetUp() bool {
+	if x != nil {
+		return x.Up
+	}
+	return false
+}
+
+func (x *LinkSpecSpec) GetMtu() uint32 {
+	if x != nil {
+		return x.Mtu
+	}
+	return 0
+}
+
+func (x *LinkSpecSpec) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *LinkSpecSpec) GetType() enums.NethelpersLinkType {
+	if x != nil {
+		return x.Type
+	}
+	return enums.NethelpersLinkType(0)
+}
+
+func (x *LinkSpecSpec) GetParentName() string {
+	if x != nil {
+		return x.ParentName
+	}
+	return ""
+}
+
+func (x *LinkSpecSpec) GetBondSlave() *BondSlave {
+	if x != nil {
+		return x.BondSlave
+	}
+	return nil
+}
+
+func (x *LinkSpecSpec) GetBridgeSlave() *BridgeSlave {
+	if x != nil {
+		return x.BridgeSlave
+	}
+	return nil
+}
+
+func (x *LinkSpecSpec) GetVlan() *VLANSpec {
+	if x != nil {
+		return x.Vlan
+	}
+	return nil
+}
+
+func (x *LinkSpecSpec) GetBondMaster() *BondMasterSpec {
+	if x != nil {
+		return x.BondMaster
+	}
+	return nil
+}
+
+func (x *LinkSpecSpec) GetBridgeMaster() *BridgeMasterSpec {
+	if x != nil {
+		return x.BridgeMaster
+	}
+	return nil
+}
+
+func (x *LinkSpecSpec) GetWireguard() *WireguardSpec {
+	if x != nil {
+		return x.Wireguard
+	}
+	return nil
+}
+
+func (x *LinkSpecSpec) GetConfigLayer() enums.NetworkConfigLayer {
+	if x != nil {
+		return x.ConfigLayer
+	}
+	return enums.NetworkConfigLayer(0)
+}
+
+// LinkStatusSpec describes status of rendered secrets.
+type LinkStatusSpec struct {
+	state            protoimpl.MessageState           `protogen:"open.v1"`
+	Index            uint32                           `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Type             enums.NethelpersLinkType         `protobuf:"varint,2,opt,name=type,proto3,enum=talos.resource.definitions.enums.NethelpersLinkType" json:"type,omitempty"`
+	LinkIndex        uint32                           `protobuf:"varint,3,opt,name=link_index,json=linkIndex,proto3" json:"link_index,omitempty"`
+	Flags            uint32                           `protobuf:"varint,4,opt,name=flags,proto3" json:"flags,omitempty"`
+	HardwareAddr     []byte                           `protobuf:"bytes,5,opt,name=hardware_addr,json=hardwareAddr,proto3" json:"hardware_addr,omitempty"`
+	BroadcastAddr    []byte                           `protobuf:"bytes,6,opt,name=broadcast_addr,json=broadcastAddr,proto3" json:"broadcast_addr,omitempty"`
+	Mtu              uint32                           `protobuf:"varint,7,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	QueueDisc        string                           `protobuf:"bytes,8,opt,name=queue_disc,json=queueDisc,proto3" json:"queue_disc,omitempty"`
+	MasterIndex      uint32                           `protobuf:"varint,9,opt,name=master_index,json=masterIndex,proto3" json:"master_index,omitempty"`
+	OperationalState enums.NethelpersOperationalState `protobuf:"varint,10,opt,name=operational_state,json=operationalState,proto3,enum=talos.resource.definitions.enums.NethelpersOperationalState" json:"operational_state,omitempty"`
+	Kind             string                           `protobuf:"bytes,11,opt,name=kind,proto3" json:"kind,omitempty"`
+	SlaveKind        string                           `protobuf:"bytes,12,opt,name=slave_kind,json=slaveKind,proto3" json:"slave_kind,omitempty"`
+	BusPath          string                           `protobuf:"bytes,13,opt,name=bus_path,json=busPath,proto3" json:"bus_path,omitempty"`
+	Pciid            string                           `protobuf:"bytes,14,opt,name=pciid,proto3" json:"pciid,omitempty"`
+	Driver           string                           `protobuf:"bytes,15,opt,name=driver,proto3" json:"driver,omitempty"`
+	DriverVersion    string                           `protobuf:"bytes,16,opt,name=driver_version,json=driverVersion,proto3" json:"driver_version,omitempty"`
+	FirmwareVersion  string                           `protobuf:"bytes,17,opt,name=firmware_version,json=firmwareVersion,proto3" json:"firmware_version,omitempty"`
+	ProductId        string                           `protobuf:"bytes,18,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VendorId         string                           `protobuf:"bytes,19,opt,name=vendor_id,json=vendorId,proto3" json:"vendor_id,omitempty"`
+	Product          string                           `protobuf:"bytes,20,opt,name=product,proto3" json:"product,omitempty"`
+	Vendor           string                           `protobuf:"bytes,21,opt,name=vendor,proto3" json:"vendor,omitempty"`
+	LinkState        bool                             `protobuf:"varint,22,opt,name=link_state,json=linkState,proto3" json:"link_state,omitempty"`
+	SpeedMegabits    int64                            `protobuf:"varint,23,opt,name=speed_megabits,json=speedMegabits,proto3" json:"speed_megabits,omitempty"`
+	Port             enums.NethelpersPort             `protobuf:"varint,24,opt,name=port,proto3,enum=talos.resource.definitions.enums.NethelpersPort" json:"port,omitempty"`
+	Duplex           enums.NethelpersDuplex           `protobuf:"varint,25,opt,name=duplex,proto3,enum=talos.resource.definitions.enums.NethelpersDuplex" json:"duplex,omitempty"`
+	Vlan             *VLANSpec                        `protobuf:"bytes,26,opt,name=vlan,proto3" json:"vlan,omitempty"`
+	BridgeMaster     *BridgeMasterSpec                `protobuf:"bytes,27,opt,name=bridge_master,json=bridgeMaster,proto3" json:"bridge_master,omitempty"`
+	BondMaster       *BondMasterSpec                  `protobuf:"bytes,28,opt,name=bond_master,json=bondMaster,proto3" json:"bond_master,omitempty"`
+	Wireguard        *WireguardSpec                   `protobuf:"bytes,29,opt,name=wireguard,proto3" json:"wireguard,omitempty"`
+	PermanentAddr    []byte                           `protobuf:"bytes,30,opt,name=permanent_addr,json=permanentAddr,proto3" json:"permanent_addr,omitempty"`
+	Alias            string                           `protobuf:"bytes,31,opt,name=alias,proto3" json:"alias,omitempty"`
+	AltNames         []string                         `protobuf:"bytes,32,rep,name=alt_names,json=altNames,proto3" json:"alt_names,omitempty"`
+	ConfiguredMtu    uint32                           `protobuf:"varint,33,opt,name=configured_mtu,json=configuredMtu,proto3" json:"configured_mtu,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *LinkStatusSpec) Reset() {
+	*x = LinkStatusSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkStatusSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkStatusSpec) ProtoMessage() {}
+
+func (x *LinkStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkStatusSpec.ProtoReflect.Descriptor instead.
+func (*LinkStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *LinkStatusSpec) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *LinkStatusSpec) GetType() enums.NethelpersLinkType {
+	if x != nil {
+		return x.Type
+	}
+	return enums.NethelpersLinkType(0)
+}
+
+func (x *LinkStatusSpec) GetLinkIndex() uint32 {
+	if x != nil {
+		return x.LinkIndex
+	}
+	return 0
+}
+
+func (x *LinkStatusSpec) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *LinkStatusSpec) GetHardwareAddr() []byte {
+	if x != nil {
+		return x.HardwareAddr
+	}
+	return nil
+}
+
+func (x *LinkStatusSpec) GetBroadcastAddr() []byte {
+	if x != nil {
+		return x.BroadcastAddr
+	}
+	return nil
+}
+
+func (x *LinkStatusSpec) GetMtu() uint32 {
+	if x != nil {
+		return x.Mtu
+	}
+	return 0
+}
+
+func (x *LinkStatusSpec) GetQueueDisc() string {
+	if x != nil {
+		return x.QueueDisc
+	}
+	return ""
+}
+
+func (x *LinkStatusSpec) GetMasterIndex() uint32 {
+	if x != nil {
+		return x.MasterIndex
+	}
+	return 0
+}
+
+func (x *LinkStatusSpec) GetOperationalState() enums.NethelpersOperationalState {
+	if x != nil {
+		return x.OperationalState
+	}
+	return enums.NethelpersOperationalState(0)
+}
+
+func (x *LinkStatusSpec) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *LinkStatusSpec) GetSlaveKind() string {
+	if x != nil {
+		return x.SlaveKind
+	}
+	return ""
+}
+
+func (x *LinkStatusSpec) GetBusPath() string {
+	if x != nil {
+		return x.BusPath
+	}
+	return ""
+}
+
+func (x *LinkStatusSpec) GetPciid() string {
+	if x != nil {
+		return x.Pciid
+	}
+	return ""
+}
+
+func (x *LinkStatusSpec) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *LinkStatusSpec) GetDriverVersion() string {
+	if x != nil {
+		return x.DriverVersion
+	}
+	return ""
+}
+
+func (x *LinkStatusSpec) GetFirmwareVersion() string {
+	if x != nil {
+		return x.FirmwareVersion
+	}
+	return ""
+}
+
+func (x *LinkStatusSpec) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *LinkStatusSpec) GetVendorId() string {
+	if x != nil {
+		return x.VendorId
+	}
+	return ""
+}
+
+func (x *LinkStatusSpec) GetProduct() string {
+	if x != nil {
+		return x.Product
+	}
+	return ""
+}
+
+func (x *LinkStatusSpec) GetVendor() string {
+	if x != nil {
+		return x.Vendor
+	}
+	return ""
+}
+
+func (x *LinkStatusSpec) GetLinkState() bool {
+	if x != nil {
+		return x.LinkState
+	}
+	return false
+}
+
+func (x *LinkStatusSpec) GetSpeedMegabits() int64 {
+	if x != nil {
+		return x.SpeedMegabits
+	}
+	return 0
+}
+
+func (x *LinkStatusSpec) GetPort() enums.NethelpersPort {
+	if x != nil {
+		return x.Port
+	}
+	return enums.NethelpersPort(0)
+}
+
+func (x *LinkStatusSpec) GetDuplex() enums.NethelpersDuplex {
+	if x != nil {
+		return x.Duplex
+	}
+	return enums.NethelpersDuplex(0)
+}
+
+func (x *LinkStatusSpec) GetVlan() *VLANSpec {
+	if x != nil {
+		return x.Vlan
+	}
+	return nil
+}
+
+func (x *LinkStatusSpec) GetBridgeMaster() *BridgeMasterSpec {
+	if x != nil {
+		return x.BridgeMaster
+	}
+	return nil
+}
+
+func (x *LinkStatusSpec) GetBondMaster() *BondMasterSpec {
+	if x != nil {
+		return x.BondMaster
+	}
+	return nil
+}
+
+func (x *LinkStatusSpec) GetWireguard() *WireguardSpec {
+	if x != nil {
+		return x.Wireguard
+	}
+	return nil
+}
+
+func (x *LinkStatusSpec) GetPermanentAddr() []byte {
+	if x != nil {
+		return x.PermanentAddr
+	}
+	return nil
+}
+
+func (x *LinkStatusSpec) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *LinkStatusSpec) GetAltNames() []string {
+	if x != nil {
+		return x.AltNames
+	}
+	return nil
+}
+
+func (x *LinkStatusSpec) GetConfiguredMtu() uint32 {
+	if x != nil {
+		return x.ConfiguredMtu
+	}
+	return 0
+}
+
+// NfTablesAddressMatch describes the match on the IP address.
+type NfTablesAddressMatch struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	IncludeSubnets []*common.NetIPPrefix  `protobuf:"bytes,1,rep,name=include_subnets,json=includeSubnets,proto3" json:"include_subnets,omitempty"`
+	ExcludeSubnets []*common.NetIPPrefix  `protobuf:"bytes,2,rep,name=exclude_subnets,json=excludeSubnets,proto3" json:"exclude_subnets,omitempty"`
+	Invert         bool                   `protobuf:"varint,3,opt,name=invert,proto3" json:"invert,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NfTablesAddressMatch) Reset() {
+	*x = NfTablesAddressMatch{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NfTablesAddressMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NfTablesAddressMatch) ProtoMessage() {}
+
+func (x *NfTablesAddressMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NfTablesAddressMatch.ProtoReflect.Descriptor instead.
+func (*NfTablesAddressMatch) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *NfTablesAddressMatch) GetIncludeSubnets() []*common.NetIPPrefix {
+	if x != nil {
+		return x.IncludeSubnets
+	}
+	return nil
+}
+
+func (x *NfTablesAddressMatch) GetExcludeSubnets() []*common.NetIPPrefix {
+	if x != nil {
+		return x.ExcludeSubnets
+	}
+	return nil
+}
+
+func (x *NfTablesAddressMatch) GetInvert() bool {
+	if x != nil {
+		return x.Invert
+	}
+	return false
+}
+
+// NfTablesChainSpec describes status of rendered secrets.
+type NfTablesChainSpec struct {
+	state         protoimpl.MessageState                `protogen:"open.v1"`
+	Type          string                                `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Hook          enums.NethelpersNfTablesChainHook     `protobuf:"varint,2,opt,name=hook,proto3,enum=talos.resource.definitions.enums.NethelpersNfTablesChainHook" json:"hook,omitempty"`
+	Priority      enums.NethelpersNfTablesChainPriority `protobuf:"varint,3,opt,name=priority,proto3,enum=talos.resource.definitions.enums.NethelpersNfTablesChainPriority" json:"priority,omitempty"`
+	Rules         []*NfTablesRule                       `protobuf:"bytes,4,rep,name=rules,proto3" json:"rules,omitempty"`
+	Policy        enums.NethelpersNfTablesVerdict       `protobuf:"varint,5,opt,name=policy,proto3,enum=talos.resource.definitions.enums.NethelpersNfTablesVerdict" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NfTablesChainSpec) Reset() {
+	*x = NfTablesChainSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NfTablesChainSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NfTablesChainSpec) ProtoMessage() {}
+
+func (x *NfTablesChainSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NfTablesChainSpec.ProtoReflect.Descriptor instead.
+func (*NfTablesChainSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *NfTablesChainSpec) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *NfTablesChainSpec) GetHook() enums.NethelpersNfTablesChainHook {
+	if x != nil {
+		return x.Hook
+	}
+	return enums.NethelpersNfTablesChainHook(0)
+}
+
+func (x *NfTablesChainSpec) GetPriority() enums.NethelpersNfTablesChainPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return enums.NethelpersNfTablesChainPriority(0)
+}
+
+func (x *NfTablesChainSpec) GetRules() []*NfTablesRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *NfTablesChainSpec) GetPolicy() enums.NethelpersNfTablesVerdict {
+	if x != nil {
+		return x.Policy
+	}
+	return enums.NethelpersNfTablesVerdict(0)
+}
+
+// NfTablesClampMSS describes the TCP MSS clamping operation.
+//
+// MSS is limited by the `MaxMTU` so that:
+// - IPv4: MSS = MaxMTU - 40
+// - IPv6: MSS = MaxMTU - 60.
+type NfTablesClampMSS struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mtu           uint32                 `protobuf:"fixed32,1,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NfTablesClampMSS) Reset() {
+	*x = NfTablesClampMSS{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NfTablesClampMSS) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NfTablesClampMSS) ProtoMessage() {}
+
+func (x *NfTablesClampMSS) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NfTablesClampMSS.ProtoReflect.Descriptor instead.
+func (*NfTablesClampMSS) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *NfTablesClampMSS) GetMtu() uint32 {
+	if x != nil {
+		return x.Mtu
+	}
+	return 0
+}
+
+// NfTablesConntrackStateMatch describes the match on the connection tracking state.
+type NfTablesConntrackStateMatch struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	States        []enums.NethelpersConntrackState `protobuf:"varint,1,rep,packed,name=states,proto3,enum=talos.resource.definitions.enums.NethelpersConntrackState" json:"states,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NfTablesConntrackStateMatch) Reset() {
+	*x = NfTablesConntrackStateMatch{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NfTablesConntrackStateMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NfTablesConntrackStateMatch) ProtoMessage() {}
+
+func (x *NfTablesConntrackStateMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NfTablesConntrackStateMatch.ProtoReflect.Descriptor instead.
+func (*NfTablesConntrackStateMatch) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *NfTablesConntrackStateMatch) GetStates() []enums.NethelpersConntrackState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+// NfTablesICMPTypeMatch describes the match on the ICMP type.
+type NfTablesICMPTypeMatch struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Types         []enums.NethelpersICMPType `protobuf:"varint,1,rep,packed,name=types,proto3,enum=talos.resource.definitions.enums.NethelpersICMPType" json:"types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NfTablesICMPTypeMatch) Reset() {
+	*x = NfTablesICMPTypeMatch{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NfTablesICMPTypeMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NfTablesICMPTypeMatch) ProtoMessage() {}
+
+func (x *NfTablesICMPTypeMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NfTablesICMPTypeMatch.ProtoReflect.Descriptor instead.
+func (*NfTablesICMPTypeMatch) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *NfTablesICMPTypeMatch) GetTypes() []enums.NethelpersICMPType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+// NfTablesIfNameMatch describes the match on the interface name.
+type NfTablesIfNameMatch struct {
+	state          protoimpl.MessageState        `protogen:"open.v1"`
+	Operator       enums.NethelpersMatchOperator `protobuf:"varint,2,opt,name=operator,proto3,enum=talos.resource.definitions.enums.NethelpersMatchOperator" json:"operator,omitempty"`
+	InterfaceNames []string                      `protobuf:"bytes,3,rep,name=interface_names,json=interfaceNames,proto3" json:"interface_names,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NfTablesIfNameMatch) Reset() {
+	*x = NfTablesIfNameMatch{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NfTablesIfNameMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NfTablesIfNameMatch) ProtoMessage() {}
+
+func (x *NfTablesIfNameMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NfTablesIfNameMatch.ProtoReflect.Descriptor instead.
+func (*NfTablesIfNameMatch) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *NfTablesIfNameMatch) GetOperator() enums.NethelpersMatchOperator {
+	if x != nil {
+		return x.Operator
+	}
+	return enums.NethelpersMatchOperator(0)
+}
+
+func (x *NfTablesIfNameMatch) GetInterfaceNames() []string {
+	if x != nil {
+		return x.InterfaceNames
+	}
+	return nil
+}
+
+// NfTablesLayer4Match describes the match on the transport layer protocol.
+type NfTablesLayer4Match struct {
+	state                protoimpl.MessageState   `protogen:"open.v1"`
+	Protocol             enums.NethelpersProtocol `protobuf:"varint,1,opt,name=protocol,proto3,enum=talos.resource.definitions.enums.NethelpersProtocol" json:"protocol,omitempty"`
+	MatchSourcePort      *NfTablesPortMatch       `protobuf:"bytes,2,opt,name=match_source_port,json=matchSourcePort,proto3" json:"match_source_port,omitempty"`
+	MatchDestinationPort *NfTablesPortMatch       `protobuf:"bytes,3,opt,name=match_destination_port,json=matchDestinationPort,proto3" json:"match_destination_port,omitempty"`
+	MatchIcmpType        *NfTablesICMPTypeMatch   `protobuf:"bytes,4,opt,name=match_icmp_type,json=matchIcmpType,proto3" json:"match_icmp_type,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *NfTablesLayer4Match) Reset() {
+	*x = NfTablesLayer4Match{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NfTablesLayer4Match) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NfTablesLayer4Match) ProtoMessage() {}
+
+func (x *NfTablesLayer4Match) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NfTablesLayer4Match.ProtoReflect.Descriptor instead.
+func (*NfTablesLayer4Match) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *NfTablesLayer4Match) GetProtocol() enums.NethelpersProtocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return enums.NethelpersProtocol(0)
+}
+
+func (x *NfTablesLayer4Match) GetMatchSourcePort() *NfTablesPortMatch {
+	if x != nil {
+		return x.MatchSourcePort
+	}
+	return nil
+}
+
+func (x *NfTablesLayer4Match) GetMatchDestinationPort() *NfTablesPortMatch {
+	if x != nil {
+		return x.MatchDestinationPort
+	}
+	return nil
+}
+
+func (x *NfTablesLayer4Match) GetMatchIcmpType() *NfTablesICMPTypeMatch {
+	if x != nil {
+		return x.MatchIcmpType
+	}
+	return nil
+}
+
+// NfTablesLimitMatch describes the match on the packet rate.
+type NfTablesLimitMatch struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	PacketRatePerSecond uint64                 `protobuf:"varint,1,opt,name=packet_rate_per_second,json=packetRatePerSecond,proto3" json:"packet_rate_per_second,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *NfTablesLimitMatch) Reset() {
+	*x = NfTablesLimitMatch{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NfTablesLimitMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NfTablesLimitMatch) ProtoMessage() {}
+
+func (x *NfTablesLimitMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NfTablesLimitMatch.ProtoReflect.Descriptor instead.
+func (*NfTablesLimitMatch) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *NfTablesLimitMatch) GetPacketRatePerSecond() uint64 {
+	if x != nil {
+		return x.PacketRatePerSecond
+	}
+	return 0
+}
+
+// NfTablesLog describes the log statement.
+type NfTablesLog struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prefix        string                 `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NfTablesLog) Reset() {
+	*x = NfTablesLog{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NfTablesLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NfTablesLog) ProtoMessage() {}
+
+func (x *NfTablesLog) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NfTablesLog.ProtoReflect.Descriptor instead.
+func (*NfTablesLog) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *NfTablesLog) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+// NfTablesMark encodes packet mark match/update operation.
+//
+// When used as a match computes the following condition:
+// (mark & mask) ^ xor == value
+//
+// When used as an update computes the following operation:
+// mark = (mark & mask) ^ xor.
+type NfTablesMark struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mask          uint32                 `protobuf:"varint,1,opt,name=mask,proto3" json:"mask,omitempty"`
+	Xor           uint32                 `protobuf:"varint,2,opt,name=xor,proto3" json:"xor,omitempty"`
+	Value         uint32                 `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NfTablesMark) Reset() {
+	*x = NfTablesMark{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NfTablesMark) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NfTablesMark) ProtoMessage() {}
+
+func (x *NfTablesMark) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NfTablesMark.ProtoReflect.Descriptor instead.
+func (*NfTablesMark) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *NfTablesMark) GetMask() uint32 {
+	if x != nil {
+		return x.Mask
+	}
+	return 0
+}
+
+func (x *NfTablesMark) GetXor() uint32 {
+	if x != nil {
+		return x.Xor
+	}
+	return 0
+}
+
+func (x *NfTablesMark) GetValue() uint32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// NfTablesPortMatch describes the match on the transport layer port.
+type NfTablesPortMatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ranges        []*PortRange           `protobuf:"bytes,1,rep,name=ranges,proto3" json:"ranges,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NfTablesPortMatch) Reset() {
+	*x = NfTablesPortMatch{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NfTablesPortMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NfTablesPortMatch) ProtoMessage() {}
+
+func (x *NfTablesPortMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NfTablesPortMatch.ProtoReflect.Descriptor instead.
+func (*NfTablesPortMatch) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *NfTablesPortMatch) GetRanges() []*PortRange {
+	if x != nil {
+		return x.Ranges
+	}
+	return nil
+}
+
+// NfTablesRule describes a single rule in the nftables chain.
+type NfTablesRule struct {
+	state                   protoimpl.MessageState          `protogen:"open.v1"`
+	MatchOIfName            *NfTablesIfNameMatch            `protobuf:"bytes,1,opt,name=match_o_if_name,json=matchOIfName,proto3" json:"match_o_if_name,omitempty"`
+	Verdict                 enums.NethelpersNfTablesVerdict `protobuf:"varint,2,opt,name=verdict,proto3,enum=talos.resource.definitions.enums.NethelpersNfTablesVerdict" json:"verdict,omitempty"`
+	MatchMark               *NfTablesMark                   `protobuf:"bytes,3,opt,name=match_mark,json=matchMark,proto3" json:"match_mark,omitempty"`
+	SetMark                 *NfTablesMark                   `protobuf:"bytes,4,opt,name=set_mark,json=setMark,proto3" json:"set_mark,omitempty"`
+	MatchSourceAddress      *NfTablesAddressMatch           `protobuf:"bytes,5,opt,name=match_source_address,json=matchSourceAddress,proto3" json:"match_source_address,omitempty"`
+	MatchDestinationAddress *NfTablesAddressMatch           `protobuf:"bytes,6,opt,name=match_destination_address,json=matchDestinationAddress,proto3" json:"match_destination_address,omitempty"`
+	MatchLayer4             *NfTablesLayer4Match            `protobuf:"bytes,7,opt,name=match_layer4,json=matchLayer4,proto3" json:"match_layer4,omitempty"`
+	MatchIIfName            *NfTablesIfNameMatch            `protobuf:"bytes,8,opt,name=match_i_if_name,json=matchIIfName,proto3" json:"match_i_if_name,omitempty"`
+	ClampMss                *NfTablesClampMSS               `protobuf:"bytes,9,opt,name=clamp_mss,json=clampMss,proto3" json:"clamp_mss,omitempty"`
+	MatchLimit              *NfTablesLimitMatch             `protobuf:"bytes,10,opt,name=match_limit,json=matchLimit,proto3" json:"match_limit,omitempty"`
+	MatchConntrackState     *NfTablesConntrackStateMatch    `protobuf:"bytes,11,opt,name=match_conntrack_state,json=matchConntrackState,proto3" json:"match_conntrack_state,omitempty"`
+	AnonCounter             bool                            `protobuf:"varint,12,opt,name=anon_counter,json=anonCounter,proto3" json:"anon_counter,omitempty"`
+	Log                     *NfTablesLog                    `protobuf:"bytes,13,opt,name=log,proto3" json:"log,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *NfTablesRule) Reset() {
+	*x = NfTablesRule{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NfTablesRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NfTablesRule) ProtoMessage() {}
+
+func (x *NfTablesRule) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NfTablesRule.ProtoReflect.Descriptor instead.
+func (*NfTablesRule) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *NfTablesRule) GetMatchOIfName() *NfTablesIfNameMatch {
+	if x != nil {
+		return x.MatchOIfName
+	}
+	return nil
+}
+
+func (x *NfTablesRule) GetVerdict() enums.NethelpersNfTablesVerdict {
+	if x != nil {
+		return x.Verdict
+	}
+	return enums.NethelpersNfTablesVerdict(0)
+}
+
+func (x *NfTablesRule) GetMatchMark() *NfTablesMark {
+	if x != nil {
+		return x.MatchMark
+	}
+	return nil
+}
+
+func (x *NfTablesRule) GetSetMark() *NfTablesMark {
+	if x != nil {
+		return x.SetMark
+	}
+	return nil
+}
+
+func (x *NfTablesRule) GetMatchSourceAddress() *NfTablesAddressMatch {
+	if x != nil {
+		return x.MatchSourceAddress
+	}
+	return nil
+}
+
+func (x *NfTablesRule) GetMatchDestinationAddress() *NfTablesAddressMatch {
+	if x != nil {
+		return x.MatchDestinationAddress
+	}
+	return nil
+}
+
+func (x *NfTablesRule) GetMatchLayer4() *NfTablesLayer4Match {
+	if x != nil {
+		return x.MatchLayer4
+	}
+	return nil
+}
+
+func (x *NfTablesRule) GetMatchIIfName() *NfTablesIfNameMatch {
+	if x != nil {
+		return x.MatchIIfName
+	}
+	return nil
+}
+
+func (x *NfTablesRule) GetClampMss() *NfTablesClampMSS {
+	if x != nil {
+		return x.ClampMss
+	}
+	return nil
+}
+
+func (x *NfTablesRule) GetMatchLimit() *NfTablesLimitMatch {
+	if x != nil {
+		return x.MatchLimit
+	}
+	return nil
+}
+
+func (x *NfTablesRule) GetMatchConntrackState() *NfTablesConntrackStateMatch {
+	if x != nil {
+		return x.MatchConntrackState
+	}
+	return nil
+}
+
+func (x *NfTablesRule) GetAnonCounter() bool {
+	if x != nil {
+		return x.AnonCounter
+	}
+	return false
+}
+
+func (x *NfTablesRule) GetLog() *NfTablesLog {
+	if x != nil {
+		return x.Log
+	}
+	return nil
+}
+
+// NodeAddressFilterSpec describes a filter for NodeAddresses.
+type NodeAddressFilterSpec struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	IncludeSubnets []*common.NetIPPrefix  `protobuf:"bytes,1,rep,name=include_subnets,json=includeSubnets,proto3" json:"include_subnets,omitempty"`
+	ExcludeSubnets []*common.NetIPPrefix  `protobuf:"bytes,2,rep,name=exclude_subnets,json=excludeSubnets,proto3" json:"exclude_subnets,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NodeAddressFilterSpec) Reset() {
+	*x = NodeAddressFilterSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeAddressFilterSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeAddressFilterSpec) ProtoMessage() {}
+
+func (x *NodeAddressFilterSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeAddressFilterSpec.ProtoReflect.Descriptor instead.
+func (*NodeAddressFilterSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *NodeAddressFilterSpec) GetIncludeSubnets() []*common.NetIPPrefix {
+	if x != nil {
+		return x.IncludeSubnets
+	}
+	return nil
+}
+
+func (x *NodeAddressFilterSpec) GetExcludeSubnets() []*common.NetIPPrefix {
+	if x != nil {
+		return x.ExcludeSubnets
+	}
+	return nil
+}
+
+// NodeAddressSortAlgorithmSpec describes a filter for NodeAddresses.
+type NodeAddressSortAlgorithmSpec struct {
+	state         protoimpl.MessageState               `protogen:"open.v1"`
+	Algorithm     enums.NethelpersAddressSortAlgorithm `protobuf:"varint,1,opt,name=algorithm,proto3,enum=talos.resource.definitions.enums.NethelpersAddressSortAlgorithm" json:"algorithm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeAddressSortAlgorithmSpec) Reset() {
+	*x = NodeAddressSortAlgorithmSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeAddressSortAlgorithmSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeAddressSortAlgorithmSpec) ProtoMessage() {}
+
+func (x *NodeAddressSortAlgorithmSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeAddressSortAlgorithmSpec.ProtoReflect.Descriptor instead.
+func (*NodeAddressSortAlgorithmSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *NodeAddressSortAlgorithmSpec) GetAlgorithm() enums.NethelpersAddressSortAlgorithm {
+	if x != nil {
+		return x.Algorithm
+	}
+	return enums.NethelpersAddressSortAlgorithm(0)
+}
+
+// NodeAddressSpec describes a set of node addresses.
+type NodeAddressSpec struct {
+	state         protoimpl.MessageState               `protogen:"open.v1"`
+	Addresses     []*common.NetIPPrefix                `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	SortAlgorithm enums.NethelpersAddressSortAlgorithm `protobuf:"varint,2,opt,name=sort_algorithm,json=sortAlgorithm,proto3,enum=talos.resource.definitions.enums.NethelpersAddressSortAlgorithm" json:"sort_algorithm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeAddressSpec) Reset() {
+	*x = NodeAddressSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeAddressSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeAddressSpec) ProtoMessage() {}
+
+func (x *NodeAddressSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeAddressSpec.ProtoReflect.Descriptor instead.
+func (*NodeAddressSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *NodeAddressSpec) GetAddresses() []*common.NetIPPrefix {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *NodeAddressSpec) GetSortAlgorithm() enums.NethelpersAddressSortAlgorithm {
+	if x != nil {
+		return x.SortAlgorithm
+	}
+	return enums.NethelpersAddressSortAlgorithm(0)
+}
+
+// OperatorSpecSpec describes DNS resolvers.
+type OperatorSpecSpec struct {
+	state          protoimpl.MessageState      `protogen:"open.v1"`
+	Operator       enums.NetworkOperator       `protobuf:"varint,1,opt,name=operator,proto3,enum=talos.resource.definitions.enums.NetworkOperator" json:"operator,omitempty"`
+	LinkName       string                      `protobuf:"bytes,2,opt,name=link_name,json=linkName,proto3" json:"link_name,omitempty"`
+	RequireUp      bool                        `protobuf:"varint,3,opt,name=require_up,json=requireUp,proto3" json:"require_up,omitempty"`
+	Dhcp4          *DHCP4OperatorSpec          `protobuf:"bytes,4,opt,name=dhcp4,proto3" json:"dhcp4,omitempty"`
+	Dhcp6          *DHCP6OperatorSpec          `protobuf:"bytes,5,opt,name=dhcp6,proto3" json:"dhcp6,omitempty"`
+	Vip            *VIPOperatorSpec            `protobuf:"bytes,6,opt,name=vip,proto3" json:"vip,omitempty"`
+	ConfigLayer    enums.NetworkConfigLayer    `protobuf:"varint,7,opt,name=config_layer,json=configLayer,proto3,enum=talos.resource.definitions.enums.NetworkConfigLayer" json:"config_layer,omitempty"`
+	StaticFallback *StaticFallbackOperatorSpec `protobuf:"bytes,8,opt,name=static_fallback,json=staticFallback,proto3" json:"static_fallback,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OperatorSpecSpec) Reset() {
+	*x = OperatorSpecSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *NfTablesLayer4Match) GetProtocol() enums.NethelpersProtocol {
+func (x *OperatorSpecSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperatorSpecSpec) ProtoMessage() {}
+
+func (x *OperatorSpecSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[51]
 	if x != nil {
-		return x.Protocol
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return enums.NethelpersProtocol(0)
+	return mi.MessageOf(x)
 }
 
-func (x *NfTablesLayer4Match) GetMatchSourcePort() *NfTablesPortMatch {
+// Deprecated: Use OperatorSpecSpec.ProtoReflect.Descriptor instead.
+func (*OperatorSpecSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *OperatorSpecSpec) GetOperator() enums.NetworkOperator {
 	if x != nil {
-		return x.MatchSourcePort
+		return x.Operator
+	}
+	return enums.NetworkOperator(0)
+}
+
+func (x *OperatorSpecSpec) GetLinkName() string {
+	if x != nil {
+		return x.LinkName
+	}
+	return ""
+}
+
+func (x *OperatorSpecSpec) GetRequireUp() bool {
+	if x != nil {
+		return x.RequireUp
+	}
+	return false
+}
+
+func (x *OperatorSpecSpec) GetDhcp4() *DHCP4OperatorSpec {
+	if x != nil {
+		return x.Dhcp4
 	}
 	return nil
 }
 
-func (x *NfTablesLayer4Match) GetMatchDestinationPort() *NfTablesPortMatch {
+func (x *OperatorSpecSpec) GetDhcp6() *DHCP6OperatorSpec {
 	if x != nil {
-		return x.MatchDestinationPort
+		return x.Dhcp6
 	}
 	return nil
 }
 
-func (x *NfTablesLayer4Match) GetMatchIcmpType() *NfTablesICMPTypeMatch {
+func (x *OperatorSpecSpec) GetVip() *VIPOperatorSpec {
 	if x != nil {
-		return x.MatchIcmpType
+		return x.Vip
 	}
 	return nil
 }
 
-// NfTablesLimitMatch describes the match on the packet rate.
-type NfTablesLimitMatch struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	PacketRatePerSecond uint64                 `protobuf:"varint,1,opt,name=packet_rate_per_second,json=packetRatePerSecond,proto3" json:"packet_rate_per_second,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+func (x *OperatorSpecSpec) GetConfigLayer() enums.NetworkConfigLayer {
+	if x != nil {
+		return x.ConfigLayer
+	}
+	return enums.NetworkConfigLayer(0)
 }
 
-func (x *NfTablesLimitMatch) Reset() {
-	*x = NfTablesLimitMatch{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[31]
+func (x *OperatorSpecSpec) GetStaticFallback() *StaticFallbackOperatorSpec {
+	if x != nil {
+		return x.StaticFallback
+	}
+	return nil
+}
+
+// PlatformConfigSpec describes platform network configuration.
+//
+// This structure is marshaled to STATE partition to persist cached network configuration across
+// reboots.
+type PlatformConfigSpec struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Addresses     []*AddressSpecSpec            `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Links         []*LinkSpecSpec               `protobuf:"bytes,2,rep,name=links,proto3" json:"links,omitempty"`
+	Routes        []*RouteSpecSpec              `protobuf:"bytes,3,rep,name=routes,proto3" json:"routes,omitempty"`
+	Hostnames     []*HostnameSpecSpec           `protobuf:"bytes,4,rep,name=hostnames,proto3" json:"hostnames,omitempty"`
+	Resolvers     []*ResolverSpecSpec           `protobuf:"bytes,5,rep,name=resolvers,proto3" json:"resolvers,omitempty"`
+	TimeServers   []*TimeServerSpecSpec         `protobuf:"bytes,6,rep,name=time_servers,json=timeServers,proto3" json:"time_servers,omitempty"`
+	Operators     []*OperatorSpecSpec           `protobuf:"bytes,7,rep,name=operators,proto3" json:"operators,omitempty"`
+	ExternalIps   []*common.NetIP               `protobuf:"bytes,8,rep,name=external_ips,json=externalIps,proto3" json:"external_ips,omitempty"`
+	Probes        []*ProbeSpecSpec              `protobuf:"bytes,9,rep,name=probes,proto3" json:"probes,omitempty"`
+	Metadata      *runtime.PlatformMetadataSpec `protobuf:"bytes,10,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlatformConfigSpec) Reset() {
+	*x = PlatformConfigSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NfTablesLimitMatch) String() string {
+func (x *PlatformConfigSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NfTablesLimitMatch) ProtoMessage() {}
+func (*PlatformConfigSpec) ProtoMessage() {}
 
-func (x *NfTablesLimitMatch) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[31]
+func (x *PlatformConfigSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2659,49 +4295,107 @@ func (x *NfTablesLimitMatch) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NfTablesLimitMatch.ProtoReflect.Descriptor instead.
-func (*NfTablesLimitMatch) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use PlatformConfigSpec.ProtoReflect.Descriptor instead.
+func (*PlatformConfigSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *NfTablesLimitMatch) GetPacketRatePerSecond() uint64 {
+func (x *PlatformConfigSpec) GetAddresses() []*AddressSpecSpec {
 	if x != nil {
-		return x.PacketRatePerSecond
+		return x.Addresses
 	}
-	return 0
+	return nil
 }
 
-// NfTablesMark encodes packet mark match/update operation.
-//
-// When used as a match computes the following condition:
-// (mark & mask) ^ xor == value
+func (x *PlatformConfigSpec) GetLinks() []*LinkSpecSpec {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *PlatformConfigSpec) GetRoutes() []*RouteSpecSpec {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+func (x *PlatformConfigSpec) GetHostnames() []*HostnameSpecSpec {
+	if x != nil {
+		return x.Hostnames
+	}
+	return nil
+}
+
+func (x *PlatformConfigSpec) GetResolvers() []*ResolverSpecSpec {
+	if x != nil {
+		return x.Resolvers
+	}
+	return nil
+}
+
+func (x *PlatformConfigSpec) GetTimeServers() []*TimeServerSpecSpec {
+	if x != nil {
+		return x.TimeServers
+	}
+	return nil
+}
+
+func (x *PlatformConfigSpec) GetOperators() []*OperatorSpecSpec {
+	if x != nil {
+		return x.Operators
+	}
+	return nil
+}
+
+func (x *PlatformConfigSpec) GetExternalIps() []*common.NetIP {
+	if x != nil {
+		return x.ExternalIps
+	}
+	return nil
+}
+
+func (x *PlatformConfigSpec) GetProbes() []*ProbeSpecSpec {
+	if x != nil {
+		return x.Probes
+	}
+	return nil
+}
+
+func (x *PlatformConfigSpec) GetMetadata() *runtime.PlatformMetadataSpec {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// PortRange describes a range of ports.
 //
-// When used as an update computes the following operation:
-// mark = (mark & mask) ^ xor.
-type NfTablesMark struct {
+// Range is [lo, hi].
+type PortRange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Mask          uint32                 `protobuf:"varint,1,opt,name=mask,proto3" json:"mask,omitempty"`
-	Xor           uint32                 `protobuf:"varint,2,opt,name=xor,proto3" json:"xor,omitempty"`
-	Value         uint32                 `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	Lo            uint32                 `protobuf:"fixed32,1,opt,name=lo,proto3" json:"lo,omitempty"`
+	Hi            uint32                 `protobuf:"fixed32,2,opt,name=hi,proto3" json:"hi,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NfTablesMark) Reset() {
-	*x = NfTablesMark{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[32]
+func (x *PortRange) Reset() {
+	*x = PortRange{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NfTablesMark) String() string {
+func (x *PortRange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NfTablesMark) ProtoMessage() {}
+func (*PortRange) ProtoMessage() {}
 
-func (x *NfTablesMark) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[32]
+func (x *PortRange) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2712,55 +4406,53 @@ func (x *NfTablesMark) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NfTablesMark.ProtoReflect.Descriptor instead.
-func (*NfTablesMark) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{32}
-}
-
-func (x *NfTablesMark) GetMask() uint32 {
-	if x != nil {
-		return x.Mask
-	}
-	return 0
+// Deprecated: Use PortRange.ProtoReflect.Descriptor instead.
+func (*PortRange) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *NfTablesMark) GetXor() uint32 {
+func (x *PortRange) GetLo() uint32 {
 	if x != nil {
-		return x.Xor
+		return x.Lo
 	}
 	return 0
 }
 
-func (x *NfTablesMark) GetValue() uint32 {
+func (x *PortRange) GetHi() uint32 {
 	if x != nil {
-		return x.Value
+		return x.Hi
 	}
 	return 0
 }
 
-// NfTablesPortMatch describes the match on the transport layer port.
-type NfTablesPortMatch struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ranges        []*PortRange           `protobuf:"bytes,1,rep,name=ranges,proto3" json:"ranges,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// ProbeSpecSpec describes the Probe.
+type ProbeSpecSpec struct {
+	state            protoimpl.MessageState   `protogen:"open.v1"`
+	Interval         *durationpb.Duration     `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	FailureThreshold int64                    `protobuf:"varint,2,opt,name=failure_threshold,json=failureThreshold,proto3" json:"failure_threshold,omitempty"`
+	Tcp              *TCPProbeSpec            `protobuf:"bytes,3,opt,name=tcp,proto3" json:"tcp,omitempty"`
+	ConfigLayer      enums.NetworkConfigLayer `protobuf:"varint,4,opt,name=config_layer,json=configLayer,proto3,enum=talos.resource.definitions.enums.NetworkConfigLayer" json:"config_layer,omitempty"`
+	Http             *HTTPProbeSpec           `protobuf:"bytes,5,opt,name=http,proto3" json:"http,omitempty"`
+	Icmp             *ICMPProbeSpec           `protobuf:"bytes,6,opt,name=icmp,proto3" json:"icmp,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *NfTablesPortMatch) Reset() {
-	*x = NfTablesPortMatch{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[33]
+func (x *ProbeSpecSpec) Reset() {
+	*x = ProbeSpecSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NfTablesPortMatch) String() string {
+func (x *ProbeSpecSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NfTablesPortMatch) ProtoMessage() {}
+func (*ProbeSpecSpec) ProtoMessage() {}
 
-func (x *NfTablesPortMatch) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[33]
+func (x *ProbeSpecSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2771,52 +4463,79 @@ func (x *NfTablesPortMatch) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NfTablesPortMatch.ProtoReflect.Descriptor instead.
-func (*NfTablesPortMatch) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use ProbeSpecSpec.ProtoReflect.Descriptor instead.
+func (*ProbeSpecSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *NfTablesPortMatch) GetRanges() []*PortRange {
+func (x *ProbeSpecSpec) GetInterval() *durationpb.Duration {
 	if x != nil {
-		return x.Ranges
+		return x.Interval
 	}
 	return nil
 }
 
-// NfTablesRule describes a single rule in the nftables chain.
-type NfTablesRule struct {
-	state                   protoimpl.MessageState          `protogen:"open.v1"`
-	MatchOIfName            *NfTablesIfNameMatch            `protobuf:"bytes,1,opt,name=match_o_if_name,json=matchOIfName,proto3" json:"match_o_if_name,omitempty"`
-	Verdict                 enums.NethelpersNfTablesVerdict `protobuf:"varint,2,opt,name=verdict,proto3,enum=talos.resource.definitions.enums.NethelpersNfTablesVerdict" json:"verdict,omitempty"`
-	MatchMark               *NfTablesMark                   `protobuf:"bytes,3,opt,name=match_mark,json=matchMark,proto3" json:"match_mark,omitempty"`
-	SetMark                 *NfTablesMark                   `protobuf:"bytes,4,opt,name=set_mark,json=setMark,proto3" json:"set_mark,omitempty"`
-	MatchSourceAddress      *NfTablesAddressMatch           `protobuf:"bytes,5,opt,name=match_source_address,json=matchSourceAddress,proto3" json:"match_source_address,omitempty"`
-	MatchDestinationAddress *NfTablesAddressMatch           `protobuf:"bytes,6,opt,name=match_destination_address,json=matchDestinationAddress,proto3" json:"match_destination_address,omitempty"`
-	MatchLayer4             *NfTablesLayer4Match            `protobuf:"bytes,7,opt,name=match_layer4,json=matchLayer4,proto3" json:"match_layer4,omitempty"`
-	MatchIIfName            *NfTablesIfNameMatch            `protobuf:"bytes,8,opt,name=match_i_if_name,json=matchIIfName,proto3" json:"match_i_if_name,omitempty"`
-	ClampMss                *NfTablesClampMSS               `protobuf:"bytes,9,opt,name=clamp_mss,json=clampMss,proto3" json:"clamp_mss,omitempty"`
-	MatchLimit              *NfTablesLimitMatch             `protobuf:"bytes,10,opt,name=match_limit,json=matchLimit,proto3" json:"match_limit,omitempty"`
-	MatchConntrackState     *NfTablesConntrackStateMatch    `protobuf:"bytes,11,opt,name=match_conntrack_state,json=matchConntrackState,proto3" json:"match_conntrack_state,omitempty"`
-	AnonCounter             bool                            `protobuf:"varint,12,opt,name=anon_counter,json=anonCounter,proto3" json:"anon_counter,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+func (x *ProbeSpecSpec) GetFailureThreshold() int64 {
+	if x != nil {
+		return x.FailureThreshold
+	}
+	return 0
 }
 
-func (x *NfTablesRule) Reset() {
-	*x = NfTablesRule{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[34]
+func (x *ProbeSpecSpec) GetTcp() *TCPProbeSpec {
+	if x != nil {
+		return x.Tcp
+	}
+	return nil
+}
+
+func (x *ProbeSpecSpec) GetConfigLayer() enums.NetworkConfigLayer {
+	if x != nil {
+		return x.ConfigLayer
+	}
+	return enums.NetworkConfigLayer(0)
+}
+
+func (x *ProbeSpecSpec) GetHttp() *HTTPProbeSpec {
+	if x != nil {
+		return x.Http
+	}
+	return nil
+}
+
+func (x *ProbeSpecSpec) GetIcmp() *ICMPProbeSpec {
+	if x != nil {
+		return x.Icmp
+	}
+	return nil
+}
+
+// ProbeStatusSpec describes the Probe.
+type ProbeStatusSpec struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Success             bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	LastError           string                 `protobuf:"bytes,2,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	ConsecutiveFailures int64                  `protobuf:"varint,3,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	LastLatency         *durationpb.Duration   `protobuf:"bytes,4,opt,name=last_latency,json=lastLatency,proto3" json:"last_latency,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ProbeStatusSpec) Reset() {
+	*x = ProbeStatusSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NfTablesRule) String() string {
+func (x *ProbeStatusSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NfTablesRule) ProtoMessage() {}
+func (*ProbeStatusSpec) ProtoMessage() {}
 
-func (x *NfTablesRule) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[34]
+func (x *ProbeStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2827,119 +4546,134 @@ func (x *NfTablesRule) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NfTablesRule.ProtoReflect.Descriptor instead.
-func (*NfTablesRule) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use ProbeStatusSpec.ProtoReflect.Descriptor instead.
+func (*ProbeStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *NfTablesRule) GetMatchOIfName() *NfTablesIfNameMatch {
+func (x *ProbeStatusSpec) GetSuccess() bool {
 	if x != nil {
-		return x.MatchOIfName
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-func (x *NfTablesRule) GetVerdict() enums.NethelpersNfTablesVerdict {
+func (x *ProbeStatusSpec) GetLastError() string {
 	if x != nil {
-		return x.Verdict
+		return x.LastError
 	}
-	return enums.NethelpersNfTablesVerdict(0)
+	return ""
 }
 
-func (x *NfTablesRule) GetMatchMark() *NfTablesMark {
+func (x *ProbeStatusSpec) GetConsecutiveFailures() int64 {
 	if x != nil {
-		return x.MatchMark
+		return x.ConsecutiveFailures
 	}
-	return nil
+	return 0
 }
 
-func (x *NfTablesRule) GetSetMark() *NfTablesMark {
+func (x *ProbeStatusSpec) GetLastLatency() *durationpb.Duration {
 	if x != nil {
-		return x.SetMark
+		return x.LastLatency
 	}
 	return nil
 }
 
-func (x *NfTablesRule) GetMatchSourceAddress() *NfTablesAddressMatch {
-	if x != nil {
-		return x.MatchSourceAddress
-	}
-	return nil
+// ResolverOptions describes resolver tuning options.
+type ResolverOptions struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Rotate         bool                   `protobuf:"varint,1,opt,name=rotate,proto3" json:"rotate,omitempty"`
+	Timeout        *durationpb.Duration   `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Attempts       int64                  `protobuf:"varint,3,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	UpstreamPolicy string                 `protobuf:"bytes,4,opt,name=upstream_policy,json=upstreamPolicy,proto3" json:"upstream_policy,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *NfTablesRule) GetMatchDestinationAddress() *NfTablesAddressMatch {
-	if x != nil {
-		return x.MatchDestinationAddress
-	}
-	return nil
+func (x *ResolverOptions) Reset() {
+	*x = ResolverOptions{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *NfTablesRule) GetMatchLayer4() *NfTablesLayer4Match {
-	if x != nil {
-		return x.MatchLayer4
-	}
-	return nil
+func (x *ResolverOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *NfTablesRule) GetMatchIIfName() *NfTablesIfNameMatch {
+func (*ResolverOptions) ProtoMessage() {}
+
+func (x *ResolverOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[56]
 	if x != nil {
-		return x.MatchIIfName
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *NfTablesRule) GetClampMss() *NfTablesClampMSS {
+// Deprecated: Use ResolverOptions.ProtoReflect.Descriptor instead.
+func (*ResolverOptions) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ResolverOptions) GetRotate() bool {
 	if x != nil {
-		return x.ClampMss
+		return x.Rotate
 	}
-	return nil
+	return false
 }
 
-func (x *NfTablesRule) GetMatchLimit() *NfTablesLimitMatch {
+func (x *ResolverOptions) GetTimeout() *durationpb.Duration {
 	if x != nil {
-		return x.MatchLimit
+		return x.Timeout
 	}
 	return nil
 }
 
-func (x *NfTablesRule) GetMatchConntrackState() *NfTablesConntrackStateMatch {
+func (x *ResolverOptions) GetAttempts() int64 {
 	if x != nil {
-		return x.MatchConntrackState
+		return x.Attempts
 	}
-	return nil
+	return 0
 }
 
-func (x *NfTablesRule) GetAnonCounter() bool {
+func (x *ResolverOptions) GetUpstreamPolicy() string {
 	if x != nil {
-		return x.AnonCounter
+		return x.UpstreamPolicy
 	}
-	return false
+	return ""
 }
 
-// NodeAddressFilterSpec describes a filter for NodeAddresses.
-type NodeAddressFilterSpec struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	IncludeSubnets []*common.NetIPPrefix  `protobuf:"bytes,1,rep,name=include_subnets,json=includeSubnets,proto3" json:"include_subnets,omitempty"`
-	ExcludeSubnets []*common.NetIPPrefix  `protobuf:"bytes,2,rep,name=exclude_subnets,json=excludeSubnets,proto3" json:"exclude_subnets,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// ResolverSpecSpec describes DNS resolvers.
+type ResolverSpecSpec struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	DnsServers    []*common.NetIP          `protobuf:"bytes,1,rep,name=dns_servers,json=dnsServers,proto3" json:"dns_servers,omitempty"`
+	ConfigLayer   enums.NetworkConfigLayer `protobuf:"varint,2,opt,name=config_layer,json=configLayer,proto3,enum=talos.resource.definitions.enums.NetworkConfigLayer" json:"config_layer,omitempty"`
+	SearchDomains []string                 `protobuf:"bytes,3,rep,name=search_domains,json=searchDomains,proto3" json:"search_domains,omitempty"`
+	Options       *ResolverOptions         `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NodeAddressFilterSpec) Reset() {
-	*x = NodeAddressFilterSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[35]
+func (x *ResolverSpecSpec) Reset() {
+	*x = ResolverSpecSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NodeAddressFilterSpec) String() string {
+func (x *ResolverSpecSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NodeAddressFilterSpec) ProtoMessage() {}
+func (*ResolverSpecSpec) ProtoMessage() {}
 
-func (x *NodeAddressFilterSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[35]
+func (x *ResolverSpecSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2950,48 +4684,64 @@ func (x *NodeAddressFilterSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NodeAddressFilterSpec.ProtoReflect.Descriptor instead.
-func (*NodeAddressFilterSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use ResolverSpecSpec.ProtoReflect.Descriptor instead.
+func (*ResolverSpecSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *NodeAddressFilterSpec) GetIncludeSubnets() []*common.NetIPPrefix {
+func (x *ResolverSpecSpec) GetDnsServers() []*common.NetIP {
 	if x != nil {
-		return x.IncludeSubnets
+		return x.DnsServers
 	}
 	return nil
 }
 
-func (x *NodeAddressFilterSpec) GetExcludeSubnets() []*common.NetIPPrefix {
+func (x *ResolverSpecSpec) GetConfigLayer() enums.NetworkConfigLayer {
 	if x != nil {
-		return x.ExcludeSubnets
+		return x.ConfigLayer
+	}
+	return enums.NetworkConfigLayer(0)
+}
+
+func (x *ResolverSpecSpec) GetSearchDomains() []string {
+	if x != nil {
+		return x.SearchDomains
 	}
 	return nil
 }
 
-// NodeAddressSortAlgorithmSpec describes a filter for NodeAddresses.
-type NodeAddressSortAlgorithmSpec struct {
-	state         protoimpl.MessageState               `protogen:"open.v1"`
-	Algorithm     enums.NethelpersAddressSortAlgorithm `protobuf:"varint,1,opt,name=algorithm,proto3,enum=talos.resource.definitions.enums.NethelpersAddressSortAlgorithm" json:"algorithm,omitempty"`
+func (x *ResolverSpecSpec) GetOptions() *ResolverOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+// ResolverStatusSpec describes DNS resolvers.
+type ResolverStatusSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DnsServers    []*common.NetIP        `protobuf:"bytes,1,rep,name=dns_servers,json=dnsServers,proto3" json:"dns_servers,omitempty"`
+	SearchDomains []string               `protobuf:"bytes,2,rep,name=search_domains,json=searchDomains,proto3" json:"search_domains,omitempty"`
+	Options       *ResolverOptions       `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NodeAddressSortAlgorithmSpec) Reset() {
-	*x = NodeAddressSortAlgorithmSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[36]
+func (x *ResolverStatusSpec) Reset() {
+	*x = ResolverStatusSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NodeAddressSortAlgorithmSpec) String() string {
+func (x *ResolverStatusSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NodeAddressSortAlgorithmSpec) ProtoMessage() {}
+func (*ResolverStatusSpec) ProtoMessage() {}
 
-func (x *NodeAddressSortAlgorithmSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[36]
+func (x *ResolverStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3002,42 +4752,62 @@ func (x *NodeAddressSortAlgorithmSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NodeAddressSortAlgorithmSpec.ProtoReflect.Descriptor instead.
-func (*NodeAddressSortAlgorithmSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use ResolverStatusSpec.ProtoReflect.Descriptor instead.
+func (*ResolverStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *NodeAddressSortAlgorithmSpec) GetAlgorithm() enums.NethelpersAddressSortAlgorithm {
+func (x *ResolverStatusSpec) GetDnsServers() []*common.NetIP {
 	if x != nil {
-		return x.Algorithm
+		return x.DnsServers
+	}
+	return nil
+}
+
+func (x *ResolverStatusSpec) GetSearchDomains() []string {
+	if x != nil {
+		return x.SearchDomains
+	}
+	return nil
+}
+
+func (x *ResolverStatusSpec) GetOptions() *ResolverOptions {
+	if x != nil {
+		return x.Options
 	}
-	return enums.NethelpersAddressSortAlgorithm(0)
+	return nil
 }
 
-// NodeAddressSpec describes a set of node addresses.
-type NodeAddressSpec struct {
-	state         protoimpl.MessageState               `protogen:"open.v1"`
-	Addresses     []*common.NetIPPrefix                `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
-	SortAlgorithm enums.NethelpersAddressSortAlgorithm `protobuf:"varint,2,opt,name=sort_algorithm,json=sortAlgorithm,proto3,enum=talos.resource.definitions.enums.NethelpersAddressSortAlgorithm" json:"sort_algorithm,omitempty"`
+// RouteRuleSpecSpec describes the routing policy rule.
+type RouteRuleSpecSpec struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Family        enums.NethelpersFamily       `protobuf:"varint,1,opt,name=family,proto3,enum=talos.resource.definitions.enums.NethelpersFamily" json:"family,omitempty"`
+	Source        *common.NetIPPrefix          `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Destination   *common.NetIPPrefix          `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
+	Table         enums.NethelpersRoutingTable `protobuf:"varint,4,opt,name=table,proto3,enum=talos.resource.definitions.enums.NethelpersRoutingTable" json:"table,omitempty"`
+	Priority      uint32                       `protobuf:"varint,5,opt,name=priority,proto3" json:"priority,omitempty"`
+	FwMark        uint32                       `protobuf:"varint,6,opt,name=fw_mark,json=fwMark,proto3" json:"fw_mark,omitempty"`
+	FwMask        uint32                       `protobuf:"varint,7,opt,name=fw_mask,json=fwMask,proto3" json:"fw_mask,omitempty"`
+	ConfigLayer   enums.NetworkConfigLayer     `protobuf:"varint,8,opt,name=config_layer,json=configLayer,proto3,enum=talos.resource.definitions.enums.NetworkConfigLayer" json:"config_layer,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NodeAddressSpec) Reset() {
-	*x = NodeAddressSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[37]
+func (x *RouteRuleSpecSpec) Reset() {
+	*x = RouteRuleSpecSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NodeAddressSpec) String() string {
+func (x *RouteRuleSpecSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NodeAddressSpec) ProtoMessage() {}
+func (*RouteRuleSpecSpec) ProtoMessage() {}
 
-func (x *NodeAddressSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[37]
+func (x *RouteRuleSpecSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3048,54 +4818,99 @@ func (x *NodeAddressSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NodeAddressSpec.ProtoReflect.Descriptor instead.
-func (*NodeAddressSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use RouteRuleSpecSpec.ProtoReflect.Descriptor instead.
+func (*RouteRuleSpecSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *NodeAddressSpec) GetAddresses() []*common.NetIPPrefix {
+func (x *RouteRuleSpecSpec) GetFamily() enums.NethelpersFamily {
 	if x != nil {
-		return x.Addresses
+		return x.Family
+	}
+	return enums.NethelpersFamily(0)
+}
+
+func (x *RouteRuleSpecSpec) GetSource() *common.NetIPPrefix {
+	if x != nil {
+		return x.Source
 	}
 	return nil
 }
 
-func (x *NodeAddressSpec) GetSortAlgorithm() enums.NethelpersAddressSortAlgorithm {
+func (x *RouteRuleSpecSpec) GetDestination() *common.NetIPPrefix {
 	if x != nil {
-		return x.SortAlgorithm
+		return x.Destination
 	}
-	return enums.NethelpersAddressSortAlgorithm(0)
+	return nil
 }
 
-// OperatorSpecSpec describes DNS resolvers.
-type OperatorSpecSpec struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Operator      enums.NetworkOperator    `protobuf:"varint,1,opt,name=operator,proto3,enum=talos.resource.definitions.enums.NetworkOperator" json:"operator,omitempty"`
-	LinkName      string                   `protobuf:"bytes,2,opt,name=link_name,json=linkName,proto3" json:"link_name,omitempty"`
-	RequireUp     bool                     `protobuf:"varint,3,opt,name=require_up,json=requireUp,proto3" json:"require_up,omitempty"`
-	Dhcp4         *DHCP4OperatorSpec       `protobuf:"bytes,4,opt,name=dhcp4,proto3" json:"dhcp4,omitempty"`
-	Dhcp6         *DHCP6OperatorSpec       `protobuf:"bytes,5,opt,name=dhcp6,proto3" json:"dhcp6,omitempty"`
-	Vip           *VIPOperatorSpec         `protobuf:"bytes,6,opt,name=vip,proto3" json:"vip,omitempty"`
-	ConfigLayer   enums.NetworkConfigLayer `protobuf:"varint,7,opt,name=config_layer,json=configLayer,proto3,enum=talos.resource.definitions.enums.NetworkConfigLayer" json:"config_layer,omitempty"`
+func (x *RouteRuleSpecSpec) GetTable() enums.NethelpersRoutingTable {
+	if x != nil {
+		return x.Table
+	}
+	return enums.NethelpersRoutingTable(0)
+}
+
+func (x *RouteRuleSpecSpec) GetPriority() uint32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *RouteRuleSpecSpec) GetFwMark() uint32 {
+	if x != nil {
+		return x.FwMark
+	}
+	return 0
+}
+
+func (x *RouteRuleSpecSpec) GetFwMask() uint32 {
+	if x != nil {
+		return x.FwMask
+	}
+	return 0
+}
+
+func (x *RouteRuleSpecSpec) GetConfigLayer() enums.NetworkConfigLayer {
+	if x != nil {
+		return x.ConfigLayer
+	}
+	return enums.NetworkConfigLayer(0)
+}
+
+// RouteRuleStatusSpec describes status of the routing policy rule.
+type RouteRuleStatusSpec struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Family        enums.NethelpersFamily        `protobuf:"varint,1,opt,name=family,proto3,enum=talos.resource.definitions.enums.NethelpersFamily" json:"family,omitempty"`
+	Source        *common.NetIPPrefix           `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Destination   *common.NetIPPrefix           `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
+	Table         enums.NethelpersRoutingTable  `protobuf:"varint,4,opt,name=table,proto3,enum=talos.resource.definitions.enums.NethelpersRoutingTable" json:"table,omitempty"`
+	Priority      uint32                        `protobuf:"varint,5,opt,name=priority,proto3" json:"priority,omitempty"`
+	FwMark        uint32                        `protobuf:"varint,6,opt,name=fw_mark,json=fwMark,proto3" json:"fw_mark,omitempty"`
+	FwMask        uint32                        `protobuf:"varint,7,opt,name=fw_mask,json=fwMask,proto3" json:"fw_mask,omitempty"`
+	InLinkName    string                        `protobuf:"bytes,8,opt,name=in_link_name,json=inLinkName,proto3" json:"in_link_name,omitempty"`
+	OutLinkName   string                        `protobuf:"bytes,9,opt,name=out_link_name,json=outLinkName,proto3" json:"out_link_name,omitempty"`
+	Protocol      enums.NethelpersRouteProtocol `protobuf:"varint,10,opt,name=protocol,proto3,enum=talos.resource.definitions.enums.NethelpersRouteProtocol" json:"protocol,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OperatorSpecSpec) Reset() {
-	*x = OperatorSpecSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[38]
+func (x *RouteRuleStatusSpec) Reset() {
+	*x = RouteRuleStatusSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OperatorSpecSpec) String() string {
+func (x *RouteRuleStatusSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OperatorSpecSpec) ProtoMessage() {}
+func (*RouteRuleStatusSpec) ProtoMessage() {}
 
-func (x *OperatorSpecSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[38]
+func (x *RouteRuleStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3106,95 +4921,117 @@ func (x *OperatorSpecSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OperatorSpecSpec.ProtoReflect.Descriptor instead.
-func (*OperatorSpecSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use RouteRuleStatusSpec.ProtoReflect.Descriptor instead.
+func (*RouteRuleStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *OperatorSpecSpec) GetOperator() enums.NetworkOperator {
+func (x *RouteRuleStatusSpec) GetFamily() enums.NethelpersFamily {
 	if x != nil {
-		return x.Operator
+		return x.Family
 	}
-	return enums.NetworkOperator(0)
+	return enums.NethelpersFamily(0)
 }
 
-func (x *OperatorSpecSpec) GetLinkName() string {
+func (x *RouteRuleStatusSpec) GetSource() *common.NetIPPrefix {
 	if x != nil {
-		return x.LinkName
+		return x.Source
 	}
-	return ""
+	return nil
 }
 
-func (x *OperatorSpecSpec) GetRequireUp() bool {
+func (x *RouteRuleStatusSpec) GetDestination() *common.NetIPPrefix {
 	if x != nil {
-		return x.RequireUp
+		return x.Destination
 	}
-	return false
+	return nil
 }
 
-func (x *OperatorSpecSpec) GetDhcp4() *DHCP4OperatorSpec {
+func (x *RouteRuleStatusSpec) GetTable() enums.NethelpersRoutingTable {
 	if x != nil {
-		return x.Dhcp4
+		return x.Table
 	}
-	return nil
+	return enums.NethelpersRoutingTable(0)
 }
 
-func (x *OperatorSpecSpec) GetDhcp6() *DHCP6OperatorSpec {
+func (x *RouteRuleStatusSpec) GetPriority() uint32 {
 	if x != nil {
-		return x.Dhcp6
+		return x.Priority
 	}
-	return nil
+	return 0
 }
 
-func (x *OperatorSpecSpec) GetVip() *VIPOperatorSpec {
+func (x *RouteRuleStatusSpec) GetFwMark() uint32 {
 	if x != nil {
-		return x.Vip
+		return x.FwMark
 	}
-	return nil
+	return 0
 }
 
-func (x *OperatorSpecSpec) GetConfigLayer() enums.NetworkConfigLayer {
+func (x *RouteRuleStatusSpec) GetFwMask() uint32 {
 	if x != nil {
-		return x.ConfigLayer
+		return x.FwMask
 	}
-	return enums.NetworkConfigLayer(0)
+	return 0
 }
 
-// PlatformConfigSpec describes platform network configuration.
-//
-// This structure is marshaled to STATE partition to persist cached network configuration across
-// reboots.
-type PlatformConfigSpec struct {
+func (x *RouteRuleStatusSpec) GetInLinkName() string {
+	if x != nil {
+		return x.InLinkName
+	}
+	return ""
+}
+
+func (x *RouteRuleStatusSpec) GetOutLinkName() string {
+	if x != nil {
+		return x.OutLinkName
+	}
+	return ""
+}
+
+func (x *RouteRuleStatusSpec) GetProtocol() enums.NethelpersRouteProtocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return enums.NethelpersRouteProtocol(0)
+}
+
+// RouteSpecSpec describes the route.
+type RouteSpecSpec struct {
 	state         protoimpl.MessageState        `protogen:"open.v1"`
-	Addresses     []*AddressSpecSpec            `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
-	Links         []*LinkSpecSpec               `protobuf:"bytes,2,rep,name=links,proto3" json:"links,omitempty"`
-	Routes        []*RouteSpecSpec              `protobuf:"bytes,3,rep,name=routes,proto3" json:"routes,omitempty"`
-	Hostnames     []*HostnameSpecSpec           `protobuf:"bytes,4,rep,name=hostnames,proto3" json:"hostnames,omitempty"`
-	Resolvers     []*ResolverSpecSpec           `protobuf:"bytes,5,rep,name=resolvers,proto3" json:"resolvers,omitempty"`
-	TimeServers   []*TimeServerSpecSpec         `protobuf:"bytes,6,rep,name=time_servers,json=timeServers,proto3" json:"time_servers,omitempty"`
-	Operators     []*OperatorSpecSpec           `protobuf:"bytes,7,rep,name=operators,proto3" json:"operators,omitempty"`
-	ExternalIps   []*common.NetIP               `protobuf:"bytes,8,rep,name=external_ips,json=externalIps,proto3" json:"external_ips,omitempty"`
-	Probes        []*ProbeSpecSpec              `protobuf:"bytes,9,rep,name=probes,proto3" json:"probes,omitempty"`
-	Metadata      *runtime.PlatformMetadataSpec `protobuf:"bytes,10,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Family        enums.NethelpersFamily        `protobuf:"varint,1,opt,name=family,proto3,enum=talos.resource.definitions.enums.NethelpersFamily" json:"family,omitempty"`
+	Destination   *common.NetIPPrefix           `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	Source        *common.NetIP                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Gateway       *common.NetIP                 `protobuf:"bytes,4,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	OutLinkName   string                        `protobuf:"bytes,5,opt,name=out_link_name,json=outLinkName,proto3" json:"out_link_name,omitempty"`
+	Table         enums.NethelpersRoutingTable  `protobuf:"varint,6,opt,name=table,proto3,enum=talos.resource.definitions.enums.NethelpersRoutingTable" json:"table,omitempty"`
+	Priority      uint32                        `protobuf:"varint,7,opt,name=priority,proto3" json:"priority,omitempty"`
+	Scope         enums.NethelpersScope         `protobuf:"varint,8,opt,name=scope,proto3,enum=talos.resource.definitions.enums.NethelpersScope" json:"scope,omitempty"`
+	Type          enums.NethelpersRouteType     `protobuf:"varint,9,opt,name=type,proto3,enum=talos.resource.definitions.enums.NethelpersRouteType" json:"type,omitempty"`
+	Flags         uint32                        `protobuf:"varint,10,opt,name=flags,proto3" json:"flags,omitempty"`
+	Protocol      enums.NethelpersRouteProtocol `protobuf:"varint,11,opt,name=protocol,proto3,enum=talos.resource.definitions.enums.NethelpersRouteProtocol" json:"protocol,omitempty"`
+	ConfigLayer   enums.NetworkConfigLayer      `protobuf:"varint,12,opt,name=config_layer,json=configLayer,proto3,enum=talos.resource.definitions.enums.NetworkConfigLayer" json:"config_layer,omitempty"`
+	Mtu           uint32                        `protobuf:"varint,13,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	Origin        string                        `protobuf:"bytes,14,opt,name=origin,proto3" json:"origin,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlatformConfigSpec) Reset() {
-	*x = PlatformConfigSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[39]
+func (x *RouteSpecSpec) Reset() {
+	*x = RouteSpecSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlatformConfigSpec) String() string {
+func (x *RouteSpecSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlatformConfigSpec) ProtoMessage() {}
+func (*RouteSpecSpec) ProtoMessage() {}
 
-func (x *PlatformConfigSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[39]
+func (x *RouteSpecSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3205,107 +5042,145 @@ func (x *PlatformConfigSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlatformConfigSpec.ProtoReflect.Descriptor instead.
-func (*PlatformConfigSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use RouteSpecSpec.ProtoReflect.Descriptor instead.
+func (*RouteSpecSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *PlatformConfigSpec) GetAddresses() []*AddressSpecSpec {
+func (x *RouteSpecSpec) GetFamily() enums.NethelpersFamily {
 	if x != nil {
-		return x.Addresses
+		return x.Family
 	}
-	return nil
+	return enums.NethelpersFamily(0)
 }
 
-func (x *PlatformConfigSpec) GetLinks() []*LinkSpecSpec {
+func (x *RouteSpecSpec) GetDestination() *common.NetIPPrefix {
 	if x != nil {
-		return x.Links
+		return x.Destination
 	}
 	return nil
 }
 
-func (x *PlatformConfigSpec) GetRoutes() []*RouteSpecSpec {
+func (x *RouteSpecSpec) GetSource() *common.NetIP {
 	if x != nil {
-		return x.Routes
+		return x.Source
 	}
 	return nil
 }
 
-func (x *PlatformConfigSpec) GetHostnames() []*HostnameSpecSpec {
+func (x *RouteSpecSpec) GetGateway() *common.NetIP {
 	if x != nil {
-		return x.Hostnames
+		return x.Gateway
 	}
 	return nil
 }
 
-func (x *PlatformConfigSpec) GetResolvers() []*ResolverSpecSpec {
+func (x *RouteSpecSpec) GetOutLinkName() string {
 	if x != nil {
-		return x.Resolvers
+		return x.OutLinkName
 	}
-	return nil
+	return ""
 }
 
-func (x *PlatformConfigSpec) GetTimeServers() []*TimeServerSpecSpec {
+func (x *RouteSpecSpec) GetTable() enums.NethelpersRoutingTable {
 	if x != nil {
-		return x.TimeServers
+		return x.Table
 	}
-	return nil
+	return enums.NethelpersRoutingTable(0)
 }
 
-func (x *PlatformConfigSpec) GetOperators() []*OperatorSpecSpec {
+func (x *RouteSpecSpec) GetPriority() uint32 {
 	if x != nil {
-		return x.Operators
+		return x.Priority
 	}
-	return nil
+	return 0
 }
 
-func (x *PlatformConfigSpec) GetExternalIps() []*common.NetIP {
+func (x *RouteSpecSpec) GetScope() enums.NethelpersScope {
 	if x != nil {
-		return x.ExternalIps
+		return x.Scope
 	}
-	return nil
+	return enums.NethelpersScope(0)
 }
 
-func (x *PlatformConfigSpec) GetProbes() []*ProbeSpecSpec {
+func (x *RouteSpecSpec) GetType() enums.NethelpersRouteType {
 	if x != nil {
-		return x.Probes
+		return x.Type
 	}
-	return nil
+	return enums.NethelpersRouteType(0)
 }
 
-func (x *PlatformConfigSpec) GetMetadata() *runtime.PlatformMetadataSpec {
+func (x *RouteSpecSpec) GetFlags() uint32 {
 	if x != nil {
-		return x.Metadata
+		return x.Flags
 	}
-	return nil
+	return 0
 }
 
-// PortRange describes a range of ports.
-//
-// Range is [lo, hi].
-type PortRange struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Lo            uint32                 `protobuf:"fixed32,1,opt,name=lo,proto3" json:"lo,omitempty"`
-	Hi            uint32                 `protobuf:"fixed32,2,opt,name=hi,proto3" json:"hi,omitempty"`
+func (x *RouteSpecSpec) GetProtocol() enums.NethelpersRouteProtocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return enums.NethelpersRouteProtocol(0)
+}
+
+func (x *RouteSpecSpec) GetConfigLayer() enums.NetworkConfigLayer {
+	if x != nil {
+		return x.ConfigLayer
+	}
+	return enums.NetworkConfigLayer(0)
+}
+
+func (x *RouteSpecSpec) GetMtu() uint32 {
+	if x != nil {
+		return x.Mtu
+	}
+	return 0
+}
+
+func (x *RouteSpecSpec) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+// RouteStatusSpec describes status of rendered secrets.
+type RouteStatusSpec struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Family        enums.NethelpersFamily        `protobuf:"varint,1,opt,name=family,proto3,enum=talos.resource.definitions.enums.NethelpersFamily" json:"family,omitempty"`
+	Destination   *common.NetIPPrefix           `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	Source        *common.NetIP                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Gateway       *common.NetIP                 `protobuf:"bytes,4,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	OutLinkIndex  uint32                        `protobuf:"varint,5,opt,name=out_link_index,json=outLinkIndex,proto3" json:"out_link_index,omitempty"`
+	OutLinkName   string                        `protobuf:"bytes,6,opt,name=out_link_name,json=outLinkName,proto3" json:"out_link_name,omitempty"`
+	Table         enums.NethelpersRoutingTable  `protobuf:"varint,7,opt,name=table,proto3,enum=talos.resource.definitions.enums.NethelpersRoutingTable" json:"table,omitempty"`
+	Priority      uint32                        `protobuf:"varint,8,opt,name=priority,proto3" json:"priority,omitempty"`
+	Scope         enums.NethelpersScope         `protobuf:"varint,9,opt,name=scope,proto3,enum=talos.resource.definitions.enums.NethelpersScope" json:"scope,omitempty"`
+	Type          enums.NethelpersRouteType     `protobuf:"varint,10,opt,name=type,proto3,enum=talos.resource.definitions.enums.NethelpersRouteType" json:"type,omitempty"`
+	Flags         uint32                        `protobuf:"varint,11,opt,name=flags,proto3" json:"flags,omitempty"`
+	Protocol      enums.NethelpersRouteProtocol `protobuf:"varint,12,opt,name=protocol,proto3,enum=talos.resource.definitions.enums.NethelpersRouteProtocol" json:"protocol,omitempty"`
+	Mtu           uint32                        `protobuf:"varint,13,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	Origin        string                        `protobuf:"bytes,14,opt,name=origin,proto3" json:"origin,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PortRange) Reset() {
-	*x = PortRange{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[40]
+func (x *RouteStatusSpec) Reset() {
+	*x = RouteStatusSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PortRange) String() string {
+func (x *RouteStatusSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PortRange) ProtoMessage() {}
+func (*RouteStatusSpec) ProtoMessage() {}
 
-func (x *PortRange) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[40]
+func (x *RouteStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3316,118 +5191,139 @@ func (x *PortRange) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PortRange.ProtoReflect.Descriptor instead.
-func (*PortRange) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use RouteStatusSpec.ProtoReflect.Descriptor instead.
+func (*RouteStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *PortRange) GetLo() uint32 {
+func (x *RouteStatusSpec) GetFamily() enums.NethelpersFamily {
 	if x != nil {
-		return x.Lo
+		return x.Family
 	}
-	return 0
+	return enums.NethelpersFamily(0)
 }
 
-func (x *PortRange) GetHi() uint32 {
+func (x *RouteStatusSpec) GetDestination() *common.NetIPPrefix {
 	if x != nil {
-		return x.Hi
+		return x.Destination
 	}
-	return 0
+	return nil
 }
 
-// ProbeSpecSpec describes the Probe.
-type ProbeSpecSpec struct {
-	state            protoimpl.MessageState   `protogen:"open.v1"`
-	Interval         *durationpb.Duration     `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"`
-	FailureThreshold int64                    `protobuf:"varint,2,opt,name=failure_threshold,json=failureThreshold,proto3" json:"failure_threshold,omitempty"`
-	Tcp              *TCPProbeSpec            `protobuf:"bytes,3,opt,name=tcp,proto3" json:"tcp,omitempty"`
-	ConfigLayer      enums.NetworkConfigLayer `protobuf:"varint,4,opt,name=config_layer,json=configLayer,proto3,enum=talos.resource.definitions.enums.NetworkConfigLayer" json:"config_layer,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *RouteStatusSpec) GetSource() *common.NetIP {
+	if x != nil {
+		return x.Source
+	}
+	return nil
 }
 
-func (x *ProbeSpecSpec) Reset() {
-	*x = ProbeSpecSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[41]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *RouteStatusSpec) GetGateway() *common.NetIP {
+	if x != nil {
+		return x.Gateway
+	}
+	return nil
 }
 
-func (x *ProbeSpecSpec) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RouteStatusSpec) GetOutLinkIndex() uint32 {
+	if x != nil {
+		return x.OutLinkIndex
+	}
+	return 0
 }
 
-func (*ProbeSpecSpec) ProtoMessage() {}
+func (x *RouteStatusSpec) GetOutLinkName() string {
+	if x != nil {
+		return x.OutLinkName
+	}
+	return ""
+}
 
-func (x *ProbeSpecSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[41]
+func (x *RouteStatusSpec) GetTable() enums.NethelpersRoutingTable {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Table
 	}
-	return mi.MessageOf(x)
+	return enums.NethelpersRoutingTable(0)
 }
 
-// Deprecated: Use ProbeSpecSpec.ProtoReflect.Descriptor instead.
-func (*ProbeSpecSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{41}
+func (x *RouteStatusSpec) GetPriority() uint32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
 }
 
-func (x *ProbeSpecSpec) GetInterval() *durationpb.Duration {
+func (x *RouteStatusSpec) GetScope() enums.NethelpersScope {
 	if x != nil {
-		return x.Interval
+		return x.Scope
 	}
-	return nil
+	return enums.NethelpersScope(0)
 }
 
-func (x *ProbeSpecSpec) GetFailureThreshold() int64 {
+func (x *RouteStatusSpec) GetType() enums.NethelpersRouteType {
 	if x != nil {
-		return x.FailureThreshold
+		return x.Type
+	}
+	return enums.NethelpersRouteType(0)
+}
+
+func (x *RouteStatusSpec) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
 	}
 	return 0
 }
 
-func (x *ProbeSpecSpec) GetTcp() *TCPProbeSpec {
+func (x *RouteStatusSpec) GetProtocol() enums.NethelpersRouteProtocol {
 	if x != nil {
-		return x.Tcp
+		return x.Protocol
 	}
-	return nil
+	return enums.NethelpersRouteProtocol(0)
 }
 
-func (x *ProbeSpecSpec) GetConfigLayer() enums.NetworkConfigLayer {
+func (x *RouteStatusSpec) GetMtu() uint32 {
 	if x != nil {
-		return x.ConfigLayer
+		return x.Mtu
 	}
-	return enums.NetworkConfigLayer(0)
+	return 0
 }
 
-// ProbeStatusSpec describes the Probe.
-type ProbeStatusSpec struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	LastError     string                 `protobuf:"bytes,2,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *RouteStatusSpec) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
 }
 
-func (x *ProbeStatusSpec) Reset() {
-	*x = ProbeStatusSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[42]
+// RouterStatusSpec describes an IPv6 router learned from router advertisements.
+type RouterStatusSpec struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	LinkName          string                 `protobuf:"bytes,1,opt,name=link_name,json=linkName,proto3" json:"link_name,omitempty"`
+	Address           *common.NetIP          `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Preference        string                 `protobuf:"bytes,3,opt,name=preference,proto3" json:"preference,omitempty"`
+	Lifetime          *durationpb.Duration   `protobuf:"bytes,4,opt,name=lifetime,proto3" json:"lifetime,omitempty"`
+	Expires           *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires,proto3" json:"expires,omitempty"`
+	Routes            []*common.NetIPPrefix  `protobuf:"bytes,6,rep,name=routes,proto3" json:"routes,omitempty"`
+	DnsServers        []*common.NetIP        `protobuf:"bytes,7,rep,name=dns_servers,json=dnsServers,proto3" json:"dns_servers,omitempty"`
+	LastAdvertisement *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_advertisement,json=lastAdvertisement,proto3" json:"last_advertisement,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RouterStatusSpec) Reset() {
+	*x = RouterStatusSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProbeStatusSpec) String() string {
+func (x *RouterStatusSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProbeStatusSpec) ProtoMessage() {}
+func (*RouterStatusSpec) ProtoMessage() {}
 
-func (x *ProbeStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[42]
+func (x *RouterStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3438,110 +5334,91 @@ func (x *ProbeStatusSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProbeStatusSpec.ProtoReflect.Descriptor instead.
-func (*ProbeStatusSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use RouterStatusSpec.ProtoReflect.Descriptor instead.
+func (*RouterStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *ProbeStatusSpec) GetSuccess() bool {
+func (x *RouterStatusSpec) GetLinkName() string {
 	if x != nil {
-		return x.Success
+		return x.LinkName
 	}
-	return false
+	return ""
 }
 
-func (x *ProbeStatusSpec) GetLastError() string {
+func (x *RouterStatusSpec) GetAddress() *common.NetIP {
 	if x != nil {
-		return x.LastError
+		return x.Address
 	}
-	return ""
-}
-
-// ResolverSpecSpec describes DNS resolvers.
-type ResolverSpecSpec struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	DnsServers    []*common.NetIP          `protobuf:"bytes,1,rep,name=dns_servers,json=dnsServers,proto3" json:"dns_servers,omitempty"`
-	ConfigLayer   enums.NetworkConfigLayer `protobuf:"varint,2,opt,name=config_layer,json=configLayer,proto3,enum=talos.resource.definitions.enums.NetworkConfigLayer" json:"config_layer,omitempty"`
-	SearchDomains []string                 `protobuf:"bytes,3,rep,name=search_domains,json=searchDomains,proto3" json:"search_domains,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ResolverSpecSpec) Reset() {
-	*x = ResolverSpecSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[43]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return nil
 }
 
-func (x *ResolverSpecSpec) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RouterStatusSpec) GetPreference() string {
+	if x != nil {
+		return x.Preference
+	}
+	return ""
 }
 
-func (*ResolverSpecSpec) ProtoMessage() {}
-
-func (x *ResolverSpecSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[43]
+func (x *RouterStatusSpec) GetLifetime() *durationpb.Duration {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Lifetime
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ResolverSpecSpec.ProtoReflect.Descriptor instead.
-func (*ResolverSpecSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{43}
+func (x *RouterStatusSpec) GetExpires() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expires
+	}
+	return nil
 }
 
-func (x *ResolverSpecSpec) GetDnsServers() []*common.NetIP {
+func (x *RouterStatusSpec) GetRoutes() []*common.NetIPPrefix {
 	if x != nil {
-		return x.DnsServers
+		return x.Routes
 	}
 	return nil
 }
 
-func (x *ResolverSpecSpec) GetConfigLayer() enums.NetworkConfigLayer {
+func (x *RouterStatusSpec) GetDnsServers() []*common.NetIP {
 	if x != nil {
-		return x.ConfigLayer
+		return x.DnsServers
 	}
-	return enums.NetworkConfigLayer(0)
+	return nil
 }
 
-func (x *ResolverSpecSpec) GetSearchDomains() []string {
+func (x *RouterStatusSpec) GetLastAdvertisement() *timestamppb.Timestamp {
 	if x != nil {
-		return x.SearchDomains
+		return x.LastAdvertisement
 	}
 	return nil
 }
 
-// ResolverStatusSpec describes DNS resolvers.
-type ResolverStatusSpec struct {
+// SRIOVSpecSpec describes SR-IOV configuration of a physical function.
+type SRIOVSpecSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DnsServers    []*common.NetIP        `protobuf:"bytes,1,rep,name=dns_servers,json=dnsServers,proto3" json:"dns_servers,omitempty"`
-	SearchDomains []string               `protobuf:"bytes,2,rep,name=search_domains,json=searchDomains,proto3" json:"search_domains,omitempty"`
+	NumVFs        uint32                 `protobuf:"varint,1,opt,name=num_v_fs,json=numVFs,proto3" json:"num_v_fs,omitempty"`
+	VFs           []*SRIOVVFSpec         `protobuf:"bytes,2,rep,name=v_fs,json=vFs,proto3" json:"v_fs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResolverStatusSpec) Reset() {
-	*x = ResolverStatusSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[44]
+func (x *SRIOVSpecSpec) Reset() {
+	*x = SRIOVSpecSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResolverStatusSpec) String() string {
+func (x *SRIOVSpecSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResolverStatusSpec) ProtoMessage() {}
+func (*SRIOVSpecSpec) ProtoMessage() {}
 
-func (x *ResolverStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[44]
+func (x *SRIOVSpecSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3552,60 +5429,54 @@ func (x *ResolverStatusSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResolverStatusSpec.ProtoReflect.Descriptor instead.
-func (*ResolverStatusSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use SRIOVSpecSpec.ProtoReflect.Descriptor instead.
+func (*SRIOVSpecSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *ResolverStatusSpec) GetDnsServers() []*common.NetIP {
+func (x *SRIOVSpecSpec) GetNumVFs() uint32 {
 	if x != nil {
-		return x.DnsServers
+		return x.NumVFs
 	}
-	return nil
+	return 0
 }
 
-func (x *ResolverStatusSpec) GetSearchDomains() []string {
+func (x *SRIOVSpecSpec) GetVFs() []*SRIOVVFSpec {
 	if x != nil {
-		return x.SearchDomains
-	}
-	return nil
-}
-
-// RouteSpecSpec describes the route.
-type RouteSpecSpec struct {
-	state         protoimpl.MessageState        `protogen:"open.v1"`
-	Family        enums.NethelpersFamily        `protobuf:"varint,1,opt,name=family,proto3,enum=talos.resource.definitions.enums.NethelpersFamily" json:"family,omitempty"`
-	Destination   *common.NetIPPrefix           `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
-	Source        *common.NetIP                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
-	Gateway       *common.NetIP                 `protobuf:"bytes,4,opt,name=gateway,proto3" json:"gateway,omitempty"`
-	OutLinkName   string                        `protobuf:"bytes,5,opt,name=out_link_name,json=outLinkName,proto3" json:"out_link_name,omitempty"`
-	Table         enums.NethelpersRoutingTable  `protobuf:"varint,6,opt,name=table,proto3,enum=talos.resource.definitions.enums.NethelpersRoutingTable" json:"table,omitempty"`
-	Priority      uint32                        `protobuf:"varint,7,opt,name=priority,proto3" json:"priority,omitempty"`
-	Scope         enums.NethelpersScope         `protobuf:"varint,8,opt,name=scope,proto3,enum=talos.resource.definitions.enums.NethelpersScope" json:"scope,omitempty"`
-	Type          enums.NethelpersRouteType     `protobuf:"varint,9,opt,name=type,proto3,enum=talos.resource.definitions.enums.NethelpersRouteType" json:"type,omitempty"`
-	Flags         uint32                        `protobuf:"varint,10,opt,name=flags,proto3" json:"flags,omitempty"`
-	Protocol      enums.NethelpersRouteProtocol `protobuf:"varint,11,opt,name=protocol,proto3,enum=talos.resource.definitions.enums.NethelpersRouteProtocol" json:"protocol,omitempty"`
-	ConfigLayer   enums.NetworkConfigLayer      `protobuf:"varint,12,opt,name=config_layer,json=configLayer,proto3,enum=talos.resource.definitions.enums.NetworkConfigLayer" json:"config_layer,omitempty"`
-	Mtu           uint32                        `protobuf:"varint,13,opt,name=mtu,proto3" json:"mtu,omitempty"`
+		return x.VFs
+	}
+	return nil
+}
+
+// SRIOVStatusSpec describes the runtime state of SR-IOV virtual functions of a physical function.
+type SRIOVStatusSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LinkName      string                 `protobuf:"bytes,1,opt,name=link_name,json=linkName,proto3" json:"link_name,omitempty"`
+	BusPath       string                 `protobuf:"bytes,2,opt,name=bus_path,json=busPath,proto3" json:"bus_path,omitempty"`
+	TotalVFs      uint32                 `protobuf:"varint,3,opt,name=total_v_fs,json=totalVFs,proto3" json:"total_v_fs,omitempty"`
+	NumVFs        uint32                 `protobuf:"varint,4,opt,name=num_v_fs,json=numVFs,proto3" json:"num_v_fs,omitempty"`
+	Ready         bool                   `protobuf:"varint,5,opt,name=ready,proto3" json:"ready,omitempty"`
+	Error         string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	VFs           []*SRIOVVFStatus       `protobuf:"bytes,7,rep,name=v_fs,json=vFs,proto3" json:"v_fs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RouteSpecSpec) Reset() {
-	*x = RouteSpecSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[45]
+func (x *SRIOVStatusSpec) Reset() {
+	*x = SRIOVStatusSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RouteSpecSpec) String() string {
+func (x *SRIOVStatusSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RouteSpecSpec) ProtoMessage() {}
+func (*SRIOVStatusSpec) ProtoMessage() {}
 
-func (x *RouteSpecSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[45]
+func (x *SRIOVStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3616,137 +5487,167 @@ func (x *RouteSpecSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RouteSpecSpec.ProtoReflect.Descriptor instead.
-func (*RouteSpecSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use SRIOVStatusSpec.ProtoReflect.Descriptor instead.
+func (*SRIOVStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *RouteSpecSpec) GetFamily() enums.NethelpersFamily {
+func (x *SRIOVStatusSpec) GetLinkName() string {
 	if x != nil {
-		return x.Family
+		return x.LinkName
 	}
-	return enums.NethelpersFamily(0)
+	return ""
 }
 
-func (x *RouteSpecSpec) GetDestination() *common.NetIPPrefix {
+func (x *SRIOVStatusSpec) GetBusPath() string {
 	if x != nil {
-		return x.Destination
+		return x.BusPath
 	}
-	return nil
+	return ""
 }
 
-func (x *RouteSpecSpec) GetSource() *common.NetIP {
+func (x *SRIOVStatusSpec) GetTotalVFs() uint32 {
 	if x != nil {
-		return x.Source
+		return x.TotalVFs
 	}
-	return nil
+	return 0
 }
 
-func (x *RouteSpecSpec) GetGateway() *common.NetIP {
+func (x *SRIOVStatusSpec) GetNumVFs() uint32 {
 	if x != nil {
-		return x.Gateway
+		return x.NumVFs
 	}
-	return nil
+	return 0
 }
 
-func (x *RouteSpecSpec) GetOutLinkName() string {
+func (x *SRIOVStatusSpec) GetReady() bool {
 	if x != nil {
-		return x.OutLinkName
+		return x.Ready
 	}
-	return ""
+	return false
 }
 
-func (x *RouteSpecSpec) GetTable() enums.NethelpersRoutingTable {
+func (x *SRIOVStatusSpec) GetError() string {
 	if x != nil {
-		return x.Table
+		return x.Error
 	}
-	return enums.NethelpersRoutingTable(0)
+	return ""
 }
 
-func (x *RouteSpecSpec) GetPriority() uint32 {
+func (x *SRIOVStatusSpec) GetVFs() []*SRIOVVFStatus {
 	if x != nil {
-		return x.Priority
+		return x.VFs
 	}
-	return 0
+	return nil
 }
 
-func (x *RouteSpecSpec) GetScope() enums.NethelpersScope {
+// SRIOVVFSpec describes configuration of a single SR-IOV virtual function.
+type SRIOVVFSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         uint32                 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	HardwareAddr  []byte                 `protobuf:"bytes,2,opt,name=hardware_addr,json=hardwareAddr,proto3" json:"hardware_addr,omitempty"`
+	Vlan          uint32                 `protobuf:"varint,3,opt,name=vlan,proto3" json:"vlan,omitempty"`
+	Trust         bool                   `protobuf:"varint,4,opt,name=trust,proto3" json:"trust,omitempty"`
+	SpoofCheck    bool                   `protobuf:"varint,5,opt,name=spoof_check,json=spoofCheck,proto3" json:"spoof_check,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SRIOVVFSpec) Reset() {
+	*x = SRIOVVFSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SRIOVVFSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SRIOVVFSpec) ProtoMessage() {}
+
+func (x *SRIOVVFSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[66]
 	if x != nil {
-		return x.Scope
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return enums.NethelpersScope(0)
+	return mi.MessageOf(x)
 }
 
-func (x *RouteSpecSpec) GetType() enums.NethelpersRouteType {
+// Deprecated: Use SRIOVVFSpec.ProtoReflect.Descriptor instead.
+func (*SRIOVVFSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SRIOVVFSpec) GetIndex() uint32 {
 	if x != nil {
-		return x.Type
+		return x.Index
 	}
-	return enums.NethelpersRouteType(0)
+	return 0
 }
 
-func (x *RouteSpecSpec) GetFlags() uint32 {
+func (x *SRIOVVFSpec) GetHardwareAddr() []byte {
 	if x != nil {
-		return x.Flags
+		return x.HardwareAddr
 	}
-	return 0
+	return nil
 }
 
-func (x *RouteSpecSpec) GetProtocol() enums.NethelpersRouteProtocol {
+func (x *SRIOVVFSpec) GetVlan() uint32 {
 	if x != nil {
-		return x.Protocol
+		return x.Vlan
 	}
-	return enums.NethelpersRouteProtocol(0)
+	return 0
 }
 
-func (x *RouteSpecSpec) GetConfigLayer() enums.NetworkConfigLayer {
+func (x *SRIOVVFSpec) GetTrust() bool {
 	if x != nil {
-		return x.ConfigLayer
+		return x.Trust
 	}
-	return enums.NetworkConfigLayer(0)
+	return false
 }
 
-func (x *RouteSpecSpec) GetMtu() uint32 {
+func (x *SRIOVVFSpec) GetSpoofCheck() bool {
 	if x != nil {
-		return x.Mtu
+		return x.SpoofCheck
 	}
-	return 0
+	return false
 }
 
-// RouteStatusSpec describes status of rendered secrets.
-type RouteStatusSpec struct {
-	state         protoimpl.MessageState        `protogen:"open.v1"`
-	Family        enums.NethelpersFamily        `protobuf:"varint,1,opt,name=family,proto3,enum=talos.resource.definitions.enums.NethelpersFamily" json:"family,omitempty"`
-	Destination   *common.NetIPPrefix           `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
-	Source        *common.NetIP                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
-	Gateway       *common.NetIP                 `protobuf:"bytes,4,opt,name=gateway,proto3" json:"gateway,omitempty"`
-	OutLinkIndex  uint32                        `protobuf:"varint,5,opt,name=out_link_index,json=outLinkIndex,proto3" json:"out_link_index,omitempty"`
-	OutLinkName   string                        `protobuf:"bytes,6,opt,name=out_link_name,json=outLinkName,proto3" json:"out_link_name,omitempty"`
-	Table         enums.NethelpersRoutingTable  `protobuf:"varint,7,opt,name=table,proto3,enum=talos.resource.definitions.enums.NethelpersRoutingTable" json:"table,omitempty"`
-	Priority      uint32                        `protobuf:"varint,8,opt,name=priority,proto3" json:"priority,omitempty"`
-	Scope         enums.NethelpersScope         `protobuf:"varint,9,opt,name=scope,proto3,enum=talos.resource.definitions.enums.NethelpersScope" json:"scope,omitempty"`
-	Type          enums.NethelpersRouteType     `protobuf:"varint,10,opt,name=type,proto3,enum=talos.resource.definitions.enums.NethelpersRouteType" json:"type,omitempty"`
-	Flags         uint32                        `protobuf:"varint,11,opt,name=flags,proto3" json:"flags,omitempty"`
-	Protocol      enums.NethelpersRouteProtocol `protobuf:"varint,12,opt,name=protocol,proto3,enum=talos.resource.definitions.enums.NethelpersRouteProtocol" json:"protocol,omitempty"`
-	Mtu           uint32                        `protobuf:"varint,13,opt,name=mtu,proto3" json:"mtu,omitempty"`
+// SRIOVVFStatus describes the runtime state of a single SR-IOV virtual function.
+type SRIOVVFStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         uint32                 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	PciAddress    string                 `protobuf:"bytes,2,opt,name=pci_address,json=pciAddress,proto3" json:"pci_address,omitempty"`
+	LinkName      string                 `protobuf:"bytes,3,opt,name=link_name,json=linkName,proto3" json:"link_name,omitempty"`
+	Driver        string                 `protobuf:"bytes,4,opt,name=driver,proto3" json:"driver,omitempty"`
+	HardwareAddr  []byte                 `protobuf:"bytes,5,opt,name=hardware_addr,json=hardwareAddr,proto3" json:"hardware_addr,omitempty"`
+	Vlan          uint32                 `protobuf:"varint,6,opt,name=vlan,proto3" json:"vlan,omitempty"`
+	Trust         bool                   `protobuf:"varint,7,opt,name=trust,proto3" json:"trust,omitempty"`
+	SpoofCheck    bool                   `protobuf:"varint,8,opt,name=spoof_check,json=spoofCheck,proto3" json:"spoof_check,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RouteStatusSpec) Reset() {
-	*x = RouteStatusSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[46]
+func (x *SRIOVVFStatus) Reset() {
+	*x = SRIOVVFStatus{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RouteStatusSpec) String() string {
+func (x *SRIOVVFStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RouteStatusSpec) ProtoMessage() {}
+func (*SRIOVVFStatus) ProtoMessage() {}
 
-func (x *RouteStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[46]
+func (x *SRIOVVFStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3757,125 +5658,217 @@ func (x *RouteStatusSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RouteStatusSpec.ProtoReflect.Descriptor instead.
-func (*RouteStatusSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use SRIOVVFStatus.ProtoReflect.Descriptor instead.
+func (*SRIOVVFStatus) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *RouteStatusSpec) GetFamily() enums.NethelpersFamily {
+func (x *SRIOVVFStatus) GetIndex() uint32 {
 	if x != nil {
-		return x.Family
+		return x.Index
 	}
-	return enums.NethelpersFamily(0)
+	return 0
 }
 
-func (x *RouteStatusSpec) GetDestination() *common.NetIPPrefix {
+func (x *SRIOVVFStatus) GetPciAddress() string {
 	if x != nil {
-		return x.Destination
+		return x.PciAddress
 	}
-	return nil
+	return ""
 }
 
-func (x *RouteStatusSpec) GetSource() *common.NetIP {
+func (x *SRIOVVFStatus) GetLinkName() string {
 	if x != nil {
-		return x.Source
+		return x.LinkName
 	}
-	return nil
+	return ""
 }
 
-func (x *RouteStatusSpec) GetGateway() *common.NetIP {
+func (x *SRIOVVFStatus) GetDriver() string {
 	if x != nil {
-		return x.Gateway
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *SRIOVVFStatus) GetHardwareAddr() []byte {
+	if x != nil {
+		return x.HardwareAddr
 	}
 	return nil
 }
 
-func (x *RouteStatusSpec) GetOutLinkIndex() uint32 {
+func (x *SRIOVVFStatus) GetVlan() uint32 {
 	if x != nil {
-		return x.OutLinkIndex
+		return x.Vlan
 	}
 	return 0
 }
 
-func (x *RouteStatusSpec) GetOutLinkName() string {
+func (x *SRIOVVFStatus) GetTrust() bool {
 	if x != nil {
-		return x.OutLinkName
+		return x.Trust
 	}
-	return ""
+	return false
 }
 
-func (x *RouteStatusSpec) GetTable() enums.NethelpersRoutingTable {
+func (x *SRIOVVFStatus) GetSpoofCheck() bool {
 	if x != nil {
-		return x.Table
+		return x.SpoofCheck
 	}
-	return enums.NethelpersRoutingTable(0)
+	return false
 }
 
-func (x *RouteStatusSpec) GetPriority() uint32 {
+// STPSpec describes Spanning Tree Protocol (STP) settings of a bridge.
+type STPSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *STPSpec) Reset() {
+	*x = STPSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *STPSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*STPSpec) ProtoMessage() {}
+
+func (x *STPSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[68]
 	if x != nil {
-		return x.Priority
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *RouteStatusSpec) GetScope() enums.NethelpersScope {
+// Deprecated: Use STPSpec.ProtoReflect.Descriptor instead.
+func (*STPSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *STPSpec) GetEnabled() bool {
 	if x != nil {
-		return x.Scope
+		return x.Enabled
 	}
-	return enums.NethelpersScope(0)
+	return false
 }
 
-func (x *RouteStatusSpec) GetType() enums.NethelpersRouteType {
+// StaticFallbackOperatorSpec describes static configuration with DHCP fallback operator options.
+type StaticFallbackOperatorSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Addresses     []*AddressSpecSpec     `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Routes        []*RouteSpecSpec       `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes,omitempty"`
+	Gateway       *common.NetIP          `protobuf:"bytes,3,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	ProbeTimeout  *durationpb.Duration   `protobuf:"bytes,4,opt,name=probe_timeout,json=probeTimeout,proto3" json:"probe_timeout,omitempty"`
+	ProbeInterval *durationpb.Duration   `protobuf:"bytes,5,opt,name=probe_interval,json=probeInterval,proto3" json:"probe_interval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StaticFallbackOperatorSpec) Reset() {
+	*x = StaticFallbackOperatorSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StaticFallbackOperatorSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaticFallbackOperatorSpec) ProtoMessage() {}
+
+func (x *StaticFallbackOperatorSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[69]
 	if x != nil {
-		return x.Type
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return enums.NethelpersRouteType(0)
+	return mi.MessageOf(x)
 }
 
-func (x *RouteStatusSpec) GetFlags() uint32 {
+// Deprecated: Use StaticFallbackOperatorSpec.ProtoReflect.Descriptor instead.
+func (*StaticFallbackOperatorSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *StaticFallbackOperatorSpec) GetAddresses() []*AddressSpecSpec {
 	if x != nil {
-		return x.Flags
+		return x.Addresses
 	}
-	return 0
+	return nil
 }
 
-func (x *RouteStatusSpec) GetProtocol() enums.NethelpersRouteProtocol {
+func (x *StaticFallbackOperatorSpec) GetRoutes() []*RouteSpecSpec {
 	if x != nil {
-		return x.Protocol
+		return x.Routes
 	}
-	return enums.NethelpersRouteProtocol(0)
+	return nil
 }
 
-func (x *RouteStatusSpec) GetMtu() uint32 {
+func (x *StaticFallbackOperatorSpec) GetGateway() *common.NetIP {
 	if x != nil {
-		return x.Mtu
+		return x.Gateway
 	}
-	return 0
+	return nil
 }
 
-// STPSpec describes Spanning Tree Protocol (STP) settings of a bridge.
-type STPSpec struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *StaticFallbackOperatorSpec) GetProbeTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.ProbeTimeout
+	}
+	return nil
 }
 
-func (x *STPSpec) Reset() {
-	*x = STPSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[47]
+func (x *StaticFallbackOperatorSpec) GetProbeInterval() *durationpb.Duration {
+	if x != nil {
+		return x.ProbeInterval
+	}
+	return nil
+}
+
+// StaticFallbackStatusSpec describes the status of the static configuration with DHCP fallback.
+type StaticFallbackStatusSpec struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	LinkName         string                 `protobuf:"bytes,1,opt,name=link_name,json=linkName,proto3" json:"link_name,omitempty"`
+	Mode             string                 `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	Gateway          *common.NetIP          `protobuf:"bytes,3,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	GatewayReachable bool                   `protobuf:"varint,4,opt,name=gateway_reachable,json=gatewayReachable,proto3" json:"gateway_reachable,omitempty"`
+	LastProbe        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_probe,json=lastProbe,proto3" json:"last_probe,omitempty"`
+	LastTransition   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_transition,json=lastTransition,proto3" json:"last_transition,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *StaticFallbackStatusSpec) Reset() {
+	*x = StaticFallbackStatusSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *STPSpec) String() string {
+func (x *StaticFallbackStatusSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*STPSpec) ProtoMessage() {}
+func (*StaticFallbackStatusSpec) ProtoMessage() {}
 
-func (x *STPSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[47]
+func (x *StaticFallbackStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3886,18 +5879,53 @@ func (x *STPSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use STPSpec.ProtoReflect.Descriptor instead.
-func (*STPSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use StaticFallbackStatusSpec.ProtoReflect.Descriptor instead.
+func (*StaticFallbackStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *STPSpec) GetEnabled() bool {
+func (x *StaticFallbackStatusSpec) GetLinkName() string {
 	if x != nil {
-		return x.Enabled
+		return x.LinkName
+	}
+	return ""
+}
+
+func (x *StaticFallbackStatusSpec) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *StaticFallbackStatusSpec) GetGateway() *common.NetIP {
+	if x != nil {
+		return x.Gateway
+	}
+	return nil
+}
+
+func (x *StaticFallbackStatusSpec) GetGatewayReachable() bool {
+	if x != nil {
+		return x.GatewayReachable
 	}
 	return false
 }
 
+func (x *StaticFallbackStatusSpec) GetLastProbe() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastProbe
+	}
+	return nil
+}
+
+func (x *StaticFallbackStatusSpec) GetLastTransition() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastTransition
+	}
+	return nil
+}
+
 // StatusSpec describes network state.
 type StatusSpec struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
@@ -3905,13 +5933,14 @@ type StatusSpec struct {
 	ConnectivityReady bool                   `protobuf:"varint,2,opt,name=connectivity_ready,json=connectivityReady,proto3" json:"connectivity_ready,omitempty"`
 	HostnameReady     bool                   `protobuf:"varint,3,opt,name=hostname_ready,json=hostnameReady,proto3" json:"hostname_ready,omitempty"`
 	EtcFilesReady     bool                   `protobuf:"varint,4,opt,name=etc_files_ready,json=etcFilesReady,proto3" json:"etc_files_ready,omitempty"`
+	SriovReady        bool                   `protobuf:"varint,5,opt,name=sriov_ready,json=sriovReady,proto3" json:"sriov_ready,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
 
 func (x *StatusSpec) Reset() {
 	*x = StatusSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[48]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3923,7 +5952,7 @@ func (x *StatusSpec) String() string {
 func (*StatusSpec) ProtoMessage() {}
 
 func (x *StatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[48]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3936,7 +5965,7 @@ func (x *StatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatusSpec.ProtoReflect.Descriptor instead.
 func (*StatusSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{48}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *StatusSpec) GetAddressReady() bool {
@@ -3967,6 +5996,13 @@ func (x *StatusSpec) GetEtcFilesReady() bool {
 	return false
 }
 
+func (x *StatusSpec) GetSriovReady() bool {
+	if x != nil {
+		return x.SriovReady
+	}
+	return false
+}
+
 // TCPProbeSpec describes the TCP Probe.
 type TCPProbeSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -3978,7 +6014,7 @@ type TCPProbeSpec struct {
 
 func (x *TCPProbeSpec) Reset() {
 	*x = TCPProbeSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[49]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3990,7 +6026,7 @@ func (x *TCPProbeSpec) String() string {
 func (*TCPProbeSpec) ProtoMessage() {}
 
 func (x *TCPProbeSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[49]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4003,7 +6039,7 @@ func (x *TCPProbeSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TCPProbeSpec.ProtoReflect.Descriptor instead.
 func (*TCPProbeSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{49}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *TCPProbeSpec) GetEndpoint() string {
@@ -4022,16 +6058,17 @@ func (x *TCPProbeSpec) GetTimeout() *durationpb.Duration {
 
 // TimeServerSpecSpec describes NTP servers.
 type TimeServerSpecSpec struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	NtpServers    []string                 `protobuf:"bytes,1,rep,name=ntp_servers,json=ntpServers,proto3" json:"ntp_servers,omitempty"`
-	ConfigLayer   enums.NetworkConfigLayer `protobuf:"varint,2,opt,name=config_layer,json=configLayer,proto3,enum=talos.resource.definitions.enums.NetworkConfigLayer" json:"config_layer,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState   `protogen:"open.v1"`
+	NtpServers      []string                 `protobuf:"bytes,1,rep,name=ntp_servers,json=ntpServers,proto3" json:"ntp_servers,omitempty"`
+	ConfigLayer     enums.NetworkConfigLayer `protobuf:"varint,2,opt,name=config_layer,json=configLayer,proto3,enum=talos.resource.definitions.enums.NetworkConfigLayer" json:"config_layer,omitempty"`
+	DhcpServersMode string                   `protobuf:"bytes,3,opt,name=dhcp_servers_mode,json=dhcpServersMode,proto3" json:"dhcp_servers_mode,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *TimeServerSpecSpec) Reset() {
 	*x = TimeServerSpecSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[50]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4043,7 +6080,7 @@ func (x *TimeServerSpecSpec) String() string {
 func (*TimeServerSpecSpec) ProtoMessage() {}
 
 func (x *TimeServerSpecSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[50]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4056,7 +6093,7 @@ func (x *TimeServerSpecSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimeServerSpecSpec.ProtoReflect.Descriptor instead.
 func (*TimeServerSpecSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{50}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *TimeServerSpecSpec) GetNtpServers() []string {
@@ -4073,6 +6110,13 @@ func (x *TimeServerSpecSpec) GetConfigLayer() enums.NetworkConfigLayer {
 	return enums.NetworkConfigLayer(0)
 }
 
+func (x *TimeServerSpecSpec) GetDhcpServersMode() string {
+	if x != nil {
+		return x.DhcpServersMode
+	}
+	return ""
+}
+
 // TimeServerStatusSpec describes NTP servers.
 type TimeServerStatusSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -4083,7 +6127,7 @@ type TimeServerStatusSpec struct {
 
 func (x *TimeServerStatusSpec) Reset() {
 	*x = TimeServerStatusSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[51]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4095,7 +6139,7 @@ func (x *TimeServerStatusSpec) String() string {
 func (*TimeServerStatusSpec) ProtoMessage() {}
 
 func (x *TimeServerStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[51]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4108,7 +6152,7 @@ func (x *TimeServerStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimeServerStatusSpec.ProtoReflect.Descriptor instead.
 func (*TimeServerStatusSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{51}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *TimeServerStatusSpec) GetNtpServers() []string {
@@ -4130,7 +6174,7 @@ type VIPEquinixMetalSpec struct {
 
 func (x *VIPEquinixMetalSpec) Reset() {
 	*x = VIPEquinixMetalSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[52]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4142,7 +6186,7 @@ func (x *VIPEquinixMetalSpec) String() string {
 func (*VIPEquinixMetalSpec) ProtoMessage() {}
 
 func (x *VIPEquinixMetalSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[52]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4155,7 +6199,7 @@ func (x *VIPEquinixMetalSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VIPEquinixMetalSpec.ProtoReflect.Descriptor instead.
 func (*VIPEquinixMetalSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{52}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *VIPEquinixMetalSpec) GetProjectId() string {
@@ -4191,7 +6235,7 @@ type VIPHCloudSpec struct {
 
 func (x *VIPHCloudSpec) Reset() {
 	*x = VIPHCloudSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[53]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4203,7 +6247,7 @@ func (x *VIPHCloudSpec) String() string {
 func (*VIPHCloudSpec) ProtoMessage() {}
 
 func (x *VIPHCloudSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[53]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4216,7 +6260,7 @@ func (x *VIPHCloudSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VIPHCloudSpec.ProtoReflect.Descriptor instead.
 func (*VIPHCloudSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{53}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *VIPHCloudSpec) GetDeviceId() int64 {
@@ -4247,13 +6291,15 @@ type VIPOperatorSpec struct {
 	GratuitousArp bool                   `protobuf:"varint,2,opt,name=gratuitous_arp,json=gratuitousArp,proto3" json:"gratuitous_arp,omitempty"`
 	EquinixMetal  *VIPEquinixMetalSpec   `protobuf:"bytes,3,opt,name=equinix_metal,json=equinixMetal,proto3" json:"equinix_metal,omitempty"`
 	HCloud        *VIPHCloudSpec         `protobuf:"bytes,4,opt,name=h_cloud,json=hCloud,proto3" json:"h_cloud,omitempty"`
+	Priority      uint32                 `protobuf:"varint,5,opt,name=priority,proto3" json:"priority,omitempty"`
+	Preempt       bool                   `protobuf:"varint,6,opt,name=preempt,proto3" json:"preempt,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *VIPOperatorSpec) Reset() {
 	*x = VIPOperatorSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[54]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4265,7 +6311,7 @@ func (x *VIPOperatorSpec) String() string {
 func (*VIPOperatorSpec) ProtoMessage() {}
 
 func (x *VIPOperatorSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[54]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4278,7 +6324,7 @@ func (x *VIPOperatorSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VIPOperatorSpec.ProtoReflect.Descriptor instead.
 func (*VIPOperatorSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{54}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *VIPOperatorSpec) GetIp() *common.NetIP {
@@ -4309,6 +6355,121 @@ func (x *VIPOperatorSpec) GetHCloud() *VIPHCloudSpec {
 	return nil
 }
 
+func (x *VIPOperatorSpec) GetPriority() uint32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *VIPOperatorSpec) GetPreempt() bool {
+	if x != nil {
+		return x.Preempt
+	}
+	return false
+}
+
+// VIPStatusSpec describes the status of the shared IP (VIP) election.
+type VIPStatusSpec struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	LinkName       string                 `protobuf:"bytes,1,opt,name=link_name,json=linkName,proto3" json:"link_name,omitempty"`
+	Ip             *common.NetIP          `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
+	Priority       uint32                 `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	Preempt        bool                   `protobuf:"varint,4,opt,name=preempt,proto3" json:"preempt,omitempty"`
+	Owned          bool                   `protobuf:"varint,5,opt,name=owned,proto3" json:"owned,omitempty"`
+	Owner          string                 `protobuf:"bytes,6,opt,name=owner,proto3" json:"owner,omitempty"`
+	OwnerPriority  uint32                 `protobuf:"varint,7,opt,name=owner_priority,json=ownerPriority,proto3" json:"owner_priority,omitempty"`
+	LastTransition *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_transition,json=lastTransition,proto3" json:"last_transition,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *VIPStatusSpec) Reset() {
+	*x = VIPStatusSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VIPStatusSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VIPStatusSpec) ProtoMessage() {}
+
+func (x *VIPStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VIPStatusSpec.ProtoReflect.Descriptor instead.
+func (*VIPStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *VIPStatusSpec) GetLinkName() string {
+	if x != nil {
+		return x.LinkName
+	}
+	return ""
+}
+
+func (x *VIPStatusSpec) GetIp() *common.NetIP {
+	if x != nil {
+		return x.Ip
+	}
+	return nil
+}
+
+func (x *VIPStatusSpec) GetPriority() uint32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *VIPStatusSpec) GetPreempt() bool {
+	if x != nil {
+		return x.Preempt
+	}
+	return false
+}
+
+func (x *VIPStatusSpec) GetOwned() bool {
+	if x != nil {
+		return x.Owned
+	}
+	return false
+}
+
+func (x *VIPStatusSpec) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *VIPStatusSpec) GetOwnerPriority() uint32 {
+	if x != nil {
+		return x.OwnerPriority
+	}
+	return 0
+}
+
+func (x *VIPStatusSpec) GetLastTransition() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastTransition
+	}
+	return nil
+}
+
 // VLANSpec describes VLAN settings if Kind == "vlan".
 type VLANSpec struct {
 	state         protoimpl.MessageState       `protogen:"open.v1"`
@@ -4320,7 +6481,7 @@ type VLANSpec struct {
 
 func (x *VLANSpec) Reset() {
 	*x = VLANSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[55]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4332,7 +6493,7 @@ func (x *VLANSpec) String() string {
 func (*VLANSpec) ProtoMessage() {}
 
 func (x *VLANSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[55]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4345,7 +6506,7 @@ func (x *VLANSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VLANSpec.ProtoReflect.Descriptor instead.
 func (*VLANSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{55}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *VLANSpec) GetVid() uint32 {
@@ -4362,6 +6523,107 @@ func (x *VLANSpec) GetProtocol() enums.NethelpersVLANProtocol {
 	return enums.NethelpersVLANProtocol(0)
 }
 
+// WireguardEndpointStatusSpec describes the resolution state of a Wireguard peer endpoint.
+type WireguardEndpointStatusSpec struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	LinkName         string                 `protobuf:"bytes,1,opt,name=link_name,json=linkName,proto3" json:"link_name,omitempty"`
+	PublicKey        string                 `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Endpoint         string                 `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	ResolvedEndpoint *common.NetIPPort      `protobuf:"bytes,4,opt,name=resolved_endpoint,json=resolvedEndpoint,proto3" json:"resolved_endpoint,omitempty"`
+	CurrentEndpoint  *common.NetIPPort      `protobuf:"bytes,5,opt,name=current_endpoint,json=currentEndpoint,proto3" json:"current_endpoint,omitempty"`
+	LastResolved     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_resolved,json=lastResolved,proto3" json:"last_resolved,omitempty"`
+	LastHandshake    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_handshake,json=lastHandshake,proto3" json:"last_handshake,omitempty"`
+	LastError        string                 `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *WireguardEndpointStatusSpec) Reset() {
+	*x = WireguardEndpointStatusSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WireguardEndpointStatusSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WireguardEndpointStatusSpec) ProtoMessage() {}
+
+func (x *WireguardEndpointStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WireguardEndpointStatusSpec.ProtoReflect.Descriptor instead.
+func (*WireguardEndpointStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *WireguardEndpointStatusSpec) GetLinkName() string {
+	if x != nil {
+		return x.LinkName
+	}
+	return ""
+}
+
+func (x *WireguardEndpointStatusSpec) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *WireguardEndpointStatusSpec) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *WireguardEndpointStatusSpec) GetResolvedEndpoint() *common.NetIPPort {
+	if x != nil {
+		return x.ResolvedEndpoint
+	}
+	return nil
+}
+
+func (x *WireguardEndpointStatusSpec) GetCurrentEndpoint() *common.NetIPPort {
+	if x != nil {
+		return x.CurrentEndpoint
+	}
+	return nil
+}
+
+func (x *WireguardEndpointStatusSpec) GetLastResolved() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastResolved
+	}
+	return nil
+}
+
+func (x *WireguardEndpointStatusSpec) GetLastHandshake() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastHandshake
+	}
+	return nil
+}
+
+func (x *WireguardEndpointStatusSpec) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
 // WireguardPeer describes a single peer.
 type WireguardPeer struct {
 	state                       protoimpl.MessageState `protogen:"open.v1"`
@@ -4376,7 +6638,7 @@ type WireguardPeer struct {
 
 func (x *WireguardPeer) Reset() {
 	*x = WireguardPeer{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[56]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4388,7 +6650,7 @@ func (x *WireguardPeer) String() string {
 func (*WireguardPeer) ProtoMessage() {}
 
 func (x *WireguardPeer) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[56]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4401,7 +6663,7 @@ func (x *WireguardPeer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WireguardPeer.ProtoReflect.Descriptor instead.
 func (*WireguardPeer) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{56}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *WireguardPeer) GetPublicKey() string {
@@ -4441,19 +6703,20 @@ func (x *WireguardPeer) GetAllowedIps() []*common.NetIPPrefix {
 
 // WireguardSpec describes Wireguard settings if Kind == "wireguard".
 type WireguardSpec struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PrivateKey    string                 `protobuf:"bytes,1,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
-	PublicKey     string                 `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	ListenPort    int64                  `protobuf:"varint,3,opt,name=listen_port,json=listenPort,proto3" json:"listen_port,omitempty"`
-	FirewallMark  int64                  `protobuf:"varint,4,opt,name=firewall_mark,json=firewallMark,proto3" json:"firewall_mark,omitempty"`
-	Peers         []*WireguardPeer       `protobuf:"bytes,5,rep,name=peers,proto3" json:"peers,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	PrivateKey              string                 `protobuf:"bytes,1,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	PublicKey               string                 `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	ListenPort              int64                  `protobuf:"varint,3,opt,name=listen_port,json=listenPort,proto3" json:"listen_port,omitempty"`
+	FirewallMark            int64                  `protobuf:"varint,4,opt,name=firewall_mark,json=firewallMark,proto3" json:"firewall_mark,omitempty"`
+	Peers                   []*WireguardPeer       `protobuf:"bytes,5,rep,name=peers,proto3" json:"peers,omitempty"`
+	EndpointResolveInterval *durationpb.Duration   `protobuf:"bytes,6,opt,name=endpoint_resolve_interval,json=endpointResolveInterval,proto3" json:"endpoint_resolve_interval,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
 func (x *WireguardSpec) Reset() {
 	*x = WireguardSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[57]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4465,7 +6728,7 @@ func (x *WireguardSpec) String() string {
 func (*WireguardSpec) ProtoMessage() {}
 
 func (x *WireguardSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[57]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4478,7 +6741,7 @@ func (x *WireguardSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WireguardSpec.ProtoReflect.Descriptor instead.
 func (*WireguardSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{57}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *WireguardSpec) GetPrivateKey() string {
@@ -4516,11 +6779,18 @@ func (x *WireguardSpec) GetPeers() []*WireguardPeer {
 	return nil
 }
 
+func (x *WireguardSpec) GetEndpointResolveInterval() *durationpb.Duration {
+	if x != nil {
+		return x.EndpointResolveInterval
+	}
+	return nil
+}
+
 var File_resource_definitions_network_network_proto protoreflect.FileDescriptor
 
 const file_resource_definitions_network_network_proto_rawDesc = "" +
 	"\n" +
-	"*resource/definitions/network/network.proto\x12\"talos.resource.definitions.network\x1a\x13common/common.proto\x1a\x1egoogle/protobuf/duration.proto\x1a&resource/definitions/enums/enums.proto\x1a*resource/definitions/runtime/runtime.proto\"\xa9\x03\n" +
+	"*resource/definitions/network/network.proto\x12\"talos.resource.definitions.network\x1a\x13common/common.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a&resource/definitions/enums/enums.proto\x1a*resource/definitions/runtime/runtime.proto\"\xa9\x03\n" +
 	"\x0fAddressSpecSpec\x12-\n" +
 	"\aaddress\x18\x01 \x01(\v2\x13.common.NetIPPrefixR\aaddress\x12\x1b\n" +
 	"\tlink_name\x18\x02 \x01(\tR\blinkName\x12J\n" +
@@ -4543,8 +6813,10 @@ const file_resource_definitions_network_network_proto_rawDesc = "" +
 	"\x05scope\x18\t \x01(\x0e21.talos.resource.definitions.enums.NethelpersScopeR\x05scope\x12\x14\n" +
 	"\x05flags\x18\n" +
 	" \x01(\rR\x05flags\x12\x1a\n" +
-	"\bpriority\x18\v \x01(\rR\bpriority\"\xa4\n" +
-	"\n" +
+	"\bpriority\x18\v \x01(\rR\bpriority\"T\n" +
+	"\x13BondARPTargetStatus\x12'\n" +
+	"\aaddress\x18\x01 \x01(\v2\r.common.NetIPR\aaddress\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\"\x8c\v\n" +
 	"\x0eBondMasterSpec\x12H\n" +
 	"\x04mode\x18\x01 \x01(\x0e24.talos.resource.definitions.enums.NethelpersBondModeR\x04mode\x12_\n" +
 	"\vhash_policy\x18\x02 \x01(\x0e2>.talos.resource.definitions.enums.NethelpersBondXmitHashPolicyR\n" +
@@ -4575,29 +6847,93 @@ const file_resource_definitions_network_network_proto_rawDesc = "" +
 	"useCarrier\x12)\n" +
 	"\x11ad_actor_sys_prio\x18\x16 \x01(\aR\x0eadActorSysPrio\x12'\n" +
 	"\x10ad_user_port_key\x18\x17 \x01(\aR\radUserPortKey\x12*\n" +
-	"\x11peer_notify_delay\x18\x18 \x01(\rR\x0fpeerNotifyDelay\"M\n" +
+	"\x11peer_notify_delay\x18\x18 \x01(\rR\x0fpeerNotifyDelay\x122\n" +
+	"\rarpip_targets\x18\x19 \x03(\v2\r.common.NetIPR\farpipTargets\x122\n" +
+	"\rnsip6_targets\x18\x1a \x03(\v2\r.common.NetIPR\fnsip6Targets\"M\n" +
 	"\tBondSlave\x12\x1f\n" +
 	"\vmaster_name\x18\x01 \x01(\tR\n" +
 	"masterName\x12\x1f\n" +
 	"\vslave_index\x18\x02 \x01(\x03R\n" +
-	"slaveIndex\"\x99\x01\n" +
+	"slaveIndex\"\xbe\x02\n" +
+	"\x0fBondSlaveStatus\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12\x1d\n" +
+	"\n" +
+	"mii_status\x18\x03 \x01(\tR\tmiiStatus\x12,\n" +
+	"\x12link_failure_count\x18\x04 \x01(\rR\x10linkFailureCount\x12%\n" +
+	"\x0epermanent_addr\x18\x05 \x01(\fR\rpermanentAddr\x12#\n" +
+	"\raggregator_id\x18\x06 \x01(\aR\faggregatorId\x121\n" +
+	"\x15actor_oper_port_state\x18\a \x01(\aR\x12actorOperPortState\x125\n" +
+	"\x17partner_oper_port_state\x18\b \x01(\aR\x14partnerOperPortState\"\xf9\x04\n" +
+	"\x0eBondStatusSpec\x12H\n" +
+	"\x04mode\x18\x01 \x01(\x0e24.talos.resource.definitions.enums.NethelpersBondModeR\x04mode\x12_\n" +
+	"\vhash_policy\x18\x02 \x01(\x0e2>.talos.resource.definitions.enums.NethelpersBondXmitHashPolicyR\n" +
+	"hashPolicy\x12Q\n" +
+	"\tlacp_rate\x18\x03 \x01(\x0e24.talos.resource.definitions.enums.NethelpersLACPRateR\blacpRate\x12!\n" +
+	"\factive_slave\x18\x04 \x01(\tR\vactiveSlave\x12#\n" +
+	"\raggregator_id\x18\x05 \x01(\aR\faggregatorId\x12\x1b\n" +
+	"\tnum_ports\x18\x06 \x01(\aR\bnumPorts\x12\x1b\n" +
+	"\tactor_key\x18\a \x01(\aR\bactorKey\x12\x1f\n" +
+	"\vpartner_key\x18\b \x01(\aR\n" +
+	"partnerKey\x12\x1f\n" +
+	"\vpartner_mac\x18\t \x01(\fR\n" +
+	"partnerMac\x12K\n" +
+	"\x06slaves\x18\n" +
+	" \x03(\v23.talos.resource.definitions.network.BondSlaveStatusR\x06slaves\x12X\n" +
+	"\varp_targets\x18\v \x03(\v27.talos.resource.definitions.network.BondARPTargetStatusR\n" +
+	"arpTargets\"\x99\x01\n" +
 	"\x10BridgeMasterSpec\x12=\n" +
 	"\x03stp\x18\x01 \x01(\v2+.talos.resource.definitions.network.STPSpecR\x03stp\x12F\n" +
-	"\x04vlan\x18\x02 \x01(\v22.talos.resource.definitions.network.BridgeVLANSpecR\x04vlan\".\n" +
+	"\x04vlan\x18\x02 \x01(\v22.talos.resource.definitions.network.BridgeVLANSpecR\x04vlan\"r\n" +
+	"\x10BridgePortStatus\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12J\n" +
+	"\x06vla_ns\x18\x02 \x03(\v23.talos.resource.definitions.network.BridgeVLANEntryR\x05vlaNs\"z\n" +
 	"\vBridgeSlave\x12\x1f\n" +
 	"\vmaster_name\x18\x01 \x01(\tR\n" +
-	"masterName\"=\n" +
+	"masterName\x12J\n" +
+	"\x06vla_ns\x18\x02 \x03(\v23.talos.resource.definitions.network.BridgeVLANEntryR\x05vlaNs\"\x85\x01\n" +
+	"\x10BridgeStatusSpec\x12%\n" +
+	"\x0evlan_filtering\x18\x01 \x01(\bR\rvlanFiltering\x12J\n" +
+	"\x05ports\x18\x02 \x03(\v24.talos.resource.definitions.network.BridgePortStatusR\x05ports\"S\n" +
+	"\x0fBridgeVLANEntry\x12\x10\n" +
+	"\x03vid\x18\x01 \x01(\rR\x03vid\x12\x12\n" +
+	"\x04pvid\x18\x02 \x01(\bR\x04pvid\x12\x1a\n" +
+	"\buntagged\x18\x03 \x01(\bR\buntagged\"=\n" +
 	"\x0eBridgeVLANSpec\x12+\n" +
-	"\x11filtering_enabled\x18\x01 \x01(\bR\x10filteringEnabled\"j\n" +
+	"\x11filtering_enabled\x18\x01 \x01(\bR\x10filteringEnabled\"\xd1\x02\n" +
+	"\x0eDHCP4LeaseSpec\x12\x1b\n" +
+	"\tlink_name\x18\x01 \x01(\tR\blinkName\x12-\n" +
+	"\aaddress\x18\x02 \x01(\v2\x13.common.NetIPPrefixR\aaddress\x12*\n" +
+	"\tserver_id\x18\x03 \x01(\v2\r.common.NetIPR\bserverId\x128\n" +
+	"\n" +
+	"lease_time\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\tleaseTime\x122\n" +
+	"\x06expiry\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x06expiry\x12\x10\n" +
+	"\x03ack\x18\x06 \x01(\fR\x03ack\x12+\n" +
+	"\x11client_identifier\x18\a \x01(\tR\x10clientIdentifier\x12\x1a\n" +
+	"\bhostname\x18\b \x01(\tR\bhostname\"\xe2\x02\n" +
 	"\x11DHCP4OperatorSpec\x12!\n" +
 	"\froute_metric\x18\x01 \x01(\rR\vrouteMetric\x122\n" +
-	"\x15skip_hostname_request\x18\x02 \x01(\bR\x13skipHostnameRequest\"~\n" +
+	"\x15skip_hostname_request\x18\x02 \x01(\bR\x13skipHostnameRequest\x12C\n" +
+	"\x1eignore_classless_static_routes\x18\x03 \x01(\bR\x1bignoreClasslessStaticRoutes\x12)\n" +
+	"\x10optimistic_lease\x18\x04 \x01(\bR\x0foptimisticLease\x12+\n" +
+	"\x11client_identifier\x18\x05 \x01(\tR\x10clientIdentifier\x12,\n" +
+	"\x12skip_send_hostname\x18\x06 \x01(\bR\x10skipSendHostname\x12+\n" +
+	"\x11requested_options\x18\a \x03(\rR\x10requestedOptions\"\x92\x01\n" +
 	"\x11DHCP6OperatorSpec\x12\x12\n" +
 	"\x04duid\x18\x01 \x01(\tR\x04duid\x12!\n" +
 	"\froute_metric\x18\x02 \x01(\rR\vrouteMetric\x122\n" +
-	"\x15skip_hostname_request\x18\x03 \x01(\bR\x13skipHostnameRequest\"-\n" +
+	"\x15skip_hostname_request\x18\x03 \x01(\bR\x13skipHostnameRequest\x12\x12\n" +
+	"\x04iaid\x18\x04 \x01(\rR\x04iaid\"X\n" +
 	"\x13DNSResolveCacheSpec\x12\x16\n" +
-	"\x06status\x18\x01 \x01(\tR\x06status\"h\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12)\n" +
+	"\x10rejected_queries\x18\x02 \x01(\x04R\x0frejectedQueries\"\xb9\x01\n" +
+	"\x16DefaultRouteStatusSpec\x12J\n" +
+	"\x06family\x18\x01 \x01(\x0e22.talos.resource.definitions.enums.NethelpersFamilyR\x06family\x12\x1b\n" +
+	"\tlink_name\x18\x02 \x01(\tR\blinkName\x12\x1e\n" +
+	"\n" +
+	"candidates\x18\x03 \x03(\tR\n" +
+	"candidates\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"h\n" +
 	"\x14EthernetChannelsSpec\x12\x0e\n" +
 	"\x02rx\x18\x01 \x01(\rR\x02rx\x12\x0e\n" +
 	"\x02tx\x18\x02 \x01(\rR\x02tx\x12\x14\n" +
@@ -4611,7 +6947,10 @@ const file_resource_definitions_network_network_proto_rawDesc = "" +
 	"\x02rx\x18\x05 \x01(\rR\x02rx\x12\x0e\n" +
 	"\x02tx\x18\x06 \x01(\rR\x02tx\x12\x14\n" +
 	"\x05other\x18\a \x01(\rR\x05other\x12\x1a\n" +
-	"\bcombined\x18\b \x01(\rR\bcombined\"C\n" +
+	"\bcombined\x18\b \x01(\rR\bcombined\"A\n" +
+	"\x13EthernetConfigError\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"C\n" +
 	"\x15EthernetFeatureStatus\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\"\x9f\x02\n" +
@@ -4653,7 +6992,7 @@ const file_resource_definitions_network_network_proto_rawDesc = "" +
 	"\bchannels\x18\x03 \x01(\v28.talos.resource.definitions.network.EthernetChannelsSpecR\bchannels\x1a;\n" +
 	"\rFeaturesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01\"\xa6\x04\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01\"\x84\x05\n" +
 	"\x12EthernetStatusSpec\x12\x1d\n" +
 	"\n" +
 	"link_state\x18\x01 \x01(\bR\tlinkState\x12%\n" +
@@ -4665,15 +7004,29 @@ const file_resource_definitions_network_network_proto_rawDesc = "" +
 	"peer_modes\x18\x06 \x03(\tR\tpeerModes\x12M\n" +
 	"\x05rings\x18\a \x01(\v27.talos.resource.definitions.network.EthernetRingsStatusR\x05rings\x12U\n" +
 	"\bfeatures\x18\b \x03(\v29.talos.resource.definitions.network.EthernetFeatureStatusR\bfeatures\x12V\n" +
-	"\bchannels\x18\t \x01(\v2:.talos.resource.definitions.network.EthernetChannelsStatusR\bchannels\"K\n" +
+	"\bchannels\x18\t \x01(\v2:.talos.resource.definitions.network.EthernetChannelsStatusR\bchannels\x12\\\n" +
+	"\rconfig_errors\x18\n" +
+	" \x03(\v27.talos.resource.definitions.network.EthernetConfigErrorR\fconfigErrors\"V\n" +
+	"\rHTTPProbeSpec\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x123\n" +
+	"\atimeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"K\n" +
 	"\x10HardwareAddrSpec\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12#\n" +
-	"\rhardware_addr\x18\x02 \x01(\fR\fhardwareAddr\"\xe5\x01\n" +
+	"\rhardware_addr\x18\x02 \x01(\fR\fhardwareAddr\"\xc5\x04\n" +
 	"\x11HostDNSConfigSpec\x12\x18\n" +
 	"\aenabled\x18\x01 \x01(\bR\aenabled\x12<\n" +
 	"\x10listen_addresses\x18\x02 \x03(\v2\x11.common.NetIPPortR\x0flistenAddresses\x12F\n" +
 	"\x18service_host_dns_address\x18\x03 \x01(\v2\r.common.NetIPR\x15serviceHostDnsAddress\x120\n" +
-	"\x14resolve_member_names\x18\x04 \x01(\bR\x12resolveMemberNames\"\xa7\x01\n" +
+	"\x14resolve_member_names\x18\x04 \x01(\bR\x12resolveMemberNames\x12\x1d\n" +
+	"\n" +
+	"cache_size\x18\x05 \x01(\x03R\tcacheSize\x12=\n" +
+	"\rcache_min_ttl\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\vcacheMinTtl\x12=\n" +
+	"\rcache_max_ttl\x18\a \x01(\v2\x19.google.protobuf.DurationR\vcacheMaxTtl\x12G\n" +
+	"\x12negative_cache_ttl\x18\b \x01(\v2\x19.google.protobuf.DurationR\x10negativeCacheTtl\x12:\n" +
+	"\vserve_stale\x18\t \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"serveStale\x12<\n" +
+	"\x0fallowed_clients\x18\n" +
+	" \x03(\v2\x13.common.NetIPPrefixR\x0eallowedClients\"\xa7\x01\n" +
 	"\x10HostnameSpecSpec\x12\x1a\n" +
 	"\bhostname\x18\x01 \x01(\tR\bhostname\x12\x1e\n" +
 	"\n" +
@@ -4684,7 +7037,23 @@ const file_resource_definitions_network_network_proto_rawDesc = "" +
 	"\bhostname\x18\x01 \x01(\tR\bhostname\x12\x1e\n" +
 	"\n" +
 	"domainname\x18\x02 \x01(\tR\n" +
-	"domainname\"1\n" +
+	"domainname\"X\n" +
+	"\rICMPProbeSpec\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x123\n" +
+	"\atimeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\xec\x02\n" +
+	"\x16LLDPNeighborStatusSpec\x12\x1b\n" +
+	"\tlink_name\x18\x01 \x01(\tR\blinkName\x12\x1d\n" +
+	"\n" +
+	"chassis_id\x18\x02 \x01(\tR\tchassisId\x12\x17\n" +
+	"\aport_id\x18\x03 \x01(\tR\x06portId\x12)\n" +
+	"\x10port_description\x18\x04 \x01(\tR\x0fportDescription\x12\x1f\n" +
+	"\vsystem_name\x18\x05 \x01(\tR\n" +
+	"systemName\x12-\n" +
+	"\x12system_description\x18\x06 \x01(\tR\x11systemDescription\x12\x1f\n" +
+	"\vport_vlanid\x18\a \x01(\rR\n" +
+	"portVlanid\x12+\n" +
+	"\x03ttl\x18\b \x01(\v2\x19.google.protobuf.DurationR\x03ttl\x124\n" +
+	"\aexpires\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\aexpires\"1\n" +
 	"\x0fLinkRefreshSpec\x12\x1e\n" +
 	"\n" +
 	"generation\x18\x01 \x01(\x03R\n" +
@@ -4707,8 +7076,7 @@ const file_resource_definitions_network_network_proto_rawDesc = "" +
 	"bondMaster\x12Y\n" +
 	"\rbridge_master\x18\f \x01(\v24.talos.resource.definitions.network.BridgeMasterSpecR\fbridgeMaster\x12O\n" +
 	"\twireguard\x18\r \x01(\v21.talos.resource.definitions.network.WireguardSpecR\twireguard\x12W\n" +
-	"\fconfig_layer\x18\x0e \x01(\x0e24.talos.resource.definitions.enums.NetworkConfigLayerR\vconfigLayer\"\xe1\n" +
-	"\n" +
+	"\fconfig_layer\x18\x0e \x01(\x0e24.talos.resource.definitions.enums.NetworkConfigLayerR\vconfigLayer\"\x88\v\n" +
 	"\x0eLinkStatusSpec\x12\x14\n" +
 	"\x05index\x18\x01 \x01(\rR\x05index\x12H\n" +
 	"\x04type\x18\x02 \x01(\x0e24.talos.resource.definitions.enums.NethelpersLinkTypeR\x04type\x12\x1d\n" +
@@ -4748,7 +7116,8 @@ const file_resource_definitions_network_network_proto_rawDesc = "" +
 	"\twireguard\x18\x1d \x01(\v21.talos.resource.definitions.network.WireguardSpecR\twireguard\x12%\n" +
 	"\x0epermanent_addr\x18\x1e \x01(\fR\rpermanentAddr\x12\x14\n" +
 	"\x05alias\x18\x1f \x01(\tR\x05alias\x12\x1b\n" +
-	"\talt_names\x18  \x03(\tR\baltNames\"\xaa\x01\n" +
+	"\talt_names\x18  \x03(\tR\baltNames\x12%\n" +
+	"\x0econfigured_mtu\x18! \x01(\rR\rconfiguredMtu\"\xaa\x01\n" +
 	"\x14NfTablesAddressMatch\x12<\n" +
 	"\x0finclude_subnets\x18\x01 \x03(\v2\x13.common.NetIPPrefixR\x0eincludeSubnets\x12<\n" +
 	"\x0fexclude_subnets\x18\x02 \x03(\v2\x13.common.NetIPPrefixR\x0eexcludeSubnets\x12\x16\n" +
@@ -4774,13 +7143,15 @@ const file_resource_definitions_network_network_proto_rawDesc = "" +
 	"\x16match_destination_port\x18\x03 \x01(\v25.talos.resource.definitions.network.NfTablesPortMatchR\x14matchDestinationPort\x12a\n" +
 	"\x0fmatch_icmp_type\x18\x04 \x01(\v29.talos.resource.definitions.network.NfTablesICMPTypeMatchR\rmatchIcmpType\"I\n" +
 	"\x12NfTablesLimitMatch\x123\n" +
-	"\x16packet_rate_per_second\x18\x01 \x01(\x04R\x13packetRatePerSecond\"J\n" +
+	"\x16packet_rate_per_second\x18\x01 \x01(\x04R\x13packetRatePerSecond\"%\n" +
+	"\vNfTablesLog\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\"J\n" +
 	"\fNfTablesMark\x12\x12\n" +
 	"\x04mask\x18\x01 \x01(\rR\x04mask\x12\x10\n" +
 	"\x03xor\x18\x02 \x01(\rR\x03xor\x12\x14\n" +
 	"\x05value\x18\x03 \x01(\rR\x05value\"Z\n" +
 	"\x11NfTablesPortMatch\x12E\n" +
-	"\x06ranges\x18\x01 \x03(\v2-.talos.resource.definitions.network.PortRangeR\x06ranges\"\xc5\b\n" +
+	"\x06ranges\x18\x01 \x03(\v2-.talos.resource.definitions.network.PortRangeR\x06ranges\"\x88\t\n" +
 	"\fNfTablesRule\x12^\n" +
 	"\x0fmatch_o_if_name\x18\x01 \x01(\v27.talos.resource.definitions.network.NfTablesIfNameMatchR\fmatchOIfName\x12U\n" +
 	"\averdict\x18\x02 \x01(\x0e2;.talos.resource.definitions.enums.NethelpersNfTablesVerdictR\averdict\x12O\n" +
@@ -4796,7 +7167,8 @@ const file_resource_definitions_network_network_proto_rawDesc = "" +
 	" \x01(\v26.talos.resource.definitions.network.NfTablesLimitMatchR\n" +
 	"matchLimit\x12s\n" +
 	"\x15match_conntrack_state\x18\v \x01(\v2?.talos.resource.definitions.network.NfTablesConntrackStateMatchR\x13matchConntrackState\x12!\n" +
-	"\fanon_counter\x18\f \x01(\bR\vanonCounter\"\x93\x01\n" +
+	"\fanon_counter\x18\f \x01(\bR\vanonCounter\x12A\n" +
+	"\x03log\x18\r \x01(\v2/.talos.resource.definitions.network.NfTablesLogR\x03log\"\x93\x01\n" +
 	"\x15NodeAddressFilterSpec\x12<\n" +
 	"\x0finclude_subnets\x18\x01 \x03(\v2\x13.common.NetIPPrefixR\x0eincludeSubnets\x12<\n" +
 	"\x0fexclude_subnets\x18\x02 \x03(\v2\x13.common.NetIPPrefixR\x0eexcludeSubnets\"~\n" +
@@ -4804,7 +7176,7 @@ const file_resource_definitions_network_network_proto_rawDesc = "" +
 	"\talgorithm\x18\x01 \x01(\x0e2@.talos.resource.definitions.enums.NethelpersAddressSortAlgorithmR\talgorithm\"\xad\x01\n" +
 	"\x0fNodeAddressSpec\x121\n" +
 	"\taddresses\x18\x01 \x03(\v2\x13.common.NetIPPrefixR\taddresses\x12g\n" +
-	"\x0esort_algorithm\x18\x02 \x01(\x0e2@.talos.resource.definitions.enums.NethelpersAddressSortAlgorithmR\rsortAlgorithm\"\xd7\x03\n" +
+	"\x0esort_algorithm\x18\x02 \x01(\x0e2@.talos.resource.definitions.enums.NethelpersAddressSortAlgorithmR\rsortAlgorithm\"\xc0\x04\n" +
 	"\x10OperatorSpecSpec\x12M\n" +
 	"\boperator\x18\x01 \x01(\x0e21.talos.resource.definitions.enums.NetworkOperatorR\boperator\x12\x1b\n" +
 	"\tlink_name\x18\x02 \x01(\tR\blinkName\x12\x1d\n" +
@@ -4813,7 +7185,8 @@ const file_resource_definitions_network_network_proto_rawDesc = "" +
 	"\x05dhcp4\x18\x04 \x01(\v25.talos.resource.definitions.network.DHCP4OperatorSpecR\x05dhcp4\x12K\n" +
 	"\x05dhcp6\x18\x05 \x01(\v25.talos.resource.definitions.network.DHCP6OperatorSpecR\x05dhcp6\x12E\n" +
 	"\x03vip\x18\x06 \x01(\v23.talos.resource.definitions.network.VIPOperatorSpecR\x03vip\x12W\n" +
-	"\fconfig_layer\x18\a \x01(\x0e24.talos.resource.definitions.enums.NetworkConfigLayerR\vconfigLayer\"\xa4\x06\n" +
+	"\fconfig_layer\x18\a \x01(\x0e24.talos.resource.definitions.enums.NetworkConfigLayerR\vconfigLayer\x12g\n" +
+	"\x0fstatic_fallback\x18\b \x01(\v2>.talos.resource.definitions.network.StaticFallbackOperatorSpecR\x0estaticFallback\"\xa4\x06\n" +
 	"\x12PlatformConfigSpec\x12Q\n" +
 	"\taddresses\x18\x01 \x03(\v23.talos.resource.definitions.network.AddressSpecSpecR\taddresses\x12F\n" +
 	"\x05links\x18\x02 \x03(\v20.talos.resource.definitions.network.LinkSpecSpecR\x05links\x12I\n" +
@@ -4828,25 +7201,58 @@ const file_resource_definitions_network_network_proto_rawDesc = "" +
 	" \x01(\v28.talos.resource.definitions.runtime.PlatformMetadataSpecR\bmetadata\"+\n" +
 	"\tPortRange\x12\x0e\n" +
 	"\x02lo\x18\x01 \x01(\aR\x02lo\x12\x0e\n" +
-	"\x02hi\x18\x02 \x01(\aR\x02hi\"\x90\x02\n" +
+	"\x02hi\x18\x02 \x01(\aR\x02hi\"\x9e\x03\n" +
 	"\rProbeSpecSpec\x125\n" +
 	"\binterval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\binterval\x12+\n" +
 	"\x11failure_threshold\x18\x02 \x01(\x03R\x10failureThreshold\x12B\n" +
 	"\x03tcp\x18\x03 \x01(\v20.talos.resource.definitions.network.TCPProbeSpecR\x03tcp\x12W\n" +
-	"\fconfig_layer\x18\x04 \x01(\x0e24.talos.resource.definitions.enums.NetworkConfigLayerR\vconfigLayer\"J\n" +
+	"\fconfig_layer\x18\x04 \x01(\x0e24.talos.resource.definitions.enums.NetworkConfigLayerR\vconfigLayer\x12E\n" +
+	"\x04http\x18\x05 \x01(\v21.talos.resource.definitions.network.HTTPProbeSpecR\x04http\x12E\n" +
+	"\x04icmp\x18\x06 \x01(\v21.talos.resource.definitions.network.ICMPProbeSpecR\x04icmp\"\xbb\x01\n" +
 	"\x0fProbeStatusSpec\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1d\n" +
 	"\n" +
-	"last_error\x18\x02 \x01(\tR\tlastError\"\xc2\x01\n" +
+	"last_error\x18\x02 \x01(\tR\tlastError\x121\n" +
+	"\x14consecutive_failures\x18\x03 \x01(\x03R\x13consecutiveFailures\x12<\n" +
+	"\flast_latency\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\vlastLatency\"\xa3\x01\n" +
+	"\x0fResolverOptions\x12\x16\n" +
+	"\x06rotate\x18\x01 \x01(\bR\x06rotate\x123\n" +
+	"\atimeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12\x1a\n" +
+	"\battempts\x18\x03 \x01(\x03R\battempts\x12'\n" +
+	"\x0fupstream_policy\x18\x04 \x01(\tR\x0eupstreamPolicy\"\x91\x02\n" +
 	"\x10ResolverSpecSpec\x12.\n" +
 	"\vdns_servers\x18\x01 \x03(\v2\r.common.NetIPR\n" +
 	"dnsServers\x12W\n" +
 	"\fconfig_layer\x18\x02 \x01(\x0e24.talos.resource.definitions.enums.NetworkConfigLayerR\vconfigLayer\x12%\n" +
-	"\x0esearch_domains\x18\x03 \x03(\tR\rsearchDomains\"k\n" +
+	"\x0esearch_domains\x18\x03 \x03(\tR\rsearchDomains\x12M\n" +
+	"\aoptions\x18\x04 \x01(\v23.talos.resource.definitions.network.ResolverOptionsR\aoptions\"\xba\x01\n" +
 	"\x12ResolverStatusSpec\x12.\n" +
 	"\vdns_servers\x18\x01 \x03(\v2\r.common.NetIPR\n" +
 	"dnsServers\x12%\n" +
-	"\x0esearch_domains\x18\x02 \x03(\tR\rsearchDomains\"\xde\x05\n" +
+	"\x0esearch_domains\x18\x02 \x03(\tR\rsearchDomains\x12M\n" +
+	"\aoptions\x18\x03 \x01(\v23.talos.resource.definitions.network.ResolverOptionsR\aoptions\"\xba\x03\n" +
+	"\x11RouteRuleSpecSpec\x12J\n" +
+	"\x06family\x18\x01 \x01(\x0e22.talos.resource.definitions.enums.NethelpersFamilyR\x06family\x12+\n" +
+	"\x06source\x18\x02 \x01(\v2\x13.common.NetIPPrefixR\x06source\x125\n" +
+	"\vdestination\x18\x03 \x01(\v2\x13.common.NetIPPrefixR\vdestination\x12N\n" +
+	"\x05table\x18\x04 \x01(\x0e28.talos.resource.definitions.enums.NethelpersRoutingTableR\x05table\x12\x1a\n" +
+	"\bpriority\x18\x05 \x01(\rR\bpriority\x12\x17\n" +
+	"\afw_mark\x18\x06 \x01(\rR\x06fwMark\x12\x17\n" +
+	"\afw_mask\x18\a \x01(\rR\x06fwMask\x12W\n" +
+	"\fconfig_layer\x18\b \x01(\x0e24.talos.resource.definitions.enums.NetworkConfigLayerR\vconfigLayer\"\x80\x04\n" +
+	"\x13RouteRuleStatusSpec\x12J\n" +
+	"\x06family\x18\x01 \x01(\x0e22.talos.resource.definitions.enums.NethelpersFamilyR\x06family\x12+\n" +
+	"\x06source\x18\x02 \x01(\v2\x13.common.NetIPPrefixR\x06source\x125\n" +
+	"\vdestination\x18\x03 \x01(\v2\x13.common.NetIPPrefixR\vdestination\x12N\n" +
+	"\x05table\x18\x04 \x01(\x0e28.talos.resource.definitions.enums.NethelpersRoutingTableR\x05table\x12\x1a\n" +
+	"\bpriority\x18\x05 \x01(\rR\bpriority\x12\x17\n" +
+	"\afw_mark\x18\x06 \x01(\rR\x06fwMark\x12\x17\n" +
+	"\afw_mask\x18\a \x01(\rR\x06fwMask\x12 \n" +
+	"\fin_link_name\x18\b \x01(\tR\n" +
+	"inLinkName\x12\"\n" +
+	"\rout_link_name\x18\t \x01(\tR\voutLinkName\x12U\n" +
+	"\bprotocol\x18\n" +
+	" \x01(\x0e29.talos.resource.definitions.enums.NethelpersRouteProtocolR\bprotocol\"\xf6\x05\n" +
 	"\rRouteSpecSpec\x12J\n" +
 	"\x06family\x18\x01 \x01(\x0e22.talos.resource.definitions.enums.NethelpersFamilyR\x06family\x125\n" +
 	"\vdestination\x18\x02 \x01(\v2\x13.common.NetIPPrefixR\vdestination\x12%\n" +
@@ -4861,7 +7267,8 @@ const file_resource_definitions_network_network_proto_rawDesc = "" +
 	" \x01(\rR\x05flags\x12U\n" +
 	"\bprotocol\x18\v \x01(\x0e29.talos.resource.definitions.enums.NethelpersRouteProtocolR\bprotocol\x12W\n" +
 	"\fconfig_layer\x18\f \x01(\x0e24.talos.resource.definitions.enums.NetworkConfigLayerR\vconfigLayer\x12\x10\n" +
-	"\x03mtu\x18\r \x01(\rR\x03mtu\"\xad\x05\n" +
+	"\x03mtu\x18\r \x01(\rR\x03mtu\x12\x16\n" +
+	"\x06origin\x18\x0e \x01(\tR\x06origin\"\xc5\x05\n" +
 	"\x0fRouteStatusSpec\x12J\n" +
 	"\x06family\x18\x01 \x01(\x0e22.talos.resource.definitions.enums.NethelpersFamilyR\x06family\x125\n" +
 	"\vdestination\x18\x02 \x01(\v2\x13.common.NetIPPrefixR\vdestination\x12%\n" +
@@ -4876,22 +7283,82 @@ const file_resource_definitions_network_network_proto_rawDesc = "" +
 	" \x01(\x0e25.talos.resource.definitions.enums.NethelpersRouteTypeR\x04type\x12\x14\n" +
 	"\x05flags\x18\v \x01(\rR\x05flags\x12U\n" +
 	"\bprotocol\x18\f \x01(\x0e29.talos.resource.definitions.enums.NethelpersRouteProtocolR\bprotocol\x12\x10\n" +
-	"\x03mtu\x18\r \x01(\rR\x03mtu\"#\n" +
+	"\x03mtu\x18\r \x01(\rR\x03mtu\x12\x16\n" +
+	"\x06origin\x18\x0e \x01(\tR\x06origin\"\x8d\x03\n" +
+	"\x10RouterStatusSpec\x12\x1b\n" +
+	"\tlink_name\x18\x01 \x01(\tR\blinkName\x12'\n" +
+	"\aaddress\x18\x02 \x01(\v2\r.common.NetIPR\aaddress\x12\x1e\n" +
+	"\n" +
+	"preference\x18\x03 \x01(\tR\n" +
+	"preference\x125\n" +
+	"\blifetime\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\blifetime\x124\n" +
+	"\aexpires\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aexpires\x12+\n" +
+	"\x06routes\x18\x06 \x03(\v2\x13.common.NetIPPrefixR\x06routes\x12.\n" +
+	"\vdns_servers\x18\a \x03(\v2\r.common.NetIPR\n" +
+	"dnsServers\x12I\n" +
+	"\x12last_advertisement\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x11lastAdvertisement\"m\n" +
+	"\rSRIOVSpecSpec\x12\x18\n" +
+	"\bnum_v_fs\x18\x01 \x01(\rR\x06numVFs\x12B\n" +
+	"\x04v_fs\x18\x02 \x03(\v2/.talos.resource.definitions.network.SRIOVVFSpecR\x03vFs\"\xf3\x01\n" +
+	"\x0fSRIOVStatusSpec\x12\x1b\n" +
+	"\tlink_name\x18\x01 \x01(\tR\blinkName\x12\x19\n" +
+	"\bbus_path\x18\x02 \x01(\tR\abusPath\x12\x1c\n" +
+	"\n" +
+	"total_v_fs\x18\x03 \x01(\rR\btotalVFs\x12\x18\n" +
+	"\bnum_v_fs\x18\x04 \x01(\rR\x06numVFs\x12\x14\n" +
+	"\x05ready\x18\x05 \x01(\bR\x05ready\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\x12D\n" +
+	"\x04v_fs\x18\a \x03(\v21.talos.resource.definitions.network.SRIOVVFStatusR\x03vFs\"\x93\x01\n" +
+	"\vSRIOVVFSpec\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\rR\x05index\x12#\n" +
+	"\rhardware_addr\x18\x02 \x01(\fR\fhardwareAddr\x12\x12\n" +
+	"\x04vlan\x18\x03 \x01(\rR\x04vlan\x12\x14\n" +
+	"\x05trust\x18\x04 \x01(\bR\x05trust\x12\x1f\n" +
+	"\vspoof_check\x18\x05 \x01(\bR\n" +
+	"spoofCheck\"\xeb\x01\n" +
+	"\rSRIOVVFStatus\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\rR\x05index\x12\x1f\n" +
+	"\vpci_address\x18\x02 \x01(\tR\n" +
+	"pciAddress\x12\x1b\n" +
+	"\tlink_name\x18\x03 \x01(\tR\blinkName\x12\x16\n" +
+	"\x06driver\x18\x04 \x01(\tR\x06driver\x12#\n" +
+	"\rhardware_addr\x18\x05 \x01(\fR\fhardwareAddr\x12\x12\n" +
+	"\x04vlan\x18\x06 \x01(\rR\x04vlan\x12\x14\n" +
+	"\x05trust\x18\a \x01(\bR\x05trust\x12\x1f\n" +
+	"\vspoof_check\x18\b \x01(\bR\n" +
+	"spoofCheck\"#\n" +
 	"\aSTPSpec\x12\x18\n" +
-	"\aenabled\x18\x01 \x01(\bR\aenabled\"\xaf\x01\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\"\xe5\x02\n" +
+	"\x1aStaticFallbackOperatorSpec\x12Q\n" +
+	"\taddresses\x18\x01 \x03(\v23.talos.resource.definitions.network.AddressSpecSpecR\taddresses\x12I\n" +
+	"\x06routes\x18\x02 \x03(\v21.talos.resource.definitions.network.RouteSpecSpecR\x06routes\x12'\n" +
+	"\agateway\x18\x03 \x01(\v2\r.common.NetIPR\agateway\x12>\n" +
+	"\rprobe_timeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\fprobeTimeout\x12@\n" +
+	"\x0eprobe_interval\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\rprobeInterval\"\xa1\x02\n" +
+	"\x18StaticFallbackStatusSpec\x12\x1b\n" +
+	"\tlink_name\x18\x01 \x01(\tR\blinkName\x12\x12\n" +
+	"\x04mode\x18\x02 \x01(\tR\x04mode\x12'\n" +
+	"\agateway\x18\x03 \x01(\v2\r.common.NetIPR\agateway\x12+\n" +
+	"\x11gateway_reachable\x18\x04 \x01(\bR\x10gatewayReachable\x129\n" +
+	"\n" +
+	"last_probe\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tlastProbe\x12C\n" +
+	"\x0flast_transition\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x0elastTransition\"\xd0\x01\n" +
 	"\n" +
 	"StatusSpec\x12#\n" +
 	"\raddress_ready\x18\x01 \x01(\bR\faddressReady\x12-\n" +
 	"\x12connectivity_ready\x18\x02 \x01(\bR\x11connectivityReady\x12%\n" +
 	"\x0ehostname_ready\x18\x03 \x01(\bR\rhostnameReady\x12&\n" +
-	"\x0fetc_files_ready\x18\x04 \x01(\bR\retcFilesReady\"_\n" +
+	"\x0fetc_files_ready\x18\x04 \x01(\bR\retcFilesReady\x12\x1f\n" +
+	"\vsriov_ready\x18\x05 \x01(\bR\n" +
+	"sriovReady\"_\n" +
 	"\fTCPProbeSpec\x12\x1a\n" +
 	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x123\n" +
-	"\atimeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\x8e\x01\n" +
+	"\atimeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\xba\x01\n" +
 	"\x12TimeServerSpecSpec\x12\x1f\n" +
 	"\vntp_servers\x18\x01 \x03(\tR\n" +
 	"ntpServers\x12W\n" +
-	"\fconfig_layer\x18\x02 \x01(\x0e24.talos.resource.definitions.enums.NetworkConfigLayerR\vconfigLayer\"7\n" +
+	"\fconfig_layer\x18\x02 \x01(\x0e24.talos.resource.definitions.enums.NetworkConfigLayerR\vconfigLayer\x12*\n" +
+	"\x11dhcp_servers_mode\x18\x03 \x01(\tR\x0fdhcpServersMode\"7\n" +
 	"\x14TimeServerStatusSpec\x12\x1f\n" +
 	"\vntp_servers\x18\x01 \x03(\tR\n" +
 	"ntpServers\"n\n" +
@@ -4904,15 +7371,37 @@ const file_resource_definitions_network_network_proto_rawDesc = "" +
 	"\tdevice_id\x18\x01 \x01(\x03R\bdeviceId\x12\x1d\n" +
 	"\n" +
 	"network_id\x18\x02 \x01(\x03R\tnetworkId\x12\x1b\n" +
-	"\tapi_token\x18\x03 \x01(\tR\bapiToken\"\x81\x02\n" +
+	"\tapi_token\x18\x03 \x01(\tR\bapiToken\"\xb7\x02\n" +
 	"\x0fVIPOperatorSpec\x12\x1d\n" +
 	"\x02ip\x18\x01 \x01(\v2\r.common.NetIPR\x02ip\x12%\n" +
 	"\x0egratuitous_arp\x18\x02 \x01(\bR\rgratuitousArp\x12\\\n" +
 	"\requinix_metal\x18\x03 \x01(\v27.talos.resource.definitions.network.VIPEquinixMetalSpecR\fequinixMetal\x12J\n" +
-	"\ah_cloud\x18\x04 \x01(\v21.talos.resource.definitions.network.VIPHCloudSpecR\x06hCloud\"r\n" +
+	"\ah_cloud\x18\x04 \x01(\v21.talos.resource.definitions.network.VIPHCloudSpecR\x06hCloud\x12\x1a\n" +
+	"\bpriority\x18\x05 \x01(\rR\bpriority\x12\x18\n" +
+	"\apreempt\x18\x06 \x01(\bR\apreempt\"\x99\x02\n" +
+	"\rVIPStatusSpec\x12\x1b\n" +
+	"\tlink_name\x18\x01 \x01(\tR\blinkName\x12\x1d\n" +
+	"\x02ip\x18\x02 \x01(\v2\r.common.NetIPR\x02ip\x12\x1a\n" +
+	"\bpriority\x18\x03 \x01(\rR\bpriority\x12\x18\n" +
+	"\apreempt\x18\x04 \x01(\bR\apreempt\x12\x14\n" +
+	"\x05owned\x18\x05 \x01(\bR\x05owned\x12\x14\n" +
+	"\x05owner\x18\x06 \x01(\tR\x05owner\x12%\n" +
+	"\x0eowner_priority\x18\a \x01(\rR\rownerPriority\x12C\n" +
+	"\x0flast_transition\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x0elastTransition\"r\n" +
 	"\bVLANSpec\x12\x10\n" +
 	"\x03vid\x18\x01 \x01(\aR\x03vid\x12T\n" +
-	"\bprotocol\x18\x02 \x01(\x0e28.talos.resource.definitions.enums.NethelpersVLANProtocolR\bprotocol\"\x84\x02\n" +
+	"\bprotocol\x18\x02 \x01(\x0e28.talos.resource.definitions.enums.NethelpersVLANProtocolR\bprotocol\"\x96\x03\n" +
+	"\x1bWireguardEndpointStatusSpec\x12\x1b\n" +
+	"\tlink_name\x18\x01 \x01(\tR\blinkName\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x02 \x01(\tR\tpublicKey\x12\x1a\n" +
+	"\bendpoint\x18\x03 \x01(\tR\bendpoint\x12>\n" +
+	"\x11resolved_endpoint\x18\x04 \x01(\v2\x11.common.NetIPPortR\x10resolvedEndpoint\x12<\n" +
+	"\x10current_endpoint\x18\x05 \x01(\v2\x11.common.NetIPPortR\x0fcurrentEndpoint\x12?\n" +
+	"\rlast_resolved\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\flastResolved\x12A\n" +
+	"\x0elast_handshake\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\rlastHandshake\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\b \x01(\tR\tlastError\"\x84\x02\n" +
 	"\rWireguardPeer\x12\x1d\n" +
 	"\n" +
 	"public_key\x18\x01 \x01(\tR\tpublicKey\x12#\n" +
@@ -4920,7 +7409,7 @@ const file_resource_definitions_network_network_proto_rawDesc = "" +
 	"\bendpoint\x18\x03 \x01(\tR\bendpoint\x12]\n" +
 	"\x1dpersistent_keepalive_interval\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x1bpersistentKeepaliveInterval\x124\n" +
 	"\vallowed_ips\x18\x05 \x03(\v2\x13.common.NetIPPrefixR\n" +
-	"allowedIps\"\xde\x01\n" +
+	"allowedIps\"\xb5\x02\n" +
 	"\rWireguardSpec\x12\x1f\n" +
 	"\vprivate_key\x18\x01 \x01(\tR\n" +
 	"privateKey\x12\x1d\n" +
@@ -4929,7 +7418,8 @@ const file_resource_definitions_network_network_proto_rawDesc = "" +
 	"\vlisten_port\x18\x03 \x01(\x03R\n" +
 	"listenPort\x12#\n" +
 	"\rfirewall_mark\x18\x04 \x01(\x03R\ffirewallMark\x12G\n" +
-	"\x05peers\x18\x05 \x03(\v21.talos.resource.definitions.network.WireguardPeerR\x05peersBx\n" +
+	"\x05peers\x18\x05 \x03(\v21.talos.resource.definitions.network.WireguardPeerR\x05peers\x12U\n" +
+	"\x19endpoint_resolve_interval\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\x17endpointResolveIntervalBx\n" +
 	"*dev.talos.api.resource.definitions.networkZJgithub.com/siderolabs/talos/pkg/machinery/api/resource/definitions/networkb\x06proto3"
 
 var (
@@ -4944,232 +7434,325 @@ func file_resource_definitions_network_network_proto_rawDescGZIP() []byte {
 	return file_resource_definitions_network_network_proto_rawDescData
 }
 
-var file_resource_definitions_network_network_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
+var file_resource_definitions_network_network_proto_msgTypes = make([]protoimpl.MessageInfo, 84)
 var file_resource_definitions_network_network_proto_goTypes = []any{
 	(*AddressSpecSpec)(nil),                    // 0: talos.resource.definitions.network.AddressSpecSpec
 	(*AddressStatusSpec)(nil),                  // 1: talos.resource.definitions.network.AddressStatusSpec
-	(*BondMasterSpec)(nil),                     // 2: talos.resource.definitions.network.BondMasterSpec
-	(*BondSlave)(nil),                          // 3: talos.resource.definitions.network.BondSlave
-	(*BridgeMasterSpec)(nil),                   // 4: talos.resource.definitions.network.BridgeMasterSpec
-	(*BridgeSlave)(nil),                        // 5: talos.resource.definitions.network.BridgeSlave
-	(*BridgeVLANSpec)(nil),                     // 6: talos.resource.definitions.network.BridgeVLANSpec
-	(*DHCP4OperatorSpec)(nil),                  // 7: talos.resource.definitions.network.DHCP4OperatorSpec
-	(*DHCP6OperatorSpec)(nil),                  // 8: talos.resource.definitions.network.DHCP6OperatorSpec
-	(*DNSResolveCacheSpec)(nil),                // 9: talos.resource.definitions.network.DNSResolveCacheSpec
-	(*EthernetChannelsSpec)(nil),               // 10: talos.resource.definitions.network.EthernetChannelsSpec
-	(*EthernetChannelsStatus)(nil),             // 11: talos.resource.definitions.network.EthernetChannelsStatus
-	(*EthernetFeatureStatus)(nil),              // 12: talos.resource.definitions.network.EthernetFeatureStatus
-	(*EthernetRingsSpec)(nil),                  // 13: talos.resource.definitions.network.EthernetRingsSpec
-	(*EthernetRingsStatus)(nil),                // 14: talos.resource.definitions.network.EthernetRingsStatus
-	(*EthernetSpecSpec)(nil),                   // 15: talos.resource.definitions.network.EthernetSpecSpec
-	(*EthernetStatusSpec)(nil),                 // 16: talos.resource.definitions.network.EthernetStatusSpec
-	(*HardwareAddrSpec)(nil),                   // 17: talos.resource.definitions.network.HardwareAddrSpec
-	(*HostDNSConfigSpec)(nil),                  // 18: talos.resource.definitions.network.HostDNSConfigSpec
-	(*HostnameSpecSpec)(nil),                   // 19: talos.resource.definitions.network.HostnameSpecSpec
-	(*HostnameStatusSpec)(nil),                 // 20: talos.resource.definitions.network.HostnameStatusSpec
-	(*LinkRefreshSpec)(nil),                    // 21: talos.resource.definitions.network.LinkRefreshSpec
-	(*LinkSpecSpec)(nil),                       // 22: talos.resource.definitions.network.LinkSpecSpec
-	(*LinkStatusSpec)(nil),                     // 23: talos.resource.definitions.network.LinkStatusSpec
-	(*NfTablesAddressMatch)(nil),               // 24: talos.resource.definitions.network.NfTablesAddressMatch
-	(*NfTablesChainSpec)(nil),                  // 25: talos.resource.definitions.network.NfTablesChainSpec
-	(*NfTablesClampMSS)(nil),                   // 26: talos.resource.definitions.network.NfTablesClampMSS
-	(*NfTablesConntrackStateMatch)(nil),        // 27: talos.resource.definitions.network.NfTablesConntrackStateMatch
-	(*NfTablesICMPTypeMatch)(nil),              // 28: talos.resource.definitions.network.NfTablesICMPTypeMatch
-	(*NfTablesIfNameMatch)(nil),                // 29: talos.resource.definitions.network.NfTablesIfNameMatch
-	(*NfTablesLayer4Match)(nil),                // 30: talos.resource.definitions.network.NfTablesLayer4Match
-	(*NfTablesLimitMatch)(nil),                 // 31: talos.resource.definitions.network.NfTablesLimitMatch
-	(*NfTablesMark)(nil),                       // 32: talos.resource.definitions.network.NfTablesMark
-	(*NfTablesPortMatch)(nil),                  // 33: talos.resource.definitions.network.NfTablesPortMatch
-	(*NfTablesRule)(nil),                       // 34: talos.resource.definitions.network.NfTablesRule
-	(*NodeAddressFilterSpec)(nil),              // 35: talos.resource.definitions.network.NodeAddressFilterSpec
-	(*NodeAddressSortAlgorithmSpec)(nil),       // 36: talos.resource.definitions.network.NodeAddressSortAlgorithmSpec
-	(*NodeAddressSpec)(nil),                    // 37: talos.resource.definitions.network.NodeAddressSpec
-	(*OperatorSpecSpec)(nil),                   // 38: talos.resource.definitions.network.OperatorSpecSpec
-	(*PlatformConfigSpec)(nil),                 // 39: talos.resource.definitions.network.PlatformConfigSpec
-	(*PortRange)(nil),                          // 40: talos.resource.definitions.network.PortRange
-	(*ProbeSpecSpec)(nil),                      // 41: talos.resource.definitions.network.ProbeSpecSpec
-	(*ProbeStatusSpec)(nil),                    // 42: talos.resource.definitions.network.ProbeStatusSpec
-	(*ResolverSpecSpec)(nil),                   // 43: talos.resource.definitions.network.ResolverSpecSpec
-	(*ResolverStatusSpec)(nil),                 // 44: talos.resource.definitions.network.ResolverStatusSpec
-	(*RouteSpecSpec)(nil),                      // 45: talos.resource.definitions.network.RouteSpecSpec
-	(*RouteStatusSpec)(nil),                    // 46: talos.resource.definitions.network.RouteStatusSpec
-	(*STPSpec)(nil),                            // 47: talos.resource.definitions.network.STPSpec
-	(*StatusSpec)(nil),                         // 48: talos.resource.definitions.network.StatusSpec
-	(*TCPProbeSpec)(nil),                       // 49: talos.resource.definitions.network.TCPProbeSpec
-	(*TimeServerSpecSpec)(nil),                 // 50: talos.resource.definitions.network.TimeServerSpecSpec
-	(*TimeServerStatusSpec)(nil),               // 51: talos.resource.definitions.network.TimeServerStatusSpec
-	(*VIPEquinixMetalSpec)(nil),                // 52: talos.resource.definitions.network.VIPEquinixMetalSpec
-	(*VIPHCloudSpec)(nil),                      // 53: talos.resource.definitions.network.VIPHCloudSpec
-	(*VIPOperatorSpec)(nil),                    // 54: talos.resource.definitions.network.VIPOperatorSpec
-	(*VLANSpec)(nil),                           // 55: talos.resource.definitions.network.VLANSpec
-	(*WireguardPeer)(nil),                      // 56: talos.resource.definitions.network.WireguardPeer
-	(*WireguardSpec)(nil),                      // 57: talos.resource.definitions.network.WireguardSpec
-	nil,                                        // 58: talos.resource.definitions.network.EthernetSpecSpec.FeaturesEntry
-	(*common.NetIPPrefix)(nil),                 // 59: common.NetIPPrefix
-	(enums.NethelpersFamily)(0),                // 60: talos.resource.definitions.enums.NethelpersFamily
-	(enums.NethelpersScope)(0),                 // 61: talos.resource.definitions.enums.NethelpersScope
-	(enums.NetworkConfigLayer)(0),              // 62: talos.resource.definitions.enums.NetworkConfigLayer
-	(*common.NetIP)(nil),                       // 63: common.NetIP
-	(enums.NethelpersBondMode)(0),              // 64: talos.resource.definitions.enums.NethelpersBondMode
-	(enums.NethelpersBondXmitHashPolicy)(0),    // 65: talos.resource.definitions.enums.NethelpersBondXmitHashPolicy
-	(enums.NethelpersLACPRate)(0),              // 66: talos.resource.definitions.enums.NethelpersLACPRate
-	(enums.NethelpersARPValidate)(0),           // 67: talos.resource.definitions.enums.NethelpersARPValidate
-	(enums.NethelpersARPAllTargets)(0),         // 68: talos.resource.definitions.enums.NethelpersARPAllTargets
-	(enums.NethelpersPrimaryReselect)(0),       // 69: talos.resource.definitions.enums.NethelpersPrimaryReselect
-	(enums.NethelpersFailOverMAC)(0),           // 70: talos.resource.definitions.enums.NethelpersFailOverMAC
-	(enums.NethelpersADSelect)(0),              // 71: talos.resource.definitions.enums.NethelpersADSelect
-	(enums.NethelpersPort)(0),                  // 72: talos.resource.definitions.enums.NethelpersPort
-	(enums.NethelpersDuplex)(0),                // 73: talos.resource.definitions.enums.NethelpersDuplex
-	(*common.NetIPPort)(nil),                   // 74: common.NetIPPort
-	(enums.NethelpersLinkType)(0),              // 75: talos.resource.definitions.enums.NethelpersLinkType
-	(enums.NethelpersOperationalState)(0),      // 76: talos.resource.definitions.enums.NethelpersOperationalState
-	(enums.NethelpersNfTablesChainHook)(0),     // 77: talos.resource.definitions.enums.NethelpersNfTablesChainHook
-	(enums.NethelpersNfTablesChainPriority)(0), // 78: talos.resource.definitions.enums.NethelpersNfTablesChainPriority
-	(enums.NethelpersNfTablesVerdict)(0),       // 79: talos.resource.definitions.enums.NethelpersNfTablesVerdict
-	(enums.NethelpersConntrackState)(0),        // 80: talos.resource.definitions.enums.NethelpersConntrackState
-	(enums.NethelpersICMPType)(0),              // 81: talos.resource.definitions.enums.NethelpersICMPType
-	(enums.NethelpersMatchOperator)(0),         // 82: talos.resource.definitions.enums.NethelpersMatchOperator
-	(enums.NethelpersProtocol)(0),              // 83: talos.resource.definitions.enums.NethelpersProtocol
-	(enums.NethelpersAddressSortAlgorithm)(0),  // 84: talos.resource.definitions.enums.NethelpersAddressSortAlgorithm
-	(enums.NetworkOperator)(0),                 // 85: talos.resource.definitions.enums.NetworkOperator
-	(*runtime.PlatformMetadataSpec)(nil),       // 86: talos.resource.definitions.runtime.PlatformMetadataSpec
-	(*durationpb.Duration)(nil),                // 87: google.protobuf.Duration
-	(enums.NethelpersRoutingTable)(0),          // 88: talos.resource.definitions.enums.NethelpersRoutingTable
-	(enums.NethelpersRouteType)(0),             // 89: talos.resource.definitions.enums.NethelpersRouteType
-	(enums.NethelpersRouteProtocol)(0),         // 90: talos.resource.definitions.enums.NethelpersRouteProtocol
-	(enums.NethelpersVLANProtocol)(0),          // 91: talos.resource.definitions.enums.NethelpersVLANProtocol
+	(*BondARPTargetStatus)(nil),                // 2: talos.resource.definitions.network.BondARPTargetStatus
+	(*BondMasterSpec)(nil),                     // 3: talos.resource.definitions.network.BondMasterSpec
+	(*BondSlave)(nil),                          // 4: talos.resource.definitions.network.BondSlave
+	(*BondSlaveStatus)(nil),                    // 5: talos.resource.definitions.network.BondSlaveStatus
+	(*BondStatusSpec)(nil),                     // 6: talos.resource.definitions.network.BondStatusSpec
+	(*BridgeMasterSpec)(nil),                   // 7: talos.resource.definitions.network.BridgeMasterSpec
+	(*BridgePortStatus)(nil),                   // 8: talos.resource.definitions.network.BridgePortStatus
+	(*BridgeSlave)(nil),                        // 9: talos.resource.definitions.network.BridgeSlave
+	(*BridgeStatusSpec)(nil),                   // 10: talos.resource.definitions.network.BridgeStatusSpec
+	(*BridgeVLANEntry)(nil),                    // 11: talos.resource.definitions.network.BridgeVLANEntry
+	(*BridgeVLANSpec)(nil),                     // 12: talos.resource.definitions.network.BridgeVLANSpec
+	(*DHCP4LeaseSpec)(nil),                     // 13: talos.resource.definitions.network.DHCP4LeaseSpec
+	(*DHCP4OperatorSpec)(nil),                  // 14: talos.resource.definitions.network.DHCP4OperatorSpec
+	(*DHCP6OperatorSpec)(nil),                  // 15: talos.resource.definitions.network.DHCP6OperatorSpec
+	(*DNSResolveCacheSpec)(nil),                // 16: talos.resource.definitions.network.DNSResolveCacheSpec
+	(*DefaultRouteStatusSpec)(nil),             // 17: talos.resource.definitions.network.DefaultRouteStatusSpec
+	(*EthernetChannelsSpec)(nil),               // 18: talos.resource.definitions.network.EthernetChannelsSpec
+	(*EthernetChannelsStatus)(nil),             // 19: talos.resource.definitions.network.EthernetChannelsStatus
+	(*EthernetConfigError)(nil),                // 20: talos.resource.definitions.network.EthernetConfigError
+	(*EthernetFeatureStatus)(nil),              // 21: talos.resource.definitions.network.EthernetFeatureStatus
+	(*EthernetRingsSpec)(nil),                  // 22: talos.resource.definitions.network.EthernetRingsSpec
+	(*EthernetRingsStatus)(nil),                // 23: talos.resource.definitions.network.EthernetRingsStatus
+	(*EthernetSpecSpec)(nil),                   // 24: talos.resource.definitions.network.EthernetSpecSpec
+	(*EthernetStatusSpec)(nil),                 // 25: talos.resource.definitions.network.EthernetStatusSpec
+	(*HTTPProbeSpec)(nil),                      // 26: talos.resource.definitions.network.HTTPProbeSpec
+	(*HardwareAddrSpec)(nil),                   // 27: talos.resource.definitions.network.HardwareAddrSpec
+	(*HostDNSConfigSpec)(nil),                  // 28: talos.resource.definitions.network.HostDNSConfigSpec
+	(*HostnameSpecSpec)(nil),                   // 29: talos.resource.definitions.network.HostnameSpecSpec
+	(*HostnameStatusSpec)(nil),                 // 30: talos.resource.definitions.network.HostnameStatusSpec
+	(*ICMPProbeSpec)(nil),                      // 31: talos.resource.definitions.network.ICMPProbeSpec
+	(*LLDPNeighborStatusSpec)(nil),             // 32: talos.resource.definitions.network.LLDPNeighborStatusSpec
+	(*LinkRefreshSpec)(nil),                    // 33: talos.resource.definitions.network.LinkRefreshSpec
+	(*LinkSpecSpec)(nil),                       // 34: talos.resource.definitions.network.LinkSpecSpec
+	(*LinkStatusSpec)(nil),                     // 35: talos.resource.definitions.network.LinkStatusSpec
+	(*NfTablesAddressMatch)(nil),               // 36: talos.resource.definitions.network.NfTablesAddressMatch
+	(*NfTablesChainSpec)(nil),                  // 37: talos.resource.definitions.network.NfTablesChainSpec
+	(*NfTablesClampMSS)(nil),                   // 38: talos.resource.definitions.network.NfTablesClampMSS
+	(*NfTablesConntrackStateMatch)(nil),        // 39: talos.resource.definitions.network.NfTablesConntrackStateMatch
+	(*NfTablesICMPTypeMatch)(nil),              // 40: talos.resource.definitions.network.NfTablesICMPTypeMatch
+	(*NfTablesIfNameMatch)(nil),                // 41: talos.resource.definitions.network.NfTablesIfNameMatch
+	(*NfTablesLayer4Match)(nil),                // 42: talos.resource.definitions.network.NfTablesLayer4Match
+	(*NfTablesLimitMatch)(nil),                 // 43: talos.resource.definitions.network.NfTablesLimitMatch
+	(*NfTablesLog)(nil),                        // 44: talos.resource.definitions.network.NfTablesLog
+	(*NfTablesMark)(nil),                       // 45: talos.resource.definitions.network.NfTablesMark
+	(*NfTablesPortMatch)(nil),                  // 46: talos.resource.definitions.network.NfTablesPortMatch
+	(*NfTablesRule)(nil),                       // 47: talos.resource.definitions.network.NfTablesRule
+	(*NodeAddressFilterSpec)(nil),              // 48: talos.resource.definitions.network.NodeAddressFilterSpec
+	(*NodeAddressSortAlgorithmSpec)(nil),       // 49: talos.resource.definitions.network.NodeAddressSortAlgorithmSpec
+	(*NodeAddressSpec)(nil),                    // 50: talos.resource.definitions.network.NodeAddressSpec
+	(*OperatorSpecSpec)(nil),                   // 51: talos.resource.definitions.network.OperatorSpecSpec
+	(*PlatformConfigSpec)(nil),                 // 52: talos.resource.definitions.network.PlatformConfigSpec
+	(*PortRange)(nil),                          // 53: talos.resource.definitions.network.PortRange
+	(*ProbeSpecSpec)(nil),                      // 54: talos.resource.definitions.network.ProbeSpecSpec
+	(*ProbeStatusSpec)(nil),                    // 55: talos.resource.definitions.network.ProbeStatusSpec
+	(*ResolverOptions)(nil),                    // 56: talos.resource.definitions.network.ResolverOptions
+	(*ResolverSpecSpec)(nil),                   // 57: talos.resource.definitions.network.ResolverSpecSpec
+	(*ResolverStatusSpec)(nil),                 // 58: talos.resource.definitions.network.ResolverStatusSpec
+	(*RouteRuleSpecSpec)(nil),                  // 59: talos.resource.definitions.network.RouteRuleSpecSpec
+	(*RouteRuleStatusSpec)(nil),                // 60: talos.resource.definitions.network.RouteRuleStatusSpec
+	(*RouteSpecSpec)(nil),                      // 61: talos.resource.definitions.network.RouteSpecSpec
+	(*RouteStatusSpec)(nil),                    // 62: talos.resource.definitions.network.RouteStatusSpec
+	(*RouterStatusSpec)(nil),                   // 63: talos.resource.definitions.network.RouterStatusSpec
+	(*SRIOVSpecSpec)(nil),                      // 64: talos.resource.definitions.network.SRIOVSpecSpec
+	(*SRIOVStatusSpec)(nil),                    // 65: talos.resource.definitions.network.SRIOVStatusSpec
+	(*SRIOVVFSpec)(nil),                        // 66: talos.resource.definitions.network.SRIOVVFSpec
+	(*SRIOVVFStatus)(nil),                      // 67: talos.resource.definitions.network.SRIOVVFStatus
+	(*STPSpec)(nil),                            // 68: talos.resource.definitions.network.STPSpec
+	(*StaticFallbackOperatorSpec)(nil),         // 69: talos.resource.definitions.network.StaticFallbackOperatorSpec
+	(*StaticFallbackStatusSpec)(nil),           // 70: talos.resource.definitions.network.StaticFallbackStatusSpec
+	(*StatusSpec)(nil),                         // 71: talos.resource.definitions.network.StatusSpec
+	(*TCPProbeSpec)(nil),                       // 72: talos.resource.definitions.network.TCPProbeSpec
+	(*TimeServerSpecSpec)(nil),                 // 73: talos.resource.definitions.network.TimeServerSpecSpec
+	(*TimeServerStatusSpec)(nil),               // 74: talos.resource.definitions.network.TimeServerStatusSpec
+	(*VIPEquinixMetalSpec)(nil),                // 75: talos.resource.definitions.network.VIPEquinixMetalSpec
+	(*VIPHCloudSpec)(nil),                      // 76: talos.resource.definitions.network.VIPHCloudSpec
+	(*VIPOperatorSpec)(nil),                    // 77: talos.resource.definitions.network.VIPOperatorSpec
+	(*VIPStatusSpec)(nil),                      // 78: talos.resource.definitions.network.VIPStatusSpec
+	(*VLANSpec)(nil),                           // 79: talos.resource.definitions.network.VLANSpec
+	(*WireguardEndpointStatusSpec)(nil),        // 80: talos.resource.definitions.network.WireguardEndpointStatusSpec
+	(*WireguardPeer)(nil),                      // 81: talos.resource.definitions.network.WireguardPeer
+	(*WireguardSpec)(nil),                      // 82: talos.resource.definitions.network.WireguardSpec
+	nil,                                        // 83: talos.resource.definitions.network.EthernetSpecSpec.FeaturesEntry
+	(*common.NetIPPrefix)(nil),                 // 84: common.NetIPPrefix
+	(enums.NethelpersFamily)(0),                // 85: talos.resource.definitions.enums.NethelpersFamily
+	(enums.NethelpersScope)(0),                 // 86: talos.resource.definitions.enums.NethelpersScope
+	(enums.NetworkConfigLayer)(0),              // 87: talos.resource.definitions.enums.NetworkConfigLayer
+	(*common.NetIP)(nil),                       // 88: common.NetIP
+	(enums.NethelpersBondMode)(0),              // 89: talos.resource.definitions.enums.NethelpersBondMode
+	(enums.NethelpersBondXmitHashPolicy)(0),    // 90: talos.resource.definitions.enums.NethelpersBondXmitHashPolicy
+	(enums.NethelpersLACPRate)(0),              // 91: talos.resource.definitions.enums.NethelpersLACPRate
+	(enums.NethelpersARPValidate)(0),           // 92: talos.resource.definitions.enums.NethelpersARPValidate
+	(enums.NethelpersARPAllTargets)(0),         // 93: talos.resource.definitions.enums.NethelpersARPAllTargets
+	(enums.NethelpersPrimaryReselect)(0),       // 94: talos.resource.definitions.enums.NethelpersPrimaryReselect
+	(enums.NethelpersFailOverMAC)(0),           // 95: talos.resource.definitions.enums.NethelpersFailOverMAC
+	(enums.NethelpersADSelect)(0),              // 96: talos.resource.definitions.enums.NethelpersADSelect
+	(*durationpb.Duration)(nil),                // 97: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),              // 98: google.protobuf.Timestamp
+	(enums.NethelpersPort)(0),                  // 99: talos.resource.definitions.enums.NethelpersPort
+	(enums.NethelpersDuplex)(0),                // 100: talos.resource.definitions.enums.NethelpersDuplex
+	(*common.NetIPPort)(nil),                   // 101: common.NetIPPort
+	(enums.NethelpersLinkType)(0),              // 102: talos.resource.definitions.enums.NethelpersLinkType
+	(enums.NethelpersOperationalState)(0),      // 103: talos.resource.definitions.enums.NethelpersOperationalState
+	(enums.NethelpersNfTablesChainHook)(0),     // 104: talos.resource.definitions.enums.NethelpersNfTablesChainHook
+	(enums.NethelpersNfTablesChainPriority)(0), // 105: talos.resource.definitions.enums.NethelpersNfTablesChainPriority
+	(enums.NethelpersNfTablesVerdict)(0),       // 106: talos.resource.definitions.enums.NethelpersNfTablesVerdict
+	(enums.NethelpersConntrackState)(0),        // 107: talos.resource.definitions.enums.NethelpersConntrackState
+	(enums.NethelpersICMPType)(0),              // 108: talos.resource.definitions.enums.NethelpersICMPType
+	(enums.NethelpersMatchOperator)(0),         // 109: talos.resource.definitions.enums.NethelpersMatchOperator
+	(enums.NethelpersProtocol)(0),              // 110: talos.resource.definitions.enums.NethelpersProtocol
+	(enums.NethelpersAddressSortAlgorithm)(0),  // 111: talos.resource.definitions.enums.NethelpersAddressSortAlgorithm
+	(enums.NetworkOperator)(0),                 // 112: talos.resource.definitions.enums.NetworkOperator
+	(*runtime.PlatformMetadataSpec)(nil),       // 113: talos.resource.definitions.runtime.PlatformMetadataSpec
+	(enums.NethelpersRoutingTable)(0),          // 114: talos.resource.definitions.enums.NethelpersRoutingTable
+	(enums.NethelpersRouteProtocol)(0),         // 115: talos.resource.definitions.enums.NethelpersRouteProtocol
+	(enums.NethelpersRouteType)(0),             // 116: talos.resource.definitions.enums.NethelpersRouteType
+	(enums.NethelpersVLANProtocol)(0),          // 117: talos.resource.definitions.enums.NethelpersVLANProtocol
 }
 var file_resource_definitions_network_network_proto_depIdxs = []int32{
-	59,  // 0: talos.resource.definitions.network.AddressSpecSpec.address:type_name -> common.NetIPPrefix
-	60,  // 1: talos.resource.definitions.network.AddressSpecSpec.family:type_name -> talos.resource.definitions.enums.NethelpersFamily
-	61,  // 2: talos.resource.definitions.network.AddressSpecSpec.scope:type_name -> talos.resource.definitions.enums.NethelpersScope
-	62,  // 3: talos.resource.definitions.network.AddressSpecSpec.config_layer:type_name -> talos.resource.definitions.enums.NetworkConfigLayer
-	59,  // 4: talos.resource.definitions.network.AddressStatusSpec.address:type_name -> common.NetIPPrefix
-	63,  // 5: talos.resource.definitions.network.AddressStatusSpec.local:type_name -> common.NetIP
-	63,  // 6: talos.resource.definitions.network.AddressStatusSpec.broadcast:type_name -> common.NetIP
-	63,  // 7: talos.resource.definitions.network.AddressStatusSpec.anycast:type_name -> common.NetIP
-	63,  // 8: talos.resource.definitions.network.AddressStatusSpec.multicast:type_name -> common.NetIP
-	60,  // 9: talos.resource.definitions.network.AddressStatusSpec.family:type_name -> talos.resource.definitions.enums.NethelpersFamily
-	61,  // 10: talos.resource.definitions.network.AddressStatusSpec.scope:type_name -> talos.resource.definitions.enums.NethelpersScope
-	64,  // 11: talos.resource.definitions.network.BondMasterSpec.mode:type_name -> talos.resource.definitions.enums.NethelpersBondMode
-	65,  // 12: talos.resource.definitions.network.BondMasterSpec.hash_policy:type_name -> talos.resource.definitions.enums.NethelpersBondXmitHashPolicy
-	66,  // 13: talos.resource.definitions.network.BondMasterSpec.lacp_rate:type_name -> talos.resource.definitions.enums.NethelpersLACPRate
-	67,  // 14: talos.resource.definitions.network.BondMasterSpec.arp_validate:type_name -> talos.resource.definitions.enums.NethelpersARPValidate
-	68,  // 15: talos.resource.definitions.network.BondMasterSpec.arp_all_targets:type_name -> talos.resource.definitions.enums.NethelpersARPAllTargets
-	69,  // 16: talos.resource.definitions.network.BondMasterSpec.primary_reselect:type_name -> talos.resource.definitions.enums.NethelpersPrimaryReselect
-	70,  // 17: talos.resource.definitions.network.BondMasterSpec.fail_over_mac:type_name -> talos.resource.definitions.enums.NethelpersFailOverMAC
-	71,  // 18: talos.resource.definitions.network.BondMasterSpec.ad_select:type_name -> talos.resource.definitions.enums.NethelpersADSelect
-	47,  // 19: talos.resource.definitions.network.BridgeMasterSpec.stp:type_name -> talos.resource.definitions.network.STPSpec
-	6,   // 20: talos.resource.definitions.network.BridgeMasterSpec.vlan:type_name -> talos.resource.definitions.network.BridgeVLANSpec
-	13,  // 21: talos.resource.definitions.network.EthernetSpecSpec.rings:type_name -> talos.resource.definitions.network.EthernetRingsSpec
-	58,  // 22: talos.resource.definitions.network.EthernetSpecSpec.features:type_name -> talos.resource.definitions.network.EthernetSpecSpec.FeaturesEntry
-	10,  // 23: talos.resource.definitions.network.EthernetSpecSpec.channels:type_name -> talos.resource.definitions.network.EthernetChannelsSpec
-	72,  // 24: talos.resource.definitions.network.EthernetStatusSpec.port:type_name -> talos.resource.definitions.enums.NethelpersPort
-	73,  // 25: talos.resource.definitions.network.EthernetStatusSpec.duplex:type_name -> talos.resource.definitions.enums.NethelpersDuplex
-	14,  // 26: talos.resource.definitions.network.EthernetStatusSpec.rings:type_name -> talos.resource.definitions.network.EthernetRingsStatus
-	12,  // 27: talos.resource.definitions.network.EthernetStatusSpec.features:type_name -> talos.resource.definitions.network.EthernetFeatureStatus
-	11,  // 28: talos.resource.definitions.network.EthernetStatusSpec.channels:type_name -> talos.resource.definitions.network.EthernetChannelsStatus
-	74,  // 29: talos.resource.definitions.network.HostDNSConfigSpec.listen_addresses:type_name -> common.NetIPPort
-	63,  // 30: talos.resource.definitions.network.HostDNSConfigSpec.service_host_dns_address:type_name -> common.NetIP
-	62,  // 31: talos.resource.definitions.network.HostnameSpecSpec.config_layer:type_name -> talos.resource.definitions.enums.NetworkConfigLayer
-	75,  // 32: talos.resource.definitions.network.LinkSpecSpec.type:type_name -> talos.resource.definitions.enums.NethelpersLinkType
-	3,   // 33: talos.resource.definitions.network.LinkSpecSpec.bond_slave:type_name -> talos.resource.definitions.network.BondSlave
-	5,   // 34: talos.resource.definitions.network.LinkSpecSpec.bridge_slave:type_name -> talos.resource.definitions.network.BridgeSlave
-	55,  // 35: talos.resource.definitions.network.LinkSpecSpec.vlan:type_name -> talos.resource.definitions.network.VLANSpec
-	2,   // 36: talos.resource.definitions.network.LinkSpecSpec.bond_master:type_name -> talos.resource.definitions.network.BondMasterSpec
-	4,   // 37: talos.resource.definitions.network.LinkSpecSpec.bridge_master:type_name -> talos.resource.definitions.network.BridgeMasterSpec
-	57,  // 38: talos.resource.definitions.network.LinkSpecSpec.wireguard:type_name -> talos.resource.definitions.network.WireguardSpec
-	62,  // 39: talos.resource.definitions.network.LinkSpecSpec.config_layer:type_name -> talos.resource.definitions.enums.NetworkConfigLayer
-	75,  // 40: talos.resource.definitions.network.LinkStatusSpec.type:type_name -> talos.resource.definitions.enums.NethelpersLinkType
-	76,  // 41: talos.resource.definitions.network.LinkStatusSpec.operational_state:type_name -> talos.resource.definitions.enums.NethelpersOperationalState
-	72,  // 42: talos.resource.definitions.network.LinkStatusSpec.port:type_name -> talos.resource.definitions.enums.NethelpersPort
-	73,  // 43: talos.resource.definitions.network.LinkStatusSpec.duplex:type_name -> talos.resource.definitions.enums.NethelpersDuplex
-	55,  // 44: talos.resource.definitions.network.LinkStatusSpec.vlan:type_name -> talos.resource.definitions.network.VLANSpec
-	4,   // 45: talos.resource.definitions.network.LinkStatusSpec.bridge_master:type_name -> talos.resource.definitions.network.BridgeMasterSpec
-	2,   // 46: talos.resource.definitions.network.LinkStatusSpec.bond_master:type_name -> talos.resource.definitions.network.BondMasterSpec
-	57,  // 47: talos.resource.definitions.network.LinkStatusSpec.wireguard:type_name -> talos.resource.definitions.network.WireguardSpec
-	59,  // 48: talos.resource.definitions.network.NfTablesAddressMatch.include_subnets:type_name -> common.NetIPPrefix
-	59,  // 49: talos.resource.definitions.network.NfTablesAddressMatch.exclude_subnets:type_name -> common.NetIPPrefix
-	77,  // 50: talos.resource.definitions.network.NfTablesChainSpec.hook:type_name -> talos.resource.definitions.enums.NethelpersNfTablesChainHook
-	78,  // 51: talos.resource.definitions.network.NfTablesChainSpec.priority:type_name -> talos.resource.definitions.enums.NethelpersNfTablesChainPriority
-	34,  // 52: talos.resource.definitions.network.NfTablesChainSpec.rules:type_name -> talos.resource.definitions.network.NfTablesRule
-	79,  // 53: talos.resource.definitions.network.NfTablesChainSpec.policy:type_name -> talos.resource.definitions.enums.NethelpersNfTablesVerdict
-	80,  // 54: talos.resource.definitions.network.NfTablesConntrackStateMatch.states:type_name -> talos.resource.definitions.enums.NethelpersConntrackState
-	81,  // 55: talos.resource.definitions.network.NfTablesICMPTypeMatch.types:type_name -> talos.resource.definitions.enums.NethelpersICMPType
-	82,  // 56: talos.resource.definitions.network.NfTablesIfNameMatch.operator:type_name -> talos.resource.definitions.enums.NethelpersMatchOperator
-	83,  // 57: talos.resource.definitions.network.NfTablesLayer4Match.protocol:type_name -> talos.resource.definitions.enums.NethelpersProtocol
-	33,  // 58: talos.resource.definitions.network.NfTablesLayer4Match.match_source_port:type_name -> talos.resource.definitions.network.NfTablesPortMatch
-	33,  // 59: talos.resource.definitions.network.NfTablesLayer4Match.match_destination_port:type_name -> talos.resource.definitions.network.NfTablesPortMatch
-	28,  // 60: talos.resource.definitions.network.NfTablesLayer4Match.match_icmp_type:type_name -> talos.resource.definitions.network.NfTablesICMPTypeMatch
-	40,  // 61: talos.resource.definitions.network.NfTablesPortMatch.ranges:type_name -> talos.resource.definitions.network.PortRange
-	29,  // 62: talos.resource.definitions.network.NfTablesRule.match_o_if_name:type_name -> talos.resource.definitions.network.NfTablesIfNameMatch
-	79,  // 63: talos.resource.definitions.network.NfTablesRule.verdict:type_name -> talos.resource.definitions.enums.NethelpersNfTablesVerdict
-	32,  // 64: talos.resource.definitions.network.NfTablesRule.match_mark:type_name -> talos.resource.definitions.network.NfTablesMark
-	32,  // 65: talos.resource.definitions.network.NfTablesRule.set_mark:type_name -> talos.resource.definitions.network.NfTablesMark
-	24,  // 66: talos.resource.definitions.network.NfTablesRule.match_source_address:type_name -> talos.resource.definitions.network.NfTablesAddressMatch
-	24,  // 67: talos.resource.definitions.network.NfTablesRule.match_destination_address:type_name -> talos.resource.definitions.network.NfTablesAddressMatch
-	30,  // 68: talos.resource.definitions.network.NfTablesRule.match_layer4:type_name -> talos.resource.definitions.network.NfTablesLayer4Match
-	29,  // 69: talos.resource.definitions.network.NfTablesRule.match_i_if_name:type_name -> talos.resource.definitions.network.NfTablesIfNameMatch
-	26,  // 70: talos.resource.definitions.network.NfTablesRule.clamp_mss:type_name -> talos.resource.definitions.network.NfTablesClampMSS
-	31,  // 71: talos.resource.definitions.network.NfTablesRule.match_limit:type_name -> talos.resource.definitions.network.NfTablesLimitMatch
-	27,  // 72: talos.resource.definitions.network.NfTablesRule.match_conntrack_state:type_name -> talos.resource.definitions.network.NfTablesConntrackStateMatch
-	59,  // 73: talos.resource.definitions.network.NodeAddressFilterSpec.include_subnets:type_name -> common.NetIPPrefix
-	59,  // 74: talos.resource.definitions.network.NodeAddressFilterSpec.exclude_subnets:type_name -> common.NetIPPrefix
-	84,  // 75: talos.resource.definitions.network.NodeAddressSortAlgorithmSpec.algorithm:type_name -> talos.resource.definitions.enums.NethelpersAddressSortAlgorithm
-	59,  // 76: talos.resource.definitions.network.NodeAddressSpec.addresses:type_name -> common.NetIPPrefix
-	84,  // 77: talos.resource.definitions.network.NodeAddressSpec.sort_algorithm:type_name -> talos.resource.definitions.enums.NethelpersAddressSortAlgorithm
-	85,  // 78: talos.resource.definitions.network.OperatorSpecSpec.operator:type_name -> talos.resource.definitions.enums.NetworkOperator
-	7,   // 79: talos.resource.definitions.network.OperatorSpecSpec.dhcp4:type_name -> talos.resource.definitions.network.DHCP4OperatorSpec
-	8,   // 80: talos.resource.definitions.network.OperatorSpecSpec.dhcp6:type_name -> talos.resource.definitions.network.DHCP6OperatorSpec
-	54,  // 81: talos.resource.definitions.network.OperatorSpecSpec.vip:type_name -> talos.resource.definitions.network.VIPOperatorSpec
-	62,  // 82: talos.resource.definitions.network.OperatorSpecSpec.config_layer:type_name -> talos.resource.definitions.enums.NetworkConfigLayer
-	0,   // 83: talos.resource.definitions.network.PlatformConfigSpec.addresses:type_name -> talos.resource.definitions.network.AddressSpecSpec
-	22,  // 84: talos.resource.definitions.network.PlatformConfigSpec.links:type_name -> talos.resource.definitions.network.LinkSpecSpec
-	45,  // 85: talos.resource.definitions.network.PlatformConfigSpec.routes:type_name -> talos.resource.definitions.network.RouteSpecSpec
-	19,  // 86: talos.resource.definitions.network.PlatformConfigSpec.hostnames:type_name -> talos.resource.definitions.network.HostnameSpecSpec
-	43,  // 87: talos.resource.definitions.network.PlatformConfigSpec.resolvers:type_name -> talos.resource.definitions.network.ResolverSpecSpec
-	50,  // 88: talos.resource.definitions.network.PlatformConfigSpec.time_servers:type_name -> talos.resource.definitions.network.TimeServerSpecSpec
-	38,  // 89: talos.resource.definitions.network.PlatformConfigSpec.operators:type_name -> talos.resource.definitions.network.OperatorSpecSpec
-	63,  // 90: talos.resource.definitions.network.PlatformConfigSpec.external_ips:type_name -> common.NetIP
-	41,  // 91: talos.resource.definitions.network.PlatformConfigSpec.probes:type_name -> talos.resource.definitions.network.ProbeSpecSpec
-	86,  // 92: talos.resource.definitions.network.PlatformConfigSpec.metadata:type_name -> talos.resource.definitions.runtime.PlatformMetadataSpec
-	87,  // 93: talos.resource.definitions.network.ProbeSpecSpec.interval:type_name -> google.protobuf.Duration
-	49,  // 94: talos.resource.definitions.network.ProbeSpecSpec.tcp:type_name -> talos.resource.definitions.network.TCPProbeSpec
-	62,  // 95: talos.resource.definitions.network.ProbeSpecSpec.config_layer:type_name -> talos.resource.definitions.enums.NetworkConfigLayer
-	63,  // 96: talos.resource.definitions.network.ResolverSpecSpec.dns_servers:type_name -> common.NetIP
-	62,  // 97: talos.resource.definitions.network.ResolverSpecSpec.config_layer:type_name -> talos.resource.definitions.enums.NetworkConfigLayer
-	63,  // 98: talos.resource.definitions.network.ResolverStatusSpec.dns_servers:type_name -> common.NetIP
-	60,  // 99: talos.resource.definitions.network.RouteSpecSpec.family:type_name -> talos.resource.definitions.enums.NethelpersFamily
-	59,  // 100: talos.resource.definitions.network.RouteSpecSpec.destination:type_name -> common.NetIPPrefix
-	63,  // 101: talos.resource.definitions.network.RouteSpecSpec.source:type_name -> common.NetIP
-	63,  // 102: talos.resource.definitions.network.RouteSpecSpec.gateway:type_name -> common.NetIP
-	88,  // 103: talos.resource.definitions.network.RouteSpecSpec.table:type_name -> talos.resource.definitions.enums.NethelpersRoutingTable
-	61,  // 104: talos.resource.definitions.network.RouteSpecSpec.scope:type_name -> talos.resource.definitions.enums.NethelpersScope
-	89,  // 105: talos.resource.definitions.network.RouteSpecSpec.type:type_name -> talos.resource.definitions.enums.NethelpersRouteType
-	90,  // 106: talos.resource.definitions.network.RouteSpecSpec.protocol:type_name -> talos.resource.definitions.enums.NethelpersRouteProtocol
-	62,  // 107: talos.resource.definitions.network.RouteSpecSpec.config_layer:type_name -> talos.resource.definitions.enums.NetworkConfigLayer
-	60,  // 108: talos.resource.definitions.network.RouteStatusSpec.family:type_name -> talos.resource.definitions.enums.NethelpersFamily
-	59,  // 109: talos.resource.definitions.network.RouteStatusSpec.destination:type_name -> common.NetIPPrefix
-	63,  // 110: talos.resource.definitions.network.RouteStatusSpec.source:type_name -> common.NetIP
-	63,  // 111: talos.resource.definitions.network.RouteStatusSpec.gateway:type_name -> common.NetIP
-	88,  // 112: talos.resource.definitions.network.RouteStatusSpec.table:type_name -> talos.resource.definitions.enums.NethelpersRoutingTable
-	61,  // 113: talos.resource.definitions.network.RouteStatusSpec.scope:type_name -> talos.resource.definitions.enums.NethelpersScope
-	89,  // 114: talos.resource.definitions.network.RouteStatusSpec.type:type_name -> talos.resource.definitions.enums.NethelpersRouteType
-	90,  // 115: talos.resource.definitions.network.RouteStatusSpec.protocol:type_name -> talos.resource.definitions.enums.NethelpersRouteProtocol
-	87,  // 116: talos.resource.definitions.network.TCPProbeSpec.timeout:type_name -> google.protobuf.Duration
-	62,  // 117: talos.resource.definitions.network.TimeServerSpecSpec.config_layer:type_name -> talos.resource.definitions.enums.NetworkConfigLayer
-	63,  // 118: talos.resource.definitions.network.VIPOperatorSpec.ip:type_name -> common.NetIP
-	52,  // 119: talos.resource.definitions.network.VIPOperatorSpec.equinix_metal:type_name -> talos.resource.definitions.network.VIPEquinixMetalSpec
-	53,  // 120: talos.resource.definitions.network.VIPOperatorSpec.h_cloud:type_name -> talos.resource.definitions.network.VIPHCloudSpec
-	91,  // 121: talos.resource.definitions.network.VLANSpec.protocol:type_name -> talos.resource.definitions.enums.NethelpersVLANProtocol
-	87,  // 122: talos.resource.definitions.network.WireguardPeer.persistent_keepalive_interval:type_name -> google.protobuf.Duration
-	59,  // 123: talos.resource.definitions.network.WireguardPeer.allowed_ips:type_name -> common.NetIPPrefix
-	56,  // 124: talos.resource.definitions.network.WireguardSpec.peers:type_name -> talos.resource.definitions.network.WireguardPeer
-	125, // [125:125] is the sub-list for method output_type
-	125, // [125:125] is the sub-list for method input_type
-	125, // [125:125] is the sub-list for extension type_name
-	125, // [125:125] is the sub-list for extension extendee
-	0,   // [0:125] is the sub-list for field type_name
+	84,  // 0: talos.resource.definitions.network.AddressSpecSpec.address:type_name -> common.NetIPPrefix
+	85,  // 1: talos.resource.definitions.network.AddressSpecSpec.family:type_name -> talos.resource.definitions.enums.NethelpersFamily
+	86,  // 2: talos.resource.definitions.network.AddressSpecSpec.scope:type_name -> talos.resource.definitions.enums.NethelpersScope
+	87,  // 3: talos.resource.definitions.network.AddressSpecSpec.config_layer:type_name -> talos.resource.definitions.enums.NetworkConfigLayer
+	84,  // 4: talos.resource.definitions.network.AddressStatusSpec.address:type_name -> common.NetIPPrefix
+	88,  // 5: talos.resource.definitions.network.AddressStatusSpec.local:type_name -> common.NetIP
+	88,  // 6: talos.resource.definitions.network.AddressStatusSpec.broadcast:type_name -> common.NetIP
+	88,  // 7: talos.resource.definitions.network.AddressStatusSpec.anycast:type_name -> common.NetIP
+	88,  // 8: talos.resource.definitions.network.AddressStatusSpec.multicast:type_name -> common.NetIP
+	85,  // 9: talos.resource.definitions.network.AddressStatusSpec.family:type_name -> talos.resource.definitions.enums.NethelpersFamily
+	86,  // 10: talos.resource.definitions.network.AddressStatusSpec.scope:type_name -> talos.resource.definitions.enums.NethelpersScope
+	88,  // 11: talos.resource.definitions.network.BondARPTargetStatus.address:type_name -> common.NetIP
+	89,  // 12: talos.resource.definitions.network.BondMasterSpec.mode:type_name -> talos.resource.definitions.enums.NethelpersBondMode
+	90,  // 13: talos.resource.definitions.network.BondMasterSpec.hash_policy:type_name -> talos.resource.definitions.enums.NethelpersBondXmitHashPolicy
+	91,  // 14: talos.resource.definitions.network.BondMasterSpec.lacp_rate:type_name -> talos.resource.definitions.enums.NethelpersLACPRate
+	92,  // 15: talos.resource.definitions.network.BondMasterSpec.arp_validate:type_name -> talos.resource.definitions.enums.NethelpersARPValidate
+	93,  // 16: talos.resource.definitions.network.BondMasterSpec.arp_all_targets:type_name -> talos.resource.definitions.enums.NethelpersARPAllTargets
+	94,  // 17: talos.resource.definitions.network.BondMasterSpec.primary_reselect:type_name -> talos.resource.definitions.enums.NethelpersPrimaryReselect
+	95,  // 18: talos.resource.definitions.network.BondMasterSpec.fail_over_mac:type_name -> talos.resource.definitions.enums.NethelpersFailOverMAC
+	96,  // 19: talos.resource.definitions.network.BondMasterSpec.ad_select:type_name -> talos.resource.definitions.enums.NethelpersADSelect
+	88,  // 20: talos.resource.definitions.network.BondMasterSpec.arpip_targets:type_name -> common.NetIP
+	88,  // 21: talos.resource.definitions.network.BondMasterSpec.nsip6_targets:type_name -> common.NetIP
+	89,  // 22: talos.resource.definitions.network.BondStatusSpec.mode:type_name -> talos.resource.definitions.enums.NethelpersBondMode
+	90,  // 23: talos.resource.definitions.network.BondStatusSpec.hash_policy:type_name -> talos.resource.definitions.enums.NethelpersBondXmitHashPolicy
+	91,  // 24: talos.resource.definitions.network.BondStatusSpec.lacp_rate:type_name -> talos.resource.definitions.enums.NethelpersLACPRate
+	5,   // 25: talos.resource.definitions.network.BondStatusSpec.slaves:type_name -> talos.resource.definitions.network.BondSlaveStatus
+	2,   // 26: talos.resource.definitions.network.BondStatusSpec.arp_targets:type_name -> talos.resource.definitions.network.BondARPTargetStatus
+	68,  // 27: talos.resource.definitions.network.BridgeMasterSpec.stp:type_name -> talos.resource.definitions.network.STPSpec
+	12,  // 28: talos.resource.definitions.network.BridgeMasterSpec.vlan:type_name -> talos.resource.definitions.network.BridgeVLANSpec
+	11,  // 29: talos.resource.definitions.network.BridgePortStatus.vla_ns:type_name -> talos.resource.definitions.network.BridgeVLANEntry
+	11,  // 30: talos.resource.definitions.network.BridgeSlave.vla_ns:type_name -> talos.resource.definitions.network.BridgeVLANEntry
+	8,   // 31: talos.resource.definitions.network.BridgeStatusSpec.ports:type_name -> talos.resource.definitions.network.BridgePortStatus
+	84,  // 32: talos.resource.definitions.network.DHCP4LeaseSpec.address:type_name -> common.NetIPPrefix
+	88,  // 33: talos.resource.definitions.network.DHCP4LeaseSpec.server_id:type_name -> common.NetIP
+	97,  // 34: talos.resource.definitions.network.DHCP4LeaseSpec.lease_time:type_name -> google.protobuf.Duration
+	98,  // 35: talos.resource.definitions.network.DHCP4LeaseSpec.expiry:type_name -> google.protobuf.Timestamp
+	85,  // 36: talos.resource.definitions.network.DefaultRouteStatusSpec.family:type_name -> talos.resource.definitions.enums.NethelpersFamily
+	22,  // 37: talos.resource.definitions.network.EthernetSpecSpec.rings:type_name -> talos.resource.definitions.network.EthernetRingsSpec
+	83,  // 38: talos.resource.definitions.network.EthernetSpecSpec.features:type_name -> talos.resource.definitions.network.EthernetSpecSpec.FeaturesEntry
+	18,  // 39: talos.resource.definitions.network.EthernetSpecSpec.channels:type_name -> talos.resource.definitions.network.EthernetChannelsSpec
+	99,  // 40: talos.resource.definitions.network.EthernetStatusSpec.port:type_name -> talos.resource.definitions.enums.NethelpersPort
+	100, // 41: talos.resource.definitions.network.EthernetStatusSpec.duplex:type_name -> talos.resource.definitions.enums.NethelpersDuplex
+	23,  // 42: talos.resource.definitions.network.EthernetStatusSpec.rings:type_name -> talos.resource.definitions.network.EthernetRingsStatus
+	21,  // 43: talos.resource.definitions.network.EthernetStatusSpec.features:type_name -> talos.resource.definitions.network.EthernetFeatureStatus
+	19,  // 44: talos.resource.definitions.network.EthernetStatusSpec.channels:type_name -> talos.resource.definitions.network.EthernetChannelsStatus
+	20,  // 45: talos.resource.definitions.network.EthernetStatusSpec.config_errors:type_name -> talos.resource.definitions.network.EthernetConfigError
+	97,  // 46: talos.resource.definitions.network.HTTPProbeSpec.timeout:type_name -> google.protobuf.Duration
+	101, // 47: talos.resource.definitions.network.HostDNSConfigSpec.listen_addresses:type_name -> common.NetIPPort
+	88,  // 48: talos.resource.definitions.network.HostDNSConfigSpec.service_host_dns_address:type_name -> common.NetIP
+	97,  // 49: talos.resource.definitions.network.HostDNSConfigSpec.cache_min_ttl:type_name -> google.protobuf.Duration
+	97,  // 50: talos.resource.definitions.network.HostDNSConfigSpec.cache_max_ttl:type_name -> google.protobuf.Duration
+	97,  // 51: talos.resource.definitions.network.HostDNSConfigSpec.negative_cache_ttl:type_name -> google.protobuf.Duration
+	97,  // 52: talos.resource.definitions.network.HostDNSConfigSpec.serve_stale:type_name -> google.protobuf.Duration
+	84,  // 53: talos.resource.definitions.network.HostDNSConfigSpec.allowed_clients:type_name -> common.NetIPPrefix
+	87,  // 54: talos.resource.definitions.network.HostnameSpecSpec.config_layer:type_name -> talos.resource.definitions.enums.NetworkConfigLayer
+	97,  // 55: talos.resource.definitions.network.ICMPProbeSpec.timeout:type_name -> google.protobuf.Duration
+	97,  // 56: talos.resource.definitions.network.LLDPNeighborStatusSpec.ttl:type_name -> google.protobuf.Duration
+	98,  // 57: talos.resource.definitions.network.LLDPNeighborStatusSpec.expires:type_name -> google.protobuf.Timestamp
+	102, // 58: talos.resource.definitions.network.LinkSpecSpec.type:type_name -> talos.resource.definitions.enums.NethelpersLinkType
+	4,   // 59: talos.resource.definitions.network.LinkSpecSpec.bond_slave:type_name -> talos.resource.definitions.network.BondSlave
+	9,   // 60: talos.resource.definitions.network.LinkSpecSpec.bridge_slave:type_name -> talos.resource.definitions.network.BridgeSlave
+	79,  // 61: talos.resource.definitions.network.LinkSpecSpec.vlan:type_name -> talos.resource.definitions.network.VLANSpec
+	3,   // 62: talos.resource.definitions.network.LinkSpecSpec.bond_master:type_name -> talos.resource.definitions.network.BondMasterSpec
+	7,   // 63: talos.resource.definitions.network.LinkSpecSpec.bridge_master:type_name -> talos.resource.definitions.network.BridgeMasterSpec
+	82,  // 64: talos.resource.definitions.network.LinkSpecSpec.wireguard:type_name -> talos.resource.definitions.network.WireguardSpec
+	87,  // 65: talos.resource.definitions.network.LinkSpecSpec.config_layer:type_name -> talos.resource.definitions.enums.NetworkConfigLayer
+	102, // 66: talos.resource.definitions.network.LinkStatusSpec.type:type_name -> talos.resource.definitions.enums.NethelpersLinkType
+	103, // 67: talos.resource.definitions.network.LinkStatusSpec.operational_state:type_name -> talos.resource.definitions.enums.NethelpersOperationalState
+	99,  // 68: talos.resource.definitions.network.LinkStatusSpec.port:type_name -> talos.resource.definitions.enums.NethelpersPort
+	100, // 69: talos.resource.definitions.network.LinkStatusSpec.duplex:type_name -> talos.resource.definitions.enums.NethelpersDuplex
+	79,  // 70: talos.resource.definitions.network.LinkStatusSpec.vlan:type_name -> talos.resource.definitions.network.VLANSpec
+	7,   // 71: talos.resource.definitions.network.LinkStatusSpec.bridge_master:type_name -> talos.resource.definitions.network.BridgeMasterSpec
+	3,   // 72: talos.resource.definitions.network.LinkStatusSpec.bond_master:type_name -> talos.resource.definitions.network.BondMasterSpec
+	82,  // 73: talos.resource.definitions.network.LinkStatusSpec.wireguard:type_name -> talos.resource.definitions.network.WireguardSpec
+	84,  // 74: talos.resource.definitions.network.NfTablesAddressMatch.include_subnets:type_name -> common.NetIPPrefix
+	84,  // 75: talos.resource.definitions.network.NfTablesAddressMatch.exclude_subnets:type_name -> common.NetIPPrefix
+	104, // 76: talos.resource.definitions.network.NfTablesChainSpec.hook:type_name -> talos.resource.definitions.enums.NethelpersNfTablesChainHook
+	105, // 77: talos.resource.definitions.network.NfTablesChainSpec.priority:type_name -> talos.resource.definitions.enums.NethelpersNfTablesChainPriority
+	47,  // 78: talos.resource.definitions.network.NfTablesChainSpec.rules:type_name -> talos.resource.definitions.network.NfTablesRule
+	106, // 79: talos.resource.definitions.network.NfTablesChainSpec.policy:type_name -> talos.resource.definitions.enums.NethelpersNfTablesVerdict
+	107, // 80: talos.resource.definitions.network.NfTablesConntrackStateMatch.states:type_name -> talos.resource.definitions.enums.NethelpersConntrackState
+	108, // 81: talos.resource.definitions.network.NfTablesICMPTypeMatch.types:type_name -> talos.resource.definitions.enums.NethelpersICMPType
+	109, // 82: talos.resource.definitions.network.NfTablesIfNameMatch.operator:type_name -> talos.resource.definitions.enums.NethelpersMatchOperator
+	110, // 83: talos.resource.definitions.network.NfTablesLayer4Match.protocol:type_name -> talos.resource.definitions.enums.NethelpersProtocol
+	46,  // 84: talos.resource.definitions.network.NfTablesLayer4Match.match_source_port:type_name -> talos.resource.definitions.network.NfTablesPortMatch
+	46,  // 85: talos.resource.definitions.network.NfTablesLayer4Match.match_destination_port:type_name -> talos.resource.definitions.network.NfTablesPortMatch
+	40,  // 86: talos.resource.definitions.network.NfTablesLayer4Match.match_icmp_type:type_name -> talos.resource.definitions.network.NfTablesICMPTypeMatch
+	53,  // 87: talos.resource.definitions.network.NfTablesPortMatch.ranges:type_name -> talos.resource.definitions.network.PortRange
+	41,  // 88: talos.resource.definitions.network.NfTablesRule.match_o_if_name:type_name -> talos.resource.definitions.network.NfTablesIfNameMatch
+	106, // 89: talos.resource.definitions.network.NfTablesRule.verdict:type_name -> talos.resource.definitions.enums.NethelpersNfTablesVerdict
+	45,  // 90: talos.resource.definitions.network.NfTablesRule.match_mark:type_name -> talos.resource.definitions.network.NfTablesMark
+	45,  // 91: talos.resource.definitions.network.NfTablesRule.set_mark:type_name -> talos.resource.definitions.network.NfTablesMark
+	36,  // 92: talos.resource.definitions.network.NfTablesRule.match_source_address:type_name -> talos.resource.definitions.network.NfTablesAddressMatch
+	36,  // 93: talos.resource.definitions.network.NfTablesRule.match_destination_address:type_name -> talos.resource.definitions.network.NfTablesAddressMatch
+	42,  // 94: talos.resource.definitions.network.NfTablesRule.match_layer4:type_name -> talos.resource.definitions.network.NfTablesLayer4Match
+	41,  // 95: talos.resource.definitions.network.NfTablesRule.match_i_if_name:type_name -> talos.resource.definitions.network.NfTablesIfNameMatch
+	38,  // 96: talos.resource.definitions.network.NfTablesRule.clamp_mss:type_name -> talos.resource.definitions.network.NfTablesClampMSS
+	43,  // 97: talos.resource.definitions.network.NfTablesRule.match_limit:type_name -> talos.resource.definitions.network.NfTablesLimitMatch
+	39,  // 98: talos.resource.definitions.network.NfTablesRule.match_conntrack_state:type_name -> talos.resource.definitions.network.NfTablesConntrackStateMatch
+	44,  // 99: talos.resource.definitions.network.NfTablesRule.log:type_name -> talos.resource.definitions.network.NfTablesLog
+	84,  // 100: talos.resource.definitions.network.NodeAddressFilterSpec.include_subnets:type_name -> common.NetIPPrefix
+	84,  // 101: talos.resource.definitions.network.NodeAddressFilterSpec.exclude_subnets:type_name -> common.NetIPPrefix
+	111, // 102: talos.resource.definitions.network.NodeAddressSortAlgorithmSpec.algorithm:type_name -> talos.resource.definitions.enums.NethelpersAddressSortAlgorithm
+	84,  // 103: talos.resource.definitions.network.NodeAddressSpec.addresses:type_name -> common.NetIPPrefix
+	111, // 104: talos.resource.definitions.network.NodeAddressSpec.sort_algorithm:type_name -> talos.resource.definitions.enums.NethelpersAddressSortAlgorithm
+	112, // 105: talos.resource.definitions.network.OperatorSpecSpec.operator:type_name -> talos.resource.definitions.enums.NetworkOperator
+	14,  // 106: talos.resource.definitions.network.OperatorSpecSpec.dhcp4:type_name -> talos.resource.definitions.network.DHCP4OperatorSpec
+	15,  // 107: talos.resource.definitions.network.OperatorSpecSpec.dhcp6:type_name -> talos.resource.definitions.network.DHCP6OperatorSpec
+	77,  // 108: talos.resource.definitions.network.OperatorSpecSpec.vip:type_name -> talos.resource.definitions.network.VIPOperatorSpec
+	87,  // 109: talos.resource.definitions.network.OperatorSpecSpec.config_layer:type_name -> talos.resource.definitions.enums.NetworkConfigLayer
+	69,  // 110: talos.resource.definitions.network.OperatorSpecSpec.static_fallback:type_name -> talos.resource.definitions.network.StaticFallbackOperatorSpec
+	0,   // 111: talos.resource.definitions.network.PlatformConfigSpec.addresses:type_name -> talos.resource.definitions.network.AddressSpecSpec
+	34,  // 112: talos.resource.definitions.network.PlatformConfigSpec.links:type_name -> talos.resource.definitions.network.LinkSpecSpec
+	61,  // 113: talos.resource.definitions.network.PlatformConfigSpec.routes:type_name -> talos.resource.definitions.network.RouteSpecSpec
+	29,  // 114: talos.resource.definitions.network.PlatformConfigSpec.hostnames:type_name -> talos.resource.definitions.network.HostnameSpecSpec
+	57,  // 115: talos.resource.definitions.network.PlatformConfigSpec.resolvers:type_name -> talos.resource.definitions.network.ResolverSpecSpec
+	73,  // 116: talos.resource.definitions.network.PlatformConfigSpec.time_servers:type_name -> talos.resource.definitions.network.TimeServerSpecSpec
+	51,  // 117: talos.resource.definitions.network.PlatformConfigSpec.operators:type_name -> talos.resource.definitions.network.OperatorSpecSpec
+	88,  // 118: talos.resource.definitions.network.PlatformConfigSpec.external_ips:type_name -> common.NetIP
+	54,  // 119: talos.resource.definitions.network.PlatformConfigSpec.probes:type_name -> talos.resource.definitions.network.ProbeSpecSpec
+	113, // 120: talos.resource.definitions.network.PlatformConfigSpec.metadata:type_name -> talos.resource.definitions.runtime.PlatformMetadataSpec
+	97,  // 121: talos.resource.definitions.network.ProbeSpecSpec.interval:type_name -> google.protobuf.Duration
+	72,  // 122: talos.resource.definitions.network.ProbeSpecSpec.tcp:type_name -> talos.resource.definitions.network.TCPProbeSpec
+	87,  // 123: talos.resource.definitions.network.ProbeSpecSpec.config_layer:type_name -> talos.resource.definitions.enums.NetworkConfigLayer
+	26,  // 124: talos.resource.definitions.network.ProbeSpecSpec.http:type_name -> talos.resource.definitions.network.HTTPProbeSpec
+	31,  // 125: talos.resource.definitions.network.ProbeSpecSpec.icmp:type_name -> talos.resource.definitions.network.ICMPProbeSpec
+	97,  // 126: talos.resource.definitions.network.ProbeStatusSpec.last_latency:type_name -> google.protobuf.Duration
+	97,  // 127: talos.resource.definitions.network.ResolverOptions.timeout:type_name -> google.protobuf.Duration
+	88,  // 128: talos.resource.definitions.network.ResolverSpecSpec.dns_servers:type_name -> common.NetIP
+	87,  // 129: talos.resource.definitions.network.ResolverSpecSpec.config_layer:type_name -> talos.resource.definitions.enums.NetworkConfigLayer
+	56,  // 130: talos.resource.definitions.network.ResolverSpecSpec.options:type_name -> talos.resource.definitions.network.ResolverOptions
+	88,  // 131: talos.resource.definitions.network.ResolverStatusSpec.dns_servers:type_name -> common.NetIP
+	56,  // 132: talos.resource.definitions.network.ResolverStatusSpec.options:type_name -> talos.resource.definitions.network.ResolverOptions
+	85,  // 133: talos.resource.definitions.network.RouteRuleSpecSpec.family:type_name -> talos.resource.definitions.enums.NethelpersFamily
+	84,  // 134: talos.resource.definitions.network.RouteRuleSpecSpec.source:type_name -> common.NetIPPrefix
+	84,  // 135: talos.resource.definitions.network.RouteRuleSpecSpec.destination:type_name -> common.NetIPPrefix
+	114, // 136: talos.resource.definitions.network.RouteRuleSpecSpec.table:type_name -> talos.resource.definitions.enums.NethelpersRoutingTable
+	87,  // 137: talos.resource.definitions.network.RouteRuleSpecSpec.config_layer:type_name -> talos.resource.definitions.enums.NetworkConfigLayer
+	85,  // 138: talos.resource.definitions.network.RouteRuleStatusSpec.family:type_name -> talos.resource.definitions.enums.NethelpersFamily
+	84,  // 139: talos.resource.definitions.network.RouteRuleStatusSpec.source:type_name -> common.NetIPPrefix
+	84,  // 140: talos.resource.definitions.network.RouteRuleStatusSpec.destination:type_name -> common.NetIPPrefix
+	114, // 141: talos.resource.definitions.network.RouteRuleStatusSpec.table:type_name -> talos.resource.definitions.enums.NethelpersRoutingTable
+	115, // 142: talos.resource.definitions.network.RouteRuleStatusSpec.protocol:type_name -> talos.resource.definitions.enums.NethelpersRouteProtocol
+	85,  // 143: talos.resource.definitions.network.RouteSpecSpec.family:type_name -> talos.resource.definitions.enums.NethelpersFamily
+	84,  // 144: talos.resource.definitions.network.RouteSpecSpec.destination:type_name -> common.NetIPPrefix
+	88,  // 145: talos.resource.definitions.network.RouteSpecSpec.source:type_name -> common.NetIP
+	88,  // 146: talos.resource.definitions.network.RouteSpecSpec.gateway:type_name -> common.NetIP
+	114, // 147: talos.resource.definitions.network.RouteSpecSpec.table:type_name -> talos.resource.definitions.enums.NethelpersRoutingTable
+	86,  // 148: talos.resource.definitions.network.RouteSpecSpec.scope:type_name -> talos.resource.definitions.enums.NethelpersScope
+	116, // 149: talos.resource.definitions.network.RouteSpecSpec.type:type_name -> talos.resource.definitions.enums.NethelpersRouteType
+	115, // 150: talos.resource.definitions.network.RouteSpecSpec.protocol:type_name -> talos.resource.definitions.enums.NethelpersRouteProtocol
+	87,  // 151: talos.resource.definitions.network.RouteSpecSpec.config_layer:type_name -> talos.resource.definitions.enums.NetworkConfigLayer
+	85,  // 152: talos.resource.definitions.network.RouteStatusSpec.family:type_name -> talos.resource.definitions.enums.NethelpersFamily
+	84,  // 153: talos.resource.definitions.network.RouteStatusSpec.destination:type_name -> common.NetIPPrefix
+	88,  // 154: talos.resource.definitions.network.RouteStatusSpec.source:type_name -> common.NetIP
+	88,  // 155: talos.resource.definitions.network.RouteStatusSpec.gateway:type_name -> common.NetIP
+	114, // 156: talos.resource.definitions.network.RouteStatusSpec.table:type_name -> talos.resource.definitions.enums.NethelpersRoutingTable
+	86,  // 157: talos.resource.definitions.network.RouteStatusSpec.scope:type_name -> talos.resource.definitions.enums.NethelpersScope
+	116, // 158: talos.resource.definitions.network.RouteStatusSpec.type:type_name -> talos.resource.definitions.enums.NethelpersRouteType
+	115, // 159: talos.resource.definitions.network.RouteStatusSpec.protocol:type_name -> talos.resource.definitions.enums.NethelpersRouteProtocol
+	88,  // 160: talos.resource.definitions.network.RouterStatusSpec.address:type_name -> common.NetIP
+	97,  // 161: talos.resource.definitions.network.RouterStatusSpec.lifetime:type_name -> google.protobuf.Duration
+	98,  // 162: talos.resource.definitions.network.RouterStatusSpec.expires:type_name -> google.protobuf.Timestamp
+	84,  // 163: talos.resource.definitions.network.RouterStatusSpec.routes:type_name -> common.NetIPPrefix
+	88,  // 164: talos.resource.definitions.network.RouterStatusSpec.dns_servers:type_name -> common.NetIP
+	98,  // 165: talos.resource.definitions.network.RouterStatusSpec.last_advertisement:type_name -> google.protobuf.Timestamp
+	66,  // 166: talos.resource.definitions.network.SRIOVSpecSpec.v_fs:type_name -> talos.resource.definitions.network.SRIOVVFSpec
+	67,  // 167: talos.resource.definitions.network.SRIOVStatusSpec.v_fs:type_name -> talos.resource.definitions.network.SRIOVVFStatus
+	0,   // 168: talos.resource.definitions.network.StaticFallbackOperatorSpec.addresses:type_name -> talos.resource.definitions.network.AddressSpecSpec
+	61,  // 169: talos.resource.definitions.network.StaticFallbackOperatorSpec.routes:type_name -> talos.resource.definitions.network.RouteSpecSpec
+	88,  // 170: talos.resource.definitions.network.StaticFallbackOperatorSpec.gateway:type_name -> common.NetIP
+	97,  // 171: talos.resource.definitions.network.StaticFallbackOperatorSpec.probe_timeout:type_name -> google.protobuf.Duration
+	97,  // 172: talos.resource.definitions.network.StaticFallbackOperatorSpec.probe_interval:type_name -> google.protobuf.Duration
+	88,  // 173: talos.resource.definitions.network.StaticFallbackStatusSpec.gateway:type_name -> common.NetIP
+	98,  // 174: talos.resource.definitions.network.StaticFallbackStatusSpec.last_probe:type_name -> google.protobuf.Timestamp
+	98,  // 175: talos.resource.definitions.network.StaticFallbackStatusSpec.last_transition:type_name -> google.protobuf.Timestamp
+	97,  // 176: talos.resource.definitions.network.TCPProbeSpec.timeout:type_name -> google.protobuf.Duration
+	87,  // 177: talos.resource.definitions.network.TimeServerSpecSpec.config_layer:type_name -> talos.resource.definitions.enums.NetworkConfigLayer
+	88,  // 178: talos.resource.definitions.network.VIPOperatorSpec.ip:type_name -> common.NetIP
+	75,  // 179: talos.resource.definitions.network.VIPOperatorSpec.equinix_metal:type_name -> talos.resource.definitions.network.VIPEquinixMetalSpec
+	76,  // 180: talos.resource.definitions.network.VIPOperatorSpec.h_cloud:type_name -> talos.resource.definitions.network.VIPHCloudSpec
+	88,  // 181: talos.resource.definitions.network.VIPStatusSpec.ip:type_name -> common.NetIP
+	98,  // 182: talos.resource.definitions.network.VIPStatusSpec.last_transition:type_name -> google.protobuf.Timestamp
+	117, // 183: talos.resource.definitions.network.VLANSpec.protocol:type_name -> talos.resource.definitions.enums.NethelpersVLANProtocol
+	101, // 184: talos.resource.definitions.network.WireguardEndpointStatusSpec.resolved_endpoint:type_name -> common.NetIPPort
+	101, // 185: talos.resource.definitions.network.WireguardEndpointStatusSpec.current_endpoint:type_name -> common.NetIPPort
+	98,  // 186: talos.resource.definitions.network.WireguardEndpointStatusSpec.last_resolved:type_name -> google.protobuf.Timestamp
+	98,  // 187: talos.resource.definitions.network.WireguardEndpointStatusSpec.last_handshake:type_name -> google.protobuf.Timestamp
+	97,  // 188: talos.resource.definitions.network.WireguardPeer.persistent_keepalive_interval:type_name -> google.protobuf.Duration
+	84,  // 189: talos.resource.definitions.network.WireguardPeer.allowed_ips:type_name -> common.NetIPPrefix
+	81,  // 190: talos.resource.definitions.network.WireguardSpec.peers:type_name -> talos.resource.definitions.network.WireguardPeer
+	97,  // 191: talos.resource.definitions.network.WireguardSpec.endpoint_resolve_interval:type_name -> google.protobuf.Duration
+	192, // [192:192] is the sub-list for method output_type
+	192, // [192:192] is the sub-list for method input_type
+	192, // [192:192] is the sub-list for extension type_name
+	192, // [192:192] is the sub-list for extension extendee
+	0,   // [0:192] is the sub-list for field type_name
 }
 
 func init() { file_resource_definitions_network_network_proto_init() }
@@ -5183,7 +7766,7 @@ func file_resource_definitions_network_network_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resource_definitions_network_network_proto_rawDesc), len(file_resource_definitions_network_network_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   59,
+			NumMessages:   84,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -14,6 +14,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 
 	common "github.com/siderolabs/talos/pkg/machinery/api/common"
 	enums "github.com/siderolabs/talos/pkg/machinery/api/resource/definitions/enums"
@@ -71,6 +72,128 @@ func (x *BootedEntrySpec) GetBootedEntry() string {
 	return ""
 }
 
+// ConfigValidationFinding describes a single finding of the config validation.
+type ConfigValidationFinding struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Path          string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigValidationFinding) Reset() {
+	*x = ConfigValidationFinding{}
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigValidationFinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigValidationFinding) ProtoMessage() {}
+
+func (x *ConfigValidationFinding) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigValidationFinding.ProtoReflect.Descriptor instead.
+func (*ConfigValidationFinding) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ConfigValidationFinding) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ConfigValidationFinding) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ConfigValidationFinding) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ConfigValidationFinding) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ConfigValidationStatusSpec describes the validation results of the active machine config.
+type ConfigValidationStatusSpec struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	ConfigVersion string                     `protobuf:"bytes,1,opt,name=config_version,json=configVersion,proto3" json:"config_version,omitempty"`
+	Warnings      []*ConfigValidationFinding `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigValidationStatusSpec) Reset() {
+	*x = ConfigValidationStatusSpec{}
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigValidationStatusSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigValidationStatusSpec) ProtoMessage() {}
+
+func (x *ConfigValidationStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigValidationStatusSpec.ProtoReflect.Descriptor instead.
+func (*ConfigValidationStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ConfigValidationStatusSpec) GetConfigVersion() string {
+	if x != nil {
+		return x.ConfigVersion
+	}
+	return ""
+}
+
+func (x *ConfigValidationStatusSpec) GetWarnings() []*ConfigValidationFinding {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
 // DevicesStatusSpec is the spec for devices status.
 type DevicesStatusSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -81,7 +204,7 @@ type DevicesStatusSpec struct {
 
 func (x *DevicesStatusSpec) Reset() {
 	*x = DevicesStatusSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[1]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -93,7 +216,7 @@ func (x *DevicesStatusSpec) String() string {
 func (*DevicesStatusSpec) ProtoMessage() {}
 
 func (x *DevicesStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[1]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -106,7 +229,7 @@ func (x *DevicesStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DevicesStatusSpec.ProtoReflect.Descriptor instead.
 func (*DevicesStatusSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{1}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *DevicesStatusSpec) GetReady() bool {
@@ -127,7 +250,7 @@ type DiagnosticSpec struct {
 
 func (x *DiagnosticSpec) Reset() {
 	*x = DiagnosticSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[2]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -139,7 +262,7 @@ func (x *DiagnosticSpec) String() string {
 func (*DiagnosticSpec) ProtoMessage() {}
 
 func (x *DiagnosticSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[2]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -152,7 +275,7 @@ func (x *DiagnosticSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiagnosticSpec.ProtoReflect.Descriptor instead.
 func (*DiagnosticSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{2}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *DiagnosticSpec) GetMessage() string {
@@ -179,7 +302,7 @@ type EventSinkConfigSpec struct {
 
 func (x *EventSinkConfigSpec) Reset() {
 	*x = EventSinkConfigSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[3]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -191,7 +314,7 @@ func (x *EventSinkConfigSpec) String() string {
 func (*EventSinkConfigSpec) ProtoMessage() {}
 
 func (x *EventSinkConfigSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[3]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -204,7 +327,7 @@ func (x *EventSinkConfigSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EventSinkConfigSpec.ProtoReflect.Descriptor instead.
 func (*EventSinkConfigSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{3}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *EventSinkConfigSpec) GetEndpoint() string {
@@ -225,7 +348,7 @@ type ExtensionServiceConfigFile struct {
 
 func (x *ExtensionServiceConfigFile) Reset() {
 	*x = ExtensionServiceConfigFile{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[4]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -237,7 +360,7 @@ func (x *ExtensionServiceConfigFile) String() string {
 func (*ExtensionServiceConfigFile) ProtoMessage() {}
 
 func (x *ExtensionServiceConfigFile) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[4]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -250,7 +373,7 @@ func (x *ExtensionServiceConfigFile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtensionServiceConfigFile.ProtoReflect.Descriptor instead.
 func (*ExtensionServiceConfigFile) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{4}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ExtensionServiceConfigFile) GetContent() string {
@@ -278,7 +401,7 @@ type ExtensionServiceConfigSpec struct {
 
 func (x *ExtensionServiceConfigSpec) Reset() {
 	*x = ExtensionServiceConfigSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[5]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -290,7 +413,7 @@ func (x *ExtensionServiceConfigSpec) String() string {
 func (*ExtensionServiceConfigSpec) ProtoMessage() {}
 
 func (x *ExtensionServiceConfigSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[5]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -303,7 +426,7 @@ func (x *ExtensionServiceConfigSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtensionServiceConfigSpec.ProtoReflect.Descriptor instead.
 func (*ExtensionServiceConfigSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{5}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ExtensionServiceConfigSpec) GetFiles() []*ExtensionServiceConfigFile {
@@ -330,7 +453,7 @@ type ExtensionServiceConfigStatusSpec struct {
 
 func (x *ExtensionServiceConfigStatusSpec) Reset() {
 	*x = ExtensionServiceConfigStatusSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[6]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -342,7 +465,7 @@ func (x *ExtensionServiceConfigStatusSpec) String() string {
 func (*ExtensionServiceConfigStatusSpec) ProtoMessage() {}
 
 func (x *ExtensionServiceConfigStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[6]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -355,7 +478,7 @@ func (x *ExtensionServiceConfigStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtensionServiceConfigStatusSpec.ProtoReflect.Descriptor instead.
 func (*ExtensionServiceConfigStatusSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{6}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ExtensionServiceConfigStatusSpec) GetSpecVersion() string {
@@ -365,6 +488,91 @@ func (x *ExtensionServiceConfigStatusSpec) GetSpecVersion() string {
 	return ""
 }
 
+// ExtensionVerificationStatusSpec describes the signature verification result of an installed system extension.
+type ExtensionVerificationStatusSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ImageRef      string                 `protobuf:"bytes,2,opt,name=image_ref,json=imageRef,proto3" json:"image_ref,omitempty"`
+	Digest        string                 `protobuf:"bytes,3,opt,name=digest,proto3" json:"digest,omitempty"`
+	Verified      bool                   `protobuf:"varint,4,opt,name=verified,proto3" json:"verified,omitempty"`
+	Signer        string                 `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
+	Error         string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtensionVerificationStatusSpec) Reset() {
+	*x = ExtensionVerificationStatusSpec{}
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtensionVerificationStatusSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtensionVerificationStatusSpec) ProtoMessage() {}
+
+func (x *ExtensionVerificationStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtensionVerificationStatusSpec.ProtoReflect.Descriptor instead.
+func (*ExtensionVerificationStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ExtensionVerificationStatusSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExtensionVerificationStatusSpec) GetImageRef() string {
+	if x != nil {
+		return x.ImageRef
+	}
+	return ""
+}
+
+func (x *ExtensionVerificationStatusSpec) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+func (x *ExtensionVerificationStatusSpec) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *ExtensionVerificationStatusSpec) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *ExtensionVerificationStatusSpec) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 // KernelCmdlineSpec presents kernel command line (contents of /proc/cmdline).
 type KernelCmdlineSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -375,7 +583,7 @@ type KernelCmdlineSpec struct {
 
 func (x *KernelCmdlineSpec) Reset() {
 	*x = KernelCmdlineSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[7]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -387,7 +595,7 @@ func (x *KernelCmdlineSpec) String() string {
 func (*KernelCmdlineSpec) ProtoMessage() {}
 
 func (x *KernelCmdlineSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[7]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -400,7 +608,7 @@ func (x *KernelCmdlineSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KernelCmdlineSpec.ProtoReflect.Descriptor instead.
 func (*KernelCmdlineSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{7}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *KernelCmdlineSpec) GetCmdline() string {
@@ -415,13 +623,14 @@ type KernelModuleSpecSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Parameters    []string               `protobuf:"bytes,2,rep,name=parameters,proto3" json:"parameters,omitempty"`
+	Order         int64                  `protobuf:"varint,3,opt,name=order,proto3" json:"order,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *KernelModuleSpecSpec) Reset() {
 	*x = KernelModuleSpecSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[8]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -433,7 +642,7 @@ func (x *KernelModuleSpecSpec) String() string {
 func (*KernelModuleSpecSpec) ProtoMessage() {}
 
 func (x *KernelModuleSpecSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[8]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -446,7 +655,7 @@ func (x *KernelModuleSpecSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KernelModuleSpecSpec.ProtoReflect.Descriptor instead.
 func (*KernelModuleSpecSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{8}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *KernelModuleSpecSpec) GetName() string {
@@ -463,6 +672,82 @@ func (x *KernelModuleSpecSpec) GetParameters() []string {
 	return nil
 }
 
+func (x *KernelModuleSpecSpec) GetOrder() int64 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
+}
+
+// KernelModuleStatusSpec describes the status of loading a configured Linux kernel module.
+type KernelModuleStatusSpec struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Parameters     []string               `protobuf:"bytes,1,rep,name=parameters,proto3" json:"parameters,omitempty"`
+	Loaded         bool                   `protobuf:"varint,2,opt,name=loaded,proto3" json:"loaded,omitempty"`
+	RebootRequired bool                   `protobuf:"varint,3,opt,name=reboot_required,json=rebootRequired,proto3" json:"reboot_required,omitempty"`
+	Error          string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *KernelModuleStatusSpec) Reset() {
+	*x = KernelModuleStatusSpec{}
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KernelModuleStatusSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KernelModuleStatusSpec) ProtoMessage() {}
+
+func (x *KernelModuleStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KernelModuleStatusSpec.ProtoReflect.Descriptor instead.
+func (*KernelModuleStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *KernelModuleStatusSpec) GetParameters() []string {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *KernelModuleStatusSpec) GetLoaded() bool {
+	if x != nil {
+		return x.Loaded
+	}
+	return false
+}
+
+func (x *KernelModuleStatusSpec) GetRebootRequired() bool {
+	if x != nil {
+		return x.RebootRequired
+	}
+	return false
+}
+
+func (x *KernelModuleStatusSpec) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 // KernelParamSpecSpec describes status of the defined sysctls.
 type KernelParamSpecSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -474,7 +759,7 @@ type KernelParamSpecSpec struct {
 
 func (x *KernelParamSpecSpec) Reset() {
 	*x = KernelParamSpecSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[9]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -486,7 +771,7 @@ func (x *KernelParamSpecSpec) String() string {
 func (*KernelParamSpecSpec) ProtoMessage() {}
 
 func (x *KernelParamSpecSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[9]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -499,7 +784,7 @@ func (x *KernelParamSpecSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KernelParamSpecSpec.ProtoReflect.Descriptor instead.
 func (*KernelParamSpecSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{9}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *KernelParamSpecSpec) GetValue() string {
@@ -528,7 +813,7 @@ type KernelParamStatusSpec struct {
 
 func (x *KernelParamStatusSpec) Reset() {
 	*x = KernelParamStatusSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[10]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -540,7 +825,7 @@ func (x *KernelParamStatusSpec) String() string {
 func (*KernelParamStatusSpec) ProtoMessage() {}
 
 func (x *KernelParamStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[10]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -553,7 +838,7 @@ func (x *KernelParamStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KernelParamStatusSpec.ProtoReflect.Descriptor instead.
 func (*KernelParamStatusSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{10}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *KernelParamStatusSpec) GetCurrent() string {
@@ -577,17 +862,104 @@ func (x *KernelParamStatusSpec) GetUnsupported() bool {
 	return false
 }
 
+// KmsgLogBufferSpec describes buffering settings of a kmsg log destination.
+type KmsgLogBufferSpec struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Destination     string                 `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	MemorySize      uint64                 `protobuf:"varint,2,opt,name=memory_size,json=memorySize,proto3" json:"memory_size,omitempty"`
+	SpoolSize       uint64                 `protobuf:"varint,3,opt,name=spool_size,json=spoolSize,proto3" json:"spool_size,omitempty"`
+	DropPolicy      string                 `protobuf:"bytes,4,opt,name=drop_policy,json=dropPolicy,proto3" json:"drop_policy,omitempty"`
+	RetryMinBackoff *durationpb.Duration   `protobuf:"bytes,5,opt,name=retry_min_backoff,json=retryMinBackoff,proto3" json:"retry_min_backoff,omitempty"`
+	RetryMaxBackoff *durationpb.Duration   `protobuf:"bytes,6,opt,name=retry_max_backoff,json=retryMaxBackoff,proto3" json:"retry_max_backoff,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *KmsgLogBufferSpec) Reset() {
+	*x = KmsgLogBufferSpec{}
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KmsgLogBufferSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KmsgLogBufferSpec) ProtoMessage() {}
+
+func (x *KmsgLogBufferSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KmsgLogBufferSpec.ProtoReflect.Descriptor instead.
+func (*KmsgLogBufferSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *KmsgLogBufferSpec) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *KmsgLogBufferSpec) GetMemorySize() uint64 {
+	if x != nil {
+		return x.MemorySize
+	}
+	return 0
+}
+
+func (x *KmsgLogBufferSpec) GetSpoolSize() uint64 {
+	if x != nil {
+		return x.SpoolSize
+	}
+	return 0
+}
+
+func (x *KmsgLogBufferSpec) GetDropPolicy() string {
+	if x != nil {
+		return x.DropPolicy
+	}
+	return ""
+}
+
+func (x *KmsgLogBufferSpec) GetRetryMinBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.RetryMinBackoff
+	}
+	return nil
+}
+
+func (x *KmsgLogBufferSpec) GetRetryMaxBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.RetryMaxBackoff
+	}
+	return nil
+}
+
 // KmsgLogConfigSpec describes configuration for kmsg log streaming.
 type KmsgLogConfigSpec struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Destinations  []*common.URL          `protobuf:"bytes,1,rep,name=destinations,proto3" json:"destinations,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Destinations       []*common.URL          `protobuf:"bytes,1,rep,name=destinations,proto3" json:"destinations,omitempty"`
+	SyslogDestinations []*common.URL          `protobuf:"bytes,2,rep,name=syslog_destinations,json=syslogDestinations,proto3" json:"syslog_destinations,omitempty"`
+	Buffers            []*KmsgLogBufferSpec   `protobuf:"bytes,3,rep,name=buffers,proto3" json:"buffers,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *KmsgLogConfigSpec) Reset() {
 	*x = KmsgLogConfigSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[11]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -599,7 +971,7 @@ func (x *KmsgLogConfigSpec) String() string {
 func (*KmsgLogConfigSpec) ProtoMessage() {}
 
 func (x *KmsgLogConfigSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[11]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -612,7 +984,7 @@ func (x *KmsgLogConfigSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KmsgLogConfigSpec.ProtoReflect.Descriptor instead.
 func (*KmsgLogConfigSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{11}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *KmsgLogConfigSpec) GetDestinations() []*common.URL {
@@ -622,6 +994,113 @@ func (x *KmsgLogConfigSpec) GetDestinations() []*common.URL {
 	return nil
 }
 
+func (x *KmsgLogConfigSpec) GetSyslogDestinations() []*common.URL {
+	if x != nil {
+		return x.SyslogDestinations
+	}
+	return nil
+}
+
+func (x *KmsgLogConfigSpec) GetBuffers() []*KmsgLogBufferSpec {
+	if x != nil {
+		return x.Buffers
+	}
+	return nil
+}
+
+// KmsgLogDeliveryStatusSpec describes delivery status of a kmsg log destination.
+type KmsgLogDeliveryStatusSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Destination   string                 `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	SentBytes     uint64                 `protobuf:"varint,2,opt,name=sent_bytes,json=sentBytes,proto3" json:"sent_bytes,omitempty"`
+	DroppedBytes  uint64                 `protobuf:"varint,3,opt,name=dropped_bytes,json=droppedBytes,proto3" json:"dropped_bytes,omitempty"`
+	SpooledBytes  uint64                 `protobuf:"varint,4,opt,name=spooled_bytes,json=spooledBytes,proto3" json:"spooled_bytes,omitempty"`
+	BufferedBytes uint64                 `protobuf:"varint,5,opt,name=buffered_bytes,json=bufferedBytes,proto3" json:"buffered_bytes,omitempty"`
+	SpoolBytes    uint64                 `protobuf:"varint,6,opt,name=spool_bytes,json=spoolBytes,proto3" json:"spool_bytes,omitempty"`
+	LastError     string                 `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KmsgLogDeliveryStatusSpec) Reset() {
+	*x = KmsgLogDeliveryStatusSpec{}
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KmsgLogDeliveryStatusSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KmsgLogDeliveryStatusSpec) ProtoMessage() {}
+
+func (x *KmsgLogDeliveryStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KmsgLogDeliveryStatusSpec.ProtoReflect.Descriptor instead.
+func (*KmsgLogDeliveryStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *KmsgLogDeliveryStatusSpec) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *KmsgLogDeliveryStatusSpec) GetSentBytes() uint64 {
+	if x != nil {
+		return x.SentBytes
+	}
+	return 0
+}
+
+func (x *KmsgLogDeliveryStatusSpec) GetDroppedBytes() uint64 {
+	if x != nil {
+		return x.DroppedBytes
+	}
+	return 0
+}
+
+func (x *KmsgLogDeliveryStatusSpec) GetSpooledBytes() uint64 {
+	if x != nil {
+		return x.SpooledBytes
+	}
+	return 0
+}
+
+func (x *KmsgLogDeliveryStatusSpec) GetBufferedBytes() uint64 {
+	if x != nil {
+		return x.BufferedBytes
+	}
+	return 0
+}
+
+func (x *KmsgLogDeliveryStatusSpec) GetSpoolBytes() uint64 {
+	if x != nil {
+		return x.SpoolBytes
+	}
+	return 0
+}
+
+func (x *KmsgLogDeliveryStatusSpec) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
 // LoadedKernelModuleSpec describes Linux kernel module to load.
 type LoadedKernelModuleSpec struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
@@ -636,7 +1115,7 @@ type LoadedKernelModuleSpec struct {
 
 func (x *LoadedKernelModuleSpec) Reset() {
 	*x = LoadedKernelModuleSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[12]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -648,7 +1127,7 @@ func (x *LoadedKernelModuleSpec) String() string {
 func (*LoadedKernelModuleSpec) ProtoMessage() {}
 
 func (x *LoadedKernelModuleSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[12]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -661,7 +1140,7 @@ func (x *LoadedKernelModuleSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoadedKernelModuleSpec.ProtoReflect.Descriptor instead.
 func (*LoadedKernelModuleSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{12}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *LoadedKernelModuleSpec) GetSize() int64 {
@@ -710,7 +1189,7 @@ type MachineStatusSpec struct {
 
 func (x *MachineStatusSpec) Reset() {
 	*x = MachineStatusSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[13]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -722,7 +1201,7 @@ func (x *MachineStatusSpec) String() string {
 func (*MachineStatusSpec) ProtoMessage() {}
 
 func (x *MachineStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[13]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -735,7 +1214,7 @@ func (x *MachineStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MachineStatusSpec.ProtoReflect.Descriptor instead.
 func (*MachineStatusSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{13}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *MachineStatusSpec) GetStage() enums.RuntimeMachineStage {
@@ -763,7 +1242,7 @@ type MachineStatusStatus struct {
 
 func (x *MachineStatusStatus) Reset() {
 	*x = MachineStatusStatus{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[14]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -775,7 +1254,7 @@ func (x *MachineStatusStatus) String() string {
 func (*MachineStatusStatus) ProtoMessage() {}
 
 func (x *MachineStatusStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[14]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -788,7 +1267,7 @@ func (x *MachineStatusStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MachineStatusStatus.ProtoReflect.Descriptor instead.
 func (*MachineStatusStatus) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{14}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *MachineStatusStatus) GetReady() bool {
@@ -816,7 +1295,7 @@ type MaintenanceServiceConfigSpec struct {
 
 func (x *MaintenanceServiceConfigSpec) Reset() {
 	*x = MaintenanceServiceConfigSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[15]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -828,7 +1307,7 @@ func (x *MaintenanceServiceConfigSpec) String() string {
 func (*MaintenanceServiceConfigSpec) ProtoMessage() {}
 
 func (x *MaintenanceServiceConfigSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[15]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -841,7 +1320,7 @@ func (x *MaintenanceServiceConfigSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MaintenanceServiceConfigSpec.ProtoReflect.Descriptor instead.
 func (*MaintenanceServiceConfigSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{15}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *MaintenanceServiceConfigSpec) GetListenAddress() string {
@@ -858,6 +1337,83 @@ func (x *MaintenanceServiceConfigSpec) GetReachableAddresses() []*common.NetIP {
 	return nil
 }
 
+// MeasuredBootStatusSpec describes the PCR values the node booted with and the TPM event log summary.
+type MeasuredBootStatusSpec struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	PcrBank          string                 `protobuf:"bytes,1,opt,name=pcr_bank,json=pcrBank,proto3" json:"pcr_bank,omitempty"`
+	PcRs             []*PCRValueSpec        `protobuf:"bytes,2,rep,name=pc_rs,json=pcRs,proto3" json:"pc_rs,omitempty"`
+	EventLogDigest   string                 `protobuf:"bytes,3,opt,name=event_log_digest,json=eventLogDigest,proto3" json:"event_log_digest,omitempty"`
+	EventLogEvents   int64                  `protobuf:"varint,4,opt,name=event_log_events,json=eventLogEvents,proto3" json:"event_log_events,omitempty"`
+	EventLogVerified bool                   `protobuf:"varint,5,opt,name=event_log_verified,json=eventLogVerified,proto3" json:"event_log_verified,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MeasuredBootStatusSpec) Reset() {
+	*x = MeasuredBootStatusSpec{}
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MeasuredBootStatusSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeasuredBootStatusSpec) ProtoMessage() {}
+
+func (x *MeasuredBootStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeasuredBootStatusSpec.ProtoReflect.Descriptor instead.
+func (*MeasuredBootStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *MeasuredBootStatusSpec) GetPcrBank() string {
+	if x != nil {
+		return x.PcrBank
+	}
+	return ""
+}
+
+func (x *MeasuredBootStatusSpec) GetPcRs() []*PCRValueSpec {
+	if x != nil {
+		return x.PcRs
+	}
+	return nil
+}
+
+func (x *MeasuredBootStatusSpec) GetEventLogDigest() string {
+	if x != nil {
+		return x.EventLogDigest
+	}
+	return ""
+}
+
+func (x *MeasuredBootStatusSpec) GetEventLogEvents() int64 {
+	if x != nil {
+		return x.EventLogEvents
+	}
+	return 0
+}
+
+func (x *MeasuredBootStatusSpec) GetEventLogVerified() bool {
+	if x != nil {
+		return x.EventLogVerified
+	}
+	return false
+}
+
 // MetaKeySpec describes status of the defined sysctls.
 type MetaKeySpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -868,7 +1424,7 @@ type MetaKeySpec struct {
 
 func (x *MetaKeySpec) Reset() {
 	*x = MetaKeySpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[16]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -880,7 +1436,7 @@ func (x *MetaKeySpec) String() string {
 func (*MetaKeySpec) ProtoMessage() {}
 
 func (x *MetaKeySpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[16]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -893,7 +1449,7 @@ func (x *MetaKeySpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MetaKeySpec.ProtoReflect.Descriptor instead.
 func (*MetaKeySpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{16}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *MetaKeySpec) GetValue() string {
@@ -913,7 +1469,7 @@ type MetaLoadedSpec struct {
 
 func (x *MetaLoadedSpec) Reset() {
 	*x = MetaLoadedSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[17]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -925,7 +1481,7 @@ func (x *MetaLoadedSpec) String() string {
 func (*MetaLoadedSpec) ProtoMessage() {}
 
 func (x *MetaLoadedSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[17]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -938,7 +1494,7 @@ func (x *MetaLoadedSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MetaLoadedSpec.ProtoReflect.Descriptor instead.
 func (*MetaLoadedSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{17}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *MetaLoadedSpec) GetDone() bool {
@@ -963,7 +1519,7 @@ type MountStatusSpec struct {
 
 func (x *MountStatusSpec) Reset() {
 	*x = MountStatusSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[18]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -975,7 +1531,7 @@ func (x *MountStatusSpec) String() string {
 func (*MountStatusSpec) ProtoMessage() {}
 
 func (x *MountStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[18]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -988,7 +1544,7 @@ func (x *MountStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MountStatusSpec.ProtoReflect.Descriptor instead.
 func (*MountStatusSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{18}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *MountStatusSpec) GetSource() string {
@@ -1033,27 +1589,80 @@ func (x *MountStatusSpec) GetEncryptionProviders() []string {
 	return nil
 }
 
-// PlatformMetadataSpec describes platform metadata properties.
-type PlatformMetadataSpec struct {
+// PCRValueSpec describes a single PCR value.
+type PCRValueSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Platform      string                 `protobuf:"bytes,1,opt,name=platform,proto3" json:"platform,omitempty"`
-	Hostname      string                 `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
-	Region        string                 `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
-	Zone          string                 `protobuf:"bytes,4,opt,name=zone,proto3" json:"zone,omitempty"`
-	InstanceType  string                 `protobuf:"bytes,5,opt,name=instance_type,json=instanceType,proto3" json:"instance_type,omitempty"`
-	InstanceId    string                 `protobuf:"bytes,6,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
-	ProviderId    string                 `protobuf:"bytes,7,opt,name=provider_id,json=providerId,proto3" json:"provider_id,omitempty"`
-	Spot          bool                   `protobuf:"varint,8,opt,name=spot,proto3" json:"spot,omitempty"`
-	InternalDns   string                 `protobuf:"bytes,9,opt,name=internal_dns,json=internalDns,proto3" json:"internal_dns,omitempty"`
-	ExternalDns   string                 `protobuf:"bytes,10,opt,name=external_dns,json=externalDns,proto3" json:"external_dns,omitempty"`
-	Tags          map[string]string      `protobuf:"bytes,11,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Pcr           int64                  `protobuf:"varint,1,opt,name=pcr,proto3" json:"pcr,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlatformMetadataSpec) Reset() {
-	*x = PlatformMetadataSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[19]
+func (x *PCRValueSpec) Reset() {
+	*x = PCRValueSpec{}
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PCRValueSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PCRValueSpec) ProtoMessage() {}
+
+func (x *PCRValueSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PCRValueSpec.ProtoReflect.Descriptor instead.
+func (*PCRValueSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PCRValueSpec) GetPcr() int64 {
+	if x != nil {
+		return x.Pcr
+	}
+	return 0
+}
+
+func (x *PCRValueSpec) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// PlatformMetadataSpec describes platform metadata properties.
+type PlatformMetadataSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Platform      string                 `protobuf:"bytes,1,opt,name=platform,proto3" json:"platform,omitempty"`
+	Hostname      string                 `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Region        string                 `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
+	Zone          string                 `protobuf:"bytes,4,opt,name=zone,proto3" json:"zone,omitempty"`
+	InstanceType  string                 `protobuf:"bytes,5,opt,name=instance_type,json=instanceType,proto3" json:"instance_type,omitempty"`
+	InstanceId    string                 `protobuf:"bytes,6,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	ProviderId    string                 `protobuf:"bytes,7,opt,name=provider_id,json=providerId,proto3" json:"provider_id,omitempty"`
+	Spot          bool                   `protobuf:"varint,8,opt,name=spot,proto3" json:"spot,omitempty"`
+	InternalDns   string                 `protobuf:"bytes,9,opt,name=internal_dns,json=internalDns,proto3" json:"internal_dns,omitempty"`
+	ExternalDns   string                 `protobuf:"bytes,10,opt,name=external_dns,json=externalDns,proto3" json:"external_dns,omitempty"`
+	Tags          map[string]string      `protobuf:"bytes,11,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlatformMetadataSpec) Reset() {
+	*x = PlatformMetadataSpec{}
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1065,7 +1674,7 @@ func (x *PlatformMetadataSpec) String() string {
 func (*PlatformMetadataSpec) ProtoMessage() {}
 
 func (x *PlatformMetadataSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[19]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1078,7 +1687,7 @@ func (x *PlatformMetadataSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlatformMetadataSpec.ProtoReflect.Descriptor instead.
 func (*PlatformMetadataSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{19}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *PlatformMetadataSpec) GetPlatform() string {
@@ -1109,83 +1718,628 @@ func (x *PlatformMetadataSpec) GetZone() string {
 	return ""
 }
 
-func (x *PlatformMetadataSpec) GetInstanceType() string {
-	if x != nil {
-		return x.InstanceType
-	}
-	return ""
+func (x *PlatformMetadataSpec) GetInstanceType() string {
+	if x != nil {
+		return x.InstanceType
+	}
+	return ""
+}
+
+func (x *PlatformMetadataSpec) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *PlatformMetadataSpec) GetProviderId() string {
+	if x != nil {
+		return x.ProviderId
+	}
+	return ""
+}
+
+func (x *PlatformMetadataSpec) GetSpot() bool {
+	if x != nil {
+		return x.Spot
+	}
+	return false
+}
+
+func (x *PlatformMetadataSpec) GetInternalDns() string {
+	if x != nil {
+		return x.InternalDns
+	}
+	return ""
+}
+
+func (x *PlatformMetadataSpec) GetExternalDns() string {
+	if x != nil {
+		return x.ExternalDns
+	}
+	return ""
+}
+
+func (x *PlatformMetadataSpec) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// ProcessParentSpec describes the parent command of the zombie or orphaned processes.
+type ProcessParentSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Command       string                 `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"`
+	Count         uint64                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessParentSpec) Reset() {
+	*x = ProcessParentSpec{}
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessParentSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessParentSpec) ProtoMessage() {}
+
+func (x *ProcessParentSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessParentSpec.ProtoReflect.Descriptor instead.
+func (*ProcessParentSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ProcessParentSpec) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *ProcessParentSpec) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// ProcessReaperStatusSpec describes the reaped, orphaned and zombie processes.
+type ProcessReaperStatusSpec struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ReapedProcesses   uint64                 `protobuf:"varint,1,opt,name=reaped_processes,json=reapedProcesses,proto3" json:"reaped_processes,omitempty"`
+	OrphanedProcesses uint64                 `protobuf:"varint,2,opt,name=orphaned_processes,json=orphanedProcesses,proto3" json:"orphaned_processes,omitempty"`
+	ZombieProcesses   uint64                 `protobuf:"varint,3,opt,name=zombie_processes,json=zombieProcesses,proto3" json:"zombie_processes,omitempty"`
+	ZombieParents     []*ProcessParentSpec   `protobuf:"bytes,4,rep,name=zombie_parents,json=zombieParents,proto3" json:"zombie_parents,omitempty"`
+	OrphanParents     []*ProcessParentSpec   `protobuf:"bytes,5,rep,name=orphan_parents,json=orphanParents,proto3" json:"orphan_parents,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ProcessReaperStatusSpec) Reset() {
+	*x = ProcessReaperStatusSpec{}
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessReaperStatusSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessReaperStatusSpec) ProtoMessage() {}
+
+func (x *ProcessReaperStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessReaperStatusSpec.ProtoReflect.Descriptor instead.
+func (*ProcessReaperStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ProcessReaperStatusSpec) GetReapedProcesses() uint64 {
+	if x != nil {
+		return x.ReapedProcesses
+	}
+	return 0
+}
+
+func (x *ProcessReaperStatusSpec) GetOrphanedProcesses() uint64 {
+	if x != nil {
+		return x.OrphanedProcesses
+	}
+	return 0
+}
+
+func (x *ProcessReaperStatusSpec) GetZombieProcesses() uint64 {
+	if x != nil {
+		return x.ZombieProcesses
+	}
+	return 0
+}
+
+func (x *ProcessReaperStatusSpec) GetZombieParents() []*ProcessParentSpec {
+	if x != nil {
+		return x.ZombieParents
+	}
+	return nil
+}
+
+func (x *ProcessReaperStatusSpec) GetOrphanParents() []*ProcessParentSpec {
+	if x != nil {
+		return x.OrphanParents
+	}
+	return nil
+}
+
+// SBOMItemSpec describes the SBOM item resource properties.
+type SBOMItemSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	License       string                 `protobuf:"bytes,3,opt,name=license,proto3" json:"license,omitempty"`
+	CpEs          []string               `protobuf:"bytes,4,rep,name=cp_es,json=cpEs,proto3" json:"cp_es,omitempty"`
+	PurLs         []string               `protobuf:"bytes,5,rep,name=pur_ls,json=purLs,proto3" json:"pur_ls,omitempty"`
+	Extension     bool                   `protobuf:"varint,6,opt,name=extension,proto3" json:"extension,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SBOMItemSpec) Reset() {
+	*x = SBOMItemSpec{}
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SBOMItemSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SBOMItemSpec) ProtoMessage() {}
+
+func (x *SBOMItemSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SBOMItemSpec.ProtoReflect.Descriptor instead.
+func (*SBOMItemSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SBOMItemSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SBOMItemSpec) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *SBOMItemSpec) GetLicense() string {
+	if x != nil {
+		return x.License
+	}
+	return ""
+}
+
+func (x *SBOMItemSpec) GetCpEs() []string {
+	if x != nil {
+		return x.CpEs
+	}
+	return nil
+}
+
+func (x *SBOMItemSpec) GetPurLs() []string {
+	if x != nil {
+		return x.PurLs
+	}
+	return nil
+}
+
+func (x *SBOMItemSpec) GetExtension() bool {
+	if x != nil {
+		return x.Extension
+	}
+	return false
+}
+
+// SecureBootStatusSpec describes the UEFI SecureBoot state and the enrolled keys.
+type SecureBootStatusSpec struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	SecureBoot                bool                   `protobuf:"varint,1,opt,name=secure_boot,json=secureBoot,proto3" json:"secure_boot,omitempty"`
+	SetupMode                 bool                   `protobuf:"varint,2,opt,name=setup_mode,json=setupMode,proto3" json:"setup_mode,omitempty"`
+	PkFingerprints            []string               `protobuf:"bytes,3,rep,name=pk_fingerprints,json=pkFingerprints,proto3" json:"pk_fingerprints,omitempty"`
+	KekFingerprints           []string               `protobuf:"bytes,4,rep,name=kek_fingerprints,json=kekFingerprints,proto3" json:"kek_fingerprints,omitempty"`
+	DbFingerprints            []string               `protobuf:"bytes,5,rep,name=db_fingerprints,json=dbFingerprints,proto3" json:"db_fingerprints,omitempty"`
+	BootAuthorityFingerprints []string               `protobuf:"bytes,6,rep,name=boot_authority_fingerprints,json=bootAuthorityFingerprints,proto3" json:"boot_authority_fingerprints,omitempty"`
+	SigningKeyEnrolled        bool                   `protobuf:"varint,7,opt,name=signing_key_enrolled,json=signingKeyEnrolled,proto3" json:"signing_key_enrolled,omitempty"`
+	UkiVerified               bool                   `protobuf:"varint,8,opt,name=uki_verified,json=ukiVerified,proto3" json:"uki_verified,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *SecureBootStatusSpec) Reset() {
+	*x = SecureBootStatusSpec{}
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecureBootStatusSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecureBootStatusSpec) ProtoMessage() {}
+
+func (x *SecureBootStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecureBootStatusSpec.ProtoReflect.Descriptor instead.
+func (*SecureBootStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SecureBootStatusSpec) GetSecureBoot() bool {
+	if x != nil {
+		return x.SecureBoot
+	}
+	return false
+}
+
+func (x *SecureBootStatusSpec) GetSetupMode() bool {
+	if x != nil {
+		return x.SetupMode
+	}
+	return false
+}
+
+func (x *SecureBootStatusSpec) GetPkFingerprints() []string {
+	if x != nil {
+		return x.PkFingerprints
+	}
+	return nil
+}
+
+func (x *SecureBootStatusSpec) GetKekFingerprints() []string {
+	if x != nil {
+		return x.KekFingerprints
+	}
+	return nil
+}
+
+func (x *SecureBootStatusSpec) GetDbFingerprints() []string {
+	if x != nil {
+		return x.DbFingerprints
+	}
+	return nil
+}
+
+func (x *SecureBootStatusSpec) GetBootAuthorityFingerprints() []string {
+	if x != nil {
+		return x.BootAuthorityFingerprints
+	}
+	return nil
+}
+
+func (x *SecureBootStatusSpec) GetSigningKeyEnrolled() bool {
+	if x != nil {
+		return x.SigningKeyEnrolled
+	}
+	return false
+}
+
+func (x *SecureBootStatusSpec) GetUkiVerified() bool {
+	if x != nil {
+		return x.UkiVerified
+	}
+	return false
+}
+
+// SecurityStateSpec describes the security state resource properties.
+type SecurityStateSpec struct {
+	state                    protoimpl.MessageState    `protogen:"open.v1"`
+	SecureBoot               bool                      `protobuf:"varint,1,opt,name=secure_boot,json=secureBoot,proto3" json:"secure_boot,omitempty"`
+	UkiSigningKeyFingerprint string                    `protobuf:"bytes,2,opt,name=uki_signing_key_fingerprint,json=ukiSigningKeyFingerprint,proto3" json:"uki_signing_key_fingerprint,omitempty"`
+	PcrSigningKeyFingerprint string                    `protobuf:"bytes,3,opt,name=pcr_signing_key_fingerprint,json=pcrSigningKeyFingerprint,proto3" json:"pcr_signing_key_fingerprint,omitempty"`
+	SeLinuxState             enums.RuntimeSELinuxState `protobuf:"varint,4,opt,name=se_linux_state,json=seLinuxState,proto3,enum=talos.resource.definitions.enums.RuntimeSELinuxState" json:"se_linux_state,omitempty"`
+	BootedWithUki            bool                      `protobuf:"varint,5,opt,name=booted_with_uki,json=bootedWithUki,proto3" json:"booted_with_uki,omitempty"`
+	FipsState                enums.RuntimeFIPSState    `protobuf:"varint,6,opt,name=fips_state,json=fipsState,proto3,enum=talos.resource.definitions.enums.RuntimeFIPSState" json:"fips_state,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *SecurityStateSpec) Reset() {
+	*x = SecurityStateSpec{}
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecurityStateSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecurityStateSpec) ProtoMessage() {}
+
+func (x *SecurityStateSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecurityStateSpec.ProtoReflect.Descriptor instead.
+func (*SecurityStateSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SecurityStateSpec) GetSecureBoot() bool {
+	if x != nil {
+		return x.SecureBoot
+	}
+	return false
+}
+
+func (x *SecurityStateSpec) GetUkiSigningKeyFingerprint() string {
+	if x != nil {
+		return x.UkiSigningKeyFingerprint
+	}
+	return ""
+}
+
+func (x *SecurityStateSpec) GetPcrSigningKeyFingerprint() string {
+	if x != nil {
+		return x.PcrSigningKeyFingerprint
+	}
+	return ""
+}
+
+func (x *SecurityStateSpec) GetSeLinuxState() enums.RuntimeSELinuxState {
+	if x != nil {
+		return x.SeLinuxState
+	}
+	return enums.RuntimeSELinuxState(0)
+}
+
+func (x *SecurityStateSpec) GetBootedWithUki() bool {
+	if x != nil {
+		return x.BootedWithUki
+	}
+	return false
+}
+
+func (x *SecurityStateSpec) GetFipsState() enums.RuntimeFIPSState {
+	if x != nil {
+		return x.FipsState
+	}
+	return enums.RuntimeFIPSState(0)
+}
+
+// ServiceResourcesStatusSpec describes the effective resource protection of a system service.
+type ServiceResourcesStatusSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CgroupPath    string                 `protobuf:"bytes,1,opt,name=cgroup_path,json=cgroupPath,proto3" json:"cgroup_path,omitempty"`
+	OomScoreAdj   int64                  `protobuf:"varint,2,opt,name=oom_score_adj,json=oomScoreAdj,proto3" json:"oom_score_adj,omitempty"`
+	MemoryMin     uint64                 `protobuf:"varint,3,opt,name=memory_min,json=memoryMin,proto3" json:"memory_min,omitempty"`
+	MemoryLow     uint64                 `protobuf:"varint,4,opt,name=memory_low,json=memoryLow,proto3" json:"memory_low,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceResourcesStatusSpec) Reset() {
+	*x = ServiceResourcesStatusSpec{}
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceResourcesStatusSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceResourcesStatusSpec) ProtoMessage() {}
+
+func (x *ServiceResourcesStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceResourcesStatusSpec.ProtoReflect.Descriptor instead.
+func (*ServiceResourcesStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ServiceResourcesStatusSpec) GetCgroupPath() string {
+	if x != nil {
+		return x.CgroupPath
+	}
+	return ""
+}
+
+func (x *ServiceResourcesStatusSpec) GetOomScoreAdj() int64 {
+	if x != nil {
+		return x.OomScoreAdj
+	}
+	return 0
+}
+
+func (x *ServiceResourcesStatusSpec) GetMemoryMin() uint64 {
+	if x != nil {
+		return x.MemoryMin
+	}
+	return 0
+}
+
+func (x *ServiceResourcesStatusSpec) GetMemoryLow() uint64 {
+	if x != nil {
+		return x.MemoryLow
+	}
+	return 0
+}
+
+// ShutdownInhibitorSpec describes a shutdown inhibitor registered via the machine API.
+type ShutdownInhibitorSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Who           string                 `protobuf:"bytes,1,opt,name=who,proto3" json:"who,omitempty"`
+	Why           string                 `protobuf:"bytes,2,opt,name=why,proto3" json:"why,omitempty"`
+	MaxHold       *durationpb.Duration   `protobuf:"bytes,3,opt,name=max_hold,json=maxHold,proto3" json:"max_hold,omitempty"`
+	Acquired      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=acquired,proto3" json:"acquired,omitempty"`
+	Owner         string                 `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShutdownInhibitorSpec) Reset() {
+	*x = ShutdownInhibitorSpec{}
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShutdownInhibitorSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShutdownInhibitorSpec) ProtoMessage() {}
+
+func (x *ShutdownInhibitorSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShutdownInhibitorSpec.ProtoReflect.Descriptor instead.
+func (*ShutdownInhibitorSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *PlatformMetadataSpec) GetInstanceId() string {
+func (x *ShutdownInhibitorSpec) GetWho() string {
 	if x != nil {
-		return x.InstanceId
+		return x.Who
 	}
 	return ""
 }
 
-func (x *PlatformMetadataSpec) GetProviderId() string {
+func (x *ShutdownInhibitorSpec) GetWhy() string {
 	if x != nil {
-		return x.ProviderId
+		return x.Why
 	}
 	return ""
 }
 
-func (x *PlatformMetadataSpec) GetSpot() bool {
+func (x *ShutdownInhibitorSpec) GetMaxHold() *durationpb.Duration {
 	if x != nil {
-		return x.Spot
+		return x.MaxHold
 	}
-	return false
+	return nil
 }
 
-func (x *PlatformMetadataSpec) GetInternalDns() string {
+func (x *ShutdownInhibitorSpec) GetAcquired() *timestamppb.Timestamp {
 	if x != nil {
-		return x.InternalDns
+		return x.Acquired
 	}
-	return ""
+	return nil
 }
 
-func (x *PlatformMetadataSpec) GetExternalDns() string {
+func (x *ShutdownInhibitorSpec) GetOwner() string {
 	if x != nil {
-		return x.ExternalDns
+		return x.Owner
 	}
 	return ""
 }
 
-func (x *PlatformMetadataSpec) GetTags() map[string]string {
-	if x != nil {
-		return x.Tags
-	}
-	return nil
-}
-
-// SBOMItemSpec describes the SBOM item resource properties.
-type SBOMItemSpec struct {
+// SysctlFailure describes a kernel param which failed to be applied.
+type SysctlFailure struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	License       string                 `protobuf:"bytes,3,opt,name=license,proto3" json:"license,omitempty"`
-	CpEs          []string               `protobuf:"bytes,4,rep,name=cp_es,json=cpEs,proto3" json:"cp_es,omitempty"`
-	PurLs         []string               `protobuf:"bytes,5,rep,name=pur_ls,json=purLs,proto3" json:"pur_ls,omitempty"`
-	Extension     bool                   `protobuf:"varint,6,opt,name=extension,proto3" json:"extension,omitempty"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Errno         uint32                 `protobuf:"varint,4,opt,name=errno,proto3" json:"errno,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SBOMItemSpec) Reset() {
-	*x = SBOMItemSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[20]
+func (x *SysctlFailure) Reset() {
+	*x = SysctlFailure{}
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SBOMItemSpec) String() string {
+func (x *SysctlFailure) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SBOMItemSpec) ProtoMessage() {}
+func (*SysctlFailure) ProtoMessage() {}
 
-func (x *SBOMItemSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[20]
+func (x *SysctlFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1196,81 +2350,126 @@ func (x *SBOMItemSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SBOMItemSpec.ProtoReflect.Descriptor instead.
-func (*SBOMItemSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use SysctlFailure.ProtoReflect.Descriptor instead.
+func (*SysctlFailure) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *SBOMItemSpec) GetName() string {
+func (x *SysctlFailure) GetKey() string {
 	if x != nil {
-		return x.Name
+		return x.Key
 	}
 	return ""
 }
 
-func (x *SBOMItemSpec) GetVersion() string {
+func (x *SysctlFailure) GetValue() string {
 	if x != nil {
-		return x.Version
+		return x.Value
 	}
 	return ""
 }
 
-func (x *SBOMItemSpec) GetLicense() string {
+func (x *SysctlFailure) GetError() string {
 	if x != nil {
-		return x.License
+		return x.Error
 	}
 	return ""
 }
 
-func (x *SBOMItemSpec) GetCpEs() []string {
+func (x *SysctlFailure) GetErrno() uint32 {
 	if x != nil {
-		return x.CpEs
+		return x.Errno
+	}
+	return 0
+}
+
+// SysctlStatusSpec describes the result of applying kernel params.
+type SysctlStatusSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Applied       []string               `protobuf:"bytes,1,rep,name=applied,proto3" json:"applied,omitempty"`
+	Failed        []*SysctlFailure       `protobuf:"bytes,2,rep,name=failed,proto3" json:"failed,omitempty"`
+	Skipped       []string               `protobuf:"bytes,3,rep,name=skipped,proto3" json:"skipped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SysctlStatusSpec) Reset() {
+	*x = SysctlStatusSpec{}
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SysctlStatusSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SysctlStatusSpec) ProtoMessage() {}
+
+func (x *SysctlStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SysctlStatusSpec.ProtoReflect.Descriptor instead.
+func (*SysctlStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SysctlStatusSpec) GetApplied() []string {
+	if x != nil {
+		return x.Applied
 	}
 	return nil
 }
 
-func (x *SBOMItemSpec) GetPurLs() []string {
+func (x *SysctlStatusSpec) GetFailed() []*SysctlFailure {
 	if x != nil {
-		return x.PurLs
+		return x.Failed
 	}
 	return nil
 }
 
-func (x *SBOMItemSpec) GetExtension() bool {
+func (x *SysctlStatusSpec) GetSkipped() []string {
 	if x != nil {
-		return x.Extension
+		return x.Skipped
 	}
-	return false
+	return nil
 }
 
-// SecurityStateSpec describes the security state resource properties.
-type SecurityStateSpec struct {
-	state                    protoimpl.MessageState    `protogen:"open.v1"`
-	SecureBoot               bool                      `protobuf:"varint,1,opt,name=secure_boot,json=secureBoot,proto3" json:"secure_boot,omitempty"`
-	UkiSigningKeyFingerprint string                    `protobuf:"bytes,2,opt,name=uki_signing_key_fingerprint,json=ukiSigningKeyFingerprint,proto3" json:"uki_signing_key_fingerprint,omitempty"`
-	PcrSigningKeyFingerprint string                    `protobuf:"bytes,3,opt,name=pcr_signing_key_fingerprint,json=pcrSigningKeyFingerprint,proto3" json:"pcr_signing_key_fingerprint,omitempty"`
-	SeLinuxState             enums.RuntimeSELinuxState `protobuf:"varint,4,opt,name=se_linux_state,json=seLinuxState,proto3,enum=talos.resource.definitions.enums.RuntimeSELinuxState" json:"se_linux_state,omitempty"`
-	BootedWithUki            bool                      `protobuf:"varint,5,opt,name=booted_with_uki,json=bootedWithUki,proto3" json:"booted_with_uki,omitempty"`
-	FipsState                enums.RuntimeFIPSState    `protobuf:"varint,6,opt,name=fips_state,json=fipsState,proto3,enum=talos.resource.definitions.enums.RuntimeFIPSState" json:"fips_state,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+// TPMStatusSpec describes the TPM 2.0 device.
+type TPMStatusSpec struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Manufacturer    string                 `protobuf:"bytes,1,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
+	VendorInfo      string                 `protobuf:"bytes,2,opt,name=vendor_info,json=vendorInfo,proto3" json:"vendor_info,omitempty"`
+	FirmwareVersion string                 `protobuf:"bytes,3,opt,name=firmware_version,json=firmwareVersion,proto3" json:"firmware_version,omitempty"`
+	PcrBanks        []string               `protobuf:"bytes,4,rep,name=pcr_banks,json=pcrBanks,proto3" json:"pcr_banks,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *SecurityStateSpec) Reset() {
-	*x = SecurityStateSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[21]
+func (x *TPMStatusSpec) Reset() {
+	*x = TPMStatusSpec{}
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SecurityStateSpec) String() string {
+func (x *TPMStatusSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SecurityStateSpec) ProtoMessage() {}
+func (*TPMStatusSpec) ProtoMessage() {}
 
-func (x *SecurityStateSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[21]
+func (x *TPMStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1281,51 +2480,37 @@ func (x *SecurityStateSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SecurityStateSpec.ProtoReflect.Descriptor instead.
-func (*SecurityStateSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *SecurityStateSpec) GetSecureBoot() bool {
-	if x != nil {
-		return x.SecureBoot
-	}
-	return false
+// Deprecated: Use TPMStatusSpec.ProtoReflect.Descriptor instead.
+func (*TPMStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *SecurityStateSpec) GetUkiSigningKeyFingerprint() string {
+func (x *TPMStatusSpec) GetManufacturer() string {
 	if x != nil {
-		return x.UkiSigningKeyFingerprint
+		return x.Manufacturer
 	}
 	return ""
 }
 
-func (x *SecurityStateSpec) GetPcrSigningKeyFingerprint() string {
+func (x *TPMStatusSpec) GetVendorInfo() string {
 	if x != nil {
-		return x.PcrSigningKeyFingerprint
+		return x.VendorInfo
 	}
 	return ""
 }
 
-func (x *SecurityStateSpec) GetSeLinuxState() enums.RuntimeSELinuxState {
-	if x != nil {
-		return x.SeLinuxState
-	}
-	return enums.RuntimeSELinuxState(0)
-}
-
-func (x *SecurityStateSpec) GetBootedWithUki() bool {
+func (x *TPMStatusSpec) GetFirmwareVersion() string {
 	if x != nil {
-		return x.BootedWithUki
+		return x.FirmwareVersion
 	}
-	return false
+	return ""
 }
 
-func (x *SecurityStateSpec) GetFipsState() enums.RuntimeFIPSState {
+func (x *TPMStatusSpec) GetPcrBanks() []string {
 	if x != nil {
-		return x.FipsState
+		return x.PcrBanks
 	}
-	return enums.RuntimeFIPSState(0)
+	return nil
 }
 
 // UniqueMachineTokenSpec is the spec for the machine unique token. Token can be empty if machine wasn't assigned any.
@@ -1338,7 +2523,7 @@ type UniqueMachineTokenSpec struct {
 
 func (x *UniqueMachineTokenSpec) Reset() {
 	*x = UniqueMachineTokenSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[22]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1350,7 +2535,7 @@ func (x *UniqueMachineTokenSpec) String() string {
 func (*UniqueMachineTokenSpec) ProtoMessage() {}
 
 func (x *UniqueMachineTokenSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[22]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1363,7 +2548,7 @@ func (x *UniqueMachineTokenSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UniqueMachineTokenSpec.ProtoReflect.Descriptor instead.
 func (*UniqueMachineTokenSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{22}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *UniqueMachineTokenSpec) GetToken() string {
@@ -1384,7 +2569,7 @@ type UnmetCondition struct {
 
 func (x *UnmetCondition) Reset() {
 	*x = UnmetCondition{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[23]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1396,7 +2581,7 @@ func (x *UnmetCondition) String() string {
 func (*UnmetCondition) ProtoMessage() {}
 
 func (x *UnmetCondition) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[23]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1409,7 +2594,7 @@ func (x *UnmetCondition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnmetCondition.ProtoReflect.Descriptor instead.
 func (*UnmetCondition) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{23}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *UnmetCondition) GetName() string {
@@ -1426,18 +2611,121 @@ func (x *UnmetCondition) GetReason() string {
 	return ""
 }
 
-// WatchdogTimerConfigSpec describes configuration of watchdog timer.
-type WatchdogTimerConfigSpec struct {
+// UpgradeStatusSpec describes the last upgrade attempt.
+type UpgradeStatusSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Device        string                 `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
-	Timeout       *durationpb.Duration   `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Image         string                 `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	FromVersion   string                 `protobuf:"bytes,2,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"`
+	ToVersion     string                 `protobuf:"bytes,3,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"`
+	Phase         string                 `protobuf:"bytes,4,opt,name=phase,proto3" json:"phase,omitempty"`
+	Outcome       string                 `protobuf:"bytes,5,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Message       string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *UpgradeStatusSpec) Reset() {
+	*x = UpgradeStatusSpec{}
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpgradeStatusSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradeStatusSpec) ProtoMessage() {}
+
+func (x *UpgradeStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpgradeStatusSpec.ProtoReflect.Descriptor instead.
+func (*UpgradeStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UpgradeStatusSpec) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *UpgradeStatusSpec) GetFromVersion() string {
+	if x != nil {
+		return x.FromVersion
+	}
+	return ""
+}
+
+func (x *UpgradeStatusSpec) GetToVersion() string {
+	if x != nil {
+		return x.ToVersion
+	}
+	return ""
+}
+
+func (x *UpgradeStatusSpec) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *UpgradeStatusSpec) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *UpgradeStatusSpec) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpgradeStatusSpec) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *UpgradeStatusSpec) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// WatchdogTimerConfigSpec describes configuration of watchdog timer.
+type WatchdogTimerConfigSpec struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Device         string                 `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	Timeout        *durationpb.Duration   `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	RequireKubelet bool                   `protobuf:"varint,3,opt,name=require_kubelet,json=requireKubelet,proto3" json:"require_kubelet,omitempty"`
+	ProbeUrl       string                 `protobuf:"bytes,4,opt,name=probe_url,json=probeUrl,proto3" json:"probe_url,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
 func (x *WatchdogTimerConfigSpec) Reset() {
 	*x = WatchdogTimerConfigSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[24]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1449,7 +2737,7 @@ func (x *WatchdogTimerConfigSpec) String() string {
 func (*WatchdogTimerConfigSpec) ProtoMessage() {}
 
 func (x *WatchdogTimerConfigSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[24]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1462,7 +2750,7 @@ func (x *WatchdogTimerConfigSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WatchdogTimerConfigSpec.ProtoReflect.Descriptor instead.
 func (*WatchdogTimerConfigSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{24}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *WatchdogTimerConfigSpec) GetDevice() string {
@@ -1479,19 +2767,35 @@ func (x *WatchdogTimerConfigSpec) GetTimeout() *durationpb.Duration {
 	return nil
 }
 
+func (x *WatchdogTimerConfigSpec) GetRequireKubelet() bool {
+	if x != nil {
+		return x.RequireKubelet
+	}
+	return false
+}
+
+func (x *WatchdogTimerConfigSpec) GetProbeUrl() string {
+	if x != nil {
+		return x.ProbeUrl
+	}
+	return ""
+}
+
 // WatchdogTimerStatusSpec describes configuration of watchdog timer.
 type WatchdogTimerStatusSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Device        string                 `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
 	Timeout       *durationpb.Duration   `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
 	FeedInterval  *durationpb.Duration   `protobuf:"bytes,3,opt,name=feed_interval,json=feedInterval,proto3" json:"feed_interval,omitempty"`
+	LastFeed      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_feed,json=lastFeed,proto3" json:"last_feed,omitempty"`
+	FeedBlockedBy string                 `protobuf:"bytes,5,opt,name=feed_blocked_by,json=feedBlockedBy,proto3" json:"feed_blocked_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *WatchdogTimerStatusSpec) Reset() {
 	*x = WatchdogTimerStatusSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[25]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1503,7 +2807,7 @@ func (x *WatchdogTimerStatusSpec) String() string {
 func (*WatchdogTimerStatusSpec) ProtoMessage() {}
 
 func (x *WatchdogTimerStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[25]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1516,7 +2820,7 @@ func (x *WatchdogTimerStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WatchdogTimerStatusSpec.ProtoReflect.Descriptor instead.
 func (*WatchdogTimerStatusSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{25}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *WatchdogTimerStatusSpec) GetDevice() string {
@@ -1540,13 +2844,35 @@ func (x *WatchdogTimerStatusSpec) GetFeedInterval() *durationpb.Duration {
 	return nil
 }
 
+func (x *WatchdogTimerStatusSpec) GetLastFeed() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastFeed
+	}
+	return nil
+}
+
+func (x *WatchdogTimerStatusSpec) GetFeedBlockedBy() string {
+	if x != nil {
+		return x.FeedBlockedBy
+	}
+	return ""
+}
+
 var File_resource_definitions_runtime_runtime_proto protoreflect.FileDescriptor
 
 const file_resource_definitions_runtime_runtime_proto_rawDesc = "" +
 	"\n" +
-	"*resource/definitions/runtime/runtime.proto\x12\"talos.resource.definitions.runtime\x1a\x13common/common.proto\x1a\x1egoogle/protobuf/duration.proto\x1a&resource/definitions/enums/enums.proto\"4\n" +
+	"*resource/definitions/runtime/runtime.proto\x12\"talos.resource.definitions.runtime\x1a\x13common/common.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a&resource/definitions/enums/enums.proto\"4\n" +
 	"\x0fBootedEntrySpec\x12!\n" +
-	"\fbooted_entry\x18\x01 \x01(\tR\vbootedEntry\")\n" +
+	"\fbooted_entry\x18\x01 \x01(\tR\vbootedEntry\"o\n" +
+	"\x17ConfigValidationFinding\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04path\x18\x03 \x01(\tR\x04path\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\x9c\x01\n" +
+	"\x1aConfigValidationStatusSpec\x12%\n" +
+	"\x0econfig_version\x18\x01 \x01(\tR\rconfigVersion\x12W\n" +
+	"\bwarnings\x18\x02 \x03(\v2;.talos.resource.definitions.runtime.ConfigValidationFindingR\bwarnings\")\n" +
 	"\x11DevicesStatusSpec\x12\x14\n" +
 	"\x05ready\x18\x01 \x01(\bR\x05ready\"D\n" +
 	"\x0eDiagnosticSpec\x12\x18\n" +
@@ -1562,23 +2888,61 @@ const file_resource_definitions_runtime_runtime_proto_rawDesc = "" +
 	"\x05files\x18\x01 \x03(\v2>.talos.resource.definitions.runtime.ExtensionServiceConfigFileR\x05files\x12 \n" +
 	"\venvironment\x18\x02 \x03(\tR\venvironment\"E\n" +
 	" ExtensionServiceConfigStatusSpec\x12!\n" +
-	"\fspec_version\x18\x01 \x01(\tR\vspecVersion\"-\n" +
+	"\fspec_version\x18\x01 \x01(\tR\vspecVersion\"\xb4\x01\n" +
+	"\x1fExtensionVerificationStatusSpec\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
+	"\timage_ref\x18\x02 \x01(\tR\bimageRef\x12\x16\n" +
+	"\x06digest\x18\x03 \x01(\tR\x06digest\x12\x1a\n" +
+	"\bverified\x18\x04 \x01(\bR\bverified\x12\x16\n" +
+	"\x06signer\x18\x05 \x01(\tR\x06signer\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\"-\n" +
 	"\x11KernelCmdlineSpec\x12\x18\n" +
-	"\acmdline\x18\x01 \x01(\tR\acmdline\"J\n" +
+	"\acmdline\x18\x01 \x01(\tR\acmdline\"`\n" +
 	"\x14KernelModuleSpecSpec\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1e\n" +
 	"\n" +
 	"parameters\x18\x02 \x03(\tR\n" +
-	"parameters\"P\n" +
+	"parameters\x12\x14\n" +
+	"\x05order\x18\x03 \x01(\x03R\x05order\"\x8f\x01\n" +
+	"\x16KernelModuleStatusSpec\x12\x1e\n" +
+	"\n" +
+	"parameters\x18\x01 \x03(\tR\n" +
+	"parameters\x12\x16\n" +
+	"\x06loaded\x18\x02 \x01(\bR\x06loaded\x12'\n" +
+	"\x0freboot_required\x18\x03 \x01(\bR\x0erebootRequired\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"P\n" +
 	"\x13KernelParamSpecSpec\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\tR\x05value\x12#\n" +
 	"\rignore_errors\x18\x02 \x01(\bR\fignoreErrors\"m\n" +
 	"\x15KernelParamStatusSpec\x12\x18\n" +
 	"\acurrent\x18\x01 \x01(\tR\acurrent\x12\x18\n" +
 	"\adefault\x18\x02 \x01(\tR\adefault\x12 \n" +
-	"\vunsupported\x18\x03 \x01(\bR\vunsupported\"D\n" +
+	"\vunsupported\x18\x03 \x01(\bR\vunsupported\"\xa4\x02\n" +
+	"\x11KmsgLogBufferSpec\x12 \n" +
+	"\vdestination\x18\x01 \x01(\tR\vdestination\x12\x1f\n" +
+	"\vmemory_size\x18\x02 \x01(\x04R\n" +
+	"memorySize\x12\x1d\n" +
+	"\n" +
+	"spool_size\x18\x03 \x01(\x04R\tspoolSize\x12\x1f\n" +
+	"\vdrop_policy\x18\x04 \x01(\tR\n" +
+	"dropPolicy\x12E\n" +
+	"\x11retry_min_backoff\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x0fretryMinBackoff\x12E\n" +
+	"\x11retry_max_backoff\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\x0fretryMaxBackoff\"\xd3\x01\n" +
 	"\x11KmsgLogConfigSpec\x12/\n" +
-	"\fdestinations\x18\x01 \x03(\v2\v.common.URLR\fdestinations\"\xa9\x01\n" +
+	"\fdestinations\x18\x01 \x03(\v2\v.common.URLR\fdestinations\x12<\n" +
+	"\x13syslog_destinations\x18\x02 \x03(\v2\v.common.URLR\x12syslogDestinations\x12O\n" +
+	"\abuffers\x18\x03 \x03(\v25.talos.resource.definitions.runtime.KmsgLogBufferSpecR\abuffers\"\x8d\x02\n" +
+	"\x19KmsgLogDeliveryStatusSpec\x12 \n" +
+	"\vdestination\x18\x01 \x01(\tR\vdestination\x12\x1d\n" +
+	"\n" +
+	"sent_bytes\x18\x02 \x01(\x04R\tsentBytes\x12#\n" +
+	"\rdropped_bytes\x18\x03 \x01(\x04R\fdroppedBytes\x12#\n" +
+	"\rspooled_bytes\x18\x04 \x01(\x04R\fspooledBytes\x12%\n" +
+	"\x0ebuffered_bytes\x18\x05 \x01(\x04R\rbufferedBytes\x12\x1f\n" +
+	"\vspool_bytes\x18\x06 \x01(\x04R\n" +
+	"spoolBytes\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\a \x01(\tR\tlastError\"\xa9\x01\n" +
 	"\x16LoadedKernelModuleSpec\x12\x12\n" +
 	"\x04size\x18\x01 \x01(\x03R\x04size\x12'\n" +
 	"\x0freference_count\x18\x02 \x01(\x03R\x0ereferenceCount\x12\"\n" +
@@ -1593,7 +2957,13 @@ const file_resource_definitions_runtime_runtime_proto_rawDesc = "" +
 	"\x10unmet_conditions\x18\x02 \x03(\v22.talos.resource.definitions.runtime.UnmetConditionR\x0funmetConditions\"\x85\x01\n" +
 	"\x1cMaintenanceServiceConfigSpec\x12%\n" +
 	"\x0elisten_address\x18\x01 \x01(\tR\rlistenAddress\x12>\n" +
-	"\x13reachable_addresses\x18\x02 \x03(\v2\r.common.NetIPR\x12reachableAddresses\"#\n" +
+	"\x13reachable_addresses\x18\x02 \x03(\v2\r.common.NetIPR\x12reachableAddresses\"\xfc\x01\n" +
+	"\x16MeasuredBootStatusSpec\x12\x19\n" +
+	"\bpcr_bank\x18\x01 \x01(\tR\apcrBank\x12E\n" +
+	"\x05pc_rs\x18\x02 \x03(\v20.talos.resource.definitions.runtime.PCRValueSpecR\x04pcRs\x12(\n" +
+	"\x10event_log_digest\x18\x03 \x01(\tR\x0eeventLogDigest\x12(\n" +
+	"\x10event_log_events\x18\x04 \x01(\x03R\x0eeventLogEvents\x12,\n" +
+	"\x12event_log_verified\x18\x05 \x01(\bR\x10eventLogVerified\"#\n" +
 	"\vMetaKeySpec\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\tR\x05value\"$\n" +
 	"\x0eMetaLoadedSpec\x12\x12\n" +
@@ -1604,7 +2974,10 @@ const file_resource_definitions_runtime_runtime_proto_rawDesc = "" +
 	"\x0ffilesystem_type\x18\x03 \x01(\tR\x0efilesystemType\x12\x18\n" +
 	"\aoptions\x18\x04 \x03(\tR\aoptions\x12\x1c\n" +
 	"\tencrypted\x18\x05 \x01(\bR\tencrypted\x121\n" +
-	"\x14encryption_providers\x18\x06 \x03(\tR\x13encryptionProviders\"\xcc\x03\n" +
+	"\x14encryption_providers\x18\x06 \x03(\tR\x13encryptionProviders\"6\n" +
+	"\fPCRValueSpec\x12\x10\n" +
+	"\x03pcr\x18\x01 \x01(\x03R\x03pcr\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"\xcc\x03\n" +
 	"\x14PlatformMetadataSpec\x12\x1a\n" +
 	"\bplatform\x18\x01 \x01(\tR\bplatform\x12\x1a\n" +
 	"\bhostname\x18\x02 \x01(\tR\bhostname\x12\x16\n" +
@@ -1622,14 +2995,34 @@ const file_resource_definitions_runtime_runtime_proto_rawDesc = "" +
 	"\x04tags\x18\v \x03(\v2B.talos.resource.definitions.runtime.PlatformMetadataSpec.TagsEntryR\x04tags\x1a7\n" +
 	"\tTagsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa0\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"C\n" +
+	"\x11ProcessParentSpec\x12\x18\n" +
+	"\acommand\x18\x01 \x01(\tR\acommand\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x04R\x05count\"\xda\x02\n" +
+	"\x17ProcessReaperStatusSpec\x12)\n" +
+	"\x10reaped_processes\x18\x01 \x01(\x04R\x0freapedProcesses\x12-\n" +
+	"\x12orphaned_processes\x18\x02 \x01(\x04R\x11orphanedProcesses\x12)\n" +
+	"\x10zombie_processes\x18\x03 \x01(\x04R\x0fzombieProcesses\x12\\\n" +
+	"\x0ezombie_parents\x18\x04 \x03(\v25.talos.resource.definitions.runtime.ProcessParentSpecR\rzombieParents\x12\\\n" +
+	"\x0eorphan_parents\x18\x05 \x03(\v25.talos.resource.definitions.runtime.ProcessParentSpecR\rorphanParents\"\xa0\x01\n" +
 	"\fSBOMItemSpec\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
 	"\aversion\x18\x02 \x01(\tR\aversion\x12\x18\n" +
 	"\alicense\x18\x03 \x01(\tR\alicense\x12\x13\n" +
 	"\x05cp_es\x18\x04 \x03(\tR\x04cpEs\x12\x15\n" +
 	"\x06pur_ls\x18\x05 \x03(\tR\x05purLs\x12\x1c\n" +
-	"\textension\x18\x06 \x01(\bR\textension\"\x8a\x03\n" +
+	"\textension\x18\x06 \x01(\bR\textension\"\xe8\x02\n" +
+	"\x14SecureBootStatusSpec\x12\x1f\n" +
+	"\vsecure_boot\x18\x01 \x01(\bR\n" +
+	"secureBoot\x12\x1d\n" +
+	"\n" +
+	"setup_mode\x18\x02 \x01(\bR\tsetupMode\x12'\n" +
+	"\x0fpk_fingerprints\x18\x03 \x03(\tR\x0epkFingerprints\x12)\n" +
+	"\x10kek_fingerprints\x18\x04 \x03(\tR\x0fkekFingerprints\x12'\n" +
+	"\x0fdb_fingerprints\x18\x05 \x03(\tR\x0edbFingerprints\x12>\n" +
+	"\x1bboot_authority_fingerprints\x18\x06 \x03(\tR\x19bootAuthorityFingerprints\x120\n" +
+	"\x14signing_key_enrolled\x18\a \x01(\bR\x12signingKeyEnrolled\x12!\n" +
+	"\fuki_verified\x18\b \x01(\bR\vukiVerified\"\x8a\x03\n" +
 	"\x11SecurityStateSpec\x12\x1f\n" +
 	"\vsecure_boot\x18\x01 \x01(\bR\n" +
 	"secureBoot\x12=\n" +
@@ -1638,19 +3031,64 @@ const file_resource_definitions_runtime_runtime_proto_rawDesc = "" +
 	"\x0ese_linux_state\x18\x04 \x01(\x0e25.talos.resource.definitions.enums.RuntimeSELinuxStateR\fseLinuxState\x12&\n" +
 	"\x0fbooted_with_uki\x18\x05 \x01(\bR\rbootedWithUki\x12Q\n" +
 	"\n" +
-	"fips_state\x18\x06 \x01(\x0e22.talos.resource.definitions.enums.RuntimeFIPSStateR\tfipsState\".\n" +
+	"fips_state\x18\x06 \x01(\x0e22.talos.resource.definitions.enums.RuntimeFIPSStateR\tfipsState\"\x9f\x01\n" +
+	"\x1aServiceResourcesStatusSpec\x12\x1f\n" +
+	"\vcgroup_path\x18\x01 \x01(\tR\n" +
+	"cgroupPath\x12\"\n" +
+	"\room_score_adj\x18\x02 \x01(\x03R\voomScoreAdj\x12\x1d\n" +
+	"\n" +
+	"memory_min\x18\x03 \x01(\x04R\tmemoryMin\x12\x1d\n" +
+	"\n" +
+	"memory_low\x18\x04 \x01(\x04R\tmemoryLow\"\xbf\x01\n" +
+	"\x15ShutdownInhibitorSpec\x12\x10\n" +
+	"\x03who\x18\x01 \x01(\tR\x03who\x12\x10\n" +
+	"\x03why\x18\x02 \x01(\tR\x03why\x124\n" +
+	"\bmax_hold\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\amaxHold\x126\n" +
+	"\bacquired\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bacquired\x12\x14\n" +
+	"\x05owner\x18\x05 \x01(\tR\x05owner\"c\n" +
+	"\rSysctlFailure\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12\x14\n" +
+	"\x05errno\x18\x04 \x01(\rR\x05errno\"\x91\x01\n" +
+	"\x10SysctlStatusSpec\x12\x18\n" +
+	"\aapplied\x18\x01 \x03(\tR\aapplied\x12I\n" +
+	"\x06failed\x18\x02 \x03(\v21.talos.resource.definitions.runtime.SysctlFailureR\x06failed\x12\x18\n" +
+	"\askipped\x18\x03 \x03(\tR\askipped\"\x9c\x01\n" +
+	"\rTPMStatusSpec\x12\"\n" +
+	"\fmanufacturer\x18\x01 \x01(\tR\fmanufacturer\x12\x1f\n" +
+	"\vvendor_info\x18\x02 \x01(\tR\n" +
+	"vendorInfo\x12)\n" +
+	"\x10firmware_version\x18\x03 \x01(\tR\x0ffirmwareVersion\x12\x1b\n" +
+	"\tpcr_banks\x18\x04 \x03(\tR\bpcrBanks\".\n" +
 	"\x16UniqueMachineTokenSpec\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\"<\n" +
 	"\x0eUnmetCondition\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
-	"\x06reason\x18\x02 \x01(\tR\x06reason\"f\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\xab\x02\n" +
+	"\x11UpgradeStatusSpec\x12\x14\n" +
+	"\x05image\x18\x01 \x01(\tR\x05image\x12!\n" +
+	"\ffrom_version\x18\x02 \x01(\tR\vfromVersion\x12\x1d\n" +
+	"\n" +
+	"to_version\x18\x03 \x01(\tR\ttoVersion\x12\x14\n" +
+	"\x05phase\x18\x04 \x01(\tR\x05phase\x12\x18\n" +
+	"\aoutcome\x18\x05 \x01(\tR\aoutcome\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\x129\n" +
+	"\n" +
+	"started_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xac\x01\n" +
 	"\x17WatchdogTimerConfigSpec\x12\x16\n" +
 	"\x06device\x18\x01 \x01(\tR\x06device\x123\n" +
-	"\atimeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\xa6\x01\n" +
+	"\atimeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12'\n" +
+	"\x0frequire_kubelet\x18\x03 \x01(\bR\x0erequireKubelet\x12\x1b\n" +
+	"\tprobe_url\x18\x04 \x01(\tR\bprobeUrl\"\x87\x02\n" +
 	"\x17WatchdogTimerStatusSpec\x12\x16\n" +
 	"\x06device\x18\x01 \x01(\tR\x06device\x123\n" +
 	"\atimeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12>\n" +
-	"\rfeed_interval\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\ffeedIntervalBx\n" +
+	"\rfeed_interval\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\ffeedInterval\x127\n" +
+	"\tlast_feed\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\blastFeed\x12&\n" +
+	"\x0ffeed_blocked_by\x18\x05 \x01(\tR\rfeedBlockedByBx\n" +
 	"*dev.talos.api.resource.definitions.runtimeZJgithub.com/siderolabs/talos/pkg/machinery/api/resource/definitions/runtimeb\x06proto3"
 
 var (
@@ -1665,60 +3103,92 @@ func file_resource_definitions_runtime_runtime_proto_rawDescGZIP() []byte {
 	return file_resource_definitions_runtime_runtime_proto_rawDescData
 }
 
-var file_resource_definitions_runtime_runtime_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_resource_definitions_runtime_runtime_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
 var file_resource_definitions_runtime_runtime_proto_goTypes = []any{
 	(*BootedEntrySpec)(nil),                  // 0: talos.resource.definitions.runtime.BootedEntrySpec
-	(*DevicesStatusSpec)(nil),                // 1: talos.resource.definitions.runtime.DevicesStatusSpec
-	(*DiagnosticSpec)(nil),                   // 2: talos.resource.definitions.runtime.DiagnosticSpec
-	(*EventSinkConfigSpec)(nil),              // 3: talos.resource.definitions.runtime.EventSinkConfigSpec
-	(*ExtensionServiceConfigFile)(nil),       // 4: talos.resource.definitions.runtime.ExtensionServiceConfigFile
-	(*ExtensionServiceConfigSpec)(nil),       // 5: talos.resource.definitions.runtime.ExtensionServiceConfigSpec
-	(*ExtensionServiceConfigStatusSpec)(nil), // 6: talos.resource.definitions.runtime.ExtensionServiceConfigStatusSpec
-	(*KernelCmdlineSpec)(nil),                // 7: talos.resource.definitions.runtime.KernelCmdlineSpec
-	(*KernelModuleSpecSpec)(nil),             // 8: talos.resource.definitions.runtime.KernelModuleSpecSpec
-	(*KernelParamSpecSpec)(nil),              // 9: talos.resource.definitions.runtime.KernelParamSpecSpec
-	(*KernelParamStatusSpec)(nil),            // 10: talos.resource.definitions.runtime.KernelParamStatusSpec
-	(*KmsgLogConfigSpec)(nil),                // 11: talos.resource.definitions.runtime.KmsgLogConfigSpec
-	(*LoadedKernelModuleSpec)(nil),           // 12: talos.resource.definitions.runtime.LoadedKernelModuleSpec
-	(*MachineStatusSpec)(nil),                // 13: talos.resource.definitions.runtime.MachineStatusSpec
-	(*MachineStatusStatus)(nil),              // 14: talos.resource.definitions.runtime.MachineStatusStatus
-	(*MaintenanceServiceConfigSpec)(nil),     // 15: talos.resource.definitions.runtime.MaintenanceServiceConfigSpec
-	(*MetaKeySpec)(nil),                      // 16: talos.resource.definitions.runtime.MetaKeySpec
-	(*MetaLoadedSpec)(nil),                   // 17: talos.resource.definitions.runtime.MetaLoadedSpec
-	(*MountStatusSpec)(nil),                  // 18: talos.resource.definitions.runtime.MountStatusSpec
-	(*PlatformMetadataSpec)(nil),             // 19: talos.resource.definitions.runtime.PlatformMetadataSpec
-	(*SBOMItemSpec)(nil),                     // 20: talos.resource.definitions.runtime.SBOMItemSpec
-	(*SecurityStateSpec)(nil),                // 21: talos.resource.definitions.runtime.SecurityStateSpec
-	(*UniqueMachineTokenSpec)(nil),           // 22: talos.resource.definitions.runtime.UniqueMachineTokenSpec
-	(*UnmetCondition)(nil),                   // 23: talos.resource.definitions.runtime.UnmetCondition
-	(*WatchdogTimerConfigSpec)(nil),          // 24: talos.resource.definitions.runtime.WatchdogTimerConfigSpec
-	(*WatchdogTimerStatusSpec)(nil),          // 25: talos.resource.definitions.runtime.WatchdogTimerStatusSpec
-	nil,                                      // 26: talos.resource.definitions.runtime.PlatformMetadataSpec.TagsEntry
-	(*common.URL)(nil),                       // 27: common.URL
-	(enums.RuntimeMachineStage)(0),           // 28: talos.resource.definitions.enums.RuntimeMachineStage
-	(*common.NetIP)(nil),                     // 29: common.NetIP
-	(enums.RuntimeSELinuxState)(0),           // 30: talos.resource.definitions.enums.RuntimeSELinuxState
-	(enums.RuntimeFIPSState)(0),              // 31: talos.resource.definitions.enums.RuntimeFIPSState
-	(*durationpb.Duration)(nil),              // 32: google.protobuf.Duration
+	(*ConfigValidationFinding)(nil),          // 1: talos.resource.definitions.runtime.ConfigValidationFinding
+	(*ConfigValidationStatusSpec)(nil),       // 2: talos.resource.definitions.runtime.ConfigValidationStatusSpec
+	(*DevicesStatusSpec)(nil),                // 3: talos.resource.definitions.runtime.DevicesStatusSpec
+	(*DiagnosticSpec)(nil),                   // 4: talos.resource.definitions.runtime.DiagnosticSpec
+	(*EventSinkConfigSpec)(nil),              // 5: talos.resource.definitions.runtime.EventSinkConfigSpec
+	(*ExtensionServiceConfigFile)(nil),       // 6: talos.resource.definitions.runtime.ExtensionServiceConfigFile
+	(*ExtensionServiceConfigSpec)(nil),       // 7: talos.resource.definitions.runtime.ExtensionServiceConfigSpec
+	(*ExtensionServiceConfigStatusSpec)(nil), // 8: talos.resource.definitions.runtime.ExtensionServiceConfigStatusSpec
+	(*ExtensionVerificationStatusSpec)(nil),  // 9: talos.resource.definitions.runtime.ExtensionVerificationStatusSpec
+	(*KernelCmdlineSpec)(nil),                // 10: talos.resource.definitions.runtime.KernelCmdlineSpec
+	(*KernelModuleSpecSpec)(nil),             // 11: talos.resource.definitions.runtime.KernelModuleSpecSpec
+	(*KernelModuleStatusSpec)(nil),           // 12: talos.resource.definitions.runtime.KernelModuleStatusSpec
+	(*KernelParamSpecSpec)(nil),              // 13: talos.resource.definitions.runtime.KernelParamSpecSpec
+	(*KernelParamStatusSpec)(nil),            // 14: talos.resource.definitions.runtime.KernelParamStatusSpec
+	(*KmsgLogBufferSpec)(nil),                // 15: talos.resource.definitions.runtime.KmsgLogBufferSpec
+	(*KmsgLogConfigSpec)(nil),                // 16: talos.resource.definitions.runtime.KmsgLogConfigSpec
+	(*KmsgLogDeliveryStatusSpec)(nil),        // 17: talos.resource.definitions.runtime.KmsgLogDeliveryStatusSpec
+	(*LoadedKernelModuleSpec)(nil),           // 18: talos.resource.definitions.runtime.LoadedKernelModuleSpec
+	(*MachineStatusSpec)(nil),                // 19: talos.resource.definitions.runtime.MachineStatusSpec
+	(*MachineStatusStatus)(nil),              // 20: talos.resource.definitions.runtime.MachineStatusStatus
+	(*MaintenanceServiceConfigSpec)(nil),     // 21: talos.resource.definitions.runtime.MaintenanceServiceConfigSpec
+	(*MeasuredBootStatusSpec)(nil),           // 22: talos.resource.definitions.runtime.MeasuredBootStatusSpec
+	(*MetaKeySpec)(nil),                      // 23: talos.resource.definitions.runtime.MetaKeySpec
+	(*MetaLoadedSpec)(nil),                   // 24: talos.resource.definitions.runtime.MetaLoadedSpec
+	(*MountStatusSpec)(nil),                  // 25: talos.resource.definitions.runtime.MountStatusSpec
+	(*PCRValueSpec)(nil),                     // 26: talos.resource.definitions.runtime.PCRValueSpec
+	(*PlatformMetadataSpec)(nil),             // 27: talos.resource.definitions.runtime.PlatformMetadataSpec
+	(*ProcessParentSpec)(nil),                // 28: talos.resource.definitions.runtime.ProcessParentSpec
+	(*ProcessReaperStatusSpec)(nil),          // 29: talos.resource.definitions.runtime.ProcessReaperStatusSpec
+	(*SBOMItemSpec)(nil),                     // 30: talos.resource.definitions.runtime.SBOMItemSpec
+	(*SecureBootStatusSpec)(nil),             // 31: talos.resource.definitions.runtime.SecureBootStatusSpec
+	(*SecurityStateSpec)(nil),                // 32: talos.resource.definitions.runtime.SecurityStateSpec
+	(*ServiceResourcesStatusSpec)(nil),       // 33: talos.resource.definitions.runtime.ServiceResourcesStatusSpec
+	(*ShutdownInhibitorSpec)(nil),            // 34: talos.resource.definitions.runtime.ShutdownInhibitorSpec
+	(*SysctlFailure)(nil),                    // 35: talos.resource.definitions.runtime.SysctlFailure
+	(*SysctlStatusSpec)(nil),                 // 36: talos.resource.definitions.runtime.SysctlStatusSpec
+	(*TPMStatusSpec)(nil),                    // 37: talos.resource.definitions.runtime.TPMStatusSpec
+	(*UniqueMachineTokenSpec)(nil),           // 38: talos.resource.definitions.runtime.UniqueMachineTokenSpec
+	(*UnmetCondition)(nil),                   // 39: talos.resource.definitions.runtime.UnmetCondition
+	(*UpgradeStatusSpec)(nil),                // 40: talos.resource.definitions.runtime.UpgradeStatusSpec
+	(*WatchdogTimerConfigSpec)(nil),          // 41: talos.resource.definitions.runtime.WatchdogTimerConfigSpec
+	(*WatchdogTimerStatusSpec)(nil),          // 42: talos.resource.definitions.runtime.WatchdogTimerStatusSpec
+	nil,                                      // 43: talos.resource.definitions.runtime.PlatformMetadataSpec.TagsEntry
+	(*durationpb.Duration)(nil),              // 44: google.protobuf.Duration
+	(*common.URL)(nil),                       // 45: common.URL
+	(enums.RuntimeMachineStage)(0),           // 46: talos.resource.definitions.enums.RuntimeMachineStage
+	(*common.NetIP)(nil),                     // 47: common.NetIP
+	(enums.RuntimeSELinuxState)(0),           // 48: talos.resource.definitions.enums.RuntimeSELinuxState
+	(enums.RuntimeFIPSState)(0),              // 49: talos.resource.definitions.enums.RuntimeFIPSState
+	(*timestamppb.Timestamp)(nil),            // 50: google.protobuf.Timestamp
 }
 var file_resource_definitions_runtime_runtime_proto_depIdxs = []int32{
-	4,  // 0: talos.resource.definitions.runtime.ExtensionServiceConfigSpec.files:type_name -> talos.resource.definitions.runtime.ExtensionServiceConfigFile
-	27, // 1: talos.resource.definitions.runtime.KmsgLogConfigSpec.destinations:type_name -> common.URL
-	28, // 2: talos.resource.definitions.runtime.MachineStatusSpec.stage:type_name -> talos.resource.definitions.enums.RuntimeMachineStage
-	14, // 3: talos.resource.definitions.runtime.MachineStatusSpec.status:type_name -> talos.resource.definitions.runtime.MachineStatusStatus
-	23, // 4: talos.resource.definitions.runtime.MachineStatusStatus.unmet_conditions:type_name -> talos.resource.definitions.runtime.UnmetCondition
-	29, // 5: talos.resource.definitions.runtime.MaintenanceServiceConfigSpec.reachable_addresses:type_name -> common.NetIP
-	26, // 6: talos.resource.definitions.runtime.PlatformMetadataSpec.tags:type_name -> talos.resource.definitions.runtime.PlatformMetadataSpec.TagsEntry
-	30, // 7: talos.resource.definitions.runtime.SecurityStateSpec.se_linux_state:type_name -> talos.resource.definitions.enums.RuntimeSELinuxState
-	31, // 8: talos.resource.definitions.runtime.SecurityStateSpec.fips_state:type_name -> talos.resource.definitions.enums.RuntimeFIPSState
-	32, // 9: talos.resource.definitions.runtime.WatchdogTimerConfigSpec.timeout:type_name -> google.protobuf.Duration
-	32, // 10: talos.resource.definitions.runtime.WatchdogTimerStatusSpec.timeout:type_name -> google.protobuf.Duration
-	32, // 11: talos.resource.definitions.runtime.WatchdogTimerStatusSpec.feed_interval:type_name -> google.protobuf.Duration
-	12, // [12:12] is the sub-list for method output_type
-	12, // [12:12] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	1,  // 0: talos.resource.definitions.runtime.ConfigValidationStatusSpec.warnings:type_name -> talos.resource.definitions.runtime.ConfigValidationFinding
+	6,  // 1: talos.resource.definitions.runtime.ExtensionServiceConfigSpec.files:type_name -> talos.resource.definitions.runtime.ExtensionServiceConfigFile
+	44, // 2: talos.resource.definitions.runtime.KmsgLogBufferSpec.retry_min_backoff:type_name -> google.protobuf.Duration
+	44, // 3: talos.resource.definitions.runtime.KmsgLogBufferSpec.retry_max_backoff:type_name -> google.protobuf.Duration
+	45, // 4: talos.resource.definitions.runtime.KmsgLogConfigSpec.destinations:type_name -> common.URL
+	45, // 5: talos.resource.definitions.runtime.KmsgLogConfigSpec.syslog_destinations:type_name -> common.URL
+	15, // 6: talos.resource.definitions.runtime.KmsgLogConfigSpec.buffers:type_name -> talos.resource.definitions.runtime.KmsgLogBufferSpec
+	46, // 7: talos.resource.definitions.runtime.MachineStatusSpec.stage:type_name -> talos.resource.definitions.enums.RuntimeMachineStage
+	20, // 8: talos.resource.definitions.runtime.MachineStatusSpec.status:type_name -> talos.resource.definitions.runtime.MachineStatusStatus
+	39, // 9: talos.resource.definitions.runtime.MachineStatusStatus.unmet_conditions:type_name -> talos.resource.definitions.runtime.UnmetCondition
+	47, // 10: talos.resource.definitions.runtime.MaintenanceServiceConfigSpec.reachable_addresses:type_name -> common.NetIP
+	26, // 11: talos.resource.definitions.runtime.MeasuredBootStatusSpec.pc_rs:type_name -> talos.resource.definitions.runtime.PCRValueSpec
+	43, // 12: talos.resource.definitions.runtime.PlatformMetadataSpec.tags:type_name -> talos.resource.definitions.runtime.PlatformMetadataSpec.TagsEntry
+	28, // 13: talos.resource.definitions.runtime.ProcessReaperStatusSpec.zombie_parents:type_name -> talos.resource.definitions.runtime.ProcessParentSpec
+	28, // 14: talos.resource.definitions.runtime.ProcessReaperStatusSpec.orphan_parents:type_name -> talos.resource.definitions.runtime.ProcessParentSpec
+	48, // 15: talos.resource.definitions.runtime.SecurityStateSpec.se_linux_state:type_name -> talos.resource.definitions.enums.RuntimeSELinuxState
+	49, // 16: talos.resource.definitions.runtime.SecurityStateSpec.fips_state:type_name -> talos.resource.definitions.enums.RuntimeFIPSState
+	44, // 17: talos.resource.definitions.runtime.ShutdownInhibitorSpec.max_hold:type_name -> google.protobuf.Duration
+	50, // 18: talos.resource.definitions.runtime.ShutdownInhibitorSpec.acquired:type_name -> google.protobuf.Timestamp
+	35, // 19: talos.resource.definitions.runtime.SysctlStatusSpec.failed:type_name -> talos.resource.definitions.runtime.SysctlFailure
+	50, // 20: talos.resource.definitions.runtime.UpgradeStatusSpec.started_at:type_name -> google.protobuf.Timestamp
+	50, // 21: talos.resource.definitions.runtime.UpgradeStatusSpec.updated_at:type_name -> google.protobuf.Timestamp
+	44, // 22: talos.resource.definitions.runtime.WatchdogTimerConfigSpec.timeout:type_name -> google.protobuf.Duration
+	44, // 23: talos.resource.definitions.runtime.WatchdogTimerStatusSpec.timeout:type_name -> google.protobuf.Duration
+	44, // 24: talos.resource.definitions.runtime.WatchdogTimerStatusSpec.feed_interval:type_name -> google.protobuf.Duration
+	50, // 25: talos.resource.definitions.runtime.WatchdogTimerStatusSpec.last_feed:type_name -> google.protobuf.Timestamp
+	26, // [26:26] is the sub-list for method output_type
+	26, // [26:26] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_resource_definitions_runtime_runtime_proto_init() }
@@ -1732,7 +3202,7 @@ func file_resource_definitions_runtime_runtime_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resource_definitions_runtime_runtime_proto_rawDesc), len(file_resource_definitions_runtime_runtime_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   27,
+			NumMessages:   44,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
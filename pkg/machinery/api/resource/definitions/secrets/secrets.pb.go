@@ -13,6 +13,8 @@ import (
 
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 
 	common "github.com/siderolabs/talos/pkg/machinery/api/common"
 )
@@ -146,6 +148,221 @@ func (x *CertSANSpec) GetFqdn() string {
 	return ""
 }
 
+// CertificateRevocationSpec describes the revoked Talos API client certificates.
+type CertificateRevocationSpec struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Fingerprints     []string               `protobuf:"bytes,1,rep,name=fingerprints,proto3" json:"fingerprints,omitempty"`
+	SerialNumbers    []string               `protobuf:"bytes,2,rep,name=serial_numbers,json=serialNumbers,proto3" json:"serial_numbers,omitempty"`
+	CrlNextUpdate    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=crl_next_update,json=crlNextUpdate,proto3" json:"crl_next_update,omitempty"`
+	DenyOnExpiredCrl bool                   `protobuf:"varint,4,opt,name=deny_on_expired_crl,json=denyOnExpiredCrl,proto3" json:"deny_on_expired_crl,omitempty"`
+	CrlError         string                 `protobuf:"bytes,5,opt,name=crl_error,json=crlError,proto3" json:"crl_error,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CertificateRevocationSpec) Reset() {
+	*x = CertificateRevocationSpec{}
+	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CertificateRevocationSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertificateRevocationSpec) ProtoMessage() {}
+
+func (x *CertificateRevocationSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertificateRevocationSpec.ProtoReflect.Descriptor instead.
+func (*CertificateRevocationSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_secrets_secrets_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CertificateRevocationSpec) GetFingerprints() []string {
+	if x != nil {
+		return x.Fingerprints
+	}
+	return nil
+}
+
+func (x *CertificateRevocationSpec) GetSerialNumbers() []string {
+	if x != nil {
+		return x.SerialNumbers
+	}
+	return nil
+}
+
+func (x *CertificateRevocationSpec) GetCrlNextUpdate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CrlNextUpdate
+	}
+	return nil
+}
+
+func (x *CertificateRevocationSpec) GetDenyOnExpiredCrl() bool {
+	if x != nil {
+		return x.DenyOnExpiredCrl
+	}
+	return false
+}
+
+func (x *CertificateRevocationSpec) GetCrlError() string {
+	if x != nil {
+		return x.CrlError
+	}
+	return ""
+}
+
+// CertificateRotationSpec describes the certificate rotation request.
+type CertificateRotationSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestedAt   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CertificateRotationSpec) Reset() {
+	*x = CertificateRotationSpec{}
+	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CertificateRotationSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertificateRotationSpec) ProtoMessage() {}
+
+func (x *CertificateRotationSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertificateRotationSpec.ProtoReflect.Descriptor instead.
+func (*CertificateRotationSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_secrets_secrets_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CertificateRotationSpec) GetRequestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return nil
+}
+
+// CertificateStatusSpec describes the expiry status of a certificate.
+type CertificateStatusSpec struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Component       string                 `protobuf:"bytes,1,opt,name=component,proto3" json:"component,omitempty"`
+	CommonName      string                 `protobuf:"bytes,2,opt,name=common_name,json=commonName,proto3" json:"common_name,omitempty"`
+	SerialNumber    string                 `protobuf:"bytes,3,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	NotBefore       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
+	NotAfter        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
+	DaysRemaining   int64                  `protobuf:"varint,6,opt,name=days_remaining,json=daysRemaining,proto3" json:"days_remaining,omitempty"`
+	ExpiryThreshold int64                  `protobuf:"varint,7,opt,name=expiry_threshold,json=expiryThreshold,proto3" json:"expiry_threshold,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CertificateStatusSpec) Reset() {
+	*x = CertificateStatusSpec{}
+	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CertificateStatusSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertificateStatusSpec) ProtoMessage() {}
+
+func (x *CertificateStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertificateStatusSpec.ProtoReflect.Descriptor instead.
+func (*CertificateStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_secrets_secrets_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CertificateStatusSpec) GetComponent() string {
+	if x != nil {
+		return x.Component
+	}
+	return ""
+}
+
+func (x *CertificateStatusSpec) GetCommonName() string {
+	if x != nil {
+		return x.CommonName
+	}
+	return ""
+}
+
+func (x *CertificateStatusSpec) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *CertificateStatusSpec) GetNotBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotBefore
+	}
+	return nil
+}
+
+func (x *CertificateStatusSpec) GetNotAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotAfter
+	}
+	return nil
+}
+
+func (x *CertificateStatusSpec) GetDaysRemaining() int64 {
+	if x != nil {
+		return x.DaysRemaining
+	}
+	return 0
+}
+
+func (x *CertificateStatusSpec) GetExpiryThreshold() int64 {
+	if x != nil {
+		return x.ExpiryThreshold
+	}
+	return 0
+}
+
 // EncryptionSaltSpec describes the salt.
 type EncryptionSaltSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -156,7 +373,7 @@ type EncryptionSaltSpec struct {
 
 func (x *EncryptionSaltSpec) Reset() {
 	*x = EncryptionSaltSpec{}
-	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[2]
+	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -168,7 +385,7 @@ func (x *EncryptionSaltSpec) String() string {
 func (*EncryptionSaltSpec) ProtoMessage() {}
 
 func (x *EncryptionSaltSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[2]
+	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -181,7 +398,7 @@ func (x *EncryptionSaltSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EncryptionSaltSpec.ProtoReflect.Descriptor instead.
 func (*EncryptionSaltSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_secrets_secrets_proto_rawDescGZIP(), []int{2}
+	return file_resource_definitions_secrets_secrets_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *EncryptionSaltSpec) GetDiskSalt() []byte {
@@ -204,7 +421,7 @@ type EtcdCertsSpec struct {
 
 func (x *EtcdCertsSpec) Reset() {
 	*x = EtcdCertsSpec{}
-	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[3]
+	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -216,7 +433,7 @@ func (x *EtcdCertsSpec) String() string {
 func (*EtcdCertsSpec) ProtoMessage() {}
 
 func (x *EtcdCertsSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[3]
+	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -229,7 +446,7 @@ func (x *EtcdCertsSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdCertsSpec.ProtoReflect.Descriptor instead.
 func (*EtcdCertsSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_secrets_secrets_proto_rawDescGZIP(), []int{3}
+	return file_resource_definitions_secrets_secrets_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *EtcdCertsSpec) GetEtcd() *common.PEMEncodedCertificateAndKey {
@@ -270,7 +487,7 @@ type EtcdRootSpec struct {
 
 func (x *EtcdRootSpec) Reset() {
 	*x = EtcdRootSpec{}
-	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[4]
+	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -282,7 +499,7 @@ func (x *EtcdRootSpec) String() string {
 func (*EtcdRootSpec) ProtoMessage() {}
 
 func (x *EtcdRootSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[4]
+	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -295,7 +512,7 @@ func (x *EtcdRootSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdRootSpec.ProtoReflect.Descriptor instead.
 func (*EtcdRootSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_secrets_secrets_proto_rawDescGZIP(), []int{4}
+	return file_resource_definitions_secrets_secrets_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *EtcdRootSpec) GetEtcdCa() *common.PEMEncodedCertificateAndKey {
@@ -305,6 +522,67 @@ func (x *EtcdRootSpec) GetEtcdCa() *common.PEMEncodedCertificateAndKey {
 	return nil
 }
 
+// JoinTokenSpec describes a token accepted by trustd from the joining machines.
+type JoinTokenSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TokenHash     string                 `protobuf:"bytes,1,opt,name=token_hash,json=tokenHash,proto3" json:"token_hash,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinTokenSpec) Reset() {
+	*x = JoinTokenSpec{}
+	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinTokenSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinTokenSpec) ProtoMessage() {}
+
+func (x *JoinTokenSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinTokenSpec.ProtoReflect.Descriptor instead.
+func (*JoinTokenSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_secrets_secrets_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *JoinTokenSpec) GetTokenHash() string {
+	if x != nil {
+		return x.TokenHash
+	}
+	return ""
+}
+
+func (x *JoinTokenSpec) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *JoinTokenSpec) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
 // KubeletSpec describes root Kubernetes secrets.
 type KubeletSpec struct {
 	state                protoimpl.MessageState          `protogen:"open.v1"`
@@ -318,7 +596,7 @@ type KubeletSpec struct {
 
 func (x *KubeletSpec) Reset() {
 	*x = KubeletSpec{}
-	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[5]
+	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -330,7 +608,7 @@ func (x *KubeletSpec) String() string {
 func (*KubeletSpec) ProtoMessage() {}
 
 func (x *KubeletSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[5]
+	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -343,7 +621,7 @@ func (x *KubeletSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KubeletSpec.ProtoReflect.Descriptor instead.
 func (*KubeletSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_secrets_secrets_proto_rawDescGZIP(), []int{5}
+	return file_resource_definitions_secrets_secrets_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *KubeletSpec) GetEndpoint() *common.URL {
@@ -374,6 +652,99 @@ func (x *KubeletSpec) GetAcceptedCAs() []*common.PEMEncodedCertificate {
 	return nil
 }
 
+// OSRootStatusSpec describes the Talos API CAs accepted and presented by the node.
+type OSRootStatusSpec struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	IssuingCa      string                 `protobuf:"bytes,1,opt,name=issuing_ca,json=issuingCa,proto3" json:"issuing_ca,omitempty"`
+	AcceptedCAs    []string               `protobuf:"bytes,2,rep,name=accepted_c_as,json=acceptedCAs,proto3" json:"accepted_c_as,omitempty"`
+	ApiServerCa    string                 `protobuf:"bytes,3,opt,name=api_server_ca,json=apiServerCa,proto3" json:"api_server_ca,omitempty"`
+	ApiClientCa    string                 `protobuf:"bytes,4,opt,name=api_client_ca,json=apiClientCa,proto3" json:"api_client_ca,omitempty"`
+	TrustdServerCa string                 `protobuf:"bytes,5,opt,name=trustd_server_ca,json=trustdServerCa,proto3" json:"trustd_server_ca,omitempty"`
+	Phase          string                 `protobuf:"bytes,6,opt,name=phase,proto3" json:"phase,omitempty"`
+	Converged      bool                   `protobuf:"varint,7,opt,name=converged,proto3" json:"converged,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OSRootStatusSpec) Reset() {
+	*x = OSRootStatusSpec{}
+	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OSRootStatusSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OSRootStatusSpec) ProtoMessage() {}
+
+func (x *OSRootStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OSRootStatusSpec.ProtoReflect.Descriptor instead.
+func (*OSRootStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_secrets_secrets_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *OSRootStatusSpec) GetIssuingCa() string {
+	if x != nil {
+		return x.IssuingCa
+	}
+	return ""
+}
+
+func (x *OSRootStatusSpec) GetAcceptedCAs() []string {
+	if x != nil {
+		return x.AcceptedCAs
+	}
+	return nil
+}
+
+func (x *OSRootStatusSpec) GetApiServerCa() string {
+	if x != nil {
+		return x.ApiServerCa
+	}
+	return ""
+}
+
+func (x *OSRootStatusSpec) GetApiClientCa() string {
+	if x != nil {
+		return x.ApiClientCa
+	}
+	return ""
+}
+
+func (x *OSRootStatusSpec) GetTrustdServerCa() string {
+	if x != nil {
+		return x.TrustdServerCa
+	}
+	return ""
+}
+
+func (x *OSRootStatusSpec) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *OSRootStatusSpec) GetConverged() bool {
+	if x != nil {
+		return x.Converged
+	}
+	return false
+}
+
 // KubernetesCertsSpec describes generated Kubernetes certificates.
 type KubernetesCertsSpec struct {
 	state                       protoimpl.MessageState `protogen:"open.v1"`
@@ -387,7 +758,7 @@ type KubernetesCertsSpec struct {
 
 func (x *KubernetesCertsSpec) Reset() {
 	*x = KubernetesCertsSpec{}
-	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[6]
+	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -399,7 +770,7 @@ func (x *KubernetesCertsSpec) String() string {
 func (*KubernetesCertsSpec) ProtoMessage() {}
 
 func (x *KubernetesCertsSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[6]
+	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -412,7 +783,7 @@ func (x *KubernetesCertsSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KubernetesCertsSpec.ProtoReflect.Descriptor instead.
 func (*KubernetesCertsSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_secrets_secrets_proto_rawDescGZIP(), []int{6}
+	return file_resource_definitions_secrets_secrets_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *KubernetesCertsSpec) GetSchedulerKubeconfig() string {
@@ -455,7 +826,7 @@ type KubernetesDynamicCertsSpec struct {
 
 func (x *KubernetesDynamicCertsSpec) Reset() {
 	*x = KubernetesDynamicCertsSpec{}
-	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[7]
+	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -467,7 +838,7 @@ func (x *KubernetesDynamicCertsSpec) String() string {
 func (*KubernetesDynamicCertsSpec) ProtoMessage() {}
 
 func (x *KubernetesDynamicCertsSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[7]
+	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -480,7 +851,7 @@ func (x *KubernetesDynamicCertsSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KubernetesDynamicCertsSpec.ProtoReflect.Descriptor instead.
 func (*KubernetesDynamicCertsSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_secrets_secrets_proto_rawDescGZIP(), []int{7}
+	return file_resource_definitions_secrets_secrets_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *KubernetesDynamicCertsSpec) GetApiServer() *common.PEMEncodedCertificateAndKey {
@@ -527,7 +898,7 @@ type KubernetesRootSpec struct {
 
 func (x *KubernetesRootSpec) Reset() {
 	*x = KubernetesRootSpec{}
-	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[8]
+	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -539,7 +910,7 @@ func (x *KubernetesRootSpec) String() string {
 func (*KubernetesRootSpec) ProtoMessage() {}
 
 func (x *KubernetesRootSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[8]
+	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -552,7 +923,7 @@ func (x *KubernetesRootSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KubernetesRootSpec.ProtoReflect.Descriptor instead.
 func (*KubernetesRootSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_secrets_secrets_proto_rawDescGZIP(), []int{8}
+	return file_resource_definitions_secrets_secrets_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *KubernetesRootSpec) GetName() string {
@@ -663,7 +1034,7 @@ type MaintenanceRootSpec struct {
 
 func (x *MaintenanceRootSpec) Reset() {
 	*x = MaintenanceRootSpec{}
-	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[9]
+	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -675,7 +1046,7 @@ func (x *MaintenanceRootSpec) String() string {
 func (*MaintenanceRootSpec) ProtoMessage() {}
 
 func (x *MaintenanceRootSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[9]
+	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -688,7 +1059,7 @@ func (x *MaintenanceRootSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MaintenanceRootSpec.ProtoReflect.Descriptor instead.
 func (*MaintenanceRootSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_secrets_secrets_proto_rawDescGZIP(), []int{9}
+	return file_resource_definitions_secrets_secrets_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *MaintenanceRootSpec) GetCa() *common.PEMEncodedCertificateAndKey {
@@ -709,7 +1080,7 @@ type MaintenanceServiceCertsSpec struct {
 
 func (x *MaintenanceServiceCertsSpec) Reset() {
 	*x = MaintenanceServiceCertsSpec{}
-	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[10]
+	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -721,7 +1092,7 @@ func (x *MaintenanceServiceCertsSpec) String() string {
 func (*MaintenanceServiceCertsSpec) ProtoMessage() {}
 
 func (x *MaintenanceServiceCertsSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[10]
+	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -734,7 +1105,7 @@ func (x *MaintenanceServiceCertsSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MaintenanceServiceCertsSpec.ProtoReflect.Descriptor instead.
 func (*MaintenanceServiceCertsSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_secrets_secrets_proto_rawDescGZIP(), []int{10}
+	return file_resource_definitions_secrets_secrets_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *MaintenanceServiceCertsSpec) GetCa() *common.PEMEncodedCertificateAndKey {
@@ -765,7 +1136,7 @@ type OSRootSpec struct {
 
 func (x *OSRootSpec) Reset() {
 	*x = OSRootSpec{}
-	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[11]
+	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -777,7 +1148,7 @@ func (x *OSRootSpec) String() string {
 func (*OSRootSpec) ProtoMessage() {}
 
 func (x *OSRootSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[11]
+	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -790,7 +1161,7 @@ func (x *OSRootSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OSRootSpec.ProtoReflect.Descriptor instead.
 func (*OSRootSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_secrets_secrets_proto_rawDescGZIP(), []int{11}
+	return file_resource_definitions_secrets_secrets_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *OSRootSpec) GetIssuingCa() *common.PEMEncodedCertificateAndKey {
@@ -839,7 +1210,7 @@ type TrustdCertsSpec struct {
 
 func (x *TrustdCertsSpec) Reset() {
 	*x = TrustdCertsSpec{}
-	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[12]
+	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -851,7 +1222,7 @@ func (x *TrustdCertsSpec) String() string {
 func (*TrustdCertsSpec) ProtoMessage() {}
 
 func (x *TrustdCertsSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[12]
+	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -864,7 +1235,7 @@ func (x *TrustdCertsSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TrustdCertsSpec.ProtoReflect.Descriptor instead.
 func (*TrustdCertsSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_secrets_secrets_proto_rawDescGZIP(), []int{12}
+	return file_resource_definitions_secrets_secrets_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *TrustdCertsSpec) GetServer() *common.PEMEncodedCertificateAndKey {
@@ -881,11 +1252,88 @@ func (x *TrustdCertsSpec) GetAcceptedCAs() []*common.PEMEncodedCertificate {
 	return nil
 }
 
+// TrustdPolicySpec describes the certificate signing policy of trustd.
+type TrustdPolicySpec struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	RequestsPerMinute      int64                  `protobuf:"varint,1,opt,name=requests_per_minute,json=requestsPerMinute,proto3" json:"requests_per_minute,omitempty"`
+	RequestBurst           int64                  `protobuf:"varint,2,opt,name=request_burst,json=requestBurst,proto3" json:"request_burst,omitempty"`
+	AllowedSubnets         []*common.NetIPPrefix  `protobuf:"bytes,3,rep,name=allowed_subnets,json=allowedSubnets,proto3" json:"allowed_subnets,omitempty"`
+	AllowedHostnames       []string               `protobuf:"bytes,4,rep,name=allowed_hostnames,json=allowedHostnames,proto3" json:"allowed_hostnames,omitempty"`
+	MaxCertificateLifetime *durationpb.Duration   `protobuf:"bytes,5,opt,name=max_certificate_lifetime,json=maxCertificateLifetime,proto3" json:"max_certificate_lifetime,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *TrustdPolicySpec) Reset() {
+	*x = TrustdPolicySpec{}
+	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrustdPolicySpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrustdPolicySpec) ProtoMessage() {}
+
+func (x *TrustdPolicySpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_secrets_secrets_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrustdPolicySpec.ProtoReflect.Descriptor instead.
+func (*TrustdPolicySpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_secrets_secrets_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TrustdPolicySpec) GetRequestsPerMinute() int64 {
+	if x != nil {
+		return x.RequestsPerMinute
+	}
+	return 0
+}
+
+func (x *TrustdPolicySpec) GetRequestBurst() int64 {
+	if x != nil {
+		return x.RequestBurst
+	}
+	return 0
+}
+
+func (x *TrustdPolicySpec) GetAllowedSubnets() []*common.NetIPPrefix {
+	if x != nil {
+		return x.AllowedSubnets
+	}
+	return nil
+}
+
+func (x *TrustdPolicySpec) GetAllowedHostnames() []string {
+	if x != nil {
+		return x.AllowedHostnames
+	}
+	return nil
+}
+
+func (x *TrustdPolicySpec) GetMaxCertificateLifetime() *durationpb.Duration {
+	if x != nil {
+		return x.MaxCertificateLifetime
+	}
+	return nil
+}
+
 var File_resource_definitions_secrets_secrets_proto protoreflect.FileDescriptor
 
 const file_resource_definitions_secrets_secrets_proto_rawDesc = "" +
 	"\n" +
-	"*resource/definitions/secrets/secrets.proto\x12\"talos.resource.definitions.secrets\x1a\x13common/common.proto\"\xcb\x01\n" +
+	"*resource/definitions/secrets/secrets.proto\x12\"talos.resource.definitions.secrets\x1a\x13common/common.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xcb\x01\n" +
 	"\fAPICertsSpec\x12;\n" +
 	"\x06client\x18\x02 \x01(\v2#.common.PEMEncodedCertificateAndKeyR\x06client\x12;\n" +
 	"\x06server\x18\x03 \x01(\v2#.common.PEMEncodedCertificateAndKeyR\x06server\x12A\n" +
@@ -893,7 +1341,25 @@ const file_resource_definitions_secrets_secrets_proto_rawDesc = "" +
 	"\vCertSANSpec\x12 \n" +
 	"\x04i_ps\x18\x01 \x03(\v2\r.common.NetIPR\x03iPs\x12\x1b\n" +
 	"\tdns_names\x18\x02 \x03(\tR\bdnsNames\x12\x12\n" +
-	"\x04fqdn\x18\x03 \x01(\tR\x04fqdn\"1\n" +
+	"\x04fqdn\x18\x03 \x01(\tR\x04fqdn\"\xf6\x01\n" +
+	"\x19CertificateRevocationSpec\x12\"\n" +
+	"\ffingerprints\x18\x01 \x03(\tR\ffingerprints\x12%\n" +
+	"\x0eserial_numbers\x18\x02 \x03(\tR\rserialNumbers\x12B\n" +
+	"\x0fcrl_next_update\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\rcrlNextUpdate\x12-\n" +
+	"\x13deny_on_expired_crl\x18\x04 \x01(\bR\x10denyOnExpiredCrl\x12\x1b\n" +
+	"\tcrl_error\x18\x05 \x01(\tR\bcrlError\"X\n" +
+	"\x17CertificateRotationSpec\x12=\n" +
+	"\frequested_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vrequestedAt\"\xc1\x02\n" +
+	"\x15CertificateStatusSpec\x12\x1c\n" +
+	"\tcomponent\x18\x01 \x01(\tR\tcomponent\x12\x1f\n" +
+	"\vcommon_name\x18\x02 \x01(\tR\n" +
+	"commonName\x12#\n" +
+	"\rserial_number\x18\x03 \x01(\tR\fserialNumber\x129\n" +
+	"\n" +
+	"not_before\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tnotBefore\x127\n" +
+	"\tnot_after\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bnotAfter\x12%\n" +
+	"\x0edays_remaining\x18\x06 \x01(\x03R\rdaysRemaining\x12)\n" +
+	"\x10expiry_threshold\x18\a \x01(\x03R\x0fexpiryThreshold\"1\n" +
 	"\x12EncryptionSaltSpec\x12\x1b\n" +
 	"\tdisk_salt\x18\x01 \x01(\fR\bdiskSalt\"\x9b\x02\n" +
 	"\rEtcdCertsSpec\x127\n" +
@@ -903,12 +1369,28 @@ const file_resource_definitions_secrets_secrets_proto_rawDesc = "" +
 	"etcd_admin\x18\x03 \x01(\v2#.common.PEMEncodedCertificateAndKeyR\tetcdAdmin\x12K\n" +
 	"\x0fetcd_api_server\x18\x04 \x01(\v2#.common.PEMEncodedCertificateAndKeyR\retcdApiServer\"L\n" +
 	"\fEtcdRootSpec\x12<\n" +
-	"\aetcd_ca\x18\x01 \x01(\v2#.common.PEMEncodedCertificateAndKeyR\x06etcdCa\"\xdd\x01\n" +
+	"\aetcd_ca\x18\x01 \x01(\v2#.common.PEMEncodedCertificateAndKeyR\x06etcdCa\"\xa4\x01\n" +
+	"\rJoinTokenSpec\x12\x1d\n" +
+	"\n" +
+	"token_hash\x18\x01 \x01(\tR\ttokenHash\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\xdd\x01\n" +
 	"\vKubeletSpec\x12'\n" +
 	"\bendpoint\x18\x01 \x01(\v2\v.common.URLR\bendpoint\x12,\n" +
 	"\x12bootstrap_token_id\x18\x03 \x01(\tR\x10bootstrapTokenId\x124\n" +
 	"\x16bootstrap_token_secret\x18\x04 \x01(\tR\x14bootstrapTokenSecret\x12A\n" +
-	"\raccepted_c_as\x18\x05 \x03(\v2\x1d.common.PEMEncodedCertificateR\vacceptedCAs\"\xf5\x01\n" +
+	"\raccepted_c_as\x18\x05 \x03(\v2\x1d.common.PEMEncodedCertificateR\vacceptedCAs\"\xfb\x01\n" +
+	"\x10OSRootStatusSpec\x12\x1d\n" +
+	"\n" +
+	"issuing_ca\x18\x01 \x01(\tR\tissuingCa\x12\"\n" +
+	"\raccepted_c_as\x18\x02 \x03(\tR\vacceptedCAs\x12\"\n" +
+	"\rapi_server_ca\x18\x03 \x01(\tR\vapiServerCa\x12\"\n" +
+	"\rapi_client_ca\x18\x04 \x01(\tR\vapiClientCa\x12(\n" +
+	"\x10trustd_server_ca\x18\x05 \x01(\tR\x0etrustdServerCa\x12\x14\n" +
+	"\x05phase\x18\x06 \x01(\tR\x05phase\x12\x1c\n" +
+	"\tconverged\x18\a \x01(\bR\tconverged\"\xf5\x01\n" +
 	"\x13KubernetesCertsSpec\x121\n" +
 	"\x14scheduler_kubeconfig\x18\x04 \x01(\tR\x13schedulerKubeconfig\x12B\n" +
 	"\x1dcontroller_manager_kubeconfig\x18\x05 \x01(\tR\x1bcontrollerManagerKubeconfig\x12<\n" +
@@ -955,7 +1437,13 @@ const file_resource_definitions_secrets_secrets_proto_rawDesc = "" +
 	"\raccepted_c_as\x18\x05 \x03(\v2\x1d.common.PEMEncodedCertificateR\vacceptedCAs\"\x91\x01\n" +
 	"\x0fTrustdCertsSpec\x12;\n" +
 	"\x06server\x18\x02 \x01(\v2#.common.PEMEncodedCertificateAndKeyR\x06server\x12A\n" +
-	"\raccepted_c_as\x18\x03 \x03(\v2\x1d.common.PEMEncodedCertificateR\vacceptedCAsBx\n" +
+	"\raccepted_c_as\x18\x03 \x03(\v2\x1d.common.PEMEncodedCertificateR\vacceptedCAs\"\xa7\x02\n" +
+	"\x10TrustdPolicySpec\x12.\n" +
+	"\x13requests_per_minute\x18\x01 \x01(\x03R\x11requestsPerMinute\x12#\n" +
+	"\rrequest_burst\x18\x02 \x01(\x03R\frequestBurst\x12<\n" +
+	"\x0fallowed_subnets\x18\x03 \x03(\v2\x13.common.NetIPPrefixR\x0eallowedSubnets\x12+\n" +
+	"\x11allowed_hostnames\x18\x04 \x03(\tR\x10allowedHostnames\x12S\n" +
+	"\x18max_certificate_lifetime\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x16maxCertificateLifetimeBx\n" +
 	"*dev.talos.api.resource.definitions.secretsZJgithub.com/siderolabs/talos/pkg/machinery/api/resource/definitions/secretsb\x06proto3"
 
 var (
@@ -970,62 +1458,79 @@ func file_resource_definitions_secrets_secrets_proto_rawDescGZIP() []byte {
 	return file_resource_definitions_secrets_secrets_proto_rawDescData
 }
 
-var file_resource_definitions_secrets_secrets_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_resource_definitions_secrets_secrets_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_resource_definitions_secrets_secrets_proto_goTypes = []any{
 	(*APICertsSpec)(nil),                       // 0: talos.resource.definitions.secrets.APICertsSpec
 	(*CertSANSpec)(nil),                        // 1: talos.resource.definitions.secrets.CertSANSpec
-	(*EncryptionSaltSpec)(nil),                 // 2: talos.resource.definitions.secrets.EncryptionSaltSpec
-	(*EtcdCertsSpec)(nil),                      // 3: talos.resource.definitions.secrets.EtcdCertsSpec
-	(*EtcdRootSpec)(nil),                       // 4: talos.resource.definitions.secrets.EtcdRootSpec
-	(*KubeletSpec)(nil),                        // 5: talos.resource.definitions.secrets.KubeletSpec
-	(*KubernetesCertsSpec)(nil),                // 6: talos.resource.definitions.secrets.KubernetesCertsSpec
-	(*KubernetesDynamicCertsSpec)(nil),         // 7: talos.resource.definitions.secrets.KubernetesDynamicCertsSpec
-	(*KubernetesRootSpec)(nil),                 // 8: talos.resource.definitions.secrets.KubernetesRootSpec
-	(*MaintenanceRootSpec)(nil),                // 9: talos.resource.definitions.secrets.MaintenanceRootSpec
-	(*MaintenanceServiceCertsSpec)(nil),        // 10: talos.resource.definitions.secrets.MaintenanceServiceCertsSpec
-	(*OSRootSpec)(nil),                         // 11: talos.resource.definitions.secrets.OSRootSpec
-	(*TrustdCertsSpec)(nil),                    // 12: talos.resource.definitions.secrets.TrustdCertsSpec
-	(*common.PEMEncodedCertificateAndKey)(nil), // 13: common.PEMEncodedCertificateAndKey
-	(*common.PEMEncodedCertificate)(nil),       // 14: common.PEMEncodedCertificate
-	(*common.NetIP)(nil),                       // 15: common.NetIP
-	(*common.URL)(nil),                         // 16: common.URL
-	(*common.PEMEncodedKey)(nil),               // 17: common.PEMEncodedKey
+	(*CertificateRevocationSpec)(nil),          // 2: talos.resource.definitions.secrets.CertificateRevocationSpec
+	(*CertificateRotationSpec)(nil),            // 3: talos.resource.definitions.secrets.CertificateRotationSpec
+	(*CertificateStatusSpec)(nil),              // 4: talos.resource.definitions.secrets.CertificateStatusSpec
+	(*EncryptionSaltSpec)(nil),                 // 5: talos.resource.definitions.secrets.EncryptionSaltSpec
+	(*EtcdCertsSpec)(nil),                      // 6: talos.resource.definitions.secrets.EtcdCertsSpec
+	(*EtcdRootSpec)(nil),                       // 7: talos.resource.definitions.secrets.EtcdRootSpec
+	(*JoinTokenSpec)(nil),                      // 8: talos.resource.definitions.secrets.JoinTokenSpec
+	(*KubeletSpec)(nil),                        // 9: talos.resource.definitions.secrets.KubeletSpec
+	(*OSRootStatusSpec)(nil),                   // 10: talos.resource.definitions.secrets.OSRootStatusSpec
+	(*KubernetesCertsSpec)(nil),                // 11: talos.resource.definitions.secrets.KubernetesCertsSpec
+	(*KubernetesDynamicCertsSpec)(nil),         // 12: talos.resource.definitions.secrets.KubernetesDynamicCertsSpec
+	(*KubernetesRootSpec)(nil),                 // 13: talos.resource.definitions.secrets.KubernetesRootSpec
+	(*MaintenanceRootSpec)(nil),                // 14: talos.resource.definitions.secrets.MaintenanceRootSpec
+	(*MaintenanceServiceCertsSpec)(nil),        // 15: talos.resource.definitions.secrets.MaintenanceServiceCertsSpec
+	(*OSRootSpec)(nil),                         // 16: talos.resource.definitions.secrets.OSRootSpec
+	(*TrustdCertsSpec)(nil),                    // 17: talos.resource.definitions.secrets.TrustdCertsSpec
+	(*TrustdPolicySpec)(nil),                   // 18: talos.resource.definitions.secrets.TrustdPolicySpec
+	(*common.PEMEncodedCertificateAndKey)(nil), // 19: common.PEMEncodedCertificateAndKey
+	(*common.PEMEncodedCertificate)(nil),       // 20: common.PEMEncodedCertificate
+	(*common.NetIP)(nil),                       // 21: common.NetIP
+	(*timestamppb.Timestamp)(nil),              // 22: google.protobuf.Timestamp
+	(*common.URL)(nil),                         // 23: common.URL
+	(*common.PEMEncodedKey)(nil),               // 24: common.PEMEncodedKey
+	(*common.NetIPPrefix)(nil),                 // 25: common.NetIPPrefix
+	(*durationpb.Duration)(nil),                // 26: google.protobuf.Duration
 }
 var file_resource_definitions_secrets_secrets_proto_depIdxs = []int32{
-	13, // 0: talos.resource.definitions.secrets.APICertsSpec.client:type_name -> common.PEMEncodedCertificateAndKey
-	13, // 1: talos.resource.definitions.secrets.APICertsSpec.server:type_name -> common.PEMEncodedCertificateAndKey
-	14, // 2: talos.resource.definitions.secrets.APICertsSpec.accepted_c_as:type_name -> common.PEMEncodedCertificate
-	15, // 3: talos.resource.definitions.secrets.CertSANSpec.i_ps:type_name -> common.NetIP
-	13, // 4: talos.resource.definitions.secrets.EtcdCertsSpec.etcd:type_name -> common.PEMEncodedCertificateAndKey
-	13, // 5: talos.resource.definitions.secrets.EtcdCertsSpec.etcd_peer:type_name -> common.PEMEncodedCertificateAndKey
-	13, // 6: talos.resource.definitions.secrets.EtcdCertsSpec.etcd_admin:type_name -> common.PEMEncodedCertificateAndKey
-	13, // 7: talos.resource.definitions.secrets.EtcdCertsSpec.etcd_api_server:type_name -> common.PEMEncodedCertificateAndKey
-	13, // 8: talos.resource.definitions.secrets.EtcdRootSpec.etcd_ca:type_name -> common.PEMEncodedCertificateAndKey
-	16, // 9: talos.resource.definitions.secrets.KubeletSpec.endpoint:type_name -> common.URL
-	14, // 10: talos.resource.definitions.secrets.KubeletSpec.accepted_c_as:type_name -> common.PEMEncodedCertificate
-	13, // 11: talos.resource.definitions.secrets.KubernetesDynamicCertsSpec.api_server:type_name -> common.PEMEncodedCertificateAndKey
-	13, // 12: talos.resource.definitions.secrets.KubernetesDynamicCertsSpec.api_server_kubelet_client:type_name -> common.PEMEncodedCertificateAndKey
-	13, // 13: talos.resource.definitions.secrets.KubernetesDynamicCertsSpec.front_proxy:type_name -> common.PEMEncodedCertificateAndKey
-	16, // 14: talos.resource.definitions.secrets.KubernetesRootSpec.endpoint:type_name -> common.URL
-	16, // 15: talos.resource.definitions.secrets.KubernetesRootSpec.local_endpoint:type_name -> common.URL
-	13, // 16: talos.resource.definitions.secrets.KubernetesRootSpec.issuing_ca:type_name -> common.PEMEncodedCertificateAndKey
-	17, // 17: talos.resource.definitions.secrets.KubernetesRootSpec.service_account:type_name -> common.PEMEncodedKey
-	13, // 18: talos.resource.definitions.secrets.KubernetesRootSpec.aggregator_ca:type_name -> common.PEMEncodedCertificateAndKey
-	15, // 19: talos.resource.definitions.secrets.KubernetesRootSpec.api_server_ips:type_name -> common.NetIP
-	14, // 20: talos.resource.definitions.secrets.KubernetesRootSpec.accepted_c_as:type_name -> common.PEMEncodedCertificate
-	13, // 21: talos.resource.definitions.secrets.MaintenanceRootSpec.ca:type_name -> common.PEMEncodedCertificateAndKey
-	13, // 22: talos.resource.definitions.secrets.MaintenanceServiceCertsSpec.ca:type_name -> common.PEMEncodedCertificateAndKey
-	13, // 23: talos.resource.definitions.secrets.MaintenanceServiceCertsSpec.server:type_name -> common.PEMEncodedCertificateAndKey
-	13, // 24: talos.resource.definitions.secrets.OSRootSpec.issuing_ca:type_name -> common.PEMEncodedCertificateAndKey
-	15, // 25: talos.resource.definitions.secrets.OSRootSpec.cert_sani_ps:type_name -> common.NetIP
-	14, // 26: talos.resource.definitions.secrets.OSRootSpec.accepted_c_as:type_name -> common.PEMEncodedCertificate
-	13, // 27: talos.resource.definitions.secrets.TrustdCertsSpec.server:type_name -> common.PEMEncodedCertificateAndKey
-	14, // 28: talos.resource.definitions.secrets.TrustdCertsSpec.accepted_c_as:type_name -> common.PEMEncodedCertificate
-	29, // [29:29] is the sub-list for method output_type
-	29, // [29:29] is the sub-list for method input_type
-	29, // [29:29] is the sub-list for extension type_name
-	29, // [29:29] is the sub-list for extension extendee
-	0,  // [0:29] is the sub-list for field type_name
+	19, // 0: talos.resource.definitions.secrets.APICertsSpec.client:type_name -> common.PEMEncodedCertificateAndKey
+	19, // 1: talos.resource.definitions.secrets.APICertsSpec.server:type_name -> common.PEMEncodedCertificateAndKey
+	20, // 2: talos.resource.definitions.secrets.APICertsSpec.accepted_c_as:type_name -> common.PEMEncodedCertificate
+	21, // 3: talos.resource.definitions.secrets.CertSANSpec.i_ps:type_name -> common.NetIP
+	22, // 4: talos.resource.definitions.secrets.CertificateRevocationSpec.crl_next_update:type_name -> google.protobuf.Timestamp
+	22, // 5: talos.resource.definitions.secrets.CertificateRotationSpec.requested_at:type_name -> google.protobuf.Timestamp
+	22, // 6: talos.resource.definitions.secrets.CertificateStatusSpec.not_before:type_name -> google.protobuf.Timestamp
+	22, // 7: talos.resource.definitions.secrets.CertificateStatusSpec.not_after:type_name -> google.protobuf.Timestamp
+	19, // 8: talos.resource.definitions.secrets.EtcdCertsSpec.etcd:type_name -> common.PEMEncodedCertificateAndKey
+	19, // 9: talos.resource.definitions.secrets.EtcdCertsSpec.etcd_peer:type_name -> common.PEMEncodedCertificateAndKey
+	19, // 10: talos.resource.definitions.secrets.EtcdCertsSpec.etcd_admin:type_name -> common.PEMEncodedCertificateAndKey
+	19, // 11: talos.resource.definitions.secrets.EtcdCertsSpec.etcd_api_server:type_name -> common.PEMEncodedCertificateAndKey
+	19, // 12: talos.resource.definitions.secrets.EtcdRootSpec.etcd_ca:type_name -> common.PEMEncodedCertificateAndKey
+	22, // 13: talos.resource.definitions.secrets.JoinTokenSpec.created_at:type_name -> google.protobuf.Timestamp
+	22, // 14: talos.resource.definitions.secrets.JoinTokenSpec.expires_at:type_name -> google.protobuf.Timestamp
+	23, // 15: talos.resource.definitions.secrets.KubeletSpec.endpoint:type_name -> common.URL
+	20, // 16: talos.resource.definitions.secrets.KubeletSpec.accepted_c_as:type_name -> common.PEMEncodedCertificate
+	19, // 17: talos.resource.definitions.secrets.KubernetesDynamicCertsSpec.api_server:type_name -> common.PEMEncodedCertificateAndKey
+	19, // 18: talos.resource.definitions.secrets.KubernetesDynamicCertsSpec.api_server_kubelet_client:type_name -> common.PEMEncodedCertificateAndKey
+	19, // 19: talos.resource.definitions.secrets.KubernetesDynamicCertsSpec.front_proxy:type_name -> common.PEMEncodedCertificateAndKey
+	23, // 20: talos.resource.definitions.secrets.KubernetesRootSpec.endpoint:type_name -> common.URL
+	23, // 21: talos.resource.definitions.secrets.KubernetesRootSpec.local_endpoint:type_name -> common.URL
+	19, // 22: talos.resource.definitions.secrets.KubernetesRootSpec.issuing_ca:type_name -> common.PEMEncodedCertificateAndKey
+	24, // 23: talos.resource.definitions.secrets.KubernetesRootSpec.service_account:type_name -> common.PEMEncodedKey
+	19, // 24: talos.resource.definitions.secrets.KubernetesRootSpec.aggregator_ca:type_name -> common.PEMEncodedCertificateAndKey
+	21, // 25: talos.resource.definitions.secrets.KubernetesRootSpec.api_server_ips:type_name -> common.NetIP
+	20, // 26: talos.resource.definitions.secrets.KubernetesRootSpec.accepted_c_as:type_name -> common.PEMEncodedCertificate
+	19, // 27: talos.resource.definitions.secrets.MaintenanceRootSpec.ca:type_name -> common.PEMEncodedCertificateAndKey
+	19, // 28: talos.resource.definitions.secrets.MaintenanceServiceCertsSpec.ca:type_name -> common.PEMEncodedCertificateAndKey
+	19, // 29: talos.resource.definitions.secrets.MaintenanceServiceCertsSpec.server:type_name -> common.PEMEncodedCertificateAndKey
+	19, // 30: talos.resource.definitions.secrets.OSRootSpec.issuing_ca:type_name -> common.PEMEncodedCertificateAndKey
+	21, // 31: talos.resource.definitions.secrets.OSRootSpec.cert_sani_ps:type_name -> common.NetIP
+	20, // 32: talos.resource.definitions.secrets.OSRootSpec.accepted_c_as:type_name -> common.PEMEncodedCertificate
+	19, // 33: talos.resource.definitions.secrets.TrustdCertsSpec.server:type_name -> common.PEMEncodedCertificateAndKey
+	20, // 34: talos.resource.definitions.secrets.TrustdCertsSpec.accepted_c_as:type_name -> common.PEMEncodedCertificate
+	25, // 35: talos.resource.definitions.secrets.TrustdPolicySpec.allowed_subnets:type_name -> common.NetIPPrefix
+	26, // 36: talos.resource.definitions.secrets.TrustdPolicySpec.max_certificate_lifetime:type_name -> google.protobuf.Duration
+	37, // [37:37] is the sub-list for method output_type
+	37, // [37:37] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
 }
 
 func init() { file_resource_definitions_secrets_secrets_proto_init() }
@@ -1039,7 +1544,7 @@ func file_resource_definitions_secrets_secrets_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resource_definitions_secrets_secrets_proto_rawDesc), len(file_resource_definitions_secrets_secrets_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -14,6 +14,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -124,19 +125,145 @@ func (x *AdjtimeStatusSpec) GetState() string {
 	return ""
 }
 
+// SourceStatusSpec describes the state of a time server as observed by the time sync process.
+type SourceStatusSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Server        string                 `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Priority      int64                  `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	Reachable     bool                   `protobuf:"varint,4,opt,name=reachable,proto3" json:"reachable,omitempty"`
+	Selected      bool                   `protobuf:"varint,5,opt,name=selected,proto3" json:"selected,omitempty"`
+	Stratum       uint32                 `protobuf:"varint,6,opt,name=stratum,proto3" json:"stratum,omitempty"`
+	Offset        *durationpb.Duration   `protobuf:"bytes,7,opt,name=offset,proto3" json:"offset,omitempty"`
+	Rtt           *durationpb.Duration   `protobuf:"bytes,8,opt,name=rtt,proto3" json:"rtt,omitempty"`
+	LastQuery     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_query,json=lastQuery,proto3" json:"last_query,omitempty"`
+	LastError     string                 `protobuf:"bytes,10,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	Authenticated bool                   `protobuf:"varint,11,opt,name=authenticated,proto3" json:"authenticated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SourceStatusSpec) Reset() {
+	*x = SourceStatusSpec{}
+	mi := &file_resource_definitions_time_time_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SourceStatusSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SourceStatusSpec) ProtoMessage() {}
+
+func (x *SourceStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_time_time_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SourceStatusSpec.ProtoReflect.Descriptor instead.
+func (*SourceStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_time_time_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SourceStatusSpec) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+func (x *SourceStatusSpec) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SourceStatusSpec) GetPriority() int64 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *SourceStatusSpec) GetReachable() bool {
+	if x != nil {
+		return x.Reachable
+	}
+	return false
+}
+
+func (x *SourceStatusSpec) GetSelected() bool {
+	if x != nil {
+		return x.Selected
+	}
+	return false
+}
+
+func (x *SourceStatusSpec) GetStratum() uint32 {
+	if x != nil {
+		return x.Stratum
+	}
+	return 0
+}
+
+func (x *SourceStatusSpec) GetOffset() *durationpb.Duration {
+	if x != nil {
+		return x.Offset
+	}
+	return nil
+}
+
+func (x *SourceStatusSpec) GetRtt() *durationpb.Duration {
+	if x != nil {
+		return x.Rtt
+	}
+	return nil
+}
+
+func (x *SourceStatusSpec) GetLastQuery() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastQuery
+	}
+	return nil
+}
+
+func (x *SourceStatusSpec) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *SourceStatusSpec) GetAuthenticated() bool {
+	if x != nil {
+		return x.Authenticated
+	}
+	return false
+}
+
 // StatusSpec describes time sync state.
 type StatusSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Synced        bool                   `protobuf:"varint,1,opt,name=synced,proto3" json:"synced,omitempty"`
 	Epoch         int64                  `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
 	SyncDisabled  bool                   `protobuf:"varint,3,opt,name=sync_disabled,json=syncDisabled,proto3" json:"sync_disabled,omitempty"`
+	Authenticated bool                   `protobuf:"varint,4,opt,name=authenticated,proto3" json:"authenticated,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *StatusSpec) Reset() {
 	*x = StatusSpec{}
-	mi := &file_resource_definitions_time_time_proto_msgTypes[1]
+	mi := &file_resource_definitions_time_time_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -148,7 +275,7 @@ func (x *StatusSpec) String() string {
 func (*StatusSpec) ProtoMessage() {}
 
 func (x *StatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_time_time_proto_msgTypes[1]
+	mi := &file_resource_definitions_time_time_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -161,7 +288,7 @@ func (x *StatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatusSpec.ProtoReflect.Descriptor instead.
 func (*StatusSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_time_time_proto_rawDescGZIP(), []int{1}
+	return file_resource_definitions_time_time_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *StatusSpec) GetSynced() bool {
@@ -185,11 +312,18 @@ func (x *StatusSpec) GetSyncDisabled() bool {
 	return false
 }
 
+func (x *StatusSpec) GetAuthenticated() bool {
+	if x != nil {
+		return x.Authenticated
+	}
+	return false
+}
+
 var File_resource_definitions_time_time_proto protoreflect.FileDescriptor
 
 const file_resource_definitions_time_time_proto_rawDesc = "" +
 	"\n" +
-	"$resource/definitions/time/time.proto\x12\x1ftalos.resource.definitions.time\x1a\x1egoogle/protobuf/duration.proto\"\xdf\x02\n" +
+	"$resource/definitions/time/time.proto\x12\x1ftalos.resource.definitions.time\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xdf\x02\n" +
 	"\x11AdjtimeStatusSpec\x121\n" +
 	"\x06offset\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x06offset\x12<\n" +
 	"\x1afrequency_adjustment_ratio\x18\x02 \x01(\x01R\x18frequencyAdjustmentRatio\x126\n" +
@@ -199,12 +333,28 @@ const file_resource_definitions_time_time_proto_rawDesc = "" +
 	"\bconstant\x18\x06 \x01(\x03R\bconstant\x12\x1f\n" +
 	"\vsync_status\x18\a \x01(\bR\n" +
 	"syncStatus\x12\x14\n" +
-	"\x05state\x18\b \x01(\tR\x05state\"_\n" +
+	"\x05state\x18\b \x01(\tR\x05state\"\x94\x03\n" +
+	"\x10SourceStatusSpec\x12\x16\n" +
+	"\x06server\x18\x01 \x01(\tR\x06server\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x1a\n" +
+	"\bpriority\x18\x03 \x01(\x03R\bpriority\x12\x1c\n" +
+	"\treachable\x18\x04 \x01(\bR\treachable\x12\x1a\n" +
+	"\bselected\x18\x05 \x01(\bR\bselected\x12\x18\n" +
+	"\astratum\x18\x06 \x01(\rR\astratum\x121\n" +
+	"\x06offset\x18\a \x01(\v2\x19.google.protobuf.DurationR\x06offset\x12+\n" +
+	"\x03rtt\x18\b \x01(\v2\x19.google.protobuf.DurationR\x03rtt\x129\n" +
+	"\n" +
+	"last_query\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tlastQuery\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\n" +
+	" \x01(\tR\tlastError\x12$\n" +
+	"\rauthenticated\x18\v \x01(\bR\rauthenticated\"\x85\x01\n" +
 	"\n" +
 	"StatusSpec\x12\x16\n" +
 	"\x06synced\x18\x01 \x01(\bR\x06synced\x12\x14\n" +
 	"\x05epoch\x18\x02 \x01(\x03R\x05epoch\x12#\n" +
-	"\rsync_disabled\x18\x03 \x01(\bR\fsyncDisabledBr\n" +
+	"\rsync_disabled\x18\x03 \x01(\bR\fsyncDisabled\x12$\n" +
+	"\rauthenticated\x18\x04 \x01(\bR\rauthenticatedBr\n" +
 	"'dev.talos.api.resource.definitions.timeZGgithub.com/siderolabs/talos/pkg/machinery/api/resource/definitions/timeb\x06proto3"
 
 var (
@@ -219,21 +369,26 @@ func file_resource_definitions_time_time_proto_rawDescGZIP() []byte {
 	return file_resource_definitions_time_time_proto_rawDescData
 }
 
-var file_resource_definitions_time_time_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_resource_definitions_time_time_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_resource_definitions_time_time_proto_goTypes = []any{
-	(*AdjtimeStatusSpec)(nil),   // 0: talos.resource.definitions.time.AdjtimeStatusSpec
-	(*StatusSpec)(nil),          // 1: talos.resource.definitions.time.StatusSpec
-	(*durationpb.Duration)(nil), // 2: google.protobuf.Duration
+	(*AdjtimeStatusSpec)(nil),     // 0: talos.resource.definitions.time.AdjtimeStatusSpec
+	(*SourceStatusSpec)(nil),      // 1: talos.resource.definitions.time.SourceStatusSpec
+	(*StatusSpec)(nil),            // 2: talos.resource.definitions.time.StatusSpec
+	(*durationpb.Duration)(nil),   // 3: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
 }
 var file_resource_definitions_time_time_proto_depIdxs = []int32{
-	2, // 0: talos.resource.definitions.time.AdjtimeStatusSpec.offset:type_name -> google.protobuf.Duration
-	2, // 1: talos.resource.definitions.time.AdjtimeStatusSpec.max_error:type_name -> google.protobuf.Duration
-	2, // 2: talos.resource.definitions.time.AdjtimeStatusSpec.est_error:type_name -> google.protobuf.Duration
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	3, // 0: talos.resource.definitions.time.AdjtimeStatusSpec.offset:type_name -> google.protobuf.Duration
+	3, // 1: talos.resource.definitions.time.AdjtimeStatusSpec.max_error:type_name -> google.protobuf.Duration
+	3, // 2: talos.resource.definitions.time.AdjtimeStatusSpec.est_error:type_name -> google.protobuf.Duration
+	3, // 3: talos.resource.definitions.time.SourceStatusSpec.offset:type_name -> google.protobuf.Duration
+	3, // 4: talos.resource.definitions.time.SourceStatusSpec.rtt:type_name -> google.protobuf.Duration
+	4, // 5: talos.resource.definitions.time.SourceStatusSpec.last_query:type_name -> google.protobuf.Timestamp
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_resource_definitions_time_time_proto_init() }
@@ -247,7 +402,7 @@ func file_resource_definitions_time_time_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resource_definitions_time_time_proto_rawDesc), len(file_resource_definitions_time_time_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -23,6 +23,7 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	clusterapi "github.com/siderolabs/talos/pkg/machinery/api/cluster"
 	"github.com/siderolabs/talos/pkg/machinery/api/common"
@@ -45,7 +46,7 @@ type Client struct {
 	StorageClient storageapi.StorageServiceClient
 	InspectClient inspectapi.InspectServiceClient
 
-	COSI state.State
+	COSI *COSIClient
 
 	Inspect *InspectClient
 }
@@ -171,7 +172,7 @@ func New(_ context.Context, opts ...OptionFunc) (c *Client, err error) {
 	c.InspectClient = inspectapi.NewInspectServiceClient(c.conn)
 
 	c.Inspect = &InspectClient{c.InspectClient}
-	c.COSI = state.WrapCore(client.NewAdapter(cosiv1alpha1.NewStateClient(c.conn)))
+	c.COSI = &COSIClient{state.WrapCore(client.NewAdapter(cosiv1alpha1.NewStateClient(c.conn)))}
 
 	return c, nil
 }
@@ -240,6 +241,13 @@ func (c *Client) Kubeconfig(ctx context.Context) ([]byte, error) {
 	return c.extractKubeconfig(r)
 }
 
+// KubernetesUpgradePreflight runs the Kubernetes upgrade pre-flight checks on the node.
+func (c *Client) KubernetesUpgradePreflight(ctx context.Context, req *machineapi.KubernetesUpgradePreflightRequest, callOptions ...grpc.CallOption) (resp *machineapi.KubernetesUpgradePreflightResponse, err error) {
+	resp, err = c.MachineClient.KubernetesUpgradePreflight(ctx, req, callOptions...)
+
+	return FilterMessages(resp, err)
+}
+
 // ApplyConfiguration implements proto.MachineServiceClient interface.
 func (c *Client) ApplyConfiguration(ctx context.Context, req *machineapi.ApplyConfigurationRequest, callOptions ...grpc.CallOption) (resp *machineapi.ApplyConfigurationResponse, err error) {
 	resp, err = c.MachineClient.ApplyConfiguration(ctx, req, callOptions...)
@@ -337,6 +345,11 @@ func WithPowerCycle(req *machineapi.RebootRequest) {
 	req.Mode = machineapi.RebootRequest_POWERCYCLE
 }
 
+// WithIgnoreInhibitors option runs the Reboot without waiting for the shutdown inhibitors.
+func WithIgnoreInhibitors(req *machineapi.RebootRequest) {
+	req.IgnoreInhibitors = true
+}
+
 // Reboot implements the proto.MachineServiceClient interface.
 func (c *Client) Reboot(ctx context.Context, opts ...RebootMode) error {
 	_, err := c.RebootWithResponse(ctx, opts...)
@@ -369,6 +382,41 @@ func (c *Client) Rollback(ctx context.Context) (err error) {
 	return
 }
 
+// RotateCertificates forces early rotation of the certificates of the selected components.
+func (c *Client) RotateCertificates(ctx context.Context, req *machineapi.RotateCertificatesRequest, callOptions ...grpc.CallOption) (*machineapi.RotateCertificatesResponse, error) {
+	resp, err := c.MachineClient.RotateCertificates(ctx, req, callOptions...)
+
+	return FilterMessages(resp, err)
+}
+
+// RotateDiskKey rotates the disk encryption keys of the volume.
+func (c *Client) RotateDiskKey(ctx context.Context, req *machineapi.RotateDiskKeyRequest, callOptions ...grpc.CallOption) (*machineapi.RotateDiskKeyResponse, error) {
+	resp, err := c.MachineClient.RotateDiskKey(ctx, req, callOptions...)
+
+	return FilterMessages(resp, err)
+}
+
+// Attest produces a TPM quote of the PCR values over the nonce.
+func (c *Client) Attest(ctx context.Context, req *machineapi.AttestRequest, callOptions ...grpc.CallOption) (*machineapi.AttestResponse, error) {
+	resp, err := c.MachineClient.Attest(ctx, req, callOptions...)
+
+	return FilterMessages(resp, err)
+}
+
+// ResetIdentity regenerates the node identity and the KubeSpan key of the machine.
+func (c *Client) ResetIdentity(ctx context.Context, req *machineapi.ResetIdentityRequest, callOptions ...grpc.CallOption) (*machineapi.ResetIdentityResponse, error) {
+	resp, err := c.MachineClient.ResetIdentity(ctx, req, callOptions...)
+
+	return FilterMessages(resp, err)
+}
+
+// SecretsBundleExport reconstructs the secrets bundle from the control plane node configuration.
+func (c *Client) SecretsBundleExport(ctx context.Context, req *machineapi.SecretsBundleExportRequest, callOptions ...grpc.CallOption) (*machineapi.SecretsBundleExportResponse, error) {
+	resp, err := c.MachineClient.SecretsBundleExport(ctx, req, callOptions...)
+
+	return FilterMessages(resp, err)
+}
+
 // Bootstrap implements the proto.MachineServiceClient interface.
 func (c *Client) Bootstrap(ctx context.Context, req *machineapi.BootstrapRequest) (err error) {
 	resp, err := c.MachineClient.Bootstrap(ctx, req)
@@ -389,6 +437,13 @@ func WithShutdownForce(force bool) ShutdownOption {
 	}
 }
 
+// WithShutdownIgnoreInhibitors shuts down the machine without waiting for the shutdown inhibitors.
+func WithShutdownIgnoreInhibitors(ignore bool) ShutdownOption {
+	return func(req *machineapi.ShutdownRequest) {
+		req.IgnoreInhibitors = ignore
+	}
+}
+
 // Shutdown implements the proto.MachineServiceClient interface.
 func (c *Client) Shutdown(ctx context.Context, opts ...ShutdownOption) error {
 	_, err := c.ShutdownWithResponse(ctx, opts...)
@@ -600,6 +655,94 @@ func (c *Client) ServiceList(ctx context.Context, callOptions ...grpc.CallOption
 	return FilterMessages(resp, err)
 }
 
+// ServiceDependencies returns the dependencies and the conditions of the services.
+//
+// If id is empty, all services are returned.
+func (c *Client) ServiceDependencies(ctx context.Context, id string, callOptions ...grpc.CallOption) (resp *machineapi.ServiceDependenciesResponse, err error) {
+	resp, err = c.MachineClient.ServiceDependencies(
+		ctx,
+		&machineapi.ServiceDependenciesRequest{Id: id},
+		callOptions...,
+	)
+
+	return FilterMessages(resp, err)
+}
+
+// InhibitorAcquire registers a shutdown inhibitor which delays the reboot, shutdown and upgrade until released.
+func (c *Client) InhibitorAcquire(ctx context.Context, who, why string, maxHold time.Duration, callOptions ...grpc.CallOption) (resp *machineapi.InhibitorAcquireResponse, err error) {
+	resp, err = c.MachineClient.InhibitorAcquire(
+		ctx,
+		&machineapi.InhibitorAcquireRequest{
+			Who:     who,
+			Why:     why,
+			MaxHold: durationpb.New(maxHold),
+		},
+		callOptions...,
+	)
+
+	return FilterMessages(resp, err)
+}
+
+// InhibitorList returns the registered shutdown inhibitors.
+func (c *Client) InhibitorList(ctx context.Context, callOptions ...grpc.CallOption) (resp *machineapi.InhibitorListResponse, err error) {
+	resp, err = c.MachineClient.InhibitorList(
+		ctx,
+		&emptypb.Empty{},
+		callOptions...,
+	)
+
+	return FilterMessages(resp, err)
+}
+
+// InhibitorRelease releases the shutdown inhibitor.
+func (c *Client) InhibitorRelease(ctx context.Context, id string, callOptions ...grpc.CallOption) (resp *machineapi.InhibitorReleaseResponse, err error) {
+	resp, err = c.MachineClient.InhibitorRelease(
+		ctx,
+		&machineapi.InhibitorReleaseRequest{Id: id},
+		callOptions...,
+	)
+
+	return FilterMessages(resp, err)
+}
+
+// JoinTokenCreate stores a named join token accepted by trustd, the token never expires if expiresAt is zero.
+func (c *Client) JoinTokenCreate(ctx context.Context, name, token string, expiresAt time.Time, callOptions ...grpc.CallOption) (resp *machineapi.JoinTokenCreateResponse, err error) {
+	req := &machineapi.JoinTokenCreateRequest{
+		Name:  name,
+		Token: token,
+	}
+
+	if !expiresAt.IsZero() {
+		req.ExpiresAt = timestamppb.New(expiresAt)
+	}
+
+	resp, err = c.MachineClient.JoinTokenCreate(ctx, req, callOptions...)
+
+	return FilterMessages(resp, err)
+}
+
+// JoinTokenList returns the join tokens accepted by trustd.
+func (c *Client) JoinTokenList(ctx context.Context, callOptions ...grpc.CallOption) (resp *machineapi.JoinTokenListResponse, err error) {
+	resp, err = c.MachineClient.JoinTokenList(
+		ctx,
+		&emptypb.Empty{},
+		callOptions...,
+	)
+
+	return FilterMessages(resp, err)
+}
+
+// JoinTokenRevoke removes the named join token.
+func (c *Client) JoinTokenRevoke(ctx context.Context, name string, callOptions ...grpc.CallOption) (resp *machineapi.JoinTokenRevokeResponse, err error) {
+	resp, err = c.MachineClient.JoinTokenRevoke(
+		ctx,
+		&machineapi.JoinTokenRevokeRequest{Name: name},
+		callOptions...,
+	)
+
+	return FilterMessages(resp, err)
+}
+
 // ServiceInfo provides info about a service and node metadata.
 type ServiceInfo struct {
 	Metadata *common.Metadata
@@ -730,6 +873,16 @@ func (c *Client) EtcdRemoveMemberByID(ctx context.Context, req *machineapi.EtcdR
 	return err
 }
 
+// EtcdPromoteMember promotes a learner member of etcd cluster by etcd member ID.
+func (c *Client) EtcdPromoteMember(ctx context.Context, req *machineapi.EtcdPromoteMemberRequest, callOptions ...grpc.CallOption) error {
+	resp, err := c.MachineClient.EtcdPromoteMember(ctx, req, callOptions...)
+	if err == nil {
+		_, err = FilterMessages(resp, err)
+	}
+
+	return err
+}
+
 // EtcdLeaveCluster makes node leave etcd cluster.
 func (c *Client) EtcdLeaveCluster(ctx context.Context, req *machineapi.EtcdLeaveClusterRequest, callOptions ...grpc.CallOption) error {
 	resp, err := c.MachineClient.EtcdLeaveCluster(ctx, req, callOptions...)
@@ -0,0 +1,301 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// COSIClient provides access to the COSI state API.
+//
+// COSIClient implements state.State, and adds helpers on top of it.
+type COSIClient struct {
+	state.State
+}
+
+// AggregatedEvent is a resource event tagged with the node it originated from.
+type AggregatedEvent struct {
+	state.Event
+
+	// Node is the node the event originated from (empty for the "current" node).
+	Node string
+
+	// Dropped is the number of resource events of the node dropped before this event
+	// because the consumer was not keeping up.
+	Dropped uint64
+}
+
+// AggregatedWatchOption configures WatchAggregated.
+type AggregatedWatchOption func(*aggregatedWatchOptions)
+
+type aggregatedWatchOptions struct {
+	namespace         resource.Namespace
+	id                resource.ID
+	bufferSize        int
+	reconnectInterval time.Duration
+	unmarshalOptions  []state.UnmarshalOption
+}
+
+// WithAggregatedNamespace sets the namespace of the watched resources.
+func WithAggregatedNamespace(namespace resource.Namespace) AggregatedWatchOption {
+	return func(opts *aggregatedWatchOptions) {
+		opts.namespace = namespace
+	}
+}
+
+// WithAggregatedID watches a single resource with the given ID instead of all resources of the type.
+func WithAggregatedID(id resource.ID) AggregatedWatchOption {
+	return func(opts *aggregatedWatchOptions) {
+		opts.id = id
+	}
+}
+
+// WithAggregatedBufferSize sets the size of the aggregated event buffer.
+func WithAggregatedBufferSize(size int) AggregatedWatchOption {
+	return func(opts *aggregatedWatchOptions) {
+		opts.bufferSize = size
+	}
+}
+
+// WithAggregatedReconnectInterval sets the initial interval between the reconnect attempts to a node.
+//
+// The interval is doubled on each failed attempt up to a minute.
+func WithAggregatedReconnectInterval(interval time.Duration) AggregatedWatchOption {
+	return func(opts *aggregatedWatchOptions) {
+		opts.reconnectInterval = interval
+	}
+}
+
+// WithAggregatedUnmarshalOptions sets the unmarshal options of the watched resources.
+func WithAggregatedUnmarshalOptions(unmarshalOptions ...state.UnmarshalOption) AggregatedWatchOption {
+	return func(opts *aggregatedWatchOptions) {
+		opts.unmarshalOptions = append(opts.unmarshalOptions, unmarshalOptions...)
+	}
+}
+
+const maxReconnectInterval = time.Minute
+
+// WatchAggregated watches the resources of the same type across multiple nodes, and delivers the events over a single channel.
+//
+// Each event is tagged with the node it originated from. If the watch on a node fails, the error is delivered
+// as an Errored event, and the watch is re-established in the background: it resumes from the last bookmark received
+// from the node, or bootstraps again (if the bookmark is not accepted anymore, e.g. the node rebooted).
+// Other nodes are not affected by the reconnects.
+//
+// The events are buffered, if the buffer is full, the resource events (Created, Updated, Destroyed) are dropped,
+// and the number of dropped events is reported in the next event delivered for the node.
+// Bootstrapped and Errored events are never dropped.
+//
+// The channel is closed when the context is canceled.
+func (c *COSIClient) WatchAggregated(ctx context.Context, nodes []string, resourceType resource.Type, opts ...AggregatedWatchOption) (<-chan AggregatedEvent, error) {
+	options := aggregatedWatchOptions{
+		bufferSize:        1024,
+		reconnectInterval: time.Second,
+	}
+
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	if len(nodes) == 0 {
+		// use "current" node
+		nodes = []string{""}
+	}
+
+	outCh := make(chan AggregatedEvent, options.bufferSize)
+
+	var wg sync.WaitGroup
+
+	for _, node := range nodes {
+		watcher := &nodeWatcher{
+			state:        c.State,
+			options:      &options,
+			resourceType: resourceType,
+			node:         node,
+			outCh:        outCh,
+		}
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			watcher.run(ctx)
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+
+		close(outCh)
+	}()
+
+	return outCh, nil
+}
+
+// nodeWatcher keeps the watch on a single node alive.
+type nodeWatcher struct {
+	state        state.State
+	options      *aggregatedWatchOptions
+	resourceType resource.Type
+	node         string
+	outCh        chan<- AggregatedEvent
+
+	bookmark state.Bookmark
+	dropped  uint64
+}
+
+func (w *nodeWatcher) run(ctx context.Context) {
+	if w.node != "" {
+		ctx = WithNode(ctx, w.node)
+	}
+
+	interval := w.options.reconnectInterval
+
+	for {
+		received, err := w.watch(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+
+		if err != nil && !w.deliver(ctx, state.Event{Type: state.Errored, Error: err}) {
+			return
+		}
+
+		if !received && w.bookmark != nil && !isUnavailable(err) {
+			// the node is reachable, but it doesn't accept the bookmark anymore (e.g. it was rebooted)
+			w.bookmark = nil
+		}
+
+		if received {
+			interval = w.options.reconnectInterval
+		} else {
+			interval = min(interval*2, maxReconnectInterval)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+	}
+}
+
+// isUnavailable returns true if the error means that the node is (temporarily) not reachable.
+func isUnavailable(err error) bool {
+	switch status.Code(err) { //nolint:exhaustive
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Canceled:
+		return true
+	default:
+		return false
+	}
+}
+
+// watch runs a single watch on the node until it fails.
+//
+// It returns true if the watch received at least one event before failing.
+func (w *nodeWatcher) watch(ctx context.Context) (bool, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	watchCh := make(chan state.Event)
+
+	md := resource.NewMetadata(w.options.namespace, w.resourceType, w.options.id, resource.VersionUndefined)
+
+	var err error
+
+	if w.options.id == "" {
+		watchOpts := []state.WatchKindOption{
+			state.WithWatchKindUnmarshalOptions(w.options.unmarshalOptions...),
+		}
+
+		if w.bookmark != nil {
+			watchOpts = append(watchOpts, state.WithKindStartFromBookmark(w.bookmark))
+		} else {
+			watchOpts = append(watchOpts, state.WithBootstrapContents(true), state.WithBootstrapBookmark(true))
+		}
+
+		err = w.state.WatchKind(ctx, md, watchCh, watchOpts...)
+	} else {
+		watchOpts := []state.WatchOption{
+			state.WithWatchUnmarshalOptions(w.options.unmarshalOptions...),
+		}
+
+		if w.bookmark != nil {
+			watchOpts = append(watchOpts, state.WithStartFromBookmark(w.bookmark))
+		}
+
+		err = w.state.Watch(ctx, md, watchCh, watchOpts...)
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	var received bool
+
+	for {
+		var ev state.Event
+
+		select {
+		case <-ctx.Done():
+			return received, nil
+		case ev = <-watchCh:
+		}
+
+		if ev.Type == state.Errored {
+			return received, ev.Error
+		}
+
+		received = true
+
+		if ev.Bookmark != nil {
+			w.bookmark = ev.Bookmark
+		}
+
+		if !w.deliver(ctx, ev) {
+			return received, nil
+		}
+	}
+}
+
+// deliver sends the event to the consumer.
+//
+// Resource events are dropped if the consumer is not keeping up, other events block until delivered.
+func (w *nodeWatcher) deliver(ctx context.Context, ev state.Event) bool {
+	aggregated := AggregatedEvent{
+		Event:   ev,
+		Node:    w.node,
+		Dropped: w.dropped,
+	}
+
+	switch ev.Type {
+	case state.Created, state.Updated, state.Destroyed:
+		select {
+		case w.outCh <- aggregated:
+			w.dropped = 0
+		case <-ctx.Done():
+			return false
+		default:
+			w.dropped++
+		}
+	case state.Bootstrapped, state.Errored, state.Noop:
+		select {
+		case w.outCh <- aggregated:
+			w.dropped = 0
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,262 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package client_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// fakeNode is a node with its own state, which can go away and come back.
+type fakeNode struct {
+	state.State
+
+	down    bool
+	cancels []context.CancelFunc
+}
+
+// fakeNodes routes the watches to the nodes based on the context metadata, like apid does.
+type fakeNodes struct {
+	state.State // not used
+
+	mu    sync.Mutex
+	nodes map[string]*fakeNode
+}
+
+func newFakeNodes(nodes ...string) *fakeNodes {
+	f := &fakeNodes{
+		nodes: map[string]*fakeNode{},
+	}
+
+	for _, node := range nodes {
+		f.nodes[node] = &fakeNode{
+			State: state.WrapCore(namespaced.NewState(
+				func(ns string) state.CoreState {
+					return inmem.NewStateWithOptions(
+						inmem.WithHistoryInitialCapacity(8),
+						inmem.WithHistoryMaxCapacity(1024),
+					)(ns)
+				},
+			)),
+		}
+	}
+
+	return f
+}
+
+func (f *fakeNodes) setDown(node string, down bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	n := f.nodes[node]
+	n.down = down
+
+	if down {
+		for _, cancel := range n.cancels {
+			cancel()
+		}
+
+		n.cancels = nil
+	}
+}
+
+func (f *fakeNodes) WatchKind(ctx context.Context, kind resource.Kind, ch chan<- state.Event, opts ...state.WatchKindOption) error {
+	md, _ := metadata.FromOutgoingContext(ctx)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	n := f.nodes[md.Get("node")[0]]
+	if n.down {
+		return status.Error(codes.Unavailable, "node is down")
+	}
+
+	watchCtx, cancel := context.WithCancel(ctx)
+	n.cancels = append(n.cancels, cancel)
+
+	innerCh := make(chan state.Event)
+
+	if err := n.State.WatchKind(watchCtx, kind, innerCh, opts...); err != nil {
+		cancel()
+
+		return err
+	}
+
+	go func() {
+		for {
+			select {
+			case ev := <-innerCh:
+				select {
+				case ch <- ev:
+				case <-ctx.Done():
+					return
+				}
+			case <-watchCtx.Done():
+				if ctx.Err() == nil {
+					select {
+					case ch <- state.Event{Type: state.Errored, Error: status.Error(codes.Unavailable, "connection lost")}:
+					case <-ctx.Done():
+					}
+				}
+
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+func collectEvents(t *testing.T, eventCh <-chan client.AggregatedEvent, count int) []client.AggregatedEvent {
+	t.Helper()
+
+	events := make([]client.AggregatedEvent, 0, count)
+
+	for len(events) < count {
+		select {
+		case ev := <-eventCh:
+			events = append(events, ev)
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "timeout waiting for events", "received %d events", len(events))
+		}
+	}
+
+	return events
+}
+
+func TestWatchAggregatedReconnect(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	nodes := newFakeNodes("node1", "node2")
+
+	require.NoError(t, nodes.nodes["node1"].Create(ctx, runtime.NewMetaKey(runtime.NamespaceName, "1")))
+	require.NoError(t, nodes.nodes["node2"].Create(ctx, runtime.NewMetaKey(runtime.NamespaceName, "2")))
+
+	cosi := &client.COSIClient{State: nodes}
+
+	eventCh, err := cosi.WatchAggregated(ctx, []string{"node1", "node2"}, runtime.MetaKeyType,
+		client.WithAggregatedNamespace(runtime.NamespaceName),
+		client.WithAggregatedReconnectInterval(10*time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	// initial contents and bootstrap events from both nodes
+	events := collectEvents(t, eventCh, 4)
+
+	for _, node := range []string{"node1", "node2"} {
+		var nodeEvents []state.EventType
+
+		for _, ev := range events {
+			if ev.Node == node {
+				nodeEvents = append(nodeEvents, ev.Type)
+			}
+		}
+
+		assert.Equal(t, []state.EventType{state.Created, state.Bootstrapped}, nodeEvents, "node %s", node)
+	}
+
+	// node1 goes away, node2 keeps delivering events
+	nodes.setDown("node1", true)
+
+	ev := collectEvents(t, eventCh, 1)[0]
+	assert.Equal(t, "node1", ev.Node)
+	assert.Equal(t, state.Errored, ev.Type)
+
+	require.NoError(t, nodes.nodes["node1"].Create(ctx, runtime.NewMetaKey(runtime.NamespaceName, "1-missed")))
+	require.NoError(t, nodes.nodes["node2"].Create(ctx, runtime.NewMetaKey(runtime.NamespaceName, "2-while-down")))
+
+	for {
+		ev = collectEvents(t, eventCh, 1)[0]
+
+		if ev.Node == "node1" {
+			// failed reconnect attempts
+			assert.Equal(t, state.Errored, ev.Type)
+
+			continue
+		}
+
+		assert.Equal(t, "node2", ev.Node)
+		assert.Equal(t, state.Created, ev.Type)
+		assert.Equal(t, "2-while-down", ev.Resource.Metadata().ID())
+
+		break
+	}
+
+	// node1 comes back, the watch resumes from the bookmark: the missed event is delivered, without the initial contents
+	nodes.setDown("node1", false)
+
+	for {
+		ev = collectEvents(t, eventCh, 1)[0]
+
+		if ev.Type == state.Errored {
+			continue
+		}
+
+		assert.Equal(t, "node1", ev.Node)
+		assert.Equal(t, state.Created, ev.Type)
+		assert.Equal(t, "1-missed", ev.Resource.Metadata().ID())
+
+		break
+	}
+
+	require.NoError(t, nodes.nodes["node2"].Create(ctx, runtime.NewMetaKey(runtime.NamespaceName, "2-after")))
+
+	ev = collectEvents(t, eventCh, 1)[0]
+	assert.Equal(t, "node2", ev.Node)
+	assert.Equal(t, "2-after", ev.Resource.Metadata().ID())
+}
+
+func TestWatchAggregatedDropped(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	nodes := newFakeNodes("node1")
+
+	cosi := &client.COSIClient{State: nodes}
+
+	eventCh, err := cosi.WatchAggregated(ctx, []string{"node1"}, runtime.MetaKeyType,
+		client.WithAggregatedNamespace(runtime.NamespaceName),
+		client.WithAggregatedBufferSize(2),
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, state.Bootstrapped, collectEvents(t, eventCh, 1)[0].Type)
+
+	// the consumer is not reading, so the buffer overflows
+	for _, id := range []string{"1", "2", "3", "4", "5"} {
+		require.NoError(t, nodes.nodes["node1"].Create(ctx, runtime.NewMetaKey(runtime.NamespaceName, id)))
+	}
+
+	// wait for the watcher to process all events
+	time.Sleep(100 * time.Millisecond)
+
+	events := collectEvents(t, eventCh, 2)
+	assert.Equal(t, "1", events[0].Resource.Metadata().ID())
+	assert.Equal(t, "2", events[1].Resource.Metadata().ID())
+
+	require.NoError(t, nodes.nodes["node1"].Create(ctx, runtime.NewMetaKey(runtime.NamespaceName, "6")))
+
+	ev := collectEvents(t, eventCh, 1)[0]
+	assert.Equal(t, "6", ev.Resource.Metadata().ID())
+	assert.EqualValues(t, 3, ev.Dropped)
+}
@@ -56,6 +56,22 @@ func WithActorID(actorID string) EventsOptionFunc {
 	}
 }
 
+// WithSinceBoot sets up Events API to replay the persisted events starting with the boot relative to the current one.
+//
+// For example, -1 returns the events of the previous boot followed by the events of the current boot.
+func WithSinceBoot(boot int32) EventsOptionFunc {
+	return func(opts *machineapi.EventsRequest) {
+		opts.SinceBoot = boot
+	}
+}
+
+// WithReplayPersisted sets up Events API to replay all persisted events of the previous boots.
+func WithReplayPersisted() EventsOptionFunc {
+	return func(opts *machineapi.EventsRequest) {
+		opts.ReplayPersisted = true
+	}
+}
+
 // Events implements the proto.OSClient interface.
 func (c *Client) Events(ctx context.Context, opts ...EventsOptionFunc) (stream machineapi.MachineService_EventsClient, err error) {
 	var req machineapi.EventsRequest
@@ -73,6 +89,7 @@ type Event struct {
 	TypeURL string
 	ID      string
 	ActorID string
+	BootID  string
 	Payload proto.Message
 }
 
@@ -239,6 +256,26 @@ func UnmarshalEvent(event *machineapi.Event) (*Event, error) {
 		&machineapi.ConfigValidationErrorEvent{},
 		&machineapi.AddressEvent{},
 		&machineapi.MachineStatusEvent{},
+		&machineapi.VIPEvent{},
+		&machineapi.StaticFallbackEvent{},
+		&machineapi.ProbeEvent{},
+		&machineapi.EtcdDefragmentationEvent{},
+		&machineapi.EtcdLearnerStuckEvent{},
+		&machineapi.EtcdSnapshotEvent{},
+		&machineapi.EtcdAlarmEvent{},
+		&machineapi.CertificateExpiryEvent{},
+		&machineapi.StaticPodRestartStormEvent{},
+		&machineapi.KubeletShutdownEvent{},
+		&machineapi.ShutdownImminentEvent{},
+		&machineapi.UpgradeEvent{},
+		&machineapi.ZombieProcessesEvent{},
+		&machineapi.CertificateSigningEvent{},
+		&machineapi.APIAuthorizationDeniedEvent{},
+		&machineapi.DiskKeyRotationEvent{},
+		&machineapi.SecretsBundleExportEvent{},
+		&machineapi.JoinTokenEvent{},
+		&machineapi.IdentityConflictEvent{},
+		&machineapi.CertificateRevokedEvent{},
 	} {
 		if typeURL == "talos/runtime/"+string(eventType.ProtoReflect().Descriptor().FullName()) {
 			msg = eventType
@@ -263,6 +300,7 @@ func UnmarshalEvent(event *machineapi.Event) (*Event, error) {
 		ID:      event.Id,
 		Payload: msg,
 		ActorID: event.ActorId,
+		BootID:  event.BootId,
 	}
 
 	if event.Metadata != nil {
@@ -24,3 +24,34 @@ func (c *InspectClient) ControllerRuntimeDependencies(ctx context.Context, callO
 
 	return FilterMessages(resp, err)
 }
+
+// BootstrapManifests returns the objects of the rendered bootstrap manifests.
+//
+// If diff is set, the diff against the live cluster objects is computed.
+func (c *InspectClient) BootstrapManifests(ctx context.Context, diff bool, callOptions ...grpc.CallOption) (*inspectapi.BootstrapManifestsResponse, error) {
+	resp, err := c.client.BootstrapManifests(ctx, &inspectapi.BootstrapManifestsRequest{Diff: diff}, callOptions...)
+
+	return FilterMessages(resp, err)
+}
+
+// ResourceSchema returns the JSON Schema of the resource spec.
+func (c *InspectClient) ResourceSchema(ctx context.Context, resourceType string, callOptions ...grpc.CallOption) (*inspectapi.ResourceSchemaResponse, error) {
+	resp, err := c.client.ResourceSchema(ctx, &inspectapi.ResourceSchemaRequest{Type: resourceType}, callOptions...)
+
+	return FilterMessages(resp, err)
+}
+
+// ResourceHistory returns the recorded revisions of the resource type.
+//
+// If id is not empty, only the revisions of the resource with the ID are returned.
+// If both from and to revisions are set, the diff between them is computed.
+func (c *InspectClient) ResourceHistory(ctx context.Context, resourceType, id string, from, to uint64, callOptions ...grpc.CallOption) (*inspectapi.ResourceHistoryResponse, error) {
+	resp, err := c.client.ResourceHistory(ctx, &inspectapi.ResourceHistoryRequest{
+		Type:         resourceType,
+		Id:           id,
+		FromRevision: from,
+		ToRevision:   to,
+	}, callOptions...)
+
+	return FilterMessages(resp, err)
+}
@@ -0,0 +1,29 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package config
+
+// Bootstrap manifest object policies.
+const (
+	// BootstrapManifestPolicySkip excludes the object from the bootstrap manifests.
+	BootstrapManifestPolicySkip = "skip"
+	// BootstrapManifestPolicyCreateOnly creates the object if it is missing, but never updates it.
+	BootstrapManifestPolicyCreateOnly = "create-only"
+)
+
+// BootstrapManifestsConfig defines the interface to access bootstrap manifests synchronization configuration.
+type BootstrapManifestsConfig interface {
+	ObjectPolicies() []BootstrapManifestObjectPolicy
+}
+
+// BootstrapManifestObjectPolicy defines the interface to access the synchronization policy of the bootstrap manifest objects.
+//
+// Empty selector fields match any value.
+type BootstrapManifestObjectPolicy interface {
+	Manifest() string
+	Kind() string
+	Namespace() string
+	Name() string
+	Policy() string
+}
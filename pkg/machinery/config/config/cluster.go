@@ -45,6 +45,8 @@ type ClusterConfig interface {
 	AdminKubeconfig() AdminKubeconfig
 	ScheduleOnControlPlanes() bool
 	Discovery() Discovery
+	// KubeletServingCertificateApprover returns kubelet serving certificate approver settings.
+	KubeletServingCertificateApprover() KubeletServingCertificateApprover
 }
 
 // ClusterNetwork defines the requirements for a config that pertains to cluster
@@ -143,6 +145,33 @@ type Etcd interface {
 	ExtraArgs() map[string]string
 	AdvertisedSubnets() []string
 	ListenSubnets() []string
+	// DefragmentationInterval returns the interval of the scheduled defragmentation, zero if it is disabled.
+	DefragmentationInterval() time.Duration
+	// DefragmentationThreshold returns the minimum percentage of the database size not in use to run defragmentation.
+	DefragmentationThreshold() int
+	// LearnerMaxLagEntries returns the maximum number of raft entries the learner can lag behind to be promoted.
+	LearnerMaxLagEntries() uint64
+	// LearnerStuckTimeout returns the time after which a learner which is not promoted is reported as stuck.
+	LearnerStuckTimeout() time.Duration
+	// MaxLearners returns the maximum number of learners in the cluster at the same time.
+	MaxLearners() int
+	// SnapshotInterval returns the interval of the scheduled snapshots, zero if it is disabled.
+	SnapshotInterval() time.Duration
+	// SnapshotRetention returns the number of the scheduled snapshots to keep on the node.
+	SnapshotRetention() int
+	// SnapshotUpload returns the object storage destination of the scheduled snapshots, nil if the upload is disabled.
+	SnapshotUpload() EtcdSnapshotUpload
+}
+
+// EtcdSnapshotUpload defines the S3-compatible object storage destination of the scheduled etcd snapshots.
+type EtcdSnapshotUpload interface {
+	Endpoint() string
+	Region() string
+	Bucket() string
+	Prefix() string
+	AccessKeyID() string
+	SecretAccessKey() string
+	ServerSideEncryption() bool
 }
 
 // Token defines the requirements for a config that pertains to Kubernetes
@@ -167,6 +196,12 @@ type ExternalCloudProvider interface {
 	ManifestURLs() []string
 }
 
+// KubeletServingCertificateApprover defines settings for the kubelet serving certificate approver.
+type KubeletServingCertificateApprover interface {
+	// Enabled returns true if kubelet serving certificate signing requests should be approved by Talos.
+	Enabled() bool
+}
+
 // AdminKubeconfig defines settings for admin kubeconfig.
 type AdminKubeconfig interface {
 	CommonName() string
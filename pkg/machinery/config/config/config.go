@@ -12,13 +12,31 @@ type Config interface { //nolint:interfacebloat
 	Cluster() ClusterConfig
 	SideroLink() SideroLinkConfig
 	ExtensionServiceConfigs() []ExtensionServiceConfig
+	ExtensionVerificationConfig() ExtensionVerificationConfig
 	Runtime() RuntimeConfig
 	NetworkRules() NetworkRuleConfig
+	NetworkAddressSets() []NetworkAddressSetConfig
 	TrustedRoots() TrustedRootsConfig
+	CertificateExpiryConfig() CertificateExpiryConfig
+	CredentialRecoveryConfig() CredentialRecoveryConfig
+	TrustdPolicyConfig() TrustdPolicyConfig
+	APIAuthorizationConfig() APIAuthorizationConfig
+	CertificateRevocationConfig() CertificateRevocationConfig
 	Volumes() VolumesConfig
 	KubespanConfig() KubespanConfig
 	PCIDriverRebindConfig() PCIDriverRebindConfig
+	CPUIsolationConfig() CPUIsolationConfig
+	ContainerdConfig() ContainerdConfig
+	DefaultSecurityProfilesConfig() DefaultSecurityProfilesConfig
+	ServiceResourcesConfigs() []ServiceResourcesConfig
+	EventLogConfig() EventLogConfig
+	ResourceHistoryConfig() ResourceHistoryConfig
+	KmsgLogDestinations() []KmsgLogDestinationConfig
+	KubeAPIServerAuditConfig() KubeAPIServerAuditConfig
+	BootstrapManifestsConfig() BootstrapManifestsConfig
+	CoreDNSConfig() CoreDNSConfig
 	EthernetConfigs() []EthernetConfig
+	SRIOVConfigs() []SRIOVConfig
 	UserVolumeConfigs() []UserVolumeConfig
 	RawVolumeConfigs() []RawVolumeConfig
 	ExistingVolumeConfigs() []ExistingVolumeConfig
@@ -26,4 +44,8 @@ type Config interface { //nolint:interfacebloat
 	ZswapConfig() ZswapConfig
 	NetworkStaticHostConfig() []NetworkStaticHostConfig
 	NetworkHostnameConfig() NetworkHostnameConfig
+	NetworkRouteSourceConfig() NetworkRouteSourceConfig
+	NetworkDefaultRouteConfig() NetworkDefaultRouteConfig
+	NetworkProbeConfigs() []NetworkProbeConfig
+	NetworkLLDPConfigs() []NetworkLLDPConfig
 }
@@ -2,15 +2,10 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-package encryption_test
+package config
 
-import (
-	"testing"
-)
-
-func TestEmpty(t *testing.T) {
-	// added for accurate coverage estimation
-	//
-	// please remove it once any unit-test is added
-	// for this package
+// ContainerdConfig defines the interface to access containerd configuration patches.
+type ContainerdConfig interface {
+	CRIConfigPatch() string
+	SystemConfigPatch() string
 }
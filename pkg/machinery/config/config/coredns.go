@@ -0,0 +1,21 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package config
+
+import "github.com/siderolabs/gen/optional"
+
+// CoreDNSConfig defines the interface to access CoreDNS configuration overrides.
+type CoreDNSConfig interface {
+	StubDomains() []CoreDNSStubDomain
+	ExtraServerBlocks() string
+	Replicas() optional.Optional[int]
+	Resources() Resources
+}
+
+// CoreDNSStubDomain defines the interface to access CoreDNS stub domain configuration.
+type CoreDNSStubDomain interface {
+	Domain() string
+	Upstreams() []string
+}
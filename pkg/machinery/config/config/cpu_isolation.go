@@ -0,0 +1,13 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package config
+
+import "github.com/siderolabs/talos/pkg/machinery/cpuset"
+
+// CPUIsolationConfig defines the interface to access CPU isolation configuration.
+type CPUIsolationConfig interface {
+	IsolatedCPUs() cpuset.Set
+	KernelArgs() []string
+}
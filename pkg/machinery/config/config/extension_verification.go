@@ -0,0 +1,17 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package config
+
+// ExtensionVerificationConfig defines the interface to access the signature verification policy of the system extensions.
+type ExtensionVerificationConfig interface {
+	TrustedKeys() []ExtensionTrustedKey
+	InsecureNoVerify() bool
+}
+
+// ExtensionTrustedKey is a public key trusted to sign the system extension images.
+type ExtensionTrustedKey interface {
+	Name() string
+	PublicKey() string
+}
@@ -0,0 +1,30 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package config
+
+// KubeAPIServerAuditConfig defines the interface to access kube-apiserver audit configuration.
+type KubeAPIServerAuditConfig interface {
+	// AuditPolicy returns the audit policy, or nil if the default policy should be used.
+	AuditPolicy() map[string]any
+	// AuditLog returns the log backend configuration, or nil if the log backend is disabled.
+	AuditLog() KubeAPIServerAuditLog
+	// AuditWebhook returns the webhook backend configuration, or nil if the webhook backend is disabled.
+	AuditWebhook() KubeAPIServerAuditWebhook
+}
+
+// KubeAPIServerAuditLog defines the interface to access kube-apiserver audit log backend configuration.
+type KubeAPIServerAuditLog interface {
+	Path() string
+	MaxAge() int
+	MaxBackup() int
+	MaxSize() int
+}
+
+// KubeAPIServerAuditWebhook defines the interface to access kube-apiserver audit webhook backend configuration.
+type KubeAPIServerAuditWebhook interface {
+	URL() string
+	CACertificate() string
+	Token() string
+}
@@ -9,6 +9,7 @@ import "net/netip"
 // KubespanConfig defines the interface to access KubeSpan configuration.
 type KubespanConfig interface {
 	ExtraAnnouncedEndpoints() []netip.AddrPort
+	ExtraAnnouncedHostnameEndpoints() []string
 }
 
 // WrapKubespanConfig wraps a list of KubespanConfig into a single KubespanConfig aggregating the results.
@@ -23,3 +24,9 @@ func (w kubespanConfigWrapper) ExtraAnnouncedEndpoints() []netip.AddrPort {
 		return c.ExtraAnnouncedEndpoints()
 	})
 }
+
+func (w kubespanConfigWrapper) ExtraAnnouncedHostnameEndpoints() []string {
+	return aggregateValues(w, func(c KubespanConfig) []string {
+		return c.ExtraAnnouncedHostnameEndpoints()
+	})
+}
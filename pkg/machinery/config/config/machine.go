@@ -6,6 +6,7 @@ package config
 
 import (
 	"crypto/tls"
+	"net/netip"
 	"net/url"
 	"os"
 	"time"
@@ -149,6 +150,16 @@ type MachineNetwork interface {
 	Devices() []Device
 	KubeSpan() KubeSpan
 	DisableSearchDomain() bool
+	ResolverOptions() ResolverOptions
+	RouteRules() []RouteRule
+}
+
+// ResolverOptions describes the resolver tuning options.
+type ResolverOptions interface {
+	Rotate() bool
+	Timeout() time.Duration
+	Attempts() int
+	UpstreamPolicy() string
 }
 
 // Device represents a network interface.
@@ -170,6 +181,21 @@ type Device interface {
 	VIPConfig() VIPConfig
 	WireguardConfig() WireguardConfig
 	Selector() NetworkDeviceSelector
+	RouterAdvertisements() RouterAdvertisements
+	DHCPFallback() DHCPFallback
+}
+
+// DHCPFallback represents the settings of falling back from the static configuration to DHCP.
+type DHCPFallback interface {
+	Enabled() bool
+	ProbeTimeout() time.Duration
+	ProbeInterval() time.Duration
+}
+
+// RouterAdvertisements represents IPv6 router advertisement settings of a device.
+type RouterAdvertisements interface {
+	Accept() bool
+	AcceptRDNSS() bool
 }
 
 // DHCPOptions represents a set of DHCP options.
@@ -178,6 +204,13 @@ type DHCPOptions interface {
 	IPv4() bool
 	IPv6() bool
 	DUIDv6() string
+	DUIDv6Type() string
+	IAIDv6() uint32
+	IgnoreClasslessStaticRoutes() bool
+	OptimisticLease() bool
+	ClientIdentifier() string
+	SendHostname() bool
+	RequestedOptions() []uint32
 }
 
 // VIPConfig contains settings for the Virtual (shared) IP setup.
@@ -185,6 +218,8 @@ type VIPConfig interface {
 	IP() string
 	EquinixMetal() VIPEquinixMetal
 	HCloud() VIPHCloud
+	Priority() uint32
+	Preempt() bool
 }
 
 // VIPEquinixMetal contains Equinix Metal API VIP settings.
@@ -207,6 +242,7 @@ type WireguardConfig interface {
 	ListenPort() int
 	FirewallMark() int
 	Peers() []WireguardPeer
+	EndpointResolveInterval() time.Duration
 }
 
 // WireguardPeer a WireGuard device peer configuration.
@@ -225,6 +261,7 @@ type Bond interface {
 	Interfaces() []string
 	Selectors() []NetworkDeviceSelector
 	ARPIPTarget() []string
+	NSIP6Target() []string
 	Mode() string
 	HashPolicy() string
 	LACPRate() string
@@ -262,6 +299,15 @@ type STP interface {
 // BridgeVLAN contains the VLAN settings for a bridge.
 type BridgeVLAN interface {
 	FilteringEnabled() bool
+	Ports() []BridgeVLANPort
+}
+
+// BridgeVLANPort contains the VLAN settings for a bridge port.
+type BridgeVLANPort interface {
+	Interface() string
+	PVID() uint16
+	Untagged() []uint16
+	Tagged() []uint16
 }
 
 // Bridge contains the options for configuring a bridged interface.
@@ -294,6 +340,17 @@ type Route interface {
 	Source() string
 	Metric() uint32
 	MTU() uint32
+	Table() nethelpers.RoutingTable
+}
+
+// RouteRule represents a routing policy rule.
+type RouteRule interface {
+	From() string
+	To() string
+	FwMark() uint32
+	FwMask() uint32
+	Priority() uint32
+	Table() nethelpers.RoutingTable
 }
 
 // KubeSpan configures KubeSpan feature.
@@ -304,11 +361,27 @@ type KubeSpan interface {
 	HarvestExtraEndpoints() bool
 	MTU() uint32
 	Filters() KubeSpanFilters
+	Keepalive() KubeSpanKeepalive
 }
 
 // KubeSpanFilters configures KubeSpan filters.
 type KubeSpanFilters interface {
 	Endpoints() []string
+	PeerEndpoints() []string
+}
+
+// KubeSpanKeepalive configures KubeSpan persistent keepalive.
+type KubeSpanKeepalive interface {
+	Interval() time.Duration
+	NATOnly() bool
+	Overrides() []KubeSpanKeepaliveOverride
+}
+
+// KubeSpanKeepaliveOverride configures KubeSpan persistent keepalive for a set of peers.
+type KubeSpanKeepaliveOverride interface {
+	Label() string
+	CIDR() string
+	Interval() time.Duration
 }
 
 // NetworkDeviceSelector defines the set of fields that can be used to pick network a device.
@@ -327,6 +400,15 @@ type Time interface {
 	Disabled() bool
 	Servers() []string
 	BootTimeout() time.Duration
+	DHCPServers() string
+	NTSServers() []NTSServer
+	NTSAllowPlainFallback() bool
+}
+
+// NTSServer defines the requirements for a config that pertains to Network Time Security (NTS) server.
+type NTSServer interface {
+	Server() string
+	CACertificate() string
 }
 
 // Kubelet defines the requirements for a config that pertains to kubelet
@@ -345,6 +427,8 @@ type Kubelet interface {
 	NodeIP() KubeletNodeIP
 	SkipNodeRegistration() bool
 	DisableManifestsDirectory() bool
+	ShutdownGracePeriod() time.Duration
+	ShutdownGracePeriodCriticalPods() time.Duration
 }
 
 // KubeletNodeIP defines the way node IPs are selected for the kubelet.
@@ -425,6 +509,7 @@ type EncryptionKeyNodeID interface {
 type EncryptionKeyTPM interface {
 	CheckSecurebootOnEnroll() bool
 	PCRs() []int
+	PCRBank() string
 	PubKeyPCRs() []int
 	String() string
 }
@@ -449,7 +534,9 @@ type Features interface {
 	RBACEnabled() bool
 	KubernetesTalosAPIAccess() KubernetesTalosAPIAccess
 	ApidCheckExtKeyUsageEnabled() bool
+	ApidProxyTargetCheck() ApidProxyTargetCheck
 	DiskQuotaSupportEnabled() bool
+	SecretsBundleExportEnabled() bool
 	HostDNS() HostDNS
 	KubePrism() KubePrism
 	ImageCache() ImageCache
@@ -463,10 +550,17 @@ type KubernetesTalosAPIAccess interface {
 	AllowedKubernetesNamespaces() []string
 }
 
+// ApidProxyTargetCheck describes the apid proxy target check feature.
+type ApidProxyTargetCheck interface {
+	Enabled() bool
+	AllowedCIDRs() []netip.Prefix
+}
+
 // KubePrism describes the API Server load balancer features.
 type KubePrism interface {
 	Enabled() bool
 	Port() int
+	FailoverOnly() bool
 }
 
 // HostDNS describes the host DNS configuration.
@@ -474,6 +568,13 @@ type HostDNS interface {
 	Enabled() bool
 	ForwardKubeDNSToHost() bool
 	ResolveMemberNames() bool
+	CacheSize() int
+	CacheMinTTL() time.Duration
+	CacheMaxTTL() time.Duration
+	NegativeCacheEnabled() bool
+	NegativeCacheTTL() time.Duration
+	ServeStale() time.Duration
+	AllowedClients() []netip.Prefix
 }
 
 // ImageCache describes the image cache configuration.
@@ -496,6 +597,13 @@ type LoggingDestination interface {
 	Endpoint() *url.URL
 	ExtraTags() map[string]string
 	Format() string
+	TLS() LoggingTLSConfig
+}
+
+// LoggingTLSConfig describes TLS settings for the logging destination.
+type LoggingTLSConfig interface {
+	ClientIdentity() *x509.PEMEncodedCertificateAndKey
+	CA() []byte
 }
 
 // Kernel describes Talos Linux kernel configuration.
@@ -5,8 +5,11 @@
 package config
 
 import (
+	"net"
 	"net/netip"
+	"time"
 
+	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 )
 
@@ -24,6 +27,9 @@ type NetworkRuleConfigRules interface {
 // NetworkRuleConfigDefaultAction defines the interface to access network firewall configuration.
 type NetworkRuleConfigDefaultAction interface {
 	DefaultAction() nethelpers.DefaultAction
+	DefaultEgressAction() nethelpers.DefaultAction
+	// EgressFailSafe returns the ports which are always allowed for the egress traffic, nil means default.
+	EgressFailSafe() []NetworkPortSelector
 }
 
 // NetworkRuleConfigSignal is used to signal documents which implement either of the NetworkRuleConfig interfaces.
@@ -32,11 +38,57 @@ type NetworkRuleConfigSignal interface {
 }
 
 // NetworkRule defines a network firewall rule.
+//
+// For the ingress rules, the subnets and address sets select the source of the traffic,
+// for the egress rules they select the destination.
 type NetworkRule interface {
+	Name() string
+	IsEgress() bool
 	Protocol() nethelpers.Protocol
 	PortRanges() [][2]uint16
 	Subnets() []netip.Prefix
+	AddressSets() []string
 	ExceptSubnets() []netip.Prefix
+	Log() bool
+}
+
+// NetworkPortSelector selects the ports of the protocol.
+type NetworkPortSelector struct {
+	Protocol   nethelpers.Protocol
+	PortRanges [][2]uint16
+}
+
+// DefaultNetworkEgressFailSafe returns the ports which are allowed for the egress traffic by default
+// to keep Talos and Kubernetes functional: DNS, DHCP, NTP, Talos API, Kubernetes API, etcd, kubelet and KubeSpan.
+func DefaultNetworkEgressFailSafe() []NetworkPortSelector {
+	return []NetworkPortSelector{
+		{
+			Protocol: nethelpers.ProtocolUDP,
+			PortRanges: [][2]uint16{
+				{53, 53},
+				{67, 67},
+				{123, 123},
+				{547, 547},
+				{constants.KubeSpanDefaultPort, constants.KubeSpanDefaultPort},
+			},
+		},
+		{
+			Protocol: nethelpers.ProtocolTCP,
+			PortRanges: [][2]uint16{
+				{53, 53},
+				{constants.EtcdClientPort, constants.EtcdPeerPort},
+				{constants.DefaultControlPlanePort, constants.DefaultControlPlanePort},
+				{constants.KubeletPort, constants.KubeletPort},
+				{constants.ApidPort, constants.TrustdPort},
+			},
+		},
+	}
+}
+
+// NetworkAddressSetConfig defines a named set of subnets which can be referenced by the network rules.
+type NetworkAddressSetConfig interface {
+	NamedDocument
+	Subnets() []netip.Prefix
 }
 
 // WrapNetworkRuleConfigList wraps a list of NetworkConfig into a single NetworkConfig aggregating the results.
@@ -56,6 +108,25 @@ func (w networkRuleConfigWrapper) DefaultAction() nethelpers.DefaultAction {
 	)
 }
 
+func (w networkRuleConfigWrapper) DefaultEgressAction() nethelpers.DefaultAction {
+	return findFirstValue(
+		filterDocuments[NetworkRuleConfigDefaultAction](w),
+		func(c NetworkRuleConfigDefaultAction) nethelpers.DefaultAction {
+			return c.DefaultEgressAction()
+		},
+	)
+}
+
+func (w networkRuleConfigWrapper) EgressFailSafe() []NetworkPortSelector {
+	for _, c := range filterDocuments[NetworkRuleConfigDefaultAction](w) {
+		if failSafe := c.EgressFailSafe(); failSafe != nil {
+			return failSafe
+		}
+	}
+
+	return DefaultNetworkEgressFailSafe()
+}
+
 func (w networkRuleConfigWrapper) Rules() []NetworkRule {
 	return aggregateValues(
 		filterDocuments[NetworkRuleConfigRules](w),
@@ -95,12 +166,72 @@ type EthernetChannelsConfig struct {
 	Combined *uint32
 }
 
+// SRIOVConfig defines a SR-IOV physical function configuration.
+type SRIOVConfig interface {
+	NamedDocument
+	NumVFs() uint32
+	VFs() []SRIOVVFConfig
+}
+
+// SRIOVVFConfig defines a configuration of a single SR-IOV virtual function.
+type SRIOVVFConfig struct {
+	Index        uint32
+	HardwareAddr net.HardwareAddr
+	VLAN         uint16
+	Trust        *bool
+	SpoofCheck   *bool
+}
+
 // NetworkStaticHostConfig defines a static host configuration.
 type NetworkStaticHostConfig interface {
 	IP() string
 	Aliases() []string
 }
 
+// NetworkRouteSourceConfig defines the precedence and the route metrics of the route sources.
+type NetworkRouteSourceConfig interface {
+	// RouteSources returns the route sources in the order of precedence, highest first.
+	RouteSources() []RouteSource
+}
+
+// RouteSource defines the configuration of a single route source.
+type RouteSource struct {
+	// Name of the route source, e.g. static, dhcp4.
+	Name string
+	// RouteMetric overrides the default route metric for the routes of the source, zero means no override.
+	RouteMetric uint32
+}
+
+// NetworkDefaultRouteConfig defines the links which own the default routes.
+type NetworkDefaultRouteConfig interface {
+	// DefaultRouteLinks returns the links which own the default route of the address family, in the order of preference.
+	DefaultRouteLinks(family nethelpers.Family) []string
+	// Failover returns true if the default route moves to the next link when the preferred link loses carrier.
+	Failover() bool
+	// SuppressOtherRoutes returns true if the default routes of the other links are removed instead of being demoted.
+	SuppressOtherRoutes() bool
+	// DemotedRouteMetric returns the route metric of the demoted default routes.
+	DemotedRouteMetric() uint32
+}
+
+// NetworkProbeConfig defines a network connectivity probe.
+type NetworkProbeConfig interface {
+	NamedDocument
+	Interval() time.Duration
+	Timeout() time.Duration
+	FailureThreshold() int
+	// Exactly one of the probe targets is set.
+	TCPEndpoint() string
+	HTTPURL() string
+	ICMPHost() string
+}
+
+// NetworkLLDPConfig defines the LLDP neighbor discovery configuration of a link.
+type NetworkLLDPConfig interface {
+	NamedDocument
+	NetworkLLDPConfigSignal()
+}
+
 // NetworkHostnameConfig defines a hostname configuration.
 type NetworkHostnameConfig interface {
 	Hostname() string
@@ -7,12 +7,15 @@ package config
 import (
 	"net/url"
 	"time"
+
+	"github.com/siderolabs/gen/optional"
 )
 
 // RuntimeConfig defines the interface to access Talos runtime configuration.
 type RuntimeConfig interface {
 	EventsEndpoint() *string
 	KmsgLogURLs() []*url.URL
+	KmsgLogSyslogURLs() []*url.URL
 	WatchdogTimer() WatchdogTimerConfig
 }
 
@@ -20,6 +23,38 @@ type RuntimeConfig interface {
 type WatchdogTimerConfig interface {
 	Device() string
 	Timeout() time.Duration
+	RequireKubelet() bool
+	ProbeURL() string
+}
+
+// ServiceResourcesConfig defines the interface to access resource reservation overrides of a system service.
+type ServiceResourcesConfig interface {
+	NamedDocument
+	MemoryMin() optional.Optional[uint64]
+	MemoryLow() optional.Optional[uint64]
+}
+
+// KmsgLogDestinationConfig defines the interface to access buffering and delivery settings of a kernel log destination.
+type KmsgLogDestinationConfig interface {
+	NamedDocument
+	DestinationURL() *url.URL
+	MemoryBufferSize() uint64
+	SpoolSize() uint64
+	DropPolicy() string
+	RetryMinBackoff() time.Duration
+	RetryMaxBackoff() time.Duration
+}
+
+// EventLogConfig defines the interface to access the configuration of the event log persisted across reboots.
+type EventLogConfig interface {
+	MaxSize() uint64
+	MinSeverity() string
+}
+
+// ResourceHistoryConfig defines the interface to access the configuration of the resource revision history.
+type ResourceHistoryConfig interface {
+	Depth() int
+	Resources() []string
 }
 
 // WrapRuntimeConfigList wraps a list of RuntimeConfig into a single RuntimeConfig aggregating the results.
@@ -41,6 +76,12 @@ func (w runtimeConfigWrapper) KmsgLogURLs() []*url.URL {
 	})
 }
 
+func (w runtimeConfigWrapper) KmsgLogSyslogURLs() []*url.URL {
+	return aggregateValues(w, func(c RuntimeConfig) []*url.URL {
+		return c.KmsgLogSyslogURLs()
+	})
+}
+
 func (w runtimeConfigWrapper) WatchdogTimer() WatchdogTimerConfig {
 	return findFirstValue(w, func(c RuntimeConfig) WatchdogTimerConfig {
 		return c.WatchdogTimer()
@@ -4,11 +4,74 @@
 
 package config
 
+import (
+	"math/big"
+	"net/netip"
+	"time"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+)
+
 // TrustedRootsConfig defines the interface to access trusted roots configuration.
 type TrustedRootsConfig interface {
 	ExtraTrustedRootCertificates() []string
 }
 
+// CertificateExpiryConfig defines the interface to access certificate expiry reporting configuration.
+type CertificateExpiryConfig interface {
+	// ExpiryThresholds returns the thresholds (in days before the expiry) in descending order.
+	ExpiryThresholds() []int
+}
+
+// CredentialRecoveryConfig defines the interface to access credential recovery configuration.
+type CredentialRecoveryConfig interface {
+	// Disabled returns true if the credential recovery is disabled.
+	Disabled() bool
+}
+
+// TrustdPolicyConfig defines the interface to access trustd certificate signing policy configuration.
+type TrustdPolicyConfig interface {
+	// RequestsPerMinute returns the number of signing requests allowed per minute per source address, zero means no limit.
+	RequestsPerMinute() int
+	// RequestBurst returns the number of signing requests allowed in a burst per source address.
+	RequestBurst() int
+	// AllowedSubnets returns the subnets eligible to receive a certificate, empty means any.
+	AllowedSubnets() []netip.Prefix
+	// AllowedHostnames returns the hostname patterns eligible to receive a certificate, empty means any.
+	AllowedHostnames() []string
+	// MaxCertificateLifetime returns the maximum lifetime of the signed certificates, zero means the default.
+	MaxCertificateLifetime() time.Duration
+}
+
+// APIAuthorizationConfig defines the interface to access Talos API authorization policy configuration.
+type APIAuthorizationConfig interface {
+	Rules() []APIAuthorizationRule
+}
+
+// APIAuthorizationRule grants access to the Talos API methods to the roles.
+type APIAuthorizationRule interface {
+	// Roles returns the roles (client certificate organizations) the rule applies to.
+	Roles() []string
+	// Methods returns the full gRPC method name patterns allowed by the rule.
+	Methods() []string
+	// MachineTypes returns the machine types the rule applies to, empty means any.
+	MachineTypes() []machine.Type
+	// NodeLabels returns the node labels the rule applies to, empty means any.
+	NodeLabels() map[string]string
+}
+
+// CertificateRevocationConfig defines the interface to access the revoked Talos API client certificates configuration.
+type CertificateRevocationConfig interface {
+	// RevokedFingerprints returns the SPKI fingerprints of the revoked certificates.
+	RevokedFingerprints() []string
+	// RevokedSerialNumbers returns the serial numbers of the revoked certificates.
+	RevokedSerialNumbers() []*big.Int
+	// CRL returns the PEM-encoded certificate revocation list, nil if not set.
+	CRL() []byte
+	// DenyOnExpiredCRL returns true if all client certificates should be rejected once the CRL is expired.
+	DenyOnExpiredCRL() bool
+}
+
 // WrapTrustedRootsConfig wraps a list of TrustedRootsConfig into a single TrustedRootsConfig aggregating the results.
 func WrapTrustedRootsConfig(configs ...TrustedRootsConfig) TrustedRootsConfig {
 	return trustedRootConfigWrapper(configs)
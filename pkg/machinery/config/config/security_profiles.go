@@ -0,0 +1,22 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package config
+
+// SecurityProfileType is the type of the default container security profile.
+type SecurityProfileType string
+
+// Security profile types.
+const (
+	SecurityProfileRuntimeDefault SecurityProfileType = "RuntimeDefault"
+	SecurityProfileLocalhost      SecurityProfileType = "Localhost"
+	SecurityProfileUnconfined     SecurityProfileType = "Unconfined"
+)
+
+// DefaultSecurityProfilesConfig defines the interface to access the default security profiles of the CRI containers.
+type DefaultSecurityProfilesConfig interface {
+	SeccompProfileType() SecurityProfileType
+	SeccompLocalhostProfile() string
+	AppArmorProfileType() SecurityProfileType
+}
@@ -31,3 +31,14 @@ type RuntimeValidator interface {
 	// The method returns warnings and fatal errors (as multierror).
 	RuntimeValidate(context.Context, state.State, validation.RuntimeMode, ...validation.Option) ([]string, error)
 }
+
+// FindingsValidator is the interface to validate configuration returning the list of findings.
+//
+// FindingsValidator is implemented by the Container, Validate and RuntimeValidate methods
+// are compatibility wrappers which return warnings and errors as strings and multierror.
+type FindingsValidator interface {
+	// ValidateFindings checks configuration and returns all findings (errors and warnings).
+	ValidateFindings(validation.RuntimeMode, ...validation.Option) validation.Findings
+	// RuntimeValidateFindings validates the config in the runtime context and returns all findings (errors and warnings).
+	RuntimeValidateFindings(context.Context, state.State, validation.RuntimeMode, ...validation.Option) validation.Findings
+}
@@ -242,7 +242,7 @@ func deleteStructFrom(searchIn reflect.Value, searchFor string, path []string, k
 					continue
 				}
 
-				if elem.Field(j).String() != value {
+				if fmt.Sprint(elem.Field(j).Interface()) != value {
 					continue
 				}
 
@@ -292,3 +292,82 @@ func TestApplyMultiDocCPDelete(t *testing.T) {
 		})
 	}
 }
+
+//go:embed testdata/keyed/config.yaml
+var configKeyed []byte
+
+func TestApplyKeyedMerge(t *testing.T) {
+	// patches applied on cluster create (e.g. `talosctl gen config --config-patch`)
+	createPatches, err := configpatcher.LoadPatches([]string{
+		"@testdata/keyed/create1.yaml",
+		"@testdata/keyed/create2.yaml",
+	})
+	require.NoError(t, err)
+
+	// patches applied to the running machine (e.g. `talosctl patch machineconfig`)
+	applyPatches, err := configpatcher.LoadPatches([]string{
+		"@testdata/keyed/apply1.yaml",
+	})
+	require.NoError(t, err)
+
+	cfg, err := configloader.NewFromBytes(configKeyed)
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		name  string
+		input configpatcher.Input
+	}{
+		{
+			name:  "WithConfig",
+			input: configpatcher.WithConfig(cfg),
+		},
+		{
+			name:  "WithBytes",
+			input: configpatcher.WithBytes(configKeyed),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			created, err := configpatcher.Apply(tt.input, createPatches)
+			require.NoError(t, err)
+
+			createdBytes, err := created.Bytes()
+			require.NoError(t, err)
+
+			out, err := configpatcher.Apply(configpatcher.WithBytes(createdBytes), applyPatches)
+			require.NoError(t, err)
+
+			outCfg, err := out.Config()
+			require.NoError(t, err)
+
+			machine := outCfg.RawV1Alpha1().MachineConfig
+
+			extraMounts := machine.MachineKubelet.KubeletExtraMounts
+			require.Len(t, extraMounts, 1)
+			assert.Equal(t, "/var/lib/example", extraMounts[0].Destination)
+			assert.Equal(t, "bind", extraMounts[0].Type)
+			assert.Equal(t, "/var/lib/example", extraMounts[0].Source)
+			assert.Equal(t, []string{"bind", "rshared", "ro"}, extraMounts[0].Options)
+
+			interfaces := machine.MachineNetwork.NetworkInterfaces
+			require.Len(t, interfaces, 1)
+			assert.Equal(t, "eth0", interfaces[0].DeviceInterface)
+			assert.Equal(t, []string{"10.0.0.2/24"}, interfaces[0].DeviceAddresses)
+			assert.Equal(t, 9000, interfaces[0].DeviceMTU)
+
+			vlans := interfaces[0].DeviceVlans
+			require.Len(t, vlans, 3)
+			assert.EqualValues(t, 100, vlans[0].VlanID)
+			assert.EqualValues(t, 1500, vlans[0].VlanMTU)
+			assert.Equal(t, []string{"10.100.0.2/24"}, vlans[0].VlanAddresses)
+			assert.EqualValues(t, 200, vlans[1].VlanID)
+			assert.Equal(t, []string{"10.200.0.2/24"}, vlans[1].VlanAddresses)
+			assert.EqualValues(t, 300, vlans[2].VlanID)
+			assert.Equal(t, []string{"10.30.0.2/24"}, vlans[2].VlanAddresses)
+
+			assert.Equal(t,
+				[]string{"https://mirror.example.com", "https://registry-1.docker.io"},
+				machine.MachineRegistries.RegistryMirrors["docker.io"].MirrorEndpoints,
+			)
+		})
+	}
+}
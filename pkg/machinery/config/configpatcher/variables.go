@@ -0,0 +1,200 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package configpatcher
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Variables defines values substituted into the config patches.
+//
+// Variables are referenced in the patches as `${NAME}` or `${NAME:type}`, where type is one of
+// `string`, `int` or `bool`:
+//
+//   - `${NAME}` inserts the value as is, so that YAML infers the type of the value (quote the reference to force a string);
+//   - `${NAME:string}` inserts the value as a quoted string;
+//   - `${NAME:int}` and `${NAME:bool}` verify that the value is an integer or a boolean.
+//
+// Literal `${` is written as `$${`.
+//
+// Substitution is performed on the raw patch contents before the patch is parsed.
+type Variables struct {
+	// Values of the variables.
+	Values map[string]string
+	// Strict mode fails on references to undefined variables, otherwise they are left as is.
+	Strict bool
+}
+
+var variableReference = regexp.MustCompile(`\$\$\{|\$\{([A-Za-z_][A-Za-z0-9_]*)(?::([a-z]+))?\}`)
+
+// Enabled returns true if the substitution should be performed.
+//
+// Substitution is disabled if no variables are defined and strict mode is off, so that
+// patches containing `${` (e.g. shell scripts in machine files) are not affected.
+func (v *Variables) Enabled() bool {
+	return v != nil && (len(v.Values) > 0 || v.Strict)
+}
+
+// Substitute replaces variable references in the input.
+func (v *Variables) Substitute(in []byte) ([]byte, error) {
+	if !v.Enabled() {
+		return in, nil
+	}
+
+	var errs error
+
+	out := variableReference.ReplaceAllFunc(in, func(ref []byte) []byte {
+		if bytes.Equal(ref, []byte("$${")) {
+			// escaped reference
+			return ref[1:]
+		}
+
+		match := variableReference.FindSubmatch(ref)
+		name, typ := string(match[1]), string(match[2])
+
+		value, ok := v.Values[name]
+		if !ok {
+			if v.Strict {
+				errs = errors.Join(errs, fmt.Errorf("variable %q is not defined", name))
+			}
+
+			return ref
+		}
+
+		substituted, err := formatVariable(value, typ)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("variable %q: %w", name, err))
+
+			return ref
+		}
+
+		return []byte(substituted)
+	})
+
+	if errs != nil {
+		return nil, errs
+	}
+
+	return out, nil
+}
+
+// SubstitutePatches substitutes variables in the patches in the LoadPatches format.
+//
+// Patches read from files (@file) are returned inline.
+func (v *Variables) SubstitutePatches(in []string) ([]string, error) {
+	if !v.Enabled() {
+		return in, nil
+	}
+
+	result := make([]string, 0, len(in))
+
+	for _, patchString := range in {
+		contents := []byte(patchString)
+
+		if filename, ok := strings.CutPrefix(patchString, "@"); ok {
+			var err error
+
+			contents, err = os.ReadFile(filename)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		substituted, err := v.Substitute(contents)
+		if err != nil {
+			return nil, fmt.Errorf("error substituting variables in patch %q: %w", patchName(patchString), err)
+		}
+
+		result = append(result, string(substituted))
+	}
+
+	return result, nil
+}
+
+// LoadVariables loads variables from the values files and `key=value` pairs.
+//
+// Values files are YAML documents with a flat map of scalar values, later files and pairs override earlier values.
+func LoadVariables(valuesFiles, pairs []string, strict bool) (*Variables, error) {
+	vars := &Variables{
+		Values: map[string]string{},
+		Strict: strict,
+	}
+
+	for _, valuesFile := range valuesFiles {
+		contents, err := os.ReadFile(valuesFile)
+		if err != nil {
+			return nil, err
+		}
+
+		var values map[string]yaml.Node
+
+		if err = yaml.Unmarshal(contents, &values); err != nil {
+			return nil, fmt.Errorf("error parsing values file %q: %w", valuesFile, err)
+		}
+
+		for key, node := range values {
+			if node.Kind != yaml.ScalarNode {
+				return nil, fmt.Errorf("value %q in values file %q is not a scalar", key, valuesFile)
+			}
+
+			vars.Values[key] = node.Value
+		}
+	}
+
+	for _, pair := range pairs {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid variable %q, expected key=value", pair)
+		}
+
+		vars.Values[key] = value
+	}
+
+	return vars, nil
+}
+
+func formatVariable(value, typ string) (string, error) {
+	switch typ {
+	case "":
+		if strings.ContainsAny(value, "\r\n") {
+			return "", errors.New("multi-line values should be substituted as strings")
+		}
+
+		return value, nil
+	case "string":
+		return strconv.Quote(value), nil
+	case "int":
+		if _, err := strconv.ParseInt(value, 0, 64); err != nil {
+			return "", fmt.Errorf("value %q is not an integer", value)
+		}
+
+		return value, nil
+	case "bool":
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return "", fmt.Errorf("value %q is not a boolean", value)
+		}
+
+		return strconv.FormatBool(b), nil
+	default:
+		return "", fmt.Errorf("unsupported type %q", typ)
+	}
+}
+
+func patchName(patchString string) string {
+	if strings.HasPrefix(patchString, "@") {
+		return patchString
+	}
+
+	return "<inline>"
+}
@@ -0,0 +1,204 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package configpatcher_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/configpatcher"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate/secrets"
+	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+)
+
+func TestSubstitute(t *testing.T) {
+	t.Parallel()
+
+	vars := &configpatcher.Variables{
+		Values: map[string]string{
+			"NAME":  "foo",
+			"PORT":  "8080",
+			"DEBUG": "true",
+			"MULTI": "a\nb",
+		},
+	}
+
+	for _, test := range []struct {
+		name   string
+		in     string
+		strict bool
+
+		expected      string
+		expectedError string
+	}{
+		{
+			name:     "plain",
+			in:       "name: ${NAME}-1",
+			expected: "name: foo-1",
+		},
+		{
+			name:     "types",
+			in:       "port: ${PORT:int}\ndebug: ${DEBUG:bool}\nversion: ${PORT:string}",
+			expected: "port: 8080\ndebug: true\nversion: \"8080\"",
+		},
+		{
+			name:     "escape",
+			in:       "script: echo $${NAME} $${ ${NAME}",
+			expected: "script: echo ${NAME} ${ foo",
+		},
+		{
+			name:     "undefined",
+			in:       "name: ${UNDEFINED}",
+			expected: "name: ${UNDEFINED}",
+		},
+		{
+			name:          "undefined strict",
+			in:            "name: ${UNDEFINED}",
+			strict:        true,
+			expectedError: "variable \"UNDEFINED\" is not defined",
+		},
+		{
+			name:          "wrong type",
+			in:            "port: ${NAME:int}",
+			expectedError: "variable \"NAME\": value \"foo\" is not an integer",
+		},
+		{
+			name:          "unsupported type",
+			in:            "port: ${NAME:float}",
+			expectedError: "variable \"NAME\": unsupported type \"float\"",
+		},
+		{
+			name:          "multi-line",
+			in:            "value: ${MULTI}",
+			expectedError: "variable \"MULTI\": multi-line values should be substituted as strings",
+		},
+		{
+			name:     "multi-line string",
+			in:       "value: ${MULTI:string}",
+			expected: "value: \"a\\nb\"",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			v := *vars
+			v.Strict = test.strict
+
+			out, err := v.Substitute([]byte(test.in))
+			if test.expectedError != "" {
+				require.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, string(out))
+		})
+	}
+}
+
+func TestSubstituteDisabled(t *testing.T) {
+	t.Parallel()
+
+	in := []byte("script: echo ${HOME} $${HOME}")
+
+	out, err := (&configpatcher.Variables{}).Substitute(in)
+	require.NoError(t, err)
+	assert.Equal(t, in, out)
+
+	patches := []string{"@testdata/variables/patch.yaml"}
+
+	substituted, err := (*configpatcher.Variables)(nil).SubstitutePatches(patches)
+	require.NoError(t, err)
+	assert.Equal(t, patches, substituted)
+}
+
+func TestLoadVariables(t *testing.T) {
+	t.Parallel()
+
+	vars, err := configpatcher.LoadVariables([]string{"testdata/variables/values.yaml"}, []string{"VIP=10.5.0.1", "EMPTY="}, true)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{
+		"HOSTNAME_PREFIX": "site-a",
+		"MTU":             "9000",
+		"VIP":             "10.5.0.1",
+		"TIME_DISABLED":   "false",
+		"NTP_SERVER":      "time.cloudflare.com",
+		"EMPTY":           "",
+	}, vars.Values)
+	assert.True(t, vars.Strict)
+
+	_, err = configpatcher.LoadVariables(nil, []string{"VIP"}, false)
+	require.EqualError(t, err, "invalid variable \"VIP\", expected key=value")
+}
+
+func TestSubstitutePatchesSecretsRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	secretsBundle, err := secrets.NewBundle(secrets.NewFixedClock(time.Now()), config.TalosVersionCurrent)
+	require.NoError(t, err)
+
+	input, err := generate.NewInput("test", "https://10.5.0.1:6443", "1.32.0", generate.WithSecretsBundle(secretsBundle))
+	require.NoError(t, err)
+
+	cfg, err := input.Config(machine.TypeControlPlane)
+	require.NoError(t, err)
+
+	cfgBytes, err := cfg.EncodeBytes(encoder.WithComments(encoder.CommentsDisabled))
+	require.NoError(t, err)
+
+	vars, err := configpatcher.LoadVariables([]string{"testdata/variables/values.yaml"}, nil, true)
+	require.NoError(t, err)
+
+	// secrets bundle and generated config contain no references, so they are not changed by the substitution
+	var secretsBytes bytes.Buffer
+
+	require.NoError(t, yaml.NewEncoder(&secretsBytes).Encode(secretsBundle))
+
+	substitutedSecrets, err := vars.Substitute(secretsBytes.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, secretsBytes.Bytes(), substitutedSecrets)
+
+	substitutedConfig, err := vars.Substitute(cfgBytes)
+	require.NoError(t, err)
+	assert.Equal(t, cfgBytes, substitutedConfig)
+
+	patchStrings, err := vars.SubstitutePatches([]string{"@testdata/variables/patch.yaml"})
+	require.NoError(t, err)
+
+	patches, err := configpatcher.LoadPatches(patchStrings)
+	require.NoError(t, err)
+
+	out, err := configpatcher.Apply(configpatcher.WithBytes(cfgBytes), patches)
+	require.NoError(t, err)
+
+	patched, err := out.Config()
+	require.NoError(t, err)
+
+	assert.Equal(t, "site-a-1", patched.NetworkHostnameConfig().Hostname())
+	assert.Equal(t, []string{"time.cloudflare.com"}, patched.Machine().Time().Servers())
+	assert.False(t, patched.Machine().Time().Disabled())
+	assert.Equal(t, 9000, patched.Machine().Network().Devices()[0].MTU())
+	assert.Equal(t, "192.168.10.50", patched.Machine().Network().Devices()[0].VIPConfig().IP())
+
+	files, err := patched.Machine().Files()
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	assert.Equal(t, "echo ${HOME}\n", files[0].Content())
+
+	// certificates are not affected by the substitution
+	assert.Equal(t, cfg.Machine().Security().IssuingCA(), patched.Machine().Security().IssuingCA())
+	assert.Equal(t, cfg.Cluster().IssuingCA(), patched.Cluster().IssuingCA())
+	assert.Equal(t, cfg.Cluster().Etcd().CA(), patched.Cluster().Etcd().CA())
+}
@@ -14,7 +14,6 @@ import (
 	"strings"
 
 	"github.com/cosi-project/runtime/pkg/state"
-	"github.com/hashicorp/go-multierror"
 	"github.com/siderolabs/gen/xslices"
 
 	coreconfig "github.com/siderolabs/talos/pkg/machinery/config"
@@ -213,6 +212,16 @@ func (container *Container) ExtensionServiceConfigs() []config.ExtensionServiceC
 	return findMatchingDocs[config.ExtensionServiceConfig](container.documents)
 }
 
+// ExtensionVerificationConfig implements config.Config interface.
+func (container *Container) ExtensionVerificationConfig() config.ExtensionVerificationConfig {
+	matching := findMatchingDocs[config.ExtensionVerificationConfig](container.documents)
+	if len(matching) == 0 {
+		return nil
+	}
+
+	return matching[0]
+}
+
 // Runtime implements config.Config interface.
 func (container *Container) Runtime() config.RuntimeConfig {
 	return config.WrapRuntimeConfigList(findMatchingDocs[config.RuntimeConfig](container.documents)...)
@@ -223,11 +232,66 @@ func (container *Container) NetworkRules() config.NetworkRuleConfig {
 	return config.WrapNetworkRuleConfigList(findMatchingDocs[config.NetworkRuleConfigSignal](container.documents)...)
 }
 
+// NetworkAddressSets implements config.Config interface.
+func (container *Container) NetworkAddressSets() []config.NetworkAddressSetConfig {
+	return findMatchingDocs[config.NetworkAddressSetConfig](container.documents)
+}
+
 // TrustedRoots implements config.Config interface.
 func (container *Container) TrustedRoots() config.TrustedRootsConfig {
 	return config.WrapTrustedRootsConfig(findMatchingDocs[config.TrustedRootsConfig](container.documents)...)
 }
 
+// CertificateExpiryConfig implements config.Config interface.
+func (container *Container) CertificateExpiryConfig() config.CertificateExpiryConfig {
+	matching := findMatchingDocs[config.CertificateExpiryConfig](container.documents)
+	if len(matching) == 0 {
+		return nil
+	}
+
+	return matching[0]
+}
+
+// CredentialRecoveryConfig implements config.Config interface.
+func (container *Container) CredentialRecoveryConfig() config.CredentialRecoveryConfig {
+	matching := findMatchingDocs[config.CredentialRecoveryConfig](container.documents)
+	if len(matching) == 0 {
+		return nil
+	}
+
+	return matching[0]
+}
+
+// TrustdPolicyConfig implements config.Config interface.
+func (container *Container) TrustdPolicyConfig() config.TrustdPolicyConfig {
+	matching := findMatchingDocs[config.TrustdPolicyConfig](container.documents)
+	if len(matching) == 0 {
+		return nil
+	}
+
+	return matching[0]
+}
+
+// APIAuthorizationConfig implements config.Config interface.
+func (container *Container) APIAuthorizationConfig() config.APIAuthorizationConfig {
+	matching := findMatchingDocs[config.APIAuthorizationConfig](container.documents)
+	if len(matching) == 0 {
+		return nil
+	}
+
+	return matching[0]
+}
+
+// CertificateRevocationConfig implements config.Config interface.
+func (container *Container) CertificateRevocationConfig() config.CertificateRevocationConfig {
+	matching := findMatchingDocs[config.CertificateRevocationConfig](container.documents)
+	if len(matching) == 0 {
+		return nil
+	}
+
+	return matching[0]
+}
+
 // Volumes implements config.Config interface.
 func (container *Container) Volumes() config.VolumesConfig {
 	return config.WrapVolumesConfigList(findMatchingDocs[config.VolumeConfig](container.documents)...)
@@ -243,11 +307,106 @@ func (container *Container) PCIDriverRebindConfig() config.PCIDriverRebindConfig
 	return config.WrapPCIDriverRebindConfig(findMatchingDocs[config.PCIDriverRebindConfig](container.documents)...)
 }
 
+// CPUIsolationConfig implements config.Config interface.
+func (container *Container) CPUIsolationConfig() config.CPUIsolationConfig {
+	matching := findMatchingDocs[config.CPUIsolationConfig](container.documents)
+	if len(matching) == 0 {
+		return nil
+	}
+
+	return matching[0]
+}
+
+// ContainerdConfig implements config.Config interface.
+func (container *Container) ContainerdConfig() config.ContainerdConfig {
+	matching := findMatchingDocs[config.ContainerdConfig](container.documents)
+	if len(matching) == 0 {
+		return nil
+	}
+
+	return matching[0]
+}
+
+// DefaultSecurityProfilesConfig implements config.Config interface.
+func (container *Container) DefaultSecurityProfilesConfig() config.DefaultSecurityProfilesConfig {
+	matching := findMatchingDocs[config.DefaultSecurityProfilesConfig](container.documents)
+	if len(matching) == 0 {
+		return nil
+	}
+
+	return matching[0]
+}
+
+// ServiceResourcesConfigs implements config.Config interface.
+func (container *Container) ServiceResourcesConfigs() []config.ServiceResourcesConfig {
+	return findMatchingDocs[config.ServiceResourcesConfig](container.documents)
+}
+
+// EventLogConfig implements config.Config interface.
+func (container *Container) EventLogConfig() config.EventLogConfig {
+	matching := findMatchingDocs[config.EventLogConfig](container.documents)
+	if len(matching) == 0 {
+		return nil
+	}
+
+	return matching[0]
+}
+
+// KmsgLogDestinations implements config.Config interface.
+func (container *Container) KmsgLogDestinations() []config.KmsgLogDestinationConfig {
+	return findMatchingDocs[config.KmsgLogDestinationConfig](container.documents)
+}
+
+// ResourceHistoryConfig implements config.Config interface.
+func (container *Container) ResourceHistoryConfig() config.ResourceHistoryConfig {
+	matching := findMatchingDocs[config.ResourceHistoryConfig](container.documents)
+	if len(matching) == 0 {
+		return nil
+	}
+
+	return matching[0]
+}
+
+// KubeAPIServerAuditConfig implements config.Config interface.
+func (container *Container) KubeAPIServerAuditConfig() config.KubeAPIServerAuditConfig {
+	matching := findMatchingDocs[config.KubeAPIServerAuditConfig](container.documents)
+	if len(matching) == 0 {
+		return nil
+	}
+
+	return matching[0]
+}
+
+// BootstrapManifestsConfig implements config.Config interface.
+func (container *Container) BootstrapManifestsConfig() config.BootstrapManifestsConfig {
+	matching := findMatchingDocs[config.BootstrapManifestsConfig](container.documents)
+	if len(matching) == 0 {
+		return nil
+	}
+
+	return matching[0]
+}
+
+// CoreDNSConfig implements config.Config interface.
+func (container *Container) CoreDNSConfig() config.CoreDNSConfig {
+	matching := findMatchingDocs[config.CoreDNSConfig](container.documents)
+	if len(matching) == 0 {
+		return nil
+	}
+
+	return matching[0]
+}
+
 // EthernetConfigs implements config.Config interface.
 func (container *Container) EthernetConfigs() []config.EthernetConfig {
 	return findMatchingDocs[config.EthernetConfig](container.documents)
 }
 
+// SRIOVConfigs implements config.Config interface.
+func (container *Container) SRIOVConfigs() []config.SRIOVConfig {
+	return findMatchingDocs[config.SRIOVConfig](container.documents)
+}
+
 // UserVolumeConfigs implements config.Config interface.
 func (container *Container) UserVolumeConfigs() []config.UserVolumeConfig {
 	return findMatchingDocs[config.UserVolumeConfig](container.documents)
@@ -302,6 +461,36 @@ func (container *Container) NetworkHostnameConfig() config.NetworkHostnameConfig
 	return nil
 }
 
+// NetworkRouteSourceConfig implements config.Config interface.
+func (container *Container) NetworkRouteSourceConfig() config.NetworkRouteSourceConfig {
+	matching := findMatchingDocs[config.NetworkRouteSourceConfig](container.documents)
+	if len(matching) == 0 {
+		return nil
+	}
+
+	return matching[0]
+}
+
+// NetworkDefaultRouteConfig implements config.Config interface.
+func (container *Container) NetworkDefaultRouteConfig() config.NetworkDefaultRouteConfig {
+	matching := findMatchingDocs[config.NetworkDefaultRouteConfig](container.documents)
+	if len(matching) == 0 {
+		return nil
+	}
+
+	return matching[0]
+}
+
+// NetworkProbeConfigs implements config.Config interface.
+func (container *Container) NetworkProbeConfigs() []config.NetworkProbeConfig {
+	return findMatchingDocs[config.NetworkProbeConfig](container.documents)
+}
+
+// NetworkLLDPConfigs implements config.Config interface.
+func (container *Container) NetworkLLDPConfigs() []config.NetworkLLDPConfig {
+	return findMatchingDocs[config.NetworkLLDPConfig](container.documents)
+}
+
 // Bytes returns source YAML representation (if available) or does default encoding.
 func (container *Container) Bytes() ([]byte, error) {
 	if !container.readonly {
@@ -381,37 +570,38 @@ func docID(doc config.Document) string {
 	return id
 }
 
+func docKindName(doc config.Document) (kind, name string) {
+	if named, ok := doc.(config.NamedDocument); ok {
+		name = named.Name()
+	}
+
+	return doc.Kind(), name
+}
+
 // Validate checks configuration and returns warnings and fatal errors (as multierror).
-//
-//nolint:gocyclo
 func (container *Container) Validate(mode validation.RuntimeMode, opt ...validation.Option) ([]string, error) {
-	var (
-		warnings []string
-		err      error
-	)
+	findings := container.ValidateFindings(mode, opt...)
 
-	if container.v1alpha1Config != nil {
-		warnings, err = container.v1alpha1Config.Validate(mode, opt...)
-		if err != nil {
-			err = fmt.Errorf("v1alpha1.Config: %w", err)
-		}
-	}
+	return findings.Warnings(), findings.Err()
+}
 
-	var multiErr *multierror.Error
+// ValidateFindings checks configuration and returns all findings (errors and warnings).
+func (container *Container) ValidateFindings(mode validation.RuntimeMode, opt ...validation.Option) validation.Findings {
+	var findings validation.Findings
 
-	if err != nil {
-		multiErr = multierror.Append(multiErr, err)
+	if container.v1alpha1Config != nil {
+		warnings, err := container.v1alpha1Config.Validate(mode, opt...)
+
+		findings = append(findings, validation.NewFindings(v1alpha1DocumentID, "", warnings, err)...)
 	}
 
 	for _, doc := range container.documents {
 		if validatableDoc, ok := doc.(config.Validator); ok {
 			docWarnings, docErr := validatableDoc.Validate(mode, opt...)
-			if docErr != nil {
-				docErr = fmt.Errorf("%s: %w", docID(doc), docErr)
-			}
 
-			warnings = append(warnings, docWarnings...)
-			multiErr = multierror.Append(multiErr, docErr)
+			kind, name := docKindName(doc)
+
+			findings = append(findings, validation.NewFindings(kind, name, docWarnings, docErr)...)
 		}
 	}
 
@@ -419,50 +609,46 @@ func (container *Container) Validate(mode validation.RuntimeMode, opt ...validat
 	if container.v1alpha1Config != nil {
 		for _, doc := range container.documents {
 			if conflictValidator, ok := doc.(V1Alpha1ConflictValidator); ok {
-				err := conflictValidator.V1Alpha1ConflictValidate(container.v1alpha1Config)
-				if err != nil {
-					multiErr = multierror.Append(multiErr, err)
-				}
+				findings = append(findings, validation.NewFindings("", "", nil, conflictValidator.V1Alpha1ConflictValidate(container.v1alpha1Config))...)
 			}
 		}
 	}
 
-	return warnings, multiErr.ErrorOrNil()
+	for _, issue := range container.CrossValidate() {
+		findings = append(findings, issue.Finding())
+	}
+
+	return findings
 }
 
 // RuntimeValidate validates the config in the runtime context.
 func (container *Container) RuntimeValidate(ctx context.Context, st state.State, mode validation.RuntimeMode, opt ...validation.Option) ([]string, error) {
-	var (
-		warnings []string
-		err      error
-	)
+	findings := container.RuntimeValidateFindings(ctx, st, mode, opt...)
 
-	if container.v1alpha1Config != nil {
-		warnings, err = container.v1alpha1Config.RuntimeValidate(ctx, st, mode, opt...)
-		if err != nil {
-			err = fmt.Errorf("v1alpha1.Config: %w", err)
-		}
-	}
+	return findings.Warnings(), findings.Err()
+}
 
-	var multiErr *multierror.Error
+// RuntimeValidateFindings validates the config in the runtime context and returns all findings (errors and warnings).
+func (container *Container) RuntimeValidateFindings(ctx context.Context, st state.State, mode validation.RuntimeMode, opt ...validation.Option) validation.Findings {
+	var findings validation.Findings
 
-	if err != nil {
-		multiErr = multierror.Append(multiErr, err)
+	if container.v1alpha1Config != nil {
+		warnings, err := container.v1alpha1Config.RuntimeValidate(ctx, st, mode, opt...)
+
+		findings = append(findings, validation.NewFindings(v1alpha1DocumentID, "", warnings, err)...)
 	}
 
 	for _, doc := range container.documents {
 		if validatableDoc, ok := doc.(config.RuntimeValidator); ok {
 			docWarnings, docErr := validatableDoc.RuntimeValidate(ctx, st, mode, opt...)
-			if docErr != nil {
-				docErr = fmt.Errorf("%s: %w", docID(doc), docErr)
-			}
 
-			warnings = append(warnings, docWarnings...)
-			multiErr = multierror.Append(multiErr, docErr)
+			kind, name := docKindName(doc)
+
+			findings = append(findings, validation.NewFindings(kind, name, docWarnings, docErr)...)
 		}
 	}
 
-	return warnings, multiErr.ErrorOrNil()
+	return findings
 }
 
 // RedactSecrets returns a copy of the Provider with all secrets replaced with the given string.
@@ -5,6 +5,8 @@
 package container_test
 
 import (
+	"encoding/json"
+	"net/netip"
 	"net/url"
 	"testing"
 
@@ -20,10 +22,13 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/config/machine"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/block"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/hardware"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/runtime/extensions"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/siderolink"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 	blockres "github.com/siderolabs/talos/pkg/machinery/resources/block"
 )
 
@@ -209,12 +214,54 @@ func TestValidate(t *testing.T) {
 		{
 			name:          "invalid v1alpha1",
 			documents:     []config.Document{invalidV1alpha1Config},
-			expectedError: "1 error occurred:\n\t* v1alpha1.Config: 1 error occurred:\n\t* machine instructions are required\n\n\n\n",
+			expectedError: "1 error occurred:\n\t* v1alpha1.Config: machine instructions are required\n\n",
 		},
 		{
 			name:          "invalid multi-doc",
 			documents:     []config.Document{invalidSideroLinkCfg, invalidV1alpha1Config},
-			expectedError: "2 errors occurred:\n\t* v1alpha1.Config: 1 error occurred:\n\t* machine instructions are required\n\n\n\t* SideroLinkConfig: apiUrl is required\n\n",
+			expectedError: "2 errors occurred:\n\t* v1alpha1.Config: machine instructions are required\n\t* SideroLinkConfig: apiUrl is required\n\n",
+		},
+		{
+			name:      "egress network rules",
+			documents: []config.Document{officeAddressSet, httpRule, httpEgressRule, proxyEgressRule},
+			expectedIssues: []container.CrossValidationIssue{
+				{
+					Document: "NetworkRuleConfig/http-egress",
+					Field:    ".egress",
+					Message:  "address set \"registries\" is not defined by any NetworkAddressSet document",
+					Severity: container.CrossValidationError,
+				},
+				{
+					Document: "NetworkRuleConfig/proxy-egress",
+					Field:    ".portSelector.ports",
+					Message:  "ports 88/tcp are also selected by NetworkRuleConfig/http-egress with different sources, with the default action \"accept\" only sources allowed by both rules can connect, merge the rules",
+					Severity: container.CrossValidationError,
+				},
+			},
+		},
+		{
+			name:      "egress network rules with default action block",
+			documents: []config.Document{officeAddressSet, httpRule, metricsRule, httpEgressRule, proxyEgressRule, blockEgressDefaultAction},
+			expectedIssues: []container.CrossValidationIssue{
+				{
+					Document: "NetworkRuleConfig/metrics",
+					Field:    ".ingress",
+					Message:  "address set \"monitoring\" is not defined by any NetworkAddressSet document",
+					Severity: container.CrossValidationError,
+				},
+				{
+					Document: "NetworkRuleConfig/http-egress",
+					Field:    ".egress",
+					Message:  "address set \"registries\" is not defined by any NetworkAddressSet document",
+					Severity: container.CrossValidationError,
+				},
+				{
+					Document: "NetworkRuleConfig/metrics",
+					Field:    ".portSelector.ports",
+					Message:  "ports 85/tcp are also selected by NetworkRuleConfig/http with different sources, with the default action \"accept\" only sources allowed by both rules can connect, merge the rules",
+					Severity: container.CrossValidationError,
+				},
+			},
 		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
@@ -236,6 +283,38 @@ func TestValidate(t *testing.T) {
 	}
 }
 
+func TestValidateFindings(t *testing.T) {
+	t.Parallel()
+
+	invalidSideroLinkCfg := siderolink.NewConfigV1Alpha1()
+	invalidV1alpha1Config := &v1alpha1.Config{}
+
+	ctr, err := container.New(invalidV1alpha1Config, invalidSideroLinkCfg)
+	require.NoError(t, err)
+
+	findings := ctr.ValidateFindings(validationMode{})
+
+	assert.Equal(t, validation.Findings{
+		{
+			Severity: validation.SeverityError,
+			Kind:     "v1alpha1.Config",
+			Message:  "machine instructions are required",
+		},
+		{
+			Severity: validation.SeverityError,
+			Kind:     "SideroLinkConfig",
+			Message:  "apiUrl is required",
+		},
+	}, findings)
+
+	assert.True(t, findings.HasErrors())
+	assert.Empty(t, findings.Warnings())
+
+	out, err := json.Marshal(findings)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{"severity":"error","kind":"v1alpha1.Config","message":"machine instructions are required"},{"severity":"error","kind":"SideroLinkConfig","message":"apiUrl is required"}]`, string(out))
+}
+
 func TestCrossValidateEncryption(t *testing.T) {
 	t.Parallel()
 
@@ -357,3 +436,199 @@ func (validationMode) RequiresInstall() bool {
 func (validationMode) InContainer() bool {
 	return false
 }
+
+func TestCrossValidate(t *testing.T) {
+	t.Parallel()
+
+	v1alpha1Cfg := &v1alpha1.Config{
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineDisks: []*v1alpha1.MachineDisk{
+				{
+					DeviceName: "/dev/sdb",
+					DiskPartitions: []*v1alpha1.DiskPartition{
+						{
+							DiskMountPoint: "/var/mnt/data",
+						},
+					},
+				},
+			},
+			MachineKubelet: &v1alpha1.KubeletConfig{
+				KubeletExtraMounts: []v1alpha1.ExtraMount{
+					{
+						Destination: "/var/mnt/data",
+						Source:      "/var/mnt/data",
+					},
+					{
+						Destination: "/var/mnt/user",
+						Source:      "/var/mnt/user/subdir",
+					},
+					{
+						Destination: "/var/mnt/missing",
+						Source:      "/var/mnt/missing",
+					},
+					{
+						Destination: "/var/lib/example",
+						Source:      "/var/lib/example",
+					},
+				},
+			},
+		},
+	}
+
+	userVolume := block.NewUserVolumeConfigV1Alpha1()
+	userVolume.MetaName = "user"
+
+	existingVolume := block.NewExistingVolumeConfigV1Alpha1()
+	existingVolume.MetaName = "user"
+
+	existingDataVolume := block.NewExistingVolumeConfigV1Alpha1()
+	existingDataVolume.MetaName = "data"
+
+	rawVolume := block.NewRawVolumeConfigV1Alpha1()
+	rawVolume.MetaName = "user"
+
+	swapVolume := block.NewSwapVolumeConfigV1Alpha1()
+	swapVolume.MetaName = "swap"
+
+	officeAddressSet := network.NewAddressSetV1Alpha1("office")
+	officeAddressSet.SetSubnets = []netip.Prefix{netip.MustParsePrefix("192.168.10.0/24")}
+
+	httpRule := network.NewRuleConfigV1Alpha1()
+	httpRule.MetaName = "http"
+	httpRule.PortSelector.Protocol = nethelpers.ProtocolTCP
+	httpRule.PortSelector.Ports = network.PortRanges{{Lo: 80, Hi: 90}}
+	httpRule.Ingress = network.IngressConfig{{AddressSet: "office"}}
+
+	metricsRule := network.NewRuleConfigV1Alpha1()
+	metricsRule.MetaName = "metrics"
+	metricsRule.PortSelector.Protocol = nethelpers.ProtocolTCP
+	metricsRule.PortSelector.Ports = network.PortRanges{{Lo: 85, Hi: 85}, {Lo: 9100, Hi: 9100}}
+	metricsRule.Ingress = network.IngressConfig{{AddressSet: "monitoring"}}
+
+	metricsUDPRule := metricsRule.DeepCopy()
+	metricsUDPRule.MetaName = "metrics-udp"
+	metricsUDPRule.PortSelector.Protocol = nethelpers.ProtocolUDP
+	metricsUDPRule.Ingress = network.IngressConfig{{AddressSet: "office"}}
+
+	blockDefaultAction := network.NewDefaultActionConfigV1Alpha1()
+	blockDefaultAction.Ingress = nethelpers.DefaultActionBlock
+
+	httpEgressRule := network.NewRuleConfigV1Alpha1()
+	httpEgressRule.MetaName = "http-egress"
+	httpEgressRule.PortSelector.Protocol = nethelpers.ProtocolTCP
+	httpEgressRule.PortSelector.Ports = network.PortRanges{{Lo: 80, Hi: 90}}
+	httpEgressRule.Egress = network.EgressConfig{{AddressSet: "registries"}}
+
+	proxyEgressRule := network.NewRuleConfigV1Alpha1()
+	proxyEgressRule.MetaName = "proxy-egress"
+	proxyEgressRule.PortSelector.Protocol = nethelpers.ProtocolTCP
+	proxyEgressRule.PortSelector.Ports = network.PortRanges{{Lo: 88, Hi: 88}}
+	proxyEgressRule.Egress = network.EgressConfig{{AddressSet: "office"}}
+
+	blockEgressDefaultAction := network.NewDefaultActionConfigV1Alpha1()
+	blockEgressDefaultAction.Egress = nethelpers.DefaultActionBlock
+
+	for _, tt := range []struct {
+		name      string
+		documents []config.Document
+
+		expectedIssues []container.CrossValidationIssue
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:      "no conflicts",
+			documents: []config.Document{v1alpha1Cfg, userVolume},
+			expectedIssues: []container.CrossValidationIssue{
+				{
+					Document: "v1alpha1.Config",
+					Field:    ".machine.kubelet.extraMounts[2].source",
+					Message:  "\"/var/mnt/missing\" is not provided by any UserVolumeConfig, ExistingVolumeConfig or machine disk partition",
+					Severity: container.CrossValidationWarning,
+				},
+			},
+		},
+		{
+			name:      "mount point conflicts",
+			documents: []config.Document{v1alpha1Cfg, userVolume, existingVolume, existingDataVolume},
+			expectedIssues: []container.CrossValidationIssue{
+				{
+					Document: "ExistingVolumeConfig/user",
+					Field:    ".name",
+					Message:  "mount point \"/var/mnt/user\" is already used by UserVolumeConfig/user .name",
+					Severity: container.CrossValidationError,
+				},
+				{
+					Document: "ExistingVolumeConfig/data",
+					Field:    ".name",
+					Message:  "mount point \"/var/mnt/data\" is already used by v1alpha1.Config .machine.disks[0].partitions[0].mountpoint",
+					Severity: container.CrossValidationError,
+				},
+				{
+					Document: "v1alpha1.Config",
+					Field:    ".machine.kubelet.extraMounts[2].source",
+					Message:  "\"/var/mnt/missing\" is not provided by any UserVolumeConfig, ExistingVolumeConfig or machine disk partition",
+					Severity: container.CrossValidationWarning,
+				},
+			},
+		},
+		{
+			name:      "volume name reuse",
+			documents: []config.Document{userVolume, existingVolume, rawVolume, swapVolume},
+			expectedIssues: []container.CrossValidationIssue{
+				{
+					Document: "ExistingVolumeConfig/user",
+					Field:    ".name",
+					Message:  "mount point \"/var/mnt/user\" is already used by UserVolumeConfig/user .name",
+					Severity: container.CrossValidationError,
+				},
+				{
+					Document: "RawVolumeConfig/user",
+					Field:    ".name",
+					Message:  "volume name \"user\" is also used by UserVolumeConfig/user",
+					Severity: container.CrossValidationWarning,
+				},
+			},
+		},
+		{
+			name:      "network rules",
+			documents: []config.Document{officeAddressSet, httpRule, metricsRule, metricsUDPRule},
+			expectedIssues: []container.CrossValidationIssue{
+				{
+					Document: "NetworkRuleConfig/metrics",
+					Field:    ".ingress",
+					Message:  "address set \"monitoring\" is not defined by any NetworkAddressSet document",
+					Severity: container.CrossValidationError,
+				},
+				{
+					Document: "NetworkRuleConfig/metrics",
+					Field:    ".portSelector.ports",
+					Message:  "ports 85/tcp are also selected by NetworkRuleConfig/http with different sources, with the default action \"accept\" only sources allowed by both rules can connect, merge the rules",
+					Severity: container.CrossValidationError,
+				},
+			},
+		},
+		{
+			name:      "network rules with default action block",
+			documents: []config.Document{officeAddressSet, httpRule, metricsRule, blockDefaultAction},
+			expectedIssues: []container.CrossValidationIssue{
+				{
+					Document: "NetworkRuleConfig/metrics",
+					Field:    ".ingress",
+					Message:  "address set \"monitoring\" is not defined by any NetworkAddressSet document",
+					Severity: container.CrossValidationError,
+				},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctr, err := container.New(tt.documents...)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedIssues, ctr.CrossValidate())
+		})
+	}
+}
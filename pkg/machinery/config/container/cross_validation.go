@@ -0,0 +1,337 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package container
+
+import (
+	"fmt"
+	"maps"
+	"net/netip"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/siderolabs/gen/xslices"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+// v1alpha1DocumentID is used to refer to the v1alpha1.Config document in the cross-validation issues.
+const v1alpha1DocumentID = "v1alpha1.Config"
+
+// CrossValidationSeverity is the severity of the cross-document validation issue.
+type CrossValidationSeverity int
+
+// CrossValidationSeverity values.
+const (
+	// CrossValidationError blocks the config from being applied.
+	CrossValidationError CrossValidationSeverity = iota
+	// CrossValidationWarning is reported, but doesn't block the config from being applied.
+	CrossValidationWarning
+)
+
+// CrossValidationIssue describes a single violation found by the cross-document validation.
+type CrossValidationIssue struct {
+	// Document is the ID of the document (kind and name) the issue was found in.
+	Document string
+	// Field is the path to the field within the document.
+	Field    string
+	Message  string
+	Severity CrossValidationSeverity
+}
+
+// String implements fmt.Stringer interface.
+func (issue CrossValidationIssue) String() string {
+	return fmt.Sprintf("%s: %s: %s", issue.Document, issue.Field, issue.Message)
+}
+
+// Finding converts the issue to the validation finding.
+func (issue CrossValidationIssue) Finding() validation.Finding {
+	kind, name, _ := strings.Cut(issue.Document, "/")
+
+	severity := validation.SeverityError
+	if issue.Severity == CrossValidationWarning {
+		severity = validation.SeverityWarning
+	}
+
+	return validation.Finding{
+		Severity: severity,
+		Kind:     kind,
+		Name:     name,
+		Path:     issue.Field,
+		Message:  issue.Message,
+	}
+}
+
+// CrossValidate checks referential integrity and uniqueness constraints which span multiple documents.
+//
+// All found issues are returned, errors and warnings are distinguished by the severity.
+func (container *Container) CrossValidate() []CrossValidationIssue {
+	mountPoints, issues := container.userMountPoints()
+
+	return slices.Concat(
+		issues,
+		container.crossValidateExtraMounts(mountPoints),
+		container.crossValidateVolumeNames(),
+		container.crossValidateNetworkRules(),
+	)
+}
+
+// userMountPoints collects mount points under the user volume mount point provided by the config documents.
+//
+// Mount points used more than once are reported as errors.
+func (container *Container) userMountPoints() ([]string, []CrossValidationIssue) {
+	var issues []CrossValidationIssue
+
+	owners := map[string]string{}
+
+	add := func(path, document, field string) {
+		path = filepath.Clean(path)
+
+		if owner, exists := owners[path]; exists {
+			issues = append(issues, CrossValidationIssue{
+				Document: document,
+				Field:    field,
+				Message:  fmt.Sprintf("mount point %q is already used by %s", path, owner),
+				Severity: CrossValidationError,
+			})
+
+			return
+		}
+
+		owners[path] = document + " " + field
+	}
+
+	if container.v1alpha1Config != nil && container.v1alpha1Config.MachineConfig != nil {
+		for i, disk := range container.v1alpha1Config.Machine().Disks() {
+			for j, partition := range disk.Partitions() {
+				if partition.MountPoint() == "" {
+					continue
+				}
+
+				add(partition.MountPoint(), v1alpha1DocumentID, fmt.Sprintf(".machine.disks[%d].partitions[%d].mountpoint", i, j))
+			}
+		}
+	}
+
+	for _, doc := range container.documents {
+		switch d := doc.(type) {
+		case config.UserVolumeConfig:
+			add(filepath.Join(constants.UserVolumeMountPoint, d.Name()), docID(doc), ".name")
+		case config.ExistingVolumeConfig:
+			add(filepath.Join(constants.UserVolumeMountPoint, d.Name()), docID(doc), ".name")
+		}
+	}
+
+	return slices.Collect(maps.Keys(owners)), issues
+}
+
+// crossValidateExtraMounts checks that kubelet extra mounts sourced from the user volume mount point refer to a configured volume.
+//
+// As the directory might be created by other means, a missing volume is reported as a warning.
+func (container *Container) crossValidateExtraMounts(mountPoints []string) []CrossValidationIssue {
+	if container.v1alpha1Config == nil || container.v1alpha1Config.MachineConfig == nil {
+		return nil
+	}
+
+	var issues []CrossValidationIssue
+
+	prefix := constants.UserVolumeMountPoint + "/"
+
+	for i, mount := range container.v1alpha1Config.Machine().Kubelet().ExtraMounts() {
+		source := filepath.Clean(mount.Source)
+
+		if !strings.HasPrefix(source, prefix) {
+			continue
+		}
+
+		if slices.ContainsFunc(mountPoints, func(mountPoint string) bool {
+			return source == mountPoint || strings.HasPrefix(source, mountPoint+"/")
+		}) {
+			continue
+		}
+
+		issues = append(issues, CrossValidationIssue{
+			Document: v1alpha1DocumentID,
+			Field:    fmt.Sprintf(".machine.kubelet.extraMounts[%d].source", i),
+			Message:  fmt.Sprintf("%q is not provided by any UserVolumeConfig, ExistingVolumeConfig or machine disk partition", mount.Source),
+			Severity: CrossValidationWarning,
+		})
+	}
+
+	return issues
+}
+
+// crossValidateVolumeNames checks that the volume names are not reused across the volume document kinds.
+//
+// The volume IDs and the partition labels are prefixed with the volume type, so the reused name doesn't break the provisioning,
+// but the volumes are hard to tell apart, so the reuse is reported as a warning.
+// User and existing volumes with the same name share the mount point, which is reported as an error by userMountPoints.
+func (container *Container) crossValidateVolumeNames() []CrossValidationIssue {
+	var issues []CrossValidationIssue
+
+	type volumeOwner struct {
+		document string
+		mounted  bool
+	}
+
+	owners := map[string]volumeOwner{}
+
+	for _, doc := range container.documents {
+		var (
+			name    string
+			mounted bool
+		)
+
+		switch d := doc.(type) {
+		case config.UserVolumeConfig:
+			name, mounted = d.Name(), true
+		case config.ExistingVolumeConfig:
+			name, mounted = d.Name(), true
+		case config.RawVolumeConfig:
+			name = d.Name()
+		case config.SwapVolumeConfig:
+			name = d.Name()
+		default:
+			continue
+		}
+
+		owner, exists := owners[name]
+		if !exists {
+			owners[name] = volumeOwner{document: docID(doc), mounted: mounted}
+
+			continue
+		}
+
+		if owner.mounted && mounted {
+			continue
+		}
+
+		issues = append(issues, CrossValidationIssue{
+			Document: docID(doc),
+			Field:    ".name",
+			Message:  fmt.Sprintf("volume name %q is also used by %s", name, owner.document),
+			Severity: CrossValidationWarning,
+		})
+	}
+
+	return issues
+}
+
+// crossValidateNetworkRules checks that network rules refer to the defined address sets and don't contradict each other.
+//
+// With the default action 'accept', each rule drops the traffic from the sources (or to the destinations for egress) it doesn't allow,
+// so two rules of the same direction selecting the same port with different sources block both.
+func (container *Container) crossValidateNetworkRules() []CrossValidationIssue {
+	var issues []CrossValidationIssue
+
+	addressSets := xslices.ToSet(xslices.Map(container.NetworkAddressSets(), func(set config.NetworkAddressSetConfig) string {
+		return set.Name()
+	}))
+
+	type documentRule struct {
+		document string
+		rule     config.NetworkRule
+	}
+
+	var rules []documentRule
+
+	for _, doc := range container.documents {
+		rulesDoc, ok := doc.(config.NetworkRuleConfigRules)
+		if !ok {
+			continue
+		}
+
+		for _, rule := range rulesDoc.Rules() {
+			field := ".ingress"
+			if rule.IsEgress() {
+				field = ".egress"
+			}
+
+			for _, name := range rule.AddressSets() {
+				if _, exists := addressSets[name]; !exists {
+					issues = append(issues, CrossValidationIssue{
+						Document: docID(doc),
+						Field:    field,
+						Message:  fmt.Sprintf("address set %q is not defined by any NetworkAddressSet document", name),
+						Severity: CrossValidationError,
+					})
+				}
+			}
+
+			rules = append(rules, documentRule{document: docID(doc), rule: rule})
+		}
+	}
+
+	defaultAction := func(egress bool) nethelpers.DefaultAction {
+		if egress {
+			return container.NetworkRules().DefaultEgressAction()
+		}
+
+		return container.NetworkRules().DefaultAction()
+	}
+
+	for i, r := range rules {
+		if defaultAction(r.rule.IsEgress()) != nethelpers.DefaultActionAccept {
+			continue
+		}
+
+		for _, other := range rules[:i] {
+			if r.rule.IsEgress() != other.rule.IsEgress() || r.rule.Protocol() != other.rule.Protocol() || sameNetworkRuleSources(r.rule, other.rule) {
+				continue
+			}
+
+			if overlap, ok := portRangesOverlap(r.rule.PortRanges(), other.rule.PortRanges()); ok {
+				issues = append(issues, CrossValidationIssue{
+					Document: r.document,
+					Field:    ".portSelector.ports",
+					Message: fmt.Sprintf(
+						"ports %s/%s are also selected by %s with different sources, with the default action %q only sources allowed by both rules can connect, merge the rules",
+						overlap, r.rule.Protocol(), other.document, nethelpers.DefaultActionAccept,
+					),
+					Severity: CrossValidationError,
+				})
+			}
+		}
+	}
+
+	return issues
+}
+
+func sameNetworkRuleSources(a, b config.NetworkRule) bool {
+	sortedPrefixes := func(prefixes []netip.Prefix) []netip.Prefix {
+		return slices.SortedFunc(slices.Values(prefixes), func(x, y netip.Prefix) int {
+			return strings.Compare(x.String(), y.String())
+		})
+	}
+
+	return slices.Equal(sortedPrefixes(a.Subnets()), sortedPrefixes(b.Subnets())) &&
+		slices.Equal(sortedPrefixes(a.ExceptSubnets()), sortedPrefixes(b.ExceptSubnets())) &&
+		slices.Equal(slices.Sorted(slices.Values(a.AddressSets())), slices.Sorted(slices.Values(b.AddressSets())))
+}
+
+// portRangesOverlap returns the first overlapping port range formatted as a string.
+func portRangesOverlap(a, b [][2]uint16) (string, bool) {
+	for _, ra := range a {
+		for _, rb := range b {
+			lo, hi := max(ra[0], rb[0]), min(ra[1], rb[1])
+
+			if lo > hi {
+				continue
+			}
+
+			if lo == hi {
+				return strconv.Itoa(int(lo)), true
+			}
+
+			return fmt.Sprintf("%d-%d", lo, hi), true
+		}
+	}
+
+	return "", false
+}
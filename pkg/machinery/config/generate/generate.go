@@ -101,6 +101,14 @@ func NewInput(clustername, endpoint, kubernetesVersion string, opts ...Option) (
 	input.AdditionalSubjectAltNames = additionalSubjectAltNames
 	input.PodNet = []string{podNet}
 	input.ServiceNet = []string{serviceNet}
+
+	if len(input.Options.PodSubnets) > 0 {
+		input.PodNet = slices.Clone(input.Options.PodSubnets)
+	}
+
+	if len(input.Options.ServiceSubnets) > 0 {
+		input.ServiceNet = slices.Clone(input.Options.ServiceSubnets)
+	}
 	input.ControlPlaneEndpoint = endpoint
 	input.KubernetesVersion = kubernetesVersion
 
@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/siderolabs/talos/pkg/machinery/client"
@@ -134,6 +136,24 @@ func (suite *GenerateSuite) TestGenerateTalosconfigSuccess() {
 	suite.Equal([]string{string(role.Admin)}, cert.Subject.Organization)
 }
 
+func TestPodServiceSubnets(t *testing.T) {
+	t.Parallel()
+
+	input, err := generate.NewInput("test", "https://[fd74:616c:a05::1]:6443", constants.DefaultKubernetesVersion,
+		generate.WithPodSubnets(constants.DefaultIPv4PodNet, constants.DefaultIPv6PodNet),
+		generate.WithServiceSubnets(constants.DefaultIPv4ServiceNet, constants.DefaultIPv6ServiceNet),
+	)
+	require.NoError(t, err)
+
+	for _, machineType := range []machine.Type{machine.TypeControlPlane, machine.TypeWorker} {
+		cfg, err := input.Config(machineType)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{constants.DefaultIPv4PodNet, constants.DefaultIPv6PodNet}, cfg.Cluster().Network().PodCIDRs())
+		assert.Equal(t, []string{constants.DefaultIPv4ServiceNet, constants.DefaultIPv6ServiceNet}, cfg.Cluster().Network().ServiceCIDRs())
+	}
+}
+
 type runtimeMode struct {
 	requiresInstall bool
 }
@@ -156,6 +156,28 @@ func WithDNSDomain(dnsDomain string) Option {
 	}
 }
 
+// WithPodSubnets specifies the Kubernetes pod subnets.
+//
+// If not set, the pod subnet is picked based on the address family of the control plane endpoint.
+func WithPodSubnets(subnets ...string) Option {
+	return func(o *Options) error {
+		o.PodSubnets = subnets
+
+		return nil
+	}
+}
+
+// WithServiceSubnets specifies the Kubernetes service subnets.
+//
+// If not set, the service subnet is picked based on the address family of the control plane endpoint.
+func WithServiceSubnets(subnets ...string) Option {
+	return func(o *Options) error {
+		o.ServiceSubnets = subnets
+
+		return nil
+	}
+}
+
 // WithDebug enables verbose logging to console for all services.
 func WithDebug(enable bool) Option {
 	return func(o *Options) error {
@@ -299,6 +321,8 @@ type Options struct {
 	LocalAPIServerPort             int
 	AdditionalSubjectAltNames      []string
 	DiscoveryEnabled               *bool
+	PodSubnets                     []string
+	ServiceSubnets                 []string
 
 	KubePrismPort optional.Optional[int]
 
@@ -75,6 +75,16 @@ func randBootstrapTokenString(length int) (string, error) {
 	return string(token), err
 }
 
+// NewBootstrapToken generates a new Kubernetes bootstrap token in the format of the cluster token.
+func NewBootstrapToken() (string, error) {
+	return genToken(6, 16)
+}
+
+// NewTrustdToken generates a new token in the format of the machine configuration token (trustd join token).
+func NewTrustdToken() (string, error) {
+	return genToken(6, 16)
+}
+
 // genToken will generate a token of the format abc.123 (like kubeadm/trustd), where the length of the first string (before the dot)
 // and length of the second string (after dot) are specified as inputs.
 func genToken(lenFirst, lenSecond int) (string, error) {
@@ -22,6 +22,10 @@ import (
 //   - if it is a pointer, merged dereferencing the pointer unless the right is nil
 //   - if it is a slice, merged by concatenating the right to the left.
 //   - if the `merge:"replace"` struct tag is defined, a slice is replaced with the value of the right (unless it's zero value.)
+//   - if the `merge:"key=<field>"` struct tag is defined, slice elements are matched by the value of the field with the
+//     specified YAML name, matching elements are merged recursively, other elements are appended.
+//     Multiple keys can be specified, the first key which is not zero value in the right element is used for matching.
+//     Bare `merge:"key"` tag matches the elements by their own value (for slices of simple values).
 //   - slices of `[]byte` are always replaced
 //   - if it is a map, for each key value is merged recursively.
 //   - if it is a struct, merge is performed for each field of the struct.
@@ -152,19 +156,35 @@ func merge(vl, vr reflect.Value, replace, overwrite bool) error {
 		}
 
 		for i := range tl.NumField() {
-			var replace bool
+			var (
+				replace bool
+				keys    []string
+			)
 
 			structTag := tl.Field(i).Tag.Get("merge")
 			for _, value := range strings.Split(structTag, ",") {
-				if value == "replace" {
+				switch {
+				case value == "replace":
 					replace = true
+				case value == "key":
+					keys = append(keys, "")
+				case strings.HasPrefix(value, "key="):
+					keys = append(keys, strings.TrimPrefix(value, "key="))
 				}
 			}
 
 			fl := vl.FieldByIndex(tl.Field(i).Index)
 			fr := vr.FieldByIndex(tr.Field(i).Index)
 
-			if err := merge(fl, fr, replace, false); err != nil {
+			var err error
+
+			if keys != nil && !replace && fl.Kind() == reflect.Slice {
+				err = mergeKeyed(fl, fr, keys)
+			} else {
+				err = merge(fl, fr, replace, false)
+			}
+
+			if err != nil {
 				return fmt.Errorf("merge field %v.%v: %w", tl, tl.Field(i).Name, err)
 			}
 		}
@@ -199,6 +219,101 @@ func merge(vl, vr reflect.Value, replace, overwrite bool) error {
 	return nil
 }
 
+// mergeKeyed merges slices matching the elements by the keys.
+func mergeKeyed(vl, vr reflect.Value, keys []string) error {
+	if vr.IsZero() {
+		return nil
+	}
+
+	if !vl.CanSet() {
+		return fmt.Errorf("merge not possible, left %v is not settable", vl)
+	}
+
+	// build a new slice to avoid modifying the backing array shared with other slices
+	result := reflect.AppendSlice(reflect.MakeSlice(vl.Type(), 0, vl.Len()+vr.Len()), vl)
+
+	for i := range vr.Len() {
+		elem := vr.Index(i)
+
+		idx, err := findKeyed(result, elem, keys)
+		if err != nil {
+			return err
+		}
+
+		if idx == -1 {
+			result = reflect.Append(result, elem)
+
+			continue
+		}
+
+		if err = merge(result.Index(idx), elem, false, false); err != nil {
+			return fmt.Errorf("merge slice element %d: %w", idx, err)
+		}
+	}
+
+	vl.Set(result)
+
+	return nil
+}
+
+// findKeyed returns the index of the element in the slice which has the same key as the element, or -1.
+func findKeyed(slice, elem reflect.Value, keys []string) (int, error) {
+	for _, key := range keys {
+		elemKey, err := keyValue(elem, key)
+		if err != nil {
+			return -1, err
+		}
+
+		if isNilOrZero(elemKey) || elemKey.IsZero() {
+			continue
+		}
+
+		for i := range slice.Len() {
+			candidateKey, err := keyValue(slice.Index(i), key)
+			if err != nil {
+				return -1, err
+			}
+
+			if !isNilOrZero(candidateKey) && reflect.DeepEqual(candidateKey.Interface(), elemKey.Interface()) {
+				return i, nil
+			}
+		}
+
+		return -1, nil
+	}
+
+	return -1, nil
+}
+
+// keyValue returns the value of the field with the given YAML name, or the value itself if the key is empty.
+func keyValue(v reflect.Value, key string) (reflect.Value, error) {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return zeroValue, nil
+		}
+
+		v = v.Elem()
+	}
+
+	if key == "" {
+		return v, nil
+	}
+
+	if v.Kind() != reflect.Struct {
+		return zeroValue, fmt.Errorf("merge key %q is not supported for %v", key, v.Type())
+	}
+
+	for i := range v.NumField() {
+		name, _, _ := strings.Cut(v.Type().Field(i).Tag.Get("yaml"), ",")
+
+		if name == key {
+			return v.Field(i), nil
+		}
+	}
+
+	return zeroValue, fmt.Errorf("merge key %q not found in %v", key, v.Type())
+}
+
 // isNilOrZero returns true if the [reflect.Value] is zero [reflect.Value] or something that is nil.
 // We need it because if map contains a key with `nil` value, simply comparing that result to the `zeroValue`
 // is not enough.
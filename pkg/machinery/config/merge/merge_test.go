@@ -25,6 +25,8 @@ type Config struct {
 	ReplacedSlice []string `merge:"replace"`
 	Map           map[string]Struct
 	CustomSlice   CustomSlice
+	KeyedSlice    []*KeyedStruct `merge:"key=name,key=selector"`
+	UniqueSlice   []string       `merge:"key"`
 }
 
 type Struct struct {
@@ -32,6 +34,13 @@ type Struct struct {
 	DB *int
 }
 
+type KeyedStruct struct {
+	Name     string   `yaml:"name,omitempty"`
+	Selector *Struct  `yaml:"selector,omitempty"`
+	Value    int      `yaml:"value,omitempty"`
+	Options  []string `yaml:"options,omitempty"`
+}
+
 type CustomSlice []string
 
 func (s *CustomSlice) Merge(other any) error {
@@ -246,6 +255,103 @@ func TestMerge(t *testing.T) {
 				CustomSlice: []string{"a", "b", "c", "d"},
 			},
 		},
+		{
+			name: "keyed slice",
+			left: &Config{
+				KeyedSlice: []*KeyedStruct{
+					{
+						Name:    "a",
+						Value:   1,
+						Options: []string{"foo"},
+					},
+					{
+						Name:  "b",
+						Value: 2,
+					},
+					{
+						Selector: &Struct{DA: true},
+						Value:    3,
+					},
+				},
+			},
+			right: &Config{
+				KeyedSlice: []*KeyedStruct{
+					{
+						Name:    "a",
+						Options: []string{"bar"},
+					},
+					{
+						Name:  "c",
+						Value: 4,
+					},
+					{
+						Selector: &Struct{DA: true},
+						Value:    5,
+					},
+					{
+						Selector: &Struct{DB: pointer.To(1)},
+						Value:    6,
+					},
+				},
+			},
+			expected: &Config{
+				KeyedSlice: []*KeyedStruct{
+					{
+						Name:    "a",
+						Value:   1,
+						Options: []string{"foo", "bar"},
+					},
+					{
+						Name:  "b",
+						Value: 2,
+					},
+					{
+						Selector: &Struct{DA: true},
+						Value:    5,
+					},
+					{
+						Name:  "c",
+						Value: 4,
+					},
+					{
+						Selector: &Struct{DB: pointer.To(1)},
+						Value:    6,
+					},
+				},
+			},
+		},
+		{
+			name: "keyed slice from zero",
+			left: &Config{},
+			right: &Config{
+				KeyedSlice: []*KeyedStruct{
+					{
+						Name:  "a",
+						Value: 1,
+					},
+				},
+			},
+			expected: &Config{
+				KeyedSlice: []*KeyedStruct{
+					{
+						Name:  "a",
+						Value: 1,
+					},
+				},
+			},
+		},
+		{
+			name: "unique slice",
+			left: &Config{
+				UniqueSlice: []string{"a", "b"},
+			},
+			right: &Config{
+				UniqueSlice: []string{"b", "c", "c"},
+			},
+			expected: &Config{
+				UniqueSlice: []string{"a", "b", "c"},
+			},
+		},
 		{
 			name: "merge with pointer override",
 			left: &Config{
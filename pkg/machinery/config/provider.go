@@ -18,6 +18,9 @@ type Validator = config.Validator
 // RuntimeValidator provides the interface to validate configuration in the runtime context.
 type RuntimeValidator = config.RuntimeValidator
 
+// FindingsValidator provides the interface to validate configuration returning the list of findings.
+type FindingsValidator = config.FindingsValidator
+
 // Container provides the interface to access configuration documents.
 //
 // Container might contain multiple config documents, supporting encoding/decoding,
@@ -26,6 +29,7 @@ type Container interface {
 	Encoder
 	Validator
 	RuntimeValidator
+	FindingsValidator
 
 	Readonly() bool
 
@@ -264,6 +264,26 @@ func (EncryptionKeyTPMOptions) Doc() *encoder.Doc {
 				Description: "List of PCRs to bind the key to. If not set, defaults to PCR 7, can be disabled by passing an empty list.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "List of PCRs to bind the key to. If not set, defaults to PCR 7, can be disabled by passing an empty list." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "pcrBank",
+				Type:        "string",
+				Note:        "",
+				Description: "PCR bank (hash algorithm) to read the PCR values from. If not set, defaults to sha256. The TPM must have the bank allocated, otherwise the key enrollment fails.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "PCR bank (hash algorithm) to read the PCR values from. If not set, defaults to sha256. The TPM must have the bank allocated, otherwise the key enrollment fails." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"sha1",
+					"sha256",
+					"sha384",
+					"sha512",
+				},
+			},
+			{
+				Name:        "disableSignedPCRPolicy",
+				Type:        "bool",
+				Note:        "",
+				Description: "Disable binding the key to the signed PCR 11 policy.\nBy default the key is bound to the PCR 11 value signed by the UKI signing key, so that the key can be unsealed by any UKI signed with the same key. If disabled, the key is bound only to the PCRs listed in `pcrs`, so at least one PCR must be set.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Disable binding the key to the signed PCR 11 policy." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
@@ -65,6 +65,10 @@ func (o *RawVolumeConfigV1Alpha1) DeepCopy() *RawVolumeConfigV1Alpha1 {
 						cp.EncryptionSpec.EncryptionKeys[i3].KeyTPM.TPMOptions.PCRs = make([]int, len(o.EncryptionSpec.EncryptionKeys[i3].KeyTPM.TPMOptions.PCRs))
 						copy(cp.EncryptionSpec.EncryptionKeys[i3].KeyTPM.TPMOptions.PCRs, o.EncryptionSpec.EncryptionKeys[i3].KeyTPM.TPMOptions.PCRs)
 					}
+					if o.EncryptionSpec.EncryptionKeys[i3].KeyTPM.TPMOptions.DisableSignedPCRPolicy != nil {
+						cp.EncryptionSpec.EncryptionKeys[i3].KeyTPM.TPMOptions.DisableSignedPCRPolicy = new(bool)
+						*cp.EncryptionSpec.EncryptionKeys[i3].KeyTPM.TPMOptions.DisableSignedPCRPolicy = *o.EncryptionSpec.EncryptionKeys[i3].KeyTPM.TPMOptions.DisableSignedPCRPolicy
+					}
 				}
 				if o.EncryptionSpec.EncryptionKeys[i3].KeyTPM.TPMCheckSecurebootStatusOnEnroll != nil {
 					cp.EncryptionSpec.EncryptionKeys[i3].KeyTPM.TPMCheckSecurebootStatusOnEnroll = new(bool)
@@ -133,6 +137,10 @@ func (o *SwapVolumeConfigV1Alpha1) DeepCopy() *SwapVolumeConfigV1Alpha1 {
 						cp.EncryptionSpec.EncryptionKeys[i3].KeyTPM.TPMOptions.PCRs = make([]int, len(o.EncryptionSpec.EncryptionKeys[i3].KeyTPM.TPMOptions.PCRs))
 						copy(cp.EncryptionSpec.EncryptionKeys[i3].KeyTPM.TPMOptions.PCRs, o.EncryptionSpec.EncryptionKeys[i3].KeyTPM.TPMOptions.PCRs)
 					}
+					if o.EncryptionSpec.EncryptionKeys[i3].KeyTPM.TPMOptions.DisableSignedPCRPolicy != nil {
+						cp.EncryptionSpec.EncryptionKeys[i3].KeyTPM.TPMOptions.DisableSignedPCRPolicy = new(bool)
+						*cp.EncryptionSpec.EncryptionKeys[i3].KeyTPM.TPMOptions.DisableSignedPCRPolicy = *o.EncryptionSpec.EncryptionKeys[i3].KeyTPM.TPMOptions.DisableSignedPCRPolicy
+					}
 				}
 				if o.EncryptionSpec.EncryptionKeys[i3].KeyTPM.TPMCheckSecurebootStatusOnEnroll != nil {
 					cp.EncryptionSpec.EncryptionKeys[i3].KeyTPM.TPMCheckSecurebootStatusOnEnroll = new(bool)
@@ -205,6 +213,10 @@ func (o *UserVolumeConfigV1Alpha1) DeepCopy() *UserVolumeConfigV1Alpha1 {
 						cp.EncryptionSpec.EncryptionKeys[i3].KeyTPM.TPMOptions.PCRs = make([]int, len(o.EncryptionSpec.EncryptionKeys[i3].KeyTPM.TPMOptions.PCRs))
 						copy(cp.EncryptionSpec.EncryptionKeys[i3].KeyTPM.TPMOptions.PCRs, o.EncryptionSpec.EncryptionKeys[i3].KeyTPM.TPMOptions.PCRs)
 					}
+					if o.EncryptionSpec.EncryptionKeys[i3].KeyTPM.TPMOptions.DisableSignedPCRPolicy != nil {
+						cp.EncryptionSpec.EncryptionKeys[i3].KeyTPM.TPMOptions.DisableSignedPCRPolicy = new(bool)
+						*cp.EncryptionSpec.EncryptionKeys[i3].KeyTPM.TPMOptions.DisableSignedPCRPolicy = *o.EncryptionSpec.EncryptionKeys[i3].KeyTPM.TPMOptions.DisableSignedPCRPolicy
+					}
 				}
 				if o.EncryptionSpec.EncryptionKeys[i3].KeyTPM.TPMCheckSecurebootStatusOnEnroll != nil {
 					cp.EncryptionSpec.EncryptionKeys[i3].KeyTPM.TPMCheckSecurebootStatusOnEnroll = new(bool)
@@ -273,6 +285,10 @@ func (o *VolumeConfigV1Alpha1) DeepCopy() *VolumeConfigV1Alpha1 {
 						cp.EncryptionSpec.EncryptionKeys[i3].KeyTPM.TPMOptions.PCRs = make([]int, len(o.EncryptionSpec.EncryptionKeys[i3].KeyTPM.TPMOptions.PCRs))
 						copy(cp.EncryptionSpec.EncryptionKeys[i3].KeyTPM.TPMOptions.PCRs, o.EncryptionSpec.EncryptionKeys[i3].KeyTPM.TPMOptions.PCRs)
 					}
+					if o.EncryptionSpec.EncryptionKeys[i3].KeyTPM.TPMOptions.DisableSignedPCRPolicy != nil {
+						cp.EncryptionSpec.EncryptionKeys[i3].KeyTPM.TPMOptions.DisableSignedPCRPolicy = new(bool)
+						*cp.EncryptionSpec.EncryptionKeys[i3].KeyTPM.TPMOptions.DisableSignedPCRPolicy = *o.EncryptionSpec.EncryptionKeys[i3].KeyTPM.TPMOptions.DisableSignedPCRPolicy
+					}
 				}
 				if o.EncryptionSpec.EncryptionKeys[i3].KeyTPM.TPMCheckSecurebootStatusOnEnroll != nil {
 					cp.EncryptionSpec.EncryptionKeys[i3].KeyTPM.TPMCheckSecurebootStatusOnEnroll = new(bool)
@@ -144,6 +144,23 @@ type EncryptionKeyTPMOptions struct {
 	//     List of PCRs to bind the key to.
 	//     If not set, defaults to PCR 7, can be disabled by passing an empty list.
 	PCRs []int `yaml:"pcrs,omitempty"`
+	//   description: >
+	//     PCR bank (hash algorithm) to read the PCR values from.
+	//     If not set, defaults to sha256.
+	//     The TPM must have the bank allocated, otherwise the key enrollment fails.
+	//   values:
+	//     - sha1
+	//     - sha256
+	//     - sha384
+	//     - sha512
+	PCRBank string `yaml:"pcrBank,omitempty"`
+	//   description: >
+	//     Disable binding the key to the signed PCR 11 policy.
+	//
+	//     By default the key is bound to the PCR 11 value signed by the UKI signing key,
+	//     so that the key can be unsealed by any UKI signed with the same key.
+	//     If disabled, the key is bound only to the PCRs listed in `pcrs`, so at least one PCR must be set.
+	DisableSignedPCRPolicy *bool `yaml:"disableSignedPCRPolicy,omitempty"`
 }
 
 // EncryptionKeyNodeID represents deterministically generated key from the node UUID and PartitionLabel.
@@ -186,6 +203,10 @@ func (s EncryptionSpec) Validate() ([]string, error) {
 
 		slotsInUse[key.KeySlot] = struct{}{}
 
+		if key.KeySlot == constants.EncryptionKeyRotationSlot {
+			errs = errors.Join(errs, fmt.Errorf("key slot %d is reserved for the key rotation", key.KeySlot))
+		}
+
 		if key.KeyStatic == nil && key.KeyNodeID == nil && key.KeyKMS == nil && key.KeyTPM == nil {
 			errs = errors.Join(errs, fmt.Errorf("at least one encryption key type must be specified for slot %d", key.KeySlot))
 		}
@@ -196,6 +217,16 @@ func (s EncryptionSpec) Validate() ([]string, error) {
 					errs = errors.Join(errs, fmt.Errorf("TPM PCR %d is out of range (0-23)", pcr))
 				}
 			}
+
+			switch key.KeyTPM.TPMOptions.PCRBank {
+			case "", "sha1", "sha256", "sha384", "sha512":
+			default:
+				errs = errors.Join(errs, fmt.Errorf("unsupported TPM PCR bank %q for slot %d", key.KeyTPM.TPMOptions.PCRBank, key.KeySlot))
+			}
+
+			if pointer.SafeDeref(key.KeyTPM.TPMOptions.DisableSignedPCRPolicy) && len(key.KeyTPM.TPMOptions.PCRs) == 0 {
+				errs = errors.Join(errs, fmt.Errorf("at least one TPM PCR must be set for slot %d when the signed PCR policy is disabled", key.KeySlot))
+			}
 		}
 	}
 
@@ -310,9 +341,22 @@ func (e *EncryptionKeyTPM) PCRs() []int {
 	return e.TPMOptions.PCRs
 }
 
+// PCRBank implements the config.Provider interface.
+func (e *EncryptionKeyTPM) PCRBank() string {
+	if e == nil || e.TPMOptions == nil || e.TPMOptions.PCRBank == "" {
+		return constants.DefaultTPMPCRBank
+	}
+
+	return e.TPMOptions.PCRBank
+}
+
 // PubKeyPCRs implements the config.Provider interface.
 func (e *EncryptionKeyTPM) PubKeyPCRs() []int {
-	// we always lock to PCR 11
+	if e != nil && e.TPMOptions != nil && pointer.SafeDeref(e.TPMOptions.DisableSignedPCRPolicy) {
+		return nil
+	}
+
+	// lock to PCR 11 unless explicitly disabled
 	return []int{constants.UKIPCR}
 }
 
@@ -243,6 +243,55 @@ func TestVolumeConfigValidate(t *testing.T) {
 
 			expectedErrors: "TPM PCR 24 is out of range (0-23)\nTPM PCR 25 is out of range (0-23)",
 		},
+		{
+			name: "invalid pcr policy",
+
+			cfg: func(t *testing.T) *block.VolumeConfigV1Alpha1 {
+				c := block.NewVolumeConfigV1Alpha1()
+				c.MetaName = constants.StatePartitionLabel
+
+				c.EncryptionSpec = block.EncryptionSpec{
+					EncryptionProvider: blockres.EncryptionProviderLUKS2,
+					EncryptionKeys: []block.EncryptionKey{
+						{
+							KeySlot: 0,
+							KeyTPM: &block.EncryptionKeyTPM{
+								TPMOptions: &block.EncryptionKeyTPMOptions{
+									PCRBank:                "md5",
+									DisableSignedPCRPolicy: pointer.To(true),
+								},
+							},
+						},
+					},
+				}
+
+				return c
+			},
+
+			expectedErrors: "unsupported TPM PCR bank \"md5\" for slot 0\nat least one TPM PCR must be set for slot 0 when the signed PCR policy is disabled",
+		},
+		{
+			name: "reserved key slot",
+
+			cfg: func(t *testing.T) *block.VolumeConfigV1Alpha1 {
+				c := block.NewVolumeConfigV1Alpha1()
+				c.MetaName = constants.StatePartitionLabel
+
+				c.EncryptionSpec = block.EncryptionSpec{
+					EncryptionProvider: blockres.EncryptionProviderLUKS2,
+					EncryptionKeys: []block.EncryptionKey{
+						{
+							KeySlot:   constants.EncryptionKeyRotationSlot,
+							KeyNodeID: &block.EncryptionKeyNodeID{},
+						},
+					},
+				}
+
+				return c
+			},
+
+			expectedErrors: "key slot 31 is reserved for the key rotation",
+		},
 		{
 			name: "valid",
 
@@ -282,26 +331,41 @@ func TestVolumeConfigTPMPCRsDefaults(t *testing.T) {
 	for _, test := range []struct {
 		name string
 
-		filename     string
-		expectedPCRs []int
+		filename           string
+		expectedPCRs       []int
+		expectedPCRBank    string
+		expectedPubKeyPCRs []int
 	}{
 		{
 			name:     "tpm encryption no options",
 			filename: "volumeconfig_tpm_encryption_no_options.yaml",
 
-			expectedPCRs: []int{7},
+			expectedPCRs:       []int{7},
+			expectedPCRBank:    "sha256",
+			expectedPubKeyPCRs: []int{constants.UKIPCR},
 		},
 		{
 			name:     "tpm encryption with pcr settings",
 			filename: "volumeconfig_tpm_encryption_with_pcr_settings.yaml",
 
-			expectedPCRs: []int{0, 7},
+			expectedPCRs:       []int{0, 7},
+			expectedPCRBank:    "sha256",
+			expectedPubKeyPCRs: []int{constants.UKIPCR},
 		},
 		{
 			name:     "tpm encryption with pcrs disabled",
 			filename: "volumeconfig_tpm_encryption_with_pcrs_disabled.yaml",
 
-			expectedPCRs: []int{},
+			expectedPCRs:       []int{},
+			expectedPCRBank:    "sha256",
+			expectedPubKeyPCRs: []int{constants.UKIPCR},
+		},
+		{
+			name:     "tpm encryption with pcr policy",
+			filename: "volumeconfig_tpm_encryption_with_pcr_policy.yaml",
+
+			expectedPCRs:    []int{7},
+			expectedPCRBank: "sha384",
 		},
 	} {
 		t.Run(test.name, func(t *testing.T) {
@@ -320,7 +384,8 @@ func TestVolumeConfigTPMPCRsDefaults(t *testing.T) {
 			require.True(t, ok)
 
 			assert.Equal(t, test.expectedPCRs, cfg.Encryption().Keys()[0].TPM().PCRs())
-			assert.Equal(t, []int{constants.UKIPCR}, cfg.Encryption().Keys()[0].TPM().PubKeyPCRs())
+			assert.Equal(t, test.expectedPCRBank, cfg.Encryption().Keys()[0].TPM().PCRBank())
+			assert.Equal(t, test.expectedPubKeyPCRs, cfg.Encryption().Keys()[0].TPM().PubKeyPCRs())
 		})
 	}
 }
@@ -0,0 +1,168 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hardware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/cosi-project/runtime/pkg/state"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/cpuset"
+)
+
+//docgen:jsonschema
+
+// CPUIsolationConfigKind is a config document kind.
+const CPUIsolationConfigKind = "CPUIsolationConfig"
+
+func init() {
+	registry.Register(CPUIsolationConfigKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1": //nolint:goconst
+			return &CPUIsolationConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.CPUIsolationConfig = &CPUIsolationConfigV1Alpha1{}
+	_ config.Validator          = &CPUIsolationConfigV1Alpha1{}
+	_ config.RuntimeValidator   = &CPUIsolationConfigV1Alpha1{}
+)
+
+// presentCPUsPath is the sysfs file listing CPUs present on the machine.
+const presentCPUsPath = "/sys/devices/system/cpu/present"
+
+// CPUIsolationConfigV1Alpha1 is a CPU isolation configuration document.
+//
+//	description: |
+//	  Isolated CPUs are removed from the general kernel scheduler and housekeeping work
+//	  via isolcpus, nohz_full and rcu_nocbs kernel arguments, which are applied on install and upgrade.
+//	  IRQs are steered away from isolated CPUs at runtime.
+//	examples:
+//	  - value: exampleCPUIsolationConfigV1Alpha1()
+//	alias: CPUIsolationConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/CPUIsolationConfig
+type CPUIsolationConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+
+	//   description: |
+	//     List of CPUs to isolate in the Linux kernel CPU list format (e.g. `2-7,10`).
+	//
+	//     At least one CPU should be left for the housekeeping tasks.
+	//   schemaRequired: true
+	IsolatedCPUsConfig string `yaml:"isolatedCPUs"`
+}
+
+// NewCPUIsolationConfigV1Alpha1 creates a new CPU isolation config document.
+func NewCPUIsolationConfigV1Alpha1() *CPUIsolationConfigV1Alpha1 {
+	return &CPUIsolationConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       CPUIsolationConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleCPUIsolationConfigV1Alpha1() *CPUIsolationConfigV1Alpha1 {
+	cfg := NewCPUIsolationConfigV1Alpha1()
+	cfg.IsolatedCPUsConfig = "2-7"
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *CPUIsolationConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Validate implements config.Validator interface.
+func (s *CPUIsolationConfigV1Alpha1) Validate(_ validation.RuntimeMode, opts ...validation.Option) ([]string, error) {
+	var warnings []string
+
+	cpus, err := cpuset.Parse(s.IsolatedCPUsConfig)
+	if err != nil {
+		return nil, fmt.Errorf("isolatedCPUs: %w", err)
+	}
+
+	if len(cpus) == 0 {
+		return nil, errors.New("isolatedCPUs: at least one CPU should be specified")
+	}
+
+	if validation.NewOptions(opts...).Local {
+		warnings = append(warnings, fmt.Sprintf("isolatedCPUs: CPU list %q can't be checked against the CPU count of the machine, it will be validated on apply", s.IsolatedCPUsConfig))
+	}
+
+	return warnings, nil
+}
+
+// RuntimeValidate implements config.RuntimeValidator interface.
+func (s *CPUIsolationConfigV1Alpha1) RuntimeValidate(_ context.Context, _ state.State, mode validation.RuntimeMode, _ ...validation.Option) ([]string, error) {
+	if mode.InContainer() {
+		return []string{"CPU isolation is not supported in container mode, this config change won't have any effect"}, nil
+	}
+
+	var warnings []string
+
+	// if booted using sd-boot, kernel arguments can't be changed on upgrade
+	if _, err := os.Stat("/sys/firmware/efi/efivars/StubInfo-4a67b082-0a4c-41cf-b6c7-440b29bb8c4f"); err == nil {
+		warnings = append(warnings, "CPU isolation kernel arguments are not supported when booting using SDBoot, only IRQ affinity will be configured")
+	}
+
+	contents, err := os.ReadFile(presentCPUsPath)
+	if err != nil {
+		return append(warnings, fmt.Sprintf("isolatedCPUs: failed to read the list of present CPUs, CPU list is not validated: %s", err)), nil
+	}
+
+	present, err := cpuset.Parse(string(contents))
+	if err != nil {
+		return append(warnings, fmt.Sprintf("isolatedCPUs: failed to parse the list of present CPUs, CPU list is not validated: %s", err)), nil
+	}
+
+	isolated := s.IsolatedCPUs()
+
+	if missing := isolated.Difference(present); len(missing) > 0 {
+		return warnings, fmt.Errorf("isolatedCPUs: CPUs %s are not present on the machine (present CPUs: %s)", missing, present)
+	}
+
+	if len(present.Difference(isolated)) == 0 {
+		return warnings, errors.New("isolatedCPUs: at least one CPU should be left for housekeeping")
+	}
+
+	return warnings, nil
+}
+
+// IsolatedCPUs implements config.CPUIsolationConfig interface.
+func (s *CPUIsolationConfigV1Alpha1) IsolatedCPUs() cpuset.Set {
+	cpus, _ := cpuset.Parse(s.IsolatedCPUsConfig) //nolint:errcheck // validated in Validate
+
+	return cpus
+}
+
+// KernelArgs implements config.CPUIsolationConfig interface.
+func (s *CPUIsolationConfigV1Alpha1) KernelArgs() []string {
+	cpus := s.IsolatedCPUs()
+	if len(cpus) == 0 {
+		return nil
+	}
+
+	return []string{
+		// managed_irq keeps kernel-managed IRQs (which can't be moved from userspace) off the isolated CPUs
+		"isolcpus=domain,managed_irq," + cpus.String(),
+		"nohz_full=" + cpus.String(),
+		"rcu_nocbs=" + cpus.String(),
+	}
+}
@@ -0,0 +1,127 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hardware_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/hardware"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/cpuset"
+)
+
+//go:embed testdata/cpuisolationconfig.yaml
+var expectedCPUIsolationConfigDocument []byte
+
+func TestCPUIsolationConfigMarshalUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	cfg := hardware.NewCPUIsolationConfigV1Alpha1()
+	cfg.IsolatedCPUsConfig = "2-7,10"
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	assert.Equal(t, string(expectedCPUIsolationConfigDocument), string(marshaled))
+
+	provider, err := configloader.NewFromBytes(expectedCPUIsolationConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, &hardware.CPUIsolationConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaAPIVersion: "v1alpha1",
+			MetaKind:       hardware.CPUIsolationConfigKind,
+		},
+		IsolatedCPUsConfig: "2-7,10",
+	}, docs[0])
+
+	require.NotNil(t, provider.CPUIsolationConfig())
+	assert.Equal(t, cpuset.Set{2, 3, 4, 5, 6, 7, 10}, provider.CPUIsolationConfig().IsolatedCPUs())
+	assert.Equal(t, []string{
+		"isolcpus=domain,managed_irq,2-7,10",
+		"nohz_full=2-7,10",
+		"rcu_nocbs=2-7,10",
+	}, provider.CPUIsolationConfig().KernelArgs())
+}
+
+func TestCPUIsolationConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+
+		isolatedCPUs string
+		opts         []validation.Option
+
+		expectedWarnings []string
+		expectedError    string
+	}{
+		{
+			name:         "valid",
+			isolatedCPUs: "1-3,6",
+		},
+		{
+			name:         "local",
+			isolatedCPUs: "1-3",
+			opts:         []validation.Option{validation.WithLocal()},
+
+			expectedWarnings: []string{
+				"isolatedCPUs: CPU list \"1-3\" can't be checked against the CPU count of the machine, it will be validated on apply",
+			},
+		},
+		{
+			name: "empty",
+
+			expectedError: "isolatedCPUs: at least one CPU should be specified",
+		},
+		{
+			name:         "invalid",
+			isolatedCPUs: "3-1",
+
+			expectedError: "isolatedCPUs: invalid CPU list \"3-1\": range \"3-1\" is reversed",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := hardware.NewCPUIsolationConfigV1Alpha1()
+			cfg.IsolatedCPUsConfig = test.isolatedCPUs
+
+			warnings, err := cfg.Validate(validationMode{}, test.opts...)
+
+			assert.Equal(t, test.expectedWarnings, warnings)
+
+			if test.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.expectedError)
+			}
+		})
+	}
+}
+
+type validationMode struct{}
+
+func (validationMode) String() string {
+	return ""
+}
+
+func (validationMode) RequiresInstall() bool {
+	return false
+}
+
+func (validationMode) InContainer() bool {
+	return false
+}
@@ -5,6 +5,6 @@
 // Package hardware provides hardware related config documents.
 package hardware
 
-//go:generate go tool github.com/siderolabs/talos/tools/docgen -output hardware_doc.go hardware.go pci_driver_rebind_config.go
+//go:generate go tool github.com/siderolabs/talos/tools/docgen -output hardware_doc.go hardware.go cpu_isolation_config.go pci_driver_rebind_config.go
 
-//go:generate go tool github.com/siderolabs/deep-copy -type PCIDriverRebindConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go .
+//go:generate go tool github.com/siderolabs/deep-copy -type CPUIsolationConfigV1Alpha1 -type PCIDriverRebindConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go .
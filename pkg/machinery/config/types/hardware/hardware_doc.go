@@ -10,6 +10,28 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
 )
 
+func (CPUIsolationConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "CPUIsolationConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "CPUIsolationConfig is a CPU isolation configuration document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "CPUIsolationConfig is a CPU isolation configuration document.\nIsolated CPUs are removed from the general kernel scheduler and housekeeping work\nvia isolcpus, nohz_full and rcu_nocbs kernel arguments, which are applied on install and upgrade.\nIRQs are steered away from isolated CPUs at runtime.\n",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "isolatedCPUs",
+				Type:        "string",
+				Note:        "",
+				Description: "List of CPUs to isolate in the Linux kernel CPU list format (e.g. `2-7,10`).\n\nAt least one CPU should be left for the housekeeping tasks.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "List of CPUs to isolate in the Linux kernel CPU list format (e.g. `2-7,10`)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleCPUIsolationConfigV1Alpha1())
+
+	return doc
+}
+
 func (PCIDriverRebindConfigV1Alpha1) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
 		Type:        "PCIDriverRebindConfig",
@@ -45,6 +67,7 @@ func GetFileDoc() *encoder.FileDoc {
 		Name:        "hardware",
 		Description: "Package hardware provides hardware related config documents.\n",
 		Structs: []*encoder.Doc{
+			CPUIsolationConfigV1Alpha1{}.Doc(),
 			PCIDriverRebindConfigV1Alpha1{}.Doc(),
 		},
 	}
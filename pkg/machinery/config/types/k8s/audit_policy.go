@@ -0,0 +1,169 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// auditPolicy mirrors the audit.k8s.io/v1 Policy schema.
+type auditPolicy struct {
+	APIVersion        string         `yaml:"apiVersion"`
+	Kind              string         `yaml:"kind"`
+	Metadata          map[string]any `yaml:"metadata,omitempty"`
+	Rules             []yaml.Node    `yaml:"rules"`
+	OmitStages        []string       `yaml:"omitStages,omitempty"`
+	OmitManagedFields bool           `yaml:"omitManagedFields,omitempty"`
+}
+
+// auditPolicyRule mirrors the audit.k8s.io/v1 PolicyRule schema.
+type auditPolicyRule struct {
+	Level             string                `yaml:"level"`
+	Users             []string              `yaml:"users,omitempty"`
+	UserGroups        []string              `yaml:"userGroups,omitempty"`
+	Verbs             []string              `yaml:"verbs,omitempty"`
+	Resources         []auditGroupResources `yaml:"resources,omitempty"`
+	Namespaces        []string              `yaml:"namespaces,omitempty"`
+	NonResourceURLs   []string              `yaml:"nonResourceURLs,omitempty"`
+	OmitStages        []string              `yaml:"omitStages,omitempty"`
+	OmitManagedFields *bool                 `yaml:"omitManagedFields,omitempty"`
+}
+
+// auditGroupResources mirrors the audit.k8s.io/v1 GroupResources schema.
+type auditGroupResources struct {
+	Group         string   `yaml:"group,omitempty"`
+	Resources     []string `yaml:"resources,omitempty"`
+	ResourceNames []string `yaml:"resourceNames,omitempty"`
+}
+
+var (
+	auditLevels = []string{"None", "Metadata", "Request", "RequestResponse"}
+	auditStages = []string{"RequestReceived", "ResponseStarted", "ResponseComplete", "Panic"}
+)
+
+func decodeStrict(in []byte, out any) error {
+	dec := yaml.NewDecoder(bytes.NewReader(in))
+	dec.KnownFields(true)
+
+	return dec.Decode(out)
+}
+
+// validateAuditPolicy validates the audit policy following the kube-apiserver validation rules.
+//
+// Errors in the policy rules are reported with the rule index.
+func validateAuditPolicy(in string) error {
+	var policy auditPolicy
+
+	if err := decodeStrict([]byte(in), &policy); err != nil {
+		return fmt.Errorf("policy: %w", err)
+	}
+
+	var errs error
+
+	if policy.APIVersion != "audit.k8s.io/v1" {
+		errs = errors.Join(errs, fmt.Errorf("policy: unsupported apiVersion %q, expected \"audit.k8s.io/v1\"", policy.APIVersion))
+	}
+
+	if policy.Kind != "Policy" {
+		errs = errors.Join(errs, fmt.Errorf("policy: unsupported kind %q, expected \"Policy\"", policy.Kind))
+	}
+
+	if len(policy.Rules) == 0 {
+		errs = errors.Join(errs, errors.New("policy: at least one rule should be specified"))
+	}
+
+	for _, stage := range invalidAuditStages(policy.OmitStages) {
+		errs = errors.Join(errs, fmt.Errorf("policy: omitStages: unsupported stage %q", stage))
+	}
+
+	for i := range policy.Rules {
+		for _, err := range validateAuditPolicyRule(&policy.Rules[i]) {
+			errs = errors.Join(errs, fmt.Errorf("policy: rules[%d]: %w", i, err))
+		}
+	}
+
+	return errs
+}
+
+func validateAuditPolicyRule(node *yaml.Node) []error {
+	// re-encode the rule to decode it in the strict mode
+	raw, err := yaml.Marshal(node)
+	if err != nil {
+		return []error{err}
+	}
+
+	var rule auditPolicyRule
+
+	if err = decodeStrict(raw, &rule); err != nil {
+		return []error{err}
+	}
+
+	var errs []error
+
+	switch {
+	case rule.Level == "":
+		errs = append(errs, errors.New("level is required"))
+	case !slices.Contains(auditLevels, rule.Level):
+		errs = append(errs, fmt.Errorf("unsupported level %q, supported levels: %s", rule.Level, strings.Join(auditLevels, ", ")))
+	}
+
+	if len(rule.Resources) > 0 && len(rule.NonResourceURLs) > 0 {
+		errs = append(errs, errors.New("rule can't apply to both resources and non-resource URLs"))
+	}
+
+	if len(rule.Namespaces) > 0 && len(rule.NonResourceURLs) > 0 {
+		errs = append(errs, errors.New("rule can't apply to both namespaces and non-resource URLs"))
+	}
+
+	for j, resources := range rule.Resources {
+		if strings.Contains(resources.Group, "/") {
+			errs = append(errs, fmt.Errorf("resources[%d]: group %q should not contain '/'", j, resources.Group))
+		}
+
+		if len(resources.ResourceNames) == 0 {
+			continue
+		}
+
+		for _, resource := range resources.Resources {
+			if strings.Contains(resource, "*") {
+				errs = append(errs, fmt.Errorf("resources[%d]: resourceNames can't be used with the wildcard resource %q", j, resource))
+			}
+		}
+	}
+
+	for _, nonResourceURL := range rule.NonResourceURLs {
+		if !strings.HasPrefix(nonResourceURL, "/") {
+			errs = append(errs, fmt.Errorf("non-resource URL %q should start with '/'", nonResourceURL))
+		}
+
+		if i := strings.Index(nonResourceURL, "*"); i >= 0 && i != len(nonResourceURL)-1 {
+			errs = append(errs, fmt.Errorf("non-resource URL %q may only use the wildcard '*' at the end", nonResourceURL))
+		}
+	}
+
+	for _, stage := range invalidAuditStages(rule.OmitStages) {
+		errs = append(errs, fmt.Errorf("omitStages: unsupported stage %q", stage))
+	}
+
+	return errs
+}
+
+func invalidAuditStages(stages []string) []string {
+	var invalid []string
+
+	for _, stage := range stages {
+		if !slices.Contains(auditStages, stage) {
+			invalid = append(invalid, stage)
+		}
+	}
+
+	return invalid
+}
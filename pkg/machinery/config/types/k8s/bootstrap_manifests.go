@@ -0,0 +1,192 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// BootstrapManifestsKind is a BootstrapManifests config document kind.
+const BootstrapManifestsKind = "BootstrapManifestsConfig"
+
+func init() {
+	registry.Register(BootstrapManifestsKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1": //nolint:goconst
+			return &BootstrapManifestsConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.BootstrapManifestsConfig      = &BootstrapManifestsConfigV1Alpha1{}
+	_ config.BootstrapManifestObjectPolicy = BootstrapManifestObjectPolicyConfig{}
+	_ config.Validator                     = &BootstrapManifestsConfigV1Alpha1{}
+)
+
+// BootstrapManifestsConfigV1Alpha1 is a config document to configure the synchronization of the bootstrap manifests.
+//
+//	examples:
+//	  - value: exampleBootstrapManifestsConfigV1Alpha1()
+//	alias: BootstrapManifestsConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/BootstrapManifestsConfig
+type BootstrapManifestsConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+
+	//   description: |
+	//     List of the bootstrap manifest object policies.
+	//
+	//     The first matching policy is applied to each object of the bootstrap manifests (CNI, kube-proxy, CoreDNS, etc.).
+	//     Objects which don't match any policy are created and kept in sync by Talos.
+	ObjectPoliciesConfig []BootstrapManifestObjectPolicyConfig `yaml:"objects,omitempty"`
+}
+
+// BootstrapManifestObjectPolicyConfig is a synchronization policy for the bootstrap manifest objects.
+type BootstrapManifestObjectPolicyConfig struct {
+	//   description: |
+	//     Name of the bootstrap manifest, as in `talosctl get manifests`.
+	//
+	//     If not set, objects from any manifest are matched.
+	//   examples:
+	//     - value: >
+	//         "11-core-dns"
+	ObjectManifest string `yaml:"manifest,omitempty"`
+	//   description: |
+	//     Kind of the object.
+	//
+	//     If not set, objects of any kind are matched.
+	//   examples:
+	//     - value: >
+	//         "ConfigMap"
+	ObjectKind string `yaml:"kind,omitempty"`
+	//   description: |
+	//     Namespace of the object.
+	//
+	//     If not set, objects in any namespace are matched.
+	//   examples:
+	//     - value: >
+	//         "kube-system"
+	ObjectNamespace string `yaml:"namespace,omitempty"`
+	//   description: |
+	//     Name of the object.
+	//
+	//     If not set, objects with any name are matched.
+	//   examples:
+	//     - value: >
+	//         "coredns"
+	ObjectName string `yaml:"name,omitempty"`
+	//   description: |
+	//     Policy for the matching objects.
+	//
+	//     `skip` excludes the objects from the bootstrap manifests, so that Talos never creates or updates them.
+	//     `create-only` creates the objects if they are missing, but Talos never updates them afterwards.
+	//   values:
+	//     - skip
+	//     - create-only
+	//   schemaRequired: true
+	ObjectPolicy string `yaml:"policy"`
+}
+
+// NewBootstrapManifestsConfigV1Alpha1 creates a new BootstrapManifestsConfig config document.
+func NewBootstrapManifestsConfigV1Alpha1() *BootstrapManifestsConfigV1Alpha1 {
+	return &BootstrapManifestsConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       BootstrapManifestsKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleBootstrapManifestsConfigV1Alpha1() *BootstrapManifestsConfigV1Alpha1 {
+	cfg := NewBootstrapManifestsConfigV1Alpha1()
+	cfg.ObjectPoliciesConfig = []BootstrapManifestObjectPolicyConfig{
+		{
+			ObjectKind:      "ConfigMap",
+			ObjectNamespace: "kube-system",
+			ObjectName:      "coredns",
+			ObjectPolicy:    config.BootstrapManifestPolicyCreateOnly,
+		},
+		{
+			ObjectManifest: "10-kube-proxy",
+			ObjectPolicy:   config.BootstrapManifestPolicySkip,
+		},
+	}
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *BootstrapManifestsConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// ObjectPolicies implements config.BootstrapManifestsConfig interface.
+func (s *BootstrapManifestsConfigV1Alpha1) ObjectPolicies() []config.BootstrapManifestObjectPolicy {
+	result := make([]config.BootstrapManifestObjectPolicy, 0, len(s.ObjectPoliciesConfig))
+
+	for _, policy := range s.ObjectPoliciesConfig {
+		result = append(result, policy)
+	}
+
+	return result
+}
+
+// Validate implements config.Validator interface.
+func (s *BootstrapManifestsConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var errs error
+
+	for i, policy := range s.ObjectPoliciesConfig {
+		if policy.ObjectManifest == "" && policy.ObjectKind == "" && policy.ObjectName == "" {
+			errs = errors.Join(errs, fmt.Errorf("object policy %d: at least one of manifest, kind or name should be set", i))
+		}
+
+		switch policy.ObjectPolicy {
+		case config.BootstrapManifestPolicySkip, config.BootstrapManifestPolicyCreateOnly:
+		case "":
+			errs = errors.Join(errs, fmt.Errorf("object policy %d: policy is required", i))
+		default:
+			errs = errors.Join(errs, fmt.Errorf("object policy %d: unsupported policy %q", i, policy.ObjectPolicy))
+		}
+	}
+
+	return nil, errs
+}
+
+// Manifest implements config.BootstrapManifestObjectPolicy interface.
+func (s BootstrapManifestObjectPolicyConfig) Manifest() string {
+	return s.ObjectManifest
+}
+
+// Kind implements config.BootstrapManifestObjectPolicy interface.
+func (s BootstrapManifestObjectPolicyConfig) Kind() string {
+	return s.ObjectKind
+}
+
+// Namespace implements config.BootstrapManifestObjectPolicy interface.
+func (s BootstrapManifestObjectPolicyConfig) Namespace() string {
+	return s.ObjectNamespace
+}
+
+// Name implements config.BootstrapManifestObjectPolicy interface.
+func (s BootstrapManifestObjectPolicyConfig) Name() string {
+	return s.ObjectName
+}
+
+// Policy implements config.BootstrapManifestObjectPolicy interface.
+func (s BootstrapManifestObjectPolicyConfig) Policy() string {
+	return s.ObjectPolicy
+}
@@ -0,0 +1,138 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+)
+
+//go:embed testdata/bootstrapmanifestsconfig.yaml
+var expectedBootstrapManifestsConfigDocument []byte
+
+func TestBootstrapManifestsConfigMarshalUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	cfg := k8s.NewBootstrapManifestsConfigV1Alpha1()
+	cfg.ObjectPoliciesConfig = []k8s.BootstrapManifestObjectPolicyConfig{
+		{
+			ObjectKind:      "ConfigMap",
+			ObjectNamespace: "kube-system",
+			ObjectName:      "coredns",
+			ObjectPolicy:    config.BootstrapManifestPolicyCreateOnly,
+		},
+		{
+			ObjectManifest: "10-kube-proxy",
+			ObjectPolicy:   config.BootstrapManifestPolicySkip,
+		},
+	}
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	assert.Equal(t, string(expectedBootstrapManifestsConfigDocument), string(marshaled))
+
+	provider, err := configloader.NewFromBytes(expectedBootstrapManifestsConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, &k8s.BootstrapManifestsConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaAPIVersion: "v1alpha1",
+			MetaKind:       k8s.BootstrapManifestsKind,
+		},
+		ObjectPoliciesConfig: cfg.ObjectPoliciesConfig,
+	}, docs[0])
+
+	bootstrapManifestsConfig := provider.BootstrapManifestsConfig()
+	require.NotNil(t, bootstrapManifestsConfig)
+
+	policies := bootstrapManifestsConfig.ObjectPolicies()
+	require.Len(t, policies, 2)
+
+	assert.Equal(t, "ConfigMap", policies[0].Kind())
+	assert.Equal(t, "kube-system", policies[0].Namespace())
+	assert.Equal(t, "coredns", policies[0].Name())
+	assert.Equal(t, config.BootstrapManifestPolicyCreateOnly, policies[0].Policy())
+	assert.Equal(t, "10-kube-proxy", policies[1].Manifest())
+	assert.Equal(t, config.BootstrapManifestPolicySkip, policies[1].Policy())
+}
+
+func TestBootstrapManifestsConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name     string
+		policies []k8s.BootstrapManifestObjectPolicyConfig
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "valid",
+			policies: []k8s.BootstrapManifestObjectPolicyConfig{
+				{
+					ObjectKind:   "DaemonSet",
+					ObjectPolicy: config.BootstrapManifestPolicySkip,
+				},
+				{
+					ObjectManifest: "11-core-dns",
+					ObjectName:     "coredns",
+					ObjectPolicy:   config.BootstrapManifestPolicyCreateOnly,
+				},
+			},
+		},
+		{
+			name: "invalid",
+			policies: []k8s.BootstrapManifestObjectPolicyConfig{
+				{
+					ObjectNamespace: "kube-system",
+					ObjectPolicy:    config.BootstrapManifestPolicySkip,
+				},
+				{
+					ObjectName: "coredns",
+				},
+				{
+					ObjectKind:   "ConfigMap",
+					ObjectPolicy: "ignore",
+				},
+			},
+
+			expectedError: "object policy 0: at least one of manifest, kind or name should be set\n" +
+				"object policy 1: policy is required\n" +
+				`object policy 2: unsupported policy "ignore"`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := k8s.NewBootstrapManifestsConfigV1Alpha1()
+			cfg.ObjectPoliciesConfig = test.policies
+
+			warnings, err := cfg.Validate(validationMode{})
+
+			assert.Empty(t, warnings)
+
+			if test.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.expectedError)
+			}
+		})
+	}
+}
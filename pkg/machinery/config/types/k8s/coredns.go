@@ -0,0 +1,325 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"regexp"
+	"strings"
+
+	"github.com/siderolabs/gen/optional"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// CoreDNSKind is a CoreDNS config document kind.
+const CoreDNSKind = "CoreDNSConfig"
+
+func init() {
+	registry.Register(CoreDNSKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1": //nolint:goconst
+			return &CoreDNSConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.CoreDNSConfig     = &CoreDNSConfigV1Alpha1{}
+	_ config.CoreDNSStubDomain = CoreDNSStubDomainConfig{}
+	_ config.Resources         = &CoreDNSResourcesConfig{}
+	_ config.Validator         = &CoreDNSConfigV1Alpha1{}
+)
+
+// CoreDNSConfigV1Alpha1 is a config document to override the CoreDNS configuration rendered by Talos.
+//
+//	examples:
+//	  - value: exampleCoreDNSConfigV1Alpha1()
+//	alias: CoreDNSConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/CoreDNSConfig
+type CoreDNSConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+
+	//   description: |
+	//     List of the stub domains forwarded to the specified upstream servers.
+	//
+	//     Each stub domain is rendered as a separate server block of the Corefile.
+	StubDomainsConfig []CoreDNSStubDomainConfig `yaml:"stubDomains,omitempty"`
+	//   description: |
+	//     Additional server blocks appended to the rendered Corefile.
+	//
+	//     The server blocks are validated for the Corefile syntax.
+	//   examples:
+	//     - value: >
+	//         exampleCoreDNSExtraServerBlocks
+	ExtraServerBlocksConfig string `yaml:"extraServerBlocks,omitempty"`
+	//   description: |
+	//     Number of CoreDNS replicas (defaults to 2).
+	//   examples:
+	//     - value: 3
+	ReplicasConfig *int `yaml:"replicas,omitempty"`
+	//   description: |
+	//     CoreDNS container resources, which override the default requests and limits.
+	ResourcesConfig *CoreDNSResourcesConfig `yaml:"resources,omitempty"`
+}
+
+// CoreDNSStubDomainConfig is a CoreDNS stub domain configuration.
+type CoreDNSStubDomainConfig struct {
+	//   description: |
+	//     Stub domain name.
+	//   schemaRequired: true
+	//   examples:
+	//     - value: >
+	//         "corp.example.com"
+	DomainName string `yaml:"domain"`
+	//   description: |
+	//     List of the upstream servers for the stub domain.
+	//
+	//     Upstreams are specified as IP addresses with an optional port, or with the `tls://` prefix for DNS-over-TLS.
+	//   schemaRequired: true
+	//   examples:
+	//     - value: >
+	//         []string{"10.0.0.53", "10.0.1.53:5353"}
+	DomainUpstreams []string `yaml:"upstreams"`
+}
+
+// CoreDNSResourcesConfig is a CoreDNS container resources configuration.
+type CoreDNSResourcesConfig struct {
+	//   description: |
+	//     Requests configures the reserved cpu/memory resources.
+	//   examples:
+	//     - value: >
+	//         map[string]string{"cpu": "200m", "memory": "128Mi"}
+	ResourceRequests map[string]string `yaml:"requests,omitempty"`
+	//   description: |
+	//     Limits configures the maximum cpu/memory resources CoreDNS can use.
+	//   examples:
+	//     - value: >
+	//         map[string]string{"memory": "256Mi"}
+	ResourceLimits map[string]string `yaml:"limits,omitempty"`
+}
+
+// resourceQuantityRegexp matches the Kubernetes resource quantity, e.g. `100m`, `128Mi` or `1.5`.
+var resourceQuantityRegexp = regexp.MustCompile(`^[+]?([0-9]+(\.[0-9]*)?|\.[0-9]+)([numkMGTPE]|[KMGTPE]i|[eE][+-]?[0-9]+)?$`)
+
+var exampleCoreDNSExtraServerBlocks = `example.org:53 {
+    errors
+    cache 300
+    forward . 192.168.1.53
+}
+`
+
+// NewCoreDNSConfigV1Alpha1 creates a new CoreDNSConfig config document.
+func NewCoreDNSConfigV1Alpha1() *CoreDNSConfigV1Alpha1 {
+	return &CoreDNSConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       CoreDNSKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleCoreDNSConfigV1Alpha1() *CoreDNSConfigV1Alpha1 {
+	replicas := 3
+
+	cfg := NewCoreDNSConfigV1Alpha1()
+	cfg.StubDomainsConfig = []CoreDNSStubDomainConfig{
+		{
+			DomainName:      "corp.example.com",
+			DomainUpstreams: []string{"10.0.0.53", "10.0.1.53"},
+		},
+	}
+	cfg.ReplicasConfig = &replicas
+	cfg.ResourcesConfig = &CoreDNSResourcesConfig{
+		ResourceLimits: map[string]string{
+			"memory": "256Mi",
+		},
+	}
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *CoreDNSConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// StubDomains implements config.CoreDNSConfig interface.
+func (s *CoreDNSConfigV1Alpha1) StubDomains() []config.CoreDNSStubDomain {
+	result := make([]config.CoreDNSStubDomain, 0, len(s.StubDomainsConfig))
+
+	for _, stubDomain := range s.StubDomainsConfig {
+		result = append(result, stubDomain)
+	}
+
+	return result
+}
+
+// ExtraServerBlocks implements config.CoreDNSConfig interface.
+func (s *CoreDNSConfigV1Alpha1) ExtraServerBlocks() string {
+	return s.ExtraServerBlocksConfig
+}
+
+// Replicas implements config.CoreDNSConfig interface.
+func (s *CoreDNSConfigV1Alpha1) Replicas() optional.Optional[int] {
+	if s.ReplicasConfig == nil {
+		return optional.None[int]()
+	}
+
+	return optional.Some(*s.ReplicasConfig)
+}
+
+// Resources implements config.CoreDNSConfig interface.
+func (s *CoreDNSConfigV1Alpha1) Resources() config.Resources {
+	return s.ResourcesConfig
+}
+
+// Validate implements config.Validator interface.
+//
+//nolint:gocyclo
+func (s *CoreDNSConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var errs error
+
+	domains := map[string]struct{}{}
+
+	for i, stubDomain := range s.StubDomainsConfig {
+		domain := strings.TrimSuffix(stubDomain.DomainName, ".")
+
+		switch {
+		case domain == "":
+			errs = errors.Join(errs, fmt.Errorf("stub domain %d: domain is required", i))
+		case strings.ContainsAny(domain, " \t{}#\"/:"):
+			errs = errors.Join(errs, fmt.Errorf("stub domain %d: invalid domain %q", i, stubDomain.DomainName))
+		}
+
+		if _, ok := domains[domain]; ok {
+			errs = errors.Join(errs, fmt.Errorf("stub domain %d: duplicate domain %q", i, stubDomain.DomainName))
+		}
+
+		domains[domain] = struct{}{}
+
+		if len(stubDomain.DomainUpstreams) == 0 {
+			errs = errors.Join(errs, fmt.Errorf("stub domain %d: at least one upstream is required", i))
+		}
+
+		for _, upstream := range stubDomain.DomainUpstreams {
+			if err := validateCoreDNSUpstream(upstream); err != nil {
+				errs = errors.Join(errs, fmt.Errorf("stub domain %d: %w", i, err))
+			}
+		}
+	}
+
+	if s.ExtraServerBlocksConfig != "" {
+		if err := validateCorefile(s.ExtraServerBlocksConfig); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("extra server blocks: %w", err))
+		}
+	}
+
+	if s.ReplicasConfig != nil && *s.ReplicasConfig < 1 {
+		errs = errors.Join(errs, fmt.Errorf("replicas should be at least 1, got %d", *s.ReplicasConfig))
+	}
+
+	if err := s.ResourcesConfig.Validate(); err != nil {
+		errs = errors.Join(errs, err)
+	}
+
+	return nil, errs
+}
+
+func validateCoreDNSUpstream(upstream string) error {
+	addr := strings.TrimPrefix(upstream, "tls://")
+
+	if _, err := netip.ParseAddr(addr); err == nil {
+		return nil
+	}
+
+	if _, err := netip.ParseAddrPort(addr); err == nil {
+		return nil
+	}
+
+	return fmt.Errorf("invalid upstream %q: should be an IP address with an optional port", upstream)
+}
+
+// Domain implements config.CoreDNSStubDomain interface.
+func (s CoreDNSStubDomainConfig) Domain() string {
+	return s.DomainName
+}
+
+// Upstreams implements config.CoreDNSStubDomain interface.
+func (s CoreDNSStubDomainConfig) Upstreams() []string {
+	return s.DomainUpstreams
+}
+
+// CPURequests implements config.Resources interface.
+func (r *CoreDNSResourcesConfig) CPURequests() string {
+	if r == nil {
+		return ""
+	}
+
+	return r.ResourceRequests["cpu"]
+}
+
+// MemoryRequests implements config.Resources interface.
+func (r *CoreDNSResourcesConfig) MemoryRequests() string {
+	if r == nil {
+		return ""
+	}
+
+	return r.ResourceRequests["memory"]
+}
+
+// CPULimits implements config.Resources interface.
+func (r *CoreDNSResourcesConfig) CPULimits() string {
+	if r == nil {
+		return ""
+	}
+
+	return r.ResourceLimits["cpu"]
+}
+
+// MemoryLimits implements config.Resources interface.
+func (r *CoreDNSResourcesConfig) MemoryLimits() string {
+	if r == nil {
+		return ""
+	}
+
+	return r.ResourceLimits["memory"]
+}
+
+// Validate the resources configuration.
+func (r *CoreDNSResourcesConfig) Validate() error {
+	if r == nil {
+		return nil
+	}
+
+	var errs error
+
+	for _, resources := range []map[string]string{r.ResourceRequests, r.ResourceLimits} {
+		for key, value := range resources {
+			switch key {
+			case "cpu", "memory":
+			default:
+				errs = errors.Join(errs, fmt.Errorf("unsupported resource %q", key))
+			}
+
+			if !resourceQuantityRegexp.MatchString(value) {
+				errs = errors.Join(errs, fmt.Errorf("invalid %s quantity %q", key, value))
+			}
+		}
+	}
+
+	return errs
+}
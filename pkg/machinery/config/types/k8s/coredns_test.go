@@ -0,0 +1,236 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+)
+
+//go:embed testdata/corednsconfig.yaml
+var expectedCoreDNSConfigDocument []byte
+
+func TestCoreDNSConfigMarshalUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	cfg := k8s.NewCoreDNSConfigV1Alpha1()
+	cfg.StubDomainsConfig = []k8s.CoreDNSStubDomainConfig{
+		{
+			DomainName:      "corp.example.com",
+			DomainUpstreams: []string{"10.0.0.53", "tls://10.0.1.53"},
+		},
+	}
+	cfg.ExtraServerBlocksConfig = "example.org:53 {\n    errors\n    forward . 192.168.1.53\n}\n"
+	cfg.ReplicasConfig = pointer.To(3)
+	cfg.ResourcesConfig = &k8s.CoreDNSResourcesConfig{
+		ResourceRequests: map[string]string{
+			"cpu": "200m",
+		},
+		ResourceLimits: map[string]string{
+			"memory": "256Mi",
+		},
+	}
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	assert.Equal(t, string(expectedCoreDNSConfigDocument), string(marshaled))
+
+	provider, err := configloader.NewFromBytes(expectedCoreDNSConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, &k8s.CoreDNSConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaAPIVersion: "v1alpha1",
+			MetaKind:       k8s.CoreDNSKind,
+		},
+		StubDomainsConfig:       cfg.StubDomainsConfig,
+		ExtraServerBlocksConfig: cfg.ExtraServerBlocksConfig,
+		ReplicasConfig:          cfg.ReplicasConfig,
+		ResourcesConfig:         cfg.ResourcesConfig,
+	}, docs[0])
+
+	coreDNSConfig := provider.CoreDNSConfig()
+	require.NotNil(t, coreDNSConfig)
+
+	stubDomains := coreDNSConfig.StubDomains()
+	require.Len(t, stubDomains, 1)
+
+	assert.Equal(t, "corp.example.com", stubDomains[0].Domain())
+	assert.Equal(t, []string{"10.0.0.53", "tls://10.0.1.53"}, stubDomains[0].Upstreams())
+	assert.Equal(t, 3, coreDNSConfig.Replicas().ValueOrZero())
+	assert.Equal(t, "200m", coreDNSConfig.Resources().CPURequests())
+	assert.Empty(t, coreDNSConfig.Resources().MemoryRequests())
+	assert.Equal(t, "256Mi", coreDNSConfig.Resources().MemoryLimits())
+}
+
+func TestCoreDNSConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *k8s.CoreDNSConfigV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg:  k8s.NewCoreDNSConfigV1Alpha1,
+		},
+		{
+			name: "valid",
+			cfg: func() *k8s.CoreDNSConfigV1Alpha1 {
+				cfg := k8s.NewCoreDNSConfigV1Alpha1()
+				cfg.StubDomainsConfig = []k8s.CoreDNSStubDomainConfig{
+					{
+						DomainName:      "corp.example.com.",
+						DomainUpstreams: []string{"10.0.0.53", "[fd00::53]:5353", "tls://10.0.1.53"},
+					},
+				}
+				cfg.ExtraServerBlocksConfig = `# custom zones
+example.org:53 dns://example.net {
+    errors
+    cache 300 {
+        success 5000
+    }
+    forward . "192.168.1.53" # upstream
+}
+`
+				cfg.ReplicasConfig = pointer.To(1)
+				cfg.ResourcesConfig = &k8s.CoreDNSResourcesConfig{
+					ResourceRequests: map[string]string{
+						"cpu":    "0.5",
+						"memory": "128Mi",
+					},
+				}
+
+				return cfg
+			},
+		},
+		{
+			name: "invalid stub domains",
+			cfg: func() *k8s.CoreDNSConfigV1Alpha1 {
+				cfg := k8s.NewCoreDNSConfigV1Alpha1()
+				cfg.StubDomainsConfig = []k8s.CoreDNSStubDomainConfig{
+					{
+						DomainUpstreams: []string{"10.0.0.53"},
+					},
+					{
+						DomainName:      "corp.example.com",
+						DomainUpstreams: []string{"dns.example.com"},
+					},
+					{
+						DomainName: "corp.example.com",
+					},
+				}
+				cfg.ReplicasConfig = pointer.To(0)
+
+				return cfg
+			},
+
+			expectedError: "stub domain 0: domain is required\n" +
+				`stub domain 1: invalid upstream "dns.example.com": should be an IP address with an optional port` + "\n" +
+				`stub domain 2: duplicate domain "corp.example.com"` + "\n" +
+				"stub domain 2: at least one upstream is required\n" +
+				"replicas should be at least 1, got 0",
+		},
+		{
+			name: "unbalanced braces",
+			cfg: func() *k8s.CoreDNSConfigV1Alpha1 {
+				cfg := k8s.NewCoreDNSConfigV1Alpha1()
+				cfg.ExtraServerBlocksConfig = "example.org {\n    errors\n"
+
+				return cfg
+			},
+
+			expectedError: "extra server blocks: unexpected end of the Corefile: missing '}'",
+		},
+		{
+			name: "directive outside of the server block",
+			cfg: func() *k8s.CoreDNSConfigV1Alpha1 {
+				cfg := k8s.NewCoreDNSConfigV1Alpha1()
+				cfg.ExtraServerBlocksConfig = "example.org {\n    errors\n}\n}\n"
+
+				return cfg
+			},
+
+			expectedError: "extra server blocks: line 4: unexpected '}'",
+		},
+		{
+			name: "invalid key",
+			cfg: func() *k8s.CoreDNSConfigV1Alpha1 {
+				cfg := k8s.NewCoreDNSConfigV1Alpha1()
+				cfg.ExtraServerBlocksConfig = "example.org:dns {\n    errors\n}\n"
+
+				return cfg
+			},
+
+			expectedError: `extra server blocks: line 1: server block key "example.org:dns": invalid port "dns"`,
+		},
+		{
+			name: "nested blocks",
+			cfg: func() *k8s.CoreDNSConfigV1Alpha1 {
+				cfg := k8s.NewCoreDNSConfigV1Alpha1()
+				cfg.ExtraServerBlocksConfig = "example.org {\n    cache {\n        success {\n        }\n    }\n}\n"
+
+				return cfg
+			},
+
+			expectedError: `extra server blocks: line 3: directive "success": nested blocks are not supported`,
+		},
+		{
+			name: "unterminated string",
+			cfg: func() *k8s.CoreDNSConfigV1Alpha1 {
+				cfg := k8s.NewCoreDNSConfigV1Alpha1()
+				cfg.ExtraServerBlocksConfig = "example.org {\n    forward . \"1.1.1.1\n}\n"
+
+				return cfg
+			},
+
+			expectedError: "extra server blocks: line 2: unterminated quoted string",
+		},
+		{
+			name: "invalid resources",
+			cfg: func() *k8s.CoreDNSConfigV1Alpha1 {
+				cfg := k8s.NewCoreDNSConfigV1Alpha1()
+				cfg.ResourcesConfig = &k8s.CoreDNSResourcesConfig{
+					ResourceLimits: map[string]string{
+						"memory": "lots",
+					},
+				}
+
+				return cfg
+			},
+
+			expectedError: `invalid memory quantity "lots"`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			warnings, err := test.cfg().Validate(validationMode{})
+
+			assert.Empty(t, warnings)
+
+			if test.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.expectedError)
+			}
+		})
+	}
+}
@@ -0,0 +1,220 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// corefileToken is a lexical token of the Corefile.
+type corefileToken struct {
+	text   string
+	line   int
+	quoted bool
+}
+
+// tokenizeCorefile splits the Corefile into tokens, dropping the comments.
+//
+// Newlines are returned as "\n" tokens, as the directives are terminated by the end of line.
+func tokenizeCorefile(corefile string) ([]corefileToken, error) {
+	var (
+		tokens  []corefileToken
+		current strings.Builder
+		line    = 1
+	)
+
+	flush := func() {
+		if current.Len() > 0 {
+			tokens = append(tokens, corefileToken{text: current.String(), line: line})
+			current.Reset()
+		}
+	}
+
+	for i := 0; i < len(corefile); i++ {
+		c := corefile[i]
+
+		switch {
+		case c == '"':
+			flush()
+
+			startLine := line
+
+			end := i + 1
+
+			for ; end < len(corefile) && corefile[end] != '"'; end++ {
+				if corefile[end] == '\\' {
+					end++
+				}
+
+				if end < len(corefile) && corefile[end] == '\n' {
+					line++
+				}
+			}
+
+			if end >= len(corefile) {
+				return nil, fmt.Errorf("line %d: unterminated quoted string", startLine)
+			}
+
+			tokens = append(tokens, corefileToken{text: corefile[i+1 : end], line: startLine, quoted: true})
+			i = end
+		case c == '#' && current.Len() == 0:
+			for i < len(corefile) && corefile[i] != '\n' {
+				i++
+			}
+
+			i--
+		case c == '\n':
+			flush()
+
+			tokens = append(tokens, corefileToken{text: "\n", line: line})
+			line++
+		case c == ' ' || c == '\t' || c == '\r':
+			flush()
+		default:
+			current.WriteByte(c)
+		}
+	}
+
+	flush()
+
+	return tokens, nil
+}
+
+// validateCorefile checks the syntax of the Corefile server blocks.
+//
+// Each server block consists of one or more keys (zones) followed by the block of directives,
+// each directive might have its own block of subdirectives.
+//
+//nolint:gocyclo,cyclop
+func validateCorefile(corefile string) error {
+	tokens, err := tokenizeCorefile(corefile)
+	if err != nil {
+		return err
+	}
+
+	var (
+		depth       int
+		keys        []corefileToken
+		serverCount int
+		lineStart   = true
+		directive   corefileToken
+	)
+
+	for _, token := range tokens {
+		if token.quoted {
+			if depth == 0 {
+				return fmt.Errorf("line %d: unexpected quoted string %q outside of the server block", token.line, token.text)
+			}
+
+			lineStart = false
+
+			continue
+		}
+
+		switch token.text {
+		case "\n":
+			lineStart = true
+		case "{":
+			switch depth {
+			case 0:
+				if len(keys) == 0 {
+					return fmt.Errorf("line %d: server block without keys", token.line)
+				}
+
+				for _, key := range keys {
+					if err = validateCorefileKey(key.text); err != nil {
+						return fmt.Errorf("line %d: %w", key.line, err)
+					}
+				}
+
+				keys = nil
+				serverCount++
+			case 1:
+				if lineStart {
+					return fmt.Errorf("line %d: block without a directive", token.line)
+				}
+			default:
+				return fmt.Errorf("line %d: directive %q: nested blocks are not supported", token.line, directive.text)
+			}
+
+			depth++
+			lineStart = true
+		case "}":
+			if depth == 0 {
+				return fmt.Errorf("line %d: unexpected '}'", token.line)
+			}
+
+			if !lineStart {
+				return fmt.Errorf("line %d: '}' should be on a separate line", token.line)
+			}
+
+			depth--
+		default:
+			if depth == 0 {
+				keys = append(keys, token)
+
+				continue
+			}
+
+			if lineStart {
+				directive = token
+			}
+
+			lineStart = false
+		}
+	}
+
+	switch {
+	case depth > 0:
+		return errors.New("unexpected end of the Corefile: missing '}'")
+	case len(keys) > 0:
+		return fmt.Errorf("line %d: server block keys without the block", keys[0].line)
+	case serverCount == 0:
+		return errors.New("no server blocks found")
+	}
+
+	return nil
+}
+
+func validateCorefileKey(key string) error {
+	key = strings.TrimSuffix(key, ",")
+
+	// snippets are defined as (name)
+	if strings.HasPrefix(key, "(") && strings.HasSuffix(key, ")") && len(key) > 2 {
+		return nil
+	}
+
+	zone := key
+
+	if scheme, rest, ok := strings.Cut(zone, "://"); ok {
+		switch scheme {
+		case "dns", "tls", "grpc", "https", "quic":
+		default:
+			return fmt.Errorf("server block key %q: unsupported scheme %q", key, scheme)
+		}
+
+		zone = rest
+	}
+
+	if host, port, ok := strings.Cut(zone, ":"); ok {
+		if port == "" || strings.Trim(port, "0123456789") != "" {
+			return fmt.Errorf("server block key %q: invalid port %q", key, port)
+		}
+
+		zone = host
+	}
+
+	if zone == "" {
+		return fmt.Errorf("server block key %q: empty zone", key)
+	}
+
+	if strings.ContainsAny(zone, "(){}\"") {
+		return fmt.Errorf("server block key %q: invalid zone", key)
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Code generated by "deep-copy -type BootstrapManifestsConfigV1Alpha1 -type CoreDNSConfigV1Alpha1 -type KubeAPIServerAuditConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+
+package k8s
+
+// DeepCopy generates a deep copy of *BootstrapManifestsConfigV1Alpha1.
+func (o *BootstrapManifestsConfigV1Alpha1) DeepCopy() *BootstrapManifestsConfigV1Alpha1 {
+	var cp BootstrapManifestsConfigV1Alpha1 = *o
+	if o.ObjectPoliciesConfig != nil {
+		cp.ObjectPoliciesConfig = make([]BootstrapManifestObjectPolicyConfig, len(o.ObjectPoliciesConfig))
+		copy(cp.ObjectPoliciesConfig, o.ObjectPoliciesConfig)
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *CoreDNSConfigV1Alpha1.
+func (o *CoreDNSConfigV1Alpha1) DeepCopy() *CoreDNSConfigV1Alpha1 {
+	var cp CoreDNSConfigV1Alpha1 = *o
+	if o.StubDomainsConfig != nil {
+		cp.StubDomainsConfig = make([]CoreDNSStubDomainConfig, len(o.StubDomainsConfig))
+		copy(cp.StubDomainsConfig, o.StubDomainsConfig)
+		for i2 := range o.StubDomainsConfig {
+			if o.StubDomainsConfig[i2].DomainUpstreams != nil {
+				cp.StubDomainsConfig[i2].DomainUpstreams = make([]string, len(o.StubDomainsConfig[i2].DomainUpstreams))
+				copy(cp.StubDomainsConfig[i2].DomainUpstreams, o.StubDomainsConfig[i2].DomainUpstreams)
+			}
+		}
+	}
+	if o.ReplicasConfig != nil {
+		cp.ReplicasConfig = new(int)
+		*cp.ReplicasConfig = *o.ReplicasConfig
+	}
+	if o.ResourcesConfig != nil {
+		cp.ResourcesConfig = new(CoreDNSResourcesConfig)
+		*cp.ResourcesConfig = *o.ResourcesConfig
+		if o.ResourcesConfig.ResourceRequests != nil {
+			cp.ResourcesConfig.ResourceRequests = make(map[string]string, len(o.ResourcesConfig.ResourceRequests))
+			for k3, v3 := range o.ResourcesConfig.ResourceRequests {
+				cp.ResourcesConfig.ResourceRequests[k3] = v3
+			}
+		}
+		if o.ResourcesConfig.ResourceLimits != nil {
+			cp.ResourcesConfig.ResourceLimits = make(map[string]string, len(o.ResourcesConfig.ResourceLimits))
+			for k3, v3 := range o.ResourcesConfig.ResourceLimits {
+				cp.ResourcesConfig.ResourceLimits[k3] = v3
+			}
+		}
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *KubeAPIServerAuditConfigV1Alpha1.
+func (o *KubeAPIServerAuditConfigV1Alpha1) DeepCopy() *KubeAPIServerAuditConfigV1Alpha1 {
+	var cp KubeAPIServerAuditConfigV1Alpha1 = *o
+	if o.LogConfig != nil {
+		cp.LogConfig = new(KubeAPIServerAuditLogConfig)
+		*cp.LogConfig = *o.LogConfig
+	}
+	if o.WebhookConfig != nil {
+		cp.WebhookConfig = new(KubeAPIServerAuditWebhookConfig)
+		*cp.WebhookConfig = *o.WebhookConfig
+	}
+	return &cp
+}
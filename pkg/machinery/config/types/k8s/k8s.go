@@ -0,0 +1,10 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package k8s provides Kubernetes control plane config documents.
+package k8s
+
+//go:generate go tool github.com/siderolabs/talos/tools/docgen -output k8s_doc.go k8s.go bootstrap_manifests.go coredns.go kube_apiserver_audit.go
+
+//go:generate go tool github.com/siderolabs/deep-copy -type BootstrapManifestsConfigV1Alpha1 -type CoreDNSConfigV1Alpha1 -type KubeAPIServerAuditConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go .
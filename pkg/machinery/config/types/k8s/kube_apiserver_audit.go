@@ -0,0 +1,332 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+//docgen:jsonschema
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// KubeAPIServerAuditKind is a KubeAPIServerAudit config document kind.
+const KubeAPIServerAuditKind = "KubeAPIServerAuditConfig"
+
+// KubeAPIServerAuditLogStdout is the audit log path which sends the audit events to the kube-apiserver standard output.
+const KubeAPIServerAuditLogStdout = "-"
+
+func init() {
+	registry.Register(KubeAPIServerAuditKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1": //nolint:goconst
+			return &KubeAPIServerAuditConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.KubeAPIServerAuditConfig  = &KubeAPIServerAuditConfigV1Alpha1{}
+	_ config.KubeAPIServerAuditLog     = &KubeAPIServerAuditLogConfig{}
+	_ config.KubeAPIServerAuditWebhook = &KubeAPIServerAuditWebhookConfig{}
+	_ config.SecretDocument            = &KubeAPIServerAuditConfigV1Alpha1{}
+	_ config.Validator                 = &KubeAPIServerAuditConfigV1Alpha1{}
+)
+
+// KubeAPIServerAuditConfigV1Alpha1 is a config document to configure kube-apiserver audit policy and audit backends.
+//
+//	examples:
+//	  - value: exampleKubeAPIServerAuditConfigV1Alpha1()
+//	alias: KubeAPIServerAuditConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/KubeAPIServerAuditConfig
+type KubeAPIServerAuditConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+
+	//   description: |
+	//     Audit policy in the `audit.k8s.io/v1` Policy format, as an inline YAML document.
+	//
+	//     The policy takes precedence over the `.cluster.apiServer.auditPolicy` field.
+	//     If not set, the default Talos audit policy is used.
+	//     The kube-apiserver pod is restarted when the audit configuration changes.
+	//   examples:
+	//     - value: >
+	//         exampleKubeAPIServerAuditPolicy
+	PolicyConfig string `yaml:"policy,omitempty"`
+	//   description: |
+	//     Log audit backend configuration.
+	//
+	//     If neither log nor webhook backend is configured, the log backend with default settings is used.
+	LogConfig *KubeAPIServerAuditLogConfig `yaml:"log,omitempty"`
+	//   description: |
+	//     Webhook audit backend configuration.
+	WebhookConfig *KubeAPIServerAuditWebhookConfig `yaml:"webhook,omitempty"`
+}
+
+// KubeAPIServerAuditLogConfig is a log audit backend configuration.
+type KubeAPIServerAuditLogConfig struct {
+	//   description: |
+	//     Path to the audit log file.
+	//
+	//     The path should be under `/var/log`, `-` sends the audit events to the kube-apiserver standard output.
+	//     Defaults to `/var/log/audit/kube/kube-apiserver.log`.
+	//   examples:
+	//     - value: >
+	//         "/var/log/audit/kube/kube-apiserver.log"
+	LogPath string `yaml:"path,omitempty"`
+	//   description: |
+	//     Maximum number of days to retain the rotated audit log files (defaults to 30).
+	LogMaxAge int `yaml:"maxAge,omitempty"`
+	//   description: |
+	//     Maximum number of the rotated audit log files to retain (defaults to 10).
+	LogMaxBackup int `yaml:"maxBackup,omitempty"`
+	//   description: |
+	//     Maximum size in megabytes of the audit log file before it gets rotated (defaults to 100).
+	LogMaxSize int `yaml:"maxSize,omitempty"`
+}
+
+// KubeAPIServerAuditWebhookConfig is a webhook audit backend configuration.
+type KubeAPIServerAuditWebhookConfig struct {
+	//   description: |
+	//     URL of the audit webhook endpoint.
+	//   schemaRequired: true
+	//   examples:
+	//     - value: >
+	//         "https://audit.example.com/events"
+	WebhookURL string `yaml:"url"`
+	//   description: |
+	//     PEM-encoded CA certificate to verify the webhook endpoint certificate.
+	WebhookCACertificate string `yaml:"caCertificate,omitempty"`
+	//   description: |
+	//     Bearer token to authenticate to the webhook endpoint.
+	//
+	//     Talos renders the webhook kubeconfig with the URL, CA certificate and the token.
+	WebhookToken string `yaml:"token,omitempty"`
+}
+
+var exampleKubeAPIServerAuditPolicy = `apiVersion: audit.k8s.io/v1
+kind: Policy
+rules:
+  - level: None
+    resources:
+      - group: ""
+        resources: ["events"]
+  - level: Metadata
+`
+
+// NewKubeAPIServerAuditConfigV1Alpha1 creates a new KubeAPIServerAuditConfig config document.
+func NewKubeAPIServerAuditConfigV1Alpha1() *KubeAPIServerAuditConfigV1Alpha1 {
+	return &KubeAPIServerAuditConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       KubeAPIServerAuditKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleKubeAPIServerAuditConfigV1Alpha1() *KubeAPIServerAuditConfigV1Alpha1 {
+	cfg := NewKubeAPIServerAuditConfigV1Alpha1()
+	cfg.PolicyConfig = exampleKubeAPIServerAuditPolicy
+	cfg.LogConfig = &KubeAPIServerAuditLogConfig{
+		LogMaxAge:    7,
+		LogMaxBackup: 5,
+	}
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *KubeAPIServerAuditConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Redact implements config.SecretDocument interface.
+func (s *KubeAPIServerAuditConfigV1Alpha1) Redact(replacement string) {
+	if s.WebhookConfig != nil && s.WebhookConfig.WebhookToken != "" {
+		s.WebhookConfig.WebhookToken = replacement
+	}
+}
+
+// AuditPolicy implements config.KubeAPIServerAuditConfig interface.
+func (s *KubeAPIServerAuditConfigV1Alpha1) AuditPolicy() map[string]any {
+	if s.PolicyConfig == "" {
+		return nil
+	}
+
+	var policy map[string]any
+
+	// the policy is validated, so the error is not expected here
+	if err := yaml.Unmarshal([]byte(s.PolicyConfig), &policy); err != nil {
+		return nil
+	}
+
+	return policy
+}
+
+// AuditLog implements config.KubeAPIServerAuditConfig interface.
+func (s *KubeAPIServerAuditConfigV1Alpha1) AuditLog() config.KubeAPIServerAuditLog {
+	switch {
+	case s.LogConfig != nil:
+		return s.LogConfig
+	case s.WebhookConfig == nil:
+		// log backend with the defaults
+		return &KubeAPIServerAuditLogConfig{}
+	default:
+		return nil
+	}
+}
+
+// AuditWebhook implements config.KubeAPIServerAuditConfig interface.
+func (s *KubeAPIServerAuditConfigV1Alpha1) AuditWebhook() config.KubeAPIServerAuditWebhook {
+	if s.WebhookConfig == nil {
+		return nil
+	}
+
+	return s.WebhookConfig
+}
+
+// Validate implements config.Validator interface.
+func (s *KubeAPIServerAuditConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var errs error
+
+	if s.PolicyConfig != "" {
+		errs = errors.Join(errs, validateAuditPolicy(s.PolicyConfig))
+	}
+
+	if s.LogConfig != nil {
+		errs = errors.Join(errs, s.LogConfig.validate())
+	}
+
+	if s.WebhookConfig != nil {
+		errs = errors.Join(errs, s.WebhookConfig.validate())
+	}
+
+	return nil, errs
+}
+
+// Path implements config.KubeAPIServerAuditLog interface.
+func (s *KubeAPIServerAuditLogConfig) Path() string {
+	if s.LogPath == "" {
+		return filepath.Join(constants.KubernetesAuditLogDir, "kube-apiserver.log")
+	}
+
+	return s.LogPath
+}
+
+// MaxAge implements config.KubeAPIServerAuditLog interface.
+func (s *KubeAPIServerAuditLogConfig) MaxAge() int {
+	if s.LogMaxAge == 0 {
+		return constants.KubernetesAuditLogMaxAge
+	}
+
+	return s.LogMaxAge
+}
+
+// MaxBackup implements config.KubeAPIServerAuditLog interface.
+func (s *KubeAPIServerAuditLogConfig) MaxBackup() int {
+	if s.LogMaxBackup == 0 {
+		return constants.KubernetesAuditLogMaxBackup
+	}
+
+	return s.LogMaxBackup
+}
+
+// MaxSize implements config.KubeAPIServerAuditLog interface.
+func (s *KubeAPIServerAuditLogConfig) MaxSize() int {
+	if s.LogMaxSize == 0 {
+		return constants.KubernetesAuditLogMaxSize
+	}
+
+	return s.LogMaxSize
+}
+
+func (s *KubeAPIServerAuditLogConfig) validate() error {
+	var errs error
+
+	if s.LogPath != "" && s.LogPath != KubeAPIServerAuditLogStdout {
+		switch {
+		case !filepath.IsAbs(s.LogPath) || filepath.Clean(s.LogPath) != s.LogPath:
+			errs = errors.Join(errs, fmt.Errorf("log path %q should be an absolute clean path", s.LogPath))
+		case !strings.HasPrefix(s.LogPath, "/var/log/"):
+			errs = errors.Join(errs, fmt.Errorf("log path %q should be under /var/log", s.LogPath))
+		}
+	}
+
+	for _, field := range []struct {
+		name  string
+		value int
+	}{
+		{"maxAge", s.LogMaxAge},
+		{"maxBackup", s.LogMaxBackup},
+		{"maxSize", s.LogMaxSize},
+	} {
+		if field.value < 0 {
+			errs = errors.Join(errs, fmt.Errorf("log %s should not be negative", field.name))
+		}
+	}
+
+	return errs
+}
+
+// URL implements config.KubeAPIServerAuditWebhook interface.
+func (s *KubeAPIServerAuditWebhookConfig) URL() string {
+	return s.WebhookURL
+}
+
+// CACertificate implements config.KubeAPIServerAuditWebhook interface.
+func (s *KubeAPIServerAuditWebhookConfig) CACertificate() string {
+	return s.WebhookCACertificate
+}
+
+// Token implements config.KubeAPIServerAuditWebhook interface.
+func (s *KubeAPIServerAuditWebhookConfig) Token() string {
+	return s.WebhookToken
+}
+
+func (s *KubeAPIServerAuditWebhookConfig) validate() error {
+	var errs error
+
+	if s.WebhookURL == "" {
+		errs = errors.Join(errs, errors.New("webhook url is required"))
+	} else {
+		u, err := url.Parse(s.WebhookURL)
+
+		switch {
+		case err != nil:
+			errs = errors.Join(errs, fmt.Errorf("invalid webhook url: %w", err))
+		case u.Scheme != "https" && u.Scheme != "http":
+			errs = errors.Join(errs, fmt.Errorf("webhook url %q should use http or https scheme", s.WebhookURL))
+		case u.Host == "":
+			errs = errors.Join(errs, fmt.Errorf("webhook url %q should have a host", s.WebhookURL))
+		}
+	}
+
+	if s.WebhookCACertificate != "" {
+		block, _ := pem.Decode([]byte(s.WebhookCACertificate))
+
+		if block == nil {
+			errs = errors.Join(errs, errors.New("webhook CA certificate should be PEM-encoded"))
+		} else if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("invalid webhook CA certificate: %w", err))
+		}
+	}
+
+	return errs
+}
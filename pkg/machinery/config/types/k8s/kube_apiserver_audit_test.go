@@ -0,0 +1,264 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+)
+
+//go:embed testdata/kubeapiserverauditconfig.yaml
+var expectedKubeAPIServerAuditConfigDocument []byte
+
+const testAuditPolicy = `apiVersion: audit.k8s.io/v1
+kind: Policy
+rules:
+  - level: Metadata
+`
+
+func TestKubeAPIServerAuditConfigMarshalUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	cfg := k8s.NewKubeAPIServerAuditConfigV1Alpha1()
+	cfg.PolicyConfig = testAuditPolicy
+	cfg.LogConfig = &k8s.KubeAPIServerAuditLogConfig{
+		LogMaxAge: 7,
+	}
+	cfg.WebhookConfig = &k8s.KubeAPIServerAuditWebhookConfig{
+		WebhookURL:   "https://audit.example.com/events",
+		WebhookToken: "secret",
+	}
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	assert.Equal(t, string(expectedKubeAPIServerAuditConfigDocument), string(marshaled))
+
+	provider, err := configloader.NewFromBytes(expectedKubeAPIServerAuditConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, &k8s.KubeAPIServerAuditConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaAPIVersion: "v1alpha1",
+			MetaKind:       k8s.KubeAPIServerAuditKind,
+		},
+		PolicyConfig:  testAuditPolicy,
+		LogConfig:     cfg.LogConfig,
+		WebhookConfig: cfg.WebhookConfig,
+	}, docs[0])
+
+	auditConfig := provider.KubeAPIServerAuditConfig()
+	require.NotNil(t, auditConfig)
+
+	assert.Equal(t, map[string]any{
+		"apiVersion": "audit.k8s.io/v1",
+		"kind":       "Policy",
+		"rules": []any{
+			map[string]any{"level": "Metadata"},
+		},
+	}, auditConfig.AuditPolicy())
+
+	require.NotNil(t, auditConfig.AuditLog())
+	assert.Equal(t, "/var/log/audit/kube/kube-apiserver.log", auditConfig.AuditLog().Path())
+	assert.Equal(t, 7, auditConfig.AuditLog().MaxAge())
+	assert.Equal(t, 10, auditConfig.AuditLog().MaxBackup())
+	assert.Equal(t, 100, auditConfig.AuditLog().MaxSize())
+
+	require.NotNil(t, auditConfig.AuditWebhook())
+	assert.Equal(t, "https://audit.example.com/events", auditConfig.AuditWebhook().URL())
+	assert.Equal(t, "secret", auditConfig.AuditWebhook().Token())
+}
+
+func TestKubeAPIServerAuditConfigBackends(t *testing.T) {
+	t.Parallel()
+
+	cfg := k8s.NewKubeAPIServerAuditConfigV1Alpha1()
+
+	// log backend is enabled by default
+	require.NotNil(t, cfg.AuditLog())
+	assert.Nil(t, cfg.AuditWebhook())
+	assert.Nil(t, cfg.AuditPolicy())
+
+	// webhook backend replaces the default log backend
+	cfg.WebhookConfig = &k8s.KubeAPIServerAuditWebhookConfig{
+		WebhookURL: "https://audit.example.com/events",
+	}
+
+	assert.Nil(t, cfg.AuditLog())
+	require.NotNil(t, cfg.AuditWebhook())
+
+	cfg.Redact("REDACTED")
+	assert.Empty(t, cfg.WebhookConfig.WebhookToken)
+
+	cfg.WebhookConfig.WebhookToken = "secret"
+	cfg.Redact("REDACTED")
+	assert.Equal(t, "REDACTED", cfg.WebhookConfig.WebhookToken)
+}
+
+func TestKubeAPIServerAuditConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *k8s.KubeAPIServerAuditConfigV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg:  k8s.NewKubeAPIServerAuditConfigV1Alpha1,
+		},
+		{
+			name: "valid",
+			cfg: func() *k8s.KubeAPIServerAuditConfigV1Alpha1 {
+				cfg := k8s.NewKubeAPIServerAuditConfigV1Alpha1()
+				cfg.PolicyConfig = `apiVersion: audit.k8s.io/v1
+kind: Policy
+omitStages:
+  - RequestReceived
+rules:
+  - level: None
+    resources:
+      - group: ""
+        resources: ["events"]
+  - level: Metadata
+    nonResourceURLs: ["/healthz*", "/version"]
+  - level: RequestResponse
+    users: ["admin"]
+`
+				cfg.LogConfig = &k8s.KubeAPIServerAuditLogConfig{
+					LogPath:   "/var/log/audit/custom/audit.log",
+					LogMaxAge: 1,
+				}
+				cfg.WebhookConfig = &k8s.KubeAPIServerAuditWebhookConfig{
+					WebhookURL: "https://audit.example.com/events",
+				}
+
+				return cfg
+			},
+		},
+		{
+			name: "stdout",
+			cfg: func() *k8s.KubeAPIServerAuditConfigV1Alpha1 {
+				cfg := k8s.NewKubeAPIServerAuditConfigV1Alpha1()
+				cfg.LogConfig = &k8s.KubeAPIServerAuditLogConfig{
+					LogPath: "-",
+				}
+
+				return cfg
+			},
+		},
+		{
+			name: "invalid policy",
+			cfg: func() *k8s.KubeAPIServerAuditConfigV1Alpha1 {
+				cfg := k8s.NewKubeAPIServerAuditConfigV1Alpha1()
+				cfg.PolicyConfig = `apiVersion: audit.k8s.io/v1beta1
+kind: Policy
+rules:
+  - level: Metadata
+  - level: Everything
+    omitStages: ["Done"]
+  - resources:
+      - group: apps/v1
+        resources: ["deployments"]
+    nonResourceURLs: ["healthz", "/api/*/foo"]
+  - level: Request
+    resources:
+      - resources: ["secrets/*"]
+        resourceNames: ["foo"]
+`
+
+				return cfg
+			},
+
+			expectedError: `policy: unsupported apiVersion "audit.k8s.io/v1beta1", expected "audit.k8s.io/v1"` + "\n" +
+				`policy: rules[1]: unsupported level "Everything", supported levels: None, Metadata, Request, RequestResponse` + "\n" +
+				`policy: rules[1]: omitStages: unsupported stage "Done"` + "\n" +
+				`policy: rules[2]: level is required` + "\n" +
+				`policy: rules[2]: rule can't apply to both resources and non-resource URLs` + "\n" +
+				`policy: rules[2]: resources[0]: group "apps/v1" should not contain '/'` + "\n" +
+				`policy: rules[2]: non-resource URL "healthz" should start with '/'` + "\n" +
+				`policy: rules[2]: non-resource URL "/api/*/foo" may only use the wildcard '*' at the end` + "\n" +
+				`policy: rules[3]: resources[0]: resourceNames can't be used with the wildcard resource "secrets/*"`,
+		},
+		{
+			name: "unknown rule field",
+			cfg: func() *k8s.KubeAPIServerAuditConfigV1Alpha1 {
+				cfg := k8s.NewKubeAPIServerAuditConfigV1Alpha1()
+				cfg.PolicyConfig = `apiVersion: audit.k8s.io/v1
+kind: Policy
+rules:
+  - level: Metadata
+  - level: Metadata
+    user: ["admin"]
+`
+
+				return cfg
+			},
+
+			expectedError: "policy: rules[1]: yaml: unmarshal errors:\n  line 2: field user not found in type k8s.auditPolicyRule",
+		},
+		{
+			name: "invalid backends",
+			cfg: func() *k8s.KubeAPIServerAuditConfigV1Alpha1 {
+				cfg := k8s.NewKubeAPIServerAuditConfigV1Alpha1()
+				cfg.LogConfig = &k8s.KubeAPIServerAuditLogConfig{
+					LogPath:    "/etc/audit.log",
+					LogMaxSize: -1,
+				}
+				cfg.WebhookConfig = &k8s.KubeAPIServerAuditWebhookConfig{
+					WebhookURL:           "ftp://audit.example.com",
+					WebhookCACertificate: "foo",
+				}
+
+				return cfg
+			},
+
+			expectedError: `log path "/etc/audit.log" should be under /var/log` + "\n" +
+				`log maxSize should not be negative` + "\n" +
+				`webhook url "ftp://audit.example.com" should use http or https scheme` + "\n" +
+				`webhook CA certificate should be PEM-encoded`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			warnings, err := test.cfg().Validate(validationMode{})
+
+			assert.Empty(t, warnings)
+
+			if test.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.expectedError)
+			}
+		})
+	}
+}
+
+type validationMode struct{}
+
+func (validationMode) String() string {
+	return ""
+}
+
+func (validationMode) RequiresInstall() bool {
+	return false
+}
+
+func (validationMode) InContainer() bool {
+	return false
+}
@@ -0,0 +1,123 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// AddressSetKind is a network address set config document kind.
+const AddressSetKind = "NetworkAddressSet"
+
+func init() {
+	registry.Register(AddressSetKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1": //nolint:goconst
+			return &AddressSetV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.NetworkAddressSetConfig = &AddressSetV1Alpha1{}
+	_ config.NamedDocument           = &AddressSetV1Alpha1{}
+	_ config.Validator               = &AddressSetV1Alpha1{}
+)
+
+// AddressSetV1Alpha1 is a named set of addresses which can be referenced by the network rules.
+//
+//	examples:
+//	  - value: exampleAddressSetV1Alpha1()
+//	alias: NetworkAddressSet
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/NetworkAddressSet
+type AddressSetV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+
+	//   description: |
+	//     Name of the address set, used to reference it from the network rules.
+	//   schemaRequired: true
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     List of subnets in the address set.
+	//   examples:
+	//    - value: >
+	//       []netip.Prefix{netip.MustParsePrefix("10.3.4.0/24"), netip.MustParsePrefix("2001:db8::/32")}
+	//   schema:
+	//     type: array
+	//     items:
+	//       type: string
+	//       pattern: ^[0-9a-f.:]+/\d{1,3}$
+	SetSubnets []netip.Prefix `yaml:"subnets" merge:"replace"`
+}
+
+// NewAddressSetV1Alpha1 creates a new NetworkAddressSet config document.
+func NewAddressSetV1Alpha1(name string) *AddressSetV1Alpha1 {
+	return &AddressSetV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       AddressSetKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+		MetaName: name,
+	}
+}
+
+func exampleAddressSetV1Alpha1() *AddressSetV1Alpha1 {
+	cfg := NewAddressSetV1Alpha1("office")
+	cfg.SetSubnets = []netip.Prefix{
+		netip.MustParsePrefix("192.168.10.0/24"),
+		netip.MustParsePrefix("2001:db8:10::/48"),
+	}
+
+	return cfg
+}
+
+// Name implements config.NamedDocument interface.
+func (s *AddressSetV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// Clone implements config.Document interface.
+func (s *AddressSetV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Validate implements config.Validator interface.
+func (s *AddressSetV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var errs error
+
+	if s.MetaName == "" {
+		errs = errors.Join(errs, errors.New("name is required"))
+	}
+
+	if len(s.SetSubnets) == 0 {
+		errs = errors.Join(errs, errors.New("at least one subnet is required"))
+	}
+
+	for _, subnet := range s.SetSubnets {
+		if !subnet.IsValid() {
+			errs = errors.Join(errs, fmt.Errorf("invalid subnet: %s", subnet))
+		}
+	}
+
+	return nil, errs
+}
+
+// Subnets implements config.NetworkAddressSetConfig interface.
+func (s *AddressSetV1Alpha1) Subnets() []netip.Prefix {
+	return s.SetSubnets
+}
@@ -0,0 +1,106 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+)
+
+//go:embed testdata/addressset.yaml
+var expectedAddressSetDocument []byte
+
+func TestAddressSetMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewAddressSetV1Alpha1("office")
+	cfg.SetSubnets = []netip.Prefix{
+		netip.MustParsePrefix("192.168.10.0/24"),
+		netip.MustParsePrefix("2001:db8:10::/48"),
+	}
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedAddressSetDocument, marshaled)
+}
+
+func TestAddressSetUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedAddressSetDocument)
+	require.NoError(t, err)
+
+	sets := provider.NetworkAddressSets()
+	require.Len(t, sets, 1)
+
+	assert.Equal(t, "office", sets[0].Name())
+	assert.Equal(t, []netip.Prefix{
+		netip.MustParsePrefix("192.168.10.0/24"),
+		netip.MustParsePrefix("2001:db8:10::/48"),
+	}, sets[0].Subnets())
+}
+
+func TestAddressSetValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.AddressSetV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg: func() *network.AddressSetV1Alpha1 {
+				return network.NewAddressSetV1Alpha1("")
+			},
+
+			expectedError: "name is required\nat least one subnet is required",
+		},
+		{
+			name: "invalid subnet",
+			cfg: func() *network.AddressSetV1Alpha1 {
+				cfg := network.NewAddressSetV1Alpha1("office")
+				cfg.SetSubnets = []netip.Prefix{{}}
+
+				return cfg
+			},
+
+			expectedError: "invalid subnet: invalid Prefix",
+		},
+		{
+			name: "valid",
+			cfg: func() *network.AddressSetV1Alpha1 {
+				cfg := network.NewAddressSetV1Alpha1("office")
+				cfg.SetSubnets = []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type DefaultActionConfigV1Alpha1 -type KubespanEndpointsConfigV1Alpha1 -type EthernetConfigV1Alpha1 -type HostnameConfigV1Alpha1 -type RuleConfigV1Alpha1 -type StaticHostConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type AddressSetV1Alpha1 -type DefaultActionConfigV1Alpha1 -type DefaultRouteConfigV1Alpha1 -type KubespanEndpointsConfigV1Alpha1 -type EthernetConfigV1Alpha1 -type HostnameConfigV1Alpha1 -type LLDPConfigV1Alpha1 -type ProbeConfigV1Alpha1 -type RouteSourceConfigV1Alpha1 -type RuleConfigV1Alpha1 -type SRIOVConfigV1Alpha1 -type StaticHostConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package network
 
@@ -12,9 +12,59 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 )
 
+// DeepCopy generates a deep copy of *AddressSetV1Alpha1.
+func (o *AddressSetV1Alpha1) DeepCopy() *AddressSetV1Alpha1 {
+	var cp AddressSetV1Alpha1 = *o
+	if o.SetSubnets != nil {
+		cp.SetSubnets = make([]netip.Prefix, len(o.SetSubnets))
+		copy(cp.SetSubnets, o.SetSubnets)
+	}
+	return &cp
+}
+
 // DeepCopy generates a deep copy of *DefaultActionConfigV1Alpha1.
 func (o *DefaultActionConfigV1Alpha1) DeepCopy() *DefaultActionConfigV1Alpha1 {
 	var cp DefaultActionConfigV1Alpha1 = *o
+	if o.EgressFailSafeConfig != nil {
+		cp.EgressFailSafeConfig = make([]RulePortSelector, len(o.EgressFailSafeConfig))
+		copy(cp.EgressFailSafeConfig, o.EgressFailSafeConfig)
+		for i2 := range o.EgressFailSafeConfig {
+			if o.EgressFailSafeConfig[i2].Ports != nil {
+				cp.EgressFailSafeConfig[i2].Ports = make([]PortRange, len(o.EgressFailSafeConfig[i2].Ports))
+				copy(cp.EgressFailSafeConfig[i2].Ports, o.EgressFailSafeConfig[i2].Ports)
+			}
+		}
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *DefaultRouteConfigV1Alpha1.
+func (o *DefaultRouteConfigV1Alpha1) DeepCopy() *DefaultRouteConfigV1Alpha1 {
+	var cp DefaultRouteConfigV1Alpha1 = *o
+	if o.LinksConfig != nil {
+		cp.LinksConfig = make([]string, len(o.LinksConfig))
+		copy(cp.LinksConfig, o.LinksConfig)
+	}
+	if o.IPv4Config != nil {
+		cp.IPv4Config = new(DefaultRouteFamilyConfig)
+		*cp.IPv4Config = *o.IPv4Config
+		if o.IPv4Config.FamilyLinksConfig != nil {
+			cp.IPv4Config.FamilyLinksConfig = make([]string, len(o.IPv4Config.FamilyLinksConfig))
+			copy(cp.IPv4Config.FamilyLinksConfig, o.IPv4Config.FamilyLinksConfig)
+		}
+	}
+	if o.IPv6Config != nil {
+		cp.IPv6Config = new(DefaultRouteFamilyConfig)
+		*cp.IPv6Config = *o.IPv6Config
+		if o.IPv6Config.FamilyLinksConfig != nil {
+			cp.IPv6Config.FamilyLinksConfig = make([]string, len(o.IPv6Config.FamilyLinksConfig))
+			copy(cp.IPv6Config.FamilyLinksConfig, o.IPv6Config.FamilyLinksConfig)
+		}
+	}
+	if o.FailoverConfig != nil {
+		cp.FailoverConfig = new(bool)
+		*cp.FailoverConfig = *o.FailoverConfig
+	}
 	return &cp
 }
 
@@ -25,6 +75,10 @@ func (o *KubespanEndpointsConfigV1Alpha1) DeepCopy() *KubespanEndpointsConfigV1A
 		cp.ExtraAnnouncedEndpointsConfig = make([]netip.AddrPort, len(o.ExtraAnnouncedEndpointsConfig))
 		copy(cp.ExtraAnnouncedEndpointsConfig, o.ExtraAnnouncedEndpointsConfig)
 	}
+	if o.ExtraAnnouncedHostnameEndpointsConfig != nil {
+		cp.ExtraAnnouncedHostnameEndpointsConfig = make([]string, len(o.ExtraAnnouncedHostnameEndpointsConfig))
+		copy(cp.ExtraAnnouncedHostnameEndpointsConfig, o.ExtraAnnouncedHostnameEndpointsConfig)
+	}
 	return &cp
 }
 
@@ -114,6 +168,40 @@ func (o *HostnameConfigV1Alpha1) DeepCopy() *HostnameConfigV1Alpha1 {
 	return &cp
 }
 
+// DeepCopy generates a deep copy of *LLDPConfigV1Alpha1.
+func (o *LLDPConfigV1Alpha1) DeepCopy() *LLDPConfigV1Alpha1 {
+	var cp LLDPConfigV1Alpha1 = *o
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *ProbeConfigV1Alpha1.
+func (o *ProbeConfigV1Alpha1) DeepCopy() *ProbeConfigV1Alpha1 {
+	var cp ProbeConfigV1Alpha1 = *o
+	if o.ProbeTCP != nil {
+		cp.ProbeTCP = new(ProbeTCPConfig)
+		*cp.ProbeTCP = *o.ProbeTCP
+	}
+	if o.ProbeHTTP != nil {
+		cp.ProbeHTTP = new(ProbeHTTPConfig)
+		*cp.ProbeHTTP = *o.ProbeHTTP
+	}
+	if o.ProbeICMP != nil {
+		cp.ProbeICMP = new(ProbeICMPConfig)
+		*cp.ProbeICMP = *o.ProbeICMP
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *RouteSourceConfigV1Alpha1.
+func (o *RouteSourceConfigV1Alpha1) DeepCopy() *RouteSourceConfigV1Alpha1 {
+	var cp RouteSourceConfigV1Alpha1 = *o
+	if o.SourcesConfig != nil {
+		cp.SourcesConfig = make([]RouteSourceEntry, len(o.SourcesConfig))
+		copy(cp.SourcesConfig, o.SourcesConfig)
+	}
+	return &cp
+}
+
 // DeepCopy generates a deep copy of *RuleConfigV1Alpha1.
 func (o *RuleConfigV1Alpha1) DeepCopy() *RuleConfigV1Alpha1 {
 	var cp RuleConfigV1Alpha1 = *o
@@ -125,6 +213,30 @@ func (o *RuleConfigV1Alpha1) DeepCopy() *RuleConfigV1Alpha1 {
 		cp.Ingress = make([]IngressRule, len(o.Ingress))
 		copy(cp.Ingress, o.Ingress)
 	}
+	if o.Egress != nil {
+		cp.Egress = make([]EgressRule, len(o.Egress))
+		copy(cp.Egress, o.Egress)
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *SRIOVConfigV1Alpha1.
+func (o *SRIOVConfigV1Alpha1) DeepCopy() *SRIOVConfigV1Alpha1 {
+	var cp SRIOVConfigV1Alpha1 = *o
+	if o.VFsConfig != nil {
+		cp.VFsConfig = make([]SRIOVVFConfig, len(o.VFsConfig))
+		copy(cp.VFsConfig, o.VFsConfig)
+		for i2 := range o.VFsConfig {
+			if o.VFsConfig[i2].VFTrust != nil {
+				cp.VFsConfig[i2].VFTrust = new(bool)
+				*cp.VFsConfig[i2].VFTrust = *o.VFsConfig[i2].VFTrust
+			}
+			if o.VFsConfig[i2].VFSpoofCheck != nil {
+				cp.VFsConfig[i2].VFSpoofCheck = new(bool)
+				*cp.VFsConfig[i2].VFSpoofCheck = *o.VFsConfig[i2].VFSpoofCheck
+			}
+		}
+	}
 	return &cp
 }
 
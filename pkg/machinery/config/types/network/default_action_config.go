@@ -7,9 +7,14 @@ package network
 //docgen:jsonschema
 
 import (
+	"errors"
+
+	"github.com/siderolabs/gen/xslices"
+
 	"github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
 	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 )
 
@@ -31,9 +36,10 @@ func init() {
 var (
 	_ config.NetworkRuleConfigDefaultAction = &DefaultActionConfigV1Alpha1{}
 	_ config.NetworkRuleConfigSignal        = &DefaultActionConfigV1Alpha1{}
+	_ config.Validator                      = &DefaultActionConfigV1Alpha1{}
 )
 
-// DefaultActionConfigV1Alpha1 is a ingress firewall default action configuration document.
+// DefaultActionConfigV1Alpha1 is a firewall default action configuration document.
 //
 //	examples:
 //	  - value: exampleDefaultActionConfigV1Alpha1()
@@ -49,6 +55,21 @@ type DefaultActionConfigV1Alpha1 struct {
 	//     - "accept"
 	//     - "block"
 	Ingress nethelpers.DefaultAction `yaml:"ingress"`
+	//   description: |
+	//     Default action for all not explicitly configured egress traffic: accept or block.
+	//
+	//     With the default action block, the established and related connections, the traffic via the loopback, SideroLink and KubeSpan links,
+	//     and the traffic to the ports of the `egressFailSafe` are always allowed.
+	//   values:
+	//     - "accept"
+	//     - "block"
+	Egress nethelpers.DefaultAction `yaml:"egress,omitempty"`
+	//   description: |
+	//     Ports which are always allowed for the egress traffic, so that a bad rule can't break the management of the machine.
+	//
+	//     Defaults to DNS (53/udp, 53/tcp), DHCP (67/udp, 547/udp), NTP (123/udp), KubeSpan (51820/udp), etcd (2379-2380/tcp),
+	//     Kubernetes API (6443/tcp), kubelet (10250/tcp) and Talos API (50000-50001/tcp).
+	EgressFailSafeConfig []RulePortSelector `yaml:"egressFailSafe,omitempty" merge:"replace"`
 }
 
 // NewDefaultActionConfigV1Alpha1 creates a new DefaultActionConfig config document.
@@ -73,6 +94,21 @@ func (s *DefaultActionConfigV1Alpha1) Clone() config.Document {
 	return s.DeepCopy()
 }
 
+// Validate implements config.Validator interface.
+func (s *DefaultActionConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	for _, selector := range s.EgressFailSafeConfig {
+		if len(selector.Ports) == 0 {
+			return nil, errors.New("egressFailSafe.ports is required")
+		}
+
+		if err := selector.Ports.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
 // NetworkRuleConfigSignal implements config.NetworkRuleConfigSignal interface.
 func (s *DefaultActionConfigV1Alpha1) NetworkRuleConfigSignal() {}
 
@@ -80,3 +116,24 @@ func (s *DefaultActionConfigV1Alpha1) NetworkRuleConfigSignal() {}
 func (s *DefaultActionConfigV1Alpha1) DefaultAction() nethelpers.DefaultAction {
 	return s.Ingress
 }
+
+// DefaultEgressAction implements config.NetworkRuleConfigDefaultAction interface.
+func (s *DefaultActionConfigV1Alpha1) DefaultEgressAction() nethelpers.DefaultAction {
+	return s.Egress
+}
+
+// EgressFailSafe implements config.NetworkRuleConfigDefaultAction interface.
+func (s *DefaultActionConfigV1Alpha1) EgressFailSafe() []config.NetworkPortSelector {
+	if s.EgressFailSafeConfig == nil {
+		return nil
+	}
+
+	return xslices.Map(s.EgressFailSafeConfig, func(selector RulePortSelector) config.NetworkPortSelector {
+		return config.NetworkPortSelector{
+			Protocol: selector.Protocol,
+			PortRanges: xslices.Map(selector.Ports, func(pr PortRange) [2]uint16 {
+				return [2]uint16{pr.Lo, pr.Hi}
+			}),
+		}
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
 	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
@@ -52,3 +53,36 @@ func TestDefaultActionConfigUnmarshal(t *testing.T) {
 		Ingress: nethelpers.DefaultActionBlock,
 	}, docs[0])
 }
+
+func TestDefaultActionConfigEgressFailSafe(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewDefaultActionConfigV1Alpha1()
+	cfg.Egress = nethelpers.DefaultActionBlock
+
+	assert.Equal(t, nethelpers.DefaultActionBlock, cfg.DefaultEgressAction())
+	assert.Nil(t, cfg.EgressFailSafe())
+
+	cfg.EgressFailSafeConfig = []network.RulePortSelector{
+		{
+			Protocol: nethelpers.ProtocolTCP,
+		},
+	}
+
+	_, err := cfg.Validate(validationMode{})
+	assert.EqualError(t, err, "egressFailSafe.ports is required")
+
+	cfg.EgressFailSafeConfig[0].Ports = network.PortRanges{
+		{Lo: 50000, Hi: 50001},
+	}
+
+	_, err = cfg.Validate(validationMode{})
+	require.NoError(t, err)
+
+	assert.Equal(t, []config.NetworkPortSelector{
+		{
+			Protocol:   nethelpers.ProtocolTCP,
+			PortRanges: [][2]uint16{{50000, 50001}},
+		},
+	}, cfg.EgressFailSafe())
+}
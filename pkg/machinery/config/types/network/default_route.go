@@ -0,0 +1,212 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/siderolabs/go-pointer"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+// DefaultRouteKind is a DefaultRoute config document kind.
+const DefaultRouteKind = "DefaultRouteConfig"
+
+// Handling of the default routes of the links which don't own the default route.
+const (
+	DefaultRouteOtherRoutesDemote   = "demote"
+	DefaultRouteOtherRoutesSuppress = "suppress"
+)
+
+// DefaultDemotedRouteMetric is the default metric of the demoted default routes.
+const DefaultDemotedRouteMetric = 4096
+
+func init() {
+	registry.Register(DefaultRouteKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1": //nolint:goconst
+			return &DefaultRouteConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.NetworkDefaultRouteConfig = &DefaultRouteConfigV1Alpha1{}
+	_ config.Validator                 = &DefaultRouteConfigV1Alpha1{}
+)
+
+// DefaultRouteConfigV1Alpha1 is a config document to select the link which owns the default route on multi-homed machines.
+//
+//	examples:
+//	  - value: exampleDefaultRouteConfigV1Alpha1()
+//	alias: DefaultRouteConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/DefaultRouteConfig
+type DefaultRouteConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+
+	//   description: |
+	//     List of links which own the default route, in the order of preference.
+	//
+	//     Default routes of the other links are demoted or suppressed.
+	//     The list applies to both IPv4 and IPv6 default routes unless it is overridden for the address family.
+	//   examples:
+	//     - value: >
+	//         []string{"eth0", "eth1"}
+	LinksConfig []string `yaml:"links,omitempty"`
+	//   description: |
+	//     Override the links which own the IPv4 default route.
+	IPv4Config *DefaultRouteFamilyConfig `yaml:"ipv4,omitempty"`
+	//   description: |
+	//     Override the links which own the IPv6 default route.
+	IPv6Config *DefaultRouteFamilyConfig `yaml:"ipv6,omitempty"`
+	//   description: |
+	//     Fail over the default route to the next link in the list when the preferred link loses carrier.
+	//
+	//     The default route moves back to the preferred link once the carrier is restored.
+	//     By default, the first link in the list always owns the default route.
+	FailoverConfig *bool `yaml:"failover,omitempty"`
+	//   description: |
+	//     Handling of the default routes of the other links.
+	//
+	//     `demote` keeps the routes with the `demotedRouteMetric`, `suppress` removes the routes.
+	//   values:
+	//     - demote
+	//     - suppress
+	OtherRoutesConfig string `yaml:"otherRoutes,omitempty"`
+	//   description: |
+	//     Route metric of the demoted default routes (defaults to 4096).
+	DemotedRouteMetricConfig uint32 `yaml:"demotedRouteMetric,omitempty"`
+}
+
+// DefaultRouteFamilyConfig is a default route configuration for the address family.
+type DefaultRouteFamilyConfig struct {
+	//   description: |
+	//     List of links which own the default route of the address family, in the order of preference.
+	//   schemaRequired: true
+	FamilyLinksConfig []string `yaml:"links"`
+}
+
+// NewDefaultRouteConfigV1Alpha1 creates a new DefaultRouteConfig config document.
+func NewDefaultRouteConfigV1Alpha1() *DefaultRouteConfigV1Alpha1 {
+	return &DefaultRouteConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       DefaultRouteKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleDefaultRouteConfigV1Alpha1() *DefaultRouteConfigV1Alpha1 {
+	cfg := NewDefaultRouteConfigV1Alpha1()
+	cfg.LinksConfig = []string{"eth0", "eth1"}
+	cfg.FailoverConfig = pointer.To(true)
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *DefaultRouteConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// DefaultRouteLinks implements config.NetworkDefaultRouteConfig interface.
+func (s *DefaultRouteConfigV1Alpha1) DefaultRouteLinks(family nethelpers.Family) []string {
+	switch {
+	case family == nethelpers.FamilyInet4 && s.IPv4Config != nil:
+		return s.IPv4Config.FamilyLinksConfig
+	case family == nethelpers.FamilyInet6 && s.IPv6Config != nil:
+		return s.IPv6Config.FamilyLinksConfig
+	default:
+		return s.LinksConfig
+	}
+}
+
+// Failover implements config.NetworkDefaultRouteConfig interface.
+func (s *DefaultRouteConfigV1Alpha1) Failover() bool {
+	return s.FailoverConfig != nil && *s.FailoverConfig
+}
+
+// SuppressOtherRoutes implements config.NetworkDefaultRouteConfig interface.
+func (s *DefaultRouteConfigV1Alpha1) SuppressOtherRoutes() bool {
+	return s.OtherRoutesConfig == DefaultRouteOtherRoutesSuppress
+}
+
+// DemotedRouteMetric implements config.NetworkDefaultRouteConfig interface.
+func (s *DefaultRouteConfigV1Alpha1) DemotedRouteMetric() uint32 {
+	if s.DemotedRouteMetricConfig == 0 {
+		return DefaultDemotedRouteMetric
+	}
+
+	return s.DemotedRouteMetricConfig
+}
+
+// Validate implements config.Validator interface.
+func (s *DefaultRouteConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var errs error
+
+	if len(s.LinksConfig) == 0 && s.IPv4Config == nil && s.IPv6Config == nil {
+		errs = errors.Join(errs, errors.New("at least one link should be specified"))
+	}
+
+	errs = errors.Join(errs, validateDefaultRouteLinks(s.LinksConfig))
+
+	for _, familyConfig := range []*DefaultRouteFamilyConfig{s.IPv4Config, s.IPv6Config} {
+		if familyConfig == nil {
+			continue
+		}
+
+		if len(familyConfig.FamilyLinksConfig) == 0 {
+			errs = errors.Join(errs, errors.New("address family links should not be empty"))
+		}
+
+		errs = errors.Join(errs, validateDefaultRouteLinks(familyConfig.FamilyLinksConfig))
+	}
+
+	switch s.OtherRoutesConfig {
+	case "", DefaultRouteOtherRoutesDemote, DefaultRouteOtherRoutesSuppress:
+	default:
+		errs = errors.Join(errs, fmt.Errorf("invalid other routes handling %q", s.OtherRoutesConfig))
+	}
+
+	if s.OtherRoutesConfig == DefaultRouteOtherRoutesSuppress && s.DemotedRouteMetricConfig != 0 {
+		errs = errors.Join(errs, errors.New("demoted route metric can't be set when other routes are suppressed"))
+	}
+
+	return nil, errs
+}
+
+func validateDefaultRouteLinks(links []string) error {
+	var errs error
+
+	seen := map[string]struct{}{}
+
+	for _, link := range links {
+		if link == "" {
+			errs = errors.Join(errs, errors.New("empty link name"))
+
+			continue
+		}
+
+		if _, ok := seen[link]; ok {
+			errs = errors.Join(errs, fmt.Errorf("duplicate link %q", link))
+		}
+
+		seen[link] = struct{}{}
+	}
+
+	return errs
+}
@@ -0,0 +1,144 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+//go:embed testdata/defaultrouteconfig.yaml
+var expectedDefaultRouteConfigDocument []byte
+
+func TestDefaultRouteConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewDefaultRouteConfigV1Alpha1()
+	cfg.LinksConfig = []string{"eth0", "eth1"}
+	cfg.IPv6Config = &network.DefaultRouteFamilyConfig{
+		FamilyLinksConfig: []string{"eth1"},
+	}
+	cfg.FailoverConfig = pointer.To(true)
+	cfg.OtherRoutesConfig = network.DefaultRouteOtherRoutesSuppress
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedDefaultRouteConfigDocument, marshaled)
+}
+
+func TestDefaultRouteConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedDefaultRouteConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, &network.DefaultRouteConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaAPIVersion: "v1alpha1",
+			MetaKind:       network.DefaultRouteKind,
+		},
+		LinksConfig: []string{"eth0", "eth1"},
+		IPv6Config: &network.DefaultRouteFamilyConfig{
+			FamilyLinksConfig: []string{"eth1"},
+		},
+		FailoverConfig:    pointer.To(true),
+		OtherRoutesConfig: network.DefaultRouteOtherRoutesSuppress,
+	}, docs[0])
+
+	defaultRouteConfig := provider.NetworkDefaultRouteConfig()
+	require.NotNil(t, defaultRouteConfig)
+
+	assert.Equal(t, []string{"eth0", "eth1"}, defaultRouteConfig.DefaultRouteLinks(nethelpers.FamilyInet4))
+	assert.Equal(t, []string{"eth1"}, defaultRouteConfig.DefaultRouteLinks(nethelpers.FamilyInet6))
+	assert.True(t, defaultRouteConfig.Failover())
+	assert.True(t, defaultRouteConfig.SuppressOtherRoutes())
+	assert.EqualValues(t, network.DefaultDemotedRouteMetric, defaultRouteConfig.DemotedRouteMetric())
+}
+
+func TestDefaultRouteConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.DefaultRouteConfigV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg:  network.NewDefaultRouteConfigV1Alpha1,
+
+			expectedError: "at least one link should be specified",
+		},
+		{
+			name: "invalid",
+			cfg: func() *network.DefaultRouteConfigV1Alpha1 {
+				cfg := network.NewDefaultRouteConfigV1Alpha1()
+				cfg.LinksConfig = []string{"eth0", "", "eth0"}
+				cfg.IPv4Config = &network.DefaultRouteFamilyConfig{}
+				cfg.OtherRoutesConfig = "drop"
+
+				return cfg
+			},
+
+			expectedError: "empty link name\nduplicate link \"eth0\"\naddress family links should not be empty\ninvalid other routes handling \"drop\"",
+		},
+		{
+			name: "metric with suppress",
+			cfg: func() *network.DefaultRouteConfigV1Alpha1 {
+				cfg := network.NewDefaultRouteConfigV1Alpha1()
+				cfg.LinksConfig = []string{"eth0"}
+				cfg.OtherRoutesConfig = network.DefaultRouteOtherRoutesSuppress
+				cfg.DemotedRouteMetricConfig = 2048
+
+				return cfg
+			},
+
+			expectedError: "demoted route metric can't be set when other routes are suppressed",
+		},
+		{
+			name: "valid",
+			cfg: func() *network.DefaultRouteConfigV1Alpha1 {
+				cfg := network.NewDefaultRouteConfigV1Alpha1()
+				cfg.IPv4Config = &network.DefaultRouteFamilyConfig{
+					FamilyLinksConfig: []string{"eth0", "eth1"},
+				}
+				cfg.DemotedRouteMetricConfig = 2048
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			warnings, err := test.cfg().Validate(validationMode{})
+
+			assert.Nil(t, warnings)
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -7,12 +7,17 @@ package network
 //docgen:jsonschema
 
 import (
+	"errors"
+	"fmt"
+	"net"
 	"net/netip"
 	"slices"
+	"strconv"
 
 	"github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
 )
 
 // KubespanEndpointsKind is a KubeSpan endpoints document kind.
@@ -32,6 +37,7 @@ func init() {
 // Check interfaces.
 var (
 	_ config.KubespanConfig = &KubespanEndpointsConfigV1Alpha1{}
+	_ config.Validator      = &KubespanEndpointsConfigV1Alpha1{}
 )
 
 // KubespanEndpointsConfigV1Alpha1 is a config document to configure KubeSpan endpoints.
@@ -54,6 +60,15 @@ type KubespanEndpointsConfigV1Alpha1 struct {
 	//     items:
 	//       type: string
 	ExtraAnnouncedEndpointsConfig []netip.AddrPort `yaml:"extraAnnouncedEndpoints"`
+	//   description: |
+	//     A list of extra Wireguard endpoints specified as hostnames (hostname:port) to announce from this machine.
+	//
+	//     The hostnames are periodically re-resolved, and the resolved addresses are announced,
+	//     so that the peers follow the changes of the dynamic address of the machine.
+	//   examples:
+	//     - value: >
+	//         []string{"hub.example.com:51820"}
+	ExtraAnnouncedHostnameEndpointsConfig []string `yaml:"extraAnnouncedHostnameEndpoints,omitempty"`
 }
 
 // NewKubespanEndpointsV1Alpha1 creates a new KubespanEndpoints config document.
@@ -84,3 +99,32 @@ func (s *KubespanEndpointsConfigV1Alpha1) Clone() config.Document {
 func (s *KubespanEndpointsConfigV1Alpha1) ExtraAnnouncedEndpoints() []netip.AddrPort {
 	return slices.Clone(s.ExtraAnnouncedEndpointsConfig)
 }
+
+// ExtraAnnouncedHostnameEndpoints implements KubespanConfig interface.
+func (s *KubespanEndpointsConfigV1Alpha1) ExtraAnnouncedHostnameEndpoints() []string {
+	return slices.Clone(s.ExtraAnnouncedHostnameEndpointsConfig)
+}
+
+// Validate implements config.Validator interface.
+func (s *KubespanEndpointsConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var errs error
+
+	for _, endpoint := range s.ExtraAnnouncedHostnameEndpointsConfig {
+		host, port, err := net.SplitHostPort(endpoint)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("invalid hostname endpoint %q: %w", endpoint, err))
+
+			continue
+		}
+
+		if host == "" {
+			errs = errors.Join(errs, fmt.Errorf("invalid hostname endpoint %q: hostname is empty", endpoint))
+		}
+
+		if _, err = strconv.ParseUint(port, 10, 16); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("invalid hostname endpoint %q: invalid port %q", endpoint, port))
+		}
+	}
+
+	return nil, errs
+}
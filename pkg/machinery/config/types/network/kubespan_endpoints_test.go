@@ -58,3 +58,49 @@ func TestKubespanEndpointsConfigUnmarshal(t *testing.T) {
 		},
 	}, docs[0])
 }
+
+func TestKubespanEndpointsConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name      string
+		endpoints []string
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:      "valid",
+			endpoints: []string{"hub.example.com:51820", "[2001:db8::1]:51820"},
+		},
+		{
+			name:      "no port",
+			endpoints: []string{"hub.example.com"},
+
+			expectedError: "invalid hostname endpoint \"hub.example.com\": address hub.example.com: missing port in address",
+		},
+		{
+			name:      "invalid port",
+			endpoints: []string{"hub.example.com:wireguard", ":51820"},
+
+			expectedError: "invalid hostname endpoint \"hub.example.com:wireguard\": invalid port \"wireguard\"\ninvalid hostname endpoint \":51820\": hostname is empty",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := network.NewKubespanEndpointsV1Alpha1()
+			cfg.ExtraAnnouncedHostnameEndpointsConfig = test.endpoints
+
+			_, err := cfg.Validate(validationMode{})
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+//docgen:jsonschema
+
+import (
+	"errors"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// LLDPKind is a LLDP config document kind.
+const LLDPKind = "LLDPConfig"
+
+func init() {
+	registry.Register(LLDPKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1": //nolint:goconst
+			return &LLDPConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.NetworkLLDPConfig = &LLDPConfigV1Alpha1{}
+	_ config.NamedDocument     = &LLDPConfigV1Alpha1{}
+	_ config.Validator         = &LLDPConfigV1Alpha1{}
+)
+
+// LLDPConfigV1Alpha1 is a config document to enable LLDP neighbor discovery on a link.
+//
+//	examples:
+//	  - value: exampleLLDPConfigV1Alpha1()
+//	alias: LLDPConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/LLDPConfig
+type LLDPConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+
+	//   description: |
+	//     Name of the link (interface) to listen for LLDP frames on.
+	//
+	//     The discovered neighbor is available as the LLDPNeighborStatus resource with the link name as the ID.
+	//   examples:
+	//     - value: >
+	//         "enp0s2"
+	//   schemaRequired: true
+	MetaName string `yaml:"name"`
+}
+
+// NewLLDPConfigV1Alpha1 creates a new LLDPConfig config document.
+func NewLLDPConfigV1Alpha1(name string) *LLDPConfigV1Alpha1 {
+	return &LLDPConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       LLDPKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+		MetaName: name,
+	}
+}
+
+func exampleLLDPConfigV1Alpha1() *LLDPConfigV1Alpha1 {
+	return NewLLDPConfigV1Alpha1("enp0s2")
+}
+
+// Clone implements config.Document interface.
+func (s *LLDPConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Name implements config.NamedDocument interface.
+func (s *LLDPConfigV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// NetworkLLDPConfigSignal implements config.NetworkLLDPConfig interface.
+func (s *LLDPConfigV1Alpha1) NetworkLLDPConfigSignal() {}
+
+// Validate implements config.Validator interface.
+func (s *LLDPConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	if s.MetaName == "" {
+		return nil, errors.New("name is required")
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,65 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+)
+
+//go:embed testdata/lldpconfig.yaml
+var expectedLLDPConfigDocument []byte
+
+func TestLLDPConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewLLDPConfigV1Alpha1("enp0s2")
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedLLDPConfigDocument, marshaled)
+}
+
+func TestLLDPConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedLLDPConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, &network.LLDPConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaAPIVersion: "v1alpha1",
+			MetaKind:       network.LLDPKind,
+		},
+		MetaName: "enp0s2",
+	}, docs[0])
+
+	require.Len(t, provider.NetworkLLDPConfigs(), 1)
+	assert.Equal(t, "enp0s2", provider.NetworkLLDPConfigs()[0].Name())
+}
+
+func TestLLDPConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	_, err := network.NewLLDPConfigV1Alpha1("").Validate(validationMode{})
+	assert.EqualError(t, err, "name is required")
+
+	_, err = network.NewLLDPConfigV1Alpha1("enp0s2").Validate(validationMode{})
+	assert.NoError(t, err)
+}
@@ -5,6 +5,6 @@
 // Package network provides network machine configuration documents.
 package network
 
-//go:generate go tool github.com/siderolabs/talos/tools/docgen -output network_doc.go network.go default_action_config.go ethernet.go hostname.go kubespan_endpoints.go port_range.go rule_config.go static_host.go
+//go:generate go tool github.com/siderolabs/talos/tools/docgen -output network_doc.go network.go address_set.go default_action_config.go default_route.go ethernet.go hostname.go kubespan_endpoints.go lldp.go port_range.go probe.go route_source.go rule_config.go sriov.go static_host.go
 
-//go:generate go tool github.com/siderolabs/deep-copy -type DefaultActionConfigV1Alpha1 -type KubespanEndpointsConfigV1Alpha1 -type EthernetConfigV1Alpha1 -type HostnameConfigV1Alpha1 -type RuleConfigV1Alpha1 -type StaticHostConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go .
+//go:generate go tool github.com/siderolabs/deep-copy -type AddressSetV1Alpha1 -type DefaultActionConfigV1Alpha1 -type DefaultRouteConfigV1Alpha1 -type KubespanEndpointsConfigV1Alpha1 -type EthernetConfigV1Alpha1 -type HostnameConfigV1Alpha1 -type LLDPConfigV1Alpha1 -type ProbeConfigV1Alpha1 -type RouteSourceConfigV1Alpha1 -type RuleConfigV1Alpha1 -type SRIOVConfigV1Alpha1 -type StaticHostConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go .
@@ -12,11 +12,42 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
 )
 
+func (AddressSetV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "NetworkAddressSet",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "NetworkAddressSet is a named set of addresses which can be referenced by the network rules." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "NetworkAddressSet is a named set of addresses which can be referenced by the network rules.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the address set, used to reference it from the network rules.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the address set, used to reference it from the network rules." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "subnets",
+				Type:        "[]Prefix",
+				Note:        "",
+				Description: "List of subnets in the address set.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "List of subnets in the address set." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleAddressSetV1Alpha1())
+
+	doc.Fields[2].AddExample("", []netip.Prefix{netip.MustParsePrefix("10.3.4.0/24"), netip.MustParsePrefix("2001:db8::/32")})
+
+	return doc
+}
+
 func (DefaultActionConfigV1Alpha1) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
 		Type:        "NetworkDefaultActionConfig",
-		Comments:    [3]string{"" /* encoder.HeadComment */, "NetworkDefaultActionConfig is a ingress firewall default action configuration document." /* encoder.LineComment */, "" /* encoder.FootComment */},
-		Description: "NetworkDefaultActionConfig is a ingress firewall default action configuration document.",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "NetworkDefaultActionConfig is a firewall default action configuration document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "NetworkDefaultActionConfig is a firewall default action configuration document.",
 		Fields: []encoder.Doc{
 			{}, {
 				Name:        "ingress",
@@ -29,6 +60,24 @@ func (DefaultActionConfigV1Alpha1) Doc() *encoder.Doc {
 					"block",
 				},
 			},
+			{
+				Name:        "egress",
+				Type:        "DefaultAction",
+				Note:        "",
+				Description: "Default action for all not explicitly configured egress traffic: accept or block.\n\nWith the default action block, the established and related connections, the traffic via the loopback, SideroLink and KubeSpan links,\nand the traffic to the ports of the `egressFailSafe` are always allowed.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Default action for all not explicitly configured egress traffic: accept or block." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"accept",
+					"block",
+				},
+			},
+			{
+				Name:        "egressFailSafe",
+				Type:        "[]RulePortSelector",
+				Note:        "",
+				Description: "Ports which are always allowed for the egress traffic, so that a bad rule can't break the management of the machine.\n\nDefaults to DNS (53/udp, 53/tcp), DHCP (67/udp, 547/udp), NTP (123/udp), KubeSpan (51820/udp), etcd (2379-2380/tcp),\nKubernetes API (6443/tcp), kubelet (10250/tcp) and Talos API (50000-50001/tcp).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Ports which are always allowed for the egress traffic, so that a bad rule can't break the management of the machine." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
@@ -37,6 +86,98 @@ func (DefaultActionConfigV1Alpha1) Doc() *encoder.Doc {
 	return doc
 }
 
+func (DefaultRouteConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "DefaultRouteConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "DefaultRouteConfig is a config document to select the link which owns the default route on multi-homed machines." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "DefaultRouteConfig is a config document to select the link which owns the default route on multi-homed machines.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "links",
+				Type:        "[]string",
+				Note:        "",
+				Description: "List of links which own the default route, in the order of preference.\n\nDefault routes of the other links are demoted or suppressed.\nThe list applies to both IPv4 and IPv6 default routes unless it is overridden for the address family.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "List of links which own the default route, in the order of preference." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "ipv4",
+				Type:        "DefaultRouteFamilyConfig",
+				Note:        "",
+				Description: "Override the links which own the IPv4 default route.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Override the links which own the IPv4 default route." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "ipv6",
+				Type:        "DefaultRouteFamilyConfig",
+				Note:        "",
+				Description: "Override the links which own the IPv6 default route.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Override the links which own the IPv6 default route." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "failover",
+				Type:        "bool",
+				Note:        "",
+				Description: "Fail over the default route to the next link in the list when the preferred link loses carrier.\n\nThe default route moves back to the preferred link once the carrier is restored.\nBy default, the first link in the list always owns the default route.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Fail over the default route to the next link in the list when the preferred link loses carrier." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "otherRoutes",
+				Type:        "string",
+				Note:        "",
+				Description: "Handling of the default routes of the other links.\n\n`demote` keeps the routes with the `demotedRouteMetric`, `suppress` removes the routes.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Handling of the default routes of the other links." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"demote",
+					"suppress",
+				},
+			},
+			{
+				Name:        "demotedRouteMetric",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Route metric of the demoted default routes (defaults to 4096).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Route metric of the demoted default routes (defaults to 4096)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleDefaultRouteConfigV1Alpha1())
+
+	doc.Fields[1].AddExample("", []string{"eth0", "eth1"})
+
+	return doc
+}
+
+func (DefaultRouteFamilyConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "DefaultRouteFamilyConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "DefaultRouteFamilyConfig is a default route configuration for the address family." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "DefaultRouteFamilyConfig is a default route configuration for the address family.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "DefaultRouteConfigV1Alpha1",
+				FieldName: "ipv4",
+			},
+			{
+				TypeName:  "DefaultRouteConfigV1Alpha1",
+				FieldName: "ipv6",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "links",
+				Type:        "[]string",
+				Note:        "",
+				Description: "List of links which own the default route of the address family, in the order of preference.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "List of links which own the default route of the address family, in the order of preference." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	return doc
+}
+
 func (EthernetConfigV1Alpha1) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
 		Type:        "EthernetConfig",
@@ -265,11 +406,253 @@ func (KubespanEndpointsConfigV1Alpha1) Doc() *encoder.Doc {
 				Description: "A list of extra Wireguard endpoints to announce from this machine.\n\nTalos automatically adds endpoints based on machine addresses, public IP, etc.\nThis field allows to add extra endpoints which are managed outside of Talos, e.g. NAT mapping.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "A list of extra Wireguard endpoints to announce from this machine." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "extraAnnouncedHostnameEndpoints",
+				Type:        "[]string",
+				Note:        "",
+				Description: "A list of extra Wireguard endpoints specified as hostnames (hostname:port) to announce from this machine.\n\nThe hostnames are periodically re-resolved, and the resolved addresses are announced,\nso that the peers follow the changes of the dynamic address of the machine.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "A list of extra Wireguard endpoints specified as hostnames (hostname:port) to announce from this machine." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
 	doc.AddExample("", exampleKubespanEndpointsV1Alpha1())
 
+	doc.Fields[2].AddExample("", []string{"hub.example.com:51820"})
+
+	return doc
+}
+
+func (LLDPConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "LLDPConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "LLDPConfig is a config document to enable LLDP neighbor discovery on a link." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "LLDPConfig is a config document to enable LLDP neighbor discovery on a link.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the link (interface) to listen for LLDP frames on.\n\nThe discovered neighbor is available as the LLDPNeighborStatus resource with the link name as the ID.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the link (interface) to listen for LLDP frames on." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleLLDPConfigV1Alpha1())
+
+	doc.Fields[1].AddExample("", "enp0s2")
+
+	return doc
+}
+
+func (ProbeConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "ProbeConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "ProbeConfig is a config document to configure network connectivity probes." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "ProbeConfig is a config document to configure network connectivity probes.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the probe.\n\nThe name is used as the ID of the ProbeStatus resource with the probe results.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the probe." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "interval",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Interval between the probes.\n\nDefaults to 10s.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Interval between the probes." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "timeout",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Timeout of a single probe.\n\nDefaults to 5s, should not exceed the interval.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Timeout of a single probe." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "failureThreshold",
+				Type:        "int",
+				Note:        "",
+				Description: "Number of consecutive failures for the probe to be considered failed after having succeeded.\n\nDefaults to 3.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Number of consecutive failures for the probe to be considered failed after having succeeded." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "tcp",
+				Type:        "ProbeTCPConfig",
+				Note:        "",
+				Description: "TCP connect probe.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "TCP connect probe." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "http",
+				Type:        "ProbeHTTPConfig",
+				Note:        "",
+				Description: "HTTP GET probe.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "HTTP GET probe." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "icmp",
+				Type:        "ProbeICMPConfig",
+				Note:        "",
+				Description: "ICMP echo (ping) probe.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "ICMP echo (ping) probe." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleProbeConfigV1Alpha1())
+
+	doc.Fields[1].AddExample("", "registry")
+
+	return doc
+}
+
+func (ProbeTCPConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "ProbeTCPConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "ProbeTCPConfig describes a TCP connect probe." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "ProbeTCPConfig describes a TCP connect probe.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "ProbeConfigV1Alpha1",
+				FieldName: "tcp",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "endpoint",
+				Type:        "string",
+				Note:        "",
+				Description: "Endpoint to connect to, in the host:port format.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Endpoint to connect to, in the host:port format." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", "10.5.0.1:6443")
+
+	return doc
+}
+
+func (ProbeHTTPConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "ProbeHTTPConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "ProbeHTTPConfig describes an HTTP GET probe." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "ProbeHTTPConfig describes an HTTP GET probe.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "ProbeConfigV1Alpha1",
+				FieldName: "http",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "url",
+				Type:        "string",
+				Note:        "",
+				Description: "URL to send the GET request to.\n\nThe probe succeeds if the response status is less than 400.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "URL to send the GET request to." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", "https://registry.example.com/v2/")
+
+	return doc
+}
+
+func (ProbeICMPConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "ProbeICMPConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "ProbeICMPConfig describes an ICMP echo (ping) probe." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "ProbeICMPConfig describes an ICMP echo (ping) probe.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "ProbeConfigV1Alpha1",
+				FieldName: "icmp",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "host",
+				Type:        "string",
+				Note:        "",
+				Description: "IP address or hostname to send the ICMP echo requests to.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "IP address or hostname to send the ICMP echo requests to." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", "time.example.com")
+
+	return doc
+}
+
+func (RouteSourceConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "RouteSourceConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "RouteSourceConfig is a config document to configure the precedence and route metrics of the route sources." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "RouteSourceConfig is a config document to configure the precedence and route metrics of the route sources.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "sources",
+				Type:        "[]RouteSourceEntry",
+				Note:        "",
+				Description: "List of route sources in the order of precedence, highest first.\n\nWhen several sources (e.g. static configuration and DHCP) provide routes to the same destination with the same metric,\nthe route of the source listed first wins: routes of the other sources get a higher metric.\nSources which are not listed keep the default precedence (static, dhcp4, ra, kubespan, platform, cmdline) after the listed ones.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "List of route sources in the order of precedence, highest first." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleRouteSourceConfigV1Alpha1())
+
+	return doc
+}
+
+func (RouteSourceEntry) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "RouteSourceEntry",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "RouteSourceEntry is a configuration of a single route source." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "RouteSourceEntry is a configuration of a single route source.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "RouteSourceConfigV1Alpha1",
+				FieldName: "sources",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the route source.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the route source." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"static",
+					"cmdline",
+					"platform",
+					"dhcp4",
+					"ra",
+					"kubespan",
+				},
+			},
+			{
+				Name:        "routeMetric",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Route metric for the routes of the source which use the default metric (1024).\n\nRoutes with an explicitly configured metric are not changed.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Route metric for the routes of the source which use the default metric (1024)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
 	return doc
 }
 
@@ -291,8 +674,8 @@ func (RuleConfigV1Alpha1) Doc() *encoder.Doc {
 				Name:        "portSelector",
 				Type:        "RulePortSelector",
 				Note:        "",
-				Description: "Port selector defines which ports and protocols on the host are affected by the rule.",
-				Comments:    [3]string{"" /* encoder.HeadComment */, "Port selector defines which ports and protocols on the host are affected by the rule." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Description: "Port selector defines which ports and protocols are affected by the rule.\n\nFor the ingress rules, the ports are the host ports, for the egress rules the ports are the destination ports.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Port selector defines which ports and protocols are affected by the rule." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
 			{
 				Name:        "ingress",
@@ -301,6 +684,20 @@ func (RuleConfigV1Alpha1) Doc() *encoder.Doc {
 				Description: "Ingress defines which source subnets are allowed to access the host ports/protocols defined by the `portSelector`.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Ingress defines which source subnets are allowed to access the host ports/protocols defined by the `portSelector`." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "egress",
+				Type:        "[]EgressRule",
+				Note:        "",
+				Description: "Egress defines which destination subnets the host is allowed to connect to on the ports/protocols defined by the `portSelector`.\n\nEither `ingress` or `egress` should be set, a rule with neither is an ingress rule.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Egress defines which destination subnets the host is allowed to connect to on the ports/protocols defined by the `portSelector`." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "log",
+				Type:        "bool",
+				Note:        "",
+				Description: "Log packets dropped by the rule.\n\nLogging is rate limited, logged packets are prefixed with `talos-<name>: ` in the kernel log.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Log packets dropped by the rule." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
@@ -315,6 +712,10 @@ func (RulePortSelector) Doc() *encoder.Doc {
 		Comments:    [3]string{"" /* encoder.HeadComment */, "RulePortSelector is a port selector for the network rule." /* encoder.LineComment */, "" /* encoder.FootComment */},
 		Description: "RulePortSelector is a port selector for the network rule.",
 		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "DefaultActionConfigV1Alpha1",
+				FieldName: "egressFailSafe",
+			},
 			{
 				TypeName:  "RuleConfigV1Alpha1",
 				FieldName: "portSelector",
@@ -369,6 +770,13 @@ func (IngressRule) Doc() *encoder.Doc {
 				Description: "Subnet defines a source subnet.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Subnet defines a source subnet." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "addressSet",
+				Type:        "string",
+				Note:        "",
+				Description: "AddressSet defines a name of the `NetworkAddressSet` document to use as source subnets.\n\nEither `subnet` or `addressSet` should be set.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "AddressSet defines a name of the `NetworkAddressSet` document to use as source subnets." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 			{
 				Name:        "except",
 				Type:        "Prefix",
@@ -386,6 +794,142 @@ func (IngressRule) Doc() *encoder.Doc {
 	return doc
 }
 
+func (EgressRule) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "EgressRule",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "EgressRule is a egress rule." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "EgressRule is a egress rule.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "RuleConfigV1Alpha1",
+				FieldName: "egress",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "subnet",
+				Type:        "Prefix",
+				Note:        "",
+				Description: "Subnet defines a destination subnet.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Subnet defines a destination subnet." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "addressSet",
+				Type:        "string",
+				Note:        "",
+				Description: "AddressSet defines a name of the `NetworkAddressSet` document to use as destination subnets.\n\nEither `subnet` or `addressSet` should be set.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "AddressSet defines a name of the `NetworkAddressSet` document to use as destination subnets." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "except",
+				Type:        "Prefix",
+				Note:        "",
+				Description: "Except defines a destination subnet to exclude from the rule, it gets excluded from the `subnet`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Except defines a destination subnet to exclude from the rule, it gets excluded from the `subnet`." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", netip.MustParsePrefix("10.3.4.0/24"))
+	doc.Fields[0].AddExample("", netip.MustParsePrefix("2001:db8::/32"))
+
+	return doc
+}
+
+func (SRIOVConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "SRIOVConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "SRIOVConfig is a config document to configure SR-IOV virtual functions of a physical function." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "SRIOVConfig is a config document to configure SR-IOV virtual functions of a physical function.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the physical function link (interface) or its PCI bus path.\n\nThe bus path is matched against the `busPath` field of the link status,\ne.g. `0000:03:00.0`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the physical function link (interface) or its PCI bus path." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "numVFs",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Number of virtual functions to create.\n\nThe number can't exceed the `sriov_totalvfs` value reported by the device.\nReducing the number of virtual functions is refused while any of them is bound to `vfio-pci`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Number of virtual functions to create." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "vfs",
+				Type:        "[]SRIOVVFConfig",
+				Note:        "",
+				Description: "Per virtual function settings.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Per virtual function settings." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleSRIOVConfigV1Alpha1())
+
+	doc.Fields[1].AddExample("", "enp3s0f0")
+	doc.Fields[1].AddExample("", "0000:03:00.0")
+
+	return doc
+}
+
+func (SRIOVVFConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "SRIOVVFConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "SRIOVVFConfig is a configuration of a single SR-IOV virtual function." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "SRIOVVFConfig is a configuration of a single SR-IOV virtual function.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "SRIOVConfigV1Alpha1",
+				FieldName: "vfs",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "index",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Index of the virtual function (starting at 0).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Index of the virtual function (starting at 0)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "hardwareAddr",
+				Type:        "string",
+				Note:        "",
+				Description: "Hardware (MAC) address to assign to the virtual function.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Hardware (MAC) address to assign to the virtual function." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "vlan",
+				Type:        "uint16",
+				Note:        "",
+				Description: "VLAN ID to assign to the virtual function (transparent VLAN tagging).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "VLAN ID to assign to the virtual function (transparent VLAN tagging)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "trust",
+				Type:        "bool",
+				Note:        "",
+				Description: "Whether the virtual function is trusted (allowed to change MAC address, enter promiscuous mode, etc.).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Whether the virtual function is trusted (allowed to change MAC address, enter promiscuous mode, etc.)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "spoofCheck",
+				Type:        "bool",
+				Note:        "",
+				Description: "Whether the spoof checking is enabled for the virtual function.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Whether the spoof checking is enabled for the virtual function." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[1].AddExample("", "02:00:00:00:00:01")
+
+	return doc
+}
+
 func (StaticHostConfigV1Alpha1) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
 		Type:        "StaticHostConfig",
@@ -421,15 +965,28 @@ func GetFileDoc() *encoder.FileDoc {
 		Name:        "network",
 		Description: "Package network provides network machine configuration documents.\n",
 		Structs: []*encoder.Doc{
+			AddressSetV1Alpha1{}.Doc(),
 			DefaultActionConfigV1Alpha1{}.Doc(),
+			DefaultRouteConfigV1Alpha1{}.Doc(),
+			DefaultRouteFamilyConfig{}.Doc(),
 			EthernetConfigV1Alpha1{}.Doc(),
 			EthernetRingsConfig{}.Doc(),
 			EthernetChannelsConfig{}.Doc(),
 			HostnameConfigV1Alpha1{}.Doc(),
 			KubespanEndpointsConfigV1Alpha1{}.Doc(),
+			LLDPConfigV1Alpha1{}.Doc(),
+			ProbeConfigV1Alpha1{}.Doc(),
+			ProbeTCPConfig{}.Doc(),
+			ProbeHTTPConfig{}.Doc(),
+			ProbeICMPConfig{}.Doc(),
+			RouteSourceConfigV1Alpha1{}.Doc(),
+			RouteSourceEntry{}.Doc(),
 			RuleConfigV1Alpha1{}.Doc(),
 			RulePortSelector{}.Doc(),
 			IngressRule{}.Doc(),
+			EgressRule{}.Doc(),
+			SRIOVConfigV1Alpha1{}.Doc(),
+			SRIOVVFConfig{}.Doc(),
 			StaticHostConfigV1Alpha1{}.Doc(),
 		},
 	}
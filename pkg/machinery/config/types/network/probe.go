@@ -0,0 +1,269 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+//docgen:jsonschema
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// ProbeKind is a Probe config document kind.
+const ProbeKind = "ProbeConfig"
+
+func init() {
+	registry.Register(ProbeKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1": //nolint:goconst
+			return &ProbeConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.NetworkProbeConfig = &ProbeConfigV1Alpha1{}
+	_ config.NamedDocument      = &ProbeConfigV1Alpha1{}
+	_ config.Validator          = &ProbeConfigV1Alpha1{}
+)
+
+// ProbeConfigV1Alpha1 is a config document to configure network connectivity probes.
+//
+//	examples:
+//	  - value: exampleProbeConfigV1Alpha1()
+//	alias: ProbeConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/ProbeConfig
+type ProbeConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+
+	//   description: |
+	//     Name of the probe.
+	//
+	//     The name is used as the ID of the ProbeStatus resource with the probe results.
+	//   examples:
+	//     - value: >
+	//         "registry"
+	//   schemaRequired: true
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     Interval between the probes.
+	//
+	//     Defaults to 10s.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	ProbeInterval time.Duration `yaml:"interval,omitempty"`
+	//   description: |
+	//     Timeout of a single probe.
+	//
+	//     Defaults to 5s, should not exceed the interval.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	ProbeTimeout time.Duration `yaml:"timeout,omitempty"`
+	//   description: |
+	//     Number of consecutive failures for the probe to be considered failed after having succeeded.
+	//
+	//     Defaults to 3.
+	ProbeFailureThreshold int `yaml:"failureThreshold,omitempty"`
+	//   description: |
+	//     TCP connect probe.
+	ProbeTCP *ProbeTCPConfig `yaml:"tcp,omitempty"`
+	//   description: |
+	//     HTTP GET probe.
+	ProbeHTTP *ProbeHTTPConfig `yaml:"http,omitempty"`
+	//   description: |
+	//     ICMP echo (ping) probe.
+	ProbeICMP *ProbeICMPConfig `yaml:"icmp,omitempty"`
+}
+
+// ProbeTCPConfig describes a TCP connect probe.
+type ProbeTCPConfig struct {
+	//   description: |
+	//     Endpoint to connect to, in the host:port format.
+	//   examples:
+	//     - value: >
+	//         "10.5.0.1:6443"
+	//   schemaRequired: true
+	TCPEndpoint string `yaml:"endpoint"`
+}
+
+// ProbeHTTPConfig describes an HTTP GET probe.
+type ProbeHTTPConfig struct {
+	//   description: |
+	//     URL to send the GET request to.
+	//
+	//     The probe succeeds if the response status is less than 400.
+	//   examples:
+	//     - value: >
+	//         "https://registry.example.com/v2/"
+	//   schemaRequired: true
+	HTTPURL string `yaml:"url"`
+}
+
+// ProbeICMPConfig describes an ICMP echo (ping) probe.
+type ProbeICMPConfig struct {
+	//   description: |
+	//     IP address or hostname to send the ICMP echo requests to.
+	//   examples:
+	//     - value: >
+	//         "time.example.com"
+	//   schemaRequired: true
+	ICMPHost string `yaml:"host"`
+}
+
+// NewProbeConfigV1Alpha1 creates a new ProbeConfig config document.
+func NewProbeConfigV1Alpha1(name string) *ProbeConfigV1Alpha1 {
+	return &ProbeConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       ProbeKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+		MetaName: name,
+	}
+}
+
+func exampleProbeConfigV1Alpha1() *ProbeConfigV1Alpha1 {
+	cfg := NewProbeConfigV1Alpha1("registry")
+	cfg.ProbeInterval = 30 * time.Second
+	cfg.ProbeFailureThreshold = 2
+	cfg.ProbeHTTP = &ProbeHTTPConfig{
+		HTTPURL: "https://registry.example.com/v2/",
+	}
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *ProbeConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Name implements config.NamedDocument interface.
+func (s *ProbeConfigV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// Interval implements config.NetworkProbeConfig interface.
+func (s *ProbeConfigV1Alpha1) Interval() time.Duration {
+	return cmp.Or(s.ProbeInterval, constants.NetworkProbeDefaultInterval)
+}
+
+// Timeout implements config.NetworkProbeConfig interface.
+func (s *ProbeConfigV1Alpha1) Timeout() time.Duration {
+	return cmp.Or(s.ProbeTimeout, min(constants.NetworkProbeDefaultTimeout, s.Interval()))
+}
+
+// FailureThreshold implements config.NetworkProbeConfig interface.
+func (s *ProbeConfigV1Alpha1) FailureThreshold() int {
+	return cmp.Or(s.ProbeFailureThreshold, constants.NetworkProbeDefaultFailureThreshold)
+}
+
+// TCPEndpoint implements config.NetworkProbeConfig interface.
+func (s *ProbeConfigV1Alpha1) TCPEndpoint() string {
+	if s.ProbeTCP == nil {
+		return ""
+	}
+
+	return s.ProbeTCP.TCPEndpoint
+}
+
+// HTTPURL implements config.NetworkProbeConfig interface.
+func (s *ProbeConfigV1Alpha1) HTTPURL() string {
+	if s.ProbeHTTP == nil {
+		return ""
+	}
+
+	return s.ProbeHTTP.HTTPURL
+}
+
+// ICMPHost implements config.NetworkProbeConfig interface.
+func (s *ProbeConfigV1Alpha1) ICMPHost() string {
+	if s.ProbeICMP == nil {
+		return ""
+	}
+
+	return s.ProbeICMP.ICMPHost
+}
+
+// Validate implements config.Validator interface.
+//
+//nolint:gocyclo
+func (s *ProbeConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var errs error
+
+	if s.MetaName == "" {
+		errs = errors.Join(errs, errors.New("name is required"))
+	}
+
+	probes := 0
+
+	if s.ProbeTCP != nil {
+		probes++
+
+		if _, _, err := net.SplitHostPort(s.ProbeTCP.TCPEndpoint); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("invalid tcp endpoint %q: %w", s.ProbeTCP.TCPEndpoint, err))
+		}
+	}
+
+	if s.ProbeHTTP != nil {
+		probes++
+
+		u, err := url.Parse(s.ProbeHTTP.HTTPURL)
+
+		switch {
+		case err != nil:
+			errs = errors.Join(errs, fmt.Errorf("invalid http url %q: %w", s.ProbeHTTP.HTTPURL, err))
+		case u.Scheme != "http" && u.Scheme != "https":
+			errs = errors.Join(errs, fmt.Errorf("invalid http url %q: scheme should be http or https", s.ProbeHTTP.HTTPURL))
+		case u.Host == "":
+			errs = errors.Join(errs, fmt.Errorf("invalid http url %q: host is required", s.ProbeHTTP.HTTPURL))
+		}
+	}
+
+	if s.ProbeICMP != nil {
+		probes++
+
+		if s.ProbeICMP.ICMPHost == "" {
+			errs = errors.Join(errs, errors.New("icmp host is required"))
+		}
+	}
+
+	if probes != 1 {
+		errs = errors.Join(errs, errors.New("exactly one of tcp, http or icmp probes should be specified"))
+	}
+
+	if s.ProbeInterval < 0 {
+		errs = errors.Join(errs, fmt.Errorf("interval should be non-negative: %s", s.ProbeInterval))
+	}
+
+	if s.ProbeTimeout < 0 {
+		errs = errors.Join(errs, fmt.Errorf("timeout should be non-negative: %s", s.ProbeTimeout))
+	}
+
+	if s.ProbeTimeout > s.Interval() {
+		errs = errors.Join(errs, fmt.Errorf("timeout %s should not exceed the interval %s", s.ProbeTimeout, s.Interval()))
+	}
+
+	if s.ProbeFailureThreshold < 0 {
+		errs = errors.Join(errs, fmt.Errorf("failure threshold should be non-negative: %d", s.ProbeFailureThreshold))
+	}
+
+	return nil, errs
+}
@@ -0,0 +1,158 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+)
+
+//go:embed testdata/probeconfig.yaml
+var expectedProbeConfigDocument []byte
+
+func TestProbeConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewProbeConfigV1Alpha1("registry")
+	cfg.ProbeInterval = 30 * time.Second
+	cfg.ProbeFailureThreshold = 2
+	cfg.ProbeHTTP = &network.ProbeHTTPConfig{
+		HTTPURL: "https://registry.example.com/v2/",
+	}
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedProbeConfigDocument, marshaled)
+}
+
+func TestProbeConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedProbeConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, &network.ProbeConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaAPIVersion: "v1alpha1",
+			MetaKind:       network.ProbeKind,
+		},
+		MetaName:              "registry",
+		ProbeInterval:         30 * time.Second,
+		ProbeFailureThreshold: 2,
+		ProbeHTTP: &network.ProbeHTTPConfig{
+			HTTPURL: "https://registry.example.com/v2/",
+		},
+	}, docs[0])
+
+	require.Len(t, provider.NetworkProbeConfigs(), 1)
+
+	probe := provider.NetworkProbeConfigs()[0]
+
+	assert.Equal(t, "registry", probe.Name())
+	assert.Equal(t, 30*time.Second, probe.Interval())
+	assert.Equal(t, 5*time.Second, probe.Timeout())
+	assert.Equal(t, 2, probe.FailureThreshold())
+	assert.Equal(t, "https://registry.example.com/v2/", probe.HTTPURL())
+	assert.Empty(t, probe.TCPEndpoint())
+	assert.Empty(t, probe.ICMPHost())
+}
+
+func TestProbeConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.ProbeConfigV1Alpha1
+
+		expectedError    string
+		expectedWarnings []string
+	}{
+		{
+			name: "empty",
+			cfg: func() *network.ProbeConfigV1Alpha1 {
+				return network.NewProbeConfigV1Alpha1("")
+			},
+
+			expectedError: "name is required\nexactly one of tcp, http or icmp probes should be specified",
+		},
+		{
+			name: "multiple probes",
+			cfg: func() *network.ProbeConfigV1Alpha1 {
+				cfg := network.NewProbeConfigV1Alpha1("gateway")
+				cfg.ProbeTCP = &network.ProbeTCPConfig{TCPEndpoint: "10.5.0.1:6443"}
+				cfg.ProbeICMP = &network.ProbeICMPConfig{ICMPHost: "10.5.0.1"}
+
+				return cfg
+			},
+
+			expectedError: "exactly one of tcp, http or icmp probes should be specified",
+		},
+		{
+			name: "invalid tcp",
+			cfg: func() *network.ProbeConfigV1Alpha1 {
+				cfg := network.NewProbeConfigV1Alpha1("gateway")
+				cfg.ProbeTCP = &network.ProbeTCPConfig{TCPEndpoint: "10.5.0.1"}
+				cfg.ProbeInterval = time.Second
+				cfg.ProbeTimeout = 2 * time.Second
+
+				return cfg
+			},
+
+			expectedError: "invalid tcp endpoint \"10.5.0.1\": address 10.5.0.1: missing port in address\ntimeout 2s should not exceed the interval 1s",
+		},
+		{
+			name: "invalid http",
+			cfg: func() *network.ProbeConfigV1Alpha1 {
+				cfg := network.NewProbeConfigV1Alpha1("registry")
+				cfg.ProbeHTTP = &network.ProbeHTTPConfig{HTTPURL: "ftp://registry.example.com/"}
+				cfg.ProbeFailureThreshold = -1
+
+				return cfg
+			},
+
+			expectedError: "invalid http url \"ftp://registry.example.com/\": scheme should be http or https\nfailure threshold should be non-negative: -1",
+		},
+		{
+			name: "valid",
+			cfg: func() *network.ProbeConfigV1Alpha1 {
+				cfg := network.NewProbeConfigV1Alpha1("gateway")
+				cfg.ProbeICMP = &network.ProbeICMPConfig{ICMPHost: "10.5.0.1"}
+				cfg.ProbeInterval = time.Second
+				cfg.ProbeTimeout = 500 * time.Millisecond
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			warnings, err := test.cfg().Validate(validationMode{})
+
+			assert.Equal(t, test.expectedWarnings, warnings)
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
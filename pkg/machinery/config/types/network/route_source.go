@@ -0,0 +1,146 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// RouteSourceKind is a RouteSource config document kind.
+const RouteSourceKind = "RouteSourceConfig"
+
+func init() {
+	registry.Register(RouteSourceKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1": //nolint:goconst
+			return &RouteSourceConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.NetworkRouteSourceConfig = &RouteSourceConfigV1Alpha1{}
+	_ config.Validator                = &RouteSourceConfigV1Alpha1{}
+)
+
+// RouteSourceConfigV1Alpha1 is a config document to configure the precedence and route metrics of the route sources.
+//
+//	examples:
+//	  - value: exampleRouteSourceConfigV1Alpha1()
+//	alias: RouteSourceConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/RouteSourceConfig
+type RouteSourceConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+
+	//   description: |
+	//     List of route sources in the order of precedence, highest first.
+	//
+	//     When several sources (e.g. static configuration and DHCP) provide routes to the same destination with the same metric,
+	//     the route of the source listed first wins: routes of the other sources get a higher metric.
+	//     Sources which are not listed keep the default precedence (static, dhcp4, ra, kubespan, platform, cmdline) after the listed ones.
+	SourcesConfig []RouteSourceEntry `yaml:"sources,omitempty"`
+}
+
+// RouteSourceEntry is a configuration of a single route source.
+type RouteSourceEntry struct {
+	//   description: |
+	//     Name of the route source.
+	//   values:
+	//     - "static"
+	//     - "cmdline"
+	//     - "platform"
+	//     - "dhcp4"
+	//     - "ra"
+	//     - "kubespan"
+	//   schemaRequired: true
+	SourceName string `yaml:"name"`
+	//   description: |
+	//     Route metric for the routes of the source which use the default metric (1024).
+	//
+	//     Routes with an explicitly configured metric are not changed.
+	SourceRouteMetric uint32 `yaml:"routeMetric,omitempty"`
+}
+
+// NewRouteSourceConfigV1Alpha1 creates a new RouteSourceConfig config document.
+func NewRouteSourceConfigV1Alpha1() *RouteSourceConfigV1Alpha1 {
+	return &RouteSourceConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       RouteSourceKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleRouteSourceConfigV1Alpha1() *RouteSourceConfigV1Alpha1 {
+	cfg := NewRouteSourceConfigV1Alpha1()
+	cfg.SourcesConfig = []RouteSourceEntry{
+		{
+			SourceName:        network.RouteOriginStatic,
+			SourceRouteMetric: 100,
+		},
+		{
+			SourceName:        network.RouteOriginDHCP4,
+			SourceRouteMetric: 200,
+		},
+	}
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *RouteSourceConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// RouteSources implements config.NetworkRouteSourceConfig interface.
+func (s *RouteSourceConfigV1Alpha1) RouteSources() []config.RouteSource {
+	result := make([]config.RouteSource, 0, len(s.SourcesConfig))
+
+	for _, source := range s.SourcesConfig {
+		result = append(result, config.RouteSource{
+			Name:        source.SourceName,
+			RouteMetric: source.SourceRouteMetric,
+		})
+	}
+
+	return result
+}
+
+// Validate implements config.Validator interface.
+func (s *RouteSourceConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var errs error
+
+	seen := map[string]struct{}{}
+
+	for _, source := range s.SourcesConfig {
+		if !slices.Contains(network.DefaultRouteOriginPrecedence, source.SourceName) {
+			errs = errors.Join(errs, fmt.Errorf("unknown route source %q", source.SourceName))
+
+			continue
+		}
+
+		if _, ok := seen[source.SourceName]; ok {
+			errs = errors.Join(errs, fmt.Errorf("duplicate route source %q", source.SourceName))
+		}
+
+		seen[source.SourceName] = struct{}{}
+	}
+
+	return nil, errs
+}
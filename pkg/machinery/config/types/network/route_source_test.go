@@ -0,0 +1,151 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+)
+
+//go:embed testdata/routesourceconfig.yaml
+var expectedRouteSourceConfigDocument []byte
+
+func TestRouteSourceConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewRouteSourceConfigV1Alpha1()
+	cfg.SourcesConfig = []network.RouteSourceEntry{
+		{
+			SourceName:        "static",
+			SourceRouteMetric: 100,
+		},
+		{
+			SourceName: "dhcp4",
+		},
+	}
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedRouteSourceConfigDocument, marshaled)
+}
+
+func TestRouteSourceConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedRouteSourceConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, &network.RouteSourceConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaAPIVersion: "v1alpha1",
+			MetaKind:       network.RouteSourceKind,
+		},
+		SourcesConfig: []network.RouteSourceEntry{
+			{
+				SourceName:        "static",
+				SourceRouteMetric: 100,
+			},
+			{
+				SourceName: "dhcp4",
+			},
+		},
+	}, docs[0])
+
+	require.NotNil(t, provider.NetworkRouteSourceConfig())
+
+	assert.Equal(t, []config.RouteSource{
+		{
+			Name:        "static",
+			RouteMetric: 100,
+		},
+		{
+			Name: "dhcp4",
+		},
+	}, provider.NetworkRouteSourceConfig().RouteSources())
+}
+
+func TestRouteSourceConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.RouteSourceConfigV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg:  network.NewRouteSourceConfigV1Alpha1,
+		},
+		{
+			name: "invalid sources",
+			cfg: func() *network.RouteSourceConfigV1Alpha1 {
+				cfg := network.NewRouteSourceConfigV1Alpha1()
+				cfg.SourcesConfig = []network.RouteSourceEntry{
+					{
+						SourceName: "dhcp4",
+					},
+					{
+						SourceName: "dhcp6",
+					},
+					{
+						SourceName:        "dhcp4",
+						SourceRouteMetric: 100,
+					},
+				}
+
+				return cfg
+			},
+
+			expectedError: "unknown route source \"dhcp6\"\nduplicate route source \"dhcp4\"",
+		},
+		{
+			name: "valid",
+			cfg: func() *network.RouteSourceConfigV1Alpha1 {
+				cfg := network.NewRouteSourceConfigV1Alpha1()
+				cfg.SourcesConfig = []network.RouteSourceEntry{
+					{
+						SourceName:        "platform",
+						SourceRouteMetric: 50,
+					},
+					{
+						SourceName: "static",
+					},
+				}
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			warnings, err := test.cfg().Validate(validationMode{})
+
+			assert.Nil(t, warnings)
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
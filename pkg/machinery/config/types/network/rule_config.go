@@ -58,11 +58,23 @@ type RuleConfigV1Alpha1 struct {
 	//   schemaRequired: true
 	MetaName string `yaml:"name"`
 	//   description: |
-	//     Port selector defines which ports and protocols on the host are affected by the rule.
+	//     Port selector defines which ports and protocols are affected by the rule.
+	//
+	//     For the ingress rules, the ports are the host ports, for the egress rules the ports are the destination ports.
 	PortSelector RulePortSelector `yaml:"portSelector"`
 	//   description: |
 	//     Ingress defines which source subnets are allowed to access the host ports/protocols defined by the `portSelector`.
-	Ingress IngressConfig `yaml:"ingress" merge:"replace"`
+	Ingress IngressConfig `yaml:"ingress,omitempty" merge:"replace"`
+	//   description: |
+	//     Egress defines which destination subnets the host is allowed to connect to on the ports/protocols defined by the `portSelector`.
+	//
+	//     Either `ingress` or `egress` should be set, a rule with neither is an ingress rule.
+	Egress EgressConfig `yaml:"egress,omitempty" merge:"replace"`
+	//   description: |
+	//     Log packets dropped by the rule.
+	//
+	//     Logging is rate limited, logged packets are prefixed with `talos-<name>: ` in the kernel log.
+	RuleLog bool `yaml:"log,omitempty"`
 }
 
 // RulePortSelector is a port selector for the network rule.
@@ -111,7 +123,12 @@ type IngressRule struct {
 	//   schema:
 	//     type: string
 	//     pattern: ^[0-9a-f.:]+/\d{1,3}$
-	Subnet netip.Prefix `yaml:"subnet"`
+	Subnet Prefix `yaml:"subnet,omitempty"`
+	//   description: |
+	//     AddressSet defines a name of the `NetworkAddressSet` document to use as source subnets.
+	//
+	//     Either `subnet` or `addressSet` should be set.
+	AddressSet string `yaml:"addressSet,omitempty"`
 	//   description: |
 	//     Except defines a source subnet to exclude from the rule, it gets excluded from the `subnet`.
 	//   schema:
@@ -120,6 +137,37 @@ type IngressRule struct {
 	Except Prefix `yaml:"except,omitempty"`
 }
 
+// EgressConfig is a egress config.
+//
+//docgen:alias
+type EgressConfig []EgressRule
+
+// EgressRule is a egress rule.
+type EgressRule struct {
+	//   description: |
+	//     Subnet defines a destination subnet.
+	//   examples:
+	//    - value: >
+	//       netip.MustParsePrefix("10.3.4.0/24")
+	//    - value: >
+	//       netip.MustParsePrefix("2001:db8::/32")
+	//   schema:
+	//     type: string
+	//     pattern: ^[0-9a-f.:]+/\d{1,3}$
+	Subnet Prefix `yaml:"subnet,omitempty"`
+	//   description: |
+	//     AddressSet defines a name of the `NetworkAddressSet` document to use as destination subnets.
+	//
+	//     Either `subnet` or `addressSet` should be set.
+	AddressSet string `yaml:"addressSet,omitempty"`
+	//   description: |
+	//     Except defines a destination subnet to exclude from the rule, it gets excluded from the `subnet`.
+	//   schema:
+	//     type: string
+	//     pattern: ^[0-9a-f.:]+/\d{1,3}$
+	Except Prefix `yaml:"except,omitempty"`
+}
+
 // Prefix is a wrapper for netip.Prefix.
 //
 // It implements IsZero() so that yaml.Marshal correctly skips empty values.
@@ -153,7 +201,7 @@ func exampleRuleConfigV1Alpha1() *RuleConfigV1Alpha1 {
 	}
 	cfg.Ingress = IngressConfig{
 		{
-			Subnet: netip.MustParsePrefix("192.168.0.0/16"),
+			Subnet: Prefix{netip.MustParsePrefix("192.168.0.0/16")},
 		},
 	}
 
@@ -184,19 +232,41 @@ func (s *RuleConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Opti
 		return nil, err
 	}
 
+	if len(s.Ingress) > 0 && len(s.Egress) > 0 {
+		return nil, errors.New("ingress and egress are mutually exclusive")
+	}
+
 	for _, rule := range s.Ingress {
-		if !rule.Subnet.IsValid() {
-			return nil, fmt.Errorf("invalid subnet: %s", rule.Subnet)
+		if err := validateRuleSubnets(rule.Subnet, rule.AddressSet, rule.Except); err != nil {
+			return nil, err
 		}
+	}
 
-		if !value.IsZero(rule.Except) && !rule.Except.IsValid() {
-			return nil, fmt.Errorf("invalid except: %s", rule.Except)
+	for _, rule := range s.Egress {
+		if err := validateRuleSubnets(rule.Subnet, rule.AddressSet, rule.Except); err != nil {
+			return nil, err
 		}
 	}
 
 	return nil, nil
 }
 
+func validateRuleSubnets(subnet Prefix, addressSet string, except Prefix) error {
+	switch {
+	case addressSet != "" && !value.IsZero(subnet):
+		return errors.New("subnet and addressSet are mutually exclusive")
+	case addressSet != "":
+	case !subnet.IsValid():
+		return fmt.Errorf("invalid subnet: %s", subnet)
+	}
+
+	if !value.IsZero(except) && !except.IsValid() {
+		return fmt.Errorf("invalid except: %s", except)
+	}
+
+	return nil
+}
+
 // NetworkRuleConfigSignal implements config.NetworkRuleConfigSignal interface.
 func (s *RuleConfigV1Alpha1) NetworkRuleConfigSignal() {}
 
@@ -205,6 +275,11 @@ func (s *RuleConfigV1Alpha1) Rules() []config.NetworkRule {
 	return []config.NetworkRule{s}
 }
 
+// IsEgress implements config.NetworkRule interface.
+func (s *RuleConfigV1Alpha1) IsEgress() bool {
+	return len(s.Egress) > 0
+}
+
 // Protocol implements config.NetworkRule interface.
 func (s *RuleConfigV1Alpha1) Protocol() nethelpers.Protocol {
 	return s.PortSelector.Protocol
@@ -219,16 +294,39 @@ func (s *RuleConfigV1Alpha1) PortRanges() [][2]uint16 {
 
 // Subnets implements config.NetworkRule interface.
 func (s *RuleConfigV1Alpha1) Subnets() []netip.Prefix {
-	return xslices.Map(s.Ingress, func(rule IngressRule) netip.Prefix {
-		return rule.Subnet
-	})
+	return xslices.Map(
+		xslices.Filter(
+			s.rules(),
+			func(rule IngressRule) bool {
+				return rule.Subnet.IsValid()
+			},
+		),
+		func(rule IngressRule) netip.Prefix {
+			return rule.Subnet.Prefix
+		},
+	)
+}
+
+// AddressSets implements config.NetworkRule interface.
+func (s *RuleConfigV1Alpha1) AddressSets() []string {
+	return xslices.Map(
+		xslices.Filter(
+			s.rules(),
+			func(rule IngressRule) bool {
+				return rule.AddressSet != ""
+			},
+		),
+		func(rule IngressRule) string {
+			return rule.AddressSet
+		},
+	)
 }
 
 // ExceptSubnets implements config.NetworkRule interface.
 func (s *RuleConfigV1Alpha1) ExceptSubnets() []netip.Prefix {
 	return xslices.Map(
 		xslices.Filter(
-			s.Ingress,
+			s.rules(),
 			func(rule IngressRule) bool {
 				return rule.Except.IsValid()
 			},
@@ -238,3 +336,17 @@ func (s *RuleConfigV1Alpha1) ExceptSubnets() []netip.Prefix {
 		},
 	)
 }
+
+// rules returns either the ingress or the egress rules, egress rules are converted to the ingress rules as they have the same fields.
+func (s *RuleConfigV1Alpha1) rules() []IngressRule {
+	if len(s.Egress) > 0 {
+		return xslices.Map(s.Egress, func(rule EgressRule) IngressRule { return IngressRule(rule) })
+	}
+
+	return s.Ingress
+}
+
+// Log implements config.NetworkRule interface.
+func (s *RuleConfigV1Alpha1) Log() bool {
+	return s.RuleLog
+}
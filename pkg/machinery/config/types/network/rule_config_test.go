@@ -38,11 +38,11 @@ func TestRuleConfigMarshalStability(t *testing.T) {
 
 	cfg.Ingress = network.IngressConfig{
 		{
-			Subnet: netip.MustParsePrefix("192.168.0.0/16"),
+			Subnet: network.Prefix{netip.MustParsePrefix("192.168.0.0/16")},
 			Except: network.Prefix{netip.MustParsePrefix("192.168.0.3/32")},
 		},
 		{
-			Subnet: netip.MustParsePrefix("2001::/16"),
+			Subnet: network.Prefix{netip.MustParsePrefix("2001::/16")},
 		},
 	}
 
@@ -78,11 +78,11 @@ func TestRuleConfigUnmarshal(t *testing.T) {
 		},
 		Ingress: network.IngressConfig{
 			{
-				Subnet: netip.MustParsePrefix("192.168.0.0/16"),
+				Subnet: network.Prefix{netip.MustParsePrefix("192.168.0.0/16")},
 				Except: network.Prefix{netip.MustParsePrefix("192.168.0.3/32")},
 			},
 			{
-				Subnet: netip.MustParsePrefix("2001::/16"),
+				Subnet: network.Prefix{netip.MustParsePrefix("2001::/16")},
 			},
 		},
 	}, docs[0])
@@ -147,6 +147,45 @@ func TestRuleConfigValidate(t *testing.T) {
 
 			expectedError: "invalid subnet: invalid Prefix",
 		},
+		{
+			name: "subnet and address set",
+			cfg: func() *network.RuleConfigV1Alpha1 {
+				cfg := network.NewRuleConfigV1Alpha1()
+				cfg.MetaName = "--"
+				cfg.PortSelector.Ports = network.PortRanges{
+					{Lo: 80, Hi: 80},
+				}
+				cfg.Ingress = network.IngressConfig{
+					{
+						Subnet:     network.Prefix{netip.MustParsePrefix("192.168.0.0/16")},
+						AddressSet: "office",
+					},
+				}
+
+				return cfg
+			},
+
+			expectedError: "subnet and addressSet are mutually exclusive",
+		},
+		{
+			name: "address set",
+			cfg: func() *network.RuleConfigV1Alpha1 {
+				cfg := network.NewRuleConfigV1Alpha1()
+				cfg.MetaName = "--"
+				cfg.PortSelector.Ports = network.PortRanges{
+					{Lo: 30000, Hi: 32767},
+				}
+				cfg.Ingress = network.IngressConfig{
+					{
+						AddressSet: "office",
+						Except:     network.Prefix{netip.MustParsePrefix("192.168.3.0/24")},
+					},
+				}
+				cfg.RuleLog = true
+
+				return cfg
+			},
+		},
 		{
 			name: "valid",
 			cfg: func() *network.RuleConfigV1Alpha1 {
@@ -158,11 +197,74 @@ func TestRuleConfigValidate(t *testing.T) {
 				}
 				cfg.Ingress = network.IngressConfig{
 					{
-						Subnet: netip.MustParsePrefix("192.168.0.0/16"),
+						Subnet: network.Prefix{netip.MustParsePrefix("192.168.0.0/16")},
 						Except: network.Prefix{netip.MustParsePrefix("192.168.3.0/24")},
 					},
 					{
-						Subnet: netip.MustParsePrefix("2001::/16"),
+						Subnet: network.Prefix{netip.MustParsePrefix("2001::/16")},
+					},
+				}
+
+				return cfg
+			},
+		},
+		{
+			name: "ingress and egress",
+			cfg: func() *network.RuleConfigV1Alpha1 {
+				cfg := network.NewRuleConfigV1Alpha1()
+				cfg.MetaName = "--"
+				cfg.PortSelector.Ports = network.PortRanges{
+					{Lo: 443, Hi: 443},
+				}
+				cfg.Ingress = network.IngressConfig{
+					{
+						Subnet: network.Prefix{netip.MustParsePrefix("192.168.0.0/16")},
+					},
+				}
+				cfg.Egress = network.EgressConfig{
+					{
+						Subnet: network.Prefix{netip.MustParsePrefix("10.0.0.0/8")},
+					},
+				}
+
+				return cfg
+			},
+
+			expectedError: "ingress and egress are mutually exclusive",
+		},
+		{
+			name: "invalid egress subnet",
+			cfg: func() *network.RuleConfigV1Alpha1 {
+				cfg := network.NewRuleConfigV1Alpha1()
+				cfg.MetaName = "--"
+				cfg.PortSelector.Ports = network.PortRanges{
+					{Lo: 443, Hi: 443},
+				}
+				cfg.Egress = network.EgressConfig{
+					{},
+				}
+
+				return cfg
+			},
+
+			expectedError: "invalid subnet: invalid Prefix",
+		},
+		{
+			name: "valid egress",
+			cfg: func() *network.RuleConfigV1Alpha1 {
+				cfg := network.NewRuleConfigV1Alpha1()
+				cfg.MetaName = "--"
+				cfg.PortSelector.Protocol = nethelpers.ProtocolTCP
+				cfg.PortSelector.Ports = network.PortRanges{
+					{Lo: 443, Hi: 443},
+				}
+				cfg.Egress = network.EgressConfig{
+					{
+						Subnet: network.Prefix{netip.MustParsePrefix("10.0.0.0/8")},
+						Except: network.Prefix{netip.MustParsePrefix("10.3.0.0/16")},
+					},
+					{
+						AddressSet: "registries",
 					},
 				}
 
@@ -199,3 +301,30 @@ func (validationMode) RequiresInstall() bool {
 func (validationMode) InContainer() bool {
 	return false
 }
+
+func TestRuleConfigEgress(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewRuleConfigV1Alpha1()
+	cfg.MetaName = "registry"
+	cfg.PortSelector.Ports = network.PortRanges{
+		{Lo: 443, Hi: 443},
+	}
+
+	assert.False(t, cfg.IsEgress())
+
+	cfg.Egress = network.EgressConfig{
+		{
+			Subnet: network.Prefix{netip.MustParsePrefix("10.0.0.0/8")},
+			Except: network.Prefix{netip.MustParsePrefix("10.3.0.0/16")},
+		},
+		{
+			AddressSet: "registries",
+		},
+	}
+
+	assert.True(t, cfg.IsEgress())
+	assert.Equal(t, []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}, cfg.Subnets())
+	assert.Equal(t, []netip.Prefix{netip.MustParsePrefix("10.3.0.0/16")}, cfg.ExceptSubnets())
+	assert.Equal(t, []string{"registries"}, cfg.AddressSets())
+}
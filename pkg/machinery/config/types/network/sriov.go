@@ -0,0 +1,193 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/siderolabs/go-pointer"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// SRIOVKind is a SR-IOV config document kind.
+const SRIOVKind = "SRIOVConfig"
+
+func init() {
+	registry.Register(SRIOVKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1": //nolint:goconst
+			return &SRIOVConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.SRIOVConfig   = &SRIOVConfigV1Alpha1{}
+	_ config.NamedDocument = &SRIOVConfigV1Alpha1{}
+	_ config.Validator     = &SRIOVConfigV1Alpha1{}
+)
+
+// SRIOVConfigV1Alpha1 is a config document to configure SR-IOV virtual functions of a physical function.
+//
+//	examples:
+//	  - value: exampleSRIOVConfigV1Alpha1()
+//	alias: SRIOVConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/SRIOVConfig
+type SRIOVConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+
+	//   description: |
+	//     Name of the physical function link (interface) or its PCI bus path.
+	//
+	//     The bus path is matched against the `busPath` field of the link status,
+	//     e.g. `0000:03:00.0`.
+	//   examples:
+	//     - value: >
+	//         "enp3s0f0"
+	//     - value: >
+	//         "0000:03:00.0"
+	//   schemaRequired: true
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     Number of virtual functions to create.
+	//
+	//     The number can't exceed the `sriov_totalvfs` value reported by the device.
+	//     Reducing the number of virtual functions is refused while any of them is bound to `vfio-pci`.
+	NumVFsConfig uint32 `yaml:"numVFs"`
+	//   description: |
+	//     Per virtual function settings.
+	VFsConfig []SRIOVVFConfig `yaml:"vfs,omitempty"`
+}
+
+// SRIOVVFConfig is a configuration of a single SR-IOV virtual function.
+type SRIOVVFConfig struct {
+	//   description: |
+	//     Index of the virtual function (starting at 0).
+	VFIndex uint32 `yaml:"index"`
+	//   description: |
+	//     Hardware (MAC) address to assign to the virtual function.
+	//   examples:
+	//     - value: >
+	//         "02:00:00:00:00:01"
+	VFHardwareAddr string `yaml:"hardwareAddr,omitempty"`
+	//   description: |
+	//     VLAN ID to assign to the virtual function (transparent VLAN tagging).
+	VFVLAN uint16 `yaml:"vlan,omitempty"`
+	//   description: |
+	//     Whether the virtual function is trusted (allowed to change MAC address, enter promiscuous mode, etc.).
+	VFTrust *bool `yaml:"trust,omitempty"`
+	//   description: |
+	//     Whether the spoof checking is enabled for the virtual function.
+	VFSpoofCheck *bool `yaml:"spoofCheck,omitempty"`
+}
+
+// NewSRIOVConfigV1Alpha1 creates a new SRIOVConfig config document.
+func NewSRIOVConfigV1Alpha1(name string) *SRIOVConfigV1Alpha1 {
+	return &SRIOVConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       SRIOVKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+		MetaName: name,
+	}
+}
+
+func exampleSRIOVConfigV1Alpha1() *SRIOVConfigV1Alpha1 {
+	cfg := NewSRIOVConfigV1Alpha1("enp3s0f0")
+	cfg.NumVFsConfig = 4
+	cfg.VFsConfig = []SRIOVVFConfig{
+		{
+			VFIndex:        0,
+			VFHardwareAddr: "02:00:00:00:00:01",
+			VFVLAN:         100,
+			VFTrust:        pointer.To(true),
+			VFSpoofCheck:   pointer.To(false),
+		},
+	}
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *SRIOVConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Name implements config.NamedDocument interface.
+func (s *SRIOVConfigV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// NumVFs implements config.SRIOVConfig interface.
+func (s *SRIOVConfigV1Alpha1) NumVFs() uint32 {
+	return s.NumVFsConfig
+}
+
+// VFs implements config.SRIOVConfig interface.
+func (s *SRIOVConfigV1Alpha1) VFs() []config.SRIOVVFConfig {
+	result := make([]config.SRIOVVFConfig, 0, len(s.VFsConfig))
+
+	for _, vf := range s.VFsConfig {
+		// validated in Validate
+		mac, _ := net.ParseMAC(vf.VFHardwareAddr) //nolint:errcheck
+
+		result = append(result, config.SRIOVVFConfig{
+			Index:        vf.VFIndex,
+			HardwareAddr: mac,
+			VLAN:         vf.VFVLAN,
+			Trust:        vf.VFTrust,
+			SpoofCheck:   vf.VFSpoofCheck,
+		})
+	}
+
+	return result
+}
+
+// Validate implements config.Validator interface.
+func (s *SRIOVConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	if s.MetaName == "" {
+		return nil, errors.New("name is required")
+	}
+
+	var errs error
+
+	seen := map[uint32]struct{}{}
+
+	for _, vf := range s.VFsConfig {
+		if vf.VFIndex >= s.NumVFsConfig {
+			errs = errors.Join(errs, fmt.Errorf("vf index %d is out of range, numVFs is %d", vf.VFIndex, s.NumVFsConfig))
+		}
+
+		if _, ok := seen[vf.VFIndex]; ok {
+			errs = errors.Join(errs, fmt.Errorf("duplicate vf index %d", vf.VFIndex))
+		}
+
+		seen[vf.VFIndex] = struct{}{}
+
+		if vf.VFHardwareAddr != "" {
+			if _, err := net.ParseMAC(vf.VFHardwareAddr); err != nil {
+				errs = errors.Join(errs, fmt.Errorf("vf %d: invalid hardware address %q: %w", vf.VFIndex, vf.VFHardwareAddr, err))
+			}
+		}
+
+		if vf.VFVLAN > 4094 {
+			errs = errors.Join(errs, fmt.Errorf("vf %d: vlan %d is out of range (0-4094)", vf.VFIndex, vf.VFVLAN))
+		}
+	}
+
+	return nil, errs
+}
@@ -0,0 +1,160 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"net"
+	"testing"
+
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+)
+
+//go:embed testdata/sriovconfig.yaml
+var expectedSRIOVConfigDocument []byte
+
+func TestSRIOVConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewSRIOVConfigV1Alpha1("enp3s0f0")
+	cfg.NumVFsConfig = 2
+	cfg.VFsConfig = []network.SRIOVVFConfig{
+		{
+			VFIndex:        1,
+			VFHardwareAddr: "02:00:00:00:00:02",
+			VFVLAN:         200,
+			VFTrust:        pointer.To(true),
+		},
+	}
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedSRIOVConfigDocument, marshaled)
+}
+
+func TestSRIOVConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedSRIOVConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, &network.SRIOVConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaAPIVersion: "v1alpha1",
+			MetaKind:       network.SRIOVKind,
+		},
+		MetaName:     "enp3s0f0",
+		NumVFsConfig: 2,
+		VFsConfig: []network.SRIOVVFConfig{
+			{
+				VFIndex:        1,
+				VFHardwareAddr: "02:00:00:00:00:02",
+				VFVLAN:         200,
+				VFTrust:        pointer.To(true),
+			},
+		},
+	}, docs[0])
+
+	require.Len(t, provider.SRIOVConfigs(), 1)
+
+	assert.Equal(t, []config.SRIOVVFConfig{
+		{
+			Index:        1,
+			HardwareAddr: net.HardwareAddr{0x02, 0, 0, 0, 0, 0x02},
+			VLAN:         200,
+			Trust:        pointer.To(true),
+		},
+	}, provider.SRIOVConfigs()[0].VFs())
+}
+
+func TestSRIOVValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.SRIOVConfigV1Alpha1
+
+		expectedError    string
+		expectedWarnings []string
+	}{
+		{
+			name: "empty",
+			cfg: func() *network.SRIOVConfigV1Alpha1 {
+				return network.NewSRIOVConfigV1Alpha1("")
+			},
+
+			expectedError: "name is required",
+		},
+		{
+			name: "invalid vfs",
+			cfg: func() *network.SRIOVConfigV1Alpha1 {
+				cfg := network.NewSRIOVConfigV1Alpha1("0000:03:00.0")
+				cfg.NumVFsConfig = 2
+				cfg.VFsConfig = []network.SRIOVVFConfig{
+					{
+						VFIndex:        0,
+						VFHardwareAddr: "foo",
+					},
+					{
+						VFIndex: 0,
+						VFVLAN:  4095,
+					},
+					{
+						VFIndex: 2,
+					},
+				}
+
+				return cfg
+			},
+
+			expectedError: "vf 0: invalid hardware address \"foo\": address foo: invalid MAC address\nduplicate vf index 0\nvf 0: vlan 4095 is out of range (0-4094)\nvf index 2 is out of range, numVFs is 2",
+		},
+		{
+			name: "valid",
+			cfg: func() *network.SRIOVConfigV1Alpha1 {
+				cfg := network.NewSRIOVConfigV1Alpha1("enp3s0f0")
+				cfg.NumVFsConfig = 4
+				cfg.VFsConfig = []network.SRIOVVFConfig{
+					{
+						VFIndex:        3,
+						VFHardwareAddr: "02:00:00:00:00:01",
+						VFVLAN:         100,
+						VFSpoofCheck:   pointer.To(false),
+					},
+				}
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			warnings, err := test.cfg().Validate(validationMode{})
+
+			assert.Equal(t, test.expectedWarnings, warnings)
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,219 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// ContainerdConfigKind is a containerd config document kind.
+const ContainerdConfigKind = "ContainerdConfig"
+
+func init() {
+	registry.Register(ContainerdConfigKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1": //nolint:goconst
+			return &ContainerdConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.ContainerdConfig = &ContainerdConfigV1Alpha1{}
+	_ config.Validator        = &ContainerdConfigV1Alpha1{}
+)
+
+// Keys of the containerd configuration which are managed by Talos, "*" suffix matches any key with the prefix.
+var (
+	containerdCommonOwnedKeys = [][]string{
+		{"version"},
+		{"root"},
+		{"state"},
+		{"imports"},
+		{"grpc", "address"},
+		{"ttrpc", "address"},
+	}
+
+	containerdCRIOwnedKeys = [][]string{
+		{"plugins", "io.containerd.cri.v1.images", "snapshotter"},
+		{"plugins", "io.containerd.cri.v1.images", "runtime_platforms", "*", "snapshotter"},
+		{"plugins", "io.containerd.cri.v1.runtime", "containerd", "runtimes", "*", "snapshotter"},
+		{"plugins", "io.containerd.cri.v1.runtime", "containerd", "runtimes", "*", "runtime_path"},
+		{"plugins", "io.containerd.cri.v1.runtime", "containerd", "runtimes", "*", "base_runtime_spec"},
+		{"plugins", "io.containerd.cri.v1.runtime", "containerd", "runtimes", "*", "options", "BinaryName"},
+	}
+
+	containerdSystemOwnedKeys = [][]string{
+		{"disabled_plugins"},
+		{"plugins", "io.containerd.cri.*"},
+		{"plugins", "io.containerd.grpc.v1.cri"},
+	}
+)
+
+// ContainerdConfigV1Alpha1 is a containerd configuration patch document.
+//
+//	description: |
+//	  Patches are TOML documents merged on top of the containerd configuration generated by Talos.
+//	  Keys managed by Talos (e.g. snapshotter, runtime binary paths) can't be patched.
+//	  Changes are applied by restarting the containerd instance (and the kubelet for the CRI instance).
+//	examples:
+//	  - value: exampleContainerdConfigV1Alpha1()
+//	alias: ContainerdConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/ContainerdConfig
+type ContainerdConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+
+	//   description: |
+	//     TOML patch for the CRI containerd instance configuration.
+	ContainerdCRIConfigPatch string `yaml:"criConfigPatch,omitempty"`
+	//   description: |
+	//     TOML patch for the system containerd instance configuration.
+	//
+	//     CRI plugins can't be configured for the system containerd instance.
+	ContainerdSystemConfigPatch string `yaml:"systemConfigPatch,omitempty"`
+}
+
+// NewContainerdConfigV1Alpha1 creates a new containerd config document.
+func NewContainerdConfigV1Alpha1() *ContainerdConfigV1Alpha1 {
+	return &ContainerdConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       ContainerdConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleContainerdConfigV1Alpha1() *ContainerdConfigV1Alpha1 {
+	cfg := NewContainerdConfigV1Alpha1()
+	cfg.ContainerdCRIConfigPatch = `[plugins."io.containerd.nri.v1.nri"]
+  disable = false
+`
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *ContainerdConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Validate implements config.Validator interface.
+func (s *ContainerdConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	return nil, errors.Join(
+		validateContainerdConfigPatch("criConfigPatch", s.ContainerdCRIConfigPatch, slices.Concat(containerdCommonOwnedKeys, containerdCRIOwnedKeys)),
+		validateContainerdConfigPatch("systemConfigPatch", s.ContainerdSystemConfigPatch, slices.Concat(containerdCommonOwnedKeys, containerdSystemOwnedKeys)),
+	)
+}
+
+// CRIConfigPatch implements config.ContainerdConfig interface.
+func (s *ContainerdConfigV1Alpha1) CRIConfigPatch() string {
+	return s.ContainerdCRIConfigPatch
+}
+
+// SystemConfigPatch implements config.ContainerdConfig interface.
+func (s *ContainerdConfigV1Alpha1) SystemConfigPatch() string {
+	return s.ContainerdSystemConfigPatch
+}
+
+func validateContainerdConfigPatch(field, patch string, ownedKeys [][]string) error {
+	if strings.TrimSpace(patch) == "" {
+		return nil
+	}
+
+	var parsed map[string]any
+
+	if err := toml.Unmarshal([]byte(patch), &parsed); err != nil {
+		return fmt.Errorf("%s: invalid TOML: %w", field, err)
+	}
+
+	var errs []error
+
+	walkTOMLKeys(parsed, nil, func(path []string) {
+		if slices.ContainsFunc(ownedKeys, func(owned []string) bool { return matchTOMLKey(owned, path) }) {
+			errs = append(errs, fmt.Errorf("%s: key %s is managed by Talos", field, formatTOMLKey(path)))
+		}
+	})
+
+	return errors.Join(errs...)
+}
+
+// walkTOMLKeys calls fn for each key in the decoded TOML document, tables are walked recursively.
+func walkTOMLKeys(m map[string]any, prefix []string, fn func(path []string)) {
+	keys := make([]string, 0, len(m))
+
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		path := append(slices.Clone(prefix), key)
+
+		fn(path)
+
+		if table, ok := m[key].(map[string]any); ok {
+			walkTOMLKeys(table, path, fn)
+		}
+	}
+}
+
+// matchTOMLKey matches the key path against a pattern, "*" suffix in the pattern segment is a prefix wildcard.
+func matchTOMLKey(pattern, path []string) bool {
+	if len(pattern) != len(path) {
+		return false
+	}
+
+	for i := range pattern {
+		prefix, isWildcard := strings.CutSuffix(pattern[i], "*")
+
+		if isWildcard {
+			if !strings.HasPrefix(path[i], prefix) {
+				return false
+			}
+
+			continue
+		}
+
+		if pattern[i] != path[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+var bareTOMLKey = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+func formatTOMLKey(path []string) string {
+	segments := make([]string, 0, len(path))
+
+	for _, segment := range path {
+		if bareTOMLKey.MatchString(segment) {
+			segments = append(segments, segment)
+		} else {
+			segments = append(segments, fmt.Sprintf("%q", segment))
+		}
+	}
+
+	return strings.Join(segments, ".")
+}
@@ -0,0 +1,114 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+)
+
+//go:embed testdata/containerdconfig.yaml
+var expectedContainerdConfigDocument []byte
+
+const nriPatch = `[plugins."io.containerd.nri.v1.nri"]
+  disable = false
+`
+
+func TestContainerdConfigMarshalUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	cfg := runtime.NewContainerdConfigV1Alpha1()
+	cfg.ContainerdCRIConfigPatch = nriPatch
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	assert.Equal(t, string(expectedContainerdConfigDocument), string(marshaled))
+
+	provider, err := configloader.NewFromBytes(expectedContainerdConfigDocument)
+	require.NoError(t, err)
+
+	require.NotNil(t, provider.ContainerdConfig())
+	assert.Equal(t, nriPatch, provider.ContainerdConfig().CRIConfigPatch())
+	assert.Empty(t, provider.ContainerdConfig().SystemConfigPatch())
+}
+
+func TestContainerdConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+
+		criPatch    string
+		systemPatch string
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "valid",
+			criPatch: nriPatch + `[plugins."io.containerd.cri.v1.images".registry]
+  config_path = "/var/lib/registries"
+
+[plugins."io.containerd.cri.v1.images".pinned_images]
+  sandbox = "registry.k8s.io/pause:3.10"
+`,
+			systemPatch: `[debug]
+  level = "debug"
+`,
+		},
+		{
+			name:     "invalid TOML",
+			criPatch: `[plugins`,
+
+			expectedError: "criConfigPatch: invalid TOML: toml: ",
+		},
+		{
+			name: "owned keys",
+			criPatch: `version = 2
+
+[plugins."io.containerd.cri.v1.images"]
+  snapshotter = "native"
+
+[plugins."io.containerd.cri.v1.runtime".containerd.runtimes.runc.options]
+  BinaryName = "/usr/local/bin/runc"
+`,
+			systemPatch: `[plugins."io.containerd.cri.v1.runtime"]
+  enable_selinux = true
+`,
+
+			expectedError: "criConfigPatch: key plugins.\"io.containerd.cri.v1.images\".snapshotter is managed by Talos\n" +
+				"criConfigPatch: key plugins.\"io.containerd.cri.v1.runtime\".containerd.runtimes.runc.options.BinaryName is managed by Talos\n" +
+				"criConfigPatch: key version is managed by Talos\n" +
+				"systemConfigPatch: key plugins.\"io.containerd.cri.v1.runtime\" is managed by Talos",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := runtime.NewContainerdConfigV1Alpha1()
+			cfg.ContainerdCRIConfigPatch = test.criPatch
+			cfg.ContainerdSystemConfigPatch = test.systemPatch
+
+			_, err := cfg.Validate(validationMode{})
+
+			if test.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.expectedError)
+			}
+		})
+	}
+}
@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type EventSinkV1Alpha1 -type KmsgLogV1Alpha1 -type WatchdogTimerV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type ContainerdConfigV1Alpha1 -type EventSinkV1Alpha1 -type KmsgLogV1Alpha1 -type WatchdogTimerV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package runtime
 
@@ -10,6 +10,32 @@ import (
 	"net/url"
 )
 
+// DeepCopy generates a deep copy of *ContainerdConfigV1Alpha1.
+func (o *ContainerdConfigV1Alpha1) DeepCopy() *ContainerdConfigV1Alpha1 {
+	var cp ContainerdConfigV1Alpha1 = *o
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *DefaultSecurityProfilesV1Alpha1.
+func (o *DefaultSecurityProfilesV1Alpha1) DeepCopy() *DefaultSecurityProfilesV1Alpha1 {
+	var cp DefaultSecurityProfilesV1Alpha1 = *o
+	if o.SeccompConfig != nil {
+		cp.SeccompConfig = new(SeccompDefaultProfileConfig)
+		*cp.SeccompConfig = *o.SeccompConfig
+	}
+	if o.AppArmorConfig != nil {
+		cp.AppArmorConfig = new(AppArmorDefaultProfileConfig)
+		*cp.AppArmorConfig = *o.AppArmorConfig
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *EventLogV1Alpha1.
+func (o *EventLogV1Alpha1) DeepCopy() *EventLogV1Alpha1 {
+	var cp EventLogV1Alpha1 = *o
+	return &cp
+}
+
 // DeepCopy generates a deep copy of *EventSinkV1Alpha1.
 func (o *EventSinkV1Alpha1) DeepCopy() *EventSinkV1Alpha1 {
 	var cp EventSinkV1Alpha1 = *o
@@ -27,11 +53,47 @@ func (o *KmsgLogV1Alpha1) DeepCopy() *KmsgLogV1Alpha1 {
 			*cp.KmsgLogURL.URL.User = *o.KmsgLogURL.URL.User
 		}
 	}
+	if o.KmsgLogBuffer != nil {
+		cp.KmsgLogBuffer = new(KmsgLogBufferConfig)
+		*cp.KmsgLogBuffer = *o.KmsgLogBuffer
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *ResourceHistoryV1Alpha1.
+func (o *ResourceHistoryV1Alpha1) DeepCopy() *ResourceHistoryV1Alpha1 {
+	var cp ResourceHistoryV1Alpha1 = *o
+	if o.ResourcesConfig != nil {
+		cp.ResourcesConfig = make([]string, len(o.ResourcesConfig))
+		copy(cp.ResourcesConfig, o.ResourcesConfig)
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *ServiceResourcesV1Alpha1.
+func (o *ServiceResourcesV1Alpha1) DeepCopy() *ServiceResourcesV1Alpha1 {
+	var cp ServiceResourcesV1Alpha1 = *o
 	return &cp
 }
 
 // DeepCopy generates a deep copy of *WatchdogTimerV1Alpha1.
 func (o *WatchdogTimerV1Alpha1) DeepCopy() *WatchdogTimerV1Alpha1 {
 	var cp WatchdogTimerV1Alpha1 = *o
+	if o.WatchdogFeed != nil {
+		cp.WatchdogFeed = new(WatchdogFeedConfig)
+		*cp.WatchdogFeed = *o.WatchdogFeed
+		if o.WatchdogFeed.FeedRequireKubelet != nil {
+			cp.WatchdogFeed.FeedRequireKubelet = new(bool)
+			*cp.WatchdogFeed.FeedRequireKubelet = *o.WatchdogFeed.FeedRequireKubelet
+		}
+		if o.WatchdogFeed.FeedProbeURL.URL != nil {
+			cp.WatchdogFeed.FeedProbeURL.URL = new(url.URL)
+			*cp.WatchdogFeed.FeedProbeURL.URL = *o.WatchdogFeed.FeedProbeURL.URL
+			if o.WatchdogFeed.FeedProbeURL.URL.User != nil {
+				cp.WatchdogFeed.FeedProbeURL.URL.User = new(url.Userinfo)
+				*cp.WatchdogFeed.FeedProbeURL.URL.User = *o.WatchdogFeed.FeedProbeURL.URL.User
+			}
+		}
+	}
 	return &cp
 }
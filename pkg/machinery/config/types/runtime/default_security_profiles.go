@@ -0,0 +1,182 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// DefaultSecurityProfilesKind is a default security profiles config document kind.
+const DefaultSecurityProfilesKind = "DefaultSecurityProfilesConfig"
+
+func init() {
+	registry.Register(DefaultSecurityProfilesKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1": //nolint:goconst
+			return &DefaultSecurityProfilesV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.DefaultSecurityProfilesConfig = &DefaultSecurityProfilesV1Alpha1{}
+	_ config.Validator                     = &DefaultSecurityProfilesV1Alpha1{}
+)
+
+// DefaultSecurityProfilesV1Alpha1 configures the default security profiles of the CRI containers.
+//
+//	description: |
+//	  The default profiles are applied to the containers which don't specify a profile in the security context.
+//	  Privileged containers always run unconfined.
+//
+//	  Changes are applied by restarting the CRI containerd instance and the kubelet, running containers keep the profiles
+//	  they were created with.
+//	examples:
+//	  - value: exampleDefaultSecurityProfilesV1Alpha1()
+//	alias: DefaultSecurityProfilesConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/DefaultSecurityProfilesConfig
+type DefaultSecurityProfilesV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+
+	//   description: |
+	//     Default seccomp profile.
+	SeccompConfig *SeccompDefaultProfileConfig `yaml:"seccomp,omitempty"`
+	//   description: |
+	//     Default AppArmor profile.
+	//
+	//     AppArmor profiles are only applied if the kernel has AppArmor enabled.
+	AppArmorConfig *AppArmorDefaultProfileConfig `yaml:"appArmor,omitempty"`
+}
+
+// SeccompDefaultProfileConfig configures the default seccomp profile.
+type SeccompDefaultProfileConfig struct {
+	//   description: |
+	//     Type of the default seccomp profile.
+	//
+	//     RuntimeDefault applies the default profile of the container runtime,
+	//     Localhost applies the custom profile, Unconfined disables the seccomp filtering.
+	//     Defaults to the `machine.kubelet.defaultRuntimeSeccompProfileEnabled` setting.
+	//   values:
+	//     - RuntimeDefault
+	//     - Localhost
+	//     - Unconfined
+	SeccompType config.SecurityProfileType `yaml:"type"`
+	//   description: |
+	//     Custom seccomp profile in the OCI runtime spec JSON format, required for the Localhost type.
+	//
+	//     The profile is also written to the kubelet seccomp directory as `profiles/talos-default.json`,
+	//     so that it can be referenced explicitly by the pods.
+	SeccompProfile string `yaml:"profile,omitempty"`
+}
+
+// AppArmorDefaultProfileConfig configures the default AppArmor profile.
+type AppArmorDefaultProfileConfig struct {
+	//   description: |
+	//     Type of the default AppArmor profile.
+	//
+	//     RuntimeDefault applies the default profile of the container runtime, Unconfined disables AppArmor for the containers.
+	//   values:
+	//     - RuntimeDefault
+	//     - Unconfined
+	AppArmorType config.SecurityProfileType `yaml:"type"`
+}
+
+// NewDefaultSecurityProfilesV1Alpha1 creates a new default security profiles config document.
+func NewDefaultSecurityProfilesV1Alpha1() *DefaultSecurityProfilesV1Alpha1 {
+	return &DefaultSecurityProfilesV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       DefaultSecurityProfilesKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleDefaultSecurityProfilesV1Alpha1() *DefaultSecurityProfilesV1Alpha1 {
+	cfg := NewDefaultSecurityProfilesV1Alpha1()
+	cfg.SeccompConfig = &SeccompDefaultProfileConfig{
+		SeccompType: config.SecurityProfileRuntimeDefault,
+	}
+	cfg.AppArmorConfig = &AppArmorDefaultProfileConfig{
+		AppArmorType: config.SecurityProfileRuntimeDefault,
+	}
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *DefaultSecurityProfilesV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// SeccompProfileType implements config.DefaultSecurityProfilesConfig interface.
+func (s *DefaultSecurityProfilesV1Alpha1) SeccompProfileType() config.SecurityProfileType {
+	if s.SeccompConfig == nil {
+		return ""
+	}
+
+	return s.SeccompConfig.SeccompType
+}
+
+// SeccompLocalhostProfile implements config.DefaultSecurityProfilesConfig interface.
+func (s *DefaultSecurityProfilesV1Alpha1) SeccompLocalhostProfile() string {
+	if s.SeccompConfig == nil || s.SeccompConfig.SeccompType != config.SecurityProfileLocalhost {
+		return ""
+	}
+
+	return s.SeccompConfig.SeccompProfile
+}
+
+// AppArmorProfileType implements config.DefaultSecurityProfilesConfig interface.
+func (s *DefaultSecurityProfilesV1Alpha1) AppArmorProfileType() config.SecurityProfileType {
+	if s.AppArmorConfig == nil {
+		return ""
+	}
+
+	return s.AppArmorConfig.AppArmorType
+}
+
+// Validate implements config.Validator interface.
+func (s *DefaultSecurityProfilesV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var errs []error
+
+	if s.SeccompConfig != nil {
+		switch s.SeccompConfig.SeccompType {
+		case config.SecurityProfileRuntimeDefault, config.SecurityProfileUnconfined:
+			if s.SeccompConfig.SeccompProfile != "" {
+				errs = append(errs, fmt.Errorf("seccomp.profile can only be set for the %s type", config.SecurityProfileLocalhost))
+			}
+		case config.SecurityProfileLocalhost:
+			if err := validateSeccompProfile(s.SeccompConfig.SeccompProfile); err != nil {
+				errs = append(errs, fmt.Errorf("seccomp.profile: %w", err))
+			}
+		default:
+			errs = append(errs, fmt.Errorf("unsupported seccomp.type %q, supported values: %s, %s, %s",
+				s.SeccompConfig.SeccompType, config.SecurityProfileRuntimeDefault, config.SecurityProfileLocalhost, config.SecurityProfileUnconfined))
+		}
+	}
+
+	if s.AppArmorConfig != nil {
+		switch s.AppArmorConfig.AppArmorType {
+		case config.SecurityProfileRuntimeDefault, config.SecurityProfileUnconfined:
+		default:
+			errs = append(errs, fmt.Errorf("unsupported appArmor.type %q, supported values: %s, %s",
+				s.AppArmorConfig.AppArmorType, config.SecurityProfileRuntimeDefault, config.SecurityProfileUnconfined))
+		}
+	}
+
+	return nil, errors.Join(errs...)
+}
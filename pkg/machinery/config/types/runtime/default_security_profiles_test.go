@@ -0,0 +1,166 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+)
+
+//go:embed testdata/defaultsecurityprofilesconfig.yaml
+var expectedDefaultSecurityProfilesConfigDocument []byte
+
+const testSeccompProfile = `{"defaultAction":"SCMP_ACT_ERRNO","syscalls":[{"names":["read","write"],"action":"SCMP_ACT_ALLOW"}]}`
+
+func TestDefaultSecurityProfilesMarshalUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	cfg := runtime.NewDefaultSecurityProfilesV1Alpha1()
+	cfg.SeccompConfig = &runtime.SeccompDefaultProfileConfig{
+		SeccompType:    config.SecurityProfileLocalhost,
+		SeccompProfile: testSeccompProfile,
+	}
+	cfg.AppArmorConfig = &runtime.AppArmorDefaultProfileConfig{
+		AppArmorType: config.SecurityProfileUnconfined,
+	}
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	assert.Equal(t, string(expectedDefaultSecurityProfilesConfigDocument), string(marshaled))
+
+	provider, err := configloader.NewFromBytes(expectedDefaultSecurityProfilesConfigDocument)
+	require.NoError(t, err)
+
+	require.NotNil(t, provider.DefaultSecurityProfilesConfig())
+	assert.Equal(t, config.SecurityProfileLocalhost, provider.DefaultSecurityProfilesConfig().SeccompProfileType())
+	assert.Equal(t, testSeccompProfile, provider.DefaultSecurityProfilesConfig().SeccompLocalhostProfile())
+	assert.Equal(t, config.SecurityProfileUnconfined, provider.DefaultSecurityProfilesConfig().AppArmorProfileType())
+}
+
+func TestDefaultSecurityProfilesValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+
+		seccomp  *runtime.SeccompDefaultProfileConfig
+		appArmor *runtime.AppArmorDefaultProfileConfig
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "runtime default",
+			seccomp: &runtime.SeccompDefaultProfileConfig{
+				SeccompType: config.SecurityProfileRuntimeDefault,
+			},
+			appArmor: &runtime.AppArmorDefaultProfileConfig{
+				AppArmorType: config.SecurityProfileRuntimeDefault,
+			},
+		},
+		{
+			name: "localhost",
+			seccomp: &runtime.SeccompDefaultProfileConfig{
+				SeccompType: config.SecurityProfileLocalhost,
+				SeccompProfile: `{
+  "defaultAction": "SCMP_ACT_ERRNO",
+  "defaultErrnoRet": 1,
+  "architectures": ["SCMP_ARCH_X86_64"],
+  "syscalls": [
+    {"names": ["personality"], "action": "SCMP_ACT_ALLOW", "args": [{"index": 0, "value": 8, "op": "SCMP_CMP_EQ"}]}
+  ]
+}`,
+			},
+		},
+		{
+			name: "unsupported types",
+			seccomp: &runtime.SeccompDefaultProfileConfig{
+				SeccompType: "Default",
+			},
+			appArmor: &runtime.AppArmorDefaultProfileConfig{
+				AppArmorType: config.SecurityProfileLocalhost,
+			},
+
+			expectedError: "unsupported seccomp.type \"Default\", supported values: RuntimeDefault, Localhost, Unconfined\n" +
+				"unsupported appArmor.type \"Localhost\", supported values: RuntimeDefault, Unconfined",
+		},
+		{
+			name: "profile for runtime default",
+			seccomp: &runtime.SeccompDefaultProfileConfig{
+				SeccompType:    config.SecurityProfileRuntimeDefault,
+				SeccompProfile: testSeccompProfile,
+			},
+
+			expectedError: "seccomp.profile can only be set for the Localhost type",
+		},
+		{
+			name: "missing profile",
+			seccomp: &runtime.SeccompDefaultProfileConfig{
+				SeccompType: config.SecurityProfileLocalhost,
+			},
+
+			expectedError: "seccomp.profile: profile is required for the Localhost type",
+		},
+		{
+			name: "invalid JSON",
+			seccomp: &runtime.SeccompDefaultProfileConfig{
+				SeccompType:    config.SecurityProfileLocalhost,
+				SeccompProfile: `{"defaultAction":`,
+			},
+
+			expectedError: "seccomp.profile: invalid profile JSON: unexpected EOF",
+		},
+		{
+			name: "unknown field",
+			seccomp: &runtime.SeccompDefaultProfileConfig{
+				SeccompType:    config.SecurityProfileLocalhost,
+				SeccompProfile: `{"defaultAction":"SCMP_ACT_ERRNO","syscall":[]}`,
+			},
+
+			expectedError: "seccomp.profile: invalid profile JSON: json: unknown field \"syscall\"",
+		},
+		{
+			name: "invalid actions",
+			seccomp: &runtime.SeccompDefaultProfileConfig{
+				SeccompType: config.SecurityProfileLocalhost,
+				SeccompProfile: `{"defaultAction":"SCMP_ACT_DENY","syscalls":[` +
+					`{"names":[],"action":"SCMP_ACT_ALLOW"},` +
+					`{"names":["clone"],"action":"allow","args":[{"index":0,"value":1,"op":"EQ"}]}]}`,
+			},
+
+			expectedError: "seccomp.profile: unsupported defaultAction \"SCMP_ACT_DENY\"\n" +
+				"syscalls[0]: names are required\n" +
+				"syscalls[1]: unsupported action \"allow\"\n" +
+				"syscalls[1].args[0]: unsupported op \"EQ\"",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := runtime.NewDefaultSecurityProfilesV1Alpha1()
+			cfg.SeccompConfig = test.seccomp
+			cfg.AppArmorConfig = test.appArmor
+
+			_, err := cfg.Validate(validationMode{})
+
+			if test.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, test.expectedError)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+//docgen:jsonschema
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/block"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// EventLogKind is an event log config document kind.
+const EventLogKind = "EventLogConfig"
+
+// EventLogSeverities is the list of the event severities, from the least to the most severe.
+var EventLogSeverities = []string{"debug", "info", "warning", "error"}
+
+func init() {
+	registry.Register(EventLogKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1": //nolint:goconst
+			return &EventLogV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.EventLogConfig = &EventLogV1Alpha1{}
+	_ config.Validator      = &EventLogV1Alpha1{}
+)
+
+// EventLogV1Alpha1 is a config document to configure the event log persisted across reboots.
+//
+//	description: |
+//	  Talos persists the machine events on the STATE partition, so that the events preceding an unexpected reboot
+//	  can be replayed with `talosctl events --since-boot=-1`.
+//	  The log is written in batches and rotated once it reaches the size limit.
+//	examples:
+//	  - value: exampleEventLogV1Alpha1()
+//	alias: EventLogConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/EventLogConfig
+type EventLogV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+
+	//   description: |
+	//     Maximum size of the persisted event log, including the rotated file.
+	//
+	//     Size is specified in bytes, but can be expressed in human readable format, e.g. 100MB.
+	//     Defaults to 4MiB, the minimum is 64KiB.
+	//   examples:
+	//     - value: >
+	//         "1MiB"
+	//   schema:
+	//     type: string
+	MaxSizeConfig block.ByteSize `yaml:"maxSize,omitempty"`
+	//   description: |
+	//     Minimum severity of the persisted events.
+	//
+	//     Sequence progress (tasks and phases) and address changes are debug events,
+	//     reboots, shutdowns and certificate expiry warnings are warning events,
+	//     failed services, sequences and upgrades are error events.
+	//     Defaults to info.
+	//   values:
+	//     - debug
+	//     - info
+	//     - warning
+	//     - error
+	MinSeverityConfig string `yaml:"minSeverity,omitempty"`
+}
+
+// NewEventLogV1Alpha1 creates a new EventLogConfig config document.
+func NewEventLogV1Alpha1() *EventLogV1Alpha1 {
+	return &EventLogV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       EventLogKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleEventLogV1Alpha1() *EventLogV1Alpha1 {
+	cfg := NewEventLogV1Alpha1()
+	cfg.MaxSizeConfig = block.MustByteSize("1MiB")
+	cfg.MinSeverityConfig = "warning"
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *EventLogV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// MaxSize implements config.EventLogConfig interface.
+func (s *EventLogV1Alpha1) MaxSize() uint64 {
+	if s.MaxSizeConfig.IsZero() {
+		return constants.EventLogDefaultMaxSize
+	}
+
+	return s.MaxSizeConfig.Value()
+}
+
+// MinSeverity implements config.EventLogConfig interface.
+func (s *EventLogV1Alpha1) MinSeverity() string {
+	if s.MinSeverityConfig == "" {
+		return constants.EventLogDefaultMinSeverity
+	}
+
+	return s.MinSeverityConfig
+}
+
+// Validate implements config.Validator interface.
+func (s *EventLogV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	if !s.MaxSizeConfig.IsZero() && s.MaxSizeConfig.Value() < constants.EventLogMinSize {
+		return nil, fmt.Errorf("maxSize should be at least %d bytes", constants.EventLogMinSize)
+	}
+
+	if s.MinSeverityConfig != "" && !slices.Contains(EventLogSeverities, s.MinSeverityConfig) {
+		return nil, fmt.Errorf("unknown minSeverity %q, supported values: %s", s.MinSeverityConfig, strings.Join(EventLogSeverities, ", "))
+	}
+
+	return nil, nil
+}
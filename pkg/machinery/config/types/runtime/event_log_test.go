@@ -0,0 +1,103 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/block"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+//go:embed testdata/eventlogconfig.yaml
+var expectedEventLogConfigDocument []byte
+
+func TestEventLogMarshalUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	cfg := runtime.NewEventLogV1Alpha1()
+	cfg.MaxSizeConfig = block.MustByteSize("1MiB")
+	cfg.MinSeverityConfig = "warning"
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	assert.Equal(t, string(expectedEventLogConfigDocument), string(marshaled))
+
+	provider, err := configloader.NewFromBytes(expectedEventLogConfigDocument)
+	require.NoError(t, err)
+
+	doc := provider.EventLogConfig()
+	require.NotNil(t, doc)
+
+	assert.Equal(t, uint64(1024*1024), doc.MaxSize())
+	assert.Equal(t, "warning", doc.MinSeverity())
+}
+
+func TestEventLogDefaults(t *testing.T) {
+	t.Parallel()
+
+	cfg := runtime.NewEventLogV1Alpha1()
+
+	assert.Equal(t, uint64(constants.EventLogDefaultMaxSize), cfg.MaxSize())
+	assert.Equal(t, constants.EventLogDefaultMinSeverity, cfg.MinSeverity())
+}
+
+func TestEventLogValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *runtime.EventLogV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg:  runtime.NewEventLogV1Alpha1,
+		},
+		{
+			name: "too small",
+			cfg: func() *runtime.EventLogV1Alpha1 {
+				cfg := runtime.NewEventLogV1Alpha1()
+				cfg.MaxSizeConfig = block.MustByteSize("4KiB")
+
+				return cfg
+			},
+
+			expectedError: "maxSize should be at least 65536 bytes",
+		},
+		{
+			name: "unknown severity",
+			cfg: func() *runtime.EventLogV1Alpha1 {
+				cfg := runtime.NewEventLogV1Alpha1()
+				cfg.MinSeverityConfig = "critical"
+
+				return cfg
+			},
+
+			expectedError: "unknown minSeverity \"critical\", supported values: debug, info, warning, error",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
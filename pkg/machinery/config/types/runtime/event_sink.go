@@ -94,6 +94,11 @@ func (s *EventSinkV1Alpha1) KmsgLogURLs() []*url.URL {
 	return nil
 }
 
+// KmsgLogSyslogURLs implements config.RuntimeConfig interface.
+func (s *EventSinkV1Alpha1) KmsgLogSyslogURLs() []*url.URL {
+	return nil
+}
+
 // WatchdogTimer implements config.RuntimeConfig interface.
 func (s *EventSinkV1Alpha1) WatchdogTimer() config.WatchdogTimerConfig {
 	return nil
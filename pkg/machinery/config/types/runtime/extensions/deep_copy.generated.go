@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type ServiceConfigV1Alpha1 -pointer-receiver -header-file ../../../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type ServiceConfigV1Alpha1 -type VerificationConfigV1Alpha1 -pointer-receiver -header-file ../../../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package extensions
 
@@ -19,3 +19,13 @@ func (o *ServiceConfigV1Alpha1) DeepCopy() *ServiceConfigV1Alpha1 {
 	}
 	return &cp
 }
+
+// DeepCopy generates a deep copy of *VerificationConfigV1Alpha1.
+func (o *VerificationConfigV1Alpha1) DeepCopy() *VerificationConfigV1Alpha1 {
+	var cp VerificationConfigV1Alpha1 = *o
+	if o.ConfigTrustedKeys != nil {
+		cp.ConfigTrustedKeys = make([]TrustedKeyConfig, len(o.ConfigTrustedKeys))
+		copy(cp.ConfigTrustedKeys, o.ConfigTrustedKeys)
+	}
+	return &cp
+}
@@ -78,6 +78,67 @@ func (ConfigFile) Doc() *encoder.Doc {
 	return doc
 }
 
+func (VerificationConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "ExtensionVerificationConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "ExtensionVerificationConfig configures the signature verification of the system extensions." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "ExtensionVerificationConfig configures the signature verification of the system extensions.\nThe system extension images should be signed (cosign-style signature of the image digest) by one of the trusted keys.\nThe policy is enforced by the installer on install and upgrade, the installation fails if any of the system extensions\nbaked into the installer image is not signed by a trusted key.\n\nThe verification results of the installed system extensions are reported by the `ExtensionVerificationStatus` resources.\n",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "trustedKeys",
+				Type:        "[]TrustedKeyConfig",
+				Note:        "",
+				Description: "List of the public keys trusted to sign the system extension images.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "List of the public keys trusted to sign the system extension images." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "insecureNoVerify",
+				Type:        "bool",
+				Note:        "",
+				Description: "Skip the signature verification of the system extensions.\n\nThe verification results are still reported, but the unsigned system extensions are installed.\nThis setting is meant for the development environments only.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Skip the signature verification of the system extensions." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleVerificationConfigV1Alpha1())
+
+	return doc
+}
+
+func (TrustedKeyConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "TrustedKeyConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "TrustedKeyConfig is a public key trusted to sign the system extension images." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "TrustedKeyConfig is a public key trusted to sign the system extension images.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "VerificationConfigV1Alpha1",
+				FieldName: "trustedKeys",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the key, reported as the signer of the system extensions.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the key, reported as the signer of the system extensions." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "publicKey",
+				Type:        "string",
+				Note:        "",
+				Description: "PEM-encoded public key (ECDSA, RSA or Ed25519), e.g. `cosign.pub`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "PEM-encoded public key (ECDSA, RSA or Ed25519), e.g. `cosign.pub`." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	return doc
+}
+
 // GetFileDoc returns documentation for the file extensions_doc.go.
 func GetFileDoc() *encoder.FileDoc {
 	return &encoder.FileDoc{
@@ -86,6 +147,8 @@ func GetFileDoc() *encoder.FileDoc {
 		Structs: []*encoder.Doc{
 			ServiceConfigV1Alpha1{}.Doc(),
 			ConfigFile{}.Doc(),
+			VerificationConfigV1Alpha1{}.Doc(),
+			TrustedKeyConfig{}.Doc(),
 		},
 	}
 }
@@ -0,0 +1,165 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package extensions
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/siderolabs/gen/xslices"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/extensions"
+)
+
+// VerificationConfigKind is a extension verification config document kind.
+const VerificationConfigKind = "ExtensionVerificationConfig"
+
+func init() {
+	registry.Register(VerificationConfigKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &VerificationConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.ExtensionVerificationConfig = &VerificationConfigV1Alpha1{}
+	_ config.Document                    = &VerificationConfigV1Alpha1{}
+	_ config.Validator                   = &VerificationConfigV1Alpha1{}
+)
+
+// VerificationConfigV1Alpha1 configures the signature verification of the system extensions.
+//
+//	description: |
+//	  The system extension images should be signed (cosign-style signature of the image digest) by one of the trusted keys.
+//	  The policy is enforced by the installer on install and upgrade, the installation fails if any of the system extensions
+//	  baked into the installer image is not signed by a trusted key.
+//
+//	  The verification results of the installed system extensions are reported by the `ExtensionVerificationStatus` resources.
+//	examples:
+//	  - value: exampleVerificationConfigV1Alpha1()
+//	alias: ExtensionVerificationConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/ExtensionVerificationConfig
+type VerificationConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+
+	//   description: |
+	//     List of the public keys trusted to sign the system extension images.
+	ConfigTrustedKeys []TrustedKeyConfig `yaml:"trustedKeys,omitempty"`
+	//   description: |
+	//     Skip the signature verification of the system extensions.
+	//
+	//     The verification results are still reported, but the unsigned system extensions are installed.
+	//     This setting is meant for the development environments only.
+	ConfigInsecureNoVerify bool `yaml:"insecureNoVerify,omitempty"`
+}
+
+// TrustedKeyConfig is a public key trusted to sign the system extension images.
+type TrustedKeyConfig struct {
+	//   description: |
+	//     Name of the key, reported as the signer of the system extensions.
+	//   schemaRequired: true
+	KeyName string `yaml:"name"`
+	//   description: |
+	//     PEM-encoded public key (ECDSA, RSA or Ed25519), e.g. `cosign.pub`.
+	//   schemaRequired: true
+	KeyPublicKey string `yaml:"publicKey"`
+}
+
+// NewVerificationConfigV1Alpha1 creates a new extension verification config document.
+func NewVerificationConfigV1Alpha1() *VerificationConfigV1Alpha1 {
+	return &VerificationConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       VerificationConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleVerificationConfigV1Alpha1() *VerificationConfigV1Alpha1 {
+	cfg := NewVerificationConfigV1Alpha1()
+	cfg.ConfigTrustedKeys = []TrustedKeyConfig{
+		{
+			KeyName: "extensions",
+			KeyPublicKey: `-----BEGIN PUBLIC KEY-----
+MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAERqJ6FueQE5+TPq/ra2IX3BwgKtVd
+pCWoAihPCXOrrKSJvXqbZQKGySw5rGPkGtUsfzZu8+R98vgJ8TAiVRChBw==
+-----END PUBLIC KEY-----
+`,
+		},
+	}
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *VerificationConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// TrustedKeys implements config.ExtensionVerificationConfig interface.
+func (s *VerificationConfigV1Alpha1) TrustedKeys() []config.ExtensionTrustedKey {
+	return xslices.Map(s.ConfigTrustedKeys, func(k TrustedKeyConfig) config.ExtensionTrustedKey { return k })
+}
+
+// InsecureNoVerify implements config.ExtensionVerificationConfig interface.
+func (s *VerificationConfigV1Alpha1) InsecureNoVerify() bool {
+	return s.ConfigInsecureNoVerify
+}
+
+// Name implements config.ExtensionTrustedKey interface.
+func (k TrustedKeyConfig) Name() string {
+	return k.KeyName
+}
+
+// PublicKey implements config.ExtensionTrustedKey interface.
+func (k TrustedKeyConfig) PublicKey() string {
+	return k.KeyPublicKey
+}
+
+// Validate implements config.Validator interface.
+func (s *VerificationConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var (
+		errs     []error
+		warnings []string
+	)
+
+	if len(s.ConfigTrustedKeys) == 0 && !s.ConfigInsecureNoVerify {
+		errs = append(errs, errors.New("at least one trusted key is required unless insecureNoVerify is set"))
+	}
+
+	if s.ConfigInsecureNoVerify {
+		warnings = append(warnings, "system extensions signature verification is disabled")
+	}
+
+	names := make(map[string]struct{}, len(s.ConfigTrustedKeys))
+
+	for i, key := range s.ConfigTrustedKeys {
+		if key.KeyName == "" {
+			errs = append(errs, fmt.Errorf("trustedKeys[%d]: name is required", i))
+		} else if _, ok := names[key.KeyName]; ok {
+			errs = append(errs, fmt.Errorf("trustedKeys[%d]: duplicate key name %q", i, key.KeyName))
+		}
+
+		names[key.KeyName] = struct{}{}
+
+		if _, err := extensions.ParsePublicKey([]byte(key.KeyPublicKey)); err != nil {
+			errs = append(errs, fmt.Errorf("trustedKeys[%d]: invalid public key: %w", i, err))
+		}
+	}
+
+	return warnings, errors.Join(errs...)
+}
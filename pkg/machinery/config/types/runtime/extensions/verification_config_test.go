@@ -0,0 +1,139 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package extensions_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/runtime/extensions"
+)
+
+//go:embed testdata/extension_verification_config.yaml
+var expectedExtensionVerificationConfigDocument []byte
+
+const testPublicKey = `-----BEGIN PUBLIC KEY-----
+MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAERqJ6FueQE5+TPq/ra2IX3BwgKtVd
+pCWoAihPCXOrrKSJvXqbZQKGySw5rGPkGtUsfzZu8+R98vgJ8TAiVRChBw==
+-----END PUBLIC KEY-----
+`
+
+func TestExtensionVerificationConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := extensions.NewVerificationConfigV1Alpha1()
+	cfg.ConfigTrustedKeys = []extensions.TrustedKeyConfig{
+		{
+			KeyName:      "extensions",
+			KeyPublicKey: testPublicKey,
+		},
+	}
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedExtensionVerificationConfigDocument, marshaled)
+}
+
+func TestExtensionVerificationConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *extensions.VerificationConfigV1Alpha1
+
+		expectedWarnings []string
+		expectedError    string
+	}{
+		{
+			name: "empty",
+			cfg:  extensions.NewVerificationConfigV1Alpha1,
+
+			expectedError: "at least one trusted key is required unless insecureNoVerify is set",
+		},
+		{
+			name: "insecure",
+			cfg: func() *extensions.VerificationConfigV1Alpha1 {
+				cfg := extensions.NewVerificationConfigV1Alpha1()
+				cfg.ConfigInsecureNoVerify = true
+
+				return cfg
+			},
+
+			expectedWarnings: []string{"system extensions signature verification is disabled"},
+		},
+		{
+			name: "invalid keys",
+			cfg: func() *extensions.VerificationConfigV1Alpha1 {
+				cfg := extensions.NewVerificationConfigV1Alpha1()
+				cfg.ConfigTrustedKeys = []extensions.TrustedKeyConfig{
+					{
+						KeyName:      "foo",
+						KeyPublicKey: testPublicKey,
+					},
+					{
+						KeyName:      "foo",
+						KeyPublicKey: "bar",
+					},
+					{
+						KeyPublicKey: testPublicKey,
+					},
+				}
+
+				return cfg
+			},
+
+			expectedError: "trustedKeys[1]: duplicate key name \"foo\"\ntrustedKeys[1]: invalid public key: no PEM data found\ntrustedKeys[2]: name is required",
+		},
+		{
+			name: "valid",
+			cfg: func() *extensions.VerificationConfigV1Alpha1 {
+				cfg := extensions.NewVerificationConfigV1Alpha1()
+				cfg.ConfigTrustedKeys = []extensions.TrustedKeyConfig{
+					{
+						KeyName:      "extensions",
+						KeyPublicKey: testPublicKey,
+					},
+				}
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			warnings, err := test.cfg().Validate(validationMode{})
+
+			assert.Equal(t, test.expectedWarnings, warnings)
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+type validationMode struct{}
+
+func (validationMode) String() string {
+	return ""
+}
+
+func (validationMode) RequiresInstall() bool {
+	return false
+}
+
+func (validationMode) InContainer() bool {
+	return false
+}
@@ -8,14 +8,18 @@ package runtime
 
 import (
 	"errors"
+	"fmt"
 	"net/url"
+	"time"
 
 	"github.com/siderolabs/gen/ensure"
 
 	"github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/block"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
 	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
 )
 
 // KmsgLogKind is a kmsg log config document kind.
@@ -34,9 +38,10 @@ func init() {
 
 // Check interfaces.
 var (
-	_ config.RuntimeConfig = &KmsgLogV1Alpha1{}
-	_ config.NamedDocument = &KmsgLogV1Alpha1{}
-	_ config.Validator     = &KmsgLogV1Alpha1{}
+	_ config.RuntimeConfig            = &KmsgLogV1Alpha1{}
+	_ config.NamedDocument            = &KmsgLogV1Alpha1{}
+	_ config.KmsgLogDestinationConfig = &KmsgLogV1Alpha1{}
+	_ config.Validator                = &KmsgLogV1Alpha1{}
 )
 
 // KmsgLogV1Alpha1 is a event sink config document.
@@ -54,7 +59,7 @@ type KmsgLogV1Alpha1 struct {
 	MetaName string `yaml:"name"`
 	//   description: |
 	//     The URL encodes the log destination.
-	//     The scheme must be tcp:// or udp://.
+	//     The scheme must be tcp:// or udp://, tls:// is supported with the syslog format.
 	//     The path must be empty.
 	//     The port is required.
 	//   examples:
@@ -62,8 +67,74 @@ type KmsgLogV1Alpha1 struct {
 	//        "udp://10.3.7.3:2810"
 	//   schema:
 	//     type: string
-	//     pattern: "^(tcp|udp)://"
+	//     pattern: "^(tcp|udp|tls)://"
 	KmsgLogURL meta.URL `yaml:"url"`
+	//   description: |
+	//     The format of the kernel log messages sent to the destination.
+	//     Defaults to json_lines.
+	//     The syslog format sends RFC 5424 messages with the kern facility.
+	//   values:
+	//     - json_lines
+	//     - syslog
+	KmsgLogFormat string `yaml:"format,omitempty"`
+	//   description: |
+	//     Buffering and delivery settings of the destination.
+	//
+	//     The kernel log messages are buffered while the destination is slow or unavailable,
+	//     and dropped according to the drop policy once the buffer is full.
+	KmsgLogBuffer *KmsgLogBufferConfig `yaml:"buffer,omitempty"`
+}
+
+// KmsgLogBufferConfig describes buffering and delivery settings of the kernel log destination.
+type KmsgLogBufferConfig struct {
+	//   description: |
+	//     Size of the in-memory buffer.
+	//
+	//     Size is specified in bytes, but can be expressed in human readable format, e.g. 1MiB.
+	//     Defaults to 512KiB, the minimum is 16KiB.
+	//   examples:
+	//     - value: >
+	//         "1MiB"
+	//   schema:
+	//     type: string
+	BufferMemorySize block.ByteSize `yaml:"memorySize,omitempty"`
+	//   description: |
+	//     Size of the on-disk spool.
+	//
+	//     If set, the messages which don't fit into the in-memory buffer are spooled to the EPHEMERAL partition
+	//     (once it is mounted).
+	//     The spool is not preserved across reboots.
+	//     Spool is disabled by default, the minimum size is 64KiB.
+	//   examples:
+	//     - value: >
+	//         "16MiB"
+	//   schema:
+	//     type: string
+	BufferSpoolSize block.ByteSize `yaml:"spoolSize,omitempty"`
+	//   description: |
+	//     Which messages are dropped when the buffer is full.
+	//
+	//     The oldest buffered messages are dropped by default.
+	//   values:
+	//     - oldest
+	//     - newest
+	BufferDropPolicy string `yaml:"dropPolicy,omitempty"`
+	//   description: |
+	//     Initial delay between the delivery attempts, the delay is doubled after each failed attempt.
+	//
+	//     Defaults to 1 second.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	BufferRetryMinBackoff time.Duration `yaml:"retryMinBackoff,omitempty"`
+	//   description: |
+	//     Maximum delay between the delivery attempts.
+	//
+	//     Defaults to 30 seconds.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	BufferRetryMaxBackoff time.Duration `yaml:"retryMaxBackoff,omitempty"`
 }
 
 // NewKmsgLogV1Alpha1 creates a new eventsink config document.
@@ -106,6 +177,19 @@ func (s *KmsgLogV1Alpha1) EventsEndpoint() *string {
 
 // KmsgLogURLs implements config.RuntimeConfig interface.
 func (s *KmsgLogV1Alpha1) KmsgLogURLs() []*url.URL {
+	if s.KmsgLogFormat == constants.LoggingFormatSyslog {
+		return nil
+	}
+
+	return []*url.URL{s.KmsgLogURL.URL}
+}
+
+// KmsgLogSyslogURLs implements config.RuntimeConfig interface.
+func (s *KmsgLogV1Alpha1) KmsgLogSyslogURLs() []*url.URL {
+	if s.KmsgLogFormat != constants.LoggingFormatSyslog {
+		return nil
+	}
+
 	return []*url.URL{s.KmsgLogURL.URL}
 }
 
@@ -114,6 +198,56 @@ func (s *KmsgLogV1Alpha1) WatchdogTimer() config.WatchdogTimerConfig {
 	return nil
 }
 
+// DestinationURL implements config.KmsgLogDestinationConfig interface.
+func (s *KmsgLogV1Alpha1) DestinationURL() *url.URL {
+	return s.KmsgLogURL.URL
+}
+
+// MemoryBufferSize implements config.KmsgLogDestinationConfig interface.
+func (s *KmsgLogV1Alpha1) MemoryBufferSize() uint64 {
+	if s.KmsgLogBuffer == nil || s.KmsgLogBuffer.BufferMemorySize.IsZero() {
+		return constants.KmsgLogDefaultBufferSize
+	}
+
+	return s.KmsgLogBuffer.BufferMemorySize.Value()
+}
+
+// SpoolSize implements config.KmsgLogDestinationConfig interface.
+func (s *KmsgLogV1Alpha1) SpoolSize() uint64 {
+	if s.KmsgLogBuffer == nil {
+		return 0
+	}
+
+	return s.KmsgLogBuffer.BufferSpoolSize.Value()
+}
+
+// DropPolicy implements config.KmsgLogDestinationConfig interface.
+func (s *KmsgLogV1Alpha1) DropPolicy() string {
+	if s.KmsgLogBuffer == nil || s.KmsgLogBuffer.BufferDropPolicy == "" {
+		return constants.KmsgLogDropPolicyOldest
+	}
+
+	return s.KmsgLogBuffer.BufferDropPolicy
+}
+
+// RetryMinBackoff implements config.KmsgLogDestinationConfig interface.
+func (s *KmsgLogV1Alpha1) RetryMinBackoff() time.Duration {
+	if s.KmsgLogBuffer == nil || s.KmsgLogBuffer.BufferRetryMinBackoff == 0 {
+		return constants.KmsgLogDefaultRetryMinBackoff
+	}
+
+	return s.KmsgLogBuffer.BufferRetryMinBackoff
+}
+
+// RetryMaxBackoff implements config.KmsgLogDestinationConfig interface.
+func (s *KmsgLogV1Alpha1) RetryMaxBackoff() time.Duration {
+	if s.KmsgLogBuffer == nil || s.KmsgLogBuffer.BufferRetryMaxBackoff == 0 {
+		return max(constants.KmsgLogDefaultRetryMaxBackoff, s.RetryMinBackoff())
+	}
+
+	return s.KmsgLogBuffer.BufferRetryMaxBackoff
+}
+
 // Validate implements config.Validator interface.
 func (s *KmsgLogV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
 	if s.MetaName == "" {
@@ -124,9 +258,20 @@ func (s *KmsgLogV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option)
 		return nil, errors.New("url is required")
 	}
 
+	switch s.KmsgLogFormat {
+	case "", constants.LoggingFormatJSONLines:
+	case constants.LoggingFormatSyslog:
+	default:
+		return nil, fmt.Errorf("unsupported format %q", s.KmsgLogFormat)
+	}
+
 	switch s.KmsgLogURL.URL.Scheme {
 	case "tcp":
 	case "udp":
+	case "tls":
+		if s.KmsgLogFormat != constants.LoggingFormatSyslog {
+			return nil, errors.New("url scheme tls:// is only supported with syslog format")
+		}
 	default:
 		return nil, errors.New("url scheme must be tcp:// or udp://")
 	}
@@ -142,5 +287,37 @@ func (s *KmsgLogV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option)
 		return nil, errors.New("url port is required")
 	}
 
+	if s.KmsgLogBuffer != nil {
+		if err := s.KmsgLogBuffer.validate(); err != nil {
+			return nil, err
+		}
+	}
+
 	return nil, nil
 }
+
+func (b *KmsgLogBufferConfig) validate() error {
+	if !b.BufferMemorySize.IsZero() && b.BufferMemorySize.Value() < constants.KmsgLogMinBufferSize {
+		return fmt.Errorf("buffer memorySize should be at least %d bytes", constants.KmsgLogMinBufferSize)
+	}
+
+	if !b.BufferSpoolSize.IsZero() && b.BufferSpoolSize.Value() < constants.KmsgLogMinSpoolSize {
+		return fmt.Errorf("buffer spoolSize should be at least %d bytes", constants.KmsgLogMinSpoolSize)
+	}
+
+	switch b.BufferDropPolicy {
+	case "", constants.KmsgLogDropPolicyOldest, constants.KmsgLogDropPolicyNewest:
+	default:
+		return fmt.Errorf("unsupported buffer dropPolicy %q, supported values: %s, %s", b.BufferDropPolicy, constants.KmsgLogDropPolicyOldest, constants.KmsgLogDropPolicyNewest)
+	}
+
+	if b.BufferRetryMinBackoff < 0 || b.BufferRetryMaxBackoff < 0 {
+		return errors.New("buffer retry backoff should be positive")
+	}
+
+	if b.BufferRetryMinBackoff != 0 && b.BufferRetryMaxBackoff != 0 && b.BufferRetryMinBackoff > b.BufferRetryMaxBackoff {
+		return errors.New("buffer retryMinBackoff should not exceed retryMaxBackoff")
+	}
+
+	return nil
+}
@@ -8,13 +8,16 @@ import (
 	_ "embed"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/siderolabs/gen/ensure"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/block"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
 )
 
 //go:embed testdata/kmsglog.yaml
@@ -96,6 +99,109 @@ func TestKmsgLogValidate(t *testing.T) {
 
 			expectedError: "url port is required",
 		},
+		{
+			name: "TLS without syslog",
+			cfg: func() *runtime.KmsgLogV1Alpha1 {
+				cfg := runtime.NewKmsgLogV1Alpha1()
+				cfg.MetaName = "name7"
+				cfg.KmsgLogURL.URL = ensure.Value(url.Parse("tls://10.2.3.4:6514/"))
+
+				return cfg
+			},
+
+			expectedError: "url scheme tls:// is only supported with syslog format",
+		},
+		{
+			name: "unsupported format",
+			cfg: func() *runtime.KmsgLogV1Alpha1 {
+				cfg := runtime.NewKmsgLogV1Alpha1()
+				cfg.MetaName = "name8"
+				cfg.KmsgLogURL.URL = ensure.Value(url.Parse("tcp://10.2.3.4:5000/"))
+				cfg.KmsgLogFormat = "gelf"
+
+				return cfg
+			},
+
+			expectedError: "unsupported format \"gelf\"",
+		},
+		{
+			name: "buffer too small",
+			cfg: func() *runtime.KmsgLogV1Alpha1 {
+				cfg := runtime.NewKmsgLogV1Alpha1()
+				cfg.MetaName = "name10"
+				cfg.KmsgLogURL.URL = ensure.Value(url.Parse("tcp://10.2.3.4:5000/"))
+				cfg.KmsgLogBuffer = &runtime.KmsgLogBufferConfig{
+					BufferMemorySize: block.MustByteSize("1KiB"),
+				}
+
+				return cfg
+			},
+
+			expectedError: "buffer memorySize should be at least 16384 bytes",
+		},
+		{
+			name: "spool too small",
+			cfg: func() *runtime.KmsgLogV1Alpha1 {
+				cfg := runtime.NewKmsgLogV1Alpha1()
+				cfg.MetaName = "name11"
+				cfg.KmsgLogURL.URL = ensure.Value(url.Parse("tcp://10.2.3.4:5000/"))
+				cfg.KmsgLogBuffer = &runtime.KmsgLogBufferConfig{
+					BufferSpoolSize: block.MustByteSize("4KiB"),
+				}
+
+				return cfg
+			},
+
+			expectedError: "buffer spoolSize should be at least 65536 bytes",
+		},
+		{
+			name: "unsupported drop policy",
+			cfg: func() *runtime.KmsgLogV1Alpha1 {
+				cfg := runtime.NewKmsgLogV1Alpha1()
+				cfg.MetaName = "name12"
+				cfg.KmsgLogURL.URL = ensure.Value(url.Parse("tcp://10.2.3.4:5000/"))
+				cfg.KmsgLogBuffer = &runtime.KmsgLogBufferConfig{
+					BufferDropPolicy: "random",
+				}
+
+				return cfg
+			},
+
+			expectedError: "unsupported buffer dropPolicy \"random\", supported values: oldest, newest",
+		},
+		{
+			name: "invalid backoff",
+			cfg: func() *runtime.KmsgLogV1Alpha1 {
+				cfg := runtime.NewKmsgLogV1Alpha1()
+				cfg.MetaName = "name13"
+				cfg.KmsgLogURL.URL = ensure.Value(url.Parse("tcp://10.2.3.4:5000/"))
+				cfg.KmsgLogBuffer = &runtime.KmsgLogBufferConfig{
+					BufferRetryMinBackoff: time.Minute,
+					BufferRetryMaxBackoff: time.Second,
+				}
+
+				return cfg
+			},
+
+			expectedError: "buffer retryMinBackoff should not exceed retryMaxBackoff",
+		},
+		{
+			name: "valid buffer",
+			cfg: func() *runtime.KmsgLogV1Alpha1 {
+				cfg := runtime.NewKmsgLogV1Alpha1()
+				cfg.MetaName = "name14"
+				cfg.KmsgLogURL.URL = ensure.Value(url.Parse("tcp://10.2.3.4:5000/"))
+				cfg.KmsgLogBuffer = &runtime.KmsgLogBufferConfig{
+					BufferMemorySize:      block.MustByteSize("1MiB"),
+					BufferSpoolSize:       block.MustByteSize("16MiB"),
+					BufferDropPolicy:      "newest",
+					BufferRetryMinBackoff: 100 * time.Millisecond,
+					BufferRetryMaxBackoff: 10 * time.Second,
+				}
+
+				return cfg
+			},
+		},
 		{
 			name: "valid TCP",
 			cfg: func() *runtime.KmsgLogV1Alpha1 {
@@ -113,6 +219,17 @@ func TestKmsgLogValidate(t *testing.T) {
 				cfg.MetaName = "name4"
 				cfg.KmsgLogURL.URL = ensure.Value(url.Parse("udp://10.2.3.4:5000/"))
 
+				return cfg
+			},
+		},
+		{
+			name: "valid syslog TLS",
+			cfg: func() *runtime.KmsgLogV1Alpha1 {
+				cfg := runtime.NewKmsgLogV1Alpha1()
+				cfg.MetaName = "name9"
+				cfg.KmsgLogURL.URL = ensure.Value(url.Parse("tls://10.2.3.4:6514/"))
+				cfg.KmsgLogFormat = "syslog"
+
 				return cfg
 			},
 		},
@@ -132,3 +249,24 @@ func TestKmsgLogValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestKmsgLogBufferDefaults(t *testing.T) {
+	t.Parallel()
+
+	cfg := runtime.NewKmsgLogV1Alpha1()
+
+	assert.Equal(t, uint64(constants.KmsgLogDefaultBufferSize), cfg.MemoryBufferSize())
+	assert.Zero(t, cfg.SpoolSize())
+	assert.Equal(t, constants.KmsgLogDropPolicyOldest, cfg.DropPolicy())
+	assert.Equal(t, constants.KmsgLogDefaultRetryMinBackoff, cfg.RetryMinBackoff())
+	assert.Equal(t, constants.KmsgLogDefaultRetryMaxBackoff, cfg.RetryMaxBackoff())
+
+	cfg.KmsgLogBuffer = &runtime.KmsgLogBufferConfig{
+		BufferSpoolSize:       block.MustByteSize("16MiB"),
+		BufferRetryMinBackoff: time.Minute,
+	}
+
+	assert.Equal(t, uint64(16*1024*1024), cfg.SpoolSize())
+	assert.Equal(t, time.Minute, cfg.RetryMinBackoff())
+	assert.Equal(t, time.Minute, cfg.RetryMaxBackoff())
+}
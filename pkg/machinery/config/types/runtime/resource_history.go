@@ -0,0 +1,151 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// ResourceHistoryKind is a resource history config document kind.
+const ResourceHistoryKind = "ResourceHistoryConfig"
+
+// ResourceHistoryDefaultResources is the list of the resource types recorded by default.
+var ResourceHistoryDefaultResources = []string{
+	"MachineConfigs.config.talos.dev",
+	"MachineStatuses.runtime.talos.dev",
+	"AddressSpecs.net.talos.dev",
+	"AddressStatuses.net.talos.dev",
+	"LinkSpecs.net.talos.dev",
+	"LinkStatuses.net.talos.dev",
+	"RouteSpecs.net.talos.dev",
+	"RouteStatuses.net.talos.dev",
+}
+
+func init() {
+	registry.Register(ResourceHistoryKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1": //nolint:goconst
+			return &ResourceHistoryV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.ResourceHistoryConfig = &ResourceHistoryV1Alpha1{}
+	_ config.Validator             = &ResourceHistoryV1Alpha1{}
+)
+
+// ResourceHistoryV1Alpha1 is a config document to enable the in-memory revision history of the resources.
+//
+//	description: |
+//	  When enabled, Talos keeps the last revisions of the selected resource types in memory,
+//	  so that the changes can be inspected with `talosctl get <type> --history` and `talosctl get <type> --diff <from>,<to>`.
+//	  The history is not persisted across reboots.
+//	  Resources holding secrets (e.g. machine configuration) are recorded with the secrets redacted.
+//	examples:
+//	  - value: exampleResourceHistoryV1Alpha1()
+//	alias: ResourceHistoryConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/ResourceHistoryConfig
+type ResourceHistoryV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+
+	//   description: |
+	//     Number of the revisions kept per resource type.
+	//
+	//     Older revisions are evicted once the limit is reached.
+	//     Defaults to 10, the maximum is 100.
+	//   examples:
+	//     - value: 20
+	DepthConfig int `yaml:"depth,omitempty"`
+	//   description: |
+	//     List of the resource types to record the history of (full resource type names or aliases).
+	//
+	//     Defaults to the machine configuration, machine status, and the network address, link and route specs and statuses.
+	//   examples:
+	//     - value: >
+	//         []string{"MachineConfigs.config.talos.dev", "AddressStatuses.net.talos.dev"}
+	ResourcesConfig []string `yaml:"resources,omitempty"`
+}
+
+// NewResourceHistoryV1Alpha1 creates a new ResourceHistoryConfig config document.
+func NewResourceHistoryV1Alpha1() *ResourceHistoryV1Alpha1 {
+	return &ResourceHistoryV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       ResourceHistoryKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleResourceHistoryV1Alpha1() *ResourceHistoryV1Alpha1 {
+	cfg := NewResourceHistoryV1Alpha1()
+	cfg.DepthConfig = 20
+	cfg.ResourcesConfig = []string{"MachineConfigs.config.talos.dev", "AddressStatuses.net.talos.dev"}
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *ResourceHistoryV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Depth implements config.ResourceHistoryConfig interface.
+func (s *ResourceHistoryV1Alpha1) Depth() int {
+	if s.DepthConfig == 0 {
+		return constants.ResourceHistoryDefaultDepth
+	}
+
+	return s.DepthConfig
+}
+
+// Resources implements config.ResourceHistoryConfig interface.
+func (s *ResourceHistoryV1Alpha1) Resources() []string {
+	if len(s.ResourcesConfig) == 0 {
+		return slices.Clone(ResourceHistoryDefaultResources)
+	}
+
+	return s.ResourcesConfig
+}
+
+// Validate implements config.Validator interface.
+func (s *ResourceHistoryV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	if s.DepthConfig < 0 || s.DepthConfig > constants.ResourceHistoryMaxDepth {
+		return nil, fmt.Errorf("depth should be in range [1, %d]", constants.ResourceHistoryMaxDepth)
+	}
+
+	seen := make(map[string]struct{}, len(s.ResourcesConfig))
+
+	for _, resourceType := range s.ResourcesConfig {
+		if resourceType == "" {
+			return nil, errors.New("resource type should not be empty")
+		}
+
+		key := strings.ToLower(resourceType)
+
+		if _, ok := seen[key]; ok {
+			return nil, fmt.Errorf("duplicate resource type %q", resourceType)
+		}
+
+		seen[key] = struct{}{}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,113 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+//go:embed testdata/resourcehistoryconfig.yaml
+var expectedResourceHistoryConfigDocument []byte
+
+func TestResourceHistoryMarshalUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	cfg := runtime.NewResourceHistoryV1Alpha1()
+	cfg.DepthConfig = 20
+	cfg.ResourcesConfig = []string{"MachineConfigs.config.talos.dev", "AddressStatuses.net.talos.dev"}
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	assert.Equal(t, string(expectedResourceHistoryConfigDocument), string(marshaled))
+
+	provider, err := configloader.NewFromBytes(expectedResourceHistoryConfigDocument)
+	require.NoError(t, err)
+
+	doc := provider.ResourceHistoryConfig()
+	require.NotNil(t, doc)
+
+	assert.Equal(t, 20, doc.Depth())
+	assert.Equal(t, []string{"MachineConfigs.config.talos.dev", "AddressStatuses.net.talos.dev"}, doc.Resources())
+}
+
+func TestResourceHistoryDefaults(t *testing.T) {
+	t.Parallel()
+
+	cfg := runtime.NewResourceHistoryV1Alpha1()
+
+	assert.Equal(t, constants.ResourceHistoryDefaultDepth, cfg.Depth())
+	assert.Equal(t, runtime.ResourceHistoryDefaultResources, cfg.Resources())
+}
+
+func TestResourceHistoryValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *runtime.ResourceHistoryV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg:  runtime.NewResourceHistoryV1Alpha1,
+		},
+		{
+			name: "too deep",
+			cfg: func() *runtime.ResourceHistoryV1Alpha1 {
+				cfg := runtime.NewResourceHistoryV1Alpha1()
+				cfg.DepthConfig = 1000
+
+				return cfg
+			},
+
+			expectedError: "depth should be in range [1, 100]",
+		},
+		{
+			name: "empty type",
+			cfg: func() *runtime.ResourceHistoryV1Alpha1 {
+				cfg := runtime.NewResourceHistoryV1Alpha1()
+				cfg.ResourcesConfig = []string{""}
+
+				return cfg
+			},
+
+			expectedError: "resource type should not be empty",
+		},
+		{
+			name: "duplicate type",
+			cfg: func() *runtime.ResourceHistoryV1Alpha1 {
+				cfg := runtime.NewResourceHistoryV1Alpha1()
+				cfg.ResourcesConfig = []string{"AddressStatuses.net.talos.dev", "addressstatuses.net.talos.dev"}
+
+				return cfg
+			},
+
+			expectedError: "duplicate resource type \"addressstatuses.net.talos.dev\"",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
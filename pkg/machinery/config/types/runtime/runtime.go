@@ -5,6 +5,6 @@
 // Package runtime provides runtime machine configuration documents.
 package runtime
 
-//go:generate go tool github.com/siderolabs/talos/tools/docgen -output runtime_doc.go runtime.go kmsg_log.go event_sink.go watchdog_timer.go
+//go:generate go tool github.com/siderolabs/talos/tools/docgen -output runtime_doc.go runtime.go kmsg_log.go event_sink.go watchdog_timer.go containerd_config.go service_resources.go event_log.go default_security_profiles.go resource_history.go
 
-//go:generate go tool github.com/siderolabs/deep-copy -type EventSinkV1Alpha1 -type KmsgLogV1Alpha1 -type WatchdogTimerV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go .
+//go:generate go tool github.com/siderolabs/deep-copy -type ContainerdConfigV1Alpha1 -type DefaultSecurityProfilesV1Alpha1 -type EventLogV1Alpha1 -type EventSinkV1Alpha1 -type KmsgLogV1Alpha1 -type ResourceHistoryV1Alpha1 -type ServiceResourcesV1Alpha1 -type WatchdogTimerV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go .
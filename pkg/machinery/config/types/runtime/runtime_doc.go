@@ -28,9 +28,27 @@ func (KmsgLogV1Alpha1) Doc() *encoder.Doc {
 				Name:        "url",
 				Type:        "URL",
 				Note:        "",
-				Description: "The URL encodes the log destination.\nThe scheme must be tcp:// or udp://.\nThe path must be empty.\nThe port is required.",
+				Description: "The URL encodes the log destination.\nThe scheme must be tcp:// or udp://, tls:// is supported with the syslog format.\nThe path must be empty.\nThe port is required.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "The URL encodes the log destination." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "format",
+				Type:        "string",
+				Note:        "",
+				Description: "The format of the kernel log messages sent to the destination.\nDefaults to json_lines.\nThe syslog format sends RFC 5424 messages with the kern facility.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The format of the kernel log messages sent to the destination." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"json_lines",
+					"syslog",
+				},
+			},
+			{
+				Name:        "buffer",
+				Type:        "KmsgLogBufferConfig",
+				Note:        "",
+				Description: "Buffering and delivery settings of the destination.\n\nThe kernel log messages are buffered while the destination is slow or unavailable,\nand dropped according to the drop policy once the buffer is full.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Buffering and delivery settings of the destination." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
@@ -41,6 +59,66 @@ func (KmsgLogV1Alpha1) Doc() *encoder.Doc {
 	return doc
 }
 
+func (KmsgLogBufferConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "KmsgLogBufferConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "KmsgLogBufferConfig describes buffering and delivery settings of the kernel log destination." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "KmsgLogBufferConfig describes buffering and delivery settings of the kernel log destination.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "KmsgLogV1Alpha1",
+				FieldName: "buffer",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "memorySize",
+				Type:        "ByteSize",
+				Note:        "",
+				Description: "Size of the in-memory buffer.\n\nSize is specified in bytes, but can be expressed in human readable format, e.g. 1MiB.\nDefaults to 512KiB, the minimum is 16KiB.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Size of the in-memory buffer." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "spoolSize",
+				Type:        "ByteSize",
+				Note:        "",
+				Description: "Size of the on-disk spool.\n\nIf set, the messages which don't fit into the in-memory buffer are spooled to the EPHEMERAL partition\n(once it is mounted).\nThe spool is not preserved across reboots.\nSpool is disabled by default, the minimum size is 64KiB.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Size of the on-disk spool." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "dropPolicy",
+				Type:        "string",
+				Note:        "",
+				Description: "Which messages are dropped when the buffer is full.\n\nThe oldest buffered messages are dropped by default.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Which messages are dropped when the buffer is full." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"oldest",
+					"newest",
+				},
+			},
+			{
+				Name:        "retryMinBackoff",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Initial delay between the delivery attempts, the delay is doubled after each failed attempt.\n\nDefaults to 1 second.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Initial delay between the delivery attempts, the delay is doubled after each failed attempt." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "retryMaxBackoff",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Maximum delay between the delivery attempts.\n\nDefaults to 30 seconds.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Maximum delay between the delivery attempts." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", "1MiB")
+	doc.Fields[1].AddExample("", "16MiB")
+
+	return doc
+}
+
 func (EventSinkV1Alpha1) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
 		Type:        "EventSinkConfig",
@@ -85,6 +163,13 @@ func (WatchdogTimerV1Alpha1) Doc() *encoder.Doc {
 				Description: "Timeout for the watchdog.\n\nIf Talos is unresponsive for this duration, the watchdog will reset the system.\n\nDefault value is 1 minute, minimum value is 10 seconds.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Timeout for the watchdog." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "feed",
+				Type:        "WatchdogFeedConfig",
+				Note:        "",
+				Description: "Conditions which should be met to keep feeding the watchdog.\n\nBy default, the watchdog is fed as long as Talos is responsive.\nThe conditions are not enforced while the machine is rebooting, shutting down, resetting or upgrading.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Conditions which should be met to keep feeding the watchdog." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
@@ -95,6 +180,280 @@ func (WatchdogTimerV1Alpha1) Doc() *encoder.Doc {
 	return doc
 }
 
+func (WatchdogFeedConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "WatchdogFeedConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "WatchdogFeedConfig describes the conditions to feed the watchdog." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "WatchdogFeedConfig describes the conditions to feed the watchdog.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "WatchdogTimerV1Alpha1",
+				FieldName: "feed",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "requireKubelet",
+				Type:        "bool",
+				Note:        "",
+				Description: "Require the kubelet to be healthy to feed the watchdog.\n\nThe condition is enforced once the kubelet was healthy since the watchdog was armed,\nso that the machine is not reset while the kubelet is starting.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Require the kubelet to be healthy to feed the watchdog." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "probeURL",
+				Type:        "URL",
+				Note:        "",
+				Description: "URL of the HTTP endpoint which should respond with a 2xx status code to feed the watchdog.\n\nThe condition is enforced once the endpoint responded successfully since the watchdog was armed.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "URL of the HTTP endpoint which should respond with a 2xx status code to feed the watchdog." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[1].AddExample("", "http://127.0.0.1:8080/healthz")
+
+	return doc
+}
+
+func (ContainerdConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "ContainerdConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "ContainerdConfig is a containerd configuration patch document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "ContainerdConfig is a containerd configuration patch document.\nPatches are TOML documents merged on top of the containerd configuration generated by Talos.\nKeys managed by Talos (e.g. snapshotter, runtime binary paths) can't be patched.\nChanges are applied by restarting the containerd instance (and the kubelet for the CRI instance).\n",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "criConfigPatch",
+				Type:        "string",
+				Note:        "",
+				Description: "TOML patch for the CRI containerd instance configuration.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "TOML patch for the CRI containerd instance configuration." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "systemConfigPatch",
+				Type:        "string",
+				Note:        "",
+				Description: "TOML patch for the system containerd instance configuration.\n\nCRI plugins can't be configured for the system containerd instance.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "TOML patch for the system containerd instance configuration." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleContainerdConfigV1Alpha1())
+
+	return doc
+}
+
+func (ServiceResourcesV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "ServiceResourcesConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "ServiceResourcesConfig is a config document to override the memory reservations of a system service." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "ServiceResourcesConfig is a config document to override the memory reservations of a system service.\nTalos protects the memory of the system services with cgroup memory protections, so that\nthe services survive the memory pressure caused by the workloads.\nThis document overrides the default reservation sizes of a single service.\nChanges are applied to the running service and are reported in the ServiceResourcesStatus resource.\n",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the system service.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the system service." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"apid",
+					"containerd",
+					"cri",
+					"etcd",
+					"kubelet",
+					"trustd",
+				},
+			},
+			{
+				Name:        "memoryMin",
+				Type:        "ByteSize",
+				Note:        "",
+				Description: "Hard memory protection of the service (cgroup memory.min).\n\nThe memory of the service is never reclaimed while it is within this limit.\nSize is specified in bytes, but can be expressed in human readable format, e.g. 100MB.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Hard memory protection of the service (cgroup memory.min)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "memoryLow",
+				Type:        "ByteSize",
+				Note:        "",
+				Description: "Soft memory protection of the service (cgroup memory.low).\n\nThe memory of the service is only reclaimed within this limit if there is no unprotected memory to reclaim.\nSize is specified in bytes, but can be expressed in human readable format, e.g. 100MB.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Soft memory protection of the service (cgroup memory.low)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleServiceResourcesV1Alpha1())
+
+	doc.Fields[2].AddExample("", "64MiB")
+	doc.Fields[3].AddExample("", "128MiB")
+
+	return doc
+}
+
+func (EventLogV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "EventLogConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "EventLogConfig is a config document to configure the event log persisted across reboots." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "EventLogConfig is a config document to configure the event log persisted across reboots.\nTalos persists the machine events on the STATE partition, so that the events preceding an unexpected reboot\ncan be replayed with `talosctl events --since-boot=-1`.\nThe log is written in batches and rotated once it reaches the size limit.\n",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "maxSize",
+				Type:        "ByteSize",
+				Note:        "",
+				Description: "Maximum size of the persisted event log, including the rotated file.\n\nSize is specified in bytes, but can be expressed in human readable format, e.g. 100MB.\nDefaults to 4MiB, the minimum is 64KiB.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Maximum size of the persisted event log, including the rotated file." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "minSeverity",
+				Type:        "string",
+				Note:        "",
+				Description: "Minimum severity of the persisted events.\n\nSequence progress (tasks and phases) and address changes are debug events,\nreboots, shutdowns and certificate expiry warnings are warning events,\nfailed services, sequences and upgrades are error events.\nDefaults to info.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Minimum severity of the persisted events." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"debug",
+					"info",
+					"warning",
+					"error",
+				},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleEventLogV1Alpha1())
+
+	doc.Fields[1].AddExample("", "1MiB")
+
+	return doc
+}
+
+func (DefaultSecurityProfilesV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "DefaultSecurityProfilesConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "DefaultSecurityProfilesConfig configures the default security profiles of the CRI containers." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "DefaultSecurityProfilesConfig configures the default security profiles of the CRI containers.\nThe default profiles are applied to the containers which don't specify a profile in the security context.\nPrivileged containers always run unconfined.\n\nChanges are applied by restarting the CRI containerd instance and the kubelet, running containers keep the profiles\nthey were created with.\n",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "seccomp",
+				Type:        "SeccompDefaultProfileConfig",
+				Note:        "",
+				Description: "Default seccomp profile.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Default seccomp profile." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "appArmor",
+				Type:        "AppArmorDefaultProfileConfig",
+				Note:        "",
+				Description: "Default AppArmor profile.\n\nAppArmor profiles are only applied if the kernel has AppArmor enabled.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Default AppArmor profile." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleDefaultSecurityProfilesV1Alpha1())
+
+	return doc
+}
+
+func (SeccompDefaultProfileConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "SeccompDefaultProfileConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "SeccompDefaultProfileConfig configures the default seccomp profile." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "SeccompDefaultProfileConfig configures the default seccomp profile.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "DefaultSecurityProfilesV1Alpha1",
+				FieldName: "seccomp",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "type",
+				Type:        "SecurityProfileType",
+				Note:        "",
+				Description: "Type of the default seccomp profile.\n\nRuntimeDefault applies the default profile of the container runtime,\nLocalhost applies the custom profile, Unconfined disables the seccomp filtering.\nDefaults to the `machine.kubelet.defaultRuntimeSeccompProfileEnabled` setting.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Type of the default seccomp profile." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"RuntimeDefault",
+					"Localhost",
+					"Unconfined",
+				},
+			},
+			{
+				Name:        "profile",
+				Type:        "string",
+				Note:        "",
+				Description: "Custom seccomp profile in the OCI runtime spec JSON format, required for the Localhost type.\n\nThe profile is also written to the kubelet seccomp directory as `profiles/talos-default.json`,\nso that it can be referenced explicitly by the pods.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Custom seccomp profile in the OCI runtime spec JSON format, required for the Localhost type." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	return doc
+}
+
+func (AppArmorDefaultProfileConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "AppArmorDefaultProfileConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "AppArmorDefaultProfileConfig configures the default AppArmor profile." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "AppArmorDefaultProfileConfig configures the default AppArmor profile.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "DefaultSecurityProfilesV1Alpha1",
+				FieldName: "appArmor",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "type",
+				Type:        "SecurityProfileType",
+				Note:        "",
+				Description: "Type of the default AppArmor profile.\n\nRuntimeDefault applies the default profile of the container runtime, Unconfined disables AppArmor for the containers.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Type of the default AppArmor profile." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"RuntimeDefault",
+					"Unconfined",
+				},
+			},
+		},
+	}
+
+	return doc
+}
+
+func (ResourceHistoryV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "ResourceHistoryConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "ResourceHistoryConfig is a config document to enable the in-memory revision history of the resources." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "ResourceHistoryConfig is a config document to enable the in-memory revision history of the resources.\nWhen enabled, Talos keeps the last revisions of the selected resource types in memory,\nso that the changes can be inspected with `talosctl get <type> --history` and `talosctl get <type> --diff <from>,<to>`.\nThe history is not persisted across reboots.\nResources holding secrets (e.g. machine configuration) are recorded with the secrets redacted.\n",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "depth",
+				Type:        "int",
+				Note:        "",
+				Description: "Number of the revisions kept per resource type.\n\nOlder revisions are evicted once the limit is reached.\nDefaults to 10, the maximum is 100.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Number of the revisions kept per resource type." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "resources",
+				Type:        "[]string",
+				Note:        "",
+				Description: "List of the resource types to record the history of (full resource type names or aliases).\n\nDefaults to the machine configuration, machine status, and the network address, link and route specs and statuses.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "List of the resource types to record the history of (full resource type names or aliases)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleResourceHistoryV1Alpha1())
+
+	doc.Fields[1].AddExample("", 20)
+	doc.Fields[2].AddExample("", []string{"MachineConfigs.config.talos.dev", "AddressStatuses.net.talos.dev"})
+
+	return doc
+}
+
 // GetFileDoc returns documentation for the file runtime_doc.go.
 func GetFileDoc() *encoder.FileDoc {
 	return &encoder.FileDoc{
@@ -102,8 +461,17 @@ func GetFileDoc() *encoder.FileDoc {
 		Description: "Package runtime provides runtime machine configuration documents.\n",
 		Structs: []*encoder.Doc{
 			KmsgLogV1Alpha1{}.Doc(),
+			KmsgLogBufferConfig{}.Doc(),
 			EventSinkV1Alpha1{}.Doc(),
 			WatchdogTimerV1Alpha1{}.Doc(),
+			WatchdogFeedConfig{}.Doc(),
+			ContainerdConfigV1Alpha1{}.Doc(),
+			ServiceResourcesV1Alpha1{}.Doc(),
+			EventLogV1Alpha1{}.Doc(),
+			DefaultSecurityProfilesV1Alpha1{}.Doc(),
+			SeccompDefaultProfileConfig{}.Doc(),
+			AppArmorDefaultProfileConfig{}.Doc(),
+			ResourceHistoryV1Alpha1{}.Doc(),
 		},
 	}
 }
@@ -0,0 +1,111 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+)
+
+// seccompProfile is the seccomp section of the OCI runtime spec.
+type seccompProfile struct {
+	DefaultAction    string           `json:"defaultAction"`
+	DefaultErrnoRet  *uint            `json:"defaultErrnoRet,omitempty"`
+	Architectures    []string         `json:"architectures,omitempty"`
+	Flags            []string         `json:"flags,omitempty"`
+	ListenerPath     string           `json:"listenerPath,omitempty"`
+	ListenerMetadata string           `json:"listenerMetadata,omitempty"`
+	Syscalls         []seccompSyscall `json:"syscalls,omitempty"`
+}
+
+type seccompSyscall struct {
+	Names    []string     `json:"names"`
+	Action   string       `json:"action"`
+	ErrnoRet *uint        `json:"errnoRet,omitempty"`
+	Args     []seccompArg `json:"args,omitempty"`
+}
+
+type seccompArg struct {
+	Index    uint   `json:"index"`
+	Value    uint64 `json:"value"`
+	ValueTwo uint64 `json:"valueTwo,omitempty"`
+	Op       string `json:"op"`
+}
+
+var (
+	seccompActions = []string{
+		"SCMP_ACT_KILL",
+		"SCMP_ACT_KILL_PROCESS",
+		"SCMP_ACT_KILL_THREAD",
+		"SCMP_ACT_TRAP",
+		"SCMP_ACT_ERRNO",
+		"SCMP_ACT_TRACE",
+		"SCMP_ACT_ALLOW",
+		"SCMP_ACT_LOG",
+		"SCMP_ACT_NOTIFY",
+	}
+
+	seccompOperators = []string{
+		"SCMP_CMP_NE",
+		"SCMP_CMP_LT",
+		"SCMP_CMP_LE",
+		"SCMP_CMP_EQ",
+		"SCMP_CMP_GE",
+		"SCMP_CMP_GT",
+		"SCMP_CMP_MASKED_EQ",
+	}
+)
+
+// validateSeccompProfile checks that the profile is a valid seccomp section of the OCI runtime spec.
+//
+// Unknown fields are rejected, as the runtime silently ignores them, so a typo would weaken the profile.
+func validateSeccompProfile(profile string) error {
+	if strings.TrimSpace(profile) == "" {
+		return fmt.Errorf("profile is required for the %s type", config.SecurityProfileLocalhost)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader([]byte(profile)))
+	decoder.DisallowUnknownFields()
+
+	var parsed seccompProfile
+
+	if err := decoder.Decode(&parsed); err != nil {
+		return fmt.Errorf("invalid profile JSON: %w", err)
+	}
+
+	if decoder.More() {
+		return errors.New("invalid profile JSON: unexpected data after the profile")
+	}
+
+	var errs []error
+
+	if !slices.Contains(seccompActions, parsed.DefaultAction) {
+		errs = append(errs, fmt.Errorf("unsupported defaultAction %q", parsed.DefaultAction))
+	}
+
+	for i, syscall := range parsed.Syscalls {
+		if len(syscall.Names) == 0 {
+			errs = append(errs, fmt.Errorf("syscalls[%d]: names are required", i))
+		}
+
+		if !slices.Contains(seccompActions, syscall.Action) {
+			errs = append(errs, fmt.Errorf("syscalls[%d]: unsupported action %q", i, syscall.Action))
+		}
+
+		for j, arg := range syscall.Args {
+			if !slices.Contains(seccompOperators, arg.Op) {
+				errs = append(errs, fmt.Errorf("syscalls[%d].args[%d]: unsupported op %q", i, j, arg.Op))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,160 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/siderolabs/gen/optional"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/block"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// ServiceResourcesConfigKind is a service resources config document kind.
+const ServiceResourcesConfigKind = "ServiceResourcesConfig"
+
+// ServiceResourcesServices is the list of system services which support resource reservation overrides.
+var ServiceResourcesServices = []string{"apid", "containerd", "cri", "etcd", "kubelet", "trustd"}
+
+func init() {
+	registry.Register(ServiceResourcesConfigKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1": //nolint:goconst
+			return &ServiceResourcesV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.ServiceResourcesConfig = &ServiceResourcesV1Alpha1{}
+	_ config.NamedDocument          = &ServiceResourcesV1Alpha1{}
+	_ config.Validator              = &ServiceResourcesV1Alpha1{}
+)
+
+// ServiceResourcesV1Alpha1 is a config document to override the memory reservations of a system service.
+//
+//	description: |
+//	  Talos protects the memory of the system services with cgroup memory protections, so that
+//	  the services survive the memory pressure caused by the workloads.
+//	  This document overrides the default reservation sizes of a single service.
+//	  Changes are applied to the running service and are reported in the ServiceResourcesStatus resource.
+//	examples:
+//	  - value: exampleServiceResourcesV1Alpha1()
+//	alias: ServiceResourcesConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/ServiceResourcesConfig
+type ServiceResourcesV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+
+	//   description: |
+	//     Name of the system service.
+	//   values:
+	//     - apid
+	//     - containerd
+	//     - cri
+	//     - etcd
+	//     - kubelet
+	//     - trustd
+	//   schemaRequired: true
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     Hard memory protection of the service (cgroup memory.min).
+	//
+	//     The memory of the service is never reclaimed while it is within this limit.
+	//     Size is specified in bytes, but can be expressed in human readable format, e.g. 100MB.
+	//   examples:
+	//     - value: >
+	//         "64MiB"
+	//   schema:
+	//     type: string
+	MemoryMinSize block.ByteSize `yaml:"memoryMin,omitempty"`
+	//   description: |
+	//     Soft memory protection of the service (cgroup memory.low).
+	//
+	//     The memory of the service is only reclaimed within this limit if there is no unprotected memory to reclaim.
+	//     Size is specified in bytes, but can be expressed in human readable format, e.g. 100MB.
+	//   examples:
+	//     - value: >
+	//         "128MiB"
+	//   schema:
+	//     type: string
+	MemoryLowSize block.ByteSize `yaml:"memoryLow,omitempty"`
+}
+
+// NewServiceResourcesV1Alpha1 creates a new ServiceResourcesConfig config document.
+func NewServiceResourcesV1Alpha1(name string) *ServiceResourcesV1Alpha1 {
+	return &ServiceResourcesV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       ServiceResourcesConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+		MetaName: name,
+	}
+}
+
+func exampleServiceResourcesV1Alpha1() *ServiceResourcesV1Alpha1 {
+	cfg := NewServiceResourcesV1Alpha1("apid")
+	cfg.MemoryMinSize = block.MustByteSize("64MiB")
+	cfg.MemoryLowSize = block.MustByteSize("128MiB")
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *ServiceResourcesV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Name implements config.NamedDocument interface.
+func (s *ServiceResourcesV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// MemoryMin implements config.ServiceResourcesConfig interface.
+func (s *ServiceResourcesV1Alpha1) MemoryMin() optional.Optional[uint64] {
+	if s.MemoryMinSize.IsZero() {
+		return optional.None[uint64]()
+	}
+
+	return optional.Some(s.MemoryMinSize.Value())
+}
+
+// MemoryLow implements config.ServiceResourcesConfig interface.
+func (s *ServiceResourcesV1Alpha1) MemoryLow() optional.Optional[uint64] {
+	if s.MemoryLowSize.IsZero() {
+		return optional.None[uint64]()
+	}
+
+	return optional.Some(s.MemoryLowSize.Value())
+}
+
+// Validate implements config.Validator interface.
+func (s *ServiceResourcesV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	if s.MetaName == "" {
+		return nil, errors.New("name is required")
+	}
+
+	if !slices.Contains(ServiceResourcesServices, s.MetaName) {
+		return nil, fmt.Errorf("service %q doesn't support resource overrides, supported services: %s", s.MetaName, strings.Join(ServiceResourcesServices, ", "))
+	}
+
+	if !s.MemoryMinSize.IsZero() && !s.MemoryLowSize.IsZero() && s.MemoryLowSize.Value() < s.MemoryMinSize.Value() {
+		return nil, errors.New("memoryLow should be greater than or equal to memoryMin")
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,105 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/block"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+)
+
+//go:embed testdata/serviceresourcesconfig.yaml
+var expectedServiceResourcesConfigDocument []byte
+
+func TestServiceResourcesMarshalUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	cfg := runtime.NewServiceResourcesV1Alpha1("apid")
+	cfg.MemoryMinSize = block.MustByteSize("64MiB")
+	cfg.MemoryLowSize = block.MustByteSize("128MiB")
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	assert.Equal(t, string(expectedServiceResourcesConfigDocument), string(marshaled))
+
+	provider, err := configloader.NewFromBytes(expectedServiceResourcesConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.ServiceResourcesConfigs()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, "apid", docs[0].Name())
+	assert.Equal(t, uint64(64*1024*1024), docs[0].MemoryMin().ValueOrZero())
+	assert.Equal(t, uint64(128*1024*1024), docs[0].MemoryLow().ValueOrZero())
+}
+
+func TestServiceResourcesValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *runtime.ServiceResourcesV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg: func() *runtime.ServiceResourcesV1Alpha1 {
+				return runtime.NewServiceResourcesV1Alpha1("")
+			},
+
+			expectedError: "name is required",
+		},
+		{
+			name: "unsupported service",
+			cfg: func() *runtime.ServiceResourcesV1Alpha1 {
+				return runtime.NewServiceResourcesV1Alpha1("udevd")
+			},
+
+			expectedError: "service \"udevd\" doesn't support resource overrides, supported services: apid, containerd, cri, etcd, kubelet, trustd",
+		},
+		{
+			name: "low below min",
+			cfg: func() *runtime.ServiceResourcesV1Alpha1 {
+				cfg := runtime.NewServiceResourcesV1Alpha1("etcd")
+				cfg.MemoryMinSize = block.MustByteSize("256MiB")
+				cfg.MemoryLowSize = block.MustByteSize("128MiB")
+
+				return cfg
+			},
+
+			expectedError: "memoryLow should be greater than or equal to memoryMin",
+		},
+		{
+			name: "min only",
+			cfg: func() *runtime.ServiceResourcesV1Alpha1 {
+				cfg := runtime.NewServiceResourcesV1Alpha1("kubelet")
+				cfg.MemoryMinSize = block.MustByteSize("256MiB")
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
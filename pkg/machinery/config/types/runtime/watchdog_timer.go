@@ -11,6 +11,8 @@ import (
 	"net/url"
 	"time"
 
+	"github.com/siderolabs/go-pointer"
+
 	"github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
@@ -69,6 +71,33 @@ type WatchdogTimerV1Alpha1 struct {
 	//     type: string
 	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
 	WatchdogTimeout time.Duration `yaml:"timeout,omitempty"`
+	//   description: |
+	//     Conditions which should be met to keep feeding the watchdog.
+	//
+	//     By default, the watchdog is fed as long as Talos is responsive.
+	//     The conditions are not enforced while the machine is rebooting, shutting down, resetting or upgrading.
+	WatchdogFeed *WatchdogFeedConfig `yaml:"feed,omitempty"`
+}
+
+// WatchdogFeedConfig describes the conditions to feed the watchdog.
+type WatchdogFeedConfig struct {
+	//   description: |
+	//     Require the kubelet to be healthy to feed the watchdog.
+	//
+	//     The condition is enforced once the kubelet was healthy since the watchdog was armed,
+	//     so that the machine is not reset while the kubelet is starting.
+	FeedRequireKubelet *bool `yaml:"requireKubelet,omitempty"`
+	//   description: |
+	//     URL of the HTTP endpoint which should respond with a 2xx status code to feed the watchdog.
+	//
+	//     The condition is enforced once the endpoint responded successfully since the watchdog was armed.
+	//   examples:
+	//     - value: >
+	//        "http://127.0.0.1:8080/healthz"
+	//   schema:
+	//     type: string
+	//     pattern: "^https?://"
+	FeedProbeURL meta.URL `yaml:"probeURL,omitempty"`
 }
 
 // NewWatchdogTimerV1Alpha1 creates a new eventsink config document.
@@ -85,6 +114,9 @@ func exampleWatchdogTimerV1Alpha1() *WatchdogTimerV1Alpha1 {
 	cfg := NewWatchdogTimerV1Alpha1()
 	cfg.WatchdogDevice = "/dev/watchdog0"
 	cfg.WatchdogTimeout = 2 * time.Minute
+	cfg.WatchdogFeed = &WatchdogFeedConfig{
+		FeedRequireKubelet: pointer.To(true),
+	}
 
 	return cfg
 }
@@ -109,6 +141,11 @@ func (s *WatchdogTimerV1Alpha1) KmsgLogURLs() []*url.URL {
 	return nil
 }
 
+// KmsgLogSyslogURLs implements config.RuntimeConfig interface.
+func (s *WatchdogTimerV1Alpha1) KmsgLogSyslogURLs() []*url.URL {
+	return nil
+}
+
 // WatchdogTimer implements config.RuntimeConfig interface.
 func (s *WatchdogTimerV1Alpha1) WatchdogTimer() config.WatchdogTimerConfig {
 	return s
@@ -128,6 +165,24 @@ func (s *WatchdogTimerV1Alpha1) Timeout() time.Duration {
 	return s.WatchdogTimeout
 }
 
+// RequireKubelet implements config.WatchdogTimerConfig interface.
+func (s *WatchdogTimerV1Alpha1) RequireKubelet() bool {
+	if s.WatchdogFeed == nil {
+		return false
+	}
+
+	return pointer.SafeDeref(s.WatchdogFeed.FeedRequireKubelet)
+}
+
+// ProbeURL implements config.WatchdogTimerConfig interface.
+func (s *WatchdogTimerV1Alpha1) ProbeURL() string {
+	if s.WatchdogFeed == nil || s.WatchdogFeed.FeedProbeURL.URL == nil {
+		return ""
+	}
+
+	return s.WatchdogFeed.FeedProbeURL.URL.String()
+}
+
 // Validate implements config.Validator interface.
 func (s *WatchdogTimerV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
 	if s.WatchdogDevice == "" {
@@ -138,5 +193,11 @@ func (s *WatchdogTimerV1Alpha1) Validate(validation.RuntimeMode, ...validation.O
 		return nil, fmt.Errorf("watchdog timeout: minimum value is %s", MinWatchdogTimeout)
 	}
 
+	if s.WatchdogFeed != nil && s.WatchdogFeed.FeedProbeURL.URL != nil {
+		if scheme := s.WatchdogFeed.FeedProbeURL.URL.Scheme; scheme != "http" && scheme != "https" {
+			return nil, fmt.Errorf("watchdog feed probe URL: unsupported scheme %q, expected http or https", scheme)
+		}
+	}
+
 	return nil, nil
 }
@@ -6,9 +6,11 @@ package runtime_test
 
 import (
 	_ "embed"
+	"net/url"
 	"testing"
 	"time"
 
+	"github.com/siderolabs/gen/ensure"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -72,6 +74,19 @@ func TestWatchdogTimerValidate(t *testing.T) {
 
 			expectedError: "watchdog timeout: minimum value is 10s",
 		},
+		{
+			name: "invalid probe URL",
+			cfg: func() *runtime.WatchdogTimerV1Alpha1 {
+				cfg := runtime.NewWatchdogTimerV1Alpha1()
+				cfg.WatchdogDevice = "/dev/watchdog1"
+				cfg.WatchdogFeed = &runtime.WatchdogFeedConfig{}
+				cfg.WatchdogFeed.FeedProbeURL.URL = ensure.Value(url.Parse("tcp://127.0.0.1:8080"))
+
+				return cfg
+			},
+
+			expectedError: "watchdog feed probe URL: unsupported scheme \"tcp\", expected http or https",
+		},
 		{
 			name: "valid",
 			cfg: func() *runtime.WatchdogTimerV1Alpha1 {
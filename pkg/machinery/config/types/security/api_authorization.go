@@ -0,0 +1,244 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package security
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/role"
+)
+
+// APIAuthorizationConfig is an API authorization config document kind.
+const APIAuthorizationConfig = "APIAuthorizationConfig"
+
+func init() {
+	registry.Register(APIAuthorizationConfig, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &APIAuthorizationConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.APIAuthorizationConfig = &APIAuthorizationConfigV1Alpha1{}
+	_ config.APIAuthorizationRule   = APIAuthorizationRule{}
+	_ config.Validator              = &APIAuthorizationConfigV1Alpha1{}
+)
+
+// APIAuthorizationConfigV1Alpha1 configures the authorization policy of the Talos API.
+//
+//	description: |
+//	  The policy grants access to the Talos API methods to the roles (client certificate organizations).
+//	  Custom roles (the ones without the `os:` prefix) are denied access unless allowed by the policy.
+//	  Built-in roles keep their permissions unless a rule for the role applies to the node,
+//	  in which case the role is allowed to call only the methods listed in the rules.
+//	  `os:admin` and `os:impersonator` roles can't be restricted.
+//
+//	  The policy is enforced by each node using its own machine configuration,
+//	  so the document should be applied to all nodes the rules should apply to.
+//	examples:
+//	  - value: exampleAPIAuthorizationConfigV1Alpha1()
+//	alias: APIAuthorizationConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/APIAuthorizationConfig
+type APIAuthorizationConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+
+	//   description: |
+	//     List of authorization rules.
+	ConfigRules []APIAuthorizationRule `yaml:"rules"`
+}
+
+// APIAuthorizationRule grants access to the Talos API methods to the roles.
+type APIAuthorizationRule struct {
+	//   description: |
+	//     List of roles (client certificate organizations) the rule applies to.
+	//   examples:
+	//     - value: >
+	//         []string{"ops:rebooter"}
+	RuleRoles []string `yaml:"roles"`
+	//   description: |
+	//     List of full gRPC method name patterns allowed by the rule.
+	//
+	//     Patterns use shell glob syntax, e.g. `/machine.MachineService/*`.
+	//   examples:
+	//     - value: >
+	//         []string{"/machine.MachineService/Reboot", "/machine.MachineService/Dmesg"}
+	RuleMethods []string `yaml:"methods"`
+	//   description: |
+	//     Selects the nodes the rule applies to, by default the rule applies to any node.
+	RuleNodeSelector *APIAuthorizationNodeSelector `yaml:"nodeSelector,omitempty"`
+}
+
+// APIAuthorizationNodeSelector selects the nodes the authorization rule applies to.
+type APIAuthorizationNodeSelector struct {
+	//   description: |
+	//     List of machine types the rule applies to.
+	//   values:
+	//     - controlplane
+	//     - worker
+	//   examples:
+	//     - value: >
+	//         []string{"worker"}
+	SelectorMachineTypes []string `yaml:"machineTypes,omitempty"`
+	//   description: |
+	//     Node labels (from `.machine.nodeLabels`) which should be all set on the node for the rule to apply.
+	SelectorNodeLabels map[string]string `yaml:"nodeLabels,omitempty"`
+}
+
+// NewAPIAuthorizationConfigV1Alpha1 creates a new APIAuthorizationConfig config document.
+func NewAPIAuthorizationConfigV1Alpha1() *APIAuthorizationConfigV1Alpha1 {
+	return &APIAuthorizationConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       APIAuthorizationConfig,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleAPIAuthorizationConfigV1Alpha1() *APIAuthorizationConfigV1Alpha1 {
+	cfg := NewAPIAuthorizationConfigV1Alpha1()
+	cfg.ConfigRules = []APIAuthorizationRule{
+		{
+			RuleRoles:   []string{"ops:rebooter"},
+			RuleMethods: []string{"/machine.MachineService/Reboot", "/machine.MachineService/Dmesg"},
+			RuleNodeSelector: &APIAuthorizationNodeSelector{
+				SelectorMachineTypes: []string{"worker"},
+			},
+		},
+	}
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *APIAuthorizationConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Validate implements config.Validator interface.
+//
+//nolint:gocyclo
+func (s *APIAuthorizationConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var errs error
+
+	for i, rule := range s.ConfigRules {
+		if len(rule.RuleRoles) == 0 {
+			errs = errors.Join(errs, fmt.Errorf("rules[%d].roles should not be empty", i))
+		}
+
+		for _, r := range rule.RuleRoles {
+			switch {
+			case strings.TrimSpace(r) == "":
+				errs = errors.Join(errs, fmt.Errorf("rules[%d].roles: empty role", i))
+			case r == string(role.Admin) || r == string(role.Impersonator):
+				errs = errors.Join(errs, fmt.Errorf("rules[%d].roles: role %q can't be restricted", i, r))
+			case strings.HasPrefix(r, role.Prefix) && !role.All.Includes(role.Role(r)):
+				errs = errors.Join(errs, fmt.Errorf("rules[%d].roles: unknown built-in role %q", i, r))
+			}
+		}
+
+		if len(rule.RuleMethods) == 0 {
+			errs = errors.Join(errs, fmt.Errorf("rules[%d].methods should not be empty", i))
+		}
+
+		for _, pattern := range rule.RuleMethods {
+			if !strings.HasPrefix(pattern, "/") {
+				errs = errors.Join(errs, fmt.Errorf("rules[%d].methods: pattern %q should be a full gRPC method name starting with '/'", i, pattern))
+
+				continue
+			}
+
+			if _, err := path.Match(pattern, ""); err != nil {
+				errs = errors.Join(errs, fmt.Errorf("rules[%d].methods: invalid pattern %q", i, pattern))
+			}
+		}
+
+		if rule.RuleNodeSelector == nil {
+			continue
+		}
+
+		for _, machineType := range rule.RuleNodeSelector.SelectorMachineTypes {
+			if t, err := machine.ParseType(machineType); err != nil {
+				errs = errors.Join(errs, fmt.Errorf("rules[%d].nodeSelector.machineTypes: %w", i, err))
+			} else if t == machine.TypeUnknown {
+				errs = errors.Join(errs, fmt.Errorf("rules[%d].nodeSelector.machineTypes: machine type %q can't be used in the selector", i, machineType))
+			}
+		}
+
+		for key := range rule.RuleNodeSelector.SelectorNodeLabels {
+			if key == "" {
+				errs = errors.Join(errs, fmt.Errorf("rules[%d].nodeSelector.nodeLabels: empty label key", i))
+			}
+		}
+	}
+
+	return nil, errs
+}
+
+// Rules implements config.APIAuthorizationConfig interface.
+func (s *APIAuthorizationConfigV1Alpha1) Rules() []config.APIAuthorizationRule {
+	rules := make([]config.APIAuthorizationRule, 0, len(s.ConfigRules))
+
+	for _, rule := range s.ConfigRules {
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+// Roles implements config.APIAuthorizationRule interface.
+func (r APIAuthorizationRule) Roles() []string {
+	return r.RuleRoles
+}
+
+// Methods implements config.APIAuthorizationRule interface.
+func (r APIAuthorizationRule) Methods() []string {
+	return r.RuleMethods
+}
+
+// MachineTypes implements config.APIAuthorizationRule interface.
+func (r APIAuthorizationRule) MachineTypes() []machine.Type {
+	if r.RuleNodeSelector == nil {
+		return nil
+	}
+
+	types := make([]machine.Type, 0, len(r.RuleNodeSelector.SelectorMachineTypes))
+
+	for _, machineType := range r.RuleNodeSelector.SelectorMachineTypes {
+		t, err := machine.ParseType(machineType)
+		if err != nil {
+			// invalid machine types are rejected by the validation
+			continue
+		}
+
+		types = append(types, t)
+	}
+
+	return types
+}
+
+// NodeLabels implements config.APIAuthorizationRule interface.
+func (r APIAuthorizationRule) NodeLabels() map[string]string {
+	if r.RuleNodeSelector == nil {
+		return nil
+	}
+
+	return r.RuleNodeSelector.SelectorNodeLabels
+}
@@ -0,0 +1,104 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package security_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/security"
+)
+
+//go:embed testdata/apiauthorizationconfig.yaml
+var expectedAPIAuthorizationConfigDocument []byte
+
+func TestAPIAuthorizationConfigMarshalUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	cfg := security.NewAPIAuthorizationConfigV1Alpha1()
+	cfg.ConfigRules = []security.APIAuthorizationRule{
+		{
+			RuleRoles:   []string{"ops:rebooter"},
+			RuleMethods: []string{"/machine.MachineService/Reboot", "/machine.MachineService/Dmesg"},
+			RuleNodeSelector: &security.APIAuthorizationNodeSelector{
+				SelectorMachineTypes: []string{"worker"},
+				SelectorNodeLabels:   map[string]string{"zone": "a"},
+			},
+		},
+		{
+			RuleRoles:   []string{"os:reader"},
+			RuleMethods: []string{"/storage.StorageService/*"},
+		},
+	}
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	assert.Equal(t, string(expectedAPIAuthorizationConfigDocument), string(marshaled))
+
+	provider, err := configloader.NewFromBytes(expectedAPIAuthorizationConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, &security.APIAuthorizationConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaAPIVersion: "v1alpha1",
+			MetaKind:       security.APIAuthorizationConfig,
+		},
+		ConfigRules: cfg.ConfigRules,
+	}, docs[0])
+
+	authorization := provider.APIAuthorizationConfig()
+	require.NotNil(t, authorization)
+
+	rules := authorization.Rules()
+	require.Len(t, rules, 2)
+
+	assert.Equal(t, []string{"ops:rebooter"}, rules[0].Roles())
+	assert.Equal(t, []string{"/machine.MachineService/Reboot", "/machine.MachineService/Dmesg"}, rules[0].Methods())
+	assert.Equal(t, []machine.Type{machine.TypeWorker}, rules[0].MachineTypes())
+	assert.Equal(t, map[string]string{"zone": "a"}, rules[0].NodeLabels())
+
+	assert.Empty(t, rules[1].MachineTypes())
+	assert.Empty(t, rules[1].NodeLabels())
+}
+
+func TestAPIAuthorizationConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	cfg := security.NewAPIAuthorizationConfigV1Alpha1()
+
+	_, err := cfg.Validate(validationMode{})
+	require.NoError(t, err)
+
+	cfg.ConfigRules = []security.APIAuthorizationRule{
+		{
+			RuleRoles:   []string{"ops:rebooter", "os:admin", "os:unknown"},
+			RuleMethods: []string{"/machine.MachineService/[", "machine.MachineService/Reboot"},
+			RuleNodeSelector: &security.APIAuthorizationNodeSelector{
+				SelectorMachineTypes: []string{"master"},
+			},
+		},
+		{},
+	}
+
+	_, err = cfg.Validate(validationMode{})
+	assert.EqualError(t, err, "rules[0].roles: role \"os:admin\" can't be restricted\n"+
+		"rules[0].roles: unknown built-in role \"os:unknown\"\n"+
+		"rules[0].methods: invalid pattern \"/machine.MachineService/[\"\n"+
+		"rules[0].methods: pattern \"machine.MachineService/Reboot\" should be a full gRPC method name starting with '/'\n"+
+		"rules[0].nodeSelector.machineTypes: invalid machine type: \"master\"\n"+
+		"rules[1].roles should not be empty\n"+
+		"rules[1].methods should not be empty")
+}
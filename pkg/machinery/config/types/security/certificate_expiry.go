@@ -0,0 +1,113 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package security
+
+//docgen:jsonschema
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// CertificateExpiryConfig is a certificate expiry config document kind.
+const CertificateExpiryConfig = "CertificateExpiryConfig"
+
+func init() {
+	registry.Register(CertificateExpiryConfig, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &CertificateExpiryConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.CertificateExpiryConfig = &CertificateExpiryConfigV1Alpha1{}
+	_ config.Validator               = &CertificateExpiryConfigV1Alpha1{}
+)
+
+// CertificateExpiryConfigV1Alpha1 configures reporting of the expiry of the certificates managed by Talos.
+//
+//	examples:
+//	  - value: exampleCertificateExpiryConfigV1Alpha1()
+//	alias: CertificateExpiryConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/CertificateExpiryConfig
+type CertificateExpiryConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+
+	//   description: |
+	//     List of thresholds (in days before the certificate expiry) to report certificate expiry events.
+	//
+	//     An event is reported each time the remaining validity of a certificate crosses one of the thresholds.
+	//     Defaults to 30, 7 and 1 days.
+	//   examples:
+	//     - value: >
+	//         []int{30, 7, 1}
+	Thresholds []int `yaml:"thresholds,omitempty"`
+}
+
+// NewCertificateExpiryConfigV1Alpha1 creates a new CertificateExpiryConfig config document.
+func NewCertificateExpiryConfigV1Alpha1() *CertificateExpiryConfigV1Alpha1 {
+	return &CertificateExpiryConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       CertificateExpiryConfig,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleCertificateExpiryConfigV1Alpha1() *CertificateExpiryConfigV1Alpha1 {
+	cfg := NewCertificateExpiryConfigV1Alpha1()
+	cfg.Thresholds = []int{60, 14, 3}
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *CertificateExpiryConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Validate implements config.Validator interface.
+func (s *CertificateExpiryConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var errs error
+
+	for i, threshold := range s.Thresholds {
+		if threshold <= 0 {
+			errs = errors.Join(errs, fmt.Errorf("thresholds[%d]: threshold should be positive", i))
+		}
+
+		if slices.Index(s.Thresholds, threshold) != i {
+			errs = errors.Join(errs, fmt.Errorf("thresholds[%d]: duplicate threshold %d", i, threshold))
+		}
+	}
+
+	return nil, errs
+}
+
+// ExpiryThresholds implements config.CertificateExpiryConfig interface.
+func (s *CertificateExpiryConfigV1Alpha1) ExpiryThresholds() []int {
+	if len(s.Thresholds) == 0 {
+		return slices.Clone(constants.DefaultCertificateExpiryThresholds)
+	}
+
+	thresholds := slices.Clone(s.Thresholds)
+
+	slices.SortFunc(thresholds, func(a, b int) int { return cmp.Compare(b, a) })
+
+	return thresholds
+}
@@ -0,0 +1,77 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package security_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/security"
+)
+
+//go:embed testdata/certificateexpiryconfig.yaml
+var expectedCertificateExpiryConfigDocument []byte
+
+func TestCertificateExpiryConfigMarshalUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	cfg := security.NewCertificateExpiryConfigV1Alpha1()
+	cfg.Thresholds = []int{60, 14, 3}
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	assert.Equal(t, string(expectedCertificateExpiryConfigDocument), string(marshaled))
+
+	provider, err := configloader.NewFromBytes(expectedCertificateExpiryConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, &security.CertificateExpiryConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaAPIVersion: "v1alpha1",
+			MetaKind:       security.CertificateExpiryConfig,
+		},
+		Thresholds: []int{60, 14, 3},
+	}, docs[0])
+
+	require.NotNil(t, provider.CertificateExpiryConfig())
+	assert.Equal(t, []int{60, 14, 3}, provider.CertificateExpiryConfig().ExpiryThresholds())
+}
+
+func TestCertificateExpiryConfigThresholds(t *testing.T) {
+	t.Parallel()
+
+	cfg := security.NewCertificateExpiryConfigV1Alpha1()
+
+	assert.Equal(t, []int{30, 7, 1}, cfg.ExpiryThresholds())
+
+	cfg.Thresholds = []int{1, 90, 14}
+
+	assert.Equal(t, []int{90, 14, 1}, cfg.ExpiryThresholds())
+	assert.Equal(t, []int{1, 90, 14}, cfg.Thresholds)
+}
+
+func TestCertificateExpiryConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	cfg := security.NewCertificateExpiryConfigV1Alpha1()
+
+	_, err := cfg.Validate(validationMode{})
+	require.NoError(t, err)
+
+	cfg.Thresholds = []int{30, 0, 7, 30, -1}
+
+	_, err = cfg.Validate(validationMode{})
+	assert.EqualError(t, err, "thresholds[1]: threshold should be positive\nthresholds[3]: duplicate threshold 30\nthresholds[4]: threshold should be positive")
+}
@@ -0,0 +1,250 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package security
+
+//docgen:jsonschema
+
+import (
+	stdx509 "crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/siderolabs/crypto/x509"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// CertificateRevocationConfig is a certificate revocation config document kind.
+const CertificateRevocationConfig = "CertificateRevocationConfig"
+
+// Expired CRL policies.
+const (
+	ExpiredCRLPolicyAllow = "allow"
+	ExpiredCRLPolicyDeny  = "deny"
+)
+
+func init() {
+	registry.Register(CertificateRevocationConfig, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &CertificateRevocationConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.CertificateRevocationConfig = &CertificateRevocationConfigV1Alpha1{}
+	_ config.Validator                   = &CertificateRevocationConfigV1Alpha1{}
+)
+
+// CertificateRevocationConfigV1Alpha1 configures the revoked Talos API client certificates.
+//
+//	description: |
+//	  The Talos API rejects the client certificates listed in the document (or in the certificate revocation list)
+//	  with the permission denied error, and reports every rejected request as a machine event.
+//	  trustd refuses to sign the certificate requests for the revoked public keys.
+//
+//	  The document should be applied to all machines of the cluster, the changes take effect without a restart.
+//	examples:
+//	  - value: exampleCertificateRevocationConfigV1Alpha1()
+//	alias: CertificateRevocationConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/CertificateRevocationConfig
+type CertificateRevocationConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+
+	//   description: |
+	//     List of the revoked client certificates.
+	RevocationRevokedCertificates []RevokedCertificate `yaml:"revokedCertificates,omitempty"`
+	//   description: |
+	//     PEM-encoded certificate revocation list (CRL) signed by the Talos API CA.
+	//
+	//     The serial numbers listed in the CRL are revoked in addition to the revokedCertificates.
+	//   schema:
+	//     type: string
+	RevocationCRL string `yaml:"crl,omitempty"`
+	//   description: |
+	//     Action to take when the certificate revocation list is past its next update time.
+	//
+	//     With `allow` (default), the entries of the expired CRL are still enforced.
+	//     With `deny`, all client certificates are rejected until the CRL is updated.
+	//   values:
+	//     - allow
+	//     - deny
+	RevocationExpiredCRLPolicy string `yaml:"expiredCRLPolicy,omitempty"`
+}
+
+// RevokedCertificate describes a revoked client certificate.
+//
+// Either the fingerprint or the serial number should be set, the certificate is revoked if either matches.
+type RevokedCertificate struct {
+	//   description: |
+	//     SPKI fingerprint of the certificate (same format as the `--cert-fingerprint` flag of talosctl).
+	//
+	//     The fingerprint revokes all certificates issued for the same key.
+	RevokedFingerprint string `yaml:"fingerprint,omitempty"`
+	//   description: |
+	//     Serial number of the certificate in hexadecimal (colons are allowed).
+	//   examples:
+	//     - value: >
+	//         "6c:8f:0b:5a:1d:ee:25:41:78:b7:fa:73:0d:3e:92:1c"
+	RevokedSerialNumber string `yaml:"serialNumber,omitempty"`
+	//   description: |
+	//     Free-form comment, e.g. the reason of the revocation.
+	//   examples:
+	//     - value: >
+	//         "lost laptop"
+	RevokedComment string `yaml:"comment,omitempty"`
+}
+
+// NewCertificateRevocationConfigV1Alpha1 creates a new CertificateRevocationConfig config document.
+func NewCertificateRevocationConfigV1Alpha1() *CertificateRevocationConfigV1Alpha1 {
+	return &CertificateRevocationConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       CertificateRevocationConfig,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleCertificateRevocationConfigV1Alpha1() *CertificateRevocationConfigV1Alpha1 {
+	cfg := NewCertificateRevocationConfigV1Alpha1()
+	cfg.RevocationRevokedCertificates = []RevokedCertificate{
+		{
+			RevokedSerialNumber: "6c:8f:0b:5a:1d:ee:25:41:78:b7:fa:73:0d:3e:92:1c",
+			RevokedComment:      "lost laptop",
+		},
+	}
+	cfg.RevocationExpiredCRLPolicy = ExpiredCRLPolicyDeny
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *CertificateRevocationConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Validate implements config.Validator interface.
+func (s *CertificateRevocationConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var errs error
+
+	for i, revoked := range s.RevocationRevokedCertificates {
+		if revoked.RevokedFingerprint == "" && revoked.RevokedSerialNumber == "" {
+			errs = errors.Join(errs, fmt.Errorf("revokedCertificates[%d]: either fingerprint or serialNumber should be set", i))
+		}
+
+		if revoked.RevokedFingerprint != "" {
+			if _, err := x509.ParseFingerprint(revoked.RevokedFingerprint); err != nil {
+				errs = errors.Join(errs, fmt.Errorf("revokedCertificates[%d]: invalid fingerprint: %w", i, err))
+			}
+		}
+
+		if revoked.RevokedSerialNumber != "" {
+			if _, err := ParseSerialNumber(revoked.RevokedSerialNumber); err != nil {
+				errs = errors.Join(errs, fmt.Errorf("revokedCertificates[%d]: %w", i, err))
+			}
+		}
+	}
+
+	if s.RevocationCRL != "" {
+		if _, err := ParseCRL([]byte(s.RevocationCRL)); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("crl: %w", err))
+		}
+	}
+
+	switch s.RevocationExpiredCRLPolicy {
+	case "", ExpiredCRLPolicyAllow, ExpiredCRLPolicyDeny:
+	default:
+		errs = errors.Join(errs, fmt.Errorf("expiredCRLPolicy should be either %q or %q", ExpiredCRLPolicyAllow, ExpiredCRLPolicyDeny))
+	}
+
+	return nil, errs
+}
+
+// RevokedFingerprints implements config.CertificateRevocationConfig interface.
+func (s *CertificateRevocationConfigV1Alpha1) RevokedFingerprints() []string {
+	var fingerprints []string
+
+	for _, revoked := range s.RevocationRevokedCertificates {
+		if revoked.RevokedFingerprint == "" {
+			continue
+		}
+
+		fingerprint, err := x509.ParseFingerprint(revoked.RevokedFingerprint)
+		if err != nil {
+			// invalid fingerprints are rejected by the validation
+			continue
+		}
+
+		fingerprints = append(fingerprints, fingerprint.String())
+	}
+
+	return fingerprints
+}
+
+// RevokedSerialNumbers implements config.CertificateRevocationConfig interface.
+func (s *CertificateRevocationConfigV1Alpha1) RevokedSerialNumbers() []*big.Int {
+	var serialNumbers []*big.Int
+
+	for _, revoked := range s.RevocationRevokedCertificates {
+		if revoked.RevokedSerialNumber == "" {
+			continue
+		}
+
+		serialNumber, err := ParseSerialNumber(revoked.RevokedSerialNumber)
+		if err != nil {
+			// invalid serial numbers are rejected by the validation
+			continue
+		}
+
+		serialNumbers = append(serialNumbers, serialNumber)
+	}
+
+	return serialNumbers
+}
+
+// CRL implements config.CertificateRevocationConfig interface.
+func (s *CertificateRevocationConfigV1Alpha1) CRL() []byte {
+	if s.RevocationCRL == "" {
+		return nil
+	}
+
+	return []byte(s.RevocationCRL)
+}
+
+// DenyOnExpiredCRL implements config.CertificateRevocationConfig interface.
+func (s *CertificateRevocationConfigV1Alpha1) DenyOnExpiredCRL() bool {
+	return s.RevocationExpiredCRLPolicy == ExpiredCRLPolicyDeny
+}
+
+// ParseSerialNumber parses the certificate serial number in hexadecimal, optionally separated with colons.
+func ParseSerialNumber(s string) (*big.Int, error) {
+	serialNumber, ok := new(big.Int).SetString(strings.ReplaceAll(s, ":", ""), 16)
+	if !ok || serialNumber.Sign() < 0 {
+		return nil, fmt.Errorf("invalid serial number %q", s)
+	}
+
+	return serialNumber, nil
+}
+
+// ParseCRL parses the PEM-encoded certificate revocation list.
+func ParseCRL(data []byte) (*stdx509.RevocationList, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "X509 CRL" {
+		return nil, errors.New("failed to decode PEM-encoded X509 CRL")
+	}
+
+	return stdx509.ParseRevocationList(block.Bytes)
+}
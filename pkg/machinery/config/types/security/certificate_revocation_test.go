@@ -0,0 +1,164 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package security_test
+
+import (
+	"crypto"
+	"crypto/rand"
+	stdx509 "crypto/x509"
+	_ "embed"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/crypto/x509"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/security"
+)
+
+//go:embed testdata/certificaterevocationconfig.yaml
+var expectedCertificateRevocationConfigDocument []byte
+
+func TestCertificateRevocationConfigMarshalUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	cfg := security.NewCertificateRevocationConfigV1Alpha1()
+	cfg.RevocationRevokedCertificates = []security.RevokedCertificate{
+		{
+			RevokedSerialNumber: "6c8f0b5a1dee254178b7fa730d3e921c",
+			RevokedComment:      "lost laptop",
+		},
+	}
+	cfg.RevocationExpiredCRLPolicy = security.ExpiredCRLPolicyDeny
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	assert.Equal(t, string(expectedCertificateRevocationConfigDocument), string(marshaled))
+
+	provider, err := configloader.NewFromBytes(expectedCertificateRevocationConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, &security.CertificateRevocationConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaAPIVersion: "v1alpha1",
+			MetaKind:       security.CertificateRevocationConfig,
+		},
+		RevocationRevokedCertificates: []security.RevokedCertificate{
+			{
+				RevokedSerialNumber: "6c8f0b5a1dee254178b7fa730d3e921c",
+				RevokedComment:      "lost laptop",
+			},
+		},
+		RevocationExpiredCRLPolicy: security.ExpiredCRLPolicyDeny,
+	}, docs[0])
+
+	revocation := provider.CertificateRevocationConfig()
+	require.NotNil(t, revocation)
+
+	expectedSerialNumber, _ := new(big.Int).SetString("6c8f0b5a1dee254178b7fa730d3e921c", 16)
+
+	assert.Empty(t, revocation.RevokedFingerprints())
+	assert.Equal(t, []*big.Int{expectedSerialNumber}, revocation.RevokedSerialNumbers())
+	assert.Nil(t, revocation.CRL())
+	assert.True(t, revocation.DenyOnExpiredCRL())
+}
+
+func TestCertificateRevocationConfigRevoked(t *testing.T) {
+	t.Parallel()
+
+	ca, err := x509.NewSelfSignedCertificateAuthority()
+	require.NoError(t, err)
+
+	fingerprint, err := x509.SPKIFingerprintFromDER(ca.Crt.Raw)
+	require.NoError(t, err)
+
+	cfg := security.NewCertificateRevocationConfigV1Alpha1()
+	cfg.RevocationRevokedCertificates = []security.RevokedCertificate{
+		{
+			RevokedFingerprint: fingerprint.String(),
+		},
+		{
+			RevokedSerialNumber: "0A:0b:0C",
+		},
+	}
+
+	_, err = cfg.Validate(validationMode{})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{fingerprint.String()}, cfg.RevokedFingerprints())
+	assert.Equal(t, []*big.Int{big.NewInt(0x0a0b0c)}, cfg.RevokedSerialNumbers())
+	assert.False(t, cfg.DenyOnExpiredCRL())
+}
+
+func TestCertificateRevocationConfigCRL(t *testing.T) {
+	t.Parallel()
+
+	ca, err := x509.NewSelfSignedCertificateAuthority()
+	require.NoError(t, err)
+
+	signer, ok := ca.Key.(crypto.Signer)
+	require.True(t, ok)
+
+	crl, err := stdx509.CreateRevocationList(rand.Reader, &stdx509.RevocationList{
+		Number:     big.NewInt(1),
+		ThisUpdate: time.Now(),
+		NextUpdate: time.Now().Add(time.Hour),
+		RevokedCertificateEntries: []stdx509.RevocationListEntry{
+			{
+				SerialNumber:   big.NewInt(42),
+				RevocationTime: time.Now(),
+			},
+		},
+	}, ca.Crt, signer)
+	require.NoError(t, err)
+
+	cfg := security.NewCertificateRevocationConfigV1Alpha1()
+	cfg.RevocationCRL = string(pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: crl}))
+
+	_, err = cfg.Validate(validationMode{})
+	require.NoError(t, err)
+
+	parsed, err := security.ParseCRL(cfg.CRL())
+	require.NoError(t, err)
+
+	require.Len(t, parsed.RevokedCertificateEntries, 1)
+	assert.Equal(t, big.NewInt(42), parsed.RevokedCertificateEntries[0].SerialNumber)
+}
+
+func TestCertificateRevocationConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	cfg := security.NewCertificateRevocationConfigV1Alpha1()
+
+	_, err := cfg.Validate(validationMode{})
+	require.NoError(t, err)
+
+	cfg.RevocationRevokedCertificates = []security.RevokedCertificate{
+		{
+			RevokedComment: "lost laptop",
+		},
+		{
+			RevokedSerialNumber: "xyz",
+		},
+	}
+	cfg.RevocationCRL = "not a CRL"
+	cfg.RevocationExpiredCRLPolicy = "ignore"
+
+	_, err = cfg.Validate(validationMode{})
+	assert.EqualError(t, err, "revokedCertificates[0]: either fingerprint or serialNumber should be set\n"+
+		"revokedCertificates[1]: invalid serial number \"xyz\"\n"+
+		"crl: failed to decode PEM-encoded X509 CRL\n"+
+		"expiredCRLPolicy should be either \"allow\" or \"deny\"")
+}
@@ -0,0 +1,79 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package security
+
+//docgen:jsonschema
+
+import (
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+)
+
+// CredentialRecoveryConfig is a credential recovery config document kind.
+const CredentialRecoveryConfig = "CredentialRecoveryConfig"
+
+func init() {
+	registry.Register(CredentialRecoveryConfig, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &CredentialRecoveryConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.CredentialRecoveryConfig = &CredentialRecoveryConfigV1Alpha1{}
+)
+
+// CredentialRecoveryConfigV1Alpha1 configures the credential recovery of the control plane nodes.
+//
+//	description: |
+//	  If the recovery gate is opened with physical access to the machine (`talos.recovery=1` kernel argument,
+//	  or the recovery META key), the control plane node serves the admin client configuration (talosconfig)
+//	  on the link-local recovery listener.
+//	  The credential recovery can be disabled entirely with this document.
+//	examples:
+//	  - value: exampleCredentialRecoveryConfigV1Alpha1()
+//	alias: CredentialRecoveryConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/CredentialRecoveryConfig
+type CredentialRecoveryConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+
+	//   description: |
+	//     Disable the credential recovery, the recovery gate is ignored.
+	ConfigDisabled bool `yaml:"disabled"`
+}
+
+// NewCredentialRecoveryConfigV1Alpha1 creates a new CredentialRecoveryConfig config document.
+func NewCredentialRecoveryConfigV1Alpha1() *CredentialRecoveryConfigV1Alpha1 {
+	return &CredentialRecoveryConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       CredentialRecoveryConfig,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleCredentialRecoveryConfigV1Alpha1() *CredentialRecoveryConfigV1Alpha1 {
+	cfg := NewCredentialRecoveryConfigV1Alpha1()
+	cfg.ConfigDisabled = true
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *CredentialRecoveryConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Disabled implements config.CredentialRecoveryConfig interface.
+func (s *CredentialRecoveryConfigV1Alpha1) Disabled() bool {
+	return s.ConfigDisabled
+}
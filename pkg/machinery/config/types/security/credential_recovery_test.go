@@ -0,0 +1,50 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package security_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/security"
+)
+
+//go:embed testdata/credentialrecoveryconfig.yaml
+var expectedCredentialRecoveryConfigDocument []byte
+
+func TestCredentialRecoveryConfigMarshalUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	cfg := security.NewCredentialRecoveryConfigV1Alpha1()
+	cfg.ConfigDisabled = true
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	assert.Equal(t, string(expectedCredentialRecoveryConfigDocument), string(marshaled))
+
+	provider, err := configloader.NewFromBytes(expectedCredentialRecoveryConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, &security.CredentialRecoveryConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaAPIVersion: "v1alpha1",
+			MetaKind:       security.CredentialRecoveryConfig,
+		},
+		ConfigDisabled: true,
+	}, docs[0])
+
+	require.NotNil(t, provider.CredentialRecoveryConfig())
+	assert.True(t, provider.CredentialRecoveryConfig().Disabled())
+}
@@ -2,12 +2,90 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type TrustedRootsConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type APIAuthorizationConfigV1Alpha1 -type CertificateExpiryConfigV1Alpha1 -type CertificateRevocationConfigV1Alpha1 -type CredentialRecoveryConfigV1Alpha1 -type TrustedRootsConfigV1Alpha1 -type TrustdPolicyConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package security
 
+// DeepCopy generates a deep copy of *APIAuthorizationConfigV1Alpha1.
+func (o *APIAuthorizationConfigV1Alpha1) DeepCopy() *APIAuthorizationConfigV1Alpha1 {
+	var cp APIAuthorizationConfigV1Alpha1 = *o
+	if o.ConfigRules != nil {
+		cp.ConfigRules = make([]APIAuthorizationRule, len(o.ConfigRules))
+		copy(cp.ConfigRules, o.ConfigRules)
+		for i2 := range o.ConfigRules {
+			if o.ConfigRules[i2].RuleRoles != nil {
+				cp.ConfigRules[i2].RuleRoles = make([]string, len(o.ConfigRules[i2].RuleRoles))
+				copy(cp.ConfigRules[i2].RuleRoles, o.ConfigRules[i2].RuleRoles)
+			}
+			if o.ConfigRules[i2].RuleMethods != nil {
+				cp.ConfigRules[i2].RuleMethods = make([]string, len(o.ConfigRules[i2].RuleMethods))
+				copy(cp.ConfigRules[i2].RuleMethods, o.ConfigRules[i2].RuleMethods)
+			}
+			if o.ConfigRules[i2].RuleNodeSelector != nil {
+				cp.ConfigRules[i2].RuleNodeSelector = new(APIAuthorizationNodeSelector)
+				*cp.ConfigRules[i2].RuleNodeSelector = *o.ConfigRules[i2].RuleNodeSelector
+				if o.ConfigRules[i2].RuleNodeSelector.SelectorMachineTypes != nil {
+					cp.ConfigRules[i2].RuleNodeSelector.SelectorMachineTypes = make([]string, len(o.ConfigRules[i2].RuleNodeSelector.SelectorMachineTypes))
+					copy(cp.ConfigRules[i2].RuleNodeSelector.SelectorMachineTypes, o.ConfigRules[i2].RuleNodeSelector.SelectorMachineTypes)
+				}
+				if o.ConfigRules[i2].RuleNodeSelector.SelectorNodeLabels != nil {
+					cp.ConfigRules[i2].RuleNodeSelector.SelectorNodeLabels = make(map[string]string, len(o.ConfigRules[i2].RuleNodeSelector.SelectorNodeLabels))
+					for k6, v6 := range o.ConfigRules[i2].RuleNodeSelector.SelectorNodeLabels {
+						cp.ConfigRules[i2].RuleNodeSelector.SelectorNodeLabels[k6] = v6
+					}
+				}
+			}
+		}
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *CertificateExpiryConfigV1Alpha1.
+func (o *CertificateExpiryConfigV1Alpha1) DeepCopy() *CertificateExpiryConfigV1Alpha1 {
+	var cp CertificateExpiryConfigV1Alpha1 = *o
+	if o.Thresholds != nil {
+		cp.Thresholds = make([]int, len(o.Thresholds))
+		copy(cp.Thresholds, o.Thresholds)
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *CertificateRevocationConfigV1Alpha1.
+func (o *CertificateRevocationConfigV1Alpha1) DeepCopy() *CertificateRevocationConfigV1Alpha1 {
+	var cp CertificateRevocationConfigV1Alpha1 = *o
+	if o.RevocationRevokedCertificates != nil {
+		cp.RevocationRevokedCertificates = make([]RevokedCertificate, len(o.RevocationRevokedCertificates))
+		copy(cp.RevocationRevokedCertificates, o.RevocationRevokedCertificates)
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *CredentialRecoveryConfigV1Alpha1.
+func (o *CredentialRecoveryConfigV1Alpha1) DeepCopy() *CredentialRecoveryConfigV1Alpha1 {
+	var cp CredentialRecoveryConfigV1Alpha1 = *o
+	return &cp
+}
+
 // DeepCopy generates a deep copy of *TrustedRootsConfigV1Alpha1.
 func (o *TrustedRootsConfigV1Alpha1) DeepCopy() *TrustedRootsConfigV1Alpha1 {
 	var cp TrustedRootsConfigV1Alpha1 = *o
 	return &cp
 }
+
+// DeepCopy generates a deep copy of *TrustdPolicyConfigV1Alpha1.
+func (o *TrustdPolicyConfigV1Alpha1) DeepCopy() *TrustdPolicyConfigV1Alpha1 {
+	var cp TrustdPolicyConfigV1Alpha1 = *o
+	if o.PolicyRateLimit != nil {
+		cp.PolicyRateLimit = new(TrustdRateLimitConfig)
+		*cp.PolicyRateLimit = *o.PolicyRateLimit
+	}
+	if o.PolicyAllowedSubnets != nil {
+		cp.PolicyAllowedSubnets = make([]string, len(o.PolicyAllowedSubnets))
+		copy(cp.PolicyAllowedSubnets, o.PolicyAllowedSubnets)
+	}
+	if o.PolicyAllowedHostnames != nil {
+		cp.PolicyAllowedHostnames = make([]string, len(o.PolicyAllowedHostnames))
+		copy(cp.PolicyAllowedHostnames, o.PolicyAllowedHostnames)
+	}
+	return &cp
+}
@@ -5,6 +5,6 @@
 // Package security provides security-related machine configuration documents.
 package security
 
-//go:generate go tool github.com/siderolabs/talos/tools/docgen -output security_doc.go security.go trusted_roots.go
+//go:generate go tool github.com/siderolabs/talos/tools/docgen -output security_doc.go security.go api_authorization.go certificate_expiry.go certificate_revocation.go credential_recovery.go trusted_roots.go trustd_policy.go
 
-//go:generate go tool github.com/siderolabs/deep-copy -type TrustedRootsConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go .
+//go:generate go tool github.com/siderolabs/deep-copy -type APIAuthorizationConfigV1Alpha1 -type CertificateExpiryConfigV1Alpha1 -type CertificateRevocationConfigV1Alpha1 -type CredentialRecoveryConfigV1Alpha1 -type TrustedRootsConfigV1Alpha1 -type TrustdPolicyConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go .
@@ -10,6 +10,233 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
 )
 
+func (APIAuthorizationConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "APIAuthorizationConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "APIAuthorizationConfig configures the authorization policy of the Talos API." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "APIAuthorizationConfig configures the authorization policy of the Talos API.\nThe policy grants access to the Talos API methods to the roles (client certificate organizations).\nCustom roles (the ones without the `os:` prefix) are denied access unless allowed by the policy.\nBuilt-in roles keep their permissions unless a rule for the role applies to the node,\nin which case the role is allowed to call only the methods listed in the rules.\n`os:admin` and `os:impersonator` roles can't be restricted.\n\nThe policy is enforced by each node using its own machine configuration,\nso the document should be applied to all nodes the rules should apply to.\n",
+		Fields: []encoder.Doc{
+			{}, {
+				Name:        "rules",
+				Type:        "[]APIAuthorizationRule",
+				Note:        "",
+				Description: "List of authorization rules.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "List of authorization rules." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleAPIAuthorizationConfigV1Alpha1())
+
+	return doc
+}
+
+func (APIAuthorizationRule) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "APIAuthorizationRule",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "APIAuthorizationRule grants access to the Talos API methods to the roles." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "APIAuthorizationRule grants access to the Talos API methods to the roles.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "APIAuthorizationConfigV1Alpha1",
+				FieldName: "rules",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "roles",
+				Type:        "[]string",
+				Note:        "",
+				Description: "List of roles (client certificate organizations) the rule applies to.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "List of roles (client certificate organizations) the rule applies to." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "methods",
+				Type:        "[]string",
+				Note:        "",
+				Description: "List of full gRPC method name patterns allowed by the rule.\n\nPatterns use shell glob syntax, e.g. `/machine.MachineService/*`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "List of full gRPC method name patterns allowed by the rule." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "nodeSelector",
+				Type:        "APIAuthorizationNodeSelector",
+				Note:        "",
+				Description: "Selects the nodes the rule applies to, by default the rule applies to any node.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Selects the nodes the rule applies to, by default the rule applies to any node." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", []string{"ops:rebooter"})
+	doc.Fields[1].AddExample("", []string{"/machine.MachineService/Reboot", "/machine.MachineService/Dmesg"})
+
+	return doc
+}
+
+func (APIAuthorizationNodeSelector) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "APIAuthorizationNodeSelector",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "APIAuthorizationNodeSelector selects the nodes the authorization rule applies to." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "APIAuthorizationNodeSelector selects the nodes the authorization rule applies to.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "APIAuthorizationRule",
+				FieldName: "nodeSelector",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "machineTypes",
+				Type:        "[]string",
+				Note:        "",
+				Description: "List of machine types the rule applies to.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "List of machine types the rule applies to." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"controlplane",
+					"worker",
+				},
+			},
+			{
+				Name:        "nodeLabels",
+				Type:        "map[string]string",
+				Note:        "",
+				Description: "Node labels (from `.machine.nodeLabels`) which should be all set on the node for the rule to apply.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Node labels (from `.machine.nodeLabels`) which should be all set on the node for the rule to apply." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", []string{"worker"})
+
+	return doc
+}
+
+func (CertificateExpiryConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "CertificateExpiryConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "CertificateExpiryConfig configures reporting of the expiry of the certificates managed by Talos." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "CertificateExpiryConfig configures reporting of the expiry of the certificates managed by Talos.",
+		Fields: []encoder.Doc{
+			{}, {
+				Name:        "thresholds",
+				Type:        "[]int",
+				Note:        "",
+				Description: "List of thresholds (in days before the certificate expiry) to report certificate expiry events.\n\nAn event is reported each time the remaining validity of a certificate crosses one of the thresholds.\nDefaults to 30, 7 and 1 days.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "List of thresholds (in days before the certificate expiry) to report certificate expiry events." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleCertificateExpiryConfigV1Alpha1())
+
+	doc.Fields[1].AddExample("", []int{30, 7, 1})
+
+	return doc
+}
+
+func (CertificateRevocationConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "CertificateRevocationConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "CertificateRevocationConfig configures the revoked Talos API client certificates." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "CertificateRevocationConfig configures the revoked Talos API client certificates.\nThe Talos API rejects the client certificates listed in the document (or in the certificate revocation list)\nwith the permission denied error, and reports every rejected request as a machine event.\ntrustd refuses to sign the certificate requests for the revoked public keys.\n\nThe document should be applied to all machines of the cluster, the changes take effect without a restart.\n",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "revokedCertificates",
+				Type:        "[]RevokedCertificate",
+				Note:        "",
+				Description: "List of the revoked client certificates.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "List of the revoked client certificates." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "crl",
+				Type:        "string",
+				Note:        "",
+				Description: "PEM-encoded certificate revocation list (CRL) signed by the Talos API CA.\n\nThe serial numbers listed in the CRL are revoked in addition to the revokedCertificates.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "PEM-encoded certificate revocation list (CRL) signed by the Talos API CA." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "expiredCRLPolicy",
+				Type:        "string",
+				Note:        "",
+				Description: "Action to take when the certificate revocation list is past its next update time.\n\nWith `allow` (default), the entries of the expired CRL are still enforced.\nWith `deny`, all client certificates are rejected until the CRL is updated.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Action to take when the certificate revocation list is past its next update time." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"allow",
+					"deny",
+				},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleCertificateRevocationConfigV1Alpha1())
+
+	return doc
+}
+
+func (RevokedCertificate) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "RevokedCertificate",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "RevokedCertificate describes a revoked client certificate." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "RevokedCertificate describes a revoked client certificate.\n\nEither the fingerprint or the serial number should be set, the certificate is revoked if either matches.\n",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "CertificateRevocationConfigV1Alpha1",
+				FieldName: "revokedCertificates",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "fingerprint",
+				Type:        "string",
+				Note:        "",
+				Description: "SPKI fingerprint of the certificate (same format as the `--cert-fingerprint` flag of talosctl).\n\nThe fingerprint revokes all certificates issued for the same key.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "SPKI fingerprint of the certificate (same format as the `--cert-fingerprint` flag of talosctl)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "serialNumber",
+				Type:        "string",
+				Note:        "",
+				Description: "Serial number of the certificate in hexadecimal (colons are allowed).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Serial number of the certificate in hexadecimal (colons are allowed)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "comment",
+				Type:        "string",
+				Note:        "",
+				Description: "Free-form comment, e.g. the reason of the revocation.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Free-form comment, e.g. the reason of the revocation." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[1].AddExample("", "6c:8f:0b:5a:1d:ee:25:41:78:b7:fa:73:0d:3e:92:1c")
+	doc.Fields[2].AddExample("", "lost laptop")
+
+	return doc
+}
+
+func (CredentialRecoveryConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "CredentialRecoveryConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "CredentialRecoveryConfig configures the credential recovery of the control plane nodes." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "CredentialRecoveryConfig configures the credential recovery of the control plane nodes.\nIf the recovery gate is opened with physical access to the machine (`talos.recovery=1` kernel argument,\nor the recovery META key), the control plane node serves the admin client configuration (talosconfig)\non the link-local recovery listener.\nThe credential recovery can be disabled entirely with this document.\n",
+		Fields: []encoder.Doc{
+			{}, {
+				Name:        "disabled",
+				Type:        "bool",
+				Note:        "",
+				Description: "Disable the credential recovery, the recovery gate is ignored.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Disable the credential recovery, the recovery gate is ignored." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleCredentialRecoveryConfigV1Alpha1())
+
+	return doc
+}
+
 func (TrustedRootsConfigV1Alpha1) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
 		Type:        "TrustedRootsConfig",
@@ -39,13 +266,100 @@ func (TrustedRootsConfigV1Alpha1) Doc() *encoder.Doc {
 	return doc
 }
 
+func (TrustdPolicyConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "TrustdPolicyConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "TrustdPolicyConfig configures the certificate signing policy of trustd running on the control plane nodes." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "TrustdPolicyConfig configures the certificate signing policy of trustd running on the control plane nodes.\ntrustd signs the Talos API server certificates of the worker nodes which present a valid machine token.\nThe policy limits which nodes are eligible to receive a certificate and how often the certificates can be requested.\nEvery signing decision is reported as a machine event.\n",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "rateLimit",
+				Type:        "TrustdRateLimitConfig",
+				Note:        "",
+				Description: "Rate limit of the certificate signing requests per source address.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Rate limit of the certificate signing requests per source address." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "allowedSubnets",
+				Type:        "[]string",
+				Note:        "",
+				Description: "List of subnets (in CIDR notation) eligible to receive a certificate.\n\nIf set, both the source address of the request and the IP addresses of the certificate\nshould be within one of the subnets.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "List of subnets (in CIDR notation) eligible to receive a certificate." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "allowedHostnames",
+				Type:        "[]string",
+				Note:        "",
+				Description: "List of hostname patterns eligible to receive a certificate.\n\nIf set, the common name and the DNS names of the certificate should match one of the patterns.\nPatterns use shell glob syntax, e.g. `worker-*`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "List of hostname patterns eligible to receive a certificate." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "maxCertificateLifetime",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Maximum lifetime of the signed certificates.\n\nThe worker nodes renew the certificates at half of the lifetime.\nDefaults to the default certificate lifetime, longer values have no effect.\nThe minimum is one hour.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Maximum lifetime of the signed certificates." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleTrustdPolicyConfigV1Alpha1())
+
+	doc.Fields[2].AddExample("", []string{"10.5.0.0/16"})
+	doc.Fields[3].AddExample("", []string{"worker-*"})
+
+	return doc
+}
+
+func (TrustdRateLimitConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "TrustdRateLimitConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "TrustdRateLimitConfig describes the rate limit of the certificate signing requests." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "TrustdRateLimitConfig describes the rate limit of the certificate signing requests.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "TrustdPolicyConfigV1Alpha1",
+				FieldName: "rateLimit",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "requestsPerMinute",
+				Type:        "int",
+				Note:        "",
+				Description: "Number of certificate signing requests allowed per minute from a single source address.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Number of certificate signing requests allowed per minute from a single source address." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "burst",
+				Type:        "int",
+				Note:        "",
+				Description: "Number of certificate signing requests allowed in a burst from a single source address.\n\nDefaults to the number of requests per minute.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Number of certificate signing requests allowed in a burst from a single source address." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	return doc
+}
+
 // GetFileDoc returns documentation for the file security_doc.go.
 func GetFileDoc() *encoder.FileDoc {
 	return &encoder.FileDoc{
 		Name:        "security",
 		Description: "Package security provides security-related machine configuration documents.\n",
 		Structs: []*encoder.Doc{
+			APIAuthorizationConfigV1Alpha1{}.Doc(),
+			APIAuthorizationRule{}.Doc(),
+			APIAuthorizationNodeSelector{}.Doc(),
+			CertificateExpiryConfigV1Alpha1{}.Doc(),
+			CertificateRevocationConfigV1Alpha1{}.Doc(),
+			RevokedCertificate{}.Doc(),
+			CredentialRecoveryConfigV1Alpha1{}.Doc(),
 			TrustedRootsConfigV1Alpha1{}.Doc(),
+			TrustdPolicyConfigV1Alpha1{}.Doc(),
+			TrustdRateLimitConfig{}.Doc(),
 		},
 	}
 }
@@ -0,0 +1,210 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package security
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"path"
+	"time"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// TrustdPolicyConfig is a trustd policy config document kind.
+const TrustdPolicyConfig = "TrustdPolicyConfig"
+
+func init() {
+	registry.Register(TrustdPolicyConfig, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &TrustdPolicyConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.TrustdPolicyConfig = &TrustdPolicyConfigV1Alpha1{}
+	_ config.Validator          = &TrustdPolicyConfigV1Alpha1{}
+)
+
+// TrustdPolicyConfigV1Alpha1 configures the certificate signing policy of trustd running on the control plane nodes.
+//
+//	description: |
+//	  trustd signs the Talos API server certificates of the worker nodes which present a valid machine token.
+//	  The policy limits which nodes are eligible to receive a certificate and how often the certificates can be requested.
+//	  Every signing decision is reported as a machine event.
+//	examples:
+//	  - value: exampleTrustdPolicyConfigV1Alpha1()
+//	alias: TrustdPolicyConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/TrustdPolicyConfig
+type TrustdPolicyConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+
+	//   description: |
+	//     Rate limit of the certificate signing requests per source address.
+	PolicyRateLimit *TrustdRateLimitConfig `yaml:"rateLimit,omitempty"`
+	//   description: |
+	//     List of subnets (in CIDR notation) eligible to receive a certificate.
+	//
+	//     If set, both the source address of the request and the IP addresses of the certificate
+	//     should be within one of the subnets.
+	//   examples:
+	//     - value: >
+	//         []string{"10.5.0.0/16"}
+	PolicyAllowedSubnets []string `yaml:"allowedSubnets,omitempty"`
+	//   description: |
+	//     List of hostname patterns eligible to receive a certificate.
+	//
+	//     If set, the common name and the DNS names of the certificate should match one of the patterns.
+	//     Patterns use shell glob syntax, e.g. `worker-*`.
+	//   examples:
+	//     - value: >
+	//         []string{"worker-*"}
+	PolicyAllowedHostnames []string `yaml:"allowedHostnames,omitempty"`
+	//   description: |
+	//     Maximum lifetime of the signed certificates.
+	//
+	//     The worker nodes renew the certificates at half of the lifetime.
+	//     Defaults to the default certificate lifetime, longer values have no effect.
+	//     The minimum is one hour.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	PolicyMaxCertificateLifetime time.Duration `yaml:"maxCertificateLifetime,omitempty"`
+}
+
+// TrustdRateLimitConfig describes the rate limit of the certificate signing requests.
+type TrustdRateLimitConfig struct {
+	//   description: |
+	//     Number of certificate signing requests allowed per minute from a single source address.
+	RateLimitRequestsPerMinute int `yaml:"requestsPerMinute"`
+	//   description: |
+	//     Number of certificate signing requests allowed in a burst from a single source address.
+	//
+	//     Defaults to the number of requests per minute.
+	RateLimitBurst int `yaml:"burst,omitempty"`
+}
+
+// NewTrustdPolicyConfigV1Alpha1 creates a new TrustdPolicyConfig config document.
+func NewTrustdPolicyConfigV1Alpha1() *TrustdPolicyConfigV1Alpha1 {
+	return &TrustdPolicyConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       TrustdPolicyConfig,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleTrustdPolicyConfigV1Alpha1() *TrustdPolicyConfigV1Alpha1 {
+	cfg := NewTrustdPolicyConfigV1Alpha1()
+	cfg.PolicyRateLimit = &TrustdRateLimitConfig{
+		RateLimitRequestsPerMinute: 10,
+		RateLimitBurst:             5,
+	}
+	cfg.PolicyAllowedSubnets = []string{"10.5.0.0/16"}
+	cfg.PolicyAllowedHostnames = []string{"worker-*"}
+	cfg.PolicyMaxCertificateLifetime = 12 * time.Hour
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *TrustdPolicyConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Validate implements config.Validator interface.
+func (s *TrustdPolicyConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var errs error
+
+	if s.PolicyRateLimit != nil {
+		if s.PolicyRateLimit.RateLimitRequestsPerMinute <= 0 {
+			errs = errors.Join(errs, errors.New("rateLimit.requestsPerMinute should be positive"))
+		}
+
+		if s.PolicyRateLimit.RateLimitBurst < 0 {
+			errs = errors.Join(errs, errors.New("rateLimit.burst should not be negative"))
+		}
+	}
+
+	for i, subnet := range s.PolicyAllowedSubnets {
+		if _, err := netip.ParsePrefix(subnet); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("allowedSubnets[%d]: %w", i, err))
+		}
+	}
+
+	for i, pattern := range s.PolicyAllowedHostnames {
+		if _, err := path.Match(pattern, ""); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("allowedHostnames[%d]: invalid pattern %q", i, pattern))
+		}
+	}
+
+	if s.PolicyMaxCertificateLifetime != 0 && s.PolicyMaxCertificateLifetime < constants.TrustdMinCertificateLifetime {
+		errs = errors.Join(errs, fmt.Errorf("maxCertificateLifetime should be at least %s", constants.TrustdMinCertificateLifetime))
+	}
+
+	return nil, errs
+}
+
+// RequestsPerMinute implements config.TrustdPolicyConfig interface.
+func (s *TrustdPolicyConfigV1Alpha1) RequestsPerMinute() int {
+	if s.PolicyRateLimit == nil {
+		return 0
+	}
+
+	return s.PolicyRateLimit.RateLimitRequestsPerMinute
+}
+
+// RequestBurst implements config.TrustdPolicyConfig interface.
+func (s *TrustdPolicyConfigV1Alpha1) RequestBurst() int {
+	if s.PolicyRateLimit == nil {
+		return 0
+	}
+
+	if s.PolicyRateLimit.RateLimitBurst == 0 {
+		return s.PolicyRateLimit.RateLimitRequestsPerMinute
+	}
+
+	return s.PolicyRateLimit.RateLimitBurst
+}
+
+// AllowedSubnets implements config.TrustdPolicyConfig interface.
+func (s *TrustdPolicyConfigV1Alpha1) AllowedSubnets() []netip.Prefix {
+	subnets := make([]netip.Prefix, 0, len(s.PolicyAllowedSubnets))
+
+	for _, subnet := range s.PolicyAllowedSubnets {
+		prefix, err := netip.ParsePrefix(subnet)
+		if err != nil {
+			// invalid subnets are rejected by the validation
+			continue
+		}
+
+		subnets = append(subnets, prefix.Masked())
+	}
+
+	return subnets
+}
+
+// AllowedHostnames implements config.TrustdPolicyConfig interface.
+func (s *TrustdPolicyConfigV1Alpha1) AllowedHostnames() []string {
+	return s.PolicyAllowedHostnames
+}
+
+// MaxCertificateLifetime implements config.TrustdPolicyConfig interface.
+func (s *TrustdPolicyConfigV1Alpha1) MaxCertificateLifetime() time.Duration {
+	return s.PolicyMaxCertificateLifetime
+}
@@ -0,0 +1,112 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package security_test
+
+import (
+	_ "embed"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/security"
+)
+
+//go:embed testdata/trustdpolicyconfig.yaml
+var expectedTrustdPolicyConfigDocument []byte
+
+func TestTrustdPolicyConfigMarshalUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	cfg := security.NewTrustdPolicyConfigV1Alpha1()
+	cfg.PolicyRateLimit = &security.TrustdRateLimitConfig{
+		RateLimitRequestsPerMinute: 10,
+		RateLimitBurst:             5,
+	}
+	cfg.PolicyAllowedSubnets = []string{"10.5.0.0/16"}
+	cfg.PolicyAllowedHostnames = []string{"worker-*"}
+	cfg.PolicyMaxCertificateLifetime = 720 * time.Hour
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	assert.Equal(t, string(expectedTrustdPolicyConfigDocument), string(marshaled))
+
+	provider, err := configloader.NewFromBytes(expectedTrustdPolicyConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, &security.TrustdPolicyConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaAPIVersion: "v1alpha1",
+			MetaKind:       security.TrustdPolicyConfig,
+		},
+		PolicyRateLimit: &security.TrustdRateLimitConfig{
+			RateLimitRequestsPerMinute: 10,
+			RateLimitBurst:             5,
+		},
+		PolicyAllowedSubnets:         []string{"10.5.0.0/16"},
+		PolicyAllowedHostnames:       []string{"worker-*"},
+		PolicyMaxCertificateLifetime: 720 * time.Hour,
+	}, docs[0])
+
+	policy := provider.TrustdPolicyConfig()
+	require.NotNil(t, policy)
+
+	assert.Equal(t, 10, policy.RequestsPerMinute())
+	assert.Equal(t, 5, policy.RequestBurst())
+	assert.Equal(t, []netip.Prefix{netip.MustParsePrefix("10.5.0.0/16")}, policy.AllowedSubnets())
+	assert.Equal(t, []string{"worker-*"}, policy.AllowedHostnames())
+	assert.Equal(t, 720*time.Hour, policy.MaxCertificateLifetime())
+}
+
+func TestTrustdPolicyConfigDefaults(t *testing.T) {
+	t.Parallel()
+
+	cfg := security.NewTrustdPolicyConfigV1Alpha1()
+
+	assert.Zero(t, cfg.RequestsPerMinute())
+	assert.Zero(t, cfg.RequestBurst())
+	assert.Empty(t, cfg.AllowedSubnets())
+
+	cfg.PolicyRateLimit = &security.TrustdRateLimitConfig{
+		RateLimitRequestsPerMinute: 20,
+	}
+	cfg.PolicyAllowedSubnets = []string{"172.20.0.5/24"}
+
+	assert.Equal(t, 20, cfg.RequestsPerMinute())
+	assert.Equal(t, 20, cfg.RequestBurst())
+	assert.Equal(t, []netip.Prefix{netip.MustParsePrefix("172.20.0.0/24")}, cfg.AllowedSubnets())
+}
+
+func TestTrustdPolicyConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	cfg := security.NewTrustdPolicyConfigV1Alpha1()
+
+	_, err := cfg.Validate(validationMode{})
+	require.NoError(t, err)
+
+	cfg.PolicyRateLimit = &security.TrustdRateLimitConfig{
+		RateLimitBurst: -1,
+	}
+	cfg.PolicyAllowedSubnets = []string{"10.5.0.0/16", "10.5.0.0"}
+	cfg.PolicyAllowedHostnames = []string{"worker-[", "worker-*"}
+	cfg.PolicyMaxCertificateLifetime = time.Minute
+
+	_, err = cfg.Validate(validationMode{})
+	assert.EqualError(t, err, "rateLimit.requestsPerMinute should be positive\n"+
+		"rateLimit.burst should not be negative\n"+
+		"allowedSubnets[1]: netip.ParsePrefix(\"10.5.0.0\"): no '/'\n"+
+		"allowedHostnames[0]: invalid pattern \"worker-[\"\n"+
+		"maxCertificateLifetime should be at least 1h0m0s")
+}
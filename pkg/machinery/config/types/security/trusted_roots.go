@@ -7,11 +7,18 @@ package security
 //docgen:jsonschema
 
 import (
+	"bytes"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
 )
 
 // TrustedRootsConfig is a default action config document kind.
@@ -32,6 +39,7 @@ func init() {
 var (
 	_ config.TrustedRootsConfig = &TrustedRootsConfigV1Alpha1{}
 	_ config.NamedDocument      = &TrustedRootsConfigV1Alpha1{}
+	_ config.Validator          = &TrustedRootsConfigV1Alpha1{}
 )
 
 // TrustedRootsConfigV1Alpha1 allows to configure additional trusted CA roots.
@@ -86,6 +94,61 @@ func (s *TrustedRootsConfigV1Alpha1) Name() string {
 	return s.MetaName
 }
 
+// Validate implements config.Validator interface.
+func (s *TrustedRootsConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var errs error
+
+	if s.MetaName == "" {
+		errs = errors.Join(errs, errors.New("name is required"))
+	}
+
+	errs = errors.Join(errs, validateCertificates([]byte(s.Certificates), time.Now()))
+
+	return nil, errs
+}
+
+// validateCertificates checks that the bundle consists of valid and non-expired PEM-encoded certificates.
+//
+// Certificates are reported by their index in the bundle (starting at 0).
+func validateCertificates(bundle []byte, now time.Time) error {
+	var (
+		errs  error
+		index int
+	)
+
+	for rest := bundle; len(bytes.TrimSpace(rest)) > 0; index++ {
+		var block *pem.Block
+
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return errors.Join(errs, fmt.Errorf("certificates[%d]: failed to decode PEM block", index))
+		}
+
+		if block.Type != "CERTIFICATE" {
+			errs = errors.Join(errs, fmt.Errorf("certificates[%d]: unexpected PEM block type %q", index, block.Type))
+
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("certificates[%d]: failed to parse certificate: %w", index, err))
+
+			continue
+		}
+
+		if now.After(cert.NotAfter) {
+			errs = errors.Join(errs, fmt.Errorf("certificates[%d]: certificate %q expired at %s", index, cert.Subject, cert.NotAfter.Format(time.RFC3339)))
+		}
+	}
+
+	if index == 0 {
+		errs = errors.Join(errs, errors.New("at least one certificate is required"))
+	}
+
+	return errs
+}
+
 // ExtraTrustedRootCertificates implements config.TrustedRootsConfig interface.
 func (s *TrustedRootsConfigV1Alpha1) ExtraTrustedRootCertificates() []string {
 	// build a header with the config name
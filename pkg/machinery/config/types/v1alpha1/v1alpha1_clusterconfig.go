@@ -153,6 +153,15 @@ func (c *ClusterConfig) ExternalCloudProvider() config.ExternalCloudProvider {
 	return c.ExternalCloudProviderConfig
 }
 
+// KubeletServingCertificateApprover implements the config.ClusterConfig interface.
+func (c *ClusterConfig) KubeletServingCertificateApprover() config.KubeletServingCertificateApprover {
+	if c.KubeletServingCertificateApproverConfig == nil {
+		return &KubeletServingCertificateApproverConfig{}
+	}
+
+	return c.KubeletServingCertificateApproverConfig
+}
+
 // ExtraManifestURLs implements the config.ClusterConfig interface.
 func (c *ClusterConfig) ExtraManifestURLs() []string {
 	return c.ExtraManifests
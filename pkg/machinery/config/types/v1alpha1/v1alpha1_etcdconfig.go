@@ -5,10 +5,14 @@
 package v1alpha1
 
 import (
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/siderolabs/crypto/x509"
 
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 )
 
@@ -65,3 +69,159 @@ func (e *EtcdConfig) ListenSubnets() []string {
 
 	return nil
 }
+
+// DefragmentationInterval implements the config.Etcd interface.
+func (e *EtcdConfig) DefragmentationInterval() time.Duration {
+	if e.EtcdDefragmentation == nil {
+		return 0
+	}
+
+	interval, err := parseEtcdSchedule(e.EtcdDefragmentation.DefragmentationSchedule)
+	if err != nil {
+		return 0
+	}
+
+	return interval
+}
+
+// DefragmentationThreshold implements the config.Etcd interface.
+func (e *EtcdConfig) DefragmentationThreshold() int {
+	if e.EtcdDefragmentation == nil {
+		return 0
+	}
+
+	return e.EtcdDefragmentation.DefragmentationThreshold
+}
+
+// LearnerMaxLagEntries implements the config.Etcd interface.
+func (e *EtcdConfig) LearnerMaxLagEntries() uint64 {
+	if e.EtcdLearnerPromotion == nil || e.EtcdLearnerPromotion.LearnerMaxLagEntries == 0 {
+		return constants.EtcdLearnerMaxLagEntries
+	}
+
+	return e.EtcdLearnerPromotion.LearnerMaxLagEntries
+}
+
+// LearnerStuckTimeout implements the config.Etcd interface.
+func (e *EtcdConfig) LearnerStuckTimeout() time.Duration {
+	if e.EtcdLearnerPromotion == nil || e.EtcdLearnerPromotion.LearnerStuckTimeout == 0 {
+		return constants.EtcdLearnerStuckTimeout
+	}
+
+	return e.EtcdLearnerPromotion.LearnerStuckTimeout
+}
+
+// MaxLearners implements the config.Etcd interface.
+func (e *EtcdConfig) MaxLearners() int {
+	if e.EtcdLearnerPromotion == nil || e.EtcdLearnerPromotion.LearnerMaxLearners == 0 {
+		return constants.EtcdMaxLearners
+	}
+
+	return e.EtcdLearnerPromotion.LearnerMaxLearners
+}
+
+// SnapshotInterval implements the config.Etcd interface.
+func (e *EtcdConfig) SnapshotInterval() time.Duration {
+	if e.EtcdSnapshots == nil {
+		return 0
+	}
+
+	interval, err := parseEtcdSchedule(e.EtcdSnapshots.SnapshotsSchedule)
+	if err != nil {
+		return 0
+	}
+
+	return interval
+}
+
+// SnapshotRetention implements the config.Etcd interface.
+func (e *EtcdConfig) SnapshotRetention() int {
+	if e.EtcdSnapshots == nil || e.EtcdSnapshots.SnapshotsRetention == 0 {
+		return constants.EtcdSnapshotRetention
+	}
+
+	return e.EtcdSnapshots.SnapshotsRetention
+}
+
+// SnapshotUpload implements the config.Etcd interface.
+func (e *EtcdConfig) SnapshotUpload() config.EtcdSnapshotUpload {
+	if e.EtcdSnapshots == nil || e.EtcdSnapshots.SnapshotsS3 == nil {
+		return nil
+	}
+
+	return e.EtcdSnapshots.SnapshotsS3
+}
+
+// Endpoint implements the config.EtcdSnapshotUpload interface.
+func (s *EtcdSnapshotsS3Config) Endpoint() string {
+	return s.S3Endpoint
+}
+
+// Region implements the config.EtcdSnapshotUpload interface.
+func (s *EtcdSnapshotsS3Config) Region() string {
+	if s.S3Region == "" {
+		return constants.EtcdSnapshotS3Region
+	}
+
+	return s.S3Region
+}
+
+// Bucket implements the config.EtcdSnapshotUpload interface.
+func (s *EtcdSnapshotsS3Config) Bucket() string {
+	return s.S3Bucket
+}
+
+// Prefix implements the config.EtcdSnapshotUpload interface.
+func (s *EtcdSnapshotsS3Config) Prefix() string {
+	return s.S3Prefix
+}
+
+// AccessKeyID implements the config.EtcdSnapshotUpload interface.
+func (s *EtcdSnapshotsS3Config) AccessKeyID() string {
+	return s.S3AccessKeyID
+}
+
+// SecretAccessKey implements the config.EtcdSnapshotUpload interface.
+func (s *EtcdSnapshotsS3Config) SecretAccessKey() string {
+	return s.S3SecretAccessKey
+}
+
+// ServerSideEncryption implements the config.EtcdSnapshotUpload interface.
+func (s *EtcdSnapshotsS3Config) ServerSideEncryption() bool {
+	return s.S3ServerSideEncryption
+}
+
+// minEtcdScheduleInterval is the minimum interval of the scheduled etcd maintenance (defragmentation, snapshots).
+const minEtcdScheduleInterval = time.Minute
+
+// parseEtcdSchedule parses the maintenance schedule into the interval between the runs.
+func parseEtcdSchedule(schedule string) (time.Duration, error) {
+	var interval time.Duration
+
+	switch schedule {
+	case "@hourly":
+		interval = time.Hour
+	case "@daily":
+		interval = 24 * time.Hour
+	case "@weekly":
+		interval = 7 * 24 * time.Hour
+	default:
+		every, ok := strings.CutPrefix(schedule, "@every ")
+		if !ok {
+			return 0, errors.New("expected one of @hourly, @daily, @weekly or @every <duration>")
+		}
+
+		var err error
+
+		interval, err = time.ParseDuration(strings.TrimSpace(every))
+		if err != nil {
+			return 0, err
+		}
+
+		if interval <= 0 {
+			return 0, errors.New("interval should be positive")
+		}
+	}
+
+	return interval, nil
+}
@@ -5,6 +5,9 @@
 package v1alpha1
 
 import (
+	"net/netip"
+	"time"
+
 	"github.com/siderolabs/go-pointer"
 
 	"github.com/siderolabs/talos/pkg/machinery/config/config"
@@ -30,11 +33,25 @@ func (f *FeaturesConfig) ApidCheckExtKeyUsageEnabled() bool {
 	return pointer.SafeDeref(f.ApidCheckExtKeyUsage)
 }
 
+// ApidProxyTargetCheck implements config.Features interface.
+func (f *FeaturesConfig) ApidProxyTargetCheck() config.ApidProxyTargetCheck {
+	if f.ApidProxyTargetCheckConfig == nil {
+		return &ApidProxyTargetCheckConfig{}
+	}
+
+	return f.ApidProxyTargetCheckConfig
+}
+
 // DiskQuotaSupportEnabled implements config.Features interface.
 func (f *FeaturesConfig) DiskQuotaSupportEnabled() bool {
 	return pointer.SafeDeref(f.DiskQuotaSupport)
 }
 
+// SecretsBundleExportEnabled implements config.Features interface.
+func (f *FeaturesConfig) SecretsBundleExportEnabled() bool {
+	return !pointer.SafeDeref(f.DisableSecretsBundleExport)
+}
+
 // HostDNS implements config.Features interface.
 func (f *FeaturesConfig) HostDNS() config.HostDNS {
 	if f.HostDNSSupport == nil {
@@ -92,6 +109,33 @@ func (a *KubePrism) Port() int {
 	return a.ServerPort
 }
 
+// FailoverOnly implements [config.KubePrism].
+func (a *KubePrism) FailoverOnly() bool {
+	return pointer.SafeDeref(a.ServerFailoverOnly)
+}
+
+// Enabled implements config.ApidProxyTargetCheck.
+func (a *ApidProxyTargetCheckConfig) Enabled() bool {
+	return pointer.SafeDeref(a.CheckEnabled)
+}
+
+// AllowedCIDRs implements config.ApidProxyTargetCheck.
+func (a *ApidProxyTargetCheckConfig) AllowedCIDRs() []netip.Prefix {
+	prefixes := make([]netip.Prefix, 0, len(a.CheckAllowedCIDRs))
+
+	for _, cidr := range a.CheckAllowedCIDRs {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			// invalid CIDRs are rejected by validation
+			continue
+		}
+
+		prefixes = append(prefixes, prefix.Masked())
+	}
+
+	return prefixes
+}
+
 // Enabled implements config.HostDNS.
 func (h *HostDNSConfig) Enabled() bool {
 	return pointer.SafeDeref(h.HostDNSEnabled)
@@ -107,6 +151,57 @@ func (h *HostDNSConfig) ResolveMemberNames() bool {
 	return pointer.SafeDeref(h.HostDNSResolveMemberNames)
 }
 
+// CacheSize implements config.HostDNS.
+func (h *HostDNSConfig) CacheSize() int {
+	return h.HostDNSCacheSize
+}
+
+// CacheMinTTL implements config.HostDNS.
+func (h *HostDNSConfig) CacheMinTTL() time.Duration {
+	return h.HostDNSCacheMinTTL
+}
+
+// CacheMaxTTL implements config.HostDNS.
+func (h *HostDNSConfig) CacheMaxTTL() time.Duration {
+	return h.HostDNSCacheMaxTTL
+}
+
+// NegativeCacheEnabled implements config.HostDNS.
+func (h *HostDNSConfig) NegativeCacheEnabled() bool {
+	return !pointer.SafeDeref(h.HostDNSDisableNegativeCache)
+}
+
+// NegativeCacheTTL implements config.HostDNS.
+func (h *HostDNSConfig) NegativeCacheTTL() time.Duration {
+	return h.HostDNSNegativeCacheTTL
+}
+
+// ServeStale implements config.HostDNS.
+func (h *HostDNSConfig) ServeStale() time.Duration {
+	return h.HostDNSServeStale
+}
+
+// AllowedClients implements config.HostDNS.
+func (h *HostDNSConfig) AllowedClients() []netip.Prefix {
+	if len(h.HostDNSAllowedClients) == 0 {
+		return nil
+	}
+
+	prefixes := make([]netip.Prefix, 0, len(h.HostDNSAllowedClients))
+
+	for _, cidr := range h.HostDNSAllowedClients {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			// invalid CIDRs are rejected by validation
+			continue
+		}
+
+		prefixes = append(prefixes, prefix.Masked())
+	}
+
+	return prefixes
+}
+
 // LocalEnabled implements config.ImageCache.
 func (i *ImageCacheConfig) LocalEnabled() bool {
 	return pointer.SafeDeref(i.CacheLocalEnabled)
@@ -5,11 +5,52 @@
 package v1alpha1
 
 import (
+	"fmt"
+	"regexp"
+	"sync"
+
+	"github.com/hashicorp/go-multierror"
 	"github.com/siderolabs/gen/xslices"
 
 	"github.com/siderolabs/talos/pkg/machinery/config/config"
 )
 
+// rxKernelModuleParameter matches key=value module parameters, rejecting whitespace, quotes and shell metacharacters.
+var rxKernelModuleParameter = sync.OnceValue(func() *regexp.Regexp {
+	return regexp.MustCompile(`^[a-zA-Z0-9_-]+=[a-zA-Z0-9_.,:/+@%=-]*$`)
+})
+
+// Validate checks kernel configuration for errors.
+func (kc *KernelConfig) Validate() error {
+	var errs *multierror.Error
+
+	names := map[string]struct{}{}
+
+	for i, module := range kc.KernelModules {
+		if module.ModuleName == "" {
+			errs = multierror.Append(errs, fmt.Errorf("[machine.kernel.modules[%d].name]: module name is required", i))
+
+			continue
+		}
+
+		if _, exists := names[module.ModuleName]; exists {
+			errs = multierror.Append(errs, fmt.Errorf("[machine.kernel.modules[%d].name]: duplicate module %q", i, module.ModuleName))
+		}
+
+		names[module.ModuleName] = struct{}{}
+
+		for j, parameter := range module.ModuleParameters {
+			if !rxKernelModuleParameter().MatchString(parameter) {
+				errs = multierror.Append(errs,
+					fmt.Errorf("[machine.kernel.modules[%d].parameters[%d]]: invalid parameter %q, expected key=value without whitespace or shell metacharacters", i, j, parameter),
+				)
+			}
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
 // Modules implements config.Kernel interface.
 func (kc *KernelConfig) Modules() []config.KernelModule {
 	return xslices.Map(kc.KernelModules, func(kmc *KernelModuleConfig) config.KernelModule { return kmc })
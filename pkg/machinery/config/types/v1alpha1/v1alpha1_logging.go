@@ -5,11 +5,14 @@
 package v1alpha1
 
 import (
+	"crypto/tls"
+	stdx509 "crypto/x509"
 	"errors"
 	"fmt"
 	"net/url"
 
 	"github.com/hashicorp/go-multierror"
+	"github.com/siderolabs/crypto/x509"
 	"github.com/siderolabs/gen/xslices"
 
 	"github.com/siderolabs/talos/pkg/machinery/config/config"
@@ -33,17 +36,37 @@ func (lc *LoggingConfig) Validate() error {
 				errs = multierror.Append(errs, errors.New("empty logging endpoint's host"))
 			}
 
-			if endpoint.Scheme != "tcp" && endpoint.Scheme != "udp" {
+			if endpoint.Scheme != "tcp" && endpoint.Scheme != "udp" && endpoint.Scheme != "tls" {
 				errs = multierror.Append(errs, fmt.Errorf("unexpected logging endpoint scheme %q", endpoint.Scheme))
 			}
 		}
 
 		switch f := dest.LoggingFormat; f {
 		case constants.LoggingFormatJSONLines:
+			if endpoint != nil && endpoint.Scheme == "tls" {
+				errs = multierror.Append(errs, fmt.Errorf("logging endpoint scheme %q is only supported with %q format", endpoint.Scheme, constants.LoggingFormatSyslog))
+			}
+		case constants.LoggingFormatSyslog:
 			// nothing
 		default:
 			errs = multierror.Append(errs, fmt.Errorf("unknown logging format %q", f))
 		}
+
+		if dest.LoggingTLS != nil {
+			if endpoint != nil && endpoint.Scheme != "tls" {
+				errs = multierror.Append(errs, fmt.Errorf("logging TLS configuration requires %q endpoint scheme", "tls"))
+			}
+
+			if dest.LoggingTLS.TLSClientIdentity != nil {
+				if _, err := tls.X509KeyPair(dest.LoggingTLS.TLSClientIdentity.Crt, dest.LoggingTLS.TLSClientIdentity.Key); err != nil {
+					errs = multierror.Append(errs, fmt.Errorf("error parsing logging client identity: %w", err))
+				}
+			}
+
+			if len(dest.LoggingTLS.TLSCA) > 0 && !stdx509.NewCertPool().AppendCertsFromPEM(dest.LoggingTLS.TLSCA) {
+				errs = multierror.Append(errs, errors.New("error parsing logging CA certificate"))
+			}
+		}
 	}
 
 	return errs.ErrorOrNil()
@@ -68,3 +91,22 @@ func (ld LoggingDestination) ExtraTags() map[string]string {
 func (ld LoggingDestination) Format() string {
 	return ld.LoggingFormat
 }
+
+// TLS implements config.LoggingDestination interface.
+func (ld LoggingDestination) TLS() config.LoggingTLSConfig {
+	if ld.LoggingTLS == nil {
+		return nil
+	}
+
+	return ld.LoggingTLS
+}
+
+// ClientIdentity implements config.LoggingTLSConfig interface.
+func (t *LoggingTLSConfig) ClientIdentity() *x509.PEMEncodedCertificateAndKey {
+	return t.TLSClientIdentity
+}
+
+// CA implements config.LoggingTLSConfig interface.
+func (t *LoggingTLSConfig) CA() []byte {
+	return t.TLSCA
+}
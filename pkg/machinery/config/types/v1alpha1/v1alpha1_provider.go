@@ -26,6 +26,7 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/machine"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 	"github.com/siderolabs/talos/pkg/machinery/resources/block"
 )
 
@@ -166,6 +167,10 @@ func (c *Config) Redact(replacement string) {
 		if c.ClusterConfig.EtcdConfig != nil && c.ClusterConfig.EtcdConfig.RootCA != nil {
 			c.ClusterConfig.EtcdConfig.RootCA.Key = redactBytes(c.ClusterConfig.EtcdConfig.RootCA.Key)
 		}
+
+		if c.ClusterConfig.EtcdConfig != nil && c.ClusterConfig.EtcdConfig.EtcdSnapshots != nil && c.ClusterConfig.EtcdConfig.EtcdSnapshots.SnapshotsS3 != nil {
+			c.ClusterConfig.EtcdConfig.EtcdSnapshots.SnapshotsS3.S3SecretAccessKey = redactStr(c.ClusterConfig.EtcdConfig.EtcdSnapshots.SnapshotsS3.S3SecretAccessKey)
+		}
 	}
 }
 
@@ -466,6 +471,16 @@ func (k *KubeletConfig) DisableManifestsDirectory() bool {
 	return pointer.SafeDeref(k.KubeletDisableManifestsDirectory)
 }
 
+// ShutdownGracePeriod implements the KubeletConfig interface.
+func (k *KubeletConfig) ShutdownGracePeriod() time.Duration {
+	return k.KubeletShutdownGracePeriod
+}
+
+// ShutdownGracePeriodCriticalPods implements the KubeletConfig interface.
+func (k *KubeletConfig) ShutdownGracePeriodCriticalPods() time.Duration {
+	return k.KubeletShutdownGracePeriodCriticalPods
+}
+
 // ValidSubnets implements the config.Provider interface.
 func (k *KubeletNodeIPConfig) ValidSubnets() []string {
 	return k.KubeletNodeIPValidSubnets
@@ -589,11 +604,40 @@ func (n *NetworkConfig) DisableSearchDomain() bool {
 	return pointer.SafeDeref(n.NetworkDisableSearchDomain)
 }
 
+// ResolverOptions implements the config.Provider interface.
+func (n *NetworkConfig) ResolverOptions() config.ResolverOptions {
+	if n.NetworkResolverOptions == nil {
+		return &ResolverOptionsConfig{}
+	}
+
+	return n.NetworkResolverOptions
+}
+
 // Devices implements the config.Provider interface.
 func (n *NetworkConfig) Devices() []config.Device {
 	return xslices.Map(n.NetworkInterfaces, func(d *Device) config.Device { return d })
 }
 
+// Rotate implements the config.ResolverOptions interface.
+func (r *ResolverOptionsConfig) Rotate() bool {
+	return pointer.SafeDeref(r.ResolverRotate)
+}
+
+// Timeout implements the config.ResolverOptions interface.
+func (r *ResolverOptionsConfig) Timeout() time.Duration {
+	return r.ResolverTimeout
+}
+
+// Attempts implements the config.ResolverOptions interface.
+func (r *ResolverOptionsConfig) Attempts() int {
+	return r.ResolverAttempts
+}
+
+// UpstreamPolicy implements the config.ResolverOptions interface.
+func (r *ResolverOptionsConfig) UpstreamPolicy() string {
+	return r.ResolverUpstreamPolicy
+}
+
 // getDevice adds or returns existing Device by name.
 //
 // This method mutates configuration, but it's only used in config generation.
@@ -626,6 +670,11 @@ func (n *NetworkConfig) ExtraHosts() []config.NetworkStaticHostConfig {
 	return xslices.Map(n.ExtraHostEntries, func(e *ExtraHost) config.NetworkStaticHostConfig { return e })
 }
 
+// RouteRules implements the config.Provider interface.
+func (n *NetworkConfig) RouteRules() []config.RouteRule {
+	return xslices.Map(n.NetworkRouteRules, func(r *RouteRule) config.RouteRule { return r })
+}
+
 // KubeSpan implements the config.Provider interface.
 func (n *NetworkConfig) KubeSpan() config.KubeSpan {
 	if n.NetworkKubeSpan == nil {
@@ -740,6 +789,24 @@ func (d *Device) VIPConfig() config.VIPConfig {
 	return d.DeviceVIPConfig
 }
 
+// RouterAdvertisements implements the config.Device interface.
+func (d *Device) RouterAdvertisements() config.RouterAdvertisements {
+	if d.DeviceRouterAdvertisements == nil {
+		return nil
+	}
+
+	return d.DeviceRouterAdvertisements
+}
+
+// DHCPFallback implements the config.Device interface.
+func (d *Device) DHCPFallback() config.DHCPFallback {
+	if d.DeviceDHCPFallback == nil {
+		return nil
+	}
+
+	return d.DeviceDHCPFallback
+}
+
 // Selector implements the config.Device interface.
 func (d *Device) Selector() config.NetworkDeviceSelector {
 	if d.DeviceSelector == nil {
@@ -777,6 +844,20 @@ func (d *DeviceVIPConfig) HCloud() config.VIPHCloud {
 	return d.HCloudConfig
 }
 
+// Priority implements the config.VIPConfig interface.
+func (d *DeviceVIPConfig) Priority() uint32 {
+	return d.VIPPriority
+}
+
+// Preempt implements the config.VIPConfig interface.
+func (d *DeviceVIPConfig) Preempt() bool {
+	if d.VIPPreempt == nil {
+		return true
+	}
+
+	return *d.VIPPreempt
+}
+
 // APIToken implements the config.VIPHCloud interface.
 func (v *VIPHCloudConfig) APIToken() string {
 	return v.HCloudAPIToken
@@ -791,6 +872,47 @@ func (d *Device) WireguardConfig() config.WireguardConfig {
 	return d.DeviceWireguardConfig
 }
 
+// Accept implements the config.RouterAdvertisements interface.
+func (ra *DeviceRouterAdvertisementsConfig) Accept() bool {
+	if ra.RAAccept == nil {
+		return true
+	}
+
+	return *ra.RAAccept
+}
+
+// AcceptRDNSS implements the config.RouterAdvertisements interface.
+func (ra *DeviceRouterAdvertisementsConfig) AcceptRDNSS() bool {
+	if ra.RAAcceptRDNSS == nil {
+		return true
+	}
+
+	return *ra.RAAcceptRDNSS
+}
+
+// Enabled implements the config.DHCPFallback interface.
+func (f *DeviceDHCPFallbackConfig) Enabled() bool {
+	return f.FallbackEnabled
+}
+
+// ProbeTimeout implements the config.DHCPFallback interface.
+func (f *DeviceDHCPFallbackConfig) ProbeTimeout() time.Duration {
+	if f.FallbackProbeTimeout == 0 {
+		return constants.DHCPFallbackDefaultProbeTimeout
+	}
+
+	return f.FallbackProbeTimeout
+}
+
+// ProbeInterval implements the config.DHCPFallback interface.
+func (f *DeviceDHCPFallbackConfig) ProbeInterval() time.Duration {
+	if f.FallbackProbeInterval == 0 {
+		return constants.DHCPFallbackDefaultProbeInterval
+	}
+
+	return f.FallbackProbeInterval
+}
+
 // RouteMetric implements the DHCPOptions interface.
 func (d *DHCPOptions) RouteMetric() uint32 {
 	return d.DHCPRouteMetric
@@ -819,6 +941,45 @@ func (d *DHCPOptions) DUIDv6() string {
 	return d.DHCPDUIDv6
 }
 
+// DUIDv6Type implements the DHCPOptions interface.
+func (d *DHCPOptions) DUIDv6Type() string {
+	return d.DHCPDUIDv6Type
+}
+
+// IAIDv6 implements the DHCPOptions interface.
+func (d *DHCPOptions) IAIDv6() uint32 {
+	return d.DHCPIAIDv6
+}
+
+// IgnoreClasslessStaticRoutes implements the DHCPOptions interface.
+func (d *DHCPOptions) IgnoreClasslessStaticRoutes() bool {
+	return pointer.SafeDeref(d.DHCPIgnoreClasslessStaticRoutes)
+}
+
+// OptimisticLease implements the DHCPOptions interface.
+func (d *DHCPOptions) OptimisticLease() bool {
+	return pointer.SafeDeref(d.DHCPOptimisticLease)
+}
+
+// ClientIdentifier implements the DHCPOptions interface.
+func (d *DHCPOptions) ClientIdentifier() string {
+	return d.DHCPClientIdentifier
+}
+
+// SendHostname implements the DHCPOptions interface.
+func (d *DHCPOptions) SendHostname() bool {
+	if d.DHCPSendHostname == nil {
+		return true
+	}
+
+	return *d.DHCPSendHostname
+}
+
+// RequestedOptions implements the DHCPOptions interface.
+func (d *DHCPOptions) RequestedOptions() []uint32 {
+	return d.DHCPRequestedOptions
+}
+
 // PrivateKey implements the MachineNetwork interface.
 func (wc *DeviceWireguardConfig) PrivateKey() string {
 	return wc.WireguardPrivateKey
@@ -839,6 +1000,11 @@ func (wc *DeviceWireguardConfig) Peers() []config.WireguardPeer {
 	return xslices.Map(wc.WireguardPeers, func(p *DeviceWireguardPeer) config.WireguardPeer { return p })
 }
 
+// EndpointResolveInterval implements the MachineNetwork interface.
+func (wc *DeviceWireguardConfig) EndpointResolveInterval() time.Duration {
+	return wc.WireguardEndpointResolveInterval
+}
+
 // PublicKey implements the MachineNetwork interface.
 func (wd *DeviceWireguardPeer) PublicKey() string {
 	return wd.WireguardPublicKey
@@ -914,6 +1080,49 @@ func (r *Route) MTU() uint32 {
 	return r.RouteMTU
 }
 
+// Table implements the MachineNetwork interface.
+func (r *Route) Table() nethelpers.RoutingTable {
+	if r.RouteTable == 0 {
+		return nethelpers.TableMain
+	}
+
+	return nethelpers.RoutingTable(r.RouteTable)
+}
+
+// From implements the MachineNetwork interface.
+func (r *RouteRule) From() string {
+	return r.RuleFrom
+}
+
+// To implements the MachineNetwork interface.
+func (r *RouteRule) To() string {
+	return r.RuleTo
+}
+
+// FwMark implements the MachineNetwork interface.
+func (r *RouteRule) FwMark() uint32 {
+	return r.RuleFwMark
+}
+
+// FwMask implements the MachineNetwork interface.
+func (r *RouteRule) FwMask() uint32 {
+	if r.RuleFwMask == 0 && r.RuleFwMark != 0 {
+		return 0xffffffff
+	}
+
+	return r.RuleFwMask
+}
+
+// Priority implements the MachineNetwork interface.
+func (r *RouteRule) Priority() uint32 {
+	return r.RulePriority
+}
+
+// Table implements the MachineNetwork interface.
+func (r *RouteRule) Table() nethelpers.RoutingTable {
+	return nethelpers.RoutingTable(r.RuleTable)
+}
+
 // Interfaces implements the MachineNetwork interface.
 func (b *Bond) Interfaces() []string {
 	if b == nil {
@@ -941,6 +1150,15 @@ func (b *Bond) ARPIPTarget() []string {
 	return b.BondARPIPTarget
 }
 
+// NSIP6Target implements the MachineNetwork interface.
+func (b *Bond) NSIP6Target() []string {
+	if b == nil {
+		return nil
+	}
+
+	return b.BondNSIP6Target
+}
+
 // Mode implements the MachineNetwork interface.
 func (b *Bond) Mode() string {
 	return b.BondMode
@@ -1088,6 +1306,35 @@ func (v *BridgeVLAN) FilteringEnabled() bool {
 	return pointer.SafeDeref(v.BridgeVLANFiltering)
 }
 
+// Ports implements the config.BridgeVLAN interface.
+func (v *BridgeVLAN) Ports() []config.BridgeVLANPort {
+	if v == nil {
+		return nil
+	}
+
+	return xslices.Map(v.BridgeVLANPorts, func(p *BridgeVLANPort) config.BridgeVLANPort { return p })
+}
+
+// Interface implements the config.BridgeVLANPort interface.
+func (p *BridgeVLANPort) Interface() string {
+	return p.BridgeVLANPortInterface
+}
+
+// PVID implements the config.BridgeVLANPort interface.
+func (p *BridgeVLANPort) PVID() uint16 {
+	return p.BridgeVLANPortPVID
+}
+
+// Untagged implements the config.BridgeVLANPort interface.
+func (p *BridgeVLANPort) Untagged() []uint16 {
+	return p.BridgeVLANPortUntagged
+}
+
+// Tagged implements the config.BridgeVLANPort interface.
+func (p *BridgeVLANPort) Tagged() []uint16 {
+	return p.BridgeVLANPortTagged
+}
+
 // Interfaces implements the config.Bridge interface.
 func (b *Bridge) Interfaces() []string {
 	return b.BridgedInterfaces
@@ -1212,11 +1459,59 @@ func (k *NetworkKubeSpan) Filters() config.KubeSpanFilters {
 	return k.KubeSpanFilters
 }
 
+// Keepalive implements the KubeSpan interface.
+func (k *NetworkKubeSpan) Keepalive() config.KubeSpanKeepalive {
+	if k.KubeSpanKeepalive == nil {
+		return &KubeSpanKeepalive{}
+	}
+
+	return k.KubeSpanKeepalive
+}
+
 // Endpoints implements the config.KubeSpanFilters interface.
 func (k *KubeSpanFilters) Endpoints() []string {
 	return k.KubeSpanFiltersEndpoints
 }
 
+// PeerEndpoints implements the config.KubeSpanFilters interface.
+func (k *KubeSpanFilters) PeerEndpoints() []string {
+	return k.KubeSpanFiltersPeerEndpoints
+}
+
+// Interval implements the config.KubeSpanKeepalive interface.
+func (k *KubeSpanKeepalive) Interval() time.Duration {
+	if k.KeepaliveInterval == 0 {
+		return constants.KubeSpanDefaultPeerKeepalive
+	}
+
+	return k.KeepaliveInterval
+}
+
+// NATOnly implements the config.KubeSpanKeepalive interface.
+func (k *KubeSpanKeepalive) NATOnly() bool {
+	return pointer.SafeDeref(k.KeepaliveNATOnly)
+}
+
+// Overrides implements the config.KubeSpanKeepalive interface.
+func (k *KubeSpanKeepalive) Overrides() []config.KubeSpanKeepaliveOverride {
+	return xslices.Map(k.KeepaliveOverrides, func(o KubeSpanKeepaliveOverride) config.KubeSpanKeepaliveOverride { return o })
+}
+
+// Label implements the config.KubeSpanKeepaliveOverride interface.
+func (o KubeSpanKeepaliveOverride) Label() string {
+	return o.OverrideLabel
+}
+
+// CIDR implements the config.KubeSpanKeepaliveOverride interface.
+func (o KubeSpanKeepaliveOverride) CIDR() string {
+	return o.OverrideCIDR
+}
+
+// Interval implements the config.KubeSpanKeepaliveOverride interface.
+func (o KubeSpanKeepaliveOverride) Interval() time.Duration {
+	return o.OverrideInterval
+}
+
 // Disabled implements the config.Provider interface.
 func (t *TimeConfig) Disabled() bool {
 	return pointer.SafeDeref(t.TimeDisabled)
@@ -1232,6 +1527,43 @@ func (t *TimeConfig) BootTimeout() time.Duration {
 	return t.TimeBootTimeout
 }
 
+// DHCPServers implements the config.Provider interface.
+func (t *TimeConfig) DHCPServers() string {
+	if t.TimeDHCPServers == "" {
+		return nethelpers.DHCPTimeServersIgnore
+	}
+
+	return t.TimeDHCPServers
+}
+
+// NTSServers implements the config.Provider interface.
+func (t *TimeConfig) NTSServers() []config.NTSServer {
+	if t.TimeNTS == nil {
+		return nil
+	}
+
+	return xslices.Map(t.TimeNTS.NTSServers, func(s TimeNTSServer) config.NTSServer { return s })
+}
+
+// NTSAllowPlainFallback implements the config.Provider interface.
+func (t *TimeConfig) NTSAllowPlainFallback() bool {
+	if t.TimeNTS == nil {
+		return false
+	}
+
+	return pointer.SafeDeref(t.TimeNTS.NTSAllowPlainFallback)
+}
+
+// Server implements the config.NTSServer interface.
+func (s TimeNTSServer) Server() string {
+	return s.NTSServer
+}
+
+// CACertificate implements the config.NTSServer interface.
+func (s TimeNTSServer) CACertificate() string {
+	return s.NTSCACertificate
+}
+
 // Image implements the config.Provider interface.
 func (i *InstallConfig) Image() string {
 	return i.InstallImage
@@ -1630,6 +1962,11 @@ func (e *EncryptionKeyTPM) PCRs() []int {
 	return []int{constants.SecureBootStatePCR}
 }
 
+// PCRBank implements the config.Provider interface.
+func (e *EncryptionKeyTPM) PCRBank() string {
+	return constants.DefaultTPMPCRBank
+}
+
 // PubKeyPCRs implements the config.Provider interface.
 func (e *EncryptionKeyTPM) PubKeyPCRs() []int {
 	// we always lock to PCR 11
@@ -11,6 +11,7 @@ import (
 
 	"github.com/siderolabs/talos/pkg/machinery/config/generate"
 	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 )
 
@@ -35,6 +36,13 @@ func TestRedactSecrets(t *testing.T) {
 	require.NotEmpty(t, config.ClusterConfig.EtcdConfig.RootCA.Key)
 	require.NotEmpty(t, config.ClusterConfig.ClusterServiceAccount.Key)
 
+	config.ClusterConfig.EtcdConfig.EtcdSnapshots = &v1alpha1.EtcdSnapshotsConfig{
+		SnapshotsS3: &v1alpha1.EtcdSnapshotsS3Config{
+			S3AccessKeyID:     "key",
+			S3SecretAccessKey: "secret",
+		},
+	}
+
 	replacement := "**.***"
 
 	config.Redact(replacement)
@@ -48,4 +56,6 @@ func TestRedactSecrets(t *testing.T) {
 	require.Equal(t, replacement, string(config.Cluster().IssuingCA().Key))
 	require.Equal(t, replacement, string(config.Cluster().Etcd().CA().Key))
 	require.Equal(t, replacement, string(config.Cluster().ServiceAccount().Key))
+	require.Equal(t, "key", config.Cluster().Etcd().SnapshotUpload().AccessKeyID())
+	require.Equal(t, replacement, config.Cluster().Etcd().SnapshotUpload().SecretAccessKey())
 }
@@ -34,7 +34,6 @@ import (
 
 	"github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
-	"github.com/siderolabs/talos/pkg/machinery/config/merge"
 )
 
 func init() {
@@ -232,6 +231,17 @@ type MachineConfig struct {
 	//       value: machineSysctlsExample()
 	MachineSysctls map[string]string `yaml:"sysctls,omitempty"`
 	//   description: |
+	//     Allows setting sysctl values which are known to break Talos and Kubernetes networking
+	//     (e.g. disabling `net.ipv4.ip_forward`).
+	//
+	//     By default, such values are rejected at validation time.
+	//   values:
+	//     - true
+	//     - yes
+	//     - false
+	//     - no
+	MachineSysctlsAllowProtected *bool `yaml:"sysctlsAllowProtected,omitempty"`
+	//   description: |
 	//     Used to configure the machine's sysfs.
 	//   examples:
 	//     - name: MachineSysfs usage example.
@@ -527,6 +537,11 @@ type ClusterConfig struct {
 	//   examples:
 	//     - value: true
 	AllowSchedulingOnControlPlanes *bool `yaml:"allowSchedulingOnControlPlanes,omitempty"`
+	//   description: |
+	//     Settings for the Talos-managed approval of the kubelet serving certificate signing requests.
+	//   examples:
+	//     - value: clusterKubeletServingCertificateApproverExample()
+	KubeletServingCertificateApproverConfig *KubeletServingCertificateApproverConfig `yaml:"kubeletServingCertificateApprover,omitempty"`
 }
 
 // LinuxIDMapping represents the Linux ID mapping.
@@ -555,7 +570,10 @@ type ExtraMount struct {
 	Source string `yaml:"source,omitempty"`
 	//   description: |
 	//     Options are fstab style mount options.
-	Options []string `yaml:"options,omitempty"`
+	//
+	//     Bind mounts accept `bind` or `rbind` (recursive), a propagation mode (`shared`, `rshared`, `slave`, `rslave`, `private`, `rprivate`),
+	//     and `ro`, `rw` or `rro` (recursive read-only, requires `rbind` and kernel support).
+	Options []string `yaml:"options,omitempty" merge:"replace"`
 
 	//   description: |
 	//     UID/GID mappings used for changing file owners w/o calling chown, fs should support it.
@@ -618,7 +636,7 @@ type KubeletConfig struct {
 	//     Note that either `bind` or `rbind` are required in the `options`.
 	//   examples:
 	//     - value: kubeletExtraMountsExample()
-	KubeletExtraMounts []ExtraMount `yaml:"extraMounts,omitempty"`
+	KubeletExtraMounts []ExtraMount `yaml:"extraMounts,omitempty" merge:"key=destination"`
 	//   description: |
 	//     The `extraConfig` field is used to provide kubelet configuration overrides.
 	//
@@ -631,6 +649,8 @@ type KubeletConfig struct {
 	KubeletExtraConfig Unstructured `yaml:"extraConfig,omitempty"`
 	//  description: |
 	//   The `KubeletCredentialProviderConfig` field is used to provide kubelet credential configuration.
+	//   The config is rendered as the kubelet `CredentialProviderConfig` file, and kubelet is restarted when it changes.
+	//   Provider binaries are looked up in `/usr/local/lib/kubelet/credentialproviders`, which is populated by system extensions.
 	//  examples:
 	//    - value: kubeletCredentialProviderConfigExample()
 	//  schema:
@@ -677,6 +697,37 @@ type KubeletConfig struct {
 	//     - false
 	//     - no
 	KubeletDisableManifestsDirectory *bool `yaml:"disableManifestsDirectory,omitempty"`
+	//   description: |
+	//     The `extraConfigAllowUnknownFields` field disables strict validation of the `extraConfig` fields.
+	//
+	//     By default, `extraConfig` is validated against the KubeletConfiguration schema embedded into Talos,
+	//     and unknown fields are rejected. Set this field to pass fields which are newer than the embedded schema.
+	//   values:
+	//     - true
+	//     - yes
+	//     - false
+	//     - no
+	KubeletExtraConfigAllowUnknownFields *bool `yaml:"extraConfigAllowUnknownFields,omitempty"`
+	//   description: |
+	//     The `shutdownGracePeriod` field configures the kubelet graceful node shutdown grace period.
+	//
+	//     On reboot, shutdown and upgrade Talos waits for the kubelet to terminate the pods within this period
+	//     (plus a small margin) before stopping the kubelet.
+	//     Defaults to 30 seconds, maximum value is 20 minutes.
+	//     Field format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	KubeletShutdownGracePeriod time.Duration `yaml:"shutdownGracePeriod,omitempty"`
+	//   description: |
+	//     The `shutdownGracePeriodCriticalPods` field configures the part of the shutdown grace period reserved for the critical pods.
+	//
+	//     Should be less than `shutdownGracePeriod`, defaults to 10 seconds.
+	//     Field format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	KubeletShutdownGracePeriodCriticalPods time.Duration `yaml:"shutdownGracePeriodCriticalPods,omitempty"`
 }
 
 // KubeletNodeIPConfig represents the kubelet node IP configuration.
@@ -702,7 +753,7 @@ type NetworkConfig struct {
 	//     This can be further tuned through this configuration parameter.
 	//   examples:
 	//     - value: machineNetworkConfigExample().NetworkInterfaces
-	NetworkInterfaces NetworkDeviceList `yaml:"interfaces,omitempty"`
+	NetworkInterfaces NetworkDeviceList `yaml:"interfaces,omitempty" merge:"key=interface,key=deviceSelector"`
 	//   description: |
 	//     Used to statically set the nameservers for the machine.
 	//     Defaults to `1.1.1.1` and `8.8.8.8`
@@ -733,60 +784,71 @@ type NetworkConfig struct {
 	//     - false
 	//     - no
 	NetworkDisableSearchDomain *bool `yaml:"disableSearchDomain,omitempty"`
+	//   description: |
+	//     Tunes the resolver behavior.
+	//
+	//     The options are applied both to the `/etc/resolv.conf` used by the host processes,
+	//     and to the upstream selection of the host DNS forwarder (if enabled).
+	//   examples:
+	//     - value: networkResolverOptionsExample()
+	NetworkResolverOptions *ResolverOptionsConfig `yaml:"resolverOptions,omitempty"`
+	//   description: |
+	//     Routing policy rules (`ip rule`) to install.
+	//     Matching packets are routed using the specified routing table,
+	//     routes can be installed into that table via the `table` field of the interface routes.
+	//   examples:
+	//     - value: networkRouteRulesExample()
+	NetworkRouteRules []*RouteRule `yaml:"routeRules,omitempty"`
 }
 
-// NetworkDeviceList is a list of *Device structures with overridden merge process.
+// ResolverOptionsConfig describes the resolver tuning options.
+type ResolverOptionsConfig struct {
+	//   description: |
+	//     Query the nameservers in round-robin order instead of always starting with the first one.
+	//
+	//     Sets `options rotate` in `/etc/resolv.conf`, and makes the host DNS forwarder
+	//     rotate the upstreams when `upstreamPolicy` is `sequential`.
+	//   values:
+	//     - true
+	//     - yes
+	//     - false
+	//     - no
+	ResolverRotate *bool `yaml:"rotate,omitempty"`
+	//   description: |
+	//     Timeout of a single query to a nameserver.
+	//
+	//     Sets `options timeout` in `/etc/resolv.conf` (rounded up to whole seconds),
+	//     and bounds a query to a single upstream in the host DNS forwarder.
+	//     Should be in the range from 1s to 30s.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	ResolverTimeout time.Duration `yaml:"timeout,omitempty"`
+	//   description: |
+	//     Number of times the resolver sends a query to the nameservers before giving up.
+	//
+	//     Sets `options attempts` in `/etc/resolv.conf`.
+	//     Should be in the range from 1 to 5.
+	ResolverAttempts int `yaml:"attempts,omitempty"`
+	//   description: |
+	//     Upstream selection policy of the host DNS forwarder.
+	//
+	//     `sequential` queries upstreams one by one until a successful response is received,
+	//     `parallel` queries all upstreams at once and uses the first successful response.
+	//     Defaults to `sequential`.
+	//   values:
+	//     - sequential
+	//     - parallel
+	ResolverUpstreamPolicy string `yaml:"upstreamPolicy,omitempty"`
+}
+
+// NetworkDeviceList is a list of *Device structures.
+//
+// Devices are merged by the interface name or the device selector.
 //
 //docgen:alias
 type NetworkDeviceList []*Device
 
-// Merge the network interface configuration intelligently.
-func (devices *NetworkDeviceList) Merge(other any) error {
-	otherDevices, ok := other.(NetworkDeviceList)
-	if !ok {
-		return fmt.Errorf("unexpected type for device merge %T", other)
-	}
-
-	for _, device := range otherDevices {
-		if err := devices.mergeDevice(device); err != nil {
-			return err
-		}
-	}
-
-	return nil
-}
-
-func (devices *NetworkDeviceList) mergeDevice(device *Device) error {
-	var existing *Device
-
-	switch {
-	case device.DeviceInterface != "":
-		for _, d := range *devices {
-			if d.DeviceInterface == device.DeviceInterface {
-				existing = d
-
-				break
-			}
-		}
-	case device.DeviceSelector != nil:
-		for _, d := range *devices {
-			if d.DeviceSelector != nil && *d.DeviceSelector == *device.DeviceSelector {
-				existing = d
-
-				break
-			}
-		}
-	}
-
-	if existing != nil {
-		return merge.Merge(existing, device)
-	}
-
-	*devices = append(*devices, device)
-
-	return nil
-}
-
 // InstallConfig represents the installation options for preparing a node.
 type InstallConfig struct {
 	//   description: |
@@ -968,6 +1030,44 @@ type TimeConfig struct {
 	//     type: string
 	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
 	TimeBootTimeout time.Duration `yaml:"bootTimeout,omitempty"`
+	//   description: |
+	//     Controls how time servers received via DHCP are combined with the `servers` list.
+	//     `ignore` uses only the `servers` list (if set), `supplement` appends DHCP servers as fallbacks
+	//     after the `servers` list, `replace` uses DHCP servers instead of the `servers` list when DHCP provides any.
+	//     Defaults to `ignore`.
+	//   values:
+	//     - ignore
+	//     - supplement
+	//     - replace
+	TimeDHCPServers string `yaml:"dhcpServers,omitempty"`
+	//   description: |
+	//     Network Time Security (NTS) configuration.
+	//     NTS servers are preferred over the plain time servers, and time received from them is authenticated.
+	//   examples:
+	//     - value: machineTimeNTSExample()
+	TimeNTS *TimeNTSConfig `yaml:"nts,omitempty"`
+}
+
+// TimeNTSConfig represents the Network Time Security (NTS) options.
+type TimeNTSConfig struct {
+	//   description: |
+	//     List of NTS servers to use for authenticated time sync.
+	NTSServers []TimeNTSServer `yaml:"servers,omitempty" merge:"key=server"`
+	//   description: |
+	//     Allow falling back to the plain (unauthenticated) time servers if none of the NTS servers can be used.
+	//     Defaults to `false`, so that only NTS servers are used if any are configured.
+	NTSAllowPlainFallback *bool `yaml:"allowPlainFallback,omitempty"`
+}
+
+// TimeNTSServer represents a single NTS server.
+type TimeNTSServer struct {
+	//   description: |
+	//     NTS key establishment server hostname, optionally with the port (defaults to 4460).
+	NTSServer string `yaml:"server"`
+	//   description: |
+	//     PEM-encoded CA certificate(s) used to verify the NTS key establishment server.
+	//     If not set, system root CAs are used.
+	NTSCACertificate string `yaml:"caCertificate,omitempty"`
 }
 
 // RegistriesConfig represents the image pull options.
@@ -1125,7 +1225,7 @@ type APIServerConfig struct {
 	//     Configure the API server admission plugins.
 	//   examples:
 	//     - value: admissionControlConfigExample()
-	AdmissionControlConfig AdmissionPluginConfigList `yaml:"admissionControl,omitempty"`
+	AdmissionControlConfig AdmissionPluginConfigList `yaml:"admissionControl,omitempty" merge:"key=name"`
 	//   description: |
 	//     Configure the API server audit policy.
 	//   examples:
@@ -1147,45 +1247,11 @@ type APIServerConfig struct {
 
 // AdmissionPluginConfigList represents the admission plugin configuration list.
 //
+// Admission plugin configs are merged by the plugin name.
+//
 //docgen:alias
 type AdmissionPluginConfigList []*AdmissionPluginConfig
 
-// Merge the admission plugin configuration intelligently.
-func (configs *AdmissionPluginConfigList) Merge(other any) error {
-	otherConfigs, ok := other.(AdmissionPluginConfigList)
-	if !ok {
-		return fmt.Errorf("unexpected type for device merge %T", other)
-	}
-
-	for _, config := range otherConfigs {
-		if err := configs.mergeConfig(config); err != nil {
-			return err
-		}
-	}
-
-	return nil
-}
-
-func (configs *AdmissionPluginConfigList) mergeConfig(config *AdmissionPluginConfig) error {
-	var existing *AdmissionPluginConfig
-
-	for _, c := range *configs {
-		if c.PluginName == config.PluginName {
-			existing = c
-
-			break
-		}
-	}
-
-	if existing != nil {
-		return merge.Merge(existing, config)
-	}
-
-	*configs = append(*configs, config)
-
-	return nil
-}
-
 // AdmissionPluginConfig represents the API server admission plugin configuration.
 type AdmissionPluginConfig struct {
 	//   description: |
@@ -1378,6 +1444,119 @@ type EtcdConfig struct {
 	//    Negative subnet matches should be specified last to filter out IPs picked by positive matches.
 	//    If not specified, advertised IP is selected as the first routable address of the node.
 	EtcdListenSubnets []string `yaml:"listenSubnets,omitempty"`
+	//  description: |
+	//    The `defragmentation` field configures the scheduled defragmentation of the local etcd member.
+	//
+	//    Members are defragmented one at a time across the cluster.
+	//  examples:
+	//    - value: clusterEtcdDefragmentationExample()
+	EtcdDefragmentation *EtcdDefragmentationConfig `yaml:"defragmentation,omitempty"`
+	//  description: |
+	//    The `learnerPromotion` field configures the promotion of the new members which join the cluster as learners.
+	//  examples:
+	//    - value: clusterEtcdLearnerPromotionExample()
+	EtcdLearnerPromotion *EtcdLearnerPromotionConfig `yaml:"learnerPromotion,omitempty"`
+	//  description: |
+	//    The `snapshots` field configures the scheduled snapshots of the local etcd member.
+	//
+	//    Snapshots are stored on the EPHEMERAL partition, and optionally uploaded to the S3-compatible object storage.
+	//  examples:
+	//    - value: clusterEtcdSnapshotsExample()
+	EtcdSnapshots *EtcdSnapshotsConfig `yaml:"snapshots,omitempty"`
+}
+
+// EtcdDefragmentationConfig represents the scheduled etcd defragmentation configuration.
+type EtcdDefragmentationConfig struct {
+	//  description: |
+	//    Defragmentation schedule.
+	//
+	//    Supported values are `@hourly`, `@daily`, `@weekly` and `@every <duration>` (e.g. `@every 12h`).
+	//    The minimum interval is one minute.
+	//  examples:
+	//    - value: '"@daily"'
+	DefragmentationSchedule string `yaml:"schedule"`
+	//  description: |
+	//    Minimum percentage of the etcd database size which is not in use to run defragmentation.
+	//
+	//    If not set, the member is defragmented on every scheduled run.
+	//  examples:
+	//    - value: 30
+	DefragmentationThreshold int `yaml:"threshold,omitempty"`
+}
+
+// EtcdLearnerPromotionConfig represents the etcd learner promotion policy.
+type EtcdLearnerPromotionConfig struct {
+	//  description: |
+	//    Maximum number of raft log entries the learner can lag behind the voting members to be promoted.
+	//
+	//    Defaults to 1000.
+	LearnerMaxLagEntries uint64 `yaml:"maxLagEntries,omitempty"`
+	//  description: |
+	//    Time after which a learner which is still not promoted is reported as stuck.
+	//
+	//    Defaults to 10 minutes.
+	//    Field format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).
+	//  schema:
+	//    type: string
+	//    pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	LearnerStuckTimeout time.Duration `yaml:"stuckTimeout,omitempty"`
+	//  description: |
+	//    Maximum number of learners in the cluster at the same time.
+	//
+	//    New members wait to join the cluster while the limit is reached.
+	//    Defaults to 1.
+	LearnerMaxLearners int `yaml:"maxLearners,omitempty"`
+}
+
+// EtcdSnapshotsConfig represents the scheduled etcd snapshots configuration.
+type EtcdSnapshotsConfig struct {
+	//  description: |
+	//    Snapshot schedule.
+	//
+	//    Supported values are `@hourly`, `@daily`, `@weekly` and `@every <duration>` (e.g. `@every 12h`).
+	//    The minimum interval is one minute.
+	//  examples:
+	//    - value: '"@every 6h"'
+	SnapshotsSchedule string `yaml:"schedule"`
+	//  description: |
+	//    Number of the snapshots to keep on the node, older snapshots are removed.
+	//
+	//    Defaults to 3.
+	SnapshotsRetention int `yaml:"retention,omitempty"`
+	//  description: |
+	//    S3-compatible object storage to upload the snapshots to.
+	SnapshotsS3 *EtcdSnapshotsS3Config `yaml:"s3,omitempty"`
+}
+
+// EtcdSnapshotsS3Config represents the S3-compatible object storage destination of the etcd snapshots.
+type EtcdSnapshotsS3Config struct {
+	//  description: |
+	//    Object storage endpoint URL.
+	//  examples:
+	//    - value: '"https://s3.us-east-1.amazonaws.com"'
+	S3Endpoint string `yaml:"endpoint"`
+	//  description: |
+	//    Object storage region.
+	//
+	//    Defaults to `us-east-1`.
+	S3Region string `yaml:"region,omitempty"`
+	//  description: |
+	//    Bucket to upload the snapshots to.
+	S3Bucket string `yaml:"bucket"`
+	//  description: |
+	//    Prefix of the uploaded snapshot object names.
+	//  examples:
+	//    - value: '"etcd/production"'
+	S3Prefix string `yaml:"prefix,omitempty"`
+	//  description: |
+	//    Access key ID.
+	S3AccessKeyID string `yaml:"accessKeyID"`
+	//  description: |
+	//    Secret access key.
+	S3SecretAccessKey string `yaml:"secretAccessKey"`
+	//  description: |
+	//    Request the server-side encryption (SSE-S3) of the uploaded snapshots.
+	S3ServerSideEncryption bool `yaml:"serverSideEncryption,omitempty"`
 }
 
 // ClusterNetworkConfig represents kube networking configuration options.
@@ -1440,6 +1619,24 @@ type FlannelCNIConfig struct {
 	FlanneldExtraArgs []string `yaml:"extraArgs,omitempty"`
 }
 
+var _ config.KubeletServingCertificateApprover = (*KubeletServingCertificateApproverConfig)(nil)
+
+// KubeletServingCertificateApproverConfig contains kubelet serving certificate approver configuration.
+type KubeletServingCertificateApproverConfig struct {
+	//   description: |
+	//     Enable approval of the kubelet serving certificate signing requests by the control plane nodes.
+	//
+	//     Requests are approved only if the requested DNS names and IP addresses match the node's hostname and addresses,
+	//     mismatching requests are denied.
+	//     Kubelet serving certificate rotation should be enabled with the `rotate-server-certificates` kubelet extra argument.
+	//   values:
+	//     - true
+	//     - yes
+	//     - false
+	//     - no
+	ApproverEnabled *bool `yaml:"enabled,omitempty"`
+}
+
 var _ config.ExternalCloudProvider = (*ExternalCloudProviderConfig)(nil)
 
 // ExternalCloudProviderConfig contains external cloud provider configuration.
@@ -1761,10 +1958,13 @@ type Device struct {
 	//     - value: networkConfigDynamicBridgePortsExample()
 	DeviceBridgePort *BridgePort `yaml:"bridgePort,omitempty"`
 	//   description: VLAN specific options.
-	DeviceVlans VlanList `yaml:"vlans,omitempty"`
+	DeviceVlans VlanList `yaml:"vlans,omitempty" merge:"key=vlanId"`
 	//   description: |
 	//     The interface's MTU.
 	//     If used in combination with DHCP, this will override any MTU settings returned from DHCP server.
+	//
+	//     For bonds and bridges, the MTU is set on the bond or bridge interface.
+	//     If a VLAN on top of the interface has a higher MTU, the interface MTU is raised to match it.
 	DeviceMTU int `yaml:"mtu,omitempty"`
 	//   description: |
 	//     Indicates if DHCP should be used to configure the interface.
@@ -1804,6 +2004,54 @@ type Device struct {
 	//     - name: layer2 vip example
 	//       value: networkConfigVIPLayer2Example()
 	DeviceVIPConfig *DeviceVIPConfig `yaml:"vip,omitempty"`
+	//   description: |
+	//     IPv6 router advertisement handling for the interface.
+	//     When set, router advertisements are processed by Talos honoring the router preference and route information options,
+	//     otherwise they are handled by the kernel.
+	//   examples:
+	//     - value: networkConfigRouterAdvertisementsExample()
+	DeviceRouterAdvertisements *DeviceRouterAdvertisementsConfig `yaml:"routerAdvertisements,omitempty"`
+	//   description: |
+	//     Fall back to DHCPv4 if the gateway of the static configuration is not reachable.
+	//     The static `addresses` and `routes` of the interface are applied first, and the gateway of the default route is probed via ARP.
+	//     If the gateway doesn't respond within the probe timeout, the static configuration is replaced with DHCPv4,
+	//     and the gateway is re-probed periodically to switch back to the static configuration.
+	//   examples:
+	//     - value: networkConfigDHCPFallbackExample()
+	DeviceDHCPFallback *DeviceDHCPFallbackConfig `yaml:"dhcpFallback,omitempty"`
+}
+
+// DeviceDHCPFallbackConfig contains settings for falling back to DHCP if the static configuration of an interface doesn't work.
+type DeviceDHCPFallbackConfig struct {
+	//   description: |
+	//     Enable the fallback to DHCPv4.
+	//     Requires static `addresses` and a default route with an IPv4 gateway to be configured for the interface.
+	FallbackEnabled bool `yaml:"enabled"`
+	//   description: |
+	//     Time to wait for the gateway to respond to the ARP probe (default is 10s).
+	//     Field format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	FallbackProbeTimeout time.Duration `yaml:"probeTimeout,omitempty"`
+	//   description: |
+	//     Interval to re-probe the gateway, both to detect the failure of the static configuration
+	//     and to switch back to it from DHCPv4 (default is 1m).
+	//     Field format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	FallbackProbeInterval time.Duration `yaml:"probeInterval,omitempty"`
+}
+
+// DeviceRouterAdvertisementsConfig contains settings for handling IPv6 router advertisements on an interface.
+type DeviceRouterAdvertisementsConfig struct {
+	//   description: |
+	//     Accept IPv6 router advertisements on the interface (default is enabled).
+	//     When disabled, router advertisements are ignored by the kernel as well (no SLAAC addresses and routes).
+	RAAccept *bool `yaml:"accept,omitempty"`
+	//   description: Use recursive DNS servers (RDNSS) from router advertisements as resolvers (default is enabled).
+	RAAcceptRDNSS *bool `yaml:"acceptRDNSS,omitempty"`
 }
 
 // DHCPOptions contains options for configuring the DHCP settings for a given interface.
@@ -1816,6 +2064,46 @@ type DHCPOptions struct {
 	DHCPIPv6 *bool `yaml:"ipv6,omitempty"`
 	//   description: Set client DUID (hex string).
 	DHCPDUIDv6 string `yaml:"duidv6,omitempty"`
+	//   description: |
+	//     Set client DUID type to be generated for DHCPv6.
+	//     `LL` is based on the link-layer address, `LLT` is based on the link-layer address (with the time field set to zero),
+	//     `UUID` is based on the machine UUID.
+	//     Ignored if `duidv6` is set.
+	//   values:
+	//     - LL
+	//     - LLT
+	//     - UUID
+	DHCPDUIDv6Type string `yaml:"duidv6Type,omitempty"`
+	//   description: |
+	//     Set identity association identifier (IAID) for DHCPv6.
+	//     Defaults to the last 4 bytes of the link-layer address.
+	DHCPIAIDv6 uint32 `yaml:"iaidv6,omitempty"`
+	//   description: |
+	//     Ignore classless static routes (DHCP options 121 and 249) received via DHCPv4.
+	//     When set, only the default gateway from the router option (option 3) is used.
+	DHCPIgnoreClasslessStaticRoutes *bool `yaml:"ignoreClasslessStaticRoutes,omitempty"`
+	//   description: |
+	//     Apply the DHCPv4 lease cached from the previous boot right away, while it is being confirmed by the DHCP server.
+	//     Expired leases are never applied.
+	DHCPOptimisticLease *bool `yaml:"optimisticLease,omitempty"`
+	//   description: |
+	//     Set the DHCPv4 client identifier (option 61).
+	//     `mac` sends the link-layer address, `duid` sends the RFC 4361 identifier built from the DHCPv6 IAID and DUID.
+	//     Colon-separated hex bytes are sent as the raw option value, any other string is sent as is.
+	//     By default, no client identifier is sent.
+	//     Changing the client identifier releases the current lease and acquires a new one.
+	//   examples:
+	//     - value: '"mac"'
+	//     - value: '"01:52:54:00:12:34:56"'
+	DHCPClientIdentifier string `yaml:"clientIdentifier,omitempty"`
+	//   description: Send the node hostname to the DHCPv4 server (default is enabled).
+	DHCPSendHostname *bool `yaml:"sendHostname,omitempty"`
+	//   description: |
+	//     Override the list of options requested from the DHCPv4 server (option 55).
+	//     The hostname is only taken from the DHCPv4 server if the hostname option (12) is requested.
+	//   examples:
+	//     - value: '[]uint32{1, 3, 6, 12, 15, 42}'
+	DHCPRequestedOptions []uint32 `yaml:"requestedOptions,omitempty"`
 }
 
 // DeviceWireguardConfig contains settings for configuring Wireguard network interface.
@@ -1830,6 +2118,17 @@ type DeviceWireguardConfig struct {
 	WireguardFirewallMark int `yaml:"firewallMark,omitempty"`
 	//   description: Specifies a list of peer configurations to apply to a device.
 	WireguardPeers []*DeviceWireguardPeer `yaml:"peers,omitempty"`
+	//   description: |
+	//     Specifies how often peer endpoints specified as hostnames are re-resolved.
+	//
+	//     The peer endpoint is updated only when the resolved address changes and
+	//     the last handshake with the peer is stale, so a working tunnel is never disrupted.
+	//     Defaults to 60s.
+	//     Field format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	WireguardEndpointResolveInterval time.Duration `yaml:"endpointResolveInterval,omitempty"`
 }
 
 // DeviceWireguardPeer a WireGuard device peer configuration.
@@ -1859,6 +2158,16 @@ type DeviceVIPConfig struct {
 	EquinixMetalConfig *VIPEquinixMetalConfig `yaml:"equinixMetal,omitempty"`
 	// description: Specifies the Hetzner Cloud API settings to assign VIP to the node.
 	HCloudConfig *VIPHCloudConfig `yaml:"hcloud,omitempty"`
+	//   description: |
+	//     Specifies the priority of the node to own the shared IP.
+	//     The node with the highest priority becomes the owner, nodes with the same priority (including the default of zero)
+	//     keep the election order: the first node to come up owns the shared IP.
+	VIPPriority uint32 `yaml:"priority,omitempty"`
+	//   description: |
+	//     Specifies whether a node with a higher priority takes the shared IP over from the current owner.
+	//     When disabled, the shared IP stays with the current owner when a node with a higher priority comes up.
+	//     Defaults to `true`.
+	VIPPreempt *bool `yaml:"preempt,omitempty"`
 }
 
 // VIPEquinixMetalConfig contains settings for Equinix Metal VIP management.
@@ -1888,11 +2197,16 @@ type Bond struct {
 	//   description: |
 	//     A bond option.
 	//     Please see the official kernel documentation.
-	//     Not supported at the moment.
+	//     IPv4 addresses used as ARP monitoring targets, requires `arpInterval` to be set.
 	BondARPIPTarget []string `yaml:"arpIPTarget,omitempty"`
 	//   description: |
 	//     A bond option.
 	//     Please see the official kernel documentation.
+	//     IPv6 addresses used as NS monitoring targets, requires `arpInterval` to be set.
+	BondNSIP6Target []string `yaml:"nsIP6Target,omitempty"`
+	//   description: |
+	//     A bond option.
+	//     Please see the official kernel documentation.
 	BondMode string `yaml:"mode"`
 	//   description: |
 	//     A bond option.
@@ -2003,6 +2317,24 @@ type STP struct {
 type BridgeVLAN struct {
 	//   description: Whether VLAN filtering is enabled.
 	BridgeVLANFiltering *bool `yaml:"vlanFiltering,omitempty"`
+	//   description: |
+	//     VLAN configuration of the bridge ports.
+	//     Requires `vlanFiltering` to be enabled.
+	BridgeVLANPorts []*BridgeVLANPort `yaml:"ports,omitempty"`
+}
+
+// BridgeVLANPort contains the VLAN configuration of a bridge port.
+type BridgeVLANPort struct {
+	//   description: The name of the bridge port interface.
+	BridgeVLANPortInterface string `yaml:"interface"`
+	//   description: |
+	//     Port VLAN ID (PVID) assigned to the untagged traffic received on the port.
+	//     PVID egresses untagged unless it is listed in `tagged`.
+	BridgeVLANPortPVID uint16 `yaml:"pvid,omitempty"`
+	//   description: The list of VLAN IDs which egress the port untagged.
+	BridgeVLANPortUntagged []uint16 `yaml:"untagged,omitempty"`
+	//   description: The list of VLAN IDs which egress the port tagged.
+	BridgeVLANPortTagged []uint16 `yaml:"tagged,omitempty"`
 }
 
 // Bridge contains the various options for configuring a bridge interface.
@@ -2025,47 +2357,13 @@ type BridgePort struct {
 	BridgePortMaster string `yaml:"master,omitempty"`
 }
 
-// VlanList is a list of *Vlan structures with overridden merge process.
+// VlanList is a list of *Vlan structures.
+//
+// VLANs are merged by the VLAN ID.
 //
 //docgen:alias
 type VlanList []*Vlan
 
-// Merge the network interface configuration intelligently.
-func (vlans *VlanList) Merge(other any) error {
-	otherVlans, ok := other.(VlanList)
-	if !ok {
-		return fmt.Errorf("unexpected type for vlan merge %T", other)
-	}
-
-	for _, vlan := range otherVlans {
-		if err := vlans.mergeVlan(vlan); err != nil {
-			return err
-		}
-	}
-
-	return nil
-}
-
-func (vlans *VlanList) mergeVlan(vlan *Vlan) error {
-	var existing *Vlan
-
-	for _, v := range *vlans {
-		if v.VlanID == vlan.VlanID {
-			existing = v
-
-			break
-		}
-	}
-
-	if existing != nil {
-		return merge.Merge(existing, vlan)
-	}
-
-	*vlans = append(*vlans, vlan)
-
-	return nil
-}
-
 // Vlan represents vlan settings for a device.
 type Vlan struct {
 	//   description: The addresses in CIDR notation or as plain IPs to use.
@@ -2078,7 +2376,11 @@ type Vlan struct {
 	VlanDHCP *bool `yaml:"dhcp,omitempty"`
 	//   description: The VLAN's ID.
 	VlanID uint16 `yaml:"vlanId"`
-	//   description: The VLAN's MTU.
+	//   description: |
+	//     The VLAN's MTU.
+	//
+	//     The VLAN MTU can't exceed the MTU of the parent interface.
+	//     If the parent interface MTU is managed by Talos, it is raised automatically, otherwise the VLAN MTU is not applied.
 	VlanMTU uint32 `yaml:"mtu,omitempty"`
 	//   description: The VLAN's virtual IP address configuration.
 	VlanVIP *DeviceVIPConfig `yaml:"vip,omitempty"`
@@ -2100,6 +2402,30 @@ type Route struct {
 	RouteMetric uint32 `yaml:"metric,omitempty"`
 	//   description: The optional MTU for the route.
 	RouteMTU uint32 `yaml:"mtu,omitempty"`
+	//   description: |
+	//     The routing table to install the route into.
+	//     Defaults to the main routing table (`254`).
+	RouteTable uint32 `yaml:"table,omitempty"`
+}
+
+// RouteRule represents a routing policy rule.
+type RouteRule struct {
+	//   description: The source address prefix to match (optional).
+	RuleFrom string `yaml:"from,omitempty"`
+	//   description: The destination address prefix to match (optional).
+	RuleTo string `yaml:"to,omitempty"`
+	//   description: The firewall mark to match (optional).
+	RuleFwMark uint32 `yaml:"fwMark,omitempty"`
+	//   description: |
+	//     The mask applied to the firewall mark before matching.
+	//     Defaults to `0xffffffff` if `fwMark` is set.
+	RuleFwMask uint32 `yaml:"fwMask,omitempty"`
+	//   description: |
+	//     The priority of the rule, rules are evaluated in the ascending order of priority.
+	//     Should be in the range 1-32765, as 0, 32766 and 32767 are used by the kernel default rules.
+	RulePriority uint32 `yaml:"priority"`
+	//   description: The routing table to look up if the rule matches.
+	RuleTable uint32 `yaml:"table"`
 }
 
 // RegistryMirrorConfig represents mirror configuration for a registry.
@@ -2108,7 +2434,7 @@ type RegistryMirrorConfig struct {
 	//     List of endpoints (URLs) for registry mirrors to use.
 	//     Endpoint configures HTTP/HTTPS access mode, host name,
 	//     port and path (if path is not set, it defaults to `/v2`).
-	MirrorEndpoints []string `yaml:"endpoints"`
+	MirrorEndpoints []string `yaml:"endpoints" merge:"key"`
 	//   description: |
 	//     Use the exact path specified for the endpoint (don't append /v2/).
 	//     This setting is often required for setting up multiple mirrors
@@ -2217,6 +2543,14 @@ type FeaturesConfig struct {
 	//     Enable checks for extended key usage of client certificates in apid.
 	ApidCheckExtKeyUsage *bool `yaml:"apidCheckExtKeyUsage,omitempty"`
 	//   description: |
+	//     Restrict apid request forwarding to cluster members.
+	//
+	//     When enabled, apid only proxies requests to the local node, discovered cluster members,
+	//     and addresses in the allowed CIDRs, other targets are rejected.
+	//   examples:
+	//     - value: apidProxyTargetCheckExample()
+	ApidProxyTargetCheckConfig *ApidProxyTargetCheckConfig `yaml:"apidProxyTargetCheck,omitempty"`
+	//   description: |
 	//     Enable XFS project quota support for EPHEMERAL partition and user disks.
 	//     Also enables kubelet tracking of ephemeral disk usage in the kubelet via quota.
 	DiskQuotaSupport *bool `yaml:"diskQuotaSupport,omitempty"`
@@ -2236,6 +2570,11 @@ type FeaturesConfig struct {
 	//     The 'v2' algorithm prefers more specific prefixes.
 	//     If unset, defaults to 'v1'.
 	FeatureNodeAddressSortAlgorithm string `yaml:"nodeAddressSortAlgorithm,omitempty"`
+	//   description: |
+	//     Disable the export of the secrets bundle via the Talos API (`talosctl get secrets-bundle`).
+	//
+	//     The secrets bundle contains the cluster CAs and keys, so the export might be disabled in locked-down environments.
+	DisableSecretsBundleExport *bool `yaml:"disableSecretsBundleExport,omitempty"`
 }
 
 // KubePrism describes the configuration for the KubePrism load balancer.
@@ -2246,6 +2585,25 @@ type KubePrism struct {
 	//   description: |
 	//     KubePrism port.
 	ServerPort int `yaml:"port,omitempty"`
+	//   description: |
+	//     Use the cluster endpoint exclusively while it is healthy, and fail over to the individual
+	//     control plane nodes only when the cluster endpoint is down.
+	//
+	//     By default, KubePrism balances the connections across all the control plane endpoints.
+	ServerFailoverOnly *bool `yaml:"failoverOnly,omitempty"`
+}
+
+// ApidProxyTargetCheckConfig describes the configuration for apid proxy target checks.
+type ApidProxyTargetCheckConfig struct {
+	//   description: |
+	//     Enable apid proxy target checks.
+	CheckEnabled *bool `yaml:"enabled,omitempty"`
+	//   description: |
+	//     List of CIDRs which are allowed as proxy targets in addition to cluster members.
+	//   examples:
+	//     - value: >
+	//        []string{"10.5.0.0/16", "fd00::/64"}
+	CheckAllowedCIDRs []string `yaml:"allowedCIDRs,omitempty"`
 }
 
 // ImageCacheConfig describes the configuration for the Image Cache feature.
@@ -2287,6 +2645,63 @@ type HostDNSConfig struct {
 	//     When enabled, cluster member hostnames and node names are resolved using the host DNS resolver.
 	//     This requires service discovery to be enabled.
 	HostDNSResolveMemberNames *bool `yaml:"resolveMemberNames,omitempty"`
+	//   description: |
+	//     Maximum number of entries in the host DNS cache.
+	//
+	//     Least recently used entries are evicted when the cache is full.
+	//     Defaults to 10000.
+	HostDNSCacheSize int `yaml:"cacheSize,omitempty"`
+	//   description: |
+	//     Minimum TTL of the cached responses.
+	//
+	//     Responses with a lower TTL are cached (and served) with this TTL.
+	//     Field format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	HostDNSCacheMinTTL time.Duration `yaml:"cacheMinTTL,omitempty"`
+	//   description: |
+	//     Maximum TTL of the cached responses.
+	//
+	//     Responses with a higher TTL are cached (and served) with this TTL.
+	//     Defaults to 1h.
+	//     Field format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	HostDNSCacheMaxTTL time.Duration `yaml:"cacheMaxTTL,omitempty"`
+	//   description: |
+	//     Disable caching of negative (NXDOMAIN and NODATA) responses.
+	HostDNSDisableNegativeCache *bool `yaml:"disableNegativeCache,omitempty"`
+	//   description: |
+	//     Maximum TTL of the cached negative (NXDOMAIN and NODATA) responses.
+	//
+	//     Defaults to 10s.
+	//     Field format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	HostDNSNegativeCacheTTL time.Duration `yaml:"negativeCacheTTL,omitempty"`
+	//   description: |
+	//     Serve expired cache entries for up to this duration when all upstream resolvers fail.
+	//
+	//     Stale responses are served with a TTL of 30s.
+	//     Disabled by default.
+	//     Field format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	HostDNSServeStale time.Duration `yaml:"serveStale,omitempty"`
+	//   description: |
+	//     List of CIDRs of the clients which are allowed to query the host DNS resolver.
+	//
+	//     Queries from other clients are refused.
+	//     Loopback addresses and the host DNS address are always allowed.
+	//     Defaults to the pod CIDRs.
+	//   examples:
+	//     - value: >
+	//        []string{"10.244.0.0/16", "fd00:10:244::/56"}
+	HostDNSAllowedClients []string `yaml:"allowedClients,omitempty"`
 }
 
 // VolumeMountConfig struct describes extra volume mount for the static pods.
@@ -2380,6 +2795,11 @@ type NetworkKubeSpan struct {
 	//
 	//   Settings in this section are optional, and settings apply only to the node.
 	KubeSpanFilters *KubeSpanFilters `yaml:"filters,omitempty"`
+	// description: |
+	//   KubeSpan Wireguard persistent keepalive settings.
+	//
+	//   Settings in this section are optional, and settings apply only to the node.
+	KubeSpanKeepalive *KubeSpanKeepalive `yaml:"keepalive,omitempty"`
 }
 
 // KubeSpanFilters struct describes KubeSpan advanced network addresses filtering.
@@ -2394,6 +2814,61 @@ type KubeSpanFilters struct {
 	//   - name: Exclude addresses in 192.168.0.0/16 subnet.
 	//     value: '[]string{"0.0.0.0/0", "!192.168.0.0/16", "::/0"}'
 	KubeSpanFiltersEndpoints []string `yaml:"endpoints,omitempty"`
+	// description: |
+	//   Filter peer endpoints which will be used by this node to establish Wireguard connections to the peers.
+	//
+	//   By default, all endpoints advertised by the peer are used.
+	//   Endpoints which don't match the filter are skipped during endpoint selection.
+	//   Changing the filter only switches the peers whose current endpoint no longer matches the filter.
+	//
+	//   Default value: no filtering.
+	// examples:
+	//   - name: Only connect to the peers over the private network.
+	//     value: '[]string{"10.0.0.0/8", "!10.255.0.0/16"}'
+	KubeSpanFiltersPeerEndpoints []string `yaml:"peerEndpoints,omitempty"`
+}
+
+// KubeSpanKeepalive struct describes KubeSpan Wireguard persistent keepalive settings.
+type KubeSpanKeepalive struct {
+	// description: |
+	//   Interval of the Wireguard persistent keepalive sent to the peers.
+	//
+	//   Default value: 25s.
+	//   Field format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).
+	// schema:
+	//   type: string
+	//   pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	KeepaliveInterval time.Duration `yaml:"interval,omitempty"`
+	// description: |
+	//   Send the persistent keepalive only to the peers which are detected to be behind NAT.
+	//
+	//   The peer is considered to be behind NAT if the endpoint seen by Wireguard
+	//   doesn't match any of the endpoints advertised by the peer.
+	KeepaliveNATOnly *bool `yaml:"natOnly,omitempty"`
+	// description: |
+	//   Override the persistent keepalive interval for the peers matching the label or the endpoint CIDR.
+	//
+	//   The first matching override wins, overrides are applied regardless of the NAT detection.
+	KeepaliveOverrides []KubeSpanKeepaliveOverride `yaml:"overrides,omitempty"`
+}
+
+// KubeSpanKeepaliveOverride struct describes KubeSpan persistent keepalive override for a set of peers.
+type KubeSpanKeepaliveOverride struct {
+	// description: |
+	//   Peer label (hostname) to match.
+	OverrideLabel string `yaml:"label,omitempty"`
+	// description: |
+	//   CIDR to match the peer endpoint against.
+	// examples:
+	//   - value: '"192.168.0.0/16"'
+	OverrideCIDR string `yaml:"cidr,omitempty"`
+	// description: |
+	//   Persistent keepalive interval for the matching peers, zero disables the keepalive.
+	//   Field format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).
+	// schema:
+	//   type: string
+	//   pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	OverrideInterval time.Duration `yaml:"interval"`
 }
 
 // NetworkDeviceSelector struct describes network device selector.
@@ -2477,24 +2952,59 @@ type LoggingConfig struct {
 type LoggingDestination struct {
 	// description: |
 	//   Where to send logs. Supported protocols are "tcp" and "udp".
+	//   The "tls" protocol is supported with the "syslog" format.
 	// examples:
 	//   - value: loggingEndpointExample1()
 	//   - value: loggingEndpointExample2()
 	LoggingEndpoint *Endpoint `yaml:"endpoint"`
 	// description: |
 	//   Logs format.
+	//
+	//   The "syslog" format sends RFC 5424 messages, using octet-counting framing for "tcp" and "tls".
 	// values:
 	//   - json_lines
+	//   - syslog
 	LoggingFormat string `yaml:"format"`
 	// description: |
 	//   Extra tags (key-value) pairs to attach to every log message sent.
 	LoggingExtraTags map[string]string `yaml:"extraTags,omitempty"`
+	// description: |
+	//   TLS configuration for the "tls" protocol.
+	//
+	//   If the CA is not set, system trusted roots are used.
+	LoggingTLS *LoggingTLSConfig `yaml:"tls,omitempty"`
+}
+
+// LoggingTLSConfig struct configures TLS for the logging destination.
+type LoggingTLSConfig struct {
+	//   description: |
+	//     Client certificate and key for mutual TLS authentication.
+	//     Client certificate and key should be base64-encoded.
+	//   examples:
+	//     - value: pemEncodedCertificateExample()
+	//   schema:
+	//     type: object
+	//     additionalProperties: false
+	//     properties:
+	//       crt:
+	//         type: string
+	//       key:
+	//         type: string
+	TLSClientIdentity *x509.PEMEncodedCertificateAndKey `yaml:"clientIdentity,omitempty"`
+	//   description: |
+	//     CA certificate to verify the logging endpoint.
+	//     Certificate should be base64-encoded.
+	//   schema:
+	//     type: string
+	TLSCA Base64Bytes `yaml:"ca,omitempty"`
 }
 
 // KernelConfig struct configures Talos Linux kernel.
 type KernelConfig struct {
 	// description: |
 	//   Kernel modules to load.
+	//
+	//   Modules are loaded in the order they are listed.
 	KernelModules []*KernelModuleConfig `yaml:"modules,omitempty"`
 }
 
@@ -2504,6 +3014,9 @@ type KernelModuleConfig struct {
 	//   Module name.
 	ModuleName string `yaml:"name"`
 	// description: |
-	//   Module parameters, changes applied after reboot.
+	//   Module parameters in `key=value` form.
+	//
+	//   If the parameters of an already loaded module change, Talos tries to reload the module.
+	//   If the module can't be unloaded, the change is applied after a reboot.
 	ModuleParameters []string `yaml:"parameters,omitempty"`
 }
@@ -187,6 +187,19 @@ func (MachineConfig) Doc() *encoder.Doc {
 				Description: "Used to configure the machine's sysctls.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Used to configure the machine's sysctls." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "sysctlsAllowProtected",
+				Type:        "bool",
+				Note:        "",
+				Description: "Allows setting sysctl values which are known to break Talos and Kubernetes networking\n(e.g. disabling `net.ipv4.ip_forward`).\n\nBy default, such values are rejected at validation time.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Allows setting sysctl values which are known to break Talos and Kubernetes networking" /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"true",
+					"yes",
+					"false",
+					"no",
+				},
+			},
 			{
 				Name:        "sysfs",
 				Type:        "map[string]string",
@@ -284,17 +297,17 @@ func (MachineConfig) Doc() *encoder.Doc {
 	doc.Fields[12].AddExample("", machineEnvExamples2())
 	doc.Fields[13].AddExample("Example configuration for cloudflare ntp server.", machineTimeExample())
 	doc.Fields[14].AddExample("MachineSysctls usage example.", machineSysctlsExample())
-	doc.Fields[15].AddExample("MachineSysfs usage example.", machineSysfsExample())
-	doc.Fields[16].AddExample("", machineConfigRegistriesExample())
-	doc.Fields[18].AddExample("", machineFeaturesExample())
-	doc.Fields[19].AddExample("", machineUdevExample())
-	doc.Fields[20].AddExample("", machineLoggingExample())
-	doc.Fields[21].AddExample("", machineKernelExample())
-	doc.Fields[22].AddExample("", machineSeccompExample())
-	doc.Fields[23].AddExample("override default open file limit", machineBaseRuntimeSpecOverridesExample())
-	doc.Fields[24].AddExample("node labels example.", map[string]string{"exampleLabel": "exampleLabelValue"})
-	doc.Fields[25].AddExample("node annotations example.", map[string]string{"customer.io/rack": "r13a25"})
-	doc.Fields[26].AddExample("node taints example.", map[string]string{"exampleTaint": "exampleTaintValue:NoSchedule"})
+	doc.Fields[16].AddExample("MachineSysfs usage example.", machineSysfsExample())
+	doc.Fields[17].AddExample("", machineConfigRegistriesExample())
+	doc.Fields[19].AddExample("", machineFeaturesExample())
+	doc.Fields[20].AddExample("", machineUdevExample())
+	doc.Fields[21].AddExample("", machineLoggingExample())
+	doc.Fields[22].AddExample("", machineKernelExample())
+	doc.Fields[23].AddExample("", machineSeccompExample())
+	doc.Fields[24].AddExample("override default open file limit", machineBaseRuntimeSpecOverridesExample())
+	doc.Fields[25].AddExample("node labels example.", map[string]string{"exampleLabel": "exampleLabelValue"})
+	doc.Fields[26].AddExample("node annotations example.", map[string]string{"customer.io/rack": "r13a25"})
+	doc.Fields[27].AddExample("node taints example.", map[string]string{"exampleTaint": "exampleTaintValue:NoSchedule"})
 
 	return doc
 }
@@ -527,6 +540,13 @@ func (ClusterConfig) Doc() *encoder.Doc {
 					"no",
 				},
 			},
+			{
+				Name:        "kubeletServingCertificateApprover",
+				Type:        "KubeletServingCertificateApproverConfig",
+				Note:        "",
+				Description: "Settings for the Talos-managed approval of the kubelet serving certificate signing requests.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Settings for the Talos-managed approval of the kubelet serving certificate signing requests." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
@@ -559,6 +579,7 @@ func (ClusterConfig) Doc() *encoder.Doc {
 	doc.Fields[22].AddExample("", clusterInlineManifestsExample())
 	doc.Fields[23].AddExample("", clusterAdminKubeconfigExample())
 	doc.Fields[25].AddExample("", true)
+	doc.Fields[26].AddExample("", clusterKubeletServingCertificateApproverExample())
 
 	return doc
 }
@@ -643,7 +664,7 @@ func (ExtraMount) Doc() *encoder.Doc {
 				Name:        "options",
 				Type:        "[]string",
 				Note:        "",
-				Description: "Options are fstab style mount options.",
+				Description: "Options are fstab style mount options.\n\nBind mounts accept `bind` or `rbind` (recursive), a propagation mode (`shared`, `rshared`, `slave`, `rslave`, `private`, `rprivate`),\nand `ro`, `rw` or `rro` (recursive read-only, requires `rbind` and kernel support).",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Options are fstab style mount options." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
 			{
@@ -803,7 +824,7 @@ func (KubeletConfig) Doc() *encoder.Doc {
 				Name:        "credentialProviderConfig",
 				Type:        "Unstructured",
 				Note:        "",
-				Description: "The `KubeletCredentialProviderConfig` field is used to provide kubelet credential configuration.",
+				Description: "The `KubeletCredentialProviderConfig` field is used to provide kubelet credential configuration.\nThe config is rendered as the kubelet `CredentialProviderConfig` file, and kubelet is restarted when it changes.\nProvider binaries are looked up in `/usr/local/lib/kubelet/credentialproviders`, which is populated by system extensions.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "The `KubeletCredentialProviderConfig` field is used to provide kubelet credential configuration." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
 			{
@@ -865,6 +886,33 @@ func (KubeletConfig) Doc() *encoder.Doc {
 					"no",
 				},
 			},
+			{
+				Name:        "extraConfigAllowUnknownFields",
+				Type:        "bool",
+				Note:        "",
+				Description: "The `extraConfigAllowUnknownFields` field disables strict validation of the `extraConfig` fields.\n\nBy default, `extraConfig` is validated against the KubeletConfiguration schema embedded into Talos,\nand unknown fields are rejected. Set this field to pass fields which are newer than the embedded schema.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The `extraConfigAllowUnknownFields` field disables strict validation of the `extraConfig` fields." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"true",
+					"yes",
+					"false",
+					"no",
+				},
+			},
+			{
+				Name:        "shutdownGracePeriod",
+				Type:        "Duration",
+				Note:        "",
+				Description: "The `shutdownGracePeriod` field configures the kubelet graceful node shutdown grace period.\n\nOn reboot, shutdown and upgrade Talos waits for the kubelet to terminate the pods within this period\n(plus a small margin) before stopping the kubelet.\nDefaults to 30 seconds, maximum value is 20 minutes.\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The `shutdownGracePeriod` field configures the kubelet graceful node shutdown grace period." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "shutdownGracePeriodCriticalPods",
+				Type:        "Duration",
+				Note:        "",
+				Description: "The `shutdownGracePeriodCriticalPods` field configures the part of the shutdown grace period reserved for the critical pods.\n\nShould be less than `shutdownGracePeriod`, defaults to 10 seconds.\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The `shutdownGracePeriodCriticalPods` field configures the part of the shutdown grace period reserved for the critical pods." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
@@ -965,6 +1013,20 @@ func (NetworkConfig) Doc() *encoder.Doc {
 					"no",
 				},
 			},
+			{
+				Name:        "resolverOptions",
+				Type:        "ResolverOptionsConfig",
+				Note:        "",
+				Description: "Tunes the resolver behavior.\n\nThe options are applied both to the `/etc/resolv.conf` used by the host processes,\nand to the upstream selection of the host DNS forwarder (if enabled).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Tunes the resolver behavior." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "routeRules",
+				Type:        "[]RouteRule",
+				Note:        "",
+				Description: "Routing policy rules (`ip rule`) to install.\nMatching packets are routed using the specified routing table,\nroutes can be installed into that table via the `table` field of the interface routes.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Routing policy rules (`ip rule`) to install." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
@@ -974,6 +1036,66 @@ func (NetworkConfig) Doc() *encoder.Doc {
 	doc.Fields[2].AddExample("", []string{"8.8.8.8", "1.1.1.1"})
 	doc.Fields[3].AddExample("", []string{"example.org", "example.com"})
 	doc.Fields[5].AddExample("", networkKubeSpanExample())
+	doc.Fields[7].AddExample("", networkResolverOptionsExample())
+	doc.Fields[8].AddExample("", networkRouteRulesExample())
+
+	return doc
+}
+
+func (ResolverOptionsConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "ResolverOptionsConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "ResolverOptionsConfig describes the resolver tuning options." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "ResolverOptionsConfig describes the resolver tuning options.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "NetworkConfig",
+				FieldName: "resolverOptions",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "rotate",
+				Type:        "bool",
+				Note:        "",
+				Description: "Query the nameservers in round-robin order instead of always starting with the first one.\n\nSets `options rotate` in `/etc/resolv.conf`, and makes the host DNS forwarder\nrotate the upstreams when `upstreamPolicy` is `sequential`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Query the nameservers in round-robin order instead of always starting with the first one." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"true",
+					"yes",
+					"false",
+					"no",
+				},
+			},
+			{
+				Name:        "timeout",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Timeout of a single query to a nameserver.\n\nSets `options timeout` in `/etc/resolv.conf` (rounded up to whole seconds),\nand bounds a query to a single upstream in the host DNS forwarder.\nShould be in the range from 1s to 30s.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Timeout of a single query to a nameserver." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "attempts",
+				Type:        "int",
+				Note:        "",
+				Description: "Number of times the resolver sends a query to the nameservers before giving up.\n\nSets `options attempts` in `/etc/resolv.conf`.\nShould be in the range from 1 to 5.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Number of times the resolver sends a query to the nameservers before giving up." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "upstreamPolicy",
+				Type:        "string",
+				Note:        "",
+				Description: "Upstream selection policy of the host DNS forwarder.\n\n`sequential` queries upstreams one by one until a successful response is received,\n`parallel` queries all upstreams at once and uses the first successful response.\nDefaults to `sequential`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Upstream selection policy of the host DNS forwarder." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"sequential",
+					"parallel",
+				},
+			},
+		},
+	}
+
+	doc.AddExample("", networkResolverOptionsExample())
 
 	return doc
 }
@@ -1182,11 +1304,98 @@ func (TimeConfig) Doc() *encoder.Doc {
 				Description: "Specifies the timeout when the node time is considered to be in sync unlocking the boot sequence.\nNTP sync will be still running in the background.\nDefaults to \"infinity\" (waiting forever for time sync)",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Specifies the timeout when the node time is considered to be in sync unlocking the boot sequence." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "dhcpServers",
+				Type:        "string",
+				Note:        "",
+				Description: "Controls how time servers received via DHCP are combined with the `servers` list.\n`ignore` uses only the `servers` list (if set), `supplement` appends DHCP servers as fallbacks\nafter the `servers` list, `replace` uses DHCP servers instead of the `servers` list when DHCP provides any.\nDefaults to `ignore`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Controls how time servers received via DHCP are combined with the `servers` list." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"ignore",
+					"supplement",
+					"replace",
+				},
+			},
+			{
+				Name:        "nts",
+				Type:        "TimeNTSConfig",
+				Note:        "",
+				Description: "Network Time Security (NTS) configuration.\nNTS servers are preferred over the plain time servers, and time received from them is authenticated.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Network Time Security (NTS) configuration." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
 	doc.AddExample("Example configuration for cloudflare ntp server.", machineTimeExample())
 
+	doc.Fields[4].AddExample("", machineTimeNTSExample())
+
+	return doc
+}
+
+func (TimeNTSConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "TimeNTSConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "TimeNTSConfig represents the Network Time Security (NTS) options." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "TimeNTSConfig represents the Network Time Security (NTS) options.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "TimeConfig",
+				FieldName: "nts",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "servers",
+				Type:        "[]TimeNTSServer",
+				Note:        "",
+				Description: "List of NTS servers to use for authenticated time sync.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "List of NTS servers to use for authenticated time sync." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "allowPlainFallback",
+				Type:        "bool",
+				Note:        "",
+				Description: "Allow falling back to the plain (unauthenticated) time servers if none of the NTS servers can be used.\nDefaults to `false`, so that only NTS servers are used if any are configured.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Allow falling back to the plain (unauthenticated) time servers if none of the NTS servers can be used." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", machineTimeNTSExample())
+
+	return doc
+}
+
+func (TimeNTSServer) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "TimeNTSServer",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "TimeNTSServer represents a single NTS server." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "TimeNTSServer represents a single NTS server.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "TimeNTSConfig",
+				FieldName: "servers",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "server",
+				Type:        "string",
+				Note:        "",
+				Description: "NTS key establishment server hostname, optionally with the port (defaults to 4460).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "NTS key establishment server hostname, optionally with the port (defaults to 4460)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "caCertificate",
+				Type:        "string",
+				Note:        "",
+				Description: "PEM-encoded CA certificate(s) used to verify the NTS key establishment server.\nIf not set, system root CAs are used.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "PEM-encoded CA certificate(s) used to verify the NTS key establishment server." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
 	return doc
 }
 
@@ -1719,6 +1928,27 @@ func (EtcdConfig) Doc() *encoder.Doc {
 				Description: "The `listenSubnets` field configures the networks for the etcd to listen for peer and client connections.\n\nIf `listenSubnets` is not set, but `advertisedSubnets` is set, `listenSubnets` defaults to\n`advertisedSubnets`.\n\nIf neither `advertisedSubnets` nor `listenSubnets` is set, `listenSubnets` defaults to listen on all addresses.\n\nIPs can be excluded from the list by using negative match with `!`, e.g `!10.0.0.0/8`.\nNegative subnet matches should be specified last to filter out IPs picked by positive matches.\nIf not specified, advertised IP is selected as the first routable address of the node.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "The `listenSubnets` field configures the networks for the etcd to listen for peer and client connections." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "defragmentation",
+				Type:        "EtcdDefragmentationConfig",
+				Note:        "",
+				Description: "The `defragmentation` field configures the scheduled defragmentation of the local etcd member.\n\nMembers are defragmented one at a time across the cluster.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The `defragmentation` field configures the scheduled defragmentation of the local etcd member." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "learnerPromotion",
+				Type:        "EtcdLearnerPromotionConfig",
+				Note:        "",
+				Description: "The `learnerPromotion` field configures the promotion of the new members which join the cluster as learners.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The `learnerPromotion` field configures the promotion of the new members which join the cluster as learners." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "snapshots",
+				Type:        "EtcdSnapshotsConfig",
+				Note:        "",
+				Description: "The `snapshots` field configures the scheduled snapshots of the local etcd member.\n\nSnapshots are stored on the EPHEMERAL partition, and optionally uploaded to the S3-compatible object storage.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The `snapshots` field configures the scheduled snapshots of the local etcd member." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
@@ -1727,6 +1957,200 @@ func (EtcdConfig) Doc() *encoder.Doc {
 	doc.Fields[0].AddExample("", clusterEtcdImageExample())
 	doc.Fields[1].AddExample("", pemEncodedCertificateExample())
 	doc.Fields[4].AddExample("", clusterEtcdAdvertisedSubnetsExample())
+	doc.Fields[6].AddExample("", clusterEtcdDefragmentationExample())
+	doc.Fields[7].AddExample("", clusterEtcdLearnerPromotionExample())
+	doc.Fields[8].AddExample("", clusterEtcdSnapshotsExample())
+
+	return doc
+}
+
+func (EtcdDefragmentationConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "EtcdDefragmentationConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "EtcdDefragmentationConfig represents the scheduled etcd defragmentation configuration." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "EtcdDefragmentationConfig represents the scheduled etcd defragmentation configuration.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "EtcdConfig",
+				FieldName: "defragmentation",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "schedule",
+				Type:        "string",
+				Note:        "",
+				Description: "Defragmentation schedule.\n\nSupported values are `@hourly`, `@daily`, `@weekly` and `@every <duration>` (e.g. `@every 12h`).\nThe minimum interval is one minute.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Defragmentation schedule." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "threshold",
+				Type:        "int",
+				Note:        "",
+				Description: "Minimum percentage of the etcd database size which is not in use to run defragmentation.\n\nIf not set, the member is defragmented on every scheduled run.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Minimum percentage of the etcd database size which is not in use to run defragmentation." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", clusterEtcdDefragmentationExample())
+
+	doc.Fields[0].AddExample("", "@daily")
+	doc.Fields[1].AddExample("", 30)
+
+	return doc
+}
+
+func (EtcdLearnerPromotionConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "EtcdLearnerPromotionConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "EtcdLearnerPromotionConfig represents the etcd learner promotion policy." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "EtcdLearnerPromotionConfig represents the etcd learner promotion policy.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "EtcdConfig",
+				FieldName: "learnerPromotion",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "maxLagEntries",
+				Type:        "uint64",
+				Note:        "",
+				Description: "Maximum number of raft log entries the learner can lag behind the voting members to be promoted.\n\nDefaults to 1000.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Maximum number of raft log entries the learner can lag behind the voting members to be promoted." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "stuckTimeout",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Time after which a learner which is still not promoted is reported as stuck.\n\nDefaults to 10 minutes.\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Time after which a learner which is still not promoted is reported as stuck." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "maxLearners",
+				Type:        "int",
+				Note:        "",
+				Description: "Maximum number of learners in the cluster at the same time.\n\nNew members wait to join the cluster while the limit is reached.\nDefaults to 1.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Maximum number of learners in the cluster at the same time." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", clusterEtcdLearnerPromotionExample())
+
+	return doc
+}
+
+func (EtcdSnapshotsConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "EtcdSnapshotsConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "EtcdSnapshotsConfig represents the scheduled etcd snapshots configuration." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "EtcdSnapshotsConfig represents the scheduled etcd snapshots configuration.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "EtcdConfig",
+				FieldName: "snapshots",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "schedule",
+				Type:        "string",
+				Note:        "",
+				Description: "Snapshot schedule.\n\nSupported values are `@hourly`, `@daily`, `@weekly` and `@every <duration>` (e.g. `@every 12h`).\nThe minimum interval is one minute.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Snapshot schedule." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "retention",
+				Type:        "int",
+				Note:        "",
+				Description: "Number of the snapshots to keep on the node, older snapshots are removed.\n\nDefaults to 3.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Number of the snapshots to keep on the node, older snapshots are removed." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "s3",
+				Type:        "EtcdSnapshotsS3Config",
+				Note:        "",
+				Description: "S3-compatible object storage to upload the snapshots to.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "S3-compatible object storage to upload the snapshots to." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", clusterEtcdSnapshotsExample())
+
+	doc.Fields[0].AddExample("", "@every 6h")
+
+	return doc
+}
+
+func (EtcdSnapshotsS3Config) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "EtcdSnapshotsS3Config",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "EtcdSnapshotsS3Config represents the S3-compatible object storage destination of the etcd snapshots." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "EtcdSnapshotsS3Config represents the S3-compatible object storage destination of the etcd snapshots.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "EtcdSnapshotsConfig",
+				FieldName: "s3",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "endpoint",
+				Type:        "string",
+				Note:        "",
+				Description: "Object storage endpoint URL.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Object storage endpoint URL." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "region",
+				Type:        "string",
+				Note:        "",
+				Description: "Object storage region.\n\nDefaults to `us-east-1`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Object storage region." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "bucket",
+				Type:        "string",
+				Note:        "",
+				Description: "Bucket to upload the snapshots to.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Bucket to upload the snapshots to." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "prefix",
+				Type:        "string",
+				Note:        "",
+				Description: "Prefix of the uploaded snapshot object names.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Prefix of the uploaded snapshot object names." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "accessKeyID",
+				Type:        "string",
+				Note:        "",
+				Description: "Access key ID.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Access key ID." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "secretAccessKey",
+				Type:        "string",
+				Note:        "",
+				Description: "Secret access key.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Secret access key." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "serverSideEncryption",
+				Type:        "bool",
+				Note:        "",
+				Description: "Request the server-side encryption (SSE-S3) of the uploaded snapshots.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Request the server-side encryption (SSE-S3) of the uploaded snapshots." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", "https://s3.us-east-1.amazonaws.com")
+	doc.Fields[3].AddExample("", "etcd/production")
 
 	return doc
 }
@@ -1857,15 +2281,15 @@ func (FlannelCNIConfig) Doc() *encoder.Doc {
 	return doc
 }
 
-func (ExternalCloudProviderConfig) Doc() *encoder.Doc {
+func (KubeletServingCertificateApproverConfig) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
-		Type:        "ExternalCloudProviderConfig",
-		Comments:    [3]string{"" /* encoder.HeadComment */, "ExternalCloudProviderConfig contains external cloud provider configuration." /* encoder.LineComment */, "" /* encoder.FootComment */},
-		Description: "ExternalCloudProviderConfig contains external cloud provider configuration.",
+		Type:        "KubeletServingCertificateApproverConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "KubeletServingCertificateApproverConfig contains kubelet serving certificate approver configuration." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "KubeletServingCertificateApproverConfig contains kubelet serving certificate approver configuration.",
 		AppearsIn: []encoder.Appearance{
 			{
 				TypeName:  "ClusterConfig",
-				FieldName: "externalCloudProvider",
+				FieldName: "kubeletServingCertificateApprover",
 			},
 		},
 		Fields: []encoder.Doc{
@@ -1873,8 +2297,8 @@ func (ExternalCloudProviderConfig) Doc() *encoder.Doc {
 				Name:        "enabled",
 				Type:        "bool",
 				Note:        "",
-				Description: "Enable external cloud provider.",
-				Comments:    [3]string{"" /* encoder.HeadComment */, "Enable external cloud provider." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Description: "Enable approval of the kubelet serving certificate signing requests by the control plane nodes.\n\nRequests are approved only if the requested DNS names and IP addresses match the node's hostname and addresses,\nmismatching requests are denied.\nKubelet serving certificate rotation should be enabled with the `rotate-server-certificates` kubelet extra argument.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Enable approval of the kubelet serving certificate signing requests by the control plane nodes." /* encoder.LineComment */, "" /* encoder.FootComment */},
 				Values: []string{
 					"true",
 					"yes",
@@ -1882,7 +2306,40 @@ func (ExternalCloudProviderConfig) Doc() *encoder.Doc {
 					"no",
 				},
 			},
-			{
+		},
+	}
+
+	doc.AddExample("", clusterKubeletServingCertificateApproverExample())
+
+	return doc
+}
+
+func (ExternalCloudProviderConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "ExternalCloudProviderConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "ExternalCloudProviderConfig contains external cloud provider configuration." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "ExternalCloudProviderConfig contains external cloud provider configuration.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "ClusterConfig",
+				FieldName: "externalCloudProvider",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "enabled",
+				Type:        "bool",
+				Note:        "",
+				Description: "Enable external cloud provider.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Enable external cloud provider." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"true",
+					"yes",
+					"false",
+					"no",
+				},
+			},
+			{
 				Name:        "manifests",
 				Type:        "[]string",
 				Note:        "",
@@ -2098,7 +2555,7 @@ func (Device) Doc() *encoder.Doc {
 				Name:        "mtu",
 				Type:        "int",
 				Note:        "",
-				Description: "The interface's MTU.\nIf used in combination with DHCP, this will override any MTU settings returned from DHCP server.",
+				Description: "The interface's MTU.\nIf used in combination with DHCP, this will override any MTU settings returned from DHCP server.\n\nFor bonds and bridges, the MTU is set on the bond or bridge interface.\nIf a VLAN on top of the interface has a higher MTU, the interface MTU is raised to match it.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "The interface's MTU." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
 			{
@@ -2143,6 +2600,20 @@ func (Device) Doc() *encoder.Doc {
 				Description: "Virtual (shared) IP address configuration.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Virtual (shared) IP address configuration." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "routerAdvertisements",
+				Type:        "DeviceRouterAdvertisementsConfig",
+				Note:        "",
+				Description: "IPv6 router advertisement handling for the interface.\nWhen set, router advertisements are processed by Talos honoring the router preference and route information options,\notherwise they are handled by the kernel.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "IPv6 router advertisement handling for the interface." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "dhcpFallback",
+				Type:        "DeviceDHCPFallbackConfig",
+				Note:        "",
+				Description: "Fall back to DHCPv4 if the gateway of the static configuration is not reachable.\nThe static `addresses` and `routes` of the interface are applied first, and the gateway of the default route is probed via ARP.\nIf the gateway doesn't respond within the probe timeout, the static configuration is replaced with DHCPv4,\nand the gateway is re-probed periodically to switch back to the static configuration.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Fall back to DHCPv4 if the gateway of the static configuration is not reachable." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
@@ -2161,6 +2632,83 @@ func (Device) Doc() *encoder.Doc {
 	doc.Fields[14].AddExample("wireguard server example", networkConfigWireguardHostExample())
 	doc.Fields[14].AddExample("wireguard peer example", networkConfigWireguardPeerExample())
 	doc.Fields[15].AddExample("layer2 vip example", networkConfigVIPLayer2Example())
+	doc.Fields[16].AddExample("", networkConfigRouterAdvertisementsExample())
+	doc.Fields[17].AddExample("", networkConfigDHCPFallbackExample())
+
+	return doc
+}
+
+func (DeviceDHCPFallbackConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "DeviceDHCPFallbackConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "DeviceDHCPFallbackConfig contains settings for falling back to DHCP if the static configuration of an interface doesn't work." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "DeviceDHCPFallbackConfig contains settings for falling back to DHCP if the static configuration of an interface doesn't work.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "Device",
+				FieldName: "dhcpFallback",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "enabled",
+				Type:        "bool",
+				Note:        "",
+				Description: "Enable the fallback to DHCPv4.\nRequires static `addresses` and a default route with an IPv4 gateway to be configured for the interface.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Enable the fallback to DHCPv4." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "probeTimeout",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Time to wait for the gateway to respond to the ARP probe (default is 10s).\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Time to wait for the gateway to respond to the ARP probe (default is 10s)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "probeInterval",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Interval to re-probe the gateway, both to detect the failure of the static configuration\nand to switch back to it from DHCPv4 (default is 1m).\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Interval to re-probe the gateway, both to detect the failure of the static configuration" /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", networkConfigDHCPFallbackExample())
+
+	return doc
+}
+
+func (DeviceRouterAdvertisementsConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "DeviceRouterAdvertisementsConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "DeviceRouterAdvertisementsConfig contains settings for handling IPv6 router advertisements on an interface." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "DeviceRouterAdvertisementsConfig contains settings for handling IPv6 router advertisements on an interface.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "Device",
+				FieldName: "routerAdvertisements",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "accept",
+				Type:        "bool",
+				Note:        "",
+				Description: "Accept IPv6 router advertisements on the interface (default is enabled).\nWhen disabled, router advertisements are ignored by the kernel as well (no SLAAC addresses and routes).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Accept IPv6 router advertisements on the interface (default is enabled)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "acceptRDNSS",
+				Type:        "bool",
+				Note:        "",
+				Description: "Use recursive DNS servers (RDNSS) from router advertisements as resolvers (default is enabled).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Use recursive DNS servers (RDNSS) from router advertisements as resolvers (default is enabled)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", networkConfigRouterAdvertisementsExample())
 
 	return doc
 }
@@ -2209,11 +2757,69 @@ func (DHCPOptions) Doc() *encoder.Doc {
 				Description: "Set client DUID (hex string).",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Set client DUID (hex string)." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "duidv6Type",
+				Type:        "string",
+				Note:        "",
+				Description: "Set client DUID type to be generated for DHCPv6.\n`LL` is based on the link-layer address, `LLT` is based on the link-layer address (with the time field set to zero),\n`UUID` is based on the machine UUID.\nIgnored if `duidv6` is set.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Set client DUID type to be generated for DHCPv6." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"LL",
+					"LLT",
+					"UUID",
+				},
+			},
+			{
+				Name:        "iaidv6",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Set identity association identifier (IAID) for DHCPv6.\nDefaults to the last 4 bytes of the link-layer address.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Set identity association identifier (IAID) for DHCPv6." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "ignoreClasslessStaticRoutes",
+				Type:        "bool",
+				Note:        "",
+				Description: "Ignore classless static routes (DHCP options 121 and 249) received via DHCPv4.\nWhen set, only the default gateway from the router option (option 3) is used.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Ignore classless static routes (DHCP options 121 and 249) received via DHCPv4." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "optimisticLease",
+				Type:        "bool",
+				Note:        "",
+				Description: "Apply the DHCPv4 lease cached from the previous boot right away, while it is being confirmed by the DHCP server.\nExpired leases are never applied.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Apply the DHCPv4 lease cached from the previous boot right away, while it is being confirmed by the DHCP server." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "clientIdentifier",
+				Type:        "string",
+				Note:        "",
+				Description: "Set the DHCPv4 client identifier (option 61).\n`mac` sends the link-layer address, `duid` sends the RFC 4361 identifier built from the DHCPv6 IAID and DUID.\nColon-separated hex bytes are sent as the raw option value, any other string is sent as is.\nBy default, no client identifier is sent.\nChanging the client identifier releases the current lease and acquires a new one.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Set the DHCPv4 client identifier (option 61)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "sendHostname",
+				Type:        "bool",
+				Note:        "",
+				Description: "Send the node hostname to the DHCPv4 server (default is enabled).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Send the node hostname to the DHCPv4 server (default is enabled)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "requestedOptions",
+				Type:        "[]uint32",
+				Note:        "",
+				Description: "Override the list of options requested from the DHCPv4 server (option 55).\nThe hostname is only taken from the DHCPv4 server if the hostname option (12) is requested.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Override the list of options requested from the DHCPv4 server (option 55)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
 	doc.AddExample("", networkConfigDHCPOptionsExample())
 
+	doc.Fields[8].AddExample("", "mac")
+	doc.Fields[8].AddExample("", "01:52:54:00:12:34:56")
+	doc.Fields[10].AddExample("", []uint32{1, 3, 6, 12, 15, 42})
+
 	return doc
 }
 
@@ -2257,6 +2863,13 @@ func (DeviceWireguardConfig) Doc() *encoder.Doc {
 				Description: "Specifies a list of peer configurations to apply to a device.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Specifies a list of peer configurations to apply to a device." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "endpointResolveInterval",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Specifies how often peer endpoints specified as hostnames are re-resolved.\n\nThe peer endpoint is updated only when the resolved address changes and\nthe last handshake with the peer is stale, so a working tunnel is never disrupted.\nDefaults to 60s.\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Specifies how often peer endpoints specified as hostnames are re-resolved." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
@@ -2350,6 +2963,20 @@ func (DeviceVIPConfig) Doc() *encoder.Doc {
 				Description: "Specifies the Hetzner Cloud API settings to assign VIP to the node.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Specifies the Hetzner Cloud API settings to assign VIP to the node." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "priority",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Specifies the priority of the node to own the shared IP.\nThe node with the highest priority becomes the owner, nodes with the same priority (including the default of zero)\nkeep the election order: the first node to come up owns the shared IP.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Specifies the priority of the node to own the shared IP." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "preempt",
+				Type:        "bool",
+				Note:        "",
+				Description: "Specifies whether a node with a higher priority takes the shared IP over from the current owner.\nWhen disabled, the shared IP stays with the current owner when a node with a higher priority comes up.\nDefaults to `true`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Specifies whether a node with a higher priority takes the shared IP over from the current owner." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
@@ -2438,7 +3065,14 @@ func (Bond) Doc() *encoder.Doc {
 				Name:        "arpIPTarget",
 				Type:        "[]string",
 				Note:        "",
-				Description: "A bond option.\nPlease see the official kernel documentation.\nNot supported at the moment.",
+				Description: "A bond option.\nPlease see the official kernel documentation.\nIPv4 addresses used as ARP monitoring targets, requires `arpInterval` to be set.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "A bond option." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "nsIP6Target",
+				Type:        "[]string",
+				Note:        "",
+				Description: "A bond option.\nPlease see the official kernel documentation.\nIPv6 addresses used as NS monitoring targets, requires `arpInterval` to be set.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "A bond option." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
 			{
@@ -2670,6 +3304,59 @@ func (BridgeVLAN) Doc() *encoder.Doc {
 				Description: "Whether VLAN filtering is enabled.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Whether VLAN filtering is enabled." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "ports",
+				Type:        "[]BridgeVLANPort",
+				Note:        "",
+				Description: "VLAN configuration of the bridge ports.\nRequires `vlanFiltering` to be enabled.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "VLAN configuration of the bridge ports." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	return doc
+}
+
+func (BridgeVLANPort) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "BridgeVLANPort",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "BridgeVLANPort contains the VLAN configuration of a bridge port." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "BridgeVLANPort contains the VLAN configuration of a bridge port.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "BridgeVLAN",
+				FieldName: "ports",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "interface",
+				Type:        "string",
+				Note:        "",
+				Description: "The name of the bridge port interface.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The name of the bridge port interface." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "pvid",
+				Type:        "uint16",
+				Note:        "",
+				Description: "Port VLAN ID (PVID) assigned to the untagged traffic received on the port.\nPVID egresses untagged unless it is listed in `tagged`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Port VLAN ID (PVID) assigned to the untagged traffic received on the port." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "untagged",
+				Type:        "[]uint16",
+				Note:        "",
+				Description: "The list of VLAN IDs which egress the port untagged.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The list of VLAN IDs which egress the port untagged." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "tagged",
+				Type:        "[]uint16",
+				Note:        "",
+				Description: "The list of VLAN IDs which egress the port tagged.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The list of VLAN IDs which egress the port tagged." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
@@ -2789,7 +3476,7 @@ func (Vlan) Doc() *encoder.Doc {
 				Name:        "mtu",
 				Type:        "uint32",
 				Note:        "",
-				Description: "The VLAN's MTU.",
+				Description: "The VLAN's MTU.\n\nThe VLAN MTU can't exceed the MTU of the parent interface.\nIf the parent interface MTU is managed by Talos, it is raised automatically, otherwise the VLAN MTU is not applied.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "The VLAN's MTU." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
 			{
@@ -2863,6 +3550,13 @@ func (Route) Doc() *encoder.Doc {
 				Description: "The optional MTU for the route.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "The optional MTU for the route." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "table",
+				Type:        "uint32",
+				Note:        "",
+				Description: "The routing table to install the route into.\nDefaults to the main routing table (`254`).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The routing table to install the route into." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
@@ -2871,6 +3565,68 @@ func (Route) Doc() *encoder.Doc {
 	return doc
 }
 
+func (RouteRule) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "RouteRule",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "RouteRule represents a routing policy rule." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "RouteRule represents a routing policy rule.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "NetworkConfig",
+				FieldName: "routeRules",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "from",
+				Type:        "string",
+				Note:        "",
+				Description: "The source address prefix to match (optional).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The source address prefix to match (optional)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "to",
+				Type:        "string",
+				Note:        "",
+				Description: "The destination address prefix to match (optional).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The destination address prefix to match (optional)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "fwMark",
+				Type:        "uint32",
+				Note:        "",
+				Description: "The firewall mark to match (optional).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The firewall mark to match (optional)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "fwMask",
+				Type:        "uint32",
+				Note:        "",
+				Description: "The mask applied to the firewall mark before matching.\nDefaults to `0xffffffff` if `fwMark` is set.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The mask applied to the firewall mark before matching." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "priority",
+				Type:        "uint32",
+				Note:        "",
+				Description: "The priority of the rule, rules are evaluated in the ascending order of priority.\nShould be in the range 1-32765, as 0, 32766 and 32767 are used by the kernel default rules.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The priority of the rule, rules are evaluated in the ascending order of priority." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "table",
+				Type:        "uint32",
+				Note:        "",
+				Description: "The routing table to look up if the rule matches.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The routing table to look up if the rule matches." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", networkRouteRulesExample())
+
+	return doc
+}
+
 func (RegistryMirrorConfig) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
 		Type:        "RegistryMirrorConfig",
@@ -3077,6 +3833,13 @@ func (FeaturesConfig) Doc() *encoder.Doc {
 				Description: "Enable checks for extended key usage of client certificates in apid.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Enable checks for extended key usage of client certificates in apid." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "apidProxyTargetCheck",
+				Type:        "ApidProxyTargetCheckConfig",
+				Note:        "",
+				Description: "Restrict apid request forwarding to cluster members.\n\nWhen enabled, apid only proxies requests to the local node, discovered cluster members,\nand addresses in the allowed CIDRs, other targets are rejected.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Restrict apid request forwarding to cluster members." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 			{
 				Name:        "diskQuotaSupport",
 				Type:        "bool",
@@ -3112,12 +3875,20 @@ func (FeaturesConfig) Doc() *encoder.Doc {
 				Description: "Select the node address sort algorithm.\nThe 'v1' algorithm sorts addresses by the address itself.\nThe 'v2' algorithm prefers more specific prefixes.\nIf unset, defaults to 'v1'.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Select the node address sort algorithm." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "disableSecretsBundleExport",
+				Type:        "bool",
+				Note:        "",
+				Description: "Disable the export of the secrets bundle via the Talos API (`talosctl get secrets-bundle`).\n\nThe secrets bundle contains the cluster CAs and keys, so the export might be disabled in locked-down environments.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Disable the export of the secrets bundle via the Talos API (`talosctl get secrets-bundle`)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
 	doc.AddExample("", machineFeaturesExample())
 
 	doc.Fields[2].AddExample("", kubernetesTalosAPIAccessConfigExample())
+	doc.Fields[4].AddExample("", apidProxyTargetCheckExample())
 
 	return doc
 }
@@ -3148,9 +3919,52 @@ func (KubePrism) Doc() *encoder.Doc {
 				Description: "KubePrism port.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "KubePrism port." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "failoverOnly",
+				Type:        "bool",
+				Note:        "",
+				Description: "Use the cluster endpoint exclusively while it is healthy, and fail over to the individual\ncontrol plane nodes only when the cluster endpoint is down.\n\nBy default, KubePrism balances the connections across all the control plane endpoints.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Use the cluster endpoint exclusively while it is healthy, and fail over to the individual" /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	return doc
+}
+
+func (ApidProxyTargetCheckConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "ApidProxyTargetCheckConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "ApidProxyTargetCheckConfig describes the configuration for apid proxy target checks." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "ApidProxyTargetCheckConfig describes the configuration for apid proxy target checks.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "FeaturesConfig",
+				FieldName: "apidProxyTargetCheck",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "enabled",
+				Type:        "bool",
+				Note:        "",
+				Description: "Enable apid proxy target checks.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Enable apid proxy target checks." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "allowedCIDRs",
+				Type:        "[]string",
+				Note:        "",
+				Description: "List of CIDRs which are allowed as proxy targets in addition to cluster members.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "List of CIDRs which are allowed as proxy targets in addition to cluster members." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
+	doc.AddExample("", apidProxyTargetCheckExample())
+
+	doc.Fields[1].AddExample("", []string{"10.5.0.0/16", "fd00::/64"})
+
 	return doc
 }
 
@@ -3253,9 +4067,60 @@ func (HostDNSConfig) Doc() *encoder.Doc {
 				Description: "Resolve member hostnames using the host DNS resolver.\n\nWhen enabled, cluster member hostnames and node names are resolved using the host DNS resolver.\nThis requires service discovery to be enabled.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Resolve member hostnames using the host DNS resolver." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "cacheSize",
+				Type:        "int",
+				Note:        "",
+				Description: "Maximum number of entries in the host DNS cache.\n\nLeast recently used entries are evicted when the cache is full.\nDefaults to 10000.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Maximum number of entries in the host DNS cache." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "cacheMinTTL",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Minimum TTL of the cached responses.\n\nResponses with a lower TTL are cached (and served) with this TTL.\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Minimum TTL of the cached responses." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "cacheMaxTTL",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Maximum TTL of the cached responses.\n\nResponses with a higher TTL are cached (and served) with this TTL.\nDefaults to 1h.\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Maximum TTL of the cached responses." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "disableNegativeCache",
+				Type:        "bool",
+				Note:        "",
+				Description: "Disable caching of negative (NXDOMAIN and NODATA) responses.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Disable caching of negative (NXDOMAIN and NODATA) responses." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "negativeCacheTTL",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Maximum TTL of the cached negative (NXDOMAIN and NODATA) responses.\n\nDefaults to 10s.\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Maximum TTL of the cached negative (NXDOMAIN and NODATA) responses." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "serveStale",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Serve expired cache entries for up to this duration when all upstream resolvers fail.\n\nStale responses are served with a TTL of 30s.\nDisabled by default.\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Serve expired cache entries for up to this duration when all upstream resolvers fail." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "allowedClients",
+				Type:        "[]string",
+				Note:        "",
+				Description: "List of CIDRs of the clients which are allowed to query the host DNS resolver.\n\nQueries from other clients are refused.\nLoopback addresses and the host DNS address are always allowed.\nDefaults to the pod CIDRs.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "List of CIDRs of the clients which are allowed to query the host DNS resolver." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
+	doc.Fields[9].AddExample("", []string{"10.244.0.0/16", "fd00:10:244::/56"})
+
 	return doc
 }
 
@@ -3401,6 +4266,13 @@ func (NetworkKubeSpan) Doc() *encoder.Doc {
 				Description: "KubeSpan advanced filtering of network addresses .\n\nSettings in this section are optional, and settings apply only to the node.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "KubeSpan advanced filtering of network addresses ." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "keepalive",
+				Type:        "KubeSpanKeepalive",
+				Note:        "",
+				Description: "KubeSpan Wireguard persistent keepalive settings.\n\nSettings in this section are optional, and settings apply only to the node.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "KubeSpan Wireguard persistent keepalive settings." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
@@ -3428,10 +4300,98 @@ func (KubeSpanFilters) Doc() *encoder.Doc {
 				Description: "Filter node addresses which will be advertised as KubeSpan endpoints for peer-to-peer Wireguard connections.\n\nBy default, all addresses are advertised, and KubeSpan cycles through all endpoints until it finds one that works.\n\nDefault value: no filtering.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Filter node addresses which will be advertised as KubeSpan endpoints for peer-to-peer Wireguard connections." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "peerEndpoints",
+				Type:        "[]string",
+				Note:        "",
+				Description: "Filter peer endpoints which will be used by this node to establish Wireguard connections to the peers.\n\nBy default, all endpoints advertised by the peer are used.\nEndpoints which don't match the filter are skipped during endpoint selection.\nChanging the filter only switches the peers whose current endpoint no longer matches the filter.\n\nDefault value: no filtering.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Filter peer endpoints which will be used by this node to establish Wireguard connections to the peers." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
 	doc.Fields[0].AddExample("Exclude addresses in 192.168.0.0/16 subnet.", []string{"0.0.0.0/0", "!192.168.0.0/16", "::/0"})
+	doc.Fields[1].AddExample("Only connect to the peers over the private network.", []string{"10.0.0.0/8", "!10.255.0.0/16"})
+
+	return doc
+}
+
+func (KubeSpanKeepalive) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "KubeSpanKeepalive",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "KubeSpanKeepalive struct describes KubeSpan Wireguard persistent keepalive settings." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "KubeSpanKeepalive struct describes KubeSpan Wireguard persistent keepalive settings.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "NetworkKubeSpan",
+				FieldName: "keepalive",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "interval",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Interval of the Wireguard persistent keepalive sent to the peers.\n\nDefault value: 25s.\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Interval of the Wireguard persistent keepalive sent to the peers." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "natOnly",
+				Type:        "bool",
+				Note:        "",
+				Description: "Send the persistent keepalive only to the peers which are detected to be behind NAT.\n\nThe peer is considered to be behind NAT if the endpoint seen by Wireguard\ndoesn't match any of the endpoints advertised by the peer.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Send the persistent keepalive only to the peers which are detected to be behind NAT." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "overrides",
+				Type:        "[]KubeSpanKeepaliveOverride",
+				Note:        "",
+				Description: "Override the persistent keepalive interval for the peers matching the label or the endpoint CIDR.\n\nThe first matching override wins, overrides are applied regardless of the NAT detection.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Override the persistent keepalive interval for the peers matching the label or the endpoint CIDR." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	return doc
+}
+
+func (KubeSpanKeepaliveOverride) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "KubeSpanKeepaliveOverride",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "KubeSpanKeepaliveOverride struct describes KubeSpan persistent keepalive override for a set of peers." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "KubeSpanKeepaliveOverride struct describes KubeSpan persistent keepalive override for a set of peers.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "KubeSpanKeepalive",
+				FieldName: "overrides",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "label",
+				Type:        "string",
+				Note:        "",
+				Description: "Peer label (hostname) to match.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Peer label (hostname) to match." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "cidr",
+				Type:        "string",
+				Note:        "",
+				Description: "CIDR to match the peer endpoint against.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "CIDR to match the peer endpoint against." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "interval",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Persistent keepalive interval for the matching peers, zero disables the keepalive.\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Persistent keepalive interval for the matching peers, zero disables the keepalive." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[1].AddExample("", "192.168.0.0/16")
 
 	return doc
 }
@@ -3701,17 +4661,18 @@ func (LoggingDestination) Doc() *encoder.Doc {
 				Name:        "endpoint",
 				Type:        "Endpoint",
 				Note:        "",
-				Description: "Where to send logs. Supported protocols are \"tcp\" and \"udp\".",
+				Description: "Where to send logs. Supported protocols are \"tcp\" and \"udp\".\nThe \"tls\" protocol is supported with the \"syslog\" format.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Where to send logs. Supported protocols are \"tcp\" and \"udp\"." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
 			{
 				Name:        "format",
 				Type:        "string",
 				Note:        "",
-				Description: "Logs format.",
+				Description: "Logs format.\n\nThe \"syslog\" format sends RFC 5424 messages, using octet-counting framing for \"tcp\" and \"tls\".",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Logs format." /* encoder.LineComment */, "" /* encoder.FootComment */},
 				Values: []string{
 					"json_lines",
+					"syslog",
 				},
 			},
 			{
@@ -3721,6 +4682,13 @@ func (LoggingDestination) Doc() *encoder.Doc {
 				Description: "Extra tags (key-value) pairs to attach to every log message sent.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Extra tags (key-value) pairs to attach to every log message sent." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "tls",
+				Type:        "LoggingTLSConfig",
+				Note:        "",
+				Description: "TLS configuration for the \"tls\" protocol.\n\nIf the CA is not set, system trusted roots are used.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "TLS configuration for the \"tls\" protocol." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
@@ -3730,6 +4698,40 @@ func (LoggingDestination) Doc() *encoder.Doc {
 	return doc
 }
 
+func (LoggingTLSConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "LoggingTLSConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "LoggingTLSConfig struct configures TLS for the logging destination." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "LoggingTLSConfig struct configures TLS for the logging destination.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "LoggingDestination",
+				FieldName: "tls",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "clientIdentity",
+				Type:        "PEMEncodedCertificateAndKey",
+				Note:        "",
+				Description: "Client certificate and key for mutual TLS authentication.\nClient certificate and key should be base64-encoded.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Client certificate and key for mutual TLS authentication." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "ca",
+				Type:        "Base64Bytes",
+				Note:        "",
+				Description: "CA certificate to verify the logging endpoint.\nCertificate should be base64-encoded.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "CA certificate to verify the logging endpoint." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", pemEncodedCertificateExample())
+
+	return doc
+}
+
 func (KernelConfig) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
 		Type:        "KernelConfig",
@@ -3746,7 +4748,7 @@ func (KernelConfig) Doc() *encoder.Doc {
 				Name:        "modules",
 				Type:        "[]KernelModuleConfig",
 				Note:        "",
-				Description: "Kernel modules to load.",
+				Description: "Kernel modules to load.\n\nModules are loaded in the order they are listed.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Kernel modules to load." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
 		},
@@ -3780,8 +4782,8 @@ func (KernelModuleConfig) Doc() *encoder.Doc {
 				Name:        "parameters",
 				Type:        "[]string",
 				Note:        "",
-				Description: "Module parameters, changes applied after reboot.",
-				Comments:    [3]string{"" /* encoder.HeadComment */, "Module parameters, changes applied after reboot." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Description: "Module parameters in `key=value` form.\n\nIf the parameters of an already loaded module change, Talos tries to reload the module.\nIf the module can't be unloaded, the change is applied after a reboot.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Module parameters in `key=value` form." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
 		},
 	}
@@ -3807,9 +4809,12 @@ func GetFileDoc() *encoder.FileDoc {
 			KubeletConfig{}.Doc(),
 			KubeletNodeIPConfig{}.Doc(),
 			NetworkConfig{}.Doc(),
+			ResolverOptionsConfig{}.Doc(),
 			InstallConfig{}.Doc(),
 			InstallDiskSelector{}.Doc(),
 			TimeConfig{}.Doc(),
+			TimeNTSConfig{}.Doc(),
+			TimeNTSServer{}.Doc(),
 			RegistriesConfig{}.Doc(),
 			CoreDNS{}.Doc(),
 			Endpoint{}.Doc(),
@@ -3821,14 +4826,21 @@ func GetFileDoc() *encoder.FileDoc {
 			ProxyConfig{}.Doc(),
 			SchedulerConfig{}.Doc(),
 			EtcdConfig{}.Doc(),
+			EtcdDefragmentationConfig{}.Doc(),
+			EtcdLearnerPromotionConfig{}.Doc(),
+			EtcdSnapshotsConfig{}.Doc(),
+			EtcdSnapshotsS3Config{}.Doc(),
 			ClusterNetworkConfig{}.Doc(),
 			CNIConfig{}.Doc(),
 			FlannelCNIConfig{}.Doc(),
+			KubeletServingCertificateApproverConfig{}.Doc(),
 			ExternalCloudProviderConfig{}.Doc(),
 			AdminKubeconfigConfig{}.Doc(),
 			ResourcesConfig{}.Doc(),
 			MachineFile{}.Doc(),
 			Device{}.Doc(),
+			DeviceDHCPFallbackConfig{}.Doc(),
+			DeviceRouterAdvertisementsConfig{}.Doc(),
 			DHCPOptions{}.Doc(),
 			DeviceWireguardConfig{}.Doc(),
 			DeviceWireguardPeer{}.Doc(),
@@ -3838,16 +4850,19 @@ func GetFileDoc() *encoder.FileDoc {
 			Bond{}.Doc(),
 			STP{}.Doc(),
 			BridgeVLAN{}.Doc(),
+			BridgeVLANPort{}.Doc(),
 			Bridge{}.Doc(),
 			BridgePort{}.Doc(),
 			Vlan{}.Doc(),
 			Route{}.Doc(),
+			RouteRule{}.Doc(),
 			RegistryMirrorConfig{}.Doc(),
 			RegistryConfig{}.Doc(),
 			RegistryAuthConfig{}.Doc(),
 			RegistryTLSConfig{}.Doc(),
 			FeaturesConfig{}.Doc(),
 			KubePrism{}.Doc(),
+			ApidProxyTargetCheckConfig{}.Doc(),
 			ImageCacheConfig{}.Doc(),
 			KubernetesTalosAPIAccessConfig{}.Doc(),
 			HostDNSConfig{}.Doc(),
@@ -3855,6 +4870,8 @@ func GetFileDoc() *encoder.FileDoc {
 			ClusterInlineManifest{}.Doc(),
 			NetworkKubeSpan{}.Doc(),
 			KubeSpanFilters{}.Doc(),
+			KubeSpanKeepalive{}.Doc(),
+			KubeSpanKeepaliveOverride{}.Doc(),
 			NetworkDeviceSelector{}.Doc(),
 			ClusterDiscoveryConfig{}.Doc(),
 			DiscoveryRegistriesConfig{}.Doc(),
@@ -3863,6 +4880,7 @@ func GetFileDoc() *encoder.FileDoc {
 			UdevConfig{}.Doc(),
 			LoggingConfig{}.Doc(),
 			LoggingDestination{}.Doc(),
+			LoggingTLSConfig{}.Doc(),
 			KernelConfig{}.Doc(),
 			KernelModuleConfig{}.Doc(),
 		},
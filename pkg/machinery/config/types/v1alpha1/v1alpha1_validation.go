@@ -5,23 +5,30 @@
 package v1alpha1
 
 import (
+	"cmp"
 	"context"
+	"crypto/x509"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
 	"net"
+	"net/netip"
 	"net/url"
 	"os"
 	"reflect"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/hashicorp/go-multierror"
 	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/siderolabs/go-pointer"
 	sideronet "github.com/siderolabs/net"
 
 	"github.com/siderolabs/talos/pkg/machinery/compatibility"
@@ -30,6 +37,7 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/config/types/block/blockhelpers"
 	"github.com/siderolabs/talos/pkg/machinery/config/validation"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/kernel"
 	"github.com/siderolabs/talos/pkg/machinery/kubelet"
 	"github.com/siderolabs/talos/pkg/machinery/labels"
 	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
@@ -175,6 +183,34 @@ func (c *Config) Validate(mode validation.RuntimeMode, options ...validation.Opt
 		result = multierror.Append(result, fmt.Errorf("unknown machine type %q", c.MachineConfig.MachineType))
 	}
 
+	if c.MachineConfig.MachineTime != nil && c.MachineConfig.MachineTime.TimeDHCPServers != "" {
+		if err := nethelpers.ValidateDHCPTimeServersMode(c.MachineConfig.MachineTime.TimeDHCPServers); err != nil {
+			result = multierror.Append(result, fmt.Errorf("invalid time configuration (.machine.time.dhcpServers): %w", err))
+		}
+	}
+
+	if c.MachineConfig.MachineTime != nil && c.MachineConfig.MachineTime.TimeNTS != nil {
+		seenNTSServers := map[string]struct{}{}
+
+		for i, ntsServer := range c.MachineConfig.MachineTime.TimeNTS.NTSServers {
+			if ntsServer.NTSServer == "" {
+				result = multierror.Append(result, fmt.Errorf("[machine.time.nts.servers[%d].server]: NTS server is required", i))
+
+				continue
+			}
+
+			if _, seen := seenNTSServers[ntsServer.NTSServer]; seen {
+				result = multierror.Append(result, fmt.Errorf("[machine.time.nts.servers[%d].server] %q: duplicate NTS server", i, ntsServer.NTSServer))
+			}
+
+			seenNTSServers[ntsServer.NTSServer] = struct{}{}
+
+			if ntsServer.NTSCACertificate != "" && !x509.NewCertPool().AppendCertsFromPEM([]byte(ntsServer.NTSCACertificate)) {
+				result = multierror.Append(result, fmt.Errorf("[machine.time.nts.servers[%d].caCertificate]: failed to parse PEM-encoded CA certificate", i))
+			}
+		}
+	}
+
 	if c.MachineConfig.MachineNetwork != nil {
 		allSecondaryInterfaces := map[string]string{}
 
@@ -205,12 +241,17 @@ func (c *Config) Validate(mode validation.RuntimeMode, options ...validation.Opt
 			}
 		}
 
+		result = multierror.Append(result, checkVlanParents(c.MachineConfig.MachineNetwork.NetworkInterfaces, allSecondaryInterfaces))
+
 		for _, device := range c.MachineConfig.MachineNetwork.NetworkInterfaces {
-			warn, err := ValidateNetworkDevices(device, allSecondaryInterfaces, CheckDeviceInterface, CheckDeviceAddressing, CheckDeviceRoutes)
+			warn, err := ValidateNetworkDevices(device, allSecondaryInterfaces, CheckDeviceInterface, CheckDeviceAddressing, CheckDeviceRoutes, CheckDeviceDHCPFallback)
 			warnings = append(warnings, warn...)
 			result = multierror.Append(result, err)
 		}
 
+		result = multierror.Append(result, checkRouteRules(c.MachineConfig.MachineNetwork.NetworkRouteRules))
+		result = multierror.Append(result, checkResolverOptions(c.MachineConfig.MachineNetwork.NetworkResolverOptions))
+
 		if c.Machine().Network().KubeSpan().Enabled() {
 			if c.Machine().Network().KubeSpan().MTU() < constants.KubeSpanLinkMinimumMTU {
 				result = multierror.Append(result, fmt.Errorf("kubespan link MTU must be at least %d", constants.KubeSpanLinkMinimumMTU))
@@ -238,6 +279,20 @@ func (c *Config) Validate(mode validation.RuntimeMode, options ...validation.Opt
 		result = multierror.Append(result, err)
 	}
 
+	for _, key := range slices.Sorted(maps.Keys(c.MachineConfig.MachineSysctls)) {
+		if err := kernel.ValidateSysctlKey(key); err != nil {
+			result = multierror.Append(result, fmt.Errorf(".machine.sysctls: %w", err))
+
+			continue
+		}
+
+		if !pointer.SafeDeref(c.MachineConfig.MachineSysctlsAllowProtected) {
+			if err := kernel.CheckProtectedSysctl(key, c.MachineConfig.MachineSysctls[key]); err != nil {
+				result = multierror.Append(result, fmt.Errorf(".machine.sysctls: %w (set .machine.sysctlsAllowProtected to override)", err))
+			}
+		}
+	}
+
 	for _, label := range []string{constants.EphemeralPartitionLabel, constants.StatePartitionLabel} {
 		encryptionConfig := c.MachineConfig.SystemDiskEncryption().Get(label)
 		if encryptionConfig != nil {
@@ -253,6 +308,10 @@ func (c *Config) Validate(mode validation.RuntimeMode, options ...validation.Opt
 
 				slotsInUse[key.Slot()] = struct{}{}
 
+				if key.Slot() == constants.EncryptionKeyRotationSlot {
+					result = multierror.Append(result, fmt.Errorf("partition %q: encryption key slot %d is reserved for the key rotation", label, key.Slot()))
+				}
+
 				if key.NodeID() == nil && key.Static() == nil && key.KMS() == nil && key.TPM() == nil {
 					result = multierror.Append(result, fmt.Errorf("partition %q: encryption key at slot %d doesn't have the configuration parameters", label, key.Slot()))
 				}
@@ -280,6 +339,34 @@ func (c *Config) Validate(mode validation.RuntimeMode, options ...validation.Opt
 				result = multierror.Append(result, fmt.Errorf("KubeSpan endpoint filer is not valid: %q", cidr))
 			}
 		}
+
+		for _, cidr := range c.Machine().Network().KubeSpan().Filters().PeerEndpoints() {
+			cidr = strings.TrimPrefix(cidr, "!")
+
+			if _, err := sideronet.ParseSubnetOrAddress(cidr); err != nil {
+				result = multierror.Append(result, fmt.Errorf("KubeSpan peer endpoint filter is not valid: %q", cidr))
+			}
+		}
+
+		if c.Machine().Network().KubeSpan().Keepalive().Interval() < 0 {
+			result = multierror.Append(result, errors.New("KubeSpan persistent keepalive interval should not be negative"))
+		}
+
+		for i, override := range c.Machine().Network().KubeSpan().Keepalive().Overrides() {
+			if override.Label() == "" && override.CIDR() == "" {
+				result = multierror.Append(result, fmt.Errorf("KubeSpan keepalive override [%d] should specify either label or cidr", i))
+			}
+
+			if override.CIDR() != "" {
+				if _, err := netip.ParsePrefix(override.CIDR()); err != nil {
+					result = multierror.Append(result, fmt.Errorf("KubeSpan keepalive override [%d] cidr is not valid: %q", i, override.CIDR()))
+				}
+			}
+
+			if override.Interval() < 0 {
+				result = multierror.Append(result, fmt.Errorf("KubeSpan keepalive override [%d] interval should not be negative", i))
+			}
+		}
 	}
 
 	if c.MachineConfig.MachineLogging != nil {
@@ -287,6 +374,11 @@ func (c *Config) Validate(mode validation.RuntimeMode, options ...validation.Opt
 		result = multierror.Append(result, err)
 	}
 
+	if c.MachineConfig.MachineKernel != nil {
+		err := c.MachineConfig.MachineKernel.Validate()
+		result = multierror.Append(result, err)
+	}
+
 	if c.MachineConfig.MachineInstall != nil {
 		extensions := map[string]struct{}{}
 
@@ -331,6 +423,20 @@ func (c *Config) Validate(mode validation.RuntimeMode, options ...validation.Opt
 		}
 	}
 
+	if c.MachineConfig.MachineFeatures != nil && c.MachineConfig.MachineFeatures.ApidProxyTargetCheckConfig != nil {
+		for _, cidr := range c.MachineConfig.MachineFeatures.ApidProxyTargetCheckConfig.CheckAllowedCIDRs {
+			if _, err := netip.ParsePrefix(cidr); err != nil {
+				result = multierror.Append(result, fmt.Errorf("invalid CIDR %q in apid proxy target check allowed CIDRs: %w", cidr, err))
+			}
+		}
+	}
+
+	if c.MachineConfig.MachineFeatures != nil && c.MachineConfig.MachineFeatures.HostDNSSupport != nil {
+		if err := c.MachineConfig.MachineFeatures.HostDNSSupport.Validate(); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
 	if c.MachineConfig.MachineFeatures != nil && c.MachineConfig.MachineFeatures.FeatureNodeAddressSortAlgorithm != "" {
 		if _, err := nethelpers.AddressSortAlgorithmString(c.MachineConfig.MachineFeatures.FeatureNodeAddressSortAlgorithm); err != nil {
 			result = multierror.Append(result, fmt.Errorf("invalid node address sort algorithm: %w", err))
@@ -587,7 +693,10 @@ func ValidateNetworkDevices(d *Device, secondaryInterfaces map[string]string, ch
 //
 //nolint:gocyclo
 func CheckDeviceInterface(d *Device, _ map[string]string) ([]string, error) {
-	var result *multierror.Error
+	var (
+		result   *multierror.Error
+		warnings []string
+	)
 
 	if d == nil {
 		return nil, errors.New("empty device")
@@ -607,17 +716,85 @@ func CheckDeviceInterface(d *Device, _ map[string]string) ([]string, error) {
 		result = multierror.Append(result, checkBond(d.DeviceBond))
 	}
 
+	if d.DeviceBridge != nil {
+		result = multierror.Append(result, checkBridge(d.DeviceBridge))
+	}
+
 	if d.DeviceWireguardConfig != nil {
 		result = multierror.Append(result, checkWireguard(d.DeviceWireguardConfig))
 	}
 
 	if d.DeviceVlans != nil {
 		result = multierror.Append(result, checkVlans(d))
+		warnings = append(warnings, checkVlanMTU(d)...)
 	}
 
-	return nil, result.ErrorOrNil()
+	if d.DeviceDHCPOptions != nil {
+		result = multierror.Append(result, checkDHCPOptions(d.DeviceInterface, d.DeviceDHCPOptions))
+	}
+
+	return warnings, result.ErrorOrNil()
 }
 
+func checkBridge(b *Bridge) error {
+	var result *multierror.Error
+
+	if b.BridgeVLAN == nil || len(b.BridgeVLAN.BridgeVLANPorts) == 0 {
+		return nil
+	}
+
+	if !b.BridgeVLAN.FilteringEnabled() {
+		result = multierror.Append(result, errors.New("bridge.vlan.ports requires vlanFiltering to be enabled"))
+	}
+
+	checkVLANID := func(port string, vlanID uint16) {
+		if vlanID < 1 || vlanID > 4094 {
+			result = multierror.Append(result, fmt.Errorf("bridge.vlan.ports[%q]: invalid VLAN ID %d, must be in range 1-4094", port, vlanID))
+		}
+	}
+
+	pvids := map[string]uint16{}
+
+	for _, port := range b.BridgeVLAN.BridgeVLANPorts {
+		if port == nil {
+			continue
+		}
+
+		if port.BridgeVLANPortInterface == "" {
+			result = multierror.Append(result, errors.New("bridge.vlan.ports: interface must be set"))
+
+			continue
+		}
+
+		if port.BridgeVLANPortPVID != 0 {
+			checkVLANID(port.BridgeVLANPortInterface, port.BridgeVLANPortPVID)
+
+			if _, exists := pvids[port.BridgeVLANPortInterface]; exists {
+				result = multierror.Append(result, fmt.Errorf("bridge.vlan.ports[%q]: duplicate PVID", port.BridgeVLANPortInterface))
+			}
+
+			pvids[port.BridgeVLANPortInterface] = port.BridgeVLANPortPVID
+		}
+
+		for _, vlanID := range port.BridgeVLANPortUntagged {
+			checkVLANID(port.BridgeVLANPortInterface, vlanID)
+
+			if slices.Contains(port.BridgeVLANPortTagged, vlanID) {
+				result = multierror.Append(result, fmt.Errorf("bridge.vlan.ports[%q]: VLAN %d can't be both tagged and untagged", port.BridgeVLANPortInterface, vlanID))
+			}
+		}
+
+		for _, vlanID := range port.BridgeVLANPortTagged {
+			checkVLANID(port.BridgeVLANPortInterface, vlanID)
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// maxBondARPTargets is the kernel limit on the number of ARP/NS monitoring targets.
+const maxBondARPTargets = 16
+
 //nolint:gocyclo,cyclop
 func checkBond(b *Bond) error {
 	var result *multierror.Error
@@ -680,14 +857,50 @@ func checkBond(b *Bond) error {
 		}
 	}
 
-	if len(b.BondARPIPTarget) > 0 {
-		result = multierror.Append(result, errors.New("bond.arpIPTarget is not supported"))
+	if b.BondMIIMon != 0 && b.BondARPInterval != 0 {
+		result = multierror.Append(result, errors.New("bond.miiMon and bond.arpInterval can't be set at the same time"))
+	}
+
+	if len(b.BondARPIPTarget)+len(b.BondNSIP6Target) > 0 && b.BondARPInterval == 0 {
+		result = multierror.Append(result, errors.New("bond.arpIPTarget and bond.nsIP6Target require arpInterval to be set"))
+	}
+
+	if len(b.BondARPIPTarget) > maxBondARPTargets {
+		result = multierror.Append(result, fmt.Errorf("bond.arpIPTarget supports at most %d targets", maxBondARPTargets))
+	}
+
+	if len(b.BondNSIP6Target) > maxBondARPTargets {
+		result = multierror.Append(result, fmt.Errorf("bond.nsIP6Target supports at most %d targets", maxBondARPTargets))
+	}
+
+	for _, target := range b.BondARPIPTarget {
+		if addr, err := netip.ParseAddr(target); err != nil || !addr.Is4() {
+			result = multierror.Append(result, fmt.Errorf("bond.arpIPTarget %q is not a valid IPv4 address", target))
+		}
+	}
+
+	for _, target := range b.BondNSIP6Target {
+		if addr, err := netip.ParseAddr(target); err != nil || !addr.Is6() || addr.Is4In6() {
+			result = multierror.Append(result, fmt.Errorf("bond.nsIP6Target %q is not a valid IPv6 address", target))
+		}
 	}
 
 	if b.BondLACPRate != "" && bondMode != nethelpers.BondMode8023AD {
 		result = multierror.Append(result, errors.New("bond.lacpRate is only available in 802.3ad mode"))
 	}
 
+	if (bondMode == nethelpers.BondModeRoundrobin || bondMode == nethelpers.BondModeActiveBackup || bondMode == nethelpers.BondModeBroadcast) && b.BondHashPolicy != "" {
+		result = multierror.Append(result, fmt.Errorf("bond.xmitHashPolicy is not available in %s mode", bondMode))
+	}
+
+	if bondMode != nethelpers.BondMode8023AD && b.BondADSelect != "" {
+		result = multierror.Append(result, errors.New("bond.adSelect is only available in 802.3ad mode"))
+	}
+
+	if bondMode != nethelpers.BondMode8023AD && b.BondMinLinks > 0 {
+		result = multierror.Append(result, errors.New("bond.minLinks is only available in 802.3ad mode"))
+	}
+
 	if b.BondADActorSystem != "" {
 		result = multierror.Append(result, errors.New("bond.adActorSystem is not supported"))
 	}
@@ -769,6 +982,10 @@ func checkVlans(d *Device) error {
 
 	// check VLAN addressing
 	for _, vlan := range d.DeviceVlans {
+		if vlan.VlanID < 1 || vlan.VlanID > 4094 {
+			result = multierror.Append(result, fmt.Errorf("[%s] %s.%d: %s", "networking.os.device.vlan", d.DeviceInterface, vlan.VlanID, "vlan ID should be in range 1-4094"))
+		}
+
 		if len(vlan.VlanAddresses) > 0 && vlan.VlanCIDR != "" {
 			result = multierror.Append(result, fmt.Errorf("[%s] %s.%d: %s", "networking.os.device.vlan", d.DeviceInterface, vlan.VlanID, "vlan can't have both .cidr and .addresses set"))
 		}
@@ -784,6 +1001,104 @@ func checkVlans(d *Device) error {
 				result = multierror.Append(result, fmt.Errorf("[%s] %s.%d: %w", "networking.os.device.vlan.addresses", d.DeviceInterface, vlan.VlanID, err))
 			}
 		}
+
+		if vlan.VlanDHCPOptions != nil {
+			result = multierror.Append(result, checkDHCPOptions(fmt.Sprintf("%s.%d", d.DeviceInterface, vlan.VlanID), vlan.VlanDHCPOptions))
+		}
+
+		for _, route := range vlan.VlanRoutes {
+			if err := checkRoutingTable(route.Table()); err != nil {
+				result = multierror.Append(result, fmt.Errorf("[%s] %s.%d: %w", "networking.os.device.vlan.route.table", d.DeviceInterface, vlan.VlanID, err))
+			}
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// checkVlanMTU warns if the VLAN MTU exceeds the MTU of the parent interface, as the parent MTU is going to be raised.
+func checkVlanMTU(d *Device) []string {
+	if d.DeviceMTU == 0 {
+		return nil
+	}
+
+	var warnings []string
+
+	for _, vlan := range d.DeviceVlans {
+		if vlan.VlanMTU > uint32(d.DeviceMTU) {
+			warnings = append(warnings, fmt.Sprintf("[%s] %s.%d: vlan MTU %d exceeds the parent interface MTU %d, the parent interface MTU will be raised",
+				"networking.os.device.vlan.mtu", d.DeviceInterface, vlan.VlanID, vlan.VlanMTU, d.DeviceMTU))
+		}
+	}
+
+	return warnings
+}
+
+// checkVlanParents verifies that VLAN parent links are not part of bonds or bridges, and that VLAN IDs are unique per parent.
+func checkVlanParents(devices []*Device, secondaryInterfaces map[string]string) error {
+	var result *multierror.Error
+
+	vlanIDs := map[string]map[uint16]struct{}{}
+
+	for _, device := range devices {
+		if device == nil || device.Ignore() || len(device.DeviceVlans) == 0 {
+			continue
+		}
+
+		// links matched by the device selector are not known at this point
+		if device.DeviceInterface == "" {
+			continue
+		}
+
+		if master, isSecondary := secondaryInterfaces[device.DeviceInterface]; isSecondary {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: vlan parent link is part of %q, vlans should be defined on %q instead",
+				"networking.os.device.vlan", device.DeviceInterface, master, master))
+		}
+
+		if _, exists := vlanIDs[device.DeviceInterface]; !exists {
+			vlanIDs[device.DeviceInterface] = map[uint16]struct{}{}
+		}
+
+		for _, vlan := range device.DeviceVlans {
+			if _, exists := vlanIDs[device.DeviceInterface][vlan.VlanID]; exists {
+				result = multierror.Append(result, fmt.Errorf("[%s] %s.%d: vlan ID is declared multiple times", "networking.os.device.vlan", device.DeviceInterface, vlan.VlanID))
+			}
+
+			vlanIDs[device.DeviceInterface][vlan.VlanID] = struct{}{}
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+func checkDHCPOptions(linkName string, opts *DHCPOptions) error {
+	var result *multierror.Error
+
+	if opts.DHCPDUIDv6 != "" {
+		if _, err := nethelpers.ParseDUID(opts.DHCPDUIDv6); err != nil {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", "networking.os.device.dhcpOptions.duidv6", linkName, err))
+		}
+	}
+
+	if opts.DHCPDUIDv6Type != "" {
+		if err := nethelpers.ValidateDUIDType(opts.DHCPDUIDv6Type); err != nil {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", "networking.os.device.dhcpOptions.duidv6Type", linkName, err))
+		}
+	}
+
+	switch opts.DHCPClientIdentifier {
+	case "", nethelpers.ClientIdentifierTypeMAC, nethelpers.ClientIdentifierTypeDUID:
+	default:
+		if _, err := nethelpers.ParseClientIdentifier(opts.DHCPClientIdentifier); err != nil {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", "networking.os.device.dhcpOptions.clientIdentifier", linkName, err))
+		}
+	}
+
+	for _, option := range opts.DHCPRequestedOptions {
+		// options 0 (pad) and 255 (end) can't be requested
+		if option == 0 || option >= 255 {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: invalid DHCP option %d", "networking.os.device.dhcpOptions.requestedOptions", linkName, option))
+		}
 	}
 
 	return result.ErrorOrNil()
@@ -889,14 +1204,214 @@ func CheckDeviceRoutes(d *Device, _ map[string]string) ([]string, error) {
 				result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", "networking.os.device.route["+strconv.Itoa(idx)+"].source", route.Source(), ErrInvalidAddress))
 			}
 		}
+
+		if err := checkRoutingTable(route.Table()); err != nil {
+			result = multierror.Append(result, fmt.Errorf("[%s] %d: %w", "networking.os.device.route["+strconv.Itoa(idx)+"].table", route.RouteTable, err))
+		}
 	}
 
 	return nil, result.ErrorOrNil()
 }
 
+// CheckDeviceDHCPFallback ensures that the fallback to DHCP is explicitly enabled and has a static configuration to fall back from.
+//
+//nolint:gocyclo
+func CheckDeviceDHCPFallback(d *Device, _ map[string]string) ([]string, error) {
+	var result *multierror.Error
+
+	if d == nil {
+		return nil, errors.New("empty device")
+	}
+
+	if d.DeviceDHCPFallback == nil {
+		return nil, nil
+	}
+
+	fallback := d.DeviceDHCPFallback
+
+	if fallback.FallbackProbeTimeout < 0 {
+		result = multierror.Append(result, fmt.Errorf("[%s] %q: probe timeout can't be negative", "networking.os.device.dhcpFallback.probeTimeout", d.DeviceInterface))
+	}
+
+	if fallback.FallbackProbeInterval < 0 {
+		result = multierror.Append(result, fmt.Errorf("[%s] %q: probe interval can't be negative", "networking.os.device.dhcpFallback.probeInterval", d.DeviceInterface))
+	}
+
+	if !fallback.Enabled() {
+		if fallback.FallbackProbeTimeout != 0 || fallback.FallbackProbeInterval != 0 {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: %s", "networking.os.device.dhcpFallback", d.DeviceInterface, "fallback settings require the fallback to be enabled explicitly"))
+		}
+
+		return nil, result.ErrorOrNil()
+	}
+
+	if d.DHCP() {
+		result = multierror.Append(result, fmt.Errorf("[%s] %q: %s", "networking.os.device.dhcpFallback", d.DeviceInterface, "fallback to DHCP can't be enabled together with DHCP"))
+	}
+
+	if len(d.DeviceAddresses) == 0 && d.DeviceCIDR == "" {
+		result = multierror.Append(result, fmt.Errorf("[%s] %q: %s", "networking.os.device.dhcpFallback", d.DeviceInterface, "fallback to DHCP requires static addresses"))
+	}
+
+	hasDefaultGateway := false
+
+	for _, route := range d.DeviceRoutes {
+		if route.Network() != "" && route.Network() != "0.0.0.0/0" {
+			continue
+		}
+
+		if ip := net.ParseIP(route.Gateway()); ip != nil && ip.To4() != nil {
+			hasDefaultGateway = true
+		}
+	}
+
+	if !hasDefaultGateway {
+		result = multierror.Append(result, fmt.Errorf("[%s] %q: %s", "networking.os.device.dhcpFallback", d.DeviceInterface, "fallback to DHCP requires a default route with an IPv4 gateway"))
+	}
+
+	return nil, result.ErrorOrNil()
+}
+
+// checkRoutingTable verifies that the routing table is not managed by the kernel or by Talos internally.
+func checkRoutingTable(table nethelpers.RoutingTable) error {
+	switch table {
+	case nethelpers.TableUnspec:
+		return errors.New("routing table should be set")
+	case nethelpers.TableLocal:
+		return errors.New("local routing table is managed by the kernel")
+	case constants.KubeSpanDefaultRoutingTable:
+		return fmt.Errorf("routing table %d is reserved for KubeSpan", constants.KubeSpanDefaultRoutingTable)
+	}
+
+	return nil
+}
+
+// Resolver options bounds.
+const (
+	minResolverTimeout  = time.Second
+	maxResolverTimeout  = 30 * time.Second
+	minResolverAttempts = 1
+	maxResolverAttempts = 5
+)
+
+// checkResolverOptions verifies the resolver options are within the supported bounds.
+func checkResolverOptions(opts *ResolverOptionsConfig) error {
+	if opts == nil {
+		return nil
+	}
+
+	var result *multierror.Error
+
+	if opts.ResolverTimeout != 0 && (opts.ResolverTimeout < minResolverTimeout || opts.ResolverTimeout > maxResolverTimeout) {
+		result = multierror.Append(result, fmt.Errorf("[machine.network.resolverOptions.timeout]: timeout %s should be in the range [%s, %s]", opts.ResolverTimeout, minResolverTimeout, maxResolverTimeout))
+	}
+
+	if opts.ResolverAttempts != 0 && (opts.ResolverAttempts < minResolverAttempts || opts.ResolverAttempts > maxResolverAttempts) {
+		result = multierror.Append(result, fmt.Errorf("[machine.network.resolverOptions.attempts]: attempts %d should be in the range [%d, %d]", opts.ResolverAttempts, minResolverAttempts, maxResolverAttempts))
+	}
+
+	switch opts.ResolverUpstreamPolicy {
+	case "", "sequential", "parallel":
+	default:
+		result = multierror.Append(result, fmt.Errorf("[machine.network.resolverOptions.upstreamPolicy]: unsupported upstream policy %q", opts.ResolverUpstreamPolicy))
+	}
+
+	return result.ErrorOrNil()
+}
+
+// checkRouteRules verifies the routing policy rules.
+//
+//nolint:gocyclo
+func checkRouteRules(rules []*RouteRule) error {
+	var result *multierror.Error
+
+	// rules are identified by the address family and priority
+	priorities := map[nethelpers.Family]map[uint32]struct{}{}
+
+	for idx, rule := range rules {
+		path := "networking.os.routeRules[" + strconv.Itoa(idx) + "]"
+
+		if rule == nil {
+			result = multierror.Append(result, fmt.Errorf("[%s]: %s", path, "rule is null"))
+
+			continue
+		}
+
+		var families []nethelpers.Family
+
+		for _, selector := range []struct {
+			name   string
+			prefix string
+		}{
+			{"from", rule.From()},
+			{"to", rule.To()},
+		} {
+			if selector.prefix == "" {
+				continue
+			}
+
+			prefix, err := netip.ParsePrefix(selector.prefix)
+			if err != nil {
+				result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", path+"."+selector.name, selector.prefix, ErrInvalidAddress))
+
+				continue
+			}
+
+			family := nethelpers.FamilyInet4
+			if prefix.Addr().Is6() {
+				family = nethelpers.FamilyInet6
+			}
+
+			if len(families) > 0 {
+				if families[0] != family {
+					result = multierror.Append(result, fmt.Errorf("[%s]: %s", path, "from and to should be of the same address family"))
+				}
+
+				continue
+			}
+
+			families = append(families, family)
+		}
+
+		if len(families) == 0 {
+			// rule without address selectors is installed for both address families
+			families = []nethelpers.Family{nethelpers.FamilyInet4, nethelpers.FamilyInet6}
+		}
+
+		if rule.Priority() == 0 || rule.Priority() > 32765 {
+			result = multierror.Append(result, fmt.Errorf("[%s] %d: %s", path+".priority", rule.Priority(), "priority should be in range 1-32765"))
+		}
+
+		for _, family := range families {
+			if priorities[family] == nil {
+				priorities[family] = map[uint32]struct{}{}
+			}
+
+			if _, exists := priorities[family][rule.Priority()]; exists {
+				result = multierror.Append(result, fmt.Errorf("[%s] %d: %s", path+".priority", rule.Priority(), "priority is declared multiple times"))
+			}
+
+			priorities[family][rule.Priority()] = struct{}{}
+		}
+
+		if err := checkRoutingTable(rule.Table()); err != nil {
+			result = multierror.Append(result, fmt.Errorf("[%s] %d: %w", path+".table", rule.RuleTable, err))
+		}
+
+		if rule.FwMark()&rule.FwMask()&constants.KubeSpanDefaultFirewallMask != 0 {
+			result = multierror.Append(result, fmt.Errorf("[%s] 0x%x: %s", path+".fwMark", rule.FwMark(), "firewall mark conflicts with KubeSpan firewall marks"))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
 // Validate kubelet configuration.
 func (k *KubeletConfig) Validate() ([]string, error) {
-	var result *multierror.Error
+	var (
+		warnings []string
+		result   *multierror.Error
+	)
 
 	if k.KubeletNodeIP != nil {
 		for _, cidr := range k.KubeletNodeIP.KubeletNodeIPValidSubnets {
@@ -908,13 +1423,81 @@ func (k *KubeletConfig) Validate() ([]string, error) {
 		}
 	}
 
+	for i, mount := range k.KubeletExtraMounts {
+		result = multierror.Append(result, kubelet.ValidateExtraMount(
+			fmt.Sprintf(".machine.kubelet.extraMounts[%d]", i),
+			mount.Destination,
+			mount.Type,
+			mount.Options,
+		))
+	}
+
+	result = multierror.Append(result, k.validateShutdownGracePeriod())
+
 	for _, field := range kubelet.ProtectedConfigurationFields {
 		if _, exists := k.KubeletExtraConfig.Object[field]; exists {
 			result = multierror.Append(result, fmt.Errorf("kubelet configuration field %q can't be overridden", field))
 		}
 	}
 
-	return nil, result.ErrorOrNil()
+	if len(k.KubeletExtraConfig.Object) > 0 {
+		// the version is used to check fields introduced in newer versions of Kubernetes,
+		// if the image reference doesn't carry the version, the check is skipped
+		var kubernetesVersion string
+
+		if v, err := KubernetesVersionFromImageRef(k.Image()); err == nil {
+			kubernetesVersion = v.String()
+		}
+
+		warn, err := kubelet.ValidateExtraConfig(
+			".machine.kubelet.extraConfig",
+			k.KubeletExtraConfig.Object,
+			kubernetesVersion,
+			pointer.SafeDeref(k.KubeletExtraConfigAllowUnknownFields),
+		)
+		warnings = append(warnings, warn...)
+		result = multierror.Append(result, err)
+	}
+
+	if len(k.KubeletCredentialProviderConfig.Object) > 0 {
+		result = multierror.Append(result, kubelet.ValidateCredentialProviderConfig(
+			".machine.kubelet.credentialProviderConfig",
+			k.KubeletCredentialProviderConfig.Object,
+		))
+	}
+
+	return warnings, result.ErrorOrNil()
+}
+
+func (k *KubeletConfig) validateShutdownGracePeriod() error {
+	if k.KubeletShutdownGracePeriod == 0 && k.KubeletShutdownGracePeriodCriticalPods == 0 {
+		return nil
+	}
+
+	var result *multierror.Error
+
+	gracePeriod := cmp.Or(k.KubeletShutdownGracePeriod, constants.KubeletShutdownGracePeriod)
+	criticalPodsGracePeriod := cmp.Or(k.KubeletShutdownGracePeriodCriticalPods, constants.KubeletShutdownGracePeriodCriticalPods)
+
+	switch {
+	case gracePeriod < 0 || criticalPodsGracePeriod < 0:
+		result = multierror.Append(result, errors.New("[machine.kubelet.shutdownGracePeriod]: shutdown grace period should not be negative"))
+	case gracePeriod > constants.KubeletShutdownGracePeriodMax:
+		result = multierror.Append(result, fmt.Errorf("[machine.kubelet.shutdownGracePeriod]: shutdown grace period %s exceeds the maximum of %s", gracePeriod, constants.KubeletShutdownGracePeriodMax))
+	case criticalPodsGracePeriod >= gracePeriod:
+		result = multierror.Append(result, fmt.Errorf(
+			"[machine.kubelet.shutdownGracePeriodCriticalPods]: shutdown grace period for critical pods %s should be less than the shutdown grace period %s",
+			criticalPodsGracePeriod, gracePeriod,
+		))
+	}
+
+	for _, field := range []string{"shutdownGracePeriod", "shutdownGracePeriodCriticalPods"} {
+		if _, exists := k.KubeletExtraConfig.Object[field]; exists {
+			result = multierror.Append(result, fmt.Errorf("[machine.kubelet.extraConfig]: field %q can't be set when shutdown grace period is configured", field))
+		}
+	}
+
+	return result.ErrorOrNil()
 }
 
 // Validate etcd configuration.
@@ -945,6 +1528,95 @@ func (e *EtcdConfig) Validate() error {
 		}
 	}
 
+	if e.EtcdDefragmentation != nil {
+		interval, err := parseEtcdSchedule(e.EtcdDefragmentation.DefragmentationSchedule)
+
+		switch {
+		case err != nil:
+			result = multierror.Append(result, fmt.Errorf("etcd defragmentation schedule %q is not valid: %w", e.EtcdDefragmentation.DefragmentationSchedule, err))
+		case interval < minEtcdScheduleInterval:
+			result = multierror.Append(result, fmt.Errorf("etcd defragmentation interval should be at least %s: %q", minEtcdScheduleInterval, e.EtcdDefragmentation.DefragmentationSchedule))
+		}
+
+		if e.EtcdDefragmentation.DefragmentationThreshold < 0 || e.EtcdDefragmentation.DefragmentationThreshold > 100 {
+			result = multierror.Append(result, fmt.Errorf("etcd defragmentation threshold should be between 0 and 100: %d", e.EtcdDefragmentation.DefragmentationThreshold))
+		}
+	}
+
+	if e.EtcdLearnerPromotion != nil {
+		if e.EtcdLearnerPromotion.LearnerStuckTimeout < 0 {
+			result = multierror.Append(result, fmt.Errorf("etcd learner stuck timeout should be non-negative: %s", e.EtcdLearnerPromotion.LearnerStuckTimeout))
+		}
+
+		if e.EtcdLearnerPromotion.LearnerMaxLearners < 0 {
+			result = multierror.Append(result, fmt.Errorf("etcd max learners should be non-negative: %d", e.EtcdLearnerPromotion.LearnerMaxLearners))
+		}
+	}
+
+	if e.EtcdSnapshots != nil {
+		interval, err := parseEtcdSchedule(e.EtcdSnapshots.SnapshotsSchedule)
+
+		switch {
+		case err != nil:
+			result = multierror.Append(result, fmt.Errorf("etcd snapshots schedule %q is not valid: %w", e.EtcdSnapshots.SnapshotsSchedule, err))
+		case interval < minEtcdScheduleInterval:
+			result = multierror.Append(result, fmt.Errorf("etcd snapshots interval should be at least %s: %q", minEtcdScheduleInterval, e.EtcdSnapshots.SnapshotsSchedule))
+		}
+
+		if e.EtcdSnapshots.SnapshotsRetention < 0 {
+			result = multierror.Append(result, fmt.Errorf("etcd snapshots retention should be non-negative: %d", e.EtcdSnapshots.SnapshotsRetention))
+		}
+
+		if s3 := e.EtcdSnapshots.SnapshotsS3; s3 != nil {
+			if u, err := url.Parse(s3.S3Endpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				result = multierror.Append(result, fmt.Errorf("etcd snapshots S3 endpoint should be a valid http(s) URL: %q", s3.S3Endpoint))
+			}
+
+			if s3.S3Bucket == "" {
+				result = multierror.Append(result, errors.New("etcd snapshots S3 bucket is required"))
+			}
+
+			if s3.S3AccessKeyID == "" || s3.S3SecretAccessKey == "" {
+				result = multierror.Append(result, errors.New("etcd snapshots S3 credentials are required"))
+			}
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// Validate host DNS configuration.
+func (h *HostDNSConfig) Validate() error {
+	var result *multierror.Error
+
+	if h.HostDNSCacheSize < 0 {
+		result = multierror.Append(result, fmt.Errorf("host DNS cache size should be non-negative: %d", h.HostDNSCacheSize))
+	}
+
+	for _, field := range []struct {
+		name  string
+		value time.Duration
+	}{
+		{"cacheMinTTL", h.HostDNSCacheMinTTL},
+		{"cacheMaxTTL", h.HostDNSCacheMaxTTL},
+		{"negativeCacheTTL", h.HostDNSNegativeCacheTTL},
+		{"serveStale", h.HostDNSServeStale},
+	} {
+		if field.value < 0 {
+			result = multierror.Append(result, fmt.Errorf("host DNS %s should be non-negative: %s", field.name, field.value))
+		}
+	}
+
+	if h.HostDNSCacheMinTTL > 0 && h.HostDNSCacheMaxTTL > 0 && h.HostDNSCacheMinTTL > h.HostDNSCacheMaxTTL {
+		result = multierror.Append(result, fmt.Errorf("host DNS cacheMinTTL %s should not be greater than cacheMaxTTL %s", h.HostDNSCacheMinTTL, h.HostDNSCacheMaxTTL))
+	}
+
+	for _, cidr := range h.HostDNSAllowedClients {
+		if _, err := netip.ParsePrefix(cidr); err != nil {
+			result = multierror.Append(result, fmt.Errorf("invalid CIDR %q in host DNS allowed clients: %w", cidr, err))
+		}
+	}
+
 	return result.ErrorOrNil()
 }
 
@@ -9,10 +9,12 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
 	"github.com/siderolabs/crypto/x509"
+	"github.com/siderolabs/gen/ensure"
 	"github.com/siderolabs/go-pointer"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -625,7 +627,7 @@ func TestValidate(t *testing.T) {
 			expectedError: "1 error occurred:\n\t* [networking.os.device.vlan.addresses] eth0.25: invalid CIDR address: 10.3.x/24\n\n",
 		},
 		{
-			name: "VlanAddressAndCIDR",
+			name: "VlanMTUExceedsParent",
 			config: &v1alpha1.Config{
 				ConfigVersion: "v1alpha1",
 				MachineConfig: &v1alpha1.MachineConfig{
@@ -638,13 +640,15 @@ func TestValidate(t *testing.T) {
 						NetworkInterfaces: []*v1alpha1.Device{
 							{
 								DeviceInterface: "eth0",
+								DeviceMTU:       1500,
 								DeviceVlans: []*v1alpha1.Vlan{
 									{
-										VlanID:   26,
-										VlanCIDR: "192.24.3.45",
-										VlanAddresses: []string{
-											"192.168.0.5/24",
-										},
+										VlanID:  25,
+										VlanMTU: 9000,
+									},
+									{
+										VlanID:  26,
+										VlanMTU: 1400,
 									},
 								},
 							},
@@ -659,10 +663,12 @@ func TestValidate(t *testing.T) {
 					},
 				},
 			},
-			expectedError: "1 error occurred:\n\t* [networking.os.device.vlan] eth0.26: vlan can't have both .cidr and .addresses set\n\n",
+			expectedWarnings: []string{
+				"[networking.os.device.vlan.mtu] eth0.25: vlan MTU 9000 exceeds the parent interface MTU 1500, the parent interface MTU will be raised",
+			},
 		},
 		{
-			name: "BondDefaultConfig",
+			name: "VlanAddressAndCIDR",
 			config: &v1alpha1.Config{
 				ConfigVersion: "v1alpha1",
 				MachineConfig: &v1alpha1.MachineConfig{
@@ -674,8 +680,16 @@ func TestValidate(t *testing.T) {
 					MachineNetwork: &v1alpha1.NetworkConfig{
 						NetworkInterfaces: []*v1alpha1.Device{
 							{
-								DeviceInterface: "bond0",
-								DeviceBond:      &v1alpha1.Bond{},
+								DeviceInterface: "eth0",
+								DeviceVlans: []*v1alpha1.Vlan{
+									{
+										VlanID:   26,
+										VlanCIDR: "192.24.3.45",
+										VlanAddresses: []string{
+											"192.168.0.5/24",
+										},
+									},
+								},
 							},
 						},
 					},
@@ -688,9 +702,10 @@ func TestValidate(t *testing.T) {
 					},
 				},
 			},
+			expectedError: "1 error occurred:\n\t* [networking.os.device.vlan] eth0.26: vlan can't have both .cidr and .addresses set\n\n",
 		},
 		{
-			name: "BondWrongMode",
+			name: "VlanOnBond",
 			config: &v1alpha1.Config{
 				ConfigVersion: "v1alpha1",
 				MachineConfig: &v1alpha1.MachineConfig{
@@ -704,15 +719,20 @@ func TestValidate(t *testing.T) {
 							{
 								DeviceInterface: "bond0",
 								DeviceBond: &v1alpha1.Bond{
-									BondMode:            "roundrobin",
-									BondUpDelay:         100,
-									BondPacketsPerSlave: 8,
-									BondADActorSysPrio:  48,
+									BondMode: "802.3ad",
 									BondInterfaces: []string{
 										"eth0",
 										"eth1",
 									},
 								},
+								DeviceVlans: []*v1alpha1.Vlan{
+									{
+										VlanID: 100,
+										VlanAddresses: []string{
+											"192.168.0.5/24",
+										},
+									},
+								},
 							},
 						},
 					},
@@ -725,10 +745,9 @@ func TestValidate(t *testing.T) {
 					},
 				},
 			},
-			expectedError: "3 errors occurred:\n\t* invalid bond type roundrobin\n\t* bond.upDelay can't be set if miiMon is zero\n\t* bond.adActorSysPrio is only available in 802.3ad mode\n\n",
 		},
 		{
-			name: "BondInterfacesAndSelectors",
+			name: "VlanParentIsBondSlave",
 			config: &v1alpha1.Config{
 				ConfigVersion: "v1alpha1",
 				MachineConfig: &v1alpha1.MachineConfig{
@@ -742,14 +761,18 @@ func TestValidate(t *testing.T) {
 							{
 								DeviceInterface: "bond0",
 								DeviceBond: &v1alpha1.Bond{
+									BondMode: "802.3ad",
 									BondInterfaces: []string{
 										"eth0",
 										"eth1",
 									},
-									BondDeviceSelectors: []v1alpha1.NetworkDeviceSelector{
-										{
-											NetworkDeviceKernelDriver: "virtio",
-										},
+								},
+							},
+							{
+								DeviceInterface: "eth0",
+								DeviceVlans: []*v1alpha1.Vlan{
+									{
+										VlanID: 100,
 									},
 								},
 							},
@@ -764,10 +787,10 @@ func TestValidate(t *testing.T) {
 					},
 				},
 			},
-			expectedError: "1 error occurred:\n\t* interface \"bond0\" has both interfaces and selectors set: config sections are mutually exclusive\n\n",
+			expectedError: "1 error occurred:\n\t* [networking.os.device.vlan] \"eth0\": vlan parent link is part of \"bond0\", vlans should be defined on \"bond0\" instead\n\n",
 		},
 		{
-			name: "BondDoubleBond",
+			name: "VlanDuplicateID",
 			config: &v1alpha1.Config{
 				ConfigVersion: "v1alpha1",
 				MachineConfig: &v1alpha1.MachineConfig{
@@ -779,20 +802,40 @@ func TestValidate(t *testing.T) {
 					MachineNetwork: &v1alpha1.NetworkConfig{
 						NetworkInterfaces: []*v1alpha1.Device{
 							{
-								DeviceInterface: "bond0",
-								DeviceBond: &v1alpha1.Bond{
-									BondInterfaces: []string{
+								DeviceInterface: "br0",
+								DeviceBridge: &v1alpha1.Bridge{
+									BridgedInterfaces: []string{
 										"eth0",
-										"eth1",
+									},
+								},
+								DeviceVlans: []*v1alpha1.Vlan{
+									{
+										VlanID: 100,
+									},
+									{
+										VlanID: 100,
 									},
 								},
 							},
 							{
-								DeviceInterface: "bond1",
-								DeviceBond: &v1alpha1.Bond{
-									BondInterfaces: []string{
-										"eth1",
-										"eth2",
+								DeviceInterface: "br0",
+								DeviceVlans: []*v1alpha1.Vlan{
+									{
+										VlanID: 5000,
+									},
+									{
+										VlanID: 200,
+									},
+									{
+										VlanID: 0,
+									},
+								},
+							},
+							{
+								DeviceInterface: "eth1",
+								DeviceVlans: []*v1alpha1.Vlan{
+									{
+										VlanID: 200,
 									},
 								},
 							},
@@ -807,10 +850,10 @@ func TestValidate(t *testing.T) {
 					},
 				},
 			},
-			expectedError: "1 error occurred:\n\t* interface \"eth1\" is declared as part of two separate links: \"bond0\" and \"bond1\"\n\n",
+			expectedError: "3 errors occurred:\n\t* [networking.os.device.vlan] br0.100: vlan ID is declared multiple times\n\t* [networking.os.device.vlan] br0.5000: vlan ID should be in range 1-4094\n\t* [networking.os.device.vlan] br0.0: vlan ID should be in range 1-4094\n\n",
 		},
 		{
-			name: "BondSlaveAddressing",
+			name: "DHCPOptionsDUID",
 			config: &v1alpha1.Config{
 				ConfigVersion: "v1alpha1",
 				MachineConfig: &v1alpha1.MachineConfig{
@@ -821,29 +864,32 @@ func TestValidate(t *testing.T) {
 					},
 					MachineNetwork: &v1alpha1.NetworkConfig{
 						NetworkInterfaces: []*v1alpha1.Device{
-							{
-								DeviceInterface: "bond0",
-								DeviceBond: &v1alpha1.Bond{
-									BondInterfaces: []string{
-										"eth0",
-										"eth1",
-										"eth2",
-									},
-								},
-							},
 							{
 								DeviceInterface: "eth0",
 								DeviceDHCP:      pointer.To(true),
-							},
-							{
-								DeviceInterface: "eth1",
-								DeviceAddresses: []string{
-									"192.168.0.1/24",
+								DeviceDHCPOptions: &v1alpha1.DHCPOptions{
+									DHCPIPv6:   pointer.To(true),
+									DHCPDUIDv6: "0003000152540012345",
+								},
+								DeviceVlans: []*v1alpha1.Vlan{
+									{
+										VlanID:   25,
+										VlanDHCP: pointer.To(true),
+										VlanDHCPOptions: &v1alpha1.DHCPOptions{
+											DHCPIPv6:       pointer.To(true),
+											DHCPDUIDv6Type: "EN",
+										},
+									},
+									{
+										VlanID:   26,
+										VlanDHCP: pointer.To(true),
+										VlanDHCPOptions: &v1alpha1.DHCPOptions{
+											DHCPIPv6:       pointer.To(true),
+											DHCPDUIDv6Type: "LL",
+											DHCPIAIDv6:     42,
+										},
+									},
 								},
-							},
-							{
-								DeviceInterface: "eth2",
-								DeviceCIDR:      "192.168.1.1/24",
 							},
 						},
 					},
@@ -856,17 +902,10 @@ func TestValidate(t *testing.T) {
 					},
 				},
 			},
-			expectedError: "3 errors occurred:\n" +
-				"\t* [networking.os.device] \"eth0\": bonded/bridged interface shouldn't have any addressing methods configured\n" +
-				"\t* [networking.os.device] \"eth1\": bonded/bridged interface shouldn't have any addressing methods configured\n" +
-				"\t* [networking.os.device] \"eth2\": bonded/bridged interface shouldn't have any addressing methods configured\n" +
-				"\n",
-			expectedWarnings: []string{
-				"\"eth2\": machine.network.interface.cidr is deprecated, please use machine.network.interface.addresses",
-			},
+			expectedError: "2 errors occurred:\n\t* [networking.os.device.dhcpOptions.duidv6Type] \"eth0.25\": unsupported DUID type \"EN\"\n\t* [networking.os.device.dhcpOptions.duidv6] \"eth0\": invalid DUID \"0003000152540012345\": encoding/hex: odd length hex string\n\n",
 		},
 		{
-			name: "BridgeSlaveAddressing",
+			name: "DHCPOptionsClientIdentifier",
 			config: &v1alpha1.Config{
 				ConfigVersion: "v1alpha1",
 				MachineConfig: &v1alpha1.MachineConfig{
@@ -877,30 +916,22 @@ func TestValidate(t *testing.T) {
 					},
 					MachineNetwork: &v1alpha1.NetworkConfig{
 						NetworkInterfaces: []*v1alpha1.Device{
-							{
-								DeviceInterface: "br0",
-								DeviceBridge: &v1alpha1.Bridge{
-									BridgedInterfaces: []string{
-										"eth0",
-										"eth1",
-										"eth2",
-									},
-								},
-							},
 							{
 								DeviceInterface: "eth0",
 								DeviceDHCP:      pointer.To(true),
+								DeviceDHCPOptions: &v1alpha1.DHCPOptions{
+									DHCPClientIdentifier: strings.Repeat("x", 255),
+									DHCPRequestedOptions: []uint32{1, 0, 12, 255},
+								},
 							},
 							{
 								DeviceInterface: "eth1",
-								DeviceAddresses: []string{
-									"192.168.0.1/24",
+								DeviceDHCP:      pointer.To(true),
+								DeviceDHCPOptions: &v1alpha1.DHCPOptions{
+									DHCPClientIdentifier: "duid",
+									DHCPSendHostname:     pointer.To(false),
 								},
 							},
-							{
-								DeviceInterface: "eth2",
-								DeviceCIDR:      "192.168.1.1/24",
-							},
 						},
 					},
 				},
@@ -912,17 +943,12 @@ func TestValidate(t *testing.T) {
 					},
 				},
 			},
-			expectedError: "3 errors occurred:\n" +
-				"\t* [networking.os.device] \"eth0\": bonded/bridged interface shouldn't have any addressing methods configured\n" +
-				"\t* [networking.os.device] \"eth1\": bonded/bridged interface shouldn't have any addressing methods configured\n" +
-				"\t* [networking.os.device] \"eth2\": bonded/bridged interface shouldn't have any addressing methods configured\n" +
-				"\n",
-			expectedWarnings: []string{
-				"\"eth2\": machine.network.interface.cidr is deprecated, please use machine.network.interface.addresses",
-			},
+			expectedError: "3 errors occurred:\n\t* [networking.os.device.dhcpOptions.clientIdentifier] \"eth0\": invalid client identifier \"" + strings.Repeat("x", 255) +
+				"\": too long (256 bytes, maximum is 255)\n\t* [networking.os.device.dhcpOptions.requestedOptions] \"eth0\": invalid DHCP option 0" +
+				"\n\t* [networking.os.device.dhcpOptions.requestedOptions] \"eth0\": invalid DHCP option 255\n\n",
 		},
 		{
-			name: "BridgeDoubleBridge",
+			name: "RouteRules",
 			config: &v1alpha1.Config{
 				ConfigVersion: "v1alpha1",
 				MachineConfig: &v1alpha1.MachineConfig{
@@ -934,22 +960,41 @@ func TestValidate(t *testing.T) {
 					MachineNetwork: &v1alpha1.NetworkConfig{
 						NetworkInterfaces: []*v1alpha1.Device{
 							{
-								DeviceInterface: "br0",
-								DeviceBridge: &v1alpha1.Bridge{
-									BridgedInterfaces: []string{
-										"eth0",
-										"eth1",
+								DeviceInterface: "eth0",
+								DeviceRoutes: []*v1alpha1.Route{
+									{
+										RouteNetwork: "10.9.0.0/24",
+										RouteGateway: "10.9.0.1",
+										RouteTable:   100,
+									},
+									{
+										RouteNetwork: "10.0.0.0/24",
+										RouteGateway: "10.0.0.1",
+										RouteTable:   180,
 									},
 								},
 							},
+						},
+						NetworkRouteRules: []*v1alpha1.RouteRule{
 							{
-								DeviceInterface: "br1",
-								DeviceBridge: &v1alpha1.Bridge{
-									BridgedInterfaces: []string{
-										"eth1",
-										"eth2",
-									},
-								},
+								RuleFrom:     "10.9.0.0/24",
+								RulePriority: 1000,
+								RuleTable:    100,
+							},
+							{
+								RuleFrom:     "10.9.0.0/24",
+								RuleTo:       "fd00::/64",
+								RulePriority: 1001,
+								RuleTable:    100,
+							},
+							{
+								RuleFwMark:   0x20,
+								RulePriority: 1000,
+								RuleTable:    180,
+							},
+							{
+								RuleFrom:     "foo",
+								RulePriority: 40000,
 							},
 						},
 					},
@@ -962,10 +1007,17 @@ func TestValidate(t *testing.T) {
 					},
 				},
 			},
-			expectedError: "1 error occurred:\n\t* interface \"eth1\" is declared as part of two separate links: \"br0\" and \"br1\"\n\n",
+			expectedError: "8 errors occurred:\n\t* [networking.os.device.route[1].table] 180: routing table 180 is reserved for KubeSpan\n" +
+				"\t* [networking.os.routeRules[1]]: from and to should be of the same address family\n" +
+				"\t* [networking.os.routeRules[2].priority] 1000: priority is declared multiple times\n" +
+				"\t* [networking.os.routeRules[2].table] 180: routing table 180 is reserved for KubeSpan\n" +
+				"\t* [networking.os.routeRules[2].fwMark] 0x20: firewall mark conflicts with KubeSpan firewall marks\n" +
+				"\t* [networking.os.routeRules[3].from] \"foo\": invalid network address\n" +
+				"\t* [networking.os.routeRules[3].priority] 40000: priority should be in range 1-32765\n" +
+				"\t* [networking.os.routeRules[3].table] 0: routing table should be set\n\n",
 		},
 		{
-			name: "InterfaceIsBothBridgeAndBond",
+			name: "TimeDHCPServers",
 			config: &v1alpha1.Config{
 				ConfigVersion: "v1alpha1",
 				MachineConfig: &v1alpha1.MachineConfig{
@@ -974,24 +1026,9 @@ func TestValidate(t *testing.T) {
 						Crt: []byte("foo"),
 						Key: []byte("bar"),
 					},
-					MachineNetwork: &v1alpha1.NetworkConfig{
-						NetworkInterfaces: []*v1alpha1.Device{
-							{
-								DeviceInterface: "bridgebond0",
-								DeviceBridge: &v1alpha1.Bridge{
-									BridgedInterfaces: []string{
-										"eth0",
-										"eth1",
-									},
-								},
-								DeviceBond: &v1alpha1.Bond{
-									BondInterfaces: []string{
-										"eth2",
-										"eth3",
-									},
-								},
-							},
-						},
+					MachineTime: &v1alpha1.TimeConfig{
+						TimeServers:     []string{"time.cloudflare.com"},
+						TimeDHCPServers: "merge",
 					},
 				},
 				ClusterConfig: &v1alpha1.ClusterConfig{
@@ -1002,10 +1039,10 @@ func TestValidate(t *testing.T) {
 					},
 				},
 			},
-			expectedError: "1 error occurred:\n\t* interface has both bridge and bond sections set \"bridgebond0\": config sections are mutually exclusive\n\n",
+			expectedError: "1 error occurred:\n\t* invalid time configuration (.machine.time.dhcpServers): unsupported DHCP time servers mode \"merge\"\n\n",
 		},
 		{
-			name: "InterfacePartOfBridgeAndBond",
+			name: "TimeNTS",
 			config: &v1alpha1.Config{
 				ConfigVersion: "v1alpha1",
 				MachineConfig: &v1alpha1.MachineConfig{
@@ -1014,24 +1051,16 @@ func TestValidate(t *testing.T) {
 						Crt: []byte("foo"),
 						Key: []byte("bar"),
 					},
-					MachineNetwork: &v1alpha1.NetworkConfig{
-						NetworkInterfaces: []*v1alpha1.Device{
-							{
-								DeviceInterface: "br0",
-								DeviceBridge: &v1alpha1.Bridge{
-									BridgedInterfaces: []string{
-										"eth0",
-										"eth1",
-									},
+					MachineTime: &v1alpha1.TimeConfig{
+						TimeNTS: &v1alpha1.TimeNTSConfig{
+							NTSServers: []v1alpha1.TimeNTSServer{
+								{
+									NTSServer: "time.cloudflare.com",
 								},
-							},
-							{
-								DeviceInterface: "bond0",
-								DeviceBond: &v1alpha1.Bond{
-									BondInterfaces: []string{
-										"eth1",
-										"eth2",
-									},
+								{},
+								{
+									NTSServer:        "time.cloudflare.com",
+									NTSCACertificate: "foo",
 								},
 							},
 						},
@@ -1045,10 +1074,13 @@ func TestValidate(t *testing.T) {
 					},
 				},
 			},
-			expectedError: "1 error occurred:\n\t* interface \"eth1\" is declared as part of two separate links: \"br0\" and \"bond0\"\n\n",
+			expectedError: "3 errors occurred:\n" +
+				"\t* [machine.time.nts.servers[1].server]: NTS server is required\n" +
+				"\t* [machine.time.nts.servers[2].server] \"time.cloudflare.com\": duplicate NTS server\n" +
+				"\t* [machine.time.nts.servers[2].caCertificate]: failed to parse PEM-encoded CA certificate\n\n",
 		},
 		{
-			name: "Wireguard",
+			name: "KernelModules",
 			config: &v1alpha1.Config{
 				ConfigVersion: "v1alpha1",
 				MachineConfig: &v1alpha1.MachineConfig{
@@ -1057,31 +1089,16 @@ func TestValidate(t *testing.T) {
 						Crt: []byte("foo"),
 						Key: []byte("bar"),
 					},
-					MachineNetwork: &v1alpha1.NetworkConfig{
-						NetworkInterfaces: []*v1alpha1.Device{
+					MachineKernel: &v1alpha1.KernelConfig{
+						KernelModules: []*v1alpha1.KernelModuleConfig{
 							{
-								DeviceInterface: "wireguard0",
-								DeviceWireguardConfig: &v1alpha1.DeviceWireguardConfig{
-									WireguardPrivateKey: "ONtS+jU1Q+ZHLgs7DbYvnF5Iyj+koxBtvknDigFsdG8=",
-									WireguardPeers: []*v1alpha1.DeviceWireguardPeer{
-										{},
-										{
-											WireguardPublicKey: "4A3rogGVHuVjeZz5cbqryWXGkGBdIGC0E6+5mX2Iz1A=",
-											WireguardEndpoint:  "example.com:1234",
-											WireguardAllowedIPs: []string{
-												"10.2.0.5/31",
-												"2.4.5.3/32",
-											},
-										},
-										{
-											WireguardPublicKey: "4A3rogGVHuVjeZz5cbqryWXGkGBdIGC0E6+5mX2Iz1==",
-											WireguardEndpoint:  "12.3.4.5",
-											WireguardAllowedIPs: []string{
-												"10.2.0",
-											},
-										},
-									},
-								},
+								ModuleName:       "nf_conntrack",
+								ModuleParameters: []string{"hashsize=131072"},
+							},
+							{},
+							{
+								ModuleName:       "nf_conntrack",
+								ModuleParameters: []string{"hashsize", "foo=bar;reboot", "foo=$(id)"},
 							},
 						},
 					},
@@ -1094,11 +1111,15 @@ func TestValidate(t *testing.T) {
 					},
 				},
 			},
-			expectedError: "4 errors occurred:\n\t* public key invalid: wrong key \"\" length: 0\n\t* public key invalid: wrong key \"4A3rogGVHuVjeZz5cbqryWXGkGBdIGC0E6+5mX2Iz1==\" length: 31\n" +
-				"\t* peer endpoint \"12.3.4.5\" is invalid\n\t* peer allowed IP \"10.2.0\" is invalid: invalid CIDR address: 10.2.0\n\n",
+			expectedError: "5 errors occurred:\n" +
+				"\t* [machine.kernel.modules[1].name]: module name is required\n" +
+				"\t* [machine.kernel.modules[2].name]: duplicate module \"nf_conntrack\"\n" +
+				"\t* [machine.kernel.modules[2].parameters[0]]: invalid parameter \"hashsize\", expected key=value without whitespace or shell metacharacters\n" +
+				"\t* [machine.kernel.modules[2].parameters[1]]: invalid parameter \"foo=bar;reboot\", expected key=value without whitespace or shell metacharacters\n" +
+				"\t* [machine.kernel.modules[2].parameters[2]]: invalid parameter \"foo=$(id)\", expected key=value without whitespace or shell metacharacters\n\n",
 		},
 		{
-			name: "StaticRoutes",
+			name: "LoggingSyslog",
 			config: &v1alpha1.Config{
 				ConfigVersion: "v1alpha1",
 				MachineConfig: &v1alpha1.MachineConfig{
@@ -1107,40 +1128,268 @@ func TestValidate(t *testing.T) {
 						Crt: []byte("foo"),
 						Key: []byte("bar"),
 					},
-					MachineNetwork: &v1alpha1.NetworkConfig{
-						NetworkInterfaces: []*v1alpha1.Device{
+					MachineLogging: &v1alpha1.LoggingConfig{
+						LoggingDestinations: []v1alpha1.LoggingDestination{
 							{
-								DeviceInterface: "eth0",
-								DeviceRoutes: []*v1alpha1.Route{
-									{
-										RouteGateway: "172.0.0.1",
-									},
-									{
-										RouteNetwork: "10.0.0.0/24",
-										RouteGateway: "10.0.0.1",
+								LoggingEndpoint: &v1alpha1.Endpoint{URL: ensure.Value(url.Parse("tls://10.5.0.1:6514"))},
+								LoggingFormat:   constants.LoggingFormatSyslog,
+							},
+							{
+								LoggingEndpoint: &v1alpha1.Endpoint{URL: ensure.Value(url.Parse("tls://10.5.0.1:6514"))},
+								LoggingFormat:   constants.LoggingFormatJSONLines,
+							},
+							{
+								LoggingEndpoint: &v1alpha1.Endpoint{URL: ensure.Value(url.Parse("udp://10.5.0.1:514"))},
+								LoggingFormat:   constants.LoggingFormatSyslog,
+								LoggingTLS: &v1alpha1.LoggingTLSConfig{
+									TLSCA: []byte("foo"),
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "3 errors occurred:\n" +
+				"\t* logging endpoint scheme \"tls\" is only supported with \"syslog\" format\n" +
+				"\t* logging TLS configuration requires \"tls\" endpoint scheme\n" +
+				"\t* error parsing logging CA certificate\n\n",
+		},
+		{
+			name: "BondDefaultConfig",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "bond0",
+								DeviceBond:      &v1alpha1.Bond{},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "BondWrongMode",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "bond0",
+								DeviceBond: &v1alpha1.Bond{
+									BondMode:            "roundrobin",
+									BondUpDelay:         100,
+									BondPacketsPerSlave: 8,
+									BondADActorSysPrio:  48,
+									BondInterfaces: []string{
+										"eth0",
+										"eth1",
 									},
-									{
-										RouteNetwork: "10.0.0.0/24",
-										RouteGateway: "10.0.0.1",
-										RouteSource:  "10.0.0.5",
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "3 errors occurred:\n\t* invalid bond type roundrobin\n\t* bond.upDelay can't be set if miiMon is zero\n\t* bond.adActorSysPrio is only available in 802.3ad mode\n\n",
+		},
+		{
+			name: "BondModeOptions",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "bond0",
+								DeviceBond: &v1alpha1.Bond{
+									BondMode:       "active-backup",
+									BondHashPolicy: "vlan+srcmac",
+									BondADSelect:   "bandwidth",
+									BondMinLinks:   1,
+									BondInterfaces: []string{
+										"eth0",
+										"eth1",
 									},
-									{
-										RouteGateway: "172.0.0.x",
+								},
+							},
+							{
+								DeviceInterface: "bond1",
+								DeviceBond: &v1alpha1.Bond{
+									BondMode:           "802.3ad",
+									BondLACPRate:       "fast",
+									BondHashPolicy:     "layer3+4",
+									BondADActorSysPrio: 100,
+									BondMinLinks:       1,
+									BondInterfaces: []string{
+										"eth2",
+										"eth3",
 									},
-									{
-										RouteNetwork: "10.0.0.0",
-										RouteGateway: "10.0.0.1",
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "3 errors occurred:\n\t* bond.xmitHashPolicy is not available in active-backup mode\n\t* bond.adSelect is only available in 802.3ad mode\n\t* bond.minLinks is only available in 802.3ad mode\n\n",
+		},
+		{
+			name: "BridgeVLANPorts",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "br0",
+								DeviceBridge: &v1alpha1.Bridge{
+									BridgedInterfaces: []string{"eth0", "eth1"},
+									BridgeVLAN: &v1alpha1.BridgeVLAN{
+										BridgeVLANFiltering: pointer.To(true),
+										BridgeVLANPorts: []*v1alpha1.BridgeVLANPort{
+											{
+												BridgeVLANPortInterface: "eth0",
+												BridgeVLANPortPVID:      10,
+												BridgeVLANPortTagged:    []uint16{20, 4095},
+											},
+											{
+												BridgeVLANPortInterface: "eth0",
+												BridgeVLANPortPVID:      11,
+											},
+											{
+												BridgeVLANPortInterface: "eth1",
+												BridgeVLANPortUntagged:  []uint16{30},
+												BridgeVLANPortTagged:    []uint16{30},
+											},
+										},
 									},
-									{
-										RouteNetwork: "10.0.0.0/24",
-										RouteGateway: "10.0.0.1",
-										RouteSource:  "10.0.0.3/32",
+								},
+							},
+							{
+								DeviceInterface: "br1",
+								DeviceBridge: &v1alpha1.Bridge{
+									BridgedInterfaces: []string{"eth2"},
+									BridgeVLAN: &v1alpha1.BridgeVLAN{
+										BridgeVLANPorts: []*v1alpha1.BridgeVLANPort{
+											{
+												BridgeVLANPortInterface: "eth2",
+												BridgeVLANPortPVID:      10,
+											},
+										},
 									},
-									{
-										RouteNetwork: "169.254.254.254/32",
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "4 errors occurred:\n\t* bridge.vlan.ports[\"eth0\"]: invalid VLAN ID 4095, must be in range 1-4094\n\t* bridge.vlan.ports[\"eth0\"]: duplicate PVID\n\t* bridge.vlan.ports[\"eth1\"]: VLAN 30 can't be both tagged and untagged\n\t* bridge.vlan.ports requires vlanFiltering to be enabled\n\n",
+		},
+		{
+			name: "BondARPMonitoring",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "bond0",
+								DeviceBond: &v1alpha1.Bond{
+									BondMode:        "active-backup",
+									BondMIIMon:      100,
+									BondARPInterval: 100,
+									BondARPIPTarget: []string{"10.0.0.1", "2001:db8::1"},
+									BondInterfaces: []string{
+										"eth0",
+										"eth1",
 									},
-									{
-										RouteSource: "10.0.0.3",
+								},
+							},
+							{
+								DeviceInterface: "bond1",
+								DeviceBond: &v1alpha1.Bond{
+									BondMode:        "active-backup",
+									BondNSIP6Target: []string{"fe80::1"},
+									BondInterfaces: []string{
+										"eth2",
+										"eth3",
+									},
+								},
+							},
+							{
+								DeviceInterface: "bond2",
+								DeviceBond: &v1alpha1.Bond{
+									BondMode:        "active-backup",
+									BondARPInterval: 100,
+									BondARPValidate: "all",
+									BondARPIPTarget: []string{"10.0.0.1"},
+									BondNSIP6Target: []string{"2001:db8::1"},
+									BondInterfaces: []string{
+										"eth4",
+										"eth5",
 									},
 								},
 							},
@@ -1155,13 +1404,10 @@ func TestValidate(t *testing.T) {
 					},
 				},
 			},
-			expectedError: "4 errors occurred:\n\t* [networking.os.device.route[3].gateway] \"172.0.0.x\": invalid network address\n" +
-				"\t* [networking.os.device.route[4].network] \"10.0.0.0\": invalid network address\n" +
-				"\t* [networking.os.device.route[5].source] \"10.0.0.3/32\": invalid network address\n" +
-				"\t* [networking.os.device.route[7]]: either network or gateway should be set\n\n",
+			expectedError: "3 errors occurred:\n\t* bond.miiMon and bond.arpInterval can't be set at the same time\n\t* bond.arpIPTarget \"2001:db8::1\" is not a valid IPv4 address\n\t* bond.arpIPTarget and bond.nsIP6Target require arpInterval to be set\n\n",
 		},
 		{
-			name: "KubeSpanNoDiscovery",
+			name: "BondInterfacesAndSelectors",
 			config: &v1alpha1.Config{
 				ConfigVersion: "v1alpha1",
 				MachineConfig: &v1alpha1.MachineConfig{
@@ -1170,9 +1416,924 @@ func TestValidate(t *testing.T) {
 						Crt: []byte("foo"),
 						Key: []byte("bar"),
 					},
-					MachineNetwork: &v1alpha1.NetworkConfig{
-						NetworkKubeSpan: &v1alpha1.NetworkKubeSpan{
-							KubeSpanEnabled: pointer.To(true),
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "bond0",
+								DeviceBond: &v1alpha1.Bond{
+									BondInterfaces: []string{
+										"eth0",
+										"eth1",
+									},
+									BondDeviceSelectors: []v1alpha1.NetworkDeviceSelector{
+										{
+											NetworkDeviceKernelDriver: "virtio",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* interface \"bond0\" has both interfaces and selectors set: config sections are mutually exclusive\n\n",
+		},
+		{
+			name: "BondDoubleBond",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "bond0",
+								DeviceBond: &v1alpha1.Bond{
+									BondInterfaces: []string{
+										"eth0",
+										"eth1",
+									},
+								},
+							},
+							{
+								DeviceInterface: "bond1",
+								DeviceBond: &v1alpha1.Bond{
+									BondInterfaces: []string{
+										"eth1",
+										"eth2",
+									},
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* interface \"eth1\" is declared as part of two separate links: \"bond0\" and \"bond1\"\n\n",
+		},
+		{
+			name: "BondSlaveAddressing",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "bond0",
+								DeviceBond: &v1alpha1.Bond{
+									BondInterfaces: []string{
+										"eth0",
+										"eth1",
+										"eth2",
+									},
+								},
+							},
+							{
+								DeviceInterface: "eth0",
+								DeviceDHCP:      pointer.To(true),
+							},
+							{
+								DeviceInterface: "eth1",
+								DeviceAddresses: []string{
+									"192.168.0.1/24",
+								},
+							},
+							{
+								DeviceInterface: "eth2",
+								DeviceCIDR:      "192.168.1.1/24",
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "3 errors occurred:\n" +
+				"\t* [networking.os.device] \"eth0\": bonded/bridged interface shouldn't have any addressing methods configured\n" +
+				"\t* [networking.os.device] \"eth1\": bonded/bridged interface shouldn't have any addressing methods configured\n" +
+				"\t* [networking.os.device] \"eth2\": bonded/bridged interface shouldn't have any addressing methods configured\n" +
+				"\n",
+			expectedWarnings: []string{
+				"\"eth2\": machine.network.interface.cidr is deprecated, please use machine.network.interface.addresses",
+			},
+		},
+		{
+			name: "BridgeSlaveAddressing",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "br0",
+								DeviceBridge: &v1alpha1.Bridge{
+									BridgedInterfaces: []string{
+										"eth0",
+										"eth1",
+										"eth2",
+									},
+								},
+							},
+							{
+								DeviceInterface: "eth0",
+								DeviceDHCP:      pointer.To(true),
+							},
+							{
+								DeviceInterface: "eth1",
+								DeviceAddresses: []string{
+									"192.168.0.1/24",
+								},
+							},
+							{
+								DeviceInterface: "eth2",
+								DeviceCIDR:      "192.168.1.1/24",
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "3 errors occurred:\n" +
+				"\t* [networking.os.device] \"eth0\": bonded/bridged interface shouldn't have any addressing methods configured\n" +
+				"\t* [networking.os.device] \"eth1\": bonded/bridged interface shouldn't have any addressing methods configured\n" +
+				"\t* [networking.os.device] \"eth2\": bonded/bridged interface shouldn't have any addressing methods configured\n" +
+				"\n",
+			expectedWarnings: []string{
+				"\"eth2\": machine.network.interface.cidr is deprecated, please use machine.network.interface.addresses",
+			},
+		},
+		{
+			name: "BridgeDoubleBridge",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "br0",
+								DeviceBridge: &v1alpha1.Bridge{
+									BridgedInterfaces: []string{
+										"eth0",
+										"eth1",
+									},
+								},
+							},
+							{
+								DeviceInterface: "br1",
+								DeviceBridge: &v1alpha1.Bridge{
+									BridgedInterfaces: []string{
+										"eth1",
+										"eth2",
+									},
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* interface \"eth1\" is declared as part of two separate links: \"br0\" and \"br1\"\n\n",
+		},
+		{
+			name: "InterfaceIsBothBridgeAndBond",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "bridgebond0",
+								DeviceBridge: &v1alpha1.Bridge{
+									BridgedInterfaces: []string{
+										"eth0",
+										"eth1",
+									},
+								},
+								DeviceBond: &v1alpha1.Bond{
+									BondInterfaces: []string{
+										"eth2",
+										"eth3",
+									},
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* interface has both bridge and bond sections set \"bridgebond0\": config sections are mutually exclusive\n\n",
+		},
+		{
+			name: "InterfacePartOfBridgeAndBond",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "br0",
+								DeviceBridge: &v1alpha1.Bridge{
+									BridgedInterfaces: []string{
+										"eth0",
+										"eth1",
+									},
+								},
+							},
+							{
+								DeviceInterface: "bond0",
+								DeviceBond: &v1alpha1.Bond{
+									BondInterfaces: []string{
+										"eth1",
+										"eth2",
+									},
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* interface \"eth1\" is declared as part of two separate links: \"br0\" and \"bond0\"\n\n",
+		},
+		{
+			name: "Wireguard",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "wireguard0",
+								DeviceWireguardConfig: &v1alpha1.DeviceWireguardConfig{
+									WireguardPrivateKey: "ONtS+jU1Q+ZHLgs7DbYvnF5Iyj+koxBtvknDigFsdG8=",
+									WireguardPeers: []*v1alpha1.DeviceWireguardPeer{
+										{},
+										{
+											WireguardPublicKey: "4A3rogGVHuVjeZz5cbqryWXGkGBdIGC0E6+5mX2Iz1A=",
+											WireguardEndpoint:  "example.com:1234",
+											WireguardAllowedIPs: []string{
+												"10.2.0.5/31",
+												"2.4.5.3/32",
+											},
+										},
+										{
+											WireguardPublicKey: "4A3rogGVHuVjeZz5cbqryWXGkGBdIGC0E6+5mX2Iz1==",
+											WireguardEndpoint:  "12.3.4.5",
+											WireguardAllowedIPs: []string{
+												"10.2.0",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "4 errors occurred:\n\t* public key invalid: wrong key \"\" length: 0\n\t* public key invalid: wrong key \"4A3rogGVHuVjeZz5cbqryWXGkGBdIGC0E6+5mX2Iz1==\" length: 31\n" +
+				"\t* peer endpoint \"12.3.4.5\" is invalid\n\t* peer allowed IP \"10.2.0\" is invalid: invalid CIDR address: 10.2.0\n\n",
+		},
+		{
+			name: "StaticRoutes",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "eth0",
+								DeviceRoutes: []*v1alpha1.Route{
+									{
+										RouteGateway: "172.0.0.1",
+									},
+									{
+										RouteNetwork: "10.0.0.0/24",
+										RouteGateway: "10.0.0.1",
+									},
+									{
+										RouteNetwork: "10.0.0.0/24",
+										RouteGateway: "10.0.0.1",
+										RouteSource:  "10.0.0.5",
+									},
+									{
+										RouteGateway: "172.0.0.x",
+									},
+									{
+										RouteNetwork: "10.0.0.0",
+										RouteGateway: "10.0.0.1",
+									},
+									{
+										RouteNetwork: "10.0.0.0/24",
+										RouteGateway: "10.0.0.1",
+										RouteSource:  "10.0.0.3/32",
+									},
+									{
+										RouteNetwork: "169.254.254.254/32",
+									},
+									{
+										RouteSource: "10.0.0.3",
+									},
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "4 errors occurred:\n\t* [networking.os.device.route[3].gateway] \"172.0.0.x\": invalid network address\n" +
+				"\t* [networking.os.device.route[4].network] \"10.0.0.0\": invalid network address\n" +
+				"\t* [networking.os.device.route[5].source] \"10.0.0.3/32\": invalid network address\n" +
+				"\t* [networking.os.device.route[7]]: either network or gateway should be set\n\n",
+		},
+		{
+			name: "DeviceDHCPFallback",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "eth0",
+								DeviceDHCP:      pointer.To(true),
+								DeviceRoutes: []*v1alpha1.Route{
+									{
+										RouteGateway: "2001:db8::1",
+									},
+								},
+								DeviceDHCPFallback: &v1alpha1.DeviceDHCPFallbackConfig{
+									FallbackEnabled: true,
+								},
+							},
+							{
+								DeviceInterface: "eth1",
+								DeviceAddresses: []string{"192.168.1.10/24"},
+								DeviceRoutes: []*v1alpha1.Route{
+									{
+										RouteGateway: "192.168.1.1",
+									},
+								},
+								DeviceDHCPFallback: &v1alpha1.DeviceDHCPFallbackConfig{
+									FallbackProbeTimeout: 5 * time.Second,
+								},
+							},
+							{
+								DeviceInterface: "eth2",
+								DeviceAddresses: []string{"192.168.2.10/24"},
+								DeviceRoutes: []*v1alpha1.Route{
+									{
+										RouteNetwork: "0.0.0.0/0",
+										RouteGateway: "192.168.2.1",
+									},
+								},
+								DeviceDHCPFallback: &v1alpha1.DeviceDHCPFallbackConfig{
+									FallbackEnabled:       true,
+									FallbackProbeInterval: time.Minute,
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "4 errors occurred:\n\t* [networking.os.device.dhcpFallback] \"eth0\": fallback to DHCP can't be enabled together with DHCP\n" +
+				"\t* [networking.os.device.dhcpFallback] \"eth0\": fallback to DHCP requires static addresses\n" +
+				"\t* [networking.os.device.dhcpFallback] \"eth0\": fallback to DHCP requires a default route with an IPv4 gateway\n" +
+				"\t* [networking.os.device.dhcpFallback] \"eth1\": fallback settings require the fallback to be enabled explicitly\n\n",
+		},
+		{
+			name: "KubeSpanNoDiscovery",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkKubeSpan: &v1alpha1.NetworkKubeSpan{
+							KubeSpanEnabled: pointer.To(true),
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "3 errors occurred:\n\t* .cluster.discovery should be enabled when .machine.network.kubespan is enabled\n" +
+				"\t* .cluster.id should be set when .machine.network.kubespan is enabled\n" +
+				"\t* .cluster.secret should be set when .machine.network.kubespan is enabled\n\n",
+		},
+		{
+			name: "KubeSpanKeepaliveOverrides",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkKubeSpan: &v1alpha1.NetworkKubeSpan{
+							KubeSpanEnabled: pointer.To(true),
+							KubeSpanKeepalive: &v1alpha1.KubeSpanKeepalive{
+								KeepaliveOverrides: []v1alpha1.KubeSpanKeepaliveOverride{
+									{
+										OverrideInterval: 10 * time.Second,
+									},
+									{
+										OverrideCIDR:     "10.0.0.0/33",
+										OverrideInterval: -time.Second,
+									},
+									{
+										OverrideLabel: "worker-1",
+									},
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ClusterID:     "foo",
+					ClusterSecret: "bar",
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					ClusterDiscoveryConfig: &v1alpha1.ClusterDiscoveryConfig{
+						DiscoveryEnabled: pointer.To(true),
+					},
+				},
+			},
+			expectedError: "3 errors occurred:\n\t* KubeSpan keepalive override [0] should specify either label or cidr\n" +
+				"\t* KubeSpan keepalive override [1] cidr is not valid: \"10.0.0.0/33\"\n" +
+				"\t* KubeSpan keepalive override [1] interval should not be negative\n\n",
+		},
+		{
+			name: "DiscoveryServiceEndpoint",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ClusterID:     "foo",
+					ClusterSecret: "bar",
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					ClusterDiscoveryConfig: &v1alpha1.ClusterDiscoveryConfig{
+						DiscoveryEnabled: pointer.To(true),
+						DiscoveryRegistries: v1alpha1.DiscoveryRegistriesConfig{
+							RegistryService: v1alpha1.RegistryServiceConfig{
+								RegistryEndpoint: "foo",
+							},
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* cluster discovery service registry endpoint is invalid: parse \"foo\": invalid URI for request\n\n",
+		},
+		{
+			name: "DiscoveryServiceClusterIDSecret",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					ClusterDiscoveryConfig: &v1alpha1.ClusterDiscoveryConfig{
+						DiscoveryEnabled: pointer.To(true),
+					},
+				},
+			},
+			expectedError: "2 errors occurred:\n\t* cluster discovery service requires .cluster.id\n\t* cluster discovery service requires .cluster.secret\n\n",
+		},
+		{
+			name: "EtcdMissingCa",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					EtcdConfig: &v1alpha1.EtcdConfig{},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* key/cert combination should not be empty\n\n",
+		},
+		{
+			name: "EtcdConfigProvidedForWorker",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					EtcdConfig: &v1alpha1.EtcdConfig{},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* etcd config is only allowed on control plane machines\n\n",
+		},
+		{
+			name: "GoodEtcdSubnet",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					EtcdConfig: &v1alpha1.EtcdConfig{
+						RootCA: &x509.PEMEncodedCertificateAndKey{},
+						EtcdAdvertisedSubnets: []string{
+							"10.0.0.0/8",
+							"!1.1.1.1/32",
+						},
+						EtcdListenSubnets: []string{
+							"10.0.0.0/8",
+							"1.1.1.1/32",
+						},
+					},
+				},
+			},
+			expectedError: "",
+		},
+		{
+			name: "BadEtcdSubnet",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					EtcdConfig: &v1alpha1.EtcdConfig{
+						RootCA: &x509.PEMEncodedCertificateAndKey{},
+						EtcdAdvertisedSubnets: []string{
+							"1234:",
+						},
+						EtcdListenSubnets: []string{
+							"10",
+						},
+					},
+				},
+			},
+			expectedError: "2 errors occurred:\n\t* etcd advertised subnet is not valid: \"1234:\"\n\t* etcd listen subnet is not valid: \"10\"\n\n",
+		},
+		{
+			name: "BadEtcdMaintenance",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					EtcdConfig: &v1alpha1.EtcdConfig{
+						RootCA: &x509.PEMEncodedCertificateAndKey{},
+						EtcdDefragmentation: &v1alpha1.EtcdDefragmentationConfig{
+							DefragmentationSchedule:  "@every 10s",
+							DefragmentationThreshold: 120,
+						},
+						EtcdLearnerPromotion: &v1alpha1.EtcdLearnerPromotionConfig{
+							LearnerMaxLearners: -1,
+						},
+					},
+				},
+			},
+			expectedError: "3 errors occurred:\n\t* etcd defragmentation interval should be at least 1m0s: \"@every 10s\"\n\t* etcd defragmentation threshold should be between 0 and 100: 120\n\t* etcd max learners should be non-negative: -1\n\n",
+		},
+		{
+			name: "BadEtcdSnapshots",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					EtcdConfig: &v1alpha1.EtcdConfig{
+						RootCA: &x509.PEMEncodedCertificateAndKey{},
+						EtcdSnapshots: &v1alpha1.EtcdSnapshotsConfig{
+							SnapshotsSchedule:  "@monthly",
+							SnapshotsRetention: -1,
+							SnapshotsS3: &v1alpha1.EtcdSnapshotsS3Config{
+								S3Endpoint:    "s3.example.com",
+								S3AccessKeyID: "key",
+							},
+						},
+					},
+				},
+			},
+			expectedError: "5 errors occurred:\n\t* etcd snapshots schedule \"@monthly\" is not valid: expected one of @hourly, @daily, @weekly or @every <duration>\n\t* etcd snapshots retention should be non-negative: -1\n\t* etcd snapshots S3 endpoint should be a valid http(s) URL: \"s3.example.com\"\n\t* etcd snapshots S3 bucket is required\n\t* etcd snapshots S3 credentials are required\n\n",
+		},
+		{
+			name: "GoodKubeletSubnet",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+					},
+					MachineKubelet: &v1alpha1.KubeletConfig{
+						KubeletNodeIP: &v1alpha1.KubeletNodeIPConfig{
+							KubeletNodeIPValidSubnets: []string{
+								"10.0.0.0/8",
+								"!10.0.0.3/32",
+								"!fd00::169:254:2:53/128",
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "",
+		},
+		{
+			name: "BadKubeletSubnet",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+					},
+					MachineKubelet: &v1alpha1.KubeletConfig{
+						KubeletNodeIP: &v1alpha1.KubeletNodeIPConfig{
+							KubeletNodeIPValidSubnets: []string{
+								"10.0.0.0.3",
+								"[fd00::169:254:2:53]:344",
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "2 errors occurred:\n" +
+				"\t* kubelet nodeIP subnet is not valid: \"10.0.0.0.3\"\n" +
+				"\t* kubelet nodeIP subnet is not valid: \"[fd00::169:254:2:53]:344\"\n" +
+				"\n",
+		},
+		{
+			name: "BadKubeletExtraConfig",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineAcceptedCAs: []*x509.PEMEncodedCertificate{
+						{
+							Crt: []byte("foo"),
+						},
+					},
+					MachineKubelet: &v1alpha1.KubeletConfig{
+						KubeletExtraConfig: v1alpha1.Unstructured{
+							Object: map[string]any{
+								"port": 345,
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* kubelet configuration field \"port\" can't be overridden\n\n",
+		},
+		{
+			name: "KubeletShutdownGracePeriod",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineAcceptedCAs: []*x509.PEMEncodedCertificate{
+						{
+							Crt: []byte("foo"),
+						},
+					},
+					MachineKubelet: &v1alpha1.KubeletConfig{
+						KubeletShutdownGracePeriod:             5 * time.Minute,
+						KubeletShutdownGracePeriodCriticalPods: time.Minute,
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "BadKubeletShutdownGracePeriod",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineAcceptedCAs: []*x509.PEMEncodedCertificate{
+						{
+							Crt: []byte("foo"),
+						},
+					},
+					MachineKubelet: &v1alpha1.KubeletConfig{
+						KubeletShutdownGracePeriodCriticalPods: time.Minute,
+						KubeletExtraConfig: v1alpha1.Unstructured{
+							Object: map[string]any{
+								"shutdownGracePeriod": "2m",
+							},
 						},
 					},
 				},
@@ -1184,50 +2345,58 @@ func TestValidate(t *testing.T) {
 					},
 				},
 			},
-			expectedError: "3 errors occurred:\n\t* .cluster.discovery should be enabled when .machine.network.kubespan is enabled\n" +
-				"\t* .cluster.id should be set when .machine.network.kubespan is enabled\n" +
-				"\t* .cluster.secret should be set when .machine.network.kubespan is enabled\n\n",
+			expectedError: "2 errors occurred:\n" +
+				"\t* [machine.kubelet.shutdownGracePeriodCriticalPods]: shutdown grace period for critical pods 1m0s should be less than the shutdown grace period 30s\n" +
+				"\t* [machine.kubelet.extraConfig]: field \"shutdownGracePeriod\" can't be set when shutdown grace period is configured\n" +
+				"\n",
 		},
 		{
-			name: "DiscoveryServiceEndpoint",
+			name: "ResolverOptionsOutOfRange",
 			config: &v1alpha1.Config{
 				ConfigVersion: "v1alpha1",
 				MachineConfig: &v1alpha1.MachineConfig{
-					MachineType: "controlplane",
-					MachineCA: &x509.PEMEncodedCertificateAndKey{
-						Crt: []byte("foo"),
-						Key: []byte("bar"),
+					MachineType: "worker",
+					MachineAcceptedCAs: []*x509.PEMEncodedCertificate{
+						{
+							Crt: []byte("foo"),
+						},
+					},
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkResolverOptions: &v1alpha1.ResolverOptionsConfig{
+							ResolverTimeout:        time.Minute,
+							ResolverAttempts:       10,
+							ResolverUpstreamPolicy: "random",
+						},
 					},
 				},
 				ClusterConfig: &v1alpha1.ClusterConfig{
-					ClusterID:     "foo",
-					ClusterSecret: "bar",
 					ControlPlane: &v1alpha1.ControlPlaneConfig{
 						Endpoint: &v1alpha1.Endpoint{
 							endpointURL,
 						},
 					},
-					ClusterDiscoveryConfig: &v1alpha1.ClusterDiscoveryConfig{
-						DiscoveryEnabled: pointer.To(true),
-						DiscoveryRegistries: v1alpha1.DiscoveryRegistriesConfig{
-							RegistryService: v1alpha1.RegistryServiceConfig{
-								RegistryEndpoint: "foo",
-							},
-						},
-					},
 				},
 			},
-			expectedError: "1 error occurred:\n\t* cluster discovery service registry endpoint is invalid: parse \"foo\": invalid URI for request\n\n",
+			expectedError: "3 errors occurred:\n\t* [machine.network.resolverOptions.timeout]: timeout 1m0s should be in the range [1s, 30s]\n\t* [machine.network.resolverOptions.attempts]: attempts 10 should be in the range [1, 5]\n\t* [machine.network.resolverOptions.upstreamPolicy]: unsupported upstream policy \"random\"\n\n",
 		},
 		{
-			name: "DiscoveryServiceClusterIDSecret",
+			name: "ResolverOptionsValid",
 			config: &v1alpha1.Config{
 				ConfigVersion: "v1alpha1",
 				MachineConfig: &v1alpha1.MachineConfig{
-					MachineType: "controlplane",
-					MachineCA: &x509.PEMEncodedCertificateAndKey{
-						Crt: []byte("foo"),
-						Key: []byte("bar"),
+					MachineType: "worker",
+					MachineAcceptedCAs: []*x509.PEMEncodedCertificate{
+						{
+							Crt: []byte("foo"),
+						},
+					},
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkResolverOptions: &v1alpha1.ResolverOptionsConfig{
+							ResolverRotate:         pointer.To(true),
+							ResolverTimeout:        2 * time.Second,
+							ResolverAttempts:       3,
+							ResolverUpstreamPolicy: "parallel",
+						},
 					},
 				},
 				ClusterConfig: &v1alpha1.ClusterConfig{
@@ -1236,22 +2405,25 @@ func TestValidate(t *testing.T) {
 							endpointURL,
 						},
 					},
-					ClusterDiscoveryConfig: &v1alpha1.ClusterDiscoveryConfig{
-						DiscoveryEnabled: pointer.To(true),
-					},
 				},
 			},
-			expectedError: "2 errors occurred:\n\t* cluster discovery service requires .cluster.id\n\t* cluster discovery service requires .cluster.secret\n\n",
 		},
 		{
-			name: "EtcdMissingCa",
+			name: "ApidProxyTargetCheckInvalidCIDR",
 			config: &v1alpha1.Config{
 				ConfigVersion: "v1alpha1",
 				MachineConfig: &v1alpha1.MachineConfig{
-					MachineType: "controlplane",
-					MachineCA: &x509.PEMEncodedCertificateAndKey{
-						Crt: []byte("foo"),
-						Key: []byte("bar"),
+					MachineType: "worker",
+					MachineAcceptedCAs: []*x509.PEMEncodedCertificate{
+						{
+							Crt: []byte("foo"),
+						},
+					},
+					MachineFeatures: &v1alpha1.FeaturesConfig{
+						ApidProxyTargetCheckConfig: &v1alpha1.ApidProxyTargetCheckConfig{
+							CheckEnabled:      pointer.To(true),
+							CheckAllowedCIDRs: []string{"10.5.0.0/16", "10.6.0.0"},
+						},
 					},
 				},
 				ClusterConfig: &v1alpha1.ClusterConfig{
@@ -1260,19 +2432,36 @@ func TestValidate(t *testing.T) {
 							endpointURL,
 						},
 					},
-					EtcdConfig: &v1alpha1.EtcdConfig{},
 				},
 			},
-			expectedError: "1 error occurred:\n\t* key/cert combination should not be empty\n\n",
+			expectedError: "1 error occurred:\n\t* invalid CIDR \"10.6.0.0\" in apid proxy target check allowed CIDRs: netip.ParsePrefix(\"10.6.0.0\"): no '/'\n\n",
 		},
 		{
-			name: "EtcdConfigProvidedForWorker",
+			name: "KubeletExtraMountConflictingOptions",
 			config: &v1alpha1.Config{
 				ConfigVersion: "v1alpha1",
 				MachineConfig: &v1alpha1.MachineConfig{
 					MachineType: "worker",
-					MachineCA: &x509.PEMEncodedCertificateAndKey{
-						Crt: []byte("foo"),
+					MachineAcceptedCAs: []*x509.PEMEncodedCertificate{
+						{
+							Crt: []byte("foo"),
+						},
+					},
+					MachineKubelet: &v1alpha1.KubeletConfig{
+						KubeletExtraMounts: []v1alpha1.ExtraMount{
+							{
+								Destination: "/var/lib/csi",
+								Type:        "bind",
+								Source:      "/var/lib/csi",
+								Options:     []string{"rbind", "rshared", "rro"},
+							},
+							{
+								Destination: "/var/lib/example",
+								Type:        "bind",
+								Source:      "/var/lib/example",
+								Options:     []string{"bind", "rshared", "rslave", "rro"},
+							},
+						},
 					},
 				},
 				ClusterConfig: &v1alpha1.ClusterConfig{
@@ -1281,20 +2470,37 @@ func TestValidate(t *testing.T) {
 							endpointURL,
 						},
 					},
-					EtcdConfig: &v1alpha1.EtcdConfig{},
 				},
 			},
-			expectedError: "1 error occurred:\n\t* etcd config is only allowed on control plane machines\n\n",
+			expectedError: "2 errors occurred:\n" +
+				"\t* .machine.kubelet.extraMounts[1].options: conflicting propagation options [\"rshared\" \"rslave\"]\n" +
+				"\t* .machine.kubelet.extraMounts[1].options: \"rro\" requires \"rbind\"\n\n",
 		},
 		{
-			name: "GoodEtcdSubnet",
+			name: "BadKubeletCredentialProviderConfig",
 			config: &v1alpha1.Config{
 				ConfigVersion: "v1alpha1",
 				MachineConfig: &v1alpha1.MachineConfig{
-					MachineType: "controlplane",
-					MachineCA: &x509.PEMEncodedCertificateAndKey{
-						Crt: []byte("foo"),
-						Key: []byte("bar"),
+					MachineType: "worker",
+					MachineAcceptedCAs: []*x509.PEMEncodedCertificate{
+						{
+							Crt: []byte("foo"),
+						},
+					},
+					MachineKubelet: &v1alpha1.KubeletConfig{
+						KubeletCredentialProviderConfig: v1alpha1.Unstructured{
+							Object: map[string]any{
+								"apiVersion": "kubelet.config.k8s.io/v1",
+								"kind":       "CredentialProviderConfig",
+								"providers": []any{
+									map[string]any{
+										"name":                 "ecr-credential-provider",
+										"apiVersion":           "credentialprovider.kubelet.k8s.io/v1",
+										"defaultCacheDuration": "12h",
+									},
+								},
+							},
+						},
 					},
 				},
 				ClusterConfig: &v1alpha1.ClusterConfig{
@@ -1303,30 +2509,25 @@ func TestValidate(t *testing.T) {
 							endpointURL,
 						},
 					},
-					EtcdConfig: &v1alpha1.EtcdConfig{
-						RootCA: &x509.PEMEncodedCertificateAndKey{},
-						EtcdAdvertisedSubnets: []string{
-							"10.0.0.0/8",
-							"!1.1.1.1/32",
-						},
-						EtcdListenSubnets: []string{
-							"10.0.0.0/8",
-							"1.1.1.1/32",
-						},
-					},
 				},
 			},
-			expectedError: "",
+			expectedError: "1 error occurred:\n\t* .machine.kubelet.credentialProviderConfig.providers[0].matchImages: at least one image pattern is required\n\n",
 		},
 		{
-			name: "BadEtcdSubnet",
+			name: "Sysctls",
 			config: &v1alpha1.Config{
 				ConfigVersion: "v1alpha1",
 				MachineConfig: &v1alpha1.MachineConfig{
-					MachineType: "controlplane",
-					MachineCA: &x509.PEMEncodedCertificateAndKey{
-						Crt: []byte("foo"),
-						Key: []byte("bar"),
+					MachineType: "worker",
+					MachineAcceptedCAs: []*x509.PEMEncodedCertificate{
+						{
+							Crt: []byte("foo"),
+						},
+					},
+					MachineSysctls: map[string]string{
+						"kernel.domainname":   "talos.dev",
+						"kernal.domainname":   "talos.dev",
+						"net.ipv4.ip_forward": "0",
 					},
 				},
 				ClusterConfig: &v1alpha1.ClusterConfig{
@@ -1335,37 +2536,28 @@ func TestValidate(t *testing.T) {
 							endpointURL,
 						},
 					},
-					EtcdConfig: &v1alpha1.EtcdConfig{
-						RootCA: &x509.PEMEncodedCertificateAndKey{},
-						EtcdAdvertisedSubnets: []string{
-							"1234:",
-						},
-						EtcdListenSubnets: []string{
-							"10",
-						},
-					},
 				},
 			},
-			expectedError: "2 errors occurred:\n\t* etcd advertised subnet is not valid: \"1234:\"\n\t* etcd listen subnet is not valid: \"10\"\n\n",
+			expectedError: "2 errors occurred:\n" +
+				"\t* .machine.sysctls: invalid sysctl key \"kernal.domainname\"\n" +
+				"\t* .machine.sysctls: sysctl \"net.ipv4.ip_forward\" can't be set to \"0\": IPv4 forwarding is required for Kubernetes networking (set .machine.sysctlsAllowProtected to override)\n" +
+				"\n",
 		},
 		{
-			name: "GoodKubeletSubnet",
+			name: "SysctlsAllowProtected",
 			config: &v1alpha1.Config{
 				ConfigVersion: "v1alpha1",
 				MachineConfig: &v1alpha1.MachineConfig{
 					MachineType: "worker",
-					MachineCA: &x509.PEMEncodedCertificateAndKey{
-						Crt: []byte("foo"),
-					},
-					MachineKubelet: &v1alpha1.KubeletConfig{
-						KubeletNodeIP: &v1alpha1.KubeletNodeIPConfig{
-							KubeletNodeIPValidSubnets: []string{
-								"10.0.0.0/8",
-								"!10.0.0.3/32",
-								"!fd00::169:254:2:53/128",
-							},
+					MachineAcceptedCAs: []*x509.PEMEncodedCertificate{
+						{
+							Crt: []byte("foo"),
 						},
 					},
+					MachineSysctls: map[string]string{
+						"net.ipv4.ip_forward": "0",
+					},
+					MachineSysctlsAllowProtected: pointer.To(true),
 				},
 				ClusterConfig: &v1alpha1.ClusterConfig{
 					ControlPlane: &v1alpha1.ControlPlaneConfig{
@@ -1375,22 +2567,28 @@ func TestValidate(t *testing.T) {
 					},
 				},
 			},
-			expectedError: "",
 		},
 		{
-			name: "BadKubeletSubnet",
+			name: "KubeletExtraConfigSchemaErrors",
 			config: &v1alpha1.Config{
 				ConfigVersion: "v1alpha1",
 				MachineConfig: &v1alpha1.MachineConfig{
 					MachineType: "worker",
-					MachineCA: &x509.PEMEncodedCertificateAndKey{
-						Crt: []byte("foo"),
+					MachineAcceptedCAs: []*x509.PEMEncodedCertificate{
+						{
+							Crt: []byte("foo"),
+						},
 					},
 					MachineKubelet: &v1alpha1.KubeletConfig{
-						KubeletNodeIP: &v1alpha1.KubeletNodeIPConfig{
-							KubeletNodeIPValidSubnets: []string{
-								"10.0.0.0.3",
-								"[fd00::169:254:2:53]:344",
+						KubeletImage: "ghcr.io/siderolabs/kubelet:v1.31.0",
+						KubeletExtraConfig: v1alpha1.Unstructured{
+							Object: map[string]any{
+								"maxPod":               110,
+								"serverTLSBootstrap":   "yes",
+								"evictionHard":         map[string]any{"memory.available": 100},
+								"shutdownGracePeriod":  "30",
+								"failCgroupV1":         true,
+								"singleProcessOOMKill": true,
 							},
 						},
 					},
@@ -1403,13 +2601,16 @@ func TestValidate(t *testing.T) {
 					},
 				},
 			},
-			expectedError: "2 errors occurred:\n" +
-				"\t* kubelet nodeIP subnet is not valid: \"10.0.0.0.3\"\n" +
-				"\t* kubelet nodeIP subnet is not valid: \"[fd00::169:254:2:53]:344\"\n" +
+			expectedError: "5 errors occurred:\n" +
+				"\t* .machine.kubelet.extraConfig.evictionHard.memory.available: expected string, got number\n" +
+				"\t* .machine.kubelet.extraConfig.maxPod: unknown kubelet configuration field\n" +
+				"\t* .machine.kubelet.extraConfig.serverTLSBootstrap: expected bool, got string\n" +
+				"\t* .machine.kubelet.extraConfig.shutdownGracePeriod: time: missing unit in duration \"30\"\n" +
+				"\t* .machine.kubelet.extraConfig.singleProcessOOMKill: kubelet configuration field requires Kubernetes 1.32.0 or later\n" +
 				"\n",
 		},
 		{
-			name: "BadKubeletExtraConfig",
+			name: "KubeletExtraConfigAllowUnknownFields",
 			config: &v1alpha1.Config{
 				ConfigVersion: "v1alpha1",
 				MachineConfig: &v1alpha1.MachineConfig{
@@ -1420,11 +2621,15 @@ func TestValidate(t *testing.T) {
 						},
 					},
 					MachineKubelet: &v1alpha1.KubeletConfig{
+						KubeletImage: "ghcr.io/siderolabs/kubelet:v1.31.0",
 						KubeletExtraConfig: v1alpha1.Unstructured{
 							Object: map[string]any{
-								"port": 345,
+								"someNewField": true,
+								"staticPodURL": "http://example.com",
+								"maxPods":      110,
 							},
 						},
+						KubeletExtraConfigAllowUnknownFields: pointer.To(true),
 					},
 				},
 				ClusterConfig: &v1alpha1.ClusterConfig{
@@ -1435,7 +2640,10 @@ func TestValidate(t *testing.T) {
 					},
 				},
 			},
-			expectedError: "1 error occurred:\n\t* kubelet configuration field \"port\" can't be overridden\n\n",
+			expectedWarnings: []string{
+				".machine.kubelet.extraConfig.someNewField: unknown kubelet configuration field, passing it as is",
+				".machine.kubelet.extraConfig.staticPodURL: kubelet configuration field is managed by Talos, the value will be ignored",
+			},
 		},
 		{
 			name: "DeviceInterfaceInvalid",
@@ -1710,6 +2918,42 @@ func TestValidate(t *testing.T) {
 			},
 			expectedError: "2 errors occurred:\n\t* KubeSpan endpoint filer is not valid: \"10\"\n\t* KubeSpan endpoint filer is not valid: \"123::/456\"\n\n",
 		},
+		{
+			name: "BadKubeSpanPeerEndpointFilters",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+					},
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkKubeSpan: &v1alpha1.NetworkKubeSpan{
+							KubeSpanEnabled: pointer.To(true),
+							KubeSpanFilters: &v1alpha1.KubeSpanFilters{
+								KubeSpanFiltersPeerEndpoints: []string{
+									"!10",
+									"123::/456",
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					ClusterID:     "test",
+					ClusterSecret: "test",
+					ClusterDiscoveryConfig: &v1alpha1.ClusterDiscoveryConfig{
+						DiscoveryEnabled: pointer.To(true),
+					},
+				},
+			},
+			expectedError: "2 errors occurred:\n\t* KubeSpan peer endpoint filter is not valid: \"10\"\n\t* KubeSpan peer endpoint filter is not valid: \"123::/456\"\n\n",
+		},
 		{
 			name: "KubeSpanSmallMTU",
 			config: &v1alpha1.Config{
@@ -1935,6 +3179,60 @@ func TestValidate(t *testing.T) {
 			},
 			expectedError: "1 error occurred:\n\t* invalid node address sort algorithm: xyz does not belong to AddressSortAlgorithm values\n\n",
 		},
+		{
+			name: "MachineFeaturesInvalidHostDNSCache",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+					MachineFeatures: &v1alpha1.FeaturesConfig{
+						HostDNSSupport: &v1alpha1.HostDNSConfig{
+							HostDNSCacheSize:   -1,
+							HostDNSCacheMinTTL: 10 * time.Minute,
+							HostDNSCacheMaxTTL: time.Minute,
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "2 errors occurred:\n\t* host DNS cache size should be non-negative: -1\n\t* host DNS cacheMinTTL 10m0s should not be greater than cacheMaxTTL 1m0s\n\n",
+		},
+		{
+			name: "MachineFeaturesInvalidHostDNSAllowedClients",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+					MachineFeatures: &v1alpha1.FeaturesConfig{
+						HostDNSSupport: &v1alpha1.HostDNSConfig{
+							HostDNSAllowedClients: []string{"10.244.0.0/16", "10.5.0.0"},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* invalid CIDR \"10.5.0.0\" in host DNS allowed clients: netip.ParsePrefix(\"10.5.0.0\"): no '/'\n\n",
+		},
 	} {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
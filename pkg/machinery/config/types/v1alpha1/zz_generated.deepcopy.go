@@ -134,6 +134,32 @@ func (in AdmissionPluginConfigList) DeepCopy() AdmissionPluginConfigList {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApidProxyTargetCheckConfig) DeepCopyInto(out *ApidProxyTargetCheckConfig) {
+	*out = *in
+	if in.CheckEnabled != nil {
+		in, out := &in.CheckEnabled, &out.CheckEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CheckAllowedCIDRs != nil {
+		in, out := &in.CheckAllowedCIDRs, &out.CheckAllowedCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApidProxyTargetCheckConfig.
+func (in *ApidProxyTargetCheckConfig) DeepCopy() *ApidProxyTargetCheckConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ApidProxyTargetCheckConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in Base64Bytes) DeepCopyInto(out *Base64Bytes) {
 	{
@@ -174,6 +200,11 @@ func (in *Bond) DeepCopyInto(out *Bond) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.BondNSIP6Target != nil {
+		in, out := &in.BondNSIP6Target, &out.BondNSIP6Target
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.BondUseCarrier != nil {
 		in, out := &in.BondUseCarrier, &out.BondUseCarrier
 		*out = new(bool)
@@ -205,6 +236,11 @@ func (in *Bridge) DeepCopyInto(out *Bridge) {
 		*out = new(STP)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BridgeVLAN != nil {
+		in, out := &in.BridgeVLAN, &out.BridgeVLAN
+		*out = new(BridgeVLAN)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -218,6 +254,64 @@ func (in *Bridge) DeepCopy() *Bridge {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BridgeVLAN) DeepCopyInto(out *BridgeVLAN) {
+	*out = *in
+	if in.BridgeVLANFiltering != nil {
+		in, out := &in.BridgeVLANFiltering, &out.BridgeVLANFiltering
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BridgeVLANPorts != nil {
+		in, out := &in.BridgeVLANPorts, &out.BridgeVLANPorts
+		*out = make([]*BridgeVLANPort, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(BridgeVLANPort)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BridgeVLAN.
+func (in *BridgeVLAN) DeepCopy() *BridgeVLAN {
+	if in == nil {
+		return nil
+	}
+	out := new(BridgeVLAN)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BridgeVLANPort) DeepCopyInto(out *BridgeVLANPort) {
+	*out = *in
+	if in.BridgeVLANPortUntagged != nil {
+		in, out := &in.BridgeVLANPortUntagged, &out.BridgeVLANPortUntagged
+		*out = make([]uint16, len(*in))
+		copy(*out, *in)
+	}
+	if in.BridgeVLANPortTagged != nil {
+		in, out := &in.BridgeVLANPortTagged, &out.BridgeVLANPortTagged
+		*out = make([]uint16, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BridgeVLANPort.
+func (in *BridgeVLANPort) DeepCopy() *BridgeVLANPort {
+	if in == nil {
+		return nil
+	}
+	out := new(BridgeVLANPort)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CNIConfig) DeepCopyInto(out *CNIConfig) {
 	*out = *in
@@ -351,6 +445,11 @@ func (in *ClusterConfig) DeepCopyInto(out *ClusterConfig) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.KubeletServingCertificateApproverConfig != nil {
+		in, out := &in.KubeletServingCertificateApproverConfig, &out.KubeletServingCertificateApproverConfig
+		*out = new(KubeletServingCertificateApproverConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -583,6 +682,26 @@ func (in *DHCPOptions) DeepCopyInto(out *DHCPOptions) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.DHCPIgnoreClasslessStaticRoutes != nil {
+		in, out := &in.DHCPIgnoreClasslessStaticRoutes, &out.DHCPIgnoreClasslessStaticRoutes
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DHCPOptimisticLease != nil {
+		in, out := &in.DHCPOptimisticLease, &out.DHCPOptimisticLease
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DHCPSendHostname != nil {
+		in, out := &in.DHCPSendHostname, &out.DHCPSendHostname
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DHCPRequestedOptions != nil {
+		in, out := &in.DHCPRequestedOptions, &out.DHCPRequestedOptions
+		*out = make([]uint32, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -671,6 +790,16 @@ func (in *Device) DeepCopyInto(out *Device) {
 		*out = new(DeviceVIPConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DeviceRouterAdvertisements != nil {
+		in, out := &in.DeviceRouterAdvertisements, &out.DeviceRouterAdvertisements
+		*out = new(DeviceRouterAdvertisementsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeviceDHCPFallback != nil {
+		in, out := &in.DeviceDHCPFallback, &out.DeviceDHCPFallback
+		*out = new(DeviceDHCPFallbackConfig)
+		**out = **in
+	}
 	return
 }
 
@@ -684,6 +813,48 @@ func (in *Device) DeepCopy() *Device {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceDHCPFallbackConfig) DeepCopyInto(out *DeviceDHCPFallbackConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceDHCPFallbackConfig.
+func (in *DeviceDHCPFallbackConfig) DeepCopy() *DeviceDHCPFallbackConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceDHCPFallbackConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceRouterAdvertisementsConfig) DeepCopyInto(out *DeviceRouterAdvertisementsConfig) {
+	*out = *in
+	if in.RAAccept != nil {
+		in, out := &in.RAAccept, &out.RAAccept
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RAAcceptRDNSS != nil {
+		in, out := &in.RAAcceptRDNSS, &out.RAAcceptRDNSS
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceRouterAdvertisementsConfig.
+func (in *DeviceRouterAdvertisementsConfig) DeepCopy() *DeviceRouterAdvertisementsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceRouterAdvertisementsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeviceVIPConfig) DeepCopyInto(out *DeviceVIPConfig) {
 	*out = *in
@@ -697,6 +868,11 @@ func (in *DeviceVIPConfig) DeepCopyInto(out *DeviceVIPConfig) {
 		*out = new(VIPHCloudConfig)
 		**out = **in
 	}
+	if in.VIPPreempt != nil {
+		in, out := &in.VIPPreempt, &out.VIPPreempt
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -948,6 +1124,21 @@ func (in *EtcdConfig) DeepCopyInto(out *EtcdConfig) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.EtcdDefragmentation != nil {
+		in, out := &in.EtcdDefragmentation, &out.EtcdDefragmentation
+		*out = new(EtcdDefragmentationConfig)
+		**out = **in
+	}
+	if in.EtcdLearnerPromotion != nil {
+		in, out := &in.EtcdLearnerPromotion, &out.EtcdLearnerPromotion
+		*out = new(EtcdLearnerPromotionConfig)
+		**out = **in
+	}
+	if in.EtcdSnapshots != nil {
+		in, out := &in.EtcdSnapshots, &out.EtcdSnapshots
+		*out = new(EtcdSnapshotsConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -961,6 +1152,75 @@ func (in *EtcdConfig) DeepCopy() *EtcdConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdDefragmentationConfig) DeepCopyInto(out *EtcdDefragmentationConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdDefragmentationConfig.
+func (in *EtcdDefragmentationConfig) DeepCopy() *EtcdDefragmentationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdDefragmentationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdLearnerPromotionConfig) DeepCopyInto(out *EtcdLearnerPromotionConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdLearnerPromotionConfig.
+func (in *EtcdLearnerPromotionConfig) DeepCopy() *EtcdLearnerPromotionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdLearnerPromotionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdSnapshotsConfig) DeepCopyInto(out *EtcdSnapshotsConfig) {
+	*out = *in
+	if in.SnapshotsS3 != nil {
+		in, out := &in.SnapshotsS3, &out.SnapshotsS3
+		*out = new(EtcdSnapshotsS3Config)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdSnapshotsConfig.
+func (in *EtcdSnapshotsConfig) DeepCopy() *EtcdSnapshotsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdSnapshotsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdSnapshotsS3Config) DeepCopyInto(out *EtcdSnapshotsS3Config) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdSnapshotsS3Config.
+func (in *EtcdSnapshotsS3Config) DeepCopy() *EtcdSnapshotsS3Config {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdSnapshotsS3Config)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExternalCloudProviderConfig) DeepCopyInto(out *ExternalCloudProviderConfig) {
 	*out = *in
@@ -1062,6 +1322,11 @@ func (in *FeaturesConfig) DeepCopyInto(out *FeaturesConfig) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ApidProxyTargetCheckConfig != nil {
+		in, out := &in.ApidProxyTargetCheckConfig, &out.ApidProxyTargetCheckConfig
+		*out = new(ApidProxyTargetCheckConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DiskQuotaSupport != nil {
 		in, out := &in.DiskQuotaSupport, &out.DiskQuotaSupport
 		*out = new(bool)
@@ -1077,6 +1342,11 @@ func (in *FeaturesConfig) DeepCopyInto(out *FeaturesConfig) {
 		*out = new(HostDNSConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DisableSecretsBundleExport != nil {
+		in, out := &in.DisableSecretsBundleExport, &out.DisableSecretsBundleExport
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -1129,6 +1399,16 @@ func (in *HostDNSConfig) DeepCopyInto(out *HostDNSConfig) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.HostDNSDisableNegativeCache != nil {
+		in, out := &in.HostDNSDisableNegativeCache, &out.HostDNSDisableNegativeCache
+		*out = new(bool)
+		**out = **in
+	}
+	if in.HostDNSAllowedClients != nil {
+		in, out := &in.HostDNSAllowedClients, &out.HostDNSAllowedClients
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1340,6 +1620,11 @@ func (in *KubePrism) DeepCopyInto(out *KubePrism) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ServerFailoverOnly != nil {
+		in, out := &in.ServerFailoverOnly, &out.ServerFailoverOnly
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -1361,6 +1646,11 @@ func (in *KubeSpanFilters) DeepCopyInto(out *KubeSpanFilters) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.KubeSpanFiltersPeerEndpoints != nil {
+		in, out := &in.KubeSpanFiltersPeerEndpoints, &out.KubeSpanFiltersPeerEndpoints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1374,6 +1664,48 @@ func (in *KubeSpanFilters) DeepCopy() *KubeSpanFilters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeSpanKeepalive) DeepCopyInto(out *KubeSpanKeepalive) {
+	*out = *in
+	if in.KeepaliveNATOnly != nil {
+		in, out := &in.KeepaliveNATOnly, &out.KeepaliveNATOnly
+		*out = new(bool)
+		**out = **in
+	}
+	if in.KeepaliveOverrides != nil {
+		in, out := &in.KeepaliveOverrides, &out.KeepaliveOverrides
+		*out = make([]KubeSpanKeepaliveOverride, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeSpanKeepalive.
+func (in *KubeSpanKeepalive) DeepCopy() *KubeSpanKeepalive {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeSpanKeepalive)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeSpanKeepaliveOverride) DeepCopyInto(out *KubeSpanKeepaliveOverride) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeSpanKeepaliveOverride.
+func (in *KubeSpanKeepaliveOverride) DeepCopy() *KubeSpanKeepaliveOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeSpanKeepaliveOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeletConfig) DeepCopyInto(out *KubeletConfig) {
 	*out = *in
@@ -1423,6 +1755,11 @@ func (in *KubeletConfig) DeepCopyInto(out *KubeletConfig) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.KubeletExtraConfigAllowUnknownFields != nil {
+		in, out := &in.KubeletExtraConfigAllowUnknownFields, &out.KubeletExtraConfigAllowUnknownFields
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -1457,6 +1794,27 @@ func (in *KubeletNodeIPConfig) DeepCopy() *KubeletNodeIPConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeletServingCertificateApproverConfig) DeepCopyInto(out *KubeletServingCertificateApproverConfig) {
+	*out = *in
+	if in.ApproverEnabled != nil {
+		in, out := &in.ApproverEnabled, &out.ApproverEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeletServingCertificateApproverConfig.
+func (in *KubeletServingCertificateApproverConfig) DeepCopy() *KubeletServingCertificateApproverConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeletServingCertificateApproverConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubernetesTalosAPIAccessConfig) DeepCopyInto(out *KubernetesTalosAPIAccessConfig) {
 	*out = *in
@@ -1541,6 +1899,11 @@ func (in *LoggingDestination) DeepCopyInto(out *LoggingDestination) {
 			(*out)[key] = val
 		}
 	}
+	if in.LoggingTLS != nil {
+		in, out := &in.LoggingTLS, &out.LoggingTLS
+		*out = new(LoggingTLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1554,6 +1917,31 @@ func (in *LoggingDestination) DeepCopy() *LoggingDestination {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingTLSConfig) DeepCopyInto(out *LoggingTLSConfig) {
+	*out = *in
+	if in.TLSClientIdentity != nil {
+		in, out := &in.TLSClientIdentity, &out.TLSClientIdentity
+		*out = (*in).DeepCopy()
+	}
+	if in.TLSCA != nil {
+		in, out := &in.TLSCA, &out.TLSCA
+		*out = make(Base64Bytes, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingTLSConfig.
+func (in *LoggingTLSConfig) DeepCopy() *LoggingTLSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingTLSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineConfig) DeepCopyInto(out *MachineConfig) {
 	*out = *in
@@ -1644,6 +2032,11 @@ func (in *MachineConfig) DeepCopyInto(out *MachineConfig) {
 			(*out)[key] = val
 		}
 	}
+	if in.MachineSysctlsAllowProtected != nil {
+		in, out := &in.MachineSysctlsAllowProtected, &out.MachineSysctlsAllowProtected
+		*out = new(bool)
+		**out = **in
+	}
 	if in.MachineSysfs != nil {
 		in, out := &in.MachineSysfs, &out.MachineSysfs
 		*out = make(map[string]string, len(*in))
@@ -1883,6 +2276,22 @@ func (in *NetworkConfig) DeepCopyInto(out *NetworkConfig) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.NetworkResolverOptions != nil {
+		in, out := &in.NetworkResolverOptions, &out.NetworkResolverOptions
+		*out = new(ResolverOptionsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkRouteRules != nil {
+		in, out := &in.NetworkRouteRules, &out.NetworkRouteRules
+		*out = make([]*RouteRule, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(RouteRule)
+				**out = **in
+			}
+		}
+	}
 	return
 }
 
@@ -1976,6 +2385,11 @@ func (in *NetworkKubeSpan) DeepCopyInto(out *NetworkKubeSpan) {
 		*out = new(KubeSpanFilters)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.KubeSpanKeepalive != nil {
+		in, out := &in.KubeSpanKeepalive, &out.KubeSpanKeepalive
+		*out = new(KubeSpanKeepalive)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2219,6 +2633,27 @@ func (in *RegistryTLSConfig) DeepCopy() *RegistryTLSConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResolverOptionsConfig) DeepCopyInto(out *ResolverOptionsConfig) {
+	*out = *in
+	if in.ResolverRotate != nil {
+		in, out := &in.ResolverRotate, &out.ResolverRotate
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolverOptionsConfig.
+func (in *ResolverOptionsConfig) DeepCopy() *ResolverOptionsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ResolverOptionsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourcesConfig) DeepCopyInto(out *ResourcesConfig) {
 	*out = *in
@@ -2253,6 +2688,22 @@ func (in *Route) DeepCopy() *Route {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteRule) DeepCopyInto(out *RouteRule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteRule.
+func (in *RouteRule) DeepCopy() *RouteRule {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *STP) DeepCopyInto(out *STP) {
 	*out = *in
@@ -2354,6 +2805,11 @@ func (in *TimeConfig) DeepCopyInto(out *TimeConfig) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.TimeNTS != nil {
+		in, out := &in.TimeNTS, &out.TimeNTS
+		*out = new(TimeNTSConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2367,6 +2823,48 @@ func (in *TimeConfig) DeepCopy() *TimeConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeNTSConfig) DeepCopyInto(out *TimeNTSConfig) {
+	*out = *in
+	if in.NTSServers != nil {
+		in, out := &in.NTSServers, &out.NTSServers
+		*out = make([]TimeNTSServer, len(*in))
+		copy(*out, *in)
+	}
+	if in.NTSAllowPlainFallback != nil {
+		in, out := &in.NTSAllowPlainFallback, &out.NTSAllowPlainFallback
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeNTSConfig.
+func (in *TimeNTSConfig) DeepCopy() *TimeNTSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeNTSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeNTSServer) DeepCopyInto(out *TimeNTSServer) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeNTSServer.
+func (in *TimeNTSServer) DeepCopy() *TimeNTSServer {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeNTSServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UdevConfig) DeepCopyInto(out *UdevConfig) {
 	*out = *in
@@ -0,0 +1,189 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+// Severity is the severity of the validation finding.
+type Severity int
+
+// Severity values.
+const (
+	// SeverityError blocks the config from being applied.
+	SeverityError Severity = iota
+	// SeverityWarning is reported, but doesn't block the config from being applied.
+	SeverityWarning
+)
+
+// String implements fmt.Stringer interface.
+func (severity Severity) String() string {
+	switch severity {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	default:
+		return fmt.Sprintf("Severity(%d)", int(severity))
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler interface.
+func (severity Severity) MarshalText() ([]byte, error) {
+	return []byte(severity.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler interface.
+func (severity *Severity) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "error":
+		*severity = SeverityError
+	case "warning":
+		*severity = SeverityWarning
+	default:
+		return fmt.Errorf("unknown severity %q", string(text))
+	}
+
+	return nil
+}
+
+// Finding describes a single problem found by the config validation.
+type Finding struct {
+	Severity Severity `json:"severity" yaml:"severity"`
+	// Kind is the kind of the document the finding was found in.
+	//
+	// Kind is empty if the finding spans multiple documents.
+	Kind string `json:"kind,omitempty" yaml:"kind,omitempty"`
+	// Name is the name of the named document.
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+	// Path is the YAML path to the field within the document, if known.
+	Path    string `json:"path,omitempty" yaml:"path,omitempty"`
+	Message string `json:"message" yaml:"message"`
+}
+
+// Document returns the document ID (kind and name) of the finding.
+func (finding Finding) Document() string {
+	if finding.Name == "" {
+		return finding.Kind
+	}
+
+	return finding.Kind + "/" + finding.Name
+}
+
+// String implements fmt.Stringer interface.
+func (finding Finding) String() string {
+	var sb strings.Builder
+
+	for _, part := range []string{finding.Document(), finding.Path} {
+		if part != "" {
+			sb.WriteString(part)
+			sb.WriteString(": ")
+		}
+	}
+
+	sb.WriteString(finding.Message)
+
+	return sb.String()
+}
+
+// Findings is a list of the validation findings.
+type Findings []Finding
+
+// NewFindings converts results of the Validate method of a document to findings.
+//
+// Multiple errors (multierror or errors.Join) are split into separate findings.
+func NewFindings(kind, name string, warnings []string, err error) Findings {
+	var findings Findings
+
+	for _, leaf := range leafErrors(err) {
+		findings = append(findings, Finding{
+			Severity: SeverityError,
+			Kind:     kind,
+			Name:     name,
+			Message:  leaf.Error(),
+		})
+	}
+
+	for _, warning := range warnings {
+		findings = append(findings, Finding{
+			Severity: SeverityWarning,
+			Kind:     kind,
+			Name:     name,
+			Message:  warning,
+		})
+	}
+
+	return findings
+}
+
+// Filter returns findings with the specified severity.
+func (findings Findings) Filter(severity Severity) Findings {
+	var result Findings
+
+	for _, finding := range findings {
+		if finding.Severity == severity {
+			result = append(result, finding)
+		}
+	}
+
+	return result
+}
+
+// HasErrors returns true if there are findings with the error severity.
+func (findings Findings) HasErrors() bool {
+	return len(findings.Filter(SeverityError)) > 0
+}
+
+// Warnings returns the warnings in the string form.
+func (findings Findings) Warnings() []string {
+	var warnings []string
+
+	for _, finding := range findings.Filter(SeverityWarning) {
+		warnings = append(warnings, finding.String())
+	}
+
+	return warnings
+}
+
+// Err returns the errors as a multierror, or nil if there are no errors.
+func (findings Findings) Err() error {
+	var multiErr *multierror.Error
+
+	for _, finding := range findings.Filter(SeverityError) {
+		multiErr = multierror.Append(multiErr, errors.New(finding.String()))
+	}
+
+	return multiErr.ErrorOrNil()
+}
+
+func leafErrors(err error) []error {
+	if err == nil {
+		return nil
+	}
+
+	var errs []error
+
+	switch e := err.(type) { //nolint:errorlint
+	case *multierror.Error:
+		errs = e.Errors
+	case interface{ Unwrap() []error }:
+		errs = e.Unwrap()
+	default:
+		return []error{err}
+	}
+
+	var leaves []error
+
+	for _, e := range errs {
+		leaves = append(leaves, leafErrors(e)...)
+	}
+
+	return leaves
+}
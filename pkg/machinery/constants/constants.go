@@ -87,6 +87,11 @@ const (
 	// KernelParamDashboardDisabled is the kernel parameter name for disabling the dashboard.
 	KernelParamDashboardDisabled = "talos.dashboard.disabled"
 
+	// KernelParamRecovery is the kernel parameter name for opening the credential recovery gate.
+	//
+	// The parameter is not preserved across upgrades, as it should be set only with physical access to the machine.
+	KernelParamRecovery = "talos.recovery"
+
 	// KernelParamEnvironment is the kernel parameter name for passing process environment.
 	KernelParamEnvironment = "talos.environment"
 
@@ -311,6 +316,18 @@ const (
 	// KubernetesAuditLogDir defines the ephemeral directory where the kube-apiserver will store its audit logs.
 	KubernetesAuditLogDir = EphemeralMountPoint + "/" + "log" + "/" + "audit" + "/" + "kube"
 
+	// KubernetesAuditLogDirSELinuxLabel defines SELinux label for the kube-apiserver audit log directory.
+	KubernetesAuditLogDirSELinuxLabel = "system_u:object_r:kube_log_t:s0"
+
+	// KubernetesAuditLogMaxAge defines the default number of days to retain the kube-apiserver audit log files.
+	KubernetesAuditLogMaxAge = 30
+
+	// KubernetesAuditLogMaxBackup defines the default number of the kube-apiserver audit log files to retain.
+	KubernetesAuditLogMaxBackup = 10
+
+	// KubernetesAuditLogMaxSize defines the default size in megabytes of the kube-apiserver audit log file before it gets rotated.
+	KubernetesAuditLogMaxSize = 100
+
 	// KubernetesAPIServerSecretsDir defines directory with kube-apiserver secrets.
 	KubernetesAPIServerSecretsDir = KubebernetesStaticSecretsDir + "/" + "kube-apiserver"
 
@@ -389,9 +406,33 @@ const (
 	// Should be less than KubeletShutdownGracePeriod.
 	KubeletShutdownGracePeriodCriticalPods = 10 * time.Second
 
+	// KubeletShutdownGracePeriodMax is the maximum kubelet shutdown grace period.
+	//
+	// Talos reports this value as the maximum inhibit delay to the kubelet.
+	KubeletShutdownGracePeriodMax = 40 * KubeletShutdownGracePeriod
+
+	// KubeletShutdownWaitMargin is the extra time given to the kubelet on top of the shutdown grace period
+	// to finish the graceful shutdown before Talos proceeds with the shutdown sequence.
+	KubeletShutdownWaitMargin = 30 * time.Second
+
+	// ShutdownInhibitorMaxHold is the maximum time a shutdown inhibitor can hold the reboot, shutdown or upgrade.
+	ShutdownInhibitorMaxHold = 5 * time.Minute
+
+	// ShutdownInhibitorsPerClient is the maximum number of shutdown inhibitors held by a single client.
+	ShutdownInhibitorsPerClient = 4
+
+	// ShutdownInhibitorsMax is the maximum number of shutdown inhibitors.
+	ShutdownInhibitorsMax = 32
+
 	// SeccompProfilesDirectory is the path to the directory where user provided seccomp profiles are mounted inside Kubelet.
 	SeccompProfilesDirectory = "/var/lib/kubelet/seccomp/profiles"
 
+	// SeccompDefaultProfileName is the name of the default Localhost seccomp profile in the SeccompProfilesDirectory.
+	SeccompDefaultProfileName = "talos-default.json"
+
+	// AppArmorEnabledPath is the path to the kernel parameter reporting whether AppArmor is enabled.
+	AppArmorEnabledPath = "/sys/module/apparmor/parameters/enabled"
+
 	// DefaultKubernetesVersion is the default target version of the control plane.
 	// renovate: datasource=github-releases depName=kubernetes/kubernetes
 	DefaultKubernetesVersion = "1.34.1"
@@ -399,6 +440,9 @@ const (
 	// SupportedKubernetesVersions is the number of Kubernetes versions supported by Talos starting from DefaultKubernesVersion going backwards.
 	SupportedKubernetesVersions = 6
 
+	// KubernetesUpgradeMinAvailableSpace is the minimum available space on the EPHEMERAL volume required to pull the images for Kubernetes upgrade.
+	KubernetesUpgradeMinAvailableSpace = 1024 * 1024 * 1024
+
 	// DefaultControlPlanePort is the default port to use for the control plane.
 	DefaultControlPlanePort = 6443
 
@@ -470,6 +514,24 @@ const (
 	// EtcdTalosServiceAccountCRDControllerMutex is the etcd mutex prefix used by Talos ServiceAccount crd controller.
 	EtcdTalosServiceAccountCRDControllerMutex = EtcdRootTalosKey + ":serviceAccountCRDController"
 
+	// EtcdTalosDefragmentationMutex is the etcd mutex prefix used to defragment etcd members one at a time.
+	EtcdTalosDefragmentationMutex = EtcdRootTalosKey + ":defragmentationMutex"
+
+	// EtcdLearnerMaxLagEntries is the default maximum number of raft entries the learner can lag behind to be promoted.
+	EtcdLearnerMaxLagEntries = 1000
+
+	// EtcdLearnerStuckTimeout is the default time after which a learner which is not promoted is reported as stuck.
+	EtcdLearnerStuckTimeout = 10 * time.Minute
+
+	// EtcdMaxLearners is the default maximum number of learners in the cluster at the same time.
+	EtcdMaxLearners = 1
+
+	// EtcdDefaultQuotaBackendBytes is the default etcd backend quota, used if the quota is not reported by etcd.
+	EtcdDefaultQuotaBackendBytes = 2 * 1024 * 1024 * 1024
+
+	// EtcdQuotaUsageThreshold is the database quota usage (in percent) above which etcd is reported as unhealthy.
+	EtcdQuotaUsageThreshold = 90
+
 	// EtcdImage is the reposistory for the etcd image.
 	EtcdImage = "gcr.io/etcd-development/etcd"
 
@@ -488,6 +550,15 @@ const (
 	// EtcdDataSELinuxLabel is the SELinux label for the etcd data directory.
 	EtcdDataSELinuxLabel = "system_u:object_r:etcd_data_t:s0"
 
+	// EtcdSnapshotsPath is the path where the scheduled etcd snapshots are stored.
+	EtcdSnapshotsPath = "/var/lib/etcd-snapshots"
+
+	// EtcdSnapshotRetention is the default number of the scheduled etcd snapshots kept on the node.
+	EtcdSnapshotRetention = 3
+
+	// EtcdSnapshotS3Region is the default region of the etcd snapshots object storage.
+	EtcdSnapshotS3Region = "us-east-1"
+
 	// EtcdRecoverySnapshotPath is the path where etcd snapshot is uploaded for recovery.
 	EtcdRecoverySnapshotPath = "/var/lib/etcd.snapshot"
 
@@ -547,6 +618,11 @@ const (
 	// TrustdPort is the port for the trustd service.
 	TrustdPort = 50001
 
+	// RecoveryPort is the port for the credential recovery service.
+	//
+	// The recovery service accepts only link-local connections.
+	RecoveryPort = 50005
+
 	// TrustdUserID is the user ID for trustd.
 	TrustdUserID = 51
 
@@ -568,6 +644,9 @@ const (
 	// CRIContainerdAddress is the path to the CRI containerd socket address.
 	CRIContainerdAddress = "/run/containerd/containerd.sock"
 
+	// SystemContainerdConfig is the path to the config for the system containerd instance.
+	SystemContainerdConfig = "/etc/containerd/config.toml"
+
 	// CRIContainerdConfig is the path to the config for the containerd instance that provides the CRI.
 	CRIContainerdConfig = "/etc/cri/containerd.toml"
 
@@ -586,6 +665,15 @@ const (
 	// CRICustomizationConfigPart is the path to the CRI generated registry configuration relative to /etc.
 	CRICustomizationConfigPart = "cri/conf.d/20-customization.part"
 
+	// CRISecurityProfilesConfigPart is the path to the CRI default security profiles configuration relative to /etc.
+	CRISecurityProfilesConfigPart = "cri/conf.d/10-security-profiles.part"
+
+	// CRIConfigPatchPart is the path to the CRI config patch from the machine configuration relative to /etc.
+	CRIConfigPatchPart = "cri/conf.d/30-config-patch.part"
+
+	// SystemContainerdConfigPatch is the path to the system containerd config patch from the machine configuration relative to /etc.
+	SystemContainerdConfigPatch = "containerd/config-patch.toml"
+
 	// CRIBaseRuntimeSpec is the path to the base runtime spec for the CRI.
 	CRIBaseRuntimeSpec = "cri/conf.d/base-spec.json"
 
@@ -640,6 +728,9 @@ const (
 	// InitramfsAssetPath is the path to the initramfs on disk.
 	InitramfsAssetPath = "/usr/install/%s/" + InitramfsAsset
 
+	// ExtensionsAssetPath is the path to the system extensions configuration (including image digests and signatures) in the installer.
+	ExtensionsAssetPath = "/usr/install/%s/extensions.yaml"
+
 	// RootfsAsset defines a well known name for our rootfs filename.
 	RootfsAsset = "rootfs.sqsh"
 
@@ -722,6 +813,9 @@ const (
 	// PCR 7 changes when UEFI SecureBoot mode is enabled/disabled, or firmware certificates (PK, KEK, db, dbx, …) are updated.
 	SecureBootStatePCR = 7
 
+	// DefaultTPMPCRBank is the default PCR bank used to seal the disk encryption keys.
+	DefaultTPMPCRBank = "sha256"
+
 	// DefaultCertificateValidityDuration is the default duration for a certificate.
 	DefaultCertificateValidityDuration = x509.DefaultCertificateValidityDuration
 
@@ -969,6 +1063,17 @@ const (
 	// AnnotationStaticPodConfigFileVersion is the annotation key for the static pod configuration file version.
 	AnnotationStaticPodConfigFileVersion = "talos.dev/config-file-version"
 
+	// AnnotationStaticPodCertSANsVersion is the annotation key for the kube-apiserver serving certificate SANs version.
+	AnnotationStaticPodCertSANsVersion = "talos.dev/cert-sans-version"
+
+	// AnnotationManifestPolicy is the annotation key for the synchronization policy of the bootstrap manifest object.
+	AnnotationManifestPolicy = "talos.dev/manifest-policy"
+
+	// AnnotationManifestChecksum is the annotation key for the checksum of the bootstrap manifest object.
+	//
+	// Objects with the checksum are updated in the cluster when the checksum changes.
+	AnnotationManifestChecksum = "talos.dev/manifest-checksum"
+
 	// AnnotationOwnedLabels is the annotation key for the list of node labels owned by Talos.
 	AnnotationOwnedLabels = "talos.dev/owned-labels"
 
@@ -1079,6 +1184,33 @@ const (
 	// LoggingFormatJSONLines represents "JSON lines" logging format.
 	LoggingFormatJSONLines = "json_lines"
 
+	// LoggingFormatSyslog represents RFC 5424 syslog logging format.
+	LoggingFormatSyslog = "syslog"
+
+	// KmsgLogDefaultBufferSize is the default size of the in-memory buffer of a kernel (or service) log destination.
+	KmsgLogDefaultBufferSize = 512 * 1024
+
+	// KmsgLogMinBufferSize is the minimum size of the in-memory buffer of a kernel log destination.
+	KmsgLogMinBufferSize = 16 * 1024
+
+	// KmsgLogMinSpoolSize is the minimum size of the on-disk spool of a kernel log destination.
+	KmsgLogMinSpoolSize = 64 * 1024
+
+	// KmsgLogSpoolPath is the directory to spool the kernel logs which can't be delivered, it is located on the EPHEMERAL partition.
+	KmsgLogSpoolPath = "/var/log/kmsg-spool"
+
+	// KmsgLogDropPolicyOldest drops the oldest buffered kernel logs when the buffer is full.
+	KmsgLogDropPolicyOldest = "oldest"
+
+	// KmsgLogDropPolicyNewest drops the incoming kernel logs when the buffer is full.
+	KmsgLogDropPolicyNewest = "newest"
+
+	// KmsgLogDefaultRetryMinBackoff is the default initial delay between the kernel (or service) log delivery attempts.
+	KmsgLogDefaultRetryMinBackoff = time.Second
+
+	// KmsgLogDefaultRetryMaxBackoff is the default maximum delay between the kernel (or service) log delivery attempts.
+	KmsgLogDefaultRetryMaxBackoff = 30 * time.Second
+
 	// SideroLinkName is the interface name for SideroLink.
 	SideroLinkName = "siderolink"
 
@@ -1091,6 +1223,60 @@ const (
 	// PlatformNetworkConfigFilename is the filename to cache platform network configuration reboots.
 	PlatformNetworkConfigFilename = "platform-network.yaml"
 
+	// DHCP4LeasesFilename is the filename to cache DHCPv4 leases across reboots.
+	DHCP4LeasesFilename = "dhcp4-leases.yaml"
+
+	// EventLogFilename is the filename of the event log persisted across reboots.
+	EventLogFilename = "events.log"
+
+	// EventLogDefaultMaxSize is the default maximum size of the persisted event log, including the rotated file.
+	EventLogDefaultMaxSize = 4 * 1024 * 1024
+
+	// EventLogMinSize is the minimum size of the persisted event log.
+	EventLogMinSize = 64 * 1024
+
+	// EventLogDefaultMinSeverity is the default minimum severity of the persisted events.
+	EventLogDefaultMinSeverity = "info"
+
+	// EventLogFlushInterval is the interval to batch the persisted events before flushing them to the disk.
+	EventLogFlushInterval = 30 * time.Second
+
+	// ResourceHistoryDefaultDepth is the default number of the resource revisions kept in memory per resource type.
+	ResourceHistoryDefaultDepth = 10
+
+	// ResourceHistoryMaxDepth is the maximum number of the resource revisions kept in memory per resource type.
+	ResourceHistoryMaxDepth = 100
+
+	// ZombieProcessesThreshold is the number of zombie processes on the node which triggers a warning.
+	ZombieProcessesThreshold = 1000
+
+	// TrustdMinCertificateLifetime is the minimum lifetime of the certificates signed by trustd.
+	TrustdMinCertificateLifetime = time.Hour
+
+	// CertificateSigningDecisionSigned is reported by trustd when the certificate is signed.
+	CertificateSigningDecisionSigned = "signed"
+
+	// CertificateSigningDecisionDenied is reported by trustd when the certificate request is refused by the signing policy.
+	CertificateSigningDecisionDenied = "denied"
+
+	// CertificateSigningDecisionRateLimited is reported by trustd when the certificate request exceeds the rate limit.
+	CertificateSigningDecisionRateLimited = "rate-limited"
+
+	// DHCPFallbackDefaultProbeTimeout is the default time to wait for the static gateway to respond before falling back to DHCP.
+	DHCPFallbackDefaultProbeTimeout = 10 * time.Second
+
+	// DHCPFallbackDefaultProbeInterval is the default interval to re-probe the static gateway.
+	DHCPFallbackDefaultProbeInterval = time.Minute
+
+	// NetworkProbeDefaultInterval is the default interval between the network connectivity probes.
+	NetworkProbeDefaultInterval = 10 * time.Second
+
+	// NetworkProbeDefaultTimeout is the default timeout of a single network connectivity probe.
+	NetworkProbeDefaultTimeout = 5 * time.Second
+
+	// NetworkProbeDefaultFailureThreshold is the default number of consecutive failures for the network connectivity probe to be considered failed.
+	NetworkProbeDefaultFailureThreshold = 3
+
 	// ExtensionServiceConfigPath is the directory path which contains  configuration files of extension services.
 	//
 	// See pkg/machinery/extensions/services for the file format.
@@ -1174,6 +1360,9 @@ const (
 	// APIAuthzRoleMetadataKey is the gRPC metadata key used to submit a role with os:impersonator.
 	APIAuthzRoleMetadataKey = "talos-role"
 
+	// APIAuthzPeerMetadataKey is the gRPC metadata key used to submit the authenticated client identity with os:impersonator.
+	APIAuthzPeerMetadataKey = "talos-peer"
+
 	// KernelLogsTTY is the number of the TTY device (/dev/ttyN) to redirect Kernel logs to.
 	KernelLogsTTY = 1
 
@@ -1216,6 +1405,12 @@ const (
 	// KubePrismHealthCheckTimeout is the timeout for health checks for the KubePrism loadbalancer.
 	KubePrismHealthCheckTimeout = 15 * time.Second
 
+	// KubePrismFailoverProbeTimeout is the timeout for the TCP probes of the endpoints in the KubePrism failover only mode.
+	KubePrismFailoverProbeTimeout = 3 * time.Second
+
+	// KubePrismFailoverProbeInterval is the interval between the TCP probes of the endpoints in the KubePrism failover only mode.
+	KubePrismFailoverProbeInterval = 5 * time.Second
+
 	// TalosAPIDefaultCertificateValidityDuration specifies default certificate duration for Talos API generated client certificates.
 	TalosAPIDefaultCertificateValidityDuration = time.Hour * 24 * 365
 
@@ -1239,6 +1434,15 @@ const (
 	// Note: 116 = 't' and 108 = 'l' in ASCII.
 	HostDNSAddress = "169.254.116.108"
 
+	// HostDNSDefaultCacheSize is the default maximum number of entries in the host DNS cache.
+	HostDNSDefaultCacheSize = 10000
+
+	// HostDNSDefaultCacheMaxTTL is the default maximum TTL of the host DNS cache entries.
+	HostDNSDefaultCacheMaxTTL = time.Hour
+
+	// HostDNSDefaultNegativeCacheTTL is the default maximum TTL of the host DNS negative cache entries.
+	HostDNSDefaultNegativeCacheTTL = 10 * time.Second
+
 	// MetalAgentModeFlagPath is the path to the file indicating if the node is running in Metal Agent mode.
 	MetalAgentModeFlagPath = "/usr/local/etc/is-metal-agent"
 
@@ -1289,6 +1493,9 @@ const (
 	// DiskEncryptionSaltSize is the size of the disk encryption salt in bytes.
 	DiskEncryptionSaltSize = 32
 
+	// EncryptionKeyRotationSlot is the LUKS2 key slot which temporarily holds the new key during the disk encryption key rotation.
+	EncryptionKeyRotationSlot = 31
+
 	// SideroV1KeysDirEnvVar is the environment variable that points to the directory containing user PGP keys for SideroV1 auth.
 	SideroV1KeysDirEnvVar = "SIDEROV1_KEYS_DIR"
 
@@ -1353,6 +1560,9 @@ var ValidEffects = []string{
 	"NoExecute",
 }
 
+// DefaultCertificateExpiryThresholds is the default set of thresholds (in days before the expiry) to report certificate expiry events.
+var DefaultCertificateExpiryThresholds = []int{30, 7, 1}
+
 // OSReleaseTemplate is the template for /etc/os-release.
 const OSReleaseTemplate = `NAME="%[1]s"
 ID=%[2]s
@@ -0,0 +1,160 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package cpuset provides helpers to work with Linux CPU lists and masks.
+package cpuset
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Set is a set of CPU IDs sorted in ascending order.
+type Set []int
+
+// Parse parses a CPU list in the Linux kernel format (e.g. `0-3,8,10-11`).
+//
+// Empty list is parsed as an empty set.
+func Parse(s string) (Set, error) {
+	s = strings.TrimSpace(s)
+
+	if s == "" {
+		return nil, nil
+	}
+
+	var result Set
+
+	for part := range strings.SplitSeq(s, ",") {
+		part = strings.TrimSpace(part)
+
+		lowStr, highStr, isRange := strings.Cut(part, "-")
+
+		low, err := parseCPU(lowStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CPU list %q: %w", s, err)
+		}
+
+		high := low
+
+		if isRange {
+			high, err = parseCPU(highStr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid CPU list %q: %w", s, err)
+			}
+
+			if high < low {
+				return nil, fmt.Errorf("invalid CPU list %q: range %q is reversed", s, part)
+			}
+		}
+
+		for cpu := low; cpu <= high; cpu++ {
+			result = append(result, cpu)
+		}
+	}
+
+	slices.Sort(result)
+
+	return slices.Compact(result), nil
+}
+
+func parseCPU(s string) (int, error) {
+	cpu, err := strconv.ParseUint(strings.TrimSpace(s), 10, 16)
+	if err != nil {
+		return 0, fmt.Errorf("invalid CPU ID %q", s)
+	}
+
+	return int(cpu), nil
+}
+
+// Range returns a set of CPUs [0, n).
+func Range(n int) Set {
+	result := make(Set, 0, n)
+
+	for cpu := range n {
+		result = append(result, cpu)
+	}
+
+	return result
+}
+
+// Max returns the highest CPU ID in the set, or -1 if the set is empty.
+func (s Set) Max() int {
+	if len(s) == 0 {
+		return -1
+	}
+
+	return s[len(s)-1]
+}
+
+// Contains checks if the CPU is in the set.
+func (s Set) Contains(cpu int) bool {
+	_, found := slices.BinarySearch(s, cpu)
+
+	return found
+}
+
+// Difference returns CPUs in the set which are not in the other set.
+func (s Set) Difference(other Set) Set {
+	var result Set
+
+	for _, cpu := range s {
+		if !other.Contains(cpu) {
+			result = append(result, cpu)
+		}
+	}
+
+	return result
+}
+
+// String returns the set in the Linux kernel CPU list format.
+func (s Set) String() string {
+	var sb strings.Builder
+
+	for i := 0; i < len(s); {
+		j := i
+
+		for j+1 < len(s) && s[j+1] == s[j]+1 {
+			j++
+		}
+
+		if sb.Len() > 0 {
+			sb.WriteByte(',')
+		}
+
+		if i == j {
+			sb.WriteString(strconv.Itoa(s[i]))
+		} else {
+			fmt.Fprintf(&sb, "%d-%d", s[i], s[j])
+		}
+
+		i = j + 1
+	}
+
+	return sb.String()
+}
+
+// Mask returns the set as a hex CPU mask in the format of /proc/irq/*/smp_affinity.
+//
+// The mask is split into comma-separated 32-bit words, most significant word first.
+func (s Set) Mask() string {
+	words := make([]uint32, s.Max()/32+1)
+
+	for _, cpu := range s {
+		words[cpu/32] |= 1 << (cpu % 32)
+	}
+
+	var sb strings.Builder
+
+	for i := len(words) - 1; i >= 0; i-- {
+		if i == len(words)-1 {
+			fmt.Fprintf(&sb, "%x", words[i])
+		} else {
+			fmt.Fprintf(&sb, ",%08x", words[i])
+		}
+	}
+
+	return sb.String()
+}
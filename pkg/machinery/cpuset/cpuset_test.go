@@ -0,0 +1,95 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cpuset_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/cpuset"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		in string
+
+		expected       cpuset.Set
+		expectedString string
+		expectedError  string
+	}{
+		{
+			in: "",
+		},
+		{
+			in: "\n",
+		},
+		{
+			in:             "0",
+			expected:       cpuset.Set{0},
+			expectedString: "0",
+		},
+		{
+			in:             "2-5,8, 10-11",
+			expected:       cpuset.Set{2, 3, 4, 5, 8, 10, 11},
+			expectedString: "2-5,8,10-11",
+		},
+		{
+			in:             "7,3-4,4,5,6",
+			expected:       cpuset.Set{3, 4, 5, 6, 7},
+			expectedString: "3-7",
+		},
+		{
+			in:            "5-2",
+			expectedError: "invalid CPU list \"5-2\": range \"5-2\" is reversed",
+		},
+		{
+			in:            "1,a",
+			expectedError: "invalid CPU list \"1,a\": invalid CPU ID \"a\"",
+		},
+		{
+			in:            "-1",
+			expectedError: "invalid CPU list \"-1\": invalid CPU ID \"\"",
+		},
+	} {
+		t.Run(test.in, func(t *testing.T) {
+			t.Parallel()
+
+			set, err := cpuset.Parse(test.in)
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, set)
+			assert.Equal(t, test.expectedString, set.String())
+		})
+	}
+}
+
+func TestMask(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "0", cpuset.Set(nil).Mask())
+	assert.Equal(t, "f", cpuset.Range(4).Mask())
+	assert.Equal(t, "c3", cpuset.Set{0, 1, 6, 7}.Mask())
+	assert.Equal(t, "1,00000001", cpuset.Set{0, 32}.Mask())
+	assert.Equal(t, "80000000,00000000,00000003", cpuset.Set{0, 1, 95}.Mask())
+}
+
+func TestDifference(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, cpuset.Set{0, 1, 6, 7}, cpuset.Range(8).Difference(cpuset.Set{2, 3, 4, 5}))
+	assert.Equal(t, cpuset.Set(nil), cpuset.Set{2, 3}.Difference(cpuset.Range(8)))
+	assert.True(t, cpuset.Set{1, 3, 5}.Contains(3))
+	assert.False(t, cpuset.Set{1, 3, 5}.Contains(4))
+	assert.Equal(t, 5, cpuset.Set{1, 3, 5}.Max())
+}
@@ -9,5 +9,23 @@ package extensions
 // DeepCopy generates a deep copy of Layer.
 func (o Layer) DeepCopy() Layer {
 	var cp Layer = o
+	if o.Source != nil {
+		cp.Source = new(Source)
+		*cp.Source = *o.Source
+		if o.Source.Signatures != nil {
+			cp.Source.Signatures = make([]Signature, len(o.Source.Signatures))
+			copy(cp.Source.Signatures, o.Source.Signatures)
+			for i3 := range o.Source.Signatures {
+				if o.Source.Signatures[i3].Payload != nil {
+					cp.Source.Signatures[i3].Payload = make([]byte, len(o.Source.Signatures[i3].Payload))
+					copy(cp.Source.Signatures[i3].Payload, o.Source.Signatures[i3].Payload)
+				}
+				if o.Source.Signatures[i3].Signature != nil {
+					cp.Source.Signatures[i3].Signature = make([]byte, len(o.Source.Signatures[i3].Signature))
+					copy(cp.Source.Signatures[i3].Signature, o.Source.Signatures[i3].Signature)
+				}
+			}
+		}
+	}
 	return cp
 }
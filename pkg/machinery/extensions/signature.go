@@ -0,0 +1,146 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package extensions
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+)
+
+// SignaturePayloadType is the type of the cosign-style signature payload (simple signing format).
+const SignaturePayloadType = "cosign container image signature"
+
+// Source describes the container image the extension was installed from.
+//
+//gotagsrewrite:gen
+type Source struct {
+	// ImageRef is the image reference as requested.
+	ImageRef string `yaml:"imageRef" protobuf:"1"`
+	// Digest is the resolved digest of the image manifest (or index).
+	Digest string `yaml:"digest" protobuf:"2"`
+	// Signatures are the cosign-style signatures attached to the image digest.
+	Signatures []Signature `yaml:"signatures,omitempty" protobuf:"3"`
+}
+
+// Signature is a cosign-style signature of the image manifest digest.
+//
+//gotagsrewrite:gen
+type Signature struct {
+	// Payload is the signed simple signing payload.
+	Payload []byte `yaml:"payload" protobuf:"1"`
+	// Signature is the raw signature of the payload.
+	Signature []byte `yaml:"signature" protobuf:"2"`
+}
+
+// TrustedKey is a public key trusted to sign the extension images.
+type TrustedKey struct {
+	Name      string
+	PublicKey crypto.PublicKey
+}
+
+// ParsePublicKey parses a PEM-encoded public key (ECDSA, RSA or Ed25519).
+func ParsePublicKey(data []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM data found")
+	}
+
+	if block.Type != "PUBLIC KEY" {
+		return nil, fmt.Errorf("unexpected PEM block type %q", block.Type)
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	switch key.(type) {
+	case *ecdsa.PublicKey, *rsa.PublicKey, ed25519.PublicKey:
+		return key, nil
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T", key)
+	}
+}
+
+// VerifySignature checks that the layer image digest is signed by one of the trusted keys.
+//
+// The name of the key which signed the image is returned.
+func (layer *Layer) VerifySignature(keys []TrustedKey) (string, error) {
+	if layer.Source == nil || layer.Source.Digest == "" {
+		return "", errors.New("image digest is not recorded")
+	}
+
+	if len(layer.Source.Signatures) == 0 {
+		return "", fmt.Errorf("image %s is not signed", layer.Source.Digest)
+	}
+
+	var errs []error
+
+	for _, signature := range layer.Source.Signatures {
+		if err := signature.verifyPayload(layer.Source.Digest); err != nil {
+			errs = append(errs, err)
+
+			continue
+		}
+
+		for _, key := range keys {
+			if signature.verify(key.PublicKey) {
+				return key.Name, nil
+			}
+		}
+
+		errs = append(errs, errors.New("signature doesn't match any trusted key"))
+	}
+
+	return "", fmt.Errorf("image %s is not signed by a trusted key: %w", layer.Source.Digest, errors.Join(errs...))
+}
+
+func (signature *Signature) verifyPayload(digest string) error {
+	var payload struct {
+		Critical struct {
+			Image struct {
+				DockerManifestDigest string `json:"docker-manifest-digest"`
+			} `json:"image"`
+			Type string `json:"type"`
+		} `json:"critical"`
+	}
+
+	if err := json.Unmarshal(signature.Payload, &payload); err != nil {
+		return fmt.Errorf("error decoding signature payload: %w", err)
+	}
+
+	if payload.Critical.Type != SignaturePayloadType {
+		return fmt.Errorf("unexpected signature payload type %q", payload.Critical.Type)
+	}
+
+	if payload.Critical.Image.DockerManifestDigest != digest {
+		return fmt.Errorf("signature is for a different digest %s", payload.Critical.Image.DockerManifestDigest)
+	}
+
+	return nil
+}
+
+func (signature *Signature) verify(key crypto.PublicKey) bool {
+	hash := sha256.Sum256(signature.Payload)
+
+	switch key := key.(type) {
+	case *ecdsa.PublicKey:
+		return ecdsa.VerifyASN1(key, hash[:], signature.Signature)
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(key, crypto.SHA256, hash[:], signature.Signature) == nil
+	case ed25519.PublicKey:
+		return ed25519.Verify(key, signature.Payload, signature.Signature)
+	default:
+		return false
+	}
+}
@@ -0,0 +1,171 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package extensions_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/extensions"
+)
+
+const testDigest = "sha256:6b9f5dbd48a7e8b8e6f7a1a0d2f6f5b3c8c3e8e4c1e1a4a6f8f1f2a3b4c5d6e7"
+
+func signaturePayload(digest string) []byte {
+	return fmt.Appendf(nil,
+		`{"critical":{"identity":{"docker-reference":"ghcr.io/siderolabs/gvisor"},"image":{"docker-manifest-digest":%q},"type":%q},"optional":null}`,
+		digest, extensions.SignaturePayloadType,
+	)
+}
+
+func parseKey(t *testing.T, pub crypto.PublicKey) crypto.PublicKey {
+	t.Helper()
+
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	require.NoError(t, err)
+
+	key, err := extensions.ParsePublicKey(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+	require.NoError(t, err)
+
+	return key
+}
+
+func TestVerifySignature(t *testing.T) {
+	t.Parallel()
+
+	payload := signaturePayload(testDigest)
+	hash := sha256.Sum256(payload)
+
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	ecdsaSignature, err := ecdsa.SignASN1(rand.Reader, ecdsaKey, hash[:])
+	require.NoError(t, err)
+
+	ed25519Public, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	rsaSignature, err := rsa.SignPKCS1v15(rand.Reader, rsaKey, crypto.SHA256, hash[:])
+	require.NoError(t, err)
+
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	trustedKeys := []extensions.TrustedKey{
+		{Name: "other", PublicKey: parseKey(t, &otherKey.PublicKey)},
+		{Name: "ecdsa", PublicKey: parseKey(t, &ecdsaKey.PublicKey)},
+		{Name: "ed25519", PublicKey: parseKey(t, ed25519Public)},
+		{Name: "rsa", PublicKey: parseKey(t, &rsaKey.PublicKey)},
+	}
+
+	for _, test := range []struct {
+		name   string
+		source *extensions.Source
+
+		expectedSigner string
+		expectedError  string
+	}{
+		{
+			name: "no source",
+
+			expectedError: "image digest is not recorded",
+		},
+		{
+			name: "unsigned",
+			source: &extensions.Source{
+				Digest: testDigest,
+			},
+
+			expectedError: "image " + testDigest + " is not signed",
+		},
+		{
+			name: "ecdsa",
+			source: &extensions.Source{
+				Digest:     testDigest,
+				Signatures: []extensions.Signature{{Payload: payload, Signature: ecdsaSignature}},
+			},
+
+			expectedSigner: "ecdsa",
+		},
+		{
+			name: "ed25519",
+			source: &extensions.Source{
+				Digest:     testDigest,
+				Signatures: []extensions.Signature{{Payload: payload, Signature: ed25519.Sign(ed25519Key, payload)}},
+			},
+
+			expectedSigner: "ed25519",
+		},
+		{
+			name: "rsa",
+			source: &extensions.Source{
+				Digest:     testDigest,
+				Signatures: []extensions.Signature{{Payload: payload, Signature: rsaSignature}},
+			},
+
+			expectedSigner: "rsa",
+		},
+		{
+			name: "untrusted signature",
+			source: &extensions.Source{
+				Digest:     testDigest,
+				Signatures: []extensions.Signature{{Payload: payload, Signature: []byte("invalid")}},
+			},
+
+			expectedError: "image " + testDigest + " is not signed by a trusted key: signature doesn't match any trusted key",
+		},
+		{
+			name: "digest mismatch",
+			source: &extensions.Source{
+				Digest:     "sha256:0000",
+				Signatures: []extensions.Signature{{Payload: payload, Signature: ecdsaSignature}},
+			},
+
+			expectedError: "image sha256:0000 is not signed by a trusted key: signature is for a different digest " + testDigest,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			layer := &extensions.Layer{Source: test.source}
+
+			signer, err := layer.VerifySignature(trustedKeys)
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedSigner, signer)
+		})
+	}
+}
+
+func TestParsePublicKey(t *testing.T) {
+	t.Parallel()
+
+	_, err := extensions.ParsePublicKey([]byte("foo"))
+	assert.EqualError(t, err, "no PEM data found")
+
+	_, err = extensions.ParsePublicKey(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("foo")}))
+	assert.EqualError(t, err, `unexpected PEM block type "CERTIFICATE"`)
+}
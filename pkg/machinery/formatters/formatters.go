@@ -279,3 +279,114 @@ func (svc ServiceInfoWrapper) HealthStatus() string {
 
 	return "Fail"
 }
+
+// ServiceBlocker is an unsatisfied condition in the chain of conditions the service is blocked on.
+type ServiceBlocker struct {
+	// Service which waits for the condition.
+	Service   string                    `json:"service"`
+	Condition *machine.ServiceCondition `json:"condition"`
+	// Depth of the condition in the chain, the conditions of the service itself have depth 0.
+	Depth int `json:"depth"`
+}
+
+// ServiceBlockers returns the chain of unsatisfied conditions the service is blocked on.
+//
+// Unsatisfied dependencies on other services are followed recursively.
+func ServiceBlockers(services []*machine.ServiceDependencyInfo, id string) []ServiceBlocker {
+	byID := make(map[string]*machine.ServiceDependencyInfo, len(services))
+
+	for _, svc := range services {
+		byID[svc.Id] = svc
+	}
+
+	var (
+		blockers []ServiceBlocker
+		walk     func(id string, depth int)
+	)
+
+	visited := map[string]struct{}{}
+
+	walk = func(id string, depth int) {
+		svc, ok := byID[id]
+		if !ok {
+			return
+		}
+
+		if _, ok = visited[id]; ok {
+			return
+		}
+
+		visited[id] = struct{}{}
+
+		for _, cond := range svc.Conditions {
+			if cond.Satisfied {
+				continue
+			}
+
+			blockers = append(blockers, ServiceBlocker{
+				Service:   id,
+				Condition: cond,
+				Depth:     depth,
+			})
+
+			if cond.Kind == "service" {
+				walk(cond.Target, depth+1)
+			}
+		}
+	}
+
+	walk(id, 0)
+
+	return blockers
+}
+
+// RenderServiceBlockers renders the chain of conditions the service is blocked on.
+func RenderServiceBlockers(resp *machine.ServiceDependenciesResponse, id string, output io.Writer, defaultNode string) error {
+	w := tabwriter.NewWriter(output, 0, 0, 3, ' ', 0)
+
+	for _, msg := range resp.Messages {
+		node := defaultNode
+
+		if msg.Metadata != nil {
+			node = msg.Metadata.Hostname
+		}
+
+		idx := slices.IndexFunc(msg.Services, func(svc *machine.ServiceDependencyInfo) bool { return svc.Id == id })
+		if idx == -1 {
+			continue
+		}
+
+		svc := msg.Services[idx]
+
+		fmt.Fprintf(w, "NODE\t%s\n", node)
+		fmt.Fprintf(w, "ID\t%s\n", svc.Id)
+		fmt.Fprintf(w, "STATE\t%s\n", svc.State)
+
+		if len(svc.DependsOn) > 0 {
+			fmt.Fprintf(w, "DEPENDS ON\t%s\n", strings.Join(svc.DependsOn, ", "))
+		}
+
+		blockers := ServiceBlockers(msg.Services, id)
+
+		if len(blockers) == 0 {
+			fmt.Fprintf(w, "BLOCKED ON\t<none>\n")
+
+			continue
+		}
+
+		label := "BLOCKED ON"
+
+		for _, blocker := range blockers {
+			description := blocker.Condition.Description
+
+			if blocker.Depth > 0 {
+				description = fmt.Sprintf("%s%s (%s)", strings.Repeat("  ", blocker.Depth), description, blocker.Service)
+			}
+
+			fmt.Fprintf(w, "%s\t%s\n", label, description)
+			label = ""
+		}
+	}
+
+	return w.Flush()
+}
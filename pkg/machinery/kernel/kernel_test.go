@@ -143,3 +143,39 @@ func TestSecureBootArgs(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateSysctlKey(t *testing.T) {
+	t.Parallel()
+
+	for _, key := range []string{
+		"kernel.domainname",
+		"net.ipv4.ip_forward",
+		"net/ipv6/conf/eth0.100/disable_ipv6",
+		"net.ipv6.conf.eth0/100.disable_ipv6",
+		"fs.inotify.max_user_instances",
+		"net.bridge.bridge-nf-call-iptables",
+	} {
+		assert.NoError(t, kernel.ValidateSysctlKey(key), key)
+	}
+
+	for _, key := range []string{
+		"",
+		"kernel",
+		"kernal.domainname",
+		"net.ipv4.ip_forward ",
+		"net.ipv4.ip_forward.",
+		"net/../../sys/kernel/mm/foo",
+		"net.ipv6.conf.eth0/103.//.//.//.//.kernel.foo",
+	} {
+		assert.Error(t, kernel.ValidateSysctlKey(key), key)
+	}
+}
+
+func TestCheckProtectedSysctl(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, kernel.CheckProtectedSysctl("net.ipv4.ip_forward", "1"))
+	assert.NoError(t, kernel.CheckProtectedSysctl("kernel.domainname", "0"))
+	assert.EqualError(t, kernel.CheckProtectedSysctl("net/ipv4/ip_forward", "0"),
+		`sysctl "net/ipv4/ip_forward" can't be set to "0": IPv4 forwarding is required for Kubernetes networking`)
+}
@@ -0,0 +1,85 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kernel
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// sysctlKeyRe is the allowlist pattern for the sysctl keys.
+//
+// The key should start with one of the well-known top-level sysctl directories,
+// and contain only characters which might appear in the /proc/sys paths (including interface names).
+var sysctlKeyRe = regexp.MustCompile(`^(abi|crypto|debug|dev|fs|kernel|net|sunrpc|user|vm)[./][A-Za-z0-9_\-+.:@/]*[A-Za-z0-9_\-]$`)
+
+// ProtectedSysctl describes a sysctl value which is known to break Talos.
+type ProtectedSysctl struct {
+	Key    string
+	Value  string
+	Reason string
+}
+
+// ProtectedSysctls is a list of sysctl values which can't be set without an explicit override.
+var ProtectedSysctls = []ProtectedSysctl{
+	{
+		Key:    "net.ipv4.ip_forward",
+		Value:  "0",
+		Reason: "IPv4 forwarding is required for Kubernetes networking",
+	},
+	{
+		Key:    "net.ipv6.conf.default.forwarding",
+		Value:  "0",
+		Reason: "IPv6 forwarding is required for Kubernetes networking",
+	},
+	{
+		Key:    "net.bridge.bridge-nf-call-iptables",
+		Value:  "0",
+		Reason: "bridged traffic should be processed by iptables for Kubernetes Services",
+	},
+	{
+		Key:    "net.bridge.bridge-nf-call-ip6tables",
+		Value:  "0",
+		Reason: "bridged traffic should be processed by ip6tables for Kubernetes Services",
+	},
+	{
+		Key:    "kernel.modules_disabled",
+		Value:  "1",
+		Reason: "Talos requires loading kernel modules at runtime",
+	},
+}
+
+// ValidateSysctlKey checks that the sysctl key matches the allowlist pattern.
+func ValidateSysctlKey(key string) error {
+	matches := sysctlKeyRe.FindStringSubmatch(key)
+	if matches == nil {
+		return fmt.Errorf("invalid sysctl key %q", key)
+	}
+
+	// the key should not escape the top-level directory via path traversal
+	if !strings.HasPrefix((&Param{Key: Sysctl + "." + key}).Path(), "/proc/sys/"+matches[1]+"/") {
+		return fmt.Errorf("invalid sysctl key %q", key)
+	}
+
+	return nil
+}
+
+// CheckProtectedSysctl returns an error if the sysctl value is known to break Talos.
+func CheckProtectedSysctl(key, value string) error {
+	path := (&Param{Key: Sysctl + "." + key}).Path()
+
+	for _, protected := range ProtectedSysctls {
+		if (&Param{Key: Sysctl + "." + protected.Key}).Path() != path {
+			continue
+		}
+
+		if strings.TrimSpace(value) == protected.Value {
+			return fmt.Errorf("sysctl %q can't be set to %q: %s", key, value, protected.Reason)
+		}
+	}
+
+	return nil
+}
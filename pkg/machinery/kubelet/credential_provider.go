@@ -0,0 +1,147 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kubelet
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+// CredentialProviderConfigAPIVersions is a list of supported kubelet CredentialProviderConfig API versions.
+var CredentialProviderConfigAPIVersions = []string{
+	"kubelet.config.k8s.io/v1",
+	"kubelet.config.k8s.io/v1beta1",
+	"kubelet.config.k8s.io/v1alpha1",
+}
+
+// CredentialProviderAPIVersions is a list of supported credential provider plugin API versions.
+var CredentialProviderAPIVersions = []string{
+	"credentialprovider.kubelet.k8s.io/v1",
+	"credentialprovider.kubelet.k8s.io/v1beta1",
+	"credentialprovider.kubelet.k8s.io/v1alpha1",
+}
+
+// CredentialProviderNames returns the names of the providers (binaries) referenced in the CredentialProviderConfig.
+func CredentialProviderNames(config map[string]any) []string {
+	providers, _ := config["providers"].([]any)
+
+	var names []string
+
+	for _, provider := range providers {
+		p, ok := provider.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		if name, ok := p["name"].(string); ok && name != "" {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+// ValidateCredentialProviderConfig validates the kubelet CredentialProviderConfig.
+//
+//nolint:gocyclo,cyclop
+func ValidateCredentialProviderConfig(path string, config map[string]any) error {
+	var result *multierror.Error
+
+	if apiVersion, _ := config["apiVersion"].(string); !slices.Contains(CredentialProviderConfigAPIVersions, apiVersion) {
+		result = multierror.Append(result, fmt.Errorf("%s.apiVersion: unsupported value %q, expected one of %q", path, apiVersion, CredentialProviderConfigAPIVersions))
+	}
+
+	if kind, _ := config["kind"].(string); kind != "CredentialProviderConfig" {
+		result = multierror.Append(result, fmt.Errorf("%s.kind: expected %q, got %q", path, "CredentialProviderConfig", kind))
+	}
+
+	providers, ok := config["providers"].([]any)
+	if !ok || len(providers) == 0 {
+		result = multierror.Append(result, fmt.Errorf("%s.providers: at least one provider is required", path))
+
+		return result.ErrorOrNil()
+	}
+
+	seen := map[string]struct{}{}
+
+	for i, provider := range providers {
+		providerPath := fmt.Sprintf("%s.providers[%d]", path, i)
+
+		p, ok := provider.(map[string]any)
+		if !ok {
+			result = multierror.Append(result, fmt.Errorf("%s: expected object, got %T", providerPath, provider))
+
+			continue
+		}
+
+		name, _ := p["name"].(string)
+
+		switch {
+		case name == "":
+			result = multierror.Append(result, fmt.Errorf("%s.name: provider name is required", providerPath))
+		case name == "." || name == ".." || strings.ContainsAny(name, `/\`):
+			result = multierror.Append(result, fmt.Errorf("%s.name: provider name %q should be a binary name, not a path", providerPath, name))
+		default:
+			if _, duplicate := seen[name]; duplicate {
+				result = multierror.Append(result, fmt.Errorf("%s.name: duplicate provider %q", providerPath, name))
+			}
+
+			seen[name] = struct{}{}
+		}
+
+		if apiVersion, _ := p["apiVersion"].(string); !slices.Contains(CredentialProviderAPIVersions, apiVersion) {
+			result = multierror.Append(result, fmt.Errorf("%s.apiVersion: unsupported value %q, expected one of %q", providerPath, apiVersion, CredentialProviderAPIVersions))
+		}
+
+		if matchImages, ok := p["matchImages"].([]any); !ok || len(matchImages) == 0 {
+			result = multierror.Append(result, fmt.Errorf("%s.matchImages: at least one image pattern is required", providerPath))
+		} else if err := checkFieldKind(providerPath+".matchImages", kindStringList, matchImages); err != nil {
+			result = multierror.Append(result, err)
+		}
+
+		if p["defaultCacheDuration"] == nil {
+			result = multierror.Append(result, fmt.Errorf("%s.defaultCacheDuration: default cache duration is required", providerPath))
+		} else if err := checkFieldKind(providerPath+".defaultCacheDuration", kindDuration, p["defaultCacheDuration"]); err != nil {
+			result = multierror.Append(result, err)
+		}
+
+		if err := checkFieldKind(providerPath+".args", kindStringList, p["args"]); err != nil {
+			result = multierror.Append(result, err)
+		}
+
+		if p["env"] != nil {
+			env, ok := p["env"].([]any)
+			if !ok {
+				result = multierror.Append(result, fmt.Errorf("%s.env: expected list, got %T", providerPath, p["env"]))
+
+				continue
+			}
+
+			for j, item := range env {
+				envPath := fmt.Sprintf("%s.env[%d]", providerPath, j)
+
+				e, ok := item.(map[string]any)
+				if !ok {
+					result = multierror.Append(result, fmt.Errorf("%s: expected object, got %T", envPath, item))
+
+					continue
+				}
+
+				if envName, _ := e["name"].(string); envName == "" {
+					result = multierror.Append(result, fmt.Errorf("%s.name: environment variable name is required", envPath))
+				}
+
+				if err := checkFieldKind(envPath+".value", kindString, e["value"]); err != nil {
+					result = multierror.Append(result, err)
+				}
+			}
+		}
+	}
+
+	return result.ErrorOrNil()
+}
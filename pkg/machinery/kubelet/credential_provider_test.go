@@ -0,0 +1,117 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kubelet_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/kubelet"
+)
+
+func TestValidateCredentialProviderConfig(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name   string
+		config string
+
+		expectedError string
+	}{
+		{
+			name: "valid",
+			config: `{
+				"apiVersion": "kubelet.config.k8s.io/v1",
+				"kind": "CredentialProviderConfig",
+				"providers": [
+					{
+						"name": "ecr-credential-provider",
+						"apiVersion": "credentialprovider.kubelet.k8s.io/v1",
+						"matchImages": ["*.dkr.ecr.*.amazonaws.com"],
+						"defaultCacheDuration": "12h",
+						"args": ["get-credentials"],
+						"env": [{"name": "AWS_PROFILE", "value": "default"}]
+					}
+				]
+			}`,
+		},
+		{
+			name:          "no providers",
+			config:        `{"apiVersion": "kubelet.config.k8s.io/v1", "kind": "CredentialProviderConfig"}`,
+			expectedError: "1 error occurred:\n\t* .credentialProviderConfig.providers: at least one provider is required\n\n",
+		},
+		{
+			name:   "wrong kind",
+			config: `{"apiVersion": "v1", "kind": "KubeletConfiguration", "providers": []}`,
+			expectedError: "3 errors occurred:\n" +
+				"\t* .credentialProviderConfig.apiVersion: unsupported value \"v1\", expected one of [\"kubelet.config.k8s.io/v1\" \"kubelet.config.k8s.io/v1beta1\" \"kubelet.config.k8s.io/v1alpha1\"]\n" +
+				"\t* .credentialProviderConfig.kind: expected \"CredentialProviderConfig\", got \"KubeletConfiguration\"\n" +
+				"\t* .credentialProviderConfig.providers: at least one provider is required\n\n",
+		},
+		{
+			name: "invalid providers",
+			config: `{
+				"apiVersion": "kubelet.config.k8s.io/v1",
+				"kind": "CredentialProviderConfig",
+				"providers": [
+					{
+						"name": "/usr/bin/provider",
+						"apiVersion": "credentialprovider.kubelet.k8s.io/v1",
+						"matchImages": ["registry.example.com", 5],
+						"defaultCacheDuration": "12x"
+					},
+					{
+						"name": "provider",
+						"apiVersion": "credentialprovider.kubelet.k8s.io/v1",
+						"defaultCacheDuration": "1h",
+						"env": [{"value": "foo"}]
+					},
+					{
+						"name": "provider",
+						"apiVersion": "credentialprovider.kubelet.k8s.io/v1",
+						"matchImages": ["registry.example.com"]
+					}
+				]
+			}`,
+			expectedError: "7 errors occurred:\n" +
+				"\t* .credentialProviderConfig.providers[0].name: provider name \"/usr/bin/provider\" should be a binary name, not a path\n" +
+				"\t* .credentialProviderConfig.providers[0].matchImages[1]: expected string, got float64\n" +
+				"\t* .credentialProviderConfig.providers[0].defaultCacheDuration: invalid duration \"12x\": time: unknown unit \"x\" in duration \"12x\"\n" +
+				"\t* .credentialProviderConfig.providers[1].matchImages: at least one image pattern is required\n" +
+				"\t* .credentialProviderConfig.providers[1].env[0].name: environment variable name is required\n" +
+				"\t* .credentialProviderConfig.providers[2].name: duplicate provider \"provider\"\n" +
+				"\t* .credentialProviderConfig.providers[2].defaultCacheDuration: default cache duration is required\n\n",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var cfg map[string]any
+
+			require.NoError(t, json.Unmarshal([]byte(test.config), &cfg))
+
+			err := kubelet.ValidateCredentialProviderConfig(".credentialProviderConfig", cfg)
+
+			if test.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.expectedError)
+			}
+		})
+	}
+}
+
+func TestCredentialProviderNames(t *testing.T) {
+	t.Parallel()
+
+	var cfg map[string]any
+
+	require.NoError(t, json.Unmarshal([]byte(`{"providers": [{"name": "ecr-credential-provider"}, {"name": "gcr-credential-provider"}, "invalid"]}`), &cfg))
+
+	assert.Equal(t, []string{"ecr-credential-provider", "gcr-credential-provider"}, kubelet.CredentialProviderNames(cfg))
+}
@@ -0,0 +1,87 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kubelet
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+// Bind mount options handled by the OCI runtime for kubelet extra mounts.
+const (
+	MountOptionBind              = "bind"
+	MountOptionRecursiveBind     = "rbind"
+	MountOptionReadOnly          = "ro"
+	MountOptionReadWrite         = "rw"
+	MountOptionRecursiveReadOnly = "rro"
+)
+
+// MountPropagationOptions is a list of supported mount propagation options.
+var MountPropagationOptions = []string{
+	"shared",
+	"rshared",
+	"slave",
+	"rslave",
+	"private",
+	"rprivate",
+}
+
+// ValidateExtraMount validates kubelet extra mount destination and options.
+//
+// Bind options, propagation and read-only modes are checked for incompatible combinations,
+// other options are passed to the OCI runtime as is.
+//
+//nolint:gocyclo
+func ValidateExtraMount(path, destination, mountType string, options []string) error {
+	var result *multierror.Error
+
+	if !filepath.IsAbs(destination) {
+		result = multierror.Append(result, fmt.Errorf("%s.destination: path %q should be absolute", path, destination))
+	}
+
+	var (
+		binds       []string
+		propagation []string
+		modes       []string
+	)
+
+	for _, option := range options {
+		switch {
+		case option == MountOptionBind || option == MountOptionRecursiveBind:
+			binds = append(binds, option)
+		case slices.Contains(MountPropagationOptions, option):
+			propagation = append(propagation, option)
+		case option == MountOptionReadOnly || option == MountOptionReadWrite || option == MountOptionRecursiveReadOnly:
+			modes = append(modes, option)
+		}
+	}
+
+	if len(binds) > 1 {
+		result = multierror.Append(result, fmt.Errorf("%s.options: conflicting bind options %q", path, binds))
+	}
+
+	if len(propagation) > 1 {
+		result = multierror.Append(result, fmt.Errorf("%s.options: conflicting propagation options %q", path, propagation))
+	}
+
+	if len(modes) > 1 {
+		result = multierror.Append(result, fmt.Errorf("%s.options: conflicting read-only/read-write options %q", path, modes))
+	}
+
+	isBind := mountType == "bind" || len(binds) > 0
+
+	if len(propagation) > 0 && !isBind {
+		result = multierror.Append(result, fmt.Errorf("%s.options: propagation option %q is only supported for bind mounts", path, propagation[0]))
+	}
+
+	if slices.Contains(modes, MountOptionRecursiveReadOnly) && !slices.Contains(binds, MountOptionRecursiveBind) {
+		result = multierror.Append(result, fmt.Errorf("%s.options: %q requires %q", path, MountOptionRecursiveReadOnly, MountOptionRecursiveBind))
+	}
+
+	return result.ErrorOrNil()
+}
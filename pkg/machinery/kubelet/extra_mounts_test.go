@@ -0,0 +1,91 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kubelet_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/siderolabs/talos/pkg/machinery/kubelet"
+)
+
+func TestValidateExtraMount(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+
+		destination string
+		mountType   string
+		options     []string
+
+		expectedError string
+	}{
+		{
+			name:        "bind rshared",
+			destination: "/var/lib/csi",
+			mountType:   "bind",
+			options:     []string{"bind", "rshared", "rw"},
+		},
+		{
+			name:        "recursive read-only",
+			destination: "/var/lib/example",
+			options:     []string{"rbind", "rslave", "rro", "nosuid"},
+		},
+		{
+			name:        "no options",
+			destination: "/var/lib/example",
+			mountType:   "tmpfs",
+		},
+		{
+			name:        "relative destination",
+			destination: "var/lib/example",
+			mountType:   "bind",
+			options:     []string{"bind"},
+
+			expectedError: "1 error occurred:\n\t* .extraMounts[0].destination: path \"var/lib/example\" should be absolute\n\n",
+		},
+		{
+			name:        "conflicting options",
+			destination: "/var/lib/example",
+			mountType:   "bind",
+			options:     []string{"bind", "rbind", "shared", "private", "ro", "rw"},
+
+			expectedError: "3 errors occurred:\n" +
+				"\t* .extraMounts[0].options: conflicting bind options [\"bind\" \"rbind\"]\n" +
+				"\t* .extraMounts[0].options: conflicting propagation options [\"shared\" \"private\"]\n" +
+				"\t* .extraMounts[0].options: conflicting read-only/read-write options [\"ro\" \"rw\"]\n\n",
+		},
+		{
+			name:        "rro without rbind",
+			destination: "/var/lib/example",
+			mountType:   "bind",
+			options:     []string{"bind", "rro"},
+
+			expectedError: "1 error occurred:\n\t* .extraMounts[0].options: \"rro\" requires \"rbind\"\n\n",
+		},
+		{
+			name:        "propagation for non-bind mount",
+			destination: "/var/lib/example",
+			mountType:   "tmpfs",
+			options:     []string{"rshared"},
+
+			expectedError: "1 error occurred:\n\t* .extraMounts[0].options: propagation option \"rshared\" is only supported for bind mounts\n\n",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := kubelet.ValidateExtraMount(".extraMounts[0]", test.destination, test.mountType, test.options)
+
+			if test.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.expectedError)
+			}
+		})
+	}
+}
@@ -22,3 +22,11 @@ var ProtectedConfigurationFields = []string{
 	"staticPodPath",
 	"seccompDefault",
 }
+
+// OverriddenConfigurationFields is a list of kubelet config fields which are always set by Talos,
+// so the value provided in the machine configuration is ignored.
+var OverriddenConfigurationFields = []string{
+	"containerRuntimeEndpoint",
+	"resolverConfig",
+	"staticPodURL",
+}
@@ -0,0 +1,172 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kubelet
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/blang/semver/v4"
+	"github.com/hashicorp/go-multierror"
+	kubeletconfig "k8s.io/kubelet/config/v1beta1"
+)
+
+// configurationFieldsSince lists the KubeletConfiguration fields which are not supported by all Kubernetes versions Talos supports,
+// with the Kubernetes version which introduced the field.
+var configurationFieldsSince = map[string]string{
+	"podLogsDir":                             "1.29.0",
+	"imageMaximumGCAge":                      "1.29.0",
+	"containerLogMaxWorkers":                 "1.30.0",
+	"containerLogMonitorInterval":            "1.30.0",
+	"userNamespaces":                         "1.30.0",
+	"failCgroupV1":                           "1.31.0",
+	"singleProcessOOMKill":                   "1.32.0",
+	"crashLoopBackOff":                       "1.32.0",
+	"imagePullCredentialsVerificationPolicy": "1.33.0",
+	"preloadedImagesVerificationAllowlist":   "1.33.0",
+	"mergeDefaultEvictionSettings":           "1.34.0",
+}
+
+// configurationFields returns the top-level fields of the KubeletConfiguration (kubelet.config.k8s.io/v1beta1).
+var configurationFields = sync.OnceValue(func() []string {
+	return jsonFields(reflect.TypeFor[kubeletconfig.KubeletConfiguration]())
+})
+
+func jsonFields(typ reflect.Type) []string {
+	var fields []string
+
+	for i := range typ.NumField() {
+		field := typ.Field(i)
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+
+		switch {
+		case name == "-":
+		case name == "" && field.Anonymous:
+			// inlined struct, e.g. TypeMeta
+			fields = append(fields, jsonFields(field.Type)...)
+		case name != "":
+			fields = append(fields, name)
+		}
+	}
+
+	return fields
+}
+
+// ValidateExtraConfig validates kubelet extra configuration against the KubeletConfiguration (kubelet.config.k8s.io/v1beta1).
+//
+// Each field is decoded in the strict mode into the upstream KubeletConfiguration type,
+// so that the unknown fields (including the nested ones) and the type mismatches are rejected.
+//
+// The kubernetesVersion is used to reject fields which are not supported by the configured version of Kubernetes,
+// if it is empty, the version check is skipped.
+// If allowUnknownFields is set, unknown fields produce warnings instead of errors.
+//
+// Protected fields (see ProtectedConfigurationFields) are not validated by this function.
+func ValidateExtraConfig(path string, extraConfig map[string]any, kubernetesVersion string, allowUnknownFields bool) ([]string, error) {
+	var (
+		warnings []string
+		result   *multierror.Error
+	)
+
+	var k8sVersion *semver.Version
+
+	if kubernetesVersion != "" {
+		if v, err := semver.ParseTolerant(kubernetesVersion); err == nil {
+			k8sVersion = &v
+		}
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(extraConfig)) {
+		if slices.Contains(ProtectedConfigurationFields, key) {
+			continue
+		}
+
+		fieldPath := path + "." + key
+
+		if !slices.Contains(configurationFields(), key) {
+			if allowUnknownFields {
+				warnings = append(warnings, fmt.Sprintf("%s: unknown kubelet configuration field, passing it as is", fieldPath))
+			} else {
+				result = multierror.Append(result, fmt.Errorf("%s: unknown kubelet configuration field", fieldPath))
+			}
+
+			continue
+		}
+
+		if since, ok := configurationFieldsSince[key]; ok && k8sVersion != nil {
+			if k8sVersion.LT(semver.MustParse(since)) {
+				if allowUnknownFields {
+					warnings = append(warnings, fmt.Sprintf("%s: kubelet configuration field requires Kubernetes %s or later", fieldPath, since))
+				} else {
+					result = multierror.Append(result, fmt.Errorf("%s: kubelet configuration field requires Kubernetes %s or later", fieldPath, since))
+				}
+
+				continue
+			}
+		}
+
+		if err := decodeField(path, key, extraConfig[key], !allowUnknownFields); err != nil {
+			result = multierror.Append(result, err)
+
+			continue
+		}
+
+		if slices.Contains(OverriddenConfigurationFields, key) {
+			warnings = append(warnings, fmt.Sprintf("%s: kubelet configuration field is managed by Talos, the value will be ignored", fieldPath))
+		}
+	}
+
+	return warnings, result.ErrorOrNil()
+}
+
+// decodeField decodes a single field into the KubeletConfiguration.
+func decodeField(path, key string, value any, strict bool) error {
+	raw, err := json.Marshal(map[string]any{key: value})
+	if err != nil {
+		return fmt.Errorf("%s.%s: %w", path, key, err)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(raw))
+
+	if strict {
+		dec.DisallowUnknownFields()
+	}
+
+	var config kubeletconfig.KubeletConfiguration
+
+	err = dec.Decode(&config)
+	if err == nil {
+		return nil
+	}
+
+	var typeErr *json.UnmarshalTypeError
+
+	if errors.As(err, &typeErr) {
+		field := cmp.Or(typeErr.Field, key)
+
+		// list items are reported as "field.index"
+		for segment := range strings.SplitSeq(field, ".") {
+			if _, err := strconv.Atoi(segment); err == nil {
+				path += "[" + segment + "]"
+			} else {
+				path += "." + segment
+			}
+		}
+
+		return fmt.Errorf("%s: expected %s, got %s", path, typeErr.Type, typeErr.Value)
+	}
+
+	return fmt.Errorf("%s.%s: %s", path, key, strings.TrimPrefix(err.Error(), "json: "))
+}
@@ -0,0 +1,112 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kubelet_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/kubelet"
+)
+
+func TestValidateExtraConfig(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name               string
+		config             string
+		kubernetesVersion  string
+		allowUnknownFields bool
+
+		expectedWarnings []string
+		expectedError    string
+	}{
+		{
+			name:   "valid",
+			config: `{"maxPods": 250, "memoryThrottlingFactor": 0.9, "featureGates": {"Foo": true}, "clusterDNS": ["10.96.0.10"]}`,
+		},
+		{
+			name:          "float for integer",
+			config:        `{"maxPods": 25.5}`,
+			expectedError: "1 error occurred:\n\t* .extraConfig.maxPods: expected int32, got number 25.5\n\n",
+		},
+		{
+			name:          "bad list",
+			config:        `{"clusterDNS": ["10.96.0.10", 5]}`,
+			expectedError: "1 error occurred:\n\t* .extraConfig.clusterDNS[1]: expected string, got number\n\n",
+		},
+		{
+			name:          "bad map",
+			config:        `{"featureGates": {"Foo": "yes"}}`,
+			expectedError: "1 error occurred:\n\t* .extraConfig.featureGates.Foo: expected bool, got string\n\n",
+		},
+		{
+			name:          "bad duration",
+			config:        `{"syncFrequency": "1x"}`,
+			expectedError: "1 error occurred:\n\t* .extraConfig.syncFrequency: time: unknown unit \"x\" in duration \"1x\"\n\n",
+		},
+		{
+			name:          "unknown",
+			config:        `{"fooBar": true}`,
+			expectedError: "1 error occurred:\n\t* .extraConfig.fooBar: unknown kubelet configuration field\n\n",
+		},
+		{
+			name:          "unknown nested",
+			config:        `{"logging": {"format": "json", "fooBar": true}}`,
+			expectedError: "1 error occurred:\n\t* .extraConfig.logging: unknown field \"fooBar\"\n\n",
+		},
+		{
+			name:               "unknown nested allowed",
+			config:             `{"logging": {"format": "json", "fooBar": true}}`,
+			allowUnknownFields: true,
+		},
+		{
+			name:             "overridden",
+			config:           `{"staticPodURL": "http://127.0.0.1:1234"}`,
+			expectedWarnings: []string{".extraConfig.staticPodURL: kubelet configuration field is managed by Talos, the value will be ignored"},
+		},
+		{
+			name:              "too old",
+			config:            `{"mergeDefaultEvictionSettings": true}`,
+			kubernetesVersion: "1.33.2",
+			expectedError:     "1 error occurred:\n\t* .extraConfig.mergeDefaultEvictionSettings: kubelet configuration field requires Kubernetes 1.34.0 or later\n\n",
+		},
+		{
+			name:   "no version",
+			config: `{"mergeDefaultEvictionSettings": true}`,
+		},
+		{
+			name:               "unknown allowed",
+			config:             `{"fooBar": true}`,
+			allowUnknownFields: true,
+			expectedWarnings:   []string{".extraConfig.fooBar: unknown kubelet configuration field, passing it as is"},
+		},
+		{
+			name:   "protected skipped",
+			config: `{"port": "foo"}`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var cfg map[string]any
+
+			require.NoError(t, json.Unmarshal([]byte(test.config), &cfg))
+
+			warnings, err := kubelet.ValidateExtraConfig(".extraConfig", cfg, test.kubernetesVersion, test.allowUnknownFields)
+
+			assert.Equal(t, test.expectedWarnings, warnings)
+
+			if test.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.expectedError)
+			}
+		})
+	}
+}
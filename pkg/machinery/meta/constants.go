@@ -29,4 +29,10 @@ const (
 	UniqueMachineToken
 	// DiskImageBootloader stores the bootloader used for the disk image, this key is wiped on first boot.
 	DiskImageBootloader
+	// UpgradeCheckpoint stores JSON-serialized checkpoint of the last upgrade attempt.
+	UpgradeCheckpoint
+	// RecoveryGate opens the credential recovery gate, the key is removed once the credentials are recovered.
+	RecoveryGate
+	// JoinTokens stores JSON-serialized join tokens created via the API (only the token hashes are stored).
+	JoinTokens
 )
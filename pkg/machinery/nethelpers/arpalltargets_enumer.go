@@ -665,11 +665,11 @@ func (i *BondMode) UnmarshalText(text []byte) error {
 	return err
 }
 
-const _BondXmitHashPolicyName = "layer2layer3+4layer2+3encap2+3encap3+4"
+const _BondXmitHashPolicyName = "layer2layer3+4layer2+3encap2+3encap3+4vlan+srcmac"
 
-var _BondXmitHashPolicyIndex = [...]uint8{0, 6, 14, 22, 30, 38}
+var _BondXmitHashPolicyIndex = [...]uint8{0, 6, 14, 22, 30, 38, 49}
 
-const _BondXmitHashPolicyLowerName = "layer2layer3+4layer2+3encap2+3encap3+4"
+const _BondXmitHashPolicyLowerName = "layer2layer3+4layer2+3encap2+3encap3+4vlan+srcmac"
 
 func (i BondXmitHashPolicy) String() string {
 	if i >= BondXmitHashPolicy(len(_BondXmitHashPolicyIndex)-1) {
@@ -687,9 +687,10 @@ func _BondXmitHashPolicyNoOp() {
 	_ = x[BondXmitPolicyLayer23-(2)]
 	_ = x[BondXmitPolicyEncap23-(3)]
 	_ = x[BondXmitPolicyEncap34-(4)]
+	_ = x[BondXmitPolicyVLANSrcMAC-(5)]
 }
 
-var _BondXmitHashPolicyValues = []BondXmitHashPolicy{BondXmitPolicyLayer2, BondXmitPolicyLayer34, BondXmitPolicyLayer23, BondXmitPolicyEncap23, BondXmitPolicyEncap34}
+var _BondXmitHashPolicyValues = []BondXmitHashPolicy{BondXmitPolicyLayer2, BondXmitPolicyLayer34, BondXmitPolicyLayer23, BondXmitPolicyEncap23, BondXmitPolicyEncap34, BondXmitPolicyVLANSrcMAC}
 
 var _BondXmitHashPolicyNameToValueMap = map[string]BondXmitHashPolicy{
 	_BondXmitHashPolicyName[0:6]:        BondXmitPolicyLayer2,
@@ -702,6 +703,8 @@ var _BondXmitHashPolicyNameToValueMap = map[string]BondXmitHashPolicy{
 	_BondXmitHashPolicyLowerName[22:30]: BondXmitPolicyEncap23,
 	_BondXmitHashPolicyName[30:38]:      BondXmitPolicyEncap34,
 	_BondXmitHashPolicyLowerName[30:38]: BondXmitPolicyEncap34,
+	_BondXmitHashPolicyName[38:49]:      BondXmitPolicyVLANSrcMAC,
+	_BondXmitHashPolicyLowerName[38:49]: BondXmitPolicyVLANSrcMAC,
 }
 
 var _BondXmitHashPolicyNames = []string{
@@ -710,6 +713,7 @@ var _BondXmitHashPolicyNames = []string{
 	_BondXmitHashPolicyName[14:22],
 	_BondXmitHashPolicyName[22:30],
 	_BondXmitHashPolicyName[30:38],
+	_BondXmitHashPolicyName[38:49],
 }
 
 // BondXmitHashPolicyString retrieves an enum value from the enum constants string name.
@@ -13,11 +13,12 @@ type BondXmitHashPolicy uint8
 //
 //structprotogen:gen_enum
 const (
-	BondXmitPolicyLayer2  BondXmitHashPolicy = iota // layer2
-	BondXmitPolicyLayer34                           // layer3+4
-	BondXmitPolicyLayer23                           // layer2+3
-	BondXmitPolicyEncap23                           // encap2+3
-	BondXmitPolicyEncap34                           // encap3+4
+	BondXmitPolicyLayer2     BondXmitHashPolicy = iota // layer2
+	BondXmitPolicyLayer34                              // layer3+4
+	BondXmitPolicyLayer23                              // layer2+3
+	BondXmitPolicyEncap23                              // encap2+3
+	BondXmitPolicyEncap34                              // encap3+4
+	BondXmitPolicyVLANSrcMAC                           // vlan+srcmac
 )
 
 // BondXmitHashPolicyByName parses bond hash policy.
@@ -33,6 +34,8 @@ func BondXmitHashPolicyByName(policy string) (BondXmitHashPolicy, error) {
 		return BondXmitPolicyEncap23, nil
 	case "encap3+4":
 		return BondXmitPolicyEncap34, nil
+	case "vlan+srcmac":
+		return BondXmitPolicyVLANSrcMAC, nil
 	default:
 		return 0, fmt.Errorf("invalid xmit hash policy %v", policy)
 	}
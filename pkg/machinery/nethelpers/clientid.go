@@ -0,0 +1,111 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nethelpers
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+)
+
+// DHCPv4 client identifier types which are generated from the link.
+const (
+	ClientIdentifierTypeMAC  = "mac"
+	ClientIdentifierTypeDUID = "duid"
+)
+
+const (
+	// clientIDTypeNone is used for client identifiers which are not hardware addresses, see RFC 2132, section 9.14.
+	clientIDTypeNone = 0
+	// clientIDTypeDUID is used for RFC 4361 node-specific client identifiers.
+	clientIDTypeDUID = 255
+
+	// clientIDMinLength and clientIDMaxLength are the limits of the client identifier option length.
+	clientIDMinLength = 2
+	clientIDMaxLength = 255
+)
+
+// ParseClientIdentifier parses and validates the explicit DHCPv4 client identifier (option 61).
+//
+// Colon-separated hex bytes (e.g. `01:52:54:00:12:34:56`) are used as the raw option value,
+// any other string is sent as an identifier of type 0.
+func ParseClientIdentifier(s string) ([]byte, error) {
+	var clientID []byte
+
+	if raw, ok := parseColonHex(s); ok {
+		clientID = raw
+	} else {
+		clientID = append([]byte{clientIDTypeNone}, s...)
+	}
+
+	if len(clientID) < clientIDMinLength {
+		return nil, fmt.Errorf("invalid client identifier %q: too short", s)
+	}
+
+	if len(clientID) > clientIDMaxLength {
+		return nil, fmt.Errorf("invalid client identifier %q: too long (%d bytes, maximum is %d)", s, len(clientID), clientIDMaxLength)
+	}
+
+	return clientID, nil
+}
+
+// ClientIdentifierLL generates DHCPv4 client identifier based on the link-layer address.
+func ClientIdentifierLL(hwType uint16, hwAddr net.HardwareAddr) ([]byte, error) {
+	if len(hwAddr) == 0 {
+		return nil, errors.New("link-layer address is empty")
+	}
+
+	if hwType > 0xff {
+		return nil, fmt.Errorf("unsupported hardware type %d", hwType)
+	}
+
+	return append([]byte{byte(hwType)}, hwAddr...), nil
+}
+
+// ClientIdentifierDUID generates RFC 4361 DHCPv4 client identifier based on the IAID and the DUID.
+func ClientIdentifierDUID(iaid uint32, duid []byte) ([]byte, error) {
+	if len(duid) == 0 {
+		return nil, errors.New("DUID is empty")
+	}
+
+	clientID := make([]byte, 5, 5+len(duid))
+	clientID[0] = clientIDTypeDUID
+	binary.BigEndian.PutUint32(clientID[1:], iaid)
+
+	clientID = append(clientID, duid...)
+
+	if len(clientID) > clientIDMaxLength {
+		return nil, errors.New("client identifier is too long")
+	}
+
+	return clientID, nil
+}
+
+func parseColonHex(s string) ([]byte, bool) {
+	parts := strings.Split(s, ":")
+	if len(parts) < 2 {
+		return nil, false
+	}
+
+	raw := make([]byte, 0, len(parts))
+
+	for _, part := range parts {
+		if len(part) != 2 {
+			return nil, false
+		}
+
+		b, err := hex.DecodeString(part)
+		if err != nil {
+			return nil, false
+		}
+
+		raw = append(raw, b[0])
+	}
+
+	return raw, true
+}
@@ -0,0 +1,89 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nethelpers_test
+
+import (
+	"encoding/hex"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+func TestParseClientIdentifier(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name     string
+		clientID string
+
+		expected      string
+		expectedError string
+	}{
+		{
+			name:     "hex",
+			clientID: "01:52:54:00:12:34:56",
+			expected: "01525400123456",
+		},
+		{
+			name:     "string",
+			clientID: "node-1",
+			expected: "006e6f64652d31",
+		},
+		{
+			name:     "not hex",
+			clientID: "a:b",
+			expected: "00613a62",
+		},
+		{
+			name:          "empty",
+			clientID:      "",
+			expectedError: "invalid client identifier \"\": too short",
+		},
+		{
+			name:          "too long",
+			clientID:      strings.Repeat("a", 255),
+			expectedError: "invalid client identifier \"" + strings.Repeat("a", 255) + "\": too long (256 bytes, maximum is 255)",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			clientID, err := nethelpers.ParseClientIdentifier(test.clientID)
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, hex.EncodeToString(clientID))
+		})
+	}
+}
+
+func TestClientIdentifierGenerate(t *testing.T) {
+	t.Parallel()
+
+	hwAddr := net.HardwareAddr{0x52, 0x54, 0x00, 0x12, 0x34, 0x56}
+
+	clientID, err := nethelpers.ClientIdentifierLL(1, hwAddr)
+	require.NoError(t, err)
+	assert.Equal(t, "01525400123456", hex.EncodeToString(clientID))
+
+	duid, err := nethelpers.DUIDLL(1, hwAddr)
+	require.NoError(t, err)
+
+	clientID, err = nethelpers.ClientIdentifierDUID(0x12345678, duid)
+	require.NoError(t, err)
+	assert.Equal(t, "ff1234567800030001525400123456", hex.EncodeToString(clientID))
+
+	_, err = nethelpers.ClientIdentifierLL(1, nil)
+	assert.EqualError(t, err, "link-layer address is empty")
+}
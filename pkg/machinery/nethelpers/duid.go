@@ -0,0 +1,127 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nethelpers
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+)
+
+// DUID types which can be generated.
+const (
+	DUIDTypeLLT  = "LLT"
+	DUIDTypeLL   = "LL"
+	DUIDTypeUUID = "UUID"
+)
+
+// DUID type codes, see RFC 8415, section 11.1.
+const (
+	duidCodeLLT  = 1
+	duidCodeEN   = 2
+	duidCodeLL   = 3
+	duidCodeUUID = 4
+
+	duidMinLength = 2 + 1 // type, identifier
+	duidMaxLength = 130
+)
+
+// ParseDUID parses and validates the DUID in hex string format.
+//
+// Both plain hex strings and colon-separated hex strings are accepted.
+// The structure is validated only for the known DUID types (RFC 8415), other values are accepted as opaque DUIDs
+// (the type followed by at least one byte of the identifier).
+func ParseDUID(s string) ([]byte, error) {
+	duid, err := hex.DecodeString(strings.ReplaceAll(s, ":", ""))
+	if err != nil {
+		return nil, fmt.Errorf("invalid DUID %q: %w", s, err)
+	}
+
+	if len(duid) > duidMaxLength {
+		return nil, fmt.Errorf("invalid DUID %q: too long", s)
+	}
+
+	minLength := duidMinLength
+
+	if len(duid) >= 2 {
+		switch binary.BigEndian.Uint16(duid) {
+		case duidCodeLLT:
+			minLength = 2 + 2 + 4 + 1 // type, hardware type, time, link-layer address
+		case duidCodeEN:
+			minLength = 2 + 4 + 1 // type, enterprise number, identifier
+		case duidCodeLL:
+			minLength = 2 + 2 + 1 // type, hardware type, link-layer address
+		case duidCodeUUID:
+			if len(duid) != 2+16 {
+				return nil, fmt.Errorf("invalid DUID %q: DUID-UUID should contain 16 bytes of UUID", s)
+			}
+		}
+	}
+
+	if len(duid) < minLength {
+		return nil, fmt.Errorf("invalid DUID %q: too short", s)
+	}
+
+	return duid, nil
+}
+
+// ValidateDUIDType checks that the DUID type is supported for generation.
+func ValidateDUIDType(duidType string) error {
+	switch duidType {
+	case DUIDTypeLL, DUIDTypeLLT, DUIDTypeUUID:
+		return nil
+	default:
+		return fmt.Errorf("unsupported DUID type %q", duidType)
+	}
+}
+
+// DUIDLL generates DUID-LL based on the link-layer address.
+func DUIDLL(hwType uint16, hwAddr net.HardwareAddr) ([]byte, error) {
+	if len(hwAddr) == 0 {
+		return nil, errors.New("link-layer address is empty")
+	}
+
+	duid := make([]byte, 4, 4+len(hwAddr))
+	binary.BigEndian.PutUint16(duid, duidCodeLL)
+	binary.BigEndian.PutUint16(duid[2:], hwType)
+
+	return append(duid, hwAddr...), nil
+}
+
+// DUIDLLT generates DUID-LLT based on the link-layer address.
+//
+// The time field is always set to zero, so that the DUID stays stable across reboots
+// without being persisted.
+func DUIDLLT(hwType uint16, hwAddr net.HardwareAddr) ([]byte, error) {
+	if len(hwAddr) == 0 {
+		return nil, errors.New("link-layer address is empty")
+	}
+
+	duid := make([]byte, 8, 8+len(hwAddr))
+	binary.BigEndian.PutUint16(duid, duidCodeLLT)
+	binary.BigEndian.PutUint16(duid[2:], hwType)
+
+	return append(duid, hwAddr...), nil
+}
+
+// DUIDUUID generates DUID-UUID based on the machine UUID.
+func DUIDUUID(uuid string) ([]byte, error) {
+	raw, err := hex.DecodeString(strings.ReplaceAll(uuid, "-", ""))
+	if err != nil {
+		return nil, fmt.Errorf("invalid UUID %q: %w", uuid, err)
+	}
+
+	if len(raw) != 16 {
+		return nil, fmt.Errorf("invalid UUID %q", uuid)
+	}
+
+	duid := make([]byte, 2, 2+len(raw))
+	binary.BigEndian.PutUint16(duid, duidCodeUUID)
+
+	return append(duid, raw...), nil
+}
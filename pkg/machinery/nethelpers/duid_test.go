@@ -0,0 +1,131 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nethelpers_test
+
+import (
+	"encoding/hex"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+func TestParseDUID(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		duid string
+
+		expected      string
+		expectedError string
+	}{
+		{
+			duid:     "00030001525400123456",
+			expected: "00030001525400123456",
+		},
+		{
+			duid:     "00:01:00:01:00:00:00:00:52:54:00:12:34:56",
+			expected: "0001000100000000525400123456",
+		},
+		{
+			duid:     "000400112233445566778899aabbccddeeff",
+			expected: "000400112233445566778899aabbccddeeff",
+		},
+		{
+			duid:     "00020000ab1101",
+			expected: "00020000ab1101",
+		},
+		{
+			duid:          "0004001122",
+			expectedError: "invalid DUID \"0004001122\": DUID-UUID should contain 16 bytes of UUID",
+		},
+		{
+			duid:          "0003",
+			expectedError: "invalid DUID \"0003\": too short",
+		},
+		{
+			duid:          "00030001",
+			expectedError: "invalid DUID \"00030001\": too short",
+		},
+		{
+			duid:     "0009000152",
+			expected: "0009000152",
+		},
+		{
+			duid:          "ab",
+			expectedError: "invalid DUID \"ab\": too short",
+		},
+		{
+			duid:          "",
+			expectedError: "invalid DUID \"\": too short",
+		},
+		{
+			duid:          "0009",
+			expectedError: "invalid DUID \"0009\": too short",
+		},
+		{
+			duid:     "de:ad:be:ef",
+			expected: "deadbeef",
+		},
+		{
+			duid:          "0001" + strings.Repeat("00", 130),
+			expectedError: "invalid DUID \"0001" + strings.Repeat("00", 130) + "\": too long",
+		},
+		{
+			duid:          "0009" + strings.Repeat("00", 129),
+			expectedError: "invalid DUID \"0009" + strings.Repeat("00", 129) + "\": too long",
+		},
+		{
+			duid:     "0009" + strings.Repeat("00", 128),
+			expected: "0009" + strings.Repeat("00", 128),
+		},
+		{
+			duid:          "zz",
+			expectedError: "invalid DUID \"zz\": encoding/hex: invalid byte: U+007A 'z'",
+		},
+	} {
+		t.Run(test.duid, func(t *testing.T) {
+			t.Parallel()
+
+			duid, err := nethelpers.ParseDUID(test.duid)
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, hex.EncodeToString(duid))
+		})
+	}
+}
+
+func TestGenerateDUID(t *testing.T) {
+	t.Parallel()
+
+	hwAddr := net.HardwareAddr{0x52, 0x54, 0x00, 0x12, 0x34, 0x56}
+
+	duid, err := nethelpers.DUIDLL(1, hwAddr)
+	require.NoError(t, err)
+	assert.Equal(t, "00030001525400123456", hex.EncodeToString(duid))
+
+	duid, err = nethelpers.DUIDLLT(1, hwAddr)
+	require.NoError(t, err)
+	assert.Equal(t, "0001000100000000525400123456", hex.EncodeToString(duid))
+
+	duid, err = nethelpers.DUIDUUID("00112233-4455-6677-8899-aabbccddeeff")
+	require.NoError(t, err)
+	assert.Equal(t, "000400112233445566778899aabbccddeeff", hex.EncodeToString(duid))
+
+	_, err = nethelpers.DUIDLL(1, nil)
+	assert.Error(t, err)
+
+	_, err = nethelpers.DUIDUUID("foo")
+	assert.Error(t, err)
+}
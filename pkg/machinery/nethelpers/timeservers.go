@@ -0,0 +1,27 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nethelpers
+
+import "fmt"
+
+// Modes of combining time servers received via DHCP with the statically configured ones.
+const (
+	// DHCPTimeServersIgnore uses statically configured time servers only (if any).
+	DHCPTimeServersIgnore = "ignore"
+	// DHCPTimeServersSupplement appends time servers received via DHCP after the statically configured ones.
+	DHCPTimeServersSupplement = "supplement"
+	// DHCPTimeServersReplace uses time servers received via DHCP instead of the statically configured ones.
+	DHCPTimeServersReplace = "replace"
+)
+
+// ValidateDHCPTimeServersMode checks that the DHCP time servers mode is supported.
+func ValidateDHCPTimeServersMode(mode string) error {
+	switch mode {
+	case DHCPTimeServersIgnore, DHCPTimeServersSupplement, DHCPTimeServersReplace:
+		return nil
+	default:
+		return fmt.Errorf("unsupported DHCP time servers mode %q", mode)
+	}
+}
@@ -36,6 +36,8 @@ func TestRegisterResource(t *testing.T) {
 		&block.SystemDisk{},
 		&block.UserDiskConfigStatus{},
 		&block.VolumeConfig{},
+		&block.VolumeKeyRotation{},
+		&block.VolumeKeyStatus{},
 		&block.VolumeLifecycle{},
 		&block.VolumeMountRequest{},
 		&block.VolumeMountStatus{},
@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type DeviceSpec -type DiscoveredVolumeSpec -type DiscoveryRefreshRequestSpec -type DiscoveryRefreshStatusSpec -type DiskSpec -type MountRequestSpec -type MountStatusSpec -type SwapStatusSpec -type SymlinkSpec -type SystemDiskSpec -type UserDiskConfigStatusSpec -type VolumeConfigSpec -type VolumeLifecycleSpec -type VolumeMountRequestSpec -type VolumeMountStatusSpec -type VolumeStatusSpec -type ZswapStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type DeviceSpec -type DiscoveredVolumeSpec -type DiscoveryRefreshRequestSpec -type DiscoveryRefreshStatusSpec -type DiskSpec -type MountRequestSpec -type MountStatusSpec -type SwapStatusSpec -type SymlinkSpec -type SystemDiskSpec -type UserDiskConfigStatusSpec -type VolumeConfigSpec -type VolumeKeyRotationSpec -type VolumeKeyStatusSpec -type VolumeLifecycleSpec -type VolumeMountRequestSpec -type VolumeMountStatusSpec -type VolumeStatusSpec -type ZswapStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package block
 
@@ -125,6 +125,26 @@ func (o VolumeConfigSpec) DeepCopy() VolumeConfigSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of VolumeKeyRotationSpec.
+func (o VolumeKeyRotationSpec) DeepCopy() VolumeKeyRotationSpec {
+	var cp VolumeKeyRotationSpec = o
+	if o.Slots != nil {
+		cp.Slots = make([]int, len(o.Slots))
+		copy(cp.Slots, o.Slots)
+	}
+	return cp
+}
+
+// DeepCopy generates a deep copy of VolumeKeyStatusSpec.
+func (o VolumeKeyStatusSpec) DeepCopy() VolumeKeyStatusSpec {
+	var cp VolumeKeyStatusSpec = o
+	if o.Slots != nil {
+		cp.Slots = make([]VolumeKeySlotSpec, len(o.Slots))
+		copy(cp.Slots, o.Slots)
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of VolumeLifecycleSpec.
 func (o VolumeLifecycleSpec) DeepCopy() VolumeLifecycleSpec {
 	var cp VolumeLifecycleSpec = o
@@ -151,6 +151,8 @@ type EncryptionKey struct {
 	TPMPCRs []int `yaml:"pcrs,omitempty" protobuf:"7"`
 	// Only for Type == "tpm":
 	TPMPubKeyPCRs []int `yaml:"pubKeyPcrs,omitempty" protobuf:"8"`
+	// Only for Type == "tpm":
+	TPMPCRBank string `yaml:"pcrBank,omitempty" protobuf:"9"`
 }
 
 // MountSpec is the spec for volume mount.
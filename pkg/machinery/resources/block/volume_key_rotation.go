@@ -0,0 +1,73 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+import (
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// VolumeKeyRotationType is type of VolumeKeyRotation resource.
+const VolumeKeyRotationType = resource.Type("VolumeKeyRotations.block.talos.dev")
+
+// VolumeKeyRotation resource requests rotation of the disk encryption keys of a volume.
+//
+// Resource ID is the volume ID.
+// The resource is created by the machine API, and the keys are rotated each time the resource is updated.
+type VolumeKeyRotation = typed.Resource[VolumeKeyRotationSpec, VolumeKeyRotationExtension]
+
+// VolumeKeyRotationSpec is the spec for VolumeKeyRotation resource.
+//
+//gotagsrewrite:gen
+type VolumeKeyRotationSpec struct {
+	RequestedAt time.Time `yaml:"requestedAt" protobuf:"1"`
+	// Slots to rotate, if empty, all key slots wrapped by a key provider are rotated.
+	Slots []int `yaml:"slots,omitempty" protobuf:"2"`
+}
+
+// NewVolumeKeyRotation initializes a VolumeKeyRotation resource.
+func NewVolumeKeyRotation(namespace resource.Namespace, id resource.ID) *VolumeKeyRotation {
+	return typed.NewResource[VolumeKeyRotationSpec, VolumeKeyRotationExtension](
+		resource.NewMetadata(namespace, VolumeKeyRotationType, id, resource.VersionUndefined),
+		VolumeKeyRotationSpec{},
+	)
+}
+
+// VolumeKeyRotationExtension is auxiliary resource data for VolumeKeyRotation.
+type VolumeKeyRotationExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (VolumeKeyRotationExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             VolumeKeyRotationType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Requested At",
+				JSONPath: "{.requestedAt}",
+			},
+			{
+				Name:     "Slots",
+				JSONPath: "{.slots}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic(VolumeKeyRotationType, &VolumeKeyRotation{})
+	if err != nil {
+		panic(err)
+	}
+}
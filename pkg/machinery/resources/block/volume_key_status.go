@@ -0,0 +1,95 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+import (
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// VolumeKeyStatusType is type of VolumeKeyStatus resource.
+const VolumeKeyStatusType = resource.Type("VolumeKeyStatuses.block.talos.dev")
+
+// VolumeKeyStatus resource holds the state of the disk encryption keys of a volume.
+//
+// Resource ID is the volume ID.
+type VolumeKeyStatus = typed.Resource[VolumeKeyStatusSpec, VolumeKeyStatusExtension]
+
+// VolumeKeyStatusSpec is the spec for VolumeKeyStatus resource.
+//
+//gotagsrewrite:gen
+type VolumeKeyStatusSpec struct {
+	Slots []VolumeKeySlotSpec `yaml:"slots" protobuf:"1"`
+	// Epoch is the highest key epoch among the key slots.
+	Epoch int `yaml:"epoch" protobuf:"2"`
+
+	// RotationStep is the current step of the key rotation, empty if no rotation is in progress.
+	RotationStep        string    `yaml:"rotationStep,omitempty" protobuf:"3"`
+	RotationError       string    `yaml:"rotationError,omitempty" protobuf:"4"`
+	RotationRequestedAt time.Time `yaml:"rotationRequestedAt,omitempty" protobuf:"5"`
+	RotatedAt           time.Time `yaml:"rotatedAt,omitempty" protobuf:"6"`
+}
+
+// VolumeKeySlotSpec describes the key in a LUKS2 key slot.
+//
+//gotagsrewrite:gen
+type VolumeKeySlotSpec struct {
+	Slot     int    `yaml:"slot" protobuf:"1"`
+	Provider string `yaml:"provider,omitempty" protobuf:"2"`
+	Epoch    int    `yaml:"epoch" protobuf:"3"`
+}
+
+// NewVolumeKeyStatus initializes a VolumeKeyStatus resource.
+func NewVolumeKeyStatus(namespace resource.Namespace, id resource.ID) *VolumeKeyStatus {
+	return typed.NewResource[VolumeKeyStatusSpec, VolumeKeyStatusExtension](
+		resource.NewMetadata(namespace, VolumeKeyStatusType, id, resource.VersionUndefined),
+		VolumeKeyStatusSpec{},
+	)
+}
+
+// VolumeKeyStatusExtension is auxiliary resource data for VolumeKeyStatus.
+type VolumeKeyStatusExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (VolumeKeyStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             VolumeKeyStatusType,
+		Aliases:          []resource.Type{"volumekeys"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Epoch",
+				JSONPath: "{.epoch}",
+			},
+			{
+				Name:     "Step",
+				JSONPath: "{.rotationStep}",
+			},
+			{
+				Name:     "Rotated At",
+				JSONPath: "{.rotatedAt}",
+			},
+			{
+				Name:     "Error",
+				JSONPath: "{.rotationError}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic(VolumeKeyStatusType, &VolumeKeyStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -73,8 +73,9 @@ type VolumeStatusSpec struct {
 //
 //gotagsrewrite:gen
 type TPMEncryptionOptionsInfo struct {
-	PCRs       []int `yaml:"pcrs,omitempty" protobuf:"1"`
-	PubKeyPCRs []int `yaml:"pubKeyPcrs,omitempty" protobuf:"2"`
+	PCRs       []int  `yaml:"pcrs,omitempty" protobuf:"1"`
+	PubKeyPCRs []int  `yaml:"pubKeyPcrs,omitempty" protobuf:"2"`
+	PCRBank    string `yaml:"pcrBank,omitempty" protobuf:"3"`
 }
 
 // SetSize sets the size of the volume status, including the pretty size.
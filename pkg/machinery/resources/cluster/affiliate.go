@@ -17,7 +17,7 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/proto"
 )
 
-//go:generate go tool github.com/siderolabs/deep-copy -type AffiliateSpec -type ConfigSpec -type IdentitySpec -type MemberSpec -type InfoSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go .
+//go:generate go tool github.com/siderolabs/deep-copy -type AffiliateSpec -type ConfigSpec -type IdentityConflictSpec -type IdentityResetSpec -type IdentitySpec -type MemberSpec -type InfoSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go .
 
 // AffiliateType is type of Affiliate resource.
 const AffiliateType = resource.Type("Affiliates.cluster.talos.dev")
@@ -80,7 +80,7 @@ type AffiliateSpec struct {
 	Hostname        string                `yaml:"hostname" protobuf:"3"`
 	Nodename        string                `yaml:"nodename,omitempty" protobuf:"4"`
 	OperatingSystem string                `yaml:"operatingSystem" protobuf:"5"`
-	MachineType     machine.Type          `yaml:"machineType" protobuf:"6"`
+	MachineType     machine.Type          `yaml:"machineType" protobuf:"6" schema:"enum=unknown|init|controlplane|worker"`
 	KubeSpan        KubeSpanAffiliateSpec `yaml:"kubespan,omitempty" protobuf:"7"`
 	ControlPlane    *ControlPlane         `yaml:"controlPlane,omitempty" protobuf:"8"`
 }
@@ -27,6 +27,8 @@ func TestRegisterResource(t *testing.T) {
 		&cluster.Affiliate{},
 		&cluster.Config{},
 		&cluster.Identity{},
+		&cluster.IdentityConflict{},
+		&cluster.IdentityReset{},
 		&cluster.Member{},
 	} {
 		assert.NoError(t, resourceRegistry.Register(ctx, resource))
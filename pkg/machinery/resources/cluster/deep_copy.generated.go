@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type AffiliateSpec -type ConfigSpec -type IdentitySpec -type MemberSpec -type InfoSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type AffiliateSpec -type ConfigSpec -type IdentityConflictSpec -type IdentityResetSpec -type IdentitySpec -type MemberSpec -type InfoSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package cluster
 
@@ -42,6 +42,22 @@ func (o ConfigSpec) DeepCopy() ConfigSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of IdentityConflictSpec.
+func (o IdentityConflictSpec) DeepCopy() IdentityConflictSpec {
+	var cp IdentityConflictSpec = o
+	if o.Addresses != nil {
+		cp.Addresses = make([]netip.Addr, len(o.Addresses))
+		copy(cp.Addresses, o.Addresses)
+	}
+	return cp
+}
+
+// DeepCopy generates a deep copy of IdentityResetSpec.
+func (o IdentityResetSpec) DeepCopy() IdentityResetSpec {
+	var cp IdentityResetSpec = o
+	return cp
+}
+
 // DeepCopy generates a deep copy of IdentitySpec.
 func (o IdentitySpec) DeepCopy() IdentitySpec {
 	var cp IdentitySpec = o
@@ -0,0 +1,79 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster
+
+import (
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// IdentityConflictType is type of IdentityConflict resource.
+const IdentityConflictType = resource.Type("IdentityConflicts.cluster.talos.dev")
+
+// IdentityConflict resource describes another machine which presents the same node identity as the local node.
+//
+// Resource ID is the ID of the raw affiliate (registry/node ID) which reported the conflicting machine.
+// The conflict usually means that the machine was cloned from the same disk image, and the node identity
+// should be reset on one of the machines.
+type IdentityConflict = typed.Resource[IdentityConflictSpec, IdentityConflictExtension]
+
+// IdentityConflictSpec describes the conflicting machine.
+//
+//gotagsrewrite:gen
+type IdentityConflictSpec struct {
+	NodeID    string       `yaml:"nodeId" protobuf:"1"`
+	Hostname  string       `yaml:"hostname" protobuf:"2"`
+	Nodename  string       `yaml:"nodename,omitempty" protobuf:"3"`
+	Addresses []netip.Addr `yaml:"addresses" protobuf:"4"`
+}
+
+// NewIdentityConflict initializes a IdentityConflict resource.
+func NewIdentityConflict(namespace resource.Namespace, id resource.ID) *IdentityConflict {
+	return typed.NewResource[IdentityConflictSpec, IdentityConflictExtension](
+		resource.NewMetadata(namespace, IdentityConflictType, id, resource.VersionUndefined),
+		IdentityConflictSpec{},
+	)
+}
+
+// IdentityConflictExtension provides auxiliary methods for IdentityConflict.
+type IdentityConflictExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (IdentityConflictExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             IdentityConflictType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Node ID",
+				JSONPath: `{.nodeId}`,
+			},
+			{
+				Name:     "Hostname",
+				JSONPath: `{.hostname}`,
+			},
+			{
+				Name:     "Addresses",
+				JSONPath: `{.addresses}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[IdentityConflictSpec](IdentityConflictType, &IdentityConflict{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,67 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster
+
+import (
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// IdentityResetType is type of IdentityReset resource.
+const IdentityResetType = resource.Type("IdentityResets.cluster.talos.dev")
+
+// IdentityReset resource requests regeneration of the node identity and the KubeSpan identity.
+//
+// Resource ID is LocalIdentity.
+// The resource is created by the machine API, and the identities are regenerated each time the resource is updated.
+type IdentityReset = typed.Resource[IdentityResetSpec, IdentityResetExtension]
+
+// IdentityResetSpec describes the identity reset request.
+//
+//gotagsrewrite:gen
+type IdentityResetSpec struct {
+	RequestedAt time.Time `yaml:"requestedAt" protobuf:"1"`
+}
+
+// NewIdentityReset initializes a IdentityReset resource.
+func NewIdentityReset(namespace resource.Namespace, id resource.ID) *IdentityReset {
+	return typed.NewResource[IdentityResetSpec, IdentityResetExtension](
+		resource.NewMetadata(namespace, IdentityResetType, id, resource.VersionUndefined),
+		IdentityResetSpec{},
+	)
+}
+
+// IdentityResetExtension provides auxiliary methods for IdentityReset.
+type IdentityResetExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (IdentityResetExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             IdentityResetType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Requested At",
+				JSONPath: `{.requestedAt}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[IdentityResetSpec](IdentityResetType, &IdentityReset{})
+	if err != nil {
+		panic(err)
+	}
+}
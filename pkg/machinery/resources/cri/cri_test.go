@@ -24,6 +24,7 @@ func TestRegisterResource(t *testing.T) {
 	resourceRegistry := registry.NewResourceRegistry(resources)
 
 	for _, resource := range []meta.ResourceWithRD{
+		&cri.DefaultSecurityProfiles{},
 		&cri.ImageCacheConfig{},
 		&cri.SeccompProfile{},
 		&cri.RegistriesConfig{},
@@ -2,10 +2,16 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type RegistriesConfigSpec -type ImageCacheConfigSpec -type SeccompProfileSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type DefaultSecurityProfilesSpec -type RegistriesConfigSpec -type ImageCacheConfigSpec -type SeccompProfileSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package cri
 
+// DeepCopy generates a deep copy of DefaultSecurityProfilesSpec.
+func (o DefaultSecurityProfilesSpec) DeepCopy() DefaultSecurityProfilesSpec {
+	var cp DefaultSecurityProfilesSpec = o
+	return cp
+}
+
 // DeepCopy generates a deep copy of RegistriesConfigSpec.
 func (o RegistriesConfigSpec) DeepCopy() RegistriesConfigSpec {
 	var cp RegistriesConfigSpec = o
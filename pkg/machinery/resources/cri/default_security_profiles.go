@@ -0,0 +1,76 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cri
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// DefaultSecurityProfilesType is type of DefaultSecurityProfiles resource.
+const DefaultSecurityProfilesType = resource.Type("DefaultSecurityProfiles.cri.talos.dev")
+
+// DefaultSecurityProfiles represents DefaultSecurityProfiles typed resource.
+type DefaultSecurityProfiles = typed.Resource[DefaultSecurityProfilesSpec, DefaultSecurityProfilesExtension]
+
+// DefaultSecurityProfilesID is the ID of the DefaultSecurityProfiles resource.
+const DefaultSecurityProfilesID = "default"
+
+// DefaultSecurityProfilesSpec describes the effective default security profiles of the CRI containers.
+//
+//gotagsrewrite:gen
+type DefaultSecurityProfilesSpec struct {
+	// Seccomp is the type of the default seccomp profile: RuntimeDefault, Localhost or Unconfined.
+	Seccomp string `yaml:"seccomp" protobuf:"1"`
+	// SeccompLocalhostProfile is the path of the Localhost profile relative to the kubelet seccomp directory.
+	SeccompLocalhostProfile string `yaml:"seccompLocalhostProfile,omitempty" protobuf:"2"`
+	// AppArmor is the type of the default AppArmor profile: RuntimeDefault or Unconfined.
+	AppArmor string `yaml:"appArmor" protobuf:"3"`
+	// AppArmorSupported is true if the kernel has AppArmor enabled.
+	AppArmorSupported bool `yaml:"appArmorSupported" protobuf:"4"`
+}
+
+// NewDefaultSecurityProfiles creates new DefaultSecurityProfiles object.
+func NewDefaultSecurityProfiles() *DefaultSecurityProfiles {
+	return typed.NewResource[DefaultSecurityProfilesSpec, DefaultSecurityProfilesExtension](
+		resource.NewMetadata(NamespaceName, DefaultSecurityProfilesType, DefaultSecurityProfilesID, resource.VersionUndefined),
+		DefaultSecurityProfilesSpec{},
+	)
+}
+
+// DefaultSecurityProfilesExtension is an auxiliary type for DefaultSecurityProfiles resource.
+type DefaultSecurityProfilesExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (DefaultSecurityProfilesExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             DefaultSecurityProfilesType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Seccomp",
+				JSONPath: "{.seccomp}",
+			},
+			{
+				Name:     "AppArmor",
+				JSONPath: "{.appArmor}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic(DefaultSecurityProfilesType, &DefaultSecurityProfiles{})
+	if err != nil {
+		panic(err)
+	}
+}
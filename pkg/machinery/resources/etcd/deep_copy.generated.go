@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type ConfigSpec -type PKIStatusSpec -type SpecSpec -type MemberSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type ConfigSpec -type DefragmentationStatusSpec -type PKIStatusSpec -type SnapshotStatusSpec -type SpecSpec -type MemberSpec -type StatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package etcd
 
@@ -38,12 +38,24 @@ func (o ConfigSpec) DeepCopy() ConfigSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of DefragmentationStatusSpec.
+func (o DefragmentationStatusSpec) DeepCopy() DefragmentationStatusSpec {
+	var cp DefragmentationStatusSpec = o
+	return cp
+}
+
 // DeepCopy generates a deep copy of PKIStatusSpec.
 func (o PKIStatusSpec) DeepCopy() PKIStatusSpec {
 	var cp PKIStatusSpec = o
 	return cp
 }
 
+// DeepCopy generates a deep copy of SnapshotStatusSpec.
+func (o SnapshotStatusSpec) DeepCopy() SnapshotStatusSpec {
+	var cp SnapshotStatusSpec = o
+	return cp
+}
+
 // DeepCopy generates a deep copy of SpecSpec.
 func (o SpecSpec) DeepCopy() SpecSpec {
 	var cp SpecSpec = o
@@ -73,3 +85,13 @@ func (o MemberSpec) DeepCopy() MemberSpec {
 	var cp MemberSpec = o
 	return cp
 }
+
+// DeepCopy generates a deep copy of StatusSpec.
+func (o StatusSpec) DeepCopy() StatusSpec {
+	var cp StatusSpec = o
+	if o.Alarms != nil {
+		cp.Alarms = make([]Alarm, len(o.Alarms))
+		copy(cp.Alarms, o.Alarms)
+	}
+	return cp
+}
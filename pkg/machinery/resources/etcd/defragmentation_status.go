@@ -0,0 +1,87 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd
+
+import (
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// DefragmentationStatusType is type of DefragmentationStatus resource.
+const DefragmentationStatusType = resource.Type("EtcdDefragmentationStatuses.etcd.talos.dev")
+
+// DefragmentationStatus resource holds the results of the scheduled defragmentation of the local etcd member.
+//
+// Resource ID is always LocalMemberID.
+type DefragmentationStatus = typed.Resource[DefragmentationStatusSpec, DefragmentationStatusExtension]
+
+// DefragmentationStatusSpec describes the results of the scheduled defragmentation of the local etcd member.
+//
+//gotagsrewrite:gen
+type DefragmentationStatusSpec struct {
+	MemberID string `yaml:"memberID" protobuf:"1"`
+	// Time of the last defragmentation attempt.
+	LastRun time.Time `yaml:"lastRun,omitempty" protobuf:"2"`
+	// Time of the next scheduled defragmentation.
+	NextRun time.Time `yaml:"nextRun,omitempty" protobuf:"3"`
+	// Duration of the last defragmentation.
+	Duration time.Duration `yaml:"duration,omitempty" protobuf:"4"`
+	// Database size before and after the last defragmentation (in bytes).
+	DBSizeBefore int64 `yaml:"dbSizeBefore,omitempty" protobuf:"5"`
+	DBSizeAfter  int64 `yaml:"dbSizeAfter,omitempty" protobuf:"6"`
+	// Error of the last defragmentation attempt.
+	LastError string `yaml:"lastError,omitempty" protobuf:"7"`
+	// Reason the last scheduled run was skipped.
+	SkipReason string `yaml:"skipReason,omitempty" protobuf:"8"`
+}
+
+// NewDefragmentationStatus initializes a DefragmentationStatus resource.
+func NewDefragmentationStatus(namespace resource.Namespace, id resource.ID) *DefragmentationStatus {
+	return typed.NewResource[DefragmentationStatusSpec, DefragmentationStatusExtension](
+		resource.NewMetadata(namespace, DefragmentationStatusType, id, resource.VersionUndefined),
+		DefragmentationStatusSpec{},
+	)
+}
+
+// DefragmentationStatusExtension provides auxiliary methods for DefragmentationStatus.
+type DefragmentationStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (DefragmentationStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             DefragmentationStatusType,
+		Aliases:          []resource.Type{"etcddefrag"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Last Run",
+				JSONPath: "{.lastRun}",
+			},
+			{
+				Name:     "Next Run",
+				JSONPath: "{.nextRun}",
+			},
+			{
+				Name:     "Error",
+				JSONPath: "{.lastError}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[DefragmentationStatusSpec](DefragmentationStatusType, &DefragmentationStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -25,7 +25,10 @@ func TestRegisterResource(t *testing.T) {
 	resourceRegistry := registry.NewResourceRegistry(resources)
 
 	for _, resource := range []meta.ResourceWithRD{
+		&etcd.DefragmentationStatus{},
 		&etcd.PKIStatus{},
+		&etcd.SnapshotStatus{},
+		&etcd.Status{},
 	} {
 		assert.NoError(t, resourceRegistry.Register(ctx, resource))
 	}
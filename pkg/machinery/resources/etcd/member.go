@@ -5,6 +5,8 @@
 package etcd
 
 import (
+	"time"
+
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
 	"github.com/cosi-project/runtime/pkg/resource/protobuf"
@@ -27,6 +29,14 @@ type Member = typed.Resource[MemberSpec, MemberExtension]
 //gotagsrewrite:gen
 type MemberSpec struct {
 	MemberID string `yaml:"memberID" protobuf:"1"`
+	// IsLearner is true if the member is a learner which is not promoted to a voting member yet.
+	IsLearner bool `yaml:"isLearner,omitempty" protobuf:"2"`
+	// Number of raft log entries the learner lags behind the voting members.
+	LearnerLag uint64 `yaml:"learnerLag,omitempty" protobuf:"3"`
+	// PromotionEligible is true if the learner caught up with the voting members according to the promotion policy.
+	PromotionEligible bool `yaml:"promotionEligible,omitempty" protobuf:"4"`
+	// Time the member was first observed as a learner.
+	LearnerSince time.Time `yaml:"learnerSince,omitempty" protobuf:"5"`
 }
 
 // NewMember initializes a Member resource.
@@ -51,6 +61,10 @@ func (MemberExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
 				Name:     "Member ID",
 				JSONPath: "{.memberID}",
 			},
+			{
+				Name:     "Learner",
+				JSONPath: "{.isLearner}",
+			},
 		},
 	}
 }
@@ -0,0 +1,98 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd
+
+import (
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// SnapshotStatusType is type of SnapshotStatus resource.
+const SnapshotStatusType = resource.Type("EtcdSnapshotStatuses.etcd.talos.dev")
+
+// SnapshotStatus resource holds the results of the scheduled snapshot of the local etcd member.
+//
+// Resource ID is always LocalMemberID.
+type SnapshotStatus = typed.Resource[SnapshotStatusSpec, SnapshotStatusExtension]
+
+// SnapshotStatusSpec describes the results of the scheduled snapshot of the local etcd member.
+//
+//gotagsrewrite:gen
+type SnapshotStatusSpec struct {
+	MemberID string `yaml:"memberID" protobuf:"1"`
+	// Time of the last snapshot attempt.
+	LastRun time.Time `yaml:"lastRun,omitempty" protobuf:"2"`
+	// Time of the next scheduled snapshot.
+	NextRun time.Time `yaml:"nextRun,omitempty" protobuf:"3"`
+	// Duration of the last snapshot.
+	Duration time.Duration `yaml:"duration,omitempty" protobuf:"4"`
+	// Path to the last snapshot on the node.
+	Path string `yaml:"path,omitempty" protobuf:"5"`
+	// Size of the last snapshot (in bytes).
+	Size int64 `yaml:"size,omitempty" protobuf:"6"`
+	// SHA256 hash of the last snapshot (hex).
+	SHA256 string `yaml:"sha256,omitempty" protobuf:"7"`
+	// Error of the last snapshot attempt.
+	LastError string `yaml:"lastError,omitempty" protobuf:"8"`
+	// Reason the last scheduled run was skipped.
+	SkipReason string `yaml:"skipReason,omitempty" protobuf:"9"`
+	// Location of the last snapshot uploaded to the object storage.
+	UploadLocation string `yaml:"uploadLocation,omitempty" protobuf:"10"`
+	// Error of the last snapshot upload.
+	UploadError string `yaml:"uploadError,omitempty" protobuf:"11"`
+}
+
+// NewSnapshotStatus initializes a SnapshotStatus resource.
+func NewSnapshotStatus(namespace resource.Namespace, id resource.ID) *SnapshotStatus {
+	return typed.NewResource[SnapshotStatusSpec, SnapshotStatusExtension](
+		resource.NewMetadata(namespace, SnapshotStatusType, id, resource.VersionUndefined),
+		SnapshotStatusSpec{},
+	)
+}
+
+// SnapshotStatusExtension provides auxiliary methods for SnapshotStatus.
+type SnapshotStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (SnapshotStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             SnapshotStatusType,
+		Aliases:          []resource.Type{"etcdsnapshot"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Last Run",
+				JSONPath: "{.lastRun}",
+			},
+			{
+				Name:     "Size",
+				JSONPath: "{.size}",
+			},
+			{
+				Name:     "Upload",
+				JSONPath: "{.uploadLocation}",
+			},
+			{
+				Name:     "Error",
+				JSONPath: "{.lastError}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[SnapshotStatusSpec](SnapshotStatusType, &SnapshotStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
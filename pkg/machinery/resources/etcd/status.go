@@ -0,0 +1,105 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd
+
+import (
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// StatusType is type of Status resource.
+const StatusType = resource.Type("EtcdStatuses.etcd.talos.dev")
+
+// Status resource holds the alarms and the database quota usage of the local etcd member.
+//
+// Resource ID is always LocalMemberID.
+type Status = typed.Resource[StatusSpec, StatusExtension]
+
+// Etcd alarm types.
+const (
+	AlarmTypeNoSpace = "NOSPACE"
+	AlarmTypeCorrupt = "CORRUPT"
+)
+
+// StatusSpec describes the alarms and the database quota usage of the local etcd member.
+//
+//gotagsrewrite:gen
+type StatusSpec struct {
+	MemberID string `yaml:"memberID" protobuf:"1"`
+	// Database size, and the database size in use (in bytes).
+	DBSize      int64 `yaml:"dbSize" protobuf:"2"`
+	DBSizeInUse int64 `yaml:"dbSizeInUse" protobuf:"3"`
+	// Backend quota of the database (in bytes).
+	DBSizeQuota int64 `yaml:"dbSizeQuota" protobuf:"4"`
+	// Active alarms of the cluster members.
+	Alarms []Alarm `yaml:"alarms,omitempty" protobuf:"5"`
+}
+
+// Alarm describes an active etcd alarm.
+//
+//gotagsrewrite:gen
+type Alarm struct {
+	Type     string    `yaml:"type" protobuf:"1"`
+	MemberID string    `yaml:"memberID" protobuf:"2"`
+	Since    time.Time `yaml:"since" protobuf:"3"`
+}
+
+// QuotaUsage returns the database quota usage in percent.
+func (spec *StatusSpec) QuotaUsage() int {
+	if spec.DBSizeQuota <= 0 {
+		return 0
+	}
+
+	return int(spec.DBSize * 100 / spec.DBSizeQuota)
+}
+
+// NewStatus initializes a Status resource.
+func NewStatus(namespace resource.Namespace, id resource.ID) *Status {
+	return typed.NewResource[StatusSpec, StatusExtension](
+		resource.NewMetadata(namespace, StatusType, id, resource.VersionUndefined),
+		StatusSpec{},
+	)
+}
+
+// StatusExtension provides auxiliary methods for Status.
+type StatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (StatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             StatusType,
+		Aliases:          []resource.Type{"etcdstatus", "etcdstatuses"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "DB Size",
+				JSONPath: "{.dbSize}",
+			},
+			{
+				Name:     "Quota",
+				JSONPath: "{.dbSizeQuota}",
+			},
+			{
+				Name:     "Alarms",
+				JSONPath: "{.alarms[*].type}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[StatusSpec](StatusType, &Status{})
+	if err != nil {
+		panic(err)
+	}
+}
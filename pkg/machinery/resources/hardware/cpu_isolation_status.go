@@ -0,0 +1,87 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hardware
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// CPUIsolationStatusType is the type of the CPUIsolationStatus resource.
+const CPUIsolationStatusType = resource.Type("CPUIsolationStatuses.hardware.talos.dev")
+
+// CPUIsolationStatusID is the ID of the singleton CPUIsolationStatus resource.
+const CPUIsolationStatusID = resource.ID("cpu-isolation")
+
+// CPUIsolationStatus resource holds the effective CPU isolation.
+type CPUIsolationStatus = typed.Resource[CPUIsolationStatusSpec, CPUIsolationStatusExtension]
+
+// CPUIsolationStatusSpec describes the effective CPU isolation.
+//
+// CPU sets are in the Linux kernel CPU list format.
+//
+//gotagsrewrite:gen
+type CPUIsolationStatusSpec struct {
+	// ConfiguredCPUs is the list of CPUs to isolate from the machine configuration.
+	ConfiguredCPUs string `yaml:"configuredCPUs,omitempty" protobuf:"1"`
+	// IsolatedCPUs is the list of CPUs isolated by the running kernel.
+	IsolatedCPUs string `yaml:"isolatedCPUs,omitempty" protobuf:"2"`
+	// NoHZFullCPUs is the list of adaptive-tick CPUs of the running kernel.
+	NoHZFullCPUs string `yaml:"nohzFullCPUs,omitempty" protobuf:"3"`
+	// HousekeepingCPUs is the list of CPUs IRQs are steered to.
+	HousekeepingCPUs string `yaml:"housekeepingCPUs,omitempty" protobuf:"4"`
+	// DefaultIRQAffinity is the default IRQ affinity mask.
+	DefaultIRQAffinity string `yaml:"defaultIRQAffinity,omitempty" protobuf:"5"`
+	// KernelArgsPending is set when the running kernel doesn't match the configuration,
+	// and an upgrade is required to apply the kernel arguments.
+	KernelArgsPending bool `yaml:"kernelArgsPending" protobuf:"6"`
+}
+
+// NewCPUIsolationStatus initializes a CPUIsolationStatus resource.
+func NewCPUIsolationStatus() *CPUIsolationStatus {
+	return typed.NewResource[CPUIsolationStatusSpec, CPUIsolationStatusExtension](
+		resource.NewMetadata(NamespaceName, CPUIsolationStatusType, CPUIsolationStatusID, resource.VersionUndefined),
+		CPUIsolationStatusSpec{},
+	)
+}
+
+// CPUIsolationStatusExtension is auxiliary resource data for CPUIsolationStatus.
+type CPUIsolationStatusExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (CPUIsolationStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             CPUIsolationStatusType,
+		Aliases:          []resource.Type{"cpuisolation"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Isolated",
+				JSONPath: `{.isolatedCPUs}`,
+			},
+			{
+				Name:     "Housekeeping",
+				JSONPath: `{.housekeepingCPUs}`,
+			},
+			{
+				Name:     "Pending",
+				JSONPath: `{.kernelArgsPending}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[CPUIsolationStatusSpec](CPUIsolationStatusType, &CPUIsolationStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -2,10 +2,16 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type MemoryModuleSpec -type PCIDeviceSpec -type PCIDriverRebindConfigSpec -type PCIDriverRebindStatusSpec -type PCRStatusSpec -type ProcessorSpec -type SystemInformationSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type CPUIsolationStatusSpec -type MemoryModuleSpec -type PCIDeviceSpec -type PCIDriverRebindConfigSpec -type PCIDriverRebindStatusSpec -type PCRStatusSpec -type ProcessorSpec -type SystemInformationSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package hardware
 
+// DeepCopy generates a deep copy of CPUIsolationStatusSpec.
+func (o CPUIsolationStatusSpec) DeepCopy() CPUIsolationStatusSpec {
+	var cp CPUIsolationStatusSpec = o
+	return cp
+}
+
 // DeepCopy generates a deep copy of MemoryModuleSpec.
 func (o MemoryModuleSpec) DeepCopy() MemoryModuleSpec {
 	var cp MemoryModuleSpec = o
@@ -28,6 +28,17 @@ type AuditPolicyConfig = typed.Resource[AuditPolicyConfigSpec, AuditPolicyConfig
 //gotagsrewrite:gen
 type AuditPolicyConfigSpec struct {
 	Config map[string]any `yaml:"config" protobuf:"1"`
+
+	// Log backend, disabled if LogPath is empty.
+	LogPath      string `yaml:"logPath" protobuf:"2"`
+	LogMaxAge    int    `yaml:"logMaxAge" protobuf:"3"`
+	LogMaxBackup int    `yaml:"logMaxBackup" protobuf:"4"`
+	LogMaxSize   int    `yaml:"logMaxSize" protobuf:"5"`
+
+	// Webhook backend, disabled if WebhookURL is empty.
+	WebhookURL           string `yaml:"webhookURL" protobuf:"6"`
+	WebhookCACertificate string `yaml:"webhookCACertificate" protobuf:"7"`
+	WebhookToken         string `yaml:"webhookToken" protobuf:"8"`
 }
 
 // NewAuditPolicyConfig returns new AuditPolicyConfig resource.
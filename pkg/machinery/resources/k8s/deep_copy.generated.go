@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type AdmissionControlConfigSpec -type APIServerConfigSpec -type AuditPolicyConfigSpec -type AuthorizationConfigSpec -type BootstrapManifestsConfigSpec -type ConfigStatusSpec -type ControllerManagerConfigSpec -type EndpointSpec -type ExtraManifestsConfigSpec -type KubeletLifecycleSpec -type KubePrismConfigSpec -type KubePrismEndpointsSpec -type KubePrismStatusesSpec -type KubeletSpecSpec -type ManifestSpec -type ManifestStatusSpec -type NodeAnnotationSpecSpec -type NodeCordonedSpecSpec -type NodeLabelSpecSpec -type NodeTaintSpecSpec -type KubeletConfigSpec -type NodeIPSpec -type NodeIPConfigSpec -type NodeStatusSpec -type NodenameSpec -type SchedulerConfigSpec -type SecretsStatusSpec -type StaticPodSpec -type StaticPodStatusSpec -type StaticPodServerStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type AdmissionControlConfigSpec -type APIServerConfigSpec -type AuditPolicyConfigSpec -type AuthorizationConfigSpec -type BootstrapManifestsConfigSpec -type ConfigStatusSpec -type ControllerManagerConfigSpec -type EndpointSpec -type ExtraManifestsConfigSpec -type KubeletLifecycleSpec -type KubePrismConfigSpec -type KubePrismEndpointsSpec -type KubePrismStatusesSpec -type KubeletSpecSpec -type KubernetesCertSANStatusSpec -type ManifestSpec -type ManifestStatusSpec -type NodeAnnotationSpecSpec -type NodeCordonedSpecSpec -type NodeLabelSpecSpec -type NodeTaintSpecSpec -type KubeletConfigSpec -type NodeIPSpec -type NodeIPConfigSpec -type NodeStatusSpec -type NodenameSpec -type SchedulerConfigSpec -type SecretsStatusSpec -type StaticPodSpec -type StaticPodRuntimeStatusSpec -type StaticPodStatusSpec -type StaticPodServerStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package k8s
 
@@ -117,6 +117,32 @@ func (o BootstrapManifestsConfigSpec) DeepCopy() BootstrapManifestsConfigSpec {
 		cp.FlannelExtraArgs = make([]string, len(o.FlannelExtraArgs))
 		copy(cp.FlannelExtraArgs, o.FlannelExtraArgs)
 	}
+	if o.ObjectPolicies != nil {
+		cp.ObjectPolicies = make([]BootstrapManifestObjectPolicy, len(o.ObjectPolicies))
+		copy(cp.ObjectPolicies, o.ObjectPolicies)
+	}
+	if o.CoreDNSStubDomains != nil {
+		cp.CoreDNSStubDomains = make([]CoreDNSStubDomain, len(o.CoreDNSStubDomains))
+		copy(cp.CoreDNSStubDomains, o.CoreDNSStubDomains)
+		for i2 := range o.CoreDNSStubDomains {
+			if o.CoreDNSStubDomains[i2].Upstreams != nil {
+				cp.CoreDNSStubDomains[i2].Upstreams = make([]string, len(o.CoreDNSStubDomains[i2].Upstreams))
+				copy(cp.CoreDNSStubDomains[i2].Upstreams, o.CoreDNSStubDomains[i2].Upstreams)
+			}
+		}
+	}
+	if o.CoreDNSResources.Requests != nil {
+		cp.CoreDNSResources.Requests = make(map[string]string, len(o.CoreDNSResources.Requests))
+		for k2, v2 := range o.CoreDNSResources.Requests {
+			cp.CoreDNSResources.Requests[k2] = v2
+		}
+	}
+	if o.CoreDNSResources.Limits != nil {
+		cp.CoreDNSResources.Limits = make(map[string]string, len(o.CoreDNSResources.Limits))
+		for k2, v2 := range o.CoreDNSResources.Limits {
+			cp.CoreDNSResources.Limits[k2] = v2
+		}
+	}
 	return cp
 }
 
@@ -268,6 +294,20 @@ func (o KubeletSpecSpec) DeepCopy() KubeletSpecSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of KubernetesCertSANStatusSpec.
+func (o KubernetesCertSANStatusSpec) DeepCopy() KubernetesCertSANStatusSpec {
+	var cp KubernetesCertSANStatusSpec = o
+	if o.DNSNames != nil {
+		cp.DNSNames = make([]string, len(o.DNSNames))
+		copy(cp.DNSNames, o.DNSNames)
+	}
+	if o.IPs != nil {
+		cp.IPs = make([]netip.Addr, len(o.IPs))
+		copy(cp.IPs, o.IPs)
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of ManifestSpec.
 func (o ManifestSpec) DeepCopy() ManifestSpec {
 	var cp ManifestSpec = o
@@ -472,6 +512,12 @@ func (o StaticPodSpec) DeepCopy() StaticPodSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of StaticPodRuntimeStatusSpec.
+func (o StaticPodRuntimeStatusSpec) DeepCopy() StaticPodRuntimeStatusSpec {
+	var cp StaticPodRuntimeStatusSpec = o
+	return cp
+}
+
 // DeepCopy generates a deep copy of StaticPodStatusSpec.
 func (o StaticPodStatusSpec) DeepCopy() StaticPodStatusSpec {
 	var cp StaticPodStatusSpec = o
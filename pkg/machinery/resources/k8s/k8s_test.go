@@ -39,6 +39,7 @@ func TestRegisterResource(t *testing.T) {
 		&k8s.KubeletLifecycle{},
 		&k8s.KubeletSpec{},
 		&k8s.KubePrismStatuses{},
+		&k8s.KubernetesCertSANStatus{},
 		&k8s.KubePrismConfig{},
 		&k8s.ManifestStatus{},
 		&k8s.Manifest{},
@@ -52,6 +53,7 @@ func TestRegisterResource(t *testing.T) {
 		&k8s.NodeIPConfig{},
 		&k8s.SchedulerConfig{},
 		&k8s.SecretsStatus{},
+		&k8s.StaticPodRuntimeStatus{},
 		&k8s.StaticPodStatus{},
 		&k8s.StaticPod{},
 	} {
@@ -5,6 +5,8 @@
 package k8s
 
 import (
+	"time"
+
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
 	"github.com/cosi-project/runtime/pkg/resource/protobuf"
@@ -41,6 +43,9 @@ type KubeletConfigSpec struct {
 	EnableFSQuotaMonitoring       bool              `yaml:"enableFSQuotaMonitoring" protobuf:"12"`
 	CredentialProviderConfig      map[string]any    `yaml:"credentialProviderConfig,omitempty" protobuf:"13"`
 	AllowSchedulingOnControlPlane bool              `yaml:"allowSchedulingOnControlPlane" protobuf:"14"`
+
+	ShutdownGracePeriod             time.Duration `yaml:"shutdownGracePeriod,omitempty" protobuf:"15"`
+	ShutdownGracePeriodCriticalPods time.Duration `yaml:"shutdownGracePeriodCriticalPods,omitempty" protobuf:"16"`
 }
 
 // NewKubeletConfig initializes an empty KubeletConfig resource.
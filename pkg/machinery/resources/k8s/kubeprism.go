@@ -36,6 +36,9 @@ func NewKubePrismStatuses(namespace resource.Namespace, id resource.ID) *KubePri
 type KubePrismStatusesSpec struct {
 	Host    string `yaml:"host" protobuf:"1"`
 	Healthy bool   `yaml:"healthy" protobuf:"2"`
+	// ActiveEndpoint is the endpoint the connections are forwarded to in the failover only mode.
+	ActiveEndpoint string `yaml:"activeEndpoint,omitempty" protobuf:"3"`
+	FailedOver     bool   `yaml:"failedOver,omitempty" protobuf:"4"`
 }
 
 // KubePrismStatusesExtension provides auxiliary methods for KubePrismStatuses.
@@ -56,6 +59,10 @@ func (KubePrismStatusesExtension) ResourceDefinition() meta.ResourceDefinitionSp
 				Name:     "HEALTHY",
 				JSONPath: "{.healthy}",
 			},
+			{
+				Name:     "ACTIVE",
+				JSONPath: "{.activeEndpoint}",
+			},
 		},
 	}
 }
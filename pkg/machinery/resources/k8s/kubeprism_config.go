@@ -37,6 +37,9 @@ type KubePrismConfigSpec struct {
 	Host      string              `yaml:"host" protobuf:"1"`
 	Port      int                 `yaml:"port" protobuf:"2"`
 	Endpoints []KubePrismEndpoint `yaml:"endpoints" protobuf:"3"`
+	// FailoverOnly mode uses the primary endpoint exclusively while it is healthy.
+	FailoverOnly    bool              `yaml:"failoverOnly,omitempty" protobuf:"4"`
+	PrimaryEndpoint KubePrismEndpoint `yaml:"primaryEndpoint,omitempty" protobuf:"5"`
 }
 
 // KubePrismConfigExtension provides auxiliary methods for KubePrismConfig.
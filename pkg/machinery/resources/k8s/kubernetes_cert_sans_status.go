@@ -0,0 +1,71 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// KubernetesCertSANStatusType is type of KubernetesCertSANStatus resource.
+const KubernetesCertSANStatusType = resource.Type("KubernetesCertSANStatuses.kubernetes.talos.dev")
+
+// KubernetesCertSANStatus resource holds the SANs of the kube-apiserver serving certificate rendered to disk.
+//
+// Resource ID is the static pod ID (kube-apiserver).
+type KubernetesCertSANStatus = typed.Resource[KubernetesCertSANStatusSpec, KubernetesCertSANStatusExtension]
+
+// KubernetesCertSANStatusSpec describes the SANs of the active kube-apiserver serving certificate.
+//
+//gotagsrewrite:gen
+type KubernetesCertSANStatusSpec struct {
+	DNSNames []string     `yaml:"dnsNames" protobuf:"1"`
+	IPs      []netip.Addr `yaml:"ips" protobuf:"2"`
+}
+
+// NewKubernetesCertSANStatus initializes a KubernetesCertSANStatus resource.
+func NewKubernetesCertSANStatus(namespace resource.Namespace, id resource.ID) *KubernetesCertSANStatus {
+	return typed.NewResource[KubernetesCertSANStatusSpec, KubernetesCertSANStatusExtension](
+		resource.NewMetadata(namespace, KubernetesCertSANStatusType, id, resource.VersionUndefined),
+		KubernetesCertSANStatusSpec{},
+	)
+}
+
+// KubernetesCertSANStatusExtension provides auxiliary methods for KubernetesCertSANStatus.
+type KubernetesCertSANStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (KubernetesCertSANStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             KubernetesCertSANStatusType,
+		Aliases:          []resource.Type{"kubernetescertsan", "kubernetescertsans"},
+		DefaultNamespace: ControlPlaneNamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "DNS Names",
+				JSONPath: "{.dnsNames}",
+			},
+			{
+				Name:     "IPs",
+				JSONPath: "{.ips}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[KubernetesCertSANStatusSpec](KubernetesCertSANStatusType, &KubernetesCertSANStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -51,6 +51,62 @@ type BootstrapManifestsConfigSpec struct {
 	PodSecurityPolicyEnabled bool `yaml:"podSecurityPolicyEnabled" protobuf:"14"`
 
 	TalosAPIServiceEnabled bool `yaml:"talosAPIServiceEnabled" protobuf:"15"`
+
+	ObjectPolicies []BootstrapManifestObjectPolicy `yaml:"objectPolicies,omitempty" protobuf:"19"`
+
+	CoreDNSOverridesEnabled  bool                `yaml:"coreDNSOverridesEnabled,omitempty" protobuf:"20"`
+	CoreDNSStubDomains       []CoreDNSStubDomain `yaml:"coreDNSStubDomains,omitempty" protobuf:"21"`
+	CoreDNSExtraServerBlocks string              `yaml:"coreDNSExtraServerBlocks,omitempty" protobuf:"22"`
+	CoreDNSReplicas          int                 `yaml:"coreDNSReplicas,omitempty" protobuf:"23"`
+	CoreDNSResources         Resources           `yaml:"coreDNSResources,omitempty" protobuf:"24"`
+}
+
+// CoreDNSStubDomain is a CoreDNS stub domain forwarded to the upstream servers.
+//
+//gotagsrewrite:gen
+type CoreDNSStubDomain struct {
+	Domain    string   `yaml:"domain" protobuf:"1"`
+	Upstreams []string `yaml:"upstreams" protobuf:"2"`
+}
+
+// BootstrapManifestObjectPolicy is a synchronization policy for the bootstrap manifest objects.
+//
+// Empty selector fields match any value.
+//
+//gotagsrewrite:gen
+type BootstrapManifestObjectPolicy struct {
+	Manifest  string `yaml:"manifest,omitempty" protobuf:"1"`
+	Kind      string `yaml:"kind,omitempty" protobuf:"2"`
+	Namespace string `yaml:"namespace,omitempty" protobuf:"3"`
+	Name      string `yaml:"name,omitempty" protobuf:"4"`
+	Policy    string `yaml:"policy" protobuf:"5"`
+}
+
+// Matches checks whether the policy applies to the object of the manifest.
+func (p BootstrapManifestObjectPolicy) Matches(manifest, kind, namespace, name string) bool {
+	for _, selector := range [][2]string{
+		{p.Manifest, manifest},
+		{p.Kind, kind},
+		{p.Namespace, namespace},
+		{p.Name, name},
+	} {
+		if selector[0] != "" && selector[0] != selector[1] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ObjectPolicy returns the policy of the first matching object policy, or empty string if none matches.
+func (spec *BootstrapManifestsConfigSpec) ObjectPolicy(manifest, kind, namespace, name string) string {
+	for _, policy := range spec.ObjectPolicies {
+		if policy.Matches(manifest, kind, namespace, name) {
+			return policy.Policy
+		}
+	}
+
+	return ""
 }
 
 // NewBootstrapManifestsConfig returns new BootstrapManifestsConfig resource.
@@ -0,0 +1,93 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// StaticPodRuntimeStatusType is type of StaticPodRuntimeStatus resource.
+const StaticPodRuntimeStatusType = resource.Type("StaticPodRuntimeStatuses.kubernetes.talos.dev")
+
+// StaticPodRuntimeStatus resource holds the container runtime state of the control plane static pod.
+//
+// Resource ID is the static pod ID (e.g. kube-apiserver).
+type StaticPodRuntimeStatus = typed.Resource[StaticPodRuntimeStatusSpec, StaticPodRuntimeStatusExtension]
+
+// Static pod runtime phases.
+const (
+	StaticPodPhaseRunning = "Running"
+	StaticPodPhaseCreated = "Created"
+	StaticPodPhaseExited  = "Exited"
+	StaticPodPhaseUnknown = "Unknown"
+)
+
+// StaticPodRuntimeStatusSpec describes the container runtime state of the static pod.
+//
+//gotagsrewrite:gen
+type StaticPodRuntimeStatusSpec struct {
+	PodName          string `yaml:"podName" protobuf:"1"`
+	Phase            string `yaml:"phase" protobuf:"2"`
+	Ready            bool   `yaml:"ready" protobuf:"3"`
+	RestartCount     int    `yaml:"restartCount" protobuf:"4"`
+	RestartStorm     bool   `yaml:"restartStorm" protobuf:"5"`
+	LastExitCode     int    `yaml:"lastExitCode" protobuf:"6"`
+	LastExitReason   string `yaml:"lastExitReason,omitempty" protobuf:"7"`
+	LastProbeFailure string `yaml:"lastProbeFailure,omitempty" protobuf:"8"`
+	Image            string `yaml:"image" protobuf:"9"`
+	ImageRef         string `yaml:"imageRef" protobuf:"10"`
+}
+
+// NewStaticPodRuntimeStatus initializes a StaticPodRuntimeStatus resource.
+func NewStaticPodRuntimeStatus(namespace resource.Namespace, id resource.ID) *StaticPodRuntimeStatus {
+	return typed.NewResource[StaticPodRuntimeStatusSpec, StaticPodRuntimeStatusExtension](
+		resource.NewMetadata(namespace, StaticPodRuntimeStatusType, id, resource.VersionUndefined),
+		StaticPodRuntimeStatusSpec{},
+	)
+}
+
+// StaticPodRuntimeStatusExtension provides auxiliary methods for StaticPodRuntimeStatus.
+type StaticPodRuntimeStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (StaticPodRuntimeStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             StaticPodRuntimeStatusType,
+		Aliases:          []resource.Type{"podruntimestatus", "podruntimestatuses"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Phase",
+				JSONPath: `{.phase}`,
+			},
+			{
+				Name:     "Ready",
+				JSONPath: `{.ready}`,
+			},
+			{
+				Name:     "Restarts",
+				JSONPath: `{.restartCount}`,
+			},
+			{
+				Name:     "Image",
+				JSONPath: `{.image}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[StaticPodRuntimeStatusSpec](StaticPodRuntimeStatusType, &StaticPodRuntimeStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -6,6 +6,7 @@ package kubespan
 
 import (
 	"net/netip"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
@@ -46,6 +47,41 @@ type ConfigSpec struct {
 	HarvestExtraEndpoints bool `yaml:"harvestExtraEndpoints" protobuf:"8"`
 	// Extra endpoints to announce.
 	ExtraEndpoints []netip.AddrPort `yaml:"extraEndpoints,omitempty" protobuf:"9"`
+	// Filters applied to the peer endpoints during endpoint selection.
+	PeerEndpointFilters []string `yaml:"peerEndpointFilters,omitempty" protobuf:"10"`
+	// Persistent keepalive interval for the peers.
+	PersistentKeepaliveInterval time.Duration `yaml:"persistentKeepaliveInterval" protobuf:"11"`
+	// Send persistent keepalive only to the peers behind NAT.
+	PersistentKeepaliveNATOnly bool `yaml:"persistentKeepaliveNATOnly,omitempty" protobuf:"12"`
+	// Persistent keepalive overrides for the matching peers.
+	PersistentKeepaliveOverrides []KeepaliveOverride `yaml:"persistentKeepaliveOverrides,omitempty" protobuf:"13"`
+	// Extra endpoints specified as hostnames (hostname:port) to resolve and announce.
+	ExtraHostnameEndpoints []string `yaml:"extraHostnameEndpoints,omitempty" protobuf:"14"`
+}
+
+// KeepaliveOverride describes persistent keepalive override for a set of peers.
+//
+//gotagsrewrite:gen
+type KeepaliveOverride struct {
+	// Peer label to match, if set.
+	Label string `yaml:"label,omitempty" protobuf:"1"`
+	// Peer endpoint CIDR to match, if set.
+	CIDR netip.Prefix `yaml:"cidr,omitempty" protobuf:"2"`
+	// Persistent keepalive interval, zero disables the keepalive.
+	Interval time.Duration `yaml:"interval" protobuf:"3"`
+}
+
+// Matches checks if the override applies to the peer with the given label and endpoint.
+func (o KeepaliveOverride) Matches(label string, endpoint netip.AddrPort) bool {
+	if o.Label != "" && o.Label != label {
+		return false
+	}
+
+	if o.CIDR.IsValid() && (!endpoint.IsValid() || !o.CIDR.Contains(endpoint.Addr())) {
+		return false
+	}
+
+	return o.Label != "" || o.CIDR.IsValid()
 }
 
 // NewConfig initializes a Config resource.
@@ -21,6 +21,18 @@ func (o ConfigSpec) DeepCopy() ConfigSpec {
 		cp.ExtraEndpoints = make([]netip.AddrPort, len(o.ExtraEndpoints))
 		copy(cp.ExtraEndpoints, o.ExtraEndpoints)
 	}
+	if o.PeerEndpointFilters != nil {
+		cp.PeerEndpointFilters = make([]string, len(o.PeerEndpointFilters))
+		copy(cp.PeerEndpointFilters, o.PeerEndpointFilters)
+	}
+	if o.PersistentKeepaliveOverrides != nil {
+		cp.PersistentKeepaliveOverrides = make([]KeepaliveOverride, len(o.PersistentKeepaliveOverrides))
+		copy(cp.PersistentKeepaliveOverrides, o.PersistentKeepaliveOverrides)
+	}
+	if o.ExtraHostnameEndpoints != nil {
+		cp.ExtraHostnameEndpoints = make([]string, len(o.ExtraHostnameEndpoints))
+		copy(cp.ExtraHostnameEndpoints, o.ExtraHostnameEndpoints)
+	}
 	return cp
 }
 
@@ -53,5 +65,9 @@ func (o PeerSpecSpec) DeepCopy() PeerSpecSpec {
 // DeepCopy generates a deep copy of PeerStatusSpec.
 func (o PeerStatusSpec) DeepCopy() PeerStatusSpec {
 	var cp PeerStatusSpec = o
+	if o.FilteredEndpoints != nil {
+		cp.FilteredEndpoints = make([]netip.AddrPort, len(o.FilteredEndpoints))
+		copy(cp.FilteredEndpoints, o.FilteredEndpoints)
+	}
 	return cp
 }
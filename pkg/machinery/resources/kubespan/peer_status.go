@@ -42,6 +42,12 @@ type PeerStatusSpec struct {
 	// Endpoint selection input.
 	LastUsedEndpoint   netip.AddrPort `yaml:"lastUsedEndpoint" protobuf:"7"`
 	LastEndpointChange time.Time      `yaml:"lastEndpointChange" protobuf:"8"`
+	// Peer endpoints skipped by the endpoint filters.
+	FilteredEndpoints []netip.AddrPort `yaml:"filteredEndpoints,omitempty" protobuf:"9"`
+	// Effective persistent keepalive interval.
+	PersistentKeepaliveInterval time.Duration `yaml:"persistentKeepaliveInterval" protobuf:"10"`
+	// Peer endpoint doesn't match any of the advertised endpoints.
+	BehindNAT bool `yaml:"behindNAT,omitempty" protobuf:"11"`
 }
 
 // NewPeerStatus initializes a PeerStatus resource.
@@ -74,6 +80,14 @@ func (PeerStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
 				Name:     "State",
 				JSONPath: `{.state}`,
 			},
+			{
+				Name:     "Last Handshake",
+				JSONPath: `{.lastHandshakeTime}`,
+			},
+			{
+				Name:     "Last Used Endpoint",
+				JSONPath: `{.lastUsedEndpoint}`,
+			},
 			{
 				Name:     "Rx",
 				JSONPath: `{.receiveBytes}`,
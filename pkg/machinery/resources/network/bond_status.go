@@ -0,0 +1,114 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// BondStatusType is type of BondStatus resource.
+const BondStatusType = resource.Type("BondStatuses.net.talos.dev")
+
+// BondStatus resource holds the runtime state of the bond link.
+type BondStatus = typed.Resource[BondStatusSpec, BondStatusExtension]
+
+// BondStatusSpec describes the runtime state of the bond link.
+//
+//gotagsrewrite:gen
+type BondStatusSpec struct {
+	Mode        nethelpers.BondMode           `yaml:"mode" protobuf:"1"`
+	HashPolicy  nethelpers.BondXmitHashPolicy `yaml:"xmitHashPolicy" protobuf:"2"`
+	LACPRate    nethelpers.LACPRate           `yaml:"lacpRate" protobuf:"3"`
+	ActiveSlave string                        `yaml:"activeSlave,omitempty" protobuf:"4"`
+	// Following fields are only populated in 802.3ad mode.
+	AggregatorID uint16                  `yaml:"aggregatorID,omitempty" protobuf:"5"`
+	NumPorts     uint16                  `yaml:"numPorts,omitempty" protobuf:"6"`
+	ActorKey     uint16                  `yaml:"actorKey,omitempty" protobuf:"7"`
+	PartnerKey   uint16                  `yaml:"partnerKey,omitempty" protobuf:"8"`
+	PartnerMAC   nethelpers.HardwareAddr `yaml:"partnerMAC,omitempty" protobuf:"9"`
+	Slaves       []BondSlaveStatus       `yaml:"slaves" protobuf:"10"`
+	// Following fields are only populated if ARP/NS monitoring is configured.
+	ARPTargets []BondARPTargetStatus `yaml:"arpTargets,omitempty" protobuf:"11"`
+}
+
+// BondARPTargetStatus describes the reachability of the bond ARP/NS monitoring target.
+//
+//gotagsrewrite:gen
+type BondARPTargetStatus struct {
+	Address netip.Addr `yaml:"address" protobuf:"1"`
+	// State of the neighbor entry of the target (e.g. reachable, stale, failed).
+	State string `yaml:"state" protobuf:"2"`
+}
+
+// BondSlaveStatus describes the runtime state of the bond slave link.
+//
+//gotagsrewrite:gen
+type BondSlaveStatus struct {
+	Name             string                  `yaml:"name" protobuf:"1"`
+	State            string                  `yaml:"state" protobuf:"2"`
+	MIIStatus        string                  `yaml:"miiStatus" protobuf:"3"`
+	LinkFailureCount uint32                  `yaml:"linkFailureCount" protobuf:"4"`
+	PermanentAddr    nethelpers.HardwareAddr `yaml:"permanentAddr" protobuf:"5"`
+	// Following fields are only populated in 802.3ad mode.
+	AggregatorID         uint16 `yaml:"aggregatorID,omitempty" protobuf:"6"`
+	ActorOperPortState   uint8  `yaml:"actorOperPortState,omitempty" protobuf:"7"`
+	PartnerOperPortState uint16 `yaml:"partnerOperPortState,omitempty" protobuf:"8"`
+}
+
+// NewBondStatus initializes a BondStatus resource.
+func NewBondStatus(namespace resource.Namespace, id resource.ID) *BondStatus {
+	return typed.NewResource[BondStatusSpec, BondStatusExtension](
+		resource.NewMetadata(namespace, BondStatusType, id, resource.VersionUndefined),
+		BondStatusSpec{},
+	)
+}
+
+// BondStatusExtension provides auxiliary methods for BondStatus.
+type BondStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (BondStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             BondStatusType,
+		Aliases:          []resource.Type{"bond", "bonds"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Mode",
+				JSONPath: `{.mode}`,
+			},
+			{
+				Name:     "Active Slave",
+				JSONPath: `{.activeSlave}`,
+			},
+			{
+				Name:     "Aggregator",
+				JSONPath: `{.aggregatorID}`,
+			},
+			{
+				Name:     "Slaves",
+				JSONPath: `{.slaves[*].name}`,
+			},
+		},
+		Sensitivity: meta.NonSensitive,
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[BondStatusSpec](BondStatusType, &BondStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
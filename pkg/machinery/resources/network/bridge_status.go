@@ -0,0 +1,77 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// BridgeStatusType is type of BridgeStatus resource.
+const BridgeStatusType = resource.Type("BridgeStatuses.net.talos.dev")
+
+// BridgeStatus resource holds the runtime state of the bridge link.
+type BridgeStatus = typed.Resource[BridgeStatusSpec, BridgeStatusExtension]
+
+// BridgeStatusSpec describes the runtime state of the bridge link.
+//
+//gotagsrewrite:gen
+type BridgeStatusSpec struct {
+	VLANFiltering bool               `yaml:"vlanFiltering" protobuf:"1"`
+	Ports         []BridgePortStatus `yaml:"ports" protobuf:"2"`
+}
+
+// BridgePortStatus describes the runtime state of the bridge port.
+//
+//gotagsrewrite:gen
+type BridgePortStatus struct {
+	Name string `yaml:"name" protobuf:"1"`
+	// VLANs is the VLAN table programmed on the port.
+	VLANs []BridgeVLANEntry `yaml:"vlans,omitempty" protobuf:"2"`
+}
+
+// NewBridgeStatus initializes a BridgeStatus resource.
+func NewBridgeStatus(namespace resource.Namespace, id resource.ID) *BridgeStatus {
+	return typed.NewResource[BridgeStatusSpec, BridgeStatusExtension](
+		resource.NewMetadata(namespace, BridgeStatusType, id, resource.VersionUndefined),
+		BridgeStatusSpec{},
+	)
+}
+
+// BridgeStatusExtension provides auxiliary methods for BridgeStatus.
+type BridgeStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (BridgeStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             BridgeStatusType,
+		Aliases:          []resource.Type{"bridge", "bridges"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "VLAN Filtering",
+				JSONPath: `{.vlanFiltering}`,
+			},
+			{
+				Name:     "Ports",
+				JSONPath: `{.ports[*].name}`,
+			},
+		},
+		Sensitivity: meta.NonSensitive,
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[BridgeStatusSpec](BridgeStatusType, &BridgeStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -79,6 +79,11 @@ func EtcFilesReady(spec *StatusSpec) bool {
 	return spec.EtcFilesReady
 }
 
+// SRIOVReady checks if SR-IOV virtual functions are configured.
+func SRIOVReady(spec *StatusSpec) bool {
+	return spec.SRIOVReady
+}
+
 // StatusChecksFromStatuses converts nethelpers.Status list into list of checks.
 func StatusChecksFromStatuses(statuses ...nethelpers.Status) []StatusCheck {
 	checks := make([]StatusCheck, 0, len(statuses))
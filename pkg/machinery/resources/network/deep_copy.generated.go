@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type AddressSpecSpec -type AddressStatusSpec -type DNSResolveCacheSpec -type EthernetSpecSpec -type EthernetStatusSpec -type HardwareAddrSpec -type HostDNSConfigSpec -type HostnameSpecSpec -type HostnameStatusSpec -type LinkRefreshSpec -type LinkSpecSpec -type LinkStatusSpec -type NfTablesChainSpec -type NodeAddressSpec -type NodeAddressSortAlgorithmSpec -type NodeAddressFilterSpec -type OperatorSpecSpec -type PlatformConfigSpec -type ProbeSpecSpec -type ProbeStatusSpec -type ResolverSpecSpec -type ResolverStatusSpec -type RouteSpecSpec -type RouteStatusSpec -type StatusSpec -type TimeServerSpecSpec -type TimeServerStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type AddressSpecSpec -type AddressStatusSpec -type BondStatusSpec -type BridgeStatusSpec -type DHCP4LeaseSpec -type DNSResolveCacheSpec -type DefaultRouteStatusSpec -type EthernetSpecSpec -type EthernetStatusSpec -type HardwareAddrSpec -type HostDNSConfigSpec -type HostnameSpecSpec -type HostnameStatusSpec -type LLDPNeighborStatusSpec -type LinkRefreshSpec -type LinkSpecSpec -type LinkStatusSpec -type NfTablesChainSpec -type NodeAddressSpec -type NodeAddressSortAlgorithmSpec -type NodeAddressFilterSpec -type OperatorSpecSpec -type PlatformConfigSpec -type ProbeSpecSpec -type ProbeStatusSpec -type ResolverSpecSpec -type ResolverStatusSpec -type RouteRuleSpecSpec -type RouteRuleStatusSpec -type RouteSpecSpec -type RouteStatusSpec -type RouterStatusSpec -type SRIOVSpecSpec -type SRIOVStatusSpec -type StaticFallbackStatusSpec -type StatusSpec -type TimeServerSpecSpec -type TimeServerStatusSpec -type VIPStatusSpec -type WireguardEndpointStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package network
 
@@ -24,12 +24,72 @@ func (o AddressStatusSpec) DeepCopy() AddressStatusSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of BondStatusSpec.
+func (o BondStatusSpec) DeepCopy() BondStatusSpec {
+	var cp BondStatusSpec = o
+	if o.PartnerMAC != nil {
+		cp.PartnerMAC = make([]byte, len(o.PartnerMAC))
+		copy(cp.PartnerMAC, o.PartnerMAC)
+	}
+	if o.Slaves != nil {
+		cp.Slaves = make([]BondSlaveStatus, len(o.Slaves))
+		copy(cp.Slaves, o.Slaves)
+		for i2 := range o.Slaves {
+			if o.Slaves[i2].PermanentAddr != nil {
+				cp.Slaves[i2].PermanentAddr = make([]byte, len(o.Slaves[i2].PermanentAddr))
+				copy(cp.Slaves[i2].PermanentAddr, o.Slaves[i2].PermanentAddr)
+			}
+		}
+	}
+	if o.ARPTargets != nil {
+		cp.ARPTargets = make([]BondARPTargetStatus, len(o.ARPTargets))
+		copy(cp.ARPTargets, o.ARPTargets)
+	}
+	return cp
+}
+
+// DeepCopy generates a deep copy of BridgeStatusSpec.
+func (o BridgeStatusSpec) DeepCopy() BridgeStatusSpec {
+	var cp BridgeStatusSpec = o
+	if o.Ports != nil {
+		cp.Ports = make([]BridgePortStatus, len(o.Ports))
+		copy(cp.Ports, o.Ports)
+		for i2 := range o.Ports {
+			if o.Ports[i2].VLANs != nil {
+				cp.Ports[i2].VLANs = make([]BridgeVLANEntry, len(o.Ports[i2].VLANs))
+				copy(cp.Ports[i2].VLANs, o.Ports[i2].VLANs)
+			}
+		}
+	}
+	return cp
+}
+
+// DeepCopy generates a deep copy of DHCP4LeaseSpec.
+func (o DHCP4LeaseSpec) DeepCopy() DHCP4LeaseSpec {
+	var cp DHCP4LeaseSpec = o
+	if o.ACK != nil {
+		cp.ACK = make([]byte, len(o.ACK))
+		copy(cp.ACK, o.ACK)
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of DNSResolveCacheSpec.
 func (o DNSResolveCacheSpec) DeepCopy() DNSResolveCacheSpec {
 	var cp DNSResolveCacheSpec = o
 	return cp
 }
 
+// DeepCopy generates a deep copy of DefaultRouteStatusSpec.
+func (o DefaultRouteStatusSpec) DeepCopy() DefaultRouteStatusSpec {
+	var cp DefaultRouteStatusSpec = o
+	if o.Candidates != nil {
+		cp.Candidates = make([]string, len(o.Candidates))
+		copy(cp.Candidates, o.Candidates)
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of EthernetSpecSpec.
 func (o EthernetSpecSpec) DeepCopy() EthernetSpecSpec {
 	var cp EthernetSpecSpec = o
@@ -217,6 +277,10 @@ func (o EthernetStatusSpec) DeepCopy() EthernetStatusSpec {
 			*cp.Channels.Combined = *o.Channels.Combined
 		}
 	}
+	if o.ConfigErrors != nil {
+		cp.ConfigErrors = make([]EthernetConfigError, len(o.ConfigErrors))
+		copy(cp.ConfigErrors, o.ConfigErrors)
+	}
 	return cp
 }
 
@@ -237,6 +301,10 @@ func (o HostDNSConfigSpec) DeepCopy() HostDNSConfigSpec {
 		cp.ListenAddresses = make([]netip.AddrPort, len(o.ListenAddresses))
 		copy(cp.ListenAddresses, o.ListenAddresses)
 	}
+	if o.AllowedClients != nil {
+		cp.AllowedClients = make([]netip.Prefix, len(o.AllowedClients))
+		copy(cp.AllowedClients, o.AllowedClients)
+	}
 	return cp
 }
 
@@ -252,6 +320,12 @@ func (o HostnameStatusSpec) DeepCopy() HostnameStatusSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of LLDPNeighborStatusSpec.
+func (o LLDPNeighborStatusSpec) DeepCopy() LLDPNeighborStatusSpec {
+	var cp LLDPNeighborStatusSpec = o
+	return cp
+}
+
 // DeepCopy generates a deep copy of LinkRefreshSpec.
 func (o LinkRefreshSpec) DeepCopy() LinkRefreshSpec {
 	var cp LinkRefreshSpec = o
@@ -261,6 +335,18 @@ func (o LinkRefreshSpec) DeepCopy() LinkRefreshSpec {
 // DeepCopy generates a deep copy of LinkSpecSpec.
 func (o LinkSpecSpec) DeepCopy() LinkSpecSpec {
 	var cp LinkSpecSpec = o
+	if o.BondMaster.ARPIPTargets != nil {
+		cp.BondMaster.ARPIPTargets = make([]netip.Addr, len(o.BondMaster.ARPIPTargets))
+		copy(cp.BondMaster.ARPIPTargets, o.BondMaster.ARPIPTargets)
+	}
+	if o.BondMaster.NSIP6Targets != nil {
+		cp.BondMaster.NSIP6Targets = make([]netip.Addr, len(o.BondMaster.NSIP6Targets))
+		copy(cp.BondMaster.NSIP6Targets, o.BondMaster.NSIP6Targets)
+	}
+	if o.BridgeSlave.VLANs != nil {
+		cp.BridgeSlave.VLANs = make([]BridgeVLANEntry, len(o.BridgeSlave.VLANs))
+		copy(cp.BridgeSlave.VLANs, o.BridgeSlave.VLANs)
+	}
 	if o.Wireguard.Peers != nil {
 		cp.Wireguard.Peers = make([]WireguardPeer, len(o.Wireguard.Peers))
 		copy(cp.Wireguard.Peers, o.Wireguard.Peers)
@@ -293,6 +379,14 @@ func (o LinkStatusSpec) DeepCopy() LinkStatusSpec {
 		cp.BroadcastAddr = make([]byte, len(o.BroadcastAddr))
 		copy(cp.BroadcastAddr, o.BroadcastAddr)
 	}
+	if o.BondMaster.ARPIPTargets != nil {
+		cp.BondMaster.ARPIPTargets = make([]netip.Addr, len(o.BondMaster.ARPIPTargets))
+		copy(cp.BondMaster.ARPIPTargets, o.BondMaster.ARPIPTargets)
+	}
+	if o.BondMaster.NSIP6Targets != nil {
+		cp.BondMaster.NSIP6Targets = make([]netip.Addr, len(o.BondMaster.NSIP6Targets))
+		copy(cp.BondMaster.NSIP6Targets, o.BondMaster.NSIP6Targets)
+	}
 	if o.Wireguard.Peers != nil {
 		cp.Wireguard.Peers = make([]WireguardPeer, len(o.Wireguard.Peers))
 		copy(cp.Wireguard.Peers, o.Wireguard.Peers)
@@ -405,6 +499,10 @@ func (o NfTablesChainSpec) DeepCopy() NfTablesChainSpec {
 				cp.Rules[i2].SetMark = new(NfTablesMark)
 				*cp.Rules[i2].SetMark = *o.Rules[i2].SetMark
 			}
+			if o.Rules[i2].Log != nil {
+				cp.Rules[i2].Log = new(NfTablesLog)
+				*cp.Rules[i2].Log = *o.Rules[i2].Log
+			}
 			if o.Rules[i2].Verdict != nil {
 				cp.Rules[i2].Verdict = new(nethelpers.NfTablesVerdict)
 				*cp.Rules[i2].Verdict = *o.Rules[i2].Verdict
@@ -447,6 +545,24 @@ func (o NodeAddressFilterSpec) DeepCopy() NodeAddressFilterSpec {
 // DeepCopy generates a deep copy of OperatorSpecSpec.
 func (o OperatorSpecSpec) DeepCopy() OperatorSpecSpec {
 	var cp OperatorSpecSpec = o
+	if o.DHCP4.RequestedOptions != nil {
+		cp.DHCP4.RequestedOptions = make([]uint32, len(o.DHCP4.RequestedOptions))
+		copy(cp.DHCP4.RequestedOptions, o.DHCP4.RequestedOptions)
+	}
+	if o.StaticFallback.Addresses != nil {
+		cp.StaticFallback.Addresses = make([]AddressSpecSpec, len(o.StaticFallback.Addresses))
+		copy(cp.StaticFallback.Addresses, o.StaticFallback.Addresses)
+		for i3 := range o.StaticFallback.Addresses {
+			cp.StaticFallback.Addresses[i3] = o.StaticFallback.Addresses[i3].DeepCopy()
+		}
+	}
+	if o.StaticFallback.Routes != nil {
+		cp.StaticFallback.Routes = make([]RouteSpecSpec, len(o.StaticFallback.Routes))
+		copy(cp.StaticFallback.Routes, o.StaticFallback.Routes)
+		for i3 := range o.StaticFallback.Routes {
+			cp.StaticFallback.Routes[i3] = o.StaticFallback.Routes[i3].DeepCopy()
+		}
+	}
 	return cp
 }
 
@@ -560,6 +676,18 @@ func (o ResolverStatusSpec) DeepCopy() ResolverStatusSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of RouteRuleSpecSpec.
+func (o RouteRuleSpecSpec) DeepCopy() RouteRuleSpecSpec {
+	var cp RouteRuleSpecSpec = o
+	return cp
+}
+
+// DeepCopy generates a deep copy of RouteRuleStatusSpec.
+func (o RouteRuleStatusSpec) DeepCopy() RouteRuleStatusSpec {
+	var cp RouteRuleStatusSpec = o
+	return cp
+}
+
 // DeepCopy generates a deep copy of RouteSpecSpec.
 func (o RouteSpecSpec) DeepCopy() RouteSpecSpec {
 	var cp RouteSpecSpec = o
@@ -572,6 +700,66 @@ func (o RouteStatusSpec) DeepCopy() RouteStatusSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of RouterStatusSpec.
+func (o RouterStatusSpec) DeepCopy() RouterStatusSpec {
+	var cp RouterStatusSpec = o
+	if o.Routes != nil {
+		cp.Routes = make([]netip.Prefix, len(o.Routes))
+		copy(cp.Routes, o.Routes)
+	}
+	if o.DNSServers != nil {
+		cp.DNSServers = make([]netip.Addr, len(o.DNSServers))
+		copy(cp.DNSServers, o.DNSServers)
+	}
+	return cp
+}
+
+// DeepCopy generates a deep copy of SRIOVSpecSpec.
+func (o SRIOVSpecSpec) DeepCopy() SRIOVSpecSpec {
+	var cp SRIOVSpecSpec = o
+	if o.VFs != nil {
+		cp.VFs = make([]SRIOVVFSpec, len(o.VFs))
+		copy(cp.VFs, o.VFs)
+		for i2 := range o.VFs {
+			if o.VFs[i2].HardwareAddr != nil {
+				cp.VFs[i2].HardwareAddr = make([]byte, len(o.VFs[i2].HardwareAddr))
+				copy(cp.VFs[i2].HardwareAddr, o.VFs[i2].HardwareAddr)
+			}
+			if o.VFs[i2].Trust != nil {
+				cp.VFs[i2].Trust = new(bool)
+				*cp.VFs[i2].Trust = *o.VFs[i2].Trust
+			}
+			if o.VFs[i2].SpoofCheck != nil {
+				cp.VFs[i2].SpoofCheck = new(bool)
+				*cp.VFs[i2].SpoofCheck = *o.VFs[i2].SpoofCheck
+			}
+		}
+	}
+	return cp
+}
+
+// DeepCopy generates a deep copy of SRIOVStatusSpec.
+func (o SRIOVStatusSpec) DeepCopy() SRIOVStatusSpec {
+	var cp SRIOVStatusSpec = o
+	if o.VFs != nil {
+		cp.VFs = make([]SRIOVVFStatus, len(o.VFs))
+		copy(cp.VFs, o.VFs)
+		for i2 := range o.VFs {
+			if o.VFs[i2].HardwareAddr != nil {
+				cp.VFs[i2].HardwareAddr = make([]byte, len(o.VFs[i2].HardwareAddr))
+				copy(cp.VFs[i2].HardwareAddr, o.VFs[i2].HardwareAddr)
+			}
+		}
+	}
+	return cp
+}
+
+// DeepCopy generates a deep copy of StaticFallbackStatusSpec.
+func (o StaticFallbackStatusSpec) DeepCopy() StaticFallbackStatusSpec {
+	var cp StaticFallbackStatusSpec = o
+	return cp
+}
+
 // DeepCopy generates a deep copy of StatusSpec.
 func (o StatusSpec) DeepCopy() StatusSpec {
 	var cp StatusSpec = o
@@ -597,3 +785,15 @@ func (o TimeServerStatusSpec) DeepCopy() TimeServerStatusSpec {
 	}
 	return cp
 }
+
+// DeepCopy generates a deep copy of VIPStatusSpec.
+func (o VIPStatusSpec) DeepCopy() VIPStatusSpec {
+	var cp VIPStatusSpec = o
+	return cp
+}
+
+// DeepCopy generates a deep copy of WireguardEndpointStatusSpec.
+func (o WireguardEndpointStatusSpec) DeepCopy() WireguardEndpointStatusSpec {
+	var cp WireguardEndpointStatusSpec = o
+	return cp
+}
@@ -0,0 +1,75 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// DefaultRouteStatusType is type of DefaultRouteStatus resource.
+const DefaultRouteStatusType = resource.Type("DefaultRouteStatuses.net.talos.dev")
+
+// DefaultRouteStatus resource holds the link which owns the default route of the address family.
+//
+// Resource ID is the address family (inet4, inet6).
+type DefaultRouteStatus = typed.Resource[DefaultRouteStatusSpec, DefaultRouteStatusExtension]
+
+// DefaultRouteStatusSpec describes the link which owns the default route of the address family.
+//
+//gotagsrewrite:gen
+type DefaultRouteStatusSpec struct {
+	Family nethelpers.Family `yaml:"family" protobuf:"1"`
+	// Link which owns the default route, empty if none of the candidates is eligible.
+	LinkName string `yaml:"linkName" protobuf:"2"`
+	// Links which can own the default route, in the order of preference.
+	Candidates []string `yaml:"candidates" protobuf:"3"`
+	// Human-readable reason of the selection.
+	Reason string `yaml:"reason" protobuf:"4"`
+}
+
+// NewDefaultRouteStatus initializes a DefaultRouteStatus resource.
+func NewDefaultRouteStatus(namespace resource.Namespace, id resource.ID) *DefaultRouteStatus {
+	return typed.NewResource[DefaultRouteStatusSpec, DefaultRouteStatusExtension](
+		resource.NewMetadata(namespace, DefaultRouteStatusType, id, resource.VersionUndefined),
+		DefaultRouteStatusSpec{},
+	)
+}
+
+// DefaultRouteStatusExtension provides auxiliary methods for DefaultRouteStatus.
+type DefaultRouteStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (DefaultRouteStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             DefaultRouteStatusType,
+		Aliases:          []resource.Type{"defaultroute", "defaultroutes"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Link",
+				JSONPath: "{.linkName}",
+			},
+			{
+				Name:     "Reason",
+				JSONPath: "{.reason}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[DefaultRouteStatusSpec](DefaultRouteStatusType, &DefaultRouteStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
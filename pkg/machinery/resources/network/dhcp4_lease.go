@@ -0,0 +1,99 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"net/netip"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// DHCP4LeaseType is type of DHCP4Lease resource.
+const DHCP4LeaseType = resource.Type("DHCP4Leases.net.talos.dev")
+
+// DHCP4Lease resource holds a DHCPv4 lease acquired on a link.
+//
+// Leases are persisted in the STATE partition, and the lease cached from the previous boot
+// is loaded with the DHCP4LeaseCachedID.
+type DHCP4Lease = typed.Resource[DHCP4LeaseSpec, DHCP4LeaseExtension]
+
+// DHCP4LeaseSpec describes a DHCPv4 lease.
+//
+//gotagsrewrite:gen
+type DHCP4LeaseSpec struct {
+	LinkName  string        `yaml:"linkName" protobuf:"1"`
+	Address   netip.Prefix  `yaml:"address" protobuf:"2"`
+	ServerID  netip.Addr    `yaml:"serverID" protobuf:"3"`
+	LeaseTime time.Duration `yaml:"leaseTime" protobuf:"4"`
+	Expiry    time.Time     `yaml:"expiry" protobuf:"5"`
+	// Raw DHCP ACK packet, which holds all the options of the lease.
+	ACK []byte `yaml:"ack" protobuf:"6"`
+	// Client identifier (in hex format) and hostname sent to the DHCP server.
+	ClientIdentifier string `yaml:"clientIdentifier,omitempty" protobuf:"7"`
+	Hostname         string `yaml:"hostname,omitempty" protobuf:"8"`
+}
+
+// Expired returns true if the lease has expired at the given time.
+func (spec *DHCP4LeaseSpec) Expired(now time.Time) bool {
+	return !now.Before(spec.Expiry)
+}
+
+// DHCP4LeaseCachedID builds ID for the DHCP4Lease loaded from the cache.
+func DHCP4LeaseCachedID(linkName string) resource.ID {
+	return "cached/" + linkName
+}
+
+// NewDHCP4Lease initializes a DHCP4Lease resource.
+func NewDHCP4Lease(namespace resource.Namespace, id resource.ID) *DHCP4Lease {
+	return typed.NewResource[DHCP4LeaseSpec, DHCP4LeaseExtension](
+		resource.NewMetadata(namespace, DHCP4LeaseType, id, resource.VersionUndefined),
+		DHCP4LeaseSpec{},
+	)
+}
+
+// DHCP4LeaseExtension provides auxiliary methods for DHCP4Lease.
+type DHCP4LeaseExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (DHCP4LeaseExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             DHCP4LeaseType,
+		Aliases:          []resource.Type{"dhcp4lease", "dhcp4leases"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Link",
+				JSONPath: "{.linkName}",
+			},
+			{
+				Name:     "Address",
+				JSONPath: "{.address}",
+			},
+			{
+				Name:     "Server",
+				JSONPath: "{.serverID}",
+			},
+			{
+				Name:     "Expiry",
+				JSONPath: "{.expiry}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[DHCP4LeaseSpec](DHCP4LeaseType, &DHCP4Lease{})
+	if err != nil {
+		panic(err)
+	}
+}
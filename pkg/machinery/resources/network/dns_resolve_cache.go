@@ -24,6 +24,8 @@ type DNSResolveCache = typed.Resource[DNSResolveCacheSpec, DNSResolveCacheExtens
 //gotagsrewrite:gen
 type DNSResolveCacheSpec struct {
 	Status string `yaml:"status" protobuf:"1"`
+	// RejectedQueries is the number of queries refused due to the client not being allowed.
+	RejectedQueries uint64 `yaml:"rejectedQueries" protobuf:"2"`
 }
 
 // NewDNSResolveCache initializes a DNSResolveCache resource.
@@ -48,6 +50,10 @@ func (DNSResolveCacheExtension) ResourceDefinition() meta.ResourceDefinitionSpec
 				Name:     "Status",
 				JSONPath: "{.status}",
 			},
+			{
+				Name:     "Rejected",
+				JSONPath: "{.rejectedQueries}",
+			},
 		},
 	}
 }
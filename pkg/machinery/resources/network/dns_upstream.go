@@ -5,6 +5,7 @@
 package network
 
 import (
+	"slices"
 	"strconv"
 	"sync/atomic"
 	"time"
@@ -33,10 +34,35 @@ type DNSUpstreamSpecSpec struct {
 func (d DNSUpstreamSpecSpec) MarshalYAML() (any, error) {
 	d.Conn.Healthcheck()
 
-	return map[string]string{
+	res := map[string]any{
 		"healthy": strconv.FormatBool(d.Conn.Fails() == 0),
 		"addr":    d.Conn.Addr(),
-	}, nil
+		"queries": d.Conn.Queries(),
+		"errors":  d.Conn.Errors(),
+	}
+
+	if latency := d.Conn.Latency(); latency > 0 {
+		res["latency"] = latency.Round(time.Microsecond).String()
+	}
+
+	histogram := d.Conn.LatencyHistogram()
+	buckets := make([]map[string]any, 0, len(histogram))
+
+	for _, bucket := range histogram {
+		le := "+Inf"
+		if bucket.UpperBound > 0 {
+			le = bucket.UpperBound.String()
+		}
+
+		buckets = append(buckets, map[string]any{
+			"le":    le,
+			"count": bucket.Count,
+		})
+	}
+
+	res["latencyHistogram"] = buckets
+
+	return res, nil
 }
 
 // NewDNSUpstream initializes a DNSUpstream resource.
@@ -65,6 +91,18 @@ func (DNSUpstreamExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
 				Name:     "Address",
 				JSONPath: "{.addr}",
 			},
+			{
+				Name:     "Latency",
+				JSONPath: "{.latency}",
+			},
+			{
+				Name:     "Queries",
+				JSONPath: "{.queries}",
+			},
+			{
+				Name:     "Errors",
+				JSONPath: "{.errors}",
+			},
 		},
 	}
 }
@@ -79,9 +117,36 @@ type Proxy interface {
 	Start(time.Duration)
 }
 
+// DNSLatencyBuckets are the upper bounds of the DNS upstream latency histogram buckets.
+var DNSLatencyBuckets = [...]time.Duration{
+	time.Millisecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+}
+
+// DNSLatencyBucket is a cumulative latency histogram bucket.
+type DNSLatencyBucket struct {
+	// UpperBound of the bucket, zero for the last (unbounded) bucket.
+	UpperBound time.Duration
+	Count      uint64
+}
+
 // DNSConn is a wrapper around a Proxy.
 type DNSConn struct {
 	counter atomic.Int64
+	// latency is the smoothed round-trip time of the successful requests in nanoseconds.
+	latency atomic.Int64
+	queries atomic.Uint64
+	errors  atomic.Uint64
+	// histogram counts the successful requests by latency, the last bucket is for requests slower than the last DNSLatencyBuckets bound.
+	histogram [len(DNSLatencyBuckets) + 1]atomic.Uint64
 	// Proxy is essentially a *proxy.Proxy interface. It's here because we don't want machinery to depend on coredns.
 	// We could use a generic struct here, but without generic aliases the usage would look ugly.
 	// Once generic aliases are here, redo the type above as `type DNSUpstream[P Proxy] = typed.Resource[...]`.
@@ -105,6 +170,68 @@ func (u *DNSConn) Addr() string { return u.proxy.Addr() }
 // Fails returns the number of fails of the DNSConn.
 func (u *DNSConn) Fails() uint32 { return u.proxy.Fails() }
 
+// Latency returns the smoothed round-trip time of the DNSConn, zero if no requests were made yet.
+func (u *DNSConn) Latency() time.Duration { return time.Duration(u.latency.Load()) }
+
+// Queries returns the number of requests sent to the DNSConn.
+func (u *DNSConn) Queries() uint64 { return u.queries.Load() }
+
+// Errors returns the number of failed requests of the DNSConn.
+func (u *DNSConn) Errors() uint64 { return u.errors.Load() }
+
+// LatencyHistogram returns the cumulative latency histogram of the successful requests.
+func (u *DNSConn) LatencyHistogram() []DNSLatencyBucket {
+	res := make([]DNSLatencyBucket, 0, len(u.histogram))
+
+	var count uint64
+
+	for i := range u.histogram {
+		count += u.histogram[i].Load()
+
+		bucket := DNSLatencyBucket{Count: count}
+
+		if i < len(DNSLatencyBuckets) {
+			bucket.UpperBound = DNSLatencyBuckets[i]
+		}
+
+		res = append(res, bucket)
+	}
+
+	return res
+}
+
+// ObserveQuery records the result of a request, rtt is the round-trip time of a successful request.
+func (u *DNSConn) ObserveQuery(rtt time.Duration, err error) {
+	u.queries.Add(1)
+
+	if err != nil {
+		u.errors.Add(1)
+
+		return
+	}
+
+	idx, _ := slices.BinarySearch(DNSLatencyBuckets[:], rtt)
+	u.histogram[idx].Add(1)
+
+	u.observeLatency(rtt)
+}
+
+func (u *DNSConn) observeLatency(rtt time.Duration) {
+	for {
+		old := u.latency.Load()
+
+		smoothed := int64(rtt)
+		if old != 0 {
+			// exponentially weighted moving average, same weight as TCP SRTT
+			smoothed = old + (int64(rtt)-old)/8
+		}
+
+		if u.latency.CompareAndSwap(old, smoothed) {
+			return
+		}
+	}
+}
+
 // Proxy returns the Proxy field of the DNSConn.
 func (u *DNSConn) Proxy() Proxy { return u.proxy }
 
@@ -5,6 +5,11 @@
 package network
 
 import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
 	"github.com/cosi-project/runtime/pkg/resource/protobuf"
@@ -54,6 +59,80 @@ type EthernetChannelsSpec struct {
 	Combined *uint32 `yaml:"combined,omitempty" protobuf:"4"`
 }
 
+// ConfigErrors returns the settings of the spec which don't match the current link status.
+//
+//nolint:gocyclo
+func (spec *EthernetSpecSpec) ConfigErrors(status *EthernetStatusSpec) []EthernetConfigError {
+	var (
+		errs     []EthernetConfigError
+		rings    EthernetRingsStatus
+		channels EthernetChannelsStatus
+	)
+
+	if status.Rings != nil {
+		rings = *status.Rings
+	}
+
+	if status.Channels != nil {
+		channels = *status.Channels
+	}
+
+	errs = appendMismatch(errs, "rings.rx", spec.Rings.RX, rings.RX)
+	errs = appendMismatch(errs, "rings.tx", spec.Rings.TX, rings.TX)
+	errs = appendMismatch(errs, "rings.rx-mini", spec.Rings.RXMini, rings.RXMini)
+	errs = appendMismatch(errs, "rings.rx-jumbo", spec.Rings.RXJumbo, rings.RXJumbo)
+	errs = appendMismatch(errs, "rings.rx-buf-len", spec.Rings.RXBufLen, rings.RXBufLen)
+	errs = appendMismatch(errs, "rings.cqe-size", spec.Rings.CQESize, rings.CQESize)
+	errs = appendMismatch(errs, "rings.tx-push", spec.Rings.TXPush, rings.TXPush)
+	errs = appendMismatch(errs, "rings.rx-push", spec.Rings.RXPush, rings.RXPush)
+	errs = appendMismatch(errs, "rings.tx-push-buf-len", spec.Rings.TXPushBufLen, rings.TXPushBufLen)
+	errs = appendMismatch(errs, "rings.tcp-data-split", spec.Rings.TCPDataSplit, rings.TCPDataSplit)
+
+	errs = appendMismatch(errs, "channels.rx", spec.Channels.RX, channels.RX)
+	errs = appendMismatch(errs, "channels.tx", spec.Channels.TX, channels.TX)
+	errs = appendMismatch(errs, "channels.other", spec.Channels.Other, channels.Other)
+	errs = appendMismatch(errs, "channels.combined", spec.Channels.Combined, channels.Combined)
+
+	features := slices.Sorted(maps.Keys(spec.Features))
+
+	for _, name := range features {
+		field := "features." + name
+
+		idx := slices.IndexFunc(status.Features, func(f EthernetFeatureStatus) bool { return f.Name == name })
+		if idx == -1 {
+			errs = append(errs, EthernetConfigError{Field: field, Error: "not supported"})
+
+			continue
+		}
+
+		configured := "off"
+		if spec.Features[name] {
+			configured = "on"
+		}
+
+		current := status.Features[idx].Status
+
+		if state, _, _ := strings.Cut(current, " "); state != configured {
+			errs = append(errs, EthernetConfigError{Field: field, Error: fmt.Sprintf("configured %s, current %s", configured, current)})
+		}
+	}
+
+	return errs
+}
+
+func appendMismatch[T comparable](errs []EthernetConfigError, field string, configured, current *T) []EthernetConfigError {
+	switch {
+	case configured == nil:
+		return errs
+	case current == nil:
+		return append(errs, EthernetConfigError{Field: field, Error: "not supported"})
+	case *configured != *current:
+		return append(errs, EthernetConfigError{Field: field, Error: fmt.Sprintf("configured %v, current %v", *configured, *current)})
+	default:
+		return errs
+	}
+}
+
 // NewEthernetSpec initializes a EthernetSpec resource.
 func NewEthernetSpec(namespace resource.Namespace, id resource.ID) *EthernetSpec {
 	return typed.NewResource[EthernetSpecSpec, EthernetSpecExtension](
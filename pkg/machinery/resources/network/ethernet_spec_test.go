@@ -0,0 +1,65 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"testing"
+
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+func TestEthernetSpecConfigErrors(t *testing.T) {
+	t.Parallel()
+
+	spec := network.EthernetSpecSpec{
+		Rings: network.EthernetRingsSpec{
+			RX:           pointer.To[uint32](4096),
+			TX:           pointer.To[uint32](1024),
+			TCPDataSplit: pointer.To(true),
+		},
+		Channels: network.EthernetChannelsSpec{
+			Combined: pointer.To[uint32](8),
+		},
+		Features: map[string]bool{
+			"rx-gro-hw":        true,
+			"tx-checksum-ipv4": false,
+			"rx-vlan-filter":   true,
+			"tx-nocache-copy":  false,
+			"rx-not-a-feature": true,
+			"rx-lro":           false,
+		},
+	}
+
+	status := network.EthernetStatusSpec{
+		Rings: &network.EthernetRingsStatus{
+			RX: pointer.To[uint32](1024),
+			TX: pointer.To[uint32](1024),
+		},
+		Features: network.EthernetFeatureStatusList{
+			{Name: "rx-gro-hw", Status: "off [fixed]"},
+			{Name: "tx-checksum-ipv4", Status: "off"},
+			{Name: "rx-vlan-filter", Status: "on"},
+			{Name: "tx-nocache-copy", Status: "on"},
+			{Name: "rx-lro", Status: "off [requested on]"},
+		},
+	}
+
+	assert.Equal(t, []network.EthernetConfigError{
+		{Field: "rings.rx", Error: "configured 4096, current 1024"},
+		{Field: "rings.tcp-data-split", Error: "not supported"},
+		{Field: "channels.combined", Error: "not supported"},
+		{Field: "features.rx-gro-hw", Error: "configured on, current off [fixed]"},
+		{Field: "features.rx-not-a-feature", Error: "not supported"},
+		{Field: "features.tx-nocache-copy", Error: "configured off, current on"},
+	}, spec.ConfigErrors(&status))
+
+	// the status is not modified
+	assert.Nil(t, status.Channels)
+
+	assert.Empty(t, (&network.EthernetSpecSpec{}).ConfigErrors(&status))
+}
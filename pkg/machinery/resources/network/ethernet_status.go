@@ -34,6 +34,7 @@ type EthernetStatusSpec struct {
 	Rings         *EthernetRingsStatus      `yaml:"rings,omitempty" protobuf:"7"`
 	Features      EthernetFeatureStatusList `yaml:"features,omitempty" protobuf:"8"`
 	Channels      *EthernetChannelsStatus   `yaml:"channels,omitempty" protobuf:"9"`
+	ConfigErrors  []EthernetConfigError     `yaml:"configErrors,omitempty" protobuf:"10"`
 }
 
 // EthernetFeatureStatusList is a list of EthernetFeatureStatus.
@@ -110,6 +111,14 @@ type EthernetFeatureStatus struct {
 	Status string `yaml:"status" protobuf:"2"`
 }
 
+// EthernetConfigError describes a configuration setting which doesn't match the current link setting.
+//
+//gotagsrewrite:gen
+type EthernetConfigError struct {
+	Field string `yaml:"field" protobuf:"1"`
+	Error string `yaml:"error" protobuf:"2"`
+}
+
 // NewEthernetStatus initializes a EthernetStatus resource.
 func NewEthernetStatus(namespace resource.Namespace, id resource.ID) *EthernetStatus {
 	return typed.NewResource[EthernetStatusSpec, EthernetStatusExtension](
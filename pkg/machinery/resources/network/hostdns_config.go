@@ -6,6 +6,7 @@ package network
 
 import (
 	"net/netip"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
@@ -32,6 +33,18 @@ type HostDNSConfigSpec struct {
 	ListenAddresses       []netip.AddrPort `yaml:"listenAddresses,omitempty" protobuf:"2"`
 	ServiceHostDNSAddress netip.Addr       `yaml:"serviceHostDNSAddress,omitempty" protobuf:"3"`
 	ResolveMemberNames    bool             `yaml:"resolveMemberNames,omitempty" protobuf:"4"`
+
+	// CacheSize is the maximum number of cached entries.
+	CacheSize int `yaml:"cacheSize,omitempty" protobuf:"5"`
+	// CacheMinTTL and CacheMaxTTL clamp the TTL of cached responses, zero means no clamping.
+	CacheMinTTL time.Duration `yaml:"cacheMinTTL,omitempty" protobuf:"6"`
+	CacheMaxTTL time.Duration `yaml:"cacheMaxTTL,omitempty" protobuf:"7"`
+	// NegativeCacheTTL is the maximum TTL of cached negative responses, zero disables negative caching.
+	NegativeCacheTTL time.Duration `yaml:"negativeCacheTTL,omitempty" protobuf:"8"`
+	// ServeStale is the duration expired entries are served for when all upstreams fail, zero disables serving stale entries.
+	ServeStale time.Duration `yaml:"serveStale,omitempty" protobuf:"9"`
+	// AllowedClients is the list of client prefixes which are allowed to query the host DNS.
+	AllowedClients []netip.Prefix `yaml:"allowedClients,omitempty" protobuf:"10"`
 }
 
 // NewHostDNSConfig initializes a HostDNSConfig resource.
@@ -7,6 +7,7 @@ package network
 import (
 	"cmp"
 	"net/netip"
+	"reflect"
 	"slices"
 	"time"
 
@@ -52,6 +53,27 @@ type BondMasterSpec struct {
 	ADActorSysPrio  uint16                        `yaml:"adActorSysPrio,omitempty" protobuf:"22"`
 	ADUserPortKey   uint16                        `yaml:"adUserPortKey,omitempty" protobuf:"23"`
 	PeerNotifyDelay uint32                        `yaml:"peerNotifyDelay,omitempty" protobuf:"24"`
+	ARPIPTargets    []netip.Addr                  `yaml:"arpIPTargets,omitempty" protobuf:"25"`
+	NSIP6Targets    []netip.Addr                  `yaml:"nsIP6Targets,omitempty" protobuf:"26"`
+}
+
+// Equal checks two BondMasterSpecs for equality.
+func (spec *BondMasterSpec) Equal(other *BondMasterSpec) bool {
+	if !slices.Equal(spec.ARPIPTargets, other.ARPIPTargets) || !slices.Equal(spec.NSIP6Targets, other.NSIP6Targets) {
+		return false
+	}
+
+	// nil and empty lists of targets are equivalent, so exclude them from the comparison
+	left, right := *spec, *other
+	left.ARPIPTargets, left.NSIP6Targets = nil, nil
+	right.ARPIPTargets, right.NSIP6Targets = nil, nil
+
+	return reflect.DeepEqual(left, right)
+}
+
+// IsZero checks if the BondMasterSpec is empty.
+func (spec *BondMasterSpec) IsZero() bool {
+	return spec.Equal(&BondMasterSpec{})
 }
 
 // BridgeMasterSpec describes bridge settings if Kind == "bridge".
@@ -76,6 +98,17 @@ type BridgeVLANSpec struct {
 	FilteringEnabled bool `yaml:"filteringEnabled" protobuf:"1"`
 }
 
+// BridgeVLANEntry describes a VLAN of the bridge port.
+//
+//gotagsrewrite:gen
+type BridgeVLANEntry struct {
+	VID uint16 `yaml:"vid" protobuf:"1"`
+	// PVID marks the VLAN assigned to the untagged traffic received on the port.
+	PVID bool `yaml:"pvid,omitempty" protobuf:"2"`
+	// Untagged marks the VLAN which egresses the port untagged.
+	Untagged bool `yaml:"untagged,omitempty" protobuf:"3"`
+}
+
 // WireguardSpec describes Wireguard settings if Kind == "wireguard".
 //
 //gotagsrewrite:gen
@@ -87,6 +120,8 @@ type WireguardSpec struct {
 	ListenPort   int             `yaml:"listenPort" protobuf:"3"`
 	FirewallMark int             `yaml:"firewallMark" protobuf:"4"`
 	Peers        []WireguardPeer `yaml:"peers" protobuf:"5"`
+	// EndpointResolveInterval is the interval to re-resolve peer endpoints specified as hostnames, present only in the LinkSpec.
+	EndpointResolveInterval time.Duration `yaml:"endpointResolveInterval,omitempty" protobuf:"6"`
 }
 
 // WireguardPeer describes a single peer.
@@ -123,8 +158,9 @@ func (peer *WireguardPeer) Equal(other *WireguardPeer) bool {
 		return false
 	}
 
-	// if the Endpoint is not set in `other`, don't consider this to be a change
-	if other.Endpoint != "" && peer.Endpoint != other.Endpoint {
+	// if the Endpoint is not set in `other`, don't consider this to be a change,
+	// hostname endpoints are re-resolved by the WireguardEndpointController
+	if other.Endpoint != "" && !other.HasHostnameEndpoint() && peer.Endpoint != other.Endpoint {
 		return false
 	}
 
@@ -149,9 +185,20 @@ func (peer *WireguardPeer) Equal(other *WireguardPeer) bool {
 	return true
 }
 
+// HasHostnameEndpoint checks if the peer endpoint is specified as a hostname (and not as an IP address).
+func (peer *WireguardPeer) HasHostnameEndpoint() bool {
+	if peer.Endpoint == "" {
+		return false
+	}
+
+	_, err := netip.ParseAddrPort(peer.Endpoint)
+
+	return err != nil
+}
+
 // IsZero checks if the WireguardSpec is zero value.
 func (spec *WireguardSpec) IsZero() bool {
-	return spec.PrivateKey == "" && spec.ListenPort == 0 && spec.FirewallMark == 0 && len(spec.Peers) == 0
+	return spec.PrivateKey == "" && spec.ListenPort == 0 && spec.FirewallMark == 0 && len(spec.Peers) == 0 && spec.EndpointResolveInterval == 0
 }
 
 // Equal checks two WireguardSpecs for equality.
@@ -216,6 +263,10 @@ func (spec *WireguardSpec) Merge(other WireguardSpec) {
 		spec.PrivateKey = other.PrivateKey
 	}
 
+	if other.EndpointResolveInterval != 0 {
+		spec.EndpointResolveInterval = other.EndpointResolveInterval
+	}
+
 	// avoid adding same peer twice, no real peer information merging for now
 	for _, peer := range other.Peers {
 		exists := false
@@ -78,6 +78,13 @@ type BondSlave struct {
 type BridgeSlave struct {
 	// MasterName indicates master link for enslaved bridged interfaces.
 	MasterName string `yaml:"masterName,omitempty" protobuf:"1"`
+	// VLANs is the VLAN table of the bridge port, if empty, the VLAN table is not managed.
+	VLANs []BridgeVLANEntry `yaml:"vlans,omitempty" protobuf:"2"`
+}
+
+// IsZero checks if the BridgeSlave is empty.
+func (slave *BridgeSlave) IsZero() bool {
+	return slave.MasterName == "" && len(slave.VLANs) == 0
 }
 
 // Merge with other, overwriting fields from other if set.
@@ -91,9 +98,9 @@ func (spec *LinkSpecSpec) Merge(other *LinkSpecSpec) error {
 	updateIfNotZero(&spec.ParentName, other.ParentName)
 	updateIfNotZero(&spec.BondSlave, other.BondSlave)
 	updateIfNotZero(&spec.VLAN, other.VLAN)
-	updateIfNotZero(&spec.BondMaster, other.BondMaster)
+	updateIfNotZeroFunc(&spec.BondMaster, other.BondMaster, (*BondMasterSpec).IsZero)
 	updateIfNotZero(&spec.BridgeMaster, other.BridgeMaster)
-	updateIfNotZero(&spec.BridgeSlave, other.BridgeSlave)
+	updateIfNotZeroFunc(&spec.BridgeSlave, other.BridgeSlave, (*BridgeSlave).IsZero)
 
 	// Wireguard config should be able to apply non-zero values in earlier config layers which may be zero values in later layers.
 	// Thus, we handle each Wireguard configuration value discretely.
@@ -117,6 +124,12 @@ func updateIfNotZero[T comparable](target *T, source T) {
 	}
 }
 
+func updateIfNotZeroFunc[T any](target *T, source T, isZero func(*T) bool) {
+	if !isZero(&source) {
+		*target = source
+	}
+}
+
 // NewLinkSpec initializes a LinkSpec resource.
 func NewLinkSpec(namespace resource.Namespace, id resource.ID) *LinkSpec {
 	return typed.NewResource[LinkSpecSpec, LinkSpecExtension](
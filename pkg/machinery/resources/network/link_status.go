@@ -61,6 +61,8 @@ type LinkStatusSpec struct {
 	BridgeMaster BridgeMasterSpec `yaml:"bridgeMaster,omitempty" protobuf:"27"`
 	BondMaster   BondMasterSpec   `yaml:"bondMaster,omitempty" protobuf:"28"`
 	Wireguard    WireguardSpec    `yaml:"wireguard,omitempty" protobuf:"29"`
+	// MTU configured for the link in the LinkSpec, if any.
+	ConfiguredMTU uint32 `yaml:"configuredMTU,omitempty" protobuf:"33"`
 }
 
 // Physical checks if the link is physical ethernet.
@@ -60,6 +60,15 @@ func TestWireguardPeer(t *testing.T) {
 	assert.False(t, peer1.Equal(&peer2))
 	assert.False(t, peer1.Equal(&peer1_1))
 	assert.True(t, peer1.Equal(&peer1_2))
+
+	peer1_3 := peer1
+	peer1_3.Endpoint = "hub.example.com:1000"
+
+	// hostname endpoints are not compared against the resolved endpoint
+	assert.True(t, peer1_3.HasHostnameEndpoint())
+	assert.False(t, peer1.HasHostnameEndpoint())
+	assert.False(t, peer1_2.HasHostnameEndpoint())
+	assert.True(t, peer1.Equal(&peer1_3))
 }
 
 func TestWireguardSpecZero(t *testing.T) {
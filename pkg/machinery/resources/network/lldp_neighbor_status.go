@@ -0,0 +1,95 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// LLDPNeighborStatusType is type of LLDPNeighborStatus resource.
+const LLDPNeighborStatusType = resource.Type("LLDPNeighborStatuses.net.talos.dev")
+
+// LLDPNeighborStatus resource holds the LLDP neighbor discovered on a link.
+//
+// Resource ID is the link name.
+type LLDPNeighborStatus = typed.Resource[LLDPNeighborStatusSpec, LLDPNeighborStatusExtension]
+
+// LLDPNeighborStatusSpec describes the LLDP neighbor discovered on a link.
+//
+//gotagsrewrite:gen
+type LLDPNeighborStatusSpec struct {
+	LinkName string `yaml:"linkName" protobuf:"1"`
+	// Chassis ID of the neighbor, MAC and network addresses are formatted, other subtypes are kept as is.
+	ChassisID string `yaml:"chassisID" protobuf:"2"`
+	// Port ID of the neighbor, e.g. the switch port name.
+	PortID            string `yaml:"portID" protobuf:"3"`
+	PortDescription   string `yaml:"portDescription,omitempty" protobuf:"4"`
+	SystemName        string `yaml:"systemName,omitempty" protobuf:"5"`
+	SystemDescription string `yaml:"systemDescription,omitempty" protobuf:"6"`
+	// Port VLAN ID, zero if not advertised.
+	PortVLANID uint16 `yaml:"portVLANID,omitempty" protobuf:"7"`
+	// Time to live advertised by the neighbor.
+	TTL time.Duration `yaml:"ttl" protobuf:"8"`
+	// Expiration of the neighbor information if not refreshed.
+	Expires time.Time `yaml:"expires" protobuf:"9"`
+}
+
+// NewLLDPNeighborStatus initializes a LLDPNeighborStatus resource.
+func NewLLDPNeighborStatus(namespace resource.Namespace, id resource.ID) *LLDPNeighborStatus {
+	return typed.NewResource[LLDPNeighborStatusSpec, LLDPNeighborStatusExtension](
+		resource.NewMetadata(namespace, LLDPNeighborStatusType, id, resource.VersionUndefined),
+		LLDPNeighborStatusSpec{},
+	)
+}
+
+// LLDPNeighborStatusExtension provides auxiliary methods for LLDPNeighborStatus.
+type LLDPNeighborStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (LLDPNeighborStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             LLDPNeighborStatusType,
+		Aliases:          []resource.Type{"lldpneighbor", "lldpneighbors"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "System Name",
+				JSONPath: "{.systemName}",
+			},
+			{
+				Name:     "Chassis ID",
+				JSONPath: "{.chassisID}",
+			},
+			{
+				Name:     "Port ID",
+				JSONPath: "{.portID}",
+			},
+			{
+				Name:     "Port Description",
+				JSONPath: "{.portDescription}",
+			},
+			{
+				Name:     "VLAN",
+				JSONPath: "{.portVLANID}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[LLDPNeighborStatusSpec](LLDPNeighborStatusType, &LLDPNeighborStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -44,8 +44,12 @@ func RouteID(table nethelpers.RoutingTable, family nethelpers.Family, destinatio
 
 	prefix := ""
 
-	if table != nethelpers.TableMain {
+	switch {
+	case table == nethelpers.TableMain:
+	case table.IsARoutingTable():
 		prefix = fmt.Sprintf("%s/", table)
+	default:
+		prefix = fmt.Sprintf("%d/", table)
 	}
 
 	if family == nethelpers.FamilyInet6 {
@@ -55,6 +59,11 @@ func RouteID(table nethelpers.RoutingTable, family nethelpers.Family, destinatio
 	return fmt.Sprintf("%s%s/%s/%s/%d", prefix, family, string(gw), string(dst), priority)
 }
 
+// RouteRuleID builds ID (primary key) for the routing policy rule.
+func RouteRuleID(family nethelpers.Family, priority uint32) string {
+	return fmt.Sprintf("%s/%05d", family, priority)
+}
+
 // OperatorID builds ID (primary key) for the operators.
 func OperatorID(operator Operator, linkName string) string {
 	return fmt.Sprintf("%s/%s", operator, linkName)
@@ -72,3 +81,26 @@ const (
 	LinkKindBridge    = "bridge"
 	LinkKindWireguard = "wireguard"
 )
+
+// Route origins, i.e. the sources the routes were configured by.
+const (
+	RouteOriginStatic   = "static"
+	RouteOriginCmdline  = "cmdline"
+	RouteOriginPlatform = "platform"
+	RouteOriginDHCP4    = "dhcp4"
+	RouteOriginRA       = "ra"
+	RouteOriginKubeSpan = "kubespan"
+	RouteOriginKernel   = "kernel"
+)
+
+// DefaultRouteOriginPrecedence is the default precedence of the route origins, highest first.
+//
+// When routes of different origins have the same destination and metric, the route of the higher precedence origin wins.
+var DefaultRouteOriginPrecedence = []string{
+	RouteOriginStatic,
+	RouteOriginDHCP4,
+	RouteOriginRA,
+	RouteOriginKubeSpan,
+	RouteOriginPlatform,
+	RouteOriginCmdline,
+}
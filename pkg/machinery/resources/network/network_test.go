@@ -26,13 +26,18 @@ func TestRegisterResource(t *testing.T) {
 	for _, resource := range []meta.ResourceWithRD{
 		&network.AddressStatus{},
 		&network.AddressSpec{},
+		&network.BondStatus{},
+		&network.BridgeStatus{},
 		&network.HardwareAddr{},
+		&network.DHCP4Lease{},
 		&network.DNSUpstream{},
+		&network.DefaultRouteStatus{},
 		&network.EthernetSpec{},
 		&network.EthernetStatus{},
 		&network.HostDNSConfig{},
 		&network.HostnameStatus{},
 		&network.HostnameSpec{},
+		&network.LLDPNeighborStatus{},
 		&network.LinkRefresh{},
 		&network.LinkStatus{},
 		&network.LinkSpec{},
@@ -46,10 +51,18 @@ func TestRegisterResource(t *testing.T) {
 		&network.ResolverStatus{},
 		&network.ResolverSpec{},
 		&network.RouteStatus{},
+		&network.RouteRuleSpec{},
+		&network.RouteRuleStatus{},
 		&network.RouteSpec{},
+		&network.RouterStatus{},
+		&network.SRIOVSpec{},
+		&network.SRIOVStatus{},
+		&network.StaticFallbackStatus{},
 		&network.Status{},
 		&network.TimeServerStatus{},
 		&network.TimeServerSpec{},
+		&network.VIPStatus{},
+		&network.WireguardEndpointStatus{},
 	} {
 		assert.NoError(t, resourceRegistry.Register(ctx, resource))
 	}
@@ -50,6 +50,7 @@ type NfTablesRule struct {
 	ClampMSS    *NfTablesClampMSS           `yaml:"clampMSS,omitempty" protobuf:"9"`
 	SetMark     *NfTablesMark               `yaml:"setMark,omitempty" protobuf:"4"`
 	AnonCounter bool                        `yaml:"anonymousCounter,omitempty" protobuf:"12"`
+	Log         *NfTablesLog                `yaml:"log,omitempty" protobuf:"13"`
 	Verdict     *nethelpers.NfTablesVerdict `yaml:"verdict,omitempty" protobuf:"2"`
 }
 
@@ -137,6 +138,13 @@ type NfTablesLimitMatch struct {
 	PacketRatePerSecond uint64 `yaml:"packetRatePerSecond" protobuf:"1"`
 }
 
+// NfTablesLog describes the log statement.
+//
+//gotagsrewrite:gen
+type NfTablesLog struct {
+	Prefix string `yaml:"prefix,omitempty" protobuf:"1"`
+}
+
 // NfTablesConntrackStateMatch describes the match on the connection tracking state.
 //
 //gotagsrewrite:gen
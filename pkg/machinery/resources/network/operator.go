@@ -11,7 +11,8 @@ type Operator int
 //
 //structprotogen:gen_enum
 const (
-	OperatorDHCP4 Operator = iota // dhcp4
-	OperatorDHCP6                 // dhcp6
-	OperatorVIP                   // vip
+	OperatorDHCP4          Operator = iota // dhcp4
+	OperatorDHCP6                          // dhcp6
+	OperatorVIP                            // vip
+	OperatorStaticFallback                 // static-fallback
 )
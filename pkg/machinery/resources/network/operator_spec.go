@@ -6,6 +6,8 @@ package network
 
 import (
 	"net/netip"
+	"slices"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
@@ -33,15 +35,21 @@ type OperatorSpecSpec struct {
 	DHCP6 DHCP6OperatorSpec `yaml:"dhcp6,omitempty" protobuf:"5"`
 	VIP   VIPOperatorSpec   `yaml:"vip,omitempty" protobuf:"6"`
 
+	StaticFallback StaticFallbackOperatorSpec `yaml:"staticFallback,omitempty" protobuf:"8"`
+
 	ConfigLayer ConfigLayer `yaml:"layer" protobuf:"7"`
 }
 
 // Equal implements equality check for OperatorSpecSpec.
 func (spec OperatorSpecSpec) Equal(other OperatorSpecSpec) bool {
 	// config layer is not important for equality check
-	spec.ConfigLayer = other.ConfigLayer
-
-	return spec == other
+	return spec.Operator == other.Operator &&
+		spec.LinkName == other.LinkName &&
+		spec.RequireUp == other.RequireUp &&
+		spec.DHCP4.Equal(other.DHCP4) &&
+		spec.DHCP6 == other.DHCP6 &&
+		spec.VIP == other.VIP &&
+		spec.StaticFallback.Equal(other.StaticFallback)
 }
 
 // DHCP4OperatorSpec describes DHCP4 operator options.
@@ -50,6 +58,27 @@ func (spec OperatorSpecSpec) Equal(other OperatorSpecSpec) bool {
 type DHCP4OperatorSpec struct {
 	RouteMetric         uint32 `yaml:"routeMetric" protobuf:"1"`
 	SkipHostnameRequest bool   `yaml:"skipHostnameRequest,omitempty" protobuf:"2"`
+	// Ignore classless static routes (options 121 and 249), use the router option only.
+	IgnoreClasslessStaticRoutes bool `yaml:"ignoreClasslessStaticRoutes,omitempty" protobuf:"3"`
+	// Apply the lease cached from the previous boot while it is being confirmed.
+	OptimisticLease bool `yaml:"optimisticLease,omitempty" protobuf:"4"`
+	// Client identifier (option 61) in hex format, not sent if empty.
+	ClientIdentifier string `yaml:"clientIdentifier,omitempty" protobuf:"5"`
+	// Never send the hostname to the DHCP server.
+	SkipSendHostname bool `yaml:"skipSendHostname,omitempty" protobuf:"6"`
+	// Override the parameter request list (option 55).
+	RequestedOptions []uint32 `yaml:"requestedOptions,omitempty" protobuf:"7"`
+}
+
+// Equal implements equality check for DHCP4OperatorSpec.
+func (spec DHCP4OperatorSpec) Equal(other DHCP4OperatorSpec) bool {
+	return spec.RouteMetric == other.RouteMetric &&
+		spec.SkipHostnameRequest == other.SkipHostnameRequest &&
+		spec.IgnoreClasslessStaticRoutes == other.IgnoreClasslessStaticRoutes &&
+		spec.OptimisticLease == other.OptimisticLease &&
+		spec.ClientIdentifier == other.ClientIdentifier &&
+		spec.SkipSendHostname == other.SkipSendHostname &&
+		slices.Equal(spec.RequestedOptions, other.RequestedOptions)
 }
 
 // DHCP6OperatorSpec describes DHCP6 operator options.
@@ -59,6 +88,7 @@ type DHCP6OperatorSpec struct {
 	DUID                string `yaml:"DUID,omitempty" protobuf:"1"`
 	RouteMetric         uint32 `yaml:"routeMetric" protobuf:"2"`
 	SkipHostnameRequest bool   `yaml:"skipHostnameRequest,omitempty" protobuf:"3"`
+	IAID                uint32 `yaml:"IAID,omitempty" protobuf:"4"`
 }
 
 // VIPOperatorSpec describes virtual IP operator options.
@@ -70,6 +100,10 @@ type VIPOperatorSpec struct {
 
 	EquinixMetal VIPEquinixMetalSpec `yaml:"equinixMetal,omitempty" protobuf:"3"`
 	HCloud       VIPHCloudSpec       `yaml:"hcloud,omitempty" protobuf:"4"`
+
+	// Priority of the node to own the shared IP, and whether a higher priority node takes the shared IP over.
+	Priority uint32 `yaml:"priority,omitempty" protobuf:"5"`
+	Preempt  bool   `yaml:"preempt,omitempty" protobuf:"6"`
 }
 
 // VIPEquinixMetalSpec describes virtual (elastic) IP settings for Equinix Metal.
@@ -90,6 +124,30 @@ type VIPHCloudSpec struct {
 	APIToken  string `yaml:"apiToken" protobuf:"3"`
 }
 
+// StaticFallbackOperatorSpec describes static configuration with DHCP fallback operator options.
+//
+// The static addresses and routes are applied as long as the gateway responds to the ARP probe,
+// otherwise the DHCPv4 operator is run with the DHCP4 options of the operator spec.
+//
+//gotagsrewrite:gen
+type StaticFallbackOperatorSpec struct {
+	Addresses []AddressSpecSpec `yaml:"addresses,omitempty" protobuf:"1"`
+	Routes    []RouteSpecSpec   `yaml:"routes,omitempty" protobuf:"2"`
+	// Gateway to probe to check the static configuration.
+	Gateway       netip.Addr    `yaml:"gateway,omitempty" protobuf:"3"`
+	ProbeTimeout  time.Duration `yaml:"probeTimeout,omitempty" protobuf:"4"`
+	ProbeInterval time.Duration `yaml:"probeInterval,omitempty" protobuf:"5"`
+}
+
+// Equal implements equality check for StaticFallbackOperatorSpec.
+func (spec StaticFallbackOperatorSpec) Equal(other StaticFallbackOperatorSpec) bool {
+	return slices.Equal(spec.Addresses, other.Addresses) &&
+		slices.Equal(spec.Routes, other.Routes) &&
+		spec.Gateway == other.Gateway &&
+		spec.ProbeTimeout == other.ProbeTimeout &&
+		spec.ProbeInterval == other.ProbeInterval
+}
+
 // NewOperatorSpec initializes a OperatorSpec resource.
 func NewOperatorSpec(namespace resource.Namespace, id resource.ID) *OperatorSpec {
 	return typed.NewResource[OperatorSpecSpec, OperatorSpecExtension](
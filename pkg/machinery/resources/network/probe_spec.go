@@ -31,21 +31,32 @@ type ProbeSpecSpec struct {
 	Interval time.Duration `yaml:"interval" protobuf:"1"`
 	// FailureThreshold is the number of consecutive failures for the probe to be considered failed after having succeeded.
 	FailureThreshold int `yaml:"failureThreshold" protobuf:"2"`
-	// One of the probe types should be specified, for now it's only TCP.
+	// One of the probe types should be specified: TCP, HTTP or ICMP.
 	TCP TCPProbeSpec `yaml:"tcp,omitempty" protobuf:"3"`
 	// Configuration layer.
-	ConfigLayer ConfigLayer `yaml:"layer" protobuf:"4"`
+	ConfigLayer ConfigLayer   `yaml:"layer" protobuf:"4"`
+	HTTP        HTTPProbeSpec `yaml:"http,omitempty" protobuf:"5"`
+	ICMP        ICMPProbeSpec `yaml:"icmp,omitempty" protobuf:"6"`
 }
 
 // ID returns the ID of the resource based on the spec.
 func (spec *ProbeSpecSpec) ID() (resource.ID, error) {
-	var zeroTCP TCPProbeSpec
+	var (
+		zeroTCP  TCPProbeSpec
+		zeroHTTP HTTPProbeSpec
+		zeroICMP ICMPProbeSpec
+	)
 
-	if spec.TCP == zeroTCP {
+	switch {
+	case spec.TCP != zeroTCP:
+		return fmt.Sprintf("tcp:%s", spec.TCP.Endpoint), nil
+	case spec.HTTP != zeroHTTP:
+		return fmt.Sprintf("http:%s", spec.HTTP.URL), nil
+	case spec.ICMP != zeroICMP:
+		return fmt.Sprintf("icmp:%s", spec.ICMP.Host), nil
+	default:
 		return "", errors.New("no probe type specified")
 	}
-
-	return fmt.Sprintf("tcp:%s", spec.TCP.Endpoint), nil
 }
 
 // Equal returns true if the specs are equal.
@@ -63,6 +74,26 @@ type TCPProbeSpec struct {
 	Timeout time.Duration `yaml:"timeout" protobuf:"2"`
 }
 
+// HTTPProbeSpec describes the HTTP Probe.
+//
+//gotagsrewrite:gen
+type HTTPProbeSpec struct {
+	// URL to probe with a GET request, the probe succeeds on a non-error (< 400) response status.
+	URL string `yaml:"url" protobuf:"1"`
+	// Timeout for the probe.
+	Timeout time.Duration `yaml:"timeout" protobuf:"2"`
+}
+
+// ICMPProbeSpec describes the ICMP Probe.
+//
+//gotagsrewrite:gen
+type ICMPProbeSpec struct {
+	// Host (IP address or hostname) to send the ICMP echo requests to.
+	Host string `yaml:"host" protobuf:"1"`
+	// Timeout for the probe.
+	Timeout time.Duration `yaml:"timeout" protobuf:"2"`
+}
+
 // NewProbeSpec initializes a ProbeSpec resource.
 func NewProbeSpec(namespace resource.Namespace, id resource.ID) *ProbeSpec {
 	return typed.NewResource[ProbeSpecSpec, ProbeSpecExtension](
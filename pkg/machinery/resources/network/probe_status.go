@@ -5,6 +5,8 @@
 package network
 
 import (
+	"time"
+
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
 	"github.com/cosi-project/runtime/pkg/resource/protobuf"
@@ -27,6 +29,10 @@ type ProbeStatusSpec struct {
 	Success bool `yaml:"success" protobuf:"1"`
 	// Last error of the probe.
 	LastError string `yaml:"lastError" protobuf:"2"`
+	// Number of consecutive failures of the probe.
+	ConsecutiveFailures int `yaml:"consecutiveFailures" protobuf:"3"`
+	// Latency of the last successful probe.
+	LastLatency time.Duration `yaml:"lastLatency,omitempty" protobuf:"4"`
 }
 
 // NewProbeStatus initializes a ProbeStatus resource.
@@ -51,6 +57,14 @@ func (ProbeStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
 				Name:     "Success",
 				JSONPath: "{.success}",
 			},
+			{
+				Name:     "Failures",
+				JSONPath: "{.consecutiveFailures}",
+			},
+			{
+				Name:     "Latency",
+				JSONPath: "{.lastLatency}",
+			},
 		},
 	}
 }
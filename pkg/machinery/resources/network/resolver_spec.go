@@ -6,6 +6,7 @@ package network
 
 import (
 	"net/netip"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
@@ -28,9 +29,25 @@ const ResolverID resource.ID = "resolvers"
 //
 //gotagsrewrite:gen
 type ResolverSpecSpec struct {
-	DNSServers    []netip.Addr `yaml:"dnsServers" protobuf:"1"`
-	ConfigLayer   ConfigLayer  `yaml:"layer" protobuf:"2"`
-	SearchDomains []string     `yaml:"searchDomains,omitempty" protobuf:"3"`
+	DNSServers    []netip.Addr    `yaml:"dnsServers" protobuf:"1"`
+	ConfigLayer   ConfigLayer     `yaml:"layer" protobuf:"2"`
+	SearchDomains []string        `yaml:"searchDomains,omitempty" protobuf:"3"`
+	Options       ResolverOptions `yaml:"options,omitempty" protobuf:"4"`
+}
+
+// ResolverOptions describes resolver tuning options.
+//
+//gotagsrewrite:gen
+type ResolverOptions struct {
+	Rotate         bool          `yaml:"rotate,omitempty" protobuf:"1"`
+	Timeout        time.Duration `yaml:"timeout,omitempty" protobuf:"2"`
+	Attempts       int           `yaml:"attempts,omitempty" protobuf:"3"`
+	UpstreamPolicy string        `yaml:"upstreamPolicy,omitempty" protobuf:"4"`
+}
+
+// IsZero returns true if no options are set.
+func (o ResolverOptions) IsZero() bool {
+	return o == ResolverOptions{}
 }
 
 // NewResolverSpec initializes a ResolverSpec resource.
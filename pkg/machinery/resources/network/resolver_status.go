@@ -25,8 +25,9 @@ type ResolverStatus = typed.Resource[ResolverStatusSpec, ResolverStatusExtension
 //
 //gotagsrewrite:gen
 type ResolverStatusSpec struct {
-	DNSServers    []netip.Addr `yaml:"dnsServers" protobuf:"1"`
-	SearchDomains []string     `yaml:"searchDomains" protobuf:"2"`
+	DNSServers    []netip.Addr    `yaml:"dnsServers" protobuf:"1"`
+	SearchDomains []string        `yaml:"searchDomains" protobuf:"2"`
+	Options       ResolverOptions `yaml:"options,omitempty" protobuf:"3"`
 }
 
 // NewResolverStatus initializes a ResolverStatus resource.
@@ -0,0 +1,67 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// RouteRuleSpecType is type of RouteRuleSpec resource.
+const RouteRuleSpecType = resource.Type("RouteRuleSpecs.net.talos.dev")
+
+// RouteRuleSpec resource holds routing policy rule specification to be applied to the kernel.
+type RouteRuleSpec = typed.Resource[RouteRuleSpecSpec, RouteRuleSpecExtension]
+
+// RouteRuleSpecSpec describes the routing policy rule.
+//
+//gotagsrewrite:gen
+type RouteRuleSpecSpec struct {
+	Family      nethelpers.Family       `yaml:"family" protobuf:"1"`
+	Source      netip.Prefix            `yaml:"src" protobuf:"2"`
+	Destination netip.Prefix            `yaml:"dst" protobuf:"3"`
+	Table       nethelpers.RoutingTable `yaml:"table" protobuf:"4"`
+	Priority    uint32                  `yaml:"priority" protobuf:"5"`
+	FwMark      uint32                  `yaml:"fwMark,omitempty" protobuf:"6"`
+	FwMask      uint32                  `yaml:"fwMask,omitempty" protobuf:"7"`
+	ConfigLayer ConfigLayer             `yaml:"layer" protobuf:"8"`
+}
+
+// NewRouteRuleSpec initializes a RouteRuleSpec resource.
+func NewRouteRuleSpec(namespace resource.Namespace, id resource.ID) *RouteRuleSpec {
+	return typed.NewResource[RouteRuleSpecSpec, RouteRuleSpecExtension](
+		resource.NewMetadata(namespace, RouteRuleSpecType, id, resource.VersionUndefined),
+		RouteRuleSpecSpec{},
+	)
+}
+
+// RouteRuleSpecExtension provides auxiliary methods for RouteRuleSpec.
+type RouteRuleSpecExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (RouteRuleSpecExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             RouteRuleSpecType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns:     []meta.PrintColumn{},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[RouteRuleSpecSpec](RouteRuleSpecType, &RouteRuleSpec{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -39,6 +39,7 @@ type RouteStatusSpec struct {
 	Flags        nethelpers.RouteFlags    `yaml:"flags" protobuf:"11"`
 	Protocol     nethelpers.RouteProtocol `yaml:"protocol" protobuf:"12"`
 	MTU          uint32                   `yaml:"mtu,omitempty" protobuf:"13"`
+	Origin       string                   `yaml:"origin,omitempty" protobuf:"14"`
 }
 
 // NewRouteStatus initializes a RouteStatus resource.
@@ -75,6 +76,10 @@ func (RouteStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
 				Name:     "Metric",
 				JSONPath: `{.priority}`,
 			},
+			{
+				Name:     "Origin",
+				JSONPath: `{.origin}`,
+			},
 		},
 	}
 }
@@ -0,0 +1,94 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"net/netip"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// RouterStatusType is type of RouterStatus resource.
+const RouterStatusType = resource.Type("RouterStatuses.net.talos.dev")
+
+// RouterStatus resource holds an IPv6 router learned from router advertisements.
+type RouterStatus = typed.Resource[RouterStatusSpec, RouterStatusExtension]
+
+// RouterStatusSpec describes an IPv6 router learned from router advertisements.
+//
+//gotagsrewrite:gen
+type RouterStatusSpec struct {
+	LinkName string     `yaml:"linkName" protobuf:"1"`
+	Address  netip.Addr `yaml:"address" protobuf:"2"`
+	// Router preference: high, medium or low.
+	Preference string `yaml:"preference" protobuf:"3"`
+	// Router lifetime, zero if the router is not a default router.
+	Lifetime time.Duration `yaml:"lifetime" protobuf:"4"`
+	// Expiration of the default router, zero if the lifetime is infinite.
+	Expires time.Time `yaml:"expires,omitempty" protobuf:"5"`
+	// Routes learned from the route information options.
+	Routes []netip.Prefix `yaml:"routes,omitempty" protobuf:"6"`
+	// DNS servers learned from the recursive DNS server options.
+	DNSServers        []netip.Addr `yaml:"dnsServers,omitempty" protobuf:"7"`
+	LastAdvertisement time.Time    `yaml:"lastAdvertisement" protobuf:"8"`
+}
+
+// RouterStatusID builds ID for the RouterStatus resource.
+func RouterStatusID(linkName string, address netip.Addr) resource.ID {
+	return linkName + "/" + address.String()
+}
+
+// NewRouterStatus initializes a RouterStatus resource.
+func NewRouterStatus(namespace resource.Namespace, id resource.ID) *RouterStatus {
+	return typed.NewResource[RouterStatusSpec, RouterStatusExtension](
+		resource.NewMetadata(namespace, RouterStatusType, id, resource.VersionUndefined),
+		RouterStatusSpec{},
+	)
+}
+
+// RouterStatusExtension provides auxiliary methods for RouterStatus.
+type RouterStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (RouterStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             RouterStatusType,
+		Aliases:          []resource.Type{"router", "routers"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Link",
+				JSONPath: "{.linkName}",
+			},
+			{
+				Name:     "Address",
+				JSONPath: "{.address}",
+			},
+			{
+				Name:     "Preference",
+				JSONPath: "{.preference}",
+			},
+			{
+				Name:     "Lifetime",
+				JSONPath: "{.lifetime}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[RouterStatusSpec](RouterStatusType, &RouterStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
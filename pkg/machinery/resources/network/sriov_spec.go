@@ -0,0 +1,77 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// SRIOVSpecType is type of SRIOVSpec resource.
+const SRIOVSpecType = resource.Type("SRIOVSpecs.net.talos.dev")
+
+// SRIOVSpec resource holds SR-IOV configuration of a physical function.
+//
+// Resource ID is the name of the physical function link or its PCI bus path.
+type SRIOVSpec = typed.Resource[SRIOVSpecSpec, SRIOVSpecExtension]
+
+// SRIOVSpecSpec describes SR-IOV configuration of a physical function.
+//
+//gotagsrewrite:gen
+type SRIOVSpecSpec struct {
+	NumVFs uint32        `yaml:"numVFs" protobuf:"1"`
+	VFs    []SRIOVVFSpec `yaml:"vfs,omitempty" protobuf:"2"`
+}
+
+// SRIOVVFSpec describes configuration of a single SR-IOV virtual function.
+//
+//gotagsrewrite:gen
+type SRIOVVFSpec struct {
+	Index        uint32                  `yaml:"index" protobuf:"1"`
+	HardwareAddr nethelpers.HardwareAddr `yaml:"hardwareAddr,omitempty" protobuf:"2"`
+	VLAN         uint16                  `yaml:"vlan,omitempty" protobuf:"3"`
+	Trust        *bool                   `yaml:"trust,omitempty" protobuf:"4"`
+	SpoofCheck   *bool                   `yaml:"spoofCheck,omitempty" protobuf:"5"`
+}
+
+// NewSRIOVSpec initializes a SRIOVSpec resource.
+func NewSRIOVSpec(namespace resource.Namespace, id resource.ID) *SRIOVSpec {
+	return typed.NewResource[SRIOVSpecSpec, SRIOVSpecExtension](
+		resource.NewMetadata(namespace, SRIOVSpecType, id, resource.VersionUndefined),
+		SRIOVSpecSpec{},
+	)
+}
+
+// SRIOVSpecExtension provides auxiliary methods for SRIOVSpec.
+type SRIOVSpecExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (SRIOVSpecExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             SRIOVSpecType,
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "VFs",
+				JSONPath: `{.numVFs}`,
+			},
+		},
+		Sensitivity: meta.NonSensitive,
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[SRIOVSpecSpec](SRIOVSpecType, &SRIOVSpec{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,98 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// SRIOVStatusType is type of SRIOVStatus resource.
+const SRIOVStatusType = resource.Type("SRIOVStatuses.net.talos.dev")
+
+// SRIOVStatus resource holds the runtime state of SR-IOV virtual functions of a physical function.
+//
+// Resource ID matches the ID of the SRIOVSpec resource.
+type SRIOVStatus = typed.Resource[SRIOVStatusSpec, SRIOVStatusExtension]
+
+// SRIOVStatusSpec describes the runtime state of SR-IOV virtual functions of a physical function.
+//
+//gotagsrewrite:gen
+type SRIOVStatusSpec struct {
+	LinkName string          `yaml:"linkName,omitempty" protobuf:"1"`
+	BusPath  string          `yaml:"busPath,omitempty" protobuf:"2"`
+	TotalVFs uint32          `yaml:"totalVFs" protobuf:"3"`
+	NumVFs   uint32          `yaml:"numVFs" protobuf:"4"`
+	Ready    bool            `yaml:"ready" protobuf:"5"`
+	Error    string          `yaml:"error,omitempty" protobuf:"6"`
+	VFs      []SRIOVVFStatus `yaml:"vfs,omitempty" protobuf:"7"`
+}
+
+// SRIOVVFStatus describes the runtime state of a single SR-IOV virtual function.
+//
+//gotagsrewrite:gen
+type SRIOVVFStatus struct {
+	Index        uint32                  `yaml:"index" protobuf:"1"`
+	PCIAddress   string                  `yaml:"pciAddress" protobuf:"2"`
+	LinkName     string                  `yaml:"linkName,omitempty" protobuf:"3"`
+	Driver       string                  `yaml:"driver,omitempty" protobuf:"4"`
+	HardwareAddr nethelpers.HardwareAddr `yaml:"hardwareAddr,omitempty" protobuf:"5"`
+	VLAN         uint16                  `yaml:"vlan,omitempty" protobuf:"6"`
+	Trust        bool                    `yaml:"trust" protobuf:"7"`
+	SpoofCheck   bool                    `yaml:"spoofCheck" protobuf:"8"`
+}
+
+// NewSRIOVStatus initializes a SRIOVStatus resource.
+func NewSRIOVStatus(namespace resource.Namespace, id resource.ID) *SRIOVStatus {
+	return typed.NewResource[SRIOVStatusSpec, SRIOVStatusExtension](
+		resource.NewMetadata(namespace, SRIOVStatusType, id, resource.VersionUndefined),
+		SRIOVStatusSpec{},
+	)
+}
+
+// SRIOVStatusExtension provides auxiliary methods for SRIOVStatus.
+type SRIOVStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (SRIOVStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             SRIOVStatusType,
+		Aliases:          []resource.Type{"sriov"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Link",
+				JSONPath: `{.linkName}`,
+			},
+			{
+				Name:     "VFs",
+				JSONPath: `{.numVFs}`,
+			},
+			{
+				Name:     "Total",
+				JSONPath: `{.totalVFs}`,
+			},
+			{
+				Name:     "Ready",
+				JSONPath: `{.ready}`,
+			},
+		},
+		Sensitivity: meta.NonSensitive,
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[SRIOVStatusSpec](SRIOVStatusType, &SRIOVStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,86 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"net/netip"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// StaticFallbackStatusType is type of StaticFallbackStatus resource.
+const StaticFallbackStatusType = resource.Type("StaticFallbackStatuses.net.talos.dev")
+
+// StaticFallbackStatus resource holds the status of the static configuration with DHCP fallback.
+type StaticFallbackStatus = typed.Resource[StaticFallbackStatusSpec, StaticFallbackStatusExtension]
+
+// Static configuration with DHCP fallback modes.
+const (
+	StaticFallbackModeStatic = "static"
+	StaticFallbackModeDHCP4  = "dhcp4"
+)
+
+// StaticFallbackStatusSpec describes the status of the static configuration with DHCP fallback.
+//
+//gotagsrewrite:gen
+type StaticFallbackStatusSpec struct {
+	LinkName string `yaml:"linkName" protobuf:"1"`
+	// Mode which is currently active: static or dhcp4.
+	Mode    string     `yaml:"mode" protobuf:"2"`
+	Gateway netip.Addr `yaml:"gateway" protobuf:"3"`
+	// Result of the last gateway probe.
+	GatewayReachable bool      `yaml:"gatewayReachable" protobuf:"4"`
+	LastProbe        time.Time `yaml:"lastProbe,omitempty" protobuf:"5"`
+	LastTransition   time.Time `yaml:"lastTransition,omitempty" protobuf:"6"`
+}
+
+// NewStaticFallbackStatus initializes a StaticFallbackStatus resource.
+func NewStaticFallbackStatus(namespace resource.Namespace, id resource.ID) *StaticFallbackStatus {
+	return typed.NewResource[StaticFallbackStatusSpec, StaticFallbackStatusExtension](
+		resource.NewMetadata(namespace, StaticFallbackStatusType, id, resource.VersionUndefined),
+		StaticFallbackStatusSpec{},
+	)
+}
+
+// StaticFallbackStatusExtension provides auxiliary methods for StaticFallbackStatus.
+type StaticFallbackStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (StaticFallbackStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             StaticFallbackStatusType,
+		Aliases:          []resource.Type{"fallback", "fallbacks"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Mode",
+				JSONPath: "{.mode}",
+			},
+			{
+				Name:     "Gateway",
+				JSONPath: "{.gateway}",
+			},
+			{
+				Name:     "Reachable",
+				JSONPath: "{.gatewayReachable}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[StaticFallbackStatusSpec](StaticFallbackStatusType, &StaticFallbackStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
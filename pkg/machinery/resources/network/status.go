@@ -27,6 +27,7 @@ type StatusSpec struct {
 	ConnectivityReady bool `yaml:"connectivityReady" protobuf:"2"`
 	HostnameReady     bool `yaml:"hostnameReady" protobuf:"3"`
 	EtcFilesReady     bool `yaml:"etcFilesReady" protobuf:"4"`
+	SRIOVReady        bool `yaml:"sriovReady" protobuf:"5"`
 }
 
 // StatusID is the resource ID of the singleton instance.
@@ -66,6 +67,10 @@ func (StatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
 				Name:     "Etc",
 				JSONPath: "{.etcFilesReady}",
 			},
+			{
+				Name:     "SR-IOV",
+				JSONPath: "{.sriovReady}",
+			},
 		},
 	}
 }
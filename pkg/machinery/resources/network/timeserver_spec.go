@@ -28,6 +28,8 @@ const TimeServerID resource.ID = "timeservers"
 type TimeServerSpecSpec struct {
 	NTPServers  []string    `yaml:"timeServers" protobuf:"1"`
 	ConfigLayer ConfigLayer `yaml:"layer" protobuf:"2"`
+	// DHCPServersMode controls merging of the time servers received via DHCP with this spec.
+	DHCPServersMode string `yaml:"dhcpServersMode,omitempty" protobuf:"3"`
 }
 
 // NewTimeServerSpec initializes a TimeServerSpec resource.
@@ -0,0 +1,87 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"net/netip"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// VIPStatusType is type of VIPStatus resource.
+const VIPStatusType = resource.Type("VIPStatuses.net.talos.dev")
+
+// VIPStatus resource holds the status of the shared IP (VIP) election.
+type VIPStatus = typed.Resource[VIPStatusSpec, VIPStatusExtension]
+
+// VIPStatusSpec describes the status of the shared IP (VIP) election.
+//
+//gotagsrewrite:gen
+type VIPStatusSpec struct {
+	LinkName string     `yaml:"linkName" protobuf:"1"`
+	IP       netip.Addr `yaml:"ip" protobuf:"2"`
+	// Priority and preemption settings of this node.
+	Priority uint32 `yaml:"priority" protobuf:"3"`
+	Preempt  bool   `yaml:"preempt" protobuf:"4"`
+	// Owned is true if this node owns the shared IP.
+	Owned bool `yaml:"owned" protobuf:"5"`
+	// Hostname and priority of the node which owns the shared IP.
+	Owner          string    `yaml:"owner,omitempty" protobuf:"6"`
+	OwnerPriority  uint32    `yaml:"ownerPriority,omitempty" protobuf:"7"`
+	LastTransition time.Time `yaml:"lastTransition,omitempty" protobuf:"8"`
+}
+
+// NewVIPStatus initializes a VIPStatus resource.
+func NewVIPStatus(namespace resource.Namespace, id resource.ID) *VIPStatus {
+	return typed.NewResource[VIPStatusSpec, VIPStatusExtension](
+		resource.NewMetadata(namespace, VIPStatusType, id, resource.VersionUndefined),
+		VIPStatusSpec{},
+	)
+}
+
+// VIPStatusExtension provides auxiliary methods for VIPStatus.
+type VIPStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (VIPStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             VIPStatusType,
+		Aliases:          []resource.Type{"vip", "vips"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Link",
+				JSONPath: "{.linkName}",
+			},
+			{
+				Name:     "Owned",
+				JSONPath: "{.owned}",
+			},
+			{
+				Name:     "Owner",
+				JSONPath: "{.owner}",
+			},
+			{
+				Name:     "Owner Priority",
+				JSONPath: "{.ownerPriority}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[VIPStatusSpec](VIPStatusType, &VIPStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,97 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"net/netip"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// WireguardEndpointStatusType is type of WireguardEndpointStatus resource.
+const WireguardEndpointStatusType = resource.Type("WireguardEndpointStatuses.net.talos.dev")
+
+// WireguardEndpointStatus resource holds the resolution state of a Wireguard peer endpoint specified as a hostname.
+//
+// Resource ID is the link name and the peer public key joined with a slash.
+// For the KubeSpan extra announced endpoints, the ID is the KubeSpan link name and the endpoint joined with a slash.
+type WireguardEndpointStatus = typed.Resource[WireguardEndpointStatusSpec, WireguardEndpointStatusExtension]
+
+// WireguardEndpointStatusSpec describes the resolution state of a Wireguard peer endpoint.
+//
+//gotagsrewrite:gen
+type WireguardEndpointStatusSpec struct {
+	LinkName string `yaml:"linkName" protobuf:"1"`
+	// PublicKey is the peer public key, empty for the KubeSpan extra announced endpoints.
+	PublicKey string `yaml:"publicKey" protobuf:"2"`
+	// Endpoint is the configured endpoint (hostname:port).
+	Endpoint string `yaml:"endpoint" protobuf:"3"`
+	// ResolvedEndpoint is the endpoint address the hostname was last resolved to.
+	ResolvedEndpoint netip.AddrPort `yaml:"resolvedEndpoint" protobuf:"4"`
+	// CurrentEndpoint is the endpoint address currently used by the Wireguard device.
+	CurrentEndpoint netip.AddrPort `yaml:"currentEndpoint" protobuf:"5"`
+	LastResolved    time.Time      `yaml:"lastResolved" protobuf:"6"`
+	LastHandshake   time.Time      `yaml:"lastHandshake" protobuf:"7"`
+	LastError       string         `yaml:"lastError,omitempty" protobuf:"8"`
+}
+
+// WireguardEndpointStatusID builds the ID of the WireguardEndpointStatus resource.
+func WireguardEndpointStatusID(linkName, publicKeyOrEndpoint string) resource.ID {
+	return linkName + "/" + publicKeyOrEndpoint
+}
+
+// NewWireguardEndpointStatus initializes a WireguardEndpointStatus resource.
+func NewWireguardEndpointStatus(namespace resource.Namespace, id resource.ID) *WireguardEndpointStatus {
+	return typed.NewResource[WireguardEndpointStatusSpec, WireguardEndpointStatusExtension](
+		resource.NewMetadata(namespace, WireguardEndpointStatusType, id, resource.VersionUndefined),
+		WireguardEndpointStatusSpec{},
+	)
+}
+
+// WireguardEndpointStatusExtension provides auxiliary methods for WireguardEndpointStatus.
+type WireguardEndpointStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (WireguardEndpointStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             WireguardEndpointStatusType,
+		Aliases:          []resource.Type{"wgendpoint", "wgendpoints"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Endpoint",
+				JSONPath: `{.endpoint}`,
+			},
+			{
+				Name:     "Resolved",
+				JSONPath: `{.resolvedEndpoint}`,
+			},
+			{
+				Name:     "Current",
+				JSONPath: `{.currentEndpoint}`,
+			},
+			{
+				Name:     "Last Resolved",
+				JSONPath: `{.lastResolved}`,
+			},
+		},
+		Sensitivity: meta.NonSensitive,
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[WireguardEndpointStatusSpec](WireguardEndpointStatusType, &WireguardEndpointStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,81 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// ConfigValidationStatusType is type of ConfigValidationStatus resource.
+const ConfigValidationStatusType = resource.Type("ConfigValidationStatuses.runtime.talos.dev")
+
+// ConfigValidationStatusID is the ID of the singleton ConfigValidationStatus resource.
+const ConfigValidationStatusID resource.ID = "active"
+
+// ConfigValidationStatus resource holds the validation results of the active machine config.
+type ConfigValidationStatus = typed.Resource[ConfigValidationStatusSpec, ConfigValidationStatusExtension]
+
+// ConfigValidationStatusSpec describes the validation results of the active machine config.
+//
+//gotagsrewrite:gen
+type ConfigValidationStatusSpec struct {
+	// Version of the machine config resource which was validated.
+	ConfigVersion string                    `yaml:"configVersion" protobuf:"1"`
+	Warnings      []ConfigValidationFinding `yaml:"warnings,omitempty" protobuf:"2"`
+}
+
+// ConfigValidationFinding describes a single finding of the config validation.
+//
+//gotagsrewrite:gen
+type ConfigValidationFinding struct {
+	Kind    string `yaml:"kind,omitempty" protobuf:"1"`
+	Name    string `yaml:"name,omitempty" protobuf:"2"`
+	Path    string `yaml:"path,omitempty" protobuf:"3"`
+	Message string `yaml:"message" protobuf:"4"`
+}
+
+// NewConfigValidationStatus initializes a ConfigValidationStatus resource.
+func NewConfigValidationStatus() *ConfigValidationStatus {
+	return typed.NewResource[ConfigValidationStatusSpec, ConfigValidationStatusExtension](
+		resource.NewMetadata(NamespaceName, ConfigValidationStatusType, ConfigValidationStatusID, resource.VersionUndefined),
+		ConfigValidationStatusSpec{},
+	)
+}
+
+// ConfigValidationStatusExtension is auxiliary resource data for ConfigValidationStatus.
+type ConfigValidationStatusExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (ConfigValidationStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             ConfigValidationStatusType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Config Version",
+				JSONPath: `{.configVersion}`,
+			},
+			{
+				Name:     "Warnings",
+				JSONPath: `{.warnings[*].message}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[ConfigValidationStatusSpec](ConfigValidationStatusType, &ConfigValidationStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
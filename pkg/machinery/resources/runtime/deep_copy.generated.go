@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type BootedEntrySpec -type DevicesStatusSpec -type DiagnosticSpec -type EventSinkConfigSpec -type ExtensionServiceConfigSpec -type ExtensionServiceConfigStatusSpec -type KernelCmdlineSpec -type KernelModuleSpecSpec -type KernelParamSpecSpec -type KernelParamStatusSpec -type KmsgLogConfigSpec -type LoadedKernelModuleSpec -type MaintenanceServiceConfigSpec -type MaintenanceServiceRequestSpec -type MachineResetSignalSpec -type MachineStatusSpec -type MetaKeySpec -type MountStatusSpec -type PlatformMetadataSpec -type SecurityStateSpec -type MetaLoadedSpec -type SBOMItemSpec -type UniqueMachineTokenSpec -type VersionSpec -type WatchdogTimerConfigSpec -type WatchdogTimerStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type BootedEntrySpec -type ConfigValidationStatusSpec -type DevicesStatusSpec -type DiagnosticSpec -type EventSinkConfigSpec -type ExtensionServiceConfigSpec -type ExtensionServiceConfigStatusSpec -type ExtensionVerificationStatusSpec -type KernelCmdlineSpec -type KernelModuleSpecSpec -type KernelModuleStatusSpec -type KernelParamSpecSpec -type KernelParamStatusSpec -type KmsgLogConfigSpec -type KmsgLogDeliveryStatusSpec -type LoadedKernelModuleSpec -type MaintenanceServiceConfigSpec -type MaintenanceServiceRequestSpec -type MachineResetSignalSpec -type MachineStatusSpec -type MeasuredBootStatusSpec -type MetaKeySpec -type MountStatusSpec -type PlatformMetadataSpec -type ProcessReaperStatusSpec -type SecureBootStatusSpec -type SecurityStateSpec -type ServiceResourcesStatusSpec -type ShutdownInhibitorSpec -type SysctlStatusSpec -type MetaLoadedSpec -type SBOMItemSpec -type TPMStatusSpec -type UniqueMachineTokenSpec -type UpgradeStatusSpec -type VersionSpec -type WatchdogTimerConfigSpec -type WatchdogTimerStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package runtime
 
@@ -17,6 +17,16 @@ func (o BootedEntrySpec) DeepCopy() BootedEntrySpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of ConfigValidationStatusSpec.
+func (o ConfigValidationStatusSpec) DeepCopy() ConfigValidationStatusSpec {
+	var cp ConfigValidationStatusSpec = o
+	if o.Warnings != nil {
+		cp.Warnings = make([]ConfigValidationFinding, len(o.Warnings))
+		copy(cp.Warnings, o.Warnings)
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of DevicesStatusSpec.
 func (o DevicesStatusSpec) DeepCopy() DevicesStatusSpec {
 	var cp DevicesStatusSpec = o
@@ -59,6 +69,12 @@ func (o ExtensionServiceConfigStatusSpec) DeepCopy() ExtensionServiceConfigStatu
 	return cp
 }
 
+// DeepCopy generates a deep copy of ExtensionVerificationStatusSpec.
+func (o ExtensionVerificationStatusSpec) DeepCopy() ExtensionVerificationStatusSpec {
+	var cp ExtensionVerificationStatusSpec = o
+	return cp
+}
+
 // DeepCopy generates a deep copy of KernelCmdlineSpec.
 func (o KernelCmdlineSpec) DeepCopy() KernelCmdlineSpec {
 	var cp KernelCmdlineSpec = o
@@ -75,6 +91,16 @@ func (o KernelModuleSpecSpec) DeepCopy() KernelModuleSpecSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of KernelModuleStatusSpec.
+func (o KernelModuleStatusSpec) DeepCopy() KernelModuleStatusSpec {
+	var cp KernelModuleStatusSpec = o
+	if o.Parameters != nil {
+		cp.Parameters = make([]string, len(o.Parameters))
+		copy(cp.Parameters, o.Parameters)
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of KernelParamSpecSpec.
 func (o KernelParamSpecSpec) DeepCopy() KernelParamSpecSpec {
 	var cp KernelParamSpecSpec = o
@@ -104,6 +130,30 @@ func (o KmsgLogConfigSpec) DeepCopy() KmsgLogConfigSpec {
 			}
 		}
 	}
+	if o.SyslogDestinations != nil {
+		cp.SyslogDestinations = make([]*url.URL, len(o.SyslogDestinations))
+		copy(cp.SyslogDestinations, o.SyslogDestinations)
+		for i2 := range o.SyslogDestinations {
+			if o.SyslogDestinations[i2] != nil {
+				cp.SyslogDestinations[i2] = new(url.URL)
+				*cp.SyslogDestinations[i2] = *o.SyslogDestinations[i2]
+				if o.SyslogDestinations[i2].User != nil {
+					cp.SyslogDestinations[i2].User = new(url.Userinfo)
+					*cp.SyslogDestinations[i2].User = *o.SyslogDestinations[i2].User
+				}
+			}
+		}
+	}
+	if o.Buffers != nil {
+		cp.Buffers = make([]KmsgLogBufferSpec, len(o.Buffers))
+		copy(cp.Buffers, o.Buffers)
+	}
+	return cp
+}
+
+// DeepCopy generates a deep copy of KmsgLogDeliveryStatusSpec.
+func (o KmsgLogDeliveryStatusSpec) DeepCopy() KmsgLogDeliveryStatusSpec {
+	var cp KmsgLogDeliveryStatusSpec = o
 	return cp
 }
 
@@ -149,6 +199,16 @@ func (o MachineStatusSpec) DeepCopy() MachineStatusSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of MeasuredBootStatusSpec.
+func (o MeasuredBootStatusSpec) DeepCopy() MeasuredBootStatusSpec {
+	var cp MeasuredBootStatusSpec = o
+	if o.PCRs != nil {
+		cp.PCRs = make([]PCRValueSpec, len(o.PCRs))
+		copy(cp.PCRs, o.PCRs)
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of MetaKeySpec.
 func (o MetaKeySpec) DeepCopy() MetaKeySpec {
 	var cp MetaKeySpec = o
@@ -181,12 +241,78 @@ func (o PlatformMetadataSpec) DeepCopy() PlatformMetadataSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of ProcessReaperStatusSpec.
+func (o ProcessReaperStatusSpec) DeepCopy() ProcessReaperStatusSpec {
+	var cp ProcessReaperStatusSpec = o
+	if o.ZombieParents != nil {
+		cp.ZombieParents = make([]ProcessParentSpec, len(o.ZombieParents))
+		copy(cp.ZombieParents, o.ZombieParents)
+	}
+	if o.OrphanParents != nil {
+		cp.OrphanParents = make([]ProcessParentSpec, len(o.OrphanParents))
+		copy(cp.OrphanParents, o.OrphanParents)
+	}
+	return cp
+}
+
+// DeepCopy generates a deep copy of SecureBootStatusSpec.
+func (o SecureBootStatusSpec) DeepCopy() SecureBootStatusSpec {
+	var cp SecureBootStatusSpec = o
+	if o.PKFingerprints != nil {
+		cp.PKFingerprints = make([]string, len(o.PKFingerprints))
+		copy(cp.PKFingerprints, o.PKFingerprints)
+	}
+	if o.KEKFingerprints != nil {
+		cp.KEKFingerprints = make([]string, len(o.KEKFingerprints))
+		copy(cp.KEKFingerprints, o.KEKFingerprints)
+	}
+	if o.DBFingerprints != nil {
+		cp.DBFingerprints = make([]string, len(o.DBFingerprints))
+		copy(cp.DBFingerprints, o.DBFingerprints)
+	}
+	if o.BootAuthorityFingerprints != nil {
+		cp.BootAuthorityFingerprints = make([]string, len(o.BootAuthorityFingerprints))
+		copy(cp.BootAuthorityFingerprints, o.BootAuthorityFingerprints)
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of SecurityStateSpec.
 func (o SecurityStateSpec) DeepCopy() SecurityStateSpec {
 	var cp SecurityStateSpec = o
 	return cp
 }
 
+// DeepCopy generates a deep copy of ServiceResourcesStatusSpec.
+func (o ServiceResourcesStatusSpec) DeepCopy() ServiceResourcesStatusSpec {
+	var cp ServiceResourcesStatusSpec = o
+	return cp
+}
+
+// DeepCopy generates a deep copy of ShutdownInhibitorSpec.
+func (o ShutdownInhibitorSpec) DeepCopy() ShutdownInhibitorSpec {
+	var cp ShutdownInhibitorSpec = o
+	return cp
+}
+
+// DeepCopy generates a deep copy of SysctlStatusSpec.
+func (o SysctlStatusSpec) DeepCopy() SysctlStatusSpec {
+	var cp SysctlStatusSpec = o
+	if o.Applied != nil {
+		cp.Applied = make([]string, len(o.Applied))
+		copy(cp.Applied, o.Applied)
+	}
+	if o.Failed != nil {
+		cp.Failed = make([]SysctlFailure, len(o.Failed))
+		copy(cp.Failed, o.Failed)
+	}
+	if o.Skipped != nil {
+		cp.Skipped = make([]string, len(o.Skipped))
+		copy(cp.Skipped, o.Skipped)
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of MetaLoadedSpec.
 func (o MetaLoadedSpec) DeepCopy() MetaLoadedSpec {
 	var cp MetaLoadedSpec = o
@@ -207,12 +333,28 @@ func (o SBOMItemSpec) DeepCopy() SBOMItemSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of TPMStatusSpec.
+func (o TPMStatusSpec) DeepCopy() TPMStatusSpec {
+	var cp TPMStatusSpec = o
+	if o.PCRBanks != nil {
+		cp.PCRBanks = make([]string, len(o.PCRBanks))
+		copy(cp.PCRBanks, o.PCRBanks)
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of UniqueMachineTokenSpec.
 func (o UniqueMachineTokenSpec) DeepCopy() UniqueMachineTokenSpec {
 	var cp UniqueMachineTokenSpec = o
 	return cp
 }
 
+// DeepCopy generates a deep copy of UpgradeStatusSpec.
+func (o UpgradeStatusSpec) DeepCopy() UpgradeStatusSpec {
+	var cp UpgradeStatusSpec = o
+	return cp
+}
+
 // DeepCopy generates a deep copy of VersionSpec.
 func (o VersionSpec) DeepCopy() VersionSpec {
 	var cp VersionSpec = o
@@ -0,0 +1,82 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// ExtensionVerificationStatusType is type of ExtensionVerificationStatus resource.
+const ExtensionVerificationStatusType = resource.Type("ExtensionVerificationStatuses.runtime.talos.dev")
+
+// ExtensionVerificationStatus resource holds the signature verification result of an installed system extension.
+//
+// The resource ID matches the ID of the ExtensionStatus resource.
+type ExtensionVerificationStatus = typed.Resource[ExtensionVerificationStatusSpec, ExtensionVerificationStatusExtension]
+
+// ExtensionVerificationStatusSpec describes the signature verification result of an installed system extension.
+//
+//gotagsrewrite:gen
+type ExtensionVerificationStatusSpec struct {
+	Name     string `yaml:"name" protobuf:"1"`
+	ImageRef string `yaml:"imageRef,omitempty" protobuf:"2"`
+	Digest   string `yaml:"digest,omitempty" protobuf:"3"`
+	Verified bool   `yaml:"verified" protobuf:"4"`
+	// Signer is the name of the trusted key which signed the extension image.
+	Signer string `yaml:"signer,omitempty" protobuf:"5"`
+	Error  string `yaml:"error,omitempty" protobuf:"6"`
+}
+
+// NewExtensionVerificationStatus initializes a ExtensionVerificationStatus resource.
+func NewExtensionVerificationStatus(namespace resource.Namespace, id resource.ID) *ExtensionVerificationStatus {
+	return typed.NewResource[ExtensionVerificationStatusSpec, ExtensionVerificationStatusExtension](
+		resource.NewMetadata(namespace, ExtensionVerificationStatusType, id, resource.VersionUndefined),
+		ExtensionVerificationStatusSpec{},
+	)
+}
+
+// ExtensionVerificationStatusExtension is auxiliary resource data for ExtensionVerificationStatus.
+type ExtensionVerificationStatusExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (ExtensionVerificationStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             ExtensionVerificationStatusType,
+		Aliases:          []resource.Type{"extensionverification"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Name",
+				JSONPath: `{.name}`,
+			},
+			{
+				Name:     "Digest",
+				JSONPath: `{.digest}`,
+			},
+			{
+				Name:     "Verified",
+				JSONPath: `{.verified}`,
+			},
+			{
+				Name:     "Signer",
+				JSONPath: `{.signer}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[ExtensionVerificationStatusSpec](ExtensionVerificationStatusType, &ExtensionVerificationStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -25,6 +25,8 @@ type KernelModuleSpec = typed.Resource[KernelModuleSpecSpec, KernelModuleSpecExt
 type KernelModuleSpecSpec struct {
 	Name       string   `yaml:"string" protobuf:"1"`
 	Parameters []string `yaml:"parameters" protobuf:"2"`
+	// Order defines the order in which modules are loaded (lower first).
+	Order int `yaml:"order" protobuf:"3"`
 	// more options in the future: aliases, etc.
 }
 
@@ -0,0 +1,76 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// KernelModuleStatusType is type of KernelModuleStatus resource.
+const KernelModuleStatusType = resource.Type("KernelModuleStatuses.runtime.talos.dev")
+
+// KernelModuleStatus resource holds the status of loading a configured Linux kernel module.
+type KernelModuleStatus = typed.Resource[KernelModuleStatusSpec, KernelModuleStatusExtension]
+
+// KernelModuleStatusSpec describes the status of loading a configured Linux kernel module.
+//
+//gotagsrewrite:gen
+type KernelModuleStatusSpec struct {
+	// Parameters the module was loaded with.
+	Parameters []string `yaml:"parameters,omitempty" protobuf:"1"`
+	Loaded     bool     `yaml:"loaded" protobuf:"2"`
+	// RebootRequired is set if the configured parameters differ from the ones in use,
+	// and the module can't be reloaded.
+	RebootRequired bool   `yaml:"rebootRequired" protobuf:"3"`
+	Error          string `yaml:"error,omitempty" protobuf:"4"`
+}
+
+// NewKernelModuleStatus initializes a KernelModuleStatus resource.
+func NewKernelModuleStatus(namespace resource.Namespace, id resource.ID) *KernelModuleStatus {
+	return typed.NewResource[KernelModuleStatusSpec, KernelModuleStatusExtension](
+		resource.NewMetadata(namespace, KernelModuleStatusType, id, resource.VersionUndefined),
+		KernelModuleStatusSpec{},
+	)
+}
+
+// KernelModuleStatusExtension is auxiliary resource data for KernelModuleStatus.
+type KernelModuleStatusExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (KernelModuleStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             KernelModuleStatusType,
+		Aliases:          []resource.Type{"kernelmodulestatus"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Loaded",
+				JSONPath: `{.loaded}`,
+			},
+			{
+				Name:     "Reboot Required",
+				JSONPath: `{.rebootRequired}`,
+			},
+			{
+				Name:     "Error",
+				JSONPath: `{.error}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[KernelModuleStatusSpec](KernelModuleStatusType, &KernelModuleStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -6,6 +6,7 @@ package runtime
 
 import (
 	"net/url"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
@@ -28,7 +29,32 @@ const KmsgLogConfigID resource.ID = "kmsg-log"
 //
 //gotagsrewrite:gen
 type KmsgLogConfigSpec struct {
-	Destinations []*url.URL `yaml:"destinations" protobuf:"1"`
+	Destinations       []*url.URL          `yaml:"destinations" protobuf:"1"`
+	SyslogDestinations []*url.URL          `yaml:"syslogDestinations,omitempty" protobuf:"2"`
+	Buffers            []KmsgLogBufferSpec `yaml:"buffers,omitempty" protobuf:"3"`
+}
+
+// KmsgLogBufferSpec describes buffering settings of a kmsg log destination.
+//
+//gotagsrewrite:gen
+type KmsgLogBufferSpec struct {
+	Destination     string        `yaml:"destination" protobuf:"1"`
+	MemorySize      uint64        `yaml:"memorySize" protobuf:"2"`
+	SpoolSize       uint64        `yaml:"spoolSize,omitempty" protobuf:"3"`
+	DropPolicy      string        `yaml:"dropPolicy" protobuf:"4"`
+	RetryMinBackoff time.Duration `yaml:"retryMinBackoff" protobuf:"5"`
+	RetryMaxBackoff time.Duration `yaml:"retryMaxBackoff" protobuf:"6"`
+}
+
+// Buffer returns buffering settings for the destination, if configured.
+func (spec *KmsgLogConfigSpec) Buffer(destination *url.URL) (KmsgLogBufferSpec, bool) {
+	for _, buffer := range spec.Buffers {
+		if buffer.Destination == destination.String() {
+			return buffer, true
+		}
+	}
+
+	return KmsgLogBufferSpec{}, false
 }
 
 // NewKmsgLogConfig initializes a KmsgLogConfig resource.
@@ -0,0 +1,80 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// KmsgLogDeliveryStatusType is type of KmsgLogDeliveryStatus resource.
+const KmsgLogDeliveryStatusType = resource.Type("KmsgLogDeliveryStatuses.runtime.talos.dev")
+
+// KmsgLogDeliveryStatus resource holds delivery counters of a kmsg log destination.
+//
+// Resource ID is the destination URL.
+type KmsgLogDeliveryStatus = typed.Resource[KmsgLogDeliveryStatusSpec, KmsgLogDeliveryStatusExtension]
+
+// KmsgLogDeliveryStatusSpec describes delivery status of a kmsg log destination.
+//
+// Sizes are approximate, they are based on the size of the log messages.
+//
+//gotagsrewrite:gen
+type KmsgLogDeliveryStatusSpec struct {
+	Destination   string `yaml:"destination" protobuf:"1"`
+	SentBytes     uint64 `yaml:"sentBytes" protobuf:"2"`
+	DroppedBytes  uint64 `yaml:"droppedBytes" protobuf:"3"`
+	SpooledBytes  uint64 `yaml:"spooledBytes" protobuf:"4"`
+	BufferedBytes uint64 `yaml:"bufferedBytes" protobuf:"5"`
+	SpoolBytes    uint64 `yaml:"spoolBytes" protobuf:"6"`
+	LastError     string `yaml:"lastError,omitempty" protobuf:"7"`
+}
+
+// NewKmsgLogDeliveryStatus initializes a KmsgLogDeliveryStatus resource.
+func NewKmsgLogDeliveryStatus(id string) *KmsgLogDeliveryStatus {
+	return typed.NewResource[KmsgLogDeliveryStatusSpec, KmsgLogDeliveryStatusExtension](
+		resource.NewMetadata(NamespaceName, KmsgLogDeliveryStatusType, id, resource.VersionUndefined),
+		KmsgLogDeliveryStatusSpec{},
+	)
+}
+
+// KmsgLogDeliveryStatusExtension is auxiliary resource data for KmsgLogDeliveryStatus.
+type KmsgLogDeliveryStatusExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (KmsgLogDeliveryStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             KmsgLogDeliveryStatusType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Sent",
+				JSONPath: `{.sentBytes}`,
+			},
+			{
+				Name:     "Dropped",
+				JSONPath: `{.droppedBytes}`,
+			},
+			{
+				Name:     "Spooled",
+				JSONPath: `{.spooledBytes}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[KmsgLogDeliveryStatusSpec](KmsgLogDeliveryStatusType, &KmsgLogDeliveryStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,89 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// MeasuredBootStatusType is type of MeasuredBootStatus resource.
+const MeasuredBootStatusType = resource.Type("MeasuredBootStatuses.runtime.talos.dev")
+
+// MeasuredBootStatusID is the ID of the singleton MeasuredBootStatus resource.
+const MeasuredBootStatusID = resource.ID("measuredboot")
+
+// MeasuredBootStatus resource holds the PCR values the node booted with and the TPM event log summary.
+type MeasuredBootStatus = typed.Resource[MeasuredBootStatusSpec, MeasuredBootStatusExtension]
+
+// MeasuredBootStatusSpec describes the PCR values the node booted with and the TPM event log summary.
+//
+// PCR values are captured once machined is started.
+//
+//gotagsrewrite:gen
+type MeasuredBootStatusSpec struct {
+	PCRBank string         `yaml:"pcrBank" protobuf:"1"`
+	PCRs    []PCRValueSpec `yaml:"pcrs" protobuf:"2"`
+	// SHA256 digest of the raw event log.
+	EventLogDigest string `yaml:"eventLogDigest,omitempty" protobuf:"3"`
+	EventLogEvents int    `yaml:"eventLogEvents,omitempty" protobuf:"4"`
+	// EventLogVerified is set when replaying the event log produces the firmware PCR (0-7) values.
+	EventLogVerified bool `yaml:"eventLogVerified" protobuf:"5"`
+}
+
+// PCRValueSpec describes a single PCR value.
+//
+//gotagsrewrite:gen
+type PCRValueSpec struct {
+	PCR   int    `yaml:"pcr" protobuf:"1"`
+	Value string `yaml:"value" protobuf:"2"`
+}
+
+// NewMeasuredBootStatus initializes a MeasuredBootStatus resource.
+func NewMeasuredBootStatus() *MeasuredBootStatus {
+	return typed.NewResource[MeasuredBootStatusSpec, MeasuredBootStatusExtension](
+		resource.NewMetadata(NamespaceName, MeasuredBootStatusType, MeasuredBootStatusID, resource.VersionUndefined),
+		MeasuredBootStatusSpec{},
+	)
+}
+
+// MeasuredBootStatusExtension is auxiliary resource data for MeasuredBootStatus.
+type MeasuredBootStatusExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (MeasuredBootStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             MeasuredBootStatusType,
+		Aliases:          []resource.Type{"measuredboot"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "PCR Bank",
+				JSONPath: `{.pcrBank}`,
+			},
+			{
+				Name:     "Event Log Events",
+				JSONPath: `{.eventLogEvents}`,
+			},
+			{
+				Name:     "Event Log Verified",
+				JSONPath: `{.eventLogVerified}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[MeasuredBootStatusSpec](MeasuredBootStatusType, &MeasuredBootStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,87 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// ProcessReaperStatusType is type of ProcessReaperStatus resource.
+const ProcessReaperStatusType = resource.Type("ProcessReaperStatuses.runtime.talos.dev")
+
+// ProcessReaperStatusID is the ID of the singleton ProcessReaperStatus resource.
+const ProcessReaperStatusID = resource.ID("reaper")
+
+// ProcessReaperStatus resource holds the statistics of the processes reaped by machined (PID 1).
+type ProcessReaperStatus = typed.Resource[ProcessReaperStatusSpec, ProcessReaperStatusExtension]
+
+// ProcessReaperStatusSpec describes the reaped, orphaned and zombie processes.
+//
+// Zombie and orphan counts are sampled periodically, so short-lived processes might be missed.
+//
+//gotagsrewrite:gen
+type ProcessReaperStatusSpec struct {
+	ReapedProcesses   uint64              `yaml:"reapedProcesses" protobuf:"1"`
+	OrphanedProcesses uint64              `yaml:"orphanedProcesses" protobuf:"2"`
+	ZombieProcesses   uint64              `yaml:"zombieProcesses" protobuf:"3"`
+	ZombieParents     []ProcessParentSpec `yaml:"zombieParents,omitempty" protobuf:"4"`
+	OrphanParents     []ProcessParentSpec `yaml:"orphanParents,omitempty" protobuf:"5"`
+}
+
+// ProcessParentSpec describes the parent command of the zombie or orphaned processes.
+//
+//gotagsrewrite:gen
+type ProcessParentSpec struct {
+	Command string `yaml:"command" protobuf:"1"`
+	Count   uint64 `yaml:"count" protobuf:"2"`
+}
+
+// NewProcessReaperStatus initializes a ProcessReaperStatus resource.
+func NewProcessReaperStatus() *ProcessReaperStatus {
+	return typed.NewResource[ProcessReaperStatusSpec, ProcessReaperStatusExtension](
+		resource.NewMetadata(NamespaceName, ProcessReaperStatusType, ProcessReaperStatusID, resource.VersionUndefined),
+		ProcessReaperStatusSpec{},
+	)
+}
+
+// ProcessReaperStatusExtension is auxiliary resource data for ProcessReaperStatus.
+type ProcessReaperStatusExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (ProcessReaperStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             ProcessReaperStatusType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Reaped",
+				JSONPath: `{.reapedProcesses}`,
+			},
+			{
+				Name:     "Orphaned",
+				JSONPath: `{.orphanedProcesses}`,
+			},
+			{
+				Name:     "Zombies",
+				JSONPath: `{.zombieProcesses}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[ProcessReaperStatusSpec](ProcessReaperStatusType, &ProcessReaperStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -25,27 +25,39 @@ func TestRegisterResource(t *testing.T) {
 
 	for _, resource := range []meta.ResourceWithRD{
 		&runtime.BootedEntry{},
+		&runtime.ConfigValidationStatus{},
 		&runtime.DevicesStatus{},
 		&runtime.Diagnostic{},
 		&runtime.EventSinkConfig{},
 		&runtime.ExtensionStatus{},
+		&runtime.ExtensionVerificationStatus{},
 		&runtime.KernelCmdline{},
 		&runtime.KernelModuleSpec{},
+		&runtime.KernelModuleStatus{},
 		&runtime.KernelParamSpec{},
 		&runtime.KernelParamStatus{},
 		&runtime.KmsgLogConfig{},
+		&runtime.KmsgLogDeliveryStatus{},
 		&runtime.LoadedKernelModule{},
 		&runtime.MachineStatus{},
 		&runtime.MachineResetSignal{},
 		&runtime.MaintenanceServiceConfig{},
 		&runtime.MaintenanceServiceRequest{},
+		&runtime.MeasuredBootStatus{},
 		&runtime.MetaKey{},
 		&runtime.MetaLoaded{},
 		&runtime.MountStatus{},
 		&runtime.PlatformMetadata{},
+		&runtime.ProcessReaperStatus{},
 		&runtime.SBOMItem{},
+		&runtime.SecureBootStatus{},
 		&runtime.SecurityState{},
+		&runtime.ServiceResourcesStatus{},
+		&runtime.ShutdownInhibitor{},
+		&runtime.SysctlStatus{},
+		&runtime.TPMStatus{},
 		&runtime.UniqueMachineToken{},
+		&runtime.UpgradeStatus{},
 		&runtime.WatchdogTimerConfig{},
 		&runtime.WatchdogTimerStatus{},
 	} {
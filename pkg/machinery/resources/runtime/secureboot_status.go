@@ -0,0 +1,94 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// SecureBootStatusType is type of SecureBootStatus resource.
+const SecureBootStatusType = resource.Type("SecureBootStatuses.runtime.talos.dev")
+
+// SecureBootStatusID is the ID of the singleton SecureBootStatus resource.
+const SecureBootStatusID = resource.ID("secureboot")
+
+// SecureBootStatus resource holds the UEFI SecureBoot state and the enrolled keys.
+type SecureBootStatus = typed.Resource[SecureBootStatusSpec, SecureBootStatusExtension]
+
+// SecureBootStatusSpec describes the UEFI SecureBoot state and the enrolled keys.
+//
+// Certificates are identified by the SHA256 fingerprints of their DER encoding.
+//
+//gotagsrewrite:gen
+type SecureBootStatusSpec struct {
+	SecureBoot      bool     `yaml:"secureBoot" protobuf:"1"`
+	SetupMode       bool     `yaml:"setupMode" protobuf:"2"`
+	PKFingerprints  []string `yaml:"pkFingerprints,omitempty" protobuf:"3"`
+	KEKFingerprints []string `yaml:"kekFingerprints,omitempty" protobuf:"4"`
+	DBFingerprints  []string `yaml:"dbFingerprints,omitempty" protobuf:"5"`
+	// Certificates from db which verified the boot components, as recorded in the TPM event log.
+	BootAuthorityFingerprints []string `yaml:"bootAuthorityFingerprints,omitempty" protobuf:"6"`
+	// SigningKeyEnrolled is set when the boot was verified by a db certificate which is not a well-known vendor certificate.
+	SigningKeyEnrolled bool `yaml:"signingKeyEnrolled" protobuf:"7"`
+	// UKIVerified is set when SecureBoot is enforced, and Talos was booted from a UKI.
+	UKIVerified bool `yaml:"ukiVerified" protobuf:"8"`
+}
+
+// Enforced returns true if the firmware enforces the SecureBoot signature verification.
+func (spec *SecureBootStatusSpec) Enforced() bool {
+	return spec.SecureBoot && !spec.SetupMode
+}
+
+// NewSecureBootStatus initializes a SecureBootStatus resource.
+func NewSecureBootStatus() *SecureBootStatus {
+	return typed.NewResource[SecureBootStatusSpec, SecureBootStatusExtension](
+		resource.NewMetadata(NamespaceName, SecureBootStatusType, SecureBootStatusID, resource.VersionUndefined),
+		SecureBootStatusSpec{},
+	)
+}
+
+// SecureBootStatusExtension is auxiliary resource data for SecureBootStatus.
+type SecureBootStatusExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (SecureBootStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             SecureBootStatusType,
+		Aliases:          []resource.Type{"secureboot"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "SecureBoot",
+				JSONPath: `{.secureBoot}`,
+			},
+			{
+				Name:     "SetupMode",
+				JSONPath: `{.setupMode}`,
+			},
+			{
+				Name:     "SigningKeyEnrolled",
+				JSONPath: `{.signingKeyEnrolled}`,
+			},
+			{
+				Name:     "UKIVerified",
+				JSONPath: `{.ukiVerified}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[SecureBootStatusSpec](SecureBootStatusType, &SecureBootStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,81 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// ServiceResourcesStatusType is type of ServiceResourcesStatus resource.
+const ServiceResourcesStatusType = resource.Type("ServiceResourcesStatuses.runtime.talos.dev")
+
+// ServiceResourcesStatus resource holds the effective resource protection of a system service.
+//
+// Resource ID is the service ID.
+type ServiceResourcesStatus = typed.Resource[ServiceResourcesStatusSpec, ServiceResourcesStatusExtension]
+
+// ServiceResourcesStatusSpec describes the effective resource protection of a system service.
+//
+//gotagsrewrite:gen
+type ServiceResourcesStatusSpec struct {
+	CgroupPath  string `yaml:"cgroupPath" protobuf:"1"`
+	OOMScoreAdj int    `yaml:"oomScoreAdj" protobuf:"2"`
+	// MemoryMin is the hard memory protection (cgroup memory.min) in bytes.
+	MemoryMin uint64 `yaml:"memoryMin" protobuf:"3"`
+	// MemoryLow is the soft memory protection (cgroup memory.low) in bytes.
+	MemoryLow uint64 `yaml:"memoryLow" protobuf:"4"`
+}
+
+// NewServiceResourcesStatus initializes a ServiceResourcesStatus resource.
+func NewServiceResourcesStatus(id string) *ServiceResourcesStatus {
+	return typed.NewResource[ServiceResourcesStatusSpec, ServiceResourcesStatusExtension](
+		resource.NewMetadata(NamespaceName, ServiceResourcesStatusType, id, resource.VersionUndefined),
+		ServiceResourcesStatusSpec{},
+	)
+}
+
+// ServiceResourcesStatusExtension is auxiliary resource data for ServiceResourcesStatus.
+type ServiceResourcesStatusExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (ServiceResourcesStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             ServiceResourcesStatusType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Cgroup",
+				JSONPath: `{.cgroupPath}`,
+			},
+			{
+				Name:     "OOM Score Adj",
+				JSONPath: `{.oomScoreAdj}`,
+			},
+			{
+				Name:     "Memory Min",
+				JSONPath: `{.memoryMin}`,
+			},
+			{
+				Name:     "Memory Low",
+				JSONPath: `{.memoryLow}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[ServiceResourcesStatusSpec](ServiceResourcesStatusType, &ServiceResourcesStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,90 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// ShutdownInhibitorType is type of ShutdownInhibitor resource.
+const ShutdownInhibitorType = resource.Type("ShutdownInhibitors.runtime.talos.dev")
+
+// ShutdownInhibitor resource holds a shutdown inhibitor registered via the machine API.
+//
+// The reboot, shutdown and upgrade sequences wait for the inhibitors to be released.
+type ShutdownInhibitor = typed.Resource[ShutdownInhibitorSpec, ShutdownInhibitorExtension]
+
+// ShutdownInhibitorSpec describes a shutdown inhibitor.
+//
+//gotagsrewrite:gen
+type ShutdownInhibitorSpec struct {
+	Who string `yaml:"who" protobuf:"1"`
+	Why string `yaml:"why" protobuf:"2"`
+	// MaxHold is the maximum time the inhibitor holds the shutdown.
+	MaxHold  time.Duration `yaml:"maxHold" protobuf:"3"`
+	Acquired time.Time     `yaml:"acquired" protobuf:"4"`
+	// Owner is the authenticated identity of the client which acquired the inhibitor.
+	Owner string `yaml:"owner,omitempty" protobuf:"5"`
+}
+
+// Remaining returns the time left until the inhibitor expires, counted from the acquisition.
+func (spec *ShutdownInhibitorSpec) Remaining(now time.Time) time.Duration {
+	return max(spec.Acquired.Add(spec.MaxHold).Sub(now), 0)
+}
+
+// Expired returns true if the inhibitor is held for longer than its maximum hold time.
+func (spec *ShutdownInhibitorSpec) Expired(now time.Time) bool {
+	return spec.Remaining(now) == 0
+}
+
+// NewShutdownInhibitor initializes a ShutdownInhibitor resource.
+func NewShutdownInhibitor(id resource.ID) *ShutdownInhibitor {
+	return typed.NewResource[ShutdownInhibitorSpec, ShutdownInhibitorExtension](
+		resource.NewMetadata(NamespaceName, ShutdownInhibitorType, id, resource.VersionUndefined),
+		ShutdownInhibitorSpec{},
+	)
+}
+
+// ShutdownInhibitorExtension is auxiliary resource data for ShutdownInhibitor.
+type ShutdownInhibitorExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (ShutdownInhibitorExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             ShutdownInhibitorType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Who",
+				JSONPath: `{.who}`,
+			},
+			{
+				Name:     "Why",
+				JSONPath: `{.why}`,
+			},
+			{
+				Name:     "Max Hold",
+				JSONPath: `{.maxHold}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[ShutdownInhibitorSpec](ShutdownInhibitorType, &ShutdownInhibitor{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,84 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// SysctlStatusType is type of SysctlStatus resource.
+const SysctlStatusType = resource.Type("SysctlStatuses.runtime.talos.dev")
+
+// SysctlStatusID is the ID of the singleton SysctlStatus resource.
+const SysctlStatusID = resource.ID("kernel-params")
+
+// SysctlStatus resource holds the result of applying kernel params.
+type SysctlStatus = typed.Resource[SysctlStatusSpec, SysctlStatusExtension]
+
+// SysctlStatusSpec describes the result of applying kernel params.
+//
+//gotagsrewrite:gen
+type SysctlStatusSpec struct {
+	// Applied is a list of kernel params which were applied successfully.
+	Applied []string `yaml:"applied" protobuf:"1"`
+	// Failed is a list of kernel params which failed to be applied.
+	Failed []SysctlFailure `yaml:"failed" protobuf:"2"`
+	// Skipped is a list of kernel params which are not supported by the running kernel.
+	Skipped []string `yaml:"skipped" protobuf:"3"`
+}
+
+// SysctlFailure describes a kernel param which failed to be applied.
+//
+//gotagsrewrite:gen
+type SysctlFailure struct {
+	Key   string `yaml:"key" protobuf:"1"`
+	Value string `yaml:"value" protobuf:"2"`
+	Error string `yaml:"error" protobuf:"3"`
+	Errno uint32 `yaml:"errno,omitempty" protobuf:"4"`
+}
+
+// NewSysctlStatus initializes a SysctlStatus resource.
+func NewSysctlStatus() *SysctlStatus {
+	return typed.NewResource[SysctlStatusSpec, SysctlStatusExtension](
+		resource.NewMetadata(NamespaceName, SysctlStatusType, SysctlStatusID, resource.VersionUndefined),
+		SysctlStatusSpec{},
+	)
+}
+
+// SysctlStatusExtension is auxiliary resource data for SysctlStatus.
+type SysctlStatusExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (SysctlStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             SysctlStatusType,
+		Aliases:          []resource.Type{"sysctlstatus"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Failed",
+				JSONPath: `{.failed[*].key}`,
+			},
+			{
+				Name:     "Skipped",
+				JSONPath: `{.skipped}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[SysctlStatusSpec](SysctlStatusType, &SysctlStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
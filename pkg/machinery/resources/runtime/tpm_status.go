@@ -0,0 +1,77 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// TPMStatusType is type of TPMStatus resource.
+const TPMStatusType = resource.Type("TPMStatuses.runtime.talos.dev")
+
+// TPMStatusID is the ID of the singleton TPMStatus resource.
+const TPMStatusID = resource.ID("tpm")
+
+// TPMStatus resource describes the TPM 2.0 device, it is not created if the node has no TPM.
+type TPMStatus = typed.Resource[TPMStatusSpec, TPMStatusExtension]
+
+// TPMStatusSpec describes the TPM 2.0 device.
+//
+//gotagsrewrite:gen
+type TPMStatusSpec struct {
+	Manufacturer    string `yaml:"manufacturer" protobuf:"1"`
+	VendorInfo      string `yaml:"vendorInfo,omitempty" protobuf:"2"`
+	FirmwareVersion string `yaml:"firmwareVersion" protobuf:"3"`
+	// Allocated PCR banks, e.g. sha256.
+	PCRBanks []string `yaml:"pcrBanks" protobuf:"4"`
+}
+
+// NewTPMStatus initializes a TPMStatus resource.
+func NewTPMStatus() *TPMStatus {
+	return typed.NewResource[TPMStatusSpec, TPMStatusExtension](
+		resource.NewMetadata(NamespaceName, TPMStatusType, TPMStatusID, resource.VersionUndefined),
+		TPMStatusSpec{},
+	)
+}
+
+// TPMStatusExtension is auxiliary resource data for TPMStatus.
+type TPMStatusExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (TPMStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             TPMStatusType,
+		Aliases:          []resource.Type{"tpm"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Manufacturer",
+				JSONPath: `{.manufacturer}`,
+			},
+			{
+				Name:     "Firmware",
+				JSONPath: `{.firmwareVersion}`,
+			},
+			{
+				Name:     "PCR Banks",
+				JSONPath: `{.pcrBanks}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[TPMStatusSpec](TPMStatusType, &TPMStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,104 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// UpgradeStatusType is type of UpgradeStatus resource.
+const UpgradeStatusType = resource.Type("UpgradeStatuses.runtime.talos.dev")
+
+// UpgradeStatusID is the ID of the UpgradeStatus resource describing the last upgrade attempt.
+const UpgradeStatusID = resource.ID("last")
+
+// Upgrade phases recorded in the upgrade checkpoint.
+const (
+	// UpgradePhasePrepare is recorded before the workloads and services are stopped.
+	UpgradePhasePrepare = "prepare"
+	// UpgradePhaseInstall is recorded before the installer is run.
+	UpgradePhaseInstall = "install"
+	// UpgradePhaseInstalled is recorded once the new image is installed and set as the default boot entry.
+	UpgradePhaseInstalled = "installed"
+	// UpgradePhaseBoot is recorded once the machine boots into the new image after an interrupted or completed upgrade.
+	UpgradePhaseBoot = "boot"
+)
+
+// Upgrade outcomes, the outcome is empty while the upgrade is in progress.
+const (
+	UpgradeOutcomeSucceeded  = "succeeded"
+	UpgradeOutcomeFailed     = "failed"
+	UpgradeOutcomeRolledBack = "rolled back"
+)
+
+// UpgradeStatus resource holds the record of the last upgrade attempt.
+type UpgradeStatus = typed.Resource[UpgradeStatusSpec, UpgradeStatusExtension]
+
+// UpgradeStatusSpec describes the last upgrade attempt.
+//
+// The spec is persisted in META as the upgrade checkpoint.
+//
+//gotagsrewrite:gen
+type UpgradeStatusSpec struct {
+	Image       string `yaml:"image" protobuf:"1"`
+	FromVersion string `yaml:"fromVersion" protobuf:"2"`
+	// ToVersion is set once the machine boots into the new image.
+	ToVersion string    `yaml:"toVersion,omitempty" protobuf:"3"`
+	Phase     string    `yaml:"phase" protobuf:"4"`
+	Outcome   string    `yaml:"outcome,omitempty" protobuf:"5"`
+	Message   string    `yaml:"message,omitempty" protobuf:"6"`
+	StartedAt time.Time `yaml:"startedAt" protobuf:"7"`
+	UpdatedAt time.Time `yaml:"updatedAt" protobuf:"8"`
+}
+
+// NewUpgradeStatus initializes an UpgradeStatus resource.
+func NewUpgradeStatus() *UpgradeStatus {
+	return typed.NewResource[UpgradeStatusSpec, UpgradeStatusExtension](
+		resource.NewMetadata(NamespaceName, UpgradeStatusType, UpgradeStatusID, resource.VersionUndefined),
+		UpgradeStatusSpec{},
+	)
+}
+
+// UpgradeStatusExtension is auxiliary resource data for UpgradeStatus.
+type UpgradeStatusExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (UpgradeStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             UpgradeStatusType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Image",
+				JSONPath: `{.image}`,
+			},
+			{
+				Name:     "Phase",
+				JSONPath: `{.phase}`,
+			},
+			{
+				Name:     "Outcome",
+				JSONPath: `{.outcome}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[UpgradeStatusSpec](UpgradeStatusType, &UpgradeStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
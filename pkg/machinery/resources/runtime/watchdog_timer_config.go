@@ -28,8 +28,10 @@ const WatchdogTimerConfigID resource.ID = "timer"
 //
 //gotagsrewrite:gen
 type WatchdogTimerConfigSpec struct {
-	Device  string        `yaml:"device" protobuf:"1"`
-	Timeout time.Duration `yaml:"timeout" protobuf:"2"`
+	Device         string        `yaml:"device" protobuf:"1"`
+	Timeout        time.Duration `yaml:"timeout" protobuf:"2"`
+	RequireKubelet bool          `yaml:"requireKubelet,omitempty" protobuf:"3"`
+	ProbeURL       string        `yaml:"probeURL,omitempty" protobuf:"4"`
 }
 
 // NewWatchdogTimerConfig initializes a WatchdogTimerConfig resource.
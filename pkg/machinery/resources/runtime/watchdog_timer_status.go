@@ -28,6 +28,10 @@ type WatchdogTimerStatusSpec struct {
 	Device       string        `yaml:"device" protobuf:"1"`
 	Timeout      time.Duration `yaml:"timeout" protobuf:"2"`
 	FeedInterval time.Duration `yaml:"feedInterval" protobuf:"3"`
+	// LastFeed is the time the watchdog was fed last time.
+	LastFeed time.Time `yaml:"lastFeed" protobuf:"4"`
+	// FeedBlockedBy is the reason the watchdog is not being fed, empty if it is fed.
+	FeedBlockedBy string `yaml:"feedBlockedBy,omitempty" protobuf:"5"`
 }
 
 // NewWatchdogTimerStatus initializes a WatchdogTimerStatus resource.
@@ -56,6 +60,10 @@ func (WatchdogTimerStatusExtension) ResourceDefinition() meta.ResourceDefinition
 				Name:     "Timeout",
 				JSONPath: `{.timeout}`,
 			},
+			{
+				Name:     "Last Feed",
+				JSONPath: `{.lastFeed}`,
+			},
 		},
 	}
 }
@@ -0,0 +1,293 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package schema generates JSON Schema for the resource specs.
+//
+// The schema describes the spec as it is marshaled to YAML (e.g. in 'talosctl get -o yaml' output),
+// so it follows the YAML field names and the custom YAML/text marshalers of the spec types.
+//
+// The field schema can be annotated with the `schema` struct tag:
+//
+//	Mode string `yaml:"mode" schema:"enum=auto|manual"`
+//	Old  string `yaml:"old,omitempty" schema:"deprecated"`
+//
+// Enum values of the enumer-generated types are discovered automatically.
+package schema
+
+import (
+	"encoding"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"gopkg.in/yaml.v3"
+)
+
+// Draft is the JSON Schema draft used for the generated schemas.
+const Draft = "https://json-schema.org/draft/2020-12/schema"
+
+// Schema is a JSON Schema document.
+type Schema struct {
+	Schema               string             `json:"$schema,omitempty"`
+	Title                string             `json:"title,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	Minimum              *int64             `json:"minimum,omitempty"`
+	Deprecated           bool               `json:"deprecated,omitempty"`
+}
+
+// ForResource generates the JSON Schema for the spec of the resource.
+func ForResource(r meta.ResourceWithRD) *Schema {
+	schema := ForSpec(r.Spec())
+
+	schema.Schema = Draft
+	schema.Title = r.ResourceDefinition().Type
+
+	return schema
+}
+
+// ForSpec generates the JSON Schema for the resource spec.
+func ForSpec(spec any) *Schema {
+	return ForType(reflect.TypeOf(spec))
+}
+
+// ForType generates the JSON Schema for the Go type.
+func ForType(typ reflect.Type) *Schema {
+	g := generator{
+		visiting: map[reflect.Type]struct{}{},
+	}
+
+	return g.schemaFor(typ)
+}
+
+var (
+	timeType          = reflect.TypeFor[time.Time]()
+	durationType      = reflect.TypeFor[time.Duration]()
+	yamlMarshalerType = reflect.TypeFor[yaml.Marshaler]()
+	textMarshalerType = reflect.TypeFor[encoding.TextMarshaler]()
+)
+
+// maxEnumValue limits the discovery of the enum values.
+const maxEnumValue = 1 << 16
+
+type generator struct {
+	visiting map[reflect.Type]struct{}
+}
+
+//nolint:gocyclo,cyclop
+func (g *generator) schemaFor(typ reflect.Type) *Schema {
+	if typ == nil {
+		return &Schema{}
+	}
+
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	switch {
+	case typ == timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case typ == durationType:
+		return &Schema{Type: "string"}
+	case implements(typ, yamlMarshalerType):
+		// custom YAML representation, can't be described
+		return &Schema{}
+	case implements(typ, textMarshalerType):
+		return &Schema{Type: "string", Enum: enumValues(typ)}
+	}
+
+	switch typ.Kind() { //nolint:exhaustive
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return &Schema{Type: "integer"}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return &Schema{Type: "integer", Minimum: new(int64)}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: "array", Items: g.schemaFor(typ.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: g.schemaFor(typ.Elem())}
+	case reflect.Struct:
+		if _, ok := g.visiting[typ]; ok {
+			// recursive type
+			return &Schema{Type: "object"}
+		}
+
+		g.visiting[typ] = struct{}{}
+		defer delete(g.visiting, typ)
+
+		schema := &Schema{Type: "object"}
+
+		g.addFields(schema, typ)
+
+		return schema
+	default:
+		// interfaces, etc.
+		return &Schema{}
+	}
+}
+
+// addFields adds the struct fields to the object schema following the YAML encoding rules.
+func (g *generator) addFields(schema *Schema, typ reflect.Type) {
+	for field := range fields(typ) {
+		name, opts := parseTag(field.Tag.Get("yaml"))
+		if name == "-" {
+			continue
+		}
+
+		if slices.Contains(opts, "inline") {
+			fieldType := field.Type
+
+			for fieldType.Kind() == reflect.Pointer {
+				fieldType = fieldType.Elem()
+			}
+
+			switch fieldType.Kind() { //nolint:exhaustive
+			case reflect.Map:
+				schema.AdditionalProperties = g.schemaFor(fieldType.Elem())
+			case reflect.Struct:
+				g.addFields(schema, fieldType)
+			}
+
+			continue
+		}
+
+		if name == "" {
+			name = strings.ToLower(field.Name)
+		}
+
+		fieldSchema := g.schemaFor(field.Type)
+		annotate(fieldSchema, field.Tag.Get("schema"))
+
+		if schema.Properties == nil {
+			schema.Properties = map[string]*Schema{}
+		}
+
+		schema.Properties[name] = fieldSchema
+
+		if !slices.Contains(opts, "omitempty") {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+}
+
+// fields iterates over the exported fields of the struct.
+func fields(typ reflect.Type) func(yield func(reflect.StructField) bool) {
+	return func(yield func(reflect.StructField) bool) {
+		for i := range typ.NumField() {
+			field := typ.Field(i)
+
+			if !field.IsExported() && !field.Anonymous {
+				continue
+			}
+
+			if !yield(field) {
+				return
+			}
+		}
+	}
+}
+
+// annotate applies the `schema` struct tag to the field schema.
+func annotate(schema *Schema, tag string) {
+	if tag == "" {
+		return
+	}
+
+	for option := range strings.SplitSeq(tag, ",") {
+		key, value, _ := strings.Cut(option, "=")
+
+		switch key {
+		case "deprecated":
+			schema.Deprecated = true
+		case "enum":
+			target := schema
+
+			if schema.Type == "array" && schema.Items != nil {
+				target = schema.Items
+			}
+
+			target.Enum = strings.Split(value, "|")
+		}
+	}
+}
+
+// enumValues discovers the values of the enumer-generated type.
+//
+// Enumer generates IsA<Type> method which reports whether the value is a valid enum value.
+func enumValues(typ reflect.Type) []string {
+	if typ.Name() == "" {
+		return nil
+	}
+
+	isA, ok := typ.MethodByName("IsA" + typ.Name())
+	if !ok || isA.Type.NumIn() != 1 || isA.Type.NumOut() != 1 || isA.Type.Out(0).Kind() != reflect.Bool {
+		return nil
+	}
+
+	switch typ.Kind() { //nolint:exhaustive
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+	default:
+		return nil
+	}
+
+	limit := uint64(maxEnumValue)
+
+	if typ.Bits() < 16 {
+		limit = uint64(1) << typ.Bits()
+	}
+
+	var values []string
+
+	for i := range limit {
+		value := reflect.New(typ).Elem()
+
+		switch typ.Kind() { //nolint:exhaustive
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			value.SetInt(int64(i))
+		default:
+			value.SetUint(i)
+		}
+
+		if !isA.Func.Call([]reflect.Value{value})[0].Bool() {
+			continue
+		}
+
+		text, err := value.Addr().Interface().(encoding.TextMarshaler).MarshalText()
+		if err != nil {
+			return nil
+		}
+
+		values = append(values, string(text))
+	}
+
+	return values
+}
+
+// implements returns true if the type or the pointer to the type implements the interface.
+func implements(typ, iface reflect.Type) bool {
+	return typ.Implements(iface) || reflect.PointerTo(typ).Implements(iface)
+}
+
+func parseTag(tag string) (string, []string) {
+	name, opts, _ := strings.Cut(tag, ",")
+
+	if opts == "" {
+		return name, nil
+	}
+
+	return name, strings.Split(opts, ",")
+}
@@ -0,0 +1,118 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package schema_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/cluster"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/schema"
+)
+
+func TestForResource(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name     string
+		resource meta.ResourceWithRD
+	}{
+		{
+			name:     "address_spec",
+			resource: network.NewAddressSpec(network.NamespaceName, "test"),
+		},
+		{
+			name:     "affiliate",
+			resource: cluster.NewAffiliate(cluster.NamespaceName, "test"),
+		},
+		{
+			name:     "machine_status",
+			resource: runtime.NewMachineStatus(),
+		},
+		{
+			name:     "static_pod",
+			resource: k8s.NewStaticPod(k8s.NamespaceName, "test"),
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			out, err := json.MarshalIndent(schema.ForResource(test.resource), "", "  ")
+			require.NoError(t, err)
+
+			expected, err := os.ReadFile(filepath.Join("testdata", test.name+".json"))
+			require.NoError(t, err)
+
+			assert.Equal(t, string(expected), string(out)+"\n")
+		})
+	}
+}
+
+type testNode struct {
+	Name     string      `yaml:"name"`
+	Children []*testNode `yaml:"children,omitempty"`
+}
+
+type testInline struct {
+	Inlined string `yaml:"inlined"`
+}
+
+type testSpec struct {
+	testInline `yaml:",inline"`
+
+	Mode      string            `yaml:"mode" schema:"enum=auto|manual"`
+	Modes     []string          `yaml:"modes,omitempty" schema:"enum=auto|manual"`
+	Legacy    string            `yaml:"legacy,omitempty" schema:"deprecated"`
+	Timestamp time.Time         `yaml:"timestamp"`
+	Timeout   time.Duration     `yaml:"timeout"`
+	Labels    map[string]string `yaml:"labels,omitempty"`
+	Ratio     float64           `yaml:"ratio"`
+	Tree      testNode          `yaml:"tree"`
+	Any       any               `yaml:"any"`
+	Skipped   string            `yaml:"-"`
+	NoTag     int
+}
+
+func TestForSpec(t *testing.T) {
+	t.Parallel()
+
+	out, err := json.Marshal(schema.ForSpec(&testSpec{}))
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{
+		"type": "object",
+		"properties": {
+			"any": {},
+			"inlined": {"type": "string"},
+			"labels": {"type": "object", "additionalProperties": {"type": "string"}},
+			"legacy": {"type": "string", "deprecated": true},
+			"mode": {"type": "string", "enum": ["auto", "manual"]},
+			"modes": {"type": "array", "items": {"type": "string", "enum": ["auto", "manual"]}},
+			"notag": {"type": "integer"},
+			"ratio": {"type": "number"},
+			"timeout": {"type": "string"},
+			"timestamp": {"type": "string", "format": "date-time"},
+			"tree": {
+				"type": "object",
+				"properties": {
+					"children": {"type": "array", "items": {"type": "object"}},
+					"name": {"type": "string"}
+				},
+				"required": ["name"]
+			}
+		},
+		"required": ["inlined", "mode", "timestamp", "timeout", "ratio", "tree", "any", "notag"]
+	}`, string(out))
+}
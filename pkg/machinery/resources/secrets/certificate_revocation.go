@@ -0,0 +1,88 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secrets
+
+import (
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// CertificateRevocationType is type of CertificateRevocation resource.
+const CertificateRevocationType = resource.Type("CertificateRevocations.secrets.talos.dev")
+
+// CertificateRevocationID is a resource ID of singleton instance.
+const CertificateRevocationID = resource.ID("api")
+
+// CertificateRevocation contains the revoked Talos API client certificates.
+type CertificateRevocation = typed.Resource[CertificateRevocationSpec, CertificateRevocationExtension]
+
+// CertificateRevocationSpec describes the revoked Talos API client certificates.
+//
+//gotagsrewrite:gen
+type CertificateRevocationSpec struct {
+	// SPKI fingerprints of the revoked certificates.
+	Fingerprints []string `yaml:"fingerprints,omitempty" protobuf:"1"`
+	// Serial numbers (lowercase hex) of the revoked certificates, including the ones listed in the CRL.
+	SerialNumbers []string `yaml:"serialNumbers,omitempty" protobuf:"2"`
+	// Next update time of the CRL, zero if the CRL is not configured.
+	CRLNextUpdate time.Time `yaml:"crlNextUpdate,omitempty" protobuf:"3"`
+	// Reject all client certificates once the CRL is expired.
+	DenyOnExpiredCRL bool `yaml:"denyOnExpiredCRL,omitempty" protobuf:"4"`
+	// Error loading the CRL (parsing or signature verification), the CRL entries are not applied.
+	CRLError string `yaml:"crlError,omitempty" protobuf:"5"`
+}
+
+// NewCertificateRevocation initializes a CertificateRevocation resource.
+func NewCertificateRevocation() *CertificateRevocation {
+	return typed.NewResource[CertificateRevocationSpec, CertificateRevocationExtension](
+		resource.NewMetadata(NamespaceName, CertificateRevocationType, CertificateRevocationID, resource.VersionUndefined),
+		CertificateRevocationSpec{},
+	)
+}
+
+// CertificateRevocationExtension provides auxiliary methods for CertificateRevocation.
+type CertificateRevocationExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (CertificateRevocationExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             CertificateRevocationType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Fingerprints",
+				JSONPath: "{.fingerprints}",
+			},
+			{
+				Name:     "Serial Numbers",
+				JSONPath: "{.serialNumbers}",
+			},
+			{
+				Name:     "CRL Error",
+				JSONPath: "{.crlError}",
+			},
+		},
+	}
+}
+
+// IsExpired returns true if the CRL is configured and it is past the next update time.
+func (spec *CertificateRevocationSpec) IsExpired(now time.Time) bool {
+	return !spec.CRLNextUpdate.IsZero() && now.After(spec.CRLNextUpdate)
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	if err := protobuf.RegisterDynamic[CertificateRevocationSpec](CertificateRevocationType, &CertificateRevocation{}); err != nil {
+		panic(err)
+	}
+}
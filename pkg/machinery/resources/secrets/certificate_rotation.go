@@ -0,0 +1,88 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secrets
+
+import (
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// CertificateRotationType is type of CertificateRotation resource.
+const CertificateRotationType = resource.Type("CertificateRotations.secrets.talos.dev")
+
+// Certificate components which can be rotated on request.
+//
+// The components are listed in the order they should be rotated.
+const (
+	CertificateComponentEtcd       = "etcd"
+	CertificateComponentAPIServer  = "apiserver"
+	CertificateComponentKubeconfig = "kubeconfig"
+	CertificateComponentKubelet    = "kubelet"
+	CertificateComponentTalosAPI   = "talos-api"
+)
+
+// CertificateComponents is the list of the certificate components in the rotation order.
+var CertificateComponents = []string{
+	CertificateComponentEtcd,
+	CertificateComponentAPIServer,
+	CertificateComponentKubeconfig,
+	CertificateComponentKubelet,
+	CertificateComponentTalosAPI,
+}
+
+// CertificateRotation resource requests early rotation of the certificates of a component.
+//
+// Resource ID is the component name, see CertificateComponents.
+// The resource is created by the machine API, and the certificates are regenerated
+// by the controllers which manage the component certificates each time the resource is updated.
+type CertificateRotation = typed.Resource[CertificateRotationSpec, CertificateRotationExtension]
+
+// CertificateRotationSpec describes the certificate rotation request.
+//
+//gotagsrewrite:gen
+type CertificateRotationSpec struct {
+	RequestedAt time.Time `yaml:"requestedAt" protobuf:"1"`
+}
+
+// NewCertificateRotation initializes a CertificateRotation resource.
+func NewCertificateRotation(component string) *CertificateRotation {
+	return typed.NewResource[CertificateRotationSpec, CertificateRotationExtension](
+		resource.NewMetadata(NamespaceName, CertificateRotationType, component, resource.VersionUndefined),
+		CertificateRotationSpec{},
+	)
+}
+
+// CertificateRotationExtension provides auxiliary methods for CertificateRotation.
+type CertificateRotationExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (CertificateRotationExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             CertificateRotationType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Requested At",
+				JSONPath: "{.requestedAt}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[CertificateRotationSpec](CertificateRotationType, &CertificateRotation{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,83 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secrets
+
+import (
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// CertificateStatusType is type of CertificateStatus resource.
+const CertificateStatusType = resource.Type("CertificateStatuses.secrets.talos.dev")
+
+// CertificateStatus resource holds the expiry status of a certificate managed by Talos.
+//
+// Resource ID is the name of the certificate, e.g. `kube-apiserver` or `kubelet-client`.
+type CertificateStatus = typed.Resource[CertificateStatusSpec, CertificateStatusExtension]
+
+// CertificateStatusSpec describes the expiry status of a certificate.
+//
+//gotagsrewrite:gen
+type CertificateStatusSpec struct {
+	// Component which is rotated to renew the certificate, see CertificateComponents.
+	Component    string    `yaml:"component" protobuf:"1"`
+	CommonName   string    `yaml:"commonName" protobuf:"2"`
+	SerialNumber string    `yaml:"serialNumber" protobuf:"3"`
+	NotBefore    time.Time `yaml:"notBefore" protobuf:"4"`
+	NotAfter     time.Time `yaml:"notAfter" protobuf:"5"`
+	// Number of full days until the certificate expires, negative if expired.
+	DaysRemaining int `yaml:"daysRemaining" protobuf:"6"`
+	// Lowest expiry threshold (in days) crossed by the certificate, zero if none.
+	ExpiryThreshold int `yaml:"expiryThreshold,omitempty" protobuf:"7"`
+}
+
+// NewCertificateStatus initializes a CertificateStatus resource.
+func NewCertificateStatus(id resource.ID) *CertificateStatus {
+	return typed.NewResource[CertificateStatusSpec, CertificateStatusExtension](
+		resource.NewMetadata(NamespaceName, CertificateStatusType, id, resource.VersionUndefined),
+		CertificateStatusSpec{},
+	)
+}
+
+// CertificateStatusExtension provides auxiliary methods for CertificateStatus.
+type CertificateStatusExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (CertificateStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             CertificateStatusType,
+		Aliases:          []resource.Type{"certstatus", "certstatuses"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Component",
+				JSONPath: "{.component}",
+			},
+			{
+				Name:     "Not After",
+				JSONPath: "{.notAfter}",
+			},
+			{
+				Name:     "Days Remaining",
+				JSONPath: "{.daysRemaining}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[CertificateStatusSpec](CertificateStatusType, &CertificateStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
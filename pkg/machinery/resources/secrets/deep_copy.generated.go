@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type APICertsSpec -type CertSANSpec -type EtcdCertsSpec -type EtcdRootSpec -type EncryptionSaltSpec -type KubeletSpec -type KubernetesCertsSpec -type KubernetesDynamicCertsSpec -type KubernetesRootSpec -type MaintenanceServiceCertsSpec -type MaintenanceRootSpec -type OSRootSpec -type TrustdCertsSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type APICertsSpec -type CertSANSpec -type CertificateRevocationSpec -type CertificateRotationSpec -type CertificateStatusSpec -type EtcdCertsSpec -type EtcdRootSpec -type EncryptionSaltSpec -type JoinTokenSpec -type KubeletSpec -type KubernetesCertsSpec -type KubernetesDynamicCertsSpec -type KubernetesRootSpec -type MaintenanceServiceCertsSpec -type MaintenanceRootSpec -type OSRootSpec -type OSRootStatusSpec -type TrustdCertsSpec -type TrustdPolicySpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package secrets
 
@@ -48,6 +48,32 @@ func (o CertSANSpec) DeepCopy() CertSANSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of CertificateRevocationSpec.
+func (o CertificateRevocationSpec) DeepCopy() CertificateRevocationSpec {
+	var cp CertificateRevocationSpec = o
+	if o.Fingerprints != nil {
+		cp.Fingerprints = make([]string, len(o.Fingerprints))
+		copy(cp.Fingerprints, o.Fingerprints)
+	}
+	if o.SerialNumbers != nil {
+		cp.SerialNumbers = make([]string, len(o.SerialNumbers))
+		copy(cp.SerialNumbers, o.SerialNumbers)
+	}
+	return cp
+}
+
+// DeepCopy generates a deep copy of CertificateRotationSpec.
+func (o CertificateRotationSpec) DeepCopy() CertificateRotationSpec {
+	var cp CertificateRotationSpec = o
+	return cp
+}
+
+// DeepCopy generates a deep copy of CertificateStatusSpec.
+func (o CertificateStatusSpec) DeepCopy() CertificateStatusSpec {
+	var cp CertificateStatusSpec = o
+	return cp
+}
+
 // DeepCopy generates a deep copy of EtcdCertsSpec.
 func (o EtcdCertsSpec) DeepCopy() EtcdCertsSpec {
 	var cp EtcdCertsSpec = o
@@ -85,6 +111,12 @@ func (o EncryptionSaltSpec) DeepCopy() EncryptionSaltSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of JoinTokenSpec.
+func (o JoinTokenSpec) DeepCopy() JoinTokenSpec {
+	var cp JoinTokenSpec = o
+	return cp
+}
+
 // DeepCopy generates a deep copy of KubeletSpec.
 func (o KubeletSpec) DeepCopy() KubeletSpec {
 	var cp KubeletSpec = o
@@ -224,6 +256,16 @@ func (o OSRootSpec) DeepCopy() OSRootSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of OSRootStatusSpec.
+func (o OSRootStatusSpec) DeepCopy() OSRootStatusSpec {
+	var cp OSRootStatusSpec = o
+	if o.AcceptedCAs != nil {
+		cp.AcceptedCAs = make([]string, len(o.AcceptedCAs))
+		copy(cp.AcceptedCAs, o.AcceptedCAs)
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of TrustdCertsSpec.
 func (o TrustdCertsSpec) DeepCopy() TrustdCertsSpec {
 	var cp TrustdCertsSpec = o
@@ -241,3 +283,17 @@ func (o TrustdCertsSpec) DeepCopy() TrustdCertsSpec {
 	}
 	return cp
 }
+
+// DeepCopy generates a deep copy of TrustdPolicySpec.
+func (o TrustdPolicySpec) DeepCopy() TrustdPolicySpec {
+	var cp TrustdPolicySpec = o
+	if o.AllowedSubnets != nil {
+		cp.AllowedSubnets = make([]netip.Prefix, len(o.AllowedSubnets))
+		copy(cp.AllowedSubnets, o.AllowedSubnets)
+	}
+	if o.AllowedHostnames != nil {
+		cp.AllowedHostnames = make([]string, len(o.AllowedHostnames))
+		copy(cp.AllowedHostnames, o.AllowedHostnames)
+	}
+	return cp
+}
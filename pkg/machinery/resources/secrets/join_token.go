@@ -0,0 +1,96 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secrets
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// JoinTokenType is type of JoinToken resource.
+const JoinTokenType = resource.Type("JoinTokens.secrets.talos.dev")
+
+// JoinTokenMachineConfigID is the ID of the join token from the machine configuration (.machine.token).
+const JoinTokenMachineConfigID = resource.ID("machine-config")
+
+// JoinToken describes a token accepted by trustd from the joining machines.
+type JoinToken = typed.Resource[JoinTokenSpec, JoinTokenExtension]
+
+// JoinTokenSpec describes a token accepted by trustd from the joining machines.
+//
+// The token itself is not stored, only its hash.
+//
+//gotagsrewrite:gen
+type JoinTokenSpec struct {
+	TokenHash string    `yaml:"tokenHash" protobuf:"1"`
+	CreatedAt time.Time `yaml:"createdAt,omitempty" protobuf:"2"`
+	// ExpiresAt is zero for the tokens which never expire.
+	ExpiresAt time.Time `yaml:"expiresAt,omitempty" protobuf:"3"`
+}
+
+// HashJoinToken returns the hash of the join token as stored in the JoinTokenSpec.
+func HashJoinToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(hash[:])
+}
+
+// Matches checks whether the token matches the spec hash.
+func (spec *JoinTokenSpec) Matches(token string) bool {
+	return subtle.ConstantTimeCompare([]byte(HashJoinToken(token)), []byte(spec.TokenHash)) == 1
+}
+
+// Expired checks whether the token is expired at the given time.
+func (spec *JoinTokenSpec) Expired(now time.Time) bool {
+	return !spec.ExpiresAt.IsZero() && !now.Before(spec.ExpiresAt)
+}
+
+// NewJoinToken initializes a JoinToken resource.
+func NewJoinToken(id resource.ID) *JoinToken {
+	return typed.NewResource[JoinTokenSpec, JoinTokenExtension](
+		resource.NewMetadata(NamespaceName, JoinTokenType, id, resource.VersionUndefined),
+		JoinTokenSpec{},
+	)
+}
+
+// JoinTokenExtension provides auxiliary methods for JoinToken.
+type JoinTokenExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (JoinTokenExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             JoinTokenType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		Sensitivity:      meta.Sensitive,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Created",
+				JSONPath: `{.createdAt}`,
+			},
+			{
+				Name:     "Expires",
+				JSONPath: `{.expiresAt}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	if err := protobuf.RegisterDynamic[JoinTokenSpec](JoinTokenType, &JoinToken{}); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,95 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secrets
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// OSRootStatusType is type of OSRootStatus resource.
+const OSRootStatusType = resource.Type("OSRootStatuses.secrets.talos.dev")
+
+// OSRootStatusID is a resource ID of singleton instance.
+const OSRootStatusID = resource.ID("os")
+
+// Talos API CA rotation phases as seen by the node.
+const (
+	// OSRootPhaseStable means that only the issuing CA is accepted.
+	OSRootPhaseStable = "stable"
+	// OSRootPhaseNewCAAccepted means that a CA newer than the issuing CA is accepted.
+	OSRootPhaseNewCAAccepted = "new-ca-accepted"
+	// OSRootPhaseNewCAIssuing means that a CA older than the issuing CA is still accepted.
+	OSRootPhaseNewCAIssuing = "new-ca-issuing"
+)
+
+// OSRootStatus reports which Talos API CAs are accepted and presented by the node.
+//
+// CAs are identified by their SPKI fingerprints.
+type OSRootStatus = typed.Resource[OSRootStatusSpec, OSRootStatusExtension]
+
+// OSRootStatusSpec describes the Talos API CAs accepted and presented by the node.
+//
+//gotagsrewrite:gen
+type OSRootStatusSpec struct {
+	// Issuing CA, on worker nodes it is the CA certificate from the machine configuration.
+	IssuingCA   string   `yaml:"issuingCA" protobuf:"1"`
+	AcceptedCAs []string `yaml:"acceptedCAs" protobuf:"2"`
+	// CAs which issued the certificates presented by apid and trustd, empty if the certificate is not issued yet
+	// or it is not issued by any of the accepted CAs.
+	APIServerCA    string `yaml:"apiServerCA,omitempty" protobuf:"3"`
+	APIClientCA    string `yaml:"apiClientCA,omitempty" protobuf:"4"`
+	TrustdServerCA string `yaml:"trustdServerCA,omitempty" protobuf:"5"`
+	// Rotation phase, see OSRootPhase constants.
+	Phase string `yaml:"phase" protobuf:"6"`
+	// Converged is set when all presented certificates are issued by the issuing CA.
+	Converged bool `yaml:"converged" protobuf:"7"`
+}
+
+// NewOSRootStatus initializes an OSRootStatus resource.
+func NewOSRootStatus() *OSRootStatus {
+	return typed.NewResource[OSRootStatusSpec, OSRootStatusExtension](
+		resource.NewMetadata(NamespaceName, OSRootStatusType, OSRootStatusID, resource.VersionUndefined),
+		OSRootStatusSpec{},
+	)
+}
+
+// OSRootStatusExtension provides auxiliary methods for OSRootStatus.
+type OSRootStatusExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (OSRootStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             OSRootStatusType,
+		Aliases:          []resource.Type{"osrootstatus"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Phase",
+				JSONPath: "{.phase}",
+			},
+			{
+				Name:     "Converged",
+				JSONPath: "{.converged}",
+			},
+			{
+				Name:     "Issuing CA",
+				JSONPath: "{.issuingCA}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	if err := protobuf.RegisterDynamic[OSRootStatusSpec](OSRootStatusType, &OSRootStatus{}); err != nil {
+		panic(err)
+	}
+}
@@ -10,4 +10,4 @@ import "github.com/cosi-project/runtime/pkg/resource"
 // NamespaceName contains resources containing secret material.
 const NamespaceName resource.Namespace = "secrets"
 
-//go:generate go tool github.com/siderolabs/deep-copy -type APICertsSpec -type CertSANSpec -type EtcdCertsSpec -type EtcdRootSpec -type EncryptionSaltSpec -type KubeletSpec -type KubernetesCertsSpec -type KubernetesDynamicCertsSpec -type KubernetesRootSpec -type MaintenanceServiceCertsSpec -type MaintenanceRootSpec -type OSRootSpec -type TrustdCertsSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go .
+//go:generate go tool github.com/siderolabs/deep-copy -type APICertsSpec -type CertSANSpec -type CertificateRevocationSpec -type CertificateRotationSpec -type CertificateStatusSpec -type EtcdCertsSpec -type EtcdRootSpec -type EncryptionSaltSpec -type JoinTokenSpec -type KubeletSpec -type KubernetesCertsSpec -type KubernetesDynamicCertsSpec -type KubernetesRootSpec -type MaintenanceServiceCertsSpec -type MaintenanceRootSpec -type OSRootSpec -type OSRootStatusSpec -type TrustdCertsSpec -type TrustdPolicySpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go .
@@ -26,9 +26,13 @@ func TestRegisterResource(t *testing.T) {
 	for _, resource := range []meta.ResourceWithRD{
 		&secrets.API{},
 		&secrets.CertSAN{},
+		&secrets.CertificateRevocation{},
+		&secrets.CertificateRotation{},
+		&secrets.CertificateStatus{},
 		&secrets.EncryptionSalt{},
 		&secrets.Etcd{},
 		&secrets.EtcdRoot{},
+		&secrets.JoinToken{},
 		&secrets.Kubelet{},
 		&secrets.Kubernetes{},
 		&secrets.KubernetesDynamicCerts{},
@@ -36,7 +40,9 @@ func TestRegisterResource(t *testing.T) {
 		&secrets.MaintenanceServiceCerts{},
 		&secrets.MaintenanceRoot{},
 		&secrets.OSRoot{},
+		&secrets.OSRootStatus{},
 		&secrets.Trustd{},
+		&secrets.TrustdPolicy{},
 	} {
 		assert.NoError(t, resourceRegistry.Register(ctx, resource))
 	}
@@ -0,0 +1,68 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secrets
+
+import (
+	"net/netip"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// TrustdPolicyType is type of TrustdPolicy resource.
+const TrustdPolicyType = resource.Type("TrustdPolicies.secrets.talos.dev")
+
+// TrustdPolicyID is a resource ID of singleton instance.
+const TrustdPolicyID = resource.ID("trustd")
+
+// TrustdPolicy contains the certificate signing policy of trustd.
+type TrustdPolicy = typed.Resource[TrustdPolicySpec, TrustdPolicyExtension]
+
+// TrustdPolicySpec describes the certificate signing policy of trustd.
+//
+//gotagsrewrite:gen
+type TrustdPolicySpec struct {
+	// Number of requests allowed per minute per source address, zero means no limit.
+	RequestsPerMinute int `yaml:"requestsPerMinute,omitempty" protobuf:"1"`
+	RequestBurst      int `yaml:"requestBurst,omitempty" protobuf:"2"`
+	// Subnets and hostname patterns eligible to receive a certificate, empty means any.
+	AllowedSubnets   []netip.Prefix `yaml:"allowedSubnets,omitempty" protobuf:"3"`
+	AllowedHostnames []string       `yaml:"allowedHostnames,omitempty" protobuf:"4"`
+	// Maximum lifetime of the signed certificates, zero means the default.
+	MaxCertificateLifetime time.Duration `yaml:"maxCertificateLifetime,omitempty" protobuf:"5"`
+}
+
+// NewTrustdPolicy initializes a TrustdPolicy resource.
+func NewTrustdPolicy() *TrustdPolicy {
+	return typed.NewResource[TrustdPolicySpec, TrustdPolicyExtension](
+		resource.NewMetadata(NamespaceName, TrustdPolicyType, TrustdPolicyID, resource.VersionUndefined),
+		TrustdPolicySpec{},
+	)
+}
+
+// TrustdPolicyExtension provides auxiliary methods for TrustdPolicy.
+type TrustdPolicyExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (TrustdPolicyExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             TrustdPolicyType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	if err := protobuf.RegisterDynamic[TrustdPolicySpec](TrustdPolicyType, &TrustdPolicy{}); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,100 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package time
+
+import (
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+// SourceStatusType is type of SourceStatus resource.
+const SourceStatusType = resource.Type("TimeSourceStatuses.v1alpha1.talos.dev")
+
+// SourceStatus describes the state of a single configured time server.
+type SourceStatus = typed.Resource[SourceStatusSpec, SourceStatusExtension]
+
+// SourceStatusSpec describes the state of a time server as observed by the time sync process.
+//
+//gotagsrewrite:gen
+type SourceStatusSpec struct {
+	// Server is the time server as configured (hostname, IP address or PTP device).
+	Server string `yaml:"server" protobuf:"1"`
+	// Address is the last queried address of the time server.
+	Address string `yaml:"address,omitempty" protobuf:"2"`
+	// Priority is the position of the server in the list of time servers, lower is preferred.
+	Priority int `yaml:"priority" protobuf:"3"`
+
+	// Reachable indicates whether the last query to the server succeeded.
+	Reachable bool `yaml:"reachable" protobuf:"4"`
+	// Selected indicates whether the server is the current time sync source.
+	Selected bool `yaml:"selected" protobuf:"5"`
+
+	Stratum   uint8         `yaml:"stratum" protobuf:"6"`
+	Offset    time.Duration `yaml:"offset" protobuf:"7"`
+	RTT       time.Duration `yaml:"rtt" protobuf:"8"`
+	LastQuery time.Time     `yaml:"lastQuery" protobuf:"9"`
+	LastError string        `yaml:"lastError,omitempty" protobuf:"10"`
+
+	// Authenticated indicates whether the last successful query to the server was authenticated with NTS.
+	Authenticated bool `yaml:"authenticated" protobuf:"11"`
+}
+
+// NewSourceStatus initializes a SourceStatus resource.
+func NewSourceStatus(id resource.ID) *SourceStatus {
+	return typed.NewResource[SourceStatusSpec, SourceStatusExtension](
+		resource.NewMetadata(v1alpha1.NamespaceName, SourceStatusType, id, resource.VersionUndefined),
+		SourceStatusSpec{},
+	)
+}
+
+// SourceStatusExtension provides auxiliary methods for SourceStatus.
+type SourceStatusExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (SourceStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             SourceStatusType,
+		Aliases:          []resource.Type{"timesource", "timesources"},
+		DefaultNamespace: v1alpha1.NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Priority",
+				JSONPath: "{.priority}",
+			},
+			{
+				Name:     "Reachable",
+				JSONPath: "{.reachable}",
+			},
+			{
+				Name:     "Selected",
+				JSONPath: "{.selected}",
+			},
+			{
+				Name:     "Stratum",
+				JSONPath: "{.stratum}",
+			},
+			{
+				Name:     "Offset",
+				JSONPath: "{.offset}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[SourceStatusSpec](SourceStatusType, &SourceStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
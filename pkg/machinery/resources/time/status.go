@@ -35,6 +35,9 @@ type StatusSpec struct {
 
 	// SyncDisabled indicates if time sync is disabled.
 	SyncDisabled bool `yaml:"syncDisabled" protobuf:"3"`
+
+	// Authenticated indicates whether the current time source is authenticated (NTS).
+	Authenticated bool `yaml:"authenticated" protobuf:"4"`
 }
 
 // NewStatus initializes a TimeSync resource.
@@ -5,4 +5,4 @@
 // Package time provides time-related resources.
 package time
 
-//go:generate go tool github.com/siderolabs/deep-copy -type AdjtimeStatusSpec -type StatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go .
+//go:generate go tool github.com/siderolabs/deep-copy -type AdjtimeStatusSpec -type SourceStatusSpec -type StatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go .
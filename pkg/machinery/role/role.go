@@ -32,6 +32,9 @@ const (
 	// EtcdBackup defines Talos role that allows making etcd backups.
 	EtcdBackup = Role(Prefix + "etcd:backup")
 
+	// Attestation defines Talos role that allows fetching the TPM quotes for the remote attestation.
+	Attestation = Role(Prefix + "attestation")
+
 	// Impersonator defines Talos role for impersonating another user (and their role).
 	// Used internally, but may also be granted to the user.
 	Impersonator = Role(Prefix + "impersonator")
@@ -44,7 +47,7 @@ type Set struct {
 
 var (
 	// All roles that can be granted to users.
-	All = MakeSet(Admin, Operator, Reader, EtcdBackup, Impersonator)
+	All = MakeSet(Admin, Operator, Reader, EtcdBackup, Attestation, Impersonator)
 
 	// Zero is an empty set of roles.
 	Zero = MakeSet()
@@ -117,3 +120,23 @@ func (s Set) Includes(role Role) bool {
 
 	return ok
 }
+
+// Difference returns a set of roles present in the set, but not in the other set.
+func (s Set) Difference(other Set) Set {
+	res := MakeSet()
+
+	for r := range s.roles {
+		if _, ok := other.roles[r]; !ok {
+			res.roles[r] = struct{}{}
+		}
+	}
+
+	return res
+}
+
+// IsCustom returns true if the role is not a built-in role (doesn't have the built-in role prefix).
+//
+// Custom roles are granted permissions by the API authorization policy.
+func (r Role) IsCustom() bool {
+	return !strings.HasPrefix(string(r), Prefix)
+}
@@ -15,11 +15,11 @@ import (
 func TestSet(t *testing.T) {
 	t.Parallel()
 
-	roles, unknownRoles := role.Parse([]string{"os:admin", "os:operator", "os:reader", "os:future", "os:impersonator", "", " "})
+	roles, unknownRoles := role.Parse([]string{"os:admin", "os:operator", "os:reader", "os:future", "os:impersonator", "os:attestation", "", " "})
 	assert.Equal(t, []string{"os:future"}, unknownRoles)
-	assert.Equal(t, role.MakeSet(role.Admin, role.Operator, role.Reader, role.Role("os:future"), role.Impersonator), roles)
+	assert.Equal(t, role.MakeSet(role.Admin, role.Operator, role.Reader, role.Role("os:future"), role.Impersonator, role.Attestation), roles)
 
-	assert.Equal(t, []string{"os:admin", "os:future", "os:impersonator", "os:operator", "os:reader"}, roles.Strings())
+	assert.Equal(t, []string{"os:admin", "os:attestation", "os:future", "os:impersonator", "os:operator", "os:reader"}, roles.Strings())
 	assert.Equal(t, []string(nil), role.MakeSet().Strings())
 
 	assert.True(t, roles.Includes(role.Admin))
@@ -32,3 +32,22 @@ func TestSet(t *testing.T) {
 	assert.False(t, role.MakeSet().IncludesAny(roles))
 	assert.False(t, role.MakeSet().IncludesAny(role.MakeSet()))
 }
+
+func TestSetDifference(t *testing.T) {
+	t.Parallel()
+
+	roles := role.MakeSet(role.Operator, role.Reader, role.Role("ops:rebooter"))
+
+	assert.Equal(t, []string{"ops:rebooter"}, roles.Difference(role.MakeSet(role.Operator, role.Reader, role.Admin)).Strings())
+	assert.Equal(t, roles.Strings(), roles.Difference(role.MakeSet()).Strings())
+	assert.Equal(t, []string(nil), role.MakeSet().Difference(roles).Strings())
+}
+
+func TestIsCustom(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, role.Admin.IsCustom())
+	assert.False(t, role.Role("os:future").IsCustom())
+	assert.True(t, role.Role("ops:rebooter").IsCustom())
+	assert.True(t, role.Role("auditor").IsCustom())
+}
@@ -4,11 +4,47 @@
 
 package access_test
 
-import "testing"
+import (
+	"net/netip"
+	"testing"
 
-func TestEmpty(t *testing.T) {
-	// added for accurate coverage estimation
-	//
-	// please remove it once any unit-test is added
-	// for this package
+	"github.com/stretchr/testify/assert"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+	"github.com/siderolabs/talos/pkg/provision"
+	"github.com/siderolabs/talos/pkg/provision/access"
+)
+
+type testCluster struct {
+	info provision.ClusterInfo
+}
+
+func (c testCluster) Provisioner() string { return "test" }
+
+func (c testCluster) StatePath() (string, error) { return "", nil }
+
+func (c testCluster) Info() provision.ClusterInfo { return c.info }
+
+func TestAdapterStoppedNodes(t *testing.T) {
+	adapter := access.NewAdapter(testCluster{
+		info: provision.ClusterInfo{
+			Nodes: []provision.NodeInfo{
+				{
+					Name: "controlplane-1",
+					Type: machine.TypeControlPlane,
+					IPs:  []netip.Addr{netip.MustParseAddr("10.5.0.2")},
+				},
+				{
+					Name:    "worker-1",
+					Type:    machine.TypeWorker,
+					IPs:     []netip.Addr{netip.MustParseAddr("10.5.0.3")},
+					Stopped: true,
+				},
+			},
+		},
+	})
+
+	assert.Len(t, adapter.Nodes(), 1)
+	assert.Len(t, adapter.NodesByType(machine.TypeControlPlane), 1)
+	assert.Empty(t, adapter.NodesByType(machine.TypeWorker))
 }
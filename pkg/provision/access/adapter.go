@@ -5,6 +5,8 @@
 package access
 
 import (
+	"github.com/siderolabs/gen/xslices"
+
 	"github.com/siderolabs/talos/pkg/cluster"
 	"github.com/siderolabs/talos/pkg/machinery/config/machine"
 	"github.com/siderolabs/talos/pkg/provision"
@@ -45,12 +47,15 @@ func NewAdapter(clusterInfo provision.Cluster, opts ...provision.Option) *Adapte
 
 	c := clusterInfo.Info()
 
-	nodeInfos, err := cluster.MapProvisionNodeInfosToClusterNodeInfos(c.Nodes)
+	// stopped nodes are not expected to be up, so they are not visible to the cluster checks
+	nodes := xslices.Filter(c.Nodes, func(node provision.NodeInfo) bool { return !node.Stopped })
+
+	nodeInfos, err := cluster.MapProvisionNodeInfosToClusterNodeInfos(nodes)
 	if err != nil {
 		panic(err)
 	}
 
-	nodeInfosByType, err := cluster.MapProvisionNodeInfosToNodeInfosByType(c.Nodes)
+	nodeInfosByType, err := cluster.MapProvisionNodeInfosToNodeInfosByType(nodes)
 	if err != nil {
 		panic(err)
 	}
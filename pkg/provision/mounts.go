@@ -0,0 +1,99 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package provision
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// Host mount types.
+const (
+	// HostMountTypeVirtioFS shares the directory over virtio-fs, with a virtiofsd process per mount.
+	HostMountTypeVirtioFS = "virtiofs"
+	// HostMountType9P shares the directory over virtio-9p.
+	HostMountType9P = "9p"
+)
+
+// HostMount describes a host directory shared with the node (QEMU only).
+type HostMount struct {
+	HostPath  string
+	GuestPath string
+	ReadOnly  bool
+	// Type is the filesystem type the directory is shared with.
+	Type string
+	// Tag identifies the mount in the node, it is used as the mount source.
+	Tag string
+}
+
+// String implements fmt.Stringer.
+func (mount HostMount) String() string {
+	parts := []string{"host=" + mount.HostPath, "guest=" + mount.GuestPath}
+
+	if mount.ReadOnly {
+		parts = append(parts, "readonly")
+	}
+
+	parts = append(parts, "type="+mount.Type)
+
+	return strings.Join(parts, ",")
+}
+
+// ParseHostMount parses the host mount specification.
+//
+// The format is `host=<host path>,guest=<guest path>[,readonly][,type=virtiofs|9p]`, both paths should be absolute.
+// The type defaults to virtio-fs on Linux, and to 9p on other hosts, as virtiofsd is Linux only.
+//
+//nolint:gocyclo
+func ParseHostMount(spec string) (HostMount, error) {
+	mount := HostMount{
+		Type: HostMountTypeVirtioFS,
+	}
+
+	if runtime.GOOS != "linux" {
+		mount.Type = HostMountType9P
+	}
+
+	for option := range strings.SplitSeq(spec, ",") {
+		key, value, _ := strings.Cut(option, "=")
+
+		switch key {
+		case "host":
+			mount.HostPath = value
+		case "guest":
+			mount.GuestPath = value
+		case "readonly":
+			if value != "" {
+				return HostMount{}, fmt.Errorf("invalid host mount %q: readonly doesn't accept a value", spec)
+			}
+
+			mount.ReadOnly = true
+		case "type":
+			switch value {
+			case HostMountTypeVirtioFS, HostMountType9P:
+			default:
+				return HostMount{}, fmt.Errorf("invalid host mount %q: unsupported type %q", spec, value)
+			}
+
+			mount.Type = value
+		default:
+			return HostMount{}, fmt.Errorf("invalid host mount %q: unknown option %q", spec, key)
+		}
+	}
+
+	if !filepath.IsAbs(mount.HostPath) {
+		return HostMount{}, fmt.Errorf("invalid host mount %q: host path should be absolute", spec)
+	}
+
+	if !strings.HasPrefix(mount.GuestPath, "/") {
+		return HostMount{}, fmt.Errorf("invalid host mount %q: guest path should be absolute", spec)
+	}
+
+	mount.HostPath = filepath.Clean(mount.HostPath)
+
+	return mount, nil
+}
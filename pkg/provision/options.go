@@ -5,6 +5,7 @@
 package provision
 
 import (
+	"fmt"
 	"io"
 	"os"
 	"runtime"
@@ -88,6 +89,24 @@ func WithTPM2(enabled bool) Option {
 	}
 }
 
+// WithSecureBoot enables or disables UEFI SecureBoot emulation.
+func WithSecureBoot(enabled bool) Option {
+	return func(o *Options) error {
+		o.SecureBootEnabled = enabled
+
+		return nil
+	}
+}
+
+// WithSecureBootVars sets the path to the UEFI variables store with the SecureBoot keys enrolled.
+func WithSecureBootVars(path string) Option {
+	return func(o *Options) error {
+		o.SecureBootVarsPath = path
+
+		return nil
+	}
+}
+
 // WithDebugShell drops into debug shell in initramfs.
 func WithDebugShell(enabled bool) Option {
 	return func(o *Options) error {
@@ -196,6 +215,19 @@ func WithSiderolinkAgent(v bool) Option {
 	}
 }
 
+// WithConcurrency limits the number of nodes created in parallel.
+func WithConcurrency(concurrency int) Option {
+	return func(o *Options) error {
+		if concurrency < 1 {
+			return fmt.Errorf("concurrency should be at least 1, got %d", concurrency)
+		}
+
+		o.Concurrency = concurrency
+
+		return nil
+	}
+}
+
 // Options describes Provisioner parameters.
 type Options struct {
 	LogWriter          io.Writer
@@ -213,6 +245,10 @@ type Options struct {
 	TPM1_2Enabled bool
 	// Enable TPM 2.0 emulation using swtpm.
 	TPM2Enabled bool
+	// Enable UEFI SecureBoot, requires SecureBoot enabled UEFI firmware.
+	SecureBootEnabled bool
+	// Path to the UEFI variables store with SecureBoot keys enrolled, if not set, firmware starts in the setup mode.
+	SecureBootVarsPath string
 	// Enable debug shell in the bootloader.
 	WithDebugShell bool
 	// Enable IOMMU for VMs and add a new PCI root controller and network interface.
@@ -231,6 +267,9 @@ type Options struct {
 	JSONLogsEndpoint string
 
 	SiderolinkEnabled bool
+
+	// Maximum number of nodes created in parallel (VM only), zero means no limit.
+	Concurrency int
 }
 
 // DefaultOptions returns default options.
@@ -0,0 +1,180 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package provision
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+// PublishedPort describes a node port published on the host.
+type PublishedPort struct {
+	// HostIP is the host address to listen on, empty means default host IP.
+	HostIP string
+	// HostPort is the port on the host, zero means a port allocated by the container runtime.
+	HostPort      int
+	ContainerPort int
+	Protocol      string
+}
+
+// String implements fmt.Stringer.
+func (port PublishedPort) String() string {
+	var hostPart string
+
+	switch {
+	case port.HostIP != "" && port.HostPort != 0:
+		hostPart = net.JoinHostPort(port.HostIP, strconv.Itoa(port.HostPort)) + ":"
+	case port.HostIP != "":
+		hostPart = net.JoinHostPort(port.HostIP, "") + ":"
+	case port.HostPort != 0:
+		hostPart = strconv.Itoa(port.HostPort) + ":"
+	}
+
+	return fmt.Sprintf("%s%d/%s", hostPart, port.ContainerPort, port.Protocol)
+}
+
+// Conflicts returns true if both ports can't be published on the host at the same time.
+func (port PublishedPort) Conflicts(other PublishedPort) bool {
+	if port.HostPort == 0 || port.HostPort != other.HostPort || port.Protocol != other.Protocol {
+		return false
+	}
+
+	return port.HostIP == other.HostIP || isUnspecifiedHostIP(port.HostIP) || isUnspecifiedHostIP(other.HostIP)
+}
+
+func isUnspecifiedHostIP(hostIP string) bool {
+	if hostIP == "" {
+		return true
+	}
+
+	addr, err := netip.ParseAddr(hostIP)
+
+	return err == nil && addr.IsUnspecified()
+}
+
+// ParsePublishedPorts parses the published port specification.
+//
+// The format is `[hostIP:][hostPort:]containerPort[/protocol]`, host and container ports can be ranges (`30000-30010`),
+// IPv6 host IPs should be enclosed in brackets. If the host port is omitted, it is allocated by the container runtime.
+//
+//nolint:gocyclo
+func ParsePublishedPorts(spec string) ([]PublishedPort, error) {
+	rest, protocol, ok := strings.Cut(spec, "/")
+	if !ok {
+		protocol = "tcp"
+	}
+
+	switch protocol {
+	case "tcp", "udp", "sctp":
+	default:
+		return nil, fmt.Errorf("invalid published port %q: unsupported protocol %q", spec, protocol)
+	}
+
+	var hostIP, hostPorts, containerPorts string
+
+	if strings.HasPrefix(rest, "[") {
+		ip, ports, found := strings.Cut(rest[1:], "]:")
+		if !found {
+			return nil, fmt.Errorf("invalid published port %q: unterminated IPv6 address", spec)
+		}
+
+		hostIP = ip
+
+		if hostPorts, containerPorts, ok = strings.Cut(ports, ":"); !ok {
+			return nil, fmt.Errorf("invalid published port %q: expected [hostIP:][hostPort:]containerPort[/protocol]", spec)
+		}
+	} else {
+		parts := strings.Split(rest, ":")
+
+		switch len(parts) {
+		case 1:
+			containerPorts = parts[0]
+		case 2:
+			hostPorts, containerPorts = parts[0], parts[1]
+		case 3:
+			hostIP, hostPorts, containerPorts = parts[0], parts[1], parts[2]
+		default:
+			return nil, fmt.Errorf("invalid published port %q: expected [hostIP:][hostPort:]containerPort[/protocol]", spec)
+		}
+	}
+
+	if hostIP != "" {
+		addr, err := netip.ParseAddr(hostIP)
+		if err != nil {
+			return nil, fmt.Errorf("invalid published port %q: invalid host IP %q", spec, hostIP)
+		}
+
+		hostIP = addr.String()
+	}
+
+	containerStart, containerEnd, err := parsePortRange(containerPorts)
+	if err != nil {
+		return nil, fmt.Errorf("invalid published port %q: %w", spec, err)
+	}
+
+	var hostStart, hostEnd int
+
+	if hostPorts != "" {
+		if hostStart, hostEnd, err = parsePortRange(hostPorts); err != nil {
+			return nil, fmt.Errorf("invalid published port %q: %w", spec, err)
+		}
+
+		if hostEnd-hostStart != containerEnd-containerStart {
+			return nil, fmt.Errorf("invalid published port %q: host and container port ranges should be of the same size", spec)
+		}
+	}
+
+	result := make([]PublishedPort, 0, containerEnd-containerStart+1)
+
+	for i := range containerEnd - containerStart + 1 {
+		port := PublishedPort{
+			HostIP:        hostIP,
+			ContainerPort: containerStart + i,
+			Protocol:      protocol,
+		}
+
+		if hostStart != 0 {
+			port.HostPort = hostStart + i
+		}
+
+		result = append(result, port)
+	}
+
+	return result, nil
+}
+
+func parsePortRange(ports string) (start, end int, err error) {
+	startStr, endStr, isRange := strings.Cut(ports, "-")
+
+	if start, err = parsePort(startStr); err != nil {
+		return 0, 0, err
+	}
+
+	if !isRange {
+		return start, start, nil
+	}
+
+	if end, err = parsePort(endStr); err != nil {
+		return 0, 0, err
+	}
+
+	if end < start {
+		return 0, 0, fmt.Errorf("invalid port range %q", ports)
+	}
+
+	return start, end, nil
+}
+
+func parsePort(port string) (int, error) {
+	n, err := strconv.ParseUint(port, 10, 16)
+	if err != nil || n == 0 {
+		return 0, fmt.Errorf("invalid port %q", port)
+	}
+
+	return int(n), nil
+}
@@ -34,6 +34,10 @@ func (p *provisioner) Create(ctx context.Context, request provision.ClusterReque
 		return nil, fmt.Errorf("unable to create state directory: %w", err)
 	}
 
+	if err = p.checkPortConflicts(request, &options); err != nil {
+		return nil, err
+	}
+
 	if err = p.ensureImageExists(ctx, request.Image, &options); err != nil {
 		return nil, err
 	}
@@ -67,8 +71,8 @@ func (p *provisioner) Create(ctx context.Context, request provision.ClusterReque
 			ClusterName: request.Name,
 			Network: provision.NetworkInfo{
 				Name:         request.Network.Name,
-				CIDRs:        request.Network.CIDRs[:1],
-				GatewayAddrs: request.Network.GatewayAddrs[:1],
+				CIDRs:        request.Network.CIDRs,
+				GatewayAddrs: request.Network.GatewayAddrs,
 				MTU:          request.Network.MTU,
 			},
 			Nodes:              nodeInfo,
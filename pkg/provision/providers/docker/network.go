@@ -5,13 +5,20 @@
 package docker
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
+	"net/netip"
+	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/docker/docker/api/types/filters"
 	"github.com/docker/docker/api/types/network"
 	"github.com/hashicorp/go-multierror"
+	"github.com/siderolabs/gen/xslices"
+	"github.com/siderolabs/go-pointer"
 
 	"github.com/siderolabs/talos/pkg/provision"
 )
@@ -26,25 +33,38 @@ func (p *provisioner) createNetwork(ctx context.Context, req provision.NetworkRe
 
 	// If named net already exists, see if we can reuse it
 	if len(existingNet) > 0 {
-		if existingNet[0].IPAM.Config[0].Subnet != req.CIDRs[0].String() {
-			return fmt.Errorf("existing network has differing cidr: %s vs %s", existingNet[0].IPAM.Config[0].Subnet, req.CIDRs[0].String())
+		existingCIDRs := xslices.Map(existingNet[0].IPAM.Config, func(config network.IPAMConfig) string { return config.Subnet })
+
+		for _, cidr := range req.CIDRs {
+			if !slices.Contains(existingCIDRs, cidr.String()) {
+				return fmt.Errorf("existing network has differing cidr: %s vs %s", strings.Join(existingCIDRs, ","), cidr.String())
+			}
 		}
 		// CIDRs match, we'll reuse
 		return nil
 	}
 
+	hasIPv4 := slices.ContainsFunc(req.CIDRs, func(cidr netip.Prefix) bool { return cidr.Addr().Is4() })
+	hasIPv6 := slices.ContainsFunc(req.CIDRs, func(cidr netip.Prefix) bool { return cidr.Addr().Is6() })
+
+	if hasIPv6 && req.DockerDisableIPv6 {
+		return errors.New("IPv6 network can't be created with IPv6 disabled in containers")
+	}
+
 	// Create new net
 	options := network.CreateOptions{
 		Labels: map[string]string{
 			"talos.owned":        "true",
 			"talos.cluster.name": req.Name,
 		},
+		EnableIPv4: pointer.To(hasIPv4),
+		EnableIPv6: pointer.To(hasIPv6),
 		IPAM: &network.IPAM{
-			Config: []network.IPAMConfig{
-				{
-					Subnet: req.CIDRs[0].String(),
-				},
-			},
+			Config: xslices.Map(req.CIDRs, func(cidr netip.Prefix) network.IPAMConfig {
+				return network.IPAMConfig{
+					Subnet: cidr.String(),
+				}
+			}),
 		},
 		Options: map[string]string{
 			"com.docker.network.driver.mtu": strconv.Itoa(req.MTU),
@@ -56,6 +76,57 @@ func (p *provisioner) createNetwork(ctx context.Context, req provision.NetworkRe
 	return err
 }
 
+// endpointIPAMConfig returns the static IPAM config of the container endpoint for the node addresses.
+func endpointIPAMConfig(ips []netip.Addr) *network.EndpointIPAMConfig {
+	config := &network.EndpointIPAMConfig{}
+
+	for _, ip := range ips {
+		if ip.Is6() {
+			config.IPv6Address = cmp.Or(config.IPv6Address, ip.String())
+		} else {
+			config.IPv4Address = cmp.Or(config.IPv4Address, ip.String())
+		}
+	}
+
+	return config
+}
+
+// endpointIPs returns the addresses of the container endpoint ordered by the address families of the network CIDRs.
+func endpointIPs(endpoint *network.EndpointSettings, cidrs []netip.Prefix) ([]netip.Addr, error) {
+	var ips []netip.Addr
+
+	for _, cidr := range cidrs {
+		var ip string
+
+		if cidr.Addr().Is6() {
+			ip = endpoint.GlobalIPv6Address
+
+			if ip == "" && endpoint.IPAMConfig != nil {
+				ip = endpoint.IPAMConfig.IPv6Address
+			}
+		} else {
+			ip = endpoint.IPAddress
+
+			if ip == "" && endpoint.IPAMConfig != nil {
+				ip = endpoint.IPAMConfig.IPv4Address
+			}
+		}
+
+		if ip == "" {
+			continue
+		}
+
+		addr, err := netip.ParseAddr(ip)
+		if err != nil {
+			return nil, err
+		}
+
+		ips = append(ips, addr)
+	}
+
+	return ips, nil
+}
+
 func (p *provisioner) listNetworks(ctx context.Context, name string) ([]network.Inspect, error) {
 	filters := filters.NewArgs()
 	filters.Add("label", "talos.owned=true")
@@ -5,13 +5,14 @@
 package docker
 
 import (
+	"cmp"
 	"context"
 	"encoding/base64"
 	"errors"
 	"fmt"
 	"net/netip"
-	"runtime"
 	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/docker/docker/api/types/container"
@@ -27,6 +28,9 @@ import (
 	"github.com/siderolabs/talos/pkg/provision"
 )
 
+// configPatchesLabel records the names of the node-specific config patches applied to the node config.
+const configPatchesLabel = "talos.config-patches"
+
 type portMap struct {
 	exposedPorts nat.PortSet
 	portBindings nat.PortMap
@@ -45,20 +49,7 @@ func (p *provisioner) createNodes(
 	for i, nodeReq := range nodeReqs {
 		go func(i int, nodeReq provision.NodeRequest) {
 			if i == 0 && isControlplane {
-				hostPrefix := ""
-
-				// on Linux, limit listening to localhost, on other OSes Docker engine VM is separate from the host
-				if runtime.GOOS == "linux" {
-					hostPrefix = "127.0.0.1:"
-				}
-
-				nodeReq.Ports = append(
-					[]string{
-						fmt.Sprintf("%s%d:%d/tcp", hostPrefix, p.mappedTalosAPIPort, constants.ApidPort),
-						fmt.Sprintf("%s%d:%d/tcp", hostPrefix, p.mappedKubernetesPort, constants.DefaultControlPlanePort),
-					},
-					nodeReq.Ports...,
-				)
+				nodeReq.Ports = append(p.mappedControlPlanePorts(), nodeReq.Ports...)
 			}
 
 			nodeInfo, err := p.createNode(ctx, clusterReq, nodeReq, options)
@@ -115,6 +106,10 @@ func (p *provisioner) createNode(ctx context.Context, clusterReq provision.Clust
 		},
 	}
 
+	if len(nodeReq.ConfigPatches) > 0 {
+		containerConfig.Labels[configPatchesLabel] = strings.Join(nodeReq.ConfigPatches, ",")
+	}
+
 	// Create the host config.
 	mounts := make([]mount.Mount, 0, len(constants.Overlays)+5+len(nodeReq.Mounts))
 
@@ -190,8 +185,36 @@ func (p *provisioner) createNode(ctx context.Context, clusterReq provision.Clust
 		}
 	}
 
+	if len(nodeReq.PublishedPorts) > 0 {
+		if containerConfig.ExposedPorts == nil {
+			containerConfig.ExposedPorts = nat.PortSet{}
+		}
+
+		if hostConfig.PortBindings == nil {
+			hostConfig.PortBindings = nat.PortMap{}
+		}
+
+		for _, publishedPort := range nodeReq.PublishedPorts {
+			natPort, err := nat.NewPort(publishedPort.Protocol, strconv.Itoa(publishedPort.ContainerPort))
+			if err != nil {
+				return provision.NodeInfo{}, err
+			}
+
+			binding := nat.PortBinding{
+				HostIP: cmp.Or(publishedPort.HostIP, options.DockerPortsHostIP),
+			}
+
+			if publishedPort.HostPort != 0 {
+				binding.HostPort = strconv.Itoa(publishedPort.HostPort)
+			}
+
+			containerConfig.ExposedPorts[natPort] = struct{}{}
+			hostConfig.PortBindings[natPort] = append(hostConfig.PortBindings[natPort], binding)
+		}
+	}
+
 	if nodeReq.IPs != nil {
-		networkConfig.EndpointsConfig[clusterReq.Network.Name].IPAMConfig = &network.EndpointIPAMConfig{IPv4Address: nodeReq.IPs[0].String()}
+		networkConfig.EndpointsConfig[clusterReq.Network.Name].IPAMConfig = endpointIPAMConfig(nodeReq.IPs)
 	}
 
 	// Create the container.
@@ -200,10 +223,12 @@ func (p *provisioner) createNode(ctx context.Context, clusterReq provision.Clust
 		return provision.NodeInfo{}, err
 	}
 
-	// Start the container.
-	err = p.client.ContainerStart(ctx, resp.ID, container.StartOptions{})
-	if err != nil {
-		return provision.NodeInfo{}, err
+	// Start the container, unless the node is created stopped.
+	if !nodeReq.Stopped {
+		err = p.client.ContainerStart(ctx, resp.ID, container.StartOptions{})
+		if err != nil {
+			return provision.NodeInfo{}, err
+		}
 	}
 
 	// Inspect the container.
@@ -212,17 +237,11 @@ func (p *provisioner) createNode(ctx context.Context, clusterReq provision.Clust
 		return provision.NodeInfo{}, err
 	}
 
-	// Get the container's IP address.
-	var addr netip.Addr
+	// Get the container's IP addresses.
+	var ips []netip.Addr
 
 	if network, ok := info.NetworkSettings.Networks[clusterReq.Network.Name]; ok {
-		ip := network.IPAddress
-
-		if ip == "" && network.IPAMConfig != nil {
-			ip = network.IPAMConfig.IPv4Address
-		}
-
-		addr, err = netip.ParseAddr(ip)
+		ips, err = endpointIPs(network, clusterReq.Network.CIDRs)
 		if err != nil {
 			return provision.NodeInfo{}, err
 		}
@@ -236,7 +255,13 @@ func (p *provisioner) createNode(ctx context.Context, clusterReq provision.Clust
 		NanoCPUs: nodeReq.NanoCPUs,
 		Memory:   nodeReq.Memory,
 
-		IPs: []netip.Addr{addr},
+		IPs: ips,
+
+		PublishedPorts: publishedPortsFromPortMap(info.NetworkSettings.Ports),
+
+		ConfigPatches: nodeReq.ConfigPatches,
+
+		Stopped: nodeReq.Stopped,
 	}
 
 	return nodeInfo, nil
@@ -0,0 +1,117 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package docker
+
+import (
+	"cmp"
+	"fmt"
+	"runtime"
+	"slices"
+	"strconv"
+
+	"github.com/docker/go-connections/nat"
+
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/provision"
+)
+
+// mappedControlPlanePorts returns the Talos API and Kubernetes API ports published on the first controlplane node.
+func (p *provisioner) mappedControlPlanePorts() []string {
+	hostPrefix := ""
+
+	// on Linux, limit listening to localhost, on other OSes Docker engine VM is separate from the host
+	if runtime.GOOS == "linux" {
+		hostPrefix = "127.0.0.1:"
+	}
+
+	return []string{
+		fmt.Sprintf("%s%d:%d/tcp", hostPrefix, p.mappedTalosAPIPort, constants.ApidPort),
+		fmt.Sprintf("%s%d:%d/tcp", hostPrefix, p.mappedKubernetesPort, constants.DefaultControlPlanePort),
+	}
+}
+
+// checkPortConflicts verifies that the host ports published by the nodes don't conflict with each other.
+//
+// The check runs before any container is created, so that the cluster is not left half-created.
+func (p *provisioner) checkPortConflicts(request provision.ClusterRequest, options *provision.Options) error {
+	type nodePort struct {
+		node string
+		port provision.PublishedPort
+	}
+
+	var published []nodePort
+
+	for i, nodeReq := range request.Nodes.ControlPlaneNodes() {
+		specs := slices.Concat(nodeReq.Ports, options.DockerPorts)
+
+		if i == 0 {
+			specs = append(p.mappedControlPlanePorts(), specs...)
+		}
+
+		for _, spec := range specs {
+			ports, err := provision.ParsePublishedPorts(spec)
+			if err != nil {
+				return fmt.Errorf("node %q: %w", nodeReq.Name, err)
+			}
+
+			for _, port := range ports {
+				published = append(published, nodePort{node: nodeReq.Name, port: port})
+			}
+		}
+	}
+
+	for _, nodeReq := range request.Nodes.WorkerNodes() {
+		for _, port := range nodeReq.PublishedPorts {
+			published = append(published, nodePort{node: nodeReq.Name, port: port})
+		}
+	}
+
+	for i := range published {
+		published[i].port.HostIP = cmp.Or(published[i].port.HostIP, options.DockerPortsHostIP)
+	}
+
+	for i := range published {
+		for j := range i {
+			if published[i].port.Conflicts(published[j].port) {
+				return fmt.Errorf("host port %d/%s is published on both nodes %q (%s) and %q (%s)",
+					published[i].port.HostPort, published[i].port.Protocol,
+					published[j].node, published[j].port,
+					published[i].node, published[i].port,
+				)
+			}
+		}
+	}
+
+	return nil
+}
+
+// publishedPortsFromPortMap converts the container port bindings to the list of published ports.
+func publishedPortsFromPortMap(portMap nat.PortMap) []provision.PublishedPort {
+	var result []provision.PublishedPort
+
+	for port, bindings := range portMap {
+		for _, binding := range bindings {
+			hostPort, _ := strconv.Atoi(binding.HostPort) //nolint:errcheck
+
+			result = append(result, provision.PublishedPort{
+				HostIP:        binding.HostIP,
+				HostPort:      hostPort,
+				ContainerPort: port.Int(),
+				Protocol:      port.Proto(),
+			})
+		}
+	}
+
+	slices.SortFunc(result, func(a, b provision.PublishedPort) int {
+		return cmp.Or(
+			cmp.Compare(a.ContainerPort, b.ContainerPort),
+			cmp.Compare(a.Protocol, b.Protocol),
+			cmp.Compare(a.HostIP, b.HostIP),
+			cmp.Compare(a.HostPort, b.HostPort),
+		)
+	})
+
+	return result
+}
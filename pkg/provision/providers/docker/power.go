@@ -0,0 +1,78 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package docker
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/docker/docker/api/types/container"
+
+	"github.com/siderolabs/talos/pkg/provision"
+)
+
+// StartNodes starts the containers of the nodes.
+func (p *provisioner) StartNodes(ctx context.Context, cluster provision.Cluster, nodeNames []string, opts ...provision.Option) error {
+	return p.setPowerState(ctx, cluster, nodeNames, false, opts)
+}
+
+// StopNodes stops the containers of the nodes, the containers are kept, so they can be started again.
+func (p *provisioner) StopNodes(ctx context.Context, cluster provision.Cluster, nodeNames []string, opts ...provision.Option) error {
+	return p.setPowerState(ctx, cluster, nodeNames, true, opts)
+}
+
+func (p *provisioner) setPowerState(ctx context.Context, cluster provision.Cluster, nodeNames []string, stopped bool, opts []provision.Option) error {
+	options := provision.DefaultOptions()
+
+	for _, opt := range opts {
+		if err := opt(&options); err != nil {
+			return err
+		}
+	}
+
+	containers, err := p.listNodes(ctx, cluster.Info().ClusterName)
+	if err != nil {
+		return err
+	}
+
+	action := "starting"
+	if stopped {
+		action = "stopping"
+	}
+
+	for _, nodeName := range nodeNames {
+		idx := slices.IndexFunc(containers, func(c container.Summary) bool {
+			return slices.ContainsFunc(c.Names, func(name string) bool { return strings.TrimLeft(name, "/") == nodeName })
+		})
+		if idx == -1 {
+			return fmt.Errorf("node %q not found in cluster %q", nodeName, cluster.Info().ClusterName)
+		}
+
+		fmt.Fprintf(options.LogWriter, "%s node %s\n", action, nodeName)
+
+		if stopped {
+			err = p.client.ContainerStop(ctx, containers[idx].ID, container.StopOptions{})
+		} else {
+			err = p.client.ContainerStart(ctx, containers[idx].ID, container.StartOptions{})
+		}
+
+		if err != nil {
+			return fmt.Errorf("error %s node %q: %w", action, nodeName, err)
+		}
+
+		// the power state is reflected from the container state, update the cluster info returned on create
+		if res, ok := cluster.(*result); ok {
+			for i := range res.clusterInfo.Nodes {
+				if strings.TrimLeft(res.clusterInfo.Nodes[i].Name, "/") == nodeName {
+					res.clusterInfo.Nodes[i].Stopped = stopped
+				}
+			}
+		}
+	}
+
+	return nil
+}
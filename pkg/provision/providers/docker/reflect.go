@@ -34,21 +34,25 @@ func (p *provisioner) Reflect(ctx context.Context, clusterName, stateDirectory s
 	if len(networks) > 0 {
 		network := networks[0]
 
-		var cidr netip.Prefix
-
-		cidr, err = netip.ParsePrefix(network.IPAM.Config[0].Subnet)
-		if err != nil {
-			return nil, err
-		}
-
 		res.clusterInfo.Network.Name = network.Name
-		res.clusterInfo.Network.CIDRs = []netip.Prefix{cidr}
+		res.clusterInfo.Network.CIDRs = []netip.Prefix{}
 		res.clusterInfo.Network.GatewayAddrs = []netip.Addr{}
 
-		var addr netip.Addr
+		for _, ipamConfig := range network.IPAM.Config {
+			var cidr netip.Prefix
+
+			cidr, err = netip.ParsePrefix(ipamConfig.Subnet)
+			if err != nil {
+				return nil, err
+			}
+
+			res.clusterInfo.Network.CIDRs = append(res.clusterInfo.Network.CIDRs, cidr)
+
+			var addr netip.Addr
 
-		if addr, err = netip.ParseAddr(network.IPAM.Config[0].Gateway); err == nil {
-			res.clusterInfo.Network.GatewayAddrs = append(res.clusterInfo.Network.GatewayAddrs, addr)
+			if addr, err = netip.ParseAddr(ipamConfig.Gateway); err == nil {
+				res.clusterInfo.Network.GatewayAddrs = append(res.clusterInfo.Network.GatewayAddrs, addr)
+			}
 		}
 
 		mtuStr, ok := network.Options["com.docker.network.driver.mtu"]
@@ -84,17 +88,10 @@ func (p *provisioner) Reflect(ctx context.Context, clusterName, stateDirectory s
 		var ips []netip.Addr
 
 		if network, ok := node.NetworkSettings.Networks[res.clusterInfo.Network.Name]; ok {
-			ip := network.IPAddress
-			if ip == "" && network.IPAMConfig != nil {
-				ip = network.IPAMConfig.IPv4Address
-			}
-
-			addr, err := netip.ParseAddr(ip)
+			ips, err = endpointIPs(network, res.clusterInfo.Network.CIDRs)
 			if err != nil {
 				return nil, err
 			}
-
-			ips = append(ips, addr)
 		}
 
 		for port, portBinding := range container.HostConfig.PortBindings {
@@ -115,6 +112,12 @@ func (p *provisioner) Reflect(ctx context.Context, clusterName, stateDirectory s
 
 				NanoCPUs: container.HostConfig.Resources.NanoCPUs,
 				Memory:   container.HostConfig.Resources.Memory,
+
+				PublishedPorts: publishedPortsFromPortMap(container.NetworkSettings.Ports),
+
+				ConfigPatches: configPatchesFromLabels(node.Labels),
+
+				Stopped: node.State != "running",
 			})
 	}
 
@@ -124,3 +127,11 @@ func (p *provisioner) Reflect(ctx context.Context, clusterName, stateDirectory s
 
 	return res, nil
 }
+
+func configPatchesFromLabels(labels map[string]string) []string {
+	if labels[configPatchesLabel] == "" {
+		return nil
+	}
+
+	return strings.Split(labels[configPatchesLabel], ",")
+}
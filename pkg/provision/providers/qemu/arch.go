@@ -5,8 +5,10 @@
 package qemu
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"os"
 	"os/exec"
 	"path/filepath"
 	"runtime"
@@ -81,7 +83,12 @@ type PFlash struct {
 }
 
 // PFlash returns settings for parallel flash.
-func (arch Arch) PFlash(uefiEnabled bool, extraUEFISearchPaths []string) []PFlash {
+//
+// With SecureBoot enabled, only SecureBoot enabled firmware is used, and the UEFI variables store is either
+// the empty one (SecureBoot setup mode, keys are enrolled by the booted image), or the one with keys enrolled.
+//
+//nolint:gocyclo
+func (arch Arch) PFlash(uefiEnabled bool, extraUEFISearchPaths []string, secureBoot bool, secureBootVarsPath string) []PFlash {
 	switch arch {
 	case ArchArm64:
 		// default search paths
@@ -118,8 +125,16 @@ func (arch Arch) PFlash(uefiEnabled bool, extraUEFISearchPaths []string) []PFlas
 		// Append extra search paths
 		uefiSourcePathPrefixes = append(uefiSourcePathPrefixes, extraUEFISearchPaths...)
 
+		if secureBoot {
+			uefiSourceFilesInsecure = nil
+		}
+
 		uefiSourcePaths, uefiVarsPaths := generateUEFIPFlashList(uefiSourcePathPrefixes, uefiSourceFiles, uefiVarsFiles, uefiSourceFilesInsecure)
 
+		if secureBootVarsPath != "" {
+			uefiVarsPaths = []string{secureBootVarsPath}
+		}
+
 		return []PFlash{
 			{
 				Size:        64 * 1024 * 1024,
@@ -173,8 +188,16 @@ func (arch Arch) PFlash(uefiEnabled bool, extraUEFISearchPaths []string) []PFlas
 		// Append extra search paths
 		uefiSourcePathPrefixes = append(uefiSourcePathPrefixes, extraUEFISearchPaths...)
 
+		if secureBoot {
+			uefiSourceFilesInsecure = nil
+		}
+
 		uefiSourcePaths, uefiVarsPaths := generateUEFIPFlashList(uefiSourcePathPrefixes, uefiSourceFiles, uefiVarsFiles, uefiSourceFilesInsecure)
 
+		if secureBootVarsPath != "" {
+			uefiVarsPaths = []string{secureBootVarsPath}
+		}
+
 		return []PFlash{
 			{
 				Size:        0,
@@ -247,8 +270,41 @@ func (arch Arch) TPMDeviceArgs(socketPath string) []string {
 	}
 }
 
-func (arch Arch) getMachineArgs(iommu bool) []string {
-	args := arch.QemuMachine()
+// SecureBootArgs returns arguments for qemu to enable SecureBoot.
+func (arch Arch) SecureBootArgs() []string {
+	switch arch {
+	case ArchAmd64:
+		// protect UEFI variables store from the guest OS modifications, requires SMM
+		return []string{"-global", "driver=cfi.pflash01,property=secure,value=on"}
+	default:
+		return nil
+	}
+}
+
+// DefaultCPUModel is the CPU model used if not overridden.
+const DefaultCPUModel = "max"
+
+// CPUModel returns the CPU model argument for qemu.
+//
+// Nested virtualization on x86_64 requires the virtualization extension of the host CPU vendor to be exposed.
+func (arch Arch) CPUModel(model string, nestedVirtualization bool) string {
+	if model == "" {
+		model = DefaultCPUModel
+	}
+
+	if nestedVirtualization && arch == ArchAmd64 {
+		model += "," + hostVirtualizationCPUFlag()
+	}
+
+	return model
+}
+
+func (arch Arch) getMachineArgs(machineType string, iommu, nestedVirtualization bool) []string {
+	args := machineType
+	if args == "" {
+		args = arch.QemuMachine()
+	}
+
 	if arch.acceleratorAvailable() {
 		args += ",accel=" + accelerator
 	}
@@ -258,9 +314,24 @@ func (arch Arch) getMachineArgs(iommu bool) []string {
 		args += ",kernel-irqchip=split"
 	}
 
+	// arm64 exposes virtualization extensions (EL2) via the machine property
+	if nestedVirtualization && arch == ArchArm64 {
+		args += ",virtualization=on"
+	}
+
 	if arch == ArchAmd64 {
 		args += ",smm=on"
 	}
 
 	return []string{"-machine", args}
 }
+
+// hostVirtualizationCPUFlag returns the x86_64 CPU flag enabling the hardware virtualization for the host CPU vendor.
+func hostVirtualizationCPUFlag() string {
+	cpuinfo, err := os.ReadFile("/proc/cpuinfo")
+	if err == nil && (bytes.Contains(cpuinfo, []byte("AuthenticAMD")) || bytes.Contains(cpuinfo, []byte("HygonGenuine"))) {
+		return "+svm"
+	}
+
+	return "+vmx"
+}
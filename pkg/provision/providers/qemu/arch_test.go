@@ -0,0 +1,214 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package qemu //nolint:testpackage
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/provision"
+)
+
+func TestPFlashSecureBoot(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		arch Arch
+
+		secureBoot         bool
+		secureBootVarsPath string
+
+		expectInsecure bool
+		expectedVars   []string
+	}{
+		{
+			name:           "amd64 insecure",
+			arch:           ArchAmd64,
+			expectInsecure: true,
+		},
+		{
+			name:       "amd64 secureboot",
+			arch:       ArchAmd64,
+			secureBoot: true,
+		},
+		{
+			name:               "amd64 secureboot enrolled",
+			arch:               ArchAmd64,
+			secureBoot:         true,
+			secureBootVarsPath: "/tmp/OVMF_VARS.enrolled.fd",
+			expectedVars:       []string{"/tmp/OVMF_VARS.enrolled.fd"},
+		},
+		{
+			name:           "arm64 insecure",
+			arch:           ArchArm64,
+			expectInsecure: true,
+		},
+		{
+			name:               "arm64 secureboot enrolled",
+			arch:               ArchArm64,
+			secureBoot:         true,
+			secureBootVarsPath: "/tmp/AAVMF_VARS.enrolled.fd",
+			expectedVars:       []string{"/tmp/AAVMF_VARS.enrolled.fd"},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			pflash := test.arch.PFlash(true, []string{"/extra"}, test.secureBoot, test.secureBootVarsPath)
+			require.Len(t, pflash, 2)
+
+			code, vars := pflash[0].SourcePaths, pflash[1].SourcePaths
+
+			assert.Contains(t, code, filepath.Join("/extra", secureBootCodeFile(test.arch)))
+
+			hasInsecure := false
+
+			for _, path := range code {
+				if !strings.Contains(path, "sec") && !strings.Contains(path, "-ms-") {
+					hasInsecure = true
+				}
+			}
+
+			assert.Equal(t, test.expectInsecure, hasInsecure)
+
+			if test.expectedVars != nil {
+				assert.Equal(t, test.expectedVars, vars)
+			} else {
+				assert.NotEmpty(t, vars)
+			}
+		})
+	}
+}
+
+func secureBootCodeFile(arch Arch) string {
+	if arch == ArchArm64 {
+		return "AAVMF_CODE.secboot.fd"
+	}
+
+	return "OVMF_CODE.secboot.fd"
+}
+
+func TestPFlashNoUEFI(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, ArchAmd64.PFlash(false, nil, false, ""))
+}
+
+func TestSecureBootArgs(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{"-global", "driver=cfi.pflash01,property=secure,value=on"}, ArchAmd64.SecureBootArgs())
+	assert.Nil(t, ArchArm64.SecureBootArgs())
+}
+
+func TestCheckFlashImagesSecureBoot(t *testing.T) {
+	t.Parallel()
+
+	firmwareDir := t.TempDir()
+
+	for _, name := range []string{"OVMF_CODE.secboot.fd", "OVMF_VARS.fd"} {
+		require.NoError(t, os.WriteFile(filepath.Join(firmwareDir, name), nil, 0o644))
+	}
+
+	insecureFirmwareDir := t.TempDir()
+
+	for _, name := range []string{"OVMF_CODE.fd", "OVMF_VARS.fd"} {
+		require.NoError(t, os.WriteFile(filepath.Join(insecureFirmwareDir, name), nil, 0o644))
+	}
+
+	enrolledVars := filepath.Join(t.TempDir(), "OVMF_VARS.enrolled.fd")
+	require.NoError(t, os.WriteFile(enrolledVars, nil, 0o644))
+
+	for _, test := range []struct {
+		name    string
+		options provision.Options
+
+		expectedError string
+		hostDependent bool
+	}{
+		{
+			name: "no UEFI",
+			options: provision.Options{
+				SecureBootEnabled: true,
+			},
+			expectedError: "SecureBoot requires UEFI, please enable it with --with-uefi",
+		},
+		{
+			name: "secureboot firmware",
+			options: provision.Options{
+				UEFIEnabled:          true,
+				SecureBootEnabled:    true,
+				ExtraUEFISearchPaths: []string{firmwareDir},
+			},
+		},
+		{
+			name: "enrolled vars",
+			options: provision.Options{
+				UEFIEnabled:          true,
+				SecureBootEnabled:    true,
+				SecureBootVarsPath:   enrolledVars,
+				ExtraUEFISearchPaths: []string{firmwareDir},
+			},
+		},
+		{
+			name: "missing vars",
+			options: provision.Options{
+				UEFIEnabled:          true,
+				SecureBootEnabled:    true,
+				SecureBootVarsPath:   filepath.Join(firmwareDir, "missing.fd"),
+				ExtraUEFISearchPaths: []string{firmwareDir},
+			},
+			expectedError: "the SecureBoot UEFI variables store is not accessible",
+		},
+		{
+			name: "insecure firmware only",
+			options: provision.Options{
+				UEFIEnabled:          true,
+				SecureBootEnabled:    true,
+				ExtraUEFISearchPaths: []string{insecureFirmwareDir},
+			},
+			expectedError: "the required SecureBoot enabled flash image was not found",
+			hostDependent: true,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			if test.hostDependent && hostHasSecureBootFirmware() {
+				t.Skip("SecureBoot enabled firmware is installed on the host")
+			}
+
+			check := preflightCheckContext{
+				options: test.options,
+				arch:    ArchAmd64,
+			}
+
+			err := check.checkFlashImages(t.Context())
+
+			if test.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.expectedError)
+			}
+		})
+	}
+}
+
+func hostHasSecureBootFirmware() bool {
+	for _, path := range ArchAmd64.PFlash(true, nil, true, "")[0].SourcePaths {
+		if _, err := os.Stat(path); err == nil {
+			return true
+		}
+	}
+
+	return false
+}
@@ -8,6 +8,10 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"slices"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/siderolabs/gen/xslices"
 
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/provision"
@@ -64,6 +68,14 @@ func (p *provisioner) Create(ctx context.Context, request provision.ClusterReque
 		return nil, fmt.Errorf("unable to provision CNI network: %w", err)
 	}
 
+	if len(request.Network.AdditionalNetworks) > 0 {
+		fmt.Fprintln(options.LogWriter, "creating additional networks")
+
+		if err = p.CreateAdditionalNetworks(ctx, state, request.Network, options); err != nil {
+			return nil, fmt.Errorf("unable to provision additional networks: %w", err)
+		}
+	}
+
 	fmt.Fprintln(options.LogWriter, "creating load balancer")
 
 	if err = p.CreateLoadBalancer(state, request); err != nil {
@@ -86,49 +98,65 @@ func (p *provisioner) Create(ctx context.Context, request provision.ClusterReque
 		}
 	}
 
-	var nodeInfo []provision.NodeInfo
+	var nodeInfo, workerNodeInfo, pxeNodeInfo []provision.NodeInfo
+
+	// the nodes created before the failure are saved to the state, so that the cluster can be destroyed
+	saveFailedState := func(err error) error {
+		state.ClusterInfo = p.clusterInfo(state, request, slices.Concat(nodeInfo, workerNodeInfo), pxeNodeInfo)
+
+		if saveErr := state.Save(); saveErr != nil {
+			return multierror.Append(err, saveErr)
+		}
+
+		return err
+	}
 
 	fmt.Fprintln(options.LogWriter, "creating controlplane nodes")
 
 	if nodeInfo, err = p.createNodes(ctx, state, request, request.Nodes.ControlPlaneNodes(), &options); err != nil {
-		return nil, err
+		return nil, saveFailedState(err)
 	}
 
 	// On darwin, qemu creates the bridge interface to which the dhcpd server is attached to, so at least one machine has to be created first.
 	fmt.Fprintln(options.LogWriter, "creating dhcpd")
 
 	if err = p.CreateDHCPd(ctx, state, request); err != nil {
-		return nil, fmt.Errorf("error creating dhcpd: %w", err)
+		return nil, saveFailedState(fmt.Errorf("error creating dhcpd: %w", err))
 	}
 
 	fmt.Fprintln(options.LogWriter, "creating worker nodes")
 
-	var workerNodeInfo []provision.NodeInfo
-
 	if workerNodeInfo, err = p.createNodes(ctx, state, request, request.Nodes.WorkerNodes(), &options); err != nil {
-		return nil, err
+		return nil, saveFailedState(err)
 	}
 
-	var pxeNodeInfo []provision.NodeInfo
-
 	pxeNodes := request.Nodes.PXENodes()
 	if len(pxeNodes) > 0 {
 		fmt.Fprintln(options.LogWriter, "creating PXE nodes")
 
 		if pxeNodeInfo, err = p.createNodes(ctx, state, request, pxeNodes, &options); err != nil {
-			return nil, err
+			return nil, saveFailedState(err)
 		}
 	}
 
-	nodeInfo = append(nodeInfo, workerNodeInfo...)
+	state.ClusterInfo = p.clusterInfo(state, request, slices.Concat(nodeInfo, workerNodeInfo), pxeNodeInfo)
 
+	err = state.Save()
+	if err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
+func (p *provisioner) clusterInfo(state *vm.State, request provision.ClusterRequest, nodes, extraNodes []provision.NodeInfo) provision.ClusterInfo {
 	lbPort := constants.DefaultControlPlanePort
 
 	if len(request.Network.LoadBalancerPorts) > 0 {
 		lbPort = request.Network.LoadBalancerPorts[0]
 	}
 
-	state.ClusterInfo = provision.ClusterInfo{
+	return provision.ClusterInfo{
 		ClusterName: request.Name,
 		Network: provision.NetworkInfo{
 			Name:              request.Network.Name,
@@ -136,16 +164,17 @@ func (p *provisioner) Create(ctx context.Context, request provision.ClusterReque
 			NoMasqueradeCIDRs: request.Network.NoMasqueradeCIDRs,
 			GatewayAddrs:      request.Network.GatewayAddrs,
 			MTU:               request.Network.MTU,
+
+			AdditionalNetworks: xslices.Map(state.AdditionalNetworks, func(network vm.AdditionalNetworkState) provision.AdditionalNetworkInfo {
+				return provision.AdditionalNetworkInfo{
+					Name:       network.Name,
+					BridgeName: network.BridgeName,
+					CIDR:       network.CIDR,
+				}
+			}),
 		},
-		Nodes:              nodeInfo,
-		ExtraNodes:         pxeNodeInfo,
+		Nodes:              nodes,
+		ExtraNodes:         extraNodes,
 		KubernetesEndpoint: p.GetExternalKubernetesControlPlaneEndpoint(request.Network, lbPort),
 	}
-
-	err = state.Save()
-	if err != nil {
-		return nil, err
-	}
-
-	return state, nil
 }
@@ -65,6 +65,10 @@ func (p *provisioner) Destroy(ctx context.Context, cluster provision.Cluster, op
 		return fmt.Errorf("error inspecting QEMU state, %#+v", cluster)
 	}
 
+	if err := p.destroyHostMounts(state); err != nil {
+		return err
+	}
+
 	fmt.Fprintln(options.LogWriter, "removing dhcpd")
 
 	if err := p.DestroyDHCPd(state); err != nil {
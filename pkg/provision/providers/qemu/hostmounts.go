@@ -0,0 +1,173 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package qemu
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"os/exec"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/siderolabs/talos/pkg/provision"
+	"github.com/siderolabs/talos/pkg/provision/providers/vm"
+)
+
+// virtiofsdSearchPaths are the locations of virtiofsd if it's not in PATH, distributions install it outside of PATH.
+var virtiofsdSearchPaths = []string{
+	"/usr/libexec/virtiofsd",
+	"/usr/lib/qemu/virtiofsd",
+	"/usr/lib/virtiofsd",
+}
+
+type hostMountConfig struct {
+	provision.HostMount
+
+	// virtio-fs only
+	SocketPath string
+	PidPath    string
+}
+
+func findVirtiofsd() (string, error) {
+	if path, err := exec.LookPath("virtiofsd"); err == nil {
+		return path, nil
+	}
+
+	for _, path := range virtiofsdSearchPaths {
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		}
+	}
+
+	return "", errors.New("virtiofsd not found, please install virtiofsd with the package manager or use type=9p for the host mounts")
+}
+
+func virtiofsdPidPath(state *vm.State, nodeName string, mount provision.HostMount) string {
+	return state.GetRelativePath(fmt.Sprintf("%s-%s-virtiofsd.pid", nodeName, mount.Tag))
+}
+
+func (p *provisioner) createHostMounts(state *vm.State, nodeName string, mounts []provision.HostMount) []hostMountConfig {
+	configs := make([]hostMountConfig, 0, len(mounts))
+
+	for _, mount := range mounts {
+		config := hostMountConfig{
+			HostMount: mount,
+		}
+
+		if mount.Type == provision.HostMountTypeVirtioFS {
+			config.SocketPath = state.GetRelativePath(fmt.Sprintf("%s-%s-virtiofsd.sock", nodeName, mount.Tag))
+			config.PidPath = virtiofsdPidPath(state, nodeName, mount)
+		}
+
+		configs = append(configs, config)
+	}
+
+	return configs
+}
+
+func (p *provisioner) destroyHostMounts(state *vm.State) error {
+	var multiErr *multierror.Error
+
+	for _, node := range state.ClusterInfo.Nodes {
+		for _, mount := range node.HostMounts {
+			if mount.Type != provision.HostMountTypeVirtioFS {
+				continue
+			}
+
+			multiErr = multierror.Append(multiErr, vm.StopProcessByPidfile(virtiofsdPidPath(state, node.Name, mount)))
+		}
+	}
+
+	return multiErr.ErrorOrNil()
+}
+
+// startHostMounts starts virtiofsd for the virtio-fs mounts and returns the QEMU arguments to attach the mounts.
+func startHostMounts(memSize int64, mounts []hostMountConfig) ([]string, error) {
+	var (
+		args            []string
+		virtiofsEnabled bool
+	)
+
+	for _, mount := range mounts {
+		switch mount.Type {
+		case provision.HostMountTypeVirtioFS:
+			if err := startVirtiofsd(mount); err != nil {
+				return nil, err
+			}
+
+			args = append(args,
+				"-chardev", fmt.Sprintf("socket,id=char-%s,path=%s", mount.Tag, mount.SocketPath),
+				"-device", fmt.Sprintf("vhost-user-fs-pci,chardev=char-%s,tag=%s", mount.Tag, mount.Tag),
+			)
+
+			virtiofsEnabled = true
+		case provision.HostMountType9P:
+			virtfs := fmt.Sprintf("local,path=%s,mount_tag=%s,security_model=none,id=%s", mount.HostPath, mount.Tag, mount.Tag)
+
+			if mount.ReadOnly {
+				virtfs += ",readonly=on"
+			}
+
+			args = append(args, "-virtfs", virtfs)
+		default:
+			return nil, fmt.Errorf("unsupported host mount type %q", mount.Type)
+		}
+	}
+
+	// vhost-user devices require the guest memory to be shared with virtiofsd
+	if virtiofsEnabled {
+		args = append(args,
+			"-object", fmt.Sprintf("memory-backend-memfd,id=mem,size=%dM,share=on", memSize),
+			"-numa", "node,memdev=mem",
+		)
+	}
+
+	return args, nil
+}
+
+func startVirtiofsd(mount hostMountConfig) error {
+	// virtiofsd of the previous VM run might be still around
+	if err := vm.StopProcessByPidfile(mount.PidPath); err != nil {
+		return err
+	}
+
+	if err := os.Remove(mount.SocketPath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	virtiofsd, err := findVirtiofsd()
+	if err != nil {
+		return err
+	}
+
+	virtiofsdArgs := []string{
+		"--socket-path", mount.SocketPath,
+		"--shared-dir", mount.HostPath,
+		"--cache", "auto",
+	}
+
+	if mount.ReadOnly {
+		virtiofsdArgs = append(virtiofsdArgs, "--readonly")
+	}
+
+	cmd := exec.Command(virtiofsd, virtiofsdArgs...) //nolint:noctx // runs in background
+
+	log.Printf("starting virtiofsd: %s", cmd.String())
+
+	if err = cmd.Start(); err != nil {
+		return err
+	}
+
+	if err = os.WriteFile(mount.PidPath, []byte(strconv.Itoa(cmd.Process.Pid)), 0o644); err != nil {
+		return err
+	}
+
+	return waitForFileToExist(mount.SocketPath, 5*time.Second)
+}
@@ -5,6 +5,7 @@
 package qemu
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
@@ -21,6 +22,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/siderolabs/go-blockdevice/v2/blkid"
 
+	"github.com/siderolabs/talos/pkg/provision"
 	"github.com/siderolabs/talos/pkg/provision/providers/vm"
 )
 
@@ -32,6 +34,8 @@ type LaunchConfig struct {
 	DiskPaths         []string
 	DiskDrivers       []string
 	DiskBlockSizes    []uint
+	DiskSerials       []string
+	DiskWWNs          []string
 	VCPUCount         int64
 	MemSize           int64
 	KernelImagePath   string
@@ -46,11 +50,20 @@ type LaunchConfig struct {
 	DefaultBootOrder  string
 	BootloaderEnabled bool
 	TPMConfig         tpmConfig
+	HostMounts        []hostMountConfig
 	NodeUUID          uuid.UUID
 	BadRTC            bool
 	ArchitectureData  Arch
 	WithDebugShell    bool
 	IOMMUEnabled      bool
+	SecureBoot        bool
+
+	// CPU and machine options
+	CPUModel             string
+	CPUTopology          *provision.CPUTopology
+	MachineType          string
+	NestedVirtualization bool
+	ExtraArgs            []string
 
 	// Talos config
 	Config string
@@ -63,6 +76,9 @@ type LaunchConfig struct {
 	// API
 	APIBindAddress *net.TCPAddr
 
+	// StartPoweredOff keeps the VM powered off until it is powered on via the API
+	StartPoweredOff bool
+
 	// sd-stub
 	sdStubExtraCmdline       string
 	sdStubExtraCmdlineConfig string
@@ -106,15 +122,21 @@ func launchVM(config *LaunchConfig) error {
 		bootOrder = "nc"
 	}
 
-	cpuArg := "max"
+	cpuArg := config.ArchitectureData.CPUModel(config.CPUModel, config.NestedVirtualization)
 
 	if config.BadRTC {
 		cpuArg += ",-kvmclock"
 	}
 
+	smpArg := fmt.Sprintf("cpus=%d", config.VCPUCount)
+
+	if config.CPUTopology != nil {
+		smpArg += "," + config.CPUTopology.String()
+	}
+
 	args := []string{
 		"-m", strconv.FormatInt(config.MemSize, 10),
-		"-smp", fmt.Sprintf("cpus=%d", config.VCPUCount),
+		"-smp", smpArg,
 		"-cpu", cpuArg,
 		"-nographic",
 		"-netdev", getNetdevParams(config.Network, "net0"),
@@ -134,6 +156,8 @@ func launchVM(config *LaunchConfig) error {
 		"-watchdog-action", "pause",
 	}
 
+	args = append(args, getAdditionalNICArgs(config.Network)...)
+
 	if config.WithDebugShell {
 		args = append(
 			args,
@@ -150,12 +174,14 @@ func launchVM(config *LaunchConfig) error {
 	for i, disk := range config.DiskPaths {
 		driver := config.DiskDrivers[i]
 		blockSize := config.DiskBlockSizes[i]
+		serial, wwn := diskIdentity(config, i)
 
 		switch driver {
 		case "virtio":
 			args = append(args,
 				"-drive", fmt.Sprintf("id=virtio%d,format=raw,if=none,file=%s,cache=none", i, disk),
-				"-device", fmt.Sprintf("virtio-blk-pci,drive=virtio%d,logical_block_size=%d,physical_block_size=%d", i, blockSize, blockSize),
+				"-device", fmt.Sprintf("virtio-blk-pci,drive=virtio%d,logical_block_size=%d,physical_block_size=%d", i, blockSize, blockSize)+
+					diskIdentityArgs(serial, ""),
 			)
 		case "ide":
 			args = append(args, "-drive", fmt.Sprintf("format=raw,if=ide,file=%s,cache=none", disk))
@@ -167,7 +193,7 @@ func launchVM(config *LaunchConfig) error {
 
 			args = append(args,
 				"-drive", fmt.Sprintf("id=ide%d,format=raw,if=none,file=%s", i, disk),
-				"-device", fmt.Sprintf("ide-hd,drive=ide%d,bus=ahci0.%d", i, ahciBus),
+				"-device", fmt.Sprintf("ide-hd,drive=ide%d,bus=ahci0.%d", i, ahciBus)+diskIdentityArgs(serial, wwn),
 			)
 
 			ahciBus++
@@ -179,20 +205,23 @@ func launchVM(config *LaunchConfig) error {
 
 			args = append(args,
 				"-drive", fmt.Sprintf("id=scsi%d,format=raw,if=none,file=%s,discard=unmap,aio=native,cache=none", i, disk),
-				"-device", fmt.Sprintf("scsi-hd,drive=scsi%d,bus=scsi0.0,logical_block_size=%d,physical_block_size=%d", i, blockSize, blockSize),
+				"-device", fmt.Sprintf("scsi-hd,drive=scsi%d,bus=scsi0.0,logical_block_size=%d,physical_block_size=%d", i, blockSize, blockSize)+
+					diskIdentityArgs(serial, wwn),
 			)
 		case "nvme":
 			if !nvmeAttached {
 				// [TODO]: once Talos is fixed, use multipath NVME: https://qemu-project.gitlab.io/qemu/system/devices/nvme.html
+				// all namespaces share the controller serial, so use the serial of the first NVMe disk
 				args = append(args,
-					"-device", "nvme,id=nvme-ctrl-0,serial=deadbeef",
+					"-device", "nvme,id=nvme-ctrl-0,serial="+cmp.Or(serial, "deadbeef"),
 				)
 				nvmeAttached = true
 			}
 
 			args = append(args,
 				"-drive", fmt.Sprintf("id=nvme%d,format=raw,if=none,file=%s,discard=unmap,aio=native,cache=none", i, disk),
-				"-device", fmt.Sprintf("nvme-ns,drive=nvme%d,logical_block_size=%d,physical_block_size=%d", i, blockSize, blockSize),
+				"-device", fmt.Sprintf("nvme-ns,drive=nvme%d,logical_block_size=%d,physical_block_size=%d", i, blockSize, blockSize)+
+					nvmeNamespaceIdentityArgs(wwn),
 			)
 		case "megaraid":
 			if !megaraidAttached {
@@ -204,14 +233,15 @@ func launchVM(config *LaunchConfig) error {
 
 			args = append(args,
 				"-drive", fmt.Sprintf("id=scsi%d,format=raw,if=none,file=%s,discard=unmap,aio=native,cache=none", i, disk),
-				"-device", fmt.Sprintf("scsi-hd,drive=scsi%d,bus=scsi1.0,channel=0,scsi-id=%d,lun=0,logical_block_size=%d,physical_block_size=%d", i, i, blockSize, blockSize),
+				"-device", fmt.Sprintf("scsi-hd,drive=scsi%d,bus=scsi1.0,channel=0,scsi-id=%d,lun=0,logical_block_size=%d,physical_block_size=%d", i, i, blockSize, blockSize)+
+					diskIdentityArgs(serial, wwn),
 			)
 		default:
 			return fmt.Errorf("unsupported disk driver %q", driver)
 		}
 	}
 
-	args = append(args, config.ArchitectureData.getMachineArgs(config.IOMMUEnabled)...)
+	args = append(args, config.ArchitectureData.getMachineArgs(config.MachineType, config.IOMMUEnabled, config.NestedVirtualization)...)
 
 	pflashArgs := make([]string, 2*len(config.PFlashImages))
 	for i := range config.PFlashImages {
@@ -221,6 +251,10 @@ func launchVM(config *LaunchConfig) error {
 
 	args = append(args, pflashArgs...)
 
+	if config.SecureBoot {
+		args = append(args, config.ArchitectureData.SecureBootArgs()...)
+	}
+
 	if config.ExtraISOPath != "" {
 		args = append(args,
 			"-drive",
@@ -270,6 +304,15 @@ func launchVM(config *LaunchConfig) error {
 		)
 	}
 
+	if len(config.HostMounts) > 0 {
+		hostMountArgs, err := startHostMounts(config.MemSize, config.HostMounts)
+		if err != nil {
+			return err
+		}
+
+		args = append(args, hostMountArgs...)
+	}
+
 	// ref: https://wiki.qemu.org/Features/VT-d
 	if config.IOMMUEnabled {
 		args = append(args,
@@ -324,6 +367,9 @@ func launchVM(config *LaunchConfig) error {
 		)
 	}
 
+	// extra args are appended last, so that they can override the defaults
+	args = append(args, config.ExtraArgs...)
+
 	fmt.Fprintf(os.Stderr, "starting %s with args:\n%s\n", config.ArchitectureData.QemuExecutable(), strings.Join(args, " "))
 	cmd := exec.Command( //nolint:noctx // runs in background
 		config.ArchitectureData.QemuExecutable(),
@@ -404,6 +450,10 @@ func Launch() error {
 	config.c = vm.ConfigureSignals()
 	config.controller = NewController()
 
+	if config.StartPoweredOff {
+		config.controller.state = PoweredOff
+	}
+
 	apiBindAddrs, err := netip.ParseAddr(config.APIBindAddress.IP.String())
 	if err != nil {
 		return err
@@ -516,6 +566,43 @@ func dumpIpam(config LaunchConfig) error {
 	return nil
 }
 
+// diskIdentity returns the serial number and WWN of the disk with the given index, if set.
+func diskIdentity(config *LaunchConfig, i int) (serial, wwn string) {
+	if i < len(config.DiskSerials) {
+		serial = config.DiskSerials[i]
+	}
+
+	if i < len(config.DiskWWNs) {
+		wwn = config.DiskWWNs[i]
+	}
+
+	return serial, wwn
+}
+
+// diskIdentityArgs returns the device properties setting the serial number and WWN of the disk.
+func diskIdentityArgs(serial, wwn string) string {
+	var args string
+
+	if serial != "" {
+		args += ",serial=" + serial
+	}
+
+	if wwn != "" {
+		args += ",wwn=" + wwn
+	}
+
+	return args
+}
+
+// nvmeNamespaceIdentityArgs returns the NVMe namespace properties setting the EUI-64 from the WWN.
+func nvmeNamespaceIdentityArgs(wwn string) string {
+	if wwn == "" {
+		return ""
+	}
+
+	return ",eui64=" + wwn
+}
+
 func checkPartitions(config *LaunchConfig) (bool, error) {
 	info, err := blkid.ProbePath(config.DiskPaths[0], blkid.WithSectorSize(config.DiskBlockSizes[0]))
 	if err != nil {
@@ -44,6 +44,11 @@ func getNetdevParams(networkConfig networkConfig, id string) string {
 	return netDevArg
 }
 
+// getAdditionalNICArgs returns nothing on darwin, as additional networks are not supported.
+func getAdditionalNICArgs(networkConfig) []string {
+	return nil
+}
+
 // getConfigServerAddr returns the ip accessible to the VM that will route to the config server.
 // hostAddrs is the address on which the server is accessible from the host network.
 func getConfigServerAddr(hostAddrs net.Addr, config LaunchConfig) (netip.AddrPort, error) {
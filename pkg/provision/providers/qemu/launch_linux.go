@@ -40,17 +40,44 @@ type networkConfig struct {
 	CNI               provision.CNIConfig
 	NoMasqueradeCIDRs []netip.Prefix
 
+	AdditionalNICs []additionalNICConfig
+
 	// filled by CNI invocation
 	tapName string
 	ns      ns.NetNS
 }
 
+type additionalNICConfig struct {
+	Network          string
+	MAC              string
+	CniNetworkConfig *libcni.NetworkConfigList
+
+	// filled by CNI invocation
+	tapName string
+}
+
 func getLaunchNetworkConfig(state *vm.State, clusterReq provision.ClusterRequest, nodeReq provision.NodeRequest) networkConfig {
+	var additionalNICs []additionalNICConfig
+
+	for _, nic := range nodeReq.AdditionalNICs {
+		additionalNetwork, ok := state.FindAdditionalNetwork(nic.Network)
+		if !ok {
+			continue
+		}
+
+		additionalNICs = append(additionalNICs, additionalNICConfig{
+			Network:          nic.Network,
+			MAC:              nic.MAC,
+			CniNetworkConfig: additionalNetwork.VMCNIConfig,
+		})
+	}
+
 	return networkConfig{
 		networkConfigBase: getLaunchNetworkConfigBase(state, clusterReq, nodeReq),
 		CniNetworkConfig:  state.VMCNIConfig,
 		CNI:               clusterReq.Network.CNI,
 		NoMasqueradeCIDRs: clusterReq.Network.NoMasqueradeCIDRs,
+		AdditionalNICs:    additionalNICs,
 	}
 }
 
@@ -58,6 +85,22 @@ func getNetdevParams(networkConfig networkConfig, id string) string {
 	return fmt.Sprintf("tap,id=%s,ifname=%s,script=no,downscript=no", id, networkConfig.tapName)
 }
 
+// getAdditionalNICArgs returns the QEMU arguments for the additional NICs attached to the additional networks.
+func getAdditionalNICArgs(networkConfig networkConfig) []string {
+	var args []string
+
+	for i, nic := range networkConfig.AdditionalNICs {
+		id := fmt.Sprintf("additional%d", i)
+
+		args = append(args,
+			"-netdev", fmt.Sprintf("tap,id=%s,ifname=%s,script=no,downscript=no", id, nic.tapName),
+			"-device", fmt.Sprintf("virtio-net-pci,netdev=%s,mac=%s", id, nic.MAC),
+		)
+	}
+
+	return args
+}
+
 func getConfigServerAddr(hostAddrs net.Addr, _ LaunchConfig) (net.Addr, error) {
 	return hostAddrs, nil
 }
@@ -202,6 +245,53 @@ func withNetworkContext(ctx context.Context, config *LaunchConfig, f func(config
 	config.VMMac = vmIface.Mac
 	config.Network.ns = ns
 
+	for i := range config.Network.AdditionalNICs {
+		nic := &config.Network.AdditionalNICs[i]
+
+		nicRuntimeConf := libcni.RuntimeConf{
+			ContainerID: containerID,
+			NetNS:       ns.Path(),
+			IfName:      fmt.Sprintf("veth%d", i+1),
+			Args: [][2]string{
+				{"TC_REDIRECT_TAP_NAME", fmt.Sprintf("tap%d", i+1)},
+				{"IgnoreUnknown", "1"},
+			},
+		}
+
+		nicRes, err := withCNIOperationLocked(
+			config,
+			func() (types.Result, error) {
+				return cniConfig.AddNetworkList(ctx, nic.CniNetworkConfig, &nicRuntimeConf)
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("error provisioning CNI network for the additional network %q: %w", nic.Network, err)
+		}
+
+		defer func() {
+			if e := withCNIOperationLockedNoResult(
+				config,
+				func() error {
+					return cniConfig.DelNetworkList(ctx, nic.CniNetworkConfig, &nicRuntimeConf)
+				},
+			); e != nil {
+				log.Printf("error cleaning up CNI for the additional network %q: %s", nic.Network, e)
+			}
+		}()
+
+		nicResult, err := types100.NewResultFromResult(nicRes)
+		if err != nil {
+			return fmt.Errorf("failed to parse cni result: %w", err)
+		}
+
+		_, nicTapIface, err := cniutils.VMTapPair(nicResult, containerID)
+		if err != nil {
+			return fmt.Errorf("failed to parse VM network configuration from CNI output for the additional network %q: %w", nic.Network, err)
+		}
+
+		nic.tapName = nicTapIface.Name
+	}
+
 	return f(config)
 }
 
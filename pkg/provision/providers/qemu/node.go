@@ -5,6 +5,7 @@
 package qemu
 
 import (
+	"cmp"
 	"context"
 	"crypto/rand"
 	"encoding/json"
@@ -15,8 +16,10 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
 	"syscall"
 
 	"github.com/google/uuid"
@@ -33,23 +36,26 @@ import (
 )
 
 //nolint:gocyclo,cyclop
-func (p *provisioner) createNode(ctx context.Context, state *vm.State, clusterReq provision.ClusterRequest, nodeReq provision.NodeRequest, opts *provision.Options) (provision.NodeInfo, error) {
+func (p *provisioner) createNode(
+	state *vm.State,
+	clusterReq provision.ClusterRequest,
+	nodeReq provision.NodeRequest,
+	alloc nodeAllocation,
+	opts *provision.Options,
+) (provision.NodeInfo, error) {
 	arch := Arch(opts.TargetArch)
 	pidPath := state.GetRelativePath(fmt.Sprintf("%s.pid", nodeReq.Name))
 
 	var pflashImages []string
 
-	if pflashSpec := arch.PFlash(opts.UEFIEnabled, opts.ExtraUEFISearchPaths); pflashSpec != nil {
+	if pflashSpec := arch.PFlash(opts.UEFIEnabled, opts.ExtraUEFISearchPaths, opts.SecureBootEnabled, opts.SecureBootVarsPath); pflashSpec != nil {
 		var err error
 		if pflashImages, err = p.createPFlashImages(state, nodeReq.Name, pflashSpec); err != nil {
 			return provision.NodeInfo{}, fmt.Errorf("error creating flash images: %w", err)
 		}
 	}
 
-	vcpuCount := int64(math.RoundToEven(float64(nodeReq.NanoCPUs) / 1000 / 1000 / 1000))
-	if vcpuCount < 2 {
-		vcpuCount = 1
-	}
+	vcpuCount := getVCPUCount(nodeReq.NanoCPUs)
 
 	memSize := nodeReq.Memory / 1024 / 1024
 
@@ -123,16 +129,6 @@ func (p *provisioner) createNode(ctx context.Context, state *vm.State, clusterRe
 		}
 	}
 
-	nodeUUID := uuid.New()
-	if nodeReq.UUID != nil {
-		nodeUUID = *nodeReq.UUID
-	}
-
-	apiBind, err := p.findAPIBindAddrs(ctx, clusterReq)
-	if err != nil {
-		return provision.NodeInfo{}, fmt.Errorf("error finding listen address for the API: %w", err)
-	}
-
 	defaultBootOrder := "cd"
 	if nodeReq.DefaultBootOrder != "" {
 		defaultBootOrder = nodeReq.DefaultBootOrder
@@ -155,6 +151,8 @@ func (p *provisioner) createNode(ctx context.Context, state *vm.State, clusterRe
 		}
 	}
 
+	nodeReq.AdditionalNICs = alloc.additionalNICs
+
 	launchConfig := LaunchConfig{
 		ArchitectureData: arch,
 		DiskPaths:        diskPaths,
@@ -164,6 +162,12 @@ func (p *provisioner) createNode(ctx context.Context, state *vm.State, clusterRe
 		DiskBlockSizes: xslices.Map(nodeReq.Disks, func(disk *provision.Disk) uint {
 			return disk.BlockSize
 		}),
+		DiskSerials: xslices.Map(nodeReq.Disks, func(disk *provision.Disk) string {
+			return disk.Serial
+		}),
+		DiskWWNs: xslices.Map(nodeReq.Disks, func(disk *provision.Disk) string {
+			return disk.WWN
+		}),
 		VCPUCount:         vcpuCount,
 		MemSize:           memSize,
 		KernelArgs:        cmdline.String(),
@@ -173,18 +177,25 @@ func (p *provisioner) createNode(ctx context.Context, state *vm.State, clusterRe
 		BadRTC:            nodeReq.BadRTC,
 		DefaultBootOrder:  defaultBootOrder,
 		BootloaderEnabled: opts.BootloaderEnabled,
-		NodeUUID:          nodeUUID,
+		NodeUUID:          alloc.uuid,
 		Config:            nodeConfig,
 		TFTPServer:        nodeReq.TFTPServer,
 		IPXEBootFileName:  nodeReq.IPXEBootFilename,
-		APIBindAddress:    apiBind,
+		APIBindAddress:    alloc.apiBind,
+		StartPoweredOff:   nodeReq.Stopped,
 		WithDebugShell:    opts.WithDebugShell,
 		IOMMUEnabled:      opts.IOMMUEnabled,
+		SecureBoot:        opts.SecureBootEnabled,
 		Network:           getLaunchNetworkConfig(state, clusterReq, nodeReq),
 
-		// Generate a random MAC address.
+		CPUModel:             nodeReq.CPUModel,
+		CPUTopology:          nodeReq.CPUTopology,
+		MachineType:          nodeReq.MachineType,
+		NestedVirtualization: nodeReq.NestedVirtualization,
+		ExtraArgs:            nodeReq.ExtraQemuArgs,
+
 		// On linux this is later overridden to the interface mac.
-		VMMac: getRandomMacAddress(),
+		VMMac: alloc.mac,
 	}
 
 	if clusterReq.IPXEBootScript != "" {
@@ -194,17 +205,33 @@ func (p *provisioner) createNode(ctx context.Context, state *vm.State, clusterRe
 
 	nodeInfo := provision.NodeInfo{
 		ID:   pidPath,
-		UUID: nodeUUID,
+		UUID: alloc.uuid,
 		Name: nodeReq.Name,
 		Type: nodeReq.Type,
 
 		NanoCPUs: nodeReq.NanoCPUs,
 		Memory:   nodeReq.Memory,
 		DiskSize: nodeReq.Disks[0].Size,
+		Disks: xslices.Map(nodeReq.Disks, func(disk *provision.Disk) provision.Disk {
+			return *disk
+		}),
 
 		IPs: nodeReq.IPs,
 
-		APIPort: apiBind.Port,
+		APIPort: alloc.apiBind.Port,
+
+		CPUModel:             cmp.Or(nodeReq.CPUModel, DefaultCPUModel),
+		CPUTopology:          nodeReq.CPUTopology,
+		MachineType:          cmp.Or(nodeReq.MachineType, arch.QemuMachine()),
+		NestedVirtualization: nodeReq.NestedVirtualization,
+		ExtraQemuArgs:        nodeReq.ExtraQemuArgs,
+		AdditionalNICs:       nodeReq.AdditionalNICs,
+		TalosVersion:         nodeReq.TalosVersion,
+		HostMounts:           nodeReq.HostMounts,
+
+		ConfigPatches: nodeReq.ConfigPatches,
+
+		Stopped: nodeReq.Stopped,
 	}
 
 	if opts.TPM1_2Enabled || opts.TPM2Enabled {
@@ -217,6 +244,10 @@ func (p *provisioner) createNode(ctx context.Context, state *vm.State, clusterRe
 		nodeInfo.TPMStateDir = tpmConfig.StateDir
 	}
 
+	if len(nodeReq.HostMounts) > 0 {
+		launchConfig.HostMounts = p.createHostMounts(state, nodeReq.Name, nodeReq.HostMounts)
+	}
+
 	if !clusterReq.Network.DHCPSkipHostname {
 		launchConfig.Network.Hostname = nodeReq.Name
 	}
@@ -227,6 +258,15 @@ func (p *provisioner) createNode(ctx context.Context, state *vm.State, clusterRe
 		launchConfig.ISOPath = strings.ReplaceAll(clusterReq.ISOPath, constants.ArchVariable, opts.TargetArch)
 		launchConfig.USBPath = strings.ReplaceAll(clusterReq.USBPath, constants.ArchVariable, opts.TargetArch)
 		launchConfig.UKIPath = strings.ReplaceAll(clusterReq.UKIPath, constants.ArchVariable, opts.TargetArch)
+
+		// boot assets of the node replace the cluster ones
+		if nodeReq.KernelPath != "" {
+			launchConfig.KernelImagePath = strings.ReplaceAll(nodeReq.KernelPath, constants.ArchVariable, opts.TargetArch)
+			launchConfig.InitrdPath = strings.ReplaceAll(nodeReq.InitramfsPath, constants.ArchVariable, opts.TargetArch)
+			launchConfig.ISOPath = ""
+			launchConfig.USBPath = ""
+			launchConfig.UKIPath = ""
+		}
 	}
 
 	launchConfig.StatePath, err = state.StatePath()
@@ -262,6 +302,9 @@ func (p *provisioner) createNode(ctx context.Context, state *vm.State, clusterRe
 	}
 
 	if err = os.WriteFile(pidPath, []byte(strconv.Itoa(cmd.Process.Pid)), os.ModePerm); err != nil {
+		// the node is not tracked without the PID file, so stop it right away
+		cmd.Process.Kill() //nolint:errcheck
+
 		return provision.NodeInfo{}, fmt.Errorf("error writing PID file: %w", err)
 	}
 
@@ -270,36 +313,123 @@ func (p *provisioner) createNode(ctx context.Context, state *vm.State, clusterRe
 	return nodeInfo, nil
 }
 
-func (p *provisioner) createNodes(ctx context.Context, state *vm.State, clusterReq provision.ClusterRequest, nodeReqs []provision.NodeRequest, opts *provision.Options) ([]provision.NodeInfo, error) {
-	errCh := make(chan error)
-	nodeCh := make(chan provision.NodeInfo, len(nodeReqs))
+func getVCPUCount(nanoCPUs int64) int64 {
+	vcpuCount := int64(math.RoundToEven(float64(nanoCPUs) / 1000 / 1000 / 1000))
+	if vcpuCount < 2 {
+		vcpuCount = 1
+	}
+
+	return vcpuCount
+}
+
+// nodeAllocation holds the resources of the node allocated before the nodes are created,
+// so that the assignments don't depend on the order the nodes are created in.
+type nodeAllocation struct {
+	uuid           uuid.UUID
+	mac            string
+	additionalNICs []provision.NIC
+	apiBind        *net.TCPAddr
+}
+
+// allocateNodes allocates the resources of the nodes in the order of the requests.
+//
+// The API ports are reserved until all the nodes are allocated, so that the nodes get distinct ports.
+func (p *provisioner) allocateNodes(ctx context.Context, clusterReq provision.ClusterRequest, nodeReqs []provision.NodeRequest) ([]nodeAllocation, error) {
+	allocations := make([]nodeAllocation, 0, len(nodeReqs))
+	listeners := make([]net.Listener, 0, len(nodeReqs))
+
+	defer func() {
+		for _, l := range listeners {
+			l.Close() //nolint:errcheck
+		}
+	}()
 
 	for _, nodeReq := range nodeReqs {
-		go func(nodeReq provision.NodeRequest) {
-			nodeInfo, err := p.createNode(ctx, state, clusterReq, nodeReq, opts)
-			if err == nil {
-				nodeCh <- nodeInfo
+		l, err := p.listenAPIBindAddr(ctx, clusterReq)
+		if err != nil {
+			return nil, fmt.Errorf("error finding listen address for the API of %q: %w", nodeReq.Name, err)
+		}
+
+		listeners = append(listeners, l)
+
+		alloc := nodeAllocation{
+			uuid:           uuid.New(),
+			mac:            getRandomMacAddress(),
+			additionalNICs: slices.Clone(nodeReq.AdditionalNICs),
+			apiBind:        l.Addr().(*net.TCPAddr),
+		}
+
+		if nodeReq.UUID != nil {
+			alloc.uuid = *nodeReq.UUID
+		}
+
+		// generate MAC addresses for the additional NICs which don't have one
+		for i := range alloc.additionalNICs {
+			if alloc.additionalNICs[i].MAC == "" {
+				alloc.additionalNICs[i].MAC = getRandomMacAddress()
 			}
+		}
 
-			errCh <- err
-		}(nodeReq)
+		allocations = append(allocations, alloc)
 	}
 
-	var multiErr *multierror.Error
+	return allocations, nil
+}
 
-	for range nodeReqs {
-		multiErr = multierror.Append(multiErr, <-errCh)
+// createNodes creates the nodes in parallel, with at most opts.Concurrency nodes being created at the same time.
+//
+// The nodes which were created are returned along with the errors of the failed nodes, so that they can be tracked in the state.
+func (p *provisioner) createNodes(ctx context.Context, state *vm.State, clusterReq provision.ClusterRequest, nodeReqs []provision.NodeRequest, opts *provision.Options) ([]provision.NodeInfo, error) {
+	allocations, err := p.allocateNodes(ctx, clusterReq, nodeReqs)
+	if err != nil {
+		return nil, err
 	}
 
-	close(nodeCh)
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = len(nodeReqs)
+	}
 
-	nodesInfo := make([]provision.NodeInfo, 0, len(nodeReqs))
+	var (
+		wg        sync.WaitGroup
+		semaphore = make(chan struct{}, concurrency)
+		nodesInfo = make([]provision.NodeInfo, len(nodeReqs))
+		errs      = make([]error, len(nodeReqs))
+	)
+
+	for i, nodeReq := range nodeReqs {
+		wg.Go(func() {
+			select {
+			case semaphore <- struct{}{}:
+			case <-ctx.Done():
+				errs[i] = ctx.Err()
+
+				return
+			}
+
+			defer func() { <-semaphore }()
+
+			nodesInfo[i], errs[i] = p.createNode(state, clusterReq, nodeReq, allocations[i], opts)
+		})
+	}
+
+	wg.Wait()
+
+	var multiErr *multierror.Error
+
+	created := make([]provision.NodeInfo, 0, len(nodeReqs))
+
+	for i, nodeReq := range nodeReqs {
+		if errs[i] != nil {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("error creating node %q: %w", nodeReq.Name, errs[i]))
+
+			continue
+		}
 
-	for nodeInfo := range nodeCh {
-		nodesInfo = append(nodesInfo, nodeInfo)
+		created = append(created, nodesInfo[i])
 	}
 
-	return nodesInfo, multiErr.ErrorOrNil()
+	return created, multiErr.ErrorOrNil()
 }
 
 func (p *provisioner) populateSystemDisk(disks []string, clusterReq provision.ClusterRequest) error {
@@ -7,17 +7,18 @@ package qemu
 import (
 	"context"
 	"net"
+	"strconv"
 
 	"github.com/siderolabs/talos/pkg/provision"
 )
 
-// findAPIBindAddrs returns the 0.0.0.0 address to bind to all interfaces on macos with a random port on macos.
-// The bridge interface address is not used as the bridge is not yet created at this stage.
-func (p *provisioner) findAPIBindAddrs(_ context.Context, _ provision.ClusterRequest) (*net.TCPAddr, error) {
-	l, err := net.Listen("tcp", net.JoinHostPort("0.0.0.0", "0"))
-	if err != nil {
-		return nil, err
-	}
+// nodeAPIAddr returns the address of the VM launcher API of the node, the API listens on all addresses.
+func nodeAPIAddr(_ provision.ClusterInfo, node provision.NodeInfo) string {
+	return net.JoinHostPort("127.0.0.1", strconv.Itoa(node.APIPort))
+}
 
-	return l.Addr().(*net.TCPAddr), l.Close()
+// listenAPIBindAddr listens on the 0.0.0.0 address to bind to all interfaces on macos with a random port on macos.
+// The bridge interface address is not used as the bridge is not yet created at this stage.
+func (p *provisioner) listenAPIBindAddr(ctx context.Context, _ provision.ClusterRequest) (net.Listener, error) {
+	return (&net.ListenConfig{}).Listen(ctx, "tcp", net.JoinHostPort("0.0.0.0", "0"))
 }
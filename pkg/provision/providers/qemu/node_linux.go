@@ -7,15 +7,17 @@ package qemu
 import (
 	"context"
 	"net"
+	"strconv"
 
 	"github.com/siderolabs/talos/pkg/provision"
 )
 
-func (p *provisioner) findAPIBindAddrs(ctx context.Context, clusterReq provision.ClusterRequest) (*net.TCPAddr, error) {
-	l, err := (&net.ListenConfig{}).Listen(ctx, "tcp", net.JoinHostPort(clusterReq.Network.GatewayAddrs[0].String(), "0"))
-	if err != nil {
-		return nil, err
-	}
+// nodeAPIAddr returns the address of the VM launcher API of the node, the API listens on the gateway address.
+func nodeAPIAddr(clusterInfo provision.ClusterInfo, node provision.NodeInfo) string {
+	return net.JoinHostPort(clusterInfo.Network.GatewayAddrs[0].String(), strconv.Itoa(node.APIPort))
+}
 
-	return l.Addr().(*net.TCPAddr), l.Close()
+// listenAPIBindAddr listens on the gateway address with a random port, the listener reserves the port until it's closed.
+func (p *provisioner) listenAPIBindAddr(ctx context.Context, clusterReq provision.ClusterRequest) (net.Listener, error) {
+	return (&net.ListenConfig{}).Listen(ctx, "tcp", net.JoinHostPort(clusterReq.Network.GatewayAddrs[0].String(), "0"))
 }
@@ -0,0 +1,62 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package qemu //nolint:testpackage
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/provision"
+)
+
+func TestAllocateNodes(t *testing.T) {
+	clusterReq := provision.ClusterRequest{
+		Network: provision.NetworkRequest{
+			GatewayAddrs: []netip.Addr{netip.MustParseAddr("127.0.0.1")},
+		},
+	}
+
+	nodeUUID := uuid.New()
+
+	nodeReqs := []provision.NodeRequest{
+		{
+			Name: "controlplane-1",
+			UUID: pointer.To(nodeUUID),
+			AdditionalNICs: []provision.NIC{
+				{Network: "storage"},
+				{Network: "management", MAC: "52:54:00:00:00:01"},
+			},
+		},
+		{Name: "worker-1"},
+		{Name: "worker-2"},
+	}
+
+	allocations, err := (&provisioner{}).allocateNodes(t.Context(), clusterReq, nodeReqs)
+	require.NoError(t, err)
+	require.Len(t, allocations, len(nodeReqs))
+
+	assert.Equal(t, nodeUUID, allocations[0].uuid)
+	assert.NotEmpty(t, allocations[0].additionalNICs[0].MAC)
+	assert.Equal(t, "52:54:00:00:00:01", allocations[0].additionalNICs[1].MAC)
+
+	// the request is not modified
+	assert.Empty(t, nodeReqs[0].AdditionalNICs[0].MAC)
+
+	ports := map[int]struct{}{}
+	macs := map[string]struct{}{}
+
+	for _, alloc := range allocations {
+		ports[alloc.apiBind.Port] = struct{}{}
+		macs[alloc.mac] = struct{}{}
+	}
+
+	assert.Len(t, ports, len(nodeReqs), "API ports should be distinct")
+	assert.Len(t, macs, len(nodeReqs), "MAC addresses should be distinct")
+}
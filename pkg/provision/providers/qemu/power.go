@@ -0,0 +1,91 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package qemu
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/siderolabs/talos/pkg/provision"
+	"github.com/siderolabs/talos/pkg/provision/providers/vm"
+)
+
+// StartNodes powers on the VMs of the nodes via the VM launcher API.
+func (p *provisioner) StartNodes(ctx context.Context, cluster provision.Cluster, nodeNames []string, opts ...provision.Option) error {
+	return p.setPowerState(ctx, cluster, nodeNames, false, opts)
+}
+
+// StopNodes powers off the VMs of the nodes via the VM launcher API.
+//
+// The VM launcher keeps running, so the VM can be powered on again.
+func (p *provisioner) StopNodes(ctx context.Context, cluster provision.Cluster, nodeNames []string, opts ...provision.Option) error {
+	return p.setPowerState(ctx, cluster, nodeNames, true, opts)
+}
+
+func (p *provisioner) setPowerState(ctx context.Context, cluster provision.Cluster, nodeNames []string, stopped bool, opts []provision.Option) error {
+	options := provision.DefaultOptions()
+
+	for _, opt := range opts {
+		if err := opt(&options); err != nil {
+			return err
+		}
+	}
+
+	state, ok := cluster.(*vm.State)
+	if !ok {
+		return fmt.Errorf("error inspecting QEMU state, %#+v", cluster)
+	}
+
+	action, endpoint := "starting", "poweron"
+	if stopped {
+		action, endpoint = "stopping", "poweroff"
+	}
+
+	for _, nodeName := range nodeNames {
+		idx := slices.IndexFunc(state.ClusterInfo.Nodes, func(node provision.NodeInfo) bool { return node.Name == nodeName })
+		if idx == -1 {
+			return fmt.Errorf("node %q not found in cluster %q", nodeName, state.ClusterInfo.ClusterName)
+		}
+
+		node := &state.ClusterInfo.Nodes[idx]
+
+		fmt.Fprintf(options.LogWriter, "%s node %s\n", action, nodeName)
+
+		if err := postLauncherAPI(ctx, nodeAPIAddr(state.ClusterInfo, *node), endpoint); err != nil {
+			return fmt.Errorf("error %s node %q: %w", action, nodeName, err)
+		}
+
+		// the desired power state is recorded right away, so that it's not lost if the next node fails
+		node.Stopped = stopped
+
+		if err := state.Save(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func postLauncherAPI(ctx context.Context, addr, endpoint string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("http://%s/%s", addr, endpoint), nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	return nil
+}
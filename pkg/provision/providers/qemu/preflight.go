@@ -5,10 +5,15 @@
 package qemu
 
 import (
+	"bufio"
 	"context"
 	"errors"
 	"fmt"
 	"os"
+	"os/exec"
+	"runtime"
+	"slices"
+	"strings"
 
 	"github.com/siderolabs/talos/pkg/provision"
 )
@@ -24,6 +29,10 @@ func (p *provisioner) preflightChecks(ctx context.Context, request provision.Clu
 		checkContext.verifyRoot,
 		checkContext.qemuExecutable,
 		checkContext.checkFlashImages,
+		checkContext.qemuCPUOptions,
+		checkContext.additionalNetworks,
+		checkContext.hostMounts,
+		checkContext.stoppedNodes,
 	} {
 		if err := check(ctx); err != nil {
 			return err
@@ -56,7 +65,19 @@ func (check *preflightCheckContext) qemuExecutable(context.Context) error {
 }
 
 func (check *preflightCheckContext) checkFlashImages(context.Context) error {
-	for _, flashImage := range check.arch.PFlash(check.options.UEFIEnabled, check.options.ExtraUEFISearchPaths) {
+	if check.options.SecureBootEnabled {
+		if check.arch == ArchAmd64 && !check.options.UEFIEnabled {
+			return errors.New("SecureBoot requires UEFI, please enable it with --with-uefi")
+		}
+
+		if check.options.SecureBootVarsPath != "" {
+			if _, err := os.Stat(check.options.SecureBootVarsPath); err != nil {
+				return fmt.Errorf("the SecureBoot UEFI variables store is not accessible: %w", err)
+			}
+		}
+	}
+
+	for _, flashImage := range check.arch.PFlash(check.options.UEFIEnabled, check.options.ExtraUEFISearchPaths, check.options.SecureBootEnabled, check.options.SecureBootVarsPath) {
 		if len(flashImage.SourcePaths) == 0 {
 			continue
 		}
@@ -73,6 +94,11 @@ func (check *preflightCheckContext) checkFlashImages(context.Context) error {
 		}
 
 		if !found {
+			if check.options.SecureBootEnabled {
+				return fmt.Errorf("the required SecureBoot enabled flash image was not found in any of the expected paths for (%q), "+
+					"please install OVMF/AAVMF (edk2) firmware with SecureBoot support with the package manager or specify --extra-uefi-search-paths", flashImage.SourcePaths)
+			}
+
 			return fmt.Errorf("the required flash image was not found in any of the expected paths for (%q), "+
 				"please install it with the package manager or specify --extra-uefi-search-paths", flashImage.SourcePaths)
 		}
@@ -80,3 +106,211 @@ func (check *preflightCheckContext) checkFlashImages(context.Context) error {
 
 	return nil
 }
+
+//nolint:gocyclo
+func (check *preflightCheckContext) qemuCPUOptions(ctx context.Context) error {
+	var cpuModels, machineTypes []string
+
+	for _, node := range check.request.Nodes {
+		if node.CPUTopology != nil {
+			if vcpuCount := getVCPUCount(node.NanoCPUs); int64(node.CPUTopology.CPUs()) != vcpuCount {
+				return fmt.Errorf("node %q: CPU topology %s defines %d CPUs, while the node has %d CPUs", node.Name, node.CPUTopology, node.CPUTopology.CPUs(), vcpuCount)
+			}
+		}
+
+		if node.CPUModel != "" {
+			// strip CPU feature flags, e.g. "Skylake-Server,-hle"
+			model, _, _ := strings.Cut(node.CPUModel, ",")
+
+			if model == "host" && !check.arch.acceleratorAvailable() {
+				return fmt.Errorf("node %q: CPU model %q requires hardware acceleration (%s), which is not available", node.Name, model, accelerator)
+			}
+
+			cpuModels = append(cpuModels, model)
+		}
+
+		if node.MachineType != "" {
+			machineTypes = append(machineTypes, node.MachineType)
+		}
+	}
+
+	for _, validation := range []struct {
+		kind    string
+		option  string
+		header  string
+		entries []string
+	}{
+		{
+			kind:    "CPU model",
+			option:  "-cpu",
+			header:  "Available CPUs:",
+			entries: cpuModels,
+		},
+		{
+			kind:    "machine type",
+			option:  "-machine",
+			header:  "Supported machines are:",
+			entries: machineTypes,
+		},
+	} {
+		if len(validation.entries) == 0 {
+			continue
+		}
+
+		output, err := exec.CommandContext(ctx, check.arch.QemuExecutable(), validation.option, "help").Output()
+		if err != nil {
+			// older or custom QEMU builds might not support listing, skip the validation
+			continue
+		}
+
+		supported := parseQemuHelp(string(output), validation.header)
+		if len(supported) == 0 {
+			continue
+		}
+
+		for _, entry := range validation.entries {
+			if !slices.Contains(supported, entry) {
+				return fmt.Errorf("%s %q is not supported by %s, see `%s %s help` for the list of supported values",
+					validation.kind, entry, check.arch.QemuExecutable(), check.arch.QemuExecutable(), validation.option)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (check *preflightCheckContext) additionalNetworks(context.Context) error {
+	networks := map[string]struct{}{}
+
+	for _, network := range check.request.Network.AdditionalNetworks {
+		if network.Name == "" {
+			return errors.New("additional network name should not be empty")
+		}
+
+		if _, ok := networks[network.Name]; ok {
+			return fmt.Errorf("duplicate additional network %q", network.Name)
+		}
+
+		networks[network.Name] = struct{}{}
+
+		if !network.CIDR.IsValid() {
+			continue
+		}
+
+		for _, cidr := range check.request.Network.CIDRs {
+			if cidr.Overlaps(network.CIDR) {
+				return fmt.Errorf("additional network %q CIDR %s overlaps with the cluster network CIDR %s", network.Name, network.CIDR, cidr)
+			}
+		}
+
+		for _, other := range check.request.Network.AdditionalNetworks {
+			if other.Name != network.Name && other.CIDR.IsValid() && other.CIDR.Overlaps(network.CIDR) {
+				return fmt.Errorf("additional network %q CIDR %s overlaps with the additional network %q CIDR %s", network.Name, network.CIDR, other.Name, other.CIDR)
+			}
+		}
+	}
+
+	for _, node := range check.request.Nodes {
+		for _, nic := range node.AdditionalNICs {
+			if _, ok := networks[nic.Network]; !ok {
+				return fmt.Errorf("node %q: NIC is attached to the unknown network %q", node.Name, nic.Network)
+			}
+		}
+	}
+
+	return nil
+}
+
+//nolint:gocyclo
+func (check *preflightCheckContext) hostMounts(context.Context) error {
+	for _, node := range check.request.Nodes {
+		tags := map[string]struct{}{}
+
+		for _, mount := range node.HostMounts {
+			if mount.Tag == "" {
+				return fmt.Errorf("node %q: host mount %q should have a tag", node.Name, mount)
+			}
+
+			if _, ok := tags[mount.Tag]; ok {
+				return fmt.Errorf("node %q: duplicate host mount tag %q", node.Name, mount.Tag)
+			}
+
+			tags[mount.Tag] = struct{}{}
+
+			st, err := os.Stat(mount.HostPath)
+			if err != nil {
+				return fmt.Errorf("node %q: host mount path is not accessible: %w", node.Name, err)
+			}
+
+			if !st.IsDir() {
+				return fmt.Errorf("node %q: host mount path %q is not a directory", node.Name, mount.HostPath)
+			}
+
+			switch mount.Type {
+			case provision.HostMountTypeVirtioFS:
+				if runtime.GOOS != "linux" {
+					return fmt.Errorf("node %q: virtio-fs host mounts are only supported on Linux, use type=9p", node.Name)
+				}
+
+				if _, err = findVirtiofsd(); err != nil {
+					return err
+				}
+			case provision.HostMountType9P:
+			default:
+				return fmt.Errorf("node %q: unsupported host mount type %q", node.Name, mount.Type)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (check *preflightCheckContext) stoppedNodes(context.Context) error {
+	// on darwin, the bridge interface the dhcpd listens on is created by qemu once the first VM is booted
+	if runtime.GOOS == "linux" {
+		return nil
+	}
+
+	if slices.ContainsFunc(check.request.Nodes.ControlPlaneNodes(), func(node provision.NodeRequest) bool { return !node.Stopped }) {
+		return nil
+	}
+
+	return fmt.Errorf("at least one control plane node should be booted when the cluster is created on %s", runtime.GOOS)
+}
+
+// parseQemuHelp parses the list of values from the output of `qemu-system-* -cpu help` or `-machine help`.
+//
+// The list starts after the header and ends with an empty line, each line starts with the value name
+// optionally prefixed by the architecture name (x86).
+func parseQemuHelp(output, header string) []string {
+	var (
+		values []string
+		inList bool
+	)
+
+	scanner := bufio.NewScanner(strings.NewReader(output))
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if !inList {
+			inList = line == header
+
+			continue
+		}
+
+		if line == "" {
+			break
+		}
+
+		fields := strings.Fields(line)
+
+		if fields[0] == "x86" && len(fields) > 1 {
+			fields = fields[1:]
+		}
+
+		values = append(values, fields[0])
+	}
+
+	return values
+}
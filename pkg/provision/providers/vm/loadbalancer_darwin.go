@@ -4,11 +4,20 @@
 
 package vm
 
-import "net/netip"
+import (
+	"net/netip"
+	"slices"
+)
 
-// getLbBindIP returns the 0.0.0.0 address to bind to all interfaces on macos.
+// getLbBindIPs returns the unspecified address to bind to all interfaces on macos.
 // The bridge interface address is not used as the bridge is not yet created at this stage.
 // Multiple loadbalancers can be assigned via different ports.
-func getLbBindIP(_ netip.Addr) string {
-	return "0.0.0.0"
+//
+// The IPv6 unspecified address accepts both IPv4 and IPv6 connections.
+func getLbBindIPs(gateways []netip.Addr) []string {
+	if slices.ContainsFunc(gateways, netip.Addr.Is6) {
+		return []string{"::"}
+	}
+
+	return []string{"0.0.0.0"}
 }
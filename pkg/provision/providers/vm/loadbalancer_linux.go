@@ -4,9 +4,15 @@
 
 package vm
 
-import "net/netip"
+import (
+	"net/netip"
 
-// getLbBindIP returns the gateway address to bind the loadbalancer to the bridge interface.
-func getLbBindIP(gateway netip.Addr) string {
-	return gateway.String()
+	"github.com/siderolabs/gen/xslices"
+)
+
+// getLbBindIPs returns the gateway addresses to bind the loadbalancer to the bridge interface.
+//
+// The loadbalancer listens on each gateway address, so that it is reachable over every address family of the network.
+func getLbBindIPs(gateways []netip.Addr) []string {
+	return xslices.Map(gateways, netip.Addr.String)
 }
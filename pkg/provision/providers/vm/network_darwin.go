@@ -6,6 +6,7 @@ package vm
 
 import (
 	"context"
+	"errors"
 	"net"
 
 	"github.com/siderolabs/gen/xslices"
@@ -33,6 +34,15 @@ func (p *Provisioner) CreateNetwork(ctx context.Context, state *State, network p
 	return nil
 }
 
+// CreateAdditionalNetworks is not supported on darwin.
+func (p *Provisioner) CreateAdditionalNetworks(ctx context.Context, state *State, network provision.NetworkRequest, options provision.Options) error {
+	if len(network.AdditionalNetworks) > 0 {
+		return errors.New("additional networks are not supported on darwin")
+	}
+
+	return nil
+}
+
 // DestroyNetwork does nothing on darwin as the network is automatically cleaned up by qemu when the final machine of a cidr block is killed.
 func (p *Provisioner) DestroyNetwork(state *State) error {
 	return nil
@@ -156,6 +156,125 @@ func (p *Provisioner) CreateNetwork(ctx context.Context, state *State, network p
 	return nil
 }
 
+// CreateAdditionalNetworks creates bridge interfaces for the additional networks.
+//
+// Additional networks are L2 only: there is no DHCP server, NAT or default gateway, and if the CIDR is set,
+// the bridge interface gets the first address of the CIDR, so that the host can reach the nodes.
+//
+//nolint:gocyclo
+func (p *Provisioner) CreateAdditionalNetworks(ctx context.Context, state *State, network provision.NetworkRequest, options provision.Options) error {
+	cniConfig := libcni.NewCNIConfigWithCacheDir(network.CNI.BinPath, network.CNI.CacheDir, nil)
+
+	for _, additionalNetwork := range network.AdditionalNetworks {
+		networkName := network.Name + "-" + additionalNetwork.Name
+		networkNameHash := sha256.Sum256([]byte(networkName))
+		bridgeName := fmt.Sprintf("%s%s", "talos", hex.EncodeToString(networkNameHash[:])[:8])
+
+		mtu := additionalNetwork.MTU
+		if mtu == 0 {
+			mtu = network.MTU
+		}
+
+		templateData := struct {
+			NetworkName   string
+			InterfaceName string
+			MTU           string
+		}{
+			NetworkName:   networkName,
+			InterfaceName: bridgeName,
+			MTU:           strconv.Itoa(mtu),
+		}
+
+		var buf bytes.Buffer
+
+		if additionalNetwork.CIDR.IsValid() {
+			// bring up the bridge interface for the first time to get gateway IP assigned
+			if err := template.Must(template.New("bridge").Parse(additionalBridgeTemplate)).Execute(&buf, templateData); err != nil {
+				return fmt.Errorf("error templating bridge CNI config: %w", err)
+			}
+
+			bridgeConfig, err := libcni.NetworkPluginConfFromBytes(buf.Bytes())
+			if err != nil {
+				return fmt.Errorf("error parsing bridge CNI config: %w", err)
+			}
+
+			gatewayAddr, err := sideronet.NthIPInNetwork(additionalNetwork.CIDR, 1)
+			if err != nil {
+				return err
+			}
+
+			fakeIP, err := sideronet.NthIPInNetwork(additionalNetwork.CIDR, 2)
+			if err != nil {
+				return err
+			}
+
+			if err = p.provisionBridge(ctx, cniConfig, bridgeConfig, sideronet.FormatCIDR(fakeIP, additionalNetwork.CIDR), gatewayAddr.String()); err != nil {
+				return fmt.Errorf("error provisioning additional network %q: %w", additionalNetwork.Name, err)
+			}
+
+			buf.Reset()
+		}
+
+		if err := template.Must(template.New("network").Parse(additionalNetworkTemplate)).Execute(&buf, templateData); err != nil {
+			return fmt.Errorf("error templating VM CNI config: %w", err)
+		}
+
+		vmCNIConfig, err := libcni.ConfListFromBytes(buf.Bytes())
+		if err != nil {
+			return fmt.Errorf("error parsing VM CNI config: %w", err)
+		}
+
+		if err = p.allowBridgeTraffic(bridgeName); err != nil {
+			return fmt.Errorf("error configuring DOCKER-USER chain: %w", err)
+		}
+
+		state.AdditionalNetworks = append(state.AdditionalNetworks, AdditionalNetworkState{
+			Name:        additionalNetwork.Name,
+			BridgeName:  bridgeName,
+			CIDR:        additionalNetwork.CIDR,
+			VMCNIConfig: vmCNIConfig,
+		})
+
+		fmt.Fprintf(options.LogWriter, "  %s: bridge %s\n", additionalNetwork.Name, bridgeName)
+	}
+
+	return nil
+}
+
+// provisionBridge creates the bridge interface by provisioning and removing a fake interface.
+func (p *Provisioner) provisionBridge(ctx context.Context, cniConfig *libcni.CNIConfig, bridgeConfig *libcni.NetworkConfig, fakeIP, gatewayAddr string) error {
+	ns, err := testutils.NewNS()
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		ns.Close()              //nolint:errcheck
+		testutils.UnmountNS(ns) //nolint:errcheck
+	}()
+
+	runtimeConf := libcni.RuntimeConf{
+		ContainerID: uuid.New().String(),
+		NetNS:       ns.Path(),
+		IfName:      "veth0",
+		Args: [][2]string{
+			{"IP", fakeIP},
+			{"GATEWAY", gatewayAddr},
+			{"IgnoreUnknown", "1"},
+		},
+	}
+
+	if _, err = cniConfig.AddNetwork(ctx, bridgeConfig, &runtimeConf); err != nil {
+		return fmt.Errorf("error provisioning bridge CNI network: %w", err)
+	}
+
+	if err = cniConfig.DelNetwork(ctx, bridgeConfig, &runtimeConf); err != nil {
+		return fmt.Errorf("error deleting bridge CNI network: %w", err)
+	}
+
+	return nil
+}
+
 func (p *Provisioner) allowBridgeTraffic(bridgeName string) error {
 	ipt, err := iptables.New()
 	if err != nil {
@@ -341,9 +460,24 @@ func (p *Provisioner) configureNetworkChaos(network provision.NetworkRequest, st
 
 // DestroyNetwork destroy bridge interface by name to clean up.
 func (p *Provisioner) DestroyNetwork(state *State) error {
-	iface, err := net.InterfaceByName(state.BridgeName)
+	for _, additionalNetwork := range state.AdditionalNetworks {
+		// bridge interface of the additional network without CIDR is created only when the first NIC is attached
+		if _, err := net.InterfaceByName(additionalNetwork.BridgeName); err != nil {
+			continue
+		}
+
+		if err := p.destroyBridge(additionalNetwork.BridgeName); err != nil {
+			return fmt.Errorf("error destroying additional network %q: %w", additionalNetwork.Name, err)
+		}
+	}
+
+	return p.destroyBridge(state.BridgeName)
+}
+
+func (p *Provisioner) destroyBridge(bridgeName string) error {
+	iface, err := net.InterfaceByName(bridgeName)
 	if err != nil {
-		return fmt.Errorf("error looking up bridge interface %q: %w", state.BridgeName, err)
+		return fmt.Errorf("error looking up bridge interface %q: %w", bridgeName, err)
 	}
 
 	rtconn, err := rtnetlink.Dial(nil)
@@ -351,11 +485,13 @@ func (p *Provisioner) DestroyNetwork(state *State) error {
 		return fmt.Errorf("error dialing rnetlink: %w", err)
 	}
 
+	defer rtconn.Close() //nolint:errcheck
+
 	if err = rtconn.Link.Delete(uint32(iface.Index)); err != nil {
 		return fmt.Errorf("error deleting bridge interface: %w", err)
 	}
 
-	if err = p.dropBridgeTrafficRule(state.BridgeName); err != nil {
+	if err = p.dropBridgeTrafficRule(bridgeName); err != nil {
 		return fmt.Errorf("error dropping bridge traffic rule: %w", err)
 	}
 
@@ -403,3 +539,35 @@ const networkTemplate = `
 	]
 }
 `
+
+const additionalBridgeTemplate = `
+{
+	"name": "{{ .NetworkName }}",
+	"cniVersion": "0.4.0",
+	"type": "bridge",
+	"bridge": "{{ .InterfaceName }}",
+	"isGateway": true,
+	"ipam": {
+		  "type": "static"
+	},
+	"mtu": {{ .MTU }}
+}
+`
+
+const additionalNetworkTemplate = `
+{
+	"name": "{{ .NetworkName }}",
+	"cniVersion": "0.4.0",
+	"plugins": [
+		{
+			"type": "bridge",
+			"bridge": "{{ .InterfaceName }}",
+			"ipam": {},
+			"mtu": {{ .MTU }}
+		},
+		{
+			"type": "tc-redirect-tap"
+		}
+	]
+}
+`
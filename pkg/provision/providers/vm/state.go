@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"io/fs"
+	"net/netip"
 	"os"
 	"path/filepath"
 
@@ -26,9 +27,31 @@ type State struct {
 
 	VMCNIConfig *libcni.NetworkConfigList
 
+	AdditionalNetworks []AdditionalNetworkState
+
 	statePath string
 }
 
+// AdditionalNetworkState is the state of the additional network.
+type AdditionalNetworkState struct {
+	Name       string
+	BridgeName string
+	CIDR       netip.Prefix
+
+	VMCNIConfig *libcni.NetworkConfigList
+}
+
+// FindAdditionalNetwork looks up the additional network state by name.
+func (s *State) FindAdditionalNetwork(name string) (AdditionalNetworkState, bool) {
+	for _, network := range s.AdditionalNetworks {
+		if network.Name == name {
+			return network, true
+		}
+	}
+
+	return AdditionalNetworkState{}, false
+}
+
 // NewState create new vm provisioner state.
 func NewState(statePath, provisionerName, clusterName string) (*State, error) {
 	s := &State{
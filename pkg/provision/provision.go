@@ -17,6 +17,11 @@ type Provisioner interface {
 	Create(context.Context, ClusterRequest, ...Option) (Cluster, error)
 	Destroy(context.Context, Cluster, ...Option) error
 
+	// StartNodes boots the stopped nodes of the cluster by node name.
+	StartNodes(ctx context.Context, cluster Cluster, nodeNames []string, opts ...Option) error
+	// StopNodes stops the nodes of the cluster by node name, the nodes are kept and can be started again.
+	StopNodes(ctx context.Context, cluster Cluster, nodeNames []string, opts ...Option) error
+
 	Reflect(ctx context.Context, clusterName, stateDirectory string) (Cluster, error)
 
 	GenOptions(NetworkRequest) []generate.Option
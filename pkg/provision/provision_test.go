@@ -4,11 +4,211 @@
 
 package provision_test
 
-import "testing"
+import (
+	"testing"
 
-func TestEmpty(t *testing.T) {
-	// added for accurate coverage estimation
-	//
-	// please remove it once any unit-test is added
-	// for this package
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/provision"
+)
+
+func TestParseCPUTopology(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		input string
+
+		expected      provision.CPUTopology
+		expectedError string
+	}{
+		{
+			input:    "sockets=2,cores=4,threads=2",
+			expected: provision.CPUTopology{Sockets: 2, Cores: 4, Threads: 2},
+		},
+		{
+			input:    "cores=4",
+			expected: provision.CPUTopology{Sockets: 1, Cores: 4, Threads: 1},
+		},
+		{
+			input:         "cores",
+			expectedError: `invalid CPU topology "cores": expected key=value pairs`,
+		},
+		{
+			input:         "cores=0",
+			expectedError: `invalid CPU topology "cores=0": cores should be a positive number`,
+		},
+		{
+			input:         "dies=2",
+			expectedError: `invalid CPU topology "dies=2": unknown key "dies"`,
+		},
+	} {
+		t.Run(test.input, func(t *testing.T) {
+			t.Parallel()
+
+			topology, err := provision.ParseCPUTopology(test.input)
+			if test.expectedError != "" {
+				require.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, topology)
+			assert.Equal(t, test.expected.Sockets*test.expected.Cores*test.expected.Threads, topology.CPUs())
+
+			roundtrip, err := provision.ParseCPUTopology(topology.String())
+			require.NoError(t, err)
+
+			assert.Equal(t, topology, roundtrip)
+		})
+	}
+}
+
+func TestParsePublishedPorts(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		input string
+
+		expected      []provision.PublishedPort
+		expectedError string
+	}{
+		{
+			input: "30080:30080/tcp",
+			expected: []provision.PublishedPort{
+				{HostPort: 30080, ContainerPort: 30080, Protocol: "tcp"},
+			},
+		},
+		{
+			input: "30053",
+			expected: []provision.PublishedPort{
+				{ContainerPort: 30053, Protocol: "tcp"},
+			},
+		},
+		{
+			input: "192.168.1.10:8000-8001:30000-30001/udp",
+			expected: []provision.PublishedPort{
+				{HostIP: "192.168.1.10", HostPort: 8000, ContainerPort: 30000, Protocol: "udp"},
+				{HostIP: "192.168.1.10", HostPort: 8001, ContainerPort: 30001, Protocol: "udp"},
+			},
+		},
+		{
+			input: "[::1]::30080",
+			expected: []provision.PublishedPort{
+				{HostIP: "::1", ContainerPort: 30080, Protocol: "tcp"},
+			},
+		},
+		{
+			input:         "8000-8002:30000-30001",
+			expectedError: `invalid published port "8000-8002:30000-30001": host and container port ranges should be of the same size`,
+		},
+		{
+			input:         "30080:30080/icmp",
+			expectedError: `invalid published port "30080:30080/icmp": unsupported protocol "icmp"`,
+		},
+		{
+			input:         "localhost:30080:30080",
+			expectedError: `invalid published port "localhost:30080:30080": invalid host IP "localhost"`,
+		},
+		{
+			input:         "0:30080",
+			expectedError: `invalid published port "0:30080": invalid port "0"`,
+		},
+	} {
+		t.Run(test.input, func(t *testing.T) {
+			t.Parallel()
+
+			ports, err := provision.ParsePublishedPorts(test.input)
+			if test.expectedError != "" {
+				require.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, ports)
+
+			for _, port := range ports {
+				roundtrip, err := provision.ParsePublishedPorts(port.String())
+				require.NoError(t, err)
+
+				assert.Equal(t, []provision.PublishedPort{port}, roundtrip)
+			}
+		})
+	}
+}
+
+func TestPublishedPortConflicts(t *testing.T) {
+	t.Parallel()
+
+	port := provision.PublishedPort{HostIP: "127.0.0.1", HostPort: 30080, ContainerPort: 30080, Protocol: "tcp"}
+
+	assert.True(t, port.Conflicts(port))
+	assert.True(t, port.Conflicts(provision.PublishedPort{HostIP: "0.0.0.0", HostPort: 30080, ContainerPort: 80, Protocol: "tcp"}))
+	assert.False(t, port.Conflicts(provision.PublishedPort{HostIP: "127.0.0.2", HostPort: 30080, ContainerPort: 30080, Protocol: "tcp"}))
+	assert.False(t, port.Conflicts(provision.PublishedPort{HostIP: "127.0.0.1", HostPort: 30080, ContainerPort: 30080, Protocol: "udp"}))
+	assert.False(t, port.Conflicts(provision.PublishedPort{HostIP: "127.0.0.1", ContainerPort: 30080, Protocol: "tcp"}))
+}
+
+func TestParseHostMount(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		input string
+
+		expected      provision.HostMount
+		expectedError string
+	}{
+		{
+			input:    "host=/src,guest=/var/mnt/src,readonly,type=virtiofs",
+			expected: provision.HostMount{HostPath: "/src", GuestPath: "/var/mnt/src", ReadOnly: true, Type: provision.HostMountTypeVirtioFS},
+		},
+		{
+			input:    "host=/home/user/src/,guest=/var/mnt/src,type=9p",
+			expected: provision.HostMount{HostPath: "/home/user/src", GuestPath: "/var/mnt/src", Type: provision.HostMountType9P},
+		},
+		{
+			input:         "host=src,guest=/var/mnt/src",
+			expectedError: `invalid host mount "host=src,guest=/var/mnt/src": host path should be absolute`,
+		},
+		{
+			input:         "host=/src",
+			expectedError: `invalid host mount "host=/src": guest path should be absolute`,
+		},
+		{
+			input:         "host=/src,guest=/var/mnt/src,readonly=true",
+			expectedError: `invalid host mount "host=/src,guest=/var/mnt/src,readonly=true": readonly doesn't accept a value`,
+		},
+		{
+			input:         "host=/src,guest=/var/mnt/src,type=nfs",
+			expectedError: `invalid host mount "host=/src,guest=/var/mnt/src,type=nfs": unsupported type "nfs"`,
+		},
+		{
+			input:         "host=/src,guest=/var/mnt/src,cache=always",
+			expectedError: `invalid host mount "host=/src,guest=/var/mnt/src,cache=always": unknown option "cache"`,
+		},
+	} {
+		t.Run(test.input, func(t *testing.T) {
+			t.Parallel()
+
+			mount, err := provision.ParseHostMount(test.input)
+			if test.expectedError != "" {
+				require.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, mount)
+
+			roundtrip, err := provision.ParseHostMount(mount.String())
+			require.NoError(t, err)
+
+			assert.Equal(t, mount, roundtrip)
+		})
+	}
 }
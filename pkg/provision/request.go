@@ -6,8 +6,11 @@ package provision
 
 import (
 	"errors"
+	"fmt"
 	"net/netip"
 	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	mounttypes "github.com/docker/docker/api/types/mount"
@@ -87,6 +90,25 @@ type NetworkRequest struct {
 	PacketReorder float64
 	PacketCorrupt float64
 	Bandwidth     int
+
+	// AdditionalNetworks are attached to the nodes in addition to the primary network (VM only).
+	AdditionalNetworks []AdditionalNetworkRequest
+}
+
+// AdditionalNetworkRequest describes an additional L2 network (bridge) without DHCP.
+type AdditionalNetworkRequest struct {
+	Name string
+	// CIDR is optional, if set, the first address of the CIDR is assigned to the bridge.
+	CIDR netip.Prefix
+	// MTU defaults to the primary network MTU.
+	MTU int
+}
+
+// NIC describes an additional network interface of the node.
+type NIC struct {
+	// Network is the name of the additional network.
+	Network string
+	MAC     string
 }
 
 // NodeRequests is a list of NodeRequest.
@@ -165,6 +187,12 @@ type Disk struct {
 	Driver string
 	// Block size for the disk, defaults to 512 if not set.
 	BlockSize uint
+	// Serial number of the disk (VM only), not supported for the "ide" driver.
+	//
+	// NVMe namespaces share the serial number of the controller, which is the serial number of the first NVMe disk.
+	Serial string
+	// WWN of the disk as a 64-bit hex number, e.g. "0x5000c50015ea71ac" (VM only), supported for the "ahci", "scsi", "megaraid" and "nvme" drivers.
+	WWN string
 }
 
 // ConfigInjectionMethod describes how to inject configuration into the node.
@@ -187,6 +215,8 @@ type NodeRequest struct {
 
 	Config                config.Provider
 	ConfigInjectionMethod ConfigInjectionMethod
+	// ConfigPatches are the names of the node-specific config patches applied to Config, informational only.
+	ConfigPatches []string
 
 	// Share of CPUs, in 1e-9 fractions
 	NanoCPUs int64
@@ -198,8 +228,12 @@ type NodeRequest struct {
 	Mounts []mounttypes.Mount
 	// Ports
 	Ports []string
+	// PublishedPorts are published on the host (containers only)
+	PublishedPorts []PublishedPort
 	// SkipInjectingConfig disables reading configuration from http server
 	SkipInjectingConfig bool
+	// Stopped creates the node without booting it, the node can be started later with Provisioner.StartNodes.
+	Stopped bool
 	// DefaultBootOrder overrides default boot order "cn" (disk, then network boot).
 	//
 	// BootOrder can be forced to be "nc" (PXE boot) via the API in QEMU provisioner.
@@ -225,6 +259,83 @@ type NodeRequest struct {
 	PXEBooted        bool
 	TFTPServer       string
 	IPXEBootFilename string
+
+	// QEMU specific parameters.
+
+	// CPUModel overrides the virtual CPU model, e.g. "host", "max" or a named model.
+	CPUModel string
+	// CPUTopology overrides the virtual CPU topology, the number of CPUs should match NanoCPUs.
+	CPUTopology *CPUTopology
+	// MachineType overrides the machine type, e.g. "q35" or "virt".
+	MachineType string
+	// NestedVirtualization exposes the hardware virtualization extensions to the VM.
+	NestedVirtualization bool
+	// ExtraQemuArgs are appended to the QEMU command line as is.
+	ExtraQemuArgs []string
+	// AdditionalNICs are attached to the additional networks.
+	AdditionalNICs []NIC
+	// KernelPath and InitramfsPath override the boot assets of the cluster for the node.
+	//
+	// This allows to boot the nodes of the cluster with different Talos versions.
+	KernelPath    string
+	InitramfsPath string
+	// TalosVersion is the version of Talos the node boots, informational only.
+	TalosVersion string
+	// HostMounts are host directories shared with the node.
+	HostMounts []HostMount
+}
+
+// CPUTopology describes the virtual CPU topology.
+type CPUTopology struct {
+	Sockets int
+	Cores   int
+	Threads int
+}
+
+// ParseCPUTopology parses the CPU topology in the QEMU `-smp` format, e.g. `sockets=1,cores=2,threads=2`.
+//
+// Omitted values default to 1.
+func ParseCPUTopology(s string) (CPUTopology, error) {
+	topology := CPUTopology{
+		Sockets: 1,
+		Cores:   1,
+		Threads: 1,
+	}
+
+	for part := range strings.SplitSeq(s, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			return CPUTopology{}, fmt.Errorf("invalid CPU topology %q: expected key=value pairs", s)
+		}
+
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 1 {
+			return CPUTopology{}, fmt.Errorf("invalid CPU topology %q: %s should be a positive number", s, key)
+		}
+
+		switch key {
+		case "sockets":
+			topology.Sockets = n
+		case "cores":
+			topology.Cores = n
+		case "threads":
+			topology.Threads = n
+		default:
+			return CPUTopology{}, fmt.Errorf("invalid CPU topology %q: unknown key %q", s, key)
+		}
+	}
+
+	return topology, nil
+}
+
+// CPUs returns the total number of the virtual CPUs.
+func (t CPUTopology) CPUs() int {
+	return t.Sockets * t.Cores * t.Threads
+}
+
+// String implements fmt.Stringer interface.
+func (t CPUTopology) String() string {
+	return fmt.Sprintf("sockets=%d,cores=%d,threads=%d", t.Sockets, t.Cores, t.Threads)
 }
 
 // SiderolinkRequest describes a request for SideroLink agent.
@@ -43,6 +43,15 @@ type NetworkInfo struct {
 	GatewayAddrs      []netip.Addr
 	MTU               int
 	NoMasqueradeCIDRs []netip.Prefix
+
+	AdditionalNetworks []AdditionalNetworkInfo
+}
+
+// AdditionalNetworkInfo describes an additional network.
+type AdditionalNetworkInfo struct {
+	Name       string
+	BridgeName string
+	CIDR       netip.Prefix
 }
 
 // NodeInfo describes a node.
@@ -58,9 +67,30 @@ type NodeInfo struct {
 	Memory int64
 	// Disk (volume) size in bytes, if applicable
 	DiskSize uint64
+	// Disks attached to the node (VM only)
+	Disks []Disk
 
 	IPs []netip.Addr
 
 	APIPort     int
 	TPMStateDir string
+
+	// Ports published on the host (containers only)
+	PublishedPorts []PublishedPort
+
+	// Names of the node-specific config patches applied to the node config
+	ConfigPatches []string
+
+	// Stopped is the desired power state of the node, stopped nodes are not expected to be up
+	Stopped bool
+
+	// QEMU specific parameters.
+	CPUModel             string
+	CPUTopology          *CPUTopology
+	MachineType          string
+	NestedVirtualization bool
+	ExtraQemuArgs        []string
+	AdditionalNICs       []NIC
+	TalosVersion         string
+	HostMounts           []HostMount
 }
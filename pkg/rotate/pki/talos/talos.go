@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/siderolabs/crypto/x509"
 	"github.com/siderolabs/go-retry/retry"
 	"google.golang.org/grpc/codes"
@@ -52,6 +53,16 @@ type Options struct {
 	// NewTalosCA is the new CA for Talos API.
 	NewTalosCA *x509.PEMEncodedCertificateAndKey
 
+	// Resume is the state of the interrupted rotation to resume.
+	//
+	// If set, NewTalosCA is ignored, and the phases completed in the state are skipped.
+	Resume *State
+	// Phase is the last phase to run, if not set, all phases are run.
+	Phase Phase
+	// Checkpoint is called with the state of the rotation before the first change to the cluster
+	// and after each completed phase, the state should be persisted to resume the interrupted rotation.
+	Checkpoint func(State) error
+
 	// EncoderOption is the option for encoding machine configuration (while patching).
 	EncoderOption encoder.Option
 
@@ -59,10 +70,47 @@ type Options struct {
 	Printf func(format string, args ...any)
 }
 
+// Phase is a step of the Talos API PKI rotation.
+type Phase string
+
+// Talos API PKI rotation phases, in the order of execution.
+const (
+	// PhaseAccept adds the new Talos CA as accepted.
+	PhaseAccept Phase = "accept"
+	// PhaseIssue makes the new Talos CA issuing, old Talos CA is still accepted.
+	PhaseIssue Phase = "issue"
+	// PhaseRetire removes the old Talos CA from the accepted CAs.
+	PhaseRetire Phase = "retire"
+)
+
+// Phases lists all rotation phases in the order of execution.
+var Phases = []Phase{PhaseAccept, PhaseIssue, PhaseRetire}
+
+// State is the checkpoint of the Talos API PKI rotation.
+type State struct {
+	// CurrentCA is the Talos CA being rotated out.
+	CurrentCA *x509.PEMEncodedCertificate `yaml:"currentCA"`
+	// NewCA is the new Talos CA.
+	NewCA *x509.PEMEncodedCertificateAndKey `yaml:"newCA"`
+	// Completed is the last completed phase, empty if no phase was completed.
+	Completed Phase `yaml:"completed,omitempty"`
+}
+
+// Done returns true if the phase was completed.
+func (s State) Done(phase Phase) bool {
+	return slices.Index(Phases, phase) <= slices.Index(Phases, s.Completed)
+}
+
+// ConvergenceTimeout is the time to wait for all nodes to re-issue the certificates after each phase.
+const ConvergenceTimeout = 5 * time.Minute
+
 type rotator struct {
 	opts Options
 
-	currentCA []byte
+	state State
+
+	currentFingerprint string
+	newFingerprint     string
 
 	intermediateTalosconfig *clientconfig.Config
 	newTalosconfig          *clientconfig.Config
@@ -76,12 +124,17 @@ type rotator struct {
 // The process overview:
 //   - fetch current information
 //   - verify connectivity with the existing PKI
-//   - add new Talos CA as accepted
+//   - add new Talos CA as accepted (PhaseAccept)
 //   - verify connectivity with the intermediate PKI
-//   - make new CA issuing, old CA is still accepted
+//   - make new CA issuing, old CA is still accepted (PhaseIssue)
 //   - verify connectivity with the new PKI
-//   - remove old Talos CA
+//   - remove old Talos CA (PhaseRetire)
 //   - verify connectivity with the new PKI.
+//
+// After each phase, the rotation waits for all nodes to converge: each node reports the accepted
+// and the issuing CAs, and the CAs of the certificates presented by apid and trustd via secrets.OSRootStatus.
+//
+// The returned talosconfig is set once the new Talos CA is issuing.
 func Rotate(ctx context.Context, opts Options) (*clientconfig.Config, error) {
 	r := rotator{
 		opts: opts,
@@ -99,6 +152,10 @@ func Rotate(ctx context.Context, opts Options) (*clientconfig.Config, error) {
 
 	err := r.rotate(ctx)
 
+	if !r.state.Done(PhaseIssue) {
+		return nil, err
+	}
+
 	return r.newTalosconfig, err
 }
 
@@ -106,44 +163,86 @@ func Rotate(ctx context.Context, opts Options) (*clientconfig.Config, error) {
 func (r *rotator) rotate(ctx context.Context) error {
 	r.printIntro()
 
-	if err := r.fetchCurrentCA(ctx); err != nil {
-		return err
+	if r.opts.Resume != nil {
+		r.state = *r.opts.Resume
+
+		r.opts.Printf("> Resuming rotation, completed phase: %q\n", r.state.Completed)
+		r.opts.Printf("> Current Talos CA:\n")
+
+		if err := r.printYAML(r.state.CurrentCA); err != nil {
+			return err
+		}
+	} else {
+		if err := r.fetchCurrentCA(ctx); err != nil {
+			return err
+		}
+
+		r.state.NewCA = r.opts.NewTalosCA
 	}
 
 	if err := r.printNewCA(); err != nil {
 		return err
 	}
 
-	if err := r.generateClients(ctx); err != nil {
+	if err := r.fingerprintCAs(); err != nil {
 		return err
 	}
 
-	if err := r.verifyConnectivity(ctx, r.opts.CurrentClient, "existing PKI"); err != nil {
+	if err := r.generateClients(ctx); err != nil {
 		return err
 	}
 
-	if err := r.addNewCAAccepted(ctx); err != nil {
-		return err
-	}
+	if r.state.Completed == "" {
+		if err := r.verifyConnectivity(ctx, r.opts.CurrentClient, "existing PKI"); err != nil {
+			return err
+		}
 
-	if err := r.verifyConnectivity(ctx, r.intermediateClient, "new client cert, but old server CA"); err != nil {
-		return err
+		if err := r.checkpoint(); err != nil {
+			return err
+		}
 	}
 
-	if err := r.swapCAs(ctx); err != nil {
-		return err
-	}
+	for _, step := range []struct {
+		phase Phase
+		run   func(context.Context) error
+	}{
+		{PhaseAccept, r.addNewCAAccepted},
+		{PhaseIssue, r.swapCAs},
+		{PhaseRetire, r.dropOldCA},
+	} {
+		if r.opts.Phase != "" && slices.Index(Phases, step.phase) > slices.Index(Phases, r.opts.Phase) {
+			r.opts.Printf("> Stopping after phase %q\n", r.opts.Phase)
+
+			break
+		}
 
-	if err := r.verifyConnectivity(ctx, r.newClient, "new PKI"); err != nil {
-		return err
+		if r.state.Done(step.phase) {
+			r.opts.Printf("> Phase %q is already completed, skipping\n", step.phase)
+
+			continue
+		}
+
+		if err := step.run(ctx); err != nil {
+			return fmt.Errorf("phase %q: %w", step.phase, err)
+		}
+
+		r.state.Completed = step.phase
+
+		if err := r.checkpoint(); err != nil {
+			return err
+		}
 	}
 
-	if err := r.dropOldCA(ctx); err != nil {
-		return err
+	return nil
+}
+
+func (r *rotator) checkpoint() error {
+	if r.opts.DryRun || r.opts.Checkpoint == nil {
+		return nil
 	}
 
-	if err := r.verifyConnectivity(ctx, r.newClient, "new PKI"); err != nil {
-		return err
+	if err := r.opts.Checkpoint(r.state); err != nil {
+		return fmt.Errorf("error saving rotation state: %w", err)
 	}
 
 	return nil
@@ -180,12 +279,24 @@ func (r *rotator) fetchCurrentCA(ctx context.Context) error {
 		return fmt.Errorf("error fetching existing Talos CA: %w", err)
 	}
 
-	r.currentCA = osRoot.TypedSpec().IssuingCA.Crt
+	r.state.CurrentCA = &x509.PEMEncodedCertificate{
+		Crt: osRoot.TypedSpec().IssuingCA.Crt,
+	}
+
+	return r.printYAML(osRoot.TypedSpec().IssuingCA)
+}
+
+func (r *rotator) printNewCA() error {
+	r.opts.Printf("> New Talos CA:\n")
+
+	return r.printYAML(r.state.NewCA)
+}
 
+func (r *rotator) printYAML(v any) error {
 	var b bytes.Buffer
 
-	if err = yaml.NewEncoder(&b).Encode(osRoot.TypedSpec().IssuingCA); err != nil {
-		return fmt.Errorf("error encoding new Talos CA: %w", err)
+	if err := yaml.NewEncoder(&b).Encode(v); err != nil {
+		return fmt.Errorf("error encoding Talos CA: %w", err)
 	}
 
 	for scanner := bufio.NewScanner(&b); scanner.Scan(); {
@@ -195,19 +306,20 @@ func (r *rotator) fetchCurrentCA(ctx context.Context) error {
 	return nil
 }
 
-func (r *rotator) printNewCA() error {
-	r.opts.Printf("> New Talos CA:\n")
-
-	var b bytes.Buffer
-
-	if err := yaml.NewEncoder(&b).Encode(r.opts.NewTalosCA); err != nil {
-		return fmt.Errorf("error encoding new Talos CA: %w", err)
+func (r *rotator) fingerprintCAs() error {
+	currentFingerprint, err := x509.SPKIFingerprintFromPEM(r.state.CurrentCA.Crt)
+	if err != nil {
+		return fmt.Errorf("error fingerprinting current Talos CA: %w", err)
 	}
 
-	for scanner := bufio.NewScanner(&b); scanner.Scan(); {
-		r.opts.Printf("  %s\n", scanner.Text())
+	newFingerprint, err := x509.SPKIFingerprintFromPEM(r.state.NewCA.Crt)
+	if err != nil {
+		return fmt.Errorf("error fingerprinting new Talos CA: %w", err)
 	}
 
+	r.currentFingerprint = currentFingerprint.String()
+	r.newFingerprint = newFingerprint.String()
+
 	return nil
 }
 
@@ -217,7 +329,7 @@ func (r *rotator) generateClients(ctx context.Context) error {
 	newBundle := &secrets.Bundle{
 		Clock: secrets.NewFixedClock(time.Now()),
 		Certs: &secrets.Certs{
-			OS: r.opts.NewTalosCA,
+			OS: r.state.NewCA,
 		},
 	}
 
@@ -226,11 +338,11 @@ func (r *rotator) generateClients(ctx context.Context) error {
 		return fmt.Errorf("error generating new talosconfig: %w", err)
 	}
 
-	// using old server CA, but new client cert
-	r.intermediateTalosconfig = clientconfig.NewConfig(r.opts.ContextName, r.opts.Endpoints, r.currentCA, cert)
+	// using both old and new server CAs, and a new client cert, so that it works while the nodes switch the issuing CA
+	r.intermediateTalosconfig = clientconfig.NewConfig(r.opts.ContextName, r.opts.Endpoints, slices.Concat(r.state.CurrentCA.Crt, r.state.NewCA.Crt), cert)
 
 	// using new server CA and a new client cert
-	r.newTalosconfig = clientconfig.NewConfig(r.opts.ContextName, r.opts.Endpoints, r.opts.NewTalosCA.Crt, cert)
+	r.newTalosconfig = clientconfig.NewConfig(r.opts.ContextName, r.opts.Endpoints, r.state.NewCA.Crt, cert)
 
 	marshalledTalosconfig, err := r.newTalosconfig.Bytes()
 	if err != nil {
@@ -298,19 +410,27 @@ func (r *rotator) addNewCAAccepted(ctx context.Context) error {
 
 	if err := r.patchAllNodes(ctx, r.opts.CurrentClient,
 		func(_ machine.Type, config *v1alpha1.Config) error {
-			config.MachineConfig.MachineAcceptedCAs = append(
-				config.MachineConfig.MachineAcceptedCAs,
-				&x509.PEMEncodedCertificate{
-					Crt: r.opts.NewTalosCA.Crt,
-				},
-			)
+			if !containsCA(config.MachineConfig.MachineAcceptedCAs, r.state.NewCA.Crt) {
+				config.MachineConfig.MachineAcceptedCAs = append(
+					config.MachineConfig.MachineAcceptedCAs,
+					&x509.PEMEncodedCertificate{
+						Crt: r.state.NewCA.Crt,
+					},
+				)
+			}
 
 			return nil
 		}); err != nil {
 		return fmt.Errorf("error patching all machine configs: %w", err)
 	}
 
-	return nil
+	if err := r.waitForConvergence(ctx, r.opts.CurrentClient, func(status *secretsres.OSRootStatusSpec) bool {
+		return status.IssuingCA == r.currentFingerprint && slices.Contains(status.AcceptedCAs, r.newFingerprint)
+	}); err != nil {
+		return err
+	}
+
+	return r.verifyConnectivity(ctx, r.intermediateClient, "new client cert, but old server CA")
 }
 
 func (r *rotator) swapCAs(ctx context.Context) error {
@@ -318,21 +438,24 @@ func (r *rotator) swapCAs(ctx context.Context) error {
 
 	if err := r.patchAllNodes(ctx, r.intermediateClient,
 		func(machineType machine.Type, config *v1alpha1.Config) error {
-			config.MachineConfig.MachineAcceptedCAs = append(
-				config.MachineConfig.MachineAcceptedCAs,
-				&x509.PEMEncodedCertificate{
-					Crt: r.currentCA,
-				},
-			)
+			if !containsCA(config.MachineConfig.MachineAcceptedCAs, r.state.CurrentCA.Crt) {
+				config.MachineConfig.MachineAcceptedCAs = append(
+					config.MachineConfig.MachineAcceptedCAs,
+					&x509.PEMEncodedCertificate{
+						Crt: r.state.CurrentCA.Crt,
+					},
+				)
+			}
+
 			config.MachineConfig.MachineAcceptedCAs = slices.DeleteFunc(config.Machine().Security().AcceptedCAs(), func(ca *x509.PEMEncodedCertificate) bool {
-				return bytes.Equal(ca.Crt, r.opts.NewTalosCA.Crt)
+				return bytes.Equal(ca.Crt, r.state.NewCA.Crt)
 			})
 
 			if machineType.IsControlPlane() {
-				config.MachineConfig.MachineCA = r.opts.NewTalosCA
+				config.MachineConfig.MachineCA = r.state.NewCA
 			} else {
 				config.MachineConfig.MachineCA = &x509.PEMEncodedCertificateAndKey{
-					Crt: r.opts.NewTalosCA.Crt,
+					Crt: r.state.NewCA.Crt,
 				}
 			}
 
@@ -341,7 +464,13 @@ func (r *rotator) swapCAs(ctx context.Context) error {
 		return fmt.Errorf("error patching all machine configs: %w", err)
 	}
 
-	return nil
+	if err := r.waitForConvergence(ctx, r.intermediateClient, func(status *secretsres.OSRootStatusSpec) bool {
+		return status.IssuingCA == r.newFingerprint && slices.Contains(status.AcceptedCAs, r.currentFingerprint)
+	}); err != nil {
+		return err
+	}
+
+	return r.verifyConnectivity(ctx, r.newClient, "new PKI")
 }
 
 func (r *rotator) dropOldCA(ctx context.Context) error {
@@ -350,7 +479,7 @@ func (r *rotator) dropOldCA(ctx context.Context) error {
 	if err := r.patchAllNodes(ctx, r.newClient,
 		func(_ machine.Type, config *v1alpha1.Config) error {
 			config.MachineConfig.MachineAcceptedCAs = slices.DeleteFunc(config.Machine().Security().AcceptedCAs(), func(ca *x509.PEMEncodedCertificate) bool {
-				return bytes.Equal(ca.Crt, r.currentCA)
+				return bytes.Equal(ca.Crt, r.state.CurrentCA.Crt)
 			})
 
 			return nil
@@ -358,9 +487,65 @@ func (r *rotator) dropOldCA(ctx context.Context) error {
 		return fmt.Errorf("error patching all machine configs: %w", err)
 	}
 
+	if err := r.waitForConvergence(ctx, r.newClient, func(status *secretsres.OSRootStatusSpec) bool {
+		return status.IssuingCA == r.newFingerprint && !slices.Contains(status.AcceptedCAs, r.currentFingerprint)
+	}); err != nil {
+		return err
+	}
+
+	return r.verifyConnectivity(ctx, r.newClient, "new PKI")
+}
+
+// waitForConvergence waits for all nodes to reach the expected CAs and to present the certificates issued by the issuing CA.
+func (r *rotator) waitForConvergence(ctx context.Context, c *client.Client, expected func(status *secretsres.OSRootStatusSpec) bool) error {
+	r.opts.Printf("> Waiting for the nodes to converge:\n")
+
+	for _, node := range r.opts.ClusterInfo.Nodes() {
+		if r.opts.DryRun {
+			r.opts.Printf("  - %s: OK (dry-run)\n", node.InternalIP)
+
+			continue
+		}
+
+		var status *secretsres.OSRootStatus
+
+		if err := retry.Constant(ConvergenceTimeout, retry.WithUnits(time.Second)).RetryWithContext(ctx, func(ctx context.Context) error {
+			var err error
+
+			status, err = safe.StateGetByID[*secretsres.OSRootStatus](client.WithNode(ctx, node.InternalIP.String()), c.COSI, secretsres.OSRootStatusID)
+			if err != nil {
+				if client.StatusCode(err) == codes.Unavailable || state.IsNotFoundError(err) {
+					return retry.ExpectedError(err)
+				}
+
+				return err
+			}
+
+			if !expected(status.TypedSpec()) {
+				return retry.ExpectedErrorf("node is in phase %q with issuing CA %s", status.TypedSpec().Phase, status.TypedSpec().IssuingCA)
+			}
+
+			if !status.TypedSpec().Converged {
+				return retry.ExpectedErrorf("certificates are not re-issued yet")
+			}
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error waiting for node %s to converge: %w", node.InternalIP, err)
+		}
+
+		r.opts.Printf("  - %s: OK (phase %s)\n", node.InternalIP, status.TypedSpec().Phase)
+	}
+
 	return nil
 }
 
+func containsCA(cas []*x509.PEMEncodedCertificate, crt []byte) bool {
+	return slices.ContainsFunc(cas, func(ca *x509.PEMEncodedCertificate) bool {
+		return bytes.Equal(ca.Crt, crt)
+	})
+}
+
 func (r *rotator) patchAllNodes(ctx context.Context, c *client.Client, patchFunc func(machineType machine.Type, config *v1alpha1.Config) error) error {
 	for _, machineType := range []machine.Type{machine.TypeInit, machine.TypeControlPlane, machine.TypeWorker} {
 		for _, node := range r.opts.ClusterInfo.NodesByType(machineType) {